@@ -7,6 +7,7 @@
 package adminpb
 
 import (
+	v11 "go-wind-admin/api/gen/go/authentication/service/v1"
 	v1 "go-wind-admin/api/gen/go/permission/service/v1"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
@@ -116,8 +117,9 @@ func (x *ListPermissionCodeResponse) GetCodes() []string {
 
 type InitialContextResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Menus         []*v1.MenuRouteItem    `protobuf:"bytes,1,rep,name=menus,proto3" json:"menus,omitempty"`             // 菜单树
-	Permissions   []string               `protobuf:"bytes,2,rep,name=permissions,proto3" json:"permissions,omitempty"` // 权限码
+	Menus         []*v1.MenuRouteItem    `protobuf:"bytes,1,rep,name=menus,proto3" json:"menus,omitempty"`                       // 菜单树
+	Permissions   []string               `protobuf:"bytes,2,rep,name=permissions,proto3" json:"permissions,omitempty"`           // 权限码
+	Impersonation *v11.ImpersonationInfo `protobuf:"bytes,3,opt,name=impersonation,proto3,oneof" json:"impersonation,omitempty"` // 代登录状态，仅代登录令牌返回
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -166,18 +168,27 @@ func (x *InitialContextResponse) GetPermissions() []string {
 	return nil
 }
 
+func (x *InitialContextResponse) GetImpersonation() *v11.ImpersonationInfo {
+	if x != nil {
+		return x.Impersonation
+	}
+	return nil
+}
+
 var File_admin_service_v1_i_admin_portal_proto protoreflect.FileDescriptor
 
 const file_admin_service_v1_i_admin_portal_proto_rawDesc = "" +
 	"\n" +
-	"%admin/service/v1/i_admin_portal.proto\x12\x10admin.service.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a permission/service/v1/menu.proto\"O\n" +
+	"%admin/service/v1/i_admin_portal.proto\x12\x10admin.service.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a-authentication/service/v1/impersonation.proto\x1a permission/service/v1/menu.proto\"O\n" +
 	"\x11ListRouteResponse\x12:\n" +
 	"\x05items\x18\x01 \x03(\v2$.permission.service.v1.MenuRouteItemR\x05items\"2\n" +
 	"\x1aListPermissionCodeResponse\x12\x14\n" +
-	"\x05codes\x18\x01 \x03(\tR\x05codes\"v\n" +
+	"\x05codes\x18\x01 \x03(\tR\x05codes\"\xe1\x01\n" +
 	"\x16InitialContextResponse\x12:\n" +
 	"\x05menus\x18\x01 \x03(\v2$.permission.service.v1.MenuRouteItemR\x05menus\x12 \n" +
-	"\vpermissions\x18\x02 \x03(\tR\vpermissions2\xf1\x02\n" +
+	"\vpermissions\x18\x02 \x03(\tR\vpermissions\x12W\n" +
+	"\rimpersonation\x18\x03 \x01(\v2,.authentication.service.v1.ImpersonationInfoH\x00R\rimpersonation\x88\x01\x01B\x10\n" +
+	"\x0e_impersonation2\xf1\x02\n" +
 	"\x12AdminPortalService\x12f\n" +
 	"\rGetNavigation\x12\x16.google.protobuf.Empty\x1a#.admin.service.v1.ListRouteResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/admin/v1/routes\x12y\n" +
 	"\x13GetMyPermissionCode\x12\x16.google.protobuf.Empty\x1a,.admin.service.v1.ListPermissionCodeResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/admin/v1/perm-codes\x12x\n" +
@@ -202,22 +213,24 @@ func file_admin_service_v1_i_admin_portal_proto_rawDescGZIP() []byte {
 	(*ListPermissionCodeResponse)(nil), // 1: admin.service.v1.ListPermissionCodeResponse
 	(*InitialContextResponse)(nil),     // 2: admin.service.v1.InitialContextResponse
 	(*v1.MenuRouteItem)(nil),           // 3: permission.service.v1.MenuRouteItem
-	(*emptypb.Empty)(nil),              // 4: google.protobuf.Empty
+	(*v11.ImpersonationInfo)(nil),      // 4: authentication.service.v1.ImpersonationInfo
+	(*emptypb.Empty)(nil),              // 5: google.protobuf.Empty
 }
 var file_admin_service_v1_i_admin_portal_proto_depIdxs = []int32{
 	3, // 0: admin.service.v1.ListRouteResponse.items:type_name -> permission.service.v1.MenuRouteItem
 	3, // 1: admin.service.v1.InitialContextResponse.menus:type_name -> permission.service.v1.MenuRouteItem
-	4, // 2: admin.service.v1.AdminPortalService.GetNavigation:input_type -> google.protobuf.Empty
-	4, // 3: admin.service.v1.AdminPortalService.GetMyPermissionCode:input_type -> google.protobuf.Empty
-	4, // 4: admin.service.v1.AdminPortalService.GetInitialContext:input_type -> google.protobuf.Empty
-	0, // 5: admin.service.v1.AdminPortalService.GetNavigation:output_type -> admin.service.v1.ListRouteResponse
-	1, // 6: admin.service.v1.AdminPortalService.GetMyPermissionCode:output_type -> admin.service.v1.ListPermissionCodeResponse
-	2, // 7: admin.service.v1.AdminPortalService.GetInitialContext:output_type -> admin.service.v1.InitialContextResponse
-	5, // [5:8] is the sub-list for method output_type
-	2, // [2:5] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	4, // 2: admin.service.v1.InitialContextResponse.impersonation:type_name -> authentication.service.v1.ImpersonationInfo
+	5, // 3: admin.service.v1.AdminPortalService.GetNavigation:input_type -> google.protobuf.Empty
+	5, // 4: admin.service.v1.AdminPortalService.GetMyPermissionCode:input_type -> google.protobuf.Empty
+	5, // 5: admin.service.v1.AdminPortalService.GetInitialContext:input_type -> google.protobuf.Empty
+	0, // 6: admin.service.v1.AdminPortalService.GetNavigation:output_type -> admin.service.v1.ListRouteResponse
+	1, // 7: admin.service.v1.AdminPortalService.GetMyPermissionCode:output_type -> admin.service.v1.ListPermissionCodeResponse
+	2, // 8: admin.service.v1.AdminPortalService.GetInitialContext:output_type -> admin.service.v1.InitialContextResponse
+	6, // [6:9] is the sub-list for method output_type
+	3, // [3:6] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_admin_service_v1_i_admin_portal_proto_init() }
@@ -225,6 +238,7 @@ func file_admin_service_v1_i_admin_portal_proto_init() {
 	if File_admin_service_v1_i_admin_portal_proto != nil {
 		return
 	}
+	file_admin_service_v1_i_admin_portal_proto_msgTypes[2].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
@@ -329,6 +329,39 @@ func (m *InitialContextResponse) validate(all bool) error {
 
 	}
 
+	if m.Impersonation != nil {
+
+		if all {
+			switch v := interface{}(m.GetImpersonation()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InitialContextResponseValidationError{
+						field:  "Impersonation",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InitialContextResponseValidationError{
+						field:  "Impersonation",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetImpersonation()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InitialContextResponseValidationError{
+					field:  "Impersonation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return InitialContextResponseMultiError(errors)
 	}
@@ -28,44 +28,64 @@
 
 const file_admin_service_v1_i_authentication_proto_rawDesc = "" +
 	"\n" +
-	"'admin/service/v1/i_authentication.proto\x12\x10admin.service.v1\x1a$gnostic/openapi/v3/annotations.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a.authentication/service/v1/authentication.proto2\xa7\x06\n" +
+	"'admin/service/v1/i_authentication.proto\x12\x10admin.service.v1\x1a$gnostic/openapi/v3/annotations.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a.authentication/service/v1/authentication.proto\x1a-authentication/service/v1/impersonation.proto\x1a0authentication/service/v1/reauthentication.proto2\xf8\v\n" +
 	"\x15AuthenticationService\x12{\n" +
 	"\x05Login\x12'.authentication.service.v1.LoginRequest\x1a(.authentication.service.v1.LoginResponse\"\x1f\xbaG\x02Z\x00\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/admin/v1/login\x12U\n" +
 	"\x06Logout\x12\x16.google.protobuf.Empty\x1a\x16.google.protobuf.Empty\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/admin/v1/logout\x12\x93\x01\n" +
 	"\fRegisterUser\x12..authentication.service.v1.RegisterUserRequest\x1a/.authentication.service.v1.RegisterUserResponse\"\"\xbaG\x02Z\x00\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/admin/v1/register\x12\x85\x01\n" +
 	"\fRefreshToken\x12'.authentication.service.v1.LoginRequest\x1a(.authentication.service.v1.LoginResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/admin/v1/refresh-token\x12}\n" +
 	"\x0fGenerateCaptcha\x12\x16.google.protobuf.Empty\x1a2.authentication.service.v1.GenerateCaptchaResponse\"\x1e\xbaG\x02Z\x00\x82\xd3\xe4\x93\x02\x13\x12\x11/admin/v1/captcha\x12\x9c\x01\n" +
-	"\rVerifyCaptcha\x12/.authentication.service.v1.VerifyCaptchaRequest\x1a0.authentication.service.v1.VerifyCaptchaResponse\"(\xbaG\x02Z\x00\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/admin/v1/captcha/verifyB\xc1\x01\n" +
+	"\rVerifyCaptcha\x12/.authentication.service.v1.VerifyCaptchaRequest\x1a0.authentication.service.v1.VerifyCaptchaResponse\"(\xbaG\x02Z\x00\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/admin/v1/captcha/verify\x12\x91\x01\n" +
+	"\x14RequestPasswordReset\x126.authentication.service.v1.RequestPasswordResetRequest\x1a\x16.google.protobuf.Empty\")\xbaG\x02Z\x00\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/admin/v1/password/forgot\x12\x90\x01\n" +
+	"\x14ConfirmPasswordReset\x126.authentication.service.v1.ConfirmPasswordResetRequest\x1a\x16.google.protobuf.Empty\"(\xbaG\x02Z\x00\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/admin/v1/password/reset\x12\x90\x01\n" +
+	"\x0fImpersonateUser\x121.authentication.service.v1.ImpersonateUserRequest\x1a(.authentication.service.v1.LoginResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/admin/v1/impersonate\x12\x80\x01\n" +
+	"\x0fActivateAccount\x121.authentication.service.v1.ActivateAccountRequest\x1a\x16.google.protobuf.Empty\"\"\xbaG\x02Z\x00\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/admin/v1/activate\x12\x91\x01\n" +
+	"\x0eReauthenticate\x120.authentication.service.v1.ReauthenticateRequest\x1a(.authentication.service.v1.LoginResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/admin/v1/reauthenticateB\xc1\x01\n" +
 	"\x14com.admin.service.v1B\x14IAuthenticationProtoP\x01Z1go-wind-admin/api/gen/go/admin/service/v1;adminpb\xa2\x02\x03ASX\xaa\x02\x10Admin.Service.V1\xca\x02\x10Admin\\Service\\V1\xe2\x02\x1cAdmin\\Service\\V1\\GPBMetadata\xea\x02\x12Admin::Service::V1b\x06proto3"
 
 var file_admin_service_v1_i_authentication_proto_goTypes = []any{
-	(*v1.LoginRequest)(nil),            // 0: authentication.service.v1.LoginRequest
-	(*emptypb.Empty)(nil),              // 1: google.protobuf.Empty
-	(*v1.RegisterUserRequest)(nil),     // 2: authentication.service.v1.RegisterUserRequest
-	(*v1.VerifyCaptchaRequest)(nil),    // 3: authentication.service.v1.VerifyCaptchaRequest
-	(*v1.LoginResponse)(nil),           // 4: authentication.service.v1.LoginResponse
-	(*v1.RegisterUserResponse)(nil),    // 5: authentication.service.v1.RegisterUserResponse
-	(*v1.GenerateCaptchaResponse)(nil), // 6: authentication.service.v1.GenerateCaptchaResponse
-	(*v1.VerifyCaptchaResponse)(nil),   // 7: authentication.service.v1.VerifyCaptchaResponse
+	(*v1.LoginRequest)(nil),                // 0: authentication.service.v1.LoginRequest
+	(*emptypb.Empty)(nil),                  // 1: google.protobuf.Empty
+	(*v1.RegisterUserRequest)(nil),         // 2: authentication.service.v1.RegisterUserRequest
+	(*v1.VerifyCaptchaRequest)(nil),        // 3: authentication.service.v1.VerifyCaptchaRequest
+	(*v1.RequestPasswordResetRequest)(nil), // 4: authentication.service.v1.RequestPasswordResetRequest
+	(*v1.ConfirmPasswordResetRequest)(nil), // 5: authentication.service.v1.ConfirmPasswordResetRequest
+	(*v1.ImpersonateUserRequest)(nil),      // 6: authentication.service.v1.ImpersonateUserRequest
+	(*v1.ActivateAccountRequest)(nil),      // 7: authentication.service.v1.ActivateAccountRequest
+	(*v1.ReauthenticateRequest)(nil),       // 8: authentication.service.v1.ReauthenticateRequest
+	(*v1.LoginResponse)(nil),               // 9: authentication.service.v1.LoginResponse
+	(*v1.RegisterUserResponse)(nil),        // 10: authentication.service.v1.RegisterUserResponse
+	(*v1.GenerateCaptchaResponse)(nil),     // 11: authentication.service.v1.GenerateCaptchaResponse
+	(*v1.VerifyCaptchaResponse)(nil),       // 12: authentication.service.v1.VerifyCaptchaResponse
 }
 var file_admin_service_v1_i_authentication_proto_depIdxs = []int32{
-	0, // 0: admin.service.v1.AuthenticationService.Login:input_type -> authentication.service.v1.LoginRequest
-	1, // 1: admin.service.v1.AuthenticationService.Logout:input_type -> google.protobuf.Empty
-	2, // 2: admin.service.v1.AuthenticationService.RegisterUser:input_type -> authentication.service.v1.RegisterUserRequest
-	0, // 3: admin.service.v1.AuthenticationService.RefreshToken:input_type -> authentication.service.v1.LoginRequest
-	1, // 4: admin.service.v1.AuthenticationService.GenerateCaptcha:input_type -> google.protobuf.Empty
-	3, // 5: admin.service.v1.AuthenticationService.VerifyCaptcha:input_type -> authentication.service.v1.VerifyCaptchaRequest
-	4, // 6: admin.service.v1.AuthenticationService.Login:output_type -> authentication.service.v1.LoginResponse
-	1, // 7: admin.service.v1.AuthenticationService.Logout:output_type -> google.protobuf.Empty
-	5, // 8: admin.service.v1.AuthenticationService.RegisterUser:output_type -> authentication.service.v1.RegisterUserResponse
-	4, // 9: admin.service.v1.AuthenticationService.RefreshToken:output_type -> authentication.service.v1.LoginResponse
-	6, // 10: admin.service.v1.AuthenticationService.GenerateCaptcha:output_type -> authentication.service.v1.GenerateCaptchaResponse
-	7, // 11: admin.service.v1.AuthenticationService.VerifyCaptcha:output_type -> authentication.service.v1.VerifyCaptchaResponse
-	6, // [6:12] is the sub-list for method output_type
-	0, // [0:6] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0,  // 0: admin.service.v1.AuthenticationService.Login:input_type -> authentication.service.v1.LoginRequest
+	1,  // 1: admin.service.v1.AuthenticationService.Logout:input_type -> google.protobuf.Empty
+	2,  // 2: admin.service.v1.AuthenticationService.RegisterUser:input_type -> authentication.service.v1.RegisterUserRequest
+	0,  // 3: admin.service.v1.AuthenticationService.RefreshToken:input_type -> authentication.service.v1.LoginRequest
+	1,  // 4: admin.service.v1.AuthenticationService.GenerateCaptcha:input_type -> google.protobuf.Empty
+	3,  // 5: admin.service.v1.AuthenticationService.VerifyCaptcha:input_type -> authentication.service.v1.VerifyCaptchaRequest
+	4,  // 6: admin.service.v1.AuthenticationService.RequestPasswordReset:input_type -> authentication.service.v1.RequestPasswordResetRequest
+	5,  // 7: admin.service.v1.AuthenticationService.ConfirmPasswordReset:input_type -> authentication.service.v1.ConfirmPasswordResetRequest
+	6,  // 8: admin.service.v1.AuthenticationService.ImpersonateUser:input_type -> authentication.service.v1.ImpersonateUserRequest
+	7,  // 9: admin.service.v1.AuthenticationService.ActivateAccount:input_type -> authentication.service.v1.ActivateAccountRequest
+	8,  // 10: admin.service.v1.AuthenticationService.Reauthenticate:input_type -> authentication.service.v1.ReauthenticateRequest
+	9,  // 11: admin.service.v1.AuthenticationService.Login:output_type -> authentication.service.v1.LoginResponse
+	1,  // 12: admin.service.v1.AuthenticationService.Logout:output_type -> google.protobuf.Empty
+	10, // 13: admin.service.v1.AuthenticationService.RegisterUser:output_type -> authentication.service.v1.RegisterUserResponse
+	9,  // 14: admin.service.v1.AuthenticationService.RefreshToken:output_type -> authentication.service.v1.LoginResponse
+	11, // 15: admin.service.v1.AuthenticationService.GenerateCaptcha:output_type -> authentication.service.v1.GenerateCaptchaResponse
+	12, // 16: admin.service.v1.AuthenticationService.VerifyCaptcha:output_type -> authentication.service.v1.VerifyCaptchaResponse
+	1,  // 17: admin.service.v1.AuthenticationService.RequestPasswordReset:output_type -> google.protobuf.Empty
+	1,  // 18: admin.service.v1.AuthenticationService.ConfirmPasswordReset:output_type -> google.protobuf.Empty
+	9,  // 19: admin.service.v1.AuthenticationService.ImpersonateUser:output_type -> authentication.service.v1.LoginResponse
+	1,  // 20: admin.service.v1.AuthenticationService.ActivateAccount:output_type -> google.protobuf.Empty
+	9,  // 21: admin.service.v1.AuthenticationService.Reauthenticate:output_type -> authentication.service.v1.LoginResponse
+	11, // [11:22] is the sub-list for method output_type
+	0,  // [0:11] is the sub-list for method input_type
+	0,  // [0:0] is the sub-list for extension type_name
+	0,  // [0:0] is the sub-list for extension extendee
+	0,  // [0:0] is the sub-list for field type_name
 }
 
 func init() { file_admin_service_v1_i_authentication_proto_init() }
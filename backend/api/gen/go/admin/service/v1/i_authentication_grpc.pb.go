@@ -21,12 +21,17 @@
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	AuthenticationService_Login_FullMethodName           = "/admin.service.v1.AuthenticationService/Login"
-	AuthenticationService_Logout_FullMethodName          = "/admin.service.v1.AuthenticationService/Logout"
-	AuthenticationService_RegisterUser_FullMethodName    = "/admin.service.v1.AuthenticationService/RegisterUser"
-	AuthenticationService_RefreshToken_FullMethodName    = "/admin.service.v1.AuthenticationService/RefreshToken"
-	AuthenticationService_GenerateCaptcha_FullMethodName = "/admin.service.v1.AuthenticationService/GenerateCaptcha"
-	AuthenticationService_VerifyCaptcha_FullMethodName   = "/admin.service.v1.AuthenticationService/VerifyCaptcha"
+	AuthenticationService_Login_FullMethodName                = "/admin.service.v1.AuthenticationService/Login"
+	AuthenticationService_Logout_FullMethodName               = "/admin.service.v1.AuthenticationService/Logout"
+	AuthenticationService_RegisterUser_FullMethodName         = "/admin.service.v1.AuthenticationService/RegisterUser"
+	AuthenticationService_RefreshToken_FullMethodName         = "/admin.service.v1.AuthenticationService/RefreshToken"
+	AuthenticationService_GenerateCaptcha_FullMethodName      = "/admin.service.v1.AuthenticationService/GenerateCaptcha"
+	AuthenticationService_VerifyCaptcha_FullMethodName        = "/admin.service.v1.AuthenticationService/VerifyCaptcha"
+	AuthenticationService_RequestPasswordReset_FullMethodName = "/admin.service.v1.AuthenticationService/RequestPasswordReset"
+	AuthenticationService_ConfirmPasswordReset_FullMethodName = "/admin.service.v1.AuthenticationService/ConfirmPasswordReset"
+	AuthenticationService_ImpersonateUser_FullMethodName      = "/admin.service.v1.AuthenticationService/ImpersonateUser"
+	AuthenticationService_ActivateAccount_FullMethodName      = "/admin.service.v1.AuthenticationService/ActivateAccount"
+	AuthenticationService_Reauthenticate_FullMethodName       = "/admin.service.v1.AuthenticationService/Reauthenticate"
 )
 
 // AuthenticationServiceClient is the client API for AuthenticationService service.
@@ -46,6 +51,16 @@ type AuthenticationServiceClient interface {
 	GenerateCaptcha(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*v1.GenerateCaptchaResponse, error)
 	// 验证验证码
 	VerifyCaptcha(ctx context.Context, in *v1.VerifyCaptchaRequest, opts ...grpc.CallOption) (*v1.VerifyCaptchaResponse, error)
+	// 申请找回密码：无论账号是否存在均返回成功，避免枚举
+	RequestPasswordReset(ctx context.Context, in *v1.RequestPasswordResetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 凭重置令牌设置新密码
+	ConfirmPasswordReset(ctx context.Context, in *v1.ConfirmPasswordResetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 代登录：以目标用户身份签发短时访问令牌，令牌同时记录真实操作人
+	ImpersonateUser(ctx context.Context, in *v1.ImpersonateUserRequest, opts ...grpc.CallOption) (*v1.LoginResponse, error)
+	// 凭激活令牌激活账号并设置密码
+	ActivateAccount(ctx context.Context, in *v1.ActivateAccountRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 二次验证：重新验证身份以执行敏感操作
+	Reauthenticate(ctx context.Context, in *v1.ReauthenticateRequest, opts ...grpc.CallOption) (*v1.LoginResponse, error)
 }
 
 type authenticationServiceClient struct {
@@ -116,6 +131,56 @@ func (c *authenticationServiceClient) VerifyCaptcha(ctx context.Context, in *v1.
 	return out, nil
 }
 
+func (c *authenticationServiceClient) RequestPasswordReset(ctx context.Context, in *v1.RequestPasswordResetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AuthenticationService_RequestPasswordReset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticationServiceClient) ConfirmPasswordReset(ctx context.Context, in *v1.ConfirmPasswordResetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AuthenticationService_ConfirmPasswordReset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticationServiceClient) ImpersonateUser(ctx context.Context, in *v1.ImpersonateUserRequest, opts ...grpc.CallOption) (*v1.LoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.LoginResponse)
+	err := c.cc.Invoke(ctx, AuthenticationService_ImpersonateUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticationServiceClient) ActivateAccount(ctx context.Context, in *v1.ActivateAccountRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AuthenticationService_ActivateAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticationServiceClient) Reauthenticate(ctx context.Context, in *v1.ReauthenticateRequest, opts ...grpc.CallOption) (*v1.LoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.LoginResponse)
+	err := c.cc.Invoke(ctx, AuthenticationService_Reauthenticate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AuthenticationServiceServer is the server API for AuthenticationService service.
 // All implementations must embed UnimplementedAuthenticationServiceServer
 // for forward compatibility.
@@ -133,6 +198,16 @@ type AuthenticationServiceServer interface {
 	GenerateCaptcha(context.Context, *emptypb.Empty) (*v1.GenerateCaptchaResponse, error)
 	// 验证验证码
 	VerifyCaptcha(context.Context, *v1.VerifyCaptchaRequest) (*v1.VerifyCaptchaResponse, error)
+	// 申请找回密码：无论账号是否存在均返回成功，避免枚举
+	RequestPasswordReset(context.Context, *v1.RequestPasswordResetRequest) (*emptypb.Empty, error)
+	// 凭重置令牌设置新密码
+	ConfirmPasswordReset(context.Context, *v1.ConfirmPasswordResetRequest) (*emptypb.Empty, error)
+	// 代登录：以目标用户身份签发短时访问令牌，令牌同时记录真实操作人
+	ImpersonateUser(context.Context, *v1.ImpersonateUserRequest) (*v1.LoginResponse, error)
+	// 凭激活令牌激活账号并设置密码
+	ActivateAccount(context.Context, *v1.ActivateAccountRequest) (*emptypb.Empty, error)
+	// 二次验证：重新验证身份以执行敏感操作
+	Reauthenticate(context.Context, *v1.ReauthenticateRequest) (*v1.LoginResponse, error)
 	mustEmbedUnimplementedAuthenticationServiceServer()
 }
 
@@ -161,6 +236,21 @@ func (UnimplementedAuthenticationServiceServer) GenerateCaptcha(context.Context,
 func (UnimplementedAuthenticationServiceServer) VerifyCaptcha(context.Context, *v1.VerifyCaptchaRequest) (*v1.VerifyCaptchaResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method VerifyCaptcha not implemented")
 }
+func (UnimplementedAuthenticationServiceServer) RequestPasswordReset(context.Context, *v1.RequestPasswordResetRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method RequestPasswordReset not implemented")
+}
+func (UnimplementedAuthenticationServiceServer) ConfirmPasswordReset(context.Context, *v1.ConfirmPasswordResetRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method ConfirmPasswordReset not implemented")
+}
+func (UnimplementedAuthenticationServiceServer) ImpersonateUser(context.Context, *v1.ImpersonateUserRequest) (*v1.LoginResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ImpersonateUser not implemented")
+}
+func (UnimplementedAuthenticationServiceServer) ActivateAccount(context.Context, *v1.ActivateAccountRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method ActivateAccount not implemented")
+}
+func (UnimplementedAuthenticationServiceServer) Reauthenticate(context.Context, *v1.ReauthenticateRequest) (*v1.LoginResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Reauthenticate not implemented")
+}
 func (UnimplementedAuthenticationServiceServer) mustEmbedUnimplementedAuthenticationServiceServer() {}
 func (UnimplementedAuthenticationServiceServer) testEmbeddedByValue()                               {}
 
@@ -290,6 +380,96 @@ func _AuthenticationService_VerifyCaptcha_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuthenticationService_RequestPasswordReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.RequestPasswordResetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticationServiceServer).RequestPasswordReset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthenticationService_RequestPasswordReset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticationServiceServer).RequestPasswordReset(ctx, req.(*v1.RequestPasswordResetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticationService_ConfirmPasswordReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.ConfirmPasswordResetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticationServiceServer).ConfirmPasswordReset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthenticationService_ConfirmPasswordReset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticationServiceServer).ConfirmPasswordReset(ctx, req.(*v1.ConfirmPasswordResetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticationService_ImpersonateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.ImpersonateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticationServiceServer).ImpersonateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthenticationService_ImpersonateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticationServiceServer).ImpersonateUser(ctx, req.(*v1.ImpersonateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticationService_ActivateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.ActivateAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticationServiceServer).ActivateAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthenticationService_ActivateAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticationServiceServer).ActivateAccount(ctx, req.(*v1.ActivateAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticationService_Reauthenticate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.ReauthenticateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticationServiceServer).Reauthenticate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthenticationService_Reauthenticate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticationServiceServer).Reauthenticate(ctx, req.(*v1.ReauthenticateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AuthenticationService_ServiceDesc is the grpc.ServiceDesc for AuthenticationService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -321,6 +501,26 @@ func _AuthenticationService_VerifyCaptcha_Handler(srv interface{}, ctx context.C
 			MethodName: "VerifyCaptcha",
 			Handler:    _AuthenticationService_VerifyCaptcha_Handler,
 		},
+		{
+			MethodName: "RequestPasswordReset",
+			Handler:    _AuthenticationService_RequestPasswordReset_Handler,
+		},
+		{
+			MethodName: "ConfirmPasswordReset",
+			Handler:    _AuthenticationService_ConfirmPasswordReset_Handler,
+		},
+		{
+			MethodName: "ImpersonateUser",
+			Handler:    _AuthenticationService_ImpersonateUser_Handler,
+		},
+		{
+			MethodName: "ActivateAccount",
+			Handler:    _AuthenticationService_ActivateAccount_Handler,
+		},
+		{
+			MethodName: "Reauthenticate",
+			Handler:    _AuthenticationService_Reauthenticate_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "admin/service/v1/i_authentication.proto",
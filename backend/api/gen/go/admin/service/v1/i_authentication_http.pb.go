@@ -21,23 +21,38 @@
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationAuthenticationServiceActivateAccount = "/admin.service.v1.AuthenticationService/ActivateAccount"
+const OperationAuthenticationServiceConfirmPasswordReset = "/admin.service.v1.AuthenticationService/ConfirmPasswordReset"
 const OperationAuthenticationServiceGenerateCaptcha = "/admin.service.v1.AuthenticationService/GenerateCaptcha"
+const OperationAuthenticationServiceImpersonateUser = "/admin.service.v1.AuthenticationService/ImpersonateUser"
 const OperationAuthenticationServiceLogin = "/admin.service.v1.AuthenticationService/Login"
 const OperationAuthenticationServiceLogout = "/admin.service.v1.AuthenticationService/Logout"
+const OperationAuthenticationServiceReauthenticate = "/admin.service.v1.AuthenticationService/Reauthenticate"
 const OperationAuthenticationServiceRefreshToken = "/admin.service.v1.AuthenticationService/RefreshToken"
 const OperationAuthenticationServiceRegisterUser = "/admin.service.v1.AuthenticationService/RegisterUser"
+const OperationAuthenticationServiceRequestPasswordReset = "/admin.service.v1.AuthenticationService/RequestPasswordReset"
 const OperationAuthenticationServiceVerifyCaptcha = "/admin.service.v1.AuthenticationService/VerifyCaptcha"
 
 type AuthenticationServiceHTTPServer interface {
+	// ActivateAccount 凭激活令牌激活账号并设置密码
+	ActivateAccount(context.Context, *v1.ActivateAccountRequest) (*emptypb.Empty, error)
+	// ConfirmPasswordReset 凭重置令牌设置新密码
+	ConfirmPasswordReset(context.Context, *v1.ConfirmPasswordResetRequest) (*emptypb.Empty, error)
 	// GenerateCaptcha 生成验证码
 	GenerateCaptcha(context.Context, *emptypb.Empty) (*v1.GenerateCaptchaResponse, error)
+	// ImpersonateUser 代登录：以目标用户身份签发短时访问令牌，令牌同时记录真实操作人
+	ImpersonateUser(context.Context, *v1.ImpersonateUserRequest) (*v1.LoginResponse, error)
 	// Login 登录
 	Login(context.Context, *v1.LoginRequest) (*v1.LoginResponse, error)
 	// Logout 登出
 	Logout(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	// Reauthenticate 二次验证：重新验证身份以执行敏感操作
+	Reauthenticate(context.Context, *v1.ReauthenticateRequest) (*v1.LoginResponse, error)
 	// RefreshToken 刷新认证令牌
 	RefreshToken(context.Context, *v1.LoginRequest) (*v1.LoginResponse, error)
 	RegisterUser(context.Context, *v1.RegisterUserRequest) (*v1.RegisterUserResponse, error)
+	// RequestPasswordReset 申请找回密码：无论账号是否存在均返回成功，避免枚举
+	RequestPasswordReset(context.Context, *v1.RequestPasswordResetRequest) (*emptypb.Empty, error)
 	// VerifyCaptcha 验证验证码
 	VerifyCaptcha(context.Context, *v1.VerifyCaptchaRequest) (*v1.VerifyCaptchaResponse, error)
 }
@@ -50,6 +65,11 @@ func RegisterAuthenticationServiceHTTPServer(s *http.Server, srv AuthenticationS
 	r.POST("/admin/v1/refresh-token", _AuthenticationService_RefreshToken0_HTTP_Handler(srv))
 	r.GET("/admin/v1/captcha", _AuthenticationService_GenerateCaptcha0_HTTP_Handler(srv))
 	r.POST("/admin/v1/captcha/verify", _AuthenticationService_VerifyCaptcha0_HTTP_Handler(srv))
+	r.POST("/admin/v1/password/forgot", _AuthenticationService_RequestPasswordReset0_HTTP_Handler(srv))
+	r.POST("/admin/v1/password/reset", _AuthenticationService_ConfirmPasswordReset0_HTTP_Handler(srv))
+	r.POST("/admin/v1/impersonate", _AuthenticationService_ImpersonateUser0_HTTP_Handler(srv))
+	r.POST("/admin/v1/activate", _AuthenticationService_ActivateAccount0_HTTP_Handler(srv))
+	r.POST("/admin/v1/reauthenticate", _AuthenticationService_Reauthenticate0_HTTP_Handler(srv))
 }
 
 func _AuthenticationService_Login0_HTTP_Handler(srv AuthenticationServiceHTTPServer) func(ctx http.Context) error {
@@ -181,16 +201,136 @@ func _AuthenticationService_VerifyCaptcha0_HTTP_Handler(srv AuthenticationServic
 	}
 }
 
+func _AuthenticationService_RequestPasswordReset0_HTTP_Handler(srv AuthenticationServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v1.RequestPasswordResetRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthenticationServiceRequestPasswordReset)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RequestPasswordReset(ctx, req.(*v1.RequestPasswordResetRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthenticationService_ConfirmPasswordReset0_HTTP_Handler(srv AuthenticationServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v1.ConfirmPasswordResetRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthenticationServiceConfirmPasswordReset)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ConfirmPasswordReset(ctx, req.(*v1.ConfirmPasswordResetRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthenticationService_ImpersonateUser0_HTTP_Handler(srv AuthenticationServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v1.ImpersonateUserRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthenticationServiceImpersonateUser)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ImpersonateUser(ctx, req.(*v1.ImpersonateUserRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v1.LoginResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthenticationService_ActivateAccount0_HTTP_Handler(srv AuthenticationServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v1.ActivateAccountRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthenticationServiceActivateAccount)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ActivateAccount(ctx, req.(*v1.ActivateAccountRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthenticationService_Reauthenticate0_HTTP_Handler(srv AuthenticationServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v1.ReauthenticateRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthenticationServiceReauthenticate)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Reauthenticate(ctx, req.(*v1.ReauthenticateRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v1.LoginResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 type AuthenticationServiceHTTPClient interface {
+	// ActivateAccount 凭激活令牌激活账号并设置密码
+	ActivateAccount(ctx context.Context, req *v1.ActivateAccountRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	// ConfirmPasswordReset 凭重置令牌设置新密码
+	ConfirmPasswordReset(ctx context.Context, req *v1.ConfirmPasswordResetRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 	// GenerateCaptcha 生成验证码
 	GenerateCaptcha(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *v1.GenerateCaptchaResponse, err error)
+	// ImpersonateUser 代登录：以目标用户身份签发短时访问令牌，令牌同时记录真实操作人
+	ImpersonateUser(ctx context.Context, req *v1.ImpersonateUserRequest, opts ...http.CallOption) (rsp *v1.LoginResponse, err error)
 	// Login 登录
 	Login(ctx context.Context, req *v1.LoginRequest, opts ...http.CallOption) (rsp *v1.LoginResponse, err error)
 	// Logout 登出
 	Logout(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	// Reauthenticate 二次验证：重新验证身份以执行敏感操作
+	Reauthenticate(ctx context.Context, req *v1.ReauthenticateRequest, opts ...http.CallOption) (rsp *v1.LoginResponse, err error)
 	// RefreshToken 刷新认证令牌
 	RefreshToken(ctx context.Context, req *v1.LoginRequest, opts ...http.CallOption) (rsp *v1.LoginResponse, err error)
 	RegisterUser(ctx context.Context, req *v1.RegisterUserRequest, opts ...http.CallOption) (rsp *v1.RegisterUserResponse, err error)
+	// RequestPasswordReset 申请找回密码：无论账号是否存在均返回成功，避免枚举
+	RequestPasswordReset(ctx context.Context, req *v1.RequestPasswordResetRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 	// VerifyCaptcha 验证验证码
 	VerifyCaptcha(ctx context.Context, req *v1.VerifyCaptchaRequest, opts ...http.CallOption) (rsp *v1.VerifyCaptchaResponse, err error)
 }
@@ -203,6 +343,34 @@ func NewAuthenticationServiceHTTPClient(client *http.Client) AuthenticationServi
 	return &AuthenticationServiceHTTPClientImpl{client}
 }
 
+// ActivateAccount 凭激活令牌激活账号并设置密码
+func (c *AuthenticationServiceHTTPClientImpl) ActivateAccount(ctx context.Context, in *v1.ActivateAccountRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/admin/v1/activate"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthenticationServiceActivateAccount))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ConfirmPasswordReset 凭重置令牌设置新密码
+func (c *AuthenticationServiceHTTPClientImpl) ConfirmPasswordReset(ctx context.Context, in *v1.ConfirmPasswordResetRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/admin/v1/password/reset"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthenticationServiceConfirmPasswordReset))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // GenerateCaptcha 生成验证码
 func (c *AuthenticationServiceHTTPClientImpl) GenerateCaptcha(ctx context.Context, in *emptypb.Empty, opts ...http.CallOption) (*v1.GenerateCaptchaResponse, error) {
 	var out v1.GenerateCaptchaResponse
@@ -217,6 +385,20 @@ func (c *AuthenticationServiceHTTPClientImpl) GenerateCaptcha(ctx context.Contex
 	return &out, nil
 }
 
+// ImpersonateUser 代登录：以目标用户身份签发短时访问令牌，令牌同时记录真实操作人
+func (c *AuthenticationServiceHTTPClientImpl) ImpersonateUser(ctx context.Context, in *v1.ImpersonateUserRequest, opts ...http.CallOption) (*v1.LoginResponse, error) {
+	var out v1.LoginResponse
+	pattern := "/admin/v1/impersonate"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthenticationServiceImpersonateUser))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // Login 登录
 func (c *AuthenticationServiceHTTPClientImpl) Login(ctx context.Context, in *v1.LoginRequest, opts ...http.CallOption) (*v1.LoginResponse, error) {
 	var out v1.LoginResponse
@@ -245,6 +427,20 @@ func (c *AuthenticationServiceHTTPClientImpl) Logout(ctx context.Context, in *em
 	return &out, nil
 }
 
+// Reauthenticate 二次验证：重新验证身份以执行敏感操作
+func (c *AuthenticationServiceHTTPClientImpl) Reauthenticate(ctx context.Context, in *v1.ReauthenticateRequest, opts ...http.CallOption) (*v1.LoginResponse, error) {
+	var out v1.LoginResponse
+	pattern := "/admin/v1/reauthenticate"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthenticationServiceReauthenticate))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // RefreshToken 刷新认证令牌
 func (c *AuthenticationServiceHTTPClientImpl) RefreshToken(ctx context.Context, in *v1.LoginRequest, opts ...http.CallOption) (*v1.LoginResponse, error) {
 	var out v1.LoginResponse
@@ -272,6 +468,20 @@ func (c *AuthenticationServiceHTTPClientImpl) RegisterUser(ctx context.Context,
 	return &out, nil
 }
 
+// RequestPasswordReset 申请找回密码：无论账号是否存在均返回成功，避免枚举
+func (c *AuthenticationServiceHTTPClientImpl) RequestPasswordReset(ctx context.Context, in *v1.RequestPasswordResetRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/admin/v1/password/forgot"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthenticationServiceRequestPasswordReset))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // VerifyCaptcha 验证验证码
 func (c *AuthenticationServiceHTTPClientImpl) VerifyCaptcha(ctx context.Context, in *v1.VerifyCaptchaRequest, opts ...http.CallOption) (*v1.VerifyCaptchaResponse, error) {
 	var out v1.VerifyCaptchaResponse
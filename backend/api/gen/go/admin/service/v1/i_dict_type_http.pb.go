@@ -43,15 +43,15 @@ type DictTypeServiceHTTPServer interface {
 
 func RegisterDictTypeServiceHTTPServer(s *http.Server, srv DictTypeServiceHTTPServer) {
 	r := s.Route("/")
-	r.GET("/admin/v1/dict/types", _DictTypeService_List4_HTTP_Handler(srv))
-	r.GET("/admin/v1/dict/types/code/{code}", _DictTypeService_Get3_HTTP_Handler(srv))
-	r.GET("/admin/v1/dict/types/{id}", _DictTypeService_Get4_HTTP_Handler(srv))
-	r.POST("/admin/v1/dict/types", _DictTypeService_Create2_HTTP_Handler(srv))
-	r.PUT("/admin/v1/dict/types/{id}", _DictTypeService_Update2_HTTP_Handler(srv))
-	r.DELETE("/admin/v1/dict/types", _DictTypeService_Delete2_HTTP_Handler(srv))
+	r.GET("/admin/v1/dict/types", _DictTypeService_List5_HTTP_Handler(srv))
+	r.GET("/admin/v1/dict/types/code/{code}", _DictTypeService_Get4_HTTP_Handler(srv))
+	r.GET("/admin/v1/dict/types/{id}", _DictTypeService_Get5_HTTP_Handler(srv))
+	r.POST("/admin/v1/dict/types", _DictTypeService_Create3_HTTP_Handler(srv))
+	r.PUT("/admin/v1/dict/types/{id}", _DictTypeService_Update3_HTTP_Handler(srv))
+	r.DELETE("/admin/v1/dict/types", _DictTypeService_Delete3_HTTP_Handler(srv))
 }
 
-func _DictTypeService_List4_HTTP_Handler(srv DictTypeServiceHTTPServer) func(ctx http.Context) error {
+func _DictTypeService_List5_HTTP_Handler(srv DictTypeServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in v1.PagingRequest
 		if err := ctx.BindQuery(&in); err != nil {
@@ -70,7 +70,7 @@ func _DictTypeService_List4_HTTP_Handler(srv DictTypeServiceHTTPServer) func(ctx
 	}
 }
 
-func _DictTypeService_Get3_HTTP_Handler(srv DictTypeServiceHTTPServer) func(ctx http.Context) error {
+func _DictTypeService_Get4_HTTP_Handler(srv DictTypeServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in v11.GetDictTypeRequest
 		if err := ctx.BindQuery(&in); err != nil {
@@ -92,7 +92,7 @@ func _DictTypeService_Get3_HTTP_Handler(srv DictTypeServiceHTTPServer) func(ctx
 	}
 }
 
-func _DictTypeService_Get4_HTTP_Handler(srv DictTypeServiceHTTPServer) func(ctx http.Context) error {
+func _DictTypeService_Get5_HTTP_Handler(srv DictTypeServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in v11.GetDictTypeRequest
 		if err := ctx.BindQuery(&in); err != nil {
@@ -114,7 +114,7 @@ func _DictTypeService_Get4_HTTP_Handler(srv DictTypeServiceHTTPServer) func(ctx
 	}
 }
 
-func _DictTypeService_Create2_HTTP_Handler(srv DictTypeServiceHTTPServer) func(ctx http.Context) error {
+func _DictTypeService_Create3_HTTP_Handler(srv DictTypeServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in v11.CreateDictTypeRequest
 		if err := ctx.Bind(&in); err != nil {
@@ -136,7 +136,7 @@ func _DictTypeService_Create2_HTTP_Handler(srv DictTypeServiceHTTPServer) func(c
 	}
 }
 
-func _DictTypeService_Update2_HTTP_Handler(srv DictTypeServiceHTTPServer) func(ctx http.Context) error {
+func _DictTypeService_Update3_HTTP_Handler(srv DictTypeServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in v11.UpdateDictTypeRequest
 		if err := ctx.Bind(&in); err != nil {
@@ -161,7 +161,7 @@ func _DictTypeService_Update2_HTTP_Handler(srv DictTypeServiceHTTPServer) func(c
 	}
 }
 
-func _DictTypeService_Delete2_HTTP_Handler(srv DictTypeServiceHTTPServer) func(ctx http.Context) error {
+func _DictTypeService_Delete3_HTTP_Handler(srv DictTypeServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in v11.DeleteDictTypeRequest
 		if err := ctx.BindQuery(&in); err != nil {
@@ -43,14 +43,14 @@ type FileServiceHTTPServer interface {
 
 func RegisterFileServiceHTTPServer(s *http.Server, srv FileServiceHTTPServer) {
 	r := s.Route("/")
-	r.GET("/admin/v1/files", _FileService_List5_HTTP_Handler(srv))
-	r.GET("/admin/v1/files/{id}", _FileService_Get5_HTTP_Handler(srv))
-	r.POST("/admin/v1/files", _FileService_Create3_HTTP_Handler(srv))
-	r.PUT("/admin/v1/files/{id}", _FileService_Update3_HTTP_Handler(srv))
-	r.DELETE("/admin/v1/files/{id}", _FileService_Delete3_HTTP_Handler(srv))
+	r.GET("/admin/v1/files", _FileService_List6_HTTP_Handler(srv))
+	r.GET("/admin/v1/files/{id}", _FileService_Get6_HTTP_Handler(srv))
+	r.POST("/admin/v1/files", _FileService_Create4_HTTP_Handler(srv))
+	r.PUT("/admin/v1/files/{id}", _FileService_Update4_HTTP_Handler(srv))
+	r.DELETE("/admin/v1/files/{id}", _FileService_Delete4_HTTP_Handler(srv))
 }
 
-func _FileService_List5_HTTP_Handler(srv FileServiceHTTPServer) func(ctx http.Context) error {
+func _FileService_List6_HTTP_Handler(srv FileServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in v1.PagingRequest
 		if err := ctx.BindQuery(&in); err != nil {
@@ -69,7 +69,7 @@ func _FileService_List5_HTTP_Handler(srv FileServiceHTTPServer) func(ctx http.Co
 	}
 }
 
-func _FileService_Get5_HTTP_Handler(srv FileServiceHTTPServer) func(ctx http.Context) error {
+func _FileService_Get6_HTTP_Handler(srv FileServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in v11.GetFileRequest
 		if err := ctx.BindQuery(&in); err != nil {
@@ -91,7 +91,7 @@ func _FileService_Get5_HTTP_Handler(srv FileServiceHTTPServer) func(ctx http.Con
 	}
 }
 
-func _FileService_Create3_HTTP_Handler(srv FileServiceHTTPServer) func(ctx http.Context) error {
+func _FileService_Create4_HTTP_Handler(srv FileServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in v11.CreateFileRequest
 		if err := ctx.Bind(&in); err != nil {
@@ -113,7 +113,7 @@ func _FileService_Create3_HTTP_Handler(srv FileServiceHTTPServer) func(ctx http.
 	}
 }
 
-func _FileService_Update3_HTTP_Handler(srv FileServiceHTTPServer) func(ctx http.Context) error {
+func _FileService_Update4_HTTP_Handler(srv FileServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in v11.UpdateFileRequest
 		if err := ctx.Bind(&in); err != nil {
@@ -138,7 +138,7 @@ func _FileService_Update3_HTTP_Handler(srv FileServiceHTTPServer) func(ctx http.
 	}
 }
 
-func _FileService_Delete3_HTTP_Handler(srv FileServiceHTTPServer) func(ctx http.Context) error {
+func _FileService_Delete4_HTTP_Handler(srv FileServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in v11.DeleteFileRequest
 		if err := ctx.BindQuery(&in); err != nil {
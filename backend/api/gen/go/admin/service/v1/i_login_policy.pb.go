@@ -28,24 +28,27 @@
 
 const file_admin_service_v1_i_login_policy_proto_rawDesc = "" +
 	"\n" +
-	"%admin/service/v1/i_login_policy.proto\x12\x10admin.service.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1epagination/v1/pagination.proto\x1a,authentication/service/v1/login_policy.proto2\x91\x05\n" +
+	"%admin/service/v1/i_login_policy.proto\x12\x10admin.service.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1epagination/v1/pagination.proto\x1a,authentication/service/v1/login_policy.proto2\xbb\x06\n" +
 	"\x12LoginPolicyService\x12w\n" +
 	"\x04List\x12\x19.pagination.PagingRequest\x1a2.authentication.service.v1.ListLoginPolicyResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/admin/v1/login-policies\x12\x86\x01\n" +
 	"\x03Get\x120.authentication.service.v1.GetLoginPolicyRequest\x1a&.authentication.service.v1.LoginPolicy\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/admin/v1/login-policies/{id}\x12z\n" +
 	"\x06Create\x123.authentication.service.v1.CreateLoginPolicyRequest\x1a\x16.google.protobuf.Empty\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/admin/v1/login-policies\x12\x7f\n" +
 	"\x06Update\x123.authentication.service.v1.UpdateLoginPolicyRequest\x1a\x16.google.protobuf.Empty\"(\x82\xd3\xe4\x93\x02\":\x01*\x1a\x1d/admin/v1/login-policies/{id}\x12|\n" +
-	"\x06Delete\x123.authentication.service.v1.DeleteLoginPolicyRequest\x1a\x16.google.protobuf.Empty\"%\x82\xd3\xe4\x93\x02\x1f*\x1d/admin/v1/login-policies/{id}B\xbe\x01\n" +
+	"\x06Delete\x123.authentication.service.v1.DeleteLoginPolicyRequest\x1a\x16.google.protobuf.Empty\"%\x82\xd3\xe4\x93\x02\x1f*\x1d/admin/v1/login-policies/{id}\x12\xa7\x01\n" +
+	"\bEvaluate\x125.authentication.service.v1.EvaluateLoginPolicyRequest\x1a6.authentication.service.v1.EvaluateLoginPolicyResponse\",\x82\xd3\xe4\x93\x02&:\x01*\"!/admin/v1/login-policies/evaluateB\xbe\x01\n" +
 	"\x14com.admin.service.v1B\x11ILoginPolicyProtoP\x01Z1go-wind-admin/api/gen/go/admin/service/v1;adminpb\xa2\x02\x03ASX\xaa\x02\x10Admin.Service.V1\xca\x02\x10Admin\\Service\\V1\xe2\x02\x1cAdmin\\Service\\V1\\GPBMetadata\xea\x02\x12Admin::Service::V1b\x06proto3"
 
 var file_admin_service_v1_i_login_policy_proto_goTypes = []any{
-	(*v1.PagingRequest)(nil),             // 0: pagination.PagingRequest
-	(*v11.GetLoginPolicyRequest)(nil),    // 1: authentication.service.v1.GetLoginPolicyRequest
-	(*v11.CreateLoginPolicyRequest)(nil), // 2: authentication.service.v1.CreateLoginPolicyRequest
-	(*v11.UpdateLoginPolicyRequest)(nil), // 3: authentication.service.v1.UpdateLoginPolicyRequest
-	(*v11.DeleteLoginPolicyRequest)(nil), // 4: authentication.service.v1.DeleteLoginPolicyRequest
-	(*v11.ListLoginPolicyResponse)(nil),  // 5: authentication.service.v1.ListLoginPolicyResponse
-	(*v11.LoginPolicy)(nil),              // 6: authentication.service.v1.LoginPolicy
-	(*emptypb.Empty)(nil),                // 7: google.protobuf.Empty
+	(*v1.PagingRequest)(nil),                // 0: pagination.PagingRequest
+	(*v11.GetLoginPolicyRequest)(nil),       // 1: authentication.service.v1.GetLoginPolicyRequest
+	(*v11.CreateLoginPolicyRequest)(nil),    // 2: authentication.service.v1.CreateLoginPolicyRequest
+	(*v11.UpdateLoginPolicyRequest)(nil),    // 3: authentication.service.v1.UpdateLoginPolicyRequest
+	(*v11.DeleteLoginPolicyRequest)(nil),    // 4: authentication.service.v1.DeleteLoginPolicyRequest
+	(*v11.EvaluateLoginPolicyRequest)(nil),  // 5: authentication.service.v1.EvaluateLoginPolicyRequest
+	(*v11.ListLoginPolicyResponse)(nil),     // 6: authentication.service.v1.ListLoginPolicyResponse
+	(*v11.LoginPolicy)(nil),                 // 7: authentication.service.v1.LoginPolicy
+	(*emptypb.Empty)(nil),                   // 8: google.protobuf.Empty
+	(*v11.EvaluateLoginPolicyResponse)(nil), // 9: authentication.service.v1.EvaluateLoginPolicyResponse
 }
 var file_admin_service_v1_i_login_policy_proto_depIdxs = []int32{
 	0, // 0: admin.service.v1.LoginPolicyService.List:input_type -> pagination.PagingRequest
@@ -53,13 +56,15 @@
 	2, // 2: admin.service.v1.LoginPolicyService.Create:input_type -> authentication.service.v1.CreateLoginPolicyRequest
 	3, // 3: admin.service.v1.LoginPolicyService.Update:input_type -> authentication.service.v1.UpdateLoginPolicyRequest
 	4, // 4: admin.service.v1.LoginPolicyService.Delete:input_type -> authentication.service.v1.DeleteLoginPolicyRequest
-	5, // 5: admin.service.v1.LoginPolicyService.List:output_type -> authentication.service.v1.ListLoginPolicyResponse
-	6, // 6: admin.service.v1.LoginPolicyService.Get:output_type -> authentication.service.v1.LoginPolicy
-	7, // 7: admin.service.v1.LoginPolicyService.Create:output_type -> google.protobuf.Empty
-	7, // 8: admin.service.v1.LoginPolicyService.Update:output_type -> google.protobuf.Empty
-	7, // 9: admin.service.v1.LoginPolicyService.Delete:output_type -> google.protobuf.Empty
-	5, // [5:10] is the sub-list for method output_type
-	0, // [0:5] is the sub-list for method input_type
+	5, // 5: admin.service.v1.LoginPolicyService.Evaluate:input_type -> authentication.service.v1.EvaluateLoginPolicyRequest
+	6, // 6: admin.service.v1.LoginPolicyService.List:output_type -> authentication.service.v1.ListLoginPolicyResponse
+	7, // 7: admin.service.v1.LoginPolicyService.Get:output_type -> authentication.service.v1.LoginPolicy
+	8, // 8: admin.service.v1.LoginPolicyService.Create:output_type -> google.protobuf.Empty
+	8, // 9: admin.service.v1.LoginPolicyService.Update:output_type -> google.protobuf.Empty
+	8, // 10: admin.service.v1.LoginPolicyService.Delete:output_type -> google.protobuf.Empty
+	9, // 11: admin.service.v1.LoginPolicyService.Evaluate:output_type -> authentication.service.v1.EvaluateLoginPolicyResponse
+	6, // [6:12] is the sub-list for method output_type
+	0, // [0:6] is the sub-list for method input_type
 	0, // [0:0] is the sub-list for extension type_name
 	0, // [0:0] is the sub-list for extension extendee
 	0, // [0:0] is the sub-list for field type_name
@@ -22,11 +22,12 @@
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	LoginPolicyService_List_FullMethodName   = "/admin.service.v1.LoginPolicyService/List"
-	LoginPolicyService_Get_FullMethodName    = "/admin.service.v1.LoginPolicyService/Get"
-	LoginPolicyService_Create_FullMethodName = "/admin.service.v1.LoginPolicyService/Create"
-	LoginPolicyService_Update_FullMethodName = "/admin.service.v1.LoginPolicyService/Update"
-	LoginPolicyService_Delete_FullMethodName = "/admin.service.v1.LoginPolicyService/Delete"
+	LoginPolicyService_List_FullMethodName     = "/admin.service.v1.LoginPolicyService/List"
+	LoginPolicyService_Get_FullMethodName      = "/admin.service.v1.LoginPolicyService/Get"
+	LoginPolicyService_Create_FullMethodName   = "/admin.service.v1.LoginPolicyService/Create"
+	LoginPolicyService_Update_FullMethodName   = "/admin.service.v1.LoginPolicyService/Update"
+	LoginPolicyService_Delete_FullMethodName   = "/admin.service.v1.LoginPolicyService/Delete"
+	LoginPolicyService_Evaluate_FullMethodName = "/admin.service.v1.LoginPolicyService/Evaluate"
 )
 
 // LoginPolicyServiceClient is the client API for LoginPolicyService service.
@@ -45,6 +46,8 @@ type LoginPolicyServiceClient interface {
 	Update(ctx context.Context, in *v11.UpdateLoginPolicyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// 删除登录策略
 	Delete(ctx context.Context, in *v11.DeleteLoginPolicyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 模拟判定登录策略：给定用户、IP、设备与时间，列出会拦截该次登录的策略
+	Evaluate(ctx context.Context, in *v11.EvaluateLoginPolicyRequest, opts ...grpc.CallOption) (*v11.EvaluateLoginPolicyResponse, error)
 }
 
 type loginPolicyServiceClient struct {
@@ -105,6 +108,16 @@ func (c *loginPolicyServiceClient) Delete(ctx context.Context, in *v11.DeleteLog
 	return out, nil
 }
 
+func (c *loginPolicyServiceClient) Evaluate(ctx context.Context, in *v11.EvaluateLoginPolicyRequest, opts ...grpc.CallOption) (*v11.EvaluateLoginPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v11.EvaluateLoginPolicyResponse)
+	err := c.cc.Invoke(ctx, LoginPolicyService_Evaluate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LoginPolicyServiceServer is the server API for LoginPolicyService service.
 // All implementations must embed UnimplementedLoginPolicyServiceServer
 // for forward compatibility.
@@ -121,6 +134,8 @@ type LoginPolicyServiceServer interface {
 	Update(context.Context, *v11.UpdateLoginPolicyRequest) (*emptypb.Empty, error)
 	// 删除登录策略
 	Delete(context.Context, *v11.DeleteLoginPolicyRequest) (*emptypb.Empty, error)
+	// 模拟判定登录策略：给定用户、IP、设备与时间，列出会拦截该次登录的策略
+	Evaluate(context.Context, *v11.EvaluateLoginPolicyRequest) (*v11.EvaluateLoginPolicyResponse, error)
 	mustEmbedUnimplementedLoginPolicyServiceServer()
 }
 
@@ -146,6 +161,9 @@ func (UnimplementedLoginPolicyServiceServer) Update(context.Context, *v11.Update
 func (UnimplementedLoginPolicyServiceServer) Delete(context.Context, *v11.DeleteLoginPolicyRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method Delete not implemented")
 }
+func (UnimplementedLoginPolicyServiceServer) Evaluate(context.Context, *v11.EvaluateLoginPolicyRequest) (*v11.EvaluateLoginPolicyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Evaluate not implemented")
+}
 func (UnimplementedLoginPolicyServiceServer) mustEmbedUnimplementedLoginPolicyServiceServer() {}
 func (UnimplementedLoginPolicyServiceServer) testEmbeddedByValue()                            {}
 
@@ -257,6 +275,24 @@ func _LoginPolicyService_Delete_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LoginPolicyService_Evaluate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v11.EvaluateLoginPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoginPolicyServiceServer).Evaluate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoginPolicyService_Evaluate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoginPolicyServiceServer).Evaluate(ctx, req.(*v11.EvaluateLoginPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // LoginPolicyService_ServiceDesc is the grpc.ServiceDesc for LoginPolicyService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -284,6 +320,10 @@ func _LoginPolicyService_Delete_Handler(srv interface{}, ctx context.Context, de
 			MethodName: "Delete",
 			Handler:    _LoginPolicyService_Delete_Handler,
 		},
+		{
+			MethodName: "Evaluate",
+			Handler:    _LoginPolicyService_Evaluate_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "admin/service/v1/i_login_policy.proto",
@@ -24,6 +24,7 @@
 
 const OperationLoginPolicyServiceCreate = "/admin.service.v1.LoginPolicyService/Create"
 const OperationLoginPolicyServiceDelete = "/admin.service.v1.LoginPolicyService/Delete"
+const OperationLoginPolicyServiceEvaluate = "/admin.service.v1.LoginPolicyService/Evaluate"
 const OperationLoginPolicyServiceGet = "/admin.service.v1.LoginPolicyService/Get"
 const OperationLoginPolicyServiceList = "/admin.service.v1.LoginPolicyService/List"
 const OperationLoginPolicyServiceUpdate = "/admin.service.v1.LoginPolicyService/Update"
@@ -33,6 +34,8 @@ type LoginPolicyServiceHTTPServer interface {
 	Create(context.Context, *v11.CreateLoginPolicyRequest) (*emptypb.Empty, error)
 	// Delete 删除登录策略
 	Delete(context.Context, *v11.DeleteLoginPolicyRequest) (*emptypb.Empty, error)
+	// Evaluate 模拟判定登录策略：给定用户、IP、设备与时间，列出会拦截该次登录的策略
+	Evaluate(context.Context, *v11.EvaluateLoginPolicyRequest) (*v11.EvaluateLoginPolicyResponse, error)
 	// Get 查询登录策略详情
 	Get(context.Context, *v11.GetLoginPolicyRequest) (*v11.LoginPolicy, error)
 	// List 查询登录策略列表
@@ -43,14 +46,15 @@ type LoginPolicyServiceHTTPServer interface {
 
 func RegisterLoginPolicyServiceHTTPServer(s *http.Server, srv LoginPolicyServiceHTTPServer) {
 	r := s.Route("/")
-	r.GET("/admin/v1/login-policies", _LoginPolicyService_List9_HTTP_Handler(srv))
-	r.GET("/admin/v1/login-policies/{id}", _LoginPolicyService_Get9_HTTP_Handler(srv))
-	r.POST("/admin/v1/login-policies", _LoginPolicyService_Create6_HTTP_Handler(srv))
-	r.PUT("/admin/v1/login-policies/{id}", _LoginPolicyService_Update6_HTTP_Handler(srv))
-	r.DELETE("/admin/v1/login-policies/{id}", _LoginPolicyService_Delete6_HTTP_Handler(srv))
+	r.GET("/admin/v1/login-policies", _LoginPolicyService_List12_HTTP_Handler(srv))
+	r.GET("/admin/v1/login-policies/{id}", _LoginPolicyService_Get11_HTTP_Handler(srv))
+	r.POST("/admin/v1/login-policies", _LoginPolicyService_Create8_HTTP_Handler(srv))
+	r.PUT("/admin/v1/login-policies/{id}", _LoginPolicyService_Update8_HTTP_Handler(srv))
+	r.DELETE("/admin/v1/login-policies/{id}", _LoginPolicyService_Delete8_HTTP_Handler(srv))
+	r.POST("/admin/v1/login-policies/evaluate", _LoginPolicyService_Evaluate0_HTTP_Handler(srv))
 }
 
-func _LoginPolicyService_List9_HTTP_Handler(srv LoginPolicyServiceHTTPServer) func(ctx http.Context) error {
+func _LoginPolicyService_List12_HTTP_Handler(srv LoginPolicyServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in v1.PagingRequest
 		if err := ctx.BindQuery(&in); err != nil {
@@ -69,7 +73,7 @@ func _LoginPolicyService_List9_HTTP_Handler(srv LoginPolicyServiceHTTPServer) fu
 	}
 }
 
-func _LoginPolicyService_Get9_HTTP_Handler(srv LoginPolicyServiceHTTPServer) func(ctx http.Context) error {
+func _LoginPolicyService_Get11_HTTP_Handler(srv LoginPolicyServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in v11.GetLoginPolicyRequest
 		if err := ctx.BindQuery(&in); err != nil {
@@ -91,7 +95,7 @@ func _LoginPolicyService_Get9_HTTP_Handler(srv LoginPolicyServiceHTTPServer) fun
 	}
 }
 
-func _LoginPolicyService_Create6_HTTP_Handler(srv LoginPolicyServiceHTTPServer) func(ctx http.Context) error {
+func _LoginPolicyService_Create8_HTTP_Handler(srv LoginPolicyServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in v11.CreateLoginPolicyRequest
 		if err := ctx.Bind(&in); err != nil {
@@ -113,7 +117,7 @@ func _LoginPolicyService_Create6_HTTP_Handler(srv LoginPolicyServiceHTTPServer)
 	}
 }
 
-func _LoginPolicyService_Update6_HTTP_Handler(srv LoginPolicyServiceHTTPServer) func(ctx http.Context) error {
+func _LoginPolicyService_Update8_HTTP_Handler(srv LoginPolicyServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in v11.UpdateLoginPolicyRequest
 		if err := ctx.Bind(&in); err != nil {
@@ -138,7 +142,7 @@ func _LoginPolicyService_Update6_HTTP_Handler(srv LoginPolicyServiceHTTPServer)
 	}
 }
 
-func _LoginPolicyService_Delete6_HTTP_Handler(srv LoginPolicyServiceHTTPServer) func(ctx http.Context) error {
+func _LoginPolicyService_Delete8_HTTP_Handler(srv LoginPolicyServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in v11.DeleteLoginPolicyRequest
 		if err := ctx.BindQuery(&in); err != nil {
@@ -160,11 +164,35 @@ func _LoginPolicyService_Delete6_HTTP_Handler(srv LoginPolicyServiceHTTPServer)
 	}
 }
 
+func _LoginPolicyService_Evaluate0_HTTP_Handler(srv LoginPolicyServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v11.EvaluateLoginPolicyRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationLoginPolicyServiceEvaluate)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Evaluate(ctx, req.(*v11.EvaluateLoginPolicyRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v11.EvaluateLoginPolicyResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 type LoginPolicyServiceHTTPClient interface {
 	// Create 创建登录策略
 	Create(ctx context.Context, req *v11.CreateLoginPolicyRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 	// Delete 删除登录策略
 	Delete(ctx context.Context, req *v11.DeleteLoginPolicyRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	// Evaluate 模拟判定登录策略：给定用户、IP、设备与时间，列出会拦截该次登录的策略
+	Evaluate(ctx context.Context, req *v11.EvaluateLoginPolicyRequest, opts ...http.CallOption) (rsp *v11.EvaluateLoginPolicyResponse, err error)
 	// Get 查询登录策略详情
 	Get(ctx context.Context, req *v11.GetLoginPolicyRequest, opts ...http.CallOption) (rsp *v11.LoginPolicy, err error)
 	// List 查询登录策略列表
@@ -209,6 +237,20 @@ func (c *LoginPolicyServiceHTTPClientImpl) Delete(ctx context.Context, in *v11.D
 	return &out, nil
 }
 
+// Evaluate 模拟判定登录策略：给定用户、IP、设备与时间，列出会拦截该次登录的策略
+func (c *LoginPolicyServiceHTTPClientImpl) Evaluate(ctx context.Context, in *v11.EvaluateLoginPolicyRequest, opts ...http.CallOption) (*v11.EvaluateLoginPolicyResponse, error) {
+	var out v11.EvaluateLoginPolicyResponse
+	pattern := "/admin/v1/login-policies/evaluate"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationLoginPolicyServiceEvaluate))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // Get 查询登录策略详情
 func (c *LoginPolicyServiceHTTPClientImpl) Get(ctx context.Context, in *v11.GetLoginPolicyRequest, opts ...http.CallOption) (*v11.LoginPolicy, error) {
 	var out v11.LoginPolicy
@@ -28,7 +28,7 @@
 
 const file_admin_service_v1_i_mfa_proto_rawDesc = "" +
 	"\n" +
-	"\x1cadmin/service/v1/i_mfa.proto\x12\x10admin.service.v1\x1a$gnostic/openapi/v3/annotations.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a#authentication/service/v1/mfa.proto\x1a.authentication/service/v1/authentication.proto2\xb3\b\n" +
+	"\x1cadmin/service/v1/i_mfa.proto\x12\x10admin.service.v1\x1a$gnostic/openapi/v3/annotations.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a#authentication/service/v1/mfa.proto\x1a.authentication/service/v1/authentication.proto2\x87\x14\n" +
 	"\n" +
 	"MfaService\x12\x8d\x01\n" +
 	"\fGetMFAStatus\x12..authentication.service.v1.GetMFAStatusRequest\x1a/.authentication.service.v1.GetMFAStatusResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/admin/v1/mfa/status\x12\xa3\x01\n" +
@@ -37,8 +37,17 @@
 	"\x13ConfirmEnrollMethod\x125.authentication.service.v1.ConfirmEnrollMethodRequest\x1a6.authentication.service.v1.ConfirmEnrollMethodResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/admin/v1/mfa/enroll/confirm\x12t\n" +
 	"\n" +
 	"DisableMFA\x12,.authentication.service.v1.DisableMFARequest\x1a\x16.google.protobuf.Empty\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/admin/v1/mfa/disable\x12\x83\x01\n" +
-	"\x0fRevokeMFADevice\x121.authentication.service.v1.RevokeMFADeviceRequest\x1a\x16.google.protobuf.Empty\"%\x82\xd3\xe4\x93\x02\x1f*\x1d/admin/v1/mfa/{credential_id}\x12\x9a\x01\n" +
-	"\x12VerifyMFAChallenge\x124.authentication.service.v1.VerifyMFAChallengeRequest\x1a(.authentication.service.v1.LoginResponse\"$\xbaG\x02Z\x00\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/admin/v1/mfa/verifyB\xb6\x01\n" +
+	"\x0fRevokeMFADevice\x121.authentication.service.v1.RevokeMFADeviceRequest\x1a\x16.google.protobuf.Empty\"%\x82\xd3\xe4\x93\x02\x1f*\x1d/admin/v1/mfa/{credential_id}\x12\xab\x01\n" +
+	"\x13GenerateBackupCodes\x125.authentication.service.v1.GenerateBackupCodesRequest\x1a6.authentication.service.v1.GenerateBackupCodesResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/admin/v1/mfa/backup-codes\x12\x9c\x01\n" +
+	"\x0fListBackupCodes\x121.authentication.service.v1.ListBackupCodesRequest\x1a2.authentication.service.v1.ListBackupCodesResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/admin/v1/mfa/backup-codes\x12\xad\x01\n" +
+	"\x11StartMFAChallenge\x123.authentication.service.v1.StartMFAChallengeRequest\x1a4.authentication.service.v1.StartMFAChallengeResponse\"-\xbaG\x02Z\x00\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/admin/v1/mfa/challenge/start\x12\x9a\x01\n" +
+	"\x12VerifyMFAChallenge\x124.authentication.service.v1.VerifyMFAChallengeRequest\x1a(.authentication.service.v1.LoginResponse\"$\xbaG\x02Z\x00\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/admin/v1/mfa/verify\x12\xb3\x01\n" +
+	"\x14StartLoginEnrollment\x123.authentication.service.v1.StartEnrollMethodRequest\x1a4.authentication.service.v1.StartEnrollMethodResponse\"0\xbaG\x02Z\x00\x82\xd3\xe4\x93\x02%:\x01*\" /admin/v1/mfa/login/enroll/start\x12\xbb\x01\n" +
+	"\x16ConfirmLoginEnrollment\x125.authentication.service.v1.ConfirmEnrollMethodRequest\x1a6.authentication.service.v1.ConfirmEnrollMethodResponse\"2\xbaG\x02Z\x00\x82\xd3\xe4\x93\x02':\x01*\"\"/admin/v1/mfa/login/enroll/confirm\x12\x82\x01\n" +
+	"\fGetMFAPolicy\x12..authentication.service.v1.GetMFAPolicyRequest\x1a$.authentication.service.v1.MFAPolicy\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/admin/v1/mfa/policy\x12}\n" +
+	"\x0fUpdateMFAPolicy\x121.authentication.service.v1.UpdateMFAPolicyRequest\x1a\x16.google.protobuf.Empty\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\x1a\x14/admin/v1/mfa/policy\x12\xb1\x01\n" +
+	"\x11StartPasskeyLogin\x123.authentication.service.v1.StartPasskeyLoginRequest\x1a4.authentication.service.v1.StartPasskeyLoginResponse\"1\xbaG\x02Z\x00\x82\xd3\xe4\x93\x02&:\x01*\"!/admin/v1/mfa/passkey/login/start\x12\xa8\x01\n" +
+	"\x12FinishPasskeyLogin\x124.authentication.service.v1.FinishPasskeyLoginRequest\x1a(.authentication.service.v1.LoginResponse\"2\xbaG\x02Z\x00\x82\xd3\xe4\x93\x02':\x01*\"\"/admin/v1/mfa/passkey/login/finishB\xb6\x01\n" +
 	"\x14com.admin.service.v1B\tIMfaProtoP\x01Z1go-wind-admin/api/gen/go/admin/service/v1;adminpb\xa2\x02\x03ASX\xaa\x02\x10Admin.Service.V1\xca\x02\x10Admin\\Service\\V1\xe2\x02\x1cAdmin\\Service\\V1\\GPBMetadata\xea\x02\x12Admin::Service::V1b\x06proto3"
 
 var file_admin_service_v1_i_mfa_proto_goTypes = []any{
@@ -48,13 +57,25 @@
 	(*v1.ConfirmEnrollMethodRequest)(nil),  // 3: authentication.service.v1.ConfirmEnrollMethodRequest
 	(*v1.DisableMFARequest)(nil),           // 4: authentication.service.v1.DisableMFARequest
 	(*v1.RevokeMFADeviceRequest)(nil),      // 5: authentication.service.v1.RevokeMFADeviceRequest
-	(*v1.VerifyMFAChallengeRequest)(nil),   // 6: authentication.service.v1.VerifyMFAChallengeRequest
-	(*v1.GetMFAStatusResponse)(nil),        // 7: authentication.service.v1.GetMFAStatusResponse
-	(*v1.ListEnrolledMethodsResponse)(nil), // 8: authentication.service.v1.ListEnrolledMethodsResponse
-	(*v1.StartEnrollMethodResponse)(nil),   // 9: authentication.service.v1.StartEnrollMethodResponse
-	(*v1.ConfirmEnrollMethodResponse)(nil), // 10: authentication.service.v1.ConfirmEnrollMethodResponse
-	(*emptypb.Empty)(nil),                  // 11: google.protobuf.Empty
-	(*v1.LoginResponse)(nil),               // 12: authentication.service.v1.LoginResponse
+	(*v1.GenerateBackupCodesRequest)(nil),  // 6: authentication.service.v1.GenerateBackupCodesRequest
+	(*v1.ListBackupCodesRequest)(nil),      // 7: authentication.service.v1.ListBackupCodesRequest
+	(*v1.StartMFAChallengeRequest)(nil),    // 8: authentication.service.v1.StartMFAChallengeRequest
+	(*v1.VerifyMFAChallengeRequest)(nil),   // 9: authentication.service.v1.VerifyMFAChallengeRequest
+	(*v1.GetMFAPolicyRequest)(nil),         // 10: authentication.service.v1.GetMFAPolicyRequest
+	(*v1.UpdateMFAPolicyRequest)(nil),      // 11: authentication.service.v1.UpdateMFAPolicyRequest
+	(*v1.StartPasskeyLoginRequest)(nil),    // 12: authentication.service.v1.StartPasskeyLoginRequest
+	(*v1.FinishPasskeyLoginRequest)(nil),   // 13: authentication.service.v1.FinishPasskeyLoginRequest
+	(*v1.GetMFAStatusResponse)(nil),        // 14: authentication.service.v1.GetMFAStatusResponse
+	(*v1.ListEnrolledMethodsResponse)(nil), // 15: authentication.service.v1.ListEnrolledMethodsResponse
+	(*v1.StartEnrollMethodResponse)(nil),   // 16: authentication.service.v1.StartEnrollMethodResponse
+	(*v1.ConfirmEnrollMethodResponse)(nil), // 17: authentication.service.v1.ConfirmEnrollMethodResponse
+	(*emptypb.Empty)(nil),                  // 18: google.protobuf.Empty
+	(*v1.GenerateBackupCodesResponse)(nil), // 19: authentication.service.v1.GenerateBackupCodesResponse
+	(*v1.ListBackupCodesResponse)(nil),     // 20: authentication.service.v1.ListBackupCodesResponse
+	(*v1.StartMFAChallengeResponse)(nil),   // 21: authentication.service.v1.StartMFAChallengeResponse
+	(*v1.LoginResponse)(nil),               // 22: authentication.service.v1.LoginResponse
+	(*v1.MFAPolicy)(nil),                   // 23: authentication.service.v1.MFAPolicy
+	(*v1.StartPasskeyLoginResponse)(nil),   // 24: authentication.service.v1.StartPasskeyLoginResponse
 }
 var file_admin_service_v1_i_mfa_proto_depIdxs = []int32{
 	0,  // 0: admin.service.v1.MfaService.GetMFAStatus:input_type -> authentication.service.v1.GetMFAStatusRequest
@@ -63,16 +84,34 @@
 	3,  // 3: admin.service.v1.MfaService.ConfirmEnrollMethod:input_type -> authentication.service.v1.ConfirmEnrollMethodRequest
 	4,  // 4: admin.service.v1.MfaService.DisableMFA:input_type -> authentication.service.v1.DisableMFARequest
 	5,  // 5: admin.service.v1.MfaService.RevokeMFADevice:input_type -> authentication.service.v1.RevokeMFADeviceRequest
-	6,  // 6: admin.service.v1.MfaService.VerifyMFAChallenge:input_type -> authentication.service.v1.VerifyMFAChallengeRequest
-	7,  // 7: admin.service.v1.MfaService.GetMFAStatus:output_type -> authentication.service.v1.GetMFAStatusResponse
-	8,  // 8: admin.service.v1.MfaService.ListEnrolledMethods:output_type -> authentication.service.v1.ListEnrolledMethodsResponse
-	9,  // 9: admin.service.v1.MfaService.StartEnrollMethod:output_type -> authentication.service.v1.StartEnrollMethodResponse
-	10, // 10: admin.service.v1.MfaService.ConfirmEnrollMethod:output_type -> authentication.service.v1.ConfirmEnrollMethodResponse
-	11, // 11: admin.service.v1.MfaService.DisableMFA:output_type -> google.protobuf.Empty
-	11, // 12: admin.service.v1.MfaService.RevokeMFADevice:output_type -> google.protobuf.Empty
-	12, // 13: admin.service.v1.MfaService.VerifyMFAChallenge:output_type -> authentication.service.v1.LoginResponse
-	7,  // [7:14] is the sub-list for method output_type
-	0,  // [0:7] is the sub-list for method input_type
+	6,  // 6: admin.service.v1.MfaService.GenerateBackupCodes:input_type -> authentication.service.v1.GenerateBackupCodesRequest
+	7,  // 7: admin.service.v1.MfaService.ListBackupCodes:input_type -> authentication.service.v1.ListBackupCodesRequest
+	8,  // 8: admin.service.v1.MfaService.StartMFAChallenge:input_type -> authentication.service.v1.StartMFAChallengeRequest
+	9,  // 9: admin.service.v1.MfaService.VerifyMFAChallenge:input_type -> authentication.service.v1.VerifyMFAChallengeRequest
+	2,  // 10: admin.service.v1.MfaService.StartLoginEnrollment:input_type -> authentication.service.v1.StartEnrollMethodRequest
+	3,  // 11: admin.service.v1.MfaService.ConfirmLoginEnrollment:input_type -> authentication.service.v1.ConfirmEnrollMethodRequest
+	10, // 12: admin.service.v1.MfaService.GetMFAPolicy:input_type -> authentication.service.v1.GetMFAPolicyRequest
+	11, // 13: admin.service.v1.MfaService.UpdateMFAPolicy:input_type -> authentication.service.v1.UpdateMFAPolicyRequest
+	12, // 14: admin.service.v1.MfaService.StartPasskeyLogin:input_type -> authentication.service.v1.StartPasskeyLoginRequest
+	13, // 15: admin.service.v1.MfaService.FinishPasskeyLogin:input_type -> authentication.service.v1.FinishPasskeyLoginRequest
+	14, // 16: admin.service.v1.MfaService.GetMFAStatus:output_type -> authentication.service.v1.GetMFAStatusResponse
+	15, // 17: admin.service.v1.MfaService.ListEnrolledMethods:output_type -> authentication.service.v1.ListEnrolledMethodsResponse
+	16, // 18: admin.service.v1.MfaService.StartEnrollMethod:output_type -> authentication.service.v1.StartEnrollMethodResponse
+	17, // 19: admin.service.v1.MfaService.ConfirmEnrollMethod:output_type -> authentication.service.v1.ConfirmEnrollMethodResponse
+	18, // 20: admin.service.v1.MfaService.DisableMFA:output_type -> google.protobuf.Empty
+	18, // 21: admin.service.v1.MfaService.RevokeMFADevice:output_type -> google.protobuf.Empty
+	19, // 22: admin.service.v1.MfaService.GenerateBackupCodes:output_type -> authentication.service.v1.GenerateBackupCodesResponse
+	20, // 23: admin.service.v1.MfaService.ListBackupCodes:output_type -> authentication.service.v1.ListBackupCodesResponse
+	21, // 24: admin.service.v1.MfaService.StartMFAChallenge:output_type -> authentication.service.v1.StartMFAChallengeResponse
+	22, // 25: admin.service.v1.MfaService.VerifyMFAChallenge:output_type -> authentication.service.v1.LoginResponse
+	16, // 26: admin.service.v1.MfaService.StartLoginEnrollment:output_type -> authentication.service.v1.StartEnrollMethodResponse
+	17, // 27: admin.service.v1.MfaService.ConfirmLoginEnrollment:output_type -> authentication.service.v1.ConfirmEnrollMethodResponse
+	23, // 28: admin.service.v1.MfaService.GetMFAPolicy:output_type -> authentication.service.v1.MFAPolicy
+	18, // 29: admin.service.v1.MfaService.UpdateMFAPolicy:output_type -> google.protobuf.Empty
+	24, // 30: admin.service.v1.MfaService.StartPasskeyLogin:output_type -> authentication.service.v1.StartPasskeyLoginResponse
+	22, // 31: admin.service.v1.MfaService.FinishPasskeyLogin:output_type -> authentication.service.v1.LoginResponse
+	16, // [16:32] is the sub-list for method output_type
+	0,  // [0:16] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
@@ -21,13 +21,22 @@
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	MfaService_GetMFAStatus_FullMethodName        = "/admin.service.v1.MfaService/GetMFAStatus"
-	MfaService_ListEnrolledMethods_FullMethodName = "/admin.service.v1.MfaService/ListEnrolledMethods"
-	MfaService_StartEnrollMethod_FullMethodName   = "/admin.service.v1.MfaService/StartEnrollMethod"
-	MfaService_ConfirmEnrollMethod_FullMethodName = "/admin.service.v1.MfaService/ConfirmEnrollMethod"
-	MfaService_DisableMFA_FullMethodName          = "/admin.service.v1.MfaService/DisableMFA"
-	MfaService_RevokeMFADevice_FullMethodName     = "/admin.service.v1.MfaService/RevokeMFADevice"
-	MfaService_VerifyMFAChallenge_FullMethodName  = "/admin.service.v1.MfaService/VerifyMFAChallenge"
+	MfaService_GetMFAStatus_FullMethodName           = "/admin.service.v1.MfaService/GetMFAStatus"
+	MfaService_ListEnrolledMethods_FullMethodName    = "/admin.service.v1.MfaService/ListEnrolledMethods"
+	MfaService_StartEnrollMethod_FullMethodName      = "/admin.service.v1.MfaService/StartEnrollMethod"
+	MfaService_ConfirmEnrollMethod_FullMethodName    = "/admin.service.v1.MfaService/ConfirmEnrollMethod"
+	MfaService_DisableMFA_FullMethodName             = "/admin.service.v1.MfaService/DisableMFA"
+	MfaService_RevokeMFADevice_FullMethodName        = "/admin.service.v1.MfaService/RevokeMFADevice"
+	MfaService_GenerateBackupCodes_FullMethodName    = "/admin.service.v1.MfaService/GenerateBackupCodes"
+	MfaService_ListBackupCodes_FullMethodName        = "/admin.service.v1.MfaService/ListBackupCodes"
+	MfaService_StartMFAChallenge_FullMethodName      = "/admin.service.v1.MfaService/StartMFAChallenge"
+	MfaService_VerifyMFAChallenge_FullMethodName     = "/admin.service.v1.MfaService/VerifyMFAChallenge"
+	MfaService_StartLoginEnrollment_FullMethodName   = "/admin.service.v1.MfaService/StartLoginEnrollment"
+	MfaService_ConfirmLoginEnrollment_FullMethodName = "/admin.service.v1.MfaService/ConfirmLoginEnrollment"
+	MfaService_GetMFAPolicy_FullMethodName           = "/admin.service.v1.MfaService/GetMFAPolicy"
+	MfaService_UpdateMFAPolicy_FullMethodName        = "/admin.service.v1.MfaService/UpdateMFAPolicy"
+	MfaService_StartPasskeyLogin_FullMethodName      = "/admin.service.v1.MfaService/StartPasskeyLogin"
+	MfaService_FinishPasskeyLogin_FullMethodName     = "/admin.service.v1.MfaService/FinishPasskeyLogin"
 )
 
 // MfaServiceClient is the client API for MfaService service.
@@ -36,26 +45,49 @@
 //
 // MFA（多因素认证）服务 HTTP 桥接。
 // 管理侧 RPC（GetMFAStatus/ListEnrolledMethods/StartEnrollMethod/ConfirmEnrollMethod/
-// DisableMFA/RevokeMFADevice）需登录态，走正常 auth+authz 中间件，不加 security:{}。
-// 登录挑战侧 RPC（VerifyMFAChallenge）免鉴权，加 security:{} 并加入 rest_server 白名单。
+// DisableMFA/RevokeMFADevice/GenerateBackupCodes/ListBackupCodes/GetMFAPolicy/UpdateMFAPolicy）
+// 需登录态，走正常 auth+authz 中间件，不加 security:{}。
+// 登录挑战侧 RPC（StartMFAChallenge/VerifyMFAChallenge/StartLoginEnrollment/ConfirmLoginEnrollment/
+// StartPasskeyLogin/FinishPasskeyLogin）免鉴权，加 security:{} 并加入 rest_server 白名单。
 type MfaServiceClient interface {
 	// 查询当前登录用户 MFA 总览
 	GetMFAStatus(ctx context.Context, in *v1.GetMFAStatusRequest, opts ...grpc.CallOption) (*v1.GetMFAStatusResponse, error)
 	// 列出已注册的 MFA 凭证
 	ListEnrolledMethods(ctx context.Context, in *v1.ListEnrolledMethodsRequest, opts ...grpc.CallOption) (*v1.ListEnrolledMethodsResponse, error)
-	// 开始注册 MFA 方法（返回 secret/QR，仅 TOTP 本轮实现）
+	// 开始注册 MFA 方法（TOTP 返回 secret/QR；WEBAUTHN 返回注册仪式参数；SMS/EMAIL 下发验证码）
 	StartEnrollMethod(ctx context.Context, in *v1.StartEnrollMethodRequest, opts ...grpc.CallOption) (*v1.StartEnrollMethodResponse, error)
-	// 确认注册 MFA 方法（提交首码完成绑定）
+	// 确认注册 MFA 方法（TOTP 提交首码；WEBAUTHN 提交认证器注册响应；SMS/EMAIL 提交验证码）
 	ConfirmEnrollMethod(ctx context.Context, in *v1.ConfirmEnrollMethodRequest, opts ...grpc.CallOption) (*v1.ConfirmEnrollMethodResponse, error)
 	// 禁用/移除已注册 MFA 凭证。
 	// 注意：kratos http 生成器不支持 DELETE 请求体（handler 只 BindQuery），
 	// 而 TS 生成器默认把 message 序列化为 body——为两端一致改用 POST + body。
 	DisableMFA(ctx context.Context, in *v1.DisableMFARequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	// 撤销指定 MFA 凭证（按 id）
+	// 撤销指定 MFA 凭证（按 id）。
+	// ⚠️ 当前无前端调用方：DELETE 请求体在 Go 生成器（恒 BindQuery）与 TS 生成器
+	// （发 body）之间不一致，贸然对接会静默丢参——需要时应改 POST（参见 DisableMFA）。
 	RevokeMFADevice(ctx context.Context, in *v1.RevokeMFADeviceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	// 验证登录 MFA 挑战。通过则返回 LoginResponse（含真 access_token）。
+	// 生成一批一次性备份码（明文仅本次返回；重新生成作废旧码）
+	GenerateBackupCodes(ctx context.Context, in *v1.GenerateBackupCodesRequest, opts ...grpc.CallOption) (*v1.GenerateBackupCodesResponse, error)
+	// 查询备份码剩余数量（不返回明文）
+	ListBackupCodes(ctx context.Context, in *v1.ListBackupCodesRequest, opts ...grpc.CallOption) (*v1.ListBackupCodesResponse, error)
+	// 发起登录 MFA 挑战。WEBAUTHN 返回断言参数；SMS/EMAIL 下发验证码（可重发）；TOTP 无需调用。
+	// 免鉴权：凭登录返回的 mfa_operation_id 调用。
+	StartMFAChallenge(ctx context.Context, in *v1.StartMFAChallengeRequest, opts ...grpc.CallOption) (*v1.StartMFAChallengeResponse, error)
+	// 验证登录 MFA 挑战（TOTP 码 / WebAuthn 断言 / 备份码）。通过则返回 LoginResponse（含真 access_token）。
 	// 免鉴权：登录流程在密码校验通过、待二次验证阶段调用。
 	VerifyMFAChallenge(ctx context.Context, in *v1.VerifyMFAChallengeRequest, opts ...grpc.CallOption) (*v1.LoginResponse, error)
+	// 登录强制注册：MFA 策略要求启用而用户尚无因子时，凭登录返回的 mfa_operation_id 开始注册（免鉴权）
+	StartLoginEnrollment(ctx context.Context, in *v1.StartEnrollMethodRequest, opts ...grpc.CallOption) (*v1.StartEnrollMethodResponse, error)
+	// 登录强制注册：确认注册（免鉴权）。成功后以同一 mfa_operation_id 调用 VerifyMFAChallenge 换取 token
+	ConfirmLoginEnrollment(ctx context.Context, in *v1.ConfirmEnrollMethodRequest, opts ...grpc.CallOption) (*v1.ConfirmEnrollMethodResponse, error)
+	// 查询 MFA 策略（租户维度；平台管理员可指定租户）
+	GetMFAPolicy(ctx context.Context, in *v1.GetMFAPolicyRequest, opts ...grpc.CallOption) (*v1.MFAPolicy, error)
+	// 更新 MFA 策略（不存在则创建）
+	UpdateMFAPolicy(ctx context.Context, in *v1.UpdateMFAPolicyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 发起通行密钥无密码登录（免鉴权）
+	StartPasskeyLogin(ctx context.Context, in *v1.StartPasskeyLoginRequest, opts ...grpc.CallOption) (*v1.StartPasskeyLoginResponse, error)
+	// 完成通行密钥无密码登录，返回 LoginResponse（免鉴权）
+	FinishPasskeyLogin(ctx context.Context, in *v1.FinishPasskeyLoginRequest, opts ...grpc.CallOption) (*v1.LoginResponse, error)
 }
 
 type mfaServiceClient struct {
@@ -126,6 +158,36 @@ func (c *mfaServiceClient) RevokeMFADevice(ctx context.Context, in *v1.RevokeMFA
 	return out, nil
 }
 
+func (c *mfaServiceClient) GenerateBackupCodes(ctx context.Context, in *v1.GenerateBackupCodesRequest, opts ...grpc.CallOption) (*v1.GenerateBackupCodesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.GenerateBackupCodesResponse)
+	err := c.cc.Invoke(ctx, MfaService_GenerateBackupCodes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mfaServiceClient) ListBackupCodes(ctx context.Context, in *v1.ListBackupCodesRequest, opts ...grpc.CallOption) (*v1.ListBackupCodesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.ListBackupCodesResponse)
+	err := c.cc.Invoke(ctx, MfaService_ListBackupCodes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mfaServiceClient) StartMFAChallenge(ctx context.Context, in *v1.StartMFAChallengeRequest, opts ...grpc.CallOption) (*v1.StartMFAChallengeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.StartMFAChallengeResponse)
+	err := c.cc.Invoke(ctx, MfaService_StartMFAChallenge_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mfaServiceClient) VerifyMFAChallenge(ctx context.Context, in *v1.VerifyMFAChallengeRequest, opts ...grpc.CallOption) (*v1.LoginResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(v1.LoginResponse)
@@ -136,32 +198,115 @@ func (c *mfaServiceClient) VerifyMFAChallenge(ctx context.Context, in *v1.Verify
 	return out, nil
 }
 
+func (c *mfaServiceClient) StartLoginEnrollment(ctx context.Context, in *v1.StartEnrollMethodRequest, opts ...grpc.CallOption) (*v1.StartEnrollMethodResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.StartEnrollMethodResponse)
+	err := c.cc.Invoke(ctx, MfaService_StartLoginEnrollment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mfaServiceClient) ConfirmLoginEnrollment(ctx context.Context, in *v1.ConfirmEnrollMethodRequest, opts ...grpc.CallOption) (*v1.ConfirmEnrollMethodResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.ConfirmEnrollMethodResponse)
+	err := c.cc.Invoke(ctx, MfaService_ConfirmLoginEnrollment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mfaServiceClient) GetMFAPolicy(ctx context.Context, in *v1.GetMFAPolicyRequest, opts ...grpc.CallOption) (*v1.MFAPolicy, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.MFAPolicy)
+	err := c.cc.Invoke(ctx, MfaService_GetMFAPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mfaServiceClient) UpdateMFAPolicy(ctx context.Context, in *v1.UpdateMFAPolicyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, MfaService_UpdateMFAPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mfaServiceClient) StartPasskeyLogin(ctx context.Context, in *v1.StartPasskeyLoginRequest, opts ...grpc.CallOption) (*v1.StartPasskeyLoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.StartPasskeyLoginResponse)
+	err := c.cc.Invoke(ctx, MfaService_StartPasskeyLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mfaServiceClient) FinishPasskeyLogin(ctx context.Context, in *v1.FinishPasskeyLoginRequest, opts ...grpc.CallOption) (*v1.LoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.LoginResponse)
+	err := c.cc.Invoke(ctx, MfaService_FinishPasskeyLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MfaServiceServer is the server API for MfaService service.
 // All implementations must embed UnimplementedMfaServiceServer
 // for forward compatibility.
 //
 // MFA（多因素认证）服务 HTTP 桥接。
 // 管理侧 RPC（GetMFAStatus/ListEnrolledMethods/StartEnrollMethod/ConfirmEnrollMethod/
-// DisableMFA/RevokeMFADevice）需登录态，走正常 auth+authz 中间件，不加 security:{}。
-// 登录挑战侧 RPC（VerifyMFAChallenge）免鉴权，加 security:{} 并加入 rest_server 白名单。
+// DisableMFA/RevokeMFADevice/GenerateBackupCodes/ListBackupCodes/GetMFAPolicy/UpdateMFAPolicy）
+// 需登录态，走正常 auth+authz 中间件，不加 security:{}。
+// 登录挑战侧 RPC（StartMFAChallenge/VerifyMFAChallenge/StartLoginEnrollment/ConfirmLoginEnrollment/
+// StartPasskeyLogin/FinishPasskeyLogin）免鉴权，加 security:{} 并加入 rest_server 白名单。
 type MfaServiceServer interface {
 	// 查询当前登录用户 MFA 总览
 	GetMFAStatus(context.Context, *v1.GetMFAStatusRequest) (*v1.GetMFAStatusResponse, error)
 	// 列出已注册的 MFA 凭证
 	ListEnrolledMethods(context.Context, *v1.ListEnrolledMethodsRequest) (*v1.ListEnrolledMethodsResponse, error)
-	// 开始注册 MFA 方法（返回 secret/QR，仅 TOTP 本轮实现）
+	// 开始注册 MFA 方法（TOTP 返回 secret/QR；WEBAUTHN 返回注册仪式参数；SMS/EMAIL 下发验证码）
 	StartEnrollMethod(context.Context, *v1.StartEnrollMethodRequest) (*v1.StartEnrollMethodResponse, error)
-	// 确认注册 MFA 方法（提交首码完成绑定）
+	// 确认注册 MFA 方法（TOTP 提交首码；WEBAUTHN 提交认证器注册响应；SMS/EMAIL 提交验证码）
 	ConfirmEnrollMethod(context.Context, *v1.ConfirmEnrollMethodRequest) (*v1.ConfirmEnrollMethodResponse, error)
 	// 禁用/移除已注册 MFA 凭证。
 	// 注意：kratos http 生成器不支持 DELETE 请求体（handler 只 BindQuery），
 	// 而 TS 生成器默认把 message 序列化为 body——为两端一致改用 POST + body。
 	DisableMFA(context.Context, *v1.DisableMFARequest) (*emptypb.Empty, error)
-	// 撤销指定 MFA 凭证（按 id）
+	// 撤销指定 MFA 凭证（按 id）。
+	// ⚠️ 当前无前端调用方：DELETE 请求体在 Go 生成器（恒 BindQuery）与 TS 生成器
+	// （发 body）之间不一致，贸然对接会静默丢参——需要时应改 POST（参见 DisableMFA）。
 	RevokeMFADevice(context.Context, *v1.RevokeMFADeviceRequest) (*emptypb.Empty, error)
-	// 验证登录 MFA 挑战。通过则返回 LoginResponse（含真 access_token）。
+	// 生成一批一次性备份码（明文仅本次返回；重新生成作废旧码）
+	GenerateBackupCodes(context.Context, *v1.GenerateBackupCodesRequest) (*v1.GenerateBackupCodesResponse, error)
+	// 查询备份码剩余数量（不返回明文）
+	ListBackupCodes(context.Context, *v1.ListBackupCodesRequest) (*v1.ListBackupCodesResponse, error)
+	// 发起登录 MFA 挑战。WEBAUTHN 返回断言参数；SMS/EMAIL 下发验证码（可重发）；TOTP 无需调用。
+	// 免鉴权：凭登录返回的 mfa_operation_id 调用。
+	StartMFAChallenge(context.Context, *v1.StartMFAChallengeRequest) (*v1.StartMFAChallengeResponse, error)
+	// 验证登录 MFA 挑战（TOTP 码 / WebAuthn 断言 / 备份码）。通过则返回 LoginResponse（含真 access_token）。
 	// 免鉴权：登录流程在密码校验通过、待二次验证阶段调用。
 	VerifyMFAChallenge(context.Context, *v1.VerifyMFAChallengeRequest) (*v1.LoginResponse, error)
+	// 登录强制注册：MFA 策略要求启用而用户尚无因子时，凭登录返回的 mfa_operation_id 开始注册（免鉴权）
+	StartLoginEnrollment(context.Context, *v1.StartEnrollMethodRequest) (*v1.StartEnrollMethodResponse, error)
+	// 登录强制注册：确认注册（免鉴权）。成功后以同一 mfa_operation_id 调用 VerifyMFAChallenge 换取 token
+	ConfirmLoginEnrollment(context.Context, *v1.ConfirmEnrollMethodRequest) (*v1.ConfirmEnrollMethodResponse, error)
+	// 查询 MFA 策略（租户维度；平台管理员可指定租户）
+	GetMFAPolicy(context.Context, *v1.GetMFAPolicyRequest) (*v1.MFAPolicy, error)
+	// 更新 MFA 策略（不存在则创建）
+	UpdateMFAPolicy(context.Context, *v1.UpdateMFAPolicyRequest) (*emptypb.Empty, error)
+	// 发起通行密钥无密码登录（免鉴权）
+	StartPasskeyLogin(context.Context, *v1.StartPasskeyLoginRequest) (*v1.StartPasskeyLoginResponse, error)
+	// 完成通行密钥无密码登录，返回 LoginResponse（免鉴权）
+	FinishPasskeyLogin(context.Context, *v1.FinishPasskeyLoginRequest) (*v1.LoginResponse, error)
 	mustEmbedUnimplementedMfaServiceServer()
 }
 
@@ -190,9 +335,36 @@ func (UnimplementedMfaServiceServer) DisableMFA(context.Context, *v1.DisableMFAR
 func (UnimplementedMfaServiceServer) RevokeMFADevice(context.Context, *v1.RevokeMFADeviceRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method RevokeMFADevice not implemented")
 }
+func (UnimplementedMfaServiceServer) GenerateBackupCodes(context.Context, *v1.GenerateBackupCodesRequest) (*v1.GenerateBackupCodesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GenerateBackupCodes not implemented")
+}
+func (UnimplementedMfaServiceServer) ListBackupCodes(context.Context, *v1.ListBackupCodesRequest) (*v1.ListBackupCodesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListBackupCodes not implemented")
+}
+func (UnimplementedMfaServiceServer) StartMFAChallenge(context.Context, *v1.StartMFAChallengeRequest) (*v1.StartMFAChallengeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method StartMFAChallenge not implemented")
+}
 func (UnimplementedMfaServiceServer) VerifyMFAChallenge(context.Context, *v1.VerifyMFAChallengeRequest) (*v1.LoginResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method VerifyMFAChallenge not implemented")
 }
+func (UnimplementedMfaServiceServer) StartLoginEnrollment(context.Context, *v1.StartEnrollMethodRequest) (*v1.StartEnrollMethodResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method StartLoginEnrollment not implemented")
+}
+func (UnimplementedMfaServiceServer) ConfirmLoginEnrollment(context.Context, *v1.ConfirmEnrollMethodRequest) (*v1.ConfirmEnrollMethodResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ConfirmLoginEnrollment not implemented")
+}
+func (UnimplementedMfaServiceServer) GetMFAPolicy(context.Context, *v1.GetMFAPolicyRequest) (*v1.MFAPolicy, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetMFAPolicy not implemented")
+}
+func (UnimplementedMfaServiceServer) UpdateMFAPolicy(context.Context, *v1.UpdateMFAPolicyRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateMFAPolicy not implemented")
+}
+func (UnimplementedMfaServiceServer) StartPasskeyLogin(context.Context, *v1.StartPasskeyLoginRequest) (*v1.StartPasskeyLoginResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method StartPasskeyLogin not implemented")
+}
+func (UnimplementedMfaServiceServer) FinishPasskeyLogin(context.Context, *v1.FinishPasskeyLoginRequest) (*v1.LoginResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method FinishPasskeyLogin not implemented")
+}
 func (UnimplementedMfaServiceServer) mustEmbedUnimplementedMfaServiceServer() {}
 func (UnimplementedMfaServiceServer) testEmbeddedByValue()                    {}
 
@@ -322,6 +494,60 @@ func _MfaService_RevokeMFADevice_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MfaService_GenerateBackupCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.GenerateBackupCodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MfaServiceServer).GenerateBackupCodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MfaService_GenerateBackupCodes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MfaServiceServer).GenerateBackupCodes(ctx, req.(*v1.GenerateBackupCodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MfaService_ListBackupCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.ListBackupCodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MfaServiceServer).ListBackupCodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MfaService_ListBackupCodes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MfaServiceServer).ListBackupCodes(ctx, req.(*v1.ListBackupCodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MfaService_StartMFAChallenge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.StartMFAChallengeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MfaServiceServer).StartMFAChallenge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MfaService_StartMFAChallenge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MfaServiceServer).StartMFAChallenge(ctx, req.(*v1.StartMFAChallengeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MfaService_VerifyMFAChallenge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(v1.VerifyMFAChallengeRequest)
 	if err := dec(in); err != nil {
@@ -340,6 +566,114 @@ func _MfaService_VerifyMFAChallenge_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MfaService_StartLoginEnrollment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.StartEnrollMethodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MfaServiceServer).StartLoginEnrollment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MfaService_StartLoginEnrollment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MfaServiceServer).StartLoginEnrollment(ctx, req.(*v1.StartEnrollMethodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MfaService_ConfirmLoginEnrollment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.ConfirmEnrollMethodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MfaServiceServer).ConfirmLoginEnrollment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MfaService_ConfirmLoginEnrollment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MfaServiceServer).ConfirmLoginEnrollment(ctx, req.(*v1.ConfirmEnrollMethodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MfaService_GetMFAPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.GetMFAPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MfaServiceServer).GetMFAPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MfaService_GetMFAPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MfaServiceServer).GetMFAPolicy(ctx, req.(*v1.GetMFAPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MfaService_UpdateMFAPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.UpdateMFAPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MfaServiceServer).UpdateMFAPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MfaService_UpdateMFAPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MfaServiceServer).UpdateMFAPolicy(ctx, req.(*v1.UpdateMFAPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MfaService_StartPasskeyLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.StartPasskeyLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MfaServiceServer).StartPasskeyLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MfaService_StartPasskeyLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MfaServiceServer).StartPasskeyLogin(ctx, req.(*v1.StartPasskeyLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MfaService_FinishPasskeyLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.FinishPasskeyLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MfaServiceServer).FinishPasskeyLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MfaService_FinishPasskeyLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MfaServiceServer).FinishPasskeyLogin(ctx, req.(*v1.FinishPasskeyLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MfaService_ServiceDesc is the grpc.ServiceDesc for MfaService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -371,10 +705,46 @@ func _MfaService_VerifyMFAChallenge_Handler(srv interface{}, ctx context.Context
 			MethodName: "RevokeMFADevice",
 			Handler:    _MfaService_RevokeMFADevice_Handler,
 		},
+		{
+			MethodName: "GenerateBackupCodes",
+			Handler:    _MfaService_GenerateBackupCodes_Handler,
+		},
+		{
+			MethodName: "ListBackupCodes",
+			Handler:    _MfaService_ListBackupCodes_Handler,
+		},
+		{
+			MethodName: "StartMFAChallenge",
+			Handler:    _MfaService_StartMFAChallenge_Handler,
+		},
 		{
 			MethodName: "VerifyMFAChallenge",
 			Handler:    _MfaService_VerifyMFAChallenge_Handler,
 		},
+		{
+			MethodName: "StartLoginEnrollment",
+			Handler:    _MfaService_StartLoginEnrollment_Handler,
+		},
+		{
+			MethodName: "ConfirmLoginEnrollment",
+			Handler:    _MfaService_ConfirmLoginEnrollment_Handler,
+		},
+		{
+			MethodName: "GetMFAPolicy",
+			Handler:    _MfaService_GetMFAPolicy_Handler,
+		},
+		{
+			MethodName: "UpdateMFAPolicy",
+			Handler:    _MfaService_UpdateMFAPolicy_Handler,
+		},
+		{
+			MethodName: "StartPasskeyLogin",
+			Handler:    _MfaService_StartPasskeyLogin_Handler,
+		},
+		{
+			MethodName: "FinishPasskeyLogin",
+			Handler:    _MfaService_FinishPasskeyLogin_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "admin/service/v1/i_mfa.proto",
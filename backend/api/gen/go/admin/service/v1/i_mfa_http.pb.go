@@ -22,29 +22,59 @@
 const _ = http.SupportPackageIsVersion1
 
 const OperationMfaServiceConfirmEnrollMethod = "/admin.service.v1.MfaService/ConfirmEnrollMethod"
+const OperationMfaServiceConfirmLoginEnrollment = "/admin.service.v1.MfaService/ConfirmLoginEnrollment"
 const OperationMfaServiceDisableMFA = "/admin.service.v1.MfaService/DisableMFA"
+const OperationMfaServiceFinishPasskeyLogin = "/admin.service.v1.MfaService/FinishPasskeyLogin"
+const OperationMfaServiceGenerateBackupCodes = "/admin.service.v1.MfaService/GenerateBackupCodes"
+const OperationMfaServiceGetMFAPolicy = "/admin.service.v1.MfaService/GetMFAPolicy"
 const OperationMfaServiceGetMFAStatus = "/admin.service.v1.MfaService/GetMFAStatus"
+const OperationMfaServiceListBackupCodes = "/admin.service.v1.MfaService/ListBackupCodes"
 const OperationMfaServiceListEnrolledMethods = "/admin.service.v1.MfaService/ListEnrolledMethods"
 const OperationMfaServiceRevokeMFADevice = "/admin.service.v1.MfaService/RevokeMFADevice"
 const OperationMfaServiceStartEnrollMethod = "/admin.service.v1.MfaService/StartEnrollMethod"
+const OperationMfaServiceStartLoginEnrollment = "/admin.service.v1.MfaService/StartLoginEnrollment"
+const OperationMfaServiceStartMFAChallenge = "/admin.service.v1.MfaService/StartMFAChallenge"
+const OperationMfaServiceStartPasskeyLogin = "/admin.service.v1.MfaService/StartPasskeyLogin"
+const OperationMfaServiceUpdateMFAPolicy = "/admin.service.v1.MfaService/UpdateMFAPolicy"
 const OperationMfaServiceVerifyMFAChallenge = "/admin.service.v1.MfaService/VerifyMFAChallenge"
 
 type MfaServiceHTTPServer interface {
-	// ConfirmEnrollMethod 确认注册 MFA 方法（提交首码完成绑定）
+	// ConfirmEnrollMethod 确认注册 MFA 方法（TOTP 提交首码；WEBAUTHN 提交认证器注册响应；SMS/EMAIL 提交验证码）
 	ConfirmEnrollMethod(context.Context, *v1.ConfirmEnrollMethodRequest) (*v1.ConfirmEnrollMethodResponse, error)
+	// ConfirmLoginEnrollment 登录强制注册：确认注册（免鉴权）。成功后以同一 mfa_operation_id 调用 VerifyMFAChallenge 换取 token
+	ConfirmLoginEnrollment(context.Context, *v1.ConfirmEnrollMethodRequest) (*v1.ConfirmEnrollMethodResponse, error)
 	// DisableMFA 禁用/移除已注册 MFA 凭证。
 	// 注意：kratos http 生成器不支持 DELETE 请求体（handler 只 BindQuery），
 	// 而 TS 生成器默认把 message 序列化为 body——为两端一致改用 POST + body。
 	DisableMFA(context.Context, *v1.DisableMFARequest) (*emptypb.Empty, error)
+	// FinishPasskeyLogin 完成通行密钥无密码登录，返回 LoginResponse（免鉴权）
+	FinishPasskeyLogin(context.Context, *v1.FinishPasskeyLoginRequest) (*v1.LoginResponse, error)
+	// GenerateBackupCodes 生成一批一次性备份码（明文仅本次返回；重新生成作废旧码）
+	GenerateBackupCodes(context.Context, *v1.GenerateBackupCodesRequest) (*v1.GenerateBackupCodesResponse, error)
+	// GetMFAPolicy 查询 MFA 策略（租户维度；平台管理员可指定租户）
+	GetMFAPolicy(context.Context, *v1.GetMFAPolicyRequest) (*v1.MFAPolicy, error)
 	// GetMFAStatus 查询当前登录用户 MFA 总览
 	GetMFAStatus(context.Context, *v1.GetMFAStatusRequest) (*v1.GetMFAStatusResponse, error)
+	// ListBackupCodes 查询备份码剩余数量（不返回明文）
+	ListBackupCodes(context.Context, *v1.ListBackupCodesRequest) (*v1.ListBackupCodesResponse, error)
 	// ListEnrolledMethods 列出已注册的 MFA 凭证
 	ListEnrolledMethods(context.Context, *v1.ListEnrolledMethodsRequest) (*v1.ListEnrolledMethodsResponse, error)
-	// RevokeMFADevice 撤销指定 MFA 凭证（按 id）
+	// RevokeMFADevice 撤销指定 MFA 凭证（按 id）。
+	// ⚠️ 当前无前端调用方：DELETE 请求体在 Go 生成器（恒 BindQuery）与 TS 生成器
+	// （发 body）之间不一致，贸然对接会静默丢参——需要时应改 POST（参见 DisableMFA）。
 	RevokeMFADevice(context.Context, *v1.RevokeMFADeviceRequest) (*emptypb.Empty, error)
-	// StartEnrollMethod 开始注册 MFA 方法（返回 secret/QR，仅 TOTP 本轮实现）
+	// StartEnrollMethod 开始注册 MFA 方法（TOTP 返回 secret/QR；WEBAUTHN 返回注册仪式参数；SMS/EMAIL 下发验证码）
 	StartEnrollMethod(context.Context, *v1.StartEnrollMethodRequest) (*v1.StartEnrollMethodResponse, error)
-	// VerifyMFAChallenge 验证登录 MFA 挑战。通过则返回 LoginResponse（含真 access_token）。
+	// StartLoginEnrollment 登录强制注册：MFA 策略要求启用而用户尚无因子时，凭登录返回的 mfa_operation_id 开始注册（免鉴权）
+	StartLoginEnrollment(context.Context, *v1.StartEnrollMethodRequest) (*v1.StartEnrollMethodResponse, error)
+	// StartMFAChallenge 发起登录 MFA 挑战。WEBAUTHN 返回断言参数；SMS/EMAIL 下发验证码（可重发）；TOTP 无需调用。
+	// 免鉴权：凭登录返回的 mfa_operation_id 调用。
+	StartMFAChallenge(context.Context, *v1.StartMFAChallengeRequest) (*v1.StartMFAChallengeResponse, error)
+	// StartPasskeyLogin 发起通行密钥无密码登录（免鉴权）
+	StartPasskeyLogin(context.Context, *v1.StartPasskeyLoginRequest) (*v1.StartPasskeyLoginResponse, error)
+	// UpdateMFAPolicy 更新 MFA 策略（不存在则创建）
+	UpdateMFAPolicy(context.Context, *v1.UpdateMFAPolicyRequest) (*emptypb.Empty, error)
+	// VerifyMFAChallenge 验证登录 MFA 挑战（TOTP 码 / WebAuthn 断言 / 备份码）。通过则返回 LoginResponse（含真 access_token）。
 	// 免鉴权：登录流程在密码校验通过、待二次验证阶段调用。
 	VerifyMFAChallenge(context.Context, *v1.VerifyMFAChallengeRequest) (*v1.LoginResponse, error)
 }
@@ -57,7 +87,16 @@ func RegisterMfaServiceHTTPServer(s *http.Server, srv MfaServiceHTTPServer) {
 	r.POST("/admin/v1/mfa/enroll/confirm", _MfaService_ConfirmEnrollMethod0_HTTP_Handler(srv))
 	r.POST("/admin/v1/mfa/disable", _MfaService_DisableMFA0_HTTP_Handler(srv))
 	r.DELETE("/admin/v1/mfa/{credential_id}", _MfaService_RevokeMFADevice0_HTTP_Handler(srv))
+	r.POST("/admin/v1/mfa/backup-codes", _MfaService_GenerateBackupCodes0_HTTP_Handler(srv))
+	r.GET("/admin/v1/mfa/backup-codes", _MfaService_ListBackupCodes0_HTTP_Handler(srv))
+	r.POST("/admin/v1/mfa/challenge/start", _MfaService_StartMFAChallenge0_HTTP_Handler(srv))
 	r.POST("/admin/v1/mfa/verify", _MfaService_VerifyMFAChallenge0_HTTP_Handler(srv))
+	r.POST("/admin/v1/mfa/login/enroll/start", _MfaService_StartLoginEnrollment0_HTTP_Handler(srv))
+	r.POST("/admin/v1/mfa/login/enroll/confirm", _MfaService_ConfirmLoginEnrollment0_HTTP_Handler(srv))
+	r.GET("/admin/v1/mfa/policy", _MfaService_GetMFAPolicy0_HTTP_Handler(srv))
+	r.PUT("/admin/v1/mfa/policy", _MfaService_UpdateMFAPolicy0_HTTP_Handler(srv))
+	r.POST("/admin/v1/mfa/passkey/login/start", _MfaService_StartPasskeyLogin0_HTTP_Handler(srv))
+	r.POST("/admin/v1/mfa/passkey/login/finish", _MfaService_FinishPasskeyLogin0_HTTP_Handler(srv))
 }
 
 func _MfaService_GetMFAStatus0_HTTP_Handler(srv MfaServiceHTTPServer) func(ctx http.Context) error {
@@ -186,6 +225,69 @@ func _MfaService_RevokeMFADevice0_HTTP_Handler(srv MfaServiceHTTPServer) func(ct
 	}
 }
 
+func _MfaService_GenerateBackupCodes0_HTTP_Handler(srv MfaServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v1.GenerateBackupCodesRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMfaServiceGenerateBackupCodes)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GenerateBackupCodes(ctx, req.(*v1.GenerateBackupCodesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v1.GenerateBackupCodesResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _MfaService_ListBackupCodes0_HTTP_Handler(srv MfaServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v1.ListBackupCodesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMfaServiceListBackupCodes)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListBackupCodes(ctx, req.(*v1.ListBackupCodesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v1.ListBackupCodesResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _MfaService_StartMFAChallenge0_HTTP_Handler(srv MfaServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v1.StartMFAChallengeRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMfaServiceStartMFAChallenge)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.StartMFAChallenge(ctx, req.(*v1.StartMFAChallengeRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v1.StartMFAChallengeResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _MfaService_VerifyMFAChallenge0_HTTP_Handler(srv MfaServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in v1.VerifyMFAChallengeRequest
@@ -208,22 +310,172 @@ func _MfaService_VerifyMFAChallenge0_HTTP_Handler(srv MfaServiceHTTPServer) func
 	}
 }
 
+func _MfaService_StartLoginEnrollment0_HTTP_Handler(srv MfaServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v1.StartEnrollMethodRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMfaServiceStartLoginEnrollment)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.StartLoginEnrollment(ctx, req.(*v1.StartEnrollMethodRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v1.StartEnrollMethodResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _MfaService_ConfirmLoginEnrollment0_HTTP_Handler(srv MfaServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v1.ConfirmEnrollMethodRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMfaServiceConfirmLoginEnrollment)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ConfirmLoginEnrollment(ctx, req.(*v1.ConfirmEnrollMethodRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v1.ConfirmEnrollMethodResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _MfaService_GetMFAPolicy0_HTTP_Handler(srv MfaServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v1.GetMFAPolicyRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMfaServiceGetMFAPolicy)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetMFAPolicy(ctx, req.(*v1.GetMFAPolicyRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v1.MFAPolicy)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _MfaService_UpdateMFAPolicy0_HTTP_Handler(srv MfaServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v1.UpdateMFAPolicyRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMfaServiceUpdateMFAPolicy)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateMFAPolicy(ctx, req.(*v1.UpdateMFAPolicyRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _MfaService_StartPasskeyLogin0_HTTP_Handler(srv MfaServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v1.StartPasskeyLoginRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMfaServiceStartPasskeyLogin)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.StartPasskeyLogin(ctx, req.(*v1.StartPasskeyLoginRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v1.StartPasskeyLoginResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _MfaService_FinishPasskeyLogin0_HTTP_Handler(srv MfaServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v1.FinishPasskeyLoginRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMfaServiceFinishPasskeyLogin)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.FinishPasskeyLogin(ctx, req.(*v1.FinishPasskeyLoginRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v1.LoginResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 type MfaServiceHTTPClient interface {
-	// ConfirmEnrollMethod 确认注册 MFA 方法（提交首码完成绑定）
+	// ConfirmEnrollMethod 确认注册 MFA 方法（TOTP 提交首码；WEBAUTHN 提交认证器注册响应；SMS/EMAIL 提交验证码）
 	ConfirmEnrollMethod(ctx context.Context, req *v1.ConfirmEnrollMethodRequest, opts ...http.CallOption) (rsp *v1.ConfirmEnrollMethodResponse, err error)
+	// ConfirmLoginEnrollment 登录强制注册：确认注册（免鉴权）。成功后以同一 mfa_operation_id 调用 VerifyMFAChallenge 换取 token
+	ConfirmLoginEnrollment(ctx context.Context, req *v1.ConfirmEnrollMethodRequest, opts ...http.CallOption) (rsp *v1.ConfirmEnrollMethodResponse, err error)
 	// DisableMFA 禁用/移除已注册 MFA 凭证。
 	// 注意：kratos http 生成器不支持 DELETE 请求体（handler 只 BindQuery），
 	// 而 TS 生成器默认把 message 序列化为 body——为两端一致改用 POST + body。
 	DisableMFA(ctx context.Context, req *v1.DisableMFARequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	// FinishPasskeyLogin 完成通行密钥无密码登录，返回 LoginResponse（免鉴权）
+	FinishPasskeyLogin(ctx context.Context, req *v1.FinishPasskeyLoginRequest, opts ...http.CallOption) (rsp *v1.LoginResponse, err error)
+	// GenerateBackupCodes 生成一批一次性备份码（明文仅本次返回；重新生成作废旧码）
+	GenerateBackupCodes(ctx context.Context, req *v1.GenerateBackupCodesRequest, opts ...http.CallOption) (rsp *v1.GenerateBackupCodesResponse, err error)
+	// GetMFAPolicy 查询 MFA 策略（租户维度；平台管理员可指定租户）
+	GetMFAPolicy(ctx context.Context, req *v1.GetMFAPolicyRequest, opts ...http.CallOption) (rsp *v1.MFAPolicy, err error)
 	// GetMFAStatus 查询当前登录用户 MFA 总览
 	GetMFAStatus(ctx context.Context, req *v1.GetMFAStatusRequest, opts ...http.CallOption) (rsp *v1.GetMFAStatusResponse, err error)
+	// ListBackupCodes 查询备份码剩余数量（不返回明文）
+	ListBackupCodes(ctx context.Context, req *v1.ListBackupCodesRequest, opts ...http.CallOption) (rsp *v1.ListBackupCodesResponse, err error)
 	// ListEnrolledMethods 列出已注册的 MFA 凭证
 	ListEnrolledMethods(ctx context.Context, req *v1.ListEnrolledMethodsRequest, opts ...http.CallOption) (rsp *v1.ListEnrolledMethodsResponse, err error)
-	// RevokeMFADevice 撤销指定 MFA 凭证（按 id）
+	// RevokeMFADevice 撤销指定 MFA 凭证（按 id）。
+	// ⚠️ 当前无前端调用方：DELETE 请求体在 Go 生成器（恒 BindQuery）与 TS 生成器
+	// （发 body）之间不一致，贸然对接会静默丢参——需要时应改 POST（参见 DisableMFA）。
 	RevokeMFADevice(ctx context.Context, req *v1.RevokeMFADeviceRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
-	// StartEnrollMethod 开始注册 MFA 方法（返回 secret/QR，仅 TOTP 本轮实现）
+	// StartEnrollMethod 开始注册 MFA 方法（TOTP 返回 secret/QR；WEBAUTHN 返回注册仪式参数；SMS/EMAIL 下发验证码）
 	StartEnrollMethod(ctx context.Context, req *v1.StartEnrollMethodRequest, opts ...http.CallOption) (rsp *v1.StartEnrollMethodResponse, err error)
-	// VerifyMFAChallenge 验证登录 MFA 挑战。通过则返回 LoginResponse（含真 access_token）。
+	// StartLoginEnrollment 登录强制注册：MFA 策略要求启用而用户尚无因子时，凭登录返回的 mfa_operation_id 开始注册（免鉴权）
+	StartLoginEnrollment(ctx context.Context, req *v1.StartEnrollMethodRequest, opts ...http.CallOption) (rsp *v1.StartEnrollMethodResponse, err error)
+	// StartMFAChallenge 发起登录 MFA 挑战。WEBAUTHN 返回断言参数；SMS/EMAIL 下发验证码（可重发）；TOTP 无需调用。
+	// 免鉴权：凭登录返回的 mfa_operation_id 调用。
+	StartMFAChallenge(ctx context.Context, req *v1.StartMFAChallengeRequest, opts ...http.CallOption) (rsp *v1.StartMFAChallengeResponse, err error)
+	// StartPasskeyLogin 发起通行密钥无密码登录（免鉴权）
+	StartPasskeyLogin(ctx context.Context, req *v1.StartPasskeyLoginRequest, opts ...http.CallOption) (rsp *v1.StartPasskeyLoginResponse, err error)
+	// UpdateMFAPolicy 更新 MFA 策略（不存在则创建）
+	UpdateMFAPolicy(ctx context.Context, req *v1.UpdateMFAPolicyRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	// VerifyMFAChallenge 验证登录 MFA 挑战（TOTP 码 / WebAuthn 断言 / 备份码）。通过则返回 LoginResponse（含真 access_token）。
 	// 免鉴权：登录流程在密码校验通过、待二次验证阶段调用。
 	VerifyMFAChallenge(ctx context.Context, req *v1.VerifyMFAChallengeRequest, opts ...http.CallOption) (rsp *v1.LoginResponse, err error)
 }
@@ -236,7 +488,7 @@ func NewMfaServiceHTTPClient(client *http.Client) MfaServiceHTTPClient {
 	return &MfaServiceHTTPClientImpl{client}
 }
 
-// ConfirmEnrollMethod 确认注册 MFA 方法（提交首码完成绑定）
+// ConfirmEnrollMethod 确认注册 MFA 方法（TOTP 提交首码；WEBAUTHN 提交认证器注册响应；SMS/EMAIL 提交验证码）
 func (c *MfaServiceHTTPClientImpl) ConfirmEnrollMethod(ctx context.Context, in *v1.ConfirmEnrollMethodRequest, opts ...http.CallOption) (*v1.ConfirmEnrollMethodResponse, error) {
 	var out v1.ConfirmEnrollMethodResponse
 	pattern := "/admin/v1/mfa/enroll/confirm"
@@ -250,6 +502,20 @@ func (c *MfaServiceHTTPClientImpl) ConfirmEnrollMethod(ctx context.Context, in *
 	return &out, nil
 }
 
+// ConfirmLoginEnrollment 登录强制注册：确认注册（免鉴权）。成功后以同一 mfa_operation_id 调用 VerifyMFAChallenge 换取 token
+func (c *MfaServiceHTTPClientImpl) ConfirmLoginEnrollment(ctx context.Context, in *v1.ConfirmEnrollMethodRequest, opts ...http.CallOption) (*v1.ConfirmEnrollMethodResponse, error) {
+	var out v1.ConfirmEnrollMethodResponse
+	pattern := "/admin/v1/mfa/login/enroll/confirm"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationMfaServiceConfirmLoginEnrollment))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // DisableMFA 禁用/移除已注册 MFA 凭证。
 // 注意：kratos http 生成器不支持 DELETE 请求体（handler 只 BindQuery），
 // 而 TS 生成器默认把 message 序列化为 body——为两端一致改用 POST + body。
@@ -266,6 +532,48 @@ func (c *MfaServiceHTTPClientImpl) DisableMFA(ctx context.Context, in *v1.Disabl
 	return &out, nil
 }
 
+// FinishPasskeyLogin 完成通行密钥无密码登录，返回 LoginResponse（免鉴权）
+func (c *MfaServiceHTTPClientImpl) FinishPasskeyLogin(ctx context.Context, in *v1.FinishPasskeyLoginRequest, opts ...http.CallOption) (*v1.LoginResponse, error) {
+	var out v1.LoginResponse
+	pattern := "/admin/v1/mfa/passkey/login/finish"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationMfaServiceFinishPasskeyLogin))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GenerateBackupCodes 生成一批一次性备份码（明文仅本次返回；重新生成作废旧码）
+func (c *MfaServiceHTTPClientImpl) GenerateBackupCodes(ctx context.Context, in *v1.GenerateBackupCodesRequest, opts ...http.CallOption) (*v1.GenerateBackupCodesResponse, error) {
+	var out v1.GenerateBackupCodesResponse
+	pattern := "/admin/v1/mfa/backup-codes"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationMfaServiceGenerateBackupCodes))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetMFAPolicy 查询 MFA 策略（租户维度；平台管理员可指定租户）
+func (c *MfaServiceHTTPClientImpl) GetMFAPolicy(ctx context.Context, in *v1.GetMFAPolicyRequest, opts ...http.CallOption) (*v1.MFAPolicy, error) {
+	var out v1.MFAPolicy
+	pattern := "/admin/v1/mfa/policy"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationMfaServiceGetMFAPolicy))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // GetMFAStatus 查询当前登录用户 MFA 总览
 func (c *MfaServiceHTTPClientImpl) GetMFAStatus(ctx context.Context, in *v1.GetMFAStatusRequest, opts ...http.CallOption) (*v1.GetMFAStatusResponse, error) {
 	var out v1.GetMFAStatusResponse
@@ -280,6 +588,20 @@ func (c *MfaServiceHTTPClientImpl) GetMFAStatus(ctx context.Context, in *v1.GetM
 	return &out, nil
 }
 
+// ListBackupCodes 查询备份码剩余数量（不返回明文）
+func (c *MfaServiceHTTPClientImpl) ListBackupCodes(ctx context.Context, in *v1.ListBackupCodesRequest, opts ...http.CallOption) (*v1.ListBackupCodesResponse, error) {
+	var out v1.ListBackupCodesResponse
+	pattern := "/admin/v1/mfa/backup-codes"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationMfaServiceListBackupCodes))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // ListEnrolledMethods 列出已注册的 MFA 凭证
 func (c *MfaServiceHTTPClientImpl) ListEnrolledMethods(ctx context.Context, in *v1.ListEnrolledMethodsRequest, opts ...http.CallOption) (*v1.ListEnrolledMethodsResponse, error) {
 	var out v1.ListEnrolledMethodsResponse
@@ -294,7 +616,9 @@ func (c *MfaServiceHTTPClientImpl) ListEnrolledMethods(ctx context.Context, in *
 	return &out, nil
 }
 
-// RevokeMFADevice 撤销指定 MFA 凭证（按 id）
+// RevokeMFADevice 撤销指定 MFA 凭证（按 id）。
+// ⚠️ 当前无前端调用方：DELETE 请求体在 Go 生成器（恒 BindQuery）与 TS 生成器
+// （发 body）之间不一致，贸然对接会静默丢参——需要时应改 POST（参见 DisableMFA）。
 func (c *MfaServiceHTTPClientImpl) RevokeMFADevice(ctx context.Context, in *v1.RevokeMFADeviceRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
 	var out emptypb.Empty
 	pattern := "/admin/v1/mfa/{credential_id}"
@@ -308,7 +632,7 @@ func (c *MfaServiceHTTPClientImpl) RevokeMFADevice(ctx context.Context, in *v1.R
 	return &out, nil
 }
 
-// StartEnrollMethod 开始注册 MFA 方法（返回 secret/QR，仅 TOTP 本轮实现）
+// StartEnrollMethod 开始注册 MFA 方法（TOTP 返回 secret/QR；WEBAUTHN 返回注册仪式参数；SMS/EMAIL 下发验证码）
 func (c *MfaServiceHTTPClientImpl) StartEnrollMethod(ctx context.Context, in *v1.StartEnrollMethodRequest, opts ...http.CallOption) (*v1.StartEnrollMethodResponse, error) {
 	var out v1.StartEnrollMethodResponse
 	pattern := "/admin/v1/mfa/enroll/start"
@@ -322,7 +646,64 @@ func (c *MfaServiceHTTPClientImpl) StartEnrollMethod(ctx context.Context, in *v1
 	return &out, nil
 }
 
-// VerifyMFAChallenge 验证登录 MFA 挑战。通过则返回 LoginResponse（含真 access_token）。
+// StartLoginEnrollment 登录强制注册：MFA 策略要求启用而用户尚无因子时，凭登录返回的 mfa_operation_id 开始注册（免鉴权）
+func (c *MfaServiceHTTPClientImpl) StartLoginEnrollment(ctx context.Context, in *v1.StartEnrollMethodRequest, opts ...http.CallOption) (*v1.StartEnrollMethodResponse, error) {
+	var out v1.StartEnrollMethodResponse
+	pattern := "/admin/v1/mfa/login/enroll/start"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationMfaServiceStartLoginEnrollment))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// StartMFAChallenge 发起登录 MFA 挑战。WEBAUTHN 返回断言参数；SMS/EMAIL 下发验证码（可重发）；TOTP 无需调用。
+// 免鉴权：凭登录返回的 mfa_operation_id 调用。
+func (c *MfaServiceHTTPClientImpl) StartMFAChallenge(ctx context.Context, in *v1.StartMFAChallengeRequest, opts ...http.CallOption) (*v1.StartMFAChallengeResponse, error) {
+	var out v1.StartMFAChallengeResponse
+	pattern := "/admin/v1/mfa/challenge/start"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationMfaServiceStartMFAChallenge))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// StartPasskeyLogin 发起通行密钥无密码登录（免鉴权）
+func (c *MfaServiceHTTPClientImpl) StartPasskeyLogin(ctx context.Context, in *v1.StartPasskeyLoginRequest, opts ...http.CallOption) (*v1.StartPasskeyLoginResponse, error) {
+	var out v1.StartPasskeyLoginResponse
+	pattern := "/admin/v1/mfa/passkey/login/start"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationMfaServiceStartPasskeyLogin))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateMFAPolicy 更新 MFA 策略（不存在则创建）
+func (c *MfaServiceHTTPClientImpl) UpdateMFAPolicy(ctx context.Context, in *v1.UpdateMFAPolicyRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/admin/v1/mfa/policy"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationMfaServiceUpdateMFAPolicy))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// VerifyMFAChallenge 验证登录 MFA 挑战（TOTP 码 / WebAuthn 断言 / 备份码）。通过则返回 LoginResponse（含真 access_token）。
 // 免鉴权：登录流程在密码校验通过、待二次验证阶段调用。
 func (c *MfaServiceHTTPClientImpl) VerifyMFAChallenge(ctx context.Context, in *v1.VerifyMFAChallengeRequest, opts ...http.CallOption) (*v1.LoginResponse, error) {
 	var out v1.LoginResponse
@@ -43,14 +43,14 @@ type PlanServiceHTTPServer interface {
 
 func RegisterPlanServiceHTTPServer(s *http.Server, srv PlanServiceHTTPServer) {
 	r := s.Route("/")
-	r.GET("/admin/v1/plans", _PlanService_List16_HTTP_Handler(srv))
-	r.GET("/admin/v1/plans/{id}", _PlanService_Get16_HTTP_Handler(srv))
-	r.POST("/admin/v1/plans", _PlanService_Create11_HTTP_Handler(srv))
-	r.PUT("/admin/v1/plans/{id}", _PlanService_Update11_HTTP_Handler(srv))
-	r.DELETE("/admin/v1/plans", _PlanService_Delete11_HTTP_Handler(srv))
+	r.GET("/admin/v1/plans", _PlanService_List21_HTTP_Handler(srv))
+	r.GET("/admin/v1/plans/{id}", _PlanService_Get21_HTTP_Handler(srv))
+	r.POST("/admin/v1/plans", _PlanService_Create15_HTTP_Handler(srv))
+	r.PUT("/admin/v1/plans/{id}", _PlanService_Update16_HTTP_Handler(srv))
+	r.DELETE("/admin/v1/plans", _PlanService_Delete15_HTTP_Handler(srv))
 }
 
-func _PlanService_List16_HTTP_Handler(srv PlanServiceHTTPServer) func(ctx http.Context) error {
+func _PlanService_List21_HTTP_Handler(srv PlanServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in v1.PagingRequest
 		if err := ctx.BindQuery(&in); err != nil {
@@ -69,7 +69,7 @@ func _PlanService_List16_HTTP_Handler(srv PlanServiceHTTPServer) func(ctx http.C
 	}
 }
 
-func _PlanService_Get16_HTTP_Handler(srv PlanServiceHTTPServer) func(ctx http.Context) error {
+func _PlanService_Get21_HTTP_Handler(srv PlanServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in v11.GetPlanRequest
 		if err := ctx.BindQuery(&in); err != nil {
@@ -91,7 +91,7 @@ func _PlanService_Get16_HTTP_Handler(srv PlanServiceHTTPServer) func(ctx http.Co
 	}
 }
 
-func _PlanService_Create11_HTTP_Handler(srv PlanServiceHTTPServer) func(ctx http.Context) error {
+func _PlanService_Create15_HTTP_Handler(srv PlanServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in v11.CreatePlanRequest
 		if err := ctx.Bind(&in); err != nil {
@@ -113,7 +113,7 @@ func _PlanService_Create11_HTTP_Handler(srv PlanServiceHTTPServer) func(ctx http
 	}
 }
 
-func _PlanService_Update11_HTTP_Handler(srv PlanServiceHTTPServer) func(ctx http.Context) error {
+func _PlanService_Update16_HTTP_Handler(srv PlanServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in v11.UpdatePlanRequest
 		if err := ctx.Bind(&in); err != nil {
@@ -138,7 +138,7 @@ func _PlanService_Update11_HTTP_Handler(srv PlanServiceHTTPServer) func(ctx http
 	}
 }
 
-func _PlanService_Delete11_HTTP_Handler(srv PlanServiceHTTPServer) func(ctx http.Context) error {
+func _PlanService_Delete15_HTTP_Handler(srv PlanServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in v11.DeletePlanRequest
 		if err := ctx.BindQuery(&in); err != nil {
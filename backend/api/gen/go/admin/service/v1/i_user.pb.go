@@ -9,6 +9,7 @@
 import (
 	v1 "github.com/tx7do/go-crud/api/gen/go/pagination/v1"
 	_ "github.com/tx7do/go-wind-toolkit/protoc-gen-go-redact/redact/v1"
+	v12 "go-wind-admin/api/gen/go/authentication/service/v1"
 	v11 "go-wind-admin/api/gen/go/identity/service/v1"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
@@ -29,7 +30,7 @@
 
 const file_admin_service_v1_i_user_proto_rawDesc = "" +
 	"\n" +
-	"\x1dadmin/service/v1/i_user.proto\x12\x10admin.service.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x16redact/v1/redact.proto\x1a\x1epagination/v1/pagination.proto\x1a\x1eidentity/service/v1/user.proto2\xfc\x06\n" +
+	"\x1dadmin/service/v1/i_user.proto\x12\x10admin.service.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x16redact/v1/redact.proto\x1a\x1epagination/v1/pagination.proto\x1a\x1eidentity/service/v1/user.proto\x1a.authentication/service/v1/authentication.proto\x1a,authentication/service/v1/user_session.proto2\xdc\v\n" +
 	"\vUserService\x12a\n" +
 	"\x04List\x12\x19.pagination.PagingRequest\x1a%.identity.service.v1.ListUserResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/admin/v1/users\x12\x8a\x01\n" +
 	"\x03Get\x12#.identity.service.v1.GetUserRequest\x1a\x19.identity.service.v1.User\"C\x82\xd3\xe4\x93\x02=Z%\x12#/admin/v1/users/username/{username}\x12\x14/admin/v1/users/{id}\x12h\n" +
@@ -38,22 +39,31 @@
 	"\x06Delete\x12&.identity.service.v1.DeleteUserRequest\x1a\x16.google.protobuf.Empty\"Gض\x1a\x01\x82\xd3\xe4\x93\x02=Z%*#/admin/v1/users/username/{username}*\x14/admin/v1/users/{id}\x12\x81\x01\n" +
 	"\n" +
 	"UserExists\x12&.identity.service.v1.UserExistsRequest\x1a'.identity.service.v1.UserExistsResponse\"\"ض\x1a\x01\x82\xd3\xe4\x93\x02\x18\x12\x16/admin/v1/users:exists\x12\x8b\x01\n" +
-	"\x10EditUserPassword\x12,.identity.service.v1.EditUserPasswordRequest\x1a\x16.google.protobuf.Empty\"1ض\x1a\x01\x82\xd3\xe4\x93\x02':\x01*\"\"/admin/v1/users/{user_id}/passwordB\xb7\x01\n" +
+	"\x10EditUserPassword\x12,.identity.service.v1.EditUserPasswordRequest\x1a\x16.google.protobuf.Empty\"1ض\x1a\x01\x82\xd3\xe4\x93\x02':\x01*\"\"/admin/v1/users/{user_id}/password\x12\x8f\x01\n" +
+	"\x0eSendActivation\x120.authentication.service.v1.SendActivationRequest\x1a\x16.google.protobuf.Empty\"3ض\x1a\x01\x82\xd3\xe4\x93\x02):\x01*\"$/admin/v1/users/{user_id}/activation\x12\xa3\x01\n" +
+	"\fListSessions\x122.authentication.service.v1.ListUserSessionsRequest\x1a3.authentication.service.v1.ListUserSessionsResponse\"*\x82\xd3\xe4\x93\x02$\x12\"/admin/v1/users/{user_id}/sessions\x12\x95\x01\n" +
+	"\rRevokeSession\x123.authentication.service.v1.RevokeUserSessionRequest\x1a\x16.google.protobuf.Empty\"7\x82\xd3\xe4\x93\x021*//admin/v1/users/{user_id}/sessions/{session_id}\x12\x8d\x01\n" +
+	"\x11RevokeAllSessions\x124.authentication.service.v1.RevokeUserSessionsRequest\x1a\x16.google.protobuf.Empty\"*\x82\xd3\xe4\x93\x02$*\"/admin/v1/users/{user_id}/sessionsB\xb7\x01\n" +
 	"\x14com.admin.service.v1B\n" +
 	"IUserProtoP\x01Z1go-wind-admin/api/gen/go/admin/service/v1;adminpb\xa2\x02\x03ASX\xaa\x02\x10Admin.Service.V1\xca\x02\x10Admin\\Service\\V1\xe2\x02\x1cAdmin\\Service\\V1\\GPBMetadata\xea\x02\x12Admin::Service::V1b\x06proto3"
 
 var file_admin_service_v1_i_user_proto_goTypes = []any{
-	(*v1.PagingRequest)(nil),            // 0: pagination.PagingRequest
-	(*v11.GetUserRequest)(nil),          // 1: identity.service.v1.GetUserRequest
-	(*v11.CreateUserRequest)(nil),       // 2: identity.service.v1.CreateUserRequest
-	(*v11.UpdateUserRequest)(nil),       // 3: identity.service.v1.UpdateUserRequest
-	(*v11.DeleteUserRequest)(nil),       // 4: identity.service.v1.DeleteUserRequest
-	(*v11.UserExistsRequest)(nil),       // 5: identity.service.v1.UserExistsRequest
-	(*v11.EditUserPasswordRequest)(nil), // 6: identity.service.v1.EditUserPasswordRequest
-	(*v11.ListUserResponse)(nil),        // 7: identity.service.v1.ListUserResponse
-	(*v11.User)(nil),                    // 8: identity.service.v1.User
-	(*emptypb.Empty)(nil),               // 9: google.protobuf.Empty
-	(*v11.UserExistsResponse)(nil),      // 10: identity.service.v1.UserExistsResponse
+	(*v1.PagingRequest)(nil),              // 0: pagination.PagingRequest
+	(*v11.GetUserRequest)(nil),            // 1: identity.service.v1.GetUserRequest
+	(*v11.CreateUserRequest)(nil),         // 2: identity.service.v1.CreateUserRequest
+	(*v11.UpdateUserRequest)(nil),         // 3: identity.service.v1.UpdateUserRequest
+	(*v11.DeleteUserRequest)(nil),         // 4: identity.service.v1.DeleteUserRequest
+	(*v11.UserExistsRequest)(nil),         // 5: identity.service.v1.UserExistsRequest
+	(*v11.EditUserPasswordRequest)(nil),   // 6: identity.service.v1.EditUserPasswordRequest
+	(*v12.SendActivationRequest)(nil),     // 7: authentication.service.v1.SendActivationRequest
+	(*v12.ListUserSessionsRequest)(nil),   // 8: authentication.service.v1.ListUserSessionsRequest
+	(*v12.RevokeUserSessionRequest)(nil),  // 9: authentication.service.v1.RevokeUserSessionRequest
+	(*v12.RevokeUserSessionsRequest)(nil), // 10: authentication.service.v1.RevokeUserSessionsRequest
+	(*v11.ListUserResponse)(nil),          // 11: identity.service.v1.ListUserResponse
+	(*v11.User)(nil),                      // 12: identity.service.v1.User
+	(*emptypb.Empty)(nil),                 // 13: google.protobuf.Empty
+	(*v11.UserExistsResponse)(nil),        // 14: identity.service.v1.UserExistsResponse
+	(*v12.ListUserSessionsResponse)(nil),  // 15: authentication.service.v1.ListUserSessionsResponse
 }
 var file_admin_service_v1_i_user_proto_depIdxs = []int32{
 	0,  // 0: admin.service.v1.UserService.List:input_type -> pagination.PagingRequest
@@ -63,15 +73,23 @@
 	4,  // 4: admin.service.v1.UserService.Delete:input_type -> identity.service.v1.DeleteUserRequest
 	5,  // 5: admin.service.v1.UserService.UserExists:input_type -> identity.service.v1.UserExistsRequest
 	6,  // 6: admin.service.v1.UserService.EditUserPassword:input_type -> identity.service.v1.EditUserPasswordRequest
-	7,  // 7: admin.service.v1.UserService.List:output_type -> identity.service.v1.ListUserResponse
-	8,  // 8: admin.service.v1.UserService.Get:output_type -> identity.service.v1.User
-	9,  // 9: admin.service.v1.UserService.Create:output_type -> google.protobuf.Empty
-	9,  // 10: admin.service.v1.UserService.Update:output_type -> google.protobuf.Empty
-	9,  // 11: admin.service.v1.UserService.Delete:output_type -> google.protobuf.Empty
-	10, // 12: admin.service.v1.UserService.UserExists:output_type -> identity.service.v1.UserExistsResponse
-	9,  // 13: admin.service.v1.UserService.EditUserPassword:output_type -> google.protobuf.Empty
-	7,  // [7:14] is the sub-list for method output_type
-	0,  // [0:7] is the sub-list for method input_type
+	7,  // 7: admin.service.v1.UserService.SendActivation:input_type -> authentication.service.v1.SendActivationRequest
+	8,  // 8: admin.service.v1.UserService.ListSessions:input_type -> authentication.service.v1.ListUserSessionsRequest
+	9,  // 9: admin.service.v1.UserService.RevokeSession:input_type -> authentication.service.v1.RevokeUserSessionRequest
+	10, // 10: admin.service.v1.UserService.RevokeAllSessions:input_type -> authentication.service.v1.RevokeUserSessionsRequest
+	11, // 11: admin.service.v1.UserService.List:output_type -> identity.service.v1.ListUserResponse
+	12, // 12: admin.service.v1.UserService.Get:output_type -> identity.service.v1.User
+	13, // 13: admin.service.v1.UserService.Create:output_type -> google.protobuf.Empty
+	13, // 14: admin.service.v1.UserService.Update:output_type -> google.protobuf.Empty
+	13, // 15: admin.service.v1.UserService.Delete:output_type -> google.protobuf.Empty
+	14, // 16: admin.service.v1.UserService.UserExists:output_type -> identity.service.v1.UserExistsResponse
+	13, // 17: admin.service.v1.UserService.EditUserPassword:output_type -> google.protobuf.Empty
+	13, // 18: admin.service.v1.UserService.SendActivation:output_type -> google.protobuf.Empty
+	15, // 19: admin.service.v1.UserService.ListSessions:output_type -> authentication.service.v1.ListUserSessionsResponse
+	13, // 20: admin.service.v1.UserService.RevokeSession:output_type -> google.protobuf.Empty
+	13, // 21: admin.service.v1.UserService.RevokeAllSessions:output_type -> google.protobuf.Empty
+	11, // [11:22] is the sub-list for method output_type
+	0,  // [0:11] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
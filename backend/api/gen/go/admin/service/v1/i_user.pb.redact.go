@@ -7,6 +7,7 @@
 	context "context"
 	pagination "github.com/tx7do/go-crud/api/gen/go/pagination/v1"
 	redact "github.com/tx7do/go-wind-toolkit/protoc-gen-go-redact/redact/v1"
+	authenticationpb "go-wind-admin/api/gen/go/authentication/service/v1"
 	identitypb "go-wind-admin/api/gen/go/identity/service/v1"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -25,6 +26,8 @@
 	_ redact.FieldRules
 	_ pagination.Sorting
 	_ identitypb.User
+	_ authenticationpb.LoginRequest
+	_ authenticationpb.UserSession
 )
 
 // RegisterRedactedUserServiceServer wraps the UserServiceServer with the redacted server and registers the service in GRPC
@@ -101,3 +104,43 @@ func (s *redactedUserServiceServer) EditUserPassword(ctx context.Context, in *id
 	// Redaction skipped
 	return s.srv.EditUserPassword(ctx, in)
 }
+
+// SendActivation is the redacted wrapper for the actual UserServiceServer.SendActivation method
+// Unary RPC
+func (s *redactedUserServiceServer) SendActivation(ctx context.Context, in *authenticationpb.SendActivationRequest) (*emptypb.Empty, error) {
+	// Redaction skipped
+	return s.srv.SendActivation(ctx, in)
+}
+
+// ListSessions is the redacted wrapper for the actual UserServiceServer.ListSessions method
+// Unary RPC
+func (s *redactedUserServiceServer) ListSessions(ctx context.Context, in *authenticationpb.ListUserSessionsRequest) (*authenticationpb.ListUserSessionsResponse, error) {
+	res, err := s.srv.ListSessions(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// RevokeSession is the redacted wrapper for the actual UserServiceServer.RevokeSession method
+// Unary RPC
+func (s *redactedUserServiceServer) RevokeSession(ctx context.Context, in *authenticationpb.RevokeUserSessionRequest) (*emptypb.Empty, error) {
+	res, err := s.srv.RevokeSession(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// RevokeAllSessions is the redacted wrapper for the actual UserServiceServer.RevokeAllSessions method
+// Unary RPC
+func (s *redactedUserServiceServer) RevokeAllSessions(ctx context.Context, in *authenticationpb.RevokeUserSessionsRequest) (*emptypb.Empty, error) {
+	res, err := s.srv.RevokeAllSessions(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
@@ -9,6 +9,7 @@
 import (
 	context "context"
 	v1 "github.com/tx7do/go-crud/api/gen/go/pagination/v1"
+	v12 "go-wind-admin/api/gen/go/authentication/service/v1"
 	v11 "go-wind-admin/api/gen/go/identity/service/v1"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -22,13 +23,17 @@
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	UserService_List_FullMethodName             = "/admin.service.v1.UserService/List"
-	UserService_Get_FullMethodName              = "/admin.service.v1.UserService/Get"
-	UserService_Create_FullMethodName           = "/admin.service.v1.UserService/Create"
-	UserService_Update_FullMethodName           = "/admin.service.v1.UserService/Update"
-	UserService_Delete_FullMethodName           = "/admin.service.v1.UserService/Delete"
-	UserService_UserExists_FullMethodName       = "/admin.service.v1.UserService/UserExists"
-	UserService_EditUserPassword_FullMethodName = "/admin.service.v1.UserService/EditUserPassword"
+	UserService_List_FullMethodName              = "/admin.service.v1.UserService/List"
+	UserService_Get_FullMethodName               = "/admin.service.v1.UserService/Get"
+	UserService_Create_FullMethodName            = "/admin.service.v1.UserService/Create"
+	UserService_Update_FullMethodName            = "/admin.service.v1.UserService/Update"
+	UserService_Delete_FullMethodName            = "/admin.service.v1.UserService/Delete"
+	UserService_UserExists_FullMethodName        = "/admin.service.v1.UserService/UserExists"
+	UserService_EditUserPassword_FullMethodName  = "/admin.service.v1.UserService/EditUserPassword"
+	UserService_SendActivation_FullMethodName    = "/admin.service.v1.UserService/SendActivation"
+	UserService_ListSessions_FullMethodName      = "/admin.service.v1.UserService/ListSessions"
+	UserService_RevokeSession_FullMethodName     = "/admin.service.v1.UserService/RevokeSession"
+	UserService_RevokeAllSessions_FullMethodName = "/admin.service.v1.UserService/RevokeAllSessions"
 )
 
 // UserServiceClient is the client API for UserService service.
@@ -51,6 +56,14 @@ type UserServiceClient interface {
 	UserExists(ctx context.Context, in *v11.UserExistsRequest, opts ...grpc.CallOption) (*v11.UserExistsResponse, error)
 	// 修改用户密码
 	EditUserPassword(ctx context.Context, in *v11.EditUserPasswordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 发送（重发）账号激活邮件/短信，仅适用于待激活的用户
+	SendActivation(ctx context.Context, in *v12.SendActivationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 查询用户的登录会话（设备）列表
+	ListSessions(ctx context.Context, in *v12.ListUserSessionsRequest, opts ...grpc.CallOption) (*v12.ListUserSessionsResponse, error)
+	// 强制下线用户的指定会话
+	RevokeSession(ctx context.Context, in *v12.RevokeUserSessionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 强制下线用户的全部会话
+	RevokeAllSessions(ctx context.Context, in *v12.RevokeUserSessionsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type userServiceClient struct {
@@ -131,6 +144,46 @@ func (c *userServiceClient) EditUserPassword(ctx context.Context, in *v11.EditUs
 	return out, nil
 }
 
+func (c *userServiceClient) SendActivation(ctx context.Context, in *v12.SendActivationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserService_SendActivation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListSessions(ctx context.Context, in *v12.ListUserSessionsRequest, opts ...grpc.CallOption) (*v12.ListUserSessionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v12.ListUserSessionsResponse)
+	err := c.cc.Invoke(ctx, UserService_ListSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RevokeSession(ctx context.Context, in *v12.RevokeUserSessionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserService_RevokeSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RevokeAllSessions(ctx context.Context, in *v12.RevokeUserSessionsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserService_RevokeAllSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 // All implementations must embed UnimplementedUserServiceServer
 // for forward compatibility.
@@ -151,6 +204,14 @@ type UserServiceServer interface {
 	UserExists(context.Context, *v11.UserExistsRequest) (*v11.UserExistsResponse, error)
 	// 修改用户密码
 	EditUserPassword(context.Context, *v11.EditUserPasswordRequest) (*emptypb.Empty, error)
+	// 发送（重发）账号激活邮件/短信，仅适用于待激活的用户
+	SendActivation(context.Context, *v12.SendActivationRequest) (*emptypb.Empty, error)
+	// 查询用户的登录会话（设备）列表
+	ListSessions(context.Context, *v12.ListUserSessionsRequest) (*v12.ListUserSessionsResponse, error)
+	// 强制下线用户的指定会话
+	RevokeSession(context.Context, *v12.RevokeUserSessionRequest) (*emptypb.Empty, error)
+	// 强制下线用户的全部会话
+	RevokeAllSessions(context.Context, *v12.RevokeUserSessionsRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedUserServiceServer()
 }
 
@@ -182,6 +243,18 @@ func (UnimplementedUserServiceServer) UserExists(context.Context, *v11.UserExist
 func (UnimplementedUserServiceServer) EditUserPassword(context.Context, *v11.EditUserPasswordRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method EditUserPassword not implemented")
 }
+func (UnimplementedUserServiceServer) SendActivation(context.Context, *v12.SendActivationRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method SendActivation not implemented")
+}
+func (UnimplementedUserServiceServer) ListSessions(context.Context, *v12.ListUserSessionsRequest) (*v12.ListUserSessionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListSessions not implemented")
+}
+func (UnimplementedUserServiceServer) RevokeSession(context.Context, *v12.RevokeUserSessionRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeSession not implemented")
+}
+func (UnimplementedUserServiceServer) RevokeAllSessions(context.Context, *v12.RevokeUserSessionsRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeAllSessions not implemented")
+}
 func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
 func (UnimplementedUserServiceServer) testEmbeddedByValue()                     {}
 
@@ -329,6 +402,78 @@ func _UserService_EditUserPassword_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_SendActivation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v12.SendActivationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SendActivation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SendActivation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SendActivation(ctx, req.(*v12.SendActivationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v12.ListUserSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListSessions(ctx, req.(*v12.ListUserSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RevokeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v12.RevokeUserSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RevokeSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RevokeSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RevokeSession(ctx, req.(*v12.RevokeUserSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RevokeAllSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v12.RevokeUserSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RevokeAllSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RevokeAllSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RevokeAllSessions(ctx, req.(*v12.RevokeUserSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -364,6 +509,22 @@ func _UserService_EditUserPassword_Handler(srv interface{}, ctx context.Context,
 			MethodName: "EditUserPassword",
 			Handler:    _UserService_EditUserPassword_Handler,
 		},
+		{
+			MethodName: "SendActivation",
+			Handler:    _UserService_SendActivation_Handler,
+		},
+		{
+			MethodName: "ListSessions",
+			Handler:    _UserService_ListSessions_Handler,
+		},
+		{
+			MethodName: "RevokeSession",
+			Handler:    _UserService_RevokeSession_Handler,
+		},
+		{
+			MethodName: "RevokeAllSessions",
+			Handler:    _UserService_RevokeAllSessions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "admin/service/v1/i_user.proto",
@@ -8,6 +8,7 @@
 
 import (
 	context "context"
+	v11 "go-wind-admin/api/gen/go/authentication/service/v1"
 	v1 "go-wind-admin/api/gen/go/identity/service/v1"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -28,6 +29,8 @@
 	UserProfileService_DeleteAvatar_FullMethodName   = "/admin.service.v1.UserProfileService/DeleteAvatar"
 	UserProfileService_BindContact_FullMethodName    = "/admin.service.v1.UserProfileService/BindContact"
 	UserProfileService_VerifyContact_FullMethodName  = "/admin.service.v1.UserProfileService/VerifyContact"
+	UserProfileService_ListMySessions_FullMethodName = "/admin.service.v1.UserProfileService/ListMySessions"
+	UserProfileService_RevokeSession_FullMethodName  = "/admin.service.v1.UserProfileService/RevokeSession"
 )
 
 // UserProfileServiceClient is the client API for UserProfileService service.
@@ -50,6 +53,10 @@ type UserProfileServiceClient interface {
 	BindContact(ctx context.Context, in *v1.BindContactRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// 验证手机号码/邮箱
 	VerifyContact(ctx context.Context, in *v1.VerifyContactRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 我的登录会话（设备）列表
+	ListMySessions(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*v11.ListUserSessionsResponse, error)
+	// 注销我的指定会话（设备），可用于注销当前会话以外的设备
+	RevokeSession(ctx context.Context, in *v11.RevokeUserSessionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type userProfileServiceClient struct {
@@ -130,6 +137,26 @@ func (c *userProfileServiceClient) VerifyContact(ctx context.Context, in *v1.Ver
 	return out, nil
 }
 
+func (c *userProfileServiceClient) ListMySessions(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*v11.ListUserSessionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v11.ListUserSessionsResponse)
+	err := c.cc.Invoke(ctx, UserProfileService_ListMySessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userProfileServiceClient) RevokeSession(ctx context.Context, in *v11.RevokeUserSessionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserProfileService_RevokeSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserProfileServiceServer is the server API for UserProfileService service.
 // All implementations must embed UnimplementedUserProfileServiceServer
 // for forward compatibility.
@@ -150,6 +177,10 @@ type UserProfileServiceServer interface {
 	BindContact(context.Context, *v1.BindContactRequest) (*emptypb.Empty, error)
 	// 验证手机号码/邮箱
 	VerifyContact(context.Context, *v1.VerifyContactRequest) (*emptypb.Empty, error)
+	// 我的登录会话（设备）列表
+	ListMySessions(context.Context, *emptypb.Empty) (*v11.ListUserSessionsResponse, error)
+	// 注销我的指定会话（设备），可用于注销当前会话以外的设备
+	RevokeSession(context.Context, *v11.RevokeUserSessionRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedUserProfileServiceServer()
 }
 
@@ -181,6 +212,12 @@ func (UnimplementedUserProfileServiceServer) BindContact(context.Context, *v1.Bi
 func (UnimplementedUserProfileServiceServer) VerifyContact(context.Context, *v1.VerifyContactRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method VerifyContact not implemented")
 }
+func (UnimplementedUserProfileServiceServer) ListMySessions(context.Context, *emptypb.Empty) (*v11.ListUserSessionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListMySessions not implemented")
+}
+func (UnimplementedUserProfileServiceServer) RevokeSession(context.Context, *v11.RevokeUserSessionRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeSession not implemented")
+}
 func (UnimplementedUserProfileServiceServer) mustEmbedUnimplementedUserProfileServiceServer() {}
 func (UnimplementedUserProfileServiceServer) testEmbeddedByValue()                            {}
 
@@ -328,6 +365,42 @@ func _UserProfileService_VerifyContact_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserProfileService_ListMySessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserProfileServiceServer).ListMySessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserProfileService_ListMySessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserProfileServiceServer).ListMySessions(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserProfileService_RevokeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v11.RevokeUserSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserProfileServiceServer).RevokeSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserProfileService_RevokeSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserProfileServiceServer).RevokeSession(ctx, req.(*v11.RevokeUserSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserProfileService_ServiceDesc is the grpc.ServiceDesc for UserProfileService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -363,6 +436,14 @@ func _UserProfileService_VerifyContact_Handler(srv interface{}, ctx context.Cont
 			MethodName: "VerifyContact",
 			Handler:    _UserProfileService_VerifyContact_Handler,
 		},
+		{
+			MethodName: "ListMySessions",
+			Handler:    _UserProfileService_ListMySessions_Handler,
+		},
+		{
+			MethodName: "RevokeSession",
+			Handler:    _UserProfileService_RevokeSession_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "admin/service/v1/i_user_profile.proto",
@@ -10,6 +10,7 @@
 	context "context"
 	http "github.com/go-kratos/kratos/v2/transport/http"
 	binding "github.com/go-kratos/kratos/v2/transport/http/binding"
+	v11 "go-wind-admin/api/gen/go/authentication/service/v1"
 	v1 "go-wind-admin/api/gen/go/identity/service/v1"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
@@ -25,6 +26,8 @@
 const OperationUserProfileServiceChangePassword = "/admin.service.v1.UserProfileService/ChangePassword"
 const OperationUserProfileServiceDeleteAvatar = "/admin.service.v1.UserProfileService/DeleteAvatar"
 const OperationUserProfileServiceGetUser = "/admin.service.v1.UserProfileService/GetUser"
+const OperationUserProfileServiceListMySessions = "/admin.service.v1.UserProfileService/ListMySessions"
+const OperationUserProfileServiceRevokeSession = "/admin.service.v1.UserProfileService/RevokeSession"
 const OperationUserProfileServiceUpdateUser = "/admin.service.v1.UserProfileService/UpdateUser"
 const OperationUserProfileServiceUploadAvatar = "/admin.service.v1.UserProfileService/UploadAvatar"
 const OperationUserProfileServiceVerifyContact = "/admin.service.v1.UserProfileService/VerifyContact"
@@ -38,6 +41,10 @@ type UserProfileServiceHTTPServer interface {
 	DeleteAvatar(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
 	// GetUser 获取用户资料
 	GetUser(context.Context, *emptypb.Empty) (*v1.User, error)
+	// ListMySessions 我的登录会话（设备）列表
+	ListMySessions(context.Context, *emptypb.Empty) (*v11.ListUserSessionsResponse, error)
+	// RevokeSession 注销我的指定会话（设备），可用于注销当前会话以外的设备
+	RevokeSession(context.Context, *v11.RevokeUserSessionRequest) (*emptypb.Empty, error)
 	// UpdateUser 更新用户资料
 	UpdateUser(context.Context, *v1.UpdateUserRequest) (*emptypb.Empty, error)
 	// UploadAvatar 上传头像
@@ -55,6 +62,8 @@ func RegisterUserProfileServiceHTTPServer(s *http.Server, srv UserProfileService
 	r.DELETE("/admin/v1/me/avatar", _UserProfileService_DeleteAvatar0_HTTP_Handler(srv))
 	r.POST("/admin/v1/me/contact", _UserProfileService_BindContact0_HTTP_Handler(srv))
 	r.POST("/admin/v1/me/contact/verify", _UserProfileService_VerifyContact0_HTTP_Handler(srv))
+	r.GET("/admin/v1/me/sessions", _UserProfileService_ListMySessions0_HTTP_Handler(srv))
+	r.DELETE("/admin/v1/me/sessions/{session_id}", _UserProfileService_RevokeSession1_HTTP_Handler(srv))
 }
 
 func _UserProfileService_GetUser0_HTTP_Handler(srv UserProfileServiceHTTPServer) func(ctx http.Context) error {
@@ -205,6 +214,47 @@ func _UserProfileService_VerifyContact0_HTTP_Handler(srv UserProfileServiceHTTPS
 	}
 }
 
+func _UserProfileService_ListMySessions0_HTTP_Handler(srv UserProfileServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in emptypb.Empty
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserProfileServiceListMySessions)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListMySessions(ctx, req.(*emptypb.Empty))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v11.ListUserSessionsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserProfileService_RevokeSession1_HTTP_Handler(srv UserProfileServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v11.RevokeUserSessionRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserProfileServiceRevokeSession)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RevokeSession(ctx, req.(*v11.RevokeUserSessionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
 type UserProfileServiceHTTPClient interface {
 	// BindContact 绑定手机号码/邮箱
 	BindContact(ctx context.Context, req *v1.BindContactRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
@@ -214,6 +264,10 @@ type UserProfileServiceHTTPClient interface {
 	DeleteAvatar(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 	// GetUser 获取用户资料
 	GetUser(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *v1.User, err error)
+	// ListMySessions 我的登录会话（设备）列表
+	ListMySessions(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *v11.ListUserSessionsResponse, err error)
+	// RevokeSession 注销我的指定会话（设备），可用于注销当前会话以外的设备
+	RevokeSession(ctx context.Context, req *v11.RevokeUserSessionRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 	// UpdateUser 更新用户资料
 	UpdateUser(ctx context.Context, req *v1.UpdateUserRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 	// UploadAvatar 上传头像
@@ -286,6 +340,34 @@ func (c *UserProfileServiceHTTPClientImpl) GetUser(ctx context.Context, in *empt
 	return &out, nil
 }
 
+// ListMySessions 我的登录会话（设备）列表
+func (c *UserProfileServiceHTTPClientImpl) ListMySessions(ctx context.Context, in *emptypb.Empty, opts ...http.CallOption) (*v11.ListUserSessionsResponse, error) {
+	var out v11.ListUserSessionsResponse
+	pattern := "/admin/v1/me/sessions"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserProfileServiceListMySessions))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RevokeSession 注销我的指定会话（设备），可用于注销当前会话以外的设备
+func (c *UserProfileServiceHTTPClientImpl) RevokeSession(ctx context.Context, in *v11.RevokeUserSessionRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/admin/v1/me/sessions/{session_id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserProfileServiceRevokeSession))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // UpdateUser 更新用户资料
 func (c *UserProfileServiceHTTPClientImpl) UpdateUser(ctx context.Context, in *v1.UpdateUserRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
 	var out emptypb.Empty
@@ -29,38 +29,40 @@
 
 // 接口审计日志
 type ApiAuditLog struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Id             *uint32                `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id,omitempty"`                                               // 接口审计日志ID
-	TenantId       *uint32                `protobuf:"varint,2,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`                   // 租户ID
-	TenantName     *string                `protobuf:"bytes,3,opt,name=tenant_name,json=tenantName,proto3,oneof" json:"tenant_name,omitempty"`              // 租户名称
-	UserId         *uint32                `protobuf:"varint,4,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`                         // 用户ID
-	Username       *string                `protobuf:"bytes,5,opt,name=username,proto3,oneof" json:"username,omitempty"`                                    // 账号名
-	IpAddress      *string                `protobuf:"bytes,10,opt,name=ip_address,json=ipAddress,proto3,oneof" json:"ip_address,omitempty"`                // IP地址
-	GeoLocation    *GeoLocation           `protobuf:"bytes,11,opt,name=geo_location,json=geoLocation,proto3,oneof" json:"geo_location,omitempty"`          // 地理位置(来自IP库)
-	DeviceInfo     *DeviceInfo            `protobuf:"bytes,12,opt,name=device_info,json=deviceInfo,proto3,oneof" json:"device_info,omitempty"`             // 设备信息
-	Referer        *string                `protobuf:"bytes,13,opt,name=referer,proto3,oneof" json:"referer,omitempty"`                                     // 请求来源URL
-	AppVersion     *string                `protobuf:"bytes,14,opt,name=app_version,json=appVersion,proto3,oneof" json:"app_version,omitempty"`             // 客户端版本号
-	HttpMethod     *string                `protobuf:"bytes,20,opt,name=http_method,json=httpMethod,proto3,oneof" json:"http_method,omitempty"`             // HTTP请求方法
-	Path           *string                `protobuf:"bytes,21,opt,name=path,proto3,oneof" json:"path,omitempty"`                                           // 请求路径
-	RequestUri     *string                `protobuf:"bytes,22,opt,name=request_uri,json=requestUri,proto3,oneof" json:"request_uri,omitempty"`             // 完整请求URI
-	ApiModule      *string                `protobuf:"bytes,23,opt,name=api_module,json=apiModule,proto3,oneof" json:"api_module,omitempty"`                // API所属业务模块
-	ApiOperation   *string                `protobuf:"bytes,24,opt,name=api_operation,json=apiOperation,proto3,oneof" json:"api_operation,omitempty"`       // API业务操作
-	ApiDescription *string                `protobuf:"bytes,25,opt,name=api_description,json=apiDescription,proto3,oneof" json:"api_description,omitempty"` // API功能描述
-	RequestId      *string                `protobuf:"bytes,26,opt,name=request_id,json=requestId,proto3,oneof" json:"request_id,omitempty"`                // 请求ID
-	TraceId        *string                `protobuf:"bytes,27,opt,name=trace_id,json=traceId,proto3,oneof" json:"trace_id,omitempty"`                      // 全局链路追踪ID
-	SpanId         *string                `protobuf:"bytes,28,opt,name=span_id,json=spanId,proto3,oneof" json:"span_id,omitempty"`                         // 当前跨度ID
-	LatencyMs      *uint32                `protobuf:"varint,29,opt,name=latency_ms,json=latencyMs,proto3,oneof" json:"latency_ms,omitempty"`               // API耗时
-	Success        *bool                  `protobuf:"varint,30,opt,name=success,proto3,oneof" json:"success,omitempty"`                                    // 操作结果
-	StatusCode     *uint32                `protobuf:"varint,31,opt,name=status_code,json=statusCode,proto3,oneof" json:"status_code,omitempty"`            // HTTP状态码
-	Reason         *string                `protobuf:"bytes,32,opt,name=reason,proto3,oneof" json:"reason,omitempty"`                                       // 操作失败原因
-	RequestHeader  *string                `protobuf:"bytes,33,opt,name=request_header,json=requestHeader,proto3,oneof" json:"request_header,omitempty"`    // 请求头
-	RequestBody    *string                `protobuf:"bytes,34,opt,name=request_body,json=requestBody,proto3,oneof" json:"request_body,omitempty"`          // 请求体
-	Response       *string                `protobuf:"bytes,35,opt,name=response,proto3,oneof" json:"response,omitempty"`                                   // 响应信息
-	LogHash        *string                `protobuf:"bytes,40,opt,name=log_hash,json=logHash,proto3,oneof" json:"log_hash,omitempty"`                      // 日志哈希
-	Signature      []byte                 `protobuf:"bytes,41,opt,name=signature,proto3,oneof" json:"signature,omitempty"`                                 // 日志数字签名
-	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,50,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at,omitempty"`                // 日志创建时间
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               *uint32                `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id,omitempty"`                                                    // 接口审计日志ID
+	TenantId         *uint32                `protobuf:"varint,2,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`                        // 租户ID
+	TenantName       *string                `protobuf:"bytes,3,opt,name=tenant_name,json=tenantName,proto3,oneof" json:"tenant_name,omitempty"`                   // 租户名称
+	UserId           *uint32                `protobuf:"varint,4,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`                              // 用户ID
+	Username         *string                `protobuf:"bytes,5,opt,name=username,proto3,oneof" json:"username,omitempty"`                                         // 账号名
+	ImpersonatorId   *uint32                `protobuf:"varint,6,opt,name=impersonator_id,json=impersonatorId,proto3,oneof" json:"impersonator_id,omitempty"`      // 代登录操作人用户ID，非代登录请求为空
+	ImpersonatorName *string                `protobuf:"bytes,7,opt,name=impersonator_name,json=impersonatorName,proto3,oneof" json:"impersonator_name,omitempty"` // 代登录操作人账号名
+	IpAddress        *string                `protobuf:"bytes,10,opt,name=ip_address,json=ipAddress,proto3,oneof" json:"ip_address,omitempty"`                     // IP地址
+	GeoLocation      *GeoLocation           `protobuf:"bytes,11,opt,name=geo_location,json=geoLocation,proto3,oneof" json:"geo_location,omitempty"`               // 地理位置(来自IP库)
+	DeviceInfo       *DeviceInfo            `protobuf:"bytes,12,opt,name=device_info,json=deviceInfo,proto3,oneof" json:"device_info,omitempty"`                  // 设备信息
+	Referer          *string                `protobuf:"bytes,13,opt,name=referer,proto3,oneof" json:"referer,omitempty"`                                          // 请求来源URL
+	AppVersion       *string                `protobuf:"bytes,14,opt,name=app_version,json=appVersion,proto3,oneof" json:"app_version,omitempty"`                  // 客户端版本号
+	HttpMethod       *string                `protobuf:"bytes,20,opt,name=http_method,json=httpMethod,proto3,oneof" json:"http_method,omitempty"`                  // HTTP请求方法
+	Path             *string                `protobuf:"bytes,21,opt,name=path,proto3,oneof" json:"path,omitempty"`                                                // 请求路径
+	RequestUri       *string                `protobuf:"bytes,22,opt,name=request_uri,json=requestUri,proto3,oneof" json:"request_uri,omitempty"`                  // 完整请求URI
+	ApiModule        *string                `protobuf:"bytes,23,opt,name=api_module,json=apiModule,proto3,oneof" json:"api_module,omitempty"`                     // API所属业务模块
+	ApiOperation     *string                `protobuf:"bytes,24,opt,name=api_operation,json=apiOperation,proto3,oneof" json:"api_operation,omitempty"`            // API业务操作
+	ApiDescription   *string                `protobuf:"bytes,25,opt,name=api_description,json=apiDescription,proto3,oneof" json:"api_description,omitempty"`      // API功能描述
+	RequestId        *string                `protobuf:"bytes,26,opt,name=request_id,json=requestId,proto3,oneof" json:"request_id,omitempty"`                     // 请求ID
+	TraceId          *string                `protobuf:"bytes,27,opt,name=trace_id,json=traceId,proto3,oneof" json:"trace_id,omitempty"`                           // 全局链路追踪ID
+	SpanId           *string                `protobuf:"bytes,28,opt,name=span_id,json=spanId,proto3,oneof" json:"span_id,omitempty"`                              // 当前跨度ID
+	LatencyMs        *uint32                `protobuf:"varint,29,opt,name=latency_ms,json=latencyMs,proto3,oneof" json:"latency_ms,omitempty"`                    // API耗时
+	Success          *bool                  `protobuf:"varint,30,opt,name=success,proto3,oneof" json:"success,omitempty"`                                         // 操作结果
+	StatusCode       *uint32                `protobuf:"varint,31,opt,name=status_code,json=statusCode,proto3,oneof" json:"status_code,omitempty"`                 // HTTP状态码
+	Reason           *string                `protobuf:"bytes,32,opt,name=reason,proto3,oneof" json:"reason,omitempty"`                                            // 操作失败原因
+	RequestHeader    *string                `protobuf:"bytes,33,opt,name=request_header,json=requestHeader,proto3,oneof" json:"request_header,omitempty"`         // 请求头
+	RequestBody      *string                `protobuf:"bytes,34,opt,name=request_body,json=requestBody,proto3,oneof" json:"request_body,omitempty"`               // 请求体
+	Response         *string                `protobuf:"bytes,35,opt,name=response,proto3,oneof" json:"response,omitempty"`                                        // 响应信息
+	LogHash          *string                `protobuf:"bytes,40,opt,name=log_hash,json=logHash,proto3,oneof" json:"log_hash,omitempty"`                           // 日志哈希
+	Signature        []byte                 `protobuf:"bytes,41,opt,name=signature,proto3,oneof" json:"signature,omitempty"`                                      // 日志数字签名
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,50,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at,omitempty"`                     // 日志创建时间
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *ApiAuditLog) Reset() {
@@ -128,6 +130,20 @@ func (x *ApiAuditLog) GetUsername() string {
 	return ""
 }
 
+func (x *ApiAuditLog) GetImpersonatorId() uint32 {
+	if x != nil && x.ImpersonatorId != nil {
+		return *x.ImpersonatorId
+	}
+	return 0
+}
+
+func (x *ApiAuditLog) GetImpersonatorName() string {
+	if x != nil && x.ImpersonatorName != nil {
+		return *x.ImpersonatorName
+	}
+	return ""
+}
+
 func (x *ApiAuditLog) GetIpAddress() string {
 	if x != nil && x.IpAddress != nil {
 		return *x.IpAddress
@@ -473,57 +489,61 @@ func (x *CreateApiAuditLogRequest) GetData() *ApiAuditLog {
 
 const file_audit_service_v1_api_audit_log_proto_rawDesc = "" +
 	"\n" +
-	"$audit/service/v1/api_audit_log.proto\x12\x10audit.service.v1\x1a$gnostic/openapi/v3/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a google/protobuf/field_mask.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x17validate/validate.proto\x1a\x1epagination/v1/pagination.proto\x1a#audit/service/v1/geo_location.proto\x1a\"audit/service/v1/device_info.proto\"\xcc\x15\n" +
+	"$audit/service/v1/api_audit_log.proto\x12\x10audit.service.v1\x1a$gnostic/openapi/v3/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a google/protobuf/field_mask.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x17validate/validate.proto\x1a\x1epagination/v1/pagination.proto\x1a#audit/service/v1/geo_location.proto\x1a\"audit/service/v1/device_info.proto\"\xb6\x17\n" +
 	"\vApiAuditLog\x12/\n" +
 	"\x02id\x18\x01 \x01(\rB\x1a\xbaG\x17\x92\x02\x14接口审计日志IDH\x00R\x02id\x88\x01\x01\x120\n" +
 	"\ttenant_id\x18\x02 \x01(\rB\x0e\xbaG\v\x92\x02\b租户IDH\x01R\btenantId\x88\x01\x01\x128\n" +
 	"\vtenant_name\x18\x03 \x01(\tB\x12\xbaG\x0f\x92\x02\f租户名称H\x02R\n" +
 	"tenantName\x88\x01\x01\x12,\n" +
 	"\auser_id\x18\x04 \x01(\rB\x0e\xbaG\v\x92\x02\b用户IDH\x03R\x06userId\x88\x01\x01\x120\n" +
-	"\busername\x18\x05 \x01(\tB\x0f\xbaG\f\x92\x02\t账号名H\x04R\busername\x88\x01\x01\x122\n" +
+	"\busername\x18\x05 \x01(\tB\x0f\xbaG\f\x92\x02\t账号名H\x04R\busername\x88\x01\x01\x12i\n" +
+	"\x0fimpersonator_id\x18\x06 \x01(\rB;\xbaG8\x92\x025代登录操作人用户ID，非代登录请求为空H\x05R\x0eimpersonatorId\x88\x01\x01\x12S\n" +
+	"\x11impersonator_name\x18\a \x01(\tB!\xbaG\x1e\x92\x02\x1b代登录操作人账号名H\x06R\x10impersonatorName\x88\x01\x01\x122\n" +
 	"\n" +
 	"ip_address\x18\n" +
-	" \x01(\tB\x0e\xbaG\v\x92\x02\bIP地址H\x05R\tipAddress\x88\x01\x01\x12f\n" +
-	"\fgeo_location\x18\v \x01(\v2\x1d.audit.service.v1.GeoLocationB\x1f\xbaG\x1c\x92\x02\x19地理位置(来自IP库)H\x06R\vgeoLocation\x88\x01\x01\x12V\n" +
-	"\vdevice_info\x18\f \x01(\v2\x1c.audit.service.v1.DeviceInfoB\x12\xbaG\x0f\x92\x02\f设备信息H\aR\n" +
+	" \x01(\tB\x0e\xbaG\v\x92\x02\bIP地址H\aR\tipAddress\x88\x01\x01\x12f\n" +
+	"\fgeo_location\x18\v \x01(\v2\x1d.audit.service.v1.GeoLocationB\x1f\xbaG\x1c\x92\x02\x19地理位置(来自IP库)H\bR\vgeoLocation\x88\x01\x01\x12V\n" +
+	"\vdevice_info\x18\f \x01(\v2\x1c.audit.service.v1.DeviceInfoB\x12\xbaG\x0f\x92\x02\f设备信息H\tR\n" +
 	"deviceInfo\x88\x01\x01\x124\n" +
-	"\areferer\x18\r \x01(\tB\x15\xbaG\x12\x92\x02\x0f请求来源URLH\bR\areferer\x88\x01\x01\x12>\n" +
-	"\vapp_version\x18\x0e \x01(\tB\x18\xbaG\x15\x92\x02\x12客户端版本号H\tR\n" +
+	"\areferer\x18\r \x01(\tB\x15\xbaG\x12\x92\x02\x0f请求来源URLH\n" +
+	"R\areferer\x88\x01\x01\x12>\n" +
+	"\vapp_version\x18\x0e \x01(\tB\x18\xbaG\x15\x92\x02\x12客户端版本号H\vR\n" +
 	"appVersion\x88\x01\x01\x12U\n" +
-	"\vhttp_method\x18\x14 \x01(\tB/\xbaG,\x92\x02)HTTP请求方法（GET/POST/PUT/DELETE）H\n" +
-	"R\n" +
+	"\vhttp_method\x18\x14 \x01(\tB/\xbaG,\x92\x02)HTTP请求方法（GET/POST/PUT/DELETE）H\fR\n" +
 	"httpMethod\x88\x01\x01\x12P\n" +
-	"\x04path\x18\x15 \x01(\tB7\xbaG4\x92\x021请求路径（不含参数，如/api/v1/users）H\vR\x04path\x88\x01\x01\x12b\n" +
-	"\vrequest_uri\x18\x16 \x01(\tB<\xbaG9\x92\x026完整请求URI（含参数，如/api/v1/users?id=1）H\fR\n" +
+	"\x04path\x18\x15 \x01(\tB7\xbaG4\x92\x021请求路径（不含参数，如/api/v1/users）H\rR\x04path\x88\x01\x01\x12b\n" +
+	"\vrequest_uri\x18\x16 \x01(\tB<\xbaG9\x92\x026完整请求URI（含参数，如/api/v1/users?id=1）H\x0eR\n" +
 	"requestUri\x88\x01\x01\x12]\n" +
 	"\n" +
-	"api_module\x18\x17 \x01(\tB9\xbaG6\x92\x023API所属业务模块（如user/permission/order）H\rR\tapiModule\x88\x01\x01\x12q\n" +
-	"\rapi_operation\x18\x18 \x01(\tBG\xbaGD\x92\x02AAPI业务操作（如查询用户/创建订单，非HTTP方法）H\x0eR\fapiOperation\x88\x01\x01\x12r\n" +
-	"\x0fapi_description\x18\x19 \x01(\tBD\xbaGA\x92\x02>API功能描述（如“根据ID查询单个用户信息”）H\x0fR\x0eapiDescription\x88\x01\x01\x12P\n" +
+	"api_module\x18\x17 \x01(\tB9\xbaG6\x92\x023API所属业务模块（如user/permission/order）H\x0fR\tapiModule\x88\x01\x01\x12q\n" +
+	"\rapi_operation\x18\x18 \x01(\tBG\xbaGD\x92\x02AAPI业务操作（如查询用户/创建订单，非HTTP方法）H\x10R\fapiOperation\x88\x01\x01\x12r\n" +
+	"\x0fapi_description\x18\x19 \x01(\tBD\xbaGA\x92\x02>API功能描述（如“根据ID查询单个用户信息”）H\x11R\x0eapiDescription\x88\x01\x01\x12P\n" +
 	"\n" +
-	"request_id\x18\x1a \x01(\tB,\xbaG)\x92\x02&全局请求ID（关联网关日志）H\x10R\trequestId\x88\x01\x01\x12\\\n" +
-	"\btrace_id\x18\x1b \x01(\tB<\xbaG9\x92\x026全局链路追踪ID（符合W3C TraceContext标准）H\x11R\atraceId\x88\x01\x01\x122\n" +
-	"\aspan_id\x18\x1c \x01(\tB\x14\xbaG\x11\x92\x02\x0e当前跨度IDH\x12R\x06spanId\x88\x01\x01\x12I\n" +
+	"request_id\x18\x1a \x01(\tB,\xbaG)\x92\x02&全局请求ID（关联网关日志）H\x12R\trequestId\x88\x01\x01\x12\\\n" +
+	"\btrace_id\x18\x1b \x01(\tB<\xbaG9\x92\x026全局链路追踪ID（符合W3C TraceContext标准）H\x13R\atraceId\x88\x01\x01\x122\n" +
+	"\aspan_id\x18\x1c \x01(\tB\x14\xbaG\x11\x92\x02\x0e当前跨度IDH\x14R\x06spanId\x88\x01\x01\x12I\n" +
 	"\n" +
-	"latency_ms\x18\x1d \x01(\rB%\xfaB\a*\x05\x18\x80\xdd\xdb\x01\xbaG\x18\x92\x02\x15API耗时（毫秒）H\x13R\tlatencyMs\x88\x01\x01\x127\n" +
-	"\asuccess\x18\x1e \x01(\bB\x18\xbaG\x15\x92\x02\x12操作是否成功H\x14R\asuccess\x88\x01\x01\x12J\n" +
-	"\vstatus_code\x18\x1f \x01(\rB$\xbaG!\x92\x02\x1eHTTP状态码（200/403/500）H\x15R\n" +
+	"latency_ms\x18\x1d \x01(\rB%\xfaB\a*\x05\x18\x80\xdd\xdb\x01\xbaG\x18\x92\x02\x15API耗时（毫秒）H\x15R\tlatencyMs\x88\x01\x01\x127\n" +
+	"\asuccess\x18\x1e \x01(\bB\x18\xbaG\x15\x92\x02\x12操作是否成功H\x16R\asuccess\x88\x01\x01\x12J\n" +
+	"\vstatus_code\x18\x1f \x01(\rB$\xbaG!\x92\x02\x1eHTTP状态码（200/403/500）H\x17R\n" +
 	"statusCode\x88\x01\x01\x12T\n" +
-	"\x06reason\x18  \x01(\tB7\xbaG4\x92\x021操作失败原因（仅success=false时填充）H\x16R\x06reason\x88\x01\x01\x12c\n" +
-	"\x0erequest_header\x18! \x01(\tB7\xbaG4\x92\x021请求头（JSON格式，敏感字段脱敏后）H\x17R\rrequestHeader\x88\x01\x01\x12_\n" +
-	"\frequest_body\x18\" \x01(\tB7\xbaG4\x92\x021请求体（JSON格式，敏感字段脱敏后）H\x18R\vrequestBody\x88\x01\x01\x12[\n" +
-	"\bresponse\x18# \x01(\tB:\xbaG7\x92\x024响应信息（JSON格式，敏感字段脱敏后）H\x19R\bresponse\x88\x01\x01\x12\\\n" +
-	"\blog_hash\x18( \x01(\tB<\xbaG9\x92\x026日志内容哈希（SHA256，十六进制字符串）H\x1aR\alogHash\x88\x01\x01\x12}\n" +
-	"\tsignature\x18) \x01(\fBZ\xbaGW\x92\x02T日志数字签名（ECDSA，签名内容：tenant_id+user_id+created_at+log_hash）H\x1bR\tsignature\x88\x01\x01\x12X\n" +
+	"\x06reason\x18  \x01(\tB7\xbaG4\x92\x021操作失败原因（仅success=false时填充）H\x18R\x06reason\x88\x01\x01\x12c\n" +
+	"\x0erequest_header\x18! \x01(\tB7\xbaG4\x92\x021请求头（JSON格式，敏感字段脱敏后）H\x19R\rrequestHeader\x88\x01\x01\x12_\n" +
+	"\frequest_body\x18\" \x01(\tB7\xbaG4\x92\x021请求体（JSON格式，敏感字段脱敏后）H\x1aR\vrequestBody\x88\x01\x01\x12[\n" +
+	"\bresponse\x18# \x01(\tB:\xbaG7\x92\x024响应信息（JSON格式，敏感字段脱敏后）H\x1bR\bresponse\x88\x01\x01\x12\\\n" +
+	"\blog_hash\x18( \x01(\tB<\xbaG9\x92\x026日志内容哈希（SHA256，十六进制字符串）H\x1cR\alogHash\x88\x01\x01\x12}\n" +
+	"\tsignature\x18) \x01(\fBZ\xbaGW\x92\x02T日志数字签名（ECDSA，签名内容：tenant_id+user_id+created_at+log_hash）H\x1dR\tsignature\x88\x01\x01\x12X\n" +
 	"\n" +
-	"created_at\x182 \x01(\v2\x1a.google.protobuf.TimestampB\x18\xbaG\x15\x92\x02\x12日志创建时间H\x1cR\tcreatedAt\x88\x01\x01B\x05\n" +
+	"created_at\x182 \x01(\v2\x1a.google.protobuf.TimestampB\x18\xbaG\x15\x92\x02\x12日志创建时间H\x1eR\tcreatedAt\x88\x01\x01B\x05\n" +
 	"\x03_idB\f\n" +
 	"\n" +
 	"_tenant_idB\x0e\n" +
 	"\f_tenant_nameB\n" +
 	"\n" +
 	"\b_user_idB\v\n" +
-	"\t_usernameB\r\n" +
+	"\t_usernameB\x12\n" +
+	"\x10_impersonator_idB\x14\n" +
+	"\x12_impersonator_nameB\r\n" +
 	"\v_ip_addressB\x0f\n" +
 	"\r_geo_locationB\x0e\n" +
 	"\f_device_infoB\n" +
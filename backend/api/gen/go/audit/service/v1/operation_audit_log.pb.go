@@ -99,29 +99,31 @@ func (OperationAuditLog_ActionType) EnumDescriptor() ([]byte, []int) {
 
 // 操作审计日志
 type OperationAuditLog struct {
-	state          protoimpl.MessageState        `protogen:"open.v1"`
-	Id             *uint32                       `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id,omitempty"`                                                                                     // 用户操作审计日志ID
-	TenantId       *uint32                       `protobuf:"varint,2,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`                                                         // 租户ID
-	TenantName     *string                       `protobuf:"bytes,3,opt,name=tenant_name,json=tenantName,proto3,oneof" json:"tenant_name,omitempty"`                                                    // 租户名称
-	UserId         *uint32                       `protobuf:"varint,4,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`                                                               // 用户ID
-	Username       *string                       `protobuf:"bytes,5,opt,name=username,proto3,oneof" json:"username,omitempty"`                                                                          // 账号名
-	ResourceType   *string                       `protobuf:"bytes,10,opt,name=resource_type,json=resourceType,proto3,oneof" json:"resource_type,omitempty"`                                             // 资源类型
-	ResourceId     *string                       `protobuf:"bytes,11,opt,name=resource_id,json=resourceId,proto3,oneof" json:"resource_id,omitempty"`                                                   // 资源ID
-	Action         *OperationAuditLog_ActionType `protobuf:"varint,12,opt,name=action,proto3,enum=audit.service.v1.OperationAuditLog_ActionType,oneof" json:"action,omitempty"`                         // 动作
-	BeforeData     *string                       `protobuf:"bytes,13,opt,name=before_data,json=beforeData,proto3,oneof" json:"before_data,omitempty"`                                                   // 操作前数据
-	AfterData      *string                       `protobuf:"bytes,14,opt,name=after_data,json=afterData,proto3,oneof" json:"after_data,omitempty"`                                                      // 操作后数据
-	SensitiveLevel *SensitiveLevel               `protobuf:"varint,15,opt,name=sensitive_level,json=sensitiveLevel,proto3,enum=audit.service.v1.SensitiveLevel,oneof" json:"sensitive_level,omitempty"` // 敏感等级
-	RequestId      *string                       `protobuf:"bytes,16,opt,name=request_id,json=requestId,proto3,oneof" json:"request_id,omitempty"`                                                      // 请求ID
-	TraceId        *string                       `protobuf:"bytes,17,opt,name=trace_id,json=traceId,proto3,oneof" json:"trace_id,omitempty"`                                                            // 全局链路追踪ID
-	Success        *bool                         `protobuf:"varint,18,opt,name=success,proto3,oneof" json:"success,omitempty"`                                                                          // 操作结果
-	FailureReason  *string                       `protobuf:"bytes,19,opt,name=failure_reason,json=failureReason,proto3,oneof" json:"failure_reason,omitempty"`                                          // 失败原因
-	IpAddress      *string                       `protobuf:"bytes,20,opt,name=ip_address,json=ipAddress,proto3,oneof" json:"ip_address,omitempty"`                                                      // IP地址
-	GeoLocation    *GeoLocation                  `protobuf:"bytes,21,opt,name=geo_location,json=geoLocation,proto3,oneof" json:"geo_location,omitempty"`                                                // 地理位置(来自IP库)
-	LogHash        *string                       `protobuf:"bytes,40,opt,name=log_hash,json=logHash,proto3,oneof" json:"log_hash,omitempty"`                                                            // 日志哈希
-	Signature      []byte                        `protobuf:"bytes,41,opt,name=signature,proto3,oneof" json:"signature,omitempty"`                                                                       // 日志数字签名
-	CreatedAt      *timestamppb.Timestamp        `protobuf:"bytes,50,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at,omitempty"`                                                      // 日志创建时间
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	state            protoimpl.MessageState        `protogen:"open.v1"`
+	Id               *uint32                       `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id,omitempty"`                                                                                     // 用户操作审计日志ID
+	TenantId         *uint32                       `protobuf:"varint,2,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`                                                         // 租户ID
+	TenantName       *string                       `protobuf:"bytes,3,opt,name=tenant_name,json=tenantName,proto3,oneof" json:"tenant_name,omitempty"`                                                    // 租户名称
+	UserId           *uint32                       `protobuf:"varint,4,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`                                                               // 用户ID
+	Username         *string                       `protobuf:"bytes,5,opt,name=username,proto3,oneof" json:"username,omitempty"`                                                                          // 账号名
+	ImpersonatorId   *uint32                       `protobuf:"varint,6,opt,name=impersonator_id,json=impersonatorId,proto3,oneof" json:"impersonator_id,omitempty"`                                       // 代登录操作人用户ID，非代登录请求为空
+	ImpersonatorName *string                       `protobuf:"bytes,7,opt,name=impersonator_name,json=impersonatorName,proto3,oneof" json:"impersonator_name,omitempty"`                                  // 代登录操作人账号名
+	ResourceType     *string                       `protobuf:"bytes,10,opt,name=resource_type,json=resourceType,proto3,oneof" json:"resource_type,omitempty"`                                             // 资源类型
+	ResourceId       *string                       `protobuf:"bytes,11,opt,name=resource_id,json=resourceId,proto3,oneof" json:"resource_id,omitempty"`                                                   // 资源ID
+	Action           *OperationAuditLog_ActionType `protobuf:"varint,12,opt,name=action,proto3,enum=audit.service.v1.OperationAuditLog_ActionType,oneof" json:"action,omitempty"`                         // 动作
+	BeforeData       *string                       `protobuf:"bytes,13,opt,name=before_data,json=beforeData,proto3,oneof" json:"before_data,omitempty"`                                                   // 操作前数据
+	AfterData        *string                       `protobuf:"bytes,14,opt,name=after_data,json=afterData,proto3,oneof" json:"after_data,omitempty"`                                                      // 操作后数据
+	SensitiveLevel   *SensitiveLevel               `protobuf:"varint,15,opt,name=sensitive_level,json=sensitiveLevel,proto3,enum=audit.service.v1.SensitiveLevel,oneof" json:"sensitive_level,omitempty"` // 敏感等级
+	RequestId        *string                       `protobuf:"bytes,16,opt,name=request_id,json=requestId,proto3,oneof" json:"request_id,omitempty"`                                                      // 请求ID
+	TraceId          *string                       `protobuf:"bytes,17,opt,name=trace_id,json=traceId,proto3,oneof" json:"trace_id,omitempty"`                                                            // 全局链路追踪ID
+	Success          *bool                         `protobuf:"varint,18,opt,name=success,proto3,oneof" json:"success,omitempty"`                                                                          // 操作结果
+	FailureReason    *string                       `protobuf:"bytes,19,opt,name=failure_reason,json=failureReason,proto3,oneof" json:"failure_reason,omitempty"`                                          // 失败原因
+	IpAddress        *string                       `protobuf:"bytes,20,opt,name=ip_address,json=ipAddress,proto3,oneof" json:"ip_address,omitempty"`                                                      // IP地址
+	GeoLocation      *GeoLocation                  `protobuf:"bytes,21,opt,name=geo_location,json=geoLocation,proto3,oneof" json:"geo_location,omitempty"`                                                // 地理位置(来自IP库)
+	LogHash          *string                       `protobuf:"bytes,40,opt,name=log_hash,json=logHash,proto3,oneof" json:"log_hash,omitempty"`                                                            // 日志哈希
+	Signature        []byte                        `protobuf:"bytes,41,opt,name=signature,proto3,oneof" json:"signature,omitempty"`                                                                       // 日志数字签名
+	CreatedAt        *timestamppb.Timestamp        `protobuf:"bytes,50,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at,omitempty"`                                                      // 日志创建时间
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *OperationAuditLog) Reset() {
@@ -189,6 +191,20 @@ func (x *OperationAuditLog) GetUsername() string {
 	return ""
 }
 
+func (x *OperationAuditLog) GetImpersonatorId() uint32 {
+	if x != nil && x.ImpersonatorId != nil {
+		return *x.ImpersonatorId
+	}
+	return 0
+}
+
+func (x *OperationAuditLog) GetImpersonatorName() string {
+	if x != nil && x.ImpersonatorName != nil {
+		return *x.ImpersonatorName
+	}
+	return ""
+}
+
 func (x *OperationAuditLog) GetResourceType() string {
 	if x != nil && x.ResourceType != nil {
 		return *x.ResourceType
@@ -471,37 +487,39 @@ func (x *CreateOperationAuditLogRequest) GetData() *OperationAuditLog {
 
 const file_audit_service_v1_operation_audit_log_proto_rawDesc = "" +
 	"\n" +
-	"*audit/service/v1/operation_audit_log.proto\x12\x10audit.service.v1\x1a$gnostic/openapi/v3/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a google/protobuf/field_mask.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1epagination/v1/pagination.proto\x1a\x1daudit/service/v1/common.proto\x1a#audit/service/v1/geo_location.proto\"\xee\x0e\n" +
+	"*audit/service/v1/operation_audit_log.proto\x12\x10audit.service.v1\x1a$gnostic/openapi/v3/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a google/protobuf/field_mask.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1epagination/v1/pagination.proto\x1a\x1daudit/service/v1/common.proto\x1a#audit/service/v1/geo_location.proto\"\xd8\x10\n" +
 	"\x11OperationAuditLog\x12,\n" +
 	"\x02id\x18\x01 \x01(\rB\x17\xbaG\x14\x92\x02\x11API审计日志IDH\x00R\x02id\x88\x01\x01\x120\n" +
 	"\ttenant_id\x18\x02 \x01(\rB\x0e\xbaG\v\x92\x02\b租户IDH\x01R\btenantId\x88\x01\x01\x128\n" +
 	"\vtenant_name\x18\x03 \x01(\tB\x12\xbaG\x0f\x92\x02\f租户名称H\x02R\n" +
 	"tenantName\x88\x01\x01\x12,\n" +
 	"\auser_id\x18\x04 \x01(\rB\x0e\xbaG\v\x92\x02\b用户IDH\x03R\x06userId\x88\x01\x01\x120\n" +
-	"\busername\x18\x05 \x01(\tB\x0f\xbaG\f\x92\x02\t账号名H\x04R\busername\x88\x01\x01\x12<\n" +
+	"\busername\x18\x05 \x01(\tB\x0f\xbaG\f\x92\x02\t账号名H\x04R\busername\x88\x01\x01\x12i\n" +
+	"\x0fimpersonator_id\x18\x06 \x01(\rB;\xbaG8\x92\x025代登录操作人用户ID，非代登录请求为空H\x05R\x0eimpersonatorId\x88\x01\x01\x12S\n" +
+	"\x11impersonator_name\x18\a \x01(\tB!\xbaG\x1e\x92\x02\x1b代登录操作人账号名H\x06R\x10impersonatorName\x88\x01\x01\x12<\n" +
 	"\rresource_type\x18\n" +
-	" \x01(\tB\x12\xbaG\x0f\x92\x02\f资源类型H\x05R\fresourceType\x88\x01\x01\x124\n" +
-	"\vresource_id\x18\v \x01(\tB\x0e\xbaG\v\x92\x02\b资源IDH\x06R\n" +
+	" \x01(\tB\x12\xbaG\x0f\x92\x02\f资源类型H\aR\fresourceType\x88\x01\x01\x124\n" +
+	"\vresource_id\x18\v \x01(\tB\x0e\xbaG\v\x92\x02\b资源IDH\bR\n" +
 	"resourceId\x88\x01\x01\x12Y\n" +
-	"\x06action\x18\f \x01(\x0e2..audit.service.v1.OperationAuditLog.ActionTypeB\f\xbaG\t\x92\x02\x06动作H\aR\x06action\x88\x01\x01\x12;\n" +
-	"\vbefore_data\x18\r \x01(\tB\x15\xbaG\x12\x92\x02\x0f操作前数据H\bR\n" +
+	"\x06action\x18\f \x01(\x0e2..audit.service.v1.OperationAuditLog.ActionTypeB\f\xbaG\t\x92\x02\x06动作H\tR\x06action\x88\x01\x01\x12;\n" +
+	"\vbefore_data\x18\r \x01(\tB\x15\xbaG\x12\x92\x02\x0f操作前数据H\n" +
+	"R\n" +
 	"beforeData\x88\x01\x01\x129\n" +
 	"\n" +
-	"after_data\x18\x0e \x01(\tB\x15\xbaG\x12\x92\x02\x0f操作后数据H\tR\tafterData\x88\x01\x01\x12b\n" +
-	"\x0fsensitive_level\x18\x0f \x01(\x0e2 .audit.service.v1.SensitiveLevelB\x12\xbaG\x0f\x92\x02\f敏感等级H\n" +
-	"R\x0esensitiveLevel\x88\x01\x01\x12P\n" +
+	"after_data\x18\x0e \x01(\tB\x15\xbaG\x12\x92\x02\x0f操作后数据H\vR\tafterData\x88\x01\x01\x12b\n" +
+	"\x0fsensitive_level\x18\x0f \x01(\x0e2 .audit.service.v1.SensitiveLevelB\x12\xbaG\x0f\x92\x02\f敏感等级H\fR\x0esensitiveLevel\x88\x01\x01\x12P\n" +
 	"\n" +
-	"request_id\x18\x10 \x01(\tB,\xbaG)\x92\x02&全局请求ID（关联网关日志）H\vR\trequestId\x88\x01\x01\x12\\\n" +
-	"\btrace_id\x18\x11 \x01(\tB<\xbaG9\x92\x026全局链路追踪ID（符合W3C TraceContext标准）H\fR\atraceId\x88\x01\x01\x127\n" +
-	"\asuccess\x18\x12 \x01(\bB\x18\xbaG\x15\x92\x02\x12操作是否成功H\rR\asuccess\x88\x01\x01\x12>\n" +
-	"\x0efailure_reason\x18\x13 \x01(\tB\x12\xbaG\x0f\x92\x02\f失败原因H\x0eR\rfailureReason\x88\x01\x01\x122\n" +
+	"request_id\x18\x10 \x01(\tB,\xbaG)\x92\x02&全局请求ID（关联网关日志）H\rR\trequestId\x88\x01\x01\x12\\\n" +
+	"\btrace_id\x18\x11 \x01(\tB<\xbaG9\x92\x026全局链路追踪ID（符合W3C TraceContext标准）H\x0eR\atraceId\x88\x01\x01\x127\n" +
+	"\asuccess\x18\x12 \x01(\bB\x18\xbaG\x15\x92\x02\x12操作是否成功H\x0fR\asuccess\x88\x01\x01\x12>\n" +
+	"\x0efailure_reason\x18\x13 \x01(\tB\x12\xbaG\x0f\x92\x02\f失败原因H\x10R\rfailureReason\x88\x01\x01\x122\n" +
 	"\n" +
-	"ip_address\x18\x14 \x01(\tB\x0e\xbaG\v\x92\x02\bIP地址H\x0fR\tipAddress\x88\x01\x01\x12f\n" +
-	"\fgeo_location\x18\x15 \x01(\v2\x1d.audit.service.v1.GeoLocationB\x1f\xbaG\x1c\x92\x02\x19地理位置(来自IP库)H\x10R\vgeoLocation\x88\x01\x01\x12\\\n" +
-	"\blog_hash\x18( \x01(\tB<\xbaG9\x92\x026日志内容哈希（SHA256，十六进制字符串）H\x11R\alogHash\x88\x01\x01\x12}\n" +
-	"\tsignature\x18) \x01(\fBZ\xbaGW\x92\x02T日志数字签名（ECDSA，签名内容：tenant_id+user_id+created_at+log_hash）H\x12R\tsignature\x88\x01\x01\x12X\n" +
+	"ip_address\x18\x14 \x01(\tB\x0e\xbaG\v\x92\x02\bIP地址H\x11R\tipAddress\x88\x01\x01\x12f\n" +
+	"\fgeo_location\x18\x15 \x01(\v2\x1d.audit.service.v1.GeoLocationB\x1f\xbaG\x1c\x92\x02\x19地理位置(来自IP库)H\x12R\vgeoLocation\x88\x01\x01\x12\\\n" +
+	"\blog_hash\x18( \x01(\tB<\xbaG9\x92\x026日志内容哈希（SHA256，十六进制字符串）H\x13R\alogHash\x88\x01\x01\x12}\n" +
+	"\tsignature\x18) \x01(\fBZ\xbaGW\x92\x02T日志数字签名（ECDSA，签名内容：tenant_id+user_id+created_at+log_hash）H\x14R\tsignature\x88\x01\x01\x12X\n" +
 	"\n" +
-	"created_at\x182 \x01(\v2\x1a.google.protobuf.TimestampB\x18\xbaG\x15\x92\x02\x12日志创建时间H\x13R\tcreatedAt\x88\x01\x01\"\x94\x01\n" +
+	"created_at\x182 \x01(\v2\x1a.google.protobuf.TimestampB\x18\xbaG\x15\x92\x02\x12日志创建时间H\x15R\tcreatedAt\x88\x01\x01\"\x94\x01\n" +
 	"\n" +
 	"ActionType\x12\x1b\n" +
 	"\x17ACTION_TYPE_UNSPECIFIED\x10\x00\x12\n" +
@@ -526,7 +544,9 @@ func (x *CreateOperationAuditLogRequest) GetData() *OperationAuditLog {
 	"\f_tenant_nameB\n" +
 	"\n" +
 	"\b_user_idB\v\n" +
-	"\t_usernameB\x10\n" +
+	"\t_usernameB\x12\n" +
+	"\x10_impersonator_idB\x14\n" +
+	"\x12_impersonator_nameB\x10\n" +
 	"\x0e_resource_typeB\x0e\n" +
 	"\f_resource_idB\t\n" +
 	"\a_actionB\x0e\n" +
@@ -77,6 +77,14 @@ func (m *OperationAuditLog) validate(all bool) error {
 		// no validation rules for Username
 	}
 
+	if m.ImpersonatorId != nil {
+		// no validation rules for ImpersonatorId
+	}
+
+	if m.ImpersonatorName != nil {
+		// no validation rules for ImpersonatorName
+	}
+
 	if m.ResourceType != nil {
 		// no validation rules for ResourceType
 	}
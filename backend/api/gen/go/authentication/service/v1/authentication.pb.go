@@ -228,6 +228,56 @@ func (TokenCategory) EnumDescriptor() ([]byte, []int) {
 	return file_authentication_service_v1_authentication_proto_rawDescGZIP(), []int{3}
 }
 
+// 令牌投递渠道（找回密码、账号激活）
+type DeliveryChannel int32
+
+const (
+	DeliveryChannel_DELIVERY_CHANNEL_UNSPECIFIED DeliveryChannel = 0 // 未指定：优先邮箱，未绑定邮箱时用手机号
+	DeliveryChannel_DELIVERY_CHANNEL_EMAIL       DeliveryChannel = 1 // 邮件
+	DeliveryChannel_DELIVERY_CHANNEL_SMS         DeliveryChannel = 2 // 短信
+)
+
+// Enum value maps for DeliveryChannel.
+var (
+	DeliveryChannel_name = map[int32]string{
+		0: "DELIVERY_CHANNEL_UNSPECIFIED",
+		1: "DELIVERY_CHANNEL_EMAIL",
+		2: "DELIVERY_CHANNEL_SMS",
+	}
+	DeliveryChannel_value = map[string]int32{
+		"DELIVERY_CHANNEL_UNSPECIFIED": 0,
+		"DELIVERY_CHANNEL_EMAIL":       1,
+		"DELIVERY_CHANNEL_SMS":         2,
+	}
+)
+
+func (x DeliveryChannel) Enum() *DeliveryChannel {
+	p := new(DeliveryChannel)
+	*p = x
+	return p
+}
+
+func (x DeliveryChannel) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DeliveryChannel) Descriptor() protoreflect.EnumDescriptor {
+	return file_authentication_service_v1_authentication_proto_enumTypes[4].Descriptor()
+}
+
+func (DeliveryChannel) Type() protoreflect.EnumType {
+	return &file_authentication_service_v1_authentication_proto_enumTypes[4]
+}
+
+func (x DeliveryChannel) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DeliveryChannel.Descriptor instead.
+func (DeliveryChannel) EnumDescriptor() ([]byte, []int) {
+	return file_authentication_service_v1_authentication_proto_rawDescGZIP(), []int{4}
+}
+
 // 用户后台登录 - 请求
 type LoginRequest struct {
 	state        protoimpl.MessageState `protogen:"open.v1"`
@@ -248,6 +298,7 @@ type LoginRequest struct {
 	Password      *string                   `protobuf:"bytes,19,opt,name=password,proto3,oneof" json:"password,omitempty"`                                                  // 用户的密码，必选项。
 	RefreshToken  *string                   `protobuf:"bytes,20,opt,name=refresh_token,proto3,oneof" json:"refresh_token,omitempty"`                                        // 更新令牌，用来获取下一次的访问令牌，必选项。
 	Code          *string                   `protobuf:"bytes,30,opt,name=code,proto3,oneof" json:"code,omitempty"`                                                          // 授权请求中收到的一次性验证/认证码。(当使用授权码模式时)
+	CodeVerifier  *string                   `protobuf:"bytes,31,opt,name=code_verifier,proto3,oneof" json:"code_verifier,omitempty"`                                        // PKCE 校验码，与授权请求中的 code_challenge 对应。(当使用授权码模式时必选)
 	ClientType    *ClientType               `protobuf:"varint,40,opt,name=client_type,proto3,enum=authentication.service.v1.ClientType,oneof" json:"client_type,omitempty"` // 客户端类型
 	DeviceId      *string                   `protobuf:"bytes,50,opt,name=device_id,proto3,oneof" json:"device_id,omitempty"`
 	Jti           *string                   `protobuf:"bytes,60,opt,name=jti,proto3,oneof" json:"jti,omitempty"`
@@ -383,6 +434,13 @@ func (x *LoginRequest) GetCode() string {
 	return ""
 }
 
+func (x *LoginRequest) GetCodeVerifier() string {
+	if x != nil && x.CodeVerifier != nil {
+		return *x.CodeVerifier
+	}
+	return ""
+}
+
 func (x *LoginRequest) GetClientType() ClientType {
 	if x != nil && x.ClientType != nil {
 		return *x.ClientType
@@ -447,8 +505,14 @@ type LoginResponse struct {
 	// 而是返回此 operation_id。前端据此跳转 MFA 挑战页，提交 TOTP 验证码到 MFAService.VerifyMFAChallenge。
 	// 该字段非空时 access_token 必为空字符串；验证通过后由 VerifyMFAChallenge 返回真 token。
 	MfaOperationId *string `protobuf:"bytes,8,opt,name=mfa_operation_id,proto3,oneof" json:"mfa_operation_id,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// 强制注册标识：MFA 策略要求该用户启用 MFA 而其尚未绑定任何因子。
+	// 此时 mfa_operation_id 仅可用于 MfaService.StartLoginEnrollment / ConfirmLoginEnrollment 完成注册，
+	// 注册成功后再以同一 operation_id 调用 VerifyMFAChallenge 换取真 token。
+	MfaEnrollmentRequired *bool `protobuf:"varint,9,opt,name=mfa_enrollment_required,proto3,oneof" json:"mfa_enrollment_required,omitempty"`
+	// 密码已超过密码策略规定的有效期：令牌照常签发，前端应引导用户立即修改密码
+	PasswordChangeRequired *bool `protobuf:"varint,10,opt,name=password_change_required,proto3,oneof" json:"password_change_required,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
 func (x *LoginResponse) Reset() {
@@ -537,6 +601,20 @@ func (x *LoginResponse) GetMfaOperationId() string {
 	return ""
 }
 
+func (x *LoginResponse) GetMfaEnrollmentRequired() bool {
+	if x != nil && x.MfaEnrollmentRequired != nil {
+		return *x.MfaEnrollmentRequired
+	}
+	return false
+}
+
+func (x *LoginResponse) GetPasswordChangeRequired() bool {
+	if x != nil && x.PasswordChangeRequired != nil {
+		return *x.PasswordChangeRequired
+	}
+	return false
+}
+
 // 用户登出 - 请求
 type LogoutRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1479,11 +1557,231 @@ func (x *VerifyCaptchaResponse) GetValid() bool {
 	return false
 }
 
+// 申请找回密码 - 请求
+type RequestPasswordResetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantCode    string                 `protobuf:"bytes,1,opt,name=tenant_code,json=tenantCode,proto3" json:"tenant_code,omitempty"`                               // 租户代码
+	Account       string                 `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`                                                       // 用户名、邮箱或手机号
+	Channel       *DeliveryChannel       `protobuf:"varint,3,opt,name=channel,proto3,enum=authentication.service.v1.DeliveryChannel,oneof" json:"channel,omitempty"` // 投递渠道
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestPasswordResetRequest) Reset() {
+	*x = RequestPasswordResetRequest{}
+	mi := &file_authentication_service_v1_authentication_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestPasswordResetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestPasswordResetRequest) ProtoMessage() {}
+
+func (x *RequestPasswordResetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authentication_service_v1_authentication_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestPasswordResetRequest.ProtoReflect.Descriptor instead.
+func (*RequestPasswordResetRequest) Descriptor() ([]byte, []int) {
+	return file_authentication_service_v1_authentication_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RequestPasswordResetRequest) GetTenantCode() string {
+	if x != nil {
+		return x.TenantCode
+	}
+	return ""
+}
+
+func (x *RequestPasswordResetRequest) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *RequestPasswordResetRequest) GetChannel() DeliveryChannel {
+	if x != nil && x.Channel != nil {
+		return *x.Channel
+	}
+	return DeliveryChannel_DELIVERY_CHANNEL_UNSPECIFIED
+}
+
+// 凭重置令牌设置新密码 - 请求
+type ConfirmPasswordResetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`                                // 重置令牌
+	NewPassword   string                 `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"` // 新密码
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmPasswordResetRequest) Reset() {
+	*x = ConfirmPasswordResetRequest{}
+	mi := &file_authentication_service_v1_authentication_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmPasswordResetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmPasswordResetRequest) ProtoMessage() {}
+
+func (x *ConfirmPasswordResetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authentication_service_v1_authentication_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmPasswordResetRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmPasswordResetRequest) Descriptor() ([]byte, []int) {
+	return file_authentication_service_v1_authentication_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ConfirmPasswordResetRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ConfirmPasswordResetRequest) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+// 激活账号 - 请求
+type ActivateAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`       // 激活令牌
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"` // 登录密码
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivateAccountRequest) Reset() {
+	*x = ActivateAccountRequest{}
+	mi := &file_authentication_service_v1_authentication_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivateAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivateAccountRequest) ProtoMessage() {}
+
+func (x *ActivateAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authentication_service_v1_authentication_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivateAccountRequest.ProtoReflect.Descriptor instead.
+func (*ActivateAccountRequest) Descriptor() ([]byte, []int) {
+	return file_authentication_service_v1_authentication_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ActivateAccountRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ActivateAccountRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+// 发送账号激活邮件/短信 - 请求
+type SendActivationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                                          // 用户ID
+	Channel       *DeliveryChannel       `protobuf:"varint,2,opt,name=channel,proto3,enum=authentication.service.v1.DeliveryChannel,oneof" json:"channel,omitempty"` // 投递渠道
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendActivationRequest) Reset() {
+	*x = SendActivationRequest{}
+	mi := &file_authentication_service_v1_authentication_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendActivationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendActivationRequest) ProtoMessage() {}
+
+func (x *SendActivationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authentication_service_v1_authentication_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendActivationRequest.ProtoReflect.Descriptor instead.
+func (*SendActivationRequest) Descriptor() ([]byte, []int) {
+	return file_authentication_service_v1_authentication_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SendActivationRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SendActivationRequest) GetChannel() DeliveryChannel {
+	if x != nil && x.Channel != nil {
+		return *x.Channel
+	}
+	return DeliveryChannel_DELIVERY_CHANNEL_UNSPECIFIED
+}
+
 var File_authentication_service_v1_authentication_proto protoreflect.FileDescriptor
 
 const file_authentication_service_v1_authentication_proto_rawDesc = "" +
 	"\n" +
-	".authentication/service/v1/authentication.proto\x12\x19authentication.service.v1\x1a$gnostic/openapi/v3/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x16redact/v1/redact.proto\x1a\x1eidentity/service/v1/user.proto\x1a*authentication/service/v1/user_token.proto\"\xd2\x0e\n" +
+	".authentication/service/v1/authentication.proto\x12\x19authentication.service.v1\x1a$gnostic/openapi/v3/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x16redact/v1/redact.proto\x1a\x1eidentity/service/v1/user.proto\x1a*authentication/service/v1/user_token.proto\"\x80\x10\n" +
 	"\fLoginRequest\x12\x99\x01\n" +
 	"\n" +
 	"grant_type\x18\x01 \x01(\x0e2$.authentication.service.v1.GrantTypeBS\xe0A\x02\xbaGM\x8a\x02\n" +
@@ -1500,12 +1798,13 @@ func (x *VerifyCaptchaResponse) GetValid() bool {
 	"\x06mobile\x18\f \x01(\tB\x0f\xbaG\f\x92\x02\t手机号H\x00R\x06mobile\x12<\n" +
 	"\bpassword\x18\x13 \x01(\tB\x1b\xbaG\x12\x92\x02\x0f用户的密码ڶ\x1a\x02z\x00H\x06R\bpassword\x88\x01\x01\x12\xc2\x02\n" +
 	"\rrefresh_token\x18\x14 \x01(\tB\x96\x02\xbaG\x92\x02\x92\x02\x8e\x02更新令牌，用来获取下一次的访问令牌，可选项。如果访问令牌将过期，则返回刷新令牌很有用，应用程序可以使用该刷新令牌来获取另一个访问令牌。但是，通过隐式授予颁发的令牌不能颁发刷新令牌。H\aR\rrefresh_token\x88\x01\x01\x12p\n" +
-	"\x04code\x18\x1e \x01(\tBW\xbaGT\x92\x02Q授权请求中收到的一次性验证/认证码。(当使用授权码模式时)H\bR\x04code\x88\x01\x01\x12c\n" +
-	"\vclient_type\x18( \x01(\x0e2%.authentication.service.v1.ClientTypeB\x15\xbaG\x12\x92\x02\x0f客户端类型H\tR\vclient_type\x88\x01\x01\x12q\n" +
-	"\tdevice_id\x182 \x01(\tBN\xbaGK\x92\x02H设备唯一标识（可选），用于设备绑定、推送、风控等H\n" +
-	"R\tdevice_id\x88\x01\x01\x12\x84\x01\n" +
-	"\x03jti\x18< \x01(\tBm\xbaGj\x92\x02g建议客户端生成并提供 jti（JWT ID）作为唯一标识，服务端可据此防止重放攻击H\vR\x03jti\x88\x01\x01\x12\x99\x01\n" +
-	"\vtenant_code\x18F \x01(\tBr\xbaGo\x92\x02l租户编号，留空表示平台登录（平台超级管理员）；非空时按该编号解析对应租户H\fR\vtenant_code\x88\x01\x01B\f\n" +
+	"\x04code\x18\x1e \x01(\tBW\xbaGT\x92\x02Q授权请求中收到的一次性验证/认证码。(当使用授权码模式时)H\bR\x04code\x88\x01\x01\x12\x99\x01\n" +
+	"\rcode_verifier\x18\x1f \x01(\tBn\xbaGe\x92\x02bPKCE 校验码，与授权请求中的 code_challenge 对应。(当使用授权码模式时必选)ڶ\x1a\x02z\x00H\tR\rcode_verifier\x88\x01\x01\x12c\n" +
+	"\vclient_type\x18( \x01(\x0e2%.authentication.service.v1.ClientTypeB\x15\xbaG\x12\x92\x02\x0f客户端类型H\n" +
+	"R\vclient_type\x88\x01\x01\x12q\n" +
+	"\tdevice_id\x182 \x01(\tBN\xbaGK\x92\x02H设备唯一标识（可选），用于设备绑定、推送、风控等H\vR\tdevice_id\x88\x01\x01\x12\x84\x01\n" +
+	"\x03jti\x18< \x01(\tBm\xbaGj\x92\x02g建议客户端生成并提供 jti（JWT ID）作为唯一标识，服务端可据此防止重放攻击H\fR\x03jti\x88\x01\x01\x12\x99\x01\n" +
+	"\vtenant_code\x18F \x01(\tBr\xbaGo\x92\x02l租户编号，留空表示平台登录（平台超级管理员）；非空时按该编号解析对应租户H\rR\vtenant_code\x88\x01\x01B\f\n" +
 	"\n" +
 	"identifierB\f\n" +
 	"\n" +
@@ -1517,13 +1816,13 @@ func (x *VerifyCaptchaResponse) GetValid() bool {
 	"\b_user_idB\v\n" +
 	"\t_passwordB\x10\n" +
 	"\x0e_refresh_tokenB\a\n" +
-	"\x05_codeB\x0e\n" +
+	"\x05_codeB\x10\n" +
+	"\x0e_code_verifierB\x0e\n" +
 	"\f_client_typeB\f\n" +
 	"\n" +
 	"_device_idB\x06\n" +
 	"\x04_jtiB\x0e\n" +
-	"\f_tenant_code\"\xb5\n" +
-	"\n" +
+	"\f_tenant_code\"\xea\f\n" +
 	"\rLoginResponse\x12\xdb\x01\n" +
 	"\n" +
 	"token_type\x18\x01 \x01(\x0e2$.authentication.service.v1.TokenTypeB\x94\x01\xbaG\x90\x01\x8a\x02\b\x1a\x06Bearer\x92\x02\x81\x01令牌的类型，该值大小写不敏感，必选项，可以是bearer类型或mac类型，通常只是字符串“Bearer”。R\n" +
@@ -1536,12 +1835,17 @@ func (x *VerifyCaptchaResponse) GetValid() bool {
 	"\x05scope\x18\x05 \x01(\tBw\xbaGt\x92\x02q以空格分隔的用户授予范围列表。如果未提供，scope则授权任何范围，默认为空列表。H\x01R\x05scope\x88\x01\x01\x12\\\n" +
 	"\x12refresh_expires_in\x18\x06 \x01(\x03B'\xbaG$\x92\x02!刷新令牌过期时间（秒）H\x02R\x12refresh_expires_in\x88\x01\x01\x12e\n" +
 	"\bid_token\x18\a \x01(\tBD\xbaGA\x92\x02>ID 令牌，OpenID Connect 扩展中定义的 JWT 格式令牌H\x03R\bid_token\x88\x01\x01\x12\x91\x01\n" +
-	"\x10mfa_operation_id\x18\b \x01(\tB`\xbaG]\x92\x02ZMFA 挑战操作标识。非空表示登录需二次验证，此时 access_token 为空。H\x04R\x10mfa_operation_id\x88\x01\x01B\x10\n" +
+	"\x10mfa_operation_id\x18\b \x01(\tB`\xbaG]\x92\x02ZMFA 挑战操作标识。非空表示登录需二次验证，此时 access_token 为空。H\x04R\x10mfa_operation_id\x88\x01\x01\x12\x80\x01\n" +
+	"\x17mfa_enrollment_required\x18\t \x01(\bBA\xbaG>\x92\x02;为 true 表示须先完成 MFA 注册再进行二次验证H\x05R\x17mfa_enrollment_required\x88\x01\x01\x12w\n" +
+	"\x18password_change_required\x18\n" +
+	" \x01(\bB6\xbaG3\x92\x020为 true 表示密码已过期，须修改密码H\x06R\x18password_change_required\x88\x01\x01B\x10\n" +
 	"\x0e_refresh_tokenB\b\n" +
 	"\x06_scopeB\x15\n" +
 	"\x13_refresh_expires_inB\v\n" +
 	"\t_id_tokenB\x13\n" +
-	"\x11_mfa_operation_id\"\x97\x01\n" +
+	"\x11_mfa_operation_idB\x1a\n" +
+	"\x18_mfa_enrollment_requiredB\x1b\n" +
+	"\x19_password_change_required\"\x97\x01\n" +
 	"\rLogoutRequest\x12'\n" +
 	"\auser_id\x18\x01 \x01(\rB\x0e\xbaG\v\x92\x02\b用户IDR\x06userId\x12]\n" +
 	"\vclient_type\x18\x02 \x01(\x0e2%.authentication.service.v1.ClientTypeB\x15\xbaG\x12\x92\x02\x0f客户端类型R\n" +
@@ -1626,7 +1930,25 @@ func (x *VerifyCaptchaResponse) GetValid() bool {
 	"\n" +
 	"user_input\x18\x02 \x01(\tB$\xbaG!\x92\x02\x1e用户输入的验证码文本R\tuserInput\"}\n" +
 	"\x15VerifyCaptchaResponse\x12d\n" +
-	"\x05valid\x18\x01 \x01(\bBN\xbaGK\x92\x02H验证码验证结果，true表示验证成功，false表示验证失败R\x05valid*j\n" +
+	"\x05valid\x18\x01 \x01(\bBN\xbaGK\x92\x02H验证码验证结果，true表示验证成功，false表示验证失败R\x05valid\"\x98\x02\n" +
+	"\x1bRequestPasswordResetRequest\x12K\n" +
+	"\vtenant_code\x18\x01 \x01(\tB*\xbaG'\x92\x02$租户代码，留空为平台账号R\n" +
+	"tenantCode\x12A\n" +
+	"\aaccount\x18\x02 \x01(\tB'\xe0A\x02\xbaG!\x92\x02\x1e用户名、邮箱或手机号R\aaccount\x12]\n" +
+	"\achannel\x18\x03 \x01(\x0e2*.authentication.service.v1.DeliveryChannelB\x12\xbaG\x0f\x92\x02\f投递渠道H\x00R\achannel\x88\x01\x01B\n" +
+	"\n" +
+	"\b_channel\"\xb7\x01\n" +
+	"\x1bConfirmPasswordResetRequest\x121\n" +
+	"\x05token\x18\x01 \x01(\tB\x1b\xe0A\x02\xbaG\x0f\x92\x02\f重置令牌ڶ\x1a\x02z\x00R\x05token\x12e\n" +
+	"\fnew_password\x18\x02 \x01(\tBB\xe0A\x02\xbaG6\x92\x023新密码（与登录密码相同的加密方式）ڶ\x1a\x02z\x00R\vnewPassword\"\xae\x01\n" +
+	"\x16ActivateAccountRequest\x121\n" +
+	"\x05token\x18\x01 \x01(\tB\x1b\xe0A\x02\xbaG\x0f\x92\x02\f激活令牌ڶ\x1a\x02z\x00R\x05token\x12a\n" +
+	"\bpassword\x18\x02 \x01(\tBE\xe0A\x02\xbaG9\x92\x026登录密码（与登录密码相同的加密方式）ڶ\x1a\x02z\x00R\bpassword\"\xab\x01\n" +
+	"\x15SendActivationRequest\x12'\n" +
+	"\auser_id\x18\x01 \x01(\rB\x0e\xbaG\v\x92\x02\b用户IDR\x06userId\x12]\n" +
+	"\achannel\x18\x02 \x01(\x0e2*.authentication.service.v1.DeliveryChannelB\x12\xbaG\x0f\x92\x02\f投递渠道H\x00R\achannel\x88\x01\x01B\n" +
+	"\n" +
+	"\b_channel*j\n" +
 	"\tGrantType\x12\f\n" +
 	"\bpassword\x10\x00\x12\x16\n" +
 	"\x12client_credentials\x10\x01\x12\x16\n" +
@@ -1645,7 +1967,11 @@ func (x *VerifyCaptchaResponse) GetValid() bool {
 	"\x1aTOKEN_CATEGORY_UNSPECIFIED\x10\x00\x12\n" +
 	"\n" +
 	"\x06ACCESS\x10\x01\x12\v\n" +
-	"\aREFRESH\x10\x022\xda\t\n" +
+	"\aREFRESH\x10\x02*i\n" +
+	"\x0fDeliveryChannel\x12 \n" +
+	"\x1cDELIVERY_CHANNEL_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16DELIVERY_CHANNEL_EMAIL\x10\x01\x12\x18\n" +
+	"\x14DELIVERY_CHANNEL_SMS\x10\x022\x8e\f\n" +
 	"\x15AuthenticationService\x12\\\n" +
 	"\x05Login\x12'.authentication.service.v1.LoginRequest\x1a(.authentication.service.v1.LoginResponse\"\x00\x12L\n" +
 	"\x06Logout\x12(.authentication.service.v1.LogoutRequest\x1a\x16.google.protobuf.Empty\"\x00\x12q\n" +
@@ -1659,7 +1985,10 @@ func (x *VerifyCaptchaResponse) GetValid() bool {
 	"\fUnblockToken\x12..authentication.service.v1.UnblockTokenRequest\x1a\x16.google.protobuf.Empty\"\x00\x12M\n" +
 	"\x06WhoAmI\x12\x16.google.protobuf.Empty\x1a).authentication.service.v1.WhoAmIResponse\"\x00\x12_\n" +
 	"\x0fGenerateCaptcha\x12\x16.google.protobuf.Empty\x1a2.authentication.service.v1.GenerateCaptchaResponse\"\x00\x12t\n" +
-	"\rVerifyCaptcha\x12/.authentication.service.v1.VerifyCaptchaRequest\x1a0.authentication.service.v1.VerifyCaptchaResponse\"\x00B\xff\x01\n" +
+	"\rVerifyCaptcha\x12/.authentication.service.v1.VerifyCaptchaRequest\x1a0.authentication.service.v1.VerifyCaptchaResponse\"\x00\x12h\n" +
+	"\x14RequestPasswordReset\x126.authentication.service.v1.RequestPasswordResetRequest\x1a\x16.google.protobuf.Empty\"\x00\x12h\n" +
+	"\x14ConfirmPasswordReset\x126.authentication.service.v1.ConfirmPasswordResetRequest\x1a\x16.google.protobuf.Empty\"\x00\x12^\n" +
+	"\x0fActivateAccount\x121.authentication.service.v1.ActivateAccountRequest\x1a\x16.google.protobuf.Empty\"\x00B\xff\x01\n" +
 	"\x1dcom.authentication.service.v1B\x13AuthenticationProtoP\x01ZCgo-wind-admin/api/gen/go/authentication/service/v1;authenticationpb\xa2\x02\x03ASX\xaa\x02\x19Authentication.Service.V1\xca\x02\x19Authentication\\Service\\V1\xe2\x02%Authentication\\Service\\V1\\GPBMetadata\xea\x02\x1bAuthentication::Service::V1b\x06proto3"
 
 var (
@@ -1674,34 +2003,39 @@ func file_authentication_service_v1_authentication_proto_rawDescGZIP() []byte {
 	return file_authentication_service_v1_authentication_proto_rawDescData
 }
 
-var file_authentication_service_v1_authentication_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_authentication_service_v1_authentication_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_authentication_service_v1_authentication_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_authentication_service_v1_authentication_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
 var file_authentication_service_v1_authentication_proto_goTypes = []any{
-	(GrantType)(0),                  // 0: authentication.service.v1.GrantType
-	(TokenType)(0),                  // 1: authentication.service.v1.TokenType
-	(ClientType)(0),                 // 2: authentication.service.v1.ClientType
-	(TokenCategory)(0),              // 3: authentication.service.v1.TokenCategory
-	(*LoginRequest)(nil),            // 4: authentication.service.v1.LoginRequest
-	(*LoginResponse)(nil),           // 5: authentication.service.v1.LoginResponse
-	(*LogoutRequest)(nil),           // 6: authentication.service.v1.LogoutRequest
-	(*ValidateTokenRequest)(nil),    // 7: authentication.service.v1.ValidateTokenRequest
-	(*ValidateTokenResponse)(nil),   // 8: authentication.service.v1.ValidateTokenResponse
-	(*RegisterUserRequest)(nil),     // 9: authentication.service.v1.RegisterUserRequest
-	(*RegisterUserResponse)(nil),    // 10: authentication.service.v1.RegisterUserResponse
-	(*WhoAmIResponse)(nil),          // 11: authentication.service.v1.WhoAmIResponse
-	(*GetAccessTokensRequest)(nil),  // 12: authentication.service.v1.GetAccessTokensRequest
-	(*GetAccessTokensResponse)(nil), // 13: authentication.service.v1.GetAccessTokensResponse
-	(*BlockTokenRequest)(nil),       // 14: authentication.service.v1.BlockTokenRequest
-	(*UnblockTokenRequest)(nil),     // 15: authentication.service.v1.UnblockTokenRequest
-	(*BlockTokenResponse)(nil),      // 16: authentication.service.v1.BlockTokenResponse
-	(*RevokeTokenByIdRequest)(nil),  // 17: authentication.service.v1.RevokeTokenByIdRequest
-	(*GenerateCaptchaResponse)(nil), // 18: authentication.service.v1.GenerateCaptchaResponse
-	(*VerifyCaptchaRequest)(nil),    // 19: authentication.service.v1.VerifyCaptchaRequest
-	(*VerifyCaptchaResponse)(nil),   // 20: authentication.service.v1.VerifyCaptchaResponse
-	(*UserTokenPayload)(nil),        // 21: authentication.service.v1.UserTokenPayload
-	(*durationpb.Duration)(nil),     // 22: google.protobuf.Duration
-	(*timestamppb.Timestamp)(nil),   // 23: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),           // 24: google.protobuf.Empty
+	(GrantType)(0),                      // 0: authentication.service.v1.GrantType
+	(TokenType)(0),                      // 1: authentication.service.v1.TokenType
+	(ClientType)(0),                     // 2: authentication.service.v1.ClientType
+	(TokenCategory)(0),                  // 3: authentication.service.v1.TokenCategory
+	(DeliveryChannel)(0),                // 4: authentication.service.v1.DeliveryChannel
+	(*LoginRequest)(nil),                // 5: authentication.service.v1.LoginRequest
+	(*LoginResponse)(nil),               // 6: authentication.service.v1.LoginResponse
+	(*LogoutRequest)(nil),               // 7: authentication.service.v1.LogoutRequest
+	(*ValidateTokenRequest)(nil),        // 8: authentication.service.v1.ValidateTokenRequest
+	(*ValidateTokenResponse)(nil),       // 9: authentication.service.v1.ValidateTokenResponse
+	(*RegisterUserRequest)(nil),         // 10: authentication.service.v1.RegisterUserRequest
+	(*RegisterUserResponse)(nil),        // 11: authentication.service.v1.RegisterUserResponse
+	(*WhoAmIResponse)(nil),              // 12: authentication.service.v1.WhoAmIResponse
+	(*GetAccessTokensRequest)(nil),      // 13: authentication.service.v1.GetAccessTokensRequest
+	(*GetAccessTokensResponse)(nil),     // 14: authentication.service.v1.GetAccessTokensResponse
+	(*BlockTokenRequest)(nil),           // 15: authentication.service.v1.BlockTokenRequest
+	(*UnblockTokenRequest)(nil),         // 16: authentication.service.v1.UnblockTokenRequest
+	(*BlockTokenResponse)(nil),          // 17: authentication.service.v1.BlockTokenResponse
+	(*RevokeTokenByIdRequest)(nil),      // 18: authentication.service.v1.RevokeTokenByIdRequest
+	(*GenerateCaptchaResponse)(nil),     // 19: authentication.service.v1.GenerateCaptchaResponse
+	(*VerifyCaptchaRequest)(nil),        // 20: authentication.service.v1.VerifyCaptchaRequest
+	(*VerifyCaptchaResponse)(nil),       // 21: authentication.service.v1.VerifyCaptchaResponse
+	(*RequestPasswordResetRequest)(nil), // 22: authentication.service.v1.RequestPasswordResetRequest
+	(*ConfirmPasswordResetRequest)(nil), // 23: authentication.service.v1.ConfirmPasswordResetRequest
+	(*ActivateAccountRequest)(nil),      // 24: authentication.service.v1.ActivateAccountRequest
+	(*SendActivationRequest)(nil),       // 25: authentication.service.v1.SendActivationRequest
+	(*UserTokenPayload)(nil),            // 26: authentication.service.v1.UserTokenPayload
+	(*durationpb.Duration)(nil),         // 27: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil),       // 28: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),               // 29: google.protobuf.Empty
 }
 var file_authentication_service_v1_authentication_proto_depIdxs = []int32{
 	0,  // 0: authentication.service.v1.LoginRequest.grant_type:type_name -> authentication.service.v1.GrantType
@@ -1710,43 +2044,51 @@ func file_authentication_service_v1_authentication_proto_rawDescGZIP() []byte {
 	2,  // 3: authentication.service.v1.LogoutRequest.client_type:type_name -> authentication.service.v1.ClientType
 	2,  // 4: authentication.service.v1.ValidateTokenRequest.client_type:type_name -> authentication.service.v1.ClientType
 	3,  // 5: authentication.service.v1.ValidateTokenRequest.token_category:type_name -> authentication.service.v1.TokenCategory
-	21, // 6: authentication.service.v1.ValidateTokenResponse.payload:type_name -> authentication.service.v1.UserTokenPayload
+	26, // 6: authentication.service.v1.ValidateTokenResponse.payload:type_name -> authentication.service.v1.UserTokenPayload
 	2,  // 7: authentication.service.v1.RegisterUserRequest.client_type:type_name -> authentication.service.v1.ClientType
 	2,  // 8: authentication.service.v1.GetAccessTokensRequest.client_type:type_name -> authentication.service.v1.ClientType
 	2,  // 9: authentication.service.v1.BlockTokenRequest.client_type:type_name -> authentication.service.v1.ClientType
-	22, // 10: authentication.service.v1.BlockTokenRequest.duration:type_name -> google.protobuf.Duration
+	27, // 10: authentication.service.v1.BlockTokenRequest.duration:type_name -> google.protobuf.Duration
 	2,  // 11: authentication.service.v1.UnblockTokenRequest.client_type:type_name -> authentication.service.v1.ClientType
-	23, // 12: authentication.service.v1.BlockTokenResponse.blocked_until:type_name -> google.protobuf.Timestamp
+	28, // 12: authentication.service.v1.BlockTokenResponse.blocked_until:type_name -> google.protobuf.Timestamp
 	2,  // 13: authentication.service.v1.RevokeTokenByIdRequest.client_type:type_name -> authentication.service.v1.ClientType
-	4,  // 14: authentication.service.v1.AuthenticationService.Login:input_type -> authentication.service.v1.LoginRequest
-	6,  // 15: authentication.service.v1.AuthenticationService.Logout:input_type -> authentication.service.v1.LogoutRequest
-	9,  // 16: authentication.service.v1.AuthenticationService.RegisterUser:input_type -> authentication.service.v1.RegisterUserRequest
-	4,  // 17: authentication.service.v1.AuthenticationService.RefreshToken:input_type -> authentication.service.v1.LoginRequest
-	7,  // 18: authentication.service.v1.AuthenticationService.ValidateToken:input_type -> authentication.service.v1.ValidateTokenRequest
-	12, // 19: authentication.service.v1.AuthenticationService.GetAccessTokens:input_type -> authentication.service.v1.GetAccessTokensRequest
-	17, // 20: authentication.service.v1.AuthenticationService.RevokeTokenById:input_type -> authentication.service.v1.RevokeTokenByIdRequest
-	14, // 21: authentication.service.v1.AuthenticationService.BlockToken:input_type -> authentication.service.v1.BlockTokenRequest
-	15, // 22: authentication.service.v1.AuthenticationService.UnblockToken:input_type -> authentication.service.v1.UnblockTokenRequest
-	24, // 23: authentication.service.v1.AuthenticationService.WhoAmI:input_type -> google.protobuf.Empty
-	24, // 24: authentication.service.v1.AuthenticationService.GenerateCaptcha:input_type -> google.protobuf.Empty
-	19, // 25: authentication.service.v1.AuthenticationService.VerifyCaptcha:input_type -> authentication.service.v1.VerifyCaptchaRequest
-	5,  // 26: authentication.service.v1.AuthenticationService.Login:output_type -> authentication.service.v1.LoginResponse
-	24, // 27: authentication.service.v1.AuthenticationService.Logout:output_type -> google.protobuf.Empty
-	10, // 28: authentication.service.v1.AuthenticationService.RegisterUser:output_type -> authentication.service.v1.RegisterUserResponse
-	5,  // 29: authentication.service.v1.AuthenticationService.RefreshToken:output_type -> authentication.service.v1.LoginResponse
-	8,  // 30: authentication.service.v1.AuthenticationService.ValidateToken:output_type -> authentication.service.v1.ValidateTokenResponse
-	13, // 31: authentication.service.v1.AuthenticationService.GetAccessTokens:output_type -> authentication.service.v1.GetAccessTokensResponse
-	24, // 32: authentication.service.v1.AuthenticationService.RevokeTokenById:output_type -> google.protobuf.Empty
-	16, // 33: authentication.service.v1.AuthenticationService.BlockToken:output_type -> authentication.service.v1.BlockTokenResponse
-	24, // 34: authentication.service.v1.AuthenticationService.UnblockToken:output_type -> google.protobuf.Empty
-	11, // 35: authentication.service.v1.AuthenticationService.WhoAmI:output_type -> authentication.service.v1.WhoAmIResponse
-	18, // 36: authentication.service.v1.AuthenticationService.GenerateCaptcha:output_type -> authentication.service.v1.GenerateCaptchaResponse
-	20, // 37: authentication.service.v1.AuthenticationService.VerifyCaptcha:output_type -> authentication.service.v1.VerifyCaptchaResponse
-	26, // [26:38] is the sub-list for method output_type
-	14, // [14:26] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	4,  // 14: authentication.service.v1.RequestPasswordResetRequest.channel:type_name -> authentication.service.v1.DeliveryChannel
+	4,  // 15: authentication.service.v1.SendActivationRequest.channel:type_name -> authentication.service.v1.DeliveryChannel
+	5,  // 16: authentication.service.v1.AuthenticationService.Login:input_type -> authentication.service.v1.LoginRequest
+	7,  // 17: authentication.service.v1.AuthenticationService.Logout:input_type -> authentication.service.v1.LogoutRequest
+	10, // 18: authentication.service.v1.AuthenticationService.RegisterUser:input_type -> authentication.service.v1.RegisterUserRequest
+	5,  // 19: authentication.service.v1.AuthenticationService.RefreshToken:input_type -> authentication.service.v1.LoginRequest
+	8,  // 20: authentication.service.v1.AuthenticationService.ValidateToken:input_type -> authentication.service.v1.ValidateTokenRequest
+	13, // 21: authentication.service.v1.AuthenticationService.GetAccessTokens:input_type -> authentication.service.v1.GetAccessTokensRequest
+	18, // 22: authentication.service.v1.AuthenticationService.RevokeTokenById:input_type -> authentication.service.v1.RevokeTokenByIdRequest
+	15, // 23: authentication.service.v1.AuthenticationService.BlockToken:input_type -> authentication.service.v1.BlockTokenRequest
+	16, // 24: authentication.service.v1.AuthenticationService.UnblockToken:input_type -> authentication.service.v1.UnblockTokenRequest
+	29, // 25: authentication.service.v1.AuthenticationService.WhoAmI:input_type -> google.protobuf.Empty
+	29, // 26: authentication.service.v1.AuthenticationService.GenerateCaptcha:input_type -> google.protobuf.Empty
+	20, // 27: authentication.service.v1.AuthenticationService.VerifyCaptcha:input_type -> authentication.service.v1.VerifyCaptchaRequest
+	22, // 28: authentication.service.v1.AuthenticationService.RequestPasswordReset:input_type -> authentication.service.v1.RequestPasswordResetRequest
+	23, // 29: authentication.service.v1.AuthenticationService.ConfirmPasswordReset:input_type -> authentication.service.v1.ConfirmPasswordResetRequest
+	24, // 30: authentication.service.v1.AuthenticationService.ActivateAccount:input_type -> authentication.service.v1.ActivateAccountRequest
+	6,  // 31: authentication.service.v1.AuthenticationService.Login:output_type -> authentication.service.v1.LoginResponse
+	29, // 32: authentication.service.v1.AuthenticationService.Logout:output_type -> google.protobuf.Empty
+	11, // 33: authentication.service.v1.AuthenticationService.RegisterUser:output_type -> authentication.service.v1.RegisterUserResponse
+	6,  // 34: authentication.service.v1.AuthenticationService.RefreshToken:output_type -> authentication.service.v1.LoginResponse
+	9,  // 35: authentication.service.v1.AuthenticationService.ValidateToken:output_type -> authentication.service.v1.ValidateTokenResponse
+	14, // 36: authentication.service.v1.AuthenticationService.GetAccessTokens:output_type -> authentication.service.v1.GetAccessTokensResponse
+	29, // 37: authentication.service.v1.AuthenticationService.RevokeTokenById:output_type -> google.protobuf.Empty
+	17, // 38: authentication.service.v1.AuthenticationService.BlockToken:output_type -> authentication.service.v1.BlockTokenResponse
+	29, // 39: authentication.service.v1.AuthenticationService.UnblockToken:output_type -> google.protobuf.Empty
+	12, // 40: authentication.service.v1.AuthenticationService.WhoAmI:output_type -> authentication.service.v1.WhoAmIResponse
+	19, // 41: authentication.service.v1.AuthenticationService.GenerateCaptcha:output_type -> authentication.service.v1.GenerateCaptchaResponse
+	21, // 42: authentication.service.v1.AuthenticationService.VerifyCaptcha:output_type -> authentication.service.v1.VerifyCaptchaResponse
+	29, // 43: authentication.service.v1.AuthenticationService.RequestPasswordReset:output_type -> google.protobuf.Empty
+	29, // 44: authentication.service.v1.AuthenticationService.ConfirmPasswordReset:output_type -> google.protobuf.Empty
+	29, // 45: authentication.service.v1.AuthenticationService.ActivateAccount:output_type -> google.protobuf.Empty
+	31, // [31:46] is the sub-list for method output_type
+	16, // [16:31] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_authentication_service_v1_authentication_proto_init() }
@@ -1773,13 +2115,15 @@ func file_authentication_service_v1_authentication_proto_init() {
 		(*UnblockTokenRequest_Jti)(nil),
 	}
 	file_authentication_service_v1_authentication_proto_msgTypes[13].OneofWrappers = []any{}
+	file_authentication_service_v1_authentication_proto_msgTypes[17].OneofWrappers = []any{}
+	file_authentication_service_v1_authentication_proto_msgTypes[20].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_authentication_service_v1_authentication_proto_rawDesc), len(file_authentication_service_v1_authentication_proto_rawDesc)),
-			NumEnums:      4,
-			NumMessages:   17,
+			NumEnums:      5,
+			NumMessages:   21,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
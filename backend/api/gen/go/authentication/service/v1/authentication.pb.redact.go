@@ -181,6 +181,39 @@ func (s *redactedAuthenticationServiceServer) VerifyCaptcha(ctx context.Context,
 	return res, err
 }
 
+// RequestPasswordReset is the redacted wrapper for the actual AuthenticationServiceServer.RequestPasswordReset method
+// Unary RPC
+func (s *redactedAuthenticationServiceServer) RequestPasswordReset(ctx context.Context, in *RequestPasswordResetRequest) (*emptypb.Empty, error) {
+	res, err := s.srv.RequestPasswordReset(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// ConfirmPasswordReset is the redacted wrapper for the actual AuthenticationServiceServer.ConfirmPasswordReset method
+// Unary RPC
+func (s *redactedAuthenticationServiceServer) ConfirmPasswordReset(ctx context.Context, in *ConfirmPasswordResetRequest) (*emptypb.Empty, error) {
+	res, err := s.srv.ConfirmPasswordReset(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// ActivateAccount is the redacted wrapper for the actual AuthenticationServiceServer.ActivateAccount method
+// Unary RPC
+func (s *redactedAuthenticationServiceServer) ActivateAccount(ctx context.Context, in *ActivateAccountRequest) (*emptypb.Empty, error) {
+	res, err := s.srv.ActivateAccount(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
 // Ensure LoginRequest implements the Redactor interface at compile time.
 var _ redact.Redactor = (*LoginRequest)(nil)
 
@@ -210,6 +243,10 @@ func (x *LoginRequest) Redact() {
 
 	// Safe field: Code
 
+	// Redacting field: CodeVerifier
+	CodeVerifierTmp := ``
+	x.CodeVerifier = &CodeVerifierTmp
+
 	// Safe field: ClientType
 
 	// Safe field: DeviceId
@@ -243,6 +280,10 @@ func (x *LoginResponse) Redact() {
 	// Safe field: IdToken
 
 	// Safe field: MfaOperationId
+
+	// Safe field: MfaEnrollmentRequired
+
+	// Safe field: PasswordChangeRequired
 }
 
 // Ensure LogoutRequest implements the Redactor interface at compile time.
@@ -470,3 +511,65 @@ func (x *VerifyCaptchaResponse) Redact() {
 
 	// Safe field: Valid
 }
+
+// Ensure RequestPasswordResetRequest implements the Redactor interface at compile time.
+var _ redact.Redactor = (*RequestPasswordResetRequest)(nil)
+
+// Redact method implementation for RequestPasswordResetRequest
+func (x *RequestPasswordResetRequest) Redact() {
+	if x == nil {
+		return
+	}
+
+	// Safe field: TenantCode
+
+	// Safe field: Account
+
+	// Safe field: Channel
+}
+
+// Ensure ConfirmPasswordResetRequest implements the Redactor interface at compile time.
+var _ redact.Redactor = (*ConfirmPasswordResetRequest)(nil)
+
+// Redact method implementation for ConfirmPasswordResetRequest
+func (x *ConfirmPasswordResetRequest) Redact() {
+	if x == nil {
+		return
+	}
+
+	// Redacting field: Token
+	x.Token = ``
+
+	// Redacting field: NewPassword
+	x.NewPassword = ``
+}
+
+// Ensure ActivateAccountRequest implements the Redactor interface at compile time.
+var _ redact.Redactor = (*ActivateAccountRequest)(nil)
+
+// Redact method implementation for ActivateAccountRequest
+func (x *ActivateAccountRequest) Redact() {
+	if x == nil {
+		return
+	}
+
+	// Redacting field: Token
+	x.Token = ``
+
+	// Redacting field: Password
+	x.Password = ``
+}
+
+// Ensure SendActivationRequest implements the Redactor interface at compile time.
+var _ redact.Redactor = (*SendActivationRequest)(nil)
+
+// Redact method implementation for SendActivationRequest
+func (x *SendActivationRequest) Redact() {
+	if x == nil {
+		return
+	}
+
+	// Safe field: UserId
+
+	// Safe field: Channel
+}
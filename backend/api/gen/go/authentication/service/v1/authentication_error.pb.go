@@ -27,11 +27,12 @@
 
 const (
 	// 400
-	AuthenticationErrorReason_BAD_REQUEST        AuthenticationErrorReason = 0 // 错误请求
-	AuthenticationErrorReason_INVALID_GRANT_TYPE AuthenticationErrorReason = 1 // 400
-	AuthenticationErrorReason_INVALID_USERID     AuthenticationErrorReason = 2 // 用户ID无效
-	AuthenticationErrorReason_INVALID_TOKEN      AuthenticationErrorReason = 3 // token无效
-	AuthenticationErrorReason_INVALID_PASSWORD   AuthenticationErrorReason = 4 // 密码无效
+	AuthenticationErrorReason_BAD_REQUEST               AuthenticationErrorReason = 0 // 错误请求
+	AuthenticationErrorReason_INVALID_GRANT_TYPE        AuthenticationErrorReason = 1 // 400
+	AuthenticationErrorReason_INVALID_USERID            AuthenticationErrorReason = 2 // 用户ID无效
+	AuthenticationErrorReason_INVALID_TOKEN             AuthenticationErrorReason = 3 // token无效
+	AuthenticationErrorReason_INVALID_PASSWORD          AuthenticationErrorReason = 4 // 密码无效
+	AuthenticationErrorReason_PASSWORD_POLICY_VIOLATION AuthenticationErrorReason = 5 // 密码不符合密码策略（metadata.violations 列出违反的规则代码）
 	// 401
 	AuthenticationErrorReason_UNAUTHORIZED            AuthenticationErrorReason = 100 // 未授权
 	AuthenticationErrorReason_USER_FREEZE             AuthenticationErrorReason = 101 // 用户被冻结
@@ -43,7 +44,8 @@
 	// 402
 	AuthenticationErrorReason_PAYMENT_REQUIRED AuthenticationErrorReason = 200 // 需要支付
 	// 403
-	AuthenticationErrorReason_FORBIDDEN AuthenticationErrorReason = 300 // 禁止访问
+	AuthenticationErrorReason_FORBIDDEN                 AuthenticationErrorReason = 300 // 禁止访问
+	AuthenticationErrorReason_REAUTHENTICATION_REQUIRED AuthenticationErrorReason = 301 // 敏感操作需重新验证身份（metadata.max_age 为要求的验证时效秒数）
 	// 404
 	AuthenticationErrorReason_NOT_FOUND               AuthenticationErrorReason = 400 // 找不到资源
 	AuthenticationErrorReason_USER_NOT_FOUND          AuthenticationErrorReason = 401 // 用户不存在
@@ -133,6 +135,7 @@
 		2:    "INVALID_USERID",
 		3:    "INVALID_TOKEN",
 		4:    "INVALID_PASSWORD",
+		5:    "PASSWORD_POLICY_VIOLATION",
 		100:  "UNAUTHORIZED",
 		101:  "USER_FREEZE",
 		103:  "INCORRECT_APP_SECRET",
@@ -142,6 +145,7 @@
 		107:  "TOKEN_NOT_EXIST",
 		200:  "PAYMENT_REQUIRED",
 		300:  "FORBIDDEN",
+		301:  "REAUTHENTICATION_REQUIRED",
 		400:  "NOT_FOUND",
 		401:  "USER_NOT_FOUND",
 		402:  "ACCESS_TOKEN_NOT_FOUND",
@@ -190,6 +194,7 @@
 		"INVALID_USERID":                  2,
 		"INVALID_TOKEN":                   3,
 		"INVALID_PASSWORD":                4,
+		"PASSWORD_POLICY_VIOLATION":       5,
 		"UNAUTHORIZED":                    100,
 		"USER_FREEZE":                     101,
 		"INCORRECT_APP_SECRET":            103,
@@ -199,6 +204,7 @@
 		"TOKEN_NOT_EXIST":                 107,
 		"PAYMENT_REQUIRED":                200,
 		"FORBIDDEN":                       300,
+		"REAUTHENTICATION_REQUIRED":       301,
 		"NOT_FOUND":                       400,
 		"USER_NOT_FOUND":                  401,
 		"ACCESS_TOKEN_NOT_FOUND":          402,
@@ -274,13 +280,14 @@ func (AuthenticationErrorReason) EnumDescriptor() ([]byte, []int) {
 
 const file_authentication_service_v1_authentication_error_proto_rawDesc = "" +
 	"\n" +
-	"4authentication/service/v1/authentication_error.proto\x12\x19authentication.service.v1\x1a\x13errors/errors.proto*\x96\r\n" +
+	"4authentication/service/v1/authentication_error.proto\x12\x19authentication.service.v1\x1a\x13errors/errors.proto*\xe1\r\n" +
 	"\x19AuthenticationErrorReason\x12\x15\n" +
 	"\vBAD_REQUEST\x10\x00\x1a\x04\xa8E\x90\x03\x12\x1c\n" +
 	"\x12INVALID_GRANT_TYPE\x10\x01\x1a\x04\xa8E\x90\x03\x12\x18\n" +
 	"\x0eINVALID_USERID\x10\x02\x1a\x04\xa8E\x90\x03\x12\x17\n" +
 	"\rINVALID_TOKEN\x10\x03\x1a\x04\xa8E\x90\x03\x12\x1a\n" +
-	"\x10INVALID_PASSWORD\x10\x04\x1a\x04\xa8E\x90\x03\x12\x16\n" +
+	"\x10INVALID_PASSWORD\x10\x04\x1a\x04\xa8E\x90\x03\x12#\n" +
+	"\x19PASSWORD_POLICY_VIOLATION\x10\x05\x1a\x04\xa8E\x90\x03\x12\x16\n" +
 	"\fUNAUTHORIZED\x10d\x1a\x04\xa8E\x91\x03\x12\x15\n" +
 	"\vUSER_FREEZE\x10e\x1a\x04\xa8E\x91\x03\x12\x1e\n" +
 	"\x14INCORRECT_APP_SECRET\x10g\x1a\x04\xa8E\x91\x03\x12 \n" +
@@ -289,7 +296,8 @@ func (AuthenticationErrorReason) EnumDescriptor() ([]byte, []int) {
 	"\rTOKEN_EXPIRED\x10j\x1a\x04\xa8E\x91\x03\x12\x19\n" +
 	"\x0fTOKEN_NOT_EXIST\x10k\x1a\x04\xa8E\x91\x03\x12\x1b\n" +
 	"\x10PAYMENT_REQUIRED\x10\xc8\x01\x1a\x04\xa8E\x92\x03\x12\x14\n" +
-	"\tFORBIDDEN\x10\xac\x02\x1a\x04\xa8E\x93\x03\x12\x14\n" +
+	"\tFORBIDDEN\x10\xac\x02\x1a\x04\xa8E\x93\x03\x12$\n" +
+	"\x19REAUTHENTICATION_REQUIRED\x10\xad\x02\x1a\x04\xa8E\x93\x03\x12\x14\n" +
 	"\tNOT_FOUND\x10\x90\x03\x1a\x04\xa8E\x94\x03\x12\x19\n" +
 	"\x0eUSER_NOT_FOUND\x10\x91\x03\x1a\x04\xa8E\x94\x03\x12!\n" +
 	"\x16ACCESS_TOKEN_NOT_FOUND\x10\x92\x03\x1a\x04\xa8E\x94\x03\x12\"\n" +
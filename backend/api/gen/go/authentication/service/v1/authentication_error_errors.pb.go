@@ -81,6 +81,20 @@ func ErrorInvalidPassword(format string, args ...interface{}) *errors.Error {
 	return errors.New(400, AuthenticationErrorReason_INVALID_PASSWORD.String(), fmt.Sprintf(format, args...))
 }
 
+// 密码不符合密码策略（metadata.violations 列出违反的规则代码）
+func IsPasswordPolicyViolation(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == AuthenticationErrorReason_PASSWORD_POLICY_VIOLATION.String() && e.Code == 400
+}
+
+// 密码不符合密码策略（metadata.violations 列出违反的规则代码）
+func ErrorPasswordPolicyViolation(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, AuthenticationErrorReason_PASSWORD_POLICY_VIOLATION.String(), fmt.Sprintf(format, args...))
+}
+
 // 401
 func IsUnauthorized(err error) bool {
 	if err == nil {
@@ -207,6 +221,20 @@ func ErrorForbidden(format string, args ...interface{}) *errors.Error {
 	return errors.New(403, AuthenticationErrorReason_FORBIDDEN.String(), fmt.Sprintf(format, args...))
 }
 
+// 敏感操作需重新验证身份（metadata.max_age 为要求的验证时效秒数）
+func IsReauthenticationRequired(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == AuthenticationErrorReason_REAUTHENTICATION_REQUIRED.String() && e.Code == 403
+}
+
+// 敏感操作需重新验证身份（metadata.max_age 为要求的验证时效秒数）
+func ErrorReauthenticationRequired(format string, args ...interface{}) *errors.Error {
+	return errors.New(403, AuthenticationErrorReason_REAUTHENTICATION_REQUIRED.String(), fmt.Sprintf(format, args...))
+}
+
 // 404
 func IsNotFound(err error) bool {
 	if err == nil {
@@ -136,23 +136,81 @@ func (LoginPolicy_Method) EnumDescriptor() ([]byte, []int) {
 	return file_authentication_service_v1_login_policy_proto_rawDescGZIP(), []int{0, 1}
 }
 
+// 登录策略生效对象
+type LoginPolicy_TargetType int32
+
+const (
+	LoginPolicy_LOGIN_POLICY_TARGET_TYPE_UNSPECIFIED LoginPolicy_TargetType = 0 // 未指定：目标ID为空时约束所有用户，否则约束该用户
+	LoginPolicy_GLOBAL                               LoginPolicy_TargetType = 1 // 全局，约束所有用户
+	LoginPolicy_USER                                 LoginPolicy_TargetType = 2 // 指定用户，目标ID为用户ID
+	LoginPolicy_ROLE                                 LoginPolicy_TargetType = 3 // 指定角色，目标编码为角色码
+	LoginPolicy_ORG_UNIT                             LoginPolicy_TargetType = 4 // 指定组织单元，目标ID为组织单元ID，同时约束其下级组织单元的成员
+)
+
+// Enum value maps for LoginPolicy_TargetType.
+var (
+	LoginPolicy_TargetType_name = map[int32]string{
+		0: "LOGIN_POLICY_TARGET_TYPE_UNSPECIFIED",
+		1: "GLOBAL",
+		2: "USER",
+		3: "ROLE",
+		4: "ORG_UNIT",
+	}
+	LoginPolicy_TargetType_value = map[string]int32{
+		"LOGIN_POLICY_TARGET_TYPE_UNSPECIFIED": 0,
+		"GLOBAL":                               1,
+		"USER":                                 2,
+		"ROLE":                                 3,
+		"ORG_UNIT":                             4,
+	}
+)
+
+func (x LoginPolicy_TargetType) Enum() *LoginPolicy_TargetType {
+	p := new(LoginPolicy_TargetType)
+	*p = x
+	return p
+}
+
+func (x LoginPolicy_TargetType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LoginPolicy_TargetType) Descriptor() protoreflect.EnumDescriptor {
+	return file_authentication_service_v1_login_policy_proto_enumTypes[2].Descriptor()
+}
+
+func (LoginPolicy_TargetType) Type() protoreflect.EnumType {
+	return &file_authentication_service_v1_login_policy_proto_enumTypes[2]
+}
+
+func (x LoginPolicy_TargetType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LoginPolicy_TargetType.Descriptor instead.
+func (LoginPolicy_TargetType) EnumDescriptor() ([]byte, []int) {
+	return file_authentication_service_v1_login_policy_proto_rawDescGZIP(), []int{0, 2}
+}
+
 // 登录策略
 type LoginPolicy struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            *uint32                `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id,omitempty"`                                                           // 登录策略ID
-	TargetId      *uint32                `protobuf:"varint,2,opt,name=target_id,json=targetId,proto3,oneof" json:"target_id,omitempty"`                               // 目标用户ID
-	Type          *LoginPolicy_Type      `protobuf:"varint,3,opt,name=type,proto3,enum=authentication.service.v1.LoginPolicy_Type,oneof" json:"type,omitempty"`       // 限制类型
-	Method        *LoginPolicy_Method    `protobuf:"varint,4,opt,name=method,proto3,enum=authentication.service.v1.LoginPolicy_Method,oneof" json:"method,omitempty"` // 限制方式
-	Value         *string                `protobuf:"bytes,5,opt,name=value,proto3,oneof" json:"value,omitempty"`                                                      // 限制值（如IP地址、MAC地址或地区代码）
-	Reason        *string                `protobuf:"bytes,6,opt,name=reason,proto3,oneof" json:"reason,omitempty"`                                                    // 限制原因
-	TenantId      *uint32                `protobuf:"varint,40,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`                              // 租户ID，0代表系统全局角色
-	TenantName    *string                `protobuf:"bytes,41,opt,name=tenant_name,json=tenantName,proto3,oneof" json:"tenant_name,omitempty"`                         // 租户名称
-	CreatedBy     *uint32                `protobuf:"varint,100,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by,omitempty"`                          // 创建者ID
-	UpdatedBy     *uint32                `protobuf:"varint,101,opt,name=updated_by,json=updatedBy,proto3,oneof" json:"updated_by,omitempty"`                          // 更新者ID
-	DeletedBy     *uint32                `protobuf:"varint,102,opt,name=deleted_by,json=deletedBy,proto3,oneof" json:"deleted_by,omitempty"`                          // 删除者用户ID
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,200,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at,omitempty"`                           // 创建时间
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,201,opt,name=updated_at,json=updatedAt,proto3,oneof" json:"updated_at,omitempty"`                           // 更新时间
-	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,202,opt,name=deleted_at,json=deletedAt,proto3,oneof" json:"deleted_at,omitempty"`                           // 删除时间
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Id            *uint32                 `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id,omitempty"`                                                                                         // 登录策略ID
+	TargetId      *uint32                 `protobuf:"varint,2,opt,name=target_id,json=targetId,proto3,oneof" json:"target_id,omitempty"`                                                             // 目标ID（用户ID或组织单元ID）
+	Type          *LoginPolicy_Type       `protobuf:"varint,3,opt,name=type,proto3,enum=authentication.service.v1.LoginPolicy_Type,oneof" json:"type,omitempty"`                                     // 限制类型
+	Method        *LoginPolicy_Method     `protobuf:"varint,4,opt,name=method,proto3,enum=authentication.service.v1.LoginPolicy_Method,oneof" json:"method,omitempty"`                               // 限制方式
+	Value         *string                 `protobuf:"bytes,5,opt,name=value,proto3,oneof" json:"value,omitempty"`                                                                                    // 限制值（如IP地址、MAC地址或地区代码）
+	Reason        *string                 `protobuf:"bytes,6,opt,name=reason,proto3,oneof" json:"reason,omitempty"`                                                                                  // 限制原因
+	TargetType    *LoginPolicy_TargetType `protobuf:"varint,7,opt,name=target_type,json=targetType,proto3,enum=authentication.service.v1.LoginPolicy_TargetType,oneof" json:"target_type,omitempty"` // 生效对象类型
+	TargetCode    *string                 `protobuf:"bytes,8,opt,name=target_code,json=targetCode,proto3,oneof" json:"target_code,omitempty"`                                                        // 目标编码（角色码）
+	TenantId      *uint32                 `protobuf:"varint,40,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`                                                            // 租户ID，0代表系统全局角色
+	TenantName    *string                 `protobuf:"bytes,41,opt,name=tenant_name,json=tenantName,proto3,oneof" json:"tenant_name,omitempty"`                                                       // 租户名称
+	CreatedBy     *uint32                 `protobuf:"varint,100,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by,omitempty"`                                                        // 创建者ID
+	UpdatedBy     *uint32                 `protobuf:"varint,101,opt,name=updated_by,json=updatedBy,proto3,oneof" json:"updated_by,omitempty"`                                                        // 更新者ID
+	DeletedBy     *uint32                 `protobuf:"varint,102,opt,name=deleted_by,json=deletedBy,proto3,oneof" json:"deleted_by,omitempty"`                                                        // 删除者用户ID
+	CreatedAt     *timestamppb.Timestamp  `protobuf:"bytes,200,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at,omitempty"`                                                         // 创建时间
+	UpdatedAt     *timestamppb.Timestamp  `protobuf:"bytes,201,opt,name=updated_at,json=updatedAt,proto3,oneof" json:"updated_at,omitempty"`                                                         // 更新时间
+	DeletedAt     *timestamppb.Timestamp  `protobuf:"bytes,202,opt,name=deleted_at,json=deletedAt,proto3,oneof" json:"deleted_at,omitempty"`                                                         // 删除时间
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -229,6 +287,20 @@ func (x *LoginPolicy) GetReason() string {
 	return ""
 }
 
+func (x *LoginPolicy) GetTargetType() LoginPolicy_TargetType {
+	if x != nil && x.TargetType != nil {
+		return *x.TargetType
+	}
+	return LoginPolicy_LOGIN_POLICY_TARGET_TYPE_UNSPECIFIED
+}
+
+func (x *LoginPolicy) GetTargetCode() string {
+	if x != nil && x.TargetCode != nil {
+		return *x.TargetCode
+	}
+	return ""
+}
+
 func (x *LoginPolicy) GetTenantId() uint32 {
 	if x != nil && x.TenantId != nil {
 		return *x.TenantId
@@ -638,35 +710,229 @@ func (x *CountLoginPolicyResponse) GetCount() uint64 {
 	return 0
 }
 
+// 模拟判定登录策略 - 请求
+type EvaluateLoginPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        *uint32                `protobuf:"varint,1,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`         // 用户ID，为空时只判定约束所有用户的策略
+	Ip            *string                `protobuf:"bytes,2,opt,name=ip,proto3,oneof" json:"ip,omitempty"`                                // 客户端IP地址
+	DeviceId      *string                `protobuf:"bytes,3,opt,name=device_id,json=deviceId,proto3,oneof" json:"device_id,omitempty"`    // 设备ID
+	LoginTime     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=login_time,json=loginTime,proto3,oneof" json:"login_time,omitempty"` // 登录时间，为空时取当前时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EvaluateLoginPolicyRequest) Reset() {
+	*x = EvaluateLoginPolicyRequest{}
+	mi := &file_authentication_service_v1_login_policy_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EvaluateLoginPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvaluateLoginPolicyRequest) ProtoMessage() {}
+
+func (x *EvaluateLoginPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authentication_service_v1_login_policy_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvaluateLoginPolicyRequest.ProtoReflect.Descriptor instead.
+func (*EvaluateLoginPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_authentication_service_v1_login_policy_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *EvaluateLoginPolicyRequest) GetUserId() uint32 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return 0
+}
+
+func (x *EvaluateLoginPolicyRequest) GetIp() string {
+	if x != nil && x.Ip != nil {
+		return *x.Ip
+	}
+	return ""
+}
+
+func (x *EvaluateLoginPolicyRequest) GetDeviceId() string {
+	if x != nil && x.DeviceId != nil {
+		return *x.DeviceId
+	}
+	return ""
+}
+
+func (x *EvaluateLoginPolicyRequest) GetLoginTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LoginTime
+	}
+	return nil
+}
+
+// 登录策略拦截明细
+type LoginPolicyViolation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Method        LoginPolicy_Method     `protobuf:"varint,1,opt,name=method,proto3,enum=authentication.service.v1.LoginPolicy_Method" json:"method,omitempty"` // 限制方式
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`                                                    // 拦截原因
+	PolicyIds     []uint32               `protobuf:"varint,3,rep,packed,name=policy_ids,json=policyIds,proto3" json:"policy_ids,omitempty"`                     // 导致拦截的策略ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginPolicyViolation) Reset() {
+	*x = LoginPolicyViolation{}
+	mi := &file_authentication_service_v1_login_policy_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginPolicyViolation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginPolicyViolation) ProtoMessage() {}
+
+func (x *LoginPolicyViolation) ProtoReflect() protoreflect.Message {
+	mi := &file_authentication_service_v1_login_policy_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginPolicyViolation.ProtoReflect.Descriptor instead.
+func (*LoginPolicyViolation) Descriptor() ([]byte, []int) {
+	return file_authentication_service_v1_login_policy_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *LoginPolicyViolation) GetMethod() LoginPolicy_Method {
+	if x != nil {
+		return x.Method
+	}
+	return LoginPolicy_LOGIN_RESTRICTION_METHOD_UNSPECIFIED
+}
+
+func (x *LoginPolicyViolation) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *LoginPolicyViolation) GetPolicyIds() []uint32 {
+	if x != nil {
+		return x.PolicyIds
+	}
+	return nil
+}
+
+// 模拟判定登录策略 - 回应
+type EvaluateLoginPolicyResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Blocked       bool                    `protobuf:"varint,1,opt,name=blocked,proto3" json:"blocked,omitempty"`      // 是否会被拦截
+	Violations    []*LoginPolicyViolation `protobuf:"bytes,2,rep,name=violations,proto3" json:"violations,omitempty"` // 拦截明细
+	Region        *string                 `protobuf:"bytes,3,opt,name=region,proto3,oneof" json:"region,omitempty"`   // IP解析出的地区
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EvaluateLoginPolicyResponse) Reset() {
+	*x = EvaluateLoginPolicyResponse{}
+	mi := &file_authentication_service_v1_login_policy_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EvaluateLoginPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvaluateLoginPolicyResponse) ProtoMessage() {}
+
+func (x *EvaluateLoginPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_authentication_service_v1_login_policy_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvaluateLoginPolicyResponse.ProtoReflect.Descriptor instead.
+func (*EvaluateLoginPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_authentication_service_v1_login_policy_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *EvaluateLoginPolicyResponse) GetBlocked() bool {
+	if x != nil {
+		return x.Blocked
+	}
+	return false
+}
+
+func (x *EvaluateLoginPolicyResponse) GetViolations() []*LoginPolicyViolation {
+	if x != nil {
+		return x.Violations
+	}
+	return nil
+}
+
+func (x *EvaluateLoginPolicyResponse) GetRegion() string {
+	if x != nil && x.Region != nil {
+		return *x.Region
+	}
+	return ""
+}
+
 var File_authentication_service_v1_login_policy_proto protoreflect.FileDescriptor
 
 const file_authentication_service_v1_login_policy_proto_rawDesc = "" +
 	"\n" +
-	",authentication/service/v1/login_policy.proto\x12\x19authentication.service.v1\x1a$gnostic/openapi/v3/annotations.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a google/protobuf/field_mask.proto\x1a\x1epagination/v1/pagination.proto\"\xd3\n" +
-	"\n" +
+	",authentication/service/v1/login_policy.proto\x12\x19authentication.service.v1\x1a$gnostic/openapi/v3/annotations.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a google/protobuf/field_mask.proto\x1a\x1epagination/v1/pagination.proto\"\xae\r\n" +
 	"\vLoginPolicy\x12,\n" +
-	"\x02id\x18\x01 \x01(\rB\x17\xe0A\x01\xbaG\x11\x92\x02\x0e登录策略IDH\x00R\x02id\x88\x01\x01\x126\n" +
-	"\ttarget_id\x18\x02 \x01(\rB\x14\xbaG\x11\x92\x02\x0e目标用户IDH\x01R\btargetId\x88\x01\x01\x12X\n" +
+	"\x02id\x18\x01 \x01(\rB\x17\xe0A\x01\xbaG\x11\x92\x02\x0e登录策略IDH\x00R\x02id\x88\x01\x01\x12O\n" +
+	"\ttarget_id\x18\x02 \x01(\rB-\xbaG*\x92\x02'目标ID（用户ID或组织单元ID）H\x01R\btargetId\x88\x01\x01\x12X\n" +
 	"\x04type\x18\x03 \x01(\x0e2+.authentication.service.v1.LoginPolicy.TypeB\x12\xbaG\x0f\x92\x02\f限制类型H\x02R\x04type\x88\x01\x01\x12^\n" +
 	"\x06method\x18\x04 \x01(\x0e2-.authentication.service.v1.LoginPolicy.MethodB\x12\xbaG\x0f\x92\x02\f限制方式H\x03R\x06method\x88\x01\x01\x12V\n" +
 	"\x05value\x18\x05 \x01(\tB;\xbaG8\x92\x025限制值（如IP地址、MAC地址或地区代码）H\x04R\x05value\x88\x01\x01\x12/\n" +
-	"\x06reason\x18\x06 \x01(\tB\x12\xbaG\x0f\x92\x02\f限制原因H\x05R\x06reason\x88\x01\x01\x12L\n" +
-	"\ttenant_id\x18( \x01(\rB*\xbaG'\x92\x02$租户ID，0代表系统全局角色H\x06R\btenantId\x88\x01\x01\x128\n" +
-	"\vtenant_name\x18) \x01(\tB\x12\xbaG\x0f\x92\x02\f租户名称H\aR\n" +
+	"\x06reason\x18\x06 \x01(\tB\x12\xbaG\x0f\x92\x02\f限制原因H\x05R\x06reason\x88\x01\x01\x12q\n" +
+	"\vtarget_type\x18\a \x01(\x0e21.authentication.service.v1.LoginPolicy.TargetTypeB\x18\xbaG\x15\x92\x02\x12生效对象类型H\x06R\n" +
+	"targetType\x88\x01\x01\x12G\n" +
+	"\vtarget_code\x18\b \x01(\tB!\xbaG\x1e\x92\x02\x1b目标编码（角色码）H\aR\n" +
+	"targetCode\x88\x01\x01\x12L\n" +
+	"\ttenant_id\x18( \x01(\rB*\xbaG'\x92\x02$租户ID，0代表系统全局角色H\bR\btenantId\x88\x01\x01\x128\n" +
+	"\vtenant_name\x18) \x01(\tB\x12\xbaG\x0f\x92\x02\f租户名称H\tR\n" +
 	"tenantName\x88\x01\x01\x125\n" +
 	"\n" +
-	"created_by\x18d \x01(\rB\x11\xbaG\x0e\x92\x02\v创建者IDH\bR\tcreatedBy\x88\x01\x01\x125\n" +
+	"created_by\x18d \x01(\rB\x11\xbaG\x0e\x92\x02\v创建者IDH\n" +
+	"R\tcreatedBy\x88\x01\x01\x125\n" +
 	"\n" +
-	"updated_by\x18e \x01(\rB\x11\xbaG\x0e\x92\x02\v更新者IDH\tR\tupdatedBy\x88\x01\x01\x12;\n" +
+	"updated_by\x18e \x01(\rB\x11\xbaG\x0e\x92\x02\v更新者IDH\vR\tupdatedBy\x88\x01\x01\x12;\n" +
 	"\n" +
-	"deleted_by\x18f \x01(\rB\x17\xbaG\x14\x92\x02\x11删除者用户IDH\n" +
-	"R\tdeletedBy\x88\x01\x01\x12S\n" +
+	"deleted_by\x18f \x01(\rB\x17\xbaG\x14\x92\x02\x11删除者用户IDH\fR\tdeletedBy\x88\x01\x01\x12S\n" +
 	"\n" +
-	"created_at\x18\xc8\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f创建时间H\vR\tcreatedAt\x88\x01\x01\x12S\n" +
+	"created_at\x18\xc8\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f创建时间H\rR\tcreatedAt\x88\x01\x01\x12S\n" +
 	"\n" +
-	"updated_at\x18\xc9\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f更新时间H\fR\tupdatedAt\x88\x01\x01\x12S\n" +
+	"updated_at\x18\xc9\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f更新时间H\x0eR\tupdatedAt\x88\x01\x01\x12S\n" +
 	"\n" +
-	"deleted_at\x18\xca\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f删除时间H\rR\tdeletedAt\x88\x01\x01\"L\n" +
+	"deleted_at\x18\xca\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f删除时间H\x0fR\tdeletedAt\x88\x01\x01\"L\n" +
 	"\x04Type\x12&\n" +
 	"\"LOGIN_RESTRICTION_TYPE_UNSPECIFIED\x10\x00\x12\r\n" +
 	"\tBLACKLIST\x10\x01\x12\r\n" +
@@ -679,14 +945,24 @@ func (x *CountLoginPolicyResponse) GetCount() uint64 {
 	"\x06REGION\x10\x03\x12\b\n" +
 	"\x04TIME\x10\x04\x12\n" +
 	"\n" +
-	"\x06DEVICE\x10\x05B\x05\n" +
+	"\x06DEVICE\x10\x05\"d\n" +
+	"\n" +
+	"TargetType\x12(\n" +
+	"$LOGIN_POLICY_TARGET_TYPE_UNSPECIFIED\x10\x00\x12\n" +
+	"\n" +
+	"\x06GLOBAL\x10\x01\x12\b\n" +
+	"\x04USER\x10\x02\x12\b\n" +
+	"\x04ROLE\x10\x03\x12\f\n" +
+	"\bORG_UNIT\x10\x04B\x05\n" +
 	"\x03_idB\f\n" +
 	"\n" +
 	"_target_idB\a\n" +
 	"\x05_typeB\t\n" +
 	"\a_methodB\b\n" +
 	"\x06_valueB\t\n" +
-	"\a_reasonB\f\n" +
+	"\a_reasonB\x0e\n" +
+	"\f_target_typeB\x0e\n" +
+	"\f_target_codeB\f\n" +
 	"\n" +
 	"_tenant_idB\x0e\n" +
 	"\f_tenant_nameB\r\n" +
@@ -722,14 +998,39 @@ func (x *CountLoginPolicyResponse) GetCount() uint64 {
 	"\n" +
 	"\bquery_by\"0\n" +
 	"\x18CountLoginPolicyResponse\x12\x14\n" +
-	"\x05count\x18\x01 \x01(\x04R\x05count2\xb6\x04\n" +
+	"\x05count\x18\x01 \x01(\x04R\x05count\"\xf9\x02\n" +
+	"\x1aEvaluateLoginPolicyRequest\x12\\\n" +
+	"\auser_id\x18\x01 \x01(\rB>\xbaG;\x92\x028用户ID，为空时只判定约束所有用户的策略H\x00R\x06userId\x88\x01\x01\x12,\n" +
+	"\x02ip\x18\x02 \x01(\tB\x17\xbaG\x14\x92\x02\x11客户端IP地址H\x01R\x02ip\x88\x01\x01\x120\n" +
+	"\tdevice_id\x18\x03 \x01(\tB\x0e\xbaG\v\x92\x02\b设备IDH\x02R\bdeviceId\x88\x01\x01\x12m\n" +
+	"\n" +
+	"login_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB-\xbaG*\x92\x02'登录时间，为空时取当前时间H\x03R\tloginTime\x88\x01\x01B\n" +
+	"\n" +
+	"\b_user_idB\x05\n" +
+	"\x03_ipB\f\n" +
+	"\n" +
+	"_device_idB\r\n" +
+	"\v_login_time\"\xb3\x02\n" +
+	"\x14LoginPolicyViolation\x12Y\n" +
+	"\x06method\x18\x01 \x01(\x0e2-.authentication.service.v1.LoginPolicy.MethodB\x12\xbaG\x0f\x92\x02\f限制方式R\x06method\x12*\n" +
+	"\x06reason\x18\x02 \x01(\tB\x12\xbaG\x0f\x92\x02\f拦截原因R\x06reason\x12\x93\x01\n" +
+	"\n" +
+	"policy_ids\x18\x03 \x03(\rBt\xbaGq\x92\x02n导致拦截的策略ID：黑名单为命中的策略，白名单为该限制方式下全部未命中的策略R\tpolicyIds\"\xb5\x02\n" +
+	"\x1bEvaluateLoginPolicyResponse\x122\n" +
+	"\ablocked\x18\x01 \x01(\bB\x18\xbaG\x15\x92\x02\x12是否会被拦截R\ablocked\x12c\n" +
+	"\n" +
+	"violations\x18\x02 \x03(\v2/.authentication.service.v1.LoginPolicyViolationB\x12\xbaG\x0f\x92\x02\f拦截明细R\n" +
+	"violations\x12r\n" +
+	"\x06region\x18\x03 \x01(\tBU\xbaGR\x92\x02OIP解析出的地区（国家/省份/城市），内网或无法解析时为空H\x00R\x06region\x88\x01\x01B\t\n" +
+	"\a_region2\xb3\x05\n" +
 	"\x12LoginPolicyService\x12W\n" +
 	"\x04List\x12\x19.pagination.PagingRequest\x1a2.authentication.service.v1.ListLoginPolicyResponse\"\x00\x12Y\n" +
 	"\x05Count\x12\x19.pagination.PagingRequest\x1a3.authentication.service.v1.CountLoginPolicyResponse\"\x00\x12a\n" +
 	"\x03Get\x120.authentication.service.v1.GetLoginPolicyRequest\x1a&.authentication.service.v1.LoginPolicy\"\x00\x12W\n" +
 	"\x06Create\x123.authentication.service.v1.CreateLoginPolicyRequest\x1a\x16.google.protobuf.Empty\"\x00\x12W\n" +
 	"\x06Update\x123.authentication.service.v1.UpdateLoginPolicyRequest\x1a\x16.google.protobuf.Empty\"\x00\x12W\n" +
-	"\x06Delete\x123.authentication.service.v1.DeleteLoginPolicyRequest\x1a\x16.google.protobuf.Empty\"\x00B\xfc\x01\n" +
+	"\x06Delete\x123.authentication.service.v1.DeleteLoginPolicyRequest\x1a\x16.google.protobuf.Empty\"\x00\x12{\n" +
+	"\bEvaluate\x125.authentication.service.v1.EvaluateLoginPolicyRequest\x1a6.authentication.service.v1.EvaluateLoginPolicyResponse\"\x00B\xfc\x01\n" +
 	"\x1dcom.authentication.service.v1B\x10LoginPolicyProtoP\x01ZCgo-wind-admin/api/gen/go/authentication/service/v1;authenticationpb\xa2\x02\x03ASX\xaa\x02\x19Authentication.Service.V1\xca\x02\x19Authentication\\Service\\V1\xe2\x02%Authentication\\Service\\V1\\GPBMetadata\xea\x02\x1bAuthentication::Service::V1b\x06proto3"
 
 var (
@@ -744,51 +1045,61 @@ func file_authentication_service_v1_login_policy_proto_rawDescGZIP() []byte {
 	return file_authentication_service_v1_login_policy_proto_rawDescData
 }
 
-var file_authentication_service_v1_login_policy_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_authentication_service_v1_login_policy_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_authentication_service_v1_login_policy_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_authentication_service_v1_login_policy_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_authentication_service_v1_login_policy_proto_goTypes = []any{
-	(LoginPolicy_Type)(0),            // 0: authentication.service.v1.LoginPolicy.Type
-	(LoginPolicy_Method)(0),          // 1: authentication.service.v1.LoginPolicy.Method
-	(*LoginPolicy)(nil),              // 2: authentication.service.v1.LoginPolicy
-	(*ListLoginPolicyResponse)(nil),  // 3: authentication.service.v1.ListLoginPolicyResponse
-	(*GetLoginPolicyRequest)(nil),    // 4: authentication.service.v1.GetLoginPolicyRequest
-	(*CreateLoginPolicyRequest)(nil), // 5: authentication.service.v1.CreateLoginPolicyRequest
-	(*UpdateLoginPolicyRequest)(nil), // 6: authentication.service.v1.UpdateLoginPolicyRequest
-	(*DeleteLoginPolicyRequest)(nil), // 7: authentication.service.v1.DeleteLoginPolicyRequest
-	(*CountLoginPolicyResponse)(nil), // 8: authentication.service.v1.CountLoginPolicyResponse
-	(*timestamppb.Timestamp)(nil),    // 9: google.protobuf.Timestamp
-	(*fieldmaskpb.FieldMask)(nil),    // 10: google.protobuf.FieldMask
-	(*v1.PagingRequest)(nil),         // 11: pagination.PagingRequest
-	(*emptypb.Empty)(nil),            // 12: google.protobuf.Empty
+	(LoginPolicy_Type)(0),               // 0: authentication.service.v1.LoginPolicy.Type
+	(LoginPolicy_Method)(0),             // 1: authentication.service.v1.LoginPolicy.Method
+	(LoginPolicy_TargetType)(0),         // 2: authentication.service.v1.LoginPolicy.TargetType
+	(*LoginPolicy)(nil),                 // 3: authentication.service.v1.LoginPolicy
+	(*ListLoginPolicyResponse)(nil),     // 4: authentication.service.v1.ListLoginPolicyResponse
+	(*GetLoginPolicyRequest)(nil),       // 5: authentication.service.v1.GetLoginPolicyRequest
+	(*CreateLoginPolicyRequest)(nil),    // 6: authentication.service.v1.CreateLoginPolicyRequest
+	(*UpdateLoginPolicyRequest)(nil),    // 7: authentication.service.v1.UpdateLoginPolicyRequest
+	(*DeleteLoginPolicyRequest)(nil),    // 8: authentication.service.v1.DeleteLoginPolicyRequest
+	(*CountLoginPolicyResponse)(nil),    // 9: authentication.service.v1.CountLoginPolicyResponse
+	(*EvaluateLoginPolicyRequest)(nil),  // 10: authentication.service.v1.EvaluateLoginPolicyRequest
+	(*LoginPolicyViolation)(nil),        // 11: authentication.service.v1.LoginPolicyViolation
+	(*EvaluateLoginPolicyResponse)(nil), // 12: authentication.service.v1.EvaluateLoginPolicyResponse
+	(*timestamppb.Timestamp)(nil),       // 13: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),       // 14: google.protobuf.FieldMask
+	(*v1.PagingRequest)(nil),            // 15: pagination.PagingRequest
+	(*emptypb.Empty)(nil),               // 16: google.protobuf.Empty
 }
 var file_authentication_service_v1_login_policy_proto_depIdxs = []int32{
 	0,  // 0: authentication.service.v1.LoginPolicy.type:type_name -> authentication.service.v1.LoginPolicy.Type
 	1,  // 1: authentication.service.v1.LoginPolicy.method:type_name -> authentication.service.v1.LoginPolicy.Method
-	9,  // 2: authentication.service.v1.LoginPolicy.created_at:type_name -> google.protobuf.Timestamp
-	9,  // 3: authentication.service.v1.LoginPolicy.updated_at:type_name -> google.protobuf.Timestamp
-	9,  // 4: authentication.service.v1.LoginPolicy.deleted_at:type_name -> google.protobuf.Timestamp
-	2,  // 5: authentication.service.v1.ListLoginPolicyResponse.items:type_name -> authentication.service.v1.LoginPolicy
-	10, // 6: authentication.service.v1.GetLoginPolicyRequest.view_mask:type_name -> google.protobuf.FieldMask
-	2,  // 7: authentication.service.v1.CreateLoginPolicyRequest.data:type_name -> authentication.service.v1.LoginPolicy
-	2,  // 8: authentication.service.v1.UpdateLoginPolicyRequest.data:type_name -> authentication.service.v1.LoginPolicy
-	10, // 9: authentication.service.v1.UpdateLoginPolicyRequest.update_mask:type_name -> google.protobuf.FieldMask
-	11, // 10: authentication.service.v1.LoginPolicyService.List:input_type -> pagination.PagingRequest
-	11, // 11: authentication.service.v1.LoginPolicyService.Count:input_type -> pagination.PagingRequest
-	4,  // 12: authentication.service.v1.LoginPolicyService.Get:input_type -> authentication.service.v1.GetLoginPolicyRequest
-	5,  // 13: authentication.service.v1.LoginPolicyService.Create:input_type -> authentication.service.v1.CreateLoginPolicyRequest
-	6,  // 14: authentication.service.v1.LoginPolicyService.Update:input_type -> authentication.service.v1.UpdateLoginPolicyRequest
-	7,  // 15: authentication.service.v1.LoginPolicyService.Delete:input_type -> authentication.service.v1.DeleteLoginPolicyRequest
-	3,  // 16: authentication.service.v1.LoginPolicyService.List:output_type -> authentication.service.v1.ListLoginPolicyResponse
-	8,  // 17: authentication.service.v1.LoginPolicyService.Count:output_type -> authentication.service.v1.CountLoginPolicyResponse
-	2,  // 18: authentication.service.v1.LoginPolicyService.Get:output_type -> authentication.service.v1.LoginPolicy
-	12, // 19: authentication.service.v1.LoginPolicyService.Create:output_type -> google.protobuf.Empty
-	12, // 20: authentication.service.v1.LoginPolicyService.Update:output_type -> google.protobuf.Empty
-	12, // 21: authentication.service.v1.LoginPolicyService.Delete:output_type -> google.protobuf.Empty
-	16, // [16:22] is the sub-list for method output_type
-	10, // [10:16] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	2,  // 2: authentication.service.v1.LoginPolicy.target_type:type_name -> authentication.service.v1.LoginPolicy.TargetType
+	13, // 3: authentication.service.v1.LoginPolicy.created_at:type_name -> google.protobuf.Timestamp
+	13, // 4: authentication.service.v1.LoginPolicy.updated_at:type_name -> google.protobuf.Timestamp
+	13, // 5: authentication.service.v1.LoginPolicy.deleted_at:type_name -> google.protobuf.Timestamp
+	3,  // 6: authentication.service.v1.ListLoginPolicyResponse.items:type_name -> authentication.service.v1.LoginPolicy
+	14, // 7: authentication.service.v1.GetLoginPolicyRequest.view_mask:type_name -> google.protobuf.FieldMask
+	3,  // 8: authentication.service.v1.CreateLoginPolicyRequest.data:type_name -> authentication.service.v1.LoginPolicy
+	3,  // 9: authentication.service.v1.UpdateLoginPolicyRequest.data:type_name -> authentication.service.v1.LoginPolicy
+	14, // 10: authentication.service.v1.UpdateLoginPolicyRequest.update_mask:type_name -> google.protobuf.FieldMask
+	13, // 11: authentication.service.v1.EvaluateLoginPolicyRequest.login_time:type_name -> google.protobuf.Timestamp
+	1,  // 12: authentication.service.v1.LoginPolicyViolation.method:type_name -> authentication.service.v1.LoginPolicy.Method
+	11, // 13: authentication.service.v1.EvaluateLoginPolicyResponse.violations:type_name -> authentication.service.v1.LoginPolicyViolation
+	15, // 14: authentication.service.v1.LoginPolicyService.List:input_type -> pagination.PagingRequest
+	15, // 15: authentication.service.v1.LoginPolicyService.Count:input_type -> pagination.PagingRequest
+	5,  // 16: authentication.service.v1.LoginPolicyService.Get:input_type -> authentication.service.v1.GetLoginPolicyRequest
+	6,  // 17: authentication.service.v1.LoginPolicyService.Create:input_type -> authentication.service.v1.CreateLoginPolicyRequest
+	7,  // 18: authentication.service.v1.LoginPolicyService.Update:input_type -> authentication.service.v1.UpdateLoginPolicyRequest
+	8,  // 19: authentication.service.v1.LoginPolicyService.Delete:input_type -> authentication.service.v1.DeleteLoginPolicyRequest
+	10, // 20: authentication.service.v1.LoginPolicyService.Evaluate:input_type -> authentication.service.v1.EvaluateLoginPolicyRequest
+	4,  // 21: authentication.service.v1.LoginPolicyService.List:output_type -> authentication.service.v1.ListLoginPolicyResponse
+	9,  // 22: authentication.service.v1.LoginPolicyService.Count:output_type -> authentication.service.v1.CountLoginPolicyResponse
+	3,  // 23: authentication.service.v1.LoginPolicyService.Get:output_type -> authentication.service.v1.LoginPolicy
+	16, // 24: authentication.service.v1.LoginPolicyService.Create:output_type -> google.protobuf.Empty
+	16, // 25: authentication.service.v1.LoginPolicyService.Update:output_type -> google.protobuf.Empty
+	16, // 26: authentication.service.v1.LoginPolicyService.Delete:output_type -> google.protobuf.Empty
+	12, // 27: authentication.service.v1.LoginPolicyService.Evaluate:output_type -> authentication.service.v1.EvaluateLoginPolicyResponse
+	21, // [21:28] is the sub-list for method output_type
+	14, // [14:21] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_authentication_service_v1_login_policy_proto_init() }
@@ -804,13 +1115,15 @@ func file_authentication_service_v1_login_policy_proto_init() {
 	file_authentication_service_v1_login_policy_proto_msgTypes[5].OneofWrappers = []any{
 		(*DeleteLoginPolicyRequest_Id)(nil),
 	}
+	file_authentication_service_v1_login_policy_proto_msgTypes[7].OneofWrappers = []any{}
+	file_authentication_service_v1_login_policy_proto_msgTypes[9].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_authentication_service_v1_login_policy_proto_rawDesc), len(file_authentication_service_v1_login_policy_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   7,
+			NumEnums:      3,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
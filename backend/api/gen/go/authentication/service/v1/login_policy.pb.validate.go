@@ -81,6 +81,14 @@ func (m *LoginPolicy) validate(all bool) error {
 		// no validation rules for Reason
 	}
 
+	if m.TargetType != nil {
+		// no validation rules for TargetType
+	}
+
+	if m.TargetCode != nil {
+		// no validation rules for TargetCode
+	}
+
 	if m.TenantId != nil {
 		// no validation rules for TenantId
 	}
@@ -1086,3 +1094,399 @@ func (e CountLoginPolicyResponseValidationError) Error() string {
 	Cause() error
 	ErrorName() string
 } = CountLoginPolicyResponseValidationError{}
+
+// Validate checks the field values on EvaluateLoginPolicyRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EvaluateLoginPolicyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EvaluateLoginPolicyRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EvaluateLoginPolicyRequestMultiError, or nil if none found.
+func (m *EvaluateLoginPolicyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EvaluateLoginPolicyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.UserId != nil {
+		// no validation rules for UserId
+	}
+
+	if m.Ip != nil {
+		// no validation rules for Ip
+	}
+
+	if m.DeviceId != nil {
+		// no validation rules for DeviceId
+	}
+
+	if m.LoginTime != nil {
+
+		if all {
+			switch v := interface{}(m.GetLoginTime()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, EvaluateLoginPolicyRequestValidationError{
+						field:  "LoginTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, EvaluateLoginPolicyRequestValidationError{
+						field:  "LoginTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetLoginTime()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return EvaluateLoginPolicyRequestValidationError{
+					field:  "LoginTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return EvaluateLoginPolicyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// EvaluateLoginPolicyRequestMultiError is an error wrapping multiple
+// validation errors returned by EvaluateLoginPolicyRequest.ValidateAll() if
+// the designated constraints aren't met.
+type EvaluateLoginPolicyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EvaluateLoginPolicyRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EvaluateLoginPolicyRequestMultiError) AllErrors() []error { return m }
+
+// EvaluateLoginPolicyRequestValidationError is the validation error returned
+// by EvaluateLoginPolicyRequest.Validate if the designated constraints aren't met.
+type EvaluateLoginPolicyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EvaluateLoginPolicyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EvaluateLoginPolicyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EvaluateLoginPolicyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EvaluateLoginPolicyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EvaluateLoginPolicyRequestValidationError) ErrorName() string {
+	return "EvaluateLoginPolicyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EvaluateLoginPolicyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPermissionCodeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EvaluateLoginPolicyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EvaluateLoginPolicyRequestValidationError{}
+
+// Validate checks the field values on LoginPolicyViolation with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *LoginPolicyViolation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LoginPolicyViolation with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LoginPolicyViolationMultiError, or nil if none found.
+func (m *LoginPolicyViolation) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LoginPolicyViolation) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Method
+
+	// no validation rules for Reason
+
+	if len(errors) > 0 {
+		return LoginPolicyViolationMultiError(errors)
+	}
+
+	return nil
+}
+
+// LoginPolicyViolationMultiError is an error wrapping multiple validation
+// errors returned by LoginPolicyViolation.ValidateAll() if the designated
+// constraints aren't met.
+type LoginPolicyViolationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LoginPolicyViolationMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LoginPolicyViolationMultiError) AllErrors() []error { return m }
+
+// LoginPolicyViolationValidationError is the validation error returned by
+// LoginPolicyViolation.Validate if the designated constraints aren't met.
+type LoginPolicyViolationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LoginPolicyViolationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LoginPolicyViolationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LoginPolicyViolationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LoginPolicyViolationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LoginPolicyViolationValidationError) ErrorName() string {
+	return "LoginPolicyViolationValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e LoginPolicyViolationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetLoginTrendRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LoginPolicyViolationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LoginPolicyViolationValidationError{}
+
+// Validate checks the field values on EvaluateLoginPolicyResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EvaluateLoginPolicyResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EvaluateLoginPolicyResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EvaluateLoginPolicyResponseMultiError, or nil if none found.
+func (m *EvaluateLoginPolicyResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EvaluateLoginPolicyResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Blocked
+
+	for idx, item := range m.GetViolations() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, EvaluateLoginPolicyResponseValidationError{
+						field:  fmt.Sprintf("Violations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, EvaluateLoginPolicyResponseValidationError{
+						field:  fmt.Sprintf("Violations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return EvaluateLoginPolicyResponseValidationError{
+					field:  fmt.Sprintf("Violations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.Region != nil {
+		// no validation rules for Region
+	}
+
+	if len(errors) > 0 {
+		return EvaluateLoginPolicyResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// EvaluateLoginPolicyResponseMultiError is an error wrapping multiple
+// validation errors returned by EvaluateLoginPolicyResponse.ValidateAll() if
+// the designated constraints aren't met.
+type EvaluateLoginPolicyResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EvaluateLoginPolicyResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EvaluateLoginPolicyResponseMultiError) AllErrors() []error { return m }
+
+// EvaluateLoginPolicyResponseValidationError is the validation error returned
+// by EvaluateLoginPolicyResponse.Validate if the designated constraints
+// aren't met.
+type EvaluateLoginPolicyResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EvaluateLoginPolicyResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EvaluateLoginPolicyResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EvaluateLoginPolicyResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EvaluateLoginPolicyResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EvaluateLoginPolicyResponseValidationError) ErrorName() string {
+	return "EvaluateLoginPolicyResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EvaluateLoginPolicyResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetOperationAuditLogRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EvaluateLoginPolicyResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EvaluateLoginPolicyResponseValidationError{}
@@ -21,12 +21,13 @@
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	LoginPolicyService_List_FullMethodName   = "/authentication.service.v1.LoginPolicyService/List"
-	LoginPolicyService_Count_FullMethodName  = "/authentication.service.v1.LoginPolicyService/Count"
-	LoginPolicyService_Get_FullMethodName    = "/authentication.service.v1.LoginPolicyService/Get"
-	LoginPolicyService_Create_FullMethodName = "/authentication.service.v1.LoginPolicyService/Create"
-	LoginPolicyService_Update_FullMethodName = "/authentication.service.v1.LoginPolicyService/Update"
-	LoginPolicyService_Delete_FullMethodName = "/authentication.service.v1.LoginPolicyService/Delete"
+	LoginPolicyService_List_FullMethodName     = "/authentication.service.v1.LoginPolicyService/List"
+	LoginPolicyService_Count_FullMethodName    = "/authentication.service.v1.LoginPolicyService/Count"
+	LoginPolicyService_Get_FullMethodName      = "/authentication.service.v1.LoginPolicyService/Get"
+	LoginPolicyService_Create_FullMethodName   = "/authentication.service.v1.LoginPolicyService/Create"
+	LoginPolicyService_Update_FullMethodName   = "/authentication.service.v1.LoginPolicyService/Update"
+	LoginPolicyService_Delete_FullMethodName   = "/authentication.service.v1.LoginPolicyService/Delete"
+	LoginPolicyService_Evaluate_FullMethodName = "/authentication.service.v1.LoginPolicyService/Evaluate"
 )
 
 // LoginPolicyServiceClient is the client API for LoginPolicyService service.
@@ -47,6 +48,8 @@ type LoginPolicyServiceClient interface {
 	Update(ctx context.Context, in *UpdateLoginPolicyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// 删除登录策略
 	Delete(ctx context.Context, in *DeleteLoginPolicyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 模拟判定登录策略（不实际登录），返回会拦截该次登录的策略
+	Evaluate(ctx context.Context, in *EvaluateLoginPolicyRequest, opts ...grpc.CallOption) (*EvaluateLoginPolicyResponse, error)
 }
 
 type loginPolicyServiceClient struct {
@@ -117,6 +120,16 @@ func (c *loginPolicyServiceClient) Delete(ctx context.Context, in *DeleteLoginPo
 	return out, nil
 }
 
+func (c *loginPolicyServiceClient) Evaluate(ctx context.Context, in *EvaluateLoginPolicyRequest, opts ...grpc.CallOption) (*EvaluateLoginPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EvaluateLoginPolicyResponse)
+	err := c.cc.Invoke(ctx, LoginPolicyService_Evaluate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LoginPolicyServiceServer is the server API for LoginPolicyService service.
 // All implementations must embed UnimplementedLoginPolicyServiceServer
 // for forward compatibility.
@@ -135,6 +148,8 @@ type LoginPolicyServiceServer interface {
 	Update(context.Context, *UpdateLoginPolicyRequest) (*emptypb.Empty, error)
 	// 删除登录策略
 	Delete(context.Context, *DeleteLoginPolicyRequest) (*emptypb.Empty, error)
+	// 模拟判定登录策略（不实际登录），返回会拦截该次登录的策略
+	Evaluate(context.Context, *EvaluateLoginPolicyRequest) (*EvaluateLoginPolicyResponse, error)
 	mustEmbedUnimplementedLoginPolicyServiceServer()
 }
 
@@ -163,6 +178,9 @@ func (UnimplementedLoginPolicyServiceServer) Update(context.Context, *UpdateLogi
 func (UnimplementedLoginPolicyServiceServer) Delete(context.Context, *DeleteLoginPolicyRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method Delete not implemented")
 }
+func (UnimplementedLoginPolicyServiceServer) Evaluate(context.Context, *EvaluateLoginPolicyRequest) (*EvaluateLoginPolicyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Evaluate not implemented")
+}
 func (UnimplementedLoginPolicyServiceServer) mustEmbedUnimplementedLoginPolicyServiceServer() {}
 func (UnimplementedLoginPolicyServiceServer) testEmbeddedByValue()                            {}
 
@@ -292,6 +310,24 @@ func _LoginPolicyService_Delete_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LoginPolicyService_Evaluate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvaluateLoginPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoginPolicyServiceServer).Evaluate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoginPolicyService_Evaluate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoginPolicyServiceServer).Evaluate(ctx, req.(*EvaluateLoginPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // LoginPolicyService_ServiceDesc is the grpc.ServiceDesc for LoginPolicyService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -323,6 +359,10 @@ func _LoginPolicyService_Delete_Handler(srv interface{}, ctx context.Context, de
 			MethodName: "Delete",
 			Handler:    _LoginPolicyService_Delete_Handler,
 		},
+		{
+			MethodName: "Evaluate",
+			Handler:    _LoginPolicyService_Evaluate_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "authentication/service/v1/login_policy.proto",
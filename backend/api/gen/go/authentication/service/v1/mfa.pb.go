@@ -185,12 +185,13 @@ func (x *GetMFAStatusRequest) GetUserId() string {
 }
 
 type GetMFAStatusResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
-	Enrolled      []*EnrolledMethod      `protobuf:"bytes,2,rep,name=enrolled,proto3" json:"enrolled,omitempty"`
-	Enforcement   MFAEnforcement         `protobuf:"varint,3,opt,name=enforcement,proto3,enum=authentication.service.v1.MFAEnforcement" json:"enforcement,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Enabled              bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Enrolled             []*EnrolledMethod      `protobuf:"bytes,2,rep,name=enrolled,proto3" json:"enrolled,omitempty"`
+	Enforcement          MFAEnforcement         `protobuf:"varint,3,opt,name=enforcement,proto3,enum=authentication.service.v1.MFAEnforcement" json:"enforcement,omitempty"`
+	BackupCodesRemaining int32                  `protobuf:"varint,4,opt,name=backup_codes_remaining,json=backupCodesRemaining,proto3" json:"backup_codes_remaining,omitempty"` // 剩余可用备份码数量
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
 func (x *GetMFAStatusResponse) Reset() {
@@ -244,6 +245,181 @@ func (x *GetMFAStatusResponse) GetEnforcement() MFAEnforcement {
 	return MFAEnforcement_MFA_NOT_REQUIRED
 }
 
+func (x *GetMFAStatusResponse) GetBackupCodesRemaining() int32 {
+	if x != nil {
+		return x.BackupCodesRemaining
+	}
+	return 0
+}
+
+// MFA 策略：每个租户至多一条，tenant_id=0 为平台策略。
+// 平台策略作用于全部用户，租户策略作用于本租户用户；两者取较严格者，租户只能收紧不能放宽。
+// 策略要求启用而用户尚无因子时，登录只返回强制注册用的 mfa_operation_id。
+type MFAPolicy struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	TenantId          *uint32                `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
+	Enforcement       MFAEnforcement         `protobuf:"varint,2,opt,name=enforcement,proto3,enum=authentication.service.v1.MFAEnforcement" json:"enforcement,omitempty"`
+	RequiredRoleCodes []string               `protobuf:"bytes,3,rep,name=required_role_codes,json=requiredRoleCodes,proto3" json:"required_role_codes,omitempty"`
+	UpdatedBy         *uint32                `protobuf:"varint,10,opt,name=updated_by,json=updatedBy,proto3,oneof" json:"updated_by,omitempty"`
+	UpdatedAt         *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3,oneof" json:"updated_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *MFAPolicy) Reset() {
+	*x = MFAPolicy{}
+	mi := &file_authentication_service_v1_mfa_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MFAPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MFAPolicy) ProtoMessage() {}
+
+func (x *MFAPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_authentication_service_v1_mfa_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MFAPolicy.ProtoReflect.Descriptor instead.
+func (*MFAPolicy) Descriptor() ([]byte, []int) {
+	return file_authentication_service_v1_mfa_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MFAPolicy) GetTenantId() uint32 {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return 0
+}
+
+func (x *MFAPolicy) GetEnforcement() MFAEnforcement {
+	if x != nil {
+		return x.Enforcement
+	}
+	return MFAEnforcement_MFA_NOT_REQUIRED
+}
+
+func (x *MFAPolicy) GetRequiredRoleCodes() []string {
+	if x != nil {
+		return x.RequiredRoleCodes
+	}
+	return nil
+}
+
+func (x *MFAPolicy) GetUpdatedBy() uint32 {
+	if x != nil && x.UpdatedBy != nil {
+		return *x.UpdatedBy
+	}
+	return 0
+}
+
+func (x *MFAPolicy) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type GetMFAPolicyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 仅平台管理员可指定租户；租户管理员恒为本租户
+	TenantId      *uint32 `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMFAPolicyRequest) Reset() {
+	*x = GetMFAPolicyRequest{}
+	mi := &file_authentication_service_v1_mfa_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMFAPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMFAPolicyRequest) ProtoMessage() {}
+
+func (x *GetMFAPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authentication_service_v1_mfa_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMFAPolicyRequest.ProtoReflect.Descriptor instead.
+func (*GetMFAPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_authentication_service_v1_mfa_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetMFAPolicyRequest) GetTenantId() uint32 {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return 0
+}
+
+type UpdateMFAPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *MFAPolicy             `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMFAPolicyRequest) Reset() {
+	*x = UpdateMFAPolicyRequest{}
+	mi := &file_authentication_service_v1_mfa_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMFAPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMFAPolicyRequest) ProtoMessage() {}
+
+func (x *UpdateMFAPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authentication_service_v1_mfa_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMFAPolicyRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMFAPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_authentication_service_v1_mfa_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UpdateMFAPolicyRequest) GetData() *MFAPolicy {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
 type EnrolledMethod struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // 凭证 id（内部唯一标识）
@@ -258,7 +434,7 @@ type EnrolledMethod struct {
 
 func (x *EnrolledMethod) Reset() {
 	*x = EnrolledMethod{}
-	mi := &file_authentication_service_v1_mfa_proto_msgTypes[2]
+	mi := &file_authentication_service_v1_mfa_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -270,7 +446,7 @@ func (x *EnrolledMethod) String() string {
 func (*EnrolledMethod) ProtoMessage() {}
 
 func (x *EnrolledMethod) ProtoReflect() protoreflect.Message {
-	mi := &file_authentication_service_v1_mfa_proto_msgTypes[2]
+	mi := &file_authentication_service_v1_mfa_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -283,7 +459,7 @@ func (x *EnrolledMethod) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnrolledMethod.ProtoReflect.Descriptor instead.
 func (*EnrolledMethod) Descriptor() ([]byte, []int) {
-	return file_authentication_service_v1_mfa_proto_rawDescGZIP(), []int{2}
+	return file_authentication_service_v1_mfa_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *EnrolledMethod) GetId() string {
@@ -338,7 +514,7 @@ type ListEnrolledMethodsRequest struct {
 
 func (x *ListEnrolledMethodsRequest) Reset() {
 	*x = ListEnrolledMethodsRequest{}
-	mi := &file_authentication_service_v1_mfa_proto_msgTypes[3]
+	mi := &file_authentication_service_v1_mfa_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -350,7 +526,7 @@ func (x *ListEnrolledMethodsRequest) String() string {
 func (*ListEnrolledMethodsRequest) ProtoMessage() {}
 
 func (x *ListEnrolledMethodsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_authentication_service_v1_mfa_proto_msgTypes[3]
+	mi := &file_authentication_service_v1_mfa_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -363,7 +539,7 @@ func (x *ListEnrolledMethodsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListEnrolledMethodsRequest.ProtoReflect.Descriptor instead.
 func (*ListEnrolledMethodsRequest) Descriptor() ([]byte, []int) {
-	return file_authentication_service_v1_mfa_proto_rawDescGZIP(), []int{3}
+	return file_authentication_service_v1_mfa_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ListEnrolledMethodsRequest) GetUserId() string {
@@ -382,7 +558,7 @@ type ListEnrolledMethodsResponse struct {
 
 func (x *ListEnrolledMethodsResponse) Reset() {
 	*x = ListEnrolledMethodsResponse{}
-	mi := &file_authentication_service_v1_mfa_proto_msgTypes[4]
+	mi := &file_authentication_service_v1_mfa_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -394,7 +570,7 @@ func (x *ListEnrolledMethodsResponse) String() string {
 func (*ListEnrolledMethodsResponse) ProtoMessage() {}
 
 func (x *ListEnrolledMethodsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_authentication_service_v1_mfa_proto_msgTypes[4]
+	mi := &file_authentication_service_v1_mfa_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -407,7 +583,7 @@ func (x *ListEnrolledMethodsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListEnrolledMethodsResponse.ProtoReflect.Descriptor instead.
 func (*ListEnrolledMethodsResponse) Descriptor() ([]byte, []int) {
-	return file_authentication_service_v1_mfa_proto_rawDescGZIP(), []int{4}
+	return file_authentication_service_v1_mfa_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ListEnrolledMethodsResponse) GetItems() []*EnrolledMethod {
@@ -421,16 +597,18 @@ func (x *ListEnrolledMethodsResponse) GetItems() []*EnrolledMethod {
 type StartEnrollMethodRequest struct {
 	state  protoimpl.MessageState `protogen:"open.v1"`
 	Method MFAMethod              `protobuf:"varint,1,opt,name=method,proto3,enum=authentication.service.v1.MFAMethod" json:"method,omitempty"`
-	// 根据 method 可能需要额外参数（例如 SMS 需要 phone）
-	Phone         *string `protobuf:"bytes,2,opt,name=phone,proto3,oneof" json:"phone,omitempty"`
-	Email         *string `protobuf:"bytes,3,opt,name=email,proto3,oneof" json:"email,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// SMS/EMAIL 接收验证码的手机号/邮箱；不传则使用账号资料中绑定的手机号/邮箱
+	Phone *string `protobuf:"bytes,2,opt,name=phone,proto3,oneof" json:"phone,omitempty"`
+	Email *string `protobuf:"bytes,3,opt,name=email,proto3,oneof" json:"email,omitempty"`
+	// 登录强制注册（StartLoginEnrollment）时必填：登录返回的 mfa_operation_id
+	LoginOperationId *string `protobuf:"bytes,4,opt,name=login_operation_id,json=loginOperationId,proto3,oneof" json:"login_operation_id,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *StartEnrollMethodRequest) Reset() {
 	*x = StartEnrollMethodRequest{}
-	mi := &file_authentication_service_v1_mfa_proto_msgTypes[5]
+	mi := &file_authentication_service_v1_mfa_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -442,7 +620,7 @@ func (x *StartEnrollMethodRequest) String() string {
 func (*StartEnrollMethodRequest) ProtoMessage() {}
 
 func (x *StartEnrollMethodRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_authentication_service_v1_mfa_proto_msgTypes[5]
+	mi := &file_authentication_service_v1_mfa_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -455,7 +633,7 @@ func (x *StartEnrollMethodRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StartEnrollMethodRequest.ProtoReflect.Descriptor instead.
 func (*StartEnrollMethodRequest) Descriptor() ([]byte, []int) {
-	return file_authentication_service_v1_mfa_proto_rawDescGZIP(), []int{5}
+	return file_authentication_service_v1_mfa_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *StartEnrollMethodRequest) GetMethod() MFAMethod {
@@ -479,6 +657,13 @@ func (x *StartEnrollMethodRequest) GetEmail() string {
 	return ""
 }
 
+func (x *StartEnrollMethodRequest) GetLoginOperationId() string {
+	if x != nil && x.LoginOperationId != nil {
+		return *x.LoginOperationId
+	}
+	return ""
+}
+
 type StartEnrollMethodResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// 不同方法的启动结果
@@ -488,6 +673,7 @@ type StartEnrollMethodResponse struct {
 	//	*StartEnrollMethodResponse_Totp
 	//	*StartEnrollMethodResponse_Sms
 	//	*StartEnrollMethodResponse_Webauthn
+	//	*StartEnrollMethodResponse_Email
 	Result    isStartEnrollMethodResponse_Result `protobuf_oneof:"result"`
 	ExpiresAt *timestamppb.Timestamp             `protobuf:"bytes,10,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"`
 	// 临时操作 id，用于 ConfirmEnrollMethod / 后续验证
@@ -498,7 +684,7 @@ type StartEnrollMethodResponse struct {
 
 func (x *StartEnrollMethodResponse) Reset() {
 	*x = StartEnrollMethodResponse{}
-	mi := &file_authentication_service_v1_mfa_proto_msgTypes[6]
+	mi := &file_authentication_service_v1_mfa_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -510,7 +696,7 @@ func (x *StartEnrollMethodResponse) String() string {
 func (*StartEnrollMethodResponse) ProtoMessage() {}
 
 func (x *StartEnrollMethodResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_authentication_service_v1_mfa_proto_msgTypes[6]
+	mi := &file_authentication_service_v1_mfa_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -523,7 +709,7 @@ func (x *StartEnrollMethodResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StartEnrollMethodResponse.ProtoReflect.Descriptor instead.
 func (*StartEnrollMethodResponse) Descriptor() ([]byte, []int) {
-	return file_authentication_service_v1_mfa_proto_rawDescGZIP(), []int{6}
+	return file_authentication_service_v1_mfa_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *StartEnrollMethodResponse) GetResult() isStartEnrollMethodResponse_Result {
@@ -560,6 +746,15 @@ func (x *StartEnrollMethodResponse) GetWebauthn() *WebAuthnResult {
 	return nil
 }
 
+func (x *StartEnrollMethodResponse) GetEmail() *EmailResult {
+	if x != nil {
+		if x, ok := x.Result.(*StartEnrollMethodResponse_Email); ok {
+			return x.Email
+		}
+	}
+	return nil
+}
+
 func (x *StartEnrollMethodResponse) GetExpiresAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.ExpiresAt
@@ -590,12 +785,18 @@ type StartEnrollMethodResponse_Webauthn struct {
 	Webauthn *WebAuthnResult `protobuf:"bytes,3,opt,name=webauthn,proto3,oneof"`
 }
 
+type StartEnrollMethodResponse_Email struct {
+	Email *EmailResult `protobuf:"bytes,4,opt,name=email,proto3,oneof"`
+}
+
 func (*StartEnrollMethodResponse_Totp) isStartEnrollMethodResponse_Result() {}
 
 func (*StartEnrollMethodResponse_Sms) isStartEnrollMethodResponse_Result() {}
 
 func (*StartEnrollMethodResponse_Webauthn) isStartEnrollMethodResponse_Result() {}
 
+func (*StartEnrollMethodResponse_Email) isStartEnrollMethodResponse_Result() {}
+
 type TOTPResult struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// base32 secret：仅在注册时返回一次，服务端应只存哈希/引用
@@ -608,7 +809,7 @@ type TOTPResult struct {
 
 func (x *TOTPResult) Reset() {
 	*x = TOTPResult{}
-	mi := &file_authentication_service_v1_mfa_proto_msgTypes[7]
+	mi := &file_authentication_service_v1_mfa_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -620,7 +821,7 @@ func (x *TOTPResult) String() string {
 func (*TOTPResult) ProtoMessage() {}
 
 func (x *TOTPResult) ProtoReflect() protoreflect.Message {
-	mi := &file_authentication_service_v1_mfa_proto_msgTypes[7]
+	mi := &file_authentication_service_v1_mfa_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -633,7 +834,7 @@ func (x *TOTPResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TOTPResult.ProtoReflect.Descriptor instead.
 func (*TOTPResult) Descriptor() ([]byte, []int) {
-	return file_authentication_service_v1_mfa_proto_rawDescGZIP(), []int{7}
+	return file_authentication_service_v1_mfa_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *TOTPResult) GetSecret() string {
@@ -658,17 +859,18 @@ func (x *TOTPResult) GetQrCodeDataUri() string {
 }
 
 type SMSResult struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	VerificationId string                 `protobuf:"bytes,1,opt,name=verification_id,json=verificationId,proto3" json:"verification_id,omitempty"`
-	SmsSent        bool                   `protobuf:"varint,2,opt,name=sms_sent,json=smsSent,proto3" json:"sms_sent,omitempty"`
-	MaskedPhone    string                 `protobuf:"bytes,3,opt,name=masked_phone,json=maskedPhone,proto3" json:"masked_phone,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	VerificationId     string                 `protobuf:"bytes,1,opt,name=verification_id,json=verificationId,proto3" json:"verification_id,omitempty"` // 等于 operation_id
+	SmsSent            bool                   `protobuf:"varint,2,opt,name=sms_sent,json=smsSent,proto3" json:"sms_sent,omitempty"`
+	MaskedPhone        string                 `protobuf:"bytes,3,opt,name=masked_phone,json=maskedPhone,proto3" json:"masked_phone,omitempty"`
+	ResendAfterSeconds int32                  `protobuf:"varint,4,opt,name=resend_after_seconds,json=resendAfterSeconds,proto3" json:"resend_after_seconds,omitempty"` // 距可重发的秒数
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *SMSResult) Reset() {
 	*x = SMSResult{}
-	mi := &file_authentication_service_v1_mfa_proto_msgTypes[8]
+	mi := &file_authentication_service_v1_mfa_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -680,7 +882,7 @@ func (x *SMSResult) String() string {
 func (*SMSResult) ProtoMessage() {}
 
 func (x *SMSResult) ProtoReflect() protoreflect.Message {
-	mi := &file_authentication_service_v1_mfa_proto_msgTypes[8]
+	mi := &file_authentication_service_v1_mfa_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -693,7 +895,7 @@ func (x *SMSResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SMSResult.ProtoReflect.Descriptor instead.
 func (*SMSResult) Descriptor() ([]byte, []int) {
-	return file_authentication_service_v1_mfa_proto_rawDescGZIP(), []int{8}
+	return file_authentication_service_v1_mfa_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *SMSResult) GetVerificationId() string {
@@ -717,10 +919,85 @@ func (x *SMSResult) GetMaskedPhone() string {
 	return ""
 }
 
+func (x *SMSResult) GetResendAfterSeconds() int32 {
+	if x != nil {
+		return x.ResendAfterSeconds
+	}
+	return 0
+}
+
+type EmailResult struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	VerificationId     string                 `protobuf:"bytes,1,opt,name=verification_id,json=verificationId,proto3" json:"verification_id,omitempty"` // 等于 operation_id
+	EmailSent          bool                   `protobuf:"varint,2,opt,name=email_sent,json=emailSent,proto3" json:"email_sent,omitempty"`
+	MaskedEmail        string                 `protobuf:"bytes,3,opt,name=masked_email,json=maskedEmail,proto3" json:"masked_email,omitempty"`
+	ResendAfterSeconds int32                  `protobuf:"varint,4,opt,name=resend_after_seconds,json=resendAfterSeconds,proto3" json:"resend_after_seconds,omitempty"` // 距可重发的秒数
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *EmailResult) Reset() {
+	*x = EmailResult{}
+	mi := &file_authentication_service_v1_mfa_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmailResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmailResult) ProtoMessage() {}
+
+func (x *EmailResult) ProtoReflect() protoreflect.Message {
+	mi := &file_authentication_service_v1_mfa_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmailResult.ProtoReflect.Descriptor instead.
+func (*EmailResult) Descriptor() ([]byte, []int) {
+	return file_authentication_service_v1_mfa_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *EmailResult) GetVerificationId() string {
+	if x != nil {
+		return x.VerificationId
+	}
+	return ""
+}
+
+func (x *EmailResult) GetEmailSent() bool {
+	if x != nil {
+		return x.EmailSent
+	}
+	return false
+}
+
+func (x *EmailResult) GetMaskedEmail() string {
+	if x != nil {
+		return x.MaskedEmail
+	}
+	return ""
+}
+
+func (x *EmailResult) GetResendAfterSeconds() int32 {
+	if x != nil {
+		return x.ResendAfterSeconds
+	}
+	return 0
+}
+
 type WebAuthnResult struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Challenge     string                 `protobuf:"bytes,1,opt,name=challenge,proto3" json:"challenge,omitempty"`
-	OptionsJson   string                 `protobuf:"bytes,2,opt,name=options_json,json=optionsJson,proto3" json:"options_json,omitempty"`
+	OptionsJson   string                 `protobuf:"bytes,2,opt,name=options_json,json=optionsJson,proto3" json:"options_json,omitempty"` // navigator.credentials.create/get 的参数（{"publicKey": {...}}）
 	RpId          string                 `protobuf:"bytes,3,opt,name=rp_id,json=rpId,proto3" json:"rp_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -728,7 +1005,7 @@ type WebAuthnResult struct {
 
 func (x *WebAuthnResult) Reset() {
 	*x = WebAuthnResult{}
-	mi := &file_authentication_service_v1_mfa_proto_msgTypes[9]
+	mi := &file_authentication_service_v1_mfa_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -740,7 +1017,7 @@ func (x *WebAuthnResult) String() string {
 func (*WebAuthnResult) ProtoMessage() {}
 
 func (x *WebAuthnResult) ProtoReflect() protoreflect.Message {
-	mi := &file_authentication_service_v1_mfa_proto_msgTypes[9]
+	mi := &file_authentication_service_v1_mfa_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -753,7 +1030,7 @@ func (x *WebAuthnResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WebAuthnResult.ProtoReflect.Descriptor instead.
 func (*WebAuthnResult) Descriptor() ([]byte, []int) {
-	return file_authentication_service_v1_mfa_proto_rawDescGZIP(), []int{9}
+	return file_authentication_service_v1_mfa_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *WebAuthnResult) GetChallenge() string {
@@ -788,16 +1065,19 @@ type ConfirmEnrollMethodRequest struct {
 	//	*ConfirmEnrollMethodRequest_Sms
 	//	*ConfirmEnrollMethodRequest_Webauthn
 	//	*ConfirmEnrollMethodRequest_BackupCode
+	//	*ConfirmEnrollMethodRequest_Email
 	Credential isConfirmEnrollMethodRequest_Credential `protobuf_oneof:"credential"`
 	// 可选：设备/显示名
-	Display       *string `protobuf:"bytes,20,opt,name=display,proto3,oneof" json:"display,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Display *string `protobuf:"bytes,20,opt,name=display,proto3,oneof" json:"display,omitempty"`
+	// 登录强制注册（ConfirmLoginEnrollment）时必填：登录返回的 mfa_operation_id
+	LoginOperationId *string `protobuf:"bytes,21,opt,name=login_operation_id,json=loginOperationId,proto3,oneof" json:"login_operation_id,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *ConfirmEnrollMethodRequest) Reset() {
 	*x = ConfirmEnrollMethodRequest{}
-	mi := &file_authentication_service_v1_mfa_proto_msgTypes[10]
+	mi := &file_authentication_service_v1_mfa_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -809,7 +1089,7 @@ func (x *ConfirmEnrollMethodRequest) String() string {
 func (*ConfirmEnrollMethodRequest) ProtoMessage() {}
 
 func (x *ConfirmEnrollMethodRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_authentication_service_v1_mfa_proto_msgTypes[10]
+	mi := &file_authentication_service_v1_mfa_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -822,7 +1102,7 @@ func (x *ConfirmEnrollMethodRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConfirmEnrollMethodRequest.ProtoReflect.Descriptor instead.
 func (*ConfirmEnrollMethodRequest) Descriptor() ([]byte, []int) {
-	return file_authentication_service_v1_mfa_proto_rawDescGZIP(), []int{10}
+	return file_authentication_service_v1_mfa_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ConfirmEnrollMethodRequest) GetMethod() MFAMethod {
@@ -882,6 +1162,15 @@ func (x *ConfirmEnrollMethodRequest) GetBackupCode() string {
 	return ""
 }
 
+func (x *ConfirmEnrollMethodRequest) GetEmail() *EmailVerification {
+	if x != nil {
+		if x, ok := x.Credential.(*ConfirmEnrollMethodRequest_Email); ok {
+			return x.Email
+		}
+	}
+	return nil
+}
+
 func (x *ConfirmEnrollMethodRequest) GetDisplay() string {
 	if x != nil && x.Display != nil {
 		return *x.Display
@@ -889,6 +1178,13 @@ func (x *ConfirmEnrollMethodRequest) GetDisplay() string {
 	return ""
 }
 
+func (x *ConfirmEnrollMethodRequest) GetLoginOperationId() string {
+	if x != nil && x.LoginOperationId != nil {
+		return *x.LoginOperationId
+	}
+	return ""
+}
+
 type isConfirmEnrollMethodRequest_Credential interface {
 	isConfirmEnrollMethodRequest_Credential()
 }
@@ -909,6 +1205,10 @@ type ConfirmEnrollMethodRequest_BackupCode struct {
 	BackupCode string `protobuf:"bytes,13,opt,name=backup_code,json=backupCode,proto3,oneof"`
 }
 
+type ConfirmEnrollMethodRequest_Email struct {
+	Email *EmailVerification `protobuf:"bytes,14,opt,name=email,proto3,oneof"`
+}
+
 func (*ConfirmEnrollMethodRequest_TotpCode) isConfirmEnrollMethodRequest_Credential() {}
 
 func (*ConfirmEnrollMethodRequest_Sms) isConfirmEnrollMethodRequest_Credential() {}
@@ -917,6 +1217,8 @@ func (*ConfirmEnrollMethodRequest_Webauthn) isConfirmEnrollMethodRequest_Credent
 
 func (*ConfirmEnrollMethodRequest_BackupCode) isConfirmEnrollMethodRequest_Credential() {}
 
+func (*ConfirmEnrollMethodRequest_Email) isConfirmEnrollMethodRequest_Credential() {}
+
 type ConfirmEnrollMethodResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -927,7 +1229,7 @@ type ConfirmEnrollMethodResponse struct {
 
 func (x *ConfirmEnrollMethodResponse) Reset() {
 	*x = ConfirmEnrollMethodResponse{}
-	mi := &file_authentication_service_v1_mfa_proto_msgTypes[11]
+	mi := &file_authentication_service_v1_mfa_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -939,7 +1241,7 @@ func (x *ConfirmEnrollMethodResponse) String() string {
 func (*ConfirmEnrollMethodResponse) ProtoMessage() {}
 
 func (x *ConfirmEnrollMethodResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_authentication_service_v1_mfa_proto_msgTypes[11]
+	mi := &file_authentication_service_v1_mfa_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -952,7 +1254,7 @@ func (x *ConfirmEnrollMethodResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConfirmEnrollMethodResponse.ProtoReflect.Descriptor instead.
 func (*ConfirmEnrollMethodResponse) Descriptor() ([]byte, []int) {
-	return file_authentication_service_v1_mfa_proto_rawDescGZIP(), []int{11}
+	return file_authentication_service_v1_mfa_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ConfirmEnrollMethodResponse) GetSuccess() bool {
@@ -994,7 +1296,7 @@ type DisableMFARequest struct {
 
 func (x *DisableMFARequest) Reset() {
 	*x = DisableMFARequest{}
-	mi := &file_authentication_service_v1_mfa_proto_msgTypes[12]
+	mi := &file_authentication_service_v1_mfa_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1006,7 +1308,7 @@ func (x *DisableMFARequest) String() string {
 func (*DisableMFARequest) ProtoMessage() {}
 
 func (x *DisableMFARequest) ProtoReflect() protoreflect.Message {
-	mi := &file_authentication_service_v1_mfa_proto_msgTypes[12]
+	mi := &file_authentication_service_v1_mfa_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1019,7 +1321,7 @@ func (x *DisableMFARequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DisableMFARequest.ProtoReflect.Descriptor instead.
 func (*DisableMFARequest) Descriptor() ([]byte, []int) {
-	return file_authentication_service_v1_mfa_proto_rawDescGZIP(), []int{12}
+	return file_authentication_service_v1_mfa_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *DisableMFARequest) GetCredentialId() string {
@@ -1127,13 +1429,14 @@ type StartMFAChallengeRequest struct {
 	UserId        *string                `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
 	Method        MFAMethod              `protobuf:"varint,2,opt,name=method,proto3,enum=authentication.service.v1.MFAMethod" json:"method,omitempty"`
 	CredentialId  *string                `protobuf:"bytes,3,opt,name=credential_id,json=credentialId,proto3,oneof" json:"credential_id,omitempty"` // 指定凭证（若多设备）
+	OperationId   *string                `protobuf:"bytes,4,opt,name=operation_id,json=operationId,proto3,oneof" json:"operation_id,omitempty"`    // 登录返回的 mfa_operation_id（登录二次验证场景必填）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *StartMFAChallengeRequest) Reset() {
 	*x = StartMFAChallengeRequest{}
-	mi := &file_authentication_service_v1_mfa_proto_msgTypes[13]
+	mi := &file_authentication_service_v1_mfa_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1145,7 +1448,7 @@ func (x *StartMFAChallengeRequest) String() string {
 func (*StartMFAChallengeRequest) ProtoMessage() {}
 
 func (x *StartMFAChallengeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_authentication_service_v1_mfa_proto_msgTypes[13]
+	mi := &file_authentication_service_v1_mfa_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1158,7 +1461,7 @@ func (x *StartMFAChallengeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StartMFAChallengeRequest.ProtoReflect.Descriptor instead.
 func (*StartMFAChallengeRequest) Descriptor() ([]byte, []int) {
-	return file_authentication_service_v1_mfa_proto_rawDescGZIP(), []int{13}
+	return file_authentication_service_v1_mfa_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *StartMFAChallengeRequest) GetUserId() string {
@@ -1182,12 +1485,20 @@ func (x *StartMFAChallengeRequest) GetCredentialId() string {
 	return ""
 }
 
+func (x *StartMFAChallengeRequest) GetOperationId() string {
+	if x != nil && x.OperationId != nil {
+		return *x.OperationId
+	}
+	return ""
+}
+
 type StartMFAChallengeResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Types that are valid to be assigned to Challenge:
 	//
 	//	*StartMFAChallengeResponse_Sms
 	//	*StartMFAChallengeResponse_Webauthn
+	//	*StartMFAChallengeResponse_Email
 	Challenge     isStartMFAChallengeResponse_Challenge `protobuf_oneof:"challenge"`
 	OperationId   string                                `protobuf:"bytes,10,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
 	ExpiresAt     *timestamppb.Timestamp                `protobuf:"bytes,11,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"`
@@ -1197,7 +1508,7 @@ type StartMFAChallengeResponse struct {
 
 func (x *StartMFAChallengeResponse) Reset() {
 	*x = StartMFAChallengeResponse{}
-	mi := &file_authentication_service_v1_mfa_proto_msgTypes[14]
+	mi := &file_authentication_service_v1_mfa_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1209,7 +1520,7 @@ func (x *StartMFAChallengeResponse) String() string {
 func (*StartMFAChallengeResponse) ProtoMessage() {}
 
 func (x *StartMFAChallengeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_authentication_service_v1_mfa_proto_msgTypes[14]
+	mi := &file_authentication_service_v1_mfa_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1222,7 +1533,7 @@ func (x *StartMFAChallengeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StartMFAChallengeResponse.ProtoReflect.Descriptor instead.
 func (*StartMFAChallengeResponse) Descriptor() ([]byte, []int) {
-	return file_authentication_service_v1_mfa_proto_rawDescGZIP(), []int{14}
+	return file_authentication_service_v1_mfa_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *StartMFAChallengeResponse) GetChallenge() isStartMFAChallengeResponse_Challenge {
@@ -1250,6 +1561,15 @@ func (x *StartMFAChallengeResponse) GetWebauthn() *WebAuthnResult {
 	return nil
 }
 
+func (x *StartMFAChallengeResponse) GetEmail() *EmailResult {
+	if x != nil {
+		if x, ok := x.Challenge.(*StartMFAChallengeResponse_Email); ok {
+			return x.Email
+		}
+	}
+	return nil
+}
+
 func (x *StartMFAChallengeResponse) GetOperationId() string {
 	if x != nil {
 		return x.OperationId
@@ -1273,13 +1593,19 @@ type StartMFAChallengeResponse_Sms struct {
 }
 
 type StartMFAChallengeResponse_Webauthn struct {
-	Webauthn *WebAuthnResult `protobuf:"bytes,2,opt,name=webauthn,proto3,oneof"` // TOTP 无需服务端 challenge，前端直接提示输入
+	Webauthn *WebAuthnResult `protobuf:"bytes,2,opt,name=webauthn,proto3,oneof"`
+}
+
+type StartMFAChallengeResponse_Email struct {
+	Email *EmailResult `protobuf:"bytes,3,opt,name=email,proto3,oneof"` // TOTP 无需服务端 challenge，前端直接提示输入
 }
 
 func (*StartMFAChallengeResponse_Sms) isStartMFAChallengeResponse_Challenge() {}
 
 func (*StartMFAChallengeResponse_Webauthn) isStartMFAChallengeResponse_Challenge() {}
 
+func (*StartMFAChallengeResponse_Email) isStartMFAChallengeResponse_Challenge() {}
+
 type VerifyMFAChallengeRequest struct {
 	state       protoimpl.MessageState `protogen:"open.v1"`
 	OperationId string                 `protobuf:"bytes,1,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
@@ -1289,6 +1615,7 @@ type VerifyMFAChallengeRequest struct {
 	//	*VerifyMFAChallengeRequest_Sms
 	//	*VerifyMFAChallengeRequest_Webauthn
 	//	*VerifyMFAChallengeRequest_BackupCode
+	//	*VerifyMFAChallengeRequest_Email
 	Response      isVerifyMFAChallengeRequest_Response `protobuf_oneof:"response"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -1296,7 +1623,7 @@ type VerifyMFAChallengeRequest struct {
 
 func (x *VerifyMFAChallengeRequest) Reset() {
 	*x = VerifyMFAChallengeRequest{}
-	mi := &file_authentication_service_v1_mfa_proto_msgTypes[15]
+	mi := &file_authentication_service_v1_mfa_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1308,7 +1635,7 @@ func (x *VerifyMFAChallengeRequest) String() string {
 func (*VerifyMFAChallengeRequest) ProtoMessage() {}
 
 func (x *VerifyMFAChallengeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_authentication_service_v1_mfa_proto_msgTypes[15]
+	mi := &file_authentication_service_v1_mfa_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1321,7 +1648,7 @@ func (x *VerifyMFAChallengeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VerifyMFAChallengeRequest.ProtoReflect.Descriptor instead.
 func (*VerifyMFAChallengeRequest) Descriptor() ([]byte, []int) {
-	return file_authentication_service_v1_mfa_proto_rawDescGZIP(), []int{15}
+	return file_authentication_service_v1_mfa_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *VerifyMFAChallengeRequest) GetOperationId() string {
@@ -1374,6 +1701,15 @@ func (x *VerifyMFAChallengeRequest) GetBackupCode() string {
 	return ""
 }
 
+func (x *VerifyMFAChallengeRequest) GetEmail() *EmailVerification {
+	if x != nil {
+		if x, ok := x.Response.(*VerifyMFAChallengeRequest_Email); ok {
+			return x.Email
+		}
+	}
+	return nil
+}
+
 type isVerifyMFAChallengeRequest_Response interface {
 	isVerifyMFAChallengeRequest_Response()
 }
@@ -1394,6 +1730,10 @@ type VerifyMFAChallengeRequest_BackupCode struct {
 	BackupCode string `protobuf:"bytes,13,opt,name=backup_code,json=backupCode,proto3,oneof"`
 }
 
+type VerifyMFAChallengeRequest_Email struct {
+	Email *EmailVerification `protobuf:"bytes,14,opt,name=email,proto3,oneof"`
+}
+
 func (*VerifyMFAChallengeRequest_TotpCode) isVerifyMFAChallengeRequest_Response() {}
 
 func (*VerifyMFAChallengeRequest_Sms) isVerifyMFAChallengeRequest_Response() {}
@@ -1402,6 +1742,8 @@ func (*VerifyMFAChallengeRequest_Webauthn) isVerifyMFAChallengeRequest_Response(
 
 func (*VerifyMFAChallengeRequest_BackupCode) isVerifyMFAChallengeRequest_Response() {}
 
+func (*VerifyMFAChallengeRequest_Email) isVerifyMFAChallengeRequest_Response() {}
+
 type VerifyMFAChallengeResponse struct {
 	state   protoimpl.MessageState `protogen:"open.v1"`
 	Success bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -1413,7 +1755,7 @@ type VerifyMFAChallengeResponse struct {
 
 func (x *VerifyMFAChallengeResponse) Reset() {
 	*x = VerifyMFAChallengeResponse{}
-	mi := &file_authentication_service_v1_mfa_proto_msgTypes[16]
+	mi := &file_authentication_service_v1_mfa_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1425,7 +1767,7 @@ func (x *VerifyMFAChallengeResponse) String() string {
 func (*VerifyMFAChallengeResponse) ProtoMessage() {}
 
 func (x *VerifyMFAChallengeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_authentication_service_v1_mfa_proto_msgTypes[16]
+	mi := &file_authentication_service_v1_mfa_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1438,7 +1780,7 @@ func (x *VerifyMFAChallengeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VerifyMFAChallengeResponse.ProtoReflect.Descriptor instead.
 func (*VerifyMFAChallengeResponse) Descriptor() ([]byte, []int) {
-	return file_authentication_service_v1_mfa_proto_rawDescGZIP(), []int{16}
+	return file_authentication_service_v1_mfa_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *VerifyMFAChallengeResponse) GetSuccess() bool {
@@ -1458,7 +1800,7 @@ func (x *VerifyMFAChallengeResponse) GetSessionToken() string {
 // 备份码管理
 type GenerateBackupCodesRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// 要生成的数量
+	// 要生成的数量（默认 10，最多 20）；重新生成会作废此前全部备份码
 	Count         *int32 `protobuf:"varint,1,opt,name=count,proto3,oneof" json:"count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -1466,7 +1808,7 @@ type GenerateBackupCodesRequest struct {
 
 func (x *GenerateBackupCodesRequest) Reset() {
 	*x = GenerateBackupCodesRequest{}
-	mi := &file_authentication_service_v1_mfa_proto_msgTypes[17]
+	mi := &file_authentication_service_v1_mfa_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1478,7 +1820,7 @@ func (x *GenerateBackupCodesRequest) String() string {
 func (*GenerateBackupCodesRequest) ProtoMessage() {}
 
 func (x *GenerateBackupCodesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_authentication_service_v1_mfa_proto_msgTypes[17]
+	mi := &file_authentication_service_v1_mfa_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1491,7 +1833,7 @@ func (x *GenerateBackupCodesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenerateBackupCodesRequest.ProtoReflect.Descriptor instead.
 func (*GenerateBackupCodesRequest) Descriptor() ([]byte, []int) {
-	return file_authentication_service_v1_mfa_proto_rawDescGZIP(), []int{17}
+	return file_authentication_service_v1_mfa_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *GenerateBackupCodesRequest) GetCount() int32 {
@@ -1512,7 +1854,7 @@ type GenerateBackupCodesResponse struct {
 
 func (x *GenerateBackupCodesResponse) Reset() {
 	*x = GenerateBackupCodesResponse{}
-	mi := &file_authentication_service_v1_mfa_proto_msgTypes[18]
+	mi := &file_authentication_service_v1_mfa_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1524,7 +1866,7 @@ func (x *GenerateBackupCodesResponse) String() string {
 func (*GenerateBackupCodesResponse) ProtoMessage() {}
 
 func (x *GenerateBackupCodesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_authentication_service_v1_mfa_proto_msgTypes[18]
+	mi := &file_authentication_service_v1_mfa_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1537,7 +1879,7 @@ func (x *GenerateBackupCodesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenerateBackupCodesResponse.ProtoReflect.Descriptor instead.
 func (*GenerateBackupCodesResponse) Descriptor() ([]byte, []int) {
-	return file_authentication_service_v1_mfa_proto_rawDescGZIP(), []int{18}
+	return file_authentication_service_v1_mfa_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *GenerateBackupCodesResponse) GetCodes() []string {
@@ -1562,7 +1904,7 @@ type ListBackupCodesRequest struct {
 
 func (x *ListBackupCodesRequest) Reset() {
 	*x = ListBackupCodesRequest{}
-	mi := &file_authentication_service_v1_mfa_proto_msgTypes[19]
+	mi := &file_authentication_service_v1_mfa_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1574,7 +1916,7 @@ func (x *ListBackupCodesRequest) String() string {
 func (*ListBackupCodesRequest) ProtoMessage() {}
 
 func (x *ListBackupCodesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_authentication_service_v1_mfa_proto_msgTypes[19]
+	mi := &file_authentication_service_v1_mfa_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1587,7 +1929,7 @@ func (x *ListBackupCodesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListBackupCodesRequest.ProtoReflect.Descriptor instead.
 func (*ListBackupCodesRequest) Descriptor() ([]byte, []int) {
-	return file_authentication_service_v1_mfa_proto_rawDescGZIP(), []int{19}
+	return file_authentication_service_v1_mfa_proto_rawDescGZIP(), []int{23}
 }
 
 type ListBackupCodesResponse struct {
@@ -1601,7 +1943,7 @@ type ListBackupCodesResponse struct {
 
 func (x *ListBackupCodesResponse) Reset() {
 	*x = ListBackupCodesResponse{}
-	mi := &file_authentication_service_v1_mfa_proto_msgTypes[20]
+	mi := &file_authentication_service_v1_mfa_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1613,7 +1955,7 @@ func (x *ListBackupCodesResponse) String() string {
 func (*ListBackupCodesResponse) ProtoMessage() {}
 
 func (x *ListBackupCodesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_authentication_service_v1_mfa_proto_msgTypes[20]
+	mi := &file_authentication_service_v1_mfa_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1626,7 +1968,7 @@ func (x *ListBackupCodesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListBackupCodesResponse.ProtoReflect.Descriptor instead.
 func (*ListBackupCodesResponse) Descriptor() ([]byte, []int) {
-	return file_authentication_service_v1_mfa_proto_rawDescGZIP(), []int{20}
+	return file_authentication_service_v1_mfa_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *ListBackupCodesResponse) GetRemaining() int32 {
@@ -1653,7 +1995,7 @@ type RevokeMFADeviceRequest struct {
 
 func (x *RevokeMFADeviceRequest) Reset() {
 	*x = RevokeMFADeviceRequest{}
-	mi := &file_authentication_service_v1_mfa_proto_msgTypes[21]
+	mi := &file_authentication_service_v1_mfa_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1665,7 +2007,7 @@ func (x *RevokeMFADeviceRequest) String() string {
 func (*RevokeMFADeviceRequest) ProtoMessage() {}
 
 func (x *RevokeMFADeviceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_authentication_service_v1_mfa_proto_msgTypes[21]
+	mi := &file_authentication_service_v1_mfa_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1678,7 +2020,7 @@ func (x *RevokeMFADeviceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RevokeMFADeviceRequest.ProtoReflect.Descriptor instead.
 func (*RevokeMFADeviceRequest) Descriptor() ([]byte, []int) {
-	return file_authentication_service_v1_mfa_proto_rawDescGZIP(), []int{21}
+	return file_authentication_service_v1_mfa_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *RevokeMFADeviceRequest) GetCredentialId() string {
@@ -1698,7 +2040,7 @@ type SMSVerification struct {
 
 func (x *SMSVerification) Reset() {
 	*x = SMSVerification{}
-	mi := &file_authentication_service_v1_mfa_proto_msgTypes[22]
+	mi := &file_authentication_service_v1_mfa_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1710,7 +2052,7 @@ func (x *SMSVerification) String() string {
 func (*SMSVerification) ProtoMessage() {}
 
 func (x *SMSVerification) ProtoReflect() protoreflect.Message {
-	mi := &file_authentication_service_v1_mfa_proto_msgTypes[22]
+	mi := &file_authentication_service_v1_mfa_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1723,7 +2065,7 @@ func (x *SMSVerification) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SMSVerification.ProtoReflect.Descriptor instead.
 func (*SMSVerification) Descriptor() ([]byte, []int) {
-	return file_authentication_service_v1_mfa_proto_rawDescGZIP(), []int{22}
+	return file_authentication_service_v1_mfa_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *SMSVerification) GetVerificationId() string {
@@ -1740,6 +2082,61 @@ func (x *SMSVerification) GetCode() string {
 	return ""
 }
 
+type EmailVerification struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	VerificationId string                 `protobuf:"bytes,1,opt,name=verification_id,json=verificationId,proto3" json:"verification_id,omitempty"`
+	Code           string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *EmailVerification) Reset() {
+	*x = EmailVerification{}
+	mi := &file_authentication_service_v1_mfa_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmailVerification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmailVerification) ProtoMessage() {}
+
+func (x *EmailVerification) ProtoReflect() protoreflect.Message {
+	mi := &file_authentication_service_v1_mfa_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmailVerification.ProtoReflect.Descriptor instead.
+func (*EmailVerification) Descriptor() ([]byte, []int) {
+	return file_authentication_service_v1_mfa_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *EmailVerification) GetVerificationId() string {
+	if x != nil {
+		return x.VerificationId
+	}
+	return ""
+}
+
+func (x *EmailVerification) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+// WebAuthn 认证器响应（二进制字段均为 base64url）。
+// 断言（登录/验证）填 authenticator_data/signature/user_handle；
+// 注册确认填 attestation_object/transports。
 type WebAuthnAssertion struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
 	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -1747,13 +2144,15 @@ type WebAuthnAssertion struct {
 	AuthenticatorData string                 `protobuf:"bytes,3,opt,name=authenticator_data,json=authenticatorData,proto3" json:"authenticator_data,omitempty"`
 	Signature         string                 `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
 	UserHandle        *string                `protobuf:"bytes,5,opt,name=user_handle,json=userHandle,proto3,oneof" json:"user_handle,omitempty"`
+	AttestationObject *string                `protobuf:"bytes,6,opt,name=attestation_object,json=attestationObject,proto3,oneof" json:"attestation_object,omitempty"`
+	Transports        []string               `protobuf:"bytes,7,rep,name=transports,proto3" json:"transports,omitempty"`
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
 
 func (x *WebAuthnAssertion) Reset() {
 	*x = WebAuthnAssertion{}
-	mi := &file_authentication_service_v1_mfa_proto_msgTypes[23]
+	mi := &file_authentication_service_v1_mfa_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1765,7 +2164,7 @@ func (x *WebAuthnAssertion) String() string {
 func (*WebAuthnAssertion) ProtoMessage() {}
 
 func (x *WebAuthnAssertion) ProtoReflect() protoreflect.Message {
-	mi := &file_authentication_service_v1_mfa_proto_msgTypes[23]
+	mi := &file_authentication_service_v1_mfa_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1778,7 +2177,7 @@ func (x *WebAuthnAssertion) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WebAuthnAssertion.ProtoReflect.Descriptor instead.
 func (*WebAuthnAssertion) Descriptor() ([]byte, []int) {
-	return file_authentication_service_v1_mfa_proto_rawDescGZIP(), []int{23}
+	return file_authentication_service_v1_mfa_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *WebAuthnAssertion) GetId() string {
@@ -1816,19 +2215,218 @@ func (x *WebAuthnAssertion) GetUserHandle() string {
 	return ""
 }
 
+func (x *WebAuthnAssertion) GetAttestationObject() string {
+	if x != nil && x.AttestationObject != nil {
+		return *x.AttestationObject
+	}
+	return ""
+}
+
+func (x *WebAuthnAssertion) GetTransports() []string {
+	if x != nil {
+		return x.Transports
+	}
+	return nil
+}
+
+// 通行密钥无密码登录
+type StartPasskeyLoginRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClientType    *ClientType            `protobuf:"varint,1,opt,name=client_type,json=clientType,proto3,enum=authentication.service.v1.ClientType,oneof" json:"client_type,omitempty"` // 登录完成后签发令牌的客户端类型
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartPasskeyLoginRequest) Reset() {
+	*x = StartPasskeyLoginRequest{}
+	mi := &file_authentication_service_v1_mfa_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartPasskeyLoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartPasskeyLoginRequest) ProtoMessage() {}
+
+func (x *StartPasskeyLoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authentication_service_v1_mfa_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartPasskeyLoginRequest.ProtoReflect.Descriptor instead.
+func (*StartPasskeyLoginRequest) Descriptor() ([]byte, []int) {
+	return file_authentication_service_v1_mfa_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *StartPasskeyLoginRequest) GetClientType() ClientType {
+	if x != nil && x.ClientType != nil {
+		return *x.ClientType
+	}
+	return ClientType_admin
+}
+
+type StartPasskeyLoginResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OperationId   string                 `protobuf:"bytes,1,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"` // FinishPasskeyLogin 时原样带回
+	Webauthn      *WebAuthnResult        `protobuf:"bytes,2,opt,name=webauthn,proto3" json:"webauthn,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartPasskeyLoginResponse) Reset() {
+	*x = StartPasskeyLoginResponse{}
+	mi := &file_authentication_service_v1_mfa_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartPasskeyLoginResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartPasskeyLoginResponse) ProtoMessage() {}
+
+func (x *StartPasskeyLoginResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_authentication_service_v1_mfa_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartPasskeyLoginResponse.ProtoReflect.Descriptor instead.
+func (*StartPasskeyLoginResponse) Descriptor() ([]byte, []int) {
+	return file_authentication_service_v1_mfa_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *StartPasskeyLoginResponse) GetOperationId() string {
+	if x != nil {
+		return x.OperationId
+	}
+	return ""
+}
+
+func (x *StartPasskeyLoginResponse) GetWebauthn() *WebAuthnResult {
+	if x != nil {
+		return x.Webauthn
+	}
+	return nil
+}
+
+func (x *StartPasskeyLoginResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type FinishPasskeyLoginRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OperationId   string                 `protobuf:"bytes,1,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
+	Webauthn      *WebAuthnAssertion     `protobuf:"bytes,2,opt,name=webauthn,proto3" json:"webauthn,omitempty"`
+	DeviceId      *string                `protobuf:"bytes,3,opt,name=device_id,json=deviceId,proto3,oneof" json:"device_id,omitempty"` // 设备ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FinishPasskeyLoginRequest) Reset() {
+	*x = FinishPasskeyLoginRequest{}
+	mi := &file_authentication_service_v1_mfa_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FinishPasskeyLoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinishPasskeyLoginRequest) ProtoMessage() {}
+
+func (x *FinishPasskeyLoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authentication_service_v1_mfa_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FinishPasskeyLoginRequest.ProtoReflect.Descriptor instead.
+func (*FinishPasskeyLoginRequest) Descriptor() ([]byte, []int) {
+	return file_authentication_service_v1_mfa_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *FinishPasskeyLoginRequest) GetOperationId() string {
+	if x != nil {
+		return x.OperationId
+	}
+	return ""
+}
+
+func (x *FinishPasskeyLoginRequest) GetWebauthn() *WebAuthnAssertion {
+	if x != nil {
+		return x.Webauthn
+	}
+	return nil
+}
+
+func (x *FinishPasskeyLoginRequest) GetDeviceId() string {
+	if x != nil && x.DeviceId != nil {
+		return *x.DeviceId
+	}
+	return ""
+}
+
 var File_authentication_service_v1_mfa_proto protoreflect.FileDescriptor
 
 const file_authentication_service_v1_mfa_proto_rawDesc = "" +
 	"\n" +
-	"#authentication/service/v1/mfa.proto\x12\x19authentication.service.v1\x1a$gnostic/openapi/v3/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\"?\n" +
+	"#authentication/service/v1/mfa.proto\x12\x19authentication.service.v1\x1a$gnostic/openapi/v3/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a.authentication/service/v1/authentication.proto\"?\n" +
 	"\x13GetMFAStatusRequest\x12\x1c\n" +
 	"\auser_id\x18\x01 \x01(\tH\x00R\x06userId\x88\x01\x01B\n" +
 	"\n" +
-	"\b_user_id\"\xc4\x01\n" +
+	"\b_user_id\"\xfa\x01\n" +
 	"\x14GetMFAStatusResponse\x12\x18\n" +
 	"\aenabled\x18\x01 \x01(\bR\aenabled\x12E\n" +
 	"\benrolled\x18\x02 \x03(\v2).authentication.service.v1.EnrolledMethodR\benrolled\x12K\n" +
-	"\venforcement\x18\x03 \x01(\x0e2).authentication.service.v1.MFAEnforcementR\venforcement\"\xb5\x02\n" +
+	"\venforcement\x18\x03 \x01(\x0e2).authentication.service.v1.MFAEnforcementR\venforcement\x124\n" +
+	"\x16backup_codes_remaining\x18\x04 \x01(\x05R\x14backupCodesRemaining\"\xd2\x04\n" +
+	"\tMFAPolicy\x12D\n" +
+	"\ttenant_id\x18\x01 \x01(\rB\"\xbaG\x1f\x92\x02\x1c租户ID，0 为平台策略H\x00R\btenantId\x88\x01\x01\x12\xb4\x01\n" +
+	"\venforcement\x18\x02 \x01(\x0e2).authentication.service.v1.MFAEnforcementBg\xbaGd\x92\x02a对全体用户的要求：MFA_REQUIRED 强制、MFA_OPTIONAL 建议、MFA_NOT_REQUIRED 不要求R\venforcement\x12\x90\x01\n" +
+	"\x13required_role_codes\x18\x03 \x03(\tB`\xbaG]\x92\x02Z持有其中任一角色的用户必须启用 MFA（如 platform:admin、tenant:manager）R\x11requiredRoleCodes\x125\n" +
+	"\n" +
+	"updated_by\x18\n" +
+	" \x01(\rB\x11\xbaG\x0e\x92\x02\v更新者IDH\x01R\tupdatedBy\x88\x01\x01\x12R\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f更新时间H\x02R\tupdatedAt\x88\x01\x01B\f\n" +
+	"\n" +
+	"_tenant_idB\r\n" +
+	"\v_updated_byB\r\n" +
+	"\v_updated_at\"E\n" +
+	"\x13GetMFAPolicyRequest\x12 \n" +
+	"\ttenant_id\x18\x01 \x01(\rH\x00R\btenantId\x88\x01\x01B\f\n" +
+	"\n" +
+	"_tenant_id\"R\n" +
+	"\x16UpdateMFAPolicyRequest\x128\n" +
+	"\x04data\x18\x01 \x01(\v2$.authentication.service.v1.MFAPolicyR\x04data\"\xb5\x02\n" +
 	"\x0eEnrolledMethod\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12<\n" +
 	"\x06method\x18\x02 \x01(\x0e2$.authentication.service.v1.MFAMethodR\x06method\x12\x18\n" +
@@ -1845,17 +2443,20 @@ func (x *WebAuthnAssertion) GetUserHandle() string {
 	"\n" +
 	"\b_user_id\"^\n" +
 	"\x1bListEnrolledMethodsResponse\x12?\n" +
-	"\x05items\x18\x01 \x03(\v2).authentication.service.v1.EnrolledMethodR\x05items\"\xa2\x01\n" +
+	"\x05items\x18\x01 \x03(\v2).authentication.service.v1.EnrolledMethodR\x05items\"\xec\x01\n" +
 	"\x18StartEnrollMethodRequest\x12<\n" +
 	"\x06method\x18\x01 \x01(\x0e2$.authentication.service.v1.MFAMethodR\x06method\x12\x19\n" +
 	"\x05phone\x18\x02 \x01(\tH\x00R\x05phone\x88\x01\x01\x12\x19\n" +
-	"\x05email\x18\x03 \x01(\tH\x01R\x05email\x88\x01\x01B\b\n" +
+	"\x05email\x18\x03 \x01(\tH\x01R\x05email\x88\x01\x01\x121\n" +
+	"\x12login_operation_id\x18\x04 \x01(\tH\x02R\x10loginOperationId\x88\x01\x01B\b\n" +
 	"\x06_phoneB\b\n" +
-	"\x06_email\"\xd7\x02\n" +
+	"\x06_emailB\x15\n" +
+	"\x13_login_operation_id\"\x97\x03\n" +
 	"\x19StartEnrollMethodResponse\x12;\n" +
 	"\x04totp\x18\x01 \x01(\v2%.authentication.service.v1.TOTPResultH\x00R\x04totp\x128\n" +
 	"\x03sms\x18\x02 \x01(\v2$.authentication.service.v1.SMSResultH\x00R\x03sms\x12G\n" +
 	"\bwebauthn\x18\x03 \x01(\v2).authentication.service.v1.WebAuthnResultH\x00R\bwebauthn\x12>\n" +
+	"\x05email\x18\x04 \x01(\v2&.authentication.service.v1.EmailResultH\x00R\x05email\x12>\n" +
 	"\n" +
 	"expires_at\x18\n" +
 	" \x01(\v2\x1a.google.protobuf.TimestampH\x01R\texpiresAt\x88\x01\x01\x12!\n" +
@@ -1867,15 +2468,22 @@ func (x *WebAuthnAssertion) GetUserHandle() string {
 	"\x06secret\x18\x01 \x01(\tR\x06secret\x12 \n" +
 	"\fotp_auth_url\x18\x02 \x01(\tR\n" +
 	"otpAuthUrl\x12'\n" +
-	"\x10qr_code_data_uri\x18\x03 \x01(\tR\rqrCodeDataUri\"r\n" +
+	"\x10qr_code_data_uri\x18\x03 \x01(\tR\rqrCodeDataUri\"\xa4\x01\n" +
 	"\tSMSResult\x12'\n" +
 	"\x0fverification_id\x18\x01 \x01(\tR\x0everificationId\x12\x19\n" +
 	"\bsms_sent\x18\x02 \x01(\bR\asmsSent\x12!\n" +
-	"\fmasked_phone\x18\x03 \x01(\tR\vmaskedPhone\"f\n" +
+	"\fmasked_phone\x18\x03 \x01(\tR\vmaskedPhone\x120\n" +
+	"\x14resend_after_seconds\x18\x04 \x01(\x05R\x12resendAfterSeconds\"\xaa\x01\n" +
+	"\vEmailResult\x12'\n" +
+	"\x0fverification_id\x18\x01 \x01(\tR\x0everificationId\x12\x1d\n" +
+	"\n" +
+	"email_sent\x18\x02 \x01(\bR\temailSent\x12!\n" +
+	"\fmasked_email\x18\x03 \x01(\tR\vmaskedEmail\x120\n" +
+	"\x14resend_after_seconds\x18\x04 \x01(\x05R\x12resendAfterSeconds\"f\n" +
 	"\x0eWebAuthnResult\x12\x1c\n" +
 	"\tchallenge\x18\x01 \x01(\tR\tchallenge\x12!\n" +
 	"\foptions_json\x18\x02 \x01(\tR\voptionsJson\x12\x13\n" +
-	"\x05rp_id\x18\x03 \x01(\tR\x04rpId\"\x84\x03\n" +
+	"\x05rp_id\x18\x03 \x01(\tR\x04rpId\"\x94\x04\n" +
 	"\x1aConfirmEnrollMethodRequest\x12<\n" +
 	"\x06method\x18\x01 \x01(\x0e2$.authentication.service.v1.MFAMethodR\x06method\x12!\n" +
 	"\foperation_id\x18\x02 \x01(\tR\voperationId\x12\x1d\n" +
@@ -1884,12 +2492,15 @@ func (x *WebAuthnAssertion) GetUserHandle() string {
 	"\x03sms\x18\v \x01(\v2*.authentication.service.v1.SMSVerificationH\x00R\x03sms\x12J\n" +
 	"\bwebauthn\x18\f \x01(\v2,.authentication.service.v1.WebAuthnAssertionH\x00R\bwebauthn\x12!\n" +
 	"\vbackup_code\x18\r \x01(\tH\x00R\n" +
-	"backupCode\x12\x1d\n" +
-	"\adisplay\x18\x14 \x01(\tH\x01R\adisplay\x88\x01\x01B\f\n" +
+	"backupCode\x12D\n" +
+	"\x05email\x18\x0e \x01(\v2,.authentication.service.v1.EmailVerificationH\x00R\x05email\x12\x1d\n" +
+	"\adisplay\x18\x14 \x01(\tH\x01R\adisplay\x88\x01\x01\x121\n" +
+	"\x12login_operation_id\x18\x15 \x01(\tH\x02R\x10loginOperationId\x88\x01\x01B\f\n" +
 	"\n" +
 	"credentialB\n" +
 	"\n" +
-	"\b_display\"\\\n" +
+	"\b_displayB\x15\n" +
+	"\x13_login_operation_id\"\\\n" +
 	"\x1bConfirmEnrollMethodResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12#\n" +
 	"\rcredential_id\x18\x02 \x01(\tR\fcredentialId\"\xc4\x03\n" +
@@ -1909,23 +2520,26 @@ func (x *WebAuthnAssertion) GetUserHandle() string {
 	"\a_methodB\t\n" +
 	"\a_reasonB\n" +
 	"\n" +
-	"\b_user_id\"\xbe\x01\n" +
+	"\b_user_id\"\xf7\x01\n" +
 	"\x18StartMFAChallengeRequest\x12\x1c\n" +
 	"\auser_id\x18\x01 \x01(\tH\x00R\x06userId\x88\x01\x01\x12<\n" +
 	"\x06method\x18\x02 \x01(\x0e2$.authentication.service.v1.MFAMethodR\x06method\x12(\n" +
-	"\rcredential_id\x18\x03 \x01(\tH\x01R\fcredentialId\x88\x01\x01B\n" +
+	"\rcredential_id\x18\x03 \x01(\tH\x01R\fcredentialId\x88\x01\x01\x12&\n" +
+	"\foperation_id\x18\x04 \x01(\tH\x02R\voperationId\x88\x01\x01B\n" +
 	"\n" +
 	"\b_user_idB\x10\n" +
-	"\x0e_credential_id\"\x9d\x02\n" +
+	"\x0e_credential_idB\x0f\n" +
+	"\r_operation_id\"\xdd\x02\n" +
 	"\x19StartMFAChallengeResponse\x128\n" +
 	"\x03sms\x18\x01 \x01(\v2$.authentication.service.v1.SMSResultH\x00R\x03sms\x12G\n" +
-	"\bwebauthn\x18\x02 \x01(\v2).authentication.service.v1.WebAuthnResultH\x00R\bwebauthn\x12!\n" +
+	"\bwebauthn\x18\x02 \x01(\v2).authentication.service.v1.WebAuthnResultH\x00R\bwebauthn\x12>\n" +
+	"\x05email\x18\x03 \x01(\v2&.authentication.service.v1.EmailResultH\x00R\x05email\x12!\n" +
 	"\foperation_id\x18\n" +
 	" \x01(\tR\voperationId\x12>\n" +
 	"\n" +
 	"expires_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampH\x01R\texpiresAt\x88\x01\x01B\v\n" +
 	"\tchallengeB\r\n" +
-	"\v_expires_at\"\x98\x02\n" +
+	"\v_expires_at\"\xde\x02\n" +
 	"\x19VerifyMFAChallengeRequest\x12!\n" +
 	"\foperation_id\x18\x01 \x01(\tR\voperationId\x12\x1d\n" +
 	"\ttotp_code\x18\n" +
@@ -1933,7 +2547,8 @@ func (x *WebAuthnAssertion) GetUserHandle() string {
 	"\x03sms\x18\v \x01(\v2*.authentication.service.v1.SMSVerificationH\x00R\x03sms\x12J\n" +
 	"\bwebauthn\x18\f \x01(\v2,.authentication.service.v1.WebAuthnAssertionH\x00R\bwebauthn\x12!\n" +
 	"\vbackup_code\x18\r \x01(\tH\x00R\n" +
-	"backupCodeB\n" +
+	"backupCode\x12D\n" +
+	"\x05email\x18\x0e \x01(\v2,.authentication.service.v1.EmailVerificationH\x00R\x05emailB\n" +
 	"\n" +
 	"\bresponse\"r\n" +
 	"\x1aVerifyMFAChallengeResponse\x12\x18\n" +
@@ -1956,15 +2571,38 @@ func (x *WebAuthnAssertion) GetUserHandle() string {
 	"\rcredential_id\x18\x01 \x01(\tR\fcredentialId\"N\n" +
 	"\x0fSMSVerification\x12'\n" +
 	"\x0fverification_id\x18\x01 \x01(\tR\x0everificationId\x12\x12\n" +
-	"\x04code\x18\x02 \x01(\tR\x04code\"\xd0\x01\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\"P\n" +
+	"\x11EmailVerification\x12'\n" +
+	"\x0fverification_id\x18\x01 \x01(\tR\x0everificationId\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\"\xbb\x02\n" +
 	"\x11WebAuthnAssertion\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12(\n" +
 	"\x10client_data_json\x18\x02 \x01(\tR\x0eclientDataJson\x12-\n" +
 	"\x12authenticator_data\x18\x03 \x01(\tR\x11authenticatorData\x12\x1c\n" +
 	"\tsignature\x18\x04 \x01(\tR\tsignature\x12$\n" +
 	"\vuser_handle\x18\x05 \x01(\tH\x00R\n" +
-	"userHandle\x88\x01\x01B\x0e\n" +
-	"\f_user_handle*x\n" +
+	"userHandle\x88\x01\x01\x122\n" +
+	"\x12attestation_object\x18\x06 \x01(\tH\x01R\x11attestationObject\x88\x01\x01\x12\x1e\n" +
+	"\n" +
+	"transports\x18\a \x03(\tR\n" +
+	"transportsB\x0e\n" +
+	"\f_user_handleB\x15\n" +
+	"\x13_attestation_object\"w\n" +
+	"\x18StartPasskeyLoginRequest\x12K\n" +
+	"\vclient_type\x18\x01 \x01(\x0e2%.authentication.service.v1.ClientTypeH\x00R\n" +
+	"clientType\x88\x01\x01B\x0e\n" +
+	"\f_client_type\"\xc0\x01\n" +
+	"\x19StartPasskeyLoginResponse\x12!\n" +
+	"\foperation_id\x18\x01 \x01(\tR\voperationId\x12E\n" +
+	"\bwebauthn\x18\x02 \x01(\v2).authentication.service.v1.WebAuthnResultR\bwebauthn\x129\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"\xb8\x01\n" +
+	"\x19FinishPasskeyLoginRequest\x12!\n" +
+	"\foperation_id\x18\x01 \x01(\tR\voperationId\x12H\n" +
+	"\bwebauthn\x18\x02 \x01(\v2,.authentication.service.v1.WebAuthnAssertionR\bwebauthn\x12 \n" +
+	"\tdevice_id\x18\x03 \x01(\tH\x00R\bdeviceId\x88\x01\x01B\f\n" +
+	"\n" +
+	"_device_id*x\n" +
 	"\tMFAMethod\x12\x1a\n" +
 	"\x16MFA_METHOD_UNSPECIFIED\x10\x00\x12\b\n" +
 	"\x04TOTP\x10\x01\x12\a\n" +
@@ -1977,7 +2615,7 @@ func (x *WebAuthnAssertion) GetUserHandle() string {
 	"\x0eMFAEnforcement\x12\x14\n" +
 	"\x10MFA_NOT_REQUIRED\x10\x00\x12\x10\n" +
 	"\fMFA_OPTIONAL\x10\x01\x12\x10\n" +
-	"\fMFA_REQUIRED\x10\x022\xd8\t\n" +
+	"\fMFA_REQUIRED\x10\x022\xad\x0f\n" +
 	"\n" +
 	"MFAService\x12q\n" +
 	"\fGetMFAStatus\x12..authentication.service.v1.GetMFAStatusRequest\x1a/.authentication.service.v1.GetMFAStatusResponse\"\x00\x12\x86\x01\n" +
@@ -1990,7 +2628,13 @@ func (x *WebAuthnAssertion) GetUserHandle() string {
 	"\x12VerifyMFAChallenge\x124.authentication.service.v1.VerifyMFAChallengeRequest\x1a5.authentication.service.v1.VerifyMFAChallengeResponse\"\x00\x12\x86\x01\n" +
 	"\x13GenerateBackupCodes\x125.authentication.service.v1.GenerateBackupCodesRequest\x1a6.authentication.service.v1.GenerateBackupCodesResponse\"\x00\x12z\n" +
 	"\x0fListBackupCodes\x121.authentication.service.v1.ListBackupCodesRequest\x1a2.authentication.service.v1.ListBackupCodesResponse\"\x00\x12^\n" +
-	"\x0fRevokeMFADevice\x121.authentication.service.v1.RevokeMFADeviceRequest\x1a\x16.google.protobuf.Empty\"\x00B\xf4\x01\n" +
+	"\x0fRevokeMFADevice\x121.authentication.service.v1.RevokeMFADeviceRequest\x1a\x16.google.protobuf.Empty\"\x00\x12\x80\x01\n" +
+	"\x11StartPasskeyLogin\x123.authentication.service.v1.StartPasskeyLoginRequest\x1a4.authentication.service.v1.StartPasskeyLoginResponse\"\x00\x12v\n" +
+	"\x12FinishPasskeyLogin\x124.authentication.service.v1.FinishPasskeyLoginRequest\x1a(.authentication.service.v1.LoginResponse\"\x00\x12\x83\x01\n" +
+	"\x14StartLoginEnrollment\x123.authentication.service.v1.StartEnrollMethodRequest\x1a4.authentication.service.v1.StartEnrollMethodResponse\"\x00\x12\x89\x01\n" +
+	"\x16ConfirmLoginEnrollment\x125.authentication.service.v1.ConfirmEnrollMethodRequest\x1a6.authentication.service.v1.ConfirmEnrollMethodResponse\"\x00\x12f\n" +
+	"\fGetMFAPolicy\x12..authentication.service.v1.GetMFAPolicyRequest\x1a$.authentication.service.v1.MFAPolicy\"\x00\x12^\n" +
+	"\x0fUpdateMFAPolicy\x121.authentication.service.v1.UpdateMFAPolicyRequest\x1a\x16.google.protobuf.Empty\"\x00B\xf4\x01\n" +
 	"\x1dcom.authentication.service.v1B\bMfaProtoP\x01ZCgo-wind-admin/api/gen/go/authentication/service/v1;authenticationpb\xa2\x02\x03ASX\xaa\x02\x19Authentication.Service.V1\xca\x02\x19Authentication\\Service\\V1\xe2\x02%Authentication\\Service\\V1\\GPBMetadata\xea\x02\x1bAuthentication::Service::V1b\x06proto3"
 
 var (
@@ -2006,88 +2650,121 @@ func file_authentication_service_v1_mfa_proto_rawDescGZIP() []byte {
 }
 
 var file_authentication_service_v1_mfa_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_authentication_service_v1_mfa_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
+var file_authentication_service_v1_mfa_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
 var file_authentication_service_v1_mfa_proto_goTypes = []any{
 	(MFAMethod)(0),                      // 0: authentication.service.v1.MFAMethod
 	(MFAEnforcement)(0),                 // 1: authentication.service.v1.MFAEnforcement
 	(*GetMFAStatusRequest)(nil),         // 2: authentication.service.v1.GetMFAStatusRequest
 	(*GetMFAStatusResponse)(nil),        // 3: authentication.service.v1.GetMFAStatusResponse
-	(*EnrolledMethod)(nil),              // 4: authentication.service.v1.EnrolledMethod
-	(*ListEnrolledMethodsRequest)(nil),  // 5: authentication.service.v1.ListEnrolledMethodsRequest
-	(*ListEnrolledMethodsResponse)(nil), // 6: authentication.service.v1.ListEnrolledMethodsResponse
-	(*StartEnrollMethodRequest)(nil),    // 7: authentication.service.v1.StartEnrollMethodRequest
-	(*StartEnrollMethodResponse)(nil),   // 8: authentication.service.v1.StartEnrollMethodResponse
-	(*TOTPResult)(nil),                  // 9: authentication.service.v1.TOTPResult
-	(*SMSResult)(nil),                   // 10: authentication.service.v1.SMSResult
-	(*WebAuthnResult)(nil),              // 11: authentication.service.v1.WebAuthnResult
-	(*ConfirmEnrollMethodRequest)(nil),  // 12: authentication.service.v1.ConfirmEnrollMethodRequest
-	(*ConfirmEnrollMethodResponse)(nil), // 13: authentication.service.v1.ConfirmEnrollMethodResponse
-	(*DisableMFARequest)(nil),           // 14: authentication.service.v1.DisableMFARequest
-	(*StartMFAChallengeRequest)(nil),    // 15: authentication.service.v1.StartMFAChallengeRequest
-	(*StartMFAChallengeResponse)(nil),   // 16: authentication.service.v1.StartMFAChallengeResponse
-	(*VerifyMFAChallengeRequest)(nil),   // 17: authentication.service.v1.VerifyMFAChallengeRequest
-	(*VerifyMFAChallengeResponse)(nil),  // 18: authentication.service.v1.VerifyMFAChallengeResponse
-	(*GenerateBackupCodesRequest)(nil),  // 19: authentication.service.v1.GenerateBackupCodesRequest
-	(*GenerateBackupCodesResponse)(nil), // 20: authentication.service.v1.GenerateBackupCodesResponse
-	(*ListBackupCodesRequest)(nil),      // 21: authentication.service.v1.ListBackupCodesRequest
-	(*ListBackupCodesResponse)(nil),     // 22: authentication.service.v1.ListBackupCodesResponse
-	(*RevokeMFADeviceRequest)(nil),      // 23: authentication.service.v1.RevokeMFADeviceRequest
-	(*SMSVerification)(nil),             // 24: authentication.service.v1.SMSVerification
-	(*WebAuthnAssertion)(nil),           // 25: authentication.service.v1.WebAuthnAssertion
-	(*timestamppb.Timestamp)(nil),       // 26: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),               // 27: google.protobuf.Empty
+	(*MFAPolicy)(nil),                   // 4: authentication.service.v1.MFAPolicy
+	(*GetMFAPolicyRequest)(nil),         // 5: authentication.service.v1.GetMFAPolicyRequest
+	(*UpdateMFAPolicyRequest)(nil),      // 6: authentication.service.v1.UpdateMFAPolicyRequest
+	(*EnrolledMethod)(nil),              // 7: authentication.service.v1.EnrolledMethod
+	(*ListEnrolledMethodsRequest)(nil),  // 8: authentication.service.v1.ListEnrolledMethodsRequest
+	(*ListEnrolledMethodsResponse)(nil), // 9: authentication.service.v1.ListEnrolledMethodsResponse
+	(*StartEnrollMethodRequest)(nil),    // 10: authentication.service.v1.StartEnrollMethodRequest
+	(*StartEnrollMethodResponse)(nil),   // 11: authentication.service.v1.StartEnrollMethodResponse
+	(*TOTPResult)(nil),                  // 12: authentication.service.v1.TOTPResult
+	(*SMSResult)(nil),                   // 13: authentication.service.v1.SMSResult
+	(*EmailResult)(nil),                 // 14: authentication.service.v1.EmailResult
+	(*WebAuthnResult)(nil),              // 15: authentication.service.v1.WebAuthnResult
+	(*ConfirmEnrollMethodRequest)(nil),  // 16: authentication.service.v1.ConfirmEnrollMethodRequest
+	(*ConfirmEnrollMethodResponse)(nil), // 17: authentication.service.v1.ConfirmEnrollMethodResponse
+	(*DisableMFARequest)(nil),           // 18: authentication.service.v1.DisableMFARequest
+	(*StartMFAChallengeRequest)(nil),    // 19: authentication.service.v1.StartMFAChallengeRequest
+	(*StartMFAChallengeResponse)(nil),   // 20: authentication.service.v1.StartMFAChallengeResponse
+	(*VerifyMFAChallengeRequest)(nil),   // 21: authentication.service.v1.VerifyMFAChallengeRequest
+	(*VerifyMFAChallengeResponse)(nil),  // 22: authentication.service.v1.VerifyMFAChallengeResponse
+	(*GenerateBackupCodesRequest)(nil),  // 23: authentication.service.v1.GenerateBackupCodesRequest
+	(*GenerateBackupCodesResponse)(nil), // 24: authentication.service.v1.GenerateBackupCodesResponse
+	(*ListBackupCodesRequest)(nil),      // 25: authentication.service.v1.ListBackupCodesRequest
+	(*ListBackupCodesResponse)(nil),     // 26: authentication.service.v1.ListBackupCodesResponse
+	(*RevokeMFADeviceRequest)(nil),      // 27: authentication.service.v1.RevokeMFADeviceRequest
+	(*SMSVerification)(nil),             // 28: authentication.service.v1.SMSVerification
+	(*EmailVerification)(nil),           // 29: authentication.service.v1.EmailVerification
+	(*WebAuthnAssertion)(nil),           // 30: authentication.service.v1.WebAuthnAssertion
+	(*StartPasskeyLoginRequest)(nil),    // 31: authentication.service.v1.StartPasskeyLoginRequest
+	(*StartPasskeyLoginResponse)(nil),   // 32: authentication.service.v1.StartPasskeyLoginResponse
+	(*FinishPasskeyLoginRequest)(nil),   // 33: authentication.service.v1.FinishPasskeyLoginRequest
+	(*timestamppb.Timestamp)(nil),       // 34: google.protobuf.Timestamp
+	(ClientType)(0),                     // 35: authentication.service.v1.ClientType
+	(*emptypb.Empty)(nil),               // 36: google.protobuf.Empty
+	(*LoginResponse)(nil),               // 37: authentication.service.v1.LoginResponse
 }
 var file_authentication_service_v1_mfa_proto_depIdxs = []int32{
-	4,  // 0: authentication.service.v1.GetMFAStatusResponse.enrolled:type_name -> authentication.service.v1.EnrolledMethod
+	7,  // 0: authentication.service.v1.GetMFAStatusResponse.enrolled:type_name -> authentication.service.v1.EnrolledMethod
 	1,  // 1: authentication.service.v1.GetMFAStatusResponse.enforcement:type_name -> authentication.service.v1.MFAEnforcement
-	0,  // 2: authentication.service.v1.EnrolledMethod.method:type_name -> authentication.service.v1.MFAMethod
-	26, // 3: authentication.service.v1.EnrolledMethod.created_at:type_name -> google.protobuf.Timestamp
-	26, // 4: authentication.service.v1.EnrolledMethod.last_used_at:type_name -> google.protobuf.Timestamp
-	4,  // 5: authentication.service.v1.ListEnrolledMethodsResponse.items:type_name -> authentication.service.v1.EnrolledMethod
-	0,  // 6: authentication.service.v1.StartEnrollMethodRequest.method:type_name -> authentication.service.v1.MFAMethod
-	9,  // 7: authentication.service.v1.StartEnrollMethodResponse.totp:type_name -> authentication.service.v1.TOTPResult
-	10, // 8: authentication.service.v1.StartEnrollMethodResponse.sms:type_name -> authentication.service.v1.SMSResult
-	11, // 9: authentication.service.v1.StartEnrollMethodResponse.webauthn:type_name -> authentication.service.v1.WebAuthnResult
-	26, // 10: authentication.service.v1.StartEnrollMethodResponse.expires_at:type_name -> google.protobuf.Timestamp
-	0,  // 11: authentication.service.v1.ConfirmEnrollMethodRequest.method:type_name -> authentication.service.v1.MFAMethod
-	24, // 12: authentication.service.v1.ConfirmEnrollMethodRequest.sms:type_name -> authentication.service.v1.SMSVerification
-	25, // 13: authentication.service.v1.ConfirmEnrollMethodRequest.webauthn:type_name -> authentication.service.v1.WebAuthnAssertion
-	0,  // 14: authentication.service.v1.DisableMFARequest.method:type_name -> authentication.service.v1.MFAMethod
-	24, // 15: authentication.service.v1.DisableMFARequest.sms:type_name -> authentication.service.v1.SMSVerification
-	25, // 16: authentication.service.v1.DisableMFARequest.webauthn:type_name -> authentication.service.v1.WebAuthnAssertion
-	0,  // 17: authentication.service.v1.StartMFAChallengeRequest.method:type_name -> authentication.service.v1.MFAMethod
-	10, // 18: authentication.service.v1.StartMFAChallengeResponse.sms:type_name -> authentication.service.v1.SMSResult
-	11, // 19: authentication.service.v1.StartMFAChallengeResponse.webauthn:type_name -> authentication.service.v1.WebAuthnResult
-	26, // 20: authentication.service.v1.StartMFAChallengeResponse.expires_at:type_name -> google.protobuf.Timestamp
-	24, // 21: authentication.service.v1.VerifyMFAChallengeRequest.sms:type_name -> authentication.service.v1.SMSVerification
-	25, // 22: authentication.service.v1.VerifyMFAChallengeRequest.webauthn:type_name -> authentication.service.v1.WebAuthnAssertion
-	26, // 23: authentication.service.v1.GenerateBackupCodesResponse.generated_at:type_name -> google.protobuf.Timestamp
-	26, // 24: authentication.service.v1.ListBackupCodesResponse.generated_at:type_name -> google.protobuf.Timestamp
-	2,  // 25: authentication.service.v1.MFAService.GetMFAStatus:input_type -> authentication.service.v1.GetMFAStatusRequest
-	5,  // 26: authentication.service.v1.MFAService.ListEnrolledMethods:input_type -> authentication.service.v1.ListEnrolledMethodsRequest
-	7,  // 27: authentication.service.v1.MFAService.StartEnrollMethod:input_type -> authentication.service.v1.StartEnrollMethodRequest
-	12, // 28: authentication.service.v1.MFAService.ConfirmEnrollMethod:input_type -> authentication.service.v1.ConfirmEnrollMethodRequest
-	14, // 29: authentication.service.v1.MFAService.DisableMFA:input_type -> authentication.service.v1.DisableMFARequest
-	15, // 30: authentication.service.v1.MFAService.StartMFAChallenge:input_type -> authentication.service.v1.StartMFAChallengeRequest
-	17, // 31: authentication.service.v1.MFAService.VerifyMFAChallenge:input_type -> authentication.service.v1.VerifyMFAChallengeRequest
-	19, // 32: authentication.service.v1.MFAService.GenerateBackupCodes:input_type -> authentication.service.v1.GenerateBackupCodesRequest
-	21, // 33: authentication.service.v1.MFAService.ListBackupCodes:input_type -> authentication.service.v1.ListBackupCodesRequest
-	23, // 34: authentication.service.v1.MFAService.RevokeMFADevice:input_type -> authentication.service.v1.RevokeMFADeviceRequest
-	3,  // 35: authentication.service.v1.MFAService.GetMFAStatus:output_type -> authentication.service.v1.GetMFAStatusResponse
-	6,  // 36: authentication.service.v1.MFAService.ListEnrolledMethods:output_type -> authentication.service.v1.ListEnrolledMethodsResponse
-	8,  // 37: authentication.service.v1.MFAService.StartEnrollMethod:output_type -> authentication.service.v1.StartEnrollMethodResponse
-	13, // 38: authentication.service.v1.MFAService.ConfirmEnrollMethod:output_type -> authentication.service.v1.ConfirmEnrollMethodResponse
-	27, // 39: authentication.service.v1.MFAService.DisableMFA:output_type -> google.protobuf.Empty
-	16, // 40: authentication.service.v1.MFAService.StartMFAChallenge:output_type -> authentication.service.v1.StartMFAChallengeResponse
-	18, // 41: authentication.service.v1.MFAService.VerifyMFAChallenge:output_type -> authentication.service.v1.VerifyMFAChallengeResponse
-	20, // 42: authentication.service.v1.MFAService.GenerateBackupCodes:output_type -> authentication.service.v1.GenerateBackupCodesResponse
-	22, // 43: authentication.service.v1.MFAService.ListBackupCodes:output_type -> authentication.service.v1.ListBackupCodesResponse
-	27, // 44: authentication.service.v1.MFAService.RevokeMFADevice:output_type -> google.protobuf.Empty
-	35, // [35:45] is the sub-list for method output_type
-	25, // [25:35] is the sub-list for method input_type
-	25, // [25:25] is the sub-list for extension type_name
-	25, // [25:25] is the sub-list for extension extendee
-	0,  // [0:25] is the sub-list for field type_name
+	1,  // 2: authentication.service.v1.MFAPolicy.enforcement:type_name -> authentication.service.v1.MFAEnforcement
+	34, // 3: authentication.service.v1.MFAPolicy.updated_at:type_name -> google.protobuf.Timestamp
+	4,  // 4: authentication.service.v1.UpdateMFAPolicyRequest.data:type_name -> authentication.service.v1.MFAPolicy
+	0,  // 5: authentication.service.v1.EnrolledMethod.method:type_name -> authentication.service.v1.MFAMethod
+	34, // 6: authentication.service.v1.EnrolledMethod.created_at:type_name -> google.protobuf.Timestamp
+	34, // 7: authentication.service.v1.EnrolledMethod.last_used_at:type_name -> google.protobuf.Timestamp
+	7,  // 8: authentication.service.v1.ListEnrolledMethodsResponse.items:type_name -> authentication.service.v1.EnrolledMethod
+	0,  // 9: authentication.service.v1.StartEnrollMethodRequest.method:type_name -> authentication.service.v1.MFAMethod
+	12, // 10: authentication.service.v1.StartEnrollMethodResponse.totp:type_name -> authentication.service.v1.TOTPResult
+	13, // 11: authentication.service.v1.StartEnrollMethodResponse.sms:type_name -> authentication.service.v1.SMSResult
+	15, // 12: authentication.service.v1.StartEnrollMethodResponse.webauthn:type_name -> authentication.service.v1.WebAuthnResult
+	14, // 13: authentication.service.v1.StartEnrollMethodResponse.email:type_name -> authentication.service.v1.EmailResult
+	34, // 14: authentication.service.v1.StartEnrollMethodResponse.expires_at:type_name -> google.protobuf.Timestamp
+	0,  // 15: authentication.service.v1.ConfirmEnrollMethodRequest.method:type_name -> authentication.service.v1.MFAMethod
+	28, // 16: authentication.service.v1.ConfirmEnrollMethodRequest.sms:type_name -> authentication.service.v1.SMSVerification
+	30, // 17: authentication.service.v1.ConfirmEnrollMethodRequest.webauthn:type_name -> authentication.service.v1.WebAuthnAssertion
+	29, // 18: authentication.service.v1.ConfirmEnrollMethodRequest.email:type_name -> authentication.service.v1.EmailVerification
+	0,  // 19: authentication.service.v1.DisableMFARequest.method:type_name -> authentication.service.v1.MFAMethod
+	28, // 20: authentication.service.v1.DisableMFARequest.sms:type_name -> authentication.service.v1.SMSVerification
+	30, // 21: authentication.service.v1.DisableMFARequest.webauthn:type_name -> authentication.service.v1.WebAuthnAssertion
+	0,  // 22: authentication.service.v1.StartMFAChallengeRequest.method:type_name -> authentication.service.v1.MFAMethod
+	13, // 23: authentication.service.v1.StartMFAChallengeResponse.sms:type_name -> authentication.service.v1.SMSResult
+	15, // 24: authentication.service.v1.StartMFAChallengeResponse.webauthn:type_name -> authentication.service.v1.WebAuthnResult
+	14, // 25: authentication.service.v1.StartMFAChallengeResponse.email:type_name -> authentication.service.v1.EmailResult
+	34, // 26: authentication.service.v1.StartMFAChallengeResponse.expires_at:type_name -> google.protobuf.Timestamp
+	28, // 27: authentication.service.v1.VerifyMFAChallengeRequest.sms:type_name -> authentication.service.v1.SMSVerification
+	30, // 28: authentication.service.v1.VerifyMFAChallengeRequest.webauthn:type_name -> authentication.service.v1.WebAuthnAssertion
+	29, // 29: authentication.service.v1.VerifyMFAChallengeRequest.email:type_name -> authentication.service.v1.EmailVerification
+	34, // 30: authentication.service.v1.GenerateBackupCodesResponse.generated_at:type_name -> google.protobuf.Timestamp
+	34, // 31: authentication.service.v1.ListBackupCodesResponse.generated_at:type_name -> google.protobuf.Timestamp
+	35, // 32: authentication.service.v1.StartPasskeyLoginRequest.client_type:type_name -> authentication.service.v1.ClientType
+	15, // 33: authentication.service.v1.StartPasskeyLoginResponse.webauthn:type_name -> authentication.service.v1.WebAuthnResult
+	34, // 34: authentication.service.v1.StartPasskeyLoginResponse.expires_at:type_name -> google.protobuf.Timestamp
+	30, // 35: authentication.service.v1.FinishPasskeyLoginRequest.webauthn:type_name -> authentication.service.v1.WebAuthnAssertion
+	2,  // 36: authentication.service.v1.MFAService.GetMFAStatus:input_type -> authentication.service.v1.GetMFAStatusRequest
+	8,  // 37: authentication.service.v1.MFAService.ListEnrolledMethods:input_type -> authentication.service.v1.ListEnrolledMethodsRequest
+	10, // 38: authentication.service.v1.MFAService.StartEnrollMethod:input_type -> authentication.service.v1.StartEnrollMethodRequest
+	16, // 39: authentication.service.v1.MFAService.ConfirmEnrollMethod:input_type -> authentication.service.v1.ConfirmEnrollMethodRequest
+	18, // 40: authentication.service.v1.MFAService.DisableMFA:input_type -> authentication.service.v1.DisableMFARequest
+	19, // 41: authentication.service.v1.MFAService.StartMFAChallenge:input_type -> authentication.service.v1.StartMFAChallengeRequest
+	21, // 42: authentication.service.v1.MFAService.VerifyMFAChallenge:input_type -> authentication.service.v1.VerifyMFAChallengeRequest
+	23, // 43: authentication.service.v1.MFAService.GenerateBackupCodes:input_type -> authentication.service.v1.GenerateBackupCodesRequest
+	25, // 44: authentication.service.v1.MFAService.ListBackupCodes:input_type -> authentication.service.v1.ListBackupCodesRequest
+	27, // 45: authentication.service.v1.MFAService.RevokeMFADevice:input_type -> authentication.service.v1.RevokeMFADeviceRequest
+	31, // 46: authentication.service.v1.MFAService.StartPasskeyLogin:input_type -> authentication.service.v1.StartPasskeyLoginRequest
+	33, // 47: authentication.service.v1.MFAService.FinishPasskeyLogin:input_type -> authentication.service.v1.FinishPasskeyLoginRequest
+	10, // 48: authentication.service.v1.MFAService.StartLoginEnrollment:input_type -> authentication.service.v1.StartEnrollMethodRequest
+	16, // 49: authentication.service.v1.MFAService.ConfirmLoginEnrollment:input_type -> authentication.service.v1.ConfirmEnrollMethodRequest
+	5,  // 50: authentication.service.v1.MFAService.GetMFAPolicy:input_type -> authentication.service.v1.GetMFAPolicyRequest
+	6,  // 51: authentication.service.v1.MFAService.UpdateMFAPolicy:input_type -> authentication.service.v1.UpdateMFAPolicyRequest
+	3,  // 52: authentication.service.v1.MFAService.GetMFAStatus:output_type -> authentication.service.v1.GetMFAStatusResponse
+	9,  // 53: authentication.service.v1.MFAService.ListEnrolledMethods:output_type -> authentication.service.v1.ListEnrolledMethodsResponse
+	11, // 54: authentication.service.v1.MFAService.StartEnrollMethod:output_type -> authentication.service.v1.StartEnrollMethodResponse
+	17, // 55: authentication.service.v1.MFAService.ConfirmEnrollMethod:output_type -> authentication.service.v1.ConfirmEnrollMethodResponse
+	36, // 56: authentication.service.v1.MFAService.DisableMFA:output_type -> google.protobuf.Empty
+	20, // 57: authentication.service.v1.MFAService.StartMFAChallenge:output_type -> authentication.service.v1.StartMFAChallengeResponse
+	22, // 58: authentication.service.v1.MFAService.VerifyMFAChallenge:output_type -> authentication.service.v1.VerifyMFAChallengeResponse
+	24, // 59: authentication.service.v1.MFAService.GenerateBackupCodes:output_type -> authentication.service.v1.GenerateBackupCodesResponse
+	26, // 60: authentication.service.v1.MFAService.ListBackupCodes:output_type -> authentication.service.v1.ListBackupCodesResponse
+	36, // 61: authentication.service.v1.MFAService.RevokeMFADevice:output_type -> google.protobuf.Empty
+	32, // 62: authentication.service.v1.MFAService.StartPasskeyLogin:output_type -> authentication.service.v1.StartPasskeyLoginResponse
+	37, // 63: authentication.service.v1.MFAService.FinishPasskeyLogin:output_type -> authentication.service.v1.LoginResponse
+	11, // 64: authentication.service.v1.MFAService.StartLoginEnrollment:output_type -> authentication.service.v1.StartEnrollMethodResponse
+	17, // 65: authentication.service.v1.MFAService.ConfirmLoginEnrollment:output_type -> authentication.service.v1.ConfirmEnrollMethodResponse
+	4,  // 66: authentication.service.v1.MFAService.GetMFAPolicy:output_type -> authentication.service.v1.MFAPolicy
+	36, // 67: authentication.service.v1.MFAService.UpdateMFAPolicy:output_type -> google.protobuf.Empty
+	52, // [52:68] is the sub-list for method output_type
+	36, // [36:52] is the sub-list for method input_type
+	36, // [36:36] is the sub-list for extension type_name
+	36, // [36:36] is the sub-list for extension extendee
+	0,  // [0:36] is the sub-list for field type_name
 }
 
 func init() { file_authentication_service_v1_mfa_proto_init() }
@@ -2095,50 +2772,59 @@ func file_authentication_service_v1_mfa_proto_init() {
 	if File_authentication_service_v1_mfa_proto != nil {
 		return
 	}
+	file_authentication_service_v1_authentication_proto_init()
 	file_authentication_service_v1_mfa_proto_msgTypes[0].OneofWrappers = []any{}
 	file_authentication_service_v1_mfa_proto_msgTypes[2].OneofWrappers = []any{}
 	file_authentication_service_v1_mfa_proto_msgTypes[3].OneofWrappers = []any{}
 	file_authentication_service_v1_mfa_proto_msgTypes[5].OneofWrappers = []any{}
-	file_authentication_service_v1_mfa_proto_msgTypes[6].OneofWrappers = []any{
+	file_authentication_service_v1_mfa_proto_msgTypes[6].OneofWrappers = []any{}
+	file_authentication_service_v1_mfa_proto_msgTypes[8].OneofWrappers = []any{}
+	file_authentication_service_v1_mfa_proto_msgTypes[9].OneofWrappers = []any{
 		(*StartEnrollMethodResponse_Totp)(nil),
 		(*StartEnrollMethodResponse_Sms)(nil),
 		(*StartEnrollMethodResponse_Webauthn)(nil),
+		(*StartEnrollMethodResponse_Email)(nil),
 	}
-	file_authentication_service_v1_mfa_proto_msgTypes[10].OneofWrappers = []any{
+	file_authentication_service_v1_mfa_proto_msgTypes[14].OneofWrappers = []any{
 		(*ConfirmEnrollMethodRequest_TotpCode)(nil),
 		(*ConfirmEnrollMethodRequest_Sms)(nil),
 		(*ConfirmEnrollMethodRequest_Webauthn)(nil),
 		(*ConfirmEnrollMethodRequest_BackupCode)(nil),
+		(*ConfirmEnrollMethodRequest_Email)(nil),
 	}
-	file_authentication_service_v1_mfa_proto_msgTypes[12].OneofWrappers = []any{
+	file_authentication_service_v1_mfa_proto_msgTypes[16].OneofWrappers = []any{
 		(*DisableMFARequest_Password)(nil),
 		(*DisableMFARequest_TotpCode)(nil),
 		(*DisableMFARequest_Sms)(nil),
 		(*DisableMFARequest_Webauthn)(nil),
 	}
-	file_authentication_service_v1_mfa_proto_msgTypes[13].OneofWrappers = []any{}
-	file_authentication_service_v1_mfa_proto_msgTypes[14].OneofWrappers = []any{
+	file_authentication_service_v1_mfa_proto_msgTypes[17].OneofWrappers = []any{}
+	file_authentication_service_v1_mfa_proto_msgTypes[18].OneofWrappers = []any{
 		(*StartMFAChallengeResponse_Sms)(nil),
 		(*StartMFAChallengeResponse_Webauthn)(nil),
+		(*StartMFAChallengeResponse_Email)(nil),
 	}
-	file_authentication_service_v1_mfa_proto_msgTypes[15].OneofWrappers = []any{
+	file_authentication_service_v1_mfa_proto_msgTypes[19].OneofWrappers = []any{
 		(*VerifyMFAChallengeRequest_TotpCode)(nil),
 		(*VerifyMFAChallengeRequest_Sms)(nil),
 		(*VerifyMFAChallengeRequest_Webauthn)(nil),
 		(*VerifyMFAChallengeRequest_BackupCode)(nil),
+		(*VerifyMFAChallengeRequest_Email)(nil),
 	}
-	file_authentication_service_v1_mfa_proto_msgTypes[16].OneofWrappers = []any{}
-	file_authentication_service_v1_mfa_proto_msgTypes[17].OneofWrappers = []any{}
-	file_authentication_service_v1_mfa_proto_msgTypes[18].OneofWrappers = []any{}
 	file_authentication_service_v1_mfa_proto_msgTypes[20].OneofWrappers = []any{}
-	file_authentication_service_v1_mfa_proto_msgTypes[23].OneofWrappers = []any{}
+	file_authentication_service_v1_mfa_proto_msgTypes[21].OneofWrappers = []any{}
+	file_authentication_service_v1_mfa_proto_msgTypes[22].OneofWrappers = []any{}
+	file_authentication_service_v1_mfa_proto_msgTypes[24].OneofWrappers = []any{}
+	file_authentication_service_v1_mfa_proto_msgTypes[28].OneofWrappers = []any{}
+	file_authentication_service_v1_mfa_proto_msgTypes[29].OneofWrappers = []any{}
+	file_authentication_service_v1_mfa_proto_msgTypes[31].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_authentication_service_v1_mfa_proto_rawDesc), len(file_authentication_service_v1_mfa_proto_rawDesc)),
 			NumEnums:      2,
-			NumMessages:   24,
+			NumMessages:   32,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
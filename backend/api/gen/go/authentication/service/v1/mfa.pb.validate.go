@@ -201,6 +201,8 @@ func (m *GetMFAStatusResponse) validate(all bool) error {
 
 	// no validation rules for Enforcement
 
+	// no validation rules for BackupCodesRemaining
+
 	if len(errors) > 0 {
 		return GetMFAStatusResponseMultiError(errors)
 	}
@@ -281,94 +283,63 @@ func (e GetMFAStatusResponseValidationError) Error() string {
 	ErrorName() string
 } = GetMFAStatusResponseValidationError{}
 
-// Validate checks the field values on EnrolledMethod with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on MFAPolicy with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *EnrolledMethod) Validate() error {
+func (m *MFAPolicy) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on EnrolledMethod with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in EnrolledMethodMultiError,
-// or nil if none found.
-func (m *EnrolledMethod) ValidateAll() error {
+// ValidateAll checks the field values on MFAPolicy with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MFAPolicyMultiError, or nil
+// if none found.
+func (m *MFAPolicy) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *EnrolledMethod) validate(all bool) error {
+func (m *MFAPolicy) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	// no validation rules for Method
-
-	// no validation rules for Display
-
-	// no validation rules for Enabled
-
-	if m.CreatedAt != nil {
+	// no validation rules for Enforcement
 
-		if all {
-			switch v := interface{}(m.GetCreatedAt()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, EnrolledMethodValidationError{
-						field:  "CreatedAt",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, EnrolledMethodValidationError{
-						field:  "CreatedAt",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return EnrolledMethodValidationError{
-					field:  "CreatedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	if m.TenantId != nil {
+		// no validation rules for TenantId
+	}
 
+	if m.UpdatedBy != nil {
+		// no validation rules for UpdatedBy
 	}
 
-	if m.LastUsedAt != nil {
+	if m.UpdatedAt != nil {
 
 		if all {
-			switch v := interface{}(m.GetLastUsedAt()).(type) {
+			switch v := interface{}(m.GetUpdatedAt()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, EnrolledMethodValidationError{
-						field:  "LastUsedAt",
+					errors = append(errors, MFAPolicyValidationError{
+						field:  "UpdatedAt",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, EnrolledMethodValidationError{
-						field:  "LastUsedAt",
+					errors = append(errors, MFAPolicyValidationError{
+						field:  "UpdatedAt",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetLastUsedAt()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return EnrolledMethodValidationError{
-					field:  "LastUsedAt",
+				return MFAPolicyValidationError{
+					field:  "UpdatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -378,19 +349,18 @@ func (m *EnrolledMethod) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return EnrolledMethodMultiError(errors)
+		return MFAPolicyMultiError(errors)
 	}
 
 	return nil
 }
 
-// EnrolledMethodMultiError is an error wrapping multiple validation errors
-// returned by EnrolledMethod.ValidateAll() if the designated constraints
-// aren't met.
-type EnrolledMethodMultiError []error
+// MFAPolicyMultiError is an error wrapping multiple validation errors returned
+// by MFAPolicy.ValidateAll() if the designated constraints aren't met.
+type MFAPolicyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m EnrolledMethodMultiError) Error() string {
+func (m MFAPolicyMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -399,11 +369,11 @@ func (m EnrolledMethodMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m EnrolledMethodMultiError) AllErrors() []error { return m }
+func (m MFAPolicyMultiError) AllErrors() []error { return m }
 
-// EnrolledMethodValidationError is the validation error returned by
-// EnrolledMethod.Validate if the designated constraints aren't met.
-type EnrolledMethodValidationError struct {
+// MFAPolicyValidationError is the validation error returned by
+// MFAPolicy.Validate if the designated constraints aren't met.
+type MFAPolicyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -411,22 +381,22 @@ type EnrolledMethodValidationError struct {
 }
 
 // Field function returns field value.
-func (e EnrolledMethodValidationError) Field() string { return e.field }
+func (e MFAPolicyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e EnrolledMethodValidationError) Reason() string { return e.reason }
+func (e MFAPolicyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e EnrolledMethodValidationError) Cause() error { return e.cause }
+func (e MFAPolicyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e EnrolledMethodValidationError) Key() bool { return e.key }
+func (e MFAPolicyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e EnrolledMethodValidationError) ErrorName() string { return "EnrolledMethodValidationError" }
+func (e MFAPolicyValidationError) ErrorName() string { return "MFAPolicyValidationError" }
 
 // Error satisfies the builtin error interface
-func (e EnrolledMethodValidationError) Error() string {
+func (e MFAPolicyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -438,14 +408,14 @@ func (e EnrolledMethodValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sEnrolledMethod.%s: %s%s",
+		"invalid %sMFAPolicy.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = EnrolledMethodValidationError{}
+var _ error = MFAPolicyValidationError{}
 
 var _ interface {
 	Field() string
@@ -453,48 +423,48 @@ func (e EnrolledMethodValidationError) Error() string {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = EnrolledMethodValidationError{}
+} = MFAPolicyValidationError{}
 
-// Validate checks the field values on ListEnrolledMethodsRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetMFAPolicyRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListEnrolledMethodsRequest) Validate() error {
+func (m *GetMFAPolicyRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListEnrolledMethodsRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetMFAPolicyRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListEnrolledMethodsRequestMultiError, or nil if none found.
-func (m *ListEnrolledMethodsRequest) ValidateAll() error {
+// GetMFAPolicyRequestMultiError, or nil if none found.
+func (m *GetMFAPolicyRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListEnrolledMethodsRequest) validate(all bool) error {
+func (m *GetMFAPolicyRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.UserId != nil {
-		// no validation rules for UserId
+	if m.TenantId != nil {
+		// no validation rules for TenantId
 	}
 
 	if len(errors) > 0 {
-		return ListEnrolledMethodsRequestMultiError(errors)
+		return GetMFAPolicyRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListEnrolledMethodsRequestMultiError is an error wrapping multiple
-// validation errors returned by ListEnrolledMethodsRequest.ValidateAll() if
-// the designated constraints aren't met.
-type ListEnrolledMethodsRequestMultiError []error
+// GetMFAPolicyRequestMultiError is an error wrapping multiple validation
+// errors returned by GetMFAPolicyRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetMFAPolicyRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListEnrolledMethodsRequestMultiError) Error() string {
+func (m GetMFAPolicyRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -503,11 +473,11 @@ func (m ListEnrolledMethodsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListEnrolledMethodsRequestMultiError) AllErrors() []error { return m }
+func (m GetMFAPolicyRequestMultiError) AllErrors() []error { return m }
 
-// ListEnrolledMethodsRequestValidationError is the validation error returned
-// by ListEnrolledMethodsRequest.Validate if the designated constraints aren't met.
-type ListEnrolledMethodsRequestValidationError struct {
+// GetMFAPolicyRequestValidationError is the validation error returned by
+// GetMFAPolicyRequest.Validate if the designated constraints aren't met.
+type GetMFAPolicyRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -515,24 +485,24 @@ type ListEnrolledMethodsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListEnrolledMethodsRequestValidationError) Field() string { return e.field }
+func (e GetMFAPolicyRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListEnrolledMethodsRequestValidationError) Reason() string { return e.reason }
+func (e GetMFAPolicyRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListEnrolledMethodsRequestValidationError) Cause() error { return e.cause }
+func (e GetMFAPolicyRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListEnrolledMethodsRequestValidationError) Key() bool { return e.key }
+func (e GetMFAPolicyRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListEnrolledMethodsRequestValidationError) ErrorName() string {
-	return "ListEnrolledMethodsRequestValidationError"
+func (e GetMFAPolicyRequestValidationError) ErrorName() string {
+	return "GetMFAPolicyRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListEnrolledMethodsRequestValidationError) Error() string {
+func (e GetMFAPolicyRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -544,14 +514,14 @@ func (e ListEnrolledMethodsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListEnrolledMethodsRequest.%s: %s%s",
+		"invalid %sGetMFAPolicyRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListEnrolledMethodsRequestValidationError{}
+var _ error = GetMFAPolicyRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -559,78 +529,73 @@ func (e ListEnrolledMethodsRequestValidationError) Error() string {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListEnrolledMethodsRequestValidationError{}
+} = GetMFAPolicyRequestValidationError{}
 
-// Validate checks the field values on ListEnrolledMethodsResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on UpdateMFAPolicyRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListEnrolledMethodsResponse) Validate() error {
+func (m *UpdateMFAPolicyRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListEnrolledMethodsResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on UpdateMFAPolicyRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListEnrolledMethodsResponseMultiError, or nil if none found.
-func (m *ListEnrolledMethodsResponse) ValidateAll() error {
+// UpdateMFAPolicyRequestMultiError, or nil if none found.
+func (m *UpdateMFAPolicyRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListEnrolledMethodsResponse) validate(all bool) error {
+func (m *UpdateMFAPolicyRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetItems() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListEnrolledMethodsResponseValidationError{
-						field:  fmt.Sprintf("Items[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListEnrolledMethodsResponseValidationError{
-						field:  fmt.Sprintf("Items[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateMFAPolicyRequestValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return ListEnrolledMethodsResponseValidationError{
-					field:  fmt.Sprintf("Items[%v]", idx),
+				errors = append(errors, UpdateMFAPolicyRequestValidationError{
+					field:  "Data",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateMFAPolicyRequestValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
 	if len(errors) > 0 {
-		return ListEnrolledMethodsResponseMultiError(errors)
+		return UpdateMFAPolicyRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListEnrolledMethodsResponseMultiError is an error wrapping multiple
-// validation errors returned by ListEnrolledMethodsResponse.ValidateAll() if
-// the designated constraints aren't met.
-type ListEnrolledMethodsResponseMultiError []error
+// UpdateMFAPolicyRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateMFAPolicyRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateMFAPolicyRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListEnrolledMethodsResponseMultiError) Error() string {
+func (m UpdateMFAPolicyRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -639,12 +604,11 @@ func (m ListEnrolledMethodsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListEnrolledMethodsResponseMultiError) AllErrors() []error { return m }
+func (m UpdateMFAPolicyRequestMultiError) AllErrors() []error { return m }
 
-// ListEnrolledMethodsResponseValidationError is the validation error returned
-// by ListEnrolledMethodsResponse.Validate if the designated constraints
-// aren't met.
-type ListEnrolledMethodsResponseValidationError struct {
+// UpdateMFAPolicyRequestValidationError is the validation error returned by
+// UpdateMFAPolicyRequest.Validate if the designated constraints aren't met.
+type UpdateMFAPolicyRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -652,24 +616,24 @@ type ListEnrolledMethodsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListEnrolledMethodsResponseValidationError) Field() string { return e.field }
+func (e UpdateMFAPolicyRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListEnrolledMethodsResponseValidationError) Reason() string { return e.reason }
+func (e UpdateMFAPolicyRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListEnrolledMethodsResponseValidationError) Cause() error { return e.cause }
+func (e UpdateMFAPolicyRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListEnrolledMethodsResponseValidationError) Key() bool { return e.key }
+func (e UpdateMFAPolicyRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListEnrolledMethodsResponseValidationError) ErrorName() string {
-	return "ListEnrolledMethodsResponseValidationError"
+func (e UpdateMFAPolicyRequestValidationError) ErrorName() string {
+	return "UpdateMFAPolicyRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListEnrolledMethodsResponseValidationError) Error() string {
+func (e UpdateMFAPolicyRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -681,14 +645,14 @@ func (e ListEnrolledMethodsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListEnrolledMethodsResponse.%s: %s%s",
+		"invalid %sUpdateMFAPolicyRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListEnrolledMethodsResponseValidationError{}
+var _ error = UpdateMFAPolicyRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -696,66 +660,487 @@ func (e ListEnrolledMethodsResponseValidationError) Error() string {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListEnrolledMethodsResponseValidationError{}
+} = UpdateMFAPolicyRequestValidationError{}
 
-// Validate checks the field values on StartEnrollMethodRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *StartEnrollMethodRequest) Validate() error {
+// Validate checks the field values on EnrolledMethod with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *EnrolledMethod) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on StartEnrollMethodRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// StartEnrollMethodRequestMultiError, or nil if none found.
-func (m *StartEnrollMethodRequest) ValidateAll() error {
+// ValidateAll checks the field values on EnrolledMethod with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in EnrolledMethodMultiError,
+// or nil if none found.
+func (m *EnrolledMethod) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *StartEnrollMethodRequest) validate(all bool) error {
+func (m *EnrolledMethod) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Method
+	// no validation rules for Id
 
-	if m.Phone != nil {
-		// no validation rules for Phone
-	}
+	// no validation rules for Method
 
-	if m.Email != nil {
-		// no validation rules for Email
-	}
+	// no validation rules for Display
 
-	if len(errors) > 0 {
-		return StartEnrollMethodRequestMultiError(errors)
-	}
+	// no validation rules for Enabled
 
-	return nil
-}
+	if m.CreatedAt != nil {
 
-// StartEnrollMethodRequestMultiError is an error wrapping multiple validation
-// errors returned by StartEnrollMethodRequest.ValidateAll() if the designated
-// constraints aren't met.
-type StartEnrollMethodRequestMultiError []error
+		if all {
+			switch v := interface{}(m.GetCreatedAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, EnrolledMethodValidationError{
+						field:  "CreatedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, EnrolledMethodValidationError{
+						field:  "CreatedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return EnrolledMethodValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-// Error returns a concatenation of all the error messages it wraps.
-func (m StartEnrollMethodRequestMultiError) Error() string {
-	msgs := make([]string, 0, len(m))
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
 	}
-	return strings.Join(msgs, "; ")
-}
 
-// AllErrors returns a list of validation violation errors.
-func (m StartEnrollMethodRequestMultiError) AllErrors() []error { return m }
+	if m.LastUsedAt != nil {
 
-// StartEnrollMethodRequestValidationError is the validation error returned by
-// StartEnrollMethodRequest.Validate if the designated constraints aren't met.
+		if all {
+			switch v := interface{}(m.GetLastUsedAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, EnrolledMethodValidationError{
+						field:  "LastUsedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, EnrolledMethodValidationError{
+						field:  "LastUsedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetLastUsedAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return EnrolledMethodValidationError{
+					field:  "LastUsedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return EnrolledMethodMultiError(errors)
+	}
+
+	return nil
+}
+
+// EnrolledMethodMultiError is an error wrapping multiple validation errors
+// returned by EnrolledMethod.ValidateAll() if the designated constraints
+// aren't met.
+type EnrolledMethodMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EnrolledMethodMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EnrolledMethodMultiError) AllErrors() []error { return m }
+
+// EnrolledMethodValidationError is the validation error returned by
+// EnrolledMethod.Validate if the designated constraints aren't met.
+type EnrolledMethodValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EnrolledMethodValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EnrolledMethodValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EnrolledMethodValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EnrolledMethodValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EnrolledMethodValidationError) ErrorName() string { return "EnrolledMethodValidationError" }
+
+// Error satisfies the builtin error interface
+func (e EnrolledMethodValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEnrolledMethod.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EnrolledMethodValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EnrolledMethodValidationError{}
+
+// Validate checks the field values on ListEnrolledMethodsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListEnrolledMethodsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListEnrolledMethodsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListEnrolledMethodsRequestMultiError, or nil if none found.
+func (m *ListEnrolledMethodsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListEnrolledMethodsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.UserId != nil {
+		// no validation rules for UserId
+	}
+
+	if len(errors) > 0 {
+		return ListEnrolledMethodsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListEnrolledMethodsRequestMultiError is an error wrapping multiple
+// validation errors returned by ListEnrolledMethodsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListEnrolledMethodsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListEnrolledMethodsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListEnrolledMethodsRequestMultiError) AllErrors() []error { return m }
+
+// ListEnrolledMethodsRequestValidationError is the validation error returned
+// by ListEnrolledMethodsRequest.Validate if the designated constraints aren't met.
+type ListEnrolledMethodsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListEnrolledMethodsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListEnrolledMethodsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListEnrolledMethodsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListEnrolledMethodsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListEnrolledMethodsRequestValidationError) ErrorName() string {
+	return "ListEnrolledMethodsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListEnrolledMethodsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListEnrolledMethodsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListEnrolledMethodsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListEnrolledMethodsRequestValidationError{}
+
+// Validate checks the field values on ListEnrolledMethodsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListEnrolledMethodsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListEnrolledMethodsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListEnrolledMethodsResponseMultiError, or nil if none found.
+func (m *ListEnrolledMethodsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListEnrolledMethodsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListEnrolledMethodsResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListEnrolledMethodsResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListEnrolledMethodsResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListEnrolledMethodsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListEnrolledMethodsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListEnrolledMethodsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListEnrolledMethodsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListEnrolledMethodsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListEnrolledMethodsResponseMultiError) AllErrors() []error { return m }
+
+// ListEnrolledMethodsResponseValidationError is the validation error returned
+// by ListEnrolledMethodsResponse.Validate if the designated constraints
+// aren't met.
+type ListEnrolledMethodsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListEnrolledMethodsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListEnrolledMethodsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListEnrolledMethodsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListEnrolledMethodsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListEnrolledMethodsResponseValidationError) ErrorName() string {
+	return "ListEnrolledMethodsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListEnrolledMethodsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListEnrolledMethodsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListEnrolledMethodsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListEnrolledMethodsResponseValidationError{}
+
+// Validate checks the field values on StartEnrollMethodRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StartEnrollMethodRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StartEnrollMethodRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StartEnrollMethodRequestMultiError, or nil if none found.
+func (m *StartEnrollMethodRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StartEnrollMethodRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Method
+
+	if m.Phone != nil {
+		// no validation rules for Phone
+	}
+
+	if m.Email != nil {
+		// no validation rules for Email
+	}
+
+	if m.LoginOperationId != nil {
+		// no validation rules for LoginOperationId
+	}
+
+	if len(errors) > 0 {
+		return StartEnrollMethodRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// StartEnrollMethodRequestMultiError is an error wrapping multiple validation
+// errors returned by StartEnrollMethodRequest.ValidateAll() if the designated
+// constraints aren't met.
+type StartEnrollMethodRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StartEnrollMethodRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StartEnrollMethodRequestMultiError) AllErrors() []error { return m }
+
+// StartEnrollMethodRequestValidationError is the validation error returned by
+// StartEnrollMethodRequest.Validate if the designated constraints aren't met.
 type StartEnrollMethodRequestValidationError struct {
 	field  string
 	reason string
@@ -764,24 +1149,755 @@ type StartEnrollMethodRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e StartEnrollMethodRequestValidationError) Field() string { return e.field }
+func (e StartEnrollMethodRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StartEnrollMethodRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StartEnrollMethodRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StartEnrollMethodRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StartEnrollMethodRequestValidationError) ErrorName() string {
+	return "StartEnrollMethodRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StartEnrollMethodRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStartEnrollMethodRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StartEnrollMethodRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StartEnrollMethodRequestValidationError{}
+
+// Validate checks the field values on StartEnrollMethodResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StartEnrollMethodResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StartEnrollMethodResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StartEnrollMethodResponseMultiError, or nil if none found.
+func (m *StartEnrollMethodResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StartEnrollMethodResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OperationId
+
+	switch v := m.Result.(type) {
+	case *StartEnrollMethodResponse_Totp:
+		if v == nil {
+			err := StartEnrollMethodResponseValidationError{
+				field:  "Result",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetTotp()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StartEnrollMethodResponseValidationError{
+						field:  "Totp",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StartEnrollMethodResponseValidationError{
+						field:  "Totp",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetTotp()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StartEnrollMethodResponseValidationError{
+					field:  "Totp",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *StartEnrollMethodResponse_Sms:
+		if v == nil {
+			err := StartEnrollMethodResponseValidationError{
+				field:  "Result",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetSms()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StartEnrollMethodResponseValidationError{
+						field:  "Sms",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StartEnrollMethodResponseValidationError{
+						field:  "Sms",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSms()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StartEnrollMethodResponseValidationError{
+					field:  "Sms",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *StartEnrollMethodResponse_Webauthn:
+		if v == nil {
+			err := StartEnrollMethodResponseValidationError{
+				field:  "Result",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetWebauthn()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StartEnrollMethodResponseValidationError{
+						field:  "Webauthn",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StartEnrollMethodResponseValidationError{
+						field:  "Webauthn",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetWebauthn()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StartEnrollMethodResponseValidationError{
+					field:  "Webauthn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *StartEnrollMethodResponse_Email:
+		if v == nil {
+			err := StartEnrollMethodResponseValidationError{
+				field:  "Result",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetEmail()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StartEnrollMethodResponseValidationError{
+						field:  "Email",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StartEnrollMethodResponseValidationError{
+						field:  "Email",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetEmail()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StartEnrollMethodResponseValidationError{
+					field:  "Email",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if m.ExpiresAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetExpiresAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StartEnrollMethodResponseValidationError{
+						field:  "ExpiresAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StartEnrollMethodResponseValidationError{
+						field:  "ExpiresAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StartEnrollMethodResponseValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return StartEnrollMethodResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// StartEnrollMethodResponseMultiError is an error wrapping multiple validation
+// errors returned by StartEnrollMethodResponse.ValidateAll() if the
+// designated constraints aren't met.
+type StartEnrollMethodResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StartEnrollMethodResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StartEnrollMethodResponseMultiError) AllErrors() []error { return m }
+
+// StartEnrollMethodResponseValidationError is the validation error returned by
+// StartEnrollMethodResponse.Validate if the designated constraints aren't met.
+type StartEnrollMethodResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StartEnrollMethodResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StartEnrollMethodResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StartEnrollMethodResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StartEnrollMethodResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StartEnrollMethodResponseValidationError) ErrorName() string {
+	return "StartEnrollMethodResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StartEnrollMethodResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStartEnrollMethodResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StartEnrollMethodResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StartEnrollMethodResponseValidationError{}
+
+// Validate checks the field values on TOTPResult with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TOTPResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TOTPResult with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TOTPResultMultiError, or
+// nil if none found.
+func (m *TOTPResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TOTPResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Secret
+
+	// no validation rules for OtpAuthUrl
+
+	// no validation rules for QrCodeDataUri
+
+	if len(errors) > 0 {
+		return TOTPResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// TOTPResultMultiError is an error wrapping multiple validation errors
+// returned by TOTPResult.ValidateAll() if the designated constraints aren't met.
+type TOTPResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TOTPResultMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TOTPResultMultiError) AllErrors() []error { return m }
+
+// TOTPResultValidationError is the validation error returned by
+// TOTPResult.Validate if the designated constraints aren't met.
+type TOTPResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TOTPResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TOTPResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TOTPResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TOTPResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TOTPResultValidationError) ErrorName() string { return "TOTPResultValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TOTPResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTOTPResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TOTPResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TOTPResultValidationError{}
+
+// Validate checks the field values on SMSResult with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SMSResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SMSResult with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SMSResultMultiError, or nil
+// if none found.
+func (m *SMSResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SMSResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for VerificationId
+
+	// no validation rules for SmsSent
+
+	// no validation rules for MaskedPhone
+
+	// no validation rules for ResendAfterSeconds
+
+	if len(errors) > 0 {
+		return SMSResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// SMSResultMultiError is an error wrapping multiple validation errors returned
+// by SMSResult.ValidateAll() if the designated constraints aren't met.
+type SMSResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SMSResultMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SMSResultMultiError) AllErrors() []error { return m }
+
+// SMSResultValidationError is the validation error returned by
+// SMSResult.Validate if the designated constraints aren't met.
+type SMSResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SMSResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SMSResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SMSResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SMSResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SMSResultValidationError) ErrorName() string { return "SMSResultValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SMSResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSMSResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SMSResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SMSResultValidationError{}
+
+// Validate checks the field values on EmailResult with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *EmailResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EmailResult with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in EmailResultMultiError, or
+// nil if none found.
+func (m *EmailResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EmailResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for VerificationId
+
+	// no validation rules for EmailSent
+
+	// no validation rules for MaskedEmail
+
+	// no validation rules for ResendAfterSeconds
+
+	if len(errors) > 0 {
+		return EmailResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// EmailResultMultiError is an error wrapping multiple validation errors
+// returned by EmailResult.ValidateAll() if the designated constraints aren't met.
+type EmailResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EmailResultMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EmailResultMultiError) AllErrors() []error { return m }
+
+// EmailResultValidationError is the validation error returned by
+// EmailResult.Validate if the designated constraints aren't met.
+type EmailResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EmailResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EmailResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EmailResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EmailResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EmailResultValidationError) ErrorName() string { return "EmailResultValidationError" }
+
+// Error satisfies the builtin error interface
+func (e EmailResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEmailResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EmailResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EmailResultValidationError{}
+
+// Validate checks the field values on WebAuthnResult with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *WebAuthnResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WebAuthnResult with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in WebAuthnResultMultiError,
+// or nil if none found.
+func (m *WebAuthnResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WebAuthnResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Challenge
+
+	// no validation rules for OptionsJson
+
+	// no validation rules for RpId
+
+	if len(errors) > 0 {
+		return WebAuthnResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// WebAuthnResultMultiError is an error wrapping multiple validation errors
+// returned by WebAuthnResult.ValidateAll() if the designated constraints
+// aren't met.
+type WebAuthnResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WebAuthnResultMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WebAuthnResultMultiError) AllErrors() []error { return m }
+
+// WebAuthnResultValidationError is the validation error returned by
+// WebAuthnResult.Validate if the designated constraints aren't met.
+type WebAuthnResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WebAuthnResultValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e StartEnrollMethodRequestValidationError) Reason() string { return e.reason }
+func (e WebAuthnResultValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e StartEnrollMethodRequestValidationError) Cause() error { return e.cause }
+func (e WebAuthnResultValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e StartEnrollMethodRequestValidationError) Key() bool { return e.key }
+func (e WebAuthnResultValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e StartEnrollMethodRequestValidationError) ErrorName() string {
-	return "StartEnrollMethodRequestValidationError"
-}
+func (e WebAuthnResultValidationError) ErrorName() string { return "WebAuthnResultValidationError" }
 
 // Error satisfies the builtin error interface
-func (e StartEnrollMethodRequestValidationError) Error() string {
+func (e WebAuthnResultValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -793,14 +1909,14 @@ func (e StartEnrollMethodRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sStartEnrollMethodRequest.%s: %s%s",
+		"invalid %sWebAuthnResult.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = StartEnrollMethodRequestValidationError{}
+var _ error = WebAuthnResultValidationError{}
 
 var _ interface {
 	Field() string
@@ -808,37 +1924,39 @@ func (e StartEnrollMethodRequestValidationError) Error() string {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = StartEnrollMethodRequestValidationError{}
+} = WebAuthnResultValidationError{}
 
-// Validate checks the field values on StartEnrollMethodResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ConfirmEnrollMethodRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *StartEnrollMethodResponse) Validate() error {
+func (m *ConfirmEnrollMethodRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on StartEnrollMethodResponse with the
+// ValidateAll checks the field values on ConfirmEnrollMethodRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// StartEnrollMethodResponseMultiError, or nil if none found.
-func (m *StartEnrollMethodResponse) ValidateAll() error {
+// ConfirmEnrollMethodRequestMultiError, or nil if none found.
+func (m *ConfirmEnrollMethodRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *StartEnrollMethodResponse) validate(all bool) error {
+func (m *ConfirmEnrollMethodRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Method
+
 	// no validation rules for OperationId
 
-	switch v := m.Result.(type) {
-	case *StartEnrollMethodResponse_Totp:
+	switch v := m.Credential.(type) {
+	case *ConfirmEnrollMethodRequest_TotpCode:
 		if v == nil {
-			err := StartEnrollMethodResponseValidationError{
-				field:  "Result",
+			err := ConfirmEnrollMethodRequestValidationError{
+				field:  "Credential",
 				reason: "oneof value cannot be a typed-nil",
 			}
 			if !all {
@@ -846,40 +1964,11 @@ func (m *StartEnrollMethodResponse) validate(all bool) error {
 			}
 			errors = append(errors, err)
 		}
-
-		if all {
-			switch v := interface{}(m.GetTotp()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, StartEnrollMethodResponseValidationError{
-						field:  "Totp",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, StartEnrollMethodResponseValidationError{
-						field:  "Totp",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetTotp()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return StartEnrollMethodResponseValidationError{
-					field:  "Totp",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	case *StartEnrollMethodResponse_Sms:
+		// no validation rules for TotpCode
+	case *ConfirmEnrollMethodRequest_Sms:
 		if v == nil {
-			err := StartEnrollMethodResponseValidationError{
-				field:  "Result",
+			err := ConfirmEnrollMethodRequestValidationError{
+				field:  "Credential",
 				reason: "oneof value cannot be a typed-nil",
 			}
 			if !all {
@@ -892,7 +1981,7 @@ func (m *StartEnrollMethodResponse) validate(all bool) error {
 			switch v := interface{}(m.GetSms()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, StartEnrollMethodResponseValidationError{
+					errors = append(errors, ConfirmEnrollMethodRequestValidationError{
 						field:  "Sms",
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -900,7 +1989,7 @@ func (m *StartEnrollMethodResponse) validate(all bool) error {
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, StartEnrollMethodResponseValidationError{
+					errors = append(errors, ConfirmEnrollMethodRequestValidationError{
 						field:  "Sms",
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -909,7 +1998,7 @@ func (m *StartEnrollMethodResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(m.GetSms()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return StartEnrollMethodResponseValidationError{
+				return ConfirmEnrollMethodRequestValidationError{
 					field:  "Sms",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -917,10 +2006,10 @@ func (m *StartEnrollMethodResponse) validate(all bool) error {
 			}
 		}
 
-	case *StartEnrollMethodResponse_Webauthn:
+	case *ConfirmEnrollMethodRequest_Webauthn:
 		if v == nil {
-			err := StartEnrollMethodResponseValidationError{
-				field:  "Result",
+			err := ConfirmEnrollMethodRequestValidationError{
+				field:  "Credential",
 				reason: "oneof value cannot be a typed-nil",
 			}
 			if !all {
@@ -933,7 +2022,7 @@ func (m *StartEnrollMethodResponse) validate(all bool) error {
 			switch v := interface{}(m.GetWebauthn()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, StartEnrollMethodResponseValidationError{
+					errors = append(errors, ConfirmEnrollMethodRequestValidationError{
 						field:  "Webauthn",
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -941,7 +2030,7 @@ func (m *StartEnrollMethodResponse) validate(all bool) error {
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, StartEnrollMethodResponseValidationError{
+					errors = append(errors, ConfirmEnrollMethodRequestValidationError{
 						field:  "Webauthn",
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -950,7 +2039,7 @@ func (m *StartEnrollMethodResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(m.GetWebauthn()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return StartEnrollMethodResponseValidationError{
+				return ConfirmEnrollMethodRequestValidationError{
 					field:  "Webauthn",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -958,57 +2047,85 @@ func (m *StartEnrollMethodResponse) validate(all bool) error {
 			}
 		}
 
-	default:
-		_ = v // ensures v is used
-	}
-
-	if m.ExpiresAt != nil {
+	case *ConfirmEnrollMethodRequest_BackupCode:
+		if v == nil {
+			err := ConfirmEnrollMethodRequestValidationError{
+				field:  "Credential",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for BackupCode
+	case *ConfirmEnrollMethodRequest_Email:
+		if v == nil {
+			err := ConfirmEnrollMethodRequestValidationError{
+				field:  "Credential",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
 
 		if all {
-			switch v := interface{}(m.GetExpiresAt()).(type) {
+			switch v := interface{}(m.GetEmail()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, StartEnrollMethodResponseValidationError{
-						field:  "ExpiresAt",
+					errors = append(errors, ConfirmEnrollMethodRequestValidationError{
+						field:  "Email",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, StartEnrollMethodResponseValidationError{
-						field:  "ExpiresAt",
+					errors = append(errors, ConfirmEnrollMethodRequestValidationError{
+						field:  "Email",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetEmail()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return StartEnrollMethodResponseValidationError{
-					field:  "ExpiresAt",
+				return ConfirmEnrollMethodRequestValidationError{
+					field:  "Email",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
+	default:
+		_ = v // ensures v is used
+	}
+
+	if m.Display != nil {
+		// no validation rules for Display
+	}
+
+	if m.LoginOperationId != nil {
+		// no validation rules for LoginOperationId
 	}
 
 	if len(errors) > 0 {
-		return StartEnrollMethodResponseMultiError(errors)
+		return ConfirmEnrollMethodRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// StartEnrollMethodResponseMultiError is an error wrapping multiple validation
-// errors returned by StartEnrollMethodResponse.ValidateAll() if the
-// designated constraints aren't met.
-type StartEnrollMethodResponseMultiError []error
+// ConfirmEnrollMethodRequestMultiError is an error wrapping multiple
+// validation errors returned by ConfirmEnrollMethodRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ConfirmEnrollMethodRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m StartEnrollMethodResponseMultiError) Error() string {
+func (m ConfirmEnrollMethodRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1017,11 +2134,11 @@ func (m StartEnrollMethodResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m StartEnrollMethodResponseMultiError) AllErrors() []error { return m }
+func (m ConfirmEnrollMethodRequestMultiError) AllErrors() []error { return m }
 
-// StartEnrollMethodResponseValidationError is the validation error returned by
-// StartEnrollMethodResponse.Validate if the designated constraints aren't met.
-type StartEnrollMethodResponseValidationError struct {
+// ConfirmEnrollMethodRequestValidationError is the validation error returned
+// by ConfirmEnrollMethodRequest.Validate if the designated constraints aren't met.
+type ConfirmEnrollMethodRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1029,24 +2146,24 @@ type StartEnrollMethodResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e StartEnrollMethodResponseValidationError) Field() string { return e.field }
+func (e ConfirmEnrollMethodRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e StartEnrollMethodResponseValidationError) Reason() string { return e.reason }
+func (e ConfirmEnrollMethodRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e StartEnrollMethodResponseValidationError) Cause() error { return e.cause }
+func (e ConfirmEnrollMethodRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e StartEnrollMethodResponseValidationError) Key() bool { return e.key }
+func (e ConfirmEnrollMethodRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e StartEnrollMethodResponseValidationError) ErrorName() string {
-	return "StartEnrollMethodResponseValidationError"
+func (e ConfirmEnrollMethodRequestValidationError) ErrorName() string {
+	return "ConfirmEnrollMethodRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e StartEnrollMethodResponseValidationError) Error() string {
+func (e ConfirmEnrollMethodRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1058,14 +2175,14 @@ func (e StartEnrollMethodResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sStartEnrollMethodResponse.%s: %s%s",
+		"invalid %sConfirmEnrollMethodRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = StartEnrollMethodResponseValidationError{}
+var _ error = ConfirmEnrollMethodRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1073,49 +2190,48 @@ func (e StartEnrollMethodResponseValidationError) Error() string {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = StartEnrollMethodResponseValidationError{}
-
-// Validate checks the field values on TOTPResult with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *TOTPResult) Validate() error {
+} = ConfirmEnrollMethodRequestValidationError{}
+
+// Validate checks the field values on ConfirmEnrollMethodResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ConfirmEnrollMethodResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TOTPResult with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in TOTPResultMultiError, or
-// nil if none found.
-func (m *TOTPResult) ValidateAll() error {
+// ValidateAll checks the field values on ConfirmEnrollMethodResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConfirmEnrollMethodResponseMultiError, or nil if none found.
+func (m *ConfirmEnrollMethodResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TOTPResult) validate(all bool) error {
+func (m *ConfirmEnrollMethodResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Secret
-
-	// no validation rules for OtpAuthUrl
+	// no validation rules for Success
 
-	// no validation rules for QrCodeDataUri
+	// no validation rules for CredentialId
 
 	if len(errors) > 0 {
-		return TOTPResultMultiError(errors)
+		return ConfirmEnrollMethodResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// TOTPResultMultiError is an error wrapping multiple validation errors
-// returned by TOTPResult.ValidateAll() if the designated constraints aren't met.
-type TOTPResultMultiError []error
+// ConfirmEnrollMethodResponseMultiError is an error wrapping multiple
+// validation errors returned by ConfirmEnrollMethodResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ConfirmEnrollMethodResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TOTPResultMultiError) Error() string {
+func (m ConfirmEnrollMethodResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1124,11 +2240,12 @@ func (m TOTPResultMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TOTPResultMultiError) AllErrors() []error { return m }
+func (m ConfirmEnrollMethodResponseMultiError) AllErrors() []error { return m }
 
-// TOTPResultValidationError is the validation error returned by
-// TOTPResult.Validate if the designated constraints aren't met.
-type TOTPResultValidationError struct {
+// ConfirmEnrollMethodResponseValidationError is the validation error returned
+// by ConfirmEnrollMethodResponse.Validate if the designated constraints
+// aren't met.
+type ConfirmEnrollMethodResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1136,22 +2253,24 @@ type TOTPResultValidationError struct {
 }
 
 // Field function returns field value.
-func (e TOTPResultValidationError) Field() string { return e.field }
+func (e ConfirmEnrollMethodResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TOTPResultValidationError) Reason() string { return e.reason }
+func (e ConfirmEnrollMethodResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TOTPResultValidationError) Cause() error { return e.cause }
+func (e ConfirmEnrollMethodResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TOTPResultValidationError) Key() bool { return e.key }
+func (e ConfirmEnrollMethodResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TOTPResultValidationError) ErrorName() string { return "TOTPResultValidationError" }
+func (e ConfirmEnrollMethodResponseValidationError) ErrorName() string {
+	return "ConfirmEnrollMethodResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e TOTPResultValidationError) Error() string {
+func (e ConfirmEnrollMethodResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1163,14 +2282,14 @@ func (e TOTPResultValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTOTPResult.%s: %s%s",
+		"invalid %sConfirmEnrollMethodResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TOTPResultValidationError{}
+var _ error = ConfirmEnrollMethodResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1178,49 +2297,171 @@ func (e TOTPResultValidationError) Error() string {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TOTPResultValidationError{}
+} = ConfirmEnrollMethodResponseValidationError{}
 
-// Validate checks the field values on SMSResult with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *SMSResult) Validate() error {
+// Validate checks the field values on DisableMFARequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DisableMFARequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SMSResult with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in SMSResultMultiError, or nil
-// if none found.
-func (m *SMSResult) ValidateAll() error {
+// ValidateAll checks the field values on DisableMFARequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DisableMFARequestMultiError, or nil if none found.
+func (m *DisableMFARequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SMSResult) validate(all bool) error {
+func (m *DisableMFARequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for VerificationId
+	switch v := m.Verifier.(type) {
+	case *DisableMFARequest_Password:
+		if v == nil {
+			err := DisableMFARequestValidationError{
+				field:  "Verifier",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for Password
+	case *DisableMFARequest_TotpCode:
+		if v == nil {
+			err := DisableMFARequestValidationError{
+				field:  "Verifier",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for TotpCode
+	case *DisableMFARequest_Sms:
+		if v == nil {
+			err := DisableMFARequestValidationError{
+				field:  "Verifier",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
 
-	// no validation rules for SmsSent
+		if all {
+			switch v := interface{}(m.GetSms()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DisableMFARequestValidationError{
+						field:  "Sms",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DisableMFARequestValidationError{
+						field:  "Sms",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSms()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DisableMFARequestValidationError{
+					field:  "Sms",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-	// no validation rules for MaskedPhone
+	case *DisableMFARequest_Webauthn:
+		if v == nil {
+			err := DisableMFARequestValidationError{
+				field:  "Verifier",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetWebauthn()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DisableMFARequestValidationError{
+						field:  "Webauthn",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DisableMFARequestValidationError{
+						field:  "Webauthn",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetWebauthn()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DisableMFARequestValidationError{
+					field:  "Webauthn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if m.CredentialId != nil {
+		// no validation rules for CredentialId
+	}
+
+	if m.Method != nil {
+		// no validation rules for Method
+	}
+
+	if m.Reason != nil {
+		// no validation rules for Reason
+	}
+
+	if m.UserId != nil {
+		// no validation rules for UserId
+	}
 
 	if len(errors) > 0 {
-		return SMSResultMultiError(errors)
+		return DisableMFARequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// SMSResultMultiError is an error wrapping multiple validation errors returned
-// by SMSResult.ValidateAll() if the designated constraints aren't met.
-type SMSResultMultiError []error
+// DisableMFARequestMultiError is an error wrapping multiple validation errors
+// returned by DisableMFARequest.ValidateAll() if the designated constraints
+// aren't met.
+type DisableMFARequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SMSResultMultiError) Error() string {
+func (m DisableMFARequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1229,11 +2470,11 @@ func (m SMSResultMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SMSResultMultiError) AllErrors() []error { return m }
+func (m DisableMFARequestMultiError) AllErrors() []error { return m }
 
-// SMSResultValidationError is the validation error returned by
-// SMSResult.Validate if the designated constraints aren't met.
-type SMSResultValidationError struct {
+// DisableMFARequestValidationError is the validation error returned by
+// DisableMFARequest.Validate if the designated constraints aren't met.
+type DisableMFARequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1241,22 +2482,24 @@ type SMSResultValidationError struct {
 }
 
 // Field function returns field value.
-func (e SMSResultValidationError) Field() string { return e.field }
+func (e DisableMFARequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SMSResultValidationError) Reason() string { return e.reason }
+func (e DisableMFARequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SMSResultValidationError) Cause() error { return e.cause }
+func (e DisableMFARequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SMSResultValidationError) Key() bool { return e.key }
+func (e DisableMFARequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SMSResultValidationError) ErrorName() string { return "SMSResultValidationError" }
+func (e DisableMFARequestValidationError) ErrorName() string {
+	return "DisableMFARequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e SMSResultValidationError) Error() string {
+func (e DisableMFARequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1268,14 +2511,14 @@ func (e SMSResultValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSMSResult.%s: %s%s",
+		"invalid %sDisableMFARequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SMSResultValidationError{}
+var _ error = DisableMFARequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1283,50 +2526,58 @@ func (e SMSResultValidationError) Error() string {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SMSResultValidationError{}
+} = DisableMFARequestValidationError{}
 
-// Validate checks the field values on WebAuthnResult with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *WebAuthnResult) Validate() error {
+// Validate checks the field values on StartMFAChallengeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StartMFAChallengeRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on WebAuthnResult with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in WebAuthnResultMultiError,
-// or nil if none found.
-func (m *WebAuthnResult) ValidateAll() error {
+// ValidateAll checks the field values on StartMFAChallengeRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StartMFAChallengeRequestMultiError, or nil if none found.
+func (m *StartMFAChallengeRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *WebAuthnResult) validate(all bool) error {
+func (m *StartMFAChallengeRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Challenge
+	// no validation rules for Method
 
-	// no validation rules for OptionsJson
+	if m.UserId != nil {
+		// no validation rules for UserId
+	}
 
-	// no validation rules for RpId
+	if m.CredentialId != nil {
+		// no validation rules for CredentialId
+	}
+
+	if m.OperationId != nil {
+		// no validation rules for OperationId
+	}
 
 	if len(errors) > 0 {
-		return WebAuthnResultMultiError(errors)
+		return StartMFAChallengeRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// WebAuthnResultMultiError is an error wrapping multiple validation errors
-// returned by WebAuthnResult.ValidateAll() if the designated constraints
-// aren't met.
-type WebAuthnResultMultiError []error
+// StartMFAChallengeRequestMultiError is an error wrapping multiple validation
+// errors returned by StartMFAChallengeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type StartMFAChallengeRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m WebAuthnResultMultiError) Error() string {
+func (m StartMFAChallengeRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1335,11 +2586,11 @@ func (m WebAuthnResultMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m WebAuthnResultMultiError) AllErrors() []error { return m }
+func (m StartMFAChallengeRequestMultiError) AllErrors() []error { return m }
 
-// WebAuthnResultValidationError is the validation error returned by
-// WebAuthnResult.Validate if the designated constraints aren't met.
-type WebAuthnResultValidationError struct {
+// StartMFAChallengeRequestValidationError is the validation error returned by
+// StartMFAChallengeRequest.Validate if the designated constraints aren't met.
+type StartMFAChallengeRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1347,22 +2598,24 @@ type WebAuthnResultValidationError struct {
 }
 
 // Field function returns field value.
-func (e WebAuthnResultValidationError) Field() string { return e.field }
+func (e StartMFAChallengeRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e WebAuthnResultValidationError) Reason() string { return e.reason }
+func (e StartMFAChallengeRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e WebAuthnResultValidationError) Cause() error { return e.cause }
+func (e StartMFAChallengeRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e WebAuthnResultValidationError) Key() bool { return e.key }
+func (e StartMFAChallengeRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e WebAuthnResultValidationError) ErrorName() string { return "WebAuthnResultValidationError" }
+func (e StartMFAChallengeRequestValidationError) ErrorName() string {
+	return "StartMFAChallengeRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e WebAuthnResultValidationError) Error() string {
+func (e StartMFAChallengeRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1374,14 +2627,14 @@ func (e WebAuthnResultValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sWebAuthnResult.%s: %s%s",
+		"invalid %sStartMFAChallengeRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = WebAuthnResultValidationError{}
+var _ error = StartMFAChallengeRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1389,51 +2642,37 @@ func (e WebAuthnResultValidationError) Error() string {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = WebAuthnResultValidationError{}
+} = StartMFAChallengeRequestValidationError{}
 
-// Validate checks the field values on ConfirmEnrollMethodRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on StartMFAChallengeResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ConfirmEnrollMethodRequest) Validate() error {
+func (m *StartMFAChallengeResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ConfirmEnrollMethodRequest with the
+// ValidateAll checks the field values on StartMFAChallengeResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ConfirmEnrollMethodRequestMultiError, or nil if none found.
-func (m *ConfirmEnrollMethodRequest) ValidateAll() error {
+// StartMFAChallengeResponseMultiError, or nil if none found.
+func (m *StartMFAChallengeResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ConfirmEnrollMethodRequest) validate(all bool) error {
+func (m *StartMFAChallengeResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Method
-
 	// no validation rules for OperationId
 
-	switch v := m.Credential.(type) {
-	case *ConfirmEnrollMethodRequest_TotpCode:
-		if v == nil {
-			err := ConfirmEnrollMethodRequestValidationError{
-				field:  "Credential",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-		// no validation rules for TotpCode
-	case *ConfirmEnrollMethodRequest_Sms:
+	switch v := m.Challenge.(type) {
+	case *StartMFAChallengeResponse_Sms:
 		if v == nil {
-			err := ConfirmEnrollMethodRequestValidationError{
-				field:  "Credential",
+			err := StartMFAChallengeResponseValidationError{
+				field:  "Challenge",
 				reason: "oneof value cannot be a typed-nil",
 			}
 			if !all {
@@ -1446,7 +2685,7 @@ func (m *ConfirmEnrollMethodRequest) validate(all bool) error {
 			switch v := interface{}(m.GetSms()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ConfirmEnrollMethodRequestValidationError{
+					errors = append(errors, StartMFAChallengeResponseValidationError{
 						field:  "Sms",
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -1454,7 +2693,7 @@ func (m *ConfirmEnrollMethodRequest) validate(all bool) error {
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ConfirmEnrollMethodRequestValidationError{
+					errors = append(errors, StartMFAChallengeResponseValidationError{
 						field:  "Sms",
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -1463,7 +2702,7 @@ func (m *ConfirmEnrollMethodRequest) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(m.GetSms()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ConfirmEnrollMethodRequestValidationError{
+				return StartMFAChallengeResponseValidationError{
 					field:  "Sms",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -1471,10 +2710,10 @@ func (m *ConfirmEnrollMethodRequest) validate(all bool) error {
 			}
 		}
 
-	case *ConfirmEnrollMethodRequest_Webauthn:
+	case *StartMFAChallengeResponse_Webauthn:
 		if v == nil {
-			err := ConfirmEnrollMethodRequestValidationError{
-				field:  "Credential",
+			err := StartMFAChallengeResponseValidationError{
+				field:  "Challenge",
 				reason: "oneof value cannot be a typed-nil",
 			}
 			if !all {
@@ -1487,7 +2726,7 @@ func (m *ConfirmEnrollMethodRequest) validate(all bool) error {
 			switch v := interface{}(m.GetWebauthn()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ConfirmEnrollMethodRequestValidationError{
+					errors = append(errors, StartMFAChallengeResponseValidationError{
 						field:  "Webauthn",
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -1495,7 +2734,7 @@ func (m *ConfirmEnrollMethodRequest) validate(all bool) error {
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ConfirmEnrollMethodRequestValidationError{
+					errors = append(errors, StartMFAChallengeResponseValidationError{
 						field:  "Webauthn",
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -1504,7 +2743,7 @@ func (m *ConfirmEnrollMethodRequest) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(m.GetWebauthn()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ConfirmEnrollMethodRequestValidationError{
+				return StartMFAChallengeResponseValidationError{
 					field:  "Webauthn",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -1512,10 +2751,10 @@ func (m *ConfirmEnrollMethodRequest) validate(all bool) error {
 			}
 		}
 
-	case *ConfirmEnrollMethodRequest_BackupCode:
+	case *StartMFAChallengeResponse_Email:
 		if v == nil {
-			err := ConfirmEnrollMethodRequestValidationError{
-				field:  "Credential",
+			err := StartMFAChallengeResponseValidationError{
+				field:  "Challenge",
 				reason: "oneof value cannot be a typed-nil",
 			}
 			if !all {
@@ -1523,135 +2762,87 @@ func (m *ConfirmEnrollMethodRequest) validate(all bool) error {
 			}
 			errors = append(errors, err)
 		}
-		// no validation rules for BackupCode
-	default:
-		_ = v // ensures v is used
-	}
 
-	if m.Display != nil {
-		// no validation rules for Display
-	}
-
-	if len(errors) > 0 {
-		return ConfirmEnrollMethodRequestMultiError(errors)
-	}
-
-	return nil
-}
-
-// ConfirmEnrollMethodRequestMultiError is an error wrapping multiple
-// validation errors returned by ConfirmEnrollMethodRequest.ValidateAll() if
-// the designated constraints aren't met.
-type ConfirmEnrollMethodRequestMultiError []error
-
-// Error returns a concatenation of all the error messages it wraps.
-func (m ConfirmEnrollMethodRequestMultiError) Error() string {
-	msgs := make([]string, 0, len(m))
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
-	}
-	return strings.Join(msgs, "; ")
-}
-
-// AllErrors returns a list of validation violation errors.
-func (m ConfirmEnrollMethodRequestMultiError) AllErrors() []error { return m }
-
-// ConfirmEnrollMethodRequestValidationError is the validation error returned
-// by ConfirmEnrollMethodRequest.Validate if the designated constraints aren't met.
-type ConfirmEnrollMethodRequestValidationError struct {
-	field  string
-	reason string
-	cause  error
-	key    bool
-}
-
-// Field function returns field value.
-func (e ConfirmEnrollMethodRequestValidationError) Field() string { return e.field }
-
-// Reason function returns reason value.
-func (e ConfirmEnrollMethodRequestValidationError) Reason() string { return e.reason }
-
-// Cause function returns cause value.
-func (e ConfirmEnrollMethodRequestValidationError) Cause() error { return e.cause }
-
-// Key function returns key value.
-func (e ConfirmEnrollMethodRequestValidationError) Key() bool { return e.key }
-
-// ErrorName returns error name.
-func (e ConfirmEnrollMethodRequestValidationError) ErrorName() string {
-	return "ConfirmEnrollMethodRequestValidationError"
-}
-
-// Error satisfies the builtin error interface
-func (e ConfirmEnrollMethodRequestValidationError) Error() string {
-	cause := ""
-	if e.cause != nil {
-		cause = fmt.Sprintf(" | caused by: %v", e.cause)
-	}
-
-	key := ""
-	if e.key {
-		key = "key for "
-	}
-
-	return fmt.Sprintf(
-		"invalid %sConfirmEnrollMethodRequest.%s: %s%s",
-		key,
-		e.field,
-		e.reason,
-		cause)
-}
-
-var _ error = ConfirmEnrollMethodRequestValidationError{}
-
-var _ interface {
-	Field() string
-	Reason() string
-	Key() bool
-	Cause() error
-	ErrorName() string
-} = ConfirmEnrollMethodRequestValidationError{}
-
-// Validate checks the field values on ConfirmEnrollMethodResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ConfirmEnrollMethodResponse) Validate() error {
-	return m.validate(false)
-}
-
-// ValidateAll checks the field values on ConfirmEnrollMethodResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ConfirmEnrollMethodResponseMultiError, or nil if none found.
-func (m *ConfirmEnrollMethodResponse) ValidateAll() error {
-	return m.validate(true)
-}
+		if all {
+			switch v := interface{}(m.GetEmail()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StartMFAChallengeResponseValidationError{
+						field:  "Email",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StartMFAChallengeResponseValidationError{
+						field:  "Email",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetEmail()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StartMFAChallengeResponseValidationError{
+					field:  "Email",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-func (m *ConfirmEnrollMethodResponse) validate(all bool) error {
-	if m == nil {
-		return nil
+	default:
+		_ = v // ensures v is used
 	}
 
-	var errors []error
+	if m.ExpiresAt != nil {
 
-	// no validation rules for Success
+		if all {
+			switch v := interface{}(m.GetExpiresAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StartMFAChallengeResponseValidationError{
+						field:  "ExpiresAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StartMFAChallengeResponseValidationError{
+						field:  "ExpiresAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StartMFAChallengeResponseValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-	// no validation rules for CredentialId
+	}
 
 	if len(errors) > 0 {
-		return ConfirmEnrollMethodResponseMultiError(errors)
+		return StartMFAChallengeResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ConfirmEnrollMethodResponseMultiError is an error wrapping multiple
-// validation errors returned by ConfirmEnrollMethodResponse.ValidateAll() if
-// the designated constraints aren't met.
-type ConfirmEnrollMethodResponseMultiError []error
+// StartMFAChallengeResponseMultiError is an error wrapping multiple validation
+// errors returned by StartMFAChallengeResponse.ValidateAll() if the
+// designated constraints aren't met.
+type StartMFAChallengeResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ConfirmEnrollMethodResponseMultiError) Error() string {
+func (m StartMFAChallengeResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1660,12 +2851,11 @@ func (m ConfirmEnrollMethodResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ConfirmEnrollMethodResponseMultiError) AllErrors() []error { return m }
+func (m StartMFAChallengeResponseMultiError) AllErrors() []error { return m }
 
-// ConfirmEnrollMethodResponseValidationError is the validation error returned
-// by ConfirmEnrollMethodResponse.Validate if the designated constraints
-// aren't met.
-type ConfirmEnrollMethodResponseValidationError struct {
+// StartMFAChallengeResponseValidationError is the validation error returned by
+// StartMFAChallengeResponse.Validate if the designated constraints aren't met.
+type StartMFAChallengeResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1673,24 +2863,24 @@ type ConfirmEnrollMethodResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ConfirmEnrollMethodResponseValidationError) Field() string { return e.field }
+func (e StartMFAChallengeResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ConfirmEnrollMethodResponseValidationError) Reason() string { return e.reason }
+func (e StartMFAChallengeResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ConfirmEnrollMethodResponseValidationError) Cause() error { return e.cause }
+func (e StartMFAChallengeResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ConfirmEnrollMethodResponseValidationError) Key() bool { return e.key }
+func (e StartMFAChallengeResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ConfirmEnrollMethodResponseValidationError) ErrorName() string {
-	return "ConfirmEnrollMethodResponseValidationError"
+func (e StartMFAChallengeResponseValidationError) ErrorName() string {
+	return "StartMFAChallengeResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ConfirmEnrollMethodResponseValidationError) Error() string {
+func (e StartMFAChallengeResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1702,14 +2892,14 @@ func (e ConfirmEnrollMethodResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sConfirmEnrollMethodResponse.%s: %s%s",
+		"invalid %sStartMFAChallengeResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ConfirmEnrollMethodResponseValidationError{}
+var _ error = StartMFAChallengeResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1717,47 +2907,37 @@ func (e ConfirmEnrollMethodResponseValidationError) Error() string {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ConfirmEnrollMethodResponseValidationError{}
+} = StartMFAChallengeResponseValidationError{}
 
-// Validate checks the field values on DisableMFARequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *DisableMFARequest) Validate() error {
+// Validate checks the field values on VerifyMFAChallengeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *VerifyMFAChallengeRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DisableMFARequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on VerifyMFAChallengeRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DisableMFARequestMultiError, or nil if none found.
-func (m *DisableMFARequest) ValidateAll() error {
+// VerifyMFAChallengeRequestMultiError, or nil if none found.
+func (m *VerifyMFAChallengeRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DisableMFARequest) validate(all bool) error {
+func (m *VerifyMFAChallengeRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	switch v := m.Verifier.(type) {
-	case *DisableMFARequest_Password:
-		if v == nil {
-			err := DisableMFARequestValidationError{
-				field:  "Verifier",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-		// no validation rules for Password
-	case *DisableMFARequest_TotpCode:
+	// no validation rules for OperationId
+
+	switch v := m.Response.(type) {
+	case *VerifyMFAChallengeRequest_TotpCode:
 		if v == nil {
-			err := DisableMFARequestValidationError{
-				field:  "Verifier",
+			err := VerifyMFAChallengeRequestValidationError{
+				field:  "Response",
 				reason: "oneof value cannot be a typed-nil",
 			}
 			if !all {
@@ -1766,10 +2946,10 @@ func (m *DisableMFARequest) validate(all bool) error {
 			errors = append(errors, err)
 		}
 		// no validation rules for TotpCode
-	case *DisableMFARequest_Sms:
+	case *VerifyMFAChallengeRequest_Sms:
 		if v == nil {
-			err := DisableMFARequestValidationError{
-				field:  "Verifier",
+			err := VerifyMFAChallengeRequestValidationError{
+				field:  "Response",
 				reason: "oneof value cannot be a typed-nil",
 			}
 			if !all {
@@ -1782,7 +2962,7 @@ func (m *DisableMFARequest) validate(all bool) error {
 			switch v := interface{}(m.GetSms()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, DisableMFARequestValidationError{
+					errors = append(errors, VerifyMFAChallengeRequestValidationError{
 						field:  "Sms",
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -1790,7 +2970,7 @@ func (m *DisableMFARequest) validate(all bool) error {
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, DisableMFARequestValidationError{
+					errors = append(errors, VerifyMFAChallengeRequestValidationError{
 						field:  "Sms",
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -1799,7 +2979,7 @@ func (m *DisableMFARequest) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(m.GetSms()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return DisableMFARequestValidationError{
+				return VerifyMFAChallengeRequestValidationError{
 					field:  "Sms",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -1807,10 +2987,10 @@ func (m *DisableMFARequest) validate(all bool) error {
 			}
 		}
 
-	case *DisableMFARequest_Webauthn:
+	case *VerifyMFAChallengeRequest_Webauthn:
 		if v == nil {
-			err := DisableMFARequestValidationError{
-				field:  "Verifier",
+			err := VerifyMFAChallengeRequestValidationError{
+				field:  "Response",
 				reason: "oneof value cannot be a typed-nil",
 			}
 			if !all {
@@ -1823,7 +3003,7 @@ func (m *DisableMFARequest) validate(all bool) error {
 			switch v := interface{}(m.GetWebauthn()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, DisableMFARequestValidationError{
+					errors = append(errors, VerifyMFAChallengeRequestValidationError{
 						field:  "Webauthn",
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -1831,7 +3011,7 @@ func (m *DisableMFARequest) validate(all bool) error {
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, DisableMFARequestValidationError{
+					errors = append(errors, VerifyMFAChallengeRequestValidationError{
 						field:  "Webauthn",
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -1840,7 +3020,7 @@ func (m *DisableMFARequest) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(m.GetWebauthn()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return DisableMFARequestValidationError{
+				return VerifyMFAChallengeRequestValidationError{
 					field:  "Webauthn",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -1848,40 +3028,185 @@ func (m *DisableMFARequest) validate(all bool) error {
 			}
 		}
 
-	default:
-		_ = v // ensures v is used
-	}
+	case *VerifyMFAChallengeRequest_BackupCode:
+		if v == nil {
+			err := VerifyMFAChallengeRequestValidationError{
+				field:  "Response",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for BackupCode
+	case *VerifyMFAChallengeRequest_Email:
+		if v == nil {
+			err := VerifyMFAChallengeRequestValidationError{
+				field:  "Response",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetEmail()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, VerifyMFAChallengeRequestValidationError{
+						field:  "Email",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, VerifyMFAChallengeRequestValidationError{
+						field:  "Email",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetEmail()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return VerifyMFAChallengeRequestValidationError{
+					field:  "Email",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return VerifyMFAChallengeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// VerifyMFAChallengeRequestMultiError is an error wrapping multiple validation
+// errors returned by VerifyMFAChallengeRequest.ValidateAll() if the
+// designated constraints aren't met.
+type VerifyMFAChallengeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VerifyMFAChallengeRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VerifyMFAChallengeRequestMultiError) AllErrors() []error { return m }
+
+// VerifyMFAChallengeRequestValidationError is the validation error returned by
+// VerifyMFAChallengeRequest.Validate if the designated constraints aren't met.
+type VerifyMFAChallengeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VerifyMFAChallengeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VerifyMFAChallengeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VerifyMFAChallengeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VerifyMFAChallengeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VerifyMFAChallengeRequestValidationError) ErrorName() string {
+	return "VerifyMFAChallengeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VerifyMFAChallengeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVerifyMFAChallengeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VerifyMFAChallengeRequestValidationError{}
 
-	if m.CredentialId != nil {
-		// no validation rules for CredentialId
-	}
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VerifyMFAChallengeRequestValidationError{}
 
-	if m.Method != nil {
-		// no validation rules for Method
-	}
+// Validate checks the field values on VerifyMFAChallengeResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *VerifyMFAChallengeResponse) Validate() error {
+	return m.validate(false)
+}
 
-	if m.Reason != nil {
-		// no validation rules for Reason
+// ValidateAll checks the field values on VerifyMFAChallengeResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// VerifyMFAChallengeResponseMultiError, or nil if none found.
+func (m *VerifyMFAChallengeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VerifyMFAChallengeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
-	if m.UserId != nil {
-		// no validation rules for UserId
+	var errors []error
+
+	// no validation rules for Success
+
+	if m.SessionToken != nil {
+		// no validation rules for SessionToken
 	}
 
 	if len(errors) > 0 {
-		return DisableMFARequestMultiError(errors)
+		return VerifyMFAChallengeResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DisableMFARequestMultiError is an error wrapping multiple validation errors
-// returned by DisableMFARequest.ValidateAll() if the designated constraints
-// aren't met.
-type DisableMFARequestMultiError []error
+// VerifyMFAChallengeResponseMultiError is an error wrapping multiple
+// validation errors returned by VerifyMFAChallengeResponse.ValidateAll() if
+// the designated constraints aren't met.
+type VerifyMFAChallengeResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DisableMFARequestMultiError) Error() string {
+func (m VerifyMFAChallengeResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1890,11 +3215,11 @@ func (m DisableMFARequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DisableMFARequestMultiError) AllErrors() []error { return m }
+func (m VerifyMFAChallengeResponseMultiError) AllErrors() []error { return m }
 
-// DisableMFARequestValidationError is the validation error returned by
-// DisableMFARequest.Validate if the designated constraints aren't met.
-type DisableMFARequestValidationError struct {
+// VerifyMFAChallengeResponseValidationError is the validation error returned
+// by VerifyMFAChallengeResponse.Validate if the designated constraints aren't met.
+type VerifyMFAChallengeResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1902,24 +3227,24 @@ type DisableMFARequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DisableMFARequestValidationError) Field() string { return e.field }
+func (e VerifyMFAChallengeResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DisableMFARequestValidationError) Reason() string { return e.reason }
+func (e VerifyMFAChallengeResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DisableMFARequestValidationError) Cause() error { return e.cause }
+func (e VerifyMFAChallengeResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DisableMFARequestValidationError) Key() bool { return e.key }
+func (e VerifyMFAChallengeResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DisableMFARequestValidationError) ErrorName() string {
-	return "DisableMFARequestValidationError"
+func (e VerifyMFAChallengeResponseValidationError) ErrorName() string {
+	return "VerifyMFAChallengeResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DisableMFARequestValidationError) Error() string {
+func (e VerifyMFAChallengeResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1931,14 +3256,14 @@ func (e DisableMFARequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDisableMFARequest.%s: %s%s",
+		"invalid %sVerifyMFAChallengeResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DisableMFARequestValidationError{}
+var _ error = VerifyMFAChallengeResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1946,54 +3271,48 @@ func (e DisableMFARequestValidationError) Error() string {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DisableMFARequestValidationError{}
+} = VerifyMFAChallengeResponseValidationError{}
 
-// Validate checks the field values on StartMFAChallengeRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GenerateBackupCodesRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *StartMFAChallengeRequest) Validate() error {
+func (m *GenerateBackupCodesRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on StartMFAChallengeRequest with the
+// ValidateAll checks the field values on GenerateBackupCodesRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// StartMFAChallengeRequestMultiError, or nil if none found.
-func (m *StartMFAChallengeRequest) ValidateAll() error {
+// GenerateBackupCodesRequestMultiError, or nil if none found.
+func (m *GenerateBackupCodesRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *StartMFAChallengeRequest) validate(all bool) error {
+func (m *GenerateBackupCodesRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Method
-
-	if m.UserId != nil {
-		// no validation rules for UserId
-	}
-
-	if m.CredentialId != nil {
-		// no validation rules for CredentialId
+	if m.Count != nil {
+		// no validation rules for Count
 	}
 
 	if len(errors) > 0 {
-		return StartMFAChallengeRequestMultiError(errors)
+		return GenerateBackupCodesRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// StartMFAChallengeRequestMultiError is an error wrapping multiple validation
-// errors returned by StartMFAChallengeRequest.ValidateAll() if the designated
-// constraints aren't met.
-type StartMFAChallengeRequestMultiError []error
+// GenerateBackupCodesRequestMultiError is an error wrapping multiple
+// validation errors returned by GenerateBackupCodesRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GenerateBackupCodesRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m StartMFAChallengeRequestMultiError) Error() string {
+func (m GenerateBackupCodesRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2002,11 +3321,11 @@ func (m StartMFAChallengeRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m StartMFAChallengeRequestMultiError) AllErrors() []error { return m }
+func (m GenerateBackupCodesRequestMultiError) AllErrors() []error { return m }
 
-// StartMFAChallengeRequestValidationError is the validation error returned by
-// StartMFAChallengeRequest.Validate if the designated constraints aren't met.
-type StartMFAChallengeRequestValidationError struct {
+// GenerateBackupCodesRequestValidationError is the validation error returned
+// by GenerateBackupCodesRequest.Validate if the designated constraints aren't met.
+type GenerateBackupCodesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2014,24 +3333,24 @@ type StartMFAChallengeRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e StartMFAChallengeRequestValidationError) Field() string { return e.field }
+func (e GenerateBackupCodesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e StartMFAChallengeRequestValidationError) Reason() string { return e.reason }
+func (e GenerateBackupCodesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e StartMFAChallengeRequestValidationError) Cause() error { return e.cause }
+func (e GenerateBackupCodesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e StartMFAChallengeRequestValidationError) Key() bool { return e.key }
+func (e GenerateBackupCodesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e StartMFAChallengeRequestValidationError) ErrorName() string {
-	return "StartMFAChallengeRequestValidationError"
+func (e GenerateBackupCodesRequestValidationError) ErrorName() string {
+	return "GenerateBackupCodesRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e StartMFAChallengeRequestValidationError) Error() string {
+func (e GenerateBackupCodesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2043,14 +3362,14 @@ func (e StartMFAChallengeRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sStartMFAChallengeRequest.%s: %s%s",
+		"invalid %sGenerateBackupCodesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = StartMFAChallengeRequestValidationError{}
+var _ error = GenerateBackupCodesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2058,144 +3377,55 @@ func (e StartMFAChallengeRequestValidationError) Error() string {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = StartMFAChallengeRequestValidationError{}
-
-// Validate checks the field values on StartMFAChallengeResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *StartMFAChallengeResponse) Validate() error {
-	return m.validate(false)
-}
+} = GenerateBackupCodesRequestValidationError{}
 
-// ValidateAll checks the field values on StartMFAChallengeResponse with the
+// Validate checks the field values on GenerateBackupCodesResponse with the
 // rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// StartMFAChallengeResponseMultiError, or nil if none found.
-func (m *StartMFAChallengeResponse) ValidateAll() error {
-	return m.validate(true)
-}
-
-func (m *StartMFAChallengeResponse) validate(all bool) error {
-	if m == nil {
-		return nil
-	}
-
-	var errors []error
-
-	// no validation rules for OperationId
-
-	switch v := m.Challenge.(type) {
-	case *StartMFAChallengeResponse_Sms:
-		if v == nil {
-			err := StartMFAChallengeResponseValidationError{
-				field:  "Challenge",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-		if all {
-			switch v := interface{}(m.GetSms()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, StartMFAChallengeResponseValidationError{
-						field:  "Sms",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, StartMFAChallengeResponseValidationError{
-						field:  "Sms",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetSms()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return StartMFAChallengeResponseValidationError{
-					field:  "Sms",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	case *StartMFAChallengeResponse_Webauthn:
-		if v == nil {
-			err := StartMFAChallengeResponseValidationError{
-				field:  "Challenge",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-		if all {
-			switch v := interface{}(m.GetWebauthn()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, StartMFAChallengeResponseValidationError{
-						field:  "Webauthn",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, StartMFAChallengeResponseValidationError{
-						field:  "Webauthn",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetWebauthn()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return StartMFAChallengeResponseValidationError{
-					field:  "Webauthn",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GenerateBackupCodesResponse) Validate() error {
+	return m.validate(false)
+}
 
-	default:
-		_ = v // ensures v is used
+// ValidateAll checks the field values on GenerateBackupCodesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GenerateBackupCodesResponseMultiError, or nil if none found.
+func (m *GenerateBackupCodesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GenerateBackupCodesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
-	if m.ExpiresAt != nil {
+	var errors []error
+
+	if m.GeneratedAt != nil {
 
 		if all {
-			switch v := interface{}(m.GetExpiresAt()).(type) {
+			switch v := interface{}(m.GetGeneratedAt()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, StartMFAChallengeResponseValidationError{
-						field:  "ExpiresAt",
+					errors = append(errors, GenerateBackupCodesResponseValidationError{
+						field:  "GeneratedAt",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, StartMFAChallengeResponseValidationError{
-						field:  "ExpiresAt",
+					errors = append(errors, GenerateBackupCodesResponseValidationError{
+						field:  "GeneratedAt",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetGeneratedAt()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return StartMFAChallengeResponseValidationError{
-					field:  "ExpiresAt",
+				return GenerateBackupCodesResponseValidationError{
+					field:  "GeneratedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -2205,19 +3435,19 @@ func (m *StartMFAChallengeResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return StartMFAChallengeResponseMultiError(errors)
+		return GenerateBackupCodesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// StartMFAChallengeResponseMultiError is an error wrapping multiple validation
-// errors returned by StartMFAChallengeResponse.ValidateAll() if the
-// designated constraints aren't met.
-type StartMFAChallengeResponseMultiError []error
+// GenerateBackupCodesResponseMultiError is an error wrapping multiple
+// validation errors returned by GenerateBackupCodesResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GenerateBackupCodesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m StartMFAChallengeResponseMultiError) Error() string {
+func (m GenerateBackupCodesResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2226,11 +3456,12 @@ func (m StartMFAChallengeResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m StartMFAChallengeResponseMultiError) AllErrors() []error { return m }
+func (m GenerateBackupCodesResponseMultiError) AllErrors() []error { return m }
 
-// StartMFAChallengeResponseValidationError is the validation error returned by
-// StartMFAChallengeResponse.Validate if the designated constraints aren't met.
-type StartMFAChallengeResponseValidationError struct {
+// GenerateBackupCodesResponseValidationError is the validation error returned
+// by GenerateBackupCodesResponse.Validate if the designated constraints
+// aren't met.
+type GenerateBackupCodesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2238,24 +3469,24 @@ type StartMFAChallengeResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e StartMFAChallengeResponseValidationError) Field() string { return e.field }
+func (e GenerateBackupCodesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e StartMFAChallengeResponseValidationError) Reason() string { return e.reason }
+func (e GenerateBackupCodesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e StartMFAChallengeResponseValidationError) Cause() error { return e.cause }
+func (e GenerateBackupCodesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e StartMFAChallengeResponseValidationError) Key() bool { return e.key }
+func (e GenerateBackupCodesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e StartMFAChallengeResponseValidationError) ErrorName() string {
-	return "StartMFAChallengeResponseValidationError"
+func (e GenerateBackupCodesResponseValidationError) ErrorName() string {
+	return "GenerateBackupCodesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e StartMFAChallengeResponseValidationError) Error() string {
+func (e GenerateBackupCodesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2267,14 +3498,14 @@ func (e StartMFAChallengeResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sStartMFAChallengeResponse.%s: %s%s",
+		"invalid %sGenerateBackupCodesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = StartMFAChallengeResponseValidationError{}
+var _ error = GenerateBackupCodesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2282,157 +3513,44 @@ func (e StartMFAChallengeResponseValidationError) Error() string {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = StartMFAChallengeResponseValidationError{}
+} = GenerateBackupCodesResponseValidationError{}
 
-// Validate checks the field values on VerifyMFAChallengeRequest with the rules
+// Validate checks the field values on ListBackupCodesRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *VerifyMFAChallengeRequest) Validate() error {
+func (m *ListBackupCodesRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on VerifyMFAChallengeRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListBackupCodesRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// VerifyMFAChallengeRequestMultiError, or nil if none found.
-func (m *VerifyMFAChallengeRequest) ValidateAll() error {
+// ListBackupCodesRequestMultiError, or nil if none found.
+func (m *ListBackupCodesRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *VerifyMFAChallengeRequest) validate(all bool) error {
+func (m *ListBackupCodesRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for OperationId
-
-	switch v := m.Response.(type) {
-	case *VerifyMFAChallengeRequest_TotpCode:
-		if v == nil {
-			err := VerifyMFAChallengeRequestValidationError{
-				field:  "Response",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-		// no validation rules for TotpCode
-	case *VerifyMFAChallengeRequest_Sms:
-		if v == nil {
-			err := VerifyMFAChallengeRequestValidationError{
-				field:  "Response",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-		if all {
-			switch v := interface{}(m.GetSms()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, VerifyMFAChallengeRequestValidationError{
-						field:  "Sms",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, VerifyMFAChallengeRequestValidationError{
-						field:  "Sms",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetSms()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return VerifyMFAChallengeRequestValidationError{
-					field:  "Sms",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	case *VerifyMFAChallengeRequest_Webauthn:
-		if v == nil {
-			err := VerifyMFAChallengeRequestValidationError{
-				field:  "Response",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-		if all {
-			switch v := interface{}(m.GetWebauthn()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, VerifyMFAChallengeRequestValidationError{
-						field:  "Webauthn",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, VerifyMFAChallengeRequestValidationError{
-						field:  "Webauthn",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetWebauthn()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return VerifyMFAChallengeRequestValidationError{
-					field:  "Webauthn",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	case *VerifyMFAChallengeRequest_BackupCode:
-		if v == nil {
-			err := VerifyMFAChallengeRequestValidationError{
-				field:  "Response",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-		// no validation rules for BackupCode
-	default:
-		_ = v // ensures v is used
-	}
-
 	if len(errors) > 0 {
-		return VerifyMFAChallengeRequestMultiError(errors)
+		return ListBackupCodesRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// VerifyMFAChallengeRequestMultiError is an error wrapping multiple validation
-// errors returned by VerifyMFAChallengeRequest.ValidateAll() if the
-// designated constraints aren't met.
-type VerifyMFAChallengeRequestMultiError []error
+// ListBackupCodesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListBackupCodesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListBackupCodesRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m VerifyMFAChallengeRequestMultiError) Error() string {
+func (m ListBackupCodesRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2441,11 +3559,11 @@ func (m VerifyMFAChallengeRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m VerifyMFAChallengeRequestMultiError) AllErrors() []error { return m }
+func (m ListBackupCodesRequestMultiError) AllErrors() []error { return m }
 
-// VerifyMFAChallengeRequestValidationError is the validation error returned by
-// VerifyMFAChallengeRequest.Validate if the designated constraints aren't met.
-type VerifyMFAChallengeRequestValidationError struct {
+// ListBackupCodesRequestValidationError is the validation error returned by
+// ListBackupCodesRequest.Validate if the designated constraints aren't met.
+type ListBackupCodesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2453,24 +3571,24 @@ type VerifyMFAChallengeRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e VerifyMFAChallengeRequestValidationError) Field() string { return e.field }
+func (e ListBackupCodesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e VerifyMFAChallengeRequestValidationError) Reason() string { return e.reason }
+func (e ListBackupCodesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e VerifyMFAChallengeRequestValidationError) Cause() error { return e.cause }
+func (e ListBackupCodesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e VerifyMFAChallengeRequestValidationError) Key() bool { return e.key }
+func (e ListBackupCodesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e VerifyMFAChallengeRequestValidationError) ErrorName() string {
-	return "VerifyMFAChallengeRequestValidationError"
+func (e ListBackupCodesRequestValidationError) ErrorName() string {
+	return "ListBackupCodesRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e VerifyMFAChallengeRequestValidationError) Error() string {
+func (e ListBackupCodesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2482,14 +3600,14 @@ func (e VerifyMFAChallengeRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sVerifyMFAChallengeRequest.%s: %s%s",
+		"invalid %sListBackupCodesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = VerifyMFAChallengeRequestValidationError{}
+var _ error = ListBackupCodesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2497,50 +3615,79 @@ func (e VerifyMFAChallengeRequestValidationError) Error() string {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = VerifyMFAChallengeRequestValidationError{}
+} = ListBackupCodesRequestValidationError{}
 
-// Validate checks the field values on VerifyMFAChallengeResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ListBackupCodesResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *VerifyMFAChallengeResponse) Validate() error {
+func (m *ListBackupCodesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on VerifyMFAChallengeResponse with the
+// ValidateAll checks the field values on ListBackupCodesResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// VerifyMFAChallengeResponseMultiError, or nil if none found.
-func (m *VerifyMFAChallengeResponse) ValidateAll() error {
+// ListBackupCodesResponseMultiError, or nil if none found.
+func (m *ListBackupCodesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *VerifyMFAChallengeResponse) validate(all bool) error {
+func (m *ListBackupCodesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Success
+	// no validation rules for Remaining
+
+	if m.GeneratedAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetGeneratedAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListBackupCodesResponseValidationError{
+						field:  "GeneratedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListBackupCodesResponseValidationError{
+						field:  "GeneratedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetGeneratedAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListBackupCodesResponseValidationError{
+					field:  "GeneratedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-	if m.SessionToken != nil {
-		// no validation rules for SessionToken
 	}
 
 	if len(errors) > 0 {
-		return VerifyMFAChallengeResponseMultiError(errors)
+		return ListBackupCodesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// VerifyMFAChallengeResponseMultiError is an error wrapping multiple
-// validation errors returned by VerifyMFAChallengeResponse.ValidateAll() if
-// the designated constraints aren't met.
-type VerifyMFAChallengeResponseMultiError []error
+// ListBackupCodesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListBackupCodesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListBackupCodesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m VerifyMFAChallengeResponseMultiError) Error() string {
+func (m ListBackupCodesResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2549,11 +3696,11 @@ func (m VerifyMFAChallengeResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m VerifyMFAChallengeResponseMultiError) AllErrors() []error { return m }
+func (m ListBackupCodesResponseMultiError) AllErrors() []error { return m }
 
-// VerifyMFAChallengeResponseValidationError is the validation error returned
-// by VerifyMFAChallengeResponse.Validate if the designated constraints aren't met.
-type VerifyMFAChallengeResponseValidationError struct {
+// ListBackupCodesResponseValidationError is the validation error returned by
+// ListBackupCodesResponse.Validate if the designated constraints aren't met.
+type ListBackupCodesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2561,24 +3708,24 @@ type VerifyMFAChallengeResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e VerifyMFAChallengeResponseValidationError) Field() string { return e.field }
+func (e ListBackupCodesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e VerifyMFAChallengeResponseValidationError) Reason() string { return e.reason }
+func (e ListBackupCodesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e VerifyMFAChallengeResponseValidationError) Cause() error { return e.cause }
+func (e ListBackupCodesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e VerifyMFAChallengeResponseValidationError) Key() bool { return e.key }
+func (e ListBackupCodesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e VerifyMFAChallengeResponseValidationError) ErrorName() string {
-	return "VerifyMFAChallengeResponseValidationError"
+func (e ListBackupCodesResponseValidationError) ErrorName() string {
+	return "ListBackupCodesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e VerifyMFAChallengeResponseValidationError) Error() string {
+func (e ListBackupCodesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2590,14 +3737,14 @@ func (e VerifyMFAChallengeResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sVerifyMFAChallengeResponse.%s: %s%s",
+		"invalid %sListBackupCodesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = VerifyMFAChallengeResponseValidationError{}
+var _ error = ListBackupCodesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2605,48 +3752,46 @@ func (e VerifyMFAChallengeResponseValidationError) Error() string {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = VerifyMFAChallengeResponseValidationError{}
+} = ListBackupCodesResponseValidationError{}
 
-// Validate checks the field values on GenerateBackupCodesRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on RevokeMFADeviceRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GenerateBackupCodesRequest) Validate() error {
+func (m *RevokeMFADeviceRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GenerateBackupCodesRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on RevokeMFADeviceRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GenerateBackupCodesRequestMultiError, or nil if none found.
-func (m *GenerateBackupCodesRequest) ValidateAll() error {
+// RevokeMFADeviceRequestMultiError, or nil if none found.
+func (m *RevokeMFADeviceRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GenerateBackupCodesRequest) validate(all bool) error {
+func (m *RevokeMFADeviceRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.Count != nil {
-		// no validation rules for Count
-	}
+	// no validation rules for CredentialId
 
 	if len(errors) > 0 {
-		return GenerateBackupCodesRequestMultiError(errors)
+		return RevokeMFADeviceRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GenerateBackupCodesRequestMultiError is an error wrapping multiple
-// validation errors returned by GenerateBackupCodesRequest.ValidateAll() if
-// the designated constraints aren't met.
-type GenerateBackupCodesRequestMultiError []error
+// RevokeMFADeviceRequestMultiError is an error wrapping multiple validation
+// errors returned by RevokeMFADeviceRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RevokeMFADeviceRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GenerateBackupCodesRequestMultiError) Error() string {
+func (m RevokeMFADeviceRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2655,11 +3800,11 @@ func (m GenerateBackupCodesRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GenerateBackupCodesRequestMultiError) AllErrors() []error { return m }
+func (m RevokeMFADeviceRequestMultiError) AllErrors() []error { return m }
 
-// GenerateBackupCodesRequestValidationError is the validation error returned
-// by GenerateBackupCodesRequest.Validate if the designated constraints aren't met.
-type GenerateBackupCodesRequestValidationError struct {
+// RevokeMFADeviceRequestValidationError is the validation error returned by
+// RevokeMFADeviceRequest.Validate if the designated constraints aren't met.
+type RevokeMFADeviceRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2667,24 +3812,24 @@ type GenerateBackupCodesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GenerateBackupCodesRequestValidationError) Field() string { return e.field }
+func (e RevokeMFADeviceRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GenerateBackupCodesRequestValidationError) Reason() string { return e.reason }
+func (e RevokeMFADeviceRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GenerateBackupCodesRequestValidationError) Cause() error { return e.cause }
+func (e RevokeMFADeviceRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GenerateBackupCodesRequestValidationError) Key() bool { return e.key }
+func (e RevokeMFADeviceRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GenerateBackupCodesRequestValidationError) ErrorName() string {
-	return "GenerateBackupCodesRequestValidationError"
+func (e RevokeMFADeviceRequestValidationError) ErrorName() string {
+	return "RevokeMFADeviceRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GenerateBackupCodesRequestValidationError) Error() string {
+func (e RevokeMFADeviceRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2696,14 +3841,14 @@ func (e GenerateBackupCodesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGenerateBackupCodesRequest.%s: %s%s",
+		"invalid %sRevokeMFADeviceRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GenerateBackupCodesRequestValidationError{}
+var _ error = RevokeMFADeviceRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2711,77 +3856,48 @@ func (e GenerateBackupCodesRequestValidationError) Error() string {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GenerateBackupCodesRequestValidationError{}
+} = RevokeMFADeviceRequestValidationError{}
 
-// Validate checks the field values on GenerateBackupCodesResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GenerateBackupCodesResponse) Validate() error {
+// Validate checks the field values on SMSVerification with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SMSVerification) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GenerateBackupCodesResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on SMSVerification with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GenerateBackupCodesResponseMultiError, or nil if none found.
-func (m *GenerateBackupCodesResponse) ValidateAll() error {
+// SMSVerificationMultiError, or nil if none found.
+func (m *SMSVerification) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GenerateBackupCodesResponse) validate(all bool) error {
+func (m *SMSVerification) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GeneratedAt != nil {
-
-		if all {
-			switch v := interface{}(m.GetGeneratedAt()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GenerateBackupCodesResponseValidationError{
-						field:  "GeneratedAt",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, GenerateBackupCodesResponseValidationError{
-						field:  "GeneratedAt",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetGeneratedAt()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return GenerateBackupCodesResponseValidationError{
-					field:  "GeneratedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for VerificationId
 
-	}
+	// no validation rules for Code
 
 	if len(errors) > 0 {
-		return GenerateBackupCodesResponseMultiError(errors)
+		return SMSVerificationMultiError(errors)
 	}
 
 	return nil
 }
 
-// GenerateBackupCodesResponseMultiError is an error wrapping multiple
-// validation errors returned by GenerateBackupCodesResponse.ValidateAll() if
-// the designated constraints aren't met.
-type GenerateBackupCodesResponseMultiError []error
+// SMSVerificationMultiError is an error wrapping multiple validation errors
+// returned by SMSVerification.ValidateAll() if the designated constraints
+// aren't met.
+type SMSVerificationMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GenerateBackupCodesResponseMultiError) Error() string {
+func (m SMSVerificationMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2790,12 +3906,11 @@ func (m GenerateBackupCodesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GenerateBackupCodesResponseMultiError) AllErrors() []error { return m }
+func (m SMSVerificationMultiError) AllErrors() []error { return m }
 
-// GenerateBackupCodesResponseValidationError is the validation error returned
-// by GenerateBackupCodesResponse.Validate if the designated constraints
-// aren't met.
-type GenerateBackupCodesResponseValidationError struct {
+// SMSVerificationValidationError is the validation error returned by
+// SMSVerification.Validate if the designated constraints aren't met.
+type SMSVerificationValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2803,24 +3918,22 @@ type GenerateBackupCodesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GenerateBackupCodesResponseValidationError) Field() string { return e.field }
+func (e SMSVerificationValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GenerateBackupCodesResponseValidationError) Reason() string { return e.reason }
+func (e SMSVerificationValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GenerateBackupCodesResponseValidationError) Cause() error { return e.cause }
+func (e SMSVerificationValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GenerateBackupCodesResponseValidationError) Key() bool { return e.key }
+func (e SMSVerificationValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GenerateBackupCodesResponseValidationError) ErrorName() string {
-	return "GenerateBackupCodesResponseValidationError"
-}
+func (e SMSVerificationValidationError) ErrorName() string { return "SMSVerificationValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GenerateBackupCodesResponseValidationError) Error() string {
+func (e SMSVerificationValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2832,14 +3945,14 @@ func (e GenerateBackupCodesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGenerateBackupCodesResponse.%s: %s%s",
+		"invalid %sSMSVerification.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GenerateBackupCodesResponseValidationError{}
+var _ error = SMSVerificationValidationError{}
 
 var _ interface {
 	Field() string
@@ -2847,44 +3960,48 @@ func (e GenerateBackupCodesResponseValidationError) Error() string {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GenerateBackupCodesResponseValidationError{}
+} = SMSVerificationValidationError{}
 
-// Validate checks the field values on ListBackupCodesRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListBackupCodesRequest) Validate() error {
+// Validate checks the field values on EmailVerification with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *EmailVerification) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListBackupCodesRequest with the rules
+// ValidateAll checks the field values on EmailVerification with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListBackupCodesRequestMultiError, or nil if none found.
-func (m *ListBackupCodesRequest) ValidateAll() error {
+// EmailVerificationMultiError, or nil if none found.
+func (m *EmailVerification) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListBackupCodesRequest) validate(all bool) error {
+func (m *EmailVerification) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for VerificationId
+
+	// no validation rules for Code
+
 	if len(errors) > 0 {
-		return ListBackupCodesRequestMultiError(errors)
+		return EmailVerificationMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListBackupCodesRequestMultiError is an error wrapping multiple validation
-// errors returned by ListBackupCodesRequest.ValidateAll() if the designated
-// constraints aren't met.
-type ListBackupCodesRequestMultiError []error
+// EmailVerificationMultiError is an error wrapping multiple validation errors
+// returned by EmailVerification.ValidateAll() if the designated constraints
+// aren't met.
+type EmailVerificationMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListBackupCodesRequestMultiError) Error() string {
+func (m EmailVerificationMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2892,12 +4009,12 @@ func (m ListBackupCodesRequestMultiError) Error() string {
 	return strings.Join(msgs, "; ")
 }
 
-// AllErrors returns a list of validation violation errors.
-func (m ListBackupCodesRequestMultiError) AllErrors() []error { return m }
-
-// ListBackupCodesRequestValidationError is the validation error returned by
-// ListBackupCodesRequest.Validate if the designated constraints aren't met.
-type ListBackupCodesRequestValidationError struct {
+// AllErrors returns a list of validation violation errors.
+func (m EmailVerificationMultiError) AllErrors() []error { return m }
+
+// EmailVerificationValidationError is the validation error returned by
+// EmailVerification.Validate if the designated constraints aren't met.
+type EmailVerificationValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2905,24 +4022,24 @@ type ListBackupCodesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListBackupCodesRequestValidationError) Field() string { return e.field }
+func (e EmailVerificationValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListBackupCodesRequestValidationError) Reason() string { return e.reason }
+func (e EmailVerificationValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListBackupCodesRequestValidationError) Cause() error { return e.cause }
+func (e EmailVerificationValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListBackupCodesRequestValidationError) Key() bool { return e.key }
+func (e EmailVerificationValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListBackupCodesRequestValidationError) ErrorName() string {
-	return "ListBackupCodesRequestValidationError"
+func (e EmailVerificationValidationError) ErrorName() string {
+	return "EmailVerificationValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListBackupCodesRequestValidationError) Error() string {
+func (e EmailVerificationValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2934,14 +4051,14 @@ func (e ListBackupCodesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListBackupCodesRequest.%s: %s%s",
+		"invalid %sEmailVerification.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListBackupCodesRequestValidationError{}
+var _ error = EmailVerificationValidationError{}
 
 var _ interface {
 	Field() string
@@ -2949,79 +4066,60 @@ func (e ListBackupCodesRequestValidationError) Error() string {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListBackupCodesRequestValidationError{}
+} = EmailVerificationValidationError{}
 
-// Validate checks the field values on ListBackupCodesResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListBackupCodesResponse) Validate() error {
+// Validate checks the field values on WebAuthnAssertion with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *WebAuthnAssertion) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListBackupCodesResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on WebAuthnAssertion with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListBackupCodesResponseMultiError, or nil if none found.
-func (m *ListBackupCodesResponse) ValidateAll() error {
+// WebAuthnAssertionMultiError, or nil if none found.
+func (m *WebAuthnAssertion) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListBackupCodesResponse) validate(all bool) error {
+func (m *WebAuthnAssertion) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Remaining
+	// no validation rules for Id
 
-	if m.GeneratedAt != nil {
+	// no validation rules for ClientDataJson
 
-		if all {
-			switch v := interface{}(m.GetGeneratedAt()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListBackupCodesResponseValidationError{
-						field:  "GeneratedAt",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListBackupCodesResponseValidationError{
-						field:  "GeneratedAt",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetGeneratedAt()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListBackupCodesResponseValidationError{
-					field:  "GeneratedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for AuthenticatorData
+
+	// no validation rules for Signature
+
+	if m.UserHandle != nil {
+		// no validation rules for UserHandle
+	}
 
+	if m.AttestationObject != nil {
+		// no validation rules for AttestationObject
 	}
 
 	if len(errors) > 0 {
-		return ListBackupCodesResponseMultiError(errors)
+		return WebAuthnAssertionMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListBackupCodesResponseMultiError is an error wrapping multiple validation
-// errors returned by ListBackupCodesResponse.ValidateAll() if the designated
-// constraints aren't met.
-type ListBackupCodesResponseMultiError []error
+// WebAuthnAssertionMultiError is an error wrapping multiple validation errors
+// returned by WebAuthnAssertion.ValidateAll() if the designated constraints
+// aren't met.
+type WebAuthnAssertionMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListBackupCodesResponseMultiError) Error() string {
+func (m WebAuthnAssertionMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3030,11 +4128,11 @@ func (m ListBackupCodesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListBackupCodesResponseMultiError) AllErrors() []error { return m }
+func (m WebAuthnAssertionMultiError) AllErrors() []error { return m }
 
-// ListBackupCodesResponseValidationError is the validation error returned by
-// ListBackupCodesResponse.Validate if the designated constraints aren't met.
-type ListBackupCodesResponseValidationError struct {
+// WebAuthnAssertionValidationError is the validation error returned by
+// WebAuthnAssertion.Validate if the designated constraints aren't met.
+type WebAuthnAssertionValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3042,24 +4140,24 @@ type ListBackupCodesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListBackupCodesResponseValidationError) Field() string { return e.field }
+func (e WebAuthnAssertionValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListBackupCodesResponseValidationError) Reason() string { return e.reason }
+func (e WebAuthnAssertionValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListBackupCodesResponseValidationError) Cause() error { return e.cause }
+func (e WebAuthnAssertionValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListBackupCodesResponseValidationError) Key() bool { return e.key }
+func (e WebAuthnAssertionValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListBackupCodesResponseValidationError) ErrorName() string {
-	return "ListBackupCodesResponseValidationError"
+func (e WebAuthnAssertionValidationError) ErrorName() string {
+	return "WebAuthnAssertionValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListBackupCodesResponseValidationError) Error() string {
+func (e WebAuthnAssertionValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3071,14 +4169,14 @@ func (e ListBackupCodesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListBackupCodesResponse.%s: %s%s",
+		"invalid %sWebAuthnAssertion.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListBackupCodesResponseValidationError{}
+var _ error = WebAuthnAssertionValidationError{}
 
 var _ interface {
 	Field() string
@@ -3086,46 +4184,48 @@ func (e ListBackupCodesResponseValidationError) Error() string {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListBackupCodesResponseValidationError{}
+} = WebAuthnAssertionValidationError{}
 
-// Validate checks the field values on RevokeMFADeviceRequest with the rules
+// Validate checks the field values on StartPasskeyLoginRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RevokeMFADeviceRequest) Validate() error {
+func (m *StartPasskeyLoginRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RevokeMFADeviceRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on StartPasskeyLoginRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RevokeMFADeviceRequestMultiError, or nil if none found.
-func (m *RevokeMFADeviceRequest) ValidateAll() error {
+// StartPasskeyLoginRequestMultiError, or nil if none found.
+func (m *StartPasskeyLoginRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RevokeMFADeviceRequest) validate(all bool) error {
+func (m *StartPasskeyLoginRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for CredentialId
+	if m.ClientType != nil {
+		// no validation rules for ClientType
+	}
 
 	if len(errors) > 0 {
-		return RevokeMFADeviceRequestMultiError(errors)
+		return StartPasskeyLoginRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// RevokeMFADeviceRequestMultiError is an error wrapping multiple validation
-// errors returned by RevokeMFADeviceRequest.ValidateAll() if the designated
+// StartPasskeyLoginRequestMultiError is an error wrapping multiple validation
+// errors returned by StartPasskeyLoginRequest.ValidateAll() if the designated
 // constraints aren't met.
-type RevokeMFADeviceRequestMultiError []error
+type StartPasskeyLoginRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RevokeMFADeviceRequestMultiError) Error() string {
+func (m StartPasskeyLoginRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3134,11 +4234,11 @@ func (m RevokeMFADeviceRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RevokeMFADeviceRequestMultiError) AllErrors() []error { return m }
+func (m StartPasskeyLoginRequestMultiError) AllErrors() []error { return m }
 
-// RevokeMFADeviceRequestValidationError is the validation error returned by
-// RevokeMFADeviceRequest.Validate if the designated constraints aren't met.
-type RevokeMFADeviceRequestValidationError struct {
+// StartPasskeyLoginRequestValidationError is the validation error returned by
+// StartPasskeyLoginRequest.Validate if the designated constraints aren't met.
+type StartPasskeyLoginRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3146,24 +4246,24 @@ type RevokeMFADeviceRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RevokeMFADeviceRequestValidationError) Field() string { return e.field }
+func (e StartPasskeyLoginRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RevokeMFADeviceRequestValidationError) Reason() string { return e.reason }
+func (e StartPasskeyLoginRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RevokeMFADeviceRequestValidationError) Cause() error { return e.cause }
+func (e StartPasskeyLoginRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RevokeMFADeviceRequestValidationError) Key() bool { return e.key }
+func (e StartPasskeyLoginRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RevokeMFADeviceRequestValidationError) ErrorName() string {
-	return "RevokeMFADeviceRequestValidationError"
+func (e StartPasskeyLoginRequestValidationError) ErrorName() string {
+	return "StartPasskeyLoginRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RevokeMFADeviceRequestValidationError) Error() string {
+func (e StartPasskeyLoginRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3175,14 +4275,14 @@ func (e RevokeMFADeviceRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRevokeMFADeviceRequest.%s: %s%s",
+		"invalid %sStartPasskeyLoginRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RevokeMFADeviceRequestValidationError{}
+var _ error = StartPasskeyLoginRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3190,48 +4290,104 @@ func (e RevokeMFADeviceRequestValidationError) Error() string {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RevokeMFADeviceRequestValidationError{}
+} = StartPasskeyLoginRequestValidationError{}
 
-// Validate checks the field values on SMSVerification with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *SMSVerification) Validate() error {
+// Validate checks the field values on StartPasskeyLoginResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StartPasskeyLoginResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SMSVerification with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on StartPasskeyLoginResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SMSVerificationMultiError, or nil if none found.
-func (m *SMSVerification) ValidateAll() error {
+// StartPasskeyLoginResponseMultiError, or nil if none found.
+func (m *StartPasskeyLoginResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SMSVerification) validate(all bool) error {
+func (m *StartPasskeyLoginResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for VerificationId
+	// no validation rules for OperationId
 
-	// no validation rules for Code
+	if all {
+		switch v := interface{}(m.GetWebauthn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StartPasskeyLoginResponseValidationError{
+					field:  "Webauthn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StartPasskeyLoginResponseValidationError{
+					field:  "Webauthn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWebauthn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StartPasskeyLoginResponseValidationError{
+				field:  "Webauthn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StartPasskeyLoginResponseValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StartPasskeyLoginResponseValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StartPasskeyLoginResponseValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return SMSVerificationMultiError(errors)
+		return StartPasskeyLoginResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// SMSVerificationMultiError is an error wrapping multiple validation errors
-// returned by SMSVerification.ValidateAll() if the designated constraints
-// aren't met.
-type SMSVerificationMultiError []error
+// StartPasskeyLoginResponseMultiError is an error wrapping multiple validation
+// errors returned by StartPasskeyLoginResponse.ValidateAll() if the
+// designated constraints aren't met.
+type StartPasskeyLoginResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SMSVerificationMultiError) Error() string {
+func (m StartPasskeyLoginResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3240,11 +4396,11 @@ func (m SMSVerificationMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SMSVerificationMultiError) AllErrors() []error { return m }
+func (m StartPasskeyLoginResponseMultiError) AllErrors() []error { return m }
 
-// SMSVerificationValidationError is the validation error returned by
-// SMSVerification.Validate if the designated constraints aren't met.
-type SMSVerificationValidationError struct {
+// StartPasskeyLoginResponseValidationError is the validation error returned by
+// StartPasskeyLoginResponse.Validate if the designated constraints aren't met.
+type StartPasskeyLoginResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3252,22 +4408,24 @@ type SMSVerificationValidationError struct {
 }
 
 // Field function returns field value.
-func (e SMSVerificationValidationError) Field() string { return e.field }
+func (e StartPasskeyLoginResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SMSVerificationValidationError) Reason() string { return e.reason }
+func (e StartPasskeyLoginResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SMSVerificationValidationError) Cause() error { return e.cause }
+func (e StartPasskeyLoginResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SMSVerificationValidationError) Key() bool { return e.key }
+func (e StartPasskeyLoginResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SMSVerificationValidationError) ErrorName() string { return "SMSVerificationValidationError" }
+func (e StartPasskeyLoginResponseValidationError) ErrorName() string {
+	return "StartPasskeyLoginResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e SMSVerificationValidationError) Error() string {
+func (e StartPasskeyLoginResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3279,14 +4437,14 @@ func (e SMSVerificationValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSMSVerification.%s: %s%s",
+		"invalid %sStartPasskeyLoginResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SMSVerificationValidationError{}
+var _ error = StartPasskeyLoginResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3294,56 +4452,79 @@ func (e SMSVerificationValidationError) Error() string {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SMSVerificationValidationError{}
+} = StartPasskeyLoginResponseValidationError{}
 
-// Validate checks the field values on WebAuthnAssertion with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *WebAuthnAssertion) Validate() error {
+// Validate checks the field values on FinishPasskeyLoginRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FinishPasskeyLoginRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on WebAuthnAssertion with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on FinishPasskeyLoginRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// WebAuthnAssertionMultiError, or nil if none found.
-func (m *WebAuthnAssertion) ValidateAll() error {
+// FinishPasskeyLoginRequestMultiError, or nil if none found.
+func (m *FinishPasskeyLoginRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *WebAuthnAssertion) validate(all bool) error {
+func (m *FinishPasskeyLoginRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	// no validation rules for ClientDataJson
-
-	// no validation rules for AuthenticatorData
+	// no validation rules for OperationId
 
-	// no validation rules for Signature
+	if all {
+		switch v := interface{}(m.GetWebauthn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FinishPasskeyLoginRequestValidationError{
+					field:  "Webauthn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FinishPasskeyLoginRequestValidationError{
+					field:  "Webauthn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWebauthn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FinishPasskeyLoginRequestValidationError{
+				field:  "Webauthn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
-	if m.UserHandle != nil {
-		// no validation rules for UserHandle
+	if m.DeviceId != nil {
+		// no validation rules for DeviceId
 	}
 
 	if len(errors) > 0 {
-		return WebAuthnAssertionMultiError(errors)
+		return FinishPasskeyLoginRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// WebAuthnAssertionMultiError is an error wrapping multiple validation errors
-// returned by WebAuthnAssertion.ValidateAll() if the designated constraints
-// aren't met.
-type WebAuthnAssertionMultiError []error
+// FinishPasskeyLoginRequestMultiError is an error wrapping multiple validation
+// errors returned by FinishPasskeyLoginRequest.ValidateAll() if the
+// designated constraints aren't met.
+type FinishPasskeyLoginRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m WebAuthnAssertionMultiError) Error() string {
+func (m FinishPasskeyLoginRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3352,11 +4533,11 @@ func (m WebAuthnAssertionMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m WebAuthnAssertionMultiError) AllErrors() []error { return m }
+func (m FinishPasskeyLoginRequestMultiError) AllErrors() []error { return m }
 
-// WebAuthnAssertionValidationError is the validation error returned by
-// WebAuthnAssertion.Validate if the designated constraints aren't met.
-type WebAuthnAssertionValidationError struct {
+// FinishPasskeyLoginRequestValidationError is the validation error returned by
+// FinishPasskeyLoginRequest.Validate if the designated constraints aren't met.
+type FinishPasskeyLoginRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3364,24 +4545,24 @@ type WebAuthnAssertionValidationError struct {
 }
 
 // Field function returns field value.
-func (e WebAuthnAssertionValidationError) Field() string { return e.field }
+func (e FinishPasskeyLoginRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e WebAuthnAssertionValidationError) Reason() string { return e.reason }
+func (e FinishPasskeyLoginRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e WebAuthnAssertionValidationError) Cause() error { return e.cause }
+func (e FinishPasskeyLoginRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e WebAuthnAssertionValidationError) Key() bool { return e.key }
+func (e FinishPasskeyLoginRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e WebAuthnAssertionValidationError) ErrorName() string {
-	return "WebAuthnAssertionValidationError"
+func (e FinishPasskeyLoginRequestValidationError) ErrorName() string {
+	return "FinishPasskeyLoginRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e WebAuthnAssertionValidationError) Error() string {
+func (e FinishPasskeyLoginRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3393,14 +4574,14 @@ func (e WebAuthnAssertionValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sWebAuthnAssertion.%s: %s%s",
+		"invalid %sFinishPasskeyLoginRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = WebAuthnAssertionValidationError{}
+var _ error = FinishPasskeyLoginRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3408,4 +4589,4 @@ func (e WebAuthnAssertionValidationError) Error() string {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = WebAuthnAssertionValidationError{}
+} = FinishPasskeyLoginRequestValidationError{}
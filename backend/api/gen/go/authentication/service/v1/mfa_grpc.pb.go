@@ -20,16 +20,22 @@
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	MFAService_GetMFAStatus_FullMethodName        = "/authentication.service.v1.MFAService/GetMFAStatus"
-	MFAService_ListEnrolledMethods_FullMethodName = "/authentication.service.v1.MFAService/ListEnrolledMethods"
-	MFAService_StartEnrollMethod_FullMethodName   = "/authentication.service.v1.MFAService/StartEnrollMethod"
-	MFAService_ConfirmEnrollMethod_FullMethodName = "/authentication.service.v1.MFAService/ConfirmEnrollMethod"
-	MFAService_DisableMFA_FullMethodName          = "/authentication.service.v1.MFAService/DisableMFA"
-	MFAService_StartMFAChallenge_FullMethodName   = "/authentication.service.v1.MFAService/StartMFAChallenge"
-	MFAService_VerifyMFAChallenge_FullMethodName  = "/authentication.service.v1.MFAService/VerifyMFAChallenge"
-	MFAService_GenerateBackupCodes_FullMethodName = "/authentication.service.v1.MFAService/GenerateBackupCodes"
-	MFAService_ListBackupCodes_FullMethodName     = "/authentication.service.v1.MFAService/ListBackupCodes"
-	MFAService_RevokeMFADevice_FullMethodName     = "/authentication.service.v1.MFAService/RevokeMFADevice"
+	MFAService_GetMFAStatus_FullMethodName           = "/authentication.service.v1.MFAService/GetMFAStatus"
+	MFAService_ListEnrolledMethods_FullMethodName    = "/authentication.service.v1.MFAService/ListEnrolledMethods"
+	MFAService_StartEnrollMethod_FullMethodName      = "/authentication.service.v1.MFAService/StartEnrollMethod"
+	MFAService_ConfirmEnrollMethod_FullMethodName    = "/authentication.service.v1.MFAService/ConfirmEnrollMethod"
+	MFAService_DisableMFA_FullMethodName             = "/authentication.service.v1.MFAService/DisableMFA"
+	MFAService_StartMFAChallenge_FullMethodName      = "/authentication.service.v1.MFAService/StartMFAChallenge"
+	MFAService_VerifyMFAChallenge_FullMethodName     = "/authentication.service.v1.MFAService/VerifyMFAChallenge"
+	MFAService_GenerateBackupCodes_FullMethodName    = "/authentication.service.v1.MFAService/GenerateBackupCodes"
+	MFAService_ListBackupCodes_FullMethodName        = "/authentication.service.v1.MFAService/ListBackupCodes"
+	MFAService_RevokeMFADevice_FullMethodName        = "/authentication.service.v1.MFAService/RevokeMFADevice"
+	MFAService_StartPasskeyLogin_FullMethodName      = "/authentication.service.v1.MFAService/StartPasskeyLogin"
+	MFAService_FinishPasskeyLogin_FullMethodName     = "/authentication.service.v1.MFAService/FinishPasskeyLogin"
+	MFAService_StartLoginEnrollment_FullMethodName   = "/authentication.service.v1.MFAService/StartLoginEnrollment"
+	MFAService_ConfirmLoginEnrollment_FullMethodName = "/authentication.service.v1.MFAService/ConfirmLoginEnrollment"
+	MFAService_GetMFAPolicy_FullMethodName           = "/authentication.service.v1.MFAService/GetMFAPolicy"
+	MFAService_UpdateMFAPolicy_FullMethodName        = "/authentication.service.v1.MFAService/UpdateMFAPolicy"
 )
 
 // MFAServiceClient is the client API for MFAService service.
@@ -58,6 +64,18 @@ type MFAServiceClient interface {
 	ListBackupCodes(ctx context.Context, in *ListBackupCodesRequest, opts ...grpc.CallOption) (*ListBackupCodesResponse, error)
 	// 撤销指定凭证（按 id）
 	RevokeMFADevice(ctx context.Context, in *RevokeMFADeviceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 发起通行密钥（WebAuthn 可发现凭证）无密码登录，返回断言参数
+	StartPasskeyLogin(ctx context.Context, in *StartPasskeyLoginRequest, opts ...grpc.CallOption) (*StartPasskeyLoginResponse, error)
+	// 完成通行密钥无密码登录，校验断言后签发令牌
+	FinishPasskeyLogin(ctx context.Context, in *FinishPasskeyLoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
+	// 登录强制注册：凭登录返回的 mfa_operation_id 开始注册 MFA 方法（策略要求启用 MFA 而用户尚无因子时）
+	StartLoginEnrollment(ctx context.Context, in *StartEnrollMethodRequest, opts ...grpc.CallOption) (*StartEnrollMethodResponse, error)
+	// 登录强制注册：确认注册，成功后同一 mfa_operation_id 可用于 VerifyMFAChallenge
+	ConfirmLoginEnrollment(ctx context.Context, in *ConfirmEnrollMethodRequest, opts ...grpc.CallOption) (*ConfirmEnrollMethodResponse, error)
+	// 查询 MFA 策略（租户维度）
+	GetMFAPolicy(ctx context.Context, in *GetMFAPolicyRequest, opts ...grpc.CallOption) (*MFAPolicy, error)
+	// 更新 MFA 策略（不存在则创建）
+	UpdateMFAPolicy(ctx context.Context, in *UpdateMFAPolicyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type mFAServiceClient struct {
@@ -168,6 +186,66 @@ func (c *mFAServiceClient) RevokeMFADevice(ctx context.Context, in *RevokeMFADev
 	return out, nil
 }
 
+func (c *mFAServiceClient) StartPasskeyLogin(ctx context.Context, in *StartPasskeyLoginRequest, opts ...grpc.CallOption) (*StartPasskeyLoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StartPasskeyLoginResponse)
+	err := c.cc.Invoke(ctx, MFAService_StartPasskeyLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mFAServiceClient) FinishPasskeyLogin(ctx context.Context, in *FinishPasskeyLoginRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LoginResponse)
+	err := c.cc.Invoke(ctx, MFAService_FinishPasskeyLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mFAServiceClient) StartLoginEnrollment(ctx context.Context, in *StartEnrollMethodRequest, opts ...grpc.CallOption) (*StartEnrollMethodResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StartEnrollMethodResponse)
+	err := c.cc.Invoke(ctx, MFAService_StartLoginEnrollment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mFAServiceClient) ConfirmLoginEnrollment(ctx context.Context, in *ConfirmEnrollMethodRequest, opts ...grpc.CallOption) (*ConfirmEnrollMethodResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfirmEnrollMethodResponse)
+	err := c.cc.Invoke(ctx, MFAService_ConfirmLoginEnrollment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mFAServiceClient) GetMFAPolicy(ctx context.Context, in *GetMFAPolicyRequest, opts ...grpc.CallOption) (*MFAPolicy, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MFAPolicy)
+	err := c.cc.Invoke(ctx, MFAService_GetMFAPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mFAServiceClient) UpdateMFAPolicy(ctx context.Context, in *UpdateMFAPolicyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, MFAService_UpdateMFAPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MFAServiceServer is the server API for MFAService service.
 // All implementations must embed UnimplementedMFAServiceServer
 // for forward compatibility.
@@ -194,6 +272,18 @@ type MFAServiceServer interface {
 	ListBackupCodes(context.Context, *ListBackupCodesRequest) (*ListBackupCodesResponse, error)
 	// 撤销指定凭证（按 id）
 	RevokeMFADevice(context.Context, *RevokeMFADeviceRequest) (*emptypb.Empty, error)
+	// 发起通行密钥（WebAuthn 可发现凭证）无密码登录，返回断言参数
+	StartPasskeyLogin(context.Context, *StartPasskeyLoginRequest) (*StartPasskeyLoginResponse, error)
+	// 完成通行密钥无密码登录，校验断言后签发令牌
+	FinishPasskeyLogin(context.Context, *FinishPasskeyLoginRequest) (*LoginResponse, error)
+	// 登录强制注册：凭登录返回的 mfa_operation_id 开始注册 MFA 方法（策略要求启用 MFA 而用户尚无因子时）
+	StartLoginEnrollment(context.Context, *StartEnrollMethodRequest) (*StartEnrollMethodResponse, error)
+	// 登录强制注册：确认注册，成功后同一 mfa_operation_id 可用于 VerifyMFAChallenge
+	ConfirmLoginEnrollment(context.Context, *ConfirmEnrollMethodRequest) (*ConfirmEnrollMethodResponse, error)
+	// 查询 MFA 策略（租户维度）
+	GetMFAPolicy(context.Context, *GetMFAPolicyRequest) (*MFAPolicy, error)
+	// 更新 MFA 策略（不存在则创建）
+	UpdateMFAPolicy(context.Context, *UpdateMFAPolicyRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedMFAServiceServer()
 }
 
@@ -234,6 +324,24 @@ func (UnimplementedMFAServiceServer) ListBackupCodes(context.Context, *ListBacku
 func (UnimplementedMFAServiceServer) RevokeMFADevice(context.Context, *RevokeMFADeviceRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method RevokeMFADevice not implemented")
 }
+func (UnimplementedMFAServiceServer) StartPasskeyLogin(context.Context, *StartPasskeyLoginRequest) (*StartPasskeyLoginResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method StartPasskeyLogin not implemented")
+}
+func (UnimplementedMFAServiceServer) FinishPasskeyLogin(context.Context, *FinishPasskeyLoginRequest) (*LoginResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method FinishPasskeyLogin not implemented")
+}
+func (UnimplementedMFAServiceServer) StartLoginEnrollment(context.Context, *StartEnrollMethodRequest) (*StartEnrollMethodResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method StartLoginEnrollment not implemented")
+}
+func (UnimplementedMFAServiceServer) ConfirmLoginEnrollment(context.Context, *ConfirmEnrollMethodRequest) (*ConfirmEnrollMethodResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ConfirmLoginEnrollment not implemented")
+}
+func (UnimplementedMFAServiceServer) GetMFAPolicy(context.Context, *GetMFAPolicyRequest) (*MFAPolicy, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetMFAPolicy not implemented")
+}
+func (UnimplementedMFAServiceServer) UpdateMFAPolicy(context.Context, *UpdateMFAPolicyRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateMFAPolicy not implemented")
+}
 func (UnimplementedMFAServiceServer) mustEmbedUnimplementedMFAServiceServer() {}
 func (UnimplementedMFAServiceServer) testEmbeddedByValue()                    {}
 
@@ -435,6 +543,114 @@ func _MFAService_RevokeMFADevice_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MFAService_StartPasskeyLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartPasskeyLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MFAServiceServer).StartPasskeyLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MFAService_StartPasskeyLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MFAServiceServer).StartPasskeyLogin(ctx, req.(*StartPasskeyLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MFAService_FinishPasskeyLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FinishPasskeyLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MFAServiceServer).FinishPasskeyLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MFAService_FinishPasskeyLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MFAServiceServer).FinishPasskeyLogin(ctx, req.(*FinishPasskeyLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MFAService_StartLoginEnrollment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartEnrollMethodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MFAServiceServer).StartLoginEnrollment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MFAService_StartLoginEnrollment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MFAServiceServer).StartLoginEnrollment(ctx, req.(*StartEnrollMethodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MFAService_ConfirmLoginEnrollment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmEnrollMethodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MFAServiceServer).ConfirmLoginEnrollment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MFAService_ConfirmLoginEnrollment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MFAServiceServer).ConfirmLoginEnrollment(ctx, req.(*ConfirmEnrollMethodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MFAService_GetMFAPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMFAPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MFAServiceServer).GetMFAPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MFAService_GetMFAPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MFAServiceServer).GetMFAPolicy(ctx, req.(*GetMFAPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MFAService_UpdateMFAPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMFAPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MFAServiceServer).UpdateMFAPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MFAService_UpdateMFAPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MFAServiceServer).UpdateMFAPolicy(ctx, req.(*UpdateMFAPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MFAService_ServiceDesc is the grpc.ServiceDesc for MFAService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -482,6 +698,30 @@ func _MFAService_RevokeMFADevice_Handler(srv interface{}, ctx context.Context, d
 			MethodName: "RevokeMFADevice",
 			Handler:    _MFAService_RevokeMFADevice_Handler,
 		},
+		{
+			MethodName: "StartPasskeyLogin",
+			Handler:    _MFAService_StartPasskeyLogin_Handler,
+		},
+		{
+			MethodName: "FinishPasskeyLogin",
+			Handler:    _MFAService_FinishPasskeyLogin_Handler,
+		},
+		{
+			MethodName: "StartLoginEnrollment",
+			Handler:    _MFAService_StartLoginEnrollment_Handler,
+		},
+		{
+			MethodName: "ConfirmLoginEnrollment",
+			Handler:    _MFAService_ConfirmLoginEnrollment_Handler,
+		},
+		{
+			MethodName: "GetMFAPolicy",
+			Handler:    _MFAService_GetMFAPolicy_Handler,
+		},
+		{
+			MethodName: "UpdateMFAPolicy",
+			Handler:    _MFAService_UpdateMFAPolicy_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "authentication/service/v1/mfa.proto",
@@ -1313,6 +1313,7 @@ func (x *RevokeLinkedAccountRequest) GetReason() string {
 // 提供商列表与元信息
 type ListProvidersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantCode    *string                `protobuf:"bytes,1,opt,name=tenant_code,json=tenantCode,proto3,oneof" json:"tenant_code,omitempty"` // 租户编号，留空表示平台
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1347,6 +1348,13 @@ func (*ListProvidersRequest) Descriptor() ([]byte, []int) {
 	return file_authentication_service_v1_oauth_proto_rawDescGZIP(), []int{17}
 }
 
+func (x *ListProvidersRequest) GetTenantCode() string {
+	if x != nil && x.TenantCode != nil {
+		return *x.TenantCode
+	}
+	return ""
+}
+
 type ListProvidersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Items         []*ProviderMetadata    `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
@@ -1543,11 +1551,200 @@ func (x *ProviderMetadata) GetTokenParams() map[string]string {
 	return nil
 }
 
+// 第三方登录流
+type StartOAuthLoginRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Provider       OAuthProvider          `protobuf:"varint,1,opt,name=provider,proto3,enum=authentication.service.v1.OAuthProvider" json:"provider,omitempty"`
+	ProviderCustom string                 `protobuf:"bytes,2,opt,name=provider_custom,json=providerCustom,proto3" json:"provider_custom,omitempty"`                                      // 通用 OIDC 提供方标识
+	TenantCode     *string                `protobuf:"bytes,3,opt,name=tenant_code,json=tenantCode,proto3,oneof" json:"tenant_code,omitempty"`                                            // 租户编号，留空表示平台
+	ClientType     *ClientType            `protobuf:"varint,4,opt,name=client_type,json=clientType,proto3,enum=authentication.service.v1.ClientType,oneof" json:"client_type,omitempty"` // 登录完成后签发令牌的客户端类型
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *StartOAuthLoginRequest) Reset() {
+	*x = StartOAuthLoginRequest{}
+	mi := &file_authentication_service_v1_oauth_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartOAuthLoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartOAuthLoginRequest) ProtoMessage() {}
+
+func (x *StartOAuthLoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authentication_service_v1_oauth_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartOAuthLoginRequest.ProtoReflect.Descriptor instead.
+func (*StartOAuthLoginRequest) Descriptor() ([]byte, []int) {
+	return file_authentication_service_v1_oauth_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *StartOAuthLoginRequest) GetProvider() OAuthProvider {
+	if x != nil {
+		return x.Provider
+	}
+	return OAuthProvider_OAUTH_PROVIDER_UNSPECIFIED
+}
+
+func (x *StartOAuthLoginRequest) GetProviderCustom() string {
+	if x != nil {
+		return x.ProviderCustom
+	}
+	return ""
+}
+
+func (x *StartOAuthLoginRequest) GetTenantCode() string {
+	if x != nil && x.TenantCode != nil {
+		return *x.TenantCode
+	}
+	return ""
+}
+
+func (x *StartOAuthLoginRequest) GetClientType() ClientType {
+	if x != nil && x.ClientType != nil {
+		return *x.ClientType
+	}
+	return ClientType_admin
+}
+
+type StartOAuthLoginResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	AuthorizationUrl string                 `protobuf:"bytes,1,opt,name=authorization_url,json=authorizationUrl,proto3" json:"authorization_url,omitempty"` // 提供方授权地址，前端整页跳转
+	State            string                 `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`                                               // 一次性 state，回调时原样带回
+	ExpiresAt        *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`                      // state 过期时间
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *StartOAuthLoginResponse) Reset() {
+	*x = StartOAuthLoginResponse{}
+	mi := &file_authentication_service_v1_oauth_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartOAuthLoginResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartOAuthLoginResponse) ProtoMessage() {}
+
+func (x *StartOAuthLoginResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_authentication_service_v1_oauth_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartOAuthLoginResponse.ProtoReflect.Descriptor instead.
+func (*StartOAuthLoginResponse) Descriptor() ([]byte, []int) {
+	return file_authentication_service_v1_oauth_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *StartOAuthLoginResponse) GetAuthorizationUrl() string {
+	if x != nil {
+		return x.AuthorizationUrl
+	}
+	return ""
+}
+
+func (x *StartOAuthLoginResponse) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *StartOAuthLoginResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type CompleteOAuthLoginRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	State         string                 `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`                             // StartOAuthLogin 返回的 state
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`                               // 提供方回调带回的授权码
+	DeviceId      *string                `protobuf:"bytes,3,opt,name=device_id,json=deviceId,proto3,oneof" json:"device_id,omitempty"` // 设备ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteOAuthLoginRequest) Reset() {
+	*x = CompleteOAuthLoginRequest{}
+	mi := &file_authentication_service_v1_oauth_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteOAuthLoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteOAuthLoginRequest) ProtoMessage() {}
+
+func (x *CompleteOAuthLoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authentication_service_v1_oauth_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteOAuthLoginRequest.ProtoReflect.Descriptor instead.
+func (*CompleteOAuthLoginRequest) Descriptor() ([]byte, []int) {
+	return file_authentication_service_v1_oauth_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CompleteOAuthLoginRequest) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *CompleteOAuthLoginRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CompleteOAuthLoginRequest) GetDeviceId() string {
+	if x != nil && x.DeviceId != nil {
+		return *x.DeviceId
+	}
+	return ""
+}
+
 var File_authentication_service_v1_oauth_proto protoreflect.FileDescriptor
 
 const file_authentication_service_v1_oauth_proto_rawDesc = "" +
 	"\n" +
-	"%authentication/service/v1/oauth.proto\x12\x19authentication.service.v1\x1a$gnostic/openapi/v3/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a/authentication/service/v1/user_credential.proto\"\xd2\x01\n" +
+	"%authentication/service/v1/oauth.proto\x12\x19authentication.service.v1\x1a$gnostic/openapi/v3/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a.authentication/service/v1/authentication.proto\x1a/authentication/service/v1/user_credential.proto\"\xd2\x01\n" +
 	"\n" +
 	"OAuthToken\x12!\n" +
 	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12(\n" +
@@ -1651,8 +1848,11 @@ func (x *ProviderMetadata) GetTokenParams() map[string]string {
 	"\rcredential_id\x18\x01 \x01(\tR\fcredentialId\x12\x1b\n" +
 	"\x06reason\x18\n" +
 	" \x01(\tH\x00R\x06reason\x88\x01\x01B\t\n" +
-	"\a_reason\"\x16\n" +
-	"\x14ListProvidersRequest\"Z\n" +
+	"\a_reason\"L\n" +
+	"\x14ListProvidersRequest\x12$\n" +
+	"\vtenant_code\x18\x01 \x01(\tH\x00R\n" +
+	"tenantCode\x88\x01\x01B\x0e\n" +
+	"\f_tenant_code\"Z\n" +
 	"\x15ListProvidersResponse\x12A\n" +
 	"\x05items\x18\x01 \x03(\v2+.authentication.service.v1.ProviderMetadataR\x05items\"\xa4\x01\n" +
 	"\x1aGetProviderMetadataRequest\x12D\n" +
@@ -1674,7 +1874,27 @@ func (x *ProviderMetadata) GetTokenParams() map[string]string {
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a>\n" +
 	"\x10TokenParamsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01*\xdb\x03\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x9a\x02\n" +
+	"\x16StartOAuthLoginRequest\x12D\n" +
+	"\bprovider\x18\x01 \x01(\x0e2(.authentication.service.v1.OAuthProviderR\bprovider\x12'\n" +
+	"\x0fprovider_custom\x18\x02 \x01(\tR\x0eproviderCustom\x12$\n" +
+	"\vtenant_code\x18\x03 \x01(\tH\x00R\n" +
+	"tenantCode\x88\x01\x01\x12K\n" +
+	"\vclient_type\x18\x04 \x01(\x0e2%.authentication.service.v1.ClientTypeH\x01R\n" +
+	"clientType\x88\x01\x01B\x0e\n" +
+	"\f_tenant_codeB\x0e\n" +
+	"\f_client_type\"\x97\x01\n" +
+	"\x17StartOAuthLoginResponse\x12+\n" +
+	"\x11authorization_url\x18\x01 \x01(\tR\x10authorizationUrl\x12\x14\n" +
+	"\x05state\x18\x02 \x01(\tR\x05state\x129\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"u\n" +
+	"\x19CompleteOAuthLoginRequest\x12\x14\n" +
+	"\x05state\x18\x01 \x01(\tR\x05state\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12 \n" +
+	"\tdevice_id\x18\x03 \x01(\tH\x00R\bdeviceId\x88\x01\x01B\f\n" +
+	"\n" +
+	"_device_id*\xdb\x03\n" +
 	"\rOAuthProvider\x12\x1e\n" +
 	"\x1aOAUTH_PROVIDER_UNSPECIFIED\x10\x00\x12\n" +
 	"\n" +
@@ -1727,8 +1947,7 @@ func (x *ProviderMetadata) GetTokenParams() map[string]string {
 	"\x12AUTHORIZATION_CODE\x10\x02\x12\f\n" +
 	"\bIMPLICIT\x10\x03\x12\f\n" +
 	"\bPASSWORD\x10\x04\x12\x11\n" +
-	"\rREFRESH_TOKEN\x10\x052\xae\n" +
-	"\n" +
+	"\rREFRESH_TOKEN\x10\x052\xa2\f\n" +
 	"\fOAuthService\x12\x83\x01\n" +
 	"\x12ListLinkedAccounts\x124.authentication.service.v1.ListLinkedAccountsRequest\x1a5.authentication.service.v1.ListLinkedAccountsResponse\"\x00\x12}\n" +
 	"\x10GetLinkedAccount\x122.authentication.service.v1.GetLinkedAccountRequest\x1a3.authentication.service.v1.GetLinkedAccountResponse\"\x00\x12w\n" +
@@ -1740,7 +1959,9 @@ func (x *ProviderMetadata) GetTokenParams() map[string]string {
 	"\x11RefreshOAuthToken\x123.authentication.service.v1.RefreshOAuthTokenRequest\x1a4.authentication.service.v1.RefreshOAuthTokenResponse\"\x00\x12\x80\x01\n" +
 	"\x11ExchangeOAuthCode\x123.authentication.service.v1.ExchangeOAuthCodeRequest\x1a4.authentication.service.v1.ExchangeOAuthCodeResponse\"\x00\x12t\n" +
 	"\rListProviders\x12/.authentication.service.v1.ListProvidersRequest\x1a0.authentication.service.v1.ListProvidersResponse\"\x00\x12{\n" +
-	"\x13GetProviderMetadata\x125.authentication.service.v1.GetProviderMetadataRequest\x1a+.authentication.service.v1.ProviderMetadata\"\x00B\xf6\x01\n" +
+	"\x13GetProviderMetadata\x125.authentication.service.v1.GetProviderMetadataRequest\x1a+.authentication.service.v1.ProviderMetadata\"\x00\x12z\n" +
+	"\x0fStartOAuthLogin\x121.authentication.service.v1.StartOAuthLoginRequest\x1a2.authentication.service.v1.StartOAuthLoginResponse\"\x00\x12v\n" +
+	"\x12CompleteOAuthLogin\x124.authentication.service.v1.CompleteOAuthLoginRequest\x1a(.authentication.service.v1.LoginResponse\"\x00B\xf6\x01\n" +
 	"\x1dcom.authentication.service.v1B\n" +
 	"OauthProtoP\x01ZCgo-wind-admin/api/gen/go/authentication/service/v1;authenticationpb\xa2\x02\x03ASX\xaa\x02\x19Authentication.Service.V1\xca\x02\x19Authentication\\Service\\V1\xe2\x02%Authentication\\Service\\V1\\GPBMetadata\xea\x02\x1bAuthentication::Service::V1b\x06proto3"
 
@@ -1757,7 +1978,7 @@ func file_authentication_service_v1_oauth_proto_rawDescGZIP() []byte {
 }
 
 var file_authentication_service_v1_oauth_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_authentication_service_v1_oauth_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
+var file_authentication_service_v1_oauth_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
 var file_authentication_service_v1_oauth_proto_goTypes = []any{
 	(OAuthProvider)(0),                 // 0: authentication.service.v1.OAuthProvider
 	(OAuthGrantType)(0),                // 1: authentication.service.v1.OAuthGrantType
@@ -1782,60 +2003,72 @@ func file_authentication_service_v1_oauth_proto_rawDescGZIP() []byte {
 	(*ListProvidersResponse)(nil),      // 20: authentication.service.v1.ListProvidersResponse
 	(*GetProviderMetadataRequest)(nil), // 21: authentication.service.v1.GetProviderMetadataRequest
 	(*ProviderMetadata)(nil),           // 22: authentication.service.v1.ProviderMetadata
-	nil,                                // 23: authentication.service.v1.ProviderMetadata.AuthorizeParamsEntry
-	nil,                                // 24: authentication.service.v1.ProviderMetadata.TokenParamsEntry
-	(*timestamppb.Timestamp)(nil),      // 25: google.protobuf.Timestamp
-	(*UserCredential)(nil),             // 26: authentication.service.v1.UserCredential
-	(*emptypb.Empty)(nil),              // 27: google.protobuf.Empty
+	(*StartOAuthLoginRequest)(nil),     // 23: authentication.service.v1.StartOAuthLoginRequest
+	(*StartOAuthLoginResponse)(nil),    // 24: authentication.service.v1.StartOAuthLoginResponse
+	(*CompleteOAuthLoginRequest)(nil),  // 25: authentication.service.v1.CompleteOAuthLoginRequest
+	nil,                                // 26: authentication.service.v1.ProviderMetadata.AuthorizeParamsEntry
+	nil,                                // 27: authentication.service.v1.ProviderMetadata.TokenParamsEntry
+	(*timestamppb.Timestamp)(nil),      // 28: google.protobuf.Timestamp
+	(*UserCredential)(nil),             // 29: authentication.service.v1.UserCredential
+	(ClientType)(0),                    // 30: authentication.service.v1.ClientType
+	(*emptypb.Empty)(nil),              // 31: google.protobuf.Empty
+	(*LoginResponse)(nil),              // 32: authentication.service.v1.LoginResponse
 }
 var file_authentication_service_v1_oauth_proto_depIdxs = []int32{
-	25, // 0: authentication.service.v1.OAuthToken.expires_at:type_name -> google.protobuf.Timestamp
-	26, // 1: authentication.service.v1.ListLinkedAccountsResponse.items:type_name -> authentication.service.v1.UserCredential
+	28, // 0: authentication.service.v1.OAuthToken.expires_at:type_name -> google.protobuf.Timestamp
+	29, // 1: authentication.service.v1.ListLinkedAccountsResponse.items:type_name -> authentication.service.v1.UserCredential
 	0,  // 2: authentication.service.v1.LinkOAuthRequest.provider:type_name -> authentication.service.v1.OAuthProvider
-	26, // 3: authentication.service.v1.LinkOAuthResponse.account:type_name -> authentication.service.v1.UserCredential
+	29, // 3: authentication.service.v1.LinkOAuthResponse.account:type_name -> authentication.service.v1.UserCredential
 	0,  // 4: authentication.service.v1.UnlinkOAuthRequest.provider:type_name -> authentication.service.v1.OAuthProvider
-	26, // 5: authentication.service.v1.GetLinkedAccountResponse.account:type_name -> authentication.service.v1.UserCredential
+	29, // 5: authentication.service.v1.GetLinkedAccountResponse.account:type_name -> authentication.service.v1.UserCredential
 	0,  // 6: authentication.service.v1.StartLinkOAuthRequest.provider:type_name -> authentication.service.v1.OAuthProvider
-	25, // 7: authentication.service.v1.StartLinkOAuthResponse.expires_at:type_name -> google.protobuf.Timestamp
+	28, // 7: authentication.service.v1.StartLinkOAuthResponse.expires_at:type_name -> google.protobuf.Timestamp
 	0,  // 8: authentication.service.v1.ConfirmLinkOAuthRequest.provider:type_name -> authentication.service.v1.OAuthProvider
-	26, // 9: authentication.service.v1.ConfirmLinkOAuthResponse.account:type_name -> authentication.service.v1.UserCredential
+	29, // 9: authentication.service.v1.ConfirmLinkOAuthResponse.account:type_name -> authentication.service.v1.UserCredential
 	2,  // 10: authentication.service.v1.ConfirmLinkOAuthResponse.secret:type_name -> authentication.service.v1.OAuthToken
-	25, // 11: authentication.service.v1.RefreshOAuthTokenResponse.expires_at:type_name -> google.protobuf.Timestamp
+	28, // 11: authentication.service.v1.RefreshOAuthTokenResponse.expires_at:type_name -> google.protobuf.Timestamp
 	0,  // 12: authentication.service.v1.ExchangeOAuthCodeRequest.provider:type_name -> authentication.service.v1.OAuthProvider
 	2,  // 13: authentication.service.v1.ExchangeOAuthCodeResponse.token:type_name -> authentication.service.v1.OAuthToken
 	22, // 14: authentication.service.v1.ExchangeOAuthCodeResponse.provider:type_name -> authentication.service.v1.ProviderMetadata
 	22, // 15: authentication.service.v1.ListProvidersResponse.items:type_name -> authentication.service.v1.ProviderMetadata
 	0,  // 16: authentication.service.v1.GetProviderMetadataRequest.provider:type_name -> authentication.service.v1.OAuthProvider
 	0,  // 17: authentication.service.v1.ProviderMetadata.provider:type_name -> authentication.service.v1.OAuthProvider
-	23, // 18: authentication.service.v1.ProviderMetadata.authorize_params:type_name -> authentication.service.v1.ProviderMetadata.AuthorizeParamsEntry
-	24, // 19: authentication.service.v1.ProviderMetadata.token_params:type_name -> authentication.service.v1.ProviderMetadata.TokenParamsEntry
-	3,  // 20: authentication.service.v1.OAuthService.ListLinkedAccounts:input_type -> authentication.service.v1.ListLinkedAccountsRequest
-	8,  // 21: authentication.service.v1.OAuthService.GetLinkedAccount:input_type -> authentication.service.v1.GetLinkedAccountRequest
-	10, // 22: authentication.service.v1.OAuthService.StartLinkOAuth:input_type -> authentication.service.v1.StartLinkOAuthRequest
-	12, // 23: authentication.service.v1.OAuthService.ConfirmLinkOAuth:input_type -> authentication.service.v1.ConfirmLinkOAuthRequest
-	5,  // 24: authentication.service.v1.OAuthService.LinkOAuth:input_type -> authentication.service.v1.LinkOAuthRequest
-	7,  // 25: authentication.service.v1.OAuthService.UnlinkOAuth:input_type -> authentication.service.v1.UnlinkOAuthRequest
-	18, // 26: authentication.service.v1.OAuthService.RevokeLinkedAccount:input_type -> authentication.service.v1.RevokeLinkedAccountRequest
-	14, // 27: authentication.service.v1.OAuthService.RefreshOAuthToken:input_type -> authentication.service.v1.RefreshOAuthTokenRequest
-	16, // 28: authentication.service.v1.OAuthService.ExchangeOAuthCode:input_type -> authentication.service.v1.ExchangeOAuthCodeRequest
-	19, // 29: authentication.service.v1.OAuthService.ListProviders:input_type -> authentication.service.v1.ListProvidersRequest
-	21, // 30: authentication.service.v1.OAuthService.GetProviderMetadata:input_type -> authentication.service.v1.GetProviderMetadataRequest
-	4,  // 31: authentication.service.v1.OAuthService.ListLinkedAccounts:output_type -> authentication.service.v1.ListLinkedAccountsResponse
-	9,  // 32: authentication.service.v1.OAuthService.GetLinkedAccount:output_type -> authentication.service.v1.GetLinkedAccountResponse
-	11, // 33: authentication.service.v1.OAuthService.StartLinkOAuth:output_type -> authentication.service.v1.StartLinkOAuthResponse
-	13, // 34: authentication.service.v1.OAuthService.ConfirmLinkOAuth:output_type -> authentication.service.v1.ConfirmLinkOAuthResponse
-	6,  // 35: authentication.service.v1.OAuthService.LinkOAuth:output_type -> authentication.service.v1.LinkOAuthResponse
-	27, // 36: authentication.service.v1.OAuthService.UnlinkOAuth:output_type -> google.protobuf.Empty
-	27, // 37: authentication.service.v1.OAuthService.RevokeLinkedAccount:output_type -> google.protobuf.Empty
-	15, // 38: authentication.service.v1.OAuthService.RefreshOAuthToken:output_type -> authentication.service.v1.RefreshOAuthTokenResponse
-	17, // 39: authentication.service.v1.OAuthService.ExchangeOAuthCode:output_type -> authentication.service.v1.ExchangeOAuthCodeResponse
-	20, // 40: authentication.service.v1.OAuthService.ListProviders:output_type -> authentication.service.v1.ListProvidersResponse
-	22, // 41: authentication.service.v1.OAuthService.GetProviderMetadata:output_type -> authentication.service.v1.ProviderMetadata
-	31, // [31:42] is the sub-list for method output_type
-	20, // [20:31] is the sub-list for method input_type
-	20, // [20:20] is the sub-list for extension type_name
-	20, // [20:20] is the sub-list for extension extendee
-	0,  // [0:20] is the sub-list for field type_name
+	26, // 18: authentication.service.v1.ProviderMetadata.authorize_params:type_name -> authentication.service.v1.ProviderMetadata.AuthorizeParamsEntry
+	27, // 19: authentication.service.v1.ProviderMetadata.token_params:type_name -> authentication.service.v1.ProviderMetadata.TokenParamsEntry
+	0,  // 20: authentication.service.v1.StartOAuthLoginRequest.provider:type_name -> authentication.service.v1.OAuthProvider
+	30, // 21: authentication.service.v1.StartOAuthLoginRequest.client_type:type_name -> authentication.service.v1.ClientType
+	28, // 22: authentication.service.v1.StartOAuthLoginResponse.expires_at:type_name -> google.protobuf.Timestamp
+	3,  // 23: authentication.service.v1.OAuthService.ListLinkedAccounts:input_type -> authentication.service.v1.ListLinkedAccountsRequest
+	8,  // 24: authentication.service.v1.OAuthService.GetLinkedAccount:input_type -> authentication.service.v1.GetLinkedAccountRequest
+	10, // 25: authentication.service.v1.OAuthService.StartLinkOAuth:input_type -> authentication.service.v1.StartLinkOAuthRequest
+	12, // 26: authentication.service.v1.OAuthService.ConfirmLinkOAuth:input_type -> authentication.service.v1.ConfirmLinkOAuthRequest
+	5,  // 27: authentication.service.v1.OAuthService.LinkOAuth:input_type -> authentication.service.v1.LinkOAuthRequest
+	7,  // 28: authentication.service.v1.OAuthService.UnlinkOAuth:input_type -> authentication.service.v1.UnlinkOAuthRequest
+	18, // 29: authentication.service.v1.OAuthService.RevokeLinkedAccount:input_type -> authentication.service.v1.RevokeLinkedAccountRequest
+	14, // 30: authentication.service.v1.OAuthService.RefreshOAuthToken:input_type -> authentication.service.v1.RefreshOAuthTokenRequest
+	16, // 31: authentication.service.v1.OAuthService.ExchangeOAuthCode:input_type -> authentication.service.v1.ExchangeOAuthCodeRequest
+	19, // 32: authentication.service.v1.OAuthService.ListProviders:input_type -> authentication.service.v1.ListProvidersRequest
+	21, // 33: authentication.service.v1.OAuthService.GetProviderMetadata:input_type -> authentication.service.v1.GetProviderMetadataRequest
+	23, // 34: authentication.service.v1.OAuthService.StartOAuthLogin:input_type -> authentication.service.v1.StartOAuthLoginRequest
+	25, // 35: authentication.service.v1.OAuthService.CompleteOAuthLogin:input_type -> authentication.service.v1.CompleteOAuthLoginRequest
+	4,  // 36: authentication.service.v1.OAuthService.ListLinkedAccounts:output_type -> authentication.service.v1.ListLinkedAccountsResponse
+	9,  // 37: authentication.service.v1.OAuthService.GetLinkedAccount:output_type -> authentication.service.v1.GetLinkedAccountResponse
+	11, // 38: authentication.service.v1.OAuthService.StartLinkOAuth:output_type -> authentication.service.v1.StartLinkOAuthResponse
+	13, // 39: authentication.service.v1.OAuthService.ConfirmLinkOAuth:output_type -> authentication.service.v1.ConfirmLinkOAuthResponse
+	6,  // 40: authentication.service.v1.OAuthService.LinkOAuth:output_type -> authentication.service.v1.LinkOAuthResponse
+	31, // 41: authentication.service.v1.OAuthService.UnlinkOAuth:output_type -> google.protobuf.Empty
+	31, // 42: authentication.service.v1.OAuthService.RevokeLinkedAccount:output_type -> google.protobuf.Empty
+	15, // 43: authentication.service.v1.OAuthService.RefreshOAuthToken:output_type -> authentication.service.v1.RefreshOAuthTokenResponse
+	17, // 44: authentication.service.v1.OAuthService.ExchangeOAuthCode:output_type -> authentication.service.v1.ExchangeOAuthCodeResponse
+	20, // 45: authentication.service.v1.OAuthService.ListProviders:output_type -> authentication.service.v1.ListProvidersResponse
+	22, // 46: authentication.service.v1.OAuthService.GetProviderMetadata:output_type -> authentication.service.v1.ProviderMetadata
+	24, // 47: authentication.service.v1.OAuthService.StartOAuthLogin:output_type -> authentication.service.v1.StartOAuthLoginResponse
+	32, // 48: authentication.service.v1.OAuthService.CompleteOAuthLogin:output_type -> authentication.service.v1.LoginResponse
+	36, // [36:49] is the sub-list for method output_type
+	23, // [23:36] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
 }
 
 func init() { file_authentication_service_v1_oauth_proto_init() }
@@ -1843,6 +2076,7 @@ func file_authentication_service_v1_oauth_proto_init() {
 	if File_authentication_service_v1_oauth_proto != nil {
 		return
 	}
+	file_authentication_service_v1_authentication_proto_init()
 	file_authentication_service_v1_user_credential_proto_init()
 	file_authentication_service_v1_oauth_proto_msgTypes[0].OneofWrappers = []any{}
 	file_authentication_service_v1_oauth_proto_msgTypes[1].OneofWrappers = []any{}
@@ -1858,14 +2092,17 @@ func file_authentication_service_v1_oauth_proto_init() {
 	file_authentication_service_v1_oauth_proto_msgTypes[12].OneofWrappers = []any{}
 	file_authentication_service_v1_oauth_proto_msgTypes[13].OneofWrappers = []any{}
 	file_authentication_service_v1_oauth_proto_msgTypes[16].OneofWrappers = []any{}
+	file_authentication_service_v1_oauth_proto_msgTypes[17].OneofWrappers = []any{}
 	file_authentication_service_v1_oauth_proto_msgTypes[19].OneofWrappers = []any{}
+	file_authentication_service_v1_oauth_proto_msgTypes[21].OneofWrappers = []any{}
+	file_authentication_service_v1_oauth_proto_msgTypes[23].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_authentication_service_v1_oauth_proto_rawDesc), len(file_authentication_service_v1_oauth_proto_rawDesc)),
 			NumEnums:      2,
-			NumMessages:   23,
+			NumMessages:   26,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -2244,6 +2244,10 @@ func (m *ListProvidersRequest) validate(all bool) error {
 
 	var errors []error
 
+	if m.TenantCode != nil {
+		// no validation rules for TenantCode
+	}
+
 	if len(errors) > 0 {
 		return ListProvidersRequestMultiError(errors)
 	}
@@ -2681,3 +2685,362 @@ func (e ProviderMetadataValidationError) Error() string {
 	Cause() error
 	ErrorName() string
 } = ProviderMetadataValidationError{}
+
+// Validate checks the field values on StartOAuthLoginRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StartOAuthLoginRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StartOAuthLoginRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StartOAuthLoginRequestMultiError, or nil if none found.
+func (m *StartOAuthLoginRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StartOAuthLoginRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Provider
+
+	// no validation rules for ProviderCustom
+
+	if m.TenantCode != nil {
+		// no validation rules for TenantCode
+	}
+
+	if m.ClientType != nil {
+		// no validation rules for ClientType
+	}
+
+	if len(errors) > 0 {
+		return StartOAuthLoginRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// StartOAuthLoginRequestMultiError is an error wrapping multiple validation
+// errors returned by StartOAuthLoginRequest.ValidateAll() if the designated
+// constraints aren't met.
+type StartOAuthLoginRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StartOAuthLoginRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StartOAuthLoginRequestMultiError) AllErrors() []error { return m }
+
+// StartOAuthLoginRequestValidationError is the validation error returned by
+// StartOAuthLoginRequest.Validate if the designated constraints aren't met.
+type StartOAuthLoginRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StartOAuthLoginRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StartOAuthLoginRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StartOAuthLoginRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StartOAuthLoginRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StartOAuthLoginRequestValidationError) ErrorName() string {
+	return "StartOAuthLoginRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StartOAuthLoginRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStartOAuthLoginRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StartOAuthLoginRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StartOAuthLoginRequestValidationError{}
+
+// Validate checks the field values on StartOAuthLoginResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StartOAuthLoginResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StartOAuthLoginResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StartOAuthLoginResponseMultiError, or nil if none found.
+func (m *StartOAuthLoginResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StartOAuthLoginResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AuthorizationUrl
+
+	// no validation rules for State
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StartOAuthLoginResponseValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StartOAuthLoginResponseValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StartOAuthLoginResponseValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return StartOAuthLoginResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// StartOAuthLoginResponseMultiError is an error wrapping multiple validation
+// errors returned by StartOAuthLoginResponse.ValidateAll() if the designated
+// constraints aren't met.
+type StartOAuthLoginResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StartOAuthLoginResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StartOAuthLoginResponseMultiError) AllErrors() []error { return m }
+
+// StartOAuthLoginResponseValidationError is the validation error returned by
+// StartOAuthLoginResponse.Validate if the designated constraints aren't met.
+type StartOAuthLoginResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StartOAuthLoginResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StartOAuthLoginResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StartOAuthLoginResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StartOAuthLoginResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StartOAuthLoginResponseValidationError) ErrorName() string {
+	return "StartOAuthLoginResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StartOAuthLoginResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStartOAuthLoginResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StartOAuthLoginResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StartOAuthLoginResponseValidationError{}
+
+// Validate checks the field values on CompleteOAuthLoginRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CompleteOAuthLoginRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CompleteOAuthLoginRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CompleteOAuthLoginRequestMultiError, or nil if none found.
+func (m *CompleteOAuthLoginRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CompleteOAuthLoginRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for State
+
+	// no validation rules for Code
+
+	if m.DeviceId != nil {
+		// no validation rules for DeviceId
+	}
+
+	if len(errors) > 0 {
+		return CompleteOAuthLoginRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CompleteOAuthLoginRequestMultiError is an error wrapping multiple validation
+// errors returned by CompleteOAuthLoginRequest.ValidateAll() if the
+// designated constraints aren't met.
+type CompleteOAuthLoginRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CompleteOAuthLoginRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CompleteOAuthLoginRequestMultiError) AllErrors() []error { return m }
+
+// CompleteOAuthLoginRequestValidationError is the validation error returned by
+// CompleteOAuthLoginRequest.Validate if the designated constraints aren't met.
+type CompleteOAuthLoginRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CompleteOAuthLoginRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CompleteOAuthLoginRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CompleteOAuthLoginRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CompleteOAuthLoginRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CompleteOAuthLoginRequestValidationError) ErrorName() string {
+	return "CompleteOAuthLoginRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CompleteOAuthLoginRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCompleteOAuthLoginRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CompleteOAuthLoginRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CompleteOAuthLoginRequestValidationError{}
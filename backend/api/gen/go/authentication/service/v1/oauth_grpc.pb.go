@@ -31,6 +31,8 @@
 	OAuthService_ExchangeOAuthCode_FullMethodName   = "/authentication.service.v1.OAuthService/ExchangeOAuthCode"
 	OAuthService_ListProviders_FullMethodName       = "/authentication.service.v1.OAuthService/ListProviders"
 	OAuthService_GetProviderMetadata_FullMethodName = "/authentication.service.v1.OAuthService/GetProviderMetadata"
+	OAuthService_StartOAuthLogin_FullMethodName     = "/authentication.service.v1.OAuthService/StartOAuthLogin"
+	OAuthService_CompleteOAuthLogin_FullMethodName  = "/authentication.service.v1.OAuthService/CompleteOAuthLogin"
 )
 
 // OAuthServiceClient is the client API for OAuthService service.
@@ -61,6 +63,10 @@ type OAuthServiceClient interface {
 	ListProviders(ctx context.Context, in *ListProvidersRequest, opts ...grpc.CallOption) (*ListProvidersResponse, error)
 	// 获取单个提供商元信息
 	GetProviderMetadata(ctx context.Context, in *GetProviderMetadataRequest, opts ...grpc.CallOption) (*ProviderMetadata, error)
+	// 发起第三方登录：返回提供方授权地址
+	StartOAuthLogin(ctx context.Context, in *StartOAuthLoginRequest, opts ...grpc.CallOption) (*StartOAuthLoginResponse, error)
+	// 完成第三方登录：以回调带回的 state + code 换取本系统令牌
+	CompleteOAuthLogin(ctx context.Context, in *CompleteOAuthLoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
 }
 
 type oAuthServiceClient struct {
@@ -181,6 +187,26 @@ func (c *oAuthServiceClient) GetProviderMetadata(ctx context.Context, in *GetPro
 	return out, nil
 }
 
+func (c *oAuthServiceClient) StartOAuthLogin(ctx context.Context, in *StartOAuthLoginRequest, opts ...grpc.CallOption) (*StartOAuthLoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StartOAuthLoginResponse)
+	err := c.cc.Invoke(ctx, OAuthService_StartOAuthLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oAuthServiceClient) CompleteOAuthLogin(ctx context.Context, in *CompleteOAuthLoginRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LoginResponse)
+	err := c.cc.Invoke(ctx, OAuthService_CompleteOAuthLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OAuthServiceServer is the server API for OAuthService service.
 // All implementations must embed UnimplementedOAuthServiceServer
 // for forward compatibility.
@@ -209,6 +235,10 @@ type OAuthServiceServer interface {
 	ListProviders(context.Context, *ListProvidersRequest) (*ListProvidersResponse, error)
 	// 获取单个提供商元信息
 	GetProviderMetadata(context.Context, *GetProviderMetadataRequest) (*ProviderMetadata, error)
+	// 发起第三方登录：返回提供方授权地址
+	StartOAuthLogin(context.Context, *StartOAuthLoginRequest) (*StartOAuthLoginResponse, error)
+	// 完成第三方登录：以回调带回的 state + code 换取本系统令牌
+	CompleteOAuthLogin(context.Context, *CompleteOAuthLoginRequest) (*LoginResponse, error)
 	mustEmbedUnimplementedOAuthServiceServer()
 }
 
@@ -252,6 +282,12 @@ func (UnimplementedOAuthServiceServer) ListProviders(context.Context, *ListProvi
 func (UnimplementedOAuthServiceServer) GetProviderMetadata(context.Context, *GetProviderMetadataRequest) (*ProviderMetadata, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetProviderMetadata not implemented")
 }
+func (UnimplementedOAuthServiceServer) StartOAuthLogin(context.Context, *StartOAuthLoginRequest) (*StartOAuthLoginResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method StartOAuthLogin not implemented")
+}
+func (UnimplementedOAuthServiceServer) CompleteOAuthLogin(context.Context, *CompleteOAuthLoginRequest) (*LoginResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CompleteOAuthLogin not implemented")
+}
 func (UnimplementedOAuthServiceServer) mustEmbedUnimplementedOAuthServiceServer() {}
 func (UnimplementedOAuthServiceServer) testEmbeddedByValue()                      {}
 
@@ -471,6 +507,42 @@ func _OAuthService_GetProviderMetadata_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OAuthService_StartOAuthLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartOAuthLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OAuthServiceServer).StartOAuthLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OAuthService_StartOAuthLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OAuthServiceServer).StartOAuthLogin(ctx, req.(*StartOAuthLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OAuthService_CompleteOAuthLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteOAuthLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OAuthServiceServer).CompleteOAuthLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OAuthService_CompleteOAuthLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OAuthServiceServer).CompleteOAuthLogin(ctx, req.(*CompleteOAuthLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // OAuthService_ServiceDesc is the grpc.ServiceDesc for OAuthService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -522,6 +594,14 @@ func _OAuthService_GetProviderMetadata_Handler(srv interface{}, ctx context.Cont
 			MethodName: "GetProviderMetadata",
 			Handler:    _OAuthService_GetProviderMetadata_Handler,
 		},
+		{
+			MethodName: "StartOAuthLogin",
+			Handler:    _OAuthService_StartOAuthLogin_Handler,
+		},
+		{
+			MethodName: "CompleteOAuthLogin",
+			Handler:    _OAuthService_CompleteOAuthLogin_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "authentication/service/v1/oauth.proto",
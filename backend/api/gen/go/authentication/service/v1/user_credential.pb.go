@@ -133,6 +133,7 @@ func (UserCredential_IdentityType) EnumDescriptor() ([]byte, []int) {
 	UserCredential_SSO_TOKEN               UserCredential_CredentialType = 70
 	UserCredential_SAML_ASSERTION          UserCredential_CredentialType = 71
 	UserCredential_OPENID_CONNECT_ID_TOKEN UserCredential_CredentialType = 72
+	UserCredential_LDAP_BIND               UserCredential_CredentialType = 73 // 目录（LDAP/AD）绑定认证，不存密码
 	// 临时 / 会话类
 	UserCredential_SESSION_COOKIE       UserCredential_CredentialType = 80
 	UserCredential_TEMPORARY_CREDENTIAL UserCredential_CredentialType = 81
@@ -166,6 +167,7 @@ func (UserCredential_IdentityType) EnumDescriptor() ([]byte, []int) {
 		70:   "SSO_TOKEN",
 		71:   "SAML_ASSERTION",
 		72:   "OPENID_CONNECT_ID_TOKEN",
+		73:   "LDAP_BIND",
 		80:   "SESSION_COOKIE",
 		81:   "TEMPORARY_CREDENTIAL",
 		200:  "CUSTOM",
@@ -194,6 +196,7 @@ func (UserCredential_IdentityType) EnumDescriptor() ([]byte, []int) {
 		"SSO_TOKEN":                70,
 		"SAML_ASSERTION":           71,
 		"OPENID_CONNECT_ID_TOKEN":  72,
+		"LDAP_BIND":                73,
 		"SESSION_COOKIE":           80,
 		"TEMPORARY_CREDENTIAL":     81,
 		"CUSTOM":                   200,
@@ -1133,7 +1136,7 @@ func (x *CountUserCredentialResponse) GetCount() uint64 {
 
 const file_authentication_service_v1_user_credential_proto_rawDesc = "" +
 	"\n" +
-	"/authentication/service/v1/user_credential.proto\x12\x19authentication.service.v1\x1a$gnostic/openapi/v3/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1epagination/v1/pagination.proto\"\xa7\x18\n" +
+	"/authentication/service/v1/user_credential.proto\x12\x19authentication.service.v1\x1a$gnostic/openapi/v3/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1epagination/v1/pagination.proto\"\xb6\x18\n" +
 	"\x0eUserCredential\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\rR\x02id\x12;\n" +
 	"\auser_id\x18\x02 \x01(\rB\x1d\xbaG\x1a\x92\x02\x17关联主表的用户IDH\x00R\x06userId\x88\x01\x01\x120\n" +
@@ -1176,7 +1179,7 @@ func (x *CountUserCredentialResponse) GetCount() uint64 {
 	"\x10IDENTITY_API_KEY\x10\xac\x02\x12\x0e\n" +
 	"\tDEVICE_ID\x10\x90\x03\x12\x14\n" +
 	"\x0fIDENTITY_CUSTOM\x10\xe8\a\x12!\n" +
-	"\x1cIDENTITY_RESERVED_FOR_FUTURE\x10\x90N\"\xf3\x03\n" +
+	"\x1cIDENTITY_RESERVED_FOR_FUTURE\x10\x90N\"\x82\x04\n" +
 	"\x0eCredentialType\x12\x14\n" +
 	"\x10TYPE_UNSPECIFIED\x10\x00\x12\x11\n" +
 	"\rPASSWORD_HASH\x10\x01\x12\v\n" +
@@ -1201,7 +1204,8 @@ func (x *CountUserCredentialResponse) GetCount() uint64 {
 	"\x0fBIOMETRIC_TOKEN\x10=\x12\r\n" +
 	"\tSSO_TOKEN\x10F\x12\x12\n" +
 	"\x0eSAML_ASSERTION\x10G\x12\x1b\n" +
-	"\x17OPENID_CONNECT_ID_TOKEN\x10H\x12\x12\n" +
+	"\x17OPENID_CONNECT_ID_TOKEN\x10H\x12\r\n" +
+	"\tLDAP_BIND\x10I\x12\x12\n" +
 	"\x0eSESSION_COOKIE\x10P\x12\x18\n" +
 	"\x14TEMPORARY_CREDENTIAL\x10Q\x12\v\n" +
 	"\x06CUSTOM\x10\xc8\x01\x12\x18\n" +
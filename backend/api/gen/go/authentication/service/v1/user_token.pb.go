@@ -31,11 +31,17 @@ type UserTokenPayload struct {
 	ClientId        *string                `protobuf:"bytes,3,opt,name=client_id,json=cid,proto3,oneof" json:"client_id,omitempty"`                                       // 客户端ID
 	DeviceId        *string                `protobuf:"bytes,4,opt,name=device_id,json=did,proto3,oneof" json:"device_id,omitempty"`                                       // 设备ID
 	Username        *string                `protobuf:"bytes,5,opt,name=username,json=sub,proto3,oneof" json:"username,omitempty"`                                         // 用户名
+	SessionId       *string                `protobuf:"bytes,6,opt,name=session_id,json=sid,proto3,oneof" json:"session_id,omitempty"`                                     // 会话ID，同一次登录刷新出的令牌共用
 	Roles           []string               `protobuf:"bytes,10,rep,name=roles,json=roc,proto3" json:"roles,omitempty"`                                                    // 用户角色码列表
 	DataScope       *v1.DataScope          `protobuf:"varint,11,opt,name=data_scope,json=ds,proto3,enum=identity.service.v1.DataScope,oneof" json:"data_scope,omitempty"` // 数据权限范围
 	OrgUnitId       *uint32                `protobuf:"varint,12,opt,name=org_unit_id,json=ouid,proto3,oneof" json:"org_unit_id,omitempty"`                                // 当前组织单元ID
+	Scopes          []string               `protobuf:"bytes,13,rep,name=scopes,json=scp,proto3" json:"scopes,omitempty"`                                                  // 授权范围列表，为空表示不受范围限制（第一方登录令牌）
 	IsPlatformAdmin *bool                  `protobuf:"varint,20,opt,name=is_platform_admin,json=ipa,proto3,oneof" json:"is_platform_admin,omitempty"`                     // 是否平台超级管理员
 	IsTenantAdmin   *bool                  `protobuf:"varint,21,opt,name=is_tenant_admin,json=ita,proto3,oneof" json:"is_tenant_admin,omitempty"`                         // 是否租户管理员
+	ApiClientId     *uint32                `protobuf:"varint,30,opt,name=api_client_id,json=acid,proto3,oneof" json:"api_client_id,omitempty"`                            // 服务客户端ID，仅 client_credentials 授权签发的令牌非零（此时 user_id 为 0）
+	ActorUserId     *uint32                `protobuf:"varint,40,opt,name=actor_user_id,json=auid,proto3,oneof" json:"actor_user_id,omitempty"`                            // 代登录的真实操作人用户ID，仅代登录令牌非零（此时 user_id 为被代登录的用户）
+	ActorUsername   *string                `protobuf:"bytes,41,opt,name=actor_username,json=asub,proto3,oneof" json:"actor_username,omitempty"`                           // 代登录的真实操作人用户名
+	AuthTime        *int64                 `protobuf:"varint,50,opt,name=auth_time,proto3,oneof" json:"auth_time,omitempty"`                                              // 最近一次身份验证（密码或MFA）的时间，Unix 秒；用于敏感操作的二次验证
 	Jti             *string                `protobuf:"bytes,100,opt,name=jti,proto3,oneof" json:"jti,omitempty"`                                                          // 令牌唯一标识(JWT ID)
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
@@ -106,6 +112,13 @@ func (x *UserTokenPayload) GetUsername() string {
 	return ""
 }
 
+func (x *UserTokenPayload) GetSessionId() string {
+	if x != nil && x.SessionId != nil {
+		return *x.SessionId
+	}
+	return ""
+}
+
 func (x *UserTokenPayload) GetRoles() []string {
 	if x != nil {
 		return x.Roles
@@ -127,6 +140,13 @@ func (x *UserTokenPayload) GetOrgUnitId() uint32 {
 	return 0
 }
 
+func (x *UserTokenPayload) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
 func (x *UserTokenPayload) GetIsPlatformAdmin() bool {
 	if x != nil && x.IsPlatformAdmin != nil {
 		return *x.IsPlatformAdmin
@@ -141,6 +161,34 @@ func (x *UserTokenPayload) GetIsTenantAdmin() bool {
 	return false
 }
 
+func (x *UserTokenPayload) GetApiClientId() uint32 {
+	if x != nil && x.ApiClientId != nil {
+		return *x.ApiClientId
+	}
+	return 0
+}
+
+func (x *UserTokenPayload) GetActorUserId() uint32 {
+	if x != nil && x.ActorUserId != nil {
+		return *x.ActorUserId
+	}
+	return 0
+}
+
+func (x *UserTokenPayload) GetActorUsername() string {
+	if x != nil && x.ActorUsername != nil {
+		return *x.ActorUsername
+	}
+	return ""
+}
+
+func (x *UserTokenPayload) GetAuthTime() int64 {
+	if x != nil && x.AuthTime != nil {
+		return *x.AuthTime
+	}
+	return 0
+}
+
 func (x *UserTokenPayload) GetJti() string {
 	if x != nil && x.Jti != nil {
 		return *x.Jti
@@ -152,21 +200,29 @@ func (x *UserTokenPayload) GetJti() string {
 
 const file_authentication_service_v1_user_token_proto_rawDesc = "" +
 	"\n" +
-	"*authentication/service/v1/user_token.proto\x12\x19authentication.service.v1\x1a$gnostic/openapi/v3/annotations.proto\x1a\x1fidentity/service/v1/types.proto\"\xfc\x05\n" +
+	"*authentication/service/v1/user_token.proto\x12\x19authentication.service.v1\x1a$gnostic/openapi/v3/annotations.proto\x1a\x1fidentity/service/v1/types.proto\"\xec\v\n" +
 	"\x10UserTokenPayload\x12$\n" +
 	"\auser_id\x18\x01 \x01(\rB\x0e\xbaG\v\x92\x02\b用户IDR\x03uid\x12+\n" +
 	"\ttenant_id\x18\x02 \x01(\rB\x0e\xbaG\v\x92\x02\b租户IDH\x00R\x03tid\x88\x01\x01\x12.\n" +
 	"\tclient_id\x18\x03 \x01(\tB\x11\xbaG\x0e\x92\x02\v客户端IDH\x01R\x03cid\x88\x01\x01\x12+\n" +
 	"\tdevice_id\x18\x04 \x01(\tB\x0e\xbaG\v\x92\x02\b设备IDH\x02R\x03did\x88\x01\x01\x12+\n" +
-	"\busername\x18\x05 \x01(\tB\x0f\xbaG\f\x92\x02\t用户名H\x03R\x03sub\x88\x01\x01\x12/\n" +
+	"\busername\x18\x05 \x01(\tB\x0f\xbaG\f\x92\x02\t用户名H\x03R\x03sub\x88\x01\x01\x12V\n" +
+	"\n" +
+	"session_id\x18\x06 \x01(\tB8\xbaG5\x92\x022会话ID，同一次登录刷新出的令牌共用H\x04R\x03sid\x88\x01\x01\x12/\n" +
 	"\x05roles\x18\n" +
 	" \x03(\tB\x1b\xbaG\x18\x92\x02\x15用户角色码列表R\x03roc\x12U\n" +
 	"\n" +
-	"data_scope\x18\v \x01(\x0e2\x1e.identity.service.v1.DataScopeB\x18\xbaG\x15\x92\x02\x12数据权限范围H\x04R\x02ds\x88\x01\x01\x12:\n" +
-	"\vorg_unit_id\x18\f \x01(\rB\x1a\xbaG\x17\x92\x02\x14当前组织单元IDH\x05R\x04ouid\x88\x01\x01\x12F\n" +
-	"\x11is_platform_admin\x18\x14 \x01(\bB!\xbaG\x1e\x92\x02\x1b是否平台超级管理员H\x06R\x03ipa\x88\x01\x01\x12>\n" +
-	"\x0fis_tenant_admin\x18\x15 \x01(\bB\x1b\xbaG\x18\x92\x02\x15是否租户管理员H\aR\x03ita\x88\x01\x01\x127\n" +
-	"\x03jti\x18d \x01(\tB \xbaG\x1d\x92\x02\x1a令牌唯一标识(JWT ID)H\bR\x03jti\x88\x01\x01B\f\n" +
+	"data_scope\x18\v \x01(\x0e2\x1e.identity.service.v1.DataScopeB\x18\xbaG\x15\x92\x02\x12数据权限范围H\x05R\x02ds\x88\x01\x01\x12:\n" +
+	"\vorg_unit_id\x18\f \x01(\rB\x1a\xbaG\x17\x92\x02\x14当前组织单元IDH\x06R\x04ouid\x88\x01\x01\x12i\n" +
+	"\x06scopes\x18\r \x03(\tBT\xbaGQ\x92\x02N授权范围列表，为空表示不受范围限制（第一方登录令牌）R\x03scp\x12F\n" +
+	"\x11is_platform_admin\x18\x14 \x01(\bB!\xbaG\x1e\x92\x02\x1b是否平台超级管理员H\aR\x03ipa\x88\x01\x01\x12>\n" +
+	"\x0fis_tenant_admin\x18\x15 \x01(\bB\x1b\xbaG\x18\x92\x02\x15是否租户管理员H\bR\x03ita\x88\x01\x01\x12\x88\x01\n" +
+	"\rapi_client_id\x18\x1e \x01(\rBf\xbaGc\x92\x02`服务客户端ID，仅 client_credentials 授权签发的令牌非零（此时 user_id 为 0）H\tR\x04acid\x88\x01\x01\x12\x93\x01\n" +
+	"\ractor_user_id\x18( \x01(\rBq\xbaGn\x92\x02k代登录的真实操作人用户ID，仅代登录令牌非零（此时 user_id 为被代登录的用户）H\n" +
+	"R\x04auid\x88\x01\x01\x12M\n" +
+	"\x0eactor_username\x18) \x01(\tB*\xbaG'\x92\x02$代登录的真实操作人用户名H\vR\x04asub\x88\x01\x01\x12g\n" +
+	"\tauth_time\x182 \x01(\x03BD\xbaGA\x92\x02>最近一次身份验证（密码或MFA）的时间，Unix 秒H\fR\tauth_time\x88\x01\x01\x127\n" +
+	"\x03jti\x18d \x01(\tB \xbaG\x1d\x92\x02\x1a令牌唯一标识(JWT ID)H\rR\x03jti\x88\x01\x01B\f\n" +
 	"\n" +
 	"_tenant_idB\f\n" +
 	"\n" +
@@ -174,10 +230,16 @@ func (x *UserTokenPayload) GetJti() string {
 	"\n" +
 	"_device_idB\v\n" +
 	"\t_usernameB\r\n" +
+	"\v_session_idB\r\n" +
 	"\v_data_scopeB\x0e\n" +
 	"\f_org_unit_idB\x14\n" +
 	"\x12_is_platform_adminB\x12\n" +
-	"\x10_is_tenant_adminB\x06\n" +
+	"\x10_is_tenant_adminB\x10\n" +
+	"\x0e_api_client_idB\x10\n" +
+	"\x0e_actor_user_idB\x11\n" +
+	"\x0f_actor_usernameB\f\n" +
+	"\n" +
+	"_auth_timeB\x06\n" +
 	"\x04_jtiB\xfa\x01\n" +
 	"\x1dcom.authentication.service.v1B\x0eUserTokenProtoP\x01ZCgo-wind-admin/api/gen/go/authentication/service/v1;authenticationpb\xa2\x02\x03ASX\xaa\x02\x19Authentication.Service.V1\xca\x02\x19Authentication\\Service\\V1\xe2\x02%Authentication\\Service\\V1\\GPBMetadata\xea\x02\x1bAuthentication::Service::V1b\x06proto3"
 
@@ -79,6 +79,10 @@ func (m *UserTokenPayload) validate(all bool) error {
 		// no validation rules for Username
 	}
 
+	if m.SessionId != nil {
+		// no validation rules for SessionId
+	}
+
 	if m.DataScope != nil {
 		// no validation rules for DataScope
 	}
@@ -95,6 +99,22 @@ func (m *UserTokenPayload) validate(all bool) error {
 		// no validation rules for IsTenantAdmin
 	}
 
+	if m.ApiClientId != nil {
+		// no validation rules for ApiClientId
+	}
+
+	if m.ActorUserId != nil {
+		// no validation rules for ActorUserId
+	}
+
+	if m.ActorUsername != nil {
+		// no validation rules for ActorUsername
+	}
+
+	if m.AuthTime != nil {
+		// no validation rules for AuthTime
+	}
+
 	if m.Jti != nil {
 		// no validation rules for Jti
 	}
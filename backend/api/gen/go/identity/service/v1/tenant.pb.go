@@ -202,6 +202,7 @@ type Tenant struct {
 	Industry         *string                `protobuf:"bytes,6,opt,name=industry,proto3,oneof" json:"industry,omitempty"`                                                                        // 所属行业
 	Type             *Tenant_Type           `protobuf:"varint,7,opt,name=type,proto3,enum=identity.service.v1.Tenant_Type,oneof" json:"type,omitempty"`                                          // 租户类型
 	Remark           *string                `protobuf:"bytes,8,opt,name=remark,proto3,oneof" json:"remark,omitempty"`                                                                            // 备注
+	Timezone         *string                `protobuf:"bytes,9,opt,name=timezone,proto3,oneof" json:"timezone,omitempty"`                                                                        // 时区，例如 "Asia/Shanghai"
 	AdminUserId      *uint32                `protobuf:"varint,10,opt,name=admin_user_id,json=adminUserId,proto3,oneof" json:"admin_user_id,omitempty"`                                           // 管理员用户ID
 	AdminUserName    *string                `protobuf:"bytes,11,opt,name=admin_user_name,json=adminUserName,proto3,oneof" json:"admin_user_name,omitempty"`                                      // 管理员用户名
 	SubscriptionAt   *timestamppb.Timestamp `protobuf:"bytes,20,opt,name=subscription_at,json=subscriptionAt,proto3,oneof" json:"subscription_at,omitempty"`                                     // 订阅时间（首次订阅/续费时间，NULL表示未订阅）
@@ -308,6 +309,13 @@ func (x *Tenant) GetRemark() string {
 	return ""
 }
 
+func (x *Tenant) GetTimezone() string {
+	if x != nil && x.Timezone != nil {
+		return *x.Timezone
+	}
+	return ""
+}
+
 func (x *Tenant) GetAdminUserId() uint32 {
 	if x != nil && x.AdminUserId != nil {
 		return *x.AdminUserId
@@ -1344,7 +1352,7 @@ func (x *CleanupTenantDataRequest) GetId() uint32 {
 
 const file_identity_service_v1_tenant_proto_rawDesc = "" +
 	"\n" +
-	" identity/service/v1/tenant.proto\x12\x13identity.service.v1\x1a$gnostic/openapi/v3/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1epagination/v1/pagination.proto\x1a\x1eidentity/service/v1/user.proto\x1a$identity/service/v1/plan_quota.proto\"\x99\x13\n" +
+	" identity/service/v1/tenant.proto\x12\x13identity.service.v1\x1a$gnostic/openapi/v3/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1epagination/v1/pagination.proto\x1a\x1eidentity/service/v1/user.proto\x1a$identity/service/v1/plan_quota.proto\"\xee\x13\n" +
 	"\x06Tenant\x12#\n" +
 	"\x02id\x18\x01 \x01(\rB\x0e\xbaG\v\x92\x02\b租户IDH\x00R\x02id\x88\x01\x01\x12+\n" +
 	"\x04name\x18\x02 \x01(\tB\x12\xbaG\x0f\x92\x02\f租户名称H\x01R\x04name\x88\x01\x01\x12+\n" +
@@ -1353,32 +1361,33 @@ func (x *CleanupTenantDataRequest) GetId() uint32 {
 	"\blogo_url\x18\x05 \x01(\tB\x16\xbaG\x13\x92\x02\x10租户logo地址H\x04R\alogoUrl\x88\x01\x01\x12c\n" +
 	"\bindustry\x18\x06 \x01(\tBB\xbaG?\x92\x02<所属行业（如“互联网”“金融”“制造”）H\x05R\bindustry\x88\x01\x01\x12M\n" +
 	"\x04type\x18\a \x01(\x0e2 .identity.service.v1.Tenant.TypeB\x12\xbaG\x0f\x92\x02\f租户类型H\x06R\x04type\x88\x01\x01\x12)\n" +
-	"\x06remark\x18\b \x01(\tB\f\xbaG\t\x92\x02\x06备注H\aR\x06remark\x88\x01\x01\x12@\n" +
+	"\x06remark\x18\b \x01(\tB\f\xbaG\t\x92\x02\x06备注H\aR\x06remark\x88\x01\x01\x12F\n" +
+	"\btimezone\x18\t \x01(\tB%\xbaG\"\x92\x02\x1f时区，例如：Asia/ShanghaiH\bR\btimezone\x88\x01\x01\x12@\n" +
 	"\radmin_user_id\x18\n" +
-	" \x01(\rB\x17\xbaG\x14\x92\x02\x11管理员用户IDH\bR\vadminUserId\x88\x01\x01\x12E\n" +
-	"\x0fadmin_user_name\x18\v \x01(\tB\x18\xbaG\x15\x92\x02\x12管理员用户名H\tR\radminUserName\x88\x01\x01\x12\x91\x01\n" +
-	"\x0fsubscription_at\x18\x14 \x01(\v2\x1a.google.protobuf.TimestampBG\xbaGD\x92\x02A订阅时间（首次订阅/续费时间，NULL表示未订阅）H\n" +
-	"R\x0esubscriptionAt\x88\x01\x01\x12`\n" +
-	"\x0eunsubscribe_at\x18\x15 \x01(\v2\x1a.google.protobuf.TimestampB\x18\xbaG\x15\x92\x02\x12取消订阅时间H\vR\runsubscribeAt\x88\x01\x01\x12\x95\x01\n" +
+	" \x01(\rB\x17\xbaG\x14\x92\x02\x11管理员用户IDH\tR\vadminUserId\x88\x01\x01\x12E\n" +
+	"\x0fadmin_user_name\x18\v \x01(\tB\x18\xbaG\x15\x92\x02\x12管理员用户名H\n" +
+	"R\radminUserName\x88\x01\x01\x12\x91\x01\n" +
+	"\x0fsubscription_at\x18\x14 \x01(\v2\x1a.google.protobuf.TimestampBG\xbaGD\x92\x02A订阅时间（首次订阅/续费时间，NULL表示未订阅）H\vR\x0esubscriptionAt\x88\x01\x01\x12`\n" +
+	"\x0eunsubscribe_at\x18\x15 \x01(\v2\x1a.google.protobuf.TimestampB\x18\xbaG\x15\x92\x02\x12取消订阅时间H\fR\runsubscribeAt\x88\x01\x01\x12\x95\x01\n" +
 	"\n" +
-	"expired_at\x18\x16 \x01(\v2\x1a.google.protobuf.TimestampBU\xbaGR\x92\x02O租户有效期（NULL表示永久，过期后状态自动改为“过期”）H\fR\texpiredAt\x88\x01\x01\x12v\n" +
-	"\x11subscription_plan\x18\x17 \x01(\tBD\xbaGA\x92\x02>订阅套餐（如“企业版1年”“基础版3个月”）H\rR\x10subscriptionPlan\x88\x01\x01\x12J\n" +
-	"\aplan_id\x18\x18 \x01(\rB,\xbaG)\x92\x02&订阅套餐ID（引用套餐目录）H\x0eR\x06planId\x88\x01\x01\x12:\n" +
-	"\fmember_count\x18\x1e \x01(\x05B\x12\xbaG\x0f\x92\x02\f成员数量H\x0fR\vmemberCount\x88\x01\x01\x12S\n" +
-	"\x06status\x18\x1f \x01(\x0e2\".identity.service.v1.Tenant.StatusB\x12\xbaG\x0f\x92\x02\f租户状态H\x10R\x06status\x88\x01\x01\x12c\n" +
-	"\faudit_status\x18  \x01(\x0e2'.identity.service.v1.Tenant.AuditStatusB\x12\xbaG\x0f\x92\x02\f审核状态H\x11R\vauditStatus\x88\x01\x01\x125\n" +
+	"expired_at\x18\x16 \x01(\v2\x1a.google.protobuf.TimestampBU\xbaGR\x92\x02O租户有效期（NULL表示永久，过期后状态自动改为“过期”）H\rR\texpiredAt\x88\x01\x01\x12v\n" +
+	"\x11subscription_plan\x18\x17 \x01(\tBD\xbaGA\x92\x02>订阅套餐（如“企业版1年”“基础版3个月”）H\x0eR\x10subscriptionPlan\x88\x01\x01\x12J\n" +
+	"\aplan_id\x18\x18 \x01(\rB,\xbaG)\x92\x02&订阅套餐ID（引用套餐目录）H\x0fR\x06planId\x88\x01\x01\x12:\n" +
+	"\fmember_count\x18\x1e \x01(\x05B\x12\xbaG\x0f\x92\x02\f成员数量H\x10R\vmemberCount\x88\x01\x01\x12S\n" +
+	"\x06status\x18\x1f \x01(\x0e2\".identity.service.v1.Tenant.StatusB\x12\xbaG\x0f\x92\x02\f租户状态H\x11R\x06status\x88\x01\x01\x12c\n" +
+	"\faudit_status\x18  \x01(\x0e2'.identity.service.v1.Tenant.AuditStatusB\x12\xbaG\x0f\x92\x02\f审核状态H\x12R\vauditStatus\x88\x01\x01\x125\n" +
 	"\n" +
-	"created_by\x18d \x01(\rB\x11\xbaG\x0e\x92\x02\v创建者IDH\x12R\tcreatedBy\x88\x01\x01\x125\n" +
+	"created_by\x18d \x01(\rB\x11\xbaG\x0e\x92\x02\v创建者IDH\x13R\tcreatedBy\x88\x01\x01\x125\n" +
 	"\n" +
-	"updated_by\x18e \x01(\rB\x11\xbaG\x0e\x92\x02\v更新者IDH\x13R\tupdatedBy\x88\x01\x01\x12;\n" +
+	"updated_by\x18e \x01(\rB\x11\xbaG\x0e\x92\x02\v更新者IDH\x14R\tupdatedBy\x88\x01\x01\x12;\n" +
 	"\n" +
-	"deleted_by\x18f \x01(\rB\x17\xbaG\x14\x92\x02\x11删除者用户IDH\x14R\tdeletedBy\x88\x01\x01\x12S\n" +
+	"deleted_by\x18f \x01(\rB\x17\xbaG\x14\x92\x02\x11删除者用户IDH\x15R\tdeletedBy\x88\x01\x01\x12S\n" +
 	"\n" +
-	"created_at\x18\xc8\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f创建时间H\x15R\tcreatedAt\x88\x01\x01\x12S\n" +
+	"created_at\x18\xc8\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f创建时间H\x16R\tcreatedAt\x88\x01\x01\x12S\n" +
 	"\n" +
-	"updated_at\x18\xc9\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f更新时间H\x16R\tupdatedAt\x88\x01\x01\x12S\n" +
+	"updated_at\x18\xc9\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f更新时间H\x17R\tupdatedAt\x88\x01\x01\x12S\n" +
 	"\n" +
-	"deleted_at\x18\xca\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f删除时间H\x17R\tdeletedAt\x88\x01\x01\"2\n" +
+	"deleted_at\x18\xca\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f删除时间H\x18R\tdeletedAt\x88\x01\x01\"2\n" +
 	"\x06Status\x12\a\n" +
 	"\x03OFF\x10\x00\x12\x06\n" +
 	"\x02ON\x10\x01\x12\v\n" +
@@ -1405,7 +1414,8 @@ func (x *CleanupTenantDataRequest) GetId() uint32 {
 	"\t_logo_urlB\v\n" +
 	"\t_industryB\a\n" +
 	"\x05_typeB\t\n" +
-	"\a_remarkB\x10\n" +
+	"\a_remarkB\v\n" +
+	"\t_timezoneB\x10\n" +
 	"\x0e_admin_user_idB\x12\n" +
 	"\x10_admin_user_nameB\x12\n" +
 	"\x10_subscription_atB\x11\n" +
@@ -88,6 +88,10 @@ func (m *Tenant) validate(all bool) error {
 		// no validation rules for Remark
 	}
 
+	if m.Timezone != nil {
+		// no validation rules for Timezone
+	}
+
 	if m.AdminUserId != nil {
 		// no validation rules for AdminUserId
 	}
@@ -616,11 +616,12 @@ func (*GetUserRequest_Username) isGetUserRequest_QueryBy() {}
 
 // 创建用户 - 请求
 type CreateUserRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          *User                  `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
-	Password      *string                `protobuf:"bytes,2,opt,name=password,proto3,oneof" json:"password,omitempty"` // 用户登录密码
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Data           *User                  `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Password       *string                `protobuf:"bytes,2,opt,name=password,proto3,oneof" json:"password,omitempty"`                                    // 用户登录密码
+	SendActivation *bool                  `protobuf:"varint,3,opt,name=send_activation,json=sendActivation,proto3,oneof" json:"send_activation,omitempty"` // 发送激活链接
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *CreateUserRequest) Reset() {
@@ -667,6 +668,13 @@ func (x *CreateUserRequest) GetPassword() string {
 	return ""
 }
 
+func (x *CreateUserRequest) GetSendActivation() bool {
+	if x != nil && x.SendActivation != nil {
+		return *x.SendActivation
+	}
+	return false
+}
+
 // 更新用户 - 请求
 type UpdateUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1861,11 +1869,13 @@ func (x *CountUserResponse) GetCount() uint64 {
 	"\n" +
 	"\bquery_byB\f\n" +
 	"\n" +
-	"_view_mask\"\x8c\x01\n" +
+	"_view_mask\"\xb2\x02\n" +
 	"\x11CreateUserRequest\x12-\n" +
 	"\x04data\x18\x01 \x01(\v2\x19.identity.service.v1.UserR\x04data\x12;\n" +
-	"\bpassword\x18\x02 \x01(\tB\x1a\xbaG\x17\x18\x01\x92\x02\x12用户登录密码H\x00R\bpassword\x88\x01\x01B\v\n" +
-	"\t_password\"\xf4\x03\n" +
+	"\bpassword\x18\x02 \x01(\tB\x1a\xbaG\x17\x18\x01\x92\x02\x12用户登录密码H\x00R\bpassword\x88\x01\x01\x12\x8f\x01\n" +
+	"\x0fsend_activation\x18\x03 \x01(\bBa\xbaG^\x92\x02[不设置密码，向用户邮箱/手机号发送激活链接，由用户自行设置密码H\x01R\x0esendActivation\x88\x01\x01B\v\n" +
+	"\t_passwordB\x12\n" +
+	"\x10_send_activation\"\xf4\x03\n" +
 	"\x11UpdateUserRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\rR\x02id\x12G\n" +
 	"\x04data\x18\x02 \x01(\v2\x19.identity.service.v1.UserB\x18\xe0A\x02\xbaG\x12\x92\x02\x0f用户的数据R\x04data\x12;\n" +
@@ -294,6 +294,8 @@ func (x *CreateUserRequest) Redact() {
 	// Safe field: Data
 
 	// Safe field: Password
+
+	// Safe field: SendActivation
 }
 
 // Ensure UpdateUserRequest implements the Redactor interface at compile time.
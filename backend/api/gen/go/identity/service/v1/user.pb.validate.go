@@ -751,6 +751,10 @@ func (m *CreateUserRequest) validate(all bool) error {
 		// no validation rules for Password
 	}
 
+	if m.SendActivation != nil {
+		// no validation rules for SendActivation
+	}
+
 	if len(errors) > 0 {
 		return CreateUserRequestMultiError(errors)
 	}
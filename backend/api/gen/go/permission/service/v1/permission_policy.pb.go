@@ -8,8 +8,11 @@
 
 import (
 	_ "github.com/google/gnostic/openapiv3"
+	v1 "github.com/tx7do/go-crud/api/gen/go/pagination/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -283,17 +286,370 @@ func (x *PermissionPolicy) GetDeletedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+// 查询权限策略列表 - 回应
+type ListPermissionPolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*PermissionPolicy    `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Total         uint64                 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPermissionPolicyResponse) Reset() {
+	*x = ListPermissionPolicyResponse{}
+	mi := &file_permission_service_v1_permission_policy_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPermissionPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPermissionPolicyResponse) ProtoMessage() {}
+
+func (x *ListPermissionPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_service_v1_permission_policy_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPermissionPolicyResponse.ProtoReflect.Descriptor instead.
+func (*ListPermissionPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_permission_service_v1_permission_policy_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListPermissionPolicyResponse) GetItems() []*PermissionPolicy {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListPermissionPolicyResponse) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// 查询权限策略详情 - 请求
+type GetPermissionPolicyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to QueryBy:
+	//
+	//	*GetPermissionPolicyRequest_Id
+	QueryBy       isGetPermissionPolicyRequest_QueryBy `protobuf_oneof:"query_by"`
+	ViewMask      *fieldmaskpb.FieldMask               `protobuf:"bytes,100,opt,name=view_mask,json=viewMask,proto3,oneof" json:"view_mask,omitempty"` // 视图字段过滤器，用于控制返回的字段
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPermissionPolicyRequest) Reset() {
+	*x = GetPermissionPolicyRequest{}
+	mi := &file_permission_service_v1_permission_policy_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPermissionPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPermissionPolicyRequest) ProtoMessage() {}
+
+func (x *GetPermissionPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_service_v1_permission_policy_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPermissionPolicyRequest.ProtoReflect.Descriptor instead.
+func (*GetPermissionPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_permission_service_v1_permission_policy_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetPermissionPolicyRequest) GetQueryBy() isGetPermissionPolicyRequest_QueryBy {
+	if x != nil {
+		return x.QueryBy
+	}
+	return nil
+}
+
+func (x *GetPermissionPolicyRequest) GetId() uint32 {
+	if x != nil {
+		if x, ok := x.QueryBy.(*GetPermissionPolicyRequest_Id); ok {
+			return x.Id
+		}
+	}
+	return 0
+}
+
+func (x *GetPermissionPolicyRequest) GetViewMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.ViewMask
+	}
+	return nil
+}
+
+type isGetPermissionPolicyRequest_QueryBy interface {
+	isGetPermissionPolicyRequest_QueryBy()
+}
+
+type GetPermissionPolicyRequest_Id struct {
+	Id uint32 `protobuf:"varint,1,opt,name=id,proto3,oneof"` // ID
+}
+
+func (*GetPermissionPolicyRequest_Id) isGetPermissionPolicyRequest_QueryBy() {}
+
+// 创建权限策略 - 请求
+type CreatePermissionPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *PermissionPolicy      `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePermissionPolicyRequest) Reset() {
+	*x = CreatePermissionPolicyRequest{}
+	mi := &file_permission_service_v1_permission_policy_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePermissionPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePermissionPolicyRequest) ProtoMessage() {}
+
+func (x *CreatePermissionPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_service_v1_permission_policy_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePermissionPolicyRequest.ProtoReflect.Descriptor instead.
+func (*CreatePermissionPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_permission_service_v1_permission_policy_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreatePermissionPolicyRequest) GetData() *PermissionPolicy {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// 更新权限策略 - 请求
+type UpdatePermissionPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Data          *PermissionPolicy      `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`              // 要更新的字段列表
+	AllowMissing  *bool                  `protobuf:"varint,4,opt,name=allow_missing,json=allowMissing,proto3,oneof" json:"allow_missing,omitempty"` // 如果设置为true的时候，资源不存在则会新增(插入)，并且在这种情况下`updateMask`字段将会被忽略。
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePermissionPolicyRequest) Reset() {
+	*x = UpdatePermissionPolicyRequest{}
+	mi := &file_permission_service_v1_permission_policy_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePermissionPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePermissionPolicyRequest) ProtoMessage() {}
+
+func (x *UpdatePermissionPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_service_v1_permission_policy_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePermissionPolicyRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePermissionPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_permission_service_v1_permission_policy_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UpdatePermissionPolicyRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdatePermissionPolicyRequest) GetData() *PermissionPolicy {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *UpdatePermissionPolicyRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdatePermissionPolicyRequest) GetAllowMissing() bool {
+	if x != nil && x.AllowMissing != nil {
+		return *x.AllowMissing
+	}
+	return false
+}
+
+// 删除权限策略 - 请求
+type DeletePermissionPolicyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to QueryBy:
+	//
+	//	*DeletePermissionPolicyRequest_Id
+	QueryBy       isDeletePermissionPolicyRequest_QueryBy `protobuf_oneof:"query_by"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePermissionPolicyRequest) Reset() {
+	*x = DeletePermissionPolicyRequest{}
+	mi := &file_permission_service_v1_permission_policy_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePermissionPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePermissionPolicyRequest) ProtoMessage() {}
+
+func (x *DeletePermissionPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_service_v1_permission_policy_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePermissionPolicyRequest.ProtoReflect.Descriptor instead.
+func (*DeletePermissionPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_permission_service_v1_permission_policy_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeletePermissionPolicyRequest) GetQueryBy() isDeletePermissionPolicyRequest_QueryBy {
+	if x != nil {
+		return x.QueryBy
+	}
+	return nil
+}
+
+func (x *DeletePermissionPolicyRequest) GetId() uint32 {
+	if x != nil {
+		if x, ok := x.QueryBy.(*DeletePermissionPolicyRequest_Id); ok {
+			return x.Id
+		}
+	}
+	return 0
+}
+
+type isDeletePermissionPolicyRequest_QueryBy interface {
+	isDeletePermissionPolicyRequest_QueryBy()
+}
+
+type DeletePermissionPolicyRequest_Id struct {
+	Id uint32 `protobuf:"varint,1,opt,name=id,proto3,oneof"` // ID
+}
+
+func (*DeletePermissionPolicyRequest_Id) isDeletePermissionPolicyRequest_QueryBy() {}
+
+type CountPermissionPolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Count         uint64                 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CountPermissionPolicyResponse) Reset() {
+	*x = CountPermissionPolicyResponse{}
+	mi := &file_permission_service_v1_permission_policy_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CountPermissionPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountPermissionPolicyResponse) ProtoMessage() {}
+
+func (x *CountPermissionPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_service_v1_permission_policy_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountPermissionPolicyResponse.ProtoReflect.Descriptor instead.
+func (*CountPermissionPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_permission_service_v1_permission_policy_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CountPermissionPolicyResponse) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
 var File_permission_service_v1_permission_policy_proto protoreflect.FileDescriptor
 
 const file_permission_service_v1_permission_policy_proto_rawDesc = "" +
 	"\n" +
-	"-permission/service/v1/permission_policy.proto\x12\x15permission.service.v1\x1a$gnostic/openapi/v3/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xac\v\n" +
+	"-permission/service/v1/permission_policy.proto\x12\x15permission.service.v1\x1a$gnostic/openapi/v3/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a google/protobuf/field_mask.proto\x1a\x1epagination/v1/pagination.proto\"\xa8\f\n" +
 	"\x10PermissionPolicy\x12)\n" +
 	"\x02id\x18\x01 \x01(\rB\x14\xbaG\x11\x92\x02\x0e权限策略IDH\x00R\x02id\x88\x01\x01\x12D\n" +
 	"\rpermission_id\x18\x02 \x01(\rB\x1a\xbaG\x17\x92\x02\x14包含的权限点IDH\x01R\fpermissionId\x88\x01\x01\x12r\n" +
-	"\rpolicy_engine\x18\x03 \x01(\x0e24.permission.service.v1.PermissionPolicy.PolicyEngineB\x12\xbaG\x0f\x92\x02\f策略引擎H\x02R\fpolicyEngine\x88\x01\x01\x12I\n" +
+	"\rpolicy_engine\x18\x03 \x01(\x0e24.permission.service.v1.PermissionPolicy.PolicyEngineB\x12\xbaG\x0f\x92\x02\f策略引擎H\x02R\fpolicyEngine\x88\x01\x01\x12\xc4\x01\n" +
 	"\n" +
-	"definition\x18\x04 \x01(\tB$\xbaG!\x92\x02\x1e策略定义（动态结构）H\x03R\n" +
+	"definition\x18\x04 \x01(\tB\x9e\x01\xbaG\x9a\x01\x92\x02\x96\x01策略定义（动态结构）。CEL 引擎为 JSON：{\"expression\": \"CEL 表达式\", \"message\": \"拒绝原因\", \"resource\": 是否为资源级策略}H\x03R\n" +
 	"definition\x88\x01\x01\x12J\n" +
 	"\aversion\x18\x05 \x01(\rB+\xbaG(\x92\x02%策略版本（用于灰度/回滚）H\x04R\aversion\x88\x01\x01\x12Q\n" +
 	"\n" +
@@ -341,7 +697,41 @@ func (x *PermissionPolicy) GetDeletedAt() *timestamppb.Timestamp {
 	"\v_deleted_byB\r\n" +
 	"\v_created_atB\r\n" +
 	"\v_updated_atB\r\n" +
-	"\v_deleted_atB\xe5\x01\n" +
+	"\v_deleted_at\"s\n" +
+	"\x1cListPermissionPolicyResponse\x12=\n" +
+	"\x05items\x18\x01 \x03(\v2'.permission.service.v1.PermissionPolicyR\x05items\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x04R\x05total\"\xcd\x01\n" +
+	"\x1aGetPermissionPolicyRequest\x12\x1c\n" +
+	"\x02id\x18\x01 \x01(\rB\n" +
+	"\xbaG\a\x18\x01\x92\x02\x02IDH\x00R\x02id\x12w\n" +
+	"\tview_mask\x18d \x01(\v2\x1a.google.protobuf.FieldMaskB9\xbaG6\x92\x023视图字段过滤器，用于控制返回的字段H\x01R\bviewMask\x88\x01\x01B\n" +
+	"\n" +
+	"\bquery_byB\f\n" +
+	"\n" +
+	"_view_mask\"\\\n" +
+	"\x1dCreatePermissionPolicyRequest\x12;\n" +
+	"\x04data\x18\x01 \x01(\v2'.permission.service.v1.PermissionPolicyR\x04data\"\xaa\x03\n" +
+	"\x1dUpdatePermissionPolicyRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12;\n" +
+	"\x04data\x18\x02 \x01(\v2'.permission.service.v1.PermissionPolicyR\x04data\x12s\n" +
+	"\vupdate_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskB6\xbaG3:\x16\x12\x14id,realname,username\x92\x02\x18要更新的字段列表R\n" +
+	"updateMask\x12\xb4\x01\n" +
+	"\rallow_missing\x18\x04 \x01(\bB\x89\x01\xbaG\x85\x01\x92\x02\x81\x01如果设置为true的时候，资源不存在则会新增(插入)，并且在这种情况下`updateMask`字段将会被忽略。H\x00R\fallowMissing\x88\x01\x01B\x10\n" +
+	"\x0e_allow_missing\"I\n" +
+	"\x1dDeletePermissionPolicyRequest\x12\x1c\n" +
+	"\x02id\x18\x01 \x01(\rB\n" +
+	"\xbaG\a\x18\x01\x92\x02\x02IDH\x00R\x02idB\n" +
+	"\n" +
+	"\bquery_by\"5\n" +
+	"\x1dCountPermissionPolicyResponse\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x04R\x05count2\xc2\x04\n" +
+	"\x17PermissionPolicyService\x12X\n" +
+	"\x04List\x12\x19.pagination.PagingRequest\x1a3.permission.service.v1.ListPermissionPolicyResponse\"\x00\x12Z\n" +
+	"\x05Count\x12\x19.pagination.PagingRequest\x1a4.permission.service.v1.CountPermissionPolicyResponse\"\x00\x12c\n" +
+	"\x03Get\x121.permission.service.v1.GetPermissionPolicyRequest\x1a'.permission.service.v1.PermissionPolicy\"\x00\x12X\n" +
+	"\x06Create\x124.permission.service.v1.CreatePermissionPolicyRequest\x1a\x16.google.protobuf.Empty\"\x00\x12X\n" +
+	"\x06Update\x124.permission.service.v1.UpdatePermissionPolicyRequest\x1a\x16.google.protobuf.Empty\"\x00\x12X\n" +
+	"\x06Delete\x124.permission.service.v1.DeletePermissionPolicyRequest\x1a\x16.google.protobuf.Empty\"\x00B\xe5\x01\n" +
 	"\x19com.permission.service.v1B\x15PermissionPolicyProtoP\x01Z;go-wind-admin/api/gen/go/permission/service/v1;permissionpb\xa2\x02\x03PSX\xaa\x02\x15Permission.Service.V1\xca\x02\x15Permission\\Service\\V1\xe2\x02!Permission\\Service\\V1\\GPBMetadata\xea\x02\x17Permission::Service::V1b\x06proto3"
 
 var (
@@ -357,24 +747,50 @@ func file_permission_service_v1_permission_policy_proto_rawDescGZIP() []byte {
 }
 
 var file_permission_service_v1_permission_policy_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_permission_service_v1_permission_policy_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_permission_service_v1_permission_policy_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_permission_service_v1_permission_policy_proto_goTypes = []any{
-	(PermissionPolicy_PolicyEngine)(0), // 0: permission.service.v1.PermissionPolicy.PolicyEngine
-	(PermissionPolicy_Status)(0),       // 1: permission.service.v1.PermissionPolicy.Status
-	(*PermissionPolicy)(nil),           // 2: permission.service.v1.PermissionPolicy
-	(*timestamppb.Timestamp)(nil),      // 3: google.protobuf.Timestamp
+	(PermissionPolicy_PolicyEngine)(0),    // 0: permission.service.v1.PermissionPolicy.PolicyEngine
+	(PermissionPolicy_Status)(0),          // 1: permission.service.v1.PermissionPolicy.Status
+	(*PermissionPolicy)(nil),              // 2: permission.service.v1.PermissionPolicy
+	(*ListPermissionPolicyResponse)(nil),  // 3: permission.service.v1.ListPermissionPolicyResponse
+	(*GetPermissionPolicyRequest)(nil),    // 4: permission.service.v1.GetPermissionPolicyRequest
+	(*CreatePermissionPolicyRequest)(nil), // 5: permission.service.v1.CreatePermissionPolicyRequest
+	(*UpdatePermissionPolicyRequest)(nil), // 6: permission.service.v1.UpdatePermissionPolicyRequest
+	(*DeletePermissionPolicyRequest)(nil), // 7: permission.service.v1.DeletePermissionPolicyRequest
+	(*CountPermissionPolicyResponse)(nil), // 8: permission.service.v1.CountPermissionPolicyResponse
+	(*timestamppb.Timestamp)(nil),         // 9: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),         // 10: google.protobuf.FieldMask
+	(*v1.PagingRequest)(nil),              // 11: pagination.PagingRequest
+	(*emptypb.Empty)(nil),                 // 12: google.protobuf.Empty
 }
 var file_permission_service_v1_permission_policy_proto_depIdxs = []int32{
-	0, // 0: permission.service.v1.PermissionPolicy.policy_engine:type_name -> permission.service.v1.PermissionPolicy.PolicyEngine
-	1, // 1: permission.service.v1.PermissionPolicy.status:type_name -> permission.service.v1.PermissionPolicy.Status
-	3, // 2: permission.service.v1.PermissionPolicy.created_at:type_name -> google.protobuf.Timestamp
-	3, // 3: permission.service.v1.PermissionPolicy.updated_at:type_name -> google.protobuf.Timestamp
-	3, // 4: permission.service.v1.PermissionPolicy.deleted_at:type_name -> google.protobuf.Timestamp
-	5, // [5:5] is the sub-list for method output_type
-	5, // [5:5] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	0,  // 0: permission.service.v1.PermissionPolicy.policy_engine:type_name -> permission.service.v1.PermissionPolicy.PolicyEngine
+	1,  // 1: permission.service.v1.PermissionPolicy.status:type_name -> permission.service.v1.PermissionPolicy.Status
+	9,  // 2: permission.service.v1.PermissionPolicy.created_at:type_name -> google.protobuf.Timestamp
+	9,  // 3: permission.service.v1.PermissionPolicy.updated_at:type_name -> google.protobuf.Timestamp
+	9,  // 4: permission.service.v1.PermissionPolicy.deleted_at:type_name -> google.protobuf.Timestamp
+	2,  // 5: permission.service.v1.ListPermissionPolicyResponse.items:type_name -> permission.service.v1.PermissionPolicy
+	10, // 6: permission.service.v1.GetPermissionPolicyRequest.view_mask:type_name -> google.protobuf.FieldMask
+	2,  // 7: permission.service.v1.CreatePermissionPolicyRequest.data:type_name -> permission.service.v1.PermissionPolicy
+	2,  // 8: permission.service.v1.UpdatePermissionPolicyRequest.data:type_name -> permission.service.v1.PermissionPolicy
+	10, // 9: permission.service.v1.UpdatePermissionPolicyRequest.update_mask:type_name -> google.protobuf.FieldMask
+	11, // 10: permission.service.v1.PermissionPolicyService.List:input_type -> pagination.PagingRequest
+	11, // 11: permission.service.v1.PermissionPolicyService.Count:input_type -> pagination.PagingRequest
+	4,  // 12: permission.service.v1.PermissionPolicyService.Get:input_type -> permission.service.v1.GetPermissionPolicyRequest
+	5,  // 13: permission.service.v1.PermissionPolicyService.Create:input_type -> permission.service.v1.CreatePermissionPolicyRequest
+	6,  // 14: permission.service.v1.PermissionPolicyService.Update:input_type -> permission.service.v1.UpdatePermissionPolicyRequest
+	7,  // 15: permission.service.v1.PermissionPolicyService.Delete:input_type -> permission.service.v1.DeletePermissionPolicyRequest
+	3,  // 16: permission.service.v1.PermissionPolicyService.List:output_type -> permission.service.v1.ListPermissionPolicyResponse
+	8,  // 17: permission.service.v1.PermissionPolicyService.Count:output_type -> permission.service.v1.CountPermissionPolicyResponse
+	2,  // 18: permission.service.v1.PermissionPolicyService.Get:output_type -> permission.service.v1.PermissionPolicy
+	12, // 19: permission.service.v1.PermissionPolicyService.Create:output_type -> google.protobuf.Empty
+	12, // 20: permission.service.v1.PermissionPolicyService.Update:output_type -> google.protobuf.Empty
+	12, // 21: permission.service.v1.PermissionPolicyService.Delete:output_type -> google.protobuf.Empty
+	16, // [16:22] is the sub-list for method output_type
+	10, // [10:16] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_permission_service_v1_permission_policy_proto_init() }
@@ -383,15 +799,22 @@ func file_permission_service_v1_permission_policy_proto_init() {
 		return
 	}
 	file_permission_service_v1_permission_policy_proto_msgTypes[0].OneofWrappers = []any{}
+	file_permission_service_v1_permission_policy_proto_msgTypes[2].OneofWrappers = []any{
+		(*GetPermissionPolicyRequest_Id)(nil),
+	}
+	file_permission_service_v1_permission_policy_proto_msgTypes[4].OneofWrappers = []any{}
+	file_permission_service_v1_permission_policy_proto_msgTypes[5].OneofWrappers = []any{
+		(*DeletePermissionPolicyRequest_Id)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_permission_service_v1_permission_policy_proto_rawDesc), len(file_permission_service_v1_permission_policy_proto_rawDesc)),
 			NumEnums:      2,
-			NumMessages:   1,
+			NumMessages:   7,
 			NumExtensions: 0,
-			NumServices:   0,
+			NumServices:   1,
 		},
 		GoTypes:           file_permission_service_v1_permission_policy_proto_goTypes,
 		DependencyIndexes: file_permission_service_v1_permission_policy_proto_depIdxs,
@@ -7,3 +7,6 @@
 
 //go:embed rbac.rego
 var OpaRbacRego []byte
+
+//go:embed zanzibar_namespaces.json
+var ZanzibarNamespaces []byte
@@ -33,14 +33,15 @@ func initApp(context *bootstrap.Context) (*kratos.App, func(), error) {
 		return nil, nil, err
 	}
 	userTokenCache := data.NewUserTokenCache(context, client)
-	authenticator := data.NewAuthenticator(context, userTokenCache)
-	clientType := data.NewClientType()
-	accessTokenChecker := data.NewTokenChecker(context, authenticator, clientType)
 	entClient, cleanup2, err := data.NewEntClient(context)
 	if err != nil {
 		cleanup()
 		return nil, nil, err
 	}
+	jwtSigningKeyRepo := data.NewJwtSigningKeyRepo(context, entClient)
+	authenticator := data.NewAuthenticator(context, userTokenCache, jwtSigningKeyRepo)
+	clientType := data.NewClientType()
+	accessTokenChecker := data.NewTokenChecker(context, authenticator, clientType)
 	tenantAccessChecker := data.NewTenantAccessCheckerImpl(context, entClient)
 	rolePermissionRepo := data.NewRolePermissionRepo(context, entClient)
 	permissionApiRepo := data.NewPermissionApiRepo(context, entClient)
@@ -49,11 +50,16 @@ func initApp(context *bootstrap.Context) (*kratos.App, func(), error) {
 	roleMetadataRepo := data.NewRoleMetadataRepo(context, entClient)
 	roleRepo := data.NewRoleRepo(context, entClient, rolePermissionRepo, permissionRepo, roleMetadataRepo)
 	apiRepo := data.NewApiRepo(context, entClient)
-	provider := data.NewAuthorizerProvider(context, roleRepo, apiRepo)
+	relationTupleRepo := data.NewRelationTupleRepo(context, entClient)
+	provider := data.NewAuthorizerProvider(context, roleRepo, apiRepo, relationTupleRepo)
 	authorizerAuthorizer := authorizer.NewAuthorizer(context, provider)
 	apiAuditLogRepo := data.NewApiAuditLogRepo(context, entClient)
 	loginAuditLogRepo := data.NewLoginAuditLogRepo(context, entClient)
-	v := server.NewRestMiddleware(context, accessTokenChecker, tenantAccessChecker, authorizerAuthorizer, apiAuditLogRepo, loginAuditLogRepo)
+	permissionPolicyRepo := data.NewPermissionPolicyRepo(context, entClient)
+	policyEvaluationLogRepo := data.NewPolicyEvaluationLogRepo(context, entClient)
+	permissionPolicyEvaluator := service.NewPermissionPolicyEvaluator(context, permissionPolicyRepo, policyEvaluationLogRepo)
+	dataScopeResolver := data.NewDataScopeResolver(context, entClient)
+	permissionCodeResolver := data.NewPermissionCodeResolver(context, entClient)
 	userRoleRepo := data.NewUserRoleRepo(context, entClient)
 	userOrgUnitRepo := data.NewUserOrgUnitRepo(context, entClient)
 	userPositionRepo := data.NewUserPositionRepo(context, entClient)
@@ -63,17 +69,45 @@ func initApp(context *bootstrap.Context) (*kratos.App, func(), error) {
 	membershipRepo := data.NewMembershipRepo(context, entClient, membershipRoleRepo, membershipPositionRepo, membershipOrgUnitRepo)
 	userRepo := data.NewUserRepo(context, entClient, userRoleRepo, userOrgUnitRepo, userPositionRepo, membershipRepo)
 	crypto := data.NewPasswordCrypto()
-	userCredentialRepo := data.NewUserCredentialRepo(context, entClient, crypto)
+	passwordPolicyRepo := data.NewPasswordPolicyRepo(context, entClient)
+	userCredentialRepo := data.NewUserCredentialRepo(context, entClient, crypto, passwordPolicyRepo)
 	tenantRepo := data.NewTenantRepo(context, entClient)
 	orgUnitRepo := data.NewOrgUnitRepo(context, entClient)
 	captcha := data.NewCaptcha(client)
 	loginRateLimiter := data.NewLoginRateLimiter(context, client)
 	loginPolicyRepo := data.NewLoginPolicyRepo(context, entClient)
 	userMfaFactorRepo := data.NewUserMfaFactorRepo(context, entClient)
+	mfaPolicyRepo := data.NewMfaPolicyRepo(context, entClient)
 	mfaChallengeCache := data.NewMfaChallengeCache(context, client)
-	authenticationService := service.NewAuthenticationService(context, userRepo, userCredentialRepo, roleRepo, tenantRepo, membershipRepo, orgUnitRepo, permissionRepo, authenticator, clientType, captcha, loginRateLimiter, loginPolicyRepo, userMfaFactorRepo, mfaChallengeCache)
-	mfaService := service.NewMfaService(context, userMfaFactorRepo, mfaChallengeCache, authenticator, loginRateLimiter)
-	loginPolicyService := service.NewLoginPolicyService(context, loginPolicyRepo)
+	apiClientRepo := data.NewApiClientRepo(context, entClient, crypto)
+	oAuthCodeCache := data.NewOAuthCodeCache(context, client)
+	samlConfigRepo := data.NewSamlConfigRepo(context, entClient)
+	ldapConfigRepo := data.NewLdapConfigRepo(context, entClient)
+	ldapAccountRepo := data.NewLdapAccountRepo(context, entClient, userRepo, userCredentialRepo, userRoleRepo, userOrgUnitRepo, ldapConfigRepo, authenticator)
+	router := data.NewSender(context)
+	operationAuditLogRepo := data.NewOperationAuditLogRepo(context, entClient)
+	authenticationService := service.NewAuthenticationService(context, userRepo, userCredentialRepo, roleRepo, tenantRepo, membershipRepo, orgUnitRepo, permissionRepo, authenticator, clientType, captcha, loginRateLimiter, loginPolicyRepo, userMfaFactorRepo, mfaPolicyRepo, mfaChallengeCache, apiClientRepo, oAuthCodeCache, samlConfigRepo, ldapConfigRepo, ldapAccountRepo, router, operationAuditLogRepo)
+	relyingParty := data.NewWebAuthnRelyingParty(context, authenticator)
+	mfaService := service.NewMfaService(context, userMfaFactorRepo, mfaPolicyRepo, mfaChallengeCache, authenticator, loginRateLimiter, relyingParty, router, authenticationService)
+	loginPolicyService := service.NewLoginPolicyService(context, loginPolicyRepo, authenticationService)
+	passwordPolicyService := service.NewPasswordPolicyService(context, passwordPolicyRepo)
+	apiClientService := service.NewApiClientService(context, apiClientRepo, roleRepo, userRepo, authenticator, clientType)
+	oAuthServerService := service.NewOAuthServerService(context, apiClientRepo, oAuthCodeCache)
+	oidcService := service.NewOidcService(context, authenticator, userRepo)
+	oAuthProviderConfigRepo := data.NewOAuthProviderConfigRepo(context, entClient)
+	socialAccountRepo := data.NewSocialAccountRepo(context, entClient, userRepo, userCredentialRepo)
+	oAuthStateCache := data.NewOAuthStateCache(context, client)
+	oAuthService := service.NewOAuthService(context, oAuthProviderConfigRepo, socialAccountRepo, oAuthStateCache, authenticationService)
+	oAuthProviderConfigService := service.NewOAuthProviderConfigService(context, oAuthProviderConfigRepo, roleRepo)
+	samlAccountRepo := data.NewSamlAccountRepo(context, entClient, userRepo, userCredentialRepo)
+	samlSessionCache := data.NewSamlSessionCache(context, client)
+	samlService := service.NewSamlService(context, samlConfigRepo, samlAccountRepo, samlSessionCache, authenticationService)
+	samlConfigService := service.NewSamlConfigService(context, samlConfigRepo, roleRepo, orgUnitRepo, tenantRepo, authenticator)
+	ldapConfigService := service.NewLdapConfigService(context, ldapConfigRepo, ldapAccountRepo, roleRepo, orgUnitRepo)
+	scimTokenRepo := data.NewScimTokenRepo(context, entClient)
+	scimService := service.NewScimService(context, scimTokenRepo, userRepo, userRoleRepo, membershipRepo, roleRepo, orgUnitRepo, authenticator, clientType, authorizerAuthorizer, operationAuditLogRepo)
+	scimTokenService := service.NewScimTokenService(context, scimTokenRepo)
+	jwtSigningKeyService := service.NewJwtSigningKeyService(context, jwtSigningKeyRepo, authenticator)
 	menuRepo := data.NewMenuRepo(context, entClient)
 	planModuleRepo := data.NewPlanModuleRepo(context, entClient)
 	adminPortalService := service.NewAdminPortalService(context, menuRepo, roleRepo, userRepo, permissionRepo, planModuleRepo, tenantRepo)
@@ -81,7 +115,7 @@ func initApp(context *bootstrap.Context) (*kratos.App, func(), error) {
 	backupRepo := data.NewBackupRepo(context, entClient)
 	tenantUsageRepo := data.NewTenantUsageRepo(context, entClient, authenticator)
 	minIOClient := data.NewMinIoClient(context)
-	taskService := service.NewTaskService(context, taskRepo, userRepo, backupRepo, tenantUsageRepo, minIOClient)
+	taskService := service.NewTaskService(context, taskRepo, userRepo, backupRepo, tenantUsageRepo, minIOClient, authenticator, ldapAccountRepo)
 	fileRepo := data.NewFileRepo(context, entClient)
 	fileService := service.NewFileService(context, fileRepo, minIOClient)
 	fileTransferService := service.NewFileTransferService(context, minIOClient, fileRepo)
@@ -99,23 +133,25 @@ func initApp(context *bootstrap.Context) (*kratos.App, func(), error) {
 	planQuotaService := service.NewPlanQuotaService(context, planQuotaRepo)
 	planModuleService := service.NewPlanModuleService(context, planModuleRepo)
 	positionRepo := data.NewPositionRepo(context, entClient)
-	userService := service.NewUserService(context, userRepo, roleRepo, userCredentialRepo, positionRepo, orgUnitRepo, tenantRepo, membershipRepo)
-	userProfileService := service.NewUserProfileService(context, userRepo, roleRepo, userCredentialRepo, minIOClient)
-	roleService := service.NewRoleService(context, authorizerAuthorizer, roleRepo, tenantRepo)
+	userService := service.NewUserService(context, userRepo, roleRepo, userCredentialRepo, positionRepo, orgUnitRepo, tenantRepo, membershipRepo, operationAuditLogRepo, router, authenticator, clientType)
+	contactBindingCache := data.NewContactBindingCache(context, client)
+	userProfileService := service.NewUserProfileService(context, userRepo, roleRepo, userCredentialRepo, minIOClient, contactBindingCache, router, authenticator, clientType)
+	roleService := service.NewRoleService(context, authorizerAuthorizer, roleRepo, tenantRepo, dataScopeResolver, permissionCodeResolver, operationAuditLogRepo)
 	positionService := service.NewPositionService(context, positionRepo, orgUnitRepo)
-	orgUnitService := service.NewOrgUnitService(context, orgUnitRepo, userRepo)
+	orgUnitService := service.NewOrgUnitService(context, orgUnitRepo, userRepo, dataScopeResolver, operationAuditLogRepo)
+	v := server.NewRestMiddleware(context, accessTokenChecker, tenantAccessChecker, authorizerAuthorizer, permissionPolicyEvaluator, dataScopeResolver, permissionCodeResolver, userService, roleService, orgUnitService, apiAuditLogRepo, loginAuditLogRepo)
 	menuService := service.NewMenuService(context, menuRepo)
 	apiService := service.NewApiService(context, apiRepo, authorizerAuthorizer)
 	permissionGroupRepo := data.NewPermissionGroupRepo(context, entClient)
-	permissionService := service.NewPermissionService(context, permissionRepo, permissionGroupRepo, menuRepo, apiRepo, roleRepo, authorizerAuthorizer)
+	permissionService := service.NewPermissionService(context, permissionRepo, permissionGroupRepo, menuRepo, apiRepo, roleRepo, authorizerAuthorizer, permissionCodeResolver)
 	permissionGroupService := service.NewPermissionGroupService(context, permissionGroupRepo, permissionRepo)
+	permissionPolicyService := service.NewPermissionPolicyService(context, permissionPolicyRepo, permissionPolicyEvaluator)
+	relationTupleService := service.NewRelationTupleService(context, authorizerAuthorizer)
 	permissionAuditLogRepo := data.NewPermissionAuditLogRepo(context, entClient)
 	permissionAuditLogService := service.NewPermissionAuditLogService(context, permissionAuditLogRepo)
-	policyEvaluationLogRepo := data.NewPolicyEvaluationLogRepo(context, entClient)
 	policyEvaluationLogService := service.NewPolicyEvaluationLogService(context, policyEvaluationLogRepo)
 	loginAuditLogService := service.NewLoginAuditLogService(context, loginAuditLogRepo)
 	apiAuditLogService := service.NewApiAuditLogService(context, apiAuditLogRepo, apiRepo)
-	operationAuditLogRepo := data.NewOperationAuditLogRepo(context, entClient)
 	operationAuditLogService := service.NewOperationAuditLogService(context, operationAuditLogRepo)
 	dataAccessAuditLogRepo := data.NewDataAccessAuditLogRepo(context, entClient)
 	dataAccessAuditLogService := service.NewDataAccessAuditLogService(context, dataAccessAuditLogRepo)
@@ -129,7 +165,7 @@ func initApp(context *bootstrap.Context) (*kratos.App, func(), error) {
 	internalMessageService := service.NewInternalMessageService(context, internalMessageRepo, internalMessageCategoryRepo, internalMessageRecipientRepo, userRepo, authenticator, clientType)
 	internalMessageCategoryService := service.NewInternalMessageCategoryService(context, internalMessageCategoryRepo)
 	internalMessageRecipientService := service.NewInternalMessageRecipientService(context, internalMessageRepo, internalMessageRecipientRepo)
-	httpServer, err := server.NewRestServer(context, v, authorizerAuthorizer, authenticationService, mfaService, loginPolicyService, adminPortalService, taskService, fileService, fileTransferService, dictTypeService, dictEntryService, languageService, tenantService, planService, planQuotaService, planModuleService, userService, userProfileService, roleService, positionService, orgUnitService, menuService, apiService, permissionService, permissionGroupService, permissionAuditLogService, policyEvaluationLogService, loginAuditLogService, apiAuditLogService, operationAuditLogService, dataAccessAuditLogService, redisCacheMonitorService, dashboardService, internalMessageService, internalMessageCategoryService, internalMessageRecipientService)
+	httpServer, err := server.NewRestServer(context, v, authorizerAuthorizer, authenticationService, mfaService, loginPolicyService, passwordPolicyService, apiClientService, oAuthServerService, oidcService, oAuthService, oAuthProviderConfigService, samlService, samlConfigService, ldapConfigService, scimService, scimTokenService, jwtSigningKeyService, adminPortalService, taskService, fileService, fileTransferService, dictTypeService, dictEntryService, languageService, tenantService, planService, planQuotaService, planModuleService, userService, userProfileService, roleService, positionService, orgUnitService, menuService, apiService, permissionService, permissionGroupService, permissionPolicyService, relationTupleService, permissionAuditLogService, policyEvaluationLogService, loginAuditLogService, apiAuditLogService, operationAuditLogService, dataAccessAuditLogService, redisCacheMonitorService, dashboardService, internalMessageService, internalMessageCategoryService, internalMessageRecipientService)
 	if err != nil {
 		cleanup2()
 		cleanup()
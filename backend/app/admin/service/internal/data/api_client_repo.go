@@ -0,0 +1,447 @@
+package data
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	paginationV1 "github.com/tx7do/go-crud/api/gen/go/pagination/v1"
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"github.com/tx7do/go-utils/copierutil"
+	"github.com/tx7do/go-utils/jwtutil"
+	"github.com/tx7do/go-utils/mapper"
+	"github.com/tx7do/go-utils/password"
+	"github.com/tx7do/go-utils/timeutil"
+
+	"go-wind-admin/app/admin/service/internal/data/ent"
+	"go-wind-admin/app/admin/service/internal/data/ent/apiclient"
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+)
+
+// apiClientIDPrefix 服务端生成的 client_id 前缀，便于在日志/配置中一眼区分服务客户端。
+const apiClientIDPrefix = "gwa_"
+
+// ApiClientAuthInfo 校验通过的服务客户端信息（client_credentials / authorization_code 用，不含密钥哈希）。
+type ApiClientAuthInfo struct {
+	ID       uint32
+	ClientID string
+	Name     string
+	TenantID uint32
+	RoleIDs  []uint32
+
+	RedirectURIs []string
+	Scopes       []string
+	PublicClient bool
+}
+
+type ApiClientRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+
+	mapper          *mapper.CopierMapper[authenticationV1.ApiClient, ent.ApiClient]
+	statusConverter *mapper.EnumTypeConverter[authenticationV1.ApiClient_Status, apiclient.Status]
+
+	passwordCrypto password.Crypto
+
+	repository *entCrud.Repository[
+		ent.ApiClientQuery, ent.ApiClientSelect,
+		ent.ApiClientCreate, ent.ApiClientCreateBulk,
+		ent.ApiClientUpdate, ent.ApiClientUpdateOne,
+		ent.ApiClientDelete,
+		predicate.ApiClient,
+		authenticationV1.ApiClient, ent.ApiClient,
+	]
+}
+
+func NewApiClientRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client], passwordCrypto password.Crypto) *ApiClientRepo {
+	repo := &ApiClientRepo{
+		log:            ctx.NewLoggerHelper("api-client/repo/admin-service"),
+		entClient:      entClient,
+		passwordCrypto: passwordCrypto,
+		mapper:         mapper.NewCopierMapper[authenticationV1.ApiClient, ent.ApiClient](),
+		statusConverter: mapper.NewEnumTypeConverter[authenticationV1.ApiClient_Status, apiclient.Status](
+			authenticationV1.ApiClient_Status_name, authenticationV1.ApiClient_Status_value,
+		),
+	}
+
+	repo.init()
+
+	return repo
+}
+
+func (r *ApiClientRepo) init() {
+	r.repository = entCrud.NewRepository[
+		ent.ApiClientQuery, ent.ApiClientSelect,
+		ent.ApiClientCreate, ent.ApiClientCreateBulk,
+		ent.ApiClientUpdate, ent.ApiClientUpdateOne,
+		ent.ApiClientDelete,
+		predicate.ApiClient,
+		authenticationV1.ApiClient, ent.ApiClient,
+	](r.mapper)
+
+	r.mapper.AppendConverters(copierutil.NewTimeStringConverterPair())
+	r.mapper.AppendConverters(copierutil.NewTimeTimestamppbConverterPair())
+
+	r.mapper.AppendConverters(r.statusConverter.NewConverterPair())
+}
+
+// newApiClientID 生成全局唯一的 client_id（前缀 + 16 字节随机数 hex）。
+func newApiClientID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return apiClientIDPrefix + hex.EncodeToString(b), nil
+}
+
+// newApiClientSecret 生成 client_secret 明文并返回其 bcrypt 哈希。
+// 明文复用 refresh token 的随机源（256 位，Base64URL 43 字符，未超出 bcrypt 72 字节上限）。
+func (r *ApiClientRepo) newApiClientSecret() (plain, hash string, err error) {
+	if plain, err = jwtutil.NewRefreshToken(); err != nil {
+		r.log.Errorf("generate api client secret failed: %s", err.Error())
+		return "", "", authenticationV1.ErrorServiceUnavailable("generate client secret failed")
+	}
+	if hash, err = r.passwordCrypto.Encrypt(plain); err != nil {
+		r.log.Errorf("hash api client secret failed: %s", err.Error())
+		return "", "", authenticationV1.ErrorServiceUnavailable("hash client secret failed")
+	}
+	return plain, hash, nil
+}
+
+func (r *ApiClientRepo) Count(ctx context.Context, whereCond []func(s *sql.Selector)) (int, error) {
+	builder := r.entClient.Client().ApiClient.Query()
+	if len(whereCond) != 0 {
+		builder.Modify(whereCond...)
+	}
+
+	count, err := builder.Count(ctx)
+	if err != nil {
+		r.log.Errorf("query count failed: %s", err.Error())
+		return 0, authenticationV1.ErrorInternalServerError("query count failed")
+	}
+
+	return count, nil
+}
+
+func (r *ApiClientRepo) List(ctx context.Context, req *paginationV1.PagingRequest) (*authenticationV1.ListApiClientResponse, error) {
+	if req == nil {
+		return nil, authenticationV1.ErrorBadRequest("invalid parameter")
+	}
+
+	builder := r.entClient.Client().ApiClient.Query()
+
+	ret, err := r.repository.ListWithPaging(ctx, builder, builder.Clone(), req)
+	if err != nil {
+		return nil, err
+	}
+	if ret == nil {
+		return &authenticationV1.ListApiClientResponse{Total: 0, Items: nil}, nil
+	}
+
+	return &authenticationV1.ListApiClientResponse{
+		Total: ret.Total,
+		Items: ret.Items,
+	}, nil
+}
+
+func (r *ApiClientRepo) IsExist(ctx context.Context, id uint32) (bool, error) {
+	exist, err := r.entClient.Client().ApiClient.Query().
+		Where(apiclient.IDEQ(id)).
+		Exist(ctx)
+	if err != nil {
+		r.log.Errorf("query exist failed: %s", err.Error())
+		return false, authenticationV1.ErrorInternalServerError("query exist failed")
+	}
+	return exist, nil
+}
+
+func (r *ApiClientRepo) Get(ctx context.Context, req *authenticationV1.GetApiClientRequest) (*authenticationV1.ApiClient, error) {
+	if req == nil {
+		return nil, authenticationV1.ErrorBadRequest("invalid parameter")
+	}
+
+	builder := r.entClient.Client().ApiClient.Query()
+
+	var whereCond []func(s *sql.Selector)
+	switch req.QueryBy.(type) {
+	default:
+	case *authenticationV1.GetApiClientRequest_Id:
+		whereCond = append(whereCond, apiclient.IDEQ(req.GetId()))
+	case *authenticationV1.GetApiClientRequest_ClientId:
+		whereCond = append(whereCond, apiclient.ClientIDEQ(req.GetClientId()))
+	}
+
+	dto, err := r.repository.Get(ctx, builder, req.GetViewMask(), whereCond...)
+	if err != nil {
+		return nil, err
+	}
+
+	return dto, err
+}
+
+// Create 创建服务客户端，由服务端生成 client_id/client_secret，明文密钥仅在返回值中出现一次。
+func (r *ApiClientRepo) Create(ctx context.Context, req *authenticationV1.CreateApiClientRequest) (*authenticationV1.ApiClientSecretResponse, error) {
+	if req == nil || req.Data == nil {
+		return nil, authenticationV1.ErrorBadRequest("invalid request")
+	}
+
+	clientID, err := newApiClientID()
+	if err != nil {
+		r.log.Errorf("generate api client id failed: %s", err.Error())
+		return nil, authenticationV1.ErrorServiceUnavailable("generate client id failed")
+	}
+
+	plainSecret, secretHash, err := r.newApiClientSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	builder := r.entClient.Client().ApiClient.Create().
+		SetNillableTenantID(req.Data.TenantId).
+		SetNillableName(req.Data.Name).
+		SetClientID(clientID).
+		SetClientSecretHash(secretHash).
+		SetRoleIds(req.Data.GetRoleIds()).
+		SetRedirectUris(req.Data.GetRedirectUris()).
+		SetScopes(req.Data.GetScopes()).
+		SetNillablePublicClient(req.Data.PublicClient).
+		SetNillableStatus(r.statusConverter.ToEntity(req.Data.Status)).
+		SetNillableDescription(req.Data.Description).
+		SetNillableExpiresAt(timeutil.TimestamppbToTime(req.Data.ExpiresAt)).
+		SetSecretRotatedAt(now).
+		SetNillableCreatedBy(req.Data.CreatedBy).
+		SetCreatedAt(now)
+
+	entity, err := builder.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, authenticationV1.ErrorBadRequest("api client name already exists")
+		}
+		r.log.Errorf("insert api client failed: %s", err.Error())
+		return nil, authenticationV1.ErrorInternalServerError("insert api client failed")
+	}
+
+	return &authenticationV1.ApiClientSecretResponse{
+		Id:           entity.ID,
+		ClientId:     clientID,
+		ClientSecret: plainSecret,
+	}, nil
+}
+
+func (r *ApiClientRepo) Update(ctx context.Context, req *authenticationV1.UpdateApiClientRequest) error {
+	if req == nil || req.Data == nil {
+		return authenticationV1.ErrorBadRequest("invalid request")
+	}
+	if req.GetId() == 0 {
+		return authenticationV1.ErrorBadRequest("id is required")
+	}
+
+	builder := r.entClient.Client().ApiClient.Update()
+	err := r.repository.UpdateX(ctx, builder, req.Data, req.GetUpdateMask(),
+		func(dto *authenticationV1.ApiClient) {
+			builder.
+				SetNillableName(req.Data.Name).
+				SetNillableStatus(r.statusConverter.ToEntity(req.Data.Status)).
+				SetNillableDescription(req.Data.Description).
+				SetNillablePublicClient(req.Data.PublicClient).
+				SetNillableExpiresAt(timeutil.TimestamppbToTime(req.Data.ExpiresAt)).
+				SetNillableUpdatedBy(req.Data.UpdatedBy).
+				SetUpdatedAt(time.Now())
+			if req.Data.RoleIds != nil {
+				builder.SetRoleIds(req.Data.GetRoleIds())
+			}
+			if req.Data.RedirectUris != nil {
+				builder.SetRedirectUris(req.Data.GetRedirectUris())
+			}
+			if req.Data.Scopes != nil {
+				builder.SetScopes(req.Data.GetScopes())
+			}
+		},
+		func(s *sql.Selector) {
+			s.Where(sql.EQ(apiclient.FieldID, req.GetId()))
+			// 租户范围过滤：隐私层只过滤查询，更新需显式限定，防止按 ID 越权修改其他租户客户端
+			if tid, hasTenant := maybeTenantFromViewer(ctx); hasTenant {
+				s.Where(sql.EQ(apiclient.FieldTenantID, tid))
+			}
+		},
+	)
+
+	return err
+}
+
+func (r *ApiClientRepo) Delete(ctx context.Context, id uint32) error {
+	if id == 0 {
+		return authenticationV1.ErrorBadRequest("invalid parameter")
+	}
+
+	builder := r.entClient.Client().ApiClient.Delete().
+		Where(apiclient.IDEQ(id))
+	// 租户范围过滤（平台管理员 tenant_id=0 不限定）
+	if tid, hasTenant := maybeTenantFromViewer(ctx); hasTenant {
+		builder.Where(apiclient.TenantIDEQ(tid))
+	}
+	affected, err := builder.Exec(ctx)
+	if err != nil {
+		r.log.Errorf("delete api client failed: %s", err.Error())
+		return authenticationV1.ErrorInternalServerError("delete api client failed")
+	}
+	if affected == 0 {
+		return authenticationV1.ErrorNotFound("api client not found")
+	}
+
+	return nil
+}
+
+// RotateSecret 轮换服务客户端密钥：生成新密钥并覆盖哈希，旧密钥随即失效。
+// 已签发令牌的吊销由调用方负责（见 ApiClientService.RotateSecret）。
+func (r *ApiClientRepo) RotateSecret(ctx context.Context, id uint32, operatorID *uint32) (*authenticationV1.ApiClientSecretResponse, error) {
+	if id == 0 {
+		return nil, authenticationV1.ErrorBadRequest("invalid parameter")
+	}
+
+	entity, err := r.entClient.Client().ApiClient.Query().
+		Where(apiclient.IDEQ(id)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, authenticationV1.ErrorNotFound("api client not found")
+		}
+		r.log.Errorf("query api client failed: %s", err.Error())
+		return nil, authenticationV1.ErrorInternalServerError("query api client failed")
+	}
+
+	plainSecret, secretHash, err := r.newApiClientSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if err = r.entClient.Client().ApiClient.UpdateOneID(id).
+		SetClientSecretHash(secretHash).
+		SetSecretRotatedAt(now).
+		SetNillableUpdatedBy(operatorID).
+		SetUpdatedAt(now).
+		Exec(ctx); err != nil {
+		r.log.Errorf("rotate api client secret failed: %s", err.Error())
+		return nil, authenticationV1.ErrorInternalServerError("rotate api client secret failed")
+	}
+
+	return &authenticationV1.ApiClientSecretResponse{
+		Id:           entity.ID,
+		ClientId:     derefStr(entity.ClientID),
+		ClientSecret: plainSecret,
+	}, nil
+}
+
+// VerifyClientSecret 按 client_id 查找服务客户端并校验密钥。
+// 与 UserCredentialRepo.FindUserCredential 同样做恒定耗时防护：客户端不存在/不可用时
+// 也跑一次 bcrypt 假校验，避免通过响应耗时枚举有效 client_id。
+// 客户端禁用或已过期与密钥错误统一返回 INVALID_PASSWORD（由调用方归一化对外文案）。
+func (r *ApiClientRepo) VerifyClientSecret(ctx context.Context, clientID, plainSecret string) (*ApiClientAuthInfo, error) {
+	if clientID == "" || plainSecret == "" {
+		return nil, authenticationV1.ErrorBadRequest("client_id or client_secret is empty")
+	}
+
+	entity, err := r.findActiveClient(ctx, clientID)
+	if err != nil {
+		r.performDummyVerify(plainSecret)
+		return nil, err
+	}
+
+	if entity.ClientSecretHash == nil {
+		r.performDummyVerify(plainSecret)
+		return nil, authenticationV1.ErrorUserFreeze("api client has no secret")
+	}
+
+	ok, err := r.passwordCrypto.Verify(plainSecret, *entity.ClientSecretHash)
+	if err != nil || !ok {
+		return nil, authenticationV1.ErrorInvalidPassword("incorrect client secret")
+	}
+
+	return r.toAuthInfo(entity), nil
+}
+
+// GetOAuthClient 按 client_id 查找可用于授权码模式的客户端（启用、未过期、登记了回调地址），不校验密钥。
+func (r *ApiClientRepo) GetOAuthClient(ctx context.Context, clientID string) (*ApiClientAuthInfo, error) {
+	if clientID == "" {
+		return nil, authenticationV1.ErrorBadRequest("client_id is empty")
+	}
+
+	entity, err := r.findActiveClient(ctx, clientID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(entity.RedirectUris) == 0 {
+		return nil, authenticationV1.ErrorUserFreeze("api client is not registered for authorization code")
+	}
+
+	return r.toAuthInfo(entity), nil
+}
+
+// findActiveClient 按 client_id 查找启用且未过期的客户端。
+func (r *ApiClientRepo) findActiveClient(ctx context.Context, clientID string) (*ent.ApiClient, error) {
+	entity, err := r.entClient.Client().ApiClient.Query().
+		Where(apiclient.ClientIDEQ(clientID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, authenticationV1.ErrorUserNotFound("api client not found")
+		}
+		r.log.Errorf("query api client failed: %s", err.Error())
+		return nil, authenticationV1.ErrorServiceUnavailable("db error")
+	}
+
+	if entity.Status == nil || *entity.Status != apiclient.StatusOn ||
+		(entity.ExpiresAt != nil && entity.ExpiresAt.Before(time.Now())) {
+		return nil, authenticationV1.ErrorUserFreeze("api client is disabled or expired")
+	}
+
+	return entity, nil
+}
+
+func (r *ApiClientRepo) toAuthInfo(entity *ent.ApiClient) *ApiClientAuthInfo {
+	var tenantID uint32
+	if entity.TenantID != nil {
+		tenantID = *entity.TenantID
+	}
+
+	return &ApiClientAuthInfo{
+		ID:           entity.ID,
+		ClientID:     derefStr(entity.ClientID),
+		Name:         derefStr(entity.Name),
+		TenantID:     tenantID,
+		RoleIDs:      entity.RoleIds,
+		RedirectURIs: entity.RedirectUris,
+		Scopes:       entity.Scopes,
+		PublicClient: entity.PublicClient != nil && *entity.PublicClient,
+	}
+}
+
+// performDummyVerify 执行一次假的密钥校验，用于抹平计时差异。
+func (r *ApiClientRepo) performDummyVerify(plainSecret string) {
+	_, _ = r.passwordCrypto.Verify(plainSecret, dummyPasswordHash)
+}
+
+// UpdateLastUsed 记录服务客户端最近一次换取令牌的时间与来源 IP。
+func (r *ApiClientRepo) UpdateLastUsed(ctx context.Context, id uint32, clientIP string, at time.Time) error {
+	builder := r.entClient.Client().ApiClient.UpdateOneID(id).
+		SetLastUsedAt(at)
+	if clientIP != "" {
+		builder.SetLastUsedIP(clientIP)
+	}
+	if err := builder.Exec(ctx); err != nil {
+		r.log.Errorf("update api client last used failed: %s", err.Error())
+		return authenticationV1.ErrorInternalServerError("update api client last used failed")
+	}
+	return nil
+}
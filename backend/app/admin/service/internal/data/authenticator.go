@@ -2,12 +2,18 @@
 
 import (
 	"context"
-	"crypto/rsa"
+	"crypto"
+	"crypto/sha256"
 	"crypto/x509"
+	"encoding/base64"
 	"encoding/pem"
+	"errors"
 	"fmt"
 	"os"
+	"sort"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
@@ -16,14 +22,19 @@
 	"github.com/tx7do/go-utils/trans"
 
 	authnEngine "github.com/tx7do/kratos-authn/engine"
-	authnJwt "github.com/tx7do/kratos-authn/engine/jwt"
 
 	conf "github.com/tx7do/kratos-bootstrap/api/gen/go/conf/v1"
 	"github.com/tx7do/kratos-bootstrap/bootstrap"
 
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	auditV1 "go-wind-admin/api/gen/go/audit/service/v1"
 	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
 
 	"go-wind-admin/pkg/jwt"
+	applogging "go-wind-admin/pkg/middleware/logging"
+	"go-wind-admin/pkg/netutil"
+	"go-wind-admin/pkg/oauth"
 )
 
 // devSamplePrivateKeyFingerprint 是 configs/auth.yaml 内置开发示例 RSA 私钥的
@@ -67,20 +78,47 @@ func applyJwtKeyOverrides(logHelper *log.Helper, jwtCfg *conf.Authentication_Jwt
 	DefaultRefreshTokenExpires = time.Hour * 24 * 7
 )
 
+const (
+	// keyringReloadInterval 密钥环从库中刷新的周期：多实例部署时，
+	// 其他实例轮换出的新密钥最迟在此间隔后被本实例用于签发。
+	keyringReloadInterval = time.Minute
+
+	// keyringForceReloadMinInterval 遇到未知 kid 时强制刷新的最小间隔，
+	// 防止携带伪造 kid 的请求把每次校验都放大成一次查库。
+	keyringForceReloadMinInterval = 5 * time.Second
+
+	// retiredKeyVerifyLeeway 退役密钥校验期在访问令牌有效期之外额外保留的余量（时钟偏差等）
+	retiredKeyVerifyLeeway = time.Minute
+)
+
 type Authenticator struct {
 	log *log.Helper
 
-	AdminAuthenticator authnEngine.Authenticator
+	// AdminAuthenticator JWT 密钥环：活动密钥签发（令牌头写入 kid），
+	// 活动密钥与校验期内的退役密钥按 kid 校验。
+	AdminAuthenticator *jwt.Keyring
 
 	// jwtCfg 保留 JWT 配置，用于读取令牌过期时间。
 	jwtCfg *conf.Authentication_Jwt
 
+	// issuer OpenID Connect 签发者标识（ID 令牌 iss 声明 / 发现文档 issuer）
+	issuer string
+
+	// signingKeyRepo 密钥环持久化；为 nil 时只使用配置中的密钥，不支持轮换。
+	signingKeyRepo *JwtSigningKeyRepo
+	// staticKeys 未入库、始终参与校验的配置密钥（仅配置了公钥的校验实例）
+	staticKeys []*jwt.SigningKey
+
+	reloadMu          sync.Mutex
+	keyringReloadedAt atomic.Int64 // UnixNano
+
 	userTokenCache *UserTokenCache
 }
 
 func NewAuthenticator(
 	ctx *bootstrap.Context,
 	userTokenCache *UserTokenCache,
+	signingKeyRepo *JwtSigningKeyRepo,
 ) *Authenticator {
 	cfg := ctx.GetConfig()
 	if cfg == nil || cfg.Authn == nil {
@@ -103,70 +141,268 @@ func NewAuthenticator(
 	a := Authenticator{
 		log:            logHelper,
 		jwtCfg:         jwtCfg,
+		issuer:         oidcIssuer(cfg.Authn.GetOidc()),
+		signingKeyRepo: signingKeyRepo,
 		userTokenCache: userTokenCache,
 	}
 
-	adminAuth, err := newAdminAuthenticator(jwtCfg)
+	configKey, configMaterial, err := signingKeyFromConfig(jwtCfg)
 	if err != nil {
 		// 启动期密钥/算法配置错误属于不可恢复故障，直接 panic 以暴露问题。
 		panic(fmt.Sprintf("init admin authenticator failed: %v", err))
 	}
-	a.AdminAuthenticator = adminAuth
+	a.AdminAuthenticator = jwt.NewKeyring(configKey)
+
+	a.bootstrapKeyring(configKey, configMaterial)
 
 	return &a
 }
 
-// newAdminAuthenticator 根据配置构造 JWT 认证器，同时支持对称与非对称签名算法。
+// bootstrapKeyring 将配置密钥导入密钥环并从库中加载活动密钥：
+//   - 首次启动时配置密钥入库成为活动密钥；
+//   - 更换 yaml / 环境变量中的密钥后，新配置密钥入库并顶替原活动密钥（原密钥进入校验期）；
+//   - 配置未变时库中的活动密钥（可能已被轮换）保持不变，轮换结果跨重启保留。
+func (a *Authenticator) bootstrapKeyring(configKey *jwt.SigningKey, configMaterial string) {
+	if a.signingKeyRepo == nil {
+		return
+	}
+
+	if !configKey.CanSign() {
+		a.staticKeys = []*jwt.SigningKey{configKey}
+	} else {
+		ctx := context.Background()
+		if _, err := a.signingKeyRepo.Activate(ctx,
+			configKey.Kid, configKey.Method, configMaterial,
+			time.Now().Add(a.retiredKeyVerifyWindow()),
+			nil,
+		); err != nil {
+			// 不影响启动：退化为仅使用配置密钥，轮换不可用
+			a.log.Errorf("import jwt signing key from config failed: %v", err)
+			return
+		}
+	}
+
+	a.reloadKeyring(context.Background(), true)
+}
+
+// retiredKeyVerifyWindow 退役密钥的校验期：覆盖其签发的最后一批访问令牌的有效期，
+// 并预留其他实例刷新密钥环的延迟。
+func (a *Authenticator) retiredKeyVerifyWindow() time.Duration {
+	return a.GetAccessTokenExpires(authenticationV1.ClientType_admin) + keyringReloadInterval + retiredKeyVerifyLeeway
+}
+
+// reloadKeyring 从库中刷新密钥环。非强制刷新按 keyringReloadInterval 节流且不等待进行中的刷新；
+// 强制刷新（遇到未知 kid、轮换之后）按 keyringForceReloadMinInterval 节流。
+// 加载失败或库中无可用密钥时保留当前密钥环。
+func (a *Authenticator) reloadKeyring(ctx context.Context, force bool) {
+	if a.signingKeyRepo == nil {
+		return
+	}
+
+	interval := keyringReloadInterval
+	if force {
+		interval = keyringForceReloadMinInterval
+	}
+	if time.Since(time.Unix(0, a.keyringReloadedAt.Load())) < interval {
+		return
+	}
+
+	if force {
+		a.reloadMu.Lock()
+	} else if !a.reloadMu.TryLock() {
+		return
+	}
+	defer a.reloadMu.Unlock()
+
+	// 双重检查：等锁期间可能已被其他请求刷新
+	if time.Since(time.Unix(0, a.keyringReloadedAt.Load())) < interval {
+		return
+	}
+	now := time.Now()
+	a.keyringReloadedAt.Store(now.UnixNano())
+
+	keys, err := a.signingKeyRepo.LoadUsable(ctx, now)
+	if err != nil {
+		a.log.Warnf("reload jwt keyring failed, keep current keys: %v", err)
+		return
+	}
+	if keys.Active == nil {
+		if len(keys.Verify) == 0 {
+			return
+		}
+		// 校验实例：不签发，仅校验库中密钥
+		if a.AdminAuthenticator.Active().CanSign() {
+			a.log.Warn("no active jwt signing key in store, keep current keys")
+			return
+		}
+	}
+
+	verify := append(keys.Verify, a.staticKeys...)
+	active := keys.Active
+	if active == nil {
+		active = a.AdminAuthenticator.Active()
+	}
+	a.AdminAuthenticator.Replace(active, verify...)
+}
+
+// RotateSigningKey 生成新签名密钥并立即启用，原活动密钥进入校验期，
+// 期满后自动失效；已过校验期的退役密钥随之清理。返回新密钥的 kid。
+func (a *Authenticator) RotateSigningKey(ctx context.Context, operatorID *uint32) (string, error) {
+	if a.signingKeyRepo == nil {
+		return "", authenticationV1.ErrorServiceUnavailable("jwt signing key store unavailable")
+	}
+	if !a.AdminAuthenticator.Active().CanSign() {
+		return "", authenticationV1.ErrorServiceUnavailable("jwt signing is not enabled on this instance")
+	}
+
+	method := signingMethodOf(a.jwtCfg)
+	key, material, err := jwt.GenerateSigningKey("", method)
+	if err != nil {
+		a.log.Errorf("generate jwt signing key failed: %v", err)
+		return "", authenticationV1.ErrorInternalServerError("generate jwt signing key failed")
+	}
+	if key.Kid, err = signingKeyID(key, material); err != nil {
+		a.log.Errorf("derive jwt signing key id failed: %v", err)
+		return "", authenticationV1.ErrorInternalServerError("generate jwt signing key failed")
+	}
+
+	if _, err = a.signingKeyRepo.Activate(ctx,
+		key.Kid, key.Method, material,
+		time.Now().Add(a.retiredKeyVerifyWindow()),
+		operatorID,
+	); err != nil {
+		return "", authenticationV1.ErrorInternalServerError("activate jwt signing key failed")
+	}
+
+	if n, err := a.signingKeyRepo.PurgeExpired(ctx, time.Now()); err == nil && n > 0 {
+		a.log.Infof("purged %d expired jwt signing keys", n)
+	}
+
+	a.keyringReloadedAt.Store(0)
+	a.reloadKeyring(ctx, true)
+
+	a.log.Infof("jwt signing key rotated, new kid [%s]", key.Kid)
+
+	return key.Kid, nil
+}
+
+// signingMethodOf 返回配置的签名算法，未配置时与底层库默认行为一致（HS256）。
+func signingMethodOf(jwtCfg *conf.Authentication_Jwt) string {
+	if jwtCfg == nil || jwtCfg.GetMethod() == "" {
+		return "HS256"
+	}
+	return jwtCfg.GetMethod()
+}
+
+// oidcIssuer 读取 OpenID Connect 签发者标识：环境变量 GWA_AUTH_OIDC_ISSUER 优先，
+// 其次为 authn.oidc.issuer_url。末尾的 / 会被去掉，保证与 iss 声明逐字节一致。
+func oidcIssuer(oidcCfg *conf.Authentication_OIDC) string {
+	issuer := os.Getenv("GWA_AUTH_OIDC_ISSUER")
+	if issuer == "" {
+		issuer = oidcCfg.GetIssuerUrl()
+	}
+	return strings.TrimRight(issuer, "/")
+}
+
+// verificationPublicKey 解析非对称算法的校验公钥：优先使用 public_key，
+// 未配置时从 private_key 派生。
+func verificationPublicKey(jwtCfg *conf.Authentication_Jwt) (crypto.PublicKey, error) {
+	if jwtCfg.GetPublicKey() != "" {
+		block, _ := pem.Decode([]byte(jwtCfg.GetPublicKey()))
+		if block == nil {
+			return nil, fmt.Errorf("failed to decode PEM block from public key")
+		}
+		switch block.Type {
+		case "PUBLIC KEY": // PKIX
+			return x509.ParsePKIXPublicKey(block.Bytes)
+		case "RSA PUBLIC KEY": // PKCS#1
+			return x509.ParsePKCS1PublicKey(block.Bytes)
+		default:
+			return nil, fmt.Errorf("unsupported PEM block type %q (expected PUBLIC KEY or RSA PUBLIC KEY)", block.Type)
+		}
+	}
+	if jwtCfg.GetPrivateKey() != "" {
+		priv, err := jwt.ParsePrivateKeyPEM([]byte(jwtCfg.GetPrivateKey()))
+		if err != nil {
+			return nil, err
+		}
+		return priv.Public(), nil
+	}
+	return nil, fmt.Errorf("no public or private key configured")
+}
+
+// newAdminAuthenticator 根据配置构造仅含配置密钥的 JWT 密钥环。
+func newAdminAuthenticator(jwtCfg *conf.Authentication_Jwt) (*jwt.Keyring, error) {
+	key, _, err := signingKeyFromConfig(jwtCfg)
+	if err != nil {
+		return nil, err
+	}
+	return jwt.NewKeyring(key), nil
+}
+
+// signingKeyFromConfig 由配置构造签名密钥，同时支持对称与非对称签名算法，并返回可入库的密钥材料。
 //   - 对称算法（HS256/HS384/HS512）：key 为共享秘钥。
 //   - 非对称算法（RS256/RS384/RS512、PS256/PS384/PS512、ES256/...、EdDSA）：
 //     private_key 用于签发，public_key 用于校验。若仅配置了 private_key（如本服务
-//     既签发又校验的单体场景），则回退为从私钥派生公钥。
-func newAdminAuthenticator(jwtCfg *conf.Authentication_Jwt) (authnEngine.Authenticator, error) {
+//     既签发又校验的单体场景），则回退为从私钥派生公钥；仅配置 public_key 时只能校验。
+//
+// kid 由密钥内容确定性派生，同一配置密钥在各实例、各次启动得到相同 kid。
+func signingKeyFromConfig(jwtCfg *conf.Authentication_Jwt) (key *jwt.SigningKey, material string, err error) {
 	if jwtCfg == nil {
-		return nil, fmt.Errorf("jwt config is nil")
+		return nil, "", fmt.Errorf("jwt config is nil")
 	}
 
-	method := jwtCfg.GetMethod()
-	if method == "" {
-		// 与底层库默认行为一致。
-		method = "HS256"
+	method := signingMethodOf(jwtCfg)
+
+	if !isAsymmetricMethod(method) {
+		// 对称算法：key 即 HMAC 共享秘钥，签发与校验共用。
+		secret := []byte(jwtCfg.GetKey())
+		material = base64.RawURLEncoding.EncodeToString(secret)
+		if key, err = jwt.NewHMACSigningKey("", method, secret); err != nil {
+			return nil, "", err
+		}
+	} else {
+		if jwtCfg.GetPrivateKey() == "" && jwtCfg.GetPublicKey() == "" {
+			return nil, "", fmt.Errorf("asymmetric method %q requires private_key and/or public_key in config", method)
+		}
+
+		var priv crypto.Signer
+		if jwtCfg.GetPrivateKey() != "" {
+			if priv, err = jwt.ParsePrivateKeyPEM([]byte(jwtCfg.GetPrivateKey())); err != nil {
+				return nil, "", fmt.Errorf("parse private key: %w", err)
+			}
+			material = jwtCfg.GetPrivateKey()
+		}
+
+		var pub crypto.PublicKey
+		if pub, err = verificationPublicKey(jwtCfg); err != nil {
+			return nil, "", fmt.Errorf("load public key: %w", err)
+		}
+
+		if key, err = jwt.NewAsymmetricSigningKey("", method, priv, pub); err != nil {
+			return nil, "", err
+		}
 	}
 
-	opts := []authnJwt.Option{
-		authnJwt.WithSigningMethod(method),
+	if key.Kid, err = signingKeyID(key, material); err != nil {
+		return nil, "", err
 	}
+	return key, material, nil
+}
 
-	if isAsymmetricMethod(method) {
-		// 非对称算法：优先使用独立配置的 private_key / public_key。
-		switch {
-		case jwtCfg.GetPrivateKey() != "" && jwtCfg.GetPublicKey() != "":
-			opts = append(opts,
-				authnJwt.WithPrivateKeyFromPEM([]byte(jwtCfg.GetPrivateKey())),
-				authnJwt.WithPublicKeyFromPEM([]byte(jwtCfg.GetPublicKey())),
-			)
-		case jwtCfg.GetPrivateKey() != "":
-			// 仅配置私钥：从私钥派生公钥（本服务既签发又校验的场景）。
-			privPEM := []byte(jwtCfg.GetPrivateKey())
-			publicKey, err := publicKeyFromPrivateKeyPEM(privPEM)
-			if err != nil {
-				return nil, fmt.Errorf("derive public key from private key: %w", err)
-			}
-			opts = append(opts,
-				authnJwt.WithPrivateKeyFromPEM(privPEM),
-				authnJwt.WithVerificationKey(publicKey),
-			)
-		case jwtCfg.GetPublicKey() != "":
-			// 仅配置公钥：只能用于校验，无法签发。
-			opts = append(opts, authnJwt.WithPublicKeyFromPEM([]byte(jwtCfg.GetPublicKey())))
-		default:
-			return nil, fmt.Errorf("asymmetric method %q requires private_key and/or public_key in config", method)
+// signingKeyID 派生密钥 kid：非对称密钥为公钥的 RFC 7638 指纹（与 JWKS 中一致），
+// 对称密钥为秘钥材料的 SHA-256 截断（不可逆，不泄露秘钥）。
+func signingKeyID(key *jwt.SigningKey, material string) (string, error) {
+	if pub := key.PublicKey(); pub != nil {
+		jwk, err := oauth.PublicKeyToJWK(pub, key.Method, "")
+		if err != nil {
+			return "", err
 		}
-	} else {
-		// 对称算法：key 即 HMAC 共享秘钥，签发与校验共用。
-		opts = append(opts, authnJwt.WithKey([]byte(jwtCfg.GetKey())))
+		return jwk.GetKid(), nil
 	}
 
-	return authnJwt.NewAuthenticator(opts...)
+	sum := sha256.Sum256([]byte(material))
+	return "hs-" + base64.RawURLEncoding.EncodeToString(sum[:12]), nil
 }
 
 // isAsymmetricMethod 判断给定的签名算法是否为非对称（基于公钥/私钥对）算法。
@@ -182,37 +418,53 @@ func isAsymmetricMethod(method string) bool {
 	}
 }
 
-// publicKeyFromPrivateKeyPEM 从 PEM 编码的 RSA 私钥中派生出公钥。
-// 适用于仅配置了私钥、本服务既签发又校验令牌的场景。
-func publicKeyFromPrivateKeyPEM(pemBytes []byte) (*rsa.PublicKey, error) {
-	block, _ := pem.Decode(pemBytes)
-	if block == nil {
-		return nil, fmt.Errorf("failed to decode PEM block from private key")
+// Issuer 返回 OpenID Connect 签发者标识，未配置时为空。
+func (a *Authenticator) Issuer() string {
+	return a.issuer
+}
+
+// SigningMethod 返回当前活动密钥的签名算法。
+func (a *Authenticator) SigningMethod() string {
+	if active := a.AdminAuthenticator.Active(); active != nil {
+		return active.Method
 	}
+	return signingMethodOf(a.jwtCfg)
+}
 
-	var privKey *rsa.PrivateKey
-	switch block.Type {
-	case "RSA PRIVATE KEY": // PKCS#1
-		parsed, err := x509.ParsePKCS1PrivateKey(block.Bytes)
-		if err != nil {
-			return nil, fmt.Errorf("parse PKCS#1 private key: %w", err)
+// PublicJWKs 返回用于离线校验令牌的公钥集，包含活动密钥与校验期内的退役密钥，
+// 下游据令牌头的 kid 选择公钥。对称算法下秘钥不可公开，不会出现在结果中。
+func (a *Authenticator) PublicJWKs() ([]*authenticationV1.JsonWebKey, error) {
+	var keys []*authenticationV1.JsonWebKey
+	for _, k := range a.AdminAuthenticator.VerificationKeys() {
+		pub := k.PublicKey()
+		if pub == nil {
+			continue
 		}
-		privKey = parsed
-	case "PRIVATE KEY": // PKCS#8
-		parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		jwk, err := oauth.PublicKeyToJWK(pub, k.Method, k.Kid)
 		if err != nil {
-			return nil, fmt.Errorf("parse PKCS#8 private key: %w", err)
-		}
-		rsaKey, ok := parsed.(*rsa.PrivateKey)
-		if !ok {
-			return nil, fmt.Errorf("PKCS#8 key is not an RSA private key")
+			return nil, err
 		}
-		privKey = rsaKey
-	default:
-		return nil, fmt.Errorf("unsupported PEM block type %q (expected RSA PRIVATE KEY or PRIVATE KEY)", block.Type)
+		keys = append(keys, jwk)
+	}
+	return keys, nil
+}
+
+// CreateIDToken 签发 OpenID Connect ID 令牌。
+// 要求非对称签名算法：HMAC 签名的 ID 令牌只能用共享秘钥校验，第三方无法验证。
+func (a *Authenticator) CreateIDToken(claims authnEngine.AuthClaims) (string, error) {
+	if a.AdminAuthenticator.Active().PublicKey() == nil {
+		return "", authenticationV1.ErrorServiceUnavailable("id token requires asymmetric jwt signing")
+	}
+	if a.issuer == "" {
+		return "", authenticationV1.ErrorServiceUnavailable("oidc issuer not configured")
 	}
 
-	return &privKey.PublicKey, nil
+	idToken, err := a.AdminAuthenticator.CreateIdentity(claims)
+	if err != nil {
+		a.log.Errorf("create id token failed: [%v]", err)
+		return "", authenticationV1.ErrorServiceUnavailable("create id token failed")
+	}
+	return idToken, nil
 }
 
 // GetAccessTokenExpires 获取访问令牌过期时间。
@@ -260,13 +512,28 @@ func (a *Authenticator) Authenticate(ctx context.Context, req *authenticationV1.
 
 	switch req.GetTokenCategory() {
 	case authenticationV1.TokenCategory_ACCESS:
+		a.reloadKeyring(ctx, false)
+
 		// Authenticate Token
 		var claims *authnEngine.AuthClaims
 		claims, err = authenticator.AuthenticateToken(req.GetToken())
+		if errors.Is(err, jwt.ErrUnknownKeyID) {
+			// 其他实例刚轮换出的密钥本实例尚未加载：强制刷新后重试一次
+			a.reloadKeyring(ctx, true)
+			claims, err = authenticator.AuthenticateToken(req.GetToken())
+		}
 		if err != nil {
 			return nil, authenticationV1.ErrorUnauthorized("authenticate token failed: [%v]", err)
 		}
 
+		// ID 令牌与访问令牌共用签名密钥，但只有 ID 令牌携带 aud（客户端 ID），
+		// 拒绝将其当作访问令牌使用
+		if _, hasAud := (*claims)[authnEngine.ClaimFieldAudience]; hasAud {
+			return &authenticationV1.ValidateTokenResponse{
+				IsValid: false,
+			}, authenticationV1.ErrorUnauthorized("id token cannot be used as access token")
+		}
+
 		// Check Token Expiration
 		if jwt.IsTokenExpired(claims) {
 			return &authenticationV1.ValidateTokenResponse{
@@ -293,7 +560,13 @@ func (a *Authenticator) Authenticate(ctx context.Context, req *authenticationV1.
 		// Check token validity in cache
 		if !req.GetSkipRedis() {
 			var valid bool
-			if valid, err = a.userTokenCache.IsValidAccessToken(ctx, req.GetClientType(), payload.GetUserId(), payload.GetJti(), req.GetToken()); err != nil {
+			if payload.GetApiClientId() != 0 {
+				// client_credentials 令牌不归属用户，按服务客户端维度校验
+				valid, err = a.userTokenCache.IsValidClientAccessToken(ctx, req.GetClientType(), payload.GetApiClientId(), payload.GetJti(), req.GetToken())
+			} else {
+				valid, err = a.userTokenCache.IsValidAccessToken(ctx, req.GetClientType(), payload.GetUserId(), payload.GetJti(), req.GetToken())
+			}
+			if err != nil {
 				return &authenticationV1.ValidateTokenResponse{
 					IsValid: false,
 				}, authenticationV1.ErrorUnauthorized("invalid access token: [%v]", err)
@@ -356,8 +629,24 @@ func (a *Authenticator) CreateUserToken(
 
 	tokenPayload.Jti = trans.Ptr(jti)
 
+	// 载荷不带会话 ID 时为新登录，新建会话并以签发时间作为身份验证时间；刷新时沿用原会话 ID
+	newSession := tokenPayload.GetSessionId() == ""
+	if newSession {
+		var sessionId string
+		if sessionId = a.newJwtId(); sessionId == "" {
+			return "", "", authenticationV1.ErrorServiceUnavailable("create session id failed")
+		}
+		tokenPayload.SessionId = trans.Ptr(sessionId)
+
+		if tokenPayload.AuthTime == nil {
+			tokenPayload.AuthTime = trans.Ptr(time.Now().Unix())
+		}
+	}
+
+	a.reloadKeyring(ctx, false)
+
 	// Create Access Token
-	if accessToken, err = a.newAccessToken(clientType, tokenPayload); accessToken == "" || err != nil {
+	if accessToken, err = a.newAccessToken(clientType, tokenPayload, a.GetAccessTokenExpires(clientType)); accessToken == "" || err != nil {
 		return "", "", authenticationV1.ErrorServiceUnavailable("create access token failed")
 	}
 
@@ -367,20 +656,202 @@ func (a *Authenticator) CreateUserToken(
 	}
 
 	// Store tokens in cache
-	if err = a.userTokenCache.AddTokenPair(
+	if err = a.storeSessionTokenPair(ctx, clientType, tokenPayload, newSession, accessToken, refreshToken); err != nil {
+		return "", "", err
+	}
+
+	return
+}
+
+// storeSessionTokenPair 保存令牌对：新登录时记录会话（设备、IP、地理位置），刷新时轮换到原会话。
+func (a *Authenticator) storeSessionTokenPair(
+	ctx context.Context,
+	clientType authenticationV1.ClientType,
+	tokenPayload *authenticationV1.UserTokenPayload,
+	newSession bool,
+	accessToken, refreshToken string,
+) error {
+	clientIP := netutil.ClientIPFromContext(ctx)
+
+	if !newSession {
+		ok, err := a.userTokenCache.RotateSessionTokenPair(
+			ctx,
+			clientType,
+			tokenPayload.GetUserId(),
+			tokenPayload.GetSessionId(),
+			tokenPayload.GetJti(),
+			clientIP,
+			accessToken,
+			refreshToken,
+			a.GetAccessTokenExpires(clientType),
+			a.GetRefreshTokenExpires(clientType),
+			tokenPayload.GetAuthTime(),
+		)
+		if err != nil {
+			return authenticationV1.ErrorServiceUnavailable("store token failed")
+		}
+		if !ok {
+			return authenticationV1.ErrorUnauthorized("session has been revoked")
+		}
+		return nil
+	}
+
+	session := &UserSessionRecord{
+		SessionID: tokenPayload.GetSessionId(),
+		Jti:       tokenPayload.GetJti(),
+		TenantID:  tokenPayload.GetTenantId(),
+		ClientID:  tokenPayload.GetClientId(),
+		DeviceID:  tokenPayload.GetDeviceId(),
+		IP:        clientIP,
+		Scopes:    tokenPayload.GetScopes(),
+		AuthTime:  time.Unix(tokenPayload.GetAuthTime(), 0),
+	}
+	if header := netutil.HeaderFromContext(ctx); header != nil {
+		session.UserAgent = header.Get("User-Agent")
+	}
+	if geo := applogging.LookupGeoLocation(clientIP); geo != nil {
+		session.Country = geo.GetCountryCode()
+		session.Province = geo.GetProvince()
+		session.City = geo.GetCity()
+		session.ISP = geo.GetIsp()
+	}
+
+	return a.userTokenCache.AddSessionTokenPair(
 		ctx,
 		clientType,
 		tokenPayload.GetUserId(),
-		jti,
+		session,
 		accessToken,
 		refreshToken,
 		a.GetAccessTokenExpires(clientType),
 		a.GetRefreshTokenExpires(clientType),
-	); err != nil {
+	)
+}
+
+// ReauthenticateUserToken 二次验证通过后在当前会话内换发令牌对：新令牌对的身份验证时间为当前时间，
+// 当前令牌对随即吊销。载荷须来自当前访问令牌（携带会话 ID 与 jti）。
+func (a *Authenticator) ReauthenticateUserToken(
+	ctx context.Context,
+	clientType authenticationV1.ClientType,
+	tokenPayload *authenticationV1.UserTokenPayload,
+) (accessToken, refreshToken string, err error) {
+	if tokenPayload == nil || tokenPayload.GetSessionId() == "" || tokenPayload.GetJti() == "" {
+		return "", "", authenticationV1.ErrorBadRequest("session is required for reauthentication")
+	}
+
+	previousJti := tokenPayload.GetJti()
+	tokenPayload.AuthTime = trans.Ptr(time.Now().Unix())
+
+	if accessToken, refreshToken, err = a.CreateUserToken(ctx, clientType, tokenPayload); err != nil {
 		return "", "", err
 	}
 
-	return
+	if err = a.userTokenCache.RevokeTokenByJti(ctx, clientType, tokenPayload.GetUserId(), previousJti); err != nil {
+		a.log.Errorf("revoke previous token [%s] of session [%s] failed: %v", previousJti, tokenPayload.GetSessionId(), err)
+	}
+
+	return accessToken, refreshToken, nil
+}
+
+// CreateClientToken 为服务客户端签发访问令牌（client_credentials 授权）。
+// 按 RFC 6749 §4.4.3 不签发刷新令牌：客户端持有密钥，过期后直接重新换取即可。
+func (a *Authenticator) CreateClientToken(
+	ctx context.Context,
+	clientType authenticationV1.ClientType,
+	tokenPayload *authenticationV1.UserTokenPayload,
+) (accessToken string, err error) {
+	if tokenPayload == nil || tokenPayload.GetApiClientId() == 0 {
+		return "", authenticationV1.ErrorBadRequest("invalid client token payload")
+	}
+
+	var jti string
+	if jti = a.newJwtId(); jti == "" {
+		return "", authenticationV1.ErrorServiceUnavailable("create jwt id failed")
+	}
+
+	tokenPayload.Jti = trans.Ptr(jti)
+
+	// 每次签发都以客户端密钥完成一次身份验证
+	tokenPayload.AuthTime = trans.Ptr(time.Now().Unix())
+
+	a.reloadKeyring(ctx, false)
+
+	if accessToken, err = a.newAccessToken(clientType, tokenPayload, a.GetAccessTokenExpires(clientType)); accessToken == "" || err != nil {
+		return "", authenticationV1.ErrorServiceUnavailable("create access token failed")
+	}
+
+	if err = a.userTokenCache.AddClientAccessToken(
+		ctx,
+		clientType,
+		tokenPayload.GetApiClientId(),
+		jti,
+		accessToken,
+		a.GetAccessTokenExpires(clientType),
+	); err != nil {
+		return "", err
+	}
+
+	return accessToken, nil
+}
+
+// CreateImpersonationToken 签发代登录访问令牌：载荷为被代登录用户，并携带操作人身份（ActorUserId）。
+// 令牌有效期为 expires（不超过常规访问令牌有效期），不签发刷新令牌、不建立会话，过期后须重新发起代登录；
+// 令牌归属被代登录用户，吊销该用户的令牌时一并失效。
+func (a *Authenticator) CreateImpersonationToken(
+	ctx context.Context,
+	clientType authenticationV1.ClientType,
+	tokenPayload *authenticationV1.UserTokenPayload,
+	expires time.Duration,
+) (accessToken string, expiresAt time.Time, err error) {
+	if tokenPayload == nil || tokenPayload.GetUserId() == 0 || tokenPayload.GetActorUserId() == 0 {
+		return "", time.Time{}, authenticationV1.ErrorBadRequest("invalid impersonation token payload")
+	}
+	if maxExpires := a.GetAccessTokenExpires(clientType); expires <= 0 || expires > maxExpires {
+		expires = maxExpires
+	}
+
+	var jti string
+	if jti = a.newJwtId(); jti == "" {
+		return "", time.Time{}, authenticationV1.ErrorServiceUnavailable("create jwt id failed")
+	}
+
+	tokenPayload.Jti = trans.Ptr(jti)
+	tokenPayload.SessionId = nil
+	// 代登录令牌不代表被代登录用户完成过身份验证，无法通过敏感操作的二次验证
+	tokenPayload.AuthTime = nil
+
+	a.reloadKeyring(ctx, false)
+
+	expiresAt = time.Now().Add(expires)
+	if accessToken, err = a.newAccessToken(clientType, tokenPayload, expires); accessToken == "" || err != nil {
+		return "", time.Time{}, authenticationV1.ErrorServiceUnavailable("create access token failed")
+	}
+
+	if err = a.userTokenCache.AddAccessToken(ctx, clientType, tokenPayload.GetUserId(), jti, accessToken, expires); err != nil {
+		a.log.Errorf("store impersonation token failed: %v", err)
+		return "", time.Time{}, authenticationV1.ErrorServiceUnavailable("store token failed")
+	}
+
+	return accessToken, expiresAt, nil
+}
+
+// RevokeClientToken 撤销服务客户端的全部访问令牌（轮换密钥、禁用或删除客户端时调用）
+func (a *Authenticator) RevokeClientToken(ctx context.Context, clientType authenticationV1.ClientType, apiClientId uint32) error {
+	if a.userTokenCache == nil {
+		a.log.Error("userTokenCache is nil")
+		return authenticationV1.ErrorServiceUnavailable("token cache unavailable")
+	}
+
+	if apiClientId == 0 {
+		return authenticationV1.ErrorBadRequest("invalid api client id")
+	}
+
+	if err := a.userTokenCache.RevokeClientAllAccessToken(ctx, clientType, apiClientId); err != nil {
+		a.log.Errorf("revoke api client token failed: %v", err)
+		return err
+	}
+
+	return nil
 }
 
 // RevokeUserToken 撤销用户令牌
@@ -420,41 +891,142 @@ func (a *Authenticator) RevokeTokenByJti(ctx context.Context, clientType *authen
 	return a.userTokenCache.RevokeTokenByJti(ctx, authenticationV1.ClientType_app, userId, jti)
 }
 
-// VerifyRefreshToken 验证刷新令牌，并原子地吊销旧令牌对。
-// 使用 Lua 脚本保证「验证 RT → 删除 RT → 删除 AT」的原子性，避免 TOCTOU 竞态。
-func (a *Authenticator) VerifyRefreshToken(
+// ListUserSessions 列出用户的登录会话，按最近活跃时间倒序；currentSessionId 对应的会话标记为当前会话。
+func (a *Authenticator) ListUserSessions(
 	ctx context.Context,
 	clientType authenticationV1.ClientType,
 	userId uint32,
-	jti string,
+	currentSessionId string,
+) ([]*authenticationV1.UserSession, error) {
+	if userId == 0 {
+		return nil, authenticationV1.ErrorBadRequest("invalid user id")
+	}
+
+	records, err := a.userTokenCache.ListSessions(ctx, clientType, userId)
+	if err != nil {
+		return nil, authenticationV1.ErrorServiceUnavailable("list sessions failed")
+	}
+
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].LastSeenAt.After(records[j].LastSeenAt)
+	})
+
+	items := make([]*authenticationV1.UserSession, 0, len(records))
+	for _, record := range records {
+		items = append(items, userSessionToProto(record, clientType, userId, currentSessionId))
+	}
+	return items, nil
+}
+
+// RevokeUserSession 吊销用户的单个会话，该会话当前的访问令牌与刷新令牌立即失效，不影响其他会话。
+func (a *Authenticator) RevokeUserSession(ctx context.Context, clientType authenticationV1.ClientType, userId uint32, sessionId string) error {
+	if userId == 0 || sessionId == "" {
+		return authenticationV1.ErrorBadRequest("invalid session")
+	}
+
+	ok, err := a.userTokenCache.RevokeSession(ctx, clientType, userId, sessionId)
+	if err != nil {
+		return authenticationV1.ErrorServiceUnavailable("revoke session failed")
+	}
+	if !ok {
+		return authenticationV1.ErrorNotFound("session not found")
+	}
+	return nil
+}
+
+func userSessionToProto(record *UserSessionRecord, clientType authenticationV1.ClientType, userId uint32, currentSessionId string) *authenticationV1.UserSession {
+	item := &authenticationV1.UserSession{
+		SessionId:  record.SessionID,
+		UserId:     userId,
+		TenantId:   trans.Ptr(record.TenantID),
+		ClientType: clientType,
+		ClientId:   emptyToNil(record.ClientID),
+		DeviceId:   emptyToNil(record.DeviceID),
+		UserAgent:  emptyToNil(record.UserAgent),
+		IpAddress:  emptyToNil(record.IP),
+		Current:    currentSessionId != "" && record.SessionID == currentSessionId,
+	}
+	if record.Country != "" || record.Province != "" || record.City != "" || record.ISP != "" {
+		item.GeoLocation = &auditV1.GeoLocation{
+			CountryCode: emptyToNil(record.Country),
+			Province:    emptyToNil(record.Province),
+			City:        emptyToNil(record.City),
+			Isp:         emptyToNil(record.ISP),
+		}
+	}
+	if !record.CreatedAt.IsZero() {
+		item.CreatedAt = timestamppb.New(record.CreatedAt)
+	}
+	if !record.LastSeenAt.IsZero() {
+		item.LastSeenAt = timestamppb.New(record.LastSeenAt)
+	}
+	if !record.ExpiresAt.IsZero() {
+		item.ExpiresAt = timestamppb.New(record.ExpiresAt)
+	}
+	return item
+}
+
+// ConsumeRefreshToken 消费刷新令牌并返回其所属会话，旧令牌对随之失效，调用方应在该会话内签发新令牌对。
+// 刷新令牌只能使用一次：宽限期外再次出现已轮换的令牌视为泄露重放，吊销整个会话并返回 ErrRefreshTokenReused（附带会话信息用于审计）。
+func (a *Authenticator) ConsumeRefreshToken(
+	ctx context.Context,
+	clientType authenticationV1.ClientType,
 	refreshToken string,
-) (err error) {
+) (*RefreshTokenFamily, error) {
 	if a.userTokenCache == nil {
 		a.log.Error("userTokenCache is nil")
-		return authenticationV1.ErrorServiceUnavailable("token cache unavailable")
+		return nil, authenticationV1.ErrorServiceUnavailable("token cache unavailable")
 	}
-	if userId == 0 {
-		return authenticationV1.ErrorBadRequest("invalid user id")
+	if refreshToken == "" {
+		return nil, authenticationV1.ErrorBadRequest("refresh token is empty")
 	}
-	if jti == "" || refreshToken == "" {
-		return authenticationV1.ErrorBadRequest("jti or refresh token is empty")
+	if _, err := a.getAuthenticator(clientType); err != nil {
+		return nil, err
 	}
-	if _, err = a.getAuthenticator(clientType); err != nil {
-		return err
+
+	family, err := a.userTokenCache.ConsumeRefreshToken(ctx, clientType, refreshToken, RefreshTokenReuseGracePeriod)
+	switch {
+	case err == nil:
+		return family, nil
+	case errors.Is(err, ErrRefreshTokenReused):
+		a.log.Warnf("refresh token reuse detected for user [%d], session [%s] revoked", family.UserID, family.SessionID)
+		return family, err
+	case errors.Is(err, ErrRefreshTokenRotating):
+		return nil, authenticationV1.ErrorConflict("refresh token already rotated, retry with the latest token")
+	case errors.Is(err, ErrRefreshTokenInvalid):
+		return nil, authenticationV1.ErrorIncorrectRefreshToken("invalid refresh token")
+	default:
+		return nil, authenticationV1.ErrorServiceUnavailable("verify refresh token failed")
 	}
+}
 
-	// 原子验证并吊销旧令牌对（Lua 脚本保证原子性）
-	var valid bool
-	if valid, err = a.userTokenCache.VerifyAndRevokeTokenPair(ctx, clientType, userId, jti, refreshToken); err != nil {
-		a.log.Errorf("verify refresh token failed for user [%d]: [%s]", userId, err)
-		return authenticationV1.ErrorServiceUnavailable("verify refresh token failed")
+// PeekRefreshToken 查询刷新令牌所属会话但不消费令牌，供刷新前加载用户与权限；
+// 加载失败时令牌仍可重试，随后以 ConsumeRefreshToken 完成轮换。
+func (a *Authenticator) PeekRefreshToken(
+	ctx context.Context,
+	clientType authenticationV1.ClientType,
+	refreshToken string,
+) (*RefreshTokenFamily, error) {
+	if a.userTokenCache == nil {
+		a.log.Error("userTokenCache is nil")
+		return nil, authenticationV1.ErrorServiceUnavailable("token cache unavailable")
 	}
-	if !valid {
-		a.log.Errorf("invalid refresh token for user [%d]", userId)
-		return authenticationV1.ErrorIncorrectRefreshToken("invalid refresh token")
+	if refreshToken == "" {
+		return nil, authenticationV1.ErrorBadRequest("refresh token is empty")
+	}
+	if _, err := a.getAuthenticator(clientType); err != nil {
+		return nil, err
 	}
 
-	return nil
+	family, err := a.userTokenCache.PeekRefreshToken(ctx, clientType, refreshToken)
+	switch {
+	case err == nil:
+		return family, nil
+	case errors.Is(err, ErrRefreshTokenInvalid):
+		return nil, authenticationV1.ErrorIncorrectRefreshToken("invalid refresh token")
+	default:
+		return nil, authenticationV1.ErrorServiceUnavailable("verify refresh token failed")
+	}
 }
 
 // GetAccessTokens 获取用户的所有访问令牌
@@ -559,17 +1131,18 @@ func (a *Authenticator) getAuthenticator(clientType authenticationV1.ClientType)
 	return authenticator, nil
 }
 
-// newAccessToken 创建访问令牌
+// newAccessToken 创建访问令牌，expires 为有效期
 func (a *Authenticator) newAccessToken(
 	clientType authenticationV1.ClientType,
 	tokenPayload *authenticationV1.UserTokenPayload,
+	expires time.Duration,
 ) (accessToken string, err error) {
 	if tokenPayload == nil {
 		a.log.Error("token payload is nil")
 		return "", authenticationV1.ErrorBadRequest("token payload is nil")
 	}
 
-	expTime := time.Now().Add(a.GetAccessTokenExpires(clientType))
+	expTime := time.Now().Add(expires)
 	authClaims := jwt.NewUserTokenAuthClaims(tokenPayload, &expTime)
 
 	authenticator, err := a.getAuthenticator(clientType)
@@ -1,6 +1,7 @@
 package data
 
 import (
+	"context"
 	"encoding/base64"
 	"strings"
 	"testing"
@@ -183,3 +184,128 @@ func TestGetExpires_FallbackDefault(t *testing.T) {
 		t.Fatalf("refresh expires = %v, want default %v", got, DefaultRefreshTokenExpires)
 	}
 }
+
+// TestPublicJWKs_PublicAndDerivedKeyMatch 验证配置公钥与从私钥派生得到的 JWK 一致，对称算法不发布密钥。
+func TestPublicJWKs_PublicAndDerivedKeyMatch(t *testing.T) {
+	withPublic, err := newAdminAuthenticator(&conf.Authentication_Jwt{
+		Method:     "RS256",
+		PrivateKey: ptrString(testRSAPrivateKey),
+		PublicKey:  ptrString(testRSAPublicKey),
+	})
+	if err != nil {
+		t.Fatalf("newAdminAuthenticator(public) failed: %v", err)
+	}
+	derived, err := newAdminAuthenticator(&conf.Authentication_Jwt{
+		Method:     "RS256",
+		PrivateKey: ptrString(testRSAPrivateKey),
+	})
+	if err != nil {
+		t.Fatalf("newAdminAuthenticator(derived) failed: %v", err)
+	}
+
+	a := &Authenticator{AdminAuthenticator: withPublic}
+	b := &Authenticator{AdminAuthenticator: derived}
+	ka, _ := a.PublicJWKs()
+	kb, _ := b.PublicJWKs()
+	if len(ka) != 1 || len(kb) != 1 || ka[0].GetKid() != kb[0].GetKid() || ka[0].GetN() != kb[0].GetN() {
+		t.Fatalf("jwks mismatch: %v vs %v", ka, kb)
+	}
+	// 配置密钥的 kid 即公钥指纹，与令牌头一致
+	if ka[0].GetKid() != withPublic.Active().Kid {
+		t.Fatalf("jwk kid %q != signing kid %q", ka[0].GetKid(), withPublic.Active().Kid)
+	}
+
+	hsRing, err := newAdminAuthenticator(&conf.Authentication_Jwt{Method: "HS256", Key: "some_api_key"})
+	if err != nil {
+		t.Fatalf("newAdminAuthenticator(HS256) failed: %v", err)
+	}
+	hs := &Authenticator{AdminAuthenticator: hsRing}
+	if keys, err := hs.PublicJWKs(); err != nil || len(keys) != 0 {
+		t.Fatalf("HS256 must not publish keys, got %v (%v)", keys, err)
+	}
+}
+
+// TestPublicJWKs_IncludesRetiredKeys 验证轮换后 JWKS 同时发布活动密钥与校验期内的退役密钥。
+func TestPublicJWKs_IncludesRetiredKeys(t *testing.T) {
+	ring, err := newAdminAuthenticator(&conf.Authentication_Jwt{
+		Method:     "RS256",
+		PrivateKey: ptrString(testRSAPrivateKey),
+	})
+	if err != nil {
+		t.Fatalf("newAdminAuthenticator failed: %v", err)
+	}
+	retired := ring.Active()
+	retired.VerifyUntil = time.Now().Add(time.Hour)
+
+	next, material, err := jwt.GenerateSigningKey("", "RS256")
+	if err != nil {
+		t.Fatalf("GenerateSigningKey failed: %v", err)
+	}
+	if next.Kid, err = signingKeyID(next, material); err != nil {
+		t.Fatalf("signingKeyID failed: %v", err)
+	}
+	ring.Replace(next, retired)
+
+	a := &Authenticator{AdminAuthenticator: ring}
+	keys, err := a.PublicJWKs()
+	if err != nil || len(keys) != 2 {
+		t.Fatalf("expected 2 jwks, got %v (%v)", keys, err)
+	}
+	if keys[0].GetKid() != next.Kid || keys[1].GetKid() != retired.Kid {
+		t.Fatalf("unexpected jwks order: %s, %s", keys[0].GetKid(), keys[1].GetKid())
+	}
+}
+
+// TestSigningKeyFromConfig_StableKid 验证配置密钥的 kid 确定性派生，不同秘钥得到不同 kid。
+func TestSigningKeyFromConfig_StableKid(t *testing.T) {
+	k1, _, err := signingKeyFromConfig(&conf.Authentication_Jwt{Method: "HS256", Key: "some_api_key"})
+	if err != nil {
+		t.Fatalf("signingKeyFromConfig failed: %v", err)
+	}
+	k2, _, _ := signingKeyFromConfig(&conf.Authentication_Jwt{Method: "HS256", Key: "some_api_key"})
+	k3, _, _ := signingKeyFromConfig(&conf.Authentication_Jwt{Method: "HS256", Key: "another_api_key"})
+	if k1.Kid == "" || k1.Kid != k2.Kid || k1.Kid == k3.Kid {
+		t.Fatalf("unexpected kids: %q %q %q", k1.Kid, k2.Kid, k3.Kid)
+	}
+	if strings.Contains(k1.Kid, "some_api_key") {
+		t.Fatalf("kid must not leak the secret: %q", k1.Kid)
+	}
+}
+
+// TestAuthenticate_RejectsIDToken 验证 ID 令牌（携带 aud）不能当作访问令牌使用。
+func TestAuthenticate_RejectsIDToken(t *testing.T) {
+	jwtCfg := &conf.Authentication_Jwt{
+		Method:     "RS256",
+		PrivateKey: ptrString(testRSAPrivateKey),
+	}
+	adminAuth, err := newAdminAuthenticator(jwtCfg)
+	if err != nil {
+		t.Fatalf("newAdminAuthenticator failed: %v", err)
+	}
+
+	a := &Authenticator{
+		AdminAuthenticator: adminAuth,
+		jwtCfg:             jwtCfg,
+		issuer:             "https://id.example.com",
+	}
+
+	idToken, err := a.CreateIDToken(map[string]interface{}{
+		"iss": a.Issuer(),
+		"sub": "1",
+		"uid": 1,
+		"aud": "gwa_client",
+		"exp": time.Now().Add(time.Hour).Unix(),
+	})
+	if err != nil {
+		t.Fatalf("CreateIDToken failed: %v", err)
+	}
+
+	_, err = a.Authenticate(context.Background(), &authenticationV1.ValidateTokenRequest{
+		Token:         idToken,
+		ClientType:    authenticationV1.ClientType_admin,
+		TokenCategory: authenticationV1.TokenCategory_ACCESS,
+	})
+	if err == nil || !strings.Contains(err.Error(), "id token") {
+		t.Fatalf("expected id token rejection, got %v", err)
+	}
+}
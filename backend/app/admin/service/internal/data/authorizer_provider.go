@@ -14,6 +14,7 @@
 	permissionV1 "go-wind-admin/api/gen/go/permission/service/v1"
 
 	"go-wind-admin/pkg/authorizer"
+	"go-wind-admin/pkg/authorizer/zanzibar"
 	"go-wind-admin/pkg/constants"
 	appViewer "go-wind-admin/pkg/entgo/viewer"
 )
@@ -22,19 +23,22 @@
 type AuthorizerProvider struct {
 	log *log.Helper
 
-	roleRepo *RoleRepo
-	apiRepo  *ApiRepo
+	roleRepo          *RoleRepo
+	apiRepo           *ApiRepo
+	relationTupleRepo *RelationTupleRepo
 }
 
 func NewAuthorizerProvider(
 	ctx *bootstrap.Context,
 	roleRepo *RoleRepo,
 	apiRepo *ApiRepo,
+	relationTupleRepo *RelationTupleRepo,
 ) authorizer.Provider {
 	return &AuthorizerProvider{
-		log:      ctx.NewLoggerHelper("authorizer-data-provider/data/admin-service"),
-		roleRepo: roleRepo,
-		apiRepo:  apiRepo,
+		log:               ctx.NewLoggerHelper("authorizer-data-provider/data/admin-service"),
+		roleRepo:          roleRepo,
+		apiRepo:           apiRepo,
+		relationTupleRepo: relationTupleRepo,
 	}
 }
 
@@ -47,10 +51,19 @@ func (p *AuthorizerProvider) ProvideModels(engineName string) authorizer.ModelDa
 		return map[string][]byte{
 			"rbac.rego": assets.OpaRbacRego,
 		}
+	case "zanzibar":
+		return map[string][]byte{
+			"namespaces.json": assets.ZanzibarNamespaces,
+		}
 	}
 	return nil
 }
 
+// ProvideTupleStore 提供关系元组存储
+func (p *AuthorizerProvider) ProvideTupleStore() zanzibar.Store {
+	return p.relationTupleRepo
+}
+
 // ProvidePolicies 提供策略数据
 func (p *AuthorizerProvider) ProvidePolicies(_ context.Context) (authorizer.PermissionDataMap, error) {
 	// 策略装载需要全量角色/权限数据，统一以 SystemViewer 跑，忽略调用方 ctx 的 viewer
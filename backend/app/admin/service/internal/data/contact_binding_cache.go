@@ -0,0 +1,178 @@
+package data
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+)
+
+// ContactKind 可绑定的联系方式类别。
+type ContactKind string
+
+const (
+	ContactKindEmail ContactKind = "email"
+	ContactKindPhone ContactKind = "phone"
+)
+
+const (
+	// ContactBindingTTL 待验证的联系方式变更（含验证码）的有效期。
+	ContactBindingTTL = 10 * time.Minute
+	// ContactBindingResendCooldown 同一用户同一类别两次下发验证码的最小间隔。
+	ContactBindingResendCooldown = 60 * time.Second
+	// ContactBindingLockWindow 失败计数窗口：窗口内失败达 MaxContactBindingFailures 次即锁定至窗口结束。
+	ContactBindingLockWindow = 15 * time.Minute
+	// MaxContactBindingFailures 锁定窗口内允许的验证码错误次数。
+	MaxContactBindingFailures = 5
+
+	// 待验证变更 key：contact:bind:<tenant_id>:<user_id>:<kind>（每个用户每种类别同时只有一个待验证变更）
+	contactBindingKeyFmt = "contact:bind:%d:%d:%s"
+	// 下发冷却 key
+	contactBindingResendKeyFmt = "contact:bind:resend:%d:%d:%s"
+	// 失败计数 key
+	contactBindingFailKeyFmt = "contact:bind:fail:%d:%d:%s"
+)
+
+var (
+	// ErrContactBindingNotFound 无待验证的变更：未申请、已过期、已被使用，或地址与申请时不符
+	ErrContactBindingNotFound = errors.New("contact binding not found")
+	// ErrContactBindingMismatch 验证码错误
+	ErrContactBindingMismatch = errors.New("contact binding code mismatch")
+	// ErrContactBindingLocked 验证码错误次数达上限，锁定期内拒绝下发与校验
+	ErrContactBindingLocked = errors.New("contact binding locked")
+)
+
+// ContactBinding 一次待验证的联系方式变更（验证码只存摘要）。
+type ContactBinding struct {
+	Address  string
+	CodeHash string
+}
+
+// ContactBindingCache 个人资料换绑手机号/邮箱的验证码缓存。
+type ContactBindingCache struct {
+	rdb *redis.Client
+	log *log.Helper
+}
+
+func NewContactBindingCache(ctx *bootstrap.Context, rdb *redis.Client) *ContactBindingCache {
+	return &ContactBindingCache{
+		rdb: rdb,
+		log: ctx.NewLoggerHelper("contact-binding-cache/data/admin-service"),
+	}
+}
+
+// TryAcquireResend 验证码下发冷却：冷却期内只允许下发一次，未获取到时返回剩余冷却时间。
+func (c *ContactBindingCache) TryAcquireResend(ctx context.Context, tenantID, userID uint32, kind ContactKind) (bool, time.Duration) {
+	key := fmt.Sprintf(contactBindingResendKeyFmt, tenantID, userID, kind)
+	ok, err := c.rdb.SetNX(ctx, key, 1, ContactBindingResendCooldown).Result()
+	if err != nil {
+		// 短信/邮件有实际成本，Redis 异常拒绝下发（fail-closed）
+		c.log.Errorf("acquire contact binding resend cooldown failed: %s", err.Error())
+		return false, ContactBindingResendCooldown
+	}
+	if ok {
+		return true, 0
+	}
+	ttl, _ := c.rdb.TTL(ctx, key).Result()
+	if ttl <= 0 {
+		ttl = time.Second
+	}
+	return false, ttl
+}
+
+// IsLocked 是否因验证码错误次数过多而处于锁定期。Redis 异常按锁定处理（fail-closed）。
+func (c *ContactBindingCache) IsLocked(ctx context.Context, tenantID, userID uint32, kind ContactKind) bool {
+	n, err := c.rdb.Get(ctx, fmt.Sprintf(contactBindingFailKeyFmt, tenantID, userID, kind)).Int()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return false
+		}
+		c.log.Errorf("query contact binding fail count failed: %s", err.Error())
+		return true
+	}
+	return n >= MaxContactBindingFailures
+}
+
+// SetPending 写入待验证的新地址与验证码，覆盖此前的申请（重发即作废旧码）。
+func (c *ContactBindingCache) SetPending(ctx context.Context, tenantID, userID uint32, kind ContactKind, address, code string) error {
+	raw, err := json.Marshal(&ContactBinding{
+		Address:  address,
+		CodeHash: hashContactBindingCode(tenantID, userID, kind, address, code),
+	})
+	if err != nil {
+		return fmt.Errorf("marshal contact binding failed: %w", err)
+	}
+	if err = c.rdb.Set(ctx, fmt.Sprintf(contactBindingKeyFmt, tenantID, userID, kind), raw, ContactBindingTTL).Err(); err != nil {
+		c.log.Errorf("set contact binding failed: %s", err.Error())
+		return fmt.Errorf("set contact binding failed")
+	}
+	return nil
+}
+
+// Verify 校验待验证变更的地址与验证码，通过即消耗（单次有效）并清零失败计数。
+//
+// 返回：
+//   - ErrContactBindingNotFound：无待验证变更，或地址与申请时不符；
+//   - ErrContactBindingMismatch：验证码错误，计入失败次数；
+//   - ErrContactBindingLocked：失败次数达上限（本次错误触发时一并作废待验证变更）。
+func (c *ContactBindingCache) Verify(ctx context.Context, tenantID, userID uint32, kind ContactKind, address, code string) error {
+	if c.IsLocked(ctx, tenantID, userID, kind) {
+		return ErrContactBindingLocked
+	}
+
+	key := fmt.Sprintf(contactBindingKeyFmt, tenantID, userID, kind)
+	raw, err := c.rdb.Get(ctx, key).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return ErrContactBindingNotFound
+		}
+		c.log.Errorf("get contact binding failed: %s", err.Error())
+		return fmt.Errorf("get contact binding failed")
+	}
+	var pending ContactBinding
+	if err = json.Unmarshal(raw, &pending); err != nil {
+		return fmt.Errorf("unmarshal contact binding failed: %w", err)
+	}
+	if pending.Address != address {
+		return ErrContactBindingNotFound
+	}
+
+	failKey := fmt.Sprintf(contactBindingFailKeyFmt, tenantID, userID, kind)
+	if subtle.ConstantTimeCompare([]byte(hashContactBindingCode(tenantID, userID, kind, address, code)), []byte(pending.CodeHash)) != 1 {
+		n, ierr := c.rdb.Incr(ctx, failKey).Result()
+		if ierr != nil {
+			c.log.Errorf("incr contact binding fail count failed: %s", ierr.Error())
+			c.rdb.Del(ctx, key)
+			return ErrContactBindingLocked
+		}
+		if n == 1 {
+			c.rdb.Expire(ctx, failKey, ContactBindingLockWindow)
+		}
+		if n >= MaxContactBindingFailures {
+			c.rdb.Del(ctx, key)
+			return ErrContactBindingLocked
+		}
+		return ErrContactBindingMismatch
+	}
+
+	// DEL 返回 0 说明并发请求已用掉该验证码
+	if n, derr := c.rdb.Del(ctx, key).Result(); derr != nil || n == 0 {
+		return ErrContactBindingNotFound
+	}
+	c.rdb.Del(ctx, failKey)
+	return nil
+}
+
+// hashContactBindingCode 验证码摘要：以归属与新地址加盐，验证码明文不落 Redis。
+func hashContactBindingCode(tenantID, userID uint32, kind ContactKind, address, code string) string {
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%d:%d:%s:%s:%s", tenantID, userID, kind, address, code)))
+	return hex.EncodeToString(sum[:])
+}
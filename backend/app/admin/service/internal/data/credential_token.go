@@ -0,0 +1,224 @@
+package data
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"time"
+
+	"go-wind-admin/app/admin/service/internal/data/ent"
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+	"go-wind-admin/app/admin/service/internal/data/ent/usercredential"
+
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+)
+
+// CredentialTokenKind 凭证一次性令牌类别，令牌记录在用户名密码凭证行上：
+// 库中只存 SHA-256 哈希，限时、单次有效，重新签发即作废旧令牌。
+type CredentialTokenKind int
+
+const (
+	// CredentialTokenReset 找回密码，仅限已启用的凭证
+	CredentialTokenReset CredentialTokenKind = iota
+	// CredentialTokenActivate 账号激活，仅限待激活（UNVERIFIED）的凭证，使用后凭证转为启用
+	CredentialTokenActivate
+)
+
+// credentialTokenBytes 令牌随机字节数（256 位，不可枚举）。
+const credentialTokenBytes = 32
+
+// errInvalidCredentialToken 令牌不存在、已过期、已使用或凭证状态不符时统一返回，不区分具体原因。
+func errInvalidCredentialToken() error {
+	return authenticationV1.ErrorBadRequest("invalid or expired token")
+}
+
+func hashCredentialToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func newCredentialToken() (string, error) {
+	b := make([]byte, credentialTokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// credentialStatus 令牌类别要求的凭证状态。
+func (k CredentialTokenKind) credentialStatus() usercredential.Status {
+	if k == CredentialTokenActivate {
+		return usercredential.StatusUnverified
+	}
+	return usercredential.StatusEnabled
+}
+
+// validPredicates 令牌哈希匹配、未使用且未过期。
+func (k CredentialTokenKind) validPredicates(hash string, now time.Time) []predicate.UserCredential {
+	if k == CredentialTokenActivate {
+		return []predicate.UserCredential{
+			usercredential.ActivateTokenHashEQ(hash),
+			usercredential.ActivateTokenUsedAtIsNil(),
+			usercredential.ActivateTokenExpiresAtGT(now),
+		}
+	}
+	return []predicate.UserCredential{
+		usercredential.ResetTokenHashEQ(hash),
+		usercredential.ResetTokenUsedAtIsNil(),
+		usercredential.ResetTokenExpiresAtGT(now),
+	}
+}
+
+// IssueCredentialToken 为用户的用户名密码凭证签发一次性令牌，返回令牌明文（仅此一次可见）。
+// 凭证不存在返回 USER_NOT_FOUND；凭证状态与令牌类别不符时：找回密码返回 USER_FREEZE，激活返回 BAD_REQUEST。
+func (r *UserCredentialRepo) IssueCredentialToken(ctx context.Context, kind CredentialTokenKind, tenantID, userID uint32, ttl time.Duration) (string, error) {
+	entity, err := r.entClient.Client().UserCredential.Query().
+		Select(usercredential.FieldID, usercredential.FieldStatus).
+		Where(
+			usercredential.TenantIDEQ(tenantID),
+			usercredential.UserIDEQ(userID),
+			usercredential.IdentityTypeEQ(usercredential.IdentityTypeUsername),
+			usercredential.CredentialTypeEQ(usercredential.CredentialTypePasswordHash),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return "", authenticationV1.ErrorUserNotFound("user credential not found")
+		}
+		r.log.Errorf("query credential failed: %s", err.Error())
+		return "", authenticationV1.ErrorInternalServerError("query credential failed")
+	}
+
+	if entity.Status == nil || *entity.Status != kind.credentialStatus() {
+		if kind == CredentialTokenActivate {
+			return "", authenticationV1.ErrorBadRequest("user already activated")
+		}
+		return "", authenticationV1.ErrorUserFreeze("user credential is not enabled")
+	}
+
+	token, err := newCredentialToken()
+	if err != nil {
+		r.log.Errorf("generate credential token failed: %s", err.Error())
+		return "", authenticationV1.ErrorInternalServerError("generate token failed")
+	}
+
+	hash := hashCredentialToken(token)
+	expiresAt := time.Now().Add(ttl)
+	builder := r.entClient.Client().UserCredential.UpdateOneID(entity.ID)
+	if kind == CredentialTokenActivate {
+		builder.
+			SetActivateTokenHash(hash).
+			SetActivateTokenExpiresAt(expiresAt).
+			ClearActivateTokenUsedAt()
+	} else {
+		builder.
+			SetResetTokenHash(hash).
+			SetResetTokenExpiresAt(expiresAt).
+			ClearResetTokenUsedAt()
+	}
+	if err = builder.Exec(ctx); err != nil {
+		r.log.Errorf("save credential token failed: %s", err.Error())
+		return "", authenticationV1.ErrorInternalServerError("save token failed")
+	}
+
+	return token, nil
+}
+
+// ConsumeCredentialToken 校验一次性令牌并设置新密码（明文），返回凭证归属的租户与用户。
+// 新密码按密码策略校验（含历史密码）；令牌在同一条 UPDATE 中标记为已使用，并发重放只有一次成功。
+func (r *UserCredentialRepo) ConsumeCredentialToken(ctx context.Context, kind CredentialTokenKind, token, plainPassword string) (tenantID, userID uint32, err error) {
+	if token == "" {
+		return 0, 0, errInvalidCredentialToken()
+	}
+
+	hash := hashCredentialToken(token)
+	now := time.Now()
+
+	where := append(kind.validPredicates(hash, now),
+		usercredential.StatusEQ(kind.credentialStatus()),
+		usercredential.CredentialTypeEQ(usercredential.CredentialTypePasswordHash),
+	)
+	entity, err := r.entClient.Client().UserCredential.Query().
+		Select(
+			usercredential.FieldID,
+			usercredential.FieldTenantID,
+			usercredential.FieldUserID,
+			usercredential.FieldIdentityType,
+			usercredential.FieldIdentifier,
+			usercredential.FieldCredentialType,
+			usercredential.FieldCredential,
+			usercredential.FieldCredentialHistory,
+		).
+		Where(where...).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) || ent.IsNotSingular(err) {
+			return 0, 0, errInvalidCredentialToken()
+		}
+		r.log.Errorf("query credential by token failed: %s", err.Error())
+		return 0, 0, authenticationV1.ErrorInternalServerError("query credential failed")
+	}
+
+	tenantID = derefUint32(entity.TenantID)
+	history, err := r.applyPasswordPolicy(ctx, r.entClient.Client(), tenantID,
+		passwordSubjectUsername(entity.IdentityType, derefStr(entity.Identifier)), plainPassword, entity)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	newCredential, err := r.prepareCredential(entity.CredentialType, plainPassword)
+	if err != nil || newCredential == "" {
+		return 0, 0, authenticationV1.ErrorBadRequest("new credential cannot be empty")
+	}
+
+	builder := r.entClient.Client().UserCredential.Update().
+		Where(append(kind.validPredicates(hash, now), usercredential.IDEQ(entity.ID))...).
+		SetCredential(newCredential).
+		SetCredentialChangedAt(now).
+		SetCredentialHistory(history).
+		SetUpdatedAt(now)
+	if kind == CredentialTokenActivate {
+		builder.
+			SetActivateTokenUsedAt(now).
+			SetStatus(usercredential.StatusEnabled)
+	} else {
+		builder.SetResetTokenUsedAt(now)
+	}
+
+	affected, err := builder.Save(ctx)
+	if err != nil {
+		r.log.Errorf("update credential by token failed: %s", err.Error())
+		return 0, 0, authenticationV1.ErrorInternalServerError("update credential failed")
+	}
+	if affected == 0 {
+		return 0, 0, errInvalidCredentialToken()
+	}
+
+	return tenantID, derefUint32(entity.UserID), nil
+}
+
+// FindUserIDByUsername 按用户名密码凭证查归属用户，未找到返回 USER_NOT_FOUND。
+func (r *UserCredentialRepo) FindUserIDByUsername(ctx context.Context, tenantID uint32, username string) (uint32, error) {
+	entity, err := r.entClient.Client().UserCredential.Query().
+		Select(usercredential.FieldUserID).
+		Where(
+			usercredential.TenantIDEQ(tenantID),
+			usercredential.IdentityTypeEQ(usercredential.IdentityTypeUsername),
+			usercredential.IdentifierEQ(username),
+			usercredential.CredentialTypeEQ(usercredential.CredentialTypePasswordHash),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return 0, authenticationV1.ErrorUserNotFound("user not found")
+		}
+		r.log.Errorf("query credential failed: %s", err.Error())
+		return 0, authenticationV1.ErrorInternalServerError("query credential failed")
+	}
+	if entity.UserID == nil {
+		return 0, authenticationV1.ErrorUserNotFound("user not found")
+	}
+	return *entity.UserID, nil
+}
@@ -0,0 +1,117 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tx7do/go-utils/trans"
+	conf "github.com/tx7do/kratos-bootstrap/api/gen/go/conf/v1"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"go-wind-admin/app/admin/service/internal/data/ent/usercredential"
+	"go-wind-admin/app/admin/service/internal/data/enttest"
+
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+)
+
+func TestUserCredentialRepo_CredentialToken(t *testing.T) {
+	entClient := enttest.NewEntClientForTest(t)
+	bctx := bootstrap.NewContextWithParam(context.Background(), &conf.AppInfo{}, &conf.Bootstrap{}, log.DefaultLogger)
+	ctx := enttest.NewSystemViewerCtx(context.Background())
+	repo := NewUserCredentialRepo(bctx, entClient, NewPasswordCrypto(), nil)
+
+	const tenantID = 9161
+
+	create := func(userID uint32, username string, status authenticationV1.UserCredential_Status) {
+		require.NoError(t, repo.Create(ctx, &authenticationV1.CreateUserCredentialRequest{Data: &authenticationV1.UserCredential{
+			UserId:         trans.Ptr(userID),
+			TenantId:       trans.Ptr(uint32(tenantID)),
+			IdentityType:   authenticationV1.UserCredential_USERNAME.Enum(),
+			Identifier:     trans.Ptr(username),
+			CredentialType: authenticationV1.UserCredential_PASSWORD_HASH.Enum(),
+			Credential:     trans.Ptr("Old#Pass1"),
+			Status:         status.Enum(),
+		}}))
+	}
+	login := func(username, password string) error {
+		_, err := repo.FindUserCredential(ctx, tenantID, authenticationV1.UserCredential_USERNAME, username, password, false)
+		return err
+	}
+
+	t.Run("reset", func(t *testing.T) {
+		create(9162, "reset9162", authenticationV1.UserCredential_ENABLED)
+
+		uid, err := repo.FindUserIDByUsername(ctx, tenantID, "reset9162")
+		require.NoError(t, err)
+		assert.Equal(t, uint32(9162), uid)
+
+		// 激活令牌只能签发给待激活凭证
+		_, err = repo.IssueCredentialToken(ctx, CredentialTokenActivate, tenantID, 9162, time.Hour)
+		assert.True(t, authenticationV1.IsBadRequest(err))
+
+		first, err := repo.IssueCredentialToken(ctx, CredentialTokenReset, tenantID, 9162, time.Hour)
+		require.NoError(t, err)
+		token, err := repo.IssueCredentialToken(ctx, CredentialTokenReset, tenantID, 9162, time.Hour)
+		require.NoError(t, err)
+		assert.NotEqual(t, first, token)
+
+		// 库中只存哈希；重新签发即作废旧令牌；类别不可混用
+		entity, err := entClient.Client().UserCredential.Query().Where(usercredential.IdentifierEQ("reset9162")).Only(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, hashCredentialToken(token), *entity.ResetTokenHash)
+		_, _, err = repo.ConsumeCredentialToken(ctx, CredentialTokenReset, first, "New#Pass2")
+		assert.True(t, authenticationV1.IsBadRequest(err))
+		_, _, err = repo.ConsumeCredentialToken(ctx, CredentialTokenActivate, token, "New#Pass2")
+		assert.True(t, authenticationV1.IsBadRequest(err))
+
+		tid, uid, err := repo.ConsumeCredentialToken(ctx, CredentialTokenReset, token, "New#Pass2")
+		require.NoError(t, err)
+		assert.Equal(t, uint32(tenantID), tid)
+		assert.Equal(t, uint32(9162), uid)
+		require.NoError(t, login("reset9162", "New#Pass2"))
+		assert.Error(t, login("reset9162", "Old#Pass1"))
+
+		// 单次有效
+		_, _, err = repo.ConsumeCredentialToken(ctx, CredentialTokenReset, token, "Other#Pass3")
+		assert.True(t, authenticationV1.IsBadRequest(err))
+
+		// 过期失效
+		expired, err := repo.IssueCredentialToken(ctx, CredentialTokenReset, tenantID, 9162, time.Hour)
+		require.NoError(t, err)
+		require.NoError(t, entClient.Client().UserCredential.UpdateOneID(entity.ID).
+			SetResetTokenExpiresAt(time.Now().Add(-time.Minute)).
+			Exec(ctx))
+		_, _, err = repo.ConsumeCredentialToken(ctx, CredentialTokenReset, expired, "Other#Pass3")
+		assert.True(t, authenticationV1.IsBadRequest(err))
+
+		_, err = repo.IssueCredentialToken(ctx, CredentialTokenReset, tenantID, 9999, time.Hour)
+		assert.True(t, authenticationV1.IsUserNotFound(err))
+	})
+
+	t.Run("activate", func(t *testing.T) {
+		create(9163, "activate9163", authenticationV1.UserCredential_UNVERIFIED)
+
+		// 待激活凭证不能登录，也不能找回密码
+		assert.Error(t, login("activate9163", "Old#Pass1"))
+		_, err := repo.IssueCredentialToken(ctx, CredentialTokenReset, tenantID, 9163, time.Hour)
+		assert.True(t, authenticationV1.IsUserFreeze(err))
+
+		token, err := repo.IssueCredentialToken(ctx, CredentialTokenActivate, tenantID, 9163, time.Hour)
+		require.NoError(t, err)
+		_, uid, err := repo.ConsumeCredentialToken(ctx, CredentialTokenActivate, token, "Chosen#Pass1")
+		require.NoError(t, err)
+		assert.Equal(t, uint32(9163), uid)
+		require.NoError(t, login("activate9163", "Chosen#Pass1"))
+
+		// 已激活后不能再签发激活令牌
+		_, err = repo.IssueCredentialToken(ctx, CredentialTokenActivate, tenantID, 9163, time.Hour)
+		assert.True(t, authenticationV1.IsBadRequest(err))
+	})
+
+	_, _, err := repo.ConsumeCredentialToken(ctx, CredentialTokenReset, "", "x")
+	assert.True(t, authenticationV1.IsBadRequest(err))
+}
@@ -0,0 +1,307 @@
+package data
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/go-crud/viewer"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"go-wind-admin/app/admin/service/internal/data/ent"
+	"go-wind-admin/app/admin/service/internal/data/ent/membership"
+	"go-wind-admin/app/admin/service/internal/data/ent/membershiporgunit"
+	"go-wind-admin/app/admin/service/internal/data/ent/role"
+	"go-wind-admin/app/admin/service/internal/data/ent/userorgunit"
+
+	permissionV1 "go-wind-admin/api/gen/go/permission/service/v1"
+
+	"go-wind-admin/pkg/constants"
+	appViewer "go-wind-admin/pkg/entgo/viewer"
+)
+
+// dataScopeCacheTTL 数据权限解析结果的进程内缓存时间。经管理接口变更角色或组织树时本实例立即失效，其他实例最多延迟该时长。
+const dataScopeCacheTTL = 30 * time.Second
+
+type cachedDataScopes struct {
+	scopes    []viewer.DataScope
+	expiresAt time.Time
+}
+
+// RoleDataScope 角色上配置的数据权限
+type RoleDataScope struct {
+	Scope      role.DataScope
+	OrgUnitIDs []uint32
+}
+
+// DataScopeResolver 数据权限解析：将租户用户各角色的数据权限展开为行过滤范围，多角色取最宽范围（并集）。
+//   - ALL：不过滤，任一角色为 ALL 即全部放行；未配置数据权限的角色同样视为 ALL；
+//   - UNIT_ONLY / UNIT_AND_CHILD：当前组织单元（令牌未携带时取用户所属的全部组织单元），后者按组织树路径展开全部下级；
+//   - SELECTED_UNITS：角色上配置的组织单元列表；
+//   - SELF：本人创建的数据（created_by）。
+type DataScopeResolver struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+
+	mu     sync.RWMutex
+	scopes map[string]cachedDataScopes
+}
+
+func NewDataScopeResolver(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *DataScopeResolver {
+	return &DataScopeResolver{
+		log:       ctx.NewLoggerHelper("data-scope-resolver/data/admin-service"),
+		entClient: entClient,
+		scopes:    make(map[string]cachedDataScopes),
+	}
+}
+
+// ResolveDataScopes 解析当前访问者的行过滤范围，实现数据权限中间件的 Resolver 接口。
+// 平台/系统视图不做行过滤，原样返回。
+func (r *DataScopeResolver) ResolveDataScopes(ctx context.Context, vc viewer.Context) ([]viewer.DataScope, error) {
+	if vc == nil || vc.IsPlatformContext() || vc.IsSystemContext() {
+		return nil, nil
+	}
+
+	roleCodes := append([]string(nil), vc.Roles()...)
+	sort.Strings(roleCodes)
+
+	key := strings.Join([]string{
+		strconv.FormatUint(vc.TenantID(), 10),
+		strconv.FormatUint(vc.UserID(), 10),
+		strconv.FormatUint(vc.OrgUnitID(), 10),
+		strings.Join(roleCodes, ","),
+	}, ":")
+	now := time.Now()
+
+	r.mu.RLock()
+	cached, ok := r.scopes[key]
+	r.mu.RUnlock()
+	if ok && now.Before(cached.expiresAt) {
+		return cached.scopes, nil
+	}
+
+	// 角色、组织树与成员关系的读取不受数据权限约束，以系统身份查询
+	sysCtx := appViewer.NewSystemViewerContext(ctx)
+
+	tenantID := uint32(vc.TenantID())
+	userID := uint32(vc.UserID())
+
+	roles, err := r.listRoleDataScopes(sysCtx, tenantID, roleCodes)
+	if err != nil {
+		return nil, err
+	}
+
+	scopes, err := MergeDataScopes(roles, userID,
+		func() ([]uint32, error) {
+			if vc.OrgUnitID() > 0 {
+				return []uint32{uint32(vc.OrgUnitID())}, nil
+			}
+			return r.listUserOrgUnitIDs(sysCtx, tenantID, userID)
+		},
+		func(ids []uint32) ([]uint32, error) {
+			return queryOrgUnitSubtree(sysCtx, r.entClient.Client(), ids)
+		},
+	)
+	if err != nil {
+		r.log.Errorf("resolve data scopes of user [%d] failed: %s", userID, err.Error())
+		return nil, permissionV1.ErrorInternalServerError("resolve data scopes failed")
+	}
+
+	r.mu.Lock()
+	r.scopes[key] = cachedDataScopes{scopes: scopes, expiresAt: now.Add(dataScopeCacheTTL)}
+	r.mu.Unlock()
+
+	return scopes, nil
+}
+
+// Invalidate 角色数据权限或组织树变更后清空缓存
+func (r *DataScopeResolver) Invalidate() {
+	r.mu.Lock()
+	r.scopes = make(map[string]cachedDataScopes)
+	r.mu.Unlock()
+}
+
+// MergeDataScopes 合并多个角色的数据权限，取最宽范围：ALL 优先，组织单元取并集，SELF 与组织单元并存。
+// userOrgUnits 返回用户当前组织单元，subtree 返回组织单元及其全部下级，均按需调用。
+// 没有任何可用范围（无角色、组织单元为空）时返回 NONE，由行过滤规则拒绝访问。
+func MergeDataScopes(
+	roles []RoleDataScope,
+	userID uint32,
+	userOrgUnits func() ([]uint32, error),
+	subtree func([]uint32) ([]uint32, error),
+) ([]viewer.DataScope, error) {
+	var (
+		self       bool
+		unitOnly   bool
+		unitTree   bool
+		unitIDs    = make(map[uint32]struct{})
+		ownedUnits []uint32
+	)
+
+	for _, rs := range roles {
+		switch rs.Scope {
+		case "", role.DataScopeAll:
+			return []viewer.DataScope{{ScopeType: viewer.ScopeTypeAll}}, nil
+		case role.DataScopeSelf:
+			self = true
+		case role.DataScopeUnitOnly:
+			unitOnly = true
+		case role.DataScopeUnitAndChild:
+			unitTree = true
+		case role.DataScopeSelectedUnits:
+			for _, id := range rs.OrgUnitIDs {
+				if id != 0 {
+					unitIDs[id] = struct{}{}
+				}
+			}
+		}
+	}
+
+	if unitOnly || unitTree {
+		var err error
+		if ownedUnits, err = userOrgUnits(); err != nil {
+			return nil, err
+		}
+	}
+	if unitTree && len(ownedUnits) > 0 {
+		tree, err := subtree(ownedUnits)
+		if err != nil {
+			return nil, err
+		}
+		ownedUnits = append(ownedUnits, tree...)
+	}
+	for _, id := range ownedUnits {
+		if id != 0 {
+			unitIDs[id] = struct{}{}
+		}
+	}
+
+	var scopes []viewer.DataScope
+	if len(unitIDs) > 0 {
+		ids := make([]uint64, 0, len(unitIDs))
+		for id := range unitIDs {
+			ids = append(ids, uint64(id))
+		}
+		sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+		scopes = append(scopes, viewer.DataScope{ScopeType: viewer.ScopeTypeUnit, TargetIDs: ids})
+	}
+	if self && userID != 0 {
+		scopes = append(scopes, viewer.DataScope{ScopeType: viewer.ScopeTypeSelf})
+	}
+	if len(scopes) == 0 {
+		scopes = append(scopes, viewer.DataScope{ScopeType: viewer.ScopeTypeNone})
+	}
+
+	return scopes, nil
+}
+
+// listRoleDataScopes 查询租户内启用角色的数据权限配置
+func (r *DataScopeResolver) listRoleDataScopes(ctx context.Context, tenantID uint32, codes []string) ([]RoleDataScope, error) {
+	if len(codes) == 0 {
+		return nil, nil
+	}
+
+	entities, err := r.entClient.Client().Role.Query().
+		Where(
+			role.TenantIDEQ(tenantID),
+			role.CodeIn(codes...),
+			role.StatusEQ(role.StatusOn),
+		).
+		Select(role.FieldDataScope, role.FieldDataScopeOrgUnitIds).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("query role data scopes failed: %s", err.Error())
+		return nil, permissionV1.ErrorInternalServerError("query role data scopes failed")
+	}
+
+	result := make([]RoleDataScope, 0, len(entities))
+	for _, e := range entities {
+		rs := RoleDataScope{OrgUnitIDs: e.DataScopeOrgUnitIds}
+		if e.DataScope != nil {
+			rs.Scope = *e.DataScope
+		}
+		result = append(result, rs)
+	}
+
+	return result, nil
+}
+
+// listUserOrgUnitIDs 查询用户在租户内有效的组织单元归属
+func (r *DataScopeResolver) listUserOrgUnitIDs(ctx context.Context, tenantID, userID uint32) ([]uint32, error) {
+	now := time.Now()
+
+	var (
+		ids []uint32
+		err error
+	)
+	switch constants.DefaultUserTenantRelationType {
+	case constants.UserTenantRelationOneToMany:
+		var memberships []*ent.Membership
+		if memberships, err = r.entClient.Client().Membership.Query().
+			Where(
+				membership.UserIDEQ(userID),
+				membership.TenantIDEQ(tenantID),
+				membership.Or(membership.StatusIsNil(), membership.StatusEQ(membership.StatusActive)),
+				membership.Or(membership.EndAtIsNil(), membership.EndAtGT(now)),
+			).
+			Select(membership.FieldID, membership.FieldOrgUnitID).
+			All(ctx); err != nil {
+			break
+		}
+
+		membershipIDs := make([]uint32, 0, len(memberships))
+		for _, m := range memberships {
+			membershipIDs = append(membershipIDs, m.ID)
+			if m.OrgUnitID != nil {
+				ids = append(ids, *m.OrgUnitID)
+			}
+		}
+		if len(membershipIDs) == 0 {
+			break
+		}
+
+		var unitIDs []int
+		if unitIDs, err = r.entClient.Client().MembershipOrgUnit.Query().
+			Where(
+				membershiporgunit.MembershipIDIn(membershipIDs...),
+				membershiporgunit.Or(membershiporgunit.StatusIsNil(), membershiporgunit.StatusEQ(membershiporgunit.StatusActive)),
+				membershiporgunit.Or(membershiporgunit.EndAtIsNil(), membershiporgunit.EndAtGT(now)),
+			).
+			Select(membershiporgunit.FieldOrgUnitID).
+			Ints(ctx); err != nil {
+			break
+		}
+		for _, id := range unitIDs {
+			ids = append(ids, uint32(id))
+		}
+
+	default:
+		var unitIDs []int
+		if unitIDs, err = r.entClient.Client().UserOrgUnit.Query().
+			Where(
+				userorgunit.UserIDEQ(userID),
+				userorgunit.TenantIDEQ(tenantID),
+				userorgunit.Or(userorgunit.StatusIsNil(), userorgunit.StatusEQ(userorgunit.StatusActive)),
+				userorgunit.Or(userorgunit.EndAtIsNil(), userorgunit.EndAtGT(now)),
+			).
+			Select(userorgunit.FieldOrgUnitID).
+			Ints(ctx); err != nil {
+			break
+		}
+		for _, id := range unitIDs {
+			ids = append(ids, uint32(id))
+		}
+	}
+	if err != nil {
+		r.log.Errorf("query org units of user [%d] failed: %s", userID, err.Error())
+		return nil, permissionV1.ErrorInternalServerError("query user org units failed")
+	}
+
+	return ids, nil
+}
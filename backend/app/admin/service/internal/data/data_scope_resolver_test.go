@@ -0,0 +1,336 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tx7do/go-crud/viewer"
+	"github.com/tx7do/go-utils/mapper"
+	"github.com/tx7do/go-utils/trans"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	identityV1 "go-wind-admin/api/gen/go/identity/service/v1"
+	"go-wind-admin/app/admin/service/internal/data/ent"
+	"go-wind-admin/app/admin/service/internal/data/ent/orgunit"
+	"go-wind-admin/app/admin/service/internal/data/ent/role"
+	"go-wind-admin/app/admin/service/internal/data/enttest"
+
+	appViewer "go-wind-admin/pkg/entgo/viewer"
+)
+
+const dataScopeTestTenantID = 1
+
+// dataScopeFixture 组织树 A → B → C 与独立的 D，每个组织单元一个职位；
+// 职位 A、D 由用户 1 创建，B、C 由用户 2 创建。
+type dataScopeFixture struct {
+	orgUnitRepo *OrgUnitRepo
+	resolver    *DataScopeResolver
+
+	units     map[string]uint32
+	positions map[uint32]string
+
+	permissions []string // 访问者的权限码
+}
+
+func newDataScopeFixture(t *testing.T) *dataScopeFixture {
+	t.Helper()
+
+	entClient := enttest.NewEntClientForTest(t)
+	logger := log.NewHelper(log.NewStdLogger(io.Discard))
+
+	orgUnitRepo := &OrgUnitRepo{
+		entClient:       entClient,
+		log:             logger,
+		mapper:          mapper.NewCopierMapper[identityV1.OrgUnit, ent.OrgUnit](),
+		typeConverter:   mapper.NewEnumTypeConverter[identityV1.OrgUnit_Type, orgunit.Type](identityV1.OrgUnit_Type_name, identityV1.OrgUnit_Type_value),
+		statusConverter: mapper.NewEnumTypeConverter[identityV1.OrgUnit_Status, orgunit.Status](identityV1.OrgUnit_Status_name, identityV1.OrgUnit_Status_value),
+	}
+	orgUnitRepo.init()
+
+	f := &dataScopeFixture{
+		orgUnitRepo: orgUnitRepo,
+		resolver: &DataScopeResolver{
+			entClient: entClient,
+			log:       logger,
+			scopes:    make(map[string]cachedDataScopes),
+		},
+		units:     make(map[string]uint32),
+		positions: make(map[uint32]string),
+	}
+
+	ctx := enttest.NewSystemViewerCtx(context.Background())
+
+	for _, u := range []struct{ name, parent string }{
+		{"A", ""}, {"B", "A"}, {"C", "B"}, {"D", ""},
+	} {
+		data := &identityV1.OrgUnit{
+			TenantId: trans.Ptr(uint32(dataScopeTestTenantID)),
+			Name:     trans.Ptr(u.name),
+		}
+		if u.parent != "" {
+			data.ParentId = trans.Ptr(f.units[u.parent])
+		}
+		dto, err := orgUnitRepo.Create(ctx, &identityV1.CreateOrgUnitRequest{Data: data})
+		require.NoError(t, err)
+		f.units[u.name] = dto.GetId()
+	}
+
+	for _, p := range []struct {
+		unit      string
+		createdBy uint32
+	}{
+		{"A", 1}, {"B", 2}, {"C", 2}, {"D", 1},
+	} {
+		pos, err := entClient.Client().Position.Create().
+			SetTenantID(dataScopeTestTenantID).
+			SetName("position " + p.unit).
+			SetCode("POS_" + p.unit).
+			SetOrgUnitID(f.units[p.unit]).
+			SetCreatedBy(p.createdBy).
+			Save(ctx)
+		require.NoError(t, err)
+		f.positions[pos.ID] = p.unit
+	}
+
+	roles := []struct {
+		code  string
+		scope *role.DataScope
+		units []uint32
+	}{
+		{"tree", trans.Ptr(role.DataScopeUnitAndChild), nil},
+		{"unit", trans.Ptr(role.DataScopeUnitOnly), nil},
+		{"selected", trans.Ptr(role.DataScopeSelectedUnits), []uint32{f.units["D"]}},
+		{"self", trans.Ptr(role.DataScopeSelf), nil},
+		{"all", trans.Ptr(role.DataScopeAll), nil},
+		{"legacy", nil, nil},
+	}
+	for _, r := range roles {
+		builder := entClient.Client().Role.Create().
+			SetTenantID(dataScopeTestTenantID).
+			SetName(r.code).
+			SetCode(r.code).
+			SetNillableDataScope(r.scope)
+		if r.units != nil {
+			builder.SetDataScopeOrgUnitIds(r.units)
+		}
+		require.NoError(t, builder.Exec(ctx))
+	}
+
+	return f
+}
+
+// viewerCtx 租户用户 1 在指定组织单元、以指定角色访问时的上下文，数据范围已解析
+func (f *dataScopeFixture) viewerCtx(t *testing.T, orgUnit string, roles ...string) context.Context {
+	t.Helper()
+
+	vc := appViewer.NewUserViewer(1, dataScopeTestTenantID, uint64(f.units[orgUnit]), "", identityV1.DataScope_DATA_SCOPE_UNSPECIFIED, roles, f.permissions)
+	ctx := context.Background()
+
+	scopes, err := f.resolver.ResolveDataScopes(ctx, vc)
+	require.NoError(t, err)
+	return viewer.WithContext(ctx, appViewer.WithDataScopes(vc, scopes))
+}
+
+// visibleUnits 以租户用户 1 的身份查询职位，返回可见职位所属的组织单元名
+func (f *dataScopeFixture) visibleUnits(t *testing.T, orgUnit string, roles ...string) ([]string, error) {
+	t.Helper()
+
+	ctx := f.viewerCtx(t, orgUnit, roles...)
+
+	ids, err := f.resolver.entClient.Client().Position.Query().IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(ids))
+	for _, id := range ids {
+		names = append(names, f.positions[id])
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+func TestDataScopeResolver_RowFilter(t *testing.T) {
+	f := newDataScopeFixture(t)
+
+	cases := []struct {
+		name    string
+		orgUnit string
+		roles   []string
+		want    []string
+	}{
+		{"本级", "B", []string{"unit"}, []string{"B"}},
+		{"本级及下级", "B", []string{"tree"}, []string{"B", "C"}},
+		{"指定组织单元", "B", []string{"selected"}, []string{"D"}},
+		{"本人创建", "B", []string{"self"}, []string{"A", "D"}},
+		{"多角色取并集", "B", []string{"tree", "selected"}, []string{"B", "C", "D"}},
+		{"组织单元与本人并存", "C", []string{"unit", "self"}, []string{"A", "C", "D"}},
+		{"全部数据优先", "B", []string{"self", "all"}, []string{"A", "B", "C", "D"}},
+		{"未配置视为全部", "B", []string{"legacy"}, []string{"A", "B", "C", "D"}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := f.visibleUnits(t, tc.orgUnit, tc.roles...)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+
+	// 没有任何可用范围时拒绝访问，而非放行
+	_, err := f.visibleUnits(t, "B")
+	assert.Error(t, err)
+}
+
+func TestDataScopeResolver_SubtreeFollowsMove(t *testing.T) {
+	f := newDataScopeFixture(t)
+	ctx := enttest.NewSystemViewerCtx(context.Background())
+
+	// 将 B（连同下级 C）移动到 D 下，下级路径随之重写
+	require.NoError(t, f.orgUnitRepo.Update(ctx, &identityV1.UpdateOrgUnitRequest{
+		Id:         f.units["B"],
+		Data:       &identityV1.OrgUnit{ParentId: trans.Ptr(f.units["D"])},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"parent_id"}},
+	}))
+
+	c, err := f.resolver.entClient.Client().OrgUnit.Get(ctx, f.units["C"])
+	require.NoError(t, err)
+	assert.True(t, isOrgUnitTreePath(*c.Path, f.units["C"]))
+
+	f.resolver.Invalidate()
+
+	got, err := f.visibleUnits(t, "D", "tree")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"B", "C", "D"}, got)
+
+	got, err = f.visibleUnits(t, "A", "tree")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"A"}, got)
+}
+
+func TestDataScopeResolver_UserRowFilter(t *testing.T) {
+	f := newDataScopeFixture(t)
+	db := f.resolver.entClient.Client()
+	ctx := enttest.NewSystemViewerCtx(context.Background())
+
+	// 用户 11~14 分别通过任职、成员关系、成员关系的附属组织单元归属 A、B、C、D；
+	// 用户 15 未归属任何组织单元，由用户 1 创建
+	for _, u := range []struct {
+		id        uint32
+		createdBy uint32
+	}{
+		{1, 0}, {11, 0}, {12, 0}, {13, 0}, {14, 0}, {15, 1},
+	} {
+		require.NoError(t, db.User.Create().
+			SetID(u.id).
+			SetTenantID(dataScopeTestTenantID).
+			SetUsername(fmt.Sprintf("user%d", u.id)).
+			SetCreatedBy(u.createdBy).
+			Exec(ctx))
+	}
+
+	for _, uo := range []struct {
+		userID uint32
+		unit   string
+	}{
+		{11, "A"}, {12, "B"},
+	} {
+		require.NoError(t, db.UserOrgUnit.Create().
+			SetTenantID(dataScopeTestTenantID).
+			SetUserID(uo.userID).
+			SetOrgUnitID(f.units[uo.unit]).
+			Exec(ctx))
+	}
+
+	require.NoError(t, db.Membership.Create().
+		SetTenantID(dataScopeTestTenantID).
+		SetUserID(13).
+		SetOrgUnitID(f.units["C"]).
+		Exec(ctx))
+
+	m, err := db.Membership.Create().
+		SetTenantID(dataScopeTestTenantID).
+		SetUserID(14).
+		Save(ctx)
+	require.NoError(t, err)
+	require.NoError(t, db.MembershipOrgUnit.Create().
+		SetTenantID(dataScopeTestTenantID).
+		SetMembershipID(m.ID).
+		SetOrgUnitID(f.units["D"]).
+		Exec(ctx))
+
+	cases := []struct {
+		name    string
+		orgUnit string
+		roles   []string
+		users   []uint32
+		members []uint32
+	}{
+		{"本级及下级", "B", []string{"tree"}, []uint32{1, 12, 13}, []uint32{13}},
+		{"指定组织单元", "B", []string{"selected"}, []uint32{1, 14}, nil},
+		{"本人创建", "B", []string{"self"}, []uint32{1, 15}, nil},
+		{"无可用范围仅本人", "B", nil, []uint32{1}, nil},
+		{"全部数据", "B", []string{"all"}, []uint32{1, 11, 12, 13, 14, 15}, []uint32{13, 14}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			vctx := f.viewerCtx(t, tc.orgUnit, tc.roles...)
+
+			users, err := db.User.Query().Order(ent.Asc("id")).IDs(vctx)
+			require.NoError(t, err)
+			assert.Equal(t, tc.users, users)
+
+			var members []uint32
+			require.NoError(t, db.Membership.Query().Order(ent.Asc("user_id")).
+				Select("user_id").Scan(vctx, &members))
+			assert.Equal(t, tc.members, members)
+		})
+	}
+
+	// 任职记录按组织单元过滤
+	uos, err := db.UserOrgUnit.Query().All(f.viewerCtx(t, "B", "tree"))
+	require.NoError(t, err)
+	require.Len(t, uos, 1)
+	assert.Equal(t, uint32(12), *uos[0].UserID)
+
+	// 范围外用户不可更新
+	f.permissions = []string{"user:edit"}
+	n, err := db.User.Update().SetNickname("x").Save(f.viewerCtx(t, "B", "tree"))
+	require.NoError(t, err)
+	assert.Equal(t, 3, n)
+	err = db.User.UpdateOneID(11).SetNickname("x").Exec(f.viewerCtx(t, "B", "tree"))
+	assert.True(t, ent.IsNotFound(err))
+}
+
+func TestMergeDataScopes(t *testing.T) {
+	noUnits := func() ([]uint32, error) { return nil, nil }
+	noTree := func([]uint32) ([]uint32, error) { return nil, nil }
+
+	scopes, err := MergeDataScopes(nil, 1, noUnits, noTree)
+	require.NoError(t, err)
+	assert.Equal(t, []viewer.DataScope{{ScopeType: viewer.ScopeTypeNone}}, scopes)
+
+	// 本级权限但用户没有组织单元：不产生空的组织单元范围
+	scopes, err = MergeDataScopes([]RoleDataScope{{Scope: role.DataScopeUnitOnly}}, 1, noUnits, noTree)
+	require.NoError(t, err)
+	assert.Equal(t, []viewer.DataScope{{ScopeType: viewer.ScopeTypeNone}}, scopes)
+
+	scopes, err = MergeDataScopes([]RoleDataScope{
+		{Scope: role.DataScopeSelectedUnits, OrgUnitIDs: []uint32{5, 3}},
+		{Scope: role.DataScopeUnitAndChild},
+		{Scope: role.DataScopeSelf},
+	}, 1,
+		func() ([]uint32, error) { return []uint32{3}, nil },
+		func([]uint32) ([]uint32, error) { return []uint32{3, 4}, nil },
+	)
+	require.NoError(t, err)
+	assert.Equal(t, []viewer.DataScope{
+		{ScopeType: viewer.ScopeTypeUnit, TargetIDs: []uint64{3, 4, 5}},
+		{ScopeType: viewer.ScopeTypeSelf},
+	}, scopes)
+}
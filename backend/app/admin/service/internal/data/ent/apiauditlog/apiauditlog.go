@@ -20,6 +20,10 @@
 	FieldUserID = "user_id"
 	// FieldUsername holds the string denoting the username field in the database.
 	FieldUsername = "username"
+	// FieldImpersonatorID holds the string denoting the impersonator_id field in the database.
+	FieldImpersonatorID = "impersonator_id"
+	// FieldImpersonatorName holds the string denoting the impersonator_name field in the database.
+	FieldImpersonatorName = "impersonator_name"
 	// FieldIPAddress holds the string denoting the ip_address field in the database.
 	FieldIPAddress = "ip_address"
 	// FieldGeoLocation holds the string denoting the geo_location field in the database.
@@ -77,6 +81,8 @@
 	FieldTenantID,
 	FieldUserID,
 	FieldUsername,
+	FieldImpersonatorID,
+	FieldImpersonatorName,
 	FieldIPAddress,
 	FieldGeoLocation,
 	FieldDeviceInfo,
@@ -154,6 +160,16 @@ func ByUsername(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUsername, opts...).ToFunc()
 }
 
+// ByImpersonatorID orders the results by the impersonator_id field.
+func ByImpersonatorID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldImpersonatorID, opts...).ToFunc()
+}
+
+// ByImpersonatorName orders the results by the impersonator_name field.
+func ByImpersonatorName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldImpersonatorName, opts...).ToFunc()
+}
+
 // ByIPAddress orders the results by the ip_address field.
 func ByIPAddress(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIPAddress, opts...).ToFunc()
@@ -74,6 +74,16 @@ func Username(v string) predicate.ApiAuditLog {
 	return predicate.ApiAuditLog(sql.FieldEQ(FieldUsername, v))
 }
 
+// ImpersonatorID applies equality check predicate on the "impersonator_id" field. It's identical to ImpersonatorIDEQ.
+func ImpersonatorID(v uint32) predicate.ApiAuditLog {
+	return predicate.ApiAuditLog(sql.FieldEQ(FieldImpersonatorID, v))
+}
+
+// ImpersonatorName applies equality check predicate on the "impersonator_name" field. It's identical to ImpersonatorNameEQ.
+func ImpersonatorName(v string) predicate.ApiAuditLog {
+	return predicate.ApiAuditLog(sql.FieldEQ(FieldImpersonatorName, v))
+}
+
 // IPAddress applies equality check predicate on the "ip_address" field. It's identical to IPAddressEQ.
 func IPAddress(v string) predicate.ApiAuditLog {
 	return predicate.ApiAuditLog(sql.FieldEQ(FieldIPAddress, v))
@@ -404,6 +414,131 @@ func UsernameContainsFold(v string) predicate.ApiAuditLog {
 	return predicate.ApiAuditLog(sql.FieldContainsFold(FieldUsername, v))
 }
 
+// ImpersonatorIDEQ applies the EQ predicate on the "impersonator_id" field.
+func ImpersonatorIDEQ(v uint32) predicate.ApiAuditLog {
+	return predicate.ApiAuditLog(sql.FieldEQ(FieldImpersonatorID, v))
+}
+
+// ImpersonatorIDNEQ applies the NEQ predicate on the "impersonator_id" field.
+func ImpersonatorIDNEQ(v uint32) predicate.ApiAuditLog {
+	return predicate.ApiAuditLog(sql.FieldNEQ(FieldImpersonatorID, v))
+}
+
+// ImpersonatorIDIn applies the In predicate on the "impersonator_id" field.
+func ImpersonatorIDIn(vs ...uint32) predicate.ApiAuditLog {
+	return predicate.ApiAuditLog(sql.FieldIn(FieldImpersonatorID, vs...))
+}
+
+// ImpersonatorIDNotIn applies the NotIn predicate on the "impersonator_id" field.
+func ImpersonatorIDNotIn(vs ...uint32) predicate.ApiAuditLog {
+	return predicate.ApiAuditLog(sql.FieldNotIn(FieldImpersonatorID, vs...))
+}
+
+// ImpersonatorIDGT applies the GT predicate on the "impersonator_id" field.
+func ImpersonatorIDGT(v uint32) predicate.ApiAuditLog {
+	return predicate.ApiAuditLog(sql.FieldGT(FieldImpersonatorID, v))
+}
+
+// ImpersonatorIDGTE applies the GTE predicate on the "impersonator_id" field.
+func ImpersonatorIDGTE(v uint32) predicate.ApiAuditLog {
+	return predicate.ApiAuditLog(sql.FieldGTE(FieldImpersonatorID, v))
+}
+
+// ImpersonatorIDLT applies the LT predicate on the "impersonator_id" field.
+func ImpersonatorIDLT(v uint32) predicate.ApiAuditLog {
+	return predicate.ApiAuditLog(sql.FieldLT(FieldImpersonatorID, v))
+}
+
+// ImpersonatorIDLTE applies the LTE predicate on the "impersonator_id" field.
+func ImpersonatorIDLTE(v uint32) predicate.ApiAuditLog {
+	return predicate.ApiAuditLog(sql.FieldLTE(FieldImpersonatorID, v))
+}
+
+// ImpersonatorIDIsNil applies the IsNil predicate on the "impersonator_id" field.
+func ImpersonatorIDIsNil() predicate.ApiAuditLog {
+	return predicate.ApiAuditLog(sql.FieldIsNull(FieldImpersonatorID))
+}
+
+// ImpersonatorIDNotNil applies the NotNil predicate on the "impersonator_id" field.
+func ImpersonatorIDNotNil() predicate.ApiAuditLog {
+	return predicate.ApiAuditLog(sql.FieldNotNull(FieldImpersonatorID))
+}
+
+// ImpersonatorNameEQ applies the EQ predicate on the "impersonator_name" field.
+func ImpersonatorNameEQ(v string) predicate.ApiAuditLog {
+	return predicate.ApiAuditLog(sql.FieldEQ(FieldImpersonatorName, v))
+}
+
+// ImpersonatorNameNEQ applies the NEQ predicate on the "impersonator_name" field.
+func ImpersonatorNameNEQ(v string) predicate.ApiAuditLog {
+	return predicate.ApiAuditLog(sql.FieldNEQ(FieldImpersonatorName, v))
+}
+
+// ImpersonatorNameIn applies the In predicate on the "impersonator_name" field.
+func ImpersonatorNameIn(vs ...string) predicate.ApiAuditLog {
+	return predicate.ApiAuditLog(sql.FieldIn(FieldImpersonatorName, vs...))
+}
+
+// ImpersonatorNameNotIn applies the NotIn predicate on the "impersonator_name" field.
+func ImpersonatorNameNotIn(vs ...string) predicate.ApiAuditLog {
+	return predicate.ApiAuditLog(sql.FieldNotIn(FieldImpersonatorName, vs...))
+}
+
+// ImpersonatorNameGT applies the GT predicate on the "impersonator_name" field.
+func ImpersonatorNameGT(v string) predicate.ApiAuditLog {
+	return predicate.ApiAuditLog(sql.FieldGT(FieldImpersonatorName, v))
+}
+
+// ImpersonatorNameGTE applies the GTE predicate on the "impersonator_name" field.
+func ImpersonatorNameGTE(v string) predicate.ApiAuditLog {
+	return predicate.ApiAuditLog(sql.FieldGTE(FieldImpersonatorName, v))
+}
+
+// ImpersonatorNameLT applies the LT predicate on the "impersonator_name" field.
+func ImpersonatorNameLT(v string) predicate.ApiAuditLog {
+	return predicate.ApiAuditLog(sql.FieldLT(FieldImpersonatorName, v))
+}
+
+// ImpersonatorNameLTE applies the LTE predicate on the "impersonator_name" field.
+func ImpersonatorNameLTE(v string) predicate.ApiAuditLog {
+	return predicate.ApiAuditLog(sql.FieldLTE(FieldImpersonatorName, v))
+}
+
+// ImpersonatorNameContains applies the Contains predicate on the "impersonator_name" field.
+func ImpersonatorNameContains(v string) predicate.ApiAuditLog {
+	return predicate.ApiAuditLog(sql.FieldContains(FieldImpersonatorName, v))
+}
+
+// ImpersonatorNameHasPrefix applies the HasPrefix predicate on the "impersonator_name" field.
+func ImpersonatorNameHasPrefix(v string) predicate.ApiAuditLog {
+	return predicate.ApiAuditLog(sql.FieldHasPrefix(FieldImpersonatorName, v))
+}
+
+// ImpersonatorNameHasSuffix applies the HasSuffix predicate on the "impersonator_name" field.
+func ImpersonatorNameHasSuffix(v string) predicate.ApiAuditLog {
+	return predicate.ApiAuditLog(sql.FieldHasSuffix(FieldImpersonatorName, v))
+}
+
+// ImpersonatorNameIsNil applies the IsNil predicate on the "impersonator_name" field.
+func ImpersonatorNameIsNil() predicate.ApiAuditLog {
+	return predicate.ApiAuditLog(sql.FieldIsNull(FieldImpersonatorName))
+}
+
+// ImpersonatorNameNotNil applies the NotNil predicate on the "impersonator_name" field.
+func ImpersonatorNameNotNil() predicate.ApiAuditLog {
+	return predicate.ApiAuditLog(sql.FieldNotNull(FieldImpersonatorName))
+}
+
+// ImpersonatorNameEqualFold applies the EqualFold predicate on the "impersonator_name" field.
+func ImpersonatorNameEqualFold(v string) predicate.ApiAuditLog {
+	return predicate.ApiAuditLog(sql.FieldEqualFold(FieldImpersonatorName, v))
+}
+
+// ImpersonatorNameContainsFold applies the ContainsFold predicate on the "impersonator_name" field.
+func ImpersonatorNameContainsFold(v string) predicate.ApiAuditLog {
+	return predicate.ApiAuditLog(sql.FieldContainsFold(FieldImpersonatorName, v))
+}
+
 // IPAddressEQ applies the EQ predicate on the "ip_address" field.
 func IPAddressEQ(v string) predicate.ApiAuditLog {
 	return predicate.ApiAuditLog(sql.FieldEQ(FieldIPAddress, v))
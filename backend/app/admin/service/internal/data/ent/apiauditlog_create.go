@@ -79,6 +79,34 @@ func (_c *ApiAuditLogCreate) SetNillableUsername(v *string) *ApiAuditLogCreate {
 	return _c
 }
 
+// SetImpersonatorID sets the "impersonator_id" field.
+func (_c *ApiAuditLogCreate) SetImpersonatorID(v uint32) *ApiAuditLogCreate {
+	_c.mutation.SetImpersonatorID(v)
+	return _c
+}
+
+// SetNillableImpersonatorID sets the "impersonator_id" field if the given value is not nil.
+func (_c *ApiAuditLogCreate) SetNillableImpersonatorID(v *uint32) *ApiAuditLogCreate {
+	if v != nil {
+		_c.SetImpersonatorID(*v)
+	}
+	return _c
+}
+
+// SetImpersonatorName sets the "impersonator_name" field.
+func (_c *ApiAuditLogCreate) SetImpersonatorName(v string) *ApiAuditLogCreate {
+	_c.mutation.SetImpersonatorName(v)
+	return _c
+}
+
+// SetNillableImpersonatorName sets the "impersonator_name" field if the given value is not nil.
+func (_c *ApiAuditLogCreate) SetNillableImpersonatorName(v *string) *ApiAuditLogCreate {
+	if v != nil {
+		_c.SetImpersonatorName(*v)
+	}
+	return _c
+}
+
 // SetIPAddress sets the "ip_address" field.
 func (_c *ApiAuditLogCreate) SetIPAddress(v string) *ApiAuditLogCreate {
 	_c.mutation.SetIPAddress(v)
@@ -493,6 +521,14 @@ func (_c *ApiAuditLogCreate) createSpec() (*ApiAuditLog, *sqlgraph.CreateSpec) {
 		_spec.SetField(apiauditlog.FieldUsername, field.TypeString, value)
 		_node.Username = &value
 	}
+	if value, ok := _c.mutation.ImpersonatorID(); ok {
+		_spec.SetField(apiauditlog.FieldImpersonatorID, field.TypeUint32, value)
+		_node.ImpersonatorID = &value
+	}
+	if value, ok := _c.mutation.ImpersonatorName(); ok {
+		_spec.SetField(apiauditlog.FieldImpersonatorName, field.TypeString, value)
+		_node.ImpersonatorName = &value
+	}
 	if value, ok := _c.mutation.IPAddress(); ok {
 		_spec.SetField(apiauditlog.FieldIPAddress, field.TypeString, value)
 		_node.IPAddress = &value
@@ -679,6 +715,48 @@ func (u *ApiAuditLogUpsert) ClearUsername() *ApiAuditLogUpsert {
 	return u
 }
 
+// SetImpersonatorID sets the "impersonator_id" field.
+func (u *ApiAuditLogUpsert) SetImpersonatorID(v uint32) *ApiAuditLogUpsert {
+	u.Set(apiauditlog.FieldImpersonatorID, v)
+	return u
+}
+
+// UpdateImpersonatorID sets the "impersonator_id" field to the value that was provided on create.
+func (u *ApiAuditLogUpsert) UpdateImpersonatorID() *ApiAuditLogUpsert {
+	u.SetExcluded(apiauditlog.FieldImpersonatorID)
+	return u
+}
+
+// AddImpersonatorID adds v to the "impersonator_id" field.
+func (u *ApiAuditLogUpsert) AddImpersonatorID(v uint32) *ApiAuditLogUpsert {
+	u.Add(apiauditlog.FieldImpersonatorID, v)
+	return u
+}
+
+// ClearImpersonatorID clears the value of the "impersonator_id" field.
+func (u *ApiAuditLogUpsert) ClearImpersonatorID() *ApiAuditLogUpsert {
+	u.SetNull(apiauditlog.FieldImpersonatorID)
+	return u
+}
+
+// SetImpersonatorName sets the "impersonator_name" field.
+func (u *ApiAuditLogUpsert) SetImpersonatorName(v string) *ApiAuditLogUpsert {
+	u.Set(apiauditlog.FieldImpersonatorName, v)
+	return u
+}
+
+// UpdateImpersonatorName sets the "impersonator_name" field to the value that was provided on create.
+func (u *ApiAuditLogUpsert) UpdateImpersonatorName() *ApiAuditLogUpsert {
+	u.SetExcluded(apiauditlog.FieldImpersonatorName)
+	return u
+}
+
+// ClearImpersonatorName clears the value of the "impersonator_name" field.
+func (u *ApiAuditLogUpsert) ClearImpersonatorName() *ApiAuditLogUpsert {
+	u.SetNull(apiauditlog.FieldImpersonatorName)
+	return u
+}
+
 // SetIPAddress sets the "ip_address" field.
 func (u *ApiAuditLogUpsert) SetIPAddress(v string) *ApiAuditLogUpsert {
 	u.Set(apiauditlog.FieldIPAddress, v)
@@ -1208,6 +1286,55 @@ func (u *ApiAuditLogUpsertOne) ClearUsername() *ApiAuditLogUpsertOne {
 	})
 }
 
+// SetImpersonatorID sets the "impersonator_id" field.
+func (u *ApiAuditLogUpsertOne) SetImpersonatorID(v uint32) *ApiAuditLogUpsertOne {
+	return u.Update(func(s *ApiAuditLogUpsert) {
+		s.SetImpersonatorID(v)
+	})
+}
+
+// AddImpersonatorID adds v to the "impersonator_id" field.
+func (u *ApiAuditLogUpsertOne) AddImpersonatorID(v uint32) *ApiAuditLogUpsertOne {
+	return u.Update(func(s *ApiAuditLogUpsert) {
+		s.AddImpersonatorID(v)
+	})
+}
+
+// UpdateImpersonatorID sets the "impersonator_id" field to the value that was provided on create.
+func (u *ApiAuditLogUpsertOne) UpdateImpersonatorID() *ApiAuditLogUpsertOne {
+	return u.Update(func(s *ApiAuditLogUpsert) {
+		s.UpdateImpersonatorID()
+	})
+}
+
+// ClearImpersonatorID clears the value of the "impersonator_id" field.
+func (u *ApiAuditLogUpsertOne) ClearImpersonatorID() *ApiAuditLogUpsertOne {
+	return u.Update(func(s *ApiAuditLogUpsert) {
+		s.ClearImpersonatorID()
+	})
+}
+
+// SetImpersonatorName sets the "impersonator_name" field.
+func (u *ApiAuditLogUpsertOne) SetImpersonatorName(v string) *ApiAuditLogUpsertOne {
+	return u.Update(func(s *ApiAuditLogUpsert) {
+		s.SetImpersonatorName(v)
+	})
+}
+
+// UpdateImpersonatorName sets the "impersonator_name" field to the value that was provided on create.
+func (u *ApiAuditLogUpsertOne) UpdateImpersonatorName() *ApiAuditLogUpsertOne {
+	return u.Update(func(s *ApiAuditLogUpsert) {
+		s.UpdateImpersonatorName()
+	})
+}
+
+// ClearImpersonatorName clears the value of the "impersonator_name" field.
+func (u *ApiAuditLogUpsertOne) ClearImpersonatorName() *ApiAuditLogUpsertOne {
+	return u.Update(func(s *ApiAuditLogUpsert) {
+		s.ClearImpersonatorName()
+	})
+}
+
 // SetIPAddress sets the "ip_address" field.
 func (u *ApiAuditLogUpsertOne) SetIPAddress(v string) *ApiAuditLogUpsertOne {
 	return u.Update(func(s *ApiAuditLogUpsert) {
@@ -1974,6 +2101,55 @@ func (u *ApiAuditLogUpsertBulk) ClearUsername() *ApiAuditLogUpsertBulk {
 	})
 }
 
+// SetImpersonatorID sets the "impersonator_id" field.
+func (u *ApiAuditLogUpsertBulk) SetImpersonatorID(v uint32) *ApiAuditLogUpsertBulk {
+	return u.Update(func(s *ApiAuditLogUpsert) {
+		s.SetImpersonatorID(v)
+	})
+}
+
+// AddImpersonatorID adds v to the "impersonator_id" field.
+func (u *ApiAuditLogUpsertBulk) AddImpersonatorID(v uint32) *ApiAuditLogUpsertBulk {
+	return u.Update(func(s *ApiAuditLogUpsert) {
+		s.AddImpersonatorID(v)
+	})
+}
+
+// UpdateImpersonatorID sets the "impersonator_id" field to the value that was provided on create.
+func (u *ApiAuditLogUpsertBulk) UpdateImpersonatorID() *ApiAuditLogUpsertBulk {
+	return u.Update(func(s *ApiAuditLogUpsert) {
+		s.UpdateImpersonatorID()
+	})
+}
+
+// ClearImpersonatorID clears the value of the "impersonator_id" field.
+func (u *ApiAuditLogUpsertBulk) ClearImpersonatorID() *ApiAuditLogUpsertBulk {
+	return u.Update(func(s *ApiAuditLogUpsert) {
+		s.ClearImpersonatorID()
+	})
+}
+
+// SetImpersonatorName sets the "impersonator_name" field.
+func (u *ApiAuditLogUpsertBulk) SetImpersonatorName(v string) *ApiAuditLogUpsertBulk {
+	return u.Update(func(s *ApiAuditLogUpsert) {
+		s.SetImpersonatorName(v)
+	})
+}
+
+// UpdateImpersonatorName sets the "impersonator_name" field to the value that was provided on create.
+func (u *ApiAuditLogUpsertBulk) UpdateImpersonatorName() *ApiAuditLogUpsertBulk {
+	return u.Update(func(s *ApiAuditLogUpsert) {
+		s.UpdateImpersonatorName()
+	})
+}
+
+// ClearImpersonatorName clears the value of the "impersonator_name" field.
+func (u *ApiAuditLogUpsertBulk) ClearImpersonatorName() *ApiAuditLogUpsertBulk {
+	return u.Update(func(s *ApiAuditLogUpsert) {
+		s.ClearImpersonatorName()
+	})
+}
+
 // SetIPAddress sets the "ip_address" field.
 func (u *ApiAuditLogUpsertBulk) SetIPAddress(v string) *ApiAuditLogUpsertBulk {
 	return u.Update(func(s *ApiAuditLogUpsert) {
@@ -76,6 +76,53 @@ func (_u *ApiAuditLogUpdate) ClearUsername() *ApiAuditLogUpdate {
 	return _u
 }
 
+// SetImpersonatorID sets the "impersonator_id" field.
+func (_u *ApiAuditLogUpdate) SetImpersonatorID(v uint32) *ApiAuditLogUpdate {
+	_u.mutation.ResetImpersonatorID()
+	_u.mutation.SetImpersonatorID(v)
+	return _u
+}
+
+// SetNillableImpersonatorID sets the "impersonator_id" field if the given value is not nil.
+func (_u *ApiAuditLogUpdate) SetNillableImpersonatorID(v *uint32) *ApiAuditLogUpdate {
+	if v != nil {
+		_u.SetImpersonatorID(*v)
+	}
+	return _u
+}
+
+// AddImpersonatorID adds value to the "impersonator_id" field.
+func (_u *ApiAuditLogUpdate) AddImpersonatorID(v int32) *ApiAuditLogUpdate {
+	_u.mutation.AddImpersonatorID(v)
+	return _u
+}
+
+// ClearImpersonatorID clears the value of the "impersonator_id" field.
+func (_u *ApiAuditLogUpdate) ClearImpersonatorID() *ApiAuditLogUpdate {
+	_u.mutation.ClearImpersonatorID()
+	return _u
+}
+
+// SetImpersonatorName sets the "impersonator_name" field.
+func (_u *ApiAuditLogUpdate) SetImpersonatorName(v string) *ApiAuditLogUpdate {
+	_u.mutation.SetImpersonatorName(v)
+	return _u
+}
+
+// SetNillableImpersonatorName sets the "impersonator_name" field if the given value is not nil.
+func (_u *ApiAuditLogUpdate) SetNillableImpersonatorName(v *string) *ApiAuditLogUpdate {
+	if v != nil {
+		_u.SetImpersonatorName(*v)
+	}
+	return _u
+}
+
+// ClearImpersonatorName clears the value of the "impersonator_name" field.
+func (_u *ApiAuditLogUpdate) ClearImpersonatorName() *ApiAuditLogUpdate {
+	_u.mutation.ClearImpersonatorName()
+	return _u
+}
+
 // SetIPAddress sets the "ip_address" field.
 func (_u *ApiAuditLogUpdate) SetIPAddress(v string) *ApiAuditLogUpdate {
 	_u.mutation.SetIPAddress(v)
@@ -594,6 +641,21 @@ func (_u *ApiAuditLogUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if _u.mutation.UsernameCleared() {
 		_spec.ClearField(apiauditlog.FieldUsername, field.TypeString)
 	}
+	if value, ok := _u.mutation.ImpersonatorID(); ok {
+		_spec.SetField(apiauditlog.FieldImpersonatorID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedImpersonatorID(); ok {
+		_spec.AddField(apiauditlog.FieldImpersonatorID, field.TypeUint32, value)
+	}
+	if _u.mutation.ImpersonatorIDCleared() {
+		_spec.ClearField(apiauditlog.FieldImpersonatorID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.ImpersonatorName(); ok {
+		_spec.SetField(apiauditlog.FieldImpersonatorName, field.TypeString, value)
+	}
+	if _u.mutation.ImpersonatorNameCleared() {
+		_spec.ClearField(apiauditlog.FieldImpersonatorName, field.TypeString)
+	}
 	if value, ok := _u.mutation.IPAddress(); ok {
 		_spec.SetField(apiauditlog.FieldIPAddress, field.TypeString, value)
 	}
@@ -807,6 +869,53 @@ func (_u *ApiAuditLogUpdateOne) ClearUsername() *ApiAuditLogUpdateOne {
 	return _u
 }
 
+// SetImpersonatorID sets the "impersonator_id" field.
+func (_u *ApiAuditLogUpdateOne) SetImpersonatorID(v uint32) *ApiAuditLogUpdateOne {
+	_u.mutation.ResetImpersonatorID()
+	_u.mutation.SetImpersonatorID(v)
+	return _u
+}
+
+// SetNillableImpersonatorID sets the "impersonator_id" field if the given value is not nil.
+func (_u *ApiAuditLogUpdateOne) SetNillableImpersonatorID(v *uint32) *ApiAuditLogUpdateOne {
+	if v != nil {
+		_u.SetImpersonatorID(*v)
+	}
+	return _u
+}
+
+// AddImpersonatorID adds value to the "impersonator_id" field.
+func (_u *ApiAuditLogUpdateOne) AddImpersonatorID(v int32) *ApiAuditLogUpdateOne {
+	_u.mutation.AddImpersonatorID(v)
+	return _u
+}
+
+// ClearImpersonatorID clears the value of the "impersonator_id" field.
+func (_u *ApiAuditLogUpdateOne) ClearImpersonatorID() *ApiAuditLogUpdateOne {
+	_u.mutation.ClearImpersonatorID()
+	return _u
+}
+
+// SetImpersonatorName sets the "impersonator_name" field.
+func (_u *ApiAuditLogUpdateOne) SetImpersonatorName(v string) *ApiAuditLogUpdateOne {
+	_u.mutation.SetImpersonatorName(v)
+	return _u
+}
+
+// SetNillableImpersonatorName sets the "impersonator_name" field if the given value is not nil.
+func (_u *ApiAuditLogUpdateOne) SetNillableImpersonatorName(v *string) *ApiAuditLogUpdateOne {
+	if v != nil {
+		_u.SetImpersonatorName(*v)
+	}
+	return _u
+}
+
+// ClearImpersonatorName clears the value of the "impersonator_name" field.
+func (_u *ApiAuditLogUpdateOne) ClearImpersonatorName() *ApiAuditLogUpdateOne {
+	_u.mutation.ClearImpersonatorName()
+	return _u
+}
+
 // SetIPAddress sets the "ip_address" field.
 func (_u *ApiAuditLogUpdateOne) SetIPAddress(v string) *ApiAuditLogUpdateOne {
 	_u.mutation.SetIPAddress(v)
@@ -1355,6 +1464,21 @@ func (_u *ApiAuditLogUpdateOne) sqlSave(ctx context.Context) (_node *ApiAuditLog
 	if _u.mutation.UsernameCleared() {
 		_spec.ClearField(apiauditlog.FieldUsername, field.TypeString)
 	}
+	if value, ok := _u.mutation.ImpersonatorID(); ok {
+		_spec.SetField(apiauditlog.FieldImpersonatorID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedImpersonatorID(); ok {
+		_spec.AddField(apiauditlog.FieldImpersonatorID, field.TypeUint32, value)
+	}
+	if _u.mutation.ImpersonatorIDCleared() {
+		_spec.ClearField(apiauditlog.FieldImpersonatorID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.ImpersonatorName(); ok {
+		_spec.SetField(apiauditlog.FieldImpersonatorName, field.TypeString, value)
+	}
+	if _u.mutation.ImpersonatorNameCleared() {
+		_spec.ClearField(apiauditlog.FieldImpersonatorName, field.TypeString)
+	}
 	if value, ok := _u.mutation.IPAddress(); ok {
 		_spec.SetField(apiauditlog.FieldIPAddress, field.TypeString, value)
 	}
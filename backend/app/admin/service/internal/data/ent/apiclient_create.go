@@ -0,0 +1,1900 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-wind-admin/app/admin/service/internal/data/ent/apiclient"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ApiClientCreate is the builder for creating a ApiClient entity.
+type ApiClientCreate struct {
+	config
+	mutation *ApiClientMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ApiClientCreate) SetCreatedAt(v time.Time) *ApiClientCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ApiClientCreate) SetNillableCreatedAt(v *time.Time) *ApiClientCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ApiClientCreate) SetUpdatedAt(v time.Time) *ApiClientCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ApiClientCreate) SetNillableUpdatedAt(v *time.Time) *ApiClientCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *ApiClientCreate) SetDeletedAt(v time.Time) *ApiClientCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *ApiClientCreate) SetNillableDeletedAt(v *time.Time) *ApiClientCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *ApiClientCreate) SetCreatedBy(v uint32) *ApiClientCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *ApiClientCreate) SetNillableCreatedBy(v *uint32) *ApiClientCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_c *ApiClientCreate) SetUpdatedBy(v uint32) *ApiClientCreate {
+	_c.mutation.SetUpdatedBy(v)
+	return _c
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_c *ApiClientCreate) SetNillableUpdatedBy(v *uint32) *ApiClientCreate {
+	if v != nil {
+		_c.SetUpdatedBy(*v)
+	}
+	return _c
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_c *ApiClientCreate) SetDeletedBy(v uint32) *ApiClientCreate {
+	_c.mutation.SetDeletedBy(v)
+	return _c
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_c *ApiClientCreate) SetNillableDeletedBy(v *uint32) *ApiClientCreate {
+	if v != nil {
+		_c.SetDeletedBy(*v)
+	}
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *ApiClientCreate) SetDescription(v string) *ApiClientCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *ApiClientCreate) SetNillableDescription(v *string) *ApiClientCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *ApiClientCreate) SetTenantID(v uint32) *ApiClientCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *ApiClientCreate) SetNillableTenantID(v *uint32) *ApiClientCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *ApiClientCreate) SetStatus(v apiclient.Status) *ApiClientCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *ApiClientCreate) SetNillableStatus(v *apiclient.Status) *ApiClientCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *ApiClientCreate) SetName(v string) *ApiClientCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_c *ApiClientCreate) SetNillableName(v *string) *ApiClientCreate {
+	if v != nil {
+		_c.SetName(*v)
+	}
+	return _c
+}
+
+// SetClientID sets the "client_id" field.
+func (_c *ApiClientCreate) SetClientID(v string) *ApiClientCreate {
+	_c.mutation.SetClientID(v)
+	return _c
+}
+
+// SetNillableClientID sets the "client_id" field if the given value is not nil.
+func (_c *ApiClientCreate) SetNillableClientID(v *string) *ApiClientCreate {
+	if v != nil {
+		_c.SetClientID(*v)
+	}
+	return _c
+}
+
+// SetClientSecretHash sets the "client_secret_hash" field.
+func (_c *ApiClientCreate) SetClientSecretHash(v string) *ApiClientCreate {
+	_c.mutation.SetClientSecretHash(v)
+	return _c
+}
+
+// SetNillableClientSecretHash sets the "client_secret_hash" field if the given value is not nil.
+func (_c *ApiClientCreate) SetNillableClientSecretHash(v *string) *ApiClientCreate {
+	if v != nil {
+		_c.SetClientSecretHash(*v)
+	}
+	return _c
+}
+
+// SetRoleIds sets the "role_ids" field.
+func (_c *ApiClientCreate) SetRoleIds(v []uint32) *ApiClientCreate {
+	_c.mutation.SetRoleIds(v)
+	return _c
+}
+
+// SetRedirectUris sets the "redirect_uris" field.
+func (_c *ApiClientCreate) SetRedirectUris(v []string) *ApiClientCreate {
+	_c.mutation.SetRedirectUris(v)
+	return _c
+}
+
+// SetScopes sets the "scopes" field.
+func (_c *ApiClientCreate) SetScopes(v []string) *ApiClientCreate {
+	_c.mutation.SetScopes(v)
+	return _c
+}
+
+// SetPublicClient sets the "public_client" field.
+func (_c *ApiClientCreate) SetPublicClient(v bool) *ApiClientCreate {
+	_c.mutation.SetPublicClient(v)
+	return _c
+}
+
+// SetNillablePublicClient sets the "public_client" field if the given value is not nil.
+func (_c *ApiClientCreate) SetNillablePublicClient(v *bool) *ApiClientCreate {
+	if v != nil {
+		_c.SetPublicClient(*v)
+	}
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *ApiClientCreate) SetExpiresAt(v time.Time) *ApiClientCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_c *ApiClientCreate) SetNillableExpiresAt(v *time.Time) *ApiClientCreate {
+	if v != nil {
+		_c.SetExpiresAt(*v)
+	}
+	return _c
+}
+
+// SetSecretRotatedAt sets the "secret_rotated_at" field.
+func (_c *ApiClientCreate) SetSecretRotatedAt(v time.Time) *ApiClientCreate {
+	_c.mutation.SetSecretRotatedAt(v)
+	return _c
+}
+
+// SetNillableSecretRotatedAt sets the "secret_rotated_at" field if the given value is not nil.
+func (_c *ApiClientCreate) SetNillableSecretRotatedAt(v *time.Time) *ApiClientCreate {
+	if v != nil {
+		_c.SetSecretRotatedAt(*v)
+	}
+	return _c
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (_c *ApiClientCreate) SetLastUsedAt(v time.Time) *ApiClientCreate {
+	_c.mutation.SetLastUsedAt(v)
+	return _c
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (_c *ApiClientCreate) SetNillableLastUsedAt(v *time.Time) *ApiClientCreate {
+	if v != nil {
+		_c.SetLastUsedAt(*v)
+	}
+	return _c
+}
+
+// SetLastUsedIP sets the "last_used_ip" field.
+func (_c *ApiClientCreate) SetLastUsedIP(v string) *ApiClientCreate {
+	_c.mutation.SetLastUsedIP(v)
+	return _c
+}
+
+// SetNillableLastUsedIP sets the "last_used_ip" field if the given value is not nil.
+func (_c *ApiClientCreate) SetNillableLastUsedIP(v *string) *ApiClientCreate {
+	if v != nil {
+		_c.SetLastUsedIP(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ApiClientCreate) SetID(v uint32) *ApiClientCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the ApiClientMutation object of the builder.
+func (_c *ApiClientCreate) Mutation() *ApiClientMutation {
+	return _c.mutation
+}
+
+// Save creates the ApiClient in the database.
+func (_c *ApiClientCreate) Save(ctx context.Context) (*ApiClient, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ApiClientCreate) SaveX(ctx context.Context) *ApiClient {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ApiClientCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ApiClientCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ApiClientCreate) defaults() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := apiclient.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := apiclient.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.PublicClient(); !ok {
+		v := apiclient.DefaultPublicClient
+		_c.mutation.SetPublicClient(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ApiClientCreate) check() error {
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "ApiClient.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := apiclient.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ApiClient.status": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := apiclient.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ApiClient.name": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ClientID(); ok {
+		if err := apiclient.ClientIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_id", err: fmt.Errorf(`ent: validator failed for field "ApiClient.client_id": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ClientSecretHash(); ok {
+		if err := apiclient.ClientSecretHashValidator(v); err != nil {
+			return &ValidationError{Name: "client_secret_hash", err: fmt.Errorf(`ent: validator failed for field "ApiClient.client_secret_hash": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.LastUsedIP(); ok {
+		if err := apiclient.LastUsedIPValidator(v); err != nil {
+			return &ValidationError{Name: "last_used_ip", err: fmt.Errorf(`ent: validator failed for field "ApiClient.last_used_ip": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := apiclient.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "ApiClient.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *ApiClientCreate) sqlSave(ctx context.Context) (*ApiClient, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint32(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ApiClientCreate) createSpec() (*ApiClient, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ApiClient{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(apiclient.Table, sqlgraph.NewFieldSpec(apiclient.FieldID, field.TypeUint32))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(apiclient.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = &value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(apiclient.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = &value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(apiclient.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(apiclient.FieldCreatedBy, field.TypeUint32, value)
+		_node.CreatedBy = &value
+	}
+	if value, ok := _c.mutation.UpdatedBy(); ok {
+		_spec.SetField(apiclient.FieldUpdatedBy, field.TypeUint32, value)
+		_node.UpdatedBy = &value
+	}
+	if value, ok := _c.mutation.DeletedBy(); ok {
+		_spec.SetField(apiclient.FieldDeletedBy, field.TypeUint32, value)
+		_node.DeletedBy = &value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(apiclient.FieldDescription, field.TypeString, value)
+		_node.Description = &value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(apiclient.FieldTenantID, field.TypeUint32, value)
+		_node.TenantID = &value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(apiclient.FieldStatus, field.TypeEnum, value)
+		_node.Status = &value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(apiclient.FieldName, field.TypeString, value)
+		_node.Name = &value
+	}
+	if value, ok := _c.mutation.ClientID(); ok {
+		_spec.SetField(apiclient.FieldClientID, field.TypeString, value)
+		_node.ClientID = &value
+	}
+	if value, ok := _c.mutation.ClientSecretHash(); ok {
+		_spec.SetField(apiclient.FieldClientSecretHash, field.TypeString, value)
+		_node.ClientSecretHash = &value
+	}
+	if value, ok := _c.mutation.RoleIds(); ok {
+		_spec.SetField(apiclient.FieldRoleIds, field.TypeJSON, value)
+		_node.RoleIds = value
+	}
+	if value, ok := _c.mutation.RedirectUris(); ok {
+		_spec.SetField(apiclient.FieldRedirectUris, field.TypeJSON, value)
+		_node.RedirectUris = value
+	}
+	if value, ok := _c.mutation.Scopes(); ok {
+		_spec.SetField(apiclient.FieldScopes, field.TypeJSON, value)
+		_node.Scopes = value
+	}
+	if value, ok := _c.mutation.PublicClient(); ok {
+		_spec.SetField(apiclient.FieldPublicClient, field.TypeBool, value)
+		_node.PublicClient = &value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(apiclient.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	if value, ok := _c.mutation.SecretRotatedAt(); ok {
+		_spec.SetField(apiclient.FieldSecretRotatedAt, field.TypeTime, value)
+		_node.SecretRotatedAt = &value
+	}
+	if value, ok := _c.mutation.LastUsedAt(); ok {
+		_spec.SetField(apiclient.FieldLastUsedAt, field.TypeTime, value)
+		_node.LastUsedAt = &value
+	}
+	if value, ok := _c.mutation.LastUsedIP(); ok {
+		_spec.SetField(apiclient.FieldLastUsedIP, field.TypeString, value)
+		_node.LastUsedIP = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ApiClient.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ApiClientUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ApiClientCreate) OnConflict(opts ...sql.ConflictOption) *ApiClientUpsertOne {
+	_c.conflict = opts
+	return &ApiClientUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ApiClient.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ApiClientCreate) OnConflictColumns(columns ...string) *ApiClientUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ApiClientUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ApiClientUpsertOne is the builder for "upsert"-ing
+	//  one ApiClient node.
+	ApiClientUpsertOne struct {
+		create *ApiClientCreate
+	}
+
+	// ApiClientUpsert is the "OnConflict" setter.
+	ApiClientUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ApiClientUpsert) SetUpdatedAt(v time.Time) *ApiClientUpsert {
+	u.Set(apiclient.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ApiClientUpsert) UpdateUpdatedAt() *ApiClientUpsert {
+	u.SetExcluded(apiclient.FieldUpdatedAt)
+	return u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *ApiClientUpsert) ClearUpdatedAt() *ApiClientUpsert {
+	u.SetNull(apiclient.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *ApiClientUpsert) SetDeletedAt(v time.Time) *ApiClientUpsert {
+	u.Set(apiclient.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *ApiClientUpsert) UpdateDeletedAt() *ApiClientUpsert {
+	u.SetExcluded(apiclient.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *ApiClientUpsert) ClearDeletedAt() *ApiClientUpsert {
+	u.SetNull(apiclient.FieldDeletedAt)
+	return u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *ApiClientUpsert) SetCreatedBy(v uint32) *ApiClientUpsert {
+	u.Set(apiclient.FieldCreatedBy, v)
+	return u
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *ApiClientUpsert) UpdateCreatedBy() *ApiClientUpsert {
+	u.SetExcluded(apiclient.FieldCreatedBy)
+	return u
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *ApiClientUpsert) AddCreatedBy(v uint32) *ApiClientUpsert {
+	u.Add(apiclient.FieldCreatedBy, v)
+	return u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *ApiClientUpsert) ClearCreatedBy() *ApiClientUpsert {
+	u.SetNull(apiclient.FieldCreatedBy)
+	return u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *ApiClientUpsert) SetUpdatedBy(v uint32) *ApiClientUpsert {
+	u.Set(apiclient.FieldUpdatedBy, v)
+	return u
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *ApiClientUpsert) UpdateUpdatedBy() *ApiClientUpsert {
+	u.SetExcluded(apiclient.FieldUpdatedBy)
+	return u
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *ApiClientUpsert) AddUpdatedBy(v uint32) *ApiClientUpsert {
+	u.Add(apiclient.FieldUpdatedBy, v)
+	return u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *ApiClientUpsert) ClearUpdatedBy() *ApiClientUpsert {
+	u.SetNull(apiclient.FieldUpdatedBy)
+	return u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *ApiClientUpsert) SetDeletedBy(v uint32) *ApiClientUpsert {
+	u.Set(apiclient.FieldDeletedBy, v)
+	return u
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *ApiClientUpsert) UpdateDeletedBy() *ApiClientUpsert {
+	u.SetExcluded(apiclient.FieldDeletedBy)
+	return u
+}
+
+// AddDeletedBy adds v to the "deleted_by" field.
+func (u *ApiClientUpsert) AddDeletedBy(v uint32) *ApiClientUpsert {
+	u.Add(apiclient.FieldDeletedBy, v)
+	return u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *ApiClientUpsert) ClearDeletedBy() *ApiClientUpsert {
+	u.SetNull(apiclient.FieldDeletedBy)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *ApiClientUpsert) SetDescription(v string) *ApiClientUpsert {
+	u.Set(apiclient.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *ApiClientUpsert) UpdateDescription() *ApiClientUpsert {
+	u.SetExcluded(apiclient.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *ApiClientUpsert) ClearDescription() *ApiClientUpsert {
+	u.SetNull(apiclient.FieldDescription)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *ApiClientUpsert) SetStatus(v apiclient.Status) *ApiClientUpsert {
+	u.Set(apiclient.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ApiClientUpsert) UpdateStatus() *ApiClientUpsert {
+	u.SetExcluded(apiclient.FieldStatus)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *ApiClientUpsert) SetName(v string) *ApiClientUpsert {
+	u.Set(apiclient.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ApiClientUpsert) UpdateName() *ApiClientUpsert {
+	u.SetExcluded(apiclient.FieldName)
+	return u
+}
+
+// ClearName clears the value of the "name" field.
+func (u *ApiClientUpsert) ClearName() *ApiClientUpsert {
+	u.SetNull(apiclient.FieldName)
+	return u
+}
+
+// SetClientSecretHash sets the "client_secret_hash" field.
+func (u *ApiClientUpsert) SetClientSecretHash(v string) *ApiClientUpsert {
+	u.Set(apiclient.FieldClientSecretHash, v)
+	return u
+}
+
+// UpdateClientSecretHash sets the "client_secret_hash" field to the value that was provided on create.
+func (u *ApiClientUpsert) UpdateClientSecretHash() *ApiClientUpsert {
+	u.SetExcluded(apiclient.FieldClientSecretHash)
+	return u
+}
+
+// ClearClientSecretHash clears the value of the "client_secret_hash" field.
+func (u *ApiClientUpsert) ClearClientSecretHash() *ApiClientUpsert {
+	u.SetNull(apiclient.FieldClientSecretHash)
+	return u
+}
+
+// SetRoleIds sets the "role_ids" field.
+func (u *ApiClientUpsert) SetRoleIds(v []uint32) *ApiClientUpsert {
+	u.Set(apiclient.FieldRoleIds, v)
+	return u
+}
+
+// UpdateRoleIds sets the "role_ids" field to the value that was provided on create.
+func (u *ApiClientUpsert) UpdateRoleIds() *ApiClientUpsert {
+	u.SetExcluded(apiclient.FieldRoleIds)
+	return u
+}
+
+// ClearRoleIds clears the value of the "role_ids" field.
+func (u *ApiClientUpsert) ClearRoleIds() *ApiClientUpsert {
+	u.SetNull(apiclient.FieldRoleIds)
+	return u
+}
+
+// SetRedirectUris sets the "redirect_uris" field.
+func (u *ApiClientUpsert) SetRedirectUris(v []string) *ApiClientUpsert {
+	u.Set(apiclient.FieldRedirectUris, v)
+	return u
+}
+
+// UpdateRedirectUris sets the "redirect_uris" field to the value that was provided on create.
+func (u *ApiClientUpsert) UpdateRedirectUris() *ApiClientUpsert {
+	u.SetExcluded(apiclient.FieldRedirectUris)
+	return u
+}
+
+// ClearRedirectUris clears the value of the "redirect_uris" field.
+func (u *ApiClientUpsert) ClearRedirectUris() *ApiClientUpsert {
+	u.SetNull(apiclient.FieldRedirectUris)
+	return u
+}
+
+// SetScopes sets the "scopes" field.
+func (u *ApiClientUpsert) SetScopes(v []string) *ApiClientUpsert {
+	u.Set(apiclient.FieldScopes, v)
+	return u
+}
+
+// UpdateScopes sets the "scopes" field to the value that was provided on create.
+func (u *ApiClientUpsert) UpdateScopes() *ApiClientUpsert {
+	u.SetExcluded(apiclient.FieldScopes)
+	return u
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (u *ApiClientUpsert) ClearScopes() *ApiClientUpsert {
+	u.SetNull(apiclient.FieldScopes)
+	return u
+}
+
+// SetPublicClient sets the "public_client" field.
+func (u *ApiClientUpsert) SetPublicClient(v bool) *ApiClientUpsert {
+	u.Set(apiclient.FieldPublicClient, v)
+	return u
+}
+
+// UpdatePublicClient sets the "public_client" field to the value that was provided on create.
+func (u *ApiClientUpsert) UpdatePublicClient() *ApiClientUpsert {
+	u.SetExcluded(apiclient.FieldPublicClient)
+	return u
+}
+
+// ClearPublicClient clears the value of the "public_client" field.
+func (u *ApiClientUpsert) ClearPublicClient() *ApiClientUpsert {
+	u.SetNull(apiclient.FieldPublicClient)
+	return u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *ApiClientUpsert) SetExpiresAt(v time.Time) *ApiClientUpsert {
+	u.Set(apiclient.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *ApiClientUpsert) UpdateExpiresAt() *ApiClientUpsert {
+	u.SetExcluded(apiclient.FieldExpiresAt)
+	return u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *ApiClientUpsert) ClearExpiresAt() *ApiClientUpsert {
+	u.SetNull(apiclient.FieldExpiresAt)
+	return u
+}
+
+// SetSecretRotatedAt sets the "secret_rotated_at" field.
+func (u *ApiClientUpsert) SetSecretRotatedAt(v time.Time) *ApiClientUpsert {
+	u.Set(apiclient.FieldSecretRotatedAt, v)
+	return u
+}
+
+// UpdateSecretRotatedAt sets the "secret_rotated_at" field to the value that was provided on create.
+func (u *ApiClientUpsert) UpdateSecretRotatedAt() *ApiClientUpsert {
+	u.SetExcluded(apiclient.FieldSecretRotatedAt)
+	return u
+}
+
+// ClearSecretRotatedAt clears the value of the "secret_rotated_at" field.
+func (u *ApiClientUpsert) ClearSecretRotatedAt() *ApiClientUpsert {
+	u.SetNull(apiclient.FieldSecretRotatedAt)
+	return u
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (u *ApiClientUpsert) SetLastUsedAt(v time.Time) *ApiClientUpsert {
+	u.Set(apiclient.FieldLastUsedAt, v)
+	return u
+}
+
+// UpdateLastUsedAt sets the "last_used_at" field to the value that was provided on create.
+func (u *ApiClientUpsert) UpdateLastUsedAt() *ApiClientUpsert {
+	u.SetExcluded(apiclient.FieldLastUsedAt)
+	return u
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (u *ApiClientUpsert) ClearLastUsedAt() *ApiClientUpsert {
+	u.SetNull(apiclient.FieldLastUsedAt)
+	return u
+}
+
+// SetLastUsedIP sets the "last_used_ip" field.
+func (u *ApiClientUpsert) SetLastUsedIP(v string) *ApiClientUpsert {
+	u.Set(apiclient.FieldLastUsedIP, v)
+	return u
+}
+
+// UpdateLastUsedIP sets the "last_used_ip" field to the value that was provided on create.
+func (u *ApiClientUpsert) UpdateLastUsedIP() *ApiClientUpsert {
+	u.SetExcluded(apiclient.FieldLastUsedIP)
+	return u
+}
+
+// ClearLastUsedIP clears the value of the "last_used_ip" field.
+func (u *ApiClientUpsert) ClearLastUsedIP() *ApiClientUpsert {
+	u.SetNull(apiclient.FieldLastUsedIP)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ApiClient.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(apiclient.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ApiClientUpsertOne) UpdateNewValues() *ApiClientUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(apiclient.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(apiclient.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(apiclient.FieldTenantID)
+		}
+		if _, exists := u.create.mutation.ClientID(); exists {
+			s.SetIgnore(apiclient.FieldClientID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ApiClient.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ApiClientUpsertOne) Ignore() *ApiClientUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ApiClientUpsertOne) DoNothing() *ApiClientUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ApiClientCreate.OnConflict
+// documentation for more info.
+func (u *ApiClientUpsertOne) Update(set func(*ApiClientUpsert)) *ApiClientUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ApiClientUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ApiClientUpsertOne) SetUpdatedAt(v time.Time) *ApiClientUpsertOne {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ApiClientUpsertOne) UpdateUpdatedAt() *ApiClientUpsertOne {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *ApiClientUpsertOne) ClearUpdatedAt() *ApiClientUpsertOne {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.ClearUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *ApiClientUpsertOne) SetDeletedAt(v time.Time) *ApiClientUpsertOne {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *ApiClientUpsertOne) UpdateDeletedAt() *ApiClientUpsertOne {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *ApiClientUpsertOne) ClearDeletedAt() *ApiClientUpsertOne {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *ApiClientUpsertOne) SetCreatedBy(v uint32) *ApiClientUpsertOne {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *ApiClientUpsertOne) AddCreatedBy(v uint32) *ApiClientUpsertOne {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.AddCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *ApiClientUpsertOne) UpdateCreatedBy() *ApiClientUpsertOne {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *ApiClientUpsertOne) ClearCreatedBy() *ApiClientUpsertOne {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *ApiClientUpsertOne) SetUpdatedBy(v uint32) *ApiClientUpsertOne {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *ApiClientUpsertOne) AddUpdatedBy(v uint32) *ApiClientUpsertOne {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.AddUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *ApiClientUpsertOne) UpdateUpdatedBy() *ApiClientUpsertOne {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *ApiClientUpsertOne) ClearUpdatedBy() *ApiClientUpsertOne {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.ClearUpdatedBy()
+	})
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *ApiClientUpsertOne) SetDeletedBy(v uint32) *ApiClientUpsertOne {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.SetDeletedBy(v)
+	})
+}
+
+// AddDeletedBy adds v to the "deleted_by" field.
+func (u *ApiClientUpsertOne) AddDeletedBy(v uint32) *ApiClientUpsertOne {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.AddDeletedBy(v)
+	})
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *ApiClientUpsertOne) UpdateDeletedBy() *ApiClientUpsertOne {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.UpdateDeletedBy()
+	})
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *ApiClientUpsertOne) ClearDeletedBy() *ApiClientUpsertOne {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.ClearDeletedBy()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *ApiClientUpsertOne) SetDescription(v string) *ApiClientUpsertOne {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *ApiClientUpsertOne) UpdateDescription() *ApiClientUpsertOne {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *ApiClientUpsertOne) ClearDescription() *ApiClientUpsertOne {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ApiClientUpsertOne) SetStatus(v apiclient.Status) *ApiClientUpsertOne {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ApiClientUpsertOne) UpdateStatus() *ApiClientUpsertOne {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *ApiClientUpsertOne) SetName(v string) *ApiClientUpsertOne {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ApiClientUpsertOne) UpdateName() *ApiClientUpsertOne {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.UpdateName()
+	})
+}
+
+// ClearName clears the value of the "name" field.
+func (u *ApiClientUpsertOne) ClearName() *ApiClientUpsertOne {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.ClearName()
+	})
+}
+
+// SetClientSecretHash sets the "client_secret_hash" field.
+func (u *ApiClientUpsertOne) SetClientSecretHash(v string) *ApiClientUpsertOne {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.SetClientSecretHash(v)
+	})
+}
+
+// UpdateClientSecretHash sets the "client_secret_hash" field to the value that was provided on create.
+func (u *ApiClientUpsertOne) UpdateClientSecretHash() *ApiClientUpsertOne {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.UpdateClientSecretHash()
+	})
+}
+
+// ClearClientSecretHash clears the value of the "client_secret_hash" field.
+func (u *ApiClientUpsertOne) ClearClientSecretHash() *ApiClientUpsertOne {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.ClearClientSecretHash()
+	})
+}
+
+// SetRoleIds sets the "role_ids" field.
+func (u *ApiClientUpsertOne) SetRoleIds(v []uint32) *ApiClientUpsertOne {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.SetRoleIds(v)
+	})
+}
+
+// UpdateRoleIds sets the "role_ids" field to the value that was provided on create.
+func (u *ApiClientUpsertOne) UpdateRoleIds() *ApiClientUpsertOne {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.UpdateRoleIds()
+	})
+}
+
+// ClearRoleIds clears the value of the "role_ids" field.
+func (u *ApiClientUpsertOne) ClearRoleIds() *ApiClientUpsertOne {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.ClearRoleIds()
+	})
+}
+
+// SetRedirectUris sets the "redirect_uris" field.
+func (u *ApiClientUpsertOne) SetRedirectUris(v []string) *ApiClientUpsertOne {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.SetRedirectUris(v)
+	})
+}
+
+// UpdateRedirectUris sets the "redirect_uris" field to the value that was provided on create.
+func (u *ApiClientUpsertOne) UpdateRedirectUris() *ApiClientUpsertOne {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.UpdateRedirectUris()
+	})
+}
+
+// ClearRedirectUris clears the value of the "redirect_uris" field.
+func (u *ApiClientUpsertOne) ClearRedirectUris() *ApiClientUpsertOne {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.ClearRedirectUris()
+	})
+}
+
+// SetScopes sets the "scopes" field.
+func (u *ApiClientUpsertOne) SetScopes(v []string) *ApiClientUpsertOne {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.SetScopes(v)
+	})
+}
+
+// UpdateScopes sets the "scopes" field to the value that was provided on create.
+func (u *ApiClientUpsertOne) UpdateScopes() *ApiClientUpsertOne {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.UpdateScopes()
+	})
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (u *ApiClientUpsertOne) ClearScopes() *ApiClientUpsertOne {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.ClearScopes()
+	})
+}
+
+// SetPublicClient sets the "public_client" field.
+func (u *ApiClientUpsertOne) SetPublicClient(v bool) *ApiClientUpsertOne {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.SetPublicClient(v)
+	})
+}
+
+// UpdatePublicClient sets the "public_client" field to the value that was provided on create.
+func (u *ApiClientUpsertOne) UpdatePublicClient() *ApiClientUpsertOne {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.UpdatePublicClient()
+	})
+}
+
+// ClearPublicClient clears the value of the "public_client" field.
+func (u *ApiClientUpsertOne) ClearPublicClient() *ApiClientUpsertOne {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.ClearPublicClient()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *ApiClientUpsertOne) SetExpiresAt(v time.Time) *ApiClientUpsertOne {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *ApiClientUpsertOne) UpdateExpiresAt() *ApiClientUpsertOne {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *ApiClientUpsertOne) ClearExpiresAt() *ApiClientUpsertOne {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// SetSecretRotatedAt sets the "secret_rotated_at" field.
+func (u *ApiClientUpsertOne) SetSecretRotatedAt(v time.Time) *ApiClientUpsertOne {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.SetSecretRotatedAt(v)
+	})
+}
+
+// UpdateSecretRotatedAt sets the "secret_rotated_at" field to the value that was provided on create.
+func (u *ApiClientUpsertOne) UpdateSecretRotatedAt() *ApiClientUpsertOne {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.UpdateSecretRotatedAt()
+	})
+}
+
+// ClearSecretRotatedAt clears the value of the "secret_rotated_at" field.
+func (u *ApiClientUpsertOne) ClearSecretRotatedAt() *ApiClientUpsertOne {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.ClearSecretRotatedAt()
+	})
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (u *ApiClientUpsertOne) SetLastUsedAt(v time.Time) *ApiClientUpsertOne {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.SetLastUsedAt(v)
+	})
+}
+
+// UpdateLastUsedAt sets the "last_used_at" field to the value that was provided on create.
+func (u *ApiClientUpsertOne) UpdateLastUsedAt() *ApiClientUpsertOne {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.UpdateLastUsedAt()
+	})
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (u *ApiClientUpsertOne) ClearLastUsedAt() *ApiClientUpsertOne {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.ClearLastUsedAt()
+	})
+}
+
+// SetLastUsedIP sets the "last_used_ip" field.
+func (u *ApiClientUpsertOne) SetLastUsedIP(v string) *ApiClientUpsertOne {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.SetLastUsedIP(v)
+	})
+}
+
+// UpdateLastUsedIP sets the "last_used_ip" field to the value that was provided on create.
+func (u *ApiClientUpsertOne) UpdateLastUsedIP() *ApiClientUpsertOne {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.UpdateLastUsedIP()
+	})
+}
+
+// ClearLastUsedIP clears the value of the "last_used_ip" field.
+func (u *ApiClientUpsertOne) ClearLastUsedIP() *ApiClientUpsertOne {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.ClearLastUsedIP()
+	})
+}
+
+// Exec executes the query.
+func (u *ApiClientUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ApiClientCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ApiClientUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ApiClientUpsertOne) ID(ctx context.Context) (id uint32, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ApiClientUpsertOne) IDX(ctx context.Context) uint32 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ApiClientCreateBulk is the builder for creating many ApiClient entities in bulk.
+type ApiClientCreateBulk struct {
+	config
+	err      error
+	builders []*ApiClientCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ApiClient entities in the database.
+func (_c *ApiClientCreateBulk) Save(ctx context.Context) ([]*ApiClient, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ApiClient, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ApiClientMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint32(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ApiClientCreateBulk) SaveX(ctx context.Context) []*ApiClient {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ApiClientCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ApiClientCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ApiClient.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ApiClientUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ApiClientCreateBulk) OnConflict(opts ...sql.ConflictOption) *ApiClientUpsertBulk {
+	_c.conflict = opts
+	return &ApiClientUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ApiClient.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ApiClientCreateBulk) OnConflictColumns(columns ...string) *ApiClientUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ApiClientUpsertBulk{
+		create: _c,
+	}
+}
+
+// ApiClientUpsertBulk is the builder for "upsert"-ing
+// a bulk of ApiClient nodes.
+type ApiClientUpsertBulk struct {
+	create *ApiClientCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ApiClient.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(apiclient.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ApiClientUpsertBulk) UpdateNewValues() *ApiClientUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(apiclient.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(apiclient.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(apiclient.FieldTenantID)
+			}
+			if _, exists := b.mutation.ClientID(); exists {
+				s.SetIgnore(apiclient.FieldClientID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ApiClient.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ApiClientUpsertBulk) Ignore() *ApiClientUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ApiClientUpsertBulk) DoNothing() *ApiClientUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ApiClientCreateBulk.OnConflict
+// documentation for more info.
+func (u *ApiClientUpsertBulk) Update(set func(*ApiClientUpsert)) *ApiClientUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ApiClientUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ApiClientUpsertBulk) SetUpdatedAt(v time.Time) *ApiClientUpsertBulk {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ApiClientUpsertBulk) UpdateUpdatedAt() *ApiClientUpsertBulk {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *ApiClientUpsertBulk) ClearUpdatedAt() *ApiClientUpsertBulk {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.ClearUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *ApiClientUpsertBulk) SetDeletedAt(v time.Time) *ApiClientUpsertBulk {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *ApiClientUpsertBulk) UpdateDeletedAt() *ApiClientUpsertBulk {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *ApiClientUpsertBulk) ClearDeletedAt() *ApiClientUpsertBulk {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *ApiClientUpsertBulk) SetCreatedBy(v uint32) *ApiClientUpsertBulk {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *ApiClientUpsertBulk) AddCreatedBy(v uint32) *ApiClientUpsertBulk {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.AddCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *ApiClientUpsertBulk) UpdateCreatedBy() *ApiClientUpsertBulk {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *ApiClientUpsertBulk) ClearCreatedBy() *ApiClientUpsertBulk {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *ApiClientUpsertBulk) SetUpdatedBy(v uint32) *ApiClientUpsertBulk {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *ApiClientUpsertBulk) AddUpdatedBy(v uint32) *ApiClientUpsertBulk {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.AddUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *ApiClientUpsertBulk) UpdateUpdatedBy() *ApiClientUpsertBulk {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *ApiClientUpsertBulk) ClearUpdatedBy() *ApiClientUpsertBulk {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.ClearUpdatedBy()
+	})
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *ApiClientUpsertBulk) SetDeletedBy(v uint32) *ApiClientUpsertBulk {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.SetDeletedBy(v)
+	})
+}
+
+// AddDeletedBy adds v to the "deleted_by" field.
+func (u *ApiClientUpsertBulk) AddDeletedBy(v uint32) *ApiClientUpsertBulk {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.AddDeletedBy(v)
+	})
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *ApiClientUpsertBulk) UpdateDeletedBy() *ApiClientUpsertBulk {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.UpdateDeletedBy()
+	})
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *ApiClientUpsertBulk) ClearDeletedBy() *ApiClientUpsertBulk {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.ClearDeletedBy()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *ApiClientUpsertBulk) SetDescription(v string) *ApiClientUpsertBulk {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *ApiClientUpsertBulk) UpdateDescription() *ApiClientUpsertBulk {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *ApiClientUpsertBulk) ClearDescription() *ApiClientUpsertBulk {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ApiClientUpsertBulk) SetStatus(v apiclient.Status) *ApiClientUpsertBulk {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ApiClientUpsertBulk) UpdateStatus() *ApiClientUpsertBulk {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *ApiClientUpsertBulk) SetName(v string) *ApiClientUpsertBulk {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ApiClientUpsertBulk) UpdateName() *ApiClientUpsertBulk {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.UpdateName()
+	})
+}
+
+// ClearName clears the value of the "name" field.
+func (u *ApiClientUpsertBulk) ClearName() *ApiClientUpsertBulk {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.ClearName()
+	})
+}
+
+// SetClientSecretHash sets the "client_secret_hash" field.
+func (u *ApiClientUpsertBulk) SetClientSecretHash(v string) *ApiClientUpsertBulk {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.SetClientSecretHash(v)
+	})
+}
+
+// UpdateClientSecretHash sets the "client_secret_hash" field to the value that was provided on create.
+func (u *ApiClientUpsertBulk) UpdateClientSecretHash() *ApiClientUpsertBulk {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.UpdateClientSecretHash()
+	})
+}
+
+// ClearClientSecretHash clears the value of the "client_secret_hash" field.
+func (u *ApiClientUpsertBulk) ClearClientSecretHash() *ApiClientUpsertBulk {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.ClearClientSecretHash()
+	})
+}
+
+// SetRoleIds sets the "role_ids" field.
+func (u *ApiClientUpsertBulk) SetRoleIds(v []uint32) *ApiClientUpsertBulk {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.SetRoleIds(v)
+	})
+}
+
+// UpdateRoleIds sets the "role_ids" field to the value that was provided on create.
+func (u *ApiClientUpsertBulk) UpdateRoleIds() *ApiClientUpsertBulk {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.UpdateRoleIds()
+	})
+}
+
+// ClearRoleIds clears the value of the "role_ids" field.
+func (u *ApiClientUpsertBulk) ClearRoleIds() *ApiClientUpsertBulk {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.ClearRoleIds()
+	})
+}
+
+// SetRedirectUris sets the "redirect_uris" field.
+func (u *ApiClientUpsertBulk) SetRedirectUris(v []string) *ApiClientUpsertBulk {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.SetRedirectUris(v)
+	})
+}
+
+// UpdateRedirectUris sets the "redirect_uris" field to the value that was provided on create.
+func (u *ApiClientUpsertBulk) UpdateRedirectUris() *ApiClientUpsertBulk {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.UpdateRedirectUris()
+	})
+}
+
+// ClearRedirectUris clears the value of the "redirect_uris" field.
+func (u *ApiClientUpsertBulk) ClearRedirectUris() *ApiClientUpsertBulk {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.ClearRedirectUris()
+	})
+}
+
+// SetScopes sets the "scopes" field.
+func (u *ApiClientUpsertBulk) SetScopes(v []string) *ApiClientUpsertBulk {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.SetScopes(v)
+	})
+}
+
+// UpdateScopes sets the "scopes" field to the value that was provided on create.
+func (u *ApiClientUpsertBulk) UpdateScopes() *ApiClientUpsertBulk {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.UpdateScopes()
+	})
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (u *ApiClientUpsertBulk) ClearScopes() *ApiClientUpsertBulk {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.ClearScopes()
+	})
+}
+
+// SetPublicClient sets the "public_client" field.
+func (u *ApiClientUpsertBulk) SetPublicClient(v bool) *ApiClientUpsertBulk {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.SetPublicClient(v)
+	})
+}
+
+// UpdatePublicClient sets the "public_client" field to the value that was provided on create.
+func (u *ApiClientUpsertBulk) UpdatePublicClient() *ApiClientUpsertBulk {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.UpdatePublicClient()
+	})
+}
+
+// ClearPublicClient clears the value of the "public_client" field.
+func (u *ApiClientUpsertBulk) ClearPublicClient() *ApiClientUpsertBulk {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.ClearPublicClient()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *ApiClientUpsertBulk) SetExpiresAt(v time.Time) *ApiClientUpsertBulk {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *ApiClientUpsertBulk) UpdateExpiresAt() *ApiClientUpsertBulk {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *ApiClientUpsertBulk) ClearExpiresAt() *ApiClientUpsertBulk {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// SetSecretRotatedAt sets the "secret_rotated_at" field.
+func (u *ApiClientUpsertBulk) SetSecretRotatedAt(v time.Time) *ApiClientUpsertBulk {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.SetSecretRotatedAt(v)
+	})
+}
+
+// UpdateSecretRotatedAt sets the "secret_rotated_at" field to the value that was provided on create.
+func (u *ApiClientUpsertBulk) UpdateSecretRotatedAt() *ApiClientUpsertBulk {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.UpdateSecretRotatedAt()
+	})
+}
+
+// ClearSecretRotatedAt clears the value of the "secret_rotated_at" field.
+func (u *ApiClientUpsertBulk) ClearSecretRotatedAt() *ApiClientUpsertBulk {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.ClearSecretRotatedAt()
+	})
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (u *ApiClientUpsertBulk) SetLastUsedAt(v time.Time) *ApiClientUpsertBulk {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.SetLastUsedAt(v)
+	})
+}
+
+// UpdateLastUsedAt sets the "last_used_at" field to the value that was provided on create.
+func (u *ApiClientUpsertBulk) UpdateLastUsedAt() *ApiClientUpsertBulk {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.UpdateLastUsedAt()
+	})
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (u *ApiClientUpsertBulk) ClearLastUsedAt() *ApiClientUpsertBulk {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.ClearLastUsedAt()
+	})
+}
+
+// SetLastUsedIP sets the "last_used_ip" field.
+func (u *ApiClientUpsertBulk) SetLastUsedIP(v string) *ApiClientUpsertBulk {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.SetLastUsedIP(v)
+	})
+}
+
+// UpdateLastUsedIP sets the "last_used_ip" field to the value that was provided on create.
+func (u *ApiClientUpsertBulk) UpdateLastUsedIP() *ApiClientUpsertBulk {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.UpdateLastUsedIP()
+	})
+}
+
+// ClearLastUsedIP clears the value of the "last_used_ip" field.
+func (u *ApiClientUpsertBulk) ClearLastUsedIP() *ApiClientUpsertBulk {
+	return u.Update(func(s *ApiClientUpsert) {
+		s.ClearLastUsedIP()
+	})
+}
+
+// Exec executes the query.
+func (u *ApiClientUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ApiClientCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ApiClientCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ApiClientUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
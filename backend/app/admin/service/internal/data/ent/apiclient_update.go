@@ -0,0 +1,1211 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-wind-admin/app/admin/service/internal/data/ent/apiclient"
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// ApiClientUpdate is the builder for updating ApiClient entities.
+type ApiClientUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ApiClientMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ApiClientUpdate builder.
+func (_u *ApiClientUpdate) Where(ps ...predicate.ApiClient) *ApiClientUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ApiClientUpdate) SetUpdatedAt(v time.Time) *ApiClientUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *ApiClientUpdate) SetNillableUpdatedAt(v *time.Time) *ApiClientUpdate {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *ApiClientUpdate) ClearUpdatedAt() *ApiClientUpdate {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *ApiClientUpdate) SetDeletedAt(v time.Time) *ApiClientUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *ApiClientUpdate) SetNillableDeletedAt(v *time.Time) *ApiClientUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *ApiClientUpdate) ClearDeletedAt() *ApiClientUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *ApiClientUpdate) SetCreatedBy(v uint32) *ApiClientUpdate {
+	_u.mutation.ResetCreatedBy()
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *ApiClientUpdate) SetNillableCreatedBy(v *uint32) *ApiClientUpdate {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// AddCreatedBy adds value to the "created_by" field.
+func (_u *ApiClientUpdate) AddCreatedBy(v int32) *ApiClientUpdate {
+	_u.mutation.AddCreatedBy(v)
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *ApiClientUpdate) ClearCreatedBy() *ApiClientUpdate {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_u *ApiClientUpdate) SetUpdatedBy(v uint32) *ApiClientUpdate {
+	_u.mutation.ResetUpdatedBy()
+	_u.mutation.SetUpdatedBy(v)
+	return _u
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_u *ApiClientUpdate) SetNillableUpdatedBy(v *uint32) *ApiClientUpdate {
+	if v != nil {
+		_u.SetUpdatedBy(*v)
+	}
+	return _u
+}
+
+// AddUpdatedBy adds value to the "updated_by" field.
+func (_u *ApiClientUpdate) AddUpdatedBy(v int32) *ApiClientUpdate {
+	_u.mutation.AddUpdatedBy(v)
+	return _u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (_u *ApiClientUpdate) ClearUpdatedBy() *ApiClientUpdate {
+	_u.mutation.ClearUpdatedBy()
+	return _u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_u *ApiClientUpdate) SetDeletedBy(v uint32) *ApiClientUpdate {
+	_u.mutation.ResetDeletedBy()
+	_u.mutation.SetDeletedBy(v)
+	return _u
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_u *ApiClientUpdate) SetNillableDeletedBy(v *uint32) *ApiClientUpdate {
+	if v != nil {
+		_u.SetDeletedBy(*v)
+	}
+	return _u
+}
+
+// AddDeletedBy adds value to the "deleted_by" field.
+func (_u *ApiClientUpdate) AddDeletedBy(v int32) *ApiClientUpdate {
+	_u.mutation.AddDeletedBy(v)
+	return _u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (_u *ApiClientUpdate) ClearDeletedBy() *ApiClientUpdate {
+	_u.mutation.ClearDeletedBy()
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *ApiClientUpdate) SetDescription(v string) *ApiClientUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *ApiClientUpdate) SetNillableDescription(v *string) *ApiClientUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *ApiClientUpdate) ClearDescription() *ApiClientUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ApiClientUpdate) SetStatus(v apiclient.Status) *ApiClientUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ApiClientUpdate) SetNillableStatus(v *apiclient.Status) *ApiClientUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *ApiClientUpdate) SetName(v string) *ApiClientUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *ApiClientUpdate) SetNillableName(v *string) *ApiClientUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *ApiClientUpdate) ClearName() *ApiClientUpdate {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetClientSecretHash sets the "client_secret_hash" field.
+func (_u *ApiClientUpdate) SetClientSecretHash(v string) *ApiClientUpdate {
+	_u.mutation.SetClientSecretHash(v)
+	return _u
+}
+
+// SetNillableClientSecretHash sets the "client_secret_hash" field if the given value is not nil.
+func (_u *ApiClientUpdate) SetNillableClientSecretHash(v *string) *ApiClientUpdate {
+	if v != nil {
+		_u.SetClientSecretHash(*v)
+	}
+	return _u
+}
+
+// ClearClientSecretHash clears the value of the "client_secret_hash" field.
+func (_u *ApiClientUpdate) ClearClientSecretHash() *ApiClientUpdate {
+	_u.mutation.ClearClientSecretHash()
+	return _u
+}
+
+// SetRoleIds sets the "role_ids" field.
+func (_u *ApiClientUpdate) SetRoleIds(v []uint32) *ApiClientUpdate {
+	_u.mutation.SetRoleIds(v)
+	return _u
+}
+
+// AppendRoleIds appends value to the "role_ids" field.
+func (_u *ApiClientUpdate) AppendRoleIds(v []uint32) *ApiClientUpdate {
+	_u.mutation.AppendRoleIds(v)
+	return _u
+}
+
+// ClearRoleIds clears the value of the "role_ids" field.
+func (_u *ApiClientUpdate) ClearRoleIds() *ApiClientUpdate {
+	_u.mutation.ClearRoleIds()
+	return _u
+}
+
+// SetRedirectUris sets the "redirect_uris" field.
+func (_u *ApiClientUpdate) SetRedirectUris(v []string) *ApiClientUpdate {
+	_u.mutation.SetRedirectUris(v)
+	return _u
+}
+
+// AppendRedirectUris appends value to the "redirect_uris" field.
+func (_u *ApiClientUpdate) AppendRedirectUris(v []string) *ApiClientUpdate {
+	_u.mutation.AppendRedirectUris(v)
+	return _u
+}
+
+// ClearRedirectUris clears the value of the "redirect_uris" field.
+func (_u *ApiClientUpdate) ClearRedirectUris() *ApiClientUpdate {
+	_u.mutation.ClearRedirectUris()
+	return _u
+}
+
+// SetScopes sets the "scopes" field.
+func (_u *ApiClientUpdate) SetScopes(v []string) *ApiClientUpdate {
+	_u.mutation.SetScopes(v)
+	return _u
+}
+
+// AppendScopes appends value to the "scopes" field.
+func (_u *ApiClientUpdate) AppendScopes(v []string) *ApiClientUpdate {
+	_u.mutation.AppendScopes(v)
+	return _u
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (_u *ApiClientUpdate) ClearScopes() *ApiClientUpdate {
+	_u.mutation.ClearScopes()
+	return _u
+}
+
+// SetPublicClient sets the "public_client" field.
+func (_u *ApiClientUpdate) SetPublicClient(v bool) *ApiClientUpdate {
+	_u.mutation.SetPublicClient(v)
+	return _u
+}
+
+// SetNillablePublicClient sets the "public_client" field if the given value is not nil.
+func (_u *ApiClientUpdate) SetNillablePublicClient(v *bool) *ApiClientUpdate {
+	if v != nil {
+		_u.SetPublicClient(*v)
+	}
+	return _u
+}
+
+// ClearPublicClient clears the value of the "public_client" field.
+func (_u *ApiClientUpdate) ClearPublicClient() *ApiClientUpdate {
+	_u.mutation.ClearPublicClient()
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *ApiClientUpdate) SetExpiresAt(v time.Time) *ApiClientUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *ApiClientUpdate) SetNillableExpiresAt(v *time.Time) *ApiClientUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *ApiClientUpdate) ClearExpiresAt() *ApiClientUpdate {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
+// SetSecretRotatedAt sets the "secret_rotated_at" field.
+func (_u *ApiClientUpdate) SetSecretRotatedAt(v time.Time) *ApiClientUpdate {
+	_u.mutation.SetSecretRotatedAt(v)
+	return _u
+}
+
+// SetNillableSecretRotatedAt sets the "secret_rotated_at" field if the given value is not nil.
+func (_u *ApiClientUpdate) SetNillableSecretRotatedAt(v *time.Time) *ApiClientUpdate {
+	if v != nil {
+		_u.SetSecretRotatedAt(*v)
+	}
+	return _u
+}
+
+// ClearSecretRotatedAt clears the value of the "secret_rotated_at" field.
+func (_u *ApiClientUpdate) ClearSecretRotatedAt() *ApiClientUpdate {
+	_u.mutation.ClearSecretRotatedAt()
+	return _u
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (_u *ApiClientUpdate) SetLastUsedAt(v time.Time) *ApiClientUpdate {
+	_u.mutation.SetLastUsedAt(v)
+	return _u
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (_u *ApiClientUpdate) SetNillableLastUsedAt(v *time.Time) *ApiClientUpdate {
+	if v != nil {
+		_u.SetLastUsedAt(*v)
+	}
+	return _u
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (_u *ApiClientUpdate) ClearLastUsedAt() *ApiClientUpdate {
+	_u.mutation.ClearLastUsedAt()
+	return _u
+}
+
+// SetLastUsedIP sets the "last_used_ip" field.
+func (_u *ApiClientUpdate) SetLastUsedIP(v string) *ApiClientUpdate {
+	_u.mutation.SetLastUsedIP(v)
+	return _u
+}
+
+// SetNillableLastUsedIP sets the "last_used_ip" field if the given value is not nil.
+func (_u *ApiClientUpdate) SetNillableLastUsedIP(v *string) *ApiClientUpdate {
+	if v != nil {
+		_u.SetLastUsedIP(*v)
+	}
+	return _u
+}
+
+// ClearLastUsedIP clears the value of the "last_used_ip" field.
+func (_u *ApiClientUpdate) ClearLastUsedIP() *ApiClientUpdate {
+	_u.mutation.ClearLastUsedIP()
+	return _u
+}
+
+// Mutation returns the ApiClientMutation object of the builder.
+func (_u *ApiClientUpdate) Mutation() *ApiClientMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ApiClientUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ApiClientUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ApiClientUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ApiClientUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ApiClientUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := apiclient.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ApiClient.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := apiclient.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ApiClient.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ClientSecretHash(); ok {
+		if err := apiclient.ClientSecretHashValidator(v); err != nil {
+			return &ValidationError{Name: "client_secret_hash", err: fmt.Errorf(`ent: validator failed for field "ApiClient.client_secret_hash": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LastUsedIP(); ok {
+		if err := apiclient.LastUsedIPValidator(v); err != nil {
+			return &ValidationError{Name: "last_used_ip", err: fmt.Errorf(`ent: validator failed for field "ApiClient.last_used_ip": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ApiClientUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ApiClientUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ApiClientUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(apiclient.Table, apiclient.Columns, sqlgraph.NewFieldSpec(apiclient.FieldID, field.TypeUint32))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(apiclient.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(apiclient.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(apiclient.FieldUpdatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(apiclient.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(apiclient.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(apiclient.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(apiclient.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(apiclient.FieldCreatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UpdatedBy(); ok {
+		_spec.SetField(apiclient.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedBy(); ok {
+		_spec.AddField(apiclient.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdatedByCleared() {
+		_spec.ClearField(apiclient.FieldUpdatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.DeletedBy(); ok {
+		_spec.SetField(apiclient.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedBy(); ok {
+		_spec.AddField(apiclient.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.DeletedByCleared() {
+		_spec.ClearField(apiclient.FieldDeletedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(apiclient.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(apiclient.FieldDescription, field.TypeString)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(apiclient.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(apiclient.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(apiclient.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(apiclient.FieldName, field.TypeString)
+	}
+	if _u.mutation.ClientIDCleared() {
+		_spec.ClearField(apiclient.FieldClientID, field.TypeString)
+	}
+	if value, ok := _u.mutation.ClientSecretHash(); ok {
+		_spec.SetField(apiclient.FieldClientSecretHash, field.TypeString, value)
+	}
+	if _u.mutation.ClientSecretHashCleared() {
+		_spec.ClearField(apiclient.FieldClientSecretHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.RoleIds(); ok {
+		_spec.SetField(apiclient.FieldRoleIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRoleIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, apiclient.FieldRoleIds, value)
+		})
+	}
+	if _u.mutation.RoleIdsCleared() {
+		_spec.ClearField(apiclient.FieldRoleIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.RedirectUris(); ok {
+		_spec.SetField(apiclient.FieldRedirectUris, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRedirectUris(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, apiclient.FieldRedirectUris, value)
+		})
+	}
+	if _u.mutation.RedirectUrisCleared() {
+		_spec.ClearField(apiclient.FieldRedirectUris, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Scopes(); ok {
+		_spec.SetField(apiclient.FieldScopes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, apiclient.FieldScopes, value)
+		})
+	}
+	if _u.mutation.ScopesCleared() {
+		_spec.ClearField(apiclient.FieldScopes, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.PublicClient(); ok {
+		_spec.SetField(apiclient.FieldPublicClient, field.TypeBool, value)
+	}
+	if _u.mutation.PublicClientCleared() {
+		_spec.ClearField(apiclient.FieldPublicClient, field.TypeBool)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(apiclient.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(apiclient.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.SecretRotatedAt(); ok {
+		_spec.SetField(apiclient.FieldSecretRotatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.SecretRotatedAtCleared() {
+		_spec.ClearField(apiclient.FieldSecretRotatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastUsedAt(); ok {
+		_spec.SetField(apiclient.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastUsedAtCleared() {
+		_spec.ClearField(apiclient.FieldLastUsedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastUsedIP(); ok {
+		_spec.SetField(apiclient.FieldLastUsedIP, field.TypeString, value)
+	}
+	if _u.mutation.LastUsedIPCleared() {
+		_spec.ClearField(apiclient.FieldLastUsedIP, field.TypeString)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{apiclient.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ApiClientUpdateOne is the builder for updating a single ApiClient entity.
+type ApiClientUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ApiClientMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ApiClientUpdateOne) SetUpdatedAt(v time.Time) *ApiClientUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *ApiClientUpdateOne) SetNillableUpdatedAt(v *time.Time) *ApiClientUpdateOne {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *ApiClientUpdateOne) ClearUpdatedAt() *ApiClientUpdateOne {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *ApiClientUpdateOne) SetDeletedAt(v time.Time) *ApiClientUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *ApiClientUpdateOne) SetNillableDeletedAt(v *time.Time) *ApiClientUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *ApiClientUpdateOne) ClearDeletedAt() *ApiClientUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *ApiClientUpdateOne) SetCreatedBy(v uint32) *ApiClientUpdateOne {
+	_u.mutation.ResetCreatedBy()
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *ApiClientUpdateOne) SetNillableCreatedBy(v *uint32) *ApiClientUpdateOne {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// AddCreatedBy adds value to the "created_by" field.
+func (_u *ApiClientUpdateOne) AddCreatedBy(v int32) *ApiClientUpdateOne {
+	_u.mutation.AddCreatedBy(v)
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *ApiClientUpdateOne) ClearCreatedBy() *ApiClientUpdateOne {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_u *ApiClientUpdateOne) SetUpdatedBy(v uint32) *ApiClientUpdateOne {
+	_u.mutation.ResetUpdatedBy()
+	_u.mutation.SetUpdatedBy(v)
+	return _u
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_u *ApiClientUpdateOne) SetNillableUpdatedBy(v *uint32) *ApiClientUpdateOne {
+	if v != nil {
+		_u.SetUpdatedBy(*v)
+	}
+	return _u
+}
+
+// AddUpdatedBy adds value to the "updated_by" field.
+func (_u *ApiClientUpdateOne) AddUpdatedBy(v int32) *ApiClientUpdateOne {
+	_u.mutation.AddUpdatedBy(v)
+	return _u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (_u *ApiClientUpdateOne) ClearUpdatedBy() *ApiClientUpdateOne {
+	_u.mutation.ClearUpdatedBy()
+	return _u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_u *ApiClientUpdateOne) SetDeletedBy(v uint32) *ApiClientUpdateOne {
+	_u.mutation.ResetDeletedBy()
+	_u.mutation.SetDeletedBy(v)
+	return _u
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_u *ApiClientUpdateOne) SetNillableDeletedBy(v *uint32) *ApiClientUpdateOne {
+	if v != nil {
+		_u.SetDeletedBy(*v)
+	}
+	return _u
+}
+
+// AddDeletedBy adds value to the "deleted_by" field.
+func (_u *ApiClientUpdateOne) AddDeletedBy(v int32) *ApiClientUpdateOne {
+	_u.mutation.AddDeletedBy(v)
+	return _u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (_u *ApiClientUpdateOne) ClearDeletedBy() *ApiClientUpdateOne {
+	_u.mutation.ClearDeletedBy()
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *ApiClientUpdateOne) SetDescription(v string) *ApiClientUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *ApiClientUpdateOne) SetNillableDescription(v *string) *ApiClientUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *ApiClientUpdateOne) ClearDescription() *ApiClientUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ApiClientUpdateOne) SetStatus(v apiclient.Status) *ApiClientUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ApiClientUpdateOne) SetNillableStatus(v *apiclient.Status) *ApiClientUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *ApiClientUpdateOne) SetName(v string) *ApiClientUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *ApiClientUpdateOne) SetNillableName(v *string) *ApiClientUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *ApiClientUpdateOne) ClearName() *ApiClientUpdateOne {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetClientSecretHash sets the "client_secret_hash" field.
+func (_u *ApiClientUpdateOne) SetClientSecretHash(v string) *ApiClientUpdateOne {
+	_u.mutation.SetClientSecretHash(v)
+	return _u
+}
+
+// SetNillableClientSecretHash sets the "client_secret_hash" field if the given value is not nil.
+func (_u *ApiClientUpdateOne) SetNillableClientSecretHash(v *string) *ApiClientUpdateOne {
+	if v != nil {
+		_u.SetClientSecretHash(*v)
+	}
+	return _u
+}
+
+// ClearClientSecretHash clears the value of the "client_secret_hash" field.
+func (_u *ApiClientUpdateOne) ClearClientSecretHash() *ApiClientUpdateOne {
+	_u.mutation.ClearClientSecretHash()
+	return _u
+}
+
+// SetRoleIds sets the "role_ids" field.
+func (_u *ApiClientUpdateOne) SetRoleIds(v []uint32) *ApiClientUpdateOne {
+	_u.mutation.SetRoleIds(v)
+	return _u
+}
+
+// AppendRoleIds appends value to the "role_ids" field.
+func (_u *ApiClientUpdateOne) AppendRoleIds(v []uint32) *ApiClientUpdateOne {
+	_u.mutation.AppendRoleIds(v)
+	return _u
+}
+
+// ClearRoleIds clears the value of the "role_ids" field.
+func (_u *ApiClientUpdateOne) ClearRoleIds() *ApiClientUpdateOne {
+	_u.mutation.ClearRoleIds()
+	return _u
+}
+
+// SetRedirectUris sets the "redirect_uris" field.
+func (_u *ApiClientUpdateOne) SetRedirectUris(v []string) *ApiClientUpdateOne {
+	_u.mutation.SetRedirectUris(v)
+	return _u
+}
+
+// AppendRedirectUris appends value to the "redirect_uris" field.
+func (_u *ApiClientUpdateOne) AppendRedirectUris(v []string) *ApiClientUpdateOne {
+	_u.mutation.AppendRedirectUris(v)
+	return _u
+}
+
+// ClearRedirectUris clears the value of the "redirect_uris" field.
+func (_u *ApiClientUpdateOne) ClearRedirectUris() *ApiClientUpdateOne {
+	_u.mutation.ClearRedirectUris()
+	return _u
+}
+
+// SetScopes sets the "scopes" field.
+func (_u *ApiClientUpdateOne) SetScopes(v []string) *ApiClientUpdateOne {
+	_u.mutation.SetScopes(v)
+	return _u
+}
+
+// AppendScopes appends value to the "scopes" field.
+func (_u *ApiClientUpdateOne) AppendScopes(v []string) *ApiClientUpdateOne {
+	_u.mutation.AppendScopes(v)
+	return _u
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (_u *ApiClientUpdateOne) ClearScopes() *ApiClientUpdateOne {
+	_u.mutation.ClearScopes()
+	return _u
+}
+
+// SetPublicClient sets the "public_client" field.
+func (_u *ApiClientUpdateOne) SetPublicClient(v bool) *ApiClientUpdateOne {
+	_u.mutation.SetPublicClient(v)
+	return _u
+}
+
+// SetNillablePublicClient sets the "public_client" field if the given value is not nil.
+func (_u *ApiClientUpdateOne) SetNillablePublicClient(v *bool) *ApiClientUpdateOne {
+	if v != nil {
+		_u.SetPublicClient(*v)
+	}
+	return _u
+}
+
+// ClearPublicClient clears the value of the "public_client" field.
+func (_u *ApiClientUpdateOne) ClearPublicClient() *ApiClientUpdateOne {
+	_u.mutation.ClearPublicClient()
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *ApiClientUpdateOne) SetExpiresAt(v time.Time) *ApiClientUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *ApiClientUpdateOne) SetNillableExpiresAt(v *time.Time) *ApiClientUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *ApiClientUpdateOne) ClearExpiresAt() *ApiClientUpdateOne {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
+// SetSecretRotatedAt sets the "secret_rotated_at" field.
+func (_u *ApiClientUpdateOne) SetSecretRotatedAt(v time.Time) *ApiClientUpdateOne {
+	_u.mutation.SetSecretRotatedAt(v)
+	return _u
+}
+
+// SetNillableSecretRotatedAt sets the "secret_rotated_at" field if the given value is not nil.
+func (_u *ApiClientUpdateOne) SetNillableSecretRotatedAt(v *time.Time) *ApiClientUpdateOne {
+	if v != nil {
+		_u.SetSecretRotatedAt(*v)
+	}
+	return _u
+}
+
+// ClearSecretRotatedAt clears the value of the "secret_rotated_at" field.
+func (_u *ApiClientUpdateOne) ClearSecretRotatedAt() *ApiClientUpdateOne {
+	_u.mutation.ClearSecretRotatedAt()
+	return _u
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (_u *ApiClientUpdateOne) SetLastUsedAt(v time.Time) *ApiClientUpdateOne {
+	_u.mutation.SetLastUsedAt(v)
+	return _u
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (_u *ApiClientUpdateOne) SetNillableLastUsedAt(v *time.Time) *ApiClientUpdateOne {
+	if v != nil {
+		_u.SetLastUsedAt(*v)
+	}
+	return _u
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (_u *ApiClientUpdateOne) ClearLastUsedAt() *ApiClientUpdateOne {
+	_u.mutation.ClearLastUsedAt()
+	return _u
+}
+
+// SetLastUsedIP sets the "last_used_ip" field.
+func (_u *ApiClientUpdateOne) SetLastUsedIP(v string) *ApiClientUpdateOne {
+	_u.mutation.SetLastUsedIP(v)
+	return _u
+}
+
+// SetNillableLastUsedIP sets the "last_used_ip" field if the given value is not nil.
+func (_u *ApiClientUpdateOne) SetNillableLastUsedIP(v *string) *ApiClientUpdateOne {
+	if v != nil {
+		_u.SetLastUsedIP(*v)
+	}
+	return _u
+}
+
+// ClearLastUsedIP clears the value of the "last_used_ip" field.
+func (_u *ApiClientUpdateOne) ClearLastUsedIP() *ApiClientUpdateOne {
+	_u.mutation.ClearLastUsedIP()
+	return _u
+}
+
+// Mutation returns the ApiClientMutation object of the builder.
+func (_u *ApiClientUpdateOne) Mutation() *ApiClientMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ApiClientUpdate builder.
+func (_u *ApiClientUpdateOne) Where(ps ...predicate.ApiClient) *ApiClientUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ApiClientUpdateOne) Select(field string, fields ...string) *ApiClientUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ApiClient entity.
+func (_u *ApiClientUpdateOne) Save(ctx context.Context) (*ApiClient, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ApiClientUpdateOne) SaveX(ctx context.Context) *ApiClient {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ApiClientUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ApiClientUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ApiClientUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := apiclient.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ApiClient.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := apiclient.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ApiClient.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ClientSecretHash(); ok {
+		if err := apiclient.ClientSecretHashValidator(v); err != nil {
+			return &ValidationError{Name: "client_secret_hash", err: fmt.Errorf(`ent: validator failed for field "ApiClient.client_secret_hash": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LastUsedIP(); ok {
+		if err := apiclient.LastUsedIPValidator(v); err != nil {
+			return &ValidationError{Name: "last_used_ip", err: fmt.Errorf(`ent: validator failed for field "ApiClient.last_used_ip": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ApiClientUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ApiClientUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ApiClientUpdateOne) sqlSave(ctx context.Context) (_node *ApiClient, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(apiclient.Table, apiclient.Columns, sqlgraph.NewFieldSpec(apiclient.FieldID, field.TypeUint32))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ApiClient.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, apiclient.FieldID)
+		for _, f := range fields {
+			if !apiclient.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != apiclient.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(apiclient.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(apiclient.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(apiclient.FieldUpdatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(apiclient.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(apiclient.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(apiclient.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(apiclient.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(apiclient.FieldCreatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UpdatedBy(); ok {
+		_spec.SetField(apiclient.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedBy(); ok {
+		_spec.AddField(apiclient.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdatedByCleared() {
+		_spec.ClearField(apiclient.FieldUpdatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.DeletedBy(); ok {
+		_spec.SetField(apiclient.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedBy(); ok {
+		_spec.AddField(apiclient.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.DeletedByCleared() {
+		_spec.ClearField(apiclient.FieldDeletedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(apiclient.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(apiclient.FieldDescription, field.TypeString)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(apiclient.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(apiclient.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(apiclient.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(apiclient.FieldName, field.TypeString)
+	}
+	if _u.mutation.ClientIDCleared() {
+		_spec.ClearField(apiclient.FieldClientID, field.TypeString)
+	}
+	if value, ok := _u.mutation.ClientSecretHash(); ok {
+		_spec.SetField(apiclient.FieldClientSecretHash, field.TypeString, value)
+	}
+	if _u.mutation.ClientSecretHashCleared() {
+		_spec.ClearField(apiclient.FieldClientSecretHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.RoleIds(); ok {
+		_spec.SetField(apiclient.FieldRoleIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRoleIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, apiclient.FieldRoleIds, value)
+		})
+	}
+	if _u.mutation.RoleIdsCleared() {
+		_spec.ClearField(apiclient.FieldRoleIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.RedirectUris(); ok {
+		_spec.SetField(apiclient.FieldRedirectUris, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRedirectUris(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, apiclient.FieldRedirectUris, value)
+		})
+	}
+	if _u.mutation.RedirectUrisCleared() {
+		_spec.ClearField(apiclient.FieldRedirectUris, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Scopes(); ok {
+		_spec.SetField(apiclient.FieldScopes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, apiclient.FieldScopes, value)
+		})
+	}
+	if _u.mutation.ScopesCleared() {
+		_spec.ClearField(apiclient.FieldScopes, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.PublicClient(); ok {
+		_spec.SetField(apiclient.FieldPublicClient, field.TypeBool, value)
+	}
+	if _u.mutation.PublicClientCleared() {
+		_spec.ClearField(apiclient.FieldPublicClient, field.TypeBool)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(apiclient.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(apiclient.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.SecretRotatedAt(); ok {
+		_spec.SetField(apiclient.FieldSecretRotatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.SecretRotatedAtCleared() {
+		_spec.ClearField(apiclient.FieldSecretRotatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastUsedAt(); ok {
+		_spec.SetField(apiclient.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastUsedAtCleared() {
+		_spec.ClearField(apiclient.FieldLastUsedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastUsedIP(); ok {
+		_spec.SetField(apiclient.FieldLastUsedIP, field.TypeString, value)
+	}
+	if _u.mutation.LastUsedIPCleared() {
+		_spec.ClearField(apiclient.FieldLastUsedIP, field.TypeString)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &ApiClient{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{apiclient.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -13,6 +13,7 @@
 
 	"go-wind-admin/app/admin/service/internal/data/ent/api"
 	"go-wind-admin/app/admin/service/internal/data/ent/apiauditlog"
+	"go-wind-admin/app/admin/service/internal/data/ent/apiclient"
 	"go-wind-admin/app/admin/service/internal/data/ent/dataaccessauditlog"
 	"go-wind-admin/app/admin/service/internal/data/ent/dictentry"
 	"go-wind-admin/app/admin/service/internal/data/ent/dictentryi18n"
@@ -21,7 +22,9 @@
 	"go-wind-admin/app/admin/service/internal/data/ent/internalmessage"
 	"go-wind-admin/app/admin/service/internal/data/ent/internalmessagecategory"
 	"go-wind-admin/app/admin/service/internal/data/ent/internalmessagerecipient"
+	"go-wind-admin/app/admin/service/internal/data/ent/jwtsigningkey"
 	"go-wind-admin/app/admin/service/internal/data/ent/language"
+	"go-wind-admin/app/admin/service/internal/data/ent/ldapconfig"
 	"go-wind-admin/app/admin/service/internal/data/ent/loginauditlog"
 	"go-wind-admin/app/admin/service/internal/data/ent/loginpolicy"
 	"go-wind-admin/app/admin/service/internal/data/ent/membership"
@@ -29,8 +32,11 @@
 	"go-wind-admin/app/admin/service/internal/data/ent/membershipposition"
 	"go-wind-admin/app/admin/service/internal/data/ent/membershiprole"
 	"go-wind-admin/app/admin/service/internal/data/ent/menu"
+	"go-wind-admin/app/admin/service/internal/data/ent/mfapolicy"
+	"go-wind-admin/app/admin/service/internal/data/ent/oauthproviderconfig"
 	"go-wind-admin/app/admin/service/internal/data/ent/operationauditlog"
 	"go-wind-admin/app/admin/service/internal/data/ent/orgunit"
+	"go-wind-admin/app/admin/service/internal/data/ent/passwordpolicy"
 	"go-wind-admin/app/admin/service/internal/data/ent/permission"
 	"go-wind-admin/app/admin/service/internal/data/ent/permissionapi"
 	"go-wind-admin/app/admin/service/internal/data/ent/permissionauditlog"
@@ -42,9 +48,13 @@
 	"go-wind-admin/app/admin/service/internal/data/ent/planquota"
 	"go-wind-admin/app/admin/service/internal/data/ent/policyevaluationlog"
 	"go-wind-admin/app/admin/service/internal/data/ent/position"
+	"go-wind-admin/app/admin/service/internal/data/ent/relationtuple"
+	"go-wind-admin/app/admin/service/internal/data/ent/relationtuplechange"
 	"go-wind-admin/app/admin/service/internal/data/ent/role"
 	"go-wind-admin/app/admin/service/internal/data/ent/rolemetadata"
 	"go-wind-admin/app/admin/service/internal/data/ent/rolepermission"
+	"go-wind-admin/app/admin/service/internal/data/ent/samlconfig"
+	"go-wind-admin/app/admin/service/internal/data/ent/scimtoken"
 	"go-wind-admin/app/admin/service/internal/data/ent/task"
 	"go-wind-admin/app/admin/service/internal/data/ent/tenant"
 	"go-wind-admin/app/admin/service/internal/data/ent/user"
@@ -69,6 +79,8 @@ type Client struct {
 	Api *APIClient
 	// ApiAuditLog is the client for interacting with the ApiAuditLog builders.
 	ApiAuditLog *ApiAuditLogClient
+	// ApiClient is the client for interacting with the ApiClient builders.
+	ApiClient *ApiClientClient
 	// DataAccessAuditLog is the client for interacting with the DataAccessAuditLog builders.
 	DataAccessAuditLog *DataAccessAuditLogClient
 	// DictEntry is the client for interacting with the DictEntry builders.
@@ -85,8 +97,12 @@ type Client struct {
 	InternalMessageCategory *InternalMessageCategoryClient
 	// InternalMessageRecipient is the client for interacting with the InternalMessageRecipient builders.
 	InternalMessageRecipient *InternalMessageRecipientClient
+	// JwtSigningKey is the client for interacting with the JwtSigningKey builders.
+	JwtSigningKey *JwtSigningKeyClient
 	// Language is the client for interacting with the Language builders.
 	Language *LanguageClient
+	// LdapConfig is the client for interacting with the LdapConfig builders.
+	LdapConfig *LdapConfigClient
 	// LoginAuditLog is the client for interacting with the LoginAuditLog builders.
 	LoginAuditLog *LoginAuditLogClient
 	// LoginPolicy is the client for interacting with the LoginPolicy builders.
@@ -101,10 +117,16 @@ type Client struct {
 	MembershipRole *MembershipRoleClient
 	// Menu is the client for interacting with the Menu builders.
 	Menu *MenuClient
+	// MfaPolicy is the client for interacting with the MfaPolicy builders.
+	MfaPolicy *MfaPolicyClient
+	// OAuthProviderConfig is the client for interacting with the OAuthProviderConfig builders.
+	OAuthProviderConfig *OAuthProviderConfigClient
 	// OperationAuditLog is the client for interacting with the OperationAuditLog builders.
 	OperationAuditLog *OperationAuditLogClient
 	// OrgUnit is the client for interacting with the OrgUnit builders.
 	OrgUnit *OrgUnitClient
+	// PasswordPolicy is the client for interacting with the PasswordPolicy builders.
+	PasswordPolicy *PasswordPolicyClient
 	// Permission is the client for interacting with the Permission builders.
 	Permission *PermissionClient
 	// PermissionApi is the client for interacting with the PermissionApi builders.
@@ -127,12 +149,20 @@ type Client struct {
 	PolicyEvaluationLog *PolicyEvaluationLogClient
 	// Position is the client for interacting with the Position builders.
 	Position *PositionClient
+	// RelationTuple is the client for interacting with the RelationTuple builders.
+	RelationTuple *RelationTupleClient
+	// RelationTupleChange is the client for interacting with the RelationTupleChange builders.
+	RelationTupleChange *RelationTupleChangeClient
 	// Role is the client for interacting with the Role builders.
 	Role *RoleClient
 	// RoleMetadata is the client for interacting with the RoleMetadata builders.
 	RoleMetadata *RoleMetadataClient
 	// RolePermission is the client for interacting with the RolePermission builders.
 	RolePermission *RolePermissionClient
+	// SamlConfig is the client for interacting with the SamlConfig builders.
+	SamlConfig *SamlConfigClient
+	// ScimToken is the client for interacting with the ScimToken builders.
+	ScimToken *ScimTokenClient
 	// Task is the client for interacting with the Task builders.
 	Task *TaskClient
 	// Tenant is the client for interacting with the Tenant builders.
@@ -162,6 +192,7 @@ func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.Api = NewAPIClient(c.config)
 	c.ApiAuditLog = NewApiAuditLogClient(c.config)
+	c.ApiClient = NewApiClientClient(c.config)
 	c.DataAccessAuditLog = NewDataAccessAuditLogClient(c.config)
 	c.DictEntry = NewDictEntryClient(c.config)
 	c.DictEntryI18n = NewDictEntryI18nClient(c.config)
@@ -170,7 +201,9 @@ func (c *Client) init() {
 	c.InternalMessage = NewInternalMessageClient(c.config)
 	c.InternalMessageCategory = NewInternalMessageCategoryClient(c.config)
 	c.InternalMessageRecipient = NewInternalMessageRecipientClient(c.config)
+	c.JwtSigningKey = NewJwtSigningKeyClient(c.config)
 	c.Language = NewLanguageClient(c.config)
+	c.LdapConfig = NewLdapConfigClient(c.config)
 	c.LoginAuditLog = NewLoginAuditLogClient(c.config)
 	c.LoginPolicy = NewLoginPolicyClient(c.config)
 	c.Membership = NewMembershipClient(c.config)
@@ -178,8 +211,11 @@ func (c *Client) init() {
 	c.MembershipPosition = NewMembershipPositionClient(c.config)
 	c.MembershipRole = NewMembershipRoleClient(c.config)
 	c.Menu = NewMenuClient(c.config)
+	c.MfaPolicy = NewMfaPolicyClient(c.config)
+	c.OAuthProviderConfig = NewOAuthProviderConfigClient(c.config)
 	c.OperationAuditLog = NewOperationAuditLogClient(c.config)
 	c.OrgUnit = NewOrgUnitClient(c.config)
+	c.PasswordPolicy = NewPasswordPolicyClient(c.config)
 	c.Permission = NewPermissionClient(c.config)
 	c.PermissionApi = NewPermissionApiClient(c.config)
 	c.PermissionAuditLog = NewPermissionAuditLogClient(c.config)
@@ -191,9 +227,13 @@ func (c *Client) init() {
 	c.PlanQuota = NewPlanQuotaClient(c.config)
 	c.PolicyEvaluationLog = NewPolicyEvaluationLogClient(c.config)
 	c.Position = NewPositionClient(c.config)
+	c.RelationTuple = NewRelationTupleClient(c.config)
+	c.RelationTupleChange = NewRelationTupleChangeClient(c.config)
 	c.Role = NewRoleClient(c.config)
 	c.RoleMetadata = NewRoleMetadataClient(c.config)
 	c.RolePermission = NewRolePermissionClient(c.config)
+	c.SamlConfig = NewSamlConfigClient(c.config)
+	c.ScimToken = NewScimTokenClient(c.config)
 	c.Task = NewTaskClient(c.config)
 	c.Tenant = NewTenantClient(c.config)
 	c.User = NewUserClient(c.config)
@@ -296,6 +336,7 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		config:                   cfg,
 		Api:                      NewAPIClient(cfg),
 		ApiAuditLog:              NewApiAuditLogClient(cfg),
+		ApiClient:                NewApiClientClient(cfg),
 		DataAccessAuditLog:       NewDataAccessAuditLogClient(cfg),
 		DictEntry:                NewDictEntryClient(cfg),
 		DictEntryI18n:            NewDictEntryI18nClient(cfg),
@@ -304,7 +345,9 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		InternalMessage:          NewInternalMessageClient(cfg),
 		InternalMessageCategory:  NewInternalMessageCategoryClient(cfg),
 		InternalMessageRecipient: NewInternalMessageRecipientClient(cfg),
+		JwtSigningKey:            NewJwtSigningKeyClient(cfg),
 		Language:                 NewLanguageClient(cfg),
+		LdapConfig:               NewLdapConfigClient(cfg),
 		LoginAuditLog:            NewLoginAuditLogClient(cfg),
 		LoginPolicy:              NewLoginPolicyClient(cfg),
 		Membership:               NewMembershipClient(cfg),
@@ -312,8 +355,11 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		MembershipPosition:       NewMembershipPositionClient(cfg),
 		MembershipRole:           NewMembershipRoleClient(cfg),
 		Menu:                     NewMenuClient(cfg),
+		MfaPolicy:                NewMfaPolicyClient(cfg),
+		OAuthProviderConfig:      NewOAuthProviderConfigClient(cfg),
 		OperationAuditLog:        NewOperationAuditLogClient(cfg),
 		OrgUnit:                  NewOrgUnitClient(cfg),
+		PasswordPolicy:           NewPasswordPolicyClient(cfg),
 		Permission:               NewPermissionClient(cfg),
 		PermissionApi:            NewPermissionApiClient(cfg),
 		PermissionAuditLog:       NewPermissionAuditLogClient(cfg),
@@ -325,9 +371,13 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		PlanQuota:                NewPlanQuotaClient(cfg),
 		PolicyEvaluationLog:      NewPolicyEvaluationLogClient(cfg),
 		Position:                 NewPositionClient(cfg),
+		RelationTuple:            NewRelationTupleClient(cfg),
+		RelationTupleChange:      NewRelationTupleChangeClient(cfg),
 		Role:                     NewRoleClient(cfg),
 		RoleMetadata:             NewRoleMetadataClient(cfg),
 		RolePermission:           NewRolePermissionClient(cfg),
+		SamlConfig:               NewSamlConfigClient(cfg),
+		ScimToken:                NewScimTokenClient(cfg),
 		Task:                     NewTaskClient(cfg),
 		Tenant:                   NewTenantClient(cfg),
 		User:                     NewUserClient(cfg),
@@ -357,6 +407,7 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		config:                   cfg,
 		Api:                      NewAPIClient(cfg),
 		ApiAuditLog:              NewApiAuditLogClient(cfg),
+		ApiClient:                NewApiClientClient(cfg),
 		DataAccessAuditLog:       NewDataAccessAuditLogClient(cfg),
 		DictEntry:                NewDictEntryClient(cfg),
 		DictEntryI18n:            NewDictEntryI18nClient(cfg),
@@ -365,7 +416,9 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		InternalMessage:          NewInternalMessageClient(cfg),
 		InternalMessageCategory:  NewInternalMessageCategoryClient(cfg),
 		InternalMessageRecipient: NewInternalMessageRecipientClient(cfg),
+		JwtSigningKey:            NewJwtSigningKeyClient(cfg),
 		Language:                 NewLanguageClient(cfg),
+		LdapConfig:               NewLdapConfigClient(cfg),
 		LoginAuditLog:            NewLoginAuditLogClient(cfg),
 		LoginPolicy:              NewLoginPolicyClient(cfg),
 		Membership:               NewMembershipClient(cfg),
@@ -373,8 +426,11 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		MembershipPosition:       NewMembershipPositionClient(cfg),
 		MembershipRole:           NewMembershipRoleClient(cfg),
 		Menu:                     NewMenuClient(cfg),
+		MfaPolicy:                NewMfaPolicyClient(cfg),
+		OAuthProviderConfig:      NewOAuthProviderConfigClient(cfg),
 		OperationAuditLog:        NewOperationAuditLogClient(cfg),
 		OrgUnit:                  NewOrgUnitClient(cfg),
+		PasswordPolicy:           NewPasswordPolicyClient(cfg),
 		Permission:               NewPermissionClient(cfg),
 		PermissionApi:            NewPermissionApiClient(cfg),
 		PermissionAuditLog:       NewPermissionAuditLogClient(cfg),
@@ -386,9 +442,13 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		PlanQuota:                NewPlanQuotaClient(cfg),
 		PolicyEvaluationLog:      NewPolicyEvaluationLogClient(cfg),
 		Position:                 NewPositionClient(cfg),
+		RelationTuple:            NewRelationTupleClient(cfg),
+		RelationTupleChange:      NewRelationTupleChangeClient(cfg),
 		Role:                     NewRoleClient(cfg),
 		RoleMetadata:             NewRoleMetadataClient(cfg),
 		RolePermission:           NewRolePermissionClient(cfg),
+		SamlConfig:               NewSamlConfigClient(cfg),
+		ScimToken:                NewScimTokenClient(cfg),
 		Task:                     NewTaskClient(cfg),
 		Tenant:                   NewTenantClient(cfg),
 		User:                     NewUserClient(cfg),
@@ -426,15 +486,18 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.Api, c.ApiAuditLog, c.DataAccessAuditLog, c.DictEntry, c.DictEntryI18n,
-		c.DictType, c.File, c.InternalMessage, c.InternalMessageCategory,
-		c.InternalMessageRecipient, c.Language, c.LoginAuditLog, c.LoginPolicy,
-		c.Membership, c.MembershipOrgUnit, c.MembershipPosition, c.MembershipRole,
-		c.Menu, c.OperationAuditLog, c.OrgUnit, c.Permission, c.PermissionApi,
-		c.PermissionAuditLog, c.PermissionGroup, c.PermissionMenu, c.PermissionPolicy,
-		c.Plan, c.PlanModule, c.PlanQuota, c.PolicyEvaluationLog, c.Position, c.Role,
-		c.RoleMetadata, c.RolePermission, c.Task, c.Tenant, c.User, c.UserCredential,
-		c.UserMfaFactor, c.UserOrgUnit, c.UserPosition, c.UserRole,
+		c.Api, c.ApiAuditLog, c.ApiClient, c.DataAccessAuditLog, c.DictEntry,
+		c.DictEntryI18n, c.DictType, c.File, c.InternalMessage,
+		c.InternalMessageCategory, c.InternalMessageRecipient, c.JwtSigningKey,
+		c.Language, c.LdapConfig, c.LoginAuditLog, c.LoginPolicy, c.Membership,
+		c.MembershipOrgUnit, c.MembershipPosition, c.MembershipRole, c.Menu,
+		c.MfaPolicy, c.OAuthProviderConfig, c.OperationAuditLog, c.OrgUnit,
+		c.PasswordPolicy, c.Permission, c.PermissionApi, c.PermissionAuditLog,
+		c.PermissionGroup, c.PermissionMenu, c.PermissionPolicy, c.Plan, c.PlanModule,
+		c.PlanQuota, c.PolicyEvaluationLog, c.Position, c.RelationTuple,
+		c.RelationTupleChange, c.Role, c.RoleMetadata, c.RolePermission, c.SamlConfig,
+		c.ScimToken, c.Task, c.Tenant, c.User, c.UserCredential, c.UserMfaFactor,
+		c.UserOrgUnit, c.UserPosition, c.UserRole,
 	} {
 		n.Use(hooks...)
 	}
@@ -444,15 +507,18 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.Api, c.ApiAuditLog, c.DataAccessAuditLog, c.DictEntry, c.DictEntryI18n,
-		c.DictType, c.File, c.InternalMessage, c.InternalMessageCategory,
-		c.InternalMessageRecipient, c.Language, c.LoginAuditLog, c.LoginPolicy,
-		c.Membership, c.MembershipOrgUnit, c.MembershipPosition, c.MembershipRole,
-		c.Menu, c.OperationAuditLog, c.OrgUnit, c.Permission, c.PermissionApi,
-		c.PermissionAuditLog, c.PermissionGroup, c.PermissionMenu, c.PermissionPolicy,
-		c.Plan, c.PlanModule, c.PlanQuota, c.PolicyEvaluationLog, c.Position, c.Role,
-		c.RoleMetadata, c.RolePermission, c.Task, c.Tenant, c.User, c.UserCredential,
-		c.UserMfaFactor, c.UserOrgUnit, c.UserPosition, c.UserRole,
+		c.Api, c.ApiAuditLog, c.ApiClient, c.DataAccessAuditLog, c.DictEntry,
+		c.DictEntryI18n, c.DictType, c.File, c.InternalMessage,
+		c.InternalMessageCategory, c.InternalMessageRecipient, c.JwtSigningKey,
+		c.Language, c.LdapConfig, c.LoginAuditLog, c.LoginPolicy, c.Membership,
+		c.MembershipOrgUnit, c.MembershipPosition, c.MembershipRole, c.Menu,
+		c.MfaPolicy, c.OAuthProviderConfig, c.OperationAuditLog, c.OrgUnit,
+		c.PasswordPolicy, c.Permission, c.PermissionApi, c.PermissionAuditLog,
+		c.PermissionGroup, c.PermissionMenu, c.PermissionPolicy, c.Plan, c.PlanModule,
+		c.PlanQuota, c.PolicyEvaluationLog, c.Position, c.RelationTuple,
+		c.RelationTupleChange, c.Role, c.RoleMetadata, c.RolePermission, c.SamlConfig,
+		c.ScimToken, c.Task, c.Tenant, c.User, c.UserCredential, c.UserMfaFactor,
+		c.UserOrgUnit, c.UserPosition, c.UserRole,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -465,6 +531,8 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.Api.mutate(ctx, m)
 	case *ApiAuditLogMutation:
 		return c.ApiAuditLog.mutate(ctx, m)
+	case *ApiClientMutation:
+		return c.ApiClient.mutate(ctx, m)
 	case *DataAccessAuditLogMutation:
 		return c.DataAccessAuditLog.mutate(ctx, m)
 	case *DictEntryMutation:
@@ -481,8 +549,12 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.InternalMessageCategory.mutate(ctx, m)
 	case *InternalMessageRecipientMutation:
 		return c.InternalMessageRecipient.mutate(ctx, m)
+	case *JwtSigningKeyMutation:
+		return c.JwtSigningKey.mutate(ctx, m)
 	case *LanguageMutation:
 		return c.Language.mutate(ctx, m)
+	case *LdapConfigMutation:
+		return c.LdapConfig.mutate(ctx, m)
 	case *LoginAuditLogMutation:
 		return c.LoginAuditLog.mutate(ctx, m)
 	case *LoginPolicyMutation:
@@ -497,10 +569,16 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.MembershipRole.mutate(ctx, m)
 	case *MenuMutation:
 		return c.Menu.mutate(ctx, m)
+	case *MfaPolicyMutation:
+		return c.MfaPolicy.mutate(ctx, m)
+	case *OAuthProviderConfigMutation:
+		return c.OAuthProviderConfig.mutate(ctx, m)
 	case *OperationAuditLogMutation:
 		return c.OperationAuditLog.mutate(ctx, m)
 	case *OrgUnitMutation:
 		return c.OrgUnit.mutate(ctx, m)
+	case *PasswordPolicyMutation:
+		return c.PasswordPolicy.mutate(ctx, m)
 	case *PermissionMutation:
 		return c.Permission.mutate(ctx, m)
 	case *PermissionApiMutation:
@@ -523,12 +601,20 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.PolicyEvaluationLog.mutate(ctx, m)
 	case *PositionMutation:
 		return c.Position.mutate(ctx, m)
+	case *RelationTupleMutation:
+		return c.RelationTuple.mutate(ctx, m)
+	case *RelationTupleChangeMutation:
+		return c.RelationTupleChange.mutate(ctx, m)
 	case *RoleMutation:
 		return c.Role.mutate(ctx, m)
 	case *RoleMetadataMutation:
 		return c.RoleMetadata.mutate(ctx, m)
 	case *RolePermissionMutation:
 		return c.RolePermission.mutate(ctx, m)
+	case *SamlConfigMutation:
+		return c.SamlConfig.mutate(ctx, m)
+	case *ScimTokenMutation:
+		return c.ScimToken.mutate(ctx, m)
 	case *TaskMutation:
 		return c.Task.mutate(ctx, m)
 	case *TenantMutation:
@@ -817,6 +903,140 @@ func (c *ApiAuditLogClient) mutate(ctx context.Context, m *ApiAuditLogMutation)
 	}
 }
 
+// ApiClientClient is a client for the ApiClient schema.
+type ApiClientClient struct {
+	config
+}
+
+// NewApiClientClient returns a client for the ApiClient from the given config.
+func NewApiClientClient(c config) *ApiClientClient {
+	return &ApiClientClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `apiclient.Hooks(f(g(h())))`.
+func (c *ApiClientClient) Use(hooks ...Hook) {
+	c.hooks.ApiClient = append(c.hooks.ApiClient, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `apiclient.Intercept(f(g(h())))`.
+func (c *ApiClientClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ApiClient = append(c.inters.ApiClient, interceptors...)
+}
+
+// Create returns a builder for creating a ApiClient entity.
+func (c *ApiClientClient) Create() *ApiClientCreate {
+	mutation := newApiClientMutation(c.config, OpCreate)
+	return &ApiClientCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ApiClient entities.
+func (c *ApiClientClient) CreateBulk(builders ...*ApiClientCreate) *ApiClientCreateBulk {
+	return &ApiClientCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ApiClientClient) MapCreateBulk(slice any, setFunc func(*ApiClientCreate, int)) *ApiClientCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ApiClientCreateBulk{err: fmt.Errorf("calling to ApiClientClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ApiClientCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ApiClientCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ApiClient.
+func (c *ApiClientClient) Update() *ApiClientUpdate {
+	mutation := newApiClientMutation(c.config, OpUpdate)
+	return &ApiClientUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ApiClientClient) UpdateOne(_m *ApiClient) *ApiClientUpdateOne {
+	mutation := newApiClientMutation(c.config, OpUpdateOne, withApiClient(_m))
+	return &ApiClientUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ApiClientClient) UpdateOneID(id uint32) *ApiClientUpdateOne {
+	mutation := newApiClientMutation(c.config, OpUpdateOne, withApiClientID(id))
+	return &ApiClientUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ApiClient.
+func (c *ApiClientClient) Delete() *ApiClientDelete {
+	mutation := newApiClientMutation(c.config, OpDelete)
+	return &ApiClientDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ApiClientClient) DeleteOne(_m *ApiClient) *ApiClientDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ApiClientClient) DeleteOneID(id uint32) *ApiClientDeleteOne {
+	builder := c.Delete().Where(apiclient.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ApiClientDeleteOne{builder}
+}
+
+// Query returns a query builder for ApiClient.
+func (c *ApiClientClient) Query() *ApiClientQuery {
+	return &ApiClientQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeApiClient},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ApiClient entity by its id.
+func (c *ApiClientClient) Get(ctx context.Context, id uint32) (*ApiClient, error) {
+	return c.Query().Where(apiclient.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ApiClientClient) GetX(ctx context.Context, id uint32) *ApiClient {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ApiClientClient) Hooks() []Hook {
+	hooks := c.hooks.ApiClient
+	return append(hooks[:len(hooks):len(hooks)], apiclient.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *ApiClientClient) Interceptors() []Interceptor {
+	return c.inters.ApiClient
+}
+
+func (c *ApiClientClient) mutate(ctx context.Context, m *ApiClientMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ApiClientCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ApiClientUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ApiClientUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ApiClientDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ApiClient mutation op: %q", m.Op())
+	}
+}
+
 // DataAccessAuditLogClient is a client for the DataAccessAuditLog schema.
 type DataAccessAuditLogClient struct {
 	config
@@ -1953,6 +2173,139 @@ func (c *InternalMessageRecipientClient) mutate(ctx context.Context, m *Internal
 	}
 }
 
+// JwtSigningKeyClient is a client for the JwtSigningKey schema.
+type JwtSigningKeyClient struct {
+	config
+}
+
+// NewJwtSigningKeyClient returns a client for the JwtSigningKey from the given config.
+func NewJwtSigningKeyClient(c config) *JwtSigningKeyClient {
+	return &JwtSigningKeyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `jwtsigningkey.Hooks(f(g(h())))`.
+func (c *JwtSigningKeyClient) Use(hooks ...Hook) {
+	c.hooks.JwtSigningKey = append(c.hooks.JwtSigningKey, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `jwtsigningkey.Intercept(f(g(h())))`.
+func (c *JwtSigningKeyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.JwtSigningKey = append(c.inters.JwtSigningKey, interceptors...)
+}
+
+// Create returns a builder for creating a JwtSigningKey entity.
+func (c *JwtSigningKeyClient) Create() *JwtSigningKeyCreate {
+	mutation := newJwtSigningKeyMutation(c.config, OpCreate)
+	return &JwtSigningKeyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of JwtSigningKey entities.
+func (c *JwtSigningKeyClient) CreateBulk(builders ...*JwtSigningKeyCreate) *JwtSigningKeyCreateBulk {
+	return &JwtSigningKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *JwtSigningKeyClient) MapCreateBulk(slice any, setFunc func(*JwtSigningKeyCreate, int)) *JwtSigningKeyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &JwtSigningKeyCreateBulk{err: fmt.Errorf("calling to JwtSigningKeyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*JwtSigningKeyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &JwtSigningKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for JwtSigningKey.
+func (c *JwtSigningKeyClient) Update() *JwtSigningKeyUpdate {
+	mutation := newJwtSigningKeyMutation(c.config, OpUpdate)
+	return &JwtSigningKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *JwtSigningKeyClient) UpdateOne(_m *JwtSigningKey) *JwtSigningKeyUpdateOne {
+	mutation := newJwtSigningKeyMutation(c.config, OpUpdateOne, withJwtSigningKey(_m))
+	return &JwtSigningKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *JwtSigningKeyClient) UpdateOneID(id uint32) *JwtSigningKeyUpdateOne {
+	mutation := newJwtSigningKeyMutation(c.config, OpUpdateOne, withJwtSigningKeyID(id))
+	return &JwtSigningKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for JwtSigningKey.
+func (c *JwtSigningKeyClient) Delete() *JwtSigningKeyDelete {
+	mutation := newJwtSigningKeyMutation(c.config, OpDelete)
+	return &JwtSigningKeyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *JwtSigningKeyClient) DeleteOne(_m *JwtSigningKey) *JwtSigningKeyDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *JwtSigningKeyClient) DeleteOneID(id uint32) *JwtSigningKeyDeleteOne {
+	builder := c.Delete().Where(jwtsigningkey.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &JwtSigningKeyDeleteOne{builder}
+}
+
+// Query returns a query builder for JwtSigningKey.
+func (c *JwtSigningKeyClient) Query() *JwtSigningKeyQuery {
+	return &JwtSigningKeyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeJwtSigningKey},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a JwtSigningKey entity by its id.
+func (c *JwtSigningKeyClient) Get(ctx context.Context, id uint32) (*JwtSigningKey, error) {
+	return c.Query().Where(jwtsigningkey.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *JwtSigningKeyClient) GetX(ctx context.Context, id uint32) *JwtSigningKey {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *JwtSigningKeyClient) Hooks() []Hook {
+	return c.hooks.JwtSigningKey
+}
+
+// Interceptors returns the client interceptors.
+func (c *JwtSigningKeyClient) Interceptors() []Interceptor {
+	return c.inters.JwtSigningKey
+}
+
+func (c *JwtSigningKeyClient) mutate(ctx context.Context, m *JwtSigningKeyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&JwtSigningKeyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&JwtSigningKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&JwtSigningKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&JwtSigningKeyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown JwtSigningKey mutation op: %q", m.Op())
+	}
+}
+
 // LanguageClient is a client for the Language schema.
 type LanguageClient struct {
 	config
@@ -2086,6 +2439,140 @@ func (c *LanguageClient) mutate(ctx context.Context, m *LanguageMutation) (Value
 	}
 }
 
+// LdapConfigClient is a client for the LdapConfig schema.
+type LdapConfigClient struct {
+	config
+}
+
+// NewLdapConfigClient returns a client for the LdapConfig from the given config.
+func NewLdapConfigClient(c config) *LdapConfigClient {
+	return &LdapConfigClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `ldapconfig.Hooks(f(g(h())))`.
+func (c *LdapConfigClient) Use(hooks ...Hook) {
+	c.hooks.LdapConfig = append(c.hooks.LdapConfig, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `ldapconfig.Intercept(f(g(h())))`.
+func (c *LdapConfigClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LdapConfig = append(c.inters.LdapConfig, interceptors...)
+}
+
+// Create returns a builder for creating a LdapConfig entity.
+func (c *LdapConfigClient) Create() *LdapConfigCreate {
+	mutation := newLdapConfigMutation(c.config, OpCreate)
+	return &LdapConfigCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LdapConfig entities.
+func (c *LdapConfigClient) CreateBulk(builders ...*LdapConfigCreate) *LdapConfigCreateBulk {
+	return &LdapConfigCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LdapConfigClient) MapCreateBulk(slice any, setFunc func(*LdapConfigCreate, int)) *LdapConfigCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LdapConfigCreateBulk{err: fmt.Errorf("calling to LdapConfigClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LdapConfigCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LdapConfigCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LdapConfig.
+func (c *LdapConfigClient) Update() *LdapConfigUpdate {
+	mutation := newLdapConfigMutation(c.config, OpUpdate)
+	return &LdapConfigUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LdapConfigClient) UpdateOne(_m *LdapConfig) *LdapConfigUpdateOne {
+	mutation := newLdapConfigMutation(c.config, OpUpdateOne, withLdapConfig(_m))
+	return &LdapConfigUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LdapConfigClient) UpdateOneID(id uint32) *LdapConfigUpdateOne {
+	mutation := newLdapConfigMutation(c.config, OpUpdateOne, withLdapConfigID(id))
+	return &LdapConfigUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LdapConfig.
+func (c *LdapConfigClient) Delete() *LdapConfigDelete {
+	mutation := newLdapConfigMutation(c.config, OpDelete)
+	return &LdapConfigDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LdapConfigClient) DeleteOne(_m *LdapConfig) *LdapConfigDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LdapConfigClient) DeleteOneID(id uint32) *LdapConfigDeleteOne {
+	builder := c.Delete().Where(ldapconfig.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LdapConfigDeleteOne{builder}
+}
+
+// Query returns a query builder for LdapConfig.
+func (c *LdapConfigClient) Query() *LdapConfigQuery {
+	return &LdapConfigQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLdapConfig},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LdapConfig entity by its id.
+func (c *LdapConfigClient) Get(ctx context.Context, id uint32) (*LdapConfig, error) {
+	return c.Query().Where(ldapconfig.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LdapConfigClient) GetX(ctx context.Context, id uint32) *LdapConfig {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *LdapConfigClient) Hooks() []Hook {
+	hooks := c.hooks.LdapConfig
+	return append(hooks[:len(hooks):len(hooks)], ldapconfig.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *LdapConfigClient) Interceptors() []Interceptor {
+	return c.inters.LdapConfig
+}
+
+func (c *LdapConfigClient) mutate(ctx context.Context, m *LdapConfigMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LdapConfigCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LdapConfigUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LdapConfigUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LdapConfigDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LdapConfig mutation op: %q", m.Op())
+	}
+}
+
 // LoginAuditLogClient is a client for the LoginAuditLog schema.
 type LoginAuditLogClient struct {
 	config
@@ -3055,69 +3542,337 @@ func (c *MenuClient) mutate(ctx context.Context, m *MenuMutation) (Value, error)
 	}
 }
 
-// OperationAuditLogClient is a client for the OperationAuditLog schema.
-type OperationAuditLogClient struct {
+// MfaPolicyClient is a client for the MfaPolicy schema.
+type MfaPolicyClient struct {
 	config
 }
 
-// NewOperationAuditLogClient returns a client for the OperationAuditLog from the given config.
-func NewOperationAuditLogClient(c config) *OperationAuditLogClient {
-	return &OperationAuditLogClient{config: c}
+// NewMfaPolicyClient returns a client for the MfaPolicy from the given config.
+func NewMfaPolicyClient(c config) *MfaPolicyClient {
+	return &MfaPolicyClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `operationauditlog.Hooks(f(g(h())))`.
-func (c *OperationAuditLogClient) Use(hooks ...Hook) {
-	c.hooks.OperationAuditLog = append(c.hooks.OperationAuditLog, hooks...)
+// A call to `Use(f, g, h)` equals to `mfapolicy.Hooks(f(g(h())))`.
+func (c *MfaPolicyClient) Use(hooks ...Hook) {
+	c.hooks.MfaPolicy = append(c.hooks.MfaPolicy, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `operationauditlog.Intercept(f(g(h())))`.
-func (c *OperationAuditLogClient) Intercept(interceptors ...Interceptor) {
-	c.inters.OperationAuditLog = append(c.inters.OperationAuditLog, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `mfapolicy.Intercept(f(g(h())))`.
+func (c *MfaPolicyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MfaPolicy = append(c.inters.MfaPolicy, interceptors...)
 }
 
-// Create returns a builder for creating a OperationAuditLog entity.
-func (c *OperationAuditLogClient) Create() *OperationAuditLogCreate {
-	mutation := newOperationAuditLogMutation(c.config, OpCreate)
-	return &OperationAuditLogCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a MfaPolicy entity.
+func (c *MfaPolicyClient) Create() *MfaPolicyCreate {
+	mutation := newMfaPolicyMutation(c.config, OpCreate)
+	return &MfaPolicyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of OperationAuditLog entities.
-func (c *OperationAuditLogClient) CreateBulk(builders ...*OperationAuditLogCreate) *OperationAuditLogCreateBulk {
-	return &OperationAuditLogCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of MfaPolicy entities.
+func (c *MfaPolicyClient) CreateBulk(builders ...*MfaPolicyCreate) *MfaPolicyCreateBulk {
+	return &MfaPolicyCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *OperationAuditLogClient) MapCreateBulk(slice any, setFunc func(*OperationAuditLogCreate, int)) *OperationAuditLogCreateBulk {
+func (c *MfaPolicyClient) MapCreateBulk(slice any, setFunc func(*MfaPolicyCreate, int)) *MfaPolicyCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &OperationAuditLogCreateBulk{err: fmt.Errorf("calling to OperationAuditLogClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &MfaPolicyCreateBulk{err: fmt.Errorf("calling to MfaPolicyClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*OperationAuditLogCreate, rv.Len())
+	builders := make([]*MfaPolicyCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &OperationAuditLogCreateBulk{config: c.config, builders: builders}
+	return &MfaPolicyCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for OperationAuditLog.
-func (c *OperationAuditLogClient) Update() *OperationAuditLogUpdate {
-	mutation := newOperationAuditLogMutation(c.config, OpUpdate)
-	return &OperationAuditLogUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for MfaPolicy.
+func (c *MfaPolicyClient) Update() *MfaPolicyUpdate {
+	mutation := newMfaPolicyMutation(c.config, OpUpdate)
+	return &MfaPolicyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *OperationAuditLogClient) UpdateOne(_m *OperationAuditLog) *OperationAuditLogUpdateOne {
-	mutation := newOperationAuditLogMutation(c.config, OpUpdateOne, withOperationAuditLog(_m))
-	return &OperationAuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *MfaPolicyClient) UpdateOne(_m *MfaPolicy) *MfaPolicyUpdateOne {
+	mutation := newMfaPolicyMutation(c.config, OpUpdateOne, withMfaPolicy(_m))
+	return &MfaPolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *OperationAuditLogClient) UpdateOneID(id uint32) *OperationAuditLogUpdateOne {
-	mutation := newOperationAuditLogMutation(c.config, OpUpdateOne, withOperationAuditLogID(id))
+func (c *MfaPolicyClient) UpdateOneID(id uint32) *MfaPolicyUpdateOne {
+	mutation := newMfaPolicyMutation(c.config, OpUpdateOne, withMfaPolicyID(id))
+	return &MfaPolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MfaPolicy.
+func (c *MfaPolicyClient) Delete() *MfaPolicyDelete {
+	mutation := newMfaPolicyMutation(c.config, OpDelete)
+	return &MfaPolicyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MfaPolicyClient) DeleteOne(_m *MfaPolicy) *MfaPolicyDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MfaPolicyClient) DeleteOneID(id uint32) *MfaPolicyDeleteOne {
+	builder := c.Delete().Where(mfapolicy.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MfaPolicyDeleteOne{builder}
+}
+
+// Query returns a query builder for MfaPolicy.
+func (c *MfaPolicyClient) Query() *MfaPolicyQuery {
+	return &MfaPolicyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMfaPolicy},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a MfaPolicy entity by its id.
+func (c *MfaPolicyClient) Get(ctx context.Context, id uint32) (*MfaPolicy, error) {
+	return c.Query().Where(mfapolicy.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MfaPolicyClient) GetX(ctx context.Context, id uint32) *MfaPolicy {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *MfaPolicyClient) Hooks() []Hook {
+	hooks := c.hooks.MfaPolicy
+	return append(hooks[:len(hooks):len(hooks)], mfapolicy.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *MfaPolicyClient) Interceptors() []Interceptor {
+	return c.inters.MfaPolicy
+}
+
+func (c *MfaPolicyClient) mutate(ctx context.Context, m *MfaPolicyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MfaPolicyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MfaPolicyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MfaPolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MfaPolicyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown MfaPolicy mutation op: %q", m.Op())
+	}
+}
+
+// OAuthProviderConfigClient is a client for the OAuthProviderConfig schema.
+type OAuthProviderConfigClient struct {
+	config
+}
+
+// NewOAuthProviderConfigClient returns a client for the OAuthProviderConfig from the given config.
+func NewOAuthProviderConfigClient(c config) *OAuthProviderConfigClient {
+	return &OAuthProviderConfigClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `oauthproviderconfig.Hooks(f(g(h())))`.
+func (c *OAuthProviderConfigClient) Use(hooks ...Hook) {
+	c.hooks.OAuthProviderConfig = append(c.hooks.OAuthProviderConfig, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `oauthproviderconfig.Intercept(f(g(h())))`.
+func (c *OAuthProviderConfigClient) Intercept(interceptors ...Interceptor) {
+	c.inters.OAuthProviderConfig = append(c.inters.OAuthProviderConfig, interceptors...)
+}
+
+// Create returns a builder for creating a OAuthProviderConfig entity.
+func (c *OAuthProviderConfigClient) Create() *OAuthProviderConfigCreate {
+	mutation := newOAuthProviderConfigMutation(c.config, OpCreate)
+	return &OAuthProviderConfigCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of OAuthProviderConfig entities.
+func (c *OAuthProviderConfigClient) CreateBulk(builders ...*OAuthProviderConfigCreate) *OAuthProviderConfigCreateBulk {
+	return &OAuthProviderConfigCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OAuthProviderConfigClient) MapCreateBulk(slice any, setFunc func(*OAuthProviderConfigCreate, int)) *OAuthProviderConfigCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OAuthProviderConfigCreateBulk{err: fmt.Errorf("calling to OAuthProviderConfigClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OAuthProviderConfigCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OAuthProviderConfigCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for OAuthProviderConfig.
+func (c *OAuthProviderConfigClient) Update() *OAuthProviderConfigUpdate {
+	mutation := newOAuthProviderConfigMutation(c.config, OpUpdate)
+	return &OAuthProviderConfigUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OAuthProviderConfigClient) UpdateOne(_m *OAuthProviderConfig) *OAuthProviderConfigUpdateOne {
+	mutation := newOAuthProviderConfigMutation(c.config, OpUpdateOne, withOAuthProviderConfig(_m))
+	return &OAuthProviderConfigUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OAuthProviderConfigClient) UpdateOneID(id uint32) *OAuthProviderConfigUpdateOne {
+	mutation := newOAuthProviderConfigMutation(c.config, OpUpdateOne, withOAuthProviderConfigID(id))
+	return &OAuthProviderConfigUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for OAuthProviderConfig.
+func (c *OAuthProviderConfigClient) Delete() *OAuthProviderConfigDelete {
+	mutation := newOAuthProviderConfigMutation(c.config, OpDelete)
+	return &OAuthProviderConfigDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OAuthProviderConfigClient) DeleteOne(_m *OAuthProviderConfig) *OAuthProviderConfigDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OAuthProviderConfigClient) DeleteOneID(id uint32) *OAuthProviderConfigDeleteOne {
+	builder := c.Delete().Where(oauthproviderconfig.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OAuthProviderConfigDeleteOne{builder}
+}
+
+// Query returns a query builder for OAuthProviderConfig.
+func (c *OAuthProviderConfigClient) Query() *OAuthProviderConfigQuery {
+	return &OAuthProviderConfigQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOAuthProviderConfig},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a OAuthProviderConfig entity by its id.
+func (c *OAuthProviderConfigClient) Get(ctx context.Context, id uint32) (*OAuthProviderConfig, error) {
+	return c.Query().Where(oauthproviderconfig.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OAuthProviderConfigClient) GetX(ctx context.Context, id uint32) *OAuthProviderConfig {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *OAuthProviderConfigClient) Hooks() []Hook {
+	hooks := c.hooks.OAuthProviderConfig
+	return append(hooks[:len(hooks):len(hooks)], oauthproviderconfig.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *OAuthProviderConfigClient) Interceptors() []Interceptor {
+	return c.inters.OAuthProviderConfig
+}
+
+func (c *OAuthProviderConfigClient) mutate(ctx context.Context, m *OAuthProviderConfigMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OAuthProviderConfigCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OAuthProviderConfigUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OAuthProviderConfigUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OAuthProviderConfigDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown OAuthProviderConfig mutation op: %q", m.Op())
+	}
+}
+
+// OperationAuditLogClient is a client for the OperationAuditLog schema.
+type OperationAuditLogClient struct {
+	config
+}
+
+// NewOperationAuditLogClient returns a client for the OperationAuditLog from the given config.
+func NewOperationAuditLogClient(c config) *OperationAuditLogClient {
+	return &OperationAuditLogClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `operationauditlog.Hooks(f(g(h())))`.
+func (c *OperationAuditLogClient) Use(hooks ...Hook) {
+	c.hooks.OperationAuditLog = append(c.hooks.OperationAuditLog, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `operationauditlog.Intercept(f(g(h())))`.
+func (c *OperationAuditLogClient) Intercept(interceptors ...Interceptor) {
+	c.inters.OperationAuditLog = append(c.inters.OperationAuditLog, interceptors...)
+}
+
+// Create returns a builder for creating a OperationAuditLog entity.
+func (c *OperationAuditLogClient) Create() *OperationAuditLogCreate {
+	mutation := newOperationAuditLogMutation(c.config, OpCreate)
+	return &OperationAuditLogCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of OperationAuditLog entities.
+func (c *OperationAuditLogClient) CreateBulk(builders ...*OperationAuditLogCreate) *OperationAuditLogCreateBulk {
+	return &OperationAuditLogCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OperationAuditLogClient) MapCreateBulk(slice any, setFunc func(*OperationAuditLogCreate, int)) *OperationAuditLogCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OperationAuditLogCreateBulk{err: fmt.Errorf("calling to OperationAuditLogClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OperationAuditLogCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OperationAuditLogCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for OperationAuditLog.
+func (c *OperationAuditLogClient) Update() *OperationAuditLogUpdate {
+	mutation := newOperationAuditLogMutation(c.config, OpUpdate)
+	return &OperationAuditLogUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OperationAuditLogClient) UpdateOne(_m *OperationAuditLog) *OperationAuditLogUpdateOne {
+	mutation := newOperationAuditLogMutation(c.config, OpUpdateOne, withOperationAuditLog(_m))
+	return &OperationAuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OperationAuditLogClient) UpdateOneID(id uint32) *OperationAuditLogUpdateOne {
+	mutation := newOperationAuditLogMutation(c.config, OpUpdateOne, withOperationAuditLogID(id))
 	return &OperationAuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
@@ -3355,6 +4110,140 @@ func (c *OrgUnitClient) mutate(ctx context.Context, m *OrgUnitMutation) (Value,
 	}
 }
 
+// PasswordPolicyClient is a client for the PasswordPolicy schema.
+type PasswordPolicyClient struct {
+	config
+}
+
+// NewPasswordPolicyClient returns a client for the PasswordPolicy from the given config.
+func NewPasswordPolicyClient(c config) *PasswordPolicyClient {
+	return &PasswordPolicyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `passwordpolicy.Hooks(f(g(h())))`.
+func (c *PasswordPolicyClient) Use(hooks ...Hook) {
+	c.hooks.PasswordPolicy = append(c.hooks.PasswordPolicy, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `passwordpolicy.Intercept(f(g(h())))`.
+func (c *PasswordPolicyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PasswordPolicy = append(c.inters.PasswordPolicy, interceptors...)
+}
+
+// Create returns a builder for creating a PasswordPolicy entity.
+func (c *PasswordPolicyClient) Create() *PasswordPolicyCreate {
+	mutation := newPasswordPolicyMutation(c.config, OpCreate)
+	return &PasswordPolicyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PasswordPolicy entities.
+func (c *PasswordPolicyClient) CreateBulk(builders ...*PasswordPolicyCreate) *PasswordPolicyCreateBulk {
+	return &PasswordPolicyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PasswordPolicyClient) MapCreateBulk(slice any, setFunc func(*PasswordPolicyCreate, int)) *PasswordPolicyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PasswordPolicyCreateBulk{err: fmt.Errorf("calling to PasswordPolicyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PasswordPolicyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PasswordPolicyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PasswordPolicy.
+func (c *PasswordPolicyClient) Update() *PasswordPolicyUpdate {
+	mutation := newPasswordPolicyMutation(c.config, OpUpdate)
+	return &PasswordPolicyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PasswordPolicyClient) UpdateOne(_m *PasswordPolicy) *PasswordPolicyUpdateOne {
+	mutation := newPasswordPolicyMutation(c.config, OpUpdateOne, withPasswordPolicy(_m))
+	return &PasswordPolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PasswordPolicyClient) UpdateOneID(id uint32) *PasswordPolicyUpdateOne {
+	mutation := newPasswordPolicyMutation(c.config, OpUpdateOne, withPasswordPolicyID(id))
+	return &PasswordPolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PasswordPolicy.
+func (c *PasswordPolicyClient) Delete() *PasswordPolicyDelete {
+	mutation := newPasswordPolicyMutation(c.config, OpDelete)
+	return &PasswordPolicyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PasswordPolicyClient) DeleteOne(_m *PasswordPolicy) *PasswordPolicyDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PasswordPolicyClient) DeleteOneID(id uint32) *PasswordPolicyDeleteOne {
+	builder := c.Delete().Where(passwordpolicy.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PasswordPolicyDeleteOne{builder}
+}
+
+// Query returns a query builder for PasswordPolicy.
+func (c *PasswordPolicyClient) Query() *PasswordPolicyQuery {
+	return &PasswordPolicyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePasswordPolicy},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PasswordPolicy entity by its id.
+func (c *PasswordPolicyClient) Get(ctx context.Context, id uint32) (*PasswordPolicy, error) {
+	return c.Query().Where(passwordpolicy.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PasswordPolicyClient) GetX(ctx context.Context, id uint32) *PasswordPolicy {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *PasswordPolicyClient) Hooks() []Hook {
+	hooks := c.hooks.PasswordPolicy
+	return append(hooks[:len(hooks):len(hooks)], passwordpolicy.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *PasswordPolicyClient) Interceptors() []Interceptor {
+	return c.inters.PasswordPolicy
+}
+
+func (c *PasswordPolicyClient) mutate(ctx context.Context, m *PasswordPolicyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PasswordPolicyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PasswordPolicyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PasswordPolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PasswordPolicyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PasswordPolicy mutation op: %q", m.Op())
+	}
+}
+
 // PermissionClient is a client for the Permission schema.
 type PermissionClient struct {
 	config
@@ -4933,6 +5822,272 @@ func (c *PositionClient) mutate(ctx context.Context, m *PositionMutation) (Value
 	}
 }
 
+// RelationTupleClient is a client for the RelationTuple schema.
+type RelationTupleClient struct {
+	config
+}
+
+// NewRelationTupleClient returns a client for the RelationTuple from the given config.
+func NewRelationTupleClient(c config) *RelationTupleClient {
+	return &RelationTupleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `relationtuple.Hooks(f(g(h())))`.
+func (c *RelationTupleClient) Use(hooks ...Hook) {
+	c.hooks.RelationTuple = append(c.hooks.RelationTuple, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `relationtuple.Intercept(f(g(h())))`.
+func (c *RelationTupleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RelationTuple = append(c.inters.RelationTuple, interceptors...)
+}
+
+// Create returns a builder for creating a RelationTuple entity.
+func (c *RelationTupleClient) Create() *RelationTupleCreate {
+	mutation := newRelationTupleMutation(c.config, OpCreate)
+	return &RelationTupleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RelationTuple entities.
+func (c *RelationTupleClient) CreateBulk(builders ...*RelationTupleCreate) *RelationTupleCreateBulk {
+	return &RelationTupleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RelationTupleClient) MapCreateBulk(slice any, setFunc func(*RelationTupleCreate, int)) *RelationTupleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RelationTupleCreateBulk{err: fmt.Errorf("calling to RelationTupleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RelationTupleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RelationTupleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RelationTuple.
+func (c *RelationTupleClient) Update() *RelationTupleUpdate {
+	mutation := newRelationTupleMutation(c.config, OpUpdate)
+	return &RelationTupleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RelationTupleClient) UpdateOne(_m *RelationTuple) *RelationTupleUpdateOne {
+	mutation := newRelationTupleMutation(c.config, OpUpdateOne, withRelationTuple(_m))
+	return &RelationTupleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RelationTupleClient) UpdateOneID(id uint32) *RelationTupleUpdateOne {
+	mutation := newRelationTupleMutation(c.config, OpUpdateOne, withRelationTupleID(id))
+	return &RelationTupleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RelationTuple.
+func (c *RelationTupleClient) Delete() *RelationTupleDelete {
+	mutation := newRelationTupleMutation(c.config, OpDelete)
+	return &RelationTupleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RelationTupleClient) DeleteOne(_m *RelationTuple) *RelationTupleDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RelationTupleClient) DeleteOneID(id uint32) *RelationTupleDeleteOne {
+	builder := c.Delete().Where(relationtuple.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RelationTupleDeleteOne{builder}
+}
+
+// Query returns a query builder for RelationTuple.
+func (c *RelationTupleClient) Query() *RelationTupleQuery {
+	return &RelationTupleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRelationTuple},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RelationTuple entity by its id.
+func (c *RelationTupleClient) Get(ctx context.Context, id uint32) (*RelationTuple, error) {
+	return c.Query().Where(relationtuple.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RelationTupleClient) GetX(ctx context.Context, id uint32) *RelationTuple {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *RelationTupleClient) Hooks() []Hook {
+	return c.hooks.RelationTuple
+}
+
+// Interceptors returns the client interceptors.
+func (c *RelationTupleClient) Interceptors() []Interceptor {
+	return c.inters.RelationTuple
+}
+
+func (c *RelationTupleClient) mutate(ctx context.Context, m *RelationTupleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RelationTupleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RelationTupleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RelationTupleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RelationTupleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RelationTuple mutation op: %q", m.Op())
+	}
+}
+
+// RelationTupleChangeClient is a client for the RelationTupleChange schema.
+type RelationTupleChangeClient struct {
+	config
+}
+
+// NewRelationTupleChangeClient returns a client for the RelationTupleChange from the given config.
+func NewRelationTupleChangeClient(c config) *RelationTupleChangeClient {
+	return &RelationTupleChangeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `relationtuplechange.Hooks(f(g(h())))`.
+func (c *RelationTupleChangeClient) Use(hooks ...Hook) {
+	c.hooks.RelationTupleChange = append(c.hooks.RelationTupleChange, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `relationtuplechange.Intercept(f(g(h())))`.
+func (c *RelationTupleChangeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RelationTupleChange = append(c.inters.RelationTupleChange, interceptors...)
+}
+
+// Create returns a builder for creating a RelationTupleChange entity.
+func (c *RelationTupleChangeClient) Create() *RelationTupleChangeCreate {
+	mutation := newRelationTupleChangeMutation(c.config, OpCreate)
+	return &RelationTupleChangeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RelationTupleChange entities.
+func (c *RelationTupleChangeClient) CreateBulk(builders ...*RelationTupleChangeCreate) *RelationTupleChangeCreateBulk {
+	return &RelationTupleChangeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RelationTupleChangeClient) MapCreateBulk(slice any, setFunc func(*RelationTupleChangeCreate, int)) *RelationTupleChangeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RelationTupleChangeCreateBulk{err: fmt.Errorf("calling to RelationTupleChangeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RelationTupleChangeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RelationTupleChangeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RelationTupleChange.
+func (c *RelationTupleChangeClient) Update() *RelationTupleChangeUpdate {
+	mutation := newRelationTupleChangeMutation(c.config, OpUpdate)
+	return &RelationTupleChangeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RelationTupleChangeClient) UpdateOne(_m *RelationTupleChange) *RelationTupleChangeUpdateOne {
+	mutation := newRelationTupleChangeMutation(c.config, OpUpdateOne, withRelationTupleChange(_m))
+	return &RelationTupleChangeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RelationTupleChangeClient) UpdateOneID(id uint32) *RelationTupleChangeUpdateOne {
+	mutation := newRelationTupleChangeMutation(c.config, OpUpdateOne, withRelationTupleChangeID(id))
+	return &RelationTupleChangeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RelationTupleChange.
+func (c *RelationTupleChangeClient) Delete() *RelationTupleChangeDelete {
+	mutation := newRelationTupleChangeMutation(c.config, OpDelete)
+	return &RelationTupleChangeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RelationTupleChangeClient) DeleteOne(_m *RelationTupleChange) *RelationTupleChangeDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RelationTupleChangeClient) DeleteOneID(id uint32) *RelationTupleChangeDeleteOne {
+	builder := c.Delete().Where(relationtuplechange.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RelationTupleChangeDeleteOne{builder}
+}
+
+// Query returns a query builder for RelationTupleChange.
+func (c *RelationTupleChangeClient) Query() *RelationTupleChangeQuery {
+	return &RelationTupleChangeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRelationTupleChange},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RelationTupleChange entity by its id.
+func (c *RelationTupleChangeClient) Get(ctx context.Context, id uint32) (*RelationTupleChange, error) {
+	return c.Query().Where(relationtuplechange.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RelationTupleChangeClient) GetX(ctx context.Context, id uint32) *RelationTupleChange {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *RelationTupleChangeClient) Hooks() []Hook {
+	return c.hooks.RelationTupleChange
+}
+
+// Interceptors returns the client interceptors.
+func (c *RelationTupleChangeClient) Interceptors() []Interceptor {
+	return c.inters.RelationTupleChange
+}
+
+func (c *RelationTupleChangeClient) mutate(ctx context.Context, m *RelationTupleChangeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RelationTupleChangeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RelationTupleChangeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RelationTupleChangeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RelationTupleChangeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RelationTupleChange mutation op: %q", m.Op())
+	}
+}
+
 // RoleClient is a client for the Role schema.
 type RoleClient struct {
 	config
@@ -5335,6 +6490,274 @@ func (c *RolePermissionClient) mutate(ctx context.Context, m *RolePermissionMuta
 	}
 }
 
+// SamlConfigClient is a client for the SamlConfig schema.
+type SamlConfigClient struct {
+	config
+}
+
+// NewSamlConfigClient returns a client for the SamlConfig from the given config.
+func NewSamlConfigClient(c config) *SamlConfigClient {
+	return &SamlConfigClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `samlconfig.Hooks(f(g(h())))`.
+func (c *SamlConfigClient) Use(hooks ...Hook) {
+	c.hooks.SamlConfig = append(c.hooks.SamlConfig, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `samlconfig.Intercept(f(g(h())))`.
+func (c *SamlConfigClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SamlConfig = append(c.inters.SamlConfig, interceptors...)
+}
+
+// Create returns a builder for creating a SamlConfig entity.
+func (c *SamlConfigClient) Create() *SamlConfigCreate {
+	mutation := newSamlConfigMutation(c.config, OpCreate)
+	return &SamlConfigCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SamlConfig entities.
+func (c *SamlConfigClient) CreateBulk(builders ...*SamlConfigCreate) *SamlConfigCreateBulk {
+	return &SamlConfigCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SamlConfigClient) MapCreateBulk(slice any, setFunc func(*SamlConfigCreate, int)) *SamlConfigCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SamlConfigCreateBulk{err: fmt.Errorf("calling to SamlConfigClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SamlConfigCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SamlConfigCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SamlConfig.
+func (c *SamlConfigClient) Update() *SamlConfigUpdate {
+	mutation := newSamlConfigMutation(c.config, OpUpdate)
+	return &SamlConfigUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SamlConfigClient) UpdateOne(_m *SamlConfig) *SamlConfigUpdateOne {
+	mutation := newSamlConfigMutation(c.config, OpUpdateOne, withSamlConfig(_m))
+	return &SamlConfigUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SamlConfigClient) UpdateOneID(id uint32) *SamlConfigUpdateOne {
+	mutation := newSamlConfigMutation(c.config, OpUpdateOne, withSamlConfigID(id))
+	return &SamlConfigUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SamlConfig.
+func (c *SamlConfigClient) Delete() *SamlConfigDelete {
+	mutation := newSamlConfigMutation(c.config, OpDelete)
+	return &SamlConfigDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SamlConfigClient) DeleteOne(_m *SamlConfig) *SamlConfigDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SamlConfigClient) DeleteOneID(id uint32) *SamlConfigDeleteOne {
+	builder := c.Delete().Where(samlconfig.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SamlConfigDeleteOne{builder}
+}
+
+// Query returns a query builder for SamlConfig.
+func (c *SamlConfigClient) Query() *SamlConfigQuery {
+	return &SamlConfigQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSamlConfig},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SamlConfig entity by its id.
+func (c *SamlConfigClient) Get(ctx context.Context, id uint32) (*SamlConfig, error) {
+	return c.Query().Where(samlconfig.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SamlConfigClient) GetX(ctx context.Context, id uint32) *SamlConfig {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SamlConfigClient) Hooks() []Hook {
+	hooks := c.hooks.SamlConfig
+	return append(hooks[:len(hooks):len(hooks)], samlconfig.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *SamlConfigClient) Interceptors() []Interceptor {
+	return c.inters.SamlConfig
+}
+
+func (c *SamlConfigClient) mutate(ctx context.Context, m *SamlConfigMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SamlConfigCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SamlConfigUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SamlConfigUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SamlConfigDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SamlConfig mutation op: %q", m.Op())
+	}
+}
+
+// ScimTokenClient is a client for the ScimToken schema.
+type ScimTokenClient struct {
+	config
+}
+
+// NewScimTokenClient returns a client for the ScimToken from the given config.
+func NewScimTokenClient(c config) *ScimTokenClient {
+	return &ScimTokenClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `scimtoken.Hooks(f(g(h())))`.
+func (c *ScimTokenClient) Use(hooks ...Hook) {
+	c.hooks.ScimToken = append(c.hooks.ScimToken, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `scimtoken.Intercept(f(g(h())))`.
+func (c *ScimTokenClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ScimToken = append(c.inters.ScimToken, interceptors...)
+}
+
+// Create returns a builder for creating a ScimToken entity.
+func (c *ScimTokenClient) Create() *ScimTokenCreate {
+	mutation := newScimTokenMutation(c.config, OpCreate)
+	return &ScimTokenCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ScimToken entities.
+func (c *ScimTokenClient) CreateBulk(builders ...*ScimTokenCreate) *ScimTokenCreateBulk {
+	return &ScimTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ScimTokenClient) MapCreateBulk(slice any, setFunc func(*ScimTokenCreate, int)) *ScimTokenCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ScimTokenCreateBulk{err: fmt.Errorf("calling to ScimTokenClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ScimTokenCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ScimTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ScimToken.
+func (c *ScimTokenClient) Update() *ScimTokenUpdate {
+	mutation := newScimTokenMutation(c.config, OpUpdate)
+	return &ScimTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ScimTokenClient) UpdateOne(_m *ScimToken) *ScimTokenUpdateOne {
+	mutation := newScimTokenMutation(c.config, OpUpdateOne, withScimToken(_m))
+	return &ScimTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ScimTokenClient) UpdateOneID(id uint32) *ScimTokenUpdateOne {
+	mutation := newScimTokenMutation(c.config, OpUpdateOne, withScimTokenID(id))
+	return &ScimTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ScimToken.
+func (c *ScimTokenClient) Delete() *ScimTokenDelete {
+	mutation := newScimTokenMutation(c.config, OpDelete)
+	return &ScimTokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ScimTokenClient) DeleteOne(_m *ScimToken) *ScimTokenDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ScimTokenClient) DeleteOneID(id uint32) *ScimTokenDeleteOne {
+	builder := c.Delete().Where(scimtoken.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ScimTokenDeleteOne{builder}
+}
+
+// Query returns a query builder for ScimToken.
+func (c *ScimTokenClient) Query() *ScimTokenQuery {
+	return &ScimTokenQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeScimToken},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ScimToken entity by its id.
+func (c *ScimTokenClient) Get(ctx context.Context, id uint32) (*ScimToken, error) {
+	return c.Query().Where(scimtoken.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ScimTokenClient) GetX(ctx context.Context, id uint32) *ScimToken {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ScimTokenClient) Hooks() []Hook {
+	hooks := c.hooks.ScimToken
+	return append(hooks[:len(hooks):len(hooks)], scimtoken.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *ScimTokenClient) Interceptors() []Interceptor {
+	return c.inters.ScimToken
+}
+
+func (c *ScimTokenClient) mutate(ctx context.Context, m *ScimTokenMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ScimTokenCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ScimTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ScimTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ScimTokenDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ScimToken mutation op: %q", m.Op())
+	}
+}
+
 // TaskClient is a client for the Task schema.
 type TaskClient struct {
 	config
@@ -5595,7 +7018,8 @@ func (c *TenantClient) QueryPlan(_m *Tenant) *PlanQuery {
 
 // Hooks returns the client hooks.
 func (c *TenantClient) Hooks() []Hook {
-	return c.hooks.Tenant
+	hooks := c.hooks.Tenant
+	return append(hooks[:len(hooks):len(hooks)], tenant.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
@@ -6425,23 +7849,28 @@ func (c *UserRoleClient) mutate(ctx context.Context, m *UserRoleMutation) (Value
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Api, ApiAuditLog, DataAccessAuditLog, DictEntry, DictEntryI18n, DictType, File,
-		InternalMessage, InternalMessageCategory, InternalMessageRecipient, Language,
-		LoginAuditLog, LoginPolicy, Membership, MembershipOrgUnit, MembershipPosition,
-		MembershipRole, Menu, OperationAuditLog, OrgUnit, Permission, PermissionApi,
-		PermissionAuditLog, PermissionGroup, PermissionMenu, PermissionPolicy, Plan,
-		PlanModule, PlanQuota, PolicyEvaluationLog, Position, Role, RoleMetadata,
-		RolePermission, Task, Tenant, User, UserCredential, UserMfaFactor, UserOrgUnit,
-		UserPosition, UserRole []ent.Hook
+		Api, ApiAuditLog, ApiClient, DataAccessAuditLog, DictEntry, DictEntryI18n,
+		DictType, File, InternalMessage, InternalMessageCategory,
+		InternalMessageRecipient, JwtSigningKey, Language, LdapConfig, LoginAuditLog,
+		LoginPolicy, Membership, MembershipOrgUnit, MembershipPosition, MembershipRole,
+		Menu, MfaPolicy, OAuthProviderConfig, OperationAuditLog, OrgUnit,
+		PasswordPolicy, Permission, PermissionApi, PermissionAuditLog, PermissionGroup,
+		PermissionMenu, PermissionPolicy, Plan, PlanModule, PlanQuota,
+		PolicyEvaluationLog, Position, RelationTuple, RelationTupleChange, Role,
+		RoleMetadata, RolePermission, SamlConfig, ScimToken, Task, Tenant, User,
+		UserCredential, UserMfaFactor, UserOrgUnit, UserPosition, UserRole []ent.Hook
 	}
 	inters struct {
-		Api, ApiAuditLog, DataAccessAuditLog, DictEntry, DictEntryI18n, DictType, File,
-		InternalMessage, InternalMessageCategory, InternalMessageRecipient, Language,
-		LoginAuditLog, LoginPolicy, Membership, MembershipOrgUnit, MembershipPosition,
-		MembershipRole, Menu, OperationAuditLog, OrgUnit, Permission, PermissionApi,
-		PermissionAuditLog, PermissionGroup, PermissionMenu, PermissionPolicy, Plan,
-		PlanModule, PlanQuota, PolicyEvaluationLog, Position, Role, RoleMetadata,
-		RolePermission, Task, Tenant, User, UserCredential, UserMfaFactor, UserOrgUnit,
-		UserPosition, UserRole []ent.Interceptor
+		Api, ApiAuditLog, ApiClient, DataAccessAuditLog, DictEntry, DictEntryI18n,
+		DictType, File, InternalMessage, InternalMessageCategory,
+		InternalMessageRecipient, JwtSigningKey, Language, LdapConfig, LoginAuditLog,
+		LoginPolicy, Membership, MembershipOrgUnit, MembershipPosition, MembershipRole,
+		Menu, MfaPolicy, OAuthProviderConfig, OperationAuditLog, OrgUnit,
+		PasswordPolicy, Permission, PermissionApi, PermissionAuditLog, PermissionGroup,
+		PermissionMenu, PermissionPolicy, Plan, PlanModule, PlanQuota,
+		PolicyEvaluationLog, Position, RelationTuple, RelationTupleChange, Role,
+		RoleMetadata, RolePermission, SamlConfig, ScimToken, Task, Tenant, User,
+		UserCredential, UserMfaFactor, UserOrgUnit, UserPosition,
+		UserRole []ent.Interceptor
 	}
 )
@@ -5,6 +5,7 @@
 import (
 	"go-wind-admin/app/admin/service/internal/data/ent/api"
 	"go-wind-admin/app/admin/service/internal/data/ent/apiauditlog"
+	"go-wind-admin/app/admin/service/internal/data/ent/apiclient"
 	"go-wind-admin/app/admin/service/internal/data/ent/dataaccessauditlog"
 	"go-wind-admin/app/admin/service/internal/data/ent/dictentry"
 	"go-wind-admin/app/admin/service/internal/data/ent/dictentryi18n"
@@ -13,7 +14,9 @@
 	"go-wind-admin/app/admin/service/internal/data/ent/internalmessage"
 	"go-wind-admin/app/admin/service/internal/data/ent/internalmessagecategory"
 	"go-wind-admin/app/admin/service/internal/data/ent/internalmessagerecipient"
+	"go-wind-admin/app/admin/service/internal/data/ent/jwtsigningkey"
 	"go-wind-admin/app/admin/service/internal/data/ent/language"
+	"go-wind-admin/app/admin/service/internal/data/ent/ldapconfig"
 	"go-wind-admin/app/admin/service/internal/data/ent/loginauditlog"
 	"go-wind-admin/app/admin/service/internal/data/ent/loginpolicy"
 	"go-wind-admin/app/admin/service/internal/data/ent/membership"
@@ -21,8 +24,11 @@
 	"go-wind-admin/app/admin/service/internal/data/ent/membershipposition"
 	"go-wind-admin/app/admin/service/internal/data/ent/membershiprole"
 	"go-wind-admin/app/admin/service/internal/data/ent/menu"
+	"go-wind-admin/app/admin/service/internal/data/ent/mfapolicy"
+	"go-wind-admin/app/admin/service/internal/data/ent/oauthproviderconfig"
 	"go-wind-admin/app/admin/service/internal/data/ent/operationauditlog"
 	"go-wind-admin/app/admin/service/internal/data/ent/orgunit"
+	"go-wind-admin/app/admin/service/internal/data/ent/passwordpolicy"
 	"go-wind-admin/app/admin/service/internal/data/ent/permission"
 	"go-wind-admin/app/admin/service/internal/data/ent/permissionapi"
 	"go-wind-admin/app/admin/service/internal/data/ent/permissionauditlog"
@@ -35,9 +41,13 @@
 	"go-wind-admin/app/admin/service/internal/data/ent/policyevaluationlog"
 	"go-wind-admin/app/admin/service/internal/data/ent/position"
 	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+	"go-wind-admin/app/admin/service/internal/data/ent/relationtuple"
+	"go-wind-admin/app/admin/service/internal/data/ent/relationtuplechange"
 	"go-wind-admin/app/admin/service/internal/data/ent/role"
 	"go-wind-admin/app/admin/service/internal/data/ent/rolemetadata"
 	"go-wind-admin/app/admin/service/internal/data/ent/rolepermission"
+	"go-wind-admin/app/admin/service/internal/data/ent/samlconfig"
+	"go-wind-admin/app/admin/service/internal/data/ent/scimtoken"
 	"go-wind-admin/app/admin/service/internal/data/ent/task"
 	"go-wind-admin/app/admin/service/internal/data/ent/tenant"
 	"go-wind-admin/app/admin/service/internal/data/ent/user"
@@ -55,7 +65,7 @@
 
 // schemaGraph holds a representation of ent/schema at runtime.
 var schemaGraph = func() *sqlgraph.Schema {
-	graph := &sqlgraph.Schema{Nodes: make([]*sqlgraph.Node, 42)}
+	graph := &sqlgraph.Schema{Nodes: make([]*sqlgraph.Node, 52)}
 	graph.Nodes[0] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   api.Table,
@@ -95,36 +105,71 @@
 		},
 		Type: "ApiAuditLog",
 		Fields: map[string]*sqlgraph.FieldSpec{
-			apiauditlog.FieldCreatedAt:      {Type: field.TypeTime, Column: apiauditlog.FieldCreatedAt},
-			apiauditlog.FieldTenantID:       {Type: field.TypeUint32, Column: apiauditlog.FieldTenantID},
-			apiauditlog.FieldUserID:         {Type: field.TypeUint32, Column: apiauditlog.FieldUserID},
-			apiauditlog.FieldUsername:       {Type: field.TypeString, Column: apiauditlog.FieldUsername},
-			apiauditlog.FieldIPAddress:      {Type: field.TypeString, Column: apiauditlog.FieldIPAddress},
-			apiauditlog.FieldGeoLocation:    {Type: field.TypeJSON, Column: apiauditlog.FieldGeoLocation},
-			apiauditlog.FieldDeviceInfo:     {Type: field.TypeJSON, Column: apiauditlog.FieldDeviceInfo},
-			apiauditlog.FieldReferer:        {Type: field.TypeString, Column: apiauditlog.FieldReferer},
-			apiauditlog.FieldAppVersion:     {Type: field.TypeString, Column: apiauditlog.FieldAppVersion},
-			apiauditlog.FieldHTTPMethod:     {Type: field.TypeString, Column: apiauditlog.FieldHTTPMethod},
-			apiauditlog.FieldPath:           {Type: field.TypeString, Column: apiauditlog.FieldPath},
-			apiauditlog.FieldRequestURI:     {Type: field.TypeString, Column: apiauditlog.FieldRequestURI},
-			apiauditlog.FieldAPIModule:      {Type: field.TypeString, Column: apiauditlog.FieldAPIModule},
-			apiauditlog.FieldAPIOperation:   {Type: field.TypeString, Column: apiauditlog.FieldAPIOperation},
-			apiauditlog.FieldAPIDescription: {Type: field.TypeString, Column: apiauditlog.FieldAPIDescription},
-			apiauditlog.FieldRequestID:      {Type: field.TypeString, Column: apiauditlog.FieldRequestID},
-			apiauditlog.FieldTraceID:        {Type: field.TypeString, Column: apiauditlog.FieldTraceID},
-			apiauditlog.FieldSpanID:         {Type: field.TypeString, Column: apiauditlog.FieldSpanID},
-			apiauditlog.FieldLatencyMs:      {Type: field.TypeUint32, Column: apiauditlog.FieldLatencyMs},
-			apiauditlog.FieldSuccess:        {Type: field.TypeBool, Column: apiauditlog.FieldSuccess},
-			apiauditlog.FieldStatusCode:     {Type: field.TypeUint32, Column: apiauditlog.FieldStatusCode},
-			apiauditlog.FieldReason:         {Type: field.TypeString, Column: apiauditlog.FieldReason},
-			apiauditlog.FieldRequestHeader:  {Type: field.TypeString, Column: apiauditlog.FieldRequestHeader},
-			apiauditlog.FieldRequestBody:    {Type: field.TypeString, Column: apiauditlog.FieldRequestBody},
-			apiauditlog.FieldResponse:       {Type: field.TypeString, Column: apiauditlog.FieldResponse},
-			apiauditlog.FieldLogHash:        {Type: field.TypeString, Column: apiauditlog.FieldLogHash},
-			apiauditlog.FieldSignature:      {Type: field.TypeBytes, Column: apiauditlog.FieldSignature},
+			apiauditlog.FieldCreatedAt:        {Type: field.TypeTime, Column: apiauditlog.FieldCreatedAt},
+			apiauditlog.FieldTenantID:         {Type: field.TypeUint32, Column: apiauditlog.FieldTenantID},
+			apiauditlog.FieldUserID:           {Type: field.TypeUint32, Column: apiauditlog.FieldUserID},
+			apiauditlog.FieldUsername:         {Type: field.TypeString, Column: apiauditlog.FieldUsername},
+			apiauditlog.FieldImpersonatorID:   {Type: field.TypeUint32, Column: apiauditlog.FieldImpersonatorID},
+			apiauditlog.FieldImpersonatorName: {Type: field.TypeString, Column: apiauditlog.FieldImpersonatorName},
+			apiauditlog.FieldIPAddress:        {Type: field.TypeString, Column: apiauditlog.FieldIPAddress},
+			apiauditlog.FieldGeoLocation:      {Type: field.TypeJSON, Column: apiauditlog.FieldGeoLocation},
+			apiauditlog.FieldDeviceInfo:       {Type: field.TypeJSON, Column: apiauditlog.FieldDeviceInfo},
+			apiauditlog.FieldReferer:          {Type: field.TypeString, Column: apiauditlog.FieldReferer},
+			apiauditlog.FieldAppVersion:       {Type: field.TypeString, Column: apiauditlog.FieldAppVersion},
+			apiauditlog.FieldHTTPMethod:       {Type: field.TypeString, Column: apiauditlog.FieldHTTPMethod},
+			apiauditlog.FieldPath:             {Type: field.TypeString, Column: apiauditlog.FieldPath},
+			apiauditlog.FieldRequestURI:       {Type: field.TypeString, Column: apiauditlog.FieldRequestURI},
+			apiauditlog.FieldAPIModule:        {Type: field.TypeString, Column: apiauditlog.FieldAPIModule},
+			apiauditlog.FieldAPIOperation:     {Type: field.TypeString, Column: apiauditlog.FieldAPIOperation},
+			apiauditlog.FieldAPIDescription:   {Type: field.TypeString, Column: apiauditlog.FieldAPIDescription},
+			apiauditlog.FieldRequestID:        {Type: field.TypeString, Column: apiauditlog.FieldRequestID},
+			apiauditlog.FieldTraceID:          {Type: field.TypeString, Column: apiauditlog.FieldTraceID},
+			apiauditlog.FieldSpanID:           {Type: field.TypeString, Column: apiauditlog.FieldSpanID},
+			apiauditlog.FieldLatencyMs:        {Type: field.TypeUint32, Column: apiauditlog.FieldLatencyMs},
+			apiauditlog.FieldSuccess:          {Type: field.TypeBool, Column: apiauditlog.FieldSuccess},
+			apiauditlog.FieldStatusCode:       {Type: field.TypeUint32, Column: apiauditlog.FieldStatusCode},
+			apiauditlog.FieldReason:           {Type: field.TypeString, Column: apiauditlog.FieldReason},
+			apiauditlog.FieldRequestHeader:    {Type: field.TypeString, Column: apiauditlog.FieldRequestHeader},
+			apiauditlog.FieldRequestBody:      {Type: field.TypeString, Column: apiauditlog.FieldRequestBody},
+			apiauditlog.FieldResponse:         {Type: field.TypeString, Column: apiauditlog.FieldResponse},
+			apiauditlog.FieldLogHash:          {Type: field.TypeString, Column: apiauditlog.FieldLogHash},
+			apiauditlog.FieldSignature:        {Type: field.TypeBytes, Column: apiauditlog.FieldSignature},
 		},
 	}
 	graph.Nodes[2] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   apiclient.Table,
+			Columns: apiclient.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUint32,
+				Column: apiclient.FieldID,
+			},
+		},
+		Type: "ApiClient",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			apiclient.FieldCreatedAt:        {Type: field.TypeTime, Column: apiclient.FieldCreatedAt},
+			apiclient.FieldUpdatedAt:        {Type: field.TypeTime, Column: apiclient.FieldUpdatedAt},
+			apiclient.FieldDeletedAt:        {Type: field.TypeTime, Column: apiclient.FieldDeletedAt},
+			apiclient.FieldCreatedBy:        {Type: field.TypeUint32, Column: apiclient.FieldCreatedBy},
+			apiclient.FieldUpdatedBy:        {Type: field.TypeUint32, Column: apiclient.FieldUpdatedBy},
+			apiclient.FieldDeletedBy:        {Type: field.TypeUint32, Column: apiclient.FieldDeletedBy},
+			apiclient.FieldDescription:      {Type: field.TypeString, Column: apiclient.FieldDescription},
+			apiclient.FieldTenantID:         {Type: field.TypeUint32, Column: apiclient.FieldTenantID},
+			apiclient.FieldStatus:           {Type: field.TypeEnum, Column: apiclient.FieldStatus},
+			apiclient.FieldName:             {Type: field.TypeString, Column: apiclient.FieldName},
+			apiclient.FieldClientID:         {Type: field.TypeString, Column: apiclient.FieldClientID},
+			apiclient.FieldClientSecretHash: {Type: field.TypeString, Column: apiclient.FieldClientSecretHash},
+			apiclient.FieldRoleIds:          {Type: field.TypeJSON, Column: apiclient.FieldRoleIds},
+			apiclient.FieldRedirectUris:     {Type: field.TypeJSON, Column: apiclient.FieldRedirectUris},
+			apiclient.FieldScopes:           {Type: field.TypeJSON, Column: apiclient.FieldScopes},
+			apiclient.FieldPublicClient:     {Type: field.TypeBool, Column: apiclient.FieldPublicClient},
+			apiclient.FieldExpiresAt:        {Type: field.TypeTime, Column: apiclient.FieldExpiresAt},
+			apiclient.FieldSecretRotatedAt:  {Type: field.TypeTime, Column: apiclient.FieldSecretRotatedAt},
+			apiclient.FieldLastUsedAt:       {Type: field.TypeTime, Column: apiclient.FieldLastUsedAt},
+			apiclient.FieldLastUsedIP:       {Type: field.TypeString, Column: apiclient.FieldLastUsedIP},
+		},
+	}
+	graph.Nodes[3] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   dataaccessauditlog.Table,
 			Columns: dataaccessauditlog.Columns,
@@ -163,7 +208,7 @@
 			dataaccessauditlog.FieldSignature:       {Type: field.TypeBytes, Column: dataaccessauditlog.FieldSignature},
 		},
 	}
-	graph.Nodes[3] = &sqlgraph.Node{
+	graph.Nodes[4] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   dictentry.Table,
 			Columns: dictentry.Columns,
@@ -187,7 +232,7 @@
 			dictentry.FieldNumericValue: {Type: field.TypeInt32, Column: dictentry.FieldNumericValue},
 		},
 	}
-	graph.Nodes[4] = &sqlgraph.Node{
+	graph.Nodes[5] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   dictentryi18n.Table,
 			Columns: dictentryi18n.Columns,
@@ -211,7 +256,7 @@
 			dictentryi18n.FieldEntryLabel:   {Type: field.TypeString, Column: dictentryi18n.FieldEntryLabel},
 		},
 	}
-	graph.Nodes[5] = &sqlgraph.Node{
+	graph.Nodes[6] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   dicttype.Table,
 			Columns: dicttype.Columns,
@@ -235,7 +280,7 @@
 			dicttype.FieldTypeName:  {Type: field.TypeString, Column: dicttype.FieldTypeName},
 		},
 	}
-	graph.Nodes[6] = &sqlgraph.Node{
+	graph.Nodes[7] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   file.Table,
 			Columns: file.Columns,
@@ -267,7 +312,7 @@
 			file.FieldContentHash:   {Type: field.TypeString, Column: file.FieldContentHash},
 		},
 	}
-	graph.Nodes[7] = &sqlgraph.Node{
+	graph.Nodes[8] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   internalmessage.Table,
 			Columns: internalmessage.Columns,
@@ -293,7 +338,7 @@
 			internalmessage.FieldType:       {Type: field.TypeEnum, Column: internalmessage.FieldType},
 		},
 	}
-	graph.Nodes[8] = &sqlgraph.Node{
+	graph.Nodes[9] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   internalmessagecategory.Table,
 			Columns: internalmessagecategory.Columns,
@@ -319,7 +364,7 @@
 			internalmessagecategory.FieldIconURL:   {Type: field.TypeString, Column: internalmessagecategory.FieldIconURL},
 		},
 	}
-	graph.Nodes[9] = &sqlgraph.Node{
+	graph.Nodes[10] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   internalmessagerecipient.Table,
 			Columns: internalmessagerecipient.Columns,
@@ -341,7 +386,33 @@
 			internalmessagerecipient.FieldReadAt:          {Type: field.TypeTime, Column: internalmessagerecipient.FieldReadAt},
 		},
 	}
-	graph.Nodes[10] = &sqlgraph.Node{
+	graph.Nodes[11] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   jwtsigningkey.Table,
+			Columns: jwtsigningkey.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUint32,
+				Column: jwtsigningkey.FieldID,
+			},
+		},
+		Type: "JwtSigningKey",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			jwtsigningkey.FieldCreatedAt:   {Type: field.TypeTime, Column: jwtsigningkey.FieldCreatedAt},
+			jwtsigningkey.FieldUpdatedAt:   {Type: field.TypeTime, Column: jwtsigningkey.FieldUpdatedAt},
+			jwtsigningkey.FieldDeletedAt:   {Type: field.TypeTime, Column: jwtsigningkey.FieldDeletedAt},
+			jwtsigningkey.FieldCreatedBy:   {Type: field.TypeUint32, Column: jwtsigningkey.FieldCreatedBy},
+			jwtsigningkey.FieldUpdatedBy:   {Type: field.TypeUint32, Column: jwtsigningkey.FieldUpdatedBy},
+			jwtsigningkey.FieldDeletedBy:   {Type: field.TypeUint32, Column: jwtsigningkey.FieldDeletedBy},
+			jwtsigningkey.FieldKid:         {Type: field.TypeString, Column: jwtsigningkey.FieldKid},
+			jwtsigningkey.FieldAlgorithm:   {Type: field.TypeString, Column: jwtsigningkey.FieldAlgorithm},
+			jwtsigningkey.FieldKeyMaterial: {Type: field.TypeString, Column: jwtsigningkey.FieldKeyMaterial},
+			jwtsigningkey.FieldStatus:      {Type: field.TypeEnum, Column: jwtsigningkey.FieldStatus},
+			jwtsigningkey.FieldActivatedAt: {Type: field.TypeTime, Column: jwtsigningkey.FieldActivatedAt},
+			jwtsigningkey.FieldRetiredAt:   {Type: field.TypeTime, Column: jwtsigningkey.FieldRetiredAt},
+			jwtsigningkey.FieldVerifyUntil: {Type: field.TypeTime, Column: jwtsigningkey.FieldVerifyUntil},
+		},
+	}
+	graph.Nodes[12] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   language.Table,
 			Columns: language.Columns,
@@ -366,7 +437,49 @@
 			language.FieldIsDefault:    {Type: field.TypeBool, Column: language.FieldIsDefault},
 		},
 	}
-	graph.Nodes[11] = &sqlgraph.Node{
+	graph.Nodes[13] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   ldapconfig.Table,
+			Columns: ldapconfig.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUint32,
+				Column: ldapconfig.FieldID,
+			},
+		},
+		Type: "LdapConfig",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			ldapconfig.FieldCreatedAt:            {Type: field.TypeTime, Column: ldapconfig.FieldCreatedAt},
+			ldapconfig.FieldUpdatedAt:            {Type: field.TypeTime, Column: ldapconfig.FieldUpdatedAt},
+			ldapconfig.FieldDeletedAt:            {Type: field.TypeTime, Column: ldapconfig.FieldDeletedAt},
+			ldapconfig.FieldCreatedBy:            {Type: field.TypeUint32, Column: ldapconfig.FieldCreatedBy},
+			ldapconfig.FieldUpdatedBy:            {Type: field.TypeUint32, Column: ldapconfig.FieldUpdatedBy},
+			ldapconfig.FieldDeletedBy:            {Type: field.TypeUint32, Column: ldapconfig.FieldDeletedBy},
+			ldapconfig.FieldTenantID:             {Type: field.TypeUint32, Column: ldapconfig.FieldTenantID},
+			ldapconfig.FieldStatus:               {Type: field.TypeEnum, Column: ldapconfig.FieldStatus},
+			ldapconfig.FieldDisplayName:          {Type: field.TypeString, Column: ldapconfig.FieldDisplayName},
+			ldapconfig.FieldServerURL:            {Type: field.TypeString, Column: ldapconfig.FieldServerURL},
+			ldapconfig.FieldStartTLS:             {Type: field.TypeBool, Column: ldapconfig.FieldStartTLS},
+			ldapconfig.FieldCaCertificate:        {Type: field.TypeString, Column: ldapconfig.FieldCaCertificate},
+			ldapconfig.FieldBindDn:               {Type: field.TypeString, Column: ldapconfig.FieldBindDn},
+			ldapconfig.FieldBindPassword:         {Type: field.TypeString, Column: ldapconfig.FieldBindPassword},
+			ldapconfig.FieldBaseDn:               {Type: field.TypeString, Column: ldapconfig.FieldBaseDn},
+			ldapconfig.FieldUserFilter:           {Type: field.TypeString, Column: ldapconfig.FieldUserFilter},
+			ldapconfig.FieldIDAttribute:          {Type: field.TypeString, Column: ldapconfig.FieldIDAttribute},
+			ldapconfig.FieldAttributeMapping:     {Type: field.TypeJSON, Column: ldapconfig.FieldAttributeMapping},
+			ldapconfig.FieldGroupFilter:          {Type: field.TypeString, Column: ldapconfig.FieldGroupFilter},
+			ldapconfig.FieldGroupMemberAttribute: {Type: field.TypeString, Column: ldapconfig.FieldGroupMemberAttribute},
+			ldapconfig.FieldGroupRoleMapping:     {Type: field.TypeJSON, Column: ldapconfig.FieldGroupRoleMapping},
+			ldapconfig.FieldOrgUnitFilter:        {Type: field.TypeString, Column: ldapconfig.FieldOrgUnitFilter},
+			ldapconfig.FieldOrgUnitRootID:        {Type: field.TypeUint32, Column: ldapconfig.FieldOrgUnitRootID},
+			ldapconfig.FieldAuthMode:             {Type: field.TypeEnum, Column: ldapconfig.FieldAuthMode},
+			ldapconfig.FieldJitProvisioning:      {Type: field.TypeBool, Column: ldapconfig.FieldJitProvisioning},
+			ldapconfig.FieldDefaultRoleIds:       {Type: field.TypeJSON, Column: ldapconfig.FieldDefaultRoleIds},
+			ldapconfig.FieldDefaultOrgUnitID:     {Type: field.TypeUint32, Column: ldapconfig.FieldDefaultOrgUnitID},
+			ldapconfig.FieldLastSyncedAt:         {Type: field.TypeTime, Column: ldapconfig.FieldLastSyncedAt},
+			ldapconfig.FieldLastSyncMessage:      {Type: field.TypeString, Column: ldapconfig.FieldLastSyncMessage},
+		},
+	}
+	graph.Nodes[14] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   loginauditlog.Table,
 			Columns: loginauditlog.Columns,
@@ -399,7 +512,7 @@
 			loginauditlog.FieldSignature:     {Type: field.TypeBytes, Column: loginauditlog.FieldSignature},
 		},
 	}
-	graph.Nodes[12] = &sqlgraph.Node{
+	graph.Nodes[15] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   loginpolicy.Table,
 			Columns: loginpolicy.Columns,
@@ -410,21 +523,23 @@
 		},
 		Type: "LoginPolicy",
 		Fields: map[string]*sqlgraph.FieldSpec{
-			loginpolicy.FieldCreatedAt: {Type: field.TypeTime, Column: loginpolicy.FieldCreatedAt},
-			loginpolicy.FieldUpdatedAt: {Type: field.TypeTime, Column: loginpolicy.FieldUpdatedAt},
-			loginpolicy.FieldDeletedAt: {Type: field.TypeTime, Column: loginpolicy.FieldDeletedAt},
-			loginpolicy.FieldCreatedBy: {Type: field.TypeUint32, Column: loginpolicy.FieldCreatedBy},
-			loginpolicy.FieldUpdatedBy: {Type: field.TypeUint32, Column: loginpolicy.FieldUpdatedBy},
-			loginpolicy.FieldDeletedBy: {Type: field.TypeUint32, Column: loginpolicy.FieldDeletedBy},
-			loginpolicy.FieldTenantID:  {Type: field.TypeUint32, Column: loginpolicy.FieldTenantID},
-			loginpolicy.FieldTargetID:  {Type: field.TypeUint32, Column: loginpolicy.FieldTargetID},
-			loginpolicy.FieldValue:     {Type: field.TypeString, Column: loginpolicy.FieldValue},
-			loginpolicy.FieldReason:    {Type: field.TypeString, Column: loginpolicy.FieldReason},
-			loginpolicy.FieldType:      {Type: field.TypeEnum, Column: loginpolicy.FieldType},
-			loginpolicy.FieldMethod:    {Type: field.TypeEnum, Column: loginpolicy.FieldMethod},
+			loginpolicy.FieldCreatedAt:  {Type: field.TypeTime, Column: loginpolicy.FieldCreatedAt},
+			loginpolicy.FieldUpdatedAt:  {Type: field.TypeTime, Column: loginpolicy.FieldUpdatedAt},
+			loginpolicy.FieldDeletedAt:  {Type: field.TypeTime, Column: loginpolicy.FieldDeletedAt},
+			loginpolicy.FieldCreatedBy:  {Type: field.TypeUint32, Column: loginpolicy.FieldCreatedBy},
+			loginpolicy.FieldUpdatedBy:  {Type: field.TypeUint32, Column: loginpolicy.FieldUpdatedBy},
+			loginpolicy.FieldDeletedBy:  {Type: field.TypeUint32, Column: loginpolicy.FieldDeletedBy},
+			loginpolicy.FieldTenantID:   {Type: field.TypeUint32, Column: loginpolicy.FieldTenantID},
+			loginpolicy.FieldTargetID:   {Type: field.TypeUint32, Column: loginpolicy.FieldTargetID},
+			loginpolicy.FieldValue:      {Type: field.TypeString, Column: loginpolicy.FieldValue},
+			loginpolicy.FieldReason:     {Type: field.TypeString, Column: loginpolicy.FieldReason},
+			loginpolicy.FieldType:       {Type: field.TypeEnum, Column: loginpolicy.FieldType},
+			loginpolicy.FieldMethod:     {Type: field.TypeEnum, Column: loginpolicy.FieldMethod},
+			loginpolicy.FieldTargetType: {Type: field.TypeEnum, Column: loginpolicy.FieldTargetType},
+			loginpolicy.FieldTargetCode: {Type: field.TypeString, Column: loginpolicy.FieldTargetCode},
 		},
 	}
-	graph.Nodes[13] = &sqlgraph.Node{
+	graph.Nodes[16] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   membership.Table,
 			Columns: membership.Columns,
@@ -456,7 +571,7 @@
 			membership.FieldStatus:     {Type: field.TypeEnum, Column: membership.FieldStatus},
 		},
 	}
-	graph.Nodes[14] = &sqlgraph.Node{
+	graph.Nodes[17] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   membershiporgunit.Table,
 			Columns: membershiporgunit.Columns,
@@ -487,7 +602,7 @@
 			membershiporgunit.FieldStatus:       {Type: field.TypeEnum, Column: membershiporgunit.FieldStatus},
 		},
 	}
-	graph.Nodes[15] = &sqlgraph.Node{
+	graph.Nodes[18] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   membershipposition.Table,
 			Columns: membershipposition.Columns,
@@ -516,7 +631,7 @@
 			membershipposition.FieldStatus:       {Type: field.TypeEnum, Column: membershipposition.FieldStatus},
 		},
 	}
-	graph.Nodes[16] = &sqlgraph.Node{
+	graph.Nodes[19] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   membershiprole.Table,
 			Columns: membershiprole.Columns,
@@ -544,7 +659,7 @@
 			membershiprole.FieldStatus:       {Type: field.TypeEnum, Column: membershiprole.FieldStatus},
 		},
 	}
-	graph.Nodes[17] = &sqlgraph.Node{
+	graph.Nodes[20] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   menu.Table,
 			Columns: menu.Columns,
@@ -574,7 +689,63 @@
 			menu.FieldModule:    {Type: field.TypeEnum, Column: menu.FieldModule},
 		},
 	}
-	graph.Nodes[18] = &sqlgraph.Node{
+	graph.Nodes[21] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   mfapolicy.Table,
+			Columns: mfapolicy.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUint32,
+				Column: mfapolicy.FieldID,
+			},
+		},
+		Type: "MfaPolicy",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			mfapolicy.FieldCreatedAt:         {Type: field.TypeTime, Column: mfapolicy.FieldCreatedAt},
+			mfapolicy.FieldUpdatedAt:         {Type: field.TypeTime, Column: mfapolicy.FieldUpdatedAt},
+			mfapolicy.FieldDeletedAt:         {Type: field.TypeTime, Column: mfapolicy.FieldDeletedAt},
+			mfapolicy.FieldCreatedBy:         {Type: field.TypeUint32, Column: mfapolicy.FieldCreatedBy},
+			mfapolicy.FieldUpdatedBy:         {Type: field.TypeUint32, Column: mfapolicy.FieldUpdatedBy},
+			mfapolicy.FieldDeletedBy:         {Type: field.TypeUint32, Column: mfapolicy.FieldDeletedBy},
+			mfapolicy.FieldTenantID:          {Type: field.TypeUint32, Column: mfapolicy.FieldTenantID},
+			mfapolicy.FieldEnforcement:       {Type: field.TypeEnum, Column: mfapolicy.FieldEnforcement},
+			mfapolicy.FieldRequiredRoleCodes: {Type: field.TypeJSON, Column: mfapolicy.FieldRequiredRoleCodes},
+		},
+	}
+	graph.Nodes[22] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   oauthproviderconfig.Table,
+			Columns: oauthproviderconfig.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUint32,
+				Column: oauthproviderconfig.FieldID,
+			},
+		},
+		Type: "OAuthProviderConfig",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			oauthproviderconfig.FieldCreatedAt:      {Type: field.TypeTime, Column: oauthproviderconfig.FieldCreatedAt},
+			oauthproviderconfig.FieldUpdatedAt:      {Type: field.TypeTime, Column: oauthproviderconfig.FieldUpdatedAt},
+			oauthproviderconfig.FieldDeletedAt:      {Type: field.TypeTime, Column: oauthproviderconfig.FieldDeletedAt},
+			oauthproviderconfig.FieldCreatedBy:      {Type: field.TypeUint32, Column: oauthproviderconfig.FieldCreatedBy},
+			oauthproviderconfig.FieldUpdatedBy:      {Type: field.TypeUint32, Column: oauthproviderconfig.FieldUpdatedBy},
+			oauthproviderconfig.FieldDeletedBy:      {Type: field.TypeUint32, Column: oauthproviderconfig.FieldDeletedBy},
+			oauthproviderconfig.FieldTenantID:       {Type: field.TypeUint32, Column: oauthproviderconfig.FieldTenantID},
+			oauthproviderconfig.FieldStatus:         {Type: field.TypeEnum, Column: oauthproviderconfig.FieldStatus},
+			oauthproviderconfig.FieldSortOrder:      {Type: field.TypeUint32, Column: oauthproviderconfig.FieldSortOrder},
+			oauthproviderconfig.FieldProvider:       {Type: field.TypeString, Column: oauthproviderconfig.FieldProvider},
+			oauthproviderconfig.FieldProviderCustom: {Type: field.TypeString, Column: oauthproviderconfig.FieldProviderCustom},
+			oauthproviderconfig.FieldDisplayName:    {Type: field.TypeString, Column: oauthproviderconfig.FieldDisplayName},
+			oauthproviderconfig.FieldClientID:       {Type: field.TypeString, Column: oauthproviderconfig.FieldClientID},
+			oauthproviderconfig.FieldClientSecret:   {Type: field.TypeString, Column: oauthproviderconfig.FieldClientSecret},
+			oauthproviderconfig.FieldAuthURL:        {Type: field.TypeString, Column: oauthproviderconfig.FieldAuthURL},
+			oauthproviderconfig.FieldTokenURL:       {Type: field.TypeString, Column: oauthproviderconfig.FieldTokenURL},
+			oauthproviderconfig.FieldUserinfoURL:    {Type: field.TypeString, Column: oauthproviderconfig.FieldUserinfoURL},
+			oauthproviderconfig.FieldRedirectURI:    {Type: field.TypeString, Column: oauthproviderconfig.FieldRedirectURI},
+			oauthproviderconfig.FieldScopes:         {Type: field.TypeJSON, Column: oauthproviderconfig.FieldScopes},
+			oauthproviderconfig.FieldAutoProvision:  {Type: field.TypeBool, Column: oauthproviderconfig.FieldAutoProvision},
+			oauthproviderconfig.FieldDefaultRoleIds: {Type: field.TypeJSON, Column: oauthproviderconfig.FieldDefaultRoleIds},
+		},
+	}
+	graph.Nodes[23] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   operationauditlog.Table,
 			Columns: operationauditlog.Columns,
@@ -585,28 +756,30 @@
 		},
 		Type: "OperationAuditLog",
 		Fields: map[string]*sqlgraph.FieldSpec{
-			operationauditlog.FieldCreatedAt:      {Type: field.TypeTime, Column: operationauditlog.FieldCreatedAt},
-			operationauditlog.FieldTenantID:       {Type: field.TypeUint32, Column: operationauditlog.FieldTenantID},
-			operationauditlog.FieldUserID:         {Type: field.TypeUint32, Column: operationauditlog.FieldUserID},
-			operationauditlog.FieldUsername:       {Type: field.TypeString, Column: operationauditlog.FieldUsername},
-			operationauditlog.FieldResourceType:   {Type: field.TypeString, Column: operationauditlog.FieldResourceType},
-			operationauditlog.FieldResourceID:     {Type: field.TypeString, Column: operationauditlog.FieldResourceID},
-			operationauditlog.FieldAction:         {Type: field.TypeEnum, Column: operationauditlog.FieldAction},
-			operationauditlog.FieldBeforeData:     {Type: field.TypeString, Column: operationauditlog.FieldBeforeData},
-			operationauditlog.FieldAfterData:      {Type: field.TypeString, Column: operationauditlog.FieldAfterData},
-			operationauditlog.FieldSensitiveLevel: {Type: field.TypeEnum, Column: operationauditlog.FieldSensitiveLevel},
-			operationauditlog.FieldRequestID:      {Type: field.TypeString, Column: operationauditlog.FieldRequestID},
-			operationauditlog.FieldTraceID:        {Type: field.TypeString, Column: operationauditlog.FieldTraceID},
-			operationauditlog.FieldSuccess:        {Type: field.TypeBool, Column: operationauditlog.FieldSuccess},
-			operationauditlog.FieldFailureReason:  {Type: field.TypeString, Column: operationauditlog.FieldFailureReason},
-			operationauditlog.FieldIPAddress:      {Type: field.TypeString, Column: operationauditlog.FieldIPAddress},
-			operationauditlog.FieldGeoLocation:    {Type: field.TypeJSON, Column: operationauditlog.FieldGeoLocation},
-			operationauditlog.FieldDeviceInfo:     {Type: field.TypeJSON, Column: operationauditlog.FieldDeviceInfo},
-			operationauditlog.FieldLogHash:        {Type: field.TypeString, Column: operationauditlog.FieldLogHash},
-			operationauditlog.FieldSignature:      {Type: field.TypeBytes, Column: operationauditlog.FieldSignature},
+			operationauditlog.FieldCreatedAt:        {Type: field.TypeTime, Column: operationauditlog.FieldCreatedAt},
+			operationauditlog.FieldTenantID:         {Type: field.TypeUint32, Column: operationauditlog.FieldTenantID},
+			operationauditlog.FieldUserID:           {Type: field.TypeUint32, Column: operationauditlog.FieldUserID},
+			operationauditlog.FieldUsername:         {Type: field.TypeString, Column: operationauditlog.FieldUsername},
+			operationauditlog.FieldImpersonatorID:   {Type: field.TypeUint32, Column: operationauditlog.FieldImpersonatorID},
+			operationauditlog.FieldImpersonatorName: {Type: field.TypeString, Column: operationauditlog.FieldImpersonatorName},
+			operationauditlog.FieldResourceType:     {Type: field.TypeString, Column: operationauditlog.FieldResourceType},
+			operationauditlog.FieldResourceID:       {Type: field.TypeString, Column: operationauditlog.FieldResourceID},
+			operationauditlog.FieldAction:           {Type: field.TypeEnum, Column: operationauditlog.FieldAction},
+			operationauditlog.FieldBeforeData:       {Type: field.TypeString, Column: operationauditlog.FieldBeforeData},
+			operationauditlog.FieldAfterData:        {Type: field.TypeString, Column: operationauditlog.FieldAfterData},
+			operationauditlog.FieldSensitiveLevel:   {Type: field.TypeEnum, Column: operationauditlog.FieldSensitiveLevel},
+			operationauditlog.FieldRequestID:        {Type: field.TypeString, Column: operationauditlog.FieldRequestID},
+			operationauditlog.FieldTraceID:          {Type: field.TypeString, Column: operationauditlog.FieldTraceID},
+			operationauditlog.FieldSuccess:          {Type: field.TypeBool, Column: operationauditlog.FieldSuccess},
+			operationauditlog.FieldFailureReason:    {Type: field.TypeString, Column: operationauditlog.FieldFailureReason},
+			operationauditlog.FieldIPAddress:        {Type: field.TypeString, Column: operationauditlog.FieldIPAddress},
+			operationauditlog.FieldGeoLocation:      {Type: field.TypeJSON, Column: operationauditlog.FieldGeoLocation},
+			operationauditlog.FieldDeviceInfo:       {Type: field.TypeJSON, Column: operationauditlog.FieldDeviceInfo},
+			operationauditlog.FieldLogHash:          {Type: field.TypeString, Column: operationauditlog.FieldLogHash},
+			operationauditlog.FieldSignature:        {Type: field.TypeBytes, Column: operationauditlog.FieldSignature},
 		},
 	}
-	graph.Nodes[19] = &sqlgraph.Node{
+	graph.Nodes[24] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   orgunit.Table,
 			Columns: orgunit.Columns,
@@ -653,7 +826,37 @@
 			orgunit.FieldPermissionTags:     {Type: field.TypeJSON, Column: orgunit.FieldPermissionTags},
 		},
 	}
-	graph.Nodes[20] = &sqlgraph.Node{
+	graph.Nodes[25] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   passwordpolicy.Table,
+			Columns: passwordpolicy.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUint32,
+				Column: passwordpolicy.FieldID,
+			},
+		},
+		Type: "PasswordPolicy",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			passwordpolicy.FieldCreatedAt:        {Type: field.TypeTime, Column: passwordpolicy.FieldCreatedAt},
+			passwordpolicy.FieldUpdatedAt:        {Type: field.TypeTime, Column: passwordpolicy.FieldUpdatedAt},
+			passwordpolicy.FieldDeletedAt:        {Type: field.TypeTime, Column: passwordpolicy.FieldDeletedAt},
+			passwordpolicy.FieldCreatedBy:        {Type: field.TypeUint32, Column: passwordpolicy.FieldCreatedBy},
+			passwordpolicy.FieldUpdatedBy:        {Type: field.TypeUint32, Column: passwordpolicy.FieldUpdatedBy},
+			passwordpolicy.FieldDeletedBy:        {Type: field.TypeUint32, Column: passwordpolicy.FieldDeletedBy},
+			passwordpolicy.FieldTenantID:         {Type: field.TypeUint32, Column: passwordpolicy.FieldTenantID},
+			passwordpolicy.FieldMinLength:        {Type: field.TypeUint32, Column: passwordpolicy.FieldMinLength},
+			passwordpolicy.FieldRequireUppercase: {Type: field.TypeBool, Column: passwordpolicy.FieldRequireUppercase},
+			passwordpolicy.FieldRequireLowercase: {Type: field.TypeBool, Column: passwordpolicy.FieldRequireLowercase},
+			passwordpolicy.FieldRequireDigit:     {Type: field.TypeBool, Column: passwordpolicy.FieldRequireDigit},
+			passwordpolicy.FieldRequireSymbol:    {Type: field.TypeBool, Column: passwordpolicy.FieldRequireSymbol},
+			passwordpolicy.FieldDisallowIdentity: {Type: field.TypeBool, Column: passwordpolicy.FieldDisallowIdentity},
+			passwordpolicy.FieldBannedSubstrings: {Type: field.TypeJSON, Column: passwordpolicy.FieldBannedSubstrings},
+			passwordpolicy.FieldHistoryCount:     {Type: field.TypeUint32, Column: passwordpolicy.FieldHistoryCount},
+			passwordpolicy.FieldMaxAgeDays:       {Type: field.TypeUint32, Column: passwordpolicy.FieldMaxAgeDays},
+			passwordpolicy.FieldCheckBreached:    {Type: field.TypeBool, Column: passwordpolicy.FieldCheckBreached},
+		},
+	}
+	graph.Nodes[26] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   permission.Table,
 			Columns: permission.Columns,
@@ -677,7 +880,7 @@
 			permission.FieldGroupID:     {Type: field.TypeUint32, Column: permission.FieldGroupID},
 		},
 	}
-	graph.Nodes[21] = &sqlgraph.Node{
+	graph.Nodes[27] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   permissionapi.Table,
 			Columns: permissionapi.Columns,
@@ -698,7 +901,7 @@
 			permissionapi.FieldAPIID:        {Type: field.TypeUint32, Column: permissionapi.FieldAPIID},
 		},
 	}
-	graph.Nodes[22] = &sqlgraph.Node{
+	graph.Nodes[28] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   permissionauditlog.Table,
 			Columns: permissionauditlog.Columns,
@@ -724,7 +927,7 @@
 			permissionauditlog.FieldSignature:  {Type: field.TypeBytes, Column: permissionauditlog.FieldSignature},
 		},
 	}
-	graph.Nodes[23] = &sqlgraph.Node{
+	graph.Nodes[29] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   permissiongroup.Table,
 			Columns: permissiongroup.Columns,
@@ -750,7 +953,7 @@
 			permissiongroup.FieldModule:      {Type: field.TypeString, Column: permissiongroup.FieldModule},
 		},
 	}
-	graph.Nodes[24] = &sqlgraph.Node{
+	graph.Nodes[30] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   permissionmenu.Table,
 			Columns: permissionmenu.Columns,
@@ -771,7 +974,7 @@
 			permissionmenu.FieldMenuID:       {Type: field.TypeUint32, Column: permissionmenu.FieldMenuID},
 		},
 	}
-	graph.Nodes[25] = &sqlgraph.Node{
+	graph.Nodes[31] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   permissionpolicy.Table,
 			Columns: permissionpolicy.Columns,
@@ -797,7 +1000,7 @@
 			permissionpolicy.FieldCacheTTL:     {Type: field.TypeUint32, Column: permissionpolicy.FieldCacheTTL},
 		},
 	}
-	graph.Nodes[26] = &sqlgraph.Node{
+	graph.Nodes[32] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   plan.Table,
 			Columns: plan.Columns,
@@ -822,7 +1025,7 @@
 			plan.FieldDescription:       {Type: field.TypeString, Column: plan.FieldDescription},
 		},
 	}
-	graph.Nodes[27] = &sqlgraph.Node{
+	graph.Nodes[33] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   planmodule.Table,
 			Columns: planmodule.Columns,
@@ -842,7 +1045,7 @@
 			planmodule.FieldModule:    {Type: field.TypeEnum, Column: planmodule.FieldModule},
 		},
 	}
-	graph.Nodes[28] = &sqlgraph.Node{
+	graph.Nodes[34] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   planquota.Table,
 			Columns: planquota.Columns,
@@ -863,7 +1066,7 @@
 			planquota.FieldQuotaValue: {Type: field.TypeUint64, Column: planquota.FieldQuotaValue},
 		},
 	}
-	graph.Nodes[29] = &sqlgraph.Node{
+	graph.Nodes[35] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   policyevaluationlog.Table,
 			Columns: policyevaluationlog.Columns,
@@ -892,7 +1095,7 @@
 			policyevaluationlog.FieldSignature:         {Type: field.TypeBytes, Column: policyevaluationlog.FieldSignature},
 		},
 	}
-	graph.Nodes[30] = &sqlgraph.Node{
+	graph.Nodes[36] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   position.Table,
 			Columns: position.Columns,
@@ -928,7 +1131,45 @@
 			position.FieldEndAt:               {Type: field.TypeTime, Column: position.FieldEndAt},
 		},
 	}
-	graph.Nodes[31] = &sqlgraph.Node{
+	graph.Nodes[37] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   relationtuple.Table,
+			Columns: relationtuple.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUint32,
+				Column: relationtuple.FieldID,
+			},
+		},
+		Type: "RelationTuple",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			relationtuple.FieldCreatedAt:        {Type: field.TypeTime, Column: relationtuple.FieldCreatedAt},
+			relationtuple.FieldCreatedBy:        {Type: field.TypeUint32, Column: relationtuple.FieldCreatedBy},
+			relationtuple.FieldNamespace:        {Type: field.TypeString, Column: relationtuple.FieldNamespace},
+			relationtuple.FieldObjectID:         {Type: field.TypeString, Column: relationtuple.FieldObjectID},
+			relationtuple.FieldRelation:         {Type: field.TypeString, Column: relationtuple.FieldRelation},
+			relationtuple.FieldSubjectNamespace: {Type: field.TypeString, Column: relationtuple.FieldSubjectNamespace},
+			relationtuple.FieldSubjectID:        {Type: field.TypeString, Column: relationtuple.FieldSubjectID},
+			relationtuple.FieldSubjectRelation:  {Type: field.TypeString, Column: relationtuple.FieldSubjectRelation},
+		},
+	}
+	graph.Nodes[38] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   relationtuplechange.Table,
+			Columns: relationtuplechange.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUint32,
+				Column: relationtuplechange.FieldID,
+			},
+		},
+		Type: "RelationTupleChange",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			relationtuplechange.FieldCreatedAt: {Type: field.TypeTime, Column: relationtuplechange.FieldCreatedAt},
+			relationtuplechange.FieldCreatedBy: {Type: field.TypeUint32, Column: relationtuplechange.FieldCreatedBy},
+			relationtuplechange.FieldOperation: {Type: field.TypeEnum, Column: relationtuplechange.FieldOperation},
+			relationtuplechange.FieldTuples:    {Type: field.TypeString, Column: relationtuplechange.FieldTuples},
+		},
+	}
+	graph.Nodes[39] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   role.Table,
 			Columns: role.Columns,
@@ -939,24 +1180,26 @@
 		},
 		Type: "Role",
 		Fields: map[string]*sqlgraph.FieldSpec{
-			role.FieldCreatedAt:   {Type: field.TypeTime, Column: role.FieldCreatedAt},
-			role.FieldUpdatedAt:   {Type: field.TypeTime, Column: role.FieldUpdatedAt},
-			role.FieldDeletedAt:   {Type: field.TypeTime, Column: role.FieldDeletedAt},
-			role.FieldCreatedBy:   {Type: field.TypeUint32, Column: role.FieldCreatedBy},
-			role.FieldUpdatedBy:   {Type: field.TypeUint32, Column: role.FieldUpdatedBy},
-			role.FieldDeletedBy:   {Type: field.TypeUint32, Column: role.FieldDeletedBy},
-			role.FieldRemark:      {Type: field.TypeString, Column: role.FieldRemark},
-			role.FieldDescription: {Type: field.TypeString, Column: role.FieldDescription},
-			role.FieldSortOrder:   {Type: field.TypeUint32, Column: role.FieldSortOrder},
-			role.FieldTenantID:    {Type: field.TypeUint32, Column: role.FieldTenantID},
-			role.FieldStatus:      {Type: field.TypeEnum, Column: role.FieldStatus},
-			role.FieldName:        {Type: field.TypeString, Column: role.FieldName},
-			role.FieldCode:        {Type: field.TypeString, Column: role.FieldCode},
-			role.FieldIsProtected: {Type: field.TypeBool, Column: role.FieldIsProtected},
-			role.FieldType:        {Type: field.TypeEnum, Column: role.FieldType},
+			role.FieldCreatedAt:           {Type: field.TypeTime, Column: role.FieldCreatedAt},
+			role.FieldUpdatedAt:           {Type: field.TypeTime, Column: role.FieldUpdatedAt},
+			role.FieldDeletedAt:           {Type: field.TypeTime, Column: role.FieldDeletedAt},
+			role.FieldCreatedBy:           {Type: field.TypeUint32, Column: role.FieldCreatedBy},
+			role.FieldUpdatedBy:           {Type: field.TypeUint32, Column: role.FieldUpdatedBy},
+			role.FieldDeletedBy:           {Type: field.TypeUint32, Column: role.FieldDeletedBy},
+			role.FieldRemark:              {Type: field.TypeString, Column: role.FieldRemark},
+			role.FieldDescription:         {Type: field.TypeString, Column: role.FieldDescription},
+			role.FieldSortOrder:           {Type: field.TypeUint32, Column: role.FieldSortOrder},
+			role.FieldTenantID:            {Type: field.TypeUint32, Column: role.FieldTenantID},
+			role.FieldStatus:              {Type: field.TypeEnum, Column: role.FieldStatus},
+			role.FieldName:                {Type: field.TypeString, Column: role.FieldName},
+			role.FieldCode:                {Type: field.TypeString, Column: role.FieldCode},
+			role.FieldIsProtected:         {Type: field.TypeBool, Column: role.FieldIsProtected},
+			role.FieldType:                {Type: field.TypeEnum, Column: role.FieldType},
+			role.FieldDataScope:           {Type: field.TypeEnum, Column: role.FieldDataScope},
+			role.FieldDataScopeOrgUnitIds: {Type: field.TypeJSON, Column: role.FieldDataScopeOrgUnitIds},
 		},
 	}
-	graph.Nodes[32] = &sqlgraph.Node{
+	graph.Nodes[40] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   rolemetadata.Table,
 			Columns: rolemetadata.Columns,
@@ -985,7 +1228,7 @@
 			rolemetadata.FieldCustomOverrides:   {Type: field.TypeJSON, Column: rolemetadata.FieldCustomOverrides},
 		},
 	}
-	graph.Nodes[33] = &sqlgraph.Node{
+	graph.Nodes[41] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   rolepermission.Table,
 			Columns: rolepermission.Columns,
@@ -1010,7 +1253,67 @@
 			rolepermission.FieldPriority:     {Type: field.TypeInt32, Column: rolepermission.FieldPriority},
 		},
 	}
-	graph.Nodes[34] = &sqlgraph.Node{
+	graph.Nodes[42] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   samlconfig.Table,
+			Columns: samlconfig.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUint32,
+				Column: samlconfig.FieldID,
+			},
+		},
+		Type: "SamlConfig",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			samlconfig.FieldCreatedAt:        {Type: field.TypeTime, Column: samlconfig.FieldCreatedAt},
+			samlconfig.FieldUpdatedAt:        {Type: field.TypeTime, Column: samlconfig.FieldUpdatedAt},
+			samlconfig.FieldDeletedAt:        {Type: field.TypeTime, Column: samlconfig.FieldDeletedAt},
+			samlconfig.FieldCreatedBy:        {Type: field.TypeUint32, Column: samlconfig.FieldCreatedBy},
+			samlconfig.FieldUpdatedBy:        {Type: field.TypeUint32, Column: samlconfig.FieldUpdatedBy},
+			samlconfig.FieldDeletedBy:        {Type: field.TypeUint32, Column: samlconfig.FieldDeletedBy},
+			samlconfig.FieldTenantID:         {Type: field.TypeUint32, Column: samlconfig.FieldTenantID},
+			samlconfig.FieldStatus:           {Type: field.TypeEnum, Column: samlconfig.FieldStatus},
+			samlconfig.FieldDisplayName:      {Type: field.TypeString, Column: samlconfig.FieldDisplayName},
+			samlconfig.FieldIdpEntityID:      {Type: field.TypeString, Column: samlconfig.FieldIdpEntityID},
+			samlconfig.FieldIdpSSOURL:        {Type: field.TypeString, Column: samlconfig.FieldIdpSSOURL},
+			samlconfig.FieldIdpCertificate:   {Type: field.TypeString, Column: samlconfig.FieldIdpCertificate},
+			samlconfig.FieldNameIDFormat:     {Type: field.TypeString, Column: samlconfig.FieldNameIDFormat},
+			samlconfig.FieldAttributeMapping: {Type: field.TypeJSON, Column: samlconfig.FieldAttributeMapping},
+			samlconfig.FieldJitProvisioning:  {Type: field.TypeBool, Column: samlconfig.FieldJitProvisioning},
+			samlconfig.FieldDefaultRoleIds:   {Type: field.TypeJSON, Column: samlconfig.FieldDefaultRoleIds},
+			samlconfig.FieldDefaultOrgUnitID: {Type: field.TypeUint32, Column: samlconfig.FieldDefaultOrgUnitID},
+			samlconfig.FieldSSOOnly:          {Type: field.TypeBool, Column: samlconfig.FieldSSOOnly},
+			samlconfig.FieldLoginRedirectURL: {Type: field.TypeString, Column: samlconfig.FieldLoginRedirectURL},
+		},
+	}
+	graph.Nodes[43] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   scimtoken.Table,
+			Columns: scimtoken.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUint32,
+				Column: scimtoken.FieldID,
+			},
+		},
+		Type: "ScimToken",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			scimtoken.FieldCreatedAt:   {Type: field.TypeTime, Column: scimtoken.FieldCreatedAt},
+			scimtoken.FieldUpdatedAt:   {Type: field.TypeTime, Column: scimtoken.FieldUpdatedAt},
+			scimtoken.FieldDeletedAt:   {Type: field.TypeTime, Column: scimtoken.FieldDeletedAt},
+			scimtoken.FieldCreatedBy:   {Type: field.TypeUint32, Column: scimtoken.FieldCreatedBy},
+			scimtoken.FieldUpdatedBy:   {Type: field.TypeUint32, Column: scimtoken.FieldUpdatedBy},
+			scimtoken.FieldDeletedBy:   {Type: field.TypeUint32, Column: scimtoken.FieldDeletedBy},
+			scimtoken.FieldDescription: {Type: field.TypeString, Column: scimtoken.FieldDescription},
+			scimtoken.FieldTenantID:    {Type: field.TypeUint32, Column: scimtoken.FieldTenantID},
+			scimtoken.FieldStatus:      {Type: field.TypeEnum, Column: scimtoken.FieldStatus},
+			scimtoken.FieldName:        {Type: field.TypeString, Column: scimtoken.FieldName},
+			scimtoken.FieldTokenPrefix: {Type: field.TypeString, Column: scimtoken.FieldTokenPrefix},
+			scimtoken.FieldTokenHash:   {Type: field.TypeString, Column: scimtoken.FieldTokenHash},
+			scimtoken.FieldExpiresAt:   {Type: field.TypeTime, Column: scimtoken.FieldExpiresAt},
+			scimtoken.FieldLastUsedAt:  {Type: field.TypeTime, Column: scimtoken.FieldLastUsedAt},
+			scimtoken.FieldLastUsedIP:  {Type: field.TypeString, Column: scimtoken.FieldLastUsedIP},
+		},
+	}
+	graph.Nodes[44] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   task.Table,
 			Columns: task.Columns,
@@ -1037,7 +1340,7 @@
 			task.FieldEnable:      {Type: field.TypeBool, Column: task.FieldEnable},
 		},
 	}
-	graph.Nodes[35] = &sqlgraph.Node{
+	graph.Nodes[45] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   tenant.Table,
 			Columns: tenant.Columns,
@@ -1060,6 +1363,7 @@
 			tenant.FieldLogoURL:          {Type: field.TypeString, Column: tenant.FieldLogoURL},
 			tenant.FieldDomain:           {Type: field.TypeString, Column: tenant.FieldDomain},
 			tenant.FieldIndustry:         {Type: field.TypeString, Column: tenant.FieldIndustry},
+			tenant.FieldTimezone:         {Type: field.TypeString, Column: tenant.FieldTimezone},
 			tenant.FieldAdminUserID:      {Type: field.TypeUint32, Column: tenant.FieldAdminUserID},
 			tenant.FieldStatus:           {Type: field.TypeEnum, Column: tenant.FieldStatus},
 			tenant.FieldType:             {Type: field.TypeEnum, Column: tenant.FieldType},
@@ -1070,7 +1374,7 @@
 			tenant.FieldExpiredAt:        {Type: field.TypeTime, Column: tenant.FieldExpiredAt},
 		},
 	}
-	graph.Nodes[36] = &sqlgraph.Node{
+	graph.Nodes[46] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   user.Table,
 			Columns: user.Columns,
@@ -1106,7 +1410,7 @@
 			user.FieldStatus:      {Type: field.TypeEnum, Column: user.FieldStatus},
 		},
 	}
-	graph.Nodes[37] = &sqlgraph.Node{
+	graph.Nodes[47] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   usercredential.Table,
 			Columns: usercredential.Columns,
@@ -1137,9 +1441,11 @@
 			usercredential.FieldResetTokenHash:         {Type: field.TypeString, Column: usercredential.FieldResetTokenHash},
 			usercredential.FieldResetTokenExpiresAt:    {Type: field.TypeTime, Column: usercredential.FieldResetTokenExpiresAt},
 			usercredential.FieldResetTokenUsedAt:       {Type: field.TypeTime, Column: usercredential.FieldResetTokenUsedAt},
+			usercredential.FieldCredentialChangedAt:    {Type: field.TypeTime, Column: usercredential.FieldCredentialChangedAt},
+			usercredential.FieldCredentialHistory:      {Type: field.TypeJSON, Column: usercredential.FieldCredentialHistory},
 		},
 	}
-	graph.Nodes[38] = &sqlgraph.Node{
+	graph.Nodes[48] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   usermfafactor.Table,
 			Columns: usermfafactor.Columns,
@@ -1150,19 +1456,27 @@
 		},
 		Type: "UserMfaFactor",
 		Fields: map[string]*sqlgraph.FieldSpec{
-			usermfafactor.FieldCreatedAt:   {Type: field.TypeTime, Column: usermfafactor.FieldCreatedAt},
-			usermfafactor.FieldUpdatedAt:   {Type: field.TypeTime, Column: usermfafactor.FieldUpdatedAt},
-			usermfafactor.FieldDeletedAt:   {Type: field.TypeTime, Column: usermfafactor.FieldDeletedAt},
-			usermfafactor.FieldTenantID:    {Type: field.TypeUint32, Column: usermfafactor.FieldTenantID},
-			usermfafactor.FieldUserID:      {Type: field.TypeUint32, Column: usermfafactor.FieldUserID},
-			usermfafactor.FieldMethod:      {Type: field.TypeEnum, Column: usermfafactor.FieldMethod},
-			usermfafactor.FieldSecretHash:  {Type: field.TypeString, Column: usermfafactor.FieldSecretHash},
-			usermfafactor.FieldDisplayName: {Type: field.TypeString, Column: usermfafactor.FieldDisplayName},
-			usermfafactor.FieldStatus:      {Type: field.TypeEnum, Column: usermfafactor.FieldStatus},
-			usermfafactor.FieldLastUsedAt:  {Type: field.TypeTime, Column: usermfafactor.FieldLastUsedAt},
+			usermfafactor.FieldCreatedAt:         {Type: field.TypeTime, Column: usermfafactor.FieldCreatedAt},
+			usermfafactor.FieldUpdatedAt:         {Type: field.TypeTime, Column: usermfafactor.FieldUpdatedAt},
+			usermfafactor.FieldDeletedAt:         {Type: field.TypeTime, Column: usermfafactor.FieldDeletedAt},
+			usermfafactor.FieldTenantID:          {Type: field.TypeUint32, Column: usermfafactor.FieldTenantID},
+			usermfafactor.FieldUserID:            {Type: field.TypeUint32, Column: usermfafactor.FieldUserID},
+			usermfafactor.FieldMethod:            {Type: field.TypeEnum, Column: usermfafactor.FieldMethod},
+			usermfafactor.FieldSecretHash:        {Type: field.TypeString, Column: usermfafactor.FieldSecretHash},
+			usermfafactor.FieldDisplayName:       {Type: field.TypeString, Column: usermfafactor.FieldDisplayName},
+			usermfafactor.FieldStatus:            {Type: field.TypeEnum, Column: usermfafactor.FieldStatus},
+			usermfafactor.FieldLastUsedAt:        {Type: field.TypeTime, Column: usermfafactor.FieldLastUsedAt},
+			usermfafactor.FieldCredentialID:      {Type: field.TypeString, Column: usermfafactor.FieldCredentialID},
+			usermfafactor.FieldPublicKey:         {Type: field.TypeBytes, Column: usermfafactor.FieldPublicKey},
+			usermfafactor.FieldSignCount:         {Type: field.TypeUint32, Column: usermfafactor.FieldSignCount},
+			usermfafactor.FieldAaguid:            {Type: field.TypeBytes, Column: usermfafactor.FieldAaguid},
+			usermfafactor.FieldTransports:        {Type: field.TypeJSON, Column: usermfafactor.FieldTransports},
+			usermfafactor.FieldCredentialFlags:   {Type: field.TypeUint8, Column: usermfafactor.FieldCredentialFlags},
+			usermfafactor.FieldAttestationType:   {Type: field.TypeString, Column: usermfafactor.FieldAttestationType},
+			usermfafactor.FieldAttestationFormat: {Type: field.TypeString, Column: usermfafactor.FieldAttestationFormat},
 		},
 	}
-	graph.Nodes[39] = &sqlgraph.Node{
+	graph.Nodes[49] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   userorgunit.Table,
 			Columns: userorgunit.Columns,
@@ -1192,7 +1506,7 @@
 			userorgunit.FieldStatus:     {Type: field.TypeEnum, Column: userorgunit.FieldStatus},
 		},
 	}
-	graph.Nodes[40] = &sqlgraph.Node{
+	graph.Nodes[50] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   userposition.Table,
 			Columns: userposition.Columns,
@@ -1221,7 +1535,7 @@
 			userposition.FieldStatus:     {Type: field.TypeEnum, Column: userposition.FieldStatus},
 		},
 	}
-	graph.Nodes[41] = &sqlgraph.Node{
+	graph.Nodes[51] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   userrole.Table,
 			Columns: userrole.Columns,
@@ -1625,6 +1939,16 @@ func (f *ApiAuditLogFilter) WhereUsername(p entql.StringP) {
 	f.Where(p.Field(apiauditlog.FieldUsername))
 }
 
+// WhereImpersonatorID applies the entql uint32 predicate on the impersonator_id field.
+func (f *ApiAuditLogFilter) WhereImpersonatorID(p entql.Uint32P) {
+	f.Where(p.Field(apiauditlog.FieldImpersonatorID))
+}
+
+// WhereImpersonatorName applies the entql string predicate on the impersonator_name field.
+func (f *ApiAuditLogFilter) WhereImpersonatorName(p entql.StringP) {
+	f.Where(p.Field(apiauditlog.FieldImpersonatorName))
+}
+
 // WhereIPAddress applies the entql string predicate on the ip_address field.
 func (f *ApiAuditLogFilter) WhereIPAddress(p entql.StringP) {
 	f.Where(p.Field(apiauditlog.FieldIPAddress))
@@ -1740,6 +2064,146 @@ func (f *ApiAuditLogFilter) WhereSignature(p entql.BytesP) {
 	f.Where(p.Field(apiauditlog.FieldSignature))
 }
 
+// addPredicate implements the predicateAdder interface.
+func (_q *ApiClientQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the ApiClientQuery builder.
+func (_q *ApiClientQuery) Filter() *ApiClientFilter {
+	return &ApiClientFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *ApiClientMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the ApiClientMutation builder.
+func (m *ApiClientMutation) Filter() *ApiClientFilter {
+	return &ApiClientFilter{config: m.config, predicateAdder: m}
+}
+
+// ApiClientFilter provides a generic filtering capability at runtime for ApiClientQuery.
+type ApiClientFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *ApiClientFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[2].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql uint32 predicate on the id field.
+func (f *ApiClientFilter) WhereID(p entql.Uint32P) {
+	f.Where(p.Field(apiclient.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *ApiClientFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(apiclient.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *ApiClientFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(apiclient.FieldUpdatedAt))
+}
+
+// WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
+func (f *ApiClientFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(apiclient.FieldDeletedAt))
+}
+
+// WhereCreatedBy applies the entql uint32 predicate on the created_by field.
+func (f *ApiClientFilter) WhereCreatedBy(p entql.Uint32P) {
+	f.Where(p.Field(apiclient.FieldCreatedBy))
+}
+
+// WhereUpdatedBy applies the entql uint32 predicate on the updated_by field.
+func (f *ApiClientFilter) WhereUpdatedBy(p entql.Uint32P) {
+	f.Where(p.Field(apiclient.FieldUpdatedBy))
+}
+
+// WhereDeletedBy applies the entql uint32 predicate on the deleted_by field.
+func (f *ApiClientFilter) WhereDeletedBy(p entql.Uint32P) {
+	f.Where(p.Field(apiclient.FieldDeletedBy))
+}
+
+// WhereDescription applies the entql string predicate on the description field.
+func (f *ApiClientFilter) WhereDescription(p entql.StringP) {
+	f.Where(p.Field(apiclient.FieldDescription))
+}
+
+// WhereTenantID applies the entql uint32 predicate on the tenant_id field.
+func (f *ApiClientFilter) WhereTenantID(p entql.Uint32P) {
+	f.Where(p.Field(apiclient.FieldTenantID))
+}
+
+// WhereStatus applies the entql string predicate on the status field.
+func (f *ApiClientFilter) WhereStatus(p entql.StringP) {
+	f.Where(p.Field(apiclient.FieldStatus))
+}
+
+// WhereName applies the entql string predicate on the name field.
+func (f *ApiClientFilter) WhereName(p entql.StringP) {
+	f.Where(p.Field(apiclient.FieldName))
+}
+
+// WhereClientID applies the entql string predicate on the client_id field.
+func (f *ApiClientFilter) WhereClientID(p entql.StringP) {
+	f.Where(p.Field(apiclient.FieldClientID))
+}
+
+// WhereClientSecretHash applies the entql string predicate on the client_secret_hash field.
+func (f *ApiClientFilter) WhereClientSecretHash(p entql.StringP) {
+	f.Where(p.Field(apiclient.FieldClientSecretHash))
+}
+
+// WhereRoleIds applies the entql json.RawMessage predicate on the role_ids field.
+func (f *ApiClientFilter) WhereRoleIds(p entql.BytesP) {
+	f.Where(p.Field(apiclient.FieldRoleIds))
+}
+
+// WhereRedirectUris applies the entql json.RawMessage predicate on the redirect_uris field.
+func (f *ApiClientFilter) WhereRedirectUris(p entql.BytesP) {
+	f.Where(p.Field(apiclient.FieldRedirectUris))
+}
+
+// WhereScopes applies the entql json.RawMessage predicate on the scopes field.
+func (f *ApiClientFilter) WhereScopes(p entql.BytesP) {
+	f.Where(p.Field(apiclient.FieldScopes))
+}
+
+// WherePublicClient applies the entql bool predicate on the public_client field.
+func (f *ApiClientFilter) WherePublicClient(p entql.BoolP) {
+	f.Where(p.Field(apiclient.FieldPublicClient))
+}
+
+// WhereExpiresAt applies the entql time.Time predicate on the expires_at field.
+func (f *ApiClientFilter) WhereExpiresAt(p entql.TimeP) {
+	f.Where(p.Field(apiclient.FieldExpiresAt))
+}
+
+// WhereSecretRotatedAt applies the entql time.Time predicate on the secret_rotated_at field.
+func (f *ApiClientFilter) WhereSecretRotatedAt(p entql.TimeP) {
+	f.Where(p.Field(apiclient.FieldSecretRotatedAt))
+}
+
+// WhereLastUsedAt applies the entql time.Time predicate on the last_used_at field.
+func (f *ApiClientFilter) WhereLastUsedAt(p entql.TimeP) {
+	f.Where(p.Field(apiclient.FieldLastUsedAt))
+}
+
+// WhereLastUsedIP applies the entql string predicate on the last_used_ip field.
+func (f *ApiClientFilter) WhereLastUsedIP(p entql.StringP) {
+	f.Where(p.Field(apiclient.FieldLastUsedIP))
+}
+
 // addPredicate implements the predicateAdder interface.
 func (_q *DataAccessAuditLogQuery) addPredicate(pred func(s *sql.Selector)) {
 	_q.predicates = append(_q.predicates, pred)
@@ -1769,7 +2233,7 @@ type DataAccessAuditLogFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *DataAccessAuditLogFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[2].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[3].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -1939,7 +2403,7 @@ type DictEntryFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *DictEntryFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[3].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[4].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -2062,7 +2526,7 @@ type DictEntryI18nFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *DictEntryI18nFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[4].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[5].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -2171,7 +2635,7 @@ type DictTypeFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *DictTypeFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[5].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[6].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -2280,7 +2744,7 @@ type FileFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *FileFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[6].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[7].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -2415,7 +2879,7 @@ type InternalMessageFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *InternalMessageFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[7].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[8].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -2520,7 +2984,7 @@ type InternalMessageCategoryFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *InternalMessageCategoryFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[8].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[9].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -2625,7 +3089,7 @@ type InternalMessageRecipientFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *InternalMessageRecipientFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[9].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[10].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -2681,6 +3145,111 @@ func (f *InternalMessageRecipientFilter) WhereReadAt(p entql.TimeP) {
 	f.Where(p.Field(internalmessagerecipient.FieldReadAt))
 }
 
+// addPredicate implements the predicateAdder interface.
+func (_q *JwtSigningKeyQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the JwtSigningKeyQuery builder.
+func (_q *JwtSigningKeyQuery) Filter() *JwtSigningKeyFilter {
+	return &JwtSigningKeyFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *JwtSigningKeyMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the JwtSigningKeyMutation builder.
+func (m *JwtSigningKeyMutation) Filter() *JwtSigningKeyFilter {
+	return &JwtSigningKeyFilter{config: m.config, predicateAdder: m}
+}
+
+// JwtSigningKeyFilter provides a generic filtering capability at runtime for JwtSigningKeyQuery.
+type JwtSigningKeyFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *JwtSigningKeyFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[11].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql uint32 predicate on the id field.
+func (f *JwtSigningKeyFilter) WhereID(p entql.Uint32P) {
+	f.Where(p.Field(jwtsigningkey.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *JwtSigningKeyFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(jwtsigningkey.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *JwtSigningKeyFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(jwtsigningkey.FieldUpdatedAt))
+}
+
+// WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
+func (f *JwtSigningKeyFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(jwtsigningkey.FieldDeletedAt))
+}
+
+// WhereCreatedBy applies the entql uint32 predicate on the created_by field.
+func (f *JwtSigningKeyFilter) WhereCreatedBy(p entql.Uint32P) {
+	f.Where(p.Field(jwtsigningkey.FieldCreatedBy))
+}
+
+// WhereUpdatedBy applies the entql uint32 predicate on the updated_by field.
+func (f *JwtSigningKeyFilter) WhereUpdatedBy(p entql.Uint32P) {
+	f.Where(p.Field(jwtsigningkey.FieldUpdatedBy))
+}
+
+// WhereDeletedBy applies the entql uint32 predicate on the deleted_by field.
+func (f *JwtSigningKeyFilter) WhereDeletedBy(p entql.Uint32P) {
+	f.Where(p.Field(jwtsigningkey.FieldDeletedBy))
+}
+
+// WhereKid applies the entql string predicate on the kid field.
+func (f *JwtSigningKeyFilter) WhereKid(p entql.StringP) {
+	f.Where(p.Field(jwtsigningkey.FieldKid))
+}
+
+// WhereAlgorithm applies the entql string predicate on the algorithm field.
+func (f *JwtSigningKeyFilter) WhereAlgorithm(p entql.StringP) {
+	f.Where(p.Field(jwtsigningkey.FieldAlgorithm))
+}
+
+// WhereKeyMaterial applies the entql string predicate on the key_material field.
+func (f *JwtSigningKeyFilter) WhereKeyMaterial(p entql.StringP) {
+	f.Where(p.Field(jwtsigningkey.FieldKeyMaterial))
+}
+
+// WhereStatus applies the entql string predicate on the status field.
+func (f *JwtSigningKeyFilter) WhereStatus(p entql.StringP) {
+	f.Where(p.Field(jwtsigningkey.FieldStatus))
+}
+
+// WhereActivatedAt applies the entql time.Time predicate on the activated_at field.
+func (f *JwtSigningKeyFilter) WhereActivatedAt(p entql.TimeP) {
+	f.Where(p.Field(jwtsigningkey.FieldActivatedAt))
+}
+
+// WhereRetiredAt applies the entql time.Time predicate on the retired_at field.
+func (f *JwtSigningKeyFilter) WhereRetiredAt(p entql.TimeP) {
+	f.Where(p.Field(jwtsigningkey.FieldRetiredAt))
+}
+
+// WhereVerifyUntil applies the entql time.Time predicate on the verify_until field.
+func (f *JwtSigningKeyFilter) WhereVerifyUntil(p entql.TimeP) {
+	f.Where(p.Field(jwtsigningkey.FieldVerifyUntil))
+}
+
 // addPredicate implements the predicateAdder interface.
 func (_q *LanguageQuery) addPredicate(pred func(s *sql.Selector)) {
 	_q.predicates = append(_q.predicates, pred)
@@ -2710,7 +3279,7 @@ type LanguageFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *LanguageFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[10].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[12].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -2781,6 +3350,191 @@ func (f *LanguageFilter) WhereIsDefault(p entql.BoolP) {
 	f.Where(p.Field(language.FieldIsDefault))
 }
 
+// addPredicate implements the predicateAdder interface.
+func (_q *LdapConfigQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the LdapConfigQuery builder.
+func (_q *LdapConfigQuery) Filter() *LdapConfigFilter {
+	return &LdapConfigFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *LdapConfigMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the LdapConfigMutation builder.
+func (m *LdapConfigMutation) Filter() *LdapConfigFilter {
+	return &LdapConfigFilter{config: m.config, predicateAdder: m}
+}
+
+// LdapConfigFilter provides a generic filtering capability at runtime for LdapConfigQuery.
+type LdapConfigFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *LdapConfigFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[13].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql uint32 predicate on the id field.
+func (f *LdapConfigFilter) WhereID(p entql.Uint32P) {
+	f.Where(p.Field(ldapconfig.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *LdapConfigFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(ldapconfig.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *LdapConfigFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(ldapconfig.FieldUpdatedAt))
+}
+
+// WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
+func (f *LdapConfigFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(ldapconfig.FieldDeletedAt))
+}
+
+// WhereCreatedBy applies the entql uint32 predicate on the created_by field.
+func (f *LdapConfigFilter) WhereCreatedBy(p entql.Uint32P) {
+	f.Where(p.Field(ldapconfig.FieldCreatedBy))
+}
+
+// WhereUpdatedBy applies the entql uint32 predicate on the updated_by field.
+func (f *LdapConfigFilter) WhereUpdatedBy(p entql.Uint32P) {
+	f.Where(p.Field(ldapconfig.FieldUpdatedBy))
+}
+
+// WhereDeletedBy applies the entql uint32 predicate on the deleted_by field.
+func (f *LdapConfigFilter) WhereDeletedBy(p entql.Uint32P) {
+	f.Where(p.Field(ldapconfig.FieldDeletedBy))
+}
+
+// WhereTenantID applies the entql uint32 predicate on the tenant_id field.
+func (f *LdapConfigFilter) WhereTenantID(p entql.Uint32P) {
+	f.Where(p.Field(ldapconfig.FieldTenantID))
+}
+
+// WhereStatus applies the entql string predicate on the status field.
+func (f *LdapConfigFilter) WhereStatus(p entql.StringP) {
+	f.Where(p.Field(ldapconfig.FieldStatus))
+}
+
+// WhereDisplayName applies the entql string predicate on the display_name field.
+func (f *LdapConfigFilter) WhereDisplayName(p entql.StringP) {
+	f.Where(p.Field(ldapconfig.FieldDisplayName))
+}
+
+// WhereServerURL applies the entql string predicate on the server_url field.
+func (f *LdapConfigFilter) WhereServerURL(p entql.StringP) {
+	f.Where(p.Field(ldapconfig.FieldServerURL))
+}
+
+// WhereStartTLS applies the entql bool predicate on the start_tls field.
+func (f *LdapConfigFilter) WhereStartTLS(p entql.BoolP) {
+	f.Where(p.Field(ldapconfig.FieldStartTLS))
+}
+
+// WhereCaCertificate applies the entql string predicate on the ca_certificate field.
+func (f *LdapConfigFilter) WhereCaCertificate(p entql.StringP) {
+	f.Where(p.Field(ldapconfig.FieldCaCertificate))
+}
+
+// WhereBindDn applies the entql string predicate on the bind_dn field.
+func (f *LdapConfigFilter) WhereBindDn(p entql.StringP) {
+	f.Where(p.Field(ldapconfig.FieldBindDn))
+}
+
+// WhereBindPassword applies the entql string predicate on the bind_password field.
+func (f *LdapConfigFilter) WhereBindPassword(p entql.StringP) {
+	f.Where(p.Field(ldapconfig.FieldBindPassword))
+}
+
+// WhereBaseDn applies the entql string predicate on the base_dn field.
+func (f *LdapConfigFilter) WhereBaseDn(p entql.StringP) {
+	f.Where(p.Field(ldapconfig.FieldBaseDn))
+}
+
+// WhereUserFilter applies the entql string predicate on the user_filter field.
+func (f *LdapConfigFilter) WhereUserFilter(p entql.StringP) {
+	f.Where(p.Field(ldapconfig.FieldUserFilter))
+}
+
+// WhereIDAttribute applies the entql string predicate on the id_attribute field.
+func (f *LdapConfigFilter) WhereIDAttribute(p entql.StringP) {
+	f.Where(p.Field(ldapconfig.FieldIDAttribute))
+}
+
+// WhereAttributeMapping applies the entql json.RawMessage predicate on the attribute_mapping field.
+func (f *LdapConfigFilter) WhereAttributeMapping(p entql.BytesP) {
+	f.Where(p.Field(ldapconfig.FieldAttributeMapping))
+}
+
+// WhereGroupFilter applies the entql string predicate on the group_filter field.
+func (f *LdapConfigFilter) WhereGroupFilter(p entql.StringP) {
+	f.Where(p.Field(ldapconfig.FieldGroupFilter))
+}
+
+// WhereGroupMemberAttribute applies the entql string predicate on the group_member_attribute field.
+func (f *LdapConfigFilter) WhereGroupMemberAttribute(p entql.StringP) {
+	f.Where(p.Field(ldapconfig.FieldGroupMemberAttribute))
+}
+
+// WhereGroupRoleMapping applies the entql json.RawMessage predicate on the group_role_mapping field.
+func (f *LdapConfigFilter) WhereGroupRoleMapping(p entql.BytesP) {
+	f.Where(p.Field(ldapconfig.FieldGroupRoleMapping))
+}
+
+// WhereOrgUnitFilter applies the entql string predicate on the org_unit_filter field.
+func (f *LdapConfigFilter) WhereOrgUnitFilter(p entql.StringP) {
+	f.Where(p.Field(ldapconfig.FieldOrgUnitFilter))
+}
+
+// WhereOrgUnitRootID applies the entql uint32 predicate on the org_unit_root_id field.
+func (f *LdapConfigFilter) WhereOrgUnitRootID(p entql.Uint32P) {
+	f.Where(p.Field(ldapconfig.FieldOrgUnitRootID))
+}
+
+// WhereAuthMode applies the entql string predicate on the auth_mode field.
+func (f *LdapConfigFilter) WhereAuthMode(p entql.StringP) {
+	f.Where(p.Field(ldapconfig.FieldAuthMode))
+}
+
+// WhereJitProvisioning applies the entql bool predicate on the jit_provisioning field.
+func (f *LdapConfigFilter) WhereJitProvisioning(p entql.BoolP) {
+	f.Where(p.Field(ldapconfig.FieldJitProvisioning))
+}
+
+// WhereDefaultRoleIds applies the entql json.RawMessage predicate on the default_role_ids field.
+func (f *LdapConfigFilter) WhereDefaultRoleIds(p entql.BytesP) {
+	f.Where(p.Field(ldapconfig.FieldDefaultRoleIds))
+}
+
+// WhereDefaultOrgUnitID applies the entql uint32 predicate on the default_org_unit_id field.
+func (f *LdapConfigFilter) WhereDefaultOrgUnitID(p entql.Uint32P) {
+	f.Where(p.Field(ldapconfig.FieldDefaultOrgUnitID))
+}
+
+// WhereLastSyncedAt applies the entql time.Time predicate on the last_synced_at field.
+func (f *LdapConfigFilter) WhereLastSyncedAt(p entql.TimeP) {
+	f.Where(p.Field(ldapconfig.FieldLastSyncedAt))
+}
+
+// WhereLastSyncMessage applies the entql string predicate on the last_sync_message field.
+func (f *LdapConfigFilter) WhereLastSyncMessage(p entql.StringP) {
+	f.Where(p.Field(ldapconfig.FieldLastSyncMessage))
+}
+
 // addPredicate implements the predicateAdder interface.
 func (_q *LoginAuditLogQuery) addPredicate(pred func(s *sql.Selector)) {
 	_q.predicates = append(_q.predicates, pred)
@@ -2810,7 +3564,7 @@ type LoginAuditLogFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *LoginAuditLogFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[11].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[14].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -2950,7 +3704,7 @@ type LoginPolicyFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *LoginPolicyFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[12].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[15].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -3021,6 +3775,16 @@ func (f *LoginPolicyFilter) WhereMethod(p entql.StringP) {
 	f.Where(p.Field(loginpolicy.FieldMethod))
 }
 
+// WhereTargetType applies the entql string predicate on the target_type field.
+func (f *LoginPolicyFilter) WhereTargetType(p entql.StringP) {
+	f.Where(p.Field(loginpolicy.FieldTargetType))
+}
+
+// WhereTargetCode applies the entql string predicate on the target_code field.
+func (f *LoginPolicyFilter) WhereTargetCode(p entql.StringP) {
+	f.Where(p.Field(loginpolicy.FieldTargetCode))
+}
+
 // addPredicate implements the predicateAdder interface.
 func (_q *MembershipQuery) addPredicate(pred func(s *sql.Selector)) {
 	_q.predicates = append(_q.predicates, pred)
@@ -3050,7 +3814,7 @@ type MembershipFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *MembershipFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[13].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[16].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -3185,7 +3949,7 @@ type MembershipOrgUnitFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *MembershipOrgUnitFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[14].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[17].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -3315,7 +4079,7 @@ type MembershipPositionFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *MembershipPositionFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[15].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[18].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -3435,7 +4199,7 @@ type MembershipRoleFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *MembershipRoleFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[16].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[19].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -3550,7 +4314,7 @@ type MenuFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *MenuFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[17].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[20].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -3621,57 +4385,287 @@ func (f *MenuFilter) WhereRedirect(p entql.StringP) {
 	f.Where(p.Field(menu.FieldRedirect))
 }
 
-// WhereAlias applies the entql string predicate on the alias field.
-func (f *MenuFilter) WhereAlias(p entql.StringP) {
-	f.Where(p.Field(menu.FieldAlias))
+// WhereAlias applies the entql string predicate on the alias field.
+func (f *MenuFilter) WhereAlias(p entql.StringP) {
+	f.Where(p.Field(menu.FieldAlias))
+}
+
+// WhereName applies the entql string predicate on the name field.
+func (f *MenuFilter) WhereName(p entql.StringP) {
+	f.Where(p.Field(menu.FieldName))
+}
+
+// WhereComponent applies the entql string predicate on the component field.
+func (f *MenuFilter) WhereComponent(p entql.StringP) {
+	f.Where(p.Field(menu.FieldComponent))
+}
+
+// WhereMeta applies the entql json.RawMessage predicate on the meta field.
+func (f *MenuFilter) WhereMeta(p entql.BytesP) {
+	f.Where(p.Field(menu.FieldMeta))
+}
+
+// WhereModule applies the entql string predicate on the module field.
+func (f *MenuFilter) WhereModule(p entql.StringP) {
+	f.Where(p.Field(menu.FieldModule))
+}
+
+// WhereHasParent applies a predicate to check if query has an edge parent.
+func (f *MenuFilter) WhereHasParent() {
+	f.Where(entql.HasEdge("parent"))
+}
+
+// WhereHasParentWith applies a predicate to check if query has an edge parent with a given conditions (other predicates).
+func (f *MenuFilter) WhereHasParentWith(preds ...predicate.Menu) {
+	f.Where(entql.HasEdgeWith("parent", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasChildren applies a predicate to check if query has an edge children.
+func (f *MenuFilter) WhereHasChildren() {
+	f.Where(entql.HasEdge("children"))
+}
+
+// WhereHasChildrenWith applies a predicate to check if query has an edge children with a given conditions (other predicates).
+func (f *MenuFilter) WhereHasChildrenWith(preds ...predicate.Menu) {
+	f.Where(entql.HasEdgeWith("children", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *MfaPolicyQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the MfaPolicyQuery builder.
+func (_q *MfaPolicyQuery) Filter() *MfaPolicyFilter {
+	return &MfaPolicyFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *MfaPolicyMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the MfaPolicyMutation builder.
+func (m *MfaPolicyMutation) Filter() *MfaPolicyFilter {
+	return &MfaPolicyFilter{config: m.config, predicateAdder: m}
+}
+
+// MfaPolicyFilter provides a generic filtering capability at runtime for MfaPolicyQuery.
+type MfaPolicyFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *MfaPolicyFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[21].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql uint32 predicate on the id field.
+func (f *MfaPolicyFilter) WhereID(p entql.Uint32P) {
+	f.Where(p.Field(mfapolicy.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *MfaPolicyFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(mfapolicy.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *MfaPolicyFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(mfapolicy.FieldUpdatedAt))
+}
+
+// WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
+func (f *MfaPolicyFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(mfapolicy.FieldDeletedAt))
+}
+
+// WhereCreatedBy applies the entql uint32 predicate on the created_by field.
+func (f *MfaPolicyFilter) WhereCreatedBy(p entql.Uint32P) {
+	f.Where(p.Field(mfapolicy.FieldCreatedBy))
+}
+
+// WhereUpdatedBy applies the entql uint32 predicate on the updated_by field.
+func (f *MfaPolicyFilter) WhereUpdatedBy(p entql.Uint32P) {
+	f.Where(p.Field(mfapolicy.FieldUpdatedBy))
+}
+
+// WhereDeletedBy applies the entql uint32 predicate on the deleted_by field.
+func (f *MfaPolicyFilter) WhereDeletedBy(p entql.Uint32P) {
+	f.Where(p.Field(mfapolicy.FieldDeletedBy))
+}
+
+// WhereTenantID applies the entql uint32 predicate on the tenant_id field.
+func (f *MfaPolicyFilter) WhereTenantID(p entql.Uint32P) {
+	f.Where(p.Field(mfapolicy.FieldTenantID))
+}
+
+// WhereEnforcement applies the entql string predicate on the enforcement field.
+func (f *MfaPolicyFilter) WhereEnforcement(p entql.StringP) {
+	f.Where(p.Field(mfapolicy.FieldEnforcement))
+}
+
+// WhereRequiredRoleCodes applies the entql json.RawMessage predicate on the required_role_codes field.
+func (f *MfaPolicyFilter) WhereRequiredRoleCodes(p entql.BytesP) {
+	f.Where(p.Field(mfapolicy.FieldRequiredRoleCodes))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *OAuthProviderConfigQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the OAuthProviderConfigQuery builder.
+func (_q *OAuthProviderConfigQuery) Filter() *OAuthProviderConfigFilter {
+	return &OAuthProviderConfigFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *OAuthProviderConfigMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the OAuthProviderConfigMutation builder.
+func (m *OAuthProviderConfigMutation) Filter() *OAuthProviderConfigFilter {
+	return &OAuthProviderConfigFilter{config: m.config, predicateAdder: m}
+}
+
+// OAuthProviderConfigFilter provides a generic filtering capability at runtime for OAuthProviderConfigQuery.
+type OAuthProviderConfigFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *OAuthProviderConfigFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[22].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql uint32 predicate on the id field.
+func (f *OAuthProviderConfigFilter) WhereID(p entql.Uint32P) {
+	f.Where(p.Field(oauthproviderconfig.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *OAuthProviderConfigFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(oauthproviderconfig.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *OAuthProviderConfigFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(oauthproviderconfig.FieldUpdatedAt))
+}
+
+// WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
+func (f *OAuthProviderConfigFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(oauthproviderconfig.FieldDeletedAt))
+}
+
+// WhereCreatedBy applies the entql uint32 predicate on the created_by field.
+func (f *OAuthProviderConfigFilter) WhereCreatedBy(p entql.Uint32P) {
+	f.Where(p.Field(oauthproviderconfig.FieldCreatedBy))
+}
+
+// WhereUpdatedBy applies the entql uint32 predicate on the updated_by field.
+func (f *OAuthProviderConfigFilter) WhereUpdatedBy(p entql.Uint32P) {
+	f.Where(p.Field(oauthproviderconfig.FieldUpdatedBy))
+}
+
+// WhereDeletedBy applies the entql uint32 predicate on the deleted_by field.
+func (f *OAuthProviderConfigFilter) WhereDeletedBy(p entql.Uint32P) {
+	f.Where(p.Field(oauthproviderconfig.FieldDeletedBy))
+}
+
+// WhereTenantID applies the entql uint32 predicate on the tenant_id field.
+func (f *OAuthProviderConfigFilter) WhereTenantID(p entql.Uint32P) {
+	f.Where(p.Field(oauthproviderconfig.FieldTenantID))
+}
+
+// WhereStatus applies the entql string predicate on the status field.
+func (f *OAuthProviderConfigFilter) WhereStatus(p entql.StringP) {
+	f.Where(p.Field(oauthproviderconfig.FieldStatus))
+}
+
+// WhereSortOrder applies the entql uint32 predicate on the sort_order field.
+func (f *OAuthProviderConfigFilter) WhereSortOrder(p entql.Uint32P) {
+	f.Where(p.Field(oauthproviderconfig.FieldSortOrder))
+}
+
+// WhereProvider applies the entql string predicate on the provider field.
+func (f *OAuthProviderConfigFilter) WhereProvider(p entql.StringP) {
+	f.Where(p.Field(oauthproviderconfig.FieldProvider))
+}
+
+// WhereProviderCustom applies the entql string predicate on the provider_custom field.
+func (f *OAuthProviderConfigFilter) WhereProviderCustom(p entql.StringP) {
+	f.Where(p.Field(oauthproviderconfig.FieldProviderCustom))
+}
+
+// WhereDisplayName applies the entql string predicate on the display_name field.
+func (f *OAuthProviderConfigFilter) WhereDisplayName(p entql.StringP) {
+	f.Where(p.Field(oauthproviderconfig.FieldDisplayName))
+}
+
+// WhereClientID applies the entql string predicate on the client_id field.
+func (f *OAuthProviderConfigFilter) WhereClientID(p entql.StringP) {
+	f.Where(p.Field(oauthproviderconfig.FieldClientID))
 }
 
-// WhereName applies the entql string predicate on the name field.
-func (f *MenuFilter) WhereName(p entql.StringP) {
-	f.Where(p.Field(menu.FieldName))
+// WhereClientSecret applies the entql string predicate on the client_secret field.
+func (f *OAuthProviderConfigFilter) WhereClientSecret(p entql.StringP) {
+	f.Where(p.Field(oauthproviderconfig.FieldClientSecret))
 }
 
-// WhereComponent applies the entql string predicate on the component field.
-func (f *MenuFilter) WhereComponent(p entql.StringP) {
-	f.Where(p.Field(menu.FieldComponent))
+// WhereAuthURL applies the entql string predicate on the auth_url field.
+func (f *OAuthProviderConfigFilter) WhereAuthURL(p entql.StringP) {
+	f.Where(p.Field(oauthproviderconfig.FieldAuthURL))
 }
 
-// WhereMeta applies the entql json.RawMessage predicate on the meta field.
-func (f *MenuFilter) WhereMeta(p entql.BytesP) {
-	f.Where(p.Field(menu.FieldMeta))
+// WhereTokenURL applies the entql string predicate on the token_url field.
+func (f *OAuthProviderConfigFilter) WhereTokenURL(p entql.StringP) {
+	f.Where(p.Field(oauthproviderconfig.FieldTokenURL))
 }
 
-// WhereModule applies the entql string predicate on the module field.
-func (f *MenuFilter) WhereModule(p entql.StringP) {
-	f.Where(p.Field(menu.FieldModule))
+// WhereUserinfoURL applies the entql string predicate on the userinfo_url field.
+func (f *OAuthProviderConfigFilter) WhereUserinfoURL(p entql.StringP) {
+	f.Where(p.Field(oauthproviderconfig.FieldUserinfoURL))
 }
 
-// WhereHasParent applies a predicate to check if query has an edge parent.
-func (f *MenuFilter) WhereHasParent() {
-	f.Where(entql.HasEdge("parent"))
+// WhereRedirectURI applies the entql string predicate on the redirect_uri field.
+func (f *OAuthProviderConfigFilter) WhereRedirectURI(p entql.StringP) {
+	f.Where(p.Field(oauthproviderconfig.FieldRedirectURI))
 }
 
-// WhereHasParentWith applies a predicate to check if query has an edge parent with a given conditions (other predicates).
-func (f *MenuFilter) WhereHasParentWith(preds ...predicate.Menu) {
-	f.Where(entql.HasEdgeWith("parent", sqlgraph.WrapFunc(func(s *sql.Selector) {
-		for _, p := range preds {
-			p(s)
-		}
-	})))
+// WhereScopes applies the entql json.RawMessage predicate on the scopes field.
+func (f *OAuthProviderConfigFilter) WhereScopes(p entql.BytesP) {
+	f.Where(p.Field(oauthproviderconfig.FieldScopes))
 }
 
-// WhereHasChildren applies a predicate to check if query has an edge children.
-func (f *MenuFilter) WhereHasChildren() {
-	f.Where(entql.HasEdge("children"))
+// WhereAutoProvision applies the entql bool predicate on the auto_provision field.
+func (f *OAuthProviderConfigFilter) WhereAutoProvision(p entql.BoolP) {
+	f.Where(p.Field(oauthproviderconfig.FieldAutoProvision))
 }
 
-// WhereHasChildrenWith applies a predicate to check if query has an edge children with a given conditions (other predicates).
-func (f *MenuFilter) WhereHasChildrenWith(preds ...predicate.Menu) {
-	f.Where(entql.HasEdgeWith("children", sqlgraph.WrapFunc(func(s *sql.Selector) {
-		for _, p := range preds {
-			p(s)
-		}
-	})))
+// WhereDefaultRoleIds applies the entql json.RawMessage predicate on the default_role_ids field.
+func (f *OAuthProviderConfigFilter) WhereDefaultRoleIds(p entql.BytesP) {
+	f.Where(p.Field(oauthproviderconfig.FieldDefaultRoleIds))
 }
 
 // addPredicate implements the predicateAdder interface.
@@ -3703,7 +4697,7 @@ type OperationAuditLogFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *OperationAuditLogFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[18].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[23].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -3734,6 +4728,16 @@ func (f *OperationAuditLogFilter) WhereUsername(p entql.StringP) {
 	f.Where(p.Field(operationauditlog.FieldUsername))
 }
 
+// WhereImpersonatorID applies the entql uint32 predicate on the impersonator_id field.
+func (f *OperationAuditLogFilter) WhereImpersonatorID(p entql.Uint32P) {
+	f.Where(p.Field(operationauditlog.FieldImpersonatorID))
+}
+
+// WhereImpersonatorName applies the entql string predicate on the impersonator_name field.
+func (f *OperationAuditLogFilter) WhereImpersonatorName(p entql.StringP) {
+	f.Where(p.Field(operationauditlog.FieldImpersonatorName))
+}
+
 // WhereResourceType applies the entql string predicate on the resource_type field.
 func (f *OperationAuditLogFilter) WhereResourceType(p entql.StringP) {
 	f.Where(p.Field(operationauditlog.FieldResourceType))
@@ -3838,7 +4842,7 @@ type OrgUnitFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *OrgUnitFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[19].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[24].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -4047,6 +5051,131 @@ func (f *OrgUnitFilter) WhereHasChildrenWith(preds ...predicate.OrgUnit) {
 	})))
 }
 
+// addPredicate implements the predicateAdder interface.
+func (_q *PasswordPolicyQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the PasswordPolicyQuery builder.
+func (_q *PasswordPolicyQuery) Filter() *PasswordPolicyFilter {
+	return &PasswordPolicyFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *PasswordPolicyMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the PasswordPolicyMutation builder.
+func (m *PasswordPolicyMutation) Filter() *PasswordPolicyFilter {
+	return &PasswordPolicyFilter{config: m.config, predicateAdder: m}
+}
+
+// PasswordPolicyFilter provides a generic filtering capability at runtime for PasswordPolicyQuery.
+type PasswordPolicyFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *PasswordPolicyFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[25].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql uint32 predicate on the id field.
+func (f *PasswordPolicyFilter) WhereID(p entql.Uint32P) {
+	f.Where(p.Field(passwordpolicy.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *PasswordPolicyFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(passwordpolicy.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *PasswordPolicyFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(passwordpolicy.FieldUpdatedAt))
+}
+
+// WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
+func (f *PasswordPolicyFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(passwordpolicy.FieldDeletedAt))
+}
+
+// WhereCreatedBy applies the entql uint32 predicate on the created_by field.
+func (f *PasswordPolicyFilter) WhereCreatedBy(p entql.Uint32P) {
+	f.Where(p.Field(passwordpolicy.FieldCreatedBy))
+}
+
+// WhereUpdatedBy applies the entql uint32 predicate on the updated_by field.
+func (f *PasswordPolicyFilter) WhereUpdatedBy(p entql.Uint32P) {
+	f.Where(p.Field(passwordpolicy.FieldUpdatedBy))
+}
+
+// WhereDeletedBy applies the entql uint32 predicate on the deleted_by field.
+func (f *PasswordPolicyFilter) WhereDeletedBy(p entql.Uint32P) {
+	f.Where(p.Field(passwordpolicy.FieldDeletedBy))
+}
+
+// WhereTenantID applies the entql uint32 predicate on the tenant_id field.
+func (f *PasswordPolicyFilter) WhereTenantID(p entql.Uint32P) {
+	f.Where(p.Field(passwordpolicy.FieldTenantID))
+}
+
+// WhereMinLength applies the entql uint32 predicate on the min_length field.
+func (f *PasswordPolicyFilter) WhereMinLength(p entql.Uint32P) {
+	f.Where(p.Field(passwordpolicy.FieldMinLength))
+}
+
+// WhereRequireUppercase applies the entql bool predicate on the require_uppercase field.
+func (f *PasswordPolicyFilter) WhereRequireUppercase(p entql.BoolP) {
+	f.Where(p.Field(passwordpolicy.FieldRequireUppercase))
+}
+
+// WhereRequireLowercase applies the entql bool predicate on the require_lowercase field.
+func (f *PasswordPolicyFilter) WhereRequireLowercase(p entql.BoolP) {
+	f.Where(p.Field(passwordpolicy.FieldRequireLowercase))
+}
+
+// WhereRequireDigit applies the entql bool predicate on the require_digit field.
+func (f *PasswordPolicyFilter) WhereRequireDigit(p entql.BoolP) {
+	f.Where(p.Field(passwordpolicy.FieldRequireDigit))
+}
+
+// WhereRequireSymbol applies the entql bool predicate on the require_symbol field.
+func (f *PasswordPolicyFilter) WhereRequireSymbol(p entql.BoolP) {
+	f.Where(p.Field(passwordpolicy.FieldRequireSymbol))
+}
+
+// WhereDisallowIdentity applies the entql bool predicate on the disallow_identity field.
+func (f *PasswordPolicyFilter) WhereDisallowIdentity(p entql.BoolP) {
+	f.Where(p.Field(passwordpolicy.FieldDisallowIdentity))
+}
+
+// WhereBannedSubstrings applies the entql json.RawMessage predicate on the banned_substrings field.
+func (f *PasswordPolicyFilter) WhereBannedSubstrings(p entql.BytesP) {
+	f.Where(p.Field(passwordpolicy.FieldBannedSubstrings))
+}
+
+// WhereHistoryCount applies the entql uint32 predicate on the history_count field.
+func (f *PasswordPolicyFilter) WhereHistoryCount(p entql.Uint32P) {
+	f.Where(p.Field(passwordpolicy.FieldHistoryCount))
+}
+
+// WhereMaxAgeDays applies the entql uint32 predicate on the max_age_days field.
+func (f *PasswordPolicyFilter) WhereMaxAgeDays(p entql.Uint32P) {
+	f.Where(p.Field(passwordpolicy.FieldMaxAgeDays))
+}
+
+// WhereCheckBreached applies the entql bool predicate on the check_breached field.
+func (f *PasswordPolicyFilter) WhereCheckBreached(p entql.BoolP) {
+	f.Where(p.Field(passwordpolicy.FieldCheckBreached))
+}
+
 // addPredicate implements the predicateAdder interface.
 func (_q *PermissionQuery) addPredicate(pred func(s *sql.Selector)) {
 	_q.predicates = append(_q.predicates, pred)
@@ -4076,7 +5205,7 @@ type PermissionFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *PermissionFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[20].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[26].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -4171,7 +5300,7 @@ type PermissionApiFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *PermissionApiFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[21].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[27].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -4251,7 +5380,7 @@ type PermissionAuditLogFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *PermissionAuditLogFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[22].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[28].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -4356,7 +5485,7 @@ type PermissionGroupFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *PermissionGroupFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[23].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[29].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -4489,7 +5618,7 @@ type PermissionMenuFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *PermissionMenuFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[24].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[30].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -4569,7 +5698,7 @@ type PermissionPolicyFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *PermissionPolicyFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[25].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[31].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -4674,7 +5803,7 @@ type PlanFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *PlanFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[26].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[32].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -4816,7 +5945,7 @@ type PlanModuleFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *PlanModuleFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[27].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[33].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -4905,7 +6034,7 @@ type PlanQuotaFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *PlanQuotaFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[28].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[34].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -4999,7 +6128,7 @@ type PolicyEvaluationLogFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *PolicyEvaluationLogFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[29].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[35].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -5119,7 +6248,7 @@ type PositionFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *PositionFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[30].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[36].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -5150,99 +6279,239 @@ func (f *PositionFilter) WhereCreatedBy(p entql.Uint32P) {
 	f.Where(p.Field(position.FieldCreatedBy))
 }
 
-// WhereUpdatedBy applies the entql uint32 predicate on the updated_by field.
-func (f *PositionFilter) WhereUpdatedBy(p entql.Uint32P) {
-	f.Where(p.Field(position.FieldUpdatedBy))
+// WhereUpdatedBy applies the entql uint32 predicate on the updated_by field.
+func (f *PositionFilter) WhereUpdatedBy(p entql.Uint32P) {
+	f.Where(p.Field(position.FieldUpdatedBy))
+}
+
+// WhereDeletedBy applies the entql uint32 predicate on the deleted_by field.
+func (f *PositionFilter) WhereDeletedBy(p entql.Uint32P) {
+	f.Where(p.Field(position.FieldDeletedBy))
+}
+
+// WhereSortOrder applies the entql uint32 predicate on the sort_order field.
+func (f *PositionFilter) WhereSortOrder(p entql.Uint32P) {
+	f.Where(p.Field(position.FieldSortOrder))
+}
+
+// WhereRemark applies the entql string predicate on the remark field.
+func (f *PositionFilter) WhereRemark(p entql.StringP) {
+	f.Where(p.Field(position.FieldRemark))
+}
+
+// WhereTenantID applies the entql uint32 predicate on the tenant_id field.
+func (f *PositionFilter) WhereTenantID(p entql.Uint32P) {
+	f.Where(p.Field(position.FieldTenantID))
+}
+
+// WhereStatus applies the entql string predicate on the status field.
+func (f *PositionFilter) WhereStatus(p entql.StringP) {
+	f.Where(p.Field(position.FieldStatus))
+}
+
+// WhereName applies the entql string predicate on the name field.
+func (f *PositionFilter) WhereName(p entql.StringP) {
+	f.Where(p.Field(position.FieldName))
+}
+
+// WhereCode applies the entql string predicate on the code field.
+func (f *PositionFilter) WhereCode(p entql.StringP) {
+	f.Where(p.Field(position.FieldCode))
+}
+
+// WhereOrgUnitID applies the entql uint32 predicate on the org_unit_id field.
+func (f *PositionFilter) WhereOrgUnitID(p entql.Uint32P) {
+	f.Where(p.Field(position.FieldOrgUnitID))
+}
+
+// WhereReportsToPositionID applies the entql uint32 predicate on the reports_to_position_id field.
+func (f *PositionFilter) WhereReportsToPositionID(p entql.Uint32P) {
+	f.Where(p.Field(position.FieldReportsToPositionID))
+}
+
+// WhereDescription applies the entql string predicate on the description field.
+func (f *PositionFilter) WhereDescription(p entql.StringP) {
+	f.Where(p.Field(position.FieldDescription))
+}
+
+// WhereJobFamily applies the entql string predicate on the job_family field.
+func (f *PositionFilter) WhereJobFamily(p entql.StringP) {
+	f.Where(p.Field(position.FieldJobFamily))
+}
+
+// WhereJobGrade applies the entql string predicate on the job_grade field.
+func (f *PositionFilter) WhereJobGrade(p entql.StringP) {
+	f.Where(p.Field(position.FieldJobGrade))
+}
+
+// WhereLevel applies the entql int32 predicate on the level field.
+func (f *PositionFilter) WhereLevel(p entql.Int32P) {
+	f.Where(p.Field(position.FieldLevel))
+}
+
+// WhereHeadcount applies the entql uint32 predicate on the headcount field.
+func (f *PositionFilter) WhereHeadcount(p entql.Uint32P) {
+	f.Where(p.Field(position.FieldHeadcount))
+}
+
+// WhereIsKeyPosition applies the entql bool predicate on the is_key_position field.
+func (f *PositionFilter) WhereIsKeyPosition(p entql.BoolP) {
+	f.Where(p.Field(position.FieldIsKeyPosition))
+}
+
+// WhereType applies the entql string predicate on the type field.
+func (f *PositionFilter) WhereType(p entql.StringP) {
+	f.Where(p.Field(position.FieldType))
+}
+
+// WhereStartAt applies the entql time.Time predicate on the start_at field.
+func (f *PositionFilter) WhereStartAt(p entql.TimeP) {
+	f.Where(p.Field(position.FieldStartAt))
+}
+
+// WhereEndAt applies the entql time.Time predicate on the end_at field.
+func (f *PositionFilter) WhereEndAt(p entql.TimeP) {
+	f.Where(p.Field(position.FieldEndAt))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *RelationTupleQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the RelationTupleQuery builder.
+func (_q *RelationTupleQuery) Filter() *RelationTupleFilter {
+	return &RelationTupleFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *RelationTupleMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the RelationTupleMutation builder.
+func (m *RelationTupleMutation) Filter() *RelationTupleFilter {
+	return &RelationTupleFilter{config: m.config, predicateAdder: m}
+}
+
+// RelationTupleFilter provides a generic filtering capability at runtime for RelationTupleQuery.
+type RelationTupleFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *RelationTupleFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[37].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql uint32 predicate on the id field.
+func (f *RelationTupleFilter) WhereID(p entql.Uint32P) {
+	f.Where(p.Field(relationtuple.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *RelationTupleFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(relationtuple.FieldCreatedAt))
 }
 
-// WhereDeletedBy applies the entql uint32 predicate on the deleted_by field.
-func (f *PositionFilter) WhereDeletedBy(p entql.Uint32P) {
-	f.Where(p.Field(position.FieldDeletedBy))
+// WhereCreatedBy applies the entql uint32 predicate on the created_by field.
+func (f *RelationTupleFilter) WhereCreatedBy(p entql.Uint32P) {
+	f.Where(p.Field(relationtuple.FieldCreatedBy))
 }
 
-// WhereSortOrder applies the entql uint32 predicate on the sort_order field.
-func (f *PositionFilter) WhereSortOrder(p entql.Uint32P) {
-	f.Where(p.Field(position.FieldSortOrder))
+// WhereNamespace applies the entql string predicate on the namespace field.
+func (f *RelationTupleFilter) WhereNamespace(p entql.StringP) {
+	f.Where(p.Field(relationtuple.FieldNamespace))
 }
 
-// WhereRemark applies the entql string predicate on the remark field.
-func (f *PositionFilter) WhereRemark(p entql.StringP) {
-	f.Where(p.Field(position.FieldRemark))
+// WhereObjectID applies the entql string predicate on the object_id field.
+func (f *RelationTupleFilter) WhereObjectID(p entql.StringP) {
+	f.Where(p.Field(relationtuple.FieldObjectID))
 }
 
-// WhereTenantID applies the entql uint32 predicate on the tenant_id field.
-func (f *PositionFilter) WhereTenantID(p entql.Uint32P) {
-	f.Where(p.Field(position.FieldTenantID))
+// WhereRelation applies the entql string predicate on the relation field.
+func (f *RelationTupleFilter) WhereRelation(p entql.StringP) {
+	f.Where(p.Field(relationtuple.FieldRelation))
 }
 
-// WhereStatus applies the entql string predicate on the status field.
-func (f *PositionFilter) WhereStatus(p entql.StringP) {
-	f.Where(p.Field(position.FieldStatus))
+// WhereSubjectNamespace applies the entql string predicate on the subject_namespace field.
+func (f *RelationTupleFilter) WhereSubjectNamespace(p entql.StringP) {
+	f.Where(p.Field(relationtuple.FieldSubjectNamespace))
 }
 
-// WhereName applies the entql string predicate on the name field.
-func (f *PositionFilter) WhereName(p entql.StringP) {
-	f.Where(p.Field(position.FieldName))
+// WhereSubjectID applies the entql string predicate on the subject_id field.
+func (f *RelationTupleFilter) WhereSubjectID(p entql.StringP) {
+	f.Where(p.Field(relationtuple.FieldSubjectID))
 }
 
-// WhereCode applies the entql string predicate on the code field.
-func (f *PositionFilter) WhereCode(p entql.StringP) {
-	f.Where(p.Field(position.FieldCode))
+// WhereSubjectRelation applies the entql string predicate on the subject_relation field.
+func (f *RelationTupleFilter) WhereSubjectRelation(p entql.StringP) {
+	f.Where(p.Field(relationtuple.FieldSubjectRelation))
 }
 
-// WhereOrgUnitID applies the entql uint32 predicate on the org_unit_id field.
-func (f *PositionFilter) WhereOrgUnitID(p entql.Uint32P) {
-	f.Where(p.Field(position.FieldOrgUnitID))
+// addPredicate implements the predicateAdder interface.
+func (_q *RelationTupleChangeQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
 }
 
-// WhereReportsToPositionID applies the entql uint32 predicate on the reports_to_position_id field.
-func (f *PositionFilter) WhereReportsToPositionID(p entql.Uint32P) {
-	f.Where(p.Field(position.FieldReportsToPositionID))
+// Filter returns a Filter implementation to apply filters on the RelationTupleChangeQuery builder.
+func (_q *RelationTupleChangeQuery) Filter() *RelationTupleChangeFilter {
+	return &RelationTupleChangeFilter{config: _q.config, predicateAdder: _q}
 }
 
-// WhereDescription applies the entql string predicate on the description field.
-func (f *PositionFilter) WhereDescription(p entql.StringP) {
-	f.Where(p.Field(position.FieldDescription))
+// addPredicate implements the predicateAdder interface.
+func (m *RelationTupleChangeMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
 }
 
-// WhereJobFamily applies the entql string predicate on the job_family field.
-func (f *PositionFilter) WhereJobFamily(p entql.StringP) {
-	f.Where(p.Field(position.FieldJobFamily))
+// Filter returns an entql.Where implementation to apply filters on the RelationTupleChangeMutation builder.
+func (m *RelationTupleChangeMutation) Filter() *RelationTupleChangeFilter {
+	return &RelationTupleChangeFilter{config: m.config, predicateAdder: m}
 }
 
-// WhereJobGrade applies the entql string predicate on the job_grade field.
-func (f *PositionFilter) WhereJobGrade(p entql.StringP) {
-	f.Where(p.Field(position.FieldJobGrade))
+// RelationTupleChangeFilter provides a generic filtering capability at runtime for RelationTupleChangeQuery.
+type RelationTupleChangeFilter struct {
+	predicateAdder
+	config
 }
 
-// WhereLevel applies the entql int32 predicate on the level field.
-func (f *PositionFilter) WhereLevel(p entql.Int32P) {
-	f.Where(p.Field(position.FieldLevel))
+// Where applies the entql predicate on the query filter.
+func (f *RelationTupleChangeFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[38].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
 }
 
-// WhereHeadcount applies the entql uint32 predicate on the headcount field.
-func (f *PositionFilter) WhereHeadcount(p entql.Uint32P) {
-	f.Where(p.Field(position.FieldHeadcount))
+// WhereID applies the entql uint32 predicate on the id field.
+func (f *RelationTupleChangeFilter) WhereID(p entql.Uint32P) {
+	f.Where(p.Field(relationtuplechange.FieldID))
 }
 
-// WhereIsKeyPosition applies the entql bool predicate on the is_key_position field.
-func (f *PositionFilter) WhereIsKeyPosition(p entql.BoolP) {
-	f.Where(p.Field(position.FieldIsKeyPosition))
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *RelationTupleChangeFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(relationtuplechange.FieldCreatedAt))
 }
 
-// WhereType applies the entql string predicate on the type field.
-func (f *PositionFilter) WhereType(p entql.StringP) {
-	f.Where(p.Field(position.FieldType))
+// WhereCreatedBy applies the entql uint32 predicate on the created_by field.
+func (f *RelationTupleChangeFilter) WhereCreatedBy(p entql.Uint32P) {
+	f.Where(p.Field(relationtuplechange.FieldCreatedBy))
 }
 
-// WhereStartAt applies the entql time.Time predicate on the start_at field.
-func (f *PositionFilter) WhereStartAt(p entql.TimeP) {
-	f.Where(p.Field(position.FieldStartAt))
+// WhereOperation applies the entql string predicate on the operation field.
+func (f *RelationTupleChangeFilter) WhereOperation(p entql.StringP) {
+	f.Where(p.Field(relationtuplechange.FieldOperation))
 }
 
-// WhereEndAt applies the entql time.Time predicate on the end_at field.
-func (f *PositionFilter) WhereEndAt(p entql.TimeP) {
-	f.Where(p.Field(position.FieldEndAt))
+// WhereTuples applies the entql string predicate on the tuples field.
+func (f *RelationTupleChangeFilter) WhereTuples(p entql.StringP) {
+	f.Where(p.Field(relationtuplechange.FieldTuples))
 }
 
 // addPredicate implements the predicateAdder interface.
@@ -5274,7 +6543,7 @@ type RoleFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *RoleFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[31].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[39].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -5360,6 +6629,16 @@ func (f *RoleFilter) WhereType(p entql.StringP) {
 	f.Where(p.Field(role.FieldType))
 }
 
+// WhereDataScope applies the entql string predicate on the data_scope field.
+func (f *RoleFilter) WhereDataScope(p entql.StringP) {
+	f.Where(p.Field(role.FieldDataScope))
+}
+
+// WhereDataScopeOrgUnitIds applies the entql json.RawMessage predicate on the data_scope_org_unit_ids field.
+func (f *RoleFilter) WhereDataScopeOrgUnitIds(p entql.BytesP) {
+	f.Where(p.Field(role.FieldDataScopeOrgUnitIds))
+}
+
 // addPredicate implements the predicateAdder interface.
 func (_q *RoleMetadataQuery) addPredicate(pred func(s *sql.Selector)) {
 	_q.predicates = append(_q.predicates, pred)
@@ -5389,7 +6668,7 @@ type RoleMetadataFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *RoleMetadataFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[32].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[40].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -5509,7 +6788,7 @@ type RolePermissionFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *RolePermissionFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[33].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[41].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -5580,6 +6859,256 @@ func (f *RolePermissionFilter) WherePriority(p entql.Int32P) {
 	f.Where(p.Field(rolepermission.FieldPriority))
 }
 
+// addPredicate implements the predicateAdder interface.
+func (_q *SamlConfigQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the SamlConfigQuery builder.
+func (_q *SamlConfigQuery) Filter() *SamlConfigFilter {
+	return &SamlConfigFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *SamlConfigMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the SamlConfigMutation builder.
+func (m *SamlConfigMutation) Filter() *SamlConfigFilter {
+	return &SamlConfigFilter{config: m.config, predicateAdder: m}
+}
+
+// SamlConfigFilter provides a generic filtering capability at runtime for SamlConfigQuery.
+type SamlConfigFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *SamlConfigFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[42].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql uint32 predicate on the id field.
+func (f *SamlConfigFilter) WhereID(p entql.Uint32P) {
+	f.Where(p.Field(samlconfig.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *SamlConfigFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(samlconfig.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *SamlConfigFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(samlconfig.FieldUpdatedAt))
+}
+
+// WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
+func (f *SamlConfigFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(samlconfig.FieldDeletedAt))
+}
+
+// WhereCreatedBy applies the entql uint32 predicate on the created_by field.
+func (f *SamlConfigFilter) WhereCreatedBy(p entql.Uint32P) {
+	f.Where(p.Field(samlconfig.FieldCreatedBy))
+}
+
+// WhereUpdatedBy applies the entql uint32 predicate on the updated_by field.
+func (f *SamlConfigFilter) WhereUpdatedBy(p entql.Uint32P) {
+	f.Where(p.Field(samlconfig.FieldUpdatedBy))
+}
+
+// WhereDeletedBy applies the entql uint32 predicate on the deleted_by field.
+func (f *SamlConfigFilter) WhereDeletedBy(p entql.Uint32P) {
+	f.Where(p.Field(samlconfig.FieldDeletedBy))
+}
+
+// WhereTenantID applies the entql uint32 predicate on the tenant_id field.
+func (f *SamlConfigFilter) WhereTenantID(p entql.Uint32P) {
+	f.Where(p.Field(samlconfig.FieldTenantID))
+}
+
+// WhereStatus applies the entql string predicate on the status field.
+func (f *SamlConfigFilter) WhereStatus(p entql.StringP) {
+	f.Where(p.Field(samlconfig.FieldStatus))
+}
+
+// WhereDisplayName applies the entql string predicate on the display_name field.
+func (f *SamlConfigFilter) WhereDisplayName(p entql.StringP) {
+	f.Where(p.Field(samlconfig.FieldDisplayName))
+}
+
+// WhereIdpEntityID applies the entql string predicate on the idp_entity_id field.
+func (f *SamlConfigFilter) WhereIdpEntityID(p entql.StringP) {
+	f.Where(p.Field(samlconfig.FieldIdpEntityID))
+}
+
+// WhereIdpSSOURL applies the entql string predicate on the idp_sso_url field.
+func (f *SamlConfigFilter) WhereIdpSSOURL(p entql.StringP) {
+	f.Where(p.Field(samlconfig.FieldIdpSSOURL))
+}
+
+// WhereIdpCertificate applies the entql string predicate on the idp_certificate field.
+func (f *SamlConfigFilter) WhereIdpCertificate(p entql.StringP) {
+	f.Where(p.Field(samlconfig.FieldIdpCertificate))
+}
+
+// WhereNameIDFormat applies the entql string predicate on the name_id_format field.
+func (f *SamlConfigFilter) WhereNameIDFormat(p entql.StringP) {
+	f.Where(p.Field(samlconfig.FieldNameIDFormat))
+}
+
+// WhereAttributeMapping applies the entql json.RawMessage predicate on the attribute_mapping field.
+func (f *SamlConfigFilter) WhereAttributeMapping(p entql.BytesP) {
+	f.Where(p.Field(samlconfig.FieldAttributeMapping))
+}
+
+// WhereJitProvisioning applies the entql bool predicate on the jit_provisioning field.
+func (f *SamlConfigFilter) WhereJitProvisioning(p entql.BoolP) {
+	f.Where(p.Field(samlconfig.FieldJitProvisioning))
+}
+
+// WhereDefaultRoleIds applies the entql json.RawMessage predicate on the default_role_ids field.
+func (f *SamlConfigFilter) WhereDefaultRoleIds(p entql.BytesP) {
+	f.Where(p.Field(samlconfig.FieldDefaultRoleIds))
+}
+
+// WhereDefaultOrgUnitID applies the entql uint32 predicate on the default_org_unit_id field.
+func (f *SamlConfigFilter) WhereDefaultOrgUnitID(p entql.Uint32P) {
+	f.Where(p.Field(samlconfig.FieldDefaultOrgUnitID))
+}
+
+// WhereSSOOnly applies the entql bool predicate on the sso_only field.
+func (f *SamlConfigFilter) WhereSSOOnly(p entql.BoolP) {
+	f.Where(p.Field(samlconfig.FieldSSOOnly))
+}
+
+// WhereLoginRedirectURL applies the entql string predicate on the login_redirect_url field.
+func (f *SamlConfigFilter) WhereLoginRedirectURL(p entql.StringP) {
+	f.Where(p.Field(samlconfig.FieldLoginRedirectURL))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *ScimTokenQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the ScimTokenQuery builder.
+func (_q *ScimTokenQuery) Filter() *ScimTokenFilter {
+	return &ScimTokenFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *ScimTokenMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the ScimTokenMutation builder.
+func (m *ScimTokenMutation) Filter() *ScimTokenFilter {
+	return &ScimTokenFilter{config: m.config, predicateAdder: m}
+}
+
+// ScimTokenFilter provides a generic filtering capability at runtime for ScimTokenQuery.
+type ScimTokenFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *ScimTokenFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[43].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql uint32 predicate on the id field.
+func (f *ScimTokenFilter) WhereID(p entql.Uint32P) {
+	f.Where(p.Field(scimtoken.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *ScimTokenFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(scimtoken.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *ScimTokenFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(scimtoken.FieldUpdatedAt))
+}
+
+// WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
+func (f *ScimTokenFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(scimtoken.FieldDeletedAt))
+}
+
+// WhereCreatedBy applies the entql uint32 predicate on the created_by field.
+func (f *ScimTokenFilter) WhereCreatedBy(p entql.Uint32P) {
+	f.Where(p.Field(scimtoken.FieldCreatedBy))
+}
+
+// WhereUpdatedBy applies the entql uint32 predicate on the updated_by field.
+func (f *ScimTokenFilter) WhereUpdatedBy(p entql.Uint32P) {
+	f.Where(p.Field(scimtoken.FieldUpdatedBy))
+}
+
+// WhereDeletedBy applies the entql uint32 predicate on the deleted_by field.
+func (f *ScimTokenFilter) WhereDeletedBy(p entql.Uint32P) {
+	f.Where(p.Field(scimtoken.FieldDeletedBy))
+}
+
+// WhereDescription applies the entql string predicate on the description field.
+func (f *ScimTokenFilter) WhereDescription(p entql.StringP) {
+	f.Where(p.Field(scimtoken.FieldDescription))
+}
+
+// WhereTenantID applies the entql uint32 predicate on the tenant_id field.
+func (f *ScimTokenFilter) WhereTenantID(p entql.Uint32P) {
+	f.Where(p.Field(scimtoken.FieldTenantID))
+}
+
+// WhereStatus applies the entql string predicate on the status field.
+func (f *ScimTokenFilter) WhereStatus(p entql.StringP) {
+	f.Where(p.Field(scimtoken.FieldStatus))
+}
+
+// WhereName applies the entql string predicate on the name field.
+func (f *ScimTokenFilter) WhereName(p entql.StringP) {
+	f.Where(p.Field(scimtoken.FieldName))
+}
+
+// WhereTokenPrefix applies the entql string predicate on the token_prefix field.
+func (f *ScimTokenFilter) WhereTokenPrefix(p entql.StringP) {
+	f.Where(p.Field(scimtoken.FieldTokenPrefix))
+}
+
+// WhereTokenHash applies the entql string predicate on the token_hash field.
+func (f *ScimTokenFilter) WhereTokenHash(p entql.StringP) {
+	f.Where(p.Field(scimtoken.FieldTokenHash))
+}
+
+// WhereExpiresAt applies the entql time.Time predicate on the expires_at field.
+func (f *ScimTokenFilter) WhereExpiresAt(p entql.TimeP) {
+	f.Where(p.Field(scimtoken.FieldExpiresAt))
+}
+
+// WhereLastUsedAt applies the entql time.Time predicate on the last_used_at field.
+func (f *ScimTokenFilter) WhereLastUsedAt(p entql.TimeP) {
+	f.Where(p.Field(scimtoken.FieldLastUsedAt))
+}
+
+// WhereLastUsedIP applies the entql string predicate on the last_used_ip field.
+func (f *ScimTokenFilter) WhereLastUsedIP(p entql.StringP) {
+	f.Where(p.Field(scimtoken.FieldLastUsedIP))
+}
+
 // addPredicate implements the predicateAdder interface.
 func (_q *TaskQuery) addPredicate(pred func(s *sql.Selector)) {
 	_q.predicates = append(_q.predicates, pred)
@@ -5609,7 +7138,7 @@ type TaskFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *TaskFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[34].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[44].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -5719,7 +7248,7 @@ type TenantFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *TenantFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[35].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[45].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -5790,6 +7319,11 @@ func (f *TenantFilter) WhereIndustry(p entql.StringP) {
 	f.Where(p.Field(tenant.FieldIndustry))
 }
 
+// WhereTimezone applies the entql string predicate on the timezone field.
+func (f *TenantFilter) WhereTimezone(p entql.StringP) {
+	f.Where(p.Field(tenant.FieldTimezone))
+}
+
 // WhereAdminUserID applies the entql uint32 predicate on the admin_user_id field.
 func (f *TenantFilter) WhereAdminUserID(p entql.Uint32P) {
 	f.Where(p.Field(tenant.FieldAdminUserID))
@@ -5873,7 +7407,7 @@ type UserFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *UserFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[36].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[46].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -6028,7 +7562,7 @@ type UserCredentialFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *UserCredentialFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[37].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[47].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -6139,6 +7673,16 @@ func (f *UserCredentialFilter) WhereResetTokenUsedAt(p entql.TimeP) {
 	f.Where(p.Field(usercredential.FieldResetTokenUsedAt))
 }
 
+// WhereCredentialChangedAt applies the entql time.Time predicate on the credential_changed_at field.
+func (f *UserCredentialFilter) WhereCredentialChangedAt(p entql.TimeP) {
+	f.Where(p.Field(usercredential.FieldCredentialChangedAt))
+}
+
+// WhereCredentialHistory applies the entql json.RawMessage predicate on the credential_history field.
+func (f *UserCredentialFilter) WhereCredentialHistory(p entql.BytesP) {
+	f.Where(p.Field(usercredential.FieldCredentialHistory))
+}
+
 // addPredicate implements the predicateAdder interface.
 func (_q *UserMfaFactorQuery) addPredicate(pred func(s *sql.Selector)) {
 	_q.predicates = append(_q.predicates, pred)
@@ -6168,7 +7712,7 @@ type UserMfaFactorFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *UserMfaFactorFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[38].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[48].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -6229,6 +7773,46 @@ func (f *UserMfaFactorFilter) WhereLastUsedAt(p entql.TimeP) {
 	f.Where(p.Field(usermfafactor.FieldLastUsedAt))
 }
 
+// WhereCredentialID applies the entql string predicate on the credential_id field.
+func (f *UserMfaFactorFilter) WhereCredentialID(p entql.StringP) {
+	f.Where(p.Field(usermfafactor.FieldCredentialID))
+}
+
+// WherePublicKey applies the entql []byte predicate on the public_key field.
+func (f *UserMfaFactorFilter) WherePublicKey(p entql.BytesP) {
+	f.Where(p.Field(usermfafactor.FieldPublicKey))
+}
+
+// WhereSignCount applies the entql uint32 predicate on the sign_count field.
+func (f *UserMfaFactorFilter) WhereSignCount(p entql.Uint32P) {
+	f.Where(p.Field(usermfafactor.FieldSignCount))
+}
+
+// WhereAaguid applies the entql []byte predicate on the aaguid field.
+func (f *UserMfaFactorFilter) WhereAaguid(p entql.BytesP) {
+	f.Where(p.Field(usermfafactor.FieldAaguid))
+}
+
+// WhereTransports applies the entql json.RawMessage predicate on the transports field.
+func (f *UserMfaFactorFilter) WhereTransports(p entql.BytesP) {
+	f.Where(p.Field(usermfafactor.FieldTransports))
+}
+
+// WhereCredentialFlags applies the entql uint8 predicate on the credential_flags field.
+func (f *UserMfaFactorFilter) WhereCredentialFlags(p entql.Uint8P) {
+	f.Where(p.Field(usermfafactor.FieldCredentialFlags))
+}
+
+// WhereAttestationType applies the entql string predicate on the attestation_type field.
+func (f *UserMfaFactorFilter) WhereAttestationType(p entql.StringP) {
+	f.Where(p.Field(usermfafactor.FieldAttestationType))
+}
+
+// WhereAttestationFormat applies the entql string predicate on the attestation_format field.
+func (f *UserMfaFactorFilter) WhereAttestationFormat(p entql.StringP) {
+	f.Where(p.Field(usermfafactor.FieldAttestationFormat))
+}
+
 // addPredicate implements the predicateAdder interface.
 func (_q *UserOrgUnitQuery) addPredicate(pred func(s *sql.Selector)) {
 	_q.predicates = append(_q.predicates, pred)
@@ -6258,7 +7842,7 @@ type UserOrgUnitFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *UserOrgUnitFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[39].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[49].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -6383,7 +7967,7 @@ type UserPositionFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *UserPositionFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[40].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[50].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -6503,7 +8087,7 @@ type UserRoleFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *UserRoleFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[41].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[51].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -32,6 +32,18 @@ func (f ApiAuditLogFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ApiAuditLogMutation", m)
 }
 
+// The ApiClientFunc type is an adapter to allow the use of ordinary
+// function as ApiClient mutator.
+type ApiClientFunc func(context.Context, *ent.ApiClientMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ApiClientFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ApiClientMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ApiClientMutation", m)
+}
+
 // The DataAccessAuditLogFunc type is an adapter to allow the use of ordinary
 // function as DataAccessAuditLog mutator.
 type DataAccessAuditLogFunc func(context.Context, *ent.DataAccessAuditLogMutation) (ent.Value, error)
@@ -128,6 +140,18 @@ func (f InternalMessageRecipientFunc) Mutate(ctx context.Context, m ent.Mutation
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.InternalMessageRecipientMutation", m)
 }
 
+// The JwtSigningKeyFunc type is an adapter to allow the use of ordinary
+// function as JwtSigningKey mutator.
+type JwtSigningKeyFunc func(context.Context, *ent.JwtSigningKeyMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f JwtSigningKeyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.JwtSigningKeyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.JwtSigningKeyMutation", m)
+}
+
 // The LanguageFunc type is an adapter to allow the use of ordinary
 // function as Language mutator.
 type LanguageFunc func(context.Context, *ent.LanguageMutation) (ent.Value, error)
@@ -140,6 +164,18 @@ func (f LanguageFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, er
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LanguageMutation", m)
 }
 
+// The LdapConfigFunc type is an adapter to allow the use of ordinary
+// function as LdapConfig mutator.
+type LdapConfigFunc func(context.Context, *ent.LdapConfigMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LdapConfigFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.LdapConfigMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LdapConfigMutation", m)
+}
+
 // The LoginAuditLogFunc type is an adapter to allow the use of ordinary
 // function as LoginAuditLog mutator.
 type LoginAuditLogFunc func(context.Context, *ent.LoginAuditLogMutation) (ent.Value, error)
@@ -224,6 +260,30 @@ func (f MenuFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error)
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MenuMutation", m)
 }
 
+// The MfaPolicyFunc type is an adapter to allow the use of ordinary
+// function as MfaPolicy mutator.
+type MfaPolicyFunc func(context.Context, *ent.MfaPolicyMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MfaPolicyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.MfaPolicyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MfaPolicyMutation", m)
+}
+
+// The OAuthProviderConfigFunc type is an adapter to allow the use of ordinary
+// function as OAuthProviderConfig mutator.
+type OAuthProviderConfigFunc func(context.Context, *ent.OAuthProviderConfigMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f OAuthProviderConfigFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.OAuthProviderConfigMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.OAuthProviderConfigMutation", m)
+}
+
 // The OperationAuditLogFunc type is an adapter to allow the use of ordinary
 // function as OperationAuditLog mutator.
 type OperationAuditLogFunc func(context.Context, *ent.OperationAuditLogMutation) (ent.Value, error)
@@ -248,6 +308,18 @@ func (f OrgUnitFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, err
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.OrgUnitMutation", m)
 }
 
+// The PasswordPolicyFunc type is an adapter to allow the use of ordinary
+// function as PasswordPolicy mutator.
+type PasswordPolicyFunc func(context.Context, *ent.PasswordPolicyMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PasswordPolicyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PasswordPolicyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PasswordPolicyMutation", m)
+}
+
 // The PermissionFunc type is an adapter to allow the use of ordinary
 // function as Permission mutator.
 type PermissionFunc func(context.Context, *ent.PermissionMutation) (ent.Value, error)
@@ -380,6 +452,30 @@ func (f PositionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, er
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PositionMutation", m)
 }
 
+// The RelationTupleFunc type is an adapter to allow the use of ordinary
+// function as RelationTuple mutator.
+type RelationTupleFunc func(context.Context, *ent.RelationTupleMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RelationTupleFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.RelationTupleMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RelationTupleMutation", m)
+}
+
+// The RelationTupleChangeFunc type is an adapter to allow the use of ordinary
+// function as RelationTupleChange mutator.
+type RelationTupleChangeFunc func(context.Context, *ent.RelationTupleChangeMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RelationTupleChangeFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.RelationTupleChangeMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RelationTupleChangeMutation", m)
+}
+
 // The RoleFunc type is an adapter to allow the use of ordinary
 // function as Role mutator.
 type RoleFunc func(context.Context, *ent.RoleMutation) (ent.Value, error)
@@ -416,6 +512,30 @@ func (f RolePermissionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Val
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RolePermissionMutation", m)
 }
 
+// The SamlConfigFunc type is an adapter to allow the use of ordinary
+// function as SamlConfig mutator.
+type SamlConfigFunc func(context.Context, *ent.SamlConfigMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SamlConfigFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SamlConfigMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SamlConfigMutation", m)
+}
+
+// The ScimTokenFunc type is an adapter to allow the use of ordinary
+// function as ScimToken mutator.
+type ScimTokenFunc func(context.Context, *ent.ScimTokenMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ScimTokenFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ScimTokenMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ScimTokenMutation", m)
+}
+
 // The TaskFunc type is an adapter to allow the use of ordinary
 // function as Task mutator.
 type TaskFunc func(context.Context, *ent.TaskMutation) (ent.Value, error)
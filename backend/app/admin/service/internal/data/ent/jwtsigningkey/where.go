@@ -0,0 +1,835 @@
+// Code generated by ent, DO NOT EDIT.
+
+package jwtsigningkey
+
+import (
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint32) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint32) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint32) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint32) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint32) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint32) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint32) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint32) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint32) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v uint32) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// UpdatedBy applies equality check predicate on the "updated_by" field. It's identical to UpdatedByEQ.
+func UpdatedBy(v uint32) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// DeletedBy applies equality check predicate on the "deleted_by" field. It's identical to DeletedByEQ.
+func DeletedBy(v uint32) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldEQ(FieldDeletedBy, v))
+}
+
+// Kid applies equality check predicate on the "kid" field. It's identical to KidEQ.
+func Kid(v string) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldEQ(FieldKid, v))
+}
+
+// Algorithm applies equality check predicate on the "algorithm" field. It's identical to AlgorithmEQ.
+func Algorithm(v string) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldEQ(FieldAlgorithm, v))
+}
+
+// KeyMaterial applies equality check predicate on the "key_material" field. It's identical to KeyMaterialEQ.
+func KeyMaterial(v string) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldEQ(FieldKeyMaterial, v))
+}
+
+// ActivatedAt applies equality check predicate on the "activated_at" field. It's identical to ActivatedAtEQ.
+func ActivatedAt(v time.Time) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldEQ(FieldActivatedAt, v))
+}
+
+// RetiredAt applies equality check predicate on the "retired_at" field. It's identical to RetiredAtEQ.
+func RetiredAt(v time.Time) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldEQ(FieldRetiredAt, v))
+}
+
+// VerifyUntil applies equality check predicate on the "verify_until" field. It's identical to VerifyUntilEQ.
+func VerifyUntil(v time.Time) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldEQ(FieldVerifyUntil, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// CreatedAtIsNil applies the IsNil predicate on the "created_at" field.
+func CreatedAtIsNil() predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldIsNull(FieldCreatedAt))
+}
+
+// CreatedAtNotNil applies the NotNil predicate on the "created_at" field.
+func CreatedAtNotNil() predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldNotNull(FieldCreatedAt))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIsNil applies the IsNil predicate on the "updated_at" field.
+func UpdatedAtIsNil() predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldIsNull(FieldUpdatedAt))
+}
+
+// UpdatedAtNotNil applies the NotNil predicate on the "updated_at" field.
+func UpdatedAtNotNil() predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldNotNull(FieldUpdatedAt))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v uint32) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v uint32) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...uint32) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...uint32) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v uint32) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v uint32) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v uint32) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v uint32) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByIsNil applies the IsNil predicate on the "created_by" field.
+func CreatedByIsNil() predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldIsNull(FieldCreatedBy))
+}
+
+// CreatedByNotNil applies the NotNil predicate on the "created_by" field.
+func CreatedByNotNil() predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldNotNull(FieldCreatedBy))
+}
+
+// UpdatedByEQ applies the EQ predicate on the "updated_by" field.
+func UpdatedByEQ(v uint32) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByNEQ applies the NEQ predicate on the "updated_by" field.
+func UpdatedByNEQ(v uint32) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldNEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByIn applies the In predicate on the "updated_by" field.
+func UpdatedByIn(vs ...uint32) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByNotIn applies the NotIn predicate on the "updated_by" field.
+func UpdatedByNotIn(vs ...uint32) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldNotIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByGT applies the GT predicate on the "updated_by" field.
+func UpdatedByGT(v uint32) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldGT(FieldUpdatedBy, v))
+}
+
+// UpdatedByGTE applies the GTE predicate on the "updated_by" field.
+func UpdatedByGTE(v uint32) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldGTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByLT applies the LT predicate on the "updated_by" field.
+func UpdatedByLT(v uint32) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldLT(FieldUpdatedBy, v))
+}
+
+// UpdatedByLTE applies the LTE predicate on the "updated_by" field.
+func UpdatedByLTE(v uint32) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldLTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByIsNil applies the IsNil predicate on the "updated_by" field.
+func UpdatedByIsNil() predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldIsNull(FieldUpdatedBy))
+}
+
+// UpdatedByNotNil applies the NotNil predicate on the "updated_by" field.
+func UpdatedByNotNil() predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldNotNull(FieldUpdatedBy))
+}
+
+// DeletedByEQ applies the EQ predicate on the "deleted_by" field.
+func DeletedByEQ(v uint32) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldEQ(FieldDeletedBy, v))
+}
+
+// DeletedByNEQ applies the NEQ predicate on the "deleted_by" field.
+func DeletedByNEQ(v uint32) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldNEQ(FieldDeletedBy, v))
+}
+
+// DeletedByIn applies the In predicate on the "deleted_by" field.
+func DeletedByIn(vs ...uint32) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldIn(FieldDeletedBy, vs...))
+}
+
+// DeletedByNotIn applies the NotIn predicate on the "deleted_by" field.
+func DeletedByNotIn(vs ...uint32) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldNotIn(FieldDeletedBy, vs...))
+}
+
+// DeletedByGT applies the GT predicate on the "deleted_by" field.
+func DeletedByGT(v uint32) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldGT(FieldDeletedBy, v))
+}
+
+// DeletedByGTE applies the GTE predicate on the "deleted_by" field.
+func DeletedByGTE(v uint32) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldGTE(FieldDeletedBy, v))
+}
+
+// DeletedByLT applies the LT predicate on the "deleted_by" field.
+func DeletedByLT(v uint32) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldLT(FieldDeletedBy, v))
+}
+
+// DeletedByLTE applies the LTE predicate on the "deleted_by" field.
+func DeletedByLTE(v uint32) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldLTE(FieldDeletedBy, v))
+}
+
+// DeletedByIsNil applies the IsNil predicate on the "deleted_by" field.
+func DeletedByIsNil() predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldIsNull(FieldDeletedBy))
+}
+
+// DeletedByNotNil applies the NotNil predicate on the "deleted_by" field.
+func DeletedByNotNil() predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldNotNull(FieldDeletedBy))
+}
+
+// KidEQ applies the EQ predicate on the "kid" field.
+func KidEQ(v string) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldEQ(FieldKid, v))
+}
+
+// KidNEQ applies the NEQ predicate on the "kid" field.
+func KidNEQ(v string) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldNEQ(FieldKid, v))
+}
+
+// KidIn applies the In predicate on the "kid" field.
+func KidIn(vs ...string) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldIn(FieldKid, vs...))
+}
+
+// KidNotIn applies the NotIn predicate on the "kid" field.
+func KidNotIn(vs ...string) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldNotIn(FieldKid, vs...))
+}
+
+// KidGT applies the GT predicate on the "kid" field.
+func KidGT(v string) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldGT(FieldKid, v))
+}
+
+// KidGTE applies the GTE predicate on the "kid" field.
+func KidGTE(v string) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldGTE(FieldKid, v))
+}
+
+// KidLT applies the LT predicate on the "kid" field.
+func KidLT(v string) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldLT(FieldKid, v))
+}
+
+// KidLTE applies the LTE predicate on the "kid" field.
+func KidLTE(v string) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldLTE(FieldKid, v))
+}
+
+// KidContains applies the Contains predicate on the "kid" field.
+func KidContains(v string) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldContains(FieldKid, v))
+}
+
+// KidHasPrefix applies the HasPrefix predicate on the "kid" field.
+func KidHasPrefix(v string) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldHasPrefix(FieldKid, v))
+}
+
+// KidHasSuffix applies the HasSuffix predicate on the "kid" field.
+func KidHasSuffix(v string) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldHasSuffix(FieldKid, v))
+}
+
+// KidIsNil applies the IsNil predicate on the "kid" field.
+func KidIsNil() predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldIsNull(FieldKid))
+}
+
+// KidNotNil applies the NotNil predicate on the "kid" field.
+func KidNotNil() predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldNotNull(FieldKid))
+}
+
+// KidEqualFold applies the EqualFold predicate on the "kid" field.
+func KidEqualFold(v string) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldEqualFold(FieldKid, v))
+}
+
+// KidContainsFold applies the ContainsFold predicate on the "kid" field.
+func KidContainsFold(v string) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldContainsFold(FieldKid, v))
+}
+
+// AlgorithmEQ applies the EQ predicate on the "algorithm" field.
+func AlgorithmEQ(v string) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldEQ(FieldAlgorithm, v))
+}
+
+// AlgorithmNEQ applies the NEQ predicate on the "algorithm" field.
+func AlgorithmNEQ(v string) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldNEQ(FieldAlgorithm, v))
+}
+
+// AlgorithmIn applies the In predicate on the "algorithm" field.
+func AlgorithmIn(vs ...string) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldIn(FieldAlgorithm, vs...))
+}
+
+// AlgorithmNotIn applies the NotIn predicate on the "algorithm" field.
+func AlgorithmNotIn(vs ...string) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldNotIn(FieldAlgorithm, vs...))
+}
+
+// AlgorithmGT applies the GT predicate on the "algorithm" field.
+func AlgorithmGT(v string) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldGT(FieldAlgorithm, v))
+}
+
+// AlgorithmGTE applies the GTE predicate on the "algorithm" field.
+func AlgorithmGTE(v string) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldGTE(FieldAlgorithm, v))
+}
+
+// AlgorithmLT applies the LT predicate on the "algorithm" field.
+func AlgorithmLT(v string) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldLT(FieldAlgorithm, v))
+}
+
+// AlgorithmLTE applies the LTE predicate on the "algorithm" field.
+func AlgorithmLTE(v string) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldLTE(FieldAlgorithm, v))
+}
+
+// AlgorithmContains applies the Contains predicate on the "algorithm" field.
+func AlgorithmContains(v string) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldContains(FieldAlgorithm, v))
+}
+
+// AlgorithmHasPrefix applies the HasPrefix predicate on the "algorithm" field.
+func AlgorithmHasPrefix(v string) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldHasPrefix(FieldAlgorithm, v))
+}
+
+// AlgorithmHasSuffix applies the HasSuffix predicate on the "algorithm" field.
+func AlgorithmHasSuffix(v string) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldHasSuffix(FieldAlgorithm, v))
+}
+
+// AlgorithmIsNil applies the IsNil predicate on the "algorithm" field.
+func AlgorithmIsNil() predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldIsNull(FieldAlgorithm))
+}
+
+// AlgorithmNotNil applies the NotNil predicate on the "algorithm" field.
+func AlgorithmNotNil() predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldNotNull(FieldAlgorithm))
+}
+
+// AlgorithmEqualFold applies the EqualFold predicate on the "algorithm" field.
+func AlgorithmEqualFold(v string) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldEqualFold(FieldAlgorithm, v))
+}
+
+// AlgorithmContainsFold applies the ContainsFold predicate on the "algorithm" field.
+func AlgorithmContainsFold(v string) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldContainsFold(FieldAlgorithm, v))
+}
+
+// KeyMaterialEQ applies the EQ predicate on the "key_material" field.
+func KeyMaterialEQ(v string) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldEQ(FieldKeyMaterial, v))
+}
+
+// KeyMaterialNEQ applies the NEQ predicate on the "key_material" field.
+func KeyMaterialNEQ(v string) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldNEQ(FieldKeyMaterial, v))
+}
+
+// KeyMaterialIn applies the In predicate on the "key_material" field.
+func KeyMaterialIn(vs ...string) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldIn(FieldKeyMaterial, vs...))
+}
+
+// KeyMaterialNotIn applies the NotIn predicate on the "key_material" field.
+func KeyMaterialNotIn(vs ...string) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldNotIn(FieldKeyMaterial, vs...))
+}
+
+// KeyMaterialGT applies the GT predicate on the "key_material" field.
+func KeyMaterialGT(v string) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldGT(FieldKeyMaterial, v))
+}
+
+// KeyMaterialGTE applies the GTE predicate on the "key_material" field.
+func KeyMaterialGTE(v string) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldGTE(FieldKeyMaterial, v))
+}
+
+// KeyMaterialLT applies the LT predicate on the "key_material" field.
+func KeyMaterialLT(v string) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldLT(FieldKeyMaterial, v))
+}
+
+// KeyMaterialLTE applies the LTE predicate on the "key_material" field.
+func KeyMaterialLTE(v string) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldLTE(FieldKeyMaterial, v))
+}
+
+// KeyMaterialContains applies the Contains predicate on the "key_material" field.
+func KeyMaterialContains(v string) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldContains(FieldKeyMaterial, v))
+}
+
+// KeyMaterialHasPrefix applies the HasPrefix predicate on the "key_material" field.
+func KeyMaterialHasPrefix(v string) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldHasPrefix(FieldKeyMaterial, v))
+}
+
+// KeyMaterialHasSuffix applies the HasSuffix predicate on the "key_material" field.
+func KeyMaterialHasSuffix(v string) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldHasSuffix(FieldKeyMaterial, v))
+}
+
+// KeyMaterialIsNil applies the IsNil predicate on the "key_material" field.
+func KeyMaterialIsNil() predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldIsNull(FieldKeyMaterial))
+}
+
+// KeyMaterialNotNil applies the NotNil predicate on the "key_material" field.
+func KeyMaterialNotNil() predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldNotNull(FieldKeyMaterial))
+}
+
+// KeyMaterialEqualFold applies the EqualFold predicate on the "key_material" field.
+func KeyMaterialEqualFold(v string) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldEqualFold(FieldKeyMaterial, v))
+}
+
+// KeyMaterialContainsFold applies the ContainsFold predicate on the "key_material" field.
+func KeyMaterialContainsFold(v string) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldContainsFold(FieldKeyMaterial, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusIsNil applies the IsNil predicate on the "status" field.
+func StatusIsNil() predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldIsNull(FieldStatus))
+}
+
+// StatusNotNil applies the NotNil predicate on the "status" field.
+func StatusNotNil() predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldNotNull(FieldStatus))
+}
+
+// ActivatedAtEQ applies the EQ predicate on the "activated_at" field.
+func ActivatedAtEQ(v time.Time) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldEQ(FieldActivatedAt, v))
+}
+
+// ActivatedAtNEQ applies the NEQ predicate on the "activated_at" field.
+func ActivatedAtNEQ(v time.Time) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldNEQ(FieldActivatedAt, v))
+}
+
+// ActivatedAtIn applies the In predicate on the "activated_at" field.
+func ActivatedAtIn(vs ...time.Time) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldIn(FieldActivatedAt, vs...))
+}
+
+// ActivatedAtNotIn applies the NotIn predicate on the "activated_at" field.
+func ActivatedAtNotIn(vs ...time.Time) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldNotIn(FieldActivatedAt, vs...))
+}
+
+// ActivatedAtGT applies the GT predicate on the "activated_at" field.
+func ActivatedAtGT(v time.Time) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldGT(FieldActivatedAt, v))
+}
+
+// ActivatedAtGTE applies the GTE predicate on the "activated_at" field.
+func ActivatedAtGTE(v time.Time) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldGTE(FieldActivatedAt, v))
+}
+
+// ActivatedAtLT applies the LT predicate on the "activated_at" field.
+func ActivatedAtLT(v time.Time) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldLT(FieldActivatedAt, v))
+}
+
+// ActivatedAtLTE applies the LTE predicate on the "activated_at" field.
+func ActivatedAtLTE(v time.Time) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldLTE(FieldActivatedAt, v))
+}
+
+// ActivatedAtIsNil applies the IsNil predicate on the "activated_at" field.
+func ActivatedAtIsNil() predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldIsNull(FieldActivatedAt))
+}
+
+// ActivatedAtNotNil applies the NotNil predicate on the "activated_at" field.
+func ActivatedAtNotNil() predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldNotNull(FieldActivatedAt))
+}
+
+// RetiredAtEQ applies the EQ predicate on the "retired_at" field.
+func RetiredAtEQ(v time.Time) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldEQ(FieldRetiredAt, v))
+}
+
+// RetiredAtNEQ applies the NEQ predicate on the "retired_at" field.
+func RetiredAtNEQ(v time.Time) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldNEQ(FieldRetiredAt, v))
+}
+
+// RetiredAtIn applies the In predicate on the "retired_at" field.
+func RetiredAtIn(vs ...time.Time) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldIn(FieldRetiredAt, vs...))
+}
+
+// RetiredAtNotIn applies the NotIn predicate on the "retired_at" field.
+func RetiredAtNotIn(vs ...time.Time) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldNotIn(FieldRetiredAt, vs...))
+}
+
+// RetiredAtGT applies the GT predicate on the "retired_at" field.
+func RetiredAtGT(v time.Time) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldGT(FieldRetiredAt, v))
+}
+
+// RetiredAtGTE applies the GTE predicate on the "retired_at" field.
+func RetiredAtGTE(v time.Time) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldGTE(FieldRetiredAt, v))
+}
+
+// RetiredAtLT applies the LT predicate on the "retired_at" field.
+func RetiredAtLT(v time.Time) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldLT(FieldRetiredAt, v))
+}
+
+// RetiredAtLTE applies the LTE predicate on the "retired_at" field.
+func RetiredAtLTE(v time.Time) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldLTE(FieldRetiredAt, v))
+}
+
+// RetiredAtIsNil applies the IsNil predicate on the "retired_at" field.
+func RetiredAtIsNil() predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldIsNull(FieldRetiredAt))
+}
+
+// RetiredAtNotNil applies the NotNil predicate on the "retired_at" field.
+func RetiredAtNotNil() predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldNotNull(FieldRetiredAt))
+}
+
+// VerifyUntilEQ applies the EQ predicate on the "verify_until" field.
+func VerifyUntilEQ(v time.Time) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldEQ(FieldVerifyUntil, v))
+}
+
+// VerifyUntilNEQ applies the NEQ predicate on the "verify_until" field.
+func VerifyUntilNEQ(v time.Time) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldNEQ(FieldVerifyUntil, v))
+}
+
+// VerifyUntilIn applies the In predicate on the "verify_until" field.
+func VerifyUntilIn(vs ...time.Time) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldIn(FieldVerifyUntil, vs...))
+}
+
+// VerifyUntilNotIn applies the NotIn predicate on the "verify_until" field.
+func VerifyUntilNotIn(vs ...time.Time) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldNotIn(FieldVerifyUntil, vs...))
+}
+
+// VerifyUntilGT applies the GT predicate on the "verify_until" field.
+func VerifyUntilGT(v time.Time) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldGT(FieldVerifyUntil, v))
+}
+
+// VerifyUntilGTE applies the GTE predicate on the "verify_until" field.
+func VerifyUntilGTE(v time.Time) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldGTE(FieldVerifyUntil, v))
+}
+
+// VerifyUntilLT applies the LT predicate on the "verify_until" field.
+func VerifyUntilLT(v time.Time) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldLT(FieldVerifyUntil, v))
+}
+
+// VerifyUntilLTE applies the LTE predicate on the "verify_until" field.
+func VerifyUntilLTE(v time.Time) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldLTE(FieldVerifyUntil, v))
+}
+
+// VerifyUntilIsNil applies the IsNil predicate on the "verify_until" field.
+func VerifyUntilIsNil() predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldIsNull(FieldVerifyUntil))
+}
+
+// VerifyUntilNotNil applies the NotNil predicate on the "verify_until" field.
+func VerifyUntilNotNil() predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.FieldNotNull(FieldVerifyUntil))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.JwtSigningKey) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.JwtSigningKey) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.JwtSigningKey) predicate.JwtSigningKey {
+	return predicate.JwtSigningKey(sql.NotPredicates(p))
+}
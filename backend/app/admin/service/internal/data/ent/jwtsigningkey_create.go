@@ -0,0 +1,1325 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-wind-admin/app/admin/service/internal/data/ent/jwtsigningkey"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// JwtSigningKeyCreate is the builder for creating a JwtSigningKey entity.
+type JwtSigningKeyCreate struct {
+	config
+	mutation *JwtSigningKeyMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *JwtSigningKeyCreate) SetCreatedAt(v time.Time) *JwtSigningKeyCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *JwtSigningKeyCreate) SetNillableCreatedAt(v *time.Time) *JwtSigningKeyCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *JwtSigningKeyCreate) SetUpdatedAt(v time.Time) *JwtSigningKeyCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *JwtSigningKeyCreate) SetNillableUpdatedAt(v *time.Time) *JwtSigningKeyCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *JwtSigningKeyCreate) SetDeletedAt(v time.Time) *JwtSigningKeyCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *JwtSigningKeyCreate) SetNillableDeletedAt(v *time.Time) *JwtSigningKeyCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *JwtSigningKeyCreate) SetCreatedBy(v uint32) *JwtSigningKeyCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *JwtSigningKeyCreate) SetNillableCreatedBy(v *uint32) *JwtSigningKeyCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_c *JwtSigningKeyCreate) SetUpdatedBy(v uint32) *JwtSigningKeyCreate {
+	_c.mutation.SetUpdatedBy(v)
+	return _c
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_c *JwtSigningKeyCreate) SetNillableUpdatedBy(v *uint32) *JwtSigningKeyCreate {
+	if v != nil {
+		_c.SetUpdatedBy(*v)
+	}
+	return _c
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_c *JwtSigningKeyCreate) SetDeletedBy(v uint32) *JwtSigningKeyCreate {
+	_c.mutation.SetDeletedBy(v)
+	return _c
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_c *JwtSigningKeyCreate) SetNillableDeletedBy(v *uint32) *JwtSigningKeyCreate {
+	if v != nil {
+		_c.SetDeletedBy(*v)
+	}
+	return _c
+}
+
+// SetKid sets the "kid" field.
+func (_c *JwtSigningKeyCreate) SetKid(v string) *JwtSigningKeyCreate {
+	_c.mutation.SetKid(v)
+	return _c
+}
+
+// SetNillableKid sets the "kid" field if the given value is not nil.
+func (_c *JwtSigningKeyCreate) SetNillableKid(v *string) *JwtSigningKeyCreate {
+	if v != nil {
+		_c.SetKid(*v)
+	}
+	return _c
+}
+
+// SetAlgorithm sets the "algorithm" field.
+func (_c *JwtSigningKeyCreate) SetAlgorithm(v string) *JwtSigningKeyCreate {
+	_c.mutation.SetAlgorithm(v)
+	return _c
+}
+
+// SetNillableAlgorithm sets the "algorithm" field if the given value is not nil.
+func (_c *JwtSigningKeyCreate) SetNillableAlgorithm(v *string) *JwtSigningKeyCreate {
+	if v != nil {
+		_c.SetAlgorithm(*v)
+	}
+	return _c
+}
+
+// SetKeyMaterial sets the "key_material" field.
+func (_c *JwtSigningKeyCreate) SetKeyMaterial(v string) *JwtSigningKeyCreate {
+	_c.mutation.SetKeyMaterial(v)
+	return _c
+}
+
+// SetNillableKeyMaterial sets the "key_material" field if the given value is not nil.
+func (_c *JwtSigningKeyCreate) SetNillableKeyMaterial(v *string) *JwtSigningKeyCreate {
+	if v != nil {
+		_c.SetKeyMaterial(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *JwtSigningKeyCreate) SetStatus(v jwtsigningkey.Status) *JwtSigningKeyCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *JwtSigningKeyCreate) SetNillableStatus(v *jwtsigningkey.Status) *JwtSigningKeyCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetActivatedAt sets the "activated_at" field.
+func (_c *JwtSigningKeyCreate) SetActivatedAt(v time.Time) *JwtSigningKeyCreate {
+	_c.mutation.SetActivatedAt(v)
+	return _c
+}
+
+// SetNillableActivatedAt sets the "activated_at" field if the given value is not nil.
+func (_c *JwtSigningKeyCreate) SetNillableActivatedAt(v *time.Time) *JwtSigningKeyCreate {
+	if v != nil {
+		_c.SetActivatedAt(*v)
+	}
+	return _c
+}
+
+// SetRetiredAt sets the "retired_at" field.
+func (_c *JwtSigningKeyCreate) SetRetiredAt(v time.Time) *JwtSigningKeyCreate {
+	_c.mutation.SetRetiredAt(v)
+	return _c
+}
+
+// SetNillableRetiredAt sets the "retired_at" field if the given value is not nil.
+func (_c *JwtSigningKeyCreate) SetNillableRetiredAt(v *time.Time) *JwtSigningKeyCreate {
+	if v != nil {
+		_c.SetRetiredAt(*v)
+	}
+	return _c
+}
+
+// SetVerifyUntil sets the "verify_until" field.
+func (_c *JwtSigningKeyCreate) SetVerifyUntil(v time.Time) *JwtSigningKeyCreate {
+	_c.mutation.SetVerifyUntil(v)
+	return _c
+}
+
+// SetNillableVerifyUntil sets the "verify_until" field if the given value is not nil.
+func (_c *JwtSigningKeyCreate) SetNillableVerifyUntil(v *time.Time) *JwtSigningKeyCreate {
+	if v != nil {
+		_c.SetVerifyUntil(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *JwtSigningKeyCreate) SetID(v uint32) *JwtSigningKeyCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the JwtSigningKeyMutation object of the builder.
+func (_c *JwtSigningKeyCreate) Mutation() *JwtSigningKeyMutation {
+	return _c.mutation
+}
+
+// Save creates the JwtSigningKey in the database.
+func (_c *JwtSigningKeyCreate) Save(ctx context.Context) (*JwtSigningKey, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *JwtSigningKeyCreate) SaveX(ctx context.Context) *JwtSigningKey {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *JwtSigningKeyCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *JwtSigningKeyCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *JwtSigningKeyCreate) defaults() {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := jwtsigningkey.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *JwtSigningKeyCreate) check() error {
+	if v, ok := _c.mutation.Kid(); ok {
+		if err := jwtsigningkey.KidValidator(v); err != nil {
+			return &ValidationError{Name: "kid", err: fmt.Errorf(`ent: validator failed for field "JwtSigningKey.kid": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Algorithm(); ok {
+		if err := jwtsigningkey.AlgorithmValidator(v); err != nil {
+			return &ValidationError{Name: "algorithm", err: fmt.Errorf(`ent: validator failed for field "JwtSigningKey.algorithm": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.KeyMaterial(); ok {
+		if err := jwtsigningkey.KeyMaterialValidator(v); err != nil {
+			return &ValidationError{Name: "key_material", err: fmt.Errorf(`ent: validator failed for field "JwtSigningKey.key_material": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := jwtsigningkey.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "JwtSigningKey.status": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := jwtsigningkey.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "JwtSigningKey.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *JwtSigningKeyCreate) sqlSave(ctx context.Context) (*JwtSigningKey, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint32(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *JwtSigningKeyCreate) createSpec() (*JwtSigningKey, *sqlgraph.CreateSpec) {
+	var (
+		_node = &JwtSigningKey{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(jwtsigningkey.Table, sqlgraph.NewFieldSpec(jwtsigningkey.FieldID, field.TypeUint32))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(jwtsigningkey.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = &value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(jwtsigningkey.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = &value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(jwtsigningkey.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(jwtsigningkey.FieldCreatedBy, field.TypeUint32, value)
+		_node.CreatedBy = &value
+	}
+	if value, ok := _c.mutation.UpdatedBy(); ok {
+		_spec.SetField(jwtsigningkey.FieldUpdatedBy, field.TypeUint32, value)
+		_node.UpdatedBy = &value
+	}
+	if value, ok := _c.mutation.DeletedBy(); ok {
+		_spec.SetField(jwtsigningkey.FieldDeletedBy, field.TypeUint32, value)
+		_node.DeletedBy = &value
+	}
+	if value, ok := _c.mutation.Kid(); ok {
+		_spec.SetField(jwtsigningkey.FieldKid, field.TypeString, value)
+		_node.Kid = &value
+	}
+	if value, ok := _c.mutation.Algorithm(); ok {
+		_spec.SetField(jwtsigningkey.FieldAlgorithm, field.TypeString, value)
+		_node.Algorithm = &value
+	}
+	if value, ok := _c.mutation.KeyMaterial(); ok {
+		_spec.SetField(jwtsigningkey.FieldKeyMaterial, field.TypeString, value)
+		_node.KeyMaterial = &value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(jwtsigningkey.FieldStatus, field.TypeEnum, value)
+		_node.Status = &value
+	}
+	if value, ok := _c.mutation.ActivatedAt(); ok {
+		_spec.SetField(jwtsigningkey.FieldActivatedAt, field.TypeTime, value)
+		_node.ActivatedAt = &value
+	}
+	if value, ok := _c.mutation.RetiredAt(); ok {
+		_spec.SetField(jwtsigningkey.FieldRetiredAt, field.TypeTime, value)
+		_node.RetiredAt = &value
+	}
+	if value, ok := _c.mutation.VerifyUntil(); ok {
+		_spec.SetField(jwtsigningkey.FieldVerifyUntil, field.TypeTime, value)
+		_node.VerifyUntil = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.JwtSigningKey.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.JwtSigningKeyUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *JwtSigningKeyCreate) OnConflict(opts ...sql.ConflictOption) *JwtSigningKeyUpsertOne {
+	_c.conflict = opts
+	return &JwtSigningKeyUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.JwtSigningKey.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *JwtSigningKeyCreate) OnConflictColumns(columns ...string) *JwtSigningKeyUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &JwtSigningKeyUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// JwtSigningKeyUpsertOne is the builder for "upsert"-ing
+	//  one JwtSigningKey node.
+	JwtSigningKeyUpsertOne struct {
+		create *JwtSigningKeyCreate
+	}
+
+	// JwtSigningKeyUpsert is the "OnConflict" setter.
+	JwtSigningKeyUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *JwtSigningKeyUpsert) SetUpdatedAt(v time.Time) *JwtSigningKeyUpsert {
+	u.Set(jwtsigningkey.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *JwtSigningKeyUpsert) UpdateUpdatedAt() *JwtSigningKeyUpsert {
+	u.SetExcluded(jwtsigningkey.FieldUpdatedAt)
+	return u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *JwtSigningKeyUpsert) ClearUpdatedAt() *JwtSigningKeyUpsert {
+	u.SetNull(jwtsigningkey.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *JwtSigningKeyUpsert) SetDeletedAt(v time.Time) *JwtSigningKeyUpsert {
+	u.Set(jwtsigningkey.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *JwtSigningKeyUpsert) UpdateDeletedAt() *JwtSigningKeyUpsert {
+	u.SetExcluded(jwtsigningkey.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *JwtSigningKeyUpsert) ClearDeletedAt() *JwtSigningKeyUpsert {
+	u.SetNull(jwtsigningkey.FieldDeletedAt)
+	return u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *JwtSigningKeyUpsert) SetCreatedBy(v uint32) *JwtSigningKeyUpsert {
+	u.Set(jwtsigningkey.FieldCreatedBy, v)
+	return u
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *JwtSigningKeyUpsert) UpdateCreatedBy() *JwtSigningKeyUpsert {
+	u.SetExcluded(jwtsigningkey.FieldCreatedBy)
+	return u
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *JwtSigningKeyUpsert) AddCreatedBy(v uint32) *JwtSigningKeyUpsert {
+	u.Add(jwtsigningkey.FieldCreatedBy, v)
+	return u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *JwtSigningKeyUpsert) ClearCreatedBy() *JwtSigningKeyUpsert {
+	u.SetNull(jwtsigningkey.FieldCreatedBy)
+	return u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *JwtSigningKeyUpsert) SetUpdatedBy(v uint32) *JwtSigningKeyUpsert {
+	u.Set(jwtsigningkey.FieldUpdatedBy, v)
+	return u
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *JwtSigningKeyUpsert) UpdateUpdatedBy() *JwtSigningKeyUpsert {
+	u.SetExcluded(jwtsigningkey.FieldUpdatedBy)
+	return u
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *JwtSigningKeyUpsert) AddUpdatedBy(v uint32) *JwtSigningKeyUpsert {
+	u.Add(jwtsigningkey.FieldUpdatedBy, v)
+	return u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *JwtSigningKeyUpsert) ClearUpdatedBy() *JwtSigningKeyUpsert {
+	u.SetNull(jwtsigningkey.FieldUpdatedBy)
+	return u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *JwtSigningKeyUpsert) SetDeletedBy(v uint32) *JwtSigningKeyUpsert {
+	u.Set(jwtsigningkey.FieldDeletedBy, v)
+	return u
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *JwtSigningKeyUpsert) UpdateDeletedBy() *JwtSigningKeyUpsert {
+	u.SetExcluded(jwtsigningkey.FieldDeletedBy)
+	return u
+}
+
+// AddDeletedBy adds v to the "deleted_by" field.
+func (u *JwtSigningKeyUpsert) AddDeletedBy(v uint32) *JwtSigningKeyUpsert {
+	u.Add(jwtsigningkey.FieldDeletedBy, v)
+	return u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *JwtSigningKeyUpsert) ClearDeletedBy() *JwtSigningKeyUpsert {
+	u.SetNull(jwtsigningkey.FieldDeletedBy)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *JwtSigningKeyUpsert) SetStatus(v jwtsigningkey.Status) *JwtSigningKeyUpsert {
+	u.Set(jwtsigningkey.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *JwtSigningKeyUpsert) UpdateStatus() *JwtSigningKeyUpsert {
+	u.SetExcluded(jwtsigningkey.FieldStatus)
+	return u
+}
+
+// ClearStatus clears the value of the "status" field.
+func (u *JwtSigningKeyUpsert) ClearStatus() *JwtSigningKeyUpsert {
+	u.SetNull(jwtsigningkey.FieldStatus)
+	return u
+}
+
+// SetActivatedAt sets the "activated_at" field.
+func (u *JwtSigningKeyUpsert) SetActivatedAt(v time.Time) *JwtSigningKeyUpsert {
+	u.Set(jwtsigningkey.FieldActivatedAt, v)
+	return u
+}
+
+// UpdateActivatedAt sets the "activated_at" field to the value that was provided on create.
+func (u *JwtSigningKeyUpsert) UpdateActivatedAt() *JwtSigningKeyUpsert {
+	u.SetExcluded(jwtsigningkey.FieldActivatedAt)
+	return u
+}
+
+// ClearActivatedAt clears the value of the "activated_at" field.
+func (u *JwtSigningKeyUpsert) ClearActivatedAt() *JwtSigningKeyUpsert {
+	u.SetNull(jwtsigningkey.FieldActivatedAt)
+	return u
+}
+
+// SetRetiredAt sets the "retired_at" field.
+func (u *JwtSigningKeyUpsert) SetRetiredAt(v time.Time) *JwtSigningKeyUpsert {
+	u.Set(jwtsigningkey.FieldRetiredAt, v)
+	return u
+}
+
+// UpdateRetiredAt sets the "retired_at" field to the value that was provided on create.
+func (u *JwtSigningKeyUpsert) UpdateRetiredAt() *JwtSigningKeyUpsert {
+	u.SetExcluded(jwtsigningkey.FieldRetiredAt)
+	return u
+}
+
+// ClearRetiredAt clears the value of the "retired_at" field.
+func (u *JwtSigningKeyUpsert) ClearRetiredAt() *JwtSigningKeyUpsert {
+	u.SetNull(jwtsigningkey.FieldRetiredAt)
+	return u
+}
+
+// SetVerifyUntil sets the "verify_until" field.
+func (u *JwtSigningKeyUpsert) SetVerifyUntil(v time.Time) *JwtSigningKeyUpsert {
+	u.Set(jwtsigningkey.FieldVerifyUntil, v)
+	return u
+}
+
+// UpdateVerifyUntil sets the "verify_until" field to the value that was provided on create.
+func (u *JwtSigningKeyUpsert) UpdateVerifyUntil() *JwtSigningKeyUpsert {
+	u.SetExcluded(jwtsigningkey.FieldVerifyUntil)
+	return u
+}
+
+// ClearVerifyUntil clears the value of the "verify_until" field.
+func (u *JwtSigningKeyUpsert) ClearVerifyUntil() *JwtSigningKeyUpsert {
+	u.SetNull(jwtsigningkey.FieldVerifyUntil)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.JwtSigningKey.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(jwtsigningkey.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *JwtSigningKeyUpsertOne) UpdateNewValues() *JwtSigningKeyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(jwtsigningkey.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(jwtsigningkey.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.Kid(); exists {
+			s.SetIgnore(jwtsigningkey.FieldKid)
+		}
+		if _, exists := u.create.mutation.Algorithm(); exists {
+			s.SetIgnore(jwtsigningkey.FieldAlgorithm)
+		}
+		if _, exists := u.create.mutation.KeyMaterial(); exists {
+			s.SetIgnore(jwtsigningkey.FieldKeyMaterial)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.JwtSigningKey.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *JwtSigningKeyUpsertOne) Ignore() *JwtSigningKeyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *JwtSigningKeyUpsertOne) DoNothing() *JwtSigningKeyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the JwtSigningKeyCreate.OnConflict
+// documentation for more info.
+func (u *JwtSigningKeyUpsertOne) Update(set func(*JwtSigningKeyUpsert)) *JwtSigningKeyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&JwtSigningKeyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *JwtSigningKeyUpsertOne) SetUpdatedAt(v time.Time) *JwtSigningKeyUpsertOne {
+	return u.Update(func(s *JwtSigningKeyUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *JwtSigningKeyUpsertOne) UpdateUpdatedAt() *JwtSigningKeyUpsertOne {
+	return u.Update(func(s *JwtSigningKeyUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *JwtSigningKeyUpsertOne) ClearUpdatedAt() *JwtSigningKeyUpsertOne {
+	return u.Update(func(s *JwtSigningKeyUpsert) {
+		s.ClearUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *JwtSigningKeyUpsertOne) SetDeletedAt(v time.Time) *JwtSigningKeyUpsertOne {
+	return u.Update(func(s *JwtSigningKeyUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *JwtSigningKeyUpsertOne) UpdateDeletedAt() *JwtSigningKeyUpsertOne {
+	return u.Update(func(s *JwtSigningKeyUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *JwtSigningKeyUpsertOne) ClearDeletedAt() *JwtSigningKeyUpsertOne {
+	return u.Update(func(s *JwtSigningKeyUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *JwtSigningKeyUpsertOne) SetCreatedBy(v uint32) *JwtSigningKeyUpsertOne {
+	return u.Update(func(s *JwtSigningKeyUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *JwtSigningKeyUpsertOne) AddCreatedBy(v uint32) *JwtSigningKeyUpsertOne {
+	return u.Update(func(s *JwtSigningKeyUpsert) {
+		s.AddCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *JwtSigningKeyUpsertOne) UpdateCreatedBy() *JwtSigningKeyUpsertOne {
+	return u.Update(func(s *JwtSigningKeyUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *JwtSigningKeyUpsertOne) ClearCreatedBy() *JwtSigningKeyUpsertOne {
+	return u.Update(func(s *JwtSigningKeyUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *JwtSigningKeyUpsertOne) SetUpdatedBy(v uint32) *JwtSigningKeyUpsertOne {
+	return u.Update(func(s *JwtSigningKeyUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *JwtSigningKeyUpsertOne) AddUpdatedBy(v uint32) *JwtSigningKeyUpsertOne {
+	return u.Update(func(s *JwtSigningKeyUpsert) {
+		s.AddUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *JwtSigningKeyUpsertOne) UpdateUpdatedBy() *JwtSigningKeyUpsertOne {
+	return u.Update(func(s *JwtSigningKeyUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *JwtSigningKeyUpsertOne) ClearUpdatedBy() *JwtSigningKeyUpsertOne {
+	return u.Update(func(s *JwtSigningKeyUpsert) {
+		s.ClearUpdatedBy()
+	})
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *JwtSigningKeyUpsertOne) SetDeletedBy(v uint32) *JwtSigningKeyUpsertOne {
+	return u.Update(func(s *JwtSigningKeyUpsert) {
+		s.SetDeletedBy(v)
+	})
+}
+
+// AddDeletedBy adds v to the "deleted_by" field.
+func (u *JwtSigningKeyUpsertOne) AddDeletedBy(v uint32) *JwtSigningKeyUpsertOne {
+	return u.Update(func(s *JwtSigningKeyUpsert) {
+		s.AddDeletedBy(v)
+	})
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *JwtSigningKeyUpsertOne) UpdateDeletedBy() *JwtSigningKeyUpsertOne {
+	return u.Update(func(s *JwtSigningKeyUpsert) {
+		s.UpdateDeletedBy()
+	})
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *JwtSigningKeyUpsertOne) ClearDeletedBy() *JwtSigningKeyUpsertOne {
+	return u.Update(func(s *JwtSigningKeyUpsert) {
+		s.ClearDeletedBy()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *JwtSigningKeyUpsertOne) SetStatus(v jwtsigningkey.Status) *JwtSigningKeyUpsertOne {
+	return u.Update(func(s *JwtSigningKeyUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *JwtSigningKeyUpsertOne) UpdateStatus() *JwtSigningKeyUpsertOne {
+	return u.Update(func(s *JwtSigningKeyUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// ClearStatus clears the value of the "status" field.
+func (u *JwtSigningKeyUpsertOne) ClearStatus() *JwtSigningKeyUpsertOne {
+	return u.Update(func(s *JwtSigningKeyUpsert) {
+		s.ClearStatus()
+	})
+}
+
+// SetActivatedAt sets the "activated_at" field.
+func (u *JwtSigningKeyUpsertOne) SetActivatedAt(v time.Time) *JwtSigningKeyUpsertOne {
+	return u.Update(func(s *JwtSigningKeyUpsert) {
+		s.SetActivatedAt(v)
+	})
+}
+
+// UpdateActivatedAt sets the "activated_at" field to the value that was provided on create.
+func (u *JwtSigningKeyUpsertOne) UpdateActivatedAt() *JwtSigningKeyUpsertOne {
+	return u.Update(func(s *JwtSigningKeyUpsert) {
+		s.UpdateActivatedAt()
+	})
+}
+
+// ClearActivatedAt clears the value of the "activated_at" field.
+func (u *JwtSigningKeyUpsertOne) ClearActivatedAt() *JwtSigningKeyUpsertOne {
+	return u.Update(func(s *JwtSigningKeyUpsert) {
+		s.ClearActivatedAt()
+	})
+}
+
+// SetRetiredAt sets the "retired_at" field.
+func (u *JwtSigningKeyUpsertOne) SetRetiredAt(v time.Time) *JwtSigningKeyUpsertOne {
+	return u.Update(func(s *JwtSigningKeyUpsert) {
+		s.SetRetiredAt(v)
+	})
+}
+
+// UpdateRetiredAt sets the "retired_at" field to the value that was provided on create.
+func (u *JwtSigningKeyUpsertOne) UpdateRetiredAt() *JwtSigningKeyUpsertOne {
+	return u.Update(func(s *JwtSigningKeyUpsert) {
+		s.UpdateRetiredAt()
+	})
+}
+
+// ClearRetiredAt clears the value of the "retired_at" field.
+func (u *JwtSigningKeyUpsertOne) ClearRetiredAt() *JwtSigningKeyUpsertOne {
+	return u.Update(func(s *JwtSigningKeyUpsert) {
+		s.ClearRetiredAt()
+	})
+}
+
+// SetVerifyUntil sets the "verify_until" field.
+func (u *JwtSigningKeyUpsertOne) SetVerifyUntil(v time.Time) *JwtSigningKeyUpsertOne {
+	return u.Update(func(s *JwtSigningKeyUpsert) {
+		s.SetVerifyUntil(v)
+	})
+}
+
+// UpdateVerifyUntil sets the "verify_until" field to the value that was provided on create.
+func (u *JwtSigningKeyUpsertOne) UpdateVerifyUntil() *JwtSigningKeyUpsertOne {
+	return u.Update(func(s *JwtSigningKeyUpsert) {
+		s.UpdateVerifyUntil()
+	})
+}
+
+// ClearVerifyUntil clears the value of the "verify_until" field.
+func (u *JwtSigningKeyUpsertOne) ClearVerifyUntil() *JwtSigningKeyUpsertOne {
+	return u.Update(func(s *JwtSigningKeyUpsert) {
+		s.ClearVerifyUntil()
+	})
+}
+
+// Exec executes the query.
+func (u *JwtSigningKeyUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for JwtSigningKeyCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *JwtSigningKeyUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *JwtSigningKeyUpsertOne) ID(ctx context.Context) (id uint32, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *JwtSigningKeyUpsertOne) IDX(ctx context.Context) uint32 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// JwtSigningKeyCreateBulk is the builder for creating many JwtSigningKey entities in bulk.
+type JwtSigningKeyCreateBulk struct {
+	config
+	err      error
+	builders []*JwtSigningKeyCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the JwtSigningKey entities in the database.
+func (_c *JwtSigningKeyCreateBulk) Save(ctx context.Context) ([]*JwtSigningKey, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*JwtSigningKey, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*JwtSigningKeyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint32(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *JwtSigningKeyCreateBulk) SaveX(ctx context.Context) []*JwtSigningKey {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *JwtSigningKeyCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *JwtSigningKeyCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.JwtSigningKey.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.JwtSigningKeyUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *JwtSigningKeyCreateBulk) OnConflict(opts ...sql.ConflictOption) *JwtSigningKeyUpsertBulk {
+	_c.conflict = opts
+	return &JwtSigningKeyUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.JwtSigningKey.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *JwtSigningKeyCreateBulk) OnConflictColumns(columns ...string) *JwtSigningKeyUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &JwtSigningKeyUpsertBulk{
+		create: _c,
+	}
+}
+
+// JwtSigningKeyUpsertBulk is the builder for "upsert"-ing
+// a bulk of JwtSigningKey nodes.
+type JwtSigningKeyUpsertBulk struct {
+	create *JwtSigningKeyCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.JwtSigningKey.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(jwtsigningkey.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *JwtSigningKeyUpsertBulk) UpdateNewValues() *JwtSigningKeyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(jwtsigningkey.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(jwtsigningkey.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.Kid(); exists {
+				s.SetIgnore(jwtsigningkey.FieldKid)
+			}
+			if _, exists := b.mutation.Algorithm(); exists {
+				s.SetIgnore(jwtsigningkey.FieldAlgorithm)
+			}
+			if _, exists := b.mutation.KeyMaterial(); exists {
+				s.SetIgnore(jwtsigningkey.FieldKeyMaterial)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.JwtSigningKey.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *JwtSigningKeyUpsertBulk) Ignore() *JwtSigningKeyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *JwtSigningKeyUpsertBulk) DoNothing() *JwtSigningKeyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the JwtSigningKeyCreateBulk.OnConflict
+// documentation for more info.
+func (u *JwtSigningKeyUpsertBulk) Update(set func(*JwtSigningKeyUpsert)) *JwtSigningKeyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&JwtSigningKeyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *JwtSigningKeyUpsertBulk) SetUpdatedAt(v time.Time) *JwtSigningKeyUpsertBulk {
+	return u.Update(func(s *JwtSigningKeyUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *JwtSigningKeyUpsertBulk) UpdateUpdatedAt() *JwtSigningKeyUpsertBulk {
+	return u.Update(func(s *JwtSigningKeyUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *JwtSigningKeyUpsertBulk) ClearUpdatedAt() *JwtSigningKeyUpsertBulk {
+	return u.Update(func(s *JwtSigningKeyUpsert) {
+		s.ClearUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *JwtSigningKeyUpsertBulk) SetDeletedAt(v time.Time) *JwtSigningKeyUpsertBulk {
+	return u.Update(func(s *JwtSigningKeyUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *JwtSigningKeyUpsertBulk) UpdateDeletedAt() *JwtSigningKeyUpsertBulk {
+	return u.Update(func(s *JwtSigningKeyUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *JwtSigningKeyUpsertBulk) ClearDeletedAt() *JwtSigningKeyUpsertBulk {
+	return u.Update(func(s *JwtSigningKeyUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *JwtSigningKeyUpsertBulk) SetCreatedBy(v uint32) *JwtSigningKeyUpsertBulk {
+	return u.Update(func(s *JwtSigningKeyUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *JwtSigningKeyUpsertBulk) AddCreatedBy(v uint32) *JwtSigningKeyUpsertBulk {
+	return u.Update(func(s *JwtSigningKeyUpsert) {
+		s.AddCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *JwtSigningKeyUpsertBulk) UpdateCreatedBy() *JwtSigningKeyUpsertBulk {
+	return u.Update(func(s *JwtSigningKeyUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *JwtSigningKeyUpsertBulk) ClearCreatedBy() *JwtSigningKeyUpsertBulk {
+	return u.Update(func(s *JwtSigningKeyUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *JwtSigningKeyUpsertBulk) SetUpdatedBy(v uint32) *JwtSigningKeyUpsertBulk {
+	return u.Update(func(s *JwtSigningKeyUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *JwtSigningKeyUpsertBulk) AddUpdatedBy(v uint32) *JwtSigningKeyUpsertBulk {
+	return u.Update(func(s *JwtSigningKeyUpsert) {
+		s.AddUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *JwtSigningKeyUpsertBulk) UpdateUpdatedBy() *JwtSigningKeyUpsertBulk {
+	return u.Update(func(s *JwtSigningKeyUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *JwtSigningKeyUpsertBulk) ClearUpdatedBy() *JwtSigningKeyUpsertBulk {
+	return u.Update(func(s *JwtSigningKeyUpsert) {
+		s.ClearUpdatedBy()
+	})
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *JwtSigningKeyUpsertBulk) SetDeletedBy(v uint32) *JwtSigningKeyUpsertBulk {
+	return u.Update(func(s *JwtSigningKeyUpsert) {
+		s.SetDeletedBy(v)
+	})
+}
+
+// AddDeletedBy adds v to the "deleted_by" field.
+func (u *JwtSigningKeyUpsertBulk) AddDeletedBy(v uint32) *JwtSigningKeyUpsertBulk {
+	return u.Update(func(s *JwtSigningKeyUpsert) {
+		s.AddDeletedBy(v)
+	})
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *JwtSigningKeyUpsertBulk) UpdateDeletedBy() *JwtSigningKeyUpsertBulk {
+	return u.Update(func(s *JwtSigningKeyUpsert) {
+		s.UpdateDeletedBy()
+	})
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *JwtSigningKeyUpsertBulk) ClearDeletedBy() *JwtSigningKeyUpsertBulk {
+	return u.Update(func(s *JwtSigningKeyUpsert) {
+		s.ClearDeletedBy()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *JwtSigningKeyUpsertBulk) SetStatus(v jwtsigningkey.Status) *JwtSigningKeyUpsertBulk {
+	return u.Update(func(s *JwtSigningKeyUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *JwtSigningKeyUpsertBulk) UpdateStatus() *JwtSigningKeyUpsertBulk {
+	return u.Update(func(s *JwtSigningKeyUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// ClearStatus clears the value of the "status" field.
+func (u *JwtSigningKeyUpsertBulk) ClearStatus() *JwtSigningKeyUpsertBulk {
+	return u.Update(func(s *JwtSigningKeyUpsert) {
+		s.ClearStatus()
+	})
+}
+
+// SetActivatedAt sets the "activated_at" field.
+func (u *JwtSigningKeyUpsertBulk) SetActivatedAt(v time.Time) *JwtSigningKeyUpsertBulk {
+	return u.Update(func(s *JwtSigningKeyUpsert) {
+		s.SetActivatedAt(v)
+	})
+}
+
+// UpdateActivatedAt sets the "activated_at" field to the value that was provided on create.
+func (u *JwtSigningKeyUpsertBulk) UpdateActivatedAt() *JwtSigningKeyUpsertBulk {
+	return u.Update(func(s *JwtSigningKeyUpsert) {
+		s.UpdateActivatedAt()
+	})
+}
+
+// ClearActivatedAt clears the value of the "activated_at" field.
+func (u *JwtSigningKeyUpsertBulk) ClearActivatedAt() *JwtSigningKeyUpsertBulk {
+	return u.Update(func(s *JwtSigningKeyUpsert) {
+		s.ClearActivatedAt()
+	})
+}
+
+// SetRetiredAt sets the "retired_at" field.
+func (u *JwtSigningKeyUpsertBulk) SetRetiredAt(v time.Time) *JwtSigningKeyUpsertBulk {
+	return u.Update(func(s *JwtSigningKeyUpsert) {
+		s.SetRetiredAt(v)
+	})
+}
+
+// UpdateRetiredAt sets the "retired_at" field to the value that was provided on create.
+func (u *JwtSigningKeyUpsertBulk) UpdateRetiredAt() *JwtSigningKeyUpsertBulk {
+	return u.Update(func(s *JwtSigningKeyUpsert) {
+		s.UpdateRetiredAt()
+	})
+}
+
+// ClearRetiredAt clears the value of the "retired_at" field.
+func (u *JwtSigningKeyUpsertBulk) ClearRetiredAt() *JwtSigningKeyUpsertBulk {
+	return u.Update(func(s *JwtSigningKeyUpsert) {
+		s.ClearRetiredAt()
+	})
+}
+
+// SetVerifyUntil sets the "verify_until" field.
+func (u *JwtSigningKeyUpsertBulk) SetVerifyUntil(v time.Time) *JwtSigningKeyUpsertBulk {
+	return u.Update(func(s *JwtSigningKeyUpsert) {
+		s.SetVerifyUntil(v)
+	})
+}
+
+// UpdateVerifyUntil sets the "verify_until" field to the value that was provided on create.
+func (u *JwtSigningKeyUpsertBulk) UpdateVerifyUntil() *JwtSigningKeyUpsertBulk {
+	return u.Update(func(s *JwtSigningKeyUpsert) {
+		s.UpdateVerifyUntil()
+	})
+}
+
+// ClearVerifyUntil clears the value of the "verify_until" field.
+func (u *JwtSigningKeyUpsertBulk) ClearVerifyUntil() *JwtSigningKeyUpsertBulk {
+	return u.Update(func(s *JwtSigningKeyUpsert) {
+		s.ClearVerifyUntil()
+	})
+}
+
+// Exec executes the query.
+func (u *JwtSigningKeyUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the JwtSigningKeyCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for JwtSigningKeyCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *JwtSigningKeyUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
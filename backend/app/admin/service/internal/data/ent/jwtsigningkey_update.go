@@ -0,0 +1,770 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-wind-admin/app/admin/service/internal/data/ent/jwtsigningkey"
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// JwtSigningKeyUpdate is the builder for updating JwtSigningKey entities.
+type JwtSigningKeyUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *JwtSigningKeyMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the JwtSigningKeyUpdate builder.
+func (_u *JwtSigningKeyUpdate) Where(ps ...predicate.JwtSigningKey) *JwtSigningKeyUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *JwtSigningKeyUpdate) SetUpdatedAt(v time.Time) *JwtSigningKeyUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *JwtSigningKeyUpdate) SetNillableUpdatedAt(v *time.Time) *JwtSigningKeyUpdate {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *JwtSigningKeyUpdate) ClearUpdatedAt() *JwtSigningKeyUpdate {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *JwtSigningKeyUpdate) SetDeletedAt(v time.Time) *JwtSigningKeyUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *JwtSigningKeyUpdate) SetNillableDeletedAt(v *time.Time) *JwtSigningKeyUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *JwtSigningKeyUpdate) ClearDeletedAt() *JwtSigningKeyUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *JwtSigningKeyUpdate) SetCreatedBy(v uint32) *JwtSigningKeyUpdate {
+	_u.mutation.ResetCreatedBy()
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *JwtSigningKeyUpdate) SetNillableCreatedBy(v *uint32) *JwtSigningKeyUpdate {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// AddCreatedBy adds value to the "created_by" field.
+func (_u *JwtSigningKeyUpdate) AddCreatedBy(v int32) *JwtSigningKeyUpdate {
+	_u.mutation.AddCreatedBy(v)
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *JwtSigningKeyUpdate) ClearCreatedBy() *JwtSigningKeyUpdate {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_u *JwtSigningKeyUpdate) SetUpdatedBy(v uint32) *JwtSigningKeyUpdate {
+	_u.mutation.ResetUpdatedBy()
+	_u.mutation.SetUpdatedBy(v)
+	return _u
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_u *JwtSigningKeyUpdate) SetNillableUpdatedBy(v *uint32) *JwtSigningKeyUpdate {
+	if v != nil {
+		_u.SetUpdatedBy(*v)
+	}
+	return _u
+}
+
+// AddUpdatedBy adds value to the "updated_by" field.
+func (_u *JwtSigningKeyUpdate) AddUpdatedBy(v int32) *JwtSigningKeyUpdate {
+	_u.mutation.AddUpdatedBy(v)
+	return _u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (_u *JwtSigningKeyUpdate) ClearUpdatedBy() *JwtSigningKeyUpdate {
+	_u.mutation.ClearUpdatedBy()
+	return _u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_u *JwtSigningKeyUpdate) SetDeletedBy(v uint32) *JwtSigningKeyUpdate {
+	_u.mutation.ResetDeletedBy()
+	_u.mutation.SetDeletedBy(v)
+	return _u
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_u *JwtSigningKeyUpdate) SetNillableDeletedBy(v *uint32) *JwtSigningKeyUpdate {
+	if v != nil {
+		_u.SetDeletedBy(*v)
+	}
+	return _u
+}
+
+// AddDeletedBy adds value to the "deleted_by" field.
+func (_u *JwtSigningKeyUpdate) AddDeletedBy(v int32) *JwtSigningKeyUpdate {
+	_u.mutation.AddDeletedBy(v)
+	return _u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (_u *JwtSigningKeyUpdate) ClearDeletedBy() *JwtSigningKeyUpdate {
+	_u.mutation.ClearDeletedBy()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *JwtSigningKeyUpdate) SetStatus(v jwtsigningkey.Status) *JwtSigningKeyUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *JwtSigningKeyUpdate) SetNillableStatus(v *jwtsigningkey.Status) *JwtSigningKeyUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// ClearStatus clears the value of the "status" field.
+func (_u *JwtSigningKeyUpdate) ClearStatus() *JwtSigningKeyUpdate {
+	_u.mutation.ClearStatus()
+	return _u
+}
+
+// SetActivatedAt sets the "activated_at" field.
+func (_u *JwtSigningKeyUpdate) SetActivatedAt(v time.Time) *JwtSigningKeyUpdate {
+	_u.mutation.SetActivatedAt(v)
+	return _u
+}
+
+// SetNillableActivatedAt sets the "activated_at" field if the given value is not nil.
+func (_u *JwtSigningKeyUpdate) SetNillableActivatedAt(v *time.Time) *JwtSigningKeyUpdate {
+	if v != nil {
+		_u.SetActivatedAt(*v)
+	}
+	return _u
+}
+
+// ClearActivatedAt clears the value of the "activated_at" field.
+func (_u *JwtSigningKeyUpdate) ClearActivatedAt() *JwtSigningKeyUpdate {
+	_u.mutation.ClearActivatedAt()
+	return _u
+}
+
+// SetRetiredAt sets the "retired_at" field.
+func (_u *JwtSigningKeyUpdate) SetRetiredAt(v time.Time) *JwtSigningKeyUpdate {
+	_u.mutation.SetRetiredAt(v)
+	return _u
+}
+
+// SetNillableRetiredAt sets the "retired_at" field if the given value is not nil.
+func (_u *JwtSigningKeyUpdate) SetNillableRetiredAt(v *time.Time) *JwtSigningKeyUpdate {
+	if v != nil {
+		_u.SetRetiredAt(*v)
+	}
+	return _u
+}
+
+// ClearRetiredAt clears the value of the "retired_at" field.
+func (_u *JwtSigningKeyUpdate) ClearRetiredAt() *JwtSigningKeyUpdate {
+	_u.mutation.ClearRetiredAt()
+	return _u
+}
+
+// SetVerifyUntil sets the "verify_until" field.
+func (_u *JwtSigningKeyUpdate) SetVerifyUntil(v time.Time) *JwtSigningKeyUpdate {
+	_u.mutation.SetVerifyUntil(v)
+	return _u
+}
+
+// SetNillableVerifyUntil sets the "verify_until" field if the given value is not nil.
+func (_u *JwtSigningKeyUpdate) SetNillableVerifyUntil(v *time.Time) *JwtSigningKeyUpdate {
+	if v != nil {
+		_u.SetVerifyUntil(*v)
+	}
+	return _u
+}
+
+// ClearVerifyUntil clears the value of the "verify_until" field.
+func (_u *JwtSigningKeyUpdate) ClearVerifyUntil() *JwtSigningKeyUpdate {
+	_u.mutation.ClearVerifyUntil()
+	return _u
+}
+
+// Mutation returns the JwtSigningKeyMutation object of the builder.
+func (_u *JwtSigningKeyUpdate) Mutation() *JwtSigningKeyMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *JwtSigningKeyUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *JwtSigningKeyUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *JwtSigningKeyUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *JwtSigningKeyUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *JwtSigningKeyUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := jwtsigningkey.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "JwtSigningKey.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *JwtSigningKeyUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *JwtSigningKeyUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *JwtSigningKeyUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(jwtsigningkey.Table, jwtsigningkey.Columns, sqlgraph.NewFieldSpec(jwtsigningkey.FieldID, field.TypeUint32))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(jwtsigningkey.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(jwtsigningkey.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(jwtsigningkey.FieldUpdatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(jwtsigningkey.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(jwtsigningkey.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(jwtsigningkey.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(jwtsigningkey.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(jwtsigningkey.FieldCreatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UpdatedBy(); ok {
+		_spec.SetField(jwtsigningkey.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedBy(); ok {
+		_spec.AddField(jwtsigningkey.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdatedByCleared() {
+		_spec.ClearField(jwtsigningkey.FieldUpdatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.DeletedBy(); ok {
+		_spec.SetField(jwtsigningkey.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedBy(); ok {
+		_spec.AddField(jwtsigningkey.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.DeletedByCleared() {
+		_spec.ClearField(jwtsigningkey.FieldDeletedBy, field.TypeUint32)
+	}
+	if _u.mutation.KidCleared() {
+		_spec.ClearField(jwtsigningkey.FieldKid, field.TypeString)
+	}
+	if _u.mutation.AlgorithmCleared() {
+		_spec.ClearField(jwtsigningkey.FieldAlgorithm, field.TypeString)
+	}
+	if _u.mutation.KeyMaterialCleared() {
+		_spec.ClearField(jwtsigningkey.FieldKeyMaterial, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(jwtsigningkey.FieldStatus, field.TypeEnum, value)
+	}
+	if _u.mutation.StatusCleared() {
+		_spec.ClearField(jwtsigningkey.FieldStatus, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.ActivatedAt(); ok {
+		_spec.SetField(jwtsigningkey.FieldActivatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ActivatedAtCleared() {
+		_spec.ClearField(jwtsigningkey.FieldActivatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RetiredAt(); ok {
+		_spec.SetField(jwtsigningkey.FieldRetiredAt, field.TypeTime, value)
+	}
+	if _u.mutation.RetiredAtCleared() {
+		_spec.ClearField(jwtsigningkey.FieldRetiredAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.VerifyUntil(); ok {
+		_spec.SetField(jwtsigningkey.FieldVerifyUntil, field.TypeTime, value)
+	}
+	if _u.mutation.VerifyUntilCleared() {
+		_spec.ClearField(jwtsigningkey.FieldVerifyUntil, field.TypeTime)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{jwtsigningkey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// JwtSigningKeyUpdateOne is the builder for updating a single JwtSigningKey entity.
+type JwtSigningKeyUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *JwtSigningKeyMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *JwtSigningKeyUpdateOne) SetUpdatedAt(v time.Time) *JwtSigningKeyUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *JwtSigningKeyUpdateOne) SetNillableUpdatedAt(v *time.Time) *JwtSigningKeyUpdateOne {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *JwtSigningKeyUpdateOne) ClearUpdatedAt() *JwtSigningKeyUpdateOne {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *JwtSigningKeyUpdateOne) SetDeletedAt(v time.Time) *JwtSigningKeyUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *JwtSigningKeyUpdateOne) SetNillableDeletedAt(v *time.Time) *JwtSigningKeyUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *JwtSigningKeyUpdateOne) ClearDeletedAt() *JwtSigningKeyUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *JwtSigningKeyUpdateOne) SetCreatedBy(v uint32) *JwtSigningKeyUpdateOne {
+	_u.mutation.ResetCreatedBy()
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *JwtSigningKeyUpdateOne) SetNillableCreatedBy(v *uint32) *JwtSigningKeyUpdateOne {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// AddCreatedBy adds value to the "created_by" field.
+func (_u *JwtSigningKeyUpdateOne) AddCreatedBy(v int32) *JwtSigningKeyUpdateOne {
+	_u.mutation.AddCreatedBy(v)
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *JwtSigningKeyUpdateOne) ClearCreatedBy() *JwtSigningKeyUpdateOne {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_u *JwtSigningKeyUpdateOne) SetUpdatedBy(v uint32) *JwtSigningKeyUpdateOne {
+	_u.mutation.ResetUpdatedBy()
+	_u.mutation.SetUpdatedBy(v)
+	return _u
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_u *JwtSigningKeyUpdateOne) SetNillableUpdatedBy(v *uint32) *JwtSigningKeyUpdateOne {
+	if v != nil {
+		_u.SetUpdatedBy(*v)
+	}
+	return _u
+}
+
+// AddUpdatedBy adds value to the "updated_by" field.
+func (_u *JwtSigningKeyUpdateOne) AddUpdatedBy(v int32) *JwtSigningKeyUpdateOne {
+	_u.mutation.AddUpdatedBy(v)
+	return _u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (_u *JwtSigningKeyUpdateOne) ClearUpdatedBy() *JwtSigningKeyUpdateOne {
+	_u.mutation.ClearUpdatedBy()
+	return _u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_u *JwtSigningKeyUpdateOne) SetDeletedBy(v uint32) *JwtSigningKeyUpdateOne {
+	_u.mutation.ResetDeletedBy()
+	_u.mutation.SetDeletedBy(v)
+	return _u
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_u *JwtSigningKeyUpdateOne) SetNillableDeletedBy(v *uint32) *JwtSigningKeyUpdateOne {
+	if v != nil {
+		_u.SetDeletedBy(*v)
+	}
+	return _u
+}
+
+// AddDeletedBy adds value to the "deleted_by" field.
+func (_u *JwtSigningKeyUpdateOne) AddDeletedBy(v int32) *JwtSigningKeyUpdateOne {
+	_u.mutation.AddDeletedBy(v)
+	return _u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (_u *JwtSigningKeyUpdateOne) ClearDeletedBy() *JwtSigningKeyUpdateOne {
+	_u.mutation.ClearDeletedBy()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *JwtSigningKeyUpdateOne) SetStatus(v jwtsigningkey.Status) *JwtSigningKeyUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *JwtSigningKeyUpdateOne) SetNillableStatus(v *jwtsigningkey.Status) *JwtSigningKeyUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// ClearStatus clears the value of the "status" field.
+func (_u *JwtSigningKeyUpdateOne) ClearStatus() *JwtSigningKeyUpdateOne {
+	_u.mutation.ClearStatus()
+	return _u
+}
+
+// SetActivatedAt sets the "activated_at" field.
+func (_u *JwtSigningKeyUpdateOne) SetActivatedAt(v time.Time) *JwtSigningKeyUpdateOne {
+	_u.mutation.SetActivatedAt(v)
+	return _u
+}
+
+// SetNillableActivatedAt sets the "activated_at" field if the given value is not nil.
+func (_u *JwtSigningKeyUpdateOne) SetNillableActivatedAt(v *time.Time) *JwtSigningKeyUpdateOne {
+	if v != nil {
+		_u.SetActivatedAt(*v)
+	}
+	return _u
+}
+
+// ClearActivatedAt clears the value of the "activated_at" field.
+func (_u *JwtSigningKeyUpdateOne) ClearActivatedAt() *JwtSigningKeyUpdateOne {
+	_u.mutation.ClearActivatedAt()
+	return _u
+}
+
+// SetRetiredAt sets the "retired_at" field.
+func (_u *JwtSigningKeyUpdateOne) SetRetiredAt(v time.Time) *JwtSigningKeyUpdateOne {
+	_u.mutation.SetRetiredAt(v)
+	return _u
+}
+
+// SetNillableRetiredAt sets the "retired_at" field if the given value is not nil.
+func (_u *JwtSigningKeyUpdateOne) SetNillableRetiredAt(v *time.Time) *JwtSigningKeyUpdateOne {
+	if v != nil {
+		_u.SetRetiredAt(*v)
+	}
+	return _u
+}
+
+// ClearRetiredAt clears the value of the "retired_at" field.
+func (_u *JwtSigningKeyUpdateOne) ClearRetiredAt() *JwtSigningKeyUpdateOne {
+	_u.mutation.ClearRetiredAt()
+	return _u
+}
+
+// SetVerifyUntil sets the "verify_until" field.
+func (_u *JwtSigningKeyUpdateOne) SetVerifyUntil(v time.Time) *JwtSigningKeyUpdateOne {
+	_u.mutation.SetVerifyUntil(v)
+	return _u
+}
+
+// SetNillableVerifyUntil sets the "verify_until" field if the given value is not nil.
+func (_u *JwtSigningKeyUpdateOne) SetNillableVerifyUntil(v *time.Time) *JwtSigningKeyUpdateOne {
+	if v != nil {
+		_u.SetVerifyUntil(*v)
+	}
+	return _u
+}
+
+// ClearVerifyUntil clears the value of the "verify_until" field.
+func (_u *JwtSigningKeyUpdateOne) ClearVerifyUntil() *JwtSigningKeyUpdateOne {
+	_u.mutation.ClearVerifyUntil()
+	return _u
+}
+
+// Mutation returns the JwtSigningKeyMutation object of the builder.
+func (_u *JwtSigningKeyUpdateOne) Mutation() *JwtSigningKeyMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the JwtSigningKeyUpdate builder.
+func (_u *JwtSigningKeyUpdateOne) Where(ps ...predicate.JwtSigningKey) *JwtSigningKeyUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *JwtSigningKeyUpdateOne) Select(field string, fields ...string) *JwtSigningKeyUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated JwtSigningKey entity.
+func (_u *JwtSigningKeyUpdateOne) Save(ctx context.Context) (*JwtSigningKey, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *JwtSigningKeyUpdateOne) SaveX(ctx context.Context) *JwtSigningKey {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *JwtSigningKeyUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *JwtSigningKeyUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *JwtSigningKeyUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := jwtsigningkey.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "JwtSigningKey.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *JwtSigningKeyUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *JwtSigningKeyUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *JwtSigningKeyUpdateOne) sqlSave(ctx context.Context) (_node *JwtSigningKey, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(jwtsigningkey.Table, jwtsigningkey.Columns, sqlgraph.NewFieldSpec(jwtsigningkey.FieldID, field.TypeUint32))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "JwtSigningKey.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, jwtsigningkey.FieldID)
+		for _, f := range fields {
+			if !jwtsigningkey.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != jwtsigningkey.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(jwtsigningkey.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(jwtsigningkey.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(jwtsigningkey.FieldUpdatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(jwtsigningkey.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(jwtsigningkey.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(jwtsigningkey.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(jwtsigningkey.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(jwtsigningkey.FieldCreatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UpdatedBy(); ok {
+		_spec.SetField(jwtsigningkey.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedBy(); ok {
+		_spec.AddField(jwtsigningkey.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdatedByCleared() {
+		_spec.ClearField(jwtsigningkey.FieldUpdatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.DeletedBy(); ok {
+		_spec.SetField(jwtsigningkey.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedBy(); ok {
+		_spec.AddField(jwtsigningkey.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.DeletedByCleared() {
+		_spec.ClearField(jwtsigningkey.FieldDeletedBy, field.TypeUint32)
+	}
+	if _u.mutation.KidCleared() {
+		_spec.ClearField(jwtsigningkey.FieldKid, field.TypeString)
+	}
+	if _u.mutation.AlgorithmCleared() {
+		_spec.ClearField(jwtsigningkey.FieldAlgorithm, field.TypeString)
+	}
+	if _u.mutation.KeyMaterialCleared() {
+		_spec.ClearField(jwtsigningkey.FieldKeyMaterial, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(jwtsigningkey.FieldStatus, field.TypeEnum, value)
+	}
+	if _u.mutation.StatusCleared() {
+		_spec.ClearField(jwtsigningkey.FieldStatus, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.ActivatedAt(); ok {
+		_spec.SetField(jwtsigningkey.FieldActivatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ActivatedAtCleared() {
+		_spec.ClearField(jwtsigningkey.FieldActivatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RetiredAt(); ok {
+		_spec.SetField(jwtsigningkey.FieldRetiredAt, field.TypeTime, value)
+	}
+	if _u.mutation.RetiredAtCleared() {
+		_spec.ClearField(jwtsigningkey.FieldRetiredAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.VerifyUntil(); ok {
+		_spec.SetField(jwtsigningkey.FieldVerifyUntil, field.TypeTime, value)
+	}
+	if _u.mutation.VerifyUntilCleared() {
+		_spec.ClearField(jwtsigningkey.FieldVerifyUntil, field.TypeTime)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &JwtSigningKey{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{jwtsigningkey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,501 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"go-wind-admin/app/admin/service/internal/data/ent/ldapconfig"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// LDAP 目录认证与同步配置表
+type LdapConfig struct {
+	config `json:"-"`
+	// ID of the ent.
+	// id
+	ID uint32 `json:"id,omitempty"`
+	// 创建时间
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	// 更新时间
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+	// 删除时间
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// 创建者ID
+	CreatedBy *uint32 `json:"created_by,omitempty"`
+	// 更新者ID
+	UpdatedBy *uint32 `json:"updated_by,omitempty"`
+	// 删除者ID
+	DeletedBy *uint32 `json:"deleted_by,omitempty"`
+	// 租户ID
+	TenantID *uint32 `json:"tenant_id,omitempty"`
+	// 状态
+	Status *ldapconfig.Status `json:"status,omitempty"`
+	// 显示名称
+	DisplayName *string `json:"display_name,omitempty"`
+	// 目录地址（ldap:// 或 ldaps://）
+	ServerURL *string `json:"server_url,omitempty"`
+	// ldap:// 连接是否升级 StartTLS
+	StartTLS *bool `json:"start_tls,omitempty"`
+	// 校验目录服务器证书的 CA（PEM），为空使用系统根证书
+	CaCertificate *string `json:"ca_certificate,omitempty"`
+	// 检索用服务账号 DN，为空匿名检索
+	BindDn *string `json:"bind_dn,omitempty"`
+	// 服务账号密码（加密存储）
+	BindPassword *string `json:"-"`
+	// 检索起点 DN
+	BaseDn *string `json:"base_dn,omitempty"`
+	// 用户过滤器，含 {username} 占位符
+	UserFilter *string `json:"user_filter,omitempty"`
+	// 条目唯一标识属性（entryUUID / objectGUID），为空以 DN 作标识
+	IDAttribute *string `json:"id_attribute,omitempty"`
+	// 用户属性映射
+	AttributeMapping map[string]string `json:"attribute_mapping,omitempty"`
+	// 组过滤器，为空不同步组
+	GroupFilter *string `json:"group_filter,omitempty"`
+	// 组成员属性（值为成员 DN）
+	GroupMemberAttribute *string `json:"group_member_attribute,omitempty"`
+	// 组到角色的映射
+	GroupRoleMapping map[string]uint32 `json:"group_role_mapping,omitempty"`
+	// 组织单元过滤器，为空不同步组织单元
+	OrgUnitFilter *string `json:"org_unit_filter,omitempty"`
+	// 同步的顶层组织单元挂载的本地上级组织单元ID
+	OrgUnitRootID *uint32 `json:"org_unit_root_id,omitempty"`
+	// 认证模式
+	AuthMode *ldapconfig.AuthMode `json:"auth_mode,omitempty"`
+	// 目录账号首次登录且尚未同步时是否自动创建用户
+	JitProvisioning *bool `json:"jit_provisioning,omitempty"`
+	// 新建用户时授予的角色ID列表
+	DefaultRoleIds []uint32 `json:"default_role_ids,omitempty"`
+	// 新建用户且目录未给出组织单元时归属的组织单元ID
+	DefaultOrgUnitID *uint32 `json:"default_org_unit_id,omitempty"`
+	// 最近一次同步时间
+	LastSyncedAt *time.Time `json:"last_synced_at,omitempty"`
+	// 最近一次同步结果
+	LastSyncMessage *string `json:"last_sync_message,omitempty"`
+	selectValues    sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LdapConfig) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case ldapconfig.FieldAttributeMapping, ldapconfig.FieldGroupRoleMapping, ldapconfig.FieldDefaultRoleIds:
+			values[i] = new([]byte)
+		case ldapconfig.FieldStartTLS, ldapconfig.FieldJitProvisioning:
+			values[i] = new(sql.NullBool)
+		case ldapconfig.FieldID, ldapconfig.FieldCreatedBy, ldapconfig.FieldUpdatedBy, ldapconfig.FieldDeletedBy, ldapconfig.FieldTenantID, ldapconfig.FieldOrgUnitRootID, ldapconfig.FieldDefaultOrgUnitID:
+			values[i] = new(sql.NullInt64)
+		case ldapconfig.FieldStatus, ldapconfig.FieldDisplayName, ldapconfig.FieldServerURL, ldapconfig.FieldCaCertificate, ldapconfig.FieldBindDn, ldapconfig.FieldBindPassword, ldapconfig.FieldBaseDn, ldapconfig.FieldUserFilter, ldapconfig.FieldIDAttribute, ldapconfig.FieldGroupFilter, ldapconfig.FieldGroupMemberAttribute, ldapconfig.FieldOrgUnitFilter, ldapconfig.FieldAuthMode, ldapconfig.FieldLastSyncMessage:
+			values[i] = new(sql.NullString)
+		case ldapconfig.FieldCreatedAt, ldapconfig.FieldUpdatedAt, ldapconfig.FieldDeletedAt, ldapconfig.FieldLastSyncedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LdapConfig fields.
+func (_m *LdapConfig) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case ldapconfig.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = uint32(value.Int64)
+		case ldapconfig.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = new(time.Time)
+				*_m.CreatedAt = value.Time
+			}
+		case ldapconfig.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = new(time.Time)
+				*_m.UpdatedAt = value.Time
+			}
+		case ldapconfig.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
+		case ldapconfig.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				_m.CreatedBy = new(uint32)
+				*_m.CreatedBy = uint32(value.Int64)
+			}
+		case ldapconfig.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				_m.UpdatedBy = new(uint32)
+				*_m.UpdatedBy = uint32(value.Int64)
+			}
+		case ldapconfig.FieldDeletedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_by", values[i])
+			} else if value.Valid {
+				_m.DeletedBy = new(uint32)
+				*_m.DeletedBy = uint32(value.Int64)
+			}
+		case ldapconfig.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = new(uint32)
+				*_m.TenantID = uint32(value.Int64)
+			}
+		case ldapconfig.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = new(ldapconfig.Status)
+				*_m.Status = ldapconfig.Status(value.String)
+			}
+		case ldapconfig.FieldDisplayName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field display_name", values[i])
+			} else if value.Valid {
+				_m.DisplayName = new(string)
+				*_m.DisplayName = value.String
+			}
+		case ldapconfig.FieldServerURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field server_url", values[i])
+			} else if value.Valid {
+				_m.ServerURL = new(string)
+				*_m.ServerURL = value.String
+			}
+		case ldapconfig.FieldStartTLS:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field start_tls", values[i])
+			} else if value.Valid {
+				_m.StartTLS = new(bool)
+				*_m.StartTLS = value.Bool
+			}
+		case ldapconfig.FieldCaCertificate:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ca_certificate", values[i])
+			} else if value.Valid {
+				_m.CaCertificate = new(string)
+				*_m.CaCertificate = value.String
+			}
+		case ldapconfig.FieldBindDn:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field bind_dn", values[i])
+			} else if value.Valid {
+				_m.BindDn = new(string)
+				*_m.BindDn = value.String
+			}
+		case ldapconfig.FieldBindPassword:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field bind_password", values[i])
+			} else if value.Valid {
+				_m.BindPassword = new(string)
+				*_m.BindPassword = value.String
+			}
+		case ldapconfig.FieldBaseDn:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field base_dn", values[i])
+			} else if value.Valid {
+				_m.BaseDn = new(string)
+				*_m.BaseDn = value.String
+			}
+		case ldapconfig.FieldUserFilter:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_filter", values[i])
+			} else if value.Valid {
+				_m.UserFilter = new(string)
+				*_m.UserFilter = value.String
+			}
+		case ldapconfig.FieldIDAttribute:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id_attribute", values[i])
+			} else if value.Valid {
+				_m.IDAttribute = new(string)
+				*_m.IDAttribute = value.String
+			}
+		case ldapconfig.FieldAttributeMapping:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field attribute_mapping", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.AttributeMapping); err != nil {
+					return fmt.Errorf("unmarshal field attribute_mapping: %w", err)
+				}
+			}
+		case ldapconfig.FieldGroupFilter:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field group_filter", values[i])
+			} else if value.Valid {
+				_m.GroupFilter = new(string)
+				*_m.GroupFilter = value.String
+			}
+		case ldapconfig.FieldGroupMemberAttribute:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field group_member_attribute", values[i])
+			} else if value.Valid {
+				_m.GroupMemberAttribute = new(string)
+				*_m.GroupMemberAttribute = value.String
+			}
+		case ldapconfig.FieldGroupRoleMapping:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field group_role_mapping", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.GroupRoleMapping); err != nil {
+					return fmt.Errorf("unmarshal field group_role_mapping: %w", err)
+				}
+			}
+		case ldapconfig.FieldOrgUnitFilter:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field org_unit_filter", values[i])
+			} else if value.Valid {
+				_m.OrgUnitFilter = new(string)
+				*_m.OrgUnitFilter = value.String
+			}
+		case ldapconfig.FieldOrgUnitRootID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field org_unit_root_id", values[i])
+			} else if value.Valid {
+				_m.OrgUnitRootID = new(uint32)
+				*_m.OrgUnitRootID = uint32(value.Int64)
+			}
+		case ldapconfig.FieldAuthMode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field auth_mode", values[i])
+			} else if value.Valid {
+				_m.AuthMode = new(ldapconfig.AuthMode)
+				*_m.AuthMode = ldapconfig.AuthMode(value.String)
+			}
+		case ldapconfig.FieldJitProvisioning:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field jit_provisioning", values[i])
+			} else if value.Valid {
+				_m.JitProvisioning = new(bool)
+				*_m.JitProvisioning = value.Bool
+			}
+		case ldapconfig.FieldDefaultRoleIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field default_role_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.DefaultRoleIds); err != nil {
+					return fmt.Errorf("unmarshal field default_role_ids: %w", err)
+				}
+			}
+		case ldapconfig.FieldDefaultOrgUnitID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field default_org_unit_id", values[i])
+			} else if value.Valid {
+				_m.DefaultOrgUnitID = new(uint32)
+				*_m.DefaultOrgUnitID = uint32(value.Int64)
+			}
+		case ldapconfig.FieldLastSyncedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_synced_at", values[i])
+			} else if value.Valid {
+				_m.LastSyncedAt = new(time.Time)
+				*_m.LastSyncedAt = value.Time
+			}
+		case ldapconfig.FieldLastSyncMessage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_sync_message", values[i])
+			} else if value.Valid {
+				_m.LastSyncMessage = new(string)
+				*_m.LastSyncMessage = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the LdapConfig.
+// This includes values selected through modifiers, order, etc.
+func (_m *LdapConfig) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this LdapConfig.
+// Note that you need to call LdapConfig.Unwrap() before calling this method if this LdapConfig
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *LdapConfig) Update() *LdapConfigUpdateOne {
+	return NewLdapConfigClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the LdapConfig entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *LdapConfig) Unwrap() *LdapConfig {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: LdapConfig is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *LdapConfig) String() string {
+	var builder strings.Builder
+	builder.WriteString("LdapConfig(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	if v := _m.CreatedAt; v != nil {
+		builder.WriteString("created_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.UpdatedAt; v != nil {
+		builder.WriteString("updated_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.CreatedBy; v != nil {
+		builder.WriteString("created_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.UpdatedBy; v != nil {
+		builder.WriteString("updated_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.DeletedBy; v != nil {
+		builder.WriteString("deleted_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.TenantID; v != nil {
+		builder.WriteString("tenant_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.Status; v != nil {
+		builder.WriteString("status=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.DisplayName; v != nil {
+		builder.WriteString("display_name=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.ServerURL; v != nil {
+		builder.WriteString("server_url=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.StartTLS; v != nil {
+		builder.WriteString("start_tls=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.CaCertificate; v != nil {
+		builder.WriteString("ca_certificate=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.BindDn; v != nil {
+		builder.WriteString("bind_dn=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("bind_password=<sensitive>")
+	builder.WriteString(", ")
+	if v := _m.BaseDn; v != nil {
+		builder.WriteString("base_dn=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.UserFilter; v != nil {
+		builder.WriteString("user_filter=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.IDAttribute; v != nil {
+		builder.WriteString("id_attribute=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("attribute_mapping=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AttributeMapping))
+	builder.WriteString(", ")
+	if v := _m.GroupFilter; v != nil {
+		builder.WriteString("group_filter=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.GroupMemberAttribute; v != nil {
+		builder.WriteString("group_member_attribute=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("group_role_mapping=")
+	builder.WriteString(fmt.Sprintf("%v", _m.GroupRoleMapping))
+	builder.WriteString(", ")
+	if v := _m.OrgUnitFilter; v != nil {
+		builder.WriteString("org_unit_filter=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.OrgUnitRootID; v != nil {
+		builder.WriteString("org_unit_root_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.AuthMode; v != nil {
+		builder.WriteString("auth_mode=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.JitProvisioning; v != nil {
+		builder.WriteString("jit_provisioning=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("default_role_ids=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DefaultRoleIds))
+	builder.WriteString(", ")
+	if v := _m.DefaultOrgUnitID; v != nil {
+		builder.WriteString("default_org_unit_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.LastSyncedAt; v != nil {
+		builder.WriteString("last_synced_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.LastSyncMessage; v != nil {
+		builder.WriteString("last_sync_message=")
+		builder.WriteString(*v)
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LdapConfigs is a parsable slice of LdapConfig.
+type LdapConfigs []*LdapConfig
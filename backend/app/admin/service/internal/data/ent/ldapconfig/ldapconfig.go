@@ -0,0 +1,351 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ldapconfig
+
+import (
+	"fmt"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the ldapconfig type in the database.
+	Label = "ldap_config"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldCreatedBy holds the string denoting the created_by field in the database.
+	FieldCreatedBy = "created_by"
+	// FieldUpdatedBy holds the string denoting the updated_by field in the database.
+	FieldUpdatedBy = "updated_by"
+	// FieldDeletedBy holds the string denoting the deleted_by field in the database.
+	FieldDeletedBy = "deleted_by"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldDisplayName holds the string denoting the display_name field in the database.
+	FieldDisplayName = "display_name"
+	// FieldServerURL holds the string denoting the server_url field in the database.
+	FieldServerURL = "server_url"
+	// FieldStartTLS holds the string denoting the start_tls field in the database.
+	FieldStartTLS = "start_tls"
+	// FieldCaCertificate holds the string denoting the ca_certificate field in the database.
+	FieldCaCertificate = "ca_certificate"
+	// FieldBindDn holds the string denoting the bind_dn field in the database.
+	FieldBindDn = "bind_dn"
+	// FieldBindPassword holds the string denoting the bind_password field in the database.
+	FieldBindPassword = "bind_password"
+	// FieldBaseDn holds the string denoting the base_dn field in the database.
+	FieldBaseDn = "base_dn"
+	// FieldUserFilter holds the string denoting the user_filter field in the database.
+	FieldUserFilter = "user_filter"
+	// FieldIDAttribute holds the string denoting the id_attribute field in the database.
+	FieldIDAttribute = "id_attribute"
+	// FieldAttributeMapping holds the string denoting the attribute_mapping field in the database.
+	FieldAttributeMapping = "attribute_mapping"
+	// FieldGroupFilter holds the string denoting the group_filter field in the database.
+	FieldGroupFilter = "group_filter"
+	// FieldGroupMemberAttribute holds the string denoting the group_member_attribute field in the database.
+	FieldGroupMemberAttribute = "group_member_attribute"
+	// FieldGroupRoleMapping holds the string denoting the group_role_mapping field in the database.
+	FieldGroupRoleMapping = "group_role_mapping"
+	// FieldOrgUnitFilter holds the string denoting the org_unit_filter field in the database.
+	FieldOrgUnitFilter = "org_unit_filter"
+	// FieldOrgUnitRootID holds the string denoting the org_unit_root_id field in the database.
+	FieldOrgUnitRootID = "org_unit_root_id"
+	// FieldAuthMode holds the string denoting the auth_mode field in the database.
+	FieldAuthMode = "auth_mode"
+	// FieldJitProvisioning holds the string denoting the jit_provisioning field in the database.
+	FieldJitProvisioning = "jit_provisioning"
+	// FieldDefaultRoleIds holds the string denoting the default_role_ids field in the database.
+	FieldDefaultRoleIds = "default_role_ids"
+	// FieldDefaultOrgUnitID holds the string denoting the default_org_unit_id field in the database.
+	FieldDefaultOrgUnitID = "default_org_unit_id"
+	// FieldLastSyncedAt holds the string denoting the last_synced_at field in the database.
+	FieldLastSyncedAt = "last_synced_at"
+	// FieldLastSyncMessage holds the string denoting the last_sync_message field in the database.
+	FieldLastSyncMessage = "last_sync_message"
+	// Table holds the table name of the ldapconfig in the database.
+	Table = "sys_ldap_configs"
+)
+
+// Columns holds all SQL columns for ldapconfig fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldDeletedAt,
+	FieldCreatedBy,
+	FieldUpdatedBy,
+	FieldDeletedBy,
+	FieldTenantID,
+	FieldStatus,
+	FieldDisplayName,
+	FieldServerURL,
+	FieldStartTLS,
+	FieldCaCertificate,
+	FieldBindDn,
+	FieldBindPassword,
+	FieldBaseDn,
+	FieldUserFilter,
+	FieldIDAttribute,
+	FieldAttributeMapping,
+	FieldGroupFilter,
+	FieldGroupMemberAttribute,
+	FieldGroupRoleMapping,
+	FieldOrgUnitFilter,
+	FieldOrgUnitRootID,
+	FieldAuthMode,
+	FieldJitProvisioning,
+	FieldDefaultRoleIds,
+	FieldDefaultOrgUnitID,
+	FieldLastSyncedAt,
+	FieldLastSyncMessage,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "go-wind-admin/app/admin/service/internal/data/ent/runtime"
+var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
+	// DefaultTenantID holds the default value on creation for the "tenant_id" field.
+	DefaultTenantID uint32
+	// DisplayNameValidator is a validator for the "display_name" field. It is called by the builders before save.
+	DisplayNameValidator func(string) error
+	// ServerURLValidator is a validator for the "server_url" field. It is called by the builders before save.
+	ServerURLValidator func(string) error
+	// DefaultStartTLS holds the default value on creation for the "start_tls" field.
+	DefaultStartTLS bool
+	// BindDnValidator is a validator for the "bind_dn" field. It is called by the builders before save.
+	BindDnValidator func(string) error
+	// BindPasswordValidator is a validator for the "bind_password" field. It is called by the builders before save.
+	BindPasswordValidator func(string) error
+	// BaseDnValidator is a validator for the "base_dn" field. It is called by the builders before save.
+	BaseDnValidator func(string) error
+	// UserFilterValidator is a validator for the "user_filter" field. It is called by the builders before save.
+	UserFilterValidator func(string) error
+	// IDAttributeValidator is a validator for the "id_attribute" field. It is called by the builders before save.
+	IDAttributeValidator func(string) error
+	// GroupFilterValidator is a validator for the "group_filter" field. It is called by the builders before save.
+	GroupFilterValidator func(string) error
+	// GroupMemberAttributeValidator is a validator for the "group_member_attribute" field. It is called by the builders before save.
+	GroupMemberAttributeValidator func(string) error
+	// OrgUnitFilterValidator is a validator for the "org_unit_filter" field. It is called by the builders before save.
+	OrgUnitFilterValidator func(string) error
+	// DefaultJitProvisioning holds the default value on creation for the "jit_provisioning" field.
+	DefaultJitProvisioning bool
+	// LastSyncMessageValidator is a validator for the "last_sync_message" field. It is called by the builders before save.
+	LastSyncMessageValidator func(string) error
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(uint32) error
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusOn is the default value of the Status enum.
+const DefaultStatus = StatusOn
+
+// Status values.
+const (
+	StatusOff Status = "OFF"
+	StatusOn  Status = "ON"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusOff, StatusOn:
+		return nil
+	default:
+		return fmt.Errorf("ldapconfig: invalid enum value for status field: %q", s)
+	}
+}
+
+// AuthMode defines the type for the "auth_mode" enum field.
+type AuthMode string
+
+// AuthModeLdapFirst is the default value of the AuthMode enum.
+const DefaultAuthMode = AuthModeLdapFirst
+
+// AuthMode values.
+const (
+	AuthModeLdapFirst AuthMode = "LDAP_FIRST"
+	AuthModeLdapOnly  AuthMode = "LDAP_ONLY"
+)
+
+func (am AuthMode) String() string {
+	return string(am)
+}
+
+// AuthModeValidator is a validator for the "auth_mode" field enum values. It is called by the builders before save.
+func AuthModeValidator(am AuthMode) error {
+	switch am {
+	case AuthModeLdapFirst, AuthModeLdapOnly:
+		return nil
+	default:
+		return fmt.Errorf("ldapconfig: invalid enum value for auth_mode field: %q", am)
+	}
+}
+
+// OrderOption defines the ordering options for the LdapConfig queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByCreatedBy orders the results by the created_by field.
+func ByCreatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedBy, opts...).ToFunc()
+}
+
+// ByUpdatedBy orders the results by the updated_by field.
+func ByUpdatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedBy, opts...).ToFunc()
+}
+
+// ByDeletedBy orders the results by the deleted_by field.
+func ByDeletedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedBy, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByDisplayName orders the results by the display_name field.
+func ByDisplayName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDisplayName, opts...).ToFunc()
+}
+
+// ByServerURL orders the results by the server_url field.
+func ByServerURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldServerURL, opts...).ToFunc()
+}
+
+// ByStartTLS orders the results by the start_tls field.
+func ByStartTLS(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartTLS, opts...).ToFunc()
+}
+
+// ByCaCertificate orders the results by the ca_certificate field.
+func ByCaCertificate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCaCertificate, opts...).ToFunc()
+}
+
+// ByBindDn orders the results by the bind_dn field.
+func ByBindDn(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBindDn, opts...).ToFunc()
+}
+
+// ByBindPassword orders the results by the bind_password field.
+func ByBindPassword(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBindPassword, opts...).ToFunc()
+}
+
+// ByBaseDn orders the results by the base_dn field.
+func ByBaseDn(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBaseDn, opts...).ToFunc()
+}
+
+// ByUserFilter orders the results by the user_filter field.
+func ByUserFilter(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserFilter, opts...).ToFunc()
+}
+
+// ByIDAttribute orders the results by the id_attribute field.
+func ByIDAttribute(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIDAttribute, opts...).ToFunc()
+}
+
+// ByGroupFilter orders the results by the group_filter field.
+func ByGroupFilter(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGroupFilter, opts...).ToFunc()
+}
+
+// ByGroupMemberAttribute orders the results by the group_member_attribute field.
+func ByGroupMemberAttribute(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGroupMemberAttribute, opts...).ToFunc()
+}
+
+// ByOrgUnitFilter orders the results by the org_unit_filter field.
+func ByOrgUnitFilter(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrgUnitFilter, opts...).ToFunc()
+}
+
+// ByOrgUnitRootID orders the results by the org_unit_root_id field.
+func ByOrgUnitRootID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrgUnitRootID, opts...).ToFunc()
+}
+
+// ByAuthMode orders the results by the auth_mode field.
+func ByAuthMode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAuthMode, opts...).ToFunc()
+}
+
+// ByJitProvisioning orders the results by the jit_provisioning field.
+func ByJitProvisioning(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldJitProvisioning, opts...).ToFunc()
+}
+
+// ByDefaultOrgUnitID orders the results by the default_org_unit_id field.
+func ByDefaultOrgUnitID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDefaultOrgUnitID, opts...).ToFunc()
+}
+
+// ByLastSyncedAt orders the results by the last_synced_at field.
+func ByLastSyncedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastSyncedAt, opts...).ToFunc()
+}
+
+// ByLastSyncMessage orders the results by the last_sync_message field.
+func ByLastSyncMessage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastSyncMessage, opts...).ToFunc()
+}
@@ -0,0 +1,1710 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ldapconfig
+
+import (
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint32) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint32) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint32) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint32) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint32) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint32) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint32) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint32) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint32) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v uint32) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// UpdatedBy applies equality check predicate on the "updated_by" field. It's identical to UpdatedByEQ.
+func UpdatedBy(v uint32) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// DeletedBy applies equality check predicate on the "deleted_by" field. It's identical to DeletedByEQ.
+func DeletedBy(v uint32) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldEQ(FieldDeletedBy, v))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uint32) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldEQ(FieldTenantID, v))
+}
+
+// DisplayName applies equality check predicate on the "display_name" field. It's identical to DisplayNameEQ.
+func DisplayName(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldEQ(FieldDisplayName, v))
+}
+
+// ServerURL applies equality check predicate on the "server_url" field. It's identical to ServerURLEQ.
+func ServerURL(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldEQ(FieldServerURL, v))
+}
+
+// StartTLS applies equality check predicate on the "start_tls" field. It's identical to StartTLSEQ.
+func StartTLS(v bool) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldEQ(FieldStartTLS, v))
+}
+
+// CaCertificate applies equality check predicate on the "ca_certificate" field. It's identical to CaCertificateEQ.
+func CaCertificate(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldEQ(FieldCaCertificate, v))
+}
+
+// BindDn applies equality check predicate on the "bind_dn" field. It's identical to BindDnEQ.
+func BindDn(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldEQ(FieldBindDn, v))
+}
+
+// BindPassword applies equality check predicate on the "bind_password" field. It's identical to BindPasswordEQ.
+func BindPassword(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldEQ(FieldBindPassword, v))
+}
+
+// BaseDn applies equality check predicate on the "base_dn" field. It's identical to BaseDnEQ.
+func BaseDn(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldEQ(FieldBaseDn, v))
+}
+
+// UserFilter applies equality check predicate on the "user_filter" field. It's identical to UserFilterEQ.
+func UserFilter(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldEQ(FieldUserFilter, v))
+}
+
+// IDAttribute applies equality check predicate on the "id_attribute" field. It's identical to IDAttributeEQ.
+func IDAttribute(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldEQ(FieldIDAttribute, v))
+}
+
+// GroupFilter applies equality check predicate on the "group_filter" field. It's identical to GroupFilterEQ.
+func GroupFilter(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldEQ(FieldGroupFilter, v))
+}
+
+// GroupMemberAttribute applies equality check predicate on the "group_member_attribute" field. It's identical to GroupMemberAttributeEQ.
+func GroupMemberAttribute(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldEQ(FieldGroupMemberAttribute, v))
+}
+
+// OrgUnitFilter applies equality check predicate on the "org_unit_filter" field. It's identical to OrgUnitFilterEQ.
+func OrgUnitFilter(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldEQ(FieldOrgUnitFilter, v))
+}
+
+// OrgUnitRootID applies equality check predicate on the "org_unit_root_id" field. It's identical to OrgUnitRootIDEQ.
+func OrgUnitRootID(v uint32) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldEQ(FieldOrgUnitRootID, v))
+}
+
+// JitProvisioning applies equality check predicate on the "jit_provisioning" field. It's identical to JitProvisioningEQ.
+func JitProvisioning(v bool) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldEQ(FieldJitProvisioning, v))
+}
+
+// DefaultOrgUnitID applies equality check predicate on the "default_org_unit_id" field. It's identical to DefaultOrgUnitIDEQ.
+func DefaultOrgUnitID(v uint32) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldEQ(FieldDefaultOrgUnitID, v))
+}
+
+// LastSyncedAt applies equality check predicate on the "last_synced_at" field. It's identical to LastSyncedAtEQ.
+func LastSyncedAt(v time.Time) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldEQ(FieldLastSyncedAt, v))
+}
+
+// LastSyncMessage applies equality check predicate on the "last_sync_message" field. It's identical to LastSyncMessageEQ.
+func LastSyncMessage(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldEQ(FieldLastSyncMessage, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// CreatedAtIsNil applies the IsNil predicate on the "created_at" field.
+func CreatedAtIsNil() predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldIsNull(FieldCreatedAt))
+}
+
+// CreatedAtNotNil applies the NotNil predicate on the "created_at" field.
+func CreatedAtNotNil() predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldNotNull(FieldCreatedAt))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIsNil applies the IsNil predicate on the "updated_at" field.
+func UpdatedAtIsNil() predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldIsNull(FieldUpdatedAt))
+}
+
+// UpdatedAtNotNil applies the NotNil predicate on the "updated_at" field.
+func UpdatedAtNotNil() predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldNotNull(FieldUpdatedAt))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v uint32) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v uint32) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...uint32) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...uint32) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v uint32) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v uint32) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v uint32) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v uint32) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByIsNil applies the IsNil predicate on the "created_by" field.
+func CreatedByIsNil() predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldIsNull(FieldCreatedBy))
+}
+
+// CreatedByNotNil applies the NotNil predicate on the "created_by" field.
+func CreatedByNotNil() predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldNotNull(FieldCreatedBy))
+}
+
+// UpdatedByEQ applies the EQ predicate on the "updated_by" field.
+func UpdatedByEQ(v uint32) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByNEQ applies the NEQ predicate on the "updated_by" field.
+func UpdatedByNEQ(v uint32) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldNEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByIn applies the In predicate on the "updated_by" field.
+func UpdatedByIn(vs ...uint32) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByNotIn applies the NotIn predicate on the "updated_by" field.
+func UpdatedByNotIn(vs ...uint32) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldNotIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByGT applies the GT predicate on the "updated_by" field.
+func UpdatedByGT(v uint32) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldGT(FieldUpdatedBy, v))
+}
+
+// UpdatedByGTE applies the GTE predicate on the "updated_by" field.
+func UpdatedByGTE(v uint32) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldGTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByLT applies the LT predicate on the "updated_by" field.
+func UpdatedByLT(v uint32) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldLT(FieldUpdatedBy, v))
+}
+
+// UpdatedByLTE applies the LTE predicate on the "updated_by" field.
+func UpdatedByLTE(v uint32) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldLTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByIsNil applies the IsNil predicate on the "updated_by" field.
+func UpdatedByIsNil() predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldIsNull(FieldUpdatedBy))
+}
+
+// UpdatedByNotNil applies the NotNil predicate on the "updated_by" field.
+func UpdatedByNotNil() predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldNotNull(FieldUpdatedBy))
+}
+
+// DeletedByEQ applies the EQ predicate on the "deleted_by" field.
+func DeletedByEQ(v uint32) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldEQ(FieldDeletedBy, v))
+}
+
+// DeletedByNEQ applies the NEQ predicate on the "deleted_by" field.
+func DeletedByNEQ(v uint32) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldNEQ(FieldDeletedBy, v))
+}
+
+// DeletedByIn applies the In predicate on the "deleted_by" field.
+func DeletedByIn(vs ...uint32) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldIn(FieldDeletedBy, vs...))
+}
+
+// DeletedByNotIn applies the NotIn predicate on the "deleted_by" field.
+func DeletedByNotIn(vs ...uint32) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldNotIn(FieldDeletedBy, vs...))
+}
+
+// DeletedByGT applies the GT predicate on the "deleted_by" field.
+func DeletedByGT(v uint32) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldGT(FieldDeletedBy, v))
+}
+
+// DeletedByGTE applies the GTE predicate on the "deleted_by" field.
+func DeletedByGTE(v uint32) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldGTE(FieldDeletedBy, v))
+}
+
+// DeletedByLT applies the LT predicate on the "deleted_by" field.
+func DeletedByLT(v uint32) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldLT(FieldDeletedBy, v))
+}
+
+// DeletedByLTE applies the LTE predicate on the "deleted_by" field.
+func DeletedByLTE(v uint32) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldLTE(FieldDeletedBy, v))
+}
+
+// DeletedByIsNil applies the IsNil predicate on the "deleted_by" field.
+func DeletedByIsNil() predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldIsNull(FieldDeletedBy))
+}
+
+// DeletedByNotNil applies the NotNil predicate on the "deleted_by" field.
+func DeletedByNotNil() predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldNotNull(FieldDeletedBy))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uint32) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uint32) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uint32) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uint32) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uint32) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uint32) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uint32) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uint32) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDIsNil applies the IsNil predicate on the "tenant_id" field.
+func TenantIDIsNil() predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldIsNull(FieldTenantID))
+}
+
+// TenantIDNotNil applies the NotNil predicate on the "tenant_id" field.
+func TenantIDNotNil() predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldNotNull(FieldTenantID))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// DisplayNameEQ applies the EQ predicate on the "display_name" field.
+func DisplayNameEQ(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldEQ(FieldDisplayName, v))
+}
+
+// DisplayNameNEQ applies the NEQ predicate on the "display_name" field.
+func DisplayNameNEQ(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldNEQ(FieldDisplayName, v))
+}
+
+// DisplayNameIn applies the In predicate on the "display_name" field.
+func DisplayNameIn(vs ...string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldIn(FieldDisplayName, vs...))
+}
+
+// DisplayNameNotIn applies the NotIn predicate on the "display_name" field.
+func DisplayNameNotIn(vs ...string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldNotIn(FieldDisplayName, vs...))
+}
+
+// DisplayNameGT applies the GT predicate on the "display_name" field.
+func DisplayNameGT(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldGT(FieldDisplayName, v))
+}
+
+// DisplayNameGTE applies the GTE predicate on the "display_name" field.
+func DisplayNameGTE(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldGTE(FieldDisplayName, v))
+}
+
+// DisplayNameLT applies the LT predicate on the "display_name" field.
+func DisplayNameLT(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldLT(FieldDisplayName, v))
+}
+
+// DisplayNameLTE applies the LTE predicate on the "display_name" field.
+func DisplayNameLTE(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldLTE(FieldDisplayName, v))
+}
+
+// DisplayNameContains applies the Contains predicate on the "display_name" field.
+func DisplayNameContains(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldContains(FieldDisplayName, v))
+}
+
+// DisplayNameHasPrefix applies the HasPrefix predicate on the "display_name" field.
+func DisplayNameHasPrefix(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldHasPrefix(FieldDisplayName, v))
+}
+
+// DisplayNameHasSuffix applies the HasSuffix predicate on the "display_name" field.
+func DisplayNameHasSuffix(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldHasSuffix(FieldDisplayName, v))
+}
+
+// DisplayNameIsNil applies the IsNil predicate on the "display_name" field.
+func DisplayNameIsNil() predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldIsNull(FieldDisplayName))
+}
+
+// DisplayNameNotNil applies the NotNil predicate on the "display_name" field.
+func DisplayNameNotNil() predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldNotNull(FieldDisplayName))
+}
+
+// DisplayNameEqualFold applies the EqualFold predicate on the "display_name" field.
+func DisplayNameEqualFold(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldEqualFold(FieldDisplayName, v))
+}
+
+// DisplayNameContainsFold applies the ContainsFold predicate on the "display_name" field.
+func DisplayNameContainsFold(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldContainsFold(FieldDisplayName, v))
+}
+
+// ServerURLEQ applies the EQ predicate on the "server_url" field.
+func ServerURLEQ(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldEQ(FieldServerURL, v))
+}
+
+// ServerURLNEQ applies the NEQ predicate on the "server_url" field.
+func ServerURLNEQ(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldNEQ(FieldServerURL, v))
+}
+
+// ServerURLIn applies the In predicate on the "server_url" field.
+func ServerURLIn(vs ...string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldIn(FieldServerURL, vs...))
+}
+
+// ServerURLNotIn applies the NotIn predicate on the "server_url" field.
+func ServerURLNotIn(vs ...string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldNotIn(FieldServerURL, vs...))
+}
+
+// ServerURLGT applies the GT predicate on the "server_url" field.
+func ServerURLGT(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldGT(FieldServerURL, v))
+}
+
+// ServerURLGTE applies the GTE predicate on the "server_url" field.
+func ServerURLGTE(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldGTE(FieldServerURL, v))
+}
+
+// ServerURLLT applies the LT predicate on the "server_url" field.
+func ServerURLLT(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldLT(FieldServerURL, v))
+}
+
+// ServerURLLTE applies the LTE predicate on the "server_url" field.
+func ServerURLLTE(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldLTE(FieldServerURL, v))
+}
+
+// ServerURLContains applies the Contains predicate on the "server_url" field.
+func ServerURLContains(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldContains(FieldServerURL, v))
+}
+
+// ServerURLHasPrefix applies the HasPrefix predicate on the "server_url" field.
+func ServerURLHasPrefix(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldHasPrefix(FieldServerURL, v))
+}
+
+// ServerURLHasSuffix applies the HasSuffix predicate on the "server_url" field.
+func ServerURLHasSuffix(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldHasSuffix(FieldServerURL, v))
+}
+
+// ServerURLIsNil applies the IsNil predicate on the "server_url" field.
+func ServerURLIsNil() predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldIsNull(FieldServerURL))
+}
+
+// ServerURLNotNil applies the NotNil predicate on the "server_url" field.
+func ServerURLNotNil() predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldNotNull(FieldServerURL))
+}
+
+// ServerURLEqualFold applies the EqualFold predicate on the "server_url" field.
+func ServerURLEqualFold(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldEqualFold(FieldServerURL, v))
+}
+
+// ServerURLContainsFold applies the ContainsFold predicate on the "server_url" field.
+func ServerURLContainsFold(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldContainsFold(FieldServerURL, v))
+}
+
+// StartTLSEQ applies the EQ predicate on the "start_tls" field.
+func StartTLSEQ(v bool) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldEQ(FieldStartTLS, v))
+}
+
+// StartTLSNEQ applies the NEQ predicate on the "start_tls" field.
+func StartTLSNEQ(v bool) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldNEQ(FieldStartTLS, v))
+}
+
+// StartTLSIsNil applies the IsNil predicate on the "start_tls" field.
+func StartTLSIsNil() predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldIsNull(FieldStartTLS))
+}
+
+// StartTLSNotNil applies the NotNil predicate on the "start_tls" field.
+func StartTLSNotNil() predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldNotNull(FieldStartTLS))
+}
+
+// CaCertificateEQ applies the EQ predicate on the "ca_certificate" field.
+func CaCertificateEQ(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldEQ(FieldCaCertificate, v))
+}
+
+// CaCertificateNEQ applies the NEQ predicate on the "ca_certificate" field.
+func CaCertificateNEQ(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldNEQ(FieldCaCertificate, v))
+}
+
+// CaCertificateIn applies the In predicate on the "ca_certificate" field.
+func CaCertificateIn(vs ...string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldIn(FieldCaCertificate, vs...))
+}
+
+// CaCertificateNotIn applies the NotIn predicate on the "ca_certificate" field.
+func CaCertificateNotIn(vs ...string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldNotIn(FieldCaCertificate, vs...))
+}
+
+// CaCertificateGT applies the GT predicate on the "ca_certificate" field.
+func CaCertificateGT(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldGT(FieldCaCertificate, v))
+}
+
+// CaCertificateGTE applies the GTE predicate on the "ca_certificate" field.
+func CaCertificateGTE(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldGTE(FieldCaCertificate, v))
+}
+
+// CaCertificateLT applies the LT predicate on the "ca_certificate" field.
+func CaCertificateLT(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldLT(FieldCaCertificate, v))
+}
+
+// CaCertificateLTE applies the LTE predicate on the "ca_certificate" field.
+func CaCertificateLTE(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldLTE(FieldCaCertificate, v))
+}
+
+// CaCertificateContains applies the Contains predicate on the "ca_certificate" field.
+func CaCertificateContains(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldContains(FieldCaCertificate, v))
+}
+
+// CaCertificateHasPrefix applies the HasPrefix predicate on the "ca_certificate" field.
+func CaCertificateHasPrefix(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldHasPrefix(FieldCaCertificate, v))
+}
+
+// CaCertificateHasSuffix applies the HasSuffix predicate on the "ca_certificate" field.
+func CaCertificateHasSuffix(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldHasSuffix(FieldCaCertificate, v))
+}
+
+// CaCertificateIsNil applies the IsNil predicate on the "ca_certificate" field.
+func CaCertificateIsNil() predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldIsNull(FieldCaCertificate))
+}
+
+// CaCertificateNotNil applies the NotNil predicate on the "ca_certificate" field.
+func CaCertificateNotNil() predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldNotNull(FieldCaCertificate))
+}
+
+// CaCertificateEqualFold applies the EqualFold predicate on the "ca_certificate" field.
+func CaCertificateEqualFold(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldEqualFold(FieldCaCertificate, v))
+}
+
+// CaCertificateContainsFold applies the ContainsFold predicate on the "ca_certificate" field.
+func CaCertificateContainsFold(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldContainsFold(FieldCaCertificate, v))
+}
+
+// BindDnEQ applies the EQ predicate on the "bind_dn" field.
+func BindDnEQ(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldEQ(FieldBindDn, v))
+}
+
+// BindDnNEQ applies the NEQ predicate on the "bind_dn" field.
+func BindDnNEQ(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldNEQ(FieldBindDn, v))
+}
+
+// BindDnIn applies the In predicate on the "bind_dn" field.
+func BindDnIn(vs ...string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldIn(FieldBindDn, vs...))
+}
+
+// BindDnNotIn applies the NotIn predicate on the "bind_dn" field.
+func BindDnNotIn(vs ...string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldNotIn(FieldBindDn, vs...))
+}
+
+// BindDnGT applies the GT predicate on the "bind_dn" field.
+func BindDnGT(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldGT(FieldBindDn, v))
+}
+
+// BindDnGTE applies the GTE predicate on the "bind_dn" field.
+func BindDnGTE(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldGTE(FieldBindDn, v))
+}
+
+// BindDnLT applies the LT predicate on the "bind_dn" field.
+func BindDnLT(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldLT(FieldBindDn, v))
+}
+
+// BindDnLTE applies the LTE predicate on the "bind_dn" field.
+func BindDnLTE(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldLTE(FieldBindDn, v))
+}
+
+// BindDnContains applies the Contains predicate on the "bind_dn" field.
+func BindDnContains(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldContains(FieldBindDn, v))
+}
+
+// BindDnHasPrefix applies the HasPrefix predicate on the "bind_dn" field.
+func BindDnHasPrefix(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldHasPrefix(FieldBindDn, v))
+}
+
+// BindDnHasSuffix applies the HasSuffix predicate on the "bind_dn" field.
+func BindDnHasSuffix(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldHasSuffix(FieldBindDn, v))
+}
+
+// BindDnIsNil applies the IsNil predicate on the "bind_dn" field.
+func BindDnIsNil() predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldIsNull(FieldBindDn))
+}
+
+// BindDnNotNil applies the NotNil predicate on the "bind_dn" field.
+func BindDnNotNil() predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldNotNull(FieldBindDn))
+}
+
+// BindDnEqualFold applies the EqualFold predicate on the "bind_dn" field.
+func BindDnEqualFold(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldEqualFold(FieldBindDn, v))
+}
+
+// BindDnContainsFold applies the ContainsFold predicate on the "bind_dn" field.
+func BindDnContainsFold(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldContainsFold(FieldBindDn, v))
+}
+
+// BindPasswordEQ applies the EQ predicate on the "bind_password" field.
+func BindPasswordEQ(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldEQ(FieldBindPassword, v))
+}
+
+// BindPasswordNEQ applies the NEQ predicate on the "bind_password" field.
+func BindPasswordNEQ(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldNEQ(FieldBindPassword, v))
+}
+
+// BindPasswordIn applies the In predicate on the "bind_password" field.
+func BindPasswordIn(vs ...string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldIn(FieldBindPassword, vs...))
+}
+
+// BindPasswordNotIn applies the NotIn predicate on the "bind_password" field.
+func BindPasswordNotIn(vs ...string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldNotIn(FieldBindPassword, vs...))
+}
+
+// BindPasswordGT applies the GT predicate on the "bind_password" field.
+func BindPasswordGT(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldGT(FieldBindPassword, v))
+}
+
+// BindPasswordGTE applies the GTE predicate on the "bind_password" field.
+func BindPasswordGTE(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldGTE(FieldBindPassword, v))
+}
+
+// BindPasswordLT applies the LT predicate on the "bind_password" field.
+func BindPasswordLT(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldLT(FieldBindPassword, v))
+}
+
+// BindPasswordLTE applies the LTE predicate on the "bind_password" field.
+func BindPasswordLTE(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldLTE(FieldBindPassword, v))
+}
+
+// BindPasswordContains applies the Contains predicate on the "bind_password" field.
+func BindPasswordContains(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldContains(FieldBindPassword, v))
+}
+
+// BindPasswordHasPrefix applies the HasPrefix predicate on the "bind_password" field.
+func BindPasswordHasPrefix(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldHasPrefix(FieldBindPassword, v))
+}
+
+// BindPasswordHasSuffix applies the HasSuffix predicate on the "bind_password" field.
+func BindPasswordHasSuffix(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldHasSuffix(FieldBindPassword, v))
+}
+
+// BindPasswordIsNil applies the IsNil predicate on the "bind_password" field.
+func BindPasswordIsNil() predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldIsNull(FieldBindPassword))
+}
+
+// BindPasswordNotNil applies the NotNil predicate on the "bind_password" field.
+func BindPasswordNotNil() predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldNotNull(FieldBindPassword))
+}
+
+// BindPasswordEqualFold applies the EqualFold predicate on the "bind_password" field.
+func BindPasswordEqualFold(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldEqualFold(FieldBindPassword, v))
+}
+
+// BindPasswordContainsFold applies the ContainsFold predicate on the "bind_password" field.
+func BindPasswordContainsFold(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldContainsFold(FieldBindPassword, v))
+}
+
+// BaseDnEQ applies the EQ predicate on the "base_dn" field.
+func BaseDnEQ(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldEQ(FieldBaseDn, v))
+}
+
+// BaseDnNEQ applies the NEQ predicate on the "base_dn" field.
+func BaseDnNEQ(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldNEQ(FieldBaseDn, v))
+}
+
+// BaseDnIn applies the In predicate on the "base_dn" field.
+func BaseDnIn(vs ...string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldIn(FieldBaseDn, vs...))
+}
+
+// BaseDnNotIn applies the NotIn predicate on the "base_dn" field.
+func BaseDnNotIn(vs ...string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldNotIn(FieldBaseDn, vs...))
+}
+
+// BaseDnGT applies the GT predicate on the "base_dn" field.
+func BaseDnGT(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldGT(FieldBaseDn, v))
+}
+
+// BaseDnGTE applies the GTE predicate on the "base_dn" field.
+func BaseDnGTE(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldGTE(FieldBaseDn, v))
+}
+
+// BaseDnLT applies the LT predicate on the "base_dn" field.
+func BaseDnLT(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldLT(FieldBaseDn, v))
+}
+
+// BaseDnLTE applies the LTE predicate on the "base_dn" field.
+func BaseDnLTE(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldLTE(FieldBaseDn, v))
+}
+
+// BaseDnContains applies the Contains predicate on the "base_dn" field.
+func BaseDnContains(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldContains(FieldBaseDn, v))
+}
+
+// BaseDnHasPrefix applies the HasPrefix predicate on the "base_dn" field.
+func BaseDnHasPrefix(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldHasPrefix(FieldBaseDn, v))
+}
+
+// BaseDnHasSuffix applies the HasSuffix predicate on the "base_dn" field.
+func BaseDnHasSuffix(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldHasSuffix(FieldBaseDn, v))
+}
+
+// BaseDnIsNil applies the IsNil predicate on the "base_dn" field.
+func BaseDnIsNil() predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldIsNull(FieldBaseDn))
+}
+
+// BaseDnNotNil applies the NotNil predicate on the "base_dn" field.
+func BaseDnNotNil() predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldNotNull(FieldBaseDn))
+}
+
+// BaseDnEqualFold applies the EqualFold predicate on the "base_dn" field.
+func BaseDnEqualFold(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldEqualFold(FieldBaseDn, v))
+}
+
+// BaseDnContainsFold applies the ContainsFold predicate on the "base_dn" field.
+func BaseDnContainsFold(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldContainsFold(FieldBaseDn, v))
+}
+
+// UserFilterEQ applies the EQ predicate on the "user_filter" field.
+func UserFilterEQ(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldEQ(FieldUserFilter, v))
+}
+
+// UserFilterNEQ applies the NEQ predicate on the "user_filter" field.
+func UserFilterNEQ(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldNEQ(FieldUserFilter, v))
+}
+
+// UserFilterIn applies the In predicate on the "user_filter" field.
+func UserFilterIn(vs ...string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldIn(FieldUserFilter, vs...))
+}
+
+// UserFilterNotIn applies the NotIn predicate on the "user_filter" field.
+func UserFilterNotIn(vs ...string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldNotIn(FieldUserFilter, vs...))
+}
+
+// UserFilterGT applies the GT predicate on the "user_filter" field.
+func UserFilterGT(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldGT(FieldUserFilter, v))
+}
+
+// UserFilterGTE applies the GTE predicate on the "user_filter" field.
+func UserFilterGTE(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldGTE(FieldUserFilter, v))
+}
+
+// UserFilterLT applies the LT predicate on the "user_filter" field.
+func UserFilterLT(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldLT(FieldUserFilter, v))
+}
+
+// UserFilterLTE applies the LTE predicate on the "user_filter" field.
+func UserFilterLTE(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldLTE(FieldUserFilter, v))
+}
+
+// UserFilterContains applies the Contains predicate on the "user_filter" field.
+func UserFilterContains(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldContains(FieldUserFilter, v))
+}
+
+// UserFilterHasPrefix applies the HasPrefix predicate on the "user_filter" field.
+func UserFilterHasPrefix(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldHasPrefix(FieldUserFilter, v))
+}
+
+// UserFilterHasSuffix applies the HasSuffix predicate on the "user_filter" field.
+func UserFilterHasSuffix(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldHasSuffix(FieldUserFilter, v))
+}
+
+// UserFilterIsNil applies the IsNil predicate on the "user_filter" field.
+func UserFilterIsNil() predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldIsNull(FieldUserFilter))
+}
+
+// UserFilterNotNil applies the NotNil predicate on the "user_filter" field.
+func UserFilterNotNil() predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldNotNull(FieldUserFilter))
+}
+
+// UserFilterEqualFold applies the EqualFold predicate on the "user_filter" field.
+func UserFilterEqualFold(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldEqualFold(FieldUserFilter, v))
+}
+
+// UserFilterContainsFold applies the ContainsFold predicate on the "user_filter" field.
+func UserFilterContainsFold(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldContainsFold(FieldUserFilter, v))
+}
+
+// IDAttributeEQ applies the EQ predicate on the "id_attribute" field.
+func IDAttributeEQ(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldEQ(FieldIDAttribute, v))
+}
+
+// IDAttributeNEQ applies the NEQ predicate on the "id_attribute" field.
+func IDAttributeNEQ(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldNEQ(FieldIDAttribute, v))
+}
+
+// IDAttributeIn applies the In predicate on the "id_attribute" field.
+func IDAttributeIn(vs ...string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldIn(FieldIDAttribute, vs...))
+}
+
+// IDAttributeNotIn applies the NotIn predicate on the "id_attribute" field.
+func IDAttributeNotIn(vs ...string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldNotIn(FieldIDAttribute, vs...))
+}
+
+// IDAttributeGT applies the GT predicate on the "id_attribute" field.
+func IDAttributeGT(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldGT(FieldIDAttribute, v))
+}
+
+// IDAttributeGTE applies the GTE predicate on the "id_attribute" field.
+func IDAttributeGTE(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldGTE(FieldIDAttribute, v))
+}
+
+// IDAttributeLT applies the LT predicate on the "id_attribute" field.
+func IDAttributeLT(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldLT(FieldIDAttribute, v))
+}
+
+// IDAttributeLTE applies the LTE predicate on the "id_attribute" field.
+func IDAttributeLTE(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldLTE(FieldIDAttribute, v))
+}
+
+// IDAttributeContains applies the Contains predicate on the "id_attribute" field.
+func IDAttributeContains(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldContains(FieldIDAttribute, v))
+}
+
+// IDAttributeHasPrefix applies the HasPrefix predicate on the "id_attribute" field.
+func IDAttributeHasPrefix(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldHasPrefix(FieldIDAttribute, v))
+}
+
+// IDAttributeHasSuffix applies the HasSuffix predicate on the "id_attribute" field.
+func IDAttributeHasSuffix(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldHasSuffix(FieldIDAttribute, v))
+}
+
+// IDAttributeIsNil applies the IsNil predicate on the "id_attribute" field.
+func IDAttributeIsNil() predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldIsNull(FieldIDAttribute))
+}
+
+// IDAttributeNotNil applies the NotNil predicate on the "id_attribute" field.
+func IDAttributeNotNil() predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldNotNull(FieldIDAttribute))
+}
+
+// IDAttributeEqualFold applies the EqualFold predicate on the "id_attribute" field.
+func IDAttributeEqualFold(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldEqualFold(FieldIDAttribute, v))
+}
+
+// IDAttributeContainsFold applies the ContainsFold predicate on the "id_attribute" field.
+func IDAttributeContainsFold(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldContainsFold(FieldIDAttribute, v))
+}
+
+// AttributeMappingIsNil applies the IsNil predicate on the "attribute_mapping" field.
+func AttributeMappingIsNil() predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldIsNull(FieldAttributeMapping))
+}
+
+// AttributeMappingNotNil applies the NotNil predicate on the "attribute_mapping" field.
+func AttributeMappingNotNil() predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldNotNull(FieldAttributeMapping))
+}
+
+// GroupFilterEQ applies the EQ predicate on the "group_filter" field.
+func GroupFilterEQ(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldEQ(FieldGroupFilter, v))
+}
+
+// GroupFilterNEQ applies the NEQ predicate on the "group_filter" field.
+func GroupFilterNEQ(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldNEQ(FieldGroupFilter, v))
+}
+
+// GroupFilterIn applies the In predicate on the "group_filter" field.
+func GroupFilterIn(vs ...string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldIn(FieldGroupFilter, vs...))
+}
+
+// GroupFilterNotIn applies the NotIn predicate on the "group_filter" field.
+func GroupFilterNotIn(vs ...string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldNotIn(FieldGroupFilter, vs...))
+}
+
+// GroupFilterGT applies the GT predicate on the "group_filter" field.
+func GroupFilterGT(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldGT(FieldGroupFilter, v))
+}
+
+// GroupFilterGTE applies the GTE predicate on the "group_filter" field.
+func GroupFilterGTE(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldGTE(FieldGroupFilter, v))
+}
+
+// GroupFilterLT applies the LT predicate on the "group_filter" field.
+func GroupFilterLT(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldLT(FieldGroupFilter, v))
+}
+
+// GroupFilterLTE applies the LTE predicate on the "group_filter" field.
+func GroupFilterLTE(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldLTE(FieldGroupFilter, v))
+}
+
+// GroupFilterContains applies the Contains predicate on the "group_filter" field.
+func GroupFilterContains(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldContains(FieldGroupFilter, v))
+}
+
+// GroupFilterHasPrefix applies the HasPrefix predicate on the "group_filter" field.
+func GroupFilterHasPrefix(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldHasPrefix(FieldGroupFilter, v))
+}
+
+// GroupFilterHasSuffix applies the HasSuffix predicate on the "group_filter" field.
+func GroupFilterHasSuffix(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldHasSuffix(FieldGroupFilter, v))
+}
+
+// GroupFilterIsNil applies the IsNil predicate on the "group_filter" field.
+func GroupFilterIsNil() predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldIsNull(FieldGroupFilter))
+}
+
+// GroupFilterNotNil applies the NotNil predicate on the "group_filter" field.
+func GroupFilterNotNil() predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldNotNull(FieldGroupFilter))
+}
+
+// GroupFilterEqualFold applies the EqualFold predicate on the "group_filter" field.
+func GroupFilterEqualFold(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldEqualFold(FieldGroupFilter, v))
+}
+
+// GroupFilterContainsFold applies the ContainsFold predicate on the "group_filter" field.
+func GroupFilterContainsFold(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldContainsFold(FieldGroupFilter, v))
+}
+
+// GroupMemberAttributeEQ applies the EQ predicate on the "group_member_attribute" field.
+func GroupMemberAttributeEQ(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldEQ(FieldGroupMemberAttribute, v))
+}
+
+// GroupMemberAttributeNEQ applies the NEQ predicate on the "group_member_attribute" field.
+func GroupMemberAttributeNEQ(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldNEQ(FieldGroupMemberAttribute, v))
+}
+
+// GroupMemberAttributeIn applies the In predicate on the "group_member_attribute" field.
+func GroupMemberAttributeIn(vs ...string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldIn(FieldGroupMemberAttribute, vs...))
+}
+
+// GroupMemberAttributeNotIn applies the NotIn predicate on the "group_member_attribute" field.
+func GroupMemberAttributeNotIn(vs ...string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldNotIn(FieldGroupMemberAttribute, vs...))
+}
+
+// GroupMemberAttributeGT applies the GT predicate on the "group_member_attribute" field.
+func GroupMemberAttributeGT(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldGT(FieldGroupMemberAttribute, v))
+}
+
+// GroupMemberAttributeGTE applies the GTE predicate on the "group_member_attribute" field.
+func GroupMemberAttributeGTE(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldGTE(FieldGroupMemberAttribute, v))
+}
+
+// GroupMemberAttributeLT applies the LT predicate on the "group_member_attribute" field.
+func GroupMemberAttributeLT(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldLT(FieldGroupMemberAttribute, v))
+}
+
+// GroupMemberAttributeLTE applies the LTE predicate on the "group_member_attribute" field.
+func GroupMemberAttributeLTE(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldLTE(FieldGroupMemberAttribute, v))
+}
+
+// GroupMemberAttributeContains applies the Contains predicate on the "group_member_attribute" field.
+func GroupMemberAttributeContains(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldContains(FieldGroupMemberAttribute, v))
+}
+
+// GroupMemberAttributeHasPrefix applies the HasPrefix predicate on the "group_member_attribute" field.
+func GroupMemberAttributeHasPrefix(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldHasPrefix(FieldGroupMemberAttribute, v))
+}
+
+// GroupMemberAttributeHasSuffix applies the HasSuffix predicate on the "group_member_attribute" field.
+func GroupMemberAttributeHasSuffix(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldHasSuffix(FieldGroupMemberAttribute, v))
+}
+
+// GroupMemberAttributeIsNil applies the IsNil predicate on the "group_member_attribute" field.
+func GroupMemberAttributeIsNil() predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldIsNull(FieldGroupMemberAttribute))
+}
+
+// GroupMemberAttributeNotNil applies the NotNil predicate on the "group_member_attribute" field.
+func GroupMemberAttributeNotNil() predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldNotNull(FieldGroupMemberAttribute))
+}
+
+// GroupMemberAttributeEqualFold applies the EqualFold predicate on the "group_member_attribute" field.
+func GroupMemberAttributeEqualFold(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldEqualFold(FieldGroupMemberAttribute, v))
+}
+
+// GroupMemberAttributeContainsFold applies the ContainsFold predicate on the "group_member_attribute" field.
+func GroupMemberAttributeContainsFold(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldContainsFold(FieldGroupMemberAttribute, v))
+}
+
+// GroupRoleMappingIsNil applies the IsNil predicate on the "group_role_mapping" field.
+func GroupRoleMappingIsNil() predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldIsNull(FieldGroupRoleMapping))
+}
+
+// GroupRoleMappingNotNil applies the NotNil predicate on the "group_role_mapping" field.
+func GroupRoleMappingNotNil() predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldNotNull(FieldGroupRoleMapping))
+}
+
+// OrgUnitFilterEQ applies the EQ predicate on the "org_unit_filter" field.
+func OrgUnitFilterEQ(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldEQ(FieldOrgUnitFilter, v))
+}
+
+// OrgUnitFilterNEQ applies the NEQ predicate on the "org_unit_filter" field.
+func OrgUnitFilterNEQ(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldNEQ(FieldOrgUnitFilter, v))
+}
+
+// OrgUnitFilterIn applies the In predicate on the "org_unit_filter" field.
+func OrgUnitFilterIn(vs ...string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldIn(FieldOrgUnitFilter, vs...))
+}
+
+// OrgUnitFilterNotIn applies the NotIn predicate on the "org_unit_filter" field.
+func OrgUnitFilterNotIn(vs ...string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldNotIn(FieldOrgUnitFilter, vs...))
+}
+
+// OrgUnitFilterGT applies the GT predicate on the "org_unit_filter" field.
+func OrgUnitFilterGT(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldGT(FieldOrgUnitFilter, v))
+}
+
+// OrgUnitFilterGTE applies the GTE predicate on the "org_unit_filter" field.
+func OrgUnitFilterGTE(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldGTE(FieldOrgUnitFilter, v))
+}
+
+// OrgUnitFilterLT applies the LT predicate on the "org_unit_filter" field.
+func OrgUnitFilterLT(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldLT(FieldOrgUnitFilter, v))
+}
+
+// OrgUnitFilterLTE applies the LTE predicate on the "org_unit_filter" field.
+func OrgUnitFilterLTE(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldLTE(FieldOrgUnitFilter, v))
+}
+
+// OrgUnitFilterContains applies the Contains predicate on the "org_unit_filter" field.
+func OrgUnitFilterContains(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldContains(FieldOrgUnitFilter, v))
+}
+
+// OrgUnitFilterHasPrefix applies the HasPrefix predicate on the "org_unit_filter" field.
+func OrgUnitFilterHasPrefix(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldHasPrefix(FieldOrgUnitFilter, v))
+}
+
+// OrgUnitFilterHasSuffix applies the HasSuffix predicate on the "org_unit_filter" field.
+func OrgUnitFilterHasSuffix(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldHasSuffix(FieldOrgUnitFilter, v))
+}
+
+// OrgUnitFilterIsNil applies the IsNil predicate on the "org_unit_filter" field.
+func OrgUnitFilterIsNil() predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldIsNull(FieldOrgUnitFilter))
+}
+
+// OrgUnitFilterNotNil applies the NotNil predicate on the "org_unit_filter" field.
+func OrgUnitFilterNotNil() predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldNotNull(FieldOrgUnitFilter))
+}
+
+// OrgUnitFilterEqualFold applies the EqualFold predicate on the "org_unit_filter" field.
+func OrgUnitFilterEqualFold(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldEqualFold(FieldOrgUnitFilter, v))
+}
+
+// OrgUnitFilterContainsFold applies the ContainsFold predicate on the "org_unit_filter" field.
+func OrgUnitFilterContainsFold(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldContainsFold(FieldOrgUnitFilter, v))
+}
+
+// OrgUnitRootIDEQ applies the EQ predicate on the "org_unit_root_id" field.
+func OrgUnitRootIDEQ(v uint32) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldEQ(FieldOrgUnitRootID, v))
+}
+
+// OrgUnitRootIDNEQ applies the NEQ predicate on the "org_unit_root_id" field.
+func OrgUnitRootIDNEQ(v uint32) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldNEQ(FieldOrgUnitRootID, v))
+}
+
+// OrgUnitRootIDIn applies the In predicate on the "org_unit_root_id" field.
+func OrgUnitRootIDIn(vs ...uint32) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldIn(FieldOrgUnitRootID, vs...))
+}
+
+// OrgUnitRootIDNotIn applies the NotIn predicate on the "org_unit_root_id" field.
+func OrgUnitRootIDNotIn(vs ...uint32) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldNotIn(FieldOrgUnitRootID, vs...))
+}
+
+// OrgUnitRootIDGT applies the GT predicate on the "org_unit_root_id" field.
+func OrgUnitRootIDGT(v uint32) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldGT(FieldOrgUnitRootID, v))
+}
+
+// OrgUnitRootIDGTE applies the GTE predicate on the "org_unit_root_id" field.
+func OrgUnitRootIDGTE(v uint32) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldGTE(FieldOrgUnitRootID, v))
+}
+
+// OrgUnitRootIDLT applies the LT predicate on the "org_unit_root_id" field.
+func OrgUnitRootIDLT(v uint32) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldLT(FieldOrgUnitRootID, v))
+}
+
+// OrgUnitRootIDLTE applies the LTE predicate on the "org_unit_root_id" field.
+func OrgUnitRootIDLTE(v uint32) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldLTE(FieldOrgUnitRootID, v))
+}
+
+// OrgUnitRootIDIsNil applies the IsNil predicate on the "org_unit_root_id" field.
+func OrgUnitRootIDIsNil() predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldIsNull(FieldOrgUnitRootID))
+}
+
+// OrgUnitRootIDNotNil applies the NotNil predicate on the "org_unit_root_id" field.
+func OrgUnitRootIDNotNil() predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldNotNull(FieldOrgUnitRootID))
+}
+
+// AuthModeEQ applies the EQ predicate on the "auth_mode" field.
+func AuthModeEQ(v AuthMode) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldEQ(FieldAuthMode, v))
+}
+
+// AuthModeNEQ applies the NEQ predicate on the "auth_mode" field.
+func AuthModeNEQ(v AuthMode) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldNEQ(FieldAuthMode, v))
+}
+
+// AuthModeIn applies the In predicate on the "auth_mode" field.
+func AuthModeIn(vs ...AuthMode) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldIn(FieldAuthMode, vs...))
+}
+
+// AuthModeNotIn applies the NotIn predicate on the "auth_mode" field.
+func AuthModeNotIn(vs ...AuthMode) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldNotIn(FieldAuthMode, vs...))
+}
+
+// AuthModeIsNil applies the IsNil predicate on the "auth_mode" field.
+func AuthModeIsNil() predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldIsNull(FieldAuthMode))
+}
+
+// AuthModeNotNil applies the NotNil predicate on the "auth_mode" field.
+func AuthModeNotNil() predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldNotNull(FieldAuthMode))
+}
+
+// JitProvisioningEQ applies the EQ predicate on the "jit_provisioning" field.
+func JitProvisioningEQ(v bool) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldEQ(FieldJitProvisioning, v))
+}
+
+// JitProvisioningNEQ applies the NEQ predicate on the "jit_provisioning" field.
+func JitProvisioningNEQ(v bool) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldNEQ(FieldJitProvisioning, v))
+}
+
+// JitProvisioningIsNil applies the IsNil predicate on the "jit_provisioning" field.
+func JitProvisioningIsNil() predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldIsNull(FieldJitProvisioning))
+}
+
+// JitProvisioningNotNil applies the NotNil predicate on the "jit_provisioning" field.
+func JitProvisioningNotNil() predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldNotNull(FieldJitProvisioning))
+}
+
+// DefaultRoleIdsIsNil applies the IsNil predicate on the "default_role_ids" field.
+func DefaultRoleIdsIsNil() predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldIsNull(FieldDefaultRoleIds))
+}
+
+// DefaultRoleIdsNotNil applies the NotNil predicate on the "default_role_ids" field.
+func DefaultRoleIdsNotNil() predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldNotNull(FieldDefaultRoleIds))
+}
+
+// DefaultOrgUnitIDEQ applies the EQ predicate on the "default_org_unit_id" field.
+func DefaultOrgUnitIDEQ(v uint32) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldEQ(FieldDefaultOrgUnitID, v))
+}
+
+// DefaultOrgUnitIDNEQ applies the NEQ predicate on the "default_org_unit_id" field.
+func DefaultOrgUnitIDNEQ(v uint32) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldNEQ(FieldDefaultOrgUnitID, v))
+}
+
+// DefaultOrgUnitIDIn applies the In predicate on the "default_org_unit_id" field.
+func DefaultOrgUnitIDIn(vs ...uint32) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldIn(FieldDefaultOrgUnitID, vs...))
+}
+
+// DefaultOrgUnitIDNotIn applies the NotIn predicate on the "default_org_unit_id" field.
+func DefaultOrgUnitIDNotIn(vs ...uint32) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldNotIn(FieldDefaultOrgUnitID, vs...))
+}
+
+// DefaultOrgUnitIDGT applies the GT predicate on the "default_org_unit_id" field.
+func DefaultOrgUnitIDGT(v uint32) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldGT(FieldDefaultOrgUnitID, v))
+}
+
+// DefaultOrgUnitIDGTE applies the GTE predicate on the "default_org_unit_id" field.
+func DefaultOrgUnitIDGTE(v uint32) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldGTE(FieldDefaultOrgUnitID, v))
+}
+
+// DefaultOrgUnitIDLT applies the LT predicate on the "default_org_unit_id" field.
+func DefaultOrgUnitIDLT(v uint32) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldLT(FieldDefaultOrgUnitID, v))
+}
+
+// DefaultOrgUnitIDLTE applies the LTE predicate on the "default_org_unit_id" field.
+func DefaultOrgUnitIDLTE(v uint32) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldLTE(FieldDefaultOrgUnitID, v))
+}
+
+// DefaultOrgUnitIDIsNil applies the IsNil predicate on the "default_org_unit_id" field.
+func DefaultOrgUnitIDIsNil() predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldIsNull(FieldDefaultOrgUnitID))
+}
+
+// DefaultOrgUnitIDNotNil applies the NotNil predicate on the "default_org_unit_id" field.
+func DefaultOrgUnitIDNotNil() predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldNotNull(FieldDefaultOrgUnitID))
+}
+
+// LastSyncedAtEQ applies the EQ predicate on the "last_synced_at" field.
+func LastSyncedAtEQ(v time.Time) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldEQ(FieldLastSyncedAt, v))
+}
+
+// LastSyncedAtNEQ applies the NEQ predicate on the "last_synced_at" field.
+func LastSyncedAtNEQ(v time.Time) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldNEQ(FieldLastSyncedAt, v))
+}
+
+// LastSyncedAtIn applies the In predicate on the "last_synced_at" field.
+func LastSyncedAtIn(vs ...time.Time) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldIn(FieldLastSyncedAt, vs...))
+}
+
+// LastSyncedAtNotIn applies the NotIn predicate on the "last_synced_at" field.
+func LastSyncedAtNotIn(vs ...time.Time) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldNotIn(FieldLastSyncedAt, vs...))
+}
+
+// LastSyncedAtGT applies the GT predicate on the "last_synced_at" field.
+func LastSyncedAtGT(v time.Time) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldGT(FieldLastSyncedAt, v))
+}
+
+// LastSyncedAtGTE applies the GTE predicate on the "last_synced_at" field.
+func LastSyncedAtGTE(v time.Time) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldGTE(FieldLastSyncedAt, v))
+}
+
+// LastSyncedAtLT applies the LT predicate on the "last_synced_at" field.
+func LastSyncedAtLT(v time.Time) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldLT(FieldLastSyncedAt, v))
+}
+
+// LastSyncedAtLTE applies the LTE predicate on the "last_synced_at" field.
+func LastSyncedAtLTE(v time.Time) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldLTE(FieldLastSyncedAt, v))
+}
+
+// LastSyncedAtIsNil applies the IsNil predicate on the "last_synced_at" field.
+func LastSyncedAtIsNil() predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldIsNull(FieldLastSyncedAt))
+}
+
+// LastSyncedAtNotNil applies the NotNil predicate on the "last_synced_at" field.
+func LastSyncedAtNotNil() predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldNotNull(FieldLastSyncedAt))
+}
+
+// LastSyncMessageEQ applies the EQ predicate on the "last_sync_message" field.
+func LastSyncMessageEQ(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldEQ(FieldLastSyncMessage, v))
+}
+
+// LastSyncMessageNEQ applies the NEQ predicate on the "last_sync_message" field.
+func LastSyncMessageNEQ(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldNEQ(FieldLastSyncMessage, v))
+}
+
+// LastSyncMessageIn applies the In predicate on the "last_sync_message" field.
+func LastSyncMessageIn(vs ...string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldIn(FieldLastSyncMessage, vs...))
+}
+
+// LastSyncMessageNotIn applies the NotIn predicate on the "last_sync_message" field.
+func LastSyncMessageNotIn(vs ...string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldNotIn(FieldLastSyncMessage, vs...))
+}
+
+// LastSyncMessageGT applies the GT predicate on the "last_sync_message" field.
+func LastSyncMessageGT(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldGT(FieldLastSyncMessage, v))
+}
+
+// LastSyncMessageGTE applies the GTE predicate on the "last_sync_message" field.
+func LastSyncMessageGTE(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldGTE(FieldLastSyncMessage, v))
+}
+
+// LastSyncMessageLT applies the LT predicate on the "last_sync_message" field.
+func LastSyncMessageLT(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldLT(FieldLastSyncMessage, v))
+}
+
+// LastSyncMessageLTE applies the LTE predicate on the "last_sync_message" field.
+func LastSyncMessageLTE(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldLTE(FieldLastSyncMessage, v))
+}
+
+// LastSyncMessageContains applies the Contains predicate on the "last_sync_message" field.
+func LastSyncMessageContains(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldContains(FieldLastSyncMessage, v))
+}
+
+// LastSyncMessageHasPrefix applies the HasPrefix predicate on the "last_sync_message" field.
+func LastSyncMessageHasPrefix(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldHasPrefix(FieldLastSyncMessage, v))
+}
+
+// LastSyncMessageHasSuffix applies the HasSuffix predicate on the "last_sync_message" field.
+func LastSyncMessageHasSuffix(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldHasSuffix(FieldLastSyncMessage, v))
+}
+
+// LastSyncMessageIsNil applies the IsNil predicate on the "last_sync_message" field.
+func LastSyncMessageIsNil() predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldIsNull(FieldLastSyncMessage))
+}
+
+// LastSyncMessageNotNil applies the NotNil predicate on the "last_sync_message" field.
+func LastSyncMessageNotNil() predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldNotNull(FieldLastSyncMessage))
+}
+
+// LastSyncMessageEqualFold applies the EqualFold predicate on the "last_sync_message" field.
+func LastSyncMessageEqualFold(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldEqualFold(FieldLastSyncMessage, v))
+}
+
+// LastSyncMessageContainsFold applies the ContainsFold predicate on the "last_sync_message" field.
+func LastSyncMessageContainsFold(v string) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.FieldContainsFold(FieldLastSyncMessage, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.LdapConfig) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.LdapConfig) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.LdapConfig) predicate.LdapConfig {
+	return predicate.LdapConfig(sql.NotPredicates(p))
+}
@@ -0,0 +1,2744 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-wind-admin/app/admin/service/internal/data/ent/ldapconfig"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LdapConfigCreate is the builder for creating a LdapConfig entity.
+type LdapConfigCreate struct {
+	config
+	mutation *LdapConfigMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *LdapConfigCreate) SetCreatedAt(v time.Time) *LdapConfigCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *LdapConfigCreate) SetNillableCreatedAt(v *time.Time) *LdapConfigCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *LdapConfigCreate) SetUpdatedAt(v time.Time) *LdapConfigCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *LdapConfigCreate) SetNillableUpdatedAt(v *time.Time) *LdapConfigCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *LdapConfigCreate) SetDeletedAt(v time.Time) *LdapConfigCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *LdapConfigCreate) SetNillableDeletedAt(v *time.Time) *LdapConfigCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *LdapConfigCreate) SetCreatedBy(v uint32) *LdapConfigCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *LdapConfigCreate) SetNillableCreatedBy(v *uint32) *LdapConfigCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_c *LdapConfigCreate) SetUpdatedBy(v uint32) *LdapConfigCreate {
+	_c.mutation.SetUpdatedBy(v)
+	return _c
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_c *LdapConfigCreate) SetNillableUpdatedBy(v *uint32) *LdapConfigCreate {
+	if v != nil {
+		_c.SetUpdatedBy(*v)
+	}
+	return _c
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_c *LdapConfigCreate) SetDeletedBy(v uint32) *LdapConfigCreate {
+	_c.mutation.SetDeletedBy(v)
+	return _c
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_c *LdapConfigCreate) SetNillableDeletedBy(v *uint32) *LdapConfigCreate {
+	if v != nil {
+		_c.SetDeletedBy(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *LdapConfigCreate) SetTenantID(v uint32) *LdapConfigCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *LdapConfigCreate) SetNillableTenantID(v *uint32) *LdapConfigCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *LdapConfigCreate) SetStatus(v ldapconfig.Status) *LdapConfigCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *LdapConfigCreate) SetNillableStatus(v *ldapconfig.Status) *LdapConfigCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetDisplayName sets the "display_name" field.
+func (_c *LdapConfigCreate) SetDisplayName(v string) *LdapConfigCreate {
+	_c.mutation.SetDisplayName(v)
+	return _c
+}
+
+// SetNillableDisplayName sets the "display_name" field if the given value is not nil.
+func (_c *LdapConfigCreate) SetNillableDisplayName(v *string) *LdapConfigCreate {
+	if v != nil {
+		_c.SetDisplayName(*v)
+	}
+	return _c
+}
+
+// SetServerURL sets the "server_url" field.
+func (_c *LdapConfigCreate) SetServerURL(v string) *LdapConfigCreate {
+	_c.mutation.SetServerURL(v)
+	return _c
+}
+
+// SetNillableServerURL sets the "server_url" field if the given value is not nil.
+func (_c *LdapConfigCreate) SetNillableServerURL(v *string) *LdapConfigCreate {
+	if v != nil {
+		_c.SetServerURL(*v)
+	}
+	return _c
+}
+
+// SetStartTLS sets the "start_tls" field.
+func (_c *LdapConfigCreate) SetStartTLS(v bool) *LdapConfigCreate {
+	_c.mutation.SetStartTLS(v)
+	return _c
+}
+
+// SetNillableStartTLS sets the "start_tls" field if the given value is not nil.
+func (_c *LdapConfigCreate) SetNillableStartTLS(v *bool) *LdapConfigCreate {
+	if v != nil {
+		_c.SetStartTLS(*v)
+	}
+	return _c
+}
+
+// SetCaCertificate sets the "ca_certificate" field.
+func (_c *LdapConfigCreate) SetCaCertificate(v string) *LdapConfigCreate {
+	_c.mutation.SetCaCertificate(v)
+	return _c
+}
+
+// SetNillableCaCertificate sets the "ca_certificate" field if the given value is not nil.
+func (_c *LdapConfigCreate) SetNillableCaCertificate(v *string) *LdapConfigCreate {
+	if v != nil {
+		_c.SetCaCertificate(*v)
+	}
+	return _c
+}
+
+// SetBindDn sets the "bind_dn" field.
+func (_c *LdapConfigCreate) SetBindDn(v string) *LdapConfigCreate {
+	_c.mutation.SetBindDn(v)
+	return _c
+}
+
+// SetNillableBindDn sets the "bind_dn" field if the given value is not nil.
+func (_c *LdapConfigCreate) SetNillableBindDn(v *string) *LdapConfigCreate {
+	if v != nil {
+		_c.SetBindDn(*v)
+	}
+	return _c
+}
+
+// SetBindPassword sets the "bind_password" field.
+func (_c *LdapConfigCreate) SetBindPassword(v string) *LdapConfigCreate {
+	_c.mutation.SetBindPassword(v)
+	return _c
+}
+
+// SetNillableBindPassword sets the "bind_password" field if the given value is not nil.
+func (_c *LdapConfigCreate) SetNillableBindPassword(v *string) *LdapConfigCreate {
+	if v != nil {
+		_c.SetBindPassword(*v)
+	}
+	return _c
+}
+
+// SetBaseDn sets the "base_dn" field.
+func (_c *LdapConfigCreate) SetBaseDn(v string) *LdapConfigCreate {
+	_c.mutation.SetBaseDn(v)
+	return _c
+}
+
+// SetNillableBaseDn sets the "base_dn" field if the given value is not nil.
+func (_c *LdapConfigCreate) SetNillableBaseDn(v *string) *LdapConfigCreate {
+	if v != nil {
+		_c.SetBaseDn(*v)
+	}
+	return _c
+}
+
+// SetUserFilter sets the "user_filter" field.
+func (_c *LdapConfigCreate) SetUserFilter(v string) *LdapConfigCreate {
+	_c.mutation.SetUserFilter(v)
+	return _c
+}
+
+// SetNillableUserFilter sets the "user_filter" field if the given value is not nil.
+func (_c *LdapConfigCreate) SetNillableUserFilter(v *string) *LdapConfigCreate {
+	if v != nil {
+		_c.SetUserFilter(*v)
+	}
+	return _c
+}
+
+// SetIDAttribute sets the "id_attribute" field.
+func (_c *LdapConfigCreate) SetIDAttribute(v string) *LdapConfigCreate {
+	_c.mutation.SetIDAttribute(v)
+	return _c
+}
+
+// SetNillableIDAttribute sets the "id_attribute" field if the given value is not nil.
+func (_c *LdapConfigCreate) SetNillableIDAttribute(v *string) *LdapConfigCreate {
+	if v != nil {
+		_c.SetIDAttribute(*v)
+	}
+	return _c
+}
+
+// SetAttributeMapping sets the "attribute_mapping" field.
+func (_c *LdapConfigCreate) SetAttributeMapping(v map[string]string) *LdapConfigCreate {
+	_c.mutation.SetAttributeMapping(v)
+	return _c
+}
+
+// SetGroupFilter sets the "group_filter" field.
+func (_c *LdapConfigCreate) SetGroupFilter(v string) *LdapConfigCreate {
+	_c.mutation.SetGroupFilter(v)
+	return _c
+}
+
+// SetNillableGroupFilter sets the "group_filter" field if the given value is not nil.
+func (_c *LdapConfigCreate) SetNillableGroupFilter(v *string) *LdapConfigCreate {
+	if v != nil {
+		_c.SetGroupFilter(*v)
+	}
+	return _c
+}
+
+// SetGroupMemberAttribute sets the "group_member_attribute" field.
+func (_c *LdapConfigCreate) SetGroupMemberAttribute(v string) *LdapConfigCreate {
+	_c.mutation.SetGroupMemberAttribute(v)
+	return _c
+}
+
+// SetNillableGroupMemberAttribute sets the "group_member_attribute" field if the given value is not nil.
+func (_c *LdapConfigCreate) SetNillableGroupMemberAttribute(v *string) *LdapConfigCreate {
+	if v != nil {
+		_c.SetGroupMemberAttribute(*v)
+	}
+	return _c
+}
+
+// SetGroupRoleMapping sets the "group_role_mapping" field.
+func (_c *LdapConfigCreate) SetGroupRoleMapping(v map[string]uint32) *LdapConfigCreate {
+	_c.mutation.SetGroupRoleMapping(v)
+	return _c
+}
+
+// SetOrgUnitFilter sets the "org_unit_filter" field.
+func (_c *LdapConfigCreate) SetOrgUnitFilter(v string) *LdapConfigCreate {
+	_c.mutation.SetOrgUnitFilter(v)
+	return _c
+}
+
+// SetNillableOrgUnitFilter sets the "org_unit_filter" field if the given value is not nil.
+func (_c *LdapConfigCreate) SetNillableOrgUnitFilter(v *string) *LdapConfigCreate {
+	if v != nil {
+		_c.SetOrgUnitFilter(*v)
+	}
+	return _c
+}
+
+// SetOrgUnitRootID sets the "org_unit_root_id" field.
+func (_c *LdapConfigCreate) SetOrgUnitRootID(v uint32) *LdapConfigCreate {
+	_c.mutation.SetOrgUnitRootID(v)
+	return _c
+}
+
+// SetNillableOrgUnitRootID sets the "org_unit_root_id" field if the given value is not nil.
+func (_c *LdapConfigCreate) SetNillableOrgUnitRootID(v *uint32) *LdapConfigCreate {
+	if v != nil {
+		_c.SetOrgUnitRootID(*v)
+	}
+	return _c
+}
+
+// SetAuthMode sets the "auth_mode" field.
+func (_c *LdapConfigCreate) SetAuthMode(v ldapconfig.AuthMode) *LdapConfigCreate {
+	_c.mutation.SetAuthMode(v)
+	return _c
+}
+
+// SetNillableAuthMode sets the "auth_mode" field if the given value is not nil.
+func (_c *LdapConfigCreate) SetNillableAuthMode(v *ldapconfig.AuthMode) *LdapConfigCreate {
+	if v != nil {
+		_c.SetAuthMode(*v)
+	}
+	return _c
+}
+
+// SetJitProvisioning sets the "jit_provisioning" field.
+func (_c *LdapConfigCreate) SetJitProvisioning(v bool) *LdapConfigCreate {
+	_c.mutation.SetJitProvisioning(v)
+	return _c
+}
+
+// SetNillableJitProvisioning sets the "jit_provisioning" field if the given value is not nil.
+func (_c *LdapConfigCreate) SetNillableJitProvisioning(v *bool) *LdapConfigCreate {
+	if v != nil {
+		_c.SetJitProvisioning(*v)
+	}
+	return _c
+}
+
+// SetDefaultRoleIds sets the "default_role_ids" field.
+func (_c *LdapConfigCreate) SetDefaultRoleIds(v []uint32) *LdapConfigCreate {
+	_c.mutation.SetDefaultRoleIds(v)
+	return _c
+}
+
+// SetDefaultOrgUnitID sets the "default_org_unit_id" field.
+func (_c *LdapConfigCreate) SetDefaultOrgUnitID(v uint32) *LdapConfigCreate {
+	_c.mutation.SetDefaultOrgUnitID(v)
+	return _c
+}
+
+// SetNillableDefaultOrgUnitID sets the "default_org_unit_id" field if the given value is not nil.
+func (_c *LdapConfigCreate) SetNillableDefaultOrgUnitID(v *uint32) *LdapConfigCreate {
+	if v != nil {
+		_c.SetDefaultOrgUnitID(*v)
+	}
+	return _c
+}
+
+// SetLastSyncedAt sets the "last_synced_at" field.
+func (_c *LdapConfigCreate) SetLastSyncedAt(v time.Time) *LdapConfigCreate {
+	_c.mutation.SetLastSyncedAt(v)
+	return _c
+}
+
+// SetNillableLastSyncedAt sets the "last_synced_at" field if the given value is not nil.
+func (_c *LdapConfigCreate) SetNillableLastSyncedAt(v *time.Time) *LdapConfigCreate {
+	if v != nil {
+		_c.SetLastSyncedAt(*v)
+	}
+	return _c
+}
+
+// SetLastSyncMessage sets the "last_sync_message" field.
+func (_c *LdapConfigCreate) SetLastSyncMessage(v string) *LdapConfigCreate {
+	_c.mutation.SetLastSyncMessage(v)
+	return _c
+}
+
+// SetNillableLastSyncMessage sets the "last_sync_message" field if the given value is not nil.
+func (_c *LdapConfigCreate) SetNillableLastSyncMessage(v *string) *LdapConfigCreate {
+	if v != nil {
+		_c.SetLastSyncMessage(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *LdapConfigCreate) SetID(v uint32) *LdapConfigCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the LdapConfigMutation object of the builder.
+func (_c *LdapConfigCreate) Mutation() *LdapConfigMutation {
+	return _c.mutation
+}
+
+// Save creates the LdapConfig in the database.
+func (_c *LdapConfigCreate) Save(ctx context.Context) (*LdapConfig, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *LdapConfigCreate) SaveX(ctx context.Context) *LdapConfig {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LdapConfigCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LdapConfigCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *LdapConfigCreate) defaults() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := ldapconfig.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := ldapconfig.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.StartTLS(); !ok {
+		v := ldapconfig.DefaultStartTLS
+		_c.mutation.SetStartTLS(v)
+	}
+	if _, ok := _c.mutation.AuthMode(); !ok {
+		v := ldapconfig.DefaultAuthMode
+		_c.mutation.SetAuthMode(v)
+	}
+	if _, ok := _c.mutation.JitProvisioning(); !ok {
+		v := ldapconfig.DefaultJitProvisioning
+		_c.mutation.SetJitProvisioning(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *LdapConfigCreate) check() error {
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "LdapConfig.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := ldapconfig.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "LdapConfig.status": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.DisplayName(); ok {
+		if err := ldapconfig.DisplayNameValidator(v); err != nil {
+			return &ValidationError{Name: "display_name", err: fmt.Errorf(`ent: validator failed for field "LdapConfig.display_name": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ServerURL(); ok {
+		if err := ldapconfig.ServerURLValidator(v); err != nil {
+			return &ValidationError{Name: "server_url", err: fmt.Errorf(`ent: validator failed for field "LdapConfig.server_url": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.BindDn(); ok {
+		if err := ldapconfig.BindDnValidator(v); err != nil {
+			return &ValidationError{Name: "bind_dn", err: fmt.Errorf(`ent: validator failed for field "LdapConfig.bind_dn": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.BindPassword(); ok {
+		if err := ldapconfig.BindPasswordValidator(v); err != nil {
+			return &ValidationError{Name: "bind_password", err: fmt.Errorf(`ent: validator failed for field "LdapConfig.bind_password": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.BaseDn(); ok {
+		if err := ldapconfig.BaseDnValidator(v); err != nil {
+			return &ValidationError{Name: "base_dn", err: fmt.Errorf(`ent: validator failed for field "LdapConfig.base_dn": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.UserFilter(); ok {
+		if err := ldapconfig.UserFilterValidator(v); err != nil {
+			return &ValidationError{Name: "user_filter", err: fmt.Errorf(`ent: validator failed for field "LdapConfig.user_filter": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.IDAttribute(); ok {
+		if err := ldapconfig.IDAttributeValidator(v); err != nil {
+			return &ValidationError{Name: "id_attribute", err: fmt.Errorf(`ent: validator failed for field "LdapConfig.id_attribute": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.GroupFilter(); ok {
+		if err := ldapconfig.GroupFilterValidator(v); err != nil {
+			return &ValidationError{Name: "group_filter", err: fmt.Errorf(`ent: validator failed for field "LdapConfig.group_filter": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.GroupMemberAttribute(); ok {
+		if err := ldapconfig.GroupMemberAttributeValidator(v); err != nil {
+			return &ValidationError{Name: "group_member_attribute", err: fmt.Errorf(`ent: validator failed for field "LdapConfig.group_member_attribute": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.OrgUnitFilter(); ok {
+		if err := ldapconfig.OrgUnitFilterValidator(v); err != nil {
+			return &ValidationError{Name: "org_unit_filter", err: fmt.Errorf(`ent: validator failed for field "LdapConfig.org_unit_filter": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.AuthMode(); ok {
+		if err := ldapconfig.AuthModeValidator(v); err != nil {
+			return &ValidationError{Name: "auth_mode", err: fmt.Errorf(`ent: validator failed for field "LdapConfig.auth_mode": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.LastSyncMessage(); ok {
+		if err := ldapconfig.LastSyncMessageValidator(v); err != nil {
+			return &ValidationError{Name: "last_sync_message", err: fmt.Errorf(`ent: validator failed for field "LdapConfig.last_sync_message": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := ldapconfig.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "LdapConfig.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *LdapConfigCreate) sqlSave(ctx context.Context) (*LdapConfig, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint32(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *LdapConfigCreate) createSpec() (*LdapConfig, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LdapConfig{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(ldapconfig.Table, sqlgraph.NewFieldSpec(ldapconfig.FieldID, field.TypeUint32))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(ldapconfig.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = &value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(ldapconfig.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = &value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(ldapconfig.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(ldapconfig.FieldCreatedBy, field.TypeUint32, value)
+		_node.CreatedBy = &value
+	}
+	if value, ok := _c.mutation.UpdatedBy(); ok {
+		_spec.SetField(ldapconfig.FieldUpdatedBy, field.TypeUint32, value)
+		_node.UpdatedBy = &value
+	}
+	if value, ok := _c.mutation.DeletedBy(); ok {
+		_spec.SetField(ldapconfig.FieldDeletedBy, field.TypeUint32, value)
+		_node.DeletedBy = &value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(ldapconfig.FieldTenantID, field.TypeUint32, value)
+		_node.TenantID = &value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(ldapconfig.FieldStatus, field.TypeEnum, value)
+		_node.Status = &value
+	}
+	if value, ok := _c.mutation.DisplayName(); ok {
+		_spec.SetField(ldapconfig.FieldDisplayName, field.TypeString, value)
+		_node.DisplayName = &value
+	}
+	if value, ok := _c.mutation.ServerURL(); ok {
+		_spec.SetField(ldapconfig.FieldServerURL, field.TypeString, value)
+		_node.ServerURL = &value
+	}
+	if value, ok := _c.mutation.StartTLS(); ok {
+		_spec.SetField(ldapconfig.FieldStartTLS, field.TypeBool, value)
+		_node.StartTLS = &value
+	}
+	if value, ok := _c.mutation.CaCertificate(); ok {
+		_spec.SetField(ldapconfig.FieldCaCertificate, field.TypeString, value)
+		_node.CaCertificate = &value
+	}
+	if value, ok := _c.mutation.BindDn(); ok {
+		_spec.SetField(ldapconfig.FieldBindDn, field.TypeString, value)
+		_node.BindDn = &value
+	}
+	if value, ok := _c.mutation.BindPassword(); ok {
+		_spec.SetField(ldapconfig.FieldBindPassword, field.TypeString, value)
+		_node.BindPassword = &value
+	}
+	if value, ok := _c.mutation.BaseDn(); ok {
+		_spec.SetField(ldapconfig.FieldBaseDn, field.TypeString, value)
+		_node.BaseDn = &value
+	}
+	if value, ok := _c.mutation.UserFilter(); ok {
+		_spec.SetField(ldapconfig.FieldUserFilter, field.TypeString, value)
+		_node.UserFilter = &value
+	}
+	if value, ok := _c.mutation.IDAttribute(); ok {
+		_spec.SetField(ldapconfig.FieldIDAttribute, field.TypeString, value)
+		_node.IDAttribute = &value
+	}
+	if value, ok := _c.mutation.AttributeMapping(); ok {
+		_spec.SetField(ldapconfig.FieldAttributeMapping, field.TypeJSON, value)
+		_node.AttributeMapping = value
+	}
+	if value, ok := _c.mutation.GroupFilter(); ok {
+		_spec.SetField(ldapconfig.FieldGroupFilter, field.TypeString, value)
+		_node.GroupFilter = &value
+	}
+	if value, ok := _c.mutation.GroupMemberAttribute(); ok {
+		_spec.SetField(ldapconfig.FieldGroupMemberAttribute, field.TypeString, value)
+		_node.GroupMemberAttribute = &value
+	}
+	if value, ok := _c.mutation.GroupRoleMapping(); ok {
+		_spec.SetField(ldapconfig.FieldGroupRoleMapping, field.TypeJSON, value)
+		_node.GroupRoleMapping = value
+	}
+	if value, ok := _c.mutation.OrgUnitFilter(); ok {
+		_spec.SetField(ldapconfig.FieldOrgUnitFilter, field.TypeString, value)
+		_node.OrgUnitFilter = &value
+	}
+	if value, ok := _c.mutation.OrgUnitRootID(); ok {
+		_spec.SetField(ldapconfig.FieldOrgUnitRootID, field.TypeUint32, value)
+		_node.OrgUnitRootID = &value
+	}
+	if value, ok := _c.mutation.AuthMode(); ok {
+		_spec.SetField(ldapconfig.FieldAuthMode, field.TypeEnum, value)
+		_node.AuthMode = &value
+	}
+	if value, ok := _c.mutation.JitProvisioning(); ok {
+		_spec.SetField(ldapconfig.FieldJitProvisioning, field.TypeBool, value)
+		_node.JitProvisioning = &value
+	}
+	if value, ok := _c.mutation.DefaultRoleIds(); ok {
+		_spec.SetField(ldapconfig.FieldDefaultRoleIds, field.TypeJSON, value)
+		_node.DefaultRoleIds = value
+	}
+	if value, ok := _c.mutation.DefaultOrgUnitID(); ok {
+		_spec.SetField(ldapconfig.FieldDefaultOrgUnitID, field.TypeUint32, value)
+		_node.DefaultOrgUnitID = &value
+	}
+	if value, ok := _c.mutation.LastSyncedAt(); ok {
+		_spec.SetField(ldapconfig.FieldLastSyncedAt, field.TypeTime, value)
+		_node.LastSyncedAt = &value
+	}
+	if value, ok := _c.mutation.LastSyncMessage(); ok {
+		_spec.SetField(ldapconfig.FieldLastSyncMessage, field.TypeString, value)
+		_node.LastSyncMessage = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.LdapConfig.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LdapConfigUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *LdapConfigCreate) OnConflict(opts ...sql.ConflictOption) *LdapConfigUpsertOne {
+	_c.conflict = opts
+	return &LdapConfigUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.LdapConfig.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *LdapConfigCreate) OnConflictColumns(columns ...string) *LdapConfigUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &LdapConfigUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// LdapConfigUpsertOne is the builder for "upsert"-ing
+	//  one LdapConfig node.
+	LdapConfigUpsertOne struct {
+		create *LdapConfigCreate
+	}
+
+	// LdapConfigUpsert is the "OnConflict" setter.
+	LdapConfigUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *LdapConfigUpsert) SetUpdatedAt(v time.Time) *LdapConfigUpsert {
+	u.Set(ldapconfig.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *LdapConfigUpsert) UpdateUpdatedAt() *LdapConfigUpsert {
+	u.SetExcluded(ldapconfig.FieldUpdatedAt)
+	return u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *LdapConfigUpsert) ClearUpdatedAt() *LdapConfigUpsert {
+	u.SetNull(ldapconfig.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *LdapConfigUpsert) SetDeletedAt(v time.Time) *LdapConfigUpsert {
+	u.Set(ldapconfig.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *LdapConfigUpsert) UpdateDeletedAt() *LdapConfigUpsert {
+	u.SetExcluded(ldapconfig.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *LdapConfigUpsert) ClearDeletedAt() *LdapConfigUpsert {
+	u.SetNull(ldapconfig.FieldDeletedAt)
+	return u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *LdapConfigUpsert) SetCreatedBy(v uint32) *LdapConfigUpsert {
+	u.Set(ldapconfig.FieldCreatedBy, v)
+	return u
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *LdapConfigUpsert) UpdateCreatedBy() *LdapConfigUpsert {
+	u.SetExcluded(ldapconfig.FieldCreatedBy)
+	return u
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *LdapConfigUpsert) AddCreatedBy(v uint32) *LdapConfigUpsert {
+	u.Add(ldapconfig.FieldCreatedBy, v)
+	return u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *LdapConfigUpsert) ClearCreatedBy() *LdapConfigUpsert {
+	u.SetNull(ldapconfig.FieldCreatedBy)
+	return u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *LdapConfigUpsert) SetUpdatedBy(v uint32) *LdapConfigUpsert {
+	u.Set(ldapconfig.FieldUpdatedBy, v)
+	return u
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *LdapConfigUpsert) UpdateUpdatedBy() *LdapConfigUpsert {
+	u.SetExcluded(ldapconfig.FieldUpdatedBy)
+	return u
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *LdapConfigUpsert) AddUpdatedBy(v uint32) *LdapConfigUpsert {
+	u.Add(ldapconfig.FieldUpdatedBy, v)
+	return u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *LdapConfigUpsert) ClearUpdatedBy() *LdapConfigUpsert {
+	u.SetNull(ldapconfig.FieldUpdatedBy)
+	return u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *LdapConfigUpsert) SetDeletedBy(v uint32) *LdapConfigUpsert {
+	u.Set(ldapconfig.FieldDeletedBy, v)
+	return u
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *LdapConfigUpsert) UpdateDeletedBy() *LdapConfigUpsert {
+	u.SetExcluded(ldapconfig.FieldDeletedBy)
+	return u
+}
+
+// AddDeletedBy adds v to the "deleted_by" field.
+func (u *LdapConfigUpsert) AddDeletedBy(v uint32) *LdapConfigUpsert {
+	u.Add(ldapconfig.FieldDeletedBy, v)
+	return u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *LdapConfigUpsert) ClearDeletedBy() *LdapConfigUpsert {
+	u.SetNull(ldapconfig.FieldDeletedBy)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *LdapConfigUpsert) SetStatus(v ldapconfig.Status) *LdapConfigUpsert {
+	u.Set(ldapconfig.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *LdapConfigUpsert) UpdateStatus() *LdapConfigUpsert {
+	u.SetExcluded(ldapconfig.FieldStatus)
+	return u
+}
+
+// SetDisplayName sets the "display_name" field.
+func (u *LdapConfigUpsert) SetDisplayName(v string) *LdapConfigUpsert {
+	u.Set(ldapconfig.FieldDisplayName, v)
+	return u
+}
+
+// UpdateDisplayName sets the "display_name" field to the value that was provided on create.
+func (u *LdapConfigUpsert) UpdateDisplayName() *LdapConfigUpsert {
+	u.SetExcluded(ldapconfig.FieldDisplayName)
+	return u
+}
+
+// ClearDisplayName clears the value of the "display_name" field.
+func (u *LdapConfigUpsert) ClearDisplayName() *LdapConfigUpsert {
+	u.SetNull(ldapconfig.FieldDisplayName)
+	return u
+}
+
+// SetServerURL sets the "server_url" field.
+func (u *LdapConfigUpsert) SetServerURL(v string) *LdapConfigUpsert {
+	u.Set(ldapconfig.FieldServerURL, v)
+	return u
+}
+
+// UpdateServerURL sets the "server_url" field to the value that was provided on create.
+func (u *LdapConfigUpsert) UpdateServerURL() *LdapConfigUpsert {
+	u.SetExcluded(ldapconfig.FieldServerURL)
+	return u
+}
+
+// ClearServerURL clears the value of the "server_url" field.
+func (u *LdapConfigUpsert) ClearServerURL() *LdapConfigUpsert {
+	u.SetNull(ldapconfig.FieldServerURL)
+	return u
+}
+
+// SetStartTLS sets the "start_tls" field.
+func (u *LdapConfigUpsert) SetStartTLS(v bool) *LdapConfigUpsert {
+	u.Set(ldapconfig.FieldStartTLS, v)
+	return u
+}
+
+// UpdateStartTLS sets the "start_tls" field to the value that was provided on create.
+func (u *LdapConfigUpsert) UpdateStartTLS() *LdapConfigUpsert {
+	u.SetExcluded(ldapconfig.FieldStartTLS)
+	return u
+}
+
+// ClearStartTLS clears the value of the "start_tls" field.
+func (u *LdapConfigUpsert) ClearStartTLS() *LdapConfigUpsert {
+	u.SetNull(ldapconfig.FieldStartTLS)
+	return u
+}
+
+// SetCaCertificate sets the "ca_certificate" field.
+func (u *LdapConfigUpsert) SetCaCertificate(v string) *LdapConfigUpsert {
+	u.Set(ldapconfig.FieldCaCertificate, v)
+	return u
+}
+
+// UpdateCaCertificate sets the "ca_certificate" field to the value that was provided on create.
+func (u *LdapConfigUpsert) UpdateCaCertificate() *LdapConfigUpsert {
+	u.SetExcluded(ldapconfig.FieldCaCertificate)
+	return u
+}
+
+// ClearCaCertificate clears the value of the "ca_certificate" field.
+func (u *LdapConfigUpsert) ClearCaCertificate() *LdapConfigUpsert {
+	u.SetNull(ldapconfig.FieldCaCertificate)
+	return u
+}
+
+// SetBindDn sets the "bind_dn" field.
+func (u *LdapConfigUpsert) SetBindDn(v string) *LdapConfigUpsert {
+	u.Set(ldapconfig.FieldBindDn, v)
+	return u
+}
+
+// UpdateBindDn sets the "bind_dn" field to the value that was provided on create.
+func (u *LdapConfigUpsert) UpdateBindDn() *LdapConfigUpsert {
+	u.SetExcluded(ldapconfig.FieldBindDn)
+	return u
+}
+
+// ClearBindDn clears the value of the "bind_dn" field.
+func (u *LdapConfigUpsert) ClearBindDn() *LdapConfigUpsert {
+	u.SetNull(ldapconfig.FieldBindDn)
+	return u
+}
+
+// SetBindPassword sets the "bind_password" field.
+func (u *LdapConfigUpsert) SetBindPassword(v string) *LdapConfigUpsert {
+	u.Set(ldapconfig.FieldBindPassword, v)
+	return u
+}
+
+// UpdateBindPassword sets the "bind_password" field to the value that was provided on create.
+func (u *LdapConfigUpsert) UpdateBindPassword() *LdapConfigUpsert {
+	u.SetExcluded(ldapconfig.FieldBindPassword)
+	return u
+}
+
+// ClearBindPassword clears the value of the "bind_password" field.
+func (u *LdapConfigUpsert) ClearBindPassword() *LdapConfigUpsert {
+	u.SetNull(ldapconfig.FieldBindPassword)
+	return u
+}
+
+// SetBaseDn sets the "base_dn" field.
+func (u *LdapConfigUpsert) SetBaseDn(v string) *LdapConfigUpsert {
+	u.Set(ldapconfig.FieldBaseDn, v)
+	return u
+}
+
+// UpdateBaseDn sets the "base_dn" field to the value that was provided on create.
+func (u *LdapConfigUpsert) UpdateBaseDn() *LdapConfigUpsert {
+	u.SetExcluded(ldapconfig.FieldBaseDn)
+	return u
+}
+
+// ClearBaseDn clears the value of the "base_dn" field.
+func (u *LdapConfigUpsert) ClearBaseDn() *LdapConfigUpsert {
+	u.SetNull(ldapconfig.FieldBaseDn)
+	return u
+}
+
+// SetUserFilter sets the "user_filter" field.
+func (u *LdapConfigUpsert) SetUserFilter(v string) *LdapConfigUpsert {
+	u.Set(ldapconfig.FieldUserFilter, v)
+	return u
+}
+
+// UpdateUserFilter sets the "user_filter" field to the value that was provided on create.
+func (u *LdapConfigUpsert) UpdateUserFilter() *LdapConfigUpsert {
+	u.SetExcluded(ldapconfig.FieldUserFilter)
+	return u
+}
+
+// ClearUserFilter clears the value of the "user_filter" field.
+func (u *LdapConfigUpsert) ClearUserFilter() *LdapConfigUpsert {
+	u.SetNull(ldapconfig.FieldUserFilter)
+	return u
+}
+
+// SetIDAttribute sets the "id_attribute" field.
+func (u *LdapConfigUpsert) SetIDAttribute(v string) *LdapConfigUpsert {
+	u.Set(ldapconfig.FieldIDAttribute, v)
+	return u
+}
+
+// UpdateIDAttribute sets the "id_attribute" field to the value that was provided on create.
+func (u *LdapConfigUpsert) UpdateIDAttribute() *LdapConfigUpsert {
+	u.SetExcluded(ldapconfig.FieldIDAttribute)
+	return u
+}
+
+// ClearIDAttribute clears the value of the "id_attribute" field.
+func (u *LdapConfigUpsert) ClearIDAttribute() *LdapConfigUpsert {
+	u.SetNull(ldapconfig.FieldIDAttribute)
+	return u
+}
+
+// SetAttributeMapping sets the "attribute_mapping" field.
+func (u *LdapConfigUpsert) SetAttributeMapping(v map[string]string) *LdapConfigUpsert {
+	u.Set(ldapconfig.FieldAttributeMapping, v)
+	return u
+}
+
+// UpdateAttributeMapping sets the "attribute_mapping" field to the value that was provided on create.
+func (u *LdapConfigUpsert) UpdateAttributeMapping() *LdapConfigUpsert {
+	u.SetExcluded(ldapconfig.FieldAttributeMapping)
+	return u
+}
+
+// ClearAttributeMapping clears the value of the "attribute_mapping" field.
+func (u *LdapConfigUpsert) ClearAttributeMapping() *LdapConfigUpsert {
+	u.SetNull(ldapconfig.FieldAttributeMapping)
+	return u
+}
+
+// SetGroupFilter sets the "group_filter" field.
+func (u *LdapConfigUpsert) SetGroupFilter(v string) *LdapConfigUpsert {
+	u.Set(ldapconfig.FieldGroupFilter, v)
+	return u
+}
+
+// UpdateGroupFilter sets the "group_filter" field to the value that was provided on create.
+func (u *LdapConfigUpsert) UpdateGroupFilter() *LdapConfigUpsert {
+	u.SetExcluded(ldapconfig.FieldGroupFilter)
+	return u
+}
+
+// ClearGroupFilter clears the value of the "group_filter" field.
+func (u *LdapConfigUpsert) ClearGroupFilter() *LdapConfigUpsert {
+	u.SetNull(ldapconfig.FieldGroupFilter)
+	return u
+}
+
+// SetGroupMemberAttribute sets the "group_member_attribute" field.
+func (u *LdapConfigUpsert) SetGroupMemberAttribute(v string) *LdapConfigUpsert {
+	u.Set(ldapconfig.FieldGroupMemberAttribute, v)
+	return u
+}
+
+// UpdateGroupMemberAttribute sets the "group_member_attribute" field to the value that was provided on create.
+func (u *LdapConfigUpsert) UpdateGroupMemberAttribute() *LdapConfigUpsert {
+	u.SetExcluded(ldapconfig.FieldGroupMemberAttribute)
+	return u
+}
+
+// ClearGroupMemberAttribute clears the value of the "group_member_attribute" field.
+func (u *LdapConfigUpsert) ClearGroupMemberAttribute() *LdapConfigUpsert {
+	u.SetNull(ldapconfig.FieldGroupMemberAttribute)
+	return u
+}
+
+// SetGroupRoleMapping sets the "group_role_mapping" field.
+func (u *LdapConfigUpsert) SetGroupRoleMapping(v map[string]uint32) *LdapConfigUpsert {
+	u.Set(ldapconfig.FieldGroupRoleMapping, v)
+	return u
+}
+
+// UpdateGroupRoleMapping sets the "group_role_mapping" field to the value that was provided on create.
+func (u *LdapConfigUpsert) UpdateGroupRoleMapping() *LdapConfigUpsert {
+	u.SetExcluded(ldapconfig.FieldGroupRoleMapping)
+	return u
+}
+
+// ClearGroupRoleMapping clears the value of the "group_role_mapping" field.
+func (u *LdapConfigUpsert) ClearGroupRoleMapping() *LdapConfigUpsert {
+	u.SetNull(ldapconfig.FieldGroupRoleMapping)
+	return u
+}
+
+// SetOrgUnitFilter sets the "org_unit_filter" field.
+func (u *LdapConfigUpsert) SetOrgUnitFilter(v string) *LdapConfigUpsert {
+	u.Set(ldapconfig.FieldOrgUnitFilter, v)
+	return u
+}
+
+// UpdateOrgUnitFilter sets the "org_unit_filter" field to the value that was provided on create.
+func (u *LdapConfigUpsert) UpdateOrgUnitFilter() *LdapConfigUpsert {
+	u.SetExcluded(ldapconfig.FieldOrgUnitFilter)
+	return u
+}
+
+// ClearOrgUnitFilter clears the value of the "org_unit_filter" field.
+func (u *LdapConfigUpsert) ClearOrgUnitFilter() *LdapConfigUpsert {
+	u.SetNull(ldapconfig.FieldOrgUnitFilter)
+	return u
+}
+
+// SetOrgUnitRootID sets the "org_unit_root_id" field.
+func (u *LdapConfigUpsert) SetOrgUnitRootID(v uint32) *LdapConfigUpsert {
+	u.Set(ldapconfig.FieldOrgUnitRootID, v)
+	return u
+}
+
+// UpdateOrgUnitRootID sets the "org_unit_root_id" field to the value that was provided on create.
+func (u *LdapConfigUpsert) UpdateOrgUnitRootID() *LdapConfigUpsert {
+	u.SetExcluded(ldapconfig.FieldOrgUnitRootID)
+	return u
+}
+
+// AddOrgUnitRootID adds v to the "org_unit_root_id" field.
+func (u *LdapConfigUpsert) AddOrgUnitRootID(v uint32) *LdapConfigUpsert {
+	u.Add(ldapconfig.FieldOrgUnitRootID, v)
+	return u
+}
+
+// ClearOrgUnitRootID clears the value of the "org_unit_root_id" field.
+func (u *LdapConfigUpsert) ClearOrgUnitRootID() *LdapConfigUpsert {
+	u.SetNull(ldapconfig.FieldOrgUnitRootID)
+	return u
+}
+
+// SetAuthMode sets the "auth_mode" field.
+func (u *LdapConfigUpsert) SetAuthMode(v ldapconfig.AuthMode) *LdapConfigUpsert {
+	u.Set(ldapconfig.FieldAuthMode, v)
+	return u
+}
+
+// UpdateAuthMode sets the "auth_mode" field to the value that was provided on create.
+func (u *LdapConfigUpsert) UpdateAuthMode() *LdapConfigUpsert {
+	u.SetExcluded(ldapconfig.FieldAuthMode)
+	return u
+}
+
+// ClearAuthMode clears the value of the "auth_mode" field.
+func (u *LdapConfigUpsert) ClearAuthMode() *LdapConfigUpsert {
+	u.SetNull(ldapconfig.FieldAuthMode)
+	return u
+}
+
+// SetJitProvisioning sets the "jit_provisioning" field.
+func (u *LdapConfigUpsert) SetJitProvisioning(v bool) *LdapConfigUpsert {
+	u.Set(ldapconfig.FieldJitProvisioning, v)
+	return u
+}
+
+// UpdateJitProvisioning sets the "jit_provisioning" field to the value that was provided on create.
+func (u *LdapConfigUpsert) UpdateJitProvisioning() *LdapConfigUpsert {
+	u.SetExcluded(ldapconfig.FieldJitProvisioning)
+	return u
+}
+
+// ClearJitProvisioning clears the value of the "jit_provisioning" field.
+func (u *LdapConfigUpsert) ClearJitProvisioning() *LdapConfigUpsert {
+	u.SetNull(ldapconfig.FieldJitProvisioning)
+	return u
+}
+
+// SetDefaultRoleIds sets the "default_role_ids" field.
+func (u *LdapConfigUpsert) SetDefaultRoleIds(v []uint32) *LdapConfigUpsert {
+	u.Set(ldapconfig.FieldDefaultRoleIds, v)
+	return u
+}
+
+// UpdateDefaultRoleIds sets the "default_role_ids" field to the value that was provided on create.
+func (u *LdapConfigUpsert) UpdateDefaultRoleIds() *LdapConfigUpsert {
+	u.SetExcluded(ldapconfig.FieldDefaultRoleIds)
+	return u
+}
+
+// ClearDefaultRoleIds clears the value of the "default_role_ids" field.
+func (u *LdapConfigUpsert) ClearDefaultRoleIds() *LdapConfigUpsert {
+	u.SetNull(ldapconfig.FieldDefaultRoleIds)
+	return u
+}
+
+// SetDefaultOrgUnitID sets the "default_org_unit_id" field.
+func (u *LdapConfigUpsert) SetDefaultOrgUnitID(v uint32) *LdapConfigUpsert {
+	u.Set(ldapconfig.FieldDefaultOrgUnitID, v)
+	return u
+}
+
+// UpdateDefaultOrgUnitID sets the "default_org_unit_id" field to the value that was provided on create.
+func (u *LdapConfigUpsert) UpdateDefaultOrgUnitID() *LdapConfigUpsert {
+	u.SetExcluded(ldapconfig.FieldDefaultOrgUnitID)
+	return u
+}
+
+// AddDefaultOrgUnitID adds v to the "default_org_unit_id" field.
+func (u *LdapConfigUpsert) AddDefaultOrgUnitID(v uint32) *LdapConfigUpsert {
+	u.Add(ldapconfig.FieldDefaultOrgUnitID, v)
+	return u
+}
+
+// ClearDefaultOrgUnitID clears the value of the "default_org_unit_id" field.
+func (u *LdapConfigUpsert) ClearDefaultOrgUnitID() *LdapConfigUpsert {
+	u.SetNull(ldapconfig.FieldDefaultOrgUnitID)
+	return u
+}
+
+// SetLastSyncedAt sets the "last_synced_at" field.
+func (u *LdapConfigUpsert) SetLastSyncedAt(v time.Time) *LdapConfigUpsert {
+	u.Set(ldapconfig.FieldLastSyncedAt, v)
+	return u
+}
+
+// UpdateLastSyncedAt sets the "last_synced_at" field to the value that was provided on create.
+func (u *LdapConfigUpsert) UpdateLastSyncedAt() *LdapConfigUpsert {
+	u.SetExcluded(ldapconfig.FieldLastSyncedAt)
+	return u
+}
+
+// ClearLastSyncedAt clears the value of the "last_synced_at" field.
+func (u *LdapConfigUpsert) ClearLastSyncedAt() *LdapConfigUpsert {
+	u.SetNull(ldapconfig.FieldLastSyncedAt)
+	return u
+}
+
+// SetLastSyncMessage sets the "last_sync_message" field.
+func (u *LdapConfigUpsert) SetLastSyncMessage(v string) *LdapConfigUpsert {
+	u.Set(ldapconfig.FieldLastSyncMessage, v)
+	return u
+}
+
+// UpdateLastSyncMessage sets the "last_sync_message" field to the value that was provided on create.
+func (u *LdapConfigUpsert) UpdateLastSyncMessage() *LdapConfigUpsert {
+	u.SetExcluded(ldapconfig.FieldLastSyncMessage)
+	return u
+}
+
+// ClearLastSyncMessage clears the value of the "last_sync_message" field.
+func (u *LdapConfigUpsert) ClearLastSyncMessage() *LdapConfigUpsert {
+	u.SetNull(ldapconfig.FieldLastSyncMessage)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.LdapConfig.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(ldapconfig.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *LdapConfigUpsertOne) UpdateNewValues() *LdapConfigUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(ldapconfig.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(ldapconfig.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(ldapconfig.FieldTenantID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.LdapConfig.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *LdapConfigUpsertOne) Ignore() *LdapConfigUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LdapConfigUpsertOne) DoNothing() *LdapConfigUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LdapConfigCreate.OnConflict
+// documentation for more info.
+func (u *LdapConfigUpsertOne) Update(set func(*LdapConfigUpsert)) *LdapConfigUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LdapConfigUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *LdapConfigUpsertOne) SetUpdatedAt(v time.Time) *LdapConfigUpsertOne {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *LdapConfigUpsertOne) UpdateUpdatedAt() *LdapConfigUpsertOne {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *LdapConfigUpsertOne) ClearUpdatedAt() *LdapConfigUpsertOne {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.ClearUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *LdapConfigUpsertOne) SetDeletedAt(v time.Time) *LdapConfigUpsertOne {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *LdapConfigUpsertOne) UpdateDeletedAt() *LdapConfigUpsertOne {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *LdapConfigUpsertOne) ClearDeletedAt() *LdapConfigUpsertOne {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *LdapConfigUpsertOne) SetCreatedBy(v uint32) *LdapConfigUpsertOne {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *LdapConfigUpsertOne) AddCreatedBy(v uint32) *LdapConfigUpsertOne {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.AddCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *LdapConfigUpsertOne) UpdateCreatedBy() *LdapConfigUpsertOne {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *LdapConfigUpsertOne) ClearCreatedBy() *LdapConfigUpsertOne {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *LdapConfigUpsertOne) SetUpdatedBy(v uint32) *LdapConfigUpsertOne {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *LdapConfigUpsertOne) AddUpdatedBy(v uint32) *LdapConfigUpsertOne {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.AddUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *LdapConfigUpsertOne) UpdateUpdatedBy() *LdapConfigUpsertOne {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *LdapConfigUpsertOne) ClearUpdatedBy() *LdapConfigUpsertOne {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.ClearUpdatedBy()
+	})
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *LdapConfigUpsertOne) SetDeletedBy(v uint32) *LdapConfigUpsertOne {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.SetDeletedBy(v)
+	})
+}
+
+// AddDeletedBy adds v to the "deleted_by" field.
+func (u *LdapConfigUpsertOne) AddDeletedBy(v uint32) *LdapConfigUpsertOne {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.AddDeletedBy(v)
+	})
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *LdapConfigUpsertOne) UpdateDeletedBy() *LdapConfigUpsertOne {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.UpdateDeletedBy()
+	})
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *LdapConfigUpsertOne) ClearDeletedBy() *LdapConfigUpsertOne {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.ClearDeletedBy()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *LdapConfigUpsertOne) SetStatus(v ldapconfig.Status) *LdapConfigUpsertOne {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *LdapConfigUpsertOne) UpdateStatus() *LdapConfigUpsertOne {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetDisplayName sets the "display_name" field.
+func (u *LdapConfigUpsertOne) SetDisplayName(v string) *LdapConfigUpsertOne {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.SetDisplayName(v)
+	})
+}
+
+// UpdateDisplayName sets the "display_name" field to the value that was provided on create.
+func (u *LdapConfigUpsertOne) UpdateDisplayName() *LdapConfigUpsertOne {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.UpdateDisplayName()
+	})
+}
+
+// ClearDisplayName clears the value of the "display_name" field.
+func (u *LdapConfigUpsertOne) ClearDisplayName() *LdapConfigUpsertOne {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.ClearDisplayName()
+	})
+}
+
+// SetServerURL sets the "server_url" field.
+func (u *LdapConfigUpsertOne) SetServerURL(v string) *LdapConfigUpsertOne {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.SetServerURL(v)
+	})
+}
+
+// UpdateServerURL sets the "server_url" field to the value that was provided on create.
+func (u *LdapConfigUpsertOne) UpdateServerURL() *LdapConfigUpsertOne {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.UpdateServerURL()
+	})
+}
+
+// ClearServerURL clears the value of the "server_url" field.
+func (u *LdapConfigUpsertOne) ClearServerURL() *LdapConfigUpsertOne {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.ClearServerURL()
+	})
+}
+
+// SetStartTLS sets the "start_tls" field.
+func (u *LdapConfigUpsertOne) SetStartTLS(v bool) *LdapConfigUpsertOne {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.SetStartTLS(v)
+	})
+}
+
+// UpdateStartTLS sets the "start_tls" field to the value that was provided on create.
+func (u *LdapConfigUpsertOne) UpdateStartTLS() *LdapConfigUpsertOne {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.UpdateStartTLS()
+	})
+}
+
+// ClearStartTLS clears the value of the "start_tls" field.
+func (u *LdapConfigUpsertOne) ClearStartTLS() *LdapConfigUpsertOne {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.ClearStartTLS()
+	})
+}
+
+// SetCaCertificate sets the "ca_certificate" field.
+func (u *LdapConfigUpsertOne) SetCaCertificate(v string) *LdapConfigUpsertOne {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.SetCaCertificate(v)
+	})
+}
+
+// UpdateCaCertificate sets the "ca_certificate" field to the value that was provided on create.
+func (u *LdapConfigUpsertOne) UpdateCaCertificate() *LdapConfigUpsertOne {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.UpdateCaCertificate()
+	})
+}
+
+// ClearCaCertificate clears the value of the "ca_certificate" field.
+func (u *LdapConfigUpsertOne) ClearCaCertificate() *LdapConfigUpsertOne {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.ClearCaCertificate()
+	})
+}
+
+// SetBindDn sets the "bind_dn" field.
+func (u *LdapConfigUpsertOne) SetBindDn(v string) *LdapConfigUpsertOne {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.SetBindDn(v)
+	})
+}
+
+// UpdateBindDn sets the "bind_dn" field to the value that was provided on create.
+func (u *LdapConfigUpsertOne) UpdateBindDn() *LdapConfigUpsertOne {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.UpdateBindDn()
+	})
+}
+
+// ClearBindDn clears the value of the "bind_dn" field.
+func (u *LdapConfigUpsertOne) ClearBindDn() *LdapConfigUpsertOne {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.ClearBindDn()
+	})
+}
+
+// SetBindPassword sets the "bind_password" field.
+func (u *LdapConfigUpsertOne) SetBindPassword(v string) *LdapConfigUpsertOne {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.SetBindPassword(v)
+	})
+}
+
+// UpdateBindPassword sets the "bind_password" field to the value that was provided on create.
+func (u *LdapConfigUpsertOne) UpdateBindPassword() *LdapConfigUpsertOne {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.UpdateBindPassword()
+	})
+}
+
+// ClearBindPassword clears the value of the "bind_password" field.
+func (u *LdapConfigUpsertOne) ClearBindPassword() *LdapConfigUpsertOne {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.ClearBindPassword()
+	})
+}
+
+// SetBaseDn sets the "base_dn" field.
+func (u *LdapConfigUpsertOne) SetBaseDn(v string) *LdapConfigUpsertOne {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.SetBaseDn(v)
+	})
+}
+
+// UpdateBaseDn sets the "base_dn" field to the value that was provided on create.
+func (u *LdapConfigUpsertOne) UpdateBaseDn() *LdapConfigUpsertOne {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.UpdateBaseDn()
+	})
+}
+
+// ClearBaseDn clears the value of the "base_dn" field.
+func (u *LdapConfigUpsertOne) ClearBaseDn() *LdapConfigUpsertOne {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.ClearBaseDn()
+	})
+}
+
+// SetUserFilter sets the "user_filter" field.
+func (u *LdapConfigUpsertOne) SetUserFilter(v string) *LdapConfigUpsertOne {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.SetUserFilter(v)
+	})
+}
+
+// UpdateUserFilter sets the "user_filter" field to the value that was provided on create.
+func (u *LdapConfigUpsertOne) UpdateUserFilter() *LdapConfigUpsertOne {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.UpdateUserFilter()
+	})
+}
+
+// ClearUserFilter clears the value of the "user_filter" field.
+func (u *LdapConfigUpsertOne) ClearUserFilter() *LdapConfigUpsertOne {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.ClearUserFilter()
+	})
+}
+
+// SetIDAttribute sets the "id_attribute" field.
+func (u *LdapConfigUpsertOne) SetIDAttribute(v string) *LdapConfigUpsertOne {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.SetIDAttribute(v)
+	})
+}
+
+// UpdateIDAttribute sets the "id_attribute" field to the value that was provided on create.
+func (u *LdapConfigUpsertOne) UpdateIDAttribute() *LdapConfigUpsertOne {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.UpdateIDAttribute()
+	})
+}
+
+// ClearIDAttribute clears the value of the "id_attribute" field.
+func (u *LdapConfigUpsertOne) ClearIDAttribute() *LdapConfigUpsertOne {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.ClearIDAttribute()
+	})
+}
+
+// SetAttributeMapping sets the "attribute_mapping" field.
+func (u *LdapConfigUpsertOne) SetAttributeMapping(v map[string]string) *LdapConfigUpsertOne {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.SetAttributeMapping(v)
+	})
+}
+
+// UpdateAttributeMapping sets the "attribute_mapping" field to the value that was provided on create.
+func (u *LdapConfigUpsertOne) UpdateAttributeMapping() *LdapConfigUpsertOne {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.UpdateAttributeMapping()
+	})
+}
+
+// ClearAttributeMapping clears the value of the "attribute_mapping" field.
+func (u *LdapConfigUpsertOne) ClearAttributeMapping() *LdapConfigUpsertOne {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.ClearAttributeMapping()
+	})
+}
+
+// SetGroupFilter sets the "group_filter" field.
+func (u *LdapConfigUpsertOne) SetGroupFilter(v string) *LdapConfigUpsertOne {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.SetGroupFilter(v)
+	})
+}
+
+// UpdateGroupFilter sets the "group_filter" field to the value that was provided on create.
+func (u *LdapConfigUpsertOne) UpdateGroupFilter() *LdapConfigUpsertOne {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.UpdateGroupFilter()
+	})
+}
+
+// ClearGroupFilter clears the value of the "group_filter" field.
+func (u *LdapConfigUpsertOne) ClearGroupFilter() *LdapConfigUpsertOne {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.ClearGroupFilter()
+	})
+}
+
+// SetGroupMemberAttribute sets the "group_member_attribute" field.
+func (u *LdapConfigUpsertOne) SetGroupMemberAttribute(v string) *LdapConfigUpsertOne {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.SetGroupMemberAttribute(v)
+	})
+}
+
+// UpdateGroupMemberAttribute sets the "group_member_attribute" field to the value that was provided on create.
+func (u *LdapConfigUpsertOne) UpdateGroupMemberAttribute() *LdapConfigUpsertOne {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.UpdateGroupMemberAttribute()
+	})
+}
+
+// ClearGroupMemberAttribute clears the value of the "group_member_attribute" field.
+func (u *LdapConfigUpsertOne) ClearGroupMemberAttribute() *LdapConfigUpsertOne {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.ClearGroupMemberAttribute()
+	})
+}
+
+// SetGroupRoleMapping sets the "group_role_mapping" field.
+func (u *LdapConfigUpsertOne) SetGroupRoleMapping(v map[string]uint32) *LdapConfigUpsertOne {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.SetGroupRoleMapping(v)
+	})
+}
+
+// UpdateGroupRoleMapping sets the "group_role_mapping" field to the value that was provided on create.
+func (u *LdapConfigUpsertOne) UpdateGroupRoleMapping() *LdapConfigUpsertOne {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.UpdateGroupRoleMapping()
+	})
+}
+
+// ClearGroupRoleMapping clears the value of the "group_role_mapping" field.
+func (u *LdapConfigUpsertOne) ClearGroupRoleMapping() *LdapConfigUpsertOne {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.ClearGroupRoleMapping()
+	})
+}
+
+// SetOrgUnitFilter sets the "org_unit_filter" field.
+func (u *LdapConfigUpsertOne) SetOrgUnitFilter(v string) *LdapConfigUpsertOne {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.SetOrgUnitFilter(v)
+	})
+}
+
+// UpdateOrgUnitFilter sets the "org_unit_filter" field to the value that was provided on create.
+func (u *LdapConfigUpsertOne) UpdateOrgUnitFilter() *LdapConfigUpsertOne {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.UpdateOrgUnitFilter()
+	})
+}
+
+// ClearOrgUnitFilter clears the value of the "org_unit_filter" field.
+func (u *LdapConfigUpsertOne) ClearOrgUnitFilter() *LdapConfigUpsertOne {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.ClearOrgUnitFilter()
+	})
+}
+
+// SetOrgUnitRootID sets the "org_unit_root_id" field.
+func (u *LdapConfigUpsertOne) SetOrgUnitRootID(v uint32) *LdapConfigUpsertOne {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.SetOrgUnitRootID(v)
+	})
+}
+
+// AddOrgUnitRootID adds v to the "org_unit_root_id" field.
+func (u *LdapConfigUpsertOne) AddOrgUnitRootID(v uint32) *LdapConfigUpsertOne {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.AddOrgUnitRootID(v)
+	})
+}
+
+// UpdateOrgUnitRootID sets the "org_unit_root_id" field to the value that was provided on create.
+func (u *LdapConfigUpsertOne) UpdateOrgUnitRootID() *LdapConfigUpsertOne {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.UpdateOrgUnitRootID()
+	})
+}
+
+// ClearOrgUnitRootID clears the value of the "org_unit_root_id" field.
+func (u *LdapConfigUpsertOne) ClearOrgUnitRootID() *LdapConfigUpsertOne {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.ClearOrgUnitRootID()
+	})
+}
+
+// SetAuthMode sets the "auth_mode" field.
+func (u *LdapConfigUpsertOne) SetAuthMode(v ldapconfig.AuthMode) *LdapConfigUpsertOne {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.SetAuthMode(v)
+	})
+}
+
+// UpdateAuthMode sets the "auth_mode" field to the value that was provided on create.
+func (u *LdapConfigUpsertOne) UpdateAuthMode() *LdapConfigUpsertOne {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.UpdateAuthMode()
+	})
+}
+
+// ClearAuthMode clears the value of the "auth_mode" field.
+func (u *LdapConfigUpsertOne) ClearAuthMode() *LdapConfigUpsertOne {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.ClearAuthMode()
+	})
+}
+
+// SetJitProvisioning sets the "jit_provisioning" field.
+func (u *LdapConfigUpsertOne) SetJitProvisioning(v bool) *LdapConfigUpsertOne {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.SetJitProvisioning(v)
+	})
+}
+
+// UpdateJitProvisioning sets the "jit_provisioning" field to the value that was provided on create.
+func (u *LdapConfigUpsertOne) UpdateJitProvisioning() *LdapConfigUpsertOne {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.UpdateJitProvisioning()
+	})
+}
+
+// ClearJitProvisioning clears the value of the "jit_provisioning" field.
+func (u *LdapConfigUpsertOne) ClearJitProvisioning() *LdapConfigUpsertOne {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.ClearJitProvisioning()
+	})
+}
+
+// SetDefaultRoleIds sets the "default_role_ids" field.
+func (u *LdapConfigUpsertOne) SetDefaultRoleIds(v []uint32) *LdapConfigUpsertOne {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.SetDefaultRoleIds(v)
+	})
+}
+
+// UpdateDefaultRoleIds sets the "default_role_ids" field to the value that was provided on create.
+func (u *LdapConfigUpsertOne) UpdateDefaultRoleIds() *LdapConfigUpsertOne {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.UpdateDefaultRoleIds()
+	})
+}
+
+// ClearDefaultRoleIds clears the value of the "default_role_ids" field.
+func (u *LdapConfigUpsertOne) ClearDefaultRoleIds() *LdapConfigUpsertOne {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.ClearDefaultRoleIds()
+	})
+}
+
+// SetDefaultOrgUnitID sets the "default_org_unit_id" field.
+func (u *LdapConfigUpsertOne) SetDefaultOrgUnitID(v uint32) *LdapConfigUpsertOne {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.SetDefaultOrgUnitID(v)
+	})
+}
+
+// AddDefaultOrgUnitID adds v to the "default_org_unit_id" field.
+func (u *LdapConfigUpsertOne) AddDefaultOrgUnitID(v uint32) *LdapConfigUpsertOne {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.AddDefaultOrgUnitID(v)
+	})
+}
+
+// UpdateDefaultOrgUnitID sets the "default_org_unit_id" field to the value that was provided on create.
+func (u *LdapConfigUpsertOne) UpdateDefaultOrgUnitID() *LdapConfigUpsertOne {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.UpdateDefaultOrgUnitID()
+	})
+}
+
+// ClearDefaultOrgUnitID clears the value of the "default_org_unit_id" field.
+func (u *LdapConfigUpsertOne) ClearDefaultOrgUnitID() *LdapConfigUpsertOne {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.ClearDefaultOrgUnitID()
+	})
+}
+
+// SetLastSyncedAt sets the "last_synced_at" field.
+func (u *LdapConfigUpsertOne) SetLastSyncedAt(v time.Time) *LdapConfigUpsertOne {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.SetLastSyncedAt(v)
+	})
+}
+
+// UpdateLastSyncedAt sets the "last_synced_at" field to the value that was provided on create.
+func (u *LdapConfigUpsertOne) UpdateLastSyncedAt() *LdapConfigUpsertOne {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.UpdateLastSyncedAt()
+	})
+}
+
+// ClearLastSyncedAt clears the value of the "last_synced_at" field.
+func (u *LdapConfigUpsertOne) ClearLastSyncedAt() *LdapConfigUpsertOne {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.ClearLastSyncedAt()
+	})
+}
+
+// SetLastSyncMessage sets the "last_sync_message" field.
+func (u *LdapConfigUpsertOne) SetLastSyncMessage(v string) *LdapConfigUpsertOne {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.SetLastSyncMessage(v)
+	})
+}
+
+// UpdateLastSyncMessage sets the "last_sync_message" field to the value that was provided on create.
+func (u *LdapConfigUpsertOne) UpdateLastSyncMessage() *LdapConfigUpsertOne {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.UpdateLastSyncMessage()
+	})
+}
+
+// ClearLastSyncMessage clears the value of the "last_sync_message" field.
+func (u *LdapConfigUpsertOne) ClearLastSyncMessage() *LdapConfigUpsertOne {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.ClearLastSyncMessage()
+	})
+}
+
+// Exec executes the query.
+func (u *LdapConfigUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LdapConfigCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LdapConfigUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *LdapConfigUpsertOne) ID(ctx context.Context) (id uint32, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *LdapConfigUpsertOne) IDX(ctx context.Context) uint32 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// LdapConfigCreateBulk is the builder for creating many LdapConfig entities in bulk.
+type LdapConfigCreateBulk struct {
+	config
+	err      error
+	builders []*LdapConfigCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the LdapConfig entities in the database.
+func (_c *LdapConfigCreateBulk) Save(ctx context.Context) ([]*LdapConfig, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*LdapConfig, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LdapConfigMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint32(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *LdapConfigCreateBulk) SaveX(ctx context.Context) []*LdapConfig {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LdapConfigCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LdapConfigCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.LdapConfig.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LdapConfigUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *LdapConfigCreateBulk) OnConflict(opts ...sql.ConflictOption) *LdapConfigUpsertBulk {
+	_c.conflict = opts
+	return &LdapConfigUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.LdapConfig.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *LdapConfigCreateBulk) OnConflictColumns(columns ...string) *LdapConfigUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &LdapConfigUpsertBulk{
+		create: _c,
+	}
+}
+
+// LdapConfigUpsertBulk is the builder for "upsert"-ing
+// a bulk of LdapConfig nodes.
+type LdapConfigUpsertBulk struct {
+	create *LdapConfigCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.LdapConfig.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(ldapconfig.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *LdapConfigUpsertBulk) UpdateNewValues() *LdapConfigUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(ldapconfig.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(ldapconfig.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(ldapconfig.FieldTenantID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.LdapConfig.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *LdapConfigUpsertBulk) Ignore() *LdapConfigUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LdapConfigUpsertBulk) DoNothing() *LdapConfigUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LdapConfigCreateBulk.OnConflict
+// documentation for more info.
+func (u *LdapConfigUpsertBulk) Update(set func(*LdapConfigUpsert)) *LdapConfigUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LdapConfigUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *LdapConfigUpsertBulk) SetUpdatedAt(v time.Time) *LdapConfigUpsertBulk {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *LdapConfigUpsertBulk) UpdateUpdatedAt() *LdapConfigUpsertBulk {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *LdapConfigUpsertBulk) ClearUpdatedAt() *LdapConfigUpsertBulk {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.ClearUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *LdapConfigUpsertBulk) SetDeletedAt(v time.Time) *LdapConfigUpsertBulk {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *LdapConfigUpsertBulk) UpdateDeletedAt() *LdapConfigUpsertBulk {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *LdapConfigUpsertBulk) ClearDeletedAt() *LdapConfigUpsertBulk {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *LdapConfigUpsertBulk) SetCreatedBy(v uint32) *LdapConfigUpsertBulk {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *LdapConfigUpsertBulk) AddCreatedBy(v uint32) *LdapConfigUpsertBulk {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.AddCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *LdapConfigUpsertBulk) UpdateCreatedBy() *LdapConfigUpsertBulk {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *LdapConfigUpsertBulk) ClearCreatedBy() *LdapConfigUpsertBulk {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *LdapConfigUpsertBulk) SetUpdatedBy(v uint32) *LdapConfigUpsertBulk {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *LdapConfigUpsertBulk) AddUpdatedBy(v uint32) *LdapConfigUpsertBulk {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.AddUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *LdapConfigUpsertBulk) UpdateUpdatedBy() *LdapConfigUpsertBulk {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *LdapConfigUpsertBulk) ClearUpdatedBy() *LdapConfigUpsertBulk {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.ClearUpdatedBy()
+	})
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *LdapConfigUpsertBulk) SetDeletedBy(v uint32) *LdapConfigUpsertBulk {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.SetDeletedBy(v)
+	})
+}
+
+// AddDeletedBy adds v to the "deleted_by" field.
+func (u *LdapConfigUpsertBulk) AddDeletedBy(v uint32) *LdapConfigUpsertBulk {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.AddDeletedBy(v)
+	})
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *LdapConfigUpsertBulk) UpdateDeletedBy() *LdapConfigUpsertBulk {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.UpdateDeletedBy()
+	})
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *LdapConfigUpsertBulk) ClearDeletedBy() *LdapConfigUpsertBulk {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.ClearDeletedBy()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *LdapConfigUpsertBulk) SetStatus(v ldapconfig.Status) *LdapConfigUpsertBulk {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *LdapConfigUpsertBulk) UpdateStatus() *LdapConfigUpsertBulk {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetDisplayName sets the "display_name" field.
+func (u *LdapConfigUpsertBulk) SetDisplayName(v string) *LdapConfigUpsertBulk {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.SetDisplayName(v)
+	})
+}
+
+// UpdateDisplayName sets the "display_name" field to the value that was provided on create.
+func (u *LdapConfigUpsertBulk) UpdateDisplayName() *LdapConfigUpsertBulk {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.UpdateDisplayName()
+	})
+}
+
+// ClearDisplayName clears the value of the "display_name" field.
+func (u *LdapConfigUpsertBulk) ClearDisplayName() *LdapConfigUpsertBulk {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.ClearDisplayName()
+	})
+}
+
+// SetServerURL sets the "server_url" field.
+func (u *LdapConfigUpsertBulk) SetServerURL(v string) *LdapConfigUpsertBulk {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.SetServerURL(v)
+	})
+}
+
+// UpdateServerURL sets the "server_url" field to the value that was provided on create.
+func (u *LdapConfigUpsertBulk) UpdateServerURL() *LdapConfigUpsertBulk {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.UpdateServerURL()
+	})
+}
+
+// ClearServerURL clears the value of the "server_url" field.
+func (u *LdapConfigUpsertBulk) ClearServerURL() *LdapConfigUpsertBulk {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.ClearServerURL()
+	})
+}
+
+// SetStartTLS sets the "start_tls" field.
+func (u *LdapConfigUpsertBulk) SetStartTLS(v bool) *LdapConfigUpsertBulk {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.SetStartTLS(v)
+	})
+}
+
+// UpdateStartTLS sets the "start_tls" field to the value that was provided on create.
+func (u *LdapConfigUpsertBulk) UpdateStartTLS() *LdapConfigUpsertBulk {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.UpdateStartTLS()
+	})
+}
+
+// ClearStartTLS clears the value of the "start_tls" field.
+func (u *LdapConfigUpsertBulk) ClearStartTLS() *LdapConfigUpsertBulk {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.ClearStartTLS()
+	})
+}
+
+// SetCaCertificate sets the "ca_certificate" field.
+func (u *LdapConfigUpsertBulk) SetCaCertificate(v string) *LdapConfigUpsertBulk {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.SetCaCertificate(v)
+	})
+}
+
+// UpdateCaCertificate sets the "ca_certificate" field to the value that was provided on create.
+func (u *LdapConfigUpsertBulk) UpdateCaCertificate() *LdapConfigUpsertBulk {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.UpdateCaCertificate()
+	})
+}
+
+// ClearCaCertificate clears the value of the "ca_certificate" field.
+func (u *LdapConfigUpsertBulk) ClearCaCertificate() *LdapConfigUpsertBulk {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.ClearCaCertificate()
+	})
+}
+
+// SetBindDn sets the "bind_dn" field.
+func (u *LdapConfigUpsertBulk) SetBindDn(v string) *LdapConfigUpsertBulk {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.SetBindDn(v)
+	})
+}
+
+// UpdateBindDn sets the "bind_dn" field to the value that was provided on create.
+func (u *LdapConfigUpsertBulk) UpdateBindDn() *LdapConfigUpsertBulk {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.UpdateBindDn()
+	})
+}
+
+// ClearBindDn clears the value of the "bind_dn" field.
+func (u *LdapConfigUpsertBulk) ClearBindDn() *LdapConfigUpsertBulk {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.ClearBindDn()
+	})
+}
+
+// SetBindPassword sets the "bind_password" field.
+func (u *LdapConfigUpsertBulk) SetBindPassword(v string) *LdapConfigUpsertBulk {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.SetBindPassword(v)
+	})
+}
+
+// UpdateBindPassword sets the "bind_password" field to the value that was provided on create.
+func (u *LdapConfigUpsertBulk) UpdateBindPassword() *LdapConfigUpsertBulk {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.UpdateBindPassword()
+	})
+}
+
+// ClearBindPassword clears the value of the "bind_password" field.
+func (u *LdapConfigUpsertBulk) ClearBindPassword() *LdapConfigUpsertBulk {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.ClearBindPassword()
+	})
+}
+
+// SetBaseDn sets the "base_dn" field.
+func (u *LdapConfigUpsertBulk) SetBaseDn(v string) *LdapConfigUpsertBulk {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.SetBaseDn(v)
+	})
+}
+
+// UpdateBaseDn sets the "base_dn" field to the value that was provided on create.
+func (u *LdapConfigUpsertBulk) UpdateBaseDn() *LdapConfigUpsertBulk {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.UpdateBaseDn()
+	})
+}
+
+// ClearBaseDn clears the value of the "base_dn" field.
+func (u *LdapConfigUpsertBulk) ClearBaseDn() *LdapConfigUpsertBulk {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.ClearBaseDn()
+	})
+}
+
+// SetUserFilter sets the "user_filter" field.
+func (u *LdapConfigUpsertBulk) SetUserFilter(v string) *LdapConfigUpsertBulk {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.SetUserFilter(v)
+	})
+}
+
+// UpdateUserFilter sets the "user_filter" field to the value that was provided on create.
+func (u *LdapConfigUpsertBulk) UpdateUserFilter() *LdapConfigUpsertBulk {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.UpdateUserFilter()
+	})
+}
+
+// ClearUserFilter clears the value of the "user_filter" field.
+func (u *LdapConfigUpsertBulk) ClearUserFilter() *LdapConfigUpsertBulk {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.ClearUserFilter()
+	})
+}
+
+// SetIDAttribute sets the "id_attribute" field.
+func (u *LdapConfigUpsertBulk) SetIDAttribute(v string) *LdapConfigUpsertBulk {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.SetIDAttribute(v)
+	})
+}
+
+// UpdateIDAttribute sets the "id_attribute" field to the value that was provided on create.
+func (u *LdapConfigUpsertBulk) UpdateIDAttribute() *LdapConfigUpsertBulk {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.UpdateIDAttribute()
+	})
+}
+
+// ClearIDAttribute clears the value of the "id_attribute" field.
+func (u *LdapConfigUpsertBulk) ClearIDAttribute() *LdapConfigUpsertBulk {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.ClearIDAttribute()
+	})
+}
+
+// SetAttributeMapping sets the "attribute_mapping" field.
+func (u *LdapConfigUpsertBulk) SetAttributeMapping(v map[string]string) *LdapConfigUpsertBulk {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.SetAttributeMapping(v)
+	})
+}
+
+// UpdateAttributeMapping sets the "attribute_mapping" field to the value that was provided on create.
+func (u *LdapConfigUpsertBulk) UpdateAttributeMapping() *LdapConfigUpsertBulk {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.UpdateAttributeMapping()
+	})
+}
+
+// ClearAttributeMapping clears the value of the "attribute_mapping" field.
+func (u *LdapConfigUpsertBulk) ClearAttributeMapping() *LdapConfigUpsertBulk {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.ClearAttributeMapping()
+	})
+}
+
+// SetGroupFilter sets the "group_filter" field.
+func (u *LdapConfigUpsertBulk) SetGroupFilter(v string) *LdapConfigUpsertBulk {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.SetGroupFilter(v)
+	})
+}
+
+// UpdateGroupFilter sets the "group_filter" field to the value that was provided on create.
+func (u *LdapConfigUpsertBulk) UpdateGroupFilter() *LdapConfigUpsertBulk {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.UpdateGroupFilter()
+	})
+}
+
+// ClearGroupFilter clears the value of the "group_filter" field.
+func (u *LdapConfigUpsertBulk) ClearGroupFilter() *LdapConfigUpsertBulk {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.ClearGroupFilter()
+	})
+}
+
+// SetGroupMemberAttribute sets the "group_member_attribute" field.
+func (u *LdapConfigUpsertBulk) SetGroupMemberAttribute(v string) *LdapConfigUpsertBulk {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.SetGroupMemberAttribute(v)
+	})
+}
+
+// UpdateGroupMemberAttribute sets the "group_member_attribute" field to the value that was provided on create.
+func (u *LdapConfigUpsertBulk) UpdateGroupMemberAttribute() *LdapConfigUpsertBulk {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.UpdateGroupMemberAttribute()
+	})
+}
+
+// ClearGroupMemberAttribute clears the value of the "group_member_attribute" field.
+func (u *LdapConfigUpsertBulk) ClearGroupMemberAttribute() *LdapConfigUpsertBulk {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.ClearGroupMemberAttribute()
+	})
+}
+
+// SetGroupRoleMapping sets the "group_role_mapping" field.
+func (u *LdapConfigUpsertBulk) SetGroupRoleMapping(v map[string]uint32) *LdapConfigUpsertBulk {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.SetGroupRoleMapping(v)
+	})
+}
+
+// UpdateGroupRoleMapping sets the "group_role_mapping" field to the value that was provided on create.
+func (u *LdapConfigUpsertBulk) UpdateGroupRoleMapping() *LdapConfigUpsertBulk {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.UpdateGroupRoleMapping()
+	})
+}
+
+// ClearGroupRoleMapping clears the value of the "group_role_mapping" field.
+func (u *LdapConfigUpsertBulk) ClearGroupRoleMapping() *LdapConfigUpsertBulk {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.ClearGroupRoleMapping()
+	})
+}
+
+// SetOrgUnitFilter sets the "org_unit_filter" field.
+func (u *LdapConfigUpsertBulk) SetOrgUnitFilter(v string) *LdapConfigUpsertBulk {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.SetOrgUnitFilter(v)
+	})
+}
+
+// UpdateOrgUnitFilter sets the "org_unit_filter" field to the value that was provided on create.
+func (u *LdapConfigUpsertBulk) UpdateOrgUnitFilter() *LdapConfigUpsertBulk {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.UpdateOrgUnitFilter()
+	})
+}
+
+// ClearOrgUnitFilter clears the value of the "org_unit_filter" field.
+func (u *LdapConfigUpsertBulk) ClearOrgUnitFilter() *LdapConfigUpsertBulk {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.ClearOrgUnitFilter()
+	})
+}
+
+// SetOrgUnitRootID sets the "org_unit_root_id" field.
+func (u *LdapConfigUpsertBulk) SetOrgUnitRootID(v uint32) *LdapConfigUpsertBulk {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.SetOrgUnitRootID(v)
+	})
+}
+
+// AddOrgUnitRootID adds v to the "org_unit_root_id" field.
+func (u *LdapConfigUpsertBulk) AddOrgUnitRootID(v uint32) *LdapConfigUpsertBulk {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.AddOrgUnitRootID(v)
+	})
+}
+
+// UpdateOrgUnitRootID sets the "org_unit_root_id" field to the value that was provided on create.
+func (u *LdapConfigUpsertBulk) UpdateOrgUnitRootID() *LdapConfigUpsertBulk {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.UpdateOrgUnitRootID()
+	})
+}
+
+// ClearOrgUnitRootID clears the value of the "org_unit_root_id" field.
+func (u *LdapConfigUpsertBulk) ClearOrgUnitRootID() *LdapConfigUpsertBulk {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.ClearOrgUnitRootID()
+	})
+}
+
+// SetAuthMode sets the "auth_mode" field.
+func (u *LdapConfigUpsertBulk) SetAuthMode(v ldapconfig.AuthMode) *LdapConfigUpsertBulk {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.SetAuthMode(v)
+	})
+}
+
+// UpdateAuthMode sets the "auth_mode" field to the value that was provided on create.
+func (u *LdapConfigUpsertBulk) UpdateAuthMode() *LdapConfigUpsertBulk {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.UpdateAuthMode()
+	})
+}
+
+// ClearAuthMode clears the value of the "auth_mode" field.
+func (u *LdapConfigUpsertBulk) ClearAuthMode() *LdapConfigUpsertBulk {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.ClearAuthMode()
+	})
+}
+
+// SetJitProvisioning sets the "jit_provisioning" field.
+func (u *LdapConfigUpsertBulk) SetJitProvisioning(v bool) *LdapConfigUpsertBulk {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.SetJitProvisioning(v)
+	})
+}
+
+// UpdateJitProvisioning sets the "jit_provisioning" field to the value that was provided on create.
+func (u *LdapConfigUpsertBulk) UpdateJitProvisioning() *LdapConfigUpsertBulk {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.UpdateJitProvisioning()
+	})
+}
+
+// ClearJitProvisioning clears the value of the "jit_provisioning" field.
+func (u *LdapConfigUpsertBulk) ClearJitProvisioning() *LdapConfigUpsertBulk {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.ClearJitProvisioning()
+	})
+}
+
+// SetDefaultRoleIds sets the "default_role_ids" field.
+func (u *LdapConfigUpsertBulk) SetDefaultRoleIds(v []uint32) *LdapConfigUpsertBulk {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.SetDefaultRoleIds(v)
+	})
+}
+
+// UpdateDefaultRoleIds sets the "default_role_ids" field to the value that was provided on create.
+func (u *LdapConfigUpsertBulk) UpdateDefaultRoleIds() *LdapConfigUpsertBulk {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.UpdateDefaultRoleIds()
+	})
+}
+
+// ClearDefaultRoleIds clears the value of the "default_role_ids" field.
+func (u *LdapConfigUpsertBulk) ClearDefaultRoleIds() *LdapConfigUpsertBulk {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.ClearDefaultRoleIds()
+	})
+}
+
+// SetDefaultOrgUnitID sets the "default_org_unit_id" field.
+func (u *LdapConfigUpsertBulk) SetDefaultOrgUnitID(v uint32) *LdapConfigUpsertBulk {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.SetDefaultOrgUnitID(v)
+	})
+}
+
+// AddDefaultOrgUnitID adds v to the "default_org_unit_id" field.
+func (u *LdapConfigUpsertBulk) AddDefaultOrgUnitID(v uint32) *LdapConfigUpsertBulk {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.AddDefaultOrgUnitID(v)
+	})
+}
+
+// UpdateDefaultOrgUnitID sets the "default_org_unit_id" field to the value that was provided on create.
+func (u *LdapConfigUpsertBulk) UpdateDefaultOrgUnitID() *LdapConfigUpsertBulk {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.UpdateDefaultOrgUnitID()
+	})
+}
+
+// ClearDefaultOrgUnitID clears the value of the "default_org_unit_id" field.
+func (u *LdapConfigUpsertBulk) ClearDefaultOrgUnitID() *LdapConfigUpsertBulk {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.ClearDefaultOrgUnitID()
+	})
+}
+
+// SetLastSyncedAt sets the "last_synced_at" field.
+func (u *LdapConfigUpsertBulk) SetLastSyncedAt(v time.Time) *LdapConfigUpsertBulk {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.SetLastSyncedAt(v)
+	})
+}
+
+// UpdateLastSyncedAt sets the "last_synced_at" field to the value that was provided on create.
+func (u *LdapConfigUpsertBulk) UpdateLastSyncedAt() *LdapConfigUpsertBulk {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.UpdateLastSyncedAt()
+	})
+}
+
+// ClearLastSyncedAt clears the value of the "last_synced_at" field.
+func (u *LdapConfigUpsertBulk) ClearLastSyncedAt() *LdapConfigUpsertBulk {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.ClearLastSyncedAt()
+	})
+}
+
+// SetLastSyncMessage sets the "last_sync_message" field.
+func (u *LdapConfigUpsertBulk) SetLastSyncMessage(v string) *LdapConfigUpsertBulk {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.SetLastSyncMessage(v)
+	})
+}
+
+// UpdateLastSyncMessage sets the "last_sync_message" field to the value that was provided on create.
+func (u *LdapConfigUpsertBulk) UpdateLastSyncMessage() *LdapConfigUpsertBulk {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.UpdateLastSyncMessage()
+	})
+}
+
+// ClearLastSyncMessage clears the value of the "last_sync_message" field.
+func (u *LdapConfigUpsertBulk) ClearLastSyncMessage() *LdapConfigUpsertBulk {
+	return u.Update(func(s *LdapConfigUpsert) {
+		s.ClearLastSyncMessage()
+	})
+}
+
+// Exec executes the query.
+func (u *LdapConfigUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the LdapConfigCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LdapConfigCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LdapConfigUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1811 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-wind-admin/app/admin/service/internal/data/ent/ldapconfig"
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// LdapConfigUpdate is the builder for updating LdapConfig entities.
+type LdapConfigUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *LdapConfigMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the LdapConfigUpdate builder.
+func (_u *LdapConfigUpdate) Where(ps ...predicate.LdapConfig) *LdapConfigUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *LdapConfigUpdate) SetUpdatedAt(v time.Time) *LdapConfigUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *LdapConfigUpdate) SetNillableUpdatedAt(v *time.Time) *LdapConfigUpdate {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *LdapConfigUpdate) ClearUpdatedAt() *LdapConfigUpdate {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *LdapConfigUpdate) SetDeletedAt(v time.Time) *LdapConfigUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *LdapConfigUpdate) SetNillableDeletedAt(v *time.Time) *LdapConfigUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *LdapConfigUpdate) ClearDeletedAt() *LdapConfigUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *LdapConfigUpdate) SetCreatedBy(v uint32) *LdapConfigUpdate {
+	_u.mutation.ResetCreatedBy()
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *LdapConfigUpdate) SetNillableCreatedBy(v *uint32) *LdapConfigUpdate {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// AddCreatedBy adds value to the "created_by" field.
+func (_u *LdapConfigUpdate) AddCreatedBy(v int32) *LdapConfigUpdate {
+	_u.mutation.AddCreatedBy(v)
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *LdapConfigUpdate) ClearCreatedBy() *LdapConfigUpdate {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_u *LdapConfigUpdate) SetUpdatedBy(v uint32) *LdapConfigUpdate {
+	_u.mutation.ResetUpdatedBy()
+	_u.mutation.SetUpdatedBy(v)
+	return _u
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_u *LdapConfigUpdate) SetNillableUpdatedBy(v *uint32) *LdapConfigUpdate {
+	if v != nil {
+		_u.SetUpdatedBy(*v)
+	}
+	return _u
+}
+
+// AddUpdatedBy adds value to the "updated_by" field.
+func (_u *LdapConfigUpdate) AddUpdatedBy(v int32) *LdapConfigUpdate {
+	_u.mutation.AddUpdatedBy(v)
+	return _u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (_u *LdapConfigUpdate) ClearUpdatedBy() *LdapConfigUpdate {
+	_u.mutation.ClearUpdatedBy()
+	return _u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_u *LdapConfigUpdate) SetDeletedBy(v uint32) *LdapConfigUpdate {
+	_u.mutation.ResetDeletedBy()
+	_u.mutation.SetDeletedBy(v)
+	return _u
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_u *LdapConfigUpdate) SetNillableDeletedBy(v *uint32) *LdapConfigUpdate {
+	if v != nil {
+		_u.SetDeletedBy(*v)
+	}
+	return _u
+}
+
+// AddDeletedBy adds value to the "deleted_by" field.
+func (_u *LdapConfigUpdate) AddDeletedBy(v int32) *LdapConfigUpdate {
+	_u.mutation.AddDeletedBy(v)
+	return _u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (_u *LdapConfigUpdate) ClearDeletedBy() *LdapConfigUpdate {
+	_u.mutation.ClearDeletedBy()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *LdapConfigUpdate) SetStatus(v ldapconfig.Status) *LdapConfigUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *LdapConfigUpdate) SetNillableStatus(v *ldapconfig.Status) *LdapConfigUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetDisplayName sets the "display_name" field.
+func (_u *LdapConfigUpdate) SetDisplayName(v string) *LdapConfigUpdate {
+	_u.mutation.SetDisplayName(v)
+	return _u
+}
+
+// SetNillableDisplayName sets the "display_name" field if the given value is not nil.
+func (_u *LdapConfigUpdate) SetNillableDisplayName(v *string) *LdapConfigUpdate {
+	if v != nil {
+		_u.SetDisplayName(*v)
+	}
+	return _u
+}
+
+// ClearDisplayName clears the value of the "display_name" field.
+func (_u *LdapConfigUpdate) ClearDisplayName() *LdapConfigUpdate {
+	_u.mutation.ClearDisplayName()
+	return _u
+}
+
+// SetServerURL sets the "server_url" field.
+func (_u *LdapConfigUpdate) SetServerURL(v string) *LdapConfigUpdate {
+	_u.mutation.SetServerURL(v)
+	return _u
+}
+
+// SetNillableServerURL sets the "server_url" field if the given value is not nil.
+func (_u *LdapConfigUpdate) SetNillableServerURL(v *string) *LdapConfigUpdate {
+	if v != nil {
+		_u.SetServerURL(*v)
+	}
+	return _u
+}
+
+// ClearServerURL clears the value of the "server_url" field.
+func (_u *LdapConfigUpdate) ClearServerURL() *LdapConfigUpdate {
+	_u.mutation.ClearServerURL()
+	return _u
+}
+
+// SetStartTLS sets the "start_tls" field.
+func (_u *LdapConfigUpdate) SetStartTLS(v bool) *LdapConfigUpdate {
+	_u.mutation.SetStartTLS(v)
+	return _u
+}
+
+// SetNillableStartTLS sets the "start_tls" field if the given value is not nil.
+func (_u *LdapConfigUpdate) SetNillableStartTLS(v *bool) *LdapConfigUpdate {
+	if v != nil {
+		_u.SetStartTLS(*v)
+	}
+	return _u
+}
+
+// ClearStartTLS clears the value of the "start_tls" field.
+func (_u *LdapConfigUpdate) ClearStartTLS() *LdapConfigUpdate {
+	_u.mutation.ClearStartTLS()
+	return _u
+}
+
+// SetCaCertificate sets the "ca_certificate" field.
+func (_u *LdapConfigUpdate) SetCaCertificate(v string) *LdapConfigUpdate {
+	_u.mutation.SetCaCertificate(v)
+	return _u
+}
+
+// SetNillableCaCertificate sets the "ca_certificate" field if the given value is not nil.
+func (_u *LdapConfigUpdate) SetNillableCaCertificate(v *string) *LdapConfigUpdate {
+	if v != nil {
+		_u.SetCaCertificate(*v)
+	}
+	return _u
+}
+
+// ClearCaCertificate clears the value of the "ca_certificate" field.
+func (_u *LdapConfigUpdate) ClearCaCertificate() *LdapConfigUpdate {
+	_u.mutation.ClearCaCertificate()
+	return _u
+}
+
+// SetBindDn sets the "bind_dn" field.
+func (_u *LdapConfigUpdate) SetBindDn(v string) *LdapConfigUpdate {
+	_u.mutation.SetBindDn(v)
+	return _u
+}
+
+// SetNillableBindDn sets the "bind_dn" field if the given value is not nil.
+func (_u *LdapConfigUpdate) SetNillableBindDn(v *string) *LdapConfigUpdate {
+	if v != nil {
+		_u.SetBindDn(*v)
+	}
+	return _u
+}
+
+// ClearBindDn clears the value of the "bind_dn" field.
+func (_u *LdapConfigUpdate) ClearBindDn() *LdapConfigUpdate {
+	_u.mutation.ClearBindDn()
+	return _u
+}
+
+// SetBindPassword sets the "bind_password" field.
+func (_u *LdapConfigUpdate) SetBindPassword(v string) *LdapConfigUpdate {
+	_u.mutation.SetBindPassword(v)
+	return _u
+}
+
+// SetNillableBindPassword sets the "bind_password" field if the given value is not nil.
+func (_u *LdapConfigUpdate) SetNillableBindPassword(v *string) *LdapConfigUpdate {
+	if v != nil {
+		_u.SetBindPassword(*v)
+	}
+	return _u
+}
+
+// ClearBindPassword clears the value of the "bind_password" field.
+func (_u *LdapConfigUpdate) ClearBindPassword() *LdapConfigUpdate {
+	_u.mutation.ClearBindPassword()
+	return _u
+}
+
+// SetBaseDn sets the "base_dn" field.
+func (_u *LdapConfigUpdate) SetBaseDn(v string) *LdapConfigUpdate {
+	_u.mutation.SetBaseDn(v)
+	return _u
+}
+
+// SetNillableBaseDn sets the "base_dn" field if the given value is not nil.
+func (_u *LdapConfigUpdate) SetNillableBaseDn(v *string) *LdapConfigUpdate {
+	if v != nil {
+		_u.SetBaseDn(*v)
+	}
+	return _u
+}
+
+// ClearBaseDn clears the value of the "base_dn" field.
+func (_u *LdapConfigUpdate) ClearBaseDn() *LdapConfigUpdate {
+	_u.mutation.ClearBaseDn()
+	return _u
+}
+
+// SetUserFilter sets the "user_filter" field.
+func (_u *LdapConfigUpdate) SetUserFilter(v string) *LdapConfigUpdate {
+	_u.mutation.SetUserFilter(v)
+	return _u
+}
+
+// SetNillableUserFilter sets the "user_filter" field if the given value is not nil.
+func (_u *LdapConfigUpdate) SetNillableUserFilter(v *string) *LdapConfigUpdate {
+	if v != nil {
+		_u.SetUserFilter(*v)
+	}
+	return _u
+}
+
+// ClearUserFilter clears the value of the "user_filter" field.
+func (_u *LdapConfigUpdate) ClearUserFilter() *LdapConfigUpdate {
+	_u.mutation.ClearUserFilter()
+	return _u
+}
+
+// SetIDAttribute sets the "id_attribute" field.
+func (_u *LdapConfigUpdate) SetIDAttribute(v string) *LdapConfigUpdate {
+	_u.mutation.SetIDAttribute(v)
+	return _u
+}
+
+// SetNillableIDAttribute sets the "id_attribute" field if the given value is not nil.
+func (_u *LdapConfigUpdate) SetNillableIDAttribute(v *string) *LdapConfigUpdate {
+	if v != nil {
+		_u.SetIDAttribute(*v)
+	}
+	return _u
+}
+
+// ClearIDAttribute clears the value of the "id_attribute" field.
+func (_u *LdapConfigUpdate) ClearIDAttribute() *LdapConfigUpdate {
+	_u.mutation.ClearIDAttribute()
+	return _u
+}
+
+// SetAttributeMapping sets the "attribute_mapping" field.
+func (_u *LdapConfigUpdate) SetAttributeMapping(v map[string]string) *LdapConfigUpdate {
+	_u.mutation.SetAttributeMapping(v)
+	return _u
+}
+
+// ClearAttributeMapping clears the value of the "attribute_mapping" field.
+func (_u *LdapConfigUpdate) ClearAttributeMapping() *LdapConfigUpdate {
+	_u.mutation.ClearAttributeMapping()
+	return _u
+}
+
+// SetGroupFilter sets the "group_filter" field.
+func (_u *LdapConfigUpdate) SetGroupFilter(v string) *LdapConfigUpdate {
+	_u.mutation.SetGroupFilter(v)
+	return _u
+}
+
+// SetNillableGroupFilter sets the "group_filter" field if the given value is not nil.
+func (_u *LdapConfigUpdate) SetNillableGroupFilter(v *string) *LdapConfigUpdate {
+	if v != nil {
+		_u.SetGroupFilter(*v)
+	}
+	return _u
+}
+
+// ClearGroupFilter clears the value of the "group_filter" field.
+func (_u *LdapConfigUpdate) ClearGroupFilter() *LdapConfigUpdate {
+	_u.mutation.ClearGroupFilter()
+	return _u
+}
+
+// SetGroupMemberAttribute sets the "group_member_attribute" field.
+func (_u *LdapConfigUpdate) SetGroupMemberAttribute(v string) *LdapConfigUpdate {
+	_u.mutation.SetGroupMemberAttribute(v)
+	return _u
+}
+
+// SetNillableGroupMemberAttribute sets the "group_member_attribute" field if the given value is not nil.
+func (_u *LdapConfigUpdate) SetNillableGroupMemberAttribute(v *string) *LdapConfigUpdate {
+	if v != nil {
+		_u.SetGroupMemberAttribute(*v)
+	}
+	return _u
+}
+
+// ClearGroupMemberAttribute clears the value of the "group_member_attribute" field.
+func (_u *LdapConfigUpdate) ClearGroupMemberAttribute() *LdapConfigUpdate {
+	_u.mutation.ClearGroupMemberAttribute()
+	return _u
+}
+
+// SetGroupRoleMapping sets the "group_role_mapping" field.
+func (_u *LdapConfigUpdate) SetGroupRoleMapping(v map[string]uint32) *LdapConfigUpdate {
+	_u.mutation.SetGroupRoleMapping(v)
+	return _u
+}
+
+// ClearGroupRoleMapping clears the value of the "group_role_mapping" field.
+func (_u *LdapConfigUpdate) ClearGroupRoleMapping() *LdapConfigUpdate {
+	_u.mutation.ClearGroupRoleMapping()
+	return _u
+}
+
+// SetOrgUnitFilter sets the "org_unit_filter" field.
+func (_u *LdapConfigUpdate) SetOrgUnitFilter(v string) *LdapConfigUpdate {
+	_u.mutation.SetOrgUnitFilter(v)
+	return _u
+}
+
+// SetNillableOrgUnitFilter sets the "org_unit_filter" field if the given value is not nil.
+func (_u *LdapConfigUpdate) SetNillableOrgUnitFilter(v *string) *LdapConfigUpdate {
+	if v != nil {
+		_u.SetOrgUnitFilter(*v)
+	}
+	return _u
+}
+
+// ClearOrgUnitFilter clears the value of the "org_unit_filter" field.
+func (_u *LdapConfigUpdate) ClearOrgUnitFilter() *LdapConfigUpdate {
+	_u.mutation.ClearOrgUnitFilter()
+	return _u
+}
+
+// SetOrgUnitRootID sets the "org_unit_root_id" field.
+func (_u *LdapConfigUpdate) SetOrgUnitRootID(v uint32) *LdapConfigUpdate {
+	_u.mutation.ResetOrgUnitRootID()
+	_u.mutation.SetOrgUnitRootID(v)
+	return _u
+}
+
+// SetNillableOrgUnitRootID sets the "org_unit_root_id" field if the given value is not nil.
+func (_u *LdapConfigUpdate) SetNillableOrgUnitRootID(v *uint32) *LdapConfigUpdate {
+	if v != nil {
+		_u.SetOrgUnitRootID(*v)
+	}
+	return _u
+}
+
+// AddOrgUnitRootID adds value to the "org_unit_root_id" field.
+func (_u *LdapConfigUpdate) AddOrgUnitRootID(v int32) *LdapConfigUpdate {
+	_u.mutation.AddOrgUnitRootID(v)
+	return _u
+}
+
+// ClearOrgUnitRootID clears the value of the "org_unit_root_id" field.
+func (_u *LdapConfigUpdate) ClearOrgUnitRootID() *LdapConfigUpdate {
+	_u.mutation.ClearOrgUnitRootID()
+	return _u
+}
+
+// SetAuthMode sets the "auth_mode" field.
+func (_u *LdapConfigUpdate) SetAuthMode(v ldapconfig.AuthMode) *LdapConfigUpdate {
+	_u.mutation.SetAuthMode(v)
+	return _u
+}
+
+// SetNillableAuthMode sets the "auth_mode" field if the given value is not nil.
+func (_u *LdapConfigUpdate) SetNillableAuthMode(v *ldapconfig.AuthMode) *LdapConfigUpdate {
+	if v != nil {
+		_u.SetAuthMode(*v)
+	}
+	return _u
+}
+
+// ClearAuthMode clears the value of the "auth_mode" field.
+func (_u *LdapConfigUpdate) ClearAuthMode() *LdapConfigUpdate {
+	_u.mutation.ClearAuthMode()
+	return _u
+}
+
+// SetJitProvisioning sets the "jit_provisioning" field.
+func (_u *LdapConfigUpdate) SetJitProvisioning(v bool) *LdapConfigUpdate {
+	_u.mutation.SetJitProvisioning(v)
+	return _u
+}
+
+// SetNillableJitProvisioning sets the "jit_provisioning" field if the given value is not nil.
+func (_u *LdapConfigUpdate) SetNillableJitProvisioning(v *bool) *LdapConfigUpdate {
+	if v != nil {
+		_u.SetJitProvisioning(*v)
+	}
+	return _u
+}
+
+// ClearJitProvisioning clears the value of the "jit_provisioning" field.
+func (_u *LdapConfigUpdate) ClearJitProvisioning() *LdapConfigUpdate {
+	_u.mutation.ClearJitProvisioning()
+	return _u
+}
+
+// SetDefaultRoleIds sets the "default_role_ids" field.
+func (_u *LdapConfigUpdate) SetDefaultRoleIds(v []uint32) *LdapConfigUpdate {
+	_u.mutation.SetDefaultRoleIds(v)
+	return _u
+}
+
+// AppendDefaultRoleIds appends value to the "default_role_ids" field.
+func (_u *LdapConfigUpdate) AppendDefaultRoleIds(v []uint32) *LdapConfigUpdate {
+	_u.mutation.AppendDefaultRoleIds(v)
+	return _u
+}
+
+// ClearDefaultRoleIds clears the value of the "default_role_ids" field.
+func (_u *LdapConfigUpdate) ClearDefaultRoleIds() *LdapConfigUpdate {
+	_u.mutation.ClearDefaultRoleIds()
+	return _u
+}
+
+// SetDefaultOrgUnitID sets the "default_org_unit_id" field.
+func (_u *LdapConfigUpdate) SetDefaultOrgUnitID(v uint32) *LdapConfigUpdate {
+	_u.mutation.ResetDefaultOrgUnitID()
+	_u.mutation.SetDefaultOrgUnitID(v)
+	return _u
+}
+
+// SetNillableDefaultOrgUnitID sets the "default_org_unit_id" field if the given value is not nil.
+func (_u *LdapConfigUpdate) SetNillableDefaultOrgUnitID(v *uint32) *LdapConfigUpdate {
+	if v != nil {
+		_u.SetDefaultOrgUnitID(*v)
+	}
+	return _u
+}
+
+// AddDefaultOrgUnitID adds value to the "default_org_unit_id" field.
+func (_u *LdapConfigUpdate) AddDefaultOrgUnitID(v int32) *LdapConfigUpdate {
+	_u.mutation.AddDefaultOrgUnitID(v)
+	return _u
+}
+
+// ClearDefaultOrgUnitID clears the value of the "default_org_unit_id" field.
+func (_u *LdapConfigUpdate) ClearDefaultOrgUnitID() *LdapConfigUpdate {
+	_u.mutation.ClearDefaultOrgUnitID()
+	return _u
+}
+
+// SetLastSyncedAt sets the "last_synced_at" field.
+func (_u *LdapConfigUpdate) SetLastSyncedAt(v time.Time) *LdapConfigUpdate {
+	_u.mutation.SetLastSyncedAt(v)
+	return _u
+}
+
+// SetNillableLastSyncedAt sets the "last_synced_at" field if the given value is not nil.
+func (_u *LdapConfigUpdate) SetNillableLastSyncedAt(v *time.Time) *LdapConfigUpdate {
+	if v != nil {
+		_u.SetLastSyncedAt(*v)
+	}
+	return _u
+}
+
+// ClearLastSyncedAt clears the value of the "last_synced_at" field.
+func (_u *LdapConfigUpdate) ClearLastSyncedAt() *LdapConfigUpdate {
+	_u.mutation.ClearLastSyncedAt()
+	return _u
+}
+
+// SetLastSyncMessage sets the "last_sync_message" field.
+func (_u *LdapConfigUpdate) SetLastSyncMessage(v string) *LdapConfigUpdate {
+	_u.mutation.SetLastSyncMessage(v)
+	return _u
+}
+
+// SetNillableLastSyncMessage sets the "last_sync_message" field if the given value is not nil.
+func (_u *LdapConfigUpdate) SetNillableLastSyncMessage(v *string) *LdapConfigUpdate {
+	if v != nil {
+		_u.SetLastSyncMessage(*v)
+	}
+	return _u
+}
+
+// ClearLastSyncMessage clears the value of the "last_sync_message" field.
+func (_u *LdapConfigUpdate) ClearLastSyncMessage() *LdapConfigUpdate {
+	_u.mutation.ClearLastSyncMessage()
+	return _u
+}
+
+// Mutation returns the LdapConfigMutation object of the builder.
+func (_u *LdapConfigUpdate) Mutation() *LdapConfigMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *LdapConfigUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LdapConfigUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *LdapConfigUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LdapConfigUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LdapConfigUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := ldapconfig.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "LdapConfig.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DisplayName(); ok {
+		if err := ldapconfig.DisplayNameValidator(v); err != nil {
+			return &ValidationError{Name: "display_name", err: fmt.Errorf(`ent: validator failed for field "LdapConfig.display_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ServerURL(); ok {
+		if err := ldapconfig.ServerURLValidator(v); err != nil {
+			return &ValidationError{Name: "server_url", err: fmt.Errorf(`ent: validator failed for field "LdapConfig.server_url": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BindDn(); ok {
+		if err := ldapconfig.BindDnValidator(v); err != nil {
+			return &ValidationError{Name: "bind_dn", err: fmt.Errorf(`ent: validator failed for field "LdapConfig.bind_dn": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BindPassword(); ok {
+		if err := ldapconfig.BindPasswordValidator(v); err != nil {
+			return &ValidationError{Name: "bind_password", err: fmt.Errorf(`ent: validator failed for field "LdapConfig.bind_password": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BaseDn(); ok {
+		if err := ldapconfig.BaseDnValidator(v); err != nil {
+			return &ValidationError{Name: "base_dn", err: fmt.Errorf(`ent: validator failed for field "LdapConfig.base_dn": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UserFilter(); ok {
+		if err := ldapconfig.UserFilterValidator(v); err != nil {
+			return &ValidationError{Name: "user_filter", err: fmt.Errorf(`ent: validator failed for field "LdapConfig.user_filter": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.IDAttribute(); ok {
+		if err := ldapconfig.IDAttributeValidator(v); err != nil {
+			return &ValidationError{Name: "id_attribute", err: fmt.Errorf(`ent: validator failed for field "LdapConfig.id_attribute": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.GroupFilter(); ok {
+		if err := ldapconfig.GroupFilterValidator(v); err != nil {
+			return &ValidationError{Name: "group_filter", err: fmt.Errorf(`ent: validator failed for field "LdapConfig.group_filter": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.GroupMemberAttribute(); ok {
+		if err := ldapconfig.GroupMemberAttributeValidator(v); err != nil {
+			return &ValidationError{Name: "group_member_attribute", err: fmt.Errorf(`ent: validator failed for field "LdapConfig.group_member_attribute": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.OrgUnitFilter(); ok {
+		if err := ldapconfig.OrgUnitFilterValidator(v); err != nil {
+			return &ValidationError{Name: "org_unit_filter", err: fmt.Errorf(`ent: validator failed for field "LdapConfig.org_unit_filter": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AuthMode(); ok {
+		if err := ldapconfig.AuthModeValidator(v); err != nil {
+			return &ValidationError{Name: "auth_mode", err: fmt.Errorf(`ent: validator failed for field "LdapConfig.auth_mode": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LastSyncMessage(); ok {
+		if err := ldapconfig.LastSyncMessageValidator(v); err != nil {
+			return &ValidationError{Name: "last_sync_message", err: fmt.Errorf(`ent: validator failed for field "LdapConfig.last_sync_message": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LdapConfigUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LdapConfigUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *LdapConfigUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(ldapconfig.Table, ldapconfig.Columns, sqlgraph.NewFieldSpec(ldapconfig.FieldID, field.TypeUint32))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(ldapconfig.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(ldapconfig.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(ldapconfig.FieldUpdatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(ldapconfig.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(ldapconfig.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(ldapconfig.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(ldapconfig.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(ldapconfig.FieldCreatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UpdatedBy(); ok {
+		_spec.SetField(ldapconfig.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedBy(); ok {
+		_spec.AddField(ldapconfig.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdatedByCleared() {
+		_spec.ClearField(ldapconfig.FieldUpdatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.DeletedBy(); ok {
+		_spec.SetField(ldapconfig.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedBy(); ok {
+		_spec.AddField(ldapconfig.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.DeletedByCleared() {
+		_spec.ClearField(ldapconfig.FieldDeletedBy, field.TypeUint32)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(ldapconfig.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(ldapconfig.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.DisplayName(); ok {
+		_spec.SetField(ldapconfig.FieldDisplayName, field.TypeString, value)
+	}
+	if _u.mutation.DisplayNameCleared() {
+		_spec.ClearField(ldapconfig.FieldDisplayName, field.TypeString)
+	}
+	if value, ok := _u.mutation.ServerURL(); ok {
+		_spec.SetField(ldapconfig.FieldServerURL, field.TypeString, value)
+	}
+	if _u.mutation.ServerURLCleared() {
+		_spec.ClearField(ldapconfig.FieldServerURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.StartTLS(); ok {
+		_spec.SetField(ldapconfig.FieldStartTLS, field.TypeBool, value)
+	}
+	if _u.mutation.StartTLSCleared() {
+		_spec.ClearField(ldapconfig.FieldStartTLS, field.TypeBool)
+	}
+	if value, ok := _u.mutation.CaCertificate(); ok {
+		_spec.SetField(ldapconfig.FieldCaCertificate, field.TypeString, value)
+	}
+	if _u.mutation.CaCertificateCleared() {
+		_spec.ClearField(ldapconfig.FieldCaCertificate, field.TypeString)
+	}
+	if value, ok := _u.mutation.BindDn(); ok {
+		_spec.SetField(ldapconfig.FieldBindDn, field.TypeString, value)
+	}
+	if _u.mutation.BindDnCleared() {
+		_spec.ClearField(ldapconfig.FieldBindDn, field.TypeString)
+	}
+	if value, ok := _u.mutation.BindPassword(); ok {
+		_spec.SetField(ldapconfig.FieldBindPassword, field.TypeString, value)
+	}
+	if _u.mutation.BindPasswordCleared() {
+		_spec.ClearField(ldapconfig.FieldBindPassword, field.TypeString)
+	}
+	if value, ok := _u.mutation.BaseDn(); ok {
+		_spec.SetField(ldapconfig.FieldBaseDn, field.TypeString, value)
+	}
+	if _u.mutation.BaseDnCleared() {
+		_spec.ClearField(ldapconfig.FieldBaseDn, field.TypeString)
+	}
+	if value, ok := _u.mutation.UserFilter(); ok {
+		_spec.SetField(ldapconfig.FieldUserFilter, field.TypeString, value)
+	}
+	if _u.mutation.UserFilterCleared() {
+		_spec.ClearField(ldapconfig.FieldUserFilter, field.TypeString)
+	}
+	if value, ok := _u.mutation.IDAttribute(); ok {
+		_spec.SetField(ldapconfig.FieldIDAttribute, field.TypeString, value)
+	}
+	if _u.mutation.IDAttributeCleared() {
+		_spec.ClearField(ldapconfig.FieldIDAttribute, field.TypeString)
+	}
+	if value, ok := _u.mutation.AttributeMapping(); ok {
+		_spec.SetField(ldapconfig.FieldAttributeMapping, field.TypeJSON, value)
+	}
+	if _u.mutation.AttributeMappingCleared() {
+		_spec.ClearField(ldapconfig.FieldAttributeMapping, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.GroupFilter(); ok {
+		_spec.SetField(ldapconfig.FieldGroupFilter, field.TypeString, value)
+	}
+	if _u.mutation.GroupFilterCleared() {
+		_spec.ClearField(ldapconfig.FieldGroupFilter, field.TypeString)
+	}
+	if value, ok := _u.mutation.GroupMemberAttribute(); ok {
+		_spec.SetField(ldapconfig.FieldGroupMemberAttribute, field.TypeString, value)
+	}
+	if _u.mutation.GroupMemberAttributeCleared() {
+		_spec.ClearField(ldapconfig.FieldGroupMemberAttribute, field.TypeString)
+	}
+	if value, ok := _u.mutation.GroupRoleMapping(); ok {
+		_spec.SetField(ldapconfig.FieldGroupRoleMapping, field.TypeJSON, value)
+	}
+	if _u.mutation.GroupRoleMappingCleared() {
+		_spec.ClearField(ldapconfig.FieldGroupRoleMapping, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.OrgUnitFilter(); ok {
+		_spec.SetField(ldapconfig.FieldOrgUnitFilter, field.TypeString, value)
+	}
+	if _u.mutation.OrgUnitFilterCleared() {
+		_spec.ClearField(ldapconfig.FieldOrgUnitFilter, field.TypeString)
+	}
+	if value, ok := _u.mutation.OrgUnitRootID(); ok {
+		_spec.SetField(ldapconfig.FieldOrgUnitRootID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedOrgUnitRootID(); ok {
+		_spec.AddField(ldapconfig.FieldOrgUnitRootID, field.TypeUint32, value)
+	}
+	if _u.mutation.OrgUnitRootIDCleared() {
+		_spec.ClearField(ldapconfig.FieldOrgUnitRootID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.AuthMode(); ok {
+		_spec.SetField(ldapconfig.FieldAuthMode, field.TypeEnum, value)
+	}
+	if _u.mutation.AuthModeCleared() {
+		_spec.ClearField(ldapconfig.FieldAuthMode, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.JitProvisioning(); ok {
+		_spec.SetField(ldapconfig.FieldJitProvisioning, field.TypeBool, value)
+	}
+	if _u.mutation.JitProvisioningCleared() {
+		_spec.ClearField(ldapconfig.FieldJitProvisioning, field.TypeBool)
+	}
+	if value, ok := _u.mutation.DefaultRoleIds(); ok {
+		_spec.SetField(ldapconfig.FieldDefaultRoleIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedDefaultRoleIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, ldapconfig.FieldDefaultRoleIds, value)
+		})
+	}
+	if _u.mutation.DefaultRoleIdsCleared() {
+		_spec.ClearField(ldapconfig.FieldDefaultRoleIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.DefaultOrgUnitID(); ok {
+		_spec.SetField(ldapconfig.FieldDefaultOrgUnitID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedDefaultOrgUnitID(); ok {
+		_spec.AddField(ldapconfig.FieldDefaultOrgUnitID, field.TypeUint32, value)
+	}
+	if _u.mutation.DefaultOrgUnitIDCleared() {
+		_spec.ClearField(ldapconfig.FieldDefaultOrgUnitID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.LastSyncedAt(); ok {
+		_spec.SetField(ldapconfig.FieldLastSyncedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastSyncedAtCleared() {
+		_spec.ClearField(ldapconfig.FieldLastSyncedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastSyncMessage(); ok {
+		_spec.SetField(ldapconfig.FieldLastSyncMessage, field.TypeString, value)
+	}
+	if _u.mutation.LastSyncMessageCleared() {
+		_spec.ClearField(ldapconfig.FieldLastSyncMessage, field.TypeString)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ldapconfig.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// LdapConfigUpdateOne is the builder for updating a single LdapConfig entity.
+type LdapConfigUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *LdapConfigMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *LdapConfigUpdateOne) SetUpdatedAt(v time.Time) *LdapConfigUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *LdapConfigUpdateOne) SetNillableUpdatedAt(v *time.Time) *LdapConfigUpdateOne {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *LdapConfigUpdateOne) ClearUpdatedAt() *LdapConfigUpdateOne {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *LdapConfigUpdateOne) SetDeletedAt(v time.Time) *LdapConfigUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *LdapConfigUpdateOne) SetNillableDeletedAt(v *time.Time) *LdapConfigUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *LdapConfigUpdateOne) ClearDeletedAt() *LdapConfigUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *LdapConfigUpdateOne) SetCreatedBy(v uint32) *LdapConfigUpdateOne {
+	_u.mutation.ResetCreatedBy()
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *LdapConfigUpdateOne) SetNillableCreatedBy(v *uint32) *LdapConfigUpdateOne {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// AddCreatedBy adds value to the "created_by" field.
+func (_u *LdapConfigUpdateOne) AddCreatedBy(v int32) *LdapConfigUpdateOne {
+	_u.mutation.AddCreatedBy(v)
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *LdapConfigUpdateOne) ClearCreatedBy() *LdapConfigUpdateOne {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_u *LdapConfigUpdateOne) SetUpdatedBy(v uint32) *LdapConfigUpdateOne {
+	_u.mutation.ResetUpdatedBy()
+	_u.mutation.SetUpdatedBy(v)
+	return _u
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_u *LdapConfigUpdateOne) SetNillableUpdatedBy(v *uint32) *LdapConfigUpdateOne {
+	if v != nil {
+		_u.SetUpdatedBy(*v)
+	}
+	return _u
+}
+
+// AddUpdatedBy adds value to the "updated_by" field.
+func (_u *LdapConfigUpdateOne) AddUpdatedBy(v int32) *LdapConfigUpdateOne {
+	_u.mutation.AddUpdatedBy(v)
+	return _u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (_u *LdapConfigUpdateOne) ClearUpdatedBy() *LdapConfigUpdateOne {
+	_u.mutation.ClearUpdatedBy()
+	return _u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_u *LdapConfigUpdateOne) SetDeletedBy(v uint32) *LdapConfigUpdateOne {
+	_u.mutation.ResetDeletedBy()
+	_u.mutation.SetDeletedBy(v)
+	return _u
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_u *LdapConfigUpdateOne) SetNillableDeletedBy(v *uint32) *LdapConfigUpdateOne {
+	if v != nil {
+		_u.SetDeletedBy(*v)
+	}
+	return _u
+}
+
+// AddDeletedBy adds value to the "deleted_by" field.
+func (_u *LdapConfigUpdateOne) AddDeletedBy(v int32) *LdapConfigUpdateOne {
+	_u.mutation.AddDeletedBy(v)
+	return _u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (_u *LdapConfigUpdateOne) ClearDeletedBy() *LdapConfigUpdateOne {
+	_u.mutation.ClearDeletedBy()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *LdapConfigUpdateOne) SetStatus(v ldapconfig.Status) *LdapConfigUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *LdapConfigUpdateOne) SetNillableStatus(v *ldapconfig.Status) *LdapConfigUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetDisplayName sets the "display_name" field.
+func (_u *LdapConfigUpdateOne) SetDisplayName(v string) *LdapConfigUpdateOne {
+	_u.mutation.SetDisplayName(v)
+	return _u
+}
+
+// SetNillableDisplayName sets the "display_name" field if the given value is not nil.
+func (_u *LdapConfigUpdateOne) SetNillableDisplayName(v *string) *LdapConfigUpdateOne {
+	if v != nil {
+		_u.SetDisplayName(*v)
+	}
+	return _u
+}
+
+// ClearDisplayName clears the value of the "display_name" field.
+func (_u *LdapConfigUpdateOne) ClearDisplayName() *LdapConfigUpdateOne {
+	_u.mutation.ClearDisplayName()
+	return _u
+}
+
+// SetServerURL sets the "server_url" field.
+func (_u *LdapConfigUpdateOne) SetServerURL(v string) *LdapConfigUpdateOne {
+	_u.mutation.SetServerURL(v)
+	return _u
+}
+
+// SetNillableServerURL sets the "server_url" field if the given value is not nil.
+func (_u *LdapConfigUpdateOne) SetNillableServerURL(v *string) *LdapConfigUpdateOne {
+	if v != nil {
+		_u.SetServerURL(*v)
+	}
+	return _u
+}
+
+// ClearServerURL clears the value of the "server_url" field.
+func (_u *LdapConfigUpdateOne) ClearServerURL() *LdapConfigUpdateOne {
+	_u.mutation.ClearServerURL()
+	return _u
+}
+
+// SetStartTLS sets the "start_tls" field.
+func (_u *LdapConfigUpdateOne) SetStartTLS(v bool) *LdapConfigUpdateOne {
+	_u.mutation.SetStartTLS(v)
+	return _u
+}
+
+// SetNillableStartTLS sets the "start_tls" field if the given value is not nil.
+func (_u *LdapConfigUpdateOne) SetNillableStartTLS(v *bool) *LdapConfigUpdateOne {
+	if v != nil {
+		_u.SetStartTLS(*v)
+	}
+	return _u
+}
+
+// ClearStartTLS clears the value of the "start_tls" field.
+func (_u *LdapConfigUpdateOne) ClearStartTLS() *LdapConfigUpdateOne {
+	_u.mutation.ClearStartTLS()
+	return _u
+}
+
+// SetCaCertificate sets the "ca_certificate" field.
+func (_u *LdapConfigUpdateOne) SetCaCertificate(v string) *LdapConfigUpdateOne {
+	_u.mutation.SetCaCertificate(v)
+	return _u
+}
+
+// SetNillableCaCertificate sets the "ca_certificate" field if the given value is not nil.
+func (_u *LdapConfigUpdateOne) SetNillableCaCertificate(v *string) *LdapConfigUpdateOne {
+	if v != nil {
+		_u.SetCaCertificate(*v)
+	}
+	return _u
+}
+
+// ClearCaCertificate clears the value of the "ca_certificate" field.
+func (_u *LdapConfigUpdateOne) ClearCaCertificate() *LdapConfigUpdateOne {
+	_u.mutation.ClearCaCertificate()
+	return _u
+}
+
+// SetBindDn sets the "bind_dn" field.
+func (_u *LdapConfigUpdateOne) SetBindDn(v string) *LdapConfigUpdateOne {
+	_u.mutation.SetBindDn(v)
+	return _u
+}
+
+// SetNillableBindDn sets the "bind_dn" field if the given value is not nil.
+func (_u *LdapConfigUpdateOne) SetNillableBindDn(v *string) *LdapConfigUpdateOne {
+	if v != nil {
+		_u.SetBindDn(*v)
+	}
+	return _u
+}
+
+// ClearBindDn clears the value of the "bind_dn" field.
+func (_u *LdapConfigUpdateOne) ClearBindDn() *LdapConfigUpdateOne {
+	_u.mutation.ClearBindDn()
+	return _u
+}
+
+// SetBindPassword sets the "bind_password" field.
+func (_u *LdapConfigUpdateOne) SetBindPassword(v string) *LdapConfigUpdateOne {
+	_u.mutation.SetBindPassword(v)
+	return _u
+}
+
+// SetNillableBindPassword sets the "bind_password" field if the given value is not nil.
+func (_u *LdapConfigUpdateOne) SetNillableBindPassword(v *string) *LdapConfigUpdateOne {
+	if v != nil {
+		_u.SetBindPassword(*v)
+	}
+	return _u
+}
+
+// ClearBindPassword clears the value of the "bind_password" field.
+func (_u *LdapConfigUpdateOne) ClearBindPassword() *LdapConfigUpdateOne {
+	_u.mutation.ClearBindPassword()
+	return _u
+}
+
+// SetBaseDn sets the "base_dn" field.
+func (_u *LdapConfigUpdateOne) SetBaseDn(v string) *LdapConfigUpdateOne {
+	_u.mutation.SetBaseDn(v)
+	return _u
+}
+
+// SetNillableBaseDn sets the "base_dn" field if the given value is not nil.
+func (_u *LdapConfigUpdateOne) SetNillableBaseDn(v *string) *LdapConfigUpdateOne {
+	if v != nil {
+		_u.SetBaseDn(*v)
+	}
+	return _u
+}
+
+// ClearBaseDn clears the value of the "base_dn" field.
+func (_u *LdapConfigUpdateOne) ClearBaseDn() *LdapConfigUpdateOne {
+	_u.mutation.ClearBaseDn()
+	return _u
+}
+
+// SetUserFilter sets the "user_filter" field.
+func (_u *LdapConfigUpdateOne) SetUserFilter(v string) *LdapConfigUpdateOne {
+	_u.mutation.SetUserFilter(v)
+	return _u
+}
+
+// SetNillableUserFilter sets the "user_filter" field if the given value is not nil.
+func (_u *LdapConfigUpdateOne) SetNillableUserFilter(v *string) *LdapConfigUpdateOne {
+	if v != nil {
+		_u.SetUserFilter(*v)
+	}
+	return _u
+}
+
+// ClearUserFilter clears the value of the "user_filter" field.
+func (_u *LdapConfigUpdateOne) ClearUserFilter() *LdapConfigUpdateOne {
+	_u.mutation.ClearUserFilter()
+	return _u
+}
+
+// SetIDAttribute sets the "id_attribute" field.
+func (_u *LdapConfigUpdateOne) SetIDAttribute(v string) *LdapConfigUpdateOne {
+	_u.mutation.SetIDAttribute(v)
+	return _u
+}
+
+// SetNillableIDAttribute sets the "id_attribute" field if the given value is not nil.
+func (_u *LdapConfigUpdateOne) SetNillableIDAttribute(v *string) *LdapConfigUpdateOne {
+	if v != nil {
+		_u.SetIDAttribute(*v)
+	}
+	return _u
+}
+
+// ClearIDAttribute clears the value of the "id_attribute" field.
+func (_u *LdapConfigUpdateOne) ClearIDAttribute() *LdapConfigUpdateOne {
+	_u.mutation.ClearIDAttribute()
+	return _u
+}
+
+// SetAttributeMapping sets the "attribute_mapping" field.
+func (_u *LdapConfigUpdateOne) SetAttributeMapping(v map[string]string) *LdapConfigUpdateOne {
+	_u.mutation.SetAttributeMapping(v)
+	return _u
+}
+
+// ClearAttributeMapping clears the value of the "attribute_mapping" field.
+func (_u *LdapConfigUpdateOne) ClearAttributeMapping() *LdapConfigUpdateOne {
+	_u.mutation.ClearAttributeMapping()
+	return _u
+}
+
+// SetGroupFilter sets the "group_filter" field.
+func (_u *LdapConfigUpdateOne) SetGroupFilter(v string) *LdapConfigUpdateOne {
+	_u.mutation.SetGroupFilter(v)
+	return _u
+}
+
+// SetNillableGroupFilter sets the "group_filter" field if the given value is not nil.
+func (_u *LdapConfigUpdateOne) SetNillableGroupFilter(v *string) *LdapConfigUpdateOne {
+	if v != nil {
+		_u.SetGroupFilter(*v)
+	}
+	return _u
+}
+
+// ClearGroupFilter clears the value of the "group_filter" field.
+func (_u *LdapConfigUpdateOne) ClearGroupFilter() *LdapConfigUpdateOne {
+	_u.mutation.ClearGroupFilter()
+	return _u
+}
+
+// SetGroupMemberAttribute sets the "group_member_attribute" field.
+func (_u *LdapConfigUpdateOne) SetGroupMemberAttribute(v string) *LdapConfigUpdateOne {
+	_u.mutation.SetGroupMemberAttribute(v)
+	return _u
+}
+
+// SetNillableGroupMemberAttribute sets the "group_member_attribute" field if the given value is not nil.
+func (_u *LdapConfigUpdateOne) SetNillableGroupMemberAttribute(v *string) *LdapConfigUpdateOne {
+	if v != nil {
+		_u.SetGroupMemberAttribute(*v)
+	}
+	return _u
+}
+
+// ClearGroupMemberAttribute clears the value of the "group_member_attribute" field.
+func (_u *LdapConfigUpdateOne) ClearGroupMemberAttribute() *LdapConfigUpdateOne {
+	_u.mutation.ClearGroupMemberAttribute()
+	return _u
+}
+
+// SetGroupRoleMapping sets the "group_role_mapping" field.
+func (_u *LdapConfigUpdateOne) SetGroupRoleMapping(v map[string]uint32) *LdapConfigUpdateOne {
+	_u.mutation.SetGroupRoleMapping(v)
+	return _u
+}
+
+// ClearGroupRoleMapping clears the value of the "group_role_mapping" field.
+func (_u *LdapConfigUpdateOne) ClearGroupRoleMapping() *LdapConfigUpdateOne {
+	_u.mutation.ClearGroupRoleMapping()
+	return _u
+}
+
+// SetOrgUnitFilter sets the "org_unit_filter" field.
+func (_u *LdapConfigUpdateOne) SetOrgUnitFilter(v string) *LdapConfigUpdateOne {
+	_u.mutation.SetOrgUnitFilter(v)
+	return _u
+}
+
+// SetNillableOrgUnitFilter sets the "org_unit_filter" field if the given value is not nil.
+func (_u *LdapConfigUpdateOne) SetNillableOrgUnitFilter(v *string) *LdapConfigUpdateOne {
+	if v != nil {
+		_u.SetOrgUnitFilter(*v)
+	}
+	return _u
+}
+
+// ClearOrgUnitFilter clears the value of the "org_unit_filter" field.
+func (_u *LdapConfigUpdateOne) ClearOrgUnitFilter() *LdapConfigUpdateOne {
+	_u.mutation.ClearOrgUnitFilter()
+	return _u
+}
+
+// SetOrgUnitRootID sets the "org_unit_root_id" field.
+func (_u *LdapConfigUpdateOne) SetOrgUnitRootID(v uint32) *LdapConfigUpdateOne {
+	_u.mutation.ResetOrgUnitRootID()
+	_u.mutation.SetOrgUnitRootID(v)
+	return _u
+}
+
+// SetNillableOrgUnitRootID sets the "org_unit_root_id" field if the given value is not nil.
+func (_u *LdapConfigUpdateOne) SetNillableOrgUnitRootID(v *uint32) *LdapConfigUpdateOne {
+	if v != nil {
+		_u.SetOrgUnitRootID(*v)
+	}
+	return _u
+}
+
+// AddOrgUnitRootID adds value to the "org_unit_root_id" field.
+func (_u *LdapConfigUpdateOne) AddOrgUnitRootID(v int32) *LdapConfigUpdateOne {
+	_u.mutation.AddOrgUnitRootID(v)
+	return _u
+}
+
+// ClearOrgUnitRootID clears the value of the "org_unit_root_id" field.
+func (_u *LdapConfigUpdateOne) ClearOrgUnitRootID() *LdapConfigUpdateOne {
+	_u.mutation.ClearOrgUnitRootID()
+	return _u
+}
+
+// SetAuthMode sets the "auth_mode" field.
+func (_u *LdapConfigUpdateOne) SetAuthMode(v ldapconfig.AuthMode) *LdapConfigUpdateOne {
+	_u.mutation.SetAuthMode(v)
+	return _u
+}
+
+// SetNillableAuthMode sets the "auth_mode" field if the given value is not nil.
+func (_u *LdapConfigUpdateOne) SetNillableAuthMode(v *ldapconfig.AuthMode) *LdapConfigUpdateOne {
+	if v != nil {
+		_u.SetAuthMode(*v)
+	}
+	return _u
+}
+
+// ClearAuthMode clears the value of the "auth_mode" field.
+func (_u *LdapConfigUpdateOne) ClearAuthMode() *LdapConfigUpdateOne {
+	_u.mutation.ClearAuthMode()
+	return _u
+}
+
+// SetJitProvisioning sets the "jit_provisioning" field.
+func (_u *LdapConfigUpdateOne) SetJitProvisioning(v bool) *LdapConfigUpdateOne {
+	_u.mutation.SetJitProvisioning(v)
+	return _u
+}
+
+// SetNillableJitProvisioning sets the "jit_provisioning" field if the given value is not nil.
+func (_u *LdapConfigUpdateOne) SetNillableJitProvisioning(v *bool) *LdapConfigUpdateOne {
+	if v != nil {
+		_u.SetJitProvisioning(*v)
+	}
+	return _u
+}
+
+// ClearJitProvisioning clears the value of the "jit_provisioning" field.
+func (_u *LdapConfigUpdateOne) ClearJitProvisioning() *LdapConfigUpdateOne {
+	_u.mutation.ClearJitProvisioning()
+	return _u
+}
+
+// SetDefaultRoleIds sets the "default_role_ids" field.
+func (_u *LdapConfigUpdateOne) SetDefaultRoleIds(v []uint32) *LdapConfigUpdateOne {
+	_u.mutation.SetDefaultRoleIds(v)
+	return _u
+}
+
+// AppendDefaultRoleIds appends value to the "default_role_ids" field.
+func (_u *LdapConfigUpdateOne) AppendDefaultRoleIds(v []uint32) *LdapConfigUpdateOne {
+	_u.mutation.AppendDefaultRoleIds(v)
+	return _u
+}
+
+// ClearDefaultRoleIds clears the value of the "default_role_ids" field.
+func (_u *LdapConfigUpdateOne) ClearDefaultRoleIds() *LdapConfigUpdateOne {
+	_u.mutation.ClearDefaultRoleIds()
+	return _u
+}
+
+// SetDefaultOrgUnitID sets the "default_org_unit_id" field.
+func (_u *LdapConfigUpdateOne) SetDefaultOrgUnitID(v uint32) *LdapConfigUpdateOne {
+	_u.mutation.ResetDefaultOrgUnitID()
+	_u.mutation.SetDefaultOrgUnitID(v)
+	return _u
+}
+
+// SetNillableDefaultOrgUnitID sets the "default_org_unit_id" field if the given value is not nil.
+func (_u *LdapConfigUpdateOne) SetNillableDefaultOrgUnitID(v *uint32) *LdapConfigUpdateOne {
+	if v != nil {
+		_u.SetDefaultOrgUnitID(*v)
+	}
+	return _u
+}
+
+// AddDefaultOrgUnitID adds value to the "default_org_unit_id" field.
+func (_u *LdapConfigUpdateOne) AddDefaultOrgUnitID(v int32) *LdapConfigUpdateOne {
+	_u.mutation.AddDefaultOrgUnitID(v)
+	return _u
+}
+
+// ClearDefaultOrgUnitID clears the value of the "default_org_unit_id" field.
+func (_u *LdapConfigUpdateOne) ClearDefaultOrgUnitID() *LdapConfigUpdateOne {
+	_u.mutation.ClearDefaultOrgUnitID()
+	return _u
+}
+
+// SetLastSyncedAt sets the "last_synced_at" field.
+func (_u *LdapConfigUpdateOne) SetLastSyncedAt(v time.Time) *LdapConfigUpdateOne {
+	_u.mutation.SetLastSyncedAt(v)
+	return _u
+}
+
+// SetNillableLastSyncedAt sets the "last_synced_at" field if the given value is not nil.
+func (_u *LdapConfigUpdateOne) SetNillableLastSyncedAt(v *time.Time) *LdapConfigUpdateOne {
+	if v != nil {
+		_u.SetLastSyncedAt(*v)
+	}
+	return _u
+}
+
+// ClearLastSyncedAt clears the value of the "last_synced_at" field.
+func (_u *LdapConfigUpdateOne) ClearLastSyncedAt() *LdapConfigUpdateOne {
+	_u.mutation.ClearLastSyncedAt()
+	return _u
+}
+
+// SetLastSyncMessage sets the "last_sync_message" field.
+func (_u *LdapConfigUpdateOne) SetLastSyncMessage(v string) *LdapConfigUpdateOne {
+	_u.mutation.SetLastSyncMessage(v)
+	return _u
+}
+
+// SetNillableLastSyncMessage sets the "last_sync_message" field if the given value is not nil.
+func (_u *LdapConfigUpdateOne) SetNillableLastSyncMessage(v *string) *LdapConfigUpdateOne {
+	if v != nil {
+		_u.SetLastSyncMessage(*v)
+	}
+	return _u
+}
+
+// ClearLastSyncMessage clears the value of the "last_sync_message" field.
+func (_u *LdapConfigUpdateOne) ClearLastSyncMessage() *LdapConfigUpdateOne {
+	_u.mutation.ClearLastSyncMessage()
+	return _u
+}
+
+// Mutation returns the LdapConfigMutation object of the builder.
+func (_u *LdapConfigUpdateOne) Mutation() *LdapConfigMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the LdapConfigUpdate builder.
+func (_u *LdapConfigUpdateOne) Where(ps ...predicate.LdapConfig) *LdapConfigUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *LdapConfigUpdateOne) Select(field string, fields ...string) *LdapConfigUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated LdapConfig entity.
+func (_u *LdapConfigUpdateOne) Save(ctx context.Context) (*LdapConfig, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LdapConfigUpdateOne) SaveX(ctx context.Context) *LdapConfig {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *LdapConfigUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LdapConfigUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LdapConfigUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := ldapconfig.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "LdapConfig.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DisplayName(); ok {
+		if err := ldapconfig.DisplayNameValidator(v); err != nil {
+			return &ValidationError{Name: "display_name", err: fmt.Errorf(`ent: validator failed for field "LdapConfig.display_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ServerURL(); ok {
+		if err := ldapconfig.ServerURLValidator(v); err != nil {
+			return &ValidationError{Name: "server_url", err: fmt.Errorf(`ent: validator failed for field "LdapConfig.server_url": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BindDn(); ok {
+		if err := ldapconfig.BindDnValidator(v); err != nil {
+			return &ValidationError{Name: "bind_dn", err: fmt.Errorf(`ent: validator failed for field "LdapConfig.bind_dn": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BindPassword(); ok {
+		if err := ldapconfig.BindPasswordValidator(v); err != nil {
+			return &ValidationError{Name: "bind_password", err: fmt.Errorf(`ent: validator failed for field "LdapConfig.bind_password": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BaseDn(); ok {
+		if err := ldapconfig.BaseDnValidator(v); err != nil {
+			return &ValidationError{Name: "base_dn", err: fmt.Errorf(`ent: validator failed for field "LdapConfig.base_dn": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UserFilter(); ok {
+		if err := ldapconfig.UserFilterValidator(v); err != nil {
+			return &ValidationError{Name: "user_filter", err: fmt.Errorf(`ent: validator failed for field "LdapConfig.user_filter": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.IDAttribute(); ok {
+		if err := ldapconfig.IDAttributeValidator(v); err != nil {
+			return &ValidationError{Name: "id_attribute", err: fmt.Errorf(`ent: validator failed for field "LdapConfig.id_attribute": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.GroupFilter(); ok {
+		if err := ldapconfig.GroupFilterValidator(v); err != nil {
+			return &ValidationError{Name: "group_filter", err: fmt.Errorf(`ent: validator failed for field "LdapConfig.group_filter": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.GroupMemberAttribute(); ok {
+		if err := ldapconfig.GroupMemberAttributeValidator(v); err != nil {
+			return &ValidationError{Name: "group_member_attribute", err: fmt.Errorf(`ent: validator failed for field "LdapConfig.group_member_attribute": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.OrgUnitFilter(); ok {
+		if err := ldapconfig.OrgUnitFilterValidator(v); err != nil {
+			return &ValidationError{Name: "org_unit_filter", err: fmt.Errorf(`ent: validator failed for field "LdapConfig.org_unit_filter": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AuthMode(); ok {
+		if err := ldapconfig.AuthModeValidator(v); err != nil {
+			return &ValidationError{Name: "auth_mode", err: fmt.Errorf(`ent: validator failed for field "LdapConfig.auth_mode": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LastSyncMessage(); ok {
+		if err := ldapconfig.LastSyncMessageValidator(v); err != nil {
+			return &ValidationError{Name: "last_sync_message", err: fmt.Errorf(`ent: validator failed for field "LdapConfig.last_sync_message": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LdapConfigUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LdapConfigUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *LdapConfigUpdateOne) sqlSave(ctx context.Context) (_node *LdapConfig, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(ldapconfig.Table, ldapconfig.Columns, sqlgraph.NewFieldSpec(ldapconfig.FieldID, field.TypeUint32))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LdapConfig.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, ldapconfig.FieldID)
+		for _, f := range fields {
+			if !ldapconfig.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != ldapconfig.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(ldapconfig.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(ldapconfig.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(ldapconfig.FieldUpdatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(ldapconfig.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(ldapconfig.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(ldapconfig.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(ldapconfig.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(ldapconfig.FieldCreatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UpdatedBy(); ok {
+		_spec.SetField(ldapconfig.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedBy(); ok {
+		_spec.AddField(ldapconfig.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdatedByCleared() {
+		_spec.ClearField(ldapconfig.FieldUpdatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.DeletedBy(); ok {
+		_spec.SetField(ldapconfig.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedBy(); ok {
+		_spec.AddField(ldapconfig.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.DeletedByCleared() {
+		_spec.ClearField(ldapconfig.FieldDeletedBy, field.TypeUint32)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(ldapconfig.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(ldapconfig.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.DisplayName(); ok {
+		_spec.SetField(ldapconfig.FieldDisplayName, field.TypeString, value)
+	}
+	if _u.mutation.DisplayNameCleared() {
+		_spec.ClearField(ldapconfig.FieldDisplayName, field.TypeString)
+	}
+	if value, ok := _u.mutation.ServerURL(); ok {
+		_spec.SetField(ldapconfig.FieldServerURL, field.TypeString, value)
+	}
+	if _u.mutation.ServerURLCleared() {
+		_spec.ClearField(ldapconfig.FieldServerURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.StartTLS(); ok {
+		_spec.SetField(ldapconfig.FieldStartTLS, field.TypeBool, value)
+	}
+	if _u.mutation.StartTLSCleared() {
+		_spec.ClearField(ldapconfig.FieldStartTLS, field.TypeBool)
+	}
+	if value, ok := _u.mutation.CaCertificate(); ok {
+		_spec.SetField(ldapconfig.FieldCaCertificate, field.TypeString, value)
+	}
+	if _u.mutation.CaCertificateCleared() {
+		_spec.ClearField(ldapconfig.FieldCaCertificate, field.TypeString)
+	}
+	if value, ok := _u.mutation.BindDn(); ok {
+		_spec.SetField(ldapconfig.FieldBindDn, field.TypeString, value)
+	}
+	if _u.mutation.BindDnCleared() {
+		_spec.ClearField(ldapconfig.FieldBindDn, field.TypeString)
+	}
+	if value, ok := _u.mutation.BindPassword(); ok {
+		_spec.SetField(ldapconfig.FieldBindPassword, field.TypeString, value)
+	}
+	if _u.mutation.BindPasswordCleared() {
+		_spec.ClearField(ldapconfig.FieldBindPassword, field.TypeString)
+	}
+	if value, ok := _u.mutation.BaseDn(); ok {
+		_spec.SetField(ldapconfig.FieldBaseDn, field.TypeString, value)
+	}
+	if _u.mutation.BaseDnCleared() {
+		_spec.ClearField(ldapconfig.FieldBaseDn, field.TypeString)
+	}
+	if value, ok := _u.mutation.UserFilter(); ok {
+		_spec.SetField(ldapconfig.FieldUserFilter, field.TypeString, value)
+	}
+	if _u.mutation.UserFilterCleared() {
+		_spec.ClearField(ldapconfig.FieldUserFilter, field.TypeString)
+	}
+	if value, ok := _u.mutation.IDAttribute(); ok {
+		_spec.SetField(ldapconfig.FieldIDAttribute, field.TypeString, value)
+	}
+	if _u.mutation.IDAttributeCleared() {
+		_spec.ClearField(ldapconfig.FieldIDAttribute, field.TypeString)
+	}
+	if value, ok := _u.mutation.AttributeMapping(); ok {
+		_spec.SetField(ldapconfig.FieldAttributeMapping, field.TypeJSON, value)
+	}
+	if _u.mutation.AttributeMappingCleared() {
+		_spec.ClearField(ldapconfig.FieldAttributeMapping, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.GroupFilter(); ok {
+		_spec.SetField(ldapconfig.FieldGroupFilter, field.TypeString, value)
+	}
+	if _u.mutation.GroupFilterCleared() {
+		_spec.ClearField(ldapconfig.FieldGroupFilter, field.TypeString)
+	}
+	if value, ok := _u.mutation.GroupMemberAttribute(); ok {
+		_spec.SetField(ldapconfig.FieldGroupMemberAttribute, field.TypeString, value)
+	}
+	if _u.mutation.GroupMemberAttributeCleared() {
+		_spec.ClearField(ldapconfig.FieldGroupMemberAttribute, field.TypeString)
+	}
+	if value, ok := _u.mutation.GroupRoleMapping(); ok {
+		_spec.SetField(ldapconfig.FieldGroupRoleMapping, field.TypeJSON, value)
+	}
+	if _u.mutation.GroupRoleMappingCleared() {
+		_spec.ClearField(ldapconfig.FieldGroupRoleMapping, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.OrgUnitFilter(); ok {
+		_spec.SetField(ldapconfig.FieldOrgUnitFilter, field.TypeString, value)
+	}
+	if _u.mutation.OrgUnitFilterCleared() {
+		_spec.ClearField(ldapconfig.FieldOrgUnitFilter, field.TypeString)
+	}
+	if value, ok := _u.mutation.OrgUnitRootID(); ok {
+		_spec.SetField(ldapconfig.FieldOrgUnitRootID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedOrgUnitRootID(); ok {
+		_spec.AddField(ldapconfig.FieldOrgUnitRootID, field.TypeUint32, value)
+	}
+	if _u.mutation.OrgUnitRootIDCleared() {
+		_spec.ClearField(ldapconfig.FieldOrgUnitRootID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.AuthMode(); ok {
+		_spec.SetField(ldapconfig.FieldAuthMode, field.TypeEnum, value)
+	}
+	if _u.mutation.AuthModeCleared() {
+		_spec.ClearField(ldapconfig.FieldAuthMode, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.JitProvisioning(); ok {
+		_spec.SetField(ldapconfig.FieldJitProvisioning, field.TypeBool, value)
+	}
+	if _u.mutation.JitProvisioningCleared() {
+		_spec.ClearField(ldapconfig.FieldJitProvisioning, field.TypeBool)
+	}
+	if value, ok := _u.mutation.DefaultRoleIds(); ok {
+		_spec.SetField(ldapconfig.FieldDefaultRoleIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedDefaultRoleIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, ldapconfig.FieldDefaultRoleIds, value)
+		})
+	}
+	if _u.mutation.DefaultRoleIdsCleared() {
+		_spec.ClearField(ldapconfig.FieldDefaultRoleIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.DefaultOrgUnitID(); ok {
+		_spec.SetField(ldapconfig.FieldDefaultOrgUnitID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedDefaultOrgUnitID(); ok {
+		_spec.AddField(ldapconfig.FieldDefaultOrgUnitID, field.TypeUint32, value)
+	}
+	if _u.mutation.DefaultOrgUnitIDCleared() {
+		_spec.ClearField(ldapconfig.FieldDefaultOrgUnitID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.LastSyncedAt(); ok {
+		_spec.SetField(ldapconfig.FieldLastSyncedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastSyncedAtCleared() {
+		_spec.ClearField(ldapconfig.FieldLastSyncedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastSyncMessage(); ok {
+		_spec.SetField(ldapconfig.FieldLastSyncMessage, field.TypeString, value)
+	}
+	if _u.mutation.LastSyncMessageCleared() {
+		_spec.ClearField(ldapconfig.FieldLastSyncMessage, field.TypeString)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &LdapConfig{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ldapconfig.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -32,7 +32,7 @@ type LoginPolicy struct {
 	DeletedBy *uint32 `json:"deleted_by,omitempty"`
 	// 租户ID
 	TenantID *uint32 `json:"tenant_id,omitempty"`
-	// 目标用户ID
+	// 目标ID（用户ID或组织单元ID）
 	TargetID *uint32 `json:"target_id,omitempty"`
 	// 限制值（如IP地址、MAC地址或地区代码）
 	Value *string `json:"value,omitempty"`
@@ -41,7 +41,11 @@ type LoginPolicy struct {
 	// 限制类型
 	Type *loginpolicy.Type `json:"type,omitempty"`
 	// 限制方式
-	Method       *loginpolicy.Method `json:"method,omitempty"`
+	Method *loginpolicy.Method `json:"method,omitempty"`
+	// 生效对象类型
+	TargetType *loginpolicy.TargetType `json:"target_type,omitempty"`
+	// 目标编码（角色码）
+	TargetCode   *string `json:"target_code,omitempty"`
 	selectValues sql.SelectValues
 }
 
@@ -52,7 +56,7 @@ func (*LoginPolicy) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case loginpolicy.FieldID, loginpolicy.FieldCreatedBy, loginpolicy.FieldUpdatedBy, loginpolicy.FieldDeletedBy, loginpolicy.FieldTenantID, loginpolicy.FieldTargetID:
 			values[i] = new(sql.NullInt64)
-		case loginpolicy.FieldValue, loginpolicy.FieldReason, loginpolicy.FieldType, loginpolicy.FieldMethod:
+		case loginpolicy.FieldValue, loginpolicy.FieldReason, loginpolicy.FieldType, loginpolicy.FieldMethod, loginpolicy.FieldTargetType, loginpolicy.FieldTargetCode:
 			values[i] = new(sql.NullString)
 		case loginpolicy.FieldCreatedAt, loginpolicy.FieldUpdatedAt, loginpolicy.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
@@ -161,6 +165,20 @@ func (_m *LoginPolicy) assignValues(columns []string, values []any) error {
 				_m.Method = new(loginpolicy.Method)
 				*_m.Method = loginpolicy.Method(value.String)
 			}
+		case loginpolicy.FieldTargetType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field target_type", values[i])
+			} else if value.Valid {
+				_m.TargetType = new(loginpolicy.TargetType)
+				*_m.TargetType = loginpolicy.TargetType(value.String)
+			}
+		case loginpolicy.FieldTargetCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field target_code", values[i])
+			} else if value.Valid {
+				_m.TargetCode = new(string)
+				*_m.TargetCode = value.String
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -256,6 +274,16 @@ func (_m *LoginPolicy) String() string {
 		builder.WriteString("method=")
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
+	builder.WriteString(", ")
+	if v := _m.TargetType; v != nil {
+		builder.WriteString("target_type=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.TargetCode; v != nil {
+		builder.WriteString("target_code=")
+		builder.WriteString(*v)
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -38,6 +38,10 @@
 	FieldType = "type"
 	// FieldMethod holds the string denoting the method field in the database.
 	FieldMethod = "method"
+	// FieldTargetType holds the string denoting the target_type field in the database.
+	FieldTargetType = "target_type"
+	// FieldTargetCode holds the string denoting the target_code field in the database.
+	FieldTargetCode = "target_code"
 	// Table holds the table name of the loginpolicy in the database.
 	Table = "sys_login_policies"
 )
@@ -57,6 +61,8 @@
 	FieldReason,
 	FieldType,
 	FieldMethod,
+	FieldTargetType,
+	FieldTargetCode,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -138,6 +144,31 @@ func MethodValidator(m Method) error {
 	}
 }
 
+// TargetType defines the type for the "target_type" enum field.
+type TargetType string
+
+// TargetType values.
+const (
+	TargetTypeGlobal  TargetType = "GLOBAL"
+	TargetTypeUser    TargetType = "USER"
+	TargetTypeRole    TargetType = "ROLE"
+	TargetTypeOrgUnit TargetType = "ORG_UNIT"
+)
+
+func (tt TargetType) String() string {
+	return string(tt)
+}
+
+// TargetTypeValidator is a validator for the "target_type" field enum values. It is called by the builders before save.
+func TargetTypeValidator(tt TargetType) error {
+	switch tt {
+	case TargetTypeGlobal, TargetTypeUser, TargetTypeRole, TargetTypeOrgUnit:
+		return nil
+	default:
+		return fmt.Errorf("loginpolicy: invalid enum value for target_type field: %q", tt)
+	}
+}
+
 // OrderOption defines the ordering options for the LoginPolicy queries.
 type OrderOption func(*sql.Selector)
 
@@ -205,3 +236,13 @@ func ByType(opts ...sql.OrderTermOption) OrderOption {
 func ByMethod(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldMethod, opts...).ToFunc()
 }
+
+// ByTargetType orders the results by the target_type field.
+func ByTargetType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTargetType, opts...).ToFunc()
+}
+
+// ByTargetCode orders the results by the target_code field.
+func ByTargetCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTargetCode, opts...).ToFunc()
+}
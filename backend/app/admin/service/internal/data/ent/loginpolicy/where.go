@@ -104,6 +104,11 @@ func Reason(v string) predicate.LoginPolicy {
 	return predicate.LoginPolicy(sql.FieldEQ(FieldReason, v))
 }
 
+// TargetCode applies equality check predicate on the "target_code" field. It's identical to TargetCodeEQ.
+func TargetCode(v string) predicate.LoginPolicy {
+	return predicate.LoginPolicy(sql.FieldEQ(FieldTargetCode, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.LoginPolicy {
 	return predicate.LoginPolicy(sql.FieldEQ(FieldCreatedAt, v))
@@ -714,6 +719,111 @@ func MethodNotNil() predicate.LoginPolicy {
 	return predicate.LoginPolicy(sql.FieldNotNull(FieldMethod))
 }
 
+// TargetTypeEQ applies the EQ predicate on the "target_type" field.
+func TargetTypeEQ(v TargetType) predicate.LoginPolicy {
+	return predicate.LoginPolicy(sql.FieldEQ(FieldTargetType, v))
+}
+
+// TargetTypeNEQ applies the NEQ predicate on the "target_type" field.
+func TargetTypeNEQ(v TargetType) predicate.LoginPolicy {
+	return predicate.LoginPolicy(sql.FieldNEQ(FieldTargetType, v))
+}
+
+// TargetTypeIn applies the In predicate on the "target_type" field.
+func TargetTypeIn(vs ...TargetType) predicate.LoginPolicy {
+	return predicate.LoginPolicy(sql.FieldIn(FieldTargetType, vs...))
+}
+
+// TargetTypeNotIn applies the NotIn predicate on the "target_type" field.
+func TargetTypeNotIn(vs ...TargetType) predicate.LoginPolicy {
+	return predicate.LoginPolicy(sql.FieldNotIn(FieldTargetType, vs...))
+}
+
+// TargetTypeIsNil applies the IsNil predicate on the "target_type" field.
+func TargetTypeIsNil() predicate.LoginPolicy {
+	return predicate.LoginPolicy(sql.FieldIsNull(FieldTargetType))
+}
+
+// TargetTypeNotNil applies the NotNil predicate on the "target_type" field.
+func TargetTypeNotNil() predicate.LoginPolicy {
+	return predicate.LoginPolicy(sql.FieldNotNull(FieldTargetType))
+}
+
+// TargetCodeEQ applies the EQ predicate on the "target_code" field.
+func TargetCodeEQ(v string) predicate.LoginPolicy {
+	return predicate.LoginPolicy(sql.FieldEQ(FieldTargetCode, v))
+}
+
+// TargetCodeNEQ applies the NEQ predicate on the "target_code" field.
+func TargetCodeNEQ(v string) predicate.LoginPolicy {
+	return predicate.LoginPolicy(sql.FieldNEQ(FieldTargetCode, v))
+}
+
+// TargetCodeIn applies the In predicate on the "target_code" field.
+func TargetCodeIn(vs ...string) predicate.LoginPolicy {
+	return predicate.LoginPolicy(sql.FieldIn(FieldTargetCode, vs...))
+}
+
+// TargetCodeNotIn applies the NotIn predicate on the "target_code" field.
+func TargetCodeNotIn(vs ...string) predicate.LoginPolicy {
+	return predicate.LoginPolicy(sql.FieldNotIn(FieldTargetCode, vs...))
+}
+
+// TargetCodeGT applies the GT predicate on the "target_code" field.
+func TargetCodeGT(v string) predicate.LoginPolicy {
+	return predicate.LoginPolicy(sql.FieldGT(FieldTargetCode, v))
+}
+
+// TargetCodeGTE applies the GTE predicate on the "target_code" field.
+func TargetCodeGTE(v string) predicate.LoginPolicy {
+	return predicate.LoginPolicy(sql.FieldGTE(FieldTargetCode, v))
+}
+
+// TargetCodeLT applies the LT predicate on the "target_code" field.
+func TargetCodeLT(v string) predicate.LoginPolicy {
+	return predicate.LoginPolicy(sql.FieldLT(FieldTargetCode, v))
+}
+
+// TargetCodeLTE applies the LTE predicate on the "target_code" field.
+func TargetCodeLTE(v string) predicate.LoginPolicy {
+	return predicate.LoginPolicy(sql.FieldLTE(FieldTargetCode, v))
+}
+
+// TargetCodeContains applies the Contains predicate on the "target_code" field.
+func TargetCodeContains(v string) predicate.LoginPolicy {
+	return predicate.LoginPolicy(sql.FieldContains(FieldTargetCode, v))
+}
+
+// TargetCodeHasPrefix applies the HasPrefix predicate on the "target_code" field.
+func TargetCodeHasPrefix(v string) predicate.LoginPolicy {
+	return predicate.LoginPolicy(sql.FieldHasPrefix(FieldTargetCode, v))
+}
+
+// TargetCodeHasSuffix applies the HasSuffix predicate on the "target_code" field.
+func TargetCodeHasSuffix(v string) predicate.LoginPolicy {
+	return predicate.LoginPolicy(sql.FieldHasSuffix(FieldTargetCode, v))
+}
+
+// TargetCodeIsNil applies the IsNil predicate on the "target_code" field.
+func TargetCodeIsNil() predicate.LoginPolicy {
+	return predicate.LoginPolicy(sql.FieldIsNull(FieldTargetCode))
+}
+
+// TargetCodeNotNil applies the NotNil predicate on the "target_code" field.
+func TargetCodeNotNil() predicate.LoginPolicy {
+	return predicate.LoginPolicy(sql.FieldNotNull(FieldTargetCode))
+}
+
+// TargetCodeEqualFold applies the EqualFold predicate on the "target_code" field.
+func TargetCodeEqualFold(v string) predicate.LoginPolicy {
+	return predicate.LoginPolicy(sql.FieldEqualFold(FieldTargetCode, v))
+}
+
+// TargetCodeContainsFold applies the ContainsFold predicate on the "target_code" field.
+func TargetCodeContainsFold(v string) predicate.LoginPolicy {
+	return predicate.LoginPolicy(sql.FieldContainsFold(FieldTargetCode, v))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.LoginPolicy) predicate.LoginPolicy {
 	return predicate.LoginPolicy(sql.AndPredicates(predicates...))
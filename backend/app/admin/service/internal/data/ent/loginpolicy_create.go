@@ -190,6 +190,34 @@ func (_c *LoginPolicyCreate) SetNillableMethod(v *loginpolicy.Method) *LoginPoli
 	return _c
 }
 
+// SetTargetType sets the "target_type" field.
+func (_c *LoginPolicyCreate) SetTargetType(v loginpolicy.TargetType) *LoginPolicyCreate {
+	_c.mutation.SetTargetType(v)
+	return _c
+}
+
+// SetNillableTargetType sets the "target_type" field if the given value is not nil.
+func (_c *LoginPolicyCreate) SetNillableTargetType(v *loginpolicy.TargetType) *LoginPolicyCreate {
+	if v != nil {
+		_c.SetTargetType(*v)
+	}
+	return _c
+}
+
+// SetTargetCode sets the "target_code" field.
+func (_c *LoginPolicyCreate) SetTargetCode(v string) *LoginPolicyCreate {
+	_c.mutation.SetTargetCode(v)
+	return _c
+}
+
+// SetNillableTargetCode sets the "target_code" field if the given value is not nil.
+func (_c *LoginPolicyCreate) SetNillableTargetCode(v *string) *LoginPolicyCreate {
+	if v != nil {
+		_c.SetTargetCode(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *LoginPolicyCreate) SetID(v uint32) *LoginPolicyCreate {
 	_c.mutation.SetID(v)
@@ -260,6 +288,11 @@ func (_c *LoginPolicyCreate) check() error {
 			return &ValidationError{Name: "method", err: fmt.Errorf(`ent: validator failed for field "LoginPolicy.method": %w`, err)}
 		}
 	}
+	if v, ok := _c.mutation.TargetType(); ok {
+		if err := loginpolicy.TargetTypeValidator(v); err != nil {
+			return &ValidationError{Name: "target_type", err: fmt.Errorf(`ent: validator failed for field "LoginPolicy.target_type": %w`, err)}
+		}
+	}
 	if v, ok := _c.mutation.ID(); ok {
 		if err := loginpolicy.IDValidator(v); err != nil {
 			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "LoginPolicy.id": %w`, err)}
@@ -346,6 +379,14 @@ func (_c *LoginPolicyCreate) createSpec() (*LoginPolicy, *sqlgraph.CreateSpec) {
 		_spec.SetField(loginpolicy.FieldMethod, field.TypeEnum, value)
 		_node.Method = &value
 	}
+	if value, ok := _c.mutation.TargetType(); ok {
+		_spec.SetField(loginpolicy.FieldTargetType, field.TypeEnum, value)
+		_node.TargetType = &value
+	}
+	if value, ok := _c.mutation.TargetCode(); ok {
+		_spec.SetField(loginpolicy.FieldTargetCode, field.TypeString, value)
+		_node.TargetCode = &value
+	}
 	return _node, _spec
 }
 
@@ -602,6 +643,42 @@ func (u *LoginPolicyUpsert) ClearMethod() *LoginPolicyUpsert {
 	return u
 }
 
+// SetTargetType sets the "target_type" field.
+func (u *LoginPolicyUpsert) SetTargetType(v loginpolicy.TargetType) *LoginPolicyUpsert {
+	u.Set(loginpolicy.FieldTargetType, v)
+	return u
+}
+
+// UpdateTargetType sets the "target_type" field to the value that was provided on create.
+func (u *LoginPolicyUpsert) UpdateTargetType() *LoginPolicyUpsert {
+	u.SetExcluded(loginpolicy.FieldTargetType)
+	return u
+}
+
+// ClearTargetType clears the value of the "target_type" field.
+func (u *LoginPolicyUpsert) ClearTargetType() *LoginPolicyUpsert {
+	u.SetNull(loginpolicy.FieldTargetType)
+	return u
+}
+
+// SetTargetCode sets the "target_code" field.
+func (u *LoginPolicyUpsert) SetTargetCode(v string) *LoginPolicyUpsert {
+	u.Set(loginpolicy.FieldTargetCode, v)
+	return u
+}
+
+// UpdateTargetCode sets the "target_code" field to the value that was provided on create.
+func (u *LoginPolicyUpsert) UpdateTargetCode() *LoginPolicyUpsert {
+	u.SetExcluded(loginpolicy.FieldTargetCode)
+	return u
+}
+
+// ClearTargetCode clears the value of the "target_code" field.
+func (u *LoginPolicyUpsert) ClearTargetCode() *LoginPolicyUpsert {
+	u.SetNull(loginpolicy.FieldTargetCode)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -894,6 +971,48 @@ func (u *LoginPolicyUpsertOne) ClearMethod() *LoginPolicyUpsertOne {
 	})
 }
 
+// SetTargetType sets the "target_type" field.
+func (u *LoginPolicyUpsertOne) SetTargetType(v loginpolicy.TargetType) *LoginPolicyUpsertOne {
+	return u.Update(func(s *LoginPolicyUpsert) {
+		s.SetTargetType(v)
+	})
+}
+
+// UpdateTargetType sets the "target_type" field to the value that was provided on create.
+func (u *LoginPolicyUpsertOne) UpdateTargetType() *LoginPolicyUpsertOne {
+	return u.Update(func(s *LoginPolicyUpsert) {
+		s.UpdateTargetType()
+	})
+}
+
+// ClearTargetType clears the value of the "target_type" field.
+func (u *LoginPolicyUpsertOne) ClearTargetType() *LoginPolicyUpsertOne {
+	return u.Update(func(s *LoginPolicyUpsert) {
+		s.ClearTargetType()
+	})
+}
+
+// SetTargetCode sets the "target_code" field.
+func (u *LoginPolicyUpsertOne) SetTargetCode(v string) *LoginPolicyUpsertOne {
+	return u.Update(func(s *LoginPolicyUpsert) {
+		s.SetTargetCode(v)
+	})
+}
+
+// UpdateTargetCode sets the "target_code" field to the value that was provided on create.
+func (u *LoginPolicyUpsertOne) UpdateTargetCode() *LoginPolicyUpsertOne {
+	return u.Update(func(s *LoginPolicyUpsert) {
+		s.UpdateTargetCode()
+	})
+}
+
+// ClearTargetCode clears the value of the "target_code" field.
+func (u *LoginPolicyUpsertOne) ClearTargetCode() *LoginPolicyUpsertOne {
+	return u.Update(func(s *LoginPolicyUpsert) {
+		s.ClearTargetCode()
+	})
+}
+
 // Exec executes the query.
 func (u *LoginPolicyUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1352,6 +1471,48 @@ func (u *LoginPolicyUpsertBulk) ClearMethod() *LoginPolicyUpsertBulk {
 	})
 }
 
+// SetTargetType sets the "target_type" field.
+func (u *LoginPolicyUpsertBulk) SetTargetType(v loginpolicy.TargetType) *LoginPolicyUpsertBulk {
+	return u.Update(func(s *LoginPolicyUpsert) {
+		s.SetTargetType(v)
+	})
+}
+
+// UpdateTargetType sets the "target_type" field to the value that was provided on create.
+func (u *LoginPolicyUpsertBulk) UpdateTargetType() *LoginPolicyUpsertBulk {
+	return u.Update(func(s *LoginPolicyUpsert) {
+		s.UpdateTargetType()
+	})
+}
+
+// ClearTargetType clears the value of the "target_type" field.
+func (u *LoginPolicyUpsertBulk) ClearTargetType() *LoginPolicyUpsertBulk {
+	return u.Update(func(s *LoginPolicyUpsert) {
+		s.ClearTargetType()
+	})
+}
+
+// SetTargetCode sets the "target_code" field.
+func (u *LoginPolicyUpsertBulk) SetTargetCode(v string) *LoginPolicyUpsertBulk {
+	return u.Update(func(s *LoginPolicyUpsert) {
+		s.SetTargetCode(v)
+	})
+}
+
+// UpdateTargetCode sets the "target_code" field to the value that was provided on create.
+func (u *LoginPolicyUpsertBulk) UpdateTargetCode() *LoginPolicyUpsertBulk {
+	return u.Update(func(s *LoginPolicyUpsert) {
+		s.UpdateTargetCode()
+	})
+}
+
+// ClearTargetCode clears the value of the "target_code" field.
+func (u *LoginPolicyUpsertBulk) ClearTargetCode() *LoginPolicyUpsertBulk {
+	return u.Update(func(s *LoginPolicyUpsert) {
+		s.ClearTargetCode()
+	})
+}
+
 // Exec executes the query.
 func (u *LoginPolicyUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
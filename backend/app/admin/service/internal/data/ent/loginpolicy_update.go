@@ -257,6 +257,46 @@ func (_u *LoginPolicyUpdate) ClearMethod() *LoginPolicyUpdate {
 	return _u
 }
 
+// SetTargetType sets the "target_type" field.
+func (_u *LoginPolicyUpdate) SetTargetType(v loginpolicy.TargetType) *LoginPolicyUpdate {
+	_u.mutation.SetTargetType(v)
+	return _u
+}
+
+// SetNillableTargetType sets the "target_type" field if the given value is not nil.
+func (_u *LoginPolicyUpdate) SetNillableTargetType(v *loginpolicy.TargetType) *LoginPolicyUpdate {
+	if v != nil {
+		_u.SetTargetType(*v)
+	}
+	return _u
+}
+
+// ClearTargetType clears the value of the "target_type" field.
+func (_u *LoginPolicyUpdate) ClearTargetType() *LoginPolicyUpdate {
+	_u.mutation.ClearTargetType()
+	return _u
+}
+
+// SetTargetCode sets the "target_code" field.
+func (_u *LoginPolicyUpdate) SetTargetCode(v string) *LoginPolicyUpdate {
+	_u.mutation.SetTargetCode(v)
+	return _u
+}
+
+// SetNillableTargetCode sets the "target_code" field if the given value is not nil.
+func (_u *LoginPolicyUpdate) SetNillableTargetCode(v *string) *LoginPolicyUpdate {
+	if v != nil {
+		_u.SetTargetCode(*v)
+	}
+	return _u
+}
+
+// ClearTargetCode clears the value of the "target_code" field.
+func (_u *LoginPolicyUpdate) ClearTargetCode() *LoginPolicyUpdate {
+	_u.mutation.ClearTargetCode()
+	return _u
+}
+
 // Mutation returns the LoginPolicyMutation object of the builder.
 func (_u *LoginPolicyUpdate) Mutation() *LoginPolicyMutation {
 	return _u.mutation
@@ -301,6 +341,11 @@ func (_u *LoginPolicyUpdate) check() error {
 			return &ValidationError{Name: "method", err: fmt.Errorf(`ent: validator failed for field "LoginPolicy.method": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.TargetType(); ok {
+		if err := loginpolicy.TargetTypeValidator(v); err != nil {
+			return &ValidationError{Name: "target_type", err: fmt.Errorf(`ent: validator failed for field "LoginPolicy.target_type": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -400,6 +445,18 @@ func (_u *LoginPolicyUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if _u.mutation.MethodCleared() {
 		_spec.ClearField(loginpolicy.FieldMethod, field.TypeEnum)
 	}
+	if value, ok := _u.mutation.TargetType(); ok {
+		_spec.SetField(loginpolicy.FieldTargetType, field.TypeEnum, value)
+	}
+	if _u.mutation.TargetTypeCleared() {
+		_spec.ClearField(loginpolicy.FieldTargetType, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.TargetCode(); ok {
+		_spec.SetField(loginpolicy.FieldTargetCode, field.TypeString, value)
+	}
+	if _u.mutation.TargetCodeCleared() {
+		_spec.ClearField(loginpolicy.FieldTargetCode, field.TypeString)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -650,6 +707,46 @@ func (_u *LoginPolicyUpdateOne) ClearMethod() *LoginPolicyUpdateOne {
 	return _u
 }
 
+// SetTargetType sets the "target_type" field.
+func (_u *LoginPolicyUpdateOne) SetTargetType(v loginpolicy.TargetType) *LoginPolicyUpdateOne {
+	_u.mutation.SetTargetType(v)
+	return _u
+}
+
+// SetNillableTargetType sets the "target_type" field if the given value is not nil.
+func (_u *LoginPolicyUpdateOne) SetNillableTargetType(v *loginpolicy.TargetType) *LoginPolicyUpdateOne {
+	if v != nil {
+		_u.SetTargetType(*v)
+	}
+	return _u
+}
+
+// ClearTargetType clears the value of the "target_type" field.
+func (_u *LoginPolicyUpdateOne) ClearTargetType() *LoginPolicyUpdateOne {
+	_u.mutation.ClearTargetType()
+	return _u
+}
+
+// SetTargetCode sets the "target_code" field.
+func (_u *LoginPolicyUpdateOne) SetTargetCode(v string) *LoginPolicyUpdateOne {
+	_u.mutation.SetTargetCode(v)
+	return _u
+}
+
+// SetNillableTargetCode sets the "target_code" field if the given value is not nil.
+func (_u *LoginPolicyUpdateOne) SetNillableTargetCode(v *string) *LoginPolicyUpdateOne {
+	if v != nil {
+		_u.SetTargetCode(*v)
+	}
+	return _u
+}
+
+// ClearTargetCode clears the value of the "target_code" field.
+func (_u *LoginPolicyUpdateOne) ClearTargetCode() *LoginPolicyUpdateOne {
+	_u.mutation.ClearTargetCode()
+	return _u
+}
+
 // Mutation returns the LoginPolicyMutation object of the builder.
 func (_u *LoginPolicyUpdateOne) Mutation() *LoginPolicyMutation {
 	return _u.mutation
@@ -707,6 +804,11 @@ func (_u *LoginPolicyUpdateOne) check() error {
 			return &ValidationError{Name: "method", err: fmt.Errorf(`ent: validator failed for field "LoginPolicy.method": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.TargetType(); ok {
+		if err := loginpolicy.TargetTypeValidator(v); err != nil {
+			return &ValidationError{Name: "target_type", err: fmt.Errorf(`ent: validator failed for field "LoginPolicy.target_type": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -823,6 +925,18 @@ func (_u *LoginPolicyUpdateOne) sqlSave(ctx context.Context) (_node *LoginPolicy
 	if _u.mutation.MethodCleared() {
 		_spec.ClearField(loginpolicy.FieldMethod, field.TypeEnum)
 	}
+	if value, ok := _u.mutation.TargetType(); ok {
+		_spec.SetField(loginpolicy.FieldTargetType, field.TypeEnum, value)
+	}
+	if _u.mutation.TargetTypeCleared() {
+		_spec.ClearField(loginpolicy.FieldTargetType, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.TargetCode(); ok {
+		_spec.SetField(loginpolicy.FieldTargetCode, field.TypeString, value)
+	}
+	if _u.mutation.TargetCodeCleared() {
+		_spec.ClearField(loginpolicy.FieldTargetCode, field.TypeString)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	_node = &LoginPolicy{config: _u.config}
 	_spec.Assign = _node.assignValues
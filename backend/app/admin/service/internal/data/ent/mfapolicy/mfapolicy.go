@@ -0,0 +1,150 @@
+// Code generated by ent, DO NOT EDIT.
+
+package mfapolicy
+
+import (
+	"fmt"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the mfapolicy type in the database.
+	Label = "mfa_policy"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldCreatedBy holds the string denoting the created_by field in the database.
+	FieldCreatedBy = "created_by"
+	// FieldUpdatedBy holds the string denoting the updated_by field in the database.
+	FieldUpdatedBy = "updated_by"
+	// FieldDeletedBy holds the string denoting the deleted_by field in the database.
+	FieldDeletedBy = "deleted_by"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldEnforcement holds the string denoting the enforcement field in the database.
+	FieldEnforcement = "enforcement"
+	// FieldRequiredRoleCodes holds the string denoting the required_role_codes field in the database.
+	FieldRequiredRoleCodes = "required_role_codes"
+	// Table holds the table name of the mfapolicy in the database.
+	Table = "sys_mfa_policies"
+)
+
+// Columns holds all SQL columns for mfapolicy fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldDeletedAt,
+	FieldCreatedBy,
+	FieldUpdatedBy,
+	FieldDeletedBy,
+	FieldTenantID,
+	FieldEnforcement,
+	FieldRequiredRoleCodes,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "go-wind-admin/app/admin/service/internal/data/ent/runtime"
+var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
+	// DefaultTenantID holds the default value on creation for the "tenant_id" field.
+	DefaultTenantID uint32
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(uint32) error
+)
+
+// Enforcement defines the type for the "enforcement" enum field.
+type Enforcement string
+
+// EnforcementNotRequired is the default value of the Enforcement enum.
+const DefaultEnforcement = EnforcementNotRequired
+
+// Enforcement values.
+const (
+	EnforcementNotRequired Enforcement = "NOT_REQUIRED"
+	EnforcementOptional    Enforcement = "OPTIONAL"
+	EnforcementRequired    Enforcement = "REQUIRED"
+)
+
+func (e Enforcement) String() string {
+	return string(e)
+}
+
+// EnforcementValidator is a validator for the "enforcement" field enum values. It is called by the builders before save.
+func EnforcementValidator(e Enforcement) error {
+	switch e {
+	case EnforcementNotRequired, EnforcementOptional, EnforcementRequired:
+		return nil
+	default:
+		return fmt.Errorf("mfapolicy: invalid enum value for enforcement field: %q", e)
+	}
+}
+
+// OrderOption defines the ordering options for the MfaPolicy queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByCreatedBy orders the results by the created_by field.
+func ByCreatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedBy, opts...).ToFunc()
+}
+
+// ByUpdatedBy orders the results by the updated_by field.
+func ByUpdatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedBy, opts...).ToFunc()
+}
+
+// ByDeletedBy orders the results by the deleted_by field.
+func ByDeletedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedBy, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByEnforcement orders the results by the enforcement field.
+func ByEnforcement(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnforcement, opts...).ToFunc()
+}
@@ -0,0 +1,1105 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-wind-admin/app/admin/service/internal/data/ent/mfapolicy"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MfaPolicyCreate is the builder for creating a MfaPolicy entity.
+type MfaPolicyCreate struct {
+	config
+	mutation *MfaPolicyMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *MfaPolicyCreate) SetCreatedAt(v time.Time) *MfaPolicyCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *MfaPolicyCreate) SetNillableCreatedAt(v *time.Time) *MfaPolicyCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *MfaPolicyCreate) SetUpdatedAt(v time.Time) *MfaPolicyCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *MfaPolicyCreate) SetNillableUpdatedAt(v *time.Time) *MfaPolicyCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *MfaPolicyCreate) SetDeletedAt(v time.Time) *MfaPolicyCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *MfaPolicyCreate) SetNillableDeletedAt(v *time.Time) *MfaPolicyCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *MfaPolicyCreate) SetCreatedBy(v uint32) *MfaPolicyCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *MfaPolicyCreate) SetNillableCreatedBy(v *uint32) *MfaPolicyCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_c *MfaPolicyCreate) SetUpdatedBy(v uint32) *MfaPolicyCreate {
+	_c.mutation.SetUpdatedBy(v)
+	return _c
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_c *MfaPolicyCreate) SetNillableUpdatedBy(v *uint32) *MfaPolicyCreate {
+	if v != nil {
+		_c.SetUpdatedBy(*v)
+	}
+	return _c
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_c *MfaPolicyCreate) SetDeletedBy(v uint32) *MfaPolicyCreate {
+	_c.mutation.SetDeletedBy(v)
+	return _c
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_c *MfaPolicyCreate) SetNillableDeletedBy(v *uint32) *MfaPolicyCreate {
+	if v != nil {
+		_c.SetDeletedBy(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *MfaPolicyCreate) SetTenantID(v uint32) *MfaPolicyCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *MfaPolicyCreate) SetNillableTenantID(v *uint32) *MfaPolicyCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetEnforcement sets the "enforcement" field.
+func (_c *MfaPolicyCreate) SetEnforcement(v mfapolicy.Enforcement) *MfaPolicyCreate {
+	_c.mutation.SetEnforcement(v)
+	return _c
+}
+
+// SetNillableEnforcement sets the "enforcement" field if the given value is not nil.
+func (_c *MfaPolicyCreate) SetNillableEnforcement(v *mfapolicy.Enforcement) *MfaPolicyCreate {
+	if v != nil {
+		_c.SetEnforcement(*v)
+	}
+	return _c
+}
+
+// SetRequiredRoleCodes sets the "required_role_codes" field.
+func (_c *MfaPolicyCreate) SetRequiredRoleCodes(v []string) *MfaPolicyCreate {
+	_c.mutation.SetRequiredRoleCodes(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *MfaPolicyCreate) SetID(v uint32) *MfaPolicyCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the MfaPolicyMutation object of the builder.
+func (_c *MfaPolicyCreate) Mutation() *MfaPolicyMutation {
+	return _c.mutation
+}
+
+// Save creates the MfaPolicy in the database.
+func (_c *MfaPolicyCreate) Save(ctx context.Context) (*MfaPolicy, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *MfaPolicyCreate) SaveX(ctx context.Context) *MfaPolicy {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *MfaPolicyCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *MfaPolicyCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *MfaPolicyCreate) defaults() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := mfapolicy.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+	if _, ok := _c.mutation.Enforcement(); !ok {
+		v := mfapolicy.DefaultEnforcement
+		_c.mutation.SetEnforcement(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *MfaPolicyCreate) check() error {
+	if v, ok := _c.mutation.Enforcement(); ok {
+		if err := mfapolicy.EnforcementValidator(v); err != nil {
+			return &ValidationError{Name: "enforcement", err: fmt.Errorf(`ent: validator failed for field "MfaPolicy.enforcement": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := mfapolicy.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "MfaPolicy.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *MfaPolicyCreate) sqlSave(ctx context.Context) (*MfaPolicy, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint32(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *MfaPolicyCreate) createSpec() (*MfaPolicy, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MfaPolicy{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(mfapolicy.Table, sqlgraph.NewFieldSpec(mfapolicy.FieldID, field.TypeUint32))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(mfapolicy.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = &value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(mfapolicy.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = &value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(mfapolicy.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(mfapolicy.FieldCreatedBy, field.TypeUint32, value)
+		_node.CreatedBy = &value
+	}
+	if value, ok := _c.mutation.UpdatedBy(); ok {
+		_spec.SetField(mfapolicy.FieldUpdatedBy, field.TypeUint32, value)
+		_node.UpdatedBy = &value
+	}
+	if value, ok := _c.mutation.DeletedBy(); ok {
+		_spec.SetField(mfapolicy.FieldDeletedBy, field.TypeUint32, value)
+		_node.DeletedBy = &value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(mfapolicy.FieldTenantID, field.TypeUint32, value)
+		_node.TenantID = &value
+	}
+	if value, ok := _c.mutation.Enforcement(); ok {
+		_spec.SetField(mfapolicy.FieldEnforcement, field.TypeEnum, value)
+		_node.Enforcement = &value
+	}
+	if value, ok := _c.mutation.RequiredRoleCodes(); ok {
+		_spec.SetField(mfapolicy.FieldRequiredRoleCodes, field.TypeJSON, value)
+		_node.RequiredRoleCodes = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.MfaPolicy.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.MfaPolicyUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *MfaPolicyCreate) OnConflict(opts ...sql.ConflictOption) *MfaPolicyUpsertOne {
+	_c.conflict = opts
+	return &MfaPolicyUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.MfaPolicy.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *MfaPolicyCreate) OnConflictColumns(columns ...string) *MfaPolicyUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &MfaPolicyUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// MfaPolicyUpsertOne is the builder for "upsert"-ing
+	//  one MfaPolicy node.
+	MfaPolicyUpsertOne struct {
+		create *MfaPolicyCreate
+	}
+
+	// MfaPolicyUpsert is the "OnConflict" setter.
+	MfaPolicyUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *MfaPolicyUpsert) SetUpdatedAt(v time.Time) *MfaPolicyUpsert {
+	u.Set(mfapolicy.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *MfaPolicyUpsert) UpdateUpdatedAt() *MfaPolicyUpsert {
+	u.SetExcluded(mfapolicy.FieldUpdatedAt)
+	return u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *MfaPolicyUpsert) ClearUpdatedAt() *MfaPolicyUpsert {
+	u.SetNull(mfapolicy.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *MfaPolicyUpsert) SetDeletedAt(v time.Time) *MfaPolicyUpsert {
+	u.Set(mfapolicy.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *MfaPolicyUpsert) UpdateDeletedAt() *MfaPolicyUpsert {
+	u.SetExcluded(mfapolicy.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *MfaPolicyUpsert) ClearDeletedAt() *MfaPolicyUpsert {
+	u.SetNull(mfapolicy.FieldDeletedAt)
+	return u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *MfaPolicyUpsert) SetCreatedBy(v uint32) *MfaPolicyUpsert {
+	u.Set(mfapolicy.FieldCreatedBy, v)
+	return u
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *MfaPolicyUpsert) UpdateCreatedBy() *MfaPolicyUpsert {
+	u.SetExcluded(mfapolicy.FieldCreatedBy)
+	return u
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *MfaPolicyUpsert) AddCreatedBy(v uint32) *MfaPolicyUpsert {
+	u.Add(mfapolicy.FieldCreatedBy, v)
+	return u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *MfaPolicyUpsert) ClearCreatedBy() *MfaPolicyUpsert {
+	u.SetNull(mfapolicy.FieldCreatedBy)
+	return u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *MfaPolicyUpsert) SetUpdatedBy(v uint32) *MfaPolicyUpsert {
+	u.Set(mfapolicy.FieldUpdatedBy, v)
+	return u
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *MfaPolicyUpsert) UpdateUpdatedBy() *MfaPolicyUpsert {
+	u.SetExcluded(mfapolicy.FieldUpdatedBy)
+	return u
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *MfaPolicyUpsert) AddUpdatedBy(v uint32) *MfaPolicyUpsert {
+	u.Add(mfapolicy.FieldUpdatedBy, v)
+	return u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *MfaPolicyUpsert) ClearUpdatedBy() *MfaPolicyUpsert {
+	u.SetNull(mfapolicy.FieldUpdatedBy)
+	return u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *MfaPolicyUpsert) SetDeletedBy(v uint32) *MfaPolicyUpsert {
+	u.Set(mfapolicy.FieldDeletedBy, v)
+	return u
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *MfaPolicyUpsert) UpdateDeletedBy() *MfaPolicyUpsert {
+	u.SetExcluded(mfapolicy.FieldDeletedBy)
+	return u
+}
+
+// AddDeletedBy adds v to the "deleted_by" field.
+func (u *MfaPolicyUpsert) AddDeletedBy(v uint32) *MfaPolicyUpsert {
+	u.Add(mfapolicy.FieldDeletedBy, v)
+	return u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *MfaPolicyUpsert) ClearDeletedBy() *MfaPolicyUpsert {
+	u.SetNull(mfapolicy.FieldDeletedBy)
+	return u
+}
+
+// SetEnforcement sets the "enforcement" field.
+func (u *MfaPolicyUpsert) SetEnforcement(v mfapolicy.Enforcement) *MfaPolicyUpsert {
+	u.Set(mfapolicy.FieldEnforcement, v)
+	return u
+}
+
+// UpdateEnforcement sets the "enforcement" field to the value that was provided on create.
+func (u *MfaPolicyUpsert) UpdateEnforcement() *MfaPolicyUpsert {
+	u.SetExcluded(mfapolicy.FieldEnforcement)
+	return u
+}
+
+// ClearEnforcement clears the value of the "enforcement" field.
+func (u *MfaPolicyUpsert) ClearEnforcement() *MfaPolicyUpsert {
+	u.SetNull(mfapolicy.FieldEnforcement)
+	return u
+}
+
+// SetRequiredRoleCodes sets the "required_role_codes" field.
+func (u *MfaPolicyUpsert) SetRequiredRoleCodes(v []string) *MfaPolicyUpsert {
+	u.Set(mfapolicy.FieldRequiredRoleCodes, v)
+	return u
+}
+
+// UpdateRequiredRoleCodes sets the "required_role_codes" field to the value that was provided on create.
+func (u *MfaPolicyUpsert) UpdateRequiredRoleCodes() *MfaPolicyUpsert {
+	u.SetExcluded(mfapolicy.FieldRequiredRoleCodes)
+	return u
+}
+
+// ClearRequiredRoleCodes clears the value of the "required_role_codes" field.
+func (u *MfaPolicyUpsert) ClearRequiredRoleCodes() *MfaPolicyUpsert {
+	u.SetNull(mfapolicy.FieldRequiredRoleCodes)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.MfaPolicy.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(mfapolicy.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *MfaPolicyUpsertOne) UpdateNewValues() *MfaPolicyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(mfapolicy.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(mfapolicy.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(mfapolicy.FieldTenantID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.MfaPolicy.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *MfaPolicyUpsertOne) Ignore() *MfaPolicyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *MfaPolicyUpsertOne) DoNothing() *MfaPolicyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the MfaPolicyCreate.OnConflict
+// documentation for more info.
+func (u *MfaPolicyUpsertOne) Update(set func(*MfaPolicyUpsert)) *MfaPolicyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&MfaPolicyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *MfaPolicyUpsertOne) SetUpdatedAt(v time.Time) *MfaPolicyUpsertOne {
+	return u.Update(func(s *MfaPolicyUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *MfaPolicyUpsertOne) UpdateUpdatedAt() *MfaPolicyUpsertOne {
+	return u.Update(func(s *MfaPolicyUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *MfaPolicyUpsertOne) ClearUpdatedAt() *MfaPolicyUpsertOne {
+	return u.Update(func(s *MfaPolicyUpsert) {
+		s.ClearUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *MfaPolicyUpsertOne) SetDeletedAt(v time.Time) *MfaPolicyUpsertOne {
+	return u.Update(func(s *MfaPolicyUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *MfaPolicyUpsertOne) UpdateDeletedAt() *MfaPolicyUpsertOne {
+	return u.Update(func(s *MfaPolicyUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *MfaPolicyUpsertOne) ClearDeletedAt() *MfaPolicyUpsertOne {
+	return u.Update(func(s *MfaPolicyUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *MfaPolicyUpsertOne) SetCreatedBy(v uint32) *MfaPolicyUpsertOne {
+	return u.Update(func(s *MfaPolicyUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *MfaPolicyUpsertOne) AddCreatedBy(v uint32) *MfaPolicyUpsertOne {
+	return u.Update(func(s *MfaPolicyUpsert) {
+		s.AddCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *MfaPolicyUpsertOne) UpdateCreatedBy() *MfaPolicyUpsertOne {
+	return u.Update(func(s *MfaPolicyUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *MfaPolicyUpsertOne) ClearCreatedBy() *MfaPolicyUpsertOne {
+	return u.Update(func(s *MfaPolicyUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *MfaPolicyUpsertOne) SetUpdatedBy(v uint32) *MfaPolicyUpsertOne {
+	return u.Update(func(s *MfaPolicyUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *MfaPolicyUpsertOne) AddUpdatedBy(v uint32) *MfaPolicyUpsertOne {
+	return u.Update(func(s *MfaPolicyUpsert) {
+		s.AddUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *MfaPolicyUpsertOne) UpdateUpdatedBy() *MfaPolicyUpsertOne {
+	return u.Update(func(s *MfaPolicyUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *MfaPolicyUpsertOne) ClearUpdatedBy() *MfaPolicyUpsertOne {
+	return u.Update(func(s *MfaPolicyUpsert) {
+		s.ClearUpdatedBy()
+	})
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *MfaPolicyUpsertOne) SetDeletedBy(v uint32) *MfaPolicyUpsertOne {
+	return u.Update(func(s *MfaPolicyUpsert) {
+		s.SetDeletedBy(v)
+	})
+}
+
+// AddDeletedBy adds v to the "deleted_by" field.
+func (u *MfaPolicyUpsertOne) AddDeletedBy(v uint32) *MfaPolicyUpsertOne {
+	return u.Update(func(s *MfaPolicyUpsert) {
+		s.AddDeletedBy(v)
+	})
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *MfaPolicyUpsertOne) UpdateDeletedBy() *MfaPolicyUpsertOne {
+	return u.Update(func(s *MfaPolicyUpsert) {
+		s.UpdateDeletedBy()
+	})
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *MfaPolicyUpsertOne) ClearDeletedBy() *MfaPolicyUpsertOne {
+	return u.Update(func(s *MfaPolicyUpsert) {
+		s.ClearDeletedBy()
+	})
+}
+
+// SetEnforcement sets the "enforcement" field.
+func (u *MfaPolicyUpsertOne) SetEnforcement(v mfapolicy.Enforcement) *MfaPolicyUpsertOne {
+	return u.Update(func(s *MfaPolicyUpsert) {
+		s.SetEnforcement(v)
+	})
+}
+
+// UpdateEnforcement sets the "enforcement" field to the value that was provided on create.
+func (u *MfaPolicyUpsertOne) UpdateEnforcement() *MfaPolicyUpsertOne {
+	return u.Update(func(s *MfaPolicyUpsert) {
+		s.UpdateEnforcement()
+	})
+}
+
+// ClearEnforcement clears the value of the "enforcement" field.
+func (u *MfaPolicyUpsertOne) ClearEnforcement() *MfaPolicyUpsertOne {
+	return u.Update(func(s *MfaPolicyUpsert) {
+		s.ClearEnforcement()
+	})
+}
+
+// SetRequiredRoleCodes sets the "required_role_codes" field.
+func (u *MfaPolicyUpsertOne) SetRequiredRoleCodes(v []string) *MfaPolicyUpsertOne {
+	return u.Update(func(s *MfaPolicyUpsert) {
+		s.SetRequiredRoleCodes(v)
+	})
+}
+
+// UpdateRequiredRoleCodes sets the "required_role_codes" field to the value that was provided on create.
+func (u *MfaPolicyUpsertOne) UpdateRequiredRoleCodes() *MfaPolicyUpsertOne {
+	return u.Update(func(s *MfaPolicyUpsert) {
+		s.UpdateRequiredRoleCodes()
+	})
+}
+
+// ClearRequiredRoleCodes clears the value of the "required_role_codes" field.
+func (u *MfaPolicyUpsertOne) ClearRequiredRoleCodes() *MfaPolicyUpsertOne {
+	return u.Update(func(s *MfaPolicyUpsert) {
+		s.ClearRequiredRoleCodes()
+	})
+}
+
+// Exec executes the query.
+func (u *MfaPolicyUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for MfaPolicyCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *MfaPolicyUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *MfaPolicyUpsertOne) ID(ctx context.Context) (id uint32, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *MfaPolicyUpsertOne) IDX(ctx context.Context) uint32 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// MfaPolicyCreateBulk is the builder for creating many MfaPolicy entities in bulk.
+type MfaPolicyCreateBulk struct {
+	config
+	err      error
+	builders []*MfaPolicyCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the MfaPolicy entities in the database.
+func (_c *MfaPolicyCreateBulk) Save(ctx context.Context) ([]*MfaPolicy, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*MfaPolicy, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MfaPolicyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint32(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *MfaPolicyCreateBulk) SaveX(ctx context.Context) []*MfaPolicy {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *MfaPolicyCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *MfaPolicyCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.MfaPolicy.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.MfaPolicyUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *MfaPolicyCreateBulk) OnConflict(opts ...sql.ConflictOption) *MfaPolicyUpsertBulk {
+	_c.conflict = opts
+	return &MfaPolicyUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.MfaPolicy.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *MfaPolicyCreateBulk) OnConflictColumns(columns ...string) *MfaPolicyUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &MfaPolicyUpsertBulk{
+		create: _c,
+	}
+}
+
+// MfaPolicyUpsertBulk is the builder for "upsert"-ing
+// a bulk of MfaPolicy nodes.
+type MfaPolicyUpsertBulk struct {
+	create *MfaPolicyCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.MfaPolicy.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(mfapolicy.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *MfaPolicyUpsertBulk) UpdateNewValues() *MfaPolicyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(mfapolicy.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(mfapolicy.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(mfapolicy.FieldTenantID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.MfaPolicy.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *MfaPolicyUpsertBulk) Ignore() *MfaPolicyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *MfaPolicyUpsertBulk) DoNothing() *MfaPolicyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the MfaPolicyCreateBulk.OnConflict
+// documentation for more info.
+func (u *MfaPolicyUpsertBulk) Update(set func(*MfaPolicyUpsert)) *MfaPolicyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&MfaPolicyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *MfaPolicyUpsertBulk) SetUpdatedAt(v time.Time) *MfaPolicyUpsertBulk {
+	return u.Update(func(s *MfaPolicyUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *MfaPolicyUpsertBulk) UpdateUpdatedAt() *MfaPolicyUpsertBulk {
+	return u.Update(func(s *MfaPolicyUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *MfaPolicyUpsertBulk) ClearUpdatedAt() *MfaPolicyUpsertBulk {
+	return u.Update(func(s *MfaPolicyUpsert) {
+		s.ClearUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *MfaPolicyUpsertBulk) SetDeletedAt(v time.Time) *MfaPolicyUpsertBulk {
+	return u.Update(func(s *MfaPolicyUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *MfaPolicyUpsertBulk) UpdateDeletedAt() *MfaPolicyUpsertBulk {
+	return u.Update(func(s *MfaPolicyUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *MfaPolicyUpsertBulk) ClearDeletedAt() *MfaPolicyUpsertBulk {
+	return u.Update(func(s *MfaPolicyUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *MfaPolicyUpsertBulk) SetCreatedBy(v uint32) *MfaPolicyUpsertBulk {
+	return u.Update(func(s *MfaPolicyUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *MfaPolicyUpsertBulk) AddCreatedBy(v uint32) *MfaPolicyUpsertBulk {
+	return u.Update(func(s *MfaPolicyUpsert) {
+		s.AddCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *MfaPolicyUpsertBulk) UpdateCreatedBy() *MfaPolicyUpsertBulk {
+	return u.Update(func(s *MfaPolicyUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *MfaPolicyUpsertBulk) ClearCreatedBy() *MfaPolicyUpsertBulk {
+	return u.Update(func(s *MfaPolicyUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *MfaPolicyUpsertBulk) SetUpdatedBy(v uint32) *MfaPolicyUpsertBulk {
+	return u.Update(func(s *MfaPolicyUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *MfaPolicyUpsertBulk) AddUpdatedBy(v uint32) *MfaPolicyUpsertBulk {
+	return u.Update(func(s *MfaPolicyUpsert) {
+		s.AddUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *MfaPolicyUpsertBulk) UpdateUpdatedBy() *MfaPolicyUpsertBulk {
+	return u.Update(func(s *MfaPolicyUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *MfaPolicyUpsertBulk) ClearUpdatedBy() *MfaPolicyUpsertBulk {
+	return u.Update(func(s *MfaPolicyUpsert) {
+		s.ClearUpdatedBy()
+	})
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *MfaPolicyUpsertBulk) SetDeletedBy(v uint32) *MfaPolicyUpsertBulk {
+	return u.Update(func(s *MfaPolicyUpsert) {
+		s.SetDeletedBy(v)
+	})
+}
+
+// AddDeletedBy adds v to the "deleted_by" field.
+func (u *MfaPolicyUpsertBulk) AddDeletedBy(v uint32) *MfaPolicyUpsertBulk {
+	return u.Update(func(s *MfaPolicyUpsert) {
+		s.AddDeletedBy(v)
+	})
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *MfaPolicyUpsertBulk) UpdateDeletedBy() *MfaPolicyUpsertBulk {
+	return u.Update(func(s *MfaPolicyUpsert) {
+		s.UpdateDeletedBy()
+	})
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *MfaPolicyUpsertBulk) ClearDeletedBy() *MfaPolicyUpsertBulk {
+	return u.Update(func(s *MfaPolicyUpsert) {
+		s.ClearDeletedBy()
+	})
+}
+
+// SetEnforcement sets the "enforcement" field.
+func (u *MfaPolicyUpsertBulk) SetEnforcement(v mfapolicy.Enforcement) *MfaPolicyUpsertBulk {
+	return u.Update(func(s *MfaPolicyUpsert) {
+		s.SetEnforcement(v)
+	})
+}
+
+// UpdateEnforcement sets the "enforcement" field to the value that was provided on create.
+func (u *MfaPolicyUpsertBulk) UpdateEnforcement() *MfaPolicyUpsertBulk {
+	return u.Update(func(s *MfaPolicyUpsert) {
+		s.UpdateEnforcement()
+	})
+}
+
+// ClearEnforcement clears the value of the "enforcement" field.
+func (u *MfaPolicyUpsertBulk) ClearEnforcement() *MfaPolicyUpsertBulk {
+	return u.Update(func(s *MfaPolicyUpsert) {
+		s.ClearEnforcement()
+	})
+}
+
+// SetRequiredRoleCodes sets the "required_role_codes" field.
+func (u *MfaPolicyUpsertBulk) SetRequiredRoleCodes(v []string) *MfaPolicyUpsertBulk {
+	return u.Update(func(s *MfaPolicyUpsert) {
+		s.SetRequiredRoleCodes(v)
+	})
+}
+
+// UpdateRequiredRoleCodes sets the "required_role_codes" field to the value that was provided on create.
+func (u *MfaPolicyUpsertBulk) UpdateRequiredRoleCodes() *MfaPolicyUpsertBulk {
+	return u.Update(func(s *MfaPolicyUpsert) {
+		s.UpdateRequiredRoleCodes()
+	})
+}
+
+// ClearRequiredRoleCodes clears the value of the "required_role_codes" field.
+func (u *MfaPolicyUpsertBulk) ClearRequiredRoleCodes() *MfaPolicyUpsertBulk {
+	return u.Update(func(s *MfaPolicyUpsert) {
+		s.ClearRequiredRoleCodes()
+	})
+}
+
+// Exec executes the query.
+func (u *MfaPolicyUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the MfaPolicyCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for MfaPolicyCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *MfaPolicyUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
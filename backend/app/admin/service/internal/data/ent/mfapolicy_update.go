@@ -0,0 +1,661 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-wind-admin/app/admin/service/internal/data/ent/mfapolicy"
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// MfaPolicyUpdate is the builder for updating MfaPolicy entities.
+type MfaPolicyUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *MfaPolicyMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the MfaPolicyUpdate builder.
+func (_u *MfaPolicyUpdate) Where(ps ...predicate.MfaPolicy) *MfaPolicyUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *MfaPolicyUpdate) SetUpdatedAt(v time.Time) *MfaPolicyUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *MfaPolicyUpdate) SetNillableUpdatedAt(v *time.Time) *MfaPolicyUpdate {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *MfaPolicyUpdate) ClearUpdatedAt() *MfaPolicyUpdate {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *MfaPolicyUpdate) SetDeletedAt(v time.Time) *MfaPolicyUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *MfaPolicyUpdate) SetNillableDeletedAt(v *time.Time) *MfaPolicyUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *MfaPolicyUpdate) ClearDeletedAt() *MfaPolicyUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *MfaPolicyUpdate) SetCreatedBy(v uint32) *MfaPolicyUpdate {
+	_u.mutation.ResetCreatedBy()
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *MfaPolicyUpdate) SetNillableCreatedBy(v *uint32) *MfaPolicyUpdate {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// AddCreatedBy adds value to the "created_by" field.
+func (_u *MfaPolicyUpdate) AddCreatedBy(v int32) *MfaPolicyUpdate {
+	_u.mutation.AddCreatedBy(v)
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *MfaPolicyUpdate) ClearCreatedBy() *MfaPolicyUpdate {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_u *MfaPolicyUpdate) SetUpdatedBy(v uint32) *MfaPolicyUpdate {
+	_u.mutation.ResetUpdatedBy()
+	_u.mutation.SetUpdatedBy(v)
+	return _u
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_u *MfaPolicyUpdate) SetNillableUpdatedBy(v *uint32) *MfaPolicyUpdate {
+	if v != nil {
+		_u.SetUpdatedBy(*v)
+	}
+	return _u
+}
+
+// AddUpdatedBy adds value to the "updated_by" field.
+func (_u *MfaPolicyUpdate) AddUpdatedBy(v int32) *MfaPolicyUpdate {
+	_u.mutation.AddUpdatedBy(v)
+	return _u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (_u *MfaPolicyUpdate) ClearUpdatedBy() *MfaPolicyUpdate {
+	_u.mutation.ClearUpdatedBy()
+	return _u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_u *MfaPolicyUpdate) SetDeletedBy(v uint32) *MfaPolicyUpdate {
+	_u.mutation.ResetDeletedBy()
+	_u.mutation.SetDeletedBy(v)
+	return _u
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_u *MfaPolicyUpdate) SetNillableDeletedBy(v *uint32) *MfaPolicyUpdate {
+	if v != nil {
+		_u.SetDeletedBy(*v)
+	}
+	return _u
+}
+
+// AddDeletedBy adds value to the "deleted_by" field.
+func (_u *MfaPolicyUpdate) AddDeletedBy(v int32) *MfaPolicyUpdate {
+	_u.mutation.AddDeletedBy(v)
+	return _u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (_u *MfaPolicyUpdate) ClearDeletedBy() *MfaPolicyUpdate {
+	_u.mutation.ClearDeletedBy()
+	return _u
+}
+
+// SetEnforcement sets the "enforcement" field.
+func (_u *MfaPolicyUpdate) SetEnforcement(v mfapolicy.Enforcement) *MfaPolicyUpdate {
+	_u.mutation.SetEnforcement(v)
+	return _u
+}
+
+// SetNillableEnforcement sets the "enforcement" field if the given value is not nil.
+func (_u *MfaPolicyUpdate) SetNillableEnforcement(v *mfapolicy.Enforcement) *MfaPolicyUpdate {
+	if v != nil {
+		_u.SetEnforcement(*v)
+	}
+	return _u
+}
+
+// ClearEnforcement clears the value of the "enforcement" field.
+func (_u *MfaPolicyUpdate) ClearEnforcement() *MfaPolicyUpdate {
+	_u.mutation.ClearEnforcement()
+	return _u
+}
+
+// SetRequiredRoleCodes sets the "required_role_codes" field.
+func (_u *MfaPolicyUpdate) SetRequiredRoleCodes(v []string) *MfaPolicyUpdate {
+	_u.mutation.SetRequiredRoleCodes(v)
+	return _u
+}
+
+// AppendRequiredRoleCodes appends value to the "required_role_codes" field.
+func (_u *MfaPolicyUpdate) AppendRequiredRoleCodes(v []string) *MfaPolicyUpdate {
+	_u.mutation.AppendRequiredRoleCodes(v)
+	return _u
+}
+
+// ClearRequiredRoleCodes clears the value of the "required_role_codes" field.
+func (_u *MfaPolicyUpdate) ClearRequiredRoleCodes() *MfaPolicyUpdate {
+	_u.mutation.ClearRequiredRoleCodes()
+	return _u
+}
+
+// Mutation returns the MfaPolicyMutation object of the builder.
+func (_u *MfaPolicyUpdate) Mutation() *MfaPolicyMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *MfaPolicyUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MfaPolicyUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *MfaPolicyUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MfaPolicyUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *MfaPolicyUpdate) check() error {
+	if v, ok := _u.mutation.Enforcement(); ok {
+		if err := mfapolicy.EnforcementValidator(v); err != nil {
+			return &ValidationError{Name: "enforcement", err: fmt.Errorf(`ent: validator failed for field "MfaPolicy.enforcement": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *MfaPolicyUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *MfaPolicyUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *MfaPolicyUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(mfapolicy.Table, mfapolicy.Columns, sqlgraph.NewFieldSpec(mfapolicy.FieldID, field.TypeUint32))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(mfapolicy.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(mfapolicy.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(mfapolicy.FieldUpdatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(mfapolicy.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(mfapolicy.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(mfapolicy.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(mfapolicy.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(mfapolicy.FieldCreatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UpdatedBy(); ok {
+		_spec.SetField(mfapolicy.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedBy(); ok {
+		_spec.AddField(mfapolicy.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdatedByCleared() {
+		_spec.ClearField(mfapolicy.FieldUpdatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.DeletedBy(); ok {
+		_spec.SetField(mfapolicy.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedBy(); ok {
+		_spec.AddField(mfapolicy.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.DeletedByCleared() {
+		_spec.ClearField(mfapolicy.FieldDeletedBy, field.TypeUint32)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(mfapolicy.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.Enforcement(); ok {
+		_spec.SetField(mfapolicy.FieldEnforcement, field.TypeEnum, value)
+	}
+	if _u.mutation.EnforcementCleared() {
+		_spec.ClearField(mfapolicy.FieldEnforcement, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.RequiredRoleCodes(); ok {
+		_spec.SetField(mfapolicy.FieldRequiredRoleCodes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRequiredRoleCodes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, mfapolicy.FieldRequiredRoleCodes, value)
+		})
+	}
+	if _u.mutation.RequiredRoleCodesCleared() {
+		_spec.ClearField(mfapolicy.FieldRequiredRoleCodes, field.TypeJSON)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{mfapolicy.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// MfaPolicyUpdateOne is the builder for updating a single MfaPolicy entity.
+type MfaPolicyUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *MfaPolicyMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *MfaPolicyUpdateOne) SetUpdatedAt(v time.Time) *MfaPolicyUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *MfaPolicyUpdateOne) SetNillableUpdatedAt(v *time.Time) *MfaPolicyUpdateOne {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *MfaPolicyUpdateOne) ClearUpdatedAt() *MfaPolicyUpdateOne {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *MfaPolicyUpdateOne) SetDeletedAt(v time.Time) *MfaPolicyUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *MfaPolicyUpdateOne) SetNillableDeletedAt(v *time.Time) *MfaPolicyUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *MfaPolicyUpdateOne) ClearDeletedAt() *MfaPolicyUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *MfaPolicyUpdateOne) SetCreatedBy(v uint32) *MfaPolicyUpdateOne {
+	_u.mutation.ResetCreatedBy()
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *MfaPolicyUpdateOne) SetNillableCreatedBy(v *uint32) *MfaPolicyUpdateOne {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// AddCreatedBy adds value to the "created_by" field.
+func (_u *MfaPolicyUpdateOne) AddCreatedBy(v int32) *MfaPolicyUpdateOne {
+	_u.mutation.AddCreatedBy(v)
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *MfaPolicyUpdateOne) ClearCreatedBy() *MfaPolicyUpdateOne {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_u *MfaPolicyUpdateOne) SetUpdatedBy(v uint32) *MfaPolicyUpdateOne {
+	_u.mutation.ResetUpdatedBy()
+	_u.mutation.SetUpdatedBy(v)
+	return _u
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_u *MfaPolicyUpdateOne) SetNillableUpdatedBy(v *uint32) *MfaPolicyUpdateOne {
+	if v != nil {
+		_u.SetUpdatedBy(*v)
+	}
+	return _u
+}
+
+// AddUpdatedBy adds value to the "updated_by" field.
+func (_u *MfaPolicyUpdateOne) AddUpdatedBy(v int32) *MfaPolicyUpdateOne {
+	_u.mutation.AddUpdatedBy(v)
+	return _u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (_u *MfaPolicyUpdateOne) ClearUpdatedBy() *MfaPolicyUpdateOne {
+	_u.mutation.ClearUpdatedBy()
+	return _u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_u *MfaPolicyUpdateOne) SetDeletedBy(v uint32) *MfaPolicyUpdateOne {
+	_u.mutation.ResetDeletedBy()
+	_u.mutation.SetDeletedBy(v)
+	return _u
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_u *MfaPolicyUpdateOne) SetNillableDeletedBy(v *uint32) *MfaPolicyUpdateOne {
+	if v != nil {
+		_u.SetDeletedBy(*v)
+	}
+	return _u
+}
+
+// AddDeletedBy adds value to the "deleted_by" field.
+func (_u *MfaPolicyUpdateOne) AddDeletedBy(v int32) *MfaPolicyUpdateOne {
+	_u.mutation.AddDeletedBy(v)
+	return _u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (_u *MfaPolicyUpdateOne) ClearDeletedBy() *MfaPolicyUpdateOne {
+	_u.mutation.ClearDeletedBy()
+	return _u
+}
+
+// SetEnforcement sets the "enforcement" field.
+func (_u *MfaPolicyUpdateOne) SetEnforcement(v mfapolicy.Enforcement) *MfaPolicyUpdateOne {
+	_u.mutation.SetEnforcement(v)
+	return _u
+}
+
+// SetNillableEnforcement sets the "enforcement" field if the given value is not nil.
+func (_u *MfaPolicyUpdateOne) SetNillableEnforcement(v *mfapolicy.Enforcement) *MfaPolicyUpdateOne {
+	if v != nil {
+		_u.SetEnforcement(*v)
+	}
+	return _u
+}
+
+// ClearEnforcement clears the value of the "enforcement" field.
+func (_u *MfaPolicyUpdateOne) ClearEnforcement() *MfaPolicyUpdateOne {
+	_u.mutation.ClearEnforcement()
+	return _u
+}
+
+// SetRequiredRoleCodes sets the "required_role_codes" field.
+func (_u *MfaPolicyUpdateOne) SetRequiredRoleCodes(v []string) *MfaPolicyUpdateOne {
+	_u.mutation.SetRequiredRoleCodes(v)
+	return _u
+}
+
+// AppendRequiredRoleCodes appends value to the "required_role_codes" field.
+func (_u *MfaPolicyUpdateOne) AppendRequiredRoleCodes(v []string) *MfaPolicyUpdateOne {
+	_u.mutation.AppendRequiredRoleCodes(v)
+	return _u
+}
+
+// ClearRequiredRoleCodes clears the value of the "required_role_codes" field.
+func (_u *MfaPolicyUpdateOne) ClearRequiredRoleCodes() *MfaPolicyUpdateOne {
+	_u.mutation.ClearRequiredRoleCodes()
+	return _u
+}
+
+// Mutation returns the MfaPolicyMutation object of the builder.
+func (_u *MfaPolicyUpdateOne) Mutation() *MfaPolicyMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the MfaPolicyUpdate builder.
+func (_u *MfaPolicyUpdateOne) Where(ps ...predicate.MfaPolicy) *MfaPolicyUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *MfaPolicyUpdateOne) Select(field string, fields ...string) *MfaPolicyUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated MfaPolicy entity.
+func (_u *MfaPolicyUpdateOne) Save(ctx context.Context) (*MfaPolicy, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MfaPolicyUpdateOne) SaveX(ctx context.Context) *MfaPolicy {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *MfaPolicyUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MfaPolicyUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *MfaPolicyUpdateOne) check() error {
+	if v, ok := _u.mutation.Enforcement(); ok {
+		if err := mfapolicy.EnforcementValidator(v); err != nil {
+			return &ValidationError{Name: "enforcement", err: fmt.Errorf(`ent: validator failed for field "MfaPolicy.enforcement": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *MfaPolicyUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *MfaPolicyUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *MfaPolicyUpdateOne) sqlSave(ctx context.Context) (_node *MfaPolicy, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(mfapolicy.Table, mfapolicy.Columns, sqlgraph.NewFieldSpec(mfapolicy.FieldID, field.TypeUint32))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "MfaPolicy.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, mfapolicy.FieldID)
+		for _, f := range fields {
+			if !mfapolicy.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != mfapolicy.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(mfapolicy.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(mfapolicy.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(mfapolicy.FieldUpdatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(mfapolicy.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(mfapolicy.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(mfapolicy.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(mfapolicy.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(mfapolicy.FieldCreatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UpdatedBy(); ok {
+		_spec.SetField(mfapolicy.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedBy(); ok {
+		_spec.AddField(mfapolicy.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdatedByCleared() {
+		_spec.ClearField(mfapolicy.FieldUpdatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.DeletedBy(); ok {
+		_spec.SetField(mfapolicy.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedBy(); ok {
+		_spec.AddField(mfapolicy.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.DeletedByCleared() {
+		_spec.ClearField(mfapolicy.FieldDeletedBy, field.TypeUint32)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(mfapolicy.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.Enforcement(); ok {
+		_spec.SetField(mfapolicy.FieldEnforcement, field.TypeEnum, value)
+	}
+	if _u.mutation.EnforcementCleared() {
+		_spec.ClearField(mfapolicy.FieldEnforcement, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.RequiredRoleCodes(); ok {
+		_spec.SetField(mfapolicy.FieldRequiredRoleCodes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRequiredRoleCodes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, mfapolicy.FieldRequiredRoleCodes, value)
+		})
+	}
+	if _u.mutation.RequiredRoleCodesCleared() {
+		_spec.ClearField(mfapolicy.FieldRequiredRoleCodes, field.TypeJSON)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &MfaPolicy{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{mfapolicy.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -79,6 +79,8 @@
 		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
 		{Name: "user_id", Type: field.TypeUint32, Nullable: true, Comment: "操作者用户ID"},
 		{Name: "username", Type: field.TypeString, Nullable: true, Comment: "操作者账号名"},
+		{Name: "impersonator_id", Type: field.TypeUint32, Nullable: true, Comment: "代登录操作人用户ID"},
+		{Name: "impersonator_name", Type: field.TypeString, Nullable: true, Comment: "代登录操作人账号名"},
 		{Name: "ip_address", Type: field.TypeString, Nullable: true, Comment: "IP地址"},
 		{Name: "geo_location", Type: field.TypeJSON, Nullable: true, Comment: "地理位置(来自IP库)"},
 		{Name: "device_info", Type: field.TypeJSON, Nullable: true, Comment: "设备信息"},
@@ -113,12 +115,12 @@
 			{
 				Name:    "uidx_sys_api_audit_logs_tenant_request_id",
 				Unique:  true,
-				Columns: []*schema.Column{SysAPIAuditLogsColumns[2], SysAPIAuditLogsColumns[16]},
+				Columns: []*schema.Column{SysAPIAuditLogsColumns[2], SysAPIAuditLogsColumns[18]},
 			},
 			{
 				Name:    "uidx_sys_api_audit_logs_tenant_log_hash",
 				Unique:  true,
-				Columns: []*schema.Column{SysAPIAuditLogsColumns[2], SysAPIAuditLogsColumns[26]},
+				Columns: []*schema.Column{SysAPIAuditLogsColumns[2], SysAPIAuditLogsColumns[28]},
 			},
 			{
 				Name:    "idx_sys_api_audit_logs_tenant_created_at",
@@ -143,27 +145,75 @@
 			{
 				Name:    "idx_sys_api_audit_logs_tenant_ip_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{SysAPIAuditLogsColumns[2], SysAPIAuditLogsColumns[5], SysAPIAuditLogsColumns[1]},
+				Columns: []*schema.Column{SysAPIAuditLogsColumns[2], SysAPIAuditLogsColumns[7], SysAPIAuditLogsColumns[1]},
 			},
 			{
 				Name:    "idx_sys_api_audit_logs_tenant_trace_id",
 				Unique:  false,
-				Columns: []*schema.Column{SysAPIAuditLogsColumns[2], SysAPIAuditLogsColumns[17]},
+				Columns: []*schema.Column{SysAPIAuditLogsColumns[2], SysAPIAuditLogsColumns[19]},
 			},
 			{
 				Name:    "idx_sys_api_audit_logs_tenant_api_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{SysAPIAuditLogsColumns[2], SysAPIAuditLogsColumns[13], SysAPIAuditLogsColumns[14], SysAPIAuditLogsColumns[1]},
+				Columns: []*schema.Column{SysAPIAuditLogsColumns[2], SysAPIAuditLogsColumns[15], SysAPIAuditLogsColumns[16], SysAPIAuditLogsColumns[1]},
 			},
 			{
 				Name:    "idx_sys_api_audit_logs_tenant_path_method_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{SysAPIAuditLogsColumns[2], SysAPIAuditLogsColumns[11], SysAPIAuditLogsColumns[10], SysAPIAuditLogsColumns[1]},
+				Columns: []*schema.Column{SysAPIAuditLogsColumns[2], SysAPIAuditLogsColumns[13], SysAPIAuditLogsColumns[12], SysAPIAuditLogsColumns[1]},
 			},
 			{
 				Name:    "idx_sys_api_audit_logs_tenant_status_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{SysAPIAuditLogsColumns[2], SysAPIAuditLogsColumns[21], SysAPIAuditLogsColumns[20], SysAPIAuditLogsColumns[1]},
+				Columns: []*schema.Column{SysAPIAuditLogsColumns[2], SysAPIAuditLogsColumns[23], SysAPIAuditLogsColumns[22], SysAPIAuditLogsColumns[1]},
+			},
+		},
+	}
+	// SysAPIClientsColumns holds the columns for the "sys_api_clients" table.
+	SysAPIClientsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "created_by", Type: field.TypeUint32, Nullable: true, Comment: "创建者ID"},
+		{Name: "updated_by", Type: field.TypeUint32, Nullable: true, Comment: "更新者ID"},
+		{Name: "deleted_by", Type: field.TypeUint32, Nullable: true, Comment: "删除者ID"},
+		{Name: "description", Type: field.TypeString, Nullable: true, Comment: "描述"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "status", Type: field.TypeEnum, Comment: "状态", Enums: []string{"OFF", "ON"}, Default: "ON"},
+		{Name: "name", Type: field.TypeString, Nullable: true, Size: 128, Comment: "客户端名称"},
+		{Name: "client_id", Type: field.TypeString, Nullable: true, Size: 64, Comment: "客户端ID"},
+		{Name: "client_secret_hash", Type: field.TypeString, Nullable: true, Size: 255, Comment: "客户端密钥哈希（bcrypt，不要存明文）"},
+		{Name: "role_ids", Type: field.TypeJSON, Nullable: true, Comment: "授权角色ID列表", SchemaType: map[string]string{"mysql": "json", "postgres": "jsonb"}},
+		{Name: "redirect_uris", Type: field.TypeJSON, Nullable: true, Comment: "授权码模式允许的回调地址列表", SchemaType: map[string]string{"mysql": "json", "postgres": "jsonb"}},
+		{Name: "scopes", Type: field.TypeJSON, Nullable: true, Comment: "授权码模式允许申请的授权范围列表", SchemaType: map[string]string{"mysql": "json", "postgres": "jsonb"}},
+		{Name: "public_client", Type: field.TypeBool, Nullable: true, Comment: "是否公共客户端（无法保管密钥，仅凭 PKCE 换取令牌）", Default: false},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true, Comment: "客户端过期时间，为空表示永不过期"},
+		{Name: "secret_rotated_at", Type: field.TypeTime, Nullable: true, Comment: "密钥最近一次轮换时间"},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true, Comment: "最近一次换取令牌的时间"},
+		{Name: "last_used_ip", Type: field.TypeString, Nullable: true, Size: 64, Comment: "最近一次换取令牌的客户端IP"},
+	}
+	// SysAPIClientsTable holds the schema information for the "sys_api_clients" table.
+	SysAPIClientsTable = &schema.Table{
+		Name:       "sys_api_clients",
+		Comment:    "服务客户端表",
+		Columns:    SysAPIClientsColumns,
+		PrimaryKey: []*schema.Column{SysAPIClientsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "uidx_sys_api_client_client_id",
+				Unique:  true,
+				Columns: []*schema.Column{SysAPIClientsColumns[11]},
+			},
+			{
+				Name:    "uidx_sys_api_client_tenant_name",
+				Unique:  true,
+				Columns: []*schema.Column{SysAPIClientsColumns[8], SysAPIClientsColumns[10]},
+			},
+			{
+				Name:    "idx_sys_api_client_tenant_status",
+				Unique:  false,
+				Columns: []*schema.Column{SysAPIClientsColumns[8], SysAPIClientsColumns[9]},
 			},
 		},
 	}
@@ -657,6 +707,42 @@
 			},
 		},
 	}
+	// SysJwtSigningKeysColumns holds the columns for the "sys_jwt_signing_keys" table.
+	SysJwtSigningKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "created_by", Type: field.TypeUint32, Nullable: true, Comment: "创建者ID"},
+		{Name: "updated_by", Type: field.TypeUint32, Nullable: true, Comment: "更新者ID"},
+		{Name: "deleted_by", Type: field.TypeUint32, Nullable: true, Comment: "删除者ID"},
+		{Name: "kid", Type: field.TypeString, Nullable: true, Size: 64, Comment: "密钥ID（JWT 头部 kid）"},
+		{Name: "algorithm", Type: field.TypeString, Nullable: true, Size: 16, Comment: "签名算法"},
+		{Name: "key_material", Type: field.TypeString, Nullable: true, Size: 2147483647, Comment: "密钥材料（非对称为 PEM 私钥，对称为 base64url 秘钥），加密存储"},
+		{Name: "status", Type: field.TypeEnum, Nullable: true, Comment: "密钥状态", Enums: []string{"ACTIVE", "RETIRED"}, Default: "ACTIVE"},
+		{Name: "activated_at", Type: field.TypeTime, Nullable: true, Comment: "启用时间"},
+		{Name: "retired_at", Type: field.TypeTime, Nullable: true, Comment: "退役时间"},
+		{Name: "verify_until", Type: field.TypeTime, Nullable: true, Comment: "校验截止时间：退役密钥在此之前仍接受其签发的令牌"},
+	}
+	// SysJwtSigningKeysTable holds the schema information for the "sys_jwt_signing_keys" table.
+	SysJwtSigningKeysTable = &schema.Table{
+		Name:       "sys_jwt_signing_keys",
+		Comment:    "JWT签名密钥表",
+		Columns:    SysJwtSigningKeysColumns,
+		PrimaryKey: []*schema.Column{SysJwtSigningKeysColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "uidx_sys_jwt_signing_key_kid",
+				Unique:  true,
+				Columns: []*schema.Column{SysJwtSigningKeysColumns[7]},
+			},
+			{
+				Name:    "idx_sys_jwt_signing_key_status_verify_until",
+				Unique:  false,
+				Columns: []*schema.Column{SysJwtSigningKeysColumns[10], SysJwtSigningKeysColumns[13]},
+			},
+		},
+	}
 	// SysLanguagesColumns holds the columns for the "sys_languages" table.
 	SysLanguagesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
@@ -722,6 +808,53 @@
 			},
 		},
 	}
+	// SysLdapConfigsColumns holds the columns for the "sys_ldap_configs" table.
+	SysLdapConfigsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "created_by", Type: field.TypeUint32, Nullable: true, Comment: "创建者ID"},
+		{Name: "updated_by", Type: field.TypeUint32, Nullable: true, Comment: "更新者ID"},
+		{Name: "deleted_by", Type: field.TypeUint32, Nullable: true, Comment: "删除者ID"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "status", Type: field.TypeEnum, Comment: "状态", Enums: []string{"OFF", "ON"}, Default: "ON"},
+		{Name: "display_name", Type: field.TypeString, Nullable: true, Size: 64, Comment: "显示名称"},
+		{Name: "server_url", Type: field.TypeString, Nullable: true, Size: 512, Comment: "目录地址（ldap:// 或 ldaps://）"},
+		{Name: "start_tls", Type: field.TypeBool, Nullable: true, Comment: "ldap:// 连接是否升级 StartTLS", Default: false},
+		{Name: "ca_certificate", Type: field.TypeString, Nullable: true, Size: 2147483647, Comment: "校验目录服务器证书的 CA（PEM），为空使用系统根证书"},
+		{Name: "bind_dn", Type: field.TypeString, Nullable: true, Size: 512, Comment: "检索用服务账号 DN，为空匿名检索"},
+		{Name: "bind_password", Type: field.TypeString, Nullable: true, Size: 1024, Comment: "服务账号密码（加密存储）"},
+		{Name: "base_dn", Type: field.TypeString, Nullable: true, Size: 512, Comment: "检索起点 DN"},
+		{Name: "user_filter", Type: field.TypeString, Nullable: true, Size: 1024, Comment: "用户过滤器，含 {username} 占位符"},
+		{Name: "id_attribute", Type: field.TypeString, Nullable: true, Size: 64, Comment: "条目唯一标识属性（entryUUID / objectGUID），为空以 DN 作标识"},
+		{Name: "attribute_mapping", Type: field.TypeJSON, Nullable: true, Comment: "用户属性映射", SchemaType: map[string]string{"mysql": "json", "postgres": "jsonb"}},
+		{Name: "group_filter", Type: field.TypeString, Nullable: true, Size: 1024, Comment: "组过滤器，为空不同步组"},
+		{Name: "group_member_attribute", Type: field.TypeString, Nullable: true, Size: 64, Comment: "组成员属性（值为成员 DN）"},
+		{Name: "group_role_mapping", Type: field.TypeJSON, Nullable: true, Comment: "组到角色的映射", SchemaType: map[string]string{"mysql": "json", "postgres": "jsonb"}},
+		{Name: "org_unit_filter", Type: field.TypeString, Nullable: true, Size: 1024, Comment: "组织单元过滤器，为空不同步组织单元"},
+		{Name: "org_unit_root_id", Type: field.TypeUint32, Nullable: true, Comment: "同步的顶层组织单元挂载的本地上级组织单元ID"},
+		{Name: "auth_mode", Type: field.TypeEnum, Nullable: true, Comment: "认证模式", Enums: []string{"LDAP_FIRST", "LDAP_ONLY"}, Default: "LDAP_FIRST"},
+		{Name: "jit_provisioning", Type: field.TypeBool, Nullable: true, Comment: "目录账号首次登录且尚未同步时是否自动创建用户", Default: false},
+		{Name: "default_role_ids", Type: field.TypeJSON, Nullable: true, Comment: "新建用户时授予的角色ID列表", SchemaType: map[string]string{"mysql": "json", "postgres": "jsonb"}},
+		{Name: "default_org_unit_id", Type: field.TypeUint32, Nullable: true, Comment: "新建用户且目录未给出组织单元时归属的组织单元ID"},
+		{Name: "last_synced_at", Type: field.TypeTime, Nullable: true, Comment: "最近一次同步时间"},
+		{Name: "last_sync_message", Type: field.TypeString, Nullable: true, Size: 1024, Comment: "最近一次同步结果"},
+	}
+	// SysLdapConfigsTable holds the schema information for the "sys_ldap_configs" table.
+	SysLdapConfigsTable = &schema.Table{
+		Name:       "sys_ldap_configs",
+		Comment:    "LDAP 目录认证与同步配置表",
+		Columns:    SysLdapConfigsColumns,
+		PrimaryKey: []*schema.Column{SysLdapConfigsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "uidx_sys_ldap_config_tenant",
+				Unique:  true,
+				Columns: []*schema.Column{SysLdapConfigsColumns[7]},
+			},
+		},
+	}
 	// SysLoginAuditLogsColumns holds the columns for the "sys_login_audit_logs" table.
 	SysLoginAuditLogsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
@@ -820,11 +953,13 @@
 		{Name: "updated_by", Type: field.TypeUint32, Nullable: true, Comment: "更新者ID"},
 		{Name: "deleted_by", Type: field.TypeUint32, Nullable: true, Comment: "删除者ID"},
 		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
-		{Name: "target_id", Type: field.TypeUint32, Nullable: true, Comment: "目标用户ID"},
+		{Name: "target_id", Type: field.TypeUint32, Nullable: true, Comment: "目标ID（用户ID或组织单元ID）"},
 		{Name: "value", Type: field.TypeString, Nullable: true, Comment: "限制值（如IP地址、MAC地址或地区代码）"},
 		{Name: "reason", Type: field.TypeString, Nullable: true, Comment: "限制原因"},
 		{Name: "type", Type: field.TypeEnum, Nullable: true, Comment: "限制类型", Enums: []string{"BLACK_LIST", "WHITE_LIST"}, Default: "BLACK_LIST"},
 		{Name: "method", Type: field.TypeEnum, Nullable: true, Comment: "限制方式", Enums: []string{"IP", "MAC", "REGION", "TIME", "DEVICE"}, Default: "IP"},
+		{Name: "target_type", Type: field.TypeEnum, Nullable: true, Comment: "生效对象类型", Enums: []string{"GLOBAL", "USER", "ROLE", "ORG_UNIT"}},
+		{Name: "target_code", Type: field.TypeString, Nullable: true, Comment: "目标编码（角色码）"},
 	}
 	// SysLoginPoliciesTable holds the schema information for the "sys_login_policies" table.
 	SysLoginPoliciesTable = &schema.Table{
@@ -836,7 +971,7 @@
 			{
 				Name:    "uidx_sys_login_policy_tenant_target_type_method",
 				Unique:  true,
-				Columns: []*schema.Column{SysLoginPoliciesColumns[7], SysLoginPoliciesColumns[8], SysLoginPoliciesColumns[11], SysLoginPoliciesColumns[12]},
+				Columns: []*schema.Column{SysLoginPoliciesColumns[7], SysLoginPoliciesColumns[13], SysLoginPoliciesColumns[8], SysLoginPoliciesColumns[14], SysLoginPoliciesColumns[11], SysLoginPoliciesColumns[12]},
 			},
 			{
 				Name:    "idx_sys_login_policy_tenant_type_method",
@@ -1328,6 +1463,77 @@
 			},
 		},
 	}
+	// SysMfaPoliciesColumns holds the columns for the "sys_mfa_policies" table.
+	SysMfaPoliciesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "created_by", Type: field.TypeUint32, Nullable: true, Comment: "创建者ID"},
+		{Name: "updated_by", Type: field.TypeUint32, Nullable: true, Comment: "更新者ID"},
+		{Name: "deleted_by", Type: field.TypeUint32, Nullable: true, Comment: "删除者ID"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "enforcement", Type: field.TypeEnum, Nullable: true, Comment: "对全体用户的要求", Enums: []string{"NOT_REQUIRED", "OPTIONAL", "REQUIRED"}, Default: "NOT_REQUIRED"},
+		{Name: "required_role_codes", Type: field.TypeJSON, Nullable: true, Comment: "强制启用 MFA 的角色代码列表", SchemaType: map[string]string{"mysql": "json", "postgres": "jsonb"}},
+	}
+	// SysMfaPoliciesTable holds the schema information for the "sys_mfa_policies" table.
+	SysMfaPoliciesTable = &schema.Table{
+		Name:       "sys_mfa_policies",
+		Comment:    "MFA 策略表",
+		Columns:    SysMfaPoliciesColumns,
+		PrimaryKey: []*schema.Column{SysMfaPoliciesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "uidx_sys_mfa_policy_tenant",
+				Unique:  true,
+				Columns: []*schema.Column{SysMfaPoliciesColumns[7]},
+			},
+		},
+	}
+	// SysOauthProviderConfigsColumns holds the columns for the "sys_oauth_provider_configs" table.
+	SysOauthProviderConfigsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "created_by", Type: field.TypeUint32, Nullable: true, Comment: "创建者ID"},
+		{Name: "updated_by", Type: field.TypeUint32, Nullable: true, Comment: "更新者ID"},
+		{Name: "deleted_by", Type: field.TypeUint32, Nullable: true, Comment: "删除者ID"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "status", Type: field.TypeEnum, Comment: "状态", Enums: []string{"OFF", "ON"}, Default: "ON"},
+		{Name: "sort_order", Type: field.TypeUint32, Nullable: true, Comment: "排序值（越小越靠前）", Default: 0},
+		{Name: "provider", Type: field.TypeString, Nullable: true, Size: 32, Comment: "提供方"},
+		{Name: "provider_custom", Type: field.TypeString, Size: 64, Comment: "自定义提供方标识", Default: ""},
+		{Name: "display_name", Type: field.TypeString, Nullable: true, Size: 64, Comment: "登录页显示名称"},
+		{Name: "client_id", Type: field.TypeString, Nullable: true, Size: 255, Comment: "提供方分配的客户端ID（微信为 AppID）"},
+		{Name: "client_secret", Type: field.TypeString, Nullable: true, Size: 1024, Comment: "提供方分配的客户端密钥（加密存储）"},
+		{Name: "auth_url", Type: field.TypeString, Nullable: true, Size: 512, Comment: "授权端点，为空使用提供方默认值"},
+		{Name: "token_url", Type: field.TypeString, Nullable: true, Size: 512, Comment: "令牌端点，为空使用提供方默认值"},
+		{Name: "userinfo_url", Type: field.TypeString, Nullable: true, Size: 512, Comment: "用户信息端点，为空使用提供方默认值"},
+		{Name: "redirect_uri", Type: field.TypeString, Nullable: true, Size: 512, Comment: "在提供方登记的回调地址"},
+		{Name: "scopes", Type: field.TypeJSON, Nullable: true, Comment: "授权范围，为空使用提供方默认值", SchemaType: map[string]string{"mysql": "json", "postgres": "jsonb"}},
+		{Name: "auto_provision", Type: field.TypeBool, Nullable: true, Comment: "未绑定的第三方账号首次登录时是否自动创建用户", Default: false},
+		{Name: "default_role_ids", Type: field.TypeJSON, Nullable: true, Comment: "自动创建用户时授予的角色ID列表", SchemaType: map[string]string{"mysql": "json", "postgres": "jsonb"}},
+	}
+	// SysOauthProviderConfigsTable holds the schema information for the "sys_oauth_provider_configs" table.
+	SysOauthProviderConfigsTable = &schema.Table{
+		Name:       "sys_oauth_provider_configs",
+		Comment:    "第三方登录提供方配置表",
+		Columns:    SysOauthProviderConfigsColumns,
+		PrimaryKey: []*schema.Column{SysOauthProviderConfigsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "uidx_sys_oauth_provider_config_tenant_provider",
+				Unique:  true,
+				Columns: []*schema.Column{SysOauthProviderConfigsColumns[7], SysOauthProviderConfigsColumns[10], SysOauthProviderConfigsColumns[11]},
+			},
+			{
+				Name:    "idx_sys_oauth_provider_config_tenant_status",
+				Unique:  false,
+				Columns: []*schema.Column{SysOauthProviderConfigsColumns[7], SysOauthProviderConfigsColumns[8]},
+			},
+		},
+	}
 	// SysOperationAuditLogsColumns holds the columns for the "sys_operation_audit_logs" table.
 	SysOperationAuditLogsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
@@ -1335,6 +1541,8 @@
 		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
 		{Name: "user_id", Type: field.TypeUint32, Nullable: true, Comment: "操作者用户ID"},
 		{Name: "username", Type: field.TypeString, Nullable: true, Comment: "操作者账号名"},
+		{Name: "impersonator_id", Type: field.TypeUint32, Nullable: true, Comment: "代登录操作人用户ID"},
+		{Name: "impersonator_name", Type: field.TypeString, Nullable: true, Comment: "代登录操作人账号名"},
 		{Name: "resource_type", Type: field.TypeString, Nullable: true, Comment: "资源类型"},
 		{Name: "resource_id", Type: field.TypeString, Nullable: true, Comment: "资源ID"},
 		{Name: "action", Type: field.TypeEnum, Nullable: true, Comment: "动作", Enums: []string{"CREATE", "UPDATE", "DELETE", "READ", "ASSIGN", "UNASSIGN", "EXPORT", "IMPORT", "OTHER"}},
@@ -1368,25 +1576,30 @@
 				Unique:  false,
 				Columns: []*schema.Column{SysOperationAuditLogsColumns[4]},
 			},
+			{
+				Name:    "operationauditlog_impersonator_id",
+				Unique:  false,
+				Columns: []*schema.Column{SysOperationAuditLogsColumns[5]},
+			},
 			{
 				Name:    "operationauditlog_request_id",
 				Unique:  false,
-				Columns: []*schema.Column{SysOperationAuditLogsColumns[11]},
+				Columns: []*schema.Column{SysOperationAuditLogsColumns[13]},
 			},
 			{
 				Name:    "operationauditlog_trace_id",
 				Unique:  false,
-				Columns: []*schema.Column{SysOperationAuditLogsColumns[12]},
+				Columns: []*schema.Column{SysOperationAuditLogsColumns[14]},
 			},
 			{
 				Name:    "operationauditlog_ip_address",
 				Unique:  false,
-				Columns: []*schema.Column{SysOperationAuditLogsColumns[15]},
+				Columns: []*schema.Column{SysOperationAuditLogsColumns[17]},
 			},
 			{
 				Name:    "operationauditlog_ip_address_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{SysOperationAuditLogsColumns[15], SysOperationAuditLogsColumns[1]},
+				Columns: []*schema.Column{SysOperationAuditLogsColumns[17], SysOperationAuditLogsColumns[1]},
 			},
 			{
 				Name:    "operationauditlog_tenant_id",
@@ -1411,32 +1624,32 @@
 			{
 				Name:    "operationauditlog_resource_type_resource_id",
 				Unique:  false,
-				Columns: []*schema.Column{SysOperationAuditLogsColumns[5], SysOperationAuditLogsColumns[6]},
+				Columns: []*schema.Column{SysOperationAuditLogsColumns[7], SysOperationAuditLogsColumns[8]},
 			},
 			{
 				Name:    "operationauditlog_action",
 				Unique:  false,
-				Columns: []*schema.Column{SysOperationAuditLogsColumns[7]},
+				Columns: []*schema.Column{SysOperationAuditLogsColumns[9]},
 			},
 			{
 				Name:    "operationauditlog_action_success_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{SysOperationAuditLogsColumns[7], SysOperationAuditLogsColumns[13], SysOperationAuditLogsColumns[1]},
+				Columns: []*schema.Column{SysOperationAuditLogsColumns[9], SysOperationAuditLogsColumns[15], SysOperationAuditLogsColumns[1]},
 			},
 			{
 				Name:    "operationauditlog_sensitive_level",
 				Unique:  false,
-				Columns: []*schema.Column{SysOperationAuditLogsColumns[10]},
+				Columns: []*schema.Column{SysOperationAuditLogsColumns[12]},
 			},
 			{
 				Name:    "operationauditlog_success",
 				Unique:  false,
-				Columns: []*schema.Column{SysOperationAuditLogsColumns[13]},
+				Columns: []*schema.Column{SysOperationAuditLogsColumns[15]},
 			},
 			{
 				Name:    "operationauditlog_log_hash",
 				Unique:  false,
-				Columns: []*schema.Column{SysOperationAuditLogsColumns[18]},
+				Columns: []*schema.Column{SysOperationAuditLogsColumns[20]},
 			},
 		},
 	}
@@ -1575,6 +1788,41 @@
 			},
 		},
 	}
+	// SysPasswordPoliciesColumns holds the columns for the "sys_password_policies" table.
+	SysPasswordPoliciesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "created_by", Type: field.TypeUint32, Nullable: true, Comment: "创建者ID"},
+		{Name: "updated_by", Type: field.TypeUint32, Nullable: true, Comment: "更新者ID"},
+		{Name: "deleted_by", Type: field.TypeUint32, Nullable: true, Comment: "删除者ID"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "min_length", Type: field.TypeUint32, Nullable: true, Comment: "最小长度", Default: 0},
+		{Name: "require_uppercase", Type: field.TypeBool, Nullable: true, Comment: "须含大写字母", Default: false},
+		{Name: "require_lowercase", Type: field.TypeBool, Nullable: true, Comment: "须含小写字母", Default: false},
+		{Name: "require_digit", Type: field.TypeBool, Nullable: true, Comment: "须含数字", Default: false},
+		{Name: "require_symbol", Type: field.TypeBool, Nullable: true, Comment: "须含特殊字符", Default: false},
+		{Name: "disallow_identity", Type: field.TypeBool, Nullable: true, Comment: "禁止包含用户名与租户代码", Default: false},
+		{Name: "banned_substrings", Type: field.TypeJSON, Nullable: true, Comment: "禁止包含的子串（不区分大小写）", SchemaType: map[string]string{"mysql": "json", "postgres": "jsonb"}},
+		{Name: "history_count", Type: field.TypeUint32, Nullable: true, Comment: "禁止重复使用最近 N 次密码，0 不限制", Default: 0},
+		{Name: "max_age_days", Type: field.TypeUint32, Nullable: true, Comment: "密码有效天数，到期后登录要求修改，0 不过期", Default: 0},
+		{Name: "check_breached", Type: field.TypeBool, Nullable: true, Comment: "是否比对本地泄露密码库", Default: false},
+	}
+	// SysPasswordPoliciesTable holds the schema information for the "sys_password_policies" table.
+	SysPasswordPoliciesTable = &schema.Table{
+		Name:       "sys_password_policies",
+		Comment:    "密码策略表",
+		Columns:    SysPasswordPoliciesColumns,
+		PrimaryKey: []*schema.Column{SysPasswordPoliciesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "uidx_sys_password_policy_tenant",
+				Unique:  true,
+				Columns: []*schema.Column{SysPasswordPoliciesColumns[7]},
+			},
+		},
+	}
 	// SysPermissionsColumns holds the columns for the "sys_permissions" table.
 	SysPermissionsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
@@ -2151,6 +2399,52 @@
 			},
 		},
 	}
+	// SysRelationTuplesColumns holds the columns for the "sys_relation_tuples" table.
+	SysRelationTuplesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "created_by", Type: field.TypeUint32, Nullable: true, Comment: "创建者ID"},
+		{Name: "namespace", Type: field.TypeString, Size: 64, Comment: "对象命名空间"},
+		{Name: "object_id", Type: field.TypeString, Size: 255, Comment: "对象ID"},
+		{Name: "relation", Type: field.TypeString, Size: 64, Comment: "关系"},
+		{Name: "subject_namespace", Type: field.TypeString, Size: 64, Comment: "主体命名空间"},
+		{Name: "subject_id", Type: field.TypeString, Size: 255, Comment: "主体ID"},
+		{Name: "subject_relation", Type: field.TypeString, Size: 64, Comment: "主体关系（为空表示具体对象，否则为用户集）", Default: ""},
+	}
+	// SysRelationTuplesTable holds the schema information for the "sys_relation_tuples" table.
+	SysRelationTuplesTable = &schema.Table{
+		Name:       "sys_relation_tuples",
+		Comment:    "关系元组表（object#relation@subject）",
+		Columns:    SysRelationTuplesColumns,
+		PrimaryKey: []*schema.Column{SysRelationTuplesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "uix_relation_tuple",
+				Unique:  true,
+				Columns: []*schema.Column{SysRelationTuplesColumns[3], SysRelationTuplesColumns[4], SysRelationTuplesColumns[5], SysRelationTuplesColumns[6], SysRelationTuplesColumns[7], SysRelationTuplesColumns[8]},
+			},
+			{
+				Name:    "idx_relation_tuple_subject",
+				Unique:  false,
+				Columns: []*schema.Column{SysRelationTuplesColumns[6], SysRelationTuplesColumns[7], SysRelationTuplesColumns[8]},
+			},
+		},
+	}
+	// SysRelationTupleChangesColumns holds the columns for the "sys_relation_tuple_changes" table.
+	SysRelationTupleChangesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "created_by", Type: field.TypeUint32, Nullable: true, Comment: "创建者ID"},
+		{Name: "operation", Type: field.TypeEnum, Comment: "变更操作", Enums: []string{"WRITE", "DELETE", "REPLACE"}},
+		{Name: "tuples", Type: field.TypeString, Nullable: true, Size: 2147483647, Comment: "变更的元组（每行一个 object#relation@subject；REPLACE 为被替换的命名空间）"},
+	}
+	// SysRelationTupleChangesTable holds the schema information for the "sys_relation_tuple_changes" table.
+	SysRelationTupleChangesTable = &schema.Table{
+		Name:       "sys_relation_tuple_changes",
+		Comment:    "关系元组变更日志表（自增ID即元组版本号，用作一致性令牌）",
+		Columns:    SysRelationTupleChangesColumns,
+		PrimaryKey: []*schema.Column{SysRelationTupleChangesColumns[0]},
+	}
 	// SysRolesColumns holds the columns for the "sys_roles" table.
 	SysRolesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
@@ -2169,6 +2463,8 @@
 		{Name: "code", Type: field.TypeString, Nullable: true, Comment: "角色标识"},
 		{Name: "is_protected", Type: field.TypeBool, Comment: "是否受保护的角色", Default: false},
 		{Name: "type", Type: field.TypeEnum, Comment: "角色类型", Enums: []string{"SYSTEM", "TEMPLATE", "TENANT"}, Default: "TENANT"},
+		{Name: "data_scope", Type: field.TypeEnum, Nullable: true, Comment: "数据权限范围，为空视为全部数据", Enums: []string{"ALL", "SELF", "UNIT_ONLY", "UNIT_AND_CHILD", "SELECTED_UNITS"}},
+		{Name: "data_scope_org_unit_ids", Type: field.TypeJSON, Nullable: true, Comment: "自定义数据权限的组织单元ID列表（SELECTED_UNITS）", SchemaType: map[string]string{"mysql": "json", "postgres": "jsonb"}},
 	}
 	// SysRolesTable holds the schema information for the "sys_roles" table.
 	SysRolesTable = &schema.Table{
@@ -2353,6 +2649,81 @@
 			},
 		},
 	}
+	// SysSamlConfigsColumns holds the columns for the "sys_saml_configs" table.
+	SysSamlConfigsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "created_by", Type: field.TypeUint32, Nullable: true, Comment: "创建者ID"},
+		{Name: "updated_by", Type: field.TypeUint32, Nullable: true, Comment: "更新者ID"},
+		{Name: "deleted_by", Type: field.TypeUint32, Nullable: true, Comment: "删除者ID"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "status", Type: field.TypeEnum, Comment: "状态", Enums: []string{"OFF", "ON"}, Default: "ON"},
+		{Name: "display_name", Type: field.TypeString, Nullable: true, Size: 64, Comment: "登录页显示名称"},
+		{Name: "idp_entity_id", Type: field.TypeString, Nullable: true, Size: 512, Comment: "IdP 实体ID（断言 Issuer）"},
+		{Name: "idp_sso_url", Type: field.TypeString, Nullable: true, Size: 1024, Comment: "IdP 单点登录地址（HTTP-Redirect 绑定）"},
+		{Name: "idp_certificate", Type: field.TypeString, Nullable: true, Size: 2147483647, Comment: "IdP 签名证书（PEM）"},
+		{Name: "name_id_format", Type: field.TypeString, Nullable: true, Size: 128, Comment: "请求的 NameID 格式，为空由 IdP 决定"},
+		{Name: "attribute_mapping", Type: field.TypeJSON, Nullable: true, Comment: "断言属性映射", SchemaType: map[string]string{"mysql": "json", "postgres": "jsonb"}},
+		{Name: "jit_provisioning", Type: field.TypeBool, Nullable: true, Comment: "未绑定的企业账号首次登录时是否自动创建用户", Default: false},
+		{Name: "default_role_ids", Type: field.TypeJSON, Nullable: true, Comment: "自动创建用户时授予的角色ID列表", SchemaType: map[string]string{"mysql": "json", "postgres": "jsonb"}},
+		{Name: "default_org_unit_id", Type: field.TypeUint32, Nullable: true, Comment: "自动创建用户时归属的组织单元ID"},
+		{Name: "sso_only", Type: field.TypeBool, Nullable: true, Comment: "是否仅允许单点登录（禁用本地密码登录）", Default: false},
+		{Name: "login_redirect_url", Type: field.TypeString, Nullable: true, Size: 512, Comment: "断言校验通过后携带一次性票据跳转的前端地址"},
+	}
+	// SysSamlConfigsTable holds the schema information for the "sys_saml_configs" table.
+	SysSamlConfigsTable = &schema.Table{
+		Name:       "sys_saml_configs",
+		Comment:    "SAML 单点登录配置表",
+		Columns:    SysSamlConfigsColumns,
+		PrimaryKey: []*schema.Column{SysSamlConfigsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "uidx_sys_saml_config_tenant",
+				Unique:  true,
+				Columns: []*schema.Column{SysSamlConfigsColumns[7]},
+			},
+		},
+	}
+	// SysScimTokensColumns holds the columns for the "sys_scim_tokens" table.
+	SysScimTokensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "created_by", Type: field.TypeUint32, Nullable: true, Comment: "创建者ID"},
+		{Name: "updated_by", Type: field.TypeUint32, Nullable: true, Comment: "更新者ID"},
+		{Name: "deleted_by", Type: field.TypeUint32, Nullable: true, Comment: "删除者ID"},
+		{Name: "description", Type: field.TypeString, Nullable: true, Comment: "描述"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "status", Type: field.TypeEnum, Comment: "状态", Enums: []string{"OFF", "ON"}, Default: "ON"},
+		{Name: "name", Type: field.TypeString, Nullable: true, Size: 64, Comment: "令牌名称，用作 SCIM 变更的审计操作人"},
+		{Name: "token_prefix", Type: field.TypeString, Nullable: true, Size: 16, Comment: "令牌前缀，便于管理员辨认"},
+		{Name: "token_hash", Type: field.TypeString, Nullable: true, Size: 64, Comment: "令牌哈希（SHA-256 十六进制，不要存明文）"},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true, Comment: "过期时间，为空表示永不过期"},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true, Comment: "最近一次使用时间"},
+		{Name: "last_used_ip", Type: field.TypeString, Nullable: true, Size: 64, Comment: "最近一次使用的客户端IP"},
+	}
+	// SysScimTokensTable holds the schema information for the "sys_scim_tokens" table.
+	SysScimTokensTable = &schema.Table{
+		Name:       "sys_scim_tokens",
+		Comment:    "SCIM 供应令牌表",
+		Columns:    SysScimTokensColumns,
+		PrimaryKey: []*schema.Column{SysScimTokensColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "uidx_sys_scim_token_hash",
+				Unique:  true,
+				Columns: []*schema.Column{SysScimTokensColumns[12]},
+			},
+			{
+				Name:    "uidx_sys_scim_token_tenant_name",
+				Unique:  true,
+				Columns: []*schema.Column{SysScimTokensColumns[8], SysScimTokensColumns[10]},
+			},
+		},
+	}
 	// SysTasksColumns holds the columns for the "sys_tasks" table.
 	SysTasksColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
@@ -2420,6 +2791,7 @@
 		{Name: "logo_url", Type: field.TypeString, Nullable: true, Comment: "租户logo地址"},
 		{Name: "domain", Type: field.TypeString, Nullable: true, Comment: "租户专属域名"},
 		{Name: "industry", Type: field.TypeString, Nullable: true, Comment: "所属行业"},
+		{Name: "timezone", Type: field.TypeString, Nullable: true, Comment: "时区"},
 		{Name: "admin_user_id", Type: field.TypeUint32, Nullable: true, Comment: "管理员用户ID"},
 		{Name: "status", Type: field.TypeEnum, Nullable: true, Comment: "租户状态", Enums: []string{"ON", "OFF", "EXPIRED", "FREEZE"}, Default: "ON"},
 		{Name: "type", Type: field.TypeEnum, Nullable: true, Comment: "租户类型", Enums: []string{"TRIAL", "PAID", "INTERNAL", "PARTNER", "CUSTOM"}, Default: "PAID"},
@@ -2439,7 +2811,7 @@
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "sys_tenants_sys_plans_tenants",
-				Columns:    []*schema.Column{SysTenantsColumns[21]},
+				Columns:    []*schema.Column{SysTenantsColumns[22]},
 				RefColumns: []*schema.Column{SysPlansColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -2463,27 +2835,27 @@
 			{
 				Name:    "idx_sys_tenant_admin_user_id",
 				Unique:  false,
-				Columns: []*schema.Column{SysTenantsColumns[13]},
+				Columns: []*schema.Column{SysTenantsColumns[14]},
 			},
 			{
 				Name:    "idx_sys_tenant_status_audit_status",
 				Unique:  false,
-				Columns: []*schema.Column{SysTenantsColumns[14], SysTenantsColumns[16]},
+				Columns: []*schema.Column{SysTenantsColumns[15], SysTenantsColumns[17]},
 			},
 			{
 				Name:    "idx_sys_tenant_type_expired_at",
 				Unique:  false,
-				Columns: []*schema.Column{SysTenantsColumns[15], SysTenantsColumns[20]},
+				Columns: []*schema.Column{SysTenantsColumns[16], SysTenantsColumns[21]},
 			},
 			{
 				Name:    "idx_sys_tenant_subscription_at",
 				Unique:  false,
-				Columns: []*schema.Column{SysTenantsColumns[17]},
+				Columns: []*schema.Column{SysTenantsColumns[18]},
 			},
 			{
 				Name:    "idx_sys_tenant_expired_at",
 				Unique:  false,
-				Columns: []*schema.Column{SysTenantsColumns[20]},
+				Columns: []*schema.Column{SysTenantsColumns[21]},
 			},
 			{
 				Name:    "idx_sys_tenant_created_by_created_at",
@@ -2578,7 +2950,7 @@
 		{Name: "user_id", Type: field.TypeUint32, Nullable: true, Comment: "关联主表的用户ID"},
 		{Name: "identity_type", Type: field.TypeEnum, Nullable: true, Comment: "认证方式类型", Enums: []string{"USERNAME", "USERID", "EMAIL", "PHONE", "SOCIAL_OAUTH", "ENTERPRISE_SSO", "IDENTITY_API_KEY", "DEVICE_ID", "CUSTOM"}, Default: "USERNAME"},
 		{Name: "identifier", Type: field.TypeString, Nullable: true, Comment: "身份唯一标识符"},
-		{Name: "credential_type", Type: field.TypeEnum, Nullable: true, Comment: "凭证类型", Enums: []string{"PASSWORD_HASH", "API_KEY", "API_SECRET", "ACCESS_TOKEN", "REFRESH_TOKEN", "JWT", "OAUTH_TOKEN", "OAUTH_AUTHORIZATION_CODE", "OAUTH_CLIENT_CREDENTIALS", "OTP", "TOTP", "SMS_OTP", "EMAIL_OTP", "HARDWARE_TOKEN", "SOFTWARE_TOKEN", "SECURITY_QUESTION", "BIOMETRIC", "BIOMETRIC_TOKEN", "SSO_TOKEN", "SAML_ASSERTION", "OPENID_CONNECT_ID_TOKEN", "SESSION_COOKIE", "TEMPORARY_CREDENTIAL", "CUSTOM", "RESERVED_FOR_FUTURE"}, Default: "PASSWORD_HASH"},
+		{Name: "credential_type", Type: field.TypeEnum, Nullable: true, Comment: "凭证类型", Enums: []string{"PASSWORD_HASH", "API_KEY", "API_SECRET", "ACCESS_TOKEN", "REFRESH_TOKEN", "JWT", "OAUTH_TOKEN", "OAUTH_AUTHORIZATION_CODE", "OAUTH_CLIENT_CREDENTIALS", "OTP", "TOTP", "SMS_OTP", "EMAIL_OTP", "HARDWARE_TOKEN", "SOFTWARE_TOKEN", "SECURITY_QUESTION", "BIOMETRIC", "BIOMETRIC_TOKEN", "SSO_TOKEN", "SAML_ASSERTION", "OPENID_CONNECT_ID_TOKEN", "LDAP_BIND", "SESSION_COOKIE", "TEMPORARY_CREDENTIAL", "CUSTOM", "RESERVED_FOR_FUTURE"}, Default: "PASSWORD_HASH"},
 		{Name: "credential", Type: field.TypeString, Nullable: true, Comment: "凭证"},
 		{Name: "is_primary", Type: field.TypeBool, Nullable: true, Comment: "是否主认证方式", Default: false},
 		{Name: "status", Type: field.TypeEnum, Nullable: true, Comment: "凭证状态", Enums: []string{"DISABLED", "ENABLED", "EXPIRED", "UNVERIFIED", "REMOVED", "BLOCKED", "TEMPORARY"}, Default: "ENABLED"},
@@ -2591,6 +2963,8 @@
 		{Name: "reset_token_hash", Type: field.TypeString, Nullable: true, Size: 255, Comment: "重置密码令牌哈希（不要存明文）"},
 		{Name: "reset_token_expires_at", Type: field.TypeTime, Nullable: true, Comment: "重置令牌到期时间"},
 		{Name: "reset_token_used_at", Type: field.TypeTime, Nullable: true, Comment: "重置令牌使用时间"},
+		{Name: "credential_changed_at", Type: field.TypeTime, Nullable: true, Comment: "密码最近修改时间，用于密码有效期判定"},
+		{Name: "credential_history", Type: field.TypeJSON, Nullable: true, Comment: "最近使用过的密码哈希", SchemaType: map[string]string{"mysql": "json", "postgres": "jsonb"}},
 	}
 	// SysUserCredentialsTable holds the schema information for the "sys_user_credentials" table.
 	SysUserCredentialsTable = &schema.Table{
@@ -2639,6 +3013,16 @@
 				Unique:  false,
 				Columns: []*schema.Column{SysUserCredentialsColumns[4], SysUserCredentialsColumns[16]},
 			},
+			{
+				Name:    "idx_sys_user_cred_reset_token_hash",
+				Unique:  false,
+				Columns: []*schema.Column{SysUserCredentialsColumns[18]},
+			},
+			{
+				Name:    "idx_sys_user_cred_activate_token_hash",
+				Unique:  false,
+				Columns: []*schema.Column{SysUserCredentialsColumns[15]},
+			},
 		},
 	}
 	// SysUserMfaFactorsColumns holds the columns for the "sys_user_mfa_factors" table.
@@ -2649,11 +3033,19 @@
 		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
 		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
 		{Name: "user_id", Type: field.TypeUint32, Nullable: true, Comment: "关联主表的用户ID"},
-		{Name: "method", Type: field.TypeEnum, Nullable: true, Comment: "MFA 方法", Enums: []string{"TOTP", "SMS", "EMAIL", "WEBAUTHN"}, Default: "TOTP"},
+		{Name: "method", Type: field.TypeEnum, Nullable: true, Comment: "MFA 方法", Enums: []string{"TOTP", "SMS", "EMAIL", "WEBAUTHN", "BACKUP_CODE"}, Default: "TOTP"},
 		{Name: "secret_hash", Type: field.TypeString, Nullable: true, Size: 512, Comment: "MFA secret 密文（AES-GCM 加密，base64 编码；TOTP 校验需还原明文）"},
 		{Name: "display_name", Type: field.TypeString, Nullable: true, Size: 128, Comment: "设备/因子展示名（用户自定义）"},
 		{Name: "status", Type: field.TypeEnum, Nullable: true, Comment: "因子状态", Enums: []string{"DISABLED", "ENABLED"}, Default: "ENABLED"},
 		{Name: "last_used_at", Type: field.TypeTime, Nullable: true, Comment: "最近一次用于验证的时间"},
+		{Name: "credential_id", Type: field.TypeString, Size: 512, Comment: "WebAuthn 凭证ID（base64url）", Default: ""},
+		{Name: "public_key", Type: field.TypeBytes, Nullable: true, Comment: "WebAuthn 凭证公钥（COSE 编码）"},
+		{Name: "sign_count", Type: field.TypeUint32, Comment: "WebAuthn 签名计数器", Default: 0},
+		{Name: "aaguid", Type: field.TypeBytes, Nullable: true, Comment: "认证器型号标识（AAGUID）"},
+		{Name: "transports", Type: field.TypeJSON, Nullable: true, Comment: "认证器支持的传输方式（usb/nfc/ble/internal/hybrid）"},
+		{Name: "credential_flags", Type: field.TypeUint8, Comment: "注册时的认证器标志位（备份资格/备份状态等）", Default: 0},
+		{Name: "attestation_type", Type: field.TypeString, Nullable: true, Size: 64, Comment: "证明类型"},
+		{Name: "attestation_format", Type: field.TypeString, Nullable: true, Size: 64, Comment: "证明格式"},
 	}
 	// SysUserMfaFactorsTable holds the schema information for the "sys_user_mfa_factors" table.
 	SysUserMfaFactorsTable = &schema.Table{
@@ -2668,9 +3060,14 @@
 				Columns: []*schema.Column{SysUserMfaFactorsColumns[4], SysUserMfaFactorsColumns[5]},
 			},
 			{
-				Name:    "idx_sys_user_mfa_tenant_uid_method",
+				Name:    "idx_sys_user_mfa_tenant_uid_method_cred",
 				Unique:  true,
-				Columns: []*schema.Column{SysUserMfaFactorsColumns[4], SysUserMfaFactorsColumns[5], SysUserMfaFactorsColumns[6]},
+				Columns: []*schema.Column{SysUserMfaFactorsColumns[4], SysUserMfaFactorsColumns[5], SysUserMfaFactorsColumns[6], SysUserMfaFactorsColumns[11]},
+			},
+			{
+				Name:    "idx_sys_user_mfa_credential_id",
+				Unique:  false,
+				Columns: []*schema.Column{SysUserMfaFactorsColumns[11]},
 			},
 		},
 	}
@@ -2955,6 +3352,7 @@
 	Tables = []*schema.Table{
 		SysApisTable,
 		SysAPIAuditLogsTable,
+		SysAPIClientsTable,
 		SysDataAccessAuditLogsTable,
 		SysDictEntriesTable,
 		SysDictEntryI18nTable,
@@ -2963,7 +3361,9 @@
 		InternalMessagesTable,
 		InternalMessageCategoriesTable,
 		InternalMessageRecipientsTable,
+		SysJwtSigningKeysTable,
 		SysLanguagesTable,
+		SysLdapConfigsTable,
 		SysLoginAuditLogsTable,
 		SysLoginPoliciesTable,
 		SysMembershipsTable,
@@ -2971,8 +3371,11 @@
 		SysMembershipPositionsTable,
 		SysMembershipRolesTable,
 		SysMenusTable,
+		SysMfaPoliciesTable,
+		SysOauthProviderConfigsTable,
 		SysOperationAuditLogsTable,
 		SysOrgUnitsTable,
+		SysPasswordPoliciesTable,
 		SysPermissionsTable,
 		SysPermissionApisTable,
 		SysPermissionAuditLogsTable,
@@ -2984,9 +3387,13 @@
 		SysPlanQuotasTable,
 		SysPolicyEvaluationLogsTable,
 		SysPositionsTable,
+		SysRelationTuplesTable,
+		SysRelationTupleChangesTable,
 		SysRolesTable,
 		SysRoleMetadataTable,
 		SysRolePermissionsTable,
+		SysSamlConfigsTable,
+		SysScimTokensTable,
 		SysTasksTable,
 		SysTenantsTable,
 		SysUsersTable,
@@ -3009,6 +3416,11 @@ func init() {
 		Charset:   "utf8mb4",
 		Collation: "utf8mb4_bin",
 	}
+	SysAPIClientsTable.Annotation = &entsql.Annotation{
+		Table:     "sys_api_clients",
+		Charset:   "utf8mb4",
+		Collation: "utf8mb4_bin",
+	}
 	SysDataAccessAuditLogsTable.Annotation = &entsql.Annotation{
 		Table:     "sys_data_access_audit_logs",
 		Charset:   "utf8mb4",
@@ -3051,11 +3463,21 @@ func init() {
 		Charset:   "utf8mb4",
 		Collation: "utf8mb4_bin",
 	}
+	SysJwtSigningKeysTable.Annotation = &entsql.Annotation{
+		Table:     "sys_jwt_signing_keys",
+		Charset:   "utf8mb4",
+		Collation: "utf8mb4_bin",
+	}
 	SysLanguagesTable.Annotation = &entsql.Annotation{
 		Table:     "sys_languages",
 		Charset:   "utf8mb4",
 		Collation: "utf8mb4_bin",
 	}
+	SysLdapConfigsTable.Annotation = &entsql.Annotation{
+		Table:     "sys_ldap_configs",
+		Charset:   "utf8mb4",
+		Collation: "utf8mb4_bin",
+	}
 	SysLoginAuditLogsTable.Annotation = &entsql.Annotation{
 		Table:     "sys_login_audit_logs",
 		Charset:   "utf8mb4",
@@ -3092,6 +3514,16 @@ func init() {
 		Charset:   "utf8mb4",
 		Collation: "utf8mb4_bin",
 	}
+	SysMfaPoliciesTable.Annotation = &entsql.Annotation{
+		Table:     "sys_mfa_policies",
+		Charset:   "utf8mb4",
+		Collation: "utf8mb4_bin",
+	}
+	SysOauthProviderConfigsTable.Annotation = &entsql.Annotation{
+		Table:     "sys_oauth_provider_configs",
+		Charset:   "utf8mb4",
+		Collation: "utf8mb4_bin",
+	}
 	SysOperationAuditLogsTable.Annotation = &entsql.Annotation{
 		Table:     "sys_operation_audit_logs",
 		Charset:   "utf8mb4",
@@ -3103,6 +3535,11 @@ func init() {
 		Charset:   "utf8mb4",
 		Collation: "utf8mb4_bin",
 	}
+	SysPasswordPoliciesTable.Annotation = &entsql.Annotation{
+		Table:     "sys_password_policies",
+		Charset:   "utf8mb4",
+		Collation: "utf8mb4_bin",
+	}
 	SysPermissionsTable.Annotation = &entsql.Annotation{
 		Table:     "sys_permissions",
 		Charset:   "utf8mb4",
@@ -3161,6 +3598,16 @@ func init() {
 		Charset:   "utf8mb4",
 		Collation: "utf8mb4_bin",
 	}
+	SysRelationTuplesTable.Annotation = &entsql.Annotation{
+		Table:     "sys_relation_tuples",
+		Charset:   "utf8mb4",
+		Collation: "utf8mb4_bin",
+	}
+	SysRelationTupleChangesTable.Annotation = &entsql.Annotation{
+		Table:     "sys_relation_tuple_changes",
+		Charset:   "utf8mb4",
+		Collation: "utf8mb4_bin",
+	}
 	SysRolesTable.Annotation = &entsql.Annotation{
 		Table:     "sys_roles",
 		Charset:   "utf8mb4",
@@ -3176,6 +3623,16 @@ func init() {
 		Charset:   "utf8mb4",
 		Collation: "utf8mb4_bin",
 	}
+	SysSamlConfigsTable.Annotation = &entsql.Annotation{
+		Table:     "sys_saml_configs",
+		Charset:   "utf8mb4",
+		Collation: "utf8mb4_bin",
+	}
+	SysScimTokensTable.Annotation = &entsql.Annotation{
+		Table:     "sys_scim_tokens",
+		Charset:   "utf8mb4",
+		Collation: "utf8mb4_bin",
+	}
 	SysTasksTable.Annotation = &entsql.Annotation{
 		Table:     "sys_tasks",
 		Charset:   "utf8mb4",
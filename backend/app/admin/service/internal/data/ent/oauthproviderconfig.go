@@ -0,0 +1,387 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"go-wind-admin/app/admin/service/internal/data/ent/oauthproviderconfig"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// 第三方登录提供方配置表
+type OAuthProviderConfig struct {
+	config `json:"-"`
+	// ID of the ent.
+	// id
+	ID uint32 `json:"id,omitempty"`
+	// 创建时间
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	// 更新时间
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+	// 删除时间
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// 创建者ID
+	CreatedBy *uint32 `json:"created_by,omitempty"`
+	// 更新者ID
+	UpdatedBy *uint32 `json:"updated_by,omitempty"`
+	// 删除者ID
+	DeletedBy *uint32 `json:"deleted_by,omitempty"`
+	// 租户ID
+	TenantID *uint32 `json:"tenant_id,omitempty"`
+	// 状态
+	Status *oauthproviderconfig.Status `json:"status,omitempty"`
+	// 排序值（越小越靠前）
+	SortOrder *uint32 `json:"sort_order,omitempty"`
+	// 提供方
+	Provider *string `json:"provider,omitempty"`
+	// 自定义提供方标识
+	ProviderCustom string `json:"provider_custom,omitempty"`
+	// 登录页显示名称
+	DisplayName *string `json:"display_name,omitempty"`
+	// 提供方分配的客户端ID（微信为 AppID）
+	ClientID *string `json:"client_id,omitempty"`
+	// 提供方分配的客户端密钥（加密存储）
+	ClientSecret *string `json:"-"`
+	// 授权端点，为空使用提供方默认值
+	AuthURL *string `json:"auth_url,omitempty"`
+	// 令牌端点，为空使用提供方默认值
+	TokenURL *string `json:"token_url,omitempty"`
+	// 用户信息端点，为空使用提供方默认值
+	UserinfoURL *string `json:"userinfo_url,omitempty"`
+	// 在提供方登记的回调地址
+	RedirectURI *string `json:"redirect_uri,omitempty"`
+	// 授权范围，为空使用提供方默认值
+	Scopes []string `json:"scopes,omitempty"`
+	// 未绑定的第三方账号首次登录时是否自动创建用户
+	AutoProvision *bool `json:"auto_provision,omitempty"`
+	// 自动创建用户时授予的角色ID列表
+	DefaultRoleIds []uint32 `json:"default_role_ids,omitempty"`
+	selectValues   sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*OAuthProviderConfig) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case oauthproviderconfig.FieldScopes, oauthproviderconfig.FieldDefaultRoleIds:
+			values[i] = new([]byte)
+		case oauthproviderconfig.FieldAutoProvision:
+			values[i] = new(sql.NullBool)
+		case oauthproviderconfig.FieldID, oauthproviderconfig.FieldCreatedBy, oauthproviderconfig.FieldUpdatedBy, oauthproviderconfig.FieldDeletedBy, oauthproviderconfig.FieldTenantID, oauthproviderconfig.FieldSortOrder:
+			values[i] = new(sql.NullInt64)
+		case oauthproviderconfig.FieldStatus, oauthproviderconfig.FieldProvider, oauthproviderconfig.FieldProviderCustom, oauthproviderconfig.FieldDisplayName, oauthproviderconfig.FieldClientID, oauthproviderconfig.FieldClientSecret, oauthproviderconfig.FieldAuthURL, oauthproviderconfig.FieldTokenURL, oauthproviderconfig.FieldUserinfoURL, oauthproviderconfig.FieldRedirectURI:
+			values[i] = new(sql.NullString)
+		case oauthproviderconfig.FieldCreatedAt, oauthproviderconfig.FieldUpdatedAt, oauthproviderconfig.FieldDeletedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the OAuthProviderConfig fields.
+func (_m *OAuthProviderConfig) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case oauthproviderconfig.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = uint32(value.Int64)
+		case oauthproviderconfig.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = new(time.Time)
+				*_m.CreatedAt = value.Time
+			}
+		case oauthproviderconfig.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = new(time.Time)
+				*_m.UpdatedAt = value.Time
+			}
+		case oauthproviderconfig.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
+		case oauthproviderconfig.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				_m.CreatedBy = new(uint32)
+				*_m.CreatedBy = uint32(value.Int64)
+			}
+		case oauthproviderconfig.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				_m.UpdatedBy = new(uint32)
+				*_m.UpdatedBy = uint32(value.Int64)
+			}
+		case oauthproviderconfig.FieldDeletedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_by", values[i])
+			} else if value.Valid {
+				_m.DeletedBy = new(uint32)
+				*_m.DeletedBy = uint32(value.Int64)
+			}
+		case oauthproviderconfig.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = new(uint32)
+				*_m.TenantID = uint32(value.Int64)
+			}
+		case oauthproviderconfig.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = new(oauthproviderconfig.Status)
+				*_m.Status = oauthproviderconfig.Status(value.String)
+			}
+		case oauthproviderconfig.FieldSortOrder:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sort_order", values[i])
+			} else if value.Valid {
+				_m.SortOrder = new(uint32)
+				*_m.SortOrder = uint32(value.Int64)
+			}
+		case oauthproviderconfig.FieldProvider:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider", values[i])
+			} else if value.Valid {
+				_m.Provider = new(string)
+				*_m.Provider = value.String
+			}
+		case oauthproviderconfig.FieldProviderCustom:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider_custom", values[i])
+			} else if value.Valid {
+				_m.ProviderCustom = value.String
+			}
+		case oauthproviderconfig.FieldDisplayName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field display_name", values[i])
+			} else if value.Valid {
+				_m.DisplayName = new(string)
+				*_m.DisplayName = value.String
+			}
+		case oauthproviderconfig.FieldClientID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field client_id", values[i])
+			} else if value.Valid {
+				_m.ClientID = new(string)
+				*_m.ClientID = value.String
+			}
+		case oauthproviderconfig.FieldClientSecret:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field client_secret", values[i])
+			} else if value.Valid {
+				_m.ClientSecret = new(string)
+				*_m.ClientSecret = value.String
+			}
+		case oauthproviderconfig.FieldAuthURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field auth_url", values[i])
+			} else if value.Valid {
+				_m.AuthURL = new(string)
+				*_m.AuthURL = value.String
+			}
+		case oauthproviderconfig.FieldTokenURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token_url", values[i])
+			} else if value.Valid {
+				_m.TokenURL = new(string)
+				*_m.TokenURL = value.String
+			}
+		case oauthproviderconfig.FieldUserinfoURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field userinfo_url", values[i])
+			} else if value.Valid {
+				_m.UserinfoURL = new(string)
+				*_m.UserinfoURL = value.String
+			}
+		case oauthproviderconfig.FieldRedirectURI:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field redirect_uri", values[i])
+			} else if value.Valid {
+				_m.RedirectURI = new(string)
+				*_m.RedirectURI = value.String
+			}
+		case oauthproviderconfig.FieldScopes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field scopes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Scopes); err != nil {
+					return fmt.Errorf("unmarshal field scopes: %w", err)
+				}
+			}
+		case oauthproviderconfig.FieldAutoProvision:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field auto_provision", values[i])
+			} else if value.Valid {
+				_m.AutoProvision = new(bool)
+				*_m.AutoProvision = value.Bool
+			}
+		case oauthproviderconfig.FieldDefaultRoleIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field default_role_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.DefaultRoleIds); err != nil {
+					return fmt.Errorf("unmarshal field default_role_ids: %w", err)
+				}
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the OAuthProviderConfig.
+// This includes values selected through modifiers, order, etc.
+func (_m *OAuthProviderConfig) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this OAuthProviderConfig.
+// Note that you need to call OAuthProviderConfig.Unwrap() before calling this method if this OAuthProviderConfig
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *OAuthProviderConfig) Update() *OAuthProviderConfigUpdateOne {
+	return NewOAuthProviderConfigClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the OAuthProviderConfig entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *OAuthProviderConfig) Unwrap() *OAuthProviderConfig {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: OAuthProviderConfig is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *OAuthProviderConfig) String() string {
+	var builder strings.Builder
+	builder.WriteString("OAuthProviderConfig(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	if v := _m.CreatedAt; v != nil {
+		builder.WriteString("created_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.UpdatedAt; v != nil {
+		builder.WriteString("updated_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.CreatedBy; v != nil {
+		builder.WriteString("created_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.UpdatedBy; v != nil {
+		builder.WriteString("updated_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.DeletedBy; v != nil {
+		builder.WriteString("deleted_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.TenantID; v != nil {
+		builder.WriteString("tenant_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.Status; v != nil {
+		builder.WriteString("status=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.SortOrder; v != nil {
+		builder.WriteString("sort_order=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.Provider; v != nil {
+		builder.WriteString("provider=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("provider_custom=")
+	builder.WriteString(_m.ProviderCustom)
+	builder.WriteString(", ")
+	if v := _m.DisplayName; v != nil {
+		builder.WriteString("display_name=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.ClientID; v != nil {
+		builder.WriteString("client_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("client_secret=<sensitive>")
+	builder.WriteString(", ")
+	if v := _m.AuthURL; v != nil {
+		builder.WriteString("auth_url=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.TokenURL; v != nil {
+		builder.WriteString("token_url=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.UserinfoURL; v != nil {
+		builder.WriteString("userinfo_url=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.RedirectURI; v != nil {
+		builder.WriteString("redirect_uri=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("scopes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Scopes))
+	builder.WriteString(", ")
+	if v := _m.AutoProvision; v != nil {
+		builder.WriteString("auto_provision=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("default_role_ids=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DefaultRoleIds))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// OAuthProviderConfigs is a parsable slice of OAuthProviderConfig.
+type OAuthProviderConfigs []*OAuthProviderConfig
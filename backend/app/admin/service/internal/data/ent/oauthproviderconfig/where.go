@@ -0,0 +1,1285 @@
+// Code generated by ent, DO NOT EDIT.
+
+package oauthproviderconfig
+
+import (
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint32) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint32) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint32) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint32) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint32) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint32) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint32) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint32) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint32) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v uint32) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// UpdatedBy applies equality check predicate on the "updated_by" field. It's identical to UpdatedByEQ.
+func UpdatedBy(v uint32) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// DeletedBy applies equality check predicate on the "deleted_by" field. It's identical to DeletedByEQ.
+func DeletedBy(v uint32) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldEQ(FieldDeletedBy, v))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uint32) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldEQ(FieldTenantID, v))
+}
+
+// SortOrder applies equality check predicate on the "sort_order" field. It's identical to SortOrderEQ.
+func SortOrder(v uint32) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldEQ(FieldSortOrder, v))
+}
+
+// Provider applies equality check predicate on the "provider" field. It's identical to ProviderEQ.
+func Provider(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldEQ(FieldProvider, v))
+}
+
+// ProviderCustom applies equality check predicate on the "provider_custom" field. It's identical to ProviderCustomEQ.
+func ProviderCustom(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldEQ(FieldProviderCustom, v))
+}
+
+// DisplayName applies equality check predicate on the "display_name" field. It's identical to DisplayNameEQ.
+func DisplayName(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldEQ(FieldDisplayName, v))
+}
+
+// ClientID applies equality check predicate on the "client_id" field. It's identical to ClientIDEQ.
+func ClientID(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldEQ(FieldClientID, v))
+}
+
+// ClientSecret applies equality check predicate on the "client_secret" field. It's identical to ClientSecretEQ.
+func ClientSecret(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldEQ(FieldClientSecret, v))
+}
+
+// AuthURL applies equality check predicate on the "auth_url" field. It's identical to AuthURLEQ.
+func AuthURL(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldEQ(FieldAuthURL, v))
+}
+
+// TokenURL applies equality check predicate on the "token_url" field. It's identical to TokenURLEQ.
+func TokenURL(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldEQ(FieldTokenURL, v))
+}
+
+// UserinfoURL applies equality check predicate on the "userinfo_url" field. It's identical to UserinfoURLEQ.
+func UserinfoURL(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldEQ(FieldUserinfoURL, v))
+}
+
+// RedirectURI applies equality check predicate on the "redirect_uri" field. It's identical to RedirectURIEQ.
+func RedirectURI(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldEQ(FieldRedirectURI, v))
+}
+
+// AutoProvision applies equality check predicate on the "auto_provision" field. It's identical to AutoProvisionEQ.
+func AutoProvision(v bool) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldEQ(FieldAutoProvision, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// CreatedAtIsNil applies the IsNil predicate on the "created_at" field.
+func CreatedAtIsNil() predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldIsNull(FieldCreatedAt))
+}
+
+// CreatedAtNotNil applies the NotNil predicate on the "created_at" field.
+func CreatedAtNotNil() predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldNotNull(FieldCreatedAt))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIsNil applies the IsNil predicate on the "updated_at" field.
+func UpdatedAtIsNil() predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldIsNull(FieldUpdatedAt))
+}
+
+// UpdatedAtNotNil applies the NotNil predicate on the "updated_at" field.
+func UpdatedAtNotNil() predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldNotNull(FieldUpdatedAt))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v uint32) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v uint32) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...uint32) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...uint32) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v uint32) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v uint32) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v uint32) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v uint32) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByIsNil applies the IsNil predicate on the "created_by" field.
+func CreatedByIsNil() predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldIsNull(FieldCreatedBy))
+}
+
+// CreatedByNotNil applies the NotNil predicate on the "created_by" field.
+func CreatedByNotNil() predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldNotNull(FieldCreatedBy))
+}
+
+// UpdatedByEQ applies the EQ predicate on the "updated_by" field.
+func UpdatedByEQ(v uint32) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByNEQ applies the NEQ predicate on the "updated_by" field.
+func UpdatedByNEQ(v uint32) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldNEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByIn applies the In predicate on the "updated_by" field.
+func UpdatedByIn(vs ...uint32) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByNotIn applies the NotIn predicate on the "updated_by" field.
+func UpdatedByNotIn(vs ...uint32) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldNotIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByGT applies the GT predicate on the "updated_by" field.
+func UpdatedByGT(v uint32) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldGT(FieldUpdatedBy, v))
+}
+
+// UpdatedByGTE applies the GTE predicate on the "updated_by" field.
+func UpdatedByGTE(v uint32) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldGTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByLT applies the LT predicate on the "updated_by" field.
+func UpdatedByLT(v uint32) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldLT(FieldUpdatedBy, v))
+}
+
+// UpdatedByLTE applies the LTE predicate on the "updated_by" field.
+func UpdatedByLTE(v uint32) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldLTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByIsNil applies the IsNil predicate on the "updated_by" field.
+func UpdatedByIsNil() predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldIsNull(FieldUpdatedBy))
+}
+
+// UpdatedByNotNil applies the NotNil predicate on the "updated_by" field.
+func UpdatedByNotNil() predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldNotNull(FieldUpdatedBy))
+}
+
+// DeletedByEQ applies the EQ predicate on the "deleted_by" field.
+func DeletedByEQ(v uint32) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldEQ(FieldDeletedBy, v))
+}
+
+// DeletedByNEQ applies the NEQ predicate on the "deleted_by" field.
+func DeletedByNEQ(v uint32) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldNEQ(FieldDeletedBy, v))
+}
+
+// DeletedByIn applies the In predicate on the "deleted_by" field.
+func DeletedByIn(vs ...uint32) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldIn(FieldDeletedBy, vs...))
+}
+
+// DeletedByNotIn applies the NotIn predicate on the "deleted_by" field.
+func DeletedByNotIn(vs ...uint32) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldNotIn(FieldDeletedBy, vs...))
+}
+
+// DeletedByGT applies the GT predicate on the "deleted_by" field.
+func DeletedByGT(v uint32) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldGT(FieldDeletedBy, v))
+}
+
+// DeletedByGTE applies the GTE predicate on the "deleted_by" field.
+func DeletedByGTE(v uint32) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldGTE(FieldDeletedBy, v))
+}
+
+// DeletedByLT applies the LT predicate on the "deleted_by" field.
+func DeletedByLT(v uint32) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldLT(FieldDeletedBy, v))
+}
+
+// DeletedByLTE applies the LTE predicate on the "deleted_by" field.
+func DeletedByLTE(v uint32) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldLTE(FieldDeletedBy, v))
+}
+
+// DeletedByIsNil applies the IsNil predicate on the "deleted_by" field.
+func DeletedByIsNil() predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldIsNull(FieldDeletedBy))
+}
+
+// DeletedByNotNil applies the NotNil predicate on the "deleted_by" field.
+func DeletedByNotNil() predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldNotNull(FieldDeletedBy))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uint32) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uint32) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uint32) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uint32) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uint32) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uint32) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uint32) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uint32) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDIsNil applies the IsNil predicate on the "tenant_id" field.
+func TenantIDIsNil() predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldIsNull(FieldTenantID))
+}
+
+// TenantIDNotNil applies the NotNil predicate on the "tenant_id" field.
+func TenantIDNotNil() predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldNotNull(FieldTenantID))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// SortOrderEQ applies the EQ predicate on the "sort_order" field.
+func SortOrderEQ(v uint32) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldEQ(FieldSortOrder, v))
+}
+
+// SortOrderNEQ applies the NEQ predicate on the "sort_order" field.
+func SortOrderNEQ(v uint32) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldNEQ(FieldSortOrder, v))
+}
+
+// SortOrderIn applies the In predicate on the "sort_order" field.
+func SortOrderIn(vs ...uint32) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldIn(FieldSortOrder, vs...))
+}
+
+// SortOrderNotIn applies the NotIn predicate on the "sort_order" field.
+func SortOrderNotIn(vs ...uint32) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldNotIn(FieldSortOrder, vs...))
+}
+
+// SortOrderGT applies the GT predicate on the "sort_order" field.
+func SortOrderGT(v uint32) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldGT(FieldSortOrder, v))
+}
+
+// SortOrderGTE applies the GTE predicate on the "sort_order" field.
+func SortOrderGTE(v uint32) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldGTE(FieldSortOrder, v))
+}
+
+// SortOrderLT applies the LT predicate on the "sort_order" field.
+func SortOrderLT(v uint32) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldLT(FieldSortOrder, v))
+}
+
+// SortOrderLTE applies the LTE predicate on the "sort_order" field.
+func SortOrderLTE(v uint32) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldLTE(FieldSortOrder, v))
+}
+
+// SortOrderIsNil applies the IsNil predicate on the "sort_order" field.
+func SortOrderIsNil() predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldIsNull(FieldSortOrder))
+}
+
+// SortOrderNotNil applies the NotNil predicate on the "sort_order" field.
+func SortOrderNotNil() predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldNotNull(FieldSortOrder))
+}
+
+// ProviderEQ applies the EQ predicate on the "provider" field.
+func ProviderEQ(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldEQ(FieldProvider, v))
+}
+
+// ProviderNEQ applies the NEQ predicate on the "provider" field.
+func ProviderNEQ(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldNEQ(FieldProvider, v))
+}
+
+// ProviderIn applies the In predicate on the "provider" field.
+func ProviderIn(vs ...string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldIn(FieldProvider, vs...))
+}
+
+// ProviderNotIn applies the NotIn predicate on the "provider" field.
+func ProviderNotIn(vs ...string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldNotIn(FieldProvider, vs...))
+}
+
+// ProviderGT applies the GT predicate on the "provider" field.
+func ProviderGT(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldGT(FieldProvider, v))
+}
+
+// ProviderGTE applies the GTE predicate on the "provider" field.
+func ProviderGTE(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldGTE(FieldProvider, v))
+}
+
+// ProviderLT applies the LT predicate on the "provider" field.
+func ProviderLT(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldLT(FieldProvider, v))
+}
+
+// ProviderLTE applies the LTE predicate on the "provider" field.
+func ProviderLTE(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldLTE(FieldProvider, v))
+}
+
+// ProviderContains applies the Contains predicate on the "provider" field.
+func ProviderContains(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldContains(FieldProvider, v))
+}
+
+// ProviderHasPrefix applies the HasPrefix predicate on the "provider" field.
+func ProviderHasPrefix(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldHasPrefix(FieldProvider, v))
+}
+
+// ProviderHasSuffix applies the HasSuffix predicate on the "provider" field.
+func ProviderHasSuffix(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldHasSuffix(FieldProvider, v))
+}
+
+// ProviderIsNil applies the IsNil predicate on the "provider" field.
+func ProviderIsNil() predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldIsNull(FieldProvider))
+}
+
+// ProviderNotNil applies the NotNil predicate on the "provider" field.
+func ProviderNotNil() predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldNotNull(FieldProvider))
+}
+
+// ProviderEqualFold applies the EqualFold predicate on the "provider" field.
+func ProviderEqualFold(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldEqualFold(FieldProvider, v))
+}
+
+// ProviderContainsFold applies the ContainsFold predicate on the "provider" field.
+func ProviderContainsFold(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldContainsFold(FieldProvider, v))
+}
+
+// ProviderCustomEQ applies the EQ predicate on the "provider_custom" field.
+func ProviderCustomEQ(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldEQ(FieldProviderCustom, v))
+}
+
+// ProviderCustomNEQ applies the NEQ predicate on the "provider_custom" field.
+func ProviderCustomNEQ(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldNEQ(FieldProviderCustom, v))
+}
+
+// ProviderCustomIn applies the In predicate on the "provider_custom" field.
+func ProviderCustomIn(vs ...string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldIn(FieldProviderCustom, vs...))
+}
+
+// ProviderCustomNotIn applies the NotIn predicate on the "provider_custom" field.
+func ProviderCustomNotIn(vs ...string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldNotIn(FieldProviderCustom, vs...))
+}
+
+// ProviderCustomGT applies the GT predicate on the "provider_custom" field.
+func ProviderCustomGT(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldGT(FieldProviderCustom, v))
+}
+
+// ProviderCustomGTE applies the GTE predicate on the "provider_custom" field.
+func ProviderCustomGTE(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldGTE(FieldProviderCustom, v))
+}
+
+// ProviderCustomLT applies the LT predicate on the "provider_custom" field.
+func ProviderCustomLT(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldLT(FieldProviderCustom, v))
+}
+
+// ProviderCustomLTE applies the LTE predicate on the "provider_custom" field.
+func ProviderCustomLTE(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldLTE(FieldProviderCustom, v))
+}
+
+// ProviderCustomContains applies the Contains predicate on the "provider_custom" field.
+func ProviderCustomContains(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldContains(FieldProviderCustom, v))
+}
+
+// ProviderCustomHasPrefix applies the HasPrefix predicate on the "provider_custom" field.
+func ProviderCustomHasPrefix(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldHasPrefix(FieldProviderCustom, v))
+}
+
+// ProviderCustomHasSuffix applies the HasSuffix predicate on the "provider_custom" field.
+func ProviderCustomHasSuffix(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldHasSuffix(FieldProviderCustom, v))
+}
+
+// ProviderCustomEqualFold applies the EqualFold predicate on the "provider_custom" field.
+func ProviderCustomEqualFold(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldEqualFold(FieldProviderCustom, v))
+}
+
+// ProviderCustomContainsFold applies the ContainsFold predicate on the "provider_custom" field.
+func ProviderCustomContainsFold(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldContainsFold(FieldProviderCustom, v))
+}
+
+// DisplayNameEQ applies the EQ predicate on the "display_name" field.
+func DisplayNameEQ(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldEQ(FieldDisplayName, v))
+}
+
+// DisplayNameNEQ applies the NEQ predicate on the "display_name" field.
+func DisplayNameNEQ(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldNEQ(FieldDisplayName, v))
+}
+
+// DisplayNameIn applies the In predicate on the "display_name" field.
+func DisplayNameIn(vs ...string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldIn(FieldDisplayName, vs...))
+}
+
+// DisplayNameNotIn applies the NotIn predicate on the "display_name" field.
+func DisplayNameNotIn(vs ...string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldNotIn(FieldDisplayName, vs...))
+}
+
+// DisplayNameGT applies the GT predicate on the "display_name" field.
+func DisplayNameGT(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldGT(FieldDisplayName, v))
+}
+
+// DisplayNameGTE applies the GTE predicate on the "display_name" field.
+func DisplayNameGTE(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldGTE(FieldDisplayName, v))
+}
+
+// DisplayNameLT applies the LT predicate on the "display_name" field.
+func DisplayNameLT(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldLT(FieldDisplayName, v))
+}
+
+// DisplayNameLTE applies the LTE predicate on the "display_name" field.
+func DisplayNameLTE(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldLTE(FieldDisplayName, v))
+}
+
+// DisplayNameContains applies the Contains predicate on the "display_name" field.
+func DisplayNameContains(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldContains(FieldDisplayName, v))
+}
+
+// DisplayNameHasPrefix applies the HasPrefix predicate on the "display_name" field.
+func DisplayNameHasPrefix(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldHasPrefix(FieldDisplayName, v))
+}
+
+// DisplayNameHasSuffix applies the HasSuffix predicate on the "display_name" field.
+func DisplayNameHasSuffix(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldHasSuffix(FieldDisplayName, v))
+}
+
+// DisplayNameIsNil applies the IsNil predicate on the "display_name" field.
+func DisplayNameIsNil() predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldIsNull(FieldDisplayName))
+}
+
+// DisplayNameNotNil applies the NotNil predicate on the "display_name" field.
+func DisplayNameNotNil() predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldNotNull(FieldDisplayName))
+}
+
+// DisplayNameEqualFold applies the EqualFold predicate on the "display_name" field.
+func DisplayNameEqualFold(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldEqualFold(FieldDisplayName, v))
+}
+
+// DisplayNameContainsFold applies the ContainsFold predicate on the "display_name" field.
+func DisplayNameContainsFold(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldContainsFold(FieldDisplayName, v))
+}
+
+// ClientIDEQ applies the EQ predicate on the "client_id" field.
+func ClientIDEQ(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldEQ(FieldClientID, v))
+}
+
+// ClientIDNEQ applies the NEQ predicate on the "client_id" field.
+func ClientIDNEQ(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldNEQ(FieldClientID, v))
+}
+
+// ClientIDIn applies the In predicate on the "client_id" field.
+func ClientIDIn(vs ...string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldIn(FieldClientID, vs...))
+}
+
+// ClientIDNotIn applies the NotIn predicate on the "client_id" field.
+func ClientIDNotIn(vs ...string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldNotIn(FieldClientID, vs...))
+}
+
+// ClientIDGT applies the GT predicate on the "client_id" field.
+func ClientIDGT(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldGT(FieldClientID, v))
+}
+
+// ClientIDGTE applies the GTE predicate on the "client_id" field.
+func ClientIDGTE(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldGTE(FieldClientID, v))
+}
+
+// ClientIDLT applies the LT predicate on the "client_id" field.
+func ClientIDLT(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldLT(FieldClientID, v))
+}
+
+// ClientIDLTE applies the LTE predicate on the "client_id" field.
+func ClientIDLTE(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldLTE(FieldClientID, v))
+}
+
+// ClientIDContains applies the Contains predicate on the "client_id" field.
+func ClientIDContains(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldContains(FieldClientID, v))
+}
+
+// ClientIDHasPrefix applies the HasPrefix predicate on the "client_id" field.
+func ClientIDHasPrefix(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldHasPrefix(FieldClientID, v))
+}
+
+// ClientIDHasSuffix applies the HasSuffix predicate on the "client_id" field.
+func ClientIDHasSuffix(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldHasSuffix(FieldClientID, v))
+}
+
+// ClientIDIsNil applies the IsNil predicate on the "client_id" field.
+func ClientIDIsNil() predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldIsNull(FieldClientID))
+}
+
+// ClientIDNotNil applies the NotNil predicate on the "client_id" field.
+func ClientIDNotNil() predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldNotNull(FieldClientID))
+}
+
+// ClientIDEqualFold applies the EqualFold predicate on the "client_id" field.
+func ClientIDEqualFold(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldEqualFold(FieldClientID, v))
+}
+
+// ClientIDContainsFold applies the ContainsFold predicate on the "client_id" field.
+func ClientIDContainsFold(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldContainsFold(FieldClientID, v))
+}
+
+// ClientSecretEQ applies the EQ predicate on the "client_secret" field.
+func ClientSecretEQ(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldEQ(FieldClientSecret, v))
+}
+
+// ClientSecretNEQ applies the NEQ predicate on the "client_secret" field.
+func ClientSecretNEQ(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldNEQ(FieldClientSecret, v))
+}
+
+// ClientSecretIn applies the In predicate on the "client_secret" field.
+func ClientSecretIn(vs ...string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldIn(FieldClientSecret, vs...))
+}
+
+// ClientSecretNotIn applies the NotIn predicate on the "client_secret" field.
+func ClientSecretNotIn(vs ...string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldNotIn(FieldClientSecret, vs...))
+}
+
+// ClientSecretGT applies the GT predicate on the "client_secret" field.
+func ClientSecretGT(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldGT(FieldClientSecret, v))
+}
+
+// ClientSecretGTE applies the GTE predicate on the "client_secret" field.
+func ClientSecretGTE(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldGTE(FieldClientSecret, v))
+}
+
+// ClientSecretLT applies the LT predicate on the "client_secret" field.
+func ClientSecretLT(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldLT(FieldClientSecret, v))
+}
+
+// ClientSecretLTE applies the LTE predicate on the "client_secret" field.
+func ClientSecretLTE(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldLTE(FieldClientSecret, v))
+}
+
+// ClientSecretContains applies the Contains predicate on the "client_secret" field.
+func ClientSecretContains(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldContains(FieldClientSecret, v))
+}
+
+// ClientSecretHasPrefix applies the HasPrefix predicate on the "client_secret" field.
+func ClientSecretHasPrefix(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldHasPrefix(FieldClientSecret, v))
+}
+
+// ClientSecretHasSuffix applies the HasSuffix predicate on the "client_secret" field.
+func ClientSecretHasSuffix(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldHasSuffix(FieldClientSecret, v))
+}
+
+// ClientSecretIsNil applies the IsNil predicate on the "client_secret" field.
+func ClientSecretIsNil() predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldIsNull(FieldClientSecret))
+}
+
+// ClientSecretNotNil applies the NotNil predicate on the "client_secret" field.
+func ClientSecretNotNil() predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldNotNull(FieldClientSecret))
+}
+
+// ClientSecretEqualFold applies the EqualFold predicate on the "client_secret" field.
+func ClientSecretEqualFold(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldEqualFold(FieldClientSecret, v))
+}
+
+// ClientSecretContainsFold applies the ContainsFold predicate on the "client_secret" field.
+func ClientSecretContainsFold(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldContainsFold(FieldClientSecret, v))
+}
+
+// AuthURLEQ applies the EQ predicate on the "auth_url" field.
+func AuthURLEQ(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldEQ(FieldAuthURL, v))
+}
+
+// AuthURLNEQ applies the NEQ predicate on the "auth_url" field.
+func AuthURLNEQ(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldNEQ(FieldAuthURL, v))
+}
+
+// AuthURLIn applies the In predicate on the "auth_url" field.
+func AuthURLIn(vs ...string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldIn(FieldAuthURL, vs...))
+}
+
+// AuthURLNotIn applies the NotIn predicate on the "auth_url" field.
+func AuthURLNotIn(vs ...string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldNotIn(FieldAuthURL, vs...))
+}
+
+// AuthURLGT applies the GT predicate on the "auth_url" field.
+func AuthURLGT(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldGT(FieldAuthURL, v))
+}
+
+// AuthURLGTE applies the GTE predicate on the "auth_url" field.
+func AuthURLGTE(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldGTE(FieldAuthURL, v))
+}
+
+// AuthURLLT applies the LT predicate on the "auth_url" field.
+func AuthURLLT(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldLT(FieldAuthURL, v))
+}
+
+// AuthURLLTE applies the LTE predicate on the "auth_url" field.
+func AuthURLLTE(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldLTE(FieldAuthURL, v))
+}
+
+// AuthURLContains applies the Contains predicate on the "auth_url" field.
+func AuthURLContains(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldContains(FieldAuthURL, v))
+}
+
+// AuthURLHasPrefix applies the HasPrefix predicate on the "auth_url" field.
+func AuthURLHasPrefix(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldHasPrefix(FieldAuthURL, v))
+}
+
+// AuthURLHasSuffix applies the HasSuffix predicate on the "auth_url" field.
+func AuthURLHasSuffix(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldHasSuffix(FieldAuthURL, v))
+}
+
+// AuthURLIsNil applies the IsNil predicate on the "auth_url" field.
+func AuthURLIsNil() predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldIsNull(FieldAuthURL))
+}
+
+// AuthURLNotNil applies the NotNil predicate on the "auth_url" field.
+func AuthURLNotNil() predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldNotNull(FieldAuthURL))
+}
+
+// AuthURLEqualFold applies the EqualFold predicate on the "auth_url" field.
+func AuthURLEqualFold(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldEqualFold(FieldAuthURL, v))
+}
+
+// AuthURLContainsFold applies the ContainsFold predicate on the "auth_url" field.
+func AuthURLContainsFold(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldContainsFold(FieldAuthURL, v))
+}
+
+// TokenURLEQ applies the EQ predicate on the "token_url" field.
+func TokenURLEQ(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldEQ(FieldTokenURL, v))
+}
+
+// TokenURLNEQ applies the NEQ predicate on the "token_url" field.
+func TokenURLNEQ(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldNEQ(FieldTokenURL, v))
+}
+
+// TokenURLIn applies the In predicate on the "token_url" field.
+func TokenURLIn(vs ...string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldIn(FieldTokenURL, vs...))
+}
+
+// TokenURLNotIn applies the NotIn predicate on the "token_url" field.
+func TokenURLNotIn(vs ...string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldNotIn(FieldTokenURL, vs...))
+}
+
+// TokenURLGT applies the GT predicate on the "token_url" field.
+func TokenURLGT(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldGT(FieldTokenURL, v))
+}
+
+// TokenURLGTE applies the GTE predicate on the "token_url" field.
+func TokenURLGTE(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldGTE(FieldTokenURL, v))
+}
+
+// TokenURLLT applies the LT predicate on the "token_url" field.
+func TokenURLLT(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldLT(FieldTokenURL, v))
+}
+
+// TokenURLLTE applies the LTE predicate on the "token_url" field.
+func TokenURLLTE(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldLTE(FieldTokenURL, v))
+}
+
+// TokenURLContains applies the Contains predicate on the "token_url" field.
+func TokenURLContains(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldContains(FieldTokenURL, v))
+}
+
+// TokenURLHasPrefix applies the HasPrefix predicate on the "token_url" field.
+func TokenURLHasPrefix(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldHasPrefix(FieldTokenURL, v))
+}
+
+// TokenURLHasSuffix applies the HasSuffix predicate on the "token_url" field.
+func TokenURLHasSuffix(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldHasSuffix(FieldTokenURL, v))
+}
+
+// TokenURLIsNil applies the IsNil predicate on the "token_url" field.
+func TokenURLIsNil() predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldIsNull(FieldTokenURL))
+}
+
+// TokenURLNotNil applies the NotNil predicate on the "token_url" field.
+func TokenURLNotNil() predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldNotNull(FieldTokenURL))
+}
+
+// TokenURLEqualFold applies the EqualFold predicate on the "token_url" field.
+func TokenURLEqualFold(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldEqualFold(FieldTokenURL, v))
+}
+
+// TokenURLContainsFold applies the ContainsFold predicate on the "token_url" field.
+func TokenURLContainsFold(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldContainsFold(FieldTokenURL, v))
+}
+
+// UserinfoURLEQ applies the EQ predicate on the "userinfo_url" field.
+func UserinfoURLEQ(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldEQ(FieldUserinfoURL, v))
+}
+
+// UserinfoURLNEQ applies the NEQ predicate on the "userinfo_url" field.
+func UserinfoURLNEQ(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldNEQ(FieldUserinfoURL, v))
+}
+
+// UserinfoURLIn applies the In predicate on the "userinfo_url" field.
+func UserinfoURLIn(vs ...string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldIn(FieldUserinfoURL, vs...))
+}
+
+// UserinfoURLNotIn applies the NotIn predicate on the "userinfo_url" field.
+func UserinfoURLNotIn(vs ...string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldNotIn(FieldUserinfoURL, vs...))
+}
+
+// UserinfoURLGT applies the GT predicate on the "userinfo_url" field.
+func UserinfoURLGT(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldGT(FieldUserinfoURL, v))
+}
+
+// UserinfoURLGTE applies the GTE predicate on the "userinfo_url" field.
+func UserinfoURLGTE(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldGTE(FieldUserinfoURL, v))
+}
+
+// UserinfoURLLT applies the LT predicate on the "userinfo_url" field.
+func UserinfoURLLT(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldLT(FieldUserinfoURL, v))
+}
+
+// UserinfoURLLTE applies the LTE predicate on the "userinfo_url" field.
+func UserinfoURLLTE(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldLTE(FieldUserinfoURL, v))
+}
+
+// UserinfoURLContains applies the Contains predicate on the "userinfo_url" field.
+func UserinfoURLContains(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldContains(FieldUserinfoURL, v))
+}
+
+// UserinfoURLHasPrefix applies the HasPrefix predicate on the "userinfo_url" field.
+func UserinfoURLHasPrefix(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldHasPrefix(FieldUserinfoURL, v))
+}
+
+// UserinfoURLHasSuffix applies the HasSuffix predicate on the "userinfo_url" field.
+func UserinfoURLHasSuffix(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldHasSuffix(FieldUserinfoURL, v))
+}
+
+// UserinfoURLIsNil applies the IsNil predicate on the "userinfo_url" field.
+func UserinfoURLIsNil() predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldIsNull(FieldUserinfoURL))
+}
+
+// UserinfoURLNotNil applies the NotNil predicate on the "userinfo_url" field.
+func UserinfoURLNotNil() predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldNotNull(FieldUserinfoURL))
+}
+
+// UserinfoURLEqualFold applies the EqualFold predicate on the "userinfo_url" field.
+func UserinfoURLEqualFold(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldEqualFold(FieldUserinfoURL, v))
+}
+
+// UserinfoURLContainsFold applies the ContainsFold predicate on the "userinfo_url" field.
+func UserinfoURLContainsFold(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldContainsFold(FieldUserinfoURL, v))
+}
+
+// RedirectURIEQ applies the EQ predicate on the "redirect_uri" field.
+func RedirectURIEQ(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldEQ(FieldRedirectURI, v))
+}
+
+// RedirectURINEQ applies the NEQ predicate on the "redirect_uri" field.
+func RedirectURINEQ(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldNEQ(FieldRedirectURI, v))
+}
+
+// RedirectURIIn applies the In predicate on the "redirect_uri" field.
+func RedirectURIIn(vs ...string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldIn(FieldRedirectURI, vs...))
+}
+
+// RedirectURINotIn applies the NotIn predicate on the "redirect_uri" field.
+func RedirectURINotIn(vs ...string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldNotIn(FieldRedirectURI, vs...))
+}
+
+// RedirectURIGT applies the GT predicate on the "redirect_uri" field.
+func RedirectURIGT(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldGT(FieldRedirectURI, v))
+}
+
+// RedirectURIGTE applies the GTE predicate on the "redirect_uri" field.
+func RedirectURIGTE(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldGTE(FieldRedirectURI, v))
+}
+
+// RedirectURILT applies the LT predicate on the "redirect_uri" field.
+func RedirectURILT(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldLT(FieldRedirectURI, v))
+}
+
+// RedirectURILTE applies the LTE predicate on the "redirect_uri" field.
+func RedirectURILTE(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldLTE(FieldRedirectURI, v))
+}
+
+// RedirectURIContains applies the Contains predicate on the "redirect_uri" field.
+func RedirectURIContains(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldContains(FieldRedirectURI, v))
+}
+
+// RedirectURIHasPrefix applies the HasPrefix predicate on the "redirect_uri" field.
+func RedirectURIHasPrefix(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldHasPrefix(FieldRedirectURI, v))
+}
+
+// RedirectURIHasSuffix applies the HasSuffix predicate on the "redirect_uri" field.
+func RedirectURIHasSuffix(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldHasSuffix(FieldRedirectURI, v))
+}
+
+// RedirectURIIsNil applies the IsNil predicate on the "redirect_uri" field.
+func RedirectURIIsNil() predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldIsNull(FieldRedirectURI))
+}
+
+// RedirectURINotNil applies the NotNil predicate on the "redirect_uri" field.
+func RedirectURINotNil() predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldNotNull(FieldRedirectURI))
+}
+
+// RedirectURIEqualFold applies the EqualFold predicate on the "redirect_uri" field.
+func RedirectURIEqualFold(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldEqualFold(FieldRedirectURI, v))
+}
+
+// RedirectURIContainsFold applies the ContainsFold predicate on the "redirect_uri" field.
+func RedirectURIContainsFold(v string) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldContainsFold(FieldRedirectURI, v))
+}
+
+// ScopesIsNil applies the IsNil predicate on the "scopes" field.
+func ScopesIsNil() predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldIsNull(FieldScopes))
+}
+
+// ScopesNotNil applies the NotNil predicate on the "scopes" field.
+func ScopesNotNil() predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldNotNull(FieldScopes))
+}
+
+// AutoProvisionEQ applies the EQ predicate on the "auto_provision" field.
+func AutoProvisionEQ(v bool) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldEQ(FieldAutoProvision, v))
+}
+
+// AutoProvisionNEQ applies the NEQ predicate on the "auto_provision" field.
+func AutoProvisionNEQ(v bool) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldNEQ(FieldAutoProvision, v))
+}
+
+// AutoProvisionIsNil applies the IsNil predicate on the "auto_provision" field.
+func AutoProvisionIsNil() predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldIsNull(FieldAutoProvision))
+}
+
+// AutoProvisionNotNil applies the NotNil predicate on the "auto_provision" field.
+func AutoProvisionNotNil() predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldNotNull(FieldAutoProvision))
+}
+
+// DefaultRoleIdsIsNil applies the IsNil predicate on the "default_role_ids" field.
+func DefaultRoleIdsIsNil() predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldIsNull(FieldDefaultRoleIds))
+}
+
+// DefaultRoleIdsNotNil applies the NotNil predicate on the "default_role_ids" field.
+func DefaultRoleIdsNotNil() predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.FieldNotNull(FieldDefaultRoleIds))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.OAuthProviderConfig) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.OAuthProviderConfig) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.OAuthProviderConfig) predicate.OAuthProviderConfig {
+	return predicate.OAuthProviderConfig(sql.NotPredicates(p))
+}
@@ -0,0 +1,1988 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-wind-admin/app/admin/service/internal/data/ent/oauthproviderconfig"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// OAuthProviderConfigCreate is the builder for creating a OAuthProviderConfig entity.
+type OAuthProviderConfigCreate struct {
+	config
+	mutation *OAuthProviderConfigMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *OAuthProviderConfigCreate) SetCreatedAt(v time.Time) *OAuthProviderConfigCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *OAuthProviderConfigCreate) SetNillableCreatedAt(v *time.Time) *OAuthProviderConfigCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *OAuthProviderConfigCreate) SetUpdatedAt(v time.Time) *OAuthProviderConfigCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *OAuthProviderConfigCreate) SetNillableUpdatedAt(v *time.Time) *OAuthProviderConfigCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *OAuthProviderConfigCreate) SetDeletedAt(v time.Time) *OAuthProviderConfigCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *OAuthProviderConfigCreate) SetNillableDeletedAt(v *time.Time) *OAuthProviderConfigCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *OAuthProviderConfigCreate) SetCreatedBy(v uint32) *OAuthProviderConfigCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *OAuthProviderConfigCreate) SetNillableCreatedBy(v *uint32) *OAuthProviderConfigCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_c *OAuthProviderConfigCreate) SetUpdatedBy(v uint32) *OAuthProviderConfigCreate {
+	_c.mutation.SetUpdatedBy(v)
+	return _c
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_c *OAuthProviderConfigCreate) SetNillableUpdatedBy(v *uint32) *OAuthProviderConfigCreate {
+	if v != nil {
+		_c.SetUpdatedBy(*v)
+	}
+	return _c
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_c *OAuthProviderConfigCreate) SetDeletedBy(v uint32) *OAuthProviderConfigCreate {
+	_c.mutation.SetDeletedBy(v)
+	return _c
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_c *OAuthProviderConfigCreate) SetNillableDeletedBy(v *uint32) *OAuthProviderConfigCreate {
+	if v != nil {
+		_c.SetDeletedBy(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *OAuthProviderConfigCreate) SetTenantID(v uint32) *OAuthProviderConfigCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *OAuthProviderConfigCreate) SetNillableTenantID(v *uint32) *OAuthProviderConfigCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *OAuthProviderConfigCreate) SetStatus(v oauthproviderconfig.Status) *OAuthProviderConfigCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *OAuthProviderConfigCreate) SetNillableStatus(v *oauthproviderconfig.Status) *OAuthProviderConfigCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (_c *OAuthProviderConfigCreate) SetSortOrder(v uint32) *OAuthProviderConfigCreate {
+	_c.mutation.SetSortOrder(v)
+	return _c
+}
+
+// SetNillableSortOrder sets the "sort_order" field if the given value is not nil.
+func (_c *OAuthProviderConfigCreate) SetNillableSortOrder(v *uint32) *OAuthProviderConfigCreate {
+	if v != nil {
+		_c.SetSortOrder(*v)
+	}
+	return _c
+}
+
+// SetProvider sets the "provider" field.
+func (_c *OAuthProviderConfigCreate) SetProvider(v string) *OAuthProviderConfigCreate {
+	_c.mutation.SetProvider(v)
+	return _c
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (_c *OAuthProviderConfigCreate) SetNillableProvider(v *string) *OAuthProviderConfigCreate {
+	if v != nil {
+		_c.SetProvider(*v)
+	}
+	return _c
+}
+
+// SetProviderCustom sets the "provider_custom" field.
+func (_c *OAuthProviderConfigCreate) SetProviderCustom(v string) *OAuthProviderConfigCreate {
+	_c.mutation.SetProviderCustom(v)
+	return _c
+}
+
+// SetNillableProviderCustom sets the "provider_custom" field if the given value is not nil.
+func (_c *OAuthProviderConfigCreate) SetNillableProviderCustom(v *string) *OAuthProviderConfigCreate {
+	if v != nil {
+		_c.SetProviderCustom(*v)
+	}
+	return _c
+}
+
+// SetDisplayName sets the "display_name" field.
+func (_c *OAuthProviderConfigCreate) SetDisplayName(v string) *OAuthProviderConfigCreate {
+	_c.mutation.SetDisplayName(v)
+	return _c
+}
+
+// SetNillableDisplayName sets the "display_name" field if the given value is not nil.
+func (_c *OAuthProviderConfigCreate) SetNillableDisplayName(v *string) *OAuthProviderConfigCreate {
+	if v != nil {
+		_c.SetDisplayName(*v)
+	}
+	return _c
+}
+
+// SetClientID sets the "client_id" field.
+func (_c *OAuthProviderConfigCreate) SetClientID(v string) *OAuthProviderConfigCreate {
+	_c.mutation.SetClientID(v)
+	return _c
+}
+
+// SetNillableClientID sets the "client_id" field if the given value is not nil.
+func (_c *OAuthProviderConfigCreate) SetNillableClientID(v *string) *OAuthProviderConfigCreate {
+	if v != nil {
+		_c.SetClientID(*v)
+	}
+	return _c
+}
+
+// SetClientSecret sets the "client_secret" field.
+func (_c *OAuthProviderConfigCreate) SetClientSecret(v string) *OAuthProviderConfigCreate {
+	_c.mutation.SetClientSecret(v)
+	return _c
+}
+
+// SetNillableClientSecret sets the "client_secret" field if the given value is not nil.
+func (_c *OAuthProviderConfigCreate) SetNillableClientSecret(v *string) *OAuthProviderConfigCreate {
+	if v != nil {
+		_c.SetClientSecret(*v)
+	}
+	return _c
+}
+
+// SetAuthURL sets the "auth_url" field.
+func (_c *OAuthProviderConfigCreate) SetAuthURL(v string) *OAuthProviderConfigCreate {
+	_c.mutation.SetAuthURL(v)
+	return _c
+}
+
+// SetNillableAuthURL sets the "auth_url" field if the given value is not nil.
+func (_c *OAuthProviderConfigCreate) SetNillableAuthURL(v *string) *OAuthProviderConfigCreate {
+	if v != nil {
+		_c.SetAuthURL(*v)
+	}
+	return _c
+}
+
+// SetTokenURL sets the "token_url" field.
+func (_c *OAuthProviderConfigCreate) SetTokenURL(v string) *OAuthProviderConfigCreate {
+	_c.mutation.SetTokenURL(v)
+	return _c
+}
+
+// SetNillableTokenURL sets the "token_url" field if the given value is not nil.
+func (_c *OAuthProviderConfigCreate) SetNillableTokenURL(v *string) *OAuthProviderConfigCreate {
+	if v != nil {
+		_c.SetTokenURL(*v)
+	}
+	return _c
+}
+
+// SetUserinfoURL sets the "userinfo_url" field.
+func (_c *OAuthProviderConfigCreate) SetUserinfoURL(v string) *OAuthProviderConfigCreate {
+	_c.mutation.SetUserinfoURL(v)
+	return _c
+}
+
+// SetNillableUserinfoURL sets the "userinfo_url" field if the given value is not nil.
+func (_c *OAuthProviderConfigCreate) SetNillableUserinfoURL(v *string) *OAuthProviderConfigCreate {
+	if v != nil {
+		_c.SetUserinfoURL(*v)
+	}
+	return _c
+}
+
+// SetRedirectURI sets the "redirect_uri" field.
+func (_c *OAuthProviderConfigCreate) SetRedirectURI(v string) *OAuthProviderConfigCreate {
+	_c.mutation.SetRedirectURI(v)
+	return _c
+}
+
+// SetNillableRedirectURI sets the "redirect_uri" field if the given value is not nil.
+func (_c *OAuthProviderConfigCreate) SetNillableRedirectURI(v *string) *OAuthProviderConfigCreate {
+	if v != nil {
+		_c.SetRedirectURI(*v)
+	}
+	return _c
+}
+
+// SetScopes sets the "scopes" field.
+func (_c *OAuthProviderConfigCreate) SetScopes(v []string) *OAuthProviderConfigCreate {
+	_c.mutation.SetScopes(v)
+	return _c
+}
+
+// SetAutoProvision sets the "auto_provision" field.
+func (_c *OAuthProviderConfigCreate) SetAutoProvision(v bool) *OAuthProviderConfigCreate {
+	_c.mutation.SetAutoProvision(v)
+	return _c
+}
+
+// SetNillableAutoProvision sets the "auto_provision" field if the given value is not nil.
+func (_c *OAuthProviderConfigCreate) SetNillableAutoProvision(v *bool) *OAuthProviderConfigCreate {
+	if v != nil {
+		_c.SetAutoProvision(*v)
+	}
+	return _c
+}
+
+// SetDefaultRoleIds sets the "default_role_ids" field.
+func (_c *OAuthProviderConfigCreate) SetDefaultRoleIds(v []uint32) *OAuthProviderConfigCreate {
+	_c.mutation.SetDefaultRoleIds(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *OAuthProviderConfigCreate) SetID(v uint32) *OAuthProviderConfigCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the OAuthProviderConfigMutation object of the builder.
+func (_c *OAuthProviderConfigCreate) Mutation() *OAuthProviderConfigMutation {
+	return _c.mutation
+}
+
+// Save creates the OAuthProviderConfig in the database.
+func (_c *OAuthProviderConfigCreate) Save(ctx context.Context) (*OAuthProviderConfig, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *OAuthProviderConfigCreate) SaveX(ctx context.Context) *OAuthProviderConfig {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *OAuthProviderConfigCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *OAuthProviderConfigCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *OAuthProviderConfigCreate) defaults() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := oauthproviderconfig.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := oauthproviderconfig.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.SortOrder(); !ok {
+		v := oauthproviderconfig.DefaultSortOrder
+		_c.mutation.SetSortOrder(v)
+	}
+	if _, ok := _c.mutation.ProviderCustom(); !ok {
+		v := oauthproviderconfig.DefaultProviderCustom
+		_c.mutation.SetProviderCustom(v)
+	}
+	if _, ok := _c.mutation.AutoProvision(); !ok {
+		v := oauthproviderconfig.DefaultAutoProvision
+		_c.mutation.SetAutoProvision(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *OAuthProviderConfigCreate) check() error {
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "OAuthProviderConfig.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := oauthproviderconfig.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "OAuthProviderConfig.status": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Provider(); ok {
+		if err := oauthproviderconfig.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "OAuthProviderConfig.provider": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ProviderCustom(); !ok {
+		return &ValidationError{Name: "provider_custom", err: errors.New(`ent: missing required field "OAuthProviderConfig.provider_custom"`)}
+	}
+	if v, ok := _c.mutation.ProviderCustom(); ok {
+		if err := oauthproviderconfig.ProviderCustomValidator(v); err != nil {
+			return &ValidationError{Name: "provider_custom", err: fmt.Errorf(`ent: validator failed for field "OAuthProviderConfig.provider_custom": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.DisplayName(); ok {
+		if err := oauthproviderconfig.DisplayNameValidator(v); err != nil {
+			return &ValidationError{Name: "display_name", err: fmt.Errorf(`ent: validator failed for field "OAuthProviderConfig.display_name": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ClientID(); ok {
+		if err := oauthproviderconfig.ClientIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_id", err: fmt.Errorf(`ent: validator failed for field "OAuthProviderConfig.client_id": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ClientSecret(); ok {
+		if err := oauthproviderconfig.ClientSecretValidator(v); err != nil {
+			return &ValidationError{Name: "client_secret", err: fmt.Errorf(`ent: validator failed for field "OAuthProviderConfig.client_secret": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.AuthURL(); ok {
+		if err := oauthproviderconfig.AuthURLValidator(v); err != nil {
+			return &ValidationError{Name: "auth_url", err: fmt.Errorf(`ent: validator failed for field "OAuthProviderConfig.auth_url": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.TokenURL(); ok {
+		if err := oauthproviderconfig.TokenURLValidator(v); err != nil {
+			return &ValidationError{Name: "token_url", err: fmt.Errorf(`ent: validator failed for field "OAuthProviderConfig.token_url": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.UserinfoURL(); ok {
+		if err := oauthproviderconfig.UserinfoURLValidator(v); err != nil {
+			return &ValidationError{Name: "userinfo_url", err: fmt.Errorf(`ent: validator failed for field "OAuthProviderConfig.userinfo_url": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.RedirectURI(); ok {
+		if err := oauthproviderconfig.RedirectURIValidator(v); err != nil {
+			return &ValidationError{Name: "redirect_uri", err: fmt.Errorf(`ent: validator failed for field "OAuthProviderConfig.redirect_uri": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := oauthproviderconfig.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "OAuthProviderConfig.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *OAuthProviderConfigCreate) sqlSave(ctx context.Context) (*OAuthProviderConfig, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint32(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *OAuthProviderConfigCreate) createSpec() (*OAuthProviderConfig, *sqlgraph.CreateSpec) {
+	var (
+		_node = &OAuthProviderConfig{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(oauthproviderconfig.Table, sqlgraph.NewFieldSpec(oauthproviderconfig.FieldID, field.TypeUint32))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(oauthproviderconfig.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = &value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(oauthproviderconfig.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = &value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(oauthproviderconfig.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(oauthproviderconfig.FieldCreatedBy, field.TypeUint32, value)
+		_node.CreatedBy = &value
+	}
+	if value, ok := _c.mutation.UpdatedBy(); ok {
+		_spec.SetField(oauthproviderconfig.FieldUpdatedBy, field.TypeUint32, value)
+		_node.UpdatedBy = &value
+	}
+	if value, ok := _c.mutation.DeletedBy(); ok {
+		_spec.SetField(oauthproviderconfig.FieldDeletedBy, field.TypeUint32, value)
+		_node.DeletedBy = &value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(oauthproviderconfig.FieldTenantID, field.TypeUint32, value)
+		_node.TenantID = &value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(oauthproviderconfig.FieldStatus, field.TypeEnum, value)
+		_node.Status = &value
+	}
+	if value, ok := _c.mutation.SortOrder(); ok {
+		_spec.SetField(oauthproviderconfig.FieldSortOrder, field.TypeUint32, value)
+		_node.SortOrder = &value
+	}
+	if value, ok := _c.mutation.Provider(); ok {
+		_spec.SetField(oauthproviderconfig.FieldProvider, field.TypeString, value)
+		_node.Provider = &value
+	}
+	if value, ok := _c.mutation.ProviderCustom(); ok {
+		_spec.SetField(oauthproviderconfig.FieldProviderCustom, field.TypeString, value)
+		_node.ProviderCustom = value
+	}
+	if value, ok := _c.mutation.DisplayName(); ok {
+		_spec.SetField(oauthproviderconfig.FieldDisplayName, field.TypeString, value)
+		_node.DisplayName = &value
+	}
+	if value, ok := _c.mutation.ClientID(); ok {
+		_spec.SetField(oauthproviderconfig.FieldClientID, field.TypeString, value)
+		_node.ClientID = &value
+	}
+	if value, ok := _c.mutation.ClientSecret(); ok {
+		_spec.SetField(oauthproviderconfig.FieldClientSecret, field.TypeString, value)
+		_node.ClientSecret = &value
+	}
+	if value, ok := _c.mutation.AuthURL(); ok {
+		_spec.SetField(oauthproviderconfig.FieldAuthURL, field.TypeString, value)
+		_node.AuthURL = &value
+	}
+	if value, ok := _c.mutation.TokenURL(); ok {
+		_spec.SetField(oauthproviderconfig.FieldTokenURL, field.TypeString, value)
+		_node.TokenURL = &value
+	}
+	if value, ok := _c.mutation.UserinfoURL(); ok {
+		_spec.SetField(oauthproviderconfig.FieldUserinfoURL, field.TypeString, value)
+		_node.UserinfoURL = &value
+	}
+	if value, ok := _c.mutation.RedirectURI(); ok {
+		_spec.SetField(oauthproviderconfig.FieldRedirectURI, field.TypeString, value)
+		_node.RedirectURI = &value
+	}
+	if value, ok := _c.mutation.Scopes(); ok {
+		_spec.SetField(oauthproviderconfig.FieldScopes, field.TypeJSON, value)
+		_node.Scopes = value
+	}
+	if value, ok := _c.mutation.AutoProvision(); ok {
+		_spec.SetField(oauthproviderconfig.FieldAutoProvision, field.TypeBool, value)
+		_node.AutoProvision = &value
+	}
+	if value, ok := _c.mutation.DefaultRoleIds(); ok {
+		_spec.SetField(oauthproviderconfig.FieldDefaultRoleIds, field.TypeJSON, value)
+		_node.DefaultRoleIds = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.OAuthProviderConfig.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.OAuthProviderConfigUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *OAuthProviderConfigCreate) OnConflict(opts ...sql.ConflictOption) *OAuthProviderConfigUpsertOne {
+	_c.conflict = opts
+	return &OAuthProviderConfigUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.OAuthProviderConfig.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *OAuthProviderConfigCreate) OnConflictColumns(columns ...string) *OAuthProviderConfigUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &OAuthProviderConfigUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// OAuthProviderConfigUpsertOne is the builder for "upsert"-ing
+	//  one OAuthProviderConfig node.
+	OAuthProviderConfigUpsertOne struct {
+		create *OAuthProviderConfigCreate
+	}
+
+	// OAuthProviderConfigUpsert is the "OnConflict" setter.
+	OAuthProviderConfigUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *OAuthProviderConfigUpsert) SetUpdatedAt(v time.Time) *OAuthProviderConfigUpsert {
+	u.Set(oauthproviderconfig.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *OAuthProviderConfigUpsert) UpdateUpdatedAt() *OAuthProviderConfigUpsert {
+	u.SetExcluded(oauthproviderconfig.FieldUpdatedAt)
+	return u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *OAuthProviderConfigUpsert) ClearUpdatedAt() *OAuthProviderConfigUpsert {
+	u.SetNull(oauthproviderconfig.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *OAuthProviderConfigUpsert) SetDeletedAt(v time.Time) *OAuthProviderConfigUpsert {
+	u.Set(oauthproviderconfig.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *OAuthProviderConfigUpsert) UpdateDeletedAt() *OAuthProviderConfigUpsert {
+	u.SetExcluded(oauthproviderconfig.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *OAuthProviderConfigUpsert) ClearDeletedAt() *OAuthProviderConfigUpsert {
+	u.SetNull(oauthproviderconfig.FieldDeletedAt)
+	return u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *OAuthProviderConfigUpsert) SetCreatedBy(v uint32) *OAuthProviderConfigUpsert {
+	u.Set(oauthproviderconfig.FieldCreatedBy, v)
+	return u
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *OAuthProviderConfigUpsert) UpdateCreatedBy() *OAuthProviderConfigUpsert {
+	u.SetExcluded(oauthproviderconfig.FieldCreatedBy)
+	return u
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *OAuthProviderConfigUpsert) AddCreatedBy(v uint32) *OAuthProviderConfigUpsert {
+	u.Add(oauthproviderconfig.FieldCreatedBy, v)
+	return u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *OAuthProviderConfigUpsert) ClearCreatedBy() *OAuthProviderConfigUpsert {
+	u.SetNull(oauthproviderconfig.FieldCreatedBy)
+	return u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *OAuthProviderConfigUpsert) SetUpdatedBy(v uint32) *OAuthProviderConfigUpsert {
+	u.Set(oauthproviderconfig.FieldUpdatedBy, v)
+	return u
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *OAuthProviderConfigUpsert) UpdateUpdatedBy() *OAuthProviderConfigUpsert {
+	u.SetExcluded(oauthproviderconfig.FieldUpdatedBy)
+	return u
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *OAuthProviderConfigUpsert) AddUpdatedBy(v uint32) *OAuthProviderConfigUpsert {
+	u.Add(oauthproviderconfig.FieldUpdatedBy, v)
+	return u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *OAuthProviderConfigUpsert) ClearUpdatedBy() *OAuthProviderConfigUpsert {
+	u.SetNull(oauthproviderconfig.FieldUpdatedBy)
+	return u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *OAuthProviderConfigUpsert) SetDeletedBy(v uint32) *OAuthProviderConfigUpsert {
+	u.Set(oauthproviderconfig.FieldDeletedBy, v)
+	return u
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *OAuthProviderConfigUpsert) UpdateDeletedBy() *OAuthProviderConfigUpsert {
+	u.SetExcluded(oauthproviderconfig.FieldDeletedBy)
+	return u
+}
+
+// AddDeletedBy adds v to the "deleted_by" field.
+func (u *OAuthProviderConfigUpsert) AddDeletedBy(v uint32) *OAuthProviderConfigUpsert {
+	u.Add(oauthproviderconfig.FieldDeletedBy, v)
+	return u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *OAuthProviderConfigUpsert) ClearDeletedBy() *OAuthProviderConfigUpsert {
+	u.SetNull(oauthproviderconfig.FieldDeletedBy)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *OAuthProviderConfigUpsert) SetStatus(v oauthproviderconfig.Status) *OAuthProviderConfigUpsert {
+	u.Set(oauthproviderconfig.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *OAuthProviderConfigUpsert) UpdateStatus() *OAuthProviderConfigUpsert {
+	u.SetExcluded(oauthproviderconfig.FieldStatus)
+	return u
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (u *OAuthProviderConfigUpsert) SetSortOrder(v uint32) *OAuthProviderConfigUpsert {
+	u.Set(oauthproviderconfig.FieldSortOrder, v)
+	return u
+}
+
+// UpdateSortOrder sets the "sort_order" field to the value that was provided on create.
+func (u *OAuthProviderConfigUpsert) UpdateSortOrder() *OAuthProviderConfigUpsert {
+	u.SetExcluded(oauthproviderconfig.FieldSortOrder)
+	return u
+}
+
+// AddSortOrder adds v to the "sort_order" field.
+func (u *OAuthProviderConfigUpsert) AddSortOrder(v uint32) *OAuthProviderConfigUpsert {
+	u.Add(oauthproviderconfig.FieldSortOrder, v)
+	return u
+}
+
+// ClearSortOrder clears the value of the "sort_order" field.
+func (u *OAuthProviderConfigUpsert) ClearSortOrder() *OAuthProviderConfigUpsert {
+	u.SetNull(oauthproviderconfig.FieldSortOrder)
+	return u
+}
+
+// SetDisplayName sets the "display_name" field.
+func (u *OAuthProviderConfigUpsert) SetDisplayName(v string) *OAuthProviderConfigUpsert {
+	u.Set(oauthproviderconfig.FieldDisplayName, v)
+	return u
+}
+
+// UpdateDisplayName sets the "display_name" field to the value that was provided on create.
+func (u *OAuthProviderConfigUpsert) UpdateDisplayName() *OAuthProviderConfigUpsert {
+	u.SetExcluded(oauthproviderconfig.FieldDisplayName)
+	return u
+}
+
+// ClearDisplayName clears the value of the "display_name" field.
+func (u *OAuthProviderConfigUpsert) ClearDisplayName() *OAuthProviderConfigUpsert {
+	u.SetNull(oauthproviderconfig.FieldDisplayName)
+	return u
+}
+
+// SetClientID sets the "client_id" field.
+func (u *OAuthProviderConfigUpsert) SetClientID(v string) *OAuthProviderConfigUpsert {
+	u.Set(oauthproviderconfig.FieldClientID, v)
+	return u
+}
+
+// UpdateClientID sets the "client_id" field to the value that was provided on create.
+func (u *OAuthProviderConfigUpsert) UpdateClientID() *OAuthProviderConfigUpsert {
+	u.SetExcluded(oauthproviderconfig.FieldClientID)
+	return u
+}
+
+// ClearClientID clears the value of the "client_id" field.
+func (u *OAuthProviderConfigUpsert) ClearClientID() *OAuthProviderConfigUpsert {
+	u.SetNull(oauthproviderconfig.FieldClientID)
+	return u
+}
+
+// SetClientSecret sets the "client_secret" field.
+func (u *OAuthProviderConfigUpsert) SetClientSecret(v string) *OAuthProviderConfigUpsert {
+	u.Set(oauthproviderconfig.FieldClientSecret, v)
+	return u
+}
+
+// UpdateClientSecret sets the "client_secret" field to the value that was provided on create.
+func (u *OAuthProviderConfigUpsert) UpdateClientSecret() *OAuthProviderConfigUpsert {
+	u.SetExcluded(oauthproviderconfig.FieldClientSecret)
+	return u
+}
+
+// ClearClientSecret clears the value of the "client_secret" field.
+func (u *OAuthProviderConfigUpsert) ClearClientSecret() *OAuthProviderConfigUpsert {
+	u.SetNull(oauthproviderconfig.FieldClientSecret)
+	return u
+}
+
+// SetAuthURL sets the "auth_url" field.
+func (u *OAuthProviderConfigUpsert) SetAuthURL(v string) *OAuthProviderConfigUpsert {
+	u.Set(oauthproviderconfig.FieldAuthURL, v)
+	return u
+}
+
+// UpdateAuthURL sets the "auth_url" field to the value that was provided on create.
+func (u *OAuthProviderConfigUpsert) UpdateAuthURL() *OAuthProviderConfigUpsert {
+	u.SetExcluded(oauthproviderconfig.FieldAuthURL)
+	return u
+}
+
+// ClearAuthURL clears the value of the "auth_url" field.
+func (u *OAuthProviderConfigUpsert) ClearAuthURL() *OAuthProviderConfigUpsert {
+	u.SetNull(oauthproviderconfig.FieldAuthURL)
+	return u
+}
+
+// SetTokenURL sets the "token_url" field.
+func (u *OAuthProviderConfigUpsert) SetTokenURL(v string) *OAuthProviderConfigUpsert {
+	u.Set(oauthproviderconfig.FieldTokenURL, v)
+	return u
+}
+
+// UpdateTokenURL sets the "token_url" field to the value that was provided on create.
+func (u *OAuthProviderConfigUpsert) UpdateTokenURL() *OAuthProviderConfigUpsert {
+	u.SetExcluded(oauthproviderconfig.FieldTokenURL)
+	return u
+}
+
+// ClearTokenURL clears the value of the "token_url" field.
+func (u *OAuthProviderConfigUpsert) ClearTokenURL() *OAuthProviderConfigUpsert {
+	u.SetNull(oauthproviderconfig.FieldTokenURL)
+	return u
+}
+
+// SetUserinfoURL sets the "userinfo_url" field.
+func (u *OAuthProviderConfigUpsert) SetUserinfoURL(v string) *OAuthProviderConfigUpsert {
+	u.Set(oauthproviderconfig.FieldUserinfoURL, v)
+	return u
+}
+
+// UpdateUserinfoURL sets the "userinfo_url" field to the value that was provided on create.
+func (u *OAuthProviderConfigUpsert) UpdateUserinfoURL() *OAuthProviderConfigUpsert {
+	u.SetExcluded(oauthproviderconfig.FieldUserinfoURL)
+	return u
+}
+
+// ClearUserinfoURL clears the value of the "userinfo_url" field.
+func (u *OAuthProviderConfigUpsert) ClearUserinfoURL() *OAuthProviderConfigUpsert {
+	u.SetNull(oauthproviderconfig.FieldUserinfoURL)
+	return u
+}
+
+// SetRedirectURI sets the "redirect_uri" field.
+func (u *OAuthProviderConfigUpsert) SetRedirectURI(v string) *OAuthProviderConfigUpsert {
+	u.Set(oauthproviderconfig.FieldRedirectURI, v)
+	return u
+}
+
+// UpdateRedirectURI sets the "redirect_uri" field to the value that was provided on create.
+func (u *OAuthProviderConfigUpsert) UpdateRedirectURI() *OAuthProviderConfigUpsert {
+	u.SetExcluded(oauthproviderconfig.FieldRedirectURI)
+	return u
+}
+
+// ClearRedirectURI clears the value of the "redirect_uri" field.
+func (u *OAuthProviderConfigUpsert) ClearRedirectURI() *OAuthProviderConfigUpsert {
+	u.SetNull(oauthproviderconfig.FieldRedirectURI)
+	return u
+}
+
+// SetScopes sets the "scopes" field.
+func (u *OAuthProviderConfigUpsert) SetScopes(v []string) *OAuthProviderConfigUpsert {
+	u.Set(oauthproviderconfig.FieldScopes, v)
+	return u
+}
+
+// UpdateScopes sets the "scopes" field to the value that was provided on create.
+func (u *OAuthProviderConfigUpsert) UpdateScopes() *OAuthProviderConfigUpsert {
+	u.SetExcluded(oauthproviderconfig.FieldScopes)
+	return u
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (u *OAuthProviderConfigUpsert) ClearScopes() *OAuthProviderConfigUpsert {
+	u.SetNull(oauthproviderconfig.FieldScopes)
+	return u
+}
+
+// SetAutoProvision sets the "auto_provision" field.
+func (u *OAuthProviderConfigUpsert) SetAutoProvision(v bool) *OAuthProviderConfigUpsert {
+	u.Set(oauthproviderconfig.FieldAutoProvision, v)
+	return u
+}
+
+// UpdateAutoProvision sets the "auto_provision" field to the value that was provided on create.
+func (u *OAuthProviderConfigUpsert) UpdateAutoProvision() *OAuthProviderConfigUpsert {
+	u.SetExcluded(oauthproviderconfig.FieldAutoProvision)
+	return u
+}
+
+// ClearAutoProvision clears the value of the "auto_provision" field.
+func (u *OAuthProviderConfigUpsert) ClearAutoProvision() *OAuthProviderConfigUpsert {
+	u.SetNull(oauthproviderconfig.FieldAutoProvision)
+	return u
+}
+
+// SetDefaultRoleIds sets the "default_role_ids" field.
+func (u *OAuthProviderConfigUpsert) SetDefaultRoleIds(v []uint32) *OAuthProviderConfigUpsert {
+	u.Set(oauthproviderconfig.FieldDefaultRoleIds, v)
+	return u
+}
+
+// UpdateDefaultRoleIds sets the "default_role_ids" field to the value that was provided on create.
+func (u *OAuthProviderConfigUpsert) UpdateDefaultRoleIds() *OAuthProviderConfigUpsert {
+	u.SetExcluded(oauthproviderconfig.FieldDefaultRoleIds)
+	return u
+}
+
+// ClearDefaultRoleIds clears the value of the "default_role_ids" field.
+func (u *OAuthProviderConfigUpsert) ClearDefaultRoleIds() *OAuthProviderConfigUpsert {
+	u.SetNull(oauthproviderconfig.FieldDefaultRoleIds)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.OAuthProviderConfig.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(oauthproviderconfig.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *OAuthProviderConfigUpsertOne) UpdateNewValues() *OAuthProviderConfigUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(oauthproviderconfig.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(oauthproviderconfig.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(oauthproviderconfig.FieldTenantID)
+		}
+		if _, exists := u.create.mutation.Provider(); exists {
+			s.SetIgnore(oauthproviderconfig.FieldProvider)
+		}
+		if _, exists := u.create.mutation.ProviderCustom(); exists {
+			s.SetIgnore(oauthproviderconfig.FieldProviderCustom)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.OAuthProviderConfig.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *OAuthProviderConfigUpsertOne) Ignore() *OAuthProviderConfigUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *OAuthProviderConfigUpsertOne) DoNothing() *OAuthProviderConfigUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the OAuthProviderConfigCreate.OnConflict
+// documentation for more info.
+func (u *OAuthProviderConfigUpsertOne) Update(set func(*OAuthProviderConfigUpsert)) *OAuthProviderConfigUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&OAuthProviderConfigUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *OAuthProviderConfigUpsertOne) SetUpdatedAt(v time.Time) *OAuthProviderConfigUpsertOne {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *OAuthProviderConfigUpsertOne) UpdateUpdatedAt() *OAuthProviderConfigUpsertOne {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *OAuthProviderConfigUpsertOne) ClearUpdatedAt() *OAuthProviderConfigUpsertOne {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.ClearUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *OAuthProviderConfigUpsertOne) SetDeletedAt(v time.Time) *OAuthProviderConfigUpsertOne {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *OAuthProviderConfigUpsertOne) UpdateDeletedAt() *OAuthProviderConfigUpsertOne {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *OAuthProviderConfigUpsertOne) ClearDeletedAt() *OAuthProviderConfigUpsertOne {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *OAuthProviderConfigUpsertOne) SetCreatedBy(v uint32) *OAuthProviderConfigUpsertOne {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *OAuthProviderConfigUpsertOne) AddCreatedBy(v uint32) *OAuthProviderConfigUpsertOne {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.AddCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *OAuthProviderConfigUpsertOne) UpdateCreatedBy() *OAuthProviderConfigUpsertOne {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *OAuthProviderConfigUpsertOne) ClearCreatedBy() *OAuthProviderConfigUpsertOne {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *OAuthProviderConfigUpsertOne) SetUpdatedBy(v uint32) *OAuthProviderConfigUpsertOne {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *OAuthProviderConfigUpsertOne) AddUpdatedBy(v uint32) *OAuthProviderConfigUpsertOne {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.AddUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *OAuthProviderConfigUpsertOne) UpdateUpdatedBy() *OAuthProviderConfigUpsertOne {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *OAuthProviderConfigUpsertOne) ClearUpdatedBy() *OAuthProviderConfigUpsertOne {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.ClearUpdatedBy()
+	})
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *OAuthProviderConfigUpsertOne) SetDeletedBy(v uint32) *OAuthProviderConfigUpsertOne {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.SetDeletedBy(v)
+	})
+}
+
+// AddDeletedBy adds v to the "deleted_by" field.
+func (u *OAuthProviderConfigUpsertOne) AddDeletedBy(v uint32) *OAuthProviderConfigUpsertOne {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.AddDeletedBy(v)
+	})
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *OAuthProviderConfigUpsertOne) UpdateDeletedBy() *OAuthProviderConfigUpsertOne {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.UpdateDeletedBy()
+	})
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *OAuthProviderConfigUpsertOne) ClearDeletedBy() *OAuthProviderConfigUpsertOne {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.ClearDeletedBy()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *OAuthProviderConfigUpsertOne) SetStatus(v oauthproviderconfig.Status) *OAuthProviderConfigUpsertOne {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *OAuthProviderConfigUpsertOne) UpdateStatus() *OAuthProviderConfigUpsertOne {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (u *OAuthProviderConfigUpsertOne) SetSortOrder(v uint32) *OAuthProviderConfigUpsertOne {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.SetSortOrder(v)
+	})
+}
+
+// AddSortOrder adds v to the "sort_order" field.
+func (u *OAuthProviderConfigUpsertOne) AddSortOrder(v uint32) *OAuthProviderConfigUpsertOne {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.AddSortOrder(v)
+	})
+}
+
+// UpdateSortOrder sets the "sort_order" field to the value that was provided on create.
+func (u *OAuthProviderConfigUpsertOne) UpdateSortOrder() *OAuthProviderConfigUpsertOne {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.UpdateSortOrder()
+	})
+}
+
+// ClearSortOrder clears the value of the "sort_order" field.
+func (u *OAuthProviderConfigUpsertOne) ClearSortOrder() *OAuthProviderConfigUpsertOne {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.ClearSortOrder()
+	})
+}
+
+// SetDisplayName sets the "display_name" field.
+func (u *OAuthProviderConfigUpsertOne) SetDisplayName(v string) *OAuthProviderConfigUpsertOne {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.SetDisplayName(v)
+	})
+}
+
+// UpdateDisplayName sets the "display_name" field to the value that was provided on create.
+func (u *OAuthProviderConfigUpsertOne) UpdateDisplayName() *OAuthProviderConfigUpsertOne {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.UpdateDisplayName()
+	})
+}
+
+// ClearDisplayName clears the value of the "display_name" field.
+func (u *OAuthProviderConfigUpsertOne) ClearDisplayName() *OAuthProviderConfigUpsertOne {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.ClearDisplayName()
+	})
+}
+
+// SetClientID sets the "client_id" field.
+func (u *OAuthProviderConfigUpsertOne) SetClientID(v string) *OAuthProviderConfigUpsertOne {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.SetClientID(v)
+	})
+}
+
+// UpdateClientID sets the "client_id" field to the value that was provided on create.
+func (u *OAuthProviderConfigUpsertOne) UpdateClientID() *OAuthProviderConfigUpsertOne {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.UpdateClientID()
+	})
+}
+
+// ClearClientID clears the value of the "client_id" field.
+func (u *OAuthProviderConfigUpsertOne) ClearClientID() *OAuthProviderConfigUpsertOne {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.ClearClientID()
+	})
+}
+
+// SetClientSecret sets the "client_secret" field.
+func (u *OAuthProviderConfigUpsertOne) SetClientSecret(v string) *OAuthProviderConfigUpsertOne {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.SetClientSecret(v)
+	})
+}
+
+// UpdateClientSecret sets the "client_secret" field to the value that was provided on create.
+func (u *OAuthProviderConfigUpsertOne) UpdateClientSecret() *OAuthProviderConfigUpsertOne {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.UpdateClientSecret()
+	})
+}
+
+// ClearClientSecret clears the value of the "client_secret" field.
+func (u *OAuthProviderConfigUpsertOne) ClearClientSecret() *OAuthProviderConfigUpsertOne {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.ClearClientSecret()
+	})
+}
+
+// SetAuthURL sets the "auth_url" field.
+func (u *OAuthProviderConfigUpsertOne) SetAuthURL(v string) *OAuthProviderConfigUpsertOne {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.SetAuthURL(v)
+	})
+}
+
+// UpdateAuthURL sets the "auth_url" field to the value that was provided on create.
+func (u *OAuthProviderConfigUpsertOne) UpdateAuthURL() *OAuthProviderConfigUpsertOne {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.UpdateAuthURL()
+	})
+}
+
+// ClearAuthURL clears the value of the "auth_url" field.
+func (u *OAuthProviderConfigUpsertOne) ClearAuthURL() *OAuthProviderConfigUpsertOne {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.ClearAuthURL()
+	})
+}
+
+// SetTokenURL sets the "token_url" field.
+func (u *OAuthProviderConfigUpsertOne) SetTokenURL(v string) *OAuthProviderConfigUpsertOne {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.SetTokenURL(v)
+	})
+}
+
+// UpdateTokenURL sets the "token_url" field to the value that was provided on create.
+func (u *OAuthProviderConfigUpsertOne) UpdateTokenURL() *OAuthProviderConfigUpsertOne {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.UpdateTokenURL()
+	})
+}
+
+// ClearTokenURL clears the value of the "token_url" field.
+func (u *OAuthProviderConfigUpsertOne) ClearTokenURL() *OAuthProviderConfigUpsertOne {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.ClearTokenURL()
+	})
+}
+
+// SetUserinfoURL sets the "userinfo_url" field.
+func (u *OAuthProviderConfigUpsertOne) SetUserinfoURL(v string) *OAuthProviderConfigUpsertOne {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.SetUserinfoURL(v)
+	})
+}
+
+// UpdateUserinfoURL sets the "userinfo_url" field to the value that was provided on create.
+func (u *OAuthProviderConfigUpsertOne) UpdateUserinfoURL() *OAuthProviderConfigUpsertOne {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.UpdateUserinfoURL()
+	})
+}
+
+// ClearUserinfoURL clears the value of the "userinfo_url" field.
+func (u *OAuthProviderConfigUpsertOne) ClearUserinfoURL() *OAuthProviderConfigUpsertOne {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.ClearUserinfoURL()
+	})
+}
+
+// SetRedirectURI sets the "redirect_uri" field.
+func (u *OAuthProviderConfigUpsertOne) SetRedirectURI(v string) *OAuthProviderConfigUpsertOne {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.SetRedirectURI(v)
+	})
+}
+
+// UpdateRedirectURI sets the "redirect_uri" field to the value that was provided on create.
+func (u *OAuthProviderConfigUpsertOne) UpdateRedirectURI() *OAuthProviderConfigUpsertOne {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.UpdateRedirectURI()
+	})
+}
+
+// ClearRedirectURI clears the value of the "redirect_uri" field.
+func (u *OAuthProviderConfigUpsertOne) ClearRedirectURI() *OAuthProviderConfigUpsertOne {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.ClearRedirectURI()
+	})
+}
+
+// SetScopes sets the "scopes" field.
+func (u *OAuthProviderConfigUpsertOne) SetScopes(v []string) *OAuthProviderConfigUpsertOne {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.SetScopes(v)
+	})
+}
+
+// UpdateScopes sets the "scopes" field to the value that was provided on create.
+func (u *OAuthProviderConfigUpsertOne) UpdateScopes() *OAuthProviderConfigUpsertOne {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.UpdateScopes()
+	})
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (u *OAuthProviderConfigUpsertOne) ClearScopes() *OAuthProviderConfigUpsertOne {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.ClearScopes()
+	})
+}
+
+// SetAutoProvision sets the "auto_provision" field.
+func (u *OAuthProviderConfigUpsertOne) SetAutoProvision(v bool) *OAuthProviderConfigUpsertOne {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.SetAutoProvision(v)
+	})
+}
+
+// UpdateAutoProvision sets the "auto_provision" field to the value that was provided on create.
+func (u *OAuthProviderConfigUpsertOne) UpdateAutoProvision() *OAuthProviderConfigUpsertOne {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.UpdateAutoProvision()
+	})
+}
+
+// ClearAutoProvision clears the value of the "auto_provision" field.
+func (u *OAuthProviderConfigUpsertOne) ClearAutoProvision() *OAuthProviderConfigUpsertOne {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.ClearAutoProvision()
+	})
+}
+
+// SetDefaultRoleIds sets the "default_role_ids" field.
+func (u *OAuthProviderConfigUpsertOne) SetDefaultRoleIds(v []uint32) *OAuthProviderConfigUpsertOne {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.SetDefaultRoleIds(v)
+	})
+}
+
+// UpdateDefaultRoleIds sets the "default_role_ids" field to the value that was provided on create.
+func (u *OAuthProviderConfigUpsertOne) UpdateDefaultRoleIds() *OAuthProviderConfigUpsertOne {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.UpdateDefaultRoleIds()
+	})
+}
+
+// ClearDefaultRoleIds clears the value of the "default_role_ids" field.
+func (u *OAuthProviderConfigUpsertOne) ClearDefaultRoleIds() *OAuthProviderConfigUpsertOne {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.ClearDefaultRoleIds()
+	})
+}
+
+// Exec executes the query.
+func (u *OAuthProviderConfigUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for OAuthProviderConfigCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *OAuthProviderConfigUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *OAuthProviderConfigUpsertOne) ID(ctx context.Context) (id uint32, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *OAuthProviderConfigUpsertOne) IDX(ctx context.Context) uint32 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// OAuthProviderConfigCreateBulk is the builder for creating many OAuthProviderConfig entities in bulk.
+type OAuthProviderConfigCreateBulk struct {
+	config
+	err      error
+	builders []*OAuthProviderConfigCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the OAuthProviderConfig entities in the database.
+func (_c *OAuthProviderConfigCreateBulk) Save(ctx context.Context) ([]*OAuthProviderConfig, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*OAuthProviderConfig, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OAuthProviderConfigMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint32(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *OAuthProviderConfigCreateBulk) SaveX(ctx context.Context) []*OAuthProviderConfig {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *OAuthProviderConfigCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *OAuthProviderConfigCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.OAuthProviderConfig.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.OAuthProviderConfigUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *OAuthProviderConfigCreateBulk) OnConflict(opts ...sql.ConflictOption) *OAuthProviderConfigUpsertBulk {
+	_c.conflict = opts
+	return &OAuthProviderConfigUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.OAuthProviderConfig.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *OAuthProviderConfigCreateBulk) OnConflictColumns(columns ...string) *OAuthProviderConfigUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &OAuthProviderConfigUpsertBulk{
+		create: _c,
+	}
+}
+
+// OAuthProviderConfigUpsertBulk is the builder for "upsert"-ing
+// a bulk of OAuthProviderConfig nodes.
+type OAuthProviderConfigUpsertBulk struct {
+	create *OAuthProviderConfigCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.OAuthProviderConfig.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(oauthproviderconfig.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *OAuthProviderConfigUpsertBulk) UpdateNewValues() *OAuthProviderConfigUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(oauthproviderconfig.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(oauthproviderconfig.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(oauthproviderconfig.FieldTenantID)
+			}
+			if _, exists := b.mutation.Provider(); exists {
+				s.SetIgnore(oauthproviderconfig.FieldProvider)
+			}
+			if _, exists := b.mutation.ProviderCustom(); exists {
+				s.SetIgnore(oauthproviderconfig.FieldProviderCustom)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.OAuthProviderConfig.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *OAuthProviderConfigUpsertBulk) Ignore() *OAuthProviderConfigUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *OAuthProviderConfigUpsertBulk) DoNothing() *OAuthProviderConfigUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the OAuthProviderConfigCreateBulk.OnConflict
+// documentation for more info.
+func (u *OAuthProviderConfigUpsertBulk) Update(set func(*OAuthProviderConfigUpsert)) *OAuthProviderConfigUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&OAuthProviderConfigUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *OAuthProviderConfigUpsertBulk) SetUpdatedAt(v time.Time) *OAuthProviderConfigUpsertBulk {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *OAuthProviderConfigUpsertBulk) UpdateUpdatedAt() *OAuthProviderConfigUpsertBulk {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *OAuthProviderConfigUpsertBulk) ClearUpdatedAt() *OAuthProviderConfigUpsertBulk {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.ClearUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *OAuthProviderConfigUpsertBulk) SetDeletedAt(v time.Time) *OAuthProviderConfigUpsertBulk {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *OAuthProviderConfigUpsertBulk) UpdateDeletedAt() *OAuthProviderConfigUpsertBulk {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *OAuthProviderConfigUpsertBulk) ClearDeletedAt() *OAuthProviderConfigUpsertBulk {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *OAuthProviderConfigUpsertBulk) SetCreatedBy(v uint32) *OAuthProviderConfigUpsertBulk {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *OAuthProviderConfigUpsertBulk) AddCreatedBy(v uint32) *OAuthProviderConfigUpsertBulk {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.AddCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *OAuthProviderConfigUpsertBulk) UpdateCreatedBy() *OAuthProviderConfigUpsertBulk {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *OAuthProviderConfigUpsertBulk) ClearCreatedBy() *OAuthProviderConfigUpsertBulk {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *OAuthProviderConfigUpsertBulk) SetUpdatedBy(v uint32) *OAuthProviderConfigUpsertBulk {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *OAuthProviderConfigUpsertBulk) AddUpdatedBy(v uint32) *OAuthProviderConfigUpsertBulk {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.AddUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *OAuthProviderConfigUpsertBulk) UpdateUpdatedBy() *OAuthProviderConfigUpsertBulk {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *OAuthProviderConfigUpsertBulk) ClearUpdatedBy() *OAuthProviderConfigUpsertBulk {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.ClearUpdatedBy()
+	})
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *OAuthProviderConfigUpsertBulk) SetDeletedBy(v uint32) *OAuthProviderConfigUpsertBulk {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.SetDeletedBy(v)
+	})
+}
+
+// AddDeletedBy adds v to the "deleted_by" field.
+func (u *OAuthProviderConfigUpsertBulk) AddDeletedBy(v uint32) *OAuthProviderConfigUpsertBulk {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.AddDeletedBy(v)
+	})
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *OAuthProviderConfigUpsertBulk) UpdateDeletedBy() *OAuthProviderConfigUpsertBulk {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.UpdateDeletedBy()
+	})
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *OAuthProviderConfigUpsertBulk) ClearDeletedBy() *OAuthProviderConfigUpsertBulk {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.ClearDeletedBy()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *OAuthProviderConfigUpsertBulk) SetStatus(v oauthproviderconfig.Status) *OAuthProviderConfigUpsertBulk {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *OAuthProviderConfigUpsertBulk) UpdateStatus() *OAuthProviderConfigUpsertBulk {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (u *OAuthProviderConfigUpsertBulk) SetSortOrder(v uint32) *OAuthProviderConfigUpsertBulk {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.SetSortOrder(v)
+	})
+}
+
+// AddSortOrder adds v to the "sort_order" field.
+func (u *OAuthProviderConfigUpsertBulk) AddSortOrder(v uint32) *OAuthProviderConfigUpsertBulk {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.AddSortOrder(v)
+	})
+}
+
+// UpdateSortOrder sets the "sort_order" field to the value that was provided on create.
+func (u *OAuthProviderConfigUpsertBulk) UpdateSortOrder() *OAuthProviderConfigUpsertBulk {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.UpdateSortOrder()
+	})
+}
+
+// ClearSortOrder clears the value of the "sort_order" field.
+func (u *OAuthProviderConfigUpsertBulk) ClearSortOrder() *OAuthProviderConfigUpsertBulk {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.ClearSortOrder()
+	})
+}
+
+// SetDisplayName sets the "display_name" field.
+func (u *OAuthProviderConfigUpsertBulk) SetDisplayName(v string) *OAuthProviderConfigUpsertBulk {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.SetDisplayName(v)
+	})
+}
+
+// UpdateDisplayName sets the "display_name" field to the value that was provided on create.
+func (u *OAuthProviderConfigUpsertBulk) UpdateDisplayName() *OAuthProviderConfigUpsertBulk {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.UpdateDisplayName()
+	})
+}
+
+// ClearDisplayName clears the value of the "display_name" field.
+func (u *OAuthProviderConfigUpsertBulk) ClearDisplayName() *OAuthProviderConfigUpsertBulk {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.ClearDisplayName()
+	})
+}
+
+// SetClientID sets the "client_id" field.
+func (u *OAuthProviderConfigUpsertBulk) SetClientID(v string) *OAuthProviderConfigUpsertBulk {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.SetClientID(v)
+	})
+}
+
+// UpdateClientID sets the "client_id" field to the value that was provided on create.
+func (u *OAuthProviderConfigUpsertBulk) UpdateClientID() *OAuthProviderConfigUpsertBulk {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.UpdateClientID()
+	})
+}
+
+// ClearClientID clears the value of the "client_id" field.
+func (u *OAuthProviderConfigUpsertBulk) ClearClientID() *OAuthProviderConfigUpsertBulk {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.ClearClientID()
+	})
+}
+
+// SetClientSecret sets the "client_secret" field.
+func (u *OAuthProviderConfigUpsertBulk) SetClientSecret(v string) *OAuthProviderConfigUpsertBulk {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.SetClientSecret(v)
+	})
+}
+
+// UpdateClientSecret sets the "client_secret" field to the value that was provided on create.
+func (u *OAuthProviderConfigUpsertBulk) UpdateClientSecret() *OAuthProviderConfigUpsertBulk {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.UpdateClientSecret()
+	})
+}
+
+// ClearClientSecret clears the value of the "client_secret" field.
+func (u *OAuthProviderConfigUpsertBulk) ClearClientSecret() *OAuthProviderConfigUpsertBulk {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.ClearClientSecret()
+	})
+}
+
+// SetAuthURL sets the "auth_url" field.
+func (u *OAuthProviderConfigUpsertBulk) SetAuthURL(v string) *OAuthProviderConfigUpsertBulk {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.SetAuthURL(v)
+	})
+}
+
+// UpdateAuthURL sets the "auth_url" field to the value that was provided on create.
+func (u *OAuthProviderConfigUpsertBulk) UpdateAuthURL() *OAuthProviderConfigUpsertBulk {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.UpdateAuthURL()
+	})
+}
+
+// ClearAuthURL clears the value of the "auth_url" field.
+func (u *OAuthProviderConfigUpsertBulk) ClearAuthURL() *OAuthProviderConfigUpsertBulk {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.ClearAuthURL()
+	})
+}
+
+// SetTokenURL sets the "token_url" field.
+func (u *OAuthProviderConfigUpsertBulk) SetTokenURL(v string) *OAuthProviderConfigUpsertBulk {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.SetTokenURL(v)
+	})
+}
+
+// UpdateTokenURL sets the "token_url" field to the value that was provided on create.
+func (u *OAuthProviderConfigUpsertBulk) UpdateTokenURL() *OAuthProviderConfigUpsertBulk {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.UpdateTokenURL()
+	})
+}
+
+// ClearTokenURL clears the value of the "token_url" field.
+func (u *OAuthProviderConfigUpsertBulk) ClearTokenURL() *OAuthProviderConfigUpsertBulk {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.ClearTokenURL()
+	})
+}
+
+// SetUserinfoURL sets the "userinfo_url" field.
+func (u *OAuthProviderConfigUpsertBulk) SetUserinfoURL(v string) *OAuthProviderConfigUpsertBulk {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.SetUserinfoURL(v)
+	})
+}
+
+// UpdateUserinfoURL sets the "userinfo_url" field to the value that was provided on create.
+func (u *OAuthProviderConfigUpsertBulk) UpdateUserinfoURL() *OAuthProviderConfigUpsertBulk {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.UpdateUserinfoURL()
+	})
+}
+
+// ClearUserinfoURL clears the value of the "userinfo_url" field.
+func (u *OAuthProviderConfigUpsertBulk) ClearUserinfoURL() *OAuthProviderConfigUpsertBulk {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.ClearUserinfoURL()
+	})
+}
+
+// SetRedirectURI sets the "redirect_uri" field.
+func (u *OAuthProviderConfigUpsertBulk) SetRedirectURI(v string) *OAuthProviderConfigUpsertBulk {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.SetRedirectURI(v)
+	})
+}
+
+// UpdateRedirectURI sets the "redirect_uri" field to the value that was provided on create.
+func (u *OAuthProviderConfigUpsertBulk) UpdateRedirectURI() *OAuthProviderConfigUpsertBulk {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.UpdateRedirectURI()
+	})
+}
+
+// ClearRedirectURI clears the value of the "redirect_uri" field.
+func (u *OAuthProviderConfigUpsertBulk) ClearRedirectURI() *OAuthProviderConfigUpsertBulk {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.ClearRedirectURI()
+	})
+}
+
+// SetScopes sets the "scopes" field.
+func (u *OAuthProviderConfigUpsertBulk) SetScopes(v []string) *OAuthProviderConfigUpsertBulk {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.SetScopes(v)
+	})
+}
+
+// UpdateScopes sets the "scopes" field to the value that was provided on create.
+func (u *OAuthProviderConfigUpsertBulk) UpdateScopes() *OAuthProviderConfigUpsertBulk {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.UpdateScopes()
+	})
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (u *OAuthProviderConfigUpsertBulk) ClearScopes() *OAuthProviderConfigUpsertBulk {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.ClearScopes()
+	})
+}
+
+// SetAutoProvision sets the "auto_provision" field.
+func (u *OAuthProviderConfigUpsertBulk) SetAutoProvision(v bool) *OAuthProviderConfigUpsertBulk {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.SetAutoProvision(v)
+	})
+}
+
+// UpdateAutoProvision sets the "auto_provision" field to the value that was provided on create.
+func (u *OAuthProviderConfigUpsertBulk) UpdateAutoProvision() *OAuthProviderConfigUpsertBulk {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.UpdateAutoProvision()
+	})
+}
+
+// ClearAutoProvision clears the value of the "auto_provision" field.
+func (u *OAuthProviderConfigUpsertBulk) ClearAutoProvision() *OAuthProviderConfigUpsertBulk {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.ClearAutoProvision()
+	})
+}
+
+// SetDefaultRoleIds sets the "default_role_ids" field.
+func (u *OAuthProviderConfigUpsertBulk) SetDefaultRoleIds(v []uint32) *OAuthProviderConfigUpsertBulk {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.SetDefaultRoleIds(v)
+	})
+}
+
+// UpdateDefaultRoleIds sets the "default_role_ids" field to the value that was provided on create.
+func (u *OAuthProviderConfigUpsertBulk) UpdateDefaultRoleIds() *OAuthProviderConfigUpsertBulk {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.UpdateDefaultRoleIds()
+	})
+}
+
+// ClearDefaultRoleIds clears the value of the "default_role_ids" field.
+func (u *OAuthProviderConfigUpsertBulk) ClearDefaultRoleIds() *OAuthProviderConfigUpsertBulk {
+	return u.Update(func(s *OAuthProviderConfigUpsert) {
+		s.ClearDefaultRoleIds()
+	})
+}
+
+// Exec executes the query.
+func (u *OAuthProviderConfigUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the OAuthProviderConfigCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for OAuthProviderConfigCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *OAuthProviderConfigUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1265 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-wind-admin/app/admin/service/internal/data/ent/oauthproviderconfig"
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// OAuthProviderConfigUpdate is the builder for updating OAuthProviderConfig entities.
+type OAuthProviderConfigUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *OAuthProviderConfigMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the OAuthProviderConfigUpdate builder.
+func (_u *OAuthProviderConfigUpdate) Where(ps ...predicate.OAuthProviderConfig) *OAuthProviderConfigUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *OAuthProviderConfigUpdate) SetUpdatedAt(v time.Time) *OAuthProviderConfigUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *OAuthProviderConfigUpdate) SetNillableUpdatedAt(v *time.Time) *OAuthProviderConfigUpdate {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *OAuthProviderConfigUpdate) ClearUpdatedAt() *OAuthProviderConfigUpdate {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *OAuthProviderConfigUpdate) SetDeletedAt(v time.Time) *OAuthProviderConfigUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *OAuthProviderConfigUpdate) SetNillableDeletedAt(v *time.Time) *OAuthProviderConfigUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *OAuthProviderConfigUpdate) ClearDeletedAt() *OAuthProviderConfigUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *OAuthProviderConfigUpdate) SetCreatedBy(v uint32) *OAuthProviderConfigUpdate {
+	_u.mutation.ResetCreatedBy()
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *OAuthProviderConfigUpdate) SetNillableCreatedBy(v *uint32) *OAuthProviderConfigUpdate {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// AddCreatedBy adds value to the "created_by" field.
+func (_u *OAuthProviderConfigUpdate) AddCreatedBy(v int32) *OAuthProviderConfigUpdate {
+	_u.mutation.AddCreatedBy(v)
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *OAuthProviderConfigUpdate) ClearCreatedBy() *OAuthProviderConfigUpdate {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_u *OAuthProviderConfigUpdate) SetUpdatedBy(v uint32) *OAuthProviderConfigUpdate {
+	_u.mutation.ResetUpdatedBy()
+	_u.mutation.SetUpdatedBy(v)
+	return _u
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_u *OAuthProviderConfigUpdate) SetNillableUpdatedBy(v *uint32) *OAuthProviderConfigUpdate {
+	if v != nil {
+		_u.SetUpdatedBy(*v)
+	}
+	return _u
+}
+
+// AddUpdatedBy adds value to the "updated_by" field.
+func (_u *OAuthProviderConfigUpdate) AddUpdatedBy(v int32) *OAuthProviderConfigUpdate {
+	_u.mutation.AddUpdatedBy(v)
+	return _u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (_u *OAuthProviderConfigUpdate) ClearUpdatedBy() *OAuthProviderConfigUpdate {
+	_u.mutation.ClearUpdatedBy()
+	return _u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_u *OAuthProviderConfigUpdate) SetDeletedBy(v uint32) *OAuthProviderConfigUpdate {
+	_u.mutation.ResetDeletedBy()
+	_u.mutation.SetDeletedBy(v)
+	return _u
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_u *OAuthProviderConfigUpdate) SetNillableDeletedBy(v *uint32) *OAuthProviderConfigUpdate {
+	if v != nil {
+		_u.SetDeletedBy(*v)
+	}
+	return _u
+}
+
+// AddDeletedBy adds value to the "deleted_by" field.
+func (_u *OAuthProviderConfigUpdate) AddDeletedBy(v int32) *OAuthProviderConfigUpdate {
+	_u.mutation.AddDeletedBy(v)
+	return _u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (_u *OAuthProviderConfigUpdate) ClearDeletedBy() *OAuthProviderConfigUpdate {
+	_u.mutation.ClearDeletedBy()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *OAuthProviderConfigUpdate) SetStatus(v oauthproviderconfig.Status) *OAuthProviderConfigUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *OAuthProviderConfigUpdate) SetNillableStatus(v *oauthproviderconfig.Status) *OAuthProviderConfigUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (_u *OAuthProviderConfigUpdate) SetSortOrder(v uint32) *OAuthProviderConfigUpdate {
+	_u.mutation.ResetSortOrder()
+	_u.mutation.SetSortOrder(v)
+	return _u
+}
+
+// SetNillableSortOrder sets the "sort_order" field if the given value is not nil.
+func (_u *OAuthProviderConfigUpdate) SetNillableSortOrder(v *uint32) *OAuthProviderConfigUpdate {
+	if v != nil {
+		_u.SetSortOrder(*v)
+	}
+	return _u
+}
+
+// AddSortOrder adds value to the "sort_order" field.
+func (_u *OAuthProviderConfigUpdate) AddSortOrder(v int32) *OAuthProviderConfigUpdate {
+	_u.mutation.AddSortOrder(v)
+	return _u
+}
+
+// ClearSortOrder clears the value of the "sort_order" field.
+func (_u *OAuthProviderConfigUpdate) ClearSortOrder() *OAuthProviderConfigUpdate {
+	_u.mutation.ClearSortOrder()
+	return _u
+}
+
+// SetDisplayName sets the "display_name" field.
+func (_u *OAuthProviderConfigUpdate) SetDisplayName(v string) *OAuthProviderConfigUpdate {
+	_u.mutation.SetDisplayName(v)
+	return _u
+}
+
+// SetNillableDisplayName sets the "display_name" field if the given value is not nil.
+func (_u *OAuthProviderConfigUpdate) SetNillableDisplayName(v *string) *OAuthProviderConfigUpdate {
+	if v != nil {
+		_u.SetDisplayName(*v)
+	}
+	return _u
+}
+
+// ClearDisplayName clears the value of the "display_name" field.
+func (_u *OAuthProviderConfigUpdate) ClearDisplayName() *OAuthProviderConfigUpdate {
+	_u.mutation.ClearDisplayName()
+	return _u
+}
+
+// SetClientID sets the "client_id" field.
+func (_u *OAuthProviderConfigUpdate) SetClientID(v string) *OAuthProviderConfigUpdate {
+	_u.mutation.SetClientID(v)
+	return _u
+}
+
+// SetNillableClientID sets the "client_id" field if the given value is not nil.
+func (_u *OAuthProviderConfigUpdate) SetNillableClientID(v *string) *OAuthProviderConfigUpdate {
+	if v != nil {
+		_u.SetClientID(*v)
+	}
+	return _u
+}
+
+// ClearClientID clears the value of the "client_id" field.
+func (_u *OAuthProviderConfigUpdate) ClearClientID() *OAuthProviderConfigUpdate {
+	_u.mutation.ClearClientID()
+	return _u
+}
+
+// SetClientSecret sets the "client_secret" field.
+func (_u *OAuthProviderConfigUpdate) SetClientSecret(v string) *OAuthProviderConfigUpdate {
+	_u.mutation.SetClientSecret(v)
+	return _u
+}
+
+// SetNillableClientSecret sets the "client_secret" field if the given value is not nil.
+func (_u *OAuthProviderConfigUpdate) SetNillableClientSecret(v *string) *OAuthProviderConfigUpdate {
+	if v != nil {
+		_u.SetClientSecret(*v)
+	}
+	return _u
+}
+
+// ClearClientSecret clears the value of the "client_secret" field.
+func (_u *OAuthProviderConfigUpdate) ClearClientSecret() *OAuthProviderConfigUpdate {
+	_u.mutation.ClearClientSecret()
+	return _u
+}
+
+// SetAuthURL sets the "auth_url" field.
+func (_u *OAuthProviderConfigUpdate) SetAuthURL(v string) *OAuthProviderConfigUpdate {
+	_u.mutation.SetAuthURL(v)
+	return _u
+}
+
+// SetNillableAuthURL sets the "auth_url" field if the given value is not nil.
+func (_u *OAuthProviderConfigUpdate) SetNillableAuthURL(v *string) *OAuthProviderConfigUpdate {
+	if v != nil {
+		_u.SetAuthURL(*v)
+	}
+	return _u
+}
+
+// ClearAuthURL clears the value of the "auth_url" field.
+func (_u *OAuthProviderConfigUpdate) ClearAuthURL() *OAuthProviderConfigUpdate {
+	_u.mutation.ClearAuthURL()
+	return _u
+}
+
+// SetTokenURL sets the "token_url" field.
+func (_u *OAuthProviderConfigUpdate) SetTokenURL(v string) *OAuthProviderConfigUpdate {
+	_u.mutation.SetTokenURL(v)
+	return _u
+}
+
+// SetNillableTokenURL sets the "token_url" field if the given value is not nil.
+func (_u *OAuthProviderConfigUpdate) SetNillableTokenURL(v *string) *OAuthProviderConfigUpdate {
+	if v != nil {
+		_u.SetTokenURL(*v)
+	}
+	return _u
+}
+
+// ClearTokenURL clears the value of the "token_url" field.
+func (_u *OAuthProviderConfigUpdate) ClearTokenURL() *OAuthProviderConfigUpdate {
+	_u.mutation.ClearTokenURL()
+	return _u
+}
+
+// SetUserinfoURL sets the "userinfo_url" field.
+func (_u *OAuthProviderConfigUpdate) SetUserinfoURL(v string) *OAuthProviderConfigUpdate {
+	_u.mutation.SetUserinfoURL(v)
+	return _u
+}
+
+// SetNillableUserinfoURL sets the "userinfo_url" field if the given value is not nil.
+func (_u *OAuthProviderConfigUpdate) SetNillableUserinfoURL(v *string) *OAuthProviderConfigUpdate {
+	if v != nil {
+		_u.SetUserinfoURL(*v)
+	}
+	return _u
+}
+
+// ClearUserinfoURL clears the value of the "userinfo_url" field.
+func (_u *OAuthProviderConfigUpdate) ClearUserinfoURL() *OAuthProviderConfigUpdate {
+	_u.mutation.ClearUserinfoURL()
+	return _u
+}
+
+// SetRedirectURI sets the "redirect_uri" field.
+func (_u *OAuthProviderConfigUpdate) SetRedirectURI(v string) *OAuthProviderConfigUpdate {
+	_u.mutation.SetRedirectURI(v)
+	return _u
+}
+
+// SetNillableRedirectURI sets the "redirect_uri" field if the given value is not nil.
+func (_u *OAuthProviderConfigUpdate) SetNillableRedirectURI(v *string) *OAuthProviderConfigUpdate {
+	if v != nil {
+		_u.SetRedirectURI(*v)
+	}
+	return _u
+}
+
+// ClearRedirectURI clears the value of the "redirect_uri" field.
+func (_u *OAuthProviderConfigUpdate) ClearRedirectURI() *OAuthProviderConfigUpdate {
+	_u.mutation.ClearRedirectURI()
+	return _u
+}
+
+// SetScopes sets the "scopes" field.
+func (_u *OAuthProviderConfigUpdate) SetScopes(v []string) *OAuthProviderConfigUpdate {
+	_u.mutation.SetScopes(v)
+	return _u
+}
+
+// AppendScopes appends value to the "scopes" field.
+func (_u *OAuthProviderConfigUpdate) AppendScopes(v []string) *OAuthProviderConfigUpdate {
+	_u.mutation.AppendScopes(v)
+	return _u
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (_u *OAuthProviderConfigUpdate) ClearScopes() *OAuthProviderConfigUpdate {
+	_u.mutation.ClearScopes()
+	return _u
+}
+
+// SetAutoProvision sets the "auto_provision" field.
+func (_u *OAuthProviderConfigUpdate) SetAutoProvision(v bool) *OAuthProviderConfigUpdate {
+	_u.mutation.SetAutoProvision(v)
+	return _u
+}
+
+// SetNillableAutoProvision sets the "auto_provision" field if the given value is not nil.
+func (_u *OAuthProviderConfigUpdate) SetNillableAutoProvision(v *bool) *OAuthProviderConfigUpdate {
+	if v != nil {
+		_u.SetAutoProvision(*v)
+	}
+	return _u
+}
+
+// ClearAutoProvision clears the value of the "auto_provision" field.
+func (_u *OAuthProviderConfigUpdate) ClearAutoProvision() *OAuthProviderConfigUpdate {
+	_u.mutation.ClearAutoProvision()
+	return _u
+}
+
+// SetDefaultRoleIds sets the "default_role_ids" field.
+func (_u *OAuthProviderConfigUpdate) SetDefaultRoleIds(v []uint32) *OAuthProviderConfigUpdate {
+	_u.mutation.SetDefaultRoleIds(v)
+	return _u
+}
+
+// AppendDefaultRoleIds appends value to the "default_role_ids" field.
+func (_u *OAuthProviderConfigUpdate) AppendDefaultRoleIds(v []uint32) *OAuthProviderConfigUpdate {
+	_u.mutation.AppendDefaultRoleIds(v)
+	return _u
+}
+
+// ClearDefaultRoleIds clears the value of the "default_role_ids" field.
+func (_u *OAuthProviderConfigUpdate) ClearDefaultRoleIds() *OAuthProviderConfigUpdate {
+	_u.mutation.ClearDefaultRoleIds()
+	return _u
+}
+
+// Mutation returns the OAuthProviderConfigMutation object of the builder.
+func (_u *OAuthProviderConfigUpdate) Mutation() *OAuthProviderConfigMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *OAuthProviderConfigUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OAuthProviderConfigUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *OAuthProviderConfigUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OAuthProviderConfigUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *OAuthProviderConfigUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := oauthproviderconfig.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "OAuthProviderConfig.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DisplayName(); ok {
+		if err := oauthproviderconfig.DisplayNameValidator(v); err != nil {
+			return &ValidationError{Name: "display_name", err: fmt.Errorf(`ent: validator failed for field "OAuthProviderConfig.display_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ClientID(); ok {
+		if err := oauthproviderconfig.ClientIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_id", err: fmt.Errorf(`ent: validator failed for field "OAuthProviderConfig.client_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ClientSecret(); ok {
+		if err := oauthproviderconfig.ClientSecretValidator(v); err != nil {
+			return &ValidationError{Name: "client_secret", err: fmt.Errorf(`ent: validator failed for field "OAuthProviderConfig.client_secret": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AuthURL(); ok {
+		if err := oauthproviderconfig.AuthURLValidator(v); err != nil {
+			return &ValidationError{Name: "auth_url", err: fmt.Errorf(`ent: validator failed for field "OAuthProviderConfig.auth_url": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TokenURL(); ok {
+		if err := oauthproviderconfig.TokenURLValidator(v); err != nil {
+			return &ValidationError{Name: "token_url", err: fmt.Errorf(`ent: validator failed for field "OAuthProviderConfig.token_url": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UserinfoURL(); ok {
+		if err := oauthproviderconfig.UserinfoURLValidator(v); err != nil {
+			return &ValidationError{Name: "userinfo_url", err: fmt.Errorf(`ent: validator failed for field "OAuthProviderConfig.userinfo_url": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RedirectURI(); ok {
+		if err := oauthproviderconfig.RedirectURIValidator(v); err != nil {
+			return &ValidationError{Name: "redirect_uri", err: fmt.Errorf(`ent: validator failed for field "OAuthProviderConfig.redirect_uri": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *OAuthProviderConfigUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *OAuthProviderConfigUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *OAuthProviderConfigUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(oauthproviderconfig.Table, oauthproviderconfig.Columns, sqlgraph.NewFieldSpec(oauthproviderconfig.FieldID, field.TypeUint32))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(oauthproviderconfig.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(oauthproviderconfig.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(oauthproviderconfig.FieldUpdatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(oauthproviderconfig.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(oauthproviderconfig.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(oauthproviderconfig.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(oauthproviderconfig.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(oauthproviderconfig.FieldCreatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UpdatedBy(); ok {
+		_spec.SetField(oauthproviderconfig.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedBy(); ok {
+		_spec.AddField(oauthproviderconfig.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdatedByCleared() {
+		_spec.ClearField(oauthproviderconfig.FieldUpdatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.DeletedBy(); ok {
+		_spec.SetField(oauthproviderconfig.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedBy(); ok {
+		_spec.AddField(oauthproviderconfig.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.DeletedByCleared() {
+		_spec.ClearField(oauthproviderconfig.FieldDeletedBy, field.TypeUint32)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(oauthproviderconfig.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(oauthproviderconfig.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.SortOrder(); ok {
+		_spec.SetField(oauthproviderconfig.FieldSortOrder, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedSortOrder(); ok {
+		_spec.AddField(oauthproviderconfig.FieldSortOrder, field.TypeUint32, value)
+	}
+	if _u.mutation.SortOrderCleared() {
+		_spec.ClearField(oauthproviderconfig.FieldSortOrder, field.TypeUint32)
+	}
+	if _u.mutation.ProviderCleared() {
+		_spec.ClearField(oauthproviderconfig.FieldProvider, field.TypeString)
+	}
+	if value, ok := _u.mutation.DisplayName(); ok {
+		_spec.SetField(oauthproviderconfig.FieldDisplayName, field.TypeString, value)
+	}
+	if _u.mutation.DisplayNameCleared() {
+		_spec.ClearField(oauthproviderconfig.FieldDisplayName, field.TypeString)
+	}
+	if value, ok := _u.mutation.ClientID(); ok {
+		_spec.SetField(oauthproviderconfig.FieldClientID, field.TypeString, value)
+	}
+	if _u.mutation.ClientIDCleared() {
+		_spec.ClearField(oauthproviderconfig.FieldClientID, field.TypeString)
+	}
+	if value, ok := _u.mutation.ClientSecret(); ok {
+		_spec.SetField(oauthproviderconfig.FieldClientSecret, field.TypeString, value)
+	}
+	if _u.mutation.ClientSecretCleared() {
+		_spec.ClearField(oauthproviderconfig.FieldClientSecret, field.TypeString)
+	}
+	if value, ok := _u.mutation.AuthURL(); ok {
+		_spec.SetField(oauthproviderconfig.FieldAuthURL, field.TypeString, value)
+	}
+	if _u.mutation.AuthURLCleared() {
+		_spec.ClearField(oauthproviderconfig.FieldAuthURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.TokenURL(); ok {
+		_spec.SetField(oauthproviderconfig.FieldTokenURL, field.TypeString, value)
+	}
+	if _u.mutation.TokenURLCleared() {
+		_spec.ClearField(oauthproviderconfig.FieldTokenURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.UserinfoURL(); ok {
+		_spec.SetField(oauthproviderconfig.FieldUserinfoURL, field.TypeString, value)
+	}
+	if _u.mutation.UserinfoURLCleared() {
+		_spec.ClearField(oauthproviderconfig.FieldUserinfoURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.RedirectURI(); ok {
+		_spec.SetField(oauthproviderconfig.FieldRedirectURI, field.TypeString, value)
+	}
+	if _u.mutation.RedirectURICleared() {
+		_spec.ClearField(oauthproviderconfig.FieldRedirectURI, field.TypeString)
+	}
+	if value, ok := _u.mutation.Scopes(); ok {
+		_spec.SetField(oauthproviderconfig.FieldScopes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauthproviderconfig.FieldScopes, value)
+		})
+	}
+	if _u.mutation.ScopesCleared() {
+		_spec.ClearField(oauthproviderconfig.FieldScopes, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AutoProvision(); ok {
+		_spec.SetField(oauthproviderconfig.FieldAutoProvision, field.TypeBool, value)
+	}
+	if _u.mutation.AutoProvisionCleared() {
+		_spec.ClearField(oauthproviderconfig.FieldAutoProvision, field.TypeBool)
+	}
+	if value, ok := _u.mutation.DefaultRoleIds(); ok {
+		_spec.SetField(oauthproviderconfig.FieldDefaultRoleIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedDefaultRoleIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauthproviderconfig.FieldDefaultRoleIds, value)
+		})
+	}
+	if _u.mutation.DefaultRoleIdsCleared() {
+		_spec.ClearField(oauthproviderconfig.FieldDefaultRoleIds, field.TypeJSON)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{oauthproviderconfig.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// OAuthProviderConfigUpdateOne is the builder for updating a single OAuthProviderConfig entity.
+type OAuthProviderConfigUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *OAuthProviderConfigMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *OAuthProviderConfigUpdateOne) SetUpdatedAt(v time.Time) *OAuthProviderConfigUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *OAuthProviderConfigUpdateOne) SetNillableUpdatedAt(v *time.Time) *OAuthProviderConfigUpdateOne {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *OAuthProviderConfigUpdateOne) ClearUpdatedAt() *OAuthProviderConfigUpdateOne {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *OAuthProviderConfigUpdateOne) SetDeletedAt(v time.Time) *OAuthProviderConfigUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *OAuthProviderConfigUpdateOne) SetNillableDeletedAt(v *time.Time) *OAuthProviderConfigUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *OAuthProviderConfigUpdateOne) ClearDeletedAt() *OAuthProviderConfigUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *OAuthProviderConfigUpdateOne) SetCreatedBy(v uint32) *OAuthProviderConfigUpdateOne {
+	_u.mutation.ResetCreatedBy()
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *OAuthProviderConfigUpdateOne) SetNillableCreatedBy(v *uint32) *OAuthProviderConfigUpdateOne {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// AddCreatedBy adds value to the "created_by" field.
+func (_u *OAuthProviderConfigUpdateOne) AddCreatedBy(v int32) *OAuthProviderConfigUpdateOne {
+	_u.mutation.AddCreatedBy(v)
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *OAuthProviderConfigUpdateOne) ClearCreatedBy() *OAuthProviderConfigUpdateOne {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_u *OAuthProviderConfigUpdateOne) SetUpdatedBy(v uint32) *OAuthProviderConfigUpdateOne {
+	_u.mutation.ResetUpdatedBy()
+	_u.mutation.SetUpdatedBy(v)
+	return _u
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_u *OAuthProviderConfigUpdateOne) SetNillableUpdatedBy(v *uint32) *OAuthProviderConfigUpdateOne {
+	if v != nil {
+		_u.SetUpdatedBy(*v)
+	}
+	return _u
+}
+
+// AddUpdatedBy adds value to the "updated_by" field.
+func (_u *OAuthProviderConfigUpdateOne) AddUpdatedBy(v int32) *OAuthProviderConfigUpdateOne {
+	_u.mutation.AddUpdatedBy(v)
+	return _u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (_u *OAuthProviderConfigUpdateOne) ClearUpdatedBy() *OAuthProviderConfigUpdateOne {
+	_u.mutation.ClearUpdatedBy()
+	return _u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_u *OAuthProviderConfigUpdateOne) SetDeletedBy(v uint32) *OAuthProviderConfigUpdateOne {
+	_u.mutation.ResetDeletedBy()
+	_u.mutation.SetDeletedBy(v)
+	return _u
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_u *OAuthProviderConfigUpdateOne) SetNillableDeletedBy(v *uint32) *OAuthProviderConfigUpdateOne {
+	if v != nil {
+		_u.SetDeletedBy(*v)
+	}
+	return _u
+}
+
+// AddDeletedBy adds value to the "deleted_by" field.
+func (_u *OAuthProviderConfigUpdateOne) AddDeletedBy(v int32) *OAuthProviderConfigUpdateOne {
+	_u.mutation.AddDeletedBy(v)
+	return _u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (_u *OAuthProviderConfigUpdateOne) ClearDeletedBy() *OAuthProviderConfigUpdateOne {
+	_u.mutation.ClearDeletedBy()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *OAuthProviderConfigUpdateOne) SetStatus(v oauthproviderconfig.Status) *OAuthProviderConfigUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *OAuthProviderConfigUpdateOne) SetNillableStatus(v *oauthproviderconfig.Status) *OAuthProviderConfigUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (_u *OAuthProviderConfigUpdateOne) SetSortOrder(v uint32) *OAuthProviderConfigUpdateOne {
+	_u.mutation.ResetSortOrder()
+	_u.mutation.SetSortOrder(v)
+	return _u
+}
+
+// SetNillableSortOrder sets the "sort_order" field if the given value is not nil.
+func (_u *OAuthProviderConfigUpdateOne) SetNillableSortOrder(v *uint32) *OAuthProviderConfigUpdateOne {
+	if v != nil {
+		_u.SetSortOrder(*v)
+	}
+	return _u
+}
+
+// AddSortOrder adds value to the "sort_order" field.
+func (_u *OAuthProviderConfigUpdateOne) AddSortOrder(v int32) *OAuthProviderConfigUpdateOne {
+	_u.mutation.AddSortOrder(v)
+	return _u
+}
+
+// ClearSortOrder clears the value of the "sort_order" field.
+func (_u *OAuthProviderConfigUpdateOne) ClearSortOrder() *OAuthProviderConfigUpdateOne {
+	_u.mutation.ClearSortOrder()
+	return _u
+}
+
+// SetDisplayName sets the "display_name" field.
+func (_u *OAuthProviderConfigUpdateOne) SetDisplayName(v string) *OAuthProviderConfigUpdateOne {
+	_u.mutation.SetDisplayName(v)
+	return _u
+}
+
+// SetNillableDisplayName sets the "display_name" field if the given value is not nil.
+func (_u *OAuthProviderConfigUpdateOne) SetNillableDisplayName(v *string) *OAuthProviderConfigUpdateOne {
+	if v != nil {
+		_u.SetDisplayName(*v)
+	}
+	return _u
+}
+
+// ClearDisplayName clears the value of the "display_name" field.
+func (_u *OAuthProviderConfigUpdateOne) ClearDisplayName() *OAuthProviderConfigUpdateOne {
+	_u.mutation.ClearDisplayName()
+	return _u
+}
+
+// SetClientID sets the "client_id" field.
+func (_u *OAuthProviderConfigUpdateOne) SetClientID(v string) *OAuthProviderConfigUpdateOne {
+	_u.mutation.SetClientID(v)
+	return _u
+}
+
+// SetNillableClientID sets the "client_id" field if the given value is not nil.
+func (_u *OAuthProviderConfigUpdateOne) SetNillableClientID(v *string) *OAuthProviderConfigUpdateOne {
+	if v != nil {
+		_u.SetClientID(*v)
+	}
+	return _u
+}
+
+// ClearClientID clears the value of the "client_id" field.
+func (_u *OAuthProviderConfigUpdateOne) ClearClientID() *OAuthProviderConfigUpdateOne {
+	_u.mutation.ClearClientID()
+	return _u
+}
+
+// SetClientSecret sets the "client_secret" field.
+func (_u *OAuthProviderConfigUpdateOne) SetClientSecret(v string) *OAuthProviderConfigUpdateOne {
+	_u.mutation.SetClientSecret(v)
+	return _u
+}
+
+// SetNillableClientSecret sets the "client_secret" field if the given value is not nil.
+func (_u *OAuthProviderConfigUpdateOne) SetNillableClientSecret(v *string) *OAuthProviderConfigUpdateOne {
+	if v != nil {
+		_u.SetClientSecret(*v)
+	}
+	return _u
+}
+
+// ClearClientSecret clears the value of the "client_secret" field.
+func (_u *OAuthProviderConfigUpdateOne) ClearClientSecret() *OAuthProviderConfigUpdateOne {
+	_u.mutation.ClearClientSecret()
+	return _u
+}
+
+// SetAuthURL sets the "auth_url" field.
+func (_u *OAuthProviderConfigUpdateOne) SetAuthURL(v string) *OAuthProviderConfigUpdateOne {
+	_u.mutation.SetAuthURL(v)
+	return _u
+}
+
+// SetNillableAuthURL sets the "auth_url" field if the given value is not nil.
+func (_u *OAuthProviderConfigUpdateOne) SetNillableAuthURL(v *string) *OAuthProviderConfigUpdateOne {
+	if v != nil {
+		_u.SetAuthURL(*v)
+	}
+	return _u
+}
+
+// ClearAuthURL clears the value of the "auth_url" field.
+func (_u *OAuthProviderConfigUpdateOne) ClearAuthURL() *OAuthProviderConfigUpdateOne {
+	_u.mutation.ClearAuthURL()
+	return _u
+}
+
+// SetTokenURL sets the "token_url" field.
+func (_u *OAuthProviderConfigUpdateOne) SetTokenURL(v string) *OAuthProviderConfigUpdateOne {
+	_u.mutation.SetTokenURL(v)
+	return _u
+}
+
+// SetNillableTokenURL sets the "token_url" field if the given value is not nil.
+func (_u *OAuthProviderConfigUpdateOne) SetNillableTokenURL(v *string) *OAuthProviderConfigUpdateOne {
+	if v != nil {
+		_u.SetTokenURL(*v)
+	}
+	return _u
+}
+
+// ClearTokenURL clears the value of the "token_url" field.
+func (_u *OAuthProviderConfigUpdateOne) ClearTokenURL() *OAuthProviderConfigUpdateOne {
+	_u.mutation.ClearTokenURL()
+	return _u
+}
+
+// SetUserinfoURL sets the "userinfo_url" field.
+func (_u *OAuthProviderConfigUpdateOne) SetUserinfoURL(v string) *OAuthProviderConfigUpdateOne {
+	_u.mutation.SetUserinfoURL(v)
+	return _u
+}
+
+// SetNillableUserinfoURL sets the "userinfo_url" field if the given value is not nil.
+func (_u *OAuthProviderConfigUpdateOne) SetNillableUserinfoURL(v *string) *OAuthProviderConfigUpdateOne {
+	if v != nil {
+		_u.SetUserinfoURL(*v)
+	}
+	return _u
+}
+
+// ClearUserinfoURL clears the value of the "userinfo_url" field.
+func (_u *OAuthProviderConfigUpdateOne) ClearUserinfoURL() *OAuthProviderConfigUpdateOne {
+	_u.mutation.ClearUserinfoURL()
+	return _u
+}
+
+// SetRedirectURI sets the "redirect_uri" field.
+func (_u *OAuthProviderConfigUpdateOne) SetRedirectURI(v string) *OAuthProviderConfigUpdateOne {
+	_u.mutation.SetRedirectURI(v)
+	return _u
+}
+
+// SetNillableRedirectURI sets the "redirect_uri" field if the given value is not nil.
+func (_u *OAuthProviderConfigUpdateOne) SetNillableRedirectURI(v *string) *OAuthProviderConfigUpdateOne {
+	if v != nil {
+		_u.SetRedirectURI(*v)
+	}
+	return _u
+}
+
+// ClearRedirectURI clears the value of the "redirect_uri" field.
+func (_u *OAuthProviderConfigUpdateOne) ClearRedirectURI() *OAuthProviderConfigUpdateOne {
+	_u.mutation.ClearRedirectURI()
+	return _u
+}
+
+// SetScopes sets the "scopes" field.
+func (_u *OAuthProviderConfigUpdateOne) SetScopes(v []string) *OAuthProviderConfigUpdateOne {
+	_u.mutation.SetScopes(v)
+	return _u
+}
+
+// AppendScopes appends value to the "scopes" field.
+func (_u *OAuthProviderConfigUpdateOne) AppendScopes(v []string) *OAuthProviderConfigUpdateOne {
+	_u.mutation.AppendScopes(v)
+	return _u
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (_u *OAuthProviderConfigUpdateOne) ClearScopes() *OAuthProviderConfigUpdateOne {
+	_u.mutation.ClearScopes()
+	return _u
+}
+
+// SetAutoProvision sets the "auto_provision" field.
+func (_u *OAuthProviderConfigUpdateOne) SetAutoProvision(v bool) *OAuthProviderConfigUpdateOne {
+	_u.mutation.SetAutoProvision(v)
+	return _u
+}
+
+// SetNillableAutoProvision sets the "auto_provision" field if the given value is not nil.
+func (_u *OAuthProviderConfigUpdateOne) SetNillableAutoProvision(v *bool) *OAuthProviderConfigUpdateOne {
+	if v != nil {
+		_u.SetAutoProvision(*v)
+	}
+	return _u
+}
+
+// ClearAutoProvision clears the value of the "auto_provision" field.
+func (_u *OAuthProviderConfigUpdateOne) ClearAutoProvision() *OAuthProviderConfigUpdateOne {
+	_u.mutation.ClearAutoProvision()
+	return _u
+}
+
+// SetDefaultRoleIds sets the "default_role_ids" field.
+func (_u *OAuthProviderConfigUpdateOne) SetDefaultRoleIds(v []uint32) *OAuthProviderConfigUpdateOne {
+	_u.mutation.SetDefaultRoleIds(v)
+	return _u
+}
+
+// AppendDefaultRoleIds appends value to the "default_role_ids" field.
+func (_u *OAuthProviderConfigUpdateOne) AppendDefaultRoleIds(v []uint32) *OAuthProviderConfigUpdateOne {
+	_u.mutation.AppendDefaultRoleIds(v)
+	return _u
+}
+
+// ClearDefaultRoleIds clears the value of the "default_role_ids" field.
+func (_u *OAuthProviderConfigUpdateOne) ClearDefaultRoleIds() *OAuthProviderConfigUpdateOne {
+	_u.mutation.ClearDefaultRoleIds()
+	return _u
+}
+
+// Mutation returns the OAuthProviderConfigMutation object of the builder.
+func (_u *OAuthProviderConfigUpdateOne) Mutation() *OAuthProviderConfigMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the OAuthProviderConfigUpdate builder.
+func (_u *OAuthProviderConfigUpdateOne) Where(ps ...predicate.OAuthProviderConfig) *OAuthProviderConfigUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *OAuthProviderConfigUpdateOne) Select(field string, fields ...string) *OAuthProviderConfigUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated OAuthProviderConfig entity.
+func (_u *OAuthProviderConfigUpdateOne) Save(ctx context.Context) (*OAuthProviderConfig, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OAuthProviderConfigUpdateOne) SaveX(ctx context.Context) *OAuthProviderConfig {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *OAuthProviderConfigUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OAuthProviderConfigUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *OAuthProviderConfigUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := oauthproviderconfig.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "OAuthProviderConfig.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DisplayName(); ok {
+		if err := oauthproviderconfig.DisplayNameValidator(v); err != nil {
+			return &ValidationError{Name: "display_name", err: fmt.Errorf(`ent: validator failed for field "OAuthProviderConfig.display_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ClientID(); ok {
+		if err := oauthproviderconfig.ClientIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_id", err: fmt.Errorf(`ent: validator failed for field "OAuthProviderConfig.client_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ClientSecret(); ok {
+		if err := oauthproviderconfig.ClientSecretValidator(v); err != nil {
+			return &ValidationError{Name: "client_secret", err: fmt.Errorf(`ent: validator failed for field "OAuthProviderConfig.client_secret": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AuthURL(); ok {
+		if err := oauthproviderconfig.AuthURLValidator(v); err != nil {
+			return &ValidationError{Name: "auth_url", err: fmt.Errorf(`ent: validator failed for field "OAuthProviderConfig.auth_url": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TokenURL(); ok {
+		if err := oauthproviderconfig.TokenURLValidator(v); err != nil {
+			return &ValidationError{Name: "token_url", err: fmt.Errorf(`ent: validator failed for field "OAuthProviderConfig.token_url": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UserinfoURL(); ok {
+		if err := oauthproviderconfig.UserinfoURLValidator(v); err != nil {
+			return &ValidationError{Name: "userinfo_url", err: fmt.Errorf(`ent: validator failed for field "OAuthProviderConfig.userinfo_url": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RedirectURI(); ok {
+		if err := oauthproviderconfig.RedirectURIValidator(v); err != nil {
+			return &ValidationError{Name: "redirect_uri", err: fmt.Errorf(`ent: validator failed for field "OAuthProviderConfig.redirect_uri": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *OAuthProviderConfigUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *OAuthProviderConfigUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *OAuthProviderConfigUpdateOne) sqlSave(ctx context.Context) (_node *OAuthProviderConfig, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(oauthproviderconfig.Table, oauthproviderconfig.Columns, sqlgraph.NewFieldSpec(oauthproviderconfig.FieldID, field.TypeUint32))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "OAuthProviderConfig.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, oauthproviderconfig.FieldID)
+		for _, f := range fields {
+			if !oauthproviderconfig.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != oauthproviderconfig.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(oauthproviderconfig.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(oauthproviderconfig.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(oauthproviderconfig.FieldUpdatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(oauthproviderconfig.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(oauthproviderconfig.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(oauthproviderconfig.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(oauthproviderconfig.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(oauthproviderconfig.FieldCreatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UpdatedBy(); ok {
+		_spec.SetField(oauthproviderconfig.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedBy(); ok {
+		_spec.AddField(oauthproviderconfig.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdatedByCleared() {
+		_spec.ClearField(oauthproviderconfig.FieldUpdatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.DeletedBy(); ok {
+		_spec.SetField(oauthproviderconfig.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedBy(); ok {
+		_spec.AddField(oauthproviderconfig.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.DeletedByCleared() {
+		_spec.ClearField(oauthproviderconfig.FieldDeletedBy, field.TypeUint32)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(oauthproviderconfig.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(oauthproviderconfig.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.SortOrder(); ok {
+		_spec.SetField(oauthproviderconfig.FieldSortOrder, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedSortOrder(); ok {
+		_spec.AddField(oauthproviderconfig.FieldSortOrder, field.TypeUint32, value)
+	}
+	if _u.mutation.SortOrderCleared() {
+		_spec.ClearField(oauthproviderconfig.FieldSortOrder, field.TypeUint32)
+	}
+	if _u.mutation.ProviderCleared() {
+		_spec.ClearField(oauthproviderconfig.FieldProvider, field.TypeString)
+	}
+	if value, ok := _u.mutation.DisplayName(); ok {
+		_spec.SetField(oauthproviderconfig.FieldDisplayName, field.TypeString, value)
+	}
+	if _u.mutation.DisplayNameCleared() {
+		_spec.ClearField(oauthproviderconfig.FieldDisplayName, field.TypeString)
+	}
+	if value, ok := _u.mutation.ClientID(); ok {
+		_spec.SetField(oauthproviderconfig.FieldClientID, field.TypeString, value)
+	}
+	if _u.mutation.ClientIDCleared() {
+		_spec.ClearField(oauthproviderconfig.FieldClientID, field.TypeString)
+	}
+	if value, ok := _u.mutation.ClientSecret(); ok {
+		_spec.SetField(oauthproviderconfig.FieldClientSecret, field.TypeString, value)
+	}
+	if _u.mutation.ClientSecretCleared() {
+		_spec.ClearField(oauthproviderconfig.FieldClientSecret, field.TypeString)
+	}
+	if value, ok := _u.mutation.AuthURL(); ok {
+		_spec.SetField(oauthproviderconfig.FieldAuthURL, field.TypeString, value)
+	}
+	if _u.mutation.AuthURLCleared() {
+		_spec.ClearField(oauthproviderconfig.FieldAuthURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.TokenURL(); ok {
+		_spec.SetField(oauthproviderconfig.FieldTokenURL, field.TypeString, value)
+	}
+	if _u.mutation.TokenURLCleared() {
+		_spec.ClearField(oauthproviderconfig.FieldTokenURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.UserinfoURL(); ok {
+		_spec.SetField(oauthproviderconfig.FieldUserinfoURL, field.TypeString, value)
+	}
+	if _u.mutation.UserinfoURLCleared() {
+		_spec.ClearField(oauthproviderconfig.FieldUserinfoURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.RedirectURI(); ok {
+		_spec.SetField(oauthproviderconfig.FieldRedirectURI, field.TypeString, value)
+	}
+	if _u.mutation.RedirectURICleared() {
+		_spec.ClearField(oauthproviderconfig.FieldRedirectURI, field.TypeString)
+	}
+	if value, ok := _u.mutation.Scopes(); ok {
+		_spec.SetField(oauthproviderconfig.FieldScopes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauthproviderconfig.FieldScopes, value)
+		})
+	}
+	if _u.mutation.ScopesCleared() {
+		_spec.ClearField(oauthproviderconfig.FieldScopes, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AutoProvision(); ok {
+		_spec.SetField(oauthproviderconfig.FieldAutoProvision, field.TypeBool, value)
+	}
+	if _u.mutation.AutoProvisionCleared() {
+		_spec.ClearField(oauthproviderconfig.FieldAutoProvision, field.TypeBool)
+	}
+	if value, ok := _u.mutation.DefaultRoleIds(); ok {
+		_spec.SetField(oauthproviderconfig.FieldDefaultRoleIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedDefaultRoleIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauthproviderconfig.FieldDefaultRoleIds, value)
+		})
+	}
+	if _u.mutation.DefaultRoleIdsCleared() {
+		_spec.ClearField(oauthproviderconfig.FieldDefaultRoleIds, field.TypeJSON)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &OAuthProviderConfig{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{oauthproviderconfig.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -28,6 +28,10 @@ type OperationAuditLog struct {
 	UserID *uint32 `json:"user_id,omitempty"`
 	// 操作者账号名
 	Username *string `json:"username,omitempty"`
+	// 代登录操作人用户ID
+	ImpersonatorID *uint32 `json:"impersonator_id,omitempty"`
+	// 代登录操作人账号名
+	ImpersonatorName *string `json:"impersonator_name,omitempty"`
 	// 资源类型
 	ResourceType *string `json:"resource_type,omitempty"`
 	// 资源ID
@@ -70,9 +74,9 @@ func (*OperationAuditLog) scanValues(columns []string) ([]any, error) {
 			values[i] = new([]byte)
 		case operationauditlog.FieldSuccess:
 			values[i] = new(sql.NullBool)
-		case operationauditlog.FieldID, operationauditlog.FieldTenantID, operationauditlog.FieldUserID:
+		case operationauditlog.FieldID, operationauditlog.FieldTenantID, operationauditlog.FieldUserID, operationauditlog.FieldImpersonatorID:
 			values[i] = new(sql.NullInt64)
-		case operationauditlog.FieldUsername, operationauditlog.FieldResourceType, operationauditlog.FieldResourceID, operationauditlog.FieldAction, operationauditlog.FieldBeforeData, operationauditlog.FieldAfterData, operationauditlog.FieldSensitiveLevel, operationauditlog.FieldRequestID, operationauditlog.FieldTraceID, operationauditlog.FieldFailureReason, operationauditlog.FieldIPAddress, operationauditlog.FieldLogHash:
+		case operationauditlog.FieldUsername, operationauditlog.FieldImpersonatorName, operationauditlog.FieldResourceType, operationauditlog.FieldResourceID, operationauditlog.FieldAction, operationauditlog.FieldBeforeData, operationauditlog.FieldAfterData, operationauditlog.FieldSensitiveLevel, operationauditlog.FieldRequestID, operationauditlog.FieldTraceID, operationauditlog.FieldFailureReason, operationauditlog.FieldIPAddress, operationauditlog.FieldLogHash:
 			values[i] = new(sql.NullString)
 		case operationauditlog.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
@@ -125,6 +129,20 @@ func (_m *OperationAuditLog) assignValues(columns []string, values []any) error
 				_m.Username = new(string)
 				*_m.Username = value.String
 			}
+		case operationauditlog.FieldImpersonatorID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field impersonator_id", values[i])
+			} else if value.Valid {
+				_m.ImpersonatorID = new(uint32)
+				*_m.ImpersonatorID = uint32(value.Int64)
+			}
+		case operationauditlog.FieldImpersonatorName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field impersonator_name", values[i])
+			} else if value.Valid {
+				_m.ImpersonatorName = new(string)
+				*_m.ImpersonatorName = value.String
+			}
 		case operationauditlog.FieldResourceType:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field resource_type", values[i])
@@ -287,6 +305,16 @@ func (_m *OperationAuditLog) String() string {
 		builder.WriteString(*v)
 	}
 	builder.WriteString(", ")
+	if v := _m.ImpersonatorID; v != nil {
+		builder.WriteString("impersonator_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.ImpersonatorName; v != nil {
+		builder.WriteString("impersonator_name=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	if v := _m.ResourceType; v != nil {
 		builder.WriteString("resource_type=")
 		builder.WriteString(*v)
@@ -22,6 +22,10 @@
 	FieldUserID = "user_id"
 	// FieldUsername holds the string denoting the username field in the database.
 	FieldUsername = "username"
+	// FieldImpersonatorID holds the string denoting the impersonator_id field in the database.
+	FieldImpersonatorID = "impersonator_id"
+	// FieldImpersonatorName holds the string denoting the impersonator_name field in the database.
+	FieldImpersonatorName = "impersonator_name"
 	// FieldResourceType holds the string denoting the resource_type field in the database.
 	FieldResourceType = "resource_type"
 	// FieldResourceID holds the string denoting the resource_id field in the database.
@@ -63,6 +67,8 @@
 	FieldTenantID,
 	FieldUserID,
 	FieldUsername,
+	FieldImpersonatorID,
+	FieldImpersonatorName,
 	FieldResourceType,
 	FieldResourceID,
 	FieldAction,
@@ -187,6 +193,16 @@ func ByUsername(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUsername, opts...).ToFunc()
 }
 
+// ByImpersonatorID orders the results by the impersonator_id field.
+func ByImpersonatorID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldImpersonatorID, opts...).ToFunc()
+}
+
+// ByImpersonatorName orders the results by the impersonator_name field.
+func ByImpersonatorName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldImpersonatorName, opts...).ToFunc()
+}
+
 // ByResourceType orders the results by the resource_type field.
 func ByResourceType(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldResourceType, opts...).ToFunc()
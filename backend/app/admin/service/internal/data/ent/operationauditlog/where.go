@@ -74,6 +74,16 @@ func Username(v string) predicate.OperationAuditLog {
 	return predicate.OperationAuditLog(sql.FieldEQ(FieldUsername, v))
 }
 
+// ImpersonatorID applies equality check predicate on the "impersonator_id" field. It's identical to ImpersonatorIDEQ.
+func ImpersonatorID(v uint32) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldEQ(FieldImpersonatorID, v))
+}
+
+// ImpersonatorName applies equality check predicate on the "impersonator_name" field. It's identical to ImpersonatorNameEQ.
+func ImpersonatorName(v string) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldEQ(FieldImpersonatorName, v))
+}
+
 // ResourceType applies equality check predicate on the "resource_type" field. It's identical to ResourceTypeEQ.
 func ResourceType(v string) predicate.OperationAuditLog {
 	return predicate.OperationAuditLog(sql.FieldEQ(FieldResourceType, v))
@@ -354,6 +364,131 @@ func UsernameContainsFold(v string) predicate.OperationAuditLog {
 	return predicate.OperationAuditLog(sql.FieldContainsFold(FieldUsername, v))
 }
 
+// ImpersonatorIDEQ applies the EQ predicate on the "impersonator_id" field.
+func ImpersonatorIDEQ(v uint32) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldEQ(FieldImpersonatorID, v))
+}
+
+// ImpersonatorIDNEQ applies the NEQ predicate on the "impersonator_id" field.
+func ImpersonatorIDNEQ(v uint32) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldNEQ(FieldImpersonatorID, v))
+}
+
+// ImpersonatorIDIn applies the In predicate on the "impersonator_id" field.
+func ImpersonatorIDIn(vs ...uint32) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldIn(FieldImpersonatorID, vs...))
+}
+
+// ImpersonatorIDNotIn applies the NotIn predicate on the "impersonator_id" field.
+func ImpersonatorIDNotIn(vs ...uint32) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldNotIn(FieldImpersonatorID, vs...))
+}
+
+// ImpersonatorIDGT applies the GT predicate on the "impersonator_id" field.
+func ImpersonatorIDGT(v uint32) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldGT(FieldImpersonatorID, v))
+}
+
+// ImpersonatorIDGTE applies the GTE predicate on the "impersonator_id" field.
+func ImpersonatorIDGTE(v uint32) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldGTE(FieldImpersonatorID, v))
+}
+
+// ImpersonatorIDLT applies the LT predicate on the "impersonator_id" field.
+func ImpersonatorIDLT(v uint32) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldLT(FieldImpersonatorID, v))
+}
+
+// ImpersonatorIDLTE applies the LTE predicate on the "impersonator_id" field.
+func ImpersonatorIDLTE(v uint32) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldLTE(FieldImpersonatorID, v))
+}
+
+// ImpersonatorIDIsNil applies the IsNil predicate on the "impersonator_id" field.
+func ImpersonatorIDIsNil() predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldIsNull(FieldImpersonatorID))
+}
+
+// ImpersonatorIDNotNil applies the NotNil predicate on the "impersonator_id" field.
+func ImpersonatorIDNotNil() predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldNotNull(FieldImpersonatorID))
+}
+
+// ImpersonatorNameEQ applies the EQ predicate on the "impersonator_name" field.
+func ImpersonatorNameEQ(v string) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldEQ(FieldImpersonatorName, v))
+}
+
+// ImpersonatorNameNEQ applies the NEQ predicate on the "impersonator_name" field.
+func ImpersonatorNameNEQ(v string) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldNEQ(FieldImpersonatorName, v))
+}
+
+// ImpersonatorNameIn applies the In predicate on the "impersonator_name" field.
+func ImpersonatorNameIn(vs ...string) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldIn(FieldImpersonatorName, vs...))
+}
+
+// ImpersonatorNameNotIn applies the NotIn predicate on the "impersonator_name" field.
+func ImpersonatorNameNotIn(vs ...string) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldNotIn(FieldImpersonatorName, vs...))
+}
+
+// ImpersonatorNameGT applies the GT predicate on the "impersonator_name" field.
+func ImpersonatorNameGT(v string) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldGT(FieldImpersonatorName, v))
+}
+
+// ImpersonatorNameGTE applies the GTE predicate on the "impersonator_name" field.
+func ImpersonatorNameGTE(v string) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldGTE(FieldImpersonatorName, v))
+}
+
+// ImpersonatorNameLT applies the LT predicate on the "impersonator_name" field.
+func ImpersonatorNameLT(v string) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldLT(FieldImpersonatorName, v))
+}
+
+// ImpersonatorNameLTE applies the LTE predicate on the "impersonator_name" field.
+func ImpersonatorNameLTE(v string) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldLTE(FieldImpersonatorName, v))
+}
+
+// ImpersonatorNameContains applies the Contains predicate on the "impersonator_name" field.
+func ImpersonatorNameContains(v string) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldContains(FieldImpersonatorName, v))
+}
+
+// ImpersonatorNameHasPrefix applies the HasPrefix predicate on the "impersonator_name" field.
+func ImpersonatorNameHasPrefix(v string) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldHasPrefix(FieldImpersonatorName, v))
+}
+
+// ImpersonatorNameHasSuffix applies the HasSuffix predicate on the "impersonator_name" field.
+func ImpersonatorNameHasSuffix(v string) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldHasSuffix(FieldImpersonatorName, v))
+}
+
+// ImpersonatorNameIsNil applies the IsNil predicate on the "impersonator_name" field.
+func ImpersonatorNameIsNil() predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldIsNull(FieldImpersonatorName))
+}
+
+// ImpersonatorNameNotNil applies the NotNil predicate on the "impersonator_name" field.
+func ImpersonatorNameNotNil() predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldNotNull(FieldImpersonatorName))
+}
+
+// ImpersonatorNameEqualFold applies the EqualFold predicate on the "impersonator_name" field.
+func ImpersonatorNameEqualFold(v string) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldEqualFold(FieldImpersonatorName, v))
+}
+
+// ImpersonatorNameContainsFold applies the ContainsFold predicate on the "impersonator_name" field.
+func ImpersonatorNameContainsFold(v string) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldContainsFold(FieldImpersonatorName, v))
+}
+
 // ResourceTypeEQ applies the EQ predicate on the "resource_type" field.
 func ResourceTypeEQ(v string) predicate.OperationAuditLog {
 	return predicate.OperationAuditLog(sql.FieldEQ(FieldResourceType, v))
@@ -79,6 +79,34 @@ func (_c *OperationAuditLogCreate) SetNillableUsername(v *string) *OperationAudi
 	return _c
 }
 
+// SetImpersonatorID sets the "impersonator_id" field.
+func (_c *OperationAuditLogCreate) SetImpersonatorID(v uint32) *OperationAuditLogCreate {
+	_c.mutation.SetImpersonatorID(v)
+	return _c
+}
+
+// SetNillableImpersonatorID sets the "impersonator_id" field if the given value is not nil.
+func (_c *OperationAuditLogCreate) SetNillableImpersonatorID(v *uint32) *OperationAuditLogCreate {
+	if v != nil {
+		_c.SetImpersonatorID(*v)
+	}
+	return _c
+}
+
+// SetImpersonatorName sets the "impersonator_name" field.
+func (_c *OperationAuditLogCreate) SetImpersonatorName(v string) *OperationAuditLogCreate {
+	_c.mutation.SetImpersonatorName(v)
+	return _c
+}
+
+// SetNillableImpersonatorName sets the "impersonator_name" field if the given value is not nil.
+func (_c *OperationAuditLogCreate) SetNillableImpersonatorName(v *string) *OperationAuditLogCreate {
+	if v != nil {
+		_c.SetImpersonatorName(*v)
+	}
+	return _c
+}
+
 // SetResourceType sets the "resource_type" field.
 func (_c *OperationAuditLogCreate) SetResourceType(v string) *OperationAuditLogCreate {
 	_c.mutation.SetResourceType(v)
@@ -391,6 +419,14 @@ func (_c *OperationAuditLogCreate) createSpec() (*OperationAuditLog, *sqlgraph.C
 		_spec.SetField(operationauditlog.FieldUsername, field.TypeString, value)
 		_node.Username = &value
 	}
+	if value, ok := _c.mutation.ImpersonatorID(); ok {
+		_spec.SetField(operationauditlog.FieldImpersonatorID, field.TypeUint32, value)
+		_node.ImpersonatorID = &value
+	}
+	if value, ok := _c.mutation.ImpersonatorName(); ok {
+		_spec.SetField(operationauditlog.FieldImpersonatorName, field.TypeString, value)
+		_node.ImpersonatorName = &value
+	}
 	if value, ok := _c.mutation.ResourceType(); ok {
 		_spec.SetField(operationauditlog.FieldResourceType, field.TypeString, value)
 		_node.ResourceType = &value
@@ -545,6 +581,48 @@ func (u *OperationAuditLogUpsert) ClearUsername() *OperationAuditLogUpsert {
 	return u
 }
 
+// SetImpersonatorID sets the "impersonator_id" field.
+func (u *OperationAuditLogUpsert) SetImpersonatorID(v uint32) *OperationAuditLogUpsert {
+	u.Set(operationauditlog.FieldImpersonatorID, v)
+	return u
+}
+
+// UpdateImpersonatorID sets the "impersonator_id" field to the value that was provided on create.
+func (u *OperationAuditLogUpsert) UpdateImpersonatorID() *OperationAuditLogUpsert {
+	u.SetExcluded(operationauditlog.FieldImpersonatorID)
+	return u
+}
+
+// AddImpersonatorID adds v to the "impersonator_id" field.
+func (u *OperationAuditLogUpsert) AddImpersonatorID(v uint32) *OperationAuditLogUpsert {
+	u.Add(operationauditlog.FieldImpersonatorID, v)
+	return u
+}
+
+// ClearImpersonatorID clears the value of the "impersonator_id" field.
+func (u *OperationAuditLogUpsert) ClearImpersonatorID() *OperationAuditLogUpsert {
+	u.SetNull(operationauditlog.FieldImpersonatorID)
+	return u
+}
+
+// SetImpersonatorName sets the "impersonator_name" field.
+func (u *OperationAuditLogUpsert) SetImpersonatorName(v string) *OperationAuditLogUpsert {
+	u.Set(operationauditlog.FieldImpersonatorName, v)
+	return u
+}
+
+// UpdateImpersonatorName sets the "impersonator_name" field to the value that was provided on create.
+func (u *OperationAuditLogUpsert) UpdateImpersonatorName() *OperationAuditLogUpsert {
+	u.SetExcluded(operationauditlog.FieldImpersonatorName)
+	return u
+}
+
+// ClearImpersonatorName clears the value of the "impersonator_name" field.
+func (u *OperationAuditLogUpsert) ClearImpersonatorName() *OperationAuditLogUpsert {
+	u.SetNull(operationauditlog.FieldImpersonatorName)
+	return u
+}
+
 // SetResourceType sets the "resource_type" field.
 func (u *OperationAuditLogUpsert) SetResourceType(v string) *OperationAuditLogUpsert {
 	u.Set(operationauditlog.FieldResourceType, v)
@@ -918,6 +996,55 @@ func (u *OperationAuditLogUpsertOne) ClearUsername() *OperationAuditLogUpsertOne
 	})
 }
 
+// SetImpersonatorID sets the "impersonator_id" field.
+func (u *OperationAuditLogUpsertOne) SetImpersonatorID(v uint32) *OperationAuditLogUpsertOne {
+	return u.Update(func(s *OperationAuditLogUpsert) {
+		s.SetImpersonatorID(v)
+	})
+}
+
+// AddImpersonatorID adds v to the "impersonator_id" field.
+func (u *OperationAuditLogUpsertOne) AddImpersonatorID(v uint32) *OperationAuditLogUpsertOne {
+	return u.Update(func(s *OperationAuditLogUpsert) {
+		s.AddImpersonatorID(v)
+	})
+}
+
+// UpdateImpersonatorID sets the "impersonator_id" field to the value that was provided on create.
+func (u *OperationAuditLogUpsertOne) UpdateImpersonatorID() *OperationAuditLogUpsertOne {
+	return u.Update(func(s *OperationAuditLogUpsert) {
+		s.UpdateImpersonatorID()
+	})
+}
+
+// ClearImpersonatorID clears the value of the "impersonator_id" field.
+func (u *OperationAuditLogUpsertOne) ClearImpersonatorID() *OperationAuditLogUpsertOne {
+	return u.Update(func(s *OperationAuditLogUpsert) {
+		s.ClearImpersonatorID()
+	})
+}
+
+// SetImpersonatorName sets the "impersonator_name" field.
+func (u *OperationAuditLogUpsertOne) SetImpersonatorName(v string) *OperationAuditLogUpsertOne {
+	return u.Update(func(s *OperationAuditLogUpsert) {
+		s.SetImpersonatorName(v)
+	})
+}
+
+// UpdateImpersonatorName sets the "impersonator_name" field to the value that was provided on create.
+func (u *OperationAuditLogUpsertOne) UpdateImpersonatorName() *OperationAuditLogUpsertOne {
+	return u.Update(func(s *OperationAuditLogUpsert) {
+		s.UpdateImpersonatorName()
+	})
+}
+
+// ClearImpersonatorName clears the value of the "impersonator_name" field.
+func (u *OperationAuditLogUpsertOne) ClearImpersonatorName() *OperationAuditLogUpsertOne {
+	return u.Update(func(s *OperationAuditLogUpsert) {
+		s.ClearImpersonatorName()
+	})
+}
+
 // SetResourceType sets the "resource_type" field.
 func (u *OperationAuditLogUpsertOne) SetResourceType(v string) *OperationAuditLogUpsertOne {
 	return u.Update(func(s *OperationAuditLogUpsert) {
@@ -1502,6 +1629,55 @@ func (u *OperationAuditLogUpsertBulk) ClearUsername() *OperationAuditLogUpsertBu
 	})
 }
 
+// SetImpersonatorID sets the "impersonator_id" field.
+func (u *OperationAuditLogUpsertBulk) SetImpersonatorID(v uint32) *OperationAuditLogUpsertBulk {
+	return u.Update(func(s *OperationAuditLogUpsert) {
+		s.SetImpersonatorID(v)
+	})
+}
+
+// AddImpersonatorID adds v to the "impersonator_id" field.
+func (u *OperationAuditLogUpsertBulk) AddImpersonatorID(v uint32) *OperationAuditLogUpsertBulk {
+	return u.Update(func(s *OperationAuditLogUpsert) {
+		s.AddImpersonatorID(v)
+	})
+}
+
+// UpdateImpersonatorID sets the "impersonator_id" field to the value that was provided on create.
+func (u *OperationAuditLogUpsertBulk) UpdateImpersonatorID() *OperationAuditLogUpsertBulk {
+	return u.Update(func(s *OperationAuditLogUpsert) {
+		s.UpdateImpersonatorID()
+	})
+}
+
+// ClearImpersonatorID clears the value of the "impersonator_id" field.
+func (u *OperationAuditLogUpsertBulk) ClearImpersonatorID() *OperationAuditLogUpsertBulk {
+	return u.Update(func(s *OperationAuditLogUpsert) {
+		s.ClearImpersonatorID()
+	})
+}
+
+// SetImpersonatorName sets the "impersonator_name" field.
+func (u *OperationAuditLogUpsertBulk) SetImpersonatorName(v string) *OperationAuditLogUpsertBulk {
+	return u.Update(func(s *OperationAuditLogUpsert) {
+		s.SetImpersonatorName(v)
+	})
+}
+
+// UpdateImpersonatorName sets the "impersonator_name" field to the value that was provided on create.
+func (u *OperationAuditLogUpsertBulk) UpdateImpersonatorName() *OperationAuditLogUpsertBulk {
+	return u.Update(func(s *OperationAuditLogUpsert) {
+		s.UpdateImpersonatorName()
+	})
+}
+
+// ClearImpersonatorName clears the value of the "impersonator_name" field.
+func (u *OperationAuditLogUpsertBulk) ClearImpersonatorName() *OperationAuditLogUpsertBulk {
+	return u.Update(func(s *OperationAuditLogUpsert) {
+		s.ClearImpersonatorName()
+	})
+}
+
 // SetResourceType sets the "resource_type" field.
 func (u *OperationAuditLogUpsertBulk) SetResourceType(v string) *OperationAuditLogUpsertBulk {
 	return u.Update(func(s *OperationAuditLogUpsert) {
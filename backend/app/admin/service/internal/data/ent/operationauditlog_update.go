@@ -76,6 +76,53 @@ func (_u *OperationAuditLogUpdate) ClearUsername() *OperationAuditLogUpdate {
 	return _u
 }
 
+// SetImpersonatorID sets the "impersonator_id" field.
+func (_u *OperationAuditLogUpdate) SetImpersonatorID(v uint32) *OperationAuditLogUpdate {
+	_u.mutation.ResetImpersonatorID()
+	_u.mutation.SetImpersonatorID(v)
+	return _u
+}
+
+// SetNillableImpersonatorID sets the "impersonator_id" field if the given value is not nil.
+func (_u *OperationAuditLogUpdate) SetNillableImpersonatorID(v *uint32) *OperationAuditLogUpdate {
+	if v != nil {
+		_u.SetImpersonatorID(*v)
+	}
+	return _u
+}
+
+// AddImpersonatorID adds value to the "impersonator_id" field.
+func (_u *OperationAuditLogUpdate) AddImpersonatorID(v int32) *OperationAuditLogUpdate {
+	_u.mutation.AddImpersonatorID(v)
+	return _u
+}
+
+// ClearImpersonatorID clears the value of the "impersonator_id" field.
+func (_u *OperationAuditLogUpdate) ClearImpersonatorID() *OperationAuditLogUpdate {
+	_u.mutation.ClearImpersonatorID()
+	return _u
+}
+
+// SetImpersonatorName sets the "impersonator_name" field.
+func (_u *OperationAuditLogUpdate) SetImpersonatorName(v string) *OperationAuditLogUpdate {
+	_u.mutation.SetImpersonatorName(v)
+	return _u
+}
+
+// SetNillableImpersonatorName sets the "impersonator_name" field if the given value is not nil.
+func (_u *OperationAuditLogUpdate) SetNillableImpersonatorName(v *string) *OperationAuditLogUpdate {
+	if v != nil {
+		_u.SetImpersonatorName(*v)
+	}
+	return _u
+}
+
+// ClearImpersonatorName clears the value of the "impersonator_name" field.
+func (_u *OperationAuditLogUpdate) ClearImpersonatorName() *OperationAuditLogUpdate {
+	_u.mutation.ClearImpersonatorName()
+	return _u
+}
+
 // SetResourceType sets the "resource_type" field.
 func (_u *OperationAuditLogUpdate) SetResourceType(v string) *OperationAuditLogUpdate {
 	_u.mutation.SetResourceType(v)
@@ -448,6 +495,21 @@ func (_u *OperationAuditLogUpdate) sqlSave(ctx context.Context) (_node int, err
 	if _u.mutation.UsernameCleared() {
 		_spec.ClearField(operationauditlog.FieldUsername, field.TypeString)
 	}
+	if value, ok := _u.mutation.ImpersonatorID(); ok {
+		_spec.SetField(operationauditlog.FieldImpersonatorID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedImpersonatorID(); ok {
+		_spec.AddField(operationauditlog.FieldImpersonatorID, field.TypeUint32, value)
+	}
+	if _u.mutation.ImpersonatorIDCleared() {
+		_spec.ClearField(operationauditlog.FieldImpersonatorID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.ImpersonatorName(); ok {
+		_spec.SetField(operationauditlog.FieldImpersonatorName, field.TypeString, value)
+	}
+	if _u.mutation.ImpersonatorNameCleared() {
+		_spec.ClearField(operationauditlog.FieldImpersonatorName, field.TypeString)
+	}
 	if value, ok := _u.mutation.ResourceType(); ok {
 		_spec.SetField(operationauditlog.FieldResourceType, field.TypeString, value)
 	}
@@ -607,6 +669,53 @@ func (_u *OperationAuditLogUpdateOne) ClearUsername() *OperationAuditLogUpdateOn
 	return _u
 }
 
+// SetImpersonatorID sets the "impersonator_id" field.
+func (_u *OperationAuditLogUpdateOne) SetImpersonatorID(v uint32) *OperationAuditLogUpdateOne {
+	_u.mutation.ResetImpersonatorID()
+	_u.mutation.SetImpersonatorID(v)
+	return _u
+}
+
+// SetNillableImpersonatorID sets the "impersonator_id" field if the given value is not nil.
+func (_u *OperationAuditLogUpdateOne) SetNillableImpersonatorID(v *uint32) *OperationAuditLogUpdateOne {
+	if v != nil {
+		_u.SetImpersonatorID(*v)
+	}
+	return _u
+}
+
+// AddImpersonatorID adds value to the "impersonator_id" field.
+func (_u *OperationAuditLogUpdateOne) AddImpersonatorID(v int32) *OperationAuditLogUpdateOne {
+	_u.mutation.AddImpersonatorID(v)
+	return _u
+}
+
+// ClearImpersonatorID clears the value of the "impersonator_id" field.
+func (_u *OperationAuditLogUpdateOne) ClearImpersonatorID() *OperationAuditLogUpdateOne {
+	_u.mutation.ClearImpersonatorID()
+	return _u
+}
+
+// SetImpersonatorName sets the "impersonator_name" field.
+func (_u *OperationAuditLogUpdateOne) SetImpersonatorName(v string) *OperationAuditLogUpdateOne {
+	_u.mutation.SetImpersonatorName(v)
+	return _u
+}
+
+// SetNillableImpersonatorName sets the "impersonator_name" field if the given value is not nil.
+func (_u *OperationAuditLogUpdateOne) SetNillableImpersonatorName(v *string) *OperationAuditLogUpdateOne {
+	if v != nil {
+		_u.SetImpersonatorName(*v)
+	}
+	return _u
+}
+
+// ClearImpersonatorName clears the value of the "impersonator_name" field.
+func (_u *OperationAuditLogUpdateOne) ClearImpersonatorName() *OperationAuditLogUpdateOne {
+	_u.mutation.ClearImpersonatorName()
+	return _u
+}
+
 // SetResourceType sets the "resource_type" field.
 func (_u *OperationAuditLogUpdateOne) SetResourceType(v string) *OperationAuditLogUpdateOne {
 	_u.mutation.SetResourceType(v)
@@ -1009,6 +1118,21 @@ func (_u *OperationAuditLogUpdateOne) sqlSave(ctx context.Context) (_node *Opera
 	if _u.mutation.UsernameCleared() {
 		_spec.ClearField(operationauditlog.FieldUsername, field.TypeString)
 	}
+	if value, ok := _u.mutation.ImpersonatorID(); ok {
+		_spec.SetField(operationauditlog.FieldImpersonatorID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedImpersonatorID(); ok {
+		_spec.AddField(operationauditlog.FieldImpersonatorID, field.TypeUint32, value)
+	}
+	if _u.mutation.ImpersonatorIDCleared() {
+		_spec.ClearField(operationauditlog.FieldImpersonatorID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.ImpersonatorName(); ok {
+		_spec.SetField(operationauditlog.FieldImpersonatorName, field.TypeString, value)
+	}
+	if _u.mutation.ImpersonatorNameCleared() {
+		_spec.ClearField(operationauditlog.FieldImpersonatorName, field.TypeString)
+	}
 	if value, ok := _u.mutation.ResourceType(); ok {
 		_spec.SetField(operationauditlog.FieldResourceType, field.TypeString, value)
 	}
@@ -0,0 +1,203 @@
+// Code generated by ent, DO NOT EDIT.
+
+package passwordpolicy
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the passwordpolicy type in the database.
+	Label = "password_policy"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldCreatedBy holds the string denoting the created_by field in the database.
+	FieldCreatedBy = "created_by"
+	// FieldUpdatedBy holds the string denoting the updated_by field in the database.
+	FieldUpdatedBy = "updated_by"
+	// FieldDeletedBy holds the string denoting the deleted_by field in the database.
+	FieldDeletedBy = "deleted_by"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldMinLength holds the string denoting the min_length field in the database.
+	FieldMinLength = "min_length"
+	// FieldRequireUppercase holds the string denoting the require_uppercase field in the database.
+	FieldRequireUppercase = "require_uppercase"
+	// FieldRequireLowercase holds the string denoting the require_lowercase field in the database.
+	FieldRequireLowercase = "require_lowercase"
+	// FieldRequireDigit holds the string denoting the require_digit field in the database.
+	FieldRequireDigit = "require_digit"
+	// FieldRequireSymbol holds the string denoting the require_symbol field in the database.
+	FieldRequireSymbol = "require_symbol"
+	// FieldDisallowIdentity holds the string denoting the disallow_identity field in the database.
+	FieldDisallowIdentity = "disallow_identity"
+	// FieldBannedSubstrings holds the string denoting the banned_substrings field in the database.
+	FieldBannedSubstrings = "banned_substrings"
+	// FieldHistoryCount holds the string denoting the history_count field in the database.
+	FieldHistoryCount = "history_count"
+	// FieldMaxAgeDays holds the string denoting the max_age_days field in the database.
+	FieldMaxAgeDays = "max_age_days"
+	// FieldCheckBreached holds the string denoting the check_breached field in the database.
+	FieldCheckBreached = "check_breached"
+	// Table holds the table name of the passwordpolicy in the database.
+	Table = "sys_password_policies"
+)
+
+// Columns holds all SQL columns for passwordpolicy fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldDeletedAt,
+	FieldCreatedBy,
+	FieldUpdatedBy,
+	FieldDeletedBy,
+	FieldTenantID,
+	FieldMinLength,
+	FieldRequireUppercase,
+	FieldRequireLowercase,
+	FieldRequireDigit,
+	FieldRequireSymbol,
+	FieldDisallowIdentity,
+	FieldBannedSubstrings,
+	FieldHistoryCount,
+	FieldMaxAgeDays,
+	FieldCheckBreached,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "go-wind-admin/app/admin/service/internal/data/ent/runtime"
+var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
+	// DefaultTenantID holds the default value on creation for the "tenant_id" field.
+	DefaultTenantID uint32
+	// DefaultMinLength holds the default value on creation for the "min_length" field.
+	DefaultMinLength uint32
+	// DefaultRequireUppercase holds the default value on creation for the "require_uppercase" field.
+	DefaultRequireUppercase bool
+	// DefaultRequireLowercase holds the default value on creation for the "require_lowercase" field.
+	DefaultRequireLowercase bool
+	// DefaultRequireDigit holds the default value on creation for the "require_digit" field.
+	DefaultRequireDigit bool
+	// DefaultRequireSymbol holds the default value on creation for the "require_symbol" field.
+	DefaultRequireSymbol bool
+	// DefaultDisallowIdentity holds the default value on creation for the "disallow_identity" field.
+	DefaultDisallowIdentity bool
+	// DefaultHistoryCount holds the default value on creation for the "history_count" field.
+	DefaultHistoryCount uint32
+	// DefaultMaxAgeDays holds the default value on creation for the "max_age_days" field.
+	DefaultMaxAgeDays uint32
+	// DefaultCheckBreached holds the default value on creation for the "check_breached" field.
+	DefaultCheckBreached bool
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(uint32) error
+)
+
+// OrderOption defines the ordering options for the PasswordPolicy queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByCreatedBy orders the results by the created_by field.
+func ByCreatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedBy, opts...).ToFunc()
+}
+
+// ByUpdatedBy orders the results by the updated_by field.
+func ByUpdatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedBy, opts...).ToFunc()
+}
+
+// ByDeletedBy orders the results by the deleted_by field.
+func ByDeletedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedBy, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByMinLength orders the results by the min_length field.
+func ByMinLength(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMinLength, opts...).ToFunc()
+}
+
+// ByRequireUppercase orders the results by the require_uppercase field.
+func ByRequireUppercase(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequireUppercase, opts...).ToFunc()
+}
+
+// ByRequireLowercase orders the results by the require_lowercase field.
+func ByRequireLowercase(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequireLowercase, opts...).ToFunc()
+}
+
+// ByRequireDigit orders the results by the require_digit field.
+func ByRequireDigit(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequireDigit, opts...).ToFunc()
+}
+
+// ByRequireSymbol orders the results by the require_symbol field.
+func ByRequireSymbol(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequireSymbol, opts...).ToFunc()
+}
+
+// ByDisallowIdentity orders the results by the disallow_identity field.
+func ByDisallowIdentity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDisallowIdentity, opts...).ToFunc()
+}
+
+// ByHistoryCount orders the results by the history_count field.
+func ByHistoryCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHistoryCount, opts...).ToFunc()
+}
+
+// ByMaxAgeDays orders the results by the max_age_days field.
+func ByMaxAgeDays(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxAgeDays, opts...).ToFunc()
+}
+
+// ByCheckBreached orders the results by the check_breached field.
+func ByCheckBreached(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCheckBreached, opts...).ToFunc()
+}
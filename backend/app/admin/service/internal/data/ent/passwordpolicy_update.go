@@ -0,0 +1,1111 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-wind-admin/app/admin/service/internal/data/ent/passwordpolicy"
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// PasswordPolicyUpdate is the builder for updating PasswordPolicy entities.
+type PasswordPolicyUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *PasswordPolicyMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the PasswordPolicyUpdate builder.
+func (_u *PasswordPolicyUpdate) Where(ps ...predicate.PasswordPolicy) *PasswordPolicyUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PasswordPolicyUpdate) SetUpdatedAt(v time.Time) *PasswordPolicyUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *PasswordPolicyUpdate) SetNillableUpdatedAt(v *time.Time) *PasswordPolicyUpdate {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *PasswordPolicyUpdate) ClearUpdatedAt() *PasswordPolicyUpdate {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *PasswordPolicyUpdate) SetDeletedAt(v time.Time) *PasswordPolicyUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *PasswordPolicyUpdate) SetNillableDeletedAt(v *time.Time) *PasswordPolicyUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *PasswordPolicyUpdate) ClearDeletedAt() *PasswordPolicyUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *PasswordPolicyUpdate) SetCreatedBy(v uint32) *PasswordPolicyUpdate {
+	_u.mutation.ResetCreatedBy()
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *PasswordPolicyUpdate) SetNillableCreatedBy(v *uint32) *PasswordPolicyUpdate {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// AddCreatedBy adds value to the "created_by" field.
+func (_u *PasswordPolicyUpdate) AddCreatedBy(v int32) *PasswordPolicyUpdate {
+	_u.mutation.AddCreatedBy(v)
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *PasswordPolicyUpdate) ClearCreatedBy() *PasswordPolicyUpdate {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_u *PasswordPolicyUpdate) SetUpdatedBy(v uint32) *PasswordPolicyUpdate {
+	_u.mutation.ResetUpdatedBy()
+	_u.mutation.SetUpdatedBy(v)
+	return _u
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_u *PasswordPolicyUpdate) SetNillableUpdatedBy(v *uint32) *PasswordPolicyUpdate {
+	if v != nil {
+		_u.SetUpdatedBy(*v)
+	}
+	return _u
+}
+
+// AddUpdatedBy adds value to the "updated_by" field.
+func (_u *PasswordPolicyUpdate) AddUpdatedBy(v int32) *PasswordPolicyUpdate {
+	_u.mutation.AddUpdatedBy(v)
+	return _u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (_u *PasswordPolicyUpdate) ClearUpdatedBy() *PasswordPolicyUpdate {
+	_u.mutation.ClearUpdatedBy()
+	return _u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_u *PasswordPolicyUpdate) SetDeletedBy(v uint32) *PasswordPolicyUpdate {
+	_u.mutation.ResetDeletedBy()
+	_u.mutation.SetDeletedBy(v)
+	return _u
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_u *PasswordPolicyUpdate) SetNillableDeletedBy(v *uint32) *PasswordPolicyUpdate {
+	if v != nil {
+		_u.SetDeletedBy(*v)
+	}
+	return _u
+}
+
+// AddDeletedBy adds value to the "deleted_by" field.
+func (_u *PasswordPolicyUpdate) AddDeletedBy(v int32) *PasswordPolicyUpdate {
+	_u.mutation.AddDeletedBy(v)
+	return _u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (_u *PasswordPolicyUpdate) ClearDeletedBy() *PasswordPolicyUpdate {
+	_u.mutation.ClearDeletedBy()
+	return _u
+}
+
+// SetMinLength sets the "min_length" field.
+func (_u *PasswordPolicyUpdate) SetMinLength(v uint32) *PasswordPolicyUpdate {
+	_u.mutation.ResetMinLength()
+	_u.mutation.SetMinLength(v)
+	return _u
+}
+
+// SetNillableMinLength sets the "min_length" field if the given value is not nil.
+func (_u *PasswordPolicyUpdate) SetNillableMinLength(v *uint32) *PasswordPolicyUpdate {
+	if v != nil {
+		_u.SetMinLength(*v)
+	}
+	return _u
+}
+
+// AddMinLength adds value to the "min_length" field.
+func (_u *PasswordPolicyUpdate) AddMinLength(v int32) *PasswordPolicyUpdate {
+	_u.mutation.AddMinLength(v)
+	return _u
+}
+
+// ClearMinLength clears the value of the "min_length" field.
+func (_u *PasswordPolicyUpdate) ClearMinLength() *PasswordPolicyUpdate {
+	_u.mutation.ClearMinLength()
+	return _u
+}
+
+// SetRequireUppercase sets the "require_uppercase" field.
+func (_u *PasswordPolicyUpdate) SetRequireUppercase(v bool) *PasswordPolicyUpdate {
+	_u.mutation.SetRequireUppercase(v)
+	return _u
+}
+
+// SetNillableRequireUppercase sets the "require_uppercase" field if the given value is not nil.
+func (_u *PasswordPolicyUpdate) SetNillableRequireUppercase(v *bool) *PasswordPolicyUpdate {
+	if v != nil {
+		_u.SetRequireUppercase(*v)
+	}
+	return _u
+}
+
+// ClearRequireUppercase clears the value of the "require_uppercase" field.
+func (_u *PasswordPolicyUpdate) ClearRequireUppercase() *PasswordPolicyUpdate {
+	_u.mutation.ClearRequireUppercase()
+	return _u
+}
+
+// SetRequireLowercase sets the "require_lowercase" field.
+func (_u *PasswordPolicyUpdate) SetRequireLowercase(v bool) *PasswordPolicyUpdate {
+	_u.mutation.SetRequireLowercase(v)
+	return _u
+}
+
+// SetNillableRequireLowercase sets the "require_lowercase" field if the given value is not nil.
+func (_u *PasswordPolicyUpdate) SetNillableRequireLowercase(v *bool) *PasswordPolicyUpdate {
+	if v != nil {
+		_u.SetRequireLowercase(*v)
+	}
+	return _u
+}
+
+// ClearRequireLowercase clears the value of the "require_lowercase" field.
+func (_u *PasswordPolicyUpdate) ClearRequireLowercase() *PasswordPolicyUpdate {
+	_u.mutation.ClearRequireLowercase()
+	return _u
+}
+
+// SetRequireDigit sets the "require_digit" field.
+func (_u *PasswordPolicyUpdate) SetRequireDigit(v bool) *PasswordPolicyUpdate {
+	_u.mutation.SetRequireDigit(v)
+	return _u
+}
+
+// SetNillableRequireDigit sets the "require_digit" field if the given value is not nil.
+func (_u *PasswordPolicyUpdate) SetNillableRequireDigit(v *bool) *PasswordPolicyUpdate {
+	if v != nil {
+		_u.SetRequireDigit(*v)
+	}
+	return _u
+}
+
+// ClearRequireDigit clears the value of the "require_digit" field.
+func (_u *PasswordPolicyUpdate) ClearRequireDigit() *PasswordPolicyUpdate {
+	_u.mutation.ClearRequireDigit()
+	return _u
+}
+
+// SetRequireSymbol sets the "require_symbol" field.
+func (_u *PasswordPolicyUpdate) SetRequireSymbol(v bool) *PasswordPolicyUpdate {
+	_u.mutation.SetRequireSymbol(v)
+	return _u
+}
+
+// SetNillableRequireSymbol sets the "require_symbol" field if the given value is not nil.
+func (_u *PasswordPolicyUpdate) SetNillableRequireSymbol(v *bool) *PasswordPolicyUpdate {
+	if v != nil {
+		_u.SetRequireSymbol(*v)
+	}
+	return _u
+}
+
+// ClearRequireSymbol clears the value of the "require_symbol" field.
+func (_u *PasswordPolicyUpdate) ClearRequireSymbol() *PasswordPolicyUpdate {
+	_u.mutation.ClearRequireSymbol()
+	return _u
+}
+
+// SetDisallowIdentity sets the "disallow_identity" field.
+func (_u *PasswordPolicyUpdate) SetDisallowIdentity(v bool) *PasswordPolicyUpdate {
+	_u.mutation.SetDisallowIdentity(v)
+	return _u
+}
+
+// SetNillableDisallowIdentity sets the "disallow_identity" field if the given value is not nil.
+func (_u *PasswordPolicyUpdate) SetNillableDisallowIdentity(v *bool) *PasswordPolicyUpdate {
+	if v != nil {
+		_u.SetDisallowIdentity(*v)
+	}
+	return _u
+}
+
+// ClearDisallowIdentity clears the value of the "disallow_identity" field.
+func (_u *PasswordPolicyUpdate) ClearDisallowIdentity() *PasswordPolicyUpdate {
+	_u.mutation.ClearDisallowIdentity()
+	return _u
+}
+
+// SetBannedSubstrings sets the "banned_substrings" field.
+func (_u *PasswordPolicyUpdate) SetBannedSubstrings(v []string) *PasswordPolicyUpdate {
+	_u.mutation.SetBannedSubstrings(v)
+	return _u
+}
+
+// AppendBannedSubstrings appends value to the "banned_substrings" field.
+func (_u *PasswordPolicyUpdate) AppendBannedSubstrings(v []string) *PasswordPolicyUpdate {
+	_u.mutation.AppendBannedSubstrings(v)
+	return _u
+}
+
+// ClearBannedSubstrings clears the value of the "banned_substrings" field.
+func (_u *PasswordPolicyUpdate) ClearBannedSubstrings() *PasswordPolicyUpdate {
+	_u.mutation.ClearBannedSubstrings()
+	return _u
+}
+
+// SetHistoryCount sets the "history_count" field.
+func (_u *PasswordPolicyUpdate) SetHistoryCount(v uint32) *PasswordPolicyUpdate {
+	_u.mutation.ResetHistoryCount()
+	_u.mutation.SetHistoryCount(v)
+	return _u
+}
+
+// SetNillableHistoryCount sets the "history_count" field if the given value is not nil.
+func (_u *PasswordPolicyUpdate) SetNillableHistoryCount(v *uint32) *PasswordPolicyUpdate {
+	if v != nil {
+		_u.SetHistoryCount(*v)
+	}
+	return _u
+}
+
+// AddHistoryCount adds value to the "history_count" field.
+func (_u *PasswordPolicyUpdate) AddHistoryCount(v int32) *PasswordPolicyUpdate {
+	_u.mutation.AddHistoryCount(v)
+	return _u
+}
+
+// ClearHistoryCount clears the value of the "history_count" field.
+func (_u *PasswordPolicyUpdate) ClearHistoryCount() *PasswordPolicyUpdate {
+	_u.mutation.ClearHistoryCount()
+	return _u
+}
+
+// SetMaxAgeDays sets the "max_age_days" field.
+func (_u *PasswordPolicyUpdate) SetMaxAgeDays(v uint32) *PasswordPolicyUpdate {
+	_u.mutation.ResetMaxAgeDays()
+	_u.mutation.SetMaxAgeDays(v)
+	return _u
+}
+
+// SetNillableMaxAgeDays sets the "max_age_days" field if the given value is not nil.
+func (_u *PasswordPolicyUpdate) SetNillableMaxAgeDays(v *uint32) *PasswordPolicyUpdate {
+	if v != nil {
+		_u.SetMaxAgeDays(*v)
+	}
+	return _u
+}
+
+// AddMaxAgeDays adds value to the "max_age_days" field.
+func (_u *PasswordPolicyUpdate) AddMaxAgeDays(v int32) *PasswordPolicyUpdate {
+	_u.mutation.AddMaxAgeDays(v)
+	return _u
+}
+
+// ClearMaxAgeDays clears the value of the "max_age_days" field.
+func (_u *PasswordPolicyUpdate) ClearMaxAgeDays() *PasswordPolicyUpdate {
+	_u.mutation.ClearMaxAgeDays()
+	return _u
+}
+
+// SetCheckBreached sets the "check_breached" field.
+func (_u *PasswordPolicyUpdate) SetCheckBreached(v bool) *PasswordPolicyUpdate {
+	_u.mutation.SetCheckBreached(v)
+	return _u
+}
+
+// SetNillableCheckBreached sets the "check_breached" field if the given value is not nil.
+func (_u *PasswordPolicyUpdate) SetNillableCheckBreached(v *bool) *PasswordPolicyUpdate {
+	if v != nil {
+		_u.SetCheckBreached(*v)
+	}
+	return _u
+}
+
+// ClearCheckBreached clears the value of the "check_breached" field.
+func (_u *PasswordPolicyUpdate) ClearCheckBreached() *PasswordPolicyUpdate {
+	_u.mutation.ClearCheckBreached()
+	return _u
+}
+
+// Mutation returns the PasswordPolicyMutation object of the builder.
+func (_u *PasswordPolicyUpdate) Mutation() *PasswordPolicyMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PasswordPolicyUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PasswordPolicyUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PasswordPolicyUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PasswordPolicyUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *PasswordPolicyUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PasswordPolicyUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *PasswordPolicyUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(passwordpolicy.Table, passwordpolicy.Columns, sqlgraph.NewFieldSpec(passwordpolicy.FieldID, field.TypeUint32))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(passwordpolicy.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(passwordpolicy.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(passwordpolicy.FieldUpdatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(passwordpolicy.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(passwordpolicy.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(passwordpolicy.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(passwordpolicy.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(passwordpolicy.FieldCreatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UpdatedBy(); ok {
+		_spec.SetField(passwordpolicy.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedBy(); ok {
+		_spec.AddField(passwordpolicy.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdatedByCleared() {
+		_spec.ClearField(passwordpolicy.FieldUpdatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.DeletedBy(); ok {
+		_spec.SetField(passwordpolicy.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedBy(); ok {
+		_spec.AddField(passwordpolicy.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.DeletedByCleared() {
+		_spec.ClearField(passwordpolicy.FieldDeletedBy, field.TypeUint32)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(passwordpolicy.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.MinLength(); ok {
+		_spec.SetField(passwordpolicy.FieldMinLength, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedMinLength(); ok {
+		_spec.AddField(passwordpolicy.FieldMinLength, field.TypeUint32, value)
+	}
+	if _u.mutation.MinLengthCleared() {
+		_spec.ClearField(passwordpolicy.FieldMinLength, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.RequireUppercase(); ok {
+		_spec.SetField(passwordpolicy.FieldRequireUppercase, field.TypeBool, value)
+	}
+	if _u.mutation.RequireUppercaseCleared() {
+		_spec.ClearField(passwordpolicy.FieldRequireUppercase, field.TypeBool)
+	}
+	if value, ok := _u.mutation.RequireLowercase(); ok {
+		_spec.SetField(passwordpolicy.FieldRequireLowercase, field.TypeBool, value)
+	}
+	if _u.mutation.RequireLowercaseCleared() {
+		_spec.ClearField(passwordpolicy.FieldRequireLowercase, field.TypeBool)
+	}
+	if value, ok := _u.mutation.RequireDigit(); ok {
+		_spec.SetField(passwordpolicy.FieldRequireDigit, field.TypeBool, value)
+	}
+	if _u.mutation.RequireDigitCleared() {
+		_spec.ClearField(passwordpolicy.FieldRequireDigit, field.TypeBool)
+	}
+	if value, ok := _u.mutation.RequireSymbol(); ok {
+		_spec.SetField(passwordpolicy.FieldRequireSymbol, field.TypeBool, value)
+	}
+	if _u.mutation.RequireSymbolCleared() {
+		_spec.ClearField(passwordpolicy.FieldRequireSymbol, field.TypeBool)
+	}
+	if value, ok := _u.mutation.DisallowIdentity(); ok {
+		_spec.SetField(passwordpolicy.FieldDisallowIdentity, field.TypeBool, value)
+	}
+	if _u.mutation.DisallowIdentityCleared() {
+		_spec.ClearField(passwordpolicy.FieldDisallowIdentity, field.TypeBool)
+	}
+	if value, ok := _u.mutation.BannedSubstrings(); ok {
+		_spec.SetField(passwordpolicy.FieldBannedSubstrings, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedBannedSubstrings(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, passwordpolicy.FieldBannedSubstrings, value)
+		})
+	}
+	if _u.mutation.BannedSubstringsCleared() {
+		_spec.ClearField(passwordpolicy.FieldBannedSubstrings, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.HistoryCount(); ok {
+		_spec.SetField(passwordpolicy.FieldHistoryCount, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedHistoryCount(); ok {
+		_spec.AddField(passwordpolicy.FieldHistoryCount, field.TypeUint32, value)
+	}
+	if _u.mutation.HistoryCountCleared() {
+		_spec.ClearField(passwordpolicy.FieldHistoryCount, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.MaxAgeDays(); ok {
+		_spec.SetField(passwordpolicy.FieldMaxAgeDays, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedMaxAgeDays(); ok {
+		_spec.AddField(passwordpolicy.FieldMaxAgeDays, field.TypeUint32, value)
+	}
+	if _u.mutation.MaxAgeDaysCleared() {
+		_spec.ClearField(passwordpolicy.FieldMaxAgeDays, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.CheckBreached(); ok {
+		_spec.SetField(passwordpolicy.FieldCheckBreached, field.TypeBool, value)
+	}
+	if _u.mutation.CheckBreachedCleared() {
+		_spec.ClearField(passwordpolicy.FieldCheckBreached, field.TypeBool)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{passwordpolicy.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PasswordPolicyUpdateOne is the builder for updating a single PasswordPolicy entity.
+type PasswordPolicyUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *PasswordPolicyMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PasswordPolicyUpdateOne) SetUpdatedAt(v time.Time) *PasswordPolicyUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *PasswordPolicyUpdateOne) SetNillableUpdatedAt(v *time.Time) *PasswordPolicyUpdateOne {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *PasswordPolicyUpdateOne) ClearUpdatedAt() *PasswordPolicyUpdateOne {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *PasswordPolicyUpdateOne) SetDeletedAt(v time.Time) *PasswordPolicyUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *PasswordPolicyUpdateOne) SetNillableDeletedAt(v *time.Time) *PasswordPolicyUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *PasswordPolicyUpdateOne) ClearDeletedAt() *PasswordPolicyUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *PasswordPolicyUpdateOne) SetCreatedBy(v uint32) *PasswordPolicyUpdateOne {
+	_u.mutation.ResetCreatedBy()
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *PasswordPolicyUpdateOne) SetNillableCreatedBy(v *uint32) *PasswordPolicyUpdateOne {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// AddCreatedBy adds value to the "created_by" field.
+func (_u *PasswordPolicyUpdateOne) AddCreatedBy(v int32) *PasswordPolicyUpdateOne {
+	_u.mutation.AddCreatedBy(v)
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *PasswordPolicyUpdateOne) ClearCreatedBy() *PasswordPolicyUpdateOne {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_u *PasswordPolicyUpdateOne) SetUpdatedBy(v uint32) *PasswordPolicyUpdateOne {
+	_u.mutation.ResetUpdatedBy()
+	_u.mutation.SetUpdatedBy(v)
+	return _u
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_u *PasswordPolicyUpdateOne) SetNillableUpdatedBy(v *uint32) *PasswordPolicyUpdateOne {
+	if v != nil {
+		_u.SetUpdatedBy(*v)
+	}
+	return _u
+}
+
+// AddUpdatedBy adds value to the "updated_by" field.
+func (_u *PasswordPolicyUpdateOne) AddUpdatedBy(v int32) *PasswordPolicyUpdateOne {
+	_u.mutation.AddUpdatedBy(v)
+	return _u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (_u *PasswordPolicyUpdateOne) ClearUpdatedBy() *PasswordPolicyUpdateOne {
+	_u.mutation.ClearUpdatedBy()
+	return _u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_u *PasswordPolicyUpdateOne) SetDeletedBy(v uint32) *PasswordPolicyUpdateOne {
+	_u.mutation.ResetDeletedBy()
+	_u.mutation.SetDeletedBy(v)
+	return _u
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_u *PasswordPolicyUpdateOne) SetNillableDeletedBy(v *uint32) *PasswordPolicyUpdateOne {
+	if v != nil {
+		_u.SetDeletedBy(*v)
+	}
+	return _u
+}
+
+// AddDeletedBy adds value to the "deleted_by" field.
+func (_u *PasswordPolicyUpdateOne) AddDeletedBy(v int32) *PasswordPolicyUpdateOne {
+	_u.mutation.AddDeletedBy(v)
+	return _u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (_u *PasswordPolicyUpdateOne) ClearDeletedBy() *PasswordPolicyUpdateOne {
+	_u.mutation.ClearDeletedBy()
+	return _u
+}
+
+// SetMinLength sets the "min_length" field.
+func (_u *PasswordPolicyUpdateOne) SetMinLength(v uint32) *PasswordPolicyUpdateOne {
+	_u.mutation.ResetMinLength()
+	_u.mutation.SetMinLength(v)
+	return _u
+}
+
+// SetNillableMinLength sets the "min_length" field if the given value is not nil.
+func (_u *PasswordPolicyUpdateOne) SetNillableMinLength(v *uint32) *PasswordPolicyUpdateOne {
+	if v != nil {
+		_u.SetMinLength(*v)
+	}
+	return _u
+}
+
+// AddMinLength adds value to the "min_length" field.
+func (_u *PasswordPolicyUpdateOne) AddMinLength(v int32) *PasswordPolicyUpdateOne {
+	_u.mutation.AddMinLength(v)
+	return _u
+}
+
+// ClearMinLength clears the value of the "min_length" field.
+func (_u *PasswordPolicyUpdateOne) ClearMinLength() *PasswordPolicyUpdateOne {
+	_u.mutation.ClearMinLength()
+	return _u
+}
+
+// SetRequireUppercase sets the "require_uppercase" field.
+func (_u *PasswordPolicyUpdateOne) SetRequireUppercase(v bool) *PasswordPolicyUpdateOne {
+	_u.mutation.SetRequireUppercase(v)
+	return _u
+}
+
+// SetNillableRequireUppercase sets the "require_uppercase" field if the given value is not nil.
+func (_u *PasswordPolicyUpdateOne) SetNillableRequireUppercase(v *bool) *PasswordPolicyUpdateOne {
+	if v != nil {
+		_u.SetRequireUppercase(*v)
+	}
+	return _u
+}
+
+// ClearRequireUppercase clears the value of the "require_uppercase" field.
+func (_u *PasswordPolicyUpdateOne) ClearRequireUppercase() *PasswordPolicyUpdateOne {
+	_u.mutation.ClearRequireUppercase()
+	return _u
+}
+
+// SetRequireLowercase sets the "require_lowercase" field.
+func (_u *PasswordPolicyUpdateOne) SetRequireLowercase(v bool) *PasswordPolicyUpdateOne {
+	_u.mutation.SetRequireLowercase(v)
+	return _u
+}
+
+// SetNillableRequireLowercase sets the "require_lowercase" field if the given value is not nil.
+func (_u *PasswordPolicyUpdateOne) SetNillableRequireLowercase(v *bool) *PasswordPolicyUpdateOne {
+	if v != nil {
+		_u.SetRequireLowercase(*v)
+	}
+	return _u
+}
+
+// ClearRequireLowercase clears the value of the "require_lowercase" field.
+func (_u *PasswordPolicyUpdateOne) ClearRequireLowercase() *PasswordPolicyUpdateOne {
+	_u.mutation.ClearRequireLowercase()
+	return _u
+}
+
+// SetRequireDigit sets the "require_digit" field.
+func (_u *PasswordPolicyUpdateOne) SetRequireDigit(v bool) *PasswordPolicyUpdateOne {
+	_u.mutation.SetRequireDigit(v)
+	return _u
+}
+
+// SetNillableRequireDigit sets the "require_digit" field if the given value is not nil.
+func (_u *PasswordPolicyUpdateOne) SetNillableRequireDigit(v *bool) *PasswordPolicyUpdateOne {
+	if v != nil {
+		_u.SetRequireDigit(*v)
+	}
+	return _u
+}
+
+// ClearRequireDigit clears the value of the "require_digit" field.
+func (_u *PasswordPolicyUpdateOne) ClearRequireDigit() *PasswordPolicyUpdateOne {
+	_u.mutation.ClearRequireDigit()
+	return _u
+}
+
+// SetRequireSymbol sets the "require_symbol" field.
+func (_u *PasswordPolicyUpdateOne) SetRequireSymbol(v bool) *PasswordPolicyUpdateOne {
+	_u.mutation.SetRequireSymbol(v)
+	return _u
+}
+
+// SetNillableRequireSymbol sets the "require_symbol" field if the given value is not nil.
+func (_u *PasswordPolicyUpdateOne) SetNillableRequireSymbol(v *bool) *PasswordPolicyUpdateOne {
+	if v != nil {
+		_u.SetRequireSymbol(*v)
+	}
+	return _u
+}
+
+// ClearRequireSymbol clears the value of the "require_symbol" field.
+func (_u *PasswordPolicyUpdateOne) ClearRequireSymbol() *PasswordPolicyUpdateOne {
+	_u.mutation.ClearRequireSymbol()
+	return _u
+}
+
+// SetDisallowIdentity sets the "disallow_identity" field.
+func (_u *PasswordPolicyUpdateOne) SetDisallowIdentity(v bool) *PasswordPolicyUpdateOne {
+	_u.mutation.SetDisallowIdentity(v)
+	return _u
+}
+
+// SetNillableDisallowIdentity sets the "disallow_identity" field if the given value is not nil.
+func (_u *PasswordPolicyUpdateOne) SetNillableDisallowIdentity(v *bool) *PasswordPolicyUpdateOne {
+	if v != nil {
+		_u.SetDisallowIdentity(*v)
+	}
+	return _u
+}
+
+// ClearDisallowIdentity clears the value of the "disallow_identity" field.
+func (_u *PasswordPolicyUpdateOne) ClearDisallowIdentity() *PasswordPolicyUpdateOne {
+	_u.mutation.ClearDisallowIdentity()
+	return _u
+}
+
+// SetBannedSubstrings sets the "banned_substrings" field.
+func (_u *PasswordPolicyUpdateOne) SetBannedSubstrings(v []string) *PasswordPolicyUpdateOne {
+	_u.mutation.SetBannedSubstrings(v)
+	return _u
+}
+
+// AppendBannedSubstrings appends value to the "banned_substrings" field.
+func (_u *PasswordPolicyUpdateOne) AppendBannedSubstrings(v []string) *PasswordPolicyUpdateOne {
+	_u.mutation.AppendBannedSubstrings(v)
+	return _u
+}
+
+// ClearBannedSubstrings clears the value of the "banned_substrings" field.
+func (_u *PasswordPolicyUpdateOne) ClearBannedSubstrings() *PasswordPolicyUpdateOne {
+	_u.mutation.ClearBannedSubstrings()
+	return _u
+}
+
+// SetHistoryCount sets the "history_count" field.
+func (_u *PasswordPolicyUpdateOne) SetHistoryCount(v uint32) *PasswordPolicyUpdateOne {
+	_u.mutation.ResetHistoryCount()
+	_u.mutation.SetHistoryCount(v)
+	return _u
+}
+
+// SetNillableHistoryCount sets the "history_count" field if the given value is not nil.
+func (_u *PasswordPolicyUpdateOne) SetNillableHistoryCount(v *uint32) *PasswordPolicyUpdateOne {
+	if v != nil {
+		_u.SetHistoryCount(*v)
+	}
+	return _u
+}
+
+// AddHistoryCount adds value to the "history_count" field.
+func (_u *PasswordPolicyUpdateOne) AddHistoryCount(v int32) *PasswordPolicyUpdateOne {
+	_u.mutation.AddHistoryCount(v)
+	return _u
+}
+
+// ClearHistoryCount clears the value of the "history_count" field.
+func (_u *PasswordPolicyUpdateOne) ClearHistoryCount() *PasswordPolicyUpdateOne {
+	_u.mutation.ClearHistoryCount()
+	return _u
+}
+
+// SetMaxAgeDays sets the "max_age_days" field.
+func (_u *PasswordPolicyUpdateOne) SetMaxAgeDays(v uint32) *PasswordPolicyUpdateOne {
+	_u.mutation.ResetMaxAgeDays()
+	_u.mutation.SetMaxAgeDays(v)
+	return _u
+}
+
+// SetNillableMaxAgeDays sets the "max_age_days" field if the given value is not nil.
+func (_u *PasswordPolicyUpdateOne) SetNillableMaxAgeDays(v *uint32) *PasswordPolicyUpdateOne {
+	if v != nil {
+		_u.SetMaxAgeDays(*v)
+	}
+	return _u
+}
+
+// AddMaxAgeDays adds value to the "max_age_days" field.
+func (_u *PasswordPolicyUpdateOne) AddMaxAgeDays(v int32) *PasswordPolicyUpdateOne {
+	_u.mutation.AddMaxAgeDays(v)
+	return _u
+}
+
+// ClearMaxAgeDays clears the value of the "max_age_days" field.
+func (_u *PasswordPolicyUpdateOne) ClearMaxAgeDays() *PasswordPolicyUpdateOne {
+	_u.mutation.ClearMaxAgeDays()
+	return _u
+}
+
+// SetCheckBreached sets the "check_breached" field.
+func (_u *PasswordPolicyUpdateOne) SetCheckBreached(v bool) *PasswordPolicyUpdateOne {
+	_u.mutation.SetCheckBreached(v)
+	return _u
+}
+
+// SetNillableCheckBreached sets the "check_breached" field if the given value is not nil.
+func (_u *PasswordPolicyUpdateOne) SetNillableCheckBreached(v *bool) *PasswordPolicyUpdateOne {
+	if v != nil {
+		_u.SetCheckBreached(*v)
+	}
+	return _u
+}
+
+// ClearCheckBreached clears the value of the "check_breached" field.
+func (_u *PasswordPolicyUpdateOne) ClearCheckBreached() *PasswordPolicyUpdateOne {
+	_u.mutation.ClearCheckBreached()
+	return _u
+}
+
+// Mutation returns the PasswordPolicyMutation object of the builder.
+func (_u *PasswordPolicyUpdateOne) Mutation() *PasswordPolicyMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the PasswordPolicyUpdate builder.
+func (_u *PasswordPolicyUpdateOne) Where(ps ...predicate.PasswordPolicy) *PasswordPolicyUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PasswordPolicyUpdateOne) Select(field string, fields ...string) *PasswordPolicyUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PasswordPolicy entity.
+func (_u *PasswordPolicyUpdateOne) Save(ctx context.Context) (*PasswordPolicy, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PasswordPolicyUpdateOne) SaveX(ctx context.Context) *PasswordPolicy {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PasswordPolicyUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PasswordPolicyUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *PasswordPolicyUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PasswordPolicyUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *PasswordPolicyUpdateOne) sqlSave(ctx context.Context) (_node *PasswordPolicy, err error) {
+	_spec := sqlgraph.NewUpdateSpec(passwordpolicy.Table, passwordpolicy.Columns, sqlgraph.NewFieldSpec(passwordpolicy.FieldID, field.TypeUint32))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PasswordPolicy.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, passwordpolicy.FieldID)
+		for _, f := range fields {
+			if !passwordpolicy.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != passwordpolicy.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(passwordpolicy.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(passwordpolicy.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(passwordpolicy.FieldUpdatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(passwordpolicy.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(passwordpolicy.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(passwordpolicy.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(passwordpolicy.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(passwordpolicy.FieldCreatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UpdatedBy(); ok {
+		_spec.SetField(passwordpolicy.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedBy(); ok {
+		_spec.AddField(passwordpolicy.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdatedByCleared() {
+		_spec.ClearField(passwordpolicy.FieldUpdatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.DeletedBy(); ok {
+		_spec.SetField(passwordpolicy.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedBy(); ok {
+		_spec.AddField(passwordpolicy.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.DeletedByCleared() {
+		_spec.ClearField(passwordpolicy.FieldDeletedBy, field.TypeUint32)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(passwordpolicy.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.MinLength(); ok {
+		_spec.SetField(passwordpolicy.FieldMinLength, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedMinLength(); ok {
+		_spec.AddField(passwordpolicy.FieldMinLength, field.TypeUint32, value)
+	}
+	if _u.mutation.MinLengthCleared() {
+		_spec.ClearField(passwordpolicy.FieldMinLength, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.RequireUppercase(); ok {
+		_spec.SetField(passwordpolicy.FieldRequireUppercase, field.TypeBool, value)
+	}
+	if _u.mutation.RequireUppercaseCleared() {
+		_spec.ClearField(passwordpolicy.FieldRequireUppercase, field.TypeBool)
+	}
+	if value, ok := _u.mutation.RequireLowercase(); ok {
+		_spec.SetField(passwordpolicy.FieldRequireLowercase, field.TypeBool, value)
+	}
+	if _u.mutation.RequireLowercaseCleared() {
+		_spec.ClearField(passwordpolicy.FieldRequireLowercase, field.TypeBool)
+	}
+	if value, ok := _u.mutation.RequireDigit(); ok {
+		_spec.SetField(passwordpolicy.FieldRequireDigit, field.TypeBool, value)
+	}
+	if _u.mutation.RequireDigitCleared() {
+		_spec.ClearField(passwordpolicy.FieldRequireDigit, field.TypeBool)
+	}
+	if value, ok := _u.mutation.RequireSymbol(); ok {
+		_spec.SetField(passwordpolicy.FieldRequireSymbol, field.TypeBool, value)
+	}
+	if _u.mutation.RequireSymbolCleared() {
+		_spec.ClearField(passwordpolicy.FieldRequireSymbol, field.TypeBool)
+	}
+	if value, ok := _u.mutation.DisallowIdentity(); ok {
+		_spec.SetField(passwordpolicy.FieldDisallowIdentity, field.TypeBool, value)
+	}
+	if _u.mutation.DisallowIdentityCleared() {
+		_spec.ClearField(passwordpolicy.FieldDisallowIdentity, field.TypeBool)
+	}
+	if value, ok := _u.mutation.BannedSubstrings(); ok {
+		_spec.SetField(passwordpolicy.FieldBannedSubstrings, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedBannedSubstrings(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, passwordpolicy.FieldBannedSubstrings, value)
+		})
+	}
+	if _u.mutation.BannedSubstringsCleared() {
+		_spec.ClearField(passwordpolicy.FieldBannedSubstrings, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.HistoryCount(); ok {
+		_spec.SetField(passwordpolicy.FieldHistoryCount, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedHistoryCount(); ok {
+		_spec.AddField(passwordpolicy.FieldHistoryCount, field.TypeUint32, value)
+	}
+	if _u.mutation.HistoryCountCleared() {
+		_spec.ClearField(passwordpolicy.FieldHistoryCount, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.MaxAgeDays(); ok {
+		_spec.SetField(passwordpolicy.FieldMaxAgeDays, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedMaxAgeDays(); ok {
+		_spec.AddField(passwordpolicy.FieldMaxAgeDays, field.TypeUint32, value)
+	}
+	if _u.mutation.MaxAgeDaysCleared() {
+		_spec.ClearField(passwordpolicy.FieldMaxAgeDays, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.CheckBreached(); ok {
+		_spec.SetField(passwordpolicy.FieldCheckBreached, field.TypeBool, value)
+	}
+	if _u.mutation.CheckBreachedCleared() {
+		_spec.ClearField(passwordpolicy.FieldCheckBreached, field.TypeBool)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &PasswordPolicy{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{passwordpolicy.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
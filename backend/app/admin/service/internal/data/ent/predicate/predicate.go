@@ -12,6 +12,9 @@
 // ApiAuditLog is the predicate function for apiauditlog builders.
 type ApiAuditLog func(*sql.Selector)
 
+// ApiClient is the predicate function for apiclient builders.
+type ApiClient func(*sql.Selector)
+
 // DataAccessAuditLog is the predicate function for dataaccessauditlog builders.
 type DataAccessAuditLog func(*sql.Selector)
 
@@ -36,9 +39,15 @@
 // InternalMessageRecipient is the predicate function for internalmessagerecipient builders.
 type InternalMessageRecipient func(*sql.Selector)
 
+// JwtSigningKey is the predicate function for jwtsigningkey builders.
+type JwtSigningKey func(*sql.Selector)
+
 // Language is the predicate function for language builders.
 type Language func(*sql.Selector)
 
+// LdapConfig is the predicate function for ldapconfig builders.
+type LdapConfig func(*sql.Selector)
+
 // LoginAuditLog is the predicate function for loginauditlog builders.
 type LoginAuditLog func(*sql.Selector)
 
@@ -60,12 +69,21 @@
 // Menu is the predicate function for menu builders.
 type Menu func(*sql.Selector)
 
+// MfaPolicy is the predicate function for mfapolicy builders.
+type MfaPolicy func(*sql.Selector)
+
+// OAuthProviderConfig is the predicate function for oauthproviderconfig builders.
+type OAuthProviderConfig func(*sql.Selector)
+
 // OperationAuditLog is the predicate function for operationauditlog builders.
 type OperationAuditLog func(*sql.Selector)
 
 // OrgUnit is the predicate function for orgunit builders.
 type OrgUnit func(*sql.Selector)
 
+// PasswordPolicy is the predicate function for passwordpolicy builders.
+type PasswordPolicy func(*sql.Selector)
+
 // Permission is the predicate function for permission builders.
 type Permission func(*sql.Selector)
 
@@ -99,6 +117,12 @@
 // Position is the predicate function for position builders.
 type Position func(*sql.Selector)
 
+// RelationTuple is the predicate function for relationtuple builders.
+type RelationTuple func(*sql.Selector)
+
+// RelationTupleChange is the predicate function for relationtuplechange builders.
+type RelationTupleChange func(*sql.Selector)
+
 // Role is the predicate function for role builders.
 type Role func(*sql.Selector)
 
@@ -108,6 +132,12 @@
 // RolePermission is the predicate function for rolepermission builders.
 type RolePermission func(*sql.Selector)
 
+// SamlConfig is the predicate function for samlconfig builders.
+type SamlConfig func(*sql.Selector)
+
+// ScimToken is the predicate function for scimtoken builders.
+type ScimToken func(*sql.Selector)
+
 // Task is the predicate function for task builders.
 type Task func(*sql.Selector)
 
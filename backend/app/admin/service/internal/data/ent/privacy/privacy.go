@@ -159,6 +159,30 @@ func (f ApiAuditLogMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mut
 	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.ApiAuditLogMutation", m)
 }
 
+// The ApiClientQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type ApiClientQueryRuleFunc func(context.Context, *ent.ApiClientQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f ApiClientQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ApiClientQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.ApiClientQuery", q)
+}
+
+// The ApiClientMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type ApiClientMutationRuleFunc func(context.Context, *ent.ApiClientMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f ApiClientMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.ApiClientMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.ApiClientMutation", m)
+}
+
 // The DataAccessAuditLogQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type DataAccessAuditLogQueryRuleFunc func(context.Context, *ent.DataAccessAuditLogQuery) error
@@ -351,6 +375,30 @@ func (f InternalMessageRecipientMutationRuleFunc) EvalMutation(ctx context.Conte
 	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.InternalMessageRecipientMutation", m)
 }
 
+// The JwtSigningKeyQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type JwtSigningKeyQueryRuleFunc func(context.Context, *ent.JwtSigningKeyQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f JwtSigningKeyQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.JwtSigningKeyQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.JwtSigningKeyQuery", q)
+}
+
+// The JwtSigningKeyMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type JwtSigningKeyMutationRuleFunc func(context.Context, *ent.JwtSigningKeyMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f JwtSigningKeyMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.JwtSigningKeyMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.JwtSigningKeyMutation", m)
+}
+
 // The LanguageQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type LanguageQueryRuleFunc func(context.Context, *ent.LanguageQuery) error
@@ -375,6 +423,30 @@ func (f LanguageMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutati
 	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.LanguageMutation", m)
 }
 
+// The LdapConfigQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type LdapConfigQueryRuleFunc func(context.Context, *ent.LdapConfigQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f LdapConfigQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.LdapConfigQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.LdapConfigQuery", q)
+}
+
+// The LdapConfigMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type LdapConfigMutationRuleFunc func(context.Context, *ent.LdapConfigMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f LdapConfigMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.LdapConfigMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.LdapConfigMutation", m)
+}
+
 // The LoginAuditLogQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type LoginAuditLogQueryRuleFunc func(context.Context, *ent.LoginAuditLogQuery) error
@@ -543,6 +615,54 @@ func (f MenuMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation)
 	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.MenuMutation", m)
 }
 
+// The MfaPolicyQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type MfaPolicyQueryRuleFunc func(context.Context, *ent.MfaPolicyQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f MfaPolicyQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.MfaPolicyQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.MfaPolicyQuery", q)
+}
+
+// The MfaPolicyMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type MfaPolicyMutationRuleFunc func(context.Context, *ent.MfaPolicyMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f MfaPolicyMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.MfaPolicyMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.MfaPolicyMutation", m)
+}
+
+// The OAuthProviderConfigQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type OAuthProviderConfigQueryRuleFunc func(context.Context, *ent.OAuthProviderConfigQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f OAuthProviderConfigQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.OAuthProviderConfigQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.OAuthProviderConfigQuery", q)
+}
+
+// The OAuthProviderConfigMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type OAuthProviderConfigMutationRuleFunc func(context.Context, *ent.OAuthProviderConfigMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f OAuthProviderConfigMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.OAuthProviderConfigMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.OAuthProviderConfigMutation", m)
+}
+
 // The OperationAuditLogQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type OperationAuditLogQueryRuleFunc func(context.Context, *ent.OperationAuditLogQuery) error
@@ -591,6 +711,30 @@ func (f OrgUnitMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutatio
 	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.OrgUnitMutation", m)
 }
 
+// The PasswordPolicyQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type PasswordPolicyQueryRuleFunc func(context.Context, *ent.PasswordPolicyQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f PasswordPolicyQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.PasswordPolicyQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.PasswordPolicyQuery", q)
+}
+
+// The PasswordPolicyMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type PasswordPolicyMutationRuleFunc func(context.Context, *ent.PasswordPolicyMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f PasswordPolicyMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.PasswordPolicyMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.PasswordPolicyMutation", m)
+}
+
 // The PermissionQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type PermissionQueryRuleFunc func(context.Context, *ent.PermissionQuery) error
@@ -855,6 +999,54 @@ func (f PositionMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutati
 	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.PositionMutation", m)
 }
 
+// The RelationTupleQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type RelationTupleQueryRuleFunc func(context.Context, *ent.RelationTupleQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f RelationTupleQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.RelationTupleQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.RelationTupleQuery", q)
+}
+
+// The RelationTupleMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type RelationTupleMutationRuleFunc func(context.Context, *ent.RelationTupleMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f RelationTupleMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.RelationTupleMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.RelationTupleMutation", m)
+}
+
+// The RelationTupleChangeQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type RelationTupleChangeQueryRuleFunc func(context.Context, *ent.RelationTupleChangeQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f RelationTupleChangeQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.RelationTupleChangeQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.RelationTupleChangeQuery", q)
+}
+
+// The RelationTupleChangeMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type RelationTupleChangeMutationRuleFunc func(context.Context, *ent.RelationTupleChangeMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f RelationTupleChangeMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.RelationTupleChangeMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.RelationTupleChangeMutation", m)
+}
+
 // The RoleQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type RoleQueryRuleFunc func(context.Context, *ent.RoleQuery) error
@@ -927,6 +1119,54 @@ func (f RolePermissionMutationRuleFunc) EvalMutation(ctx context.Context, m ent.
 	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.RolePermissionMutation", m)
 }
 
+// The SamlConfigQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type SamlConfigQueryRuleFunc func(context.Context, *ent.SamlConfigQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f SamlConfigQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.SamlConfigQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.SamlConfigQuery", q)
+}
+
+// The SamlConfigMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type SamlConfigMutationRuleFunc func(context.Context, *ent.SamlConfigMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f SamlConfigMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.SamlConfigMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.SamlConfigMutation", m)
+}
+
+// The ScimTokenQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type ScimTokenQueryRuleFunc func(context.Context, *ent.ScimTokenQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f ScimTokenQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ScimTokenQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.ScimTokenQuery", q)
+}
+
+// The ScimTokenMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type ScimTokenMutationRuleFunc func(context.Context, *ent.ScimTokenMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f ScimTokenMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.ScimTokenMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.ScimTokenMutation", m)
+}
+
 // The TaskQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type TaskQueryRuleFunc func(context.Context, *ent.TaskQuery) error
@@ -1158,6 +1398,8 @@ func queryFilter(q ent.Query) (Filter, error) {
 		return q.Filter(), nil
 	case *ent.ApiAuditLogQuery:
 		return q.Filter(), nil
+	case *ent.ApiClientQuery:
+		return q.Filter(), nil
 	case *ent.DataAccessAuditLogQuery:
 		return q.Filter(), nil
 	case *ent.DictEntryQuery:
@@ -1174,8 +1416,12 @@ func queryFilter(q ent.Query) (Filter, error) {
 		return q.Filter(), nil
 	case *ent.InternalMessageRecipientQuery:
 		return q.Filter(), nil
+	case *ent.JwtSigningKeyQuery:
+		return q.Filter(), nil
 	case *ent.LanguageQuery:
 		return q.Filter(), nil
+	case *ent.LdapConfigQuery:
+		return q.Filter(), nil
 	case *ent.LoginAuditLogQuery:
 		return q.Filter(), nil
 	case *ent.LoginPolicyQuery:
@@ -1190,10 +1436,16 @@ func queryFilter(q ent.Query) (Filter, error) {
 		return q.Filter(), nil
 	case *ent.MenuQuery:
 		return q.Filter(), nil
+	case *ent.MfaPolicyQuery:
+		return q.Filter(), nil
+	case *ent.OAuthProviderConfigQuery:
+		return q.Filter(), nil
 	case *ent.OperationAuditLogQuery:
 		return q.Filter(), nil
 	case *ent.OrgUnitQuery:
 		return q.Filter(), nil
+	case *ent.PasswordPolicyQuery:
+		return q.Filter(), nil
 	case *ent.PermissionQuery:
 		return q.Filter(), nil
 	case *ent.PermissionApiQuery:
@@ -1216,12 +1468,20 @@ func queryFilter(q ent.Query) (Filter, error) {
 		return q.Filter(), nil
 	case *ent.PositionQuery:
 		return q.Filter(), nil
+	case *ent.RelationTupleQuery:
+		return q.Filter(), nil
+	case *ent.RelationTupleChangeQuery:
+		return q.Filter(), nil
 	case *ent.RoleQuery:
 		return q.Filter(), nil
 	case *ent.RoleMetadataQuery:
 		return q.Filter(), nil
 	case *ent.RolePermissionQuery:
 		return q.Filter(), nil
+	case *ent.SamlConfigQuery:
+		return q.Filter(), nil
+	case *ent.ScimTokenQuery:
+		return q.Filter(), nil
 	case *ent.TaskQuery:
 		return q.Filter(), nil
 	case *ent.TenantQuery:
@@ -1249,6 +1509,8 @@ func mutationFilter(m ent.Mutation) (Filter, error) {
 		return m.Filter(), nil
 	case *ent.ApiAuditLogMutation:
 		return m.Filter(), nil
+	case *ent.ApiClientMutation:
+		return m.Filter(), nil
 	case *ent.DataAccessAuditLogMutation:
 		return m.Filter(), nil
 	case *ent.DictEntryMutation:
@@ -1265,8 +1527,12 @@ func mutationFilter(m ent.Mutation) (Filter, error) {
 		return m.Filter(), nil
 	case *ent.InternalMessageRecipientMutation:
 		return m.Filter(), nil
+	case *ent.JwtSigningKeyMutation:
+		return m.Filter(), nil
 	case *ent.LanguageMutation:
 		return m.Filter(), nil
+	case *ent.LdapConfigMutation:
+		return m.Filter(), nil
 	case *ent.LoginAuditLogMutation:
 		return m.Filter(), nil
 	case *ent.LoginPolicyMutation:
@@ -1281,10 +1547,16 @@ func mutationFilter(m ent.Mutation) (Filter, error) {
 		return m.Filter(), nil
 	case *ent.MenuMutation:
 		return m.Filter(), nil
+	case *ent.MfaPolicyMutation:
+		return m.Filter(), nil
+	case *ent.OAuthProviderConfigMutation:
+		return m.Filter(), nil
 	case *ent.OperationAuditLogMutation:
 		return m.Filter(), nil
 	case *ent.OrgUnitMutation:
 		return m.Filter(), nil
+	case *ent.PasswordPolicyMutation:
+		return m.Filter(), nil
 	case *ent.PermissionMutation:
 		return m.Filter(), nil
 	case *ent.PermissionApiMutation:
@@ -1307,12 +1579,20 @@ func mutationFilter(m ent.Mutation) (Filter, error) {
 		return m.Filter(), nil
 	case *ent.PositionMutation:
 		return m.Filter(), nil
+	case *ent.RelationTupleMutation:
+		return m.Filter(), nil
+	case *ent.RelationTupleChangeMutation:
+		return m.Filter(), nil
 	case *ent.RoleMutation:
 		return m.Filter(), nil
 	case *ent.RoleMetadataMutation:
 		return m.Filter(), nil
 	case *ent.RolePermissionMutation:
 		return m.Filter(), nil
+	case *ent.SamlConfigMutation:
+		return m.Filter(), nil
+	case *ent.ScimTokenMutation:
+		return m.Filter(), nil
 	case *ent.TaskMutation:
 		return m.Filter(), nil
 	case *ent.TenantMutation:
@@ -0,0 +1,925 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-wind-admin/app/admin/service/internal/data/ent/relationtuple"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RelationTupleCreate is the builder for creating a RelationTuple entity.
+type RelationTupleCreate struct {
+	config
+	mutation *RelationTupleMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *RelationTupleCreate) SetCreatedAt(v time.Time) *RelationTupleCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *RelationTupleCreate) SetNillableCreatedAt(v *time.Time) *RelationTupleCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *RelationTupleCreate) SetCreatedBy(v uint32) *RelationTupleCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *RelationTupleCreate) SetNillableCreatedBy(v *uint32) *RelationTupleCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetNamespace sets the "namespace" field.
+func (_c *RelationTupleCreate) SetNamespace(v string) *RelationTupleCreate {
+	_c.mutation.SetNamespace(v)
+	return _c
+}
+
+// SetObjectID sets the "object_id" field.
+func (_c *RelationTupleCreate) SetObjectID(v string) *RelationTupleCreate {
+	_c.mutation.SetObjectID(v)
+	return _c
+}
+
+// SetRelation sets the "relation" field.
+func (_c *RelationTupleCreate) SetRelation(v string) *RelationTupleCreate {
+	_c.mutation.SetRelation(v)
+	return _c
+}
+
+// SetSubjectNamespace sets the "subject_namespace" field.
+func (_c *RelationTupleCreate) SetSubjectNamespace(v string) *RelationTupleCreate {
+	_c.mutation.SetSubjectNamespace(v)
+	return _c
+}
+
+// SetSubjectID sets the "subject_id" field.
+func (_c *RelationTupleCreate) SetSubjectID(v string) *RelationTupleCreate {
+	_c.mutation.SetSubjectID(v)
+	return _c
+}
+
+// SetSubjectRelation sets the "subject_relation" field.
+func (_c *RelationTupleCreate) SetSubjectRelation(v string) *RelationTupleCreate {
+	_c.mutation.SetSubjectRelation(v)
+	return _c
+}
+
+// SetNillableSubjectRelation sets the "subject_relation" field if the given value is not nil.
+func (_c *RelationTupleCreate) SetNillableSubjectRelation(v *string) *RelationTupleCreate {
+	if v != nil {
+		_c.SetSubjectRelation(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *RelationTupleCreate) SetID(v uint32) *RelationTupleCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the RelationTupleMutation object of the builder.
+func (_c *RelationTupleCreate) Mutation() *RelationTupleMutation {
+	return _c.mutation
+}
+
+// Save creates the RelationTuple in the database.
+func (_c *RelationTupleCreate) Save(ctx context.Context) (*RelationTuple, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *RelationTupleCreate) SaveX(ctx context.Context) *RelationTuple {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RelationTupleCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RelationTupleCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *RelationTupleCreate) defaults() {
+	if _, ok := _c.mutation.SubjectRelation(); !ok {
+		v := relationtuple.DefaultSubjectRelation
+		_c.mutation.SetSubjectRelation(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *RelationTupleCreate) check() error {
+	if _, ok := _c.mutation.Namespace(); !ok {
+		return &ValidationError{Name: "namespace", err: errors.New(`ent: missing required field "RelationTuple.namespace"`)}
+	}
+	if v, ok := _c.mutation.Namespace(); ok {
+		if err := relationtuple.NamespaceValidator(v); err != nil {
+			return &ValidationError{Name: "namespace", err: fmt.Errorf(`ent: validator failed for field "RelationTuple.namespace": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ObjectID(); !ok {
+		return &ValidationError{Name: "object_id", err: errors.New(`ent: missing required field "RelationTuple.object_id"`)}
+	}
+	if v, ok := _c.mutation.ObjectID(); ok {
+		if err := relationtuple.ObjectIDValidator(v); err != nil {
+			return &ValidationError{Name: "object_id", err: fmt.Errorf(`ent: validator failed for field "RelationTuple.object_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Relation(); !ok {
+		return &ValidationError{Name: "relation", err: errors.New(`ent: missing required field "RelationTuple.relation"`)}
+	}
+	if v, ok := _c.mutation.Relation(); ok {
+		if err := relationtuple.RelationValidator(v); err != nil {
+			return &ValidationError{Name: "relation", err: fmt.Errorf(`ent: validator failed for field "RelationTuple.relation": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.SubjectNamespace(); !ok {
+		return &ValidationError{Name: "subject_namespace", err: errors.New(`ent: missing required field "RelationTuple.subject_namespace"`)}
+	}
+	if v, ok := _c.mutation.SubjectNamespace(); ok {
+		if err := relationtuple.SubjectNamespaceValidator(v); err != nil {
+			return &ValidationError{Name: "subject_namespace", err: fmt.Errorf(`ent: validator failed for field "RelationTuple.subject_namespace": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.SubjectID(); !ok {
+		return &ValidationError{Name: "subject_id", err: errors.New(`ent: missing required field "RelationTuple.subject_id"`)}
+	}
+	if v, ok := _c.mutation.SubjectID(); ok {
+		if err := relationtuple.SubjectIDValidator(v); err != nil {
+			return &ValidationError{Name: "subject_id", err: fmt.Errorf(`ent: validator failed for field "RelationTuple.subject_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.SubjectRelation(); !ok {
+		return &ValidationError{Name: "subject_relation", err: errors.New(`ent: missing required field "RelationTuple.subject_relation"`)}
+	}
+	if v, ok := _c.mutation.SubjectRelation(); ok {
+		if err := relationtuple.SubjectRelationValidator(v); err != nil {
+			return &ValidationError{Name: "subject_relation", err: fmt.Errorf(`ent: validator failed for field "RelationTuple.subject_relation": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := relationtuple.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "RelationTuple.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *RelationTupleCreate) sqlSave(ctx context.Context) (*RelationTuple, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint32(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *RelationTupleCreate) createSpec() (*RelationTuple, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RelationTuple{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(relationtuple.Table, sqlgraph.NewFieldSpec(relationtuple.FieldID, field.TypeUint32))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(relationtuple.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(relationtuple.FieldCreatedBy, field.TypeUint32, value)
+		_node.CreatedBy = &value
+	}
+	if value, ok := _c.mutation.Namespace(); ok {
+		_spec.SetField(relationtuple.FieldNamespace, field.TypeString, value)
+		_node.Namespace = value
+	}
+	if value, ok := _c.mutation.ObjectID(); ok {
+		_spec.SetField(relationtuple.FieldObjectID, field.TypeString, value)
+		_node.ObjectID = value
+	}
+	if value, ok := _c.mutation.Relation(); ok {
+		_spec.SetField(relationtuple.FieldRelation, field.TypeString, value)
+		_node.Relation = value
+	}
+	if value, ok := _c.mutation.SubjectNamespace(); ok {
+		_spec.SetField(relationtuple.FieldSubjectNamespace, field.TypeString, value)
+		_node.SubjectNamespace = value
+	}
+	if value, ok := _c.mutation.SubjectID(); ok {
+		_spec.SetField(relationtuple.FieldSubjectID, field.TypeString, value)
+		_node.SubjectID = value
+	}
+	if value, ok := _c.mutation.SubjectRelation(); ok {
+		_spec.SetField(relationtuple.FieldSubjectRelation, field.TypeString, value)
+		_node.SubjectRelation = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.RelationTuple.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RelationTupleUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *RelationTupleCreate) OnConflict(opts ...sql.ConflictOption) *RelationTupleUpsertOne {
+	_c.conflict = opts
+	return &RelationTupleUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.RelationTuple.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *RelationTupleCreate) OnConflictColumns(columns ...string) *RelationTupleUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &RelationTupleUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// RelationTupleUpsertOne is the builder for "upsert"-ing
+	//  one RelationTuple node.
+	RelationTupleUpsertOne struct {
+		create *RelationTupleCreate
+	}
+
+	// RelationTupleUpsert is the "OnConflict" setter.
+	RelationTupleUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCreatedBy sets the "created_by" field.
+func (u *RelationTupleUpsert) SetCreatedBy(v uint32) *RelationTupleUpsert {
+	u.Set(relationtuple.FieldCreatedBy, v)
+	return u
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *RelationTupleUpsert) UpdateCreatedBy() *RelationTupleUpsert {
+	u.SetExcluded(relationtuple.FieldCreatedBy)
+	return u
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *RelationTupleUpsert) AddCreatedBy(v uint32) *RelationTupleUpsert {
+	u.Add(relationtuple.FieldCreatedBy, v)
+	return u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *RelationTupleUpsert) ClearCreatedBy() *RelationTupleUpsert {
+	u.SetNull(relationtuple.FieldCreatedBy)
+	return u
+}
+
+// SetNamespace sets the "namespace" field.
+func (u *RelationTupleUpsert) SetNamespace(v string) *RelationTupleUpsert {
+	u.Set(relationtuple.FieldNamespace, v)
+	return u
+}
+
+// UpdateNamespace sets the "namespace" field to the value that was provided on create.
+func (u *RelationTupleUpsert) UpdateNamespace() *RelationTupleUpsert {
+	u.SetExcluded(relationtuple.FieldNamespace)
+	return u
+}
+
+// SetObjectID sets the "object_id" field.
+func (u *RelationTupleUpsert) SetObjectID(v string) *RelationTupleUpsert {
+	u.Set(relationtuple.FieldObjectID, v)
+	return u
+}
+
+// UpdateObjectID sets the "object_id" field to the value that was provided on create.
+func (u *RelationTupleUpsert) UpdateObjectID() *RelationTupleUpsert {
+	u.SetExcluded(relationtuple.FieldObjectID)
+	return u
+}
+
+// SetRelation sets the "relation" field.
+func (u *RelationTupleUpsert) SetRelation(v string) *RelationTupleUpsert {
+	u.Set(relationtuple.FieldRelation, v)
+	return u
+}
+
+// UpdateRelation sets the "relation" field to the value that was provided on create.
+func (u *RelationTupleUpsert) UpdateRelation() *RelationTupleUpsert {
+	u.SetExcluded(relationtuple.FieldRelation)
+	return u
+}
+
+// SetSubjectNamespace sets the "subject_namespace" field.
+func (u *RelationTupleUpsert) SetSubjectNamespace(v string) *RelationTupleUpsert {
+	u.Set(relationtuple.FieldSubjectNamespace, v)
+	return u
+}
+
+// UpdateSubjectNamespace sets the "subject_namespace" field to the value that was provided on create.
+func (u *RelationTupleUpsert) UpdateSubjectNamespace() *RelationTupleUpsert {
+	u.SetExcluded(relationtuple.FieldSubjectNamespace)
+	return u
+}
+
+// SetSubjectID sets the "subject_id" field.
+func (u *RelationTupleUpsert) SetSubjectID(v string) *RelationTupleUpsert {
+	u.Set(relationtuple.FieldSubjectID, v)
+	return u
+}
+
+// UpdateSubjectID sets the "subject_id" field to the value that was provided on create.
+func (u *RelationTupleUpsert) UpdateSubjectID() *RelationTupleUpsert {
+	u.SetExcluded(relationtuple.FieldSubjectID)
+	return u
+}
+
+// SetSubjectRelation sets the "subject_relation" field.
+func (u *RelationTupleUpsert) SetSubjectRelation(v string) *RelationTupleUpsert {
+	u.Set(relationtuple.FieldSubjectRelation, v)
+	return u
+}
+
+// UpdateSubjectRelation sets the "subject_relation" field to the value that was provided on create.
+func (u *RelationTupleUpsert) UpdateSubjectRelation() *RelationTupleUpsert {
+	u.SetExcluded(relationtuple.FieldSubjectRelation)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.RelationTuple.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(relationtuple.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *RelationTupleUpsertOne) UpdateNewValues() *RelationTupleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(relationtuple.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(relationtuple.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.RelationTuple.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *RelationTupleUpsertOne) Ignore() *RelationTupleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RelationTupleUpsertOne) DoNothing() *RelationTupleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RelationTupleCreate.OnConflict
+// documentation for more info.
+func (u *RelationTupleUpsertOne) Update(set func(*RelationTupleUpsert)) *RelationTupleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RelationTupleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *RelationTupleUpsertOne) SetCreatedBy(v uint32) *RelationTupleUpsertOne {
+	return u.Update(func(s *RelationTupleUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *RelationTupleUpsertOne) AddCreatedBy(v uint32) *RelationTupleUpsertOne {
+	return u.Update(func(s *RelationTupleUpsert) {
+		s.AddCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *RelationTupleUpsertOne) UpdateCreatedBy() *RelationTupleUpsertOne {
+	return u.Update(func(s *RelationTupleUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *RelationTupleUpsertOne) ClearCreatedBy() *RelationTupleUpsertOne {
+	return u.Update(func(s *RelationTupleUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// SetNamespace sets the "namespace" field.
+func (u *RelationTupleUpsertOne) SetNamespace(v string) *RelationTupleUpsertOne {
+	return u.Update(func(s *RelationTupleUpsert) {
+		s.SetNamespace(v)
+	})
+}
+
+// UpdateNamespace sets the "namespace" field to the value that was provided on create.
+func (u *RelationTupleUpsertOne) UpdateNamespace() *RelationTupleUpsertOne {
+	return u.Update(func(s *RelationTupleUpsert) {
+		s.UpdateNamespace()
+	})
+}
+
+// SetObjectID sets the "object_id" field.
+func (u *RelationTupleUpsertOne) SetObjectID(v string) *RelationTupleUpsertOne {
+	return u.Update(func(s *RelationTupleUpsert) {
+		s.SetObjectID(v)
+	})
+}
+
+// UpdateObjectID sets the "object_id" field to the value that was provided on create.
+func (u *RelationTupleUpsertOne) UpdateObjectID() *RelationTupleUpsertOne {
+	return u.Update(func(s *RelationTupleUpsert) {
+		s.UpdateObjectID()
+	})
+}
+
+// SetRelation sets the "relation" field.
+func (u *RelationTupleUpsertOne) SetRelation(v string) *RelationTupleUpsertOne {
+	return u.Update(func(s *RelationTupleUpsert) {
+		s.SetRelation(v)
+	})
+}
+
+// UpdateRelation sets the "relation" field to the value that was provided on create.
+func (u *RelationTupleUpsertOne) UpdateRelation() *RelationTupleUpsertOne {
+	return u.Update(func(s *RelationTupleUpsert) {
+		s.UpdateRelation()
+	})
+}
+
+// SetSubjectNamespace sets the "subject_namespace" field.
+func (u *RelationTupleUpsertOne) SetSubjectNamespace(v string) *RelationTupleUpsertOne {
+	return u.Update(func(s *RelationTupleUpsert) {
+		s.SetSubjectNamespace(v)
+	})
+}
+
+// UpdateSubjectNamespace sets the "subject_namespace" field to the value that was provided on create.
+func (u *RelationTupleUpsertOne) UpdateSubjectNamespace() *RelationTupleUpsertOne {
+	return u.Update(func(s *RelationTupleUpsert) {
+		s.UpdateSubjectNamespace()
+	})
+}
+
+// SetSubjectID sets the "subject_id" field.
+func (u *RelationTupleUpsertOne) SetSubjectID(v string) *RelationTupleUpsertOne {
+	return u.Update(func(s *RelationTupleUpsert) {
+		s.SetSubjectID(v)
+	})
+}
+
+// UpdateSubjectID sets the "subject_id" field to the value that was provided on create.
+func (u *RelationTupleUpsertOne) UpdateSubjectID() *RelationTupleUpsertOne {
+	return u.Update(func(s *RelationTupleUpsert) {
+		s.UpdateSubjectID()
+	})
+}
+
+// SetSubjectRelation sets the "subject_relation" field.
+func (u *RelationTupleUpsertOne) SetSubjectRelation(v string) *RelationTupleUpsertOne {
+	return u.Update(func(s *RelationTupleUpsert) {
+		s.SetSubjectRelation(v)
+	})
+}
+
+// UpdateSubjectRelation sets the "subject_relation" field to the value that was provided on create.
+func (u *RelationTupleUpsertOne) UpdateSubjectRelation() *RelationTupleUpsertOne {
+	return u.Update(func(s *RelationTupleUpsert) {
+		s.UpdateSubjectRelation()
+	})
+}
+
+// Exec executes the query.
+func (u *RelationTupleUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for RelationTupleCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RelationTupleUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *RelationTupleUpsertOne) ID(ctx context.Context) (id uint32, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *RelationTupleUpsertOne) IDX(ctx context.Context) uint32 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// RelationTupleCreateBulk is the builder for creating many RelationTuple entities in bulk.
+type RelationTupleCreateBulk struct {
+	config
+	err      error
+	builders []*RelationTupleCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the RelationTuple entities in the database.
+func (_c *RelationTupleCreateBulk) Save(ctx context.Context) ([]*RelationTuple, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*RelationTuple, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RelationTupleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint32(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *RelationTupleCreateBulk) SaveX(ctx context.Context) []*RelationTuple {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RelationTupleCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RelationTupleCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.RelationTuple.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RelationTupleUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *RelationTupleCreateBulk) OnConflict(opts ...sql.ConflictOption) *RelationTupleUpsertBulk {
+	_c.conflict = opts
+	return &RelationTupleUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.RelationTuple.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *RelationTupleCreateBulk) OnConflictColumns(columns ...string) *RelationTupleUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &RelationTupleUpsertBulk{
+		create: _c,
+	}
+}
+
+// RelationTupleUpsertBulk is the builder for "upsert"-ing
+// a bulk of RelationTuple nodes.
+type RelationTupleUpsertBulk struct {
+	create *RelationTupleCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.RelationTuple.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(relationtuple.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *RelationTupleUpsertBulk) UpdateNewValues() *RelationTupleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(relationtuple.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(relationtuple.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.RelationTuple.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *RelationTupleUpsertBulk) Ignore() *RelationTupleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RelationTupleUpsertBulk) DoNothing() *RelationTupleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RelationTupleCreateBulk.OnConflict
+// documentation for more info.
+func (u *RelationTupleUpsertBulk) Update(set func(*RelationTupleUpsert)) *RelationTupleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RelationTupleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *RelationTupleUpsertBulk) SetCreatedBy(v uint32) *RelationTupleUpsertBulk {
+	return u.Update(func(s *RelationTupleUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *RelationTupleUpsertBulk) AddCreatedBy(v uint32) *RelationTupleUpsertBulk {
+	return u.Update(func(s *RelationTupleUpsert) {
+		s.AddCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *RelationTupleUpsertBulk) UpdateCreatedBy() *RelationTupleUpsertBulk {
+	return u.Update(func(s *RelationTupleUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *RelationTupleUpsertBulk) ClearCreatedBy() *RelationTupleUpsertBulk {
+	return u.Update(func(s *RelationTupleUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// SetNamespace sets the "namespace" field.
+func (u *RelationTupleUpsertBulk) SetNamespace(v string) *RelationTupleUpsertBulk {
+	return u.Update(func(s *RelationTupleUpsert) {
+		s.SetNamespace(v)
+	})
+}
+
+// UpdateNamespace sets the "namespace" field to the value that was provided on create.
+func (u *RelationTupleUpsertBulk) UpdateNamespace() *RelationTupleUpsertBulk {
+	return u.Update(func(s *RelationTupleUpsert) {
+		s.UpdateNamespace()
+	})
+}
+
+// SetObjectID sets the "object_id" field.
+func (u *RelationTupleUpsertBulk) SetObjectID(v string) *RelationTupleUpsertBulk {
+	return u.Update(func(s *RelationTupleUpsert) {
+		s.SetObjectID(v)
+	})
+}
+
+// UpdateObjectID sets the "object_id" field to the value that was provided on create.
+func (u *RelationTupleUpsertBulk) UpdateObjectID() *RelationTupleUpsertBulk {
+	return u.Update(func(s *RelationTupleUpsert) {
+		s.UpdateObjectID()
+	})
+}
+
+// SetRelation sets the "relation" field.
+func (u *RelationTupleUpsertBulk) SetRelation(v string) *RelationTupleUpsertBulk {
+	return u.Update(func(s *RelationTupleUpsert) {
+		s.SetRelation(v)
+	})
+}
+
+// UpdateRelation sets the "relation" field to the value that was provided on create.
+func (u *RelationTupleUpsertBulk) UpdateRelation() *RelationTupleUpsertBulk {
+	return u.Update(func(s *RelationTupleUpsert) {
+		s.UpdateRelation()
+	})
+}
+
+// SetSubjectNamespace sets the "subject_namespace" field.
+func (u *RelationTupleUpsertBulk) SetSubjectNamespace(v string) *RelationTupleUpsertBulk {
+	return u.Update(func(s *RelationTupleUpsert) {
+		s.SetSubjectNamespace(v)
+	})
+}
+
+// UpdateSubjectNamespace sets the "subject_namespace" field to the value that was provided on create.
+func (u *RelationTupleUpsertBulk) UpdateSubjectNamespace() *RelationTupleUpsertBulk {
+	return u.Update(func(s *RelationTupleUpsert) {
+		s.UpdateSubjectNamespace()
+	})
+}
+
+// SetSubjectID sets the "subject_id" field.
+func (u *RelationTupleUpsertBulk) SetSubjectID(v string) *RelationTupleUpsertBulk {
+	return u.Update(func(s *RelationTupleUpsert) {
+		s.SetSubjectID(v)
+	})
+}
+
+// UpdateSubjectID sets the "subject_id" field to the value that was provided on create.
+func (u *RelationTupleUpsertBulk) UpdateSubjectID() *RelationTupleUpsertBulk {
+	return u.Update(func(s *RelationTupleUpsert) {
+		s.UpdateSubjectID()
+	})
+}
+
+// SetSubjectRelation sets the "subject_relation" field.
+func (u *RelationTupleUpsertBulk) SetSubjectRelation(v string) *RelationTupleUpsertBulk {
+	return u.Update(func(s *RelationTupleUpsert) {
+		s.SetSubjectRelation(v)
+	})
+}
+
+// UpdateSubjectRelation sets the "subject_relation" field to the value that was provided on create.
+func (u *RelationTupleUpsertBulk) UpdateSubjectRelation() *RelationTupleUpsertBulk {
+	return u.Update(func(s *RelationTupleUpsert) {
+		s.UpdateSubjectRelation()
+	})
+}
+
+// Exec executes the query.
+func (u *RelationTupleUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the RelationTupleCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for RelationTupleCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RelationTupleUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,549 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+	"go-wind-admin/app/admin/service/internal/data/ent/relationtuple"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RelationTupleUpdate is the builder for updating RelationTuple entities.
+type RelationTupleUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *RelationTupleMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the RelationTupleUpdate builder.
+func (_u *RelationTupleUpdate) Where(ps ...predicate.RelationTuple) *RelationTupleUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *RelationTupleUpdate) SetCreatedBy(v uint32) *RelationTupleUpdate {
+	_u.mutation.ResetCreatedBy()
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *RelationTupleUpdate) SetNillableCreatedBy(v *uint32) *RelationTupleUpdate {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// AddCreatedBy adds value to the "created_by" field.
+func (_u *RelationTupleUpdate) AddCreatedBy(v int32) *RelationTupleUpdate {
+	_u.mutation.AddCreatedBy(v)
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *RelationTupleUpdate) ClearCreatedBy() *RelationTupleUpdate {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetNamespace sets the "namespace" field.
+func (_u *RelationTupleUpdate) SetNamespace(v string) *RelationTupleUpdate {
+	_u.mutation.SetNamespace(v)
+	return _u
+}
+
+// SetNillableNamespace sets the "namespace" field if the given value is not nil.
+func (_u *RelationTupleUpdate) SetNillableNamespace(v *string) *RelationTupleUpdate {
+	if v != nil {
+		_u.SetNamespace(*v)
+	}
+	return _u
+}
+
+// SetObjectID sets the "object_id" field.
+func (_u *RelationTupleUpdate) SetObjectID(v string) *RelationTupleUpdate {
+	_u.mutation.SetObjectID(v)
+	return _u
+}
+
+// SetNillableObjectID sets the "object_id" field if the given value is not nil.
+func (_u *RelationTupleUpdate) SetNillableObjectID(v *string) *RelationTupleUpdate {
+	if v != nil {
+		_u.SetObjectID(*v)
+	}
+	return _u
+}
+
+// SetRelation sets the "relation" field.
+func (_u *RelationTupleUpdate) SetRelation(v string) *RelationTupleUpdate {
+	_u.mutation.SetRelation(v)
+	return _u
+}
+
+// SetNillableRelation sets the "relation" field if the given value is not nil.
+func (_u *RelationTupleUpdate) SetNillableRelation(v *string) *RelationTupleUpdate {
+	if v != nil {
+		_u.SetRelation(*v)
+	}
+	return _u
+}
+
+// SetSubjectNamespace sets the "subject_namespace" field.
+func (_u *RelationTupleUpdate) SetSubjectNamespace(v string) *RelationTupleUpdate {
+	_u.mutation.SetSubjectNamespace(v)
+	return _u
+}
+
+// SetNillableSubjectNamespace sets the "subject_namespace" field if the given value is not nil.
+func (_u *RelationTupleUpdate) SetNillableSubjectNamespace(v *string) *RelationTupleUpdate {
+	if v != nil {
+		_u.SetSubjectNamespace(*v)
+	}
+	return _u
+}
+
+// SetSubjectID sets the "subject_id" field.
+func (_u *RelationTupleUpdate) SetSubjectID(v string) *RelationTupleUpdate {
+	_u.mutation.SetSubjectID(v)
+	return _u
+}
+
+// SetNillableSubjectID sets the "subject_id" field if the given value is not nil.
+func (_u *RelationTupleUpdate) SetNillableSubjectID(v *string) *RelationTupleUpdate {
+	if v != nil {
+		_u.SetSubjectID(*v)
+	}
+	return _u
+}
+
+// SetSubjectRelation sets the "subject_relation" field.
+func (_u *RelationTupleUpdate) SetSubjectRelation(v string) *RelationTupleUpdate {
+	_u.mutation.SetSubjectRelation(v)
+	return _u
+}
+
+// SetNillableSubjectRelation sets the "subject_relation" field if the given value is not nil.
+func (_u *RelationTupleUpdate) SetNillableSubjectRelation(v *string) *RelationTupleUpdate {
+	if v != nil {
+		_u.SetSubjectRelation(*v)
+	}
+	return _u
+}
+
+// Mutation returns the RelationTupleMutation object of the builder.
+func (_u *RelationTupleUpdate) Mutation() *RelationTupleMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *RelationTupleUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RelationTupleUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *RelationTupleUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RelationTupleUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RelationTupleUpdate) check() error {
+	if v, ok := _u.mutation.Namespace(); ok {
+		if err := relationtuple.NamespaceValidator(v); err != nil {
+			return &ValidationError{Name: "namespace", err: fmt.Errorf(`ent: validator failed for field "RelationTuple.namespace": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ObjectID(); ok {
+		if err := relationtuple.ObjectIDValidator(v); err != nil {
+			return &ValidationError{Name: "object_id", err: fmt.Errorf(`ent: validator failed for field "RelationTuple.object_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Relation(); ok {
+		if err := relationtuple.RelationValidator(v); err != nil {
+			return &ValidationError{Name: "relation", err: fmt.Errorf(`ent: validator failed for field "RelationTuple.relation": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SubjectNamespace(); ok {
+		if err := relationtuple.SubjectNamespaceValidator(v); err != nil {
+			return &ValidationError{Name: "subject_namespace", err: fmt.Errorf(`ent: validator failed for field "RelationTuple.subject_namespace": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SubjectID(); ok {
+		if err := relationtuple.SubjectIDValidator(v); err != nil {
+			return &ValidationError{Name: "subject_id", err: fmt.Errorf(`ent: validator failed for field "RelationTuple.subject_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SubjectRelation(); ok {
+		if err := relationtuple.SubjectRelationValidator(v); err != nil {
+			return &ValidationError{Name: "subject_relation", err: fmt.Errorf(`ent: validator failed for field "RelationTuple.subject_relation": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *RelationTupleUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *RelationTupleUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *RelationTupleUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(relationtuple.Table, relationtuple.Columns, sqlgraph.NewFieldSpec(relationtuple.FieldID, field.TypeUint32))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(relationtuple.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(relationtuple.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(relationtuple.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(relationtuple.FieldCreatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.Namespace(); ok {
+		_spec.SetField(relationtuple.FieldNamespace, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ObjectID(); ok {
+		_spec.SetField(relationtuple.FieldObjectID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Relation(); ok {
+		_spec.SetField(relationtuple.FieldRelation, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SubjectNamespace(); ok {
+		_spec.SetField(relationtuple.FieldSubjectNamespace, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SubjectID(); ok {
+		_spec.SetField(relationtuple.FieldSubjectID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SubjectRelation(); ok {
+		_spec.SetField(relationtuple.FieldSubjectRelation, field.TypeString, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{relationtuple.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// RelationTupleUpdateOne is the builder for updating a single RelationTuple entity.
+type RelationTupleUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *RelationTupleMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *RelationTupleUpdateOne) SetCreatedBy(v uint32) *RelationTupleUpdateOne {
+	_u.mutation.ResetCreatedBy()
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *RelationTupleUpdateOne) SetNillableCreatedBy(v *uint32) *RelationTupleUpdateOne {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// AddCreatedBy adds value to the "created_by" field.
+func (_u *RelationTupleUpdateOne) AddCreatedBy(v int32) *RelationTupleUpdateOne {
+	_u.mutation.AddCreatedBy(v)
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *RelationTupleUpdateOne) ClearCreatedBy() *RelationTupleUpdateOne {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetNamespace sets the "namespace" field.
+func (_u *RelationTupleUpdateOne) SetNamespace(v string) *RelationTupleUpdateOne {
+	_u.mutation.SetNamespace(v)
+	return _u
+}
+
+// SetNillableNamespace sets the "namespace" field if the given value is not nil.
+func (_u *RelationTupleUpdateOne) SetNillableNamespace(v *string) *RelationTupleUpdateOne {
+	if v != nil {
+		_u.SetNamespace(*v)
+	}
+	return _u
+}
+
+// SetObjectID sets the "object_id" field.
+func (_u *RelationTupleUpdateOne) SetObjectID(v string) *RelationTupleUpdateOne {
+	_u.mutation.SetObjectID(v)
+	return _u
+}
+
+// SetNillableObjectID sets the "object_id" field if the given value is not nil.
+func (_u *RelationTupleUpdateOne) SetNillableObjectID(v *string) *RelationTupleUpdateOne {
+	if v != nil {
+		_u.SetObjectID(*v)
+	}
+	return _u
+}
+
+// SetRelation sets the "relation" field.
+func (_u *RelationTupleUpdateOne) SetRelation(v string) *RelationTupleUpdateOne {
+	_u.mutation.SetRelation(v)
+	return _u
+}
+
+// SetNillableRelation sets the "relation" field if the given value is not nil.
+func (_u *RelationTupleUpdateOne) SetNillableRelation(v *string) *RelationTupleUpdateOne {
+	if v != nil {
+		_u.SetRelation(*v)
+	}
+	return _u
+}
+
+// SetSubjectNamespace sets the "subject_namespace" field.
+func (_u *RelationTupleUpdateOne) SetSubjectNamespace(v string) *RelationTupleUpdateOne {
+	_u.mutation.SetSubjectNamespace(v)
+	return _u
+}
+
+// SetNillableSubjectNamespace sets the "subject_namespace" field if the given value is not nil.
+func (_u *RelationTupleUpdateOne) SetNillableSubjectNamespace(v *string) *RelationTupleUpdateOne {
+	if v != nil {
+		_u.SetSubjectNamespace(*v)
+	}
+	return _u
+}
+
+// SetSubjectID sets the "subject_id" field.
+func (_u *RelationTupleUpdateOne) SetSubjectID(v string) *RelationTupleUpdateOne {
+	_u.mutation.SetSubjectID(v)
+	return _u
+}
+
+// SetNillableSubjectID sets the "subject_id" field if the given value is not nil.
+func (_u *RelationTupleUpdateOne) SetNillableSubjectID(v *string) *RelationTupleUpdateOne {
+	if v != nil {
+		_u.SetSubjectID(*v)
+	}
+	return _u
+}
+
+// SetSubjectRelation sets the "subject_relation" field.
+func (_u *RelationTupleUpdateOne) SetSubjectRelation(v string) *RelationTupleUpdateOne {
+	_u.mutation.SetSubjectRelation(v)
+	return _u
+}
+
+// SetNillableSubjectRelation sets the "subject_relation" field if the given value is not nil.
+func (_u *RelationTupleUpdateOne) SetNillableSubjectRelation(v *string) *RelationTupleUpdateOne {
+	if v != nil {
+		_u.SetSubjectRelation(*v)
+	}
+	return _u
+}
+
+// Mutation returns the RelationTupleMutation object of the builder.
+func (_u *RelationTupleUpdateOne) Mutation() *RelationTupleMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the RelationTupleUpdate builder.
+func (_u *RelationTupleUpdateOne) Where(ps ...predicate.RelationTuple) *RelationTupleUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *RelationTupleUpdateOne) Select(field string, fields ...string) *RelationTupleUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated RelationTuple entity.
+func (_u *RelationTupleUpdateOne) Save(ctx context.Context) (*RelationTuple, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RelationTupleUpdateOne) SaveX(ctx context.Context) *RelationTuple {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *RelationTupleUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RelationTupleUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RelationTupleUpdateOne) check() error {
+	if v, ok := _u.mutation.Namespace(); ok {
+		if err := relationtuple.NamespaceValidator(v); err != nil {
+			return &ValidationError{Name: "namespace", err: fmt.Errorf(`ent: validator failed for field "RelationTuple.namespace": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ObjectID(); ok {
+		if err := relationtuple.ObjectIDValidator(v); err != nil {
+			return &ValidationError{Name: "object_id", err: fmt.Errorf(`ent: validator failed for field "RelationTuple.object_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Relation(); ok {
+		if err := relationtuple.RelationValidator(v); err != nil {
+			return &ValidationError{Name: "relation", err: fmt.Errorf(`ent: validator failed for field "RelationTuple.relation": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SubjectNamespace(); ok {
+		if err := relationtuple.SubjectNamespaceValidator(v); err != nil {
+			return &ValidationError{Name: "subject_namespace", err: fmt.Errorf(`ent: validator failed for field "RelationTuple.subject_namespace": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SubjectID(); ok {
+		if err := relationtuple.SubjectIDValidator(v); err != nil {
+			return &ValidationError{Name: "subject_id", err: fmt.Errorf(`ent: validator failed for field "RelationTuple.subject_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SubjectRelation(); ok {
+		if err := relationtuple.SubjectRelationValidator(v); err != nil {
+			return &ValidationError{Name: "subject_relation", err: fmt.Errorf(`ent: validator failed for field "RelationTuple.subject_relation": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *RelationTupleUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *RelationTupleUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *RelationTupleUpdateOne) sqlSave(ctx context.Context) (_node *RelationTuple, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(relationtuple.Table, relationtuple.Columns, sqlgraph.NewFieldSpec(relationtuple.FieldID, field.TypeUint32))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RelationTuple.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, relationtuple.FieldID)
+		for _, f := range fields {
+			if !relationtuple.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != relationtuple.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(relationtuple.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(relationtuple.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(relationtuple.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(relationtuple.FieldCreatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.Namespace(); ok {
+		_spec.SetField(relationtuple.FieldNamespace, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ObjectID(); ok {
+		_spec.SetField(relationtuple.FieldObjectID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Relation(); ok {
+		_spec.SetField(relationtuple.FieldRelation, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SubjectNamespace(); ok {
+		_spec.SetField(relationtuple.FieldSubjectNamespace, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SubjectID(); ok {
+		_spec.SetField(relationtuple.FieldSubjectID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SubjectRelation(); ok {
+		_spec.SetField(relationtuple.FieldSubjectRelation, field.TypeString, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &RelationTuple{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{relationtuple.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,149 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"go-wind-admin/app/admin/service/internal/data/ent/relationtuplechange"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// 关系元组变更日志表（自增ID即元组版本号，用作一致性令牌）
+type RelationTupleChange struct {
+	config `json:"-"`
+	// ID of the ent.
+	// id
+	ID uint32 `json:"id,omitempty"`
+	// 创建时间
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	// 创建者ID
+	CreatedBy *uint32 `json:"created_by,omitempty"`
+	// 变更操作
+	Operation relationtuplechange.Operation `json:"operation,omitempty"`
+	// 变更的元组（每行一个 object#relation@subject；REPLACE 为被替换的命名空间）
+	Tuples       *string `json:"tuples,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RelationTupleChange) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case relationtuplechange.FieldID, relationtuplechange.FieldCreatedBy:
+			values[i] = new(sql.NullInt64)
+		case relationtuplechange.FieldOperation, relationtuplechange.FieldTuples:
+			values[i] = new(sql.NullString)
+		case relationtuplechange.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RelationTupleChange fields.
+func (_m *RelationTupleChange) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case relationtuplechange.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = uint32(value.Int64)
+		case relationtuplechange.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = new(time.Time)
+				*_m.CreatedAt = value.Time
+			}
+		case relationtuplechange.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				_m.CreatedBy = new(uint32)
+				*_m.CreatedBy = uint32(value.Int64)
+			}
+		case relationtuplechange.FieldOperation:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field operation", values[i])
+			} else if value.Valid {
+				_m.Operation = relationtuplechange.Operation(value.String)
+			}
+		case relationtuplechange.FieldTuples:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tuples", values[i])
+			} else if value.Valid {
+				_m.Tuples = new(string)
+				*_m.Tuples = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the RelationTupleChange.
+// This includes values selected through modifiers, order, etc.
+func (_m *RelationTupleChange) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this RelationTupleChange.
+// Note that you need to call RelationTupleChange.Unwrap() before calling this method if this RelationTupleChange
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *RelationTupleChange) Update() *RelationTupleChangeUpdateOne {
+	return NewRelationTupleChangeClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the RelationTupleChange entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *RelationTupleChange) Unwrap() *RelationTupleChange {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RelationTupleChange is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *RelationTupleChange) String() string {
+	var builder strings.Builder
+	builder.WriteString("RelationTupleChange(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	if v := _m.CreatedAt; v != nil {
+		builder.WriteString("created_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.CreatedBy; v != nil {
+		builder.WriteString("created_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("operation=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Operation))
+	builder.WriteString(", ")
+	if v := _m.Tuples; v != nil {
+		builder.WriteString("tuples=")
+		builder.WriteString(*v)
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RelationTupleChanges is a parsable slice of RelationTupleChange.
+type RelationTupleChanges []*RelationTupleChange
@@ -0,0 +1,102 @@
+// Code generated by ent, DO NOT EDIT.
+
+package relationtuplechange
+
+import (
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the relationtuplechange type in the database.
+	Label = "relation_tuple_change"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldCreatedBy holds the string denoting the created_by field in the database.
+	FieldCreatedBy = "created_by"
+	// FieldOperation holds the string denoting the operation field in the database.
+	FieldOperation = "operation"
+	// FieldTuples holds the string denoting the tuples field in the database.
+	FieldTuples = "tuples"
+	// Table holds the table name of the relationtuplechange in the database.
+	Table = "sys_relation_tuple_changes"
+)
+
+// Columns holds all SQL columns for relationtuplechange fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldCreatedBy,
+	FieldOperation,
+	FieldTuples,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(uint32) error
+)
+
+// Operation defines the type for the "operation" enum field.
+type Operation string
+
+// Operation values.
+const (
+	OperationWrite   Operation = "WRITE"
+	OperationDelete  Operation = "DELETE"
+	OperationReplace Operation = "REPLACE"
+)
+
+func (o Operation) String() string {
+	return string(o)
+}
+
+// OperationValidator is a validator for the "operation" field enum values. It is called by the builders before save.
+func OperationValidator(o Operation) error {
+	switch o {
+	case OperationWrite, OperationDelete, OperationReplace:
+		return nil
+	default:
+		return fmt.Errorf("relationtuplechange: invalid enum value for operation field: %q", o)
+	}
+}
+
+// OrderOption defines the ordering options for the RelationTupleChange queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByCreatedBy orders the results by the created_by field.
+func ByCreatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedBy, opts...).ToFunc()
+}
+
+// ByOperation orders the results by the operation field.
+func ByOperation(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOperation, opts...).ToFunc()
+}
+
+// ByTuples orders the results by the tuples field.
+func ByTuples(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTuples, opts...).ToFunc()
+}
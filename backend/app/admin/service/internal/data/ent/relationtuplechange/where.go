@@ -0,0 +1,280 @@
+// Code generated by ent, DO NOT EDIT.
+
+package relationtuplechange
+
+import (
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint32) predicate.RelationTupleChange {
+	return predicate.RelationTupleChange(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint32) predicate.RelationTupleChange {
+	return predicate.RelationTupleChange(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint32) predicate.RelationTupleChange {
+	return predicate.RelationTupleChange(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint32) predicate.RelationTupleChange {
+	return predicate.RelationTupleChange(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint32) predicate.RelationTupleChange {
+	return predicate.RelationTupleChange(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint32) predicate.RelationTupleChange {
+	return predicate.RelationTupleChange(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint32) predicate.RelationTupleChange {
+	return predicate.RelationTupleChange(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint32) predicate.RelationTupleChange {
+	return predicate.RelationTupleChange(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint32) predicate.RelationTupleChange {
+	return predicate.RelationTupleChange(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.RelationTupleChange {
+	return predicate.RelationTupleChange(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v uint32) predicate.RelationTupleChange {
+	return predicate.RelationTupleChange(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// Tuples applies equality check predicate on the "tuples" field. It's identical to TuplesEQ.
+func Tuples(v string) predicate.RelationTupleChange {
+	return predicate.RelationTupleChange(sql.FieldEQ(FieldTuples, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.RelationTupleChange {
+	return predicate.RelationTupleChange(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.RelationTupleChange {
+	return predicate.RelationTupleChange(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.RelationTupleChange {
+	return predicate.RelationTupleChange(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.RelationTupleChange {
+	return predicate.RelationTupleChange(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.RelationTupleChange {
+	return predicate.RelationTupleChange(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.RelationTupleChange {
+	return predicate.RelationTupleChange(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.RelationTupleChange {
+	return predicate.RelationTupleChange(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.RelationTupleChange {
+	return predicate.RelationTupleChange(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// CreatedAtIsNil applies the IsNil predicate on the "created_at" field.
+func CreatedAtIsNil() predicate.RelationTupleChange {
+	return predicate.RelationTupleChange(sql.FieldIsNull(FieldCreatedAt))
+}
+
+// CreatedAtNotNil applies the NotNil predicate on the "created_at" field.
+func CreatedAtNotNil() predicate.RelationTupleChange {
+	return predicate.RelationTupleChange(sql.FieldNotNull(FieldCreatedAt))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v uint32) predicate.RelationTupleChange {
+	return predicate.RelationTupleChange(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v uint32) predicate.RelationTupleChange {
+	return predicate.RelationTupleChange(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...uint32) predicate.RelationTupleChange {
+	return predicate.RelationTupleChange(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...uint32) predicate.RelationTupleChange {
+	return predicate.RelationTupleChange(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v uint32) predicate.RelationTupleChange {
+	return predicate.RelationTupleChange(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v uint32) predicate.RelationTupleChange {
+	return predicate.RelationTupleChange(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v uint32) predicate.RelationTupleChange {
+	return predicate.RelationTupleChange(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v uint32) predicate.RelationTupleChange {
+	return predicate.RelationTupleChange(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByIsNil applies the IsNil predicate on the "created_by" field.
+func CreatedByIsNil() predicate.RelationTupleChange {
+	return predicate.RelationTupleChange(sql.FieldIsNull(FieldCreatedBy))
+}
+
+// CreatedByNotNil applies the NotNil predicate on the "created_by" field.
+func CreatedByNotNil() predicate.RelationTupleChange {
+	return predicate.RelationTupleChange(sql.FieldNotNull(FieldCreatedBy))
+}
+
+// OperationEQ applies the EQ predicate on the "operation" field.
+func OperationEQ(v Operation) predicate.RelationTupleChange {
+	return predicate.RelationTupleChange(sql.FieldEQ(FieldOperation, v))
+}
+
+// OperationNEQ applies the NEQ predicate on the "operation" field.
+func OperationNEQ(v Operation) predicate.RelationTupleChange {
+	return predicate.RelationTupleChange(sql.FieldNEQ(FieldOperation, v))
+}
+
+// OperationIn applies the In predicate on the "operation" field.
+func OperationIn(vs ...Operation) predicate.RelationTupleChange {
+	return predicate.RelationTupleChange(sql.FieldIn(FieldOperation, vs...))
+}
+
+// OperationNotIn applies the NotIn predicate on the "operation" field.
+func OperationNotIn(vs ...Operation) predicate.RelationTupleChange {
+	return predicate.RelationTupleChange(sql.FieldNotIn(FieldOperation, vs...))
+}
+
+// TuplesEQ applies the EQ predicate on the "tuples" field.
+func TuplesEQ(v string) predicate.RelationTupleChange {
+	return predicate.RelationTupleChange(sql.FieldEQ(FieldTuples, v))
+}
+
+// TuplesNEQ applies the NEQ predicate on the "tuples" field.
+func TuplesNEQ(v string) predicate.RelationTupleChange {
+	return predicate.RelationTupleChange(sql.FieldNEQ(FieldTuples, v))
+}
+
+// TuplesIn applies the In predicate on the "tuples" field.
+func TuplesIn(vs ...string) predicate.RelationTupleChange {
+	return predicate.RelationTupleChange(sql.FieldIn(FieldTuples, vs...))
+}
+
+// TuplesNotIn applies the NotIn predicate on the "tuples" field.
+func TuplesNotIn(vs ...string) predicate.RelationTupleChange {
+	return predicate.RelationTupleChange(sql.FieldNotIn(FieldTuples, vs...))
+}
+
+// TuplesGT applies the GT predicate on the "tuples" field.
+func TuplesGT(v string) predicate.RelationTupleChange {
+	return predicate.RelationTupleChange(sql.FieldGT(FieldTuples, v))
+}
+
+// TuplesGTE applies the GTE predicate on the "tuples" field.
+func TuplesGTE(v string) predicate.RelationTupleChange {
+	return predicate.RelationTupleChange(sql.FieldGTE(FieldTuples, v))
+}
+
+// TuplesLT applies the LT predicate on the "tuples" field.
+func TuplesLT(v string) predicate.RelationTupleChange {
+	return predicate.RelationTupleChange(sql.FieldLT(FieldTuples, v))
+}
+
+// TuplesLTE applies the LTE predicate on the "tuples" field.
+func TuplesLTE(v string) predicate.RelationTupleChange {
+	return predicate.RelationTupleChange(sql.FieldLTE(FieldTuples, v))
+}
+
+// TuplesContains applies the Contains predicate on the "tuples" field.
+func TuplesContains(v string) predicate.RelationTupleChange {
+	return predicate.RelationTupleChange(sql.FieldContains(FieldTuples, v))
+}
+
+// TuplesHasPrefix applies the HasPrefix predicate on the "tuples" field.
+func TuplesHasPrefix(v string) predicate.RelationTupleChange {
+	return predicate.RelationTupleChange(sql.FieldHasPrefix(FieldTuples, v))
+}
+
+// TuplesHasSuffix applies the HasSuffix predicate on the "tuples" field.
+func TuplesHasSuffix(v string) predicate.RelationTupleChange {
+	return predicate.RelationTupleChange(sql.FieldHasSuffix(FieldTuples, v))
+}
+
+// TuplesIsNil applies the IsNil predicate on the "tuples" field.
+func TuplesIsNil() predicate.RelationTupleChange {
+	return predicate.RelationTupleChange(sql.FieldIsNull(FieldTuples))
+}
+
+// TuplesNotNil applies the NotNil predicate on the "tuples" field.
+func TuplesNotNil() predicate.RelationTupleChange {
+	return predicate.RelationTupleChange(sql.FieldNotNull(FieldTuples))
+}
+
+// TuplesEqualFold applies the EqualFold predicate on the "tuples" field.
+func TuplesEqualFold(v string) predicate.RelationTupleChange {
+	return predicate.RelationTupleChange(sql.FieldEqualFold(FieldTuples, v))
+}
+
+// TuplesContainsFold applies the ContainsFold predicate on the "tuples" field.
+func TuplesContainsFold(v string) predicate.RelationTupleChange {
+	return predicate.RelationTupleChange(sql.FieldContainsFold(FieldTuples, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.RelationTupleChange) predicate.RelationTupleChange {
+	return predicate.RelationTupleChange(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.RelationTupleChange) predicate.RelationTupleChange {
+	return predicate.RelationTupleChange(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.RelationTupleChange) predicate.RelationTupleChange {
+	return predicate.RelationTupleChange(sql.NotPredicates(p))
+}
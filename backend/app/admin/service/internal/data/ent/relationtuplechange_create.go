@@ -0,0 +1,695 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-wind-admin/app/admin/service/internal/data/ent/relationtuplechange"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RelationTupleChangeCreate is the builder for creating a RelationTupleChange entity.
+type RelationTupleChangeCreate struct {
+	config
+	mutation *RelationTupleChangeMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *RelationTupleChangeCreate) SetCreatedAt(v time.Time) *RelationTupleChangeCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *RelationTupleChangeCreate) SetNillableCreatedAt(v *time.Time) *RelationTupleChangeCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *RelationTupleChangeCreate) SetCreatedBy(v uint32) *RelationTupleChangeCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *RelationTupleChangeCreate) SetNillableCreatedBy(v *uint32) *RelationTupleChangeCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetOperation sets the "operation" field.
+func (_c *RelationTupleChangeCreate) SetOperation(v relationtuplechange.Operation) *RelationTupleChangeCreate {
+	_c.mutation.SetOperation(v)
+	return _c
+}
+
+// SetTuples sets the "tuples" field.
+func (_c *RelationTupleChangeCreate) SetTuples(v string) *RelationTupleChangeCreate {
+	_c.mutation.SetTuples(v)
+	return _c
+}
+
+// SetNillableTuples sets the "tuples" field if the given value is not nil.
+func (_c *RelationTupleChangeCreate) SetNillableTuples(v *string) *RelationTupleChangeCreate {
+	if v != nil {
+		_c.SetTuples(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *RelationTupleChangeCreate) SetID(v uint32) *RelationTupleChangeCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the RelationTupleChangeMutation object of the builder.
+func (_c *RelationTupleChangeCreate) Mutation() *RelationTupleChangeMutation {
+	return _c.mutation
+}
+
+// Save creates the RelationTupleChange in the database.
+func (_c *RelationTupleChangeCreate) Save(ctx context.Context) (*RelationTupleChange, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *RelationTupleChangeCreate) SaveX(ctx context.Context) *RelationTupleChange {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RelationTupleChangeCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RelationTupleChangeCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *RelationTupleChangeCreate) check() error {
+	if _, ok := _c.mutation.Operation(); !ok {
+		return &ValidationError{Name: "operation", err: errors.New(`ent: missing required field "RelationTupleChange.operation"`)}
+	}
+	if v, ok := _c.mutation.Operation(); ok {
+		if err := relationtuplechange.OperationValidator(v); err != nil {
+			return &ValidationError{Name: "operation", err: fmt.Errorf(`ent: validator failed for field "RelationTupleChange.operation": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := relationtuplechange.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "RelationTupleChange.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *RelationTupleChangeCreate) sqlSave(ctx context.Context) (*RelationTupleChange, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint32(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *RelationTupleChangeCreate) createSpec() (*RelationTupleChange, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RelationTupleChange{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(relationtuplechange.Table, sqlgraph.NewFieldSpec(relationtuplechange.FieldID, field.TypeUint32))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(relationtuplechange.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(relationtuplechange.FieldCreatedBy, field.TypeUint32, value)
+		_node.CreatedBy = &value
+	}
+	if value, ok := _c.mutation.Operation(); ok {
+		_spec.SetField(relationtuplechange.FieldOperation, field.TypeEnum, value)
+		_node.Operation = value
+	}
+	if value, ok := _c.mutation.Tuples(); ok {
+		_spec.SetField(relationtuplechange.FieldTuples, field.TypeString, value)
+		_node.Tuples = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.RelationTupleChange.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RelationTupleChangeUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *RelationTupleChangeCreate) OnConflict(opts ...sql.ConflictOption) *RelationTupleChangeUpsertOne {
+	_c.conflict = opts
+	return &RelationTupleChangeUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.RelationTupleChange.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *RelationTupleChangeCreate) OnConflictColumns(columns ...string) *RelationTupleChangeUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &RelationTupleChangeUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// RelationTupleChangeUpsertOne is the builder for "upsert"-ing
+	//  one RelationTupleChange node.
+	RelationTupleChangeUpsertOne struct {
+		create *RelationTupleChangeCreate
+	}
+
+	// RelationTupleChangeUpsert is the "OnConflict" setter.
+	RelationTupleChangeUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCreatedBy sets the "created_by" field.
+func (u *RelationTupleChangeUpsert) SetCreatedBy(v uint32) *RelationTupleChangeUpsert {
+	u.Set(relationtuplechange.FieldCreatedBy, v)
+	return u
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *RelationTupleChangeUpsert) UpdateCreatedBy() *RelationTupleChangeUpsert {
+	u.SetExcluded(relationtuplechange.FieldCreatedBy)
+	return u
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *RelationTupleChangeUpsert) AddCreatedBy(v uint32) *RelationTupleChangeUpsert {
+	u.Add(relationtuplechange.FieldCreatedBy, v)
+	return u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *RelationTupleChangeUpsert) ClearCreatedBy() *RelationTupleChangeUpsert {
+	u.SetNull(relationtuplechange.FieldCreatedBy)
+	return u
+}
+
+// SetOperation sets the "operation" field.
+func (u *RelationTupleChangeUpsert) SetOperation(v relationtuplechange.Operation) *RelationTupleChangeUpsert {
+	u.Set(relationtuplechange.FieldOperation, v)
+	return u
+}
+
+// UpdateOperation sets the "operation" field to the value that was provided on create.
+func (u *RelationTupleChangeUpsert) UpdateOperation() *RelationTupleChangeUpsert {
+	u.SetExcluded(relationtuplechange.FieldOperation)
+	return u
+}
+
+// SetTuples sets the "tuples" field.
+func (u *RelationTupleChangeUpsert) SetTuples(v string) *RelationTupleChangeUpsert {
+	u.Set(relationtuplechange.FieldTuples, v)
+	return u
+}
+
+// UpdateTuples sets the "tuples" field to the value that was provided on create.
+func (u *RelationTupleChangeUpsert) UpdateTuples() *RelationTupleChangeUpsert {
+	u.SetExcluded(relationtuplechange.FieldTuples)
+	return u
+}
+
+// ClearTuples clears the value of the "tuples" field.
+func (u *RelationTupleChangeUpsert) ClearTuples() *RelationTupleChangeUpsert {
+	u.SetNull(relationtuplechange.FieldTuples)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.RelationTupleChange.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(relationtuplechange.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *RelationTupleChangeUpsertOne) UpdateNewValues() *RelationTupleChangeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(relationtuplechange.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(relationtuplechange.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.RelationTupleChange.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *RelationTupleChangeUpsertOne) Ignore() *RelationTupleChangeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RelationTupleChangeUpsertOne) DoNothing() *RelationTupleChangeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RelationTupleChangeCreate.OnConflict
+// documentation for more info.
+func (u *RelationTupleChangeUpsertOne) Update(set func(*RelationTupleChangeUpsert)) *RelationTupleChangeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RelationTupleChangeUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *RelationTupleChangeUpsertOne) SetCreatedBy(v uint32) *RelationTupleChangeUpsertOne {
+	return u.Update(func(s *RelationTupleChangeUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *RelationTupleChangeUpsertOne) AddCreatedBy(v uint32) *RelationTupleChangeUpsertOne {
+	return u.Update(func(s *RelationTupleChangeUpsert) {
+		s.AddCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *RelationTupleChangeUpsertOne) UpdateCreatedBy() *RelationTupleChangeUpsertOne {
+	return u.Update(func(s *RelationTupleChangeUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *RelationTupleChangeUpsertOne) ClearCreatedBy() *RelationTupleChangeUpsertOne {
+	return u.Update(func(s *RelationTupleChangeUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// SetOperation sets the "operation" field.
+func (u *RelationTupleChangeUpsertOne) SetOperation(v relationtuplechange.Operation) *RelationTupleChangeUpsertOne {
+	return u.Update(func(s *RelationTupleChangeUpsert) {
+		s.SetOperation(v)
+	})
+}
+
+// UpdateOperation sets the "operation" field to the value that was provided on create.
+func (u *RelationTupleChangeUpsertOne) UpdateOperation() *RelationTupleChangeUpsertOne {
+	return u.Update(func(s *RelationTupleChangeUpsert) {
+		s.UpdateOperation()
+	})
+}
+
+// SetTuples sets the "tuples" field.
+func (u *RelationTupleChangeUpsertOne) SetTuples(v string) *RelationTupleChangeUpsertOne {
+	return u.Update(func(s *RelationTupleChangeUpsert) {
+		s.SetTuples(v)
+	})
+}
+
+// UpdateTuples sets the "tuples" field to the value that was provided on create.
+func (u *RelationTupleChangeUpsertOne) UpdateTuples() *RelationTupleChangeUpsertOne {
+	return u.Update(func(s *RelationTupleChangeUpsert) {
+		s.UpdateTuples()
+	})
+}
+
+// ClearTuples clears the value of the "tuples" field.
+func (u *RelationTupleChangeUpsertOne) ClearTuples() *RelationTupleChangeUpsertOne {
+	return u.Update(func(s *RelationTupleChangeUpsert) {
+		s.ClearTuples()
+	})
+}
+
+// Exec executes the query.
+func (u *RelationTupleChangeUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for RelationTupleChangeCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RelationTupleChangeUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *RelationTupleChangeUpsertOne) ID(ctx context.Context) (id uint32, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *RelationTupleChangeUpsertOne) IDX(ctx context.Context) uint32 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// RelationTupleChangeCreateBulk is the builder for creating many RelationTupleChange entities in bulk.
+type RelationTupleChangeCreateBulk struct {
+	config
+	err      error
+	builders []*RelationTupleChangeCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the RelationTupleChange entities in the database.
+func (_c *RelationTupleChangeCreateBulk) Save(ctx context.Context) ([]*RelationTupleChange, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*RelationTupleChange, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RelationTupleChangeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint32(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *RelationTupleChangeCreateBulk) SaveX(ctx context.Context) []*RelationTupleChange {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RelationTupleChangeCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RelationTupleChangeCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.RelationTupleChange.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RelationTupleChangeUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *RelationTupleChangeCreateBulk) OnConflict(opts ...sql.ConflictOption) *RelationTupleChangeUpsertBulk {
+	_c.conflict = opts
+	return &RelationTupleChangeUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.RelationTupleChange.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *RelationTupleChangeCreateBulk) OnConflictColumns(columns ...string) *RelationTupleChangeUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &RelationTupleChangeUpsertBulk{
+		create: _c,
+	}
+}
+
+// RelationTupleChangeUpsertBulk is the builder for "upsert"-ing
+// a bulk of RelationTupleChange nodes.
+type RelationTupleChangeUpsertBulk struct {
+	create *RelationTupleChangeCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.RelationTupleChange.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(relationtuplechange.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *RelationTupleChangeUpsertBulk) UpdateNewValues() *RelationTupleChangeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(relationtuplechange.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(relationtuplechange.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.RelationTupleChange.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *RelationTupleChangeUpsertBulk) Ignore() *RelationTupleChangeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RelationTupleChangeUpsertBulk) DoNothing() *RelationTupleChangeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RelationTupleChangeCreateBulk.OnConflict
+// documentation for more info.
+func (u *RelationTupleChangeUpsertBulk) Update(set func(*RelationTupleChangeUpsert)) *RelationTupleChangeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RelationTupleChangeUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *RelationTupleChangeUpsertBulk) SetCreatedBy(v uint32) *RelationTupleChangeUpsertBulk {
+	return u.Update(func(s *RelationTupleChangeUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *RelationTupleChangeUpsertBulk) AddCreatedBy(v uint32) *RelationTupleChangeUpsertBulk {
+	return u.Update(func(s *RelationTupleChangeUpsert) {
+		s.AddCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *RelationTupleChangeUpsertBulk) UpdateCreatedBy() *RelationTupleChangeUpsertBulk {
+	return u.Update(func(s *RelationTupleChangeUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *RelationTupleChangeUpsertBulk) ClearCreatedBy() *RelationTupleChangeUpsertBulk {
+	return u.Update(func(s *RelationTupleChangeUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// SetOperation sets the "operation" field.
+func (u *RelationTupleChangeUpsertBulk) SetOperation(v relationtuplechange.Operation) *RelationTupleChangeUpsertBulk {
+	return u.Update(func(s *RelationTupleChangeUpsert) {
+		s.SetOperation(v)
+	})
+}
+
+// UpdateOperation sets the "operation" field to the value that was provided on create.
+func (u *RelationTupleChangeUpsertBulk) UpdateOperation() *RelationTupleChangeUpsertBulk {
+	return u.Update(func(s *RelationTupleChangeUpsert) {
+		s.UpdateOperation()
+	})
+}
+
+// SetTuples sets the "tuples" field.
+func (u *RelationTupleChangeUpsertBulk) SetTuples(v string) *RelationTupleChangeUpsertBulk {
+	return u.Update(func(s *RelationTupleChangeUpsert) {
+		s.SetTuples(v)
+	})
+}
+
+// UpdateTuples sets the "tuples" field to the value that was provided on create.
+func (u *RelationTupleChangeUpsertBulk) UpdateTuples() *RelationTupleChangeUpsertBulk {
+	return u.Update(func(s *RelationTupleChangeUpsert) {
+		s.UpdateTuples()
+	})
+}
+
+// ClearTuples clears the value of the "tuples" field.
+func (u *RelationTupleChangeUpsertBulk) ClearTuples() *RelationTupleChangeUpsertBulk {
+	return u.Update(func(s *RelationTupleChangeUpsert) {
+		s.ClearTuples()
+	})
+}
+
+// Exec executes the query.
+func (u *RelationTupleChangeUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the RelationTupleChangeCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for RelationTupleChangeCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RelationTupleChangeUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
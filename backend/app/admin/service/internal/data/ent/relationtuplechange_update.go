@@ -0,0 +1,381 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+	"go-wind-admin/app/admin/service/internal/data/ent/relationtuplechange"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RelationTupleChangeUpdate is the builder for updating RelationTupleChange entities.
+type RelationTupleChangeUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *RelationTupleChangeMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the RelationTupleChangeUpdate builder.
+func (_u *RelationTupleChangeUpdate) Where(ps ...predicate.RelationTupleChange) *RelationTupleChangeUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *RelationTupleChangeUpdate) SetCreatedBy(v uint32) *RelationTupleChangeUpdate {
+	_u.mutation.ResetCreatedBy()
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *RelationTupleChangeUpdate) SetNillableCreatedBy(v *uint32) *RelationTupleChangeUpdate {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// AddCreatedBy adds value to the "created_by" field.
+func (_u *RelationTupleChangeUpdate) AddCreatedBy(v int32) *RelationTupleChangeUpdate {
+	_u.mutation.AddCreatedBy(v)
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *RelationTupleChangeUpdate) ClearCreatedBy() *RelationTupleChangeUpdate {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetOperation sets the "operation" field.
+func (_u *RelationTupleChangeUpdate) SetOperation(v relationtuplechange.Operation) *RelationTupleChangeUpdate {
+	_u.mutation.SetOperation(v)
+	return _u
+}
+
+// SetNillableOperation sets the "operation" field if the given value is not nil.
+func (_u *RelationTupleChangeUpdate) SetNillableOperation(v *relationtuplechange.Operation) *RelationTupleChangeUpdate {
+	if v != nil {
+		_u.SetOperation(*v)
+	}
+	return _u
+}
+
+// SetTuples sets the "tuples" field.
+func (_u *RelationTupleChangeUpdate) SetTuples(v string) *RelationTupleChangeUpdate {
+	_u.mutation.SetTuples(v)
+	return _u
+}
+
+// SetNillableTuples sets the "tuples" field if the given value is not nil.
+func (_u *RelationTupleChangeUpdate) SetNillableTuples(v *string) *RelationTupleChangeUpdate {
+	if v != nil {
+		_u.SetTuples(*v)
+	}
+	return _u
+}
+
+// ClearTuples clears the value of the "tuples" field.
+func (_u *RelationTupleChangeUpdate) ClearTuples() *RelationTupleChangeUpdate {
+	_u.mutation.ClearTuples()
+	return _u
+}
+
+// Mutation returns the RelationTupleChangeMutation object of the builder.
+func (_u *RelationTupleChangeUpdate) Mutation() *RelationTupleChangeMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *RelationTupleChangeUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RelationTupleChangeUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *RelationTupleChangeUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RelationTupleChangeUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RelationTupleChangeUpdate) check() error {
+	if v, ok := _u.mutation.Operation(); ok {
+		if err := relationtuplechange.OperationValidator(v); err != nil {
+			return &ValidationError{Name: "operation", err: fmt.Errorf(`ent: validator failed for field "RelationTupleChange.operation": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *RelationTupleChangeUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *RelationTupleChangeUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *RelationTupleChangeUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(relationtuplechange.Table, relationtuplechange.Columns, sqlgraph.NewFieldSpec(relationtuplechange.FieldID, field.TypeUint32))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(relationtuplechange.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(relationtuplechange.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(relationtuplechange.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(relationtuplechange.FieldCreatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.Operation(); ok {
+		_spec.SetField(relationtuplechange.FieldOperation, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Tuples(); ok {
+		_spec.SetField(relationtuplechange.FieldTuples, field.TypeString, value)
+	}
+	if _u.mutation.TuplesCleared() {
+		_spec.ClearField(relationtuplechange.FieldTuples, field.TypeString)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{relationtuplechange.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// RelationTupleChangeUpdateOne is the builder for updating a single RelationTupleChange entity.
+type RelationTupleChangeUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *RelationTupleChangeMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *RelationTupleChangeUpdateOne) SetCreatedBy(v uint32) *RelationTupleChangeUpdateOne {
+	_u.mutation.ResetCreatedBy()
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *RelationTupleChangeUpdateOne) SetNillableCreatedBy(v *uint32) *RelationTupleChangeUpdateOne {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// AddCreatedBy adds value to the "created_by" field.
+func (_u *RelationTupleChangeUpdateOne) AddCreatedBy(v int32) *RelationTupleChangeUpdateOne {
+	_u.mutation.AddCreatedBy(v)
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *RelationTupleChangeUpdateOne) ClearCreatedBy() *RelationTupleChangeUpdateOne {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetOperation sets the "operation" field.
+func (_u *RelationTupleChangeUpdateOne) SetOperation(v relationtuplechange.Operation) *RelationTupleChangeUpdateOne {
+	_u.mutation.SetOperation(v)
+	return _u
+}
+
+// SetNillableOperation sets the "operation" field if the given value is not nil.
+func (_u *RelationTupleChangeUpdateOne) SetNillableOperation(v *relationtuplechange.Operation) *RelationTupleChangeUpdateOne {
+	if v != nil {
+		_u.SetOperation(*v)
+	}
+	return _u
+}
+
+// SetTuples sets the "tuples" field.
+func (_u *RelationTupleChangeUpdateOne) SetTuples(v string) *RelationTupleChangeUpdateOne {
+	_u.mutation.SetTuples(v)
+	return _u
+}
+
+// SetNillableTuples sets the "tuples" field if the given value is not nil.
+func (_u *RelationTupleChangeUpdateOne) SetNillableTuples(v *string) *RelationTupleChangeUpdateOne {
+	if v != nil {
+		_u.SetTuples(*v)
+	}
+	return _u
+}
+
+// ClearTuples clears the value of the "tuples" field.
+func (_u *RelationTupleChangeUpdateOne) ClearTuples() *RelationTupleChangeUpdateOne {
+	_u.mutation.ClearTuples()
+	return _u
+}
+
+// Mutation returns the RelationTupleChangeMutation object of the builder.
+func (_u *RelationTupleChangeUpdateOne) Mutation() *RelationTupleChangeMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the RelationTupleChangeUpdate builder.
+func (_u *RelationTupleChangeUpdateOne) Where(ps ...predicate.RelationTupleChange) *RelationTupleChangeUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *RelationTupleChangeUpdateOne) Select(field string, fields ...string) *RelationTupleChangeUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated RelationTupleChange entity.
+func (_u *RelationTupleChangeUpdateOne) Save(ctx context.Context) (*RelationTupleChange, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RelationTupleChangeUpdateOne) SaveX(ctx context.Context) *RelationTupleChange {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *RelationTupleChangeUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RelationTupleChangeUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RelationTupleChangeUpdateOne) check() error {
+	if v, ok := _u.mutation.Operation(); ok {
+		if err := relationtuplechange.OperationValidator(v); err != nil {
+			return &ValidationError{Name: "operation", err: fmt.Errorf(`ent: validator failed for field "RelationTupleChange.operation": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *RelationTupleChangeUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *RelationTupleChangeUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *RelationTupleChangeUpdateOne) sqlSave(ctx context.Context) (_node *RelationTupleChange, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(relationtuplechange.Table, relationtuplechange.Columns, sqlgraph.NewFieldSpec(relationtuplechange.FieldID, field.TypeUint32))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RelationTupleChange.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, relationtuplechange.FieldID)
+		for _, f := range fields {
+			if !relationtuplechange.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != relationtuplechange.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(relationtuplechange.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(relationtuplechange.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(relationtuplechange.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(relationtuplechange.FieldCreatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.Operation(); ok {
+		_spec.SetField(relationtuplechange.FieldOperation, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Tuples(); ok {
+		_spec.SetField(relationtuplechange.FieldTuples, field.TypeString, value)
+	}
+	if _u.mutation.TuplesCleared() {
+		_spec.ClearField(relationtuplechange.FieldTuples, field.TypeString)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &RelationTupleChange{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{relationtuplechange.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"go-wind-admin/app/admin/service/internal/data/ent/role"
 	"strings"
@@ -47,8 +48,12 @@ type Role struct {
 	// 是否受保护的角色
 	IsProtected *bool `json:"is_protected,omitempty"`
 	// 角色类型
-	Type         *role.Type `json:"type,omitempty"`
-	selectValues sql.SelectValues
+	Type *role.Type `json:"type,omitempty"`
+	// 数据权限范围，为空视为全部数据
+	DataScope *role.DataScope `json:"data_scope,omitempty"`
+	// 自定义数据权限的组织单元ID列表（SELECTED_UNITS）
+	DataScopeOrgUnitIds []uint32 `json:"data_scope_org_unit_ids,omitempty"`
+	selectValues        sql.SelectValues
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -56,11 +61,13 @@ func (*Role) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case role.FieldDataScopeOrgUnitIds:
+			values[i] = new([]byte)
 		case role.FieldIsProtected:
 			values[i] = new(sql.NullBool)
 		case role.FieldID, role.FieldCreatedBy, role.FieldUpdatedBy, role.FieldDeletedBy, role.FieldSortOrder, role.FieldTenantID:
 			values[i] = new(sql.NullInt64)
-		case role.FieldRemark, role.FieldDescription, role.FieldStatus, role.FieldName, role.FieldCode, role.FieldType:
+		case role.FieldRemark, role.FieldDescription, role.FieldStatus, role.FieldName, role.FieldCode, role.FieldType, role.FieldDataScope:
 			values[i] = new(sql.NullString)
 		case role.FieldCreatedAt, role.FieldUpdatedAt, role.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
@@ -190,6 +197,21 @@ func (_m *Role) assignValues(columns []string, values []any) error {
 				_m.Type = new(role.Type)
 				*_m.Type = role.Type(value.String)
 			}
+		case role.FieldDataScope:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field data_scope", values[i])
+			} else if value.Valid {
+				_m.DataScope = new(role.DataScope)
+				*_m.DataScope = role.DataScope(value.String)
+			}
+		case role.FieldDataScopeOrgUnitIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field data_scope_org_unit_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.DataScopeOrgUnitIds); err != nil {
+					return fmt.Errorf("unmarshal field data_scope_org_unit_ids: %w", err)
+				}
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -300,6 +322,14 @@ func (_m *Role) String() string {
 		builder.WriteString("type=")
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
+	builder.WriteString(", ")
+	if v := _m.DataScope; v != nil {
+		builder.WriteString("data_scope=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("data_scope_org_unit_ids=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DataScopeOrgUnitIds))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -869,6 +869,46 @@ func TypeNotIn(vs ...Type) predicate.Role {
 	return predicate.Role(sql.FieldNotIn(FieldType, vs...))
 }
 
+// DataScopeEQ applies the EQ predicate on the "data_scope" field.
+func DataScopeEQ(v DataScope) predicate.Role {
+	return predicate.Role(sql.FieldEQ(FieldDataScope, v))
+}
+
+// DataScopeNEQ applies the NEQ predicate on the "data_scope" field.
+func DataScopeNEQ(v DataScope) predicate.Role {
+	return predicate.Role(sql.FieldNEQ(FieldDataScope, v))
+}
+
+// DataScopeIn applies the In predicate on the "data_scope" field.
+func DataScopeIn(vs ...DataScope) predicate.Role {
+	return predicate.Role(sql.FieldIn(FieldDataScope, vs...))
+}
+
+// DataScopeNotIn applies the NotIn predicate on the "data_scope" field.
+func DataScopeNotIn(vs ...DataScope) predicate.Role {
+	return predicate.Role(sql.FieldNotIn(FieldDataScope, vs...))
+}
+
+// DataScopeIsNil applies the IsNil predicate on the "data_scope" field.
+func DataScopeIsNil() predicate.Role {
+	return predicate.Role(sql.FieldIsNull(FieldDataScope))
+}
+
+// DataScopeNotNil applies the NotNil predicate on the "data_scope" field.
+func DataScopeNotNil() predicate.Role {
+	return predicate.Role(sql.FieldNotNull(FieldDataScope))
+}
+
+// DataScopeOrgUnitIdsIsNil applies the IsNil predicate on the "data_scope_org_unit_ids" field.
+func DataScopeOrgUnitIdsIsNil() predicate.Role {
+	return predicate.Role(sql.FieldIsNull(FieldDataScopeOrgUnitIds))
+}
+
+// DataScopeOrgUnitIdsNotNil applies the NotNil predicate on the "data_scope_org_unit_ids" field.
+func DataScopeOrgUnitIdsNotNil() predicate.Role {
+	return predicate.Role(sql.FieldNotNull(FieldDataScopeOrgUnitIds))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Role) predicate.Role {
 	return predicate.Role(sql.AndPredicates(predicates...))
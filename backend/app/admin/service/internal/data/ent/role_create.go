@@ -232,6 +232,26 @@ func (_c *RoleCreate) SetNillableType(v *role.Type) *RoleCreate {
 	return _c
 }
 
+// SetDataScope sets the "data_scope" field.
+func (_c *RoleCreate) SetDataScope(v role.DataScope) *RoleCreate {
+	_c.mutation.SetDataScope(v)
+	return _c
+}
+
+// SetNillableDataScope sets the "data_scope" field if the given value is not nil.
+func (_c *RoleCreate) SetNillableDataScope(v *role.DataScope) *RoleCreate {
+	if v != nil {
+		_c.SetDataScope(*v)
+	}
+	return _c
+}
+
+// SetDataScopeOrgUnitIds sets the "data_scope_org_unit_ids" field.
+func (_c *RoleCreate) SetDataScopeOrgUnitIds(v []uint32) *RoleCreate {
+	_c.mutation.SetDataScopeOrgUnitIds(v)
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *RoleCreate) SetID(v uint32) *RoleCreate {
 	_c.mutation.SetID(v)
@@ -329,6 +349,11 @@ func (_c *RoleCreate) check() error {
 			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Role.type": %w`, err)}
 		}
 	}
+	if v, ok := _c.mutation.DataScope(); ok {
+		if err := role.DataScopeValidator(v); err != nil {
+			return &ValidationError{Name: "data_scope", err: fmt.Errorf(`ent: validator failed for field "Role.data_scope": %w`, err)}
+		}
+	}
 	if v, ok := _c.mutation.ID(); ok {
 		if err := role.IDValidator(v); err != nil {
 			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Role.id": %w`, err)}
@@ -427,6 +452,14 @@ func (_c *RoleCreate) createSpec() (*Role, *sqlgraph.CreateSpec) {
 		_spec.SetField(role.FieldType, field.TypeEnum, value)
 		_node.Type = &value
 	}
+	if value, ok := _c.mutation.DataScope(); ok {
+		_spec.SetField(role.FieldDataScope, field.TypeEnum, value)
+		_node.DataScope = &value
+	}
+	if value, ok := _c.mutation.DataScopeOrgUnitIds(); ok {
+		_spec.SetField(role.FieldDataScopeOrgUnitIds, field.TypeJSON, value)
+		_node.DataScopeOrgUnitIds = value
+	}
 	return _node, _spec
 }
 
@@ -719,6 +752,42 @@ func (u *RoleUpsert) UpdateType() *RoleUpsert {
 	return u
 }
 
+// SetDataScope sets the "data_scope" field.
+func (u *RoleUpsert) SetDataScope(v role.DataScope) *RoleUpsert {
+	u.Set(role.FieldDataScope, v)
+	return u
+}
+
+// UpdateDataScope sets the "data_scope" field to the value that was provided on create.
+func (u *RoleUpsert) UpdateDataScope() *RoleUpsert {
+	u.SetExcluded(role.FieldDataScope)
+	return u
+}
+
+// ClearDataScope clears the value of the "data_scope" field.
+func (u *RoleUpsert) ClearDataScope() *RoleUpsert {
+	u.SetNull(role.FieldDataScope)
+	return u
+}
+
+// SetDataScopeOrgUnitIds sets the "data_scope_org_unit_ids" field.
+func (u *RoleUpsert) SetDataScopeOrgUnitIds(v []uint32) *RoleUpsert {
+	u.Set(role.FieldDataScopeOrgUnitIds, v)
+	return u
+}
+
+// UpdateDataScopeOrgUnitIds sets the "data_scope_org_unit_ids" field to the value that was provided on create.
+func (u *RoleUpsert) UpdateDataScopeOrgUnitIds() *RoleUpsert {
+	u.SetExcluded(role.FieldDataScopeOrgUnitIds)
+	return u
+}
+
+// ClearDataScopeOrgUnitIds clears the value of the "data_scope_org_unit_ids" field.
+func (u *RoleUpsert) ClearDataScopeOrgUnitIds() *RoleUpsert {
+	u.SetNull(role.FieldDataScopeOrgUnitIds)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -1053,6 +1122,48 @@ func (u *RoleUpsertOne) UpdateType() *RoleUpsertOne {
 	})
 }
 
+// SetDataScope sets the "data_scope" field.
+func (u *RoleUpsertOne) SetDataScope(v role.DataScope) *RoleUpsertOne {
+	return u.Update(func(s *RoleUpsert) {
+		s.SetDataScope(v)
+	})
+}
+
+// UpdateDataScope sets the "data_scope" field to the value that was provided on create.
+func (u *RoleUpsertOne) UpdateDataScope() *RoleUpsertOne {
+	return u.Update(func(s *RoleUpsert) {
+		s.UpdateDataScope()
+	})
+}
+
+// ClearDataScope clears the value of the "data_scope" field.
+func (u *RoleUpsertOne) ClearDataScope() *RoleUpsertOne {
+	return u.Update(func(s *RoleUpsert) {
+		s.ClearDataScope()
+	})
+}
+
+// SetDataScopeOrgUnitIds sets the "data_scope_org_unit_ids" field.
+func (u *RoleUpsertOne) SetDataScopeOrgUnitIds(v []uint32) *RoleUpsertOne {
+	return u.Update(func(s *RoleUpsert) {
+		s.SetDataScopeOrgUnitIds(v)
+	})
+}
+
+// UpdateDataScopeOrgUnitIds sets the "data_scope_org_unit_ids" field to the value that was provided on create.
+func (u *RoleUpsertOne) UpdateDataScopeOrgUnitIds() *RoleUpsertOne {
+	return u.Update(func(s *RoleUpsert) {
+		s.UpdateDataScopeOrgUnitIds()
+	})
+}
+
+// ClearDataScopeOrgUnitIds clears the value of the "data_scope_org_unit_ids" field.
+func (u *RoleUpsertOne) ClearDataScopeOrgUnitIds() *RoleUpsertOne {
+	return u.Update(func(s *RoleUpsert) {
+		s.ClearDataScopeOrgUnitIds()
+	})
+}
+
 // Exec executes the query.
 func (u *RoleUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1553,6 +1664,48 @@ func (u *RoleUpsertBulk) UpdateType() *RoleUpsertBulk {
 	})
 }
 
+// SetDataScope sets the "data_scope" field.
+func (u *RoleUpsertBulk) SetDataScope(v role.DataScope) *RoleUpsertBulk {
+	return u.Update(func(s *RoleUpsert) {
+		s.SetDataScope(v)
+	})
+}
+
+// UpdateDataScope sets the "data_scope" field to the value that was provided on create.
+func (u *RoleUpsertBulk) UpdateDataScope() *RoleUpsertBulk {
+	return u.Update(func(s *RoleUpsert) {
+		s.UpdateDataScope()
+	})
+}
+
+// ClearDataScope clears the value of the "data_scope" field.
+func (u *RoleUpsertBulk) ClearDataScope() *RoleUpsertBulk {
+	return u.Update(func(s *RoleUpsert) {
+		s.ClearDataScope()
+	})
+}
+
+// SetDataScopeOrgUnitIds sets the "data_scope_org_unit_ids" field.
+func (u *RoleUpsertBulk) SetDataScopeOrgUnitIds(v []uint32) *RoleUpsertBulk {
+	return u.Update(func(s *RoleUpsert) {
+		s.SetDataScopeOrgUnitIds(v)
+	})
+}
+
+// UpdateDataScopeOrgUnitIds sets the "data_scope_org_unit_ids" field to the value that was provided on create.
+func (u *RoleUpsertBulk) UpdateDataScopeOrgUnitIds() *RoleUpsertBulk {
+	return u.Update(func(s *RoleUpsert) {
+		s.UpdateDataScopeOrgUnitIds()
+	})
+}
+
+// ClearDataScopeOrgUnitIds clears the value of the "data_scope_org_unit_ids" field.
+func (u *RoleUpsertBulk) ClearDataScopeOrgUnitIds() *RoleUpsertBulk {
+	return u.Update(func(s *RoleUpsert) {
+		s.ClearDataScopeOrgUnitIds()
+	})
+}
+
 // Exec executes the query.
 func (u *RoleUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
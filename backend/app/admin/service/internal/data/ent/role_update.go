@@ -12,6 +12,7 @@
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 )
 
@@ -299,6 +300,44 @@ func (_u *RoleUpdate) SetNillableType(v *role.Type) *RoleUpdate {
 	return _u
 }
 
+// SetDataScope sets the "data_scope" field.
+func (_u *RoleUpdate) SetDataScope(v role.DataScope) *RoleUpdate {
+	_u.mutation.SetDataScope(v)
+	return _u
+}
+
+// SetNillableDataScope sets the "data_scope" field if the given value is not nil.
+func (_u *RoleUpdate) SetNillableDataScope(v *role.DataScope) *RoleUpdate {
+	if v != nil {
+		_u.SetDataScope(*v)
+	}
+	return _u
+}
+
+// ClearDataScope clears the value of the "data_scope" field.
+func (_u *RoleUpdate) ClearDataScope() *RoleUpdate {
+	_u.mutation.ClearDataScope()
+	return _u
+}
+
+// SetDataScopeOrgUnitIds sets the "data_scope_org_unit_ids" field.
+func (_u *RoleUpdate) SetDataScopeOrgUnitIds(v []uint32) *RoleUpdate {
+	_u.mutation.SetDataScopeOrgUnitIds(v)
+	return _u
+}
+
+// AppendDataScopeOrgUnitIds appends value to the "data_scope_org_unit_ids" field.
+func (_u *RoleUpdate) AppendDataScopeOrgUnitIds(v []uint32) *RoleUpdate {
+	_u.mutation.AppendDataScopeOrgUnitIds(v)
+	return _u
+}
+
+// ClearDataScopeOrgUnitIds clears the value of the "data_scope_org_unit_ids" field.
+func (_u *RoleUpdate) ClearDataScopeOrgUnitIds() *RoleUpdate {
+	_u.mutation.ClearDataScopeOrgUnitIds()
+	return _u
+}
+
 // Mutation returns the RoleMutation object of the builder.
 func (_u *RoleUpdate) Mutation() *RoleMutation {
 	return _u.mutation
@@ -353,6 +392,11 @@ func (_u *RoleUpdate) check() error {
 			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Role.type": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.DataScope(); ok {
+		if err := role.DataScopeValidator(v); err != nil {
+			return &ValidationError{Name: "data_scope", err: fmt.Errorf(`ent: validator failed for field "Role.data_scope": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -461,6 +505,23 @@ func (_u *RoleUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.GetType(); ok {
 		_spec.SetField(role.FieldType, field.TypeEnum, value)
 	}
+	if value, ok := _u.mutation.DataScope(); ok {
+		_spec.SetField(role.FieldDataScope, field.TypeEnum, value)
+	}
+	if _u.mutation.DataScopeCleared() {
+		_spec.ClearField(role.FieldDataScope, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.DataScopeOrgUnitIds(); ok {
+		_spec.SetField(role.FieldDataScopeOrgUnitIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedDataScopeOrgUnitIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, role.FieldDataScopeOrgUnitIds, value)
+		})
+	}
+	if _u.mutation.DataScopeOrgUnitIdsCleared() {
+		_spec.ClearField(role.FieldDataScopeOrgUnitIds, field.TypeJSON)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -753,6 +814,44 @@ func (_u *RoleUpdateOne) SetNillableType(v *role.Type) *RoleUpdateOne {
 	return _u
 }
 
+// SetDataScope sets the "data_scope" field.
+func (_u *RoleUpdateOne) SetDataScope(v role.DataScope) *RoleUpdateOne {
+	_u.mutation.SetDataScope(v)
+	return _u
+}
+
+// SetNillableDataScope sets the "data_scope" field if the given value is not nil.
+func (_u *RoleUpdateOne) SetNillableDataScope(v *role.DataScope) *RoleUpdateOne {
+	if v != nil {
+		_u.SetDataScope(*v)
+	}
+	return _u
+}
+
+// ClearDataScope clears the value of the "data_scope" field.
+func (_u *RoleUpdateOne) ClearDataScope() *RoleUpdateOne {
+	_u.mutation.ClearDataScope()
+	return _u
+}
+
+// SetDataScopeOrgUnitIds sets the "data_scope_org_unit_ids" field.
+func (_u *RoleUpdateOne) SetDataScopeOrgUnitIds(v []uint32) *RoleUpdateOne {
+	_u.mutation.SetDataScopeOrgUnitIds(v)
+	return _u
+}
+
+// AppendDataScopeOrgUnitIds appends value to the "data_scope_org_unit_ids" field.
+func (_u *RoleUpdateOne) AppendDataScopeOrgUnitIds(v []uint32) *RoleUpdateOne {
+	_u.mutation.AppendDataScopeOrgUnitIds(v)
+	return _u
+}
+
+// ClearDataScopeOrgUnitIds clears the value of the "data_scope_org_unit_ids" field.
+func (_u *RoleUpdateOne) ClearDataScopeOrgUnitIds() *RoleUpdateOne {
+	_u.mutation.ClearDataScopeOrgUnitIds()
+	return _u
+}
+
 // Mutation returns the RoleMutation object of the builder.
 func (_u *RoleUpdateOne) Mutation() *RoleMutation {
 	return _u.mutation
@@ -820,6 +919,11 @@ func (_u *RoleUpdateOne) check() error {
 			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Role.type": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.DataScope(); ok {
+		if err := role.DataScopeValidator(v); err != nil {
+			return &ValidationError{Name: "data_scope", err: fmt.Errorf(`ent: validator failed for field "Role.data_scope": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -945,6 +1049,23 @@ func (_u *RoleUpdateOne) sqlSave(ctx context.Context) (_node *Role, err error) {
 	if value, ok := _u.mutation.GetType(); ok {
 		_spec.SetField(role.FieldType, field.TypeEnum, value)
 	}
+	if value, ok := _u.mutation.DataScope(); ok {
+		_spec.SetField(role.FieldDataScope, field.TypeEnum, value)
+	}
+	if _u.mutation.DataScopeCleared() {
+		_spec.ClearField(role.FieldDataScope, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.DataScopeOrgUnitIds(); ok {
+		_spec.SetField(role.FieldDataScopeOrgUnitIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedDataScopeOrgUnitIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, role.FieldDataScopeOrgUnitIds, value)
+		})
+	}
+	if _u.mutation.DataScopeOrgUnitIdsCleared() {
+		_spec.ClearField(role.FieldDataScopeOrgUnitIds, field.TypeJSON)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	_node = &Role{config: _u.config}
 	_spec.Assign = _node.assignValues
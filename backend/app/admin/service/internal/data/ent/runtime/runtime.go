@@ -7,6 +7,7 @@
 	permissionpb "go-wind-admin/api/gen/go/permission/service/v1"
 	"go-wind-admin/app/admin/service/internal/data/ent/api"
 	"go-wind-admin/app/admin/service/internal/data/ent/apiauditlog"
+	"go-wind-admin/app/admin/service/internal/data/ent/apiclient"
 	"go-wind-admin/app/admin/service/internal/data/ent/dataaccessauditlog"
 	"go-wind-admin/app/admin/service/internal/data/ent/dictentry"
 	"go-wind-admin/app/admin/service/internal/data/ent/dictentryi18n"
@@ -15,7 +16,9 @@
 	"go-wind-admin/app/admin/service/internal/data/ent/internalmessage"
 	"go-wind-admin/app/admin/service/internal/data/ent/internalmessagecategory"
 	"go-wind-admin/app/admin/service/internal/data/ent/internalmessagerecipient"
+	"go-wind-admin/app/admin/service/internal/data/ent/jwtsigningkey"
 	"go-wind-admin/app/admin/service/internal/data/ent/language"
+	"go-wind-admin/app/admin/service/internal/data/ent/ldapconfig"
 	"go-wind-admin/app/admin/service/internal/data/ent/loginauditlog"
 	"go-wind-admin/app/admin/service/internal/data/ent/loginpolicy"
 	"go-wind-admin/app/admin/service/internal/data/ent/membership"
@@ -23,8 +26,11 @@
 	"go-wind-admin/app/admin/service/internal/data/ent/membershipposition"
 	"go-wind-admin/app/admin/service/internal/data/ent/membershiprole"
 	"go-wind-admin/app/admin/service/internal/data/ent/menu"
+	"go-wind-admin/app/admin/service/internal/data/ent/mfapolicy"
+	"go-wind-admin/app/admin/service/internal/data/ent/oauthproviderconfig"
 	"go-wind-admin/app/admin/service/internal/data/ent/operationauditlog"
 	"go-wind-admin/app/admin/service/internal/data/ent/orgunit"
+	"go-wind-admin/app/admin/service/internal/data/ent/passwordpolicy"
 	"go-wind-admin/app/admin/service/internal/data/ent/permission"
 	"go-wind-admin/app/admin/service/internal/data/ent/permissionapi"
 	"go-wind-admin/app/admin/service/internal/data/ent/permissionauditlog"
@@ -36,10 +42,14 @@
 	"go-wind-admin/app/admin/service/internal/data/ent/planquota"
 	"go-wind-admin/app/admin/service/internal/data/ent/policyevaluationlog"
 	"go-wind-admin/app/admin/service/internal/data/ent/position"
+	"go-wind-admin/app/admin/service/internal/data/ent/relationtuple"
+	"go-wind-admin/app/admin/service/internal/data/ent/relationtuplechange"
 	"go-wind-admin/app/admin/service/internal/data/ent/role"
 	"go-wind-admin/app/admin/service/internal/data/ent/rolemetadata"
 	"go-wind-admin/app/admin/service/internal/data/ent/rolepermission"
+	"go-wind-admin/app/admin/service/internal/data/ent/samlconfig"
 	"go-wind-admin/app/admin/service/internal/data/ent/schema"
+	"go-wind-admin/app/admin/service/internal/data/ent/scimtoken"
 	"go-wind-admin/app/admin/service/internal/data/ent/task"
 	"go-wind-admin/app/admin/service/internal/data/ent/tenant"
 	"go-wind-admin/app/admin/service/internal/data/ent/user"
@@ -92,6 +102,94 @@ func init() {
 	apiauditlogDescID := apiauditlogMixinFields0[0].Descriptor()
 	// apiauditlog.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	apiauditlog.IDValidator = apiauditlogDescID.Validators[0].(func(uint32) error)
+	apiclientMixin := schema.ApiClient{}.Mixin()
+	apiclient.Policy = privacy.NewPolicies(apiclientMixin[4], schema.ApiClient{})
+	apiclient.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := apiclient.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	apiclientMixinFields0 := apiclientMixin[0].Fields()
+	_ = apiclientMixinFields0
+	apiclientMixinFields4 := apiclientMixin[4].Fields()
+	_ = apiclientMixinFields4
+	apiclientMixinFields5 := apiclientMixin[5].Fields()
+	_ = apiclientMixinFields5
+	apiclientFields := schema.ApiClient{}.Fields()
+	_ = apiclientFields
+	// apiclientDescTenantID is the schema descriptor for tenant_id field.
+	apiclientDescTenantID := apiclientMixinFields4[0].Descriptor()
+	// apiclient.DefaultTenantID holds the default value on creation for the tenant_id field.
+	apiclient.DefaultTenantID = apiclientDescTenantID.Default.(uint32)
+	// apiclientDescName is the schema descriptor for name field.
+	apiclientDescName := apiclientFields[0].Descriptor()
+	// apiclient.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	apiclient.NameValidator = func() func(string) error {
+		validators := apiclientDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// apiclientDescClientID is the schema descriptor for client_id field.
+	apiclientDescClientID := apiclientFields[1].Descriptor()
+	// apiclient.ClientIDValidator is a validator for the "client_id" field. It is called by the builders before save.
+	apiclient.ClientIDValidator = func() func(string) error {
+		validators := apiclientDescClientID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(client_id string) error {
+			for _, fn := range fns {
+				if err := fn(client_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// apiclientDescClientSecretHash is the schema descriptor for client_secret_hash field.
+	apiclientDescClientSecretHash := apiclientFields[2].Descriptor()
+	// apiclient.ClientSecretHashValidator is a validator for the "client_secret_hash" field. It is called by the builders before save.
+	apiclient.ClientSecretHashValidator = func() func(string) error {
+		validators := apiclientDescClientSecretHash.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(client_secret_hash string) error {
+			for _, fn := range fns {
+				if err := fn(client_secret_hash); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// apiclientDescPublicClient is the schema descriptor for public_client field.
+	apiclientDescPublicClient := apiclientFields[6].Descriptor()
+	// apiclient.DefaultPublicClient holds the default value on creation for the public_client field.
+	apiclient.DefaultPublicClient = apiclientDescPublicClient.Default.(bool)
+	// apiclientDescLastUsedIP is the schema descriptor for last_used_ip field.
+	apiclientDescLastUsedIP := apiclientFields[10].Descriptor()
+	// apiclient.LastUsedIPValidator is a validator for the "last_used_ip" field. It is called by the builders before save.
+	apiclient.LastUsedIPValidator = apiclientDescLastUsedIP.Validators[0].(func(string) error)
+	// apiclientDescID is the schema descriptor for id field.
+	apiclientDescID := apiclientMixinFields0[0].Descriptor()
+	// apiclient.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	apiclient.IDValidator = apiclientDescID.Validators[0].(func(uint32) error)
 	dataaccessauditlogMixin := schema.DataAccessAuditLog{}.Mixin()
 	dataaccessauditlog.Policy = privacy.NewPolicies(dataaccessauditlogMixin[2], schema.DataAccessAuditLog{})
 	dataaccessauditlog.Hooks[0] = func(next ent.Mutator) ent.Mutator {
@@ -354,6 +452,55 @@ func init() {
 	internalmessagerecipientDescID := internalmessagerecipientMixinFields0[0].Descriptor()
 	// internalmessagerecipient.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	internalmessagerecipient.IDValidator = internalmessagerecipientDescID.Validators[0].(func(uint32) error)
+	jwtsigningkeyMixin := schema.JwtSigningKey{}.Mixin()
+	jwtsigningkeyMixinFields0 := jwtsigningkeyMixin[0].Fields()
+	_ = jwtsigningkeyMixinFields0
+	jwtsigningkeyFields := schema.JwtSigningKey{}.Fields()
+	_ = jwtsigningkeyFields
+	// jwtsigningkeyDescKid is the schema descriptor for kid field.
+	jwtsigningkeyDescKid := jwtsigningkeyFields[0].Descriptor()
+	// jwtsigningkey.KidValidator is a validator for the "kid" field. It is called by the builders before save.
+	jwtsigningkey.KidValidator = func() func(string) error {
+		validators := jwtsigningkeyDescKid.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(kid string) error {
+			for _, fn := range fns {
+				if err := fn(kid); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// jwtsigningkeyDescAlgorithm is the schema descriptor for algorithm field.
+	jwtsigningkeyDescAlgorithm := jwtsigningkeyFields[1].Descriptor()
+	// jwtsigningkey.AlgorithmValidator is a validator for the "algorithm" field. It is called by the builders before save.
+	jwtsigningkey.AlgorithmValidator = func() func(string) error {
+		validators := jwtsigningkeyDescAlgorithm.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(algorithm string) error {
+			for _, fn := range fns {
+				if err := fn(algorithm); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// jwtsigningkeyDescKeyMaterial is the schema descriptor for key_material field.
+	jwtsigningkeyDescKeyMaterial := jwtsigningkeyFields[2].Descriptor()
+	// jwtsigningkey.KeyMaterialValidator is a validator for the "key_material" field. It is called by the builders before save.
+	jwtsigningkey.KeyMaterialValidator = jwtsigningkeyDescKeyMaterial.Validators[0].(func(string) error)
+	// jwtsigningkeyDescID is the schema descriptor for id field.
+	jwtsigningkeyDescID := jwtsigningkeyMixinFields0[0].Descriptor()
+	// jwtsigningkey.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	jwtsigningkey.IDValidator = jwtsigningkeyDescID.Validators[0].(func(uint32) error)
 	languageMixin := schema.Language{}.Mixin()
 	languageMixinFields0 := languageMixin[0].Fields()
 	_ = languageMixinFields0
@@ -391,6 +538,126 @@ func init() {
 	languageDescID := languageMixinFields0[0].Descriptor()
 	// language.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	language.IDValidator = languageDescID.Validators[0].(func(uint32) error)
+	ldapconfigMixin := schema.LdapConfig{}.Mixin()
+	ldapconfig.Policy = privacy.NewPolicies(ldapconfigMixin[3], schema.LdapConfig{})
+	ldapconfig.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := ldapconfig.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	ldapconfigMixinFields0 := ldapconfigMixin[0].Fields()
+	_ = ldapconfigMixinFields0
+	ldapconfigMixinFields3 := ldapconfigMixin[3].Fields()
+	_ = ldapconfigMixinFields3
+	ldapconfigMixinFields4 := ldapconfigMixin[4].Fields()
+	_ = ldapconfigMixinFields4
+	ldapconfigFields := schema.LdapConfig{}.Fields()
+	_ = ldapconfigFields
+	// ldapconfigDescTenantID is the schema descriptor for tenant_id field.
+	ldapconfigDescTenantID := ldapconfigMixinFields3[0].Descriptor()
+	// ldapconfig.DefaultTenantID holds the default value on creation for the tenant_id field.
+	ldapconfig.DefaultTenantID = ldapconfigDescTenantID.Default.(uint32)
+	// ldapconfigDescDisplayName is the schema descriptor for display_name field.
+	ldapconfigDescDisplayName := ldapconfigFields[0].Descriptor()
+	// ldapconfig.DisplayNameValidator is a validator for the "display_name" field. It is called by the builders before save.
+	ldapconfig.DisplayNameValidator = ldapconfigDescDisplayName.Validators[0].(func(string) error)
+	// ldapconfigDescServerURL is the schema descriptor for server_url field.
+	ldapconfigDescServerURL := ldapconfigFields[1].Descriptor()
+	// ldapconfig.ServerURLValidator is a validator for the "server_url" field. It is called by the builders before save.
+	ldapconfig.ServerURLValidator = func() func(string) error {
+		validators := ldapconfigDescServerURL.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(server_url string) error {
+			for _, fn := range fns {
+				if err := fn(server_url); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// ldapconfigDescStartTLS is the schema descriptor for start_tls field.
+	ldapconfigDescStartTLS := ldapconfigFields[2].Descriptor()
+	// ldapconfig.DefaultStartTLS holds the default value on creation for the start_tls field.
+	ldapconfig.DefaultStartTLS = ldapconfigDescStartTLS.Default.(bool)
+	// ldapconfigDescBindDn is the schema descriptor for bind_dn field.
+	ldapconfigDescBindDn := ldapconfigFields[4].Descriptor()
+	// ldapconfig.BindDnValidator is a validator for the "bind_dn" field. It is called by the builders before save.
+	ldapconfig.BindDnValidator = ldapconfigDescBindDn.Validators[0].(func(string) error)
+	// ldapconfigDescBindPassword is the schema descriptor for bind_password field.
+	ldapconfigDescBindPassword := ldapconfigFields[5].Descriptor()
+	// ldapconfig.BindPasswordValidator is a validator for the "bind_password" field. It is called by the builders before save.
+	ldapconfig.BindPasswordValidator = ldapconfigDescBindPassword.Validators[0].(func(string) error)
+	// ldapconfigDescBaseDn is the schema descriptor for base_dn field.
+	ldapconfigDescBaseDn := ldapconfigFields[6].Descriptor()
+	// ldapconfig.BaseDnValidator is a validator for the "base_dn" field. It is called by the builders before save.
+	ldapconfig.BaseDnValidator = func() func(string) error {
+		validators := ldapconfigDescBaseDn.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(base_dn string) error {
+			for _, fn := range fns {
+				if err := fn(base_dn); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// ldapconfigDescUserFilter is the schema descriptor for user_filter field.
+	ldapconfigDescUserFilter := ldapconfigFields[7].Descriptor()
+	// ldapconfig.UserFilterValidator is a validator for the "user_filter" field. It is called by the builders before save.
+	ldapconfig.UserFilterValidator = func() func(string) error {
+		validators := ldapconfigDescUserFilter.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(user_filter string) error {
+			for _, fn := range fns {
+				if err := fn(user_filter); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// ldapconfigDescIDAttribute is the schema descriptor for id_attribute field.
+	ldapconfigDescIDAttribute := ldapconfigFields[8].Descriptor()
+	// ldapconfig.IDAttributeValidator is a validator for the "id_attribute" field. It is called by the builders before save.
+	ldapconfig.IDAttributeValidator = ldapconfigDescIDAttribute.Validators[0].(func(string) error)
+	// ldapconfigDescGroupFilter is the schema descriptor for group_filter field.
+	ldapconfigDescGroupFilter := ldapconfigFields[10].Descriptor()
+	// ldapconfig.GroupFilterValidator is a validator for the "group_filter" field. It is called by the builders before save.
+	ldapconfig.GroupFilterValidator = ldapconfigDescGroupFilter.Validators[0].(func(string) error)
+	// ldapconfigDescGroupMemberAttribute is the schema descriptor for group_member_attribute field.
+	ldapconfigDescGroupMemberAttribute := ldapconfigFields[11].Descriptor()
+	// ldapconfig.GroupMemberAttributeValidator is a validator for the "group_member_attribute" field. It is called by the builders before save.
+	ldapconfig.GroupMemberAttributeValidator = ldapconfigDescGroupMemberAttribute.Validators[0].(func(string) error)
+	// ldapconfigDescOrgUnitFilter is the schema descriptor for org_unit_filter field.
+	ldapconfigDescOrgUnitFilter := ldapconfigFields[13].Descriptor()
+	// ldapconfig.OrgUnitFilterValidator is a validator for the "org_unit_filter" field. It is called by the builders before save.
+	ldapconfig.OrgUnitFilterValidator = ldapconfigDescOrgUnitFilter.Validators[0].(func(string) error)
+	// ldapconfigDescJitProvisioning is the schema descriptor for jit_provisioning field.
+	ldapconfigDescJitProvisioning := ldapconfigFields[16].Descriptor()
+	// ldapconfig.DefaultJitProvisioning holds the default value on creation for the jit_provisioning field.
+	ldapconfig.DefaultJitProvisioning = ldapconfigDescJitProvisioning.Default.(bool)
+	// ldapconfigDescLastSyncMessage is the schema descriptor for last_sync_message field.
+	ldapconfigDescLastSyncMessage := ldapconfigFields[20].Descriptor()
+	// ldapconfig.LastSyncMessageValidator is a validator for the "last_sync_message" field. It is called by the builders before save.
+	ldapconfig.LastSyncMessageValidator = ldapconfigDescLastSyncMessage.Validators[0].(func(string) error)
+	// ldapconfigDescID is the schema descriptor for id field.
+	ldapconfigDescID := ldapconfigMixinFields0[0].Descriptor()
+	// ldapconfig.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	ldapconfig.IDValidator = ldapconfigDescID.Validators[0].(func(uint32) error)
 	loginauditlogMixin := schema.LoginAuditLog{}.Mixin()
 	loginauditlog.Policy = privacy.NewPolicies(loginauditlogMixin[2], schema.LoginAuditLog{})
 	loginauditlog.Hooks[0] = func(next ent.Mutator) ent.Mutator {
@@ -570,6 +837,132 @@ func init() {
 	menuDescID := menuMixinFields0[0].Descriptor()
 	// menu.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	menu.IDValidator = menuDescID.Validators[0].(func(uint32) error)
+	mfapolicyMixin := schema.MfaPolicy{}.Mixin()
+	mfapolicy.Policy = privacy.NewPolicies(mfapolicyMixin[3], schema.MfaPolicy{})
+	mfapolicy.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := mfapolicy.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	mfapolicyMixinFields0 := mfapolicyMixin[0].Fields()
+	_ = mfapolicyMixinFields0
+	mfapolicyMixinFields3 := mfapolicyMixin[3].Fields()
+	_ = mfapolicyMixinFields3
+	mfapolicyFields := schema.MfaPolicy{}.Fields()
+	_ = mfapolicyFields
+	// mfapolicyDescTenantID is the schema descriptor for tenant_id field.
+	mfapolicyDescTenantID := mfapolicyMixinFields3[0].Descriptor()
+	// mfapolicy.DefaultTenantID holds the default value on creation for the tenant_id field.
+	mfapolicy.DefaultTenantID = mfapolicyDescTenantID.Default.(uint32)
+	// mfapolicyDescID is the schema descriptor for id field.
+	mfapolicyDescID := mfapolicyMixinFields0[0].Descriptor()
+	// mfapolicy.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	mfapolicy.IDValidator = mfapolicyDescID.Validators[0].(func(uint32) error)
+	oauthproviderconfigMixin := schema.OAuthProviderConfig{}.Mixin()
+	oauthproviderconfig.Policy = privacy.NewPolicies(oauthproviderconfigMixin[3], schema.OAuthProviderConfig{})
+	oauthproviderconfig.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := oauthproviderconfig.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	oauthproviderconfigMixinFields0 := oauthproviderconfigMixin[0].Fields()
+	_ = oauthproviderconfigMixinFields0
+	oauthproviderconfigMixinFields3 := oauthproviderconfigMixin[3].Fields()
+	_ = oauthproviderconfigMixinFields3
+	oauthproviderconfigMixinFields4 := oauthproviderconfigMixin[4].Fields()
+	_ = oauthproviderconfigMixinFields4
+	oauthproviderconfigMixinFields5 := oauthproviderconfigMixin[5].Fields()
+	_ = oauthproviderconfigMixinFields5
+	oauthproviderconfigFields := schema.OAuthProviderConfig{}.Fields()
+	_ = oauthproviderconfigFields
+	// oauthproviderconfigDescTenantID is the schema descriptor for tenant_id field.
+	oauthproviderconfigDescTenantID := oauthproviderconfigMixinFields3[0].Descriptor()
+	// oauthproviderconfig.DefaultTenantID holds the default value on creation for the tenant_id field.
+	oauthproviderconfig.DefaultTenantID = oauthproviderconfigDescTenantID.Default.(uint32)
+	// oauthproviderconfigDescSortOrder is the schema descriptor for sort_order field.
+	oauthproviderconfigDescSortOrder := oauthproviderconfigMixinFields5[0].Descriptor()
+	// oauthproviderconfig.DefaultSortOrder holds the default value on creation for the sort_order field.
+	oauthproviderconfig.DefaultSortOrder = oauthproviderconfigDescSortOrder.Default.(uint32)
+	// oauthproviderconfigDescProvider is the schema descriptor for provider field.
+	oauthproviderconfigDescProvider := oauthproviderconfigFields[0].Descriptor()
+	// oauthproviderconfig.ProviderValidator is a validator for the "provider" field. It is called by the builders before save.
+	oauthproviderconfig.ProviderValidator = func() func(string) error {
+		validators := oauthproviderconfigDescProvider.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(provider string) error {
+			for _, fn := range fns {
+				if err := fn(provider); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// oauthproviderconfigDescProviderCustom is the schema descriptor for provider_custom field.
+	oauthproviderconfigDescProviderCustom := oauthproviderconfigFields[1].Descriptor()
+	// oauthproviderconfig.DefaultProviderCustom holds the default value on creation for the provider_custom field.
+	oauthproviderconfig.DefaultProviderCustom = oauthproviderconfigDescProviderCustom.Default.(string)
+	// oauthproviderconfig.ProviderCustomValidator is a validator for the "provider_custom" field. It is called by the builders before save.
+	oauthproviderconfig.ProviderCustomValidator = oauthproviderconfigDescProviderCustom.Validators[0].(func(string) error)
+	// oauthproviderconfigDescDisplayName is the schema descriptor for display_name field.
+	oauthproviderconfigDescDisplayName := oauthproviderconfigFields[2].Descriptor()
+	// oauthproviderconfig.DisplayNameValidator is a validator for the "display_name" field. It is called by the builders before save.
+	oauthproviderconfig.DisplayNameValidator = oauthproviderconfigDescDisplayName.Validators[0].(func(string) error)
+	// oauthproviderconfigDescClientID is the schema descriptor for client_id field.
+	oauthproviderconfigDescClientID := oauthproviderconfigFields[3].Descriptor()
+	// oauthproviderconfig.ClientIDValidator is a validator for the "client_id" field. It is called by the builders before save.
+	oauthproviderconfig.ClientIDValidator = func() func(string) error {
+		validators := oauthproviderconfigDescClientID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(client_id string) error {
+			for _, fn := range fns {
+				if err := fn(client_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// oauthproviderconfigDescClientSecret is the schema descriptor for client_secret field.
+	oauthproviderconfigDescClientSecret := oauthproviderconfigFields[4].Descriptor()
+	// oauthproviderconfig.ClientSecretValidator is a validator for the "client_secret" field. It is called by the builders before save.
+	oauthproviderconfig.ClientSecretValidator = oauthproviderconfigDescClientSecret.Validators[0].(func(string) error)
+	// oauthproviderconfigDescAuthURL is the schema descriptor for auth_url field.
+	oauthproviderconfigDescAuthURL := oauthproviderconfigFields[5].Descriptor()
+	// oauthproviderconfig.AuthURLValidator is a validator for the "auth_url" field. It is called by the builders before save.
+	oauthproviderconfig.AuthURLValidator = oauthproviderconfigDescAuthURL.Validators[0].(func(string) error)
+	// oauthproviderconfigDescTokenURL is the schema descriptor for token_url field.
+	oauthproviderconfigDescTokenURL := oauthproviderconfigFields[6].Descriptor()
+	// oauthproviderconfig.TokenURLValidator is a validator for the "token_url" field. It is called by the builders before save.
+	oauthproviderconfig.TokenURLValidator = oauthproviderconfigDescTokenURL.Validators[0].(func(string) error)
+	// oauthproviderconfigDescUserinfoURL is the schema descriptor for userinfo_url field.
+	oauthproviderconfigDescUserinfoURL := oauthproviderconfigFields[7].Descriptor()
+	// oauthproviderconfig.UserinfoURLValidator is a validator for the "userinfo_url" field. It is called by the builders before save.
+	oauthproviderconfig.UserinfoURLValidator = oauthproviderconfigDescUserinfoURL.Validators[0].(func(string) error)
+	// oauthproviderconfigDescRedirectURI is the schema descriptor for redirect_uri field.
+	oauthproviderconfigDescRedirectURI := oauthproviderconfigFields[8].Descriptor()
+	// oauthproviderconfig.RedirectURIValidator is a validator for the "redirect_uri" field. It is called by the builders before save.
+	oauthproviderconfig.RedirectURIValidator = oauthproviderconfigDescRedirectURI.Validators[0].(func(string) error)
+	// oauthproviderconfigDescAutoProvision is the schema descriptor for auto_provision field.
+	oauthproviderconfigDescAutoProvision := oauthproviderconfigFields[10].Descriptor()
+	// oauthproviderconfig.DefaultAutoProvision holds the default value on creation for the auto_provision field.
+	oauthproviderconfig.DefaultAutoProvision = oauthproviderconfigDescAutoProvision.Default.(bool)
+	// oauthproviderconfigDescID is the schema descriptor for id field.
+	oauthproviderconfigDescID := oauthproviderconfigMixinFields0[0].Descriptor()
+	// oauthproviderconfig.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	oauthproviderconfig.IDValidator = oauthproviderconfigDescID.Validators[0].(func(uint32) error)
 	operationauditlogMixin := schema.OperationAuditLog{}.Mixin()
 	operationauditlog.Policy = privacy.NewPolicies(operationauditlogMixin[2], schema.OperationAuditLog{})
 	operationauditlog.Hooks[0] = func(next ent.Mutator) ent.Mutator {
@@ -640,6 +1033,66 @@ func init() {
 	orgunitDescID := orgunitMixinFields0[0].Descriptor()
 	// orgunit.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	orgunit.IDValidator = orgunitDescID.Validators[0].(func(uint32) error)
+	passwordpolicyMixin := schema.PasswordPolicy{}.Mixin()
+	passwordpolicy.Policy = privacy.NewPolicies(passwordpolicyMixin[3], schema.PasswordPolicy{})
+	passwordpolicy.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := passwordpolicy.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	passwordpolicyMixinFields0 := passwordpolicyMixin[0].Fields()
+	_ = passwordpolicyMixinFields0
+	passwordpolicyMixinFields3 := passwordpolicyMixin[3].Fields()
+	_ = passwordpolicyMixinFields3
+	passwordpolicyFields := schema.PasswordPolicy{}.Fields()
+	_ = passwordpolicyFields
+	// passwordpolicyDescTenantID is the schema descriptor for tenant_id field.
+	passwordpolicyDescTenantID := passwordpolicyMixinFields3[0].Descriptor()
+	// passwordpolicy.DefaultTenantID holds the default value on creation for the tenant_id field.
+	passwordpolicy.DefaultTenantID = passwordpolicyDescTenantID.Default.(uint32)
+	// passwordpolicyDescMinLength is the schema descriptor for min_length field.
+	passwordpolicyDescMinLength := passwordpolicyFields[0].Descriptor()
+	// passwordpolicy.DefaultMinLength holds the default value on creation for the min_length field.
+	passwordpolicy.DefaultMinLength = passwordpolicyDescMinLength.Default.(uint32)
+	// passwordpolicyDescRequireUppercase is the schema descriptor for require_uppercase field.
+	passwordpolicyDescRequireUppercase := passwordpolicyFields[1].Descriptor()
+	// passwordpolicy.DefaultRequireUppercase holds the default value on creation for the require_uppercase field.
+	passwordpolicy.DefaultRequireUppercase = passwordpolicyDescRequireUppercase.Default.(bool)
+	// passwordpolicyDescRequireLowercase is the schema descriptor for require_lowercase field.
+	passwordpolicyDescRequireLowercase := passwordpolicyFields[2].Descriptor()
+	// passwordpolicy.DefaultRequireLowercase holds the default value on creation for the require_lowercase field.
+	passwordpolicy.DefaultRequireLowercase = passwordpolicyDescRequireLowercase.Default.(bool)
+	// passwordpolicyDescRequireDigit is the schema descriptor for require_digit field.
+	passwordpolicyDescRequireDigit := passwordpolicyFields[3].Descriptor()
+	// passwordpolicy.DefaultRequireDigit holds the default value on creation for the require_digit field.
+	passwordpolicy.DefaultRequireDigit = passwordpolicyDescRequireDigit.Default.(bool)
+	// passwordpolicyDescRequireSymbol is the schema descriptor for require_symbol field.
+	passwordpolicyDescRequireSymbol := passwordpolicyFields[4].Descriptor()
+	// passwordpolicy.DefaultRequireSymbol holds the default value on creation for the require_symbol field.
+	passwordpolicy.DefaultRequireSymbol = passwordpolicyDescRequireSymbol.Default.(bool)
+	// passwordpolicyDescDisallowIdentity is the schema descriptor for disallow_identity field.
+	passwordpolicyDescDisallowIdentity := passwordpolicyFields[5].Descriptor()
+	// passwordpolicy.DefaultDisallowIdentity holds the default value on creation for the disallow_identity field.
+	passwordpolicy.DefaultDisallowIdentity = passwordpolicyDescDisallowIdentity.Default.(bool)
+	// passwordpolicyDescHistoryCount is the schema descriptor for history_count field.
+	passwordpolicyDescHistoryCount := passwordpolicyFields[7].Descriptor()
+	// passwordpolicy.DefaultHistoryCount holds the default value on creation for the history_count field.
+	passwordpolicy.DefaultHistoryCount = passwordpolicyDescHistoryCount.Default.(uint32)
+	// passwordpolicyDescMaxAgeDays is the schema descriptor for max_age_days field.
+	passwordpolicyDescMaxAgeDays := passwordpolicyFields[8].Descriptor()
+	// passwordpolicy.DefaultMaxAgeDays holds the default value on creation for the max_age_days field.
+	passwordpolicy.DefaultMaxAgeDays = passwordpolicyDescMaxAgeDays.Default.(uint32)
+	// passwordpolicyDescCheckBreached is the schema descriptor for check_breached field.
+	passwordpolicyDescCheckBreached := passwordpolicyFields[9].Descriptor()
+	// passwordpolicy.DefaultCheckBreached holds the default value on creation for the check_breached field.
+	passwordpolicy.DefaultCheckBreached = passwordpolicyDescCheckBreached.Default.(bool)
+	// passwordpolicyDescID is the schema descriptor for id field.
+	passwordpolicyDescID := passwordpolicyMixinFields0[0].Descriptor()
+	// passwordpolicy.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	passwordpolicy.IDValidator = passwordpolicyDescID.Validators[0].(func(uint32) error)
 	permissionMixin := schema.Permission{}.Mixin()
 	permissionMixinFields0 := permissionMixin[0].Fields()
 	_ = permissionMixinFields0
@@ -854,6 +1307,120 @@ func init() {
 	positionDescID := positionMixinFields0[0].Descriptor()
 	// position.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	position.IDValidator = positionDescID.Validators[0].(func(uint32) error)
+	relationtupleMixin := schema.RelationTuple{}.Mixin()
+	relationtupleMixinFields0 := relationtupleMixin[0].Fields()
+	_ = relationtupleMixinFields0
+	relationtupleFields := schema.RelationTuple{}.Fields()
+	_ = relationtupleFields
+	// relationtupleDescNamespace is the schema descriptor for namespace field.
+	relationtupleDescNamespace := relationtupleFields[0].Descriptor()
+	// relationtuple.NamespaceValidator is a validator for the "namespace" field. It is called by the builders before save.
+	relationtuple.NamespaceValidator = func() func(string) error {
+		validators := relationtupleDescNamespace.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(namespace string) error {
+			for _, fn := range fns {
+				if err := fn(namespace); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// relationtupleDescObjectID is the schema descriptor for object_id field.
+	relationtupleDescObjectID := relationtupleFields[1].Descriptor()
+	// relationtuple.ObjectIDValidator is a validator for the "object_id" field. It is called by the builders before save.
+	relationtuple.ObjectIDValidator = func() func(string) error {
+		validators := relationtupleDescObjectID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(object_id string) error {
+			for _, fn := range fns {
+				if err := fn(object_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// relationtupleDescRelation is the schema descriptor for relation field.
+	relationtupleDescRelation := relationtupleFields[2].Descriptor()
+	// relationtuple.RelationValidator is a validator for the "relation" field. It is called by the builders before save.
+	relationtuple.RelationValidator = func() func(string) error {
+		validators := relationtupleDescRelation.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(relation string) error {
+			for _, fn := range fns {
+				if err := fn(relation); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// relationtupleDescSubjectNamespace is the schema descriptor for subject_namespace field.
+	relationtupleDescSubjectNamespace := relationtupleFields[3].Descriptor()
+	// relationtuple.SubjectNamespaceValidator is a validator for the "subject_namespace" field. It is called by the builders before save.
+	relationtuple.SubjectNamespaceValidator = func() func(string) error {
+		validators := relationtupleDescSubjectNamespace.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(subject_namespace string) error {
+			for _, fn := range fns {
+				if err := fn(subject_namespace); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// relationtupleDescSubjectID is the schema descriptor for subject_id field.
+	relationtupleDescSubjectID := relationtupleFields[4].Descriptor()
+	// relationtuple.SubjectIDValidator is a validator for the "subject_id" field. It is called by the builders before save.
+	relationtuple.SubjectIDValidator = func() func(string) error {
+		validators := relationtupleDescSubjectID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(subject_id string) error {
+			for _, fn := range fns {
+				if err := fn(subject_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// relationtupleDescSubjectRelation is the schema descriptor for subject_relation field.
+	relationtupleDescSubjectRelation := relationtupleFields[5].Descriptor()
+	// relationtuple.DefaultSubjectRelation holds the default value on creation for the subject_relation field.
+	relationtuple.DefaultSubjectRelation = relationtupleDescSubjectRelation.Default.(string)
+	// relationtuple.SubjectRelationValidator is a validator for the "subject_relation" field. It is called by the builders before save.
+	relationtuple.SubjectRelationValidator = relationtupleDescSubjectRelation.Validators[0].(func(string) error)
+	// relationtupleDescID is the schema descriptor for id field.
+	relationtupleDescID := relationtupleMixinFields0[0].Descriptor()
+	// relationtuple.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	relationtuple.IDValidator = relationtupleDescID.Validators[0].(func(uint32) error)
+	relationtuplechangeMixin := schema.RelationTupleChange{}.Mixin()
+	relationtuplechangeMixinFields0 := relationtuplechangeMixin[0].Fields()
+	_ = relationtuplechangeMixinFields0
+	relationtuplechangeFields := schema.RelationTupleChange{}.Fields()
+	_ = relationtuplechangeFields
+	// relationtuplechangeDescID is the schema descriptor for id field.
+	relationtuplechangeDescID := relationtuplechangeMixinFields0[0].Descriptor()
+	// relationtuplechange.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	relationtuplechange.IDValidator = relationtuplechangeDescID.Validators[0].(func(uint32) error)
 	roleMixin := schema.Role{}.Mixin()
 	role.Policy = privacy.NewPolicies(roleMixin[6], schema.Role{})
 	role.Hooks[0] = func(next ent.Mutator) ent.Mutator {
@@ -964,6 +1531,158 @@ func init() {
 	rolepermissionDescID := rolepermissionMixinFields0[0].Descriptor()
 	// rolepermission.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	rolepermission.IDValidator = rolepermissionDescID.Validators[0].(func(uint32) error)
+	samlconfigMixin := schema.SamlConfig{}.Mixin()
+	samlconfig.Policy = privacy.NewPolicies(samlconfigMixin[3], schema.SamlConfig{})
+	samlconfig.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := samlconfig.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	samlconfigMixinFields0 := samlconfigMixin[0].Fields()
+	_ = samlconfigMixinFields0
+	samlconfigMixinFields3 := samlconfigMixin[3].Fields()
+	_ = samlconfigMixinFields3
+	samlconfigMixinFields4 := samlconfigMixin[4].Fields()
+	_ = samlconfigMixinFields4
+	samlconfigFields := schema.SamlConfig{}.Fields()
+	_ = samlconfigFields
+	// samlconfigDescTenantID is the schema descriptor for tenant_id field.
+	samlconfigDescTenantID := samlconfigMixinFields3[0].Descriptor()
+	// samlconfig.DefaultTenantID holds the default value on creation for the tenant_id field.
+	samlconfig.DefaultTenantID = samlconfigDescTenantID.Default.(uint32)
+	// samlconfigDescDisplayName is the schema descriptor for display_name field.
+	samlconfigDescDisplayName := samlconfigFields[0].Descriptor()
+	// samlconfig.DisplayNameValidator is a validator for the "display_name" field. It is called by the builders before save.
+	samlconfig.DisplayNameValidator = samlconfigDescDisplayName.Validators[0].(func(string) error)
+	// samlconfigDescIdpEntityID is the schema descriptor for idp_entity_id field.
+	samlconfigDescIdpEntityID := samlconfigFields[1].Descriptor()
+	// samlconfig.IdpEntityIDValidator is a validator for the "idp_entity_id" field. It is called by the builders before save.
+	samlconfig.IdpEntityIDValidator = func() func(string) error {
+		validators := samlconfigDescIdpEntityID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(idp_entity_id string) error {
+			for _, fn := range fns {
+				if err := fn(idp_entity_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// samlconfigDescIdpSSOURL is the schema descriptor for idp_sso_url field.
+	samlconfigDescIdpSSOURL := samlconfigFields[2].Descriptor()
+	// samlconfig.IdpSSOURLValidator is a validator for the "idp_sso_url" field. It is called by the builders before save.
+	samlconfig.IdpSSOURLValidator = func() func(string) error {
+		validators := samlconfigDescIdpSSOURL.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(idp_sso_url string) error {
+			for _, fn := range fns {
+				if err := fn(idp_sso_url); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// samlconfigDescNameIDFormat is the schema descriptor for name_id_format field.
+	samlconfigDescNameIDFormat := samlconfigFields[4].Descriptor()
+	// samlconfig.NameIDFormatValidator is a validator for the "name_id_format" field. It is called by the builders before save.
+	samlconfig.NameIDFormatValidator = samlconfigDescNameIDFormat.Validators[0].(func(string) error)
+	// samlconfigDescJitProvisioning is the schema descriptor for jit_provisioning field.
+	samlconfigDescJitProvisioning := samlconfigFields[6].Descriptor()
+	// samlconfig.DefaultJitProvisioning holds the default value on creation for the jit_provisioning field.
+	samlconfig.DefaultJitProvisioning = samlconfigDescJitProvisioning.Default.(bool)
+	// samlconfigDescSSOOnly is the schema descriptor for sso_only field.
+	samlconfigDescSSOOnly := samlconfigFields[9].Descriptor()
+	// samlconfig.DefaultSSOOnly holds the default value on creation for the sso_only field.
+	samlconfig.DefaultSSOOnly = samlconfigDescSSOOnly.Default.(bool)
+	// samlconfigDescLoginRedirectURL is the schema descriptor for login_redirect_url field.
+	samlconfigDescLoginRedirectURL := samlconfigFields[10].Descriptor()
+	// samlconfig.LoginRedirectURLValidator is a validator for the "login_redirect_url" field. It is called by the builders before save.
+	samlconfig.LoginRedirectURLValidator = samlconfigDescLoginRedirectURL.Validators[0].(func(string) error)
+	// samlconfigDescID is the schema descriptor for id field.
+	samlconfigDescID := samlconfigMixinFields0[0].Descriptor()
+	// samlconfig.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	samlconfig.IDValidator = samlconfigDescID.Validators[0].(func(uint32) error)
+	scimtokenMixin := schema.ScimToken{}.Mixin()
+	scimtoken.Policy = privacy.NewPolicies(scimtokenMixin[4], schema.ScimToken{})
+	scimtoken.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := scimtoken.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	scimtokenMixinFields0 := scimtokenMixin[0].Fields()
+	_ = scimtokenMixinFields0
+	scimtokenMixinFields4 := scimtokenMixin[4].Fields()
+	_ = scimtokenMixinFields4
+	scimtokenMixinFields5 := scimtokenMixin[5].Fields()
+	_ = scimtokenMixinFields5
+	scimtokenFields := schema.ScimToken{}.Fields()
+	_ = scimtokenFields
+	// scimtokenDescTenantID is the schema descriptor for tenant_id field.
+	scimtokenDescTenantID := scimtokenMixinFields4[0].Descriptor()
+	// scimtoken.DefaultTenantID holds the default value on creation for the tenant_id field.
+	scimtoken.DefaultTenantID = scimtokenDescTenantID.Default.(uint32)
+	// scimtokenDescName is the schema descriptor for name field.
+	scimtokenDescName := scimtokenFields[0].Descriptor()
+	// scimtoken.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	scimtoken.NameValidator = func() func(string) error {
+		validators := scimtokenDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// scimtokenDescTokenPrefix is the schema descriptor for token_prefix field.
+	scimtokenDescTokenPrefix := scimtokenFields[1].Descriptor()
+	// scimtoken.TokenPrefixValidator is a validator for the "token_prefix" field. It is called by the builders before save.
+	scimtoken.TokenPrefixValidator = scimtokenDescTokenPrefix.Validators[0].(func(string) error)
+	// scimtokenDescTokenHash is the schema descriptor for token_hash field.
+	scimtokenDescTokenHash := scimtokenFields[2].Descriptor()
+	// scimtoken.TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
+	scimtoken.TokenHashValidator = func() func(string) error {
+		validators := scimtokenDescTokenHash.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(token_hash string) error {
+			for _, fn := range fns {
+				if err := fn(token_hash); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// scimtokenDescLastUsedIP is the schema descriptor for last_used_ip field.
+	scimtokenDescLastUsedIP := scimtokenFields[5].Descriptor()
+	// scimtoken.LastUsedIPValidator is a validator for the "last_used_ip" field. It is called by the builders before save.
+	scimtoken.LastUsedIPValidator = scimtokenDescLastUsedIP.Validators[0].(func(string) error)
+	// scimtokenDescID is the schema descriptor for id field.
+	scimtokenDescID := scimtokenMixinFields0[0].Descriptor()
+	// scimtoken.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	scimtoken.IDValidator = scimtokenDescID.Validators[0].(func(uint32) error)
 	taskMixin := schema.Task{}.Mixin()
 	task.Policy = privacy.NewPolicies(taskMixin[4], schema.Task{})
 	task.Hooks[0] = func(next ent.Mutator) ent.Mutator {
@@ -993,6 +1712,15 @@ func init() {
 	// task.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	task.IDValidator = taskDescID.Validators[0].(func(uint32) error)
 	tenantMixin := schema.Tenant{}.Mixin()
+	tenant.Policy = privacy.NewPolicies(schema.Tenant{})
+	tenant.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := tenant.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
 	tenantMixinFields0 := tenantMixin[0].Fields()
 	_ = tenantMixinFields0
 	tenantFields := schema.Tenant{}.Fields()
@@ -1151,6 +1879,28 @@ func init() {
 	usermfafactorDescDisplayName := usermfafactorFields[3].Descriptor()
 	// usermfafactor.DisplayNameValidator is a validator for the "display_name" field. It is called by the builders before save.
 	usermfafactor.DisplayNameValidator = usermfafactorDescDisplayName.Validators[0].(func(string) error)
+	// usermfafactorDescCredentialID is the schema descriptor for credential_id field.
+	usermfafactorDescCredentialID := usermfafactorFields[6].Descriptor()
+	// usermfafactor.DefaultCredentialID holds the default value on creation for the credential_id field.
+	usermfafactor.DefaultCredentialID = usermfafactorDescCredentialID.Default.(string)
+	// usermfafactor.CredentialIDValidator is a validator for the "credential_id" field. It is called by the builders before save.
+	usermfafactor.CredentialIDValidator = usermfafactorDescCredentialID.Validators[0].(func(string) error)
+	// usermfafactorDescSignCount is the schema descriptor for sign_count field.
+	usermfafactorDescSignCount := usermfafactorFields[8].Descriptor()
+	// usermfafactor.DefaultSignCount holds the default value on creation for the sign_count field.
+	usermfafactor.DefaultSignCount = usermfafactorDescSignCount.Default.(uint32)
+	// usermfafactorDescCredentialFlags is the schema descriptor for credential_flags field.
+	usermfafactorDescCredentialFlags := usermfafactorFields[11].Descriptor()
+	// usermfafactor.DefaultCredentialFlags holds the default value on creation for the credential_flags field.
+	usermfafactor.DefaultCredentialFlags = usermfafactorDescCredentialFlags.Default.(uint8)
+	// usermfafactorDescAttestationType is the schema descriptor for attestation_type field.
+	usermfafactorDescAttestationType := usermfafactorFields[12].Descriptor()
+	// usermfafactor.AttestationTypeValidator is a validator for the "attestation_type" field. It is called by the builders before save.
+	usermfafactor.AttestationTypeValidator = usermfafactorDescAttestationType.Validators[0].(func(string) error)
+	// usermfafactorDescAttestationFormat is the schema descriptor for attestation_format field.
+	usermfafactorDescAttestationFormat := usermfafactorFields[13].Descriptor()
+	// usermfafactor.AttestationFormatValidator is a validator for the "attestation_format" field. It is called by the builders before save.
+	usermfafactor.AttestationFormatValidator = usermfafactorDescAttestationFormat.Validators[0].(func(string) error)
 	// usermfafactorDescID is the schema descriptor for id field.
 	usermfafactorDescID := usermfafactorMixinFields1[0].Descriptor()
 	// usermfafactor.IDValidator is a validator for the "id" field. It is called by the builders before save.
@@ -0,0 +1,365 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"go-wind-admin/app/admin/service/internal/data/ent/samlconfig"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// SAML 单点登录配置表
+type SamlConfig struct {
+	config `json:"-"`
+	// ID of the ent.
+	// id
+	ID uint32 `json:"id,omitempty"`
+	// 创建时间
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	// 更新时间
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+	// 删除时间
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// 创建者ID
+	CreatedBy *uint32 `json:"created_by,omitempty"`
+	// 更新者ID
+	UpdatedBy *uint32 `json:"updated_by,omitempty"`
+	// 删除者ID
+	DeletedBy *uint32 `json:"deleted_by,omitempty"`
+	// 租户ID
+	TenantID *uint32 `json:"tenant_id,omitempty"`
+	// 状态
+	Status *samlconfig.Status `json:"status,omitempty"`
+	// 登录页显示名称
+	DisplayName *string `json:"display_name,omitempty"`
+	// IdP 实体ID（断言 Issuer）
+	IdpEntityID *string `json:"idp_entity_id,omitempty"`
+	// IdP 单点登录地址（HTTP-Redirect 绑定）
+	IdpSSOURL *string `json:"idp_sso_url,omitempty"`
+	// IdP 签名证书（PEM）
+	IdpCertificate *string `json:"idp_certificate,omitempty"`
+	// 请求的 NameID 格式，为空由 IdP 决定
+	NameIDFormat *string `json:"name_id_format,omitempty"`
+	// 断言属性映射
+	AttributeMapping map[string]string `json:"attribute_mapping,omitempty"`
+	// 未绑定的企业账号首次登录时是否自动创建用户
+	JitProvisioning *bool `json:"jit_provisioning,omitempty"`
+	// 自动创建用户时授予的角色ID列表
+	DefaultRoleIds []uint32 `json:"default_role_ids,omitempty"`
+	// 自动创建用户时归属的组织单元ID
+	DefaultOrgUnitID *uint32 `json:"default_org_unit_id,omitempty"`
+	// 是否仅允许单点登录（禁用本地密码登录）
+	SSOOnly *bool `json:"sso_only,omitempty"`
+	// 断言校验通过后携带一次性票据跳转的前端地址
+	LoginRedirectURL *string `json:"login_redirect_url,omitempty"`
+	selectValues     sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SamlConfig) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case samlconfig.FieldAttributeMapping, samlconfig.FieldDefaultRoleIds:
+			values[i] = new([]byte)
+		case samlconfig.FieldJitProvisioning, samlconfig.FieldSSOOnly:
+			values[i] = new(sql.NullBool)
+		case samlconfig.FieldID, samlconfig.FieldCreatedBy, samlconfig.FieldUpdatedBy, samlconfig.FieldDeletedBy, samlconfig.FieldTenantID, samlconfig.FieldDefaultOrgUnitID:
+			values[i] = new(sql.NullInt64)
+		case samlconfig.FieldStatus, samlconfig.FieldDisplayName, samlconfig.FieldIdpEntityID, samlconfig.FieldIdpSSOURL, samlconfig.FieldIdpCertificate, samlconfig.FieldNameIDFormat, samlconfig.FieldLoginRedirectURL:
+			values[i] = new(sql.NullString)
+		case samlconfig.FieldCreatedAt, samlconfig.FieldUpdatedAt, samlconfig.FieldDeletedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SamlConfig fields.
+func (_m *SamlConfig) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case samlconfig.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = uint32(value.Int64)
+		case samlconfig.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = new(time.Time)
+				*_m.CreatedAt = value.Time
+			}
+		case samlconfig.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = new(time.Time)
+				*_m.UpdatedAt = value.Time
+			}
+		case samlconfig.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
+		case samlconfig.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				_m.CreatedBy = new(uint32)
+				*_m.CreatedBy = uint32(value.Int64)
+			}
+		case samlconfig.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				_m.UpdatedBy = new(uint32)
+				*_m.UpdatedBy = uint32(value.Int64)
+			}
+		case samlconfig.FieldDeletedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_by", values[i])
+			} else if value.Valid {
+				_m.DeletedBy = new(uint32)
+				*_m.DeletedBy = uint32(value.Int64)
+			}
+		case samlconfig.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = new(uint32)
+				*_m.TenantID = uint32(value.Int64)
+			}
+		case samlconfig.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = new(samlconfig.Status)
+				*_m.Status = samlconfig.Status(value.String)
+			}
+		case samlconfig.FieldDisplayName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field display_name", values[i])
+			} else if value.Valid {
+				_m.DisplayName = new(string)
+				*_m.DisplayName = value.String
+			}
+		case samlconfig.FieldIdpEntityID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field idp_entity_id", values[i])
+			} else if value.Valid {
+				_m.IdpEntityID = new(string)
+				*_m.IdpEntityID = value.String
+			}
+		case samlconfig.FieldIdpSSOURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field idp_sso_url", values[i])
+			} else if value.Valid {
+				_m.IdpSSOURL = new(string)
+				*_m.IdpSSOURL = value.String
+			}
+		case samlconfig.FieldIdpCertificate:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field idp_certificate", values[i])
+			} else if value.Valid {
+				_m.IdpCertificate = new(string)
+				*_m.IdpCertificate = value.String
+			}
+		case samlconfig.FieldNameIDFormat:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name_id_format", values[i])
+			} else if value.Valid {
+				_m.NameIDFormat = new(string)
+				*_m.NameIDFormat = value.String
+			}
+		case samlconfig.FieldAttributeMapping:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field attribute_mapping", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.AttributeMapping); err != nil {
+					return fmt.Errorf("unmarshal field attribute_mapping: %w", err)
+				}
+			}
+		case samlconfig.FieldJitProvisioning:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field jit_provisioning", values[i])
+			} else if value.Valid {
+				_m.JitProvisioning = new(bool)
+				*_m.JitProvisioning = value.Bool
+			}
+		case samlconfig.FieldDefaultRoleIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field default_role_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.DefaultRoleIds); err != nil {
+					return fmt.Errorf("unmarshal field default_role_ids: %w", err)
+				}
+			}
+		case samlconfig.FieldDefaultOrgUnitID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field default_org_unit_id", values[i])
+			} else if value.Valid {
+				_m.DefaultOrgUnitID = new(uint32)
+				*_m.DefaultOrgUnitID = uint32(value.Int64)
+			}
+		case samlconfig.FieldSSOOnly:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field sso_only", values[i])
+			} else if value.Valid {
+				_m.SSOOnly = new(bool)
+				*_m.SSOOnly = value.Bool
+			}
+		case samlconfig.FieldLoginRedirectURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field login_redirect_url", values[i])
+			} else if value.Valid {
+				_m.LoginRedirectURL = new(string)
+				*_m.LoginRedirectURL = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SamlConfig.
+// This includes values selected through modifiers, order, etc.
+func (_m *SamlConfig) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this SamlConfig.
+// Note that you need to call SamlConfig.Unwrap() before calling this method if this SamlConfig
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *SamlConfig) Update() *SamlConfigUpdateOne {
+	return NewSamlConfigClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the SamlConfig entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *SamlConfig) Unwrap() *SamlConfig {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SamlConfig is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *SamlConfig) String() string {
+	var builder strings.Builder
+	builder.WriteString("SamlConfig(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	if v := _m.CreatedAt; v != nil {
+		builder.WriteString("created_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.UpdatedAt; v != nil {
+		builder.WriteString("updated_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.CreatedBy; v != nil {
+		builder.WriteString("created_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.UpdatedBy; v != nil {
+		builder.WriteString("updated_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.DeletedBy; v != nil {
+		builder.WriteString("deleted_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.TenantID; v != nil {
+		builder.WriteString("tenant_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.Status; v != nil {
+		builder.WriteString("status=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.DisplayName; v != nil {
+		builder.WriteString("display_name=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.IdpEntityID; v != nil {
+		builder.WriteString("idp_entity_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.IdpSSOURL; v != nil {
+		builder.WriteString("idp_sso_url=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.IdpCertificate; v != nil {
+		builder.WriteString("idp_certificate=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.NameIDFormat; v != nil {
+		builder.WriteString("name_id_format=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("attribute_mapping=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AttributeMapping))
+	builder.WriteString(", ")
+	if v := _m.JitProvisioning; v != nil {
+		builder.WriteString("jit_provisioning=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("default_role_ids=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DefaultRoleIds))
+	builder.WriteString(", ")
+	if v := _m.DefaultOrgUnitID; v != nil {
+		builder.WriteString("default_org_unit_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.SSOOnly; v != nil {
+		builder.WriteString("sso_only=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.LoginRedirectURL; v != nil {
+		builder.WriteString("login_redirect_url=")
+		builder.WriteString(*v)
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SamlConfigs is a parsable slice of SamlConfig.
+type SamlConfigs []*SamlConfig
@@ -0,0 +1,238 @@
+// Code generated by ent, DO NOT EDIT.
+
+package samlconfig
+
+import (
+	"fmt"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the samlconfig type in the database.
+	Label = "saml_config"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldCreatedBy holds the string denoting the created_by field in the database.
+	FieldCreatedBy = "created_by"
+	// FieldUpdatedBy holds the string denoting the updated_by field in the database.
+	FieldUpdatedBy = "updated_by"
+	// FieldDeletedBy holds the string denoting the deleted_by field in the database.
+	FieldDeletedBy = "deleted_by"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldDisplayName holds the string denoting the display_name field in the database.
+	FieldDisplayName = "display_name"
+	// FieldIdpEntityID holds the string denoting the idp_entity_id field in the database.
+	FieldIdpEntityID = "idp_entity_id"
+	// FieldIdpSSOURL holds the string denoting the idp_sso_url field in the database.
+	FieldIdpSSOURL = "idp_sso_url"
+	// FieldIdpCertificate holds the string denoting the idp_certificate field in the database.
+	FieldIdpCertificate = "idp_certificate"
+	// FieldNameIDFormat holds the string denoting the name_id_format field in the database.
+	FieldNameIDFormat = "name_id_format"
+	// FieldAttributeMapping holds the string denoting the attribute_mapping field in the database.
+	FieldAttributeMapping = "attribute_mapping"
+	// FieldJitProvisioning holds the string denoting the jit_provisioning field in the database.
+	FieldJitProvisioning = "jit_provisioning"
+	// FieldDefaultRoleIds holds the string denoting the default_role_ids field in the database.
+	FieldDefaultRoleIds = "default_role_ids"
+	// FieldDefaultOrgUnitID holds the string denoting the default_org_unit_id field in the database.
+	FieldDefaultOrgUnitID = "default_org_unit_id"
+	// FieldSSOOnly holds the string denoting the sso_only field in the database.
+	FieldSSOOnly = "sso_only"
+	// FieldLoginRedirectURL holds the string denoting the login_redirect_url field in the database.
+	FieldLoginRedirectURL = "login_redirect_url"
+	// Table holds the table name of the samlconfig in the database.
+	Table = "sys_saml_configs"
+)
+
+// Columns holds all SQL columns for samlconfig fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldDeletedAt,
+	FieldCreatedBy,
+	FieldUpdatedBy,
+	FieldDeletedBy,
+	FieldTenantID,
+	FieldStatus,
+	FieldDisplayName,
+	FieldIdpEntityID,
+	FieldIdpSSOURL,
+	FieldIdpCertificate,
+	FieldNameIDFormat,
+	FieldAttributeMapping,
+	FieldJitProvisioning,
+	FieldDefaultRoleIds,
+	FieldDefaultOrgUnitID,
+	FieldSSOOnly,
+	FieldLoginRedirectURL,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "go-wind-admin/app/admin/service/internal/data/ent/runtime"
+var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
+	// DefaultTenantID holds the default value on creation for the "tenant_id" field.
+	DefaultTenantID uint32
+	// DisplayNameValidator is a validator for the "display_name" field. It is called by the builders before save.
+	DisplayNameValidator func(string) error
+	// IdpEntityIDValidator is a validator for the "idp_entity_id" field. It is called by the builders before save.
+	IdpEntityIDValidator func(string) error
+	// IdpSSOURLValidator is a validator for the "idp_sso_url" field. It is called by the builders before save.
+	IdpSSOURLValidator func(string) error
+	// NameIDFormatValidator is a validator for the "name_id_format" field. It is called by the builders before save.
+	NameIDFormatValidator func(string) error
+	// DefaultJitProvisioning holds the default value on creation for the "jit_provisioning" field.
+	DefaultJitProvisioning bool
+	// DefaultSSOOnly holds the default value on creation for the "sso_only" field.
+	DefaultSSOOnly bool
+	// LoginRedirectURLValidator is a validator for the "login_redirect_url" field. It is called by the builders before save.
+	LoginRedirectURLValidator func(string) error
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(uint32) error
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusOn is the default value of the Status enum.
+const DefaultStatus = StatusOn
+
+// Status values.
+const (
+	StatusOff Status = "OFF"
+	StatusOn  Status = "ON"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusOff, StatusOn:
+		return nil
+	default:
+		return fmt.Errorf("samlconfig: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the SamlConfig queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByCreatedBy orders the results by the created_by field.
+func ByCreatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedBy, opts...).ToFunc()
+}
+
+// ByUpdatedBy orders the results by the updated_by field.
+func ByUpdatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedBy, opts...).ToFunc()
+}
+
+// ByDeletedBy orders the results by the deleted_by field.
+func ByDeletedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedBy, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByDisplayName orders the results by the display_name field.
+func ByDisplayName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDisplayName, opts...).ToFunc()
+}
+
+// ByIdpEntityID orders the results by the idp_entity_id field.
+func ByIdpEntityID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIdpEntityID, opts...).ToFunc()
+}
+
+// ByIdpSSOURL orders the results by the idp_sso_url field.
+func ByIdpSSOURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIdpSSOURL, opts...).ToFunc()
+}
+
+// ByIdpCertificate orders the results by the idp_certificate field.
+func ByIdpCertificate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIdpCertificate, opts...).ToFunc()
+}
+
+// ByNameIDFormat orders the results by the name_id_format field.
+func ByNameIDFormat(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNameIDFormat, opts...).ToFunc()
+}
+
+// ByJitProvisioning orders the results by the jit_provisioning field.
+func ByJitProvisioning(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldJitProvisioning, opts...).ToFunc()
+}
+
+// ByDefaultOrgUnitID orders the results by the default_org_unit_id field.
+func ByDefaultOrgUnitID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDefaultOrgUnitID, opts...).ToFunc()
+}
+
+// BySSOOnly orders the results by the sso_only field.
+func BySSOOnly(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSSOOnly, opts...).ToFunc()
+}
+
+// ByLoginRedirectURL orders the results by the login_redirect_url field.
+func ByLoginRedirectURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLoginRedirectURL, opts...).ToFunc()
+}
@@ -0,0 +1,1080 @@
+// Code generated by ent, DO NOT EDIT.
+
+package samlconfig
+
+import (
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint32) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint32) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint32) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint32) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint32) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint32) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint32) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint32) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint32) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v uint32) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// UpdatedBy applies equality check predicate on the "updated_by" field. It's identical to UpdatedByEQ.
+func UpdatedBy(v uint32) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// DeletedBy applies equality check predicate on the "deleted_by" field. It's identical to DeletedByEQ.
+func DeletedBy(v uint32) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldEQ(FieldDeletedBy, v))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uint32) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldEQ(FieldTenantID, v))
+}
+
+// DisplayName applies equality check predicate on the "display_name" field. It's identical to DisplayNameEQ.
+func DisplayName(v string) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldEQ(FieldDisplayName, v))
+}
+
+// IdpEntityID applies equality check predicate on the "idp_entity_id" field. It's identical to IdpEntityIDEQ.
+func IdpEntityID(v string) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldEQ(FieldIdpEntityID, v))
+}
+
+// IdpSSOURL applies equality check predicate on the "idp_sso_url" field. It's identical to IdpSSOURLEQ.
+func IdpSSOURL(v string) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldEQ(FieldIdpSSOURL, v))
+}
+
+// IdpCertificate applies equality check predicate on the "idp_certificate" field. It's identical to IdpCertificateEQ.
+func IdpCertificate(v string) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldEQ(FieldIdpCertificate, v))
+}
+
+// NameIDFormat applies equality check predicate on the "name_id_format" field. It's identical to NameIDFormatEQ.
+func NameIDFormat(v string) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldEQ(FieldNameIDFormat, v))
+}
+
+// JitProvisioning applies equality check predicate on the "jit_provisioning" field. It's identical to JitProvisioningEQ.
+func JitProvisioning(v bool) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldEQ(FieldJitProvisioning, v))
+}
+
+// DefaultOrgUnitID applies equality check predicate on the "default_org_unit_id" field. It's identical to DefaultOrgUnitIDEQ.
+func DefaultOrgUnitID(v uint32) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldEQ(FieldDefaultOrgUnitID, v))
+}
+
+// SSOOnly applies equality check predicate on the "sso_only" field. It's identical to SSOOnlyEQ.
+func SSOOnly(v bool) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldEQ(FieldSSOOnly, v))
+}
+
+// LoginRedirectURL applies equality check predicate on the "login_redirect_url" field. It's identical to LoginRedirectURLEQ.
+func LoginRedirectURL(v string) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldEQ(FieldLoginRedirectURL, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// CreatedAtIsNil applies the IsNil predicate on the "created_at" field.
+func CreatedAtIsNil() predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldIsNull(FieldCreatedAt))
+}
+
+// CreatedAtNotNil applies the NotNil predicate on the "created_at" field.
+func CreatedAtNotNil() predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldNotNull(FieldCreatedAt))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIsNil applies the IsNil predicate on the "updated_at" field.
+func UpdatedAtIsNil() predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldIsNull(FieldUpdatedAt))
+}
+
+// UpdatedAtNotNil applies the NotNil predicate on the "updated_at" field.
+func UpdatedAtNotNil() predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldNotNull(FieldUpdatedAt))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v uint32) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v uint32) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...uint32) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...uint32) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v uint32) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v uint32) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v uint32) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v uint32) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByIsNil applies the IsNil predicate on the "created_by" field.
+func CreatedByIsNil() predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldIsNull(FieldCreatedBy))
+}
+
+// CreatedByNotNil applies the NotNil predicate on the "created_by" field.
+func CreatedByNotNil() predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldNotNull(FieldCreatedBy))
+}
+
+// UpdatedByEQ applies the EQ predicate on the "updated_by" field.
+func UpdatedByEQ(v uint32) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByNEQ applies the NEQ predicate on the "updated_by" field.
+func UpdatedByNEQ(v uint32) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldNEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByIn applies the In predicate on the "updated_by" field.
+func UpdatedByIn(vs ...uint32) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByNotIn applies the NotIn predicate on the "updated_by" field.
+func UpdatedByNotIn(vs ...uint32) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldNotIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByGT applies the GT predicate on the "updated_by" field.
+func UpdatedByGT(v uint32) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldGT(FieldUpdatedBy, v))
+}
+
+// UpdatedByGTE applies the GTE predicate on the "updated_by" field.
+func UpdatedByGTE(v uint32) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldGTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByLT applies the LT predicate on the "updated_by" field.
+func UpdatedByLT(v uint32) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldLT(FieldUpdatedBy, v))
+}
+
+// UpdatedByLTE applies the LTE predicate on the "updated_by" field.
+func UpdatedByLTE(v uint32) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldLTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByIsNil applies the IsNil predicate on the "updated_by" field.
+func UpdatedByIsNil() predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldIsNull(FieldUpdatedBy))
+}
+
+// UpdatedByNotNil applies the NotNil predicate on the "updated_by" field.
+func UpdatedByNotNil() predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldNotNull(FieldUpdatedBy))
+}
+
+// DeletedByEQ applies the EQ predicate on the "deleted_by" field.
+func DeletedByEQ(v uint32) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldEQ(FieldDeletedBy, v))
+}
+
+// DeletedByNEQ applies the NEQ predicate on the "deleted_by" field.
+func DeletedByNEQ(v uint32) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldNEQ(FieldDeletedBy, v))
+}
+
+// DeletedByIn applies the In predicate on the "deleted_by" field.
+func DeletedByIn(vs ...uint32) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldIn(FieldDeletedBy, vs...))
+}
+
+// DeletedByNotIn applies the NotIn predicate on the "deleted_by" field.
+func DeletedByNotIn(vs ...uint32) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldNotIn(FieldDeletedBy, vs...))
+}
+
+// DeletedByGT applies the GT predicate on the "deleted_by" field.
+func DeletedByGT(v uint32) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldGT(FieldDeletedBy, v))
+}
+
+// DeletedByGTE applies the GTE predicate on the "deleted_by" field.
+func DeletedByGTE(v uint32) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldGTE(FieldDeletedBy, v))
+}
+
+// DeletedByLT applies the LT predicate on the "deleted_by" field.
+func DeletedByLT(v uint32) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldLT(FieldDeletedBy, v))
+}
+
+// DeletedByLTE applies the LTE predicate on the "deleted_by" field.
+func DeletedByLTE(v uint32) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldLTE(FieldDeletedBy, v))
+}
+
+// DeletedByIsNil applies the IsNil predicate on the "deleted_by" field.
+func DeletedByIsNil() predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldIsNull(FieldDeletedBy))
+}
+
+// DeletedByNotNil applies the NotNil predicate on the "deleted_by" field.
+func DeletedByNotNil() predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldNotNull(FieldDeletedBy))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uint32) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uint32) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uint32) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uint32) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uint32) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uint32) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uint32) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uint32) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDIsNil applies the IsNil predicate on the "tenant_id" field.
+func TenantIDIsNil() predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldIsNull(FieldTenantID))
+}
+
+// TenantIDNotNil applies the NotNil predicate on the "tenant_id" field.
+func TenantIDNotNil() predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldNotNull(FieldTenantID))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// DisplayNameEQ applies the EQ predicate on the "display_name" field.
+func DisplayNameEQ(v string) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldEQ(FieldDisplayName, v))
+}
+
+// DisplayNameNEQ applies the NEQ predicate on the "display_name" field.
+func DisplayNameNEQ(v string) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldNEQ(FieldDisplayName, v))
+}
+
+// DisplayNameIn applies the In predicate on the "display_name" field.
+func DisplayNameIn(vs ...string) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldIn(FieldDisplayName, vs...))
+}
+
+// DisplayNameNotIn applies the NotIn predicate on the "display_name" field.
+func DisplayNameNotIn(vs ...string) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldNotIn(FieldDisplayName, vs...))
+}
+
+// DisplayNameGT applies the GT predicate on the "display_name" field.
+func DisplayNameGT(v string) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldGT(FieldDisplayName, v))
+}
+
+// DisplayNameGTE applies the GTE predicate on the "display_name" field.
+func DisplayNameGTE(v string) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldGTE(FieldDisplayName, v))
+}
+
+// DisplayNameLT applies the LT predicate on the "display_name" field.
+func DisplayNameLT(v string) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldLT(FieldDisplayName, v))
+}
+
+// DisplayNameLTE applies the LTE predicate on the "display_name" field.
+func DisplayNameLTE(v string) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldLTE(FieldDisplayName, v))
+}
+
+// DisplayNameContains applies the Contains predicate on the "display_name" field.
+func DisplayNameContains(v string) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldContains(FieldDisplayName, v))
+}
+
+// DisplayNameHasPrefix applies the HasPrefix predicate on the "display_name" field.
+func DisplayNameHasPrefix(v string) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldHasPrefix(FieldDisplayName, v))
+}
+
+// DisplayNameHasSuffix applies the HasSuffix predicate on the "display_name" field.
+func DisplayNameHasSuffix(v string) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldHasSuffix(FieldDisplayName, v))
+}
+
+// DisplayNameIsNil applies the IsNil predicate on the "display_name" field.
+func DisplayNameIsNil() predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldIsNull(FieldDisplayName))
+}
+
+// DisplayNameNotNil applies the NotNil predicate on the "display_name" field.
+func DisplayNameNotNil() predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldNotNull(FieldDisplayName))
+}
+
+// DisplayNameEqualFold applies the EqualFold predicate on the "display_name" field.
+func DisplayNameEqualFold(v string) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldEqualFold(FieldDisplayName, v))
+}
+
+// DisplayNameContainsFold applies the ContainsFold predicate on the "display_name" field.
+func DisplayNameContainsFold(v string) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldContainsFold(FieldDisplayName, v))
+}
+
+// IdpEntityIDEQ applies the EQ predicate on the "idp_entity_id" field.
+func IdpEntityIDEQ(v string) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldEQ(FieldIdpEntityID, v))
+}
+
+// IdpEntityIDNEQ applies the NEQ predicate on the "idp_entity_id" field.
+func IdpEntityIDNEQ(v string) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldNEQ(FieldIdpEntityID, v))
+}
+
+// IdpEntityIDIn applies the In predicate on the "idp_entity_id" field.
+func IdpEntityIDIn(vs ...string) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldIn(FieldIdpEntityID, vs...))
+}
+
+// IdpEntityIDNotIn applies the NotIn predicate on the "idp_entity_id" field.
+func IdpEntityIDNotIn(vs ...string) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldNotIn(FieldIdpEntityID, vs...))
+}
+
+// IdpEntityIDGT applies the GT predicate on the "idp_entity_id" field.
+func IdpEntityIDGT(v string) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldGT(FieldIdpEntityID, v))
+}
+
+// IdpEntityIDGTE applies the GTE predicate on the "idp_entity_id" field.
+func IdpEntityIDGTE(v string) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldGTE(FieldIdpEntityID, v))
+}
+
+// IdpEntityIDLT applies the LT predicate on the "idp_entity_id" field.
+func IdpEntityIDLT(v string) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldLT(FieldIdpEntityID, v))
+}
+
+// IdpEntityIDLTE applies the LTE predicate on the "idp_entity_id" field.
+func IdpEntityIDLTE(v string) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldLTE(FieldIdpEntityID, v))
+}
+
+// IdpEntityIDContains applies the Contains predicate on the "idp_entity_id" field.
+func IdpEntityIDContains(v string) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldContains(FieldIdpEntityID, v))
+}
+
+// IdpEntityIDHasPrefix applies the HasPrefix predicate on the "idp_entity_id" field.
+func IdpEntityIDHasPrefix(v string) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldHasPrefix(FieldIdpEntityID, v))
+}
+
+// IdpEntityIDHasSuffix applies the HasSuffix predicate on the "idp_entity_id" field.
+func IdpEntityIDHasSuffix(v string) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldHasSuffix(FieldIdpEntityID, v))
+}
+
+// IdpEntityIDIsNil applies the IsNil predicate on the "idp_entity_id" field.
+func IdpEntityIDIsNil() predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldIsNull(FieldIdpEntityID))
+}
+
+// IdpEntityIDNotNil applies the NotNil predicate on the "idp_entity_id" field.
+func IdpEntityIDNotNil() predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldNotNull(FieldIdpEntityID))
+}
+
+// IdpEntityIDEqualFold applies the EqualFold predicate on the "idp_entity_id" field.
+func IdpEntityIDEqualFold(v string) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldEqualFold(FieldIdpEntityID, v))
+}
+
+// IdpEntityIDContainsFold applies the ContainsFold predicate on the "idp_entity_id" field.
+func IdpEntityIDContainsFold(v string) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldContainsFold(FieldIdpEntityID, v))
+}
+
+// IdpSSOURLEQ applies the EQ predicate on the "idp_sso_url" field.
+func IdpSSOURLEQ(v string) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldEQ(FieldIdpSSOURL, v))
+}
+
+// IdpSSOURLNEQ applies the NEQ predicate on the "idp_sso_url" field.
+func IdpSSOURLNEQ(v string) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldNEQ(FieldIdpSSOURL, v))
+}
+
+// IdpSSOURLIn applies the In predicate on the "idp_sso_url" field.
+func IdpSSOURLIn(vs ...string) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldIn(FieldIdpSSOURL, vs...))
+}
+
+// IdpSSOURLNotIn applies the NotIn predicate on the "idp_sso_url" field.
+func IdpSSOURLNotIn(vs ...string) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldNotIn(FieldIdpSSOURL, vs...))
+}
+
+// IdpSSOURLGT applies the GT predicate on the "idp_sso_url" field.
+func IdpSSOURLGT(v string) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldGT(FieldIdpSSOURL, v))
+}
+
+// IdpSSOURLGTE applies the GTE predicate on the "idp_sso_url" field.
+func IdpSSOURLGTE(v string) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldGTE(FieldIdpSSOURL, v))
+}
+
+// IdpSSOURLLT applies the LT predicate on the "idp_sso_url" field.
+func IdpSSOURLLT(v string) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldLT(FieldIdpSSOURL, v))
+}
+
+// IdpSSOURLLTE applies the LTE predicate on the "idp_sso_url" field.
+func IdpSSOURLLTE(v string) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldLTE(FieldIdpSSOURL, v))
+}
+
+// IdpSSOURLContains applies the Contains predicate on the "idp_sso_url" field.
+func IdpSSOURLContains(v string) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldContains(FieldIdpSSOURL, v))
+}
+
+// IdpSSOURLHasPrefix applies the HasPrefix predicate on the "idp_sso_url" field.
+func IdpSSOURLHasPrefix(v string) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldHasPrefix(FieldIdpSSOURL, v))
+}
+
+// IdpSSOURLHasSuffix applies the HasSuffix predicate on the "idp_sso_url" field.
+func IdpSSOURLHasSuffix(v string) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldHasSuffix(FieldIdpSSOURL, v))
+}
+
+// IdpSSOURLIsNil applies the IsNil predicate on the "idp_sso_url" field.
+func IdpSSOURLIsNil() predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldIsNull(FieldIdpSSOURL))
+}
+
+// IdpSSOURLNotNil applies the NotNil predicate on the "idp_sso_url" field.
+func IdpSSOURLNotNil() predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldNotNull(FieldIdpSSOURL))
+}
+
+// IdpSSOURLEqualFold applies the EqualFold predicate on the "idp_sso_url" field.
+func IdpSSOURLEqualFold(v string) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldEqualFold(FieldIdpSSOURL, v))
+}
+
+// IdpSSOURLContainsFold applies the ContainsFold predicate on the "idp_sso_url" field.
+func IdpSSOURLContainsFold(v string) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldContainsFold(FieldIdpSSOURL, v))
+}
+
+// IdpCertificateEQ applies the EQ predicate on the "idp_certificate" field.
+func IdpCertificateEQ(v string) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldEQ(FieldIdpCertificate, v))
+}
+
+// IdpCertificateNEQ applies the NEQ predicate on the "idp_certificate" field.
+func IdpCertificateNEQ(v string) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldNEQ(FieldIdpCertificate, v))
+}
+
+// IdpCertificateIn applies the In predicate on the "idp_certificate" field.
+func IdpCertificateIn(vs ...string) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldIn(FieldIdpCertificate, vs...))
+}
+
+// IdpCertificateNotIn applies the NotIn predicate on the "idp_certificate" field.
+func IdpCertificateNotIn(vs ...string) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldNotIn(FieldIdpCertificate, vs...))
+}
+
+// IdpCertificateGT applies the GT predicate on the "idp_certificate" field.
+func IdpCertificateGT(v string) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldGT(FieldIdpCertificate, v))
+}
+
+// IdpCertificateGTE applies the GTE predicate on the "idp_certificate" field.
+func IdpCertificateGTE(v string) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldGTE(FieldIdpCertificate, v))
+}
+
+// IdpCertificateLT applies the LT predicate on the "idp_certificate" field.
+func IdpCertificateLT(v string) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldLT(FieldIdpCertificate, v))
+}
+
+// IdpCertificateLTE applies the LTE predicate on the "idp_certificate" field.
+func IdpCertificateLTE(v string) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldLTE(FieldIdpCertificate, v))
+}
+
+// IdpCertificateContains applies the Contains predicate on the "idp_certificate" field.
+func IdpCertificateContains(v string) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldContains(FieldIdpCertificate, v))
+}
+
+// IdpCertificateHasPrefix applies the HasPrefix predicate on the "idp_certificate" field.
+func IdpCertificateHasPrefix(v string) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldHasPrefix(FieldIdpCertificate, v))
+}
+
+// IdpCertificateHasSuffix applies the HasSuffix predicate on the "idp_certificate" field.
+func IdpCertificateHasSuffix(v string) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldHasSuffix(FieldIdpCertificate, v))
+}
+
+// IdpCertificateIsNil applies the IsNil predicate on the "idp_certificate" field.
+func IdpCertificateIsNil() predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldIsNull(FieldIdpCertificate))
+}
+
+// IdpCertificateNotNil applies the NotNil predicate on the "idp_certificate" field.
+func IdpCertificateNotNil() predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldNotNull(FieldIdpCertificate))
+}
+
+// IdpCertificateEqualFold applies the EqualFold predicate on the "idp_certificate" field.
+func IdpCertificateEqualFold(v string) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldEqualFold(FieldIdpCertificate, v))
+}
+
+// IdpCertificateContainsFold applies the ContainsFold predicate on the "idp_certificate" field.
+func IdpCertificateContainsFold(v string) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldContainsFold(FieldIdpCertificate, v))
+}
+
+// NameIDFormatEQ applies the EQ predicate on the "name_id_format" field.
+func NameIDFormatEQ(v string) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldEQ(FieldNameIDFormat, v))
+}
+
+// NameIDFormatNEQ applies the NEQ predicate on the "name_id_format" field.
+func NameIDFormatNEQ(v string) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldNEQ(FieldNameIDFormat, v))
+}
+
+// NameIDFormatIn applies the In predicate on the "name_id_format" field.
+func NameIDFormatIn(vs ...string) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldIn(FieldNameIDFormat, vs...))
+}
+
+// NameIDFormatNotIn applies the NotIn predicate on the "name_id_format" field.
+func NameIDFormatNotIn(vs ...string) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldNotIn(FieldNameIDFormat, vs...))
+}
+
+// NameIDFormatGT applies the GT predicate on the "name_id_format" field.
+func NameIDFormatGT(v string) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldGT(FieldNameIDFormat, v))
+}
+
+// NameIDFormatGTE applies the GTE predicate on the "name_id_format" field.
+func NameIDFormatGTE(v string) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldGTE(FieldNameIDFormat, v))
+}
+
+// NameIDFormatLT applies the LT predicate on the "name_id_format" field.
+func NameIDFormatLT(v string) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldLT(FieldNameIDFormat, v))
+}
+
+// NameIDFormatLTE applies the LTE predicate on the "name_id_format" field.
+func NameIDFormatLTE(v string) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldLTE(FieldNameIDFormat, v))
+}
+
+// NameIDFormatContains applies the Contains predicate on the "name_id_format" field.
+func NameIDFormatContains(v string) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldContains(FieldNameIDFormat, v))
+}
+
+// NameIDFormatHasPrefix applies the HasPrefix predicate on the "name_id_format" field.
+func NameIDFormatHasPrefix(v string) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldHasPrefix(FieldNameIDFormat, v))
+}
+
+// NameIDFormatHasSuffix applies the HasSuffix predicate on the "name_id_format" field.
+func NameIDFormatHasSuffix(v string) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldHasSuffix(FieldNameIDFormat, v))
+}
+
+// NameIDFormatIsNil applies the IsNil predicate on the "name_id_format" field.
+func NameIDFormatIsNil() predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldIsNull(FieldNameIDFormat))
+}
+
+// NameIDFormatNotNil applies the NotNil predicate on the "name_id_format" field.
+func NameIDFormatNotNil() predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldNotNull(FieldNameIDFormat))
+}
+
+// NameIDFormatEqualFold applies the EqualFold predicate on the "name_id_format" field.
+func NameIDFormatEqualFold(v string) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldEqualFold(FieldNameIDFormat, v))
+}
+
+// NameIDFormatContainsFold applies the ContainsFold predicate on the "name_id_format" field.
+func NameIDFormatContainsFold(v string) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldContainsFold(FieldNameIDFormat, v))
+}
+
+// AttributeMappingIsNil applies the IsNil predicate on the "attribute_mapping" field.
+func AttributeMappingIsNil() predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldIsNull(FieldAttributeMapping))
+}
+
+// AttributeMappingNotNil applies the NotNil predicate on the "attribute_mapping" field.
+func AttributeMappingNotNil() predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldNotNull(FieldAttributeMapping))
+}
+
+// JitProvisioningEQ applies the EQ predicate on the "jit_provisioning" field.
+func JitProvisioningEQ(v bool) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldEQ(FieldJitProvisioning, v))
+}
+
+// JitProvisioningNEQ applies the NEQ predicate on the "jit_provisioning" field.
+func JitProvisioningNEQ(v bool) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldNEQ(FieldJitProvisioning, v))
+}
+
+// JitProvisioningIsNil applies the IsNil predicate on the "jit_provisioning" field.
+func JitProvisioningIsNil() predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldIsNull(FieldJitProvisioning))
+}
+
+// JitProvisioningNotNil applies the NotNil predicate on the "jit_provisioning" field.
+func JitProvisioningNotNil() predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldNotNull(FieldJitProvisioning))
+}
+
+// DefaultRoleIdsIsNil applies the IsNil predicate on the "default_role_ids" field.
+func DefaultRoleIdsIsNil() predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldIsNull(FieldDefaultRoleIds))
+}
+
+// DefaultRoleIdsNotNil applies the NotNil predicate on the "default_role_ids" field.
+func DefaultRoleIdsNotNil() predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldNotNull(FieldDefaultRoleIds))
+}
+
+// DefaultOrgUnitIDEQ applies the EQ predicate on the "default_org_unit_id" field.
+func DefaultOrgUnitIDEQ(v uint32) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldEQ(FieldDefaultOrgUnitID, v))
+}
+
+// DefaultOrgUnitIDNEQ applies the NEQ predicate on the "default_org_unit_id" field.
+func DefaultOrgUnitIDNEQ(v uint32) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldNEQ(FieldDefaultOrgUnitID, v))
+}
+
+// DefaultOrgUnitIDIn applies the In predicate on the "default_org_unit_id" field.
+func DefaultOrgUnitIDIn(vs ...uint32) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldIn(FieldDefaultOrgUnitID, vs...))
+}
+
+// DefaultOrgUnitIDNotIn applies the NotIn predicate on the "default_org_unit_id" field.
+func DefaultOrgUnitIDNotIn(vs ...uint32) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldNotIn(FieldDefaultOrgUnitID, vs...))
+}
+
+// DefaultOrgUnitIDGT applies the GT predicate on the "default_org_unit_id" field.
+func DefaultOrgUnitIDGT(v uint32) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldGT(FieldDefaultOrgUnitID, v))
+}
+
+// DefaultOrgUnitIDGTE applies the GTE predicate on the "default_org_unit_id" field.
+func DefaultOrgUnitIDGTE(v uint32) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldGTE(FieldDefaultOrgUnitID, v))
+}
+
+// DefaultOrgUnitIDLT applies the LT predicate on the "default_org_unit_id" field.
+func DefaultOrgUnitIDLT(v uint32) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldLT(FieldDefaultOrgUnitID, v))
+}
+
+// DefaultOrgUnitIDLTE applies the LTE predicate on the "default_org_unit_id" field.
+func DefaultOrgUnitIDLTE(v uint32) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldLTE(FieldDefaultOrgUnitID, v))
+}
+
+// DefaultOrgUnitIDIsNil applies the IsNil predicate on the "default_org_unit_id" field.
+func DefaultOrgUnitIDIsNil() predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldIsNull(FieldDefaultOrgUnitID))
+}
+
+// DefaultOrgUnitIDNotNil applies the NotNil predicate on the "default_org_unit_id" field.
+func DefaultOrgUnitIDNotNil() predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldNotNull(FieldDefaultOrgUnitID))
+}
+
+// SSOOnlyEQ applies the EQ predicate on the "sso_only" field.
+func SSOOnlyEQ(v bool) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldEQ(FieldSSOOnly, v))
+}
+
+// SSOOnlyNEQ applies the NEQ predicate on the "sso_only" field.
+func SSOOnlyNEQ(v bool) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldNEQ(FieldSSOOnly, v))
+}
+
+// SSOOnlyIsNil applies the IsNil predicate on the "sso_only" field.
+func SSOOnlyIsNil() predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldIsNull(FieldSSOOnly))
+}
+
+// SSOOnlyNotNil applies the NotNil predicate on the "sso_only" field.
+func SSOOnlyNotNil() predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldNotNull(FieldSSOOnly))
+}
+
+// LoginRedirectURLEQ applies the EQ predicate on the "login_redirect_url" field.
+func LoginRedirectURLEQ(v string) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldEQ(FieldLoginRedirectURL, v))
+}
+
+// LoginRedirectURLNEQ applies the NEQ predicate on the "login_redirect_url" field.
+func LoginRedirectURLNEQ(v string) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldNEQ(FieldLoginRedirectURL, v))
+}
+
+// LoginRedirectURLIn applies the In predicate on the "login_redirect_url" field.
+func LoginRedirectURLIn(vs ...string) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldIn(FieldLoginRedirectURL, vs...))
+}
+
+// LoginRedirectURLNotIn applies the NotIn predicate on the "login_redirect_url" field.
+func LoginRedirectURLNotIn(vs ...string) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldNotIn(FieldLoginRedirectURL, vs...))
+}
+
+// LoginRedirectURLGT applies the GT predicate on the "login_redirect_url" field.
+func LoginRedirectURLGT(v string) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldGT(FieldLoginRedirectURL, v))
+}
+
+// LoginRedirectURLGTE applies the GTE predicate on the "login_redirect_url" field.
+func LoginRedirectURLGTE(v string) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldGTE(FieldLoginRedirectURL, v))
+}
+
+// LoginRedirectURLLT applies the LT predicate on the "login_redirect_url" field.
+func LoginRedirectURLLT(v string) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldLT(FieldLoginRedirectURL, v))
+}
+
+// LoginRedirectURLLTE applies the LTE predicate on the "login_redirect_url" field.
+func LoginRedirectURLLTE(v string) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldLTE(FieldLoginRedirectURL, v))
+}
+
+// LoginRedirectURLContains applies the Contains predicate on the "login_redirect_url" field.
+func LoginRedirectURLContains(v string) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldContains(FieldLoginRedirectURL, v))
+}
+
+// LoginRedirectURLHasPrefix applies the HasPrefix predicate on the "login_redirect_url" field.
+func LoginRedirectURLHasPrefix(v string) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldHasPrefix(FieldLoginRedirectURL, v))
+}
+
+// LoginRedirectURLHasSuffix applies the HasSuffix predicate on the "login_redirect_url" field.
+func LoginRedirectURLHasSuffix(v string) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldHasSuffix(FieldLoginRedirectURL, v))
+}
+
+// LoginRedirectURLIsNil applies the IsNil predicate on the "login_redirect_url" field.
+func LoginRedirectURLIsNil() predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldIsNull(FieldLoginRedirectURL))
+}
+
+// LoginRedirectURLNotNil applies the NotNil predicate on the "login_redirect_url" field.
+func LoginRedirectURLNotNil() predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldNotNull(FieldLoginRedirectURL))
+}
+
+// LoginRedirectURLEqualFold applies the EqualFold predicate on the "login_redirect_url" field.
+func LoginRedirectURLEqualFold(v string) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldEqualFold(FieldLoginRedirectURL, v))
+}
+
+// LoginRedirectURLContainsFold applies the ContainsFold predicate on the "login_redirect_url" field.
+func LoginRedirectURLContainsFold(v string) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.FieldContainsFold(FieldLoginRedirectURL, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SamlConfig) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SamlConfig) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SamlConfig) predicate.SamlConfig {
+	return predicate.SamlConfig(sql.NotPredicates(p))
+}
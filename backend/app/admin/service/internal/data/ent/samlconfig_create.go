@@ -0,0 +1,1913 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-wind-admin/app/admin/service/internal/data/ent/samlconfig"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SamlConfigCreate is the builder for creating a SamlConfig entity.
+type SamlConfigCreate struct {
+	config
+	mutation *SamlConfigMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *SamlConfigCreate) SetCreatedAt(v time.Time) *SamlConfigCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *SamlConfigCreate) SetNillableCreatedAt(v *time.Time) *SamlConfigCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *SamlConfigCreate) SetUpdatedAt(v time.Time) *SamlConfigCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *SamlConfigCreate) SetNillableUpdatedAt(v *time.Time) *SamlConfigCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *SamlConfigCreate) SetDeletedAt(v time.Time) *SamlConfigCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *SamlConfigCreate) SetNillableDeletedAt(v *time.Time) *SamlConfigCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *SamlConfigCreate) SetCreatedBy(v uint32) *SamlConfigCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *SamlConfigCreate) SetNillableCreatedBy(v *uint32) *SamlConfigCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_c *SamlConfigCreate) SetUpdatedBy(v uint32) *SamlConfigCreate {
+	_c.mutation.SetUpdatedBy(v)
+	return _c
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_c *SamlConfigCreate) SetNillableUpdatedBy(v *uint32) *SamlConfigCreate {
+	if v != nil {
+		_c.SetUpdatedBy(*v)
+	}
+	return _c
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_c *SamlConfigCreate) SetDeletedBy(v uint32) *SamlConfigCreate {
+	_c.mutation.SetDeletedBy(v)
+	return _c
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_c *SamlConfigCreate) SetNillableDeletedBy(v *uint32) *SamlConfigCreate {
+	if v != nil {
+		_c.SetDeletedBy(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *SamlConfigCreate) SetTenantID(v uint32) *SamlConfigCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *SamlConfigCreate) SetNillableTenantID(v *uint32) *SamlConfigCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *SamlConfigCreate) SetStatus(v samlconfig.Status) *SamlConfigCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *SamlConfigCreate) SetNillableStatus(v *samlconfig.Status) *SamlConfigCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetDisplayName sets the "display_name" field.
+func (_c *SamlConfigCreate) SetDisplayName(v string) *SamlConfigCreate {
+	_c.mutation.SetDisplayName(v)
+	return _c
+}
+
+// SetNillableDisplayName sets the "display_name" field if the given value is not nil.
+func (_c *SamlConfigCreate) SetNillableDisplayName(v *string) *SamlConfigCreate {
+	if v != nil {
+		_c.SetDisplayName(*v)
+	}
+	return _c
+}
+
+// SetIdpEntityID sets the "idp_entity_id" field.
+func (_c *SamlConfigCreate) SetIdpEntityID(v string) *SamlConfigCreate {
+	_c.mutation.SetIdpEntityID(v)
+	return _c
+}
+
+// SetNillableIdpEntityID sets the "idp_entity_id" field if the given value is not nil.
+func (_c *SamlConfigCreate) SetNillableIdpEntityID(v *string) *SamlConfigCreate {
+	if v != nil {
+		_c.SetIdpEntityID(*v)
+	}
+	return _c
+}
+
+// SetIdpSSOURL sets the "idp_sso_url" field.
+func (_c *SamlConfigCreate) SetIdpSSOURL(v string) *SamlConfigCreate {
+	_c.mutation.SetIdpSSOURL(v)
+	return _c
+}
+
+// SetNillableIdpSSOURL sets the "idp_sso_url" field if the given value is not nil.
+func (_c *SamlConfigCreate) SetNillableIdpSSOURL(v *string) *SamlConfigCreate {
+	if v != nil {
+		_c.SetIdpSSOURL(*v)
+	}
+	return _c
+}
+
+// SetIdpCertificate sets the "idp_certificate" field.
+func (_c *SamlConfigCreate) SetIdpCertificate(v string) *SamlConfigCreate {
+	_c.mutation.SetIdpCertificate(v)
+	return _c
+}
+
+// SetNillableIdpCertificate sets the "idp_certificate" field if the given value is not nil.
+func (_c *SamlConfigCreate) SetNillableIdpCertificate(v *string) *SamlConfigCreate {
+	if v != nil {
+		_c.SetIdpCertificate(*v)
+	}
+	return _c
+}
+
+// SetNameIDFormat sets the "name_id_format" field.
+func (_c *SamlConfigCreate) SetNameIDFormat(v string) *SamlConfigCreate {
+	_c.mutation.SetNameIDFormat(v)
+	return _c
+}
+
+// SetNillableNameIDFormat sets the "name_id_format" field if the given value is not nil.
+func (_c *SamlConfigCreate) SetNillableNameIDFormat(v *string) *SamlConfigCreate {
+	if v != nil {
+		_c.SetNameIDFormat(*v)
+	}
+	return _c
+}
+
+// SetAttributeMapping sets the "attribute_mapping" field.
+func (_c *SamlConfigCreate) SetAttributeMapping(v map[string]string) *SamlConfigCreate {
+	_c.mutation.SetAttributeMapping(v)
+	return _c
+}
+
+// SetJitProvisioning sets the "jit_provisioning" field.
+func (_c *SamlConfigCreate) SetJitProvisioning(v bool) *SamlConfigCreate {
+	_c.mutation.SetJitProvisioning(v)
+	return _c
+}
+
+// SetNillableJitProvisioning sets the "jit_provisioning" field if the given value is not nil.
+func (_c *SamlConfigCreate) SetNillableJitProvisioning(v *bool) *SamlConfigCreate {
+	if v != nil {
+		_c.SetJitProvisioning(*v)
+	}
+	return _c
+}
+
+// SetDefaultRoleIds sets the "default_role_ids" field.
+func (_c *SamlConfigCreate) SetDefaultRoleIds(v []uint32) *SamlConfigCreate {
+	_c.mutation.SetDefaultRoleIds(v)
+	return _c
+}
+
+// SetDefaultOrgUnitID sets the "default_org_unit_id" field.
+func (_c *SamlConfigCreate) SetDefaultOrgUnitID(v uint32) *SamlConfigCreate {
+	_c.mutation.SetDefaultOrgUnitID(v)
+	return _c
+}
+
+// SetNillableDefaultOrgUnitID sets the "default_org_unit_id" field if the given value is not nil.
+func (_c *SamlConfigCreate) SetNillableDefaultOrgUnitID(v *uint32) *SamlConfigCreate {
+	if v != nil {
+		_c.SetDefaultOrgUnitID(*v)
+	}
+	return _c
+}
+
+// SetSSOOnly sets the "sso_only" field.
+func (_c *SamlConfigCreate) SetSSOOnly(v bool) *SamlConfigCreate {
+	_c.mutation.SetSSOOnly(v)
+	return _c
+}
+
+// SetNillableSSOOnly sets the "sso_only" field if the given value is not nil.
+func (_c *SamlConfigCreate) SetNillableSSOOnly(v *bool) *SamlConfigCreate {
+	if v != nil {
+		_c.SetSSOOnly(*v)
+	}
+	return _c
+}
+
+// SetLoginRedirectURL sets the "login_redirect_url" field.
+func (_c *SamlConfigCreate) SetLoginRedirectURL(v string) *SamlConfigCreate {
+	_c.mutation.SetLoginRedirectURL(v)
+	return _c
+}
+
+// SetNillableLoginRedirectURL sets the "login_redirect_url" field if the given value is not nil.
+func (_c *SamlConfigCreate) SetNillableLoginRedirectURL(v *string) *SamlConfigCreate {
+	if v != nil {
+		_c.SetLoginRedirectURL(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *SamlConfigCreate) SetID(v uint32) *SamlConfigCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the SamlConfigMutation object of the builder.
+func (_c *SamlConfigCreate) Mutation() *SamlConfigMutation {
+	return _c.mutation
+}
+
+// Save creates the SamlConfig in the database.
+func (_c *SamlConfigCreate) Save(ctx context.Context) (*SamlConfig, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *SamlConfigCreate) SaveX(ctx context.Context) *SamlConfig {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SamlConfigCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SamlConfigCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *SamlConfigCreate) defaults() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := samlconfig.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := samlconfig.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.JitProvisioning(); !ok {
+		v := samlconfig.DefaultJitProvisioning
+		_c.mutation.SetJitProvisioning(v)
+	}
+	if _, ok := _c.mutation.SSOOnly(); !ok {
+		v := samlconfig.DefaultSSOOnly
+		_c.mutation.SetSSOOnly(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *SamlConfigCreate) check() error {
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "SamlConfig.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := samlconfig.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "SamlConfig.status": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.DisplayName(); ok {
+		if err := samlconfig.DisplayNameValidator(v); err != nil {
+			return &ValidationError{Name: "display_name", err: fmt.Errorf(`ent: validator failed for field "SamlConfig.display_name": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.IdpEntityID(); ok {
+		if err := samlconfig.IdpEntityIDValidator(v); err != nil {
+			return &ValidationError{Name: "idp_entity_id", err: fmt.Errorf(`ent: validator failed for field "SamlConfig.idp_entity_id": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.IdpSSOURL(); ok {
+		if err := samlconfig.IdpSSOURLValidator(v); err != nil {
+			return &ValidationError{Name: "idp_sso_url", err: fmt.Errorf(`ent: validator failed for field "SamlConfig.idp_sso_url": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.NameIDFormat(); ok {
+		if err := samlconfig.NameIDFormatValidator(v); err != nil {
+			return &ValidationError{Name: "name_id_format", err: fmt.Errorf(`ent: validator failed for field "SamlConfig.name_id_format": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.LoginRedirectURL(); ok {
+		if err := samlconfig.LoginRedirectURLValidator(v); err != nil {
+			return &ValidationError{Name: "login_redirect_url", err: fmt.Errorf(`ent: validator failed for field "SamlConfig.login_redirect_url": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := samlconfig.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "SamlConfig.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *SamlConfigCreate) sqlSave(ctx context.Context) (*SamlConfig, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint32(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *SamlConfigCreate) createSpec() (*SamlConfig, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SamlConfig{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(samlconfig.Table, sqlgraph.NewFieldSpec(samlconfig.FieldID, field.TypeUint32))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(samlconfig.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = &value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(samlconfig.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = &value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(samlconfig.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(samlconfig.FieldCreatedBy, field.TypeUint32, value)
+		_node.CreatedBy = &value
+	}
+	if value, ok := _c.mutation.UpdatedBy(); ok {
+		_spec.SetField(samlconfig.FieldUpdatedBy, field.TypeUint32, value)
+		_node.UpdatedBy = &value
+	}
+	if value, ok := _c.mutation.DeletedBy(); ok {
+		_spec.SetField(samlconfig.FieldDeletedBy, field.TypeUint32, value)
+		_node.DeletedBy = &value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(samlconfig.FieldTenantID, field.TypeUint32, value)
+		_node.TenantID = &value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(samlconfig.FieldStatus, field.TypeEnum, value)
+		_node.Status = &value
+	}
+	if value, ok := _c.mutation.DisplayName(); ok {
+		_spec.SetField(samlconfig.FieldDisplayName, field.TypeString, value)
+		_node.DisplayName = &value
+	}
+	if value, ok := _c.mutation.IdpEntityID(); ok {
+		_spec.SetField(samlconfig.FieldIdpEntityID, field.TypeString, value)
+		_node.IdpEntityID = &value
+	}
+	if value, ok := _c.mutation.IdpSSOURL(); ok {
+		_spec.SetField(samlconfig.FieldIdpSSOURL, field.TypeString, value)
+		_node.IdpSSOURL = &value
+	}
+	if value, ok := _c.mutation.IdpCertificate(); ok {
+		_spec.SetField(samlconfig.FieldIdpCertificate, field.TypeString, value)
+		_node.IdpCertificate = &value
+	}
+	if value, ok := _c.mutation.NameIDFormat(); ok {
+		_spec.SetField(samlconfig.FieldNameIDFormat, field.TypeString, value)
+		_node.NameIDFormat = &value
+	}
+	if value, ok := _c.mutation.AttributeMapping(); ok {
+		_spec.SetField(samlconfig.FieldAttributeMapping, field.TypeJSON, value)
+		_node.AttributeMapping = value
+	}
+	if value, ok := _c.mutation.JitProvisioning(); ok {
+		_spec.SetField(samlconfig.FieldJitProvisioning, field.TypeBool, value)
+		_node.JitProvisioning = &value
+	}
+	if value, ok := _c.mutation.DefaultRoleIds(); ok {
+		_spec.SetField(samlconfig.FieldDefaultRoleIds, field.TypeJSON, value)
+		_node.DefaultRoleIds = value
+	}
+	if value, ok := _c.mutation.DefaultOrgUnitID(); ok {
+		_spec.SetField(samlconfig.FieldDefaultOrgUnitID, field.TypeUint32, value)
+		_node.DefaultOrgUnitID = &value
+	}
+	if value, ok := _c.mutation.SSOOnly(); ok {
+		_spec.SetField(samlconfig.FieldSSOOnly, field.TypeBool, value)
+		_node.SSOOnly = &value
+	}
+	if value, ok := _c.mutation.LoginRedirectURL(); ok {
+		_spec.SetField(samlconfig.FieldLoginRedirectURL, field.TypeString, value)
+		_node.LoginRedirectURL = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SamlConfig.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SamlConfigUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *SamlConfigCreate) OnConflict(opts ...sql.ConflictOption) *SamlConfigUpsertOne {
+	_c.conflict = opts
+	return &SamlConfigUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SamlConfig.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *SamlConfigCreate) OnConflictColumns(columns ...string) *SamlConfigUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &SamlConfigUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// SamlConfigUpsertOne is the builder for "upsert"-ing
+	//  one SamlConfig node.
+	SamlConfigUpsertOne struct {
+		create *SamlConfigCreate
+	}
+
+	// SamlConfigUpsert is the "OnConflict" setter.
+	SamlConfigUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SamlConfigUpsert) SetUpdatedAt(v time.Time) *SamlConfigUpsert {
+	u.Set(samlconfig.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SamlConfigUpsert) UpdateUpdatedAt() *SamlConfigUpsert {
+	u.SetExcluded(samlconfig.FieldUpdatedAt)
+	return u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *SamlConfigUpsert) ClearUpdatedAt() *SamlConfigUpsert {
+	u.SetNull(samlconfig.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *SamlConfigUpsert) SetDeletedAt(v time.Time) *SamlConfigUpsert {
+	u.Set(samlconfig.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *SamlConfigUpsert) UpdateDeletedAt() *SamlConfigUpsert {
+	u.SetExcluded(samlconfig.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *SamlConfigUpsert) ClearDeletedAt() *SamlConfigUpsert {
+	u.SetNull(samlconfig.FieldDeletedAt)
+	return u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *SamlConfigUpsert) SetCreatedBy(v uint32) *SamlConfigUpsert {
+	u.Set(samlconfig.FieldCreatedBy, v)
+	return u
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *SamlConfigUpsert) UpdateCreatedBy() *SamlConfigUpsert {
+	u.SetExcluded(samlconfig.FieldCreatedBy)
+	return u
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *SamlConfigUpsert) AddCreatedBy(v uint32) *SamlConfigUpsert {
+	u.Add(samlconfig.FieldCreatedBy, v)
+	return u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *SamlConfigUpsert) ClearCreatedBy() *SamlConfigUpsert {
+	u.SetNull(samlconfig.FieldCreatedBy)
+	return u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *SamlConfigUpsert) SetUpdatedBy(v uint32) *SamlConfigUpsert {
+	u.Set(samlconfig.FieldUpdatedBy, v)
+	return u
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *SamlConfigUpsert) UpdateUpdatedBy() *SamlConfigUpsert {
+	u.SetExcluded(samlconfig.FieldUpdatedBy)
+	return u
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *SamlConfigUpsert) AddUpdatedBy(v uint32) *SamlConfigUpsert {
+	u.Add(samlconfig.FieldUpdatedBy, v)
+	return u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *SamlConfigUpsert) ClearUpdatedBy() *SamlConfigUpsert {
+	u.SetNull(samlconfig.FieldUpdatedBy)
+	return u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *SamlConfigUpsert) SetDeletedBy(v uint32) *SamlConfigUpsert {
+	u.Set(samlconfig.FieldDeletedBy, v)
+	return u
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *SamlConfigUpsert) UpdateDeletedBy() *SamlConfigUpsert {
+	u.SetExcluded(samlconfig.FieldDeletedBy)
+	return u
+}
+
+// AddDeletedBy adds v to the "deleted_by" field.
+func (u *SamlConfigUpsert) AddDeletedBy(v uint32) *SamlConfigUpsert {
+	u.Add(samlconfig.FieldDeletedBy, v)
+	return u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *SamlConfigUpsert) ClearDeletedBy() *SamlConfigUpsert {
+	u.SetNull(samlconfig.FieldDeletedBy)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *SamlConfigUpsert) SetStatus(v samlconfig.Status) *SamlConfigUpsert {
+	u.Set(samlconfig.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *SamlConfigUpsert) UpdateStatus() *SamlConfigUpsert {
+	u.SetExcluded(samlconfig.FieldStatus)
+	return u
+}
+
+// SetDisplayName sets the "display_name" field.
+func (u *SamlConfigUpsert) SetDisplayName(v string) *SamlConfigUpsert {
+	u.Set(samlconfig.FieldDisplayName, v)
+	return u
+}
+
+// UpdateDisplayName sets the "display_name" field to the value that was provided on create.
+func (u *SamlConfigUpsert) UpdateDisplayName() *SamlConfigUpsert {
+	u.SetExcluded(samlconfig.FieldDisplayName)
+	return u
+}
+
+// ClearDisplayName clears the value of the "display_name" field.
+func (u *SamlConfigUpsert) ClearDisplayName() *SamlConfigUpsert {
+	u.SetNull(samlconfig.FieldDisplayName)
+	return u
+}
+
+// SetIdpEntityID sets the "idp_entity_id" field.
+func (u *SamlConfigUpsert) SetIdpEntityID(v string) *SamlConfigUpsert {
+	u.Set(samlconfig.FieldIdpEntityID, v)
+	return u
+}
+
+// UpdateIdpEntityID sets the "idp_entity_id" field to the value that was provided on create.
+func (u *SamlConfigUpsert) UpdateIdpEntityID() *SamlConfigUpsert {
+	u.SetExcluded(samlconfig.FieldIdpEntityID)
+	return u
+}
+
+// ClearIdpEntityID clears the value of the "idp_entity_id" field.
+func (u *SamlConfigUpsert) ClearIdpEntityID() *SamlConfigUpsert {
+	u.SetNull(samlconfig.FieldIdpEntityID)
+	return u
+}
+
+// SetIdpSSOURL sets the "idp_sso_url" field.
+func (u *SamlConfigUpsert) SetIdpSSOURL(v string) *SamlConfigUpsert {
+	u.Set(samlconfig.FieldIdpSSOURL, v)
+	return u
+}
+
+// UpdateIdpSSOURL sets the "idp_sso_url" field to the value that was provided on create.
+func (u *SamlConfigUpsert) UpdateIdpSSOURL() *SamlConfigUpsert {
+	u.SetExcluded(samlconfig.FieldIdpSSOURL)
+	return u
+}
+
+// ClearIdpSSOURL clears the value of the "idp_sso_url" field.
+func (u *SamlConfigUpsert) ClearIdpSSOURL() *SamlConfigUpsert {
+	u.SetNull(samlconfig.FieldIdpSSOURL)
+	return u
+}
+
+// SetIdpCertificate sets the "idp_certificate" field.
+func (u *SamlConfigUpsert) SetIdpCertificate(v string) *SamlConfigUpsert {
+	u.Set(samlconfig.FieldIdpCertificate, v)
+	return u
+}
+
+// UpdateIdpCertificate sets the "idp_certificate" field to the value that was provided on create.
+func (u *SamlConfigUpsert) UpdateIdpCertificate() *SamlConfigUpsert {
+	u.SetExcluded(samlconfig.FieldIdpCertificate)
+	return u
+}
+
+// ClearIdpCertificate clears the value of the "idp_certificate" field.
+func (u *SamlConfigUpsert) ClearIdpCertificate() *SamlConfigUpsert {
+	u.SetNull(samlconfig.FieldIdpCertificate)
+	return u
+}
+
+// SetNameIDFormat sets the "name_id_format" field.
+func (u *SamlConfigUpsert) SetNameIDFormat(v string) *SamlConfigUpsert {
+	u.Set(samlconfig.FieldNameIDFormat, v)
+	return u
+}
+
+// UpdateNameIDFormat sets the "name_id_format" field to the value that was provided on create.
+func (u *SamlConfigUpsert) UpdateNameIDFormat() *SamlConfigUpsert {
+	u.SetExcluded(samlconfig.FieldNameIDFormat)
+	return u
+}
+
+// ClearNameIDFormat clears the value of the "name_id_format" field.
+func (u *SamlConfigUpsert) ClearNameIDFormat() *SamlConfigUpsert {
+	u.SetNull(samlconfig.FieldNameIDFormat)
+	return u
+}
+
+// SetAttributeMapping sets the "attribute_mapping" field.
+func (u *SamlConfigUpsert) SetAttributeMapping(v map[string]string) *SamlConfigUpsert {
+	u.Set(samlconfig.FieldAttributeMapping, v)
+	return u
+}
+
+// UpdateAttributeMapping sets the "attribute_mapping" field to the value that was provided on create.
+func (u *SamlConfigUpsert) UpdateAttributeMapping() *SamlConfigUpsert {
+	u.SetExcluded(samlconfig.FieldAttributeMapping)
+	return u
+}
+
+// ClearAttributeMapping clears the value of the "attribute_mapping" field.
+func (u *SamlConfigUpsert) ClearAttributeMapping() *SamlConfigUpsert {
+	u.SetNull(samlconfig.FieldAttributeMapping)
+	return u
+}
+
+// SetJitProvisioning sets the "jit_provisioning" field.
+func (u *SamlConfigUpsert) SetJitProvisioning(v bool) *SamlConfigUpsert {
+	u.Set(samlconfig.FieldJitProvisioning, v)
+	return u
+}
+
+// UpdateJitProvisioning sets the "jit_provisioning" field to the value that was provided on create.
+func (u *SamlConfigUpsert) UpdateJitProvisioning() *SamlConfigUpsert {
+	u.SetExcluded(samlconfig.FieldJitProvisioning)
+	return u
+}
+
+// ClearJitProvisioning clears the value of the "jit_provisioning" field.
+func (u *SamlConfigUpsert) ClearJitProvisioning() *SamlConfigUpsert {
+	u.SetNull(samlconfig.FieldJitProvisioning)
+	return u
+}
+
+// SetDefaultRoleIds sets the "default_role_ids" field.
+func (u *SamlConfigUpsert) SetDefaultRoleIds(v []uint32) *SamlConfigUpsert {
+	u.Set(samlconfig.FieldDefaultRoleIds, v)
+	return u
+}
+
+// UpdateDefaultRoleIds sets the "default_role_ids" field to the value that was provided on create.
+func (u *SamlConfigUpsert) UpdateDefaultRoleIds() *SamlConfigUpsert {
+	u.SetExcluded(samlconfig.FieldDefaultRoleIds)
+	return u
+}
+
+// ClearDefaultRoleIds clears the value of the "default_role_ids" field.
+func (u *SamlConfigUpsert) ClearDefaultRoleIds() *SamlConfigUpsert {
+	u.SetNull(samlconfig.FieldDefaultRoleIds)
+	return u
+}
+
+// SetDefaultOrgUnitID sets the "default_org_unit_id" field.
+func (u *SamlConfigUpsert) SetDefaultOrgUnitID(v uint32) *SamlConfigUpsert {
+	u.Set(samlconfig.FieldDefaultOrgUnitID, v)
+	return u
+}
+
+// UpdateDefaultOrgUnitID sets the "default_org_unit_id" field to the value that was provided on create.
+func (u *SamlConfigUpsert) UpdateDefaultOrgUnitID() *SamlConfigUpsert {
+	u.SetExcluded(samlconfig.FieldDefaultOrgUnitID)
+	return u
+}
+
+// AddDefaultOrgUnitID adds v to the "default_org_unit_id" field.
+func (u *SamlConfigUpsert) AddDefaultOrgUnitID(v uint32) *SamlConfigUpsert {
+	u.Add(samlconfig.FieldDefaultOrgUnitID, v)
+	return u
+}
+
+// ClearDefaultOrgUnitID clears the value of the "default_org_unit_id" field.
+func (u *SamlConfigUpsert) ClearDefaultOrgUnitID() *SamlConfigUpsert {
+	u.SetNull(samlconfig.FieldDefaultOrgUnitID)
+	return u
+}
+
+// SetSSOOnly sets the "sso_only" field.
+func (u *SamlConfigUpsert) SetSSOOnly(v bool) *SamlConfigUpsert {
+	u.Set(samlconfig.FieldSSOOnly, v)
+	return u
+}
+
+// UpdateSSOOnly sets the "sso_only" field to the value that was provided on create.
+func (u *SamlConfigUpsert) UpdateSSOOnly() *SamlConfigUpsert {
+	u.SetExcluded(samlconfig.FieldSSOOnly)
+	return u
+}
+
+// ClearSSOOnly clears the value of the "sso_only" field.
+func (u *SamlConfigUpsert) ClearSSOOnly() *SamlConfigUpsert {
+	u.SetNull(samlconfig.FieldSSOOnly)
+	return u
+}
+
+// SetLoginRedirectURL sets the "login_redirect_url" field.
+func (u *SamlConfigUpsert) SetLoginRedirectURL(v string) *SamlConfigUpsert {
+	u.Set(samlconfig.FieldLoginRedirectURL, v)
+	return u
+}
+
+// UpdateLoginRedirectURL sets the "login_redirect_url" field to the value that was provided on create.
+func (u *SamlConfigUpsert) UpdateLoginRedirectURL() *SamlConfigUpsert {
+	u.SetExcluded(samlconfig.FieldLoginRedirectURL)
+	return u
+}
+
+// ClearLoginRedirectURL clears the value of the "login_redirect_url" field.
+func (u *SamlConfigUpsert) ClearLoginRedirectURL() *SamlConfigUpsert {
+	u.SetNull(samlconfig.FieldLoginRedirectURL)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.SamlConfig.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(samlconfig.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *SamlConfigUpsertOne) UpdateNewValues() *SamlConfigUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(samlconfig.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(samlconfig.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(samlconfig.FieldTenantID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SamlConfig.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *SamlConfigUpsertOne) Ignore() *SamlConfigUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SamlConfigUpsertOne) DoNothing() *SamlConfigUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SamlConfigCreate.OnConflict
+// documentation for more info.
+func (u *SamlConfigUpsertOne) Update(set func(*SamlConfigUpsert)) *SamlConfigUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SamlConfigUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SamlConfigUpsertOne) SetUpdatedAt(v time.Time) *SamlConfigUpsertOne {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SamlConfigUpsertOne) UpdateUpdatedAt() *SamlConfigUpsertOne {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *SamlConfigUpsertOne) ClearUpdatedAt() *SamlConfigUpsertOne {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.ClearUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *SamlConfigUpsertOne) SetDeletedAt(v time.Time) *SamlConfigUpsertOne {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *SamlConfigUpsertOne) UpdateDeletedAt() *SamlConfigUpsertOne {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *SamlConfigUpsertOne) ClearDeletedAt() *SamlConfigUpsertOne {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *SamlConfigUpsertOne) SetCreatedBy(v uint32) *SamlConfigUpsertOne {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *SamlConfigUpsertOne) AddCreatedBy(v uint32) *SamlConfigUpsertOne {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.AddCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *SamlConfigUpsertOne) UpdateCreatedBy() *SamlConfigUpsertOne {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *SamlConfigUpsertOne) ClearCreatedBy() *SamlConfigUpsertOne {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *SamlConfigUpsertOne) SetUpdatedBy(v uint32) *SamlConfigUpsertOne {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *SamlConfigUpsertOne) AddUpdatedBy(v uint32) *SamlConfigUpsertOne {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.AddUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *SamlConfigUpsertOne) UpdateUpdatedBy() *SamlConfigUpsertOne {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *SamlConfigUpsertOne) ClearUpdatedBy() *SamlConfigUpsertOne {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.ClearUpdatedBy()
+	})
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *SamlConfigUpsertOne) SetDeletedBy(v uint32) *SamlConfigUpsertOne {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.SetDeletedBy(v)
+	})
+}
+
+// AddDeletedBy adds v to the "deleted_by" field.
+func (u *SamlConfigUpsertOne) AddDeletedBy(v uint32) *SamlConfigUpsertOne {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.AddDeletedBy(v)
+	})
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *SamlConfigUpsertOne) UpdateDeletedBy() *SamlConfigUpsertOne {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.UpdateDeletedBy()
+	})
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *SamlConfigUpsertOne) ClearDeletedBy() *SamlConfigUpsertOne {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.ClearDeletedBy()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *SamlConfigUpsertOne) SetStatus(v samlconfig.Status) *SamlConfigUpsertOne {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *SamlConfigUpsertOne) UpdateStatus() *SamlConfigUpsertOne {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetDisplayName sets the "display_name" field.
+func (u *SamlConfigUpsertOne) SetDisplayName(v string) *SamlConfigUpsertOne {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.SetDisplayName(v)
+	})
+}
+
+// UpdateDisplayName sets the "display_name" field to the value that was provided on create.
+func (u *SamlConfigUpsertOne) UpdateDisplayName() *SamlConfigUpsertOne {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.UpdateDisplayName()
+	})
+}
+
+// ClearDisplayName clears the value of the "display_name" field.
+func (u *SamlConfigUpsertOne) ClearDisplayName() *SamlConfigUpsertOne {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.ClearDisplayName()
+	})
+}
+
+// SetIdpEntityID sets the "idp_entity_id" field.
+func (u *SamlConfigUpsertOne) SetIdpEntityID(v string) *SamlConfigUpsertOne {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.SetIdpEntityID(v)
+	})
+}
+
+// UpdateIdpEntityID sets the "idp_entity_id" field to the value that was provided on create.
+func (u *SamlConfigUpsertOne) UpdateIdpEntityID() *SamlConfigUpsertOne {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.UpdateIdpEntityID()
+	})
+}
+
+// ClearIdpEntityID clears the value of the "idp_entity_id" field.
+func (u *SamlConfigUpsertOne) ClearIdpEntityID() *SamlConfigUpsertOne {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.ClearIdpEntityID()
+	})
+}
+
+// SetIdpSSOURL sets the "idp_sso_url" field.
+func (u *SamlConfigUpsertOne) SetIdpSSOURL(v string) *SamlConfigUpsertOne {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.SetIdpSSOURL(v)
+	})
+}
+
+// UpdateIdpSSOURL sets the "idp_sso_url" field to the value that was provided on create.
+func (u *SamlConfigUpsertOne) UpdateIdpSSOURL() *SamlConfigUpsertOne {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.UpdateIdpSSOURL()
+	})
+}
+
+// ClearIdpSSOURL clears the value of the "idp_sso_url" field.
+func (u *SamlConfigUpsertOne) ClearIdpSSOURL() *SamlConfigUpsertOne {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.ClearIdpSSOURL()
+	})
+}
+
+// SetIdpCertificate sets the "idp_certificate" field.
+func (u *SamlConfigUpsertOne) SetIdpCertificate(v string) *SamlConfigUpsertOne {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.SetIdpCertificate(v)
+	})
+}
+
+// UpdateIdpCertificate sets the "idp_certificate" field to the value that was provided on create.
+func (u *SamlConfigUpsertOne) UpdateIdpCertificate() *SamlConfigUpsertOne {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.UpdateIdpCertificate()
+	})
+}
+
+// ClearIdpCertificate clears the value of the "idp_certificate" field.
+func (u *SamlConfigUpsertOne) ClearIdpCertificate() *SamlConfigUpsertOne {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.ClearIdpCertificate()
+	})
+}
+
+// SetNameIDFormat sets the "name_id_format" field.
+func (u *SamlConfigUpsertOne) SetNameIDFormat(v string) *SamlConfigUpsertOne {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.SetNameIDFormat(v)
+	})
+}
+
+// UpdateNameIDFormat sets the "name_id_format" field to the value that was provided on create.
+func (u *SamlConfigUpsertOne) UpdateNameIDFormat() *SamlConfigUpsertOne {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.UpdateNameIDFormat()
+	})
+}
+
+// ClearNameIDFormat clears the value of the "name_id_format" field.
+func (u *SamlConfigUpsertOne) ClearNameIDFormat() *SamlConfigUpsertOne {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.ClearNameIDFormat()
+	})
+}
+
+// SetAttributeMapping sets the "attribute_mapping" field.
+func (u *SamlConfigUpsertOne) SetAttributeMapping(v map[string]string) *SamlConfigUpsertOne {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.SetAttributeMapping(v)
+	})
+}
+
+// UpdateAttributeMapping sets the "attribute_mapping" field to the value that was provided on create.
+func (u *SamlConfigUpsertOne) UpdateAttributeMapping() *SamlConfigUpsertOne {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.UpdateAttributeMapping()
+	})
+}
+
+// ClearAttributeMapping clears the value of the "attribute_mapping" field.
+func (u *SamlConfigUpsertOne) ClearAttributeMapping() *SamlConfigUpsertOne {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.ClearAttributeMapping()
+	})
+}
+
+// SetJitProvisioning sets the "jit_provisioning" field.
+func (u *SamlConfigUpsertOne) SetJitProvisioning(v bool) *SamlConfigUpsertOne {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.SetJitProvisioning(v)
+	})
+}
+
+// UpdateJitProvisioning sets the "jit_provisioning" field to the value that was provided on create.
+func (u *SamlConfigUpsertOne) UpdateJitProvisioning() *SamlConfigUpsertOne {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.UpdateJitProvisioning()
+	})
+}
+
+// ClearJitProvisioning clears the value of the "jit_provisioning" field.
+func (u *SamlConfigUpsertOne) ClearJitProvisioning() *SamlConfigUpsertOne {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.ClearJitProvisioning()
+	})
+}
+
+// SetDefaultRoleIds sets the "default_role_ids" field.
+func (u *SamlConfigUpsertOne) SetDefaultRoleIds(v []uint32) *SamlConfigUpsertOne {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.SetDefaultRoleIds(v)
+	})
+}
+
+// UpdateDefaultRoleIds sets the "default_role_ids" field to the value that was provided on create.
+func (u *SamlConfigUpsertOne) UpdateDefaultRoleIds() *SamlConfigUpsertOne {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.UpdateDefaultRoleIds()
+	})
+}
+
+// ClearDefaultRoleIds clears the value of the "default_role_ids" field.
+func (u *SamlConfigUpsertOne) ClearDefaultRoleIds() *SamlConfigUpsertOne {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.ClearDefaultRoleIds()
+	})
+}
+
+// SetDefaultOrgUnitID sets the "default_org_unit_id" field.
+func (u *SamlConfigUpsertOne) SetDefaultOrgUnitID(v uint32) *SamlConfigUpsertOne {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.SetDefaultOrgUnitID(v)
+	})
+}
+
+// AddDefaultOrgUnitID adds v to the "default_org_unit_id" field.
+func (u *SamlConfigUpsertOne) AddDefaultOrgUnitID(v uint32) *SamlConfigUpsertOne {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.AddDefaultOrgUnitID(v)
+	})
+}
+
+// UpdateDefaultOrgUnitID sets the "default_org_unit_id" field to the value that was provided on create.
+func (u *SamlConfigUpsertOne) UpdateDefaultOrgUnitID() *SamlConfigUpsertOne {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.UpdateDefaultOrgUnitID()
+	})
+}
+
+// ClearDefaultOrgUnitID clears the value of the "default_org_unit_id" field.
+func (u *SamlConfigUpsertOne) ClearDefaultOrgUnitID() *SamlConfigUpsertOne {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.ClearDefaultOrgUnitID()
+	})
+}
+
+// SetSSOOnly sets the "sso_only" field.
+func (u *SamlConfigUpsertOne) SetSSOOnly(v bool) *SamlConfigUpsertOne {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.SetSSOOnly(v)
+	})
+}
+
+// UpdateSSOOnly sets the "sso_only" field to the value that was provided on create.
+func (u *SamlConfigUpsertOne) UpdateSSOOnly() *SamlConfigUpsertOne {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.UpdateSSOOnly()
+	})
+}
+
+// ClearSSOOnly clears the value of the "sso_only" field.
+func (u *SamlConfigUpsertOne) ClearSSOOnly() *SamlConfigUpsertOne {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.ClearSSOOnly()
+	})
+}
+
+// SetLoginRedirectURL sets the "login_redirect_url" field.
+func (u *SamlConfigUpsertOne) SetLoginRedirectURL(v string) *SamlConfigUpsertOne {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.SetLoginRedirectURL(v)
+	})
+}
+
+// UpdateLoginRedirectURL sets the "login_redirect_url" field to the value that was provided on create.
+func (u *SamlConfigUpsertOne) UpdateLoginRedirectURL() *SamlConfigUpsertOne {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.UpdateLoginRedirectURL()
+	})
+}
+
+// ClearLoginRedirectURL clears the value of the "login_redirect_url" field.
+func (u *SamlConfigUpsertOne) ClearLoginRedirectURL() *SamlConfigUpsertOne {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.ClearLoginRedirectURL()
+	})
+}
+
+// Exec executes the query.
+func (u *SamlConfigUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SamlConfigCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SamlConfigUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *SamlConfigUpsertOne) ID(ctx context.Context) (id uint32, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *SamlConfigUpsertOne) IDX(ctx context.Context) uint32 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// SamlConfigCreateBulk is the builder for creating many SamlConfig entities in bulk.
+type SamlConfigCreateBulk struct {
+	config
+	err      error
+	builders []*SamlConfigCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the SamlConfig entities in the database.
+func (_c *SamlConfigCreateBulk) Save(ctx context.Context) ([]*SamlConfig, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*SamlConfig, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SamlConfigMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint32(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *SamlConfigCreateBulk) SaveX(ctx context.Context) []*SamlConfig {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SamlConfigCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SamlConfigCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SamlConfig.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SamlConfigUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *SamlConfigCreateBulk) OnConflict(opts ...sql.ConflictOption) *SamlConfigUpsertBulk {
+	_c.conflict = opts
+	return &SamlConfigUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SamlConfig.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *SamlConfigCreateBulk) OnConflictColumns(columns ...string) *SamlConfigUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &SamlConfigUpsertBulk{
+		create: _c,
+	}
+}
+
+// SamlConfigUpsertBulk is the builder for "upsert"-ing
+// a bulk of SamlConfig nodes.
+type SamlConfigUpsertBulk struct {
+	create *SamlConfigCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.SamlConfig.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(samlconfig.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *SamlConfigUpsertBulk) UpdateNewValues() *SamlConfigUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(samlconfig.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(samlconfig.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(samlconfig.FieldTenantID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SamlConfig.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *SamlConfigUpsertBulk) Ignore() *SamlConfigUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SamlConfigUpsertBulk) DoNothing() *SamlConfigUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SamlConfigCreateBulk.OnConflict
+// documentation for more info.
+func (u *SamlConfigUpsertBulk) Update(set func(*SamlConfigUpsert)) *SamlConfigUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SamlConfigUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SamlConfigUpsertBulk) SetUpdatedAt(v time.Time) *SamlConfigUpsertBulk {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SamlConfigUpsertBulk) UpdateUpdatedAt() *SamlConfigUpsertBulk {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *SamlConfigUpsertBulk) ClearUpdatedAt() *SamlConfigUpsertBulk {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.ClearUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *SamlConfigUpsertBulk) SetDeletedAt(v time.Time) *SamlConfigUpsertBulk {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *SamlConfigUpsertBulk) UpdateDeletedAt() *SamlConfigUpsertBulk {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *SamlConfigUpsertBulk) ClearDeletedAt() *SamlConfigUpsertBulk {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *SamlConfigUpsertBulk) SetCreatedBy(v uint32) *SamlConfigUpsertBulk {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *SamlConfigUpsertBulk) AddCreatedBy(v uint32) *SamlConfigUpsertBulk {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.AddCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *SamlConfigUpsertBulk) UpdateCreatedBy() *SamlConfigUpsertBulk {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *SamlConfigUpsertBulk) ClearCreatedBy() *SamlConfigUpsertBulk {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *SamlConfigUpsertBulk) SetUpdatedBy(v uint32) *SamlConfigUpsertBulk {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *SamlConfigUpsertBulk) AddUpdatedBy(v uint32) *SamlConfigUpsertBulk {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.AddUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *SamlConfigUpsertBulk) UpdateUpdatedBy() *SamlConfigUpsertBulk {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *SamlConfigUpsertBulk) ClearUpdatedBy() *SamlConfigUpsertBulk {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.ClearUpdatedBy()
+	})
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *SamlConfigUpsertBulk) SetDeletedBy(v uint32) *SamlConfigUpsertBulk {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.SetDeletedBy(v)
+	})
+}
+
+// AddDeletedBy adds v to the "deleted_by" field.
+func (u *SamlConfigUpsertBulk) AddDeletedBy(v uint32) *SamlConfigUpsertBulk {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.AddDeletedBy(v)
+	})
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *SamlConfigUpsertBulk) UpdateDeletedBy() *SamlConfigUpsertBulk {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.UpdateDeletedBy()
+	})
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *SamlConfigUpsertBulk) ClearDeletedBy() *SamlConfigUpsertBulk {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.ClearDeletedBy()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *SamlConfigUpsertBulk) SetStatus(v samlconfig.Status) *SamlConfigUpsertBulk {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *SamlConfigUpsertBulk) UpdateStatus() *SamlConfigUpsertBulk {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetDisplayName sets the "display_name" field.
+func (u *SamlConfigUpsertBulk) SetDisplayName(v string) *SamlConfigUpsertBulk {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.SetDisplayName(v)
+	})
+}
+
+// UpdateDisplayName sets the "display_name" field to the value that was provided on create.
+func (u *SamlConfigUpsertBulk) UpdateDisplayName() *SamlConfigUpsertBulk {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.UpdateDisplayName()
+	})
+}
+
+// ClearDisplayName clears the value of the "display_name" field.
+func (u *SamlConfigUpsertBulk) ClearDisplayName() *SamlConfigUpsertBulk {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.ClearDisplayName()
+	})
+}
+
+// SetIdpEntityID sets the "idp_entity_id" field.
+func (u *SamlConfigUpsertBulk) SetIdpEntityID(v string) *SamlConfigUpsertBulk {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.SetIdpEntityID(v)
+	})
+}
+
+// UpdateIdpEntityID sets the "idp_entity_id" field to the value that was provided on create.
+func (u *SamlConfigUpsertBulk) UpdateIdpEntityID() *SamlConfigUpsertBulk {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.UpdateIdpEntityID()
+	})
+}
+
+// ClearIdpEntityID clears the value of the "idp_entity_id" field.
+func (u *SamlConfigUpsertBulk) ClearIdpEntityID() *SamlConfigUpsertBulk {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.ClearIdpEntityID()
+	})
+}
+
+// SetIdpSSOURL sets the "idp_sso_url" field.
+func (u *SamlConfigUpsertBulk) SetIdpSSOURL(v string) *SamlConfigUpsertBulk {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.SetIdpSSOURL(v)
+	})
+}
+
+// UpdateIdpSSOURL sets the "idp_sso_url" field to the value that was provided on create.
+func (u *SamlConfigUpsertBulk) UpdateIdpSSOURL() *SamlConfigUpsertBulk {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.UpdateIdpSSOURL()
+	})
+}
+
+// ClearIdpSSOURL clears the value of the "idp_sso_url" field.
+func (u *SamlConfigUpsertBulk) ClearIdpSSOURL() *SamlConfigUpsertBulk {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.ClearIdpSSOURL()
+	})
+}
+
+// SetIdpCertificate sets the "idp_certificate" field.
+func (u *SamlConfigUpsertBulk) SetIdpCertificate(v string) *SamlConfigUpsertBulk {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.SetIdpCertificate(v)
+	})
+}
+
+// UpdateIdpCertificate sets the "idp_certificate" field to the value that was provided on create.
+func (u *SamlConfigUpsertBulk) UpdateIdpCertificate() *SamlConfigUpsertBulk {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.UpdateIdpCertificate()
+	})
+}
+
+// ClearIdpCertificate clears the value of the "idp_certificate" field.
+func (u *SamlConfigUpsertBulk) ClearIdpCertificate() *SamlConfigUpsertBulk {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.ClearIdpCertificate()
+	})
+}
+
+// SetNameIDFormat sets the "name_id_format" field.
+func (u *SamlConfigUpsertBulk) SetNameIDFormat(v string) *SamlConfigUpsertBulk {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.SetNameIDFormat(v)
+	})
+}
+
+// UpdateNameIDFormat sets the "name_id_format" field to the value that was provided on create.
+func (u *SamlConfigUpsertBulk) UpdateNameIDFormat() *SamlConfigUpsertBulk {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.UpdateNameIDFormat()
+	})
+}
+
+// ClearNameIDFormat clears the value of the "name_id_format" field.
+func (u *SamlConfigUpsertBulk) ClearNameIDFormat() *SamlConfigUpsertBulk {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.ClearNameIDFormat()
+	})
+}
+
+// SetAttributeMapping sets the "attribute_mapping" field.
+func (u *SamlConfigUpsertBulk) SetAttributeMapping(v map[string]string) *SamlConfigUpsertBulk {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.SetAttributeMapping(v)
+	})
+}
+
+// UpdateAttributeMapping sets the "attribute_mapping" field to the value that was provided on create.
+func (u *SamlConfigUpsertBulk) UpdateAttributeMapping() *SamlConfigUpsertBulk {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.UpdateAttributeMapping()
+	})
+}
+
+// ClearAttributeMapping clears the value of the "attribute_mapping" field.
+func (u *SamlConfigUpsertBulk) ClearAttributeMapping() *SamlConfigUpsertBulk {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.ClearAttributeMapping()
+	})
+}
+
+// SetJitProvisioning sets the "jit_provisioning" field.
+func (u *SamlConfigUpsertBulk) SetJitProvisioning(v bool) *SamlConfigUpsertBulk {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.SetJitProvisioning(v)
+	})
+}
+
+// UpdateJitProvisioning sets the "jit_provisioning" field to the value that was provided on create.
+func (u *SamlConfigUpsertBulk) UpdateJitProvisioning() *SamlConfigUpsertBulk {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.UpdateJitProvisioning()
+	})
+}
+
+// ClearJitProvisioning clears the value of the "jit_provisioning" field.
+func (u *SamlConfigUpsertBulk) ClearJitProvisioning() *SamlConfigUpsertBulk {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.ClearJitProvisioning()
+	})
+}
+
+// SetDefaultRoleIds sets the "default_role_ids" field.
+func (u *SamlConfigUpsertBulk) SetDefaultRoleIds(v []uint32) *SamlConfigUpsertBulk {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.SetDefaultRoleIds(v)
+	})
+}
+
+// UpdateDefaultRoleIds sets the "default_role_ids" field to the value that was provided on create.
+func (u *SamlConfigUpsertBulk) UpdateDefaultRoleIds() *SamlConfigUpsertBulk {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.UpdateDefaultRoleIds()
+	})
+}
+
+// ClearDefaultRoleIds clears the value of the "default_role_ids" field.
+func (u *SamlConfigUpsertBulk) ClearDefaultRoleIds() *SamlConfigUpsertBulk {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.ClearDefaultRoleIds()
+	})
+}
+
+// SetDefaultOrgUnitID sets the "default_org_unit_id" field.
+func (u *SamlConfigUpsertBulk) SetDefaultOrgUnitID(v uint32) *SamlConfigUpsertBulk {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.SetDefaultOrgUnitID(v)
+	})
+}
+
+// AddDefaultOrgUnitID adds v to the "default_org_unit_id" field.
+func (u *SamlConfigUpsertBulk) AddDefaultOrgUnitID(v uint32) *SamlConfigUpsertBulk {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.AddDefaultOrgUnitID(v)
+	})
+}
+
+// UpdateDefaultOrgUnitID sets the "default_org_unit_id" field to the value that was provided on create.
+func (u *SamlConfigUpsertBulk) UpdateDefaultOrgUnitID() *SamlConfigUpsertBulk {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.UpdateDefaultOrgUnitID()
+	})
+}
+
+// ClearDefaultOrgUnitID clears the value of the "default_org_unit_id" field.
+func (u *SamlConfigUpsertBulk) ClearDefaultOrgUnitID() *SamlConfigUpsertBulk {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.ClearDefaultOrgUnitID()
+	})
+}
+
+// SetSSOOnly sets the "sso_only" field.
+func (u *SamlConfigUpsertBulk) SetSSOOnly(v bool) *SamlConfigUpsertBulk {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.SetSSOOnly(v)
+	})
+}
+
+// UpdateSSOOnly sets the "sso_only" field to the value that was provided on create.
+func (u *SamlConfigUpsertBulk) UpdateSSOOnly() *SamlConfigUpsertBulk {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.UpdateSSOOnly()
+	})
+}
+
+// ClearSSOOnly clears the value of the "sso_only" field.
+func (u *SamlConfigUpsertBulk) ClearSSOOnly() *SamlConfigUpsertBulk {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.ClearSSOOnly()
+	})
+}
+
+// SetLoginRedirectURL sets the "login_redirect_url" field.
+func (u *SamlConfigUpsertBulk) SetLoginRedirectURL(v string) *SamlConfigUpsertBulk {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.SetLoginRedirectURL(v)
+	})
+}
+
+// UpdateLoginRedirectURL sets the "login_redirect_url" field to the value that was provided on create.
+func (u *SamlConfigUpsertBulk) UpdateLoginRedirectURL() *SamlConfigUpsertBulk {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.UpdateLoginRedirectURL()
+	})
+}
+
+// ClearLoginRedirectURL clears the value of the "login_redirect_url" field.
+func (u *SamlConfigUpsertBulk) ClearLoginRedirectURL() *SamlConfigUpsertBulk {
+	return u.Update(func(s *SamlConfigUpsert) {
+		s.ClearLoginRedirectURL()
+	})
+}
+
+// Exec executes the query.
+func (u *SamlConfigUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the SamlConfigCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SamlConfigCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SamlConfigUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
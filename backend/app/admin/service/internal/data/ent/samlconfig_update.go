@@ -0,0 +1,1217 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+	"go-wind-admin/app/admin/service/internal/data/ent/samlconfig"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// SamlConfigUpdate is the builder for updating SamlConfig entities.
+type SamlConfigUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *SamlConfigMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the SamlConfigUpdate builder.
+func (_u *SamlConfigUpdate) Where(ps ...predicate.SamlConfig) *SamlConfigUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *SamlConfigUpdate) SetUpdatedAt(v time.Time) *SamlConfigUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *SamlConfigUpdate) SetNillableUpdatedAt(v *time.Time) *SamlConfigUpdate {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *SamlConfigUpdate) ClearUpdatedAt() *SamlConfigUpdate {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *SamlConfigUpdate) SetDeletedAt(v time.Time) *SamlConfigUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *SamlConfigUpdate) SetNillableDeletedAt(v *time.Time) *SamlConfigUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *SamlConfigUpdate) ClearDeletedAt() *SamlConfigUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *SamlConfigUpdate) SetCreatedBy(v uint32) *SamlConfigUpdate {
+	_u.mutation.ResetCreatedBy()
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *SamlConfigUpdate) SetNillableCreatedBy(v *uint32) *SamlConfigUpdate {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// AddCreatedBy adds value to the "created_by" field.
+func (_u *SamlConfigUpdate) AddCreatedBy(v int32) *SamlConfigUpdate {
+	_u.mutation.AddCreatedBy(v)
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *SamlConfigUpdate) ClearCreatedBy() *SamlConfigUpdate {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_u *SamlConfigUpdate) SetUpdatedBy(v uint32) *SamlConfigUpdate {
+	_u.mutation.ResetUpdatedBy()
+	_u.mutation.SetUpdatedBy(v)
+	return _u
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_u *SamlConfigUpdate) SetNillableUpdatedBy(v *uint32) *SamlConfigUpdate {
+	if v != nil {
+		_u.SetUpdatedBy(*v)
+	}
+	return _u
+}
+
+// AddUpdatedBy adds value to the "updated_by" field.
+func (_u *SamlConfigUpdate) AddUpdatedBy(v int32) *SamlConfigUpdate {
+	_u.mutation.AddUpdatedBy(v)
+	return _u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (_u *SamlConfigUpdate) ClearUpdatedBy() *SamlConfigUpdate {
+	_u.mutation.ClearUpdatedBy()
+	return _u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_u *SamlConfigUpdate) SetDeletedBy(v uint32) *SamlConfigUpdate {
+	_u.mutation.ResetDeletedBy()
+	_u.mutation.SetDeletedBy(v)
+	return _u
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_u *SamlConfigUpdate) SetNillableDeletedBy(v *uint32) *SamlConfigUpdate {
+	if v != nil {
+		_u.SetDeletedBy(*v)
+	}
+	return _u
+}
+
+// AddDeletedBy adds value to the "deleted_by" field.
+func (_u *SamlConfigUpdate) AddDeletedBy(v int32) *SamlConfigUpdate {
+	_u.mutation.AddDeletedBy(v)
+	return _u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (_u *SamlConfigUpdate) ClearDeletedBy() *SamlConfigUpdate {
+	_u.mutation.ClearDeletedBy()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *SamlConfigUpdate) SetStatus(v samlconfig.Status) *SamlConfigUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *SamlConfigUpdate) SetNillableStatus(v *samlconfig.Status) *SamlConfigUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetDisplayName sets the "display_name" field.
+func (_u *SamlConfigUpdate) SetDisplayName(v string) *SamlConfigUpdate {
+	_u.mutation.SetDisplayName(v)
+	return _u
+}
+
+// SetNillableDisplayName sets the "display_name" field if the given value is not nil.
+func (_u *SamlConfigUpdate) SetNillableDisplayName(v *string) *SamlConfigUpdate {
+	if v != nil {
+		_u.SetDisplayName(*v)
+	}
+	return _u
+}
+
+// ClearDisplayName clears the value of the "display_name" field.
+func (_u *SamlConfigUpdate) ClearDisplayName() *SamlConfigUpdate {
+	_u.mutation.ClearDisplayName()
+	return _u
+}
+
+// SetIdpEntityID sets the "idp_entity_id" field.
+func (_u *SamlConfigUpdate) SetIdpEntityID(v string) *SamlConfigUpdate {
+	_u.mutation.SetIdpEntityID(v)
+	return _u
+}
+
+// SetNillableIdpEntityID sets the "idp_entity_id" field if the given value is not nil.
+func (_u *SamlConfigUpdate) SetNillableIdpEntityID(v *string) *SamlConfigUpdate {
+	if v != nil {
+		_u.SetIdpEntityID(*v)
+	}
+	return _u
+}
+
+// ClearIdpEntityID clears the value of the "idp_entity_id" field.
+func (_u *SamlConfigUpdate) ClearIdpEntityID() *SamlConfigUpdate {
+	_u.mutation.ClearIdpEntityID()
+	return _u
+}
+
+// SetIdpSSOURL sets the "idp_sso_url" field.
+func (_u *SamlConfigUpdate) SetIdpSSOURL(v string) *SamlConfigUpdate {
+	_u.mutation.SetIdpSSOURL(v)
+	return _u
+}
+
+// SetNillableIdpSSOURL sets the "idp_sso_url" field if the given value is not nil.
+func (_u *SamlConfigUpdate) SetNillableIdpSSOURL(v *string) *SamlConfigUpdate {
+	if v != nil {
+		_u.SetIdpSSOURL(*v)
+	}
+	return _u
+}
+
+// ClearIdpSSOURL clears the value of the "idp_sso_url" field.
+func (_u *SamlConfigUpdate) ClearIdpSSOURL() *SamlConfigUpdate {
+	_u.mutation.ClearIdpSSOURL()
+	return _u
+}
+
+// SetIdpCertificate sets the "idp_certificate" field.
+func (_u *SamlConfigUpdate) SetIdpCertificate(v string) *SamlConfigUpdate {
+	_u.mutation.SetIdpCertificate(v)
+	return _u
+}
+
+// SetNillableIdpCertificate sets the "idp_certificate" field if the given value is not nil.
+func (_u *SamlConfigUpdate) SetNillableIdpCertificate(v *string) *SamlConfigUpdate {
+	if v != nil {
+		_u.SetIdpCertificate(*v)
+	}
+	return _u
+}
+
+// ClearIdpCertificate clears the value of the "idp_certificate" field.
+func (_u *SamlConfigUpdate) ClearIdpCertificate() *SamlConfigUpdate {
+	_u.mutation.ClearIdpCertificate()
+	return _u
+}
+
+// SetNameIDFormat sets the "name_id_format" field.
+func (_u *SamlConfigUpdate) SetNameIDFormat(v string) *SamlConfigUpdate {
+	_u.mutation.SetNameIDFormat(v)
+	return _u
+}
+
+// SetNillableNameIDFormat sets the "name_id_format" field if the given value is not nil.
+func (_u *SamlConfigUpdate) SetNillableNameIDFormat(v *string) *SamlConfigUpdate {
+	if v != nil {
+		_u.SetNameIDFormat(*v)
+	}
+	return _u
+}
+
+// ClearNameIDFormat clears the value of the "name_id_format" field.
+func (_u *SamlConfigUpdate) ClearNameIDFormat() *SamlConfigUpdate {
+	_u.mutation.ClearNameIDFormat()
+	return _u
+}
+
+// SetAttributeMapping sets the "attribute_mapping" field.
+func (_u *SamlConfigUpdate) SetAttributeMapping(v map[string]string) *SamlConfigUpdate {
+	_u.mutation.SetAttributeMapping(v)
+	return _u
+}
+
+// ClearAttributeMapping clears the value of the "attribute_mapping" field.
+func (_u *SamlConfigUpdate) ClearAttributeMapping() *SamlConfigUpdate {
+	_u.mutation.ClearAttributeMapping()
+	return _u
+}
+
+// SetJitProvisioning sets the "jit_provisioning" field.
+func (_u *SamlConfigUpdate) SetJitProvisioning(v bool) *SamlConfigUpdate {
+	_u.mutation.SetJitProvisioning(v)
+	return _u
+}
+
+// SetNillableJitProvisioning sets the "jit_provisioning" field if the given value is not nil.
+func (_u *SamlConfigUpdate) SetNillableJitProvisioning(v *bool) *SamlConfigUpdate {
+	if v != nil {
+		_u.SetJitProvisioning(*v)
+	}
+	return _u
+}
+
+// ClearJitProvisioning clears the value of the "jit_provisioning" field.
+func (_u *SamlConfigUpdate) ClearJitProvisioning() *SamlConfigUpdate {
+	_u.mutation.ClearJitProvisioning()
+	return _u
+}
+
+// SetDefaultRoleIds sets the "default_role_ids" field.
+func (_u *SamlConfigUpdate) SetDefaultRoleIds(v []uint32) *SamlConfigUpdate {
+	_u.mutation.SetDefaultRoleIds(v)
+	return _u
+}
+
+// AppendDefaultRoleIds appends value to the "default_role_ids" field.
+func (_u *SamlConfigUpdate) AppendDefaultRoleIds(v []uint32) *SamlConfigUpdate {
+	_u.mutation.AppendDefaultRoleIds(v)
+	return _u
+}
+
+// ClearDefaultRoleIds clears the value of the "default_role_ids" field.
+func (_u *SamlConfigUpdate) ClearDefaultRoleIds() *SamlConfigUpdate {
+	_u.mutation.ClearDefaultRoleIds()
+	return _u
+}
+
+// SetDefaultOrgUnitID sets the "default_org_unit_id" field.
+func (_u *SamlConfigUpdate) SetDefaultOrgUnitID(v uint32) *SamlConfigUpdate {
+	_u.mutation.ResetDefaultOrgUnitID()
+	_u.mutation.SetDefaultOrgUnitID(v)
+	return _u
+}
+
+// SetNillableDefaultOrgUnitID sets the "default_org_unit_id" field if the given value is not nil.
+func (_u *SamlConfigUpdate) SetNillableDefaultOrgUnitID(v *uint32) *SamlConfigUpdate {
+	if v != nil {
+		_u.SetDefaultOrgUnitID(*v)
+	}
+	return _u
+}
+
+// AddDefaultOrgUnitID adds value to the "default_org_unit_id" field.
+func (_u *SamlConfigUpdate) AddDefaultOrgUnitID(v int32) *SamlConfigUpdate {
+	_u.mutation.AddDefaultOrgUnitID(v)
+	return _u
+}
+
+// ClearDefaultOrgUnitID clears the value of the "default_org_unit_id" field.
+func (_u *SamlConfigUpdate) ClearDefaultOrgUnitID() *SamlConfigUpdate {
+	_u.mutation.ClearDefaultOrgUnitID()
+	return _u
+}
+
+// SetSSOOnly sets the "sso_only" field.
+func (_u *SamlConfigUpdate) SetSSOOnly(v bool) *SamlConfigUpdate {
+	_u.mutation.SetSSOOnly(v)
+	return _u
+}
+
+// SetNillableSSOOnly sets the "sso_only" field if the given value is not nil.
+func (_u *SamlConfigUpdate) SetNillableSSOOnly(v *bool) *SamlConfigUpdate {
+	if v != nil {
+		_u.SetSSOOnly(*v)
+	}
+	return _u
+}
+
+// ClearSSOOnly clears the value of the "sso_only" field.
+func (_u *SamlConfigUpdate) ClearSSOOnly() *SamlConfigUpdate {
+	_u.mutation.ClearSSOOnly()
+	return _u
+}
+
+// SetLoginRedirectURL sets the "login_redirect_url" field.
+func (_u *SamlConfigUpdate) SetLoginRedirectURL(v string) *SamlConfigUpdate {
+	_u.mutation.SetLoginRedirectURL(v)
+	return _u
+}
+
+// SetNillableLoginRedirectURL sets the "login_redirect_url" field if the given value is not nil.
+func (_u *SamlConfigUpdate) SetNillableLoginRedirectURL(v *string) *SamlConfigUpdate {
+	if v != nil {
+		_u.SetLoginRedirectURL(*v)
+	}
+	return _u
+}
+
+// ClearLoginRedirectURL clears the value of the "login_redirect_url" field.
+func (_u *SamlConfigUpdate) ClearLoginRedirectURL() *SamlConfigUpdate {
+	_u.mutation.ClearLoginRedirectURL()
+	return _u
+}
+
+// Mutation returns the SamlConfigMutation object of the builder.
+func (_u *SamlConfigUpdate) Mutation() *SamlConfigMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *SamlConfigUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SamlConfigUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *SamlConfigUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SamlConfigUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SamlConfigUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := samlconfig.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "SamlConfig.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DisplayName(); ok {
+		if err := samlconfig.DisplayNameValidator(v); err != nil {
+			return &ValidationError{Name: "display_name", err: fmt.Errorf(`ent: validator failed for field "SamlConfig.display_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.IdpEntityID(); ok {
+		if err := samlconfig.IdpEntityIDValidator(v); err != nil {
+			return &ValidationError{Name: "idp_entity_id", err: fmt.Errorf(`ent: validator failed for field "SamlConfig.idp_entity_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.IdpSSOURL(); ok {
+		if err := samlconfig.IdpSSOURLValidator(v); err != nil {
+			return &ValidationError{Name: "idp_sso_url", err: fmt.Errorf(`ent: validator failed for field "SamlConfig.idp_sso_url": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.NameIDFormat(); ok {
+		if err := samlconfig.NameIDFormatValidator(v); err != nil {
+			return &ValidationError{Name: "name_id_format", err: fmt.Errorf(`ent: validator failed for field "SamlConfig.name_id_format": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LoginRedirectURL(); ok {
+		if err := samlconfig.LoginRedirectURLValidator(v); err != nil {
+			return &ValidationError{Name: "login_redirect_url", err: fmt.Errorf(`ent: validator failed for field "SamlConfig.login_redirect_url": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *SamlConfigUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SamlConfigUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *SamlConfigUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(samlconfig.Table, samlconfig.Columns, sqlgraph.NewFieldSpec(samlconfig.FieldID, field.TypeUint32))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(samlconfig.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(samlconfig.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(samlconfig.FieldUpdatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(samlconfig.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(samlconfig.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(samlconfig.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(samlconfig.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(samlconfig.FieldCreatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UpdatedBy(); ok {
+		_spec.SetField(samlconfig.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedBy(); ok {
+		_spec.AddField(samlconfig.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdatedByCleared() {
+		_spec.ClearField(samlconfig.FieldUpdatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.DeletedBy(); ok {
+		_spec.SetField(samlconfig.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedBy(); ok {
+		_spec.AddField(samlconfig.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.DeletedByCleared() {
+		_spec.ClearField(samlconfig.FieldDeletedBy, field.TypeUint32)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(samlconfig.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(samlconfig.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.DisplayName(); ok {
+		_spec.SetField(samlconfig.FieldDisplayName, field.TypeString, value)
+	}
+	if _u.mutation.DisplayNameCleared() {
+		_spec.ClearField(samlconfig.FieldDisplayName, field.TypeString)
+	}
+	if value, ok := _u.mutation.IdpEntityID(); ok {
+		_spec.SetField(samlconfig.FieldIdpEntityID, field.TypeString, value)
+	}
+	if _u.mutation.IdpEntityIDCleared() {
+		_spec.ClearField(samlconfig.FieldIdpEntityID, field.TypeString)
+	}
+	if value, ok := _u.mutation.IdpSSOURL(); ok {
+		_spec.SetField(samlconfig.FieldIdpSSOURL, field.TypeString, value)
+	}
+	if _u.mutation.IdpSSOURLCleared() {
+		_spec.ClearField(samlconfig.FieldIdpSSOURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.IdpCertificate(); ok {
+		_spec.SetField(samlconfig.FieldIdpCertificate, field.TypeString, value)
+	}
+	if _u.mutation.IdpCertificateCleared() {
+		_spec.ClearField(samlconfig.FieldIdpCertificate, field.TypeString)
+	}
+	if value, ok := _u.mutation.NameIDFormat(); ok {
+		_spec.SetField(samlconfig.FieldNameIDFormat, field.TypeString, value)
+	}
+	if _u.mutation.NameIDFormatCleared() {
+		_spec.ClearField(samlconfig.FieldNameIDFormat, field.TypeString)
+	}
+	if value, ok := _u.mutation.AttributeMapping(); ok {
+		_spec.SetField(samlconfig.FieldAttributeMapping, field.TypeJSON, value)
+	}
+	if _u.mutation.AttributeMappingCleared() {
+		_spec.ClearField(samlconfig.FieldAttributeMapping, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.JitProvisioning(); ok {
+		_spec.SetField(samlconfig.FieldJitProvisioning, field.TypeBool, value)
+	}
+	if _u.mutation.JitProvisioningCleared() {
+		_spec.ClearField(samlconfig.FieldJitProvisioning, field.TypeBool)
+	}
+	if value, ok := _u.mutation.DefaultRoleIds(); ok {
+		_spec.SetField(samlconfig.FieldDefaultRoleIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedDefaultRoleIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, samlconfig.FieldDefaultRoleIds, value)
+		})
+	}
+	if _u.mutation.DefaultRoleIdsCleared() {
+		_spec.ClearField(samlconfig.FieldDefaultRoleIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.DefaultOrgUnitID(); ok {
+		_spec.SetField(samlconfig.FieldDefaultOrgUnitID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedDefaultOrgUnitID(); ok {
+		_spec.AddField(samlconfig.FieldDefaultOrgUnitID, field.TypeUint32, value)
+	}
+	if _u.mutation.DefaultOrgUnitIDCleared() {
+		_spec.ClearField(samlconfig.FieldDefaultOrgUnitID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.SSOOnly(); ok {
+		_spec.SetField(samlconfig.FieldSSOOnly, field.TypeBool, value)
+	}
+	if _u.mutation.SSOOnlyCleared() {
+		_spec.ClearField(samlconfig.FieldSSOOnly, field.TypeBool)
+	}
+	if value, ok := _u.mutation.LoginRedirectURL(); ok {
+		_spec.SetField(samlconfig.FieldLoginRedirectURL, field.TypeString, value)
+	}
+	if _u.mutation.LoginRedirectURLCleared() {
+		_spec.ClearField(samlconfig.FieldLoginRedirectURL, field.TypeString)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{samlconfig.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// SamlConfigUpdateOne is the builder for updating a single SamlConfig entity.
+type SamlConfigUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *SamlConfigMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *SamlConfigUpdateOne) SetUpdatedAt(v time.Time) *SamlConfigUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *SamlConfigUpdateOne) SetNillableUpdatedAt(v *time.Time) *SamlConfigUpdateOne {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *SamlConfigUpdateOne) ClearUpdatedAt() *SamlConfigUpdateOne {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *SamlConfigUpdateOne) SetDeletedAt(v time.Time) *SamlConfigUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *SamlConfigUpdateOne) SetNillableDeletedAt(v *time.Time) *SamlConfigUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *SamlConfigUpdateOne) ClearDeletedAt() *SamlConfigUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *SamlConfigUpdateOne) SetCreatedBy(v uint32) *SamlConfigUpdateOne {
+	_u.mutation.ResetCreatedBy()
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *SamlConfigUpdateOne) SetNillableCreatedBy(v *uint32) *SamlConfigUpdateOne {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// AddCreatedBy adds value to the "created_by" field.
+func (_u *SamlConfigUpdateOne) AddCreatedBy(v int32) *SamlConfigUpdateOne {
+	_u.mutation.AddCreatedBy(v)
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *SamlConfigUpdateOne) ClearCreatedBy() *SamlConfigUpdateOne {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_u *SamlConfigUpdateOne) SetUpdatedBy(v uint32) *SamlConfigUpdateOne {
+	_u.mutation.ResetUpdatedBy()
+	_u.mutation.SetUpdatedBy(v)
+	return _u
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_u *SamlConfigUpdateOne) SetNillableUpdatedBy(v *uint32) *SamlConfigUpdateOne {
+	if v != nil {
+		_u.SetUpdatedBy(*v)
+	}
+	return _u
+}
+
+// AddUpdatedBy adds value to the "updated_by" field.
+func (_u *SamlConfigUpdateOne) AddUpdatedBy(v int32) *SamlConfigUpdateOne {
+	_u.mutation.AddUpdatedBy(v)
+	return _u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (_u *SamlConfigUpdateOne) ClearUpdatedBy() *SamlConfigUpdateOne {
+	_u.mutation.ClearUpdatedBy()
+	return _u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_u *SamlConfigUpdateOne) SetDeletedBy(v uint32) *SamlConfigUpdateOne {
+	_u.mutation.ResetDeletedBy()
+	_u.mutation.SetDeletedBy(v)
+	return _u
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_u *SamlConfigUpdateOne) SetNillableDeletedBy(v *uint32) *SamlConfigUpdateOne {
+	if v != nil {
+		_u.SetDeletedBy(*v)
+	}
+	return _u
+}
+
+// AddDeletedBy adds value to the "deleted_by" field.
+func (_u *SamlConfigUpdateOne) AddDeletedBy(v int32) *SamlConfigUpdateOne {
+	_u.mutation.AddDeletedBy(v)
+	return _u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (_u *SamlConfigUpdateOne) ClearDeletedBy() *SamlConfigUpdateOne {
+	_u.mutation.ClearDeletedBy()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *SamlConfigUpdateOne) SetStatus(v samlconfig.Status) *SamlConfigUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *SamlConfigUpdateOne) SetNillableStatus(v *samlconfig.Status) *SamlConfigUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetDisplayName sets the "display_name" field.
+func (_u *SamlConfigUpdateOne) SetDisplayName(v string) *SamlConfigUpdateOne {
+	_u.mutation.SetDisplayName(v)
+	return _u
+}
+
+// SetNillableDisplayName sets the "display_name" field if the given value is not nil.
+func (_u *SamlConfigUpdateOne) SetNillableDisplayName(v *string) *SamlConfigUpdateOne {
+	if v != nil {
+		_u.SetDisplayName(*v)
+	}
+	return _u
+}
+
+// ClearDisplayName clears the value of the "display_name" field.
+func (_u *SamlConfigUpdateOne) ClearDisplayName() *SamlConfigUpdateOne {
+	_u.mutation.ClearDisplayName()
+	return _u
+}
+
+// SetIdpEntityID sets the "idp_entity_id" field.
+func (_u *SamlConfigUpdateOne) SetIdpEntityID(v string) *SamlConfigUpdateOne {
+	_u.mutation.SetIdpEntityID(v)
+	return _u
+}
+
+// SetNillableIdpEntityID sets the "idp_entity_id" field if the given value is not nil.
+func (_u *SamlConfigUpdateOne) SetNillableIdpEntityID(v *string) *SamlConfigUpdateOne {
+	if v != nil {
+		_u.SetIdpEntityID(*v)
+	}
+	return _u
+}
+
+// ClearIdpEntityID clears the value of the "idp_entity_id" field.
+func (_u *SamlConfigUpdateOne) ClearIdpEntityID() *SamlConfigUpdateOne {
+	_u.mutation.ClearIdpEntityID()
+	return _u
+}
+
+// SetIdpSSOURL sets the "idp_sso_url" field.
+func (_u *SamlConfigUpdateOne) SetIdpSSOURL(v string) *SamlConfigUpdateOne {
+	_u.mutation.SetIdpSSOURL(v)
+	return _u
+}
+
+// SetNillableIdpSSOURL sets the "idp_sso_url" field if the given value is not nil.
+func (_u *SamlConfigUpdateOne) SetNillableIdpSSOURL(v *string) *SamlConfigUpdateOne {
+	if v != nil {
+		_u.SetIdpSSOURL(*v)
+	}
+	return _u
+}
+
+// ClearIdpSSOURL clears the value of the "idp_sso_url" field.
+func (_u *SamlConfigUpdateOne) ClearIdpSSOURL() *SamlConfigUpdateOne {
+	_u.mutation.ClearIdpSSOURL()
+	return _u
+}
+
+// SetIdpCertificate sets the "idp_certificate" field.
+func (_u *SamlConfigUpdateOne) SetIdpCertificate(v string) *SamlConfigUpdateOne {
+	_u.mutation.SetIdpCertificate(v)
+	return _u
+}
+
+// SetNillableIdpCertificate sets the "idp_certificate" field if the given value is not nil.
+func (_u *SamlConfigUpdateOne) SetNillableIdpCertificate(v *string) *SamlConfigUpdateOne {
+	if v != nil {
+		_u.SetIdpCertificate(*v)
+	}
+	return _u
+}
+
+// ClearIdpCertificate clears the value of the "idp_certificate" field.
+func (_u *SamlConfigUpdateOne) ClearIdpCertificate() *SamlConfigUpdateOne {
+	_u.mutation.ClearIdpCertificate()
+	return _u
+}
+
+// SetNameIDFormat sets the "name_id_format" field.
+func (_u *SamlConfigUpdateOne) SetNameIDFormat(v string) *SamlConfigUpdateOne {
+	_u.mutation.SetNameIDFormat(v)
+	return _u
+}
+
+// SetNillableNameIDFormat sets the "name_id_format" field if the given value is not nil.
+func (_u *SamlConfigUpdateOne) SetNillableNameIDFormat(v *string) *SamlConfigUpdateOne {
+	if v != nil {
+		_u.SetNameIDFormat(*v)
+	}
+	return _u
+}
+
+// ClearNameIDFormat clears the value of the "name_id_format" field.
+func (_u *SamlConfigUpdateOne) ClearNameIDFormat() *SamlConfigUpdateOne {
+	_u.mutation.ClearNameIDFormat()
+	return _u
+}
+
+// SetAttributeMapping sets the "attribute_mapping" field.
+func (_u *SamlConfigUpdateOne) SetAttributeMapping(v map[string]string) *SamlConfigUpdateOne {
+	_u.mutation.SetAttributeMapping(v)
+	return _u
+}
+
+// ClearAttributeMapping clears the value of the "attribute_mapping" field.
+func (_u *SamlConfigUpdateOne) ClearAttributeMapping() *SamlConfigUpdateOne {
+	_u.mutation.ClearAttributeMapping()
+	return _u
+}
+
+// SetJitProvisioning sets the "jit_provisioning" field.
+func (_u *SamlConfigUpdateOne) SetJitProvisioning(v bool) *SamlConfigUpdateOne {
+	_u.mutation.SetJitProvisioning(v)
+	return _u
+}
+
+// SetNillableJitProvisioning sets the "jit_provisioning" field if the given value is not nil.
+func (_u *SamlConfigUpdateOne) SetNillableJitProvisioning(v *bool) *SamlConfigUpdateOne {
+	if v != nil {
+		_u.SetJitProvisioning(*v)
+	}
+	return _u
+}
+
+// ClearJitProvisioning clears the value of the "jit_provisioning" field.
+func (_u *SamlConfigUpdateOne) ClearJitProvisioning() *SamlConfigUpdateOne {
+	_u.mutation.ClearJitProvisioning()
+	return _u
+}
+
+// SetDefaultRoleIds sets the "default_role_ids" field.
+func (_u *SamlConfigUpdateOne) SetDefaultRoleIds(v []uint32) *SamlConfigUpdateOne {
+	_u.mutation.SetDefaultRoleIds(v)
+	return _u
+}
+
+// AppendDefaultRoleIds appends value to the "default_role_ids" field.
+func (_u *SamlConfigUpdateOne) AppendDefaultRoleIds(v []uint32) *SamlConfigUpdateOne {
+	_u.mutation.AppendDefaultRoleIds(v)
+	return _u
+}
+
+// ClearDefaultRoleIds clears the value of the "default_role_ids" field.
+func (_u *SamlConfigUpdateOne) ClearDefaultRoleIds() *SamlConfigUpdateOne {
+	_u.mutation.ClearDefaultRoleIds()
+	return _u
+}
+
+// SetDefaultOrgUnitID sets the "default_org_unit_id" field.
+func (_u *SamlConfigUpdateOne) SetDefaultOrgUnitID(v uint32) *SamlConfigUpdateOne {
+	_u.mutation.ResetDefaultOrgUnitID()
+	_u.mutation.SetDefaultOrgUnitID(v)
+	return _u
+}
+
+// SetNillableDefaultOrgUnitID sets the "default_org_unit_id" field if the given value is not nil.
+func (_u *SamlConfigUpdateOne) SetNillableDefaultOrgUnitID(v *uint32) *SamlConfigUpdateOne {
+	if v != nil {
+		_u.SetDefaultOrgUnitID(*v)
+	}
+	return _u
+}
+
+// AddDefaultOrgUnitID adds value to the "default_org_unit_id" field.
+func (_u *SamlConfigUpdateOne) AddDefaultOrgUnitID(v int32) *SamlConfigUpdateOne {
+	_u.mutation.AddDefaultOrgUnitID(v)
+	return _u
+}
+
+// ClearDefaultOrgUnitID clears the value of the "default_org_unit_id" field.
+func (_u *SamlConfigUpdateOne) ClearDefaultOrgUnitID() *SamlConfigUpdateOne {
+	_u.mutation.ClearDefaultOrgUnitID()
+	return _u
+}
+
+// SetSSOOnly sets the "sso_only" field.
+func (_u *SamlConfigUpdateOne) SetSSOOnly(v bool) *SamlConfigUpdateOne {
+	_u.mutation.SetSSOOnly(v)
+	return _u
+}
+
+// SetNillableSSOOnly sets the "sso_only" field if the given value is not nil.
+func (_u *SamlConfigUpdateOne) SetNillableSSOOnly(v *bool) *SamlConfigUpdateOne {
+	if v != nil {
+		_u.SetSSOOnly(*v)
+	}
+	return _u
+}
+
+// ClearSSOOnly clears the value of the "sso_only" field.
+func (_u *SamlConfigUpdateOne) ClearSSOOnly() *SamlConfigUpdateOne {
+	_u.mutation.ClearSSOOnly()
+	return _u
+}
+
+// SetLoginRedirectURL sets the "login_redirect_url" field.
+func (_u *SamlConfigUpdateOne) SetLoginRedirectURL(v string) *SamlConfigUpdateOne {
+	_u.mutation.SetLoginRedirectURL(v)
+	return _u
+}
+
+// SetNillableLoginRedirectURL sets the "login_redirect_url" field if the given value is not nil.
+func (_u *SamlConfigUpdateOne) SetNillableLoginRedirectURL(v *string) *SamlConfigUpdateOne {
+	if v != nil {
+		_u.SetLoginRedirectURL(*v)
+	}
+	return _u
+}
+
+// ClearLoginRedirectURL clears the value of the "login_redirect_url" field.
+func (_u *SamlConfigUpdateOne) ClearLoginRedirectURL() *SamlConfigUpdateOne {
+	_u.mutation.ClearLoginRedirectURL()
+	return _u
+}
+
+// Mutation returns the SamlConfigMutation object of the builder.
+func (_u *SamlConfigUpdateOne) Mutation() *SamlConfigMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the SamlConfigUpdate builder.
+func (_u *SamlConfigUpdateOne) Where(ps ...predicate.SamlConfig) *SamlConfigUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *SamlConfigUpdateOne) Select(field string, fields ...string) *SamlConfigUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated SamlConfig entity.
+func (_u *SamlConfigUpdateOne) Save(ctx context.Context) (*SamlConfig, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SamlConfigUpdateOne) SaveX(ctx context.Context) *SamlConfig {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *SamlConfigUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SamlConfigUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SamlConfigUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := samlconfig.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "SamlConfig.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DisplayName(); ok {
+		if err := samlconfig.DisplayNameValidator(v); err != nil {
+			return &ValidationError{Name: "display_name", err: fmt.Errorf(`ent: validator failed for field "SamlConfig.display_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.IdpEntityID(); ok {
+		if err := samlconfig.IdpEntityIDValidator(v); err != nil {
+			return &ValidationError{Name: "idp_entity_id", err: fmt.Errorf(`ent: validator failed for field "SamlConfig.idp_entity_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.IdpSSOURL(); ok {
+		if err := samlconfig.IdpSSOURLValidator(v); err != nil {
+			return &ValidationError{Name: "idp_sso_url", err: fmt.Errorf(`ent: validator failed for field "SamlConfig.idp_sso_url": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.NameIDFormat(); ok {
+		if err := samlconfig.NameIDFormatValidator(v); err != nil {
+			return &ValidationError{Name: "name_id_format", err: fmt.Errorf(`ent: validator failed for field "SamlConfig.name_id_format": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LoginRedirectURL(); ok {
+		if err := samlconfig.LoginRedirectURLValidator(v); err != nil {
+			return &ValidationError{Name: "login_redirect_url", err: fmt.Errorf(`ent: validator failed for field "SamlConfig.login_redirect_url": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *SamlConfigUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SamlConfigUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *SamlConfigUpdateOne) sqlSave(ctx context.Context) (_node *SamlConfig, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(samlconfig.Table, samlconfig.Columns, sqlgraph.NewFieldSpec(samlconfig.FieldID, field.TypeUint32))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SamlConfig.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, samlconfig.FieldID)
+		for _, f := range fields {
+			if !samlconfig.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != samlconfig.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(samlconfig.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(samlconfig.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(samlconfig.FieldUpdatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(samlconfig.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(samlconfig.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(samlconfig.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(samlconfig.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(samlconfig.FieldCreatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UpdatedBy(); ok {
+		_spec.SetField(samlconfig.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedBy(); ok {
+		_spec.AddField(samlconfig.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdatedByCleared() {
+		_spec.ClearField(samlconfig.FieldUpdatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.DeletedBy(); ok {
+		_spec.SetField(samlconfig.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedBy(); ok {
+		_spec.AddField(samlconfig.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.DeletedByCleared() {
+		_spec.ClearField(samlconfig.FieldDeletedBy, field.TypeUint32)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(samlconfig.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(samlconfig.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.DisplayName(); ok {
+		_spec.SetField(samlconfig.FieldDisplayName, field.TypeString, value)
+	}
+	if _u.mutation.DisplayNameCleared() {
+		_spec.ClearField(samlconfig.FieldDisplayName, field.TypeString)
+	}
+	if value, ok := _u.mutation.IdpEntityID(); ok {
+		_spec.SetField(samlconfig.FieldIdpEntityID, field.TypeString, value)
+	}
+	if _u.mutation.IdpEntityIDCleared() {
+		_spec.ClearField(samlconfig.FieldIdpEntityID, field.TypeString)
+	}
+	if value, ok := _u.mutation.IdpSSOURL(); ok {
+		_spec.SetField(samlconfig.FieldIdpSSOURL, field.TypeString, value)
+	}
+	if _u.mutation.IdpSSOURLCleared() {
+		_spec.ClearField(samlconfig.FieldIdpSSOURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.IdpCertificate(); ok {
+		_spec.SetField(samlconfig.FieldIdpCertificate, field.TypeString, value)
+	}
+	if _u.mutation.IdpCertificateCleared() {
+		_spec.ClearField(samlconfig.FieldIdpCertificate, field.TypeString)
+	}
+	if value, ok := _u.mutation.NameIDFormat(); ok {
+		_spec.SetField(samlconfig.FieldNameIDFormat, field.TypeString, value)
+	}
+	if _u.mutation.NameIDFormatCleared() {
+		_spec.ClearField(samlconfig.FieldNameIDFormat, field.TypeString)
+	}
+	if value, ok := _u.mutation.AttributeMapping(); ok {
+		_spec.SetField(samlconfig.FieldAttributeMapping, field.TypeJSON, value)
+	}
+	if _u.mutation.AttributeMappingCleared() {
+		_spec.ClearField(samlconfig.FieldAttributeMapping, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.JitProvisioning(); ok {
+		_spec.SetField(samlconfig.FieldJitProvisioning, field.TypeBool, value)
+	}
+	if _u.mutation.JitProvisioningCleared() {
+		_spec.ClearField(samlconfig.FieldJitProvisioning, field.TypeBool)
+	}
+	if value, ok := _u.mutation.DefaultRoleIds(); ok {
+		_spec.SetField(samlconfig.FieldDefaultRoleIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedDefaultRoleIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, samlconfig.FieldDefaultRoleIds, value)
+		})
+	}
+	if _u.mutation.DefaultRoleIdsCleared() {
+		_spec.ClearField(samlconfig.FieldDefaultRoleIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.DefaultOrgUnitID(); ok {
+		_spec.SetField(samlconfig.FieldDefaultOrgUnitID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedDefaultOrgUnitID(); ok {
+		_spec.AddField(samlconfig.FieldDefaultOrgUnitID, field.TypeUint32, value)
+	}
+	if _u.mutation.DefaultOrgUnitIDCleared() {
+		_spec.ClearField(samlconfig.FieldDefaultOrgUnitID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.SSOOnly(); ok {
+		_spec.SetField(samlconfig.FieldSSOOnly, field.TypeBool, value)
+	}
+	if _u.mutation.SSOOnlyCleared() {
+		_spec.ClearField(samlconfig.FieldSSOOnly, field.TypeBool)
+	}
+	if value, ok := _u.mutation.LoginRedirectURL(); ok {
+		_spec.SetField(samlconfig.FieldLoginRedirectURL, field.TypeString, value)
+	}
+	if _u.mutation.LoginRedirectURLCleared() {
+		_spec.ClearField(samlconfig.FieldLoginRedirectURL, field.TypeString)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &SamlConfig{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{samlconfig.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,65 @@
+package schema
+
+import (
+	"context"
+
+	"entgo.io/ent"
+	"github.com/tx7do/go-crud/viewer"
+
+	entgen "go-wind-admin/app/admin/service/internal/data/ent"
+	"go-wind-admin/app/admin/service/internal/data/ent/privacy"
+)
+
+// actionPermissionRule 动作级权限：租户视图写入前校验 Viewer 具备对应实体的动作权限，
+// 创建/更新/删除分别对应 create/edit/delete（如 Position 的更新需要 position:edit）；平台/系统视图不校验。
+// 挂载于用户、角色、组织单元、租户与职位；登录、注册及 SAML/OIDC JIT 开通等认证前流程经 privacy.DecisionContext 放行，
+// LDAP 同步等后台任务以系统视图运行，均不受此规则约束；SCIM 令牌的租户视图携带 user:*、org-unit:*、role:* 动作权限。
+// Viewer 的权限码由认证中间件按角色解析（见 data.PermissionCodeResolver），租户管理员角色内置 constants.ActionPermissionCodes，
+// 未解析出权限时拒绝写入。
+func actionPermissionRule() privacy.MutationRule {
+	return privacy.MutationRuleFunc(func(ctx context.Context, m ent.Mutation) error {
+		vc, ok := viewer.FromContext(ctx)
+		if !ok || vc == nil {
+			return privacy.Denyf("viewer-context is missing")
+		}
+		if vc.IsPlatformContext() || vc.IsSystemContext() {
+			return privacy.Skip
+		}
+
+		action := mutationAction(m.Op())
+		if !vc.HasPermission(action, m.Type()) {
+			return privacy.Denyf("permission denied: %s %s", action, m.Type())
+		}
+		return privacy.Skip
+	})
+}
+
+// selfUpdateRule 本人资料的更新（个人中心修改资料、头像等）不要求 user:edit，可更新的字段由接口层限定
+func selfUpdateRule() privacy.MutationRule {
+	return privacy.UserMutationRuleFunc(func(ctx context.Context, m *entgen.UserMutation) error {
+		if !m.Op().Is(ent.OpUpdateOne) {
+			return privacy.Skip
+		}
+
+		vc, ok := viewer.FromContext(ctx)
+		if !ok || vc == nil || vc.UserID() == 0 {
+			return privacy.Skip
+		}
+		if id, exists := m.ID(); exists && uint64(id) == vc.UserID() {
+			return privacy.Allow
+		}
+		return privacy.Skip
+	})
+}
+
+// mutationAction 将 Ent 变更类型映射为权限动作
+func mutationAction(op ent.Op) string {
+	switch {
+	case op.Is(ent.OpCreate):
+		return "create"
+	case op.Is(ent.OpDelete | ent.OpDeleteOne):
+		return "delete"
+	default:
+		return "edit"
+	}
+}
@@ -42,6 +42,16 @@ func (ApiAuditLog) Fields() []ent.Field {
 			Optional().
 			Nillable(),
 
+		field.Uint32("impersonator_id").
+			Comment("代登录操作人用户ID").
+			Optional().
+			Nillable(),
+
+		field.String("impersonator_name").
+			Comment("代登录操作人账号名").
+			Optional().
+			Nillable(),
+
 		field.String("ip_address").
 			Comment("IP地址").
 			Optional().
@@ -0,0 +1,143 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// ApiClient holds the schema definition for the ApiClient entity.
+// 机器对机器（client_credentials 授权）的服务客户端。client_secret 与
+// IDENTITY_API_KEY/API_SECRET 凭证同规：只存 bcrypt 哈希，明文仅在创建/轮换时返回一次。
+type ApiClient struct {
+	ent.Schema
+}
+
+func (ApiClient) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{
+			Table:     "sys_api_clients",
+			Charset:   "utf8mb4",
+			Collation: "utf8mb4_bin",
+		},
+		entsql.WithComments(true),
+		schema.Comment("服务客户端表"),
+	}
+}
+
+// Fields of the ApiClient.
+func (ApiClient) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			Comment("客户端名称").
+			MaxLen(128).
+			NotEmpty().
+			Nillable().
+			Optional(),
+
+		// client_id 全局唯一：client_credentials 登录不携带 tenant_code，按 client_id 反查归属租户。
+		field.String("client_id").
+			Comment("客户端ID").
+			MaxLen(64).
+			NotEmpty().
+			Immutable().
+			Nillable().
+			Optional(),
+
+		field.String("client_secret_hash").
+			Comment("客户端密钥哈希（bcrypt，不要存明文）").
+			MaxLen(255).
+			NotEmpty().
+			Sensitive().
+			Nillable().
+			Optional(),
+
+		field.JSON("role_ids", []uint32{}).
+			Comment("授权角色ID列表").
+			SchemaType(map[string]string{
+				dialect.MySQL:    "json",
+				dialect.Postgres: "jsonb",
+			}).
+			Optional(),
+
+		// 授权码模式（authorization_code + PKCE）登记信息；redirect_uris 为空即不启用该模式
+		field.JSON("redirect_uris", []string{}).
+			Comment("授权码模式允许的回调地址列表").
+			SchemaType(map[string]string{
+				dialect.MySQL:    "json",
+				dialect.Postgres: "jsonb",
+			}).
+			Optional(),
+
+		field.JSON("scopes", []string{}).
+			Comment("授权码模式允许申请的授权范围列表").
+			SchemaType(map[string]string{
+				dialect.MySQL:    "json",
+				dialect.Postgres: "jsonb",
+			}).
+			Optional(),
+
+		field.Bool("public_client").
+			Comment("是否公共客户端（无法保管密钥，仅凭 PKCE 换取令牌）").
+			Default(false).
+			Nillable().
+			Optional(),
+
+		field.Time("expires_at").
+			Comment("客户端过期时间，为空表示永不过期").
+			Nillable().
+			Optional(),
+
+		field.Time("secret_rotated_at").
+			Comment("密钥最近一次轮换时间").
+			Nillable().
+			Optional(),
+
+		field.Time("last_used_at").
+			Comment("最近一次换取令牌的时间").
+			Nillable().
+			Optional(),
+
+		field.String("last_used_ip").
+			Comment("最近一次换取令牌的客户端IP").
+			MaxLen(64).
+			Nillable().
+			Optional(),
+	}
+}
+
+// Mixin of the ApiClient.
+func (ApiClient) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AutoIncrementId{},
+		mixin.TimeAt{},
+		mixin.OperatorID{},
+		mixin.Description{},
+		mixin.TenantID[uint32]{},
+		mixin.SwitchStatus{},
+	}
+}
+
+// Indexes of the ApiClient.
+func (ApiClient) Indexes() []ent.Index {
+	return []ent.Index{
+		// client_id 全局唯一，登录时按其精确查找
+		index.Fields("client_id").
+			Unique().
+			StorageKey("uidx_sys_api_client_client_id"),
+
+		// 在租户范围内保证客户端名称不重复
+		index.Fields("tenant_id", "name").
+			Unique().
+			StorageKey("uidx_sys_api_client_tenant_name"),
+
+		// 按租户 + 状态过滤
+		index.Fields("tenant_id", "status").
+			StorageKey("idx_sys_api_client_tenant_status"),
+	}
+}
@@ -0,0 +1,18 @@
+package schema
+
+import (
+	"context"
+
+	"github.com/tx7do/go-crud/entgo/rule"
+
+	"go-wind-admin/app/admin/service/internal/data/ent/privacy"
+)
+
+// dataScopeRule 数据权限行过滤：按 Viewer 的数据范围限定 org_unit_id（组织单元）或 created_by（本人），
+// 多个范围取并集；平台/系统视图不过滤。适用于同时具备 org_unit_id 与 created_by 字段的组织归属表。
+// Viewer 的数据范围由数据层按角色配置解析（见 data.DataScopeResolver），未解析出有效范围时拒绝访问。
+func dataScopeRule() privacy.QueryMutationRule {
+	return privacy.FilterFunc(func(ctx context.Context, f privacy.Filter) error {
+		return rule.PermissionRule(ctx, f)
+	})
+}
@@ -0,0 +1,106 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// JwtSigningKey holds the schema definition for the JwtSigningKey entity.
+// JWT 签名密钥环（平台级，不分租户）。同一时刻只有一把 ACTIVE 密钥签发令牌，
+// 退役密钥保留到 verify_until 以校验其签发的存量令牌。密钥材料经 pkg/crypto 加密落库。
+type JwtSigningKey struct {
+	ent.Schema
+}
+
+func (JwtSigningKey) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{
+			Table:     "sys_jwt_signing_keys",
+			Charset:   "utf8mb4",
+			Collation: "utf8mb4_bin",
+		},
+		entsql.WithComments(true),
+		schema.Comment("JWT签名密钥表"),
+	}
+}
+
+// Fields of the JwtSigningKey.
+func (JwtSigningKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("kid").
+			Comment("密钥ID（JWT 头部 kid）").
+			MaxLen(64).
+			NotEmpty().
+			Immutable().
+			Nillable().
+			Optional(),
+
+		field.String("algorithm").
+			Comment("签名算法").
+			MaxLen(16).
+			NotEmpty().
+			Immutable().
+			Nillable().
+			Optional(),
+
+		field.Text("key_material").
+			Comment("密钥材料（非对称为 PEM 私钥，对称为 base64url 秘钥），加密存储").
+			NotEmpty().
+			Immutable().
+			Sensitive().
+			Nillable().
+			Optional(),
+
+		field.Enum("status").
+			Comment("密钥状态").
+			NamedValues(
+				"Active", "ACTIVE",
+				"Retired", "RETIRED",
+			).
+			Default("ACTIVE").
+			Nillable().
+			Optional(),
+
+		field.Time("activated_at").
+			Comment("启用时间").
+			Nillable().
+			Optional(),
+
+		field.Time("retired_at").
+			Comment("退役时间").
+			Nillable().
+			Optional(),
+
+		field.Time("verify_until").
+			Comment("校验截止时间：退役密钥在此之前仍接受其签发的令牌").
+			Nillable().
+			Optional(),
+	}
+}
+
+// Mixin of the JwtSigningKey.
+func (JwtSigningKey) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AutoIncrementId{},
+		mixin.TimeAt{},
+		mixin.OperatorID{},
+	}
+}
+
+// Indexes of the JwtSigningKey.
+func (JwtSigningKey) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("kid").
+			Unique().
+			StorageKey("uidx_sys_jwt_signing_key_kid"),
+
+		// 加载密钥环：按状态 + 校验截止时间过滤
+		index.Fields("status", "verify_until").
+			StorageKey("idx_sys_jwt_signing_key_status_verify_until"),
+	}
+}
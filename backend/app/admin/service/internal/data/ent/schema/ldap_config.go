@@ -0,0 +1,195 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// LdapConfig holds the schema definition for the LdapConfig entity.
+// 租户维度的 LDAP / Active Directory 配置，每个租户至多一条。bind_password 经 crypto.EncryptIfNeeded 加密落库。
+// 目录账号绑定关系存于 user_credential（identity_type=ENTERPRISE_SSO，credential_type=LDAP_BIND）。
+type LdapConfig struct {
+	ent.Schema
+}
+
+func (LdapConfig) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{
+			Table:     "sys_ldap_configs",
+			Charset:   "utf8mb4",
+			Collation: "utf8mb4_bin",
+		},
+		entsql.WithComments(true),
+		schema.Comment("LDAP 目录认证与同步配置表"),
+	}
+}
+
+// Fields of the LdapConfig.
+func (LdapConfig) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("display_name").
+			Comment("显示名称").
+			MaxLen(64).
+			Nillable().
+			Optional(),
+
+		field.String("server_url").
+			Comment("目录地址（ldap:// 或 ldaps://）").
+			MaxLen(512).
+			NotEmpty().
+			Nillable().
+			Optional(),
+
+		field.Bool("start_tls").
+			Comment("ldap:// 连接是否升级 StartTLS").
+			Default(false).
+			Nillable().
+			Optional(),
+
+		field.Text("ca_certificate").
+			Comment("校验目录服务器证书的 CA（PEM），为空使用系统根证书").
+			Nillable().
+			Optional(),
+
+		field.String("bind_dn").
+			Comment("检索用服务账号 DN，为空匿名检索").
+			MaxLen(512).
+			Nillable().
+			Optional(),
+
+		field.String("bind_password").
+			Comment("服务账号密码（加密存储）").
+			MaxLen(1024).
+			Sensitive().
+			Nillable().
+			Optional(),
+
+		field.String("base_dn").
+			Comment("检索起点 DN").
+			MaxLen(512).
+			NotEmpty().
+			Nillable().
+			Optional(),
+
+		field.String("user_filter").
+			Comment("用户过滤器，含 {username} 占位符").
+			MaxLen(1024).
+			NotEmpty().
+			Nillable().
+			Optional(),
+
+		field.String("id_attribute").
+			Comment("条目唯一标识属性（entryUUID / objectGUID），为空以 DN 作标识").
+			MaxLen(64).
+			Nillable().
+			Optional(),
+
+		// 键为本地字段（username/email/nickname/realname/mobile），值为目录属性名
+		field.JSON("attribute_mapping", map[string]string{}).
+			Comment("用户属性映射").
+			SchemaType(map[string]string{
+				dialect.MySQL:    "json",
+				dialect.Postgres: "jsonb",
+			}).
+			Optional(),
+
+		field.String("group_filter").
+			Comment("组过滤器，为空不同步组").
+			MaxLen(1024).
+			Nillable().
+			Optional(),
+
+		field.String("group_member_attribute").
+			Comment("组成员属性（值为成员 DN）").
+			MaxLen(64).
+			Nillable().
+			Optional(),
+
+		// 键为组 DN，值为本地角色ID；只有映射中的角色由同步维护，手工授予的其他角色不受影响
+		field.JSON("group_role_mapping", map[string]uint32{}).
+			Comment("组到角色的映射").
+			SchemaType(map[string]string{
+				dialect.MySQL:    "json",
+				dialect.Postgres: "jsonb",
+			}).
+			Optional(),
+
+		field.String("org_unit_filter").
+			Comment("组织单元过滤器，为空不同步组织单元").
+			MaxLen(1024).
+			Nillable().
+			Optional(),
+
+		field.Uint32("org_unit_root_id").
+			Comment("同步的顶层组织单元挂载的本地上级组织单元ID").
+			Nillable().
+			Optional(),
+
+		field.Enum("auth_mode").
+			Comment("认证模式").
+			NamedValues(
+				"LdapFirst", "LDAP_FIRST",
+				"LdapOnly", "LDAP_ONLY",
+			).
+			Default("LDAP_FIRST").
+			Nillable().
+			Optional(),
+
+		field.Bool("jit_provisioning").
+			Comment("目录账号首次登录且尚未同步时是否自动创建用户").
+			Default(false).
+			Nillable().
+			Optional(),
+
+		field.JSON("default_role_ids", []uint32{}).
+			Comment("新建用户时授予的角色ID列表").
+			SchemaType(map[string]string{
+				dialect.MySQL:    "json",
+				dialect.Postgres: "jsonb",
+			}).
+			Optional(),
+
+		field.Uint32("default_org_unit_id").
+			Comment("新建用户且目录未给出组织单元时归属的组织单元ID").
+			Nillable().
+			Optional(),
+
+		field.Time("last_synced_at").
+			Comment("最近一次同步时间").
+			Nillable().
+			Optional(),
+
+		field.String("last_sync_message").
+			Comment("最近一次同步结果").
+			MaxLen(1024).
+			Nillable().
+			Optional(),
+	}
+}
+
+// Mixin of the LdapConfig.
+func (LdapConfig) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AutoIncrementId{},
+		mixin.TimeAt{},
+		mixin.OperatorID{},
+		mixin.TenantID[uint32]{},
+		mixin.SwitchStatus{},
+	}
+}
+
+// Indexes of the LdapConfig.
+func (LdapConfig) Indexes() []ent.Index {
+	return []ent.Index{
+		// 每个租户只能配置一个目录
+		index.Fields("tenant_id").
+			Unique().
+			StorageKey("uidx_sys_ldap_config_tenant"),
+	}
+}
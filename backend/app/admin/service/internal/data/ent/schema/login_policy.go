@@ -31,7 +31,7 @@ func (LoginPolicy) Annotations() []schema.Annotation {
 func (LoginPolicy) Fields() []ent.Field {
 	return []ent.Field{
 		field.Uint32("target_id").
-			Comment("目标用户ID").
+			Comment("目标ID（用户ID或组织单元ID）").
 			Optional().
 			Nillable(),
 
@@ -67,6 +67,22 @@ func (LoginPolicy) Fields() []ent.Field {
 			Default("IP").
 			Optional().
 			Nillable(),
+
+		field.Enum("target_type").
+			Comment("生效对象类型").
+			NamedValues(
+				"Global", "GLOBAL",
+				"User", "USER",
+				"Role", "ROLE",
+				"OrgUnit", "ORG_UNIT",
+			).
+			Optional().
+			Nillable(),
+
+		field.String("target_code").
+			Comment("目标编码（角色码）").
+			Optional().
+			Nillable(),
 	}
 }
 
@@ -84,7 +100,7 @@ func (LoginPolicy) Mixin() []ent.Mixin {
 func (LoginPolicy) Indexes() []ent.Index {
 	return []ent.Index{
 		// 在租户维度上保证同一目标 + 类型 + 方式 的唯一性，防止重复策略
-		index.Fields("tenant_id", "target_id", "type", "method").Unique().
+		index.Fields("tenant_id", "target_type", "target_id", "target_code", "type", "method").Unique().
 			StorageKey("uidx_sys_login_policy_tenant_target_type_method"),
 
 		// 常用查询：按租户 + 类型 + 方式 列表策略
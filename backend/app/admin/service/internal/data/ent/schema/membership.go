@@ -8,6 +8,8 @@
 	"entgo.io/ent/schema/index"
 
 	"github.com/tx7do/go-crud/entgo/mixin"
+
+	"go-wind-admin/app/admin/service/internal/data/ent/privacy"
 )
 
 // tenant_id 为 NULL 或者 0 时代表全局或个人系统成员。
@@ -157,3 +159,15 @@ func (Membership) Indexes() []ent.Index {
 			StorageKey("idx_sys_membership_created_at"),
 	}
 }
+
+// Policy of the Membership.
+func (Membership) Policy() ent.Policy {
+	return privacy.Policy{
+		Query: privacy.QueryPolicy{
+			memberDataScopeRule(),
+		},
+		Mutation: privacy.MutationPolicy{
+			memberDataScopeRule(),
+		},
+	}
+}
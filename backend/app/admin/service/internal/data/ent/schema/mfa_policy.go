@@ -0,0 +1,76 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// MfaPolicy holds the schema definition for the MfaPolicy entity.
+// 租户维度的 MFA 策略，每个租户至多一条；tenant_id=0 为平台策略，作用于全部用户。
+// 登录时平台策略与用户所在租户策略取较严格者：要求启用而用户尚无因子时只签发强制注册用的登录挑战。
+type MfaPolicy struct {
+	ent.Schema
+}
+
+func (MfaPolicy) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{
+			Table:     "sys_mfa_policies",
+			Charset:   "utf8mb4",
+			Collation: "utf8mb4_bin",
+		},
+		entsql.WithComments(true),
+		schema.Comment("MFA 策略表"),
+	}
+}
+
+// Fields of the MfaPolicy.
+func (MfaPolicy) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("enforcement").
+			Comment("对全体用户的要求").
+			NamedValues(
+				"NotRequired", "NOT_REQUIRED",
+				"Optional", "OPTIONAL",
+				"Required", "REQUIRED",
+			).
+			Default("NOT_REQUIRED").
+			Nillable().
+			Optional(),
+
+		// 角色代码（如 platform:admin、tenant:manager），持有任一角色的用户必须启用 MFA
+		field.JSON("required_role_codes", []string{}).
+			Comment("强制启用 MFA 的角色代码列表").
+			SchemaType(map[string]string{
+				dialect.MySQL:    "json",
+				dialect.Postgres: "jsonb",
+			}).
+			Optional(),
+	}
+}
+
+// Mixin of the MfaPolicy.
+func (MfaPolicy) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AutoIncrementId{},
+		mixin.TimeAt{},
+		mixin.OperatorID{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+// Indexes of the MfaPolicy.
+func (MfaPolicy) Indexes() []ent.Index {
+	return []ent.Index{
+		// 每个租户只有一条策略
+		index.Fields("tenant_id").
+			Unique().
+			StorageKey("uidx_sys_mfa_policy_tenant"),
+	}
+}
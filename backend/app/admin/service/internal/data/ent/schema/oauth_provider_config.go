@@ -0,0 +1,144 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// OAuthProviderConfig holds the schema definition for the OAuthProviderConfig entity.
+// 租户维度的第三方登录提供方配置。client_secret 经 crypto.EncryptIfNeeded 加密落库，
+// 第三方账号绑定关系存于 user_credential（identity_type=SOCIAL_OAUTH）。
+type OAuthProviderConfig struct {
+	ent.Schema
+}
+
+func (OAuthProviderConfig) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{
+			Table:     "sys_oauth_provider_configs",
+			Charset:   "utf8mb4",
+			Collation: "utf8mb4_bin",
+		},
+		entsql.WithComments(true),
+		schema.Comment("第三方登录提供方配置表"),
+	}
+}
+
+// Fields of the OAuthProviderConfig.
+func (OAuthProviderConfig) Fields() []ent.Field {
+	return []ent.Field{
+		// 预置提供方名称（github/google/microsoft/dingtalk/wechat/oidc），对应 social.Registry 注册名
+		field.String("provider").
+			Comment("提供方").
+			MaxLen(32).
+			NotEmpty().
+			Immutable().
+			Nillable().
+			Optional(),
+
+		// 自定义标识：通用 OIDC 必填（如 keycloak），用于区分同一租户下的多个 OIDC 提供方
+		field.String("provider_custom").
+			Comment("自定义提供方标识").
+			MaxLen(64).
+			Immutable().
+			Default(""),
+
+		field.String("display_name").
+			Comment("登录页显示名称").
+			MaxLen(64).
+			Nillable().
+			Optional(),
+
+		field.String("client_id").
+			Comment("提供方分配的客户端ID（微信为 AppID）").
+			MaxLen(255).
+			NotEmpty().
+			Nillable().
+			Optional(),
+
+		field.String("client_secret").
+			Comment("提供方分配的客户端密钥（加密存储）").
+			MaxLen(1024).
+			Sensitive().
+			Nillable().
+			Optional(),
+
+		field.String("auth_url").
+			Comment("授权端点，为空使用提供方默认值").
+			MaxLen(512).
+			Nillable().
+			Optional(),
+
+		field.String("token_url").
+			Comment("令牌端点，为空使用提供方默认值").
+			MaxLen(512).
+			Nillable().
+			Optional(),
+
+		field.String("userinfo_url").
+			Comment("用户信息端点，为空使用提供方默认值").
+			MaxLen(512).
+			Nillable().
+			Optional(),
+
+		field.String("redirect_uri").
+			Comment("在提供方登记的回调地址").
+			MaxLen(512).
+			Nillable().
+			Optional(),
+
+		field.JSON("scopes", []string{}).
+			Comment("授权范围，为空使用提供方默认值").
+			SchemaType(map[string]string{
+				dialect.MySQL:    "json",
+				dialect.Postgres: "jsonb",
+			}).
+			Optional(),
+
+		field.Bool("auto_provision").
+			Comment("未绑定的第三方账号首次登录时是否自动创建用户").
+			Default(false).
+			Nillable().
+			Optional(),
+
+		field.JSON("default_role_ids", []uint32{}).
+			Comment("自动创建用户时授予的角色ID列表").
+			SchemaType(map[string]string{
+				dialect.MySQL:    "json",
+				dialect.Postgres: "jsonb",
+			}).
+			Optional(),
+	}
+}
+
+// Mixin of the OAuthProviderConfig.
+func (OAuthProviderConfig) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AutoIncrementId{},
+		mixin.TimeAt{},
+		mixin.OperatorID{},
+		mixin.TenantID[uint32]{},
+		mixin.SwitchStatus{},
+		mixin.SortOrder{},
+	}
+}
+
+// Indexes of the OAuthProviderConfig.
+func (OAuthProviderConfig) Indexes() []ent.Index {
+	return []ent.Index{
+		// 同一租户下每个提供方只能配置一次
+		index.Fields("tenant_id", "provider", "provider_custom").
+			Unique().
+			StorageKey("uidx_sys_oauth_provider_config_tenant_provider"),
+
+		// 登录页按租户 + 状态列出可用提供方
+		index.Fields("tenant_id", "status").
+			StorageKey("idx_sys_oauth_provider_config_tenant_status"),
+	}
+}
@@ -43,6 +43,16 @@ func (OperationAuditLog) Fields() []ent.Field {
 			Optional().
 			Nillable(),
 
+		field.Uint32("impersonator_id").
+			Comment("代登录操作人用户ID").
+			Optional().
+			Nillable(),
+
+		field.String("impersonator_name").
+			Comment("代登录操作人账号名").
+			Optional().
+			Nillable(),
+
 		field.String("resource_type").
 			Comment("资源类型").
 			Optional().
@@ -158,6 +168,7 @@ func (OperationAuditLog) Indexes() []ent.Index {
 		// 用户与账号常用查询
 		index.Fields("user_id"),
 		index.Fields("username"),
+		index.Fields("impersonator_id"),
 
 		// 请求追踪与会话
 		index.Fields("request_id"),
@@ -8,6 +8,8 @@
 	"entgo.io/ent/schema/index"
 
 	"github.com/tx7do/go-crud/entgo/mixin"
+
+	"go-wind-admin/app/admin/service/internal/data/ent/privacy"
 )
 
 type OrgUnit struct {
@@ -216,3 +218,12 @@ func (OrgUnit) Indexes() []ent.Index {
 			StorageKey("idx_org_created_at"),
 	}
 }
+
+// Policy of the OrgUnit.
+func (OrgUnit) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			actionPermissionRule(),
+		},
+	}
+}
@@ -0,0 +1,118 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// PasswordPolicy holds the schema definition for the PasswordPolicy entity.
+// 租户维度的密码策略，每个租户至多一条；tenant_id=0 为平台策略，作用于全部用户。
+// 设置/修改密码时平台策略与用户所在租户策略逐项取较严格者，租户只能在平台基线上收紧。
+type PasswordPolicy struct {
+	ent.Schema
+}
+
+func (PasswordPolicy) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{
+			Table:     "sys_password_policies",
+			Charset:   "utf8mb4",
+			Collation: "utf8mb4_bin",
+		},
+		entsql.WithComments(true),
+		schema.Comment("密码策略表"),
+	}
+}
+
+// Fields of the PasswordPolicy.
+func (PasswordPolicy) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint32("min_length").
+			Comment("最小长度").
+			Default(0).
+			Nillable().
+			Optional(),
+
+		field.Bool("require_uppercase").
+			Comment("须含大写字母").
+			Default(false).
+			Nillable().
+			Optional(),
+
+		field.Bool("require_lowercase").
+			Comment("须含小写字母").
+			Default(false).
+			Nillable().
+			Optional(),
+
+		field.Bool("require_digit").
+			Comment("须含数字").
+			Default(false).
+			Nillable().
+			Optional(),
+
+		field.Bool("require_symbol").
+			Comment("须含特殊字符").
+			Default(false).
+			Nillable().
+			Optional(),
+
+		field.Bool("disallow_identity").
+			Comment("禁止包含用户名与租户代码").
+			Default(false).
+			Nillable().
+			Optional(),
+
+		field.JSON("banned_substrings", []string{}).
+			Comment("禁止包含的子串（不区分大小写）").
+			SchemaType(map[string]string{
+				dialect.MySQL:    "json",
+				dialect.Postgres: "jsonb",
+			}).
+			Optional(),
+
+		field.Uint32("history_count").
+			Comment("禁止重复使用最近 N 次密码，0 不限制").
+			Default(0).
+			Nillable().
+			Optional(),
+
+		field.Uint32("max_age_days").
+			Comment("密码有效天数，到期后登录要求修改，0 不过期").
+			Default(0).
+			Nillable().
+			Optional(),
+
+		field.Bool("check_breached").
+			Comment("是否比对本地泄露密码库").
+			Default(false).
+			Nillable().
+			Optional(),
+	}
+}
+
+// Mixin of the PasswordPolicy.
+func (PasswordPolicy) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AutoIncrementId{},
+		mixin.TimeAt{},
+		mixin.OperatorID{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+// Indexes of the PasswordPolicy.
+func (PasswordPolicy) Indexes() []ent.Index {
+	return []ent.Index{
+		// 每个租户只有一条策略
+		index.Fields("tenant_id").
+			Unique().
+			StorageKey("uidx_sys_password_policy_tenant"),
+	}
+}
@@ -8,6 +8,8 @@
 	"entgo.io/ent/schema/index"
 
 	"github.com/tx7do/go-crud/entgo/mixin"
+
+	"go-wind-admin/app/admin/service/internal/data/ent/privacy"
 )
 
 // Position holds the schema definition for the Position entity.
@@ -179,3 +181,16 @@ func (Position) Indexes() []ent.Index {
 			StorageKey("idx_sys_positions_tenant_id"),
 	}
 }
+
+// Policy of the Position.
+func (Position) Policy() ent.Policy {
+	return privacy.Policy{
+		Query: privacy.QueryPolicy{
+			dataScopeRule(),
+		},
+		Mutation: privacy.MutationPolicy{
+			actionPermissionRule(),
+			dataScopeRule(),
+		},
+	}
+}
@@ -0,0 +1,85 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// RelationTuple holds the schema definition for the RelationTuple entity.
+type RelationTuple struct {
+	ent.Schema
+}
+
+func (RelationTuple) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{
+			Table:     "sys_relation_tuples",
+			Charset:   "utf8mb4",
+			Collation: "utf8mb4_bin",
+		},
+		entsql.WithComments(true),
+		schema.Comment("关系元组表（object#relation@subject）"),
+	}
+}
+
+// Fields of the RelationTuple.
+func (RelationTuple) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("namespace").
+			Comment("对象命名空间").
+			MaxLen(64).
+			NotEmpty(),
+
+		field.String("object_id").
+			Comment("对象ID").
+			MaxLen(255).
+			NotEmpty(),
+
+		field.String("relation").
+			Comment("关系").
+			MaxLen(64).
+			NotEmpty(),
+
+		field.String("subject_namespace").
+			Comment("主体命名空间").
+			MaxLen(64).
+			NotEmpty(),
+
+		field.String("subject_id").
+			Comment("主体ID").
+			MaxLen(255).
+			NotEmpty(),
+
+		field.String("subject_relation").
+			Comment("主体关系（为空表示具体对象，否则为用户集）").
+			MaxLen(64).
+			Default(""),
+	}
+}
+
+// Mixin of the RelationTuple.
+func (RelationTuple) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AutoIncrementId{},
+		mixin.CreatedAt{},
+		mixin.CreatedBy{},
+	}
+}
+
+// Indexes of the RelationTuple.
+func (RelationTuple) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("namespace", "object_id", "relation", "subject_namespace", "subject_id", "subject_relation").
+			Unique().
+			StorageKey("uix_relation_tuple"),
+
+		// 反查：按主体查询所在的关系
+		index.Fields("subject_namespace", "subject_id", "subject_relation").
+			StorageKey("idx_relation_tuple_subject"),
+	}
+}
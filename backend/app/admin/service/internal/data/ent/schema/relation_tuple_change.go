@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// RelationTupleChange holds the schema definition for the RelationTupleChange entity.
+type RelationTupleChange struct {
+	ent.Schema
+}
+
+func (RelationTupleChange) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{
+			Table:     "sys_relation_tuple_changes",
+			Charset:   "utf8mb4",
+			Collation: "utf8mb4_bin",
+		},
+		entsql.WithComments(true),
+		schema.Comment("关系元组变更日志表（自增ID即元组版本号，用作一致性令牌）"),
+	}
+}
+
+// Fields of the RelationTupleChange.
+func (RelationTupleChange) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("operation").
+			Comment("变更操作").
+			NamedValues(
+				"Write", "WRITE",
+				"Delete", "DELETE",
+				"Replace", "REPLACE",
+			),
+
+		field.Text("tuples").
+			Comment("变更的元组（每行一个 object#relation@subject；REPLACE 为被替换的命名空间）").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Mixin of the RelationTupleChange.
+func (RelationTupleChange) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AutoIncrementId{},
+		mixin.CreatedAt{},
+		mixin.CreatedBy{},
+	}
+}
@@ -2,12 +2,15 @@
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
 
 	"github.com/tx7do/go-crud/entgo/mixin"
+
+	"go-wind-admin/app/admin/service/internal/data/ent/privacy"
 )
 
 // Role holds the schema definition for the Role entity.
@@ -56,6 +59,26 @@ func (Role) Fields() []ent.Field {
 			).
 			Default("TENANT").
 			Nillable(),
+
+		field.Enum("data_scope").
+			Comment("数据权限范围，为空视为全部数据").
+			NamedValues(
+				"All", "ALL",
+				"Self", "SELF",
+				"UnitOnly", "UNIT_ONLY",
+				"UnitAndChild", "UNIT_AND_CHILD",
+				"SelectedUnits", "SELECTED_UNITS",
+			).
+			Optional().
+			Nillable(),
+
+		field.JSON("data_scope_org_unit_ids", []uint32{}).
+			Comment("自定义数据权限的组织单元ID列表（SELECTED_UNITS）").
+			SchemaType(map[string]string{
+				dialect.MySQL:    "json",
+				dialect.Postgres: "jsonb",
+			}).
+			Optional(),
 	}
 }
 
@@ -113,3 +136,12 @@ func (Role) Indexes() []ent.Index {
 			StorageKey("idx_sys_roles_created_by"),
 	}
 }
+
+// Policy of the Role.
+func (Role) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			actionPermissionRule(),
+		},
+	}
+}
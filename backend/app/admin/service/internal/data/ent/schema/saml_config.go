@@ -0,0 +1,129 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// SamlConfig holds the schema definition for the SamlConfig entity.
+// 租户维度的 SAML 2.0 SP 配置，每个租户至多一条。
+// 企业账号绑定关系存于 user_credential（identity_type=ENTERPRISE_SSO，credential_type=SAML_ASSERTION）。
+type SamlConfig struct {
+	ent.Schema
+}
+
+func (SamlConfig) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{
+			Table:     "sys_saml_configs",
+			Charset:   "utf8mb4",
+			Collation: "utf8mb4_bin",
+		},
+		entsql.WithComments(true),
+		schema.Comment("SAML 单点登录配置表"),
+	}
+}
+
+// Fields of the SamlConfig.
+func (SamlConfig) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("display_name").
+			Comment("登录页显示名称").
+			MaxLen(64).
+			Nillable().
+			Optional(),
+
+		field.String("idp_entity_id").
+			Comment("IdP 实体ID（断言 Issuer）").
+			MaxLen(512).
+			NotEmpty().
+			Nillable().
+			Optional(),
+
+		field.String("idp_sso_url").
+			Comment("IdP 单点登录地址（HTTP-Redirect 绑定）").
+			MaxLen(1024).
+			NotEmpty().
+			Nillable().
+			Optional(),
+
+		// 支持多张证书（PEM 拼接），IdP 轮换证书期间新旧证书并存
+		field.Text("idp_certificate").
+			Comment("IdP 签名证书（PEM）").
+			Nillable().
+			Optional(),
+
+		field.String("name_id_format").
+			Comment("请求的 NameID 格式，为空由 IdP 决定").
+			MaxLen(128).
+			Nillable().
+			Optional(),
+
+		// 键为本地字段（username/email/nickname/realname/mobile），值为断言属性名（Name 或 FriendlyName）
+		field.JSON("attribute_mapping", map[string]string{}).
+			Comment("断言属性映射").
+			SchemaType(map[string]string{
+				dialect.MySQL:    "json",
+				dialect.Postgres: "jsonb",
+			}).
+			Optional(),
+
+		field.Bool("jit_provisioning").
+			Comment("未绑定的企业账号首次登录时是否自动创建用户").
+			Default(false).
+			Nillable().
+			Optional(),
+
+		field.JSON("default_role_ids", []uint32{}).
+			Comment("自动创建用户时授予的角色ID列表").
+			SchemaType(map[string]string{
+				dialect.MySQL:    "json",
+				dialect.Postgres: "jsonb",
+			}).
+			Optional(),
+
+		field.Uint32("default_org_unit_id").
+			Comment("自动创建用户时归属的组织单元ID").
+			Nillable().
+			Optional(),
+
+		field.Bool("sso_only").
+			Comment("是否仅允许单点登录（禁用本地密码登录）").
+			Default(false).
+			Nillable().
+			Optional(),
+
+		field.String("login_redirect_url").
+			Comment("断言校验通过后携带一次性票据跳转的前端地址").
+			MaxLen(512).
+			Nillable().
+			Optional(),
+	}
+}
+
+// Mixin of the SamlConfig.
+func (SamlConfig) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AutoIncrementId{},
+		mixin.TimeAt{},
+		mixin.OperatorID{},
+		mixin.TenantID[uint32]{},
+		mixin.SwitchStatus{},
+	}
+}
+
+// Indexes of the SamlConfig.
+func (SamlConfig) Indexes() []ent.Index {
+	return []ent.Index{
+		// 每个租户只能配置一个 IdP
+		index.Fields("tenant_id").
+			Unique().
+			StorageKey("uidx_sys_saml_config_tenant"),
+	}
+}
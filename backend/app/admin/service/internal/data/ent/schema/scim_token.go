@@ -0,0 +1,101 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// ScimToken holds the schema definition for the ScimToken entity.
+// 身份提供方（Okta、Entra ID 等）调用 SCIM 2.0 供应接口使用的租户级 Bearer 令牌。
+// 令牌为 256 位随机数，只存 SHA-256 哈希，明文仅在创建时返回一次。
+type ScimToken struct {
+	ent.Schema
+}
+
+func (ScimToken) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{
+			Table:     "sys_scim_tokens",
+			Charset:   "utf8mb4",
+			Collation: "utf8mb4_bin",
+		},
+		entsql.WithComments(true),
+		schema.Comment("SCIM 供应令牌表"),
+	}
+}
+
+// Fields of the ScimToken.
+func (ScimToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			Comment("令牌名称，用作 SCIM 变更的审计操作人").
+			MaxLen(64).
+			NotEmpty().
+			Nillable().
+			Optional(),
+
+		field.String("token_prefix").
+			Comment("令牌前缀，便于管理员辨认").
+			MaxLen(16).
+			Immutable().
+			Nillable().
+			Optional(),
+
+		field.String("token_hash").
+			Comment("令牌哈希（SHA-256 十六进制，不要存明文）").
+			MaxLen(64).
+			NotEmpty().
+			Immutable().
+			Sensitive().
+			Nillable().
+			Optional(),
+
+		field.Time("expires_at").
+			Comment("过期时间，为空表示永不过期").
+			Nillable().
+			Optional(),
+
+		field.Time("last_used_at").
+			Comment("最近一次使用时间").
+			Nillable().
+			Optional(),
+
+		field.String("last_used_ip").
+			Comment("最近一次使用的客户端IP").
+			MaxLen(64).
+			Nillable().
+			Optional(),
+	}
+}
+
+// Mixin of the ScimToken.
+func (ScimToken) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AutoIncrementId{},
+		mixin.TimeAt{},
+		mixin.OperatorID{},
+		mixin.Description{},
+		mixin.TenantID[uint32]{},
+		mixin.SwitchStatus{},
+	}
+}
+
+// Indexes of the ScimToken.
+func (ScimToken) Indexes() []ent.Index {
+	return []ent.Index{
+		// 请求按令牌哈希精确查找
+		index.Fields("token_hash").
+			Unique().
+			StorageKey("uidx_sys_scim_token_hash"),
+
+		// 在租户范围内保证令牌名称不重复
+		index.Fields("tenant_id", "name").
+			Unique().
+			StorageKey("uidx_sys_scim_token_tenant_name"),
+	}
+}
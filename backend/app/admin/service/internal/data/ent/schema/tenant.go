@@ -9,6 +9,8 @@
 	"entgo.io/ent/schema/index"
 
 	"github.com/tx7do/go-crud/entgo/mixin"
+
+	"go-wind-admin/app/admin/service/internal/data/ent/privacy"
 )
 
 // Tenant holds the schema definition for the Tenant entity.
@@ -60,6 +62,11 @@ func (Tenant) Fields() []ent.Field {
 			Optional().
 			Nillable(),
 
+		field.String("timezone").
+			Comment("时区").
+			Optional().
+			Nillable(),
+
 		field.Uint32("admin_user_id").
 			Comment("管理员用户ID").
 			Optional().
@@ -179,3 +186,12 @@ func (Tenant) Indexes() []ent.Index {
 		index.Fields("created_at").StorageKey("idx_sys_tenant_created_at"),
 	}
 }
+
+// Policy of the Tenant.
+func (Tenant) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			actionPermissionRule(),
+		},
+	}
+}
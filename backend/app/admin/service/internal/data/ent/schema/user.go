@@ -8,6 +8,8 @@
 	"entgo.io/ent/schema/index"
 
 	"github.com/tx7do/go-crud/entgo/mixin"
+
+	"go-wind-admin/app/admin/service/internal/data/ent/privacy"
 )
 
 // User holds the schema definition for the User entity.
@@ -169,3 +171,17 @@ func (User) Indexes() []ent.Index {
 		index.Fields("tenant_id", "created_at").StorageKey("idx_sys_user_tenant_created_at"),
 	}
 }
+
+// Policy of the User.
+func (User) Policy() ent.Policy {
+	return privacy.Policy{
+		Query: privacy.QueryPolicy{
+			userDataScopeQueryRule(),
+		},
+		Mutation: privacy.MutationPolicy{
+			selfUpdateRule(),
+			actionPermissionRule(),
+			userDataScopeMutationRule(),
+		},
+	}
+}
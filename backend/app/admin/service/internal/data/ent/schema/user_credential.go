@@ -91,6 +91,7 @@ func (UserCredential) Fields() []ent.Field {
 				"SsoToken", "SSO_TOKEN",
 				"SamlAssertion", "SAML_ASSERTION",
 				"OpenidConnectIdToken", "OPENID_CONNECT_ID_TOKEN",
+				"LdapBind", "LDAP_BIND",
 
 				"SessionCookie", "SESSION_COOKIE",
 				"TemporaryCredential", "TEMPORARY_CREDENTIAL",
@@ -179,6 +180,20 @@ func (UserCredential) Fields() []ent.Field {
 			Comment("重置令牌使用时间").
 			Nillable().
 			Optional(),
+
+		field.Time("credential_changed_at").
+			Comment("密码最近修改时间，用于密码有效期判定").
+			Nillable().
+			Optional(),
+
+		// 仅保留策略要求的条数（新在前），不含当前密码
+		field.JSON("credential_history", []string{}).
+			Comment("最近使用过的密码哈希").
+			SchemaType(map[string]string{
+				dialect.MySQL:    "json",
+				dialect.Postgres: "jsonb",
+			}).
+			Optional(),
 	}
 }
 
@@ -229,5 +244,11 @@ func (UserCredential) Indexes() []ent.Index {
 		// 按租户 + 激活/重置令牌到期时间，用于按令牌过期查询
 		index.Fields("tenant_id", "activate_token_expires_at").
 			StorageKey("idx_sys_user_cred_tenant_activate_expires_at"),
+
+		// 找回密码/账号激活时按令牌哈希定位凭证（请求不带租户）
+		index.Fields("reset_token_hash").
+			StorageKey("idx_sys_user_cred_reset_token_hash"),
+		index.Fields("activate_token_hash").
+			StorageKey("idx_sys_user_cred_activate_token_hash"),
 	}
 }
@@ -0,0 +1,158 @@
+package schema
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/entql"
+	"github.com/tx7do/go-crud/viewer"
+
+	entgen "go-wind-admin/app/admin/service/internal/data/ent"
+	"go-wind-admin/app/admin/service/internal/data/ent/membership"
+	"go-wind-admin/app/admin/service/internal/data/ent/membershiporgunit"
+	"go-wind-admin/app/admin/service/internal/data/ent/privacy"
+	"go-wind-admin/app/admin/service/internal/data/ent/user"
+	"go-wind-admin/app/admin/service/internal/data/ent/userorgunit"
+)
+
+// rowScope 租户视图下用户相关数据的可见范围，由 Viewer 的数据范围换算而来
+type rowScope struct {
+	userID   uint32   // 当前用户，本人数据始终可见
+	self     bool     // SELF：本人创建的数据
+	units    []uint32 // 可见的组织单元
+	creators []uint32 // 指定创建人
+}
+
+// viewerRowScope 解析当前 Viewer 的可见范围，返回 nil 表示不做行过滤：
+// 平台/系统视图、数据范围为 ALL，以及未携带 Viewer 的认证前流程（登录、令牌刷新等按用户名/ID 查找用户）。
+// 未解析出数据范围或范围为 NONE 时仅本人数据可见。
+func viewerRowScope(ctx context.Context) *rowScope {
+	vc, ok := viewer.FromContext(ctx)
+	if !ok || vc == nil || vc.IsPlatformContext() || vc.IsSystemContext() {
+		return nil
+	}
+
+	rs := &rowScope{userID: uint32(vc.UserID())}
+	for _, s := range vc.DataScope() {
+		switch s.ScopeType {
+		case viewer.ScopeTypeAll:
+			return nil
+		case viewer.ScopeTypeSelf:
+			rs.self = true
+		case viewer.ScopeTypeUnit:
+			for _, id := range s.TargetIDs {
+				rs.units = append(rs.units, uint32(id))
+			}
+		case viewer.ScopeTypeUser:
+			for _, id := range s.TargetIDs {
+				rs.creators = append(rs.creators, uint32(id))
+			}
+		}
+	}
+	return rs
+}
+
+// userDataScopeQueryRule 用户数据权限：本人之外，仅可见归属于数据范围内组织单元的用户
+// （任职、成员关系或成员关系的附属组织单元），SELF 与指定用户范围按 created_by 匹配
+func userDataScopeQueryRule() privacy.QueryRule {
+	return privacy.UserQueryRuleFunc(func(ctx context.Context, q *entgen.UserQuery) error {
+		if rs := viewerRowScope(ctx); rs != nil {
+			q.Where(rs.userPredicate)
+		}
+		return privacy.Skip
+	})
+}
+
+// userDataScopeMutationRule 用户的更新/删除限定在可见范围内，创建不受限制
+func userDataScopeMutationRule() privacy.MutationRule {
+	return privacy.UserMutationRuleFunc(func(ctx context.Context, m *entgen.UserMutation) error {
+		if m.Op().Is(entgen.OpCreate) {
+			return privacy.Skip
+		}
+		if rs := viewerRowScope(ctx); rs != nil {
+			m.WhereP(rs.userPredicate)
+		}
+		return privacy.Skip
+	})
+}
+
+// userPredicate 用户表的行过滤谓词
+func (rs *rowScope) userPredicate(s *sql.Selector) {
+	preds := []*sql.Predicate{sql.EQ(s.C(user.FieldID), rs.userID)}
+
+	if rs.self {
+		preds = append(preds, sql.EQ(s.C(user.FieldCreatedBy), rs.userID))
+	}
+
+	if len(rs.creators) > 0 {
+		preds = append(preds, sql.In(s.C(user.FieldCreatedBy), anyValues(rs.creators)...))
+	}
+
+	if len(rs.units) > 0 {
+		units := anyValues(rs.units)
+
+		uou := sql.Table(userorgunit.Table)
+		preds = append(preds, sql.In(s.C(user.FieldID),
+			sql.Select(uou.C(userorgunit.FieldUserID)).From(uou).Where(sql.And(
+				sql.In(uou.C(userorgunit.FieldOrgUnitID), units...),
+				sql.IsNull(uou.C(userorgunit.FieldDeletedAt)),
+			)),
+		))
+
+		m := sql.Table(membership.Table)
+		preds = append(preds, sql.In(s.C(user.FieldID),
+			sql.Select(m.C(membership.FieldUserID)).From(m).Where(sql.And(
+				sql.In(m.C(membership.FieldOrgUnitID), units...),
+				sql.IsNull(m.C(membership.FieldDeletedAt)),
+			)),
+		))
+
+		m2 := sql.Table(membership.Table)
+		mou := sql.Table(membershiporgunit.Table)
+		preds = append(preds, sql.In(s.C(user.FieldID),
+			sql.Select(m2.C(membership.FieldUserID)).From(m2).
+				Join(mou).On(mou.C(membershiporgunit.FieldMembershipID), m2.C(membership.FieldID)).
+				Where(sql.And(
+					sql.In(mou.C(membershiporgunit.FieldOrgUnitID), units...),
+					sql.IsNull(mou.C(membershiporgunit.FieldDeletedAt)),
+					sql.IsNull(m2.C(membership.FieldDeletedAt)),
+				)),
+		))
+	}
+
+	s.Where(sql.Or(preds...))
+}
+
+// memberDataScopeRule 成员关系类数据权限（Membership、UserOrgUnit）：本人的记录、数据范围内组织单元的记录，
+// 以及 SELF 与指定用户范围按 created_by 匹配的记录可见
+func memberDataScopeRule() privacy.QueryMutationRule {
+	return privacy.FilterFunc(func(ctx context.Context, f privacy.Filter) error {
+		rs := viewerRowScope(ctx)
+		if rs == nil {
+			return privacy.Skip
+		}
+
+		p := entql.Uint32EQ(rs.userID).Field("user_id")
+		if rs.self {
+			p = entql.Or(p, entql.Uint32EQ(rs.userID).Field("created_by"))
+		}
+		if len(rs.creators) > 0 {
+			p = entql.Or(p, entql.FieldIn("created_by", anyValues(rs.creators)...))
+		}
+		if len(rs.units) > 0 {
+			p = entql.Or(p, entql.FieldIn("org_unit_id", anyValues(rs.units)...))
+		}
+		f.Where(p)
+
+		return privacy.Skip
+	})
+}
+
+// anyValues 转换为 IN 条件的参数
+func anyValues(ids []uint32) []any {
+	vs := make([]any, 0, len(ids))
+	for _, id := range ids {
+		vs = append(vs, id)
+	}
+	return vs
+}
@@ -11,8 +11,11 @@
 )
 
 // UserMfaFactor holds the schema definition for the UserMfaFactor entity.
-// 存储用户绑定的 MFA 因子。本轮仅 TOTP：secret 字段存 AES-GCM 加密后的 TOTP secret，
-// 校验时解密后用 totp 库验证。其余方法（SMS/EMAIL/WEBAUTHN）预留枚举值，本轮不落库。
+// 存储用户绑定的 MFA 因子：
+//   - TOTP：secret 字段存 AES-GCM 加密后的 TOTP secret，校验时解密后用 totp 库验证，每用户至多一个；
+//   - WEBAUTHN：每把安全密钥/通行密钥一行，存凭证 ID、COSE 公钥与签名计数器，每用户可绑定多个；
+//   - SMS/EMAIL：每用户各至多一个，secret 字段存 AES-GCM 加密后的手机号/邮箱，验证码经 Sender 下发；
+//   - BACKUP_CODE：每个一次性备份码一行，secret 字段存码的 SHA-256 摘要，使用后置为 DISABLED。
 type UserMfaFactor struct {
 	ent.Schema
 }
@@ -37,7 +40,7 @@ func (UserMfaFactor) Fields() []ent.Field {
 			Nillable().
 			Optional(),
 
-		// MFA 方法：本轮仅 TOTP 会落库；其余枚举值预留，服务层对非 TOTP 直接返回未实现。
+		// MFA 方法：均会落库；各方法 secret/credential 字段用法见类型注释。
 		field.Enum("method").
 			Comment("MFA 方法").
 			NamedValues(
@@ -45,14 +48,15 @@ func (UserMfaFactor) Fields() []ent.Field {
 				"Sms", "SMS",
 				"Email", "EMAIL",
 				"Webauthn", "WEBAUTHN",
+				"BackupCode", "BACKUP_CODE",
 			).
 			Default("TOTP").
 			Nillable().
 			Optional(),
 
-		// TOTP secret 的 AES-GCM 密文（base64）。绝不存明文；校验时解密。
+		// TOTP secret（或短信/邮件因子的手机号/邮箱）的 AES-GCM 密文（base64）。绝不存明文；校验时解密。
 		// 字段名沿用 secret_hash 是历史命名，实际内容为对称加密密文而非单向哈希，
-		// 因为 TOTP 校验需要还原明文 secret。
+		// 因为 TOTP 校验需要还原明文 secret。备份码例外：存单向摘要（见 UserMfaFactorRepo.hashBackupCode）。
 		field.String("secret_hash").
 			Comment("MFA secret 密文（AES-GCM 加密，base64 编码；TOTP 校验需还原明文）").
 			MaxLen(512).
@@ -80,6 +84,48 @@ func (UserMfaFactor) Fields() []ent.Field {
 			Comment("最近一次用于验证的时间").
 			Nillable().
 			Optional(),
+
+		// 以下字段除 credential_id 外仅 WEBAUTHN 因子使用。
+
+		// 凭证 ID（base64url 无填充）。TOTP 因子为空串，使唯一索引对 TOTP 仍退化为 (user_id, method)；
+		// 备份码存批内序号，保证同批各码在唯一索引下互不冲突。
+		field.String("credential_id").
+			Comment("WebAuthn 凭证ID（base64url）").
+			MaxLen(512).
+			Default(""),
+
+		field.Bytes("public_key").
+			Comment("WebAuthn 凭证公钥（COSE 编码）").
+			Optional(),
+
+		// 签名计数器：每次断言后更新；收到不大于已存值的非零计数视为凭证被克隆。
+		field.Uint32("sign_count").
+			Comment("WebAuthn 签名计数器").
+			Default(0),
+
+		field.Bytes("aaguid").
+			Comment("认证器型号标识（AAGUID）").
+			Optional(),
+
+		field.Strings("transports").
+			Comment("认证器支持的传输方式（usb/nfc/ble/internal/hybrid）").
+			Optional(),
+
+		field.Uint8("credential_flags").
+			Comment("注册时的认证器标志位（备份资格/备份状态等）").
+			Default(0),
+
+		field.String("attestation_type").
+			Comment("证明类型").
+			MaxLen(64).
+			Nillable().
+			Optional(),
+
+		field.String("attestation_format").
+			Comment("证明格式").
+			MaxLen(64).
+			Nillable().
+			Optional(),
 	}
 }
 
@@ -99,10 +145,15 @@ func (UserMfaFactor) Indexes() []ent.Index {
 		index.Fields("tenant_id", "user_id").
 			StorageKey("idx_sys_user_mfa_tenant_user_id"),
 
-		// 在租户范围内保证 (user_id, method) 唯一：一用户同一方法至多一个因子
+		// 在租户范围内保证 (user_id, method, credential_id) 唯一：
+		// TOTP 的 credential_id 恒为空串，即一用户至多一个 TOTP；WebAuthn 每个凭证一行。
 		// 注意：若字段可空，Postgres 需 partial unique index
-		index.Fields("tenant_id", "user_id", "method").
+		index.Fields("tenant_id", "user_id", "method", "credential_id").
 			Unique().
-			StorageKey("idx_sys_user_mfa_tenant_uid_method"),
+			StorageKey("idx_sys_user_mfa_tenant_uid_method_cred"),
+
+		// 按凭证 ID 全局查重（凭证只能归属一个账号）
+		index.Fields("credential_id").
+			StorageKey("idx_sys_user_mfa_credential_id"),
 	}
 }
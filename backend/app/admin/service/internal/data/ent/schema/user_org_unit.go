@@ -8,6 +8,8 @@
 	"entgo.io/ent/schema/index"
 
 	"github.com/tx7do/go-crud/entgo/mixin"
+
+	"go-wind-admin/app/admin/service/internal/data/ent/privacy"
 )
 
 // UserOrgUnit 用户与组织单元关联表
@@ -151,3 +153,15 @@ func (UserOrgUnit) Indexes() []ent.Index {
 			StorageKey("idx_uou_created_at"),
 	}
 }
+
+// Policy of the UserOrgUnit.
+func (UserOrgUnit) Policy() ent.Policy {
+	return privacy.Policy{
+		Query: privacy.QueryPolicy{
+			memberDataScopeRule(),
+		},
+		Mutation: privacy.MutationPolicy{
+			memberDataScopeRule(),
+		},
+	}
+}
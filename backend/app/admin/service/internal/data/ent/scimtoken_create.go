@@ -0,0 +1,1476 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-wind-admin/app/admin/service/internal/data/ent/scimtoken"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ScimTokenCreate is the builder for creating a ScimToken entity.
+type ScimTokenCreate struct {
+	config
+	mutation *ScimTokenMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ScimTokenCreate) SetCreatedAt(v time.Time) *ScimTokenCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ScimTokenCreate) SetNillableCreatedAt(v *time.Time) *ScimTokenCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ScimTokenCreate) SetUpdatedAt(v time.Time) *ScimTokenCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ScimTokenCreate) SetNillableUpdatedAt(v *time.Time) *ScimTokenCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *ScimTokenCreate) SetDeletedAt(v time.Time) *ScimTokenCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *ScimTokenCreate) SetNillableDeletedAt(v *time.Time) *ScimTokenCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *ScimTokenCreate) SetCreatedBy(v uint32) *ScimTokenCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *ScimTokenCreate) SetNillableCreatedBy(v *uint32) *ScimTokenCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_c *ScimTokenCreate) SetUpdatedBy(v uint32) *ScimTokenCreate {
+	_c.mutation.SetUpdatedBy(v)
+	return _c
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_c *ScimTokenCreate) SetNillableUpdatedBy(v *uint32) *ScimTokenCreate {
+	if v != nil {
+		_c.SetUpdatedBy(*v)
+	}
+	return _c
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_c *ScimTokenCreate) SetDeletedBy(v uint32) *ScimTokenCreate {
+	_c.mutation.SetDeletedBy(v)
+	return _c
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_c *ScimTokenCreate) SetNillableDeletedBy(v *uint32) *ScimTokenCreate {
+	if v != nil {
+		_c.SetDeletedBy(*v)
+	}
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *ScimTokenCreate) SetDescription(v string) *ScimTokenCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *ScimTokenCreate) SetNillableDescription(v *string) *ScimTokenCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *ScimTokenCreate) SetTenantID(v uint32) *ScimTokenCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *ScimTokenCreate) SetNillableTenantID(v *uint32) *ScimTokenCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *ScimTokenCreate) SetStatus(v scimtoken.Status) *ScimTokenCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *ScimTokenCreate) SetNillableStatus(v *scimtoken.Status) *ScimTokenCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *ScimTokenCreate) SetName(v string) *ScimTokenCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_c *ScimTokenCreate) SetNillableName(v *string) *ScimTokenCreate {
+	if v != nil {
+		_c.SetName(*v)
+	}
+	return _c
+}
+
+// SetTokenPrefix sets the "token_prefix" field.
+func (_c *ScimTokenCreate) SetTokenPrefix(v string) *ScimTokenCreate {
+	_c.mutation.SetTokenPrefix(v)
+	return _c
+}
+
+// SetNillableTokenPrefix sets the "token_prefix" field if the given value is not nil.
+func (_c *ScimTokenCreate) SetNillableTokenPrefix(v *string) *ScimTokenCreate {
+	if v != nil {
+		_c.SetTokenPrefix(*v)
+	}
+	return _c
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (_c *ScimTokenCreate) SetTokenHash(v string) *ScimTokenCreate {
+	_c.mutation.SetTokenHash(v)
+	return _c
+}
+
+// SetNillableTokenHash sets the "token_hash" field if the given value is not nil.
+func (_c *ScimTokenCreate) SetNillableTokenHash(v *string) *ScimTokenCreate {
+	if v != nil {
+		_c.SetTokenHash(*v)
+	}
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *ScimTokenCreate) SetExpiresAt(v time.Time) *ScimTokenCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_c *ScimTokenCreate) SetNillableExpiresAt(v *time.Time) *ScimTokenCreate {
+	if v != nil {
+		_c.SetExpiresAt(*v)
+	}
+	return _c
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (_c *ScimTokenCreate) SetLastUsedAt(v time.Time) *ScimTokenCreate {
+	_c.mutation.SetLastUsedAt(v)
+	return _c
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (_c *ScimTokenCreate) SetNillableLastUsedAt(v *time.Time) *ScimTokenCreate {
+	if v != nil {
+		_c.SetLastUsedAt(*v)
+	}
+	return _c
+}
+
+// SetLastUsedIP sets the "last_used_ip" field.
+func (_c *ScimTokenCreate) SetLastUsedIP(v string) *ScimTokenCreate {
+	_c.mutation.SetLastUsedIP(v)
+	return _c
+}
+
+// SetNillableLastUsedIP sets the "last_used_ip" field if the given value is not nil.
+func (_c *ScimTokenCreate) SetNillableLastUsedIP(v *string) *ScimTokenCreate {
+	if v != nil {
+		_c.SetLastUsedIP(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ScimTokenCreate) SetID(v uint32) *ScimTokenCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the ScimTokenMutation object of the builder.
+func (_c *ScimTokenCreate) Mutation() *ScimTokenMutation {
+	return _c.mutation
+}
+
+// Save creates the ScimToken in the database.
+func (_c *ScimTokenCreate) Save(ctx context.Context) (*ScimToken, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ScimTokenCreate) SaveX(ctx context.Context) *ScimToken {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ScimTokenCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ScimTokenCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ScimTokenCreate) defaults() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := scimtoken.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := scimtoken.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ScimTokenCreate) check() error {
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "ScimToken.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := scimtoken.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ScimToken.status": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := scimtoken.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ScimToken.name": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.TokenPrefix(); ok {
+		if err := scimtoken.TokenPrefixValidator(v); err != nil {
+			return &ValidationError{Name: "token_prefix", err: fmt.Errorf(`ent: validator failed for field "ScimToken.token_prefix": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.TokenHash(); ok {
+		if err := scimtoken.TokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "ScimToken.token_hash": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.LastUsedIP(); ok {
+		if err := scimtoken.LastUsedIPValidator(v); err != nil {
+			return &ValidationError{Name: "last_used_ip", err: fmt.Errorf(`ent: validator failed for field "ScimToken.last_used_ip": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := scimtoken.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "ScimToken.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *ScimTokenCreate) sqlSave(ctx context.Context) (*ScimToken, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint32(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ScimTokenCreate) createSpec() (*ScimToken, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ScimToken{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(scimtoken.Table, sqlgraph.NewFieldSpec(scimtoken.FieldID, field.TypeUint32))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(scimtoken.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = &value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(scimtoken.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = &value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(scimtoken.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(scimtoken.FieldCreatedBy, field.TypeUint32, value)
+		_node.CreatedBy = &value
+	}
+	if value, ok := _c.mutation.UpdatedBy(); ok {
+		_spec.SetField(scimtoken.FieldUpdatedBy, field.TypeUint32, value)
+		_node.UpdatedBy = &value
+	}
+	if value, ok := _c.mutation.DeletedBy(); ok {
+		_spec.SetField(scimtoken.FieldDeletedBy, field.TypeUint32, value)
+		_node.DeletedBy = &value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(scimtoken.FieldDescription, field.TypeString, value)
+		_node.Description = &value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(scimtoken.FieldTenantID, field.TypeUint32, value)
+		_node.TenantID = &value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(scimtoken.FieldStatus, field.TypeEnum, value)
+		_node.Status = &value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(scimtoken.FieldName, field.TypeString, value)
+		_node.Name = &value
+	}
+	if value, ok := _c.mutation.TokenPrefix(); ok {
+		_spec.SetField(scimtoken.FieldTokenPrefix, field.TypeString, value)
+		_node.TokenPrefix = &value
+	}
+	if value, ok := _c.mutation.TokenHash(); ok {
+		_spec.SetField(scimtoken.FieldTokenHash, field.TypeString, value)
+		_node.TokenHash = &value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(scimtoken.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	if value, ok := _c.mutation.LastUsedAt(); ok {
+		_spec.SetField(scimtoken.FieldLastUsedAt, field.TypeTime, value)
+		_node.LastUsedAt = &value
+	}
+	if value, ok := _c.mutation.LastUsedIP(); ok {
+		_spec.SetField(scimtoken.FieldLastUsedIP, field.TypeString, value)
+		_node.LastUsedIP = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ScimToken.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ScimTokenUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ScimTokenCreate) OnConflict(opts ...sql.ConflictOption) *ScimTokenUpsertOne {
+	_c.conflict = opts
+	return &ScimTokenUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ScimToken.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ScimTokenCreate) OnConflictColumns(columns ...string) *ScimTokenUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ScimTokenUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ScimTokenUpsertOne is the builder for "upsert"-ing
+	//  one ScimToken node.
+	ScimTokenUpsertOne struct {
+		create *ScimTokenCreate
+	}
+
+	// ScimTokenUpsert is the "OnConflict" setter.
+	ScimTokenUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ScimTokenUpsert) SetUpdatedAt(v time.Time) *ScimTokenUpsert {
+	u.Set(scimtoken.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ScimTokenUpsert) UpdateUpdatedAt() *ScimTokenUpsert {
+	u.SetExcluded(scimtoken.FieldUpdatedAt)
+	return u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *ScimTokenUpsert) ClearUpdatedAt() *ScimTokenUpsert {
+	u.SetNull(scimtoken.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *ScimTokenUpsert) SetDeletedAt(v time.Time) *ScimTokenUpsert {
+	u.Set(scimtoken.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *ScimTokenUpsert) UpdateDeletedAt() *ScimTokenUpsert {
+	u.SetExcluded(scimtoken.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *ScimTokenUpsert) ClearDeletedAt() *ScimTokenUpsert {
+	u.SetNull(scimtoken.FieldDeletedAt)
+	return u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *ScimTokenUpsert) SetCreatedBy(v uint32) *ScimTokenUpsert {
+	u.Set(scimtoken.FieldCreatedBy, v)
+	return u
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *ScimTokenUpsert) UpdateCreatedBy() *ScimTokenUpsert {
+	u.SetExcluded(scimtoken.FieldCreatedBy)
+	return u
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *ScimTokenUpsert) AddCreatedBy(v uint32) *ScimTokenUpsert {
+	u.Add(scimtoken.FieldCreatedBy, v)
+	return u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *ScimTokenUpsert) ClearCreatedBy() *ScimTokenUpsert {
+	u.SetNull(scimtoken.FieldCreatedBy)
+	return u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *ScimTokenUpsert) SetUpdatedBy(v uint32) *ScimTokenUpsert {
+	u.Set(scimtoken.FieldUpdatedBy, v)
+	return u
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *ScimTokenUpsert) UpdateUpdatedBy() *ScimTokenUpsert {
+	u.SetExcluded(scimtoken.FieldUpdatedBy)
+	return u
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *ScimTokenUpsert) AddUpdatedBy(v uint32) *ScimTokenUpsert {
+	u.Add(scimtoken.FieldUpdatedBy, v)
+	return u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *ScimTokenUpsert) ClearUpdatedBy() *ScimTokenUpsert {
+	u.SetNull(scimtoken.FieldUpdatedBy)
+	return u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *ScimTokenUpsert) SetDeletedBy(v uint32) *ScimTokenUpsert {
+	u.Set(scimtoken.FieldDeletedBy, v)
+	return u
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *ScimTokenUpsert) UpdateDeletedBy() *ScimTokenUpsert {
+	u.SetExcluded(scimtoken.FieldDeletedBy)
+	return u
+}
+
+// AddDeletedBy adds v to the "deleted_by" field.
+func (u *ScimTokenUpsert) AddDeletedBy(v uint32) *ScimTokenUpsert {
+	u.Add(scimtoken.FieldDeletedBy, v)
+	return u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *ScimTokenUpsert) ClearDeletedBy() *ScimTokenUpsert {
+	u.SetNull(scimtoken.FieldDeletedBy)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *ScimTokenUpsert) SetDescription(v string) *ScimTokenUpsert {
+	u.Set(scimtoken.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *ScimTokenUpsert) UpdateDescription() *ScimTokenUpsert {
+	u.SetExcluded(scimtoken.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *ScimTokenUpsert) ClearDescription() *ScimTokenUpsert {
+	u.SetNull(scimtoken.FieldDescription)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *ScimTokenUpsert) SetStatus(v scimtoken.Status) *ScimTokenUpsert {
+	u.Set(scimtoken.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ScimTokenUpsert) UpdateStatus() *ScimTokenUpsert {
+	u.SetExcluded(scimtoken.FieldStatus)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *ScimTokenUpsert) SetName(v string) *ScimTokenUpsert {
+	u.Set(scimtoken.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ScimTokenUpsert) UpdateName() *ScimTokenUpsert {
+	u.SetExcluded(scimtoken.FieldName)
+	return u
+}
+
+// ClearName clears the value of the "name" field.
+func (u *ScimTokenUpsert) ClearName() *ScimTokenUpsert {
+	u.SetNull(scimtoken.FieldName)
+	return u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *ScimTokenUpsert) SetExpiresAt(v time.Time) *ScimTokenUpsert {
+	u.Set(scimtoken.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *ScimTokenUpsert) UpdateExpiresAt() *ScimTokenUpsert {
+	u.SetExcluded(scimtoken.FieldExpiresAt)
+	return u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *ScimTokenUpsert) ClearExpiresAt() *ScimTokenUpsert {
+	u.SetNull(scimtoken.FieldExpiresAt)
+	return u
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (u *ScimTokenUpsert) SetLastUsedAt(v time.Time) *ScimTokenUpsert {
+	u.Set(scimtoken.FieldLastUsedAt, v)
+	return u
+}
+
+// UpdateLastUsedAt sets the "last_used_at" field to the value that was provided on create.
+func (u *ScimTokenUpsert) UpdateLastUsedAt() *ScimTokenUpsert {
+	u.SetExcluded(scimtoken.FieldLastUsedAt)
+	return u
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (u *ScimTokenUpsert) ClearLastUsedAt() *ScimTokenUpsert {
+	u.SetNull(scimtoken.FieldLastUsedAt)
+	return u
+}
+
+// SetLastUsedIP sets the "last_used_ip" field.
+func (u *ScimTokenUpsert) SetLastUsedIP(v string) *ScimTokenUpsert {
+	u.Set(scimtoken.FieldLastUsedIP, v)
+	return u
+}
+
+// UpdateLastUsedIP sets the "last_used_ip" field to the value that was provided on create.
+func (u *ScimTokenUpsert) UpdateLastUsedIP() *ScimTokenUpsert {
+	u.SetExcluded(scimtoken.FieldLastUsedIP)
+	return u
+}
+
+// ClearLastUsedIP clears the value of the "last_used_ip" field.
+func (u *ScimTokenUpsert) ClearLastUsedIP() *ScimTokenUpsert {
+	u.SetNull(scimtoken.FieldLastUsedIP)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ScimToken.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(scimtoken.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ScimTokenUpsertOne) UpdateNewValues() *ScimTokenUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(scimtoken.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(scimtoken.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(scimtoken.FieldTenantID)
+		}
+		if _, exists := u.create.mutation.TokenPrefix(); exists {
+			s.SetIgnore(scimtoken.FieldTokenPrefix)
+		}
+		if _, exists := u.create.mutation.TokenHash(); exists {
+			s.SetIgnore(scimtoken.FieldTokenHash)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ScimToken.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ScimTokenUpsertOne) Ignore() *ScimTokenUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ScimTokenUpsertOne) DoNothing() *ScimTokenUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ScimTokenCreate.OnConflict
+// documentation for more info.
+func (u *ScimTokenUpsertOne) Update(set func(*ScimTokenUpsert)) *ScimTokenUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ScimTokenUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ScimTokenUpsertOne) SetUpdatedAt(v time.Time) *ScimTokenUpsertOne {
+	return u.Update(func(s *ScimTokenUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ScimTokenUpsertOne) UpdateUpdatedAt() *ScimTokenUpsertOne {
+	return u.Update(func(s *ScimTokenUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *ScimTokenUpsertOne) ClearUpdatedAt() *ScimTokenUpsertOne {
+	return u.Update(func(s *ScimTokenUpsert) {
+		s.ClearUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *ScimTokenUpsertOne) SetDeletedAt(v time.Time) *ScimTokenUpsertOne {
+	return u.Update(func(s *ScimTokenUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *ScimTokenUpsertOne) UpdateDeletedAt() *ScimTokenUpsertOne {
+	return u.Update(func(s *ScimTokenUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *ScimTokenUpsertOne) ClearDeletedAt() *ScimTokenUpsertOne {
+	return u.Update(func(s *ScimTokenUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *ScimTokenUpsertOne) SetCreatedBy(v uint32) *ScimTokenUpsertOne {
+	return u.Update(func(s *ScimTokenUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *ScimTokenUpsertOne) AddCreatedBy(v uint32) *ScimTokenUpsertOne {
+	return u.Update(func(s *ScimTokenUpsert) {
+		s.AddCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *ScimTokenUpsertOne) UpdateCreatedBy() *ScimTokenUpsertOne {
+	return u.Update(func(s *ScimTokenUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *ScimTokenUpsertOne) ClearCreatedBy() *ScimTokenUpsertOne {
+	return u.Update(func(s *ScimTokenUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *ScimTokenUpsertOne) SetUpdatedBy(v uint32) *ScimTokenUpsertOne {
+	return u.Update(func(s *ScimTokenUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *ScimTokenUpsertOne) AddUpdatedBy(v uint32) *ScimTokenUpsertOne {
+	return u.Update(func(s *ScimTokenUpsert) {
+		s.AddUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *ScimTokenUpsertOne) UpdateUpdatedBy() *ScimTokenUpsertOne {
+	return u.Update(func(s *ScimTokenUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *ScimTokenUpsertOne) ClearUpdatedBy() *ScimTokenUpsertOne {
+	return u.Update(func(s *ScimTokenUpsert) {
+		s.ClearUpdatedBy()
+	})
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *ScimTokenUpsertOne) SetDeletedBy(v uint32) *ScimTokenUpsertOne {
+	return u.Update(func(s *ScimTokenUpsert) {
+		s.SetDeletedBy(v)
+	})
+}
+
+// AddDeletedBy adds v to the "deleted_by" field.
+func (u *ScimTokenUpsertOne) AddDeletedBy(v uint32) *ScimTokenUpsertOne {
+	return u.Update(func(s *ScimTokenUpsert) {
+		s.AddDeletedBy(v)
+	})
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *ScimTokenUpsertOne) UpdateDeletedBy() *ScimTokenUpsertOne {
+	return u.Update(func(s *ScimTokenUpsert) {
+		s.UpdateDeletedBy()
+	})
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *ScimTokenUpsertOne) ClearDeletedBy() *ScimTokenUpsertOne {
+	return u.Update(func(s *ScimTokenUpsert) {
+		s.ClearDeletedBy()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *ScimTokenUpsertOne) SetDescription(v string) *ScimTokenUpsertOne {
+	return u.Update(func(s *ScimTokenUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *ScimTokenUpsertOne) UpdateDescription() *ScimTokenUpsertOne {
+	return u.Update(func(s *ScimTokenUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *ScimTokenUpsertOne) ClearDescription() *ScimTokenUpsertOne {
+	return u.Update(func(s *ScimTokenUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ScimTokenUpsertOne) SetStatus(v scimtoken.Status) *ScimTokenUpsertOne {
+	return u.Update(func(s *ScimTokenUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ScimTokenUpsertOne) UpdateStatus() *ScimTokenUpsertOne {
+	return u.Update(func(s *ScimTokenUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *ScimTokenUpsertOne) SetName(v string) *ScimTokenUpsertOne {
+	return u.Update(func(s *ScimTokenUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ScimTokenUpsertOne) UpdateName() *ScimTokenUpsertOne {
+	return u.Update(func(s *ScimTokenUpsert) {
+		s.UpdateName()
+	})
+}
+
+// ClearName clears the value of the "name" field.
+func (u *ScimTokenUpsertOne) ClearName() *ScimTokenUpsertOne {
+	return u.Update(func(s *ScimTokenUpsert) {
+		s.ClearName()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *ScimTokenUpsertOne) SetExpiresAt(v time.Time) *ScimTokenUpsertOne {
+	return u.Update(func(s *ScimTokenUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *ScimTokenUpsertOne) UpdateExpiresAt() *ScimTokenUpsertOne {
+	return u.Update(func(s *ScimTokenUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *ScimTokenUpsertOne) ClearExpiresAt() *ScimTokenUpsertOne {
+	return u.Update(func(s *ScimTokenUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (u *ScimTokenUpsertOne) SetLastUsedAt(v time.Time) *ScimTokenUpsertOne {
+	return u.Update(func(s *ScimTokenUpsert) {
+		s.SetLastUsedAt(v)
+	})
+}
+
+// UpdateLastUsedAt sets the "last_used_at" field to the value that was provided on create.
+func (u *ScimTokenUpsertOne) UpdateLastUsedAt() *ScimTokenUpsertOne {
+	return u.Update(func(s *ScimTokenUpsert) {
+		s.UpdateLastUsedAt()
+	})
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (u *ScimTokenUpsertOne) ClearLastUsedAt() *ScimTokenUpsertOne {
+	return u.Update(func(s *ScimTokenUpsert) {
+		s.ClearLastUsedAt()
+	})
+}
+
+// SetLastUsedIP sets the "last_used_ip" field.
+func (u *ScimTokenUpsertOne) SetLastUsedIP(v string) *ScimTokenUpsertOne {
+	return u.Update(func(s *ScimTokenUpsert) {
+		s.SetLastUsedIP(v)
+	})
+}
+
+// UpdateLastUsedIP sets the "last_used_ip" field to the value that was provided on create.
+func (u *ScimTokenUpsertOne) UpdateLastUsedIP() *ScimTokenUpsertOne {
+	return u.Update(func(s *ScimTokenUpsert) {
+		s.UpdateLastUsedIP()
+	})
+}
+
+// ClearLastUsedIP clears the value of the "last_used_ip" field.
+func (u *ScimTokenUpsertOne) ClearLastUsedIP() *ScimTokenUpsertOne {
+	return u.Update(func(s *ScimTokenUpsert) {
+		s.ClearLastUsedIP()
+	})
+}
+
+// Exec executes the query.
+func (u *ScimTokenUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ScimTokenCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ScimTokenUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ScimTokenUpsertOne) ID(ctx context.Context) (id uint32, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ScimTokenUpsertOne) IDX(ctx context.Context) uint32 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ScimTokenCreateBulk is the builder for creating many ScimToken entities in bulk.
+type ScimTokenCreateBulk struct {
+	config
+	err      error
+	builders []*ScimTokenCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ScimToken entities in the database.
+func (_c *ScimTokenCreateBulk) Save(ctx context.Context) ([]*ScimToken, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ScimToken, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ScimTokenMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint32(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ScimTokenCreateBulk) SaveX(ctx context.Context) []*ScimToken {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ScimTokenCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ScimTokenCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ScimToken.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ScimTokenUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ScimTokenCreateBulk) OnConflict(opts ...sql.ConflictOption) *ScimTokenUpsertBulk {
+	_c.conflict = opts
+	return &ScimTokenUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ScimToken.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ScimTokenCreateBulk) OnConflictColumns(columns ...string) *ScimTokenUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ScimTokenUpsertBulk{
+		create: _c,
+	}
+}
+
+// ScimTokenUpsertBulk is the builder for "upsert"-ing
+// a bulk of ScimToken nodes.
+type ScimTokenUpsertBulk struct {
+	create *ScimTokenCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ScimToken.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(scimtoken.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ScimTokenUpsertBulk) UpdateNewValues() *ScimTokenUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(scimtoken.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(scimtoken.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(scimtoken.FieldTenantID)
+			}
+			if _, exists := b.mutation.TokenPrefix(); exists {
+				s.SetIgnore(scimtoken.FieldTokenPrefix)
+			}
+			if _, exists := b.mutation.TokenHash(); exists {
+				s.SetIgnore(scimtoken.FieldTokenHash)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ScimToken.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ScimTokenUpsertBulk) Ignore() *ScimTokenUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ScimTokenUpsertBulk) DoNothing() *ScimTokenUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ScimTokenCreateBulk.OnConflict
+// documentation for more info.
+func (u *ScimTokenUpsertBulk) Update(set func(*ScimTokenUpsert)) *ScimTokenUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ScimTokenUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ScimTokenUpsertBulk) SetUpdatedAt(v time.Time) *ScimTokenUpsertBulk {
+	return u.Update(func(s *ScimTokenUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ScimTokenUpsertBulk) UpdateUpdatedAt() *ScimTokenUpsertBulk {
+	return u.Update(func(s *ScimTokenUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *ScimTokenUpsertBulk) ClearUpdatedAt() *ScimTokenUpsertBulk {
+	return u.Update(func(s *ScimTokenUpsert) {
+		s.ClearUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *ScimTokenUpsertBulk) SetDeletedAt(v time.Time) *ScimTokenUpsertBulk {
+	return u.Update(func(s *ScimTokenUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *ScimTokenUpsertBulk) UpdateDeletedAt() *ScimTokenUpsertBulk {
+	return u.Update(func(s *ScimTokenUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *ScimTokenUpsertBulk) ClearDeletedAt() *ScimTokenUpsertBulk {
+	return u.Update(func(s *ScimTokenUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *ScimTokenUpsertBulk) SetCreatedBy(v uint32) *ScimTokenUpsertBulk {
+	return u.Update(func(s *ScimTokenUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *ScimTokenUpsertBulk) AddCreatedBy(v uint32) *ScimTokenUpsertBulk {
+	return u.Update(func(s *ScimTokenUpsert) {
+		s.AddCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *ScimTokenUpsertBulk) UpdateCreatedBy() *ScimTokenUpsertBulk {
+	return u.Update(func(s *ScimTokenUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *ScimTokenUpsertBulk) ClearCreatedBy() *ScimTokenUpsertBulk {
+	return u.Update(func(s *ScimTokenUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *ScimTokenUpsertBulk) SetUpdatedBy(v uint32) *ScimTokenUpsertBulk {
+	return u.Update(func(s *ScimTokenUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *ScimTokenUpsertBulk) AddUpdatedBy(v uint32) *ScimTokenUpsertBulk {
+	return u.Update(func(s *ScimTokenUpsert) {
+		s.AddUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *ScimTokenUpsertBulk) UpdateUpdatedBy() *ScimTokenUpsertBulk {
+	return u.Update(func(s *ScimTokenUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *ScimTokenUpsertBulk) ClearUpdatedBy() *ScimTokenUpsertBulk {
+	return u.Update(func(s *ScimTokenUpsert) {
+		s.ClearUpdatedBy()
+	})
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *ScimTokenUpsertBulk) SetDeletedBy(v uint32) *ScimTokenUpsertBulk {
+	return u.Update(func(s *ScimTokenUpsert) {
+		s.SetDeletedBy(v)
+	})
+}
+
+// AddDeletedBy adds v to the "deleted_by" field.
+func (u *ScimTokenUpsertBulk) AddDeletedBy(v uint32) *ScimTokenUpsertBulk {
+	return u.Update(func(s *ScimTokenUpsert) {
+		s.AddDeletedBy(v)
+	})
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *ScimTokenUpsertBulk) UpdateDeletedBy() *ScimTokenUpsertBulk {
+	return u.Update(func(s *ScimTokenUpsert) {
+		s.UpdateDeletedBy()
+	})
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *ScimTokenUpsertBulk) ClearDeletedBy() *ScimTokenUpsertBulk {
+	return u.Update(func(s *ScimTokenUpsert) {
+		s.ClearDeletedBy()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *ScimTokenUpsertBulk) SetDescription(v string) *ScimTokenUpsertBulk {
+	return u.Update(func(s *ScimTokenUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *ScimTokenUpsertBulk) UpdateDescription() *ScimTokenUpsertBulk {
+	return u.Update(func(s *ScimTokenUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *ScimTokenUpsertBulk) ClearDescription() *ScimTokenUpsertBulk {
+	return u.Update(func(s *ScimTokenUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ScimTokenUpsertBulk) SetStatus(v scimtoken.Status) *ScimTokenUpsertBulk {
+	return u.Update(func(s *ScimTokenUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ScimTokenUpsertBulk) UpdateStatus() *ScimTokenUpsertBulk {
+	return u.Update(func(s *ScimTokenUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *ScimTokenUpsertBulk) SetName(v string) *ScimTokenUpsertBulk {
+	return u.Update(func(s *ScimTokenUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ScimTokenUpsertBulk) UpdateName() *ScimTokenUpsertBulk {
+	return u.Update(func(s *ScimTokenUpsert) {
+		s.UpdateName()
+	})
+}
+
+// ClearName clears the value of the "name" field.
+func (u *ScimTokenUpsertBulk) ClearName() *ScimTokenUpsertBulk {
+	return u.Update(func(s *ScimTokenUpsert) {
+		s.ClearName()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *ScimTokenUpsertBulk) SetExpiresAt(v time.Time) *ScimTokenUpsertBulk {
+	return u.Update(func(s *ScimTokenUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *ScimTokenUpsertBulk) UpdateExpiresAt() *ScimTokenUpsertBulk {
+	return u.Update(func(s *ScimTokenUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *ScimTokenUpsertBulk) ClearExpiresAt() *ScimTokenUpsertBulk {
+	return u.Update(func(s *ScimTokenUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (u *ScimTokenUpsertBulk) SetLastUsedAt(v time.Time) *ScimTokenUpsertBulk {
+	return u.Update(func(s *ScimTokenUpsert) {
+		s.SetLastUsedAt(v)
+	})
+}
+
+// UpdateLastUsedAt sets the "last_used_at" field to the value that was provided on create.
+func (u *ScimTokenUpsertBulk) UpdateLastUsedAt() *ScimTokenUpsertBulk {
+	return u.Update(func(s *ScimTokenUpsert) {
+		s.UpdateLastUsedAt()
+	})
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (u *ScimTokenUpsertBulk) ClearLastUsedAt() *ScimTokenUpsertBulk {
+	return u.Update(func(s *ScimTokenUpsert) {
+		s.ClearLastUsedAt()
+	})
+}
+
+// SetLastUsedIP sets the "last_used_ip" field.
+func (u *ScimTokenUpsertBulk) SetLastUsedIP(v string) *ScimTokenUpsertBulk {
+	return u.Update(func(s *ScimTokenUpsert) {
+		s.SetLastUsedIP(v)
+	})
+}
+
+// UpdateLastUsedIP sets the "last_used_ip" field to the value that was provided on create.
+func (u *ScimTokenUpsertBulk) UpdateLastUsedIP() *ScimTokenUpsertBulk {
+	return u.Update(func(s *ScimTokenUpsert) {
+		s.UpdateLastUsedIP()
+	})
+}
+
+// ClearLastUsedIP clears the value of the "last_used_ip" field.
+func (u *ScimTokenUpsertBulk) ClearLastUsedIP() *ScimTokenUpsertBulk {
+	return u.Update(func(s *ScimTokenUpsert) {
+		s.ClearLastUsedIP()
+	})
+}
+
+// Exec executes the query.
+func (u *ScimTokenUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ScimTokenCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ScimTokenCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ScimTokenUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
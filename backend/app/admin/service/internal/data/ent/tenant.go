@@ -43,6 +43,8 @@ type Tenant struct {
 	Domain *string `json:"domain,omitempty"`
 	// 所属行业
 	Industry *string `json:"industry,omitempty"`
+	// 时区
+	Timezone *string `json:"timezone,omitempty"`
 	// 管理员用户ID
 	AdminUserID *uint32 `json:"admin_user_id,omitempty"`
 	// 租户状态
@@ -93,7 +95,7 @@ func (*Tenant) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case tenant.FieldID, tenant.FieldCreatedBy, tenant.FieldUpdatedBy, tenant.FieldDeletedBy, tenant.FieldAdminUserID:
 			values[i] = new(sql.NullInt64)
-		case tenant.FieldRemark, tenant.FieldName, tenant.FieldCode, tenant.FieldLogoURL, tenant.FieldDomain, tenant.FieldIndustry, tenant.FieldStatus, tenant.FieldType, tenant.FieldAuditStatus, tenant.FieldSubscriptionPlan:
+		case tenant.FieldRemark, tenant.FieldName, tenant.FieldCode, tenant.FieldLogoURL, tenant.FieldDomain, tenant.FieldIndustry, tenant.FieldTimezone, tenant.FieldStatus, tenant.FieldType, tenant.FieldAuditStatus, tenant.FieldSubscriptionPlan:
 			values[i] = new(sql.NullString)
 		case tenant.FieldCreatedAt, tenant.FieldUpdatedAt, tenant.FieldDeletedAt, tenant.FieldSubscriptionAt, tenant.FieldUnsubscribeAt, tenant.FieldExpiredAt:
 			values[i] = new(sql.NullTime)
@@ -204,6 +206,13 @@ func (_m *Tenant) assignValues(columns []string, values []any) error {
 				_m.Industry = new(string)
 				*_m.Industry = value.String
 			}
+		case tenant.FieldTimezone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field timezone", values[i])
+			} else if value.Valid {
+				_m.Timezone = new(string)
+				*_m.Timezone = value.String
+			}
 		case tenant.FieldAdminUserID:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field admin_user_id", values[i])
@@ -368,6 +377,11 @@ func (_m *Tenant) String() string {
 		builder.WriteString(*v)
 	}
 	builder.WriteString(", ")
+	if v := _m.Timezone; v != nil {
+		builder.WriteString("timezone=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	if v := _m.AdminUserID; v != nil {
 		builder.WriteString("admin_user_id=")
 		builder.WriteString(fmt.Sprintf("%v", *v))
@@ -5,6 +5,7 @@
 import (
 	"fmt"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 )
@@ -38,6 +39,8 @@
 	FieldDomain = "domain"
 	// FieldIndustry holds the string denoting the industry field in the database.
 	FieldIndustry = "industry"
+	// FieldTimezone holds the string denoting the timezone field in the database.
+	FieldTimezone = "timezone"
 	// FieldAdminUserID holds the string denoting the admin_user_id field in the database.
 	FieldAdminUserID = "admin_user_id"
 	// FieldStatus holds the string denoting the status field in the database.
@@ -82,6 +85,7 @@
 	FieldLogoURL,
 	FieldDomain,
 	FieldIndustry,
+	FieldTimezone,
 	FieldAdminUserID,
 	FieldStatus,
 	FieldType,
@@ -113,7 +117,14 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "go-wind-admin/app/admin/service/internal/data/ent/runtime"
 var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
 	// NameValidator is a validator for the "name" field. It is called by the builders before save.
 	NameValidator func(string) error
 	// CodeValidator is a validator for the "code" field. It is called by the builders before save.
@@ -271,6 +282,11 @@ func ByIndustry(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIndustry, opts...).ToFunc()
 }
 
+// ByTimezone orders the results by the timezone field.
+func ByTimezone(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTimezone, opts...).ToFunc()
+}
+
 // ByAdminUserID orders the results by the admin_user_id field.
 func ByAdminUserID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAdminUserID, opts...).ToFunc()
@@ -191,6 +191,20 @@ func (_c *TenantCreate) SetNillableIndustry(v *string) *TenantCreate {
 	return _c
 }
 
+// SetTimezone sets the "timezone" field.
+func (_c *TenantCreate) SetTimezone(v string) *TenantCreate {
+	_c.mutation.SetTimezone(v)
+	return _c
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (_c *TenantCreate) SetNillableTimezone(v *string) *TenantCreate {
+	if v != nil {
+		_c.SetTimezone(*v)
+	}
+	return _c
+}
+
 // SetAdminUserID sets the "admin_user_id" field.
 func (_c *TenantCreate) SetAdminUserID(v uint32) *TenantCreate {
 	_c.mutation.SetAdminUserID(v)
@@ -335,7 +349,9 @@ func (_c *TenantCreate) Mutation() *TenantMutation {
 
 // Save creates the Tenant in the database.
 func (_c *TenantCreate) Save(ctx context.Context) (*Tenant, error) {
-	_c.defaults()
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -362,7 +378,7 @@ func (_c *TenantCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_c *TenantCreate) defaults() {
+func (_c *TenantCreate) defaults() error {
 	if _, ok := _c.mutation.Status(); !ok {
 		v := tenant.DefaultStatus
 		_c.mutation.SetStatus(v)
@@ -371,6 +387,7 @@ func (_c *TenantCreate) defaults() {
 		v := tenant.DefaultType
 		_c.mutation.SetType(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -486,6 +503,10 @@ func (_c *TenantCreate) createSpec() (*Tenant, *sqlgraph.CreateSpec) {
 		_spec.SetField(tenant.FieldIndustry, field.TypeString, value)
 		_node.Industry = &value
 	}
+	if value, ok := _c.mutation.Timezone(); ok {
+		_spec.SetField(tenant.FieldTimezone, field.TypeString, value)
+		_node.Timezone = &value
+	}
 	if value, ok := _c.mutation.AdminUserID(); ok {
 		_spec.SetField(tenant.FieldAdminUserID, field.TypeUint32, value)
 		_node.AdminUserID = &value
@@ -803,6 +824,24 @@ func (u *TenantUpsert) ClearIndustry() *TenantUpsert {
 	return u
 }
 
+// SetTimezone sets the "timezone" field.
+func (u *TenantUpsert) SetTimezone(v string) *TenantUpsert {
+	u.Set(tenant.FieldTimezone, v)
+	return u
+}
+
+// UpdateTimezone sets the "timezone" field to the value that was provided on create.
+func (u *TenantUpsert) UpdateTimezone() *TenantUpsert {
+	u.SetExcluded(tenant.FieldTimezone)
+	return u
+}
+
+// ClearTimezone clears the value of the "timezone" field.
+func (u *TenantUpsert) ClearTimezone() *TenantUpsert {
+	u.SetNull(tenant.FieldTimezone)
+	return u
+}
+
 // SetAdminUserID sets the "admin_user_id" field.
 func (u *TenantUpsert) SetAdminUserID(v uint32) *TenantUpsert {
 	u.Set(tenant.FieldAdminUserID, v)
@@ -1256,6 +1295,27 @@ func (u *TenantUpsertOne) ClearIndustry() *TenantUpsertOne {
 	})
 }
 
+// SetTimezone sets the "timezone" field.
+func (u *TenantUpsertOne) SetTimezone(v string) *TenantUpsertOne {
+	return u.Update(func(s *TenantUpsert) {
+		s.SetTimezone(v)
+	})
+}
+
+// UpdateTimezone sets the "timezone" field to the value that was provided on create.
+func (u *TenantUpsertOne) UpdateTimezone() *TenantUpsertOne {
+	return u.Update(func(s *TenantUpsert) {
+		s.UpdateTimezone()
+	})
+}
+
+// ClearTimezone clears the value of the "timezone" field.
+func (u *TenantUpsertOne) ClearTimezone() *TenantUpsertOne {
+	return u.Update(func(s *TenantUpsert) {
+		s.ClearTimezone()
+	})
+}
+
 // SetAdminUserID sets the "admin_user_id" field.
 func (u *TenantUpsertOne) SetAdminUserID(v uint32) *TenantUpsertOne {
 	return u.Update(func(s *TenantUpsert) {
@@ -1900,6 +1960,27 @@ func (u *TenantUpsertBulk) ClearIndustry() *TenantUpsertBulk {
 	})
 }
 
+// SetTimezone sets the "timezone" field.
+func (u *TenantUpsertBulk) SetTimezone(v string) *TenantUpsertBulk {
+	return u.Update(func(s *TenantUpsert) {
+		s.SetTimezone(v)
+	})
+}
+
+// UpdateTimezone sets the "timezone" field to the value that was provided on create.
+func (u *TenantUpsertBulk) UpdateTimezone() *TenantUpsertBulk {
+	return u.Update(func(s *TenantUpsert) {
+		s.UpdateTimezone()
+	})
+}
+
+// ClearTimezone clears the value of the "timezone" field.
+func (u *TenantUpsertBulk) ClearTimezone() *TenantUpsertBulk {
+	return u.Update(func(s *TenantUpsert) {
+		s.ClearTimezone()
+	})
+}
+
 // SetAdminUserID sets the "admin_user_id" field.
 func (u *TenantUpsertBulk) SetAdminUserID(v uint32) *TenantUpsertBulk {
 	return u.Update(func(s *TenantUpsert) {
@@ -271,6 +271,26 @@ func (_u *TenantUpdate) ClearIndustry() *TenantUpdate {
 	return _u
 }
 
+// SetTimezone sets the "timezone" field.
+func (_u *TenantUpdate) SetTimezone(v string) *TenantUpdate {
+	_u.mutation.SetTimezone(v)
+	return _u
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (_u *TenantUpdate) SetNillableTimezone(v *string) *TenantUpdate {
+	if v != nil {
+		_u.SetTimezone(*v)
+	}
+	return _u
+}
+
+// ClearTimezone clears the value of the "timezone" field.
+func (_u *TenantUpdate) ClearTimezone() *TenantUpdate {
+	_u.mutation.ClearTimezone()
+	return _u
+}
+
 // SetAdminUserID sets the "admin_user_id" field.
 func (_u *TenantUpdate) SetAdminUserID(v uint32) *TenantUpdate {
 	_u.mutation.ResetAdminUserID()
@@ -621,6 +641,12 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.IndustryCleared() {
 		_spec.ClearField(tenant.FieldIndustry, field.TypeString)
 	}
+	if value, ok := _u.mutation.Timezone(); ok {
+		_spec.SetField(tenant.FieldTimezone, field.TypeString, value)
+	}
+	if _u.mutation.TimezoneCleared() {
+		_spec.ClearField(tenant.FieldTimezone, field.TypeString)
+	}
 	if value, ok := _u.mutation.AdminUserID(); ok {
 		_spec.SetField(tenant.FieldAdminUserID, field.TypeUint32, value)
 	}
@@ -964,6 +990,26 @@ func (_u *TenantUpdateOne) ClearIndustry() *TenantUpdateOne {
 	return _u
 }
 
+// SetTimezone sets the "timezone" field.
+func (_u *TenantUpdateOne) SetTimezone(v string) *TenantUpdateOne {
+	_u.mutation.SetTimezone(v)
+	return _u
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (_u *TenantUpdateOne) SetNillableTimezone(v *string) *TenantUpdateOne {
+	if v != nil {
+		_u.SetTimezone(*v)
+	}
+	return _u
+}
+
+// ClearTimezone clears the value of the "timezone" field.
+func (_u *TenantUpdateOne) ClearTimezone() *TenantUpdateOne {
+	_u.mutation.ClearTimezone()
+	return _u
+}
+
 // SetAdminUserID sets the "admin_user_id" field.
 func (_u *TenantUpdateOne) SetAdminUserID(v uint32) *TenantUpdateOne {
 	_u.mutation.ResetAdminUserID()
@@ -1344,6 +1390,12 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 	if _u.mutation.IndustryCleared() {
 		_spec.ClearField(tenant.FieldIndustry, field.TypeString)
 	}
+	if value, ok := _u.mutation.Timezone(); ok {
+		_spec.SetField(tenant.FieldTimezone, field.TypeString, value)
+	}
+	if _u.mutation.TimezoneCleared() {
+		_spec.ClearField(tenant.FieldTimezone, field.TypeString)
+	}
 	if value, ok := _u.mutation.AdminUserID(); ok {
 		_spec.SetField(tenant.FieldAdminUserID, field.TypeUint32, value)
 	}
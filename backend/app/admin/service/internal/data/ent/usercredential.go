@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"go-wind-admin/app/admin/service/internal/data/ent/usercredential"
 	"strings"
@@ -58,7 +59,11 @@ type UserCredential struct {
 	ResetTokenExpiresAt *time.Time `json:"reset_token_expires_at,omitempty"`
 	// 重置令牌使用时间
 	ResetTokenUsedAt *time.Time `json:"reset_token_used_at,omitempty"`
-	selectValues     sql.SelectValues
+	// 密码最近修改时间，用于密码有效期判定
+	CredentialChangedAt *time.Time `json:"credential_changed_at,omitempty"`
+	// 最近使用过的密码哈希
+	CredentialHistory []string `json:"credential_history,omitempty"`
+	selectValues      sql.SelectValues
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -66,13 +71,15 @@ func (*UserCredential) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case usercredential.FieldCredentialHistory:
+			values[i] = new([]byte)
 		case usercredential.FieldIsPrimary:
 			values[i] = new(sql.NullBool)
 		case usercredential.FieldID, usercredential.FieldTenantID, usercredential.FieldUserID:
 			values[i] = new(sql.NullInt64)
 		case usercredential.FieldIdentityType, usercredential.FieldIdentifier, usercredential.FieldCredentialType, usercredential.FieldCredential, usercredential.FieldStatus, usercredential.FieldExtraInfo, usercredential.FieldProvider, usercredential.FieldProviderAccountID, usercredential.FieldActivateTokenHash, usercredential.FieldResetTokenHash:
 			values[i] = new(sql.NullString)
-		case usercredential.FieldCreatedAt, usercredential.FieldUpdatedAt, usercredential.FieldDeletedAt, usercredential.FieldActivateTokenExpiresAt, usercredential.FieldActivateTokenUsedAt, usercredential.FieldResetTokenExpiresAt, usercredential.FieldResetTokenUsedAt:
+		case usercredential.FieldCreatedAt, usercredential.FieldUpdatedAt, usercredential.FieldDeletedAt, usercredential.FieldActivateTokenExpiresAt, usercredential.FieldActivateTokenUsedAt, usercredential.FieldResetTokenExpiresAt, usercredential.FieldResetTokenUsedAt, usercredential.FieldCredentialChangedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -235,6 +242,21 @@ func (_m *UserCredential) assignValues(columns []string, values []any) error {
 				_m.ResetTokenUsedAt = new(time.Time)
 				*_m.ResetTokenUsedAt = value.Time
 			}
+		case usercredential.FieldCredentialChangedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field credential_changed_at", values[i])
+			} else if value.Valid {
+				_m.CredentialChangedAt = new(time.Time)
+				*_m.CredentialChangedAt = value.Time
+			}
+		case usercredential.FieldCredentialHistory:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field credential_history", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.CredentialHistory); err != nil {
+					return fmt.Errorf("unmarshal field credential_history: %w", err)
+				}
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -370,6 +392,14 @@ func (_m *UserCredential) String() string {
 		builder.WriteString("reset_token_used_at=")
 		builder.WriteString(v.Format(time.ANSIC))
 	}
+	builder.WriteString(", ")
+	if v := _m.CredentialChangedAt; v != nil {
+		builder.WriteString("credential_changed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("credential_history=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CredentialHistory))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -54,6 +54,10 @@
 	FieldResetTokenExpiresAt = "reset_token_expires_at"
 	// FieldResetTokenUsedAt holds the string denoting the reset_token_used_at field in the database.
 	FieldResetTokenUsedAt = "reset_token_used_at"
+	// FieldCredentialChangedAt holds the string denoting the credential_changed_at field in the database.
+	FieldCredentialChangedAt = "credential_changed_at"
+	// FieldCredentialHistory holds the string denoting the credential_history field in the database.
+	FieldCredentialHistory = "credential_history"
 	// Table holds the table name of the usercredential in the database.
 	Table = "sys_user_credentials"
 )
@@ -81,6 +85,8 @@
 	FieldResetTokenHash,
 	FieldResetTokenExpiresAt,
 	FieldResetTokenUsedAt,
+	FieldCredentialChangedAt,
+	FieldCredentialHistory,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -179,6 +185,7 @@ func IdentityTypeValidator(it IdentityType) error {
 	CredentialTypeSsoToken               CredentialType = "SSO_TOKEN"
 	CredentialTypeSamlAssertion          CredentialType = "SAML_ASSERTION"
 	CredentialTypeOpenidConnectIdToken   CredentialType = "OPENID_CONNECT_ID_TOKEN"
+	CredentialTypeLdapBind               CredentialType = "LDAP_BIND"
 	CredentialTypeSessionCookie          CredentialType = "SESSION_COOKIE"
 	CredentialTypeTemporaryCredential    CredentialType = "TEMPORARY_CREDENTIAL"
 	CredentialTypeCustom                 CredentialType = "CUSTOM"
@@ -192,7 +199,7 @@ func (ct CredentialType) String() string {
 // CredentialTypeValidator is a validator for the "credential_type" field enum values. It is called by the builders before save.
 func CredentialTypeValidator(ct CredentialType) error {
 	switch ct {
-	case CredentialTypePasswordHash, CredentialTypeApiKey, CredentialTypeApiSecret, CredentialTypeAccessToken, CredentialTypeRefreshToken, CredentialTypeJWT, CredentialTypeOauthToken, CredentialTypeOauthAuthorizationCode, CredentialTypeOauthClientCredentials, CredentialTypeOTP, CredentialTypeTOTP, CredentialTypeSmsOtp, CredentialTypeEmailOtp, CredentialTypeHardwareToken, CredentialTypeSoftwareToken, CredentialTypeSecurityQuestion, CredentialTypeBiometric, CredentialTypeBiometricToken, CredentialTypeSsoToken, CredentialTypeSamlAssertion, CredentialTypeOpenidConnectIdToken, CredentialTypeSessionCookie, CredentialTypeTemporaryCredential, CredentialTypeCustom, CredentialTypeReservedForFuture:
+	case CredentialTypePasswordHash, CredentialTypeApiKey, CredentialTypeApiSecret, CredentialTypeAccessToken, CredentialTypeRefreshToken, CredentialTypeJWT, CredentialTypeOauthToken, CredentialTypeOauthAuthorizationCode, CredentialTypeOauthClientCredentials, CredentialTypeOTP, CredentialTypeTOTP, CredentialTypeSmsOtp, CredentialTypeEmailOtp, CredentialTypeHardwareToken, CredentialTypeSoftwareToken, CredentialTypeSecurityQuestion, CredentialTypeBiometric, CredentialTypeBiometricToken, CredentialTypeSsoToken, CredentialTypeSamlAssertion, CredentialTypeOpenidConnectIdToken, CredentialTypeLdapBind, CredentialTypeSessionCookie, CredentialTypeTemporaryCredential, CredentialTypeCustom, CredentialTypeReservedForFuture:
 		return nil
 	default:
 		return fmt.Errorf("usercredential: invalid enum value for credential_type field: %q", ct)
@@ -337,3 +344,8 @@ func ByResetTokenExpiresAt(opts ...sql.OrderTermOption) OrderOption {
 func ByResetTokenUsedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldResetTokenUsedAt, opts...).ToFunc()
 }
+
+// ByCredentialChangedAt orders the results by the credential_changed_at field.
+func ByCredentialChangedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCredentialChangedAt, opts...).ToFunc()
+}
@@ -139,6 +139,11 @@ func ResetTokenUsedAt(v time.Time) predicate.UserCredential {
 	return predicate.UserCredential(sql.FieldEQ(FieldResetTokenUsedAt, v))
 }
 
+// CredentialChangedAt applies equality check predicate on the "credential_changed_at" field. It's identical to CredentialChangedAtEQ.
+func CredentialChangedAt(v time.Time) predicate.UserCredential {
+	return predicate.UserCredential(sql.FieldEQ(FieldCredentialChangedAt, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.UserCredential {
 	return predicate.UserCredential(sql.FieldEQ(FieldCreatedAt, v))
@@ -1224,6 +1229,66 @@ func ResetTokenUsedAtNotNil() predicate.UserCredential {
 	return predicate.UserCredential(sql.FieldNotNull(FieldResetTokenUsedAt))
 }
 
+// CredentialChangedAtEQ applies the EQ predicate on the "credential_changed_at" field.
+func CredentialChangedAtEQ(v time.Time) predicate.UserCredential {
+	return predicate.UserCredential(sql.FieldEQ(FieldCredentialChangedAt, v))
+}
+
+// CredentialChangedAtNEQ applies the NEQ predicate on the "credential_changed_at" field.
+func CredentialChangedAtNEQ(v time.Time) predicate.UserCredential {
+	return predicate.UserCredential(sql.FieldNEQ(FieldCredentialChangedAt, v))
+}
+
+// CredentialChangedAtIn applies the In predicate on the "credential_changed_at" field.
+func CredentialChangedAtIn(vs ...time.Time) predicate.UserCredential {
+	return predicate.UserCredential(sql.FieldIn(FieldCredentialChangedAt, vs...))
+}
+
+// CredentialChangedAtNotIn applies the NotIn predicate on the "credential_changed_at" field.
+func CredentialChangedAtNotIn(vs ...time.Time) predicate.UserCredential {
+	return predicate.UserCredential(sql.FieldNotIn(FieldCredentialChangedAt, vs...))
+}
+
+// CredentialChangedAtGT applies the GT predicate on the "credential_changed_at" field.
+func CredentialChangedAtGT(v time.Time) predicate.UserCredential {
+	return predicate.UserCredential(sql.FieldGT(FieldCredentialChangedAt, v))
+}
+
+// CredentialChangedAtGTE applies the GTE predicate on the "credential_changed_at" field.
+func CredentialChangedAtGTE(v time.Time) predicate.UserCredential {
+	return predicate.UserCredential(sql.FieldGTE(FieldCredentialChangedAt, v))
+}
+
+// CredentialChangedAtLT applies the LT predicate on the "credential_changed_at" field.
+func CredentialChangedAtLT(v time.Time) predicate.UserCredential {
+	return predicate.UserCredential(sql.FieldLT(FieldCredentialChangedAt, v))
+}
+
+// CredentialChangedAtLTE applies the LTE predicate on the "credential_changed_at" field.
+func CredentialChangedAtLTE(v time.Time) predicate.UserCredential {
+	return predicate.UserCredential(sql.FieldLTE(FieldCredentialChangedAt, v))
+}
+
+// CredentialChangedAtIsNil applies the IsNil predicate on the "credential_changed_at" field.
+func CredentialChangedAtIsNil() predicate.UserCredential {
+	return predicate.UserCredential(sql.FieldIsNull(FieldCredentialChangedAt))
+}
+
+// CredentialChangedAtNotNil applies the NotNil predicate on the "credential_changed_at" field.
+func CredentialChangedAtNotNil() predicate.UserCredential {
+	return predicate.UserCredential(sql.FieldNotNull(FieldCredentialChangedAt))
+}
+
+// CredentialHistoryIsNil applies the IsNil predicate on the "credential_history" field.
+func CredentialHistoryIsNil() predicate.UserCredential {
+	return predicate.UserCredential(sql.FieldIsNull(FieldCredentialHistory))
+}
+
+// CredentialHistoryNotNil applies the NotNil predicate on the "credential_history" field.
+func CredentialHistoryNotNil() predicate.UserCredential {
+	return predicate.UserCredential(sql.FieldNotNull(FieldCredentialHistory))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.UserCredential) predicate.UserCredential {
 	return predicate.UserCredential(sql.AndPredicates(predicates...))
@@ -302,6 +302,26 @@ func (_c *UserCredentialCreate) SetNillableResetTokenUsedAt(v *time.Time) *UserC
 	return _c
 }
 
+// SetCredentialChangedAt sets the "credential_changed_at" field.
+func (_c *UserCredentialCreate) SetCredentialChangedAt(v time.Time) *UserCredentialCreate {
+	_c.mutation.SetCredentialChangedAt(v)
+	return _c
+}
+
+// SetNillableCredentialChangedAt sets the "credential_changed_at" field if the given value is not nil.
+func (_c *UserCredentialCreate) SetNillableCredentialChangedAt(v *time.Time) *UserCredentialCreate {
+	if v != nil {
+		_c.SetCredentialChangedAt(*v)
+	}
+	return _c
+}
+
+// SetCredentialHistory sets the "credential_history" field.
+func (_c *UserCredentialCreate) SetCredentialHistory(v []string) *UserCredentialCreate {
+	_c.mutation.SetCredentialHistory(v)
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *UserCredentialCreate) SetID(v uint32) *UserCredentialCreate {
 	_c.mutation.SetID(v)
@@ -523,6 +543,14 @@ func (_c *UserCredentialCreate) createSpec() (*UserCredential, *sqlgraph.CreateS
 		_spec.SetField(usercredential.FieldResetTokenUsedAt, field.TypeTime, value)
 		_node.ResetTokenUsedAt = &value
 	}
+	if value, ok := _c.mutation.CredentialChangedAt(); ok {
+		_spec.SetField(usercredential.FieldCredentialChangedAt, field.TypeTime, value)
+		_node.CredentialChangedAt = &value
+	}
+	if value, ok := _c.mutation.CredentialHistory(); ok {
+		_spec.SetField(usercredential.FieldCredentialHistory, field.TypeJSON, value)
+		_node.CredentialHistory = value
+	}
 	return _node, _spec
 }
 
@@ -905,6 +933,42 @@ func (u *UserCredentialUpsert) ClearResetTokenUsedAt() *UserCredentialUpsert {
 	return u
 }
 
+// SetCredentialChangedAt sets the "credential_changed_at" field.
+func (u *UserCredentialUpsert) SetCredentialChangedAt(v time.Time) *UserCredentialUpsert {
+	u.Set(usercredential.FieldCredentialChangedAt, v)
+	return u
+}
+
+// UpdateCredentialChangedAt sets the "credential_changed_at" field to the value that was provided on create.
+func (u *UserCredentialUpsert) UpdateCredentialChangedAt() *UserCredentialUpsert {
+	u.SetExcluded(usercredential.FieldCredentialChangedAt)
+	return u
+}
+
+// ClearCredentialChangedAt clears the value of the "credential_changed_at" field.
+func (u *UserCredentialUpsert) ClearCredentialChangedAt() *UserCredentialUpsert {
+	u.SetNull(usercredential.FieldCredentialChangedAt)
+	return u
+}
+
+// SetCredentialHistory sets the "credential_history" field.
+func (u *UserCredentialUpsert) SetCredentialHistory(v []string) *UserCredentialUpsert {
+	u.Set(usercredential.FieldCredentialHistory, v)
+	return u
+}
+
+// UpdateCredentialHistory sets the "credential_history" field to the value that was provided on create.
+func (u *UserCredentialUpsert) UpdateCredentialHistory() *UserCredentialUpsert {
+	u.SetExcluded(usercredential.FieldCredentialHistory)
+	return u
+}
+
+// ClearCredentialHistory clears the value of the "credential_history" field.
+func (u *UserCredentialUpsert) ClearCredentialHistory() *UserCredentialUpsert {
+	u.SetNull(usercredential.FieldCredentialHistory)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -1344,6 +1408,48 @@ func (u *UserCredentialUpsertOne) ClearResetTokenUsedAt() *UserCredentialUpsertO
 	})
 }
 
+// SetCredentialChangedAt sets the "credential_changed_at" field.
+func (u *UserCredentialUpsertOne) SetCredentialChangedAt(v time.Time) *UserCredentialUpsertOne {
+	return u.Update(func(s *UserCredentialUpsert) {
+		s.SetCredentialChangedAt(v)
+	})
+}
+
+// UpdateCredentialChangedAt sets the "credential_changed_at" field to the value that was provided on create.
+func (u *UserCredentialUpsertOne) UpdateCredentialChangedAt() *UserCredentialUpsertOne {
+	return u.Update(func(s *UserCredentialUpsert) {
+		s.UpdateCredentialChangedAt()
+	})
+}
+
+// ClearCredentialChangedAt clears the value of the "credential_changed_at" field.
+func (u *UserCredentialUpsertOne) ClearCredentialChangedAt() *UserCredentialUpsertOne {
+	return u.Update(func(s *UserCredentialUpsert) {
+		s.ClearCredentialChangedAt()
+	})
+}
+
+// SetCredentialHistory sets the "credential_history" field.
+func (u *UserCredentialUpsertOne) SetCredentialHistory(v []string) *UserCredentialUpsertOne {
+	return u.Update(func(s *UserCredentialUpsert) {
+		s.SetCredentialHistory(v)
+	})
+}
+
+// UpdateCredentialHistory sets the "credential_history" field to the value that was provided on create.
+func (u *UserCredentialUpsertOne) UpdateCredentialHistory() *UserCredentialUpsertOne {
+	return u.Update(func(s *UserCredentialUpsert) {
+		s.UpdateCredentialHistory()
+	})
+}
+
+// ClearCredentialHistory clears the value of the "credential_history" field.
+func (u *UserCredentialUpsertOne) ClearCredentialHistory() *UserCredentialUpsertOne {
+	return u.Update(func(s *UserCredentialUpsert) {
+		s.ClearCredentialHistory()
+	})
+}
+
 // Exec executes the query.
 func (u *UserCredentialUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1949,6 +2055,48 @@ func (u *UserCredentialUpsertBulk) ClearResetTokenUsedAt() *UserCredentialUpsert
 	})
 }
 
+// SetCredentialChangedAt sets the "credential_changed_at" field.
+func (u *UserCredentialUpsertBulk) SetCredentialChangedAt(v time.Time) *UserCredentialUpsertBulk {
+	return u.Update(func(s *UserCredentialUpsert) {
+		s.SetCredentialChangedAt(v)
+	})
+}
+
+// UpdateCredentialChangedAt sets the "credential_changed_at" field to the value that was provided on create.
+func (u *UserCredentialUpsertBulk) UpdateCredentialChangedAt() *UserCredentialUpsertBulk {
+	return u.Update(func(s *UserCredentialUpsert) {
+		s.UpdateCredentialChangedAt()
+	})
+}
+
+// ClearCredentialChangedAt clears the value of the "credential_changed_at" field.
+func (u *UserCredentialUpsertBulk) ClearCredentialChangedAt() *UserCredentialUpsertBulk {
+	return u.Update(func(s *UserCredentialUpsert) {
+		s.ClearCredentialChangedAt()
+	})
+}
+
+// SetCredentialHistory sets the "credential_history" field.
+func (u *UserCredentialUpsertBulk) SetCredentialHistory(v []string) *UserCredentialUpsertBulk {
+	return u.Update(func(s *UserCredentialUpsert) {
+		s.SetCredentialHistory(v)
+	})
+}
+
+// UpdateCredentialHistory sets the "credential_history" field to the value that was provided on create.
+func (u *UserCredentialUpsertBulk) UpdateCredentialHistory() *UserCredentialUpsertBulk {
+	return u.Update(func(s *UserCredentialUpsert) {
+		s.UpdateCredentialHistory()
+	})
+}
+
+// ClearCredentialHistory clears the value of the "credential_history" field.
+func (u *UserCredentialUpsertBulk) ClearCredentialHistory() *UserCredentialUpsertBulk {
+	return u.Update(func(s *UserCredentialUpsert) {
+		s.ClearCredentialHistory()
+	})
+}
+
 // Exec executes the query.
 func (u *UserCredentialUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
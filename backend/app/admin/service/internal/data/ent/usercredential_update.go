@@ -12,6 +12,7 @@
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 )
 
@@ -396,6 +397,44 @@ func (_u *UserCredentialUpdate) ClearResetTokenUsedAt() *UserCredentialUpdate {
 	return _u
 }
 
+// SetCredentialChangedAt sets the "credential_changed_at" field.
+func (_u *UserCredentialUpdate) SetCredentialChangedAt(v time.Time) *UserCredentialUpdate {
+	_u.mutation.SetCredentialChangedAt(v)
+	return _u
+}
+
+// SetNillableCredentialChangedAt sets the "credential_changed_at" field if the given value is not nil.
+func (_u *UserCredentialUpdate) SetNillableCredentialChangedAt(v *time.Time) *UserCredentialUpdate {
+	if v != nil {
+		_u.SetCredentialChangedAt(*v)
+	}
+	return _u
+}
+
+// ClearCredentialChangedAt clears the value of the "credential_changed_at" field.
+func (_u *UserCredentialUpdate) ClearCredentialChangedAt() *UserCredentialUpdate {
+	_u.mutation.ClearCredentialChangedAt()
+	return _u
+}
+
+// SetCredentialHistory sets the "credential_history" field.
+func (_u *UserCredentialUpdate) SetCredentialHistory(v []string) *UserCredentialUpdate {
+	_u.mutation.SetCredentialHistory(v)
+	return _u
+}
+
+// AppendCredentialHistory appends value to the "credential_history" field.
+func (_u *UserCredentialUpdate) AppendCredentialHistory(v []string) *UserCredentialUpdate {
+	_u.mutation.AppendCredentialHistory(v)
+	return _u
+}
+
+// ClearCredentialHistory clears the value of the "credential_history" field.
+func (_u *UserCredentialUpdate) ClearCredentialHistory() *UserCredentialUpdate {
+	_u.mutation.ClearCredentialHistory()
+	return _u
+}
+
 // Mutation returns the UserCredentialMutation object of the builder.
 func (_u *UserCredentialUpdate) Mutation() *UserCredentialMutation {
 	return _u.mutation
@@ -603,6 +642,23 @@ func (_u *UserCredentialUpdate) sqlSave(ctx context.Context) (_node int, err err
 	if _u.mutation.ResetTokenUsedAtCleared() {
 		_spec.ClearField(usercredential.FieldResetTokenUsedAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.CredentialChangedAt(); ok {
+		_spec.SetField(usercredential.FieldCredentialChangedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CredentialChangedAtCleared() {
+		_spec.ClearField(usercredential.FieldCredentialChangedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CredentialHistory(); ok {
+		_spec.SetField(usercredential.FieldCredentialHistory, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedCredentialHistory(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, usercredential.FieldCredentialHistory, value)
+		})
+	}
+	if _u.mutation.CredentialHistoryCleared() {
+		_spec.ClearField(usercredential.FieldCredentialHistory, field.TypeJSON)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -992,6 +1048,44 @@ func (_u *UserCredentialUpdateOne) ClearResetTokenUsedAt() *UserCredentialUpdate
 	return _u
 }
 
+// SetCredentialChangedAt sets the "credential_changed_at" field.
+func (_u *UserCredentialUpdateOne) SetCredentialChangedAt(v time.Time) *UserCredentialUpdateOne {
+	_u.mutation.SetCredentialChangedAt(v)
+	return _u
+}
+
+// SetNillableCredentialChangedAt sets the "credential_changed_at" field if the given value is not nil.
+func (_u *UserCredentialUpdateOne) SetNillableCredentialChangedAt(v *time.Time) *UserCredentialUpdateOne {
+	if v != nil {
+		_u.SetCredentialChangedAt(*v)
+	}
+	return _u
+}
+
+// ClearCredentialChangedAt clears the value of the "credential_changed_at" field.
+func (_u *UserCredentialUpdateOne) ClearCredentialChangedAt() *UserCredentialUpdateOne {
+	_u.mutation.ClearCredentialChangedAt()
+	return _u
+}
+
+// SetCredentialHistory sets the "credential_history" field.
+func (_u *UserCredentialUpdateOne) SetCredentialHistory(v []string) *UserCredentialUpdateOne {
+	_u.mutation.SetCredentialHistory(v)
+	return _u
+}
+
+// AppendCredentialHistory appends value to the "credential_history" field.
+func (_u *UserCredentialUpdateOne) AppendCredentialHistory(v []string) *UserCredentialUpdateOne {
+	_u.mutation.AppendCredentialHistory(v)
+	return _u
+}
+
+// ClearCredentialHistory clears the value of the "credential_history" field.
+func (_u *UserCredentialUpdateOne) ClearCredentialHistory() *UserCredentialUpdateOne {
+	_u.mutation.ClearCredentialHistory()
+	return _u
+}
+
 // Mutation returns the UserCredentialMutation object of the builder.
 func (_u *UserCredentialUpdateOne) Mutation() *UserCredentialMutation {
 	return _u.mutation
@@ -1229,6 +1323,23 @@ func (_u *UserCredentialUpdateOne) sqlSave(ctx context.Context) (_node *UserCred
 	if _u.mutation.ResetTokenUsedAtCleared() {
 		_spec.ClearField(usercredential.FieldResetTokenUsedAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.CredentialChangedAt(); ok {
+		_spec.SetField(usercredential.FieldCredentialChangedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CredentialChangedAtCleared() {
+		_spec.ClearField(usercredential.FieldCredentialChangedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CredentialHistory(); ok {
+		_spec.SetField(usercredential.FieldCredentialHistory, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedCredentialHistory(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, usercredential.FieldCredentialHistory, value)
+		})
+	}
+	if _u.mutation.CredentialHistoryCleared() {
+		_spec.ClearField(usercredential.FieldCredentialHistory, field.TypeJSON)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	_node = &UserCredential{config: _u.config}
 	_spec.Assign = _node.assignValues
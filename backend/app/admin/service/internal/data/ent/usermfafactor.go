@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"go-wind-admin/app/admin/service/internal/data/ent/usermfafactor"
 	"strings"
@@ -37,8 +38,24 @@ type UserMfaFactor struct {
 	// 因子状态
 	Status *usermfafactor.Status `json:"status,omitempty"`
 	// 最近一次用于验证的时间
-	LastUsedAt   *time.Time `json:"last_used_at,omitempty"`
-	selectValues sql.SelectValues
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	// WebAuthn 凭证ID（base64url）
+	CredentialID string `json:"credential_id,omitempty"`
+	// WebAuthn 凭证公钥（COSE 编码）
+	PublicKey []byte `json:"public_key,omitempty"`
+	// WebAuthn 签名计数器
+	SignCount uint32 `json:"sign_count,omitempty"`
+	// 认证器型号标识（AAGUID）
+	Aaguid []byte `json:"aaguid,omitempty"`
+	// 认证器支持的传输方式（usb/nfc/ble/internal/hybrid）
+	Transports []string `json:"transports,omitempty"`
+	// 注册时的认证器标志位（备份资格/备份状态等）
+	CredentialFlags uint8 `json:"credential_flags,omitempty"`
+	// 证明类型
+	AttestationType *string `json:"attestation_type,omitempty"`
+	// 证明格式
+	AttestationFormat *string `json:"attestation_format,omitempty"`
+	selectValues      sql.SelectValues
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -46,9 +63,11 @@ func (*UserMfaFactor) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case usermfafactor.FieldID, usermfafactor.FieldTenantID, usermfafactor.FieldUserID:
+		case usermfafactor.FieldPublicKey, usermfafactor.FieldAaguid, usermfafactor.FieldTransports:
+			values[i] = new([]byte)
+		case usermfafactor.FieldID, usermfafactor.FieldTenantID, usermfafactor.FieldUserID, usermfafactor.FieldSignCount, usermfafactor.FieldCredentialFlags:
 			values[i] = new(sql.NullInt64)
-		case usermfafactor.FieldMethod, usermfafactor.FieldSecretHash, usermfafactor.FieldDisplayName, usermfafactor.FieldStatus:
+		case usermfafactor.FieldMethod, usermfafactor.FieldSecretHash, usermfafactor.FieldDisplayName, usermfafactor.FieldStatus, usermfafactor.FieldCredentialID, usermfafactor.FieldAttestationType, usermfafactor.FieldAttestationFormat:
 			values[i] = new(sql.NullString)
 		case usermfafactor.FieldCreatedAt, usermfafactor.FieldUpdatedAt, usermfafactor.FieldDeletedAt, usermfafactor.FieldLastUsedAt:
 			values[i] = new(sql.NullTime)
@@ -143,6 +162,58 @@ func (_m *UserMfaFactor) assignValues(columns []string, values []any) error {
 				_m.LastUsedAt = new(time.Time)
 				*_m.LastUsedAt = value.Time
 			}
+		case usermfafactor.FieldCredentialID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field credential_id", values[i])
+			} else if value.Valid {
+				_m.CredentialID = value.String
+			}
+		case usermfafactor.FieldPublicKey:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field public_key", values[i])
+			} else if value != nil {
+				_m.PublicKey = *value
+			}
+		case usermfafactor.FieldSignCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sign_count", values[i])
+			} else if value.Valid {
+				_m.SignCount = uint32(value.Int64)
+			}
+		case usermfafactor.FieldAaguid:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field aaguid", values[i])
+			} else if value != nil {
+				_m.Aaguid = *value
+			}
+		case usermfafactor.FieldTransports:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field transports", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Transports); err != nil {
+					return fmt.Errorf("unmarshal field transports: %w", err)
+				}
+			}
+		case usermfafactor.FieldCredentialFlags:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field credential_flags", values[i])
+			} else if value.Valid {
+				_m.CredentialFlags = uint8(value.Int64)
+			}
+		case usermfafactor.FieldAttestationType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field attestation_type", values[i])
+			} else if value.Valid {
+				_m.AttestationType = new(string)
+				*_m.AttestationType = value.String
+			}
+		case usermfafactor.FieldAttestationFormat:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field attestation_format", values[i])
+			} else if value.Valid {
+				_m.AttestationFormat = new(string)
+				*_m.AttestationFormat = value.String
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -228,6 +299,34 @@ func (_m *UserMfaFactor) String() string {
 		builder.WriteString("last_used_at=")
 		builder.WriteString(v.Format(time.ANSIC))
 	}
+	builder.WriteString(", ")
+	builder.WriteString("credential_id=")
+	builder.WriteString(_m.CredentialID)
+	builder.WriteString(", ")
+	builder.WriteString("public_key=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PublicKey))
+	builder.WriteString(", ")
+	builder.WriteString("sign_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SignCount))
+	builder.WriteString(", ")
+	builder.WriteString("aaguid=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Aaguid))
+	builder.WriteString(", ")
+	builder.WriteString("transports=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Transports))
+	builder.WriteString(", ")
+	builder.WriteString("credential_flags=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CredentialFlags))
+	builder.WriteString(", ")
+	if v := _m.AttestationType; v != nil {
+		builder.WriteString("attestation_type=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.AttestationFormat; v != nil {
+		builder.WriteString("attestation_format=")
+		builder.WriteString(*v)
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -34,6 +34,22 @@
 	FieldStatus = "status"
 	// FieldLastUsedAt holds the string denoting the last_used_at field in the database.
 	FieldLastUsedAt = "last_used_at"
+	// FieldCredentialID holds the string denoting the credential_id field in the database.
+	FieldCredentialID = "credential_id"
+	// FieldPublicKey holds the string denoting the public_key field in the database.
+	FieldPublicKey = "public_key"
+	// FieldSignCount holds the string denoting the sign_count field in the database.
+	FieldSignCount = "sign_count"
+	// FieldAaguid holds the string denoting the aaguid field in the database.
+	FieldAaguid = "aaguid"
+	// FieldTransports holds the string denoting the transports field in the database.
+	FieldTransports = "transports"
+	// FieldCredentialFlags holds the string denoting the credential_flags field in the database.
+	FieldCredentialFlags = "credential_flags"
+	// FieldAttestationType holds the string denoting the attestation_type field in the database.
+	FieldAttestationType = "attestation_type"
+	// FieldAttestationFormat holds the string denoting the attestation_format field in the database.
+	FieldAttestationFormat = "attestation_format"
 	// Table holds the table name of the usermfafactor in the database.
 	Table = "sys_user_mfa_factors"
 )
@@ -51,6 +67,14 @@
 	FieldDisplayName,
 	FieldStatus,
 	FieldLastUsedAt,
+	FieldCredentialID,
+	FieldPublicKey,
+	FieldSignCount,
+	FieldAaguid,
+	FieldTransports,
+	FieldCredentialFlags,
+	FieldAttestationType,
+	FieldAttestationFormat,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -77,6 +101,18 @@ func ValidColumn(column string) bool {
 	SecretHashValidator func(string) error
 	// DisplayNameValidator is a validator for the "display_name" field. It is called by the builders before save.
 	DisplayNameValidator func(string) error
+	// DefaultCredentialID holds the default value on creation for the "credential_id" field.
+	DefaultCredentialID string
+	// CredentialIDValidator is a validator for the "credential_id" field. It is called by the builders before save.
+	CredentialIDValidator func(string) error
+	// DefaultSignCount holds the default value on creation for the "sign_count" field.
+	DefaultSignCount uint32
+	// DefaultCredentialFlags holds the default value on creation for the "credential_flags" field.
+	DefaultCredentialFlags uint8
+	// AttestationTypeValidator is a validator for the "attestation_type" field. It is called by the builders before save.
+	AttestationTypeValidator func(string) error
+	// AttestationFormatValidator is a validator for the "attestation_format" field. It is called by the builders before save.
+	AttestationFormatValidator func(string) error
 	// IDValidator is a validator for the "id" field. It is called by the builders before save.
 	IDValidator func(uint32) error
 )
@@ -89,10 +125,11 @@ func ValidColumn(column string) bool {
 
 // Method values.
 const (
-	MethodTotp     Method = "TOTP"
-	MethodSms      Method = "SMS"
-	MethodEmail    Method = "EMAIL"
-	MethodWebauthn Method = "WEBAUTHN"
+	MethodTotp       Method = "TOTP"
+	MethodSms        Method = "SMS"
+	MethodEmail      Method = "EMAIL"
+	MethodWebauthn   Method = "WEBAUTHN"
+	MethodBackupCode Method = "BACKUP_CODE"
 )
 
 func (m Method) String() string {
@@ -102,7 +139,7 @@ func (m Method) String() string {
 // MethodValidator is a validator for the "method" field enum values. It is called by the builders before save.
 func MethodValidator(m Method) error {
 	switch m {
-	case MethodTotp, MethodSms, MethodEmail, MethodWebauthn:
+	case MethodTotp, MethodSms, MethodEmail, MethodWebauthn, MethodBackupCode:
 		return nil
 	default:
 		return fmt.Errorf("usermfafactor: invalid enum value for method field: %q", m)
@@ -192,3 +229,28 @@ func ByStatus(opts ...sql.OrderTermOption) OrderOption {
 func ByLastUsedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldLastUsedAt, opts...).ToFunc()
 }
+
+// ByCredentialID orders the results by the credential_id field.
+func ByCredentialID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCredentialID, opts...).ToFunc()
+}
+
+// BySignCount orders the results by the sign_count field.
+func BySignCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSignCount, opts...).ToFunc()
+}
+
+// ByCredentialFlags orders the results by the credential_flags field.
+func ByCredentialFlags(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCredentialFlags, opts...).ToFunc()
+}
+
+// ByAttestationType orders the results by the attestation_type field.
+func ByAttestationType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAttestationType, opts...).ToFunc()
+}
+
+// ByAttestationFormat orders the results by the attestation_format field.
+func ByAttestationFormat(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAttestationFormat, opts...).ToFunc()
+}
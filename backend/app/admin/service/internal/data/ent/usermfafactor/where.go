@@ -94,6 +94,41 @@ func LastUsedAt(v time.Time) predicate.UserMfaFactor {
 	return predicate.UserMfaFactor(sql.FieldEQ(FieldLastUsedAt, v))
 }
 
+// CredentialID applies equality check predicate on the "credential_id" field. It's identical to CredentialIDEQ.
+func CredentialID(v string) predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldEQ(FieldCredentialID, v))
+}
+
+// PublicKey applies equality check predicate on the "public_key" field. It's identical to PublicKeyEQ.
+func PublicKey(v []byte) predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldEQ(FieldPublicKey, v))
+}
+
+// SignCount applies equality check predicate on the "sign_count" field. It's identical to SignCountEQ.
+func SignCount(v uint32) predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldEQ(FieldSignCount, v))
+}
+
+// Aaguid applies equality check predicate on the "aaguid" field. It's identical to AaguidEQ.
+func Aaguid(v []byte) predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldEQ(FieldAaguid, v))
+}
+
+// CredentialFlags applies equality check predicate on the "credential_flags" field. It's identical to CredentialFlagsEQ.
+func CredentialFlags(v uint8) predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldEQ(FieldCredentialFlags, v))
+}
+
+// AttestationType applies equality check predicate on the "attestation_type" field. It's identical to AttestationTypeEQ.
+func AttestationType(v string) predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldEQ(FieldAttestationType, v))
+}
+
+// AttestationFormat applies equality check predicate on the "attestation_format" field. It's identical to AttestationFormatEQ.
+func AttestationFormat(v string) predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldEQ(FieldAttestationFormat, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.UserMfaFactor {
 	return predicate.UserMfaFactor(sql.FieldEQ(FieldCreatedAt, v))
@@ -604,6 +639,411 @@ func LastUsedAtNotNil() predicate.UserMfaFactor {
 	return predicate.UserMfaFactor(sql.FieldNotNull(FieldLastUsedAt))
 }
 
+// CredentialIDEQ applies the EQ predicate on the "credential_id" field.
+func CredentialIDEQ(v string) predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldEQ(FieldCredentialID, v))
+}
+
+// CredentialIDNEQ applies the NEQ predicate on the "credential_id" field.
+func CredentialIDNEQ(v string) predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldNEQ(FieldCredentialID, v))
+}
+
+// CredentialIDIn applies the In predicate on the "credential_id" field.
+func CredentialIDIn(vs ...string) predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldIn(FieldCredentialID, vs...))
+}
+
+// CredentialIDNotIn applies the NotIn predicate on the "credential_id" field.
+func CredentialIDNotIn(vs ...string) predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldNotIn(FieldCredentialID, vs...))
+}
+
+// CredentialIDGT applies the GT predicate on the "credential_id" field.
+func CredentialIDGT(v string) predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldGT(FieldCredentialID, v))
+}
+
+// CredentialIDGTE applies the GTE predicate on the "credential_id" field.
+func CredentialIDGTE(v string) predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldGTE(FieldCredentialID, v))
+}
+
+// CredentialIDLT applies the LT predicate on the "credential_id" field.
+func CredentialIDLT(v string) predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldLT(FieldCredentialID, v))
+}
+
+// CredentialIDLTE applies the LTE predicate on the "credential_id" field.
+func CredentialIDLTE(v string) predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldLTE(FieldCredentialID, v))
+}
+
+// CredentialIDContains applies the Contains predicate on the "credential_id" field.
+func CredentialIDContains(v string) predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldContains(FieldCredentialID, v))
+}
+
+// CredentialIDHasPrefix applies the HasPrefix predicate on the "credential_id" field.
+func CredentialIDHasPrefix(v string) predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldHasPrefix(FieldCredentialID, v))
+}
+
+// CredentialIDHasSuffix applies the HasSuffix predicate on the "credential_id" field.
+func CredentialIDHasSuffix(v string) predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldHasSuffix(FieldCredentialID, v))
+}
+
+// CredentialIDEqualFold applies the EqualFold predicate on the "credential_id" field.
+func CredentialIDEqualFold(v string) predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldEqualFold(FieldCredentialID, v))
+}
+
+// CredentialIDContainsFold applies the ContainsFold predicate on the "credential_id" field.
+func CredentialIDContainsFold(v string) predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldContainsFold(FieldCredentialID, v))
+}
+
+// PublicKeyEQ applies the EQ predicate on the "public_key" field.
+func PublicKeyEQ(v []byte) predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldEQ(FieldPublicKey, v))
+}
+
+// PublicKeyNEQ applies the NEQ predicate on the "public_key" field.
+func PublicKeyNEQ(v []byte) predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldNEQ(FieldPublicKey, v))
+}
+
+// PublicKeyIn applies the In predicate on the "public_key" field.
+func PublicKeyIn(vs ...[]byte) predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldIn(FieldPublicKey, vs...))
+}
+
+// PublicKeyNotIn applies the NotIn predicate on the "public_key" field.
+func PublicKeyNotIn(vs ...[]byte) predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldNotIn(FieldPublicKey, vs...))
+}
+
+// PublicKeyGT applies the GT predicate on the "public_key" field.
+func PublicKeyGT(v []byte) predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldGT(FieldPublicKey, v))
+}
+
+// PublicKeyGTE applies the GTE predicate on the "public_key" field.
+func PublicKeyGTE(v []byte) predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldGTE(FieldPublicKey, v))
+}
+
+// PublicKeyLT applies the LT predicate on the "public_key" field.
+func PublicKeyLT(v []byte) predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldLT(FieldPublicKey, v))
+}
+
+// PublicKeyLTE applies the LTE predicate on the "public_key" field.
+func PublicKeyLTE(v []byte) predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldLTE(FieldPublicKey, v))
+}
+
+// PublicKeyIsNil applies the IsNil predicate on the "public_key" field.
+func PublicKeyIsNil() predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldIsNull(FieldPublicKey))
+}
+
+// PublicKeyNotNil applies the NotNil predicate on the "public_key" field.
+func PublicKeyNotNil() predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldNotNull(FieldPublicKey))
+}
+
+// SignCountEQ applies the EQ predicate on the "sign_count" field.
+func SignCountEQ(v uint32) predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldEQ(FieldSignCount, v))
+}
+
+// SignCountNEQ applies the NEQ predicate on the "sign_count" field.
+func SignCountNEQ(v uint32) predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldNEQ(FieldSignCount, v))
+}
+
+// SignCountIn applies the In predicate on the "sign_count" field.
+func SignCountIn(vs ...uint32) predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldIn(FieldSignCount, vs...))
+}
+
+// SignCountNotIn applies the NotIn predicate on the "sign_count" field.
+func SignCountNotIn(vs ...uint32) predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldNotIn(FieldSignCount, vs...))
+}
+
+// SignCountGT applies the GT predicate on the "sign_count" field.
+func SignCountGT(v uint32) predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldGT(FieldSignCount, v))
+}
+
+// SignCountGTE applies the GTE predicate on the "sign_count" field.
+func SignCountGTE(v uint32) predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldGTE(FieldSignCount, v))
+}
+
+// SignCountLT applies the LT predicate on the "sign_count" field.
+func SignCountLT(v uint32) predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldLT(FieldSignCount, v))
+}
+
+// SignCountLTE applies the LTE predicate on the "sign_count" field.
+func SignCountLTE(v uint32) predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldLTE(FieldSignCount, v))
+}
+
+// AaguidEQ applies the EQ predicate on the "aaguid" field.
+func AaguidEQ(v []byte) predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldEQ(FieldAaguid, v))
+}
+
+// AaguidNEQ applies the NEQ predicate on the "aaguid" field.
+func AaguidNEQ(v []byte) predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldNEQ(FieldAaguid, v))
+}
+
+// AaguidIn applies the In predicate on the "aaguid" field.
+func AaguidIn(vs ...[]byte) predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldIn(FieldAaguid, vs...))
+}
+
+// AaguidNotIn applies the NotIn predicate on the "aaguid" field.
+func AaguidNotIn(vs ...[]byte) predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldNotIn(FieldAaguid, vs...))
+}
+
+// AaguidGT applies the GT predicate on the "aaguid" field.
+func AaguidGT(v []byte) predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldGT(FieldAaguid, v))
+}
+
+// AaguidGTE applies the GTE predicate on the "aaguid" field.
+func AaguidGTE(v []byte) predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldGTE(FieldAaguid, v))
+}
+
+// AaguidLT applies the LT predicate on the "aaguid" field.
+func AaguidLT(v []byte) predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldLT(FieldAaguid, v))
+}
+
+// AaguidLTE applies the LTE predicate on the "aaguid" field.
+func AaguidLTE(v []byte) predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldLTE(FieldAaguid, v))
+}
+
+// AaguidIsNil applies the IsNil predicate on the "aaguid" field.
+func AaguidIsNil() predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldIsNull(FieldAaguid))
+}
+
+// AaguidNotNil applies the NotNil predicate on the "aaguid" field.
+func AaguidNotNil() predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldNotNull(FieldAaguid))
+}
+
+// TransportsIsNil applies the IsNil predicate on the "transports" field.
+func TransportsIsNil() predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldIsNull(FieldTransports))
+}
+
+// TransportsNotNil applies the NotNil predicate on the "transports" field.
+func TransportsNotNil() predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldNotNull(FieldTransports))
+}
+
+// CredentialFlagsEQ applies the EQ predicate on the "credential_flags" field.
+func CredentialFlagsEQ(v uint8) predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldEQ(FieldCredentialFlags, v))
+}
+
+// CredentialFlagsNEQ applies the NEQ predicate on the "credential_flags" field.
+func CredentialFlagsNEQ(v uint8) predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldNEQ(FieldCredentialFlags, v))
+}
+
+// CredentialFlagsIn applies the In predicate on the "credential_flags" field.
+func CredentialFlagsIn(vs ...uint8) predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldIn(FieldCredentialFlags, vs...))
+}
+
+// CredentialFlagsNotIn applies the NotIn predicate on the "credential_flags" field.
+func CredentialFlagsNotIn(vs ...uint8) predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldNotIn(FieldCredentialFlags, vs...))
+}
+
+// CredentialFlagsGT applies the GT predicate on the "credential_flags" field.
+func CredentialFlagsGT(v uint8) predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldGT(FieldCredentialFlags, v))
+}
+
+// CredentialFlagsGTE applies the GTE predicate on the "credential_flags" field.
+func CredentialFlagsGTE(v uint8) predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldGTE(FieldCredentialFlags, v))
+}
+
+// CredentialFlagsLT applies the LT predicate on the "credential_flags" field.
+func CredentialFlagsLT(v uint8) predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldLT(FieldCredentialFlags, v))
+}
+
+// CredentialFlagsLTE applies the LTE predicate on the "credential_flags" field.
+func CredentialFlagsLTE(v uint8) predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldLTE(FieldCredentialFlags, v))
+}
+
+// AttestationTypeEQ applies the EQ predicate on the "attestation_type" field.
+func AttestationTypeEQ(v string) predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldEQ(FieldAttestationType, v))
+}
+
+// AttestationTypeNEQ applies the NEQ predicate on the "attestation_type" field.
+func AttestationTypeNEQ(v string) predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldNEQ(FieldAttestationType, v))
+}
+
+// AttestationTypeIn applies the In predicate on the "attestation_type" field.
+func AttestationTypeIn(vs ...string) predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldIn(FieldAttestationType, vs...))
+}
+
+// AttestationTypeNotIn applies the NotIn predicate on the "attestation_type" field.
+func AttestationTypeNotIn(vs ...string) predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldNotIn(FieldAttestationType, vs...))
+}
+
+// AttestationTypeGT applies the GT predicate on the "attestation_type" field.
+func AttestationTypeGT(v string) predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldGT(FieldAttestationType, v))
+}
+
+// AttestationTypeGTE applies the GTE predicate on the "attestation_type" field.
+func AttestationTypeGTE(v string) predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldGTE(FieldAttestationType, v))
+}
+
+// AttestationTypeLT applies the LT predicate on the "attestation_type" field.
+func AttestationTypeLT(v string) predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldLT(FieldAttestationType, v))
+}
+
+// AttestationTypeLTE applies the LTE predicate on the "attestation_type" field.
+func AttestationTypeLTE(v string) predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldLTE(FieldAttestationType, v))
+}
+
+// AttestationTypeContains applies the Contains predicate on the "attestation_type" field.
+func AttestationTypeContains(v string) predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldContains(FieldAttestationType, v))
+}
+
+// AttestationTypeHasPrefix applies the HasPrefix predicate on the "attestation_type" field.
+func AttestationTypeHasPrefix(v string) predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldHasPrefix(FieldAttestationType, v))
+}
+
+// AttestationTypeHasSuffix applies the HasSuffix predicate on the "attestation_type" field.
+func AttestationTypeHasSuffix(v string) predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldHasSuffix(FieldAttestationType, v))
+}
+
+// AttestationTypeIsNil applies the IsNil predicate on the "attestation_type" field.
+func AttestationTypeIsNil() predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldIsNull(FieldAttestationType))
+}
+
+// AttestationTypeNotNil applies the NotNil predicate on the "attestation_type" field.
+func AttestationTypeNotNil() predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldNotNull(FieldAttestationType))
+}
+
+// AttestationTypeEqualFold applies the EqualFold predicate on the "attestation_type" field.
+func AttestationTypeEqualFold(v string) predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldEqualFold(FieldAttestationType, v))
+}
+
+// AttestationTypeContainsFold applies the ContainsFold predicate on the "attestation_type" field.
+func AttestationTypeContainsFold(v string) predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldContainsFold(FieldAttestationType, v))
+}
+
+// AttestationFormatEQ applies the EQ predicate on the "attestation_format" field.
+func AttestationFormatEQ(v string) predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldEQ(FieldAttestationFormat, v))
+}
+
+// AttestationFormatNEQ applies the NEQ predicate on the "attestation_format" field.
+func AttestationFormatNEQ(v string) predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldNEQ(FieldAttestationFormat, v))
+}
+
+// AttestationFormatIn applies the In predicate on the "attestation_format" field.
+func AttestationFormatIn(vs ...string) predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldIn(FieldAttestationFormat, vs...))
+}
+
+// AttestationFormatNotIn applies the NotIn predicate on the "attestation_format" field.
+func AttestationFormatNotIn(vs ...string) predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldNotIn(FieldAttestationFormat, vs...))
+}
+
+// AttestationFormatGT applies the GT predicate on the "attestation_format" field.
+func AttestationFormatGT(v string) predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldGT(FieldAttestationFormat, v))
+}
+
+// AttestationFormatGTE applies the GTE predicate on the "attestation_format" field.
+func AttestationFormatGTE(v string) predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldGTE(FieldAttestationFormat, v))
+}
+
+// AttestationFormatLT applies the LT predicate on the "attestation_format" field.
+func AttestationFormatLT(v string) predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldLT(FieldAttestationFormat, v))
+}
+
+// AttestationFormatLTE applies the LTE predicate on the "attestation_format" field.
+func AttestationFormatLTE(v string) predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldLTE(FieldAttestationFormat, v))
+}
+
+// AttestationFormatContains applies the Contains predicate on the "attestation_format" field.
+func AttestationFormatContains(v string) predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldContains(FieldAttestationFormat, v))
+}
+
+// AttestationFormatHasPrefix applies the HasPrefix predicate on the "attestation_format" field.
+func AttestationFormatHasPrefix(v string) predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldHasPrefix(FieldAttestationFormat, v))
+}
+
+// AttestationFormatHasSuffix applies the HasSuffix predicate on the "attestation_format" field.
+func AttestationFormatHasSuffix(v string) predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldHasSuffix(FieldAttestationFormat, v))
+}
+
+// AttestationFormatIsNil applies the IsNil predicate on the "attestation_format" field.
+func AttestationFormatIsNil() predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldIsNull(FieldAttestationFormat))
+}
+
+// AttestationFormatNotNil applies the NotNil predicate on the "attestation_format" field.
+func AttestationFormatNotNil() predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldNotNull(FieldAttestationFormat))
+}
+
+// AttestationFormatEqualFold applies the EqualFold predicate on the "attestation_format" field.
+func AttestationFormatEqualFold(v string) predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldEqualFold(FieldAttestationFormat, v))
+}
+
+// AttestationFormatContainsFold applies the ContainsFold predicate on the "attestation_format" field.
+func AttestationFormatContainsFold(v string) predicate.UserMfaFactor {
+	return predicate.UserMfaFactor(sql.FieldContainsFold(FieldAttestationFormat, v))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.UserMfaFactor) predicate.UserMfaFactor {
 	return predicate.UserMfaFactor(sql.AndPredicates(predicates...))
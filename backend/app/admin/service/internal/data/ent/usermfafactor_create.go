@@ -162,6 +162,94 @@ func (_c *UserMfaFactorCreate) SetNillableLastUsedAt(v *time.Time) *UserMfaFacto
 	return _c
 }
 
+// SetCredentialID sets the "credential_id" field.
+func (_c *UserMfaFactorCreate) SetCredentialID(v string) *UserMfaFactorCreate {
+	_c.mutation.SetCredentialID(v)
+	return _c
+}
+
+// SetNillableCredentialID sets the "credential_id" field if the given value is not nil.
+func (_c *UserMfaFactorCreate) SetNillableCredentialID(v *string) *UserMfaFactorCreate {
+	if v != nil {
+		_c.SetCredentialID(*v)
+	}
+	return _c
+}
+
+// SetPublicKey sets the "public_key" field.
+func (_c *UserMfaFactorCreate) SetPublicKey(v []byte) *UserMfaFactorCreate {
+	_c.mutation.SetPublicKey(v)
+	return _c
+}
+
+// SetSignCount sets the "sign_count" field.
+func (_c *UserMfaFactorCreate) SetSignCount(v uint32) *UserMfaFactorCreate {
+	_c.mutation.SetSignCount(v)
+	return _c
+}
+
+// SetNillableSignCount sets the "sign_count" field if the given value is not nil.
+func (_c *UserMfaFactorCreate) SetNillableSignCount(v *uint32) *UserMfaFactorCreate {
+	if v != nil {
+		_c.SetSignCount(*v)
+	}
+	return _c
+}
+
+// SetAaguid sets the "aaguid" field.
+func (_c *UserMfaFactorCreate) SetAaguid(v []byte) *UserMfaFactorCreate {
+	_c.mutation.SetAaguid(v)
+	return _c
+}
+
+// SetTransports sets the "transports" field.
+func (_c *UserMfaFactorCreate) SetTransports(v []string) *UserMfaFactorCreate {
+	_c.mutation.SetTransports(v)
+	return _c
+}
+
+// SetCredentialFlags sets the "credential_flags" field.
+func (_c *UserMfaFactorCreate) SetCredentialFlags(v uint8) *UserMfaFactorCreate {
+	_c.mutation.SetCredentialFlags(v)
+	return _c
+}
+
+// SetNillableCredentialFlags sets the "credential_flags" field if the given value is not nil.
+func (_c *UserMfaFactorCreate) SetNillableCredentialFlags(v *uint8) *UserMfaFactorCreate {
+	if v != nil {
+		_c.SetCredentialFlags(*v)
+	}
+	return _c
+}
+
+// SetAttestationType sets the "attestation_type" field.
+func (_c *UserMfaFactorCreate) SetAttestationType(v string) *UserMfaFactorCreate {
+	_c.mutation.SetAttestationType(v)
+	return _c
+}
+
+// SetNillableAttestationType sets the "attestation_type" field if the given value is not nil.
+func (_c *UserMfaFactorCreate) SetNillableAttestationType(v *string) *UserMfaFactorCreate {
+	if v != nil {
+		_c.SetAttestationType(*v)
+	}
+	return _c
+}
+
+// SetAttestationFormat sets the "attestation_format" field.
+func (_c *UserMfaFactorCreate) SetAttestationFormat(v string) *UserMfaFactorCreate {
+	_c.mutation.SetAttestationFormat(v)
+	return _c
+}
+
+// SetNillableAttestationFormat sets the "attestation_format" field if the given value is not nil.
+func (_c *UserMfaFactorCreate) SetNillableAttestationFormat(v *string) *UserMfaFactorCreate {
+	if v != nil {
+		_c.SetAttestationFormat(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *UserMfaFactorCreate) SetID(v uint32) *UserMfaFactorCreate {
 	_c.mutation.SetID(v)
@@ -217,6 +305,18 @@ func (_c *UserMfaFactorCreate) defaults() error {
 		v := usermfafactor.DefaultStatus
 		_c.mutation.SetStatus(v)
 	}
+	if _, ok := _c.mutation.CredentialID(); !ok {
+		v := usermfafactor.DefaultCredentialID
+		_c.mutation.SetCredentialID(v)
+	}
+	if _, ok := _c.mutation.SignCount(); !ok {
+		v := usermfafactor.DefaultSignCount
+		_c.mutation.SetSignCount(v)
+	}
+	if _, ok := _c.mutation.CredentialFlags(); !ok {
+		v := usermfafactor.DefaultCredentialFlags
+		_c.mutation.SetCredentialFlags(v)
+	}
 	return nil
 }
 
@@ -242,6 +342,30 @@ func (_c *UserMfaFactorCreate) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "UserMfaFactor.status": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.CredentialID(); !ok {
+		return &ValidationError{Name: "credential_id", err: errors.New(`ent: missing required field "UserMfaFactor.credential_id"`)}
+	}
+	if v, ok := _c.mutation.CredentialID(); ok {
+		if err := usermfafactor.CredentialIDValidator(v); err != nil {
+			return &ValidationError{Name: "credential_id", err: fmt.Errorf(`ent: validator failed for field "UserMfaFactor.credential_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.SignCount(); !ok {
+		return &ValidationError{Name: "sign_count", err: errors.New(`ent: missing required field "UserMfaFactor.sign_count"`)}
+	}
+	if _, ok := _c.mutation.CredentialFlags(); !ok {
+		return &ValidationError{Name: "credential_flags", err: errors.New(`ent: missing required field "UserMfaFactor.credential_flags"`)}
+	}
+	if v, ok := _c.mutation.AttestationType(); ok {
+		if err := usermfafactor.AttestationTypeValidator(v); err != nil {
+			return &ValidationError{Name: "attestation_type", err: fmt.Errorf(`ent: validator failed for field "UserMfaFactor.attestation_type": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.AttestationFormat(); ok {
+		if err := usermfafactor.AttestationFormatValidator(v); err != nil {
+			return &ValidationError{Name: "attestation_format", err: fmt.Errorf(`ent: validator failed for field "UserMfaFactor.attestation_format": %w`, err)}
+		}
+	}
 	if v, ok := _c.mutation.ID(); ok {
 		if err := usermfafactor.IDValidator(v); err != nil {
 			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "UserMfaFactor.id": %w`, err)}
@@ -320,6 +444,38 @@ func (_c *UserMfaFactorCreate) createSpec() (*UserMfaFactor, *sqlgraph.CreateSpe
 		_spec.SetField(usermfafactor.FieldLastUsedAt, field.TypeTime, value)
 		_node.LastUsedAt = &value
 	}
+	if value, ok := _c.mutation.CredentialID(); ok {
+		_spec.SetField(usermfafactor.FieldCredentialID, field.TypeString, value)
+		_node.CredentialID = value
+	}
+	if value, ok := _c.mutation.PublicKey(); ok {
+		_spec.SetField(usermfafactor.FieldPublicKey, field.TypeBytes, value)
+		_node.PublicKey = value
+	}
+	if value, ok := _c.mutation.SignCount(); ok {
+		_spec.SetField(usermfafactor.FieldSignCount, field.TypeUint32, value)
+		_node.SignCount = value
+	}
+	if value, ok := _c.mutation.Aaguid(); ok {
+		_spec.SetField(usermfafactor.FieldAaguid, field.TypeBytes, value)
+		_node.Aaguid = value
+	}
+	if value, ok := _c.mutation.Transports(); ok {
+		_spec.SetField(usermfafactor.FieldTransports, field.TypeJSON, value)
+		_node.Transports = value
+	}
+	if value, ok := _c.mutation.CredentialFlags(); ok {
+		_spec.SetField(usermfafactor.FieldCredentialFlags, field.TypeUint8, value)
+		_node.CredentialFlags = value
+	}
+	if value, ok := _c.mutation.AttestationType(); ok {
+		_spec.SetField(usermfafactor.FieldAttestationType, field.TypeString, value)
+		_node.AttestationType = &value
+	}
+	if value, ok := _c.mutation.AttestationFormat(); ok {
+		_spec.SetField(usermfafactor.FieldAttestationFormat, field.TypeString, value)
+		_node.AttestationFormat = &value
+	}
 	return _node, _spec
 }
 
@@ -522,6 +678,144 @@ func (u *UserMfaFactorUpsert) ClearLastUsedAt() *UserMfaFactorUpsert {
 	return u
 }
 
+// SetCredentialID sets the "credential_id" field.
+func (u *UserMfaFactorUpsert) SetCredentialID(v string) *UserMfaFactorUpsert {
+	u.Set(usermfafactor.FieldCredentialID, v)
+	return u
+}
+
+// UpdateCredentialID sets the "credential_id" field to the value that was provided on create.
+func (u *UserMfaFactorUpsert) UpdateCredentialID() *UserMfaFactorUpsert {
+	u.SetExcluded(usermfafactor.FieldCredentialID)
+	return u
+}
+
+// SetPublicKey sets the "public_key" field.
+func (u *UserMfaFactorUpsert) SetPublicKey(v []byte) *UserMfaFactorUpsert {
+	u.Set(usermfafactor.FieldPublicKey, v)
+	return u
+}
+
+// UpdatePublicKey sets the "public_key" field to the value that was provided on create.
+func (u *UserMfaFactorUpsert) UpdatePublicKey() *UserMfaFactorUpsert {
+	u.SetExcluded(usermfafactor.FieldPublicKey)
+	return u
+}
+
+// ClearPublicKey clears the value of the "public_key" field.
+func (u *UserMfaFactorUpsert) ClearPublicKey() *UserMfaFactorUpsert {
+	u.SetNull(usermfafactor.FieldPublicKey)
+	return u
+}
+
+// SetSignCount sets the "sign_count" field.
+func (u *UserMfaFactorUpsert) SetSignCount(v uint32) *UserMfaFactorUpsert {
+	u.Set(usermfafactor.FieldSignCount, v)
+	return u
+}
+
+// UpdateSignCount sets the "sign_count" field to the value that was provided on create.
+func (u *UserMfaFactorUpsert) UpdateSignCount() *UserMfaFactorUpsert {
+	u.SetExcluded(usermfafactor.FieldSignCount)
+	return u
+}
+
+// AddSignCount adds v to the "sign_count" field.
+func (u *UserMfaFactorUpsert) AddSignCount(v uint32) *UserMfaFactorUpsert {
+	u.Add(usermfafactor.FieldSignCount, v)
+	return u
+}
+
+// SetAaguid sets the "aaguid" field.
+func (u *UserMfaFactorUpsert) SetAaguid(v []byte) *UserMfaFactorUpsert {
+	u.Set(usermfafactor.FieldAaguid, v)
+	return u
+}
+
+// UpdateAaguid sets the "aaguid" field to the value that was provided on create.
+func (u *UserMfaFactorUpsert) UpdateAaguid() *UserMfaFactorUpsert {
+	u.SetExcluded(usermfafactor.FieldAaguid)
+	return u
+}
+
+// ClearAaguid clears the value of the "aaguid" field.
+func (u *UserMfaFactorUpsert) ClearAaguid() *UserMfaFactorUpsert {
+	u.SetNull(usermfafactor.FieldAaguid)
+	return u
+}
+
+// SetTransports sets the "transports" field.
+func (u *UserMfaFactorUpsert) SetTransports(v []string) *UserMfaFactorUpsert {
+	u.Set(usermfafactor.FieldTransports, v)
+	return u
+}
+
+// UpdateTransports sets the "transports" field to the value that was provided on create.
+func (u *UserMfaFactorUpsert) UpdateTransports() *UserMfaFactorUpsert {
+	u.SetExcluded(usermfafactor.FieldTransports)
+	return u
+}
+
+// ClearTransports clears the value of the "transports" field.
+func (u *UserMfaFactorUpsert) ClearTransports() *UserMfaFactorUpsert {
+	u.SetNull(usermfafactor.FieldTransports)
+	return u
+}
+
+// SetCredentialFlags sets the "credential_flags" field.
+func (u *UserMfaFactorUpsert) SetCredentialFlags(v uint8) *UserMfaFactorUpsert {
+	u.Set(usermfafactor.FieldCredentialFlags, v)
+	return u
+}
+
+// UpdateCredentialFlags sets the "credential_flags" field to the value that was provided on create.
+func (u *UserMfaFactorUpsert) UpdateCredentialFlags() *UserMfaFactorUpsert {
+	u.SetExcluded(usermfafactor.FieldCredentialFlags)
+	return u
+}
+
+// AddCredentialFlags adds v to the "credential_flags" field.
+func (u *UserMfaFactorUpsert) AddCredentialFlags(v uint8) *UserMfaFactorUpsert {
+	u.Add(usermfafactor.FieldCredentialFlags, v)
+	return u
+}
+
+// SetAttestationType sets the "attestation_type" field.
+func (u *UserMfaFactorUpsert) SetAttestationType(v string) *UserMfaFactorUpsert {
+	u.Set(usermfafactor.FieldAttestationType, v)
+	return u
+}
+
+// UpdateAttestationType sets the "attestation_type" field to the value that was provided on create.
+func (u *UserMfaFactorUpsert) UpdateAttestationType() *UserMfaFactorUpsert {
+	u.SetExcluded(usermfafactor.FieldAttestationType)
+	return u
+}
+
+// ClearAttestationType clears the value of the "attestation_type" field.
+func (u *UserMfaFactorUpsert) ClearAttestationType() *UserMfaFactorUpsert {
+	u.SetNull(usermfafactor.FieldAttestationType)
+	return u
+}
+
+// SetAttestationFormat sets the "attestation_format" field.
+func (u *UserMfaFactorUpsert) SetAttestationFormat(v string) *UserMfaFactorUpsert {
+	u.Set(usermfafactor.FieldAttestationFormat, v)
+	return u
+}
+
+// UpdateAttestationFormat sets the "attestation_format" field to the value that was provided on create.
+func (u *UserMfaFactorUpsert) UpdateAttestationFormat() *UserMfaFactorUpsert {
+	u.SetExcluded(usermfafactor.FieldAttestationFormat)
+	return u
+}
+
+// ClearAttestationFormat clears the value of the "attestation_format" field.
+func (u *UserMfaFactorUpsert) ClearAttestationFormat() *UserMfaFactorUpsert {
+	u.SetNull(usermfafactor.FieldAttestationFormat)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -751,6 +1045,167 @@ func (u *UserMfaFactorUpsertOne) ClearLastUsedAt() *UserMfaFactorUpsertOne {
 	})
 }
 
+// SetCredentialID sets the "credential_id" field.
+func (u *UserMfaFactorUpsertOne) SetCredentialID(v string) *UserMfaFactorUpsertOne {
+	return u.Update(func(s *UserMfaFactorUpsert) {
+		s.SetCredentialID(v)
+	})
+}
+
+// UpdateCredentialID sets the "credential_id" field to the value that was provided on create.
+func (u *UserMfaFactorUpsertOne) UpdateCredentialID() *UserMfaFactorUpsertOne {
+	return u.Update(func(s *UserMfaFactorUpsert) {
+		s.UpdateCredentialID()
+	})
+}
+
+// SetPublicKey sets the "public_key" field.
+func (u *UserMfaFactorUpsertOne) SetPublicKey(v []byte) *UserMfaFactorUpsertOne {
+	return u.Update(func(s *UserMfaFactorUpsert) {
+		s.SetPublicKey(v)
+	})
+}
+
+// UpdatePublicKey sets the "public_key" field to the value that was provided on create.
+func (u *UserMfaFactorUpsertOne) UpdatePublicKey() *UserMfaFactorUpsertOne {
+	return u.Update(func(s *UserMfaFactorUpsert) {
+		s.UpdatePublicKey()
+	})
+}
+
+// ClearPublicKey clears the value of the "public_key" field.
+func (u *UserMfaFactorUpsertOne) ClearPublicKey() *UserMfaFactorUpsertOne {
+	return u.Update(func(s *UserMfaFactorUpsert) {
+		s.ClearPublicKey()
+	})
+}
+
+// SetSignCount sets the "sign_count" field.
+func (u *UserMfaFactorUpsertOne) SetSignCount(v uint32) *UserMfaFactorUpsertOne {
+	return u.Update(func(s *UserMfaFactorUpsert) {
+		s.SetSignCount(v)
+	})
+}
+
+// AddSignCount adds v to the "sign_count" field.
+func (u *UserMfaFactorUpsertOne) AddSignCount(v uint32) *UserMfaFactorUpsertOne {
+	return u.Update(func(s *UserMfaFactorUpsert) {
+		s.AddSignCount(v)
+	})
+}
+
+// UpdateSignCount sets the "sign_count" field to the value that was provided on create.
+func (u *UserMfaFactorUpsertOne) UpdateSignCount() *UserMfaFactorUpsertOne {
+	return u.Update(func(s *UserMfaFactorUpsert) {
+		s.UpdateSignCount()
+	})
+}
+
+// SetAaguid sets the "aaguid" field.
+func (u *UserMfaFactorUpsertOne) SetAaguid(v []byte) *UserMfaFactorUpsertOne {
+	return u.Update(func(s *UserMfaFactorUpsert) {
+		s.SetAaguid(v)
+	})
+}
+
+// UpdateAaguid sets the "aaguid" field to the value that was provided on create.
+func (u *UserMfaFactorUpsertOne) UpdateAaguid() *UserMfaFactorUpsertOne {
+	return u.Update(func(s *UserMfaFactorUpsert) {
+		s.UpdateAaguid()
+	})
+}
+
+// ClearAaguid clears the value of the "aaguid" field.
+func (u *UserMfaFactorUpsertOne) ClearAaguid() *UserMfaFactorUpsertOne {
+	return u.Update(func(s *UserMfaFactorUpsert) {
+		s.ClearAaguid()
+	})
+}
+
+// SetTransports sets the "transports" field.
+func (u *UserMfaFactorUpsertOne) SetTransports(v []string) *UserMfaFactorUpsertOne {
+	return u.Update(func(s *UserMfaFactorUpsert) {
+		s.SetTransports(v)
+	})
+}
+
+// UpdateTransports sets the "transports" field to the value that was provided on create.
+func (u *UserMfaFactorUpsertOne) UpdateTransports() *UserMfaFactorUpsertOne {
+	return u.Update(func(s *UserMfaFactorUpsert) {
+		s.UpdateTransports()
+	})
+}
+
+// ClearTransports clears the value of the "transports" field.
+func (u *UserMfaFactorUpsertOne) ClearTransports() *UserMfaFactorUpsertOne {
+	return u.Update(func(s *UserMfaFactorUpsert) {
+		s.ClearTransports()
+	})
+}
+
+// SetCredentialFlags sets the "credential_flags" field.
+func (u *UserMfaFactorUpsertOne) SetCredentialFlags(v uint8) *UserMfaFactorUpsertOne {
+	return u.Update(func(s *UserMfaFactorUpsert) {
+		s.SetCredentialFlags(v)
+	})
+}
+
+// AddCredentialFlags adds v to the "credential_flags" field.
+func (u *UserMfaFactorUpsertOne) AddCredentialFlags(v uint8) *UserMfaFactorUpsertOne {
+	return u.Update(func(s *UserMfaFactorUpsert) {
+		s.AddCredentialFlags(v)
+	})
+}
+
+// UpdateCredentialFlags sets the "credential_flags" field to the value that was provided on create.
+func (u *UserMfaFactorUpsertOne) UpdateCredentialFlags() *UserMfaFactorUpsertOne {
+	return u.Update(func(s *UserMfaFactorUpsert) {
+		s.UpdateCredentialFlags()
+	})
+}
+
+// SetAttestationType sets the "attestation_type" field.
+func (u *UserMfaFactorUpsertOne) SetAttestationType(v string) *UserMfaFactorUpsertOne {
+	return u.Update(func(s *UserMfaFactorUpsert) {
+		s.SetAttestationType(v)
+	})
+}
+
+// UpdateAttestationType sets the "attestation_type" field to the value that was provided on create.
+func (u *UserMfaFactorUpsertOne) UpdateAttestationType() *UserMfaFactorUpsertOne {
+	return u.Update(func(s *UserMfaFactorUpsert) {
+		s.UpdateAttestationType()
+	})
+}
+
+// ClearAttestationType clears the value of the "attestation_type" field.
+func (u *UserMfaFactorUpsertOne) ClearAttestationType() *UserMfaFactorUpsertOne {
+	return u.Update(func(s *UserMfaFactorUpsert) {
+		s.ClearAttestationType()
+	})
+}
+
+// SetAttestationFormat sets the "attestation_format" field.
+func (u *UserMfaFactorUpsertOne) SetAttestationFormat(v string) *UserMfaFactorUpsertOne {
+	return u.Update(func(s *UserMfaFactorUpsert) {
+		s.SetAttestationFormat(v)
+	})
+}
+
+// UpdateAttestationFormat sets the "attestation_format" field to the value that was provided on create.
+func (u *UserMfaFactorUpsertOne) UpdateAttestationFormat() *UserMfaFactorUpsertOne {
+	return u.Update(func(s *UserMfaFactorUpsert) {
+		s.UpdateAttestationFormat()
+	})
+}
+
+// ClearAttestationFormat clears the value of the "attestation_format" field.
+func (u *UserMfaFactorUpsertOne) ClearAttestationFormat() *UserMfaFactorUpsertOne {
+	return u.Update(func(s *UserMfaFactorUpsert) {
+		s.ClearAttestationFormat()
+	})
+}
+
 // Exec executes the query.
 func (u *UserMfaFactorUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1146,6 +1601,167 @@ func (u *UserMfaFactorUpsertBulk) ClearLastUsedAt() *UserMfaFactorUpsertBulk {
 	})
 }
 
+// SetCredentialID sets the "credential_id" field.
+func (u *UserMfaFactorUpsertBulk) SetCredentialID(v string) *UserMfaFactorUpsertBulk {
+	return u.Update(func(s *UserMfaFactorUpsert) {
+		s.SetCredentialID(v)
+	})
+}
+
+// UpdateCredentialID sets the "credential_id" field to the value that was provided on create.
+func (u *UserMfaFactorUpsertBulk) UpdateCredentialID() *UserMfaFactorUpsertBulk {
+	return u.Update(func(s *UserMfaFactorUpsert) {
+		s.UpdateCredentialID()
+	})
+}
+
+// SetPublicKey sets the "public_key" field.
+func (u *UserMfaFactorUpsertBulk) SetPublicKey(v []byte) *UserMfaFactorUpsertBulk {
+	return u.Update(func(s *UserMfaFactorUpsert) {
+		s.SetPublicKey(v)
+	})
+}
+
+// UpdatePublicKey sets the "public_key" field to the value that was provided on create.
+func (u *UserMfaFactorUpsertBulk) UpdatePublicKey() *UserMfaFactorUpsertBulk {
+	return u.Update(func(s *UserMfaFactorUpsert) {
+		s.UpdatePublicKey()
+	})
+}
+
+// ClearPublicKey clears the value of the "public_key" field.
+func (u *UserMfaFactorUpsertBulk) ClearPublicKey() *UserMfaFactorUpsertBulk {
+	return u.Update(func(s *UserMfaFactorUpsert) {
+		s.ClearPublicKey()
+	})
+}
+
+// SetSignCount sets the "sign_count" field.
+func (u *UserMfaFactorUpsertBulk) SetSignCount(v uint32) *UserMfaFactorUpsertBulk {
+	return u.Update(func(s *UserMfaFactorUpsert) {
+		s.SetSignCount(v)
+	})
+}
+
+// AddSignCount adds v to the "sign_count" field.
+func (u *UserMfaFactorUpsertBulk) AddSignCount(v uint32) *UserMfaFactorUpsertBulk {
+	return u.Update(func(s *UserMfaFactorUpsert) {
+		s.AddSignCount(v)
+	})
+}
+
+// UpdateSignCount sets the "sign_count" field to the value that was provided on create.
+func (u *UserMfaFactorUpsertBulk) UpdateSignCount() *UserMfaFactorUpsertBulk {
+	return u.Update(func(s *UserMfaFactorUpsert) {
+		s.UpdateSignCount()
+	})
+}
+
+// SetAaguid sets the "aaguid" field.
+func (u *UserMfaFactorUpsertBulk) SetAaguid(v []byte) *UserMfaFactorUpsertBulk {
+	return u.Update(func(s *UserMfaFactorUpsert) {
+		s.SetAaguid(v)
+	})
+}
+
+// UpdateAaguid sets the "aaguid" field to the value that was provided on create.
+func (u *UserMfaFactorUpsertBulk) UpdateAaguid() *UserMfaFactorUpsertBulk {
+	return u.Update(func(s *UserMfaFactorUpsert) {
+		s.UpdateAaguid()
+	})
+}
+
+// ClearAaguid clears the value of the "aaguid" field.
+func (u *UserMfaFactorUpsertBulk) ClearAaguid() *UserMfaFactorUpsertBulk {
+	return u.Update(func(s *UserMfaFactorUpsert) {
+		s.ClearAaguid()
+	})
+}
+
+// SetTransports sets the "transports" field.
+func (u *UserMfaFactorUpsertBulk) SetTransports(v []string) *UserMfaFactorUpsertBulk {
+	return u.Update(func(s *UserMfaFactorUpsert) {
+		s.SetTransports(v)
+	})
+}
+
+// UpdateTransports sets the "transports" field to the value that was provided on create.
+func (u *UserMfaFactorUpsertBulk) UpdateTransports() *UserMfaFactorUpsertBulk {
+	return u.Update(func(s *UserMfaFactorUpsert) {
+		s.UpdateTransports()
+	})
+}
+
+// ClearTransports clears the value of the "transports" field.
+func (u *UserMfaFactorUpsertBulk) ClearTransports() *UserMfaFactorUpsertBulk {
+	return u.Update(func(s *UserMfaFactorUpsert) {
+		s.ClearTransports()
+	})
+}
+
+// SetCredentialFlags sets the "credential_flags" field.
+func (u *UserMfaFactorUpsertBulk) SetCredentialFlags(v uint8) *UserMfaFactorUpsertBulk {
+	return u.Update(func(s *UserMfaFactorUpsert) {
+		s.SetCredentialFlags(v)
+	})
+}
+
+// AddCredentialFlags adds v to the "credential_flags" field.
+func (u *UserMfaFactorUpsertBulk) AddCredentialFlags(v uint8) *UserMfaFactorUpsertBulk {
+	return u.Update(func(s *UserMfaFactorUpsert) {
+		s.AddCredentialFlags(v)
+	})
+}
+
+// UpdateCredentialFlags sets the "credential_flags" field to the value that was provided on create.
+func (u *UserMfaFactorUpsertBulk) UpdateCredentialFlags() *UserMfaFactorUpsertBulk {
+	return u.Update(func(s *UserMfaFactorUpsert) {
+		s.UpdateCredentialFlags()
+	})
+}
+
+// SetAttestationType sets the "attestation_type" field.
+func (u *UserMfaFactorUpsertBulk) SetAttestationType(v string) *UserMfaFactorUpsertBulk {
+	return u.Update(func(s *UserMfaFactorUpsert) {
+		s.SetAttestationType(v)
+	})
+}
+
+// UpdateAttestationType sets the "attestation_type" field to the value that was provided on create.
+func (u *UserMfaFactorUpsertBulk) UpdateAttestationType() *UserMfaFactorUpsertBulk {
+	return u.Update(func(s *UserMfaFactorUpsert) {
+		s.UpdateAttestationType()
+	})
+}
+
+// ClearAttestationType clears the value of the "attestation_type" field.
+func (u *UserMfaFactorUpsertBulk) ClearAttestationType() *UserMfaFactorUpsertBulk {
+	return u.Update(func(s *UserMfaFactorUpsert) {
+		s.ClearAttestationType()
+	})
+}
+
+// SetAttestationFormat sets the "attestation_format" field.
+func (u *UserMfaFactorUpsertBulk) SetAttestationFormat(v string) *UserMfaFactorUpsertBulk {
+	return u.Update(func(s *UserMfaFactorUpsert) {
+		s.SetAttestationFormat(v)
+	})
+}
+
+// UpdateAttestationFormat sets the "attestation_format" field to the value that was provided on create.
+func (u *UserMfaFactorUpsertBulk) UpdateAttestationFormat() *UserMfaFactorUpsertBulk {
+	return u.Update(func(s *UserMfaFactorUpsert) {
+		s.UpdateAttestationFormat()
+	})
+}
+
+// ClearAttestationFormat clears the value of the "attestation_format" field.
+func (u *UserMfaFactorUpsertBulk) ClearAttestationFormat() *UserMfaFactorUpsertBulk {
+	return u.Update(func(s *UserMfaFactorUpsert) {
+		s.ClearAttestationFormat()
+	})
+}
+
 // Exec executes the query.
 func (u *UserMfaFactorUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -12,6 +12,7 @@
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 )
 
@@ -196,6 +197,144 @@ func (_u *UserMfaFactorUpdate) ClearLastUsedAt() *UserMfaFactorUpdate {
 	return _u
 }
 
+// SetCredentialID sets the "credential_id" field.
+func (_u *UserMfaFactorUpdate) SetCredentialID(v string) *UserMfaFactorUpdate {
+	_u.mutation.SetCredentialID(v)
+	return _u
+}
+
+// SetNillableCredentialID sets the "credential_id" field if the given value is not nil.
+func (_u *UserMfaFactorUpdate) SetNillableCredentialID(v *string) *UserMfaFactorUpdate {
+	if v != nil {
+		_u.SetCredentialID(*v)
+	}
+	return _u
+}
+
+// SetPublicKey sets the "public_key" field.
+func (_u *UserMfaFactorUpdate) SetPublicKey(v []byte) *UserMfaFactorUpdate {
+	_u.mutation.SetPublicKey(v)
+	return _u
+}
+
+// ClearPublicKey clears the value of the "public_key" field.
+func (_u *UserMfaFactorUpdate) ClearPublicKey() *UserMfaFactorUpdate {
+	_u.mutation.ClearPublicKey()
+	return _u
+}
+
+// SetSignCount sets the "sign_count" field.
+func (_u *UserMfaFactorUpdate) SetSignCount(v uint32) *UserMfaFactorUpdate {
+	_u.mutation.ResetSignCount()
+	_u.mutation.SetSignCount(v)
+	return _u
+}
+
+// SetNillableSignCount sets the "sign_count" field if the given value is not nil.
+func (_u *UserMfaFactorUpdate) SetNillableSignCount(v *uint32) *UserMfaFactorUpdate {
+	if v != nil {
+		_u.SetSignCount(*v)
+	}
+	return _u
+}
+
+// AddSignCount adds value to the "sign_count" field.
+func (_u *UserMfaFactorUpdate) AddSignCount(v int32) *UserMfaFactorUpdate {
+	_u.mutation.AddSignCount(v)
+	return _u
+}
+
+// SetAaguid sets the "aaguid" field.
+func (_u *UserMfaFactorUpdate) SetAaguid(v []byte) *UserMfaFactorUpdate {
+	_u.mutation.SetAaguid(v)
+	return _u
+}
+
+// ClearAaguid clears the value of the "aaguid" field.
+func (_u *UserMfaFactorUpdate) ClearAaguid() *UserMfaFactorUpdate {
+	_u.mutation.ClearAaguid()
+	return _u
+}
+
+// SetTransports sets the "transports" field.
+func (_u *UserMfaFactorUpdate) SetTransports(v []string) *UserMfaFactorUpdate {
+	_u.mutation.SetTransports(v)
+	return _u
+}
+
+// AppendTransports appends value to the "transports" field.
+func (_u *UserMfaFactorUpdate) AppendTransports(v []string) *UserMfaFactorUpdate {
+	_u.mutation.AppendTransports(v)
+	return _u
+}
+
+// ClearTransports clears the value of the "transports" field.
+func (_u *UserMfaFactorUpdate) ClearTransports() *UserMfaFactorUpdate {
+	_u.mutation.ClearTransports()
+	return _u
+}
+
+// SetCredentialFlags sets the "credential_flags" field.
+func (_u *UserMfaFactorUpdate) SetCredentialFlags(v uint8) *UserMfaFactorUpdate {
+	_u.mutation.ResetCredentialFlags()
+	_u.mutation.SetCredentialFlags(v)
+	return _u
+}
+
+// SetNillableCredentialFlags sets the "credential_flags" field if the given value is not nil.
+func (_u *UserMfaFactorUpdate) SetNillableCredentialFlags(v *uint8) *UserMfaFactorUpdate {
+	if v != nil {
+		_u.SetCredentialFlags(*v)
+	}
+	return _u
+}
+
+// AddCredentialFlags adds value to the "credential_flags" field.
+func (_u *UserMfaFactorUpdate) AddCredentialFlags(v int8) *UserMfaFactorUpdate {
+	_u.mutation.AddCredentialFlags(v)
+	return _u
+}
+
+// SetAttestationType sets the "attestation_type" field.
+func (_u *UserMfaFactorUpdate) SetAttestationType(v string) *UserMfaFactorUpdate {
+	_u.mutation.SetAttestationType(v)
+	return _u
+}
+
+// SetNillableAttestationType sets the "attestation_type" field if the given value is not nil.
+func (_u *UserMfaFactorUpdate) SetNillableAttestationType(v *string) *UserMfaFactorUpdate {
+	if v != nil {
+		_u.SetAttestationType(*v)
+	}
+	return _u
+}
+
+// ClearAttestationType clears the value of the "attestation_type" field.
+func (_u *UserMfaFactorUpdate) ClearAttestationType() *UserMfaFactorUpdate {
+	_u.mutation.ClearAttestationType()
+	return _u
+}
+
+// SetAttestationFormat sets the "attestation_format" field.
+func (_u *UserMfaFactorUpdate) SetAttestationFormat(v string) *UserMfaFactorUpdate {
+	_u.mutation.SetAttestationFormat(v)
+	return _u
+}
+
+// SetNillableAttestationFormat sets the "attestation_format" field if the given value is not nil.
+func (_u *UserMfaFactorUpdate) SetNillableAttestationFormat(v *string) *UserMfaFactorUpdate {
+	if v != nil {
+		_u.SetAttestationFormat(*v)
+	}
+	return _u
+}
+
+// ClearAttestationFormat clears the value of the "attestation_format" field.
+func (_u *UserMfaFactorUpdate) ClearAttestationFormat() *UserMfaFactorUpdate {
+	_u.mutation.ClearAttestationFormat()
+	return _u
+}
+
 // Mutation returns the UserMfaFactorMutation object of the builder.
 func (_u *UserMfaFactorUpdate) Mutation() *UserMfaFactorMutation {
 	return _u.mutation
@@ -250,6 +389,21 @@ func (_u *UserMfaFactorUpdate) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "UserMfaFactor.status": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.CredentialID(); ok {
+		if err := usermfafactor.CredentialIDValidator(v); err != nil {
+			return &ValidationError{Name: "credential_id", err: fmt.Errorf(`ent: validator failed for field "UserMfaFactor.credential_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AttestationType(); ok {
+		if err := usermfafactor.AttestationTypeValidator(v); err != nil {
+			return &ValidationError{Name: "attestation_type", err: fmt.Errorf(`ent: validator failed for field "UserMfaFactor.attestation_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AttestationFormat(); ok {
+		if err := usermfafactor.AttestationFormatValidator(v); err != nil {
+			return &ValidationError{Name: "attestation_format", err: fmt.Errorf(`ent: validator failed for field "UserMfaFactor.attestation_format": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -328,6 +482,56 @@ func (_u *UserMfaFactorUpdate) sqlSave(ctx context.Context) (_node int, err erro
 	if _u.mutation.LastUsedAtCleared() {
 		_spec.ClearField(usermfafactor.FieldLastUsedAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.CredentialID(); ok {
+		_spec.SetField(usermfafactor.FieldCredentialID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PublicKey(); ok {
+		_spec.SetField(usermfafactor.FieldPublicKey, field.TypeBytes, value)
+	}
+	if _u.mutation.PublicKeyCleared() {
+		_spec.ClearField(usermfafactor.FieldPublicKey, field.TypeBytes)
+	}
+	if value, ok := _u.mutation.SignCount(); ok {
+		_spec.SetField(usermfafactor.FieldSignCount, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedSignCount(); ok {
+		_spec.AddField(usermfafactor.FieldSignCount, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.Aaguid(); ok {
+		_spec.SetField(usermfafactor.FieldAaguid, field.TypeBytes, value)
+	}
+	if _u.mutation.AaguidCleared() {
+		_spec.ClearField(usermfafactor.FieldAaguid, field.TypeBytes)
+	}
+	if value, ok := _u.mutation.Transports(); ok {
+		_spec.SetField(usermfafactor.FieldTransports, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTransports(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, usermfafactor.FieldTransports, value)
+		})
+	}
+	if _u.mutation.TransportsCleared() {
+		_spec.ClearField(usermfafactor.FieldTransports, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.CredentialFlags(); ok {
+		_spec.SetField(usermfafactor.FieldCredentialFlags, field.TypeUint8, value)
+	}
+	if value, ok := _u.mutation.AddedCredentialFlags(); ok {
+		_spec.AddField(usermfafactor.FieldCredentialFlags, field.TypeUint8, value)
+	}
+	if value, ok := _u.mutation.AttestationType(); ok {
+		_spec.SetField(usermfafactor.FieldAttestationType, field.TypeString, value)
+	}
+	if _u.mutation.AttestationTypeCleared() {
+		_spec.ClearField(usermfafactor.FieldAttestationType, field.TypeString)
+	}
+	if value, ok := _u.mutation.AttestationFormat(); ok {
+		_spec.SetField(usermfafactor.FieldAttestationFormat, field.TypeString, value)
+	}
+	if _u.mutation.AttestationFormatCleared() {
+		_spec.ClearField(usermfafactor.FieldAttestationFormat, field.TypeString)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -517,6 +721,144 @@ func (_u *UserMfaFactorUpdateOne) ClearLastUsedAt() *UserMfaFactorUpdateOne {
 	return _u
 }
 
+// SetCredentialID sets the "credential_id" field.
+func (_u *UserMfaFactorUpdateOne) SetCredentialID(v string) *UserMfaFactorUpdateOne {
+	_u.mutation.SetCredentialID(v)
+	return _u
+}
+
+// SetNillableCredentialID sets the "credential_id" field if the given value is not nil.
+func (_u *UserMfaFactorUpdateOne) SetNillableCredentialID(v *string) *UserMfaFactorUpdateOne {
+	if v != nil {
+		_u.SetCredentialID(*v)
+	}
+	return _u
+}
+
+// SetPublicKey sets the "public_key" field.
+func (_u *UserMfaFactorUpdateOne) SetPublicKey(v []byte) *UserMfaFactorUpdateOne {
+	_u.mutation.SetPublicKey(v)
+	return _u
+}
+
+// ClearPublicKey clears the value of the "public_key" field.
+func (_u *UserMfaFactorUpdateOne) ClearPublicKey() *UserMfaFactorUpdateOne {
+	_u.mutation.ClearPublicKey()
+	return _u
+}
+
+// SetSignCount sets the "sign_count" field.
+func (_u *UserMfaFactorUpdateOne) SetSignCount(v uint32) *UserMfaFactorUpdateOne {
+	_u.mutation.ResetSignCount()
+	_u.mutation.SetSignCount(v)
+	return _u
+}
+
+// SetNillableSignCount sets the "sign_count" field if the given value is not nil.
+func (_u *UserMfaFactorUpdateOne) SetNillableSignCount(v *uint32) *UserMfaFactorUpdateOne {
+	if v != nil {
+		_u.SetSignCount(*v)
+	}
+	return _u
+}
+
+// AddSignCount adds value to the "sign_count" field.
+func (_u *UserMfaFactorUpdateOne) AddSignCount(v int32) *UserMfaFactorUpdateOne {
+	_u.mutation.AddSignCount(v)
+	return _u
+}
+
+// SetAaguid sets the "aaguid" field.
+func (_u *UserMfaFactorUpdateOne) SetAaguid(v []byte) *UserMfaFactorUpdateOne {
+	_u.mutation.SetAaguid(v)
+	return _u
+}
+
+// ClearAaguid clears the value of the "aaguid" field.
+func (_u *UserMfaFactorUpdateOne) ClearAaguid() *UserMfaFactorUpdateOne {
+	_u.mutation.ClearAaguid()
+	return _u
+}
+
+// SetTransports sets the "transports" field.
+func (_u *UserMfaFactorUpdateOne) SetTransports(v []string) *UserMfaFactorUpdateOne {
+	_u.mutation.SetTransports(v)
+	return _u
+}
+
+// AppendTransports appends value to the "transports" field.
+func (_u *UserMfaFactorUpdateOne) AppendTransports(v []string) *UserMfaFactorUpdateOne {
+	_u.mutation.AppendTransports(v)
+	return _u
+}
+
+// ClearTransports clears the value of the "transports" field.
+func (_u *UserMfaFactorUpdateOne) ClearTransports() *UserMfaFactorUpdateOne {
+	_u.mutation.ClearTransports()
+	return _u
+}
+
+// SetCredentialFlags sets the "credential_flags" field.
+func (_u *UserMfaFactorUpdateOne) SetCredentialFlags(v uint8) *UserMfaFactorUpdateOne {
+	_u.mutation.ResetCredentialFlags()
+	_u.mutation.SetCredentialFlags(v)
+	return _u
+}
+
+// SetNillableCredentialFlags sets the "credential_flags" field if the given value is not nil.
+func (_u *UserMfaFactorUpdateOne) SetNillableCredentialFlags(v *uint8) *UserMfaFactorUpdateOne {
+	if v != nil {
+		_u.SetCredentialFlags(*v)
+	}
+	return _u
+}
+
+// AddCredentialFlags adds value to the "credential_flags" field.
+func (_u *UserMfaFactorUpdateOne) AddCredentialFlags(v int8) *UserMfaFactorUpdateOne {
+	_u.mutation.AddCredentialFlags(v)
+	return _u
+}
+
+// SetAttestationType sets the "attestation_type" field.
+func (_u *UserMfaFactorUpdateOne) SetAttestationType(v string) *UserMfaFactorUpdateOne {
+	_u.mutation.SetAttestationType(v)
+	return _u
+}
+
+// SetNillableAttestationType sets the "attestation_type" field if the given value is not nil.
+func (_u *UserMfaFactorUpdateOne) SetNillableAttestationType(v *string) *UserMfaFactorUpdateOne {
+	if v != nil {
+		_u.SetAttestationType(*v)
+	}
+	return _u
+}
+
+// ClearAttestationType clears the value of the "attestation_type" field.
+func (_u *UserMfaFactorUpdateOne) ClearAttestationType() *UserMfaFactorUpdateOne {
+	_u.mutation.ClearAttestationType()
+	return _u
+}
+
+// SetAttestationFormat sets the "attestation_format" field.
+func (_u *UserMfaFactorUpdateOne) SetAttestationFormat(v string) *UserMfaFactorUpdateOne {
+	_u.mutation.SetAttestationFormat(v)
+	return _u
+}
+
+// SetNillableAttestationFormat sets the "attestation_format" field if the given value is not nil.
+func (_u *UserMfaFactorUpdateOne) SetNillableAttestationFormat(v *string) *UserMfaFactorUpdateOne {
+	if v != nil {
+		_u.SetAttestationFormat(*v)
+	}
+	return _u
+}
+
+// ClearAttestationFormat clears the value of the "attestation_format" field.
+func (_u *UserMfaFactorUpdateOne) ClearAttestationFormat() *UserMfaFactorUpdateOne {
+	_u.mutation.ClearAttestationFormat()
+	return _u
+}
+
 // Mutation returns the UserMfaFactorMutation object of the builder.
 func (_u *UserMfaFactorUpdateOne) Mutation() *UserMfaFactorMutation {
 	return _u.mutation
@@ -584,6 +926,21 @@ func (_u *UserMfaFactorUpdateOne) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "UserMfaFactor.status": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.CredentialID(); ok {
+		if err := usermfafactor.CredentialIDValidator(v); err != nil {
+			return &ValidationError{Name: "credential_id", err: fmt.Errorf(`ent: validator failed for field "UserMfaFactor.credential_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AttestationType(); ok {
+		if err := usermfafactor.AttestationTypeValidator(v); err != nil {
+			return &ValidationError{Name: "attestation_type", err: fmt.Errorf(`ent: validator failed for field "UserMfaFactor.attestation_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AttestationFormat(); ok {
+		if err := usermfafactor.AttestationFormatValidator(v); err != nil {
+			return &ValidationError{Name: "attestation_format", err: fmt.Errorf(`ent: validator failed for field "UserMfaFactor.attestation_format": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -679,6 +1036,56 @@ func (_u *UserMfaFactorUpdateOne) sqlSave(ctx context.Context) (_node *UserMfaFa
 	if _u.mutation.LastUsedAtCleared() {
 		_spec.ClearField(usermfafactor.FieldLastUsedAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.CredentialID(); ok {
+		_spec.SetField(usermfafactor.FieldCredentialID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PublicKey(); ok {
+		_spec.SetField(usermfafactor.FieldPublicKey, field.TypeBytes, value)
+	}
+	if _u.mutation.PublicKeyCleared() {
+		_spec.ClearField(usermfafactor.FieldPublicKey, field.TypeBytes)
+	}
+	if value, ok := _u.mutation.SignCount(); ok {
+		_spec.SetField(usermfafactor.FieldSignCount, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedSignCount(); ok {
+		_spec.AddField(usermfafactor.FieldSignCount, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.Aaguid(); ok {
+		_spec.SetField(usermfafactor.FieldAaguid, field.TypeBytes, value)
+	}
+	if _u.mutation.AaguidCleared() {
+		_spec.ClearField(usermfafactor.FieldAaguid, field.TypeBytes)
+	}
+	if value, ok := _u.mutation.Transports(); ok {
+		_spec.SetField(usermfafactor.FieldTransports, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTransports(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, usermfafactor.FieldTransports, value)
+		})
+	}
+	if _u.mutation.TransportsCleared() {
+		_spec.ClearField(usermfafactor.FieldTransports, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.CredentialFlags(); ok {
+		_spec.SetField(usermfafactor.FieldCredentialFlags, field.TypeUint8, value)
+	}
+	if value, ok := _u.mutation.AddedCredentialFlags(); ok {
+		_spec.AddField(usermfafactor.FieldCredentialFlags, field.TypeUint8, value)
+	}
+	if value, ok := _u.mutation.AttestationType(); ok {
+		_spec.SetField(usermfafactor.FieldAttestationType, field.TypeString, value)
+	}
+	if _u.mutation.AttestationTypeCleared() {
+		_spec.ClearField(usermfafactor.FieldAttestationType, field.TypeString)
+	}
+	if value, ok := _u.mutation.AttestationFormat(); ok {
+		_spec.SetField(usermfafactor.FieldAttestationFormat, field.TypeString, value)
+	}
+	if _u.mutation.AttestationFormatCleared() {
+		_spec.ClearField(usermfafactor.FieldAttestationFormat, field.TypeString)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	_node = &UserMfaFactor{config: _u.config}
 	_spec.Assign = _node.assignValues
@@ -18,6 +18,7 @@ func RegisterMigrateModels() {
 	gorm.RegisterMigrateModels(
 		&models.Api{},
 		&models.ApiAuditLog{},
+		&models.ApiClient{},
 		&models.DataAccessAuditLog{},
 		&models.DictEntry{},
 		&models.DictEntryI18n{},
@@ -26,7 +27,9 @@ func RegisterMigrateModels() {
 		&models.InternalMessage{},
 		&models.InternalMessageCategory{},
 		&models.InternalMessageRecipient{},
+		&models.JwtSigningKey{},
 		&models.Language{},
+		&models.LdapConfig{},
 		&models.LoginAuditLog{},
 		&models.LoginPolicy{},
 		&models.Membership{},
@@ -34,8 +37,11 @@ func RegisterMigrateModels() {
 		&models.MembershipPosition{},
 		&models.MembershipRole{},
 		&models.Menu{},
+		&models.MfaPolicy{},
+		&models.OAuthProviderConfig{},
 		&models.OperationAuditLog{},
 		&models.OrgUnit{},
+		&models.PasswordPolicy{},
 		&models.Permission{},
 		&models.PermissionApi{},
 		&models.PermissionAuditLog{},
@@ -47,9 +53,13 @@ func RegisterMigrateModels() {
 		&models.PlanQuota{},
 		&models.PolicyEvaluationLog{},
 		&models.Position{},
+		&models.RelationTuple{},
+		&models.RelationTupleChange{},
 		&models.Role{},
 		&models.RoleMetadata{},
 		&models.RolePermission{},
+		&models.SamlConfig{},
+		&models.ScimToken{},
 		&models.Task{},
 		&models.Tenant{},
 		&models.User{},
@@ -11,13 +11,15 @@ type LoginPolicy struct {
 	mixin.OperatorID
 	mixin.TenantID
 
-	TargetID *uint32 `gorm:"column:target_id;type:int unsigned;comment:目标用户ID"`
-	Value    *string `gorm:"column:value;type:varchar(255);comment:限制值（如IP地址、MAC地址或地区代码）"`
-	Reason   *string `gorm:"column:reason;type:varchar(255);comment:限制原因"`
+	TargetID   *uint32 `gorm:"column:target_id;type:int unsigned;comment:目标ID（用户ID或组织单元ID）"`
+	TargetCode *string `gorm:"column:target_code;type:varchar(255);comment:目标编码（角色码）"`
+	Value      *string `gorm:"column:value;type:varchar(255);comment:限制值（如IP地址、MAC地址或地区代码）"`
+	Reason     *string `gorm:"column:reason;type:varchar(255);comment:限制原因"`
 
 	// 使用字符串表示枚举，保留可空语义
-	Type   *string `gorm:"column:type;type:varchar(32);default:BLACKLIST;comment:限制类型"`
-	Method *string `gorm:"column:method;type:varchar(32);default:IP;comment:限制方式"`
+	Type       *string `gorm:"column:type;type:varchar(32);default:BLACKLIST;comment:限制类型"`
+	Method     *string `gorm:"column:method;type:varchar(32);default:IP;comment:限制方式"`
+	TargetType *string `gorm:"column:target_type;type:varchar(32);comment:生效对象类型"`
 }
 
 func (LoginPolicy) TableName() string {
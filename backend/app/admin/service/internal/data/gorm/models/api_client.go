@@ -0,0 +1,36 @@
+package models
+
+import (
+	"time"
+
+	"github.com/tx7do/go-crud/gorm/mixin"
+	"gorm.io/datatypes"
+)
+
+// ApiClient 对应表 sys_api_clients，服务客户端表（client_credentials 授权）
+type ApiClient struct {
+	mixin.AutoIncrementID
+
+	Name             *string         `gorm:"column:name;type:varchar(128);comment:客户端名称"`
+	ClientID         *string         `gorm:"column:client_id;type:varchar(64);comment:客户端ID;uniqueIndex:uidx_sys_api_client_client_id"`
+	ClientSecretHash *string         `gorm:"column:client_secret_hash;type:varchar(255);comment:客户端密钥哈希（bcrypt，不要存明文）"`
+	RoleIds          *datatypes.JSON `gorm:"column:role_ids;type:json;comment:授权角色ID列表"`
+	RedirectUris     *datatypes.JSON `gorm:"column:redirect_uris;type:json;comment:授权码模式允许的回调地址列表"`
+	Scopes           *datatypes.JSON `gorm:"column:scopes;type:json;comment:授权码模式允许申请的授权范围列表"`
+	PublicClient     *bool           `gorm:"column:public_client;default:false;comment:是否公共客户端（无法保管密钥，仅凭 PKCE 换取令牌）"`
+	ExpiresAt        *time.Time      `gorm:"column:expires_at;type:datetime;comment:客户端过期时间，为空表示永不过期"`
+	SecretRotatedAt  *time.Time      `gorm:"column:secret_rotated_at;type:datetime;comment:密钥最近一次轮换时间"`
+	LastUsedAt       *time.Time      `gorm:"column:last_used_at;type:datetime;comment:最近一次换取令牌的时间"`
+	LastUsedIP       *string         `gorm:"column:last_used_ip;type:varchar(64);comment:最近一次换取令牌的客户端IP"`
+
+	mixin.TimeAt
+	mixin.OperatorID
+	mixin.Description
+	mixin.TenantID
+	mixin.SwitchStatus
+}
+
+// TableName 指定表名
+func (ApiClient) TableName() string {
+	return "sys_api_clients"
+}
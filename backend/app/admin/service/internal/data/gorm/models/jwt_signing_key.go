@@ -0,0 +1,28 @@
+package models
+
+import (
+	"time"
+
+	"github.com/tx7do/go-crud/gorm/mixin"
+)
+
+// JwtSigningKey 对应表 sys_jwt_signing_keys，JWT 签名密钥表
+type JwtSigningKey struct {
+	mixin.AutoIncrementID
+
+	Kid         *string    `gorm:"column:kid;type:varchar(64);comment:密钥ID（JWT 头部 kid）;uniqueIndex:uidx_sys_jwt_signing_key_kid"`
+	Algorithm   *string    `gorm:"column:algorithm;type:varchar(16);comment:签名算法"`
+	KeyMaterial *string    `gorm:"column:key_material;type:text;comment:密钥材料（非对称为 PEM 私钥，对称为 base64url 秘钥），加密存储"`
+	Status      *string    `gorm:"column:status;type:varchar(16);default:ACTIVE;comment:密钥状态;index:idx_sys_jwt_signing_key_status_verify_until,priority:1"`
+	ActivatedAt *time.Time `gorm:"column:activated_at;type:datetime;comment:启用时间"`
+	RetiredAt   *time.Time `gorm:"column:retired_at;type:datetime;comment:退役时间"`
+	VerifyUntil *time.Time `gorm:"column:verify_until;type:datetime;comment:校验截止时间：退役密钥在此之前仍接受其签发的令牌;index:idx_sys_jwt_signing_key_status_verify_until,priority:2"`
+
+	mixin.TimeAt
+	mixin.OperatorID
+}
+
+// TableName 指定表名
+func (JwtSigningKey) TableName() string {
+	return "sys_jwt_signing_keys"
+}
@@ -0,0 +1,45 @@
+package models
+
+import (
+	"time"
+
+	"github.com/tx7do/go-crud/gorm/mixin"
+	"gorm.io/datatypes"
+)
+
+// LdapConfig 对应表 sys_ldap_configs，LDAP 目录认证与同步配置表
+type LdapConfig struct {
+	mixin.AutoIncrementID
+
+	DisplayName          *string         `gorm:"column:display_name;type:varchar(64);comment:显示名称"`
+	ServerURL            *string         `gorm:"column:server_url;type:varchar(512);comment:目录地址（ldap:// 或 ldaps://）"`
+	StartTLS             *bool           `gorm:"column:start_tls;default:false;comment:ldap:// 连接是否升级 StartTLS"`
+	CaCertificate        *string         `gorm:"column:ca_certificate;type:text;comment:校验目录服务器证书的 CA（PEM），为空使用系统根证书"`
+	BindDn               *string         `gorm:"column:bind_dn;type:varchar(512);comment:检索用服务账号 DN，为空匿名检索"`
+	BindPassword         *string         `gorm:"column:bind_password;type:varchar(1024);comment:服务账号密码（加密存储）"`
+	BaseDn               *string         `gorm:"column:base_dn;type:varchar(512);comment:检索起点 DN"`
+	UserFilter           *string         `gorm:"column:user_filter;type:varchar(1024);comment:用户过滤器，含 {username} 占位符"`
+	IDAttribute          *string         `gorm:"column:id_attribute;type:varchar(64);comment:条目唯一标识属性（entryUUID / objectGUID），为空以 DN 作标识"`
+	AttributeMapping     *datatypes.JSON `gorm:"column:attribute_mapping;type:json;comment:用户属性映射"`
+	GroupFilter          *string         `gorm:"column:group_filter;type:varchar(1024);comment:组过滤器，为空不同步组"`
+	GroupMemberAttribute *string         `gorm:"column:group_member_attribute;type:varchar(64);comment:组成员属性（值为成员 DN）"`
+	GroupRoleMapping     *datatypes.JSON `gorm:"column:group_role_mapping;type:json;comment:组到角色的映射"`
+	OrgUnitFilter        *string         `gorm:"column:org_unit_filter;type:varchar(1024);comment:组织单元过滤器，为空不同步组织单元"`
+	OrgUnitRootID        *uint32         `gorm:"column:org_unit_root_id;comment:同步的顶层组织单元挂载的本地上级组织单元ID"`
+	AuthMode             *string         `gorm:"column:auth_mode;type:varchar(32);default:LDAP_FIRST;comment:认证模式"`
+	JitProvisioning      *bool           `gorm:"column:jit_provisioning;default:false;comment:目录账号首次登录且尚未同步时是否自动创建用户"`
+	DefaultRoleIds       *datatypes.JSON `gorm:"column:default_role_ids;type:json;comment:新建用户时授予的角色ID列表"`
+	DefaultOrgUnitID     *uint32         `gorm:"column:default_org_unit_id;comment:新建用户且目录未给出组织单元时归属的组织单元ID"`
+	LastSyncedAt         *time.Time      `gorm:"column:last_synced_at;type:datetime;comment:最近一次同步时间"`
+	LastSyncMessage      *string         `gorm:"column:last_sync_message;type:varchar(1024);comment:最近一次同步结果"`
+
+	mixin.TimeAt
+	mixin.OperatorID
+	mixin.TenantID
+	mixin.SwitchStatus
+}
+
+// TableName 指定表名
+func (LdapConfig) TableName() string {
+	return "sys_ldap_configs"
+}
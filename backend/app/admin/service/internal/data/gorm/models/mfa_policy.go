@@ -0,0 +1,23 @@
+package models
+
+import (
+	"github.com/tx7do/go-crud/gorm/mixin"
+	"gorm.io/datatypes"
+)
+
+// MfaPolicy 对应表 sys_mfa_policies，MFA 策略表
+type MfaPolicy struct {
+	mixin.AutoIncrementID
+
+	Enforcement       *string         `gorm:"column:enforcement;type:varchar(32);default:NOT_REQUIRED;comment:对全体用户的要求"`
+	RequiredRoleCodes *datatypes.JSON `gorm:"column:required_role_codes;type:json;comment:强制启用 MFA 的角色代码列表"`
+
+	mixin.TimeAt
+	mixin.OperatorID
+	mixin.TenantID
+}
+
+// TableName 指定表名
+func (MfaPolicy) TableName() string {
+	return "sys_mfa_policies"
+}
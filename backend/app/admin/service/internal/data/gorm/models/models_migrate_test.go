@@ -37,16 +37,18 @@ func TestAllModelsMigrate(t *testing.T) {
 // 模型列表，集中维护以避免两处漂移。
 func allMigrateModelsForTest() []interface{} {
 	return []interface{}{
-		&Api{}, &ApiAuditLog{}, &DataAccessAuditLog{},
+		&Api{}, &ApiAuditLog{}, &ApiClient{}, &DataAccessAuditLog{},
 		&DictEntry{}, &DictEntryI18n{}, &DictType{},
 		&File{}, &InternalMessage{}, &InternalMessageCategory{}, &InternalMessageRecipient{},
-		&Language{}, &LoginAuditLog{}, &LoginPolicy{},
+		&JwtSigningKey{},
+		&Language{}, &LdapConfig{}, &LoginAuditLog{}, &LoginPolicy{},
 		&Membership{}, &MembershipOrgUnit{}, &MembershipPosition{}, &MembershipRole{},
-		&Menu{}, &OperationAuditLog{}, &OrgUnit{},
-		&Permission{}, &PermissionApi{}, &PermissionAuditLog{}, &PermissionGroup{},
+		&Menu{}, &MfaPolicy{}, &OAuthProviderConfig{}, &OperationAuditLog{}, &OrgUnit{},
+		&PasswordPolicy{}, &Permission{}, &PermissionApi{}, &PermissionAuditLog{}, &PermissionGroup{},
 		&PermissionMenu{}, &PermissionPolicy{},
 		&Plan{}, &PlanModule{}, &PlanQuota{},
-		&PolicyEvaluationLog{}, &Position{}, &Role{}, &RoleMetadata{}, &RolePermission{},
+		&PolicyEvaluationLog{}, &Position{}, &RelationTuple{}, &RelationTupleChange{}, &Role{}, &RoleMetadata{}, &RolePermission{},
+		&SamlConfig{}, &ScimToken{},
 		&Task{}, &Tenant{}, &User{}, &UserCredential{}, &UserMfaFactor{},
 		&UserOrgUnit{}, &UserPosition{}, &UserRole{},
 	}
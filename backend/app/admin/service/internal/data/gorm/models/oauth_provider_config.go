@@ -0,0 +1,35 @@
+package models
+
+import (
+	"github.com/tx7do/go-crud/gorm/mixin"
+	"gorm.io/datatypes"
+)
+
+// OAuthProviderConfig 对应表 sys_oauth_provider_configs，第三方登录提供方配置表
+type OAuthProviderConfig struct {
+	mixin.AutoIncrementID
+
+	Provider       *string         `gorm:"column:provider;type:varchar(32);comment:提供方"`
+	ProviderCustom string          `gorm:"column:provider_custom;type:varchar(64);default:'';comment:自定义提供方标识"`
+	DisplayName    *string         `gorm:"column:display_name;type:varchar(64);comment:登录页显示名称"`
+	ClientID       *string         `gorm:"column:client_id;type:varchar(255);comment:提供方分配的客户端ID（微信为 AppID）"`
+	ClientSecret   *string         `gorm:"column:client_secret;type:varchar(1024);comment:提供方分配的客户端密钥（加密存储）"`
+	AuthURL        *string         `gorm:"column:auth_url;type:varchar(512);comment:授权端点，为空使用提供方默认值"`
+	TokenURL       *string         `gorm:"column:token_url;type:varchar(512);comment:令牌端点，为空使用提供方默认值"`
+	UserinfoURL    *string         `gorm:"column:userinfo_url;type:varchar(512);comment:用户信息端点，为空使用提供方默认值"`
+	RedirectURI    *string         `gorm:"column:redirect_uri;type:varchar(512);comment:在提供方登记的回调地址"`
+	Scopes         *datatypes.JSON `gorm:"column:scopes;type:json;comment:授权范围，为空使用提供方默认值"`
+	AutoProvision  *bool           `gorm:"column:auto_provision;default:false;comment:未绑定的第三方账号首次登录时是否自动创建用户"`
+	DefaultRoleIds *datatypes.JSON `gorm:"column:default_role_ids;type:json;comment:自动创建用户时授予的角色ID列表"`
+
+	mixin.TimeAt
+	mixin.OperatorID
+	mixin.TenantID
+	mixin.SwitchStatus
+	mixin.SortOrder
+}
+
+// TableName 指定表名
+func (OAuthProviderConfig) TableName() string {
+	return "sys_oauth_provider_configs"
+}
@@ -0,0 +1,31 @@
+package models
+
+import (
+	"github.com/tx7do/go-crud/gorm/mixin"
+	"gorm.io/datatypes"
+)
+
+// PasswordPolicy 对应表 sys_password_policies，密码策略表
+type PasswordPolicy struct {
+	mixin.AutoIncrementID
+
+	MinLength        *uint32         `gorm:"column:min_length;type:int unsigned;default:0;comment:最小长度"`
+	RequireUppercase *bool           `gorm:"column:require_uppercase;type:tinyint(1);default:0;comment:须含大写字母"`
+	RequireLowercase *bool           `gorm:"column:require_lowercase;type:tinyint(1);default:0;comment:须含小写字母"`
+	RequireDigit     *bool           `gorm:"column:require_digit;type:tinyint(1);default:0;comment:须含数字"`
+	RequireSymbol    *bool           `gorm:"column:require_symbol;type:tinyint(1);default:0;comment:须含特殊字符"`
+	DisallowIdentity *bool           `gorm:"column:disallow_identity;type:tinyint(1);default:0;comment:禁止包含用户名与租户代码"`
+	BannedSubstrings *datatypes.JSON `gorm:"column:banned_substrings;type:json;comment:禁止包含的子串（不区分大小写）"`
+	HistoryCount     *uint32         `gorm:"column:history_count;type:int unsigned;default:0;comment:禁止重复使用最近 N 次密码，0 不限制"`
+	MaxAgeDays       *uint32         `gorm:"column:max_age_days;type:int unsigned;default:0;comment:密码有效天数，到期后登录要求修改，0 不过期"`
+	CheckBreached    *bool           `gorm:"column:check_breached;type:tinyint(1);default:0;comment:是否比对本地泄露密码库"`
+
+	mixin.TimeAt
+	mixin.OperatorID
+	mixin.TenantID
+}
+
+// TableName 指定表名
+func (PasswordPolicy) TableName() string {
+	return "sys_password_policies"
+}
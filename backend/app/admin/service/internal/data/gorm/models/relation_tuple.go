@@ -0,0 +1,25 @@
+package models
+
+import (
+	"github.com/tx7do/go-crud/gorm/mixin"
+)
+
+// RelationTuple 对应表 sys_relation_tuples，关系元组表（object#relation@subject）
+type RelationTuple struct {
+	mixin.AutoIncrementID
+
+	Namespace        *string `gorm:"column:namespace;type:varchar(64);comment:对象命名空间;uniqueIndex:uix_relation_tuple,priority:1"`
+	ObjectID         *string `gorm:"column:object_id;type:varchar(255);comment:对象ID;uniqueIndex:uix_relation_tuple,priority:2"`
+	Relation         *string `gorm:"column:relation;type:varchar(64);comment:关系;uniqueIndex:uix_relation_tuple,priority:3"`
+	SubjectNamespace *string `gorm:"column:subject_namespace;type:varchar(64);comment:主体命名空间;uniqueIndex:uix_relation_tuple,priority:4;index:idx_relation_tuple_subject,priority:1"`
+	SubjectID        *string `gorm:"column:subject_id;type:varchar(255);comment:主体ID;uniqueIndex:uix_relation_tuple,priority:5;index:idx_relation_tuple_subject,priority:2"`
+	SubjectRelation  *string `gorm:"column:subject_relation;type:varchar(64);default:'';comment:主体关系（为空表示具体对象，否则为用户集）;uniqueIndex:uix_relation_tuple,priority:6;index:idx_relation_tuple_subject,priority:3"`
+	CreatedBy        *uint32 `gorm:"column:created_by;type:int unsigned;comment:创建者ID"`
+
+	mixin.CreatedAt
+}
+
+// TableName 指定表名
+func (RelationTuple) TableName() string {
+	return "sys_relation_tuples"
+}
@@ -0,0 +1,21 @@
+package models
+
+import (
+	"github.com/tx7do/go-crud/gorm/mixin"
+)
+
+// RelationTupleChange 对应表 sys_relation_tuple_changes，关系元组变更日志表（自增ID即元组版本号）
+type RelationTupleChange struct {
+	mixin.AutoIncrementID
+
+	Operation *string `gorm:"column:operation;type:varchar(16);comment:变更操作"`
+	Tuples    *string `gorm:"column:tuples;type:text;comment:变更的元组（每行一个 object#relation@subject；REPLACE 为被替换的命名空间）"`
+	CreatedBy *uint32 `gorm:"column:created_by;type:int unsigned;comment:创建者ID"`
+
+	mixin.CreatedAt
+}
+
+// TableName 指定表名
+func (RelationTupleChange) TableName() string {
+	return "sys_relation_tuple_changes"
+}
@@ -2,6 +2,7 @@
 
 import (
 	"github.com/tx7do/go-crud/gorm/mixin"
+	"gorm.io/datatypes"
 )
 
 // Role 对应表 sys_roles
@@ -13,6 +14,9 @@ type Role struct {
 	IsProtected  *bool   `gorm:"column:is_protected;type:boolean;comment:是否受保护"`
 	Type         *string `gorm:"column:type;type:varchar(128);comment:类型"`
 
+	DataScope           *string         `gorm:"column:data_scope;type:varchar(32);comment:数据权限范围"`
+	DataScopeOrgUnitIds *datatypes.JSON `gorm:"column:data_scope_org_unit_ids;type:json;comment:自定义数据权限的组织单元ID列表"`
+
 	mixin.TimeAt
 	mixin.OperatorID
 	mixin.Remark
@@ -0,0 +1,33 @@
+package models
+
+import (
+	"github.com/tx7do/go-crud/gorm/mixin"
+	"gorm.io/datatypes"
+)
+
+// SamlConfig 对应表 sys_saml_configs，SAML 单点登录配置表
+type SamlConfig struct {
+	mixin.AutoIncrementID
+
+	DisplayName      *string         `gorm:"column:display_name;type:varchar(64);comment:登录页显示名称"`
+	IdpEntityID      *string         `gorm:"column:idp_entity_id;type:varchar(512);comment:IdP 实体ID（断言 Issuer）"`
+	IdpSsoURL        *string         `gorm:"column:idp_sso_url;type:varchar(1024);comment:IdP 单点登录地址（HTTP-Redirect 绑定）"`
+	IdpCertificate   *string         `gorm:"column:idp_certificate;type:text;comment:IdP 签名证书（PEM）"`
+	NameIDFormat     *string         `gorm:"column:name_id_format;type:varchar(128);comment:请求的 NameID 格式，为空由 IdP 决定"`
+	AttributeMapping *datatypes.JSON `gorm:"column:attribute_mapping;type:json;comment:断言属性映射"`
+	JitProvisioning  *bool           `gorm:"column:jit_provisioning;default:false;comment:未绑定的企业账号首次登录时是否自动创建用户"`
+	DefaultRoleIds   *datatypes.JSON `gorm:"column:default_role_ids;type:json;comment:自动创建用户时授予的角色ID列表"`
+	DefaultOrgUnitID *uint32         `gorm:"column:default_org_unit_id;comment:自动创建用户时归属的组织单元ID"`
+	SsoOnly          *bool           `gorm:"column:sso_only;default:false;comment:是否仅允许单点登录（禁用本地密码登录）"`
+	LoginRedirectURL *string         `gorm:"column:login_redirect_url;type:varchar(512);comment:断言校验通过后携带一次性票据跳转的前端地址"`
+
+	mixin.TimeAt
+	mixin.OperatorID
+	mixin.TenantID
+	mixin.SwitchStatus
+}
+
+// TableName 指定表名
+func (SamlConfig) TableName() string {
+	return "sys_saml_configs"
+}
@@ -0,0 +1,30 @@
+package models
+
+import (
+	"time"
+
+	"github.com/tx7do/go-crud/gorm/mixin"
+)
+
+// ScimToken 对应表 sys_scim_tokens，SCIM 供应令牌表
+type ScimToken struct {
+	mixin.AutoIncrementID
+
+	Name        *string    `gorm:"column:name;type:varchar(64);comment:令牌名称，用作 SCIM 变更的审计操作人"`
+	TokenPrefix *string    `gorm:"column:token_prefix;type:varchar(16);comment:令牌前缀，便于管理员辨认"`
+	TokenHash   *string    `gorm:"column:token_hash;type:varchar(64);uniqueIndex:uidx_sys_scim_token_hash;comment:令牌哈希（SHA-256 十六进制，不要存明文）"`
+	ExpiresAt   *time.Time `gorm:"column:expires_at;type:datetime;comment:过期时间，为空表示永不过期"`
+	LastUsedAt  *time.Time `gorm:"column:last_used_at;type:datetime;comment:最近一次使用时间"`
+	LastUsedIP  *string    `gorm:"column:last_used_ip;type:varchar(64);comment:最近一次使用的客户端IP"`
+
+	mixin.TimeAt
+	mixin.OperatorID
+	mixin.Description
+	mixin.TenantID
+	mixin.SwitchStatus
+}
+
+// TableName 指定表名
+func (ScimToken) TableName() string {
+	return "sys_scim_tokens"
+}
@@ -21,12 +21,14 @@ type UserCredential struct {
 	ExtraInfo              *datatypes.JSON `gorm:"column:extra_info;type:json;comment:扩展信息"`
 	Provider               *string         `gorm:"column:provider;type:varchar(255);comment:第三方平台标识;index:idx_sys_user_credential_provider;uniqueIndex:idx_sys_user_credential_provider_account,priority:1"`
 	ProviderAccountID      *string         `gorm:"column:provider_account_id;type:varchar(255);comment:第三方平台的账号唯一ID;uniqueIndex:idx_sys_user_credential_provider_account,priority:2"`
-	ActivateTokenHash      *string         `gorm:"column:activate_token_hash;type:varchar(255);comment:激活令牌哈希（不要存明文）"`
+	ActivateTokenHash      *string         `gorm:"column:activate_token_hash;type:varchar(255);comment:激活令牌哈希（不要存明文）;index:idx_sys_user_credential_activate_token_hash"`
 	ActivateTokenExpiresAt *time.Time      `gorm:"column:activate_token_expires_at;type:datetime;comment:激活令牌到期时间"`
 	ActivateTokenUsedAt    *time.Time      `gorm:"column:activate_token_used_at;type:datetime;comment:激活令牌使用时间，单次使用时记录"`
-	ResetTokenHash         *string         `gorm:"column:reset_token_hash;type:varchar(255);comment:重置密码令牌哈希（不要存明文）"`
+	ResetTokenHash         *string         `gorm:"column:reset_token_hash;type:varchar(255);comment:重置密码令牌哈希（不要存明文）;index:idx_sys_user_credential_reset_token_hash"`
 	ResetTokenExpiresAt    *time.Time      `gorm:"column:reset_token_expires_at;type:datetime;comment:重置令牌到期时间"`
 	ResetTokenUsedAt       *time.Time      `gorm:"column:reset_token_used_at;type:datetime;comment:重置令牌使用时间"`
+	CredentialChangedAt    *time.Time      `gorm:"column:credential_changed_at;type:datetime;comment:密码最近修改时间，用于密码有效期判定"`
+	CredentialHistory      *datatypes.JSON `gorm:"column:credential_history;type:json;comment:最近使用过的密码哈希"`
 
 	mixin.TimeAt
 	mixin.TenantID
@@ -4,18 +4,28 @@
 	"time"
 
 	"github.com/tx7do/go-crud/gorm/mixin"
+	"gorm.io/datatypes"
 )
 
 // UserMfaFactor 对应表 sys_user_mfa_factors，用户 MFA 因子表
 type UserMfaFactor struct {
 	mixin.AutoIncrementID
 
-	UserID     *uint32    `gorm:"column:user_id;type:int unsigned;comment:关联主表的用户ID;index:idx_sys_user_mfa_factor_user_id;uniqueIndex:idx_sys_user_mfa_factor_uid_method,priority:1"`
-	Method     *string    `gorm:"column:method;type:varchar(128);comment:MFA 方法;uniqueIndex:idx_sys_user_mfa_factor_uid_method,priority:2"`
-	SecretHash *string    `gorm:"column:secret_hash;type:varchar(512);comment:MFA secret 密文（AES-GCM 加密，base64 编码；TOTP 校验需还原明文）"`
-	DisplayName *string   `gorm:"column:display_name;type:varchar(128);comment:设备/因子展示名（用户自定义）"`
-	Status     *string    `gorm:"column:status;type:varchar(128);comment:因子状态"`
-	LastUsedAt *time.Time `gorm:"column:last_used_at;type:datetime;comment:最近一次用于验证的时间"`
+	UserID      *uint32    `gorm:"column:user_id;type:int unsigned;comment:关联主表的用户ID;index:idx_sys_user_mfa_factor_user_id;uniqueIndex:idx_sys_user_mfa_factor_uid_method_cred,priority:1"`
+	Method      *string    `gorm:"column:method;type:varchar(128);comment:MFA 方法;uniqueIndex:idx_sys_user_mfa_factor_uid_method_cred,priority:2"`
+	SecretHash  *string    `gorm:"column:secret_hash;type:varchar(512);comment:MFA secret 密文（AES-GCM 加密，base64 编码；TOTP 校验需还原明文）"`
+	DisplayName *string    `gorm:"column:display_name;type:varchar(128);comment:设备/因子展示名（用户自定义）"`
+	Status      *string    `gorm:"column:status;type:varchar(128);comment:因子状态"`
+	LastUsedAt  *time.Time `gorm:"column:last_used_at;type:datetime;comment:最近一次用于验证的时间"`
+
+	CredentialID      string          `gorm:"column:credential_id;type:varchar(512);not null;default:'';comment:WebAuthn 凭证ID（base64url）;index:idx_sys_user_mfa_factor_credential_id;uniqueIndex:idx_sys_user_mfa_factor_uid_method_cred,priority:3"`
+	PublicKey         []byte          `gorm:"column:public_key;type:blob;comment:WebAuthn 凭证公钥（COSE 编码）"`
+	SignCount         uint32          `gorm:"column:sign_count;type:int unsigned;not null;default:0;comment:WebAuthn 签名计数器"`
+	Aaguid            []byte          `gorm:"column:aaguid;type:blob;comment:认证器型号标识（AAGUID）"`
+	Transports        *datatypes.JSON `gorm:"column:transports;type:json;comment:认证器支持的传输方式（usb/nfc/ble/internal/hybrid）"`
+	CredentialFlags   uint8           `gorm:"column:credential_flags;type:tinyint unsigned;not null;default:0;comment:注册时的认证器标志位（备份资格/备份状态等）"`
+	AttestationType   *string         `gorm:"column:attestation_type;type:varchar(64);comment:证明类型"`
+	AttestationFormat *string         `gorm:"column:attestation_format;type:varchar(64);comment:证明格式"`
 
 	mixin.TimeAt
 	mixin.TenantID
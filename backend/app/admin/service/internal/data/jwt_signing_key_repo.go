@@ -0,0 +1,237 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/go-utils/timeutil"
+	"github.com/tx7do/go-utils/trans"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"go-wind-admin/app/admin/service/internal/data/ent"
+	"go-wind-admin/app/admin/service/internal/data/ent/jwtsigningkey"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"go-wind-admin/pkg/crypto"
+	"go-wind-admin/pkg/jwt"
+
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+)
+
+// UsableSigningKeys 从库中加载的密钥环内容。
+type UsableSigningKeys struct {
+	// Active 最新启用的 ACTIVE 密钥，库中无记录时为 nil
+	Active *jwt.SigningKey
+	// Verify 其余仍在校验期内的密钥
+	Verify []*jwt.SigningKey
+}
+
+// JwtSigningKeyRepo JWT 签名密钥仓储。
+// 密钥材料经 crypto.EncryptIfNeeded 加密落库，加载密钥环时用 crypto.DecryptIfNeeded 还原。
+type JwtSigningKeyRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+}
+
+func NewJwtSigningKeyRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *JwtSigningKeyRepo {
+	return &JwtSigningKeyRepo{
+		entClient: entClient,
+		log:       ctx.NewLoggerHelper("jwt-signing-key/repo/admin-service"),
+	}
+}
+
+// LoadUsable 加载 ACTIVE 密钥与校验期内的退役密钥。
+// 单条记录还原失败只记日志并跳过，避免一把坏密钥导致整个密钥环不可用。
+func (r *JwtSigningKeyRepo) LoadUsable(ctx context.Context, now time.Time) (*UsableSigningKeys, error) {
+	entities, err := r.entClient.Client().JwtSigningKey.Query().
+		Where(
+			jwtsigningkey.Or(
+				jwtsigningkey.StatusEQ(jwtsigningkey.StatusActive),
+				jwtsigningkey.VerifyUntilGT(now),
+			),
+		).
+		Order(ent.Desc(jwtsigningkey.FieldActivatedAt), ent.Desc(jwtsigningkey.FieldID)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("query usable jwt signing keys failed: %s", err.Error())
+		return nil, fmt.Errorf("query jwt signing keys failed")
+	}
+
+	out := &UsableSigningKeys{}
+	for _, e := range entities {
+		key, kerr := r.toSigningKey(e)
+		if kerr != nil {
+			r.log.Errorf("restore jwt signing key [%s] failed: %s", derefStr(e.Kid), kerr.Error())
+			continue
+		}
+
+		// 按启用时间倒序，第一把 ACTIVE 即活动密钥；并发轮换可能残留多把 ACTIVE，其余按校验密钥处理
+		if out.Active == nil && e.Status != nil && *e.Status == jwtsigningkey.StatusActive {
+			out.Active = key
+			continue
+		}
+		out.Verify = append(out.Verify, key)
+	}
+	return out, nil
+}
+
+func (r *JwtSigningKeyRepo) toSigningKey(e *ent.JwtSigningKey) (*jwt.SigningKey, error) {
+	if e.Kid == nil || e.Algorithm == nil || e.KeyMaterial == nil {
+		return nil, fmt.Errorf("incomplete key record")
+	}
+
+	material, err := crypto.DecryptIfNeeded(*e.KeyMaterial)
+	if err != nil {
+		return nil, fmt.Errorf("decrypt key material: %w", err)
+	}
+
+	key, err := jwt.NewSigningKeyFromMaterial(*e.Kid, *e.Algorithm, material)
+	if err != nil {
+		return nil, err
+	}
+	if e.Status != nil && *e.Status == jwtsigningkey.StatusRetired && e.VerifyUntil != nil {
+		key.VerifyUntil = *e.VerifyUntil
+	}
+	return key, nil
+}
+
+// List 列出全部签名密钥（不含密钥材料），活动密钥在前。
+func (r *JwtSigningKeyRepo) List(ctx context.Context) (*authenticationV1.ListJwtSigningKeyResponse, error) {
+	entities, err := r.entClient.Client().JwtSigningKey.Query().
+		Order(ent.Asc(jwtsigningkey.FieldStatus), ent.Desc(jwtsigningkey.FieldActivatedAt)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list jwt signing keys failed: %s", err.Error())
+		return nil, authenticationV1.ErrorInternalServerError("list jwt signing keys failed")
+	}
+
+	items := make([]*authenticationV1.JwtSigningKey, 0, len(entities))
+	for _, e := range entities {
+		item := &authenticationV1.JwtSigningKey{
+			Id:          trans.Ptr(e.ID),
+			Kid:         e.Kid,
+			Algorithm:   e.Algorithm,
+			ActivatedAt: timeutil.TimeToTimestamppb(e.ActivatedAt),
+			RetiredAt:   timeutil.TimeToTimestamppb(e.RetiredAt),
+			VerifyUntil: timeutil.TimeToTimestamppb(e.VerifyUntil),
+			CreatedBy:   e.CreatedBy,
+			CreatedAt:   timeutil.TimeToTimestamppb(e.CreatedAt),
+		}
+		if e.Status != nil {
+			switch *e.Status {
+			case jwtsigningkey.StatusActive:
+				item.Status = authenticationV1.JwtSigningKey_ACTIVE.Enum()
+			case jwtsigningkey.StatusRetired:
+				item.Status = authenticationV1.JwtSigningKey_RETIRED.Enum()
+			}
+		}
+		items = append(items, item)
+	}
+
+	return &authenticationV1.ListJwtSigningKeyResponse{
+		Items: items,
+		Total: uint64(len(items)),
+	}, nil
+}
+
+// Activate 登记新密钥为活动密钥，并将原 ACTIVE 密钥退役、保留校验到 retireVerifyUntil。
+// kid 已存在时不做任何修改并返回 false（同一配置密钥重复启动、或多实例并发导入时幂等）。
+func (r *JwtSigningKeyRepo) Activate(
+	ctx context.Context,
+	kid, algorithm, material string,
+	retireVerifyUntil time.Time,
+	operatorID *uint32,
+) (activated bool, err error) {
+	exist, err := r.entClient.Client().JwtSigningKey.Query().
+		Where(jwtsigningkey.KidEQ(kid)).
+		Exist(ctx)
+	if err != nil {
+		r.log.Errorf("query jwt signing key failed: %s", err.Error())
+		return false, fmt.Errorf("query jwt signing key failed")
+	}
+	if exist {
+		return false, nil
+	}
+
+	encrypted, err := crypto.EncryptIfNeeded(material)
+	if err != nil {
+		r.log.Errorf("encrypt jwt signing key failed: %s", err.Error())
+		return false, fmt.Errorf("encrypt jwt signing key failed")
+	}
+	if !crypto.IsEncrypted(encrypted) {
+		r.log.Warn("GOWIND_CRYPTO_KEY 未配置，JWT 签名密钥将以明文落库")
+	}
+
+	// conflict 其他实例已并发登记同一 kid：回滚本事务（含退役操作），按幂等返回
+	var conflict bool
+
+	var tx *ent.Tx
+	tx, err = r.entClient.Client().Tx(ctx)
+	if err != nil {
+		r.log.Errorf("start transaction failed: %s", err.Error())
+		return false, fmt.Errorf("start transaction failed")
+	}
+	defer func() {
+		if err != nil || conflict {
+			if rollbackErr := tx.Rollback(); rollbackErr != nil {
+				r.log.Errorf("transaction rollback failed: %s", rollbackErr.Error())
+			}
+			return
+		}
+		if commitErr := tx.Commit(); commitErr != nil {
+			r.log.Errorf("transaction commit failed: %s", commitErr.Error())
+			err = fmt.Errorf("transaction commit failed")
+		}
+	}()
+
+	now := time.Now()
+
+	if _, err = tx.JwtSigningKey.Update().
+		Where(jwtsigningkey.StatusEQ(jwtsigningkey.StatusActive)).
+		SetStatus(jwtsigningkey.StatusRetired).
+		SetRetiredAt(now).
+		SetVerifyUntil(retireVerifyUntil).
+		SetNillableUpdatedBy(operatorID).
+		SetUpdatedAt(now).
+		Save(ctx); err != nil {
+		r.log.Errorf("retire jwt signing keys failed: %s", err.Error())
+		return false, fmt.Errorf("retire jwt signing keys failed")
+	}
+
+	if err = tx.JwtSigningKey.Create().
+		SetKid(kid).
+		SetAlgorithm(algorithm).
+		SetKeyMaterial(encrypted).
+		SetStatus(jwtsigningkey.StatusActive).
+		SetActivatedAt(now).
+		SetNillableCreatedBy(operatorID).
+		SetCreatedAt(now).
+		Exec(ctx); err != nil {
+		if ent.IsConstraintError(err) {
+			conflict, err = true, nil
+			return false, nil
+		}
+		r.log.Errorf("insert jwt signing key failed: %s", err.Error())
+		return false, fmt.Errorf("insert jwt signing key failed")
+	}
+
+	return true, nil
+}
+
+// PurgeExpired 删除已过校验截止时间的退役密钥。
+func (r *JwtSigningKeyRepo) PurgeExpired(ctx context.Context, now time.Time) (int, error) {
+	n, err := r.entClient.Client().JwtSigningKey.Delete().
+		Where(
+			jwtsigningkey.StatusEQ(jwtsigningkey.StatusRetired),
+			jwtsigningkey.VerifyUntilLT(now),
+		).
+		Exec(ctx)
+	if err != nil {
+		r.log.Errorf("purge expired jwt signing keys failed: %s", err.Error())
+		return 0, fmt.Errorf("purge expired jwt signing keys failed")
+	}
+	return n, nil
+}
@@ -0,0 +1,741 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/go-utils/trans"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/genproto/protobuf/field_mask"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"go-wind-admin/app/admin/service/internal/data/ent"
+	"go-wind-admin/app/admin/service/internal/data/ent/orgunit"
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+	"go-wind-admin/app/admin/service/internal/data/ent/user"
+	"go-wind-admin/app/admin/service/internal/data/ent/usercredential"
+
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+	identityV1 "go-wind-admin/api/gen/go/identity/service/v1"
+
+	"go-wind-admin/pkg/ldap"
+)
+
+// LdapAccountProvider 目录账号在 user_credential.provider 中的取值。
+// 每个租户至多一个目录，(tenant_id, provider, provider_account_id) 即可唯一确定目录账号。
+const LdapAccountProvider = "ldap"
+
+// ldapOrgUnitExternalPrefix 同步创建的组织单元 external_id 前缀，与其他来源的外部ID区分。
+const ldapOrgUnitExternalPrefix = "ldap:"
+
+// ldapAccountInfo 写入 user_credential.extra_info 的目录账号信息，便于排查目录侧账号。
+type ldapAccountInfo struct {
+	DN string `json:"dn,omitempty"`
+}
+
+// ldapLinkedAccount 已绑定的目录账号。
+type ldapLinkedAccount struct {
+	credentialID uint32
+	userID       uint32
+	enabled      bool
+}
+
+// LdapAccountRepo 目录账号仓储。绑定关系存于 user_credential：
+// identity_type=ENTERPRISE_SSO，credential_type=LDAP_BIND，provider_account_id 为条目唯一标识，不存目录密码。
+// 绑定凭证为 DISABLED 表示该账号已从目录删除、由同步停用，账号重新出现时同步（或登录）自动恢复。
+type LdapAccountRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+
+	userRepo        UserRepo
+	credentialRepo  *UserCredentialRepo
+	userRoleRepo    *UserRoleRepo
+	userOrgUnitRepo *UserOrgUnitRepo
+	configRepo      *LdapConfigRepo
+
+	authenticator *Authenticator
+}
+
+func NewLdapAccountRepo(
+	ctx *bootstrap.Context,
+	entClient *entCrud.EntClient[*ent.Client],
+	userRepo UserRepo,
+	credentialRepo *UserCredentialRepo,
+	userRoleRepo *UserRoleRepo,
+	userOrgUnitRepo *UserOrgUnitRepo,
+	configRepo *LdapConfigRepo,
+	authenticator *Authenticator,
+) *LdapAccountRepo {
+	return &LdapAccountRepo{
+		entClient:       entClient,
+		log:             ctx.NewLoggerHelper("ldap-account/repo/admin-service"),
+		userRepo:        userRepo,
+		credentialRepo:  credentialRepo,
+		userRoleRepo:    userRoleRepo,
+		userOrgUnitRepo: userOrgUnitRepo,
+		configRepo:      configRepo,
+		authenticator:   authenticator,
+	}
+}
+
+// Resolve 目录认证成功后解析本地用户：已绑定直接返回（同步停用的账号先恢复）；
+// 未绑定且开启了自动创建则创建用户，否则返回 0。登录时拿不到组信息，新用户只授予默认角色，组映射角色由下次同步补齐。
+func (r *LdapAccountRepo) Resolve(ctx context.Context, settings *LdapSettings, entry *ldap.Entry, loginName string) (uint32, error) {
+	linked, err := r.listLinkedAccounts(ctx, settings.TenantID, entry.ID)
+	if err != nil {
+		return 0, err
+	}
+
+	if account, ok := linked[entry.ID]; ok {
+		if !account.enabled {
+			if err = r.setAccountEnabled(ctx, account, true); err != nil {
+				return 0, err
+			}
+		}
+		return account.userID, nil
+	}
+
+	if !settings.JitProvisioning {
+		return 0, nil
+	}
+
+	var orgUnitIDs []uint32
+	if settings.DefaultOrgUnitID != 0 {
+		orgUnitIDs = []uint32{settings.DefaultOrgUnitID}
+	}
+	u, err := r.provision(ctx, settings, entry, loginName, settings.DefaultRoleIDs, orgUnitIDs)
+	if err != nil {
+		return 0, err
+	}
+	return u.GetId(), nil
+}
+
+// listLinkedAccounts 列出租户已绑定的目录账号（含同步停用的），accountIDs 为空时列出全部。
+func (r *LdapAccountRepo) listLinkedAccounts(ctx context.Context, tenantID uint32, accountIDs ...string) (map[string]*ldapLinkedAccount, error) {
+	query := r.entClient.Client().UserCredential.Query().
+		Where(
+			usercredential.TenantIDEQ(tenantID),
+			usercredential.IdentityTypeEQ(usercredential.IdentityTypeEnterpriseSso),
+			usercredential.ProviderEQ(LdapAccountProvider),
+		)
+	if len(accountIDs) > 0 {
+		query.Where(usercredential.ProviderAccountIDIn(accountIDs...))
+	}
+
+	entities, err := query.All(ctx)
+	if err != nil {
+		r.log.Errorf("query ldap accounts failed: %s", err.Error())
+		return nil, authenticationV1.ErrorServiceUnavailable("db error")
+	}
+
+	accounts := make(map[string]*ldapLinkedAccount, len(entities))
+	for _, e := range entities {
+		accounts[derefStr(e.ProviderAccountID)] = &ldapLinkedAccount{
+			credentialID: e.ID,
+			userID:       derefUint32(e.UserID),
+			enabled:      e.Status != nil && *e.Status == usercredential.StatusEnabled,
+		}
+	}
+	return accounts, nil
+}
+
+// setAccountEnabled 停用或恢复目录账号：绑定凭证与用户状态一并修改（同一事务），停用后吊销在线令牌。
+// 只恢复由同步停用的账号，管理员手工禁用的用户（绑定凭证仍为启用）不受同步影响。
+func (r *LdapAccountRepo) setAccountEnabled(ctx context.Context, account *ldapLinkedAccount, enabled bool) (err error) {
+	credentialStatus, userStatus := usercredential.StatusDisabled, user.StatusDisabled
+	if enabled {
+		credentialStatus, userStatus = usercredential.StatusEnabled, user.StatusNormal
+	}
+
+	var tx *ent.Tx
+	tx, err = r.entClient.Client().Tx(ctx)
+	if err != nil {
+		r.log.Errorf("start transaction failed: %s", err.Error())
+		return authenticationV1.ErrorInternalServerError("start transaction failed")
+	}
+	defer func() {
+		if err != nil {
+			if rollbackErr := tx.Rollback(); rollbackErr != nil {
+				r.log.Errorf("transaction rollback failed: %s", rollbackErr.Error())
+			}
+			return
+		}
+		if commitErr := tx.Commit(); commitErr != nil {
+			r.log.Errorf("transaction commit failed: %s", commitErr.Error())
+			err = authenticationV1.ErrorInternalServerError("transaction commit failed")
+		}
+	}()
+
+	now := time.Now()
+	if err = tx.UserCredential.UpdateOneID(account.credentialID).
+		SetStatus(credentialStatus).
+		SetUpdatedAt(now).
+		Exec(ctx); err != nil {
+		r.log.Errorf("update ldap credential status failed: %s", err.Error())
+		return authenticationV1.ErrorInternalServerError("update ldap account failed")
+	}
+	if err = tx.User.UpdateOneID(account.userID).
+		SetStatus(userStatus).
+		SetUpdatedAt(now).
+		Exec(ctx); err != nil {
+		r.log.Errorf("update ldap user status failed: %s", err.Error())
+		return authenticationV1.ErrorInternalServerError("update ldap account failed")
+	}
+
+	account.enabled = enabled
+	if !enabled && r.authenticator != nil {
+		_ = r.authenticator.RevokeUserToken(ctx, authenticationV1.ClientType_admin, account.userID)
+		_ = r.authenticator.RevokeUserToken(ctx, authenticationV1.ClientType_app, account.userID)
+	}
+	return nil
+}
+
+// provision 为未绑定的目录账号创建用户并完成绑定（同一事务）。
+// 只按条目标识建立关联，绝不按邮箱合并到已有用户；映射的邮箱/手机号在租户内未被占用时才写入新用户。
+func (r *LdapAccountRepo) provision(ctx context.Context, settings *LdapSettings, entry *ldap.Entry, loginName string, roleIDs, orgUnitIDs []uint32) (u *identityV1.User, err error) {
+	tenantID := settings.TenantID
+
+	var tx *ent.Tx
+	tx, err = r.entClient.Client().Tx(ctx)
+	if err != nil {
+		r.log.Errorf("start transaction failed: %s", err.Error())
+		return nil, authenticationV1.ErrorInternalServerError("start transaction failed")
+	}
+	defer func() {
+		if err != nil {
+			if rollbackErr := tx.Rollback(); rollbackErr != nil {
+				r.log.Errorf("transaction rollback failed: %s", rollbackErr.Error())
+			}
+			return
+		}
+		if commitErr := tx.Commit(); commitErr != nil {
+			r.log.Errorf("transaction commit failed: %s", commitErr.Error())
+			err = authenticationV1.ErrorInternalServerError("transaction commit failed")
+		}
+	}()
+
+	base := sanitizeUsername(settings.MappedAttribute(entry, LdapAttrUsername))
+	if base == "" {
+		base = sanitizeUsername(loginName)
+	}
+	if base == "" {
+		return nil, authenticationV1.ErrorBadRequest("ldap entry has no username")
+	}
+
+	var username string
+	if username, err = pickAvailableUsername(ctx, tx, tenantID, base); err != nil {
+		r.log.Errorf("pick username for ldap account [%s] failed: %s", entry.DN, err.Error())
+		return nil, authenticationV1.ErrorInternalServerError("generate username failed")
+	}
+
+	data := &identityV1.User{
+		TenantId:   trans.Ptr(tenantID),
+		Username:   trans.Ptr(username),
+		Status:     identityV1.User_NORMAL.Enum(),
+		RoleIds:    roleIDs,
+		OrgUnitIds: orgUnitIDs,
+	}
+	if v := settings.MappedAttribute(entry, LdapAttrNickname); v != "" {
+		data.Nickname = trans.Ptr(v)
+	}
+	if v := settings.MappedAttribute(entry, LdapAttrRealname); v != "" {
+		data.Realname = trans.Ptr(v)
+	}
+	if v := settings.MappedAttribute(entry, LdapAttrEmail); v != "" {
+		var taken bool
+		if taken, err = r.contactTaken(ctx, tx, tenantID, user.EmailEQ(v)); err != nil {
+			return nil, err
+		}
+		if !taken {
+			data.Email = trans.Ptr(v)
+		}
+	}
+	if v := settings.MappedAttribute(entry, LdapAttrMobile); v != "" {
+		var taken bool
+		if taken, err = r.contactTaken(ctx, tx, tenantID, user.MobileEQ(v)); err != nil {
+			return nil, err
+		}
+		if !taken {
+			data.Mobile = trans.Ptr(v)
+		}
+	}
+
+	if u, err = r.userRepo.CreateWithTx(ctx, tx, data); err != nil {
+		return nil, err
+	}
+
+	if err = r.credentialRepo.CreateWithTx(ctx, tx, newLdapCredential(tenantID, u.GetId(), entry)); err != nil {
+		return nil, err
+	}
+
+	return u, nil
+}
+
+// contactTaken 邮箱/手机号是否已被租户内其他用户占用。
+func (r *LdapAccountRepo) contactTaken(ctx context.Context, tx *ent.Tx, tenantID uint32, cond predicate.User) (bool, error) {
+	taken, err := tx.User.Query().
+		Where(user.TenantIDEQ(tenantID), cond).
+		Exist(ctx)
+	if err != nil {
+		r.log.Errorf("query user contact failed: %s", err.Error())
+		return false, authenticationV1.ErrorInternalServerError("query user failed")
+	}
+	return taken, nil
+}
+
+// newLdapCredential 构造目录账号绑定凭证；identifier 取 ldap:<条目标识>，满足 NotEmpty 与用户内唯一约束。
+func newLdapCredential(tenantID, userID uint32, entry *ldap.Entry) *authenticationV1.UserCredential {
+	info, _ := json.Marshal(&ldapAccountInfo{DN: entry.DN})
+
+	return &authenticationV1.UserCredential{
+		UserId:            trans.Ptr(userID),
+		TenantId:          trans.Ptr(tenantID),
+		IdentityType:      authenticationV1.UserCredential_ENTERPRISE_SSO.Enum(),
+		Identifier:        trans.Ptr(LdapAccountProvider + ":" + entry.ID),
+		CredentialType:    authenticationV1.UserCredential_LDAP_BIND.Enum(),
+		IsPrimary:         trans.Ptr(false),
+		Status:            authenticationV1.UserCredential_ENABLED.Enum(),
+		ExtraInfo:         trans.Ptr(string(info)),
+		Provider:          trans.Ptr(LdapAccountProvider),
+		ProviderAccountId: trans.Ptr(entry.ID),
+	}
+}
+
+// Sync 按目录快照同步租户的组织单元、用户、组映射角色，并停用目录中已删除的账号。
+//
+//   - 组织单元按 external_id 匹配，新建或更新名称与上级；不删除本地组织单元。
+//   - 用户按条目标识匹配，未绑定的新建，已绑定的刷新映射属性；用户名不随目录改名。
+//   - 角色只维护 group_role_mapping 中出现的角色：补上所属组对应的角色、移除已不属于的，手工授予的其他角色保留。
+//   - 用户所在组织单元同理只维护同步而来的组织单元。
+//   - 目录返回零个可用用户而本地有已绑定账号时中止，防止过滤器写错或目录异常导致全员停用。
+func (r *LdapAccountRepo) Sync(ctx context.Context, settings *LdapSettings) (*authenticationV1.LdapSyncResult, error) {
+	snapshot, err := settings.Client().Snapshot(ctx)
+	if err != nil {
+		r.log.Errorf("ldap snapshot of tenant [%d] failed: %s", settings.TenantID, err.Error())
+		return nil, authenticationV1.ErrorServiceUnavailable("ldap directory unavailable")
+	}
+
+	linked, err := r.listLinkedAccounts(ctx, settings.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &authenticationV1.LdapSyncResult{}
+
+	users := make([]*ldap.Entry, 0, len(snapshot.Users))
+	for _, e := range snapshot.Users {
+		if e.ID == "" {
+			result.UsersSkipped++
+			continue
+		}
+		users = append(users, e)
+	}
+	if len(users) == 0 && slices.ContainsFunc(mapValues(linked), func(a *ldapLinkedAccount) bool { return a.enabled }) {
+		return nil, authenticationV1.ErrorServiceUnavailable("ldap directory returned no users, sync aborted")
+	}
+
+	orgUnitsByDN, err := r.syncOrgUnits(ctx, settings, snapshot.OrgUnits, result)
+	if err != nil {
+		return nil, err
+	}
+	syncedOrgUnitIDs := mapValues(orgUnitsByDN)
+
+	memberRoles := ldapMemberRoles(settings, snapshot.Groups)
+	managedRoleIDs := settings.MappedRoleIDs()
+
+	seen := make(map[string]bool, len(users))
+	for _, entry := range users {
+		if seen[entry.ID] {
+			continue
+		}
+		seen[entry.ID] = true
+
+		roleIDs := memberRoles[ldap.NormalizeDN(entry.DN)]
+		var orgUnitIDs []uint32
+		if id, ok := orgUnitsByDN[ldap.ParentDN(entry.DN)]; ok {
+			orgUnitIDs = []uint32{id}
+		}
+
+		account, ok := linked[entry.ID]
+		if !ok {
+			if settings.MappedAttribute(entry, LdapAttrUsername) == "" {
+				result.UsersSkipped++
+				continue
+			}
+			if len(orgUnitIDs) == 0 && settings.DefaultOrgUnitID != 0 {
+				orgUnitIDs = []uint32{settings.DefaultOrgUnitID}
+			}
+			if _, err = r.provision(ctx, settings, entry, "", mergeIDs(settings.DefaultRoleIDs, roleIDs), orgUnitIDs); err != nil {
+				return nil, err
+			}
+			result.UsersCreated++
+			continue
+		}
+
+		if !account.enabled {
+			if err = r.setAccountEnabled(ctx, account, true); err != nil {
+				return nil, err
+			}
+			result.UsersReactivated++
+		}
+
+		var changed bool
+		if changed, err = r.refreshUser(ctx, settings, account.userID, entry,
+			managedRoleIDs, roleIDs, syncedOrgUnitIDs, orgUnitIDs); err != nil {
+			return nil, err
+		}
+		if changed {
+			result.UsersUpdated++
+		}
+	}
+
+	for accountID, account := range linked {
+		if seen[accountID] || !account.enabled {
+			continue
+		}
+		if err = r.setAccountEnabled(ctx, account, false); err != nil {
+			return nil, err
+		}
+		result.UsersDeactivated++
+	}
+
+	return result, nil
+}
+
+// syncOrgUnits 同步组织单元，返回 规范化 DN → 本地组织单元ID。
+// 按 DN 深度由浅到深处理，保证上级先于下级落库；上级不在同步范围内的挂到 org_unit_root_id。
+func (r *LdapAccountRepo) syncOrgUnits(ctx context.Context, settings *LdapSettings, entries []*ldap.Entry, result *authenticationV1.LdapSyncResult) (map[string]uint32, error) {
+	byDN := make(map[string]uint32, len(entries))
+	if len(entries) == 0 {
+		return byDN, nil
+	}
+
+	type ouEntry struct {
+		dn    string
+		depth int
+		entry *ldap.Entry
+	}
+	ordered := make([]ouEntry, 0, len(entries))
+	externalIDs := make([]string, 0, len(entries))
+	for _, e := range entries {
+		dn := ldap.NormalizeDN(e.DN)
+		if dn == "" || e.ID == "" {
+			continue
+		}
+		ordered = append(ordered, ouEntry{dn: dn, depth: strings.Count(dn, ","), entry: e})
+		externalIDs = append(externalIDs, ldapOrgUnitExternalPrefix+e.ID)
+	}
+	sort.SliceStable(ordered, func(i, j int) bool { return ordered[i].depth < ordered[j].depth })
+
+	existing, err := r.entClient.Client().OrgUnit.Query().
+		Where(
+			orgunit.TenantIDEQ(settings.TenantID),
+			orgunit.ExternalIDIn(externalIDs...),
+		).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("query ldap org units failed: %s", err.Error())
+		return nil, authenticationV1.ErrorInternalServerError("query org units failed")
+	}
+	byExternalID := make(map[string]*ent.OrgUnit, len(existing))
+	for _, e := range existing {
+		byExternalID[derefStr(e.ExternalID)] = e
+	}
+
+	paths := make(map[uint32]string)
+	if settings.OrgUnitRootID != 0 {
+		root, err := r.entClient.Client().OrgUnit.Query().
+			Where(orgunit.IDEQ(settings.OrgUnitRootID)).
+			Only(ctx)
+		if err != nil {
+			r.log.Errorf("query ldap org unit root [%d] failed: %s", settings.OrgUnitRootID, err.Error())
+			return nil, authenticationV1.ErrorInternalServerError("query org unit root failed")
+		}
+		paths[root.ID] = derefStr(root.Path)
+	}
+
+	for _, ou := range ordered {
+		name := strings.TrimSpace(ou.entry.Attribute("ou"))
+		if name == "" {
+			name = ou.dn
+		}
+
+		parentID, ok := byDN[ldap.ParentDN(ou.entry.DN)]
+		if !ok {
+			parentID = settings.OrgUnitRootID
+		}
+		var parentPtr *uint32
+		if parentID != 0 {
+			parentPtr = trans.Ptr(parentID)
+		}
+
+		current, ok := byExternalID[ldapOrgUnitExternalPrefix+ou.entry.ID]
+		if !ok {
+			created, err := r.entClient.Client().OrgUnit.Create().
+				SetTenantID(settings.TenantID).
+				SetName(name).
+				SetExternalID(ldapOrgUnitExternalPrefix + ou.entry.ID).
+				SetNillableParentID(parentPtr).
+				SetCreatedAt(time.Now()).
+				Save(ctx)
+			if err != nil {
+				r.log.Errorf("insert ldap org unit [%s] failed: %s", ou.dn, err.Error())
+				return nil, authenticationV1.ErrorInternalServerError("insert org unit failed")
+			}
+			path := entCrud.ComputeTreePath(paths[parentID], created.ID)
+			if err = r.entClient.Client().OrgUnit.UpdateOneID(created.ID).SetPath(path).Exec(ctx); err != nil {
+				r.log.Errorf("set ldap org unit [%s] path failed: %s", ou.dn, err.Error())
+				return nil, authenticationV1.ErrorInternalServerError("insert org unit failed")
+			}
+			byDN[ou.dn], paths[created.ID] = created.ID, path
+			result.OrgUnitsCreated++
+			continue
+		}
+
+		path := entCrud.ComputeTreePath(paths[parentID], current.ID)
+		if derefStr(current.Name) != name || derefUint32(current.ParentID) != parentID || derefStr(current.Path) != path {
+			builder := r.entClient.Client().OrgUnit.UpdateOneID(current.ID).
+				SetName(name).
+				SetPath(path).
+				SetUpdatedAt(time.Now())
+			if parentPtr != nil {
+				builder.SetParentID(parentID)
+			} else {
+				builder.ClearParentID()
+			}
+			if err = builder.Exec(ctx); err != nil {
+				r.log.Errorf("update ldap org unit [%s] failed: %s", ou.dn, err.Error())
+				return nil, authenticationV1.ErrorInternalServerError("update org unit failed")
+			}
+			result.OrgUnitsUpdated++
+		}
+		byDN[ou.dn], paths[current.ID] = current.ID, path
+	}
+
+	return byDN, nil
+}
+
+// ldapMemberRoles 按组映射计算 规范化成员 DN → 角色ID列表。
+func ldapMemberRoles(settings *LdapSettings, groups []*ldap.Entry) map[string][]uint32 {
+	memberAttr := settings.Directory.GroupMemberAttribute
+	if memberAttr == "" {
+		memberAttr = ldap.DefaultGroupMemberAttribute
+	}
+
+	roles := make(map[string][]uint32)
+	for _, g := range groups {
+		roleID := settings.GroupRoleMapping[ldap.NormalizeDN(g.DN)]
+		if roleID == 0 {
+			continue
+		}
+		for _, member := range g.Values(memberAttr) {
+			if dn := ldap.NormalizeDN(member); dn != "" && !slices.Contains(roles[dn], roleID) {
+				roles[dn] = append(roles[dn], roleID)
+			}
+		}
+	}
+	return roles
+}
+
+// refreshUser 刷新已绑定用户的映射属性与受同步维护的角色、组织单元，返回是否有变更。
+func (r *LdapAccountRepo) refreshUser(
+	ctx context.Context,
+	settings *LdapSettings,
+	userID uint32,
+	entry *ldap.Entry,
+	managedRoleIDs, roleIDs []uint32,
+	managedOrgUnitIDs, orgUnitIDs []uint32,
+) (bool, error) {
+	users, err := r.userRepo.ListUsersByIds(ctx, []uint32{userID})
+	if err != nil {
+		return false, err
+	}
+	if len(users) == 0 {
+		return false, nil
+	}
+	current := users[0]
+
+	currentRoleIDs, _, currentOrgUnitIDs, err := r.userRepo.ListUserRelationIDs(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+
+	data := &identityV1.User{}
+	var paths []string
+
+	if v := settings.MappedAttribute(entry, LdapAttrNickname); v != "" && v != current.GetNickname() {
+		data.Nickname = trans.Ptr(v)
+		paths = append(paths, "nickname")
+	}
+	if v := settings.MappedAttribute(entry, LdapAttrRealname); v != "" && v != current.GetRealname() {
+		data.Realname = trans.Ptr(v)
+		paths = append(paths, "realname")
+	}
+	if v := settings.MappedAttribute(entry, LdapAttrEmail); v != "" && v != current.GetEmail() {
+		taken, err := r.contactTakenByOthers(ctx, settings.TenantID, userID, user.EmailEQ(v))
+		if err != nil {
+			return false, err
+		}
+		if !taken {
+			data.Email = trans.Ptr(v)
+			paths = append(paths, "email")
+		}
+	}
+	if v := settings.MappedAttribute(entry, LdapAttrMobile); v != "" && v != current.GetMobile() {
+		taken, err := r.contactTakenByOthers(ctx, settings.TenantID, userID, user.MobileEQ(v))
+		if err != nil {
+			return false, err
+		}
+		if !taken {
+			data.Mobile = trans.Ptr(v)
+			paths = append(paths, "mobile")
+		}
+	}
+
+	wantRoleIDs := mergeIDs(removeIDs(currentRoleIDs, managedRoleIDs), roleIDs)
+	rolesChanged := !sameIDs(currentRoleIDs, wantRoleIDs)
+	if rolesChanged && len(wantRoleIDs) > 0 {
+		data.RoleIds = wantRoleIDs
+		paths = append(paths, "role_ids")
+	}
+
+	wantOrgUnitIDs := currentOrgUnitIDs
+	if len(orgUnitIDs) > 0 {
+		wantOrgUnitIDs = mergeIDs(removeIDs(currentOrgUnitIDs, managedOrgUnitIDs), orgUnitIDs)
+	}
+	orgUnitsChanged := !sameIDs(currentOrgUnitIDs, wantOrgUnitIDs)
+	if orgUnitsChanged {
+		data.OrgUnitIds = wantOrgUnitIDs
+		paths = append(paths, "org_unit_ids")
+	}
+
+	if len(paths) > 0 {
+		if err = r.userRepo.Update(ctx, &identityV1.UpdateUserRequest{
+			Id:         userID,
+			Data:       data,
+			UpdateMask: &field_mask.FieldMask{Paths: paths},
+		}); err != nil {
+			return false, err
+		}
+	}
+	// 关联重建对空列表不做处理，最后一个受维护的角色需显式移除
+	if rolesChanged && len(wantRoleIDs) == 0 {
+		if err = r.userRoleRepo.RemoveRolesFromUser(ctx, userID, currentRoleIDs); err != nil {
+			return false, err
+		}
+	}
+
+	return len(paths) > 0 || rolesChanged, nil
+}
+
+// contactTakenByOthers 事务外检查邮箱/手机号是否已被租户内其他用户占用。
+func (r *LdapAccountRepo) contactTakenByOthers(ctx context.Context, tenantID, selfID uint32, cond predicate.User) (bool, error) {
+	taken, err := r.entClient.Client().User.Query().
+		Where(user.TenantIDEQ(tenantID), user.IDNEQ(selfID), cond).
+		Exist(ctx)
+	if err != nil {
+		r.log.Errorf("query user contact failed: %s", err.Error())
+		return false, authenticationV1.ErrorInternalServerError("query user failed")
+	}
+	return taken, nil
+}
+
+// SyncAll 同步指定租户（tenantID 为 0 时依次同步全部已启用目录），单个租户失败不影响其他租户；结果写回各配置的同步记录。
+func (r *LdapAccountRepo) SyncAll(ctx context.Context, tenantID uint32) error {
+	var items []*LdapSettings
+	if tenantID != 0 {
+		settings, err := r.configRepo.GetEnabled(ctx, tenantID)
+		if err != nil {
+			return err
+		}
+		if settings != nil {
+			items = append(items, settings)
+		}
+	} else {
+		var err error
+		if items, err = r.configRepo.ListEnabled(ctx); err != nil {
+			return err
+		}
+	}
+
+	var errs []error
+	for _, settings := range items {
+		if _, err := r.SyncAndRecord(ctx, settings); err != nil {
+			errs = append(errs, fmt.Errorf("tenant %d: %w", settings.TenantID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// SyncAndRecord 执行同步并把结果写回配置的同步记录。
+func (r *LdapAccountRepo) SyncAndRecord(ctx context.Context, settings *LdapSettings) (*authenticationV1.LdapSyncResult, error) {
+	result, err := r.Sync(ctx, settings)
+
+	var message string
+	if err != nil {
+		message = "failed: " + err.Error()
+	} else {
+		message = fmt.Sprintf("ok: created=%d updated=%d deactivated=%d reactivated=%d skipped=%d org_units_created=%d org_units_updated=%d",
+			result.GetUsersCreated(), result.GetUsersUpdated(), result.GetUsersDeactivated(), result.GetUsersReactivated(),
+			result.GetUsersSkipped(), result.GetOrgUnitsCreated(), result.GetOrgUnitsUpdated())
+	}
+	if recordErr := r.configRepo.RecordSync(ctx, settings.ID, message); recordErr != nil && err == nil {
+		return result, recordErr
+	}
+
+	return result, err
+}
+
+func mapValues[K comparable, V any](m map[K]V) []V {
+	out := make([]V, 0, len(m))
+	for _, v := range m {
+		out = append(out, v)
+	}
+	return out
+}
+
+// mergeIDs 合并去重，保持首次出现的顺序。
+func mergeIDs(lists ...[]uint32) []uint32 {
+	var out []uint32
+	for _, list := range lists {
+		for _, id := range list {
+			if id != 0 && !slices.Contains(out, id) {
+				out = append(out, id)
+			}
+		}
+	}
+	return out
+}
+
+// removeIDs 返回 ids 中不在 remove 内的元素。
+func removeIDs(ids, remove []uint32) []uint32 {
+	out := make([]uint32, 0, len(ids))
+	for _, id := range ids {
+		if !slices.Contains(remove, id) {
+			out = append(out, id)
+		}
+	}
+	return out
+}
+
+// sameIDs 两个ID列表作为集合是否相等。
+func sameIDs(a, b []uint32) bool {
+	a, b = mergeIDs(a), mergeIDs(b)
+	if len(a) != len(b) {
+		return false
+	}
+	for _, id := range a {
+		if !slices.Contains(b, id) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,363 @@
+package data
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tx7do/go-utils/trans"
+
+	conf "github.com/tx7do/kratos-bootstrap/api/gen/go/conf/v1"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+
+	"go-wind-admin/app/admin/service/internal/data/ent/orgunit"
+	"go-wind-admin/app/admin/service/internal/data/ent/user"
+	"go-wind-admin/app/admin/service/internal/data/ent/userorgunit"
+	"go-wind-admin/app/admin/service/internal/data/ent/userrole"
+	"go-wind-admin/app/admin/service/internal/data/enttest"
+
+	"go-wind-admin/pkg/ldap"
+	"go-wind-admin/pkg/ldap/ldaptest"
+)
+
+const (
+	ldapTestTenantID = 1
+	ldapTestBaseDN   = "dc=acme,dc=test"
+	ldapTestAliceDN  = "uid=alice,ou=Engineering,dc=acme,dc=test"
+	ldapTestBobDN    = "uid=bob,ou=Backend,ou=Engineering,dc=acme,dc=test"
+	ldapTestAdmins   = "cn=admins,dc=acme,dc=test"
+)
+
+type ldapTestEnv struct {
+	repo     *LdapAccountRepo
+	dir      *ldaptest.Directory
+	settings *LdapSettings
+	ctx      context.Context
+
+	adminRoleID  uint32
+	manualRoleID uint32
+	defaultRole  uint32
+}
+
+func newLdapTestDirectory() *ldaptest.Directory {
+	dir := ldaptest.New()
+	dir.Add("ou=Engineering,dc=acme,dc=test", map[string][]string{
+		"objectClass": {"organizationalUnit"},
+		"ou":          {"Engineering"},
+		"entryUUID":   {"ou-eng"},
+	})
+	dir.Add("ou=Backend,ou=Engineering,dc=acme,dc=test", map[string][]string{
+		"objectClass": {"organizationalUnit"},
+		"ou":          {"Backend"},
+		"entryUUID":   {"ou-backend"},
+	})
+	dir.Add(ldapTestAliceDN, map[string][]string{
+		"objectClass": {"inetOrgPerson"},
+		"uid":         {"alice"},
+		"mail":        {"alice@acme.test"},
+		"cn":          {"Alice W"},
+		"entryUUID":   {"u-alice"},
+	})
+	dir.SetPassword(ldapTestAliceDN, "alice-pw")
+	dir.Add(ldapTestBobDN, map[string][]string{
+		"objectClass": {"inetOrgPerson"},
+		"uid":         {"bob"},
+		"entryUUID":   {"u-bob"},
+	})
+	dir.Add(ldapTestAdmins, map[string][]string{
+		"objectClass": {"groupOfNames"},
+		"cn":          {"admins"},
+		"member":      {ldapTestAliceDN},
+		"entryUUID":   {"g-admins"},
+	})
+	return dir
+}
+
+func newLdapTestEnv(t *testing.T) *ldapTestEnv {
+	t.Helper()
+
+	entClient := enttest.NewEntClientForTest(t)
+	bctx := bootstrap.NewContextWithParam(context.Background(), &conf.AppInfo{}, &conf.Bootstrap{}, log.DefaultLogger)
+	ctx := enttest.NewSystemViewerCtx(context.Background())
+
+	userRoleRepo := NewUserRoleRepo(bctx, entClient)
+	userOrgUnitRepo := NewUserOrgUnitRepo(bctx, entClient)
+	userRepo := NewUserRepo(bctx, entClient, userRoleRepo, userOrgUnitRepo, NewUserPositionRepo(bctx, entClient),
+		NewMembershipRepo(bctx, entClient, NewMembershipRoleRepo(bctx, entClient), NewMembershipPositionRepo(bctx, entClient), NewMembershipOrgUnitRepo(bctx, entClient)))
+	configRepo := NewLdapConfigRepo(bctx, entClient)
+
+	env := &ldapTestEnv{
+		repo: NewLdapAccountRepo(bctx, entClient, userRepo, NewUserCredentialRepo(bctx, entClient, NewPasswordCrypto(), nil),
+			userRoleRepo, userOrgUnitRepo, configRepo, nil),
+		dir: newLdapTestDirectory(),
+		ctx: ctx,
+	}
+
+	for _, code := range []string{"ldap_admin", "manual", "ldap_default"} {
+		r, err := entClient.Client().Role.Create().
+			SetTenantID(ldapTestTenantID).
+			SetName(code).
+			SetCode(code).
+			Save(ctx)
+		require.NoError(t, err)
+		switch code {
+		case "ldap_admin":
+			env.adminRoleID = r.ID
+		case "manual":
+			env.manualRoleID = r.ID
+		default:
+			env.defaultRole = r.ID
+		}
+	}
+
+	env.settings = &LdapSettings{
+		ID:       1,
+		TenantID: ldapTestTenantID,
+		Directory: ldap.Config{
+			URL:            "ldap://dir.acme.test",
+			BaseDN:         ldapTestBaseDN,
+			UserFilter:     "(&(objectClass=inetOrgPerson)(uid={username}))",
+			UserAttributes: []string{"uid", "mail", "cn"},
+			IDAttribute:    "entryUUID",
+			GroupFilter:    "(objectClass=groupOfNames)",
+			OrgUnitFilter:  "(objectClass=organizationalUnit)",
+		},
+		AttributeMapping: map[string]string{
+			LdapAttrUsername: "uid",
+			LdapAttrEmail:    "mail",
+			LdapAttrNickname: "cn",
+		},
+		GroupRoleMapping: map[string]uint32{ldap.NormalizeDN(ldapTestAdmins): env.adminRoleID},
+		JitProvisioning:  true,
+		DefaultRoleIDs:   []uint32{env.defaultRole},
+		dial:             env.dir.Dial,
+	}
+
+	return env
+}
+
+func (env *ldapTestEnv) userByName(t *testing.T, username string) *userSnapshot {
+	t.Helper()
+
+	client := env.repo.entClient.Client()
+	u, err := client.User.Query().
+		Where(user.TenantIDEQ(ldapTestTenantID), user.UsernameEQ(username)).
+		Only(env.ctx)
+	require.NoError(t, err)
+
+	userRoles, err := client.UserRole.Query().
+		Where(userrole.UserIDEQ(u.ID)).
+		All(env.ctx)
+	require.NoError(t, err)
+	var roleIDs []uint32
+	for _, ur := range userRoles {
+		roleIDs = append(roleIDs, *ur.RoleID)
+	}
+
+	userOrgUnits, err := client.UserOrgUnit.Query().
+		Where(userorgunit.UserIDEQ(u.ID)).
+		All(env.ctx)
+	require.NoError(t, err)
+	var orgUnitIDs []uint32
+	for _, uo := range userOrgUnits {
+		orgUnitIDs = append(orgUnitIDs, *uo.OrgUnitID)
+	}
+
+	return &userSnapshot{
+		id:         u.ID,
+		status:     *u.Status,
+		email:      derefStr(u.Email),
+		nickname:   derefStr(u.Nickname),
+		roleIDs:    roleIDs,
+		orgUnitIDs: orgUnitIDs,
+	}
+}
+
+type userSnapshot struct {
+	id         uint32
+	status     user.Status
+	email      string
+	nickname   string
+	roleIDs    []uint32
+	orgUnitIDs []uint32
+}
+
+func (env *ldapTestEnv) orgUnitID(t *testing.T, entryID string) uint32 {
+	t.Helper()
+
+	ou, err := env.repo.entClient.Client().OrgUnit.Query().
+		Where(orgunit.ExternalIDEQ(ldapOrgUnitExternalPrefix + entryID)).
+		Only(env.ctx)
+	require.NoError(t, err)
+	return ou.ID
+}
+
+func TestLdapAccountRepo_Sync(t *testing.T) {
+	env := newLdapTestEnv(t)
+
+	result, err := env.repo.Sync(env.ctx, env.settings)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(2), result.GetUsersCreated())
+	assert.Equal(t, uint32(2), result.GetOrgUnitsCreated())
+
+	// 组织单元按 external_id 建立，层级随 DN
+	engID, backendID := env.orgUnitID(t, "ou-eng"), env.orgUnitID(t, "ou-backend")
+	backend, err := env.repo.entClient.Client().OrgUnit.Get(env.ctx, backendID)
+	require.NoError(t, err)
+	assert.Equal(t, engID, derefUint32(backend.ParentID))
+	assert.Equal(t, "Backend", derefStr(backend.Name))
+
+	alice := env.userByName(t, "alice")
+	assert.Equal(t, "alice@acme.test", alice.email)
+	assert.Equal(t, "Alice W", alice.nickname)
+	assert.ElementsMatch(t, []uint32{env.defaultRole, env.adminRoleID}, alice.roleIDs)
+	assert.Equal(t, []uint32{engID}, alice.orgUnitIDs)
+
+	bob := env.userByName(t, "bob")
+	assert.Equal(t, []uint32{env.defaultRole}, bob.roleIDs)
+	assert.Equal(t, []uint32{backendID}, bob.orgUnitIDs)
+
+	// 再次同步无变化
+	result, err = env.repo.Sync(env.ctx, env.settings)
+	require.NoError(t, err)
+	assert.Zero(t, result.GetUsersCreated())
+	assert.Zero(t, result.GetUsersUpdated())
+	assert.Zero(t, result.GetOrgUnitsCreated())
+
+	// 手工授予的角色保留，移出组后只撤销映射角色；改名的组织单元随之更新
+	_, err = env.repo.entClient.Client().UserRole.Create().
+		SetTenantID(ldapTestTenantID).
+		SetUserID(alice.id).
+		SetRoleID(env.manualRoleID).
+		Save(env.ctx)
+	require.NoError(t, err)
+	env.dir.SetAttribute(ldapTestAdmins, "member", ldapTestBobDN)
+	env.dir.SetAttribute(ldapTestAliceDN, "mail", "alice.w@acme.test")
+	env.dir.SetAttribute("ou=Backend,ou=Engineering,dc=acme,dc=test", "ou", "Platform")
+
+	result, err = env.repo.Sync(env.ctx, env.settings)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(2), result.GetUsersUpdated())
+	assert.Equal(t, uint32(1), result.GetOrgUnitsUpdated())
+
+	alice = env.userByName(t, "alice")
+	assert.Equal(t, "alice.w@acme.test", alice.email)
+	assert.ElementsMatch(t, []uint32{env.defaultRole, env.manualRoleID}, alice.roleIDs)
+	bob = env.userByName(t, "bob")
+	assert.ElementsMatch(t, []uint32{env.defaultRole, env.adminRoleID}, bob.roleIDs)
+}
+
+func TestLdapAccountRepo_SyncDeactivatesRemovedUsers(t *testing.T) {
+	env := newLdapTestEnv(t)
+
+	_, err := env.repo.Sync(env.ctx, env.settings)
+	require.NoError(t, err)
+
+	env.dir.Remove(ldapTestBobDN)
+	result, err := env.repo.Sync(env.ctx, env.settings)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(1), result.GetUsersDeactivated())
+	assert.Equal(t, user.StatusDisabled, env.userByName(t, "bob").status)
+	assert.Equal(t, user.StatusNormal, env.userByName(t, "alice").status)
+
+	// 已停用的账号不重复计数
+	result, err = env.repo.Sync(env.ctx, env.settings)
+	require.NoError(t, err)
+	assert.Zero(t, result.GetUsersDeactivated())
+
+	// 重新出现在目录中后恢复
+	env.dir.Add(ldapTestBobDN, map[string][]string{
+		"objectClass": {"inetOrgPerson"},
+		"uid":         {"bob"},
+		"entryUUID":   {"u-bob"},
+	})
+	result, err = env.repo.Sync(env.ctx, env.settings)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(1), result.GetUsersReactivated())
+	assert.Equal(t, user.StatusNormal, env.userByName(t, "bob").status)
+}
+
+func TestLdapAccountRepo_SyncAbortsOnEmptyDirectory(t *testing.T) {
+	env := newLdapTestEnv(t)
+
+	_, err := env.repo.Sync(env.ctx, env.settings)
+	require.NoError(t, err)
+
+	// 过滤器写错等情况下目录返回零个用户，不得停用全部账号
+	env.settings.Directory.UserFilter = "(&(objectClass=nobody)(uid={username}))"
+	_, err = env.repo.Sync(env.ctx, env.settings)
+	assert.True(t, authenticationV1.IsServiceUnavailable(err))
+	assert.Equal(t, user.StatusNormal, env.userByName(t, "alice").status)
+
+	// 目录不可用同样不改动本地数据
+	env.settings.Directory.UserFilter = "(&(objectClass=inetOrgPerson)(uid={username}))"
+	env.dir.SetUnavailable(true)
+	_, err = env.repo.Sync(env.ctx, env.settings)
+	assert.True(t, authenticationV1.IsServiceUnavailable(err))
+	assert.Equal(t, user.StatusNormal, env.userByName(t, "bob").status)
+}
+
+func TestLdapAccountRepo_Resolve(t *testing.T) {
+	env := newLdapTestEnv(t)
+	client := env.settings.Client()
+
+	entry, err := client.Authenticate(env.ctx, "alice", "alice-pw")
+	require.NoError(t, err)
+
+	// 未开启自动创建时不建用户
+	env.settings.JitProvisioning = false
+	uid, err := env.repo.Resolve(env.ctx, env.settings, entry, "alice")
+	require.NoError(t, err)
+	assert.Zero(t, uid)
+
+	// 自动创建只授予默认角色，组映射角色由同步补齐
+	env.settings.JitProvisioning = true
+	uid, err = env.repo.Resolve(env.ctx, env.settings, entry, "alice")
+	require.NoError(t, err)
+	require.NotZero(t, uid)
+	alice := env.userByName(t, "alice")
+	assert.Equal(t, uid, alice.id)
+	assert.Equal(t, []uint32{env.defaultRole}, alice.roleIDs)
+
+	// 已绑定直接返回同一用户
+	again, err := env.repo.Resolve(env.ctx, env.settings, entry, "alice")
+	require.NoError(t, err)
+	assert.Equal(t, uid, again)
+
+	// 同步停用的账号登录时恢复
+	linked, err := env.repo.listLinkedAccounts(env.ctx, ldapTestTenantID, entry.ID)
+	require.NoError(t, err)
+	require.NoError(t, env.repo.setAccountEnabled(env.ctx, linked[entry.ID], false))
+	assert.Equal(t, user.StatusDisabled, env.userByName(t, "alice").status)
+
+	again, err = env.repo.Resolve(env.ctx, env.settings, entry, "alice")
+	require.NoError(t, err)
+	assert.Equal(t, uid, again)
+	assert.Equal(t, user.StatusNormal, env.userByName(t, "alice").status)
+}
+
+func TestNewLdapDirectoryConfig(t *testing.T) {
+	cfg := &authenticationV1.LdapConfig{
+		ServerUrl:        trans.Ptr("ldaps://dc01.acme.test:636"),
+		BaseDn:           trans.Ptr(ldapTestBaseDN),
+		UserFilter:       trans.Ptr("(sAMAccountName={username})"),
+		AttributeMapping: map[string]string{LdapAttrUsername: "sAMAccountName"},
+	}
+	dc, err := NewLdapDirectoryConfig(cfg, "secret")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"sAMAccountName"}, dc.UserAttributes)
+	assert.Equal(t, "secret", dc.BindPassword)
+
+	cfg.CaCertificate = trans.Ptr("not a pem")
+	_, err = NewLdapDirectoryConfig(cfg, "")
+	assert.ErrorIs(t, err, ldap.ErrInvalidConfig)
+
+	cfg.CaCertificate = nil
+	cfg.UserFilter = trans.Ptr("(sAMAccountName=alice)")
+	_, err = NewLdapDirectoryConfig(cfg, "")
+	assert.ErrorIs(t, err, ldap.ErrInvalidConfig)
+}
@@ -0,0 +1,484 @@
+package data
+
+import (
+	"context"
+	"crypto/x509"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/go-utils/timeutil"
+	"github.com/tx7do/go-utils/trans"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	paginationV1 "github.com/tx7do/go-crud/api/gen/go/pagination/v1"
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"go-wind-admin/app/admin/service/internal/data/ent"
+	"go-wind-admin/app/admin/service/internal/data/ent/ldapconfig"
+
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+
+	"go-wind-admin/pkg/crypto"
+	"go-wind-admin/pkg/ldap"
+)
+
+// LDAP 用户属性映射的本地字段键。
+const (
+	LdapAttrUsername = "username"
+	LdapAttrEmail    = "email"
+	LdapAttrNickname = "nickname"
+	LdapAttrRealname = "realname"
+	LdapAttrMobile   = "mobile"
+)
+
+// LdapSettings 登录与同步流程使用的租户 LDAP 配置（服务账号密码已解密）。
+type LdapSettings struct {
+	ID          uint32
+	TenantID    uint32
+	DisplayName string
+
+	Directory ldap.Config
+
+	AttributeMapping map[string]string
+	// GroupRoleMapping 键为规范化后的组 DN
+	GroupRoleMapping map[string]uint32
+	OrgUnitRootID    uint32
+
+	LdapOnly         bool
+	JitProvisioning  bool
+	DefaultRoleIDs   []uint32
+	DefaultOrgUnitID uint32
+
+	dial ldap.DialFunc
+}
+
+// Client 按配置创建目录客户端。
+func (s *LdapSettings) Client() *ldap.Client {
+	return ldap.NewClient(s.Directory, s.dial)
+}
+
+// MappedAttribute 按属性映射取条目中的本地字段值，未映射或条目未携带返回空串。
+func (s *LdapSettings) MappedAttribute(e *ldap.Entry, key string) string {
+	name := s.AttributeMapping[key]
+	if name == "" {
+		return ""
+	}
+	return strings.TrimSpace(e.Attribute(name))
+}
+
+// MappedRoleIDs 返回映射中出现的全部角色ID：同步只维护这些角色。
+func (s *LdapSettings) MappedRoleIDs() []uint32 {
+	seen := make(map[uint32]bool, len(s.GroupRoleMapping))
+	ids := make([]uint32, 0, len(s.GroupRoleMapping))
+	for _, id := range s.GroupRoleMapping {
+		if id == 0 || seen[id] {
+			continue
+		}
+		seen[id] = true
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// NewLdapDirectoryConfig 由配置 DTO 构造目录连接配置；bindPassword 为明文。
+func NewLdapDirectoryConfig(cfg *authenticationV1.LdapConfig, bindPassword string) (ldap.Config, error) {
+	dc := ldap.Config{
+		URL:                  strings.TrimSpace(cfg.GetServerUrl()),
+		StartTLS:             cfg.GetStartTls(),
+		BindDN:               strings.TrimSpace(cfg.GetBindDn()),
+		BindPassword:         bindPassword,
+		BaseDN:               strings.TrimSpace(cfg.GetBaseDn()),
+		UserFilter:           strings.TrimSpace(cfg.GetUserFilter()),
+		IDAttribute:          strings.TrimSpace(cfg.GetIdAttribute()),
+		GroupFilter:          strings.TrimSpace(cfg.GetGroupFilter()),
+		GroupMemberAttribute: strings.TrimSpace(cfg.GetGroupMemberAttribute()),
+		OrgUnitFilter:        strings.TrimSpace(cfg.GetOrgUnitFilter()),
+	}
+	for _, attr := range cfg.GetAttributeMapping() {
+		if attr = strings.TrimSpace(attr); attr != "" {
+			dc.UserAttributes = append(dc.UserAttributes, attr)
+		}
+	}
+
+	if pem := strings.TrimSpace(cfg.GetCaCertificate()); pem != "" {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(pem)) {
+			return dc, ldap.ErrInvalidConfig
+		}
+		dc.RootCAs = pool
+	}
+
+	return dc, dc.Validate()
+}
+
+// LdapConfigRepo LDAP 目录配置仓储，每个租户至多一条配置。
+// bind_password 经 crypto.EncryptIfNeeded 加密落库，对外 DTO 永不回显，只以 bind_password_set 标识是否已配置。
+type LdapConfigRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+
+	// dial 目录建连函数，测试可替换为进程内目录桩
+	dial ldap.DialFunc
+}
+
+func NewLdapConfigRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *LdapConfigRepo {
+	return &LdapConfigRepo{
+		entClient: entClient,
+		log:       ctx.NewLoggerHelper("ldap-config/repo/admin-service"),
+		dial:      ldap.DefaultDial,
+	}
+}
+
+func (r *LdapConfigRepo) toDTO(e *ent.LdapConfig) *authenticationV1.LdapConfig {
+	dto := &authenticationV1.LdapConfig{
+		Id:                   trans.Ptr(e.ID),
+		DisplayName:          e.DisplayName,
+		ServerUrl:            e.ServerURL,
+		StartTls:             e.StartTLS,
+		CaCertificate:        e.CaCertificate,
+		BindDn:               e.BindDn,
+		BaseDn:               e.BaseDn,
+		UserFilter:           e.UserFilter,
+		IdAttribute:          e.IDAttribute,
+		AttributeMapping:     e.AttributeMapping,
+		GroupFilter:          e.GroupFilter,
+		GroupMemberAttribute: e.GroupMemberAttribute,
+		GroupRoleMapping:     e.GroupRoleMapping,
+		OrgUnitFilter:        e.OrgUnitFilter,
+		OrgUnitRootId:        e.OrgUnitRootID,
+		JitProvisioning:      e.JitProvisioning,
+		DefaultRoleIds:       e.DefaultRoleIds,
+		DefaultOrgUnitId:     e.DefaultOrgUnitID,
+		BindPasswordSet:      trans.Ptr(derefStr(e.BindPassword) != ""),
+		LastSyncedAt:         timeutil.TimeToTimestamppb(e.LastSyncedAt),
+		LastSyncMessage:      e.LastSyncMessage,
+		TenantId:             e.TenantID,
+		CreatedBy:            e.CreatedBy,
+		UpdatedBy:            e.UpdatedBy,
+		CreatedAt:            timeutil.TimeToTimestamppb(e.CreatedAt),
+		UpdatedAt:            timeutil.TimeToTimestamppb(e.UpdatedAt),
+	}
+	if e.Status != nil && *e.Status == ldapconfig.StatusOn {
+		dto.Status = authenticationV1.LdapConfig_ON.Enum()
+	} else {
+		dto.Status = authenticationV1.LdapConfig_OFF.Enum()
+	}
+	if e.AuthMode != nil && *e.AuthMode == ldapconfig.AuthModeLdapOnly {
+		dto.AuthMode = authenticationV1.LdapConfig_LDAP_ONLY.Enum()
+	} else {
+		dto.AuthMode = authenticationV1.LdapConfig_LDAP_FIRST.Enum()
+	}
+	return dto
+}
+
+func toLdapConfigStatus(status *authenticationV1.LdapConfig_Status) *ldapconfig.Status {
+	if status == nil {
+		return nil
+	}
+	if *status == authenticationV1.LdapConfig_ON {
+		return trans.Ptr(ldapconfig.StatusOn)
+	}
+	return trans.Ptr(ldapconfig.StatusOff)
+}
+
+func toLdapConfigAuthMode(mode *authenticationV1.LdapConfig_AuthMode) *ldapconfig.AuthMode {
+	if mode == nil {
+		return nil
+	}
+	if *mode == authenticationV1.LdapConfig_LDAP_ONLY {
+		return trans.Ptr(ldapconfig.AuthModeLdapOnly)
+	}
+	return trans.Ptr(ldapconfig.AuthModeLdapFirst)
+}
+
+// encryptBindPassword 加密服务账号密码；未配置 GOWIND_CRYPTO_KEY 时原样落库并告警。
+func (r *LdapConfigRepo) encryptBindPassword(password string) (string, error) {
+	encrypted, err := crypto.EncryptIfNeeded(password)
+	if err != nil {
+		r.log.Errorf("encrypt ldap bind password failed: %s", err.Error())
+		return "", authenticationV1.ErrorInternalServerError("encrypt bind password failed")
+	}
+	if !crypto.IsEncrypted(encrypted) {
+		r.log.Warn("GOWIND_CRYPTO_KEY 未配置，LDAP 服务账号密码将以明文落库")
+	}
+	return encrypted, nil
+}
+
+func (r *LdapConfigRepo) List(ctx context.Context, req *paginationV1.PagingRequest) (*authenticationV1.ListLdapConfigResponse, error) {
+	if req == nil {
+		return nil, authenticationV1.ErrorBadRequest("invalid parameter")
+	}
+
+	builder := r.entClient.Client().LdapConfig.Query()
+
+	total, err := builder.Clone().Count(ctx)
+	if err != nil {
+		r.log.Errorf("query count failed: %s", err.Error())
+		return nil, authenticationV1.ErrorInternalServerError("query count failed")
+	}
+
+	builder.Order(ent.Asc(ldapconfig.FieldTenantID))
+	if !req.GetNoPaging() && req.GetPageSize() > 0 {
+		page := max(req.GetPage(), 1)
+		builder.Offset(int((page - 1) * req.GetPageSize())).Limit(int(req.GetPageSize()))
+	}
+
+	entities, err := builder.All(ctx)
+	if err != nil {
+		r.log.Errorf("query list failed: %s", err.Error())
+		return nil, authenticationV1.ErrorInternalServerError("query list failed")
+	}
+
+	items := make([]*authenticationV1.LdapConfig, 0, len(entities))
+	for _, e := range entities {
+		items = append(items, r.toDTO(e))
+	}
+
+	return &authenticationV1.ListLdapConfigResponse{
+		Total: uint64(total),
+		Items: items,
+	}, nil
+}
+
+func (r *LdapConfigRepo) Get(ctx context.Context, id uint32) (*authenticationV1.LdapConfig, error) {
+	entity, err := r.entClient.Client().LdapConfig.Query().
+		Where(ldapconfig.IDEQ(id)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, authenticationV1.ErrorNotFound("ldap config not found")
+		}
+		r.log.Errorf("query ldap config failed: %s", err.Error())
+		return nil, authenticationV1.ErrorInternalServerError("query ldap config failed")
+	}
+
+	return r.toDTO(entity), nil
+}
+
+func (r *LdapConfigRepo) Create(ctx context.Context, data *authenticationV1.LdapConfig) error {
+	if data == nil {
+		return authenticationV1.ErrorBadRequest("invalid request")
+	}
+
+	builder := r.entClient.Client().LdapConfig.Create().
+		SetNillableTenantID(data.TenantId).
+		SetNillableDisplayName(data.DisplayName).
+		SetNillableServerURL(data.ServerUrl).
+		SetNillableStartTLS(data.StartTls).
+		SetNillableCaCertificate(data.CaCertificate).
+		SetNillableBindDn(data.BindDn).
+		SetNillableBaseDn(data.BaseDn).
+		SetNillableUserFilter(data.UserFilter).
+		SetNillableIDAttribute(data.IdAttribute).
+		SetAttributeMapping(data.GetAttributeMapping()).
+		SetNillableGroupFilter(data.GroupFilter).
+		SetNillableGroupMemberAttribute(data.GroupMemberAttribute).
+		SetGroupRoleMapping(data.GetGroupRoleMapping()).
+		SetNillableOrgUnitFilter(data.OrgUnitFilter).
+		SetNillableOrgUnitRootID(data.OrgUnitRootId).
+		SetNillableAuthMode(toLdapConfigAuthMode(data.AuthMode)).
+		SetNillableJitProvisioning(data.JitProvisioning).
+		SetDefaultRoleIds(data.GetDefaultRoleIds()).
+		SetNillableDefaultOrgUnitID(data.DefaultOrgUnitId).
+		SetNillableStatus(toLdapConfigStatus(data.Status)).
+		SetNillableCreatedBy(data.CreatedBy).
+		SetCreatedAt(time.Now())
+
+	if data.GetBindPassword() != "" {
+		password, err := r.encryptBindPassword(data.GetBindPassword())
+		if err != nil {
+			return err
+		}
+		builder.SetBindPassword(password)
+	}
+
+	if err := builder.Exec(ctx); err != nil {
+		if ent.IsConstraintError(err) {
+			return authenticationV1.ErrorBadRequest("ldap already configured for tenant")
+		}
+		r.log.Errorf("insert ldap config failed: %s", err.Error())
+		return authenticationV1.ErrorInternalServerError("insert ldap config failed")
+	}
+
+	return nil
+}
+
+// Update 更新 LDAP 配置：只更新请求中携带的字段，服务账号密码留空表示不修改。
+func (r *LdapConfigRepo) Update(ctx context.Context, id uint32, data *authenticationV1.LdapConfig) error {
+	if id == 0 || data == nil {
+		return authenticationV1.ErrorBadRequest("invalid request")
+	}
+
+	builder := r.entClient.Client().LdapConfig.Update().
+		Where(ldapconfig.IDEQ(id)).
+		SetNillableDisplayName(data.DisplayName).
+		SetNillableServerURL(data.ServerUrl).
+		SetNillableStartTLS(data.StartTls).
+		SetNillableCaCertificate(data.CaCertificate).
+		SetNillableBindDn(data.BindDn).
+		SetNillableBaseDn(data.BaseDn).
+		SetNillableUserFilter(data.UserFilter).
+		SetNillableIDAttribute(data.IdAttribute).
+		SetNillableGroupFilter(data.GroupFilter).
+		SetNillableGroupMemberAttribute(data.GroupMemberAttribute).
+		SetNillableOrgUnitFilter(data.OrgUnitFilter).
+		SetNillableOrgUnitRootID(data.OrgUnitRootId).
+		SetNillableAuthMode(toLdapConfigAuthMode(data.AuthMode)).
+		SetNillableJitProvisioning(data.JitProvisioning).
+		SetNillableDefaultOrgUnitID(data.DefaultOrgUnitId).
+		SetNillableStatus(toLdapConfigStatus(data.Status)).
+		SetNillableUpdatedBy(data.UpdatedBy).
+		SetUpdatedAt(time.Now())
+	// 租户范围过滤：隐私层只过滤查询，更新需显式限定，防止按 ID 越权修改其他租户配置
+	if tid, hasTenant := maybeTenantFromViewer(ctx); hasTenant {
+		builder.Where(ldapconfig.TenantIDEQ(tid))
+	}
+	if data.AttributeMapping != nil {
+		builder.SetAttributeMapping(data.GetAttributeMapping())
+	}
+	if data.GroupRoleMapping != nil {
+		builder.SetGroupRoleMapping(data.GetGroupRoleMapping())
+	}
+	if data.DefaultRoleIds != nil {
+		builder.SetDefaultRoleIds(data.GetDefaultRoleIds())
+	}
+	if data.GetBindPassword() != "" {
+		password, err := r.encryptBindPassword(data.GetBindPassword())
+		if err != nil {
+			return err
+		}
+		builder.SetBindPassword(password)
+	}
+
+	affected, err := builder.Save(ctx)
+	if err != nil {
+		r.log.Errorf("update ldap config failed: %s", err.Error())
+		return authenticationV1.ErrorInternalServerError("update ldap config failed")
+	}
+	if affected == 0 {
+		return authenticationV1.ErrorNotFound("ldap config not found")
+	}
+
+	return nil
+}
+
+func (r *LdapConfigRepo) Delete(ctx context.Context, id uint32) error {
+	if id == 0 {
+		return authenticationV1.ErrorBadRequest("invalid parameter")
+	}
+
+	builder := r.entClient.Client().LdapConfig.Delete().
+		Where(ldapconfig.IDEQ(id))
+	// 租户范围过滤（平台管理员 tenant_id=0 不限定）
+	if tid, hasTenant := maybeTenantFromViewer(ctx); hasTenant {
+		builder.Where(ldapconfig.TenantIDEQ(tid))
+	}
+	affected, err := builder.Exec(ctx)
+	if err != nil {
+		r.log.Errorf("delete ldap config failed: %s", err.Error())
+		return authenticationV1.ErrorInternalServerError("delete ldap config failed")
+	}
+	if affected == 0 {
+		return authenticationV1.ErrorNotFound("ldap config not found")
+	}
+
+	return nil
+}
+
+// GetEnabled 取租户已启用的 LDAP 配置；未配置或已禁用返回 nil。
+// 登录流程无租户 viewer，显式按 tenantID 过滤。
+func (r *LdapConfigRepo) GetEnabled(ctx context.Context, tenantID uint32) (*LdapSettings, error) {
+	entity, err := r.entClient.Client().LdapConfig.Query().
+		Where(
+			ldapconfig.TenantIDEQ(tenantID),
+			ldapconfig.StatusEQ(ldapconfig.StatusOn),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		r.log.Errorf("query ldap config failed: %s", err.Error())
+		return nil, authenticationV1.ErrorServiceUnavailable("db error")
+	}
+
+	return r.toSettings(entity)
+}
+
+// ListEnabled 列出全部已启用的 LDAP 配置，供定时同步遍历；配置无效的租户记录日志后跳过。
+func (r *LdapConfigRepo) ListEnabled(ctx context.Context) ([]*LdapSettings, error) {
+	entities, err := r.entClient.Client().LdapConfig.Query().
+		Where(ldapconfig.StatusEQ(ldapconfig.StatusOn)).
+		Order(ent.Asc(ldapconfig.FieldTenantID)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("query ldap configs failed: %s", err.Error())
+		return nil, authenticationV1.ErrorInternalServerError("query ldap configs failed")
+	}
+
+	items := make([]*LdapSettings, 0, len(entities))
+	for _, e := range entities {
+		settings, err := r.toSettings(e)
+		if err != nil {
+			continue
+		}
+		items = append(items, settings)
+	}
+	return items, nil
+}
+
+// RecordSync 记录最近一次同步的时间与结果。
+func (r *LdapConfigRepo) RecordSync(ctx context.Context, id uint32, message string) error {
+	if len(message) > 1024 {
+		message = message[:1024]
+	}
+
+	err := r.entClient.Client().LdapConfig.UpdateOneID(id).
+		SetLastSyncedAt(time.Now()).
+		SetLastSyncMessage(message).
+		Exec(ctx)
+	if err != nil {
+		r.log.Errorf("record ldap sync result failed: %s", err.Error())
+		return authenticationV1.ErrorInternalServerError("record ldap sync result failed")
+	}
+	return nil
+}
+
+func (r *LdapConfigRepo) toSettings(entity *ent.LdapConfig) (*LdapSettings, error) {
+	tenantID := derefUint32(entity.TenantID)
+
+	password, err := crypto.DecryptIfNeeded(derefStr(entity.BindPassword))
+	if err != nil {
+		r.log.Errorf("decrypt ldap bind password of tenant [%d] failed: %s", tenantID, err.Error())
+		return nil, authenticationV1.ErrorInternalServerError("ldap config invalid")
+	}
+
+	directory, err := NewLdapDirectoryConfig(r.toDTO(entity), password)
+	if err != nil {
+		r.log.Errorf("ldap config of tenant [%d] invalid: %s", tenantID, err.Error())
+		return nil, authenticationV1.ErrorInternalServerError("ldap config invalid")
+	}
+
+	groupRoles := make(map[string]uint32, len(entity.GroupRoleMapping))
+	for dn, roleID := range entity.GroupRoleMapping {
+		if key := ldap.NormalizeDN(dn); key != "" {
+			groupRoles[key] = roleID
+		}
+	}
+
+	return &LdapSettings{
+		ID:               entity.ID,
+		TenantID:         tenantID,
+		DisplayName:      derefStr(entity.DisplayName),
+		Directory:        directory,
+		AttributeMapping: entity.AttributeMapping,
+		GroupRoleMapping: groupRoles,
+		OrgUnitRootID:    derefUint32(entity.OrgUnitRootID),
+		LdapOnly:         entity.AuthMode != nil && *entity.AuthMode == ldapconfig.AuthModeLdapOnly,
+		JitProvisioning:  entity.JitProvisioning != nil && *entity.JitProvisioning,
+		DefaultRoleIDs:   entity.DefaultRoleIds,
+		DefaultOrgUnitID: derefUint32(entity.DefaultOrgUnitID),
+		dial:             r.dial,
+	}, nil
+}
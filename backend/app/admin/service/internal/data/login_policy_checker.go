@@ -3,35 +3,72 @@
 import (
 	"fmt"
 	"net"
+	"slices"
 	"strings"
 	"time"
+
+	auditV1 "go-wind-admin/api/gen/go/audit/service/v1"
 )
 
 // 登录策略匹配器：纯函数，供登录闸门调用，语义按维度独立判定——
 //   - 黑名单：任一条目命中 → 拒绝
-//   - 白名单：该维度存在白名单约束（全局或定向当前用户）且当前值未命中任何白名单 → 拒绝
+//   - 白名单：该维度存在约束当前登录对象的白名单且当前值未命中任何白名单 → 拒绝
 //
-// 生效范围：条目 TargetID 为 0 表示全局（约束所有用户），否则仅约束该用户；
-// userId 传 0 表示只检查全局条目（密码校验前的第一段），取到 userId 后再查第二段。
+// 生效对象：全局策略约束所有用户；定向策略按用户 ID、角色码或组织单元（含下级）约束。
+// 身份未知（UserID 为 0，密码校验前的第一段）时只匹配全局策略，取到用户身份与角色后再查第二段。
 //
-// 维度支持：IP（精确 IP 或 CIDR）、TIME（HH:MM-HH:MM 时间窗，支持跨午夜）、
-// DEVICE（device_id 精确匹配）。MAC 与 REGION 第一版不判定：HTTP 请求上下文
-// 拿不到 MAC 地址，REGION 依赖 IP 地理库（后续可复用登录审计的 GeoLocation 能力接入）。
-func MatchLoginPolicy(
-	policies []EffectivePolicy,
-	userId uint32,
-	clientIP string,
-	deviceId string,
-	now time.Time,
-) (blocked bool, reason string) {
-	for _, method := range []string{"IP", "TIME", "DEVICE"} {
-		// 该维度对当前用户生效的条目（全局 + 定向）
+// 维度支持：IP（精确 IP 或 CIDR）、REGION（IP 地理位置，"国家[/省份[/城市]]"）、
+// TIME（HH:MM-HH:MM 时间窗，支持跨午夜，按策略的时区判定）、DEVICE（device_id 精确匹配）。
+// MAC 不判定：HTTP 请求上下文拿不到 MAC 地址。
+
+// LoginPolicySubject 登录策略的匹配对象：本次登录的用户身份与请求环境。
+type LoginPolicySubject struct {
+	UserID     uint32   // 0 表示身份未知，只匹配全局策略
+	RoleCodes  []string // 用户的角色码
+	OrgUnitIDs []uint32 // 用户所属的组织单元及其全部上级
+
+	ClientIP string
+	DeviceID string
+
+	// Region IP 的地理位置（与登录审计日志相同的 geoip 查询），内网或无法解析时为 nil，此时不判定地区策略
+	Region *auditV1.GeoLocation
+
+	Now time.Time
+}
+
+// LoginPolicyViolation 一条拦截结果
+type LoginPolicyViolation struct {
+	Method string
+	Reason string
+	// PolicyIDs 导致拦截的策略：黑名单为命中的策略，白名单为该维度全部未命中的策略
+	PolicyIDs []uint32
+}
+
+// loginPolicyMethods 参与判定的维度，按判定顺序排列
+var loginPolicyMethods = []string{"IP", "REGION", "TIME", "DEVICE"}
+
+// MatchLoginPolicy 判定登录是否被拦截，返回首个拦截原因。
+func MatchLoginPolicy(policies []EffectivePolicy, subject LoginPolicySubject) (blocked bool, reason string) {
+	violations := EvaluateLoginPolicies(policies, subject)
+	if len(violations) == 0 {
+		return false, ""
+	}
+	return true, violations[0].Reason
+}
+
+// EvaluateLoginPolicies 判定全部维度，返回全部拦截结果（供模拟判定展示），未被拦截时返回空。
+func EvaluateLoginPolicies(policies []EffectivePolicy, subject LoginPolicySubject) []LoginPolicyViolation {
+	var violations []LoginPolicyViolation
+	for _, method := range loginPolicyMethods {
+		if method == "REGION" && subject.Region == nil {
+			// 地区未知（内网 IP 或 geoip 库未收录）：不判定，避免白名单把内网登录全部拦下
+			continue
+		}
+
+		// 该维度对当前登录对象生效的条目
 		var blacks, whites []EffectivePolicy
 		for _, p := range policies {
-			if p.Method != method {
-				continue
-			}
-			if p.TargetID != 0 && p.TargetID != userId {
+			if p.Method != method || !p.appliesTo(subject) {
 				continue
 			}
 			if p.Type == "WHITE_LIST" {
@@ -41,35 +78,117 @@ func MatchLoginPolicy(
 			}
 		}
 
-		var matched func(v string) bool
+		var matched func(p EffectivePolicy) bool
 		switch method {
 		case "IP":
-			matched = func(v string) bool { return matchIPValue(clientIP, v) }
+			matched = func(p EffectivePolicy) bool { return matchIPValue(subject.ClientIP, p.Value) }
+		case "REGION":
+			matched = func(p EffectivePolicy) bool { return matchRegion(subject.Region, p.Value) }
 		case "TIME":
-			matched = func(v string) bool { return matchTimeWindow(now, v) }
+			matched = func(p EffectivePolicy) bool { return matchTimeWindow(p.localTime(subject.Now), p.Value) }
 		case "DEVICE":
-			matched = func(v string) bool { return deviceId != "" && deviceId == v }
+			matched = func(p EffectivePolicy) bool { return subject.DeviceID != "" && subject.DeviceID == p.Value }
 		}
 
 		for _, p := range blacks {
-			if matched(p.Value) {
-				return true, p.describe(method)
+			if matched(p) {
+				violations = append(violations, LoginPolicyViolation{
+					Method:    method,
+					Reason:    p.describe(method),
+					PolicyIDs: []uint32{p.ID},
+				})
 			}
 		}
-		if len(whites) > 0 {
-			hit := false
-			for _, p := range whites {
-				if matched(p.Value) {
-					hit = true
-					break
-				}
+		if len(whites) > 0 && !slices.ContainsFunc(whites, matched) {
+			v := LoginPolicyViolation{
+				Method: method,
+				Reason: "not in " + strings.ToLower(method) + " whitelist",
 			}
-			if !hit {
-				return true, "not in " + strings.ToLower(method) + " whitelist"
+			for _, p := range whites {
+				v.PolicyIDs = append(v.PolicyIDs, p.ID)
 			}
+			violations = append(violations, v)
 		}
 	}
-	return false, ""
+	return violations
+}
+
+// HasLoginPolicyMethod 策略中是否存在指定维度的条目，用于按需查询地理位置、解析时区。
+func HasLoginPolicyMethod(policies []EffectivePolicy, method string) bool {
+	return slices.ContainsFunc(policies, func(p EffectivePolicy) bool { return p.Method == method })
+}
+
+// HasLoginPolicyTargetType 策略中是否存在指定生效对象类型的条目，用于按需展开组织单元上级。
+func HasLoginPolicyTargetType(policies []EffectivePolicy, targetType string) bool {
+	return slices.ContainsFunc(policies, func(p EffectivePolicy) bool { return p.TargetType == targetType })
+}
+
+// appliesTo 判定策略是否约束该登录对象。未指定生效对象类型的历史数据：TargetID 为 0 表示全局，否则为用户。
+func (p EffectivePolicy) appliesTo(subject LoginPolicySubject) bool {
+	switch p.TargetType {
+	case "GLOBAL":
+		return true
+	case "USER":
+		return p.TargetID != 0 && p.TargetID == subject.UserID
+	case "ROLE":
+		return p.TargetCode != "" && slices.Contains(subject.RoleCodes, p.TargetCode)
+	case "ORG_UNIT":
+		return p.TargetID != 0 && slices.Contains(subject.OrgUnitIDs, p.TargetID)
+	default:
+		return p.TargetID == 0 || p.TargetID == subject.UserID
+	}
+}
+
+// localTime 把判定时间换算到策略的时区，未配置时区时保持原值（服务器本地时间）。
+func (p EffectivePolicy) localTime(now time.Time) time.Time {
+	if p.Location == nil {
+		return now
+	}
+	return now.In(p.Location)
+}
+
+// matchRegion 判定地理位置是否命中 "国家[/省份[/城市]]" 形式的地区值：逐级比较（忽略大小写与首尾空白），
+// 只写国家即匹配该国全部地区。地区名称与登录审计日志中的地理位置一致。
+func matchRegion(geo *auditV1.GeoLocation, value string) bool {
+	segments, ok := parseRegion(value)
+	if !ok || geo == nil {
+		return false
+	}
+	actual := []string{geo.GetCountryCode(), geo.GetProvince(), geo.GetCity()}
+	for i, seg := range segments {
+		if !strings.EqualFold(seg, strings.TrimSpace(actual[i])) {
+			return false
+		}
+	}
+	return true
+}
+
+func parseRegion(value string) ([]string, bool) {
+	segments := strings.Split(strings.TrimSpace(value), "/")
+	if len(segments) > 3 {
+		return nil, false
+	}
+	for i := range segments {
+		segments[i] = strings.TrimSpace(segments[i])
+		if segments[i] == "" {
+			return nil, false
+		}
+	}
+	return segments, true
+}
+
+// FormatRegion 把地理位置格式化为 "国家/省份/城市"（省略空的层级），内网或无法解析时返回空串。
+func FormatRegion(geo *auditV1.GeoLocation) string {
+	if geo == nil {
+		return ""
+	}
+	var segments []string
+	for _, seg := range []string{geo.GetCountryCode(), geo.GetProvince(), geo.GetCity()} {
+		if seg = strings.TrimSpace(seg); seg != "" {
+			segments = append(segments, seg)
+		}
+	}
+	return strings.Join(segments, "/")
 }
 
 // matchIPValue 判定 clientIP 是否命中策略值：策略值支持精确 IP 或 CIDR 网段。
@@ -181,10 +300,29 @@ func ValidateLoginPolicyValue(method, value string) error {
 		}
 		return nil
 	case "REGION":
-		if len(value) > 32 {
-			return fmt.Errorf("region code too long")
+		if len(value) > 255 {
+			return fmt.Errorf("value too long")
+		}
+		if _, ok := parseRegion(value); !ok {
+			return fmt.Errorf("region must be country[/province[/city]]")
 		}
 		return nil
 	}
 	return fmt.Errorf("unknown policy method: %s", method)
 }
+
+// ValidateLoginPolicyTarget 校验生效对象（管理端 Create/Update 调用）：定向策略必须给出目标，
+// 否则会退化为不约束任何人。未指定生效对象类型时沿用目标 ID 判定全局或用户，不做校验。
+func ValidateLoginPolicyTarget(targetType string, targetID uint32, targetCode string) error {
+	switch targetType {
+	case "USER", "ORG_UNIT":
+		if targetID == 0 {
+			return fmt.Errorf("target id is required for %s policy", strings.ToLower(targetType))
+		}
+	case "ROLE":
+		if strings.TrimSpace(targetCode) == "" {
+			return fmt.Errorf("target code is required for role policy")
+		}
+	}
+	return nil
+}
@@ -1,8 +1,13 @@
 package data
 
 import (
+	"slices"
 	"testing"
 	"time"
+
+	"github.com/tx7do/go-utils/trans"
+
+	auditV1 "go-wind-admin/api/gen/go/audit/service/v1"
 )
 
 func TestMatchLoginPolicyIpBlacklist(t *testing.T) {
@@ -10,18 +15,18 @@ func TestMatchLoginPolicyIpBlacklist(t *testing.T) {
 		{TargetID: 0, Value: "10.0.0.5", Type: "BLACK_LIST", Method: "IP"},
 		{TargetID: 0, Value: "172.16.0.0/16", Type: "BLACK_LIST", Method: "IP"},
 	}
-	if blocked, _ := MatchLoginPolicy(policies, 0, "10.0.0.5", "", time.Now()); !blocked {
+	if blocked, _ := MatchLoginPolicy(policies, LoginPolicySubject{ClientIP: "10.0.0.5", Now: time.Now()}); !blocked {
 		t.Fatalf("exact IP blacklist should block")
 	}
-	if blocked, _ := MatchLoginPolicy(policies, 0, "172.16.99.1", "", time.Now()); !blocked {
+	if blocked, _ := MatchLoginPolicy(policies, LoginPolicySubject{ClientIP: "172.16.99.1", Now: time.Now()}); !blocked {
 		t.Fatalf("CIDR blacklist should block")
 	}
-	if blocked, _ := MatchLoginPolicy(policies, 0, "192.168.1.1", "", time.Now()); blocked {
+	if blocked, _ := MatchLoginPolicy(policies, LoginPolicySubject{ClientIP: "192.168.1.1", Now: time.Now()}); blocked {
 		t.Fatalf("unlisted IP should pass")
 	}
 	// 非法策略值不命中（配置错误不阻断全部登录）
 	bad := []EffectivePolicy{{TargetID: 0, Value: "not-an-ip", Type: "BLACK_LIST", Method: "IP"}}
-	if blocked, _ := MatchLoginPolicy(bad, 0, "1.2.3.4", "", time.Now()); blocked {
+	if blocked, _ := MatchLoginPolicy(bad, LoginPolicySubject{ClientIP: "1.2.3.4", Now: time.Now()}); blocked {
 		t.Fatalf("malformed policy value should not block")
 	}
 }
@@ -30,10 +35,10 @@ func TestMatchLoginPolicyIpWhitelist(t *testing.T) {
 	policies := []EffectivePolicy{
 		{TargetID: 0, Value: "10.0.0.0/8", Type: "WHITE_LIST", Method: "IP"},
 	}
-	if blocked, _ := MatchLoginPolicy(policies, 0, "10.1.2.3", "", time.Now()); blocked {
+	if blocked, _ := MatchLoginPolicy(policies, LoginPolicySubject{ClientIP: "10.1.2.3", Now: time.Now()}); blocked {
 		t.Fatalf("IP in whitelist should pass")
 	}
-	if blocked, _ := MatchLoginPolicy(policies, 0, "192.168.1.1", "", time.Now()); !blocked {
+	if blocked, _ := MatchLoginPolicy(policies, LoginPolicySubject{ClientIP: "192.168.1.1", Now: time.Now()}); !blocked {
 		t.Fatalf("IP outside whitelist should block")
 	}
 }
@@ -43,15 +48,15 @@ func TestMatchLoginPolicyTargetScope(t *testing.T) {
 		{TargetID: 42, Value: "10.0.0.5", Type: "BLACK_LIST", Method: "IP"},
 	}
 	// userId=0（密码校验前的全局段）：定向条目不生效
-	if blocked, _ := MatchLoginPolicy(policies, 0, "10.0.0.5", "", time.Now()); blocked {
+	if blocked, _ := MatchLoginPolicy(policies, LoginPolicySubject{ClientIP: "10.0.0.5", Now: time.Now()}); blocked {
 		t.Fatalf("user-targeted policy should not apply to global check")
 	}
 	// 命中目标用户：生效
-	if blocked, _ := MatchLoginPolicy(policies, 42, "10.0.0.5", "", time.Now()); !blocked {
+	if blocked, _ := MatchLoginPolicy(policies, LoginPolicySubject{UserID: 42, ClientIP: "10.0.0.5", Now: time.Now()}); !blocked {
 		t.Fatalf("user-targeted policy should block target user")
 	}
 	// 非目标用户：不生效
-	if blocked, _ := MatchLoginPolicy(policies, 43, "10.0.0.5", "", time.Now()); blocked {
+	if blocked, _ := MatchLoginPolicy(policies, LoginPolicySubject{UserID: 43, ClientIP: "10.0.0.5", Now: time.Now()}); blocked {
 		t.Fatalf("user-targeted policy should not apply to other users")
 	}
 }
@@ -61,23 +66,23 @@ func TestMatchLoginPolicyTimeWindow(t *testing.T) {
 		{TargetID: 0, Value: "22:00-06:00", Type: "BLACK_LIST", Method: "TIME"},
 	}
 	at := func(h, m int) time.Time { return time.Date(2026, 1, 1, h, m, 0, 0, time.Local) }
-	if blocked, _ := MatchLoginPolicy(policies, 0, "", "", at(23, 30)); !blocked {
+	if blocked, _ := MatchLoginPolicy(policies, LoginPolicySubject{Now: at(23, 30)}); !blocked {
 		t.Fatalf("23:30 should be inside overnight blacklist window")
 	}
-	if blocked, _ := MatchLoginPolicy(policies, 0, "", "", at(3, 0)); !blocked {
+	if blocked, _ := MatchLoginPolicy(policies, LoginPolicySubject{Now: at(3, 0)}); !blocked {
 		t.Fatalf("03:00 should be inside overnight blacklist window")
 	}
-	if blocked, _ := MatchLoginPolicy(policies, 0, "", "", at(12, 0)); blocked {
+	if blocked, _ := MatchLoginPolicy(policies, LoginPolicySubject{Now: at(12, 0)}); blocked {
 		t.Fatalf("noon should be outside overnight blacklist window")
 	}
 	// 跨午夜白名单：仅工作时间允许
 	white := []EffectivePolicy{
 		{TargetID: 0, Value: "09:00-18:00", Type: "WHITE_LIST", Method: "TIME"},
 	}
-	if blocked, _ := MatchLoginPolicy(white, 0, "", "", at(10, 0)); blocked {
+	if blocked, _ := MatchLoginPolicy(white, LoginPolicySubject{Now: at(10, 0)}); blocked {
 		t.Fatalf("10:00 should be inside work-hours whitelist")
 	}
-	if blocked, _ := MatchLoginPolicy(white, 0, "", "", at(20, 0)); !blocked {
+	if blocked, _ := MatchLoginPolicy(white, LoginPolicySubject{Now: at(20, 0)}); !blocked {
 		t.Fatalf("20:00 should be outside work-hours whitelist")
 	}
 }
@@ -86,18 +91,147 @@ func TestMatchLoginPolicyDevice(t *testing.T) {
 	policies := []EffectivePolicy{
 		{TargetID: 0, Value: "device-abc", Type: "BLACK_LIST", Method: "DEVICE"},
 	}
-	if blocked, _ := MatchLoginPolicy(policies, 0, "", "device-abc", time.Now()); !blocked {
+	if blocked, _ := MatchLoginPolicy(policies, LoginPolicySubject{DeviceID: "device-abc", Now: time.Now()}); !blocked {
 		t.Fatalf("blacklisted device should block")
 	}
-	if blocked, _ := MatchLoginPolicy(policies, 0, "", "device-xyz", time.Now()); blocked {
+	if blocked, _ := MatchLoginPolicy(policies, LoginPolicySubject{DeviceID: "device-xyz", Now: time.Now()}); blocked {
 		t.Fatalf("other device should pass")
 	}
 	// 空 deviceId 不匹配任何值
-	if blocked, _ := MatchLoginPolicy(policies, 0, "", "", time.Now()); blocked {
+	if blocked, _ := MatchLoginPolicy(policies, LoginPolicySubject{Now: time.Now()}); blocked {
 		t.Fatalf("empty device id should not match")
 	}
 }
 
+func TestMatchLoginPolicyRegion(t *testing.T) {
+	shanghai := &auditV1.GeoLocation{CountryCode: trans.Ptr("中国"), Province: trans.Ptr("上海"), City: trans.Ptr("上海")}
+	guangdong := &auditV1.GeoLocation{CountryCode: trans.Ptr("中国"), Province: trans.Ptr("广东"), City: trans.Ptr("深圳")}
+	usa := &auditV1.GeoLocation{CountryCode: trans.Ptr("United States"), Province: trans.Ptr("California")}
+
+	black := []EffectivePolicy{
+		{ID: 1, Value: "united states", Type: "BLACK_LIST", Method: "REGION"},
+	}
+	if blocked, _ := MatchLoginPolicy(black, LoginPolicySubject{Region: usa, Now: time.Now()}); !blocked {
+		t.Fatalf("country-level blacklist should match case-insensitively")
+	}
+	if blocked, _ := MatchLoginPolicy(black, LoginPolicySubject{Region: shanghai, Now: time.Now()}); blocked {
+		t.Fatalf("other country should pass")
+	}
+
+	white := []EffectivePolicy{
+		{ID: 2, Value: "中国/上海", Type: "WHITE_LIST", Method: "REGION"},
+	}
+	if blocked, _ := MatchLoginPolicy(white, LoginPolicySubject{Region: shanghai, Now: time.Now()}); blocked {
+		t.Fatalf("province in whitelist should pass")
+	}
+	if blocked, _ := MatchLoginPolicy(white, LoginPolicySubject{Region: guangdong, Now: time.Now()}); !blocked {
+		t.Fatalf("province outside whitelist should block")
+	}
+	// 地区未知（内网 IP）不判定
+	if blocked, _ := MatchLoginPolicy(white, LoginPolicySubject{ClientIP: "10.0.0.1", Now: time.Now()}); blocked {
+		t.Fatalf("unknown region should not be judged")
+	}
+}
+
+func TestMatchLoginPolicyRoleAndOrgUnitTarget(t *testing.T) {
+	policies := []EffectivePolicy{
+		{ID: 1, TargetType: "ROLE", TargetCode: "auditor", Value: "10.0.0.0/8", Type: "WHITE_LIST", Method: "IP"},
+		{ID: 2, TargetType: "ORG_UNIT", TargetID: 5, Value: "device-abc", Type: "BLACK_LIST", Method: "DEVICE"},
+	}
+
+	if blocked, _ := MatchLoginPolicy(policies, LoginPolicySubject{UserID: 1, RoleCodes: []string{"auditor"}, ClientIP: "1.2.3.4", Now: time.Now()}); !blocked {
+		t.Fatalf("role whitelist should block ip outside range")
+	}
+	if blocked, _ := MatchLoginPolicy(policies, LoginPolicySubject{UserID: 1, RoleCodes: []string{"editor"}, ClientIP: "1.2.3.4", Now: time.Now()}); blocked {
+		t.Fatalf("role whitelist should not constrain other roles")
+	}
+
+	// OrgUnitIDs 含上级：下级组织单元的成员同样受约束
+	if blocked, _ := MatchLoginPolicy(policies, LoginPolicySubject{UserID: 2, OrgUnitIDs: []uint32{7, 5}, DeviceID: "device-abc", Now: time.Now()}); !blocked {
+		t.Fatalf("org unit blacklist should constrain members of child units")
+	}
+	if blocked, _ := MatchLoginPolicy(policies, LoginPolicySubject{UserID: 2, OrgUnitIDs: []uint32{8}, DeviceID: "device-abc", Now: time.Now()}); blocked {
+		t.Fatalf("org unit blacklist should not constrain other units")
+	}
+	// 身份未知时定向策略不生效
+	if blocked, _ := MatchLoginPolicy(policies, LoginPolicySubject{ClientIP: "1.2.3.4", DeviceID: "device-abc", Now: time.Now()}); blocked {
+		t.Fatalf("targeted policies should not apply before identity is known")
+	}
+}
+
+func TestMatchLoginPolicyTimeZone(t *testing.T) {
+	// 服务器时间 UTC 02:00 = 东八区 10:00
+	now := time.Date(2026, 1, 1, 2, 0, 0, 0, time.UTC)
+	policies := []EffectivePolicy{
+		{Value: "09:00-18:00", Type: "WHITE_LIST", Method: "TIME", Location: time.FixedZone("CST", 8*3600)},
+	}
+	if blocked, _ := MatchLoginPolicy(policies, LoginPolicySubject{Now: now}); blocked {
+		t.Fatalf("10:00 in policy time zone should be inside work hours")
+	}
+	policies[0].Location = nil
+	if blocked, _ := MatchLoginPolicy(policies, LoginPolicySubject{Now: now}); !blocked {
+		t.Fatalf("02:00 without time zone should be outside work hours")
+	}
+}
+
+func TestEvaluateLoginPolicies(t *testing.T) {
+	policies := []EffectivePolicy{
+		{ID: 1, Value: "1.2.3.4", Type: "BLACK_LIST", Method: "IP"},
+		{ID: 2, Value: "device-a", Type: "WHITE_LIST", Method: "DEVICE"},
+		{ID: 3, Value: "device-b", Type: "WHITE_LIST", Method: "DEVICE"},
+		{ID: 4, Value: "5.6.7.8", Type: "BLACK_LIST", Method: "IP"},
+	}
+	violations := EvaluateLoginPolicies(policies, LoginPolicySubject{ClientIP: "1.2.3.4", DeviceID: "device-c", Now: time.Now()})
+	if len(violations) != 2 {
+		t.Fatalf("expected 2 violations, got %d", len(violations))
+	}
+	if violations[0].Method != "IP" || !slices.Equal(violations[0].PolicyIDs, []uint32{1}) {
+		t.Fatalf("unexpected ip violation: %+v", violations[0])
+	}
+	if violations[1].Method != "DEVICE" || !slices.Equal(violations[1].PolicyIDs, []uint32{2, 3}) {
+		t.Fatalf("unexpected device violation: %+v", violations[1])
+	}
+
+	if violations = EvaluateLoginPolicies(policies, LoginPolicySubject{ClientIP: "9.9.9.9", DeviceID: "device-b", Now: time.Now()}); len(violations) != 0 {
+		t.Fatalf("expected no violations, got %+v", violations)
+	}
+}
+
+func TestValidateLoginPolicyRegionAndTarget(t *testing.T) {
+	for _, v := range []string{"中国", "中国/上海", "United States/California/San Jose"} {
+		if err := ValidateLoginPolicyValue("REGION", v); err != nil {
+			t.Fatalf("region %q should be valid: %v", v, err)
+		}
+	}
+	for _, v := range []string{"a/b/c/d", "中国//上海", "/"} {
+		if err := ValidateLoginPolicyValue("REGION", v); err == nil {
+			t.Fatalf("region %q should be invalid", v)
+		}
+	}
+
+	if err := ValidateLoginPolicyTarget("ROLE", 0, " "); err == nil {
+		t.Fatalf("role policy without code should be invalid")
+	}
+	if err := ValidateLoginPolicyTarget("ORG_UNIT", 0, ""); err == nil {
+		t.Fatalf("org unit policy without id should be invalid")
+	}
+	if err := ValidateLoginPolicyTarget("USER", 3, ""); err != nil {
+		t.Fatalf("user policy with id should be valid: %v", err)
+	}
+	if err := ValidateLoginPolicyTarget("GLOBAL", 0, ""); err != nil {
+		t.Fatalf("global policy should be valid: %v", err)
+	}
+}
+
+func TestFormatRegion(t *testing.T) {
+	if got := FormatRegion(&auditV1.GeoLocation{CountryCode: trans.Ptr("中国"), City: trans.Ptr("上海")}); got != "中国/上海" {
+		t.Fatalf("FormatRegion = %q", got)
+	}
+	if got := FormatRegion(nil); got != "" {
+		t.Fatalf("FormatRegion(nil) = %q", got)
+	}
+}
+
 func TestParseHHMM(t *testing.T) {
 	cases := []struct {
 		in   string
@@ -15,6 +15,7 @@
 	"go-wind-admin/app/admin/service/internal/data/ent"
 	"go-wind-admin/app/admin/service/internal/data/ent/loginpolicy"
 	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+	"go-wind-admin/app/admin/service/internal/data/ent/tenant"
 
 	"github.com/tx7do/go-utils/copierutil"
 	"github.com/tx7do/go-utils/mapper"
@@ -27,9 +28,10 @@ type LoginPolicyRepo struct {
 	entClient *entCrud.EntClient[*ent.Client]
 	log       *log.Helper
 
-	mapper          *mapper.CopierMapper[authenticationV1.LoginPolicy, ent.LoginPolicy]
-	typeConverter   *mapper.EnumTypeConverter[authenticationV1.LoginPolicy_Type, loginpolicy.Type]
-	methodConverter *mapper.EnumTypeConverter[authenticationV1.LoginPolicy_Method, loginpolicy.Method]
+	mapper              *mapper.CopierMapper[authenticationV1.LoginPolicy, ent.LoginPolicy]
+	typeConverter       *mapper.EnumTypeConverter[authenticationV1.LoginPolicy_Type, loginpolicy.Type]
+	methodConverter     *mapper.EnumTypeConverter[authenticationV1.LoginPolicy_Method, loginpolicy.Method]
+	targetTypeConverter *mapper.EnumTypeConverter[authenticationV1.LoginPolicy_TargetType, loginpolicy.TargetType]
 
 	repository *entCrud.Repository[
 		ent.LoginPolicyQuery, ent.LoginPolicySelect,
@@ -52,6 +54,9 @@ func NewLoginPolicyRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*en
 		methodConverter: mapper.NewEnumTypeConverter[authenticationV1.LoginPolicy_Method, loginpolicy.Method](
 			authenticationV1.LoginPolicy_Method_name, authenticationV1.LoginPolicy_Method_value,
 		),
+		targetTypeConverter: mapper.NewEnumTypeConverter[authenticationV1.LoginPolicy_TargetType, loginpolicy.TargetType](
+			authenticationV1.LoginPolicy_TargetType_name, authenticationV1.LoginPolicy_TargetType_value,
+		),
 	}
 
 	repo.init()
@@ -74,20 +79,36 @@ func (r *LoginPolicyRepo) init() {
 
 	r.mapper.AppendConverters(r.typeConverter.NewConverterPair())
 	r.mapper.AppendConverters(r.methodConverter.NewConverterPair())
+	r.mapper.AppendConverters(r.targetTypeConverter.NewConverterPair())
+}
+
+// targetTypeToEntity 生效对象类型转为实体值，未指定时不写入（沿用目标ID判定全局或用户）。
+func (r *LoginPolicyRepo) targetTypeToEntity(targetType *authenticationV1.LoginPolicy_TargetType) *loginpolicy.TargetType {
+	if targetType == nil || *targetType == authenticationV1.LoginPolicy_LOGIN_POLICY_TARGET_TYPE_UNSPECIFIED {
+		return nil
+	}
+	return r.targetTypeConverter.ToEntity(targetType)
 }
 
 // EffectivePolicy 登录闸门用的策略条目（ent 实体的精简视图，避免服务层依赖生成代码）。
 type EffectivePolicy struct {
-	TargetID uint32 // 0 表示全局策略（不限定用户）
-	Value    string
-	Type     string // BLACK_LIST / WHITE_LIST
-	Method   string // IP / MAC / REGION / TIME / DEVICE
-	Reason   string
+	ID         uint32
+	TargetType string // 空（按 TargetID 判定全局或用户）/ GLOBAL / USER / ROLE / ORG_UNIT
+	TargetID   uint32 // 用户 ID 或组织单元 ID，未指定生效对象类型时 0 表示全局策略
+	TargetCode string // 角色码
+	Value      string
+	Type       string // BLACK_LIST / WHITE_LIST
+	Method     string // IP / MAC / REGION / TIME / DEVICE
+	Reason     string
+
+	// Location 判定时间窗所用的时区：组织单元定向策略取该组织单元（或最近的上级）配置的时区，
+	// 其余取租户配置的时区；均未配置时为 nil，按服务器本地时间判定。
+	Location *time.Location
 }
 
-// ListForLogin 拉取租户内全部登录策略，供登录闸门在内存中按
-// 全局（target_id 为空）/ 用户定向（target_id = userId）两批匹配。
+// ListForLogin 拉取租户内全部登录策略，供登录闸门在内存中按生效对象过滤后匹配。
 // 策略量级小（管理配置项），全量拉取 + 内存过滤即可，无需按用户建索引。
+// 存在时间窗策略时一并解析各策略的判定时区，时区配置无效时按服务器本地时间判定。
 func (r *LoginPolicyRepo) ListForLogin(ctx context.Context, tenantID uint32) ([]EffectivePolicy, error) {
 	entities, err := r.entClient.Client().LoginPolicy.Query().
 		Where(loginpolicy.TenantIDEQ(tenantID)).
@@ -96,19 +117,118 @@ func (r *LoginPolicyRepo) ListForLogin(ctx context.Context, tenantID uint32) ([]
 		r.log.Errorf("list login policies for login failed: %s", err.Error())
 		return nil, fmt.Errorf("list login policies failed")
 	}
+
 	policies := make([]EffectivePolicy, 0, len(entities))
+	var orgUnitIDs []uint32
 	for _, e := range entities {
-		policies = append(policies, EffectivePolicy{
-			TargetID: derefUint32(e.TargetID),
-			Value:    derefStr(e.Value),
-			Type:     derefStrP(e.Type),
-			Method:   derefStrP(e.Method),
-			Reason:   derefStr(e.Reason),
-		})
+		p := EffectivePolicy{
+			ID:         e.ID,
+			TargetType: derefStrP(e.TargetType),
+			TargetID:   derefUint32(e.TargetID),
+			TargetCode: derefStr(e.TargetCode),
+			Value:      derefStr(e.Value),
+			Type:       derefStrP(e.Type),
+			Method:     derefStrP(e.Method),
+			Reason:     derefStr(e.Reason),
+		}
+		if p.Method == loginpolicy.MethodTime.String() && p.TargetType == loginpolicy.TargetTypeOrgUnit.String() {
+			orgUnitIDs = append(orgUnitIDs, p.TargetID)
+		}
+		policies = append(policies, p)
+	}
+
+	if !HasLoginPolicyMethod(policies, loginpolicy.MethodTime.String()) {
+		return policies, nil
+	}
+
+	tenantLoc := r.tenantLocation(ctx, tenantID)
+
+	var units map[uint32]*ent.OrgUnit
+	if len(orgUnitIDs) > 0 {
+		if units, err = queryOrgUnitAncestry(ctx, r.entClient.Client(), orgUnitIDs); err != nil {
+			r.log.Errorf("query org units of login policies failed: %s", err.Error())
+		}
+	}
+
+	for i := range policies {
+		if policies[i].Method != loginpolicy.MethodTime.String() {
+			continue
+		}
+		policies[i].Location = tenantLoc
+		if policies[i].TargetType == loginpolicy.TargetTypeOrgUnit.String() {
+			if loc := r.orgUnitLocation(units, policies[i].TargetID); loc != nil {
+				policies[i].Location = loc
+			}
+		}
 	}
+
 	return policies, nil
 }
 
+// ExpandOrgUnitAncestors 把用户所属的组织单元展开为含全部上级，供组织单元定向策略约束下级成员。
+func (r *LoginPolicyRepo) ExpandOrgUnitAncestors(ctx context.Context, orgUnitIDs []uint32) ([]uint32, error) {
+	if len(orgUnitIDs) == 0 {
+		return nil, nil
+	}
+
+	units, err := queryOrgUnitAncestry(ctx, r.entClient.Client(), orgUnitIDs)
+	if err != nil {
+		r.log.Errorf("query org unit ancestors failed: %s", err.Error())
+		return nil, fmt.Errorf("query org unit ancestors failed")
+	}
+
+	ids := make([]uint32, 0, len(units))
+	for id := range units {
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// tenantLocation 租户配置的时区，平台（租户 0）、未配置或配置无效时返回 nil。
+func (r *LoginPolicyRepo) tenantLocation(ctx context.Context, tenantID uint32) *time.Location {
+	if tenantID == 0 {
+		return nil
+	}
+
+	entity, err := r.entClient.Client().Tenant.Query().
+		Where(tenant.IDEQ(tenantID)).
+		Select(tenant.FieldTimezone).
+		Only(ctx)
+	if err != nil {
+		r.log.Errorf("query timezone of tenant [%d] failed: %s", tenantID, err.Error())
+		return nil
+	}
+
+	return r.loadLocation(derefStr(entity.Timezone))
+}
+
+// orgUnitLocation 组织单元配置的时区，未配置时沿上级查找最近配置了时区的组织单元。
+func (r *LoginPolicyRepo) orgUnitLocation(units map[uint32]*ent.OrgUnit, orgUnitID uint32) *time.Location {
+	for id, depth := orgUnitID, 0; id != 0 && depth < len(units); depth++ {
+		unit, ok := units[id]
+		if !ok {
+			return nil
+		}
+		if loc := r.loadLocation(derefStr(unit.Timezone)); loc != nil {
+			return loc
+		}
+		id = derefUint32(unit.ParentID)
+	}
+	return nil
+}
+
+func (r *LoginPolicyRepo) loadLocation(name string) *time.Location {
+	if name == "" {
+		return nil
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		r.log.Warnf("invalid timezone [%s]: %s", name, err.Error())
+		return nil
+	}
+	return loc
+}
+
 func derefUint32(p *uint32) uint32 {
 	if p == nil {
 		return 0
@@ -200,6 +320,8 @@ func (r *LoginPolicyRepo) Create(ctx context.Context, req *authenticationV1.Crea
 	builder := r.entClient.Client().LoginPolicy.Create().
 		SetNillableTenantID(req.Data.TenantId).
 		SetNillableTargetID(req.Data.TargetId).
+		SetNillableTargetType(r.targetTypeToEntity(req.Data.TargetType)).
+		SetNillableTargetCode(req.Data.TargetCode).
 		SetNillableType(r.typeConverter.ToEntity(req.Data.Type)).
 		SetNillableMethod(r.methodConverter.ToEntity(req.Data.Method)).
 		SetNillableValue(req.Data.Value).
@@ -242,6 +364,8 @@ func (r *LoginPolicyRepo) Update(ctx context.Context, req *authenticationV1.Upda
 		func(dto *authenticationV1.LoginPolicy) {
 			builder.
 				SetNillableTargetID(req.Data.TargetId).
+				SetNillableTargetType(r.targetTypeToEntity(req.Data.TargetType)).
+				SetNillableTargetCode(req.Data.TargetCode).
 				SetNillableType(r.typeConverter.ToEntity(req.Data.Type)).
 				SetNillableMethod(r.methodConverter.ToEntity(req.Data.Method)).
 				SetNillableValue(req.Data.Value).
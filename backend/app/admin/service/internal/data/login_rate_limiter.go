@@ -28,6 +28,8 @@
 	loginFailKeyByIPFmt = "gowind:login:fail:ip:%s"
 	// loginFailKeyByUser 按用户名维度计数的 Redis 键。
 	loginFailKeyByUserFmt = "gowind:login:fail:user:%s"
+	// rateLimitKeyFmt 通用固定窗口计数键：scope + 维度值。
+	rateLimitKeyFmt = "gowind:ratelimit:%s:%s"
 )
 
 // incrIfNotLockedScript 原子「判定是否已锁定 → 自增失败计数 → 设置 TTL」Lua 脚本。
@@ -50,6 +52,16 @@
 	return {0, current}
 `)
 
+// fixedWindowScript 固定窗口计数：自增并在首次计数时设置 TTL。
+// 返回值：{当前计数, 窗口剩余秒数}
+var fixedWindowScript = redis.NewScript(`
+	local current = redis.call('INCR', KEYS[1])
+	if current == 1 then
+		redis.call('EXPIRE', KEYS[1], ARGV[1])
+	end
+	return {current, redis.call('TTL', KEYS[1])}
+`)
+
 // LoginRateLimiter 基于 Redis 的登录失败计数器，按 IP 与用户名双维度限流。
 // 用于 H5：防止登录暴力破解——失败超阈值后锁定相应窗口。
 type LoginRateLimiter struct {
@@ -149,3 +161,32 @@ func (l *LoginRateLimiter) failKeys(ip, username string) []string {
 	}
 	return keys
 }
+
+// Allow 通用固定窗口限流，供找回密码、账号激活等未登录接口使用：
+// 同一 scope 下同一 key 在 window 内至多放行 limit 次，超出时返回窗口剩余时长。
+// 与登录失败计数不同，每次调用都计数（无论业务成功与否）。key 为空或 Redis 不可用时放行。
+func (l *LoginRateLimiter) Allow(ctx context.Context, scope, key string, limit int, window time.Duration) (bool, time.Duration) {
+	if l == nil || l.rdb == nil || key == "" {
+		return true, 0
+	}
+
+	redisKey := fmt.Sprintf(rateLimitKeyFmt, scope, key)
+	res, err := fixedWindowScript.Run(ctx, l.rdb, []string{redisKey}, int64(window.Seconds())).Result()
+	if err != nil {
+		l.log.Errorf("incr rate limit counter failed (key=%s): %s", redisKey, err.Error())
+		return true, 0
+	}
+	vals, ok := res.([]interface{})
+	if !ok || len(vals) < 2 {
+		return true, 0
+	}
+	count, _ := vals[0].(int64)
+	ttl, _ := vals[1].(int64)
+	if count > int64(limit) {
+		if ttl <= 0 {
+			ttl = int64(window.Seconds())
+		}
+		return false, time.Duration(ttl) * time.Second
+	}
+	return true, 0
+}
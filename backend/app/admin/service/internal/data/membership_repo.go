@@ -231,6 +231,21 @@ func (r *MembershipRepo) AssignMembershipRoles(ctx context.Context,
 	return nil
 }
 
+// RemoveMembershipRoles 从用户（当前租户）的成员身份中移除角色。
+// AssignMembershipRoles 传空列表不做任何事，撤销最后一个角色需走此方法。
+func (r *MembershipRepo) RemoveMembershipRoles(ctx context.Context, userID uint32, roleIDs []uint32) error {
+	if len(roleIDs) == 0 {
+		return nil
+	}
+
+	membershipID, err := r.GetMembershipID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	return r.membershipRoleRepo.RemoveRolesFromMembership(ctx, membershipID, roleIDs)
+}
+
 // AssignMembershipPositions 分配职位给用户
 func (r *MembershipRepo) AssignMembershipPositions(
 	ctx context.Context,
@@ -403,6 +418,42 @@ func (r *MembershipRepo) SetUserStatus(ctx context.Context, userID uint32, statu
 	return nil
 }
 
+// SetUserTenantStatus 设置用户在指定租户下的成员身份状态（软停用/恢复）。
+// 与 SetUserStatus 不同，只作用于一个租户，不影响用户在其他租户的成员身份。
+func (r *MembershipRepo) SetUserTenantStatus(ctx context.Context, userID, tenantID uint32, status identityV1.Membership_Status) error {
+	if _, err := r.entClient.Client().Membership.
+		Update().
+		Where(
+			membership.UserIDEQ(userID),
+			membership.TenantIDEQ(tenantID),
+		).
+		SetStatus(*r.statusConverter.ToEntity(&status)).
+		SetUpdatedAt(time.Now()).
+		Save(ctx); err != nil {
+		r.log.Errorf("update membership status failed: %s", err.Error())
+		return identityV1.ErrorInternalServerError("update membership status failed")
+	}
+	return nil
+}
+
+// IsMembershipSuspended 用户在指定租户下存在成员身份且状态不是 ACTIVE 时返回 true。
+// 没有成员身份记录（一对一模式下的普通用户）或状态为空的历史数据视为未停用。
+func (r *MembershipRepo) IsMembershipSuspended(ctx context.Context, userID, tenantID uint32) (bool, error) {
+	exist, err := r.entClient.Client().Membership.Query().
+		Where(
+			membership.UserIDEQ(userID),
+			membership.TenantIDEQ(tenantID),
+			membership.StatusNotNil(),
+			membership.StatusNEQ(membership.StatusActive),
+		).
+		Exist(ctx)
+	if err != nil {
+		r.log.Errorf("query membership status failed: %s", err.Error())
+		return false, identityV1.ErrorInternalServerError("query membership status failed")
+	}
+	return exist, nil
+}
+
 // SetUserEndAt 设置用户的结束时间
 func (r *MembershipRepo) SetUserEndAt(ctx context.Context, userID uint32, endAt *time.Time) error {
 	up := r.entClient.Client().Membership.
@@ -2,6 +2,9 @@
 
 import (
 	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -19,6 +22,8 @@
 const (
 	// MfaChallengeTTL 挑战/注册操作上下文的有效期。
 	MfaChallengeTTL = 5 * time.Minute
+	// MfaEnrollmentChallengeTTL 强制注册登录挑战的有效期：需留出安装认证器 App、绑定并完成首次验证的时间。
+	MfaEnrollmentChallengeTTL = 15 * time.Minute
 
 	// mfaEnrollCooldown 注册发起冷却（防已登录用户循环 Start 塞 Redis）。
 	mfaEnrollCooldown = 30 * time.Second
@@ -27,24 +32,94 @@
 	mfaLoginChallengeKeyFmt = "mfa:login:%s"
 	// 注册上下文 key 前缀：mfa:enroll：<operation_id>
 	mfaEnrollChallengeKeyFmt = "mfa:enroll:%s"
+	// 登录挑战的 WebAuthn 断言会话 key：mfa:login:webauthn:<operation_id>
+	mfaLoginWebAuthnKeyFmt = "mfa:login:webauthn:%s"
+	// 通行密钥无密码登录上下文 key：mfa:passkey:<operation_id>
+	mfaPasskeyLoginKeyFmt = "mfa:passkey:%s"
+
+	// 短信/邮件验证码 key：mfa:otp:<operation_id>（注册与登录挑战共用，operation_id 互不重复）
+	mfaOtpCodeKeyFmt = "mfa:otp:%s"
+	// 验证码重发冷却 key（按因子）：mfa:otp:resend:<tenant>:<user>:<method>
+	mfaOtpResendKeyFmt = "mfa:otp:resend:%d:%d:%s"
+	// 验证码失败计数 key（按因子，跨挑战累计）：mfa:otp:fail:<tenant>:<user>:<method>
+	mfaOtpFailKeyFmt = "mfa:otp:fail:%d:%d:%s"
+
+	// MfaOtpResendCooldown 同一因子两次下发验证码的最小间隔。
+	MfaOtpResendCooldown = 60 * time.Second
+	// MfaOtpLockWindow 因子失败计数窗口：窗口内失败达 MaxOtpFailures 次即锁定至窗口结束。
+	MfaOtpLockWindow = 15 * time.Minute
+	// MaxOtpFailures 单个因子在锁定窗口内允许的验证码错误次数。
+	// 6 位验证码空间 10^6，且每次重发作废旧码，5 次内随机命中的概率可忽略。
+	MaxOtpFailures = 5
 )
 
-var ErrMfaChallengeNotFound = errors.New("mfa challenge not found or expired")
+var (
+	ErrMfaChallengeNotFound = errors.New("mfa challenge not found or expired")
+	// ErrMfaOtpMismatch 验证码错误
+	ErrMfaOtpMismatch = errors.New("mfa otp code mismatch")
+	// ErrMfaOtpLocked 因子验证码错误次数达上限，锁定期内拒绝下发与校验
+	ErrMfaOtpLocked = errors.New("mfa otp factor locked")
+)
 
 // MfaLoginChallengeContext 登录挑战上下文。
-// 密码校验通过且用户绑定 TOTP 后，由 doGrantTypePassword 写入；
+// 密码校验通过且用户绑定了主因子（或 MFA 策略要求启用）后，由登录流程写入；
 // VerifyMFAChallenge 取出并用于签发真 token。
 type MfaLoginChallengeContext struct {
 	Payload    *authenticationV1.UserTokenPayload
 	ClientType authenticationV1.ClientType
+
+	// EnrollmentRequired 强制注册挑战：用户尚无因子，须先经 StartLoginEnrollment/ConfirmLoginEnrollment
+	// 完成注册（CompleteLoginEnrollment 清除该标记）才能进行二次验证
+	EnrollmentRequired bool
 }
 
 // MfaEnrollChallengeContext 注册上下文。
-// StartEnrollMethod 生成 TOTP secret 后写入；ConfirmEnrollMethod 取出 secret 校验首码。
+// StartEnrollMethod 生成 TOTP secret 或 WebAuthn 注册会话后写入；
+// ConfirmEnrollMethod 取出后校验首码/认证器注册响应。
 type MfaEnrollChallengeContext struct {
 	Secret   string
 	TenantID uint32
 	UserID   uint32
+
+	// WebAuthnSession WebAuthn 注册仪式会话（挑战等），TOTP 注册为空
+	WebAuthnSession []byte `json:",omitempty"`
+
+	// OtpMethod / Destination 短信、邮件注册的方法与待验证的手机号/邮箱，其余方法为空
+	OtpMethod   authenticationV1.MFAMethod `json:",omitempty"`
+	Destination string                     `json:",omitempty"`
+}
+
+// IsWebAuthn 是否为 WebAuthn 注册上下文。
+func (c *MfaEnrollChallengeContext) IsWebAuthn() bool {
+	return len(c.WebAuthnSession) > 0
+}
+
+// Method 注册上下文对应的 MFA 方法。
+func (c *MfaEnrollChallengeContext) Method() authenticationV1.MFAMethod {
+	switch {
+	case c.IsWebAuthn():
+		return authenticationV1.MFAMethod_WEBAUTHN
+	case c.OtpMethod != authenticationV1.MFAMethod_MFA_METHOD_UNSPECIFIED:
+		return c.OtpMethod
+	}
+	return authenticationV1.MFAMethod_TOTP
+}
+
+// MfaOtpChallenge 一次下发的短信/邮件验证码（只存摘要）。
+type MfaOtpChallenge struct {
+	TenantID uint32
+	UserID   uint32
+	Method   authenticationV1.MFAMethod
+	// FactorID 登录挑战所用因子；注册阶段因子尚未落库，为 0
+	FactorID uint32 `json:",omitempty"`
+	CodeHash string
+}
+
+// MfaPasskeyLoginContext 通行密钥无密码登录上下文。
+// StartPasskeyLogin 写入断言会话与客户端类型；FinishPasskeyLogin 取出即删。
+type MfaPasskeyLoginContext struct {
+	Session    []byte
+	ClientType authenticationV1.ClientType
 }
 
 // MfaChallengeCache MFA 操作上下文缓存。
@@ -63,21 +138,35 @@ func NewMfaChallengeCache(ctx *bootstrap.Context, rdb *redis.Client) *MfaChallen
 
 // SetLoginChallenge 写入登录挑战上下文，返回 operation_id。
 func (c *MfaChallengeCache) SetLoginChallenge(ctx context.Context, payload *authenticationV1.UserTokenPayload, clientType authenticationV1.ClientType) (string, error) {
+	return c.setLoginChallenge(ctx, payload, clientType, false)
+}
+
+// SetEnrollmentLoginChallenge 写入强制注册登录挑战（MFA 策略要求启用而用户尚无因子），返回 operation_id。
+func (c *MfaChallengeCache) SetEnrollmentLoginChallenge(ctx context.Context, payload *authenticationV1.UserTokenPayload, clientType authenticationV1.ClientType) (string, error) {
+	return c.setLoginChallenge(ctx, payload, clientType, true)
+}
+
+func (c *MfaChallengeCache) setLoginChallenge(ctx context.Context, payload *authenticationV1.UserTokenPayload, clientType authenticationV1.ClientType, enrollmentRequired bool) (string, error) {
 	opId := newOperationID()
 	data, err := proto.Marshal(payload)
 	if err != nil {
 		return "", fmt.Errorf("marshal login challenge payload failed: %w", err)
 	}
 	envelope := mfaLoginChallengeEnvelope{
-		Payload:    data,
-		ClientType: int32(clientType),
+		Payload:            data,
+		ClientType:         int32(clientType),
+		EnrollmentRequired: enrollmentRequired,
 	}
 	raw, err := json.Marshal(envelope)
 	if err != nil {
 		return "", fmt.Errorf("marshal login challenge envelope failed: %w", err)
 	}
+	ttl := MfaChallengeTTL
+	if enrollmentRequired {
+		ttl = MfaEnrollmentChallengeTTL
+	}
 	key := fmt.Sprintf(mfaLoginChallengeKeyFmt, opId)
-	if err := c.rdb.Set(ctx, key, raw, MfaChallengeTTL).Err(); err != nil {
+	if err := c.rdb.Set(ctx, key, raw, ttl).Err(); err != nil {
 		c.log.Errorf("set login challenge failed: %s", err.Error())
 		return "", fmt.Errorf("set login challenge failed")
 	}
@@ -114,11 +203,49 @@ func (c *MfaChallengeCache) PeekLoginChallenge(ctx context.Context, opId string)
 		return nil, fmt.Errorf("unmarshal login challenge payload failed: %w", err)
 	}
 	return &MfaLoginChallengeContext{
-		Payload:    payload,
-		ClientType: authenticationV1.ClientType(envelope.ClientType),
+		Payload:            payload,
+		ClientType:         authenticationV1.ClientType(envelope.ClientType),
+		EnrollmentRequired: envelope.EnrollmentRequired,
 	}, nil
 }
 
+// CompleteLoginEnrollment 强制注册完成：清除登录挑战的注册标记（保留剩余有效期），
+// 此后同一 operation_id 按普通登录挑战走 StartMFAChallenge/VerifyMFAChallenge。
+func (c *MfaChallengeCache) CompleteLoginEnrollment(ctx context.Context, opId string) error {
+	key := fmt.Sprintf(mfaLoginChallengeKeyFmt, opId)
+	raw, err := c.rdb.Get(ctx, key).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return ErrMfaChallengeNotFound
+		}
+		c.log.Errorf("get login challenge failed: %s", err.Error())
+		return fmt.Errorf("get mfa challenge failed")
+	}
+
+	var envelope mfaLoginChallengeEnvelope
+	if err = json.Unmarshal(raw, &envelope); err != nil {
+		return fmt.Errorf("unmarshal login challenge envelope failed: %w", err)
+	}
+	if !envelope.EnrollmentRequired {
+		return nil
+	}
+	envelope.EnrollmentRequired = false
+	if raw, err = json.Marshal(envelope); err != nil {
+		return fmt.Errorf("marshal login challenge envelope failed: %w", err)
+	}
+
+	// XX：挑战在读写之间被消耗（并发验证/失败超限）时不重新写入
+	ok, err := c.rdb.SetArgs(ctx, key, raw, redis.SetArgs{Mode: "XX", KeepTTL: true}).Result()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		c.log.Errorf("complete login enrollment failed: %s", err.Error())
+		return fmt.Errorf("update mfa challenge failed")
+	}
+	if ok != "OK" {
+		return ErrMfaChallengeNotFound
+	}
+	return nil
+}
+
 // RecordLoginFailure 记录一次验证失败并返回挑战是否已达失败上限。
 // 达上限时顺带作废挑战上下文（幂等：计数 key 与挑战 key 同 TTL 生命周期）。
 func (c *MfaChallengeCache) RecordLoginFailure(ctx context.Context, opId string) (exceeded bool) {
@@ -141,7 +268,12 @@ func (c *MfaChallengeCache) RecordLoginFailure(ctx context.Context, opId string)
 // 注意：验证通过的发 token 路径不能用它——普通 DEL 幂等，无法裁决并发双花，
 // 必须走 TakeLoginChallengeAtomic。
 func (c *MfaChallengeCache) ConsumeLoginChallenge(ctx context.Context, opId string) {
-	c.rdb.Del(ctx, fmt.Sprintf(mfaLoginChallengeKeyFmt, opId), fmt.Sprintf(mfaLoginFailKeyFmt, opId))
+	c.rdb.Del(ctx,
+		fmt.Sprintf(mfaLoginChallengeKeyFmt, opId),
+		fmt.Sprintf(mfaLoginFailKeyFmt, opId),
+		fmt.Sprintf(mfaLoginWebAuthnKeyFmt, opId),
+		fmt.Sprintf(mfaOtpCodeKeyFmt, opId),
+	)
 }
 
 // takeAndDelScript 原子 GET+DEL：返回键值或 nil（不存在/已被并发消耗）。
@@ -168,10 +300,77 @@ func (c *MfaChallengeCache) TakeLoginChallengeAtomic(ctx context.Context, opId s
 	if res == nil {
 		return false
 	}
-	c.rdb.Del(ctx, fmt.Sprintf(mfaLoginFailKeyFmt, opId))
+	c.rdb.Del(ctx,
+		fmt.Sprintf(mfaLoginFailKeyFmt, opId),
+		fmt.Sprintf(mfaLoginWebAuthnKeyFmt, opId),
+		fmt.Sprintf(mfaOtpCodeKeyFmt, opId),
+	)
 	return true
 }
 
+// SetLoginWebAuthnSession 为登录挑战写入 WebAuthn 断言会话（StartMFAChallenge 调用）。
+// 重复发起覆盖旧会话，仅最近一次下发的挑战有效。
+func (c *MfaChallengeCache) SetLoginWebAuthnSession(ctx context.Context, opId string, session []byte) error {
+	key := fmt.Sprintf(mfaLoginWebAuthnKeyFmt, opId)
+	if err := c.rdb.Set(ctx, key, session, MfaChallengeTTL).Err(); err != nil {
+		c.log.Errorf("set login webauthn session failed: %s", err.Error())
+		return fmt.Errorf("set mfa challenge failed")
+	}
+	return nil
+}
+
+// TakeLoginWebAuthnSession 原子取出登录挑战的 WebAuthn 断言会话。
+// 每次断言消耗一个挑战：校验失败后需重新 StartMFAChallenge，防同一挑战被重放。
+func (c *MfaChallengeCache) TakeLoginWebAuthnSession(ctx context.Context, opId string) ([]byte, error) {
+	key := fmt.Sprintf(mfaLoginWebAuthnKeyFmt, opId)
+	return c.take(ctx, key)
+}
+
+// SetPasskeyLoginChallenge 写入通行密钥无密码登录上下文，返回 operation_id。
+func (c *MfaChallengeCache) SetPasskeyLoginChallenge(ctx context.Context, session []byte, clientType authenticationV1.ClientType) (string, error) {
+	opId := newOperationID()
+	raw, err := json.Marshal(MfaPasskeyLoginContext{Session: session, ClientType: clientType})
+	if err != nil {
+		return "", fmt.Errorf("marshal passkey login context failed: %w", err)
+	}
+	key := fmt.Sprintf(mfaPasskeyLoginKeyFmt, opId)
+	if err := c.rdb.Set(ctx, key, raw, MfaChallengeTTL).Err(); err != nil {
+		c.log.Errorf("set passkey login challenge failed: %s", err.Error())
+		return "", fmt.Errorf("set passkey login challenge failed")
+	}
+	return opId, nil
+}
+
+// TakePasskeyLoginChallenge 原子取出通行密钥无密码登录上下文（单次有效）。
+func (c *MfaChallengeCache) TakePasskeyLoginChallenge(ctx context.Context, opId string) (*MfaPasskeyLoginContext, error) {
+	raw, err := c.take(ctx, fmt.Sprintf(mfaPasskeyLoginKeyFmt, opId))
+	if err != nil {
+		return nil, err
+	}
+	var passkeyCtx MfaPasskeyLoginContext
+	if err := json.Unmarshal(raw, &passkeyCtx); err != nil {
+		return nil, fmt.Errorf("unmarshal passkey login context failed: %w", err)
+	}
+	return &passkeyCtx, nil
+}
+
+// take 原子 GET+DEL，键不存在时返回 ErrMfaChallengeNotFound。
+func (c *MfaChallengeCache) take(ctx context.Context, key string) ([]byte, error) {
+	res, err := takeAndDelScript.Run(ctx, c.rdb, []string{key}).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, ErrMfaChallengeNotFound
+		}
+		c.log.Errorf("atomic take mfa challenge failed: %s", err.Error())
+		return nil, fmt.Errorf("take mfa challenge failed")
+	}
+	str, ok := res.(string)
+	if !ok {
+		return nil, ErrMfaChallengeNotFound
+	}
+	return []byte(str), nil
+}
+
 // TryAcquireEnrollCooldown 注册频控：同一用户冷却期内只允许发起一次 StartEnroll。
 // 防止已登录用户循环 Start 塞满 Redis（每条 5 分钟 TTL）的低成本 DoS。
 func (c *MfaChallengeCache) TryAcquireEnrollCooldown(ctx context.Context, tenantID, userID uint32) bool {
@@ -185,14 +384,36 @@ func (c *MfaChallengeCache) TryAcquireEnrollCooldown(ctx context.Context, tenant
 	return ok
 }
 
-// SetEnrollChallenge 写入注册上下文，返回 operation_id。
+// SetEnrollChallenge 写入 TOTP 注册上下文，返回 operation_id。
 func (c *MfaChallengeCache) SetEnrollChallenge(ctx context.Context, secret string, tenantID, userID uint32) (string, error) {
-	opId := newOperationID()
-	envelope := MfaEnrollChallengeContext{
+	return c.setEnrollChallenge(ctx, &MfaEnrollChallengeContext{
 		Secret:   secret,
 		TenantID: tenantID,
 		UserID:   userID,
-	}
+	})
+}
+
+// SetOtpEnrollChallenge 写入短信/邮件注册上下文（待验证的手机号/邮箱），返回 operation_id。
+func (c *MfaChallengeCache) SetOtpEnrollChallenge(ctx context.Context, method authenticationV1.MFAMethod, destination string, tenantID, userID uint32) (string, error) {
+	return c.setEnrollChallenge(ctx, &MfaEnrollChallengeContext{
+		TenantID:    tenantID,
+		UserID:      userID,
+		OtpMethod:   method,
+		Destination: destination,
+	})
+}
+
+// SetWebAuthnEnrollChallenge 写入 WebAuthn 注册上下文，返回 operation_id。
+func (c *MfaChallengeCache) SetWebAuthnEnrollChallenge(ctx context.Context, session []byte, tenantID, userID uint32) (string, error) {
+	return c.setEnrollChallenge(ctx, &MfaEnrollChallengeContext{
+		TenantID:        tenantID,
+		UserID:          userID,
+		WebAuthnSession: session,
+	})
+}
+
+func (c *MfaChallengeCache) setEnrollChallenge(ctx context.Context, envelope *MfaEnrollChallengeContext) (string, error) {
+	opId := newOperationID()
 	raw, err := json.Marshal(envelope)
 	if err != nil {
 		return "", fmt.Errorf("marshal enroll challenge envelope failed: %w", err)
@@ -226,17 +447,128 @@ func (c *MfaChallengeCache) PeekEnrollChallenge(ctx context.Context, opId string
 	return &envelope, nil
 }
 
-// DeleteEnrollChallenge 删除注册上下文（注册成功后消耗）。
+// DeleteEnrollChallenge 删除注册上下文（注册成功后消耗），连同未使用的验证码。
 func (c *MfaChallengeCache) DeleteEnrollChallenge(ctx context.Context, opId string) {
-	key := fmt.Sprintf(mfaEnrollChallengeKeyFmt, opId)
-	c.rdb.Del(ctx, key)
+	c.rdb.Del(ctx, fmt.Sprintf(mfaEnrollChallengeKeyFmt, opId), fmt.Sprintf(mfaOtpCodeKeyFmt, opId))
+}
+
+// TryAcquireOtpResend 验证码重发冷却：同一因子冷却期内只允许下发一次。
+// 未获取到时返回剩余冷却时间。
+func (c *MfaChallengeCache) TryAcquireOtpResend(ctx context.Context, tenantID, userID uint32, method authenticationV1.MFAMethod) (bool, time.Duration) {
+	key := fmt.Sprintf(mfaOtpResendKeyFmt, tenantID, userID, method.String())
+	ok, err := c.rdb.SetNX(ctx, key, 1, MfaOtpResendCooldown).Result()
+	if err != nil {
+		// Redis 异常拒绝下发（短信/邮件有实际成本，fail-closed 防刷）
+		c.log.Errorf("acquire otp resend cooldown failed: %s", err.Error())
+		return false, MfaOtpResendCooldown
+	}
+	if ok {
+		return true, 0
+	}
+	ttl, _ := c.rdb.TTL(ctx, key).Result()
+	if ttl <= 0 {
+		ttl = time.Second
+	}
+	return false, ttl
+}
+
+// IsOtpLocked 因子是否因验证码错误次数过多而处于锁定期。Redis 异常按锁定处理（fail-closed）。
+func (c *MfaChallengeCache) IsOtpLocked(ctx context.Context, tenantID, userID uint32, method authenticationV1.MFAMethod) bool {
+	n, err := c.rdb.Get(ctx, fmt.Sprintf(mfaOtpFailKeyFmt, tenantID, userID, method.String())).Int()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return false
+		}
+		c.log.Errorf("query otp fail count failed: %s", err.Error())
+		return true
+	}
+	return n >= MaxOtpFailures
+}
+
+// SetOtpChallenge 为操作（注册或登录挑战）写入新下发的验证码，覆盖此前的验证码（重发即作废旧码）。
+// 只存摘要，有效期与挑战一致。
+func (c *MfaChallengeCache) SetOtpChallenge(ctx context.Context, opId string, challenge *MfaOtpChallenge, code string) error {
+	stored := *challenge
+	stored.CodeHash = hashOtpCode(opId, code)
+	raw, err := json.Marshal(&stored)
+	if err != nil {
+		return fmt.Errorf("marshal otp challenge failed: %w", err)
+	}
+	if err = c.rdb.Set(ctx, fmt.Sprintf(mfaOtpCodeKeyFmt, opId), raw, MfaChallengeTTL).Err(); err != nil {
+		c.log.Errorf("set otp challenge failed: %s", err.Error())
+		return fmt.Errorf("set mfa challenge failed")
+	}
+	return nil
+}
+
+// VerifyOtpChallenge 校验操作的验证码，校验通过即消耗（单次有效）并清零该因子的失败计数。
+//
+// 返回：
+//   - ErrMfaChallengeNotFound：未下发、已过期、已被使用，或归属（租户/用户/方法）不符；
+//   - ErrMfaOtpMismatch：验证码错误，计入因子失败次数；
+//   - ErrMfaOtpLocked：因子失败次数达上限（本次错误触发时一并作废当前验证码）。
+func (c *MfaChallengeCache) VerifyOtpChallenge(ctx context.Context, opId string, tenantID, userID uint32, method authenticationV1.MFAMethod, code string) (*MfaOtpChallenge, error) {
+	if c.IsOtpLocked(ctx, tenantID, userID, method) {
+		return nil, ErrMfaOtpLocked
+	}
+
+	codeKey := fmt.Sprintf(mfaOtpCodeKeyFmt, opId)
+	raw, err := c.rdb.Get(ctx, codeKey).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, ErrMfaChallengeNotFound
+		}
+		c.log.Errorf("get otp challenge failed: %s", err.Error())
+		return nil, fmt.Errorf("get mfa challenge failed")
+	}
+	var challenge MfaOtpChallenge
+	if err = json.Unmarshal(raw, &challenge); err != nil {
+		return nil, fmt.Errorf("unmarshal otp challenge failed: %w", err)
+	}
+	if challenge.TenantID != tenantID || challenge.UserID != userID || challenge.Method != method {
+		return nil, ErrMfaChallengeNotFound
+	}
+
+	failKey := fmt.Sprintf(mfaOtpFailKeyFmt, tenantID, userID, method.String())
+	if subtle.ConstantTimeCompare([]byte(hashOtpCode(opId, code)), []byte(challenge.CodeHash)) != 1 {
+		n, ierr := c.rdb.Incr(ctx, failKey).Result()
+		if ierr != nil {
+			c.log.Errorf("incr otp fail count failed: %s", ierr.Error())
+			c.rdb.Del(ctx, codeKey)
+			return nil, ErrMfaOtpLocked
+		}
+		if n == 1 {
+			c.rdb.Expire(ctx, failKey, MfaOtpLockWindow)
+		}
+		if n >= MaxOtpFailures {
+			c.rdb.Del(ctx, codeKey)
+			return nil, ErrMfaOtpLocked
+		}
+		return nil, ErrMfaOtpMismatch
+	}
+
+	// DEL 返回 0 说明并发请求已用掉该验证码
+	if n, derr := c.rdb.Del(ctx, codeKey).Result(); derr != nil || n == 0 {
+		return nil, ErrMfaChallengeNotFound
+	}
+	c.rdb.Del(ctx, failKey)
+	return &challenge, nil
+}
+
+// hashOtpCode 验证码摘要：以 operation_id 加盐，验证码明文不落 Redis。
+func hashOtpCode(opId, code string) string {
+	sum := sha256.Sum256([]byte(opId + ":" + code))
+	return hex.EncodeToString(sum[:])
 }
 
 // mfaLoginChallengeEnvelope 是登录挑战上下文的传输封装。
-// payload 为 proto 序列化的 UserTokenPayload（含 roles/data_scope 等），clientType 为枚举值。
+// payload 为 proto 序列化的 UserTokenPayload（含 roles/data_scope 等），clientType 为枚举值，
+// enrollmentRequired 标记强制注册挑战。
 type mfaLoginChallengeEnvelope struct {
 	Payload    []byte
 	ClientType int32
+
+	EnrollmentRequired bool `json:",omitempty"`
 }
 
 func newOperationID() string {
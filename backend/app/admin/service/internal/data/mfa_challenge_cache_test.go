@@ -0,0 +1,107 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+
+	conf "github.com/tx7do/kratos-bootstrap/api/gen/go/conf/v1"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+)
+
+func newTestMfaChallengeCache(t *testing.T) (*MfaChallengeCache, *miniredis.Miniredis) {
+	t.Helper()
+
+	mr, err := miniredis.Run()
+	assert.NoError(t, err)
+	t.Cleanup(mr.Close)
+
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	bctx := bootstrap.NewContextWithParam(context.Background(), &conf.AppInfo{}, &conf.Bootstrap{}, log.DefaultLogger)
+	return NewMfaChallengeCache(bctx, rdb), mr
+}
+
+func TestMfaChallengeCache_WebAuthnEnroll(t *testing.T) {
+	cache, _ := newTestMfaChallengeCache(t)
+	ctx := context.Background()
+
+	opId, err := cache.SetWebAuthnEnrollChallenge(ctx, []byte(`{"challenge":"abc"}`), 1, 2)
+	assert.NoError(t, err)
+	enrollCtx, err := cache.PeekEnrollChallenge(ctx, opId)
+	assert.NoError(t, err)
+	assert.True(t, enrollCtx.IsWebAuthn())
+	assert.Equal(t, uint32(1), enrollCtx.TenantID)
+	assert.Equal(t, uint32(2), enrollCtx.UserID)
+	assert.Equal(t, []byte(`{"challenge":"abc"}`), enrollCtx.WebAuthnSession)
+
+	opId, err = cache.SetEnrollChallenge(ctx, "SECRET", 1, 2)
+	assert.NoError(t, err)
+	enrollCtx, err = cache.PeekEnrollChallenge(ctx, opId)
+	assert.NoError(t, err)
+	assert.False(t, enrollCtx.IsWebAuthn())
+	assert.Equal(t, "SECRET", enrollCtx.Secret)
+}
+
+func TestMfaChallengeCache_LoginWebAuthnSession(t *testing.T) {
+	cache, mr := newTestMfaChallengeCache(t)
+	ctx := context.Background()
+
+	opId, err := cache.SetLoginChallenge(ctx, &authenticationV1.UserTokenPayload{UserId: 2}, authenticationV1.ClientType_admin)
+	assert.NoError(t, err)
+
+	_, err = cache.TakeLoginWebAuthnSession(ctx, opId)
+	assert.True(t, errors.Is(err, ErrMfaChallengeNotFound))
+
+	assert.NoError(t, cache.SetLoginWebAuthnSession(ctx, opId, []byte("s1")))
+	assert.NoError(t, cache.SetLoginWebAuthnSession(ctx, opId, []byte("s2")))
+	session, err := cache.TakeLoginWebAuthnSession(ctx, opId)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("s2"), session)
+	_, err = cache.TakeLoginWebAuthnSession(ctx, opId)
+	assert.True(t, errors.Is(err, ErrMfaChallengeNotFound))
+
+	// 作废登录挑战时一并清理断言会话
+	assert.NoError(t, cache.SetLoginWebAuthnSession(ctx, opId, []byte("s3")))
+	cache.ConsumeLoginChallenge(ctx, opId)
+	assert.False(t, mr.Exists("mfa:login:"+opId))
+	assert.False(t, mr.Exists("mfa:login:webauthn:"+opId))
+}
+
+func TestMfaChallengeCache_PasskeyLoginTakenOnce(t *testing.T) {
+	cache, mr := newTestMfaChallengeCache(t)
+	ctx := context.Background()
+
+	opId, err := cache.SetPasskeyLoginChallenge(ctx, []byte("session"), authenticationV1.ClientType_app)
+	assert.NoError(t, err)
+	assert.Equal(t, MfaChallengeTTL, mr.TTL("mfa:passkey:"+opId))
+
+	passkeyCtx, err := cache.TakePasskeyLoginChallenge(ctx, opId)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("session"), passkeyCtx.Session)
+	assert.Equal(t, authenticationV1.ClientType_app, passkeyCtx.ClientType)
+
+	_, err = cache.TakePasskeyLoginChallenge(ctx, opId)
+	assert.True(t, errors.Is(err, ErrMfaChallengeNotFound))
+}
+
+func TestWebAuthnUserHandle(t *testing.T) {
+	handle := WebAuthnUserHandle(7, 42)
+	assert.Len(t, handle, 8)
+
+	tid, uid, err := ParseWebAuthnUserHandle(handle)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(7), tid)
+	assert.Equal(t, uint32(42), uid)
+
+	_, _, err = ParseWebAuthnUserHandle([]byte("short"))
+	assert.Error(t, err)
+	_, _, err = ParseWebAuthnUserHandle(WebAuthnUserHandle(7, 0))
+	assert.Error(t, err)
+}
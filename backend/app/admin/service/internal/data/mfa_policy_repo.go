@@ -0,0 +1,161 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/go-utils/timeutil"
+	"github.com/tx7do/go-utils/trans"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"go-wind-admin/app/admin/service/internal/data/ent"
+	"go-wind-admin/app/admin/service/internal/data/ent/mfapolicy"
+	"go-wind-admin/app/admin/service/internal/data/ent/privacy"
+
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+)
+
+// MfaPolicyRepo MFA 策略仓储，每个租户至多一条，tenant_id=0 为平台策略。
+type MfaPolicyRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+}
+
+func NewMfaPolicyRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *MfaPolicyRepo {
+	return &MfaPolicyRepo{
+		entClient: entClient,
+		log:       ctx.NewLoggerHelper("mfa-policy/repo/admin-service"),
+	}
+}
+
+func (r *MfaPolicyRepo) toDTO(e *ent.MfaPolicy) *authenticationV1.MFAPolicy {
+	dto := &authenticationV1.MFAPolicy{
+		TenantId:          trans.Ptr(derefUint32(e.TenantID)),
+		Enforcement:       authenticationV1.MFAEnforcement_MFA_NOT_REQUIRED,
+		RequiredRoleCodes: e.RequiredRoleCodes,
+		UpdatedBy:         e.UpdatedBy,
+		UpdatedAt:         timeutil.TimeToTimestamppb(e.UpdatedAt),
+	}
+	if e.Enforcement != nil {
+		dto.Enforcement = toMFAEnforcement(*e.Enforcement)
+	}
+	return dto
+}
+
+func toMFAEnforcement(e mfapolicy.Enforcement) authenticationV1.MFAEnforcement {
+	switch e {
+	case mfapolicy.EnforcementRequired:
+		return authenticationV1.MFAEnforcement_MFA_REQUIRED
+	case mfapolicy.EnforcementOptional:
+		return authenticationV1.MFAEnforcement_MFA_OPTIONAL
+	}
+	return authenticationV1.MFAEnforcement_MFA_NOT_REQUIRED
+}
+
+func toMfaPolicyEnforcement(e authenticationV1.MFAEnforcement) mfapolicy.Enforcement {
+	switch e {
+	case authenticationV1.MFAEnforcement_MFA_REQUIRED:
+		return mfapolicy.EnforcementRequired
+	case authenticationV1.MFAEnforcement_MFA_OPTIONAL:
+		return mfapolicy.EnforcementOptional
+	}
+	return mfapolicy.EnforcementNotRequired
+}
+
+// Get 取租户策略；未配置时返回不要求 MFA 的默认策略。
+func (r *MfaPolicyRepo) Get(ctx context.Context, tenantID uint32) (*authenticationV1.MFAPolicy, error) {
+	entity, err := r.entClient.Client().MfaPolicy.Query().
+		Where(mfapolicy.TenantIDEQ(tenantID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return &authenticationV1.MFAPolicy{
+				TenantId:    trans.Ptr(tenantID),
+				Enforcement: authenticationV1.MFAEnforcement_MFA_NOT_REQUIRED,
+			}, nil
+		}
+		r.log.Errorf("query mfa policy failed: %s", err.Error())
+		return nil, authenticationV1.ErrorInternalServerError("query mfa policy failed")
+	}
+
+	return r.toDTO(entity), nil
+}
+
+// Save 创建或覆盖租户策略（按 tenant_id 唯一索引 upsert）。
+func (r *MfaPolicyRepo) Save(ctx context.Context, data *authenticationV1.MFAPolicy) error {
+	if data == nil {
+		return authenticationV1.ErrorBadRequest("invalid request")
+	}
+
+	now := time.Now()
+	enforcement := toMfaPolicyEnforcement(data.GetEnforcement())
+	roleCodes := data.GetRequiredRoleCodes()
+	if roleCodes == nil {
+		roleCodes = []string{}
+	}
+
+	err := r.entClient.Client().MfaPolicy.Create().
+		SetTenantID(data.GetTenantId()).
+		SetEnforcement(enforcement).
+		SetRequiredRoleCodes(roleCodes).
+		SetNillableCreatedBy(data.UpdatedBy).
+		SetNillableUpdatedBy(data.UpdatedBy).
+		SetCreatedAt(now).
+		SetUpdatedAt(now).
+		OnConflictColumns(mfapolicy.FieldTenantID).
+		// 冲突时取本次插入值（EXCLUDED）：JSON 列经 Set 直传会绕过序列化
+		Update(func(u *ent.MfaPolicyUpsert) {
+			u.UpdateEnforcement()
+			u.UpdateRequiredRoleCodes()
+			u.UpdateUpdatedAt()
+			if data.UpdatedBy != nil {
+				u.UpdateUpdatedBy()
+			}
+		}).
+		Exec(ctx)
+	if err != nil {
+		r.log.Errorf("save mfa policy failed: %s", err.Error())
+		return authenticationV1.ErrorInternalServerError("save mfa policy failed")
+	}
+
+	return nil
+}
+
+// Resolve 计算用户在平台策略与所在租户策略下的 MFA 要求，取较严格者：
+// 任一策略为 REQUIRED，或用户持有任一策略列出的角色，即为 MFA_REQUIRED。
+// 平台策略对租户用户不可见（租户隐私过滤），且登录流程无 viewer，这里以 privacy.Allow 显式按租户查询。
+func (r *MfaPolicyRepo) Resolve(ctx context.Context, tenantID uint32, roleCodes []string) (authenticationV1.MFAEnforcement, error) {
+	entities, err := r.entClient.Client().MfaPolicy.Query().
+		Where(mfapolicy.TenantIDIn(0, tenantID)).
+		All(privacy.DecisionContext(ctx, privacy.Allow))
+	if err != nil {
+		r.log.Errorf("query mfa policies failed: %s", err.Error())
+		return authenticationV1.MFAEnforcement_MFA_NOT_REQUIRED, authenticationV1.ErrorInternalServerError("query mfa policy failed")
+	}
+
+	held := make(map[string]bool, len(roleCodes))
+	for _, code := range roleCodes {
+		held[code] = true
+	}
+
+	result := authenticationV1.MFAEnforcement_MFA_NOT_REQUIRED
+	for _, e := range entities {
+		enforcement := authenticationV1.MFAEnforcement_MFA_NOT_REQUIRED
+		if e.Enforcement != nil {
+			enforcement = toMFAEnforcement(*e.Enforcement)
+		}
+		for _, code := range e.RequiredRoleCodes {
+			if held[code] {
+				enforcement = authenticationV1.MFAEnforcement_MFA_REQUIRED
+				break
+			}
+		}
+		if enforcement > result {
+			result = enforcement
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,85 @@
+package data
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tx7do/go-crud/viewer"
+	"github.com/tx7do/go-utils/trans"
+	conf "github.com/tx7do/kratos-bootstrap/api/gen/go/conf/v1"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"go-wind-admin/app/admin/service/internal/data/enttest"
+
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+
+	"go-wind-admin/pkg/constants"
+)
+
+func TestMfaPolicyRepo_Resolve(t *testing.T) {
+	entClient := enttest.NewEntClientForTest(t)
+	bctx := bootstrap.NewContextWithParam(context.Background(), &conf.AppInfo{}, &conf.Bootstrap{}, log.DefaultLogger)
+	ctx := enttest.NewSystemViewerCtx(context.Background())
+	repo := NewMfaPolicyRepo(bctx, entClient)
+
+	const tenantID = 9131
+
+	// 未配置：默认不要求
+	policy, err := repo.Get(ctx, tenantID)
+	require.NoError(t, err)
+	assert.Equal(t, authenticationV1.MFAEnforcement_MFA_NOT_REQUIRED, policy.GetEnforcement())
+
+	require.NoError(t, repo.Save(ctx, &authenticationV1.MFAPolicy{
+		TenantId:          trans.Ptr(uint32(0)),
+		Enforcement:       authenticationV1.MFAEnforcement_MFA_NOT_REQUIRED,
+		RequiredRoleCodes: []string{constants.PlatformAdminRoleCode, constants.TenantAdminRoleCode},
+	}))
+	require.NoError(t, repo.Save(ctx, &authenticationV1.MFAPolicy{
+		TenantId:    trans.Ptr(uint32(tenantID)),
+		Enforcement: authenticationV1.MFAEnforcement_MFA_OPTIONAL,
+		UpdatedBy:   trans.Ptr(uint32(1)),
+	}))
+	t.Cleanup(func() {
+		_ = repo.Save(ctx, &authenticationV1.MFAPolicy{TenantId: trans.Ptr(uint32(0))})
+	})
+
+	// 登录流程无租户 viewer：平台策略与租户策略都应可见
+	loginCtx := viewer.WithContext(context.Background(), viewer.NewNoopContext())
+
+	enforcement, err := repo.Resolve(loginCtx, tenantID, []string{"tenant:staff"})
+	require.NoError(t, err)
+	assert.Equal(t, authenticationV1.MFAEnforcement_MFA_OPTIONAL, enforcement)
+
+	// 平台策略列出的角色在任一租户都强制
+	enforcement, err = repo.Resolve(loginCtx, tenantID, []string{constants.TenantAdminRoleCode})
+	require.NoError(t, err)
+	assert.Equal(t, authenticationV1.MFAEnforcement_MFA_REQUIRED, enforcement)
+
+	enforcement, err = repo.Resolve(loginCtx, 0, []string{constants.PlatformAdminRoleCode})
+	require.NoError(t, err)
+	assert.Equal(t, authenticationV1.MFAEnforcement_MFA_REQUIRED, enforcement)
+
+	// 其他租户不受本租户策略影响
+	enforcement, err = repo.Resolve(loginCtx, tenantID+1, nil)
+	require.NoError(t, err)
+	assert.Equal(t, authenticationV1.MFAEnforcement_MFA_NOT_REQUIRED, enforcement)
+
+	// 再次保存覆盖原策略
+	require.NoError(t, repo.Save(ctx, &authenticationV1.MFAPolicy{
+		TenantId:    trans.Ptr(uint32(tenantID)),
+		Enforcement: authenticationV1.MFAEnforcement_MFA_REQUIRED,
+		UpdatedBy:   trans.Ptr(uint32(2)),
+	}))
+	policy, err = repo.Get(ctx, tenantID)
+	require.NoError(t, err)
+	assert.Equal(t, authenticationV1.MFAEnforcement_MFA_REQUIRED, policy.GetEnforcement())
+	assert.Empty(t, policy.GetRequiredRoleCodes())
+	assert.Equal(t, uint32(2), policy.GetUpdatedBy())
+
+	enforcement, err = repo.Resolve(loginCtx, tenantID, nil)
+	require.NoError(t, err)
+	assert.Equal(t, authenticationV1.MFAEnforcement_MFA_REQUIRED, enforcement)
+}
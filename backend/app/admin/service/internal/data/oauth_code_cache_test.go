@@ -0,0 +1,52 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+
+	conf "github.com/tx7do/kratos-bootstrap/api/gen/go/conf/v1"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+)
+
+func TestOAuthCodeCache_IssueAndConsumeOnce(t *testing.T) {
+	mr, err := miniredis.Run()
+	assert.NoError(t, err)
+	defer mr.Close()
+
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	ctx := context.Background()
+	bctx := bootstrap.NewContextWithParam(ctx, &conf.AppInfo{}, &conf.Bootstrap{}, log.DefaultLogger)
+	cache := NewOAuthCodeCache(bctx, rdb)
+
+	grant := &OAuthCodeGrant{
+		ClientID:            "gwa_test",
+		RedirectURI:         "https://tools.example.com/cb",
+		Scopes:              []string{"openid", "opm:read"},
+		CodeChallenge:       "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM",
+		CodeChallengeMethod: "S256",
+		UserID:              12,
+		TenantID:            3,
+	}
+
+	code, err := cache.Issue(ctx, grant)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, code)
+	assert.Equal(t, OAuthCodeTTL, mr.TTL("oauth:code:"+code))
+
+	got, err := cache.Consume(ctx, code)
+	assert.NoError(t, err)
+	assert.Equal(t, grant, got)
+
+	// 授权码一次性有效：第二次换取失败
+	_, err = cache.Consume(ctx, code)
+	assert.True(t, errors.Is(err, ErrOAuthCodeNotFound))
+
+	_, err = cache.Consume(ctx, "")
+	assert.True(t, errors.Is(err, ErrOAuthCodeNotFound))
+}
@@ -0,0 +1,409 @@
+package data
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/go-utils/timeutil"
+	"github.com/tx7do/go-utils/trans"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	paginationV1 "github.com/tx7do/go-crud/api/gen/go/pagination/v1"
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"go-wind-admin/app/admin/service/internal/data/ent"
+	"go-wind-admin/app/admin/service/internal/data/ent/oauthproviderconfig"
+
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+
+	"go-wind-admin/pkg/crypto"
+	"go-wind-admin/pkg/oauth/social"
+)
+
+// oauthProviderPresets OAuthProvider 枚举与 social.Registry 预置提供方的对应关系。
+// 通用 OIDC 提供方以 OAUTH_PROVIDER_UNSPECIFIED + provider_custom 表示。
+var oauthProviderPresets = map[authenticationV1.OAuthProvider]string{
+	authenticationV1.OAuthProvider_GITHUB:    social.ProviderGitHub,
+	authenticationV1.OAuthProvider_GOOGLE:    social.ProviderGoogle,
+	authenticationV1.OAuthProvider_MICROSOFT: social.ProviderMicrosoft,
+	authenticationV1.OAuthProvider_DINGTALK:  social.ProviderDingTalk,
+	authenticationV1.OAuthProvider_WECHAT:    social.ProviderWeChat,
+}
+
+// OAuthProviderPreset 将请求中的 provider/provider_custom 解析为预置提供方名称与自定义标识。
+// 预置提供方忽略 provider_custom；通用 OIDC 必须携带 provider_custom。
+func OAuthProviderPreset(provider authenticationV1.OAuthProvider, custom string) (preset, providerCustom string, ok bool) {
+	if provider == authenticationV1.OAuthProvider_OAUTH_PROVIDER_UNSPECIFIED {
+		custom = strings.ToLower(strings.TrimSpace(custom))
+		if custom == "" {
+			return "", "", false
+		}
+		return social.ProviderOIDC, custom, true
+	}
+
+	preset, ok = oauthProviderPresets[provider]
+	return preset, "", ok
+}
+
+// OAuthProviderEnum 预置提供方名称反查 OAuthProvider 枚举，通用 OIDC 返回 UNSPECIFIED。
+func OAuthProviderEnum(preset string) authenticationV1.OAuthProvider {
+	for k, v := range oauthProviderPresets {
+		if v == preset {
+			return k
+		}
+	}
+	return authenticationV1.OAuthProvider_OAUTH_PROVIDER_UNSPECIFIED
+}
+
+// OAuthAccountProvider 第三方账号在 user_credential.provider 中的取值：
+// 预置提供方为其名称（如 github），通用 OIDC 为 oidc:<provider_custom>。
+func OAuthAccountProvider(preset, providerCustom string) string {
+	if providerCustom == "" {
+		return preset
+	}
+	return preset + ":" + providerCustom
+}
+
+// OAuthProviderSettings 登录/绑定流程使用的提供方配置（含解密后的客户端密钥）。
+type OAuthProviderSettings struct {
+	ID             uint32
+	TenantID       uint32
+	Provider       string
+	ProviderCustom string
+	DisplayName    string
+
+	Config social.Config
+
+	AutoProvision  bool
+	DefaultRoleIDs []uint32
+}
+
+// AccountProvider 见 OAuthAccountProvider。
+func (s *OAuthProviderSettings) AccountProvider() string {
+	return OAuthAccountProvider(s.Provider, s.ProviderCustom)
+}
+
+// OAuthProviderConfigRepo 第三方登录提供方配置仓储。
+// client_secret 经 crypto.EncryptIfNeeded 加密落库，对外 DTO 永不回显，只以 client_secret_set 标识是否已配置。
+type OAuthProviderConfigRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+}
+
+func NewOAuthProviderConfigRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *OAuthProviderConfigRepo {
+	return &OAuthProviderConfigRepo{
+		entClient: entClient,
+		log:       ctx.NewLoggerHelper("oauth-provider-config/repo/admin-service"),
+	}
+}
+
+func (r *OAuthProviderConfigRepo) toDTO(e *ent.OAuthProviderConfig) *authenticationV1.OAuthProviderConfig {
+	dto := &authenticationV1.OAuthProviderConfig{
+		Id:              trans.Ptr(e.ID),
+		Provider:        OAuthProviderEnum(derefStr(e.Provider)).Enum(),
+		ProviderCustom:  trans.Ptr(e.ProviderCustom),
+		DisplayName:     e.DisplayName,
+		ClientId:        e.ClientID,
+		AuthUrl:         e.AuthURL,
+		TokenUrl:        e.TokenURL,
+		UserinfoUrl:     e.UserinfoURL,
+		RedirectUri:     e.RedirectURI,
+		Scopes:          e.Scopes,
+		AutoProvision:   e.AutoProvision,
+		DefaultRoleIds:  e.DefaultRoleIds,
+		SortOrder:       e.SortOrder,
+		ClientSecretSet: trans.Ptr(derefStr(e.ClientSecret) != ""),
+		TenantId:        e.TenantID,
+		CreatedBy:       e.CreatedBy,
+		UpdatedBy:       e.UpdatedBy,
+		CreatedAt:       timeutil.TimeToTimestamppb(e.CreatedAt),
+		UpdatedAt:       timeutil.TimeToTimestamppb(e.UpdatedAt),
+	}
+	if e.Status != nil && *e.Status == oauthproviderconfig.StatusOn {
+		dto.Status = authenticationV1.OAuthProviderConfig_ON.Enum()
+	} else {
+		dto.Status = authenticationV1.OAuthProviderConfig_OFF.Enum()
+	}
+	return dto
+}
+
+func toOAuthProviderConfigStatus(status *authenticationV1.OAuthProviderConfig_Status) *oauthproviderconfig.Status {
+	if status == nil {
+		return nil
+	}
+	if *status == authenticationV1.OAuthProviderConfig_ON {
+		return trans.Ptr(oauthproviderconfig.StatusOn)
+	}
+	return trans.Ptr(oauthproviderconfig.StatusOff)
+}
+
+// encryptSecret 加密客户端密钥；未配置 GOWIND_CRYPTO_KEY 时原样落库并告警。
+func (r *OAuthProviderConfigRepo) encryptSecret(secret string) (string, error) {
+	encrypted, err := crypto.EncryptIfNeeded(secret)
+	if err != nil {
+		r.log.Errorf("encrypt oauth client secret failed: %s", err.Error())
+		return "", authenticationV1.ErrorInternalServerError("encrypt client secret failed")
+	}
+	if !crypto.IsEncrypted(encrypted) {
+		r.log.Warn("GOWIND_CRYPTO_KEY 未配置，第三方登录客户端密钥将以明文落库")
+	}
+	return encrypted, nil
+}
+
+func (r *OAuthProviderConfigRepo) List(ctx context.Context, req *paginationV1.PagingRequest) (*authenticationV1.ListOAuthProviderConfigResponse, error) {
+	if req == nil {
+		return nil, authenticationV1.ErrorBadRequest("invalid parameter")
+	}
+
+	builder := r.entClient.Client().OAuthProviderConfig.Query()
+
+	total, err := builder.Clone().Count(ctx)
+	if err != nil {
+		r.log.Errorf("query count failed: %s", err.Error())
+		return nil, authenticationV1.ErrorInternalServerError("query count failed")
+	}
+
+	builder.Order(ent.Asc(oauthproviderconfig.FieldSortOrder), ent.Asc(oauthproviderconfig.FieldID))
+	if !req.GetNoPaging() && req.GetPageSize() > 0 {
+		page := max(req.GetPage(), 1)
+		builder.Offset(int((page - 1) * req.GetPageSize())).Limit(int(req.GetPageSize()))
+	}
+
+	entities, err := builder.All(ctx)
+	if err != nil {
+		r.log.Errorf("query list failed: %s", err.Error())
+		return nil, authenticationV1.ErrorInternalServerError("query list failed")
+	}
+
+	items := make([]*authenticationV1.OAuthProviderConfig, 0, len(entities))
+	for _, e := range entities {
+		items = append(items, r.toDTO(e))
+	}
+
+	return &authenticationV1.ListOAuthProviderConfigResponse{
+		Total: uint64(total),
+		Items: items,
+	}, nil
+}
+
+func (r *OAuthProviderConfigRepo) IsExist(ctx context.Context, id uint32) (bool, error) {
+	exist, err := r.entClient.Client().OAuthProviderConfig.Query().
+		Where(oauthproviderconfig.IDEQ(id)).
+		Exist(ctx)
+	if err != nil {
+		r.log.Errorf("query exist failed: %s", err.Error())
+		return false, authenticationV1.ErrorInternalServerError("query exist failed")
+	}
+	return exist, nil
+}
+
+func (r *OAuthProviderConfigRepo) Get(ctx context.Context, id uint32) (*authenticationV1.OAuthProviderConfig, error) {
+	entity, err := r.entClient.Client().OAuthProviderConfig.Query().
+		Where(oauthproviderconfig.IDEQ(id)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, authenticationV1.ErrorNotFound("oauth provider config not found")
+		}
+		r.log.Errorf("query oauth provider config failed: %s", err.Error())
+		return nil, authenticationV1.ErrorInternalServerError("query oauth provider config failed")
+	}
+
+	return r.toDTO(entity), nil
+}
+
+// Create 创建提供方配置。provider/provider_custom 须已由调用方经 OAuthProviderPreset 规范化。
+func (r *OAuthProviderConfigRepo) Create(ctx context.Context, preset, providerCustom string, data *authenticationV1.OAuthProviderConfig) error {
+	if data == nil {
+		return authenticationV1.ErrorBadRequest("invalid request")
+	}
+
+	builder := r.entClient.Client().OAuthProviderConfig.Create().
+		SetNillableTenantID(data.TenantId).
+		SetProvider(preset).
+		SetProviderCustom(providerCustom).
+		SetNillableDisplayName(data.DisplayName).
+		SetNillableClientID(data.ClientId).
+		SetNillableAuthURL(data.AuthUrl).
+		SetNillableTokenURL(data.TokenUrl).
+		SetNillableUserinfoURL(data.UserinfoUrl).
+		SetNillableRedirectURI(data.RedirectUri).
+		SetScopes(data.GetScopes()).
+		SetNillableAutoProvision(data.AutoProvision).
+		SetDefaultRoleIds(data.GetDefaultRoleIds()).
+		SetNillableStatus(toOAuthProviderConfigStatus(data.Status)).
+		SetNillableSortOrder(data.SortOrder).
+		SetNillableCreatedBy(data.CreatedBy).
+		SetCreatedAt(time.Now())
+
+	if data.GetClientSecret() != "" {
+		secret, err := r.encryptSecret(data.GetClientSecret())
+		if err != nil {
+			return err
+		}
+		builder.SetClientSecret(secret)
+	}
+
+	if err := builder.Exec(ctx); err != nil {
+		if ent.IsConstraintError(err) {
+			return authenticationV1.ErrorBadRequest("oauth provider already configured")
+		}
+		r.log.Errorf("insert oauth provider config failed: %s", err.Error())
+		return authenticationV1.ErrorInternalServerError("insert oauth provider config failed")
+	}
+
+	return nil
+}
+
+// Update 更新提供方配置：只更新请求中携带的字段；provider 创建后不可修改，client_secret 留空表示不修改。
+func (r *OAuthProviderConfigRepo) Update(ctx context.Context, id uint32, data *authenticationV1.OAuthProviderConfig) error {
+	if id == 0 || data == nil {
+		return authenticationV1.ErrorBadRequest("invalid request")
+	}
+
+	builder := r.entClient.Client().OAuthProviderConfig.Update().
+		Where(oauthproviderconfig.IDEQ(id)).
+		SetNillableDisplayName(data.DisplayName).
+		SetNillableClientID(data.ClientId).
+		SetNillableAuthURL(data.AuthUrl).
+		SetNillableTokenURL(data.TokenUrl).
+		SetNillableUserinfoURL(data.UserinfoUrl).
+		SetNillableRedirectURI(data.RedirectUri).
+		SetNillableAutoProvision(data.AutoProvision).
+		SetNillableStatus(toOAuthProviderConfigStatus(data.Status)).
+		SetNillableSortOrder(data.SortOrder).
+		SetNillableUpdatedBy(data.UpdatedBy).
+		SetUpdatedAt(time.Now())
+	// 租户范围过滤：隐私层只过滤查询，更新需显式限定，防止按 ID 越权修改其他租户配置
+	if tid, hasTenant := maybeTenantFromViewer(ctx); hasTenant {
+		builder.Where(oauthproviderconfig.TenantIDEQ(tid))
+	}
+	if data.Scopes != nil {
+		builder.SetScopes(data.GetScopes())
+	}
+	if data.DefaultRoleIds != nil {
+		builder.SetDefaultRoleIds(data.GetDefaultRoleIds())
+	}
+	if data.GetClientSecret() != "" {
+		secret, err := r.encryptSecret(data.GetClientSecret())
+		if err != nil {
+			return err
+		}
+		builder.SetClientSecret(secret)
+	}
+
+	affected, err := builder.Save(ctx)
+	if err != nil {
+		r.log.Errorf("update oauth provider config failed: %s", err.Error())
+		return authenticationV1.ErrorInternalServerError("update oauth provider config failed")
+	}
+	if affected == 0 {
+		return authenticationV1.ErrorNotFound("oauth provider config not found")
+	}
+
+	return nil
+}
+
+func (r *OAuthProviderConfigRepo) Delete(ctx context.Context, id uint32) error {
+	if id == 0 {
+		return authenticationV1.ErrorBadRequest("invalid parameter")
+	}
+
+	builder := r.entClient.Client().OAuthProviderConfig.Delete().
+		Where(oauthproviderconfig.IDEQ(id))
+	// 租户范围过滤（平台管理员 tenant_id=0 不限定）
+	if tid, hasTenant := maybeTenantFromViewer(ctx); hasTenant {
+		builder.Where(oauthproviderconfig.TenantIDEQ(tid))
+	}
+	affected, err := builder.Exec(ctx)
+	if err != nil {
+		r.log.Errorf("delete oauth provider config failed: %s", err.Error())
+		return authenticationV1.ErrorInternalServerError("delete oauth provider config failed")
+	}
+	if affected == 0 {
+		return authenticationV1.ErrorNotFound("oauth provider config not found")
+	}
+
+	return nil
+}
+
+// ListEnabled 列出租户已启用的提供方（登录页用），按排序号升序。
+// 登录流程无租户 viewer，显式按 tenantID 过滤。
+func (r *OAuthProviderConfigRepo) ListEnabled(ctx context.Context, tenantID uint32) ([]*OAuthProviderSettings, error) {
+	entities, err := r.entClient.Client().OAuthProviderConfig.Query().
+		Where(
+			oauthproviderconfig.TenantIDEQ(tenantID),
+			oauthproviderconfig.StatusEQ(oauthproviderconfig.StatusOn),
+		).
+		Order(ent.Asc(oauthproviderconfig.FieldSortOrder), ent.Asc(oauthproviderconfig.FieldID)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list enabled oauth providers failed: %s", err.Error())
+		return nil, authenticationV1.ErrorInternalServerError("list oauth providers failed")
+	}
+
+	out := make([]*OAuthProviderSettings, 0, len(entities))
+	for _, e := range entities {
+		settings, serr := r.toSettings(e)
+		if serr != nil {
+			r.log.Errorf("restore oauth provider config [%d] failed: %s", e.ID, serr.Error())
+			continue
+		}
+		out = append(out, settings)
+	}
+	return out, nil
+}
+
+// GetEnabled 取租户下指定提供方的已启用配置；未配置或已禁用返回 NotFound。
+func (r *OAuthProviderConfigRepo) GetEnabled(ctx context.Context, tenantID uint32, preset, providerCustom string) (*OAuthProviderSettings, error) {
+	entity, err := r.entClient.Client().OAuthProviderConfig.Query().
+		Where(
+			oauthproviderconfig.TenantIDEQ(tenantID),
+			oauthproviderconfig.ProviderEQ(preset),
+			oauthproviderconfig.ProviderCustomEQ(providerCustom),
+			oauthproviderconfig.StatusEQ(oauthproviderconfig.StatusOn),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, authenticationV1.ErrorNotFound("oauth provider not configured")
+		}
+		r.log.Errorf("query oauth provider config failed: %s", err.Error())
+		return nil, authenticationV1.ErrorInternalServerError("query oauth provider config failed")
+	}
+
+	settings, err := r.toSettings(entity)
+	if err != nil {
+		r.log.Errorf("restore oauth provider config [%d] failed: %s", entity.ID, err.Error())
+		return nil, authenticationV1.ErrorInternalServerError("oauth provider config invalid")
+	}
+	return settings, nil
+}
+
+func (r *OAuthProviderConfigRepo) toSettings(e *ent.OAuthProviderConfig) (*OAuthProviderSettings, error) {
+	secret, err := crypto.DecryptIfNeeded(derefStr(e.ClientSecret))
+	if err != nil {
+		return nil, err
+	}
+
+	return &OAuthProviderSettings{
+		ID:             e.ID,
+		TenantID:       derefUint32(e.TenantID),
+		Provider:       derefStr(e.Provider),
+		ProviderCustom: e.ProviderCustom,
+		DisplayName:    derefStr(e.DisplayName),
+		Config: social.Config{
+			ClientID:     derefStr(e.ClientID),
+			ClientSecret: secret,
+			AuthURL:      derefStr(e.AuthURL),
+			TokenURL:     derefStr(e.TokenURL),
+			UserInfoURL:  derefStr(e.UserinfoURL),
+			RedirectURL:  derefStr(e.RedirectURI),
+			Scopes:       e.Scopes,
+		},
+		AutoProvision:  e.AutoProvision != nil && *e.AutoProvision,
+		DefaultRoleIDs: e.DefaultRoleIds,
+	}, nil
+}
@@ -0,0 +1,69 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+
+	conf "github.com/tx7do/kratos-bootstrap/api/gen/go/conf/v1"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+)
+
+func TestOAuthStateCache_IssueAndConsumeOnce(t *testing.T) {
+	mr, err := miniredis.Run()
+	assert.NoError(t, err)
+	defer mr.Close()
+
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	ctx := context.Background()
+	bctx := bootstrap.NewContextWithParam(ctx, &conf.AppInfo{}, &conf.Bootstrap{}, log.DefaultLogger)
+	cache := NewOAuthStateCache(bctx, rdb)
+
+	st := &OAuthState{
+		Purpose:      OAuthStatePurposeLink,
+		TenantID:     3,
+		Provider:     "github",
+		RedirectURI:  "https://admin.example.com/oauth/callback",
+		CodeVerifier: "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk",
+		UserID:       12,
+	}
+
+	state, expiresAt, err := cache.Issue(ctx, st)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, state)
+	assert.False(t, expiresAt.IsZero())
+	assert.Equal(t, OAuthStateTTL, mr.TTL("oauth:state:"+state))
+
+	got, err := cache.Consume(ctx, state, OAuthStatePurposeLink)
+	assert.NoError(t, err)
+	assert.Equal(t, st, got)
+
+	// state 一次性有效：重放失败
+	_, err = cache.Consume(ctx, state, OAuthStatePurposeLink)
+	assert.True(t, errors.Is(err, ErrOAuthStateNotFound))
+}
+
+func TestOAuthStateCache_PurposeMismatch(t *testing.T) {
+	mr, err := miniredis.Run()
+	assert.NoError(t, err)
+	defer mr.Close()
+
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	ctx := context.Background()
+	bctx := bootstrap.NewContextWithParam(ctx, &conf.AppInfo{}, &conf.Bootstrap{}, log.DefaultLogger)
+	cache := NewOAuthStateCache(bctx, rdb)
+
+	state, _, err := cache.Issue(ctx, &OAuthState{Purpose: OAuthStatePurposeLink, UserID: 12})
+	assert.NoError(t, err)
+
+	// 绑定流程的 state 不能用于登录，且取出后即作废
+	_, err = cache.Consume(ctx, state, OAuthStatePurposeLogin)
+	assert.True(t, errors.Is(err, ErrOAuthStateNotFound))
+	_, err = cache.Consume(ctx, state, OAuthStatePurposeLink)
+	assert.True(t, errors.Is(err, ErrOAuthStateNotFound))
+}
@@ -153,6 +153,8 @@ func (r *OperationAuditLogRepo) Create(ctx context.Context, req *auditV1.CreateO
 		SetNillableTenantID(req.Data.TenantId).
 		SetNillableUserID(req.Data.UserId).
 		SetNillableUsername(req.Data.Username).
+		SetNillableImpersonatorID(req.Data.ImpersonatorId).
+		SetNillableImpersonatorName(req.Data.ImpersonatorName).
 		SetNillableResourceType(req.Data.ResourceType).
 		SetNillableResourceID(req.Data.ResourceId).
 		SetNillableAction(r.actionTypeConverter.ToEntity(req.Data.Action)).
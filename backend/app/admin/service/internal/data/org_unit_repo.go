@@ -3,6 +3,8 @@
 import (
 	"context"
 	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -217,16 +219,16 @@ func (r *OrgUnitRepo) ListOrgUnitsByIds(ctx context.Context, ids []uint32) ([]*i
 	return dtos, nil
 }
 
-func (r *OrgUnitRepo) Create(ctx context.Context, req *identityV1.CreateOrgUnitRequest) (err error) {
+func (r *OrgUnitRepo) Create(ctx context.Context, req *identityV1.CreateOrgUnitRequest) (dto *identityV1.OrgUnit, err error) {
 	if req == nil || req.Data == nil {
-		return identityV1.ErrorBadRequest("invalid parameter")
+		return nil, identityV1.ErrorBadRequest("invalid parameter")
 	}
 
 	var tx *ent.Tx
 	tx, err = r.entClient.Client().Tx(ctx)
 	if err != nil {
 		r.log.Errorf("start transaction failed: %s", err.Error())
-		return identityV1.ErrorInternalServerError("start transaction failed")
+		return nil, identityV1.ErrorInternalServerError("start transaction failed")
 	}
 	defer func() {
 		if err != nil {
@@ -285,14 +287,14 @@ func (r *OrgUnitRepo) Create(ctx context.Context, req *identityV1.CreateOrgUnitR
 	var entity *ent.OrgUnit
 	if entity, err = builder.Save(ctx); err != nil {
 		r.log.Errorf("insert org unit failed: %s", err.Error())
-		return identityV1.ErrorInternalServerError("insert org unit failed")
+		return nil, identityV1.ErrorInternalServerError("insert org unit failed")
 	}
 
 	if err = r.setTreePath(ctx, tx, entity); err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	return r.mapper.ToDTO(entity), nil
 }
 
 func (r *OrgUnitRepo) Update(ctx context.Context, req *identityV1.UpdateOrgUnitRequest) error {
@@ -313,7 +315,8 @@ func (r *OrgUnitRepo) Update(ctx context.Context, req *identityV1.UpdateOrgUnitR
 			createReq := &identityV1.CreateOrgUnitRequest{Data: req.Data}
 			createReq.Data.CreatedBy = createReq.Data.UpdatedBy
 			createReq.Data.UpdatedBy = nil
-			return r.Create(ctx, createReq)
+			_, err = r.Create(ctx, createReq)
+			return err
 		}
 	}
 
@@ -360,8 +363,16 @@ func(s *sql.Selector) {
 			s.Where(sql.EQ(orgunit.FieldID, req.GetId()))
 		},
 	)
+	if err != nil {
+		return err
+	}
 
-	return err
+	// 上级变更后重算路径，数据权限的子树范围依赖路径前缀
+	if req.Data.ParentId != nil && (req.GetUpdateMask() == nil || hasPath(orgunit.FieldParentID, req.GetUpdateMask())) {
+		return r.rebuildTreePath(ctx, req.GetId())
+	}
+
+	return nil
 }
 
 func (r *OrgUnitRepo) Delete(ctx context.Context, req *identityV1.DeleteOrgUnitRequest) error {
@@ -396,9 +407,10 @@ func (r *OrgUnitRepo) Delete(ctx context.Context, req *identityV1.DeleteOrgUnitR
 	return nil
 }
 
+// setTreePath 计算并写入节点路径：/<祖先ID>/.../<自身ID>/，根节点为 /<自身ID>/，便于按前缀查询子树
 func (r *OrgUnitRepo) setTreePath(ctx context.Context, tx *ent.Tx, entity *ent.OrgUnit) (err error) {
-	var parentPath string
-	if entity.ParentID != nil {
+	parentPath := "/"
+	if entity.ParentID != nil && *entity.ParentID != 0 {
 		var parentEntity *ent.OrgUnit
 		parentEntity, err = tx.OrgUnit.Query().
 			Where(
@@ -409,7 +421,7 @@ func (r *OrgUnitRepo) setTreePath(ctx context.Context, tx *ent.Tx, entity *ent.O
 		if err != nil {
 			return err
 		} else {
-			if parentEntity.Path != nil {
+			if parentEntity.Path != nil && *parentEntity.Path != "" {
 				parentPath = *parentEntity.Path
 			}
 		}
@@ -420,3 +432,182 @@ func (r *OrgUnitRepo) setTreePath(ctx context.Context, tx *ent.Tx, entity *ent.O
 
 	return err
 }
+
+// rebuildTreePath 重算节点路径，并将全部下级节点路径中的旧前缀替换为新路径
+func (r *OrgUnitRepo) rebuildTreePath(ctx context.Context, id uint32) (err error) {
+	var tx *ent.Tx
+	tx, err = r.entClient.Client().Tx(ctx)
+	if err != nil {
+		r.log.Errorf("start transaction failed: %s", err.Error())
+		return identityV1.ErrorInternalServerError("start transaction failed")
+	}
+	defer func() {
+		if err != nil {
+			if rollbackErr := tx.Rollback(); rollbackErr != nil {
+				r.log.Errorf("transaction rollback failed: %s", rollbackErr.Error())
+			}
+			return
+		}
+		if commitErr := tx.Commit(); commitErr != nil {
+			r.log.Errorf("transaction commit failed: %s", commitErr.Error())
+			err = identityV1.ErrorInternalServerError("transaction commit failed")
+		}
+	}()
+
+	var entity *ent.OrgUnit
+	if entity, err = tx.OrgUnit.Query().
+		Where(orgunit.IDEQ(id)).
+		Select(orgunit.FieldID, orgunit.FieldParentID, orgunit.FieldPath).
+		Only(ctx); err != nil {
+		r.log.Errorf("query org unit [%d] failed: %s", id, err.Error())
+		return identityV1.ErrorInternalServerError("query org unit failed")
+	}
+
+	if err = r.setTreePath(ctx, tx, entity); err != nil {
+		r.log.Errorf("set org unit [%d] path failed: %s", id, err.Error())
+		return identityV1.ErrorInternalServerError("update org unit path failed")
+	}
+
+	// 旧路径不规范（历史数据）时无法按前缀定位下级，仅更新自身
+	if entity.Path == nil || !isOrgUnitTreePath(*entity.Path, id) {
+		return nil
+	}
+	oldPath := *entity.Path
+
+	var updated *ent.OrgUnit
+	if updated, err = tx.OrgUnit.Query().
+		Where(orgunit.IDEQ(id)).
+		Select(orgunit.FieldPath).
+		Only(ctx); err != nil {
+		r.log.Errorf("query org unit [%d] failed: %s", id, err.Error())
+		return identityV1.ErrorInternalServerError("query org unit failed")
+	}
+	newPath := *updated.Path
+	if newPath == oldPath {
+		return nil
+	}
+
+	var descendants []*ent.OrgUnit
+	if descendants, err = tx.OrgUnit.Query().
+		Where(
+			orgunit.PathHasPrefix(oldPath),
+			orgunit.IDNEQ(id),
+		).
+		Select(orgunit.FieldID, orgunit.FieldPath).
+		All(ctx); err != nil {
+		r.log.Errorf("query org unit [%d] descendants failed: %s", id, err.Error())
+		return identityV1.ErrorInternalServerError("query org unit failed")
+	}
+	for _, d := range descendants {
+		if err = tx.OrgUnit.UpdateOneID(d.ID).
+			SetPath(newPath + strings.TrimPrefix(*d.Path, oldPath)).
+			Exec(ctx); err != nil {
+			r.log.Errorf("update org unit [%d] path failed: %s", d.ID, err.Error())
+			return identityV1.ErrorInternalServerError("update org unit path failed")
+		}
+	}
+
+	return nil
+}
+
+// isOrgUnitTreePath 路径是否为以自身ID结尾的规范路径（/1/2/3/）
+func isOrgUnitTreePath(path string, id uint32) bool {
+	return strings.HasPrefix(path, "/") && strings.HasSuffix(path, "/"+strconv.FormatUint(uint64(id), 10)+"/")
+}
+
+// queryOrgUnitSubtree 查询组织单元及其全部下级的 ID。
+// 路径规范的节点按路径前缀一次查出子树；历史数据路径不规范时沿 parent_id 逐层下探。
+func queryOrgUnitSubtree(ctx context.Context, client *ent.Client, ids []uint32) ([]uint32, error) {
+	roots, err := client.OrgUnit.Query().
+		Where(orgunit.IDIn(ids...)).
+		Select(orgunit.FieldID, orgunit.FieldPath).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	visited := make(map[uint32]struct{}, len(roots))
+	var pending []uint32
+	for _, root := range roots {
+		visited[root.ID] = struct{}{}
+
+		if root.Path == nil || !isOrgUnitTreePath(*root.Path, root.ID) {
+			pending = append(pending, root.ID)
+			continue
+		}
+
+		var subIDs []uint32
+		if subIDs, err = client.OrgUnit.Query().
+			Where(orgunit.PathHasPrefix(*root.Path)).
+			IDs(ctx); err != nil {
+			return nil, err
+		}
+		for _, id := range subIDs {
+			visited[id] = struct{}{}
+		}
+	}
+
+	for len(pending) > 0 {
+		var children []uint32
+		if children, err = client.OrgUnit.Query().
+			Where(orgunit.ParentIDIn(pending...)).
+			IDs(ctx); err != nil {
+			return nil, err
+		}
+
+		pending = nil
+		for _, id := range children {
+			if _, ok := visited[id]; !ok {
+				visited[id] = struct{}{}
+				pending = append(pending, id)
+			}
+		}
+	}
+
+	result := make([]uint32, 0, len(visited))
+	for id := range visited {
+		result = append(result, id)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i] < result[j] })
+
+	return result, nil
+}
+
+// queryOrgUnitAncestry 查询组织单元及其全部上级（沿 parent_id 逐层上溯，每层一次查询），返回 ID → 组织单元。
+// 只取 ID、上级 ID 与时区，供登录策略的组织单元定向与时区判定使用；不存在的 ID 直接忽略。
+func queryOrgUnitAncestry(ctx context.Context, client *ent.Client, ids []uint32) (map[uint32]*ent.OrgUnit, error) {
+	units := make(map[uint32]*ent.OrgUnit, len(ids))
+	visited := make(map[uint32]struct{}, len(ids))
+
+	var pending []uint32
+	for _, id := range ids {
+		if _, ok := visited[id]; !ok && id != 0 {
+			visited[id] = struct{}{}
+			pending = append(pending, id)
+		}
+	}
+
+	for len(pending) > 0 {
+		entities, err := client.OrgUnit.Query().
+			Where(orgunit.IDIn(pending...)).
+			Select(orgunit.FieldID, orgunit.FieldParentID, orgunit.FieldTimezone).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		pending = nil
+		for _, e := range entities {
+			units[e.ID] = e
+			if e.ParentID == nil || *e.ParentID == 0 {
+				continue
+			}
+			if _, ok := visited[*e.ParentID]; !ok {
+				visited[*e.ParentID] = struct{}{}
+				pending = append(pending, *e.ParentID)
+			}
+		}
+	}
+
+	return units, nil
+}
@@ -0,0 +1,234 @@
+package data
+
+import (
+	"context"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/go-utils/timeutil"
+	"github.com/tx7do/go-utils/trans"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"go-wind-admin/app/admin/service/internal/data/ent"
+	"go-wind-admin/app/admin/service/internal/data/ent/passwordpolicy"
+	"go-wind-admin/app/admin/service/internal/data/ent/privacy"
+	"go-wind-admin/app/admin/service/internal/data/ent/tenant"
+
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+
+	pwpolicy "go-wind-admin/pkg/passwordpolicy"
+)
+
+// PasswordPolicyRepo 密码策略仓储，每个租户至多一条，tenant_id=0 为平台策略。
+//
+// 泄露密码库读取环境变量 GWA_AUTH_BREACHED_PASSWORDS_FILE（每行一个 SHA-1，格式见 passwordpolicy.BreachedList），
+// 未配置时策略中的 check_breached 不生效。
+type PasswordPolicyRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+
+	breached *pwpolicy.BreachedList
+}
+
+func NewPasswordPolicyRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *PasswordPolicyRepo {
+	repo := &PasswordPolicyRepo{
+		entClient: entClient,
+		log:       ctx.NewLoggerHelper("password-policy/repo/admin-service"),
+	}
+
+	if path := os.Getenv("GWA_AUTH_BREACHED_PASSWORDS_FILE"); path != "" {
+		list, err := pwpolicy.LoadBreachedList(path)
+		if err != nil {
+			repo.log.Errorf("load breached password list failed: %s", err.Error())
+		} else {
+			repo.log.Infof("loaded %d breached password hashes", list.Len())
+			repo.breached = list
+		}
+	}
+
+	return repo
+}
+
+func (r *PasswordPolicyRepo) toDTO(e *ent.PasswordPolicy) *authenticationV1.PasswordPolicy {
+	return &authenticationV1.PasswordPolicy{
+		TenantId:         trans.Ptr(derefUint32(e.TenantID)),
+		MinLength:        derefUint32(e.MinLength),
+		RequireUppercase: derefBool(e.RequireUppercase),
+		RequireLowercase: derefBool(e.RequireLowercase),
+		RequireDigit:     derefBool(e.RequireDigit),
+		RequireSymbol:    derefBool(e.RequireSymbol),
+		DisallowIdentity: derefBool(e.DisallowIdentity),
+		BannedSubstrings: e.BannedSubstrings,
+		HistoryCount:     derefUint32(e.HistoryCount),
+		MaxAgeDays:       derefUint32(e.MaxAgeDays),
+		CheckBreached:    derefBool(e.CheckBreached),
+		UpdatedBy:        e.UpdatedBy,
+		UpdatedAt:        timeutil.TimeToTimestamppb(e.UpdatedAt),
+	}
+}
+
+func toPasswordPolicy(e *ent.PasswordPolicy) pwpolicy.Policy {
+	return pwpolicy.Policy{
+		MinLength:        int(derefUint32(e.MinLength)),
+		RequireUppercase: derefBool(e.RequireUppercase),
+		RequireLowercase: derefBool(e.RequireLowercase),
+		RequireDigit:     derefBool(e.RequireDigit),
+		RequireSymbol:    derefBool(e.RequireSymbol),
+		DisallowIdentity: derefBool(e.DisallowIdentity),
+		BannedSubstrings: e.BannedSubstrings,
+		HistoryCount:     min(int(derefUint32(e.HistoryCount)), pwpolicy.MaxHistoryCount),
+		MaxAge:           time.Duration(derefUint32(e.MaxAgeDays)) * 24 * time.Hour,
+		CheckBreached:    derefBool(e.CheckBreached),
+	}
+}
+
+func derefBool(p *bool) bool {
+	if p == nil {
+		return false
+	}
+	return *p
+}
+
+// Get 取租户策略；未配置时返回不做限制的默认策略。
+func (r *PasswordPolicyRepo) Get(ctx context.Context, tenantID uint32) (*authenticationV1.PasswordPolicy, error) {
+	entity, err := r.entClient.Client().PasswordPolicy.Query().
+		Where(passwordpolicy.TenantIDEQ(tenantID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return &authenticationV1.PasswordPolicy{TenantId: trans.Ptr(tenantID)}, nil
+		}
+		r.log.Errorf("query password policy failed: %s", err.Error())
+		return nil, authenticationV1.ErrorInternalServerError("query password policy failed")
+	}
+
+	return r.toDTO(entity), nil
+}
+
+// Save 创建或覆盖租户策略（按 tenant_id 唯一索引 upsert）。
+func (r *PasswordPolicyRepo) Save(ctx context.Context, data *authenticationV1.PasswordPolicy) error {
+	if data == nil {
+		return authenticationV1.ErrorBadRequest("invalid request")
+	}
+
+	now := time.Now()
+	banned := data.GetBannedSubstrings()
+	if banned == nil {
+		banned = []string{}
+	}
+
+	err := r.entClient.Client().PasswordPolicy.Create().
+		SetTenantID(data.GetTenantId()).
+		SetMinLength(data.GetMinLength()).
+		SetRequireUppercase(data.GetRequireUppercase()).
+		SetRequireLowercase(data.GetRequireLowercase()).
+		SetRequireDigit(data.GetRequireDigit()).
+		SetRequireSymbol(data.GetRequireSymbol()).
+		SetDisallowIdentity(data.GetDisallowIdentity()).
+		SetBannedSubstrings(banned).
+		SetHistoryCount(data.GetHistoryCount()).
+		SetMaxAgeDays(data.GetMaxAgeDays()).
+		SetCheckBreached(data.GetCheckBreached()).
+		SetNillableCreatedBy(data.UpdatedBy).
+		SetNillableUpdatedBy(data.UpdatedBy).
+		SetCreatedAt(now).
+		SetUpdatedAt(now).
+		OnConflictColumns(passwordpolicy.FieldTenantID).
+		// 冲突时取本次插入值（EXCLUDED），与 MfaPolicyRepo.Save 同理
+		Update(func(u *ent.PasswordPolicyUpsert) {
+			u.UpdateMinLength()
+			u.UpdateRequireUppercase()
+			u.UpdateRequireLowercase()
+			u.UpdateRequireDigit()
+			u.UpdateRequireSymbol()
+			u.UpdateDisallowIdentity()
+			u.UpdateBannedSubstrings()
+			u.UpdateHistoryCount()
+			u.UpdateMaxAgeDays()
+			u.UpdateCheckBreached()
+			u.UpdateUpdatedAt()
+			if data.UpdatedBy != nil {
+				u.UpdateUpdatedBy()
+			}
+		}).
+		Exec(ctx)
+	if err != nil {
+		r.log.Errorf("save password policy failed: %s", err.Error())
+		return authenticationV1.ErrorInternalServerError("save password policy failed")
+	}
+
+	return nil
+}
+
+// Resolve 计算租户用户适用的密码策略：平台策略与租户策略逐项取较严格者。
+func (r *PasswordPolicyRepo) Resolve(ctx context.Context, tenantID uint32) (pwpolicy.Policy, error) {
+	return r.resolve(ctx, r.entClient.Client(), tenantID)
+}
+
+// resolve 同 Resolve，client 可为事务客户端。
+// 平台策略对租户用户不可见（租户隐私过滤），且登录/注册流程无 viewer，这里以 privacy.Allow 显式按租户查询。
+func (r *PasswordPolicyRepo) resolve(ctx context.Context, client *ent.Client, tenantID uint32) (pwpolicy.Policy, error) {
+	entities, err := client.PasswordPolicy.Query().
+		Where(passwordpolicy.TenantIDIn(0, tenantID)).
+		All(privacy.DecisionContext(ctx, privacy.Allow))
+	if err != nil {
+		r.log.Errorf("query password policies failed: %s", err.Error())
+		return pwpolicy.Policy{}, authenticationV1.ErrorInternalServerError("query password policy failed")
+	}
+
+	var policy pwpolicy.Policy
+	for _, e := range entities {
+		policy = policy.Merge(toPasswordPolicy(e))
+	}
+	return policy, nil
+}
+
+// check 按租户策略校验明文密码（不含历史密码），返回生效的策略与违规项。
+func (r *PasswordPolicyRepo) check(ctx context.Context, client *ent.Client, tenantID uint32, username, password string) (pwpolicy.Policy, []pwpolicy.Violation, error) {
+	policy, err := r.resolve(ctx, client, tenantID)
+	if err != nil {
+		return policy, nil, err
+	}
+
+	subject := pwpolicy.Subject{Username: username}
+	if policy.DisallowIdentity && tenantID != 0 {
+		t, terr := client.Tenant.Query().
+			Where(tenant.IDEQ(tenantID)).
+			Select(tenant.FieldCode).
+			Only(privacy.DecisionContext(ctx, privacy.Allow))
+		if terr != nil && !ent.IsNotFound(terr) {
+			r.log.Errorf("query tenant code failed: %s", terr.Error())
+			return policy, nil, authenticationV1.ErrorInternalServerError("query password policy failed")
+		}
+		if t != nil {
+			subject.TenantCode = derefStr(t.Code)
+		}
+	}
+
+	return policy, policy.Check(password, subject, r.breached), nil
+}
+
+// newPasswordPolicyError 违规项转为 PASSWORD_POLICY_VIOLATION 错误：
+// metadata.violations 为逗号分隔的规则代码，并以规则代码为键给出说明，供前端逐条提示。
+func newPasswordPolicyError(violations []pwpolicy.Violation) *errors.Error {
+	md := make(map[string]string, len(violations)+1)
+	rules := make([]string, 0, len(violations))
+	messages := make([]string, 0, len(violations))
+	for _, v := range violations {
+		if _, dup := md[v.Rule]; dup {
+			continue
+		}
+		md[v.Rule] = v.Message
+		rules = append(rules, v.Rule)
+		messages = append(messages, v.Message)
+	}
+	md["violations"] = strings.Join(rules, ",")
+
+	return authenticationV1.ErrorPasswordPolicyViolation("password %s", strings.Join(messages, "; ")).
+		WithMetadata(md)
+}
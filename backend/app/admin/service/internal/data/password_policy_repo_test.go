@@ -0,0 +1,144 @@
+package data
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tx7do/go-utils/trans"
+	conf "github.com/tx7do/kratos-bootstrap/api/gen/go/conf/v1"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"go-wind-admin/app/admin/service/internal/data/ent/usercredential"
+	"go-wind-admin/app/admin/service/internal/data/enttest"
+
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+
+	pwpolicy "go-wind-admin/pkg/passwordpolicy"
+)
+
+// requirePolicyViolation 断言错误为 PASSWORD_POLICY_VIOLATION 并返回违反的规则代码。
+func requirePolicyViolation(t *testing.T, err error) string {
+	t.Helper()
+
+	require.Error(t, err)
+	assert.True(t, authenticationV1.IsPasswordPolicyViolation(err), err.Error())
+	return errors.FromError(err).GetMetadata()["violations"]
+}
+
+func TestUserCredentialRepo_PasswordPolicy(t *testing.T) {
+	entClient := enttest.NewEntClientForTest(t)
+	bctx := bootstrap.NewContextWithParam(context.Background(), &conf.AppInfo{}, &conf.Bootstrap{}, log.DefaultLogger)
+	ctx := enttest.NewSystemViewerCtx(context.Background())
+
+	const (
+		tenantID = 9151
+		userID   = 9151
+		username = "pwpolicy9151"
+	)
+
+	policyRepo := NewPasswordPolicyRepo(bctx, entClient)
+	sum := sha1.Sum([]byte("Breached#Pass1"))
+	breached, err := pwpolicy.ParseBreachedList(strings.NewReader(hex.EncodeToString(sum[:])))
+	require.NoError(t, err)
+	policyRepo.breached = breached
+	repo := NewUserCredentialRepo(bctx, entClient, NewPasswordCrypto(), policyRepo)
+
+	require.NoError(t, policyRepo.Save(ctx, &authenticationV1.PasswordPolicy{
+		TenantId:     trans.Ptr(uint32(0)),
+		MinLength:    8,
+		RequireDigit: true,
+		MaxAgeDays:   90,
+	}))
+	require.NoError(t, policyRepo.Save(ctx, &authenticationV1.PasswordPolicy{
+		TenantId:         trans.Ptr(uint32(tenantID)),
+		RequireSymbol:    true,
+		DisallowIdentity: true,
+		BannedSubstrings: []string{"Acme"},
+		HistoryCount:     3,
+		MaxAgeDays:       30,
+		CheckBreached:    true,
+	}))
+	t.Cleanup(func() {
+		_ = policyRepo.Save(ctx, &authenticationV1.PasswordPolicy{TenantId: trans.Ptr(uint32(0))})
+	})
+
+	// 平台与租户策略逐项取较严格者
+	policy, err := policyRepo.Resolve(ctx, tenantID)
+	require.NoError(t, err)
+	assert.Equal(t, 8, policy.MinLength)
+	assert.True(t, policy.RequireDigit)
+	assert.True(t, policy.RequireSymbol)
+	assert.Equal(t, 30*24*time.Hour, policy.MaxAge)
+	policy, err = policyRepo.Resolve(ctx, tenantID+1)
+	require.NoError(t, err)
+	assert.False(t, policy.RequireSymbol)
+
+	create := func(password string) error {
+		return repo.Create(ctx, &authenticationV1.CreateUserCredentialRequest{Data: &authenticationV1.UserCredential{
+			UserId:         trans.Ptr(uint32(userID)),
+			TenantId:       trans.Ptr(uint32(tenantID)),
+			IdentityType:   authenticationV1.UserCredential_USERNAME.Enum(),
+			Identifier:     trans.Ptr(username),
+			CredentialType: authenticationV1.UserCredential_PASSWORD_HASH.Enum(),
+			Credential:     trans.Ptr(password),
+			Status:         authenticationV1.UserCredential_ENABLED.Enum(),
+		}})
+	}
+	reset := func(password string) error {
+		return repo.ResetCredential(ctx, &authenticationV1.ResetCredentialRequest{
+			IdentityType:  authenticationV1.UserCredential_USERNAME,
+			Identifier:    username,
+			NewCredential: password,
+		})
+	}
+
+	// 违规项全部列出
+	assert.Equal(t, "min_length,digit,symbol", requirePolicyViolation(t, create("short")))
+	assert.Equal(t, "identity", requirePolicyViolation(t, repo.CheckPassword(ctx, tenantID, username, "x"+username+"#1")))
+	assert.Equal(t, "banned_substring", requirePolicyViolation(t, repo.CheckPassword(ctx, tenantID, username, "ACME#2024x")))
+	assert.Equal(t, "breached", requirePolicyViolation(t, repo.CheckPassword(ctx, tenantID, username, "Breached#Pass1")))
+	require.NoError(t, create("Init#Pass1"))
+
+	// 最近 3 次密码（含当前）不可重复使用
+	require.NoError(t, reset("Next#Pass2"))
+	assert.Equal(t, "history", requirePolicyViolation(t, reset("Next#Pass2")))
+	assert.Equal(t, "history", requirePolicyViolation(t, reset("Init#Pass1")))
+	require.NoError(t, reset("Third#Pass3"))
+	err = repo.ChangeCredential(ctx, &authenticationV1.ChangeCredentialRequest{
+		IdentityType:  authenticationV1.UserCredential_USERNAME,
+		Identifier:    username,
+		OldCredential: "Third#Pass3",
+		NewCredential: "Init#Pass1",
+	})
+	assert.Equal(t, "history", requirePolicyViolation(t, err))
+	require.NoError(t, reset("Fourth#Pass4"))
+	// 超出保留条数的旧密码可再次使用
+	require.NoError(t, reset("Init#Pass1"))
+
+	entity, err := entClient.Client().UserCredential.Query().
+		Where(usercredential.IdentifierEQ(username)).
+		Only(ctx)
+	require.NoError(t, err)
+	assert.Len(t, entity.CredentialHistory, 2)
+	require.NotNil(t, entity.CredentialChangedAt)
+
+	// 有效期按修改时间判定
+	expired, err := repo.PasswordExpired(ctx, tenantID, username)
+	require.NoError(t, err)
+	assert.False(t, expired)
+
+	require.NoError(t, entClient.Client().UserCredential.UpdateOneID(entity.ID).
+		SetCredentialChangedAt(time.Now().Add(-31*24*time.Hour)).
+		Exec(ctx))
+	expired, err = repo.PasswordExpired(ctx, tenantID, username)
+	require.NoError(t, err)
+	assert.True(t, expired)
+}
@@ -0,0 +1,189 @@
+package data
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"go-wind-admin/app/admin/service/internal/data/ent"
+	"go-wind-admin/app/admin/service/internal/data/ent/api"
+	"go-wind-admin/app/admin/service/internal/data/ent/permission"
+	"go-wind-admin/app/admin/service/internal/data/ent/permissionapi"
+	"go-wind-admin/app/admin/service/internal/data/ent/role"
+	"go-wind-admin/app/admin/service/internal/data/ent/rolepermission"
+
+	permissionV1 "go-wind-admin/api/gen/go/permission/service/v1"
+
+	appViewer "go-wind-admin/pkg/entgo/viewer"
+	"go-wind-admin/pkg/utils/converter"
+)
+
+// permissionCodeCacheTTL 用户权限码的进程内缓存时间。经管理接口变更角色或权限时本实例立即失效，其他实例最多延迟该时长。
+const permissionCodeCacheTTL = 30 * time.Second
+
+type cachedPermissionCodes struct {
+	codes     []string
+	expiresAt time.Time
+}
+
+// PermissionCodeResolver 权限码解析：按令牌中的角色展开用户的权限码，实现认证中间件的 PermissionResolver 接口。
+// 结果包含角色绑定的权限码（如 sys:tenant_manager）与权限下 API 按 resource:action 生成的动作码（如 user:edit），
+// 后者与权限同步时的 API 权限码规则一致，供 Viewer.HasPermission 做动作级判断。
+type PermissionCodeResolver struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+
+	apiPermissionConverter *converter.ApiPermissionConverter
+
+	mu    sync.RWMutex
+	codes map[string]cachedPermissionCodes
+}
+
+func NewPermissionCodeResolver(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *PermissionCodeResolver {
+	return &PermissionCodeResolver{
+		log:                    ctx.NewLoggerHelper("permission-code-resolver/data/admin-service"),
+		entClient:              entClient,
+		apiPermissionConverter: converter.NewApiPermissionConverter(),
+		codes:                  make(map[string]cachedPermissionCodes),
+	}
+}
+
+// ResolvePermissionCodes 返回用户在租户内经启用角色获得的权限码（去重、有序），按用户缓存
+func (r *PermissionCodeResolver) ResolvePermissionCodes(ctx context.Context, tenantID, userID uint32, roleCodes []string) ([]string, error) {
+	if len(roleCodes) == 0 {
+		return nil, nil
+	}
+
+	roleCodes = append([]string(nil), roleCodes...)
+	sort.Strings(roleCodes)
+
+	key := strings.Join([]string{
+		strconv.FormatUint(uint64(tenantID), 10),
+		strconv.FormatUint(uint64(userID), 10),
+		strings.Join(roleCodes, ","),
+	}, ":")
+	now := time.Now()
+
+	r.mu.RLock()
+	cached, ok := r.codes[key]
+	r.mu.RUnlock()
+	if ok && now.Before(cached.expiresAt) {
+		return cached.codes, nil
+	}
+
+	// 角色与权限的读取不受调用方视图约束，以系统身份查询
+	codes, err := r.queryPermissionCodes(appViewer.NewSystemViewerContext(ctx), tenantID, roleCodes)
+	if err != nil {
+		r.log.Errorf("resolve permission codes of user [%d] failed: %s", userID, err.Error())
+		return nil, permissionV1.ErrorInternalServerError("resolve permission codes failed")
+	}
+
+	r.mu.Lock()
+	r.codes[key] = cachedPermissionCodes{codes: codes, expiresAt: now.Add(permissionCodeCacheTTL)}
+	r.mu.Unlock()
+
+	return codes, nil
+}
+
+// Invalidate 角色或权限变更后清空缓存
+func (r *PermissionCodeResolver) Invalidate() {
+	r.mu.Lock()
+	r.codes = make(map[string]cachedPermissionCodes)
+	r.mu.Unlock()
+}
+
+// queryPermissionCodes 角色 → 角色权限 → 权限码及其 API 动作码
+func (r *PermissionCodeResolver) queryPermissionCodes(ctx context.Context, tenantID uint32, roleCodes []string) ([]string, error) {
+	client := r.entClient.Client()
+
+	roleIDs, err := client.Role.Query().
+		Where(
+			role.TenantIDEQ(tenantID),
+			role.CodeIn(roleCodes...),
+			role.StatusEQ(role.StatusOn),
+		).
+		IDs(ctx)
+	if err != nil || len(roleIDs) == 0 {
+		return nil, err
+	}
+
+	intIDs, err := client.RolePermission.Query().
+		Where(rolepermission.RoleIDIn(roleIDs...)).
+		Select(rolepermission.FieldPermissionID).
+		Ints(ctx)
+	if err != nil || len(intIDs) == 0 {
+		return nil, err
+	}
+	permissionIDs := make([]uint32, 0, len(intIDs))
+	for _, id := range intIDs {
+		permissionIDs = append(permissionIDs, uint32(id))
+	}
+
+	permissions, err := client.Permission.Query().
+		Where(
+			permission.IDIn(permissionIDs...),
+			permission.StatusEQ(permission.StatusOn),
+		).
+		Select(permission.FieldID, permission.FieldCode).
+		All(ctx)
+	if err != nil || len(permissions) == 0 {
+		return nil, err
+	}
+
+	set := make(map[string]struct{}, len(permissions))
+	enabledIDs := make([]uint32, 0, len(permissions))
+	for _, p := range permissions {
+		enabledIDs = append(enabledIDs, p.ID)
+		if p.Code != nil && *p.Code != "" {
+			set[*p.Code] = struct{}{}
+		}
+	}
+
+	if intIDs, err = client.PermissionApi.Query().
+		Where(permissionapi.PermissionIDIn(enabledIDs...)).
+		Select(permissionapi.FieldAPIID).
+		Ints(ctx); err != nil {
+		return nil, err
+	}
+	if len(intIDs) > 0 {
+		apiIDs := make([]uint32, 0, len(intIDs))
+		for _, id := range intIDs {
+			apiIDs = append(apiIDs, uint32(id))
+		}
+
+		var apis []*ent.Api
+		if apis, err = client.Api.Query().
+			Where(
+				api.IDIn(apiIDs...),
+				api.StatusEQ(api.StatusOn),
+			).
+			Select(api.FieldMethod, api.FieldPath).
+			All(ctx); err != nil {
+			return nil, err
+		}
+		for _, a := range apis {
+			if a.Method == nil || a.Path == nil {
+				continue
+			}
+			if code := r.apiPermissionConverter.ConvertCodeByPath(*a.Method, *a.Path); code != "" && !strings.HasPrefix(code, ":") {
+				set[code] = struct{}{}
+			}
+		}
+	}
+
+	codes := make([]string, 0, len(set))
+	for code := range set {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+
+	return codes, nil
+}
@@ -0,0 +1,190 @@
+package data
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tx7do/go-crud/viewer"
+
+	identityV1 "go-wind-admin/api/gen/go/identity/service/v1"
+	"go-wind-admin/app/admin/service/internal/data/ent/role"
+	"go-wind-admin/app/admin/service/internal/data/enttest"
+
+	appViewer "go-wind-admin/pkg/entgo/viewer"
+	"go-wind-admin/pkg/utils/converter"
+)
+
+// newPermissionCodeResolverSqlite 租户 1 的角色 editor 绑定权限 biz:position，权限下挂职位的查看与编辑 API
+func newPermissionCodeResolverSqlite(t *testing.T) *PermissionCodeResolver {
+	t.Helper()
+
+	entClient := enttest.NewEntClientForTest(t)
+	ctx := enttest.NewSystemViewerCtx(context.Background())
+	client := entClient.Client()
+
+	r, err := client.Role.Create().
+		SetTenantID(1).
+		SetName("editor").
+		SetCode("editor").
+		Save(ctx)
+	require.NoError(t, err)
+
+	p, err := client.Permission.Create().
+		SetName("职位管理").
+		SetCode("biz:position").
+		Save(ctx)
+	require.NoError(t, err)
+
+	require.NoError(t, client.RolePermission.Create().
+		SetTenantID(1).
+		SetRoleID(r.ID).
+		SetPermissionID(p.ID).
+		Exec(ctx))
+
+	for _, a := range []struct{ method, path string }{
+		{"GET", "/admin/v1/positions"},
+		{"PUT", "/admin/v1/positions/{id}"},
+	} {
+		entity, err := client.Api.Create().
+			SetMethod(a.method).
+			SetPath(a.path).
+			Save(ctx)
+		require.NoError(t, err)
+		require.NoError(t, client.PermissionApi.Create().
+			SetPermissionID(p.ID).
+			SetAPIID(entity.ID).
+			Exec(ctx))
+	}
+
+	return &PermissionCodeResolver{
+		entClient:              entClient,
+		log:                    log.NewHelper(log.NewStdLogger(io.Discard)),
+		apiPermissionConverter: converter.NewApiPermissionConverter(),
+		codes:                  make(map[string]cachedPermissionCodes),
+	}
+}
+
+func TestPermissionCodeResolver_Resolve(t *testing.T) {
+	r := newPermissionCodeResolverSqlite(t)
+	ctx := context.Background()
+
+	codes, err := r.ResolvePermissionCodes(ctx, 1, 10, []string{"editor"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"biz:position", "position:edit", "position:view"}, codes)
+
+	// 角色编码只在所属租户内生效
+	codes, err = r.ResolvePermissionCodes(ctx, 2, 10, []string{"editor"})
+	require.NoError(t, err)
+	assert.Empty(t, codes)
+
+	// 停用角色后缓存仍有效，失效后重新解析
+	sysCtx := enttest.NewSystemViewerCtx(ctx)
+	require.NoError(t, r.entClient.Client().Role.Update().
+		Where(role.CodeEQ("editor")).
+		SetStatus(role.StatusOff).
+		Exec(sysCtx))
+
+	codes, err = r.ResolvePermissionCodes(ctx, 1, 10, []string{"editor"})
+	require.NoError(t, err)
+	assert.NotEmpty(t, codes)
+
+	r.Invalidate()
+	codes, err = r.ResolvePermissionCodes(ctx, 1, 10, []string{"editor"})
+	require.NoError(t, err)
+	assert.Empty(t, codes)
+}
+
+func TestPermissionCodeResolver_ActionPermission(t *testing.T) {
+	r := newPermissionCodeResolverSqlite(t)
+	client := r.entClient.Client()
+
+	codes, err := r.ResolvePermissionCodes(context.Background(), 1, 10, []string{"editor"})
+	require.NoError(t, err)
+
+	vc := appViewer.NewUserViewer(10, 1, 0, "", identityV1.DataScope_ALL, []string{"editor"}, codes)
+	assert.True(t, vc.HasPermission("update", "Position"))
+	assert.True(t, vc.HasPermission("list", "positions"))
+	assert.False(t, vc.HasPermission("delete", "Position"))
+	assert.False(t, vc.HasPermission("update", "User"))
+
+	pos, err := client.Position.Create().
+		SetTenantID(1).
+		SetName("职位").
+		SetCode("POS").
+		SetOrgUnitID(1).
+		Save(enttest.NewSystemViewerCtx(context.Background()))
+	require.NoError(t, err)
+
+	ctx := viewer.WithContext(context.Background(), vc)
+
+	// 具备 position:edit 可更新，缺少 position:delete / position:create 时写入被隐私规则拒绝
+	require.NoError(t, client.Position.UpdateOneID(pos.ID).SetName("职位2").Exec(ctx))
+	assert.Error(t, client.Position.DeleteOneID(pos.ID).Exec(ctx))
+	assert.Error(t, client.Position.Create().
+		SetTenantID(1).
+		SetName("职位3").
+		SetCode("POS3").
+		SetOrgUnitID(1).
+		Exec(ctx))
+
+	// 未填充权限码的租户视图不可写入
+	noPerm := viewer.WithContext(context.Background(),
+		appViewer.NewUserViewer(10, 1, 0, "", identityV1.DataScope_ALL, []string{"editor"}, nil))
+	assert.Error(t, client.Position.UpdateOneID(pos.ID).SetName("职位4").Exec(noPerm))
+
+	got, err := client.Position.Get(ctx, pos.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "职位2", *got.Name)
+}
+
+func TestActionPermission_Entities(t *testing.T) {
+	entClient := enttest.NewEntClientForTest(t)
+	client := entClient.Client()
+	sysCtx := enttest.NewSystemViewerCtx(context.Background())
+
+	tn, err := client.Tenant.Create().SetName("租户").SetCode("T1").Save(sysCtx)
+	require.NoError(t, err)
+	r, err := client.Role.Create().SetTenantID(tn.ID).SetName("staff").SetCode("staff").Save(sysCtx)
+	require.NoError(t, err)
+	ou, err := client.OrgUnit.Create().SetTenantID(tn.ID).SetName("总部").Save(sysCtx)
+	require.NoError(t, err)
+	self, err := client.User.Create().SetTenantID(tn.ID).SetUsername("self").Save(sysCtx)
+	require.NoError(t, err)
+	other, err := client.User.Create().SetTenantID(tn.ID).SetUsername("other").Save(sysCtx)
+	require.NoError(t, err)
+
+	tenantCtx := func(permissions ...string) context.Context {
+		return viewer.WithContext(context.Background(),
+			appViewer.NewUserViewer(uint64(self.ID), uint64(tn.ID), 0, "", identityV1.DataScope_ALL, []string{"staff"}, permissions))
+	}
+	noPerm := tenantCtx()
+	ctx := tenantCtx("user:edit", "role:edit", "org-unit:create", "org-unit:edit")
+
+	// 用户：更新他人需要 user:edit，删除需要 user:delete；本人资料的更新不要求权限
+	assert.Error(t, client.User.UpdateOneID(other.ID).SetNickname("x").Exec(noPerm))
+	require.NoError(t, client.User.UpdateOneID(other.ID).SetNickname("x").Exec(ctx))
+	assert.Error(t, client.User.DeleteOneID(other.ID).Exec(ctx))
+	require.NoError(t, client.User.UpdateOneID(self.ID).SetNickname("me").Exec(noPerm))
+
+	// 角色：具备 role:edit 可更新，缺少 role:create 不可创建
+	assert.Error(t, client.Role.UpdateOneID(r.ID).SetName("员工").Exec(noPerm))
+	require.NoError(t, client.Role.UpdateOneID(r.ID).SetName("员工").Exec(ctx))
+	assert.Error(t, client.Role.Create().SetTenantID(tn.ID).SetName("admin").SetCode("admin").Exec(ctx))
+
+	// 组织单元：资源码按 kebab-case 归一为 org-unit
+	assert.Error(t, client.OrgUnit.Create().SetTenantID(tn.ID).SetName("分部").Exec(noPerm))
+	require.NoError(t, client.OrgUnit.Create().SetTenantID(tn.ID).SetName("分部").Exec(ctx))
+	require.NoError(t, client.OrgUnit.UpdateOneID(ou.ID).SetName("总公司").Exec(ctx))
+	assert.Error(t, client.OrgUnit.DeleteOneID(ou.ID).Exec(ctx))
+
+	// 租户：租户视图缺少 tenant:edit 不可修改，平台视图不校验
+	assert.Error(t, client.Tenant.UpdateOneID(tn.ID).SetName("租户2").Exec(ctx))
+	require.NoError(t, client.Tenant.UpdateOneID(tn.ID).SetName("租户2").Exec(tenantCtx("tenant:edit")))
+	platformCtx := viewer.WithContext(context.Background(),
+		appViewer.NewUserViewer(1, 0, 0, "", identityV1.DataScope_ALL, nil, nil))
+	require.NoError(t, client.Tenant.UpdateOneID(tn.ID).SetName("租户3").Exec(platformCtx))
+}
@@ -1,4 +1,340 @@
 package data
 
+import (
+	"context"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	paginationV1 "github.com/tx7do/go-crud/api/gen/go/pagination/v1"
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"go-wind-admin/app/admin/service/internal/data/ent"
+	"go-wind-admin/app/admin/service/internal/data/ent/api"
+	"go-wind-admin/app/admin/service/internal/data/ent/permission"
+	"go-wind-admin/app/admin/service/internal/data/ent/permissionapi"
+	"go-wind-admin/app/admin/service/internal/data/ent/permissionpolicy"
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+
+	"github.com/tx7do/go-utils/copierutil"
+	"github.com/tx7do/go-utils/mapper"
+
+	permissionV1 "go-wind-admin/api/gen/go/permission/service/v1"
+)
+
 type PermissionPolicyRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+
+	mapper          *mapper.CopierMapper[permissionV1.PermissionPolicy, ent.PermissionPolicy]
+	engineConverter *mapper.EnumTypeConverter[permissionV1.PermissionPolicy_PolicyEngine, permissionpolicy.PolicyEngine]
+	statusConverter *mapper.EnumTypeConverter[permissionV1.PermissionPolicy_Status, permissionpolicy.Status]
+
+	repository *entCrud.Repository[
+		ent.PermissionPolicyQuery, ent.PermissionPolicySelect,
+		ent.PermissionPolicyCreate, ent.PermissionPolicyCreateBulk,
+		ent.PermissionPolicyUpdate, ent.PermissionPolicyUpdateOne,
+		ent.PermissionPolicyDelete,
+		predicate.PermissionPolicy,
+		permissionV1.PermissionPolicy, ent.PermissionPolicy,
+	]
+}
+
+func NewPermissionPolicyRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *PermissionPolicyRepo {
+	repo := &PermissionPolicyRepo{
+		log:       ctx.NewLoggerHelper("permission-policy/repo/admin-service"),
+		entClient: entClient,
+		mapper:    mapper.NewCopierMapper[permissionV1.PermissionPolicy, ent.PermissionPolicy](),
+		engineConverter: mapper.NewEnumTypeConverter[permissionV1.PermissionPolicy_PolicyEngine, permissionpolicy.PolicyEngine](
+			permissionV1.PermissionPolicy_PolicyEngine_name, permissionV1.PermissionPolicy_PolicyEngine_value,
+		),
+		statusConverter: mapper.NewEnumTypeConverter[permissionV1.PermissionPolicy_Status, permissionpolicy.Status](
+			permissionV1.PermissionPolicy_Status_name, permissionV1.PermissionPolicy_Status_value,
+		),
+	}
+
+	repo.init()
+
+	return repo
+}
+
+func (r *PermissionPolicyRepo) init() {
+	r.repository = entCrud.NewRepository[
+		ent.PermissionPolicyQuery, ent.PermissionPolicySelect,
+		ent.PermissionPolicyCreate, ent.PermissionPolicyCreateBulk,
+		ent.PermissionPolicyUpdate, ent.PermissionPolicyUpdateOne,
+		ent.PermissionPolicyDelete,
+		predicate.PermissionPolicy,
+		permissionV1.PermissionPolicy, ent.PermissionPolicy,
+	](r.mapper)
+
+	r.mapper.AppendConverters(copierutil.NewTimeStringConverterPair())
+	r.mapper.AppendConverters(copierutil.NewTimeTimestamppbConverterPair())
+
+	r.mapper.AppendConverters(r.engineConverter.NewConverterPair())
+	r.mapper.AppendConverters(r.statusConverter.NewConverterPair())
+}
+
+// engineToEntity 策略引擎转为实体值，未指定时不写入（沿用默认值）
+func (r *PermissionPolicyRepo) engineToEntity(engine *permissionV1.PermissionPolicy_PolicyEngine) *permissionpolicy.PolicyEngine {
+	if engine == nil || *engine == permissionV1.PermissionPolicy_POLICY_ENGINE_UNSPECIFIED {
+		return nil
+	}
+	return r.engineConverter.ToEntity(engine)
+}
+
+// EffectivePermissionPolicy 判定用的策略条目（ent 实体的精简视图）
+type EffectivePermissionPolicy struct {
+	ID           uint32
+	PermissionID uint32
+	Definition   string
+	Version      uint32
+	EvalOrder    uint32
+	CacheTTL     time.Duration
+	UpdatedAt    time.Time // 参与判定结果缓存键：策略更新后旧结果自然失效
+}
+
+// ListCelPoliciesByApi 查询请求路由（路由模板 + HTTP 方法）关联的权限点上启用的 CEL 策略，按评估优先级排序。
+// 路由经 sys_permission_apis 关联到权限点；同一权限点可挂多条策略（含不同版本），全部启用的策略都参与判定。
+func (r *PermissionPolicyRepo) ListCelPoliciesByApi(ctx context.Context, path, method string) ([]EffectivePermissionPolicy, error) {
+	apiIDs, err := r.entClient.Client().Api.Query().
+		Where(
+			api.PathEQ(path),
+			api.MethodEQ(method),
+		).
+		IDs(ctx)
+	if err != nil {
+		r.log.Errorf("query apis of route [%s %s] failed: %s", method, path, err.Error())
+		return nil, permissionV1.ErrorInternalServerError("query permission policies failed")
+	}
+	if len(apiIDs) == 0 {
+		return nil, nil
+	}
+
+	relations, err := r.entClient.Client().PermissionApi.Query().
+		Where(permissionapi.APIIDIn(apiIDs...)).
+		Select(permissionapi.FieldPermissionID).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("query permissions of route [%s %s] failed: %s", method, path, err.Error())
+		return nil, permissionV1.ErrorInternalServerError("query permission policies failed")
+	}
+
+	permissionIDs := make([]uint32, 0, len(relations))
+	for _, rel := range relations {
+		permissionIDs = append(permissionIDs, derefUint32(rel.PermissionID))
+	}
+
+	return r.listCelPolicies(ctx, permissionIDs)
+}
+
+// ListCelPoliciesByPermissionCode 查询权限点上启用的 CEL 策略，供业务层在加载资源后判定资源级策略
+func (r *PermissionPolicyRepo) ListCelPoliciesByPermissionCode(ctx context.Context, code string) ([]EffectivePermissionPolicy, error) {
+	permissionIDs, err := r.entClient.Client().Permission.Query().
+		Where(permission.CodeEQ(code)).
+		IDs(ctx)
+	if err != nil {
+		r.log.Errorf("query permission [%s] failed: %s", code, err.Error())
+		return nil, permissionV1.ErrorInternalServerError("query permission policies failed")
+	}
+
+	return r.listCelPolicies(ctx, permissionIDs)
+}
+
+func (r *PermissionPolicyRepo) listCelPolicies(ctx context.Context, permissionIDs []uint32) ([]EffectivePermissionPolicy, error) {
+	if len(permissionIDs) == 0 {
+		return nil, nil
+	}
+
+	entities, err := r.entClient.Client().PermissionPolicy.Query().
+		Where(
+			permissionpolicy.PermissionIDIn(permissionIDs...),
+			permissionpolicy.PolicyEngineEQ(permissionpolicy.PolicyEngineCel),
+			permissionpolicy.StatusEQ(permissionpolicy.StatusOn),
+		).
+		Order(
+			ent.Asc(permissionpolicy.FieldEvalOrder),
+			ent.Asc(permissionpolicy.FieldID),
+		).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("query permission policies failed: %s", err.Error())
+		return nil, permissionV1.ErrorInternalServerError("query permission policies failed")
+	}
+
+	policies := make([]EffectivePermissionPolicy, 0, len(entities))
+	for _, e := range entities {
+		p := EffectivePermissionPolicy{
+			ID:           e.ID,
+			PermissionID: derefUint32(e.PermissionID),
+			Definition:   derefStr(e.Definition),
+			Version:      derefUint32(e.Version),
+			EvalOrder:    derefUint32(e.EvalOrder),
+			CacheTTL:     time.Duration(derefUint32(e.CacheTTL)) * time.Second,
+		}
+		if e.UpdatedAt != nil {
+			p.UpdatedAt = *e.UpdatedAt
+		} else if e.CreatedAt != nil {
+			p.UpdatedAt = *e.CreatedAt
+		}
+		policies = append(policies, p)
+	}
+
+	return policies, nil
+}
+
+func (r *PermissionPolicyRepo) Count(ctx context.Context, whereCond []func(s *sql.Selector)) (int, error) {
+	builder := r.entClient.Client().PermissionPolicy.Query()
+	if len(whereCond) != 0 {
+		builder.Modify(whereCond...)
+	}
+
+	count, err := builder.Count(ctx)
+	if err != nil {
+		r.log.Errorf("query count failed: %s", err.Error())
+		return 0, permissionV1.ErrorInternalServerError("query count failed")
+	}
+
+	return count, nil
+}
+
+func (r *PermissionPolicyRepo) List(ctx context.Context, req *paginationV1.PagingRequest) (*permissionV1.ListPermissionPolicyResponse, error) {
+	if req == nil {
+		return nil, permissionV1.ErrorBadRequest("invalid parameter")
+	}
+
+	builder := r.entClient.Client().PermissionPolicy.Query()
+
+	ret, err := r.repository.ListWithPaging(ctx, builder, builder.Clone(), req)
+	if err != nil {
+		return nil, err
+	}
+	if ret == nil {
+		return &permissionV1.ListPermissionPolicyResponse{Total: 0, Items: nil}, nil
+	}
+
+	return &permissionV1.ListPermissionPolicyResponse{
+		Total: ret.Total,
+		Items: ret.Items,
+	}, nil
+}
+
+func (r *PermissionPolicyRepo) IsExist(ctx context.Context, id uint32) (bool, error) {
+	exist, err := r.entClient.Client().PermissionPolicy.Query().
+		Where(permissionpolicy.IDEQ(id)).
+		Exist(ctx)
+	if err != nil {
+		r.log.Errorf("query exist failed: %s", err.Error())
+		return false, permissionV1.ErrorInternalServerError("query exist failed")
+	}
+	return exist, nil
+}
+
+func (r *PermissionPolicyRepo) Get(ctx context.Context, req *permissionV1.GetPermissionPolicyRequest) (*permissionV1.PermissionPolicy, error) {
+	if req == nil {
+		return nil, permissionV1.ErrorBadRequest("invalid parameter")
+	}
+
+	builder := r.entClient.Client().PermissionPolicy.Query()
+
+	var whereCond []func(s *sql.Selector)
+	switch req.QueryBy.(type) {
+	default:
+	case *permissionV1.GetPermissionPolicyRequest_Id:
+		whereCond = append(whereCond, permissionpolicy.IDEQ(req.GetId()))
+	}
+
+	dto, err := r.repository.Get(ctx, builder, req.GetViewMask(), whereCond...)
+	if err != nil {
+		return nil, err
+	}
+
+	return dto, err
+}
+
+func (r *PermissionPolicyRepo) Create(ctx context.Context, req *permissionV1.CreatePermissionPolicyRequest) error {
+	if req == nil || req.Data == nil {
+		return permissionV1.ErrorBadRequest("invalid request")
+	}
+
+	builder := r.entClient.Client().PermissionPolicy.Create().
+		SetPermissionID(req.Data.GetPermissionId()).
+		SetNillablePolicyEngine(r.engineToEntity(req.Data.PolicyEngine)).
+		SetNillableDefinition(req.Data.Definition).
+		SetNillableVersion(req.Data.Version).
+		SetNillableEvalOrder(req.Data.EvalOrder).
+		SetNillableCacheTTL(req.Data.CacheTtl).
+		SetNillableStatus(r.statusConverter.ToEntity(req.Data.Status)).
+		SetNillableCreatedBy(req.Data.CreatedBy).
+		SetCreatedAt(time.Now())
+
+	if err := builder.Exec(ctx); err != nil {
+		r.log.Errorf("insert permission policy failed: %s", err.Error())
+		return permissionV1.ErrorInternalServerError("insert permission policy failed")
+	}
+
+	return nil
+}
+
+func (r *PermissionPolicyRepo) Update(ctx context.Context, req *permissionV1.UpdatePermissionPolicyRequest) error {
+	if req == nil || req.Data == nil {
+		return permissionV1.ErrorBadRequest("invalid request")
+	}
+	if req.GetId() == 0 {
+		return permissionV1.ErrorBadRequest("id is required")
+	}
+
+	// 如果不存在则创建
+	if req.GetAllowMissing() {
+		exist, err := r.IsExist(ctx, req.GetId())
+		if err != nil {
+			return err
+		}
+		if !exist {
+			createReq := &permissionV1.CreatePermissionPolicyRequest{Data: req.Data}
+			createReq.Data.CreatedBy = createReq.Data.UpdatedBy
+			createReq.Data.UpdatedBy = nil
+			return r.Create(ctx, createReq)
+		}
+	}
+
+	builder := r.entClient.Client().PermissionPolicy.Update()
+	err := r.repository.UpdateX(ctx, builder, req.Data, req.GetUpdateMask(),
+		func(dto *permissionV1.PermissionPolicy) {
+			builder.
+				SetNillablePermissionID(req.Data.PermissionId).
+				SetNillablePolicyEngine(r.engineToEntity(req.Data.PolicyEngine)).
+				SetNillableDefinition(req.Data.Definition).
+				SetNillableVersion(req.Data.Version).
+				SetNillableEvalOrder(req.Data.EvalOrder).
+				SetNillableCacheTTL(req.Data.CacheTtl).
+				SetNillableStatus(r.statusConverter.ToEntity(req.Data.Status)).
+				SetNillableUpdatedBy(req.Data.UpdatedBy).
+				SetUpdatedAt(time.Now())
+		},
+		func(s *sql.Selector) {
+			s.Where(sql.EQ(permissionpolicy.FieldID, req.GetId()))
+		},
+	)
+
+	return err
+}
+
+func (r *PermissionPolicyRepo) Delete(ctx context.Context, req *permissionV1.DeletePermissionPolicyRequest) error {
+	if req == nil {
+		return permissionV1.ErrorBadRequest("invalid parameter")
+	}
+
+	builder := r.entClient.Client().PermissionPolicy.Delete()
+	_, err := r.repository.Delete(ctx, builder, func(s *sql.Selector) {
+		s.Where(sql.EQ(permissionpolicy.FieldID, req.GetId()))
+	})
+	if err != nil {
+		r.log.Errorf("delete permission policy failed: %s", err.Error())
+		return permissionV1.ErrorInternalServerError("delete permission policy failed")
+	}
+
+	return nil
 }
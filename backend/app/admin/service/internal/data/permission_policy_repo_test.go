@@ -0,0 +1,96 @@
+package data
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tx7do/go-utils/mapper"
+	"github.com/tx7do/go-utils/trans"
+
+	permissionV1 "go-wind-admin/api/gen/go/permission/service/v1"
+
+	"go-wind-admin/app/admin/service/internal/data/ent"
+	"go-wind-admin/app/admin/service/internal/data/ent/permissionpolicy"
+	"go-wind-admin/app/admin/service/internal/data/enttest"
+)
+
+func newPermissionPolicyRepoSqlite(t *testing.T) *PermissionPolicyRepo {
+	t.Helper()
+	repo := &PermissionPolicyRepo{
+		entClient: enttest.NewEntClientForTest(t),
+		log:       log.NewHelper(log.NewStdLogger(io.Discard)),
+		mapper:    mapper.NewCopierMapper[permissionV1.PermissionPolicy, ent.PermissionPolicy](),
+		engineConverter: mapper.NewEnumTypeConverter[permissionV1.PermissionPolicy_PolicyEngine, permissionpolicy.PolicyEngine](
+			permissionV1.PermissionPolicy_PolicyEngine_name, permissionV1.PermissionPolicy_PolicyEngine_value,
+		),
+		statusConverter: mapper.NewEnumTypeConverter[permissionV1.PermissionPolicy_Status, permissionpolicy.Status](
+			permissionV1.PermissionPolicy_Status_name, permissionV1.PermissionPolicy_Status_value,
+		),
+	}
+	repo.init()
+	return repo
+}
+
+func TestPermissionPolicyRepo_ListCelPoliciesByApi(t *testing.T) {
+	repo := newPermissionPolicyRepoSqlite(t)
+	ctx := enttest.NewSystemViewerCtx(context.Background())
+	client := repo.entClient.Client()
+
+	perm, err := client.Permission.Create().
+		SetName("查看用户").
+		SetCode("sys:user:view").
+		Save(ctx)
+	require.NoError(t, err)
+
+	route, err := client.Api.Create().
+		SetPath("/admin/v1/users/{id}").
+		SetMethod("GET").
+		Save(ctx)
+	require.NoError(t, err)
+
+	_, err = client.PermissionApi.Create().
+		SetPermissionID(perm.ID).
+		SetAPIID(route.ID).
+		Save(ctx)
+	require.NoError(t, err)
+
+	create := func(engine permissionV1.PermissionPolicy_PolicyEngine, status permissionV1.PermissionPolicy_Status, order uint32, ttl uint32) {
+		require.NoError(t, repo.Create(ctx, &permissionV1.CreatePermissionPolicyRequest{
+			Data: &permissionV1.PermissionPolicy{
+				PermissionId: trans.Ptr(perm.ID),
+				PolicyEngine: trans.Ptr(engine),
+				Definition:   trans.Ptr(`{"expression": "subject.user_id > 0"}`),
+				EvalOrder:    trans.Ptr(order),
+				CacheTtl:     trans.Ptr(ttl),
+				Status:       trans.Ptr(status),
+			},
+		}))
+	}
+	create(permissionV1.PermissionPolicy_CEL, permissionV1.PermissionPolicy_ON, 20, 0)
+	create(permissionV1.PermissionPolicy_CEL, permissionV1.PermissionPolicy_ON, 10, 60)
+	// 停用的策略和非 CEL 引擎的策略不参与判定
+	create(permissionV1.PermissionPolicy_CEL, permissionV1.PermissionPolicy_OFF, 0, 0)
+	create(permissionV1.PermissionPolicy_OPA, permissionV1.PermissionPolicy_ON, 0, 0)
+
+	policies, err := repo.ListCelPoliciesByApi(ctx, "/admin/v1/users/{id}", "GET")
+	require.NoError(t, err)
+	require.Len(t, policies, 2)
+	assert.Equal(t, uint32(10), policies[0].EvalOrder)
+	assert.Equal(t, time.Minute, policies[0].CacheTTL)
+	assert.Equal(t, uint32(20), policies[1].EvalOrder)
+	assert.Equal(t, perm.ID, policies[0].PermissionID)
+	assert.False(t, policies[0].UpdatedAt.IsZero())
+
+	policies, err = repo.ListCelPoliciesByApi(ctx, "/admin/v1/users/{id}", "DELETE")
+	require.NoError(t, err)
+	assert.Empty(t, policies)
+
+	policies, err = repo.ListCelPoliciesByPermissionCode(ctx, "sys:user:view")
+	require.NoError(t, err)
+	assert.Len(t, policies, 2)
+}
@@ -383,6 +383,49 @@ func (r *PermissionRepo) BatchCreate(ctx context.Context, permissions []*permiss
 	return nil
 }
 
+// CreateMissingPermissions 按权限代码补齐缺失的权限，返回新建权限的ID列表；已存在的权限不做修改
+func (r *PermissionRepo) CreateMissingPermissions(ctx context.Context, permissions []*permissionV1.Permission) ([]uint32, error) {
+	codes := make([]string, 0, len(permissions))
+	for _, perm := range permissions {
+		codes = append(codes, perm.GetCode())
+	}
+
+	existCodes, err := r.entClient.Client().Permission.Query().
+		Where(
+			permission.CodeIn(codes...),
+		).
+		Select(permission.FieldCode).
+		Strings(ctx)
+	if err != nil {
+		r.log.Errorf("query permission codes failed: %s", err.Error())
+		return nil, permissionV1.ErrorInternalServerError("query permission codes failed")
+	}
+
+	exists := make(map[string]struct{}, len(existCodes))
+	for _, code := range existCodes {
+		exists[code] = struct{}{}
+	}
+
+	var missing []*permissionV1.Permission
+	var missingCodes []string
+	for _, perm := range permissions {
+		if _, ok := exists[perm.GetCode()]; ok {
+			continue
+		}
+		missing = append(missing, perm)
+		missingCodes = append(missingCodes, perm.GetCode())
+	}
+	if len(missing) == 0 {
+		return nil, nil
+	}
+
+	if err = r.BatchCreate(ctx, missing); err != nil {
+		return nil, err
+	}
+
+	return r.GetPermissionIDsByCodes(ctx, missingCodes)
+}
+
 // newPermissionCreate 创建 Permission Create 构造器
 func (r *PermissionRepo) newPermissionCreate(permission *permissionV1.Permission) *ent.PermissionCreate {
 	builder := r.entClient.Client().Permission.Create().
@@ -574,11 +617,13 @@ func (r *PermissionRepo) CleanMenuPermissions(ctx context.Context) error {
 	return r.permissionMenuRepo.Truncate(ctx)
 }
 
-// TruncateBizPermissions 清理业务权限
+// TruncateBizPermissions 清理业务权限，系统权限、字段权限与动作权限保留
 func (r *PermissionRepo) TruncateBizPermissions(ctx context.Context) error {
 	builder := r.entClient.Client().Permission.Delete().
 		Where(
 			permission.Not(permission.CodeHasPrefix(constants.SystemPermissionCodePrefix)),
+			permission.CodeNotIn(constants.FieldPermissionCodes...),
+			permission.CodeNotIn(constants.ActionPermissionCodes...),
 		)
 
 	_, err := builder.Exec(ctx)
@@ -0,0 +1,98 @@
+package data
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	conf "github.com/tx7do/kratos-bootstrap/api/gen/go/conf/v1"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"go-wind-admin/app/admin/service/internal/data/enttest"
+
+	permissionV1 "go-wind-admin/api/gen/go/permission/service/v1"
+
+	"go-wind-admin/pkg/constants"
+)
+
+func TestPermissionRepo_MigrateFieldPermissions(t *testing.T) {
+	entClient := enttest.NewEntClientForTest(t)
+	bctx := bootstrap.NewContextWithParam(context.Background(), &conf.AppInfo{}, &conf.Bootstrap{}, log.DefaultLogger)
+	ctx := enttest.NewSystemViewerCtx(context.Background())
+	client := entClient.Client()
+
+	permissionRepo := NewPermissionRepo(bctx, entClient, NewPermissionApiRepo(bctx, entClient), NewPermissionMenuRepo(bctx, entClient))
+	rolePermissionRepo := NewRolePermissionRepo(bctx, entClient)
+	roleRepo := NewRoleRepo(bctx, entClient, rolePermissionRepo, permissionRepo, NewRoleMetadataRepo(bctx, entClient))
+
+	// 已有数据的库：内置权限与角色已存在，字段权限尚未创建
+	legacy, err := client.Permission.Create().
+		SetName("平台管理").
+		SetCode(constants.SystemPlatformAdminPermissionCode).
+		Save(ctx)
+	require.NoError(t, err)
+
+	roles := map[string]uint32{}
+	for _, r := range []struct {
+		key      string
+		tenantID uint32
+		code     string
+	}{
+		{"platform", 0, constants.PlatformAdminRoleCode},
+		{"template", 0, constants.TenantAdminTemplateRoleCode},
+		{"tenant1", 1, constants.TenantAdminRoleCode},
+		{"tenant2", 2, constants.TenantAdminRoleCode},
+		{"staff", 1, "staff"},
+	} {
+		entity, err := client.Role.Create().SetTenantID(r.tenantID).SetName(r.key).SetCode(r.code).Save(ctx)
+		require.NoError(t, err)
+		roles[r.key] = entity.ID
+	}
+
+	var fieldPermissions []*permissionV1.Permission
+	for _, d := range constants.DefaultPermissions {
+		if slices.Contains(constants.FieldPermissionCodes, d.GetCode()) {
+			fieldPermissions = append(fieldPermissions, d)
+		}
+	}
+	require.Len(t, fieldPermissions, len(constants.FieldPermissionCodes))
+
+	ids, err := permissionRepo.CreateMissingPermissions(ctx, fieldPermissions)
+	require.NoError(t, err)
+	require.Len(t, ids, len(constants.FieldPermissionCodes))
+
+	adminRoleCodes := []string{constants.PlatformAdminRoleCode, constants.TenantAdminTemplateRoleCode, constants.TenantAdminRoleCode}
+	adminIDs, err := permissionRepo.GetPermissionIDsByCodes(ctx, constants.AdminFieldPermissionCodes)
+	require.NoError(t, err)
+	require.Len(t, adminIDs, len(constants.AdminFieldPermissionCodes))
+
+	// 跨租户授权只允许系统上下文
+	assert.Error(t, roleRepo.GrantPermissionsByRoleCodes(context.Background(), adminRoleCodes, adminIDs))
+
+	require.NoError(t, roleRepo.GrantPermissionsByRoleCodes(ctx, adminRoleCodes, adminIDs))
+
+	for key, roleID := range roles {
+		granted, err := rolePermissionRepo.ListPermissionIDs(ctx, roleID)
+		require.NoError(t, err)
+		if key == "staff" {
+			assert.Empty(t, granted, key)
+			continue
+		}
+		assert.ElementsMatch(t, adminIDs, granted, key)
+	}
+
+	// 再次执行时字段权限均已存在，不再新建
+	ids, err = permissionRepo.CreateMissingPermissions(ctx, fieldPermissions)
+	require.NoError(t, err)
+	assert.Empty(t, ids)
+
+	// 同步权限清理业务权限时保留字段权限
+	require.NoError(t, permissionRepo.TruncateBizPermissions(ctx))
+	remain, err := permissionRepo.GetPermissionIDsByCodes(ctx, append([]string{*legacy.Code}, constants.FieldPermissionCodes...))
+	require.NoError(t, err)
+	assert.Len(t, remain, len(constants.FieldPermissionCodes)+1)
+}
@@ -38,6 +38,9 @@
 	data.NewUserTokenCache,
 	data.NewLoginRateLimiter,
 	data.NewMfaChallengeCache,
+	data.NewContactBindingCache,
+	data.NewWebAuthnRelyingParty,
+	data.NewSender,
 	data.NewRedisCacheMonitorRepo,
 
 	data.NewDictTypeRepo,
@@ -48,6 +51,17 @@
 	data.NewTaskRepo,
 	data.NewBackupRepo,
 	data.NewLoginPolicyRepo,
+	data.NewApiClientRepo,
+	data.NewOAuthCodeCache,
+	data.NewOAuthProviderConfigRepo,
+	data.NewOAuthStateCache,
+	data.NewSocialAccountRepo,
+	data.NewSamlConfigRepo,
+	data.NewSamlSessionCache,
+	data.NewSamlAccountRepo,
+	data.NewLdapConfigRepo,
+	data.NewLdapAccountRepo,
+	data.NewScimTokenRepo,
 
 	data.NewOrgUnitRepo,
 	data.NewPositionRepo,
@@ -58,8 +72,11 @@
 	data.NewTenantUsageRepo,
 
 	data.NewUserRepo,
+	data.NewPasswordPolicyRepo,
 	data.NewUserCredentialRepo,
 	data.NewUserMfaFactorRepo,
+	data.NewMfaPolicyRepo,
+	data.NewJwtSigningKeyRepo,
 	data.NewUserOrgUnitRepo,
 	data.NewUserPositionRepo,
 	data.NewUserRoleRepo,
@@ -81,7 +98,11 @@
 	data.NewPermissionApiRepo,
 	data.NewPermissionMenuRepo,
 	data.NewPermissionAuditLogRepo,
+	data.NewPermissionPolicyRepo,
 	data.NewPolicyEvaluationLogRepo,
+	data.NewRelationTupleRepo,
+	data.NewDataScopeResolver,
+	data.NewPermissionCodeResolver,
 
 	data.NewLoginAuditLogRepo,
 	data.NewApiAuditLogRepo,
@@ -0,0 +1,195 @@
+package data
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+)
+
+// 刷新令牌族：同一会话内依次轮换出的刷新令牌属于同一族（族 ID 即会话 ID）。
+// 每个签发过的刷新令牌以摘要为键记录其归属与状态 rtf:{sha256(rt)}，
+// 刷新时据此定位族并原子地将其标记为已轮换；已轮换的令牌再次出现即视为泄露，吊销整族。
+
+const (
+	// RefreshTokenFamilyKeyFormat 刷新令牌族记录键格式 rtf:{sha256(rt)}
+	RefreshTokenFamilyKeyFormat = "rtf:%s"
+
+	// RefreshTokenReuseGracePeriod 并发刷新宽限期：令牌轮换后该时间内再次出现按并发请求处理，不判定为重放
+	RefreshTokenReuseGracePeriod = 10 * time.Second
+)
+
+const (
+	refreshFamilyFieldClientType = "ct"
+	refreshFamilyFieldUserID     = "uid"
+	refreshFamilyFieldSessionID  = "sid"
+	refreshFamilyFieldJti        = "jti"
+	refreshFamilyFieldState      = "st"
+
+	refreshFamilyStateActive = "active"
+)
+
+var (
+	// ErrRefreshTokenInvalid 刷新令牌不存在、已过期、与记录不符或所属会话已注销
+	ErrRefreshTokenInvalid = errors.New("refresh token invalid")
+	// ErrRefreshTokenRotating 刷新令牌刚被并发请求轮换（宽限期内），应改用最新令牌重试
+	ErrRefreshTokenRotating = errors.New("refresh token rotating")
+	// ErrRefreshTokenReused 已轮换的刷新令牌被重放，整族已吊销
+	ErrRefreshTokenReused = errors.New("refresh token reused")
+)
+
+// consumeRefreshTokenScript 原子消费刷新令牌。
+// KEYS: 族记录、访问令牌、刷新令牌、会话；ARGV: 刷新令牌、当前毫秒时间戳、宽限期毫秒、访问/刷新令牌键前缀（不含 jti）。
+// 返回值: {1, scopes, auth_time}=消费成功, {0}=无效, {2}=宽限期内的并发刷新, {3}=重放（已吊销整族）
+var consumeRefreshTokenScript = redis.NewScript(`
+	local familyKey = KEYS[1]
+	local state = redis.call('HGET', familyKey, 'st')
+	if not state then
+		return {0}
+	end
+
+	if state == 'active' then
+		if redis.call('GET', KEYS[3]) ~= ARGV[1] then
+			return {0}
+		end
+		redis.call('DEL', KEYS[2], KEYS[3])
+		redis.call('HSET', familyKey, 'st', 'rotated', 'rotated_at', ARGV[2])
+		local sess = redis.call('HMGET', KEYS[4], 'scp', 'auth_time')
+		return {1, sess[1] or '', sess[2] or ''}
+	end
+
+	local rotatedAt = tonumber(redis.call('HGET', familyKey, 'rotated_at') or '0')
+	if tonumber(ARGV[2]) - rotatedAt <= tonumber(ARGV[3]) then
+		return {2}
+	end
+
+	local jti = redis.call('HGET', KEYS[4], 'jti')
+	if jti then
+		redis.call('DEL', ARGV[4] .. jti, ARGV[5] .. jti)
+	end
+	redis.call('DEL', KEYS[4])
+	return {3}
+`)
+
+// RefreshTokenFamily 刷新令牌所属的族（会话）
+type RefreshTokenFamily struct {
+	ClientType authenticationV1.ClientType
+	UserID     uint32
+	SessionID  string
+	Scopes     []string
+	AuthTime   int64 // 会话最近一次身份验证时间（Unix 秒），新令牌沿用
+}
+
+// ConsumeRefreshToken 消费刷新令牌：校验通过后吊销其令牌对并标记为已轮换，随后应在同一会话内签发新令牌对。
+//
+// 返回：
+//   - ErrRefreshTokenInvalid：令牌无效，或不属于 clientType（此时不消费令牌）；
+//   - ErrRefreshTokenRotating：宽限期内的并发刷新，不影响会话；
+//   - ErrRefreshTokenReused：已轮换的令牌被重放，整族已吊销，同时返回族信息用于审计。
+func (r *UserTokenCache) ConsumeRefreshToken(
+	ctx context.Context,
+	clientType authenticationV1.ClientType,
+	refreshToken string,
+	gracePeriod time.Duration,
+) (*RefreshTokenFamily, error) {
+	familyKey := r.makeRefreshTokenFamilyKey(refreshToken)
+
+	family, jti, err := r.getRefreshTokenFamily(ctx, familyKey, clientType)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := []string{
+		familyKey,
+		r.makeAccessTokenFieldKey(family.ClientType, family.UserID, jti),
+		r.makeRefreshTokenFieldKey(family.ClientType, family.UserID, jti),
+		r.makeSessionFieldKey(family.ClientType, family.UserID, family.SessionID),
+	}
+	result, err := consumeRefreshTokenScript.Run(ctx, r.rdb, keys,
+		refreshToken,
+		time.Now().UnixMilli(),
+		gracePeriod.Milliseconds(),
+		r.makeAccessTokenKey(family.ClientType, family.UserID)+":",
+		r.makeRefreshTokenKey(family.ClientType, family.UserID)+":",
+	).Slice()
+	if err != nil {
+		r.log.Errorf("consume refresh token for user [%d] failed: %v", family.UserID, err)
+		return nil, err
+	}
+
+	code, _ := result[0].(int64)
+	switch code {
+	case 1:
+		if len(result) > 1 {
+			if scopes, _ := result[1].(string); scopes != "" {
+				family.Scopes = strings.Fields(scopes)
+			}
+		}
+		if len(result) > 2 {
+			if authTime, _ := result[2].(string); authTime != "" {
+				family.AuthTime, _ = strconv.ParseInt(authTime, 10, 64)
+			}
+		}
+		return family, nil
+	case 2:
+		return nil, ErrRefreshTokenRotating
+	case 3:
+		return family, ErrRefreshTokenReused
+	default:
+		return nil, ErrRefreshTokenInvalid
+	}
+}
+
+// PeekRefreshToken 查询刷新令牌所属的族但不消费，供刷新前预先加载用户；令牌是否可用以 ConsumeRefreshToken 为准。
+// 返回的族信息不含授权范围与身份验证时间。
+func (r *UserTokenCache) PeekRefreshToken(
+	ctx context.Context,
+	clientType authenticationV1.ClientType,
+	refreshToken string,
+) (*RefreshTokenFamily, error) {
+	family, _, err := r.getRefreshTokenFamily(ctx, r.makeRefreshTokenFamilyKey(refreshToken), clientType)
+	return family, err
+}
+
+// getRefreshTokenFamily 读取族记录并校验客户端类型，返回族信息与当前令牌对的 jti
+func (r *UserTokenCache) getRefreshTokenFamily(
+	ctx context.Context,
+	familyKey string,
+	clientType authenticationV1.ClientType,
+) (*RefreshTokenFamily, string, error) {
+	values, err := r.rdb.HGetAll(ctx, familyKey).Result()
+	if err != nil {
+		r.log.Errorf("get refresh token family failed: %v", err)
+		return nil, "", err
+	}
+	if len(values) == 0 {
+		return nil, "", ErrRefreshTokenInvalid
+	}
+
+	ct, _ := strconv.ParseInt(values[refreshFamilyFieldClientType], 10, 32)
+	family := &RefreshTokenFamily{
+		ClientType: authenticationV1.ClientType(ct),
+		UserID:     parseSessionUint32(values[refreshFamilyFieldUserID]),
+		SessionID:  values[refreshFamilyFieldSessionID],
+	}
+	jti := values[refreshFamilyFieldJti]
+	if family.ClientType != clientType || family.UserID == 0 || family.SessionID == "" || jti == "" {
+		return nil, "", ErrRefreshTokenInvalid
+	}
+
+	return family, jti, nil
+}
+
+// makeRefreshTokenFamilyKey 生成刷新令牌族记录键，令牌明文不入键
+func (r *UserTokenCache) makeRefreshTokenFamilyKey(refreshToken string) string {
+	sum := sha256.Sum256([]byte(refreshToken))
+	return fmt.Sprintf(RefreshTokenFamilyKeyFormat, hex.EncodeToString(sum[:]))
+}
@@ -0,0 +1,115 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	conf "github.com/tx7do/kratos-bootstrap/api/gen/go/conf/v1"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+)
+
+func TestAuthenticator_RefreshTokenRotation(t *testing.T) {
+	mr, err := miniredis.Run()
+	require.NoError(t, err)
+	t.Cleanup(mr.Close)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+
+	bctx := bootstrap.NewContextWithParam(context.Background(), &conf.AppInfo{}, &conf.Bootstrap{
+		Authn: &conf.Authentication{
+			Jwt: &conf.Authentication_Jwt{Method: "HS256", Key: "refresh-rotation-test-signing-key"},
+		},
+	}, log.DefaultLogger)
+	cache := NewUserTokenCache(bctx, rdb)
+	a := NewAuthenticator(bctx, cache, nil)
+
+	ctx := context.Background()
+	clientType := authenticationV1.ClientType_admin
+	const userID = 9191
+
+	valid := func(accessToken string) bool {
+		_, err := a.Authenticate(ctx, &authenticationV1.ValidateTokenRequest{
+			Token:         accessToken,
+			ClientType:    clientType,
+			TokenCategory: authenticationV1.TokenCategory_ACCESS,
+		})
+		return err == nil
+	}
+	// rotate 消费刷新令牌并在同一会话内签发新令牌对
+	rotate := func(refreshToken string) (string, string) {
+		family, err := a.ConsumeRefreshToken(ctx, clientType, refreshToken)
+		require.NoError(t, err)
+		accessToken, nextRefreshToken, err := a.CreateUserToken(ctx, clientType, &authenticationV1.UserTokenPayload{
+			UserId:    family.UserID,
+			SessionId: &family.SessionID,
+			Scopes:    family.Scopes,
+		})
+		require.NoError(t, err)
+		return accessToken, nextRefreshToken
+	}
+	// expireGrace 模拟宽限期已过
+	expireGrace := func(refreshToken string) {
+		require.NoError(t, rdb.HSet(ctx, cache.makeRefreshTokenFamilyKey(refreshToken),
+			"rotated_at", time.Now().Add(-2*RefreshTokenReuseGracePeriod).UnixMilli()).Err())
+	}
+
+	login := &authenticationV1.UserTokenPayload{UserId: userID, Scopes: []string{"openid", "profile"}}
+	_, firstRT, err := a.CreateUserToken(ctx, clientType, login)
+	require.NoError(t, err)
+	other := &authenticationV1.UserTokenPayload{UserId: userID}
+	otherAT, _, err := a.CreateUserToken(ctx, clientType, other)
+	require.NoError(t, err)
+
+	// 刷新令牌只对签发时的客户端类型有效
+	_, err = a.ConsumeRefreshToken(ctx, authenticationV1.ClientType_app, firstRT)
+	assert.True(t, authenticationV1.IsIncorrectRefreshToken(err))
+	_, err = a.ConsumeRefreshToken(ctx, clientType, "unknown")
+	assert.True(t, authenticationV1.IsIncorrectRefreshToken(err))
+
+	// 轮换后沿用会话与授权范围
+	family, err := cache.ConsumeRefreshToken(ctx, clientType, firstRT, RefreshTokenReuseGracePeriod)
+	require.NoError(t, err)
+	assert.Equal(t, login.GetSessionId(), family.SessionID)
+	assert.Equal(t, []string{"openid", "profile"}, family.Scopes)
+	secondAT, secondRT, err := a.CreateUserToken(ctx, clientType, &authenticationV1.UserTokenPayload{
+		UserId:    userID,
+		SessionId: &family.SessionID,
+		Scopes:    family.Scopes,
+	})
+	require.NoError(t, err)
+	assert.True(t, valid(secondAT))
+
+	// 宽限期内的并发刷新：拒绝但不吊销会话
+	_, err = a.ConsumeRefreshToken(ctx, clientType, firstRT)
+	assert.True(t, authenticationV1.IsConflict(err))
+	assert.True(t, valid(secondAT))
+
+	thirdAT, thirdRT := rotate(secondRT)
+	assert.False(t, valid(secondAT))
+	assert.True(t, valid(thirdAT))
+
+	// 宽限期后重放已轮换的令牌：整族吊销，其他会话不受影响
+	expireGrace(firstRT)
+	family, err = a.ConsumeRefreshToken(ctx, clientType, firstRT)
+	require.True(t, errors.Is(err, ErrRefreshTokenReused))
+	assert.Equal(t, uint32(userID), family.UserID)
+	assert.Equal(t, login.GetSessionId(), family.SessionID)
+	assert.False(t, valid(thirdAT))
+	assert.True(t, valid(otherAT))
+	_, err = a.ConsumeRefreshToken(ctx, clientType, thirdRT)
+	assert.True(t, authenticationV1.IsIncorrectRefreshToken(err))
+
+	sessions, err := a.ListUserSessions(ctx, clientType, userID, "")
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	assert.Equal(t, other.GetSessionId(), sessions[0].GetSessionId())
+}
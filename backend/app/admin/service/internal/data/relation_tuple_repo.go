@@ -0,0 +1,298 @@
+package data
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/go-crud/viewer"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"go-wind-admin/app/admin/service/internal/data/ent"
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+	"go-wind-admin/app/admin/service/internal/data/ent/relationtuple"
+	"go-wind-admin/app/admin/service/internal/data/ent/relationtuplechange"
+
+	permissionV1 "go-wind-admin/api/gen/go/permission/service/v1"
+
+	"go-wind-admin/pkg/authorizer/zanzibar"
+)
+
+var _ zanzibar.Store = (*RelationTupleRepo)(nil)
+
+// RelationTupleRepo 关系元组存储（ReBAC 引擎的 zanzibar.Store 实现）。
+// 每次写入在同一事务内追加一条变更日志，变更日志的自增ID即元组版本号。
+type RelationTupleRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+}
+
+func NewRelationTupleRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *RelationTupleRepo {
+	return &RelationTupleRepo{
+		log:       ctx.NewLoggerHelper("relation-tuple/repo/admin-service"),
+		entClient: entClient,
+	}
+}
+
+// ReadTuples 按条件读取元组
+func (r *RelationTupleRepo) ReadTuples(ctx context.Context, filter zanzibar.TupleFilter) ([]zanzibar.Tuple, error) {
+	var conds []predicate.RelationTuple
+	if filter.Namespace != "" {
+		conds = append(conds, relationtuple.NamespaceEQ(filter.Namespace))
+	}
+	if filter.ObjectID != "" {
+		conds = append(conds, relationtuple.ObjectIDEQ(filter.ObjectID))
+	}
+	if filter.Relation != "" {
+		conds = append(conds, relationtuple.RelationEQ(filter.Relation))
+	}
+	if filter.Subject != nil {
+		conds = append(conds,
+			relationtuple.SubjectNamespaceEQ(filter.Subject.Namespace),
+			relationtuple.SubjectIDEQ(filter.Subject.ID),
+			relationtuple.SubjectRelationEQ(filter.Subject.Relation),
+		)
+	}
+
+	entities, err := r.entClient.Client().RelationTuple.Query().
+		Where(conds...).
+		Order(ent.Asc(relationtuple.FieldID)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("query relation tuples failed: %s", err.Error())
+		return nil, permissionV1.ErrorInternalServerError("query relation tuples failed")
+	}
+
+	tuples := make([]zanzibar.Tuple, 0, len(entities))
+	for _, e := range entities {
+		tuples = append(tuples, zanzibar.Tuple{
+			Object:   zanzibar.Object{Namespace: e.Namespace, ID: e.ObjectID},
+			Relation: e.Relation,
+			Subject: zanzibar.Subject{
+				Namespace: e.SubjectNamespace,
+				ID:        e.SubjectID,
+				Relation:  e.SubjectRelation,
+			},
+		})
+	}
+
+	return tuples, nil
+}
+
+// ListObjectIDs 列出命名空间下作为对象或主体出现过的对象ID
+func (r *RelationTupleRepo) ListObjectIDs(ctx context.Context, namespace string) ([]string, error) {
+	objectIDs, err := r.entClient.Client().RelationTuple.Query().
+		Where(relationtuple.NamespaceEQ(namespace)).
+		Unique(true).
+		Select(relationtuple.FieldObjectID).
+		Strings(ctx)
+	if err != nil {
+		r.log.Errorf("query relation objects of [%s] failed: %s", namespace, err.Error())
+		return nil, permissionV1.ErrorInternalServerError("query relation objects failed")
+	}
+
+	subjectIDs, err := r.entClient.Client().RelationTuple.Query().
+		Where(relationtuple.SubjectNamespaceEQ(namespace)).
+		Unique(true).
+		Select(relationtuple.FieldSubjectID).
+		Strings(ctx)
+	if err != nil {
+		r.log.Errorf("query relation subjects of [%s] failed: %s", namespace, err.Error())
+		return nil, permissionV1.ErrorInternalServerError("query relation objects failed")
+	}
+
+	seen := make(map[string]struct{}, len(objectIDs)+len(subjectIDs))
+	ids := make([]string, 0, len(objectIDs)+len(subjectIDs))
+	for _, id := range append(objectIDs, subjectIDs...) {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	return ids, nil
+}
+
+// WriteTuples 在同一事务内删除、写入元组并追加变更日志
+func (r *RelationTupleRepo) WriteTuples(ctx context.Context, writes, deletes []zanzibar.Tuple) (rev zanzibar.Revision, err error) {
+	var tx *ent.Tx
+	tx, err = r.entClient.Client().Tx(ctx)
+	if err != nil {
+		r.log.Errorf("start transaction failed: %s", err.Error())
+		return 0, permissionV1.ErrorInternalServerError("start transaction failed")
+	}
+	defer func() {
+		if err != nil {
+			if rollbackErr := tx.Rollback(); rollbackErr != nil {
+				r.log.Errorf("transaction rollback failed: %s", rollbackErr.Error())
+			}
+			return
+		}
+		if commitErr := tx.Commit(); commitErr != nil {
+			r.log.Errorf("transaction commit failed: %s", commitErr.Error())
+			err = permissionV1.ErrorInternalServerError("transaction commit failed")
+		}
+	}()
+
+	if len(deletes) > 0 {
+		if err = r.deleteTuples(ctx, tx, deletes); err != nil {
+			return 0, err
+		}
+		if rev, err = r.appendChange(ctx, tx, relationtuplechange.OperationDelete, tupleLines(deletes)); err != nil {
+			return 0, err
+		}
+		if len(writes) == 0 {
+			return rev, nil
+		}
+	}
+
+	if err = r.createTuples(ctx, tx, writes); err != nil {
+		return 0, err
+	}
+
+	return r.appendChange(ctx, tx, relationtuplechange.OperationWrite, tupleLines(writes))
+}
+
+// ReplaceNamespace 在同一事务内清空命名空间并写入新元组
+func (r *RelationTupleRepo) ReplaceNamespace(ctx context.Context, namespace string, tuples []zanzibar.Tuple) (rev zanzibar.Revision, err error) {
+	var tx *ent.Tx
+	tx, err = r.entClient.Client().Tx(ctx)
+	if err != nil {
+		r.log.Errorf("start transaction failed: %s", err.Error())
+		return 0, permissionV1.ErrorInternalServerError("start transaction failed")
+	}
+	defer func() {
+		if err != nil {
+			if rollbackErr := tx.Rollback(); rollbackErr != nil {
+				r.log.Errorf("transaction rollback failed: %s", rollbackErr.Error())
+			}
+			return
+		}
+		if commitErr := tx.Commit(); commitErr != nil {
+			r.log.Errorf("transaction commit failed: %s", commitErr.Error())
+			err = permissionV1.ErrorInternalServerError("transaction commit failed")
+		}
+	}()
+
+	if _, err = tx.RelationTuple.Delete().
+		Where(relationtuple.NamespaceEQ(namespace)).
+		Exec(ctx); err != nil {
+		r.log.Errorf("clear relation namespace [%s] failed: %s", namespace, err.Error())
+		return 0, permissionV1.ErrorInternalServerError("clear relation namespace failed")
+	}
+
+	if err = r.createTuples(ctx, tx, tuples); err != nil {
+		return 0, err
+	}
+
+	return r.appendChange(ctx, tx, relationtuplechange.OperationReplace, namespace)
+}
+
+// Revision 当前版本号，即最新变更日志的ID
+func (r *RelationTupleRepo) Revision(ctx context.Context) (zanzibar.Revision, error) {
+	change, err := r.entClient.Client().RelationTupleChange.Query().
+		Order(ent.Desc(relationtuplechange.FieldID)).
+		Select(relationtuplechange.FieldID).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return 0, nil
+		}
+		r.log.Errorf("query relation tuple revision failed: %s", err.Error())
+		return 0, permissionV1.ErrorInternalServerError("query relation tuple revision failed")
+	}
+
+	return zanzibar.Revision(change.ID), nil
+}
+
+func (r *RelationTupleRepo) createTuples(ctx context.Context, tx *ent.Tx, tuples []zanzibar.Tuple) error {
+	now := time.Now()
+	operatorID := operatorFromViewer(ctx)
+
+	for _, t := range tuples {
+		// 已存在的元组保持不变，写入幂等
+		if err := tx.RelationTuple.Create().
+			SetNamespace(t.Object.Namespace).
+			SetObjectID(t.Object.ID).
+			SetRelation(t.Relation).
+			SetSubjectNamespace(t.Subject.Namespace).
+			SetSubjectID(t.Subject.ID).
+			SetSubjectRelation(t.Subject.Relation).
+			SetNillableCreatedBy(operatorID).
+			SetCreatedAt(now).
+			OnConflictColumns(
+				relationtuple.FieldNamespace,
+				relationtuple.FieldObjectID,
+				relationtuple.FieldRelation,
+				relationtuple.FieldSubjectNamespace,
+				relationtuple.FieldSubjectID,
+				relationtuple.FieldSubjectRelation,
+			).
+			Ignore().
+			Exec(ctx); err != nil {
+			r.log.Errorf("insert relation tuple [%s] failed: %s", t.String(), err.Error())
+			return permissionV1.ErrorInternalServerError("insert relation tuple failed")
+		}
+	}
+
+	return nil
+}
+
+func (r *RelationTupleRepo) deleteTuples(ctx context.Context, tx *ent.Tx, tuples []zanzibar.Tuple) error {
+	for _, t := range tuples {
+		if _, err := tx.RelationTuple.Delete().
+			Where(
+				relationtuple.NamespaceEQ(t.Object.Namespace),
+				relationtuple.ObjectIDEQ(t.Object.ID),
+				relationtuple.RelationEQ(t.Relation),
+				relationtuple.SubjectNamespaceEQ(t.Subject.Namespace),
+				relationtuple.SubjectIDEQ(t.Subject.ID),
+				relationtuple.SubjectRelationEQ(t.Subject.Relation),
+			).
+			Exec(ctx); err != nil {
+			r.log.Errorf("delete relation tuple [%s] failed: %s", t.String(), err.Error())
+			return permissionV1.ErrorInternalServerError("delete relation tuple failed")
+		}
+	}
+
+	return nil
+}
+
+func (r *RelationTupleRepo) appendChange(ctx context.Context, tx *ent.Tx, op relationtuplechange.Operation, content string) (zanzibar.Revision, error) {
+	change, err := tx.RelationTupleChange.Create().
+		SetOperation(op).
+		SetTuples(content).
+		SetNillableCreatedBy(operatorFromViewer(ctx)).
+		SetCreatedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		r.log.Errorf("insert relation tuple change failed: %s", err.Error())
+		return 0, permissionV1.ErrorInternalServerError("insert relation tuple change failed")
+	}
+
+	return zanzibar.Revision(change.ID), nil
+}
+
+func tupleLines(tuples []zanzibar.Tuple) string {
+	lines := make([]string, 0, len(tuples))
+	for _, t := range tuples {
+		lines = append(lines, t.String())
+	}
+	return strings.Join(lines, "\n")
+}
+
+// operatorFromViewer 取当前访问者的用户ID，系统身份返回 nil
+func operatorFromViewer(ctx context.Context) *uint32 {
+	vc, ok := viewer.FromContext(ctx)
+	if !ok || vc.UserID() == 0 {
+		return nil
+	}
+	id := uint32(vc.UserID())
+	return &id
+}
@@ -0,0 +1,122 @@
+package data
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go-wind-admin/app/admin/service/internal/data/enttest"
+
+	"go-wind-admin/pkg/authorizer/zanzibar"
+)
+
+func newRelationTupleRepoSqlite(t *testing.T) *RelationTupleRepo {
+	t.Helper()
+	return &RelationTupleRepo{
+		entClient: enttest.NewEntClientForTest(t),
+		log:       log.NewHelper(log.NewStdLogger(io.Discard)),
+	}
+}
+
+func mustParseTuples(t *testing.T, texts ...string) []zanzibar.Tuple {
+	t.Helper()
+	tuples := make([]zanzibar.Tuple, 0, len(texts))
+	for _, s := range texts {
+		tuple, err := zanzibar.ParseTuple(s)
+		require.NoError(t, err, s)
+		tuples = append(tuples, tuple)
+	}
+	return tuples
+}
+
+func TestRelationTupleRepo_Store(t *testing.T) {
+	repo := newRelationTupleRepoSqlite(t)
+	ctx := enttest.NewSystemViewerCtx(context.Background())
+
+	rev, err := repo.Revision(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, zanzibar.Revision(0), rev)
+
+	rev1, err := repo.WriteTuples(ctx, mustParseTuples(t,
+		"document:1#owner@user:1",
+		"document:1#viewer@org_unit:2#member",
+		"org_unit:2#parent@org_unit:1",
+	), nil)
+	require.NoError(t, err)
+	assert.Greater(t, rev1, zanzibar.Revision(0))
+
+	// 重复写入幂等，版本仍递增
+	rev2, err := repo.WriteTuples(ctx, mustParseTuples(t, "document:1#owner@user:1"), nil)
+	require.NoError(t, err)
+	assert.Greater(t, rev2, rev1)
+
+	tuples, err := repo.ReadTuples(ctx, zanzibar.TupleFilter{Namespace: "document", ObjectID: "1"})
+	require.NoError(t, err)
+	require.Len(t, tuples, 2)
+	assert.Equal(t, "document:1#owner@user:1", tuples[0].String())
+	assert.Equal(t, "document:1#viewer@org_unit:2#member", tuples[1].String())
+
+	tuples, err = repo.ReadTuples(ctx, zanzibar.TupleFilter{
+		Subject: &zanzibar.Subject{Namespace: "org_unit", ID: "2", Relation: "member"},
+	})
+	require.NoError(t, err)
+	assert.Len(t, tuples, 1)
+
+	ids, err := repo.ListObjectIDs(ctx, "org_unit")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1", "2"}, ids)
+
+	rev3, err := repo.WriteTuples(ctx, nil, mustParseTuples(t, "document:1#owner@user:1"))
+	require.NoError(t, err)
+	assert.Greater(t, rev3, rev2)
+	tuples, err = repo.ReadTuples(ctx, zanzibar.TupleFilter{Namespace: "document", Relation: "owner"})
+	require.NoError(t, err)
+	assert.Empty(t, tuples)
+
+	rev4, err := repo.ReplaceNamespace(ctx, "org_unit", mustParseTuples(t, "org_unit:3#parent@org_unit:1"))
+	require.NoError(t, err)
+	assert.Greater(t, rev4, rev3)
+	ids, err = repo.ListObjectIDs(ctx, "org_unit")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1", "2", "3"}, ids)
+
+	rev, err = repo.Revision(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, rev4, rev)
+}
+
+func TestRelationTupleRepo_EngineConsistency(t *testing.T) {
+	repo := newRelationTupleRepoSqlite(t)
+	ctx := enttest.NewSystemViewerCtx(context.Background())
+
+	namespaces, err := zanzibar.ParseNamespaces([]byte(`[
+		{"name": "user"},
+		{"name": "document", "relations": {"owner": {}, "editor": {"union": [{"this": true}, {"computed_userset": "owner"}]}}}
+	]`))
+	require.NoError(t, err)
+
+	// 两个引擎实例共享同一存储，模拟多实例部署
+	a, err := zanzibar.NewEngine(repo, namespaces)
+	require.NoError(t, err)
+	b, err := zanzibar.NewEngine(repo, namespaces)
+	require.NoError(t, err)
+
+	doc := zanzibar.Object{Namespace: "document", ID: "1"}
+	alice := zanzibar.Subject{Namespace: "user", ID: "1"}
+
+	allowed, _, err := b.Check(ctx, doc, "editor", alice, "")
+	require.NoError(t, err)
+	assert.False(t, allowed)
+
+	token, err := a.Write(ctx, mustParseTuples(t, "document:1#owner@user:1"), nil)
+	require.NoError(t, err)
+
+	// 实例 b 无令牌时可能返回缓存的旧结果，携带写入令牌时必须看到写入
+	allowed, _, err = b.Check(ctx, doc, "editor", alice, token)
+	require.NoError(t, err)
+	assert.True(t, allowed)
+}
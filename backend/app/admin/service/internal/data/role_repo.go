@@ -10,6 +10,7 @@
 
 	paginationV1 "github.com/tx7do/go-crud/api/gen/go/pagination/v1"
 	entCrud "github.com/tx7do/go-crud/entgo"
+	"github.com/tx7do/go-crud/viewer"
 
 	"github.com/tx7do/go-utils/copierutil"
 	"github.com/tx7do/go-utils/mapper"
@@ -19,6 +20,7 @@
 	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
 	"go-wind-admin/app/admin/service/internal/data/ent/role"
 
+	identityV1 "go-wind-admin/api/gen/go/identity/service/v1"
 	permissionV1 "go-wind-admin/api/gen/go/permission/service/v1"
 
 	"go-wind-admin/pkg/constants"
@@ -33,6 +35,8 @@ type RoleRepo struct {
 	statusConverter *mapper.EnumTypeConverter[permissionV1.Role_Status, role.Status]
 	typeConverter   *mapper.EnumTypeConverter[permissionV1.Role_Type, role.Type]
 
+	dataScopeConverter *mapper.EnumTypeConverter[identityV1.DataScope, role.DataScope]
+
 	repository *entCrud.Repository[
 		ent.RoleQuery, ent.RoleSelect,
 		ent.RoleCreate, ent.RoleCreateBulk,
@@ -66,6 +70,10 @@ func NewRoleRepo(
 			permissionV1.Role_Type_name,
 			permissionV1.Role_Type_value,
 		),
+		dataScopeConverter: mapper.NewEnumTypeConverter[identityV1.DataScope, role.DataScope](
+			identityV1.DataScope_name,
+			identityV1.DataScope_value,
+		),
 		permissionRepo:     permissionRepo,
 		rolePermissionRepo: rolePermissionRepo,
 		roleMetadataRepo:   roleMetadataRepo,
@@ -91,6 +99,7 @@ func (r *RoleRepo) init() {
 
 	r.mapper.AppendConverters(r.statusConverter.NewConverterPair())
 	r.mapper.AppendConverters(r.typeConverter.NewConverterPair())
+	r.mapper.AppendConverters(r.dataScopeConverter.NewConverterPair())
 }
 func (r *RoleRepo) Count(ctx context.Context, req *paginationV1.PagingRequest) (int, error) {
 	builder := r.entClient.Client().Role.Query()
@@ -371,16 +380,16 @@ func (r *RoleRepo) CreateTenantRoleFromTemplate(ctx context.Context, tx *ent.Tx,
 }
 
 // Create 创建角色
-func (r *RoleRepo) Create(ctx context.Context, req *permissionV1.CreateRoleRequest) (err error) {
+func (r *RoleRepo) Create(ctx context.Context, req *permissionV1.CreateRoleRequest) (dto *permissionV1.Role, err error) {
 	if req == nil || req.Data == nil {
-		return permissionV1.ErrorBadRequest("invalid parameter")
+		return nil, permissionV1.ErrorBadRequest("invalid parameter")
 	}
 
 	var tx *ent.Tx
 	tx, err = r.entClient.Client().Tx(ctx)
 	if err != nil {
 		r.log.Errorf("start transaction failed: %s", err.Error())
-		return permissionV1.ErrorInternalServerError("start transaction failed")
+		return nil, permissionV1.ErrorInternalServerError("start transaction failed")
 	}
 	defer func() {
 		if err != nil {
@@ -395,8 +404,7 @@ func (r *RoleRepo) Create(ctx context.Context, req *permissionV1.CreateRoleReque
 		}
 	}()
 
-	_, err = r.CreateWithTx(ctx, tx, req.GetData())
-	return err
+	return r.CreateWithTx(ctx, tx, req.GetData())
 }
 
 // CreateWithTx 创建角色
@@ -414,9 +422,14 @@ func (r *RoleRepo) CreateWithTx(ctx context.Context, tx *ent.Tx, data *permissio
 		SetNillableType(r.typeConverter.ToEntity(data.Type)).
 		SetNillableStatus(r.statusConverter.ToEntity(data.Status)).
 		SetNillableDescription(data.Description).
+		SetNillableDataScope(r.dataScopeConverter.ToEntity(data.DataScope)).
 		SetNillableCreatedBy(data.CreatedBy).
 		SetCreatedAt(time.Now())
 
+	if data.DataScopeOrgUnitIds != nil {
+		builder.SetDataScopeOrgUnitIds(data.GetDataScopeOrgUnitIds())
+	}
+
 	if data.Id != nil {
 		builder.SetID(data.GetId())
 	}
@@ -489,7 +502,8 @@ func (r *RoleRepo) Update(ctx context.Context, req *permissionV1.UpdateRoleReque
 			createReq := &permissionV1.CreateRoleRequest{Data: req.Data}
 			createReq.Data.CreatedBy = createReq.Data.UpdatedBy
 			createReq.Data.UpdatedBy = nil
-			return r.Create(ctx, createReq)
+			_, err = r.Create(ctx, createReq)
+			return err
 		}
 	}
 
@@ -535,8 +549,13 @@ func(dto *permissionV1.Role) {
 				SetNillableType(r.typeConverter.ToEntity(req.Data.Type)).
 				SetNillableStatus(r.statusConverter.ToEntity(req.Data.Status)).
 				SetNillableDescription(req.Data.Description).
+				SetNillableDataScope(r.dataScopeConverter.ToEntity(req.Data.DataScope)).
 				SetNillableUpdatedBy(req.Data.UpdatedBy).
 				SetUpdatedAt(time.Now())
+
+			if req.Data.DataScopeOrgUnitIds != nil {
+				builder.SetDataScopeOrgUnitIds(req.Data.GetDataScopeOrgUnitIds())
+			}
 		},
 		func(s *sql.Selector) {
 			s.Where(sql.EQ(role.FieldID, req.GetId()))
@@ -632,6 +651,62 @@ func (r *RoleRepo) ListPermissionIDsByRoleCodes(ctx context.Context, roleCodes [
 
 	return r.rolePermissionRepo.ListPermissionIDsByRoleIDs(ctx, roleIDs)
 }
+
+// GrantPermissionsByRoleCodes 将权限追加授予所有租户下指定编码的角色，已有的授权不受影响。
+// 跨租户写入，仅允许在系统上下文中调用（启动时的权限数据迁移）
+func (r *RoleRepo) GrantPermissionsByRoleCodes(ctx context.Context, roleCodes []string, permissionIDs []uint32) (err error) {
+	if len(roleCodes) == 0 || len(permissionIDs) == 0 {
+		return nil
+	}
+
+	if vc, ok := viewer.FromContext(ctx); !ok || vc == nil || !vc.IsSystemContext() {
+		return permissionV1.ErrorForbidden("system context required to grant permissions by role codes")
+	}
+
+	entities, err := r.entClient.Client().Role.Query().
+		Where(role.CodeIn(roleCodes...)).
+		Select(role.FieldID, role.FieldTenantID).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("query roles by codes failed: %s", err.Error())
+		return permissionV1.ErrorInternalServerError("query roles by codes failed")
+	}
+	if len(entities) == 0 {
+		return nil
+	}
+
+	var tx *ent.Tx
+	tx, err = r.entClient.Client().Tx(ctx)
+	if err != nil {
+		r.log.Errorf("start transaction failed: %s", err.Error())
+		return permissionV1.ErrorInternalServerError("start transaction failed")
+	}
+	defer func() {
+		if err != nil {
+			if rollbackErr := tx.Rollback(); rollbackErr != nil {
+				r.log.Errorf("transaction rollback failed: %s", rollbackErr.Error())
+			}
+			return
+		}
+		if commitErr := tx.Commit(); commitErr != nil {
+			r.log.Errorf("transaction commit failed: %s", commitErr.Error())
+			err = permissionV1.ErrorInternalServerError("transaction commit failed")
+		}
+	}()
+
+	for _, entity := range entities {
+		var tenantID uint32
+		if entity.TenantID != nil {
+			tenantID = *entity.TenantID
+		}
+		if err = r.assignPermissionsToRole(ctx, tx, tenantID, 0, entity.ID, permissionIDs); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // assignPermissionsToRole 分配权限给角色
 func (r *RoleRepo) assignPermissionsToRole(ctx context.Context, tx *ent.Tx,
 	tenantID, operatorID uint32,
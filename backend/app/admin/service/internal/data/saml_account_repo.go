@@ -0,0 +1,197 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/go-utils/trans"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"go-wind-admin/app/admin/service/internal/data/ent"
+	"go-wind-admin/app/admin/service/internal/data/ent/user"
+	"go-wind-admin/app/admin/service/internal/data/ent/usercredential"
+
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+	identityV1 "go-wind-admin/api/gen/go/identity/service/v1"
+
+	"go-wind-admin/pkg/saml"
+)
+
+// SamlAccountProvider 企业账号在 user_credential.provider 中的取值。
+// 每个租户至多一个 IdP，(tenant_id, provider, provider_account_id) 即可唯一确定企业账号。
+const SamlAccountProvider = "saml"
+
+// samlAccountInfo 写入 user_credential.extra_info 的企业账号信息，便于排查 IdP 侧账号。
+type samlAccountInfo struct {
+	IdPEntityID  string `json:"idp_entity_id,omitempty"`
+	NameIDFormat string `json:"name_id_format,omitempty"`
+}
+
+// SamlAccountRepo 企业单点登录账号仓储。绑定关系存于 user_credential：
+// identity_type=ENTERPRISE_SSO，credential_type=SAML_ASSERTION，provider_account_id 为断言 NameID，不存断言原文。
+type SamlAccountRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+
+	userRepo       UserRepo
+	credentialRepo *UserCredentialRepo
+}
+
+func NewSamlAccountRepo(
+	ctx *bootstrap.Context,
+	entClient *entCrud.EntClient[*ent.Client],
+	userRepo UserRepo,
+	credentialRepo *UserCredentialRepo,
+) *SamlAccountRepo {
+	return &SamlAccountRepo{
+		entClient:      entClient,
+		log:            ctx.NewLoggerHelper("saml-account/repo/admin-service"),
+		userRepo:       userRepo,
+		credentialRepo: credentialRepo,
+	}
+}
+
+// FindUserID 按断言 NameID 查找已绑定的用户，未绑定（或绑定已禁用）返回 0。
+func (r *SamlAccountRepo) FindUserID(ctx context.Context, tenantID uint32, nameID string) (uint32, error) {
+	entity, err := r.entClient.Client().UserCredential.Query().
+		Where(
+			usercredential.TenantIDEQ(tenantID),
+			usercredential.IdentityTypeEQ(usercredential.IdentityTypeEnterpriseSso),
+			usercredential.ProviderEQ(SamlAccountProvider),
+			usercredential.ProviderAccountIDEQ(nameID),
+			usercredential.StatusEQ(usercredential.StatusEnabled),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return 0, nil
+		}
+		r.log.Errorf("query saml account failed: %s", err.Error())
+		return 0, authenticationV1.ErrorServiceUnavailable("db error")
+	}
+
+	return derefUint32(entity.UserID), nil
+}
+
+// Provision 为未绑定的企业账号自动创建用户并完成绑定（同一事务）：
+// 用户归入租户，授予配置的默认角色与组织单元（经 UserRepo 按租户关系模式写入 Membership）。
+// 只按 NameID 建立关联，绝不按邮箱合并到已有用户；映射的邮箱/手机号在租户内未被占用时才写入新用户。
+func (r *SamlAccountRepo) Provision(ctx context.Context, settings *SamlSettings, assertion *saml.Assertion) (u *identityV1.User, err error) {
+	tenantID := settings.TenantID
+
+	var tx *ent.Tx
+	tx, err = r.entClient.Client().Tx(ctx)
+	if err != nil {
+		r.log.Errorf("start transaction failed: %s", err.Error())
+		return nil, authenticationV1.ErrorInternalServerError("start transaction failed")
+	}
+	defer func() {
+		if err != nil {
+			if rollbackErr := tx.Rollback(); rollbackErr != nil {
+				r.log.Errorf("transaction rollback failed: %s", rollbackErr.Error())
+			}
+			return
+		}
+		if commitErr := tx.Commit(); commitErr != nil {
+			r.log.Errorf("transaction commit failed: %s", commitErr.Error())
+			err = authenticationV1.ErrorInternalServerError("transaction commit failed")
+		}
+	}()
+
+	var username string
+	if username, err = pickAvailableUsername(ctx, tx, tenantID, samlUsernameBase(settings, assertion)); err != nil {
+		r.log.Errorf("pick username for saml account [%s] failed: %s", assertion.NameID, err.Error())
+		return nil, authenticationV1.ErrorInternalServerError("generate username failed")
+	}
+
+	data := &identityV1.User{
+		TenantId: trans.Ptr(tenantID),
+		Username: trans.Ptr(username),
+		Status:   identityV1.User_NORMAL.Enum(),
+		RoleIds:  settings.DefaultRoleIDs,
+	}
+	if settings.DefaultOrgUnitID != 0 {
+		data.OrgUnitIds = []uint32{settings.DefaultOrgUnitID}
+	}
+	if v := settings.MappedAttribute(assertion, SamlAttrNickname); v != "" {
+		data.Nickname = trans.Ptr(v)
+	}
+	if v := settings.MappedAttribute(assertion, SamlAttrRealname); v != "" {
+		data.Realname = trans.Ptr(v)
+	}
+	if v := settings.MappedAttribute(assertion, SamlAttrEmail); v != "" {
+		var taken bool
+		if taken, err = tx.User.Query().
+			Where(user.TenantIDEQ(tenantID), user.EmailEQ(v)).
+			Exist(ctx); err != nil {
+			r.log.Errorf("query user email failed: %s", err.Error())
+			return nil, authenticationV1.ErrorInternalServerError("query user failed")
+		}
+		if !taken {
+			data.Email = trans.Ptr(v)
+		}
+	}
+	if v := settings.MappedAttribute(assertion, SamlAttrMobile); v != "" {
+		var taken bool
+		if taken, err = tx.User.Query().
+			Where(user.TenantIDEQ(tenantID), user.MobileEQ(v)).
+			Exist(ctx); err != nil {
+			r.log.Errorf("query user mobile failed: %s", err.Error())
+			return nil, authenticationV1.ErrorInternalServerError("query user failed")
+		}
+		if !taken {
+			data.Mobile = trans.Ptr(v)
+		}
+	}
+
+	if u, err = r.userRepo.CreateWithTx(ctx, tx, data); err != nil {
+		return nil, err
+	}
+
+	if err = r.credentialRepo.CreateWithTx(ctx, tx, newSamlCredential(tenantID, u.GetId(), settings, assertion)); err != nil {
+		return nil, err
+	}
+
+	return u, nil
+}
+
+// newSamlCredential 构造企业账号绑定凭证；identifier 取 saml:<NameID>，满足 NotEmpty 与用户内唯一约束。
+func newSamlCredential(tenantID, userID uint32, settings *SamlSettings, assertion *saml.Assertion) *authenticationV1.UserCredential {
+	info, _ := json.Marshal(&samlAccountInfo{
+		IdPEntityID:  settings.IdP.EntityID,
+		NameIDFormat: assertion.NameIDFormat,
+	})
+
+	return &authenticationV1.UserCredential{
+		UserId:            trans.Ptr(userID),
+		TenantId:          trans.Ptr(tenantID),
+		IdentityType:      authenticationV1.UserCredential_ENTERPRISE_SSO.Enum(),
+		Identifier:        trans.Ptr(SamlAccountProvider + ":" + assertion.NameID),
+		CredentialType:    authenticationV1.UserCredential_SAML_ASSERTION.Enum(),
+		IsPrimary:         trans.Ptr(false),
+		Status:            authenticationV1.UserCredential_ENABLED.Enum(),
+		ExtraInfo:         trans.Ptr(string(info)),
+		Provider:          trans.Ptr(SamlAccountProvider),
+		ProviderAccountId: trans.Ptr(assertion.NameID),
+	}
+}
+
+// samlUsernameBase 自动创建用户的用户名候选：优先映射的用户名属性，其次 NameID（邮箱格式取 @ 前部分）。
+func samlUsernameBase(settings *SamlSettings, assertion *saml.Assertion) string {
+	if base := sanitizeUsername(settings.MappedAttribute(assertion, SamlAttrUsername)); base != "" {
+		return base
+	}
+
+	nameID := assertion.NameID
+	if i := strings.IndexByte(nameID, '@'); i > 0 {
+		nameID = nameID[:i]
+	}
+	if base := sanitizeUsername(nameID); base != "" {
+		return base
+	}
+	return sanitizeUsername(SamlAccountProvider + "_" + assertion.NameID)
+}
@@ -0,0 +1,30 @@
+package data
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go-wind-admin/pkg/saml"
+)
+
+func TestSamlUsernameBase(t *testing.T) {
+	settings := &SamlSettings{AttributeMapping: map[string]string{SamlAttrUsername: "uid"}}
+
+	a := &saml.Assertion{
+		NameID:     "alice@acme.test",
+		Attributes: map[string][]string{"uid": {"alice.w"}},
+	}
+	assert.Equal(t, "alice.w", samlUsernameBase(settings, a))
+
+	// 未携带映射属性时取邮箱格式 NameID 的 @ 前部分
+	a.Attributes = map[string][]string{}
+	assert.Equal(t, "alice", samlUsernameBase(settings, a))
+
+	// 不透明 NameID 去除非法字符
+	a.NameID = "AAdzZWNy/ZXQ="
+	assert.Equal(t, "AAdzZWNyZXQ", samlUsernameBase(&SamlSettings{}, a))
+
+	a.NameID = "张三"
+	assert.Equal(t, "saml_", samlUsernameBase(&SamlSettings{}, a))
+}
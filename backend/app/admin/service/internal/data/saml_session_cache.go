@@ -0,0 +1,162 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+	"github.com/tx7do/go-utils/jwtutil"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+)
+
+const (
+	// SamlRequestTTL AuthnRequest 有效期：覆盖用户在 IdP 登录页完成认证的时间。
+	SamlRequestTTL = 10 * time.Minute
+	// SamlTicketTTL ACS 签发的一次性票据有效期：只覆盖浏览器跳转到前端并回调的时间。
+	SamlTicketTTL = 2 * time.Minute
+
+	// 已登记的 AuthnRequest：saml:request:<request_id>
+	samlRequestKeyFmt = "saml:request:%s"
+	// 一次性登录票据：saml:ticket:<ticket>
+	samlTicketKeyFmt = "saml:ticket:%s"
+	// 已消费的断言（防重放）：saml:assertion:<tenant_id>:<assertion_id>
+	samlAssertionKeyFmt = "saml:assertion:%d:%s"
+)
+
+var (
+	ErrSamlRequestNotFound = errors.New("saml request not found or expired")
+	ErrSamlTicketNotFound  = errors.New("saml ticket not found or expired")
+)
+
+// SamlRequest 跳转 IdP 前登记的 AuthnRequest 上下文，以请求 ID 为键、一次性有效。
+// 断言的 InResponseTo 必须命中本方登记的请求，拒绝 IdP 发起（unsolicited）的响应。
+type SamlRequest struct {
+	TenantID   uint32 `json:"tenant_id"`
+	ClientType int32  `json:"client_type,omitempty"`
+}
+
+// SamlTicket 断言校验通过后签发给前端的一次性票据，换取令牌时取出。
+type SamlTicket struct {
+	TenantID   uint32 `json:"tenant_id"`
+	UserID     uint32 `json:"user_id"`
+	ClientType int32  `json:"client_type,omitempty"`
+}
+
+// SamlSessionCache SAML 登录流程的短期状态：请求登记、断言防重放与一次性票据。
+type SamlSessionCache struct {
+	log *log.Helper
+	rdb *redis.Client
+}
+
+func NewSamlSessionCache(ctx *bootstrap.Context, rdb *redis.Client) *SamlSessionCache {
+	return &SamlSessionCache{
+		rdb: rdb,
+		log: ctx.NewLoggerHelper("saml-session/cache"),
+	}
+}
+
+// RegisterRequest 登记 AuthnRequest，返回过期时间。
+func (c *SamlSessionCache) RegisterRequest(ctx context.Context, requestID string, req *SamlRequest) (time.Time, error) {
+	raw, err := json.Marshal(req)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("marshal saml request failed: %w", err)
+	}
+
+	key := fmt.Sprintf(samlRequestKeyFmt, requestID)
+	if err = c.rdb.Set(ctx, key, raw, SamlRequestTTL).Err(); err != nil {
+		c.log.Errorf("set saml request failed: %s", err.Error())
+		return time.Time{}, fmt.Errorf("set saml request failed")
+	}
+
+	return time.Now().Add(SamlRequestTTL), nil
+}
+
+// ConsumeRequest 原子取出并删除已登记的 AuthnRequest。
+func (c *SamlSessionCache) ConsumeRequest(ctx context.Context, requestID string) (*SamlRequest, error) {
+	var req SamlRequest
+	if err := c.take(ctx, fmt.Sprintf(samlRequestKeyFmt, requestID), &req); err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, ErrSamlRequestNotFound
+		}
+		return nil, err
+	}
+	return &req, nil
+}
+
+// MarkAssertion 登记已消费的断言 ID，保留到断言失效为止；重复提交返回 false。
+func (c *SamlSessionCache) MarkAssertion(ctx context.Context, tenantID uint32, assertionID string, notOnOrAfter time.Time) (bool, error) {
+	ttl := time.Until(notOnOrAfter)
+	if ttl < time.Minute {
+		ttl = time.Minute
+	}
+
+	key := fmt.Sprintf(samlAssertionKeyFmt, tenantID, assertionID)
+	ok, err := c.rdb.SetNX(ctx, key, 1, ttl).Result()
+	if err != nil {
+		c.log.Errorf("mark saml assertion failed: %s", err.Error())
+		return false, fmt.Errorf("mark saml assertion failed")
+	}
+	return ok, nil
+}
+
+// IssueTicket 签发一次性登录票据。
+func (c *SamlSessionCache) IssueTicket(ctx context.Context, t *SamlTicket) (string, error) {
+	ticket, err := jwtutil.NewRefreshToken()
+	if err != nil {
+		return "", fmt.Errorf("generate saml ticket failed: %w", err)
+	}
+
+	raw, err := json.Marshal(t)
+	if err != nil {
+		return "", fmt.Errorf("marshal saml ticket failed: %w", err)
+	}
+
+	key := fmt.Sprintf(samlTicketKeyFmt, ticket)
+	if err = c.rdb.Set(ctx, key, raw, SamlTicketTTL).Err(); err != nil {
+		c.log.Errorf("set saml ticket failed: %s", err.Error())
+		return "", fmt.Errorf("set saml ticket failed")
+	}
+
+	return ticket, nil
+}
+
+// ConsumeTicket 原子取出并删除登录票据，同一票据只能换取一次令牌。
+func (c *SamlSessionCache) ConsumeTicket(ctx context.Context, ticket string) (*SamlTicket, error) {
+	if ticket == "" {
+		return nil, ErrSamlTicketNotFound
+	}
+
+	var t SamlTicket
+	if err := c.take(ctx, fmt.Sprintf(samlTicketKeyFmt, ticket), &t); err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, ErrSamlTicketNotFound
+		}
+		return nil, err
+	}
+	return &t, nil
+}
+
+// take 原子取出并删除 key，反序列化到 out；key 不存在返回 redis.Nil。
+func (c *SamlSessionCache) take(ctx context.Context, key string, out any) error {
+	res, err := takeAndDelScript.Run(ctx, c.rdb, []string{key}).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return err
+		}
+		c.log.Errorf("take saml session key failed: %s", err.Error())
+		return fmt.Errorf("take saml session key failed")
+	}
+
+	raw, ok := res.(string)
+	if !ok {
+		return redis.Nil
+	}
+	if err = json.Unmarshal([]byte(raw), out); err != nil {
+		return fmt.Errorf("unmarshal saml session failed: %w", err)
+	}
+	return nil
+}
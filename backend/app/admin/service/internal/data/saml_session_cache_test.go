@@ -0,0 +1,87 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+
+	conf "github.com/tx7do/kratos-bootstrap/api/gen/go/conf/v1"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+)
+
+func newTestSamlSessionCache(t *testing.T) (*SamlSessionCache, *miniredis.Miniredis) {
+	t.Helper()
+
+	mr, err := miniredis.Run()
+	assert.NoError(t, err)
+	t.Cleanup(mr.Close)
+
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	bctx := bootstrap.NewContextWithParam(context.Background(), &conf.AppInfo{}, &conf.Bootstrap{}, log.DefaultLogger)
+	return NewSamlSessionCache(bctx, rdb), mr
+}
+
+func TestSamlSessionCache_RequestConsumedOnce(t *testing.T) {
+	cache, mr := newTestSamlSessionCache(t)
+	ctx := context.Background()
+
+	expiresAt, err := cache.RegisterRequest(ctx, "_req1", &SamlRequest{TenantID: 7, ClientType: 1})
+	assert.NoError(t, err)
+	assert.False(t, expiresAt.IsZero())
+	assert.Equal(t, SamlRequestTTL, mr.TTL("saml:request:_req1"))
+
+	req, err := cache.ConsumeRequest(ctx, "_req1")
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(7), req.TenantID)
+	assert.Equal(t, int32(1), req.ClientType)
+
+	_, err = cache.ConsumeRequest(ctx, "_req1")
+	assert.True(t, errors.Is(err, ErrSamlRequestNotFound))
+}
+
+func TestSamlSessionCache_AssertionReplay(t *testing.T) {
+	cache, mr := newTestSamlSessionCache(t)
+	ctx := context.Background()
+
+	fresh, err := cache.MarkAssertion(ctx, 7, "_a1", time.Now().Add(5*time.Minute))
+	assert.NoError(t, err)
+	assert.True(t, fresh)
+	assert.Greater(t, mr.TTL("saml:assertion:7:_a1"), 4*time.Minute)
+
+	fresh, err = cache.MarkAssertion(ctx, 7, "_a1", time.Now().Add(5*time.Minute))
+	assert.NoError(t, err)
+	assert.False(t, fresh)
+
+	// 断言 ID 按租户隔离
+	fresh, err = cache.MarkAssertion(ctx, 8, "_a1", time.Now())
+	assert.NoError(t, err)
+	assert.True(t, fresh)
+	assert.Equal(t, time.Minute, mr.TTL("saml:assertion:8:_a1"))
+}
+
+func TestSamlSessionCache_TicketConsumedOnce(t *testing.T) {
+	cache, mr := newTestSamlSessionCache(t)
+	ctx := context.Background()
+
+	ticket, err := cache.IssueTicket(ctx, &SamlTicket{TenantID: 7, UserID: 42})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, ticket)
+	assert.Equal(t, SamlTicketTTL, mr.TTL("saml:ticket:"+ticket))
+
+	got, err := cache.ConsumeTicket(ctx, ticket)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(42), got.UserID)
+	assert.Equal(t, uint32(7), got.TenantID)
+
+	_, err = cache.ConsumeTicket(ctx, ticket)
+	assert.True(t, errors.Is(err, ErrSamlTicketNotFound))
+
+	_, err = cache.ConsumeTicket(ctx, "")
+	assert.True(t, errors.Is(err, ErrSamlTicketNotFound))
+}
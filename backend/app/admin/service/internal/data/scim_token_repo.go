@@ -0,0 +1,267 @@
+package data
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	paginationV1 "github.com/tx7do/go-crud/api/gen/go/pagination/v1"
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"github.com/tx7do/go-utils/copierutil"
+	"github.com/tx7do/go-utils/mapper"
+	"github.com/tx7do/go-utils/timeutil"
+
+	"go-wind-admin/app/admin/service/internal/data/ent"
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+	"go-wind-admin/app/admin/service/internal/data/ent/scimtoken"
+
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+)
+
+const (
+	// scimTokenPrefix 令牌明文前缀，便于在身份提供方配置与日志中辨认。
+	scimTokenPrefix = "gwscim_"
+	// scimTokenDisplayLen 管理端展示的令牌前缀长度。
+	scimTokenDisplayLen = 12
+)
+
+// ScimTokenAuthInfo 校验通过的 SCIM 令牌信息（不含哈希）。
+type ScimTokenAuthInfo struct {
+	ID       uint32
+	Name     string
+	TenantID uint32
+}
+
+type ScimTokenRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+
+	mapper          *mapper.CopierMapper[authenticationV1.ScimToken, ent.ScimToken]
+	statusConverter *mapper.EnumTypeConverter[authenticationV1.ScimToken_Status, scimtoken.Status]
+
+	repository *entCrud.Repository[
+		ent.ScimTokenQuery, ent.ScimTokenSelect,
+		ent.ScimTokenCreate, ent.ScimTokenCreateBulk,
+		ent.ScimTokenUpdate, ent.ScimTokenUpdateOne,
+		ent.ScimTokenDelete,
+		predicate.ScimToken,
+		authenticationV1.ScimToken, ent.ScimToken,
+	]
+}
+
+func NewScimTokenRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *ScimTokenRepo {
+	repo := &ScimTokenRepo{
+		log:       ctx.NewLoggerHelper("scim-token/repo/admin-service"),
+		entClient: entClient,
+		mapper:    mapper.NewCopierMapper[authenticationV1.ScimToken, ent.ScimToken](),
+		statusConverter: mapper.NewEnumTypeConverter[authenticationV1.ScimToken_Status, scimtoken.Status](
+			authenticationV1.ScimToken_Status_name, authenticationV1.ScimToken_Status_value,
+		),
+	}
+
+	repo.init()
+
+	return repo
+}
+
+func (r *ScimTokenRepo) init() {
+	r.repository = entCrud.NewRepository[
+		ent.ScimTokenQuery, ent.ScimTokenSelect,
+		ent.ScimTokenCreate, ent.ScimTokenCreateBulk,
+		ent.ScimTokenUpdate, ent.ScimTokenUpdateOne,
+		ent.ScimTokenDelete,
+		predicate.ScimToken,
+		authenticationV1.ScimToken, ent.ScimToken,
+	](r.mapper)
+
+	r.mapper.AppendConverters(copierutil.NewTimeStringConverterPair())
+	r.mapper.AppendConverters(copierutil.NewTimeTimestamppbConverterPair())
+
+	r.mapper.AppendConverters(r.statusConverter.NewConverterPair())
+}
+
+// hashScimToken 令牌本身是 256 位随机数，无需慢哈希，SHA-256 即可支持按哈希精确查找。
+func hashScimToken(plain string) string {
+	sum := sha256.Sum256([]byte(plain))
+	return hex.EncodeToString(sum[:])
+}
+
+// newScimToken 生成令牌明文（前缀 + 32 字节随机数 hex）。
+func newScimToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return scimTokenPrefix + hex.EncodeToString(b), nil
+}
+
+func (r *ScimTokenRepo) List(ctx context.Context, req *paginationV1.PagingRequest) (*authenticationV1.ListScimTokenResponse, error) {
+	if req == nil {
+		return nil, authenticationV1.ErrorBadRequest("invalid parameter")
+	}
+
+	builder := r.entClient.Client().ScimToken.Query()
+
+	ret, err := r.repository.ListWithPaging(ctx, builder, builder.Clone(), req)
+	if err != nil {
+		return nil, err
+	}
+	if ret == nil {
+		return &authenticationV1.ListScimTokenResponse{Total: 0, Items: nil}, nil
+	}
+
+	return &authenticationV1.ListScimTokenResponse{
+		Total: ret.Total,
+		Items: ret.Items,
+	}, nil
+}
+
+func (r *ScimTokenRepo) Get(ctx context.Context, req *authenticationV1.GetScimTokenRequest) (*authenticationV1.ScimToken, error) {
+	if req == nil {
+		return nil, authenticationV1.ErrorBadRequest("invalid parameter")
+	}
+
+	builder := r.entClient.Client().ScimToken.Query()
+
+	return r.repository.Get(ctx, builder, req.GetViewMask(), scimtoken.IDEQ(req.GetId()))
+}
+
+// Create 创建 SCIM 令牌，明文令牌仅在返回值中出现一次。
+func (r *ScimTokenRepo) Create(ctx context.Context, req *authenticationV1.CreateScimTokenRequest) (*authenticationV1.ScimTokenSecretResponse, error) {
+	if req == nil || req.Data == nil {
+		return nil, authenticationV1.ErrorBadRequest("invalid request")
+	}
+
+	plain, err := newScimToken()
+	if err != nil {
+		r.log.Errorf("generate scim token failed: %s", err.Error())
+		return nil, authenticationV1.ErrorServiceUnavailable("generate scim token failed")
+	}
+
+	entity, err := r.entClient.Client().ScimToken.Create().
+		SetNillableTenantID(req.Data.TenantId).
+		SetNillableName(req.Data.Name).
+		SetTokenPrefix(plain[:scimTokenDisplayLen]).
+		SetTokenHash(hashScimToken(plain)).
+		SetNillableStatus(r.statusConverter.ToEntity(req.Data.Status)).
+		SetNillableDescription(req.Data.Description).
+		SetNillableExpiresAt(timeutil.TimestamppbToTime(req.Data.ExpiresAt)).
+		SetNillableCreatedBy(req.Data.CreatedBy).
+		SetCreatedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, authenticationV1.ErrorBadRequest("scim token name already exists")
+		}
+		r.log.Errorf("insert scim token failed: %s", err.Error())
+		return nil, authenticationV1.ErrorInternalServerError("insert scim token failed")
+	}
+
+	return &authenticationV1.ScimTokenSecretResponse{
+		Id:    entity.ID,
+		Token: plain,
+	}, nil
+}
+
+func (r *ScimTokenRepo) Update(ctx context.Context, req *authenticationV1.UpdateScimTokenRequest) error {
+	if req == nil || req.Data == nil {
+		return authenticationV1.ErrorBadRequest("invalid request")
+	}
+	if req.GetId() == 0 {
+		return authenticationV1.ErrorBadRequest("id is required")
+	}
+
+	builder := r.entClient.Client().ScimToken.Update()
+	err := r.repository.UpdateX(ctx, builder, req.Data, req.GetUpdateMask(),
+		func(dto *authenticationV1.ScimToken) {
+			builder.
+				SetNillableName(req.Data.Name).
+				SetNillableStatus(r.statusConverter.ToEntity(req.Data.Status)).
+				SetNillableDescription(req.Data.Description).
+				SetNillableExpiresAt(timeutil.TimestamppbToTime(req.Data.ExpiresAt)).
+				SetNillableUpdatedBy(req.Data.UpdatedBy).
+				SetUpdatedAt(time.Now())
+		},
+		func(s *sql.Selector) {
+			s.Where(sql.EQ(scimtoken.FieldID, req.GetId()))
+			// 租户范围过滤：隐私层只过滤查询，更新需显式限定
+			if tid, hasTenant := maybeTenantFromViewer(ctx); hasTenant {
+				s.Where(sql.EQ(scimtoken.FieldTenantID, tid))
+			}
+		},
+	)
+
+	return err
+}
+
+func (r *ScimTokenRepo) Delete(ctx context.Context, id uint32) error {
+	if id == 0 {
+		return authenticationV1.ErrorBadRequest("invalid parameter")
+	}
+
+	builder := r.entClient.Client().ScimToken.Delete().
+		Where(scimtoken.IDEQ(id))
+	if tid, hasTenant := maybeTenantFromViewer(ctx); hasTenant {
+		builder.Where(scimtoken.TenantIDEQ(tid))
+	}
+	affected, err := builder.Exec(ctx)
+	if err != nil {
+		r.log.Errorf("delete scim token failed: %s", err.Error())
+		return authenticationV1.ErrorInternalServerError("delete scim token failed")
+	}
+	if affected == 0 {
+		return authenticationV1.ErrorNotFound("scim token not found")
+	}
+
+	return nil
+}
+
+// Verify 按令牌明文查找启用、未过期的令牌，并记录最近使用信息。
+// 令牌以哈希精确查找，不存在、禁用与过期统一返回 UNAUTHORIZED，不向调用方区分原因。
+// 调用方需以系统身份执行（请求到达时尚无租户上下文）。
+func (r *ScimTokenRepo) Verify(ctx context.Context, plain, clientIP string) (*ScimTokenAuthInfo, error) {
+	if plain == "" {
+		return nil, authenticationV1.ErrorUnauthorized("missing scim token")
+	}
+
+	entity, err := r.entClient.Client().ScimToken.Query().
+		Where(scimtoken.TokenHashEQ(hashScimToken(plain))).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, authenticationV1.ErrorUnauthorized("invalid scim token")
+		}
+		r.log.Errorf("query scim token failed: %s", err.Error())
+		return nil, authenticationV1.ErrorServiceUnavailable("db error")
+	}
+
+	now := time.Now()
+	if entity.Status == nil || *entity.Status != scimtoken.StatusOn ||
+		(entity.ExpiresAt != nil && entity.ExpiresAt.Before(now)) ||
+		entity.TenantID == nil || *entity.TenantID == 0 {
+		return nil, authenticationV1.ErrorUnauthorized("invalid scim token")
+	}
+
+	builder := r.entClient.Client().ScimToken.UpdateOneID(entity.ID).
+		SetLastUsedAt(now)
+	if clientIP != "" {
+		builder.SetLastUsedIP(clientIP)
+	}
+	if err = builder.Exec(ctx); err != nil {
+		// 仅影响展示，不阻断供应请求
+		r.log.Warnf("update scim token last used failed: %s", err.Error())
+	}
+
+	return &ScimTokenAuthInfo{
+		ID:       entity.ID,
+		Name:     derefStr(entity.Name),
+		TenantID: *entity.TenantID,
+	}, nil
+}
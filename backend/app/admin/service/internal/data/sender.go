@@ -0,0 +1,60 @@
+package data
+
+import (
+	"os"
+	"strings"
+
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"go-wind-admin/pkg/sender"
+)
+
+// NewSender 创建消息投递路由（邮件/短信验证码等）。
+//
+// 配置读取环境变量：
+//   - GWA_SENDER_DRIVER=log：本地开发/测试用，两个渠道都只记录到内存并写日志（含验证码明文），生产切勿启用；
+//   - GWA_SMTP_ADDR（host:port）、GWA_SMTP_USERNAME、GWA_SMTP_PASSWORD、GWA_SMTP_FROM、
+//     GWA_SMTP_IMPLICIT_TLS（true 表示建连即 TLS，如 465 端口）：邮件渠道；
+//   - GWA_SMS_WEBHOOK_URL、GWA_SMS_WEBHOOK_SECRET：短信渠道，POST 到短信网关并以 HMAC-SHA256 签名。
+//
+// 未配置的渠道不注册，依赖该渠道的功能（如短信/邮件 MFA）返回"未配置"。
+func NewSender(ctx *bootstrap.Context) *sender.Router {
+	l := ctx.NewLoggerHelper("sender/data/admin-service")
+
+	router := sender.NewRouter()
+
+	if strings.EqualFold(os.Getenv("GWA_SENDER_DRIVER"), "log") {
+		l.Warn("GWA_SENDER_DRIVER=log: email/sms messages are logged instead of delivered")
+		mem := sender.NewMemorySender(ctx.GetLogger())
+		return router.Register(sender.ChannelEmail, mem).Register(sender.ChannelSMS, mem)
+	}
+
+	if addr := os.Getenv("GWA_SMTP_ADDR"); addr != "" {
+		s, err := sender.NewSMTPSender(sender.SMTPConfig{
+			Addr:        addr,
+			Username:    os.Getenv("GWA_SMTP_USERNAME"),
+			Password:    os.Getenv("GWA_SMTP_PASSWORD"),
+			From:        os.Getenv("GWA_SMTP_FROM"),
+			ImplicitTLS: strings.EqualFold(os.Getenv("GWA_SMTP_IMPLICIT_TLS"), "true"),
+		})
+		if err != nil {
+			l.Errorf("init smtp sender failed: %s", err.Error())
+		} else {
+			router.Register(sender.ChannelEmail, s)
+		}
+	}
+
+	if webhookURL := os.Getenv("GWA_SMS_WEBHOOK_URL"); webhookURL != "" {
+		s, err := sender.NewWebhookSender(sender.WebhookConfig{
+			URL:    webhookURL,
+			Secret: os.Getenv("GWA_SMS_WEBHOOK_SECRET"),
+		})
+		if err != nil {
+			l.Errorf("init sms webhook sender failed: %s", err.Error())
+		} else {
+			router.Register(sender.ChannelSMS, s)
+		}
+	}
+
+	return router
+}
@@ -0,0 +1,360 @@
+package data
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/go-utils/trans"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"go-wind-admin/app/admin/service/internal/data/ent"
+	"go-wind-admin/app/admin/service/internal/data/ent/user"
+	"go-wind-admin/app/admin/service/internal/data/ent/usercredential"
+
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+	identityV1 "go-wind-admin/api/gen/go/identity/service/v1"
+
+	"go-wind-admin/pkg/oauth/social"
+)
+
+// maxProvisionUsernameLen 自动创建用户时生成的用户名长度上限。
+const maxProvisionUsernameLen = 64
+
+// socialAccountInfo 绑定时写入 user_credential.extra_info 的第三方账号资料，供个人资料页展示。
+type socialAccountInfo struct {
+	Username string `json:"username,omitempty"`
+	Name     string `json:"name,omitempty"`
+	Email    string `json:"email,omitempty"`
+	Avatar   string `json:"avatar,omitempty"`
+}
+
+// SocialAccountRepo 第三方账号绑定仓储。绑定关系存于 user_credential：
+// identity_type=SOCIAL_OAUTH，provider 见 OAuthAccountProvider，provider_account_id 为提供方用户标识，
+// identifier 取 provider:provider_account_id（满足 NotEmpty 与用户内唯一约束），不存任何提供方令牌。
+type SocialAccountRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+
+	userRepo       UserRepo
+	credentialRepo *UserCredentialRepo
+}
+
+func NewSocialAccountRepo(
+	ctx *bootstrap.Context,
+	entClient *entCrud.EntClient[*ent.Client],
+	userRepo UserRepo,
+	credentialRepo *UserCredentialRepo,
+) *SocialAccountRepo {
+	return &SocialAccountRepo{
+		entClient:      entClient,
+		log:            ctx.NewLoggerHelper("social-account/repo/admin-service"),
+		userRepo:       userRepo,
+		credentialRepo: credentialRepo,
+	}
+}
+
+func socialAccountIdentifier(provider, accountID string) string {
+	return provider + ":" + accountID
+}
+
+// newSocialCredential 构造第三方账号绑定凭证。
+func newSocialCredential(tenantID, userID uint32, provider string, profile *social.Profile) *authenticationV1.UserCredential {
+	info, _ := json.Marshal(&socialAccountInfo{
+		Username: profile.Username,
+		Name:     profile.Name,
+		Email:    profile.Email,
+		Avatar:   profile.Avatar,
+	})
+
+	return &authenticationV1.UserCredential{
+		UserId:            trans.Ptr(userID),
+		TenantId:          trans.Ptr(tenantID),
+		IdentityType:      authenticationV1.UserCredential_SOCIAL_OAUTH.Enum(),
+		Identifier:        trans.Ptr(socialAccountIdentifier(provider, profile.ID)),
+		CredentialType:    authenticationV1.UserCredential_OAUTH_TOKEN.Enum(),
+		IsPrimary:         trans.Ptr(false),
+		Status:            authenticationV1.UserCredential_ENABLED.Enum(),
+		ExtraInfo:         trans.Ptr(string(info)),
+		Provider:          trans.Ptr(provider),
+		ProviderAccountId: trans.Ptr(profile.ID),
+	}
+}
+
+// FindUserID 按第三方账号查找已绑定的用户，未绑定（或绑定已禁用）返回 0。
+func (r *SocialAccountRepo) FindUserID(ctx context.Context, tenantID uint32, provider, accountID string) (uint32, error) {
+	entity, err := r.entClient.Client().UserCredential.Query().
+		Where(
+			usercredential.TenantIDEQ(tenantID),
+			usercredential.IdentityTypeEQ(usercredential.IdentityTypeSocialOauth),
+			usercredential.ProviderEQ(provider),
+			usercredential.ProviderAccountIDEQ(accountID),
+			usercredential.StatusEQ(usercredential.StatusEnabled),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return 0, nil
+		}
+		r.log.Errorf("query social account failed: %s", err.Error())
+		return 0, authenticationV1.ErrorServiceUnavailable("db error")
+	}
+
+	return derefUint32(entity.UserID), nil
+}
+
+// ListByUser 列出用户已绑定的第三方账号。
+func (r *SocialAccountRepo) ListByUser(ctx context.Context, tenantID, userID uint32) ([]*authenticationV1.UserCredential, error) {
+	entities, err := r.entClient.Client().UserCredential.Query().
+		Where(
+			usercredential.TenantIDEQ(tenantID),
+			usercredential.UserIDEQ(userID),
+			usercredential.IdentityTypeEQ(usercredential.IdentityTypeSocialOauth),
+		).
+		Order(ent.Asc(usercredential.FieldID)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list social accounts failed: %s", err.Error())
+		return nil, authenticationV1.ErrorInternalServerError("list linked accounts failed")
+	}
+
+	items := make([]*authenticationV1.UserCredential, 0, len(entities))
+	for _, e := range entities {
+		dto := r.credentialRepo.mapper.ToDTO(e)
+		// 第三方账号凭证不存令牌，防御性清空
+		dto.Credential = nil
+		items = append(items, dto)
+	}
+	return items, nil
+}
+
+// Link 为用户绑定第三方账号。同一第三方账号在租户内只能绑定一个用户，同一用户对同一提供方只能绑定一个账号。
+func (r *SocialAccountRepo) Link(ctx context.Context, tenantID, userID uint32, provider string, profile *social.Profile) (*authenticationV1.UserCredential, error) {
+	exist, err := r.entClient.Client().UserCredential.Query().
+		Where(
+			usercredential.TenantIDEQ(tenantID),
+			usercredential.IdentityTypeEQ(usercredential.IdentityTypeSocialOauth),
+			usercredential.ProviderEQ(provider),
+			usercredential.Or(
+				usercredential.UserIDEQ(userID),
+				usercredential.ProviderAccountIDEQ(profile.ID),
+			),
+		).
+		Exist(ctx)
+	if err != nil {
+		r.log.Errorf("query social account failed: %s", err.Error())
+		return nil, authenticationV1.ErrorInternalServerError("query linked account failed")
+	}
+	if exist {
+		return nil, authenticationV1.ErrorBadRequest("oauth account already linked")
+	}
+
+	cred := newSocialCredential(tenantID, userID, provider, profile)
+
+	entity, err := r.entClient.Client().UserCredential.Create().
+		SetUserID(userID).
+		SetTenantID(tenantID).
+		SetIdentityType(usercredential.IdentityTypeSocialOauth).
+		SetIdentifier(cred.GetIdentifier()).
+		SetCredentialType(usercredential.CredentialTypeOauthToken).
+		SetIsPrimary(false).
+		SetStatus(usercredential.StatusEnabled).
+		SetExtraInfo(cred.GetExtraInfo()).
+		SetProvider(provider).
+		SetProviderAccountID(profile.ID).
+		SetCreatedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		// 并发绑定撞唯一索引 (tenant_id, provider, provider_account_id)
+		if ent.IsConstraintError(err) {
+			return nil, authenticationV1.ErrorBadRequest("oauth account already linked")
+		}
+		r.log.Errorf("insert social account failed: %s", err.Error())
+		return nil, authenticationV1.ErrorInternalServerError("link oauth account failed")
+	}
+
+	return r.credentialRepo.mapper.ToDTO(entity), nil
+}
+
+// Unlink 解除用户的第三方账号绑定：credentialID 非 0 时按 ID，否则按提供方。
+// 第三方账号是用户唯一的登录凭证时拒绝解绑，避免账号无法再登录。
+func (r *SocialAccountRepo) Unlink(ctx context.Context, tenantID, userID, credentialID uint32, provider string) error {
+	query := r.entClient.Client().UserCredential.Query().
+		Where(
+			usercredential.TenantIDEQ(tenantID),
+			usercredential.UserIDEQ(userID),
+			usercredential.IdentityTypeEQ(usercredential.IdentityTypeSocialOauth),
+		)
+	if credentialID != 0 {
+		query.Where(usercredential.IDEQ(credentialID))
+	} else {
+		query.Where(usercredential.ProviderEQ(provider))
+	}
+
+	entity, err := query.First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return authenticationV1.ErrorNotFound("linked account not found")
+		}
+		r.log.Errorf("query social account failed: %s", err.Error())
+		return authenticationV1.ErrorInternalServerError("query linked account failed")
+	}
+
+	others, err := r.entClient.Client().UserCredential.Query().
+		Where(
+			usercredential.TenantIDEQ(tenantID),
+			usercredential.UserIDEQ(userID),
+			usercredential.IDNEQ(entity.ID),
+			usercredential.StatusEQ(usercredential.StatusEnabled),
+		).
+		Count(ctx)
+	if err != nil {
+		r.log.Errorf("count user credentials failed: %s", err.Error())
+		return authenticationV1.ErrorInternalServerError("query credentials failed")
+	}
+	if others == 0 {
+		return authenticationV1.ErrorBadRequest("cannot unlink the only sign-in method")
+	}
+
+	if _, err = r.entClient.Client().UserCredential.Delete().
+		Where(
+			usercredential.IDEQ(entity.ID),
+			usercredential.TenantIDEQ(tenantID),
+		).
+		Exec(ctx); err != nil {
+		r.log.Errorf("delete social account failed: %s", err.Error())
+		return authenticationV1.ErrorInternalServerError("unlink oauth account failed")
+	}
+
+	return nil
+}
+
+// Provision 为未绑定的第三方账号自动创建用户并完成绑定（同一事务）。
+// 只按第三方账号标识建立关联，绝不按邮箱关联到已有用户——提供方邮箱未必经过验证，按邮箱合并会导致账号劫持；
+// 邮箱仅在提供方声明已验证且租户内未被占用时写入新用户。
+func (r *SocialAccountRepo) Provision(ctx context.Context, tenantID uint32, provider string, profile *social.Profile, roleIDs []uint32) (u *identityV1.User, err error) {
+	var tx *ent.Tx
+	tx, err = r.entClient.Client().Tx(ctx)
+	if err != nil {
+		r.log.Errorf("start transaction failed: %s", err.Error())
+		return nil, authenticationV1.ErrorInternalServerError("start transaction failed")
+	}
+	defer func() {
+		if err != nil {
+			if rollbackErr := tx.Rollback(); rollbackErr != nil {
+				r.log.Errorf("transaction rollback failed: %s", rollbackErr.Error())
+			}
+			return
+		}
+		if commitErr := tx.Commit(); commitErr != nil {
+			r.log.Errorf("transaction commit failed: %s", commitErr.Error())
+			err = authenticationV1.ErrorInternalServerError("transaction commit failed")
+		}
+	}()
+
+	var username string
+	if username, err = r.availableUsername(ctx, tx, tenantID, provider, profile); err != nil {
+		return nil, err
+	}
+
+	data := &identityV1.User{
+		TenantId: trans.Ptr(tenantID),
+		Username: trans.Ptr(username),
+		Status:   identityV1.User_NORMAL.Enum(),
+		RoleIds:  roleIDs,
+	}
+	if profile.Name != "" {
+		data.Nickname = trans.Ptr(profile.Name)
+	}
+	if profile.Avatar != "" {
+		data.Avatar = trans.Ptr(profile.Avatar)
+	}
+	if profile.Email != "" && profile.EmailVerified {
+		var taken bool
+		if taken, err = tx.User.Query().
+			Where(user.TenantIDEQ(tenantID), user.EmailEQ(profile.Email)).
+			Exist(ctx); err != nil {
+			r.log.Errorf("query user email failed: %s", err.Error())
+			return nil, authenticationV1.ErrorInternalServerError("query user failed")
+		}
+		if !taken {
+			data.Email = trans.Ptr(profile.Email)
+		}
+	}
+
+	if u, err = r.userRepo.CreateWithTx(ctx, tx, data); err != nil {
+		return nil, err
+	}
+
+	if err = r.credentialRepo.CreateWithTx(ctx, tx, newSocialCredential(tenantID, u.GetId(), provider, profile)); err != nil {
+		return nil, err
+	}
+
+	return u, nil
+}
+
+// availableUsername 生成租户内未占用的用户名：优先提供方用户名，其次 提供方_账号标识。
+func (r *SocialAccountRepo) availableUsername(ctx context.Context, tx *ent.Tx, tenantID uint32, provider string, profile *social.Profile) (string, error) {
+	base := sanitizeUsername(profile.Username)
+	if base == "" {
+		base = sanitizeUsername(strings.ReplaceAll(provider, ":", "_") + "_" + profile.ID)
+	}
+
+	username, err := pickAvailableUsername(ctx, tx, tenantID, base)
+	if err != nil {
+		r.log.Errorf("pick username for [%s] account [%s] failed: %s", provider, profile.ID, err.Error())
+		return "", authenticationV1.ErrorInternalServerError("generate username failed")
+	}
+	return username, nil
+}
+
+// pickAvailableUsername 自动创建用户时挑选租户内未占用的用户名：base 已被占用时追加随机后缀重试。
+func pickAvailableUsername(ctx context.Context, tx *ent.Tx, tenantID uint32, base string) (string, error) {
+	candidate := base
+	for i := 0; i < 5; i++ {
+		taken, err := tx.User.Query().
+			Where(user.TenantIDEQ(tenantID), user.UsernameEQ(candidate)).
+			Exist(ctx)
+		if err != nil {
+			return "", err
+		}
+		if !taken {
+			return candidate, nil
+		}
+
+		b := make([]byte, 3)
+		if _, err = rand.Read(b); err != nil {
+			return "", err
+		}
+		suffix := "_" + hex.EncodeToString(b)
+		candidate = truncateUsername(base, maxProvisionUsernameLen-len(suffix)) + suffix
+	}
+
+	return "", errors.New("no available username")
+}
+
+// sanitizeUsername 只保留字母、数字与 _ . -，并截断到长度上限。
+func sanitizeUsername(s string) string {
+	var b strings.Builder
+	for _, c := range s {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9', c == '_', c == '.', c == '-':
+			b.WriteRune(c)
+		}
+	}
+	return truncateUsername(b.String(), maxProvisionUsernameLen)
+}
+
+func truncateUsername(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n]
+}
@@ -0,0 +1,37 @@
+package data
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+)
+
+func TestSanitizeUsername(t *testing.T) {
+	assert.Equal(t, "octo-cat_1.x", sanitizeUsername("octo-cat_1.x"))
+	assert.Equal(t, "zhangsan", sanitizeUsername("张zhang san!"))
+	assert.Equal(t, "", sanitizeUsername("张三"))
+	assert.Len(t, sanitizeUsername(strings.Repeat("a", 100)), maxProvisionUsernameLen)
+}
+
+func TestOAuthProviderPreset(t *testing.T) {
+	preset, custom, ok := OAuthProviderPreset(authenticationV1.OAuthProvider_GITHUB, "ignored")
+	assert.True(t, ok)
+	assert.Equal(t, "github", preset)
+	assert.Equal(t, "", custom)
+	assert.Equal(t, "github", OAuthAccountProvider(preset, custom))
+
+	preset, custom, ok = OAuthProviderPreset(authenticationV1.OAuthProvider_OAUTH_PROVIDER_UNSPECIFIED, " Keycloak ")
+	assert.True(t, ok)
+	assert.Equal(t, "oidc", preset)
+	assert.Equal(t, "keycloak", custom)
+	assert.Equal(t, "oidc:keycloak", OAuthAccountProvider(preset, custom))
+	assert.Equal(t, authenticationV1.OAuthProvider_OAUTH_PROVIDER_UNSPECIFIED, OAuthProviderEnum(preset))
+
+	_, _, ok = OAuthProviderPreset(authenticationV1.OAuthProvider_OAUTH_PROVIDER_UNSPECIFIED, "")
+	assert.False(t, ok)
+	_, _, ok = OAuthProviderPreset(authenticationV1.OAuthProvider_QQ, "")
+	assert.False(t, ok)
+}
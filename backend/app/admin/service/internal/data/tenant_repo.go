@@ -213,6 +213,7 @@ func (r *TenantRepo) CreateWithTx(ctx context.Context, tx *ent.Tx, data *identit
 		SetNillableLogoURL(data.LogoUrl).
 		SetNillableRemark(data.Remark).
 		SetNillableIndustry(data.Industry).
+		SetNillableTimezone(data.Timezone).
 		SetNillableAdminUserID(data.AdminUserId).
 		SetNillableStatus(r.statusConverter.ToEntity(data.Status)).
 		SetNillableType(r.typeConverter.ToEntity(data.Type)).
@@ -270,6 +271,7 @@ func(dto *identityV1.Tenant) {
 				SetNillableLogoURL(req.Data.LogoUrl).
 				SetNillableRemark(req.Data.Remark).
 				SetNillableIndustry(req.Data.Industry).
+				SetNillableTimezone(req.Data.Timezone).
 				SetNillableAdminUserID(req.Data.AdminUserId).
 				SetNillableStatus(r.statusConverter.ToEntity(req.Data.Status)).
 				SetNillableType(r.typeConverter.ToEntity(req.Data.Type)).
@@ -36,7 +36,8 @@ type UserCredentialRepo struct {
 	identityTypeConverter   *mapper.EnumTypeConverter[authenticationV1.UserCredential_IdentityType, usercredential.IdentityType]
 	credentialTypeConverter *mapper.EnumTypeConverter[authenticationV1.UserCredential_CredentialType, usercredential.CredentialType]
 
-	passwordCrypto password.Crypto
+	passwordCrypto     password.Crypto
+	passwordPolicyRepo *PasswordPolicyRepo
 
 	repository *entCrud.Repository[
 		ent.UserCredentialQuery, ent.UserCredentialSelect,
@@ -48,11 +49,12 @@ type UserCredentialRepo struct {
 	]
 }
 
-func NewUserCredentialRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client], passwordCrypto password.Crypto) *UserCredentialRepo {
+func NewUserCredentialRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client], passwordCrypto password.Crypto, passwordPolicyRepo *PasswordPolicyRepo) *UserCredentialRepo {
 	repo := &UserCredentialRepo{
 		log:                     ctx.NewLoggerHelper("user-credentials/repo/admin-service"),
 		entClient:               entClient,
 		passwordCrypto:          passwordCrypto,
+		passwordPolicyRepo:      passwordPolicyRepo,
 		mapper:                  mapper.NewCopierMapper[authenticationV1.UserCredential, ent.UserCredential](),
 		statusConverter:         mapper.NewEnumTypeConverter[authenticationV1.UserCredential_Status, usercredential.Status](authenticationV1.UserCredential_Status_name, authenticationV1.UserCredential_Status_value),
 		identityTypeConverter:   mapper.NewEnumTypeConverter[authenticationV1.UserCredential_IdentityType, usercredential.IdentityType](authenticationV1.UserCredential_IdentityType_name, authenticationV1.UserCredential_IdentityType_value),
@@ -163,9 +165,21 @@ func (r *UserCredentialRepo) CreateWithTx(ctx context.Context, tx *ent.Tx, data
 
 	var err error
 
+	var changedAt *time.Time
 	if data.Credential != nil {
+		credentialType := r.credentialTypeConverter.ToEntity(data.CredentialType)
+		// 待激活凭证的密码是随机占位值，不受策略约束；激活时用户设置的密码再按策略校验
+		if isPasswordCredential(credentialType) && data.GetStatus() != authenticationV1.UserCredential_UNVERIFIED {
+			// 事务内新建的用户/租户尚未提交，策略与租户代码须经事务客户端查询
+			if _, err = r.applyPasswordPolicy(ctx, tx.Client(), data.GetTenantId(),
+				passwordSubjectUsername(r.identityTypeConverter.ToEntity(data.IdentityType), data.GetIdentifier()), data.GetCredential(), nil); err != nil {
+				return err
+			}
+			changedAt = trans.Ptr(time.Now())
+		}
+
 		var newCredential string
-		newCredential, err = r.prepareCredential(r.credentialTypeConverter.ToEntity(data.CredentialType), data.GetCredential())
+		newCredential, err = r.prepareCredential(credentialType, data.GetCredential())
 		if err != nil {
 			r.log.Errorf("prepare new credential failed: %s", err.Error())
 			return authenticationV1.ErrorBadRequest("prepare new credential failed")
@@ -186,6 +200,7 @@ func (r *UserCredentialRepo) CreateWithTx(ctx context.Context, tx *ent.Tx, data
 		SetNillableExtraInfo(data.ExtraInfo).
 		SetNillableProvider(data.Provider).
 		SetNillableProviderAccountID(data.ProviderAccountId).
+		SetNillableCredentialChangedAt(changedAt).
 		SetCreatedAt(time.Now())
 
 	if err = builder.Exec(ctx); err != nil {
@@ -218,9 +233,29 @@ func (r *UserCredentialRepo) Update(ctx context.Context, req *authenticationV1.U
 
 	var err error
 
+	var history []string
+	var changedAt *time.Time
 	if req.Data.Credential != nil {
+		credentialType := r.credentialTypeConverter.ToEntity(req.Data.CredentialType)
+		if isPasswordCredential(credentialType) {
+			var current *ent.UserCredential
+			current, err = r.entClient.Client().UserCredential.Get(ctx, req.GetId())
+			if err != nil {
+				if ent.IsNotFound(err) {
+					return authenticationV1.ErrorNotFound("user credential not found")
+				}
+				r.log.Errorf("query one data failed: %s", err.Error())
+				return authenticationV1.ErrorInternalServerError("query one data failed")
+			}
+			if history, err = r.applyPasswordPolicy(ctx, r.entClient.Client(), derefUint32(current.TenantID),
+				passwordSubjectUsername(current.IdentityType, derefStr(current.Identifier)), req.Data.GetCredential(), current); err != nil {
+				return err
+			}
+			changedAt = trans.Ptr(time.Now())
+		}
+
 		var newCredential string
-		newCredential, err = r.prepareCredential(r.credentialTypeConverter.ToEntity(req.Data.CredentialType), req.Data.GetCredential())
+		newCredential, err = r.prepareCredential(credentialType, req.Data.GetCredential())
 		if err != nil {
 			r.log.Errorf("prepare new credential failed: %s", err.Error())
 			return authenticationV1.ErrorBadRequest("prepare new credential failed")
@@ -242,6 +277,11 @@ func(dto *authenticationV1.UserCredential) {
 				SetNillableProvider(req.Data.Provider).
 				SetNillableProviderAccountID(req.Data.ProviderAccountId).
 				SetUpdatedAt(time.Now())
+			if changedAt != nil {
+				builder.
+					SetCredentialChangedAt(*changedAt).
+					SetCredentialHistory(history)
+			}
 		},
 		func(s *sql.Selector) {
 			s.Where(sql.EQ(usercredential.FieldID, req.GetId()))
@@ -394,6 +434,21 @@ func (r *UserCredentialRepo) performDummyVerify(plainCredential string) {
 	_, _ = r.passwordCrypto.Verify(plainCredential, dummyPasswordHash)
 }
 
+// DecryptLoginCredential 解密登录请求中的密码（base64+AES 密文）。
+func (r *UserCredentialRepo) DecryptLoginCredential(encrypted string) (string, error) {
+	bytesPass, err := base64.StdEncoding.DecodeString(encrypted)
+	if err != nil {
+		r.log.Errorf("decode base64 credential failed: %s", err.Error())
+		return "", authenticationV1.ErrorBadRequest("invalid credential format")
+	}
+	decrypted, err := crypto.AesDecrypt(bytesPass, crypto.DefaultAESKey, nil)
+	if err != nil {
+		r.log.Errorf("decrypt credential failed: %s", err.Error())
+		return "", authenticationV1.ErrorBadRequest("decrypt credential failed")
+	}
+	return string(decrypted), nil
+}
+
 // FindUserCredential 在指定租户范围内根据身份类型+标识符查询单条凭证并校验密码。
 // tenantID 由调用方解析确定（登录时按 tenant_code 解析；留空视为平台 tenant 0）。
 // (tenant_id, identity_type, identifier) 在该 tenant 内唯一，无需跨租户消歧。
@@ -401,17 +456,10 @@ func (r *UserCredentialRepo) performDummyVerify(plainCredential string) {
 // 返回匹配到的用户 ID，调用方可据此通过 ID 查找用户。
 func (r *UserCredentialRepo) FindUserCredential(ctx context.Context, tenantID uint32, identityType authenticationV1.UserCredential_IdentityType, identifier, plainCredential string, needDecrypt bool) (uint32, error) {
 	if needDecrypt {
-		bytesPass, err := base64.StdEncoding.DecodeString(plainCredential)
-		if err != nil {
-			r.log.Errorf("decode base64 credential failed: %s", err.Error())
-			return 0, authenticationV1.ErrorBadRequest("invalid credential format")
-		}
-		decrypted, err := crypto.AesDecrypt(bytesPass, crypto.DefaultAESKey, nil)
-		if err != nil {
-			r.log.Errorf("decrypt credential failed: %s", err.Error())
-			return 0, authenticationV1.ErrorBadRequest("decrypt credential failed")
+		var err error
+		if plainCredential, err = r.DecryptLoginCredential(plainCredential); err != nil {
+			return 0, err
 		}
-		plainCredential = string(decrypted)
 	}
 
 	entity, err := r.entClient.Client().UserCredential.Query().
@@ -501,6 +549,128 @@ func (r *UserCredentialRepo) prepareCredential(credentialType *usercredential.Cr
 	return newCredential, nil
 }
 
+// isPasswordCredential 是否为受密码策略约束的密码类凭证。
+func isPasswordCredential(credentialType *usercredential.CredentialType) bool {
+	return credentialType != nil && *credentialType == usercredential.CredentialTypePasswordHash
+}
+
+// passwordSubjectUsername 用户名类凭证以标识符作为密码策略中的用户名；
+// 其他身份类型（邮箱、手机号等）不参与身份子串比对。未指定身份类型时按默认值 USERNAME 处理。
+func passwordSubjectUsername(identityType *usercredential.IdentityType, identifier string) string {
+	if identityType == nil || *identityType == usercredential.IdentityTypeUsername {
+		return identifier
+	}
+	return ""
+}
+
+// CheckPassword 按密码策略预校验明文密码（不含历史密码），供创建用户等流程在写入用户前提前拒绝，
+// 避免用户已落库而凭证因策略被拒。
+func (r *UserCredentialRepo) CheckPassword(ctx context.Context, tenantID uint32, username, plainPassword string) error {
+	if r.passwordPolicyRepo == nil {
+		return nil
+	}
+	_, violations, err := r.passwordPolicyRepo.check(ctx, r.entClient.Client(), tenantID, username, plainPassword)
+	if err != nil {
+		return err
+	}
+	if len(violations) > 0 {
+		return newPasswordPolicyError(violations)
+	}
+	return nil
+}
+
+// applyPasswordPolicy 设置新密码前按租户密码策略校验，违规时返回列出全部违规项的 PASSWORD_POLICY_VIOLATION 错误。
+// current 为被修改的凭证（新建时为 nil），其当前密码与历史密码参与重复使用校验；
+// 返回修改后应保存的历史密码哈希（新在前，当前密码随本次修改进入历史，仅保留策略要求的条数）。
+func (r *UserCredentialRepo) applyPasswordPolicy(
+	ctx context.Context,
+	client *ent.Client,
+	tenantID uint32,
+	username, plainPassword string,
+	current *ent.UserCredential,
+) ([]string, error) {
+	if r.passwordPolicyRepo == nil {
+		return []string{}, nil
+	}
+
+	policy, violations, err := r.passwordPolicyRepo.check(ctx, client, tenantID, username, plainPassword)
+	if err != nil {
+		return nil, err
+	}
+
+	var previous []string
+	if current != nil {
+		if current.Credential != nil && *current.Credential != "" {
+			previous = append(previous, *current.Credential)
+		}
+		previous = append(previous, current.CredentialHistory...)
+	}
+
+	// history_count 含当前密码：比对当前密码与最近 N-1 条历史
+	if policy.HistoryCount > 0 {
+		for _, hash := range previous[:min(len(previous), policy.HistoryCount)] {
+			if ok, _ := r.passwordCrypto.Verify(plainPassword, hash); ok {
+				violations = append(violations, policy.HistoryViolation())
+				break
+			}
+		}
+	}
+
+	if len(violations) > 0 {
+		return nil, newPasswordPolicyError(violations)
+	}
+
+	return previous[:min(len(previous), max(policy.HistoryCount-1, 0))], nil
+}
+
+// PasswordExpired 用户名密码凭证是否已超过密码策略规定的有效期；平台与租户策略均未设置有效期时恒为 false。
+// 未记录修改时间的存量凭证依次以更新时间、创建时间作为修改时间。
+func (r *UserCredentialRepo) PasswordExpired(ctx context.Context, tenantID uint32, identifier string) (bool, error) {
+	if r.passwordPolicyRepo == nil {
+		return false, nil
+	}
+
+	policy, err := r.passwordPolicyRepo.Resolve(ctx, tenantID)
+	if err != nil {
+		return false, err
+	}
+	if policy.MaxAge <= 0 {
+		return false, nil
+	}
+
+	entity, err := r.entClient.Client().UserCredential.Query().
+		Select(
+			usercredential.FieldCredentialChangedAt,
+			usercredential.FieldUpdatedAt,
+			usercredential.FieldCreatedAt,
+		).
+		Where(
+			usercredential.TenantIDEQ(tenantID),
+			usercredential.IdentityTypeEQ(usercredential.IdentityTypeUsername),
+			usercredential.IdentifierEQ(identifier),
+			usercredential.CredentialTypeEQ(usercredential.CredentialTypePasswordHash),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return false, nil
+		}
+		r.log.Errorf("query credential failed: %s", err.Error())
+		return false, authenticationV1.ErrorInternalServerError("query credential failed")
+	}
+
+	var changedAt time.Time
+	switch {
+	case entity.CredentialChangedAt != nil:
+		changedAt = *entity.CredentialChangedAt
+	case entity.UpdatedAt != nil:
+		changedAt = *entity.UpdatedAt
+	case entity.CreatedAt != nil:
+		changedAt = *entity.CreatedAt
+	}
+	return policy.Expired(changedAt, time.Now()), nil
+}
+
 // ChangeCredential 修改认证信息
 func (r *UserCredentialRepo) ChangeCredential(ctx context.Context, req *authenticationV1.ChangeCredentialRequest) error {
 	if req.GetNeedDecrypt() {
@@ -544,8 +714,10 @@ func (r *UserCredentialRepo) ChangeCredential(ctx context.Context, req *authenti
 	entity, err := r.entClient.Client().UserCredential.
 		Query().
 		Select(
+			usercredential.FieldTenantID,
 			usercredential.FieldCredentialType,
 			usercredential.FieldCredential,
+			usercredential.FieldCredentialHistory,
 		).
 		Where(tenantWhere...).
 		Only(ctx)
@@ -566,6 +738,14 @@ func (r *UserCredentialRepo) ChangeCredential(ctx context.Context, req *authenti
 		return authenticationV1.ErrorBadRequest("invalid old password")
 	}
 
+	var history []string
+	if isPasswordCredential(entity.CredentialType) {
+		if history, err = r.applyPasswordPolicy(ctx, r.entClient.Client(), derefUint32(entity.TenantID),
+			passwordSubjectUsername(r.identityTypeConverter.ToEntity(trans.Ptr(req.GetIdentityType())), req.GetIdentifier()), req.GetNewCredential(), entity); err != nil {
+			return err
+		}
+	}
+
 	var newCredential string
 	newCredential, err = r.prepareCredential(entity.CredentialType, req.GetNewCredential())
 	if err != nil {
@@ -577,11 +757,17 @@ func (r *UserCredentialRepo) ChangeCredential(ctx context.Context, req *authenti
 		return authenticationV1.ErrorBadRequest("new credential cannot be empty")
 	}
 
+	now := time.Now()
 	builder := r.entClient.Client().UserCredential.Update()
 	builder.Where(tenantWhere...)
 	builder.
 		SetCredential(newCredential).
-		SetUpdatedAt(time.Now())
+		SetUpdatedAt(now)
+	if isPasswordCredential(entity.CredentialType) {
+		builder.
+			SetCredentialChangedAt(now).
+			SetCredentialHistory(history)
+	}
 	if err = builder.Exec(ctx); err != nil {
 		r.log.Errorf("update one data failed: %s", err.Error())
 		return authenticationV1.ErrorInternalServerError("update data failed")
@@ -619,7 +805,10 @@ func (r *UserCredentialRepo) ResetCredential(ctx context.Context, req *authentic
 	entity, err := r.entClient.Client().UserCredential.
 		Query().
 		Select(
+			usercredential.FieldTenantID,
 			usercredential.FieldCredentialType,
+			usercredential.FieldCredential,
+			usercredential.FieldCredentialHistory,
 		).
 		Where(tenantWhere...).
 		Only(ctx)
@@ -635,6 +824,14 @@ func (r *UserCredentialRepo) ResetCredential(ctx context.Context, req *authentic
 		return authenticationV1.ErrorNotFound("user credential not found")
 	}
 
+	var history []string
+	if isPasswordCredential(entity.CredentialType) {
+		if history, err = r.applyPasswordPolicy(ctx, r.entClient.Client(), derefUint32(entity.TenantID),
+			passwordSubjectUsername(r.identityTypeConverter.ToEntity(trans.Ptr(req.GetIdentityType())), req.GetIdentifier()), req.GetNewCredential(), entity); err != nil {
+			return err
+		}
+	}
+
 	var newCredential string
 	newCredential, err = r.prepareCredential(entity.CredentialType, req.GetNewCredential())
 	if err != nil {
@@ -646,11 +843,17 @@ func (r *UserCredentialRepo) ResetCredential(ctx context.Context, req *authentic
 		return authenticationV1.ErrorBadRequest("new credential cannot be empty")
 	}
 
+	now := time.Now()
 	builder := r.entClient.Client().UserCredential.Update()
 	builder.Where(tenantWhere...)
 	builder.
 		SetCredential(newCredential).
-		SetUpdatedAt(time.Now())
+		SetUpdatedAt(now)
+	if isPasswordCredential(entity.CredentialType) {
+		builder.
+			SetCredentialChangedAt(now).
+			SetCredentialHistory(history)
+	}
 	if err = builder.Exec(ctx); err != nil {
 		r.log.Errorf("update one data failed: %s", err.Error())
 		return authenticationV1.ErrorInternalServerError("update data failed")
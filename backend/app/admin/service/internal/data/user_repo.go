@@ -25,6 +25,7 @@
 	"go-wind-admin/app/admin/service/internal/data/ent"
 	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
 	"go-wind-admin/app/admin/service/internal/data/ent/user"
+	"go-wind-admin/app/admin/service/internal/data/ent/usercredential"
 
 	identityV1 "go-wind-admin/api/gen/go/identity/service/v1"
 	permissionV1 "go-wind-admin/api/gen/go/permission/service/v1"
@@ -75,6 +76,13 @@ type UserRepo interface {
 	// 纯数字按 mobile 查，其余原样视为 username 返回（userId=0 表示未反查）。
 	// mobile 在租户内非唯一，命中多行时返回错误（数据歧义应拒绝登录而非随机取一）。
 	FindUsernameByIdentifier(ctx context.Context, tenantID uint32, identifier string) (username string, userId uint32, err error)
+
+	// ContactInUse 租户内是否已有其他用户绑定了该邮箱/手机号。
+	ContactInUse(ctx context.Context, tenantID, userID uint32, kind ContactKind, address string) (bool, error)
+
+	// ChangeContact 更换用户绑定的邮箱/手机号，并同步以旧地址为标识的登录凭证（EMAIL/PHONE）。
+	// 当前值已不是 oldAddress（并发修改）返回 CONFLICT，新地址已被他人占用返回 CONFLICT。
+	ChangeContact(ctx context.Context, tenantID, userID uint32, kind ContactKind, oldAddress, newAddress string) error
 }
 
 type userRepo struct {
@@ -1210,6 +1218,122 @@ func isDigits(s string) bool {
 	return true
 }
 
+// contactPredicate 联系方式类别对应的用户字段条件。
+func contactPredicate(kind ContactKind, address string) predicate.User {
+	if kind == ContactKindEmail {
+		return user.EmailEQ(address)
+	}
+	return user.MobileEQ(address)
+}
+
+func (r *userRepo) ContactInUse(ctx context.Context, tenantID, userID uint32, kind ContactKind, address string) (bool, error) {
+	exist, err := r.entClient.Client().User.Query().
+		Where(
+			user.TenantIDEQ(tenantID),
+			user.IDNEQ(userID),
+			contactPredicate(kind, address),
+		).
+		Exist(ctx)
+	if err != nil {
+		r.log.Errorf("query user contact failed: %s", err.Error())
+		return false, identityV1.ErrorInternalServerError("query user contact failed")
+	}
+	return exist, nil
+}
+
+func (r *userRepo) ChangeContact(ctx context.Context, tenantID, userID uint32, kind ContactKind, oldAddress, newAddress string) (err error) {
+	var tx *ent.Tx
+	tx, err = r.entClient.Client().Tx(ctx)
+	if err != nil {
+		r.log.Errorf("start transaction failed: %s", err.Error())
+		return identityV1.ErrorInternalServerError("start transaction failed")
+	}
+	defer func() {
+		if err != nil {
+			if rollbackErr := tx.Rollback(); rollbackErr != nil {
+				r.log.Errorf("transaction rollback failed: %s", rollbackErr.Error())
+			}
+			return
+		}
+		if commitErr := tx.Commit(); commitErr != nil {
+			r.log.Errorf("transaction commit failed: %s", commitErr.Error())
+			err = identityV1.ErrorInternalServerError("transaction commit failed")
+		}
+	}()
+
+	var taken bool
+	if taken, err = tx.User.Query().
+		Where(
+			user.TenantIDEQ(tenantID),
+			user.IDNEQ(userID),
+			contactPredicate(kind, newAddress),
+		).
+		Exist(ctx); err != nil {
+		r.log.Errorf("query user contact failed: %s", err.Error())
+		return identityV1.ErrorInternalServerError("query user contact failed")
+	} else if taken {
+		return identityV1.ErrorConflict("%s already in use", kind)
+	}
+
+	// 以旧值为条件更新，期间被其他请求改过则不覆盖
+	current := contactPredicate(kind, oldAddress)
+	if oldAddress == "" {
+		if kind == ContactKindEmail {
+			current = user.Or(user.EmailIsNil(), user.EmailEQ(""))
+		} else {
+			current = user.Or(user.MobileIsNil(), user.MobileEQ(""))
+		}
+	}
+	builder := tx.User.Update().
+		Where(
+			user.IDEQ(userID),
+			user.TenantIDEQ(tenantID),
+			current,
+		).
+		SetUpdatedAt(time.Now())
+	identityType := usercredential.IdentityTypePhone
+	if kind == ContactKindEmail {
+		builder.SetEmail(newAddress)
+		identityType = usercredential.IdentityTypeEmail
+	} else {
+		builder.SetMobile(newAddress)
+	}
+
+	var affected int
+	if affected, err = builder.Save(ctx); err != nil {
+		if ent.IsConstraintError(err) {
+			return identityV1.ErrorConflict("%s already in use", kind)
+		}
+		r.log.Errorf("update user contact failed: %s", err.Error())
+		return identityV1.ErrorInternalServerError("update user contact failed")
+	}
+	if affected == 0 {
+		return identityV1.ErrorConflict("%s changed concurrently", kind)
+	}
+
+	if oldAddress == "" {
+		return nil
+	}
+	if _, err = tx.UserCredential.Update().
+		Where(
+			usercredential.TenantIDEQ(tenantID),
+			usercredential.UserIDEQ(userID),
+			usercredential.IdentityTypeEQ(identityType),
+			usercredential.IdentifierEQ(oldAddress),
+		).
+		SetIdentifier(newAddress).
+		SetUpdatedAt(time.Now()).
+		Save(ctx); err != nil {
+		if ent.IsConstraintError(err) {
+			return identityV1.ErrorConflict("%s already in use", kind)
+		}
+		r.log.Errorf("update credential identifier failed: %s", err.Error())
+		return identityV1.ErrorInternalServerError("update credential identifier failed")
+	}
+
+	return nil
+}
+
 func (r *userRepo) ListRoleIDsByUserID(ctx context.Context, userID uint32) ([]uint32, error) {
 	return r.userRoleRepo.ListRoleIDs(ctx, userID, false)
 }
@@ -0,0 +1,293 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+)
+
+// 用户会话：一次登录对应一个会话（刷新令牌族），刷新令牌轮换时沿用同一会话。
+// 会话以 Redis Hash 存储 sess:{ct}:{uid}:{sid}，字段见 sessionField*，
+// jti 指向该会话当前有效的令牌对，会话 TTL 随刷新令牌续期。
+
+const (
+	// SessionKeyFormat 会话键前缀格式 sess:{ct}:{uid}
+	SessionKeyFormat = "sess:%d:%d"
+	// SessionFieldKeyFormat 会话键格式（含会话 ID）sess:{ct}:{uid}:{sid}
+	SessionFieldKeyFormat = "sess:%d:%d:%s"
+)
+
+const (
+	sessionFieldJti        = "jti"
+	sessionFieldTenantID   = "tid"
+	sessionFieldClientID   = "cid"
+	sessionFieldDeviceID   = "did"
+	sessionFieldUserAgent  = "ua"
+	sessionFieldIP         = "ip"
+	sessionFieldCountry    = "geo_country"
+	sessionFieldProvince   = "geo_province"
+	sessionFieldCity       = "geo_city"
+	sessionFieldISP        = "geo_isp"
+	sessionFieldScopes     = "scp"
+	sessionFieldCreatedAt  = "created_at"
+	sessionFieldLastSeenAt = "last_seen_at"
+	sessionFieldAuthTime   = "auth_time"
+)
+
+// rotateSessionTokenPairScript 会话仍存在时原子写入新令牌对及其刷新令牌族记录并刷新会话，会话已被吊销则什么都不写。
+// ARGV[11] 为最近一次身份验证时间，为 0 时保留会话原值。
+// 返回值: 1=成功, 0=会话不存在
+var rotateSessionTokenPairScript = redis.NewScript(`
+	local sessKey = KEYS[1]
+	if redis.call('EXISTS', sessKey) == 0 then
+		return 0
+	end
+
+	redis.call('SET', KEYS[2], ARGV[1], 'PX', ARGV[3])
+	redis.call('SET', KEYS[3], ARGV[2], 'PX', ARGV[4])
+	redis.call('HSET', sessKey, 'jti', ARGV[5], 'ip', ARGV[6], 'last_seen_at', ARGV[7])
+	if ARGV[11] ~= '0' then
+		redis.call('HSET', sessKey, 'auth_time', ARGV[11])
+	end
+	redis.call('PEXPIRE', sessKey, ARGV[4])
+	redis.call('HSET', KEYS[4], 'ct', ARGV[8], 'uid', ARGV[9], 'sid', ARGV[10], 'jti', ARGV[5], 'st', 'active')
+	redis.call('PEXPIRE', KEYS[4], ARGV[4])
+	return 1
+`)
+
+// revokeSessionScript 原子删除会话及其当前令牌对。ARGV 为访问/刷新令牌键前缀（不含 jti）。
+// 返回值: 1=已删除, 0=会话不存在
+var revokeSessionScript = redis.NewScript(`
+	local sessKey = KEYS[1]
+	local jti = redis.call('HGET', sessKey, 'jti')
+	if not jti then
+		return 0
+	end
+
+	redis.call('DEL', ARGV[1] .. jti, ARGV[2] .. jti, sessKey)
+	return 1
+`)
+
+// UserSessionRecord 会话记录
+type UserSessionRecord struct {
+	SessionID string
+	Jti       string
+
+	TenantID  uint32
+	ClientID  string
+	DeviceID  string
+	UserAgent string
+	IP        string
+	Scopes    []string
+
+	Country  string
+	Province string
+	City     string
+	ISP      string
+
+	CreatedAt  time.Time
+	LastSeenAt time.Time
+	ExpiresAt  time.Time
+	AuthTime   time.Time // 最近一次身份验证（登录或二次验证）时间
+}
+
+// AddSessionTokenPair 新建会话并写入其首个令牌对，刷新令牌作为该会话令牌族的起点
+func (r *UserTokenCache) AddSessionTokenPair(
+	ctx context.Context,
+	clientType authenticationV1.ClientType,
+	userId uint32,
+	session *UserSessionRecord,
+	accessToken string,
+	refreshToken string,
+	accessTokenExpires time.Duration,
+	refreshTokenExpires time.Duration,
+) error {
+	now := time.Now().Unix()
+
+	pipe := r.rdb.TxPipeline()
+	pipe.Set(ctx, r.makeAccessTokenFieldKey(clientType, userId, session.Jti), accessToken, accessTokenExpires)
+	pipe.Set(ctx, r.makeRefreshTokenFieldKey(clientType, userId, session.Jti), refreshToken, refreshTokenExpires)
+
+	sessKey := r.makeSessionFieldKey(clientType, userId, session.SessionID)
+	pipe.HSet(ctx, sessKey,
+		sessionFieldJti, session.Jti,
+		sessionFieldTenantID, session.TenantID,
+		sessionFieldClientID, session.ClientID,
+		sessionFieldDeviceID, session.DeviceID,
+		sessionFieldUserAgent, session.UserAgent,
+		sessionFieldIP, session.IP,
+		sessionFieldCountry, session.Country,
+		sessionFieldProvince, session.Province,
+		sessionFieldCity, session.City,
+		sessionFieldISP, session.ISP,
+		sessionFieldScopes, strings.Join(session.Scopes, " "),
+		sessionFieldCreatedAt, now,
+		sessionFieldLastSeenAt, now,
+		sessionFieldAuthTime, session.AuthTime.Unix(),
+	)
+	pipe.Expire(ctx, sessKey, refreshTokenExpires)
+
+	familyKey := r.makeRefreshTokenFamilyKey(refreshToken)
+	pipe.HSet(ctx, familyKey,
+		refreshFamilyFieldClientType, int32(clientType),
+		refreshFamilyFieldUserID, userId,
+		refreshFamilyFieldSessionID, session.SessionID,
+		refreshFamilyFieldJti, session.Jti,
+		refreshFamilyFieldState, refreshFamilyStateActive,
+	)
+	pipe.Expire(ctx, familyKey, refreshTokenExpires)
+
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// RotateSessionTokenPair 刷新令牌后为已有会话写入新令牌对（加入该会话的令牌族），并更新最近活跃时间与 IP。
+// authTime 非零时（二次验证）同时更新会话的身份验证时间。会话已被吊销时返回 (false, nil)，不写入任何令牌。
+func (r *UserTokenCache) RotateSessionTokenPair(
+	ctx context.Context,
+	clientType authenticationV1.ClientType,
+	userId uint32,
+	sessionId string,
+	jti string,
+	ip string,
+	accessToken string,
+	refreshToken string,
+	accessTokenExpires time.Duration,
+	refreshTokenExpires time.Duration,
+	authTime int64,
+) (bool, error) {
+	keys := []string{
+		r.makeSessionFieldKey(clientType, userId, sessionId),
+		r.makeAccessTokenFieldKey(clientType, userId, jti),
+		r.makeRefreshTokenFieldKey(clientType, userId, jti),
+		r.makeRefreshTokenFamilyKey(refreshToken),
+	}
+	result, err := rotateSessionTokenPairScript.Run(ctx, r.rdb, keys,
+		accessToken, refreshToken,
+		accessTokenExpires.Milliseconds(), refreshTokenExpires.Milliseconds(),
+		jti, ip, time.Now().Unix(),
+		int32(clientType), userId, sessionId,
+		authTime,
+	).Int64()
+	if err != nil {
+		r.log.Errorf("rotate session [%s] token pair for user [%d] failed: %v", sessionId, userId, err)
+		return false, err
+	}
+	return result == 1, nil
+}
+
+// ListSessions 列出用户的全部会话，已过期的会话不在结果中
+func (r *UserTokenCache) ListSessions(ctx context.Context, clientType authenticationV1.ClientType, userId uint32) ([]*UserSessionRecord, error) {
+	keys, err := r.scanKeys(ctx, r.makeSessionKey(clientType, userId)+":*")
+	if err != nil {
+		return nil, err
+	}
+	if len(keys) == 0 {
+		return nil, nil
+	}
+
+	pipe := r.rdb.Pipeline()
+	fields := make([]*redis.MapStringStringCmd, len(keys))
+	ttls := make([]*redis.DurationCmd, len(keys))
+	for i, key := range keys {
+		fields[i] = pipe.HGetAll(ctx, key)
+		ttls[i] = pipe.PTTL(ctx, key)
+	}
+	if _, err = pipe.Exec(ctx); err != nil && !errors.Is(err, redis.Nil) {
+		r.log.Errorf("list sessions for user [%d] failed: %v", userId, err)
+		return nil, err
+	}
+
+	now := time.Now()
+	sessions := make([]*UserSessionRecord, 0, len(keys))
+	for i, key := range keys {
+		values := fields[i].Val()
+		if len(values) == 0 {
+			continue // 期间已过期或被吊销
+		}
+		session := &UserSessionRecord{
+			SessionID:  r.extractJtiFromKey(key),
+			Jti:        values[sessionFieldJti],
+			TenantID:   parseSessionUint32(values[sessionFieldTenantID]),
+			ClientID:   values[sessionFieldClientID],
+			DeviceID:   values[sessionFieldDeviceID],
+			UserAgent:  values[sessionFieldUserAgent],
+			IP:         values[sessionFieldIP],
+			Country:    values[sessionFieldCountry],
+			Province:   values[sessionFieldProvince],
+			City:       values[sessionFieldCity],
+			ISP:        values[sessionFieldISP],
+			CreatedAt:  parseSessionTime(values[sessionFieldCreatedAt]),
+			LastSeenAt: parseSessionTime(values[sessionFieldLastSeenAt]),
+			AuthTime:   parseSessionTime(values[sessionFieldAuthTime]),
+		}
+		if ttl := ttls[i].Val(); ttl > 0 {
+			session.ExpiresAt = now.Add(ttl)
+		}
+		sessions = append(sessions, session)
+	}
+
+	return sessions, nil
+}
+
+// RevokeSession 吊销会话及其当前令牌对，会话不存在返回 (false, nil)
+func (r *UserTokenCache) RevokeSession(ctx context.Context, clientType authenticationV1.ClientType, userId uint32, sessionId string) (bool, error) {
+	result, err := revokeSessionScript.Run(ctx, r.rdb,
+		[]string{r.makeSessionFieldKey(clientType, userId, sessionId)},
+		r.makeAccessTokenKey(clientType, userId)+":",
+		r.makeRefreshTokenKey(clientType, userId)+":",
+	).Int64()
+	if err != nil {
+		r.log.Errorf("revoke session [%s] for user [%d] failed: %v", sessionId, userId, err)
+		return false, err
+	}
+	return result == 1, nil
+}
+
+// RevokeUserAllSessions 删除用户的全部会话记录
+func (r *UserTokenCache) RevokeUserAllSessions(
+	ctx context.Context,
+	clientType authenticationV1.ClientType,
+	userId uint32,
+) error {
+	pattern := r.makeSessionKey(clientType, userId) + ":*"
+	return r.delByPattern(ctx, pattern)
+}
+
+// makeSessionKey 生成会话键前缀 sess:{ct}:{uid}（用于 SCAN 匹配）
+func (r *UserTokenCache) makeSessionKey(clientType authenticationV1.ClientType, userId uint32) string {
+	return fmt.Sprintf(SessionKeyFormat, clientType.Number(), userId)
+}
+
+// makeSessionFieldKey 生成会话键（含会话 ID）sess:{ct}:{uid}:{sid}
+func (r *UserTokenCache) makeSessionFieldKey(clientType authenticationV1.ClientType, userId uint32, sessionId string) string {
+	return fmt.Sprintf(SessionFieldKeyFormat, clientType.Number(), userId, sessionId)
+}
+
+func parseSessionUint32(v string) uint32 {
+	n, _ := strconv.ParseUint(v, 10, 32)
+	return uint32(n)
+}
+
+func parseSessionTime(v string) time.Time {
+	sec, err := strconv.ParseInt(v, 10, 64)
+	if err != nil || sec == 0 {
+		return time.Time{}
+	}
+	return time.Unix(sec, 0)
+}
+
+// emptyToNil 空串视为未设置
+func emptyToNil(v string) *string {
+	if v == "" {
+		return nil
+	}
+	return &v
+}
@@ -0,0 +1,109 @@
+package data
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tx7do/go-utils/trans"
+
+	conf "github.com/tx7do/kratos-bootstrap/api/gen/go/conf/v1"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+)
+
+func TestAuthenticator_UserSessions(t *testing.T) {
+	mr, err := miniredis.Run()
+	require.NoError(t, err)
+	t.Cleanup(mr.Close)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+
+	bctx := bootstrap.NewContextWithParam(context.Background(), &conf.AppInfo{}, &conf.Bootstrap{
+		Authn: &conf.Authentication{
+			Jwt: &conf.Authentication_Jwt{Method: "HS256", Key: "user-session-test-signing-key"},
+		},
+	}, log.DefaultLogger)
+	a := NewAuthenticator(bctx, NewUserTokenCache(bctx, rdb), nil)
+
+	ctx := context.Background()
+	clientType := authenticationV1.ClientType_admin
+	const userID = 9181
+
+	login := func(deviceID string) (*authenticationV1.UserTokenPayload, string, string) {
+		payload := &authenticationV1.UserTokenPayload{
+			UserId:   userID,
+			Username: trans.Ptr("session9181"),
+			DeviceId: trans.Ptr(deviceID),
+		}
+		accessToken, refreshToken, err := a.CreateUserToken(ctx, clientType, payload)
+		require.NoError(t, err)
+		require.NotEmpty(t, payload.GetSessionId())
+		return payload, accessToken, refreshToken
+	}
+	valid := func(accessToken string) bool {
+		_, err := a.Authenticate(ctx, &authenticationV1.ValidateTokenRequest{Token: accessToken, ClientType: clientType, TokenCategory: authenticationV1.TokenCategory_ACCESS})
+		return err == nil
+	}
+
+	first, firstAT, firstRT := login("laptop")
+	second, secondAT, _ := login("phone")
+	assert.NotEqual(t, first.GetSessionId(), second.GetSessionId())
+
+	// 令牌载荷携带会话 ID
+	resp, err := a.Authenticate(ctx, &authenticationV1.ValidateTokenRequest{Token: firstAT, ClientType: clientType, TokenCategory: authenticationV1.TokenCategory_ACCESS})
+	require.NoError(t, err)
+	assert.Equal(t, first.GetSessionId(), resp.GetPayload().GetSessionId())
+
+	sessions, err := a.ListUserSessions(ctx, clientType, userID, first.GetSessionId())
+	require.NoError(t, err)
+	require.Len(t, sessions, 2)
+	for _, session := range sessions {
+		assert.Equal(t, session.GetSessionId() == first.GetSessionId(), session.GetCurrent())
+		if session.GetSessionId() == second.GetSessionId() {
+			assert.Equal(t, "phone", session.GetDeviceId())
+		}
+		assert.NotNil(t, session.GetCreatedAt())
+		assert.NotNil(t, session.GetExpiresAt())
+	}
+
+	// 刷新在原会话内轮换令牌，不新增会话
+	family, err := a.ConsumeRefreshToken(ctx, clientType, firstRT)
+	require.NoError(t, err)
+	assert.Equal(t, first.GetSessionId(), family.SessionID)
+	refreshed := &authenticationV1.UserTokenPayload{UserId: userID, SessionId: first.SessionId}
+	refreshedAT, _, err := a.CreateUserToken(ctx, clientType, refreshed)
+	require.NoError(t, err)
+	assert.Equal(t, first.GetSessionId(), refreshed.GetSessionId())
+	assert.False(t, valid(firstAT))
+	assert.True(t, valid(refreshedAT))
+	sessions, err = a.ListUserSessions(ctx, clientType, userID, "")
+	require.NoError(t, err)
+	assert.Len(t, sessions, 2)
+
+	// 吊销单个会话：该会话令牌立即失效，其他会话不受影响
+	require.NoError(t, a.RevokeUserSession(ctx, clientType, userID, first.GetSessionId()))
+	assert.False(t, valid(refreshedAT))
+	assert.True(t, valid(secondAT))
+	assert.True(t, authenticationV1.IsNotFound(a.RevokeUserSession(ctx, clientType, userID, first.GetSessionId())))
+
+	// 已吊销的会话不能再通过刷新复活
+	_, _, err = a.CreateUserToken(ctx, clientType, &authenticationV1.UserTokenPayload{UserId: userID, SessionId: first.SessionId})
+	assert.True(t, authenticationV1.IsUnauthorized(err))
+
+	sessions, err = a.ListUserSessions(ctx, clientType, userID, "")
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	assert.Equal(t, second.GetSessionId(), sessions[0].GetSessionId())
+
+	// 吊销用户全部令牌时一并清除会话
+	require.NoError(t, a.RevokeUserToken(ctx, clientType, userID))
+	assert.False(t, valid(secondAT))
+	sessions, err = a.ListUserSessions(ctx, clientType, userID, "")
+	require.NoError(t, err)
+	assert.Empty(t, sessions)
+}
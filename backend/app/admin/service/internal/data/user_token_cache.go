@@ -25,6 +25,11 @@
 	// RefreshTokenFieldKeyFormat 刷新令牌键格式（含 jti）rt:{ct}:{uid}:{jti}
 	RefreshTokenFieldKeyFormat = "rt:%d:%d:%s"
 
+	// ClientAccessTokenKeyFormat 服务客户端访问令牌键前缀格式 cat:{ct}:{acid}
+	ClientAccessTokenKeyFormat = "cat:%d:%d"
+	// ClientAccessTokenFieldKeyFormat 服务客户端访问令牌键格式（含 jti）cat:{ct}:{acid}:{jti}
+	ClientAccessTokenFieldKeyFormat = "cat:%d:%d:%s"
+
 	// BlacklistKeyFormat 访问令牌黑名单键格式 bl:{jti}
 	BlacklistKeyFormat = "bl:%s"
 
@@ -116,6 +121,21 @@ func (r *UserTokenCache) AddRefreshToken(
 	return r.set(ctx, key, refreshToken, expires)
 }
 
+// AddClientAccessToken 添加服务客户端访问令牌。
+// client_credentials 令牌不含用户（uid 为 0），按服务客户端 ID 独立成键，
+// 以便轮换密钥/禁用客户端时一次性吊销其全部令牌，且不与用户令牌相互干扰。
+func (r *UserTokenCache) AddClientAccessToken(
+	ctx context.Context,
+	clientType authenticationV1.ClientType,
+	apiClientId uint32,
+	jti string,
+	accessToken string,
+	expires time.Duration,
+) error {
+	key := r.makeClientAccessTokenFieldKey(clientType, apiClientId, jti)
+	return r.set(ctx, key, accessToken, expires)
+}
+
 // AddBlockedAccessToken 添加被阻止的访问令牌
 func (r *UserTokenCache) AddBlockedAccessToken(ctx context.Context, jti string, reason string, expires time.Duration) error {
 	key := r.makeBlacklistKey(jti)
@@ -145,6 +165,10 @@ func (r *UserTokenCache) RevokeToken(ctx context.Context, clientType authenticat
 		r.log.Errorf("remove user refresh token failed: [%v]", err)
 	}
 
+	if err = r.RevokeUserAllSessions(ctx, clientType, userId); err != nil {
+		r.log.Errorf("remove user sessions failed: [%v]", err)
+	}
+
 	return err
 }
 
@@ -211,6 +235,27 @@ func (r *UserTokenCache) IsValidAccessToken(
 	return true, nil
 }
 
+// IsValidClientAccessToken 服务客户端访问令牌是否有效
+func (r *UserTokenCache) IsValidClientAccessToken(
+	ctx context.Context,
+	clientType authenticationV1.ClientType,
+	apiClientId uint32,
+	jti string,
+	uploadedToken string,
+) (bool, error) {
+	key := r.makeClientAccessTokenFieldKey(clientType, apiClientId, jti)
+
+	storedToken, err := r.rdb.Get(ctx, key).Result()
+	if errors.Is(err, redis.Nil) {
+		return false, nil // 令牌不存在或已过期
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return storedToken == uploadedToken, nil
+}
+
 func (r *UserTokenCache) IsExistAccessTokenByJti(
 	ctx context.Context,
 	clientType authenticationV1.ClientType,
@@ -297,6 +342,16 @@ func (r *UserTokenCache) RevokeUserAllRefreshToken(
 	return r.delByPattern(ctx, pattern)
 }
 
+// RevokeClientAllAccessToken 删除服务客户端的全部访问令牌
+func (r *UserTokenCache) RevokeClientAllAccessToken(
+	ctx context.Context,
+	clientType authenticationV1.ClientType,
+	apiClientId uint32,
+) error {
+	pattern := r.makeClientAccessTokenKey(clientType, apiClientId) + ":*"
+	return r.delByPattern(ctx, pattern)
+}
+
 // VerifyAndRevokeTokenPair 原子验证并吊销刷新令牌及其关联的访问令牌。
 // 使用 Lua 脚本保证「验证→删除」操作的原子性，避免 TOCTOU 竞态条件。
 // 返回 (true, nil) 表示刷新令牌有效且已成功吊销旧令牌对。
@@ -343,6 +398,16 @@ func (r *UserTokenCache) makeRefreshTokenFieldKey(clientType authenticationV1.Cl
 	return fmt.Sprintf(RefreshTokenFieldKeyFormat, clientType.Number(), userId, jti)
 }
 
+// makeClientAccessTokenKey 生成服务客户端访问令牌键前缀 cat:{ct}:{acid}（用于 SCAN 匹配）
+func (r *UserTokenCache) makeClientAccessTokenKey(clientType authenticationV1.ClientType, apiClientId uint32) string {
+	return fmt.Sprintf(ClientAccessTokenKeyFormat, clientType.Number(), apiClientId)
+}
+
+// makeClientAccessTokenFieldKey 生成服务客户端访问令牌键（含 jti）cat:{ct}:{acid}:{jti}
+func (r *UserTokenCache) makeClientAccessTokenFieldKey(clientType authenticationV1.ClientType, apiClientId uint32, jti string) string {
+	return fmt.Sprintf(ClientAccessTokenFieldKeyFormat, clientType.Number(), apiClientId, jti)
+}
+
 // makeBlacklistKey 生成黑名单键
 func (r *UserTokenCache) makeBlacklistKey(jti string) string {
 	return fmt.Sprintf(BlacklistKeyFormat, jti)
@@ -196,3 +196,59 @@ func TestUserTokenCache_VerifyAndRevokeTokenPair(t *testing.T) {
 	assert.NoError(t, err)
 	assert.True(t, valid, "验证失败时不应删除令牌")
 }
+
+func TestUserTokenCache_ClientAccessToken(t *testing.T) {
+	mr, err := miniredis.Run()
+	assert.NoError(t, err)
+	defer mr.Close()
+
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	ctx := context.Background()
+
+	cfg := &conf.Bootstrap{
+		Server: &conf.Server{
+			Rest: &conf.Server_REST{
+				Middleware: &conf.Middleware{
+					Auth: &conf.Middleware_Auth{Method: "HS256", Key: "some_api_key"},
+				},
+			},
+		},
+		Data: &conf.Data{Redis: &conf.Data_Redis{Addr: mr.Addr()}},
+	}
+	bctx := bootstrap.NewContextWithParam(ctx, &conf.AppInfo{}, cfg, log.DefaultLogger)
+	repo := NewUserTokenCache(bctx, rdb)
+
+	clientType := authenticationV1.ClientType_admin
+	var id uint32 = 7
+
+	err = repo.AddClientAccessToken(ctx, clientType, id, "cjti-1", "client_access_1", 0)
+	assert.NoError(t, err)
+
+	valid, err := repo.IsValidClientAccessToken(ctx, clientType, id, "cjti-1", "client_access_1")
+	assert.NoError(t, err)
+	assert.True(t, valid)
+
+	valid, err = repo.IsValidClientAccessToken(ctx, clientType, id, "cjti-1", "wrong_token")
+	assert.NoError(t, err)
+	assert.False(t, valid, "令牌值不匹配时应校验失败")
+
+	// 客户端令牌与同数值 ID 的用户令牌互不可见
+	valid, err = repo.IsValidAccessToken(ctx, clientType, id, "cjti-1", "client_access_1")
+	assert.NoError(t, err)
+	assert.False(t, valid, "客户端令牌不应通过用户维度校验")
+
+	err = repo.AddAccessToken(ctx, clientType, id, "ujti-1", "user_access_1", 0)
+	assert.NoError(t, err)
+
+	// 吊销客户端全部令牌，不影响同 ID 用户的令牌
+	err = repo.RevokeClientAllAccessToken(ctx, clientType, id)
+	assert.NoError(t, err)
+
+	valid, err = repo.IsValidClientAccessToken(ctx, clientType, id, "cjti-1", "client_access_1")
+	assert.NoError(t, err)
+	assert.False(t, valid)
+
+	valid, err = repo.IsValidAccessToken(ctx, clientType, id, "ujti-1", "user_access_1")
+	assert.NoError(t, err)
+	assert.True(t, valid)
+}
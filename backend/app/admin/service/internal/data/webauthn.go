@@ -0,0 +1,90 @@
+package data
+
+import (
+	"encoding/binary"
+	"errors"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"go-wind-admin/pkg/webauthn"
+)
+
+// webAuthnDefaultRPName 依赖方展示名默认值（认证器/浏览器弹窗内展示）。
+const webAuthnDefaultRPName = "GoWindAdmin"
+
+// webAuthnUserHandleLen 用户句柄长度：租户 ID 与用户 ID 各 4 字节（大端）。
+const webAuthnUserHandleLen = 8
+
+// NewWebAuthnRelyingParty 创建 WebAuthn 依赖方。
+//
+// 配置读取环境变量：
+//   - GWA_AUTH_WEBAUTHN_RP_ID：依赖方 ID（注册域名，如 admin.example.com）；
+//   - GWA_AUTH_WEBAUTHN_RP_ORIGINS：允许的前端源，逗号分隔（如 https://admin.example.com）；
+//   - GWA_AUTH_WEBAUTHN_RP_NAME：展示名，默认 GoWindAdmin。
+//
+// 未配置时回退到 OIDC 签发者地址（authenticator.Issuer()）的主机名与源。
+// 两者均缺失时返回 nil，WebAuthn 相关接口返回"未配置"。
+func NewWebAuthnRelyingParty(ctx *bootstrap.Context, authenticator *Authenticator) *webauthn.RelyingParty {
+	l := ctx.NewLoggerHelper("webauthn/data/admin-service")
+
+	cfg := webauthn.Config{
+		RPID:          os.Getenv("GWA_AUTH_WEBAUTHN_RP_ID"),
+		RPDisplayName: os.Getenv("GWA_AUTH_WEBAUTHN_RP_NAME"),
+	}
+	for _, origin := range strings.Split(os.Getenv("GWA_AUTH_WEBAUTHN_RP_ORIGINS"), ",") {
+		if origin = strings.TrimRight(strings.TrimSpace(origin), "/"); origin != "" {
+			cfg.RPOrigins = append(cfg.RPOrigins, origin)
+		}
+	}
+	if cfg.RPDisplayName == "" {
+		cfg.RPDisplayName = webAuthnDefaultRPName
+	}
+
+	if authenticator != nil && authenticator.Issuer() != "" {
+		if u, err := url.Parse(authenticator.Issuer()); err == nil && u.Host != "" {
+			if cfg.RPID == "" {
+				cfg.RPID = u.Hostname()
+			}
+			if len(cfg.RPOrigins) == 0 {
+				cfg.RPOrigins = []string{u.Scheme + "://" + u.Host}
+			}
+		}
+	}
+
+	if cfg.RPID == "" || len(cfg.RPOrigins) == 0 {
+		l.Info("webauthn disabled: GWA_AUTH_WEBAUTHN_RP_ID/GWA_AUTH_WEBAUTHN_RP_ORIGINS or oidc issuer not configured")
+		return nil
+	}
+
+	rp, err := webauthn.New(cfg)
+	if err != nil {
+		l.Errorf("init webauthn relying party failed: %s", err.Error())
+		return nil
+	}
+	return rp
+}
+
+// WebAuthnUserHandle 生成用户句柄：租户 ID + 用户 ID（各 4 字节大端）。
+// 句柄写入可发现凭证，无密码登录时由认证器回传以定位用户；不含用户名等个人信息。
+func WebAuthnUserHandle(tenantID, userID uint32) []byte {
+	handle := make([]byte, webAuthnUserHandleLen)
+	binary.BigEndian.PutUint32(handle[:4], tenantID)
+	binary.BigEndian.PutUint32(handle[4:], userID)
+	return handle
+}
+
+// ParseWebAuthnUserHandle 解析用户句柄，返回租户 ID 与用户 ID。
+func ParseWebAuthnUserHandle(handle []byte) (tenantID, userID uint32, err error) {
+	if len(handle) != webAuthnUserHandleLen {
+		return 0, 0, errors.New("invalid webauthn user handle")
+	}
+	tenantID = binary.BigEndian.Uint32(handle[:4])
+	userID = binary.BigEndian.Uint32(handle[4:])
+	if userID == 0 {
+		return 0, 0, errors.New("invalid webauthn user handle")
+	}
+	return tenantID, userID, nil
+}
@@ -37,6 +37,18 @@ func NewAsynqServer(ctx *bootstrap.Context, taskService *service.TaskService) (*
 		return nil, err
 	}
 
+	// JWT 签名密钥轮换任务：由管理员在任务模块中按 cron 周期创建，定期轮换签名密钥。
+	if err = asynqServer.RegisterSubscriber(srv, task.JwtKeyRotationTaskType, taskService.AsyncJwtKeyRotation); err != nil {
+		log.Error(err)
+		return nil, err
+	}
+
+	// LDAP 目录同步任务：由管理员在任务模块中按 cron 周期创建，定期同步目录账号并停用上游已删除的账号。
+	if err = asynqServer.RegisterSubscriber(srv, task.LdapSyncTaskType, taskService.AsyncLdapSync); err != nil {
+		log.Error(err)
+		return nil, err
+	}
+
 	// 注册租户到期扫描任务（系统级常驻任务，不写入 sys_tasks 表）。
 	// 该任务每小时整点扫描 status==ON 且 expired_at<=now 的租户，按套餐 expiry_policy 修改状态并吊销令牌。
 	// READONLY 策略的即时读写拦截由 TenantAccessChecker 中间件承担，不依赖本扫描任务。
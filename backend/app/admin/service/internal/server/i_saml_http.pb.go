@@ -0,0 +1,83 @@
+package server
+
+import (
+	"context"
+	stdhttp "net/http"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport/http"
+
+	"go-wind-admin/app/admin/service/internal/service"
+
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+)
+
+// maxSamlFormSize ACS 表单上限：SAMLResponse 为 base64 编码的 XML，解码后另有 512KB 上限。
+const maxSamlFormSize = 1 << 20
+
+// registerSamlServiceHandler 注册 SAML SP 元数据与 ACS 端点。
+// 两者分别返回 XML 与 303 跳转、接收表单，不能使用代码生成器生成的 JSON Handler，需要手动注册。
+func registerSamlServiceHandler(srv *http.Server, svc *service.SamlService) {
+	r := srv.Route("/")
+
+	r.GET("admin/v1/saml/{tenant_code}/metadata", _SamlService_GetMetadata_HTTP_Handler(svc))
+	r.POST("admin/v1/saml/{tenant_code}/acs", _SamlService_AssertionConsumer_HTTP_Handler(svc))
+}
+
+const OperationSamlServiceGetMetadata = "/admin.service.v1.SamlService/GetMetadata"
+const OperationSamlServiceAssertionConsumer = "/admin.service.v1.SamlService/AssertionConsumer"
+
+func _SamlService_GetMetadata_HTTP_Handler(svc *service.SamlService) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		http.SetOperation(ctx, OperationSamlServiceGetMetadata)
+
+		tenantCode := ctx.Vars().Get("tenant_code")
+
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return svc.Metadata(ctx, req.(string))
+		})
+
+		out, err := h(ctx, tenantCode)
+		if err != nil {
+			return err
+		}
+
+		w := ctx.Response()
+		w.Header().Set("Content-Type", "application/samlmetadata+xml; charset=utf-8")
+		w.WriteHeader(stdhttp.StatusOK)
+		_, err = w.Write(out.([]byte))
+		return err
+	}
+}
+
+func _SamlService_AssertionConsumer_HTTP_Handler(svc *service.SamlService) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		http.SetOperation(ctx, OperationSamlServiceAssertionConsumer)
+
+		tenantCode := ctx.Vars().Get("tenant_code")
+
+		r := ctx.Request()
+		r.Body = stdhttp.MaxBytesReader(ctx.Response(), r.Body, maxSamlFormSize)
+		if err := r.ParseForm(); err != nil {
+			log.Errorf("Parse saml acs form error: %v", err)
+			return authenticationV1.ErrorBadRequest("invalid saml response")
+		}
+		samlResponse := r.PostForm.Get("SAMLResponse")
+		if samlResponse == "" {
+			return authenticationV1.ErrorBadRequest("invalid saml response")
+		}
+
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return svc.ConsumeAssertion(ctx, tenantCode, req.(string))
+		})
+
+		// 逻辑处理，取跳转地址
+		out, err := h(ctx, samlResponse)
+		if err != nil {
+			return err
+		}
+
+		stdhttp.Redirect(ctx.Response(), r, out.(string), stdhttp.StatusSeeOther)
+		return nil
+	}
+}
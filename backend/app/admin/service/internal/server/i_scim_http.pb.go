@@ -0,0 +1,238 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	stdhttp "net/http"
+	"strings"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport/http"
+
+	"go-wind-admin/app/admin/service/internal/service"
+
+	"go-wind-admin/pkg/scim"
+)
+
+// maxScimBodySize SCIM 请求体上限，单个用户 / 组资源或 PATCH 操作集远小于此值。
+const maxScimBodySize = 1 << 20
+
+// registerScimServiceHandler 注册 SCIM 2.0 端点。
+// SCIM 使用 application/scim+json 报文、RFC 7644 错误格式与 ETag 头，并以租户令牌鉴权，
+// 不能使用代码生成器生成的 JSON Handler，需要手动注册。
+func registerScimServiceHandler(srv *http.Server, svc *service.ScimService) {
+	r := srv.Route("/")
+
+	base := strings.TrimPrefix(service.ScimBasePath, "/")
+
+	r.GET(base+"/ServiceProviderConfig", scimHandler(svc, OperationScimServiceGetServiceProviderConfig, stdhttp.StatusOK,
+		func(ctx context.Context, _ *scimRequest) (any, error) {
+			return svc.ServiceProviderConfig(), nil
+		}))
+
+	r.GET(base+"/Users", scimHandler(svc, OperationScimServiceListUsers, stdhttp.StatusOK,
+		func(ctx context.Context, req *scimRequest) (any, error) {
+			return svc.ListUsers(ctx, req.query("filter"), req.query("startIndex"), req.query("count"))
+		}))
+	r.POST(base+"/Users", scimHandler(svc, OperationScimServiceCreateUser, stdhttp.StatusCreated,
+		func(ctx context.Context, req *scimRequest) (any, error) {
+			var in scim.User
+			if err := scim.Decode(req.body, &in); err != nil {
+				return nil, err
+			}
+			return svc.CreateUser(ctx, &in)
+		}))
+	r.GET(base+"/Users/{id}", scimHandler(svc, OperationScimServiceGetUser, stdhttp.StatusOK,
+		func(ctx context.Context, req *scimRequest) (any, error) {
+			return svc.GetUser(ctx, req.id)
+		}))
+	r.PUT(base+"/Users/{id}", scimHandler(svc, OperationScimServiceReplaceUser, stdhttp.StatusOK,
+		func(ctx context.Context, req *scimRequest) (any, error) {
+			var in scim.User
+			if err := scim.Decode(req.body, &in); err != nil {
+				return nil, err
+			}
+			return svc.ReplaceUser(ctx, req.id, req.ifMatch, &in)
+		}))
+	r.PATCH(base+"/Users/{id}", scimHandler(svc, OperationScimServicePatchUser, stdhttp.StatusOK,
+		func(ctx context.Context, req *scimRequest) (any, error) {
+			var in scim.PatchRequest
+			if err := scim.Decode(req.body, &in); err != nil {
+				return nil, err
+			}
+			return svc.PatchUser(ctx, req.id, req.ifMatch, &in)
+		}))
+	r.DELETE(base+"/Users/{id}", scimHandler(svc, OperationScimServiceDeleteUser, stdhttp.StatusNoContent,
+		func(ctx context.Context, req *scimRequest) (any, error) {
+			return nil, svc.DeleteUser(ctx, req.id, req.ifMatch)
+		}))
+
+	r.GET(base+"/Groups", scimHandler(svc, OperationScimServiceListGroups, stdhttp.StatusOK,
+		func(ctx context.Context, req *scimRequest) (any, error) {
+			return svc.ListGroups(ctx, req.query("filter"), req.query("startIndex"), req.query("count"))
+		}))
+	r.POST(base+"/Groups", scimHandler(svc, OperationScimServiceCreateGroup, stdhttp.StatusCreated,
+		func(ctx context.Context, req *scimRequest) (any, error) {
+			var in scim.Group
+			if err := scim.Decode(req.body, &in); err != nil {
+				return nil, err
+			}
+			return svc.CreateGroup(ctx, &in)
+		}))
+	r.GET(base+"/Groups/{id}", scimHandler(svc, OperationScimServiceGetGroup, stdhttp.StatusOK,
+		func(ctx context.Context, req *scimRequest) (any, error) {
+			return svc.GetGroup(ctx, req.id)
+		}))
+	r.PUT(base+"/Groups/{id}", scimHandler(svc, OperationScimServiceReplaceGroup, stdhttp.StatusOK,
+		func(ctx context.Context, req *scimRequest) (any, error) {
+			var in scim.Group
+			if err := scim.Decode(req.body, &in); err != nil {
+				return nil, err
+			}
+			return svc.ReplaceGroup(ctx, req.id, req.ifMatch, &in)
+		}))
+	r.PATCH(base+"/Groups/{id}", scimHandler(svc, OperationScimServicePatchGroup, stdhttp.StatusOK,
+		func(ctx context.Context, req *scimRequest) (any, error) {
+			var in scim.PatchRequest
+			if err := scim.Decode(req.body, &in); err != nil {
+				return nil, err
+			}
+			return svc.PatchGroup(ctx, req.id, req.ifMatch, &in)
+		}))
+	r.DELETE(base+"/Groups/{id}", scimHandler(svc, OperationScimServiceDeleteGroup, stdhttp.StatusNoContent,
+		func(ctx context.Context, req *scimRequest) (any, error) {
+			return nil, svc.DeleteGroup(ctx, req.id, req.ifMatch)
+		}))
+}
+
+const OperationScimServiceGetServiceProviderConfig = "/admin.service.v1.ScimService/GetServiceProviderConfig"
+const OperationScimServiceListUsers = "/admin.service.v1.ScimService/ListUsers"
+const OperationScimServiceGetUser = "/admin.service.v1.ScimService/GetUser"
+const OperationScimServiceCreateUser = "/admin.service.v1.ScimService/CreateUser"
+const OperationScimServiceReplaceUser = "/admin.service.v1.ScimService/ReplaceUser"
+const OperationScimServicePatchUser = "/admin.service.v1.ScimService/PatchUser"
+const OperationScimServiceDeleteUser = "/admin.service.v1.ScimService/DeleteUser"
+const OperationScimServiceListGroups = "/admin.service.v1.ScimService/ListGroups"
+const OperationScimServiceGetGroup = "/admin.service.v1.ScimService/GetGroup"
+const OperationScimServiceCreateGroup = "/admin.service.v1.ScimService/CreateGroup"
+const OperationScimServiceReplaceGroup = "/admin.service.v1.ScimService/ReplaceGroup"
+const OperationScimServicePatchGroup = "/admin.service.v1.ScimService/PatchGroup"
+const OperationScimServiceDeleteGroup = "/admin.service.v1.ScimService/DeleteGroup"
+
+// scimRequest 传给 SCIM 业务处理函数的请求内容。
+type scimRequest struct {
+	r       *stdhttp.Request
+	id      string
+	ifMatch string
+	body    []byte
+}
+
+func (req *scimRequest) query(key string) string {
+	return req.r.URL.Query().Get(key)
+}
+
+// scimHandler 包装 SCIM 端点：读取请求体、经中间件链校验令牌后调用业务处理，并按 SCIM 规范输出结果或错误。
+func scimHandler(
+	svc *service.ScimService,
+	operation string,
+	status int,
+	call func(ctx context.Context, req *scimRequest) (any, error),
+) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		http.SetOperation(ctx, operation)
+
+		r := ctx.Request()
+		req := &scimRequest{
+			r:       r,
+			id:      ctx.Vars().Get("id"),
+			ifMatch: r.Header.Get("If-Match"),
+		}
+		if r.Body != nil {
+			body, err := io.ReadAll(stdhttp.MaxBytesReader(ctx.Response(), r.Body, maxScimBodySize))
+			if err != nil {
+				return writeScimError(ctx.Response(), scim.NewError(stdhttp.StatusRequestEntityTooLarge, "", "request body too large"))
+			}
+			req.body = body
+		}
+
+		authorization := r.Header.Get("Authorization")
+		h := ctx.Middleware(func(c context.Context, _ interface{}) (interface{}, error) {
+			c, err := svc.Authenticate(c, authorization)
+			if err != nil {
+				return nil, err
+			}
+			return call(c, req)
+		})
+
+		out, err := h(ctx, req.body)
+		if err != nil {
+			return writeScimError(ctx.Response(), err)
+		}
+
+		return writeScimResponse(ctx.Response(), r, status, out)
+	}
+}
+
+// writeScimResponse 输出资源；单个资源附带 ETag，新建资源附带 Location，If-None-Match 命中时返回 304。
+func writeScimResponse(w stdhttp.ResponseWriter, r *stdhttp.Request, status int, out any) error {
+	if status == stdhttp.StatusNoContent || out == nil {
+		w.WriteHeader(stdhttp.StatusNoContent)
+		return nil
+	}
+
+	var meta *scim.Meta
+	switch v := out.(type) {
+	case *scim.User:
+		meta = v.Meta
+	case *scim.Group:
+		meta = v.Meta
+	}
+	if meta != nil && meta.Version != "" {
+		w.Header().Set("ETag", meta.Version)
+		if r.Method == stdhttp.MethodGet && scim.MatchETag(r.Header.Get("If-None-Match"), meta.Version) {
+			w.WriteHeader(stdhttp.StatusNotModified)
+			return nil
+		}
+	}
+	if status == stdhttp.StatusCreated && meta != nil {
+		w.Header().Set("Location", meta.Location)
+	}
+
+	body, err := json.Marshal(out)
+	if err != nil {
+		return writeScimError(w, err)
+	}
+
+	w.Header().Set("Content-Type", scim.ContentType)
+	w.WriteHeader(status)
+	_, err = w.Write(body)
+	return err
+}
+
+// writeScimError 输出 RFC 7644 错误报文；业务层的 Kratos 错误按其 HTTP 状态码转换。
+func writeScimError(w stdhttp.ResponseWriter, err error) error {
+	var scimErr *scim.Error
+	if !errors.As(err, &scimErr) {
+		se := kerrors.FromError(err)
+		code := int(se.Code)
+		if code < 400 || code > 599 {
+			code = stdhttp.StatusInternalServerError
+		}
+		if code >= stdhttp.StatusInternalServerError {
+			log.Errorf("scim request failed: %v", err)
+			scimErr = scim.NewError(code, "", "internal server error")
+		} else {
+			scimErr = scim.NewError(code, "", se.Message)
+		}
+	}
+
+	body, _ := json.Marshal(scimErr)
+
+	w.Header().Set("Content-Type", scim.ContentType)
+	w.WriteHeader(scimErr.StatusCode())
+	_, err = w.Write(body)
+	return err
+}
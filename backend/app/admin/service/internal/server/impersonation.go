@@ -0,0 +1,92 @@
+package server
+
+import (
+	adminV1 "go-wind-admin/api/gen/go/admin/service/v1"
+)
+
+// impersonationAllowedOperations 代登录令牌允许调用的操作：查看被代登录用户可见的数据与退出代登录。
+// 采用允许列表，未列出的操作（包括后续新增的接口）一律拒绝；
+// 安全配置（客户端凭据、签名密钥、SCIM 令牌、目录与单点登录配置）与 MFA 备用码即使只读也不开放。
+var impersonationAllowedOperations = []string{
+	adminV1.OperationAuthenticationServiceLogout,
+	adminV1.OperationOidcServiceUserInfo,
+	adminV1.OperationRelationTupleServiceCheck,
+	adminV1.OperationFileTransferServiceDownloadFile,
+
+	adminV1.OperationAdminPortalServiceGetInitialContext,
+	adminV1.OperationAdminPortalServiceGetMyPermissionCode,
+	adminV1.OperationAdminPortalServiceGetNavigation,
+	adminV1.OperationApiAuditLogServiceGet,
+	adminV1.OperationApiAuditLogServiceList,
+	adminV1.OperationApiServiceGet,
+	adminV1.OperationApiServiceGetWalkRouteData,
+	adminV1.OperationApiServiceList,
+	adminV1.OperationDashboardServiceGetLoginStatusDistribution,
+	adminV1.OperationDashboardServiceGetLoginTrend,
+	adminV1.OperationDashboardServiceGetOperationActionDistribution,
+	adminV1.OperationDashboardServiceGetOverview,
+	adminV1.OperationDataAccessAuditLogServiceGet,
+	adminV1.OperationDataAccessAuditLogServiceList,
+	adminV1.OperationDictEntryServiceList,
+	adminV1.OperationDictEntryServiceListByTypeCode,
+	adminV1.OperationDictTypeServiceGet,
+	adminV1.OperationDictTypeServiceList,
+	adminV1.OperationFileServiceGet,
+	adminV1.OperationFileServiceList,
+	adminV1.OperationInternalMessageCategoryServiceGet,
+	adminV1.OperationInternalMessageCategoryServiceList,
+	adminV1.OperationInternalMessageRecipientServiceListUserInbox,
+	adminV1.OperationInternalMessageServiceGetMessage,
+	adminV1.OperationInternalMessageServiceListMessage,
+	adminV1.OperationLanguageServiceGet,
+	adminV1.OperationLanguageServiceList,
+	adminV1.OperationLoginAuditLogServiceGet,
+	adminV1.OperationLoginAuditLogServiceList,
+	adminV1.OperationLoginPolicyServiceGet,
+	adminV1.OperationLoginPolicyServiceList,
+	adminV1.OperationMenuServiceGet,
+	adminV1.OperationMenuServiceList,
+	adminV1.OperationMfaServiceGetMFAPolicy,
+	adminV1.OperationMfaServiceGetMFAStatus,
+	adminV1.OperationMfaServiceListEnrolledMethods,
+	adminV1.OperationOAuthServiceListLinkedAccounts,
+	adminV1.OperationOperationAuditLogServiceGet,
+	adminV1.OperationOperationAuditLogServiceList,
+	adminV1.OperationOrgUnitServiceGet,
+	adminV1.OperationOrgUnitServiceList,
+	adminV1.OperationPasswordPolicyServiceGet,
+	adminV1.OperationPermissionAuditLogServiceGet,
+	adminV1.OperationPermissionAuditLogServiceList,
+	adminV1.OperationPermissionGroupServiceGet,
+	adminV1.OperationPermissionGroupServiceList,
+	adminV1.OperationPermissionPolicyServiceGet,
+	adminV1.OperationPermissionPolicyServiceList,
+	adminV1.OperationPermissionServiceGet,
+	adminV1.OperationPermissionServiceList,
+	adminV1.OperationPlanModuleServiceGet,
+	adminV1.OperationPlanModuleServiceList,
+	adminV1.OperationPlanQuotaServiceList,
+	adminV1.OperationPlanServiceGet,
+	adminV1.OperationPlanServiceList,
+	adminV1.OperationPolicyEvaluationLogServiceGet,
+	adminV1.OperationPolicyEvaluationLogServiceList,
+	adminV1.OperationPositionServiceGet,
+	adminV1.OperationPositionServiceList,
+	adminV1.OperationRedisCacheMonitorServiceGet,
+	adminV1.OperationRelationTupleServiceListNamespaces,
+	adminV1.OperationRelationTupleServiceListObjects,
+	adminV1.OperationRelationTupleServiceListTuples,
+	adminV1.OperationRoleServiceGet,
+	adminV1.OperationRoleServiceList,
+	adminV1.OperationTaskServiceGet,
+	adminV1.OperationTaskServiceList,
+	adminV1.OperationTaskServiceListTaskTypeName,
+	adminV1.OperationTenantServiceGet,
+	adminV1.OperationTenantServiceGetUsage,
+	adminV1.OperationTenantServiceList,
+	adminV1.OperationUserProfileServiceGetUser,
+	adminV1.OperationUserProfileServiceListMySessions,
+	adminV1.OperationUserServiceGet,
+	adminV1.OperationUserServiceList,
+	adminV1.OperationUserServiceListSessions,
+}
@@ -0,0 +1,40 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	adminV1 "go-wind-admin/api/gen/go/admin/service/v1"
+)
+
+func TestImpersonationAllowedOperations(t *testing.T) {
+	allowed := make(map[string]struct{}, len(impersonationAllowedOperations))
+	for _, op := range impersonationAllowedOperations {
+		allowed[op] = struct{}{}
+	}
+
+	// 变更凭证、换取长期令牌与安全配置的操作不对代登录开放
+	for _, op := range []string{
+		adminV1.OperationAuthenticationServiceImpersonateUser,
+		adminV1.OperationOAuthServerServiceAuthorize,
+		adminV1.OperationOAuthServiceStartLinkOAuth,
+		adminV1.OperationOAuthServiceConfirmLinkOAuth,
+		adminV1.OperationOAuthServiceUnlinkOAuth,
+		adminV1.OperationUserProfileServiceUpdateUser,
+		adminV1.OperationUserProfileServiceChangePassword,
+		adminV1.OperationUserProfileServiceBindContact,
+		adminV1.OperationMfaServiceStartEnrollMethod,
+		adminV1.OperationMfaServiceStartLoginEnrollment,
+		adminV1.OperationMfaServiceConfirmLoginEnrollment,
+		adminV1.OperationMfaServiceListBackupCodes,
+		adminV1.OperationUserServiceEditUserPassword,
+		adminV1.OperationApiClientServiceGet,
+	} {
+		_, ok := allowed[op]
+		assert.False(t, ok, op)
+	}
+
+	_, ok := allowed[adminV1.OperationUserProfileServiceGetUser]
+	assert.True(t, ok)
+}
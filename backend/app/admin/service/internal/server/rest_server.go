@@ -2,6 +2,7 @@
 
 import (
 	"context"
+	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware"
@@ -26,16 +27,28 @@
 
 	"go-wind-admin/pkg/authorizer"
 	appViewer "go-wind-admin/pkg/entgo/viewer"
+	abacMiddleware "go-wind-admin/pkg/middleware/abac"
 	"go-wind-admin/pkg/middleware/auth"
+	dataScopeMiddleware "go-wind-admin/pkg/middleware/datascope"
+	"go-wind-admin/pkg/middleware/fieldperm"
 	applogging "go-wind-admin/pkg/middleware/logging"
 )
 
+// stepUpMaxAge 敏感操作允许的身份验证时间（auth_time）最大间隔
+const stepUpMaxAge = 15 * time.Minute
+
 // NewRestMiddleware 创建中间件
 func NewRestMiddleware(
 	ctx *bootstrap.Context,
 	accessTokenChecker auth.AccessTokenChecker,
 	tenantAccessChecker auth.TenantAccessChecker,
 	authorizer *authorizer.Authorizer,
+	permissionPolicyEvaluator *service.PermissionPolicyEvaluator,
+	dataScopeResolver *data.DataScopeResolver,
+	permissionCodeResolver *data.PermissionCodeResolver,
+	userService *service.UserService,
+	roleService *service.RoleService,
+	orgUnitService *service.OrgUnitService,
 	apiAuditLogRepo *data.ApiAuditLogRepo,
 	loginLogRepo *data.LoginAuditLogRepo,
 ) []middleware.Middleware {
@@ -64,9 +77,46 @@ func NewRestMiddleware(
 		adminV1.OperationAuthenticationServiceRegisterUser,
 		adminV1.OperationAuthenticationServiceGenerateCaptcha,
 		adminV1.OperationAuthenticationServiceVerifyCaptcha,
-		// MFA 登录挑战验证免鉴权：operation_id 由登录流程签发，见 doGrantTypePassword 的 MFA 闸门。
-		// 仅此一个 MFA RPC 免鉴权；管理侧 RPC（GetMFAStatus 等）走正常 auth+authz。
+		// 找回密码与账号激活在登录前进行，凭邮件/短信中的一次性令牌操作，接口自带限流
+		adminV1.OperationAuthenticationServiceRequestPasswordReset,
+		adminV1.OperationAuthenticationServiceConfirmPasswordReset,
+		adminV1.OperationAuthenticationServiceActivateAccount,
+		// MFA 登录挑战免鉴权：operation_id 由登录流程签发，见 completeUserLogin 的 MFA 闸门。
+		// 通行密钥无密码登录在令牌签发前进行，挑战由 StartPasskeyLogin 签发。
+		// 管理侧 RPC（GetMFAStatus 等）走正常 auth+authz。
+		adminV1.OperationMfaServiceStartMFAChallenge,
 		adminV1.OperationMfaServiceVerifyMFAChallenge,
+		// 登录强制注册：MFA 策略要求启用而用户尚无因子时，凭登录签发的强制注册挑战注册因子
+		adminV1.OperationMfaServiceStartLoginEnrollment,
+		adminV1.OperationMfaServiceConfirmLoginEnrollment,
+		adminV1.OperationMfaServiceStartPasskeyLogin,
+		adminV1.OperationMfaServiceFinishPasskeyLogin,
+		// OIDC 发现文档与公钥集供第三方/网关匿名拉取
+		adminV1.OperationOidcServiceGetOpenIDConfiguration,
+		adminV1.OperationOidcServiceGetJwks,
+		// 第三方登录：登录页列出提供方、发起授权与回调换票均在登录前，state 由 StartOAuthLogin 签发
+		adminV1.OperationOAuthServiceListProviders,
+		adminV1.OperationOAuthServiceStartOAuthLogin,
+		adminV1.OperationOAuthServiceCompleteOAuthLogin,
+		// SAML 单点登录：SP 元数据供 IdP 匿名拉取，ACS 由浏览器携带 IdP 断言提交，登录票据在令牌签发前使用
+		adminV1.OperationSamlServiceStartSamlLogin,
+		adminV1.OperationSamlServiceCompleteSamlLogin,
+		OperationSamlServiceGetMetadata,
+		OperationSamlServiceAssertionConsumer,
+		// SCIM 供应由身份提供方以租户令牌调用，令牌在 Handler 内校验，不走 JWT 鉴权与权限校验
+		OperationScimServiceGetServiceProviderConfig,
+		OperationScimServiceListUsers,
+		OperationScimServiceGetUser,
+		OperationScimServiceCreateUser,
+		OperationScimServiceReplaceUser,
+		OperationScimServicePatchUser,
+		OperationScimServiceDeleteUser,
+		OperationScimServiceListGroups,
+		OperationScimServiceGetGroup,
+		OperationScimServiceCreateGroup,
+		OperationScimServiceReplaceGroup,
+		OperationScimServicePatchGroup,
+		OperationScimServiceDeleteGroup,
 		//OperationFileTransferServiceDownloadFile,
 		//OperationFileTransferServicePostUploadFile,
 		//OperationFileTransferServicePutUploadFile,
@@ -76,10 +126,39 @@ func NewRestMiddleware(
 			auth.Server(
 				auth.WithAccessTokenChecker(accessTokenChecker),
 				auth.WithTenantAccessChecker(tenantAccessChecker),
+				// 按角色填充 Ent Viewer 的权限码，供 HasPermission 做动作级判断
+				auth.WithPermissionResolver(permissionCodeResolver),
 				auth.WithInjectMetadata(false),
 				auth.WithInjectEnt(true),
+				auth.WithEnableCheckScopes(true),
+				// 代登录令牌只能调用只读与支持类操作（允许列表），不得变更被代登录用户的凭证与安全设置，也不得再次发起代登录
+				auth.WithImpersonationAllowedOperations(impersonationAllowedOperations...),
+				// 敏感操作要求近期完成身份验证（登录或二次验证），超时需先调用 Reauthenticate
+				auth.WithStepUpOperations(stepUpMaxAge,
+					adminV1.OperationAuthenticationServiceImpersonateUser,
+					adminV1.OperationUserProfileServiceChangePassword,
+					adminV1.OperationMfaServiceDisableMFA,
+					adminV1.OperationMfaServiceRevokeMFADevice,
+					adminV1.OperationMfaServiceGenerateBackupCodes,
+					adminV1.OperationMfaServiceUpdateMFAPolicy,
+					adminV1.OperationUserServiceEditUserPassword,
+					adminV1.OperationTenantServiceCleanupData,
+					adminV1.OperationRoleServiceUpdate,
+					adminV1.OperationRoleServiceDelete,
+					adminV1.OperationTaskServiceControlTask,
+					adminV1.OperationTaskServiceRestartAllTask,
+					adminV1.OperationTaskServiceStopAllTask,
+					adminV1.OperationApiClientServiceRotateSecret,
+					adminV1.OperationJwtSigningKeyServiceRotate,
+				),
 			),
 			authz.Server(authorizer.Engine()),
+			// RBAC 放行后再按权限点上的动态策略（ABAC）判定
+			abacMiddleware.Server(permissionPolicyEvaluator),
+			// 按角色数据权限为 Ent Viewer 注入行过滤范围
+			dataScopeMiddleware.Server(dataScopeResolver),
+			// 按角色权限码校验字段写入并脱敏响应字段，规则由各服务注册
+			fieldperm.Server(userService, roleService, orgUnitService),
 		).
 			Match(rpc.NewRestWhiteListMatcher()).
 			Build(),
@@ -98,6 +177,18 @@ func NewRestServer(
 	authenticationService *service.AuthenticationService,
 	mfaService *service.MfaService,
 	loginPolicyService *service.LoginPolicyService,
+	passwordPolicyService *service.PasswordPolicyService,
+	apiClientService *service.ApiClientService,
+	oauthServerService *service.OAuthServerService,
+	oidcService *service.OidcService,
+	oauthService *service.OAuthService,
+	oauthProviderConfigService *service.OAuthProviderConfigService,
+	samlService *service.SamlService,
+	samlConfigService *service.SamlConfigService,
+	ldapConfigService *service.LdapConfigService,
+	scimService *service.ScimService,
+	scimTokenService *service.ScimTokenService,
+	jwtSigningKeyService *service.JwtSigningKeyService,
 
 	portalService *service.AdminPortalService,
 	taskService *service.TaskService,
@@ -123,6 +214,8 @@ func NewRestServer(
 	apiService *service.ApiService,
 	permissionService *service.PermissionService,
 	permissionGroupService *service.PermissionGroupService,
+	permissionPolicyService *service.PermissionPolicyService,
+	relationTupleService *service.RelationTupleService,
 	permissionAuditLogService *service.PermissionAuditLogService,
 	policyEvaluationLogService *service.PolicyEvaluationLogService,
 
@@ -162,6 +255,21 @@ func NewRestServer(
 	adminV1.RegisterAdminPortalServiceHTTPServer(srv, portalService)
 	adminV1.RegisterTaskServiceHTTPServer(srv, taskService)
 	adminV1.RegisterLoginPolicyServiceHTTPServer(srv, loginPolicyService)
+	adminV1.RegisterPasswordPolicyServiceHTTPServer(srv, passwordPolicyService)
+	adminV1.RegisterApiClientServiceHTTPServer(srv, apiClientService)
+	adminV1.RegisterOAuthServerServiceHTTPServer(srv, oauthServerService)
+	adminV1.RegisterOidcServiceHTTPServer(srv, oidcService)
+	adminV1.RegisterOAuthServiceHTTPServer(srv, oauthService)
+	adminV1.RegisterOAuthProviderConfigServiceHTTPServer(srv, oauthProviderConfigService)
+	adminV1.RegisterSamlServiceHTTPServer(srv, samlService)
+	adminV1.RegisterSamlConfigServiceHTTPServer(srv, samlConfigService)
+	// SP 元数据与 ACS 返回 XML / 跳转，手动注册
+	registerSamlServiceHandler(srv, samlService)
+	adminV1.RegisterLdapConfigServiceHTTPServer(srv, ldapConfigService)
+	adminV1.RegisterScimTokenServiceHTTPServer(srv, scimTokenService)
+	// SCIM 端点使用 scim+json 报文与租户令牌鉴权，手动注册
+	registerScimServiceHandler(srv, scimService)
+	adminV1.RegisterJwtSigningKeyServiceHTTPServer(srv, jwtSigningKeyService)
 
 	adminV1.RegisterDictTypeServiceHTTPServer(srv, dictTypeService)
 	adminV1.RegisterDictEntryServiceHTTPServer(srv, dictEntryService)
@@ -171,10 +279,13 @@ func NewRestServer(
 	adminV1.RegisterMenuServiceHTTPServer(srv, menuService)
 	adminV1.RegisterPermissionServiceHTTPServer(srv, permissionService)
 	adminV1.RegisterPermissionGroupServiceHTTPServer(srv, permissionGroupService)
+	adminV1.RegisterPermissionPolicyServiceHTTPServer(srv, permissionPolicyService)
+	adminV1.RegisterRelationTupleServiceHTTPServer(srv, relationTupleService)
 	adminV1.RegisterPolicyEvaluationLogServiceHTTPServer(srv, policyEvaluationLogService)
 	adminV1.RegisterPermissionAuditLogServiceHTTPServer(srv, permissionAuditLogService)
 
-	adminV1.RegisterUserServiceHTTPServer(srv, adminV1.RedactedUserServiceServer(&userServiceServerAdapter{UserServiceHTTPServer: userService}, nil))
+	// 静态脱敏为基线，调用方显式持有字段查看权限码时由字段权限中间件按字段恢复明文
+	adminV1.RegisterUserServiceHTTPServer(srv, adminV1.RedactedUserServiceServer(&userServiceServerAdapter{UserServiceHTTPServer: userService}, fieldperm.Bypass{}))
 	adminV1.RegisterOrgUnitServiceHTTPServer(srv, orgUnitService)
 	adminV1.RegisterRoleServiceHTTPServer(srv, roleService)
 	adminV1.RegisterPositionServiceHTTPServer(srv, positionService)
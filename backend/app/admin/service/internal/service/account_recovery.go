@@ -0,0 +1,278 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"go-wind-admin/app/admin/service/internal/data"
+
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+	identityV1 "go-wind-admin/api/gen/go/identity/service/v1"
+
+	"go-wind-admin/pkg/netutil"
+	"go-wind-admin/pkg/sender"
+)
+
+// 找回密码与账号激活：
+//   - RequestPasswordReset 向账号绑定的邮箱/手机号发送重置令牌，无论账号是否存在、能否投递都返回成功（防枚举）；
+//   - ConfirmPasswordReset / ActivateAccount 凭令牌设置新密码，令牌无效、过期、已使用统一返回同一错误；
+//   - 管理员创建用户时可选择发送激活链接（UserService），用户激活前无法登录。
+//
+// 令牌见 data.CredentialTokenKind。链接地址读取环境变量 GWA_AUTH_PASSWORD_RESET_URL / GWA_AUTH_ACTIVATION_URL
+// （前端页面地址，令牌以 token 查询参数附加），未配置时消息中直接给出令牌。
+
+const (
+	// passwordResetTokenTTL 重置令牌有效期。
+	passwordResetTokenTTL = 30 * time.Minute
+	// activationTokenTTL 激活令牌有效期。
+	activationTokenTTL = 72 * time.Hour
+
+	// recoveryWindow 找回密码/激活接口的限流窗口。
+	recoveryWindow = 15 * time.Minute
+	// recoveryIPLimit 同一 IP 在窗口内的请求上限。
+	recoveryIPLimit = 20
+	// recoveryAccountLimit 同一账号在窗口内可申请重置的次数（防消息轰炸）。
+	recoveryAccountLimit = 3
+
+	// placeholderPasswordBytes 待激活用户占位密码的随机字节数，占位密码不告知任何人。
+	placeholderPasswordBytes = 32
+)
+
+// 限流 scope，对应 data.LoginRateLimiter.Allow。
+const (
+	rateLimitScopeResetRequest = "password-reset"
+	rateLimitScopeResetConfirm = "password-reset-confirm"
+	rateLimitScopeActivate     = "account-activate"
+)
+
+// credentialTokenNotifier 组装并投递找回密码/激活消息。
+type credentialTokenNotifier struct {
+	sender *sender.Router
+
+	resetURL      string
+	activationURL string
+}
+
+func newCredentialTokenNotifier(router *sender.Router) *credentialTokenNotifier {
+	return &credentialTokenNotifier{
+		sender:        router,
+		resetURL:      strings.TrimSpace(os.Getenv("GWA_AUTH_PASSWORD_RESET_URL")),
+		activationURL: strings.TrimSpace(os.Getenv("GWA_AUTH_ACTIVATION_URL")),
+	}
+}
+
+// destination 按指定渠道取收件人；未指定时优先邮箱，其次手机号。渠道未配置驱动或用户未绑定时 ok=false。
+func (n *credentialTokenNotifier) destination(user *identityV1.User, channel authenticationV1.DeliveryChannel) (sender.Channel, string, bool) {
+	email := strings.TrimSpace(user.GetEmail())
+	mobile := strings.TrimSpace(user.GetMobile())
+
+	useEmail := email != "" && n.sender.Supports(sender.ChannelEmail)
+	useSMS := mobile != "" && n.sender.Supports(sender.ChannelSMS)
+
+	switch {
+	case channel == authenticationV1.DeliveryChannel_DELIVERY_CHANNEL_EMAIL && useEmail,
+		channel == authenticationV1.DeliveryChannel_DELIVERY_CHANNEL_UNSPECIFIED && useEmail:
+		return sender.ChannelEmail, email, true
+	case channel == authenticationV1.DeliveryChannel_DELIVERY_CHANNEL_SMS && useSMS,
+		channel == authenticationV1.DeliveryChannel_DELIVERY_CHANNEL_UNSPECIFIED && useSMS:
+		return sender.ChannelSMS, mobile, true
+	}
+	return "", "", false
+}
+
+// link 拼接前端页面链接，未配置地址时返回空串。
+func (n *credentialTokenNotifier) link(kind data.CredentialTokenKind, token string) string {
+	base := n.resetURL
+	if kind == data.CredentialTokenActivate {
+		base = n.activationURL
+	}
+	if base == "" {
+		return ""
+	}
+	sep := "?"
+	if strings.Contains(base, "?") {
+		sep = "&"
+	}
+	// 令牌为 base64url，无需转义
+	return base + sep + "token=" + token
+}
+
+// send 投递令牌消息；Params 供基于模板的短信网关使用。
+func (n *credentialTokenNotifier) send(ctx context.Context, kind data.CredentialTokenKind, channel sender.Channel, to, token string) error {
+	subject, action, ttl := "重置密码", "重置密码", passwordResetTokenTTL
+	if kind == data.CredentialTokenActivate {
+		subject, action, ttl = "激活账号", "激活账号并设置密码", activationTokenTTL
+	}
+
+	minutes := fmt.Sprintf("%d", int(ttl.Minutes()))
+	link := n.link(kind, token)
+	target := link
+	if target == "" {
+		target = "令牌 " + token
+	}
+
+	return n.sender.Send(ctx, &sender.Message{
+		Channel: channel,
+		To:      to,
+		Subject: fmt.Sprintf("%s %s", mfaTotpIssuer, subject),
+		Body:    fmt.Sprintf("【%s】请在 %s 分钟内通过以下%s：%s 。如非本人操作，请忽略。", mfaTotpIssuer, minutes, action, target),
+		Params: map[string]string{
+			"token":       token,
+			"link":        link,
+			"ttl_minutes": minutes,
+		},
+	})
+}
+
+// newPlaceholderPassword 生成待激活用户的随机占位密码。
+func newPlaceholderPassword() (string, error) {
+	b := make([]byte, placeholderPasswordBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// allowRecovery 未登录接口限流：先按 IP，再按账号（account 为空时跳过）。
+func (s *AuthenticationService) allowRecovery(ctx context.Context, scope, clientIP, account string) error {
+	if ok, retryAfter := s.rateLimiter.Allow(ctx, scope+":ip", clientIP, recoveryIPLimit, recoveryWindow); !ok {
+		return authenticationV1.ErrorTooManyRequests("too many requests, retry in %d seconds", int(retryAfter.Seconds()))
+	}
+	if ok, retryAfter := s.rateLimiter.Allow(ctx, scope+":account", account, recoveryAccountLimit, recoveryWindow); !ok {
+		return authenticationV1.ErrorTooManyRequests("too many requests, retry in %d seconds", int(retryAfter.Seconds()))
+	}
+	return nil
+}
+
+// RequestPasswordReset 申请找回密码。
+// 账号不存在、已停用、未绑定可投递的联系方式、租户仅允许单点登录等情况均只记日志，对外与成功一致，
+// 与 normalizeLoginVerifyError 一样不暴露账号是否存在。限流按 IP 与账号计数，与账号是否存在无关。
+func (s *AuthenticationService) RequestPasswordReset(ctx context.Context, req *authenticationV1.RequestPasswordResetRequest) (*emptypb.Empty, error) {
+	ctx = s.resetContextForLogin(ctx)
+
+	account := strings.TrimSpace(req.GetAccount())
+	if account == "" {
+		return nil, authenticationV1.ErrorBadRequest("account required")
+	}
+
+	clientIP := netutil.ClientIPFromContext(ctx)
+	accountKey := strings.ToLower(strings.TrimSpace(req.GetTenantCode()) + "/" + account)
+	if err := s.allowRecovery(ctx, rateLimitScopeResetRequest, clientIP, accountKey); err != nil {
+		s.log.Warnf("password reset rate limited: ip=%s account=%s", clientIP, account)
+		return nil, err
+	}
+
+	if err := s.sendPasswordReset(ctx, req.GetTenantCode(), account, req.GetChannel()); err != nil {
+		s.log.Warnf("password reset not sent: ip=%s account=%s reason=%s", clientIP, account, err.Error())
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// sendPasswordReset 解析账号并投递重置令牌，任何失败都由调用方吞掉。
+func (s *AuthenticationService) sendPasswordReset(ctx context.Context, tenantCode, account string, channel authenticationV1.DeliveryChannel) error {
+	tenantID, err := s.resolveLoginTenant(ctx, tenantCode)
+	if err != nil {
+		return err
+	}
+	if err = s.checkLocalPasswordAllowed(ctx, tenantID); err != nil {
+		return err
+	}
+	if s.ldapConfigRepo != nil {
+		// 仅目录认证的租户密码由目录管理，本地重置无意义
+		if settings, lerr := s.ldapConfigRepo.GetEnabled(ctx, tenantID); lerr != nil {
+			return lerr
+		} else if settings != nil && settings.LdapOnly {
+			return authenticationV1.ErrorForbidden("password managed by ldap")
+		}
+	}
+
+	username, userID, err := s.userRepo.FindUsernameByIdentifier(ctx, tenantID, account)
+	if err != nil {
+		return err
+	}
+	if userID == 0 {
+		if userID, err = s.userCredentialRepo.FindUserIDByUsername(ctx, tenantID, username); err != nil {
+			return err
+		}
+	}
+
+	user, err := s.userRepo.Get(ctx, &identityV1.GetUserRequest{QueryBy: &identityV1.GetUserRequest_Id{Id: userID}})
+	if err != nil {
+		return err
+	}
+	if user.GetTenantId() != tenantID || user.GetStatus() != identityV1.User_NORMAL {
+		return authenticationV1.ErrorUserFreeze("user is disabled")
+	}
+
+	ch, to, ok := s.tokenNotifier.destination(user, channel)
+	if !ok {
+		return authenticationV1.ErrorBadRequest("no deliverable contact for channel %s", channel)
+	}
+
+	token, err := s.userCredentialRepo.IssueCredentialToken(ctx, data.CredentialTokenReset, tenantID, userID, passwordResetTokenTTL)
+	if err != nil {
+		return err
+	}
+	if err = s.tokenNotifier.send(ctx, data.CredentialTokenReset, ch, to, token); err != nil {
+		return err
+	}
+
+	s.log.Infof("password reset token sent to user [%d] via %s", userID, ch)
+	return nil
+}
+
+// ConfirmPasswordReset 凭重置令牌设置新密码（与登录密码相同的加密方式），成功后吊销该用户已签发的令牌。
+func (s *AuthenticationService) ConfirmPasswordReset(ctx context.Context, req *authenticationV1.ConfirmPasswordResetRequest) (*emptypb.Empty, error) {
+	ctx = s.resetContextForLogin(ctx)
+
+	if err := s.allowRecovery(ctx, rateLimitScopeResetConfirm, netutil.ClientIPFromContext(ctx), ""); err != nil {
+		return nil, err
+	}
+
+	password, err := s.userCredentialRepo.DecryptLoginCredential(req.GetNewPassword())
+	if err != nil {
+		return nil, err
+	}
+
+	_, userID, err := s.userCredentialRepo.ConsumeCredentialToken(ctx, data.CredentialTokenReset, req.GetToken(), password)
+	if err != nil {
+		return nil, err
+	}
+
+	// 密码可能已泄露：重置后旧会话一律失效
+	if err = s.authenticator.RevokeUserToken(ctx, s.clientType, userID); err != nil {
+		s.log.Errorf("revoke tokens after password reset failed for user [%d]: %s", userID, err.Error())
+	}
+
+	s.log.Infof("user [%d] reset password by token", userID)
+	return &emptypb.Empty{}, nil
+}
+
+// ActivateAccount 凭激活令牌设置密码并启用凭证。
+func (s *AuthenticationService) ActivateAccount(ctx context.Context, req *authenticationV1.ActivateAccountRequest) (*emptypb.Empty, error) {
+	ctx = s.resetContextForLogin(ctx)
+
+	if err := s.allowRecovery(ctx, rateLimitScopeActivate, netutil.ClientIPFromContext(ctx), ""); err != nil {
+		return nil, err
+	}
+
+	password, err := s.userCredentialRepo.DecryptLoginCredential(req.GetPassword())
+	if err != nil {
+		return nil, err
+	}
+
+	_, userID, err := s.userCredentialRepo.ConsumeCredentialToken(ctx, data.CredentialTokenActivate, req.GetToken(), password)
+	if err != nil {
+		return nil, err
+	}
+
+	s.log.Infof("user [%d] activated account", userID)
+	return &emptypb.Empty{}, nil
+}
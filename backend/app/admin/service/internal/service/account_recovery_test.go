@@ -0,0 +1,150 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tx7do/go-utils/crypto"
+	"github.com/tx7do/go-utils/trans"
+
+	conf "github.com/tx7do/kratos-bootstrap/api/gen/go/conf/v1"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"go-wind-admin/app/admin/service/internal/data"
+	"go-wind-admin/app/admin/service/internal/data/ent/user"
+	"go-wind-admin/app/admin/service/internal/data/enttest"
+
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+
+	"go-wind-admin/pkg/sender"
+)
+
+// encryptLoginPassword 按登录接口约定加密明文密码（AES + base64）。
+func encryptLoginPassword(t *testing.T, plain string) string {
+	t.Helper()
+	encrypted, err := crypto.AesEncrypt([]byte(plain), crypto.DefaultAESKey, nil)
+	require.NoError(t, err)
+	return base64.StdEncoding.EncodeToString(encrypted)
+}
+
+func TestAuthenticationService_PasswordReset(t *testing.T) {
+	t.Setenv("GWA_AUTH_PASSWORD_RESET_URL", "https://admin.example.com/#/reset-password")
+
+	mr, err := miniredis.Run()
+	require.NoError(t, err)
+	t.Cleanup(mr.Close)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+
+	entClient := enttest.NewEntClientForTest(t)
+	bctx := bootstrap.NewContextWithParam(context.Background(), &conf.AppInfo{}, &conf.Bootstrap{
+		Authn: &conf.Authentication{
+			Jwt: &conf.Authentication_Jwt{Method: "HS256", Key: "password-reset-test-signing-key"},
+		},
+	}, log.DefaultLogger)
+	ctx := enttest.NewSystemViewerCtx(context.Background())
+
+	userRoleRepo := data.NewUserRoleRepo(bctx, entClient)
+	membershipRepo := data.NewMembershipRepo(bctx, entClient,
+		data.NewMembershipRoleRepo(bctx, entClient), data.NewMembershipPositionRepo(bctx, entClient), data.NewMembershipOrgUnitRepo(bctx, entClient))
+	userRepo := data.NewUserRepo(bctx, entClient, userRoleRepo, data.NewUserOrgUnitRepo(bctx, entClient), data.NewUserPositionRepo(bctx, entClient), membershipRepo)
+	credentialRepo := data.NewUserCredentialRepo(bctx, entClient, data.NewPasswordCrypto(), nil)
+	authenticator := data.NewAuthenticator(bctx, data.NewUserTokenCache(bctx, rdb), nil)
+
+	sent := sender.NewMemorySender(nil)
+	router := sender.NewRouter().Register(sender.ChannelEmail, sent)
+	svc := NewAuthenticationService(bctx, userRepo, credentialRepo, nil, nil, nil, nil, nil, authenticator,
+		authenticationV1.ClientType_admin, nil, data.NewLoginRateLimiter(bctx, rdb), nil, nil, nil, nil, nil, nil, nil, nil, nil, router, nil)
+
+	const (
+		userID   = 9164
+		username = "recover9164"
+		email    = "recover9164@example.com"
+	)
+	require.NoError(t, entClient.Client().User.Create().
+		SetID(userID).
+		SetTenantID(0).
+		SetUsername(username).
+		SetEmail(email).
+		SetMobile("13800009164").
+		SetStatus(user.StatusNormal).
+		Exec(ctx))
+	require.NoError(t, credentialRepo.Create(ctx, &authenticationV1.CreateUserCredentialRequest{Data: &authenticationV1.UserCredential{
+		UserId:         trans.Ptr(uint32(userID)),
+		TenantId:       trans.Ptr(uint32(0)),
+		IdentityType:   authenticationV1.UserCredential_USERNAME.Enum(),
+		Identifier:     trans.Ptr(username),
+		CredentialType: authenticationV1.UserCredential_PASSWORD_HASH.Enum(),
+		Credential:     trans.Ptr("Old#Pass1"),
+		Status:         authenticationV1.UserCredential_ENABLED.Enum(),
+	}}))
+
+	// 未知账号、未配置驱动的渠道与正常账号响应一致
+	_, err = svc.RequestPasswordReset(context.Background(), &authenticationV1.RequestPasswordResetRequest{Account: "nobody9164"})
+	require.NoError(t, err)
+	_, err = svc.RequestPasswordReset(context.Background(), &authenticationV1.RequestPasswordResetRequest{
+		Account: username,
+		Channel: authenticationV1.DeliveryChannel_DELIVERY_CHANNEL_SMS.Enum(),
+	})
+	require.NoError(t, err)
+	assert.Empty(t, sent.Messages())
+
+	// 按邮箱申请，令牌随链接投递到绑定邮箱
+	_, err = svc.RequestPasswordReset(context.Background(), &authenticationV1.RequestPasswordResetRequest{Account: email})
+	require.NoError(t, err)
+	msg, ok := sent.Last(email)
+	require.True(t, ok)
+	token := msg.Params["token"]
+	require.NotEmpty(t, token)
+	assert.Equal(t, "https://admin.example.com/#/reset-password?token="+token, msg.Params["link"])
+	assert.True(t, strings.Contains(msg.Body, msg.Params["link"]))
+
+	// 同一账号窗口内超过上限即限流
+	for i := 1; i < recoveryAccountLimit; i++ {
+		_, err = svc.RequestPasswordReset(context.Background(), &authenticationV1.RequestPasswordResetRequest{Account: email})
+		require.NoError(t, err)
+	}
+	_, err = svc.RequestPasswordReset(context.Background(), &authenticationV1.RequestPasswordResetRequest{Account: email})
+	assert.Equal(t, 429, int(errors.Code(err)))
+	msg, _ = sent.Last(email)
+	token = msg.Params["token"]
+
+	accessToken, _, err := authenticator.CreateUserToken(context.Background(), authenticationV1.ClientType_admin,
+		&authenticationV1.UserTokenPayload{UserId: userID, Username: trans.Ptr(username)})
+	require.NoError(t, err)
+
+	_, err = svc.ConfirmPasswordReset(context.Background(), &authenticationV1.ConfirmPasswordResetRequest{
+		Token:       "invalid",
+		NewPassword: encryptLoginPassword(t, "New#Pass2"),
+	})
+	assert.Equal(t, 400, int(errors.Code(err)))
+
+	_, err = svc.ConfirmPasswordReset(context.Background(), &authenticationV1.ConfirmPasswordResetRequest{
+		Token:       token,
+		NewPassword: encryptLoginPassword(t, "New#Pass2"),
+	})
+	require.NoError(t, err)
+
+	_, err = credentialRepo.FindUserCredential(ctx, 0, authenticationV1.UserCredential_USERNAME, username, "New#Pass2", false)
+	require.NoError(t, err)
+
+	// 重置后旧会话失效
+	_, err = authenticator.Authenticate(context.Background(), &authenticationV1.ValidateTokenRequest{
+		Token:      accessToken,
+		ClientType: authenticationV1.ClientType_admin,
+	})
+	assert.Error(t, err)
+
+	_, err = svc.ConfirmPasswordReset(context.Background(), &authenticationV1.ConfirmPasswordResetRequest{
+		Token:       token,
+		NewPassword: encryptLoginPassword(t, "Other#Pass3"),
+	})
+	assert.Equal(t, 400, int(errors.Code(err)))
+}
@@ -7,16 +7,20 @@
 	"strings"
 
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/go-utils/jwtutil"
+	authnEngine "github.com/tx7do/kratos-authn/engine"
 
 	paginationV1 "github.com/tx7do/go-crud/api/gen/go/pagination/v1"
 	"github.com/tx7do/go-utils/sliceutil"
 	"github.com/tx7do/go-utils/trans"
 	"github.com/tx7do/kratos-bootstrap/bootstrap"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"go-wind-admin/app/admin/service/internal/data"
 
 	adminV1 "go-wind-admin/api/gen/go/admin/service/v1"
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
 	identityV1 "go-wind-admin/api/gen/go/identity/service/v1"
 	permissionV1 "go-wind-admin/api/gen/go/permission/service/v1"
 
@@ -240,3 +244,51 @@ func (s *AdminPortalService) filterMenusByPlanWhitelist(ctx context.Context, ite
 	}
 	return filtered
 }
+
+// GetInitialContext 一次性返回进入后台所需的菜单、权限码；代登录令牌额外返回代登录状态，供前端醒目提示
+func (s *AdminPortalService) GetInitialContext(ctx context.Context, _ *emptypb.Empty) (*adminV1.InitialContextResponse, error) {
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	navigation, err := s.GetNavigation(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, err
+	}
+
+	permissionCodes, err := s.GetMyPermissionCode(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &adminV1.InitialContextResponse{
+		Menus:         navigation.GetItems(),
+		Permissions:   permissionCodes.GetCodes(),
+		Impersonation: s.impersonationInfo(ctx, operator),
+	}, nil
+}
+
+// impersonationInfo 代登录令牌的操作人与到期时间，普通令牌返回 nil
+func (s *AdminPortalService) impersonationInfo(ctx context.Context, operator *authenticationV1.UserTokenPayload) *authenticationV1.ImpersonationInfo {
+	if operator.GetActorUserId() == 0 {
+		return nil
+	}
+
+	info := &authenticationV1.ImpersonationInfo{
+		ActorUserId:   operator.GetActorUserId(),
+		ActorUsername: operator.ActorUsername,
+	}
+
+	// 令牌已由认证中间件验签，这里只读取其过期时间
+	if token, err := authnEngine.AuthFromMD(ctx, authnEngine.BearerWord, authnEngine.ContextTypeKratosMetaData); err == nil {
+		if claims, perr := jwtutil.ParseJWTPayload(token); perr == nil {
+			if exp, _ := claims.GetExpirationTime(); exp != nil {
+				info.ExpiresAt = timestamppb.New(exp.Time)
+			}
+		}
+	}
+
+	return info
+}
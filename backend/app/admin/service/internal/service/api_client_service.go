@@ -0,0 +1,225 @@
+package service
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/log"
+	paginationV1 "github.com/tx7do/go-crud/api/gen/go/pagination/v1"
+	"github.com/tx7do/go-utils/sliceutil"
+	"github.com/tx7do/go-utils/trans"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"go-wind-admin/app/admin/service/internal/data"
+
+	adminV1 "go-wind-admin/api/gen/go/admin/service/v1"
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+	identityV1 "go-wind-admin/api/gen/go/identity/service/v1"
+
+	"go-wind-admin/pkg/middleware/auth"
+	"go-wind-admin/pkg/oauth"
+)
+
+type ApiClientService struct {
+	adminV1.ApiClientServiceHTTPServer
+
+	log *log.Helper
+
+	repo     *data.ApiClientRepo
+	roleRepo *data.RoleRepo
+	userRepo data.UserRepo
+
+	authenticator *data.Authenticator
+	clientType    authenticationV1.ClientType
+}
+
+func NewApiClientService(
+	ctx *bootstrap.Context,
+	repo *data.ApiClientRepo,
+	roleRepo *data.RoleRepo,
+	userRepo data.UserRepo,
+	authenticator *data.Authenticator,
+	clientType authenticationV1.ClientType,
+) *ApiClientService {
+	return &ApiClientService{
+		log:           ctx.NewLoggerHelper("api-client/service/admin-service"),
+		repo:          repo,
+		roleRepo:      roleRepo,
+		userRepo:      userRepo,
+		authenticator: authenticator,
+		clientType:    clientType,
+	}
+}
+
+// checkRoleIds 校验授权给服务客户端的角色均可分配（启用、非模板、非平台管理员模板）。
+// 角色查询受租户隐私过滤，租户管理员无法把其他租户的角色授予本租户客户端。
+// 授予操作人自身未持有、且客户端原本没有的角色属于授权操作，另需角色管理权限，
+// 避免仅有客户端管理权限者借 client_credentials 客户端提权。
+func (s *ApiClientService) checkRoleIds(ctx context.Context, operatorId uint32, roleIds, keptRoleIds []uint32) error {
+	for _, roleId := range roleIds {
+		if _, err := s.roleRepo.CanAssignRole(ctx, roleId); err != nil {
+			return err
+		}
+	}
+
+	var operatorUser *identityV1.User
+	for _, roleId := range roleIds {
+		if sliceutil.Includes(keptRoleIds, roleId) {
+			continue
+		}
+		if operatorUser == nil {
+			var err error
+			if operatorUser, err = s.userRepo.Get(ctx, &identityV1.GetUserRequest{
+				QueryBy: &identityV1.GetUserRequest_Id{Id: operatorId},
+			}); err != nil {
+				return err
+			}
+		}
+		if sliceutil.Includes(operatorUser.GetRoleIds(), roleId) {
+			continue
+		}
+		return requirePermission(ctx, "edit", "role")
+	}
+	return nil
+}
+
+// checkOAuthConfig 校验授权码模式登记信息：回调地址格式与授权范围词表。
+func (s *ApiClientService) checkOAuthConfig(data *authenticationV1.ApiClient) error {
+	for _, uri := range data.GetRedirectUris() {
+		if !oauth.IsValidRedirectURI(uri) {
+			return adminV1.ErrorBadRequest("invalid redirect uri: %s", uri)
+		}
+	}
+	for _, scope := range data.GetScopes() {
+		if !oauth.IsValidScope(scope) {
+			return adminV1.ErrorBadRequest("invalid scope: %s", scope)
+		}
+	}
+	return nil
+}
+
+// revokeTokens 吊销服务客户端已签发的全部令牌；失败仅告警，令牌最长存活至自然过期。
+func (s *ApiClientService) revokeTokens(ctx context.Context, id uint32) {
+	if err := s.authenticator.RevokeClientToken(ctx, s.clientType, id); err != nil {
+		s.log.Errorf("revoke api client [%d] tokens failed: %s", id, err.Error())
+	}
+}
+
+func (s *ApiClientService) List(ctx context.Context, req *paginationV1.PagingRequest) (*authenticationV1.ListApiClientResponse, error) {
+	return s.repo.List(ctx, req)
+}
+
+func (s *ApiClientService) Get(ctx context.Context, req *authenticationV1.GetApiClientRequest) (*authenticationV1.ApiClient, error) {
+	return s.repo.Get(ctx, req)
+}
+
+func (s *ApiClientService) Create(ctx context.Context, req *authenticationV1.CreateApiClientRequest) (*authenticationV1.ApiClientSecretResponse, error) {
+	if req == nil || req.Data == nil {
+		return nil, adminV1.ErrorBadRequest("invalid request")
+	}
+
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Data.CreatedBy = trans.Ptr(operator.UserId)
+
+	if err = s.checkRoleIds(ctx, operator.UserId, req.Data.GetRoleIds(), nil); err != nil {
+		return nil, err
+	}
+	if err = s.checkOAuthConfig(req.Data); err != nil {
+		return nil, err
+	}
+
+	return s.repo.Create(ctx, req)
+}
+
+func (s *ApiClientService) Update(ctx context.Context, req *authenticationV1.UpdateApiClientRequest) (*emptypb.Empty, error) {
+	if req == nil || req.Data == nil {
+		return nil, adminV1.ErrorBadRequest("invalid request")
+	}
+
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 查询受租户隐私过滤：先确认客户端对当前操作人可见，再更新/吊销令牌
+	if exist, eerr := s.repo.IsExist(ctx, req.GetId()); eerr != nil {
+		return nil, eerr
+	} else if !exist {
+		return nil, adminV1.ErrorNotFound("api client not found")
+	}
+
+	if req.Data.RoleIds != nil {
+		before, gerr := s.repo.Get(ctx, &authenticationV1.GetApiClientRequest{
+			QueryBy: &authenticationV1.GetApiClientRequest_Id{Id: req.GetId()},
+		})
+		if gerr != nil {
+			return nil, gerr
+		}
+		// 保持客户端原有角色不要求角色管理权限
+		if err = s.checkRoleIds(ctx, operator.UserId, req.Data.GetRoleIds(), before.GetRoleIds()); err != nil {
+			return nil, err
+		}
+	}
+	if err = s.checkOAuthConfig(req.Data); err != nil {
+		return nil, err
+	}
+
+	req.Data.Id = trans.Ptr(req.GetId())
+
+	req.Data.UpdatedBy = trans.Ptr(operator.UserId)
+	if req.UpdateMask != nil {
+		req.UpdateMask.Paths = append(req.UpdateMask.Paths, "updated_by")
+	}
+
+	if err = s.repo.Update(ctx, req); err != nil {
+		return nil, err
+	}
+
+	// 禁用或调整授权角色后，已签发令牌里的角色码已过期，统一吊销让客户端重新换取
+	if req.Data.GetStatus() == authenticationV1.ApiClient_OFF || req.Data.RoleIds != nil {
+		s.revokeTokens(ctx, req.GetId())
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *ApiClientService) Delete(ctx context.Context, req *authenticationV1.DeleteApiClientRequest) (*emptypb.Empty, error) {
+	if req == nil {
+		return nil, adminV1.ErrorBadRequest("invalid request")
+	}
+
+	if err := s.repo.Delete(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+
+	s.revokeTokens(ctx, req.GetId())
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *ApiClientService) RotateSecret(ctx context.Context, req *authenticationV1.RotateApiClientSecretRequest) (*authenticationV1.ApiClientSecretResponse, error) {
+	if req == nil {
+		return nil, adminV1.ErrorBadRequest("invalid request")
+	}
+
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.repo.RotateSecret(ctx, req.GetId(), trans.Ptr(operator.UserId))
+	if err != nil {
+		return nil, err
+	}
+
+	s.revokeTokens(ctx, req.GetId())
+
+	return resp, nil
+}
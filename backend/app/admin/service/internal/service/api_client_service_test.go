@@ -0,0 +1,118 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tx7do/go-crud/viewer"
+	"github.com/tx7do/go-utils/trans"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	conf "github.com/tx7do/kratos-bootstrap/api/gen/go/conf/v1"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"go-wind-admin/app/admin/service/internal/data"
+	"go-wind-admin/app/admin/service/internal/data/ent/role"
+	"go-wind-admin/app/admin/service/internal/data/ent/user"
+	"go-wind-admin/app/admin/service/internal/data/ent/userrole"
+	"go-wind-admin/app/admin/service/internal/data/enttest"
+
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+	identityV1 "go-wind-admin/api/gen/go/identity/service/v1"
+
+	appViewer "go-wind-admin/pkg/entgo/viewer"
+	"go-wind-admin/pkg/middleware/auth"
+)
+
+func TestApiClientService_RoleGrant(t *testing.T) {
+	mr, err := miniredis.Run()
+	require.NoError(t, err)
+	t.Cleanup(mr.Close)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+
+	entClient := enttest.NewEntClientForTest(t)
+	bctx := bootstrap.NewContextWithParam(context.Background(), &conf.AppInfo{}, &conf.Bootstrap{
+		Authn: &conf.Authentication{
+			Jwt: &conf.Authentication_Jwt{Method: "HS256", Key: "api-client-test-signing-key"},
+		},
+	}, log.DefaultLogger)
+	sysCtx := enttest.NewSystemViewerCtx(context.Background())
+
+	const (
+		tenantID   = 9601
+		operatorID = 9601
+		staffRole  = 9601
+		adminRole  = 9602
+	)
+	db := entClient.Client()
+	for _, r := range []struct {
+		id   uint32
+		code string
+	}{
+		{staffRole, "staff"}, {adminRole, "tenant-admin"},
+	} {
+		require.NoError(t, db.Role.Create().SetID(r.id).SetTenantID(tenantID).SetName(r.code).SetCode(r.code).
+			SetType(role.TypeTenant).SetStatus(role.StatusOn).Exec(sysCtx))
+		require.NoError(t, db.RoleMetadata.Create().SetTenantID(tenantID).SetRoleID(r.id).Exec(sysCtx))
+	}
+	require.NoError(t, db.User.Create().SetID(operatorID).SetTenantID(tenantID).SetUsername("operator9601").
+		SetStatus(user.StatusNormal).Exec(sysCtx))
+	require.NoError(t, db.UserRole.Create().SetTenantID(tenantID).SetUserID(operatorID).SetRoleID(staffRole).
+		SetIsPrimary(true).SetStatus(userrole.StatusActive).Exec(sysCtx))
+
+	userRoleRepo := data.NewUserRoleRepo(bctx, entClient)
+	membershipRepo := data.NewMembershipRepo(bctx, entClient,
+		data.NewMembershipRoleRepo(bctx, entClient), data.NewMembershipPositionRepo(bctx, entClient), data.NewMembershipOrgUnitRepo(bctx, entClient))
+	userRepo := data.NewUserRepo(bctx, entClient, userRoleRepo, data.NewUserOrgUnitRepo(bctx, entClient), data.NewUserPositionRepo(bctx, entClient), membershipRepo)
+	permissionRepo := data.NewPermissionRepo(bctx, entClient, data.NewPermissionApiRepo(bctx, entClient), data.NewPermissionMenuRepo(bctx, entClient))
+	roleRepo := data.NewRoleRepo(bctx, entClient, data.NewRolePermissionRepo(bctx, entClient), permissionRepo, data.NewRoleMetadataRepo(bctx, entClient))
+	authenticator := data.NewAuthenticator(bctx, data.NewUserTokenCache(bctx, rdb), nil)
+	svc := NewApiClientService(bctx, data.NewApiClientRepo(bctx, entClient, data.NewPasswordCrypto()), roleRepo, userRepo,
+		authenticator, authenticationV1.ClientType_admin)
+
+	operatorCtx := func(permissions ...string) context.Context {
+		ctx := viewer.WithContext(context.Background(),
+			appViewer.NewUserViewer(operatorID, tenantID, 0, "", identityV1.DataScope_ALL, []string{"staff"}, permissions))
+		return auth.NewContext(ctx, &authenticationV1.UserTokenPayload{
+			UserId:   operatorID,
+			TenantId: trans.Ptr(uint32(tenantID)),
+			Roles:    []string{"staff"},
+		})
+	}
+	create := func(ctx context.Context, name string, roleIDs ...uint32) (uint32, error) {
+		resp, err := svc.Create(ctx, &authenticationV1.CreateApiClientRequest{
+			Data: &authenticationV1.ApiClient{Name: trans.Ptr(name), RoleIds: roleIDs},
+		})
+		return resp.GetId(), err
+	}
+
+	// 仅授予操作人自身持有的角色时，客户端管理权限即可
+	clientID, err := create(operatorCtx("api-client:create"), "sync-9601", staffRole)
+	require.NoError(t, err)
+
+	// 授予操作人未持有的角色需要角色管理权限
+	_, err = create(operatorCtx("api-client:create"), "sync-9602", adminRole)
+	assert.Equal(t, 403, int(errors.Code(err)))
+	_, err = create(operatorCtx("api-client:create"), "sync-9603", staffRole, adminRole)
+	assert.Equal(t, 403, int(errors.Code(err)))
+	_, err = create(operatorCtx("api-client:create", "role:edit"), "sync-9604", adminRole)
+	require.NoError(t, err)
+
+	// 更新时追加未持有的角色同样被拒绝
+	update := func(ctx context.Context, roleIDs ...uint32) error {
+		_, err := svc.Update(ctx, &authenticationV1.UpdateApiClientRequest{
+			Id:         clientID,
+			Data:       &authenticationV1.ApiClient{RoleIds: roleIDs},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"role_ids"}},
+		})
+		return err
+	}
+	assert.Equal(t, 403, int(errors.Code(update(operatorCtx("api-client:edit"), staffRole, adminRole))))
+	require.NoError(t, update(operatorCtx("api-client:edit", "role:edit"), staffRole, adminRole))
+}
@@ -0,0 +1,118 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tx7do/go-utils/trans"
+
+	conf "github.com/tx7do/kratos-bootstrap/api/gen/go/conf/v1"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"go-wind-admin/app/admin/service/internal/data"
+	"go-wind-admin/app/admin/service/internal/data/ent/permission"
+	"go-wind-admin/app/admin/service/internal/data/ent/role"
+	"go-wind-admin/app/admin/service/internal/data/ent/rolepermission"
+	"go-wind-admin/app/admin/service/internal/data/ent/user"
+	"go-wind-admin/app/admin/service/internal/data/ent/userrole"
+	"go-wind-admin/app/admin/service/internal/data/enttest"
+
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+	identityV1 "go-wind-admin/api/gen/go/identity/service/v1"
+
+	"go-wind-admin/pkg/constants"
+)
+
+// flakyUserRepo 模拟用户查询暂时失败
+type flakyUserRepo struct {
+	data.UserRepo
+	err error
+}
+
+func (r *flakyUserRepo) Get(ctx context.Context, req *identityV1.GetUserRequest) (*identityV1.User, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	return r.UserRepo.Get(ctx, req)
+}
+
+func TestAuthenticationService_RefreshTokenLookupFailure(t *testing.T) {
+	mr, err := miniredis.Run()
+	require.NoError(t, err)
+	t.Cleanup(mr.Close)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+
+	entClient := enttest.NewEntClientForTest(t)
+	bctx := bootstrap.NewContextWithParam(context.Background(), &conf.AppInfo{}, &conf.Bootstrap{
+		Authn: &conf.Authentication{
+			Jwt: &conf.Authentication_Jwt{Method: "HS256", Key: "refresh-lookup-test-signing-key"},
+		},
+	}, log.DefaultLogger)
+	sysCtx := enttest.NewSystemViewerCtx(context.Background())
+
+	userRoleRepo := data.NewUserRoleRepo(bctx, entClient)
+	membershipRepo := data.NewMembershipRepo(bctx, entClient,
+		data.NewMembershipRoleRepo(bctx, entClient), data.NewMembershipPositionRepo(bctx, entClient), data.NewMembershipOrgUnitRepo(bctx, entClient))
+	userRepo := &flakyUserRepo{
+		UserRepo: data.NewUserRepo(bctx, entClient, userRoleRepo, data.NewUserOrgUnitRepo(bctx, entClient), data.NewUserPositionRepo(bctx, entClient), membershipRepo),
+	}
+	permissionRepo := data.NewPermissionRepo(bctx, entClient, data.NewPermissionApiRepo(bctx, entClient), data.NewPermissionMenuRepo(bctx, entClient))
+	roleRepo := data.NewRoleRepo(bctx, entClient, data.NewRolePermissionRepo(bctx, entClient), permissionRepo, data.NewRoleMetadataRepo(bctx, entClient))
+	authenticator := data.NewAuthenticator(bctx, data.NewUserTokenCache(bctx, rdb), nil)
+	svc := NewAuthenticationService(bctx, userRepo, nil, roleRepo, data.NewTenantRepo(bctx, entClient), membershipRepo, nil, permissionRepo, authenticator,
+		authenticationV1.ClientType_admin, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, data.NewOperationAuditLogRepo(bctx, entClient))
+
+	const userID = 9301
+	db := entClient.Client()
+	require.NoError(t, db.Permission.Create().SetID(9301).SetName("access backend").
+		SetCode(constants.SystemAccessBackendPermissionCode).SetStatus(permission.StatusOn).Exec(sysCtx))
+	require.NoError(t, db.Role.Create().SetID(9301).SetTenantID(0).SetName("admin").SetCode(constants.PlatformAdminRoleCode).
+		SetType(role.TypeSystem).SetIsProtected(false).SetStatus(role.StatusOn).Exec(sysCtx))
+	require.NoError(t, db.RolePermission.Create().SetTenantID(0).SetRoleID(9301).SetPermissionID(9301).
+		SetStatus(rolepermission.StatusOn).Exec(sysCtx))
+	require.NoError(t, db.User.Create().SetID(userID).SetTenantID(0).SetUsername("refresh").
+		SetStatus(user.StatusNormal).Exec(sysCtx))
+	require.NoError(t, db.UserRole.Create().SetTenantID(0).SetUserID(userID).SetRoleID(9301).
+		SetIsPrimary(true).SetStatus(userrole.StatusActive).Exec(sysCtx))
+
+	_, refreshToken, err := authenticator.CreateUserToken(context.Background(), authenticationV1.ClientType_admin,
+		&authenticationV1.UserTokenPayload{UserId: userID, TenantId: trans.Ptr(uint32(0)), Scopes: []string{"openid"}})
+	require.NoError(t, err)
+
+	refresh := func(rt string) (*authenticationV1.LoginResponse, error) {
+		return svc.doGrantTypeRefreshToken(context.Background(), &authenticationV1.LoginRequest{
+			GrantType:    authenticationV1.GrantType_refresh_token,
+			ClientType:   trans.Ptr(authenticationV1.ClientType_admin),
+			RefreshToken: trans.Ptr(rt),
+		})
+	}
+
+	// 加载用户失败时返回错误，刷新令牌不被消费
+	userRepo.err = identityV1.ErrorServiceUnavailable("database unavailable")
+	_, err = refresh(refreshToken)
+	assert.Equal(t, 503, int(errors.Code(err)))
+
+	// 恢复后可用同一刷新令牌重试，并沿用原会话的授权范围
+	userRepo.err = nil
+	resp, err := refresh(refreshToken)
+	require.NoError(t, err)
+	assert.NotEmpty(t, resp.GetRefreshToken())
+
+	validated, err := authenticator.Authenticate(context.Background(), &authenticationV1.ValidateTokenRequest{
+		Token:         resp.GetAccessToken(),
+		ClientType:    authenticationV1.ClientType_admin,
+		TokenCategory: authenticationV1.TokenCategory_ACCESS,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"openid"}, validated.GetPayload().GetScopes())
+
+	// 轮换后旧令牌失效
+	_, err = refresh(refreshToken)
+	assert.Error(t, err)
+}
@@ -3,10 +3,14 @@
 import (
 	"context"
 	"encoding/base64"
+	"errors"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
+	ktransport "github.com/go-kratos/kratos/v2/transport"
+	khttp "github.com/go-kratos/kratos/v2/transport/http"
 	"github.com/tx7do/go-crud/viewer"
 	"github.com/tx7do/go-utils/captcha"
 	"github.com/tx7do/go-utils/crypto"
@@ -22,8 +26,12 @@
 	identityV1 "go-wind-admin/api/gen/go/identity/service/v1"
 
 	"go-wind-admin/pkg/constants"
+	"go-wind-admin/pkg/ldap"
 	"go-wind-admin/pkg/middleware/auth"
+	applogging "go-wind-admin/pkg/middleware/logging"
 	"go-wind-admin/pkg/netutil"
+	"go-wind-admin/pkg/oauth"
+	"go-wind-admin/pkg/sender"
 )
 
 // 验证码相关请求头（H5：登录强制验证码，通过 header 传递以避免改动 proto 与三套前端生成代码）。
@@ -73,7 +81,20 @@ type AuthenticationService struct {
 	loginPolicyRepo *data.LoginPolicyRepo
 
 	mfaFactorRepo     *data.UserMfaFactorRepo
+	mfaPolicyRepo     *data.MfaPolicyRepo
 	mfaChallengeCache *data.MfaChallengeCache
+
+	apiClientRepo  *data.ApiClientRepo
+	oauthCodeCache *data.OAuthCodeCache
+
+	samlConfigRepo *data.SamlConfigRepo
+
+	ldapConfigRepo  *data.LdapConfigRepo
+	ldapAccountRepo *data.LdapAccountRepo
+
+	tokenNotifier *credentialTokenNotifier
+
+	auditor *operationAuditor
 }
 
 func NewAuthenticationService(
@@ -91,10 +112,19 @@ func NewAuthenticationService(
 	rateLimiter *data.LoginRateLimiter,
 	loginPolicyRepo *data.LoginPolicyRepo,
 	mfaFactorRepo *data.UserMfaFactorRepo,
+	mfaPolicyRepo *data.MfaPolicyRepo,
 	mfaChallengeCache *data.MfaChallengeCache,
+	apiClientRepo *data.ApiClientRepo,
+	oauthCodeCache *data.OAuthCodeCache,
+	samlConfigRepo *data.SamlConfigRepo,
+	ldapConfigRepo *data.LdapConfigRepo,
+	ldapAccountRepo *data.LdapAccountRepo,
+	sender *sender.Router,
+	operationAuditLogRepo *data.OperationAuditLogRepo,
 ) *AuthenticationService {
+	l := ctx.NewLoggerHelper("authn/service/admin-service")
 	return &AuthenticationService{
-		log:                ctx.NewLoggerHelper("authn/service/admin-service"),
+		log:                l,
 		userRepo:           userRepo,
 		userCredentialRepo: userCredentialRepo,
 		tenantRepo:         tenantRepo,
@@ -108,21 +138,52 @@ func NewAuthenticationService(
 		rateLimiter:        rateLimiter,
 		loginPolicyRepo:    loginPolicyRepo,
 		mfaFactorRepo:      mfaFactorRepo,
+		mfaPolicyRepo:      mfaPolicyRepo,
 		mfaChallengeCache:  mfaChallengeCache,
+		apiClientRepo:      apiClientRepo,
+		oauthCodeCache:     oauthCodeCache,
+		samlConfigRepo:     samlConfigRepo,
+		ldapConfigRepo:     ldapConfigRepo,
+		ldapAccountRepo:    ldapAccountRepo,
+		tokenNotifier:      newCredentialTokenNotifier(sender),
+		auditor:            newOperationAuditor(l, operationAuditLogRepo),
 	}
 }
 
 // checkLoginPolicies 拉取租户登录策略并按当前上下文匹配。
-// userId 传 0 时只匹配全局条目（target_id 为空）；密码校验前与取到 user 后各调用一次。
+// subject.UserID 为 0 时只匹配全局条目；密码校验前与取到 user（含角色、组织单元）后各调用一次。
 // 匹配逻辑见 data.MatchLoginPolicy（纯函数，含单测）。
 // 策略查询失败时 fail-open（仅告警）——登录可用性优先于策略拦截，与验证码开关的容错取向一致。
-func (s *AuthenticationService) checkLoginPolicies(ctx context.Context, tenantID, userId uint32, clientIP, deviceId string) (bool, string) {
-	policies, err := s.loginPolicyRepo.ListForLogin(ctx, tenantID)
+func (s *AuthenticationService) checkLoginPolicies(ctx context.Context, tenantID uint32, subject data.LoginPolicySubject) (bool, string) {
+	policies, err := s.prepareLoginPolicies(ctx, tenantID, &subject)
 	if err != nil {
 		s.log.Errorf("list login policies failed for tenant [%d]: %s", tenantID, err.Error())
 		return false, ""
 	}
-	return data.MatchLoginPolicy(policies, userId, clientIP, deviceId, time.Now())
+	return data.MatchLoginPolicy(policies, subject)
+}
+
+// prepareLoginPolicies 拉取租户登录策略，并按策略需要补全匹配对象：
+// 存在地区策略时查询 IP 地理位置，存在组织单元定向策略时把用户的组织单元展开为含全部上级。
+func (s *AuthenticationService) prepareLoginPolicies(ctx context.Context, tenantID uint32, subject *data.LoginPolicySubject) ([]data.EffectivePolicy, error) {
+	policies, err := s.loginPolicyRepo.ListForLogin(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	if subject.Now.IsZero() {
+		subject.Now = time.Now()
+	}
+	if subject.Region == nil && data.HasLoginPolicyMethod(policies, "REGION") {
+		subject.Region = applogging.LookupGeoLocation(subject.ClientIP)
+	}
+	if len(subject.OrgUnitIDs) > 0 && data.HasLoginPolicyTargetType(policies, "ORG_UNIT") {
+		if subject.OrgUnitIDs, err = s.loginPolicyRepo.ExpandOrgUnitAncestors(ctx, subject.OrgUnitIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	return policies, nil
 }
 
 func (s *AuthenticationService) resetContextForLogin(ctx context.Context) context.Context {
@@ -146,6 +207,9 @@ func (s *AuthenticationService) Login(ctx context.Context, req *authenticationV1
 	case authenticationV1.GrantType_client_credentials:
 		return s.doGrantTypeClientCredentials(ctx, req)
 
+	case authenticationV1.GrantType_authorization_code:
+		return s.doGrantTypeAuthorizationCode(ctx, req)
+
 	default:
 		return nil, authenticationV1.ErrorInvalidGrantType("invalid grant type")
 	}
@@ -242,6 +306,11 @@ func (s *AuthenticationService) authorizeAndEnrichUserTokenPayloadUserTenantRela
 	hasBackendAccess := false
 	var validRoleIDs []uint32
 	for _, m := range memberships {
+		// 跳过已停用的成员身份（状态为空的历史数据视为启用）
+		if m.Status != nil && m.GetStatus() != identityV1.Membership_ACTIVE {
+			continue
+		}
+
 		if m.GetTenantId() > 0 {
 			// 检查租户状态
 			tenant, _ := s.tenantRepo.Get(ctx, &identityV1.GetTenantRequest{
@@ -298,6 +367,19 @@ func (s *AuthenticationService) authorizeAndEnrichUserTokenPayloadUserTenantRela
 
 // authorizeAndEnrichUserTokenPayload 授权并丰富用户令牌载荷
 func (s *AuthenticationService) authorizeAndEnrichUserTokenPayload(ctx context.Context, userID, tenantID uint32, tokenPayload *authenticationV1.UserTokenPayload) error {
+	// 成员身份被停用（如身份提供方经 SCIM 停用账号）时拒绝签发令牌，刷新令牌同样经过此处。
+	// 查询失败时拒绝（fail-closed）
+	if tenantID > 0 {
+		suspended, err := s.membershipRepo.IsMembershipSuspended(ctx, userID, tenantID)
+		if err != nil {
+			return authenticationV1.ErrorForbidden("insufficient authority")
+		}
+		if suspended {
+			s.log.Warnf("user [%d] membership of tenant [%d] is not active", userID, tenantID)
+			return authenticationV1.ErrorForbidden("membership is disabled")
+		}
+	}
+
 	switch constants.DefaultUserTenantRelationType {
 	case constants.UserTenantRelationOneToOne:
 		return s.authorizeAndEnrichUserTokenPayloadUserTenantRelationOneToOne(ctx, userID, tenantID, tokenPayload)
@@ -311,6 +393,39 @@ func (s *AuthenticationService) authorizeAndEnrichUserTokenPayload(ctx context.C
 	}
 }
 
+// resolveLoginTenant 按登录请求的 tenant_code 解析租户：留空视为平台（tenant 0）。
+func (s *AuthenticationService) resolveLoginTenant(ctx context.Context, code string) (uint32, error) {
+	if strings.TrimSpace(code) == "" {
+		return 0, nil
+	}
+
+	tenant, _ := s.tenantRepo.Get(ctx, &identityV1.GetTenantRequest{
+		QueryBy: &identityV1.GetTenantRequest_Code{Code: code},
+	})
+	// 查不到、或租户非启用状态，统一返回同一文案，防止通过返回差异枚举有效租户编号
+	if tenant == nil || tenant.GetStatus() != identityV1.Tenant_ON {
+		return 0, authenticationV1.ErrorBadRequest("invalid tenant")
+	}
+	return tenant.GetId(), nil
+}
+
+// checkLocalPasswordAllowed 租户启用 SAML 且要求仅单点登录时，拒绝本地密码登录与自助注册。
+// 查询失败时拒绝（fail-closed）：放行会绕过租户的身份管控要求。
+func (s *AuthenticationService) checkLocalPasswordAllowed(ctx context.Context, tenantID uint32) error {
+	if s.samlConfigRepo == nil || tenantID == 0 {
+		return nil
+	}
+
+	ssoOnly, err := s.samlConfigRepo.IsSsoOnly(ctx, tenantID)
+	if err != nil {
+		return err
+	}
+	if ssoOnly {
+		return authenticationV1.ErrorForbidden("password login disabled, use single sign-on")
+	}
+	return nil
+}
+
 // resolveUserAuthority 解析用户权限信息
 func (s *AuthenticationService) resolveUserAuthority(ctx context.Context, user *identityV1.User, tokenPayload *authenticationV1.UserTokenPayload) error {
 	if user.GetStatus() != identityV1.User_NORMAL {
@@ -350,55 +465,58 @@ func (s *AuthenticationService) doGrantTypePassword(ctx context.Context, req *au
 
 	// ===== 租户解析：tenant_code 留空视为平台（tenant 0），非空则按编号定位租户 =====
 	// 解析后的 tenantID 限定后续凭证查询范围，消除同名 identifier 跨租户歧义。
-	var tenantID uint32 = 0
-	if code := req.GetTenantCode(); strings.TrimSpace(code) != "" {
-		tenant, _ := s.tenantRepo.Get(ctx, &identityV1.GetTenantRequest{
-			QueryBy: &identityV1.GetTenantRequest_Code{Code: code},
-		})
-		// 查不到、或租户非启用状态，统一返回同一文案，防止通过返回差异枚举有效租户编号
-		if tenant == nil || tenant.GetStatus() != identityV1.Tenant_ON {
-			return nil, authenticationV1.ErrorBadRequest("invalid tenant")
-		}
-		tenantID = tenant.GetId()
+	tenantID, err := s.resolveLoginTenant(ctx, req.GetTenantCode())
+	if err != nil {
+		return nil, err
+	}
+
+	// ===== 仅单点登录：租户要求经企业 IdP 登录时不接受本地密码 =====
+	if err = s.checkLocalPasswordAllowed(ctx, tenantID); err != nil {
+		return nil, err
 	}
 
 	// ===== 登录策略闸门（全局部分）：target_id 为空的策略不依赖用户身份， =====
 	// ===== 在 identifier 反查与密码校验之前拦截，被封锁的 IP 连 user 表查询都省掉。
 	// ===== 用户定向策略（target_id = userId）在取到 user 后二次检查。
 	if s.loginPolicyRepo != nil {
-		if blocked, reason := s.checkLoginPolicies(ctx, tenantID, 0, clientIP, req.GetDeviceId()); blocked {
+		if blocked, reason := s.checkLoginPolicies(ctx, tenantID, data.LoginPolicySubject{ClientIP: clientIP, DeviceID: req.GetDeviceId()}); blocked {
 			s.log.Warnf("login blocked by policy: ip=%s username=%s reason=%s", clientIP, username, reason)
 			return nil, authenticationV1.ErrorForbidden("login blocked by security policy")
 		}
 	}
 
-	// ===== identifier 智能解析：输入含 @ 视为 email、纯数字视为 mobile， =====
-	// ===== 经 user 表反查得到真实 username 后仍走 USERNAME 维度凭证校验。
-	// 未命中时原样返回，交由凭证校验走统一失败路径（防枚举）；mobile 多行歧义直接拒绝。
-	if resolved, _, rerr := s.userRepo.FindUsernameByIdentifier(ctx, tenantID, username); rerr != nil {
-		return nil, rerr
-	} else if resolved != username {
-		username = resolved
-	}
-
-	// ===== 凭证校验：在解析出的 tenant 范围内查单条凭证并校验密码 =====
-	// 注意用解析后的 username 局部变量（可能是 email/mobile 反查所得），而非 req 原始输入
+	// ===== 目录认证：租户启用了 LDAP 时先经目录校验，目录未接管时走本地凭证 =====
 	var matchedUserID uint32
-	var err error
-	matchedUserID, err = s.userCredentialRepo.FindUserCredential(ctx, tenantID, authenticationV1.UserCredential_USERNAME, username, req.GetPassword(), true)
-	if err != nil {
-		// 服务端日志保留真实原因（USER_NOT_FOUND / USER_FREEZE / INVALID_PASSWORD），便于运维排查
-		s.log.Errorf("verify user credential failed for username [%s]: %s", username, err.Error())
+	var viaLdap bool
+	if matchedUserID, viaLdap, err = s.authenticateWithLdap(ctx, tenantID, username, req.GetPassword()); viaLdap && err != nil {
+		s.log.Errorf("ldap authentication failed for username [%s]: %s", username, err.Error())
+		s.incrLoginFailure(ctx, clientIP, username)
+		return nil, normalizeLoginVerifyError(err)
+	}
 
-		// H5：登录失败时自增失败计数（按 IP + 用户名双维度）
-		if s.rateLimiter != nil {
-			if _, _, _, cerr := s.rateLimiter.CheckAndIncr(ctx, clientIP, username); cerr != nil {
-				s.log.Errorf("login rate limiter incr failed: %s", cerr.Error())
-			}
+	if !viaLdap {
+		// ===== identifier 智能解析：输入含 @ 视为 email、纯数字视为 mobile， =====
+		// ===== 经 user 表反查得到真实 username 后仍走 USERNAME 维度凭证校验。
+		// 未命中时原样返回，交由凭证校验走统一失败路径（防枚举）；mobile 多行歧义直接拒绝。
+		if resolved, _, rerr := s.userRepo.FindUsernameByIdentifier(ctx, tenantID, username); rerr != nil {
+			return nil, rerr
+		} else if resolved != username {
+			username = resolved
 		}
 
-		// 对客户端统一返回同一文案，避免通过 HTTP 状态码/reason 枚举有效用户名（H11）
-		return nil, normalizeLoginVerifyError(err)
+		// ===== 凭证校验：在解析出的 tenant 范围内查单条凭证并校验密码 =====
+		// 注意用解析后的 username 局部变量（可能是 email/mobile 反查所得），而非 req 原始输入
+		matchedUserID, err = s.userCredentialRepo.FindUserCredential(ctx, tenantID, authenticationV1.UserCredential_USERNAME, username, req.GetPassword(), true)
+		if err != nil {
+			// 服务端日志保留真实原因（USER_NOT_FOUND / USER_FREEZE / INVALID_PASSWORD），便于运维排查
+			s.log.Errorf("verify user credential failed for username [%s]: %s", username, err.Error())
+
+			// H5：登录失败时自增失败计数（按 IP + 用户名双维度）
+			s.incrLoginFailure(ctx, clientIP, username)
+
+			// 对客户端统一返回同一文案，避免通过 HTTP 状态码/reason 枚举有效用户名（H11）
+			return nil, normalizeLoginVerifyError(err)
+		}
 	}
 
 	// 获取用户信息（按凭证归属的 user_id 精确查找，避免同 identifier 多租户歧义）
@@ -416,72 +534,210 @@ func (s *AuthenticationService) doGrantTypePassword(ctx context.Context, req *au
 		return nil, authenticationV1.ErrorBadRequest("invalid tenant")
 	}
 
-	// ===== 登录策略闸门（用户定向部分）：密码已通过、userId 已知， =====
-	// ===== 检查 target_id 约束到该用户的策略条目。
-	if s.loginPolicyRepo != nil {
-		if blocked, reason := s.checkLoginPolicies(ctx, tenantID, user.GetId(), clientIP, req.GetDeviceId()); blocked {
-			s.log.Warnf("login blocked by user-targeted policy: uid=%d ip=%s reason=%s", user.GetId(), clientIP, reason)
-			return nil, authenticationV1.ErrorForbidden("login blocked by security policy")
+	resp, err := s.completeUserLogin(ctx, user, req.GetClientType(), req.ClientId, req.DeviceId, clientIP)
+	if err != nil {
+		return nil, err
+	}
+
+	// ===== 密码有效期：超期照常登录，响应带 password_change_required 由前端引导立即改密 =====
+	// 需二次验证时标识随首个（仅含 mfa_operation_id 的）响应返回。目录账号的密码由目录管理，不做判定。
+	if !viaLdap {
+		if expired, perr := s.userCredentialRepo.PasswordExpired(ctx, tenantID, username); perr != nil {
+			s.log.Errorf("check password expiry failed for user [%d]: %s", user.GetId(), perr.Error())
+		} else if expired {
+			resp.PasswordChangeRequired = trans.Ptr(true)
+		}
+	}
+
+	// H5：登录成功后清零失败计数；需二次验证时认证尚未完成，清零在 VerifyMFAChallenge 通过后
+	if resp.GetAccessToken() != "" && s.rateLimiter != nil {
+		s.rateLimiter.Reset(ctx, clientIP, username)
+	}
+
+	return resp, nil
+}
+
+// incrLoginFailure 登录失败时自增失败计数（按 IP + 用户名双维度）。
+func (s *AuthenticationService) incrLoginFailure(ctx context.Context, clientIP, username string) {
+	if s.rateLimiter == nil {
+		return
+	}
+	if _, _, _, err := s.rateLimiter.CheckAndIncr(ctx, clientIP, username); err != nil {
+		s.log.Errorf("login rate limiter incr failed: %s", err.Error())
+	}
+}
+
+// authenticateWithLdap 租户启用了 LDAP 时经目录校验密码并解析本地用户，handled 表示目录是否接管了本次登录。
+// LDAP_FIRST：目录中不存在该账号或目录不可用时不接管，回落本地密码；目录密码错误直接失败，不再尝试本地密码。
+// LDAP_ONLY：目录的任何失败都直接拒绝登录。
+func (s *AuthenticationService) authenticateWithLdap(ctx context.Context, tenantID uint32, username, encryptedPassword string) (userID uint32, handled bool, err error) {
+	if s.ldapConfigRepo == nil || s.ldapAccountRepo == nil {
+		return 0, false, nil
+	}
+
+	settings, err := s.ldapConfigRepo.GetEnabled(ctx, tenantID)
+	if err != nil {
+		return 0, true, err
+	}
+	if settings == nil {
+		return 0, false, nil
+	}
+
+	password, err := s.userCredentialRepo.DecryptLoginCredential(encryptedPassword)
+	if err != nil {
+		return 0, true, err
+	}
+
+	entry, err := settings.Client().Authenticate(ctx, username, password)
+	switch {
+	case err == nil:
+	case errors.Is(err, ldap.ErrInvalidCredentials):
+		return 0, true, authenticationV1.ErrorInvalidPassword("incorrect password")
+	case errors.Is(err, ldap.ErrUserNotFound):
+		if settings.LdapOnly {
+			return 0, true, authenticationV1.ErrorUserNotFound("user not found in directory")
+		}
+		return 0, false, nil
+	case errors.Is(err, ldap.ErrAmbiguousUser), errors.Is(err, ldap.ErrMissingID):
+		return 0, true, authenticationV1.ErrorUserNotFound("%s", err.Error())
+	default:
+		s.log.Errorf("ldap directory of tenant [%d] unavailable: %s", tenantID, err.Error())
+		if settings.LdapOnly {
+			return 0, true, authenticationV1.ErrorServiceUnavailable("directory unavailable")
 		}
+		return 0, false, nil
 	}
 
+	if userID, err = s.ldapAccountRepo.Resolve(ctx, settings, entry, username); err != nil {
+		return 0, true, err
+	}
+	if userID == 0 {
+		return 0, true, authenticationV1.ErrorUserNotFound("ldap account not linked")
+	}
+	return userID, true, nil
+}
+
+// completeUserLogin 已确认用户身份（密码校验通过、第三方账号已绑定等）后的公共收尾：
+// 用户定向登录策略、权限解析、MFA 闸门与令牌签发。需要二次验证时返回仅含 MfaOperationId 的响应。
+func (s *AuthenticationService) completeUserLogin(
+	ctx context.Context,
+	user *identityV1.User,
+	clientType authenticationV1.ClientType,
+	clientID, deviceID *string,
+	clientIP string,
+) (*authenticationV1.LoginResponse, error) {
+	return s.issueUserLogin(ctx, user, clientType, clientID, deviceID, clientIP, false)
+}
+
+// completeMultiFactorLogin 身份已由多因素凭证确认（如带用户验证的通行密钥）时的收尾：
+// 与 completeUserLogin 相同，但跳过 MFA 闸门。
+func (s *AuthenticationService) completeMultiFactorLogin(
+	ctx context.Context,
+	user *identityV1.User,
+	clientType authenticationV1.ClientType,
+	deviceID *string,
+	clientIP string,
+) (*authenticationV1.LoginResponse, error) {
+	return s.issueUserLogin(ctx, user, clientType, nil, deviceID, clientIP, true)
+}
+
+func (s *AuthenticationService) issueUserLogin(
+	ctx context.Context,
+	user *identityV1.User,
+	clientType authenticationV1.ClientType,
+	clientID, deviceID *string,
+	clientIP string,
+	mfaSatisfied bool,
+) (*authenticationV1.LoginResponse, error) {
 	tokenPayload := &authenticationV1.UserTokenPayload{
 		UserId:   user.GetId(),
 		TenantId: user.TenantId,
 		Username: user.Username,
-		ClientId: req.ClientId,
-		DeviceId: req.DeviceId,
+		ClientId: clientID,
+		DeviceId: deviceID,
 	}
 
 	// 解析用户权限信息
-	err = s.resolveUserAuthority(ctx, user, tokenPayload)
+	err := s.resolveUserAuthority(ctx, user, tokenPayload)
 	if err != nil {
 		s.log.Errorf("resolve user [%d] authority failed [%s]", user.GetId(), err.Error())
 		return nil, err
 	}
 
-	// ===== MFA 闸门：若该用户绑定了 ENABLED 的 TOTP 因子，则不签发 token， =====
+	// ===== 登录策略闸门（定向部分）：身份已确认、角色已解析， =====
+	// ===== 检查约束到该用户、其角色或其所属组织单元（含上级）的策略条目。
+	if s.loginPolicyRepo != nil {
+		if blocked, reason := s.checkLoginPolicies(ctx, user.GetTenantId(), data.LoginPolicySubject{
+			UserID:     user.GetId(),
+			RoleCodes:  tokenPayload.GetRoles(),
+			OrgUnitIDs: user.GetOrgUnitIds(),
+			ClientIP:   clientIP,
+			DeviceID:   tokenPayload.GetDeviceId(),
+		}); blocked {
+			s.log.Warnf("login blocked by targeted policy: uid=%d ip=%s reason=%s", user.GetId(), clientIP, reason)
+			return nil, authenticationV1.ErrorForbidden("login blocked by security policy")
+		}
+	}
+
+	// ===== MFA 闸门：若该用户绑定了 ENABLED 的主因子（TOTP/WebAuthn/短信/邮件），则不签发 token， =====
 	// ===== 改为签发 operation_id，要求前端走二次验证（MfaService.VerifyMFAChallenge）。
-	// 注意：此处不清零限流计数——认证尚未完成；真正清零在 VerifyMFAChallenge 通过后。
-	if s.mfaFactorRepo != nil {
-		needMfa, merr := s.mfaFactorRepo.HasEnabledTotp(ctx, user.GetTenantId(), user.GetId())
+	// ===== 未绑定因子但 MFA 策略（平台/租户/角色）要求启用时，签发强制注册挑战，注册完成后同样走二次验证。
+	if s.mfaFactorRepo != nil && !mfaSatisfied {
+		needMfa, merr := s.mfaFactorRepo.HasEnabledFactor(ctx, user.GetTenantId(), user.GetId())
 		if merr != nil {
 			// fail-closed：MFA 状态查询失败时拒绝登录，避免已绑定用户在 DB 故障时
 			// 被降级为单因子放行（与上方凭证校验出错即拒登的行为一致）。
 			s.log.Errorf("check mfa factor failed for user [%d]: %s", user.GetId(), merr.Error())
 			return nil, authenticationV1.ErrorInternalServerError("mfa check failed")
 		}
-		if needMfa && s.mfaChallengeCache != nil {
-			opId, cerr := s.mfaChallengeCache.SetLoginChallenge(ctx, tokenPayload, req.GetClientType())
+		needEnroll := false
+		if !needMfa && s.mfaPolicyRepo != nil {
+			enforcement, perr := s.mfaPolicyRepo.Resolve(ctx, user.GetTenantId(), tokenPayload.GetRoles())
+			if perr != nil {
+				// 同为 fail-closed：策略不可读时不能把强制 MFA 的账号降级为单因子登录
+				s.log.Errorf("resolve mfa policy failed for user [%d]: %s", user.GetId(), perr.Error())
+				return nil, authenticationV1.ErrorInternalServerError("mfa check failed")
+			}
+			needEnroll = enforcement == authenticationV1.MFAEnforcement_MFA_REQUIRED
+		}
+		if (needMfa || needEnroll) && s.mfaChallengeCache != nil {
+			var (
+				opId string
+				cerr error
+			)
+			if needEnroll {
+				opId, cerr = s.mfaChallengeCache.SetEnrollmentLoginChallenge(ctx, tokenPayload, clientType)
+			} else {
+				opId, cerr = s.mfaChallengeCache.SetLoginChallenge(ctx, tokenPayload, clientType)
+			}
 			if cerr != nil {
 				s.log.Errorf("set mfa login challenge failed for user [%d]: %s", user.GetId(), cerr.Error())
 				return nil, authenticationV1.ErrorInternalServerError("mfa challenge failed")
 			}
-			return &authenticationV1.LoginResponse{
+			resp := &authenticationV1.LoginResponse{
 				TokenType:      authenticationV1.TokenType_bearer,
 				AccessToken:    "",
 				MfaOperationId: trans.Ptr(opId),
-			}, nil
+			}
+			if needEnroll {
+				resp.MfaEnrollmentRequired = trans.Ptr(true)
+			}
+			return resp, nil
 		}
 	}
 
 	// 生成令牌
-	accessToken, refreshToken, err := s.authenticator.CreateUserToken(ctx, req.GetClientType(), tokenPayload)
+	accessToken, refreshToken, err := s.authenticator.CreateUserToken(ctx, clientType, tokenPayload)
 	if err != nil {
 		return nil, err
 	}
 
-	// H5：登录成功后清零失败计数
-	if s.rateLimiter != nil {
-		s.rateLimiter.Reset(ctx, clientIP, username)
-	}
-
 	return &authenticationV1.LoginResponse{
 		TokenType:        authenticationV1.TokenType_bearer,
 		AccessToken:      accessToken,
 		RefreshToken:     trans.Ptr(refreshToken),
-		ExpiresIn:        int64(s.authenticator.GetAccessTokenExpires(req.GetClientType()).Seconds()),
-		RefreshExpiresIn: trans.Ptr(int64(s.authenticator.GetRefreshTokenExpires(req.GetClientType()).Seconds())),
+		ExpiresIn:        int64(s.authenticator.GetAccessTokenExpires(clientType).Seconds()),
+		RefreshExpiresIn: trans.Ptr(int64(s.authenticator.GetRefreshTokenExpires(clientType).Seconds())),
 	}, nil
 }
 
@@ -543,9 +799,16 @@ func (s *AuthenticationService) verifyLoginCaptcha(ctx context.Context) bool {
 }
 
 // doGrantTypeRefreshToken 处理授权类型 - 刷新令牌
+// 刷新令牌自身即可定位所属用户与会话，无需携带（可能已过期的）访问令牌。
+// 每次刷新都轮换出新的刷新令牌，旧令牌立即失效；旧令牌被重放视为泄露，整个会话随之吊销并记高风险登录审计。
+//
+// 先按刷新令牌定位会话并加载用户与权限，全部成功后才消费令牌：
+// 加载失败（如数据库暂时不可用）时返回错误而令牌保持有效，客户端可用同一刷新令牌重试，不会因此被登出。
 func (s *AuthenticationService) doGrantTypeRefreshToken(ctx context.Context, req *authenticationV1.LoginRequest) (*authenticationV1.LoginResponse, error) {
-	// 获取操作人信息
-	operator, err := auth.FromContext(ctx)
+	ctx = s.resetContextForLogin(ctx)
+
+	// 定位刷新令牌所属会话，不消费令牌
+	peeked, err := s.authenticator.PeekRefreshToken(ctx, req.GetClientType(), req.GetRefreshToken())
 	if err != nil {
 		return nil, err
 	}
@@ -553,10 +816,11 @@ func (s *AuthenticationService) doGrantTypeRefreshToken(ctx context.Context, req
 	// 获取用户信息
 	user, err := s.userRepo.Get(ctx, &identityV1.GetUserRequest{
 		QueryBy: &identityV1.GetUserRequest_Id{
-			Id: operator.UserId,
+			Id: peeked.UserID,
 		},
 	})
 	if err != nil {
+		s.log.Errorf("load user [%d] for token refresh failed [%s]", peeked.UserID, err.Error())
 		return nil, err
 	}
 
@@ -575,10 +839,24 @@ func (s *AuthenticationService) doGrantTypeRefreshToken(ctx context.Context, req
 		return nil, err
 	}
 
-	// 验证刷新令牌
-	if err = s.authenticator.VerifyRefreshToken(ctx, req.GetClientType(), req.GetUserId(), operator.GetJti(), req.GetRefreshToken()); err != nil {
-		s.log.Errorf("verify refresh token failed for user [%d]: [%s]", req.GetUserId(), err)
-		return nil, authenticationV1.ErrorIncorrectRefreshToken("invalid refresh token")
+	// 消费刷新令牌，旧令牌对随之失效
+	family, err := s.authenticator.ConsumeRefreshToken(ctx, req.GetClientType(), req.GetRefreshToken())
+	if err != nil {
+		if errors.Is(err, data.ErrRefreshTokenReused) {
+			s.log.Warnf("refresh token reuse detected for user [%d], session [%s] revoked", family.UserID, family.SessionID)
+			setAuditRisk(ctx, applogging.RiskFactorRefreshTokenReuse, family.UserID)
+			return nil, authenticationV1.ErrorIncorrectRefreshToken("refresh token reuse detected, please sign in again")
+		}
+		return nil, err
+	}
+
+	// 授权码令牌刷新后沿用原授权范围，不能因刷新升级为不受限的第一方令牌
+	tokenPayload.Scopes = family.Scopes
+	// 刷新在原会话内轮换令牌，不新建会话
+	tokenPayload.SessionId = trans.Ptr(family.SessionID)
+	// 刷新不是身份验证，沿用会话最近一次身份验证的时间
+	if family.AuthTime != 0 {
+		tokenPayload.AuthTime = trans.Ptr(family.AuthTime)
 	}
 
 	// 生成令牌
@@ -596,9 +874,224 @@ func (s *AuthenticationService) doGrantTypeRefreshToken(ctx context.Context, req
 	}, nil
 }
 
+// setAuditRisk 通过响应头把高风险事件与所属用户交给登录审计中间件（中间件读取后移除，不回传客户端）
+func setAuditRisk(ctx context.Context, riskFactor string, userId uint32) {
+	if tr, ok := ktransport.FromServerContext(ctx); ok {
+		if htr, hok := tr.(*khttp.Transport); hok {
+			htr.ReplyHeader().Set(applogging.HeaderKeyXAuditRisk, riskFactor)
+			htr.ReplyHeader().Set(applogging.HeaderKeyXAuditUserID, strconv.FormatUint(uint64(userId), 10))
+		}
+	}
+}
+
 // doGrantTypeClientCredentials 处理授权类型 - 客户端凭据
-func (s *AuthenticationService) doGrantTypeClientCredentials(_ context.Context, _ *authenticationV1.LoginRequest) (*authenticationV1.LoginResponse, error) {
-	return nil, authenticationV1.ErrorInvalidGrantType("invalid grant type")
+// 机器对机器调用：以 client_id/client_secret 换取仅含访问令牌的 token（RFC 6749 §4.4.3 不签发刷新令牌）。
+// 令牌 user_id 为 0、api_client_id 为客户端 ID，角色取自客户端授权的 role_ids，鉴权链路与用户令牌一致。
+func (s *AuthenticationService) doGrantTypeClientCredentials(ctx context.Context, req *authenticationV1.LoginRequest) (*authenticationV1.LoginResponse, error) {
+	if s.apiClientRepo == nil {
+		return nil, authenticationV1.ErrorInvalidGrantType("invalid grant type")
+	}
+
+	ctx = s.resetContextForLogin(ctx)
+
+	clientIP := netutil.ClientIPFromContext(ctx)
+	clientID := strings.TrimSpace(req.GetClientId())
+	if clientID == "" || req.GetClientSecret() == "" {
+		return nil, authenticationV1.ErrorBadRequest("client_id and client_secret are required")
+	}
+
+	// 限流预检：与密码登录共用计数器，以 client_id 作为"用户名"维度
+	if s.rateLimiter != nil {
+		if locked, lerr := s.rateLimiter.IsLocked(ctx, clientIP, clientID); lerr != nil {
+			s.log.Errorf("login rate limiter pre-check failed: %s", lerr.Error())
+		} else if locked {
+			s.log.Warnf("client credentials blocked by rate limiter: ip=%s, client_id=%s", clientIP, clientID)
+			return nil, authenticationV1.ErrorBadRequest("too many login failures, please try again later")
+		}
+	}
+
+	client, err := s.apiClientRepo.VerifyClientSecret(ctx, clientID, req.GetClientSecret())
+	if err != nil {
+		s.log.Errorf("verify api client [%s] failed: %s", clientID, err.Error())
+
+		if s.rateLimiter != nil {
+			if _, _, _, cerr := s.rateLimiter.CheckAndIncr(ctx, clientIP, clientID); cerr != nil {
+				s.log.Errorf("login rate limiter incr failed: %s", cerr.Error())
+			}
+		}
+
+		// 统一对外文案，避免枚举有效 client_id
+		if authenticationV1.IsUserNotFound(err) ||
+			authenticationV1.IsUserFreeze(err) ||
+			authenticationV1.IsInvalidPassword(err) {
+			return nil, authenticationV1.ErrorInvalidPassword("invalid client_id or client_secret")
+		}
+		return nil, err
+	}
+
+	// 租户客户端：所属租户必须处于启用状态
+	if client.TenantID != 0 {
+		tenant, _ := s.tenantRepo.Get(ctx, &identityV1.GetTenantRequest{
+			QueryBy: &identityV1.GetTenantRequest_Id{Id: client.TenantID},
+		})
+		if tenant == nil || tenant.GetStatus() != identityV1.Tenant_ON {
+			return nil, authenticationV1.ErrorBadRequest("invalid tenant")
+		}
+	}
+
+	// 登录策略闸门：服务客户端无用户身份，只匹配全局条目（IP 白/黑名单等）
+	if s.loginPolicyRepo != nil {
+		if blocked, reason := s.checkLoginPolicies(ctx, client.TenantID, data.LoginPolicySubject{ClientIP: clientIP, DeviceID: req.GetDeviceId()}); blocked {
+			s.log.Warnf("client credentials blocked by policy: ip=%s client_id=%s reason=%s", clientIP, clientID, reason)
+			return nil, authenticationV1.ErrorForbidden("login blocked by security policy")
+		}
+	}
+
+	roleCodes, err := s.roleRepo.ListRoleCodesByRoleIds(ctx, client.RoleIDs)
+	if err != nil {
+		s.log.Errorf("list role codes for api client [%d] failed: %s", client.ID, err.Error())
+		return nil, err
+	}
+
+	username := client.Name
+	if username == "" {
+		username = client.ClientID
+	}
+
+	tokenPayload := &authenticationV1.UserTokenPayload{
+		UserId:      0,
+		TenantId:    trans.Ptr(client.TenantID),
+		Username:    trans.Ptr(username),
+		ClientId:    trans.Ptr(client.ClientID),
+		DeviceId:    req.DeviceId,
+		Roles:       roleCodes,
+		ApiClientId: trans.Ptr(client.ID),
+	}
+	fillAdminFlags(tokenPayload, roleCodes)
+
+	accessToken, err := s.authenticator.CreateClientToken(ctx, req.GetClientType(), tokenPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.rateLimiter != nil {
+		s.rateLimiter.Reset(ctx, clientIP, clientID)
+	}
+
+	// 最近使用记录失败不影响令牌签发
+	if lerr := s.apiClientRepo.UpdateLastUsed(ctx, client.ID, clientIP, time.Now()); lerr != nil {
+		s.log.Warnf("update api client [%d] last used failed: %s", client.ID, lerr.Error())
+	}
+
+	return &authenticationV1.LoginResponse{
+		TokenType:   authenticationV1.TokenType_bearer,
+		AccessToken: accessToken,
+		ExpiresIn:   int64(s.authenticator.GetAccessTokenExpires(req.GetClientType()).Seconds()),
+	}, nil
+}
+
+// doGrantTypeAuthorizationCode 处理授权类型 - 授权码（必须携带 PKCE）
+// 第三方应用以 OAuthServerService.Authorize 签发的一次性授权码 + code_verifier 换取用户令牌，
+// 令牌携带授权时确定的 scopes，由认证中间件的作用域检查限制可访问的接口。
+// implicit 模式因令牌经前端 URL 暴露（OAuth 2.1 已废弃）不予支持。
+func (s *AuthenticationService) doGrantTypeAuthorizationCode(ctx context.Context, req *authenticationV1.LoginRequest) (*authenticationV1.LoginResponse, error) {
+	if s.apiClientRepo == nil || s.oauthCodeCache == nil {
+		return nil, authenticationV1.ErrorInvalidGrantType("invalid grant type")
+	}
+
+	ctx = s.resetContextForLogin(ctx)
+
+	clientID := strings.TrimSpace(req.GetClientId())
+	if clientID == "" || req.GetCode() == "" || req.GetRedirectUri() == "" || req.GetCodeVerifier() == "" {
+		return nil, authenticationV1.ErrorBadRequest("client_id, code, redirect_uri and code_verifier are required")
+	}
+
+	// 客户端认证：机密客户端必须校验 client_secret，公共客户端只凭 PKCE
+	client, err := s.apiClientRepo.GetOAuthClient(ctx, clientID)
+	if err == nil && !client.PublicClient {
+		_, err = s.apiClientRepo.VerifyClientSecret(ctx, clientID, req.GetClientSecret())
+	}
+	if err != nil {
+		s.log.Errorf("verify oauth client [%s] failed: %s", clientID, err.Error())
+		return nil, authenticationV1.ErrorInvalidPassword("invalid client")
+	}
+
+	// 先消耗授权码再校验：任何校验失败都会作废该授权码，防止对 code_verifier 反复试探
+	grant, err := s.oauthCodeCache.Consume(ctx, req.GetCode())
+	if err != nil {
+		return nil, authenticationV1.ErrorBadRequest("invalid authorization code")
+	}
+	if grant.ClientID != client.ClientID ||
+		grant.RedirectURI != req.GetRedirectUri() ||
+		!oauth.VerifyCodeChallenge(req.GetCodeVerifier(), grant.CodeChallenge, grant.CodeChallengeMethod) {
+		s.log.Warnf("oauth code exchange rejected: client=%s uid=%d", clientID, grant.UserID)
+		return nil, authenticationV1.ErrorBadRequest("invalid authorization code")
+	}
+
+	user, err := s.userRepo.Get(ctx, &identityV1.GetUserRequest{QueryBy: &identityV1.GetUserRequest_Id{Id: grant.UserID}})
+	if err != nil {
+		s.log.Errorf("get user by id [%d] failed [%s]", grant.UserID, err.Error())
+		return nil, err
+	}
+	if user.GetTenantId() != grant.TenantID {
+		return nil, authenticationV1.ErrorBadRequest("invalid tenant")
+	}
+
+	tokenPayload := &authenticationV1.UserTokenPayload{
+		UserId:   user.GetId(),
+		TenantId: user.TenantId,
+		Username: user.Username,
+		ClientId: trans.Ptr(client.ClientID),
+		DeviceId: req.DeviceId,
+		Scopes:   grant.Scopes,
+	}
+	// 第三方应用的令牌不能比授权时的用户令牌更"新鲜"，身份验证时间沿用授权时的值
+	if grant.AuthTime != 0 {
+		tokenPayload.AuthTime = trans.Ptr(grant.AuthTime)
+	}
+
+	// 解析用户权限信息（含用户/租户状态校验，授权后被禁用的用户无法换取令牌）
+	if err = s.resolveUserAuthority(ctx, user, tokenPayload); err != nil {
+		s.log.Errorf("resolve user [%d] authority failed [%s]", user.GetId(), err.Error())
+		return nil, err
+	}
+
+	accessToken, refreshToken, err := s.authenticator.CreateUserToken(ctx, req.GetClientType(), tokenPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &authenticationV1.LoginResponse{
+		TokenType:        authenticationV1.TokenType_bearer,
+		AccessToken:      accessToken,
+		RefreshToken:     trans.Ptr(refreshToken),
+		ExpiresIn:        int64(s.authenticator.GetAccessTokenExpires(req.GetClientType()).Seconds()),
+		RefreshExpiresIn: trans.Ptr(int64(s.authenticator.GetRefreshTokenExpires(req.GetClientType()).Seconds())),
+		Scope:            trans.Ptr(oauth.JoinScope(grant.Scopes)),
+	}
+
+	// 申请了 openid 范围：附带 ID 令牌（OpenID Connect Core 1.0 §3.1.3.3）
+	if oauth.HasScope(grant.Scopes, oauth.ScopeOpenID) {
+		now := time.Now()
+		idToken, ierr := s.authenticator.CreateIDToken(oauth.NewIDTokenClaims(&oauth.IDTokenParams{
+			Issuer:      s.authenticator.Issuer(),
+			ClientID:    client.ClientID,
+			Nonce:       grant.Nonce,
+			AccessToken: accessToken,
+			Alg:         s.authenticator.SigningMethod(),
+			Scopes:      grant.Scopes,
+			User:        user,
+			IssuedAt:    now,
+			ExpiresAt:   now.Add(s.authenticator.GetAccessTokenExpires(req.GetClientType())),
+		}))
+		if ierr != nil {
+			s.log.Errorf("create id token for user [%d] failed [%s]", user.GetId(), ierr.Error())
+			return nil, ierr
+		}
+		resp.IdToken = trans.Ptr(idToken)
+	}
+
+	return resp, nil
 }
 
 // Logout 登出
@@ -609,7 +1102,23 @@ func (s *AuthenticationService) Logout(ctx context.Context, _ *emptypb.Empty) (*
 		return nil, err
 	}
 
-	if err = s.authenticator.RevokeUserToken(ctx, s.clientType, operator.GetUserId()); err != nil {
+	// 服务客户端令牌（user_id 为 0）按客户端维度吊销，不能落到用户维度的 0 号键上
+	if operator.GetApiClientId() != 0 {
+		if err = s.authenticator.RevokeClientToken(ctx, s.clientType, operator.GetApiClientId()); err != nil {
+			return nil, err
+		}
+		return &emptypb.Empty{}, nil
+	}
+
+	// 只注销当前会话，同一用户在其他设备上的会话不受影响；不带会话 ID 的旧令牌按 jti 注销
+	if sessionId := operator.GetSessionId(); sessionId != "" {
+		if err = s.authenticator.RevokeUserSession(ctx, s.clientType, operator.GetUserId(), sessionId); err != nil && !authenticationV1.IsNotFound(err) {
+			return nil, err
+		}
+		return &emptypb.Empty{}, nil
+	}
+
+	if err = s.authenticator.RevokeTokenByJti(ctx, &s.clientType, operator.GetUserId(), operator.GetJti()); err != nil {
 		return nil, err
 	}
 
@@ -623,14 +1132,7 @@ func (s *AuthenticationService) RefreshToken(ctx context.Context, req *authentic
 		return nil, authenticationV1.ErrorInvalidGrantType("invalid grant type")
 	}
 
-	operator, err := auth.FromContext(ctx)
-	if err != nil {
-		return nil, err
-	}
-
 	req.ClientType = trans.Ptr(authenticationV1.ClientType_admin)
-	req.UserId = trans.Ptr(operator.GetUserId())
-	req.Jti = operator.Jti
 
 	return s.doGrantTypeRefreshToken(ctx, req)
 }
@@ -664,6 +1166,28 @@ func (s *AuthenticationService) RegisterUser(ctx context.Context, req *authentic
 		}
 
 		tenantId = tenant.Id
+
+		// 仅单点登录的租户由 IdP 管理账号，不开放本地密码注册
+		if err = s.checkLocalPasswordAllowed(ctx, tenant.GetId()); err != nil {
+			return nil, err
+		}
+	}
+
+	// 前端注册密码与登录一致为 AES 加密传输（base64），落库哈希必须基于明文：
+	// 此前直接把 AES 密文传给 Create，存成 bcrypt(AES密文)，而登录校验是
+	// bcrypt(解密后明文)——哈希对象不一致，注册用户永远无法登录。
+	plainPassword, derr := decryptTransportSecret(req.GetPassword())
+	if derr != nil {
+		return nil, authenticationV1.ErrorBadRequest("invalid password format")
+	}
+
+	// 密码策略须在创建用户前校验，否则用户落库而凭证被拒，留下无法登录的账号
+	var policyTenantID uint32
+	if tenantId != nil {
+		policyTenantID = *tenantId
+	}
+	if err = s.userCredentialRepo.CheckPassword(ctx, policyTenantID, req.GetUsername(), plainPassword); err != nil {
+		return nil, err
 	}
 
 	user, err := s.userRepo.Create(ctx, &identityV1.CreateUserRequest{
@@ -678,14 +1202,6 @@ func (s *AuthenticationService) RegisterUser(ctx context.Context, req *authentic
 		return nil, err
 	}
 
-	// 前端注册密码与登录一致为 AES 加密传输（base64），落库哈希必须基于明文：
-	// 此前直接把 AES 密文传给 Create，存成 bcrypt(AES密文)，而登录校验是
-	// bcrypt(解密后明文)——哈希对象不一致，注册用户永远无法登录。
-	plainPassword, derr := decryptTransportSecret(req.GetPassword())
-	if derr != nil {
-		return nil, authenticationV1.ErrorBadRequest("invalid password format")
-	}
-
 	if err = s.userCredentialRepo.Create(ctx, &authenticationV1.CreateUserCredentialRequest{
 		Data: &authenticationV1.UserCredential{
 			UserId:   user.Id,
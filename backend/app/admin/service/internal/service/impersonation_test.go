@@ -0,0 +1,231 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tx7do/go-utils/trans"
+	"google.golang.org/protobuf/proto"
+
+	conf "github.com/tx7do/kratos-bootstrap/api/gen/go/conf/v1"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"go-wind-admin/app/admin/service/internal/data"
+	"go-wind-admin/app/admin/service/internal/data/ent/operationauditlog"
+	"go-wind-admin/app/admin/service/internal/data/ent/permission"
+	"go-wind-admin/app/admin/service/internal/data/ent/role"
+	"go-wind-admin/app/admin/service/internal/data/ent/rolepermission"
+	"go-wind-admin/app/admin/service/internal/data/ent/tenant"
+	"go-wind-admin/app/admin/service/internal/data/ent/user"
+	"go-wind-admin/app/admin/service/internal/data/ent/userrole"
+	"go-wind-admin/app/admin/service/internal/data/enttest"
+
+	auditV1 "go-wind-admin/api/gen/go/audit/service/v1"
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+	identityV1 "go-wind-admin/api/gen/go/identity/service/v1"
+
+	"go-wind-admin/pkg/constants"
+	"go-wind-admin/pkg/middleware/auth"
+)
+
+func TestAuthenticationService_ImpersonateUser(t *testing.T) {
+	mr, err := miniredis.Run()
+	require.NoError(t, err)
+	t.Cleanup(mr.Close)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+
+	entClient := enttest.NewEntClientForTest(t)
+	bctx := bootstrap.NewContextWithParam(context.Background(), &conf.AppInfo{}, &conf.Bootstrap{
+		Authn: &conf.Authentication{
+			Jwt: &conf.Authentication_Jwt{Method: "HS256", Key: "impersonation-test-signing-key"},
+		},
+	}, log.DefaultLogger)
+	sysCtx := enttest.NewSystemViewerCtx(context.Background())
+
+	userRoleRepo := data.NewUserRoleRepo(bctx, entClient)
+	membershipRepo := data.NewMembershipRepo(bctx, entClient,
+		data.NewMembershipRoleRepo(bctx, entClient), data.NewMembershipPositionRepo(bctx, entClient), data.NewMembershipOrgUnitRepo(bctx, entClient))
+	userRepo := data.NewUserRepo(bctx, entClient, userRoleRepo, data.NewUserOrgUnitRepo(bctx, entClient), data.NewUserPositionRepo(bctx, entClient), membershipRepo)
+	permissionRepo := data.NewPermissionRepo(bctx, entClient, data.NewPermissionApiRepo(bctx, entClient), data.NewPermissionMenuRepo(bctx, entClient))
+	roleRepo := data.NewRoleRepo(bctx, entClient, data.NewRolePermissionRepo(bctx, entClient), permissionRepo, data.NewRoleMetadataRepo(bctx, entClient))
+	authenticator := data.NewAuthenticator(bctx, data.NewUserTokenCache(bctx, rdb), nil)
+	svc := NewAuthenticationService(bctx, userRepo, nil, roleRepo, data.NewTenantRepo(bctx, entClient), membershipRepo, nil, permissionRepo, authenticator,
+		authenticationV1.ClientType_admin, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, data.NewOperationAuditLogRepo(bctx, entClient))
+
+	const (
+		tenantA = 9201
+		tenantB = 9202
+
+		platformAdminID = 9201
+		tenantAdminID   = 9202
+		memberID        = 9203
+		otherMemberID   = 9204
+		otherAdminID    = 9205
+	)
+	db := entClient.Client()
+	for _, tid := range []uint32{tenantA, tenantB} {
+		require.NoError(t, db.Tenant.Create().SetID(tid).SetName(fmt.Sprintf("tenant%d", tid)).
+			SetCode(fmt.Sprintf("tenant%d", tid)).SetStatus(tenant.StatusOn).Exec(sysCtx))
+	}
+	require.NoError(t, db.Permission.Create().SetID(9201).SetName("access backend").
+		SetCode(constants.SystemAccessBackendPermissionCode).SetStatus(permission.StatusOn).Exec(sysCtx))
+	// grant 创建带后台访问权限的角色并分配给用户
+	grant := func(roleID uint32, tenantID uint32, code string, userIDs ...uint32) {
+		require.NoError(t, db.Role.Create().SetID(roleID).SetTenantID(tenantID).SetName(code).SetCode(code).
+			SetType(role.TypeTenant).SetIsProtected(false).SetStatus(role.StatusOn).Exec(sysCtx))
+		require.NoError(t, db.RolePermission.Create().SetTenantID(tenantID).SetRoleID(roleID).SetPermissionID(9201).
+			SetStatus(rolepermission.StatusOn).Exec(sysCtx))
+		for _, uid := range userIDs {
+			require.NoError(t, db.UserRole.Create().SetTenantID(tenantID).SetUserID(uid).SetRoleID(roleID).
+				SetIsPrimary(true).SetStatus(userrole.StatusActive).Exec(sysCtx))
+		}
+	}
+	users := map[uint32]uint32{platformAdminID: 0, otherAdminID: 0, tenantAdminID: tenantA, memberID: tenantA, otherMemberID: tenantB}
+	for uid, tid := range users {
+		require.NoError(t, db.User.Create().SetID(uid).SetTenantID(tid).
+			SetUsername(fmt.Sprintf("imp%d", uid)).SetStatus(user.StatusNormal).Exec(sysCtx))
+	}
+	grant(9201, 0, constants.PlatformAdminRoleCode, platformAdminID, otherAdminID)
+	grant(9202, tenantA, constants.TenantAdminRoleCode, tenantAdminID)
+	grant(9203, tenantA, "tenant:member", memberID)
+	grant(9204, tenantB, "tenant:member", otherMemberID)
+
+	platformAdmin := auth.NewContext(sysCtx, &authenticationV1.UserTokenPayload{
+		UserId: platformAdminID, TenantId: trans.Ptr(uint32(0)), Username: trans.Ptr("root"), IsPlatformAdmin: trans.Ptr(true),
+	})
+	tenantAdmin := auth.NewContext(sysCtx, &authenticationV1.UserTokenPayload{
+		UserId: tenantAdminID, TenantId: trans.Ptr(uint32(tenantA)), Username: trans.Ptr("manager"), IsTenantAdmin: trans.Ptr(true),
+	})
+	member := auth.NewContext(sysCtx, &authenticationV1.UserTokenPayload{
+		UserId: memberID, TenantId: trans.Ptr(uint32(tenantA)),
+	})
+	impersonate := func(ctx context.Context, userID uint32, reason string) (*authenticationV1.LoginResponse, error) {
+		return svc.ImpersonateUser(ctx, &authenticationV1.ImpersonateUserRequest{UserId: userID, Reason: reason})
+	}
+
+	// 权限与参数校验
+	_, err = impersonate(member, otherMemberID, "support")
+	assert.Equal(t, 403, int(errors.Code(err)))
+	_, err = impersonate(tenantAdmin, memberID, " ")
+	assert.Equal(t, 400, int(errors.Code(err)))
+	_, err = impersonate(tenantAdmin, tenantAdminID, "support")
+	assert.Equal(t, 400, int(errors.Code(err)))
+	_, err = impersonate(tenantAdmin, otherMemberID, "support")
+	assert.Equal(t, 404, int(errors.Code(err)))
+	_, err = impersonate(tenantAdmin, platformAdminID, "support")
+	assert.Equal(t, 404, int(errors.Code(err)))
+	_, err = impersonate(platformAdmin, platformAdminID+100, "support")
+	assert.Equal(t, 404, int(errors.Code(err)))
+
+	// 不能借代登录提升权限
+	_, err = impersonate(platformAdmin, platformAdminID, "support")
+	assert.Equal(t, 400, int(errors.Code(err)))
+	_, err = impersonate(platformAdmin, otherAdminID, "support")
+	assert.Equal(t, 403, int(errors.Code(err)))
+	_, err = impersonate(tenantAdmin, tenantAdminID+100, "support")
+	assert.Equal(t, 404, int(errors.Code(err)))
+	_, err = impersonate(platformAdmin, tenantAdminID, "support")
+	assert.NoError(t, err)
+
+	// 租户管理员代登录本租户成员：短期令牌、无刷新令牌，载荷同时携带两个身份
+	resp, err := impersonate(tenantAdmin, memberID, "ticket #42")
+	require.NoError(t, err)
+	assert.Empty(t, resp.GetRefreshToken())
+	assert.LessOrEqual(t, resp.GetExpiresIn(), int64(impersonationTokenTTL.Seconds()))
+	assert.Greater(t, resp.GetExpiresIn(), int64(0))
+
+	validated, err := authenticator.Authenticate(context.Background(), &authenticationV1.ValidateTokenRequest{
+		Token:         resp.GetAccessToken(),
+		ClientType:    authenticationV1.ClientType_admin,
+		TokenCategory: authenticationV1.TokenCategory_ACCESS,
+	})
+	require.NoError(t, err)
+	payload := validated.GetPayload()
+	assert.Equal(t, uint32(memberID), payload.GetUserId())
+	assert.Equal(t, uint32(tenantA), payload.GetTenantId())
+	assert.Equal(t, uint32(tenantAdminID), payload.GetActorUserId())
+	assert.Equal(t, "manager", payload.GetActorUsername())
+	assert.Empty(t, payload.GetSessionId())
+	assert.False(t, payload.GetIsTenantAdmin())
+
+	// 代登录令牌不能再次代登录
+	_, err = impersonate(auth.NewContext(sysCtx, payload), otherMemberID, "support")
+	assert.Equal(t, 403, int(errors.Code(err)))
+
+	// 操作审计记录代登录本身，代登录期间的操作同时记录两个身份
+	entries, err := db.OperationAuditLog.Query().
+		Where(operationauditlog.ResourceTypeEQ(auditResourceImpersonation), operationauditlog.ResourceIDEQ("9203"), operationauditlog.SuccessEQ(true)).
+		All(sysCtx)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, uint32(tenantAdminID), *entries[0].UserID)
+
+	svc.auditor.record(auth.NewContext(sysCtx, payload), auditResourceUser, memberID, auditV1.OperationAuditLog_UPDATE, nil, nil, nil)
+	entries, err = db.OperationAuditLog.Query().
+		Where(operationauditlog.ImpersonatorIDEQ(tenantAdminID), operationauditlog.ResourceTypeEQ(auditResourceUser)).
+		All(sysCtx)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, uint32(memberID), *entries[0].UserID)
+	assert.Equal(t, "manager", *entries[0].ImpersonatorName)
+
+	// 吊销被代登录用户的令牌时代登录令牌一并失效
+	require.NoError(t, authenticator.RevokeUserToken(context.Background(), authenticationV1.ClientType_admin, memberID))
+	_, err = authenticator.Authenticate(context.Background(), &authenticationV1.ValidateTokenRequest{
+		Token:         resp.GetAccessToken(),
+		ClientType:    authenticationV1.ClientType_admin,
+		TokenCategory: authenticationV1.TokenCategory_ACCESS,
+	})
+	assert.Error(t, err)
+}
+
+func TestImpersonationTokenForbidden(t *testing.T) {
+	impersonated := auth.NewContext(context.Background(), &authenticationV1.UserTokenPayload{
+		UserId: 9203, TenantId: trans.Ptr(uint32(9201)), ActorUserId: trans.Ptr(uint32(9202)),
+	})
+
+	// 签发授权码：换出的令牌不带代登录标记、可刷新且不受代登录时效限制
+	_, err := (&OAuthServerService{}).Authorize(impersonated, &authenticationV1.OAuthAuthorizeRequest{ResponseType: "code"})
+	assert.Equal(t, 403, int(errors.Code(err)))
+
+	// 绑定、解绑第三方账号：操作人可借自己的社交账号长期登录被代登录用户
+	oauthSvc := &OAuthService{}
+	_, err = oauthSvc.StartLinkOAuth(impersonated, &authenticationV1.StartLinkOAuthRequest{})
+	assert.Equal(t, 403, int(errors.Code(err)))
+	_, err = oauthSvc.ConfirmLinkOAuth(impersonated, &authenticationV1.ConfirmLinkOAuthRequest{
+		Credential: &authenticationV1.ConfirmLinkOAuthRequest_Code{Code: "code"},
+		State:      trans.Ptr("state"),
+	})
+	assert.Equal(t, 403, int(errors.Code(err)))
+	_, err = oauthSvc.UnlinkOAuth(impersonated, &authenticationV1.UnlinkOAuthRequest{})
+	assert.Equal(t, 403, int(errors.Code(err)))
+
+	// 修改个人资料：邮箱、手机号可用于找回密码
+	_, err = (&UserProfileService{}).UpdateUser(impersonated, &identityV1.UpdateUserRequest{Data: &identityV1.User{}})
+	assert.Equal(t, 403, int(errors.Code(err)))
+
+	// 登录强制注册：挑战若绑定代登录身份，不得为被代登录用户注册因子
+	env := newWebAuthnTestEnv(t, 9206)
+	operator := proto.Clone(env.operator).(*authenticationV1.UserTokenPayload)
+	operator.ActorUserId = trans.Ptr(uint32(9202))
+	opId, err := env.cache.SetEnrollmentLoginChallenge(context.Background(), operator, authenticationV1.ClientType_admin)
+	require.NoError(t, err)
+	_, err = env.svc.StartLoginEnrollment(context.Background(), &authenticationV1.StartEnrollMethodRequest{
+		Method:           authenticationV1.MFAMethod_TOTP,
+		LoginOperationId: trans.Ptr(opId),
+	})
+	assert.Equal(t, 403, int(errors.Code(err)))
+	_, err = env.svc.ConfirmLoginEnrollment(context.Background(), &authenticationV1.ConfirmEnrollMethodRequest{
+		Method:           authenticationV1.MFAMethod_TOTP,
+		Credential:       &authenticationV1.ConfirmEnrollMethodRequest_TotpCode{TotpCode: "000000"},
+		LoginOperationId: trans.Ptr(opId),
+	})
+	assert.Equal(t, 403, int(errors.Code(err)))
+}
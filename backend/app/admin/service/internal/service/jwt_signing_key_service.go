@@ -0,0 +1,78 @@
+package service
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/go-utils/trans"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"go-wind-admin/app/admin/service/internal/data"
+
+	adminV1 "go-wind-admin/api/gen/go/admin/service/v1"
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+
+	"go-wind-admin/pkg/middleware/auth"
+)
+
+// JwtSigningKeyService JWT 签名密钥管理服务。密钥环为平台级资源，仅平台管理员可查看与轮换。
+type JwtSigningKeyService struct {
+	adminV1.JwtSigningKeyServiceHTTPServer
+
+	log *log.Helper
+
+	repo          *data.JwtSigningKeyRepo
+	authenticator *data.Authenticator
+}
+
+func NewJwtSigningKeyService(
+	ctx *bootstrap.Context,
+	repo *data.JwtSigningKeyRepo,
+	authenticator *data.Authenticator,
+) *JwtSigningKeyService {
+	return &JwtSigningKeyService{
+		log:           ctx.NewLoggerHelper("jwt-signing-key/service/admin-service"),
+		repo:          repo,
+		authenticator: authenticator,
+	}
+}
+
+// checkPlatformAdmin 校验操作人为平台管理员，返回操作人ID
+func (s *JwtSigningKeyService) checkPlatformAdmin(ctx context.Context) (uint32, error) {
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if !operator.GetIsPlatformAdmin() {
+		return 0, authenticationV1.ErrorForbidden("only platform admin can manage jwt signing keys")
+	}
+
+	return operator.GetUserId(), nil
+}
+
+func (s *JwtSigningKeyService) List(ctx context.Context, _ *emptypb.Empty) (*authenticationV1.ListJwtSigningKeyResponse, error) {
+	if _, err := s.checkPlatformAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	return s.repo.List(ctx)
+}
+
+// Rotate 立即轮换签名密钥。新密钥签发的令牌对其他实例在下次刷新密钥环（或遇到未知 kid）后可校验；
+// 旧密钥签发的令牌在其有效期内继续有效，用户无感知。
+func (s *JwtSigningKeyService) Rotate(ctx context.Context, _ *emptypb.Empty) (*authenticationV1.RotateJwtSigningKeyResponse, error) {
+	operatorID, err := s.checkPlatformAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	kid, err := s.authenticator.RotateSigningKey(ctx, trans.Ptr(operatorID))
+	if err != nil {
+		return nil, err
+	}
+
+	return &authenticationV1.RotateJwtSigningKeyResponse{Kid: kid}, nil
+}
@@ -0,0 +1,219 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/log"
+	paginationV1 "github.com/tx7do/go-crud/api/gen/go/pagination/v1"
+	"github.com/tx7do/go-utils/trans"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"go-wind-admin/app/admin/service/internal/data"
+
+	adminV1 "go-wind-admin/api/gen/go/admin/service/v1"
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+
+	appViewer "go-wind-admin/pkg/entgo/viewer"
+	"go-wind-admin/pkg/ldap"
+	"go-wind-admin/pkg/middleware/auth"
+)
+
+// ldapAttributeKeys 属性映射允许的本地字段。
+var ldapAttributeKeys = map[string]bool{
+	data.LdapAttrUsername: true,
+	data.LdapAttrEmail:    true,
+	data.LdapAttrNickname: true,
+	data.LdapAttrRealname: true,
+	data.LdapAttrMobile:   true,
+}
+
+// LdapConfigService LDAP / Active Directory 配置管理（租户维度）。
+type LdapConfigService struct {
+	adminV1.LdapConfigServiceHTTPServer
+
+	log *log.Helper
+
+	repo        *data.LdapConfigRepo
+	accountRepo *data.LdapAccountRepo
+	roleRepo    *data.RoleRepo
+	orgUnitRepo *data.OrgUnitRepo
+}
+
+func NewLdapConfigService(
+	ctx *bootstrap.Context,
+	repo *data.LdapConfigRepo,
+	accountRepo *data.LdapAccountRepo,
+	roleRepo *data.RoleRepo,
+	orgUnitRepo *data.OrgUnitRepo,
+) *LdapConfigService {
+	return &LdapConfigService{
+		log:         ctx.NewLoggerHelper("ldap-config/service/admin-service"),
+		repo:        repo,
+		accountRepo: accountRepo,
+		roleRepo:    roleRepo,
+		orgUnitRepo: orgUnitRepo,
+	}
+}
+
+// checkOrgUnitExist 组织单元查询受租户隐私过滤，只能引用本租户的组织单元。
+func (s *LdapConfigService) checkOrgUnitExist(ctx context.Context, id uint32, name string) error {
+	if id == 0 {
+		return nil
+	}
+	exist, err := s.orgUnitRepo.IsExist(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !exist {
+		return adminV1.ErrorBadRequest("%s not found", name)
+	}
+	return nil
+}
+
+// checkLdapConfig 校验合并后的完整配置。
+func (s *LdapConfigService) checkLdapConfig(ctx context.Context, cfg *authenticationV1.LdapConfig) error {
+	if _, err := data.NewLdapDirectoryConfig(cfg, ""); err != nil {
+		return adminV1.ErrorBadRequest("invalid ldap config: %s", err.Error())
+	}
+
+	for key, attr := range cfg.GetAttributeMapping() {
+		if !ldapAttributeKeys[key] || strings.TrimSpace(attr) == "" {
+			return adminV1.ErrorBadRequest("invalid attribute mapping: %s", key)
+		}
+	}
+	if strings.TrimSpace(cfg.GetAttributeMapping()[data.LdapAttrUsername]) == "" {
+		return adminV1.ErrorBadRequest("attribute mapping of username is required")
+	}
+
+	for dn, roleId := range cfg.GetGroupRoleMapping() {
+		if ldap.NormalizeDN(dn) == "" {
+			return adminV1.ErrorBadRequest("invalid group dn: %s", dn)
+		}
+		if _, err := s.roleRepo.CanAssignRole(ctx, roleId); err != nil {
+			return err
+		}
+	}
+	for _, roleId := range cfg.GetDefaultRoleIds() {
+		if _, err := s.roleRepo.CanAssignRole(ctx, roleId); err != nil {
+			return err
+		}
+	}
+
+	if err := s.checkOrgUnitExist(ctx, cfg.GetDefaultOrgUnitId(), "default org unit"); err != nil {
+		return err
+	}
+	if err := s.checkOrgUnitExist(ctx, cfg.GetOrgUnitRootId(), "org unit root"); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *LdapConfigService) List(ctx context.Context, req *paginationV1.PagingRequest) (*authenticationV1.ListLdapConfigResponse, error) {
+	return s.repo.List(ctx, req)
+}
+
+func (s *LdapConfigService) Get(ctx context.Context, req *authenticationV1.GetLdapConfigRequest) (*authenticationV1.LdapConfig, error) {
+	return s.repo.Get(ctx, req.GetId())
+}
+
+func (s *LdapConfigService) Create(ctx context.Context, req *authenticationV1.CreateLdapConfigRequest) (*emptypb.Empty, error) {
+	if req == nil || req.Data == nil {
+		return nil, adminV1.ErrorBadRequest("invalid request")
+	}
+
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if operator.GetTenantId() != 0 {
+		req.Data.TenantId = trans.Ptr(operator.GetTenantId())
+	}
+
+	if err = s.checkLdapConfig(ctx, req.Data); err != nil {
+		return nil, err
+	}
+
+	req.Data.CreatedBy = trans.Ptr(operator.UserId)
+
+	if err = s.repo.Create(ctx, req.Data); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *LdapConfigService) Update(ctx context.Context, req *authenticationV1.UpdateLdapConfigRequest) (*emptypb.Empty, error) {
+	if req == nil || req.Data == nil {
+		return nil, adminV1.ErrorBadRequest("invalid request")
+	}
+
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 查询受租户隐私过滤：先确认配置对当前操作人可见，再以合并后的完整配置做校验
+	current, err := s.repo.Get(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	merged := proto.Clone(current).(*authenticationV1.LdapConfig)
+	if req.Data.AttributeMapping != nil {
+		merged.AttributeMapping = nil
+	}
+	if req.Data.GroupRoleMapping != nil {
+		merged.GroupRoleMapping = nil
+	}
+	if req.Data.DefaultRoleIds != nil {
+		merged.DefaultRoleIds = nil
+	}
+	proto.Merge(merged, req.Data)
+	if err = s.checkLdapConfig(ctx, merged); err != nil {
+		return nil, err
+	}
+
+	req.Data.UpdatedBy = trans.Ptr(operator.UserId)
+
+	if err = s.repo.Update(ctx, req.GetId(), req.Data); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *LdapConfigService) Delete(ctx context.Context, req *authenticationV1.DeleteLdapConfigRequest) (*emptypb.Empty, error) {
+	if err := s.repo.Delete(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *LdapConfigService) SyncLdapDirectory(ctx context.Context, req *authenticationV1.SyncLdapDirectoryRequest) (*authenticationV1.LdapSyncResult, error) {
+	// 查询受租户隐私过滤：只能同步当前操作人可见的配置
+	current, err := s.repo.Get(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	// 同步需写入服务账号不可见的用户凭证与组织单元，与定时任务一样以系统身份执行
+	sysCtx := appViewer.NewSystemViewerContext(ctx)
+
+	settings, err := s.repo.GetEnabled(sysCtx, current.GetTenantId())
+	if err != nil {
+		return nil, err
+	}
+	if settings == nil || settings.ID != current.GetId() {
+		return nil, adminV1.ErrorBadRequest("ldap config is disabled")
+	}
+
+	return s.accountRepo.SyncAndRecord(sysCtx, settings)
+}
@@ -2,6 +2,7 @@
 
 import (
 	"context"
+	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
 	paginationV1 "github.com/tx7do/go-crud/api/gen/go/pagination/v1"
@@ -13,8 +14,10 @@
 
 	adminV1 "go-wind-admin/api/gen/go/admin/service/v1"
 	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+	identityV1 "go-wind-admin/api/gen/go/identity/service/v1"
 
 	"go-wind-admin/pkg/middleware/auth"
+	applogging "go-wind-admin/pkg/middleware/logging"
 )
 
 type LoginPolicyService struct {
@@ -23,12 +26,15 @@ type LoginPolicyService struct {
 	log *log.Helper
 
 	repo *data.LoginPolicyRepo
+
+	authnService *AuthenticationService
 }
 
-func NewLoginPolicyService(ctx *bootstrap.Context, repo *data.LoginPolicyRepo) *LoginPolicyService {
+func NewLoginPolicyService(ctx *bootstrap.Context, repo *data.LoginPolicyRepo, authnService *AuthenticationService) *LoginPolicyService {
 	return &LoginPolicyService{
-		log:  ctx.NewLoggerHelper("login-policy/service/admin-service"),
-		repo: repo,
+		log:          ctx.NewLoggerHelper("login-policy/service/admin-service"),
+		repo:         repo,
+		authnService: authnService,
 	}
 }
 
@@ -57,6 +63,11 @@ func (s *LoginPolicyService) Create(ctx context.Context, req *authenticationV1.C
 	if verr := data.ValidateLoginPolicyValue(req.Data.GetMethod().String(), req.Data.GetValue()); verr != nil {
 		return nil, adminV1.ErrorBadRequest("%s", verr.Error())
 	}
+	if req.Data.TargetType != nil {
+		if verr := data.ValidateLoginPolicyTarget(req.Data.GetTargetType().String(), req.Data.GetTargetId(), req.Data.GetTargetCode()); verr != nil {
+			return nil, adminV1.ErrorBadRequest("%s", verr.Error())
+		}
+	}
 
 	if err = s.repo.Create(ctx, req); err != nil {
 		return nil, err
@@ -74,6 +85,11 @@ func (s *LoginPolicyService) Update(ctx context.Context, req *authenticationV1.U
 	if verr := data.ValidateLoginPolicyValue(req.Data.GetMethod().String(), req.Data.GetValue()); verr != nil {
 		return nil, adminV1.ErrorBadRequest("%s", verr.Error())
 	}
+	if req.Data.TargetType != nil {
+		if verr := data.ValidateLoginPolicyTarget(req.Data.GetTargetType().String(), req.Data.GetTargetId(), req.Data.GetTargetCode()); verr != nil {
+			return nil, adminV1.ErrorBadRequest("%s", verr.Error())
+		}
+	}
 
 	// 获取操作人信息
 	operator, err := auth.FromContext(ctx)
@@ -106,3 +122,77 @@ func (s *LoginPolicyService) Delete(ctx context.Context, req *authenticationV1.D
 
 	return &emptypb.Empty{}, nil
 }
+
+// Evaluate 模拟判定登录策略：按给定的用户、IP、设备与时间走一遍登录闸门的判定，返回全部拦截明细，
+// 供管理员在保存策略前确认不会误锁。未指定用户时只判定约束所有用户的策略。
+func (s *LoginPolicyService) Evaluate(ctx context.Context, req *authenticationV1.EvaluateLoginPolicyRequest) (*authenticationV1.EvaluateLoginPolicyResponse, error) {
+	if req == nil {
+		return nil, adminV1.ErrorBadRequest("invalid request")
+	}
+
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tenantID := operator.GetTenantId()
+	subject := data.LoginPolicySubject{
+		ClientIP: req.GetIp(),
+		DeviceID: req.GetDeviceId(),
+		// 模拟判定总是解析地区，便于管理员核对 IP 归属
+		Region: applogging.LookupGeoLocation(req.GetIp()),
+		Now:    time.Now(),
+	}
+	if req.LoginTime != nil {
+		subject.Now = req.GetLoginTime().AsTime()
+	}
+
+	if req.GetUserId() != 0 {
+		// 以操作人身份读取用户：租户隔离由隐私规则保证，不能借模拟判定探查其他租户的用户
+		user, uerr := s.authnService.userRepo.Get(ctx, &identityV1.GetUserRequest{
+			QueryBy: &identityV1.GetUserRequest_Id{Id: req.GetUserId()},
+		})
+		if uerr != nil {
+			return nil, uerr
+		}
+		if user == nil {
+			return nil, adminV1.ErrorNotFound("user not found")
+		}
+
+		// 角色按登录时的规则解析
+		payload := &authenticationV1.UserTokenPayload{
+			UserId:   user.GetId(),
+			TenantId: user.TenantId,
+			Username: user.Username,
+		}
+		if err = s.authnService.resolveUserAuthority(s.authnService.resetContextForLogin(ctx), user, payload); err != nil {
+			return nil, err
+		}
+
+		tenantID = user.GetTenantId()
+		subject.UserID = user.GetId()
+		subject.RoleCodes = payload.GetRoles()
+		subject.OrgUnitIDs = user.GetOrgUnitIds()
+	}
+
+	policies, err := s.authnService.prepareLoginPolicies(ctx, tenantID, &subject)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &authenticationV1.EvaluateLoginPolicyResponse{}
+	for _, v := range data.EvaluateLoginPolicies(policies, subject) {
+		resp.Violations = append(resp.Violations, &authenticationV1.LoginPolicyViolation{
+			Method:    authenticationV1.LoginPolicy_Method(authenticationV1.LoginPolicy_Method_value[v.Method]),
+			Reason:    v.Reason,
+			PolicyIds: v.PolicyIDs,
+		})
+	}
+	resp.Blocked = len(resp.Violations) > 0
+	if region := data.FormatRegion(subject.Region); region != "" {
+		resp.Region = trans.Ptr(region)
+	}
+
+	return resp, nil
+}
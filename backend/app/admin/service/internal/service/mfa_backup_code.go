@@ -0,0 +1,101 @@
+package service
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go-wind-admin/app/admin/service/internal/data/ent/usermfafactor"
+
+	"go-wind-admin/pkg/middleware/auth"
+
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+)
+
+const (
+	// mfaBackupCodeDefaultCount 未指定数量时生成的备份码个数。
+	mfaBackupCodeDefaultCount = 10
+	// mfaBackupCodeMaxCount 单批备份码数量上限。
+	mfaBackupCodeMaxCount = 20
+)
+
+// GenerateBackupCodes 为当前登录用户生成一批一次性备份码。
+// 明文仅本次返回；重新生成会作废此前全部备份码（含未使用的）。
+// 备份码只是主因子（TOTP/WebAuthn/短信/邮件）的替代手段，须先绑定主因子。
+func (s *MfaService) GenerateBackupCodes(ctx context.Context, req *authenticationV1.GenerateBackupCodesRequest) (*authenticationV1.GenerateBackupCodesResponse, error) {
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	count := int(req.GetCount())
+	switch {
+	case req.Count == nil || count == 0:
+		count = mfaBackupCodeDefaultCount
+	case count < 0 || count > mfaBackupCodeMaxCount:
+		return nil, authenticationV1.ErrorBadRequest("backup code count must be between 1 and 20")
+	}
+
+	tid := operator.GetTenantId()
+	uid := operator.GetUserId()
+	if has, herr := s.mfaFactorRepo.HasEnabledFactor(ctx, tid, uid); herr != nil {
+		return nil, authenticationV1.ErrorInternalServerError("check mfa status failed")
+	} else if !has {
+		return nil, authenticationV1.ErrorBadRequest("enroll an mfa factor before generating backup codes")
+	}
+
+	codes, generatedAt, err := s.mfaFactorRepo.ReplaceBackupCodes(ctx, tid, uid, count)
+	if err != nil {
+		return nil, authenticationV1.ErrorInternalServerError("generate backup codes failed")
+	}
+	s.log.Infof("user [%d] regenerated %d mfa backup codes", uid, count)
+
+	return &authenticationV1.GenerateBackupCodesResponse{
+		Codes:       codes,
+		GeneratedAt: timestamppb.New(generatedAt),
+	}, nil
+}
+
+// ListBackupCodes 查询当前登录用户剩余可用的备份码数量（不返回明文）。
+func (s *MfaService) ListBackupCodes(ctx context.Context, _ *authenticationV1.ListBackupCodesRequest) (*authenticationV1.ListBackupCodesResponse, error) {
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	remaining, generatedAt, err := s.mfaFactorRepo.CountBackupCodes(ctx, operator.GetTenantId(), operator.GetUserId())
+	if err != nil {
+		return nil, authenticationV1.ErrorInternalServerError("query backup codes failed")
+	}
+
+	resp := &authenticationV1.ListBackupCodesResponse{Remaining: int32(remaining)}
+	if generatedAt != nil {
+		resp.GeneratedAt = timestamppb.New(*generatedAt)
+	}
+	return resp, nil
+}
+
+// verifyBackupCodeChallenge 以备份码完成登录挑战：命中即核销（一次性），返回所用因子 ID。
+func (s *MfaService) verifyBackupCodeChallenge(ctx context.Context, tid, uid uint32, code string) (uint32, error) {
+	factorId, ok, err := s.mfaFactorRepo.ConsumeBackupCode(ctx, tid, uid, code)
+	if err != nil {
+		return 0, authenticationV1.ErrorInternalServerError("verify backup code failed")
+	}
+	if !ok {
+		return 0, errMfaResponseInvalid
+	}
+	s.log.Infof("user [%d] signed in with an mfa backup code", uid)
+	return factorId, nil
+}
+
+// purgeOrphanBackupCodes 最后一个主因子被移除后清空备份码：
+// 备份码不能脱离主因子单独存在，否则日后重新绑定时旧码会随之复活。
+func (s *MfaService) purgeOrphanBackupCodes(ctx context.Context, tid, uid uint32) {
+	has, err := s.mfaFactorRepo.HasEnabledFactor(ctx, tid, uid)
+	if err != nil || has {
+		return
+	}
+	if _, err = s.mfaFactorRepo.DeleteAllByUserMethod(ctx, tid, uid, usermfafactor.MethodBackupCode); err != nil {
+		s.log.Errorf("purge backup codes for user [%d] failed: %s", uid, err.Error())
+	}
+}
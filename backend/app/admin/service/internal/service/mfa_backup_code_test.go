@@ -0,0 +1,83 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tx7do/go-utils/trans"
+
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+
+	"go-wind-admin/pkg/webauthn/webauthntest"
+)
+
+func (e *webAuthnTestEnv) verifyBackupCode(t *testing.T, code string) (*authenticationV1.LoginResponse, error) {
+	t.Helper()
+
+	opId, err := e.cache.SetLoginChallenge(context.Background(), e.operator, authenticationV1.ClientType_admin)
+	require.NoError(t, err)
+	return e.svc.VerifyMFAChallenge(context.Background(), &authenticationV1.VerifyMFAChallengeRequest{
+		OperationId: opId,
+		Response:    &authenticationV1.VerifyMFAChallengeRequest_BackupCode{BackupCode: code},
+	})
+}
+
+func TestMfaService_BackupCodes(t *testing.T) {
+	env := newWebAuthnTestEnv(t, 9111)
+
+	// 未绑定主因子时不允许生成
+	_, err := env.svc.GenerateBackupCodes(env.ctx, &authenticationV1.GenerateBackupCodesRequest{})
+	require.Error(t, err)
+	assert.Equal(t, 400, int(errors.Code(err)))
+
+	env.enroll(t, webauthntest.New(webAuthnTestOrigin))
+
+	_, err = env.svc.GenerateBackupCodes(env.ctx, &authenticationV1.GenerateBackupCodesRequest{Count: trans.Ptr(int32(21))})
+	require.Error(t, err)
+
+	generated, err := env.svc.GenerateBackupCodes(env.ctx, &authenticationV1.GenerateBackupCodesRequest{})
+	require.NoError(t, err)
+	require.Len(t, generated.GetCodes(), mfaBackupCodeDefaultCount)
+	assert.NotNil(t, generated.GetGeneratedAt())
+
+	status, err := env.svc.GetMFAStatus(env.ctx, &authenticationV1.GetMFAStatusRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, int32(mfaBackupCodeDefaultCount), status.GetBackupCodesRemaining())
+	require.Len(t, status.GetEnrolled(), 1, "backup codes are not listed as enrolled methods")
+
+	// 输入不区分大小写、忽略分隔符
+	code := generated.GetCodes()[0]
+	resp, err := env.verifyBackupCode(t, strings.ToUpper(strings.ReplaceAll(code, "-", "")))
+	require.NoError(t, err)
+	assert.NotEmpty(t, resp.GetAccessToken())
+
+	// 一次性：同一码不可再用
+	_, err = env.verifyBackupCode(t, code)
+	require.Error(t, err)
+	assert.Equal(t, 403, int(errors.Code(err)))
+
+	listed, err := env.svc.ListBackupCodes(env.ctx, &authenticationV1.ListBackupCodesRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, int32(mfaBackupCodeDefaultCount-1), listed.GetRemaining())
+
+	// 重新生成作废旧码
+	regenerated, err := env.svc.GenerateBackupCodes(env.ctx, &authenticationV1.GenerateBackupCodesRequest{Count: trans.Ptr(int32(3))})
+	require.NoError(t, err)
+	require.Len(t, regenerated.GetCodes(), 3)
+	_, err = env.verifyBackupCode(t, generated.GetCodes()[1])
+	require.Error(t, err)
+	_, err = env.verifyBackupCode(t, regenerated.GetCodes()[0])
+	require.NoError(t, err)
+
+	// 移除最后一个主因子后备份码随之清空
+	_, err = env.svc.DisableMFA(env.ctx, &authenticationV1.DisableMFARequest{Method: trans.Ptr(authenticationV1.MFAMethod_WEBAUTHN)})
+	require.NoError(t, err)
+	listed, err = env.svc.ListBackupCodes(env.ctx, &authenticationV1.ListBackupCodesRequest{})
+	require.NoError(t, err)
+	assert.Zero(t, listed.GetRemaining())
+	assert.Nil(t, listed.GetGeneratedAt())
+}
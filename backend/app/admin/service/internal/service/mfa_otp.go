@@ -0,0 +1,341 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/mail"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go-wind-admin/app/admin/service/internal/data"
+
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+	identityV1 "go-wind-admin/api/gen/go/identity/service/v1"
+
+	"go-wind-admin/pkg/sender"
+)
+
+// 短信/邮件验证码（OTP）因子相关流程：
+//   - 注册：StartEnrollMethod 向待绑定的手机号/邮箱下发验证码，ConfirmEnrollMethod 校验通过后落库因子；
+//   - 登录二次验证：StartMFAChallenge 向已绑定的手机号/邮箱下发验证码，VerifyMFAChallenge 校验。
+//
+// 验证码 6 位数字，有效期同挑战（data.MfaChallengeTTL），重发即作废旧码；
+// 下发冷却与失败锁定按因子计（data.MfaOtpResendCooldown / data.MaxOtpFailures），跨挑战累计。
+
+// mfaOtpDigits 验证码位数。
+const mfaOtpDigits = 6
+
+// isOtpMethod 是否为经 Sender 下发验证码的方法。
+func isOtpMethod(m authenticationV1.MFAMethod) bool {
+	return m == authenticationV1.MFAMethod_SMS || m == authenticationV1.MFAMethod_EMAIL
+}
+
+// otpChannel 方法对应的投递渠道。
+func otpChannel(m authenticationV1.MFAMethod) sender.Channel {
+	if m == authenticationV1.MFAMethod_EMAIL {
+		return sender.ChannelEmail
+	}
+	return sender.ChannelSMS
+}
+
+// startOtpEnroll 开始短信/邮件注册：校验手机号/邮箱后下发验证码。
+func (s *MfaService) startOtpEnroll(ctx context.Context, operator *authenticationV1.UserTokenPayload, req *authenticationV1.StartEnrollMethodRequest) (*authenticationV1.StartEnrollMethodResponse, error) {
+	method := req.GetMethod()
+	if !s.sender.Supports(otpChannel(method)) {
+		return nil, authenticationV1.ErrorServiceUnavailable("%s sender not configured", otpChannel(method))
+	}
+
+	tid := operator.GetTenantId()
+	uid := operator.GetUserId()
+
+	entityMethod, _ := methodToEntity(method)
+	if has, err := s.mfaFactorRepo.HasEnabledMethod(ctx, tid, uid, entityMethod); err != nil {
+		return nil, authenticationV1.ErrorInternalServerError("check mfa status failed")
+	} else if has {
+		return nil, authenticationV1.ErrorBadRequest("%s already enrolled, disable it first", strings.ToLower(method.String()))
+	}
+
+	destination, err := s.resolveOtpDestination(ctx, operator, req)
+	if err != nil {
+		return nil, err
+	}
+
+	opId, err := s.mfaChallengeCache.SetOtpEnrollChallenge(ctx, method, destination, tid, uid)
+	if err != nil {
+		s.log.Errorf("set enroll challenge failed: %s", err.Error())
+		return nil, authenticationV1.ErrorInternalServerError("start enroll failed")
+	}
+	if err = s.issueOtp(ctx, opId, &data.MfaOtpChallenge{TenantID: tid, UserID: uid, Method: method}, destination); err != nil {
+		s.mfaChallengeCache.DeleteEnrollChallenge(ctx, opId)
+		return nil, err
+	}
+
+	resp := &authenticationV1.StartEnrollMethodResponse{
+		OperationId: opId,
+		ExpiresAt:   timestamppb.New(time.Now().Add(data.MfaChallengeTTL)),
+	}
+	if method == authenticationV1.MFAMethod_EMAIL {
+		resp.Result = &authenticationV1.StartEnrollMethodResponse_Email{Email: emailOtpResult(opId, destination)}
+	} else {
+		resp.Result = &authenticationV1.StartEnrollMethodResponse_Sms{Sms: smsOtpResult(opId, destination)}
+	}
+	return resp, nil
+}
+
+// confirmOtpEnroll 校验注册验证码，通过则落库因子。
+// 验证码错误与 TOTP 首码错误一致返回 success=false；错误次数达上限时作废注册上下文。
+func (s *MfaService) confirmOtpEnroll(ctx context.Context, req *authenticationV1.ConfirmEnrollMethodRequest, enrollCtx *data.MfaEnrollChallengeContext) (*authenticationV1.ConfirmEnrollMethodResponse, error) {
+	code := otpCodeFromEnroll(req)
+	if code == "" {
+		return nil, authenticationV1.ErrorBadRequest("verification code required")
+	}
+
+	_, err := s.mfaChallengeCache.VerifyOtpChallenge(ctx, req.GetOperationId(), enrollCtx.TenantID, enrollCtx.UserID, enrollCtx.OtpMethod, code)
+	switch {
+	case errors.Is(err, data.ErrMfaOtpMismatch):
+		return &authenticationV1.ConfirmEnrollMethodResponse{Success: false}, nil
+	case errors.Is(err, data.ErrMfaOtpLocked):
+		s.mfaChallengeCache.DeleteEnrollChallenge(ctx, req.GetOperationId())
+		return nil, authenticationV1.ErrorTooManyRequests("too many invalid verification codes, retry later")
+	case errors.Is(err, data.ErrMfaChallengeNotFound):
+		return nil, authenticationV1.ErrorBadRequest("verification code expired, restart enroll")
+	case err != nil:
+		return nil, authenticationV1.ErrorInternalServerError("verify code failed")
+	}
+
+	method, _ := methodToEntity(enrollCtx.OtpMethod)
+	display := req.GetDisplay()
+	if display == "" {
+		display = maskOtpDestination(enrollCtx.OtpMethod, enrollCtx.Destination)
+	}
+	factorId, err := s.mfaFactorRepo.CreateOtpFactor(ctx, enrollCtx.TenantID, enrollCtx.UserID, method, enrollCtx.Destination, display)
+	if err != nil {
+		return nil, authenticationV1.ErrorInternalServerError("create mfa factor failed")
+	}
+	s.mfaChallengeCache.DeleteEnrollChallenge(ctx, req.GetOperationId())
+	return &authenticationV1.ConfirmEnrollMethodResponse{
+		Success:      true,
+		CredentialId: fmt.Sprintf("%d", factorId),
+	}, nil
+}
+
+// startOtpChallenge 向登录用户已绑定的手机号/邮箱下发登录验证码。
+func (s *MfaService) startOtpChallenge(ctx context.Context, opId string, tid, uid uint32, method authenticationV1.MFAMethod) (*authenticationV1.StartMFAChallengeResponse, error) {
+	if !s.sender.Supports(otpChannel(method)) {
+		return nil, authenticationV1.ErrorServiceUnavailable("%s sender not configured", otpChannel(method))
+	}
+
+	entityMethod, _ := methodToEntity(method)
+	factorId, destination, err := s.mfaFactorRepo.FindEnabledOtpFactor(ctx, tid, uid, entityMethod)
+	if err != nil {
+		return nil, authenticationV1.ErrorBadRequest("no %s factor enrolled", strings.ToLower(method.String()))
+	}
+
+	if err = s.issueOtp(ctx, opId, &data.MfaOtpChallenge{TenantID: tid, UserID: uid, Method: method, FactorID: factorId}, destination); err != nil {
+		return nil, err
+	}
+
+	resp := &authenticationV1.StartMFAChallengeResponse{
+		OperationId: opId,
+		ExpiresAt:   timestamppb.New(time.Now().Add(data.MfaChallengeTTL)),
+	}
+	if method == authenticationV1.MFAMethod_EMAIL {
+		resp.Challenge = &authenticationV1.StartMFAChallengeResponse_Email{Email: emailOtpResult(opId, destination)}
+	} else {
+		resp.Challenge = &authenticationV1.StartMFAChallengeResponse_Sms{Sms: smsOtpResult(opId, destination)}
+	}
+	return resp, nil
+}
+
+// verifyOtpChallenge 校验登录挑战的短信/邮件验证码，返回所用因子 ID。
+func (s *MfaService) verifyOtpChallenge(ctx context.Context, opId string, tid, uid uint32, method authenticationV1.MFAMethod, code string) (uint32, error) {
+	if code == "" {
+		return 0, authenticationV1.ErrorBadRequest("verification code required")
+	}
+
+	entityMethod, _ := methodToEntity(method)
+	factorId, _, err := s.mfaFactorRepo.FindEnabledOtpFactor(ctx, tid, uid, entityMethod)
+	if err != nil {
+		s.log.Errorf("find %s factor for login mfa failed uid=%d: %s", method, uid, err.Error())
+		return 0, errMfaFactorMissing
+	}
+
+	otpChallenge, err := s.mfaChallengeCache.VerifyOtpChallenge(ctx, opId, tid, uid, method, code)
+	switch {
+	case errors.Is(err, data.ErrMfaOtpMismatch):
+		return 0, errMfaResponseInvalid
+	case errors.Is(err, data.ErrMfaOtpLocked):
+		// 因子锁定：作废登录挑战，锁定期后重新登录
+		s.mfaChallengeCache.ConsumeLoginChallenge(ctx, opId)
+		return 0, authenticationV1.ErrorTooManyRequests("too many invalid verification codes, please login again later")
+	case errors.Is(err, data.ErrMfaChallengeNotFound):
+		return 0, authenticationV1.ErrorBadRequest("verification code not sent or expired")
+	case err != nil:
+		return 0, authenticationV1.ErrorInternalServerError("mfa verification failed")
+	}
+	// 验证码下发后因子被重新绑定：旧手机号/邮箱上的验证码不再有效
+	if otpChallenge.FactorID != factorId {
+		return 0, errMfaFactorMissing
+	}
+	return factorId, nil
+}
+
+// issueOtp 检查锁定与重发冷却后生成验证码、写入缓存并投递。
+func (s *MfaService) issueOtp(ctx context.Context, opId string, challenge *data.MfaOtpChallenge, destination string) error {
+	if s.mfaChallengeCache.IsOtpLocked(ctx, challenge.TenantID, challenge.UserID, challenge.Method) {
+		return authenticationV1.ErrorTooManyRequests("too many invalid verification codes, retry later")
+	}
+	if ok, retryAfter := s.mfaChallengeCache.TryAcquireOtpResend(ctx, challenge.TenantID, challenge.UserID, challenge.Method); !ok {
+		return authenticationV1.ErrorTooManyRequests("verification code sent too frequently, retry in %d seconds", int(retryAfter.Seconds()+0.5))
+	}
+
+	code, err := newOtpCode()
+	if err != nil {
+		s.log.Errorf("generate otp code failed: %s", err.Error())
+		return authenticationV1.ErrorInternalServerError("generate verification code failed")
+	}
+	if err = s.mfaChallengeCache.SetOtpChallenge(ctx, opId, challenge, code); err != nil {
+		return authenticationV1.ErrorInternalServerError("send verification code failed")
+	}
+
+	if err = s.sender.Send(ctx, buildOtpMessage(challenge.Method, destination, code)); err != nil {
+		s.log.Errorf("send %s otp to user [%d] failed: %s", challenge.Method, challenge.UserID, err.Error())
+		return authenticationV1.ErrorServiceUnavailable("send verification code failed")
+	}
+	return nil
+}
+
+// resolveOtpDestination 取注册用的手机号/邮箱：请求显式传入优先，否则取账号资料中绑定的值。
+func (s *MfaService) resolveOtpDestination(ctx context.Context, operator *authenticationV1.UserTokenPayload, req *authenticationV1.StartEnrollMethodRequest) (string, error) {
+	isEmail := req.GetMethod() == authenticationV1.MFAMethod_EMAIL
+
+	destination := strings.TrimSpace(req.GetPhone())
+	if isEmail {
+		destination = strings.TrimSpace(req.GetEmail())
+	}
+	if destination == "" && s.authnService != nil {
+		user, err := s.authnService.userRepo.Get(ctx, &identityV1.GetUserRequest{QueryBy: &identityV1.GetUserRequest_Id{Id: operator.GetUserId()}})
+		if err != nil {
+			return "", authenticationV1.ErrorInternalServerError("query user failed")
+		}
+		destination = user.GetMobile()
+		if isEmail {
+			destination = user.GetEmail()
+		}
+	}
+
+	if isEmail {
+		if destination == "" {
+			return "", authenticationV1.ErrorBadRequest("email required")
+		}
+		if addr, err := mail.ParseAddress(destination); err != nil || addr.Address != destination {
+			return "", authenticationV1.ErrorBadRequest("invalid email")
+		}
+		return destination, nil
+	}
+
+	if destination == "" {
+		return "", authenticationV1.ErrorBadRequest("phone required")
+	}
+	if !isValidPhone(destination) {
+		return "", authenticationV1.ErrorBadRequest("invalid phone")
+	}
+	return destination, nil
+}
+
+// otpCodeFromEnroll 取注册确认请求中的验证码（短信或邮件）。
+func otpCodeFromEnroll(req *authenticationV1.ConfirmEnrollMethodRequest) string {
+	if req.GetMethod() == authenticationV1.MFAMethod_EMAIL {
+		return strings.TrimSpace(req.GetEmail().GetCode())
+	}
+	return strings.TrimSpace(req.GetSms().GetCode())
+}
+
+// buildOtpMessage 组装验证码消息；Params 供基于模板的短信网关使用。
+func buildOtpMessage(method authenticationV1.MFAMethod, destination, code string) *sender.Message {
+	minutes := fmt.Sprintf("%d", int(data.MfaChallengeTTL.Minutes()))
+	return &sender.Message{
+		Channel: otpChannel(method),
+		To:      destination,
+		Subject: fmt.Sprintf("%s 验证码", mfaTotpIssuer),
+		Body:    fmt.Sprintf("【%s】您的验证码为 %s，%s 分钟内有效。如非本人操作，请忽略并及时修改密码。", mfaTotpIssuer, code, minutes),
+		Params: map[string]string{
+			"code":        code,
+			"ttl_minutes": minutes,
+		},
+	}
+}
+
+func smsOtpResult(opId, phone string) *authenticationV1.SMSResult {
+	return &authenticationV1.SMSResult{
+		VerificationId:     opId,
+		SmsSent:            true,
+		MaskedPhone:        maskPhone(phone),
+		ResendAfterSeconds: int32(data.MfaOtpResendCooldown.Seconds()),
+	}
+}
+
+func emailOtpResult(opId, email string) *authenticationV1.EmailResult {
+	return &authenticationV1.EmailResult{
+		VerificationId:     opId,
+		EmailSent:          true,
+		MaskedEmail:        maskEmail(email),
+		ResendAfterSeconds: int32(data.MfaOtpResendCooldown.Seconds()),
+	}
+}
+
+// newOtpCode 生成定长数字验证码（加密随机源）。
+func newOtpCode() (string, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(1_000_000))
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%0*d", mfaOtpDigits, n.Int64()), nil
+}
+
+// isValidPhone 手机号格式粗校验：可选 "+" 前缀，6~20 位数字。
+func isValidPhone(phone string) bool {
+	digits := strings.TrimPrefix(phone, "+")
+	if len(digits) < 6 || len(digits) > 20 {
+		return false
+	}
+	for _, c := range digits {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func maskOtpDestination(method authenticationV1.MFAMethod, destination string) string {
+	if method == authenticationV1.MFAMethod_EMAIL {
+		return maskEmail(destination)
+	}
+	return maskPhone(destination)
+}
+
+// maskPhone 手机号掩码：保留前 3 位与后 4 位，如 138****5678。
+func maskPhone(phone string) string {
+	if len(phone) <= 7 {
+		return strings.Repeat("*", len(phone))
+	}
+	return phone[:3] + strings.Repeat("*", len(phone)-7) + phone[len(phone)-4:]
+}
+
+// maskEmail 邮箱掩码：本地部分保留首尾字符，如 a***e@example.com。
+func maskEmail(email string) string {
+	at := strings.LastIndex(email, "@")
+	if at <= 0 {
+		return "***"
+	}
+	local := email[:at]
+	if len(local) <= 2 {
+		return local[:1] + "***" + email[at:]
+	}
+	return local[:1] + "***" + local[len(local)-1:] + email[at:]
+}
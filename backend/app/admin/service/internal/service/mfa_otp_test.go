@@ -0,0 +1,213 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tx7do/go-utils/trans"
+
+	"go-wind-admin/app/admin/service/internal/data"
+
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+)
+
+const otpTestPhone = "13812345678"
+
+// lastOtpCode 取最近一次发往指定收件人的验证码。
+func (e *webAuthnTestEnv) lastOtpCode(t *testing.T, to string) string {
+	t.Helper()
+
+	msg, ok := e.sent.Last(to)
+	require.True(t, ok)
+	require.NotEmpty(t, msg.Params["code"])
+	return msg.Params["code"]
+}
+
+func (e *webAuthnTestEnv) enrollSms(t *testing.T) {
+	t.Helper()
+
+	started, err := e.svc.StartEnrollMethod(e.ctx, &authenticationV1.StartEnrollMethodRequest{
+		Method: authenticationV1.MFAMethod_SMS,
+		Phone:  trans.Ptr(otpTestPhone),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "138****5678", started.GetSms().GetMaskedPhone())
+	assert.Equal(t, started.GetOperationId(), started.GetSms().GetVerificationId())
+
+	confirm := func(code string) *authenticationV1.ConfirmEnrollMethodResponse {
+		resp, cerr := e.svc.ConfirmEnrollMethod(e.ctx, &authenticationV1.ConfirmEnrollMethodRequest{
+			Method:      authenticationV1.MFAMethod_SMS,
+			OperationId: started.GetOperationId(),
+			Credential: &authenticationV1.ConfirmEnrollMethodRequest_Sms{Sms: &authenticationV1.SMSVerification{
+				VerificationId: started.GetOperationId(),
+				Code:           code,
+			}},
+		})
+		require.NoError(t, cerr)
+		return resp
+	}
+
+	code := e.lastOtpCode(t, otpTestPhone)
+	wrong := "000000"
+	if code == wrong {
+		wrong = "111111"
+	}
+	assert.False(t, confirm(wrong).GetSuccess())
+	assert.True(t, confirm(code).GetSuccess())
+}
+
+func TestMfaService_SmsEnrollAndVerify(t *testing.T) {
+	env := newWebAuthnTestEnv(t, 9121)
+	env.enrollSms(t)
+
+	status, err := env.svc.GetMFAStatus(env.ctx, &authenticationV1.GetMFAStatusRequest{})
+	require.NoError(t, err)
+	assert.True(t, status.GetEnabled())
+	require.Len(t, status.GetEnrolled(), 1)
+	assert.Equal(t, authenticationV1.MFAMethod_SMS, status.GetEnrolled()[0].GetMethod())
+	assert.Equal(t, "138****5678", status.GetEnrolled()[0].GetDisplay())
+
+	// 已绑定时拒绝重复注册
+	env.mr.FastForward(data.MfaOtpResendCooldown)
+	_, err = env.svc.StartEnrollMethod(env.ctx, &authenticationV1.StartEnrollMethodRequest{Method: authenticationV1.MFAMethod_SMS, Phone: trans.Ptr(otpTestPhone)})
+	require.Error(t, err)
+	assert.Equal(t, 400, int(errors.Code(err)))
+
+	opId, err := env.cache.SetLoginChallenge(context.Background(), env.operator, authenticationV1.ClientType_admin)
+	require.NoError(t, err)
+	start := func() error {
+		_, serr := env.svc.StartMFAChallenge(context.Background(), &authenticationV1.StartMFAChallengeRequest{
+			Method:      authenticationV1.MFAMethod_SMS,
+			OperationId: trans.Ptr(opId),
+		})
+		return serr
+	}
+	verify := func(code string) (*authenticationV1.LoginResponse, error) {
+		return env.svc.VerifyMFAChallenge(context.Background(), &authenticationV1.VerifyMFAChallengeRequest{
+			OperationId: opId,
+			Response:    &authenticationV1.VerifyMFAChallengeRequest_Sms{Sms: &authenticationV1.SMSVerification{Code: code}},
+		})
+	}
+
+	// 未下发验证码前校验
+	_, err = verify("123456")
+	require.Error(t, err)
+	assert.Equal(t, 400, int(errors.Code(err)))
+
+	require.NoError(t, start())
+	first := env.lastOtpCode(t, otpTestPhone)
+
+	// 重发冷却
+	err = start()
+	require.Error(t, err)
+	assert.Equal(t, 429, int(errors.Code(err)))
+
+	// 冷却后重发：旧码作废
+	env.mr.FastForward(data.MfaOtpResendCooldown)
+	require.NoError(t, start())
+	second := env.lastOtpCode(t, otpTestPhone)
+	if first != second {
+		_, err = verify(first)
+		require.Error(t, err)
+		assert.Equal(t, 403, int(errors.Code(err)))
+	}
+
+	resp, err := verify(second)
+	require.NoError(t, err)
+	assert.NotEmpty(t, resp.GetAccessToken())
+}
+
+func TestMfaService_OtpFactorLocked(t *testing.T) {
+	env := newWebAuthnTestEnv(t, 9122)
+	env.enrollSms(t)
+
+	// 失败次数跨挑战按因子累计：每个登录挑战最多错 MaxLoginChallengeFailures 次
+	failures := 0
+	for failures < data.MaxOtpFailures {
+		opId, err := env.cache.SetLoginChallenge(context.Background(), env.operator, authenticationV1.ClientType_admin)
+		require.NoError(t, err)
+		env.mr.FastForward(data.MfaOtpResendCooldown)
+		_, err = env.svc.StartMFAChallenge(context.Background(), &authenticationV1.StartMFAChallengeRequest{
+			Method:      authenticationV1.MFAMethod_SMS,
+			OperationId: trans.Ptr(opId),
+		})
+		require.NoError(t, err)
+		code := env.lastOtpCode(t, otpTestPhone)
+		wrong := "000000"
+		if code == wrong {
+			wrong = "111111"
+		}
+
+		for i := 0; i < data.MaxLoginChallengeFailures && failures < data.MaxOtpFailures; i++ {
+			_, err = env.svc.VerifyMFAChallenge(context.Background(), &authenticationV1.VerifyMFAChallengeRequest{
+				OperationId: opId,
+				Response:    &authenticationV1.VerifyMFAChallengeRequest_Sms{Sms: &authenticationV1.SMSVerification{Code: wrong}},
+			})
+			require.Error(t, err)
+			failures++
+		}
+		if failures == data.MaxOtpFailures {
+			assert.Equal(t, 429, int(errors.Code(err)))
+		}
+	}
+
+	// 锁定期内拒绝再下发
+	opId, err := env.cache.SetLoginChallenge(context.Background(), env.operator, authenticationV1.ClientType_admin)
+	require.NoError(t, err)
+	env.mr.FastForward(data.MfaOtpResendCooldown)
+	_, err = env.svc.StartMFAChallenge(context.Background(), &authenticationV1.StartMFAChallengeRequest{
+		Method:      authenticationV1.MFAMethod_SMS,
+		OperationId: trans.Ptr(opId),
+	})
+	require.Error(t, err)
+	assert.Equal(t, 429, int(errors.Code(err)))
+
+	// 锁定窗口过后恢复
+	env.mr.FastForward(data.MfaOtpLockWindow)
+	opId, err = env.cache.SetLoginChallenge(context.Background(), env.operator, authenticationV1.ClientType_admin)
+	require.NoError(t, err)
+	_, err = env.svc.StartMFAChallenge(context.Background(), &authenticationV1.StartMFAChallengeRequest{
+		Method:      authenticationV1.MFAMethod_SMS,
+		OperationId: trans.Ptr(opId),
+	})
+	require.NoError(t, err)
+}
+
+func TestMfaService_EmailEnrollValidation(t *testing.T) {
+	env := newWebAuthnTestEnv(t, 9123)
+
+	_, err := env.svc.StartEnrollMethod(env.ctx, &authenticationV1.StartEnrollMethodRequest{
+		Method: authenticationV1.MFAMethod_EMAIL,
+		Email:  trans.Ptr("Alice <alice@example.com>"),
+	})
+	require.Error(t, err)
+	assert.Equal(t, 400, int(errors.Code(err)))
+
+	env.mr.FastForward(data.MfaOtpResendCooldown)
+	started, err := env.svc.StartEnrollMethod(env.ctx, &authenticationV1.StartEnrollMethodRequest{
+		Method: authenticationV1.MFAMethod_EMAIL,
+		Email:  trans.Ptr("alice@example.com"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "a***e@example.com", started.GetEmail().GetMaskedEmail())
+
+	// 邮件注册上下文不能被当作短信确认
+	_, err = env.svc.ConfirmEnrollMethod(env.ctx, &authenticationV1.ConfirmEnrollMethodRequest{
+		Method:      authenticationV1.MFAMethod_SMS,
+		OperationId: started.GetOperationId(),
+		Credential:  &authenticationV1.ConfirmEnrollMethodRequest_Sms{Sms: &authenticationV1.SMSVerification{Code: env.lastOtpCode(t, "alice@example.com")}},
+	})
+	require.Error(t, err)
+	assert.Equal(t, 400, int(errors.Code(err)))
+
+	resp, err := env.svc.ConfirmEnrollMethod(env.ctx, &authenticationV1.ConfirmEnrollMethodRequest{
+		Method:      authenticationV1.MFAMethod_EMAIL,
+		OperationId: started.GetOperationId(),
+		Credential:  &authenticationV1.ConfirmEnrollMethodRequest_Email{Email: &authenticationV1.EmailVerification{Code: env.lastOtpCode(t, "alice@example.com")}},
+	})
+	require.NoError(t, err)
+	assert.True(t, resp.GetSuccess())
+}
@@ -0,0 +1,182 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/tx7do/go-crud/viewer"
+	"github.com/tx7do/go-utils/trans"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"go-wind-admin/app/admin/service/internal/data/ent/privacy"
+
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+
+	"go-wind-admin/pkg/middleware/auth"
+)
+
+// MFA 策略与登录强制注册：
+//   - 策略每个租户一条，tenant_id=0 为平台策略；登录时与用户所在租户策略取较严格者（data.MfaPolicyRepo.Resolve）；
+//   - 要求启用而用户尚无因子时，登录闸门签发强制注册挑战（LoginResponse.mfa_enrollment_required）；
+//   - 用户凭其 operation_id 调用 StartLoginEnrollment/ConfirmLoginEnrollment 注册，成功后挑战转为普通登录挑战，
+//     再以同一 operation_id 走 StartMFAChallenge/VerifyMFAChallenge 换取真 token。
+
+const (
+	// mfaPolicyMaxRoleCodes 单条策略可列出的角色代码上限。
+	mfaPolicyMaxRoleCodes = 32
+	// mfaPolicyMaxRoleCodeLen 角色代码长度上限（与角色表 code 字段一致）。
+	mfaPolicyMaxRoleCodeLen = 64
+)
+
+// policyTenant 租户维度策略（MFA、密码策略）的归属租户：租户用户恒为本租户，平台用户可指定任意租户（不指定为平台策略）。
+func policyTenant(operator *authenticationV1.UserTokenPayload, requested *uint32) (uint32, error) {
+	if tid := operator.GetTenantId(); tid != 0 {
+		if requested != nil && *requested != tid {
+			return 0, authenticationV1.ErrorForbidden("cannot access policy of other tenant")
+		}
+		return tid, nil
+	}
+	if requested != nil {
+		return *requested, nil
+	}
+	return 0, nil
+}
+
+// normalizeRoleCodes 去空白、去重并校验角色代码列表。
+func normalizeRoleCodes(codes []string) ([]string, error) {
+	seen := make(map[string]bool, len(codes))
+	out := make([]string, 0, len(codes))
+	for _, code := range codes {
+		code = strings.TrimSpace(code)
+		if code == "" || seen[code] {
+			continue
+		}
+		if len(code) > mfaPolicyMaxRoleCodeLen {
+			return nil, authenticationV1.ErrorBadRequest("role code too long: %s", code)
+		}
+		seen[code] = true
+		out = append(out, code)
+	}
+	if len(out) > mfaPolicyMaxRoleCodes {
+		return nil, authenticationV1.ErrorBadRequest("at most %d role codes are allowed", mfaPolicyMaxRoleCodes)
+	}
+	return out, nil
+}
+
+// GetMFAPolicy 查询 MFA 策略，未配置时返回不要求 MFA 的默认策略。
+func (s *MfaService) GetMFAPolicy(ctx context.Context, req *authenticationV1.GetMFAPolicyRequest) (*authenticationV1.MFAPolicy, error) {
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tid, err := policyTenant(operator, req.TenantId)
+	if err != nil {
+		return nil, err
+	}
+	return s.mfaPolicyRepo.Get(ctx, tid)
+}
+
+// UpdateMFAPolicy 更新 MFA 策略（不存在则创建）。
+// 只影响此后的登录：已签发的令牌不受影响，用户下次登录时按新策略判定。
+func (s *MfaService) UpdateMFAPolicy(ctx context.Context, req *authenticationV1.UpdateMFAPolicyRequest) (*emptypb.Empty, error) {
+	if req == nil || req.Data == nil {
+		return nil, authenticationV1.ErrorBadRequest("invalid request")
+	}
+
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tid, err := policyTenant(operator, req.Data.TenantId)
+	if err != nil {
+		return nil, err
+	}
+
+	switch req.Data.GetEnforcement() {
+	case authenticationV1.MFAEnforcement_MFA_NOT_REQUIRED,
+		authenticationV1.MFAEnforcement_MFA_OPTIONAL,
+		authenticationV1.MFAEnforcement_MFA_REQUIRED:
+	default:
+		return nil, authenticationV1.ErrorBadRequest("invalid enforcement")
+	}
+
+	roleCodes, err := normalizeRoleCodes(req.Data.GetRequiredRoleCodes())
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.mfaPolicyRepo.Save(ctx, &authenticationV1.MFAPolicy{
+		TenantId:          trans.Ptr(tid),
+		Enforcement:       req.Data.GetEnforcement(),
+		RequiredRoleCodes: roleCodes,
+		UpdatedBy:         trans.Ptr(operator.GetUserId()),
+	}); err != nil {
+		return nil, err
+	}
+
+	s.log.Infof("user [%d] updated mfa policy of tenant [%d]: enforcement=%s roles=%v",
+		operator.GetUserId(), tid, req.Data.GetEnforcement(), roleCodes)
+	return &emptypb.Empty{}, nil
+}
+
+// loginEnrollmentOperator 校验强制注册登录挑战，返回挑战绑定的用户身份。
+func (s *MfaService) loginEnrollmentOperator(ctx context.Context, opId string) (*authenticationV1.UserTokenPayload, error) {
+	if opId == "" {
+		return nil, authenticationV1.ErrorBadRequest("login_operation_id required")
+	}
+	challengeCtx, err := s.mfaChallengeCache.PeekLoginChallenge(ctx, opId)
+	if err != nil || challengeCtx.Payload == nil {
+		return nil, authenticationV1.ErrorBadRequest("invalid or expired mfa operation")
+	}
+	if !challengeCtx.EnrollmentRequired {
+		return nil, authenticationV1.ErrorBadRequest("mfa enrollment not required")
+	}
+	// 强制注册只属于用户本人的登录流程，代登录身份不得为被代登录用户注册因子
+	if challengeCtx.Payload.GetActorUserId() != 0 {
+		return nil, authenticationV1.ErrorForbidden("operation not allowed while impersonating")
+	}
+	return challengeCtx.Payload, nil
+}
+
+// StartLoginEnrollment 登录强制注册：凭强制注册挑战开始注册 MFA 方法。
+// 免鉴权：与 VerifyMFAChallenge 相同注入 Noop viewer + privacy.Allow，身份来自挑战上下文。
+func (s *MfaService) StartLoginEnrollment(ctx context.Context, req *authenticationV1.StartEnrollMethodRequest) (*authenticationV1.StartEnrollMethodResponse, error) {
+	if !isEnrollableMethod(req.GetMethod()) {
+		return nil, authenticationV1.ErrorBadRequest("only TOTP, WEBAUTHN, SMS and EMAIL are supported")
+	}
+
+	ctx = viewer.WithContext(ctx, viewer.NewNoopContext())
+	ctx = privacy.DecisionContext(ctx, privacy.Allow)
+
+	operator, err := s.loginEnrollmentOperator(ctx, req.GetLoginOperationId())
+	if err != nil {
+		return nil, err
+	}
+	return s.startEnroll(ctx, operator, req)
+}
+
+// ConfirmLoginEnrollment 登录强制注册：确认注册，成功后挑战转为普通登录挑战。
+func (s *MfaService) ConfirmLoginEnrollment(ctx context.Context, req *authenticationV1.ConfirmEnrollMethodRequest) (*authenticationV1.ConfirmEnrollMethodResponse, error) {
+	if !isEnrollableMethod(req.GetMethod()) {
+		return nil, authenticationV1.ErrorBadRequest("only TOTP, WEBAUTHN, SMS and EMAIL are supported")
+	}
+
+	ctx = viewer.WithContext(ctx, viewer.NewNoopContext())
+	ctx = privacy.DecisionContext(ctx, privacy.Allow)
+
+	operator, err := s.loginEnrollmentOperator(ctx, req.GetLoginOperationId())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.confirmEnroll(ctx, operator, req)
+	if err != nil || !resp.GetSuccess() {
+		return resp, err
+	}
+
+	// 因子已落库：挑战若恰好过期，用户重新登录即走普通二次验证，不影响本次注册结果
+	if cerr := s.mfaChallengeCache.CompleteLoginEnrollment(ctx, req.GetLoginOperationId()); cerr != nil {
+		s.log.Warnf("complete login enrollment failed for user [%d]: %s", operator.GetUserId(), cerr.Error())
+	}
+	return resp, nil
+}
@@ -0,0 +1,130 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	otpTotp "github.com/pquerna/otp/totp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tx7do/go-utils/trans"
+
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+
+	"go-wind-admin/pkg/constants"
+)
+
+func TestMfaService_LoginEnrollment(t *testing.T) {
+	env := newWebAuthnTestEnv(t, 9131)
+
+	opId, err := env.cache.SetEnrollmentLoginChallenge(context.Background(), env.operator, authenticationV1.ClientType_admin)
+	require.NoError(t, err)
+
+	// 强制注册挑战不能直接用于二次验证
+	_, err = env.svc.VerifyMFAChallenge(context.Background(), &authenticationV1.VerifyMFAChallengeRequest{
+		OperationId: opId,
+		Response:    &authenticationV1.VerifyMFAChallengeRequest_TotpCode{TotpCode: "000000"},
+	})
+	assert.Equal(t, 400, int(errors.Code(err)))
+	_, err = env.svc.StartMFAChallenge(context.Background(), &authenticationV1.StartMFAChallengeRequest{
+		Method:      authenticationV1.MFAMethod_SMS,
+		OperationId: trans.Ptr(opId),
+	})
+	assert.Equal(t, 400, int(errors.Code(err)))
+
+	// 缺少或使用普通登录挑战均拒绝
+	_, err = env.svc.StartLoginEnrollment(context.Background(), &authenticationV1.StartEnrollMethodRequest{
+		Method: authenticationV1.MFAMethod_TOTP,
+	})
+	assert.Equal(t, 400, int(errors.Code(err)))
+	plainOpId, err := env.cache.SetLoginChallenge(context.Background(), env.operator, authenticationV1.ClientType_admin)
+	require.NoError(t, err)
+	_, err = env.svc.StartLoginEnrollment(context.Background(), &authenticationV1.StartEnrollMethodRequest{
+		Method:           authenticationV1.MFAMethod_TOTP,
+		LoginOperationId: trans.Ptr(plainOpId),
+	})
+	assert.Equal(t, 400, int(errors.Code(err)))
+
+	started, err := env.svc.StartLoginEnrollment(context.Background(), &authenticationV1.StartEnrollMethodRequest{
+		Method:           authenticationV1.MFAMethod_TOTP,
+		LoginOperationId: trans.Ptr(opId),
+	})
+	require.NoError(t, err)
+	secret := started.GetTotp().GetSecret()
+	require.NotEmpty(t, secret)
+
+	code, err := otpTotp.GenerateCode(secret, time.Now())
+	require.NoError(t, err)
+	confirmed, err := env.svc.ConfirmLoginEnrollment(context.Background(), &authenticationV1.ConfirmEnrollMethodRequest{
+		Method:           authenticationV1.MFAMethod_TOTP,
+		OperationId:      started.GetOperationId(),
+		Credential:       &authenticationV1.ConfirmEnrollMethodRequest_TotpCode{TotpCode: code},
+		LoginOperationId: trans.Ptr(opId),
+	})
+	require.NoError(t, err)
+	require.True(t, confirmed.GetSuccess())
+
+	// 注册完成后同一挑战转为普通登录挑战，不能再用于注册
+	_, err = env.svc.StartLoginEnrollment(context.Background(), &authenticationV1.StartEnrollMethodRequest{
+		Method:           authenticationV1.MFAMethod_TOTP,
+		LoginOperationId: trans.Ptr(opId),
+	})
+	assert.Equal(t, 400, int(errors.Code(err)))
+
+	resp, err := env.svc.VerifyMFAChallenge(context.Background(), &authenticationV1.VerifyMFAChallengeRequest{
+		OperationId: opId,
+		Response:    &authenticationV1.VerifyMFAChallengeRequest_TotpCode{TotpCode: code},
+	})
+	require.NoError(t, err)
+	assert.NotEmpty(t, resp.GetAccessToken())
+}
+
+func TestMfaService_MFAPolicy(t *testing.T) {
+	env := newWebAuthnTestEnv(t, 9132)
+	env.operator.TenantId = trans.Ptr(uint32(9141))
+	env.operator.Roles = []string{constants.TenantAdminRoleCode}
+	t.Cleanup(func() {
+		_, _ = env.svc.UpdateMFAPolicy(env.ctx, &authenticationV1.UpdateMFAPolicyRequest{Data: &authenticationV1.MFAPolicy{}})
+	})
+
+	// 租户用户不能操作其他租户的策略
+	_, err := env.svc.UpdateMFAPolicy(env.ctx, &authenticationV1.UpdateMFAPolicyRequest{Data: &authenticationV1.MFAPolicy{
+		TenantId:    trans.Ptr(uint32(9142)),
+		Enforcement: authenticationV1.MFAEnforcement_MFA_REQUIRED,
+	}})
+	assert.Equal(t, 403, int(errors.Code(err)))
+
+	_, err = env.svc.UpdateMFAPolicy(env.ctx, &authenticationV1.UpdateMFAPolicyRequest{Data: &authenticationV1.MFAPolicy{
+		Enforcement: authenticationV1.MFAEnforcement(99),
+	}})
+	assert.Equal(t, 400, int(errors.Code(err)))
+
+	status, err := env.svc.GetMFAStatus(env.ctx, &authenticationV1.GetMFAStatusRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, authenticationV1.MFAEnforcement_MFA_NOT_REQUIRED, status.GetEnforcement())
+
+	_, err = env.svc.UpdateMFAPolicy(env.ctx, &authenticationV1.UpdateMFAPolicyRequest{Data: &authenticationV1.MFAPolicy{
+		Enforcement:       authenticationV1.MFAEnforcement_MFA_OPTIONAL,
+		RequiredRoleCodes: []string{" " + constants.TenantAdminRoleCode, constants.TenantAdminRoleCode, ""},
+	}})
+	require.NoError(t, err)
+
+	policy, err := env.svc.GetMFAPolicy(env.ctx, &authenticationV1.GetMFAPolicyRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, uint32(9141), policy.GetTenantId())
+	assert.Equal(t, authenticationV1.MFAEnforcement_MFA_OPTIONAL, policy.GetEnforcement())
+	assert.Equal(t, []string{constants.TenantAdminRoleCode}, policy.GetRequiredRoleCodes())
+	assert.Equal(t, uint32(9132), policy.GetUpdatedBy())
+
+	// 持有策略列出的角色即要求启用
+	status, err = env.svc.GetMFAStatus(env.ctx, &authenticationV1.GetMFAStatusRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, authenticationV1.MFAEnforcement_MFA_REQUIRED, status.GetEnforcement())
+
+	env.operator.Roles = nil
+	status, err = env.svc.GetMFAStatus(env.ctx, &authenticationV1.GetMFAStatusRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, authenticationV1.MFAEnforcement_MFA_OPTIONAL, status.GetEnforcement())
+}
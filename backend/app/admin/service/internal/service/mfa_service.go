@@ -4,12 +4,15 @@
 	"bytes"
 	"context"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"image/png"
 	"strconv"
 	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
+	ktransport "github.com/go-kratos/kratos/v2/transport"
+	khttp "github.com/go-kratos/kratos/v2/transport/http"
 	"github.com/tx7do/go-utils/trans"
 	"github.com/tx7do/kratos-bootstrap/bootstrap"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -18,11 +21,11 @@
 	"go-wind-admin/app/admin/service/internal/data"
 	"go-wind-admin/app/admin/service/internal/data/ent/privacy"
 	"go-wind-admin/app/admin/service/internal/data/ent/usermfafactor"
-	khttp "github.com/go-kratos/kratos/v2/transport/http"
-	ktransport "github.com/go-kratos/kratos/v2/transport"
 
 	"go-wind-admin/pkg/middleware/auth"
 	"go-wind-admin/pkg/netutil"
+	"go-wind-admin/pkg/sender"
+	"go-wind-admin/pkg/webauthn"
 
 	"github.com/tx7do/go-crud/viewer"
 
@@ -39,37 +42,62 @@
 	mfaTotpSkew = 1
 )
 
+// 登录挑战校验结果分类，由 VerifyMFAChallenge 统一处置。
+var (
+	// errMfaFactorMissing 用户已无可用因子（验证期间被解绑/重置），作废挑战
+	errMfaFactorMissing = errors.New("mfa factor missing")
+	// errMfaResponseInvalid 验证码/断言错误，计入失败次数
+	errMfaResponseInvalid = errors.New("mfa response invalid")
+)
+
 // MfaService 实现 adminV1.MfaServiceHTTPServer。
 //
-// 本轮仅落地 TOTP：
+// 支持 TOTP、WebAuthn（安全密钥/通行密钥）与短信/邮件验证码：
 //   - 管理面（GetMFAStatus/ListEnrolledMethods/StartEnrollMethod/ConfirmEnrollMethod/DisableMFA/RevokeMFADevice）
 //     需登录态，operator 从 auth.FromContext(ctx) 取得，强制只能操作本人因子。
-//   - 登录挑战面（VerifyMFAChallenge）免鉴权，operation_id 由登录流程签发并存入
+//   - 登录挑战面（StartMFAChallenge/VerifyMFAChallenge）免鉴权，operation_id 由登录流程签发并存入
 //     MfaChallengeCache（含 UserTokenPayload + ClientType），验证通过后用 authenticator 签发真 token。
+//   - 通行密钥无密码登录（StartPasskeyLogin/FinishPasskeyLogin）免鉴权，不经密码与 MFA 闸门。
+//   - 备份码（GenerateBackupCodes/ListBackupCodes）需登录态；登录挑战中可代替 TOTP 码，每码仅可用一次。
+//   - MFA 策略（GetMFAPolicy/UpdateMFAPolicy）需登录态；策略要求启用而用户尚无因子时，登录签发强制注册挑战，
+//     用户凭其 operation_id 免鉴权注册（StartLoginEnrollment/ConfirmLoginEnrollment）后再走 VerifyMFAChallenge。
 //
-// 非 TOTP 方法、StartMFAChallenge、备份码相关 RPC 本轮返回 UNIMPLEMENTED。
+// WebAuthn 依赖方或短信/邮件投递渠道未配置时相关接口返回 SERVICE_UNAVAILABLE。
 type MfaService struct {
 	log *log.Helper
 
 	mfaFactorRepo     *data.UserMfaFactorRepo
+	mfaPolicyRepo     *data.MfaPolicyRepo
 	mfaChallengeCache *data.MfaChallengeCache
 	authenticator     *data.Authenticator
 	rateLimiter       *data.LoginRateLimiter
+	relyingParty      *webauthn.RelyingParty
+	sender            *sender.Router
+
+	authnService *AuthenticationService
 }
 
 func NewMfaService(
 	ctx *bootstrap.Context,
 	mfaFactorRepo *data.UserMfaFactorRepo,
+	mfaPolicyRepo *data.MfaPolicyRepo,
 	mfaChallengeCache *data.MfaChallengeCache,
 	authenticator *data.Authenticator,
 	rateLimiter *data.LoginRateLimiter,
+	relyingParty *webauthn.RelyingParty,
+	sender *sender.Router,
+	authnService *AuthenticationService,
 ) *MfaService {
 	return &MfaService{
 		log:               ctx.NewLoggerHelper("mfa/service/admin-service"),
 		mfaFactorRepo:     mfaFactorRepo,
+		mfaPolicyRepo:     mfaPolicyRepo,
 		mfaChallengeCache: mfaChallengeCache,
 		authenticator:     authenticator,
 		rateLimiter:       rateLimiter,
+		relyingParty:      relyingParty,
+		sender:            sender,
+		authnService:      authnService,
 	}
 }
 
@@ -85,20 +113,32 @@ func (s *MfaService) GetMFAStatus(ctx context.Context, _ *authenticationV1.GetMF
 	if err != nil {
 		return nil, authenticationV1.ErrorInternalServerError("query mfa status failed")
 	}
-	hasTotp := false
+	// 与登录 MFA 闸门（HasEnabledFactor）同口径：任一启用的主因子即视为已启用
+	hasFactor := false
 	for _, i := range infos {
-		if i.Method == authenticationV1.MFAMethod_TOTP && i.Enabled {
-			hasTotp = true
+		if i.Enabled && isEnrollableMethod(i.Method) {
+			hasFactor = true
 			break
 		}
 	}
+	// 已绑定因子即须二次验证；未绑定时按 MFA 策略报告（MFA_REQUIRED 表示下次登录将被要求注册）
+	enforcement, err := s.mfaPolicyRepo.Resolve(ctx, tid, operator.GetRoles())
+	if err != nil {
+		return nil, err
+	}
 	resp := &authenticationV1.GetMFAStatusResponse{
-		Enabled:     hasTotp,
-		Enforcement: authenticationV1.MFAEnforcement_MFA_NOT_REQUIRED,
+		Enabled:     hasFactor,
+		Enforcement: enforcement,
 	}
-	if hasTotp {
+	if hasFactor {
 		resp.Enforcement = authenticationV1.MFAEnforcement_MFA_REQUIRED
 		resp.Enrolled = buildEnrolledProto(infos)
+
+		remaining, _, cerr := s.mfaFactorRepo.CountBackupCodes(ctx, tid, uid)
+		if cerr != nil {
+			return nil, authenticationV1.ErrorInternalServerError("query mfa status failed")
+		}
+		resp.BackupCodesRemaining = int32(remaining)
 	}
 	return resp, nil
 }
@@ -118,21 +158,33 @@ func (s *MfaService) ListEnrolledMethods(ctx context.Context, _ *authenticationV
 	}, nil
 }
 
-// StartEnrollMethod 开始注册 MFA 方法。仅 TOTP 本轮实现。
+// StartEnrollMethod 开始注册 MFA 方法（TOTP / WEBAUTHN / SMS / EMAIL）。
 func (s *MfaService) StartEnrollMethod(ctx context.Context, req *authenticationV1.StartEnrollMethodRequest) (*authenticationV1.StartEnrollMethodResponse, error) {
-	if req.GetMethod() != authenticationV1.MFAMethod_TOTP {
-		return nil, authenticationV1.ErrorBadRequest("only TOTP is supported")
+	if !isEnrollableMethod(req.GetMethod()) {
+		return nil, authenticationV1.ErrorBadRequest("only TOTP, WEBAUTHN, SMS and EMAIL are supported")
 	}
 	operator, err := auth.FromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
+	return s.startEnroll(ctx, operator, req)
+}
 
+// startEnroll 为 operator 开始注册 MFA 方法，调用方已校验方法可注册。
+// operator 来自登录态（StartEnrollMethod）或强制注册登录挑战（StartLoginEnrollment）。
+func (s *MfaService) startEnroll(ctx context.Context, operator *authenticationV1.UserTokenPayload, req *authenticationV1.StartEnrollMethodRequest) (*authenticationV1.StartEnrollMethodResponse, error) {
 	// 频控：同一用户 30s 冷却期内只允许发起一次注册（防循环 Start 塞 Redis）
 	if !s.mfaChallengeCache.TryAcquireEnrollCooldown(ctx, operator.GetTenantId(), operator.GetUserId()) {
 		return nil, authenticationV1.ErrorBadRequest("enroll request too frequent, retry later")
 	}
 
+	if req.GetMethod() == authenticationV1.MFAMethod_WEBAUTHN {
+		return s.startWebAuthnEnroll(ctx, operator)
+	}
+	if isOtpMethod(req.GetMethod()) {
+		return s.startOtpEnroll(ctx, operator, req)
+	}
+
 	// 预检：已绑定 TOTP 时拒绝重复注册（(tenant,user,method) 唯一约束兜底，
 	// 但提前返回友好错误，避免 Confirm 阶段撞唯一索引报笼统 500）。
 	// 重新绑定需先 DisableMFA 解绑。
@@ -177,16 +229,20 @@ func (s *MfaService) StartEnrollMethod(ctx context.Context, req *authenticationV
 	}, nil
 }
 
-// ConfirmEnrollMethod 确认注册：校验首码通过则落库因子。
+// ConfirmEnrollMethod 确认注册：校验首码/认证器注册响应/验证码通过则落库因子。
 func (s *MfaService) ConfirmEnrollMethod(ctx context.Context, req *authenticationV1.ConfirmEnrollMethodRequest) (*authenticationV1.ConfirmEnrollMethodResponse, error) {
-	if req.GetMethod() != authenticationV1.MFAMethod_TOTP {
-		return nil, authenticationV1.ErrorBadRequest("only TOTP is supported")
+	if !isEnrollableMethod(req.GetMethod()) {
+		return nil, authenticationV1.ErrorBadRequest("only TOTP, WEBAUTHN, SMS and EMAIL are supported")
 	}
 	operator, err := auth.FromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
+	return s.confirmEnroll(ctx, operator, req)
+}
 
+// confirmEnroll 确认 operator 的注册，调用方已校验方法可注册。
+func (s *MfaService) confirmEnroll(ctx context.Context, operator *authenticationV1.UserTokenPayload, req *authenticationV1.ConfirmEnrollMethodRequest) (*authenticationV1.ConfirmEnrollMethodResponse, error) {
 	// 注册流程允许首码输错重试：peek 不消耗，落库成功才删（与登录挑战的
 	// 取出即删不同——登录挑战失败必须作废防暴破，注册有登录态且首码空间同样 10^6，
 	// 重试面由 operation TTL + 已绑定预检 + 唯一索引共同约束）
@@ -198,6 +254,16 @@ func (s *MfaService) ConfirmEnrollMethod(ctx context.Context, req *authenticatio
 	if enrollCtx.TenantID != operator.GetTenantId() || enrollCtx.UserID != operator.GetUserId() {
 		return nil, authenticationV1.ErrorForbidden("enroll operation user mismatch")
 	}
+	// 注册上下文的方法必须与本次确认一致（防 WebAuthn/验证码上下文被当作空 secret 的 TOTP 确认）
+	if enrollCtx.Method() != req.GetMethod() {
+		return nil, authenticationV1.ErrorBadRequest("enroll operation method mismatch")
+	}
+	if enrollCtx.IsWebAuthn() {
+		return s.confirmWebAuthnEnroll(ctx, operator, req, enrollCtx)
+	}
+	if isOtpMethod(enrollCtx.Method()) {
+		return s.confirmOtpEnroll(ctx, req, enrollCtx)
+	}
 
 	// 校验首码：用默认 Validate（±1 窗口，等同 ValidateCustom 的默认参数语义）
 	if !otpTotp.Validate(req.GetTotpCode(), enrollCtx.Secret) {
@@ -236,6 +302,9 @@ func (s *MfaService) VerifyMFAChallenge(ctx context.Context, req *authentication
 	if payload == nil {
 		return nil, authenticationV1.ErrorInternalServerError("mfa challenge payload missing")
 	}
+	if challengeCtx.EnrollmentRequired {
+		return nil, authenticationV1.ErrorBadRequest("mfa enrollment required")
+	}
 
 	// 审计贯通：本接口请求体无 username（中间件解析不到），无论成功失败
 	// 都通过响应头把挑战上下文中的用户名回传给登录审计中间件（兜底填充）。
@@ -248,25 +317,31 @@ func (s *MfaService) VerifyMFAChallenge(ctx context.Context, req *authentication
 	uid := payload.GetUserId()
 	tid := payload.GetTenantId()
 
-	// 取该用户 ENABLED 的 TOTP 因子并解密 secret
-	factorId, plainSecret, err := s.mfaFactorRepo.FindEnabledTotpForUser(ctx, tid, uid)
-	if err != nil {
+	// 按提交的响应类型校验：WebAuthn 断言、短信/邮件验证码、备份码或 TOTP 码
+	isWebAuthn := req.GetWebauthn() != nil
+	isBackupCode := req.GetBackupCode() != ""
+	isOtp := req.GetSms() != nil || req.GetEmail() != nil
+	var factorId uint32
+	switch {
+	case isWebAuthn:
+		factorId, err = s.verifyWebAuthnChallenge(ctx, req.GetOperationId(), tid, uid, req.GetWebauthn())
+	case isBackupCode:
+		factorId, err = s.verifyBackupCodeChallenge(ctx, tid, uid, req.GetBackupCode())
+	case req.GetSms() != nil:
+		factorId, err = s.verifyOtpChallenge(ctx, req.GetOperationId(), tid, uid, authenticationV1.MFAMethod_SMS, req.GetSms().GetCode())
+	case req.GetEmail() != nil:
+		factorId, err = s.verifyOtpChallenge(ctx, req.GetOperationId(), tid, uid, authenticationV1.MFAMethod_EMAIL, req.GetEmail().GetCode())
+	default:
+		factorId, err = s.verifyTotpChallenge(ctx, tid, uid, req.GetTotpCode())
+	}
+	switch {
+	case errors.Is(err, errMfaFactorMissing):
 		// 因子缺失（如验证期间被解绑/被管理员重置）：作废挑战走重新登录
-		s.log.Errorf("find totp factor for login mfa failed uid=%d: %s", uid, err.Error())
 		s.mfaChallengeCache.ConsumeLoginChallenge(ctx, req.GetOperationId())
 		return nil, authenticationV1.ErrorForbidden("mfa verification failed")
-	}
 
-	// 校验 TOTP 码：±1 窗口（防时钟漂移），默认周期 30s、6 位、SHA1。
-	// digits/algorithm 用常量：totp.Generate 产出的 key 恒为 6 位 SHA1，这里与之对齐。
-	ok, verr := otpTotp.ValidateCustom(req.GetTotpCode(), plainSecret, time.Now(), otpTotp.ValidateOpts{
-		Period:    30,
-		Skew:      mfaTotpSkew,
-		Digits:    otp.DigitsSix,
-		Algorithm: otp.AlgorithmSHA1,
-	})
-	if verr != nil || !ok {
-		// 错码：记失败计数，达上限作废挑战；同时计入登录限流（IP+用户名双维度）
+	case errors.Is(err, errMfaResponseInvalid):
+		// 错码/错误断言：记失败计数，达上限作废挑战；同时计入登录限流（IP+用户名双维度）
 		exceeded := s.mfaChallengeCache.RecordLoginFailure(ctx, req.GetOperationId())
 		if s.rateLimiter != nil {
 			if _, _, _, cerr := s.rateLimiter.CheckAndIncr(ctx, netutil.ClientIPFromContext(ctx), payload.GetUsername()); cerr != nil {
@@ -276,15 +351,29 @@ func (s *MfaService) VerifyMFAChallenge(ctx context.Context, req *authentication
 		if exceeded {
 			return nil, authenticationV1.ErrorForbidden("too many invalid mfa attempts, please login again")
 		}
+		if isWebAuthn {
+			return nil, authenticationV1.ErrorForbidden("invalid webauthn assertion")
+		}
+		if isBackupCode {
+			return nil, authenticationV1.ErrorForbidden("invalid backup code")
+		}
+		if isOtp {
+			return nil, authenticationV1.ErrorForbidden("invalid verification code")
+		}
 		return nil, authenticationV1.ErrorForbidden("invalid mfa code")
+
+	case err != nil:
+		return nil, err
 	}
 
 	// 通过：原子消耗挑战做最终裁决（并发同 opId 仅先抢到者发 token，防双花），
-	// 更新 last_used_at（best-effort）、清零限流，签发真 token
+	// 更新 last_used_at（best-effort；WebAuthn 随签名计数器、备份码随核销一并更新）、清零限流，签发真 token
 	if !s.mfaChallengeCache.TakeLoginChallengeAtomic(ctx, req.GetOperationId()) {
 		return nil, authenticationV1.ErrorForbidden("mfa challenge already consumed")
 	}
-	_ = s.mfaFactorRepo.UpdateLastUsed(ctx, tid, uid, factorId, time.Now())
+	if !isWebAuthn && !isBackupCode {
+		_ = s.mfaFactorRepo.UpdateLastUsed(ctx, tid, uid, factorId, time.Now())
+	}
 
 	// 兑现登录 MFA 闸门的承诺："限流清零在 VerifyMFAChallenge 通过后"。
 	// 不清零会导致绑定 MFA 的用户失败计数只增不减（密码错误与 MFA 登录成功
@@ -293,7 +382,6 @@ func (s *MfaService) VerifyMFAChallenge(ctx context.Context, req *authentication
 		s.rateLimiter.Reset(ctx, netutil.ClientIPFromContext(ctx), payload.GetUsername())
 	}
 
-
 	accessToken, refreshToken, err := s.authenticator.CreateUserToken(ctx, challengeCtx.ClientType, payload)
 	if err != nil {
 		return nil, err
@@ -308,6 +396,33 @@ func (s *MfaService) VerifyMFAChallenge(ctx context.Context, req *authentication
 	}, nil
 }
 
+// verifyTotpChallenge 校验登录挑战的 TOTP 码，返回所用因子 ID。
+func (s *MfaService) verifyTotpChallenge(ctx context.Context, tid, uid uint32, code string) (uint32, error) {
+	// 取该用户 ENABLED 的 TOTP 因子并解密 secret
+	factorId, plainSecret, err := s.mfaFactorRepo.FindEnabledTotpForUser(ctx, tid, uid)
+	if err != nil {
+		s.log.Errorf("find totp factor for login mfa failed uid=%d: %s", uid, err.Error())
+		return 0, errMfaFactorMissing
+	}
+
+	if !validateTotpCode(code, plainSecret) {
+		return 0, errMfaResponseInvalid
+	}
+	return factorId, nil
+}
+
+// validateTotpCode 校验 TOTP 码：±1 窗口（防时钟漂移），默认周期 30s、6 位、SHA1。
+// digits/algorithm 用常量：totp.Generate 产出的 key 恒为 6 位 SHA1，这里与之对齐。
+func validateTotpCode(code, secret string) bool {
+	ok, err := otpTotp.ValidateCustom(code, secret, time.Now(), otpTotp.ValidateOpts{
+		Period:    30,
+		Skew:      mfaTotpSkew,
+		Digits:    otp.DigitsSix,
+		Algorithm: otp.AlgorithmSHA1,
+	})
+	return err == nil && ok
+}
+
 // DisableMFA 禁用/移除 MFA 凭证。
 //   - 不传 user_id：操作当前登录用户本人（解绑自己的因子）。
 //   - 传 user_id 指定他人：仅平台管理员允许，用于用户认证器丢失时的救援重置
@@ -340,6 +455,7 @@ func (s *MfaService) DisableMFA(ctx context.Context, req *authenticationV1.Disab
 			if derr != nil || !ok {
 				return nil, authenticationV1.ErrorInternalServerError("disable mfa failed")
 			}
+			s.purgeOrphanBackupCodes(ctx, tid, uid)
 		} else {
 			method, merr := methodToEntity(req.GetMethod())
 			if merr != nil {
@@ -356,6 +472,7 @@ func (s *MfaService) DisableMFA(ctx context.Context, req *authenticationV1.Disab
 			if _, derr := s.mfaFactorRepo.DeleteAllByUserMethod(ctx, tid, uid, method); derr != nil {
 				return nil, authenticationV1.ErrorInternalServerError("disable mfa failed")
 			}
+			s.purgeOrphanBackupCodes(ctx, tid, uid)
 		}
 
 		s.log.Warnf("admin [%d] reset mfa for user [%d], reason=%s", operator.GetUserId(), target, req.GetReason())
@@ -376,6 +493,7 @@ func (s *MfaService) DisableMFA(ctx context.Context, req *authenticationV1.Disab
 		if n == 0 {
 			return nil, authenticationV1.ErrorNotFound("mfa credential not found")
 		}
+		s.purgeOrphanBackupCodes(ctx, operator.GetTenantId(), operator.GetUserId())
 		return &emptypb.Empty{}, nil
 	}
 	factorId, err := parseFactorId(req.GetCredentialId())
@@ -389,6 +507,7 @@ func (s *MfaService) DisableMFA(ctx context.Context, req *authenticationV1.Disab
 	if !ok {
 		return nil, authenticationV1.ErrorNotFound("mfa credential not found")
 	}
+	s.purgeOrphanBackupCodes(ctx, operator.GetTenantId(), operator.GetUserId())
 	return &emptypb.Empty{}, nil
 }
 
@@ -409,6 +528,7 @@ func (s *MfaService) RevokeMFADevice(ctx context.Context, req *authenticationV1.
 	if !ok {
 		return nil, authenticationV1.ErrorNotFound("mfa credential not found")
 	}
+	s.purgeOrphanBackupCodes(ctx, operator.GetTenantId(), operator.GetUserId())
 	return &emptypb.Empty{}, nil
 }
 
@@ -436,11 +556,31 @@ func buildEnrolledProto(infos []data.EnrolledFactorInfo) []*authenticationV1.Enr
 	return out
 }
 
-// methodToEntity 将 proto MFAMethod 映射为 ent 因子方法枚举（仅 TOTP 本轮支持）。
+// isEnrollableMethod 是否为可注册的主因子方法（与 data.PrimaryMfaMethods 对应）。
+func isEnrollableMethod(m authenticationV1.MFAMethod) bool {
+	switch m {
+	case authenticationV1.MFAMethod_TOTP,
+		authenticationV1.MFAMethod_WEBAUTHN,
+		authenticationV1.MFAMethod_SMS,
+		authenticationV1.MFAMethod_EMAIL:
+		return true
+	}
+	return false
+}
+
+// methodToEntity 将 proto MFAMethod 映射为 ent 因子方法枚举（支持 TOTP、WEBAUTHN、SMS、EMAIL 与 BACKUP_CODE）。
 func methodToEntity(m authenticationV1.MFAMethod) (usermfafactor.Method, error) {
 	switch m {
 	case authenticationV1.MFAMethod_TOTP:
 		return usermfafactor.MethodTotp, nil
+	case authenticationV1.MFAMethod_WEBAUTHN:
+		return usermfafactor.MethodWebauthn, nil
+	case authenticationV1.MFAMethod_SMS:
+		return usermfafactor.MethodSms, nil
+	case authenticationV1.MFAMethod_EMAIL:
+		return usermfafactor.MethodEmail, nil
+	case authenticationV1.MFAMethod_BACKUP_CODE:
+		return usermfafactor.MethodBackupCode, nil
 	}
 	return "", fmt.Errorf("unsupported mfa method: %v", m)
 }
@@ -0,0 +1,400 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	ktransport "github.com/go-kratos/kratos/v2/transport"
+	khttp "github.com/go-kratos/kratos/v2/transport/http"
+	"github.com/tx7do/go-crud/viewer"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go-wind-admin/app/admin/service/internal/data"
+	"go-wind-admin/app/admin/service/internal/data/ent/privacy"
+
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+	identityV1 "go-wind-admin/api/gen/go/identity/service/v1"
+
+	"go-wind-admin/pkg/netutil"
+	"go-wind-admin/pkg/webauthn"
+)
+
+// WebAuthn（安全密钥/通行密钥）相关流程：
+//   - 注册：StartEnrollMethod 下发注册仪式参数，ConfirmEnrollMethod 校验认证器注册响应后落库凭证；
+//   - 登录二次验证：StartMFAChallenge 为登录挑战下发断言参数，VerifyMFAChallenge 校验断言；
+//   - 无密码登录：StartPasskeyLogin / FinishPasskeyLogin，认证器回传的用户句柄定位用户。
+//
+// 签名计数器回退（或并发断言中未能推进）视为凭证被克隆：立即停用该凭证并拒绝本次验证。
+
+// startWebAuthnEnroll 开始 WebAuthn 注册：已注册的凭证全部列入排除列表。
+func (s *MfaService) startWebAuthnEnroll(ctx context.Context, operator *authenticationV1.UserTokenPayload) (*authenticationV1.StartEnrollMethodResponse, error) {
+	if s.relyingParty == nil {
+		return nil, authenticationV1.ErrorServiceUnavailable("webauthn not configured")
+	}
+
+	factors, err := s.mfaFactorRepo.ListWebAuthnFactors(ctx, operator.GetTenantId(), operator.GetUserId())
+	if err != nil {
+		return nil, authenticationV1.ErrorInternalServerError("check mfa status failed")
+	}
+
+	user := &webauthn.User{
+		Handle:      data.WebAuthnUserHandle(operator.GetTenantId(), operator.GetUserId()),
+		Name:        operator.GetUsername(),
+		DisplayName: operator.GetUsername(),
+	}
+	for _, f := range factors {
+		user.Credentials = append(user.Credentials, f.Credential)
+	}
+
+	challenge, err := s.relyingParty.BeginRegistration(user)
+	if err != nil {
+		s.log.Errorf("begin webauthn registration failed: %s", err.Error())
+		return nil, authenticationV1.ErrorInternalServerError("start enroll failed")
+	}
+
+	opId, err := s.mfaChallengeCache.SetWebAuthnEnrollChallenge(ctx, challenge.Session, operator.GetTenantId(), operator.GetUserId())
+	if err != nil {
+		s.log.Errorf("set enroll challenge failed: %s", err.Error())
+		return nil, authenticationV1.ErrorInternalServerError("start enroll failed")
+	}
+
+	return &authenticationV1.StartEnrollMethodResponse{
+		Result: &authenticationV1.StartEnrollMethodResponse_Webauthn{
+			Webauthn: s.webAuthnResult(challenge),
+		},
+		OperationId: opId,
+		ExpiresAt:   timestamppb.New(time.Now().Add(data.MfaChallengeTTL)),
+	}, nil
+}
+
+// confirmWebAuthnEnroll 校验认证器注册响应，通过则落库凭证。
+// 响应无效时与 TOTP 首码错误一致返回 success=false，注册上下文保留至过期。
+func (s *MfaService) confirmWebAuthnEnroll(ctx context.Context, operator *authenticationV1.UserTokenPayload, req *authenticationV1.ConfirmEnrollMethodRequest, enrollCtx *data.MfaEnrollChallengeContext) (*authenticationV1.ConfirmEnrollMethodResponse, error) {
+	if s.relyingParty == nil {
+		return nil, authenticationV1.ErrorServiceUnavailable("webauthn not configured")
+	}
+	attestation := req.GetWebauthn()
+	if attestation == nil || attestation.GetAttestationObject() == "" {
+		return nil, authenticationV1.ErrorBadRequest("webauthn attestation required")
+	}
+
+	user := &webauthn.User{
+		Handle:      data.WebAuthnUserHandle(enrollCtx.TenantID, enrollCtx.UserID),
+		Name:        operator.GetUsername(),
+		DisplayName: operator.GetUsername(),
+	}
+
+	cred, err := s.relyingParty.FinishRegistration(user, enrollCtx.WebAuthnSession, &webauthn.AttestationResponse{
+		ID:                attestation.GetId(),
+		ClientDataJSON:    attestation.GetClientDataJson(),
+		AttestationObject: attestation.GetAttestationObject(),
+		Transports:        attestation.GetTransports(),
+	})
+	if err != nil {
+		s.log.Warnf("webauthn registration rejected for user [%d]: %s", enrollCtx.UserID, err.Error())
+		return &authenticationV1.ConfirmEnrollMethodResponse{Success: false}, nil
+	}
+
+	// 凭证只能归属一个账号：跨租户查重（唯一索引仅覆盖同一用户）
+	exists, err := s.mfaFactorRepo.WebAuthnCredentialExists(privacy.DecisionContext(ctx, privacy.Allow), cred.ID)
+	if err != nil {
+		return nil, authenticationV1.ErrorInternalServerError("create mfa factor failed")
+	}
+	if exists {
+		return nil, authenticationV1.ErrorBadRequest("webauthn credential already registered")
+	}
+
+	factorId, err := s.mfaFactorRepo.CreateWebAuthnFactor(ctx, enrollCtx.TenantID, enrollCtx.UserID, cred, req.GetDisplay())
+	if err != nil {
+		return nil, authenticationV1.ErrorInternalServerError("create mfa factor failed")
+	}
+	s.mfaChallengeCache.DeleteEnrollChallenge(ctx, req.GetOperationId())
+	return &authenticationV1.ConfirmEnrollMethodResponse{
+		Success:      true,
+		CredentialId: fmt.Sprintf("%d", factorId),
+	}, nil
+}
+
+// StartMFAChallenge 为登录挑战下发服务端挑战：WEBAUTHN 返回断言参数，SMS/EMAIL 下发验证码
+// （TOTP 无需服务端挑战）。
+// 免鉴权：凭登录返回的 mfa_operation_id 调用；credential_id 可指定只用某个已注册凭证（WEBAUTHN）。
+func (s *MfaService) StartMFAChallenge(ctx context.Context, req *authenticationV1.StartMFAChallengeRequest) (*authenticationV1.StartMFAChallengeResponse, error) {
+	isOtp := isOtpMethod(req.GetMethod())
+	if req.GetMethod() != authenticationV1.MFAMethod_WEBAUTHN && !isOtp {
+		return nil, authenticationV1.ErrorBadRequest("only WEBAUTHN, SMS and EMAIL require a challenge")
+	}
+	if !isOtp && s.relyingParty == nil {
+		return nil, authenticationV1.ErrorServiceUnavailable("webauthn not configured")
+	}
+
+	// 与 VerifyMFAChallenge 相同：白名单接口无 viewer，注入 Noop viewer + privacy.Allow
+	ctx = viewer.WithContext(ctx, viewer.NewNoopContext())
+	ctx = privacy.DecisionContext(ctx, privacy.Allow)
+
+	opId := req.GetOperationId()
+	challengeCtx, err := s.mfaChallengeCache.PeekLoginChallenge(ctx, opId)
+	if err != nil || challengeCtx.Payload == nil {
+		return nil, authenticationV1.ErrorBadRequest("invalid or expired mfa operation")
+	}
+	if challengeCtx.EnrollmentRequired {
+		return nil, authenticationV1.ErrorBadRequest("mfa enrollment required")
+	}
+	tid := challengeCtx.Payload.GetTenantId()
+	uid := challengeCtx.Payload.GetUserId()
+
+	if isOtp {
+		return s.startOtpChallenge(ctx, opId, tid, uid, req.GetMethod())
+	}
+
+	var onlyFactorId uint32
+	if req.GetCredentialId() != "" {
+		if onlyFactorId, err = parseFactorId(req.GetCredentialId()); err != nil {
+			return nil, authenticationV1.ErrorBadRequest("invalid credential id")
+		}
+	}
+
+	user, _, err := s.loadWebAuthnUser(ctx, tid, uid, onlyFactorId)
+	if err != nil {
+		return nil, authenticationV1.ErrorInternalServerError("start mfa challenge failed")
+	}
+	if len(user.Credentials) == 0 {
+		return nil, authenticationV1.ErrorBadRequest("no webauthn credential enrolled")
+	}
+
+	challenge, err := s.relyingParty.BeginLogin(user)
+	if err != nil {
+		s.log.Errorf("begin webauthn login failed: %s", err.Error())
+		return nil, authenticationV1.ErrorInternalServerError("start mfa challenge failed")
+	}
+	if err = s.mfaChallengeCache.SetLoginWebAuthnSession(ctx, opId, challenge.Session); err != nil {
+		return nil, authenticationV1.ErrorInternalServerError("start mfa challenge failed")
+	}
+
+	return &authenticationV1.StartMFAChallengeResponse{
+		Challenge: &authenticationV1.StartMFAChallengeResponse_Webauthn{
+			Webauthn: s.webAuthnResult(challenge),
+		},
+		OperationId: opId,
+		ExpiresAt:   timestamppb.New(time.Now().Add(data.MfaChallengeTTL)),
+	}, nil
+}
+
+// verifyWebAuthnChallenge 校验登录挑战的 WebAuthn 断言，返回所用因子 ID。
+// 断言会话单次有效，校验失败后需重新 StartMFAChallenge。
+func (s *MfaService) verifyWebAuthnChallenge(ctx context.Context, opId string, tid, uid uint32, assertion *authenticationV1.WebAuthnAssertion) (uint32, error) {
+	if s.relyingParty == nil {
+		return 0, authenticationV1.ErrorServiceUnavailable("webauthn not configured")
+	}
+
+	user, factorIds, err := s.loadWebAuthnUser(ctx, tid, uid, 0)
+	if err != nil {
+		return 0, authenticationV1.ErrorInternalServerError("mfa verification failed")
+	}
+	if len(user.Credentials) == 0 {
+		s.log.Errorf("no enabled webauthn factor for login mfa uid=%d", uid)
+		return 0, errMfaFactorMissing
+	}
+
+	session, err := s.mfaChallengeCache.TakeLoginWebAuthnSession(ctx, opId)
+	if err != nil {
+		return 0, authenticationV1.ErrorBadRequest("webauthn challenge not started or expired")
+	}
+
+	cred, err := s.relyingParty.FinishLogin(user, session, toAssertionResponse(assertion))
+	if err != nil && !errors.Is(err, webauthn.ErrCloneDetected) {
+		s.log.Warnf("webauthn assertion rejected for user [%d]: %s", uid, err.Error())
+		return 0, errMfaResponseInvalid
+	}
+
+	factorId := factorIds[webauthn.EncodeID(cred.ID)]
+	if err == nil {
+		err = s.advanceSignCount(ctx, tid, uid, factorId, cred.SignCount)
+	}
+	if errors.Is(err, webauthn.ErrCloneDetected) {
+		s.disableClonedFactor(ctx, tid, uid, factorId)
+		s.mfaChallengeCache.ConsumeLoginChallenge(ctx, opId)
+		return 0, authenticationV1.ErrorForbidden("authenticator clone detected, credential disabled")
+	}
+	if err != nil {
+		return 0, err
+	}
+	return factorId, nil
+}
+
+// StartPasskeyLogin 发起通行密钥无密码登录：不指定用户，由认证器选择可发现凭证。
+func (s *MfaService) StartPasskeyLogin(ctx context.Context, req *authenticationV1.StartPasskeyLoginRequest) (*authenticationV1.StartPasskeyLoginResponse, error) {
+	if s.relyingParty == nil {
+		return nil, authenticationV1.ErrorServiceUnavailable("webauthn not configured")
+	}
+
+	challenge, err := s.relyingParty.BeginDiscoverableLogin()
+	if err != nil {
+		s.log.Errorf("begin passkey login failed: %s", err.Error())
+		return nil, authenticationV1.ErrorInternalServerError("start passkey login failed")
+	}
+
+	clientType := req.GetClientType()
+	if clientType == authenticationV1.ClientType(0) {
+		clientType = s.authnService.clientType
+	}
+	opId, err := s.mfaChallengeCache.SetPasskeyLoginChallenge(ctx, challenge.Session, clientType)
+	if err != nil {
+		return nil, authenticationV1.ErrorInternalServerError("start passkey login failed")
+	}
+
+	return &authenticationV1.StartPasskeyLoginResponse{
+		OperationId: opId,
+		Webauthn:    s.webAuthnResult(challenge),
+		ExpiresAt:   timestamppb.New(time.Now().Add(data.MfaChallengeTTL)),
+	}, nil
+}
+
+// FinishPasskeyLogin 校验通行密钥断言并签发令牌。
+// 可发现凭证强制用户验证（UV），持有凭证 + 生物特征/PIN 已构成多因素，不再进入 MFA 闸门；
+// 登录策略、用户状态与"仅单点登录"租户约束照常生效。
+func (s *MfaService) FinishPasskeyLogin(ctx context.Context, req *authenticationV1.FinishPasskeyLoginRequest) (*authenticationV1.LoginResponse, error) {
+	if s.relyingParty == nil {
+		return nil, authenticationV1.ErrorServiceUnavailable("webauthn not configured")
+	}
+	if req.GetWebauthn() == nil {
+		return nil, authenticationV1.ErrorBadRequest("webauthn assertion required")
+	}
+
+	ctx = s.authnService.resetContextForLogin(ctx)
+	clientIP := netutil.ClientIPFromContext(ctx)
+
+	passkeyCtx, err := s.mfaChallengeCache.TakePasskeyLoginChallenge(ctx, req.GetOperationId())
+	if err != nil {
+		return nil, authenticationV1.ErrorBadRequest("invalid or expired passkey operation")
+	}
+
+	var (
+		tid, uid  uint32
+		factorIds map[string]uint32
+	)
+	_, cred, err := s.relyingParty.FinishDiscoverableLogin(passkeyCtx.Session, toAssertionResponse(req.GetWebauthn()),
+		func(handle []byte) (*webauthn.User, error) {
+			var perr error
+			if tid, uid, perr = data.ParseWebAuthnUserHandle(handle); perr != nil {
+				return nil, nil
+			}
+			user, ids, lerr := s.loadWebAuthnUser(ctx, tid, uid, 0)
+			if lerr != nil {
+				return nil, lerr
+			}
+			factorIds = ids
+			if len(user.Credentials) == 0 {
+				return nil, nil
+			}
+			return user, nil
+		})
+	if err != nil && !errors.Is(err, webauthn.ErrCloneDetected) {
+		s.log.Warnf("passkey login rejected: ip=%s: %s", clientIP, err.Error())
+		return nil, authenticationV1.ErrorForbidden("passkey verification failed")
+	}
+
+	factorId := factorIds[webauthn.EncodeID(cred.ID)]
+	if err == nil {
+		err = s.advanceSignCount(ctx, tid, uid, factorId, cred.SignCount)
+	}
+	if errors.Is(err, webauthn.ErrCloneDetected) {
+		s.disableClonedFactor(ctx, tid, uid, factorId)
+		return nil, authenticationV1.ErrorForbidden("authenticator clone detected, credential disabled")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.authnService.userRepo.Get(ctx, &identityV1.GetUserRequest{QueryBy: &identityV1.GetUserRequest_Id{Id: uid}})
+	if err != nil {
+		s.log.Errorf("get user by id [%d] failed [%s]", uid, err.Error())
+		return nil, err
+	}
+	// 纵深防御：用户句柄中的租户必须与用户行一致
+	if user.GetTenantId() != tid {
+		s.log.Errorf("tenant mismatch for user [%d]: passkey tenant [%d] vs user tenant [%d]", uid, tid, user.GetTenantId())
+		return nil, authenticationV1.ErrorForbidden("passkey verification failed")
+	}
+
+	// 审计贯通：请求体无 username，通过响应头回传给登录审计中间件（同 VerifyMFAChallenge）
+	if tr, tok := ktransport.FromServerContext(ctx); tok {
+		if htr, hok := tr.(*khttp.Transport); hok && isASCII(user.GetUsername()) {
+			htr.ReplyHeader().Set("X-Audit-Username", user.GetUsername())
+		}
+	}
+
+	// 租户要求仅单点登录时，本地凭证（含通行密钥）一律拒绝
+	if err = s.authnService.checkLocalPasswordAllowed(ctx, tid); err != nil {
+		return nil, err
+	}
+
+	return s.authnService.completeMultiFactorLogin(ctx, user, passkeyCtx.ClientType, req.DeviceId, clientIP)
+}
+
+// loadWebAuthnUser 加载用户 ENABLED 的 WebAuthn 凭证，onlyFactorId 非 0 时只取该因子。
+// 同时返回凭证 ID（base64url）到因子 ID 的映射，供校验后定位因子。
+func (s *MfaService) loadWebAuthnUser(ctx context.Context, tid, uid, onlyFactorId uint32) (*webauthn.User, map[string]uint32, error) {
+	factors, err := s.mfaFactorRepo.ListWebAuthnFactors(ctx, tid, uid)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	user := &webauthn.User{Handle: data.WebAuthnUserHandle(tid, uid)}
+	factorIds := make(map[string]uint32, len(factors))
+	for _, f := range factors {
+		if !f.Enabled || (onlyFactorId != 0 && f.ID != onlyFactorId) {
+			continue
+		}
+		user.Credentials = append(user.Credentials, f.Credential)
+		factorIds[webauthn.EncodeID(f.Credential.ID)] = f.ID
+	}
+	return user, factorIds, nil
+}
+
+// advanceSignCount 推进签名计数器；未能推进（并发断言已用更大计数）时返回 ErrCloneDetected。
+func (s *MfaService) advanceSignCount(ctx context.Context, tid, uid, factorId, signCount uint32) error {
+	advanced, err := s.mfaFactorRepo.AdvanceWebAuthnSignCount(ctx, tid, uid, factorId, signCount, time.Now())
+	if err != nil {
+		return authenticationV1.ErrorInternalServerError("mfa verification failed")
+	}
+	if !advanced {
+		return webauthn.ErrCloneDetected
+	}
+	return nil
+}
+
+// disableClonedFactor 停用疑似被克隆的凭证（best-effort），并记告警日志留痕。
+func (s *MfaService) disableClonedFactor(ctx context.Context, tid, uid, factorId uint32) {
+	s.log.Warnf("webauthn clone detected: tenant=%d user=%d factor=%d, disabling credential", tid, uid, factorId)
+	if factorId == 0 {
+		return
+	}
+	if err := s.mfaFactorRepo.DisableFactor(ctx, tid, uid, factorId); err != nil {
+		s.log.Errorf("disable cloned webauthn factor [%d] failed: %s", factorId, err.Error())
+	}
+}
+
+// webAuthnResult 将仪式参数转为 proto WebAuthnResult。
+func (s *MfaService) webAuthnResult(challenge *webauthn.Challenge) *authenticationV1.WebAuthnResult {
+	return &authenticationV1.WebAuthnResult{
+		Challenge:   challenge.Challenge,
+		OptionsJson: challenge.OptionsJSON,
+		RpId:        s.relyingParty.RPID(),
+	}
+}
+
+// toAssertionResponse 将 proto WebAuthnAssertion 转为断言响应。
+func toAssertionResponse(a *authenticationV1.WebAuthnAssertion) *webauthn.AssertionResponse {
+	if a == nil {
+		return nil
+	}
+	return &webauthn.AssertionResponse{
+		ID:                a.GetId(),
+		ClientDataJSON:    a.GetClientDataJson(),
+		AuthenticatorData: a.GetAuthenticatorData(),
+		Signature:         a.GetSignature(),
+		UserHandle:        a.GetUserHandle(),
+	}
+}
@@ -0,0 +1,188 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tx7do/go-utils/trans"
+
+	conf "github.com/tx7do/kratos-bootstrap/api/gen/go/conf/v1"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"go-wind-admin/app/admin/service/internal/data"
+	"go-wind-admin/app/admin/service/internal/data/enttest"
+
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+
+	"go-wind-admin/pkg/middleware/auth"
+	"go-wind-admin/pkg/sender"
+	"go-wind-admin/pkg/webauthn"
+	"go-wind-admin/pkg/webauthn/webauthntest"
+)
+
+const webAuthnTestOrigin = "https://admin.example.com"
+
+type webAuthnTestEnv struct {
+	svc      *MfaService
+	cache    *data.MfaChallengeCache
+	sent     *sender.MemorySender
+	mr       *miniredis.Miniredis
+	operator *authenticationV1.UserTokenPayload
+	ctx      context.Context
+}
+
+func newWebAuthnTestEnv(t *testing.T, userID uint32) *webAuthnTestEnv {
+	t.Helper()
+
+	mr, err := miniredis.Run()
+	require.NoError(t, err)
+	t.Cleanup(mr.Close)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+
+	bctx := bootstrap.NewContextWithParam(context.Background(), &conf.AppInfo{}, &conf.Bootstrap{
+		Authn: &conf.Authentication{
+			Jwt: &conf.Authentication_Jwt{Method: "HS256", Key: "webauthn-test-signing-key"},
+		},
+	}, log.DefaultLogger)
+	entClient := enttest.NewEntClientForTest(t)
+
+	rp, err := webauthn.New(webauthn.Config{RPID: "admin.example.com", RPOrigins: []string{webAuthnTestOrigin}})
+	require.NoError(t, err)
+
+	cache := data.NewMfaChallengeCache(bctx, rdb)
+	authenticator := data.NewAuthenticator(bctx, data.NewUserTokenCache(bctx, rdb), nil)
+	sent := sender.NewMemorySender(nil)
+	router := sender.NewRouter().Register(sender.ChannelSMS, sent).Register(sender.ChannelEmail, sent)
+	svc := NewMfaService(bctx, data.NewUserMfaFactorRepo(bctx, entClient), data.NewMfaPolicyRepo(bctx, entClient), cache, authenticator, nil, rp, router, nil)
+
+	operator := &authenticationV1.UserTokenPayload{
+		UserId:   userID,
+		TenantId: trans.Ptr(uint32(1)),
+		Username: trans.Ptr("alice"),
+	}
+	return &webAuthnTestEnv{
+		svc:      svc,
+		cache:    cache,
+		sent:     sent,
+		mr:       mr,
+		operator: operator,
+		ctx:      auth.NewContext(enttest.NewSystemViewerCtx(context.Background()), operator),
+	}
+}
+
+func (e *webAuthnTestEnv) enroll(t *testing.T, authr *webauthntest.Authenticator) {
+	t.Helper()
+
+	started, err := e.svc.StartEnrollMethod(e.ctx, &authenticationV1.StartEnrollMethodRequest{Method: authenticationV1.MFAMethod_WEBAUTHN})
+	require.NoError(t, err)
+	require.NotNil(t, started.GetWebauthn())
+	assert.Equal(t, "admin.example.com", started.GetWebauthn().GetRpId())
+
+	attestation, err := authr.Register(started.GetWebauthn().GetOptionsJson())
+	require.NoError(t, err)
+	confirmed, err := e.svc.ConfirmEnrollMethod(e.ctx, &authenticationV1.ConfirmEnrollMethodRequest{
+		Method:      authenticationV1.MFAMethod_WEBAUTHN,
+		OperationId: started.GetOperationId(),
+		Credential: &authenticationV1.ConfirmEnrollMethodRequest_Webauthn{Webauthn: &authenticationV1.WebAuthnAssertion{
+			Id:                attestation.ID,
+			ClientDataJson:    attestation.ClientDataJSON,
+			AttestationObject: trans.Ptr(attestation.AttestationObject),
+			Transports:        attestation.Transports,
+		}},
+		Display: trans.Ptr("YubiKey"),
+	})
+	require.NoError(t, err)
+	require.True(t, confirmed.GetSuccess())
+}
+
+// verify 走一次登录二次验证：签发登录挑战 → StartMFAChallenge → 认证器断言 → VerifyMFAChallenge。
+func (e *webAuthnTestEnv) verify(t *testing.T, authr *webauthntest.Authenticator) (*authenticationV1.LoginResponse, error) {
+	t.Helper()
+
+	opId, err := e.cache.SetLoginChallenge(context.Background(), e.operator, authenticationV1.ClientType_admin)
+	require.NoError(t, err)
+
+	challenge, err := e.svc.StartMFAChallenge(context.Background(), &authenticationV1.StartMFAChallengeRequest{
+		Method:      authenticationV1.MFAMethod_WEBAUTHN,
+		OperationId: trans.Ptr(opId),
+	})
+	require.NoError(t, err)
+
+	assertion, err := authr.Assert(challenge.GetWebauthn().GetOptionsJson())
+	require.NoError(t, err)
+	return e.svc.VerifyMFAChallenge(context.Background(), &authenticationV1.VerifyMFAChallengeRequest{
+		OperationId: opId,
+		Response: &authenticationV1.VerifyMFAChallengeRequest_Webauthn{Webauthn: &authenticationV1.WebAuthnAssertion{
+			Id:                assertion.ID,
+			ClientDataJson:    assertion.ClientDataJSON,
+			AuthenticatorData: assertion.AuthenticatorData,
+			Signature:         assertion.Signature,
+			UserHandle:        trans.Ptr(assertion.UserHandle),
+		}},
+	})
+}
+
+func TestMfaService_WebAuthnEnrollAndVerify(t *testing.T) {
+	env := newWebAuthnTestEnv(t, 9101)
+	authr := webauthntest.New(webAuthnTestOrigin)
+	env.enroll(t, authr)
+
+	status, err := env.svc.GetMFAStatus(env.ctx, &authenticationV1.GetMFAStatusRequest{})
+	require.NoError(t, err)
+	assert.True(t, status.GetEnabled())
+	require.Len(t, status.GetEnrolled(), 1)
+	assert.Equal(t, authenticationV1.MFAMethod_WEBAUTHN, status.GetEnrolled()[0].GetMethod())
+	assert.Equal(t, "YubiKey", status.GetEnrolled()[0].GetDisplay())
+
+	resp, err := env.verify(t, authr)
+	require.NoError(t, err)
+	assert.NotEmpty(t, resp.GetAccessToken())
+
+	// 再次发起注册：冷却期内拒绝
+	_, err = env.svc.StartEnrollMethod(env.ctx, &authenticationV1.StartEnrollMethodRequest{Method: authenticationV1.MFAMethod_WEBAUTHN})
+	require.Error(t, err)
+	assert.Equal(t, 400, int(errors.Code(err)))
+}
+
+func TestMfaService_WebAuthnCloneDetected(t *testing.T) {
+	env := newWebAuthnTestEnv(t, 9102)
+	authr := webauthntest.New(webAuthnTestOrigin)
+	env.enroll(t, authr)
+
+	_, err := env.verify(t, authr)
+	require.NoError(t, err)
+	_, err = env.verify(t, authr)
+	require.NoError(t, err)
+
+	// 克隆的认证器：计数器落后于已存值
+	authr.SignCount = 0
+	_, err = env.verify(t, authr)
+	require.Error(t, err)
+	assert.Equal(t, 403, int(errors.Code(err)))
+
+	// 凭证已停用：MFA 不再视为启用
+	status, err := env.svc.GetMFAStatus(env.ctx, &authenticationV1.GetMFAStatusRequest{})
+	require.NoError(t, err)
+	assert.False(t, status.GetEnabled())
+}
+
+func TestMfaService_WebAuthnEnrollMethodMismatch(t *testing.T) {
+	env := newWebAuthnTestEnv(t, 9103)
+
+	started, err := env.svc.StartEnrollMethod(env.ctx, &authenticationV1.StartEnrollMethodRequest{Method: authenticationV1.MFAMethod_WEBAUTHN})
+	require.NoError(t, err)
+
+	_, err = env.svc.ConfirmEnrollMethod(env.ctx, &authenticationV1.ConfirmEnrollMethodRequest{
+		Method:      authenticationV1.MFAMethod_TOTP,
+		OperationId: started.GetOperationId(),
+		Credential:  &authenticationV1.ConfirmEnrollMethodRequest_TotpCode{TotpCode: "000000"},
+	})
+	require.Error(t, err)
+	assert.Equal(t, 400, int(errors.Code(err)))
+}
@@ -0,0 +1,167 @@
+package service
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/go-kratos/kratos/v2/log"
+	paginationV1 "github.com/tx7do/go-crud/api/gen/go/pagination/v1"
+	"github.com/tx7do/go-utils/trans"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"go-wind-admin/app/admin/service/internal/data"
+
+	adminV1 "go-wind-admin/api/gen/go/admin/service/v1"
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+
+	"go-wind-admin/pkg/middleware/auth"
+	"go-wind-admin/pkg/oauth"
+	"go-wind-admin/pkg/oauth/social"
+)
+
+// OAuthProviderConfigService 第三方登录提供方配置管理（租户维度）。
+type OAuthProviderConfigService struct {
+	adminV1.OAuthProviderConfigServiceHTTPServer
+
+	log *log.Helper
+
+	repo     *data.OAuthProviderConfigRepo
+	roleRepo *data.RoleRepo
+
+	registry *social.Registry
+}
+
+func NewOAuthProviderConfigService(
+	ctx *bootstrap.Context,
+	repo *data.OAuthProviderConfigRepo,
+	roleRepo *data.RoleRepo,
+) *OAuthProviderConfigService {
+	return &OAuthProviderConfigService{
+		log:      ctx.NewLoggerHelper("oauth-provider-config/service/admin-service"),
+		repo:     repo,
+		roleRepo: roleRepo,
+		registry: social.NewRegistry(),
+	}
+}
+
+// checkRoleIds 校验自动创建用户时授予的角色均可分配；角色查询受租户隐私过滤。
+func (s *OAuthProviderConfigService) checkRoleIds(ctx context.Context, roleIds []uint32) error {
+	for _, roleId := range roleIds {
+		if _, err := s.roleRepo.CanAssignRole(ctx, roleId); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isValidEndpointURL 提供方端点须为绝对 http(s) 地址；私有化部署的提供方允许 http。
+func isValidEndpointURL(raw string) bool {
+	u, err := url.Parse(raw)
+	if err != nil || !u.IsAbs() || u.Host == "" || u.User != nil {
+		return false
+	}
+	return u.Scheme == "https" || u.Scheme == "http"
+}
+
+// checkEndpoints 校验回调地址与自定义端点格式。
+func (s *OAuthProviderConfigService) checkEndpoints(data *authenticationV1.OAuthProviderConfig) error {
+	if data.RedirectUri != nil && !oauth.IsValidRedirectURI(data.GetRedirectUri()) {
+		return adminV1.ErrorBadRequest("invalid redirect uri: %s", data.GetRedirectUri())
+	}
+	for _, endpoint := range []*string{data.AuthUrl, data.TokenUrl, data.UserinfoUrl} {
+		if endpoint != nil && *endpoint != "" && !isValidEndpointURL(*endpoint) {
+			return adminV1.ErrorBadRequest("invalid endpoint: %s", *endpoint)
+		}
+	}
+	return nil
+}
+
+func (s *OAuthProviderConfigService) List(ctx context.Context, req *paginationV1.PagingRequest) (*authenticationV1.ListOAuthProviderConfigResponse, error) {
+	return s.repo.List(ctx, req)
+}
+
+func (s *OAuthProviderConfigService) Get(ctx context.Context, req *authenticationV1.GetOAuthProviderConfigRequest) (*authenticationV1.OAuthProviderConfig, error) {
+	return s.repo.Get(ctx, req.GetId())
+}
+
+func (s *OAuthProviderConfigService) Create(ctx context.Context, req *authenticationV1.CreateOAuthProviderConfigRequest) (*emptypb.Empty, error) {
+	if req == nil || req.Data == nil {
+		return nil, adminV1.ErrorBadRequest("invalid request")
+	}
+
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	preset, providerCustom, ok := data.OAuthProviderPreset(req.Data.GetProvider(), req.Data.GetProviderCustom())
+	if !ok || !s.registry.Has(preset) {
+		return nil, adminV1.ErrorBadRequest("unsupported oauth provider")
+	}
+	// 通用 OIDC 没有默认端点
+	if preset == social.ProviderOIDC &&
+		(req.Data.GetAuthUrl() == "" || req.Data.GetTokenUrl() == "" || req.Data.GetUserinfoUrl() == "") {
+		return nil, adminV1.ErrorBadRequest("oidc provider requires auth, token and userinfo urls")
+	}
+	if req.Data.GetClientId() == "" || req.Data.GetClientSecret() == "" || req.Data.GetRedirectUri() == "" {
+		return nil, adminV1.ErrorBadRequest("client id, client secret and redirect uri are required")
+	}
+	if err = s.checkEndpoints(req.Data); err != nil {
+		return nil, err
+	}
+	if err = s.checkRoleIds(ctx, req.Data.GetDefaultRoleIds()); err != nil {
+		return nil, err
+	}
+
+	req.Data.CreatedBy = trans.Ptr(operator.UserId)
+
+	if err = s.repo.Create(ctx, preset, providerCustom, req.Data); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *OAuthProviderConfigService) Update(ctx context.Context, req *authenticationV1.UpdateOAuthProviderConfigRequest) (*emptypb.Empty, error) {
+	if req == nil || req.Data == nil {
+		return nil, adminV1.ErrorBadRequest("invalid request")
+	}
+
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 查询受租户隐私过滤：先确认配置对当前操作人可见
+	if exist, eerr := s.repo.IsExist(ctx, req.GetId()); eerr != nil {
+		return nil, eerr
+	} else if !exist {
+		return nil, adminV1.ErrorNotFound("oauth provider config not found")
+	}
+
+	if err = s.checkEndpoints(req.Data); err != nil {
+		return nil, err
+	}
+	if err = s.checkRoleIds(ctx, req.Data.GetDefaultRoleIds()); err != nil {
+		return nil, err
+	}
+
+	req.Data.UpdatedBy = trans.Ptr(operator.UserId)
+
+	if err = s.repo.Update(ctx, req.GetId(), req.Data); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *OAuthProviderConfigService) Delete(ctx context.Context, req *authenticationV1.DeleteOAuthProviderConfigRequest) (*emptypb.Empty, error) {
+	if err := s.repo.Delete(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
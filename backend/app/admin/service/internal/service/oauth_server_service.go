@@ -0,0 +1,128 @@
+package service
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/go-crud/viewer"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"go-wind-admin/app/admin/service/internal/data"
+	"go-wind-admin/app/admin/service/internal/data/ent/privacy"
+
+	adminV1 "go-wind-admin/api/gen/go/admin/service/v1"
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+
+	"go-wind-admin/pkg/middleware/auth"
+	"go-wind-admin/pkg/oauth"
+)
+
+// OAuthServerService OAuth2 授权服务端：go-wind-admin 作为身份提供方（"Sign in with GoWind"）。
+// 授权页由前端承载（用户已登录管理端），用户同意后调用 Authorize 换取一次性授权码，
+// 第三方应用再以授权码 + PKCE code_verifier 通过 AuthenticationService.Login 换取令牌。
+type OAuthServerService struct {
+	adminV1.OAuthServerServiceHTTPServer
+
+	log *log.Helper
+
+	apiClientRepo  *data.ApiClientRepo
+	oauthCodeCache *data.OAuthCodeCache
+}
+
+func NewOAuthServerService(
+	ctx *bootstrap.Context,
+	apiClientRepo *data.ApiClientRepo,
+	oauthCodeCache *data.OAuthCodeCache,
+) *OAuthServerService {
+	return &OAuthServerService{
+		log:            ctx.NewLoggerHelper("oauth/service/admin-service"),
+		apiClientRepo:  apiClientRepo,
+		oauthCodeCache: oauthCodeCache,
+	}
+}
+
+// Authorize 当前登录用户同意授权请求，签发一次性授权码。
+func (s *OAuthServerService) Authorize(ctx context.Context, req *authenticationV1.OAuthAuthorizeRequest) (*authenticationV1.OAuthAuthorizeResponse, error) {
+	if req == nil {
+		return nil, adminV1.ErrorBadRequest("invalid request")
+	}
+
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 只有第一方用户会话可以授权：服务客户端令牌没有用户身份，
+	// 带授权范围的令牌再签发授权码会让第三方应用自行转授权，
+	// 代登录令牌换出的令牌不再带有代登录标记、可刷新且不受代登录时效限制。
+	if operator.GetUserId() == 0 || operator.GetApiClientId() != 0 || operator.GetActorUserId() != 0 || len(operator.GetScopes()) > 0 {
+		return nil, adminV1.ErrorForbidden("authorization requires a user session")
+	}
+
+	if req.GetResponseType() != "code" {
+		return nil, adminV1.ErrorBadRequest("unsupported response_type")
+	}
+	if req.GetCodeChallengeMethod() != oauth.CodeChallengeMethodS256 || !oauth.IsValidCodeChallenge(req.GetCodeChallenge()) {
+		return nil, adminV1.ErrorBadRequest("invalid code_challenge")
+	}
+
+	// 客户端可能是平台客户端（tenant 0），租户用户的查询会被隐私层过滤掉，
+	// 因此与登录流程一样绕过隐私层查找，再显式校验租户归属。
+	lookupCtx := viewer.WithContext(ctx, viewer.NewNoopContext())
+	lookupCtx = privacy.DecisionContext(lookupCtx, privacy.Allow)
+
+	client, err := s.apiClientRepo.GetOAuthClient(lookupCtx, req.GetClientId())
+	if err != nil {
+		s.log.Warnf("oauth authorize: client [%s] unavailable: %s", req.GetClientId(), err.Error())
+		return nil, adminV1.ErrorBadRequest("invalid client")
+	}
+
+	// 租户客户端只服务本租户用户；平台客户端服务所有用户
+	if client.TenantID != 0 && client.TenantID != operator.GetTenantId() {
+		return nil, adminV1.ErrorBadRequest("invalid client")
+	}
+
+	if !oauth.MatchRedirectURI(req.GetRedirectUri(), client.RedirectURIs) {
+		return nil, adminV1.ErrorBadRequest("redirect_uri mismatch")
+	}
+
+	// 授权范围：未申请时授予客户端登记的全部范围；申请的范围必须是登记范围的子集。
+	// 最终范围不能为空——空范围的令牌不受作用域限制，等同于完整会话。
+	scopes := oauth.ParseScope(req.GetScope())
+	if len(scopes) == 0 {
+		scopes = client.Scopes
+	}
+	if len(scopes) == 0 || !oauth.IsSubset(scopes, client.Scopes) {
+		return nil, adminV1.ErrorBadRequest("invalid scope")
+	}
+
+	code, err := s.oauthCodeCache.Issue(ctx, &data.OAuthCodeGrant{
+		ClientID:            client.ClientID,
+		RedirectURI:         req.GetRedirectUri(),
+		Scopes:              scopes,
+		CodeChallenge:       req.GetCodeChallenge(),
+		CodeChallengeMethod: req.GetCodeChallengeMethod(),
+		UserID:              operator.GetUserId(),
+		TenantID:            operator.GetTenantId(),
+		Nonce:               req.GetNonce(),
+		AuthTime:            operator.GetAuthTime(),
+	})
+	if err != nil {
+		s.log.Errorf("issue oauth code failed: %s", err.Error())
+		return nil, adminV1.ErrorInternalServerError("issue authorization code failed")
+	}
+
+	redirectTo, err := oauth.BuildRedirectURL(req.GetRedirectUri(), code, req.GetState())
+	if err != nil {
+		return nil, adminV1.ErrorBadRequest("invalid redirect_uri")
+	}
+
+	return &authenticationV1.OAuthAuthorizeResponse{
+		Code:       code,
+		State:      req.State,
+		RedirectTo: redirectTo,
+		ExpiresIn:  int64(data.OAuthCodeTTL.Seconds()),
+		Scope:      oauth.JoinScope(scopes),
+	}, nil
+}
@@ -0,0 +1,442 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go-wind-admin/app/admin/service/internal/data"
+
+	adminV1 "go-wind-admin/api/gen/go/admin/service/v1"
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+	identityV1 "go-wind-admin/api/gen/go/identity/service/v1"
+
+	"go-wind-admin/pkg/middleware/auth"
+	"go-wind-admin/pkg/netutil"
+	"go-wind-admin/pkg/oauth"
+	"go-wind-admin/pkg/oauth/social"
+)
+
+// OAuthService 第三方登录：go-wind-admin 作为依赖方接入 GitHub、Google、钉钉、微信及通用 OIDC 提供方。
+// 登录与绑定都走授权码模式：Start 签发一次性 state 并返回提供方授权地址，
+// 前端回调页拿到 code 后调用 Complete/Confirm，服务端以 PKCE code_verifier 换取令牌并拉取用户资料。
+// 提供方令牌只用于拉取资料，不落库。
+type OAuthService struct {
+	adminV1.OAuthServiceHTTPServer
+
+	log *log.Helper
+
+	providerConfigRepo *data.OAuthProviderConfigRepo
+	socialAccountRepo  *data.SocialAccountRepo
+	stateCache         *data.OAuthStateCache
+
+	authnService *AuthenticationService
+
+	registry *social.Registry
+}
+
+func NewOAuthService(
+	ctx *bootstrap.Context,
+	providerConfigRepo *data.OAuthProviderConfigRepo,
+	socialAccountRepo *data.SocialAccountRepo,
+	stateCache *data.OAuthStateCache,
+	authnService *AuthenticationService,
+) *OAuthService {
+	return &OAuthService{
+		log:                ctx.NewLoggerHelper("oauth-social/service/admin-service"),
+		providerConfigRepo: providerConfigRepo,
+		socialAccountRepo:  socialAccountRepo,
+		stateCache:         stateCache,
+		authnService:       authnService,
+		registry:           social.NewRegistry(),
+	}
+}
+
+// newProvider 按配置构造提供方实例。
+func (s *OAuthService) newProvider(settings *data.OAuthProviderSettings) (social.Provider, error) {
+	provider, err := s.registry.New(settings.Provider, settings.Config)
+	if err != nil {
+		s.log.Errorf("build oauth provider [%s] failed: %s", settings.AccountProvider(), err.Error())
+		return nil, authenticationV1.ErrorInternalServerError("oauth provider config invalid")
+	}
+	return provider, nil
+}
+
+// resolveRedirectURI 回调地址只能是提供方配置中登记的地址：请求携带时必须与之一致，
+// 防止 state 与授权码被引导到攻击者控制的页面。
+func resolveRedirectURI(settings *data.OAuthProviderSettings, requested string) (string, error) {
+	configured := settings.Config.RedirectURL
+	if requested != "" && requested != configured {
+		return "", authenticationV1.ErrorBadRequest("redirect_uri mismatch")
+	}
+	if configured == "" {
+		return "", authenticationV1.ErrorBadRequest("redirect_uri not configured")
+	}
+	return configured, nil
+}
+
+// startAuthorization 登记授权上下文并构造提供方授权地址。
+func (s *OAuthService) startAuthorization(ctx context.Context, settings *data.OAuthProviderSettings, st *data.OAuthState) (authURL, state string, expiresAt *timestamppb.Timestamp, err error) {
+	provider, err := s.newProvider(settings)
+	if err != nil {
+		return "", "", nil, err
+	}
+
+	verifier, err := oauth.NewCodeVerifier()
+	if err != nil {
+		return "", "", nil, authenticationV1.ErrorInternalServerError("generate code verifier failed")
+	}
+	st.CodeVerifier = verifier
+
+	state, expireTime, err := s.stateCache.Issue(ctx, st)
+	if err != nil {
+		s.log.Errorf("issue oauth state failed: %s", err.Error())
+		return "", "", nil, authenticationV1.ErrorServiceUnavailable("oauth state unavailable")
+	}
+
+	authURL = provider.AuthCodeURL(state, oauth.S256CodeChallenge(verifier), st.RedirectURI)
+	return authURL, state, timestamppb.New(expireTime), nil
+}
+
+// fetchProfile 消费 state 后用授权码换取提供方令牌并拉取用户资料。
+func (s *OAuthService) fetchProfile(ctx context.Context, settings *data.OAuthProviderSettings, st *data.OAuthState, code string) (*social.Profile, error) {
+	provider, err := s.newProvider(settings)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := provider.Exchange(ctx, code, st.CodeVerifier, st.RedirectURI)
+	if err != nil {
+		s.log.Warnf("oauth [%s] exchange code failed: %s", settings.AccountProvider(), err.Error())
+		return nil, authenticationV1.ErrorBadRequest("oauth code exchange failed")
+	}
+
+	profile, err := provider.FetchProfile(ctx, token)
+	if err != nil {
+		s.log.Warnf("oauth [%s] fetch profile failed: %s", settings.AccountProvider(), err.Error())
+		return nil, authenticationV1.ErrorBadRequest("oauth fetch profile failed")
+	}
+
+	return profile, nil
+}
+
+// consumeState 取出一次性 state；不存在、已过期或用途不符统一返回同一错误。
+func (s *OAuthService) consumeState(ctx context.Context, state, purpose string) (*data.OAuthState, error) {
+	st, err := s.stateCache.Consume(ctx, state, purpose)
+	if err != nil {
+		s.log.Warnf("consume oauth state failed: %s", err.Error())
+		return nil, authenticationV1.ErrorBadRequest("invalid or expired oauth state")
+	}
+	return st, nil
+}
+
+// ListProviders 登录页列出租户已启用的第三方登录提供方（公开接口，不返回任何密钥）。
+func (s *OAuthService) ListProviders(ctx context.Context, req *authenticationV1.ListProvidersRequest) (*authenticationV1.ListProvidersResponse, error) {
+	ctx = s.authnService.resetContextForLogin(ctx)
+
+	tenantID, err := s.authnService.resolveLoginTenant(ctx, req.GetTenantCode())
+	if err != nil {
+		return nil, err
+	}
+
+	settings, err := s.providerConfigRepo.ListEnabled(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*authenticationV1.ProviderMetadata, 0, len(settings))
+	for _, st := range settings {
+		if !s.registry.Has(st.Provider) {
+			continue
+		}
+		items = append(items, &authenticationV1.ProviderMetadata{
+			Provider:       data.OAuthProviderEnum(st.Provider),
+			ProviderCustom: st.ProviderCustom,
+			DisplayName:    st.DisplayName,
+			DefaultScopes:  st.Config.Scopes,
+		})
+	}
+
+	return &authenticationV1.ListProvidersResponse{Items: items}, nil
+}
+
+// StartOAuthLogin 发起第三方登录，返回提供方授权地址。
+func (s *OAuthService) StartOAuthLogin(ctx context.Context, req *authenticationV1.StartOAuthLoginRequest) (*authenticationV1.StartOAuthLoginResponse, error) {
+	if req == nil {
+		return nil, authenticationV1.ErrorBadRequest("invalid request")
+	}
+
+	ctx = s.authnService.resetContextForLogin(ctx)
+
+	preset, providerCustom, ok := data.OAuthProviderPreset(req.GetProvider(), req.GetProviderCustom())
+	if !ok {
+		return nil, authenticationV1.ErrorBadRequest("unsupported oauth provider")
+	}
+
+	tenantID, err := s.authnService.resolveLoginTenant(ctx, req.GetTenantCode())
+	if err != nil {
+		return nil, err
+	}
+
+	settings, err := s.providerConfigRepo.GetEnabled(ctx, tenantID, preset, providerCustom)
+	if err != nil {
+		return nil, err
+	}
+
+	redirectURI, err := resolveRedirectURI(settings, "")
+	if err != nil {
+		return nil, err
+	}
+
+	authURL, state, expiresAt, err := s.startAuthorization(ctx, settings, &data.OAuthState{
+		Purpose:        data.OAuthStatePurposeLogin,
+		TenantID:       tenantID,
+		Provider:       preset,
+		ProviderCustom: providerCustom,
+		RedirectURI:    redirectURI,
+		ClientType:     int32(req.GetClientType()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &authenticationV1.StartOAuthLoginResponse{
+		AuthorizationUrl: authURL,
+		State:            state,
+		ExpiresAt:        expiresAt,
+	}, nil
+}
+
+// CompleteOAuthLogin 完成第三方登录：校验 state、换取用户资料，按绑定关系找到用户后签发令牌。
+// 未绑定的账号仅在提供方配置开启自动创建时注册新用户，否则拒绝登录。
+func (s *OAuthService) CompleteOAuthLogin(ctx context.Context, req *authenticationV1.CompleteOAuthLoginRequest) (*authenticationV1.LoginResponse, error) {
+	if req == nil || req.GetCode() == "" {
+		return nil, authenticationV1.ErrorBadRequest("invalid request")
+	}
+
+	ctx = s.authnService.resetContextForLogin(ctx)
+	clientIP := netutil.ClientIPFromContext(ctx)
+
+	st, err := s.consumeState(ctx, req.GetState(), data.OAuthStatePurposeLogin)
+	if err != nil {
+		return nil, err
+	}
+
+	// 登录策略闸门（全局部分）：在调用提供方接口之前拦截
+	if s.authnService.loginPolicyRepo != nil {
+		if blocked, reason := s.authnService.checkLoginPolicies(ctx, st.TenantID, data.LoginPolicySubject{ClientIP: clientIP, DeviceID: req.GetDeviceId()}); blocked {
+			s.log.Warnf("oauth login blocked by policy: ip=%s provider=%s reason=%s", clientIP, st.Provider, reason)
+			return nil, authenticationV1.ErrorForbidden("login blocked by security policy")
+		}
+	}
+
+	// 提供方配置在授权期间可能被禁用，重新读取
+	settings, err := s.providerConfigRepo.GetEnabled(ctx, st.TenantID, st.Provider, st.ProviderCustom)
+	if err != nil {
+		return nil, err
+	}
+
+	profile, err := s.fetchProfile(ctx, settings, st, req.GetCode())
+	if err != nil {
+		return nil, err
+	}
+
+	accountProvider := settings.AccountProvider()
+
+	userID, err := s.socialAccountRepo.FindUserID(ctx, st.TenantID, accountProvider, profile.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var user *identityV1.User
+	if userID != 0 {
+		user, err = s.authnService.userRepo.Get(ctx, &identityV1.GetUserRequest{QueryBy: &identityV1.GetUserRequest_Id{Id: userID}})
+		if err != nil {
+			s.log.Errorf("get user by id [%d] failed [%s]", userID, err.Error())
+			return nil, err
+		}
+	} else {
+		if !settings.AutoProvision {
+			s.log.Warnf("oauth login: [%s] account [%s] not linked in tenant [%d]", accountProvider, profile.ID, st.TenantID)
+			return nil, authenticationV1.ErrorUnauthorized("oauth account not linked")
+		}
+
+		user, err = s.socialAccountRepo.Provision(ctx, st.TenantID, accountProvider, profile, settings.DefaultRoleIDs)
+		if err != nil {
+			s.log.Errorf("provision user for [%s] account [%s] failed: %s", accountProvider, profile.ID, err.Error())
+			return nil, err
+		}
+		s.log.Infof("oauth login: provisioned user [%d] for [%s] account [%s]", user.GetId(), accountProvider, profile.ID)
+	}
+
+	// 纵深防御：绑定关系的租户必须与用户行一致
+	if user.GetTenantId() != st.TenantID {
+		s.log.Errorf("tenant mismatch for user [%d]: oauth tenant [%d] vs user tenant [%d]",
+			user.GetId(), st.TenantID, user.GetTenantId())
+		return nil, authenticationV1.ErrorBadRequest("invalid tenant")
+	}
+
+	clientType := authenticationV1.ClientType(st.ClientType)
+	if clientType == authenticationV1.ClientType(0) {
+		clientType = s.authnService.clientType
+	}
+
+	return s.authnService.completeUserLogin(ctx, user, clientType, nil, req.DeviceId, clientIP)
+}
+
+// ListLinkedAccounts 列出当前用户已绑定的第三方账号。
+func (s *OAuthService) ListLinkedAccounts(ctx context.Context, _ *authenticationV1.ListLinkedAccountsRequest) (*authenticationV1.ListLinkedAccountsResponse, error) {
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := s.socialAccountRepo.ListByUser(ctx, operator.GetTenantId(), operator.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &authenticationV1.ListLinkedAccountsResponse{
+		Items: items,
+		Total: uint64(len(items)),
+	}, nil
+}
+
+// StartLinkOAuth 当前用户发起绑定第三方账号，返回提供方授权地址；operation_id 即 state。
+func (s *OAuthService) StartLinkOAuth(ctx context.Context, req *authenticationV1.StartLinkOAuthRequest) (*authenticationV1.StartLinkOAuthResponse, error) {
+	if req == nil {
+		return nil, authenticationV1.ErrorBadRequest("invalid request")
+	}
+
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// 代登录令牌不得为被代登录用户绑定第三方账号，否则操作人可借自己的社交账号长期登录该用户
+	if operator.GetUserId() == 0 || operator.GetApiClientId() != 0 || operator.GetActorUserId() != 0 {
+		return nil, authenticationV1.ErrorForbidden("linking requires a user session")
+	}
+
+	preset, providerCustom, ok := data.OAuthProviderPreset(req.GetProvider(), req.GetProviderCustom())
+	if !ok {
+		return nil, authenticationV1.ErrorBadRequest("unsupported oauth provider")
+	}
+
+	settings, err := s.providerConfigRepo.GetEnabled(ctx, operator.GetTenantId(), preset, providerCustom)
+	if err != nil {
+		return nil, err
+	}
+
+	redirectURI, err := resolveRedirectURI(settings, req.GetRedirectUri())
+	if err != nil {
+		return nil, err
+	}
+
+	authURL, state, expiresAt, err := s.startAuthorization(ctx, settings, &data.OAuthState{
+		Purpose:        data.OAuthStatePurposeLink,
+		TenantID:       operator.GetTenantId(),
+		Provider:       preset,
+		ProviderCustom: providerCustom,
+		RedirectURI:    redirectURI,
+		UserID:         operator.GetUserId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	displayHint := settings.DisplayName
+	return &authenticationV1.StartLinkOAuthResponse{
+		AuthorizationUrl: &authURL,
+		OperationId:      &state,
+		ExpiresAt:        expiresAt,
+		DisplayHint:      &displayHint,
+	}, nil
+}
+
+// ConfirmLinkOAuth 完成绑定：state 必须由当前用户发起，绑定结果不返回任何提供方令牌。
+func (s *OAuthService) ConfirmLinkOAuth(ctx context.Context, req *authenticationV1.ConfirmLinkOAuthRequest) (*authenticationV1.ConfirmLinkOAuthResponse, error) {
+	if req == nil || req.GetCode() == "" {
+		return nil, authenticationV1.ErrorBadRequest("invalid request")
+	}
+
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if operator.GetActorUserId() != 0 {
+		return nil, authenticationV1.ErrorForbidden("linking requires a user session")
+	}
+
+	state := req.GetState()
+	if state == "" {
+		state = req.GetOperationId()
+	}
+
+	st, err := s.consumeState(ctx, state, data.OAuthStatePurposeLink)
+	if err != nil {
+		return nil, err
+	}
+	if st.UserID != operator.GetUserId() || st.TenantID != operator.GetTenantId() {
+		s.log.Warnf("oauth link state issued for user [%d] used by user [%d]", st.UserID, operator.GetUserId())
+		return nil, authenticationV1.ErrorBadRequest("invalid or expired oauth state")
+	}
+
+	settings, err := s.providerConfigRepo.GetEnabled(ctx, st.TenantID, st.Provider, st.ProviderCustom)
+	if err != nil {
+		return nil, err
+	}
+
+	profile, err := s.fetchProfile(ctx, settings, st, req.GetCode())
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := s.socialAccountRepo.Link(ctx, st.TenantID, st.UserID, settings.AccountProvider(), profile)
+	if err != nil {
+		return nil, err
+	}
+
+	return &authenticationV1.ConfirmLinkOAuthResponse{Account: account}, nil
+}
+
+// UnlinkOAuth 解除当前用户的第三方账号绑定，可按 credential_id 或提供方指定。
+func (s *OAuthService) UnlinkOAuth(ctx context.Context, req *authenticationV1.UnlinkOAuthRequest) (*emptypb.Empty, error) {
+	if req == nil {
+		return nil, authenticationV1.ErrorBadRequest("invalid request")
+	}
+
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if operator.GetActorUserId() != 0 {
+		return nil, authenticationV1.ErrorForbidden("unlinking requires a user session")
+	}
+
+	var credentialID uint32
+	var provider string
+	if raw := strings.TrimSpace(req.GetCredentialId()); raw != "" {
+		id, perr := strconv.ParseUint(raw, 10, 32)
+		if perr != nil || id == 0 {
+			return nil, authenticationV1.ErrorBadRequest("invalid credential_id")
+		}
+		credentialID = uint32(id)
+	} else {
+		preset, providerCustom, ok := data.OAuthProviderPreset(req.GetProvider(), req.GetProviderCustom())
+		if !ok {
+			return nil, authenticationV1.ErrorBadRequest("unsupported oauth provider")
+		}
+		provider = data.OAuthAccountProvider(preset, providerCustom)
+	}
+
+	if err = s.socialAccountRepo.Unlink(ctx, operator.GetTenantId(), operator.GetUserId(), credentialID, provider); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,118 @@
+package service
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"go-wind-admin/app/admin/service/internal/data"
+
+	adminV1 "go-wind-admin/api/gen/go/admin/service/v1"
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+	identityV1 "go-wind-admin/api/gen/go/identity/service/v1"
+
+	"go-wind-admin/pkg/middleware/auth"
+	"go-wind-admin/pkg/oauth"
+)
+
+// OIDC 端点路径，与 i_oidc.proto / i_authentication.proto / i_oauth_server.proto 的 HTTP 映射保持一致
+const (
+	oidcAuthorizationPath = "/admin/v1/oauth/authorize"
+	oidcTokenPath         = "/admin/v1/login"
+	oidcUserInfoPath      = "/admin/v1/oauth/userinfo"
+	oidcJwksPath          = "/.well-known/jwks.json"
+)
+
+// OidcService OpenID Connect 身份提供方：发布发现文档与签名公钥，
+// 下游服务与 API 网关据此离线校验本服务签发的访问令牌与 ID 令牌。
+type OidcService struct {
+	adminV1.OidcServiceHTTPServer
+
+	log *log.Helper
+
+	authenticator *data.Authenticator
+	userRepo      data.UserRepo
+}
+
+func NewOidcService(
+	ctx *bootstrap.Context,
+	authenticator *data.Authenticator,
+	userRepo data.UserRepo,
+) *OidcService {
+	return &OidcService{
+		log:           ctx.NewLoggerHelper("oidc/service/admin-service"),
+		authenticator: authenticator,
+		userRepo:      userRepo,
+	}
+}
+
+// GetOpenIDConfiguration 发现文档（/.well-known/openid-configuration）
+func (s *OidcService) GetOpenIDConfiguration(_ context.Context, _ *emptypb.Empty) (*authenticationV1.OpenIDConfiguration, error) {
+	issuer := s.authenticator.Issuer()
+	if issuer == "" {
+		return nil, adminV1.ErrorServiceUnavailable("oidc issuer not configured")
+	}
+
+	var algs []string
+	if keys, err := s.authenticator.PublicJWKs(); err == nil && len(keys) > 0 {
+		algs = []string{s.authenticator.SigningMethod()}
+	}
+
+	return &authenticationV1.OpenIDConfiguration{
+		Issuer:                            issuer,
+		AuthorizationEndpoint:             issuer + oidcAuthorizationPath,
+		TokenEndpoint:                     issuer + oidcTokenPath,
+		UserinfoEndpoint:                  issuer + oidcUserInfoPath,
+		JwksUri:                           issuer + oidcJwksPath,
+		ScopesSupported:                   oauth.SupportedScopes(),
+		ResponseTypesSupported:            []string{"code"},
+		GrantTypesSupported:               []string{"authorization_code", "refresh_token", "client_credentials", "password"},
+		SubjectTypesSupported:             []string{"public"},
+		IdTokenSigningAlgValuesSupported:  algs,
+		TokenEndpointAuthMethodsSupported: []string{"client_secret_post", "none"},
+		CodeChallengeMethodsSupported:     []string{oauth.CodeChallengeMethodS256},
+		ClaimsSupported: []string{
+			"iss", "sub", "aud", "exp", "iat", "azp", "nonce", "at_hash",
+			"preferred_username", "nickname", "name", "picture", "updated_at", "email", "tid",
+		},
+	}, nil
+}
+
+// GetJwks 签名公钥集。对称签名算法下秘钥不可公开，返回空集。
+func (s *OidcService) GetJwks(_ context.Context, _ *emptypb.Empty) (*authenticationV1.JsonWebKeySet, error) {
+	keys, err := s.authenticator.PublicJWKs()
+	if err != nil {
+		s.log.Errorf("build jwks failed: %s", err.Error())
+		return nil, adminV1.ErrorInternalServerError("build jwks failed")
+	}
+
+	return &authenticationV1.JsonWebKeySet{Keys: keys}, nil
+}
+
+// UserInfo 返回当前令牌所属用户的身份声明，按令牌的授权范围裁剪。
+func (s *OidcService) UserInfo(ctx context.Context, _ *emptypb.Empty) (*authenticationV1.OidcUserInfo, error) {
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 服务客户端令牌不代表用户
+	if operator.GetUserId() == 0 || operator.GetApiClientId() != 0 {
+		return nil, adminV1.ErrorForbidden("userinfo requires a user token")
+	}
+
+	user, err := s.userRepo.Get(ctx, &identityV1.GetUserRequest{
+		QueryBy: &identityV1.GetUserRequest_Id{
+			Id: operator.GetUserId(),
+		},
+	})
+	if err != nil {
+		s.log.Errorf("get user [%d] failed [%s]", operator.GetUserId(), err.Error())
+		return nil, adminV1.ErrorNotFound("user not found")
+	}
+
+	return oauth.NewUserInfo(user, operator.GetScopes()), nil
+}
@@ -8,14 +8,17 @@
 	"github.com/tx7do/go-utils/aggregator"
 	"github.com/tx7do/go-utils/trans"
 	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	"go-wind-admin/app/admin/service/internal/data"
 
 	adminV1 "go-wind-admin/api/gen/go/admin/service/v1"
+	auditV1 "go-wind-admin/api/gen/go/audit/service/v1"
 	identityV1 "go-wind-admin/api/gen/go/identity/service/v1"
 
 	"go-wind-admin/pkg/middleware/auth"
+	"go-wind-admin/pkg/middleware/fieldperm"
 )
 
 type OrgUnitService struct {
@@ -25,17 +28,27 @@ type OrgUnitService struct {
 
 	orgUnitRepo *data.OrgUnitRepo
 	userRepo    data.UserRepo
+
+	dataScopeResolver *data.DataScopeResolver
+
+	auditor *operationAuditor
 }
 
 func NewOrgUnitService(
 	ctx *bootstrap.Context,
 	organizationRepo *data.OrgUnitRepo,
 	userRepo data.UserRepo,
+	dataScopeResolver *data.DataScopeResolver,
+	operationAuditLogRepo *data.OperationAuditLogRepo,
 ) *OrgUnitService {
+	l := ctx.NewLoggerHelper("org-unit/service/admin-service")
 	return &OrgUnitService{
-		log:         ctx.NewLoggerHelper("org-unit/service/admin-service"),
+		log:         l,
 		orgUnitRepo: organizationRepo,
 		userRepo:    userRepo,
+		auditor:     newOperationAuditor(l, operationAuditLogRepo),
+
+		dataScopeResolver: dataScopeResolver,
 	}
 }
 
@@ -175,10 +188,14 @@ func (s *OrgUnitService) Create(ctx context.Context, req *identityV1.CreateOrgUn
 
 	req.Data.CreatedBy = trans.Ptr(operator.UserId)
 
-	if err = s.orgUnitRepo.Create(ctx, req); err != nil {
+	orgUnit, err := s.orgUnitRepo.Create(ctx, req)
+	s.auditor.record(ctx, auditResourceOrgUnit, orgUnit.GetId(), auditV1.OperationAuditLog_CREATE, nil, orgUnit, err)
+	if err != nil {
 		return nil, err
 	}
 
+	s.invalidateDataScopes()
+
 	return &emptypb.Empty{}, nil
 }
 
@@ -200,16 +217,60 @@ func (s *OrgUnitService) Update(ctx context.Context, req *identityV1.UpdateOrgUn
 		req.UpdateMask.Paths = append(req.UpdateMask.Paths, "updated_by")
 	}
 
+	getTarget := &identityV1.GetOrgUnitRequest{QueryBy: &identityV1.GetOrgUnitRequest_Id{Id: req.GetId()}}
+	before, _ := s.orgUnitRepo.Get(ctx, getTarget)
+
 	if err = s.orgUnitRepo.Update(ctx, req); err != nil {
+		s.auditor.record(ctx, auditResourceOrgUnit, req.GetId(), auditV1.OperationAuditLog_UPDATE, before, nil, err)
 		return nil, err
 	}
 
+	after, _ := s.orgUnitRepo.Get(ctx, getTarget)
+	s.auditor.record(ctx, auditResourceOrgUnit, req.GetId(), auditV1.OperationAuditLog_UPDATE, before, after, nil)
+
+	// 组织树调整会改变“本级及下级”的展开结果
+	s.invalidateDataScopes()
+
 	return &emptypb.Empty{}, nil
 }
 
 func (s *OrgUnitService) Delete(ctx context.Context, req *identityV1.DeleteOrgUnitRequest) (*emptypb.Empty, error) {
-	if err := s.orgUnitRepo.Delete(ctx, req); err != nil {
+	before, _ := s.orgUnitRepo.Get(ctx, &identityV1.GetOrgUnitRequest{
+		QueryBy: &identityV1.GetOrgUnitRequest_Id{Id: req.GetId()},
+	})
+
+	err := s.orgUnitRepo.Delete(ctx, req)
+	s.auditor.record(ctx, auditResourceOrgUnit, req.GetId(), auditV1.OperationAuditLog_DELETE, before, nil, err)
+	if err != nil {
 		return nil, err
 	}
+
+	s.invalidateDataScopes()
+
 	return &emptypb.Empty{}, nil
 }
+
+// invalidateDataScopes 组织树变更后清空数据权限缓存
+func (s *OrgUnitService) invalidateDataScopes() {
+	if s.dataScopeResolver != nil {
+		s.dataScopeResolver.Invalidate()
+	}
+}
+
+// FieldRules 组织单元字段的写入权限：负责人仅具备 org-unit:leader-id:edit 权限码方可修改
+func (s *OrgUnitService) FieldRules() []fieldperm.Rule {
+	orgUnit := (&identityV1.OrgUnit{}).ProtoReflect().Descriptor().FullName()
+
+	return []fieldperm.Rule{
+		{Message: orgUnit, Field: "leader_id", Write: true},
+	}
+}
+
+// LoadFieldCurrent 加载被更新组织单元的当前值，负责人未改动时不校验写入权限
+func (s *OrgUnitService) LoadFieldCurrent(ctx context.Context, req proto.Message) (proto.Message, error) {
+	r, ok := req.(*identityV1.UpdateOrgUnitRequest)
+	if !ok || r.GetId() == 0 {
+		return nil, nil
+	}
+	return s.orgUnitRepo.Get(ctx, &identityV1.GetOrgUnitRequest{QueryBy: &identityV1.GetOrgUnitRequest_Id{Id: r.GetId()}})
+}
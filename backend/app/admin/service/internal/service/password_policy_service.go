@@ -0,0 +1,136 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/go-utils/trans"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"go-wind-admin/app/admin/service/internal/data"
+
+	adminV1 "go-wind-admin/api/gen/go/admin/service/v1"
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+
+	"go-wind-admin/pkg/middleware/auth"
+	"go-wind-admin/pkg/passwordpolicy"
+)
+
+const (
+	// passwordPolicyMaxBannedSubstrings 单条策略可列出的禁止子串上限。
+	passwordPolicyMaxBannedSubstrings = 64
+	// passwordPolicyMaxBannedSubstringLen 禁止子串长度上限。
+	passwordPolicyMaxBannedSubstringLen = 64
+	// passwordPolicyMaxAgeDays 密码有效天数上限（10 年）。
+	passwordPolicyMaxAgeDays = 3650
+)
+
+// PasswordPolicyService 密码策略管理（租户维度）。
+// 策略在设置/修改密码（注册、创建用户、修改密码、重置密码）时生效，对已有密码只影响有效期判定。
+type PasswordPolicyService struct {
+	adminV1.PasswordPolicyServiceHTTPServer
+
+	log *log.Helper
+
+	repo *data.PasswordPolicyRepo
+}
+
+func NewPasswordPolicyService(
+	ctx *bootstrap.Context,
+	repo *data.PasswordPolicyRepo,
+) *PasswordPolicyService {
+	return &PasswordPolicyService{
+		log:  ctx.NewLoggerHelper("password-policy/service/admin-service"),
+		repo: repo,
+	}
+}
+
+// normalizeBannedSubstrings 去空白、去重（不区分大小写）并校验禁止子串列表。
+func normalizeBannedSubstrings(items []string) ([]string, error) {
+	seen := make(map[string]bool, len(items))
+	out := make([]string, 0, len(items))
+	for _, item := range items {
+		item = strings.TrimSpace(item)
+		key := strings.ToLower(item)
+		if item == "" || seen[key] {
+			continue
+		}
+		if len(item) > passwordPolicyMaxBannedSubstringLen {
+			return nil, authenticationV1.ErrorBadRequest("banned substring too long: %s", item)
+		}
+		seen[key] = true
+		out = append(out, item)
+	}
+	if len(out) > passwordPolicyMaxBannedSubstrings {
+		return nil, authenticationV1.ErrorBadRequest("at most %d banned substrings are allowed", passwordPolicyMaxBannedSubstrings)
+	}
+	return out, nil
+}
+
+// Get 查询密码策略，未配置时返回不做限制的默认策略。
+func (s *PasswordPolicyService) Get(ctx context.Context, req *authenticationV1.GetPasswordPolicyRequest) (*authenticationV1.PasswordPolicy, error) {
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tid, err := policyTenant(operator, req.TenantId)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.Get(ctx, tid)
+}
+
+// Update 更新密码策略（不存在则创建）。
+// 只约束此后设置的密码；有效期按密码修改时间判定，缩短后已超期的用户下次登录即被要求修改。
+func (s *PasswordPolicyService) Update(ctx context.Context, req *authenticationV1.UpdatePasswordPolicyRequest) (*emptypb.Empty, error) {
+	if req == nil || req.Data == nil {
+		return nil, authenticationV1.ErrorBadRequest("invalid request")
+	}
+
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tid, err := policyTenant(operator, req.Data.TenantId)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Data.GetMinLength() > passwordpolicy.MaxLength {
+		return nil, authenticationV1.ErrorBadRequest("min_length must not exceed %d", passwordpolicy.MaxLength)
+	}
+	if req.Data.GetHistoryCount() > passwordpolicy.MaxHistoryCount {
+		return nil, authenticationV1.ErrorBadRequest("history_count must not exceed %d", passwordpolicy.MaxHistoryCount)
+	}
+	if req.Data.GetMaxAgeDays() > passwordPolicyMaxAgeDays {
+		return nil, authenticationV1.ErrorBadRequest("max_age_days must not exceed %d", passwordPolicyMaxAgeDays)
+	}
+
+	banned, err := normalizeBannedSubstrings(req.Data.GetBannedSubstrings())
+	if err != nil {
+		return nil, err
+	}
+
+	policy := &authenticationV1.PasswordPolicy{
+		TenantId:         trans.Ptr(tid),
+		MinLength:        req.Data.GetMinLength(),
+		RequireUppercase: req.Data.GetRequireUppercase(),
+		RequireLowercase: req.Data.GetRequireLowercase(),
+		RequireDigit:     req.Data.GetRequireDigit(),
+		RequireSymbol:    req.Data.GetRequireSymbol(),
+		DisallowIdentity: req.Data.GetDisallowIdentity(),
+		BannedSubstrings: banned,
+		HistoryCount:     req.Data.GetHistoryCount(),
+		MaxAgeDays:       req.Data.GetMaxAgeDays(),
+		CheckBreached:    req.Data.GetCheckBreached(),
+		UpdatedBy:        trans.Ptr(operator.GetUserId()),
+	}
+	if err = s.repo.Save(ctx, policy); err != nil {
+		return nil, err
+	}
+
+	s.log.Infof("user [%d] updated password policy of tenant [%d]", operator.GetUserId(), tid)
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,309 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/go-utils/trans"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go-wind-admin/app/admin/service/internal/data"
+
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+	permissionV1 "go-wind-admin/api/gen/go/permission/service/v1"
+
+	"go-wind-admin/pkg/abac"
+	appViewer "go-wind-admin/pkg/entgo/viewer"
+	"go-wind-admin/pkg/middleware/auth"
+)
+
+// 权限点动态策略（ABAC）：
+//   - RBAC（Casbin/OPA）放行后，ABAC 中间件按请求路由找到关联权限点上启用的 CEL 策略，按评估优先级依次判定，任一不通过即拒绝；
+//   - 资源级策略（定义中 resource 为 true）不在请求阶段判定，由业务层加载资源后调用 EvaluateResource；
+//   - 判定结果按策略的缓存秒数缓存，每次判定（含命中缓存）都写入策略评估日志；
+//   - 策略查询失败、定义无效或表达式求值出错时拒绝（fail-closed）。
+
+// routePolicyCacheTTL 路由关联策略的进程内缓存时间。经管理接口变更策略时本实例立即失效，其他实例最多延迟该时长。
+const routePolicyCacheTTL = 30 * time.Second
+
+type cachedRoutePolicies struct {
+	policies  []data.EffectivePermissionPolicy
+	expiresAt time.Time
+}
+
+// PermissionPolicyEvaluator 权限点动态策略判定器
+type PermissionPolicyEvaluator struct {
+	log *log.Helper
+
+	policyRepo *data.PermissionPolicyRepo
+	logRepo    *data.PolicyEvaluationLogRepo
+
+	engine    *abac.CelEngine
+	decisions *abac.DecisionCache
+
+	mu     sync.RWMutex
+	routes map[string]cachedRoutePolicies
+}
+
+func NewPermissionPolicyEvaluator(
+	ctx *bootstrap.Context,
+	policyRepo *data.PermissionPolicyRepo,
+	logRepo *data.PolicyEvaluationLogRepo,
+) *PermissionPolicyEvaluator {
+	e := &PermissionPolicyEvaluator{
+		log:        ctx.NewLoggerHelper("permission-policy-evaluator/service/admin-service"),
+		policyRepo: policyRepo,
+		logRepo:    logRepo,
+		decisions:  abac.NewDecisionCache(),
+		routes:     make(map[string]cachedRoutePolicies),
+	}
+
+	engine, err := abac.NewCelEngine()
+	if err != nil {
+		e.log.Errorf("init cel engine failed: %s", err.Error())
+	}
+	e.engine = engine
+
+	return e
+}
+
+// EvaluateRequest 请求级判定，实现 ABAC 中间件的 Evaluator 接口
+func (e *PermissionPolicyEvaluator) EvaluateRequest(ctx context.Context, req *abac.Request) error {
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		// 免鉴权接口没有访问主体，不做 ABAC 判定
+		return nil
+	}
+	if req.Path == "" || req.Method == "" {
+		return nil
+	}
+
+	policies, err := e.routePolicies(ctx, req.Path, req.Method)
+	if err != nil {
+		return err
+	}
+	if len(policies) == 0 {
+		return nil
+	}
+
+	attrs := &abac.Attributes{
+		Subject: subjectFromToken(operator),
+		Request: *req,
+	}
+	return e.evaluate(ctx, attrs, policies, false)
+}
+
+// EvaluateResource 资源级判定：业务层加载资源后，按权限点上的资源级策略判定当前用户能否访问该资源。
+// resource 为资源字段，表达式中以 resource.xxx 访问（整数字段请转为 int64）。
+func (e *PermissionPolicyEvaluator) EvaluateResource(ctx context.Context, permissionCode string, resource map[string]any) error {
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	policies, err := e.policyRepo.ListCelPoliciesByPermissionCode(appViewer.NewSystemViewerContext(ctx), permissionCode)
+	if err != nil {
+		return err
+	}
+	if len(policies) == 0 {
+		return nil
+	}
+
+	attrs := &abac.Attributes{
+		Subject:  subjectFromToken(operator),
+		Request:  abac.NewRequestFromContext(ctx),
+		Resource: resource,
+	}
+	return e.evaluate(ctx, attrs, policies, true)
+}
+
+// Invalidate 策略变更后清空路由策略与判定结果缓存
+func (e *PermissionPolicyEvaluator) Invalidate() {
+	e.mu.Lock()
+	e.routes = make(map[string]cachedRoutePolicies)
+	e.mu.Unlock()
+
+	e.decisions.Purge()
+}
+
+// ValidateDefinition 校验 CEL 策略定义：JSON 结构合法且表达式可编译为 bool
+func (e *PermissionPolicyEvaluator) ValidateDefinition(definition string) error {
+	def, err := abac.ParseDefinition(definition)
+	if err != nil {
+		return err
+	}
+	if e.engine == nil {
+		return fmt.Errorf("cel engine is not available")
+	}
+	_, err = e.engine.Compile(def.Expression)
+	return err
+}
+
+func (e *PermissionPolicyEvaluator) routePolicies(ctx context.Context, path, method string) ([]data.EffectivePermissionPolicy, error) {
+	key := method + " " + path
+	now := time.Now()
+
+	e.mu.RLock()
+	cached, ok := e.routes[key]
+	e.mu.RUnlock()
+	if ok && now.Before(cached.expiresAt) {
+		return cached.policies, nil
+	}
+
+	// 策略与权限点为平台级配置，以系统身份读取
+	policies, err := e.policyRepo.ListCelPoliciesByApi(appViewer.NewSystemViewerContext(ctx), path, method)
+	if err != nil {
+		return nil, err
+	}
+
+	e.mu.Lock()
+	e.routes[key] = cachedRoutePolicies{policies: policies, expiresAt: now.Add(routePolicyCacheTTL)}
+	e.mu.Unlock()
+
+	return policies, nil
+}
+
+// evaluate 按评估优先级依次判定，resourceStage 区分请求级与资源级策略
+func (e *PermissionPolicyEvaluator) evaluate(
+	ctx context.Context,
+	attrs *abac.Attributes,
+	policies []data.EffectivePermissionPolicy,
+	resourceStage bool,
+) error {
+	for _, p := range policies {
+		def, err := abac.ParseDefinition(p.Definition)
+		if err != nil {
+			// 定义无效的策略只在请求阶段判定一次（拒绝），避免资源阶段重复拒绝与记录
+			if resourceStage {
+				continue
+			}
+		} else if def.Resource != resourceStage {
+			continue
+		}
+
+		allowed, detail := e.decide(p, def, err, attrs)
+		e.record(ctx, attrs, p, allowed, detail)
+		if allowed {
+			continue
+		}
+
+		e.log.Warnf("access denied by permission policy [%d]: user=%d %s %s: %s",
+			p.ID, attrs.Subject.UserID, attrs.Request.Method, attrs.Request.Path, detail)
+		if def != nil && def.Message != "" {
+			return permissionV1.ErrorForbidden("%s", def.Message)
+		}
+		return permissionV1.ErrorForbidden("access denied by permission policy")
+	}
+
+	return nil
+}
+
+// decide 判定单条策略，返回是否放行与评估详情
+func (e *PermissionPolicyEvaluator) decide(
+	p data.EffectivePermissionPolicy,
+	def *abac.Definition,
+	defErr error,
+	attrs *abac.Attributes,
+) (bool, string) {
+	if defErr != nil {
+		return false, "invalid definition: " + defErr.Error()
+	}
+	if e.engine == nil {
+		return false, "cel engine is not available"
+	}
+
+	var key string
+	if p.CacheTTL > 0 {
+		key = fmt.Sprintf("%d:%d:%d:%s", p.ID, p.Version, p.UpdatedAt.UnixNano(), attrs.Fingerprint())
+		if allowed, ok := e.decisions.Get(key); ok {
+			return allowed, decisionDetail(allowed) + " (cached)"
+		}
+	}
+
+	allowed, err := e.engine.Evaluate(def.Expression, attrs)
+	if err != nil {
+		// 求值错误不缓存：可能与本次请求的属性有关
+		return false, "evaluation error: " + err.Error()
+	}
+
+	if key != "" {
+		e.decisions.Set(key, allowed, p.CacheTTL)
+	}
+	return allowed, decisionDetail(allowed)
+}
+
+func decisionDetail(allowed bool) string {
+	if allowed {
+		return "allow"
+	}
+	return "deny"
+}
+
+// record 写策略评估日志，写入失败只记日志，不影响判定结果
+func (e *PermissionPolicyEvaluator) record(
+	ctx context.Context,
+	attrs *abac.Attributes,
+	p data.EffectivePermissionPolicy,
+	allowed bool,
+	detail string,
+) {
+	if e.logRepo == nil {
+		return
+	}
+
+	entry := &permissionV1.PolicyEvaluationLog{
+		TenantId:          trans.Ptr(attrs.Subject.TenantID),
+		UserId:            trans.Ptr(attrs.Subject.UserID),
+		PermissionId:      trans.Ptr(p.PermissionID),
+		PolicyId:          trans.Ptr(p.ID),
+		RequestPath:       trans.Ptr(attrs.Request.Path),
+		RequestMethod:     trans.Ptr(attrs.Request.Method),
+		Result:            trans.Ptr(allowed),
+		EffectDetails:     trans.Ptr(detail),
+		IpAddress:         trans.Ptr(attrs.Request.IP),
+		EvaluationContext: trans.Ptr(attrs.Snapshot()),
+		CreatedAt:         timestamppb.Now(),
+	}
+	if spanContext := trace.SpanContextFromContext(ctx); spanContext.HasTraceID() {
+		entry.TraceId = trans.Ptr(spanContext.TraceID().String())
+	}
+
+	entry.LogHash = trans.Ptr(hashPolicyEvaluationLog(entry))
+
+	if err := e.logRepo.Create(appViewer.NewSystemViewerContext(ctx), &permissionV1.CreatePolicyEvaluationLogRequest{Data: entry}); err != nil {
+		e.log.Errorf("write policy evaluation log [%d] failed: %s", p.ID, err.Error())
+	}
+}
+
+// hashPolicyEvaluationLog 与操作审计日志相同的规则：排除 log_hash 和 signature，Protobuf 确定性序列化后取 SHA256。
+func hashPolicyEvaluationLog(entry *permissionV1.PolicyEvaluationLog) string {
+	c := proto.Clone(entry).(*permissionV1.PolicyEvaluationLog)
+	c.LogHash = nil
+	c.Signature = nil
+
+	raw, err := proto.MarshalOptions{Deterministic: true}.Marshal(c)
+	if err != nil {
+		return ""
+	}
+
+	sum := sha256.Sum256(raw)
+	return hex.EncodeToString(sum[:])
+}
+
+func subjectFromToken(operator *authenticationV1.UserTokenPayload) abac.Subject {
+	return abac.Subject{
+		UserID:    operator.GetUserId(),
+		TenantID:  operator.GetTenantId(),
+		OrgUnitID: operator.GetOrgUnitId(),
+		Username:  operator.GetUsername(),
+		Roles:     operator.GetRoles(),
+	}
+}
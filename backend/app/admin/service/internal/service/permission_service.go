@@ -2,6 +2,7 @@
 
 import (
 	"context"
+	"slices"
 	"sort"
 	"strings"
 
@@ -40,7 +41,8 @@ type PermissionService struct {
 
 	roleRepo *data.RoleRepo
 
-	authorizer *authorizer.Authorizer
+	authorizer             *authorizer.Authorizer
+	permissionCodeResolver *data.PermissionCodeResolver
 
 	menuPermissionConverter *converter.MenuPermissionConverter
 	apiPermissionConverter  *converter.ApiPermissionConverter
@@ -54,6 +56,7 @@ func NewPermissionService(
 	apiRepo *data.ApiRepo,
 	roleRepo *data.RoleRepo,
 	authorizer *authorizer.Authorizer,
+	permissionCodeResolver *data.PermissionCodeResolver,
 ) *PermissionService {
 	svc := &PermissionService{
 		log:                     ctx.NewLoggerHelper("permission/service/admin-service"),
@@ -63,6 +66,7 @@ func NewPermissionService(
 		apiRepo:                 apiRepo,
 		roleRepo:                roleRepo,
 		authorizer:              authorizer,
+		permissionCodeResolver:  permissionCodeResolver,
 		menuPermissionConverter: converter.NewMenuPermissionConverter(),
 		apiPermissionConverter:  converter.NewApiPermissionConverter(),
 	}
@@ -86,6 +90,11 @@ func (s *PermissionService) init() {
 			_, _ = s.SyncPermissions(ctx, &emptypb.Empty{})
 		}
 	}
+
+	// 已有数据的库补齐后续新增的字段权限，并将其中的写入权限授予平台管理员与租户管理员角色
+	_ = s.migrateFieldPermissions(ctx)
+	// 补齐租户管理员的动作权限，避免动作级权限规则拒绝租户管理员的写入
+	_ = s.migrateActionPermissions(ctx)
 }
 
 func (s *PermissionService) extractRelationIDs(
@@ -237,6 +246,8 @@ func (s *PermissionService) Create(ctx context.Context, req *permissionV1.Create
 		return nil, err
 	}
 
+	s.invalidatePermissionCodes()
+
 	// 重置权限策略
 	if err = s.authorizer.ResetPolicies(ctx); err != nil {
 		return nil, err
@@ -267,6 +278,8 @@ func (s *PermissionService) Update(ctx context.Context, req *permissionV1.Update
 		return nil, err
 	}
 
+	s.invalidatePermissionCodes()
+
 	// 重置权限策略
 	if err = s.authorizer.ResetPolicies(ctx); err != nil {
 		return nil, err
@@ -280,6 +293,8 @@ func (s *PermissionService) Delete(ctx context.Context, req *permissionV1.Delete
 		return nil, err
 	}
 
+	s.invalidatePermissionCodes()
+
 	// 重置权限策略
 	if err := s.authorizer.ResetPolicies(ctx); err != nil {
 		return nil, err
@@ -288,6 +303,13 @@ func (s *PermissionService) Delete(ctx context.Context, req *permissionV1.Delete
 	return &emptypb.Empty{}, nil
 }
 
+// invalidatePermissionCodes 权限或其 API 变更后清空用户权限码缓存
+func (s *PermissionService) invalidatePermissionCodes() {
+	if s.permissionCodeResolver != nil {
+		s.permissionCodeResolver.Invalidate()
+	}
+}
+
 // appendAPis 为权限追加对应的 API 资源 ID 列表
 func (s *PermissionService) appendAPis(
 	ctx context.Context,
@@ -519,6 +541,8 @@ func (s *PermissionService) SyncPermissions(ctx context.Context, _ *emptypb.Empt
 		return nil, err
 	}
 
+	s.invalidatePermissionCodes()
+
 	// 重置权限策略
 	if err = s.authorizer.ResetPolicies(ctx); err != nil {
 		return nil, err
@@ -541,3 +565,81 @@ func (s *PermissionService) createDefaultPermissions(ctx context.Context) error
 
 	return nil
 }
+
+// migrateFieldPermissions 创建缺失的字段权限。其中写入权限（AdminFieldPermissionCodes）本次新建时授予内置管理员角色，
+// 保持管理员升级前可修改的字段；查看明文的权限不自动授予，须按角色显式分配。
+// 已存在的权限不再重复授予，避免覆盖管理员对角色授权的调整
+func (s *PermissionService) migrateFieldPermissions(ctx context.Context) error {
+	var viewPermissions, adminPermissions []*permissionV1.Permission
+	for _, d := range constants.DefaultPermissions {
+		switch {
+		case slices.Contains(constants.AdminFieldPermissionCodes, d.GetCode()):
+			adminPermissions = append(adminPermissions, d)
+		case slices.Contains(constants.FieldPermissionCodes, d.GetCode()):
+			viewPermissions = append(viewPermissions, d)
+		}
+	}
+
+	if _, err := s.permissionRepo.CreateMissingPermissions(ctx, viewPermissions); err != nil {
+		s.log.Errorf("create field permissions failed: %v", err)
+		return err
+	}
+
+	ids, err := s.permissionRepo.CreateMissingPermissions(ctx, adminPermissions)
+	if err != nil {
+		s.log.Errorf("create field permissions failed: %v", err)
+		return err
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	if err = s.roleRepo.GrantPermissionsByRoleCodes(ctx, []string{
+		constants.PlatformAdminRoleCode,
+		constants.TenantAdminTemplateRoleCode,
+		constants.TenantAdminRoleCode,
+	}, ids); err != nil {
+		s.log.Errorf("grant field permissions to admin roles failed: %v", err)
+		return err
+	}
+
+	if s.permissionCodeResolver != nil {
+		s.permissionCodeResolver.Invalidate()
+	}
+
+	return nil
+}
+
+// migrateActionPermissions 创建缺失的动作权限（ActionPermissionCodes），本次新建时授予租户管理员模板与租户管理员角色。
+// 平台视图不受动作级权限规则约束，平台管理员角色无需授予；已存在的权限不再重复授予
+func (s *PermissionService) migrateActionPermissions(ctx context.Context) error {
+	var actionPermissions []*permissionV1.Permission
+	for _, d := range constants.DefaultPermissions {
+		if slices.Contains(constants.ActionPermissionCodes, d.GetCode()) {
+			actionPermissions = append(actionPermissions, d)
+		}
+	}
+
+	ids, err := s.permissionRepo.CreateMissingPermissions(ctx, actionPermissions)
+	if err != nil {
+		s.log.Errorf("create action permissions failed: %v", err)
+		return err
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	if err = s.roleRepo.GrantPermissionsByRoleCodes(ctx, []string{
+		constants.TenantAdminTemplateRoleCode,
+		constants.TenantAdminRoleCode,
+	}, ids); err != nil {
+		s.log.Errorf("grant action permissions to tenant admin roles failed: %v", err)
+		return err
+	}
+
+	if s.permissionCodeResolver != nil {
+		s.permissionCodeResolver.Invalidate()
+	}
+
+	return nil
+}
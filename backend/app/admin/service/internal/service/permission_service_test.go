@@ -1,9 +1,117 @@
 package service
 
 import (
+	"context"
 	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tx7do/go-crud/viewer"
+
+	conf "github.com/tx7do/kratos-bootstrap/api/gen/go/conf/v1"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"go-wind-admin/app/admin/service/internal/data"
+	"go-wind-admin/app/admin/service/internal/data/ent/role"
+	"go-wind-admin/app/admin/service/internal/data/ent/user"
+	"go-wind-admin/app/admin/service/internal/data/enttest"
+
+	identityV1 "go-wind-admin/api/gen/go/identity/service/v1"
+
+	"go-wind-admin/pkg/constants"
+	appViewer "go-wind-admin/pkg/entgo/viewer"
 )
 
 func TestPermissionService_syncWithOpenAPI_EmptyPaths(t *testing.T) {
 
 }
+
+func TestPermissionService_MigrateFieldPermissions(t *testing.T) {
+	entClient := enttest.NewEntClientForTest(t)
+	bctx := bootstrap.NewContextWithParam(context.Background(), &conf.AppInfo{}, &conf.Bootstrap{}, log.DefaultLogger)
+	sysCtx := enttest.NewSystemViewerCtx(context.Background())
+	db := entClient.Client()
+
+	// 升级前的库：已有内置权限与角色，字段权限尚未创建
+	require.NoError(t, db.Permission.Create().SetName("平台管理").SetCode(constants.SystemPlatformAdminPermissionCode).Exec(sysCtx))
+	adminRole, err := db.Role.Create().SetTenantID(1).SetName("租户管理员").SetCode(constants.TenantAdminRoleCode).Save(sysCtx)
+	require.NoError(t, err)
+	staffRole, err := db.Role.Create().SetTenantID(1).SetName("员工").SetCode("staff").Save(sysCtx)
+	require.NoError(t, err)
+
+	permissionRepo := data.NewPermissionRepo(bctx, entClient, data.NewPermissionApiRepo(bctx, entClient), data.NewPermissionMenuRepo(bctx, entClient))
+	rolePermissionRepo := data.NewRolePermissionRepo(bctx, entClient)
+	roleRepo := data.NewRoleRepo(bctx, entClient, rolePermissionRepo, permissionRepo, data.NewRoleMetadataRepo(bctx, entClient))
+
+	// 启动时补齐字段权限
+	NewPermissionService(bctx, permissionRepo, nil, nil, nil, roleRepo, nil, nil)
+
+	fieldIDs, err := permissionRepo.GetPermissionIDsByCodes(sysCtx, constants.FieldPermissionCodes)
+	require.NoError(t, err)
+	assert.Len(t, fieldIDs, len(constants.FieldPermissionCodes))
+
+	// 管理员角色仅获得写入类字段权限与动作权限，查看明文的权限不自动授予
+	adminIDs, err := permissionRepo.GetPermissionIDsByCodes(sysCtx, append(constants.AdminFieldPermissionCodes, constants.ActionPermissionCodes...))
+	require.NoError(t, err)
+	granted, err := rolePermissionRepo.ListPermissionIDs(sysCtx, adminRole.ID)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, adminIDs, granted)
+
+	codes, err := permissionRepo.GetPermissionCodesByIDs(sysCtx, granted)
+	require.NoError(t, err)
+	assert.NotContains(t, codes, constants.UserMobileViewPermissionCode)
+	assert.NotContains(t, codes, constants.UserEmailViewPermissionCode)
+
+	granted, err = rolePermissionRepo.ListPermissionIDs(sysCtx, staffRole.ID)
+	require.NoError(t, err)
+	assert.Empty(t, granted)
+}
+
+func TestPermissionService_MigrateActionPermissions(t *testing.T) {
+	entClient := enttest.NewEntClientForTest(t)
+	bctx := bootstrap.NewContextWithParam(context.Background(), &conf.AppInfo{}, &conf.Bootstrap{}, log.DefaultLogger)
+	sysCtx := enttest.NewSystemViewerCtx(context.Background())
+	db := entClient.Client()
+
+	const tenantID = 9701
+	// 升级前的库：租户管理员角色仅有接口关联的权限，未解析出动作权限码
+	require.NoError(t, db.Permission.Create().SetName("租户管理员权限").SetCode(constants.SystemTenantManagerPermissionCode).Exec(sysCtx))
+	require.NoError(t, db.Role.Create().SetTenantID(tenantID).SetName("租户管理员").SetCode(constants.TenantAdminRoleCode).
+		SetStatus(role.StatusOn).Exec(sysCtx))
+	require.NoError(t, db.Role.Create().SetTenantID(tenantID).SetName("员工").SetCode("staff").
+		SetStatus(role.StatusOn).Exec(sysCtx))
+
+	permissionRepo := data.NewPermissionRepo(bctx, entClient, data.NewPermissionApiRepo(bctx, entClient), data.NewPermissionMenuRepo(bctx, entClient))
+	roleRepo := data.NewRoleRepo(bctx, entClient, data.NewRolePermissionRepo(bctx, entClient), permissionRepo, data.NewRoleMetadataRepo(bctx, entClient))
+	resolver := data.NewPermissionCodeResolver(bctx, entClient)
+
+	NewPermissionService(bctx, permissionRepo, nil, nil, nil, roleRepo, nil, resolver)
+
+	tenantCtx := func(roleCode string) context.Context {
+		codes, err := resolver.ResolvePermissionCodes(context.Background(), tenantID, 9701, []string{roleCode})
+		require.NoError(t, err)
+		return viewer.WithContext(context.Background(),
+			appViewer.NewUserViewer(9701, tenantID, 0, "", identityV1.DataScope_ALL, []string{roleCode}, codes))
+	}
+	createUser := func(ctx context.Context, username string) error {
+		return db.User.Create().SetTenantID(tenantID).SetUsername(username).SetStatus(user.StatusNormal).Exec(ctx)
+	}
+
+	// 租户管理员经内置动作权限可写入，普通角色被动作级权限规则拒绝
+	assert.NoError(t, createUser(tenantCtx(constants.TenantAdminRoleCode), "member9701"))
+	assert.NoError(t, db.OrgUnit.Create().SetTenantID(tenantID).SetName("研发部").Exec(tenantCtx(constants.TenantAdminRoleCode)))
+	assert.Error(t, createUser(tenantCtx("staff"), "member9702"))
+
+	// SCIM 令牌的租户视图
+	scimCtx := viewer.WithContext(context.Background(),
+		appViewer.NewUserViewer(0, tenantID, 0, "", identityV1.DataScope_ALL, nil, scimPermissionCodes))
+	assert.NoError(t, createUser(scimCtx, "scim9703"))
+
+	// LDAP 同步等后台任务以系统视图运行
+	assert.NoError(t, createUser(appViewer.NewSystemViewerContext(context.Background()), "ldap9704"))
+
+	// SAML/OIDC 登录时的 JIT 开通经认证流程放行
+	loginCtx := (&AuthenticationService{}).resetContextForLogin(context.Background())
+	assert.NoError(t, createUser(loginCtx, "saml9705"))
+}
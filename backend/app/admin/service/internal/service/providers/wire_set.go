@@ -41,11 +41,26 @@
 	service.NewInternalMessageCategoryService,
 	service.NewInternalMessageRecipientService,
 	service.NewLoginPolicyService,
+	service.NewPasswordPolicyService,
+	service.NewApiClientService,
+	service.NewOAuthServerService,
+	service.NewOidcService,
+	service.NewOAuthService,
+	service.NewOAuthProviderConfigService,
+	service.NewSamlService,
+	service.NewSamlConfigService,
+	service.NewLdapConfigService,
+	service.NewScimService,
+	service.NewScimTokenService,
+	service.NewJwtSigningKeyService,
 	service.NewUserProfileService,
 	service.NewUserCredentialService,
 	service.NewApiService,
 	service.NewPermissionService,
 	service.NewPermissionGroupService,
+	service.NewPermissionPolicyService,
+	service.NewPermissionPolicyEvaluator,
+	service.NewRelationTupleService,
 	service.NewPolicyEvaluationLogService,
 	service.NewPermissionAuditLogService,
 	service.NewDataAccessAuditLogService,
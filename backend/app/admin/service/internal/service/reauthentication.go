@@ -0,0 +1,153 @@
+package service
+
+import (
+	"context"
+
+	"github.com/tx7do/go-utils/trans"
+
+	auditV1 "go-wind-admin/api/gen/go/audit/service/v1"
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+	identityV1 "go-wind-admin/api/gen/go/identity/service/v1"
+
+	"go-wind-admin/pkg/middleware/auth"
+	"go-wind-admin/pkg/netutil"
+)
+
+// 二次验证（Step-up）：
+//   - 改密、MFA 变更、角色编辑等敏感操作要求访问令牌的身份验证时间（auth_time）足够新，
+//     超时由认证中间件返回 REAUTHENTICATION_REQUIRED；
+//   - 用户以密码或 TOTP 重新验证后，在当前会话内换发令牌对（auth_time 为当前时间），原令牌立即作废；
+//   - 验证失败与登录共用限流计数（IP + 用户名双维度），成功与失败均记操作审计。
+
+// Reauthenticate 重新验证当前登录用户的身份，返回携带最新身份验证时间的令牌对
+func (s *AuthenticationService) Reauthenticate(ctx context.Context, req *authenticationV1.ReauthenticateRequest) (*authenticationV1.LoginResponse, error) {
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.reauthenticate(ctx, operator, req)
+	if err != nil {
+		s.log.Warnf("user [%d] reauthenticate failed: %s", operator.GetUserId(), err.Error())
+	} else {
+		s.log.Infof("user [%d] reauthenticated in session [%s]", operator.GetUserId(), operator.GetSessionId())
+	}
+	// 请求含密码/验证码，不记快照
+	s.auditor.record(ctx, auditResourceReauthentication, operator.GetUserId(), auditV1.OperationAuditLog_OTHER, nil, nil, err)
+
+	return resp, err
+}
+
+func (s *AuthenticationService) reauthenticate(
+	ctx context.Context,
+	operator *authenticationV1.UserTokenPayload,
+	req *authenticationV1.ReauthenticateRequest,
+) (*authenticationV1.LoginResponse, error) {
+	// 代登录令牌的操作人不是令牌用户本人，服务客户端令牌没有可验证的用户
+	if operator.GetActorUserId() != 0 || operator.GetApiClientId() != 0 || operator.GetUserId() == 0 {
+		return nil, authenticationV1.ErrorForbidden("reauthentication not allowed")
+	}
+	if req.GetPassword() == "" && req.GetTotpCode() == "" {
+		return nil, authenticationV1.ErrorBadRequest("password or totp code is required")
+	}
+
+	clientIP := netutil.ClientIPFromContext(ctx)
+	username := operator.GetUsername()
+
+	// 与登录共用限流：防止盗用的会话借二次验证暴力猜测密码
+	if s.rateLimiter != nil {
+		if locked, lerr := s.rateLimiter.IsLocked(ctx, clientIP, username); lerr != nil {
+			s.log.Errorf("reauthentication rate limiter pre-check failed: %s", lerr.Error())
+		} else if locked {
+			s.log.Warnf("reauthentication blocked by rate limiter: ip=%s, username=%s", clientIP, username)
+			return nil, authenticationV1.ErrorBadRequest("too many login failures, please try again later")
+		}
+	}
+
+	// 以系统身份校验凭证、读取用户的角色与权限
+	sysCtx := s.resetContextForLogin(ctx)
+
+	if err := s.verifyReauthentication(sysCtx, operator, req); err != nil {
+		s.log.Errorf("verify reauthentication failed for user [%d]: %s", operator.GetUserId(), err.Error())
+		s.incrLoginFailure(ctx, clientIP, username)
+		return nil, authenticationV1.ErrorInvalidPassword("reauthentication failed")
+	}
+
+	user, err := s.userRepo.Get(sysCtx, &identityV1.GetUserRequest{
+		QueryBy: &identityV1.GetUserRequest_Id{Id: operator.GetUserId()},
+	})
+	if err != nil || user == nil {
+		return nil, authenticationV1.ErrorNotFound("user not found")
+	}
+
+	// 沿用当前会话与令牌（会话 ID、jti 用于换发与吊销），角色与权限按最新状态重新判定
+	tokenPayload := &authenticationV1.UserTokenPayload{
+		UserId:    user.GetId(),
+		TenantId:  user.TenantId,
+		Username:  user.Username,
+		ClientId:  operator.ClientId,
+		DeviceId:  operator.DeviceId,
+		SessionId: operator.SessionId,
+		Jti:       operator.Jti,
+		Scopes:    operator.GetScopes(),
+	}
+	if err = s.resolveUserAuthority(sysCtx, user, tokenPayload); err != nil {
+		return nil, err
+	}
+
+	accessToken, refreshToken, err := s.authenticator.ReauthenticateUserToken(ctx, s.clientType, tokenPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.rateLimiter != nil {
+		s.rateLimiter.Reset(ctx, clientIP, username)
+	}
+
+	return &authenticationV1.LoginResponse{
+		TokenType:        authenticationV1.TokenType_bearer,
+		AccessToken:      accessToken,
+		RefreshToken:     trans.Ptr(refreshToken),
+		ExpiresIn:        int64(s.authenticator.GetAccessTokenExpires(s.clientType).Seconds()),
+		RefreshExpiresIn: trans.Ptr(int64(s.authenticator.GetRefreshTokenExpires(s.clientType).Seconds())),
+	}, nil
+}
+
+// verifyReauthentication 校验二次验证凭证：优先 TOTP（已启用 MFA 的用户），否则校验密码。
+// 密码按令牌所属租户校验，启用 LDAP 的租户经目录校验；凭证解析出的用户必须是令牌用户本人。
+func (s *AuthenticationService) verifyReauthentication(
+	ctx context.Context,
+	operator *authenticationV1.UserTokenPayload,
+	req *authenticationV1.ReauthenticateRequest,
+) error {
+	if req.GetTotpCode() != "" {
+		if s.mfaFactorRepo == nil {
+			return authenticationV1.ErrorBadRequest("mfa is not available")
+		}
+		_, secret, err := s.mfaFactorRepo.FindEnabledTotpForUser(ctx, operator.GetTenantId(), operator.GetUserId())
+		if err != nil {
+			return err
+		}
+		if !validateTotpCode(req.GetTotpCode(), secret) {
+			return authenticationV1.ErrorInvalidPassword("invalid totp code")
+		}
+		return nil
+	}
+
+	userID, viaLdap, err := s.authenticateWithLdap(ctx, operator.GetTenantId(), operator.GetUsername(), req.GetPassword())
+	if viaLdap && err != nil {
+		return err
+	}
+	if !viaLdap {
+		if userID, err = s.userCredentialRepo.FindUserCredential(
+			ctx, operator.GetTenantId(), authenticationV1.UserCredential_USERNAME, operator.GetUsername(), req.GetPassword(), true,
+		); err != nil {
+			return err
+		}
+	}
+
+	if userID != operator.GetUserId() {
+		return authenticationV1.ErrorInvalidPassword("credential does not belong to current user")
+	}
+	return nil
+}
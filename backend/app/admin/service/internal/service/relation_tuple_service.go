@@ -0,0 +1,269 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sort"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	adminV1 "go-wind-admin/api/gen/go/admin/service/v1"
+	permissionV1 "go-wind-admin/api/gen/go/permission/service/v1"
+
+	"go-wind-admin/pkg/authorizer"
+	"go-wind-admin/pkg/authorizer/zanzibar"
+	"go-wind-admin/pkg/middleware/auth"
+)
+
+// RelationTupleService 关系元组（ReBAC）服务。
+// 元组为平台级数据，写入仅限平台管理员；api 命名空间由角色权限同步生成，不接受手工写入。
+type RelationTupleService struct {
+	adminV1.RelationTupleServiceHTTPServer
+
+	log *log.Helper
+
+	authorizer *authorizer.Authorizer
+}
+
+func NewRelationTupleService(
+	ctx *bootstrap.Context,
+	authorizer *authorizer.Authorizer,
+) *RelationTupleService {
+	return &RelationTupleService{
+		log:        ctx.NewLoggerHelper("relation-tuple/service/admin-service"),
+		authorizer: authorizer,
+	}
+}
+
+func (s *RelationTupleService) engine() (*zanzibar.Engine, error) {
+	if s.authorizer == nil || s.authorizer.Relations() == nil {
+		return nil, permissionV1.ErrorServiceUnavailable("relation engine is not available")
+	}
+	return s.authorizer.Relations(), nil
+}
+
+func (s *RelationTupleService) ListTuples(ctx context.Context, req *permissionV1.ListRelationTuplesRequest) (*permissionV1.ListRelationTuplesResponse, error) {
+	engine, err := s.engine()
+	if err != nil {
+		return nil, err
+	}
+
+	filter := zanzibar.TupleFilter{
+		Namespace: req.GetNamespace(),
+		ObjectID:  req.GetObjectId(),
+		Relation:  req.GetRelation(),
+	}
+	if req.GetSubject() != "" {
+		subject, perr := zanzibar.ParseSubject(req.GetSubject())
+		if perr != nil {
+			return nil, permissionV1.ErrorBadRequest("%s", perr.Error())
+		}
+		filter.Subject = &subject
+	}
+
+	tuples, err := engine.ReadTuples(ctx, filter)
+	if err != nil {
+		return nil, relationError(err)
+	}
+
+	items := make([]*permissionV1.RelationTuple, 0, len(tuples))
+	for _, t := range tuples {
+		items = append(items, tupleToProto(t))
+	}
+
+	return &permissionV1.ListRelationTuplesResponse{
+		Items: items,
+		Total: uint64(len(items)),
+	}, nil
+}
+
+func (s *RelationTupleService) WriteTuples(ctx context.Context, req *permissionV1.WriteRelationTuplesRequest) (*permissionV1.WriteRelationTuplesResponse, error) {
+	engine, err := s.engine()
+	if err != nil {
+		return nil, err
+	}
+
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !operator.GetIsPlatformAdmin() {
+		return nil, permissionV1.ErrorForbidden("only platform admin can write relation tuples")
+	}
+
+	if len(req.GetWrites()) == 0 && len(req.GetDeletes()) == 0 {
+		return nil, permissionV1.ErrorBadRequest("no relation tuples to write")
+	}
+
+	writes, err := tuplesFromProto(req.GetWrites())
+	if err != nil {
+		return nil, err
+	}
+	deletes, err := tuplesFromProto(req.GetDeletes())
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := engine.Write(ctx, writes, deletes)
+	if err != nil {
+		return nil, relationError(err)
+	}
+
+	s.log.Infof("relation tuples written by [%d]: +%d -%d", operator.GetUserId(), len(writes), len(deletes))
+
+	return &permissionV1.WriteRelationTuplesResponse{ConsistencyToken: token}, nil
+}
+
+func (s *RelationTupleService) Check(ctx context.Context, req *permissionV1.CheckRelationRequest) (*permissionV1.CheckRelationResponse, error) {
+	engine, err := s.engine()
+	if err != nil {
+		return nil, err
+	}
+
+	object := zanzibar.Object{Namespace: req.GetNamespace(), ID: req.GetObjectId()}
+	subject, err := zanzibar.ParseSubject(req.GetSubject())
+	if err != nil {
+		return nil, permissionV1.ErrorBadRequest("%s", err.Error())
+	}
+
+	allowed, token, err := engine.Check(ctx, object, req.GetRelation(), subject, req.GetConsistencyToken())
+	if err != nil {
+		return nil, relationError(err)
+	}
+
+	return &permissionV1.CheckRelationResponse{
+		Allowed:          allowed,
+		ConsistencyToken: token,
+	}, nil
+}
+
+func (s *RelationTupleService) Expand(ctx context.Context, req *permissionV1.ExpandRelationRequest) (*permissionV1.ExpandRelationResponse, error) {
+	engine, err := s.engine()
+	if err != nil {
+		return nil, err
+	}
+
+	object := zanzibar.Object{Namespace: req.GetNamespace(), ID: req.GetObjectId()}
+	tree, token, err := engine.Expand(ctx, object, req.GetRelation(), req.GetConsistencyToken())
+	if err != nil {
+		return nil, relationError(err)
+	}
+
+	return &permissionV1.ExpandRelationResponse{
+		Tree:             expandNodeToProto(tree),
+		ConsistencyToken: token,
+	}, nil
+}
+
+func (s *RelationTupleService) ListObjects(ctx context.Context, req *permissionV1.ListRelationObjectsRequest) (*permissionV1.ListRelationObjectsResponse, error) {
+	engine, err := s.engine()
+	if err != nil {
+		return nil, err
+	}
+
+	subject, err := zanzibar.ParseSubject(req.GetSubject())
+	if err != nil {
+		return nil, permissionV1.ErrorBadRequest("%s", err.Error())
+	}
+
+	ids, token, err := engine.ListObjects(ctx, req.GetNamespace(), req.GetRelation(), subject, req.GetConsistencyToken())
+	if err != nil {
+		return nil, relationError(err)
+	}
+
+	return &permissionV1.ListRelationObjectsResponse{
+		ObjectIds:        ids,
+		ConsistencyToken: token,
+	}, nil
+}
+
+func (s *RelationTupleService) ListNamespaces(_ context.Context, _ *emptypb.Empty) (*permissionV1.ListRelationNamespacesResponse, error) {
+	engine, err := s.engine()
+	if err != nil {
+		return nil, err
+	}
+
+	var items []*permissionV1.RelationNamespace
+	for _, ns := range engine.Namespaces() {
+		relations := make([]string, 0, len(ns.Relations))
+		for name := range ns.Relations {
+			relations = append(relations, name)
+		}
+		sort.Strings(relations)
+
+		config, _ := json.Marshal(ns)
+
+		items = append(items, &permissionV1.RelationNamespace{
+			Name:      ns.Name,
+			Relations: relations,
+			Config:    string(config),
+		})
+	}
+
+	return &permissionV1.ListRelationNamespacesResponse{Items: items}, nil
+}
+
+func tuplesFromProto(items []*permissionV1.RelationTuple) ([]zanzibar.Tuple, error) {
+	tuples := make([]zanzibar.Tuple, 0, len(items))
+	for _, item := range items {
+		if item == nil {
+			continue
+		}
+
+		t, err := zanzibar.ParseTuple(item.GetNamespace() + ":" + item.GetObjectId() + "#" + item.GetRelation() + "@" + item.GetSubject())
+		if err != nil {
+			return nil, permissionV1.ErrorBadRequest("%s", err.Error())
+		}
+		if t.Object.Namespace == zanzibar.APINamespace {
+			return nil, permissionV1.ErrorBadRequest("namespace %s is generated from role permissions and cannot be written", zanzibar.APINamespace)
+		}
+
+		tuples = append(tuples, t)
+	}
+	return tuples, nil
+}
+
+func tupleToProto(t zanzibar.Tuple) *permissionV1.RelationTuple {
+	return &permissionV1.RelationTuple{
+		Namespace: t.Object.Namespace,
+		ObjectId:  t.Object.ID,
+		Relation:  t.Relation,
+		Subject:   t.Subject.String(),
+	}
+}
+
+func expandNodeToProto(node *zanzibar.ExpandNode) *permissionV1.RelationUsersetNode {
+	if node == nil {
+		return nil
+	}
+
+	pb := &permissionV1.RelationUsersetNode{
+		Operation: node.Operation,
+		Object:    node.Object.String(),
+		Relation:  node.Relation,
+	}
+	for _, subject := range node.Subjects {
+		pb.Subjects = append(pb.Subjects, subject.String())
+	}
+	for _, child := range node.Children {
+		pb.Children = append(pb.Children, expandNodeToProto(child))
+	}
+	return pb
+}
+
+// relationError 存储错误原样返回；令牌版本尚不可见提示稍后重试；其余为请求参数错误
+func relationError(err error) error {
+	var se *kerrors.Error
+	if errors.As(err, &se) {
+		return err
+	}
+	if errors.Is(err, zanzibar.ErrRevisionUnavailable) {
+		return permissionV1.ErrorServiceUnavailable("%s", err.Error())
+	}
+	return permissionV1.ErrorBadRequest("%s", err.Error())
+}
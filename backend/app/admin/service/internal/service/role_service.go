@@ -8,11 +8,13 @@
 	"github.com/tx7do/go-utils/aggregator"
 	"github.com/tx7do/go-utils/trans"
 	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	"go-wind-admin/app/admin/service/internal/data"
 
 	adminV1 "go-wind-admin/api/gen/go/admin/service/v1"
+	auditV1 "go-wind-admin/api/gen/go/audit/service/v1"
 	identityV1 "go-wind-admin/api/gen/go/identity/service/v1"
 	permissionV1 "go-wind-admin/api/gen/go/permission/service/v1"
 
@@ -20,6 +22,7 @@
 	"go-wind-admin/pkg/constants"
 	appViewer "go-wind-admin/pkg/entgo/viewer"
 	"go-wind-admin/pkg/middleware/auth"
+	"go-wind-admin/pkg/middleware/fieldperm"
 	"go-wind-admin/pkg/utils"
 )
 
@@ -32,6 +35,11 @@ type RoleService struct {
 
 	roleRepo   *data.RoleRepo
 	tenantRepo *data.TenantRepo
+
+	dataScopeResolver      *data.DataScopeResolver
+	permissionCodeResolver *data.PermissionCodeResolver
+
+	auditor *operationAuditor
 }
 
 func NewRoleService(
@@ -39,12 +47,20 @@ func NewRoleService(
 	authorizer *authorizer.Authorizer,
 	roleRepo *data.RoleRepo,
 	tenantRepo *data.TenantRepo,
+	dataScopeResolver *data.DataScopeResolver,
+	permissionCodeResolver *data.PermissionCodeResolver,
+	operationAuditLogRepo *data.OperationAuditLogRepo,
 ) *RoleService {
+	l := ctx.NewLoggerHelper("role/service/admin-service")
 	svc := &RoleService{
-		log:        ctx.NewLoggerHelper("role/service/admin-service"),
+		log:        l,
 		authorizer: authorizer,
 		roleRepo:   roleRepo,
 		tenantRepo: tenantRepo,
+		auditor:    newOperationAuditor(l, operationAuditLogRepo),
+
+		dataScopeResolver:      dataScopeResolver,
+		permissionCodeResolver: permissionCodeResolver,
 	}
 
 	svc.init()
@@ -169,7 +185,9 @@ func (s *RoleService) Create(ctx context.Context, req *permissionV1.CreateRoleRe
 		req.Data.Type = trans.Ptr(permissionV1.Role_TENANT)
 	}
 
-	if err = s.roleRepo.Create(ctx, req); err != nil {
+	role, err := s.roleRepo.Create(ctx, req)
+	s.auditor.record(ctx, auditResourceRole, role.GetId(), auditV1.OperationAuditLog_CREATE, nil, role, err)
+	if err != nil {
 		return nil, err
 	}
 
@@ -236,9 +254,17 @@ func (s *RoleService) Update(ctx context.Context, req *permissionV1.UpdateRoleRe
 
 	if err = s.roleRepo.Update(ctx, req); err != nil {
 		s.log.Errorf("update role error: %v", err)
+		s.auditor.record(ctx, auditResourceRole, req.GetId(), auditV1.OperationAuditLog_UPDATE, r, nil, err)
 		return nil, err
 	}
 
+	after, _ := s.roleRepo.Get(ctx, &permissionV1.GetRoleRequest{
+		QueryBy: &permissionV1.GetRoleRequest_Id{Id: req.GetId()},
+	})
+	s.auditor.record(ctx, auditResourceRole, req.GetId(), auditV1.OperationAuditLog_UPDATE, r, after, nil)
+
+	s.invalidateRoleCaches()
+
 	if err = s.authorizer.ResetPolicies(ctx); err != nil {
 		s.log.Errorf("reset policies error: %v", err)
 	}
@@ -249,10 +275,18 @@ func (s *RoleService) Update(ctx context.Context, req *permissionV1.UpdateRoleRe
 func (s *RoleService) Delete(ctx context.Context, req *permissionV1.DeleteRoleRequest) (*emptypb.Empty, error) {
 	var err error
 
-	if err = s.roleRepo.Delete(ctx, req); err != nil {
+	before, _ := s.roleRepo.Get(ctx, &permissionV1.GetRoleRequest{
+		QueryBy: &permissionV1.GetRoleRequest_Id{Id: req.GetId()},
+	})
+
+	err = s.roleRepo.Delete(ctx, req)
+	s.auditor.record(ctx, auditResourceRole, req.GetId(), auditV1.OperationAuditLog_DELETE, before, nil, err)
+	if err != nil {
 		return nil, err
 	}
 
+	s.invalidateRoleCaches()
+
 	if err = s.authorizer.ResetPolicies(ctx); err != nil {
 		s.log.Errorf("reset policies error: %v", err)
 	}
@@ -260,6 +294,16 @@ func (s *RoleService) Delete(ctx context.Context, req *permissionV1.DeleteRoleRe
 	return &emptypb.Empty{}, nil
 }
 
+// invalidateRoleCaches 角色变更后清空数据权限与用户权限码缓存
+func (s *RoleService) invalidateRoleCaches() {
+	if s.dataScopeResolver != nil {
+		s.dataScopeResolver.Invalidate()
+	}
+	if s.permissionCodeResolver != nil {
+		s.permissionCodeResolver.Invalidate()
+	}
+}
+
 func (s *RoleService) GetRoleCodesByRoleIds(ctx context.Context, req *permissionV1.GetRoleCodesByRoleIdsRequest) (*permissionV1.GetRoleCodesByRoleIdsResponse, error) {
 	ids, err := s.roleRepo.ListRoleCodesByRoleIds(ctx, req.GetRoleIds())
 	if err != nil {
@@ -300,7 +344,7 @@ func (s *RoleService) createDefaultRoles(ctx context.Context) error {
 	var err error
 
 	for _, d := range constants.DefaultRoles {
-		err = s.roleRepo.Create(ctx, &permissionV1.CreateRoleRequest{
+		_, err = s.roleRepo.Create(ctx, &permissionV1.CreateRoleRequest{
 			Data: d,
 		})
 		if err != nil {
@@ -310,3 +354,23 @@ func (s *RoleService) createDefaultRoles(ctx context.Context) error {
 
 	return nil
 }
+
+// FieldRules 角色字段的写入权限：数据权限范围决定角色成员可见的数据，
+// 仅具备 role:data-scope:edit、role:data-scope-org-unit-ids:edit 权限码方可修改
+func (s *RoleService) FieldRules() []fieldperm.Rule {
+	role := (&permissionV1.Role{}).ProtoReflect().Descriptor().FullName()
+
+	return []fieldperm.Rule{
+		{Message: role, Field: "data_scope", Write: true},
+		{Message: role, Field: "data_scope_org_unit_ids", Write: true},
+	}
+}
+
+// LoadFieldCurrent 加载被更新角色的当前值，数据权限未改动时不校验写入权限
+func (s *RoleService) LoadFieldCurrent(ctx context.Context, req proto.Message) (proto.Message, error) {
+	r, ok := req.(*permissionV1.UpdateRoleRequest)
+	if !ok || r.GetId() == 0 {
+		return nil, nil
+	}
+	return s.roleRepo.Get(ctx, &permissionV1.GetRoleRequest{QueryBy: &permissionV1.GetRoleRequest_Id{Id: r.GetId()}})
+}
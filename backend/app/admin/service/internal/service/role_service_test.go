@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tx7do/go-crud/viewer"
+	"github.com/tx7do/go-utils/trans"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	conf "github.com/tx7do/kratos-bootstrap/api/gen/go/conf/v1"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"go-wind-admin/app/admin/service/internal/data"
+	"go-wind-admin/app/admin/service/internal/data/ent/role"
+	"go-wind-admin/app/admin/service/internal/data/enttest"
+
+	identityV1 "go-wind-admin/api/gen/go/identity/service/v1"
+	permissionV1 "go-wind-admin/api/gen/go/permission/service/v1"
+
+	"go-wind-admin/pkg/constants"
+	appViewer "go-wind-admin/pkg/entgo/viewer"
+	"go-wind-admin/pkg/middleware/fieldperm"
+)
+
+func TestRoleService_FieldRules(t *testing.T) {
+	entClient := enttest.NewEntClientForTest(t)
+	bctx := bootstrap.NewContextWithParam(context.Background(), &conf.AppInfo{}, &conf.Bootstrap{}, log.DefaultLogger)
+	sysCtx := enttest.NewSystemViewerCtx(context.Background())
+
+	const (
+		tenantID = 9501
+		roleID   = 9501
+	)
+	require.NoError(t, entClient.Client().Role.Create().SetID(roleID).SetTenantID(tenantID).SetName("员工").SetCode("staff").
+		SetType(role.TypeTenant).SetStatus(role.StatusOn).SetDataScope(role.DataScopeSelf).Exec(sysCtx))
+
+	permissionRepo := data.NewPermissionRepo(bctx, entClient, data.NewPermissionApiRepo(bctx, entClient), data.NewPermissionMenuRepo(bctx, entClient))
+	roleRepo := data.NewRoleRepo(bctx, entClient, data.NewRolePermissionRepo(bctx, entClient), permissionRepo, data.NewRoleMetadataRepo(bctx, entClient))
+
+	svc := &RoleService{roleRepo: roleRepo}
+	h := fieldperm.Server(svc)(func(context.Context, interface{}) (interface{}, error) { return nil, nil })
+
+	operatorCtx := func(permissions ...string) context.Context {
+		return viewer.WithContext(context.Background(),
+			appViewer.NewUserViewer(9501, tenantID, 0, "", identityV1.DataScope_ALL, []string{"tenant-admin"}, permissions))
+	}
+	update := func(scope identityV1.DataScope) *permissionV1.UpdateRoleRequest {
+		return &permissionV1.UpdateRoleRequest{
+			Id:         roleID,
+			Data:       &permissionV1.Role{Name: trans.Ptr("员工"), DataScope: trans.Ptr(scope)},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"name", "data_scope"}},
+		}
+	}
+
+	// 数据权限范围未变化：整体提交表单无需字段权限
+	_, err := h(operatorCtx(), update(identityV1.DataScope_SELF))
+	assert.NoError(t, err)
+
+	// 扩大数据权限范围需 role:data-scope:edit
+	_, err = h(operatorCtx(), update(identityV1.DataScope_ALL))
+	assert.ErrorIs(t, err, fieldperm.ErrFieldWriteForbidden)
+
+	_, err = h(operatorCtx(constants.RoleDataScopeEditPermissionCode), update(identityV1.DataScope_ALL))
+	assert.NoError(t, err)
+
+	// 自定义组织单元列表单独受 role:data-scope-org-unit-ids:edit 约束
+	req := &permissionV1.UpdateRoleRequest{
+		Id:         roleID,
+		Data:       &permissionV1.Role{DataScopeOrgUnitIds: []uint32{1, 2}},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"data_scope_org_unit_ids"}},
+	}
+	_, err = h(operatorCtx(constants.RoleDataScopeEditPermissionCode), req)
+	assert.ErrorIs(t, err, fieldperm.ErrFieldWriteForbidden)
+
+	_, err = h(operatorCtx(constants.RoleDataScopeOrgUnitsEditPermissionCode), req)
+	assert.NoError(t, err)
+}
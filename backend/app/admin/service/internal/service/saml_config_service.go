@@ -0,0 +1,272 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/log"
+	paginationV1 "github.com/tx7do/go-crud/api/gen/go/pagination/v1"
+	"github.com/tx7do/go-utils/trans"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"go-wind-admin/app/admin/service/internal/data"
+
+	adminV1 "go-wind-admin/api/gen/go/admin/service/v1"
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+	identityV1 "go-wind-admin/api/gen/go/identity/service/v1"
+
+	"go-wind-admin/pkg/middleware/auth"
+	"go-wind-admin/pkg/oauth"
+	"go-wind-admin/pkg/saml"
+)
+
+// samlAttributeKeys 属性映射允许的本地字段。
+var samlAttributeKeys = map[string]bool{
+	data.SamlAttrUsername: true,
+	data.SamlAttrEmail:    true,
+	data.SamlAttrNickname: true,
+	data.SamlAttrRealname: true,
+	data.SamlAttrMobile:   true,
+}
+
+// SamlConfigService SAML 单点登录配置管理（租户维度）。
+type SamlConfigService struct {
+	adminV1.SamlConfigServiceHTTPServer
+
+	log *log.Helper
+
+	repo        *data.SamlConfigRepo
+	roleRepo    *data.RoleRepo
+	orgUnitRepo *data.OrgUnitRepo
+	tenantRepo  *data.TenantRepo
+
+	authenticator *data.Authenticator
+}
+
+func NewSamlConfigService(
+	ctx *bootstrap.Context,
+	repo *data.SamlConfigRepo,
+	roleRepo *data.RoleRepo,
+	orgUnitRepo *data.OrgUnitRepo,
+	tenantRepo *data.TenantRepo,
+	authenticator *data.Authenticator,
+) *SamlConfigService {
+	return &SamlConfigService{
+		log:           ctx.NewLoggerHelper("saml-config/service/admin-service"),
+		repo:          repo,
+		roleRepo:      roleRepo,
+		orgUnitRepo:   orgUnitRepo,
+		tenantRepo:    tenantRepo,
+		authenticator: authenticator,
+	}
+}
+
+// applyIdPMetadata 从 IdP 元数据补全未显式填写的 IdP 字段。
+func applyIdPMetadata(cfg *authenticationV1.SamlConfig) error {
+	if strings.TrimSpace(cfg.GetIdpMetadataXml()) == "" {
+		return nil
+	}
+
+	md, err := saml.ParseIdPMetadata([]byte(cfg.GetIdpMetadataXml()))
+	if err != nil {
+		return adminV1.ErrorBadRequest("invalid idp metadata: %s", err.Error())
+	}
+	if cfg.GetIdpEntityId() == "" {
+		cfg.IdpEntityId = trans.Ptr(md.EntityID)
+	}
+	if cfg.GetIdpSsoUrl() == "" {
+		cfg.IdpSsoUrl = trans.Ptr(md.SSOURL)
+	}
+	if cfg.GetIdpCertificate() == "" {
+		cfg.IdpCertificate = trans.Ptr(saml.EncodeCertificates(md.Certificates))
+	}
+	cfg.IdpMetadataXml = nil
+	return nil
+}
+
+// checkSamlConfig 校验合并后的完整配置。
+func (s *SamlConfigService) checkSamlConfig(ctx context.Context, cfg *authenticationV1.SamlConfig) error {
+	if cfg.GetIdpEntityId() == "" {
+		return adminV1.ErrorBadRequest("idp entity id is required")
+	}
+	if !isValidEndpointURL(cfg.GetIdpSsoUrl()) {
+		return adminV1.ErrorBadRequest("invalid idp sso url: %s", cfg.GetIdpSsoUrl())
+	}
+	if certs, err := saml.ParseCertificates(cfg.GetIdpCertificate()); err != nil || len(certs) == 0 {
+		return adminV1.ErrorBadRequest("invalid idp certificate")
+	}
+	if !oauth.IsValidRedirectURI(cfg.GetLoginRedirectUrl()) {
+		return adminV1.ErrorBadRequest("invalid login redirect url: %s", cfg.GetLoginRedirectUrl())
+	}
+	for key, attr := range cfg.GetAttributeMapping() {
+		if !samlAttributeKeys[key] || strings.TrimSpace(attr) == "" {
+			return adminV1.ErrorBadRequest("invalid attribute mapping: %s", key)
+		}
+	}
+
+	for _, roleId := range cfg.GetDefaultRoleIds() {
+		if _, err := s.roleRepo.CanAssignRole(ctx, roleId); err != nil {
+			return err
+		}
+	}
+	if cfg.GetDefaultOrgUnitId() != 0 {
+		// 组织单元查询受租户隐私过滤
+		exist, err := s.orgUnitRepo.IsExist(ctx, cfg.GetDefaultOrgUnitId())
+		if err != nil {
+			return err
+		}
+		if !exist {
+			return adminV1.ErrorBadRequest("default org unit not found")
+		}
+	}
+
+	return nil
+}
+
+// fillSPEndpoints 回填供 IdP 登记的 SP 实体ID与 ACS 地址。
+func (s *SamlConfigService) fillSPEndpoints(ctx context.Context, items ...*authenticationV1.SamlConfig) {
+	issuer := s.authenticator.Issuer()
+	if issuer == "" || len(items) == 0 {
+		return
+	}
+
+	ids := make([]uint32, 0, len(items))
+	for _, item := range items {
+		ids = append(ids, item.GetTenantId())
+	}
+	tenants, err := s.tenantRepo.ListTenantsByIds(ctx, ids)
+	if err != nil {
+		s.log.Errorf("list tenants for saml configs failed: %s", err.Error())
+		return
+	}
+	codes := make(map[uint32]string, len(tenants))
+	for _, t := range tenants {
+		codes[t.GetId()] = t.GetCode()
+	}
+
+	for _, item := range items {
+		code := codes[item.GetTenantId()]
+		if code == "" {
+			continue
+		}
+		entityID, acsURL := samlSPEndpoints(issuer, code)
+		item.SpEntityId = trans.Ptr(entityID)
+		item.SpAcsUrl = trans.Ptr(acsURL)
+	}
+}
+
+func (s *SamlConfigService) List(ctx context.Context, req *paginationV1.PagingRequest) (*authenticationV1.ListSamlConfigResponse, error) {
+	resp, err := s.repo.List(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	s.fillSPEndpoints(ctx, resp.Items...)
+	return resp, nil
+}
+
+func (s *SamlConfigService) Get(ctx context.Context, req *authenticationV1.GetSamlConfigRequest) (*authenticationV1.SamlConfig, error) {
+	resp, err := s.repo.Get(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	s.fillSPEndpoints(ctx, resp)
+	return resp, nil
+}
+
+func (s *SamlConfigService) Create(ctx context.Context, req *authenticationV1.CreateSamlConfigRequest) (*emptypb.Empty, error) {
+	if req == nil || req.Data == nil {
+		return nil, adminV1.ErrorBadRequest("invalid request")
+	}
+
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// SP 端点以租户编号区分，平台（tenant 0）没有编号，不能配置 SAML
+	if operator.GetTenantId() != 0 {
+		req.Data.TenantId = trans.Ptr(operator.GetTenantId())
+	}
+	if req.Data.GetTenantId() == 0 {
+		return nil, adminV1.ErrorBadRequest("saml requires a tenant")
+	}
+	tenant, err := s.tenantRepo.Get(ctx, &identityV1.GetTenantRequest{
+		QueryBy: &identityV1.GetTenantRequest_Id{Id: req.Data.GetTenantId()},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if tenant.GetCode() == "" {
+		return nil, adminV1.ErrorBadRequest("saml requires a tenant code")
+	}
+
+	if err = applyIdPMetadata(req.Data); err != nil {
+		return nil, err
+	}
+	if err = s.checkSamlConfig(ctx, req.Data); err != nil {
+		return nil, err
+	}
+
+	req.Data.CreatedBy = trans.Ptr(operator.UserId)
+
+	if err = s.repo.Create(ctx, req.Data); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *SamlConfigService) Update(ctx context.Context, req *authenticationV1.UpdateSamlConfigRequest) (*emptypb.Empty, error) {
+	if req == nil || req.Data == nil {
+		return nil, adminV1.ErrorBadRequest("invalid request")
+	}
+
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 查询受租户隐私过滤：先确认配置对当前操作人可见，再以合并后的完整配置做校验
+	current, err := s.repo.Get(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err = applyIdPMetadata(req.Data); err != nil {
+		return nil, err
+	}
+
+	merged := proto.Clone(current).(*authenticationV1.SamlConfig)
+	if req.Data.AttributeMapping != nil {
+		merged.AttributeMapping = nil
+	}
+	if req.Data.DefaultRoleIds != nil {
+		merged.DefaultRoleIds = nil
+	}
+	proto.Merge(merged, req.Data)
+	if err = s.checkSamlConfig(ctx, merged); err != nil {
+		return nil, err
+	}
+
+	req.Data.UpdatedBy = trans.Ptr(operator.UserId)
+
+	if err = s.repo.Update(ctx, req.GetId(), req.Data); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *SamlConfigService) Delete(ctx context.Context, req *authenticationV1.DeleteSamlConfigRequest) (*emptypb.Empty, error) {
+	if err := s.repo.Delete(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,287 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go-wind-admin/app/admin/service/internal/data"
+
+	adminV1 "go-wind-admin/api/gen/go/admin/service/v1"
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+	identityV1 "go-wind-admin/api/gen/go/identity/service/v1"
+
+	"go-wind-admin/pkg/netutil"
+	"go-wind-admin/pkg/saml"
+)
+
+// SAML SP 端点（相对签发者地址），%s 为租户编号。
+const (
+	samlMetadataPathFmt = "/admin/v1/saml/%s/metadata"
+	samlACSPathFmt      = "/admin/v1/saml/%s/acs"
+)
+
+// ACS 跳转前端时携带的错误码（error 查询参数），具体原因只记服务端日志。
+const (
+	samlErrorInvalidResponse = "saml_invalid_response"
+	samlErrorRequestExpired  = "saml_request_expired"
+	samlErrorNotLinked       = "saml_account_not_linked"
+	samlErrorLoginBlocked    = "saml_login_blocked"
+	samlErrorServer          = "saml_server_error"
+)
+
+// samlSPEndpoints 租户 SP 的实体ID（即元数据地址）与 ACS 地址。
+func samlSPEndpoints(issuer, tenantCode string) (entityID, acsURL string) {
+	code := url.PathEscape(tenantCode)
+	return issuer + fmt.Sprintf(samlMetadataPathFmt, code), issuer + fmt.Sprintf(samlACSPathFmt, code)
+}
+
+// withQueryParam 在地址上追加查询参数，保留原有参数。
+func withQueryParam(rawURL, key, value string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return rawURL
+	}
+	q := u.Query()
+	q.Set(key, value)
+	u.RawQuery = q.Encode()
+	return u.String()
+}
+
+// SamlService SAML 2.0 单点登录：go-wind-admin 作为 SP 接入租户自有 IdP（SP 发起，HTTP-Redirect + HTTP-POST 绑定）。
+// StartSamlLogin 登记 AuthnRequest 并返回 IdP 跳转地址；IdP 将断言 POST 到 ACS，
+// 校验签名、受众、时效与 InResponseTo 后找到（或按需创建）用户，签发一次性 ticket 跳转前端，
+// 前端以 ticket 调用 CompleteSamlLogin 换取令牌。
+type SamlService struct {
+	adminV1.SamlServiceHTTPServer
+
+	log *log.Helper
+
+	configRepo   *data.SamlConfigRepo
+	accountRepo  *data.SamlAccountRepo
+	sessionCache *data.SamlSessionCache
+
+	authnService *AuthenticationService
+}
+
+func NewSamlService(
+	ctx *bootstrap.Context,
+	configRepo *data.SamlConfigRepo,
+	accountRepo *data.SamlAccountRepo,
+	sessionCache *data.SamlSessionCache,
+	authnService *AuthenticationService,
+) *SamlService {
+	return &SamlService{
+		log:          ctx.NewLoggerHelper("saml/service/admin-service"),
+		configRepo:   configRepo,
+		accountRepo:  accountRepo,
+		sessionCache: sessionCache,
+		authnService: authnService,
+	}
+}
+
+// loadServiceProvider 按租户编号取启用的 SAML 配置并构造 SP。
+func (s *SamlService) loadServiceProvider(ctx context.Context, tenantCode string) (*data.SamlSettings, *saml.ServiceProvider, error) {
+	if strings.TrimSpace(tenantCode) == "" {
+		return nil, nil, authenticationV1.ErrorBadRequest("invalid tenant")
+	}
+
+	tenantID, err := s.authnService.resolveLoginTenant(ctx, tenantCode)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	settings, err := s.configRepo.GetEnabled(ctx, tenantID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	issuer := s.authnService.authenticator.Issuer()
+	if issuer == "" {
+		s.log.Error("saml sso requires authn.oidc.issuer_url as the public base url")
+		return nil, nil, authenticationV1.ErrorServiceUnavailable("saml not available")
+	}
+
+	entityID, acsURL := samlSPEndpoints(issuer, tenantCode)
+	return settings, &saml.ServiceProvider{
+		EntityID:     entityID,
+		ACSURL:       acsURL,
+		NameIDFormat: settings.NameIDFormat,
+		IdP:          settings.IdP,
+	}, nil
+}
+
+// Metadata 生成租户 SP 元数据（公开端点，供 IdP 导入）。
+func (s *SamlService) Metadata(ctx context.Context, tenantCode string) ([]byte, error) {
+	ctx = s.authnService.resetContextForLogin(ctx)
+
+	_, sp, err := s.loadServiceProvider(ctx, tenantCode)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := sp.Metadata()
+	if err != nil {
+		s.log.Errorf("build saml sp metadata failed: %s", err.Error())
+		return nil, authenticationV1.ErrorInternalServerError("build saml metadata failed")
+	}
+	return out, nil
+}
+
+// StartSamlLogin 发起单点登录：登记 AuthnRequest，返回 IdP 跳转地址。
+func (s *SamlService) StartSamlLogin(ctx context.Context, req *authenticationV1.StartSamlLoginRequest) (*authenticationV1.StartSamlLoginResponse, error) {
+	if req == nil {
+		return nil, authenticationV1.ErrorBadRequest("invalid request")
+	}
+
+	ctx = s.authnService.resetContextForLogin(ctx)
+
+	settings, sp, err := s.loadServiceProvider(ctx, req.GetTenantCode())
+	if err != nil {
+		return nil, err
+	}
+
+	redirectURL, requestID, err := sp.AuthnRequestURL("", time.Now())
+	if err != nil {
+		s.log.Errorf("build saml authn request for tenant [%d] failed: %s", settings.TenantID, err.Error())
+		return nil, authenticationV1.ErrorInternalServerError("saml config invalid")
+	}
+
+	expiresAt, err := s.sessionCache.RegisterRequest(ctx, requestID, &data.SamlRequest{
+		TenantID:   settings.TenantID,
+		ClientType: int32(req.GetClientType()),
+	})
+	if err != nil {
+		s.log.Errorf("register saml request failed: %s", err.Error())
+		return nil, authenticationV1.ErrorServiceUnavailable("saml session unavailable")
+	}
+
+	return &authenticationV1.StartSamlLoginResponse{
+		RedirectUrl: redirectURL,
+		DisplayName: settings.DisplayName,
+		ExpiresAt:   timestamppb.New(expiresAt),
+	}, nil
+}
+
+// ConsumeAssertion 处理 IdP 回送到 ACS 的 SAMLResponse，返回浏览器下一步跳转的前端地址：
+// 成功时携带一次性 ticket，失败时携带 error 错误码。租户或配置不存在时返回错误（无处可跳）。
+func (s *SamlService) ConsumeAssertion(ctx context.Context, tenantCode, samlResponse string) (string, error) {
+	ctx = s.authnService.resetContextForLogin(ctx)
+	clientIP := netutil.ClientIPFromContext(ctx)
+
+	settings, sp, err := s.loadServiceProvider(ctx, tenantCode)
+	if err != nil {
+		return "", err
+	}
+	fail := func(code string) (string, error) {
+		return withQueryParam(settings.LoginRedirectURL, "error", code), nil
+	}
+
+	// 登录策略闸门（全局部分）：在解析断言之前拦截
+	if s.authnService.loginPolicyRepo != nil {
+		if blocked, reason := s.authnService.checkLoginPolicies(ctx, settings.TenantID, data.LoginPolicySubject{ClientIP: clientIP}); blocked {
+			s.log.Warnf("saml login blocked by policy: ip=%s tenant=%d reason=%s", clientIP, settings.TenantID, reason)
+			return fail(samlErrorLoginBlocked)
+		}
+	}
+
+	assertion, err := sp.ParseResponse(samlResponse, time.Now())
+	if err != nil {
+		s.log.Warnf("saml response of tenant [%d] rejected: %s", settings.TenantID, err.Error())
+		return fail(samlErrorInvalidResponse)
+	}
+
+	// 断言必须对应本方登记、且属于同一租户的请求（拒绝 IdP 发起的登录与跨租户投递）
+	request, err := s.sessionCache.ConsumeRequest(ctx, assertion.InResponseTo)
+	if err != nil {
+		s.log.Warnf("saml response of tenant [%d] answers unknown request [%s]: %s", settings.TenantID, assertion.InResponseTo, err.Error())
+		return fail(samlErrorRequestExpired)
+	}
+	if request.TenantID != settings.TenantID {
+		s.log.Warnf("saml request [%s] issued for tenant [%d] answered at tenant [%d]", assertion.InResponseTo, request.TenantID, settings.TenantID)
+		return fail(samlErrorInvalidResponse)
+	}
+
+	fresh, err := s.sessionCache.MarkAssertion(ctx, settings.TenantID, assertion.ID, assertion.NotOnOrAfter)
+	if err != nil {
+		return fail(samlErrorServer)
+	}
+	if !fresh {
+		s.log.Warnf("saml assertion [%s] of tenant [%d] replayed", assertion.ID, settings.TenantID)
+		return fail(samlErrorInvalidResponse)
+	}
+
+	userID, err := s.accountRepo.FindUserID(ctx, settings.TenantID, assertion.NameID)
+	if err != nil {
+		return fail(samlErrorServer)
+	}
+	if userID == 0 {
+		if !settings.JitProvisioning {
+			s.log.Warnf("saml login: account [%s] not linked in tenant [%d]", assertion.NameID, settings.TenantID)
+			return fail(samlErrorNotLinked)
+		}
+
+		var user *identityV1.User
+		user, err = s.accountRepo.Provision(ctx, settings, assertion)
+		if err != nil {
+			s.log.Errorf("provision user for saml account [%s] failed: %s", assertion.NameID, err.Error())
+			return fail(samlErrorServer)
+		}
+		userID = user.GetId()
+		s.log.Infof("saml login: provisioned user [%d] for account [%s] in tenant [%d]", userID, assertion.NameID, settings.TenantID)
+	}
+
+	ticket, err := s.sessionCache.IssueTicket(ctx, &data.SamlTicket{
+		TenantID:   settings.TenantID,
+		UserID:     userID,
+		ClientType: request.ClientType,
+	})
+	if err != nil {
+		s.log.Errorf("issue saml ticket failed: %s", err.Error())
+		return fail(samlErrorServer)
+	}
+
+	return withQueryParam(settings.LoginRedirectURL, "ticket", ticket), nil
+}
+
+// CompleteSamlLogin 以 ACS 签发的一次性 ticket 换取本系统令牌。
+func (s *SamlService) CompleteSamlLogin(ctx context.Context, req *authenticationV1.CompleteSamlLoginRequest) (*authenticationV1.LoginResponse, error) {
+	if req == nil || req.GetTicket() == "" {
+		return nil, authenticationV1.ErrorBadRequest("invalid request")
+	}
+
+	ctx = s.authnService.resetContextForLogin(ctx)
+	clientIP := netutil.ClientIPFromContext(ctx)
+
+	ticket, err := s.sessionCache.ConsumeTicket(ctx, req.GetTicket())
+	if err != nil {
+		s.log.Warnf("consume saml ticket failed: %s", err.Error())
+		return nil, authenticationV1.ErrorBadRequest("invalid or expired saml ticket")
+	}
+
+	user, err := s.authnService.userRepo.Get(ctx, &identityV1.GetUserRequest{QueryBy: &identityV1.GetUserRequest_Id{Id: ticket.UserID}})
+	if err != nil {
+		s.log.Errorf("get user by id [%d] failed [%s]", ticket.UserID, err.Error())
+		return nil, err
+	}
+
+	// 纵深防御：绑定关系的租户必须与用户行一致
+	if user.GetTenantId() != ticket.TenantID {
+		s.log.Errorf("tenant mismatch for user [%d]: saml tenant [%d] vs user tenant [%d]",
+			user.GetId(), ticket.TenantID, user.GetTenantId())
+		return nil, authenticationV1.ErrorBadRequest("invalid tenant")
+	}
+
+	clientType := authenticationV1.ClientType(ticket.ClientType)
+	if clientType == authenticationV1.ClientType(0) {
+		clientType = s.authnService.clientType
+	}
+
+	return s.authnService.completeUserLogin(ctx, user, clientType, nil, req.DeviceId, clientIP)
+}
@@ -0,0 +1,1105 @@
+package service
+
+import (
+	"cmp"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/go-crud/viewer"
+	"github.com/tx7do/go-utils/sliceutil"
+	"github.com/tx7do/go-utils/timeutil"
+	"github.com/tx7do/go-utils/trans"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	paginationV1 "github.com/tx7do/go-crud/api/gen/go/pagination/v1"
+
+	"go-wind-admin/app/admin/service/internal/data"
+
+	auditV1 "go-wind-admin/api/gen/go/audit/service/v1"
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+	identityV1 "go-wind-admin/api/gen/go/identity/service/v1"
+	permissionV1 "go-wind-admin/api/gen/go/permission/service/v1"
+
+	"go-wind-admin/pkg/authorizer"
+	"go-wind-admin/pkg/constants"
+	appViewer "go-wind-admin/pkg/entgo/viewer"
+	"go-wind-admin/pkg/middleware/auth"
+	"go-wind-admin/pkg/netutil"
+	"go-wind-admin/pkg/scim"
+)
+
+// ScimBasePath SCIM 端点的路径前缀，资源 meta.location 以此拼接。
+const ScimBasePath = "/scim/v2"
+
+// scimOperatorPrefix 审计日志中 SCIM 操作人的用户名前缀，后接令牌名称。
+const scimOperatorPrefix = "scim:"
+
+// scimRoleCodePrefix SCIM 创建的组（租户角色）的角色标识前缀。
+const scimRoleCodePrefix = "scim_"
+
+// ScimService SCIM 2.0 供应服务：身份提供方（Okta、Entra ID 等）以租户令牌推送用户与组。
+//
+// 映射关系：
+//   - User  -> 用户（UserRepo），active 映射到该租户下成员身份（Membership）的状态，停用不删除用户；
+//   - Group -> 租户角色（RoleRepo），members 映射到用户在该租户下的角色分配；
+//   - 企业扩展 department -> 本租户同名组织单元（OrgUnitRepo），不存在时自动创建。
+//
+// externalId 不落库，查询时按 externalId 过滤恒返回空结果，身份提供方会转而按 userName / displayName 匹配。
+type ScimService struct {
+	log *log.Helper
+
+	tokenRepo      *data.ScimTokenRepo
+	userRepo       data.UserRepo
+	userRoleRepo   *data.UserRoleRepo
+	membershipRepo *data.MembershipRepo
+	roleRepo       *data.RoleRepo
+	orgUnitRepo    *data.OrgUnitRepo
+
+	authenticator *data.Authenticator
+	clientType    authenticationV1.ClientType
+	authorizer    *authorizer.Authorizer
+
+	auditor *operationAuditor
+}
+
+func NewScimService(
+	ctx *bootstrap.Context,
+	tokenRepo *data.ScimTokenRepo,
+	userRepo data.UserRepo,
+	userRoleRepo *data.UserRoleRepo,
+	membershipRepo *data.MembershipRepo,
+	roleRepo *data.RoleRepo,
+	orgUnitRepo *data.OrgUnitRepo,
+	authenticator *data.Authenticator,
+	clientType authenticationV1.ClientType,
+	authorizer *authorizer.Authorizer,
+	operationAuditLogRepo *data.OperationAuditLogRepo,
+) *ScimService {
+	l := ctx.NewLoggerHelper("scim/service/admin-service")
+	return &ScimService{
+		log:            l,
+		tokenRepo:      tokenRepo,
+		userRepo:       userRepo,
+		userRoleRepo:   userRoleRepo,
+		membershipRepo: membershipRepo,
+		roleRepo:       roleRepo,
+		orgUnitRepo:    orgUnitRepo,
+		authenticator:  authenticator,
+		clientType:     clientType,
+		authorizer:     authorizer,
+		auditor:        newOperationAuditor(l, operationAuditLogRepo),
+	}
+}
+
+// scimPermissionCodes SCIM 令牌在所属租户内的动作权限：同步用户、组织单元与组（角色）
+var scimPermissionCodes = []string{
+	constants.UserManagePermissionCode,
+	constants.OrgUnitManagePermissionCode,
+	constants.RoleManagePermissionCode,
+}
+
+// Authenticate 校验 Authorization 头中的 SCIM 令牌，返回限定在令牌所属租户的上下文。
+// SCIM 端点不走 JWT 鉴权中间件，租户隔离依赖此处注入的 Viewer（ent 租户隐私规则）。
+func (s *ScimService) Authenticate(ctx context.Context, authorization string) (context.Context, error) {
+	scheme, token, _ := strings.Cut(strings.TrimSpace(authorization), " ")
+	if !strings.EqualFold(scheme, "Bearer") || strings.TrimSpace(token) == "" {
+		return nil, scim.NewError(http.StatusUnauthorized, "", "bearer token required")
+	}
+
+	info, err := s.tokenRepo.Verify(appViewer.NewSystemViewerContext(ctx), strings.TrimSpace(token), netutil.ClientIPFromContext(ctx))
+	if err != nil {
+		return nil, scim.NewError(http.StatusUnauthorized, "", "invalid bearer token")
+	}
+
+	ctx = viewer.WithContext(ctx, appViewer.NewUserViewer(0, uint64(info.TenantID), 0, "", identityV1.DataScope_ALL, nil, scimPermissionCodes))
+	ctx = auth.NewContext(ctx, &authenticationV1.UserTokenPayload{
+		TenantId: trans.Ptr(info.TenantID),
+		Username: trans.Ptr(scimOperatorPrefix + info.Name),
+	})
+
+	return ctx, nil
+}
+
+// ServiceProviderConfig 能力声明。
+func (s *ScimService) ServiceProviderConfig() *scim.ServiceProviderConfig {
+	return scim.NewServiceProviderConfig()
+}
+
+func tenantFromContext(ctx context.Context) uint32 {
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return 0
+	}
+	return operator.GetTenantId()
+}
+
+func parseScimID(id string) (uint32, error) {
+	v, err := strconv.ParseUint(id, 10, 32)
+	if err != nil || v == 0 {
+		return 0, scim.NewError(http.StatusNotFound, "", "resource "+id+" not found")
+	}
+	return uint32(v), nil
+}
+
+func formatScimID(id uint32) string {
+	return strconv.FormatUint(uint64(id), 10)
+}
+
+// scimQuery 构造仓储层的过滤查询串，值经 JSON 编码避免注入。
+func scimQuery(conds map[string]any) string {
+	b, _ := json.Marshal(conds)
+	return string(b)
+}
+
+// scimPaging 将 startIndex / count 换算为页码分页，返回请求及结果前需跳过的条数；count 为 0 时只取 1 条用于统计总数。
+// 偏移分页器（offset / limit）存在参数互换的问题，这里不使用。
+func scimPaging(startIndex, count int, conds map[string]any) (*paginationV1.PagingRequest, int) {
+	size := max(count, 1)
+	skip := startIndex - 1
+
+	page, pageSize := skip/size+1, size
+	if skip%size != 0 {
+		page, pageSize = 1, skip+size
+	} else {
+		skip = 0
+	}
+
+	return &paginationV1.PagingRequest{
+		Page:     trans.Ptr(uint32(page)),
+		PageSize: trans.Ptr(uint32(pageSize)),
+		OrderBy:  trans.Ptr("id"),
+		FilteringType: &paginationV1.PagingRequest_Query{
+			Query: scimQuery(conds),
+		},
+	}, skip
+}
+
+// scimPage 丢弃页码分页多取的前 skip 条。
+func scimPage[T any](items []T, skip int) []T {
+	if skip >= len(items) {
+		return nil
+	}
+	return items[skip:]
+}
+
+func scimTime(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	t := ts.AsTime()
+	return &t
+}
+
+// setMeta 计算 ETag 后填充 meta，ETag 不包含 meta 本身。
+func setMeta(resource any, meta **scim.Meta, resourceType, id string, created, updated *timestamppb.Timestamp) error {
+	etag, err := scim.ETag(resource)
+	if err != nil {
+		return scim.NewError(http.StatusInternalServerError, "", "compute etag failed")
+	}
+
+	path := "/Users/"
+	if resourceType == scim.ResourceTypeGroup {
+		path = "/Groups/"
+	}
+
+	*meta = &scim.Meta{
+		ResourceType: resourceType,
+		Created:      scimTime(created),
+		LastModified: scimTime(updated),
+		Location:     ScimBasePath + path + id,
+		Version:      etag,
+	}
+	return nil
+}
+
+// checkIfMatch 校验 If-Match 头，不携带时不校验。
+func checkIfMatch(ifMatch string, meta *scim.Meta) error {
+	if strings.TrimSpace(ifMatch) == "" || meta == nil {
+		return nil
+	}
+	if !scim.MatchETag(ifMatch, meta.Version) {
+		return scim.NewError(http.StatusPreconditionFailed, "", "resource version mismatch")
+	}
+	return nil
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// Users
+////////////////////////////////////////////////////////////////////////////////
+
+// ListUsers 查询用户，支持 userName / displayName / emails 的 eq 过滤。
+func (s *ScimService) ListUsers(ctx context.Context, filter, startIndex, count string) (*scim.ListResponse, error) {
+	start, size, err := scim.ParseListParams(startIndex, count)
+	if err != nil {
+		return nil, err
+	}
+	f, err := scim.ParseFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	conds := map[string]any{"tenant_id": tenantFromContext(ctx)}
+	switch {
+	case f == nil:
+	case f.Is("userName"):
+		conds["username"] = f.Value
+	case f.Is("displayName"):
+		conds["nickname"] = f.Value
+	case f.Is("emails"), f.Is("emails.value"):
+		conds["email"] = f.Value
+	case f.Is("externalId"), f.Is("id"):
+		return scim.NewListResponse(0, start, nil), nil
+	default:
+		return nil, scim.NewError(http.StatusBadRequest, scim.ErrTypeInvalidFilter, "unsupported filter attribute "+f.Attr)
+	}
+
+	req, skip := scimPaging(start, size, conds)
+	resp, err := s.userRepo.List(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var resources []any
+	if size > 0 {
+		for _, u := range scimPage(resp.GetItems(), skip) {
+			res, err := s.toScimUser(ctx, u)
+			if err != nil {
+				return nil, err
+			}
+			resources = append(resources, res)
+		}
+	}
+
+	return scim.NewListResponse(int(resp.GetTotal()), start, resources), nil
+}
+
+// GetUser 获取用户。
+func (s *ScimService) GetUser(ctx context.Context, id string) (*scim.User, error) {
+	u, err := s.getUser(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.toScimUser(ctx, u)
+}
+
+// CreateUser 创建用户，并在令牌所属租户下建立生效中的成员身份。
+func (s *ScimService) CreateUser(ctx context.Context, in *scim.User) (*scim.User, error) {
+	tenantID := tenantFromContext(ctx)
+
+	username := strings.TrimSpace(in.UserName)
+	if username == "" {
+		return nil, scim.NewError(http.StatusBadRequest, scim.ErrTypeInvalidValue, "userName is required")
+	}
+	if existing, err := s.findUser(ctx, map[string]any{"tenant_id": tenantID, "username": username}); err != nil {
+		return nil, err
+	} else if existing != nil {
+		return nil, scim.NewError(http.StatusConflict, scim.ErrTypeUniqueness, "userName already exists")
+	}
+
+	dto := &identityV1.User{
+		TenantId: trans.Ptr(tenantID),
+		Username: trans.Ptr(username),
+		Status:   identityV1.User_NORMAL.Enum(),
+	}
+	if err := s.fillUserProfile(ctx, nil, dto, nil, in); err != nil {
+		return nil, err
+	}
+
+	created, err := s.userRepo.Create(ctx, &identityV1.CreateUserRequest{Data: dto})
+	if err != nil {
+		s.auditor.record(ctx, auditResourceUser, 0, auditV1.OperationAuditLog_CREATE, nil, nil, err)
+		return nil, err
+	}
+
+	// 一对一模式下创建用户不会产生成员身份记录，停用需要依赖它
+	if err = s.membershipRepo.AssignTenantMembershipWith(ctx, &identityV1.Membership{
+		TenantId:  trans.Ptr(tenantID),
+		UserId:    created.Id,
+		Status:    identityV1.Membership_ACTIVE.Enum(),
+		IsPrimary: trans.Ptr(true),
+	}); err != nil {
+		return nil, err
+	}
+
+	after, _ := s.userRepo.Get(ctx, &identityV1.GetUserRequest{QueryBy: &identityV1.GetUserRequest_Id{Id: created.GetId()}})
+	if after == nil {
+		after = created
+	}
+	s.auditor.record(ctx, auditResourceUser, created.GetId(), auditV1.OperationAuditLog_CREATE, nil, after, nil)
+
+	if !in.IsActive() {
+		if err = s.setUserActive(ctx, created.GetId(), true, false); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.toScimUser(ctx, after)
+}
+
+// ReplaceUser 以请求内容整体替换用户（PUT）。
+func (s *ScimService) ReplaceUser(ctx context.Context, id, ifMatch string, in *scim.User) (*scim.User, error) {
+	before, err := s.getUser(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	current, err := s.toScimUser(ctx, before)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkIfMatch(ifMatch, current.Meta); err != nil {
+		return nil, err
+	}
+
+	return s.applyUser(ctx, before, current, in)
+}
+
+// PatchUser 按 PATCH 操作修改用户。
+func (s *ScimService) PatchUser(ctx context.Context, id, ifMatch string, req *scim.PatchRequest) (*scim.User, error) {
+	before, err := s.getUser(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	current, err := s.toScimUser(ctx, before)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkIfMatch(ifMatch, current.Meta); err != nil {
+		return nil, err
+	}
+
+	desired, err := s.toScimUser(ctx, before)
+	if err != nil {
+		return nil, err
+	}
+	if err = scim.ApplyPatch(desired, req.Operations); err != nil {
+		return nil, err
+	}
+
+	return s.applyUser(ctx, before, current, desired)
+}
+
+// DeleteUser 删除用户。
+func (s *ScimService) DeleteUser(ctx context.Context, id, ifMatch string) error {
+	before, err := s.getUser(ctx, id)
+	if err != nil {
+		return err
+	}
+	if strings.TrimSpace(ifMatch) != "" {
+		current, err := s.toScimUser(ctx, before)
+		if err != nil {
+			return err
+		}
+		if err = checkIfMatch(ifMatch, current.Meta); err != nil {
+			return err
+		}
+	}
+
+	err = s.userRepo.Delete(ctx, &identityV1.DeleteUserRequest{QueryBy: &identityV1.DeleteUserRequest_Id{Id: before.GetId()}})
+	s.auditor.record(ctx, auditResourceUser, before.GetId(), auditV1.OperationAuditLog_DELETE, before, nil, err)
+	if err != nil {
+		return err
+	}
+
+	s.revokeUserToken(ctx, before.GetId())
+	return nil
+}
+
+// applyUser 将期望状态写入用户：先更新资料与组织单元，最后设置成员身份状态。
+// userRepo.Update 在一对多模式下会把成员身份重置为生效中，状态必须放在最后写。
+func (s *ScimService) applyUser(ctx context.Context, before *identityV1.User, current, desired *scim.User) (*scim.User, error) {
+	if username := strings.TrimSpace(desired.UserName); username != "" && username != before.GetUsername() {
+		return nil, scim.NewError(http.StatusBadRequest, scim.ErrTypeMutability, "userName is immutable")
+	}
+
+	dto := &identityV1.User{
+		Id:       before.Id,
+		TenantId: before.TenantId,
+	}
+	mask := &fieldmaskpb.FieldMask{}
+	if err := s.fillUserProfile(ctx, before, dto, mask, desired); err != nil {
+		return nil, err
+	}
+
+	if len(mask.Paths) > 0 {
+		err := s.userRepo.Update(ctx, &identityV1.UpdateUserRequest{
+			Id:         before.GetId(),
+			Data:       dto,
+			UpdateMask: mask,
+		})
+		var after *identityV1.User
+		if err == nil {
+			after, _ = s.userRepo.Get(ctx, &identityV1.GetUserRequest{QueryBy: &identityV1.GetUserRequest_Id{Id: before.GetId()}})
+		}
+		s.auditor.record(ctx, auditResourceUser, before.GetId(), auditV1.OperationAuditLog_UPDATE, before, after, err)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// 一对多模式下 userRepo.Update 已把成员身份重置为生效中，资料有变更时也要重新写入状态
+	if len(mask.Paths) > 0 || desired.IsActive() != current.IsActive() {
+		if err := s.setUserActive(ctx, before.GetId(), current.IsActive(), desired.IsActive()); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.GetUser(ctx, formatScimID(before.GetId()))
+}
+
+// fillUserProfile 将 SCIM 用户资料映射到本地用户，before 为空表示新建；mask 非空时只记录有变化的字段。
+// 邮箱、手机号在租户内唯一，被其他用户占用时返回 uniqueness 错误；请求中未携带时保持原值。
+func (s *ScimService) fillUserProfile(ctx context.Context, before *identityV1.User, dto *identityV1.User, mask *fieldmaskpb.FieldMask, in *scim.User) error {
+	changed := func(path, oldValue, newValue string) bool {
+		if before != nil && oldValue == newValue {
+			return false
+		}
+		if mask != nil {
+			mask.Paths = append(mask.Paths, path)
+		}
+		return true
+	}
+
+	nickname := in.DisplayName
+	if nickname == "" {
+		nickname = in.NickName
+	}
+	if changed("nickname", before.GetNickname(), nickname) {
+		dto.Nickname = trans.Ptr(nickname)
+	}
+
+	var realname string
+	if in.Name != nil {
+		realname = strings.TrimSpace(strings.Join([]string{in.Name.GivenName, in.Name.FamilyName}, " "))
+		if realname == "" {
+			realname = in.Name.Formatted
+		}
+	}
+	if changed("realname", before.GetRealname(), realname) {
+		dto.Realname = trans.Ptr(realname)
+	}
+
+	if email := strings.TrimSpace(in.PrimaryEmail()); email != "" && changed("email", before.GetEmail(), email) {
+		if err := s.checkUserUnique(ctx, before.GetId(), "email", email); err != nil {
+			return err
+		}
+		dto.Email = trans.Ptr(email)
+	}
+
+	if mobile := strings.TrimSpace(in.PrimaryPhoneNumber()); mobile != "" && changed("mobile", before.GetMobile(), mobile) {
+		if err := s.checkUserUnique(ctx, before.GetId(), "mobile", mobile); err != nil {
+			return err
+		}
+		dto.Mobile = trans.Ptr(mobile)
+	}
+
+	if department := strings.TrimSpace(in.Department()); department != "" {
+		orgUnitID, err := s.ensureDepartment(ctx, dto.GetTenantId(), department)
+		if err != nil {
+			return err
+		}
+		if !slices.Equal(before.GetOrgUnitIds(), []uint32{orgUnitID}) {
+			dto.OrgUnitIds = []uint32{orgUnitID}
+			if mask != nil {
+				mask.Paths = append(mask.Paths, "org_unit_ids")
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *ScimService) checkUserUnique(ctx context.Context, userID uint32, field, value string) error {
+	existing, err := s.findUser(ctx, map[string]any{"tenant_id": tenantFromContext(ctx), field: value})
+	if err != nil {
+		return err
+	}
+	if existing != nil && existing.GetId() != userID {
+		return scim.NewError(http.StatusConflict, scim.ErrTypeUniqueness, field+" already exists")
+	}
+	return nil
+}
+
+// ensureDepartment 按名称查找本租户的组织单元，不存在时创建为部门。
+func (s *ScimService) ensureDepartment(ctx context.Context, tenantID uint32, name string) (uint32, error) {
+	req, _ := scimPaging(1, 1, map[string]any{"tenant_id": tenantID, "name": name})
+	resp, err := s.orgUnitRepo.List(ctx, req)
+	if err != nil {
+		return 0, err
+	}
+	if len(resp.GetItems()) > 0 {
+		return resp.GetItems()[0].GetId(), nil
+	}
+
+	orgUnit, err := s.orgUnitRepo.Create(ctx, &identityV1.CreateOrgUnitRequest{
+		Data: &identityV1.OrgUnit{
+			TenantId: trans.Ptr(tenantID),
+			Name:     trans.Ptr(name),
+			Type:     identityV1.OrgUnit_DEPARTMENT.Enum(),
+			Status:   identityV1.OrgUnit_ON.Enum(),
+		},
+	})
+	s.auditor.record(ctx, auditResourceOrgUnit, orgUnit.GetId(), auditV1.OperationAuditLog_CREATE, nil, orgUnit, err)
+	if err != nil {
+		return 0, err
+	}
+	return orgUnit.GetId(), nil
+}
+
+// setUserActive 设置用户在当前租户下的成员身份状态，wasActive 为变更前 SCIM 资源的 active；停用时吊销其令牌。
+func (s *ScimService) setUserActive(ctx context.Context, userID uint32, wasActive, active bool) error {
+	tenantID := tenantFromContext(ctx)
+
+	status := identityV1.Membership_ACTIVE
+	if !active {
+		status = identityV1.Membership_DISABLED
+	}
+
+	err := s.membershipRepo.SetUserTenantStatus(ctx, userID, tenantID, status)
+	if wasActive != active {
+		beforeStatus := identityV1.Membership_ACTIVE
+		if !wasActive {
+			beforeStatus = identityV1.Membership_DISABLED
+		}
+		s.auditor.record(ctx, auditResourceMembership, userID, auditV1.OperationAuditLog_UPDATE,
+			&identityV1.Membership{UserId: trans.Ptr(userID), TenantId: trans.Ptr(tenantID), Status: beforeStatus.Enum()},
+			&identityV1.Membership{UserId: trans.Ptr(userID), TenantId: trans.Ptr(tenantID), Status: status.Enum()},
+			err,
+		)
+	}
+	if err != nil {
+		return err
+	}
+
+	if !active {
+		s.revokeUserToken(ctx, userID)
+	}
+	return nil
+}
+
+func (s *ScimService) revokeUserToken(ctx context.Context, userID uint32) {
+	if s.authenticator == nil {
+		return
+	}
+	if err := s.authenticator.RevokeUserToken(ctx, s.clientType, userID); err != nil {
+		s.log.Errorf("revoke tokens of scim user [%d] failed: %s", userID, err.Error())
+	}
+}
+
+func (s *ScimService) getUser(ctx context.Context, id string) (*identityV1.User, error) {
+	userID, err := parseScimID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := s.findUser(ctx, map[string]any{"tenant_id": tenantFromContext(ctx), "id": userID})
+	if err != nil {
+		return nil, err
+	}
+	if u == nil {
+		return nil, scim.NewError(http.StatusNotFound, "", "user "+id+" not found")
+	}
+	return u, nil
+}
+
+// findUser 在当前租户内按条件查找单个用户，不存在时返回 nil。
+func (s *ScimService) findUser(ctx context.Context, conds map[string]any) (*identityV1.User, error) {
+	req, _ := scimPaging(1, 1, conds)
+	resp, err := s.userRepo.List(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.GetItems()) == 0 {
+		return nil, nil
+	}
+	return resp.GetItems()[0], nil
+}
+
+func (s *ScimService) toScimUser(ctx context.Context, u *identityV1.User) (*scim.User, error) {
+	suspended, err := s.membershipRepo.IsMembershipSuspended(ctx, u.GetId(), tenantFromContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	active := scim.Bool(!suspended)
+
+	id := formatScimID(u.GetId())
+	res := &scim.User{
+		Schemas:     []string{scim.SchemaUser},
+		ID:          id,
+		UserName:    u.GetUsername(),
+		DisplayName: u.GetNickname(),
+		Active:      &active,
+	}
+	if u.GetRealname() != "" {
+		res.Name = &scim.Name{Formatted: u.GetRealname()}
+	}
+	if u.GetEmail() != "" {
+		res.Emails = []scim.MultiValued{{Value: u.GetEmail(), Type: "work", Primary: true}}
+	}
+	if u.GetMobile() != "" {
+		res.PhoneNumbers = []scim.MultiValued{{Value: u.GetMobile(), Type: "mobile", Primary: true}}
+	}
+
+	if len(u.GetRoleIds()) > 0 {
+		roles, err := s.roleRepo.ListRolesByRoleIds(ctx, u.GetRoleIds())
+		if err != nil {
+			return nil, err
+		}
+		slices.SortFunc(roles, func(a, b *permissionV1.Role) int { return cmp.Compare(a.GetId(), b.GetId()) })
+		for _, role := range roles {
+			res.Groups = append(res.Groups, scim.MultiValued{
+				Value:   formatScimID(role.GetId()),
+				Display: role.GetName(),
+				Ref:     ScimBasePath + "/Groups/" + formatScimID(role.GetId()),
+			})
+		}
+	}
+
+	if len(u.GetOrgUnitIds()) > 0 {
+		orgUnits, err := s.orgUnitRepo.ListOrgUnitsByIds(ctx, u.GetOrgUnitIds()[:1])
+		if err != nil {
+			return nil, err
+		}
+		if len(orgUnits) > 0 {
+			res.Schemas = append(res.Schemas, scim.SchemaEnterpriseUser)
+			res.Enterprise = &scim.EnterpriseUser{Department: orgUnits[0].GetName()}
+		}
+	}
+
+	if err = setMeta(res, &res.Meta, scim.ResourceTypeUser, id, u.CreatedAt, u.UpdatedAt); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// Groups
+////////////////////////////////////////////////////////////////////////////////
+
+// ListGroups 查询组（本租户角色），支持 displayName 的 eq 过滤。
+func (s *ScimService) ListGroups(ctx context.Context, filter, startIndex, count string) (*scim.ListResponse, error) {
+	start, size, err := scim.ParseListParams(startIndex, count)
+	if err != nil {
+		return nil, err
+	}
+	f, err := scim.ParseFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	conds := map[string]any{"tenant_id": tenantFromContext(ctx), "type": permissionV1.Role_TENANT.String()}
+	switch {
+	case f == nil:
+	case f.Is("displayName"):
+		conds["name"] = f.Value
+	case f.Is("externalId"), f.Is("id"):
+		return scim.NewListResponse(0, start, nil), nil
+	default:
+		return nil, scim.NewError(http.StatusBadRequest, scim.ErrTypeInvalidFilter, "unsupported filter attribute "+f.Attr)
+	}
+
+	req, skip := scimPaging(start, size, conds)
+	resp, err := s.roleRepo.List(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var resources []any
+	if size > 0 {
+		for _, role := range scimPage(resp.GetItems(), skip) {
+			res, err := s.toScimGroup(ctx, role)
+			if err != nil {
+				return nil, err
+			}
+			resources = append(resources, res)
+		}
+	}
+
+	return scim.NewListResponse(int(resp.GetTotal()), start, resources), nil
+}
+
+// GetGroup 获取组。
+func (s *ScimService) GetGroup(ctx context.Context, id string) (*scim.Group, error) {
+	role, err := s.getRole(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.toScimGroup(ctx, role)
+}
+
+// CreateGroup 创建组：新建租户角色并分配成员。
+func (s *ScimService) CreateGroup(ctx context.Context, in *scim.Group) (*scim.Group, error) {
+	tenantID := tenantFromContext(ctx)
+
+	name := strings.TrimSpace(in.DisplayName)
+	if name == "" {
+		return nil, scim.NewError(http.StatusBadRequest, scim.ErrTypeInvalidValue, "displayName is required")
+	}
+	if existing, err := s.findRole(ctx, map[string]any{"tenant_id": tenantID, "type": permissionV1.Role_TENANT.String(), "name": name}); err != nil {
+		return nil, err
+	} else if existing != nil {
+		return nil, scim.NewError(http.StatusConflict, scim.ErrTypeUniqueness, "displayName already exists")
+	}
+
+	code, err := newScimRoleCode()
+	if err != nil {
+		return nil, err
+	}
+
+	memberIDs, err := s.resolveMembers(ctx, in.Members)
+	if err != nil {
+		return nil, err
+	}
+
+	role, err := s.roleRepo.Create(ctx, &permissionV1.CreateRoleRequest{
+		Data: &permissionV1.Role{
+			TenantId: trans.Ptr(tenantID),
+			Name:     trans.Ptr(name),
+			Code:     trans.Ptr(code),
+			Type:     permissionV1.Role_TENANT.Enum(),
+			Status:   permissionV1.Role_ON.Enum(),
+		},
+	})
+	s.auditor.record(ctx, auditResourceRole, role.GetId(), auditV1.OperationAuditLog_CREATE, nil, role, err)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.syncMembers(ctx, role.GetId(), nil, memberIDs); err != nil {
+		return nil, err
+	}
+
+	return s.GetGroup(ctx, formatScimID(role.GetId()))
+}
+
+// ReplaceGroup 以请求内容整体替换组（PUT）。
+func (s *ScimService) ReplaceGroup(ctx context.Context, id, ifMatch string, in *scim.Group) (*scim.Group, error) {
+	role, err := s.getRole(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	current, err := s.toScimGroup(ctx, role)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkIfMatch(ifMatch, current.Meta); err != nil {
+		return nil, err
+	}
+
+	return s.applyGroup(ctx, role, current, in)
+}
+
+// PatchGroup 按 PATCH 操作修改组，成员增删只影响被操作的用户。
+func (s *ScimService) PatchGroup(ctx context.Context, id, ifMatch string, req *scim.PatchRequest) (*scim.Group, error) {
+	role, err := s.getRole(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	current, err := s.toScimGroup(ctx, role)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkIfMatch(ifMatch, current.Meta); err != nil {
+		return nil, err
+	}
+
+	desired, err := s.toScimGroup(ctx, role)
+	if err != nil {
+		return nil, err
+	}
+	if err = scim.ApplyPatch(desired, req.Operations); err != nil {
+		return nil, err
+	}
+
+	return s.applyGroup(ctx, role, current, desired)
+}
+
+// DeleteGroup 删除组：先撤销全部成员的该角色，再删除角色。
+func (s *ScimService) DeleteGroup(ctx context.Context, id, ifMatch string) error {
+	role, err := s.getRole(ctx, id)
+	if err != nil {
+		return err
+	}
+	current, err := s.toScimGroup(ctx, role)
+	if err != nil {
+		return err
+	}
+	if err = checkIfMatch(ifMatch, current.Meta); err != nil {
+		return err
+	}
+
+	memberIDs, err := s.listRoleUserIDs(ctx, role.GetId())
+	if err != nil {
+		return err
+	}
+	if err = s.syncMembers(ctx, role.GetId(), memberIDs, nil); err != nil {
+		return err
+	}
+
+	err = s.roleRepo.Delete(ctx, &permissionV1.DeleteRoleRequest{QueryBy: &permissionV1.DeleteRoleRequest_Id{Id: role.GetId()}})
+	s.auditor.record(ctx, auditResourceRole, role.GetId(), auditV1.OperationAuditLog_DELETE, role, nil, err)
+	if err != nil {
+		return err
+	}
+
+	s.resetPolicies(ctx)
+	return nil
+}
+
+// applyGroup 写入组名与成员的变更。
+func (s *ScimService) applyGroup(ctx context.Context, role *permissionV1.Role, current, desired *scim.Group) (*scim.Group, error) {
+	name := strings.TrimSpace(desired.DisplayName)
+	if name == "" {
+		return nil, scim.NewError(http.StatusBadRequest, scim.ErrTypeInvalidValue, "displayName is required")
+	}
+
+	if name != role.GetName() {
+		existing, err := s.findRole(ctx, map[string]any{"tenant_id": tenantFromContext(ctx), "type": permissionV1.Role_TENANT.String(), "name": name})
+		if err != nil {
+			return nil, err
+		}
+		if existing != nil && existing.GetId() != role.GetId() {
+			return nil, scim.NewError(http.StatusConflict, scim.ErrTypeUniqueness, "displayName already exists")
+		}
+
+		err = s.roleRepo.Update(ctx, &permissionV1.UpdateRoleRequest{
+			Id:         role.GetId(),
+			Data:       &permissionV1.Role{Id: role.Id, Name: trans.Ptr(name)},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"name"}},
+		})
+		var after *permissionV1.Role
+		if err == nil {
+			after, _ = s.roleRepo.Get(ctx, &permissionV1.GetRoleRequest{QueryBy: &permissionV1.GetRoleRequest_Id{Id: role.GetId()}})
+		}
+		s.auditor.record(ctx, auditResourceRole, role.GetId(), auditV1.OperationAuditLog_UPDATE, role, after, err)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	currentIDs, err := s.resolveMembers(ctx, current.Members)
+	if err != nil {
+		return nil, err
+	}
+	desiredIDs, err := s.resolveMembers(ctx, desired.Members)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.syncMembers(ctx, role.GetId(), currentIDs, desiredIDs); err != nil {
+		return nil, err
+	}
+
+	return s.GetGroup(ctx, formatScimID(role.GetId()))
+}
+
+// resolveMembers 解析成员列表中的用户 ID，引用不存在（或不属于本租户）的用户视为请求错误。
+func (s *ScimService) resolveMembers(ctx context.Context, members []scim.MultiValued) ([]uint32, error) {
+	var ids []uint32
+	for _, m := range members {
+		u, err := s.getUser(ctx, m.Value)
+		if err != nil {
+			return nil, scim.NewError(http.StatusBadRequest, scim.ErrTypeInvalidValue, "member "+m.Value+" not found")
+		}
+		ids = append(ids, u.GetId())
+	}
+	return sliceutil.Unique(ids), nil
+}
+
+// syncMembers 按差集为新增成员分配角色、为移除成员撤销角色，每个受影响的用户各留一条审计。
+func (s *ScimService) syncMembers(ctx context.Context, roleID uint32, currentIDs, desiredIDs []uint32) error {
+	changed := false
+
+	for _, userID := range desiredIDs {
+		if slices.Contains(currentIDs, userID) {
+			continue
+		}
+		if err := s.changeUserRole(ctx, userID, roleID, true); err != nil {
+			return err
+		}
+		changed = true
+	}
+	for _, userID := range currentIDs {
+		if slices.Contains(desiredIDs, userID) {
+			continue
+		}
+		if err := s.changeUserRole(ctx, userID, roleID, false); err != nil {
+			return err
+		}
+		changed = true
+	}
+
+	if changed {
+		s.resetPolicies(ctx)
+	}
+	return nil
+}
+
+// changeUserRole 为用户分配或撤销一个角色，其它角色保持不变。
+// 一对多模式写成员身份角色（MembershipRepo），其它模式写用户角色（UserRepo）。
+func (s *ScimService) changeUserRole(ctx context.Context, userID, roleID uint32, assign bool) error {
+	before, err := s.userRepo.Get(ctx, &identityV1.GetUserRequest{QueryBy: &identityV1.GetUserRequest_Id{Id: userID}})
+	if err != nil {
+		return err
+	}
+
+	roleIDs := slices.DeleteFunc(slices.Clone(before.GetRoleIds()), func(id uint32) bool { return id == roleID })
+	if assign {
+		roleIDs = append(roleIDs, roleID)
+	}
+
+	err = s.assignUserRoles(ctx, userID, roleID, roleIDs)
+
+	var after *identityV1.User
+	if err == nil {
+		after, _ = s.userRepo.Get(ctx, &identityV1.GetUserRequest{QueryBy: &identityV1.GetUserRequest_Id{Id: userID}})
+	}
+	s.auditor.record(ctx, auditResourceUser, userID, auditV1.OperationAuditLog_UPDATE, before, after, err)
+
+	return err
+}
+
+// assignUserRoles 将用户在当前租户下的角色设置为 roleIDs；角色分配接口传空列表不做任何事，
+// 撤销最后一个角色需走移除接口。
+func (s *ScimService) assignUserRoles(ctx context.Context, userID, changedRoleID uint32, roleIDs []uint32) error {
+	tenantID := tenantFromContext(ctx)
+	now := time.Now()
+
+	switch constants.DefaultUserTenantRelationType {
+	case constants.UserTenantRelationOneToMany:
+		if len(roleIDs) == 0 {
+			return s.membershipRepo.RemoveMembershipRoles(ctx, userID, []uint32{changedRoleID})
+		}
+
+		membershipID, err := s.membershipRepo.GetMembershipID(ctx, userID)
+		if err != nil {
+			return err
+		}
+		var datas []*permissionV1.MembershipRole
+		for _, roleID := range roleIDs {
+			datas = append(datas, &permissionV1.MembershipRole{
+				MembershipId: trans.Ptr(membershipID),
+				TenantId:     trans.Ptr(tenantID),
+				RoleId:       trans.Ptr(roleID),
+				Status:       permissionV1.MembershipRole_ACTIVE.Enum(),
+				AssignedAt:   timeutil.TimeToTimestamppb(&now),
+			})
+		}
+		return s.membershipRepo.AssignMembershipRoles(ctx, userID, datas)
+
+	default:
+		if len(roleIDs) == 0 {
+			return s.userRoleRepo.RemoveRolesFromUser(ctx, userID, []uint32{changedRoleID})
+		}
+
+		var datas []*permissionV1.UserRole
+		for _, roleID := range roleIDs {
+			datas = append(datas, &permissionV1.UserRole{
+				TenantId:   trans.Ptr(tenantID),
+				UserId:     trans.Ptr(userID),
+				RoleId:     trans.Ptr(roleID),
+				Status:     permissionV1.UserRole_ACTIVE.Enum(),
+				AssignedAt: timeutil.TimeToTimestamppb(&now),
+			})
+		}
+		return s.userRepo.AssignUserRoles(ctx, userID, datas)
+	}
+}
+
+// listRoleUserIDs 列出拥有该角色的用户 ID。
+func (s *ScimService) listRoleUserIDs(ctx context.Context, roleID uint32) ([]uint32, error) {
+	switch constants.DefaultUserTenantRelationType {
+	case constants.UserTenantRelationOneToMany:
+		return s.membershipRepo.ListUserIDsByRoleID(ctx, roleID, false)
+	default:
+		return s.userRoleRepo.ListUserIDs(ctx, roleID, false)
+	}
+}
+
+func (s *ScimService) resetPolicies(ctx context.Context) {
+	if s.authorizer == nil {
+		return
+	}
+	if err := s.authorizer.ResetPolicies(ctx); err != nil {
+		s.log.Errorf("reset policies error: %v", err)
+	}
+}
+
+func (s *ScimService) getRole(ctx context.Context, id string) (*permissionV1.Role, error) {
+	roleID, err := parseScimID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	role, err := s.findRole(ctx, map[string]any{"tenant_id": tenantFromContext(ctx), "type": permissionV1.Role_TENANT.String(), "id": roleID})
+	if err != nil {
+		return nil, err
+	}
+	if role == nil {
+		return nil, scim.NewError(http.StatusNotFound, "", "group "+id+" not found")
+	}
+	return role, nil
+}
+
+// findRole 在当前租户内按条件查找单个角色，不存在时返回 nil。
+func (s *ScimService) findRole(ctx context.Context, conds map[string]any) (*permissionV1.Role, error) {
+	req, _ := scimPaging(1, 1, conds)
+	resp, err := s.roleRepo.List(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.GetItems()) == 0 {
+		return nil, nil
+	}
+	return resp.GetItems()[0], nil
+}
+
+func (s *ScimService) toScimGroup(ctx context.Context, role *permissionV1.Role) (*scim.Group, error) {
+	id := formatScimID(role.GetId())
+	res := &scim.Group{
+		Schemas:     []string{scim.SchemaGroup},
+		ID:          id,
+		DisplayName: role.GetName(),
+	}
+
+	userIDs, err := s.listRoleUserIDs(ctx, role.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if len(userIDs) > 0 {
+		users, err := s.userRepo.ListUsersByIds(ctx, userIDs)
+		if err != nil {
+			return nil, err
+		}
+		slices.SortFunc(users, func(a, b *identityV1.User) int { return cmp.Compare(a.GetId(), b.GetId()) })
+		for _, u := range users {
+			res.Members = append(res.Members, scim.MultiValued{
+				Value:   formatScimID(u.GetId()),
+				Display: u.GetUsername(),
+				Ref:     ScimBasePath + "/Users/" + formatScimID(u.GetId()),
+			})
+		}
+	}
+
+	if err = setMeta(res, &res.Meta, scim.ResourceTypeGroup, id, role.CreatedAt, role.UpdatedAt); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// newScimRoleCode 生成 SCIM 组对应角色的标识，组名可被身份提供方修改，不参与角色标识。
+func newScimRoleCode() (string, error) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", scim.NewError(http.StatusInternalServerError, "", "generate group code failed")
+	}
+	return scimRoleCodePrefix + hex.EncodeToString(b), nil
+}
@@ -0,0 +1,216 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tx7do/go-utils/trans"
+
+	paginationV1 "github.com/tx7do/go-crud/api/gen/go/pagination/v1"
+	conf "github.com/tx7do/kratos-bootstrap/api/gen/go/conf/v1"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"go-wind-admin/app/admin/service/internal/data"
+	"go-wind-admin/app/admin/service/internal/data/enttest"
+
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+
+	"go-wind-admin/pkg/scim"
+)
+
+const scimTestTenantID = 7
+
+type scimTestEnv struct {
+	svc       *ScimService
+	auditRepo *data.OperationAuditLogRepo
+	member    *data.MembershipRepo
+	ctx       context.Context
+	sysCtx    context.Context
+}
+
+func newScimTestEnv(t *testing.T) *scimTestEnv {
+	t.Helper()
+
+	entClient := enttest.NewEntClientForTest(t)
+	bctx := bootstrap.NewContextWithParam(context.Background(), &conf.AppInfo{}, &conf.Bootstrap{}, log.DefaultLogger)
+	sysCtx := enttest.NewSystemViewerCtx(context.Background())
+
+	userRoleRepo := data.NewUserRoleRepo(bctx, entClient)
+	membershipRepo := data.NewMembershipRepo(bctx, entClient,
+		data.NewMembershipRoleRepo(bctx, entClient), data.NewMembershipPositionRepo(bctx, entClient), data.NewMembershipOrgUnitRepo(bctx, entClient))
+	userRepo := data.NewUserRepo(bctx, entClient, userRoleRepo, data.NewUserOrgUnitRepo(bctx, entClient), data.NewUserPositionRepo(bctx, entClient), membershipRepo)
+	roleRepo := data.NewRoleRepo(bctx, entClient, data.NewRolePermissionRepo(bctx, entClient),
+		data.NewPermissionRepo(bctx, entClient, data.NewPermissionApiRepo(bctx, entClient), data.NewPermissionMenuRepo(bctx, entClient)),
+		data.NewRoleMetadataRepo(bctx, entClient))
+	tokenRepo := data.NewScimTokenRepo(bctx, entClient)
+	auditRepo := data.NewOperationAuditLogRepo(bctx, entClient)
+
+	svc := NewScimService(bctx, tokenRepo, userRepo, userRoleRepo, membershipRepo, roleRepo, data.NewOrgUnitRepo(bctx, entClient),
+		nil, authenticationV1.ClientType_admin, nil, auditRepo)
+
+	secret, err := tokenRepo.Create(sysCtx, &authenticationV1.CreateScimTokenRequest{
+		Data: &authenticationV1.ScimToken{
+			TenantId: trans.Ptr(uint32(scimTestTenantID)),
+			Name:     trans.Ptr("okta"),
+			Status:   authenticationV1.ScimToken_ON.Enum(),
+		},
+	})
+	require.NoError(t, err)
+
+	ctx, err := svc.Authenticate(context.Background(), "Bearer "+secret.GetToken())
+	require.NoError(t, err)
+
+	return &scimTestEnv{svc: svc, auditRepo: auditRepo, member: membershipRepo, ctx: ctx, sysCtx: sysCtx}
+}
+
+func (env *scimTestEnv) auditCount(t *testing.T) int {
+	t.Helper()
+	resp, err := env.auditRepo.List(env.sysCtx, &paginationV1.PagingRequest{NoPaging: trans.Ptr(true)})
+	require.NoError(t, err)
+	return int(resp.GetTotal())
+}
+
+func scimPatch(t *testing.T, op, path string, value any) *scim.PatchRequest {
+	t.Helper()
+	raw, err := json.Marshal(value)
+	require.NoError(t, err)
+	return &scim.PatchRequest{
+		Schemas:    []string{scim.SchemaPatchOp},
+		Operations: []scim.PatchOperation{{Op: op, Path: path, Value: raw}},
+	}
+}
+
+func scimStatus(err error) int {
+	var e *scim.Error
+	if errors.As(err, &e) {
+		return e.StatusCode()
+	}
+	return 0
+}
+
+func TestScimService_Authenticate(t *testing.T) {
+	env := newScimTestEnv(t)
+
+	_, err := env.svc.Authenticate(context.Background(), "Bearer gwscim_unknown")
+	assert.Equal(t, http.StatusUnauthorized, scimStatus(err))
+
+	_, err = env.svc.Authenticate(context.Background(), "Basic abc")
+	assert.Equal(t, http.StatusUnauthorized, scimStatus(err))
+
+	assert.Equal(t, uint32(scimTestTenantID), tenantFromContext(env.ctx))
+}
+
+func TestScimService_UserLifecycle(t *testing.T) {
+	env := newScimTestEnv(t)
+	active := scim.Bool(true)
+
+	created, err := env.svc.CreateUser(env.ctx, &scim.User{
+		Schemas:     []string{scim.SchemaUser},
+		UserName:    "alice@acme.test",
+		DisplayName: "Alice",
+		Name:        &scim.Name{GivenName: "Alice", FamilyName: "Wong"},
+		Active:      &active,
+		Emails:      []scim.MultiValued{{Value: "alice@acme.test", Primary: true}},
+		Enterprise:  &scim.EnterpriseUser{Department: "Engineering"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "alice@acme.test", created.UserName)
+	assert.Equal(t, "Alice Wong", created.Name.Formatted)
+	assert.Equal(t, "Engineering", created.Enterprise.Department)
+	assert.True(t, created.IsActive())
+	require.NotNil(t, created.Meta)
+	assert.NotEmpty(t, created.Meta.Version)
+	// 用户与自动创建的部门各一条
+	assert.Equal(t, 2, env.auditCount(t))
+
+	_, err = env.svc.CreateUser(env.ctx, &scim.User{UserName: "alice@acme.test"})
+	assert.Equal(t, http.StatusConflict, scimStatus(err))
+
+	list, err := env.svc.ListUsers(env.ctx, `userName eq "alice@acme.test"`, "", "")
+	require.NoError(t, err)
+	assert.Equal(t, 1, list.TotalResults)
+	list, err = env.svc.ListUsers(env.ctx, `userName eq "bob"`, "", "")
+	require.NoError(t, err)
+	assert.Equal(t, 0, list.TotalResults)
+
+	// 版本不符拒绝修改
+	_, err = env.svc.PatchUser(env.ctx, created.ID, `W/"stale"`, scimPatch(t, "replace", "active", false))
+	assert.Equal(t, http.StatusPreconditionFailed, scimStatus(err))
+
+	// 软停用：成员身份置为停用，用户保留
+	patched, err := env.svc.PatchUser(env.ctx, created.ID, created.Meta.Version, scimPatch(t, "replace", "active", "False"))
+	require.NoError(t, err)
+	assert.False(t, patched.IsActive())
+	assert.NotEqual(t, created.Meta.Version, patched.Meta.Version)
+
+	userID, _ := parseScimID(created.ID)
+	suspended, err := env.member.IsMembershipSuspended(env.sysCtx, userID, scimTestTenantID)
+	require.NoError(t, err)
+	assert.True(t, suspended)
+
+	// 修改资料不会把停用的成员身份重新激活
+	patched, err = env.svc.PatchUser(env.ctx, created.ID, "", scimPatch(t, "replace", "displayName", "Alice W"))
+	require.NoError(t, err)
+	assert.Equal(t, "Alice W", patched.DisplayName)
+	assert.False(t, patched.IsActive())
+
+	_, err = env.svc.PatchUser(env.ctx, created.ID, "", scimPatch(t, "replace", "userName", "mallory"))
+	assert.Equal(t, http.StatusBadRequest, scimStatus(err))
+
+	require.NoError(t, env.svc.DeleteUser(env.ctx, created.ID, ""))
+	_, err = env.svc.GetUser(env.ctx, created.ID)
+	assert.Equal(t, http.StatusNotFound, scimStatus(err))
+}
+
+func TestScimService_GroupMembers(t *testing.T) {
+	env := newScimTestEnv(t)
+
+	alice, err := env.svc.CreateUser(env.ctx, &scim.User{UserName: "alice"})
+	require.NoError(t, err)
+	bob, err := env.svc.CreateUser(env.ctx, &scim.User{UserName: "bob"})
+	require.NoError(t, err)
+
+	group, err := env.svc.CreateGroup(env.ctx, &scim.Group{
+		DisplayName: "Engineers",
+		Members:     []scim.MultiValued{{Value: alice.ID}},
+	})
+	require.NoError(t, err)
+	require.Len(t, group.Members, 1)
+	assert.Equal(t, alice.ID, group.Members[0].Value)
+
+	got, err := env.svc.GetUser(env.ctx, alice.ID)
+	require.NoError(t, err)
+	require.Len(t, got.Groups, 1)
+	assert.Equal(t, group.ID, got.Groups[0].Value)
+
+	group, err = env.svc.PatchGroup(env.ctx, group.ID, "", scimPatch(t, "add", "members", []map[string]string{{"value": bob.ID}}))
+	require.NoError(t, err)
+	assert.Len(t, group.Members, 2)
+
+	group, err = env.svc.PatchGroup(env.ctx, group.ID, "", scimPatch(t, "remove", `members[value eq "`+alice.ID+`"]`, nil))
+	require.NoError(t, err)
+	require.Len(t, group.Members, 1)
+	assert.Equal(t, bob.ID, group.Members[0].Value)
+
+	got, err = env.svc.GetUser(env.ctx, alice.ID)
+	require.NoError(t, err)
+	assert.Empty(t, got.Groups)
+
+	_, err = env.svc.PatchGroup(env.ctx, group.ID, "", scimPatch(t, "add", "members", []map[string]string{{"value": "999"}}))
+	assert.Equal(t, http.StatusBadRequest, scimStatus(err))
+
+	list, err := env.svc.ListGroups(env.ctx, `displayName eq "Engineers"`, "", "")
+	require.NoError(t, err)
+	assert.Equal(t, 1, list.TotalResults)
+
+	require.NoError(t, env.svc.DeleteGroup(env.ctx, group.ID, ""))
+	got, err = env.svc.GetUser(env.ctx, bob.ID)
+	require.NoError(t, err)
+	assert.Empty(t, got.Groups)
+}
@@ -55,7 +55,10 @@ type TaskService struct {
 	taskRepo        *data.TaskRepo
 	backupRepo      *data.BackupRepo
 	tenantUsageRepo *data.TenantUsageRepo
+	ldapAccountRepo *data.LdapAccountRepo
 	mc              *oss.MinIOClient
+
+	authenticator *data.Authenticator
 }
 
 func NewTaskService(
@@ -65,6 +68,8 @@ func NewTaskService(
 	backupRepo *data.BackupRepo,
 	tenantUsageRepo *data.TenantUsageRepo,
 	mc *oss.MinIOClient,
+	authenticator *data.Authenticator,
+	ldapAccountRepo *data.LdapAccountRepo,
 ) *TaskService {
 	svc := &TaskService{
 		log:             ctx.NewLoggerHelper("task/service/admin-service"),
@@ -73,6 +78,8 @@ func NewTaskService(
 		backupRepo:      backupRepo,
 		tenantUsageRepo: tenantUsageRepo,
 		mc:              mc,
+		authenticator:   authenticator,
+		ldapAccountRepo: ldapAccountRepo,
 	}
 
 	return svc
@@ -591,3 +598,52 @@ func (s *TaskService) AsyncTenantExpiryScan(taskType string, taskData *task.Tena
 	s.log.Infof("AsyncTenantExpiryScan: completed, %d tenants enforced", count)
 	return nil
 }
+
+// AsyncJwtKeyRotation JWT 签名密钥轮换任务的实际执行逻辑。
+//
+// 由管理员在任务模块中按 cron 周期创建（如每月一次），逻辑详见 Authenticator.RotateSigningKey：
+// 生成新密钥立即用于签发，原活动密钥保留到其签发的令牌全部过期，并清理已过校验期的退役密钥。
+func (s *TaskService) AsyncJwtKeyRotation(taskType string, taskData *task.JwtKeyRotationTaskData) error {
+	s.log.Infof("AsyncJwtKeyRotation [%s] [%+v]", taskType, taskData)
+
+	if s.authenticator == nil {
+		s.log.Errorf("AsyncJwtKeyRotation: authenticator is nil, aborting")
+		return errors.New("authenticator is not configured")
+	}
+
+	kid, err := s.authenticator.RotateSigningKey(context.Background(), nil)
+	if err != nil {
+		s.log.Errorf("AsyncJwtKeyRotation: rotate failed: %v", err)
+		return err
+	}
+
+	s.log.Infof("AsyncJwtKeyRotation: completed, new kid [%s]", kid)
+	return nil
+}
+
+// AsyncLdapSync LDAP 目录同步任务的实际执行逻辑。
+// 逐个租户同步，单个目录失败不影响其他租户；每个租户的结果写回其配置的最近同步记录。
+func (s *TaskService) AsyncLdapSync(taskType string, taskData *task.LdapSyncTaskData) error {
+	s.log.Infof("AsyncLdapSync [%s] [%+v]", taskType, taskData)
+
+	if s.ldapAccountRepo == nil {
+		s.log.Errorf("AsyncLdapSync: ldapAccountRepo is nil, aborting")
+		return errors.New("ldapAccountRepo is not configured")
+	}
+
+	var tenantID uint32
+	if taskData != nil {
+		tenantID = taskData.TenantID
+	}
+
+	// 用 SystemViewerContext 包裹：同步需要跨租户读取配置并写入各租户的用户与组织单元。
+	ctx := appViewer.NewSystemViewerContext(context.Background())
+
+	if err := s.ldapAccountRepo.SyncAll(ctx, tenantID); err != nil {
+		s.log.Errorf("AsyncLdapSync: sync failed: %v", err)
+		return err
+	}
+
+	s.log.Infof("AsyncLdapSync: completed")
+	return nil
+}
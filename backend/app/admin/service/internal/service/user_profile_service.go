@@ -3,6 +3,10 @@
 import (
 	"context"
 	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/mail"
+	"strings"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/tx7do/go-utils/trans"
@@ -18,6 +22,7 @@
 
 	"go-wind-admin/pkg/middleware/auth"
 	"go-wind-admin/pkg/oss"
+	"go-wind-admin/pkg/sender"
 )
 
 type UserProfileService struct {
@@ -28,6 +33,12 @@ type UserProfileService struct {
 	userCredentialRepo *data.UserCredentialRepo
 	mc                 *oss.MinIOClient
 
+	contactBindingCache *data.ContactBindingCache
+	sender              *sender.Router
+
+	authenticator *data.Authenticator
+	clientType    authenticationV1.ClientType
+
 	log *log.Helper
 }
 
@@ -37,13 +48,21 @@ func NewUserProfileService(
 	roleRepo *data.RoleRepo,
 	userCredentialRepo *data.UserCredentialRepo,
 	mc *oss.MinIOClient,
+	contactBindingCache *data.ContactBindingCache,
+	sender *sender.Router,
+	authenticator *data.Authenticator,
+	clientType authenticationV1.ClientType,
 ) *UserProfileService {
 	return &UserProfileService{
-		log:                ctx.NewLoggerHelper("user-profile/service/admin-service"),
-		userRepo:           userRepo,
-		roleRepo:           roleRepo,
-		userCredentialRepo: userCredentialRepo,
-		mc:                 mc,
+		log:                 ctx.NewLoggerHelper("user-profile/service/admin-service"),
+		userRepo:            userRepo,
+		roleRepo:            roleRepo,
+		userCredentialRepo:  userCredentialRepo,
+		mc:                  mc,
+		contactBindingCache: contactBindingCache,
+		sender:              sender,
+		authenticator:       authenticator,
+		clientType:          clientType,
 	}
 }
 
@@ -82,6 +101,11 @@ func (s *UserProfileService) UpdateUser(ctx context.Context, req *identityV1.Upd
 		return nil, err
 	}
 
+	// 代登录令牌不得修改被代登录用户的资料（邮箱、手机号可用于找回密码）
+	if operator.GetActorUserId() != 0 {
+		return nil, adminV1.ErrorForbidden("operation not allowed while impersonating")
+	}
+
 	req.Data.Id = trans.Ptr(operator.UserId)
 	req.Id = operator.UserId
 
@@ -193,12 +217,251 @@ func (s *UserProfileService) UploadAvatar(ctx context.Context, req *identityV1.U
 	}, nil
 }
 
-// BindContact 绑定手机号码/邮箱
-func (s *UserProfileService) BindContact(context.Context, *identityV1.BindContactRequest) (*emptypb.Empty, error) {
-	return nil, nil
+// BindContact 绑定手机号码/邮箱：向新地址下发验证码，待 VerifyContact 校验通过后才生效。
+// 请求中已携带验证码时直接完成验证，等同 VerifyContact。
+func (s *UserProfileService) BindContact(ctx context.Context, req *identityV1.BindContactRequest) (*emptypb.Empty, error) {
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var kind data.ContactKind
+	var address, code string
+	switch req.GetContact().(type) {
+	case *identityV1.BindContactRequest_Phone:
+		kind, address, code = data.ContactKindPhone, req.GetPhone().GetPhone(), req.GetPhone().GetCode()
+	case *identityV1.BindContactRequest_Email:
+		kind, address, code = data.ContactKindEmail, req.GetEmail().GetEmail(), req.GetEmail().GetVerificationCode()
+	default:
+		return nil, identityV1.ErrorBadRequest("invalid contact")
+	}
+
+	address, err = normalizeContactAddress(kind, address)
+	if err != nil {
+		return nil, err
+	}
+	if code = strings.TrimSpace(code); code != "" {
+		return s.verifyContact(ctx, operator, kind, address, code)
+	}
+
+	channel := contactChannel(kind)
+	if !s.sender.Supports(channel) {
+		return nil, identityV1.ErrorServiceUnavailable("%s sender not configured", channel)
+	}
+
+	tid := operator.GetTenantId()
+	uid := operator.GetUserId()
+
+	user, err := s.userRepo.Get(ctx, &identityV1.GetUserRequest{QueryBy: &identityV1.GetUserRequest_Id{Id: uid}})
+	if err != nil {
+		return nil, err
+	}
+	if currentContact(user, kind) == address {
+		return nil, identityV1.ErrorBadRequest("%s already bound", kind)
+	}
+	if inUse, ierr := s.userRepo.ContactInUse(ctx, tid, uid, kind, address); ierr != nil {
+		return nil, ierr
+	} else if inUse {
+		return nil, identityV1.ErrorConflict("%s already in use", kind)
+	}
+
+	if s.contactBindingCache.IsLocked(ctx, tid, uid, kind) {
+		return nil, identityV1.ErrorTooManyRequests("too many failed attempts, try again later")
+	}
+	if ok, retryAfter := s.contactBindingCache.TryAcquireResend(ctx, tid, uid, kind); !ok {
+		return nil, identityV1.ErrorTooManyRequests("verification code already sent, retry in %d seconds", int(retryAfter.Seconds()))
+	}
+
+	code, err = newOtpCode()
+	if err != nil {
+		s.log.Errorf("generate contact verification code failed: %s", err.Error())
+		return nil, identityV1.ErrorInternalServerError("generate verification code failed")
+	}
+	if err = s.contactBindingCache.SetPending(ctx, tid, uid, kind, address, code); err != nil {
+		return nil, identityV1.ErrorInternalServerError("save verification code failed")
+	}
+	if err = s.sender.Send(ctx, buildContactCodeMessage(kind, address, code)); err != nil {
+		s.log.Errorf("send contact verification code to user [%d] failed: %s", uid, err.Error())
+		return nil, identityV1.ErrorServiceUnavailable("send verification code failed")
+	}
+
+	return &emptypb.Empty{}, nil
 }
 
-// VerifyContact 验证手机号码/邮箱
-func (s *UserProfileService) VerifyContact(context.Context, *identityV1.VerifyContactRequest) (*emptypb.Empty, error) {
-	return nil, nil
+// VerifyContact 验证手机号码/邮箱：校验通过后更新用户资料与对应登录凭证，并通知旧地址。
+func (s *UserProfileService) VerifyContact(ctx context.Context, req *identityV1.VerifyContactRequest) (*emptypb.Empty, error) {
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var kind data.ContactKind
+	var address, code string
+	switch req.GetContact().(type) {
+	case *identityV1.VerifyContactRequest_Phone:
+		kind, address, code = data.ContactKindPhone, req.GetPhone().GetPhone(), req.GetPhone().GetCode()
+	case *identityV1.VerifyContactRequest_Email:
+		kind, address, code = data.ContactKindEmail, req.GetEmail().GetEmail(), req.GetEmail().GetCode()
+	default:
+		return nil, identityV1.ErrorBadRequest("invalid contact")
+	}
+
+	address, err = normalizeContactAddress(kind, address)
+	if err != nil {
+		return nil, err
+	}
+	if code = strings.TrimSpace(code); code == "" {
+		return nil, identityV1.ErrorBadRequest("verification code required")
+	}
+
+	return s.verifyContact(ctx, operator, kind, address, code)
+}
+
+// ListMySessions 列出当前用户的登录会话（设备），标记发起请求的会话
+func (s *UserProfileService) ListMySessions(ctx context.Context, _ *emptypb.Empty) (*authenticationV1.ListUserSessionsResponse, error) {
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := s.authenticator.ListUserSessions(ctx, s.clientType, operator.GetUserId(), operator.GetSessionId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &authenticationV1.ListUserSessionsResponse{
+		Items: items,
+		Total: uint32(len(items)),
+	}, nil
+}
+
+// RevokeSession 注销当前用户的指定会话（设备）；只能注销自己的会话，请求中的 user_id 被忽略
+func (s *UserProfileService) RevokeSession(ctx context.Context, req *authenticationV1.RevokeUserSessionRequest) (*emptypb.Empty, error) {
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.authenticator.RevokeUserSession(ctx, s.clientType, operator.GetUserId(), req.GetSessionId()); err != nil {
+		return nil, err
+	}
+	s.log.Infof("user [%d] revoked session [%s]", operator.GetUserId(), req.GetSessionId())
+
+	return &emptypb.Empty{}, nil
+}
+
+// verifyContact 校验验证码并落库新地址。
+func (s *UserProfileService) verifyContact(ctx context.Context, operator *authenticationV1.UserTokenPayload, kind data.ContactKind, address, code string) (*emptypb.Empty, error) {
+	tid := operator.GetTenantId()
+	uid := operator.GetUserId()
+
+	switch err := s.contactBindingCache.Verify(ctx, tid, uid, kind, address, code); {
+	case errors.Is(err, data.ErrContactBindingMismatch):
+		return nil, identityV1.ErrorBadRequest("invalid verification code")
+	case errors.Is(err, data.ErrContactBindingLocked):
+		return nil, identityV1.ErrorTooManyRequests("too many failed attempts, try again later")
+	case errors.Is(err, data.ErrContactBindingNotFound):
+		return nil, identityV1.ErrorBadRequest("verification code expired, please request a new one")
+	case err != nil:
+		return nil, identityV1.ErrorInternalServerError("verify contact failed")
+	}
+
+	user, err := s.userRepo.Get(ctx, &identityV1.GetUserRequest{QueryBy: &identityV1.GetUserRequest_Id{Id: uid}})
+	if err != nil {
+		return nil, err
+	}
+	oldAddress := currentContact(user, kind)
+
+	if err = s.userRepo.ChangeContact(ctx, tid, uid, kind, oldAddress, address); err != nil {
+		return nil, err
+	}
+	s.log.Infof("user [%d] changed %s", uid, kind)
+
+	// 通知旧地址，便于非本人操作时及时发现；投递失败不影响结果
+	if oldAddress != "" && s.sender.Supports(contactChannel(kind)) {
+		if err = s.sender.Send(ctx, buildContactChangedMessage(kind, oldAddress, address)); err != nil {
+			s.log.Warnf("notify user [%d] old %s failed: %s", uid, kind, err.Error())
+		}
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// normalizeContactAddress 校验并规范化手机号/邮箱（邮箱只接受纯地址，不含显示名）。
+func normalizeContactAddress(kind data.ContactKind, address string) (string, error) {
+	address = strings.TrimSpace(address)
+	if kind == data.ContactKindPhone {
+		if !isValidPhone(address) {
+			return "", identityV1.ErrorBadRequest("invalid phone number")
+		}
+		return address, nil
+	}
+
+	parsed, err := mail.ParseAddress(address)
+	if err != nil || parsed.Address != address {
+		return "", identityV1.ErrorBadRequest("invalid email address")
+	}
+	return address, nil
+}
+
+// contactChannel 联系方式类别对应的投递渠道。
+func contactChannel(kind data.ContactKind) sender.Channel {
+	if kind == data.ContactKindEmail {
+		return sender.ChannelEmail
+	}
+	return sender.ChannelSMS
+}
+
+func currentContact(user *identityV1.User, kind data.ContactKind) string {
+	if kind == data.ContactKindEmail {
+		return user.GetEmail()
+	}
+	return user.GetMobile()
+}
+
+func maskContact(kind data.ContactKind, address string) string {
+	if kind == data.ContactKindEmail {
+		return maskEmail(address)
+	}
+	return maskPhone(address)
+}
+
+// buildContactCodeMessage 组装换绑验证码消息；Params 供基于模板的短信网关使用。
+func buildContactCodeMessage(kind data.ContactKind, to, code string) *sender.Message {
+	minutes := fmt.Sprintf("%d", int(data.ContactBindingTTL.Minutes()))
+	return &sender.Message{
+		Channel: contactChannel(kind),
+		To:      to,
+		Subject: fmt.Sprintf("%s 验证码", mfaTotpIssuer),
+		Body:    fmt.Sprintf("【%s】您正在绑定此%s，验证码为 %s，%s 分钟内有效。如非本人操作，请忽略。", mfaTotpIssuer, contactKindName(kind), code, minutes),
+		Params: map[string]string{
+			"code":        code,
+			"ttl_minutes": minutes,
+		},
+	}
+}
+
+// buildContactChangedMessage 组装发往旧地址的变更通知。
+func buildContactChangedMessage(kind data.ContactKind, to, newAddress string) *sender.Message {
+	masked := maskContact(kind, newAddress)
+	return &sender.Message{
+		Channel: contactChannel(kind),
+		To:      to,
+		Subject: fmt.Sprintf("%s %s变更提醒", mfaTotpIssuer, contactKindName(kind)),
+		Body:    fmt.Sprintf("【%s】您账号绑定的%s已更换为 %s。如非本人操作，请立即修改密码并联系管理员。", mfaTotpIssuer, contactKindName(kind), masked),
+		Params: map[string]string{
+			"contact": masked,
+		},
+	}
+}
+
+func contactKindName(kind data.ContactKind) string {
+	if kind == data.ContactKindEmail {
+		return "邮箱"
+	}
+	return "手机号"
 }
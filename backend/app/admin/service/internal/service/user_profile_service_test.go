@@ -0,0 +1,165 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tx7do/go-utils/trans"
+
+	conf "github.com/tx7do/kratos-bootstrap/api/gen/go/conf/v1"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"go-wind-admin/app/admin/service/internal/data"
+	"go-wind-admin/app/admin/service/internal/data/ent/user"
+	"go-wind-admin/app/admin/service/internal/data/enttest"
+
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+	identityV1 "go-wind-admin/api/gen/go/identity/service/v1"
+
+	"go-wind-admin/pkg/middleware/auth"
+	"go-wind-admin/pkg/sender"
+)
+
+func TestUserProfileService_BindContact(t *testing.T) {
+	mr, err := miniredis.Run()
+	require.NoError(t, err)
+	t.Cleanup(mr.Close)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+
+	entClient := enttest.NewEntClientForTest(t)
+	bctx := bootstrap.NewContextWithParam(context.Background(), &conf.AppInfo{}, &conf.Bootstrap{}, log.DefaultLogger)
+	sysCtx := enttest.NewSystemViewerCtx(context.Background())
+
+	userRoleRepo := data.NewUserRoleRepo(bctx, entClient)
+	membershipRepo := data.NewMembershipRepo(bctx, entClient,
+		data.NewMembershipRoleRepo(bctx, entClient), data.NewMembershipPositionRepo(bctx, entClient), data.NewMembershipOrgUnitRepo(bctx, entClient))
+	userRepo := data.NewUserRepo(bctx, entClient, userRoleRepo, data.NewUserOrgUnitRepo(bctx, entClient), data.NewUserPositionRepo(bctx, entClient), membershipRepo)
+	credentialRepo := data.NewUserCredentialRepo(bctx, entClient, data.NewPasswordCrypto(), nil)
+
+	sent := sender.NewMemorySender(nil)
+	router := sender.NewRouter().
+		Register(sender.ChannelEmail, sent).
+		Register(sender.ChannelSMS, sent)
+	svc := NewUserProfileService(bctx, userRepo, nil, credentialRepo, nil, data.NewContactBindingCache(bctx, rdb), router, nil, authenticationV1.ClientType_admin)
+
+	const (
+		tenantID = 9171
+		userID   = 9171
+		oldEmail = "old9171@example.com"
+		newEmail = "new9171@example.com"
+	)
+	require.NoError(t, entClient.Client().User.Create().
+		SetID(userID).
+		SetTenantID(tenantID).
+		SetUsername("contact9171").
+		SetEmail(oldEmail).
+		SetStatus(user.StatusNormal).
+		Exec(sysCtx))
+	require.NoError(t, entClient.Client().User.Create().
+		SetID(9172).
+		SetTenantID(tenantID).
+		SetUsername("contact9172").
+		SetEmail("taken9172@example.com").
+		SetMobile("13800009172").
+		SetStatus(user.StatusNormal).
+		Exec(sysCtx))
+	require.NoError(t, credentialRepo.Create(sysCtx, &authenticationV1.CreateUserCredentialRequest{Data: &authenticationV1.UserCredential{
+		UserId:         trans.Ptr(uint32(userID)),
+		TenantId:       trans.Ptr(uint32(tenantID)),
+		IdentityType:   authenticationV1.UserCredential_EMAIL.Enum(),
+		Identifier:     trans.Ptr(oldEmail),
+		CredentialType: authenticationV1.UserCredential_PASSWORD_HASH.Enum(),
+		Credential:     trans.Ptr("Old#Pass1"),
+		Status:         authenticationV1.UserCredential_ENABLED.Enum(),
+	}}))
+
+	ctx := auth.NewContext(sysCtx, &authenticationV1.UserTokenPayload{
+		UserId:   userID,
+		TenantId: trans.Ptr(uint32(tenantID)),
+	})
+	bindEmail := func(email string) error {
+		_, err := svc.BindContact(ctx, &identityV1.BindContactRequest{
+			Contact: &identityV1.BindContactRequest_Email{Email: &identityV1.BindEmailRequest{Email: email}},
+		})
+		return err
+	}
+	verifyEmail := func(email, code string) error {
+		_, err := svc.VerifyContact(ctx, &identityV1.VerifyContactRequest{
+			Contact: &identityV1.VerifyContactRequest_Email{Email: &identityV1.EmailVerification{Email: email, Code: code}},
+		})
+		return err
+	}
+
+	assert.Equal(t, 400, int(errors.Code(bindEmail("Someone <x@example.com>"))))
+	assert.Equal(t, 400, int(errors.Code(bindEmail(oldEmail))))
+	assert.Equal(t, 409, int(errors.Code(bindEmail("taken9172@example.com"))))
+	assert.Empty(t, sent.Messages())
+
+	// 验证码发往新地址；冷却期内不可重发
+	require.NoError(t, bindEmail(newEmail))
+	msg, ok := sent.Last(newEmail)
+	require.True(t, ok)
+	code := msg.Params["code"]
+	require.Len(t, code, mfaOtpDigits)
+	assert.Equal(t, 429, int(errors.Code(bindEmail(newEmail))))
+
+	// 地址与申请不符、验证码错误均不生效
+	assert.Equal(t, 400, int(errors.Code(verifyEmail("other9171@example.com", code))))
+	wrong := "000000"
+	if code == wrong {
+		wrong = "111111"
+	}
+	assert.Equal(t, 400, int(errors.Code(verifyEmail(newEmail, wrong))))
+	entity, err := entClient.Client().User.Get(sysCtx, userID)
+	require.NoError(t, err)
+	assert.Equal(t, oldEmail, *entity.Email)
+
+	// 校验通过：资料与凭证标识同步更新，旧地址收到通知
+	require.NoError(t, verifyEmail(newEmail, code))
+	entity, err = entClient.Client().User.Get(sysCtx, userID)
+	require.NoError(t, err)
+	assert.Equal(t, newEmail, *entity.Email)
+	_, err = credentialRepo.FindUserCredential(sysCtx, tenantID, authenticationV1.UserCredential_EMAIL, newEmail, "Old#Pass1", false)
+	require.NoError(t, err)
+	notice, ok := sent.Last(oldEmail)
+	require.True(t, ok)
+	assert.Equal(t, maskEmail(newEmail), notice.Params["contact"])
+
+	// 单次有效
+	assert.Equal(t, 400, int(errors.Code(verifyEmail(newEmail, code))))
+
+	// 手机号：绑定请求携带验证码即完成验证；错误次数达上限后锁定
+	mr.FastForward(data.ContactBindingResendCooldown)
+	_, err = svc.BindContact(ctx, &identityV1.BindContactRequest{
+		Contact: &identityV1.BindContactRequest_Phone{Phone: &identityV1.BindPhoneRequest{Phone: "13800009171"}},
+	})
+	require.NoError(t, err)
+	msg, ok = sent.Last("13800009171")
+	require.True(t, ok)
+	_, err = svc.BindContact(ctx, &identityV1.BindContactRequest{
+		Contact: &identityV1.BindContactRequest_Phone{Phone: &identityV1.BindPhoneRequest{Phone: "13800009171", Code: msg.Params["code"]}},
+	})
+	require.NoError(t, err)
+	entity, err = entClient.Client().User.Get(sysCtx, userID)
+	require.NoError(t, err)
+	assert.Equal(t, "13800009171", *entity.Mobile)
+
+	mr.FastForward(data.ContactBindingResendCooldown)
+	require.NoError(t, bindEmail("third9171@example.com"))
+	msg, _ = sent.Last("third9171@example.com")
+	wrong = "000000"
+	if msg.Params["code"] == wrong {
+		wrong = "111111"
+	}
+	for i := 1; i < data.MaxContactBindingFailures; i++ {
+		assert.Equal(t, 400, int(errors.Code(verifyEmail("third9171@example.com", wrong))))
+	}
+	assert.Equal(t, 429, int(errors.Code(verifyEmail("third9171@example.com", wrong))))
+	assert.Equal(t, 429, int(errors.Code(verifyEmail("third9171@example.com", msg.Params["code"]))))
+}
@@ -10,11 +10,13 @@
 	"github.com/tx7do/go-utils/sliceutil"
 	"github.com/tx7do/go-utils/trans"
 	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	"go-wind-admin/app/admin/service/internal/data"
 
 	adminV1 "go-wind-admin/api/gen/go/admin/service/v1"
+	auditV1 "go-wind-admin/api/gen/go/audit/service/v1"
 	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
 	identityV1 "go-wind-admin/api/gen/go/identity/service/v1"
 	permissionV1 "go-wind-admin/api/gen/go/permission/service/v1"
@@ -22,6 +24,8 @@
 	"go-wind-admin/pkg/constants"
 	appViewer "go-wind-admin/pkg/entgo/viewer"
 	"go-wind-admin/pkg/middleware/auth"
+	"go-wind-admin/pkg/middleware/fieldperm"
+	"go-wind-admin/pkg/sender"
 	"go-wind-admin/pkg/utils"
 )
 
@@ -39,6 +43,13 @@ type UserService struct {
 	tenantRepo   *data.TenantRepo
 
 	membershipRepo *data.MembershipRepo
+
+	auditor *operationAuditor
+
+	tokenNotifier *credentialTokenNotifier
+
+	authenticator *data.Authenticator
+	clientType    authenticationV1.ClientType
 }
 
 func NewUserService(
@@ -50,9 +61,14 @@ func NewUserService(
 	orgUnitRepo *data.OrgUnitRepo,
 	tenantRepo *data.TenantRepo,
 	membershipRepo *data.MembershipRepo,
+	operationAuditLogRepo *data.OperationAuditLogRepo,
+	sender *sender.Router,
+	authenticator *data.Authenticator,
+	clientType authenticationV1.ClientType,
 ) *UserService {
+	l := ctx.NewLoggerHelper("user/service/admin-service")
 	svc := &UserService{
-		log:                ctx.NewLoggerHelper("user/service/admin-service"),
+		log:                l,
 		userRepo:           userRepo,
 		roleRepo:           roleRepo,
 		userCredentialRepo: userCredentialRepo,
@@ -60,6 +76,10 @@ func NewUserService(
 		orgUnitRepo:        orgUnitRepo,
 		tenantRepo:         tenantRepo,
 		membershipRepo:     membershipRepo,
+		auditor:            newOperationAuditor(l, operationAuditLogRepo),
+		tokenNotifier:      newCredentialTokenNotifier(sender),
+		authenticator:      authenticator,
+		clientType:         clientType,
 	}
 
 	svc.init()
@@ -335,7 +355,7 @@ func (s *UserService) Create(ctx context.Context, req *identityV1.CreateUserRequ
 	}
 
 	// 获取操作者的用户信息
-	_, err = s.userRepo.Get(ctx, &identityV1.GetUserRequest{
+	operatorUser, err := s.userRepo.Get(ctx, &identityV1.GetUserRequest{
 		QueryBy: &identityV1.GetUserRequest_Id{
 			Id: operator.UserId,
 		},
@@ -396,17 +416,54 @@ func (s *UserService) Create(ctx context.Context, req *identityV1.CreateUserRequ
 	req.Data.RoleId = nil
 	req.Data.RoleIds = roleIds
 
+	// 授予操作者自身未持有的角色属于授权操作，另需角色管理权限，避免仅有用户管理权限者借分配角色提权
+	for _, roleId := range roleIds {
+		if sliceutil.Includes(operatorUser.GetRoleIds(), roleId) {
+			continue
+		}
+		if err = requirePermission(ctx, "edit", "role"); err != nil {
+			return nil, err
+		}
+		break
+	}
+
+	credentialStatus := authenticationV1.UserCredential_ENABLED
+	if req.GetSendActivation() {
+		// 激活模式：凭证以随机占位密码待激活，由用户经激活链接自行设置密码。
+		// 投递渠道须在创建用户前确认可用，否则用户落库却无法激活
+		if len(req.GetPassword()) > 0 {
+			return nil, adminV1.ErrorBadRequest("password must be empty when send_activation is set")
+		}
+		if _, _, ok := s.tokenNotifier.destination(req.Data, authenticationV1.DeliveryChannel_DELIVERY_CHANNEL_UNSPECIFIED); !ok {
+			return nil, adminV1.ErrorBadRequest("email or mobile with a configured sender is required for activation")
+		}
+		placeholder, perr := newPlaceholderPassword()
+		if perr != nil {
+			s.log.Errorf("generate placeholder password failed: %s", perr.Error())
+			return nil, adminV1.ErrorInternalServerError("create user failed")
+		}
+		req.Password = trans.Ptr(placeholder)
+		credentialStatus = authenticationV1.UserCredential_UNVERIFIED
+	} else {
+		if len(req.GetPassword()) == 0 {
+			// 如果没有设置密码，则设置为默认密码。
+			req.Password = trans.Ptr(constants.DefaultUserPassword)
+		}
+
+		// 密码策略须在创建用户前校验，否则用户落库而凭证被拒
+		if err = s.userCredentialRepo.CheckPassword(ctx, req.Data.GetTenantId(), req.Data.GetUsername(), req.GetPassword()); err != nil {
+			return nil, err
+		}
+	}
+
 	// 创建用户
 	var user *identityV1.User
-	if user, err = s.userRepo.Create(ctx, req); err != nil {
+	user, err = s.userRepo.Create(ctx, req)
+	s.auditor.record(ctx, auditResourceUser, user.GetId(), auditV1.OperationAuditLog_CREATE, nil, user, err)
+	if err != nil {
 		return nil, err
 	}
 
-	if len(req.GetPassword()) == 0 {
-		// 如果没有设置密码，则设置为默认密码。
-		req.Password = trans.Ptr(constants.DefaultUserPassword)
-	}
-
 	if len(req.GetPassword()) > 0 {
 		if err = s.userCredentialRepo.Create(ctx, &authenticationV1.CreateUserCredentialRequest{
 			Data: &authenticationV1.UserCredential{
@@ -420,13 +477,20 @@ func (s *UserService) Create(ctx context.Context, req *identityV1.CreateUserRequ
 				Credential:     req.Password,
 
 				IsPrimary: trans.Ptr(true),
-				Status:    authenticationV1.UserCredential_ENABLED.Enum(),
+				Status:    credentialStatus.Enum(),
 			},
 		}); err != nil {
 			return nil, err
 		}
 	}
 
+	if req.GetSendActivation() {
+		if err = s.sendActivation(ctx, user, authenticationV1.DeliveryChannel_DELIVERY_CHANNEL_UNSPECIFIED); err != nil {
+			s.log.Errorf("send activation to new user [%d] failed: %s", user.GetId(), err.Error())
+			return nil, adminV1.ErrorServiceUnavailable("user created, but sending activation failed, please resend later")
+		}
+	}
+
 	return &emptypb.Empty{}, nil
 }
 
@@ -509,12 +573,30 @@ func (s *UserService) Update(ctx context.Context, req *identityV1.UpdateUserRequ
 	req.Data.RoleId = nil
 	req.Data.RoleIds = roleIds
 
+	getTarget := &identityV1.GetUserRequest{QueryBy: &identityV1.GetUserRequest_Id{Id: req.GetId()}}
+	before, _ := s.userRepo.Get(ctx, getTarget)
+
+	// 新增授予的角色同样需要角色管理权限；仅保持或收回原有角色时不要求
+	for _, roleId := range roleIds {
+		if sliceutil.Includes(before.GetRoleIds(), roleId) {
+			continue
+		}
+		if err = requirePermission(ctx, "edit", "role"); err != nil {
+			return nil, err
+		}
+		break
+	}
+
 	// 更新用户
 	if err = s.userRepo.Update(ctx, req); err != nil {
 		s.log.Error(err)
+		s.auditor.record(ctx, auditResourceUser, req.GetId(), auditV1.OperationAuditLog_UPDATE, before, nil, err)
 		return nil, err
 	}
 
+	after, _ := s.userRepo.Get(ctx, getTarget)
+	s.auditor.record(ctx, auditResourceUser, req.GetId(), auditV1.OperationAuditLog_UPDATE, before, after, nil)
+
 	if len(req.GetPassword()) > 0 {
 		// 跨租户防护：非平台超级管理员重置密码前，校验目标用户真实租户
 		// 注意：此处不能用 req.Data.GetTenantId()，因为上方已将其覆盖为操作者租户
@@ -603,6 +685,7 @@ func (s *UserService) Delete(ctx context.Context, req *identityV1.DeleteUserRequ
 
 	// 删除用户
 	err = s.userRepo.Delete(ctx, req)
+	s.auditor.record(ctx, auditResourceUser, target.GetId(), auditV1.OperationAuditLog_DELETE, target, nil, err)
 
 	return &emptypb.Empty{}, err
 }
@@ -697,3 +780,145 @@ func (s *UserService) createDefaultUser(ctx context.Context) error {
 
 	return err
 }
+
+// SendActivation 向待激活用户发送（重发）激活链接，重发即作废此前的激活令牌。
+func (s *UserService) SendActivation(ctx context.Context, req *authenticationV1.SendActivationRequest) (*emptypb.Empty, error) {
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.userRepo.Get(ctx, &identityV1.GetUserRequest{
+		QueryBy: &identityV1.GetUserRequest_Id{Id: req.GetUserId()},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if operator.GetTenantId() > 0 && user.GetTenantId() != operator.GetTenantId() {
+		return nil, adminV1.ErrorNotFound("user not found")
+	}
+
+	if err = s.sendActivation(ctx, user, req.GetChannel()); err != nil {
+		return nil, err
+	}
+
+	s.log.Infof("user [%d] sent activation to user [%d]", operator.GetUserId(), user.GetId())
+	return &emptypb.Empty{}, nil
+}
+
+// ListSessions 查询用户的登录会话（设备）列表
+func (s *UserService) ListSessions(ctx context.Context, req *authenticationV1.ListUserSessionsRequest) (*authenticationV1.ListUserSessionsResponse, error) {
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.checkSessionUser(ctx, operator, req.GetUserId()); err != nil {
+		return nil, err
+	}
+
+	items, err := s.authenticator.ListUserSessions(ctx, s.clientType, req.GetUserId(), operator.GetSessionId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &authenticationV1.ListUserSessionsResponse{
+		Items: items,
+		Total: uint32(len(items)),
+	}, nil
+}
+
+// RevokeSession 强制下线用户的指定会话
+func (s *UserService) RevokeSession(ctx context.Context, req *authenticationV1.RevokeUserSessionRequest) (*emptypb.Empty, error) {
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.checkSessionUser(ctx, operator, req.GetUserId()); err != nil {
+		return nil, err
+	}
+
+	if err = s.authenticator.RevokeUserSession(ctx, s.clientType, req.GetUserId(), req.GetSessionId()); err != nil {
+		return nil, err
+	}
+
+	s.log.Infof("user [%d] revoked session [%s] of user [%d]", operator.GetUserId(), req.GetSessionId(), req.GetUserId())
+	return &emptypb.Empty{}, nil
+}
+
+// RevokeAllSessions 强制下线用户的全部会话
+func (s *UserService) RevokeAllSessions(ctx context.Context, req *authenticationV1.RevokeUserSessionsRequest) (*emptypb.Empty, error) {
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.checkSessionUser(ctx, operator, req.GetUserId()); err != nil {
+		return nil, err
+	}
+
+	if err = s.authenticator.RevokeUserToken(ctx, s.clientType, req.GetUserId()); err != nil {
+		return nil, err
+	}
+
+	s.log.Infof("user [%d] revoked all sessions of user [%d]", operator.GetUserId(), req.GetUserId())
+	return &emptypb.Empty{}, nil
+}
+
+// checkSessionUser 租户管理员只能管理本租户用户的会话
+func (s *UserService) checkSessionUser(ctx context.Context, operator *authenticationV1.UserTokenPayload, userId uint32) error {
+	user, err := s.userRepo.Get(ctx, &identityV1.GetUserRequest{
+		QueryBy: &identityV1.GetUserRequest_Id{Id: userId},
+	})
+	if err != nil {
+		return err
+	}
+	if operator.GetTenantId() > 0 && user.GetTenantId() != operator.GetTenantId() {
+		return adminV1.ErrorNotFound("user not found")
+	}
+	return nil
+}
+
+// sendActivation 签发激活令牌并投递到用户邮箱/手机号。
+func (s *UserService) sendActivation(ctx context.Context, user *identityV1.User, channel authenticationV1.DeliveryChannel) error {
+	ch, to, ok := s.tokenNotifier.destination(user, channel)
+	if !ok {
+		return adminV1.ErrorBadRequest("no deliverable email or mobile for activation")
+	}
+
+	token, err := s.userCredentialRepo.IssueCredentialToken(ctx, data.CredentialTokenActivate, user.GetTenantId(), user.GetId(), activationTokenTTL)
+	if err != nil {
+		return err
+	}
+
+	if err = s.tokenNotifier.send(ctx, data.CredentialTokenActivate, ch, to, token); err != nil {
+		s.log.Errorf("send activation to user [%d] failed: %s", user.GetId(), err.Error())
+		return adminV1.ErrorServiceUnavailable("send activation failed")
+	}
+	return nil
+}
+
+// FieldRules 用户字段的读写权限：
+// 手机号、邮箱、真实姓名需显式持有 user:mobile:view 等权限码（如 HR 角色）方可查看明文，其余调用方看到脱敏值；
+// 状态与锁定截止时间仅具备 user:status:edit、user:locked-until:edit 权限码（如安全管理员）方可修改。
+func (s *UserService) FieldRules() []fieldperm.Rule {
+	user := (&identityV1.User{}).ProtoReflect().Descriptor().FullName()
+
+	return []fieldperm.Rule{
+		{Message: user, Field: "mobile", Read: true, Mask: fieldperm.MaskMobile},
+		{Message: user, Field: "email", Read: true, Mask: fieldperm.MaskEmail},
+		{Message: user, Field: "realname", Read: true, Mask: fieldperm.MaskName},
+		{Message: user, Field: "status", Write: true},
+		{Message: user, Field: "locked_until", Write: true},
+	}
+}
+
+// LoadFieldCurrent 加载被更新用户的当前值：管理端表单整体提交时 update_mask 带上未改动的状态等字段，值未变化的不校验写入权限
+func (s *UserService) LoadFieldCurrent(ctx context.Context, req proto.Message) (proto.Message, error) {
+	r, ok := req.(*identityV1.UpdateUserRequest)
+	if !ok || r.GetId() == 0 {
+		return nil, nil
+	}
+	return s.userRepo.Get(ctx, &identityV1.GetUserRequest{QueryBy: &identityV1.GetUserRequest_Id{Id: r.GetId()}})
+}
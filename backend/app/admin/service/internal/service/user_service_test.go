@@ -0,0 +1,93 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tx7do/go-crud/viewer"
+	"github.com/tx7do/go-utils/trans"
+
+	conf "github.com/tx7do/kratos-bootstrap/api/gen/go/conf/v1"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"go-wind-admin/app/admin/service/internal/data"
+	"go-wind-admin/app/admin/service/internal/data/ent/role"
+	"go-wind-admin/app/admin/service/internal/data/ent/user"
+	"go-wind-admin/app/admin/service/internal/data/ent/userrole"
+	"go-wind-admin/app/admin/service/internal/data/enttest"
+
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+	identityV1 "go-wind-admin/api/gen/go/identity/service/v1"
+
+	appViewer "go-wind-admin/pkg/entgo/viewer"
+	"go-wind-admin/pkg/middleware/auth"
+)
+
+func TestUserService_CreateRoleGrant(t *testing.T) {
+	entClient := enttest.NewEntClientForTest(t)
+	bctx := bootstrap.NewContextWithParam(context.Background(), &conf.AppInfo{}, &conf.Bootstrap{}, log.DefaultLogger)
+	sysCtx := enttest.NewSystemViewerCtx(context.Background())
+
+	const (
+		tenantID   = 9401
+		operatorID = 9401
+		staffRole  = 9401
+		adminRole  = 9402
+	)
+	db := entClient.Client()
+	for _, r := range []struct {
+		id   uint32
+		code string
+	}{
+		{staffRole, "staff"}, {adminRole, "tenant-admin"},
+	} {
+		require.NoError(t, db.Role.Create().SetID(r.id).SetTenantID(tenantID).SetName(r.code).SetCode(r.code).
+			SetType(role.TypeTenant).SetStatus(role.StatusOn).Exec(sysCtx))
+	}
+	require.NoError(t, db.User.Create().SetID(operatorID).SetTenantID(tenantID).SetUsername("operator9401").
+		SetStatus(user.StatusNormal).Exec(sysCtx))
+	require.NoError(t, db.UserRole.Create().SetTenantID(tenantID).SetUserID(operatorID).SetRoleID(staffRole).
+		SetIsPrimary(true).SetStatus(userrole.StatusActive).Exec(sysCtx))
+
+	userRoleRepo := data.NewUserRoleRepo(bctx, entClient)
+	membershipRepo := data.NewMembershipRepo(bctx, entClient,
+		data.NewMembershipRoleRepo(bctx, entClient), data.NewMembershipPositionRepo(bctx, entClient), data.NewMembershipOrgUnitRepo(bctx, entClient))
+	userRepo := data.NewUserRepo(bctx, entClient, userRoleRepo, data.NewUserOrgUnitRepo(bctx, entClient), data.NewUserPositionRepo(bctx, entClient), membershipRepo)
+	permissionRepo := data.NewPermissionRepo(bctx, entClient, data.NewPermissionApiRepo(bctx, entClient), data.NewPermissionMenuRepo(bctx, entClient))
+	roleRepo := data.NewRoleRepo(bctx, entClient, data.NewRolePermissionRepo(bctx, entClient), permissionRepo, data.NewRoleMetadataRepo(bctx, entClient))
+	svc := NewUserService(bctx, userRepo, roleRepo, data.NewUserCredentialRepo(bctx, entClient, data.NewPasswordCrypto(), nil),
+		nil, nil, data.NewTenantRepo(bctx, entClient), membershipRepo, data.NewOperationAuditLogRepo(bctx, entClient),
+		nil, nil, authenticationV1.ClientType_admin)
+
+	operatorCtx := func(permissions ...string) context.Context {
+		ctx := viewer.WithContext(context.Background(),
+			appViewer.NewUserViewer(operatorID, tenantID, 0, "", identityV1.DataScope_ALL, []string{"staff"}, permissions))
+		return auth.NewContext(ctx, &authenticationV1.UserTokenPayload{
+			UserId:   operatorID,
+			TenantId: trans.Ptr(uint32(tenantID)),
+			Roles:    []string{"staff"},
+		})
+	}
+	create := func(ctx context.Context, username string, roleIDs ...uint32) error {
+		_, err := svc.Create(ctx, &identityV1.CreateUserRequest{
+			Data:     &identityV1.User{Username: trans.Ptr(username), RoleIds: roleIDs},
+			Password: trans.Ptr("Init#Pass9401"),
+		})
+		return err
+	}
+
+	// 仅授予操作者自身持有的角色时，用户管理权限即可
+	require.NoError(t, create(operatorCtx("user:create"), "member9401", staffRole))
+
+	// 授予操作者未持有的角色需要角色管理权限
+	assert.Equal(t, 403, int(errors.Code(create(operatorCtx("user:create"), "member9402", adminRole))))
+	assert.Equal(t, 403, int(errors.Code(create(operatorCtx("user:create"), "member9403", staffRole, adminRole))))
+	require.NoError(t, create(operatorCtx("user:create", "role:edit"), "member9404", adminRole))
+
+	// 缺少 user:create 时写入被实体隐私规则拒绝
+	assert.Error(t, create(operatorCtx(), "member9405", staffRole))
+}
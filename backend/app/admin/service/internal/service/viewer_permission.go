@@ -0,0 +1,22 @@
+package service
+
+import (
+	"context"
+
+	"github.com/tx7do/go-crud/viewer"
+
+	adminV1 "go-wind-admin/api/gen/go/admin/service/v1"
+)
+
+// requirePermission 动作级权限校验：要求当前 Viewer 具备资源上的动作权限（如 ("edit", "role") -> role:edit）。
+// 用于接口鉴权之外、同一接口内按请求内容追加的细粒度判断；Viewer 缺失时拒绝。
+func requirePermission(ctx context.Context, action, resource string) error {
+	vc, ok := viewer.FromContext(ctx)
+	if !ok || vc == nil {
+		return adminV1.ErrorForbidden("missing viewer context")
+	}
+	if !vc.HasPermission(action, resource) {
+		return adminV1.ErrorForbidden("no permission to %s %s", action, resource)
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+package abac
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/transport"
+	khttp "github.com/go-kratos/kratos/v2/transport/http"
+
+	"go-wind-admin/pkg/netutil"
+)
+
+// Subject 访问主体：当前登录用户的身份属性
+type Subject struct {
+	UserID    uint32   `json:"user_id"`
+	TenantID  uint32   `json:"tenant_id"`
+	OrgUnitID uint32   `json:"org_unit_id"`
+	Username  string   `json:"username"`
+	Roles     []string `json:"roles"`
+}
+
+// Request 请求属性
+type Request struct {
+	Operation string            `json:"operation"`
+	Path      string            `json:"path"`   // 路由模板，如 /admin/v1/users/{id}
+	Method    string            `json:"method"` // HTTP 方法
+	IP        string            `json:"ip"`
+	Params    map[string]string `json:"params"` // 路径参数，如 {"id": "5"}
+	Time      time.Time         `json:"time"`
+}
+
+// Attributes 一次判定的全部属性
+type Attributes struct {
+	Subject  Subject        `json:"subject"`
+	Request  Request        `json:"request"`
+	Resource map[string]any `json:"resource,omitempty"` // 业务层加载的资源，仅资源级策略可用
+}
+
+// NewRequestFromContext 从传输层上下文提取请求属性
+func NewRequestFromContext(ctx context.Context) Request {
+	req := Request{
+		IP:     netutil.ClientIPFromContext(ctx),
+		Params: map[string]string{},
+		Time:   time.Now(),
+	}
+
+	tr, ok := transport.FromServerContext(ctx)
+	if !ok {
+		return req
+	}
+
+	req.Operation = tr.Operation()
+	req.Path = tr.Operation()
+	if htr, isHttp := tr.(khttp.Transporter); isHttp {
+		req.Path = htr.PathTemplate()
+		req.Method = htr.Request().Method
+		req.Params = PathParams(req.Path, htr.Request().URL.Path)
+	}
+
+	return req
+}
+
+// PathParams 按路由模板从实际路径中提取路径参数：模板段 {name} 或 {name=pattern} 对应实际路径的同位置段。
+// 模板与路径段数不一致时只提取能对齐的部分。
+func PathParams(template, path string) map[string]string {
+	params := map[string]string{}
+
+	tSegs := strings.Split(strings.Trim(template, "/"), "/")
+	pSegs := strings.Split(strings.Trim(path, "/"), "/")
+	for i, seg := range tSegs {
+		if i >= len(pSegs) {
+			break
+		}
+		if !strings.HasPrefix(seg, "{") || !strings.HasSuffix(seg, "}") {
+			continue
+		}
+		name := strings.TrimSuffix(strings.TrimPrefix(seg, "{"), "}")
+		name, _, _ = strings.Cut(name, "=")
+		if name != "" {
+			params[name] = pSegs[i]
+		}
+	}
+
+	return params
+}
+
+// activation 转为 CEL 变量：整数统一为 int64，时间为 timestamp
+func (a *Attributes) activation() map[string]any {
+	roles := a.Subject.Roles
+	if roles == nil {
+		roles = []string{}
+	}
+	params := a.Request.Params
+	if params == nil {
+		params = map[string]string{}
+	}
+	resource := a.Resource
+	if resource == nil {
+		resource = map[string]any{}
+	}
+
+	return map[string]any{
+		"subject": map[string]any{
+			"user_id":     int64(a.Subject.UserID),
+			"tenant_id":   int64(a.Subject.TenantID),
+			"org_unit_id": int64(a.Subject.OrgUnitID),
+			"username":    a.Subject.Username,
+			"roles":       roles,
+		},
+		"request": map[string]any{
+			"operation": a.Request.Operation,
+			"path":      a.Request.Path,
+			"method":    a.Request.Method,
+			"ip":        a.Request.IP,
+			"params":    params,
+			"time":      a.Request.Time,
+		},
+		"resource": resource,
+	}
+}
+
+// Snapshot 属性快照（JSON），写入策略评估日志
+func (a *Attributes) Snapshot() string {
+	b, err := json.Marshal(a)
+	if err != nil {
+		return ""
+	}
+	return string(b)
+}
+
+// Fingerprint 判定结果缓存键的属性部分。不含请求时间——否则缓存永不命中；
+// 依赖时间的策略应把缓存秒数设为 0 或足够小。
+func (a *Attributes) Fingerprint() string {
+	c := *a
+	c.Request.Time = time.Time{}
+	b, err := json.Marshal(c)
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,76 @@
+package abac
+
+import (
+	"sync"
+	"time"
+)
+
+// decisionCacheMaxEntries 缓存条目超过该值时在写入前清理过期条目
+const decisionCacheMaxEntries = 10000
+
+type decisionEntry struct {
+	allowed   bool
+	expiresAt time.Time
+}
+
+// DecisionCache 判定结果的进程内缓存，按策略的缓存秒数过期。
+// 策略变更时由调用方 Purge；键中包含策略版本与更新时间，多实例下未及时 Purge 的旧结果也不会被新策略命中。
+type DecisionCache struct {
+	mu    sync.Mutex
+	items map[string]decisionEntry
+	now   func() time.Time
+}
+
+func NewDecisionCache() *DecisionCache {
+	return &DecisionCache{
+		items: make(map[string]decisionEntry),
+		now:   time.Now,
+	}
+}
+
+// Get 读取未过期的判定结果
+func (c *DecisionCache) Get(key string) (allowed bool, ok bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.items[key]
+	if !ok {
+		return false, false
+	}
+	if !c.now().Before(entry.expiresAt) {
+		delete(c.items, key)
+		return false, false
+	}
+	return entry.allowed, true
+}
+
+// Set 写入判定结果，ttl 不大于 0 时不缓存
+func (c *DecisionCache) Set(key string, allowed bool, ttl time.Duration) {
+	if ttl <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	if len(c.items) >= decisionCacheMaxEntries {
+		for k, v := range c.items {
+			if !now.Before(v.expiresAt) {
+				delete(c.items, k)
+			}
+		}
+		// 全部未过期时整体清空，避免无界增长
+		if len(c.items) >= decisionCacheMaxEntries {
+			c.items = make(map[string]decisionEntry)
+		}
+	}
+	c.items[key] = decisionEntry{allowed: allowed, expiresAt: now.Add(ttl)}
+}
+
+// Purge 清空缓存
+func (c *DecisionCache) Purge() {
+	c.mu.Lock()
+	c.items = make(map[string]decisionEntry)
+	c.mu.Unlock()
+}
@@ -0,0 +1,135 @@
+package abac
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestAttributes() *Attributes {
+	return &Attributes{
+		Subject: Subject{
+			UserID:    5,
+			TenantID:  2,
+			OrgUnitID: 7,
+			Username:  "alice",
+			Roles:     []string{"auditor"},
+		},
+		Request: Request{
+			Path:   "/admin/v1/users/{id}",
+			Method: "GET",
+			IP:     "10.0.0.8",
+			Params: map[string]string{"id": "5"},
+			Time:   time.Date(2026, 1, 1, 10, 30, 0, 0, time.UTC),
+		},
+	}
+}
+
+func TestCelEngineEvaluate(t *testing.T) {
+	engine, err := NewCelEngine()
+	require.NoError(t, err)
+
+	attrs := newTestAttributes()
+	cases := []struct {
+		expr string
+		want bool
+	}{
+		{`request.ip.startsWith('10.')`, true},
+		{`'auditor' in subject.roles`, true},
+		{`'admin' in subject.roles`, false},
+		{`subject.tenant_id == 2 && subject.org_unit_id == 7`, true},
+		{`request.params.id == string(subject.user_id)`, true},
+		{`request.time.getHours('UTC') >= 9 && request.time.getHours('UTC') < 18`, true},
+		{`request.method == 'DELETE'`, false},
+	}
+	for _, c := range cases {
+		got, err := engine.Evaluate(c.expr, attrs)
+		require.NoError(t, err, c.expr)
+		assert.Equal(t, c.want, got, c.expr)
+	}
+
+	// 资源属性
+	attrs.Resource = map[string]any{"owner_id": int64(5)}
+	got, err := engine.Evaluate(`resource.owner_id == subject.user_id`, attrs)
+	require.NoError(t, err)
+	assert.True(t, got)
+
+	// 访问不存在的字段为求值错误，由调用方按拒绝处理
+	attrs.Resource = nil
+	_, err = engine.Evaluate(`resource.owner_id == subject.user_id`, attrs)
+	assert.Error(t, err)
+}
+
+func TestCelEngineCompile(t *testing.T) {
+	engine, err := NewCelEngine()
+	require.NoError(t, err)
+
+	_, err = engine.Compile(`subject.user_id ==`)
+	assert.Error(t, err)
+
+	_, err = engine.Compile(`1 + 2`)
+	assert.Error(t, err)
+
+	_, err = engine.Compile(`subject.user_id > 0`)
+	assert.NoError(t, err)
+}
+
+func TestParseDefinition(t *testing.T) {
+	def, err := ParseDefinition(`{"expression": "subject.user_id > 0", "message": "denied", "resource": true}`)
+	require.NoError(t, err)
+	assert.Equal(t, "subject.user_id > 0", def.Expression)
+	assert.Equal(t, "denied", def.Message)
+	assert.True(t, def.Resource)
+
+	_, err = ParseDefinition(``)
+	assert.Error(t, err)
+	_, err = ParseDefinition(`{"message": "x"}`)
+	assert.Error(t, err)
+	_, err = ParseDefinition(`not json`)
+	assert.Error(t, err)
+}
+
+func TestPathParams(t *testing.T) {
+	assert.Equal(t, map[string]string{"id": "5"}, PathParams("/admin/v1/users/{id}", "/admin/v1/users/5"))
+	assert.Equal(t,
+		map[string]string{"userId": "3", "name": "a"},
+		PathParams("/admin/v1/users/{userId}/files/{name=*}", "/admin/v1/users/3/files/a"),
+	)
+	assert.Empty(t, PathParams("/admin/v1/users", "/admin/v1/users"))
+}
+
+func TestAttributesFingerprint(t *testing.T) {
+	a := newTestAttributes()
+	b := newTestAttributes()
+	b.Request.Time = b.Request.Time.Add(time.Hour)
+	assert.Equal(t, a.Fingerprint(), b.Fingerprint())
+
+	b.Request.IP = "10.0.0.9"
+	assert.NotEqual(t, a.Fingerprint(), b.Fingerprint())
+}
+
+func TestDecisionCache(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	c := NewDecisionCache()
+	c.now = func() time.Time { return now }
+
+	c.Set("a", true, time.Minute)
+	c.Set("b", false, 0)
+
+	allowed, ok := c.Get("a")
+	assert.True(t, ok)
+	assert.True(t, allowed)
+	_, ok = c.Get("b")
+	assert.False(t, ok)
+
+	now = now.Add(time.Minute)
+	_, ok = c.Get("a")
+	assert.False(t, ok)
+
+	c.Set("a", false, time.Minute)
+	c.Purge()
+	_, ok = c.Get("a")
+	assert.False(t, ok)
+}
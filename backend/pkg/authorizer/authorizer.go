@@ -13,14 +13,22 @@
 	"github.com/tx7do/kratos-authz/engine/opa"
 
 	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"go-wind-admin/pkg/authorizer/zanzibar"
 )
 
+// zanzibarNamespacesModel ReBAC 命名空间配置的模型名
+const zanzibarNamespacesModel = "namespaces.json"
+
 // Authorizer 权限管理器
 type Authorizer struct {
 	log *log.Helper
 
 	engine   authzEngine.Engine
 	provider Provider
+
+	// relations ReBAC 引擎，与接口鉴权使用的引擎类型无关，始终提供关系元组的读写与查询
+	relations *zanzibar.Engine
 }
 
 func NewAuthorizer(
@@ -48,6 +56,7 @@ func NewAuthorizer(
 }
 
 func (a *Authorizer) init(ctx context.Context, cfg *conf.Authorization) {
+	a.relations = a.newRelationEngine()
 	a.engine = a.newEngine(ctx, cfg)
 
 	//if err := a.ResetPolicies(ctx); err != nil {
@@ -59,6 +68,11 @@ func (a *Authorizer) Engine() authzEngine.Engine {
 	return a.engine
 }
 
+// Relations ReBAC 引擎，未配置元组存储或命名空间时为 nil
+func (a *Authorizer) Relations() *zanzibar.Engine {
+	return a.relations
+}
+
 // ResetPolicies 重置策略
 func (a *Authorizer) ResetPolicies(ctx context.Context) error {
 	//a.log.Info("*******************reset policies")
@@ -87,6 +101,9 @@ func (a *Authorizer) ResetPolicies(ctx context.Context) error {
 			return err
 		}
 
+	case "zanzibar":
+		policies = a.generateZanzibarPolicies(result)
+
 	case "noop":
 		return nil
 
@@ -159,6 +176,27 @@ type OpaPolicyPath struct {
 	return policies, nil
 }
 
+// generateZanzibarPolicies 生成 api 命名空间的关系元组：api:<路由模板>#<小写HTTP方法>@role:<角色编码>#member
+func (a *Authorizer) generateZanzibarPolicies(data PermissionDataMap) authzEngine.PolicyMap {
+	var tuples []zanzibar.Tuple
+	seen := make(map[zanzibar.Tuple]struct{})
+
+	for roleCode, aRules := range data {
+		for _, api := range aRules {
+			t := zanzibar.APITuple(roleCode, api.Path, api.Method)
+			if _, ok := seen[t]; ok {
+				continue
+			}
+			seen[t] = struct{}{}
+			tuples = append(tuples, t)
+		}
+	}
+
+	return authzEngine.PolicyMap{
+		zanzibar.PolicyTuples: tuples,
+	}
+}
+
 // newEngine 创建权限引擎
 func (a *Authorizer) newEngine(ctx context.Context, cfg *conf.Authorization) authzEngine.Engine {
 	if cfg == nil {
@@ -182,9 +220,44 @@ func (a *Authorizer) newEngine(ctx context.Context, cfg *conf.Authorization) aut
 	}
 }
 
-// newEngineZanzibar 创建 Zanzibar 引擎（未实现）
+// newEngineZanzibar 以 ReBAC 引擎做接口鉴权
 func (a *Authorizer) newEngineZanzibar(_ context.Context) authzEngine.Engine {
-	return nil
+	if a.relations == nil {
+		a.log.Errorf("zanzibar engine is not available: tuple store or namespace config missing")
+		return nil
+	}
+	return a.relations
+}
+
+// newRelationEngine 创建 ReBAC 引擎，命名空间配置来自模型数据
+func (a *Authorizer) newRelationEngine() *zanzibar.Engine {
+	if a.provider == nil {
+		return nil
+	}
+
+	store := a.provider.ProvideTupleStore()
+	if store == nil {
+		return nil
+	}
+
+	model, ok := a.provider.ProvideModels("zanzibar")[zanzibarNamespacesModel]
+	if !ok {
+		a.log.Errorf("zanzibar model not found: %s", zanzibarNamespacesModel)
+		return nil
+	}
+
+	namespaces, err := zanzibar.ParseNamespaces(model)
+	if err != nil {
+		a.log.Errorf("parse zanzibar namespaces error: %v", err)
+		return nil
+	}
+
+	engine, err := zanzibar.NewEngine(store, namespaces)
+	if err != nil {
+		a.log.Errorf("init zanzibar engine error: %v", err)
+		return nil
+	}
+	return engine
 }
 
 // newEngineNoop 创建 Noop 引擎
@@ -1,6 +1,10 @@
 package authorizer
 
-import "context"
+import (
+	"context"
+
+	"go-wind-admin/pkg/authorizer/zanzibar"
+)
 
 // PermissionData 权限数据
 type PermissionData struct {
@@ -24,4 +28,7 @@ type Provider interface {
 
 	// ProvidePolicies 提供策略数据
 	ProvidePolicies(ctx context.Context) (PermissionDataMap, error)
+
+	// ProvideTupleStore 提供关系元组存储（ReBAC 引擎使用）
+	ProvideTupleStore() zanzibar.Store
 }
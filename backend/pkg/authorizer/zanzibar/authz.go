@@ -0,0 +1,119 @@
+package zanzibar
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	authzEngine "github.com/tx7do/kratos-authz/engine"
+)
+
+// 接口鉴权映射：RBAC 的“角色可调用接口”表示为 api:<路由模板>#<小写HTTP方法>@role:<角色编码>#member，
+// 由 Authorizer.ResetPolicies 依据角色权限整体重建 api 命名空间；角色成员、角色继承等可另写元组表达。
+const (
+	APINamespace       = "api"
+	RoleNamespace      = "role"
+	RoleMemberRelation = "member"
+
+	// PolicyTuples SetPolicies 策略表中 api 命名空间元组（[]Tuple）的键
+	PolicyTuples = "tuples"
+)
+
+var _ authzEngine.Engine = (*Engine)(nil)
+
+// RoleSubject 角色成员用户集 role:<code>#member
+func RoleSubject(roleCode string) Subject {
+	return Subject{Namespace: RoleNamespace, ID: roleCode, Relation: RoleMemberRelation}
+}
+
+// APITuple 角色可调用接口的元组
+func APITuple(roleCode, path, method string) Tuple {
+	return Tuple{
+		Object:   Object{Namespace: APINamespace, ID: path},
+		Relation: strings.ToLower(method),
+		Subject:  RoleSubject(roleCode),
+	}
+}
+
+func (e *Engine) Name() string {
+	return string(authzEngine.Zanzibar)
+}
+
+func (e *Engine) IsAuthorized(ctx context.Context, subject authzEngine.Subject, action authzEngine.Action, resource authzEngine.Resource, _ authzEngine.Project) (bool, error) {
+	object := Object{Namespace: APINamespace, ID: string(resource)}
+	relation := strings.ToLower(string(action))
+	if object.validate() != nil {
+		return false, nil
+	}
+	if _, err := e.relation(APINamespace, relation); err != nil {
+		return false, nil
+	}
+
+	roleSubject := RoleSubject(string(subject))
+	if roleSubject.validate() != nil {
+		return false, nil
+	}
+
+	allowed, _, err := e.Check(ctx, object, relation, roleSubject, "")
+	return allowed, err
+}
+
+func (e *Engine) ProjectsAuthorized(ctx context.Context, subjects authzEngine.Subjects, action authzEngine.Action, resource authzEngine.Resource, projects authzEngine.Projects) (authzEngine.Projects, error) {
+	for _, subject := range subjects {
+		allowed, err := e.IsAuthorized(ctx, subject, action, resource, "")
+		if err != nil {
+			return nil, err
+		}
+		if allowed {
+			return projects, nil
+		}
+	}
+	return authzEngine.Projects{}, nil
+}
+
+func (e *Engine) FilterAuthorizedPairs(ctx context.Context, subjects authzEngine.Subjects, pairs authzEngine.Pairs) (authzEngine.Pairs, error) {
+	result := authzEngine.Pairs{}
+	for _, pair := range pairs {
+		for _, subject := range subjects {
+			allowed, err := e.IsAuthorized(ctx, subject, pair.Action, pair.Resource, "")
+			if err != nil {
+				return nil, err
+			}
+			if allowed {
+				result = append(result, pair)
+				break
+			}
+		}
+	}
+	return result, nil
+}
+
+// FilterAuthorizedProjects 关系模型中没有项目概念
+func (e *Engine) FilterAuthorizedProjects(_ context.Context, _ authzEngine.Subjects) (authzEngine.Projects, error) {
+	return authzEngine.Projects{}, nil
+}
+
+// SetPolicies 以 policies[PolicyTuples] 整体替换 api 命名空间的元组
+func (e *Engine) SetPolicies(ctx context.Context, policies authzEngine.PolicyMap, _ authzEngine.RoleMap) error {
+	tuples, ok := policies[PolicyTuples].([]Tuple)
+	if !ok {
+		return fmt.Errorf("zanzibar policies must contain %q of []Tuple", PolicyTuples)
+	}
+
+	for _, t := range tuples {
+		if t.Object.Namespace != APINamespace {
+			return fmt.Errorf("policy tuple %s is not in namespace %s", t, APINamespace)
+		}
+		if err := e.ValidateTuple(t); err != nil {
+			return err
+		}
+	}
+
+	if _, err := e.store.ReplaceNamespace(ctx, APINamespace, tuples); err != nil {
+		return err
+	}
+
+	e.purgeCache()
+
+	return nil
+}
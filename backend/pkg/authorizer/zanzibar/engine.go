@@ -0,0 +1,537 @@
+// Package zanzibar 基于关系的访问控制（ReBAC）引擎，模型参照 Google Zanzibar：
+//   - 关系元组 object#relation@subject 描述对象与主体的关系，主体可以是用户集（object#relation）；
+//   - 命名空间配置为关系声明用户集改写规则（union / intersection / exclusion，
+//     computed_userset / tuple_to_userset），如文档 owner 即 editor、组织单元继承上级单元的 manager；
+//   - 提供 Check、Expand、ListObjects 查询与元组写入，写入返回一致性令牌，
+//     查询携带令牌时保证看到该次写入（不使用早于令牌版本的缓存结果）。
+package zanzibar
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	OperationUnion          = "union"
+	OperationIntersection   = "intersection"
+	OperationExclusion      = "exclusion"
+	OperationThis           = "this"
+	OperationTupleToUserset = "tuple_to_userset"
+	// OperationReference 环路中已在展开的用户集，只给出引用不再展开
+	OperationReference = "reference"
+)
+
+const (
+	defaultMaxDepth      = 32
+	defaultCheckCacheTTL = 5 * time.Second
+	maxCheckCacheEntries = 10000
+)
+
+var (
+	ErrUnknownNamespace    = errors.New("unknown namespace")
+	ErrUnknownRelation     = errors.New("unknown relation")
+	ErrMaxDepthExceeded    = errors.New("max userset depth exceeded")
+	ErrRevisionUnavailable = errors.New("consistency token is ahead of the tuple store")
+)
+
+// ExpandNode 用户集展开树
+type ExpandNode struct {
+	Operation string
+	Object    Object
+	Relation  string
+	// Subjects 直接元组的主体（Operation 为 this 时）
+	Subjects []Subject
+	Children []*ExpandNode
+}
+
+type Option func(*Engine)
+
+// WithMaxDepth 用户集递归的最大深度
+func WithMaxDepth(depth int) Option {
+	return func(e *Engine) {
+		if depth > 0 {
+			e.maxDepth = depth
+		}
+	}
+}
+
+// WithCheckCacheTTL Check 结果的缓存时间，<= 0 不缓存
+func WithCheckCacheTTL(ttl time.Duration) Option {
+	return func(e *Engine) {
+		e.cacheTTL = ttl
+	}
+}
+
+type checkCacheEntry struct {
+	allowed   bool
+	revision  Revision
+	expiresAt time.Time
+}
+
+// Engine ReBAC 引擎
+type Engine struct {
+	store      Store
+	namespaces map[string]*Namespace
+
+	maxDepth int
+	cacheTTL time.Duration
+	now      func() time.Time
+
+	mu    sync.Mutex
+	cache map[string]checkCacheEntry
+}
+
+func NewEngine(store Store, namespaces []*Namespace, opts ...Option) (*Engine, error) {
+	if store == nil {
+		return nil, errors.New("tuple store is nil")
+	}
+
+	index, err := indexNamespaces(namespaces)
+	if err != nil {
+		return nil, err
+	}
+
+	e := &Engine{
+		store:      store,
+		namespaces: index,
+		maxDepth:   defaultMaxDepth,
+		cacheTTL:   defaultCheckCacheTTL,
+		now:        time.Now,
+		cache:      make(map[string]checkCacheEntry),
+	}
+	for _, opt := range opts {
+		opt(e)
+	}
+
+	return e, nil
+}
+
+// Namespaces 命名空间配置
+func (e *Engine) Namespaces() []*Namespace {
+	result := make([]*Namespace, 0, len(e.namespaces))
+	for _, ns := range e.namespaces {
+		result = append(result, ns)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+	return result
+}
+
+// Check 判定主体是否具有对象上的关系。token 为空时可使用缓存结果；
+// 携带令牌时只使用不早于令牌版本的结果。返回判定所依据版本的一致性令牌。
+func (e *Engine) Check(ctx context.Context, object Object, relation string, subject Subject, token string) (bool, string, error) {
+	if _, err := e.relation(object.Namespace, relation); err != nil {
+		return false, "", err
+	}
+	if err := subject.validate(); err != nil {
+		return false, "", err
+	}
+
+	atLeast, err := DecodeToken(token)
+	if err != nil {
+		return false, "", err
+	}
+
+	key := object.String() + "#" + relation + "@" + subject.String()
+	if entry, ok := e.cachedCheck(key, atLeast); ok {
+		return entry.allowed, EncodeToken(entry.revision), nil
+	}
+
+	rev, err := e.revision(ctx, atLeast)
+	if err != nil {
+		return false, "", err
+	}
+
+	allowed, err := e.newChecker(ctx).check(object, relation, subject, 0)
+	if err != nil {
+		return false, "", err
+	}
+
+	e.storeCheck(key, allowed, rev)
+
+	return allowed, EncodeToken(rev), nil
+}
+
+// Expand 展开对象关系的有效用户集
+func (e *Engine) Expand(ctx context.Context, object Object, relation string, token string) (*ExpandNode, string, error) {
+	if _, err := e.relation(object.Namespace, relation); err != nil {
+		return nil, "", err
+	}
+
+	atLeast, err := DecodeToken(token)
+	if err != nil {
+		return nil, "", err
+	}
+	rev, err := e.revision(ctx, atLeast)
+	if err != nil {
+		return nil, "", err
+	}
+
+	node, err := e.newChecker(ctx).expand(object, relation, 0)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return node, EncodeToken(rev), nil
+}
+
+// ListObjects 列出命名空间下主体具有指定关系的对象ID。
+// 以命名空间下出现过的对象为候选逐个判定，适用于对象规模有限的管理数据。
+func (e *Engine) ListObjects(ctx context.Context, namespace, relation string, subject Subject, token string) ([]string, string, error) {
+	if _, err := e.relation(namespace, relation); err != nil {
+		return nil, "", err
+	}
+	if err := subject.validate(); err != nil {
+		return nil, "", err
+	}
+
+	atLeast, err := DecodeToken(token)
+	if err != nil {
+		return nil, "", err
+	}
+	rev, err := e.revision(ctx, atLeast)
+	if err != nil {
+		return nil, "", err
+	}
+
+	ids, err := e.store.ListObjectIDs(ctx, namespace)
+	if err != nil {
+		return nil, "", err
+	}
+
+	c := e.newChecker(ctx)
+	var result []string
+	for _, id := range ids {
+		allowed, err := c.check(Object{Namespace: namespace, ID: id}, relation, subject, 0)
+		if err != nil {
+			return nil, "", err
+		}
+		if allowed {
+			result = append(result, id)
+		}
+	}
+
+	return result, EncodeToken(rev), nil
+}
+
+// Write 校验并写入、删除元组，返回包含本次写入的一致性令牌
+func (e *Engine) Write(ctx context.Context, writes, deletes []Tuple) (string, error) {
+	for _, t := range writes {
+		if err := e.ValidateTuple(t); err != nil {
+			return "", err
+		}
+	}
+	for _, t := range deletes {
+		if err := t.validate(); err != nil {
+			return "", err
+		}
+	}
+
+	rev, err := e.store.WriteTuples(ctx, writes, deletes)
+	if err != nil {
+		return "", err
+	}
+
+	e.purgeCache()
+
+	return EncodeToken(rev), nil
+}
+
+// ReadTuples 按条件读取元组
+func (e *Engine) ReadTuples(ctx context.Context, filter TupleFilter) ([]Tuple, error) {
+	return e.store.ReadTuples(ctx, filter)
+}
+
+// ValidateTuple 校验元组符合命名空间配置：对象关系接受直接元组，主体命名空间与关系已声明
+func (e *Engine) ValidateTuple(t Tuple) error {
+	if err := t.validate(); err != nil {
+		return err
+	}
+
+	rel, err := e.relation(t.Object.Namespace, t.Relation)
+	if err != nil {
+		return err
+	}
+	if !rel.allowsDirect() {
+		return fmt.Errorf("relation %s#%s is computed and does not accept tuples", t.Object.Namespace, t.Relation)
+	}
+
+	if t.Subject.IsUserset() {
+		_, err = e.relation(t.Subject.Namespace, t.Subject.Relation)
+		return err
+	}
+	if _, ok := e.namespaces[t.Subject.Namespace]; !ok {
+		return fmt.Errorf("%w: %s", ErrUnknownNamespace, t.Subject.Namespace)
+	}
+	return nil
+}
+
+func (e *Engine) relation(namespace, relation string) (*Relation, error) {
+	ns, ok := e.namespaces[namespace]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownNamespace, namespace)
+	}
+	rel, ok := ns.Relations[relation]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s#%s", ErrUnknownRelation, namespace, relation)
+	}
+	return rel, nil
+}
+
+// revision 读取存储当前版本，并确认不早于令牌要求的版本
+func (e *Engine) revision(ctx context.Context, atLeast Revision) (Revision, error) {
+	rev, err := e.store.Revision(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if rev < atLeast {
+		return 0, ErrRevisionUnavailable
+	}
+	return rev, nil
+}
+
+func (e *Engine) cachedCheck(key string, atLeast Revision) (checkCacheEntry, bool) {
+	if e.cacheTTL <= 0 {
+		return checkCacheEntry{}, false
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	entry, ok := e.cache[key]
+	if !ok || !e.now().Before(entry.expiresAt) || entry.revision < atLeast {
+		return checkCacheEntry{}, false
+	}
+	return entry, true
+}
+
+func (e *Engine) storeCheck(key string, allowed bool, rev Revision) {
+	if e.cacheTTL <= 0 {
+		return
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	now := e.now()
+	if len(e.cache) >= maxCheckCacheEntries {
+		for k, v := range e.cache {
+			if !now.Before(v.expiresAt) {
+				delete(e.cache, k)
+			}
+		}
+		if len(e.cache) >= maxCheckCacheEntries {
+			e.cache = make(map[string]checkCacheEntry)
+		}
+	}
+	e.cache[key] = checkCacheEntry{allowed: allowed, revision: rev, expiresAt: now.Add(e.cacheTTL)}
+}
+
+func (e *Engine) purgeCache() {
+	e.mu.Lock()
+	e.cache = make(map[string]checkCacheEntry)
+	e.mu.Unlock()
+}
+
+// checker 单次查询的求值状态：缓存本次查询读过的元组，记录递归路径以切断环路
+type checker struct {
+	ctx      context.Context
+	e        *Engine
+	reads    map[TupleFilter][]Tuple
+	visiting map[string]bool
+}
+
+func (e *Engine) newChecker(ctx context.Context) *checker {
+	return &checker{
+		ctx:      ctx,
+		e:        e,
+		reads:    make(map[TupleFilter][]Tuple),
+		visiting: make(map[string]bool),
+	}
+}
+
+func (c *checker) read(object Object, relation string) ([]Tuple, error) {
+	filter := TupleFilter{Namespace: object.Namespace, ObjectID: object.ID, Relation: relation}
+	if tuples, ok := c.reads[filter]; ok {
+		return tuples, nil
+	}
+
+	tuples, err := c.e.store.ReadTuples(c.ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	c.reads[filter] = tuples
+	return tuples, nil
+}
+
+func (c *checker) check(object Object, relation string, subject Subject, depth int) (bool, error) {
+	// 用户集包含其自身
+	if subject.Relation == relation && subject.Object() == object {
+		return true, nil
+	}
+	if depth > c.e.maxDepth {
+		return false, ErrMaxDepthExceeded
+	}
+
+	rel, err := c.e.relation(object.Namespace, relation)
+	if err != nil {
+		// 元组指向未声明的关系（如配置变更后遗留的元组）视为不成立
+		return false, nil
+	}
+
+	key := object.String() + "#" + relation
+	if c.visiting[key] {
+		return false, nil
+	}
+	c.visiting[key] = true
+	defer delete(c.visiting, key)
+
+	op, usersets := rel.usersets()
+	switch op {
+	case OperationIntersection:
+		for _, u := range usersets {
+			ok, err := c.checkUserset(object, relation, u, subject, depth)
+			if err != nil || !ok {
+				return false, err
+			}
+		}
+		return true, nil
+
+	case OperationExclusion:
+		ok, err := c.checkUserset(object, relation, usersets[0], subject, depth)
+		if err != nil || !ok {
+			return false, err
+		}
+		excluded, err := c.checkUserset(object, relation, usersets[1], subject, depth)
+		if err != nil {
+			return false, err
+		}
+		return !excluded, nil
+
+	default:
+		for _, u := range usersets {
+			ok, err := c.checkUserset(object, relation, u, subject, depth)
+			if err != nil || ok {
+				return ok, err
+			}
+		}
+		return false, nil
+	}
+}
+
+func (c *checker) checkUserset(object Object, relation string, u *Userset, subject Subject, depth int) (bool, error) {
+	switch {
+	case u.This:
+		tuples, err := c.read(object, relation)
+		if err != nil {
+			return false, err
+		}
+		for _, t := range tuples {
+			if t.Subject == subject {
+				return true, nil
+			}
+		}
+		for _, t := range tuples {
+			if !t.Subject.IsUserset() {
+				continue
+			}
+			ok, err := c.check(t.Subject.Object(), t.Subject.Relation, subject, depth+1)
+			if err != nil || ok {
+				return ok, err
+			}
+		}
+		return false, nil
+
+	case u.ComputedUserset != "":
+		return c.check(object, u.ComputedUserset, subject, depth+1)
+
+	case u.TupleToUserset != nil:
+		tuples, err := c.read(object, u.TupleToUserset.Tupleset)
+		if err != nil {
+			return false, err
+		}
+		for _, t := range tuples {
+			ok, err := c.check(t.Subject.Object(), u.TupleToUserset.ComputedUserset, subject, depth+1)
+			if err != nil || ok {
+				return ok, err
+			}
+		}
+		return false, nil
+	}
+
+	return false, nil
+}
+
+func (c *checker) expand(object Object, relation string, depth int) (*ExpandNode, error) {
+	if depth > c.e.maxDepth {
+		return nil, ErrMaxDepthExceeded
+	}
+
+	rel, err := c.e.relation(object.Namespace, relation)
+	if err != nil {
+		return &ExpandNode{Operation: OperationThis, Object: object, Relation: relation}, nil
+	}
+
+	key := object.String() + "#" + relation
+	if c.visiting[key] {
+		return &ExpandNode{Operation: OperationReference, Object: object, Relation: relation}, nil
+	}
+	c.visiting[key] = true
+	defer delete(c.visiting, key)
+
+	op, usersets := rel.usersets()
+	node := &ExpandNode{Operation: op, Object: object, Relation: relation}
+	for _, u := range usersets {
+		child, err := c.expandUserset(object, relation, u, depth)
+		if err != nil {
+			return nil, err
+		}
+		node.Children = append(node.Children, child)
+	}
+
+	return node, nil
+}
+
+func (c *checker) expandUserset(object Object, relation string, u *Userset, depth int) (*ExpandNode, error) {
+	switch {
+	case u.ComputedUserset != "":
+		return c.expand(object, u.ComputedUserset, depth+1)
+
+	case u.TupleToUserset != nil:
+		tuples, err := c.read(object, u.TupleToUserset.Tupleset)
+		if err != nil {
+			return nil, err
+		}
+		node := &ExpandNode{Operation: OperationTupleToUserset, Object: object, Relation: u.TupleToUserset.Tupleset}
+		for _, t := range tuples {
+			child, err := c.expand(t.Subject.Object(), u.TupleToUserset.ComputedUserset, depth+1)
+			if err != nil {
+				return nil, err
+			}
+			node.Children = append(node.Children, child)
+		}
+		return node, nil
+
+	default:
+		tuples, err := c.read(object, relation)
+		if err != nil {
+			return nil, err
+		}
+		node := &ExpandNode{Operation: OperationThis, Object: object, Relation: relation}
+		for _, t := range tuples {
+			node.Subjects = append(node.Subjects, t.Subject)
+			if !t.Subject.IsUserset() {
+				continue
+			}
+			child, err := c.expand(t.Subject.Object(), t.Subject.Relation, depth+1)
+			if err != nil {
+				return nil, err
+			}
+			node.Children = append(node.Children, child)
+		}
+		return node, nil
+	}
+}
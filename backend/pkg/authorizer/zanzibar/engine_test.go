@@ -0,0 +1,300 @@
+package zanzibar
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	authzEngine "github.com/tx7do/kratos-authz/engine"
+)
+
+const testNamespaces = `[
+  {"name": "user"},
+  {"name": "role", "relations": {"member": {}}},
+  {"name": "api", "relations": {"get": {}, "post": {}}},
+  {"name": "org_unit", "relations": {
+    "parent": {},
+    "manager": {"union": [{"this": true}, {"tuple_to_userset": {"tupleset": "parent", "computed_userset": "manager"}}]},
+    "member": {"union": [{"this": true}, {"computed_userset": "manager"}]}
+  }},
+  {"name": "document", "relations": {
+    "owner": {},
+    "banned": {},
+    "editor": {"union": [{"this": true}, {"computed_userset": "owner"}]},
+    "viewer": {"exclusion": {
+      "base": {"computed_userset": "reader"},
+      "subtract": {"computed_userset": "banned"}
+    }},
+    "reader": {"union": [{"this": true}, {"computed_userset": "editor"}]}
+  }}
+]`
+
+func newTestEngine(t *testing.T, opts ...Option) (*Engine, *MemoryStore) {
+	t.Helper()
+	namespaces, err := ParseNamespaces([]byte(testNamespaces))
+	require.NoError(t, err)
+
+	store := NewMemoryStore()
+	engine, err := NewEngine(store, namespaces, opts...)
+	require.NoError(t, err)
+	return engine, store
+}
+
+func mustTuples(t *testing.T, texts ...string) []Tuple {
+	t.Helper()
+	tuples := make([]Tuple, 0, len(texts))
+	for _, s := range texts {
+		tuple, err := ParseTuple(s)
+		require.NoError(t, err, s)
+		tuples = append(tuples, tuple)
+	}
+	return tuples
+}
+
+func mustCheck(t *testing.T, e *Engine, object, relation, subject string) bool {
+	t.Helper()
+	o, err := ParseObject(object)
+	require.NoError(t, err)
+	s, err := ParseSubject(subject)
+	require.NoError(t, err)
+	allowed, _, err := e.Check(context.Background(), o, relation, s, "")
+	require.NoError(t, err)
+	return allowed
+}
+
+func TestParseTuple(t *testing.T) {
+	tuple, err := ParseTuple("document:42#viewer@org_unit:3#member")
+	require.NoError(t, err)
+	assert.Equal(t, Object{Namespace: "document", ID: "42"}, tuple.Object)
+	assert.Equal(t, "viewer", tuple.Relation)
+	assert.Equal(t, Subject{Namespace: "org_unit", ID: "3", Relation: "member"}, tuple.Subject)
+	assert.Equal(t, "document:42#viewer@org_unit:3#member", tuple.String())
+
+	tuple, err = ParseTuple("api:/admin/v1/users/{id}#get@role:admin#member")
+	require.NoError(t, err)
+	assert.Equal(t, "/admin/v1/users/{id}", tuple.Object.ID)
+
+	for _, s := range []string{"", "document:1#owner", "document#owner@user:1", "Doc:1#owner@user:1", "document:1#owner@user"} {
+		_, err = ParseTuple(s)
+		assert.Error(t, err, s)
+	}
+}
+
+func TestParseNamespacesValidation(t *testing.T) {
+	_, err := ParseNamespaces([]byte(`[{"name": "doc", "relations": {"viewer": {"union": [{"computed_userset": "owner"}]}}}]`))
+	assert.Error(t, err)
+
+	_, err = ParseNamespaces([]byte(`[{"name": "doc"}, {"name": "doc"}]`))
+	assert.Error(t, err)
+
+	_, err = ParseNamespaces([]byte(`[{"name": "doc", "relations": {"owner": {}, "viewer": {"union": [{"this": true, "computed_userset": "owner"}]}}}]`))
+	assert.Error(t, err)
+}
+
+func TestEngineCheckComputedUsersets(t *testing.T) {
+	e, _ := newTestEngine(t)
+	ctx := context.Background()
+
+	_, err := e.Write(ctx, mustTuples(t,
+		"document:1#owner@user:1",
+		"document:1#reader@org_unit:20#member",
+		"document:1#banned@user:4",
+		"org_unit:20#parent@org_unit:10",
+		"org_unit:10#manager@user:2",
+		"org_unit:20#member@user:3",
+		"org_unit:20#member@user:4",
+	), nil)
+	require.NoError(t, err)
+
+	// owner 即 editor
+	assert.True(t, mustCheck(t, e, "document:1", "editor", "user:1"))
+	assert.True(t, mustCheck(t, e, "document:1", "viewer", "user:1"))
+	assert.False(t, mustCheck(t, e, "document:1", "editor", "user:3"))
+
+	// 上级组织单元的 manager 继承为下级的 manager，进而是成员
+	assert.True(t, mustCheck(t, e, "org_unit:20", "manager", "user:2"))
+	assert.False(t, mustCheck(t, e, "org_unit:10", "member", "user:3"))
+	assert.True(t, mustCheck(t, e, "document:1", "viewer", "user:2"))
+	assert.True(t, mustCheck(t, e, "document:1", "viewer", "user:3"))
+
+	// 差集：被禁用的成员不能查看
+	assert.True(t, mustCheck(t, e, "document:1", "reader", "user:4"))
+	assert.False(t, mustCheck(t, e, "document:1", "viewer", "user:4"))
+
+	// 用户集主体
+	assert.True(t, mustCheck(t, e, "document:1", "viewer", "org_unit:20#member"))
+
+	o, _ := ParseObject("document:1")
+	_, _, err = e.Check(ctx, o, "undefined", Subject{Namespace: "user", ID: "1"}, "")
+	assert.ErrorIs(t, err, ErrUnknownRelation)
+}
+
+func TestEngineCheckCycle(t *testing.T) {
+	e, _ := newTestEngine(t)
+	ctx := context.Background()
+
+	_, err := e.Write(ctx, mustTuples(t,
+		"org_unit:1#parent@org_unit:2",
+		"org_unit:2#parent@org_unit:1",
+		"org_unit:2#manager@user:9",
+	), nil)
+	require.NoError(t, err)
+
+	assert.True(t, mustCheck(t, e, "org_unit:1", "manager", "user:9"))
+	assert.False(t, mustCheck(t, e, "org_unit:1", "manager", "user:8"))
+}
+
+func TestEngineWriteValidation(t *testing.T) {
+	e, _ := newTestEngine(t)
+	ctx := context.Background()
+
+	// computed 关系不接受直接元组
+	_, err := e.Write(ctx, mustTuples(t, "document:1#viewer@user:1"), nil)
+	assert.Error(t, err)
+	// 未声明的主体命名空间
+	_, err = e.Write(ctx, mustTuples(t, "document:1#owner@group:1"), nil)
+	assert.ErrorIs(t, err, ErrUnknownNamespace)
+	// 未声明的主体关系
+	_, err = e.Write(ctx, mustTuples(t, "document:1#owner@org_unit:1#owner"), nil)
+	assert.ErrorIs(t, err, ErrUnknownRelation)
+}
+
+func TestEngineConsistencyToken(t *testing.T) {
+	e, store := newTestEngine(t)
+	ctx := context.Background()
+	o := Object{Namespace: "document", ID: "1"}
+	alice := Subject{Namespace: "user", ID: "1"}
+
+	allowed, _, err := e.Check(ctx, o, "owner", alice, "")
+	require.NoError(t, err)
+	assert.False(t, allowed)
+
+	// 绕过引擎直接写存储（模拟其他实例写入）：无令牌的 Check 命中缓存，携带写入令牌的 Check 看到写入
+	rev, err := store.WriteTuples(ctx, mustTuples(t, "document:1#owner@user:1"), nil)
+	require.NoError(t, err)
+	token := EncodeToken(rev)
+
+	allowed, _, err = e.Check(ctx, o, "owner", alice, "")
+	require.NoError(t, err)
+	assert.False(t, allowed)
+
+	allowed, got, err := e.Check(ctx, o, "owner", alice, token)
+	require.NoError(t, err)
+	assert.True(t, allowed)
+	assert.Equal(t, token, got)
+
+	// 经引擎写入后本实例缓存立即失效
+	token, err = e.Write(ctx, nil, mustTuples(t, "document:1#owner@user:1"))
+	require.NoError(t, err)
+	allowed, _, err = e.Check(ctx, o, "owner", alice, "")
+	require.NoError(t, err)
+	assert.False(t, allowed)
+
+	rev, err = DecodeToken(token)
+	require.NoError(t, err)
+	_, _, err = e.Check(ctx, o, "owner", alice, EncodeToken(rev+1))
+	assert.ErrorIs(t, err, ErrRevisionUnavailable)
+
+	_, _, err = e.Check(ctx, o, "owner", alice, "garbage")
+	assert.ErrorIs(t, err, ErrInvalidToken)
+}
+
+func TestEngineExpand(t *testing.T) {
+	e, _ := newTestEngine(t)
+	ctx := context.Background()
+
+	_, err := e.Write(ctx, mustTuples(t,
+		"document:1#owner@user:1",
+		"document:1#editor@org_unit:20#member",
+		"org_unit:20#member@user:3",
+	), nil)
+	require.NoError(t, err)
+
+	tree, token, err := e.Expand(ctx, Object{Namespace: "document", ID: "1"}, "editor", "")
+	require.NoError(t, err)
+	assert.NotEmpty(t, token)
+	assert.Equal(t, OperationUnion, tree.Operation)
+	require.Len(t, tree.Children, 2)
+
+	direct := tree.Children[0]
+	assert.Equal(t, OperationThis, direct.Operation)
+	assert.Equal(t, []Subject{{Namespace: "org_unit", ID: "20", Relation: "member"}}, direct.Subjects)
+	require.Len(t, direct.Children, 1)
+
+	owner := tree.Children[1]
+	assert.Equal(t, "owner", owner.Relation)
+	require.Len(t, owner.Children, 1)
+	assert.Equal(t, []Subject{{Namespace: "user", ID: "1"}}, owner.Children[0].Subjects)
+}
+
+func TestEngineListObjects(t *testing.T) {
+	e, _ := newTestEngine(t)
+	ctx := context.Background()
+
+	_, err := e.Write(ctx, mustTuples(t,
+		"org_unit:20#parent@org_unit:10",
+		"org_unit:30#parent@org_unit:20",
+		"org_unit:40#parent@org_unit:99",
+		"org_unit:10#manager@user:2",
+	), nil)
+	require.NoError(t, err)
+
+	ids, _, err := e.ListObjects(ctx, "org_unit", "manager", Subject{Namespace: "user", ID: "2"}, "")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"10", "20", "30"}, ids)
+}
+
+func TestEngineAuthorizer(t *testing.T) {
+	e, _ := newTestEngine(t)
+	ctx := context.Background()
+
+	err := e.SetPolicies(ctx, map[string]interface{}{
+		PolicyTuples: []Tuple{
+			APITuple("admin", "/admin/v1/users", "GET"),
+			APITuple("admin", "/admin/v1/users", "POST"),
+			APITuple("auditor", "/admin/v1/users", "GET"),
+		},
+	}, nil)
+	require.NoError(t, err)
+
+	allowed, err := e.IsAuthorized(ctx, "auditor", "GET", "/admin/v1/users", "")
+	require.NoError(t, err)
+	assert.True(t, allowed)
+
+	allowed, err = e.IsAuthorized(ctx, "auditor", "POST", "/admin/v1/users", "")
+	require.NoError(t, err)
+	assert.False(t, allowed)
+
+	// 未声明的方法与未知角色不报错，按无权限处理
+	allowed, err = e.IsAuthorized(ctx, "admin", "PATCH", "/admin/v1/users", "")
+	require.NoError(t, err)
+	assert.False(t, allowed)
+
+	// 角色继承：editor 角色的成员都是 auditor 的成员
+	_, err = e.Write(ctx, mustTuples(t, "role:auditor#member@role:editor#member"), nil)
+	require.NoError(t, err)
+	allowed, err = e.IsAuthorized(ctx, "editor", "GET", "/admin/v1/users", "")
+	require.NoError(t, err)
+	assert.True(t, allowed)
+
+	// 重建只替换 api 命名空间
+	err = e.SetPolicies(ctx, map[string]interface{}{
+		PolicyTuples: []Tuple{APITuple("admin", "/admin/v1/users", "GET")},
+	}, nil)
+	require.NoError(t, err)
+	allowed, err = e.IsAuthorized(ctx, "auditor", "GET", "/admin/v1/users", "")
+	require.NoError(t, err)
+	assert.False(t, allowed)
+	tuples, err := e.ReadTuples(ctx, TupleFilter{Namespace: RoleNamespace})
+	require.NoError(t, err)
+	assert.Len(t, tuples, 1)
+
+	pairs, err := e.FilterAuthorizedPairs(ctx, authzEngine.Subjects{"auditor", "admin"}, authzEngine.Pairs{
+		authzEngine.MakePair("/admin/v1/users", "GET"),
+		authzEngine.MakePair("/admin/v1/users", "POST"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, authzEngine.Pairs{authzEngine.MakePair("/admin/v1/users", "GET")}, pairs)
+}
@@ -0,0 +1,114 @@
+package zanzibar
+
+import (
+	"context"
+	"sort"
+	"sync"
+)
+
+var _ Store = (*MemoryStore)(nil)
+
+// MemoryStore 进程内元组存储，用于测试与单机演示
+type MemoryStore struct {
+	mu       sync.RWMutex
+	tuples   map[Tuple]struct{}
+	revision Revision
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{tuples: make(map[Tuple]struct{})}
+}
+
+func (s *MemoryStore) ReadTuples(_ context.Context, filter TupleFilter) ([]Tuple, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var result []Tuple
+	for t := range s.tuples {
+		if filter.match(t) {
+			result = append(result, t)
+		}
+	}
+	sortTuples(result)
+	return result, nil
+}
+
+func (s *MemoryStore) ListObjectIDs(_ context.Context, namespace string) ([]string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	seen := make(map[string]struct{})
+	for t := range s.tuples {
+		if t.Object.Namespace == namespace {
+			seen[t.Object.ID] = struct{}{}
+		}
+		if t.Subject.Namespace == namespace {
+			seen[t.Subject.ID] = struct{}{}
+		}
+	}
+
+	ids := make([]string, 0, len(seen))
+	for id := range seen {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids, nil
+}
+
+func (s *MemoryStore) WriteTuples(_ context.Context, writes, deletes []Tuple) (Revision, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, t := range deletes {
+		delete(s.tuples, t)
+	}
+	for _, t := range writes {
+		s.tuples[t] = struct{}{}
+	}
+	s.revision++
+	return s.revision, nil
+}
+
+func (s *MemoryStore) ReplaceNamespace(_ context.Context, namespace string, tuples []Tuple) (Revision, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for t := range s.tuples {
+		if t.Object.Namespace == namespace {
+			delete(s.tuples, t)
+		}
+	}
+	for _, t := range tuples {
+		s.tuples[t] = struct{}{}
+	}
+	s.revision++
+	return s.revision, nil
+}
+
+func (s *MemoryStore) Revision(_ context.Context) (Revision, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.revision, nil
+}
+
+func (f TupleFilter) match(t Tuple) bool {
+	if f.Namespace != "" && t.Object.Namespace != f.Namespace {
+		return false
+	}
+	if f.ObjectID != "" && t.Object.ID != f.ObjectID {
+		return false
+	}
+	if f.Relation != "" && t.Relation != f.Relation {
+		return false
+	}
+	if f.Subject != nil && t.Subject != *f.Subject {
+		return false
+	}
+	return true
+}
+
+func sortTuples(tuples []Tuple) {
+	sort.Slice(tuples, func(i, j int) bool {
+		return tuples[i].String() < tuples[j].String()
+	})
+}
@@ -0,0 +1,176 @@
+package zanzibar
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Namespace 命名空间配置：声明对象类型上的关系及其用户集改写规则
+type Namespace struct {
+	Name      string               `json:"name"`
+	Relations map[string]*Relation `json:"relations,omitempty"`
+}
+
+// Relation 关系的用户集改写规则。三者均为空时等价于只包含直接元组（this）。
+//   - union：任一用户集包含主体即成立；
+//   - intersection：全部用户集包含主体才成立；
+//   - exclusion：base 包含且 subtract 不包含主体时成立。
+type Relation struct {
+	Union        []*Userset `json:"union,omitempty"`
+	Intersection []*Userset `json:"intersection,omitempty"`
+	Exclusion    *Exclusion `json:"exclusion,omitempty"`
+}
+
+// Userset 用户集，三者只能设置其一
+type Userset struct {
+	// This 直接写在本对象本关系上的元组
+	This bool `json:"this,omitempty"`
+	// ComputedUserset 本对象另一关系的用户集，如文档的 editor 包含 owner
+	ComputedUserset string `json:"computed_userset,omitempty"`
+	// TupleToUserset 沿本对象某关系指向的对象取其关系的用户集，如组织单元继承上级单元的 manager
+	TupleToUserset *TupleToUserset `json:"tuple_to_userset,omitempty"`
+}
+
+// TupleToUserset 读取本对象 tupleset 关系上的元组，取元组主体对象上 computed_userset 关系的用户集
+type TupleToUserset struct {
+	Tupleset        string `json:"tupleset"`
+	ComputedUserset string `json:"computed_userset"`
+}
+
+// Exclusion 差集
+type Exclusion struct {
+	Base     *Userset `json:"base"`
+	Subtract *Userset `json:"subtract"`
+}
+
+var thisUserset = &Userset{This: true}
+
+// usersets 返回改写规则的运算名与参与运算的用户集
+func (r *Relation) usersets() (string, []*Userset) {
+	switch {
+	case r == nil:
+		return OperationUnion, []*Userset{thisUserset}
+	case len(r.Union) > 0:
+		return OperationUnion, r.Union
+	case len(r.Intersection) > 0:
+		return OperationIntersection, r.Intersection
+	case r.Exclusion != nil:
+		return OperationExclusion, []*Userset{r.Exclusion.Base, r.Exclusion.Subtract}
+	default:
+		return OperationUnion, []*Userset{thisUserset}
+	}
+}
+
+// allowsDirect 关系是否接受直接写入的元组
+func (r *Relation) allowsDirect() bool {
+	_, usersets := r.usersets()
+	for _, u := range usersets {
+		if u != nil && u.This {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseNamespaces 解析 JSON 数组形式的命名空间配置并校验
+func ParseNamespaces(data []byte) ([]*Namespace, error) {
+	var namespaces []*Namespace
+	if err := json.Unmarshal(data, &namespaces); err != nil {
+		return nil, fmt.Errorf("invalid namespace config: %w", err)
+	}
+	if _, err := indexNamespaces(namespaces); err != nil {
+		return nil, err
+	}
+	return namespaces, nil
+}
+
+func indexNamespaces(namespaces []*Namespace) (map[string]*Namespace, error) {
+	index := make(map[string]*Namespace, len(namespaces))
+	for _, ns := range namespaces {
+		if ns == nil {
+			continue
+		}
+		if !nameRegexp.MatchString(ns.Name) {
+			return nil, fmt.Errorf("invalid namespace name %q", ns.Name)
+		}
+		if _, ok := index[ns.Name]; ok {
+			return nil, fmt.Errorf("duplicate namespace %q", ns.Name)
+		}
+		index[ns.Name] = ns
+	}
+
+	for _, ns := range index {
+		for name, rel := range ns.Relations {
+			if !nameRegexp.MatchString(name) {
+				return nil, fmt.Errorf("namespace %q: invalid relation name %q", ns.Name, name)
+			}
+			if err := validateRelation(ns, rel); err != nil {
+				return nil, fmt.Errorf("namespace %q relation %q: %w", ns.Name, name, err)
+			}
+		}
+	}
+
+	return index, nil
+}
+
+func validateRelation(ns *Namespace, rel *Relation) error {
+	if rel == nil {
+		return nil
+	}
+
+	set := 0
+	if len(rel.Union) > 0 {
+		set++
+	}
+	if len(rel.Intersection) > 0 {
+		set++
+	}
+	if rel.Exclusion != nil {
+		set++
+		if rel.Exclusion.Base == nil || rel.Exclusion.Subtract == nil {
+			return fmt.Errorf("exclusion requires base and subtract")
+		}
+	}
+	if set > 1 {
+		return fmt.Errorf("only one of union, intersection and exclusion can be set")
+	}
+
+	_, usersets := rel.usersets()
+	for _, u := range usersets {
+		if err := validateUserset(ns, u); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateUserset(ns *Namespace, u *Userset) error {
+	if u == nil {
+		return fmt.Errorf("empty userset")
+	}
+
+	set := 0
+	if u.This {
+		set++
+	}
+	if u.ComputedUserset != "" {
+		set++
+		if _, ok := ns.Relations[u.ComputedUserset]; !ok {
+			return fmt.Errorf("computed userset refers to undefined relation %q", u.ComputedUserset)
+		}
+	}
+	if u.TupleToUserset != nil {
+		set++
+		if _, ok := ns.Relations[u.TupleToUserset.Tupleset]; !ok {
+			return fmt.Errorf("tupleset refers to undefined relation %q", u.TupleToUserset.Tupleset)
+		}
+		// computed_userset 属于 tupleset 主体所在的命名空间，判定时再解析
+		if !nameRegexp.MatchString(u.TupleToUserset.ComputedUserset) {
+			return fmt.Errorf("invalid tuple_to_userset computed relation %q", u.TupleToUserset.ComputedUserset)
+		}
+	}
+	if set != 1 {
+		return fmt.Errorf("userset must set exactly one of this, computed_userset and tuple_to_userset")
+	}
+	return nil
+}
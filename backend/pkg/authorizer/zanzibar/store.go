@@ -0,0 +1,71 @@
+package zanzibar
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Revision 元组存储的版本号，每次写入单调递增
+type Revision uint64
+
+// TupleFilter 元组查询条件，空字段不参与过滤
+type TupleFilter struct {
+	Namespace string
+	ObjectID  string
+	Relation  string
+	Subject   *Subject
+}
+
+// Store 关系元组存储
+type Store interface {
+	// ReadTuples 按条件读取元组
+	ReadTuples(ctx context.Context, filter TupleFilter) ([]Tuple, error)
+
+	// ListObjectIDs 列出命名空间下出现过的对象ID（作为对象或主体）
+	ListObjectIDs(ctx context.Context, namespace string) ([]string, error)
+
+	// WriteTuples 在同一事务内写入与删除元组，返回写入后的版本号。写入已存在或删除不存在的元组不报错。
+	WriteTuples(ctx context.Context, writes, deletes []Tuple) (Revision, error)
+
+	// ReplaceNamespace 以给定元组整体替换命名空间下的元组，返回写入后的版本号
+	ReplaceNamespace(ctx context.Context, namespace string, tuples []Tuple) (Revision, error)
+
+	// Revision 当前版本号
+	Revision(ctx context.Context) (Revision, error)
+}
+
+const tokenPrefix = "zk1."
+
+var ErrInvalidToken = errors.New("invalid consistency token")
+
+// EncodeToken 将版本号编码为不透明的一致性令牌（zookie）
+func EncodeToken(rev Revision) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(tokenPrefix + strconv.FormatUint(uint64(rev), 10)))
+}
+
+// DecodeToken 解析一致性令牌，空令牌返回 0（不要求一致性）
+func DecodeToken(token string) (Revision, error) {
+	if token == "" {
+		return 0, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, ErrInvalidToken
+	}
+
+	v, ok := strings.CutPrefix(string(raw), tokenPrefix)
+	if !ok {
+		return 0, ErrInvalidToken
+	}
+
+	rev, err := strconv.ParseUint(v, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %s", ErrInvalidToken, err.Error())
+	}
+	return Revision(rev), nil
+}
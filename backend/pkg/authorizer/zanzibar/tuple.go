@@ -0,0 +1,145 @@
+package zanzibar
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var nameRegexp = regexp.MustCompile(`^[a-z][a-z0-9_]{0,63}$`)
+
+// Object 对象，文本形式为 namespace:id，如 document:42
+type Object struct {
+	Namespace string
+	ID        string
+}
+
+func (o Object) String() string {
+	return o.Namespace + ":" + o.ID
+}
+
+// Subject 主体：具体对象（user:5）或用户集（org_unit:3#member，即某对象某关系下的全部主体）
+type Subject struct {
+	Namespace string
+	ID        string
+	Relation  string
+}
+
+// Object 主体对应的对象
+func (s Subject) Object() Object {
+	return Object{Namespace: s.Namespace, ID: s.ID}
+}
+
+// IsUserset 是否为用户集
+func (s Subject) IsUserset() bool {
+	return s.Relation != ""
+}
+
+func (s Subject) String() string {
+	if s.Relation == "" {
+		return s.Namespace + ":" + s.ID
+	}
+	return s.Namespace + ":" + s.ID + "#" + s.Relation
+}
+
+// Tuple 关系元组，文本形式为 object#relation@subject，如 document:42#owner@user:5
+type Tuple struct {
+	Object   Object
+	Relation string
+	Subject  Subject
+}
+
+func (t Tuple) String() string {
+	return t.Object.String() + "#" + t.Relation + "@" + t.Subject.String()
+}
+
+// ParseObject 解析 namespace:id
+func ParseObject(s string) (Object, error) {
+	ns, id, ok := strings.Cut(s, ":")
+	if !ok {
+		return Object{}, fmt.Errorf("invalid object %q: want namespace:id", s)
+	}
+	o := Object{Namespace: ns, ID: id}
+	if err := o.validate(); err != nil {
+		return Object{}, err
+	}
+	return o, nil
+}
+
+// ParseSubject 解析 namespace:id 或 namespace:id#relation
+func ParseSubject(s string) (Subject, error) {
+	ref, relation, _ := cutLast(s, "#")
+	o, err := ParseObject(ref)
+	if err != nil {
+		return Subject{}, fmt.Errorf("invalid subject %q: %w", s, err)
+	}
+	sub := Subject{Namespace: o.Namespace, ID: o.ID, Relation: relation}
+	if err = sub.validate(); err != nil {
+		return Subject{}, err
+	}
+	return sub, nil
+}
+
+// ParseTuple 解析 namespace:id#relation@subject
+func ParseTuple(s string) (Tuple, error) {
+	objectPart, subjectPart, ok := strings.Cut(s, "@")
+	if !ok {
+		return Tuple{}, fmt.Errorf("invalid tuple %q: want object#relation@subject", s)
+	}
+	ref, relation, ok := cutLast(objectPart, "#")
+	if !ok {
+		return Tuple{}, fmt.Errorf("invalid tuple %q: missing relation", s)
+	}
+
+	o, err := ParseObject(ref)
+	if err != nil {
+		return Tuple{}, err
+	}
+	sub, err := ParseSubject(subjectPart)
+	if err != nil {
+		return Tuple{}, err
+	}
+
+	t := Tuple{Object: o, Relation: relation, Subject: sub}
+	if err = t.validate(); err != nil {
+		return Tuple{}, err
+	}
+	return t, nil
+}
+
+func (o Object) validate() error {
+	if !nameRegexp.MatchString(o.Namespace) {
+		return fmt.Errorf("invalid namespace %q", o.Namespace)
+	}
+	if o.ID == "" || len(o.ID) > 255 || strings.ContainsAny(o.ID, "#@") {
+		return fmt.Errorf("invalid object id %q", o.ID)
+	}
+	return nil
+}
+
+func (s Subject) validate() error {
+	if err := s.Object().validate(); err != nil {
+		return err
+	}
+	if s.Relation != "" && !nameRegexp.MatchString(s.Relation) {
+		return fmt.Errorf("invalid relation %q", s.Relation)
+	}
+	return nil
+}
+
+func (t Tuple) validate() error {
+	if err := t.Object.validate(); err != nil {
+		return err
+	}
+	if !nameRegexp.MatchString(t.Relation) {
+		return fmt.Errorf("invalid relation %q", t.Relation)
+	}
+	return t.Subject.validate()
+}
+
+func cutLast(s, sep string) (before, after string, found bool) {
+	if i := strings.LastIndex(s, sep); i >= 0 {
+		return s[:i], s[i+len(sep):], true
+	}
+	return s, "", false
+}
@@ -164,6 +164,102 @@
 		Code:        trans.Ptr(SystemAuditLogsPermissionCode),
 		Status:      trans.Ptr(permissionV1.Permission_ON),
 	},
+	{
+		//Id:          trans.Ptr(uint32(6)),
+		GroupId:     trans.Ptr(uint32(2)),
+		Name:        trans.Ptr("查看用户手机号"),
+		Description: trans.Ptr("允许查看用户手机号明文，否则显示脱敏值"),
+		Code:        trans.Ptr(UserMobileViewPermissionCode),
+		Status:      trans.Ptr(permissionV1.Permission_ON),
+	},
+	{
+		//Id:          trans.Ptr(uint32(7)),
+		GroupId:     trans.Ptr(uint32(2)),
+		Name:        trans.Ptr("查看用户邮箱"),
+		Description: trans.Ptr("允许查看用户邮箱明文，否则显示脱敏值"),
+		Code:        trans.Ptr(UserEmailViewPermissionCode),
+		Status:      trans.Ptr(permissionV1.Permission_ON),
+	},
+	{
+		//Id:          trans.Ptr(uint32(8)),
+		GroupId:     trans.Ptr(uint32(2)),
+		Name:        trans.Ptr("查看用户真实姓名"),
+		Description: trans.Ptr("允许查看用户真实姓名明文，否则显示脱敏值"),
+		Code:        trans.Ptr(UserRealnameViewPermissionCode),
+		Status:      trans.Ptr(permissionV1.Permission_ON),
+	},
+	{
+		//Id:          trans.Ptr(uint32(9)),
+		GroupId:     trans.Ptr(uint32(2)),
+		Name:        trans.Ptr("修改用户状态"),
+		Description: trans.Ptr("允许启用/禁用用户"),
+		Code:        trans.Ptr(UserStatusEditPermissionCode),
+		Status:      trans.Ptr(permissionV1.Permission_ON),
+	},
+	{
+		//Id:          trans.Ptr(uint32(10)),
+		GroupId:     trans.Ptr(uint32(2)),
+		Name:        trans.Ptr("修改用户锁定时间"),
+		Description: trans.Ptr("允许锁定/解锁用户"),
+		Code:        trans.Ptr(UserLockedUntilEditPermissionCode),
+		Status:      trans.Ptr(permissionV1.Permission_ON),
+	},
+	{
+		//Id:          trans.Ptr(uint32(11)),
+		GroupId:     trans.Ptr(uint32(2)),
+		Name:        trans.Ptr("修改角色数据权限"),
+		Description: trans.Ptr("允许修改角色的数据权限范围"),
+		Code:        trans.Ptr(RoleDataScopeEditPermissionCode),
+		Status:      trans.Ptr(permissionV1.Permission_ON),
+	},
+	{
+		//Id:          trans.Ptr(uint32(12)),
+		GroupId:     trans.Ptr(uint32(2)),
+		Name:        trans.Ptr("修改角色自定义数据权限"),
+		Description: trans.Ptr("允许修改角色自定义数据权限的组织单元"),
+		Code:        trans.Ptr(RoleDataScopeOrgUnitsEditPermissionCode),
+		Status:      trans.Ptr(permissionV1.Permission_ON),
+	},
+	{
+		//Id:          trans.Ptr(uint32(13)),
+		GroupId:     trans.Ptr(uint32(2)),
+		Name:        trans.Ptr("修改组织单元负责人"),
+		Description: trans.Ptr("允许修改组织单元的负责人"),
+		Code:        trans.Ptr(OrgUnitLeaderEditPermissionCode),
+		Status:      trans.Ptr(permissionV1.Permission_ON),
+	},
+	{
+		//Id:          trans.Ptr(uint32(14)),
+		GroupId:     trans.Ptr(uint32(3)),
+		Name:        trans.Ptr("管理用户"),
+		Description: trans.Ptr("允许在租户内创建、修改、删除用户"),
+		Code:        trans.Ptr(UserManagePermissionCode),
+		Status:      trans.Ptr(permissionV1.Permission_ON),
+	},
+	{
+		//Id:          trans.Ptr(uint32(15)),
+		GroupId:     trans.Ptr(uint32(3)),
+		Name:        trans.Ptr("管理角色"),
+		Description: trans.Ptr("允许在租户内创建、修改、删除角色"),
+		Code:        trans.Ptr(RoleManagePermissionCode),
+		Status:      trans.Ptr(permissionV1.Permission_ON),
+	},
+	{
+		//Id:          trans.Ptr(uint32(16)),
+		GroupId:     trans.Ptr(uint32(3)),
+		Name:        trans.Ptr("管理组织单元"),
+		Description: trans.Ptr("允许在租户内创建、修改、删除组织单元"),
+		Code:        trans.Ptr(OrgUnitManagePermissionCode),
+		Status:      trans.Ptr(permissionV1.Permission_ON),
+	},
+	{
+		//Id:          trans.Ptr(uint32(17)),
+		GroupId:     trans.Ptr(uint32(3)),
+		Name:        trans.Ptr("管理职位"),
+		Description: trans.Ptr("允许在租户内创建、修改、删除职位"),
+		Code:        trans.Ptr(PositionManagePermissionCode),
+		Status:      trans.Ptr(permissionV1.Permission_ON),
+	},
 }
 
 // DefaultRoles 系统初始化默认角色数据
@@ -177,7 +273,7 @@
 		IsProtected: trans.Ptr(true),
 		Type:        trans.Ptr(permissionV1.Role_SYSTEM),
 		SortOrder:   trans.Ptr(uint32(1)),
-		Permissions: []uint32{1, 2, 4},
+		Permissions: []uint32{1, 2, 4, 9, 10, 11, 12, 13},
 	},
 	{
 		//Id:          trans.Ptr(uint32(2)),
@@ -188,7 +284,7 @@
 		IsProtected: trans.Ptr(true),
 		Type:        trans.Ptr(permissionV1.Role_TEMPLATE),
 		SortOrder:   trans.Ptr(uint32(2)),
-		Permissions: []uint32{1, 3},
+		Permissions: []uint32{1, 3, 9, 10, 11, 12, 13, 14, 15, 16, 17},
 	},
 }
 
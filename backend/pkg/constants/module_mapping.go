@@ -30,6 +30,12 @@
 	"FileTransferService":            identityV1.Module_FILE,
 	"TaskService":                    identityV1.Module_TASK,
 	"LoginPolicyService":             identityV1.Module_SYSTEM,
+	"ApiClientService":               identityV1.Module_SYSTEM,
+	"JwtSigningKeyService":           identityV1.Module_SYSTEM,
+	"OAuthProviderConfigService":     identityV1.Module_SYSTEM,
+	"SamlConfigService":              identityV1.Module_SYSTEM,
+	"LdapConfigService":              identityV1.Module_SYSTEM,
+	"ScimTokenService":               identityV1.Module_SYSTEM,
 
 	"TenantService":                  identityV1.Module_TENANT,
 	"PlanService":                    identityV1.Module_TENANT,
@@ -48,4 +54,8 @@
 	"InternalMessageRecipientService": identityV1.Module_INTERNAL_MESSAGE,
 
 	"AuthenticationService":          identityV1.Module_DASHBOARD,
+	"OAuthServerService":             identityV1.Module_DASHBOARD,
+	"OidcService":                    identityV1.Module_DASHBOARD,
+	"OAuthService":                   identityV1.Module_DASHBOARD,
+	"SamlService":                    identityV1.Module_DASHBOARD,
 }
@@ -18,6 +18,32 @@
 	// SystemTenantManagerPermissionCode 系统租户管理员权限代码
 	SystemTenantManagerPermissionCode = SystemPermissionCodePrefix + "tenant_manager"
 
+	// UserMobileViewPermissionCode 查看用户手机号明文的字段权限代码
+	UserMobileViewPermissionCode = "user:mobile:view"
+	// UserEmailViewPermissionCode 查看用户邮箱明文的字段权限代码
+	UserEmailViewPermissionCode = "user:email:view"
+	// UserRealnameViewPermissionCode 查看用户真实姓名明文的字段权限代码
+	UserRealnameViewPermissionCode = "user:realname:view"
+	// UserStatusEditPermissionCode 修改用户状态的字段权限代码
+	UserStatusEditPermissionCode = "user:status:edit"
+	// UserLockedUntilEditPermissionCode 修改用户锁定截止时间的字段权限代码
+	UserLockedUntilEditPermissionCode = "user:locked-until:edit"
+	// RoleDataScopeEditPermissionCode 修改角色数据权限范围的字段权限代码
+	RoleDataScopeEditPermissionCode = "role:data-scope:edit"
+	// RoleDataScopeOrgUnitsEditPermissionCode 修改角色自定义数据权限组织单元的字段权限代码
+	RoleDataScopeOrgUnitsEditPermissionCode = "role:data-scope-org-unit-ids:edit"
+	// OrgUnitLeaderEditPermissionCode 修改组织单元负责人的字段权限代码
+	OrgUnitLeaderEditPermissionCode = "org-unit:leader-id:edit"
+
+	// UserManagePermissionCode 租户内用户增删改的动作权限代码
+	UserManagePermissionCode = "user:*"
+	// RoleManagePermissionCode 租户内角色增删改的动作权限代码
+	RoleManagePermissionCode = "role:*"
+	// OrgUnitManagePermissionCode 租户内组织单元增删改的动作权限代码
+	OrgUnitManagePermissionCode = "org-unit:*"
+	// PositionManagePermissionCode 租户内职位增删改的动作权限代码
+	PositionManagePermissionCode = "position:*"
+
 	// SystemPermissionModule 系统权限模块标识
 	SystemPermissionModule = "sys"
 
@@ -35,4 +61,48 @@
 	SystemAuditLogsPermissionCode,
 	SystemPlatformAdminPermissionCode,
 	SystemTenantManagerPermissionCode,
+	UserMobileViewPermissionCode,
+	UserEmailViewPermissionCode,
+	UserRealnameViewPermissionCode,
+	UserStatusEditPermissionCode,
+	UserLockedUntilEditPermissionCode,
+	RoleDataScopeEditPermissionCode,
+	RoleDataScopeOrgUnitsEditPermissionCode,
+	OrgUnitLeaderEditPermissionCode,
+	UserManagePermissionCode,
+	RoleManagePermissionCode,
+	OrgUnitManagePermissionCode,
+	PositionManagePermissionCode,
+}
+
+// FieldPermissionCodes 字段级读写权限代码，由字段权限中间件校验。
+// 不以系统权限前缀开头，同步权限时需保留；启动时补齐缺失的权限
+var FieldPermissionCodes = []string{
+	UserMobileViewPermissionCode,
+	UserEmailViewPermissionCode,
+	UserRealnameViewPermissionCode,
+	UserStatusEditPermissionCode,
+	UserLockedUntilEditPermissionCode,
+	RoleDataScopeEditPermissionCode,
+	RoleDataScopeOrgUnitsEditPermissionCode,
+	OrgUnitLeaderEditPermissionCode,
+}
+
+// AdminFieldPermissionCodes 授予平台管理员与租户管理员角色的字段权限代码。
+// 仅含写入权限，保持管理员升级前可修改的字段；查看明文的权限须按角色显式授予
+var AdminFieldPermissionCodes = []string{
+	UserStatusEditPermissionCode,
+	UserLockedUntilEditPermissionCode,
+	RoleDataScopeEditPermissionCode,
+	RoleDataScopeOrgUnitsEditPermissionCode,
+	OrgUnitLeaderEditPermissionCode,
+}
+
+// ActionPermissionCodes 租户管理员的动作权限代码，由 ent 动作级权限规则校验（user:* 覆盖 user:create/edit/delete）。
+// 不依赖接口关联推导，同步权限时需保留；启动时补齐缺失的权限并授予租户管理员角色
+var ActionPermissionCodes = []string{
+	UserManagePermissionCode,
+	RoleManagePermissionCode,
+	OrgUnitManagePermissionCode,
+	PositionManagePermissionCode,
 }
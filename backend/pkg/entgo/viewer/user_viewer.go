@@ -1,9 +1,13 @@
 package viewer
 
 import (
+	"strings"
+
 	identityV1 "go-wind-admin/api/gen/go/identity/service/v1"
 
 	"github.com/tx7do/go-crud/viewer"
+
+	"go-wind-admin/pkg/utils/converter"
 )
 
 // UserViewer describes a user-viewer.
@@ -15,6 +19,8 @@ type UserViewer struct {
 	roles       []string
 	permissions []string
 	traceID     string
+
+	permissionSet map[string]struct{}
 }
 
 func NewUserViewer(
@@ -23,17 +29,38 @@ func NewUserViewer(
 	ouid uint64,
 	traceID string,
 	dataScope identityV1.DataScope,
+	roles []string,
+	permissions []string,
 ) viewer.Context {
 	uv := UserViewer{
-		uid:        uid,
-		tid:        tid,
-		ouid:       ouid,
-		dataScopes: []viewer.DataScope{convertDataScope(dataScope)},
-		traceID:    traceID,
+		uid:         uid,
+		tid:         tid,
+		ouid:        ouid,
+		dataScopes:  []viewer.DataScope{convertDataScope(dataScope, ouid)},
+		roles:       roles,
+		permissions: permissions,
+		traceID:     traceID,
+	}
+	if len(permissions) > 0 {
+		uv.permissionSet = make(map[string]struct{}, len(permissions))
+		for _, code := range permissions {
+			uv.permissionSet[code] = struct{}{}
+		}
 	}
 	return uv
 }
 
+// WithDataScopes 返回替换了数据权限范围的 Viewer 副本（数据层按角色解析出的行过滤范围），
+// 非 UserViewer 原样返回
+func WithDataScopes(vc viewer.Context, scopes []viewer.DataScope) viewer.Context {
+	uv, ok := vc.(UserViewer)
+	if !ok {
+		return vc
+	}
+	uv.dataScopes = scopes
+	return uv
+}
+
 // UserID 返回当前用户ID
 func (v UserViewer) UserID() uint64 {
 	return v.uid
@@ -69,9 +96,25 @@ func (v UserViewer) TraceID() string {
 	return v.traceID
 }
 
-// HasPermission 判断是否具有某个动作/资源的权限（如 "update:user"）
-func (v UserViewer) HasPermission(_, _ string) bool {
-	return false
+// HasPermission 判断是否具有某个动作/资源的权限（如 "update:user"）。
+// 动作与资源按 resource:action 风格归一后匹配权限码（如 ("update", "User") -> user:edit），
+// 亦匹配资源通配 resource:* 与全局通配 *。
+func (v UserViewer) HasPermission(action, resource string) bool {
+	if len(v.permissionSet) == 0 || action == "" || resource == "" {
+		return false
+	}
+
+	code := converter.PermissionCode(action, resource)
+	if _, ok := v.permissionSet[code]; ok {
+		return true
+	}
+
+	res := code[:strings.LastIndexByte(code, ':')]
+	if _, ok := v.permissionSet[res+":*"]; ok {
+		return true
+	}
+	_, ok := v.permissionSet["*"]
+	return ok
 }
 
 // IsPlatformContext 当前是否处于平台管理视图（tenant_id == 0）
@@ -94,13 +137,26 @@ func (v UserViewer) ShouldAudit() bool {
 	return false
 }
 
-func convertDataScope(dataScope identityV1.DataScope) viewer.DataScope {
+// convertDataScope 将令牌中的数据范围转换为行过滤范围。
+// 仅凭令牌无法展开组织树与角色自定义的组织单元：UNIT_AND_CHILD 此处只含当前组织单元，
+// SELECTED_UNITS 不含目标ID（行过滤时按无效范围拒绝），完整范围由数据层按角色解析后经 WithDataScopes 替换。
+func convertDataScope(dataScope identityV1.DataScope, ouid uint64) viewer.DataScope {
 	switch dataScope {
 	case identityV1.DataScope_ALL:
 		return viewer.DataScope{
 			ScopeType: viewer.ScopeTypeAll,
 		}
 	case identityV1.DataScope_UNIT_ONLY, identityV1.DataScope_UNIT_AND_CHILD:
+		if ouid == 0 {
+			return viewer.DataScope{
+				ScopeType: viewer.ScopeTypeNone,
+			}
+		}
+		return viewer.DataScope{
+			ScopeType: viewer.ScopeTypeUnit,
+			TargetIDs: []uint64{ouid},
+		}
+	case identityV1.DataScope_SELECTED_UNITS:
 		return viewer.DataScope{
 			ScopeType: viewer.ScopeTypeUnit,
 		}
@@ -0,0 +1,393 @@
+package jwt
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	jwtV5 "github.com/golang-jwt/jwt/v5"
+
+	authn "github.com/tx7do/kratos-authn/engine"
+)
+
+// HeaderKeyID JWT 头部的密钥 ID 字段
+const HeaderKeyID = "kid"
+
+var (
+	// ErrUnknownKeyID 令牌的 kid 不在密钥环中（密钥已过保留期，或其他实例刚轮换、本实例尚未刷新）
+	ErrUnknownKeyID = errors.New("unknown jwt key id")
+
+	// ErrNoSigningKey 密钥环没有可用于签发的密钥（仅配置了公钥的校验实例）
+	ErrNoSigningKey = errors.New("no jwt signing key")
+)
+
+var _ authn.Authenticator = (*Keyring)(nil)
+
+// SigningKey 密钥环中的一把密钥。
+type SigningKey struct {
+	Kid    string
+	Method string
+
+	signKey   any // 私钥或 HMAC 秘钥；仅用于校验时为 nil
+	verifyKey any // 公钥或 HMAC 秘钥
+
+	// VerifyUntil 校验截止时间：退役密钥在此之前仍接受其签发的令牌，零值表示不限。
+	VerifyUntil time.Time
+}
+
+// NewHMACSigningKey 构造对称签名密钥（HS256/HS384/HS512），签发与校验共用秘钥。
+func NewHMACSigningKey(kid, method string, secret []byte) (*SigningKey, error) {
+	if _, ok := jwtV5.GetSigningMethod(method).(*jwtV5.SigningMethodHMAC); !ok {
+		return nil, fmt.Errorf("method %q is not a HMAC signing method", method)
+	}
+	if len(secret) == 0 {
+		return nil, fmt.Errorf("hmac secret is empty")
+	}
+	return &SigningKey{Kid: kid, Method: method, signKey: secret, verifyKey: secret}, nil
+}
+
+// NewAsymmetricSigningKey 构造非对称签名密钥。priv 为 nil 时只能用于校验；
+// pub 为 nil 时从私钥派生。
+func NewAsymmetricSigningKey(kid, method string, priv crypto.Signer, pub crypto.PublicKey) (*SigningKey, error) {
+	if jwtV5.GetSigningMethod(method) == nil {
+		return nil, fmt.Errorf("unsupported signing method %q", method)
+	}
+	if pub == nil && priv != nil {
+		pub = priv.Public()
+	}
+	if pub == nil {
+		return nil, fmt.Errorf("neither private nor public key provided")
+	}
+
+	k := &SigningKey{Kid: kid, Method: method, verifyKey: pub}
+	if priv != nil {
+		k.signKey = priv
+	}
+	return k, nil
+}
+
+// NewSigningKeyFromMaterial 由持久化的密钥材料还原签名密钥：
+// 对称算法为 base64url 编码的秘钥，非对称算法为 PEM 编码的私钥。
+func NewSigningKeyFromMaterial(kid, method, material string) (*SigningKey, error) {
+	if isHMACMethod(method) {
+		secret, err := base64.RawURLEncoding.DecodeString(material)
+		if err != nil {
+			return nil, fmt.Errorf("decode hmac secret: %w", err)
+		}
+		return NewHMACSigningKey(kid, method, secret)
+	}
+
+	priv, err := ParsePrivateKeyPEM([]byte(material))
+	if err != nil {
+		return nil, err
+	}
+	return NewAsymmetricSigningKey(kid, method, priv, nil)
+}
+
+// GenerateSigningKey 按签名算法生成新密钥，返回密钥及其可持久化的密钥材料。
+func GenerateSigningKey(kid, method string) (*SigningKey, string, error) {
+	if isHMACMethod(method) {
+		secret := make([]byte, hmacSecretSize(method))
+		if _, err := rand.Read(secret); err != nil {
+			return nil, "", fmt.Errorf("generate hmac secret: %w", err)
+		}
+		key, err := NewHMACSigningKey(kid, method, secret)
+		if err != nil {
+			return nil, "", err
+		}
+		return key, base64.RawURLEncoding.EncodeToString(secret), nil
+	}
+
+	var (
+		priv crypto.Signer
+		err  error
+	)
+	switch method {
+	case "RS256", "RS384", "RS512", "PS256", "PS384", "PS512":
+		priv, err = rsa.GenerateKey(rand.Reader, 2048)
+	case "ES256":
+		priv, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	case "ES384":
+		priv, err = ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	case "ES512":
+		priv, err = ecdsa.GenerateKey(elliptic.P521(), rand.Reader)
+	case "EdDSA":
+		_, priv, err = ed25519.GenerateKey(rand.Reader)
+	default:
+		return nil, "", fmt.Errorf("unsupported signing method %q", method)
+	}
+	if err != nil {
+		return nil, "", fmt.Errorf("generate %s key: %w", method, err)
+	}
+
+	der, err := x509.MarshalPKCS8PrivateKey(priv)
+	if err != nil {
+		return nil, "", fmt.Errorf("marshal private key: %w", err)
+	}
+	material := string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+
+	key, err := NewAsymmetricSigningKey(kid, method, priv, nil)
+	if err != nil {
+		return nil, "", err
+	}
+	return key, material, nil
+}
+
+// ParsePrivateKeyPEM 解析 PEM 编码的私钥，支持 PKCS#8、PKCS#1（RSA）与 SEC 1（EC）。
+func ParsePrivateKeyPEM(pemBytes []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(pemBytes)
+	if block == nil {
+		return nil, fmt.Errorf("failed to decode PEM block from private key")
+	}
+
+	var (
+		key any
+		err error
+	)
+	switch block.Type {
+	case "PRIVATE KEY": // PKCS#8
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY": // PKCS#1
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY": // SEC 1
+		key, err = x509.ParseECPrivateKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("unsupported PEM block type %q", block.Type)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("parse %s: %w", block.Type, err)
+	}
+
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("private key type %T is not a signer", key)
+	}
+	return signer, nil
+}
+
+// CanSign 是否持有签发所需的私钥/秘钥。
+func (k *SigningKey) CanSign() bool {
+	return k != nil && k.signKey != nil
+}
+
+// PublicKey 返回非对称密钥的公钥；对称密钥返回 nil（秘钥不可公开）。
+func (k *SigningKey) PublicKey() crypto.PublicKey {
+	if k == nil || isHMACMethod(k.Method) {
+		return nil
+	}
+	return k.verifyKey
+}
+
+// PrivateKey 返回非对称密钥的私钥（用于派生 kid 等），对称密钥或仅校验密钥返回 nil。
+func (k *SigningKey) PrivateKey() crypto.Signer {
+	if k == nil {
+		return nil
+	}
+	signer, _ := k.signKey.(crypto.Signer)
+	return signer
+}
+
+// Keyring JWT 密钥环：一把活动密钥负责签发（令牌头写入 kid），
+// 活动密钥与未过保留期的退役密钥共同负责校验，按令牌头的 kid 选择密钥。
+// 实现 authn.Authenticator，可直接替换单密钥的 JWT 认证器。
+type Keyring struct {
+	mu     sync.RWMutex
+	active *SigningKey
+	keys   map[string]*SigningKey
+
+	// legacyKid 密钥环上线前签发的令牌没有 kid 头，按此密钥校验
+	legacyKid string
+
+	now func() time.Time
+}
+
+// NewKeyring 以初始活动密钥创建密钥环，该密钥同时用于校验不带 kid 的历史令牌。
+func NewKeyring(active *SigningKey) *Keyring {
+	r := &Keyring{
+		now:  time.Now,
+		keys: map[string]*SigningKey{},
+	}
+	if active != nil {
+		r.legacyKid = active.Kid
+		r.active = active
+		r.keys[active.Kid] = active
+	}
+	return r
+}
+
+// Replace 整体替换密钥环内容。active 可以为 nil（只校验不签发）。
+func (r *Keyring) Replace(active *SigningKey, verifyKeys ...*SigningKey) {
+	keys := make(map[string]*SigningKey, len(verifyKeys)+1)
+	for _, k := range verifyKeys {
+		if k != nil {
+			keys[k.Kid] = k
+		}
+	}
+	if active != nil {
+		keys[active.Kid] = active
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.active = active
+	r.keys = keys
+}
+
+// Active 返回当前活动密钥。
+func (r *Keyring) Active() *SigningKey {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.active
+}
+
+// VerificationKeys 返回当前可用于校验的全部密钥，活动密钥在前，其余按 kid 排序。
+func (r *Keyring) VerificationKeys() []*SigningKey {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	now := r.now()
+	out := make([]*SigningKey, 0, len(r.keys))
+	for _, k := range r.keys {
+		if k == r.active || !isVerifyExpired(k, now) {
+			out = append(out, k)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i] == r.active {
+			return true
+		}
+		if out[j] == r.active {
+			return false
+		}
+		return out[i].Kid < out[j].Kid
+	})
+	return out
+}
+
+// Lookup 按 kid 查找校验密钥；kid 为空时返回历史密钥。
+func (r *Keyring) Lookup(kid string) (*SigningKey, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if kid == "" {
+		kid = r.legacyKid
+	}
+	k, ok := r.keys[kid]
+	if !ok || (k != r.active && isVerifyExpired(k, r.now())) {
+		return nil, ErrUnknownKeyID
+	}
+	return k, nil
+}
+
+func (r *Keyring) Authenticate(ctx context.Context, contextType authn.ContextType) (*authn.AuthClaims, error) {
+	tokenString, err := authn.AuthFromMD(ctx, authn.BearerWord, contextType)
+	if err != nil {
+		return nil, authn.ErrMissingBearerToken
+	}
+	return r.AuthenticateToken(tokenString)
+}
+
+// AuthenticateToken 校验令牌签名与时效。kid 未知时返回 ErrUnknownKeyID，调用方可刷新密钥环后重试。
+func (r *Keyring) AuthenticateToken(tokenString string) (*authn.AuthClaims, error) {
+	jwtToken, err := jwtV5.Parse(tokenString, r.keyFunc)
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrUnknownKeyID):
+			return nil, ErrUnknownKeyID
+		case errors.Is(err, jwtV5.ErrTokenMalformed):
+			return nil, authn.ErrInvalidToken
+		case errors.Is(err, jwtV5.ErrTokenSignatureInvalid):
+			return nil, authn.ErrSignTokenFailed
+		case errors.Is(err, jwtV5.ErrTokenExpired) || errors.Is(err, jwtV5.ErrTokenNotValidYet):
+			return nil, authn.ErrTokenExpired
+		case errors.Is(err, authn.ErrUnsupportedSigningMethod):
+			return nil, authn.ErrUnsupportedSigningMethod
+		default:
+			return nil, authn.ErrInvalidToken
+		}
+	}
+	if jwtToken == nil || !jwtToken.Valid {
+		return nil, authn.ErrInvalidToken
+	}
+
+	claims, ok := jwtToken.Claims.(jwtV5.MapClaims)
+	if !ok {
+		return nil, authn.ErrInvalidClaims
+	}
+
+	authClaims := authn.AuthClaims(claims)
+	return &authClaims, nil
+}
+
+// keyFunc 按 kid 选择校验密钥，并要求令牌算法与密钥登记的算法一致，防止算法混淆攻击。
+func (r *Keyring) keyFunc(token *jwtV5.Token) (any, error) {
+	kid, _ := token.Header[HeaderKeyID].(string)
+	k, err := r.Lookup(kid)
+	if err != nil {
+		return nil, err
+	}
+	if token.Method == nil || token.Method.Alg() != k.Method {
+		return nil, authn.ErrUnsupportedSigningMethod
+	}
+	return k.verifyKey, nil
+}
+
+func (r *Keyring) CreateIdentityWithContext(ctx context.Context, contextType authn.ContextType, claims authn.AuthClaims) (context.Context, error) {
+	strToken, err := r.CreateIdentity(claims)
+	if err != nil {
+		return ctx, err
+	}
+	return authn.MDWithAuth(ctx, authn.BearerWord, strToken, contextType), nil
+}
+
+// CreateIdentity 以活动密钥签发令牌，令牌头写入 kid。
+func (r *Keyring) CreateIdentity(claims authn.AuthClaims) (string, error) {
+	active := r.Active()
+	if !active.CanSign() {
+		return "", ErrNoSigningKey
+	}
+
+	jwtToken := jwtV5.NewWithClaims(jwtV5.GetSigningMethod(active.Method), &claims)
+	jwtToken.Header[HeaderKeyID] = active.Kid
+
+	strToken, err := jwtToken.SignedString(active.signKey)
+	if err != nil {
+		return "", authn.ErrSignTokenFailed
+	}
+	return strToken, nil
+}
+
+func (r *Keyring) Close() {}
+
+func isVerifyExpired(k *SigningKey, now time.Time) bool {
+	return !k.VerifyUntil.IsZero() && now.After(k.VerifyUntil)
+}
+
+func isHMACMethod(method string) bool {
+	_, ok := jwtV5.GetSigningMethod(method).(*jwtV5.SigningMethodHMAC)
+	return ok
+}
+
+// hmacSecretSize 秘钥长度与哈希输出等长（RFC 7518 §3.2 的下限）
+func hmacSecretSize(method string) int {
+	switch method {
+	case "HS384":
+		return 48
+	case "HS512":
+		return 64
+	default:
+		return 32
+	}
+}
@@ -0,0 +1,132 @@
+package jwt
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	jwtV5 "github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	authn "github.com/tx7do/kratos-authn/engine"
+)
+
+func testClaims() authn.AuthClaims {
+	return authn.AuthClaims{
+		authn.ClaimFieldSubject:        "admin",
+		authn.ClaimFieldExpirationTime: time.Now().Add(time.Hour).Unix(),
+	}
+}
+
+func tokenHeader(t *testing.T, token string) map[string]any {
+	t.Helper()
+	raw, err := base64.RawURLEncoding.DecodeString(strings.Split(token, ".")[0])
+	require.NoError(t, err)
+	var header map[string]any
+	require.NoError(t, json.Unmarshal(raw, &header))
+	return header
+}
+
+func TestKeyring_RotationKeepsRetiredKeys(t *testing.T) {
+	oldKey, _, err := GenerateSigningKey("k1", "RS256")
+	require.NoError(t, err)
+
+	ring := NewKeyring(oldKey)
+	oldToken, err := ring.CreateIdentity(testClaims())
+	require.NoError(t, err)
+	assert.Equal(t, "k1", tokenHeader(t, oldToken)[HeaderKeyID])
+
+	// 轮换：新密钥签发，旧密钥保留校验到 VerifyUntil
+	newKey, _, err := GenerateSigningKey("k2", "RS256")
+	require.NoError(t, err)
+	oldKey.VerifyUntil = time.Now().Add(time.Hour)
+	ring.Replace(newKey, oldKey)
+
+	newToken, err := ring.CreateIdentity(testClaims())
+	require.NoError(t, err)
+	assert.Equal(t, "k2", tokenHeader(t, newToken)[HeaderKeyID])
+
+	_, err = ring.AuthenticateToken(oldToken)
+	assert.NoError(t, err)
+	_, err = ring.AuthenticateToken(newToken)
+	assert.NoError(t, err)
+	assert.Len(t, ring.VerificationKeys(), 2)
+	assert.Equal(t, "k2", ring.VerificationKeys()[0].Kid)
+
+	// 保留期过后旧令牌不再被接受
+	ring.now = func() time.Time { return time.Now().Add(2 * time.Hour) }
+	_, err = ring.AuthenticateToken(oldToken)
+	assert.ErrorIs(t, err, ErrUnknownKeyID)
+	assert.Len(t, ring.VerificationKeys(), 1)
+}
+
+func TestKeyring_LegacyTokenWithoutKid(t *testing.T) {
+	key, err := NewHMACSigningKey("cfg", "HS256", []byte("some_api_key"))
+	require.NoError(t, err)
+	ring := NewKeyring(key)
+
+	// 模拟密钥环上线前签发、不带 kid 的令牌
+	claims := testClaims()
+	token, err := jwtV5.NewWithClaims(jwtV5.SigningMethodHS256, &claims).SignedString([]byte("some_api_key"))
+	require.NoError(t, err)
+
+	_, err = ring.AuthenticateToken(token)
+	assert.NoError(t, err)
+}
+
+func TestKeyring_RejectsUnknownKidAndAlgMismatch(t *testing.T) {
+	a, _, err := GenerateSigningKey("a", "HS256")
+	require.NoError(t, err)
+	b, _, err := GenerateSigningKey("b", "HS256")
+	require.NoError(t, err)
+
+	tokenB, err := NewKeyring(b).CreateIdentity(testClaims())
+	require.NoError(t, err)
+
+	_, err = NewKeyring(a).AuthenticateToken(tokenB)
+	assert.ErrorIs(t, err, ErrUnknownKeyID)
+
+	// kid 相同但算法不同：拒绝（防止以公钥作 HMAC 秘钥的算法混淆）
+	rsaKey, _, err := GenerateSigningKey("b", "RS256")
+	require.NoError(t, err)
+	_, err = NewKeyring(rsaKey).AuthenticateToken(tokenB)
+	assert.Error(t, err)
+}
+
+func TestSigningKeyMaterialRoundTrip(t *testing.T) {
+	for _, method := range []string{"HS256", "RS256", "ES256", "EdDSA"} {
+		t.Run(method, func(t *testing.T) {
+			key, material, err := GenerateSigningKey("kid-"+method, method)
+			require.NoError(t, err)
+
+			restored, err := NewSigningKeyFromMaterial(key.Kid, method, material)
+			require.NoError(t, err)
+
+			token, err := NewKeyring(key).CreateIdentity(testClaims())
+			require.NoError(t, err)
+			_, err = NewKeyring(restored).AuthenticateToken(token)
+			assert.NoError(t, err)
+
+			if method == "HS256" {
+				assert.Nil(t, restored.PublicKey())
+			} else {
+				assert.NotNil(t, restored.PublicKey())
+			}
+		})
+	}
+}
+
+func TestKeyring_VerifyOnly(t *testing.T) {
+	key, _, err := GenerateSigningKey("k", "ES256")
+	require.NoError(t, err)
+
+	verifyOnly, err := NewAsymmetricSigningKey("k", "ES256", nil, key.PublicKey())
+	require.NoError(t, err)
+	assert.False(t, verifyOnly.CanSign())
+
+	_, err = NewKeyring(verifyOnly).CreateIdentity(testClaims())
+	assert.ErrorIs(t, err, ErrNoSigningKey)
+}
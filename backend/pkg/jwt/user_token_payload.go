@@ -20,12 +20,21 @@
 	ClaimFieldTenantID  = "tid"                   // 租户 ID
 	ClaimFieldClientID  = "cid"                   // 客户端 ID
 	ClaimFieldDeviceID  = "did"                   // 设备 ID
+	ClaimFieldSessionID = "sid"                   // 会话 ID
 	ClaimFieldRoleCodes = "roc"                   // 角色码列表
 	ClaimFieldDataScope = "ds"                    // 数据范围
 	ClaimFieldOrgUnitID = "ouid"                  // 组织单元 ID
+	ClaimFieldScopes    = "scp"                   // 授权范围列表（OAuth2 授权码令牌）
 
 	ClaimFieldIsPlatformAdmin = "ipa" // 是否平台管理员
 	ClaimFieldIsTenantAdmin   = "ita" // 是否租户管理员
+
+	ClaimFieldApiClientID = "acid" // 服务客户端 ID（client_credentials 令牌）
+
+	ClaimFieldActorUserID   = "auid" // 代登录操作人用户 ID（代登录令牌）
+	ClaimFieldActorUserName = "asub" // 代登录操作人用户名（代登录令牌）
+
+	ClaimFieldAuthTime = "auth_time" // 最近一次身份验证时间（OIDC auth_time，二次验证据此判定）
 )
 
 const (
@@ -85,12 +94,18 @@ func NewUserTokenAuthClaims(
 	if len(tokenPayload.Roles) > 0 {
 		authClaims[ClaimFieldRoleCodes] = tokenPayload.Roles
 	}
+	if len(tokenPayload.Scopes) > 0 {
+		authClaims[ClaimFieldScopes] = tokenPayload.Scopes
+	}
 	if tokenPayload.DeviceId != nil {
 		authClaims[ClaimFieldDeviceID] = tokenPayload.GetDeviceId()
 	}
 	if tokenPayload.ClientId != nil {
 		authClaims[ClaimFieldClientID] = tokenPayload.GetClientId()
 	}
+	if tokenPayload.SessionId != nil {
+		authClaims[ClaimFieldSessionID] = tokenPayload.GetSessionId()
+	}
 
 	if tokenPayload.DataScope != nil {
 		authClaims[ClaimFieldDataScope] = tokenPayload.GetDataScope().String()
@@ -104,6 +119,18 @@ func NewUserTokenAuthClaims(
 	if tokenPayload.IsTenantAdmin != nil {
 		authClaims[ClaimFieldIsTenantAdmin] = tokenPayload.GetIsTenantAdmin()
 	}
+	if tokenPayload.ApiClientId != nil {
+		authClaims[ClaimFieldApiClientID] = tokenPayload.GetApiClientId()
+	}
+	if tokenPayload.ActorUserId != nil {
+		authClaims[ClaimFieldActorUserID] = tokenPayload.GetActorUserId()
+	}
+	if tokenPayload.ActorUsername != nil {
+		authClaims[ClaimFieldActorUserName] = tokenPayload.GetActorUsername()
+	}
+	if tokenPayload.AuthTime != nil {
+		authClaims[ClaimFieldAuthTime] = tokenPayload.GetAuthTime()
+	}
 
 	return &authClaims
 }
@@ -160,6 +187,14 @@ func NewUserTokenPayloadWithClaims(claims *authn.AuthClaims) (*authenticationV1.
 		payload.DeviceId = trans.Ptr(deviceId)
 	}
 
+	sessionId, err := claims.GetString(ClaimFieldSessionID)
+	if err != nil {
+		log.Errorf("GetString ClaimFieldSessionID failed: %v", err)
+	}
+	if sessionId != "" {
+		payload.SessionId = trans.Ptr(sessionId)
+	}
+
 	roleCodes, err := claims.GetStrings(ClaimFieldRoleCodes)
 	if err != nil {
 		log.Errorf("GetStrings ClaimFieldRoleCodes failed: %v", err)
@@ -168,6 +203,14 @@ func NewUserTokenPayloadWithClaims(claims *authn.AuthClaims) (*authenticationV1.
 		payload.Roles = roleCodes
 	}
 
+	scopes, err := claims.GetStrings(ClaimFieldScopes)
+	if err != nil {
+		log.Errorf("GetStrings ClaimFieldScopes failed: %v", err)
+	}
+	if scopes != nil {
+		payload.Scopes = scopes
+	}
+
 	dataScope, err := claims.GetString(ClaimFieldDataScope)
 	if err != nil {
 		log.Errorf("GetString ClaimFieldDataScope failed: %v", err)
@@ -194,6 +237,38 @@ func NewUserTokenPayloadWithClaims(claims *authn.AuthClaims) (*authenticationV1.
 		payload.IsTenantAdmin = trans.Ptr(true)
 	}
 
+	apiClientID, err := claims.GetUint32(ClaimFieldApiClientID)
+	if err != nil {
+		log.Errorf("GetUint32 ClaimFieldApiClientID failed: %v", err)
+	}
+	if apiClientID != 0 {
+		payload.ApiClientId = trans.Ptr(apiClientID)
+	}
+
+	actorUserID, err := claims.GetUint32(ClaimFieldActorUserID)
+	if err != nil {
+		log.Errorf("GetUint32 ClaimFieldActorUserID failed: %v", err)
+	}
+	if actorUserID != 0 {
+		payload.ActorUserId = trans.Ptr(actorUserID)
+	}
+
+	actorUserName, err := claims.GetString(ClaimFieldActorUserName)
+	if err != nil {
+		log.Errorf("GetString ClaimFieldActorUserName failed: %v", err)
+	}
+	if actorUserName != "" {
+		payload.ActorUsername = trans.Ptr(actorUserName)
+	}
+
+	authTime, err := claims.GetInt64(ClaimFieldAuthTime)
+	if err != nil {
+		log.Errorf("GetInt64 ClaimFieldAuthTime failed: %v", err)
+	}
+	if authTime != 0 {
+		payload.AuthTime = trans.Ptr(authTime)
+	}
+
 	return payload, nil
 }
 
@@ -230,6 +305,10 @@ func NewUserTokenPayloadWithJwtMapClaims(claims jwt.MapClaims) (*authenticationV
 		payload.DeviceId = trans.Ptr(deviceId)
 	}
 
+	if sessionId, ok := claims[ClaimFieldSessionID].(string); ok {
+		payload.SessionId = trans.Ptr(sessionId)
+	}
+
 	if dataScope, ok := claims[ClaimFieldDataScope].(string); ok {
 		if v, vok := identityV1.DataScope_value[dataScope]; vok {
 			payload.DataScope = trans.Ptr(identityV1.DataScope(v))
@@ -247,6 +326,33 @@ func NewUserTokenPayloadWithJwtMapClaims(claims jwt.MapClaims) (*authenticationV
 		payload.IsTenantAdmin = trans.Ptr(true)
 	}
 
+	if apiClientID, ok := claims[ClaimFieldApiClientID].(float64); ok {
+		payload.ApiClientId = trans.Ptr(uint32(apiClientID))
+	}
+
+	if actorUserID, ok := claims[ClaimFieldActorUserID].(float64); ok && actorUserID != 0 {
+		payload.ActorUserId = trans.Ptr(uint32(actorUserID))
+	}
+	if actorUserName, ok := claims[ClaimFieldActorUserName].(string); ok && actorUserName != "" {
+		payload.ActorUsername = trans.Ptr(actorUserName)
+	}
+
+	if authTime, ok := claims[ClaimFieldAuthTime].(float64); ok && authTime != 0 {
+		payload.AuthTime = trans.Ptr(int64(authTime))
+	}
+
+	// 授权范围与角色码同样容忍 []interface{}/[]string 两种形态，元素类型不符则跳过。
+	switch itf := claims[ClaimFieldScopes].(type) {
+	case []interface{}:
+		for _, sc := range itf {
+			if scStr, scOk := sc.(string); scOk {
+				payload.Scopes = append(payload.Scopes, scStr)
+			}
+		}
+	case []string:
+		payload.Scopes = itf
+	}
+
 	roleCodes := claims[ClaimFieldRoleCodes]
 	if roleCodes != nil {
 		switch itf := roleCodes.(type) {
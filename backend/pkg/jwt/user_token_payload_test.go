@@ -8,6 +8,7 @@
 	"github.com/tx7do/go-utils/trans"
 	authn "github.com/tx7do/kratos-authn/engine"
 
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
 	identityV1 "go-wind-admin/api/gen/go/identity/service/v1"
 )
 
@@ -92,6 +93,7 @@ func TestNewUserTokenPayloadWithClaims(t *testing.T) {
 		ClaimFieldTenantID:      user.GetTenantId(),
 		ClaimFieldClientID:      client,
 		ClaimFieldDeviceID:      device,
+		ClaimFieldSessionID:     "sess",
 		ClaimFieldRoleCodes:     user.Roles,
 		ClaimFieldDataScope:     ds.String(),
 		ClaimFieldOrgUnitID:     ou,
@@ -105,6 +107,7 @@ func TestNewUserTokenPayloadWithClaims(t *testing.T) {
 	assert.Equal(t, user.GetTenantId(), payload.GetTenantId())
 	assert.Equal(t, client, payload.GetClientId())
 	assert.Equal(t, device, payload.GetDeviceId())
+	assert.Equal(t, "sess", payload.GetSessionId())
 	assert.Equal(t, user.Roles, payload.GetRoles())
 	if payload.DataScope != nil {
 		assert.Equal(t, ds, payload.GetDataScope())
@@ -155,3 +158,109 @@ func TestNewUserTokenPayloadWithJwtMapClaims(t *testing.T) {
 		assert.Equal(t, ou, payload.GetOrgUnitId())
 	}
 }
+
+func TestApiClientIDClaimRoundTrip(t *testing.T) {
+	payload := &authenticationV1.UserTokenPayload{
+		UserId:      0,
+		TenantId:    trans.Ptr(uint32(3)),
+		Username:    trans.Ptr("billing-sync"),
+		ClientId:    trans.Ptr("gwa_0123"),
+		Roles:       []string{"api_reader"},
+		ApiClientId: trans.Ptr(uint32(9)),
+	}
+
+	claims := NewUserTokenAuthClaims(payload, nil)
+	assert.Equal(t, uint32(9), (*claims)[ClaimFieldApiClientID])
+
+	// 经 JSON 解码后数值为 float64
+	mapClaims := jwt.MapClaims{
+		"sub":                 payload.GetUsername(),
+		ClaimFieldUserID:      float64(0),
+		ClaimFieldTenantID:    float64(3),
+		ClaimFieldClientID:    payload.GetClientId(),
+		ClaimFieldRoleCodes:   []interface{}{"api_reader"},
+		ClaimFieldApiClientID: float64(9),
+	}
+	decoded, err := NewUserTokenPayloadWithJwtMapClaims(mapClaims)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(0), decoded.GetUserId())
+	assert.Equal(t, uint32(9), decoded.GetApiClientId())
+
+	// 用户令牌不携带该声明
+	userClaims := NewUserTokenAuthClaims(&authenticationV1.UserTokenPayload{UserId: 1}, nil)
+	_, exist := (*userClaims)[ClaimFieldApiClientID]
+	assert.False(t, exist)
+}
+
+func TestActorClaimRoundTrip(t *testing.T) {
+	payload := &authenticationV1.UserTokenPayload{
+		UserId:        12,
+		TenantId:      trans.Ptr(uint32(3)),
+		Username:      trans.Ptr("bob"),
+		ActorUserId:   trans.Ptr(uint32(1)),
+		ActorUsername: trans.Ptr("admin"),
+	}
+
+	claims := NewUserTokenAuthClaims(payload, nil)
+	assert.Equal(t, uint32(1), (*claims)[ClaimFieldActorUserID])
+	assert.Equal(t, "admin", (*claims)[ClaimFieldActorUserName])
+
+	decoded, err := NewUserTokenPayloadWithClaims(claims)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(1), decoded.GetActorUserId())
+	assert.Equal(t, "admin", decoded.GetActorUsername())
+
+	// 经 JSON 解码后数值为 float64；类型不符的声明被忽略
+	decoded, err = NewUserTokenPayloadWithJwtMapClaims(jwt.MapClaims{
+		"sub":                   "bob",
+		ClaimFieldUserID:        float64(12),
+		ClaimFieldActorUserID:   float64(1),
+		ClaimFieldActorUserName: "admin",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(1), decoded.GetActorUserId())
+	assert.Equal(t, "admin", decoded.GetActorUsername())
+
+	decoded, err = NewUserTokenPayloadWithJwtMapClaims(jwt.MapClaims{
+		ClaimFieldUserID:        float64(12),
+		ClaimFieldActorUserID:   "1",
+		ClaimFieldActorUserName: 1,
+	})
+	assert.NoError(t, err)
+	assert.Nil(t, decoded.ActorUserId)
+	assert.Nil(t, decoded.ActorUsername)
+
+	// 普通用户令牌不携带代登录声明
+	userClaims := NewUserTokenAuthClaims(&authenticationV1.UserTokenPayload{UserId: 1}, nil)
+	_, exist := (*userClaims)[ClaimFieldActorUserID]
+	assert.False(t, exist)
+}
+
+func TestAuthTimeClaimRoundTrip(t *testing.T) {
+	authTime := int64(1700000000)
+	payload := &authenticationV1.UserTokenPayload{
+		UserId:   12,
+		Username: trans.Ptr("bob"),
+		AuthTime: trans.Ptr(authTime),
+	}
+
+	claims := NewUserTokenAuthClaims(payload, nil)
+	assert.Equal(t, authTime, (*claims)[ClaimFieldAuthTime])
+
+	decoded, err := NewUserTokenPayloadWithClaims(claims)
+	assert.NoError(t, err)
+	assert.Equal(t, authTime, decoded.GetAuthTime())
+
+	// 经 JSON 解码后数值为 float64
+	decoded, err = NewUserTokenPayloadWithJwtMapClaims(jwt.MapClaims{
+		ClaimFieldUserID:   float64(12),
+		ClaimFieldAuthTime: float64(authTime),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, authTime, decoded.GetAuthTime())
+
+	// 未记录身份验证时间的令牌不携带该声明
+	userClaims := NewUserTokenAuthClaims(&authenticationV1.UserTokenPayload{UserId: 1}, nil)
+	_, exist := (*userClaims)[ClaimFieldAuthTime]
+	assert.False(t, exist)
+}
@@ -0,0 +1,386 @@
+// Package ldap LDAP / Active Directory 目录客户端：按租户配置校验目录账号密码，并拉取用户、组、组织单元快照供同步使用。
+//
+// 认证流程为“服务账号检索 + 用户 DN 绑定”：先以服务账号（或匿名）按用户过滤器检索出唯一条目，
+// 再以该条目 DN 与用户输入的密码绑定，绑定成功即认证通过。空密码一律拒绝，避免被目录视为匿名绑定。
+//
+// 目录连接经 Conn / DialFunc 抽象，生产使用 DefaultDial（go-ldap），测试可替换为 ldaptest 进程内目录桩。
+package ldap
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	ldapv3 "github.com/go-ldap/ldap/v3"
+)
+
+// UsernamePlaceholder 用户过滤器中的用户名占位符。
+const UsernamePlaceholder = "{username}"
+
+// 默认值
+const (
+	DefaultTimeout              = 10 * time.Second
+	DefaultPageSize             = 500
+	DefaultGroupMemberAttribute = "member"
+)
+
+// maxUsernameLength 登录名长度上限，超长输入直接判为不存在。
+const maxUsernameLength = 256
+
+var (
+	ErrInvalidConfig      = errors.New("ldap: invalid directory config")
+	ErrUserNotFound       = errors.New("ldap: user not found in directory")
+	ErrAmbiguousUser      = errors.New("ldap: username matches multiple directory entries")
+	ErrInvalidCredentials = errors.New("ldap: invalid credentials")
+	ErrMissingID          = errors.New("ldap: entry has no id attribute")
+)
+
+// binaryIDAttributes 以二进制存储的唯一标识属性，统一编码为十六进制字符串。
+var binaryIDAttributes = map[string]bool{
+	"objectguid": true,
+	"objectsid":  true,
+}
+
+// Config 目录连接与检索配置。
+type Config struct {
+	// URL 目录地址，ldap://host:389 或 ldaps://host:636
+	URL string
+	// StartTLS 对 ldap:// 连接升级 TLS
+	StartTLS bool
+	// RootCAs 校验目录服务器证书的根证书，为空使用系统根证书
+	RootCAs *x509.CertPool
+
+	// BindDN / BindPassword 检索用服务账号，BindDN 为空时匿名检索
+	BindDN       string
+	BindPassword string
+
+	// BaseDN 检索起点
+	BaseDN string
+	// UserFilter 用户过滤器，须包含 {username} 占位符，如 (&(objectClass=person)(uid={username}))；
+	// 同步时占位符替换为 *，检索全部用户
+	UserFilter string
+	// UserAttributes 检索用户时额外读取的属性
+	UserAttributes []string
+	// IDAttribute 条目唯一标识属性（OpenLDAP 为 entryUUID，AD 为 objectGUID），为空时以规范化 DN 作标识
+	IDAttribute string
+
+	// GroupFilter 组过滤器，为空不同步组
+	GroupFilter string
+	// GroupMemberAttribute 组成员属性，值为成员 DN
+	GroupMemberAttribute string
+
+	// OrgUnitFilter 组织单元过滤器，为空不同步组织单元
+	OrgUnitFilter string
+
+	Timeout  time.Duration
+	PageSize uint32
+}
+
+// Validate 校验配置完整性与过滤器语法。
+func (c *Config) Validate() error {
+	u, err := url.Parse(c.URL)
+	if err != nil || u.Host == "" || (u.Scheme != "ldap" && u.Scheme != "ldaps") {
+		return fmt.Errorf("%w: url must be ldap:// or ldaps://", ErrInvalidConfig)
+	}
+	if c.StartTLS && u.Scheme == "ldaps" {
+		return fmt.Errorf("%w: start tls requires ldap://", ErrInvalidConfig)
+	}
+	if _, err = ldapv3.ParseDN(c.BaseDN); err != nil || strings.TrimSpace(c.BaseDN) == "" {
+		return fmt.Errorf("%w: invalid base dn", ErrInvalidConfig)
+	}
+	if c.BindDN != "" {
+		if _, err = ldapv3.ParseDN(c.BindDN); err != nil {
+			return fmt.Errorf("%w: invalid bind dn", ErrInvalidConfig)
+		}
+	}
+	if !strings.Contains(c.UserFilter, UsernamePlaceholder) {
+		return fmt.Errorf("%w: user filter must contain %s", ErrInvalidConfig, UsernamePlaceholder)
+	}
+	for _, filter := range []string{c.userFilter("x"), c.GroupFilter, c.OrgUnitFilter} {
+		if filter == "" {
+			continue
+		}
+		if _, err = ldapv3.CompileFilter(filter); err != nil {
+			return fmt.Errorf("%w: invalid filter %s", ErrInvalidConfig, filter)
+		}
+	}
+	return nil
+}
+
+// userFilter 以转义后的用户名替换占位符。
+func (c *Config) userFilter(username string) string {
+	return strings.ReplaceAll(c.UserFilter, UsernamePlaceholder, ldapv3.EscapeFilter(username))
+}
+
+func (c *Config) timeout() time.Duration {
+	if c.Timeout > 0 {
+		return c.Timeout
+	}
+	return DefaultTimeout
+}
+
+func (c *Config) pageSize() uint32 {
+	if c.PageSize > 0 {
+		return c.PageSize
+	}
+	return DefaultPageSize
+}
+
+func (c *Config) groupMemberAttribute() string {
+	if c.GroupMemberAttribute != "" {
+		return c.GroupMemberAttribute
+	}
+	return DefaultGroupMemberAttribute
+}
+
+// Conn 目录连接，*ldapv3.Conn 满足该接口。
+type Conn interface {
+	Bind(username, password string) error
+	SearchWithPaging(searchRequest *ldapv3.SearchRequest, pagingSize uint32) (*ldapv3.SearchResult, error)
+	Close() error
+}
+
+// DialFunc 按配置建立目录连接。
+type DialFunc func(ctx context.Context, cfg *Config) (Conn, error)
+
+// DefaultDial 使用 go-ldap 建立连接：ldaps:// 直接 TLS，ldap:// 按需 StartTLS。
+func DefaultDial(ctx context.Context, cfg *Config) (Conn, error) {
+	u, err := url.Parse(cfg.URL)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidConfig, err.Error())
+	}
+
+	timeout := cfg.timeout()
+	if deadline, ok := ctx.Deadline(); ok {
+		timeout = min(timeout, time.Until(deadline))
+	}
+	tlsConfig := &tls.Config{
+		ServerName: u.Hostname(),
+		RootCAs:    cfg.RootCAs,
+		MinVersion: tls.VersionTLS12,
+	}
+
+	conn, err := ldapv3.DialURL(cfg.URL,
+		ldapv3.DialWithDialer(&net.Dialer{Timeout: timeout}),
+		ldapv3.DialWithTLSConfig(tlsConfig),
+	)
+	if err != nil {
+		return nil, err
+	}
+	conn.SetTimeout(timeout)
+
+	if cfg.StartTLS {
+		if err = conn.StartTLS(tlsConfig); err != nil {
+			_ = conn.Close()
+			return nil, err
+		}
+	}
+	return conn, nil
+}
+
+// Entry 目录条目，属性名统一为小写。
+type Entry struct {
+	DN         string
+	ID         string
+	Attributes map[string][]string
+}
+
+// Attribute 取属性首个值，不存在返回空串。
+func (e *Entry) Attribute(name string) string {
+	if values := e.Attributes[strings.ToLower(name)]; len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// Values 取属性全部值。
+func (e *Entry) Values(name string) []string {
+	return e.Attributes[strings.ToLower(name)]
+}
+
+// Snapshot 一次同步拉取的目录快照。
+type Snapshot struct {
+	Users    []*Entry
+	Groups   []*Entry
+	OrgUnits []*Entry
+}
+
+// NormalizeDN 规范化 DN（属性类型与值均小写），用于 DN 比较与映射键；非法 DN 返回空串。
+func NormalizeDN(dn string) string {
+	parsed, err := ldapv3.ParseDN(dn)
+	if err != nil || len(parsed.RDNs) == 0 {
+		return ""
+	}
+	return strings.ToLower(parsed.String())
+}
+
+// ParentDN 取规范化后的上级 DN，顶层或非法 DN 返回空串。
+func ParentDN(dn string) string {
+	parsed, err := ldapv3.ParseDN(dn)
+	if err != nil || len(parsed.RDNs) < 2 {
+		return ""
+	}
+	return strings.ToLower((&ldapv3.DN{RDNs: parsed.RDNs[1:]}).String())
+}
+
+// Client 目录客户端，每次操作独立建连，用完即关。
+type Client struct {
+	cfg  Config
+	dial DialFunc
+}
+
+// NewClient 创建目录客户端，dial 为空时使用 DefaultDial。
+func NewClient(cfg Config, dial DialFunc) *Client {
+	if dial == nil {
+		dial = DefaultDial
+	}
+	return &Client{cfg: cfg, dial: dial}
+}
+
+// connect 建连并以服务账号绑定。
+func (c *Client) connect(ctx context.Context) (Conn, error) {
+	conn, err := c.dial(ctx, &c.cfg)
+	if err != nil {
+		return nil, fmt.Errorf("ldap: dial %s: %w", c.cfg.URL, err)
+	}
+	if c.cfg.BindDN != "" {
+		if err = conn.Bind(c.cfg.BindDN, c.cfg.BindPassword); err != nil {
+			_ = conn.Close()
+			return nil, fmt.Errorf("ldap: service account bind: %w", err)
+		}
+	}
+	return conn, nil
+}
+
+// Authenticate 校验目录账号密码，成功返回用户条目。
+// 用户不存在返回 ErrUserNotFound，密码错误返回 ErrInvalidCredentials，其余为目录不可用等错误。
+func (c *Client) Authenticate(ctx context.Context, username, password string) (*Entry, error) {
+	if username == "" || len(username) > maxUsernameLength {
+		return nil, ErrUserNotFound
+	}
+	if password == "" {
+		return nil, ErrInvalidCredentials
+	}
+
+	conn, err := c.connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	entries, err := c.search(conn, c.cfg.userFilter(username), c.userAttributes())
+	if err != nil {
+		return nil, err
+	}
+	switch len(entries) {
+	case 0:
+		return nil, ErrUserNotFound
+	case 1:
+	default:
+		return nil, ErrAmbiguousUser
+	}
+
+	entry := entries[0]
+	if err = conn.Bind(entry.DN, password); err != nil {
+		if ldapv3.IsErrorWithCode(err, ldapv3.LDAPResultInvalidCredentials) {
+			return nil, ErrInvalidCredentials
+		}
+		return nil, fmt.Errorf("ldap: user bind: %w", err)
+	}
+	if entry.ID == "" {
+		return nil, ErrMissingID
+	}
+	return entry, nil
+}
+
+// Snapshot 拉取全部用户，以及配置了过滤器的组与组织单元。
+func (c *Client) Snapshot(ctx context.Context) (*Snapshot, error) {
+	conn, err := c.connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	snapshot := &Snapshot{}
+	if snapshot.Users, err = c.search(conn, strings.ReplaceAll(c.cfg.UserFilter, UsernamePlaceholder, "*"), c.userAttributes()); err != nil {
+		return nil, err
+	}
+	if c.cfg.GroupFilter != "" {
+		attrs := c.withID("cn", c.cfg.groupMemberAttribute())
+		if snapshot.Groups, err = c.search(conn, c.cfg.GroupFilter, attrs); err != nil {
+			return nil, err
+		}
+	}
+	if c.cfg.OrgUnitFilter != "" {
+		if snapshot.OrgUnits, err = c.search(conn, c.cfg.OrgUnitFilter, c.withID("ou", "description")); err != nil {
+			return nil, err
+		}
+	}
+	return snapshot, nil
+}
+
+func (c *Client) userAttributes() []string {
+	return c.withID(c.cfg.UserAttributes...)
+}
+
+// withID 在检索属性中补上唯一标识属性。
+func (c *Client) withID(attrs ...string) []string {
+	out := make([]string, 0, len(attrs)+1)
+	if c.cfg.IDAttribute != "" {
+		out = append(out, c.cfg.IDAttribute)
+	}
+	return append(out, attrs...)
+}
+
+// search 在 BaseDN 下分页检索整棵子树。
+func (c *Client) search(conn Conn, filter string, attrs []string) ([]*Entry, error) {
+	req := ldapv3.NewSearchRequest(
+		c.cfg.BaseDN,
+		ldapv3.ScopeWholeSubtree, ldapv3.NeverDerefAliases, 0, int(c.cfg.timeout().Seconds()), false,
+		filter,
+		attrs,
+		nil,
+	)
+	result, err := conn.SearchWithPaging(req, c.cfg.pageSize())
+	if err != nil {
+		return nil, fmt.Errorf("ldap: search %s: %w", filter, err)
+	}
+
+	entries := make([]*Entry, 0, len(result.Entries))
+	for _, e := range result.Entries {
+		entries = append(entries, c.toEntry(e))
+	}
+	return entries, nil
+}
+
+func (c *Client) toEntry(e *ldapv3.Entry) *Entry {
+	entry := &Entry{
+		DN:         e.DN,
+		Attributes: make(map[string][]string, len(e.Attributes)),
+	}
+	for _, attr := range e.Attributes {
+		name := strings.ToLower(attr.Name)
+		entry.Attributes[name] = append(entry.Attributes[name], attr.Values...)
+	}
+
+	if c.cfg.IDAttribute == "" {
+		entry.ID = NormalizeDN(e.DN)
+		return entry
+	}
+	raw := e.GetEqualFoldRawAttributeValue(c.cfg.IDAttribute)
+	switch {
+	case len(raw) == 0:
+	case binaryIDAttributes[strings.ToLower(c.cfg.IDAttribute)]:
+		entry.ID = hex.EncodeToString(raw)
+	default:
+		entry.ID = string(raw)
+	}
+	return entry
+}
@@ -0,0 +1,205 @@
+package ldap_test
+
+import (
+	"context"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go-wind-admin/pkg/ldap"
+	"go-wind-admin/pkg/ldap/ldaptest"
+)
+
+const (
+	baseDN    = "dc=acme,dc=test"
+	serviceDN = "cn=svc,dc=acme,dc=test"
+)
+
+func newDirectory() *ldaptest.Directory {
+	dir := ldaptest.New()
+	dir.Add(serviceDN, map[string][]string{"objectClass": {"person"}, "cn": {"svc"}})
+	dir.SetPassword(serviceDN, "svc-secret")
+
+	dir.Add("ou=Engineering,dc=acme,dc=test", map[string][]string{
+		"objectClass": {"organizationalUnit"},
+		"ou":          {"Engineering"},
+		"entryUUID":   {"ou-eng"},
+	})
+	dir.Add("uid=alice,ou=Engineering,dc=acme,dc=test", map[string][]string{
+		"objectClass": {"inetOrgPerson"},
+		"uid":         {"alice"},
+		"mail":        {"alice@acme.test"},
+		"cn":          {"Alice W"},
+		"entryUUID":   {"u-alice"},
+	})
+	dir.SetPassword("uid=alice,ou=Engineering,dc=acme,dc=test", "alice-pw")
+	dir.Add("uid=bob,dc=acme,dc=test", map[string][]string{
+		"objectClass": {"inetOrgPerson"},
+		"uid":         {"bob"},
+		"entryUUID":   {"u-bob"},
+	})
+	dir.Add("cn=admins,dc=acme,dc=test", map[string][]string{
+		"objectClass": {"groupOfNames"},
+		"cn":          {"admins"},
+		"member":      {"uid=alice,ou=Engineering,dc=acme,dc=test"},
+		"entryUUID":   {"g-admins"},
+	})
+	return dir
+}
+
+func newConfig() ldap.Config {
+	return ldap.Config{
+		URL:            "ldap://dir.acme.test",
+		BindDN:         serviceDN,
+		BindPassword:   "svc-secret",
+		BaseDN:         baseDN,
+		UserFilter:     "(&(objectClass=inetOrgPerson)(uid={username}))",
+		UserAttributes: []string{"uid", "mail", "cn"},
+		IDAttribute:    "entryUUID",
+		GroupFilter:    "(objectClass=groupOfNames)",
+		OrgUnitFilter:  "(objectClass=organizationalUnit)",
+	}
+}
+
+func TestAuthenticate(t *testing.T) {
+	dir := newDirectory()
+	client := ldap.NewClient(newConfig(), dir.Dial)
+	ctx := context.Background()
+
+	entry, err := client.Authenticate(ctx, "alice", "alice-pw")
+	require.NoError(t, err)
+	assert.Equal(t, "u-alice", entry.ID)
+	assert.Equal(t, "alice@acme.test", entry.Attribute("MAIL"))
+	assert.Contains(t, dir.Binds(), "uid=alice,ou=engineering,dc=acme,dc=test")
+
+	_, err = client.Authenticate(ctx, "alice", "wrong")
+	assert.ErrorIs(t, err, ldap.ErrInvalidCredentials)
+
+	// 目录把空密码当作匿名绑定放行，客户端必须自行拒绝
+	_, err = client.Authenticate(ctx, "alice", "")
+	assert.ErrorIs(t, err, ldap.ErrInvalidCredentials)
+
+	// 未设置密码的账号无法绑定
+	_, err = client.Authenticate(ctx, "bob", "anything")
+	assert.ErrorIs(t, err, ldap.ErrInvalidCredentials)
+
+	_, err = client.Authenticate(ctx, "carol", "pw")
+	assert.ErrorIs(t, err, ldap.ErrUserNotFound)
+
+	// 过滤器注入：通配符被转义，不会匹配任意用户
+	_, err = client.Authenticate(ctx, "*", "alice-pw")
+	assert.ErrorIs(t, err, ldap.ErrUserNotFound)
+	_, err = client.Authenticate(ctx, "alice)(uid=*", "alice-pw")
+	assert.ErrorIs(t, err, ldap.ErrUserNotFound)
+}
+
+func TestAuthenticateAmbiguous(t *testing.T) {
+	dir := newDirectory()
+	dir.Add("uid=alice,dc=acme,dc=test", map[string][]string{
+		"objectClass": {"inetOrgPerson"},
+		"uid":         {"alice"},
+		"entryUUID":   {"u-alice-2"},
+	})
+
+	_, err := ldap.NewClient(newConfig(), dir.Dial).Authenticate(context.Background(), "alice", "alice-pw")
+	assert.ErrorIs(t, err, ldap.ErrAmbiguousUser)
+}
+
+func TestAuthenticateServiceBindAndOutage(t *testing.T) {
+	dir := newDirectory()
+	ctx := context.Background()
+
+	cfg := newConfig()
+	cfg.BindPassword = "bad"
+	_, err := ldap.NewClient(cfg, dir.Dial).Authenticate(ctx, "alice", "alice-pw")
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, ldap.ErrInvalidCredentials)
+
+	dir.SetUnavailable(true)
+	_, err = ldap.NewClient(newConfig(), dir.Dial).Authenticate(ctx, "alice", "alice-pw")
+	assert.ErrorIs(t, err, ldaptest.ErrUnavailable)
+}
+
+func TestSnapshot(t *testing.T) {
+	dir := newDirectory()
+
+	snapshot, err := ldap.NewClient(newConfig(), dir.Dial).Snapshot(context.Background())
+	require.NoError(t, err)
+
+	var users []string
+	for _, u := range snapshot.Users {
+		users = append(users, u.ID)
+	}
+	assert.ElementsMatch(t, []string{"u-alice", "u-bob"}, users)
+
+	require.Len(t, snapshot.Groups, 1)
+	assert.Equal(t, "g-admins", snapshot.Groups[0].ID)
+	assert.Equal(t, []string{"uid=alice,ou=Engineering,dc=acme,dc=test"}, snapshot.Groups[0].Values("member"))
+
+	require.Len(t, snapshot.OrgUnits, 1)
+	assert.Equal(t, "Engineering", snapshot.OrgUnits[0].Attribute("ou"))
+
+	// 未配置组与组织单元过滤器时不检索
+	cfg := newConfig()
+	cfg.GroupFilter, cfg.OrgUnitFilter = "", ""
+	snapshot, err = ldap.NewClient(cfg, dir.Dial).Snapshot(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, snapshot.Users, 2)
+	assert.Empty(t, snapshot.Groups)
+	assert.Empty(t, snapshot.OrgUnits)
+}
+
+func TestEntryID(t *testing.T) {
+	dir := newDirectory()
+	guid := []byte{0x01, 0x02, 0xfe, 0xff}
+	dir.SetAttribute("uid=alice,ou=Engineering,dc=acme,dc=test", "objectGUID", string(guid))
+
+	cfg := newConfig()
+	cfg.IDAttribute = "objectGUID"
+	entry, err := ldap.NewClient(cfg, dir.Dial).Authenticate(context.Background(), "alice", "alice-pw")
+	require.NoError(t, err)
+	assert.Equal(t, hex.EncodeToString(guid), entry.ID)
+
+	// 未配置标识属性时以规范化 DN 作标识
+	cfg.IDAttribute = ""
+	entry, err = ldap.NewClient(cfg, dir.Dial).Authenticate(context.Background(), "alice", "alice-pw")
+	require.NoError(t, err)
+	assert.Equal(t, "uid=alice,ou=engineering,dc=acme,dc=test", entry.ID)
+
+	// 条目缺少标识属性时拒绝
+	cfg.IDAttribute = "employeeNumber"
+	_, err = ldap.NewClient(cfg, dir.Dial).Authenticate(context.Background(), "alice", "alice-pw")
+	assert.ErrorIs(t, err, ldap.ErrMissingID)
+}
+
+func TestConfigValidate(t *testing.T) {
+	cfg := newConfig()
+	assert.NoError(t, cfg.Validate())
+
+	cases := map[string]func(c *ldap.Config){
+		"scheme":       func(c *ldap.Config) { c.URL = "http://dir.acme.test" },
+		"no host":      func(c *ldap.Config) { c.URL = "ldap://" },
+		"ldaps tls":    func(c *ldap.Config) { c.URL = "ldaps://dir.acme.test"; c.StartTLS = true },
+		"base dn":      func(c *ldap.Config) { c.BaseDN = "" },
+		"bind dn":      func(c *ldap.Config) { c.BindDN = "not a dn" },
+		"placeholder":  func(c *ldap.Config) { c.UserFilter = "(uid=alice)" },
+		"user filter":  func(c *ldap.Config) { c.UserFilter = "(uid={username}" },
+		"group filter": func(c *ldap.Config) { c.GroupFilter = "objectClass=group" },
+	}
+	for name, mutate := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := newConfig()
+			mutate(&c)
+			assert.ErrorIs(t, c.Validate(), ldap.ErrInvalidConfig)
+		})
+	}
+}
+
+func TestDN(t *testing.T) {
+	assert.Equal(t, "uid=alice,ou=engineering,dc=acme,dc=test", ldap.NormalizeDN("UID=Alice, OU=Engineering,DC=acme,DC=test"))
+	assert.Equal(t, "ou=engineering,dc=acme,dc=test", ldap.ParentDN("uid=alice,OU=Engineering,dc=acme,dc=test"))
+	assert.Equal(t, "", ldap.ParentDN("dc=test"))
+	assert.Equal(t, "", ldap.NormalizeDN("not a dn"))
+}
@@ -0,0 +1,103 @@
+package ldaptest
+
+import (
+	"fmt"
+	"strings"
+
+	ber "github.com/go-asn1-ber/asn1-ber"
+	ldapv3 "github.com/go-ldap/ldap/v3"
+)
+
+// match 对条目属性求值已编译的过滤器。
+func match(filter *ber.Packet, attrs map[string][]string) (bool, error) {
+	switch filter.Tag {
+	case ldapv3.FilterAnd:
+		for _, child := range filter.Children {
+			ok, err := match(child, attrs)
+			if err != nil || !ok {
+				return false, err
+			}
+		}
+		return true, nil
+
+	case ldapv3.FilterOr:
+		for _, child := range filter.Children {
+			ok, err := match(child, attrs)
+			if err != nil || ok {
+				return ok, err
+			}
+		}
+		return false, nil
+
+	case ldapv3.FilterNot:
+		ok, err := match(filter.Children[0], attrs)
+		return !ok, err
+
+	case ldapv3.FilterPresent:
+		return len(attrs[attributeName(filter)]) > 0, nil
+
+	case ldapv3.FilterEqualityMatch, ldapv3.FilterApproxMatch:
+		name, value := assertion(filter)
+		return anyValue(attrs[name], func(v string) bool { return strings.EqualFold(v, value) }), nil
+
+	case ldapv3.FilterGreaterOrEqual:
+		name, value := assertion(filter)
+		return anyValue(attrs[name], func(v string) bool { return strings.ToLower(v) >= value }), nil
+
+	case ldapv3.FilterLessOrEqual:
+		name, value := assertion(filter)
+		return anyValue(attrs[name], func(v string) bool { return strings.ToLower(v) <= value }), nil
+
+	case ldapv3.FilterSubstrings:
+		name := strings.ToLower(string(filter.Children[0].Data.Bytes()))
+		parts := filter.Children[1].Children
+		return anyValue(attrs[name], func(v string) bool { return matchSubstrings(strings.ToLower(v), parts) }), nil
+
+	default:
+		return false, fmt.Errorf("ldaptest: unsupported filter %s", ldapv3.FilterMap[uint64(filter.Tag)])
+	}
+}
+
+func attributeName(filter *ber.Packet) string {
+	return strings.ToLower(string(filter.Data.Bytes()))
+}
+
+// assertion 取属性值断言的属性名与值（均小写）。
+func assertion(filter *ber.Packet) (string, string) {
+	return strings.ToLower(string(filter.Children[0].Data.Bytes())),
+		strings.ToLower(string(filter.Children[1].Data.Bytes()))
+}
+
+func anyValue(values []string, fn func(string) bool) bool {
+	for _, v := range values {
+		if fn(v) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchSubstrings(value string, parts []*ber.Packet) bool {
+	for _, part := range parts {
+		s := strings.ToLower(string(part.Data.Bytes()))
+		switch part.Tag {
+		case ldapv3.FilterSubstringsInitial:
+			if !strings.HasPrefix(value, s) {
+				return false
+			}
+			value = value[len(s):]
+		case ldapv3.FilterSubstringsAny:
+			i := strings.Index(value, s)
+			if i < 0 {
+				return false
+			}
+			value = value[i+len(s):]
+		case ldapv3.FilterSubstringsFinal:
+			if !strings.HasSuffix(value, s) {
+				return false
+			}
+			value = ""
+		}
+	}
+	return true
+}
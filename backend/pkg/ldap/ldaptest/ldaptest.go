@@ -0,0 +1,228 @@
+// Package ldaptest 进程内 LDAP 目录桩：在内存中维护条目与密码，实现 ldap.Conn，
+// 供单元测试替代真实目录服务（Directory.Dial 可直接作为 ldap.DialFunc 注入）。
+//
+// 支持简单绑定、按作用域检索与常用过滤器（& | ! = =* 子串 >= <= ~=），属性名与值比较均不区分大小写。
+// 与真实目录一致，空密码绑定视为匿名绑定并成功。
+package ldaptest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	ldapv3 "github.com/go-ldap/ldap/v3"
+
+	"go-wind-admin/pkg/ldap"
+)
+
+// ErrUnavailable 目录被置为不可用时建连返回的错误。
+var ErrUnavailable = errors.New("ldaptest: directory unavailable")
+
+type record struct {
+	dn       *ldapv3.DN
+	rawDN    string
+	attrs    map[string][]string
+	password string
+}
+
+// Directory 内存目录。
+type Directory struct {
+	mu          sync.RWMutex
+	records     map[string]*record
+	order       []string
+	unavailable bool
+	binds       []string
+}
+
+// New 创建空目录。
+func New() *Directory {
+	return &Directory{records: make(map[string]*record)}
+}
+
+// Add 新增或覆盖条目，属性名不区分大小写。
+func (d *Directory) Add(dn string, attrs map[string][]string) {
+	parsed, err := ldapv3.ParseDN(dn)
+	if err != nil {
+		panic(fmt.Sprintf("ldaptest: invalid dn %q: %v", dn, err))
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	key := ldap.NormalizeDN(dn)
+	r := &record{dn: parsed, rawDN: dn, attrs: make(map[string][]string, len(attrs))}
+	for name, values := range attrs {
+		r.attrs[strings.ToLower(name)] = append([]string(nil), values...)
+	}
+	if old, ok := d.records[key]; ok {
+		r.password = old.password
+	} else {
+		d.order = append(d.order, key)
+	}
+	d.records[key] = r
+}
+
+// SetAttribute 替换条目的属性值。
+func (d *Directory) SetAttribute(dn, name string, values ...string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if r, ok := d.records[ldap.NormalizeDN(dn)]; ok {
+		r.attrs[strings.ToLower(name)] = append([]string(nil), values...)
+	}
+}
+
+// SetPassword 设置条目的绑定密码。
+func (d *Directory) SetPassword(dn, password string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if r, ok := d.records[ldap.NormalizeDN(dn)]; ok {
+		r.password = password
+	}
+}
+
+// Remove 删除条目。
+func (d *Directory) Remove(dn string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	key := ldap.NormalizeDN(dn)
+	if _, ok := d.records[key]; !ok {
+		return
+	}
+	delete(d.records, key)
+	for i, k := range d.order {
+		if k == key {
+			d.order = append(d.order[:i], d.order[i+1:]...)
+			break
+		}
+	}
+}
+
+// SetUnavailable 模拟目录宕机：之后的建连全部失败。
+func (d *Directory) SetUnavailable(unavailable bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.unavailable = unavailable
+}
+
+// Binds 返回成功绑定过的 DN（规范化），便于断言认证路径。
+func (d *Directory) Binds() []string {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	return append([]string(nil), d.binds...)
+}
+
+// Dial 实现 ldap.DialFunc。
+func (d *Directory) Dial(_ context.Context, _ *ldap.Config) (ldap.Conn, error) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	if d.unavailable {
+		return nil, ErrUnavailable
+	}
+	return &conn{dir: d}, nil
+}
+
+type conn struct {
+	dir    *Directory
+	closed bool
+}
+
+func (c *conn) Bind(username, password string) error {
+	if c.closed {
+		return ldapv3.NewError(ldapv3.ErrorNetwork, errors.New("connection closed"))
+	}
+	// 空密码为匿名绑定
+	if password == "" {
+		return nil
+	}
+
+	c.dir.mu.Lock()
+	defer c.dir.mu.Unlock()
+
+	key := ldap.NormalizeDN(username)
+	r, ok := c.dir.records[key]
+	if !ok || r.password == "" || r.password != password {
+		return ldapv3.NewError(ldapv3.LDAPResultInvalidCredentials, errors.New("invalid credentials"))
+	}
+	c.dir.binds = append(c.dir.binds, key)
+	return nil
+}
+
+func (c *conn) SearchWithPaging(req *ldapv3.SearchRequest, _ uint32) (*ldapv3.SearchResult, error) {
+	if c.closed {
+		return nil, ldapv3.NewError(ldapv3.ErrorNetwork, errors.New("connection closed"))
+	}
+
+	base, err := ldapv3.ParseDN(req.BaseDN)
+	if err != nil {
+		return nil, ldapv3.NewError(ldapv3.LDAPResultInvalidDNSyntax, err)
+	}
+	filter, err := ldapv3.CompileFilter(req.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	c.dir.mu.RLock()
+	defer c.dir.mu.RUnlock()
+
+	result := &ldapv3.SearchResult{}
+	for _, key := range c.dir.order {
+		r := c.dir.records[key]
+		if !inScope(base, r.dn, req.Scope) {
+			continue
+		}
+		matched, err := match(filter, r.attrs)
+		if err != nil {
+			return nil, err
+		}
+		if matched {
+			result.Entries = append(result.Entries, ldapv3.NewEntry(r.rawDN, selectAttributes(r.attrs, req.Attributes)))
+		}
+	}
+	return result, nil
+}
+
+func (c *conn) Close() error {
+	c.closed = true
+	return nil
+}
+
+func inScope(base, dn *ldapv3.DN, scope int) bool {
+	switch scope {
+	case ldapv3.ScopeBaseObject:
+		return base.EqualFold(dn)
+	case ldapv3.ScopeSingleLevel:
+		return base.AncestorOfFold(dn) && len(dn.RDNs) == len(base.RDNs)+1
+	default:
+		return base.EqualFold(dn) || base.AncestorOfFold(dn)
+	}
+}
+
+func selectAttributes(attrs map[string][]string, requested []string) map[string][]string {
+	out := make(map[string][]string, len(attrs))
+	all := len(requested) == 0
+	for _, name := range requested {
+		if name == "*" {
+			all = true
+		}
+	}
+	if all {
+		for name, values := range attrs {
+			out[name] = values
+		}
+		return out
+	}
+	for _, name := range requested {
+		if values, ok := attrs[strings.ToLower(name)]; ok {
+			out[name] = values
+		}
+	}
+	return out
+}
@@ -0,0 +1,32 @@
+package abac
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+
+	"go-wind-admin/pkg/abac"
+)
+
+// Evaluator 请求级 ABAC 判定：返回错误即拒绝本次请求
+type Evaluator interface {
+	EvaluateRequest(ctx context.Context, req *abac.Request) error
+}
+
+// Server ABAC 中间件，置于 RBAC（authz）中间件之后：RBAC 放行的请求再按权限点上的动态策略判定
+func Server(evaluator Evaluator) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			if evaluator == nil {
+				return handler(ctx, req)
+			}
+
+			request := abac.NewRequestFromContext(ctx)
+			if err := evaluator.EvaluateRequest(ctx, &request); err != nil {
+				return nil, err
+			}
+
+			return handler(ctx, req)
+		}
+	}
+}
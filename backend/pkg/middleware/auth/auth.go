@@ -2,6 +2,7 @@
 
 import (
 	"context"
+	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware"
@@ -63,6 +64,28 @@ func Server(opts ...Option) middleware.Middleware {
 
 			ctx = NewContext(ctx, tokenPayload)
 
+			// 作用域检查：仅对携带 scopes 的令牌（OAuth2 授权码签发）生效，第一方令牌不受限制
+			if op.enableCheckScopes {
+				if err = checkScopes(tr, tokenPayload); err != nil {
+					op.log.Warnf("auth middleware: insufficient scope %v for [%s]", tokenPayload.GetScopes(), tr.Operation())
+					return nil, err
+				}
+			}
+
+			// 代登录令牌只能调用只读与支持类操作，避免操作人以被代登录用户身份篡改其凭证或换取长期令牌
+			if err = checkImpersonation(tr.Operation(), tokenPayload, op.impersonationAllowedOperations); err != nil {
+				op.log.Warnf("auth middleware: operator [%d] impersonating user [%d] blocked from [%s]",
+					tokenPayload.GetActorUserId(), tokenPayload.GetUserId(), tr.Operation())
+				return nil, err
+			}
+
+			// 敏感操作要求近期完成过身份验证（登录或二次验证），防止长期有效的令牌被盗用后直接执行高危操作
+			if err = checkStepUp(tr.Operation(), tokenPayload, op.stepUpOperations, time.Now()); err != nil {
+				op.log.Warnf("auth middleware: user [%d] requires reauthentication for [%s], auth_time=%d",
+					tokenPayload.GetUserId(), tr.Operation(), tokenPayload.GetAuthTime())
+				return nil, err
+			}
+
 			if op.injectOperatorId {
 				if err = setRequestOperationId(req, tokenPayload); err != nil {
 					op.log.Errorf("auth middleware: invalid token payload in context [%s]", err.Error())
@@ -83,12 +106,24 @@ func Server(opts ...Option) middleware.Middleware {
 					traceID = spanContext.TraceID().String()
 				}
 
+				var permissions []string
+				if op.permissionResolver != nil {
+					if permissions, err = op.permissionResolver.ResolvePermissionCodes(ctx,
+						tokenPayload.GetTenantId(), tokenPayload.GetUserId(), tokenPayload.GetRoles(),
+					); err != nil {
+						op.log.Errorf("auth middleware: resolve permissions of user [%d] failed [%s]", tokenPayload.GetUserId(), err.Error())
+						return nil, err
+					}
+				}
+
 				userViewer := appViewer.NewUserViewer(
 					uint64(tokenPayload.GetUserId()),
 					uint64(tokenPayload.GetTenantId()),
 					uint64(tokenPayload.GetOrgUnitId()),
 					traceID,
 					tokenPayload.GetDataScope(),
+					tokenPayload.GetRoles(),
+					permissions,
 				)
 				ctx = viewer.WithContext(ctx, userViewer)
 			}
@@ -1,6 +1,10 @@
 package auth
 
-import "github.com/go-kratos/kratos/v2/errors"
+import (
+	"github.com/go-kratos/kratos/v2/errors"
+
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+)
 
 const (
 	reason string = "UNAUTHORIZED"
@@ -18,4 +22,10 @@
 	ErrInvalidRequest        = errors.Unauthorized(reason, "invalid request")
 
 	ErrAccessTokenCheckerNotConfigured = errors.Unauthorized(reason, "access token checker is not configured")
+
+	ErrInsufficientScope = errors.Forbidden("INSUFFICIENT_SCOPE", "insufficient scope")
+
+	ErrImpersonationForbidden = errors.Forbidden("IMPERSONATION_FORBIDDEN", "operation not allowed while impersonating")
+
+	ErrReauthenticationRequired = authenticationV1.ErrorReauthenticationRequired("recent authentication required")
 )
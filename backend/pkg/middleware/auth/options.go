@@ -2,6 +2,7 @@
 
 import (
 	"context"
+	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
 
@@ -26,6 +27,13 @@ type TenantAccessChecker interface {
 	CheckTenantAccess(ctx context.Context, tenantId uint32, path string, method string) error
 }
 
+// PermissionResolver 权限码解析接口
+// 按令牌中的角色解析当前用户的权限码，填充 Ent Viewer 的权限列表以支持 HasPermission 细粒度判断。
+type PermissionResolver interface {
+	// ResolvePermissionCodes 返回用户在租户内经角色获得的权限码
+	ResolvePermissionCodes(ctx context.Context, tenantId, userId uint32, roleCodes []string) ([]string, error)
+}
+
 type AccessTokenCheckerFunc func(ctx context.Context, accessToken string, skipRedis bool) (bool, *authenticationV1.UserTokenPayload)
 
 func (f AccessTokenCheckerFunc) IsValidAccessToken(ctx context.Context, accessToken string, skipRedis bool) (bool, *authenticationV1.UserTokenPayload) {
@@ -73,9 +81,13 @@ type options struct {
 
 	accessTokenChecker                AccessTokenChecker // 访问令牌检查器
 	tenantAccessChecker               TenantAccessChecker
+	permissionResolver                PermissionResolver // 权限码解析器，为空时 Viewer 不含权限码
 	enableCheckRefreshTokenExpiration bool               // 是否启用刷新令牌过期检查
 	enableCheckScopes                 bool               // 是否启用作用域检查
 
+	impersonationAllowedOperations map[string]struct{}      // 代登录令牌允许调用的操作，其余一律拒绝
+	stepUpOperations               map[string]time.Duration // 需要二次验证的敏感操作及其身份验证时效
+
 	enableAuthz bool // 是否启用鉴权
 
 	injectOperatorId bool
@@ -100,6 +112,13 @@ func WithTenantAccessChecker(checker TenantAccessChecker) Option {
 	}
 }
 
+// WithPermissionResolver 设置权限码解析器
+func WithPermissionResolver(resolver PermissionResolver) Option {
+	return func(opts *options) {
+		opts.permissionResolver = resolver
+	}
+}
+
 func WithAccessTokenCheckerFromFuncs(valid AccessTokenCheckerFunc, blocker AccessTokenBlockerFunc) Option {
 	return func(opts *options) {
 		opts.accessTokenChecker = NewAccessTokenCheckerFromFuncs(valid, blocker)
@@ -119,6 +138,31 @@ func WithEnableCheckScopes(enable bool) Option {
 	}
 }
 
+// WithImpersonationAllowedOperations 设置代登录令牌允许调用的操作（只读与支持类操作），可多次调用累加。
+// 未列出的操作一律拒绝，新增的敏感接口默认不对代登录开放。
+func WithImpersonationAllowedOperations(operations ...string) Option {
+	return func(opts *options) {
+		if opts.impersonationAllowedOperations == nil {
+			opts.impersonationAllowedOperations = make(map[string]struct{}, len(operations))
+		}
+		for _, operation := range operations {
+			opts.impersonationAllowedOperations[operation] = struct{}{}
+		}
+	}
+}
+
+// WithStepUpOperations 设置需要二次验证的敏感操作：令牌的身份验证时间（auth_time）须在 maxAge 以内，可多次调用累加
+func WithStepUpOperations(maxAge time.Duration, operations ...string) Option {
+	return func(opts *options) {
+		if opts.stepUpOperations == nil {
+			opts.stepUpOperations = make(map[string]time.Duration, len(operations))
+		}
+		for _, operation := range operations {
+			opts.stepUpOperations[operation] = maxAge
+		}
+	}
+}
+
 // WithInjectOperatorId 设置是否注入操作员ID
 func WithInjectOperatorId(enable bool) Option {
 	return func(opts *options) {
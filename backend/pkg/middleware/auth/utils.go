@@ -3,6 +3,8 @@
 import (
 	"context"
 	"reflect"
+	"strconv"
+	"time"
 
 	"github.com/go-kratos/kratos/v2/transport"
 	"github.com/go-kratos/kratos/v2/transport/http"
@@ -12,6 +14,8 @@
 	authz "github.com/tx7do/kratos-authz/middleware"
 
 	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+
+	"go-wind-admin/pkg/oauth"
 )
 
 func processAuthz(
@@ -44,6 +48,49 @@ func processAuthz(
 	return ctx, nil
 }
 
+// checkScopes 检查令牌授权范围是否覆盖当前请求，规则见 oauth.ScopeAllows。
+func checkScopes(tr transport.Transporter, tokenPayload *authenticationV1.UserTokenPayload) error {
+	if len(tokenPayload.GetScopes()) == 0 {
+		return nil
+	}
+
+	var method string
+	if htr, ok := tr.(*http.Transport); ok {
+		method = htr.Request().Method
+	}
+
+	if !oauth.ScopeAllows(tokenPayload.GetScopes(), tr.Operation(), method) {
+		return ErrInsufficientScope
+	}
+	return nil
+}
+
+// checkImpersonation 代登录令牌（携带操作人 ID）只能调用允许列表中的操作
+func checkImpersonation(operation string, tokenPayload *authenticationV1.UserTokenPayload, allowed map[string]struct{}) error {
+	if tokenPayload.GetActorUserId() == 0 {
+		return nil
+	}
+	if _, ok := allowed[operation]; !ok {
+		return ErrImpersonationForbidden
+	}
+	return nil
+}
+
+// checkStepUp 敏感操作要求令牌的身份验证时间在时效内，否则返回 REAUTHENTICATION_REQUIRED，
+// metadata.max_age 为要求的时效（秒），客户端据此引导用户重新验证身份后重试。
+func checkStepUp(operation string, tokenPayload *authenticationV1.UserTokenPayload, required map[string]time.Duration, now time.Time) error {
+	maxAge, ok := required[operation]
+	if !ok {
+		return nil
+	}
+	if authTime := tokenPayload.GetAuthTime(); authTime > 0 && now.Sub(time.Unix(authTime, 0)) <= maxAge {
+		return nil
+	}
+	return ErrReauthenticationRequired.WithMetadata(map[string]string{
+		"max_age": strconv.FormatInt(int64(maxAge.Seconds()), 10),
+	})
+}
+
 func setRequestOperationId(req interface{}, payload *authenticationV1.UserTokenPayload) error {
 	if req == nil {
 		return ErrInvalidRequest
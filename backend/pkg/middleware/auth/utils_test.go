@@ -0,0 +1,56 @@
+package auth
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/tx7do/go-utils/trans"
+
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+)
+
+func TestCheckImpersonation(t *testing.T) {
+	var op options
+	WithImpersonationAllowedOperations("/svc/ListUser")(&op)
+	WithImpersonationAllowedOperations("/svc/GetUser")(&op)
+
+	user := &authenticationV1.UserTokenPayload{UserId: 2}
+	impersonated := &authenticationV1.UserTokenPayload{UserId: 2, ActorUserId: trans.Ptr(uint32(1))}
+
+	// 普通令牌不受限制
+	assert.NoError(t, checkImpersonation("/svc/ChangePassword", user, op.impersonationAllowedOperations))
+
+	// 代登录令牌只放行列出的操作，未列出的（包括新增接口）一律拒绝
+	assert.NoError(t, checkImpersonation("/svc/ListUser", impersonated, op.impersonationAllowedOperations))
+	assert.NoError(t, checkImpersonation("/svc/GetUser", impersonated, op.impersonationAllowedOperations))
+	assert.ErrorIs(t, checkImpersonation("/svc/ChangePassword", impersonated, op.impersonationAllowedOperations), ErrImpersonationForbidden)
+	assert.ErrorIs(t, checkImpersonation("/svc/NewSensitiveRPC", impersonated, op.impersonationAllowedOperations), ErrImpersonationForbidden)
+
+	// 未配置允许列表时代登录令牌不能调用任何受保护操作
+	assert.ErrorIs(t, checkImpersonation("/svc/ListUser", impersonated, nil), ErrImpersonationForbidden)
+}
+
+func TestCheckStepUp(t *testing.T) {
+	var op options
+	WithStepUpOperations(10*time.Minute, "/svc/DisableMFA", "/svc/CleanupData")(&op)
+
+	now := time.Unix(1_700_000_000, 0)
+	fresh := &authenticationV1.UserTokenPayload{UserId: 2, AuthTime: trans.Ptr(now.Add(-5 * time.Minute).Unix())}
+	stale := &authenticationV1.UserTokenPayload{UserId: 2, AuthTime: trans.Ptr(now.Add(-11 * time.Minute).Unix())}
+	missing := &authenticationV1.UserTokenPayload{UserId: 2}
+
+	// 非敏感操作不检查身份验证时间
+	assert.NoError(t, checkStepUp("/svc/ListUser", missing, op.stepUpOperations, now))
+
+	// 敏感操作要求身份验证时间在时效内，缺失视为过期
+	assert.NoError(t, checkStepUp("/svc/DisableMFA", fresh, op.stepUpOperations, now))
+	assert.ErrorIs(t, checkStepUp("/svc/DisableMFA", stale, op.stepUpOperations, now), ErrReauthenticationRequired)
+	assert.ErrorIs(t, checkStepUp("/svc/CleanupData", missing, op.stepUpOperations, now), ErrReauthenticationRequired)
+
+	// 结构化错误：客户端据 reason 与 metadata.max_age 引导重新验证
+	err := errors.FromError(checkStepUp("/svc/DisableMFA", stale, op.stepUpOperations, now))
+	assert.True(t, authenticationV1.IsReauthenticationRequired(err))
+	assert.Equal(t, "600", err.Metadata["max_age"])
+}
@@ -0,0 +1,41 @@
+package datascope
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/tx7do/go-crud/viewer"
+
+	appViewer "go-wind-admin/pkg/entgo/viewer"
+)
+
+// Resolver 按访问者的角色解析行过滤范围：返回错误即拒绝本次请求
+type Resolver interface {
+	ResolveDataScopes(ctx context.Context, vc viewer.Context) ([]viewer.DataScope, error)
+}
+
+// Server 数据权限中间件，置于认证中间件之后：将角色数据权限解析为行过滤范围写回 Ent Viewer，由实体的隐私策略过滤查询
+func Server(resolver Resolver) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			if resolver == nil {
+				return handler(ctx, req)
+			}
+
+			vc, ok := viewer.FromContext(ctx)
+			if !ok || vc == nil || vc.IsPlatformContext() || vc.IsSystemContext() {
+				return handler(ctx, req)
+			}
+
+			scopes, err := resolver.ResolveDataScopes(ctx, vc)
+			if err != nil {
+				return nil, err
+			}
+			if len(scopes) > 0 {
+				ctx = viewer.WithContext(ctx, appViewer.WithDataScopes(vc, scopes))
+			}
+
+			return handler(ctx, req)
+		}
+	}
+}
@@ -32,6 +32,8 @@ func Server() middleware.Middleware {
 				data.GetOrgUnitId(),
 				traceID,
 				data.GetDataScope(),
+				nil,
+				nil,
 			)
 			ctx = viewer.WithContext(ctx, userViewer)
 
@@ -0,0 +1,407 @@
+package fieldperm
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/tx7do/go-crud/viewer"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+var ErrFieldWriteForbidden = errors.Forbidden("FIELD_PERMISSION_DENIED", "no permission to update field")
+
+const (
+	fieldData         protoreflect.Name = "data"
+	fieldUpdateMask   protoreflect.Name = "update_mask"
+	fieldAllowMissing protoreflect.Name = "allow_missing"
+)
+
+type contextKey struct{}
+
+// Provider 字段权限规则的提供方，通常由服务实现：各服务声明自身消息的字段规则，
+// 由 Server 统一校验 update_mask 写入与响应脱敏，任何采用 data + update_mask 约定的服务均可接入
+type Provider interface {
+	FieldRules() []Rule
+}
+
+// CurrentLoader 可由 Provider 一并实现：加载更新请求所针对记录的当前值（与请求 data 字段同一消息类型），
+// 用于仅在受限字段的值实际变化时校验写入权限；返回 nil 或出错时按请求涉及的全部字段校验
+type CurrentLoader interface {
+	LoadFieldCurrent(ctx context.Context, req proto.Message) (proto.Message, error)
+}
+
+// Rules 以规则列表作为 Provider
+type Rules []Rule
+
+func (r Rules) FieldRules() []Rule {
+	return r
+}
+
+// redactor protoc-gen-go-redact 为消息生成的静态脱敏方法
+type redactor interface {
+	Redact()
+}
+
+// readGrant 当前请求的字段读取授权
+type readGrant struct {
+	granted  map[protoreflect.FullName]map[protoreflect.Name]bool // 调用方显式持有 view 权限码的字段
+	bypassed bool                                                 // 生成代码的静态脱敏已被跳过，需由中间件补回
+}
+
+func grantFromContext(ctx context.Context) *readGrant {
+	g, _ := ctx.Value(contextKey{}).(*readGrant)
+	return g
+}
+
+// Bypass 实现 protoc-gen-go-redact 的 Bypass 接口。静态脱敏（proto 注解）始终是基线：
+// 仅当调用方对至少一个字段显式持有 view 权限码时跳过生成代码中的整体脱敏，
+// 由本中间件对响应副本重新执行静态脱敏，再按字段恢复 CheckField 放行的明文；
+// 其余请求（未持有字段权限码的平台/租户管理员、白名单路由等）照常静态脱敏。
+type Bypass struct{}
+
+func (Bypass) CheckInternal(ctx context.Context) bool {
+	g := grantFromContext(ctx)
+	if g == nil || len(g.granted) == 0 {
+		return false
+	}
+	g.bypassed = true
+	return true
+}
+
+// CheckField 调用方可否查看字段明文：须显式持有该字段的 view 权限码，通配权限码不放行
+func (Bypass) CheckField(ctx context.Context, message protoreflect.FullName, field protoreflect.Name) bool {
+	g := grantFromContext(ctx)
+	return g != nil && g.granted[message][field]
+}
+
+type ruleSet struct {
+	rules   map[protoreflect.FullName]map[protoreflect.Name]Rule
+	loaders map[protoreflect.FullName]CurrentLoader
+
+	// covered 缓存消息类型（含嵌套消息）是否涉及受限字段，避免逐个遍历无关响应
+	covered sync.Map
+}
+
+func newRuleSet(providers []Provider) *ruleSet {
+	rs := &ruleSet{
+		rules:   make(map[protoreflect.FullName]map[protoreflect.Name]Rule),
+		loaders: make(map[protoreflect.FullName]CurrentLoader),
+	}
+	for _, p := range providers {
+		loader, _ := p.(CurrentLoader)
+		for _, r := range p.FieldRules() {
+			fields, ok := rs.rules[r.Message]
+			if !ok {
+				fields = make(map[protoreflect.Name]Rule)
+				rs.rules[r.Message] = fields
+			}
+			fields[r.Field] = r
+			if loader != nil {
+				rs.loaders[r.Message] = loader
+			}
+		}
+	}
+	return rs
+}
+
+// grant 计算调用方可查看明文的字段
+func (rs *ruleSet) grant(vc viewer.Context) *readGrant {
+	g := &readGrant{granted: make(map[protoreflect.FullName]map[protoreflect.Name]bool)}
+
+	pv, ok := vc.(interface{ Permissions() []string })
+	if !ok {
+		return g
+	}
+	codes := make(map[string]struct{}, len(pv.Permissions()))
+	for _, code := range pv.Permissions() {
+		codes[code] = struct{}{}
+	}
+
+	for message, fields := range rs.rules {
+		for name, r := range fields {
+			if !r.Read {
+				continue
+			}
+			if _, ok := codes[r.ReadPermission()]; !ok {
+				continue
+			}
+			if g.granted[message] == nil {
+				g.granted[message] = make(map[protoreflect.Name]bool)
+			}
+			g.granted[message][name] = true
+		}
+	}
+	return g
+}
+
+// Server 字段权限中间件，置于认证中间件之后，规则由各服务通过 Provider 注册：
+// 更新请求的 update_mask（未指定时为 data 中已赋值的字段）含无写入权限且值发生变化的字段时拒绝；
+// 响应在静态脱敏的基础上，仅对显式持有 view 权限码的字段恢复明文，其余受限字段按规则脱敏。
+// 平台与系统视图同样按权限码判定。
+func Server(providers ...Provider) middleware.Middleware {
+	rs := newRuleSet(providers)
+
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			if len(rs.rules) == 0 {
+				return handler(ctx, req)
+			}
+
+			vc, ok := viewer.FromContext(ctx)
+			if !ok || vc == nil {
+				return handler(ctx, req)
+			}
+
+			if m, ok := req.(proto.Message); ok {
+				if err := rs.checkWrite(ctx, vc, m); err != nil {
+					return nil, err
+				}
+			}
+
+			g := rs.grant(vc)
+			ctx = context.WithValue(ctx, contextKey{}, g)
+
+			reply, err := handler(ctx, req)
+			if err != nil {
+				return reply, err
+			}
+
+			m, ok := reply.(proto.Message)
+			if !ok || m == nil || !m.ProtoReflect().IsValid() {
+				return reply, nil
+			}
+
+			if !g.bypassed {
+				rs.maskRead(g, m.ProtoReflect(), m.ProtoReflect())
+				return reply, nil
+			}
+
+			// 静态脱敏被跳过：在副本上补回静态脱敏，再按字段授权从原响应恢复明文
+			out := proto.Clone(m)
+			if r, ok := out.(redactor); ok {
+				r.Redact()
+			}
+			rs.maskRead(g, m.ProtoReflect(), out.ProtoReflect())
+			return out, nil
+		}
+	}
+}
+
+// checkWrite 校验更新请求涉及的字段是否可写
+func (rs *ruleSet) checkWrite(ctx context.Context, vc viewer.Context, m proto.Message) error {
+	req := m.ProtoReflect()
+	desc := req.Descriptor()
+
+	dataField := desc.Fields().ByName(fieldData)
+	maskField := desc.Fields().ByName(fieldUpdateMask)
+	if dataField == nil || dataField.Message() == nil || maskField == nil ||
+		maskField.Message() == nil || maskField.Message().FullName() != "google.protobuf.FieldMask" {
+		return nil
+	}
+
+	fields, ok := rs.rules[dataField.Message().FullName()]
+	if !ok || !req.Has(dataField) {
+		return nil
+	}
+	data := req.Get(dataField).Message()
+
+	var paths []string
+	if req.Has(maskField) {
+		if mask, ok := req.Get(maskField).Message().Interface().(*fieldmaskpb.FieldMask); ok {
+			paths = mask.GetPaths()
+		}
+	}
+
+	// 未指定 update_mask 或允许创建时，按 data 中已赋值的字段判断
+	allowMissing := desc.Fields().ByName(fieldAllowMissing)
+	if len(paths) == 0 || (allowMissing != nil && req.Get(allowMissing).Bool()) {
+		data.Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+			paths = append(paths, string(fd.Name()))
+			return true
+		})
+	}
+
+	var (
+		current protoreflect.Message
+		loaded  bool
+	)
+	for _, path := range paths {
+		name, _, _ := strings.Cut(path, ".")
+		r, ok := fields[protoreflect.Name(name)]
+		if !ok || !r.Write || vc.HasPermission("edit", r.resource()) {
+			continue
+		}
+
+		// 表单整体提交时 update_mask 会带上未改动的受限字段，值未变化的不视为写入
+		if !loaded {
+			current, loaded = loadCurrent(ctx, rs.loaders[data.Descriptor().FullName()], m, data.Descriptor()), true
+		}
+		if fd := data.Descriptor().Fields().ByName(protoreflect.Name(name)); fd != nil && current != nil &&
+			sameField(fd, data, current) {
+			continue
+		}
+
+		return ErrFieldWriteForbidden.WithMetadata(map[string]string{"field": name})
+	}
+
+	return nil
+}
+
+// loadCurrent 加载记录当前值，类型不符或加载失败时返回 nil
+func loadCurrent(ctx context.Context, loader CurrentLoader, req proto.Message, desc protoreflect.MessageDescriptor) protoreflect.Message {
+	if loader == nil {
+		return nil
+	}
+
+	current, err := loader.LoadFieldCurrent(ctx, req)
+	if err != nil || current == nil {
+		return nil
+	}
+
+	m := current.ProtoReflect()
+	if !m.IsValid() || m.Descriptor().FullName() != desc.FullName() {
+		return nil
+	}
+	return m
+}
+
+// sameField 字段在两条消息中的取值是否一致（含均未赋值）
+func sameField(fd protoreflect.FieldDescriptor, a, b protoreflect.Message) bool {
+	if a.Has(fd) != b.Has(fd) {
+		return false
+	}
+	if !a.Has(fd) {
+		return true
+	}
+
+	av, bv := a.Get(fd), b.Get(fd)
+	switch {
+	case fd.IsMap():
+		return false
+	case fd.IsList():
+		al, bl := av.List(), bv.List()
+		if al.Len() != bl.Len() {
+			return false
+		}
+		for i := 0; i < al.Len(); i++ {
+			if !sameValue(fd, al.Get(i), bl.Get(i)) {
+				return false
+			}
+		}
+		return true
+	default:
+		return sameValue(fd, av, bv)
+	}
+}
+
+// sameValue 比较单个取值（列表元素或标量字段）
+func sameValue(fd protoreflect.FieldDescriptor, a, b protoreflect.Value) bool {
+	switch {
+	case fd.Message() != nil:
+		return proto.Equal(a.Message().Interface(), b.Message().Interface())
+	case fd.Kind() == protoreflect.BytesKind:
+		return bytes.Equal(a.Bytes(), b.Bytes())
+	default:
+		return a.Interface() == b.Interface()
+	}
+}
+
+// maskRead 递归处理受限字段：显式授权的字段取 src 中的明文，其余按规则脱敏。
+// src 与 dst 为同一消息或 dst 为 src 经静态脱敏的副本
+func (rs *ruleSet) maskRead(g *readGrant, src, dst protoreflect.Message) {
+	if !dst.IsValid() || !rs.covers(dst.Descriptor(), nil) {
+		return
+	}
+
+	message := dst.Descriptor().FullName()
+	for name, r := range rs.rules[message] {
+		fd := dst.Descriptor().Fields().ByName(name)
+		if fd == nil || !r.Read || !src.Has(fd) {
+			continue
+		}
+		switch {
+		case g.granted[message][name]:
+			dst.Set(fd, src.Get(fd))
+		case r.Mask != nil && fd.Kind() == protoreflect.StringKind && !fd.IsList() && !fd.IsMap():
+			dst.Set(fd, protoreflect.ValueOfString(r.Mask(src.Get(fd).String())))
+		default:
+			dst.Clear(fd)
+		}
+	}
+
+	dst.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		sv := src.Get(fd)
+		switch {
+		case fd.IsList() && fd.Message() != nil:
+			list, srcList := v.List(), sv.List()
+			for i := 0; i < list.Len(); i++ {
+				item := list.Get(i).Message()
+				srcItem := item
+				if i < srcList.Len() {
+					srcItem = srcList.Get(i).Message()
+				}
+				rs.maskRead(g, srcItem, item)
+			}
+		case fd.IsMap() && fd.MapValue().Message() != nil:
+			srcMap := sv.Map()
+			v.Map().Range(func(k protoreflect.MapKey, mv protoreflect.Value) bool {
+				srcItem := mv.Message()
+				if srcMap.Has(k) {
+					srcItem = srcMap.Get(k).Message()
+				}
+				rs.maskRead(g, srcItem, mv.Message())
+				return true
+			})
+		case fd.Message() != nil && !fd.IsMap():
+			rs.maskRead(g, sv.Message(), v.Message())
+		}
+		return true
+	})
+}
+
+// covers 消息类型自身或其嵌套消息是否存在读取规则
+func (rs *ruleSet) covers(desc protoreflect.MessageDescriptor, visiting map[protoreflect.FullName]bool) bool {
+	if v, ok := rs.covered.Load(desc.FullName()); ok {
+		return v.(bool)
+	}
+	if visiting[desc.FullName()] {
+		return false
+	}
+	if visiting == nil {
+		visiting = make(map[protoreflect.FullName]bool)
+	}
+	visiting[desc.FullName()] = true
+
+	found := false
+	for _, r := range rs.rules[desc.FullName()] {
+		if r.Read {
+			found = true
+			break
+		}
+	}
+
+	fields := desc.Fields()
+	for i := 0; i < fields.Len() && !found; i++ {
+		fd := fields.Get(i)
+		if fd.IsMap() {
+			fd = fd.MapValue()
+		}
+		if fd.Message() != nil && rs.covers(fd.Message(), visiting) {
+			found = true
+		}
+	}
+
+	// 递归中途的否定结果可能依赖尚未完成的祖先，仅缓存顶层结果与肯定结果
+	if found || len(visiting) == 1 {
+		rs.covered.Store(desc.FullName(), found)
+	}
+	delete(visiting, desc.FullName())
+
+	return found
+}
@@ -0,0 +1,309 @@
+package fieldperm
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tx7do/go-crud/viewer"
+	"github.com/tx7do/go-utils/trans"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	identityV1 "go-wind-admin/api/gen/go/identity/service/v1"
+	permissionV1 "go-wind-admin/api/gen/go/permission/service/v1"
+
+	appViewer "go-wind-admin/pkg/entgo/viewer"
+)
+
+func testRules() Rules {
+	user := (&identityV1.User{}).ProtoReflect().Descriptor().FullName()
+	return Rules{
+		{Message: user, Field: "mobile", Read: true, Mask: MaskMobile},
+		{Message: user, Field: "email", Read: true, Mask: MaskEmail},
+		{Message: user, Field: "realname", Read: true, Mask: MaskName},
+		{Message: user, Field: "status", Write: true},
+		{Message: user, Field: "locked_until", Write: true},
+	}
+}
+
+// userProvider 模拟用户服务注册规则并提供当前值
+type userProvider struct {
+	current *identityV1.User
+}
+
+func (p userProvider) FieldRules() []Rule {
+	return testRules()
+}
+
+func (p userProvider) LoadFieldCurrent(_ context.Context, req proto.Message) (proto.Message, error) {
+	if req.(*identityV1.UpdateUserRequest).GetId() != p.current.GetId() {
+		return nil, errors.New("not found")
+	}
+	return p.current, nil
+}
+
+func tenantCtx(uid uint64, permissions ...string) context.Context {
+	return viewer.WithContext(context.Background(),
+		appViewer.NewUserViewer(uid, 1, 0, "", identityV1.DataScope_ALL, []string{"admin"}, permissions))
+}
+
+func platformCtx(permissions ...string) context.Context {
+	return viewer.WithContext(context.Background(),
+		appViewer.NewUserViewer(1, 0, 0, "", identityV1.DataScope_ALL, []string{"platform:admin"}, permissions))
+}
+
+func newUser(id uint32) *identityV1.User {
+	return &identityV1.User{
+		Id:       trans.Ptr(id),
+		Realname: trans.Ptr("张三丰"),
+		Email:    trans.Ptr("admin@example.com"),
+		Mobile:   trans.Ptr("13800138000"),
+	}
+}
+
+func TestRule_Permission(t *testing.T) {
+	rules := testRules()
+	assert.Equal(t, "user:mobile:view", rules[0].ReadPermission())
+	assert.Equal(t, "user:locked-until:edit", rules[4].WritePermission())
+}
+
+func TestMask(t *testing.T) {
+	assert.Equal(t, "138****8000", MaskMobile("13800138000"))
+	assert.Equal(t, "ad***@example.com", MaskEmail("admin@example.com"))
+	assert.Equal(t, "张**", MaskName("张三丰"))
+	assert.Equal(t, "123", MaskMobile("123"))
+}
+
+func TestServer_MaskRead(t *testing.T) {
+	handler := func(context.Context, interface{}) (interface{}, error) {
+		return &identityV1.ListUserResponse{Items: []*identityV1.User{newUser(2), newUser(10)}, Total: 2}, nil
+	}
+	h := Server(testRules())(handler)
+
+	// 无字段查看权限：全部脱敏，包括本人记录
+	reply, err := h(tenantCtx(10), &identityV1.GetUserRequest{})
+	require.NoError(t, err)
+	items := reply.(*identityV1.ListUserResponse).GetItems()
+	assert.Equal(t, "138****8000", items[0].GetMobile())
+	assert.Equal(t, "ad***@example.com", items[0].GetEmail())
+	assert.Equal(t, "张**", items[0].GetRealname())
+	assert.Equal(t, "138****8000", items[1].GetMobile())
+
+	// 具备 user:mobile:view、user:realname:view（如 HR 角色）可见明文，邮箱仍脱敏
+	reply, err = h(tenantCtx(10, "user:mobile:view", "user:realname:view"), &identityV1.GetUserRequest{})
+	require.NoError(t, err)
+	items = reply.(*identityV1.ListUserResponse).GetItems()
+	assert.Equal(t, "13800138000", items[0].GetMobile())
+	assert.Equal(t, "张三丰", items[0].GetRealname())
+	assert.Equal(t, "ad***@example.com", items[0].GetEmail())
+
+	// 通配权限码不视为显式授权
+	reply, err = h(tenantCtx(10, "user:*", "*"), &identityV1.GetUserRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, "138****8000", reply.(*identityV1.ListUserResponse).GetItems()[0].GetMobile())
+
+	// 平台管理员与系统身份同样按权限码判定
+	reply, err = h(platformCtx(), &identityV1.GetUserRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, "138****8000", reply.(*identityV1.ListUserResponse).GetItems()[0].GetMobile())
+
+	reply, err = h(appViewer.NewSystemViewerContext(context.Background()), &identityV1.GetUserRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, "138****8000", reply.(*identityV1.ListUserResponse).GetItems()[0].GetMobile())
+
+	reply, err = h(platformCtx("user:mobile:view"), &identityV1.GetUserRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, "13800138000", reply.(*identityV1.ListUserResponse).GetItems()[0].GetMobile())
+}
+
+func TestServer_StaticRedaction(t *testing.T) {
+	var bypassed bool
+	// 模拟 RedactedUserServiceServer：未放行时对响应整体执行静态脱敏
+	handler := func(ctx context.Context, _ interface{}) (interface{}, error) {
+		res := &identityV1.ListUserResponse{Items: []*identityV1.User{newUser(2)}, Total: 1}
+		bypassed = Bypass{}.CheckInternal(ctx)
+		if !bypassed {
+			res.Redact()
+		}
+		return res, nil
+	}
+
+	// 仅为手机号配置动态规则，邮箱只有静态脱敏注解
+	user := (&identityV1.User{}).ProtoReflect().Descriptor().FullName()
+	h := Server(Rules{{Message: user, Field: "mobile", Read: true, Mask: MaskMobile}})(handler)
+
+	static := newUser(2)
+	static.Redact()
+
+	// 未持有字段权限码的平台管理员：静态脱敏照常生效
+	reply, err := h(platformCtx(), &identityV1.GetUserRequest{})
+	require.NoError(t, err)
+	assert.False(t, bypassed)
+	item := reply.(*identityV1.ListUserResponse).GetItems()[0]
+	assert.Equal(t, static.GetMobile(), item.GetMobile())
+	assert.Equal(t, static.GetEmail(), item.GetEmail())
+
+	// 持有 user:mobile:view：仅手机号恢复明文，邮箱仍按静态注解脱敏
+	reply, err = h(tenantCtx(10, "user:mobile:view"), &identityV1.GetUserRequest{})
+	require.NoError(t, err)
+	assert.True(t, bypassed)
+	item = reply.(*identityV1.ListUserResponse).GetItems()[0]
+	assert.Equal(t, "13800138000", item.GetMobile())
+	assert.Equal(t, static.GetEmail(), item.GetEmail())
+	assert.NotEqual(t, "admin@example.com", item.GetEmail())
+}
+
+func TestBypass_CheckField(t *testing.T) {
+	user := (&identityV1.User{}).ProtoReflect().Descriptor().FullName()
+
+	var mobile, email, internal bool
+	handler := func(ctx context.Context, _ interface{}) (interface{}, error) {
+		mobile = Bypass{}.CheckField(ctx, user, "mobile")
+		email = Bypass{}.CheckField(ctx, user, "email")
+		internal = Bypass{}.CheckInternal(ctx)
+		return nil, nil
+	}
+
+	assert.False(t, Bypass{}.CheckInternal(context.Background()))
+	assert.False(t, Bypass{}.CheckField(context.Background(), user, "mobile"))
+
+	_, err := Server(testRules())(handler)(tenantCtx(10), &identityV1.GetUserRequest{})
+	require.NoError(t, err)
+	assert.False(t, internal)
+	assert.False(t, mobile)
+
+	_, err = Server(testRules())(handler)(tenantCtx(10, "user:mobile:view"), &identityV1.GetUserRequest{})
+	require.NoError(t, err)
+	assert.True(t, internal)
+	assert.True(t, mobile)
+	assert.False(t, email)
+}
+
+func TestServer_CheckWrite(t *testing.T) {
+	var called bool
+	handler := func(context.Context, interface{}) (interface{}, error) {
+		called = true
+		return nil, nil
+	}
+	h := Server(testRules())(handler)
+
+	update := func(paths ...string) *identityV1.UpdateUserRequest {
+		req := &identityV1.UpdateUserRequest{
+			Id:   2,
+			Data: &identityV1.User{Nickname: trans.Ptr("nick"), Status: trans.Ptr(identityV1.User_DISABLED)},
+		}
+		if paths != nil {
+			req.UpdateMask = &fieldmaskpb.FieldMask{Paths: paths}
+		}
+		return req
+	}
+
+	_, err := h(tenantCtx(10), update("nickname"))
+	assert.NoError(t, err)
+	assert.True(t, called)
+
+	called = false
+	_, err = h(tenantCtx(10), update("nickname", "status"))
+	assert.ErrorIs(t, err, ErrFieldWriteForbidden)
+	assert.False(t, called)
+
+	// 未指定 update_mask 时按已赋值字段判断
+	_, err = h(tenantCtx(10), update())
+	assert.ErrorIs(t, err, ErrFieldWriteForbidden)
+
+	// 安全管理员具备 user:status:edit
+	_, err = h(tenantCtx(10, "user:status:edit"), update("status"))
+	assert.NoError(t, err)
+
+	_, err = h(tenantCtx(10, "user:status:edit"), update("locked_until"))
+	assert.ErrorIs(t, err, ErrFieldWriteForbidden)
+
+	// 平台管理员同样需要字段权限码
+	_, err = h(platformCtx(), update("status"))
+	assert.ErrorIs(t, err, ErrFieldWriteForbidden)
+	_, err = h(platformCtx("user:status:edit"), update("status"))
+	assert.NoError(t, err)
+}
+
+func TestServer_CheckWriteUnchanged(t *testing.T) {
+	p := userProvider{current: &identityV1.User{Id: trans.Ptr(uint32(2)), Nickname: trans.Ptr("old"), Status: trans.Ptr(identityV1.User_NORMAL)}}
+	h := Server(p)(func(context.Context, interface{}) (interface{}, error) { return nil, nil })
+
+	// 管理端表单整体提交：update_mask 含 status，但值未变化
+	update := func(id uint32, status identityV1.User_Status) *identityV1.UpdateUserRequest {
+		return &identityV1.UpdateUserRequest{
+			Id:         id,
+			Data:       &identityV1.User{Nickname: trans.Ptr("new"), Status: trans.Ptr(status)},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"nickname", "status", "locked_until"}},
+		}
+	}
+
+	_, err := h(tenantCtx(10), update(2, identityV1.User_NORMAL))
+	assert.NoError(t, err)
+
+	// 值发生变化仍需 user:status:edit
+	_, err = h(tenantCtx(10), update(2, identityV1.User_DISABLED))
+	assert.ErrorIs(t, err, ErrFieldWriteForbidden)
+
+	_, err = h(tenantCtx(10, "user:status:edit"), update(2, identityV1.User_DISABLED))
+	assert.NoError(t, err)
+
+	// 无法加载当前值时按涉及的字段校验
+	_, err = h(tenantCtx(10), update(3, identityV1.User_NORMAL))
+	assert.ErrorIs(t, err, ErrFieldWriteForbidden)
+}
+
+func TestServer_MultipleProviders(t *testing.T) {
+	role := (&permissionV1.Role{}).ProtoReflect().Descriptor().FullName()
+	orgUnit := (&identityV1.OrgUnit{}).ProtoReflect().Descriptor().FullName()
+
+	h := Server(
+		testRules(),
+		Rules{{Message: role, Field: "data_scope_org_unit_ids", Write: true}},
+		Rules{{Message: orgUnit, Field: "tax_id", Read: true}},
+	)(func(_ context.Context, req interface{}) (interface{}, error) {
+		if _, ok := req.(*identityV1.GetOrgUnitRequest); ok {
+			return &identityV1.OrgUnit{
+				Name:     trans.Ptr("总部"),
+				TaxId:    trans.Ptr("91110000"),
+				Children: []*identityV1.OrgUnit{{Name: trans.Ptr("分部"), TaxId: trans.Ptr("91110001")}},
+			}, nil
+		}
+		return nil, nil
+	})
+
+	// 角色的列表字段同样按 update_mask 校验
+	update := &permissionV1.UpdateRoleRequest{
+		Id:         1,
+		Data:       &permissionV1.Role{Name: trans.Ptr("r"), DataScopeOrgUnitIds: []uint32{1, 2}},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"name", "data_scope_org_unit_ids"}},
+	}
+	_, err := h(tenantCtx(10), update)
+	assert.ErrorIs(t, err, ErrFieldWriteForbidden)
+	_, err = h(tenantCtx(10, "role:data-scope-org-unit-ids:edit"), update)
+	assert.NoError(t, err)
+
+	// 组织单元的读取规则作用于嵌套的子节点，无脱敏函数时清空字段
+	reply, err := h(tenantCtx(10), &identityV1.GetOrgUnitRequest{})
+	require.NoError(t, err)
+	ou := reply.(*identityV1.OrgUnit)
+	assert.Nil(t, ou.TaxId)
+	assert.Nil(t, ou.GetChildren()[0].TaxId)
+	assert.Equal(t, "分部", ou.GetChildren()[0].GetName())
+
+	reply, err = h(tenantCtx(10, "org-unit:tax-id:view"), &identityV1.GetOrgUnitRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, "91110001", reply.(*identityV1.OrgUnit).GetChildren()[0].GetTaxId())
+}
+
+func TestSameField_List(t *testing.T) {
+	fd := (&permissionV1.Role{}).ProtoReflect().Descriptor().Fields().ByName("data_scope_org_unit_ids")
+	a := &permissionV1.Role{DataScopeOrgUnitIds: []uint32{1, 2}}
+
+	assert.True(t, sameField(fd, a.ProtoReflect(), (&permissionV1.Role{DataScopeOrgUnitIds: []uint32{1, 2}}).ProtoReflect()))
+	assert.False(t, sameField(fd, a.ProtoReflect(), (&permissionV1.Role{DataScopeOrgUnitIds: []uint32{2, 1}}).ProtoReflect()))
+	assert.False(t, sameField(fd, a.ProtoReflect(), (&permissionV1.Role{}).ProtoReflect()))
+}
@@ -0,0 +1,71 @@
+package fieldperm
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"go-wind-admin/pkg/utils/converter"
+)
+
+// MaskFunc 字符串字段的脱敏函数
+type MaskFunc func(string) string
+
+// Rule 字段级读写权限规则。
+// 权限码按 resource:field:action 生成（如 User.mobile -> user:mobile:view / user:mobile:edit），
+// 与角色绑定的权限码一致，由 Viewer.HasPermission 判定。
+type Rule struct {
+	Message protoreflect.FullName // 消息全名，如 identity.service.v1.User
+	Field   protoreflect.Name     // 字段名（proto 名），如 mobile
+
+	Read  bool // 读取受限：无 view 权限时按 Mask 脱敏
+	Write bool // 写入受限：无 edit 权限时拒绝更新该字段
+
+	Mask MaskFunc // 为空或字段非字符串时清空字段
+}
+
+// resource 字段对应的权限资源，如 User:mobile
+func (r Rule) resource() string {
+	return string(r.Message.Name()) + ":" + string(r.Field)
+}
+
+// ReadPermission 读取字段所需的权限码
+func (r Rule) ReadPermission() string {
+	return converter.PermissionCode("view", r.resource())
+}
+
+// WritePermission 写入字段所需的权限码
+func (r Rule) WritePermission() string {
+	return converter.PermissionCode("edit", r.resource())
+}
+
+// MaskMobile 保留前 3 位与后 4 位，如 138****8000
+func MaskMobile(s string) string {
+	return maskMiddle(s, 3, 4)
+}
+
+// MaskEmail 保留本地部分前 2 位与域名，如 ad***@example.com
+func MaskEmail(s string) string {
+	at := strings.LastIndex(s, "@")
+	if at < 0 {
+		return maskMiddle(s, 2, 0)
+	}
+	return maskMiddle(s[:at], 2, 0) + s[at:]
+}
+
+// MaskName 仅保留首字，如 张**
+func MaskName(s string) string {
+	return maskMiddle(s, 1, 0)
+}
+
+// maskMiddle 按字符保留首尾，其余替换为 *
+func maskMiddle(s string, keepFirst, keepLast int) string {
+	n := utf8.RuneCountInString(s)
+	if n <= keepFirst+keepLast {
+		return s
+	}
+
+	runes := []rune(s)
+	return string(runes[:keepFirst]) + strings.Repeat("*", n-keepFirst-keepLast) + string(runes[n-keepLast:])
+}
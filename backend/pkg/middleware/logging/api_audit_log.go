@@ -63,6 +63,8 @@ func (a *ApiAuditLogMiddleware) Handle(ctx context.Context, htr *http.Transport,
 		apiAuditLog.UserId = trans.Ptr(ut.UserId)
 		apiAuditLog.TenantId = ut.TenantId
 		apiAuditLog.Username = ut.Username
+		apiAuditLog.ImpersonatorId = ut.ActorUserId
+		apiAuditLog.ImpersonatorName = ut.ActorUsername
 	}
 
 	// 地理位置
@@ -11,4 +11,9 @@
 	HeaderKeyXForwardedFor  = "X-Forwarded-For"
 	HeaderKeyXRealIP        = "X-Real-IP"
 	HeaderKeyXClientIP      = "X-Client-IP"
+
+	// HeaderKeyXAuditRisk 处理器标记的高风险事件（值为风险因素），由登录审计读取后移除，不回传客户端
+	HeaderKeyXAuditRisk = "X-Audit-Risk"
+	// HeaderKeyXAuditUserID 配合 HeaderKeyXAuditRisk 回传事件所属用户（请求未携带令牌时），同样不回传客户端
+	HeaderKeyXAuditUserID = "X-Audit-User-Id"
 )
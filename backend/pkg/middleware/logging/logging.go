@@ -19,6 +19,8 @@ func Server(opts ...Option) middleware.Middleware {
 			// MFA 登录挑战验证也按登录事件审计：它是登录流程的二次验证阶段，
 			// 审计 schema 已预埋 mfa_status / Status.PARTIAL 等字段支持此语义。
 			adminV1.OperationMfaServiceVerifyMFAChallenge,
+			// 通行密钥无密码登录：单步完成身份确认与多因素验证
+			adminV1.OperationMfaServiceFinishPasskeyLogin,
 		},
 		logoutOperation: adminV1.OperationAuthenticationServiceLogout,
 	}
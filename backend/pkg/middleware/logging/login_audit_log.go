@@ -9,6 +9,7 @@
 	"encoding/json"
 	"fmt"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -53,7 +54,15 @@ func (l *LoginAuditLogMiddleware) Handle(ctx context.Context, htr *http.Transpor
 			break
 		}
 	}
-	if !isLoginOp && htr.Operation() != l.op.logoutOperation {
+	// 处理器标记的高风险事件（如刷新令牌重放）无论来自哪个接口都进登录审计
+	auditRisk := htr.ReplyHeader().Get(HeaderKeyXAuditRisk)
+	auditUserID := htr.ReplyHeader().Get(HeaderKeyXAuditUserID)
+	if w := htr.Response(); w != nil {
+		w.Header().Del(HeaderKeyXAuditRisk)
+		w.Header().Del(HeaderKeyXAuditUserID)
+	}
+
+	if !isLoginOp && htr.Operation() != l.op.logoutOperation && auditRisk == "" {
 		return
 	}
 
@@ -64,15 +73,17 @@ func (l *LoginAuditLogMiddleware) Handle(ctx context.Context, htr *http.Transpor
 	loginAuditLog := &auditV1.LoginAuditLog{}
 
 	switch {
-	case isLoginOp:
-		loginAuditLog.ActionType = trans.Ptr(auditV1.LoginAuditLog_LOGIN)
 	case htr.Operation() == l.op.logoutOperation:
 		loginAuditLog.ActionType = trans.Ptr(auditV1.LoginAuditLog_LOGOUT)
+	default:
+		loginAuditLog.ActionType = trans.Ptr(auditV1.LoginAuditLog_LOGIN)
 	}
 
 	// MFA 验证事件：填充 mfa_status（proto 预定义 VERIFIED/FAILED 语义，供
-	// 风险因子计算——此前全链路无人填充，MFA 相关风险因子从未生效）
-	if htr.Operation() == adminV1.OperationMfaServiceVerifyMFAChallenge {
+	// 风险因子计算——此前全链路无人填充，MFA 相关风险因子从未生效）。
+	// 通行密钥登录本身即多因素验证（持有凭证 + 用户验证），按同一语义填充。
+	if htr.Operation() == adminV1.OperationMfaServiceVerifyMFAChallenge ||
+		htr.Operation() == adminV1.OperationMfaServiceFinishPasskeyLogin {
 		if success {
 			loginAuditLog.MfaStatus = trans.Ptr("VERIFIED")
 		} else {
@@ -103,6 +114,11 @@ func (l *LoginAuditLogMiddleware) Handle(ctx context.Context, htr *http.Transpor
 			loginAuditLog.Username = ut.Username
 		}
 	}
+	if loginAuditLog.UserId == nil && auditUserID != "" {
+		if uid, err := strconv.ParseUint(auditUserID, 10, 32); err == nil {
+			loginAuditLog.UserId = trans.Ptr(uint32(uid))
+		}
+	}
 
 	// 免鉴权接口（如 MFA 验证）请求体无 username 也无 token：以上两路都取不到，
 	// 用 handler 通过响应头回传的挑战上下文用户名兜底。
@@ -129,8 +145,18 @@ func (l *LoginAuditLogMiddleware) Handle(ctx context.Context, htr *http.Transpor
 	loginAuditLog.RiskScore = trans.Ptr(riskScore)
 	loginAuditLog.RiskLevel = trans.Ptr(l.levelFromScore(riskScore))
 
+	// 处理器标记的高风险事件直接定为最高风险
+	if auditRisk != "" {
+		loginAuditLog.RiskScore = trans.Ptr(uint32(100))
+		loginAuditLog.RiskLevel = trans.Ptr(auditV1.LoginAuditLog_HIGH)
+	}
+
 	// 计算风险因素
 	loginAuditLog.RiskFactors = l.computeRiskFactors(loginAuditLog)
+	if auditRisk != "" {
+		loginAuditLog.RiskFactors = append(loginAuditLog.RiskFactors, auditRisk)
+		sort.Strings(loginAuditLog.RiskFactors)
+	}
 
 	// 计算哈希和签名
 	loginAuditLog.LogHash = trans.Ptr(l.hashLog(loginAuditLog))
@@ -298,6 +324,8 @@ func (l *LoginAuditLogMiddleware) levelFromScore(score uint32) auditV1.LoginAudi
 	RiskFactorHighRiskScore    = "HIGH_RISK_SCORE"
 	RiskFactorMediumRiskScore  = "MEDIUM_RISK_SCORE"
 	RiskFactorLowRiskScore     = "LOW_RISK_SCORE"
+
+	RiskFactorRefreshTokenReuse = "REFRESH_TOKEN_REUSE"
 )
 
 // computeRiskFactors 基于 LoginAuditLog 的若干字段，使用无状态启发式规则返回风险因素列表（去重、排序）。
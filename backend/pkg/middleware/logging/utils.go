@@ -328,6 +328,23 @@ func fillGeoLocation(clientIp string) (info *auditV1.GeoLocation) {
 	return
 }
 
+// LookupGeoLocation 查询 IP 的地理位置，空 IP、内网地址或查询失败时返回 nil。
+func LookupGeoLocation(clientIp string) *auditV1.GeoLocation {
+	if clientIp == "" || isPrivateIP(clientIp) {
+		return nil
+	}
+	result := clientIpToLocation(clientIp)
+	if result == nil {
+		return nil
+	}
+	return &auditV1.GeoLocation{
+		CountryCode: trans.Ptr(result.Country),
+		Province:    trans.Ptr(result.Province),
+		City:        trans.Ptr(result.City),
+		Isp:         trans.Ptr(result.ISP),
+	}
+}
+
 // isPrivateIP 检查 IP 是否属于常见内网或链路本地地址
 func isPrivateIP(ipStr string) bool {
 	ip := net.ParseIP(strings.TrimSpace(ipStr))
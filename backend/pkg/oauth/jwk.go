@@ -0,0 +1,100 @@
+package oauth
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/tx7do/go-utils/trans"
+
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+)
+
+// jwkUseSignature JWK 用途：签名校验
+const jwkUseSignature = "sig"
+
+// PublicKeyToJWK 将签名公钥转换为 JWK（RFC 7517）。
+// kid 为空时取 RFC 7638 JWK 指纹，同一公钥在多实例间得到相同的 kid。
+func PublicKeyToJWK(pub crypto.PublicKey, alg, kid string) (*authenticationV1.JsonWebKey, error) {
+	jwk := &authenticationV1.JsonWebKey{
+		Use: jwkUseSignature,
+		Alg: alg,
+	}
+
+	switch k := pub.(type) {
+	case *rsa.PublicKey:
+		jwk.Kty = "RSA"
+		jwk.N = trans.Ptr(base64URL(k.N.Bytes()))
+		jwk.E = trans.Ptr(base64URL(big.NewInt(int64(k.E)).Bytes()))
+
+	case *ecdsa.PublicKey:
+		crv, size, err := ecCurve(k.Curve)
+		if err != nil {
+			return nil, err
+		}
+		jwk.Kty = "EC"
+		jwk.Crv = trans.Ptr(crv)
+		// 坐标按曲线长度左侧补零（RFC 7518 §6.2.1.2）
+		jwk.X = trans.Ptr(base64URL(k.X.FillBytes(make([]byte, size))))
+		jwk.Y = trans.Ptr(base64URL(k.Y.FillBytes(make([]byte, size))))
+
+	case ed25519.PublicKey:
+		jwk.Kty = "OKP"
+		jwk.Crv = trans.Ptr("Ed25519")
+		jwk.X = trans.Ptr(base64URL(k))
+
+	default:
+		return nil, fmt.Errorf("unsupported public key type %T", pub)
+	}
+
+	if kid == "" {
+		kid = JWKThumbprint(jwk)
+	}
+	jwk.Kid = kid
+
+	return jwk, nil
+}
+
+// JWKThumbprint 计算 JWK 指纹（RFC 7638）：按字典序拼接必需成员后取 SHA-256。
+func JWKThumbprint(jwk *authenticationV1.JsonWebKey) string {
+	var members map[string]string
+	switch jwk.GetKty() {
+	case "RSA":
+		members = map[string]string{"e": jwk.GetE(), "kty": jwk.GetKty(), "n": jwk.GetN()}
+	case "EC":
+		members = map[string]string{"crv": jwk.GetCrv(), "kty": jwk.GetKty(), "x": jwk.GetX(), "y": jwk.GetY()}
+	case "OKP":
+		members = map[string]string{"crv": jwk.GetCrv(), "kty": jwk.GetKty(), "x": jwk.GetX()}
+	default:
+		return ""
+	}
+
+	// encoding/json 对 map 键按字典序输出，且不含多余空白，满足 RFC 7638 的规范化要求
+	raw, _ := json.Marshal(members)
+	sum := sha256.Sum256(raw)
+	return base64URL(sum[:])
+}
+
+func ecCurve(curve elliptic.Curve) (name string, size int, err error) {
+	switch curve {
+	case elliptic.P256():
+		return "P-256", 32, nil
+	case elliptic.P384():
+		return "P-384", 48, nil
+	case elliptic.P521():
+		return "P-521", 66, nil
+	default:
+		return "", 0, fmt.Errorf("unsupported elliptic curve %s", curve.Params().Name)
+	}
+}
+
+func base64URL(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
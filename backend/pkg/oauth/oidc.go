@@ -0,0 +1,158 @@
+package oauth
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"hash"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tx7do/go-utils/trans"
+
+	authn "github.com/tx7do/kratos-authn/engine"
+
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+	identityV1 "go-wind-admin/api/gen/go/identity/service/v1"
+)
+
+// ID 令牌专有声明（OpenID Connect Core 1.0 §2）
+const (
+	ClaimFieldNonce           = "nonce"
+	ClaimFieldAuthorizedParty = "azp"
+	ClaimFieldAccessTokenHash = "at_hash"
+)
+
+// IDTokenParams 签发 ID 令牌所需的上下文。
+type IDTokenParams struct {
+	Issuer      string
+	ClientID    string
+	Nonce       string
+	AccessToken string // 非空时写入 at_hash
+	Alg         string
+	Scopes      []string
+	User        *identityV1.User
+	IssuedAt    time.Time
+	ExpiresAt   time.Time
+}
+
+// HasScope 判断授权范围列表中是否包含指定范围。
+func HasScope(scopes []string, scope string) bool {
+	for _, s := range scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// SupportedScopes 返回全部可登记的授权范围（身份类范围 + 各业务模块的全量/只读范围），用于发现文档。
+func SupportedScopes() []string {
+	scopes := []string{ScopeOpenID, ScopeProfile, ScopeEmail}
+
+	modules := make([]int, 0, len(identityV1.Module_name))
+	for v := range identityV1.Module_name {
+		if identityV1.Module(v) != identityV1.Module_MODULE_UNSPECIFIED {
+			modules = append(modules, int(v))
+		}
+	}
+	sort.Ints(modules)
+
+	for _, v := range modules {
+		name := strings.ToLower(identityV1.Module(v).String())
+		scopes = append(scopes, name, name+readScopeSuffix)
+	}
+	return scopes
+}
+
+// NewUserInfo 按授权范围裁剪用户身份声明。scopes 为空（第一方令牌）时返回全部声明。
+func NewUserInfo(user *identityV1.User, scopes []string) *authenticationV1.OidcUserInfo {
+	info := &authenticationV1.OidcUserInfo{
+		Sub:      Subject(user.GetId()),
+		TenantId: user.TenantId,
+	}
+
+	unrestricted := len(scopes) == 0
+
+	if unrestricted || HasScope(scopes, ScopeProfile) {
+		info.PreferredUsername = user.Username
+		info.Nickname = user.Nickname
+		info.Name = user.Realname
+		if user.Avatar != nil && user.GetAvatar() != "" {
+			info.Picture = user.Avatar
+		}
+		if user.UpdatedAt != nil {
+			info.UpdatedAt = trans.Ptr(user.GetUpdatedAt().AsTime().Unix())
+		}
+	}
+
+	if (unrestricted || HasScope(scopes, ScopeEmail)) && user.GetEmail() != "" {
+		info.Email = user.Email
+	}
+
+	return info
+}
+
+// Subject 用户在 ID 令牌/用户信息中的 sub：取不可变的用户 ID，而非可修改的用户名。
+func Subject(userID uint32) string {
+	return strconv.FormatUint(uint64(userID), 10)
+}
+
+// NewIDTokenClaims 构造 ID 令牌声明。aud 为客户端 ID，
+// 因此 ID 令牌不会被当作访问令牌接受（访问令牌不携带 aud，见 Authenticator.Authenticate）。
+func NewIDTokenClaims(p *IDTokenParams) authn.AuthClaims {
+	claims := authn.AuthClaims{
+		authn.ClaimFieldIssuer:         p.Issuer,
+		authn.ClaimFieldSubject:        Subject(p.User.GetId()),
+		authn.ClaimFieldAudience:       p.ClientID,
+		authn.ClaimFieldIssuedAt:       p.IssuedAt.Unix(),
+		authn.ClaimFieldExpirationTime: p.ExpiresAt.Unix(),
+		ClaimFieldAuthorizedParty:      p.ClientID,
+	}
+
+	if p.Nonce != "" {
+		claims[ClaimFieldNonce] = p.Nonce
+	}
+	if p.AccessToken != "" {
+		if atHash := AccessTokenHash(p.Alg, p.AccessToken); atHash != "" {
+			claims[ClaimFieldAccessTokenHash] = atHash
+		}
+	}
+
+	info := NewUserInfo(p.User, p.Scopes)
+	setClaim := func(name string, v *string) {
+		if v != nil && *v != "" {
+			claims[name] = *v
+		}
+	}
+	setClaim("preferred_username", info.PreferredUsername)
+	setClaim("nickname", info.Nickname)
+	setClaim("name", info.Name)
+	setClaim("picture", info.Picture)
+	setClaim("email", info.Email)
+	if info.UpdatedAt != nil {
+		claims["updated_at"] = info.GetUpdatedAt()
+	}
+
+	return claims
+}
+
+// AccessTokenHash 计算 at_hash：取签名算法对应哈希的左半部分再 base64url 编码。
+// 未知算法返回空串（at_hash 在授权码模式下为可选声明）。
+func AccessTokenHash(alg, accessToken string) string {
+	var h hash.Hash
+	switch strings.ToUpper(alg) {
+	case "RS256", "PS256", "ES256", "HS256":
+		h = sha256.New()
+	case "RS384", "PS384", "ES384", "HS384":
+		h = sha512.New384()
+	case "RS512", "PS512", "ES512", "HS512", "EDDSA":
+		h = sha512.New()
+	default:
+		return ""
+	}
+	h.Write([]byte(accessToken))
+	sum := h.Sum(nil)
+	return base64URL(sum[:len(sum)/2])
+}
@@ -0,0 +1,114 @@
+package oauth
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tx7do/go-utils/trans"
+
+	authn "github.com/tx7do/kratos-authn/engine"
+
+	identityV1 "go-wind-admin/api/gen/go/identity/service/v1"
+)
+
+func TestPublicKeyToJWK_RSAThumbprint(t *testing.T) {
+	// RFC 7638 §3.1 的示例密钥与指纹
+	n := "0vx7agoebGcQSuuPiLJXZptN9nndrQmbXEps2aiAFbWhM78LhWx4cbbfAAtVT86zwu1RK7aPFFxuhDR1L6tSoc_BJECPebWKRXjBZCiFV4n3oknjhMstn64tZ_2W-5JsGY4Hc5n9yBXArwl93lqt7_RN5w6Cf0h4QyQ5v-65YGjQR0_FDW2QvzqY368QQMicAtaSqzs8KJZgnYb9c7d0zgdAZHzu6qMQvRL5hajrn1n91CbOpbISD08qNLyrdkt-bFTWhAI4vMQFh6WeZu0fM4lFd2NcRwr3XPksINHaQ-G_xBniIqbw0Ls1jF44-csFCur-kEgU8awapJzKnqDKgw"
+	nBytes, err := base64.RawURLEncoding.DecodeString(n)
+	require.NoError(t, err)
+
+	pub := &rsa.PublicKey{N: new(big.Int).SetBytes(nBytes), E: 65537}
+
+	jwk, err := PublicKeyToJWK(pub, "RS256", "")
+	require.NoError(t, err)
+	assert.Equal(t, "RSA", jwk.GetKty())
+	assert.Equal(t, "sig", jwk.GetUse())
+	assert.Equal(t, "RS256", jwk.GetAlg())
+	assert.Equal(t, n, jwk.GetN())
+	assert.Equal(t, "AQAB", jwk.GetE())
+	assert.Equal(t, "NzbLsXh8uDCcd-6MNwXF4W_7noWXFZAfHkxZsRGC9Xs", jwk.GetKid())
+
+	// 显式 kid 优先
+	jwk, err = PublicKeyToJWK(pub, "RS256", "key-1")
+	require.NoError(t, err)
+	assert.Equal(t, "key-1", jwk.GetKid())
+}
+
+func TestPublicKeyToJWK_EC(t *testing.T) {
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	jwk, err := PublicKeyToJWK(&priv.PublicKey, "ES256", "")
+	require.NoError(t, err)
+	assert.Equal(t, "EC", jwk.GetKty())
+	assert.Equal(t, "P-256", jwk.GetCrv())
+	assert.Len(t, jwk.GetX(), 43) // 32 字节坐标的 base64url 长度
+	assert.Len(t, jwk.GetY(), 43)
+	assert.NotEmpty(t, jwk.GetKid())
+
+	_, err = PublicKeyToJWK("not-a-key", "RS256", "")
+	assert.Error(t, err)
+}
+
+func TestAccessTokenHash(t *testing.T) {
+	sum := sha256.Sum256([]byte("access-token"))
+	assert.Equal(t, base64.RawURLEncoding.EncodeToString(sum[:16]), AccessTokenHash("RS256", "access-token"))
+	assert.Empty(t, AccessTokenHash("none", "access-token"))
+}
+
+func TestNewUserInfo_ScopeFiltering(t *testing.T) {
+	user := &identityV1.User{
+		Id:       trans.Ptr(uint32(42)),
+		TenantId: trans.Ptr(uint32(7)),
+		Username: trans.Ptr("alice"),
+		Realname: trans.Ptr("Alice"),
+		Email:    trans.Ptr("alice@example.com"),
+	}
+
+	info := NewUserInfo(user, []string{ScopeOpenID})
+	assert.Equal(t, "42", info.GetSub())
+	assert.Nil(t, info.PreferredUsername)
+	assert.Nil(t, info.Email)
+
+	info = NewUserInfo(user, []string{ScopeOpenID, ScopeEmail})
+	assert.Equal(t, "alice@example.com", info.GetEmail())
+	assert.Nil(t, info.Name)
+
+	// 第一方令牌不受范围限制
+	info = NewUserInfo(user, nil)
+	assert.Equal(t, "alice", info.GetPreferredUsername())
+	assert.Equal(t, "Alice", info.GetName())
+	assert.Equal(t, "alice@example.com", info.GetEmail())
+}
+
+func TestNewIDTokenClaims(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	claims := NewIDTokenClaims(&IDTokenParams{
+		Issuer:      "https://id.example.com",
+		ClientID:    "gwa_client",
+		Nonce:       "n-0S6_WzA2Mj",
+		AccessToken: "access-token",
+		Alg:         "RS256",
+		Scopes:      []string{ScopeOpenID, ScopeProfile},
+		User:        &identityV1.User{Id: trans.Ptr(uint32(42)), Username: trans.Ptr("alice"), Email: trans.Ptr("alice@example.com")},
+		IssuedAt:    now,
+		ExpiresAt:   now.Add(time.Hour),
+	})
+
+	assert.Equal(t, "https://id.example.com", claims[authn.ClaimFieldIssuer])
+	assert.Equal(t, "42", claims[authn.ClaimFieldSubject])
+	assert.Equal(t, "gwa_client", claims[authn.ClaimFieldAudience])
+	assert.Equal(t, "n-0S6_WzA2Mj", claims[ClaimFieldNonce])
+	assert.Equal(t, AccessTokenHash("RS256", "access-token"), claims[ClaimFieldAccessTokenHash])
+	assert.Equal(t, "alice", claims["preferred_username"])
+	assert.NotContains(t, claims, "email")
+}
@@ -0,0 +1,42 @@
+package oauth
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVerifyCodeChallenge(t *testing.T) {
+	// RFC 7636 附录 B 的示例
+	verifier := "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"
+	challenge := "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM"
+
+	assert.Equal(t, challenge, S256CodeChallenge(verifier))
+	assert.True(t, IsValidCodeChallenge(challenge))
+	assert.True(t, VerifyCodeChallenge(verifier, challenge, CodeChallengeMethodS256))
+
+	assert.False(t, VerifyCodeChallenge(verifier, challenge, "plain"))
+	assert.False(t, VerifyCodeChallenge(verifier+"x", challenge, CodeChallengeMethodS256))
+	assert.False(t, VerifyCodeChallenge("short", S256CodeChallenge("short"), CodeChallengeMethodS256))
+}
+
+func TestIsValidCodeVerifier(t *testing.T) {
+	assert.True(t, IsValidCodeVerifier(strings.Repeat("a", 43)))
+	assert.True(t, IsValidCodeVerifier(strings.Repeat("Az0-._~", 18)))
+	assert.False(t, IsValidCodeVerifier(strings.Repeat("a", 42)))
+	assert.False(t, IsValidCodeVerifier(strings.Repeat("a", 129)))
+	assert.False(t, IsValidCodeVerifier(strings.Repeat("a", 42)+"+"))
+	assert.False(t, IsValidCodeChallenge("not-a-challenge"))
+}
+
+func TestNewCodeVerifier(t *testing.T) {
+	v1, err := NewCodeVerifier()
+	assert.NoError(t, err)
+	assert.True(t, IsValidCodeVerifier(v1))
+
+	v2, err := NewCodeVerifier()
+	assert.NoError(t, err)
+	assert.NotEqual(t, v1, v2)
+	assert.True(t, VerifyCodeChallenge(v1, S256CodeChallenge(v1), CodeChallengeMethodS256))
+}
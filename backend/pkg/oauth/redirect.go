@@ -0,0 +1,54 @@
+package oauth
+
+import (
+	"net"
+	"net/url"
+)
+
+// IsValidRedirectURI 校验登记的回调地址：必须是绝对地址、不含片段（RFC 6749 §3.1.2）；
+// 除回环地址（本地开发/原生应用）外只允许 https，避免授权码经明文链路泄露。
+func IsValidRedirectURI(raw string) bool {
+	u, err := url.Parse(raw)
+	if err != nil || !u.IsAbs() || u.Host == "" || u.Fragment != "" || u.User != nil {
+		return false
+	}
+
+	switch u.Scheme {
+	case "https":
+		return true
+	case "http":
+		host := u.Hostname()
+		if host == "localhost" {
+			return true
+		}
+		ip := net.ParseIP(host)
+		return ip != nil && ip.IsLoopback()
+	default:
+		return false
+	}
+}
+
+// MatchRedirectURI 判断请求的回调地址是否与登记地址之一完全一致（不做前缀/通配匹配）。
+func MatchRedirectURI(requested string, registered []string) bool {
+	for _, r := range registered {
+		if r == requested {
+			return true
+		}
+	}
+	return false
+}
+
+// BuildRedirectURL 在回调地址上追加 code/state 查询参数，保留其原有查询参数。
+func BuildRedirectURL(redirectURI, code, state string) (string, error) {
+	u, err := url.Parse(redirectURI)
+	if err != nil {
+		return "", err
+	}
+	q := u.Query()
+	q.Set("code", code)
+	if state != "" {
+		q.Set("state", state)
+	}
+	u.RawQuery = q.Encode()
+	return u.String(), nil
+}
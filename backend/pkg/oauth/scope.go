@@ -0,0 +1,148 @@
+package oauth
+
+import (
+	"net/http"
+	"strings"
+
+	identityV1 "go-wind-admin/api/gen/go/identity/service/v1"
+
+	"go-wind-admin/pkg/constants"
+)
+
+// 身份类授权范围（与 OpenID Connect 保持一致的命名）
+const (
+	ScopeOpenID  = "openid"
+	ScopeProfile = "profile"
+	ScopeEmail   = "email"
+)
+
+// 业务模块授权范围：取 identityV1.Module 的小写名，如 "opm" 授予组织人员模块全部操作，
+// "opm:read" 仅授予该模块的只读（GET/HEAD）操作。模块归属沿用 constants.ServiceTagToBusinessModule。
+const readScopeSuffix = ":read"
+
+// 带授权范围的令牌始终允许的操作（令牌自身的生命周期管理）
+var alwaysAllowedOperations = map[string]bool{
+	"AuthenticationService/Logout":       true,
+	"AuthenticationService/RefreshToken": true,
+}
+
+// 身份类范围可访问的服务（只读）
+var identityScopeServices = map[string]bool{
+	"UserProfileService": true,
+}
+
+// openid 范围可访问的操作
+var openIDScopeOperations = map[string]bool{
+	"OidcService/UserInfo": true,
+}
+
+// ParseScope 解析空格分隔的 scope 字符串，去重并保持原有顺序。
+func ParseScope(scope string) []string {
+	fields := strings.Fields(scope)
+	if len(fields) == 0 {
+		return nil
+	}
+	seen := make(map[string]struct{}, len(fields))
+	out := make([]string, 0, len(fields))
+	for _, f := range fields {
+		if _, ok := seen[f]; ok {
+			continue
+		}
+		seen[f] = struct{}{}
+		out = append(out, f)
+	}
+	return out
+}
+
+// JoinScope 将授权范围列表拼接为空格分隔的字符串。
+func JoinScope(scopes []string) string {
+	return strings.Join(scopes, " ")
+}
+
+// IsValidScope 判断授权范围是否属于已知词表（身份类范围或业务模块范围）。
+func IsValidScope(scope string) bool {
+	switch scope {
+	case ScopeOpenID, ScopeProfile, ScopeEmail:
+		return true
+	}
+	name := strings.TrimSuffix(scope, readScopeSuffix)
+	v, ok := identityV1.Module_value[strings.ToUpper(name)]
+	return ok && identityV1.Module(v) != identityV1.Module_MODULE_UNSPECIFIED && name == strings.ToLower(name)
+}
+
+// IsSubset 判断 requested 中的每个范围都在 allowed 内。
+func IsSubset(requested, allowed []string) bool {
+	set := make(map[string]struct{}, len(allowed))
+	for _, a := range allowed {
+		set[a] = struct{}{}
+	}
+	for _, r := range requested {
+		if _, ok := set[r]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// ServiceMethodFromOperation 从 Kratos operation（如 "/admin.service.v1.UserService/List"）
+// 解析出服务短名与方法名。
+func ServiceMethodFromOperation(operation string) (service, method string) {
+	operation = strings.TrimPrefix(operation, "/")
+	idx := strings.LastIndex(operation, "/")
+	if idx < 0 {
+		return "", ""
+	}
+	service, method = operation[:idx], operation[idx+1:]
+	if dot := strings.LastIndex(service, "."); dot >= 0 {
+		service = service[dot+1:]
+	}
+	return service, method
+}
+
+// ScopeAllows 判断带授权范围的令牌能否调用指定操作。
+// scopes 为空表示第一方令牌，不受范围限制；httpMethod 为空（非 HTTP 传输）时只认模块全量范围。
+func ScopeAllows(scopes []string, operation, httpMethod string) bool {
+	if len(scopes) == 0 {
+		return true
+	}
+
+	service, method := ServiceMethodFromOperation(operation)
+	if service == "" {
+		return false
+	}
+	if alwaysAllowedOperations[service+"/"+method] {
+		return true
+	}
+
+	readOnly := httpMethod == http.MethodGet || httpMethod == http.MethodHead
+
+	module, hasModule := constants.ServiceTagToBusinessModule[service]
+	moduleScope := strings.ToLower(module.String())
+
+	for _, s := range scopes {
+		switch s {
+		case ScopeProfile, ScopeEmail:
+			if readOnly && identityScopeServices[service] {
+				return true
+			}
+			continue
+		case ScopeOpenID:
+			if openIDScopeOperations[service+"/"+method] {
+				return true
+			}
+			continue
+		}
+
+		if !hasModule || module == identityV1.Module_MODULE_UNSPECIFIED {
+			continue
+		}
+		if s == moduleScope {
+			return true
+		}
+		if readOnly && s == moduleScope+readScopeSuffix {
+			return true
+		}
+	}
+
+	return false
+}
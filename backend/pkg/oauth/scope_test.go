@@ -0,0 +1,71 @@
+package oauth
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseScope(t *testing.T) {
+	assert.Nil(t, ParseScope("   "))
+	assert.Equal(t, []string{"openid", "opm:read", "log"}, ParseScope(" openid opm:read  openid log "))
+}
+
+func TestIsValidScope(t *testing.T) {
+	for _, s := range []string{"openid", "profile", "email", "opm", "opm:read", "internal_message", "log:read"} {
+		assert.True(t, IsValidScope(s), s)
+	}
+	for _, s := range []string{"", "OPM", "module_unspecified", "opm:write", "unknown", "opm:read:read"} {
+		assert.False(t, IsValidScope(s), s)
+	}
+}
+
+func TestIsSubset(t *testing.T) {
+	assert.True(t, IsSubset([]string{"openid"}, []string{"openid", "opm"}))
+	assert.True(t, IsSubset(nil, []string{"openid"}))
+	assert.False(t, IsSubset([]string{"opm"}, []string{"opm:read"}))
+}
+
+func TestServiceMethodFromOperation(t *testing.T) {
+	svc, m := ServiceMethodFromOperation("/admin.service.v1.UserService/List")
+	assert.Equal(t, "UserService", svc)
+	assert.Equal(t, "List", m)
+
+	svc, m = ServiceMethodFromOperation("garbage")
+	assert.Empty(t, svc)
+	assert.Empty(t, m)
+}
+
+func TestScopeAllows(t *testing.T) {
+	const listUsers = "/admin.service.v1.UserService/List"
+	const createUser = "/admin.service.v1.UserService/Create"
+
+	// 第一方令牌不受限制
+	assert.True(t, ScopeAllows(nil, createUser, http.MethodPost))
+
+	// 模块全量范围
+	assert.True(t, ScopeAllows([]string{"opm"}, createUser, http.MethodPost))
+	// 只读范围只放行 GET/HEAD
+	assert.True(t, ScopeAllows([]string{"opm:read"}, listUsers, http.MethodGet))
+	assert.False(t, ScopeAllows([]string{"opm:read"}, createUser, http.MethodPost))
+	// 非 HTTP 传输无法判断读写，只读范围不放行
+	assert.False(t, ScopeAllows([]string{"opm:read"}, listUsers, ""))
+	// 其他模块的范围不放行
+	assert.False(t, ScopeAllows([]string{"log"}, listUsers, http.MethodGet))
+
+	// 身份类范围只放行个人资料只读
+	assert.True(t, ScopeAllows([]string{"profile"}, "/admin.service.v1.UserProfileService/GetUser", http.MethodGet))
+	assert.False(t, ScopeAllows([]string{"profile"}, "/admin.service.v1.UserProfileService/UpdateUser", http.MethodPut))
+	assert.False(t, ScopeAllows([]string{"openid"}, listUsers, http.MethodGet))
+
+	// openid 放行用户信息端点
+	assert.True(t, ScopeAllows([]string{"openid"}, "/admin.service.v1.OidcService/UserInfo", http.MethodGet))
+	assert.False(t, ScopeAllows([]string{"profile"}, "/admin.service.v1.OidcService/UserInfo", http.MethodGet))
+
+	// 令牌生命周期操作始终放行
+	assert.True(t, ScopeAllows([]string{"openid"}, "/admin.service.v1.AuthenticationService/Logout", http.MethodPost))
+
+	// 未登记模块的服务一律拒绝
+	assert.False(t, ScopeAllows([]string{"system"}, "/admin.service.v1.UnknownService/List", http.MethodGet))
+}
@@ -0,0 +1,213 @@
+package social
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// maxResponseBytes 提供方响应体读取上限，防止异常响应占满内存。
+const maxResponseBytes = 1 << 20
+
+// profileMapper 将用户信息接口返回的 JSON 映射为 Profile。
+type profileMapper func(claims map[string]any) *Profile
+
+// oauth2Provider 标准 OAuth2 授权码模式提供方：表单换取令牌、Bearer 拉取用户信息。
+// GitHub、Google、Microsoft 与通用 OIDC 均基于它，差异只在默认端点与字段映射。
+type oauth2Provider struct {
+	name   string
+	cfg    Config
+	scopes []string
+	pkce   bool
+
+	mapProfile profileMapper
+
+	// afterProfile 用户信息补全钩子（如 GitHub 私密邮箱需额外接口获取）
+	afterProfile func(ctx context.Context, p *oauth2Provider, token *Token, profile *Profile)
+}
+
+func (p *oauth2Provider) Name() string {
+	return p.name
+}
+
+func (p *oauth2Provider) AuthCodeURL(state, codeChallenge, redirectURL string) string {
+	v := url.Values{}
+	v.Set("response_type", "code")
+	v.Set("client_id", p.cfg.ClientID)
+	v.Set("redirect_uri", firstNonEmpty(redirectURL, p.cfg.RedirectURL))
+	v.Set("state", state)
+	if len(p.scopes) > 0 {
+		v.Set("scope", strings.Join(p.scopes, " "))
+	}
+	if p.pkce && codeChallenge != "" {
+		v.Set("code_challenge", codeChallenge)
+		v.Set("code_challenge_method", "S256")
+	}
+	return appendQuery(p.cfg.AuthURL, v)
+}
+
+func (p *oauth2Provider) Exchange(ctx context.Context, code, codeVerifier, redirectURL string) (*Token, error) {
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", firstNonEmpty(redirectURL, p.cfg.RedirectURL))
+	form.Set("client_id", p.cfg.ClientID)
+	form.Set("client_secret", p.cfg.ClientSecret)
+	if p.pkce && codeVerifier != "" {
+		form.Set("code_verifier", codeVerifier)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.cfg.TokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	// GitHub 默认以表单格式返回令牌，显式声明 JSON
+	req.Header.Set("Accept", "application/json")
+
+	var body struct {
+		AccessToken      string          `json:"access_token"`
+		RefreshToken     string          `json:"refresh_token"`
+		TokenType        string          `json:"token_type"`
+		Scope            string          `json:"scope"`
+		IDToken          string          `json:"id_token"`
+		ExpiresIn        json.RawMessage `json:"expires_in"`
+		Error            string          `json:"error"`
+		ErrorDescription string          `json:"error_description"`
+	}
+	if err = doJSON(p.cfg.httpClient(), req, &body); err != nil {
+		return nil, fmt.Errorf("%s token exchange: %w", p.name, err)
+	}
+	if body.Error != "" {
+		return nil, fmt.Errorf("%s token exchange: %s %s", p.name, body.Error, body.ErrorDescription)
+	}
+	if body.AccessToken == "" {
+		return nil, fmt.Errorf("%s token exchange: empty access token", p.name)
+	}
+
+	return &Token{
+		AccessToken:  body.AccessToken,
+		RefreshToken: body.RefreshToken,
+		TokenType:    body.TokenType,
+		Scope:        body.Scope,
+		IDToken:      body.IDToken,
+		ExpiresIn:    parseExpiresIn(body.ExpiresIn),
+	}, nil
+}
+
+func (p *oauth2Provider) FetchProfile(ctx context.Context, token *Token) (*Profile, error) {
+	var claims map[string]any
+	if err := p.getJSON(ctx, p.cfg.UserInfoURL, token, &claims); err != nil {
+		return nil, fmt.Errorf("%s userinfo: %w", p.name, err)
+	}
+
+	profile := p.mapProfile(claims)
+	if profile == nil || profile.ID == "" {
+		return nil, ErrEmptyProfile
+	}
+
+	if p.afterProfile != nil {
+		p.afterProfile(ctx, p, token, profile)
+	}
+
+	return profile, nil
+}
+
+func (p *oauth2Provider) getJSON(ctx context.Context, endpoint string, token *Token, out any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+token.AccessToken)
+	req.Header.Set("Accept", "application/json")
+	return doJSON(p.cfg.httpClient(), req, out)
+}
+
+// mapOIDCProfile 按 OpenID Connect 标准声明映射（Google、Microsoft、通用 OIDC）。
+func mapOIDCProfile(claims map[string]any) *Profile {
+	return &Profile{
+		ID:            claimString(claims, "sub"),
+		Username:      firstNonEmpty(claimString(claims, "preferred_username"), claimString(claims, "email")),
+		Name:          claimString(claims, "name"),
+		Email:         claimString(claims, "email"),
+		EmailVerified: claimBool(claims, "email_verified"),
+		Avatar:        claimString(claims, "picture"),
+	}
+}
+
+// doJSON 发送请求并解析 JSON 响应；非 2xx 状态码视为失败。
+func doJSON(client *http.Client, req *http.Request, out any) error {
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	raw, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseBytes))
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status %d: %s", resp.StatusCode, truncate(string(raw), 256))
+	}
+	if err = json.Unmarshal(raw, out); err != nil {
+		return fmt.Errorf("decode response: %w", err)
+	}
+	return nil
+}
+
+// appendQuery 向端点追加查询参数，兼容端点自带查询串的情况。
+func appendQuery(endpoint string, v url.Values) string {
+	sep := "?"
+	if strings.Contains(endpoint, "?") {
+		sep = "&"
+	}
+	return endpoint + sep + v.Encode()
+}
+
+// parseExpiresIn 兼容数字与字符串两种 expires_in 格式（部分提供方以字符串返回）。
+func parseExpiresIn(raw json.RawMessage) int64 {
+	if len(raw) == 0 {
+		return 0
+	}
+	s := strings.Trim(string(raw), `"`)
+	n, _ := strconv.ParseInt(s, 10, 64)
+	return n
+}
+
+// claimString 读取字符串声明；数字 ID（如 GitHub 的 id）按整数格式化。
+func claimString(claims map[string]any, key string) string {
+	switch v := claims[key].(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case json.Number:
+		return v.String()
+	default:
+		return ""
+	}
+}
+
+func claimBool(claims map[string]any, key string) bool {
+	switch v := claims[key].(type) {
+	case bool:
+		return v
+	case string:
+		return v == "true"
+	default:
+		return false
+	}
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n]
+}
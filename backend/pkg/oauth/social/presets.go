@@ -0,0 +1,114 @@
+package social
+
+import (
+	"context"
+	"strings"
+)
+
+// NewGitHub GitHub OAuth App / GitHub App 用户授权。
+// 未公开邮箱的账号 /user 返回的 email 为空，另调 /user/emails 取已验证的主邮箱（需 user:email 授权范围）。
+func NewGitHub(cfg Config) (Provider, error) {
+	withDefaults(&cfg,
+		"https://github.com/login/oauth/authorize",
+		"https://github.com/login/oauth/access_token",
+		"https://api.github.com/user",
+	)
+	return newOAuth2Provider(ProviderGitHub, cfg, []string{"read:user", "user:email"}, true,
+		func(claims map[string]any) *Profile {
+			return &Profile{
+				ID:       claimString(claims, "id"),
+				Username: claimString(claims, "login"),
+				Name:     claimString(claims, "name"),
+				Email:    claimString(claims, "email"),
+				Avatar:   claimString(claims, "avatar_url"),
+			}
+		},
+		githubPrimaryEmail,
+	)
+}
+
+// githubPrimaryEmail 补全 GitHub 已验证主邮箱；接口失败不影响登录，只是资料里没有邮箱。
+func githubPrimaryEmail(ctx context.Context, p *oauth2Provider, token *Token, profile *Profile) {
+	if profile.Email != "" {
+		return
+	}
+
+	var emails []struct {
+		Email    string `json:"email"`
+		Primary  bool   `json:"primary"`
+		Verified bool   `json:"verified"`
+	}
+	if err := p.getJSON(ctx, strings.TrimSuffix(p.cfg.UserInfoURL, "/")+"/emails", token, &emails); err != nil {
+		return
+	}
+	for _, e := range emails {
+		if e.Primary && e.Verified {
+			profile.Email = e.Email
+			profile.EmailVerified = true
+			return
+		}
+	}
+}
+
+// NewGoogle Google 账号登录（OpenID Connect）。
+func NewGoogle(cfg Config) (Provider, error) {
+	withDefaults(&cfg,
+		"https://accounts.google.com/o/oauth2/v2/auth",
+		"https://oauth2.googleapis.com/token",
+		"https://openidconnect.googleapis.com/v1/userinfo",
+	)
+	return newOAuth2Provider(ProviderGoogle, cfg, []string{"openid", "email", "profile"}, true, mapOIDCProfile, nil)
+}
+
+// NewMicrosoft Microsoft 账号 / Entra ID 登录（OpenID Connect，common 端点同时接受个人与组织账号）。
+// 限定单一组织时把端点中的 common 换成租户 ID。
+func NewMicrosoft(cfg Config) (Provider, error) {
+	withDefaults(&cfg,
+		"https://login.microsoftonline.com/common/oauth2/v2.0/authorize",
+		"https://login.microsoftonline.com/common/oauth2/v2.0/token",
+		"https://graph.microsoft.com/oidc/userinfo",
+	)
+	return newOAuth2Provider(ProviderMicrosoft, cfg, []string{"openid", "email", "profile"}, true, mapOIDCProfile, nil)
+}
+
+// NewOIDC 通用 OpenID Connect 提供方，无默认端点。
+func NewOIDC(cfg Config) (Provider, error) {
+	if cfg.AuthURL == "" || cfg.TokenURL == "" || cfg.UserInfoURL == "" {
+		return nil, ErrInvalidConfig
+	}
+	return newOAuth2Provider(ProviderOIDC, cfg, []string{"openid", "email", "profile"}, true, mapOIDCProfile, nil)
+}
+
+func newOAuth2Provider(
+	name string,
+	cfg Config,
+	defaultScopes []string,
+	pkce bool,
+	mapProfile profileMapper,
+	afterProfile func(ctx context.Context, p *oauth2Provider, token *Token, profile *Profile),
+) (Provider, error) {
+	if cfg.ClientID == "" {
+		return nil, ErrInvalidConfig
+	}
+
+	scopes := cfg.Scopes
+	if len(scopes) == 0 {
+		scopes = defaultScopes
+	}
+
+	return &oauth2Provider{
+		name:         name,
+		cfg:          cfg,
+		scopes:       scopes,
+		pkce:         pkce,
+		mapProfile:   mapProfile,
+		afterProfile: afterProfile,
+	}, nil
+}
+
+// withDefaults 为未显式配置的端点填充默认值。
+func withDefaults(cfg *Config, authURL, tokenURL, userInfoURL string) {
+	cfg.AuthURL = firstNonEmpty(cfg.AuthURL, authURL)
+	cfg.TokenURL = firstNonEmpty(cfg.TokenURL, tokenURL)
+	cfg.UserInfoURL = firstNonEmpty(cfg.UserInfoURL, userInfoURL)
+}
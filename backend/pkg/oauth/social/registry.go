@@ -0,0 +1,69 @@
+package social
+
+import (
+	"sort"
+	"sync"
+)
+
+// Factory 按配置构造提供方实例。
+type Factory func(cfg Config) (Provider, error)
+
+// Registry 提供方注册表。预置 GitHub、Google、Microsoft、钉钉、微信与通用 OIDC，
+// 其他平台实现 Provider 后通过 Register 接入。
+type Registry struct {
+	mu        sync.RWMutex
+	factories map[string]Factory
+}
+
+// NewRegistry 创建带全部预置提供方的注册表。
+func NewRegistry() *Registry {
+	r := &Registry{factories: make(map[string]Factory)}
+
+	r.Register(ProviderGitHub, NewGitHub)
+	r.Register(ProviderGoogle, NewGoogle)
+	r.Register(ProviderMicrosoft, NewMicrosoft)
+	r.Register(ProviderDingTalk, NewDingTalk)
+	r.Register(ProviderWeChat, NewWeChat)
+	r.Register(ProviderOIDC, NewOIDC)
+
+	return r
+}
+
+// Register 注册（或替换）提供方。
+func (r *Registry) Register(name string, factory Factory) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.factories[name] = factory
+}
+
+// Has 是否已注册该提供方。
+func (r *Registry) Has(name string) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	_, ok := r.factories[name]
+	return ok
+}
+
+// Names 已注册的提供方名称（按字母序）。
+func (r *Registry) Names() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	names := make([]string, 0, len(r.factories))
+	for name := range r.factories {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// New 按名称与配置构造提供方实例。
+func (r *Registry) New(name string, cfg Config) (Provider, error) {
+	r.mu.RLock()
+	factory, ok := r.factories[name]
+	r.mu.RUnlock()
+	if !ok {
+		return nil, ErrUnsupportedProvider
+	}
+	return factory(cfg)
+}
@@ -0,0 +1,229 @@
+package social
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// mockOAuthServer 本地模拟 OAuth 服务：按路径模拟各平台的令牌与用户信息接口。
+func mockOAuthServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	writeJSON := func(w http.ResponseWriter, v any) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(v)
+	}
+
+	mux := http.NewServeMux()
+
+	// 标准 OAuth2 表单换取令牌（GitHub / OIDC）
+	mux.HandleFunc("/oauth/token", func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+		if r.Form.Get("code") != "good-code" || r.Form.Get("client_secret") != "secret" {
+			writeJSON(w, map[string]string{"error": "invalid_grant", "error_description": "bad code"})
+			return
+		}
+		if r.Form.Get("code_verifier") != "verifier" {
+			writeJSON(w, map[string]string{"error": "invalid_grant", "error_description": "pkce failed"})
+			return
+		}
+		writeJSON(w, map[string]any{"access_token": "at-1", "token_type": "bearer", "expires_in": "3600"})
+	})
+	mux.HandleFunc("/github/user", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer at-1" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		writeJSON(w, map[string]any{"id": 583231, "login": "octocat", "name": "The Octocat", "email": nil})
+	})
+	mux.HandleFunc("/github/user/emails", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, []map[string]any{
+			{"email": "old@example.com", "primary": false, "verified": true},
+			{"email": "octocat@example.com", "primary": true, "verified": true},
+		})
+	})
+	mux.HandleFunc("/oidc/userinfo", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, map[string]any{"sub": "1108", "email": "alice@example.com", "email_verified": true, "name": "Alice"})
+	})
+
+	// 钉钉：JSON 换取令牌 + 自定义令牌请求头
+	mux.HandleFunc("/dingtalk/token", func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]string
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		if body["code"] != "good-code" || body["grantType"] != "authorization_code" {
+			w.WriteHeader(http.StatusBadRequest)
+			writeJSON(w, map[string]string{"code": "invalidAuthCode", "message": "bad code"})
+			return
+		}
+		writeJSON(w, map[string]any{"accessToken": "dt-1", "expireIn": 7200})
+	})
+	mux.HandleFunc("/dingtalk/me", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("x-acs-dingtalk-access-token") != "dt-1" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		writeJSON(w, map[string]any{"nick": "张三", "openId": "open-1", "unionId": "union-1"})
+	})
+
+	// 微信：GET 换取令牌，业务错误为 HTTP 200 + errcode
+	mux.HandleFunc("/wechat/token", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("code") != "good-code" {
+			writeJSON(w, map[string]any{"errcode": 40029, "errmsg": "invalid code"})
+			return
+		}
+		writeJSON(w, map[string]any{"access_token": "wx-1", "expires_in": 7200, "openid": "wx-open-1"})
+	})
+	mux.HandleFunc("/wechat/userinfo", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("openid") != "wx-open-1" {
+			writeJSON(w, map[string]any{"errcode": 40003, "errmsg": "invalid openid"})
+			return
+		}
+		writeJSON(w, map[string]any{"openid": "wx-open-1", "nickname": "李四", "headimgurl": "https://img/1"})
+	})
+
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestGitHub_ExchangeAndProfile(t *testing.T) {
+	srv := mockOAuthServer(t)
+	ctx := context.Background()
+
+	p, err := NewGitHub(Config{
+		ClientID:     "cid",
+		ClientSecret: "secret",
+		AuthURL:      srv.URL + "/authorize",
+		TokenURL:     srv.URL + "/oauth/token",
+		UserInfoURL:  srv.URL + "/github/user",
+		RedirectURL:  "https://admin.example.com/oauth/callback",
+	})
+	assert.NoError(t, err)
+
+	authURL, err := url.Parse(p.AuthCodeURL("st-1", "challenge", ""))
+	assert.NoError(t, err)
+	q := authURL.Query()
+	assert.Equal(t, "cid", q.Get("client_id"))
+	assert.Equal(t, "st-1", q.Get("state"))
+	assert.Equal(t, "https://admin.example.com/oauth/callback", q.Get("redirect_uri"))
+	assert.Equal(t, "challenge", q.Get("code_challenge"))
+	assert.Equal(t, "S256", q.Get("code_challenge_method"))
+	assert.Equal(t, "read:user user:email", q.Get("scope"))
+
+	token, err := p.Exchange(ctx, "good-code", "verifier", "")
+	assert.NoError(t, err)
+	assert.Equal(t, "at-1", token.AccessToken)
+	assert.Equal(t, int64(3600), token.ExpiresIn)
+
+	profile, err := p.FetchProfile(ctx, token)
+	assert.NoError(t, err)
+	assert.Equal(t, "583231", profile.ID)
+	assert.Equal(t, "octocat", profile.Username)
+	// 私密邮箱经 /user/emails 补全为已验证主邮箱
+	assert.Equal(t, "octocat@example.com", profile.Email)
+	assert.True(t, profile.EmailVerified)
+
+	_, err = p.Exchange(ctx, "bad-code", "verifier", "")
+	assert.ErrorContains(t, err, "invalid_grant")
+
+	_, err = p.Exchange(ctx, "good-code", "wrong-verifier", "")
+	assert.ErrorContains(t, err, "pkce failed")
+}
+
+func TestOIDC_RequiresEndpoints(t *testing.T) {
+	_, err := NewOIDC(Config{ClientID: "cid"})
+	assert.ErrorIs(t, err, ErrInvalidConfig)
+
+	srv := mockOAuthServer(t)
+	p, err := NewOIDC(Config{
+		ClientID:     "cid",
+		ClientSecret: "secret",
+		AuthURL:      srv.URL + "/authorize",
+		TokenURL:     srv.URL + "/oauth/token",
+		UserInfoURL:  srv.URL + "/oidc/userinfo",
+	})
+	assert.NoError(t, err)
+
+	token, err := p.Exchange(context.Background(), "good-code", "verifier", "https://cb")
+	assert.NoError(t, err)
+	profile, err := p.FetchProfile(context.Background(), token)
+	assert.NoError(t, err)
+	assert.Equal(t, &Profile{ID: "1108", Username: "alice@example.com", Name: "Alice", Email: "alice@example.com", EmailVerified: true}, profile)
+}
+
+func TestDingTalk_ExchangeAndProfile(t *testing.T) {
+	srv := mockOAuthServer(t)
+	ctx := context.Background()
+
+	p, err := NewDingTalk(Config{
+		ClientID:     "ding-app",
+		ClientSecret: "secret",
+		TokenURL:     srv.URL + "/dingtalk/token",
+		UserInfoURL:  srv.URL + "/dingtalk/me",
+	})
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(p.AuthCodeURL("st", "", "https://cb"), "https://login.dingtalk.com/oauth2/auth?"))
+
+	token, err := p.Exchange(ctx, "good-code", "", "")
+	assert.NoError(t, err)
+
+	profile, err := p.FetchProfile(ctx, token)
+	assert.NoError(t, err)
+	assert.Equal(t, "union-1", profile.ID)
+	assert.Equal(t, "张三", profile.Name)
+
+	_, err = p.Exchange(ctx, "bad-code", "", "")
+	assert.ErrorContains(t, err, "invalidAuthCode")
+}
+
+func TestWeChat_ExchangeAndProfile(t *testing.T) {
+	srv := mockOAuthServer(t)
+	ctx := context.Background()
+
+	p, err := NewWeChat(Config{
+		ClientID:     "wx-app",
+		ClientSecret: "secret",
+		TokenURL:     srv.URL + "/wechat/token",
+		UserInfoURL:  srv.URL + "/wechat/userinfo",
+	})
+	assert.NoError(t, err)
+
+	authURL := p.AuthCodeURL("st", "", "https://cb")
+	assert.True(t, strings.HasSuffix(authURL, "#wechat_redirect"))
+	assert.Contains(t, authURL, "appid=wx-app")
+
+	token, err := p.Exchange(ctx, "good-code", "", "")
+	assert.NoError(t, err)
+	assert.Equal(t, "wx-open-1", token.OpenID)
+
+	profile, err := p.FetchProfile(ctx, token)
+	assert.NoError(t, err)
+	// 未绑定开放平台时没有 unionid，退回 openid
+	assert.Equal(t, "wx-open-1", profile.ID)
+	assert.Equal(t, "李四", profile.Name)
+
+	_, err = p.Exchange(ctx, "bad-code", "", "")
+	assert.ErrorContains(t, err, "errcode 40029")
+}
+
+func TestRegistry(t *testing.T) {
+	r := NewRegistry()
+	assert.Equal(t, []string{"dingtalk", "github", "google", "microsoft", "oidc", "wechat"}, r.Names())
+
+	p, err := r.New(ProviderGoogle, Config{ClientID: "cid"})
+	assert.NoError(t, err)
+	assert.Equal(t, ProviderGoogle, p.Name())
+
+	_, err = r.New("myspace", Config{ClientID: "cid"})
+	assert.ErrorIs(t, err, ErrUnsupportedProvider)
+
+	_, err = r.New(ProviderGitHub, Config{})
+	assert.ErrorIs(t, err, ErrInvalidConfig)
+}
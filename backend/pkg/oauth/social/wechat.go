@@ -0,0 +1,134 @@
+package social
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// weChatProvider 微信开放平台网站应用扫码登录。
+// 参数名为 appid/secret，令牌与用户信息接口均为 GET，业务错误以 HTTP 200 + errcode 返回。
+type weChatProvider struct {
+	cfg    Config
+	scopes []string
+}
+
+// weChatError 微信接口的业务错误字段。
+type weChatError struct {
+	ErrCode int    `json:"errcode"`
+	ErrMsg  string `json:"errmsg"`
+}
+
+func (e weChatError) err() error {
+	if e.ErrCode == 0 {
+		return nil
+	}
+	return fmt.Errorf("errcode %d: %s", e.ErrCode, e.ErrMsg)
+}
+
+// NewWeChat 微信登录。用户标识优先取 unionid（绑定开放平台后同一主体下各应用一致），缺失时退回 openid。
+func NewWeChat(cfg Config) (Provider, error) {
+	if cfg.ClientID == "" {
+		return nil, ErrInvalidConfig
+	}
+	withDefaults(&cfg,
+		"https://open.weixin.qq.com/connect/qrconnect",
+		"https://api.weixin.qq.com/sns/oauth2/access_token",
+		"https://api.weixin.qq.com/sns/userinfo",
+	)
+
+	scopes := cfg.Scopes
+	if len(scopes) == 0 {
+		scopes = []string{"snsapi_login"}
+	}
+	return &weChatProvider{cfg: cfg, scopes: scopes}, nil
+}
+
+func (p *weChatProvider) Name() string {
+	return ProviderWeChat
+}
+
+func (p *weChatProvider) AuthCodeURL(state, _, redirectURL string) string {
+	v := url.Values{}
+	v.Set("appid", p.cfg.ClientID)
+	v.Set("redirect_uri", firstNonEmpty(redirectURL, p.cfg.RedirectURL))
+	v.Set("response_type", "code")
+	v.Set("scope", strings.Join(p.scopes, ","))
+	v.Set("state", state)
+	// 微信要求以 #wechat_redirect 结尾
+	return appendQuery(p.cfg.AuthURL, v) + "#wechat_redirect"
+}
+
+func (p *weChatProvider) Exchange(ctx context.Context, code, _, _ string) (*Token, error) {
+	v := url.Values{}
+	v.Set("appid", p.cfg.ClientID)
+	v.Set("secret", p.cfg.ClientSecret)
+	v.Set("code", code)
+	v.Set("grant_type", "authorization_code")
+
+	var body struct {
+		weChatError
+		AccessToken  string `json:"access_token"`
+		RefreshToken string `json:"refresh_token"`
+		ExpiresIn    int64  `json:"expires_in"`
+		Scope        string `json:"scope"`
+		OpenID       string `json:"openid"`
+	}
+	if err := p.get(ctx, appendQuery(p.cfg.TokenURL, v), &body); err != nil {
+		return nil, fmt.Errorf("wechat token exchange: %w", err)
+	}
+	if err := body.err(); err != nil {
+		return nil, fmt.Errorf("wechat token exchange: %w", err)
+	}
+	if body.AccessToken == "" || body.OpenID == "" {
+		return nil, fmt.Errorf("wechat token exchange: empty access token")
+	}
+
+	return &Token{
+		AccessToken:  body.AccessToken,
+		RefreshToken: body.RefreshToken,
+		ExpiresIn:    body.ExpiresIn,
+		Scope:        body.Scope,
+		OpenID:       body.OpenID,
+	}, nil
+}
+
+func (p *weChatProvider) FetchProfile(ctx context.Context, token *Token) (*Profile, error) {
+	v := url.Values{}
+	v.Set("access_token", token.AccessToken)
+	v.Set("openid", token.OpenID)
+
+	var body struct {
+		weChatError
+		OpenID     string `json:"openid"`
+		UnionID    string `json:"unionid"`
+		Nickname   string `json:"nickname"`
+		HeadImgURL string `json:"headimgurl"`
+	}
+	if err := p.get(ctx, appendQuery(p.cfg.UserInfoURL, v), &body); err != nil {
+		return nil, fmt.Errorf("wechat userinfo: %w", err)
+	}
+	if err := body.err(); err != nil {
+		return nil, fmt.Errorf("wechat userinfo: %w", err)
+	}
+
+	profile := &Profile{
+		ID:     firstNonEmpty(body.UnionID, body.OpenID),
+		Name:   body.Nickname,
+		Avatar: body.HeadImgURL,
+	}
+	if profile.ID == "" {
+		return nil, ErrEmptyProfile
+	}
+	return profile, nil
+}
+
+func (p *weChatProvider) get(ctx context.Context, endpoint string, out any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return err
+	}
+	return doJSON(p.cfg.httpClient(), req, out)
+}
@@ -0,0 +1,79 @@
+package passwordpolicy
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// BreachedList 本地泄露密码库：密码 SHA-1 摘要集合，离线比对，不向外部服务发送任何数据。
+//
+// 文件格式与 Have I Been Pwned 的 Pwned Passwords 下载一致，每行一个十六进制 SHA-1，
+// 可带 ":出现次数" 后缀；空行与 # 开头的注释行忽略。整表载入内存（每条 20 字节），
+// 宜使用按出现次数截取的常见密码子集，而非完整数据集。
+type BreachedList struct {
+	hashes map[[sha1.Size]byte]struct{}
+}
+
+// ParseBreachedList 从 r 读取泄露密码库，遇到无法解析的行返回错误（带行号）。
+func ParseBreachedList(r io.Reader) (*BreachedList, error) {
+	l := &BreachedList{hashes: make(map[[sha1.Size]byte]struct{})}
+
+	scanner := bufio.NewScanner(r)
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if i := strings.IndexByte(line, ':'); i >= 0 {
+			line = line[:i]
+		}
+
+		var sum [sha1.Size]byte
+		if len(line) != hex.EncodedLen(sha1.Size) {
+			return nil, fmt.Errorf("passwordpolicy: breached list line %d: invalid sha1 length", lineNo)
+		}
+		if _, err := hex.Decode(sum[:], []byte(line)); err != nil {
+			return nil, fmt.Errorf("passwordpolicy: breached list line %d: %w", lineNo, err)
+		}
+		l.hashes[sum] = struct{}{}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("passwordpolicy: read breached list: %w", err)
+	}
+	return l, nil
+}
+
+// LoadBreachedList 从文件载入泄露密码库。
+func LoadBreachedList(path string) (*BreachedList, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("passwordpolicy: open breached list: %w", err)
+	}
+	defer f.Close()
+
+	return ParseBreachedList(f)
+}
+
+// Contains 密码是否在泄露库中。nil 列表恒返回 false。
+func (l *BreachedList) Contains(password string) bool {
+	if l == nil || password == "" {
+		return false
+	}
+	_, ok := l.hashes[sha1.Sum([]byte(password))]
+	return ok
+}
+
+// Len 库中摘要条数。
+func (l *BreachedList) Len() int {
+	if l == nil {
+		return 0
+	}
+	return len(l.hashes)
+}
@@ -0,0 +1,176 @@
+// Package passwordpolicy 密码策略规则：长度、字符类别、禁止子串、本地泄露密码库比对与有效期判定。
+//
+// 本包只做纯规则计算，不涉及存储；历史密码比对需要逐条做哈希校验，由调用方完成后以 RuleHistory 上报。
+package passwordpolicy
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// 规则代码，作为违规项的稳定标识返回给前端。
+const (
+	RuleMinLength       = "min_length"
+	RuleMaxLength       = "max_length"
+	RuleUppercase       = "uppercase"
+	RuleLowercase       = "lowercase"
+	RuleDigit           = "digit"
+	RuleSymbol          = "symbol"
+	RuleIdentity        = "identity"
+	RuleBannedSubstring = "banned_substring"
+	RuleHistory         = "history"
+	RuleBreached        = "breached"
+)
+
+const (
+	// MaxHistoryCount 历史密码条数上限：每条比对都是一次慢哈希校验。
+	MaxHistoryCount = 24
+	// MaxLength 密码长度上限，bcrypt 只取前 72 字节，过长的输入同时也是拒绝服务面。
+	MaxLength = 128
+
+	// minIdentityLen 用户名/租户代码短于此长度时不参与子串比对，避免误伤。
+	minIdentityLen = 3
+)
+
+// Policy 密码策略，零值表示除长度上限（MaxLength）外不做任何限制。
+type Policy struct {
+	MinLength        int
+	RequireUppercase bool
+	RequireLowercase bool
+	RequireDigit     bool
+	RequireSymbol    bool
+	// DisallowIdentity 禁止包含用户名与租户代码（不区分大小写）
+	DisallowIdentity bool
+	// BannedSubstrings 禁止包含的子串（不区分大小写）
+	BannedSubstrings []string
+	// HistoryCount 禁止重复使用最近 N 次密码（含当前密码）
+	HistoryCount int
+	// MaxAge 密码有效期，0 不过期
+	MaxAge        time.Duration
+	CheckBreached bool
+}
+
+// Subject 被校验密码的归属信息，用于身份子串规则。
+type Subject struct {
+	Username   string
+	TenantCode string
+}
+
+// Violation 一条违规项。
+type Violation struct {
+	Rule    string
+	Message string
+}
+
+// Merge 逐项取较严格者：长度与历史条数取大，布尔规则取或，禁止子串取并集，有效期取非零最小值。
+func (p Policy) Merge(o Policy) Policy {
+	out := Policy{
+		MinLength:        max(p.MinLength, o.MinLength),
+		RequireUppercase: p.RequireUppercase || o.RequireUppercase,
+		RequireLowercase: p.RequireLowercase || o.RequireLowercase,
+		RequireDigit:     p.RequireDigit || o.RequireDigit,
+		RequireSymbol:    p.RequireSymbol || o.RequireSymbol,
+		DisallowIdentity: p.DisallowIdentity || o.DisallowIdentity,
+		HistoryCount:     max(p.HistoryCount, o.HistoryCount),
+		MaxAge:           p.MaxAge,
+		CheckBreached:    p.CheckBreached || o.CheckBreached,
+	}
+	if o.MaxAge > 0 && (out.MaxAge == 0 || o.MaxAge < out.MaxAge) {
+		out.MaxAge = o.MaxAge
+	}
+
+	seen := make(map[string]bool, len(p.BannedSubstrings)+len(o.BannedSubstrings))
+	for _, s := range append(append([]string{}, p.BannedSubstrings...), o.BannedSubstrings...) {
+		key := strings.ToLower(s)
+		if key == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		out.BannedSubstrings = append(out.BannedSubstrings, s)
+	}
+	return out
+}
+
+// Check 校验除历史密码外的全部规则，返回全部违规项（无违规返回 nil）。
+// breached 为 nil 时跳过泄露库比对。
+func (p Policy) Check(password string, subject Subject, breached *BreachedList) []Violation {
+	var violations []Violation
+	add := func(rule, format string, args ...any) {
+		violations = append(violations, Violation{Rule: rule, Message: fmt.Sprintf(format, args...)})
+	}
+
+	length := utf8.RuneCountInString(password)
+	if p.MinLength > 0 && length < p.MinLength {
+		add(RuleMinLength, "must be at least %d characters", p.MinLength)
+	}
+	if length > MaxLength {
+		add(RuleMaxLength, "must be at most %d characters", MaxLength)
+	}
+
+	var hasUpper, hasLower, hasDigit, hasSymbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			hasUpper = true
+		case unicode.IsLower(r):
+			hasLower = true
+		case unicode.IsDigit(r):
+			hasDigit = true
+		case unicode.IsPunct(r) || unicode.IsSymbol(r):
+			hasSymbol = true
+		}
+	}
+	if p.RequireUppercase && !hasUpper {
+		add(RuleUppercase, "must contain an uppercase letter")
+	}
+	if p.RequireLowercase && !hasLower {
+		add(RuleLowercase, "must contain a lowercase letter")
+	}
+	if p.RequireDigit && !hasDigit {
+		add(RuleDigit, "must contain a digit")
+	}
+	if p.RequireSymbol && !hasSymbol {
+		add(RuleSymbol, "must contain a symbol")
+	}
+
+	lower := strings.ToLower(password)
+	if p.DisallowIdentity {
+		for _, id := range []string{subject.Username, subject.TenantCode} {
+			if len(id) >= minIdentityLen && strings.Contains(lower, strings.ToLower(id)) {
+				add(RuleIdentity, "must not contain the username or tenant code")
+				break
+			}
+		}
+	}
+	for _, s := range p.BannedSubstrings {
+		if s != "" && strings.Contains(lower, strings.ToLower(s)) {
+			add(RuleBannedSubstring, "must not contain a banned word")
+			break
+		}
+	}
+
+	if p.CheckBreached && breached.Contains(password) {
+		add(RuleBreached, "has appeared in a data breach")
+	}
+
+	return violations
+}
+
+// HistoryViolation 历史密码重复时的违规项。
+func (p Policy) HistoryViolation() Violation {
+	return Violation{
+		Rule:    RuleHistory,
+		Message: fmt.Sprintf("must not reuse any of the last %d passwords", p.HistoryCount),
+	}
+}
+
+// Expired 密码是否已超过有效期。changedAt 为零值（从未记录修改时间）时视为未过期。
+func (p Policy) Expired(changedAt, now time.Time) bool {
+	if p.MaxAge <= 0 || changedAt.IsZero() {
+		return false
+	}
+	return now.Sub(changedAt) >= p.MaxAge
+}
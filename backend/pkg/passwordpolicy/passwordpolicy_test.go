@@ -0,0 +1,96 @@
+package passwordpolicy
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func rules(violations []Violation) []string {
+	out := make([]string, 0, len(violations))
+	for _, v := range violations {
+		out = append(out, v.Rule)
+	}
+	return out
+}
+
+func TestPolicy_Check(t *testing.T) {
+	p := Policy{
+		MinLength:        10,
+		RequireUppercase: true,
+		RequireLowercase: true,
+		RequireDigit:     true,
+		RequireSymbol:    true,
+		DisallowIdentity: true,
+		BannedSubstrings: []string{"Company"},
+	}
+	subject := Subject{Username: "alice", TenantCode: "acme"}
+
+	assert.Empty(t, p.Check("Tr0ub4dor&3x", subject, nil))
+	assert.Equal(t, []string{RuleMinLength, RuleUppercase, RuleDigit, RuleSymbol}, rules(p.Check("short", subject, nil)))
+	assert.Equal(t, []string{RuleIdentity}, rules(p.Check("xxALICE-2024x", subject, nil)))
+	assert.Equal(t, []string{RuleIdentity}, rules(p.Check("Acme#2024abcd", subject, nil)))
+	assert.Equal(t, []string{RuleBannedSubstring}, rules(p.Check("my-company-9X", subject, nil)))
+	assert.Equal(t, []string{RuleMaxLength}, rules(p.Check("Aa1!"+strings.Repeat("x", MaxLength), subject, nil)))
+
+	// 过短的身份信息不参与子串比对
+	assert.Empty(t, p.Check("Tr0ub4dor&3x", Subject{Username: "x"}, nil))
+
+	// 零值策略不做限制
+	assert.Empty(t, Policy{}.Check("a", subject, nil))
+}
+
+func TestPolicy_Merge(t *testing.T) {
+	platform := Policy{MinLength: 8, RequireDigit: true, BannedSubstrings: []string{"password"}, MaxAge: 90 * 24 * time.Hour}
+	tenant := Policy{MinLength: 12, RequireSymbol: true, BannedSubstrings: []string{"PASSWORD", "acme"}, HistoryCount: 5, MaxAge: 30 * 24 * time.Hour}
+
+	merged := platform.Merge(tenant)
+	assert.Equal(t, 12, merged.MinLength)
+	assert.True(t, merged.RequireDigit)
+	assert.True(t, merged.RequireSymbol)
+	assert.Equal(t, []string{"password", "acme"}, merged.BannedSubstrings)
+	assert.Equal(t, 5, merged.HistoryCount)
+	assert.Equal(t, 30*24*time.Hour, merged.MaxAge)
+
+	// 一方不过期时取另一方的有效期
+	assert.Equal(t, 90*24*time.Hour, platform.Merge(Policy{}).MaxAge)
+	assert.Equal(t, 90*24*time.Hour, Policy{}.Merge(platform).MaxAge)
+}
+
+func TestPolicy_Expired(t *testing.T) {
+	now := time.Now()
+	p := Policy{MaxAge: 24 * time.Hour}
+
+	assert.False(t, p.Expired(now.Add(-time.Hour), now))
+	assert.True(t, p.Expired(now.Add(-25*time.Hour), now))
+	assert.False(t, p.Expired(time.Time{}, now))
+	assert.False(t, Policy{}.Expired(now.Add(-1000*time.Hour), now))
+}
+
+func TestBreachedList(t *testing.T) {
+	sum := sha1.Sum([]byte("P@ssw0rd123"))
+	input := "# common passwords\n\n" + strings.ToUpper(hex.EncodeToString(sum[:])) + ":42\n" +
+		"5BAA61E4C9B93F3F0682250B6CF8331B7EE68FD8\n"
+
+	l, err := ParseBreachedList(strings.NewReader(input))
+	require.NoError(t, err)
+	assert.Equal(t, 2, l.Len())
+	assert.True(t, l.Contains("P@ssw0rd123"))
+	assert.True(t, l.Contains("password"))
+	assert.False(t, l.Contains("Tr0ub4dor&3x"))
+
+	p := Policy{CheckBreached: true}
+	assert.Equal(t, []string{RuleBreached}, rules(p.Check("password", Subject{}, l)))
+	assert.Empty(t, p.Check("password", Subject{}, nil))
+
+	var empty *BreachedList
+	assert.False(t, empty.Contains("password"))
+
+	_, err = ParseBreachedList(strings.NewReader("not-a-hash\n"))
+	assert.ErrorContains(t, err, "line 1")
+}
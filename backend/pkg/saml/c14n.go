@@ -0,0 +1,198 @@
+package saml
+
+import (
+	"bytes"
+	"sort"
+	"strings"
+
+	"github.com/beevik/etree"
+)
+
+// 规范化算法
+const (
+	algExcC14N = "http://www.w3.org/2001/10/xml-exc-c14n#"
+)
+
+// canonicalize 按排他规范化（Exclusive XML Canonicalization 1.0，不含注释）序列化 el 子树。
+// exclude 非空时跳过该子元素（enveloped-signature 变换）；inclusivePrefixes 为 InclusiveNamespaces PrefixList，
+// 其中 "#default" 表示默认命名空间。命名空间从 el 的祖先中解析，不要求 el 为文档根。
+func canonicalize(el, exclude *etree.Element, inclusivePrefixes []string) []byte {
+	inclusive := make(map[string]bool, len(inclusivePrefixes))
+	for _, p := range inclusivePrefixes {
+		if p == "#default" {
+			p = ""
+		}
+		inclusive[p] = true
+	}
+
+	var buf bytes.Buffer
+	writeCanonicalElement(&buf, el, exclude, map[string]string{}, inclusive)
+	return buf.Bytes()
+}
+
+type nsDecl struct {
+	prefix, uri string
+}
+
+type canonicalAttr struct {
+	uri, qname, local, value string
+}
+
+// lookupNamespace 在 el 及其祖先中查找前缀绑定的命名空间，"" 表示默认命名空间。
+func lookupNamespace(el *etree.Element, prefix string) (string, bool) {
+	if prefix == "xml" {
+		return nsXML, true
+	}
+	for e := el; e != nil; e = e.Parent() {
+		for _, a := range e.Attr {
+			if prefix == "" && a.Space == "" && a.Key == "xmlns" {
+				return a.Value, true
+			}
+			if prefix != "" && a.Space == "xmlns" && a.Key == prefix {
+				return a.Value, true
+			}
+		}
+	}
+	return "", false
+}
+
+func isNamespaceDecl(a *etree.Attr) bool {
+	return a.Space == "xmlns" || (a.Space == "" && a.Key == "xmlns")
+}
+
+func writeCanonicalElement(buf *bytes.Buffer, el, exclude *etree.Element, rendered map[string]string, inclusive map[string]bool) {
+	// 本元素可见使用的前缀：元素自身前缀与带前缀的属性
+	utilized := map[string]bool{el.Space: true}
+	for i := range el.Attr {
+		a := &el.Attr[i]
+		if !isNamespaceDecl(a) && a.Space != "" {
+			utilized[a.Space] = true
+		}
+	}
+	for p := range inclusive {
+		utilized[p] = true
+	}
+
+	next := make(map[string]string, len(rendered)+len(utilized))
+	for k, v := range rendered {
+		next[k] = v
+	}
+
+	var decls []nsDecl
+	for prefix := range utilized {
+		if prefix == "xml" {
+			continue
+		}
+		uri, _ := lookupNamespace(el, prefix)
+		prev, seen := rendered[prefix]
+		if prefix == "" {
+			// 默认命名空间为空时，只有祖先输出过非空默认命名空间才需要输出 xmlns=""
+			if uri == "" {
+				if seen && prev != "" {
+					decls = append(decls, nsDecl{prefix: "", uri: ""})
+					next[""] = ""
+				}
+				continue
+			}
+		} else if uri == "" {
+			continue
+		}
+		if !seen || prev != uri {
+			decls = append(decls, nsDecl{prefix: prefix, uri: uri})
+			next[prefix] = uri
+		}
+	}
+	sort.Slice(decls, func(i, j int) bool { return decls[i].prefix < decls[j].prefix })
+
+	attrs := make([]canonicalAttr, 0, len(el.Attr))
+	for i := range el.Attr {
+		a := &el.Attr[i]
+		if isNamespaceDecl(a) {
+			continue
+		}
+		var uri string
+		if a.Space != "" {
+			uri, _ = lookupNamespace(el, a.Space)
+		}
+		attrs = append(attrs, canonicalAttr{uri: uri, qname: a.FullKey(), local: a.Key, value: a.Value})
+	}
+	sort.Slice(attrs, func(i, j int) bool {
+		if attrs[i].uri != attrs[j].uri {
+			return attrs[i].uri < attrs[j].uri
+		}
+		return attrs[i].local < attrs[j].local
+	})
+
+	qname := el.FullTag()
+	buf.WriteByte('<')
+	buf.WriteString(qname)
+	for _, d := range decls {
+		if d.prefix == "" {
+			buf.WriteString(` xmlns="`)
+		} else {
+			buf.WriteString(` xmlns:`)
+			buf.WriteString(d.prefix)
+			buf.WriteString(`="`)
+		}
+		buf.WriteString(escapeAttrValue(d.uri))
+		buf.WriteByte('"')
+	}
+	for _, a := range attrs {
+		buf.WriteByte(' ')
+		buf.WriteString(a.qname)
+		buf.WriteString(`="`)
+		buf.WriteString(escapeAttrValue(a.value))
+		buf.WriteByte('"')
+	}
+	buf.WriteByte('>')
+
+	for _, child := range el.Child {
+		switch t := child.(type) {
+		case *etree.Element:
+			if t == exclude {
+				continue
+			}
+			writeCanonicalElement(buf, t, exclude, next, inclusive)
+		case *etree.CharData:
+			buf.WriteString(escapeText(t.Data))
+		case *etree.ProcInst:
+			buf.WriteString("<?")
+			buf.WriteString(t.Target)
+			if t.Inst != "" {
+				buf.WriteByte(' ')
+				buf.WriteString(t.Inst)
+			}
+			buf.WriteString("?>")
+		}
+		// 注释与 DTD 指令不参与规范化
+	}
+
+	buf.WriteString("</")
+	buf.WriteString(qname)
+	buf.WriteByte('>')
+}
+
+var (
+	textEscaper = strings.NewReplacer(
+		"&", "&amp;",
+		"<", "&lt;",
+		">", "&gt;",
+		"\r", "&#xD;",
+	)
+	attrEscaper = strings.NewReplacer(
+		"&", "&amp;",
+		"<", "&lt;",
+		`"`, "&quot;",
+		"\t", "&#x9;",
+		"\n", "&#xA;",
+		"\r", "&#xD;",
+	)
+)
+
+func escapeText(s string) string {
+	return textEscaper.Replace(s)
+}
+
+func escapeAttrValue(s string) string {
+	return attrEscaper.Replace(s)
+}
@@ -0,0 +1,198 @@
+package saml
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/subtle"
+	"crypto/x509"
+	"encoding/base64"
+	"math/big"
+	"strings"
+
+	_ "crypto/sha256"
+	_ "crypto/sha512"
+
+	"github.com/beevik/etree"
+)
+
+// XML-DSig 算法。只接受 SHA-256/SHA-512 系列：SHA-1 已可构造碰撞，不用于校验断言。
+const (
+	algEnvelopedSignature = "http://www.w3.org/2000/09/xmldsig#enveloped-signature"
+
+	algDigestSHA256 = "http://www.w3.org/2001/04/xmlenc#sha256"
+	algDigestSHA512 = "http://www.w3.org/2001/04/xmlenc#sha512"
+
+	algRSASHA256   = "http://www.w3.org/2001/04/xmldsig-more#rsa-sha256"
+	algRSASHA512   = "http://www.w3.org/2001/04/xmldsig-more#rsa-sha512"
+	algECDSASHA256 = "http://www.w3.org/2001/04/xmldsig-more#ecdsa-sha256"
+	algECDSASHA512 = "http://www.w3.org/2001/04/xmldsig-more#ecdsa-sha512"
+)
+
+var digestAlgorithms = map[string]crypto.Hash{
+	algDigestSHA256: crypto.SHA256,
+	algDigestSHA512: crypto.SHA512,
+}
+
+var signatureAlgorithms = map[string]crypto.Hash{
+	algRSASHA256:   crypto.SHA256,
+	algRSASHA512:   crypto.SHA512,
+	algECDSASHA256: crypto.SHA256,
+	algECDSASHA512: crypto.SHA512,
+}
+
+// childElement 返回 el 下第一个指定命名空间与本地名的直接子元素。
+func childElement(el *etree.Element, ns, tag string) *etree.Element {
+	for _, c := range el.ChildElements() {
+		if c.Tag == tag && c.NamespaceURI() == ns {
+			return c
+		}
+	}
+	return nil
+}
+
+// childElements 返回 el 下全部指定命名空间与本地名的直接子元素。
+func childElements(el *etree.Element, ns, tag string) []*etree.Element {
+	var out []*etree.Element
+	for _, c := range el.ChildElements() {
+		if c.Tag == tag && c.NamespaceURI() == ns {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+// signatureOf 返回 el 的 enveloped 签名元素（直接子元素 ds:Signature），没有时返回 nil。
+func signatureOf(el *etree.Element) *etree.Element {
+	return childElement(el, nsDSig, "Signature")
+}
+
+// inclusivePrefixList 读取规范化/变换元素下的 InclusiveNamespaces@PrefixList。
+func inclusivePrefixList(el *etree.Element) []string {
+	for _, c := range el.ChildElements() {
+		if c.Tag == "InclusiveNamespaces" && c.NamespaceURI() == algExcC14N {
+			return strings.Fields(c.SelectAttrValue("PrefixList", ""))
+		}
+	}
+	return nil
+}
+
+// verifyEnvelopedSignature 校验 el 上的 enveloped 签名：引用必须恰好指向 el 自身（URI="#<el.ID>"），
+// 因此通过校验的内容就是调用方随后读取的元素，签名包裹（XSW）攻击无从下手。
+func verifyEnvelopedSignature(el *etree.Element, certs []*x509.Certificate) error {
+	sig := signatureOf(el)
+	if sig == nil {
+		return ErrUnsigned
+	}
+
+	id := el.SelectAttrValue("ID", "")
+	if id == "" {
+		return ErrInvalidSignature
+	}
+
+	signedInfo := childElement(sig, nsDSig, "SignedInfo")
+	if signedInfo == nil {
+		return ErrInvalidSignature
+	}
+
+	c14nMethod := childElement(signedInfo, nsDSig, "CanonicalizationMethod")
+	if c14nMethod == nil || c14nMethod.SelectAttrValue("Algorithm", "") != algExcC14N {
+		return ErrUnsupportedAlgorithm
+	}
+
+	sigMethod := childElement(signedInfo, nsDSig, "SignatureMethod")
+	if sigMethod == nil {
+		return ErrInvalidSignature
+	}
+	sigAlg := sigMethod.SelectAttrValue("Algorithm", "")
+	sigHash, ok := signatureAlgorithms[sigAlg]
+	if !ok {
+		return ErrUnsupportedAlgorithm
+	}
+
+	refs := childElements(signedInfo, nsDSig, "Reference")
+	if len(refs) != 1 {
+		return ErrInvalidSignature
+	}
+	ref := refs[0]
+	if ref.SelectAttrValue("URI", "") != "#"+id {
+		return ErrInvalidSignature
+	}
+
+	// 变换只允许 enveloped-signature 与排他规范化，且必须包含排他规范化
+	var prefixes []string
+	var hasC14N bool
+	if transforms := childElement(ref, nsDSig, "Transforms"); transforms != nil {
+		for _, t := range childElements(transforms, nsDSig, "Transform") {
+			switch t.SelectAttrValue("Algorithm", "") {
+			case algEnvelopedSignature:
+			case algExcC14N:
+				hasC14N = true
+				prefixes = inclusivePrefixList(t)
+			default:
+				return ErrUnsupportedAlgorithm
+			}
+		}
+	}
+	if !hasC14N {
+		return ErrUnsupportedAlgorithm
+	}
+
+	digestMethod := childElement(ref, nsDSig, "DigestMethod")
+	digestValue := childElement(ref, nsDSig, "DigestValue")
+	if digestMethod == nil || digestValue == nil {
+		return ErrInvalidSignature
+	}
+	digestHash, ok := digestAlgorithms[digestMethod.SelectAttrValue("Algorithm", "")]
+	if !ok {
+		return ErrUnsupportedAlgorithm
+	}
+	expectedDigest, err := base64.StdEncoding.DecodeString(stripWhitespace(digestValue.Text()))
+	if err != nil {
+		return ErrInvalidSignature
+	}
+
+	h := digestHash.New()
+	h.Write(canonicalize(el, sig, prefixes))
+	if subtle.ConstantTimeCompare(h.Sum(nil), expectedDigest) != 1 {
+		return ErrInvalidSignature
+	}
+
+	sigValue := childElement(sig, nsDSig, "SignatureValue")
+	if sigValue == nil {
+		return ErrInvalidSignature
+	}
+	signature, err := base64.StdEncoding.DecodeString(stripWhitespace(sigValue.Text()))
+	if err != nil {
+		return ErrInvalidSignature
+	}
+
+	sh := sigHash.New()
+	sh.Write(canonicalize(signedInfo, nil, inclusivePrefixList(c14nMethod)))
+	hashed := sh.Sum(nil)
+
+	for _, cert := range certs {
+		if verifyWithKey(cert.PublicKey, sigHash, hashed, signature) {
+			return nil
+		}
+	}
+	return ErrInvalidSignature
+}
+
+// verifyWithKey 用证书公钥校验签名；XML-DSig 的 ECDSA 签名值为定长 r||s 拼接。
+func verifyWithKey(pub crypto.PublicKey, hash crypto.Hash, hashed, signature []byte) bool {
+	switch key := pub.(type) {
+	case *rsa.PublicKey:
+		return rsa.VerifyPKCS1v15(key, hash, hashed, signature) == nil
+	case *ecdsa.PublicKey:
+		if len(signature) == 0 || len(signature)%2 != 0 {
+			return false
+		}
+		half := len(signature) / 2
+		r := new(big.Int).SetBytes(signature[:half])
+		s := new(big.Int).SetBytes(signature[half:])
+		return ecdsa.Verify(key, hashed, r, s)
+	default:
+		return false
+	}
+}
@@ -0,0 +1,118 @@
+package saml
+
+import (
+	"bytes"
+	"crypto/x509"
+	"encoding/base64"
+
+	"github.com/beevik/etree"
+)
+
+// Metadata 生成 SP 元数据，供租户管理员导入 IdP。
+func (sp *ServiceProvider) Metadata() ([]byte, error) {
+	if sp.EntityID == "" || sp.ACSURL == "" {
+		return nil, ErrInvalidConfig
+	}
+
+	doc := etree.NewDocument()
+	doc.CreateProcInst("xml", `version="1.0" encoding="UTF-8"`)
+
+	ed := doc.CreateElement("md:EntityDescriptor")
+	ed.CreateAttr("xmlns:md", nsMetadata)
+	ed.CreateAttr("entityID", sp.EntityID)
+
+	sso := ed.CreateElement("md:SPSSODescriptor")
+	sso.CreateAttr("AuthnRequestsSigned", "false")
+	sso.CreateAttr("WantAssertionsSigned", "true")
+	sso.CreateAttr("protocolSupportEnumeration", nsProtocol)
+
+	if sp.NameIDFormat != "" {
+		sso.CreateElement("md:NameIDFormat").SetText(sp.NameIDFormat)
+	}
+
+	acs := sso.CreateElement("md:AssertionConsumerService")
+	acs.CreateAttr("Binding", BindingHTTPPOST)
+	acs.CreateAttr("Location", sp.ACSURL)
+	acs.CreateAttr("index", "0")
+	acs.CreateAttr("isDefault", "true")
+
+	doc.Indent(2)
+	return doc.WriteToBytes()
+}
+
+// IdPMetadata 从 IdP 元数据解析出的接入参数。
+type IdPMetadata struct {
+	EntityID     string
+	SSOURL       string
+	Certificates []*x509.Certificate
+}
+
+// ParseIdPMetadata 解析 IdP 元数据（EntityDescriptor，或取 EntitiesDescriptor 中第一个含 IDPSSODescriptor 的实体）。
+// 单点登录地址取 HTTP-Redirect 绑定；签名证书取 use 为 signing 或未声明 use 的 KeyDescriptor。
+func ParseIdPMetadata(raw []byte) (*IdPMetadata, error) {
+	if bytes.Contains(raw, []byte("<!DOCTYPE")) {
+		return nil, ErrDocumentTypeForbidden
+	}
+
+	doc := etree.NewDocument()
+	if err := doc.ReadFromBytes(raw); err != nil {
+		return nil, ErrInvalidMetadata
+	}
+	root := doc.Root()
+	if root == nil || root.NamespaceURI() != nsMetadata {
+		return nil, ErrInvalidMetadata
+	}
+
+	var entity, idp *etree.Element
+	switch root.Tag {
+	case "EntityDescriptor":
+		entity, idp = root, childElement(root, nsMetadata, "IDPSSODescriptor")
+	case "EntitiesDescriptor":
+		for _, ed := range childElements(root, nsMetadata, "EntityDescriptor") {
+			if d := childElement(ed, nsMetadata, "IDPSSODescriptor"); d != nil {
+				entity, idp = ed, d
+				break
+			}
+		}
+	}
+	if entity == nil || idp == nil {
+		return nil, ErrInvalidMetadata
+	}
+
+	md := &IdPMetadata{EntityID: entity.SelectAttrValue("entityID", "")}
+
+	for _, s := range childElements(idp, nsMetadata, "SingleSignOnService") {
+		if s.SelectAttrValue("Binding", "") == BindingHTTPRedirect {
+			md.SSOURL = s.SelectAttrValue("Location", "")
+			break
+		}
+	}
+
+	for _, kd := range childElements(idp, nsMetadata, "KeyDescriptor") {
+		if use := kd.SelectAttrValue("use", ""); use != "" && use != "signing" {
+			continue
+		}
+		keyInfo := childElement(kd, nsDSig, "KeyInfo")
+		if keyInfo == nil {
+			continue
+		}
+		for _, data := range childElements(keyInfo, nsDSig, "X509Data") {
+			for _, c := range childElements(data, nsDSig, "X509Certificate") {
+				der, err := base64.StdEncoding.DecodeString(stripWhitespace(c.Text()))
+				if err != nil {
+					return nil, ErrInvalidCertificate
+				}
+				cert, err := x509.ParseCertificate(der)
+				if err != nil {
+					return nil, ErrInvalidCertificate
+				}
+				md.Certificates = append(md.Certificates, cert)
+			}
+		}
+	}
+
+	if md.EntityID == "" || md.SSOURL == "" || len(md.Certificates) == 0 {
+		return nil, ErrInvalidMetadata
+	}
+	return md, nil
+}
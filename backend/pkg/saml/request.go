@@ -0,0 +1,82 @@
+package saml
+
+import (
+	"bytes"
+	"compress/flate"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"net/url"
+	"time"
+
+	"github.com/beevik/etree"
+)
+
+// newRequestID 生成 AuthnRequest ID。XML ID 须以字母或下划线开头。
+func newRequestID() (string, error) {
+	b := make([]byte, 20)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return "_" + hex.EncodeToString(b), nil
+}
+
+// AuthnRequestURL 构造 SP 发起登录的 IdP 跳转地址（HTTP-Redirect 绑定），
+// 返回的 requestID 须由调用方登记，收到响应时与 InResponseTo 比对。
+func (sp *ServiceProvider) AuthnRequestURL(relayState string, now time.Time) (redirectURL, requestID string, err error) {
+	if err = sp.validate(); err != nil {
+		return "", "", err
+	}
+
+	requestID, err = newRequestID()
+	if err != nil {
+		return "", "", err
+	}
+
+	doc := etree.NewDocument()
+	req := doc.CreateElement("samlp:AuthnRequest")
+	req.CreateAttr("xmlns:samlp", nsProtocol)
+	req.CreateAttr("xmlns:saml", nsAssertion)
+	req.CreateAttr("ID", requestID)
+	req.CreateAttr("Version", "2.0")
+	req.CreateAttr("IssueInstant", now.UTC().Format(time.RFC3339))
+	req.CreateAttr("Destination", sp.IdP.SSOURL)
+	req.CreateAttr("AssertionConsumerServiceURL", sp.ACSURL)
+	req.CreateAttr("ProtocolBinding", BindingHTTPPOST)
+	req.CreateElement("saml:Issuer").SetText(sp.EntityID)
+	if sp.NameIDFormat != "" {
+		policy := req.CreateElement("samlp:NameIDPolicy")
+		policy.CreateAttr("Format", sp.NameIDFormat)
+		policy.CreateAttr("AllowCreate", "true")
+	}
+
+	raw, err := doc.WriteToBytes()
+	if err != nil {
+		return "", "", err
+	}
+
+	var compressed bytes.Buffer
+	fw, err := flate.NewWriter(&compressed, flate.BestCompression)
+	if err != nil {
+		return "", "", err
+	}
+	if _, err = fw.Write(raw); err != nil {
+		return "", "", err
+	}
+	if err = fw.Close(); err != nil {
+		return "", "", err
+	}
+
+	u, err := url.Parse(sp.IdP.SSOURL)
+	if err != nil {
+		return "", "", ErrInvalidConfig
+	}
+	q := u.Query()
+	q.Set("SAMLRequest", base64.StdEncoding.EncodeToString(compressed.Bytes()))
+	if relayState != "" {
+		q.Set("RelayState", relayState)
+	}
+	u.RawQuery = q.Encode()
+
+	return u.String(), requestID, nil
+}
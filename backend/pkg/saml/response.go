@@ -0,0 +1,241 @@
+package saml
+
+import (
+	"bytes"
+	"encoding/base64"
+	"strings"
+	"time"
+
+	"github.com/beevik/etree"
+)
+
+// ParseResponse 解码并校验 HTTP-POST 绑定回送的 SAMLResponse，返回通过校验的断言。
+// 调用方还须：按 Assertion.InResponseTo 消费本方登记的请求 ID（一次性），
+// 并按 Assertion.ID 做重放检查。
+func (sp *ServiceProvider) ParseResponse(encoded string, now time.Time) (*Assertion, error) {
+	if err := sp.validate(); err != nil {
+		return nil, err
+	}
+	if len(sp.IdP.Certificates) == 0 {
+		return nil, ErrInvalidConfig
+	}
+
+	raw, err := base64.StdEncoding.DecodeString(stripWhitespace(encoded))
+	if err != nil {
+		return nil, ErrMalformedResponse
+	}
+	if len(raw) > maxResponseSize {
+		return nil, ErrResponseTooLarge
+	}
+	if bytes.Contains(raw, []byte("<!DOCTYPE")) {
+		return nil, ErrDocumentTypeForbidden
+	}
+
+	doc := etree.NewDocument()
+	if err = doc.ReadFromBytes(raw); err != nil {
+		return nil, ErrMalformedResponse
+	}
+	resp := doc.Root()
+	if resp == nil || resp.Tag != "Response" || resp.NamespaceURI() != nsProtocol {
+		return nil, ErrMalformedResponse
+	}
+
+	if dest := resp.SelectAttrValue("Destination", ""); dest != "" && dest != sp.ACSURL {
+		return nil, ErrDestinationMismatch
+	}
+	if issuer := childElement(resp, nsAssertion, "Issuer"); issuer != nil && strings.TrimSpace(issuer.Text()) != sp.IdP.EntityID {
+		return nil, ErrIssuerMismatch
+	}
+
+	status := childElement(resp, nsProtocol, "Status")
+	if status == nil {
+		return nil, ErrMalformedResponse
+	}
+	statusCode := childElement(status, nsProtocol, "StatusCode")
+	if statusCode == nil || statusCode.SelectAttrValue("Value", "") != StatusSuccess {
+		return nil, ErrStatusNotSuccess
+	}
+
+	if len(childElements(resp, nsAssertion, "EncryptedAssertion")) > 0 {
+		return nil, ErrEncryptedAssertion
+	}
+	assertions := childElements(resp, nsAssertion, "Assertion")
+	if len(assertions) != 1 {
+		return nil, ErrMalformedResponse
+	}
+	assertion := assertions[0]
+
+	// Response 与 Assertion 至少其一须签名；携带的签名必须有效
+	var signed bool
+	if signatureOf(resp) != nil {
+		if err = verifyEnvelopedSignature(resp, sp.IdP.Certificates); err != nil {
+			return nil, err
+		}
+		signed = true
+	}
+	if signatureOf(assertion) != nil {
+		if err = verifyEnvelopedSignature(assertion, sp.IdP.Certificates); err != nil {
+			return nil, err
+		}
+		signed = true
+	}
+	if !signed {
+		return nil, ErrUnsigned
+	}
+
+	return sp.parseAssertion(assertion, resp.SelectAttrValue("InResponseTo", ""), now)
+}
+
+func (sp *ServiceProvider) parseAssertion(el *etree.Element, responseInResponseTo string, now time.Time) (*Assertion, error) {
+	skew := sp.clockSkew()
+
+	issuer := childElement(el, nsAssertion, "Issuer")
+	if issuer == nil || strings.TrimSpace(issuer.Text()) != sp.IdP.EntityID {
+		return nil, ErrIssuerMismatch
+	}
+
+	out := &Assertion{
+		ID:         el.SelectAttrValue("ID", ""),
+		Issuer:     sp.IdP.EntityID,
+		Attributes: map[string][]string{},
+	}
+	if out.ID == "" {
+		return nil, ErrMalformedResponse
+	}
+
+	// Subject
+	subject := childElement(el, nsAssertion, "Subject")
+	if subject == nil {
+		return nil, ErrMissingNameID
+	}
+	nameID := childElement(subject, nsAssertion, "NameID")
+	if nameID == nil || strings.TrimSpace(nameID.Text()) == "" {
+		return nil, ErrMissingNameID
+	}
+	out.NameID = strings.TrimSpace(nameID.Text())
+	out.NameIDFormat = nameID.SelectAttrValue("Format", "")
+
+	// 至少一个满足条件的 bearer 确认：接收方为本方 ACS、未过期、对应本方请求
+	var confirmed bool
+	for _, sc := range childElements(subject, nsAssertion, "SubjectConfirmation") {
+		if sc.SelectAttrValue("Method", "") != subjectConfirmationBearer {
+			continue
+		}
+		data := childElement(sc, nsAssertion, "SubjectConfirmationData")
+		if data == nil {
+			continue
+		}
+		if data.SelectAttrValue("Recipient", "") != sp.ACSURL {
+			continue
+		}
+		notOnOrAfter, has, err := parseTime(data.SelectAttrValue("NotOnOrAfter", ""))
+		if err != nil || !has || !now.Before(notOnOrAfter.Add(skew)) {
+			continue
+		}
+		if notBefore, has, err := parseTime(data.SelectAttrValue("NotBefore", "")); err != nil || (has && now.Add(skew).Before(notBefore)) {
+			continue
+		}
+
+		inResponseTo := data.SelectAttrValue("InResponseTo", "")
+		if inResponseTo == "" {
+			return nil, ErrMissingInResponseTo
+		}
+		if responseInResponseTo != "" && responseInResponseTo != inResponseTo {
+			return nil, ErrInResponseToMismatch
+		}
+
+		out.InResponseTo = inResponseTo
+		out.NotOnOrAfter = notOnOrAfter
+		confirmed = true
+		break
+	}
+	if !confirmed {
+		return nil, ErrMissingBearerSubject
+	}
+
+	// Conditions
+	conditions := childElement(el, nsAssertion, "Conditions")
+	if conditions == nil {
+		return nil, ErrAudienceMismatch
+	}
+	notBefore, has, err := parseTime(conditions.SelectAttrValue("NotBefore", ""))
+	if err != nil {
+		return nil, ErrMalformedResponse
+	}
+	if has && now.Add(skew).Before(notBefore) {
+		return nil, ErrExpired
+	}
+	notOnOrAfter, has, err := parseTime(conditions.SelectAttrValue("NotOnOrAfter", ""))
+	if err != nil {
+		return nil, ErrMalformedResponse
+	}
+	if has {
+		if !now.Before(notOnOrAfter.Add(skew)) {
+			return nil, ErrExpired
+		}
+		if notOnOrAfter.Before(out.NotOnOrAfter) {
+			out.NotOnOrAfter = notOnOrAfter
+		}
+	}
+
+	restrictions := childElements(conditions, nsAssertion, "AudienceRestriction")
+	if len(restrictions) == 0 {
+		return nil, ErrAudienceMismatch
+	}
+	// 多个 AudienceRestriction 须同时满足
+	for _, r := range restrictions {
+		var matched bool
+		for _, a := range childElements(r, nsAssertion, "Audience") {
+			if strings.TrimSpace(a.Text()) == sp.EntityID {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return nil, ErrAudienceMismatch
+		}
+	}
+
+	if authn := childElement(el, nsAssertion, "AuthnStatement"); authn != nil {
+		out.SessionIndex = authn.SelectAttrValue("SessionIndex", "")
+	}
+
+	for _, stmt := range childElements(el, nsAssertion, "AttributeStatement") {
+		for _, attr := range childElements(stmt, nsAssertion, "Attribute") {
+			var values []string
+			for _, v := range childElements(attr, nsAssertion, "AttributeValue") {
+				values = append(values, strings.TrimSpace(v.Text()))
+			}
+			if name := attr.SelectAttrValue("Name", ""); name != "" {
+				out.Attributes[name] = append(out.Attributes[name], values...)
+			}
+		}
+	}
+	// FriendlyName 作为补充键，便于按 mail/displayName 等短名映射
+	for _, stmt := range childElements(el, nsAssertion, "AttributeStatement") {
+		for _, attr := range childElements(stmt, nsAssertion, "Attribute") {
+			friendly := attr.SelectAttrValue("FriendlyName", "")
+			if friendly == "" {
+				continue
+			}
+			if _, exists := out.Attributes[friendly]; exists {
+				continue
+			}
+			out.Attributes[friendly] = out.Attributes[attr.SelectAttrValue("Name", "")]
+		}
+	}
+
+	return out, nil
+}
+
+// parseTime 解析可选的 xs:dateTime 属性；空串返回 has=false。
+func parseTime(s string) (t time.Time, has bool, err error) {
+	if s == "" {
+		return time.Time{}, false, nil
+	}
+	t, err = time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	return t, true, nil
+}
@@ -0,0 +1,193 @@
+// Package saml SAML 2.0 服务提供方（SP）最小实现：go-wind-admin 作为 SP 对接租户自有的身份提供方（IdP）。
+//
+// 支持范围：
+//   - SP 发起登录，AuthnRequest 走 HTTP-Redirect 绑定（不签名）；
+//   - IdP 以 HTTP-POST 绑定回送 Response，Response 或 Assertion 至少其一须由配置的 IdP 证书签名
+//     （XML-DSig enveloped 签名 + 排他规范化，RSA/ECDSA + SHA-256/SHA-512）；
+//   - 只接受对应本方 AuthnRequest 的响应（InResponseTo 必填），不接受 IdP 发起的登录；
+//   - 不支持加密断言（EncryptedAssertion）与单点登出。
+//
+// 信任锚只有配置中的 IdP 证书，报文 KeyInfo 中携带的证书一律忽略。
+package saml
+
+import (
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"strings"
+	"time"
+)
+
+// XML 命名空间
+const (
+	nsProtocol  = "urn:oasis:names:tc:SAML:2.0:protocol"
+	nsAssertion = "urn:oasis:names:tc:SAML:2.0:assertion"
+	nsMetadata  = "urn:oasis:names:tc:SAML:2.0:metadata"
+	nsDSig      = "http://www.w3.org/2000/09/xmldsig#"
+	nsXML       = "http://www.w3.org/XML/1998/namespace"
+)
+
+// 绑定与状态码
+const (
+	BindingHTTPRedirect = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect"
+	BindingHTTPPOST     = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST"
+
+	StatusSuccess = "urn:oasis:names:tc:SAML:2.0:status:Success"
+
+	subjectConfirmationBearer = "urn:oasis:names:tc:SAML:2.0:cm:bearer"
+)
+
+// NameID 格式
+const (
+	NameIDFormatUnspecified  = "urn:oasis:names:tc:SAML:1.1:nameid-format:unspecified"
+	NameIDFormatEmailAddress = "urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress"
+	NameIDFormatPersistent   = "urn:oasis:names:tc:SAML:2.0:nameid-format:persistent"
+)
+
+// DefaultClockSkew 校验断言有效期时容忍的 SP 与 IdP 时钟偏差。
+const DefaultClockSkew = 2 * time.Minute
+
+// maxResponseSize SAMLResponse 解码后的大小上限，防止超大报文拖垮解析。
+const maxResponseSize = 512 * 1024
+
+var (
+	ErrInvalidConfig         = errors.New("saml: invalid service provider config")
+	ErrInvalidMetadata       = errors.New("saml: invalid idp metadata")
+	ErrInvalidCertificate    = errors.New("saml: invalid idp certificate")
+	ErrMalformedResponse     = errors.New("saml: malformed response")
+	ErrStatusNotSuccess      = errors.New("saml: idp returned non-success status")
+	ErrUnsigned              = errors.New("saml: response is not signed")
+	ErrInvalidSignature      = errors.New("saml: invalid signature")
+	ErrUnsupportedAlgorithm  = errors.New("saml: unsupported signature algorithm")
+	ErrEncryptedAssertion    = errors.New("saml: encrypted assertions are not supported")
+	ErrIssuerMismatch        = errors.New("saml: issuer mismatch")
+	ErrDestinationMismatch   = errors.New("saml: destination mismatch")
+	ErrAudienceMismatch      = errors.New("saml: audience mismatch")
+	ErrExpired               = errors.New("saml: assertion expired or not yet valid")
+	ErrMissingInResponseTo   = errors.New("saml: unsolicited response")
+	ErrInResponseToMismatch  = errors.New("saml: in-response-to mismatch")
+	ErrMissingNameID         = errors.New("saml: assertion has no name id")
+	ErrMissingBearerSubject  = errors.New("saml: assertion has no valid bearer subject confirmation")
+	ErrResponseTooLarge      = errors.New("saml: response too large")
+	ErrDocumentTypeForbidden = errors.New("saml: document type declarations are not allowed")
+)
+
+// IdentityProvider 身份提供方配置。
+type IdentityProvider struct {
+	// EntityID IdP 实体标识，须与报文 Issuer 一致
+	EntityID string
+	// SSOURL 单点登录地址（HTTP-Redirect 绑定）
+	SSOURL string
+	// Certificates 签名证书；IdP 轮换证书期间可同时配置新旧两张
+	Certificates []*x509.Certificate
+}
+
+// ServiceProvider 服务提供方配置。
+type ServiceProvider struct {
+	// EntityID SP 实体标识，IdP 以此作为断言的 Audience
+	EntityID string
+	// ACSURL 断言消费地址，IdP 以此作为 Destination/Recipient
+	ACSURL string
+	// NameIDFormat 请求的 NameID 格式，为空不限定
+	NameIDFormat string
+
+	IdP IdentityProvider
+
+	// ClockSkew 为 0 时使用 DefaultClockSkew
+	ClockSkew time.Duration
+}
+
+func (sp *ServiceProvider) clockSkew() time.Duration {
+	if sp.ClockSkew > 0 {
+		return sp.ClockSkew
+	}
+	return DefaultClockSkew
+}
+
+func (sp *ServiceProvider) validate() error {
+	if sp.EntityID == "" || sp.ACSURL == "" || sp.IdP.EntityID == "" || sp.IdP.SSOURL == "" {
+		return ErrInvalidConfig
+	}
+	return nil
+}
+
+// Assertion 通过校验的断言内容。
+type Assertion struct {
+	ID           string
+	Issuer       string
+	InResponseTo string
+
+	NameID       string
+	NameIDFormat string
+	SessionIndex string
+
+	// NotOnOrAfter 断言失效时间，用于防重放记录的保留期
+	NotOnOrAfter time.Time
+
+	// Attributes 以 Attribute@Name 为键；FriendlyName 不与已有键冲突时也作为键
+	Attributes map[string][]string
+}
+
+// Attribute 取属性的第一个值。
+func (a *Assertion) Attribute(name string) string {
+	if values := a.Attributes[name]; len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// ParseCertificates 解析 PEM 编码的证书（可多张）；不带 PEM 头的单张 BASE64 DER 也可接受，
+// 便于直接粘贴 IdP 元数据中的 X509Certificate 内容。
+func ParseCertificates(text string) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+
+	rest := []byte(strings.TrimSpace(text))
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, ErrInvalidCertificate
+		}
+		certs = append(certs, cert)
+	}
+	if len(certs) > 0 {
+		return certs, nil
+	}
+
+	der, err := base64.StdEncoding.DecodeString(stripWhitespace(text))
+	if err != nil {
+		return nil, ErrInvalidCertificate
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, ErrInvalidCertificate
+	}
+	return []*x509.Certificate{cert}, nil
+}
+
+// EncodeCertificates 将证书编码为 PEM 文本。
+func EncodeCertificates(certs []*x509.Certificate) string {
+	var sb strings.Builder
+	for _, cert := range certs {
+		_ = pem.Encode(&sb, &pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})
+	}
+	return sb.String()
+}
+
+func stripWhitespace(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '\t', '\r', '\n':
+			return -1
+		}
+		return r
+	}, s)
+}
@@ -0,0 +1,291 @@
+package saml
+
+import (
+	"bytes"
+	"compress/flate"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"io"
+	"math/big"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/beevik/etree"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testIdPEntityID = "https://idp.example.com/metadata"
+	testSPEntityID  = "https://admin.example.com/admin/v1/saml/acme/metadata"
+	testACSURL      = "https://admin.example.com/admin/v1/saml/acme/acs"
+	testRequestID   = "_4fee3b046395c4e751011e97f8900b5273d56685"
+)
+
+func newTestKey(t *testing.T) (*rsa.PrivateKey, *x509.Certificate) {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "idp.example.com"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	assert.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	assert.NoError(t, err)
+
+	return key, cert
+}
+
+func newTestSP(cert *x509.Certificate) *ServiceProvider {
+	return &ServiceProvider{
+		EntityID: testSPEntityID,
+		ACSURL:   testACSURL,
+		IdP: IdentityProvider{
+			EntityID:     testIdPEntityID,
+			SSOURL:       "https://idp.example.com/sso?tenant=acme",
+			Certificates: []*x509.Certificate{cert},
+		},
+	}
+}
+
+func testResponseXML(now time.Time) string {
+	issue := now.UTC().Format(time.RFC3339)
+	notOnOrAfter := now.Add(5 * time.Minute).UTC().Format(time.RFC3339)
+	notBefore := now.Add(-time.Minute).UTC().Format(time.RFC3339)
+
+	return `<samlp:Response xmlns:samlp="urn:oasis:names:tc:SAML:2.0:protocol" xmlns:saml="urn:oasis:names:tc:SAML:2.0:assertion" ID="_resp1" Version="2.0" IssueInstant="` + issue + `" Destination="` + testACSURL + `" InResponseTo="` + testRequestID + `">` +
+		`<saml:Issuer>` + testIdPEntityID + `</saml:Issuer>` +
+		`<samlp:Status><samlp:StatusCode Value="urn:oasis:names:tc:SAML:2.0:status:Success"/></samlp:Status>` +
+		`<saml:Assertion ID="_assert1" Version="2.0" IssueInstant="` + issue + `">` +
+		`<saml:Issuer>` + testIdPEntityID + `</saml:Issuer>` +
+		`<saml:Subject><saml:NameID Format="urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress">alice@acme.test</saml:NameID>` +
+		`<saml:SubjectConfirmation Method="urn:oasis:names:tc:SAML:2.0:cm:bearer">` +
+		`<saml:SubjectConfirmationData InResponseTo="` + testRequestID + `" NotOnOrAfter="` + notOnOrAfter + `" Recipient="` + testACSURL + `"/>` +
+		`</saml:SubjectConfirmation></saml:Subject>` +
+		`<saml:Conditions NotBefore="` + notBefore + `" NotOnOrAfter="` + notOnOrAfter + `">` +
+		`<saml:AudienceRestriction><saml:Audience>` + testSPEntityID + `</saml:Audience></saml:AudienceRestriction>` +
+		`</saml:Conditions>` +
+		`<saml:AuthnStatement AuthnInstant="` + issue + `" SessionIndex="_session1"/>` +
+		`<saml:AttributeStatement>` +
+		`<saml:Attribute Name="urn:oid:0.9.2342.19200300.100.1.3" FriendlyName="mail"><saml:AttributeValue>alice@acme.test</saml:AttributeValue></saml:Attribute>` +
+		`<saml:Attribute Name="groups"><saml:AttributeValue>admins</saml:AttributeValue><saml:AttributeValue>dev</saml:AttributeValue></saml:Attribute>` +
+		`</saml:AttributeStatement>` +
+		`</saml:Assertion>` +
+		`</samlp:Response>`
+}
+
+// signElement 以 RSA-SHA256 + 排他规范化为 el 添加 enveloped 签名（模拟 IdP）。
+func signElement(t *testing.T, el *etree.Element, key *rsa.PrivateKey) {
+	t.Helper()
+
+	sig := etree.NewElement("ds:Signature")
+	sig.CreateAttr("xmlns:ds", nsDSig)
+	signedInfo := sig.CreateElement("ds:SignedInfo")
+	signedInfo.CreateElement("ds:CanonicalizationMethod").CreateAttr("Algorithm", algExcC14N)
+	signedInfo.CreateElement("ds:SignatureMethod").CreateAttr("Algorithm", algRSASHA256)
+	ref := signedInfo.CreateElement("ds:Reference")
+	ref.CreateAttr("URI", "#"+el.SelectAttrValue("ID", ""))
+	transforms := ref.CreateElement("ds:Transforms")
+	transforms.CreateElement("ds:Transform").CreateAttr("Algorithm", algEnvelopedSignature)
+	transforms.CreateElement("ds:Transform").CreateAttr("Algorithm", algExcC14N)
+	ref.CreateElement("ds:DigestMethod").CreateAttr("Algorithm", algDigestSHA256)
+	digestValue := ref.CreateElement("ds:DigestValue")
+	sigValue := sig.CreateElement("ds:SignatureValue")
+
+	// 签名元素按惯例插在 Issuer 之后
+	el.InsertChildAt(1, sig)
+
+	digest := sha256.Sum256(canonicalize(el, sig, nil))
+	digestValue.SetText(base64.StdEncoding.EncodeToString(digest[:]))
+
+	hashed := sha256.Sum256(canonicalize(signedInfo, nil, nil))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, hashed[:])
+	assert.NoError(t, err)
+	sigValue.SetText(base64.StdEncoding.EncodeToString(signature))
+}
+
+func encodeDoc(t *testing.T, doc *etree.Document) string {
+	t.Helper()
+	raw, err := doc.WriteToBytes()
+	assert.NoError(t, err)
+	return base64.StdEncoding.EncodeToString(raw)
+}
+
+func signedResponse(t *testing.T, key *rsa.PrivateKey, now time.Time, signAssertion bool) *etree.Document {
+	t.Helper()
+	doc := etree.NewDocument()
+	assert.NoError(t, doc.ReadFromString(testResponseXML(now)))
+	if signAssertion {
+		signElement(t, doc.Root().SelectElement("Assertion"), key)
+	} else {
+		signElement(t, doc.Root(), key)
+	}
+	return doc
+}
+
+func TestCanonicalize_Exclusive(t *testing.T) {
+	doc := etree.NewDocument()
+	assert.NoError(t, doc.ReadFromString(
+		`<a:root xmlns:a="urn:a" xmlns:b="urn:b" xmlns:unused="urn:u"><a:child z="2" b:attr="1" c="3">t&amp;x<!-- c --><a:e/></a:child></a:root>`))
+
+	child := doc.Root().SelectElement("child")
+	got := string(canonicalize(child, nil, nil))
+	assert.Equal(t, `<a:child xmlns:a="urn:a" xmlns:b="urn:b" c="3" z="2" b:attr="1">t&amp;x<a:e></a:e></a:child>`, got)
+
+	// InclusiveNamespaces 中的前缀即使未被使用也要输出
+	got = string(canonicalize(child, nil, []string{"unused"}))
+	assert.True(t, strings.HasPrefix(got, `<a:child xmlns:a="urn:a" xmlns:b="urn:b" xmlns:unused="urn:u" c="3"`))
+}
+
+func TestParseResponse_SignedAssertion(t *testing.T) {
+	key, cert := newTestKey(t)
+	sp := newTestSP(cert)
+	now := time.Now()
+
+	a, err := sp.ParseResponse(encodeDoc(t, signedResponse(t, key, now, true)), now)
+	assert.NoError(t, err)
+	assert.Equal(t, "_assert1", a.ID)
+	assert.Equal(t, "alice@acme.test", a.NameID)
+	assert.Equal(t, NameIDFormatEmailAddress, a.NameIDFormat)
+	assert.Equal(t, testRequestID, a.InResponseTo)
+	assert.Equal(t, "_session1", a.SessionIndex)
+	assert.Equal(t, "alice@acme.test", a.Attribute("mail"))
+	assert.Equal(t, []string{"admins", "dev"}, a.Attributes["groups"])
+}
+
+func TestParseResponse_SignedResponse(t *testing.T) {
+	key, cert := newTestKey(t)
+	sp := newTestSP(cert)
+	now := time.Now()
+
+	a, err := sp.ParseResponse(encodeDoc(t, signedResponse(t, key, now, false)), now)
+	assert.NoError(t, err)
+	assert.Equal(t, "alice@acme.test", a.NameID)
+}
+
+func TestParseResponse_Rejects(t *testing.T) {
+	key, cert := newTestKey(t)
+	otherKey, _ := newTestKey(t)
+	sp := newTestSP(cert)
+	now := time.Now()
+
+	// 未签名
+	unsigned := base64.StdEncoding.EncodeToString([]byte(testResponseXML(now)))
+	_, err := sp.ParseResponse(unsigned, now)
+	assert.ErrorIs(t, err, ErrUnsigned)
+
+	// 非受信证书签名
+	_, err = sp.ParseResponse(encodeDoc(t, signedResponse(t, otherKey, now, true)), now)
+	assert.ErrorIs(t, err, ErrInvalidSignature)
+
+	// 签名后篡改 NameID
+	doc := signedResponse(t, key, now, true)
+	doc.FindElement("//NameID").SetText("mallory@acme.test")
+	_, err = sp.ParseResponse(encodeDoc(t, doc), now)
+	assert.ErrorIs(t, err, ErrInvalidSignature)
+
+	// 过期
+	_, err = sp.ParseResponse(encodeDoc(t, signedResponse(t, key, now, true)), now.Add(10*time.Minute))
+	assert.ErrorIs(t, err, ErrMissingBearerSubject)
+
+	// Audience 不是本 SP
+	other := *sp
+	other.EntityID = "https://other.example.com"
+	_, err = other.ParseResponse(encodeDoc(t, signedResponse(t, key, now, true)), now)
+	assert.ErrorIs(t, err, ErrAudienceMismatch)
+
+	// 发往其他 ACS
+	other = *sp
+	other.ACSURL = "https://other.example.com/acs"
+	_, err = other.ParseResponse(encodeDoc(t, signedResponse(t, key, now, true)), now)
+	assert.ErrorIs(t, err, ErrDestinationMismatch)
+
+	// 签名包裹：把已签名断言挪走，换上伪造断言
+	doc = signedResponse(t, key, now, true)
+	signedAssertion := doc.Root().SelectElement("Assertion")
+	forged := signedAssertion.Copy()
+	forged.RemoveChildAt(1)
+	forged.FindElement("Subject/NameID").SetText("mallory@acme.test")
+	doc.Root().RemoveChild(signedAssertion)
+	doc.Root().AddChild(forged)
+	forged.FindElement("Subject").AddChild(signedAssertion)
+	_, err = sp.ParseResponse(encodeDoc(t, doc), now)
+	assert.ErrorIs(t, err, ErrUnsigned)
+
+	// DTD
+	_, err = sp.ParseResponse(base64.StdEncoding.EncodeToString([]byte(`<!DOCTYPE x [<!ENTITY a "b">]><x/>`)), now)
+	assert.ErrorIs(t, err, ErrDocumentTypeForbidden)
+}
+
+func TestAuthnRequestURL(t *testing.T) {
+	_, cert := newTestKey(t)
+	sp := newTestSP(cert)
+	sp.NameIDFormat = NameIDFormatEmailAddress
+
+	redirectURL, requestID, err := sp.AuthnRequestURL("relay-1", time.Now())
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(requestID, "_"))
+
+	u, err := url.Parse(redirectURL)
+	assert.NoError(t, err)
+	assert.Equal(t, "acme", u.Query().Get("tenant"))
+	assert.Equal(t, "relay-1", u.Query().Get("RelayState"))
+
+	compressed, err := base64.StdEncoding.DecodeString(u.Query().Get("SAMLRequest"))
+	assert.NoError(t, err)
+	raw, err := io.ReadAll(flate.NewReader(bytes.NewReader(compressed)))
+	assert.NoError(t, err)
+
+	doc := etree.NewDocument()
+	assert.NoError(t, doc.ReadFromBytes(raw))
+	assert.Equal(t, requestID, doc.Root().SelectAttrValue("ID", ""))
+	assert.Equal(t, testACSURL, doc.Root().SelectAttrValue("AssertionConsumerServiceURL", ""))
+	assert.Equal(t, testSPEntityID, doc.Root().SelectElement("Issuer").Text())
+}
+
+func TestMetadata_RoundTrip(t *testing.T) {
+	_, cert := newTestKey(t)
+	sp := newTestSP(cert)
+
+	spMetadata, err := sp.Metadata()
+	assert.NoError(t, err)
+	assert.Contains(t, string(spMetadata), `entityID="`+testSPEntityID+`"`)
+	assert.Contains(t, string(spMetadata), `Location="`+testACSURL+`"`)
+
+	idpMetadata := `<md:EntityDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata" xmlns:ds="http://www.w3.org/2000/09/xmldsig#" entityID="` + testIdPEntityID + `">
+  <md:IDPSSODescriptor protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol">
+    <md:KeyDescriptor use="encryption"><ds:KeyInfo><ds:X509Data><ds:X509Certificate>invalid</ds:X509Certificate></ds:X509Data></ds:KeyInfo></md:KeyDescriptor>
+    <md:KeyDescriptor use="signing"><ds:KeyInfo><ds:X509Data><ds:X509Certificate>
+` + base64.StdEncoding.EncodeToString(cert.Raw) + `
+    </ds:X509Certificate></ds:X509Data></ds:KeyInfo></md:KeyDescriptor>
+    <md:SingleSignOnService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST" Location="https://idp.example.com/sso/post"/>
+    <md:SingleSignOnService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect" Location="https://idp.example.com/sso/redirect"/>
+  </md:IDPSSODescriptor>
+</md:EntityDescriptor>`
+
+	md, err := ParseIdPMetadata([]byte(idpMetadata))
+	assert.NoError(t, err)
+	assert.Equal(t, testIdPEntityID, md.EntityID)
+	assert.Equal(t, "https://idp.example.com/sso/redirect", md.SSOURL)
+	assert.Len(t, md.Certificates, 1)
+	assert.True(t, md.Certificates[0].Equal(cert))
+
+	certs, err := ParseCertificates(EncodeCertificates(md.Certificates))
+	assert.NoError(t, err)
+	assert.True(t, certs[0].Equal(cert))
+	certs, err = ParseCertificates(base64.StdEncoding.EncodeToString(cert.Raw))
+	assert.NoError(t, err)
+	assert.True(t, certs[0].Equal(cert))
+}
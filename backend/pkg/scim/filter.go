@@ -0,0 +1,72 @@
+package scim
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// Filter 单个 `attr eq "value"` 过滤表达式。
+type Filter struct {
+	// Attr 属性路径，已去掉核心模式 URN 前缀，比较时不区分大小写
+	Attr string
+	// Value 比较值，布尔与数字按字面量文本保存
+	Value string
+}
+
+// Is 判断过滤器是否作用于指定属性（不区分大小写）。
+func (f *Filter) Is(attr string) bool {
+	return f != nil && strings.EqualFold(f.Attr, attr)
+}
+
+// ParseFilter 解析过滤表达式，只支持 eq 运算；空串返回 nil。
+func ParseFilter(s string) (*Filter, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil, nil
+	}
+
+	attr, rest, ok := strings.Cut(s, " ")
+	if !ok {
+		return nil, badRequest(ErrTypeInvalidFilter, "invalid filter %q", s)
+	}
+	op, value, ok := strings.Cut(strings.TrimLeft(rest, " "), " ")
+	if !ok {
+		return nil, badRequest(ErrTypeInvalidFilter, "invalid filter %q", s)
+	}
+	if !strings.EqualFold(op, "eq") {
+		return nil, badRequest(ErrTypeInvalidFilter, "unsupported filter operator %q", op)
+	}
+
+	attr = stripCoreSchema(attr)
+	if attr == "" {
+		return nil, badRequest(ErrTypeInvalidFilter, "invalid filter %q", s)
+	}
+
+	// 比较值须是单个 JSON 字面量，逻辑组合（and/or）等其它语法在此被拒绝
+	var v any
+	if err := json.Unmarshal([]byte(strings.TrimSpace(value)), &v); err != nil {
+		return nil, badRequest(ErrTypeInvalidFilter, "invalid filter value %q", value)
+	}
+
+	f := &Filter{Attr: attr}
+	switch t := v.(type) {
+	case string:
+		f.Value = t
+	case bool, float64:
+		f.Value = strings.TrimSpace(value)
+	default:
+		return nil, badRequest(ErrTypeInvalidFilter, "invalid filter value %q", value)
+	}
+
+	return f, nil
+}
+
+// stripCoreSchema 去掉属性路径上的核心模式 URN 前缀，如 urn:...:core:2.0:User:userName -> userName。
+func stripCoreSchema(attr string) string {
+	for _, schema := range []string{SchemaUser, SchemaGroup} {
+		if len(attr) > len(schema) && strings.EqualFold(attr[:len(schema)+1], schema+":") {
+			return attr[len(schema)+1:]
+		}
+	}
+	return attr
+}
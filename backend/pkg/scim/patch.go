@@ -0,0 +1,410 @@
+package scim
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// PATCH 操作名（比较时不区分大小写，Entra ID 会发送首字母大写的 "Replace"）
+const (
+	PatchOpAdd     = "add"
+	PatchOpReplace = "replace"
+	PatchOpRemove  = "remove"
+)
+
+// patchPath 解析后的 PATCH 路径：[扩展模式 URN:]attr[filter][.sub]
+type patchPath struct {
+	// container 扩展模式 URN，为空表示资源顶层
+	container string
+	attr      string
+	filter    *Filter
+	sub       string
+}
+
+func parsePath(p string) (*patchPath, error) {
+	raw := p
+	p = stripCoreSchema(strings.TrimSpace(p))
+
+	path := &patchPath{}
+
+	// 扩展模式：URN 与属性名之间以最后一个冒号分隔（过滤器内容里可能也有冒号，只看方括号之前）
+	if hasURNPrefix(p) {
+		head := p
+		if i := strings.IndexByte(p, '['); i >= 0 {
+			head = p[:i]
+		}
+		i := strings.LastIndexByte(head, ':')
+		if i <= 0 || i == len(p)-1 {
+			return nil, badRequest(ErrTypeInvalidPath, "invalid path %q", raw)
+		}
+		path.container, p = p[:i], p[i+1:]
+	}
+
+	if i := strings.IndexByte(p, '['); i >= 0 {
+		j := strings.LastIndexByte(p, ']')
+		if j < i {
+			return nil, badRequest(ErrTypeInvalidPath, "invalid path %q", raw)
+		}
+		filter, err := ParseFilter(p[i+1 : j])
+		if err != nil || filter == nil {
+			return nil, badRequest(ErrTypeInvalidPath, "invalid value filter in path %q", raw)
+		}
+		path.attr, path.filter = p[:i], filter
+
+		rest := p[j+1:]
+		if rest != "" {
+			if !strings.HasPrefix(rest, ".") || len(rest) == 1 {
+				return nil, badRequest(ErrTypeInvalidPath, "invalid path %q", raw)
+			}
+			path.sub = rest[1:]
+		}
+	} else {
+		path.attr, path.sub, _ = strings.Cut(p, ".")
+	}
+
+	if path.attr == "" || strings.ContainsAny(path.attr+path.sub, " []") {
+		return nil, badRequest(ErrTypeInvalidPath, "invalid path %q", raw)
+	}
+
+	return path, nil
+}
+
+func hasURNPrefix(s string) bool {
+	return len(s) > 4 && strings.EqualFold(s[:4], "urn:")
+}
+
+// ApplyPatch 将 PATCH 操作依次应用到资源（*User、*Group 等结构体指针），任一操作失败则返回错误且不修改资源。
+func ApplyPatch(resource any, ops []PatchOperation) error {
+	rv := reflect.ValueOf(resource)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() {
+		return fmt.Errorf("scim: patch target must be a non-nil pointer")
+	}
+
+	raw, err := json.Marshal(resource)
+	if err != nil {
+		return err
+	}
+	var doc map[string]any
+	if err = json.Unmarshal(raw, &doc); err != nil {
+		return err
+	}
+
+	for _, op := range ops {
+		if err = applyOperation(doc, op); err != nil {
+			return err
+		}
+	}
+
+	if raw, err = json.Marshal(doc); err != nil {
+		return err
+	}
+
+	// 先解析到新值，成功后再整体替换，避免删除的属性因 json 合并语义残留
+	patched := reflect.New(rv.Elem().Type())
+	if err = json.Unmarshal(raw, patched.Interface()); err != nil {
+		return badRequest(ErrTypeInvalidValue, "invalid patch value: %s", err.Error())
+	}
+	rv.Elem().Set(patched.Elem())
+
+	return nil
+}
+
+func applyOperation(doc map[string]any, op PatchOperation) error {
+	name := strings.ToLower(strings.TrimSpace(op.Op))
+	switch name {
+	case PatchOpAdd, PatchOpReplace, PatchOpRemove:
+	default:
+		return badRequest(ErrTypeInvalidSyntax, "unsupported patch op %q", op.Op)
+	}
+
+	var value any
+	if len(op.Value) != 0 {
+		if err := json.Unmarshal(op.Value, &value); err != nil {
+			return badRequest(ErrTypeInvalidValue, "invalid patch value: %s", err.Error())
+		}
+	}
+
+	if strings.TrimSpace(op.Path) != "" {
+		path, err := parsePath(op.Path)
+		if err != nil {
+			return err
+		}
+		if name != PatchOpRemove && value == nil {
+			return badRequest(ErrTypeInvalidValue, "%s operation requires a value", name)
+		}
+		return applyAt(doc, name, path, value)
+	}
+
+	// 无路径时 value 是属性集合：每个键都按路径处理（Entra ID 会用 "name.givenName" 这类键），
+	// 扩展模式 URN 作为键时其下的属性逐个处理
+	if name == PatchOpRemove {
+		return badRequest(ErrTypeNoTarget, "remove operation requires a path")
+	}
+	attrs, ok := value.(map[string]any)
+	if !ok {
+		return badRequest(ErrTypeInvalidValue, "%s operation without path requires an object value", name)
+	}
+	for key, v := range attrs {
+		if ext, isExt := v.(map[string]any); isExt && hasURNPrefix(key) {
+			for subKey, subValue := range ext {
+				path, err := parsePath(key + ":" + subKey)
+				if err != nil {
+					return err
+				}
+				if err = applyAt(doc, name, path, subValue); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		path, err := parsePath(key)
+		if err != nil {
+			return err
+		}
+		if err = applyAt(doc, name, path, v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func applyAt(doc map[string]any, op string, path *patchPath, value any) error {
+	target := doc
+	if path.container != "" {
+		key := lookupKey(doc, path.container)
+		ext, ok := doc[key].(map[string]any)
+		if !ok {
+			if op == PatchOpRemove {
+				return nil
+			}
+			ext = map[string]any{}
+			doc[key] = ext
+		}
+		target = ext
+	}
+
+	key := lookupKey(target, path.attr)
+
+	switch {
+	case path.filter != nil:
+		return applyFiltered(target, key, op, path, value)
+
+	case path.sub != "":
+		switch existing := target[key].(type) {
+		case []any:
+			// 未带过滤器的多值属性子路径作用于全部元素
+			for _, elem := range existing {
+				if m, ok := elem.(map[string]any); ok {
+					setOrRemove(m, path.sub, op, value)
+				}
+			}
+		case map[string]any:
+			setOrRemove(existing, path.sub, op, value)
+		default:
+			if op == PatchOpRemove {
+				return nil
+			}
+			m := map[string]any{}
+			setOrRemove(m, path.sub, op, value)
+			target[key] = m
+		}
+		return nil
+	}
+
+	existing, exists := target[key]
+
+	switch op {
+	case PatchOpRemove:
+		// Entra ID 移除组成员时以 value 列出待移除元素，而不是使用过滤器路径
+		if arr, ok := existing.([]any); ok && value != nil {
+			arr = removeElements(arr, asSlice(value))
+			if len(arr) == 0 {
+				delete(target, key)
+			} else {
+				target[key] = arr
+			}
+			return nil
+		}
+		delete(target, key)
+
+	case PatchOpAdd:
+		switch cur := existing.(type) {
+		case []any:
+			target[key] = appendElements(cur, asSlice(value))
+		case map[string]any:
+			if m, ok := value.(map[string]any); ok {
+				mergeInto(cur, m)
+			} else {
+				target[key] = value
+			}
+		default:
+			target[key] = value
+		}
+
+	case PatchOpReplace:
+		// 复杂属性只替换给出的子属性；多值属性整体替换
+		cur, isMap := existing.(map[string]any)
+		if m, ok := value.(map[string]any); ok && exists && isMap {
+			mergeInto(cur, m)
+		} else {
+			target[key] = value
+		}
+	}
+
+	return nil
+}
+
+// applyFiltered 处理带值过滤器的多值属性路径，如 emails[type eq "work"].value。
+func applyFiltered(target map[string]any, key, op string, path *patchPath, value any) error {
+	arr, _ := target[key].([]any)
+
+	var matched []map[string]any
+	kept := arr[:0:0]
+	for _, elem := range arr {
+		m, ok := elem.(map[string]any)
+		if ok && matchElement(m, path.filter) {
+			matched = append(matched, m)
+			if op == PatchOpRemove && path.sub == "" {
+				continue
+			}
+		}
+		kept = append(kept, elem)
+	}
+
+	switch {
+	case op == PatchOpRemove && path.sub == "":
+		arr = kept
+
+	case op == PatchOpRemove:
+		for _, m := range matched {
+			delete(m, lookupKey(m, path.sub))
+		}
+
+	case len(matched) == 0:
+		// 目标元素不存在时按新增处理：以过滤条件作为新元素的判别属性
+		elem := map[string]any{path.filter.Attr: path.filter.Value}
+		if path.sub != "" {
+			elem[path.sub] = value
+		} else if m, ok := value.(map[string]any); ok {
+			mergeInto(elem, m)
+		} else {
+			return badRequest(ErrTypeInvalidValue, "value for %s must be an object", path.attr)
+		}
+		arr = append(arr, elem)
+
+	default:
+		for _, m := range matched {
+			if path.sub != "" {
+				m[lookupKey(m, path.sub)] = value
+			} else if v, ok := value.(map[string]any); ok {
+				mergeInto(m, v)
+			} else {
+				return badRequest(ErrTypeInvalidValue, "value for %s must be an object", path.attr)
+			}
+		}
+	}
+
+	if len(arr) == 0 {
+		delete(target, key)
+	} else {
+		target[key] = arr
+	}
+	return nil
+}
+
+func matchElement(m map[string]any, f *Filter) bool {
+	v, ok := m[lookupKey(m, f.Attr)]
+	if !ok || v == nil {
+		return false
+	}
+	if s, isStr := v.(string); isStr {
+		return strings.EqualFold(s, f.Value)
+	}
+	return strings.EqualFold(fmt.Sprint(v), f.Value)
+}
+
+func setOrRemove(m map[string]any, attr, op string, value any) {
+	key := lookupKey(m, attr)
+	if op == PatchOpRemove {
+		delete(m, key)
+		return
+	}
+	m[key] = value
+}
+
+// lookupKey 属性名不区分大小写：返回已存在的同名键，不存在时返回原名。
+func lookupKey(m map[string]any, name string) string {
+	if _, ok := m[name]; ok {
+		return name
+	}
+	for k := range m {
+		if strings.EqualFold(k, name) {
+			return k
+		}
+	}
+	return name
+}
+
+func mergeInto(dst, src map[string]any) {
+	for k, v := range src {
+		dst[lookupKey(dst, k)] = v
+	}
+}
+
+func asSlice(v any) []any {
+	if arr, ok := v.([]any); ok {
+		return arr
+	}
+	return []any{v}
+}
+
+// sameElement 多值属性元素带 value 子属性时按 value 判等，否则整体比较。
+func sameElement(a, b any) bool {
+	am, aok := a.(map[string]any)
+	bm, bok := b.(map[string]any)
+	if aok && bok {
+		av, ahas := am[lookupKey(am, "value")]
+		bv, bhas := bm[lookupKey(bm, "value")]
+		if ahas && bhas {
+			return reflect.DeepEqual(av, bv)
+		}
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+func appendElements(arr, values []any) []any {
+	for _, v := range values {
+		dup := false
+		for _, elem := range arr {
+			if sameElement(elem, v) {
+				dup = true
+				break
+			}
+		}
+		if !dup {
+			arr = append(arr, v)
+		}
+	}
+	return arr
+}
+
+func removeElements(arr, values []any) []any {
+	kept := arr[:0:0]
+	for _, elem := range arr {
+		remove := false
+		for _, v := range values {
+			if sameElement(elem, v) {
+				remove = true
+				break
+			}
+		}
+		if !remove {
+			kept = append(kept, elem)
+		}
+	}
+	return kept
+}
@@ -0,0 +1,384 @@
+// Package scim SCIM 2.0（RFC 7643 / RFC 7644）服务端协议层：资源模型、过滤器、PATCH 与 ETag。
+//
+// 支持范围：
+//   - User（含企业扩展 department）与 Group 两类资源；
+//   - 过滤器只支持单个 `attr eq "value"` 表达式，足以覆盖 Okta、Entra ID 的存在性查询；
+//   - PATCH 支持 add / replace / remove，路径支持属性、子属性、带值过滤器的多值属性
+//     （如 `emails[type eq "work"].value`、`members[value eq "42"]`）与扩展模式 URN 前缀；
+//   - ETag 为资源内容（不含 meta）的弱校验值，配合 If-Match / If-None-Match 使用。
+//
+// 本包不涉及存储与鉴权，资源与本地模型的映射由调用方负责。
+package scim
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ContentType SCIM 报文的媒体类型。
+const ContentType = "application/scim+json"
+
+// 模式 URN
+const (
+	SchemaUser                  = "urn:ietf:params:scim:schemas:core:2.0:User"
+	SchemaGroup                 = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	SchemaEnterpriseUser        = "urn:ietf:params:scim:schemas:extension:enterprise:2.0:User"
+	SchemaServiceProviderConfig = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+	SchemaListResponse          = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	SchemaPatchOp               = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	SchemaError                 = "urn:ietf:params:scim:api:messages:2.0:Error"
+)
+
+// 资源类型
+const (
+	ResourceTypeUser  = "User"
+	ResourceTypeGroup = "Group"
+)
+
+// 分页参数
+const (
+	DefaultCount = 100
+	MaxCount     = 1000
+)
+
+// Bool 宽松布尔值：兼容 Entra ID 在 PATCH 中以字符串 "True"/"False" 传递布尔属性。
+type Bool bool
+
+func (b *Bool) UnmarshalJSON(data []byte) error {
+	var v bool
+	if err := json.Unmarshal(data, &v); err == nil {
+		*b = Bool(v)
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("scim: invalid boolean %s", string(data))
+	}
+	v, err := strconv.ParseBool(strings.TrimSpace(s))
+	if err != nil {
+		return fmt.Errorf("scim: invalid boolean %q", s)
+	}
+	*b = Bool(v)
+	return nil
+}
+
+// Meta 资源元数据。
+type Meta struct {
+	ResourceType string     `json:"resourceType,omitempty"`
+	Created      *time.Time `json:"created,omitempty"`
+	LastModified *time.Time `json:"lastModified,omitempty"`
+	Location     string     `json:"location,omitempty"`
+	Version      string     `json:"version,omitempty"`
+}
+
+// Name 用户姓名。
+type Name struct {
+	Formatted  string `json:"formatted,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+	GivenName  string `json:"givenName,omitempty"`
+}
+
+// MultiValued 多值属性的元素（emails、phoneNumbers、groups、members 共用）。
+type MultiValued struct {
+	Value   string `json:"value,omitempty"`
+	Display string `json:"display,omitempty"`
+	Type    string `json:"type,omitempty"`
+	Primary Bool   `json:"primary,omitempty"`
+	Ref     string `json:"$ref,omitempty"`
+}
+
+// EnterpriseUser 企业用户扩展。
+type EnterpriseUser struct {
+	EmployeeNumber string `json:"employeeNumber,omitempty"`
+	Organization   string `json:"organization,omitempty"`
+	Department     string `json:"department,omitempty"`
+}
+
+// User 用户资源。
+type User struct {
+	Schemas      []string        `json:"schemas"`
+	ID           string          `json:"id,omitempty"`
+	ExternalID   string          `json:"externalId,omitempty"`
+	UserName     string          `json:"userName"`
+	Name         *Name           `json:"name,omitempty"`
+	DisplayName  string          `json:"displayName,omitempty"`
+	NickName     string          `json:"nickName,omitempty"`
+	Title        string          `json:"title,omitempty"`
+	Active       *Bool           `json:"active,omitempty"`
+	Emails       []MultiValued   `json:"emails,omitempty"`
+	PhoneNumbers []MultiValued   `json:"phoneNumbers,omitempty"`
+	Groups       []MultiValued   `json:"groups,omitempty"`
+	Enterprise   *EnterpriseUser `json:"urn:ietf:params:scim:schemas:extension:enterprise:2.0:User,omitempty"`
+	Meta         *Meta           `json:"meta,omitempty"`
+}
+
+// IsActive 未传 active 视为启用。
+func (u *User) IsActive() bool {
+	return u.Active == nil || bool(*u.Active)
+}
+
+// PrimaryEmail 返回主邮箱，没有标记 primary 时取第一个。
+func (u *User) PrimaryEmail() string {
+	return primaryValue(u.Emails)
+}
+
+// PrimaryPhoneNumber 返回主电话，没有标记 primary 时取第一个。
+func (u *User) PrimaryPhoneNumber() string {
+	return primaryValue(u.PhoneNumbers)
+}
+
+// Department 返回企业扩展中的部门。
+func (u *User) Department() string {
+	if u.Enterprise == nil {
+		return ""
+	}
+	return strings.TrimSpace(u.Enterprise.Department)
+}
+
+func primaryValue(values []MultiValued) string {
+	for _, v := range values {
+		if v.Primary && v.Value != "" {
+			return v.Value
+		}
+	}
+	for _, v := range values {
+		if v.Value != "" {
+			return v.Value
+		}
+	}
+	return ""
+}
+
+// Group 组资源。
+type Group struct {
+	Schemas     []string      `json:"schemas"`
+	ID          string        `json:"id,omitempty"`
+	ExternalID  string        `json:"externalId,omitempty"`
+	DisplayName string        `json:"displayName"`
+	Members     []MultiValued `json:"members,omitempty"`
+	Meta        *Meta         `json:"meta,omitempty"`
+}
+
+// ListResponse 查询结果。
+type ListResponse struct {
+	Schemas      []string `json:"schemas"`
+	TotalResults int      `json:"totalResults"`
+	StartIndex   int      `json:"startIndex"`
+	ItemsPerPage int      `json:"itemsPerPage"`
+	Resources    []any    `json:"Resources"`
+}
+
+// NewListResponse 构造查询结果，Resources 为空时输出空数组而不是 null。
+func NewListResponse(total, startIndex int, resources []any) *ListResponse {
+	if resources == nil {
+		resources = []any{}
+	}
+	return &ListResponse{
+		Schemas:      []string{SchemaListResponse},
+		TotalResults: total,
+		StartIndex:   startIndex,
+		ItemsPerPage: len(resources),
+		Resources:    resources,
+	}
+}
+
+// PatchOperation PATCH 操作。
+type PatchOperation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path,omitempty"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// PatchRequest PATCH 请求体。
+type PatchRequest struct {
+	Schemas    []string         `json:"schemas"`
+	Operations []PatchOperation `json:"Operations"`
+}
+
+// 错误类型（RFC 7644 3.12 scimType）
+const (
+	ErrTypeInvalidFilter = "invalidFilter"
+	ErrTypeInvalidSyntax = "invalidSyntax"
+	ErrTypeInvalidPath   = "invalidPath"
+	ErrTypeNoTarget      = "noTarget"
+	ErrTypeInvalidValue  = "invalidValue"
+	ErrTypeMutability    = "mutability"
+	ErrTypeUniqueness    = "uniqueness"
+)
+
+// Error SCIM 错误响应，同时实现 error 接口。
+type Error struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status"`
+	ScimType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail,omitempty"`
+}
+
+// NewError 构造错误响应。
+func NewError(status int, scimType, detail string) *Error {
+	return &Error{
+		Schemas:  []string{SchemaError},
+		Status:   strconv.Itoa(status),
+		ScimType: scimType,
+		Detail:   detail,
+	}
+}
+
+func (e *Error) Error() string {
+	if e.ScimType != "" {
+		return fmt.Sprintf("scim: %s %s: %s", e.Status, e.ScimType, e.Detail)
+	}
+	return fmt.Sprintf("scim: %s: %s", e.Status, e.Detail)
+}
+
+// StatusCode 返回 HTTP 状态码。
+func (e *Error) StatusCode() int {
+	code, err := strconv.Atoi(e.Status)
+	if err != nil {
+		return http.StatusInternalServerError
+	}
+	return code
+}
+
+// badRequest 请求内容错误。
+func badRequest(scimType, format string, args ...any) *Error {
+	return NewError(http.StatusBadRequest, scimType, fmt.Sprintf(format, args...))
+}
+
+// ParseListParams 解析 startIndex / count 查询参数（startIndex 从 1 开始，count 上限 MaxCount）。
+func ParseListParams(startIndex, count string) (int, int, error) {
+	start, size := 1, DefaultCount
+
+	if startIndex = strings.TrimSpace(startIndex); startIndex != "" {
+		v, err := strconv.Atoi(startIndex)
+		if err != nil {
+			return 0, 0, badRequest(ErrTypeInvalidValue, "invalid startIndex %q", startIndex)
+		}
+		// RFC 7644 3.4.2.4：小于 1 按 1 处理
+		if v > 1 {
+			start = v
+		}
+	}
+
+	if count = strings.TrimSpace(count); count != "" {
+		v, err := strconv.Atoi(count)
+		if err != nil {
+			return 0, 0, badRequest(ErrTypeInvalidValue, "invalid count %q", count)
+		}
+		// 负数按 0 处理，只返回总数
+		size = max(0, min(v, MaxCount))
+	}
+
+	return start, size, nil
+}
+
+// ETag 计算资源的弱 ETag：对去掉 meta 后的规范化 JSON 取 SHA-256。
+func ETag(resource any) (string, error) {
+	raw, err := json.Marshal(resource)
+	if err != nil {
+		return "", err
+	}
+
+	var m map[string]any
+	if err = json.Unmarshal(raw, &m); err != nil {
+		return "", err
+	}
+	delete(m, "meta")
+
+	// map 序列化按键排序，结果与字段声明顺序无关
+	if raw, err = json.Marshal(m); err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(raw)
+	return `W/"` + hex.EncodeToString(sum[:16]) + `"`, nil
+}
+
+// MatchETag 判断 If-Match / If-None-Match 头是否命中 etag，比较时忽略弱校验前缀。
+func MatchETag(header, etag string) bool {
+	header = strings.TrimSpace(header)
+	if header == "" {
+		return false
+	}
+	if header == "*" {
+		return true
+	}
+
+	want := strings.TrimPrefix(etag, "W/")
+	for _, part := range strings.Split(header, ",") {
+		if strings.TrimPrefix(strings.TrimSpace(part), "W/") == want {
+			return true
+		}
+	}
+	return false
+}
+
+// ServiceProviderConfig 服务能力声明。
+type ServiceProviderConfig struct {
+	Schemas               []string               `json:"schemas"`
+	DocumentationURI      string                 `json:"documentationUri,omitempty"`
+	Patch                 supported              `json:"patch"`
+	Bulk                  bulkConfig             `json:"bulk"`
+	Filter                filterConfig           `json:"filter"`
+	ChangePassword        supported              `json:"changePassword"`
+	Sort                  supported              `json:"sort"`
+	ETag                  supported              `json:"etag"`
+	AuthenticationSchemes []authenticationScheme `json:"authenticationSchemes"`
+}
+
+type supported struct {
+	Supported bool `json:"supported"`
+}
+
+type bulkConfig struct {
+	Supported      bool `json:"supported"`
+	MaxOperations  int  `json:"maxOperations"`
+	MaxPayloadSize int  `json:"maxPayloadSize"`
+}
+
+type filterConfig struct {
+	Supported  bool `json:"supported"`
+	MaxResults int  `json:"maxResults"`
+}
+
+type authenticationScheme struct {
+	Type        string `json:"type"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Primary     bool   `json:"primary"`
+}
+
+// NewServiceProviderConfig 返回本实现的能力声明。
+func NewServiceProviderConfig() *ServiceProviderConfig {
+	return &ServiceProviderConfig{
+		Schemas:        []string{SchemaServiceProviderConfig},
+		Patch:          supported{Supported: true},
+		Filter:         filterConfig{Supported: true, MaxResults: MaxCount},
+		ChangePassword: supported{Supported: false},
+		Sort:           supported{Supported: false},
+		ETag:           supported{Supported: true},
+		AuthenticationSchemes: []authenticationScheme{{
+			Type:        "oauthbearertoken",
+			Name:        "OAuth Bearer Token",
+			Description: "Authentication scheme using a per-tenant SCIM bearer token",
+			Primary:     true,
+		}},
+	}
+}
+
+// Decode 解析请求体，拒绝无法识别的 JSON。
+func Decode(body []byte, v any) error {
+	dec := json.NewDecoder(bytes.NewReader(body))
+	if err := dec.Decode(v); err != nil {
+		return badRequest(ErrTypeInvalidSyntax, "invalid request body: %s", err.Error())
+	}
+	return nil
+}
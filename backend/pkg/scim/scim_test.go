@@ -0,0 +1,217 @@
+package scim_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go-wind-admin/pkg/scim"
+)
+
+func ops(t *testing.T, raw string) []scim.PatchOperation {
+	t.Helper()
+	var req scim.PatchRequest
+	require.NoError(t, json.Unmarshal([]byte(raw), &req))
+	return req.Operations
+}
+
+func TestParseFilter(t *testing.T) {
+	f, err := scim.ParseFilter(`userName eq "alice@acme.test"`)
+	require.NoError(t, err)
+	assert.True(t, f.Is("username"))
+	assert.Equal(t, "alice@acme.test", f.Value)
+
+	f, err = scim.ParseFilter(`urn:ietf:params:scim:schemas:core:2.0:User:externalId EQ "00u1"`)
+	require.NoError(t, err)
+	assert.True(t, f.Is("externalId"))
+	assert.Equal(t, "00u1", f.Value)
+
+	f, err = scim.ParseFilter(`displayName eq "Quote \"Team\""`)
+	require.NoError(t, err)
+	assert.Equal(t, `Quote "Team"`, f.Value)
+
+	f, err = scim.ParseFilter("")
+	require.NoError(t, err)
+	assert.Nil(t, f)
+
+	for _, bad := range []string{
+		`userName`,
+		`userName sw "a"`,
+		`userName eq "a" and active eq true`,
+		`userName eq alice`,
+	} {
+		_, err = scim.ParseFilter(bad)
+		var scimErr *scim.Error
+		require.ErrorAs(t, err, &scimErr, bad)
+		assert.Equal(t, scim.ErrTypeInvalidFilter, scimErr.ScimType)
+		assert.Equal(t, http.StatusBadRequest, scimErr.StatusCode())
+	}
+}
+
+func TestUserLenientActive(t *testing.T) {
+	var u scim.User
+	require.NoError(t, json.Unmarshal([]byte(`{"userName":"a","active":"False"}`), &u))
+	assert.False(t, u.IsActive())
+
+	u = scim.User{}
+	require.NoError(t, json.Unmarshal([]byte(`{"userName":"a"}`), &u))
+	assert.True(t, u.IsActive())
+
+	assert.Error(t, json.Unmarshal([]byte(`{"active":"maybe"}`), &u))
+}
+
+func TestUserPrimaryValues(t *testing.T) {
+	u := scim.User{
+		Emails: []scim.MultiValued{
+			{Value: "home@acme.test", Type: "home"},
+			{Value: "work@acme.test", Type: "work", Primary: true},
+		},
+		PhoneNumbers: []scim.MultiValued{{Value: "+100"}},
+		Enterprise:   &scim.EnterpriseUser{Department: " Engineering "},
+	}
+	assert.Equal(t, "work@acme.test", u.PrimaryEmail())
+	assert.Equal(t, "+100", u.PrimaryPhoneNumber())
+	assert.Equal(t, "Engineering", u.Department())
+}
+
+func TestApplyPatchOktaStyle(t *testing.T) {
+	active := scim.Bool(true)
+	u := &scim.User{
+		Schemas:  []string{scim.SchemaUser},
+		ID:       "7",
+		UserName: "alice",
+		Active:   &active,
+		Name:     &scim.Name{GivenName: "Alice", FamilyName: "W"},
+	}
+
+	require.NoError(t, scim.ApplyPatch(u, ops(t, `{
+		"schemas": ["urn:ietf:params:scim:api:messages:2.0:PatchOp"],
+		"Operations": [{"op": "replace", "value": {"active": false, "name": {"givenName": "Alicia"}}}]
+	}`)))
+
+	assert.False(t, u.IsActive())
+	assert.Equal(t, "Alicia", u.Name.GivenName)
+	assert.Equal(t, "W", u.Name.FamilyName, "replace on a complex attribute keeps unspecified sub-attributes")
+	assert.Equal(t, "7", u.ID)
+}
+
+func TestApplyPatchEntraStyle(t *testing.T) {
+	u := &scim.User{
+		UserName: "bob",
+		Emails:   []scim.MultiValued{{Value: "old@acme.test", Type: "work", Primary: true}},
+	}
+
+	require.NoError(t, scim.ApplyPatch(u, ops(t, `{"Operations": [
+		{"op": "Replace", "path": "emails[type eq \"work\"].value", "value": "new@acme.test"},
+		{"op": "Add", "path": "phoneNumbers[type eq \"mobile\"].value", "value": "+200"},
+		{"op": "Replace", "path": "active", "value": "False"},
+		{"op": "Add", "value": {
+			"name.givenName": "Bob",
+			"urn:ietf:params:scim:schemas:extension:enterprise:2.0:User:department": "Sales"
+		}}
+	]}`)))
+
+	require.Len(t, u.Emails, 1)
+	assert.Equal(t, "new@acme.test", u.Emails[0].Value)
+	assert.True(t, bool(u.Emails[0].Primary))
+	require.Len(t, u.PhoneNumbers, 1)
+	assert.Equal(t, scim.MultiValued{Value: "+200", Type: "mobile"}, u.PhoneNumbers[0])
+	assert.False(t, u.IsActive())
+	assert.Equal(t, "Bob", u.Name.GivenName)
+	assert.Equal(t, "Sales", u.Department())
+
+	require.NoError(t, scim.ApplyPatch(u, ops(t, `{"Operations": [
+		{"op": "remove", "path": "urn:ietf:params:scim:schemas:extension:enterprise:2.0:User:department"},
+		{"op": "remove", "path": "phoneNumbers[type eq \"mobile\"]"}
+	]}`)))
+	assert.Empty(t, u.Department())
+	assert.Empty(t, u.PhoneNumbers)
+}
+
+func TestApplyPatchGroupMembers(t *testing.T) {
+	g := &scim.Group{
+		DisplayName: "Admins",
+		Members:     []scim.MultiValued{{Value: "1"}, {Value: "2"}},
+	}
+
+	require.NoError(t, scim.ApplyPatch(g, ops(t, `{"Operations": [
+		{"op": "add", "path": "members", "value": [{"value": "2"}, {"value": "3"}]},
+		{"op": "remove", "path": "members[value eq \"1\"]"}
+	]}`)))
+	assert.Equal(t, []scim.MultiValued{{Value: "2"}, {Value: "3"}}, g.Members)
+
+	// Entra ID 以 value 列出待移除成员
+	require.NoError(t, scim.ApplyPatch(g, ops(t, `{"Operations": [
+		{"op": "Remove", "path": "members", "value": [{"value": "3"}]}
+	]}`)))
+	assert.Equal(t, []scim.MultiValued{{Value: "2"}}, g.Members)
+
+	require.NoError(t, scim.ApplyPatch(g, ops(t, `{"Operations": [
+		{"op": "replace", "path": "members", "value": []},
+		{"op": "replace", "path": "displayName", "value": "Operators"}
+	]}`)))
+	assert.Empty(t, g.Members)
+	assert.Equal(t, "Operators", g.DisplayName)
+}
+
+func TestApplyPatchErrors(t *testing.T) {
+	g := &scim.Group{DisplayName: "Admins", Members: []scim.MultiValued{{Value: "1"}}}
+
+	for _, raw := range []string{
+		`{"Operations": [{"op": "move", "path": "members"}]}`,
+		`{"Operations": [{"op": "remove"}]}`,
+		`{"Operations": [{"op": "replace", "path": "members[value sw \"1\"]", "value": {}}]}`,
+		`{"Operations": [{"op": "add", "path": "displayName"}]}`,
+		`{"Operations": [{"op": "replace", "path": "displayName", "value": "x"}, {"op": "replace", "value": "x"}]}`,
+	} {
+		err := scim.ApplyPatch(g, ops(t, raw))
+		var scimErr *scim.Error
+		require.ErrorAs(t, err, &scimErr, raw)
+		assert.Equal(t, http.StatusBadRequest, scimErr.StatusCode())
+	}
+
+	// 失败的 PATCH 不修改资源
+	assert.Equal(t, "Admins", g.DisplayName)
+	assert.Len(t, g.Members, 1)
+}
+
+func TestETag(t *testing.T) {
+	u := &scim.User{Schemas: []string{scim.SchemaUser}, ID: "1", UserName: "alice"}
+	tag, err := scim.ETag(u)
+	require.NoError(t, err)
+	assert.Regexp(t, `^W/"[0-9a-f]{32}"$`, tag)
+
+	u.Meta = &scim.Meta{ResourceType: scim.ResourceTypeUser, Version: tag}
+	same, err := scim.ETag(u)
+	require.NoError(t, err)
+	assert.Equal(t, tag, same, "meta does not take part in the etag")
+
+	u.DisplayName = "Alice"
+	changed, err := scim.ETag(u)
+	require.NoError(t, err)
+	assert.NotEqual(t, tag, changed)
+
+	assert.True(t, scim.MatchETag(tag, tag))
+	assert.True(t, scim.MatchETag(`"other", `+tag[2:], tag))
+	assert.True(t, scim.MatchETag("*", tag))
+	assert.False(t, scim.MatchETag(changed, tag))
+	assert.False(t, scim.MatchETag("", tag))
+}
+
+func TestParseListParams(t *testing.T) {
+	start, count, err := scim.ParseListParams("", "")
+	require.NoError(t, err)
+	assert.Equal(t, 1, start)
+	assert.Equal(t, scim.DefaultCount, count)
+
+	start, count, err = scim.ParseListParams("0", "5000")
+	require.NoError(t, err)
+	assert.Equal(t, 1, start)
+	assert.Equal(t, scim.MaxCount, count)
+
+	_, _, err = scim.ParseListParams("x", "")
+	assert.Error(t, err)
+}
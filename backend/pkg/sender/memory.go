@@ -0,0 +1,73 @@
+package sender
+
+import (
+	"context"
+	"sync"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// MemorySender 将消息记录在内存中，不真正发出。
+// 传入 logger 时同时写一条日志（含正文），便于本地开发直接在控制台取验证码；生产环境切勿启用。
+type MemorySender struct {
+	mu       sync.Mutex
+	messages []Message
+	log      *log.Helper
+}
+
+func NewMemorySender(logger log.Logger) *MemorySender {
+	s := &MemorySender{}
+	if logger != nil {
+		s.log = log.NewHelper(log.With(logger, "module", "sender/memory"))
+	}
+	return s
+}
+
+func (s *MemorySender) Send(_ context.Context, msg *Message) error {
+	if err := msg.Validate(); err != nil {
+		return err
+	}
+
+	cp := *msg
+	if msg.Params != nil {
+		cp.Params = make(map[string]string, len(msg.Params))
+		for k, v := range msg.Params {
+			cp.Params[k] = v
+		}
+	}
+
+	s.mu.Lock()
+	s.messages = append(s.messages, cp)
+	s.mu.Unlock()
+
+	if s.log != nil {
+		s.log.Infof("[%s] to=%s subject=%q body=%q", msg.Channel, msg.To, msg.Subject, msg.Body)
+	}
+	return nil
+}
+
+// Messages 返回已记录消息的副本（按发送顺序）。
+func (s *MemorySender) Messages() []Message {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Message(nil), s.messages...)
+}
+
+// Last 返回发给指定收件人的最后一条消息。
+func (s *MemorySender) Last(to string) (Message, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i := len(s.messages) - 1; i >= 0; i-- {
+		if s.messages[i].To == to {
+			return s.messages[i], true
+		}
+	}
+	return Message{}, false
+}
+
+// Reset 清空已记录消息。
+func (s *MemorySender) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.messages = nil
+}
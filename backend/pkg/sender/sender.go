@@ -0,0 +1,109 @@
+// Package sender 消息投递抽象：验证码、通知等经 Sender 按渠道（邮件/短信）投递，业务层不感知具体通道。
+//
+// 内置驱动：
+//   - SMTPSender：邮件，经 SMTP 投递（支持 STARTTLS 与隐式 TLS）；
+//   - WebhookSender：短信，以 JSON POST 到自建/第三方短信网关，请求体带 HMAC-SHA256 签名；
+//   - MemorySender：记录到内存并可选写日志，供测试与本地开发使用（不真正发出）。
+//
+// Router 按渠道组合多个驱动，未注册的渠道返回 ErrChannelNotConfigured。
+package sender
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// Channel 投递渠道。
+type Channel string
+
+const (
+	ChannelEmail Channel = "email"
+	ChannelSMS   Channel = "sms"
+)
+
+var (
+	ErrChannelNotConfigured = errors.New("sender: channel not configured")
+	ErrInvalidMessage       = errors.New("sender: invalid message")
+	ErrInvalidConfig        = errors.New("sender: invalid config")
+)
+
+// Message 一条待投递消息。
+type Message struct {
+	Channel Channel
+	// To 收件人：邮件为邮箱地址，短信为手机号
+	To string
+	// Subject 邮件主题（短信忽略）
+	Subject string
+	// Body 纯文本正文
+	Body string
+	// Params 模板参数（如 code），供基于模板的短信网关使用
+	Params map[string]string
+}
+
+// Validate 基础校验：收件人与正文非空，收件人与主题不得含换行（防邮件头注入）。
+func (m *Message) Validate() error {
+	if m == nil || strings.TrimSpace(m.To) == "" || m.Body == "" {
+		return ErrInvalidMessage
+	}
+	if strings.ContainsAny(m.To, "\r\n") || strings.ContainsAny(m.Subject, "\r\n") {
+		return ErrInvalidMessage
+	}
+	return nil
+}
+
+// Sender 消息投递驱动。
+type Sender interface {
+	Send(ctx context.Context, msg *Message) error
+}
+
+// Router 按渠道分发到对应驱动。
+type Router struct {
+	mu      sync.RWMutex
+	senders map[Channel]Sender
+}
+
+func NewRouter() *Router {
+	return &Router{senders: make(map[Channel]Sender)}
+}
+
+// Register 为渠道注册驱动（重复注册覆盖），返回自身便于链式调用。
+func (r *Router) Register(channel Channel, s Sender) *Router {
+	if s == nil {
+		return r
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.senders[channel] = s
+	return r
+}
+
+// Supports 渠道是否已配置驱动。
+func (r *Router) Supports(channel Channel) bool {
+	if r == nil {
+		return false
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	_, ok := r.senders[channel]
+	return ok
+}
+
+// Send 校验消息并交给渠道驱动投递。
+func (r *Router) Send(ctx context.Context, msg *Message) error {
+	if err := msg.Validate(); err != nil {
+		return err
+	}
+	if r == nil {
+		return ErrChannelNotConfigured
+	}
+	r.mu.RLock()
+	s, ok := r.senders[msg.Channel]
+	r.mu.RUnlock()
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrChannelNotConfigured, msg.Channel)
+	}
+	return s.Send(ctx, msg)
+}
@@ -0,0 +1,161 @@
+package sender
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRouter(t *testing.T) {
+	mem := NewMemorySender(nil)
+	router := NewRouter().Register(ChannelSMS, mem)
+
+	assert.True(t, router.Supports(ChannelSMS))
+	assert.False(t, router.Supports(ChannelEmail))
+
+	msg := &Message{Channel: ChannelSMS, To: "13800000000", Body: "code 123456", Params: map[string]string{"code": "123456"}}
+	require.NoError(t, router.Send(context.Background(), msg))
+
+	err := router.Send(context.Background(), &Message{Channel: ChannelEmail, To: "a@example.com", Body: "hi"})
+	assert.True(t, errors.Is(err, ErrChannelNotConfigured))
+
+	// 收件人/主题含换行视为非法（防邮件头注入）
+	err = router.Send(context.Background(), &Message{Channel: ChannelSMS, To: "1380\r\nBcc: x", Body: "hi"})
+	assert.True(t, errors.Is(err, ErrInvalidMessage))
+
+	last, ok := mem.Last("13800000000")
+	require.True(t, ok)
+	assert.Equal(t, "123456", last.Params["code"])
+
+	// 记录的是副本：调用方事后修改不影响已记录消息
+	msg.Params["code"] = "000000"
+	last, _ = mem.Last("13800000000")
+	assert.Equal(t, "123456", last.Params["code"])
+
+	mem.Reset()
+	assert.Empty(t, mem.Messages())
+}
+
+func TestWebhookSender(t *testing.T) {
+	var (
+		gotPayload WebhookPayload
+		gotSig     string
+		gotTs      string
+		gotBody    []byte
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotBody, _ = io.ReadAll(r.Body)
+		gotSig = r.Header.Get(WebhookSignatureHeader)
+		gotTs = r.Header.Get(WebhookTimestampHeader)
+		_ = json.Unmarshal(gotBody, &gotPayload)
+		if gotPayload.To == "reject" {
+			w.WriteHeader(http.StatusBadGateway)
+		}
+	}))
+	defer srv.Close()
+
+	s, err := NewWebhookSender(WebhookConfig{URL: srv.URL, Secret: "s3cret"})
+	require.NoError(t, err)
+
+	err = s.Send(context.Background(), &Message{Channel: ChannelSMS, To: "13800000000", Body: "code 123456", Params: map[string]string{"code": "123456"}})
+	require.NoError(t, err)
+	assert.Equal(t, ChannelSMS, gotPayload.Channel)
+	assert.Equal(t, "13800000000", gotPayload.To)
+	assert.Equal(t, "123456", gotPayload.Params["code"])
+	assert.Equal(t, "sha256="+SignWebhook("s3cret", gotTs, gotBody), gotSig)
+
+	err = s.Send(context.Background(), &Message{Channel: ChannelSMS, To: "reject", Body: "x"})
+	assert.Error(t, err)
+
+	_, err = NewWebhookSender(WebhookConfig{URL: "ftp://example.com"})
+	assert.True(t, errors.Is(err, ErrInvalidConfig))
+}
+
+// fakeSMTPServer 最小 SMTP 服务端：应答一次会话并返回 DATA 段原文。
+func fakeSMTPServer(t *testing.T) (addr string, data <-chan string) {
+	t.Helper()
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = ln.Close() })
+
+	ch := make(chan string, 1)
+	go func() {
+		conn, aerr := ln.Accept()
+		if aerr != nil {
+			return
+		}
+		defer func() { _ = conn.Close() }()
+
+		r := bufio.NewReader(conn)
+		reply := func(s string) { _, _ = conn.Write([]byte(s + "\r\n")) }
+		reply("220 localhost ESMTP")
+		for {
+			line, rerr := r.ReadString('\n')
+			if rerr != nil {
+				return
+			}
+			cmd := strings.ToUpper(strings.TrimSpace(line))
+			switch {
+			case strings.HasPrefix(cmd, "EHLO"), strings.HasPrefix(cmd, "HELO"):
+				reply("250 localhost")
+			case strings.HasPrefix(cmd, "MAIL"), strings.HasPrefix(cmd, "RCPT"):
+				reply("250 OK")
+			case cmd == "DATA":
+				reply("354 go ahead")
+				var sb strings.Builder
+				for {
+					l, derr := r.ReadString('\n')
+					if derr != nil || l == ".\r\n" {
+						break
+					}
+					sb.WriteString(l)
+				}
+				ch <- sb.String()
+				reply("250 queued")
+			case cmd == "QUIT":
+				reply("221 bye")
+				return
+			default:
+				reply("502 not implemented")
+			}
+		}
+	}()
+	return ln.Addr().String(), ch
+}
+
+func TestSMTPSender(t *testing.T) {
+	addr, data := fakeSMTPServer(t)
+
+	s, err := NewSMTPSender(SMTPConfig{Addr: addr, From: "GoWindAdmin <no-reply@example.com>"})
+	require.NoError(t, err)
+
+	err = s.Send(context.Background(), &Message{Channel: ChannelEmail, To: "alice@example.com", Subject: "登录验证码", Body: "您的验证码为 123456"})
+	require.NoError(t, err)
+
+	raw := <-data
+	assert.Contains(t, raw, "To: <alice@example.com>\r\n")
+	assert.Contains(t, raw, "Subject: =?UTF-8?b?")
+	parts := strings.SplitN(raw, "\r\n\r\n", 2)
+	require.Len(t, parts, 2)
+	body, err := base64.StdEncoding.DecodeString(strings.ReplaceAll(parts[1], "\r\n", ""))
+	require.NoError(t, err)
+	assert.Equal(t, "您的验证码为 123456", string(body))
+
+	err = s.Send(context.Background(), &Message{Channel: ChannelEmail, To: "not-an-address", Body: "x"})
+	assert.True(t, errors.Is(err, ErrInvalidMessage))
+
+	_, err = NewSMTPSender(SMTPConfig{Addr: "no-port", From: "a@example.com"})
+	assert.True(t, errors.Is(err, ErrInvalidConfig))
+}
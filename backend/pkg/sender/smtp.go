@@ -0,0 +1,174 @@
+package sender
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"mime"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"time"
+)
+
+// DefaultTimeout 单次投递（建连 + 会话）的默认超时。
+const DefaultTimeout = 10 * time.Second
+
+// SMTPConfig 邮件投递配置。
+type SMTPConfig struct {
+	// Addr SMTP 服务地址 host:port
+	Addr string
+	// Username / Password 认证账号，Username 为空时不认证。
+	// 使用 PLAIN 认证，仅在 TLS 连接（或本机回环）上发送口令。
+	Username string
+	Password string
+	// From 发件人，可带显示名，如 "GoWindAdmin <no-reply@example.com>"
+	From string
+	// ImplicitTLS 建连即 TLS（常见于 465 端口）；否则在服务端支持时升级 STARTTLS
+	ImplicitTLS bool
+	// TLSConfig 自定义 TLS 配置，为空时按 Addr 主机名校验证书
+	TLSConfig *tls.Config
+	// Timeout 单次投递超时，默认 DefaultTimeout
+	Timeout time.Duration
+}
+
+// SMTPSender 经 SMTP 投递纯文本邮件。
+type SMTPSender struct {
+	cfg  SMTPConfig
+	host string
+	from *mail.Address
+}
+
+func NewSMTPSender(cfg SMTPConfig) (*SMTPSender, error) {
+	host, _, err := net.SplitHostPort(cfg.Addr)
+	if err != nil || host == "" {
+		return nil, fmt.Errorf("%w: smtp addr must be host:port", ErrInvalidConfig)
+	}
+	from, err := mail.ParseAddress(cfg.From)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid smtp from address", ErrInvalidConfig)
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = DefaultTimeout
+	}
+	return &SMTPSender{cfg: cfg, host: host, from: from}, nil
+}
+
+func (s *SMTPSender) Send(ctx context.Context, msg *Message) error {
+	if err := msg.Validate(); err != nil {
+		return err
+	}
+	to, err := mail.ParseAddress(msg.To)
+	if err != nil {
+		return fmt.Errorf("%w: invalid email address", ErrInvalidMessage)
+	}
+	raw, err := s.buildMessage(to, msg)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.cfg.Timeout)
+	defer cancel()
+
+	conn, err := s.dial(ctx)
+	if err != nil {
+		return fmt.Errorf("sender: smtp dial failed: %w", err)
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	client, err := smtp.NewClient(conn, s.host)
+	if err != nil {
+		_ = conn.Close()
+		return fmt.Errorf("sender: smtp handshake failed: %w", err)
+	}
+	defer func() { _ = client.Close() }()
+
+	if !s.cfg.ImplicitTLS {
+		if ok, _ := client.Extension("STARTTLS"); ok {
+			if err = client.StartTLS(s.tlsConfig()); err != nil {
+				return fmt.Errorf("sender: smtp starttls failed: %w", err)
+			}
+		}
+	}
+	if s.cfg.Username != "" {
+		if err = client.Auth(smtp.PlainAuth("", s.cfg.Username, s.cfg.Password, s.host)); err != nil {
+			return fmt.Errorf("sender: smtp auth failed: %w", err)
+		}
+	}
+
+	if err = client.Mail(s.from.Address); err != nil {
+		return fmt.Errorf("sender: smtp MAIL FROM failed: %w", err)
+	}
+	if err = client.Rcpt(to.Address); err != nil {
+		return fmt.Errorf("sender: smtp RCPT TO failed: %w", err)
+	}
+	w, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("sender: smtp DATA failed: %w", err)
+	}
+	if _, err = w.Write(raw); err != nil {
+		_ = w.Close()
+		return fmt.Errorf("sender: smtp write failed: %w", err)
+	}
+	if err = w.Close(); err != nil {
+		return fmt.Errorf("sender: smtp DATA rejected: %w", err)
+	}
+	return client.Quit()
+}
+
+func (s *SMTPSender) dial(ctx context.Context) (net.Conn, error) {
+	if s.cfg.ImplicitTLS {
+		d := &tls.Dialer{Config: s.tlsConfig()}
+		return d.DialContext(ctx, "tcp", s.cfg.Addr)
+	}
+	var d net.Dialer
+	return d.DialContext(ctx, "tcp", s.cfg.Addr)
+}
+
+func (s *SMTPSender) tlsConfig() *tls.Config {
+	if s.cfg.TLSConfig != nil {
+		return s.cfg.TLSConfig.Clone()
+	}
+	return &tls.Config{ServerName: s.host, MinVersion: tls.VersionTLS12}
+}
+
+// buildMessage 组装 RFC 5322 邮件：UTF-8 纯文本，主题 B 编码，正文 base64（每行 76 字符）。
+func (s *SMTPSender) buildMessage(to *mail.Address, msg *Message) ([]byte, error) {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	writeHeader := func(k, v string) {
+		buf.WriteString(k)
+		buf.WriteString(": ")
+		buf.WriteString(v)
+		buf.WriteString("\r\n")
+	}
+	writeHeader("From", s.from.String())
+	writeHeader("To", to.String())
+	writeHeader("Subject", mime.BEncoding.Encode("UTF-8", msg.Subject))
+	writeHeader("Date", time.Now().Format(time.RFC1123Z))
+	writeHeader("Message-ID", fmt.Sprintf("<%s@%s>", hex.EncodeToString(id), s.host))
+	writeHeader("MIME-Version", "1.0")
+	writeHeader("Content-Type", `text/plain; charset="UTF-8"`)
+	writeHeader("Content-Transfer-Encoding", "base64")
+	buf.WriteString("\r\n")
+
+	body := base64.StdEncoding.EncodeToString([]byte(msg.Body))
+	for len(body) > 76 {
+		buf.WriteString(body[:76])
+		buf.WriteString("\r\n")
+		body = body[76:]
+	}
+	buf.WriteString(body)
+	buf.WriteString("\r\n")
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,117 @@
+package sender
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+const (
+	// WebhookSignatureHeader 请求签名头：sha256=<hex(HMAC-SHA256(secret, timestamp + "." + body))>
+	WebhookSignatureHeader = "X-Gwa-Signature"
+	// WebhookTimestampHeader 签名时间戳头（Unix 秒），网关据此拒绝过期重放
+	WebhookTimestampHeader = "X-Gwa-Timestamp"
+)
+
+// WebhookConfig 短信网关 webhook 配置。
+type WebhookConfig struct {
+	// URL 网关地址（http/https）
+	URL string
+	// Secret 签名密钥，为空时不签名
+	Secret string
+	// Timeout 单次投递超时，默认 DefaultTimeout
+	Timeout time.Duration
+	// HTTPClient 自定义 HTTP 客户端，为空时使用带超时的默认客户端
+	HTTPClient *http.Client
+}
+
+// WebhookPayload webhook 请求体。
+type WebhookPayload struct {
+	Channel Channel           `json:"channel"`
+	To      string            `json:"to"`
+	Subject string            `json:"subject,omitempty"`
+	Content string            `json:"content"`
+	Params  map[string]string `json:"params,omitempty"`
+}
+
+// WebhookSender 将消息以 JSON POST 到短信网关，2xx 视为受理成功。
+// 对接具体运营商（模板 ID、签名名称等）由网关侧完成，本端只投递内容与模板参数。
+type WebhookSender struct {
+	cfg    WebhookConfig
+	client *http.Client
+}
+
+func NewWebhookSender(cfg WebhookConfig) (*WebhookSender, error) {
+	u, err := url.Parse(cfg.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("%w: webhook url must be http(s)", ErrInvalidConfig)
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = DefaultTimeout
+	}
+	client := cfg.HTTPClient
+	if client == nil {
+		client = &http.Client{Timeout: cfg.Timeout}
+	}
+	return &WebhookSender{cfg: cfg, client: client}, nil
+}
+
+func (s *WebhookSender) Send(ctx context.Context, msg *Message) error {
+	if err := msg.Validate(); err != nil {
+		return err
+	}
+	body, err := json.Marshal(WebhookPayload{
+		Channel: msg.Channel,
+		To:      msg.To,
+		Subject: msg.Subject,
+		Content: msg.Body,
+		Params:  msg.Params,
+	})
+	if err != nil {
+		return fmt.Errorf("sender: marshal webhook payload failed: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.cfg.Timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.cfg.URL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("sender: build webhook request failed: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if s.cfg.Secret != "" {
+		ts := strconv.FormatInt(time.Now().Unix(), 10)
+		req.Header.Set(WebhookTimestampHeader, ts)
+		req.Header.Set(WebhookSignatureHeader, "sha256="+SignWebhook(s.cfg.Secret, ts, body))
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("sender: webhook request failed: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("sender: webhook responded %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// SignWebhook 计算 webhook 签名（hex），网关侧按同一算法校验。
+func SignWebhook(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,9 @@
+package task
+
+// JwtKeyRotationTaskType 是 JWT 签名密钥轮换任务的类型常量。
+// 与备份任务一样由管理员在任务模块中创建（写入 sys_tasks 表），按 cron 周期定期轮换；
+// handler 为 TaskService.AsyncJwtKeyRotation。
+const JwtKeyRotationTaskType = "jwt_key_rotation"
+
+// JwtKeyRotationTaskData JWT 签名密钥轮换任务的载荷（当前无参数）。
+type JwtKeyRotationTaskData struct{}
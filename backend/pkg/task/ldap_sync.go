@@ -0,0 +1,12 @@
+package task
+
+// LdapSyncTaskType 是 LDAP 目录同步任务的类型常量。
+// 由管理员在任务模块中按 cron 周期创建（写入 sys_tasks 表），定期从目录同步用户、组映射角色与组织单元，
+// 并停用目录中已删除的账号；handler 为 TaskService.AsyncLdapSync。
+const LdapSyncTaskType = "ldap_sync"
+
+// LdapSyncTaskData LDAP 目录同步任务的载荷。
+type LdapSyncTaskData struct {
+	// TenantID 只同步指定租户，为 0 时同步全部已启用目录
+	TenantID uint32 `json:"tenantId,omitempty"`
+}
@@ -35,19 +35,36 @@ func (c *ApiPermissionConverter) ConvertCodeByOperationID(operationID string) st
 		resource = resource + ":" + stringcase.KebabCase(name)
 	}
 
+	return resource + ":" + NormalizeAction(action)
+}
+
+// NormalizeAction 将动作别名归一为权限码中的标准动作（如 update -> edit，list -> view）
+func NormalizeAction(action string) string {
 	action = stringcase.KebabCase(action)
 	switch action {
 	case "list", "get", "retrieve", "query", "exist":
-		action = "view"
+		return "view"
 	case "create", "add", "new":
-		action = "create"
+		return "create"
 	case "update", "edit", "modify", "change":
-		action = "edit"
+		return "edit"
 	case "delete", "remove", "del":
-		action = "delete"
+		return "delete"
 	}
+	return action
+}
 
-	return resource + ":" + action
+// PermissionCode 由动作与资源生成 resource:action 风格的 code（如 ("update", "OrgUnit") -> org-unit:edit），
+// 资源名按单数 kebab-case 归一，多级资源（如字段级的 user:mobile）逐段归一，
+// 与 ConvertCodeByPath / ConvertCodeByOperationID 生成的 code 一致
+func PermissionCode(action, resource string) string {
+	var c ApiPermissionConverter
+	segs := strings.Split(resource, ":")
+	segs[0] = c.singularizeSegments(segs[0])
+	for i := range segs {
+		segs[i] = stringcase.KebabCase(segs[i])
+	}
+	return strings.Join(segs, ":") + ":" + NormalizeAction(action)
 }
 
 // ConvertCodeByPath 通过 HTTP 方法和路径生成 resource:action 风格的 code（如 users:delete, users:list）
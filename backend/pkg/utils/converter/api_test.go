@@ -63,6 +63,33 @@ func TestApiPermissionConverter_ConvertByOperationID(t *testing.T) {
 	}
 }
 
+func TestPermissionCode(t *testing.T) {
+	cases := []struct {
+		name     string
+		action   string
+		resource string
+		want     string
+	}{
+		{"ent type name", "update", "OrgUnit", "org-unit:edit"},
+		{"plural resource", "list", "users", "user:view"},
+		{"kebab resource", "delete", "user-group", "user-group:delete"},
+		{"standard action", "create", "position", "position:create"},
+		{"unknown action kept", "Export", "Position", "position:export"},
+		{"field resource", "update", "User:lockedUntil", "user:locked-until:edit"},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got := PermissionCode(tc.action, tc.resource)
+			if got != tc.want {
+				t.Fatalf("PermissionCode(%q, %q) = %q, want %q", tc.action, tc.resource, got, tc.want)
+			}
+		})
+	}
+}
+
 func TestStripVersionPrefix(t *testing.T) {
 	c := NewApiPermissionConverter()
 
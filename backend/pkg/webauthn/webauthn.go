@@ -0,0 +1,452 @@
+// Package webauthn WebAuthn / 通行密钥（passkey）依赖方：封装 go-webauthn 的注册与断言仪式。
+//
+// 与前端交换的二进制字段一律为 base64url 字符串，仪式参数以 JSON 形式下发（可直接交给
+// navigator.credentials.create / get）。仪式状态序列化为不透明字节，由调用方在 Begin 与 Finish
+// 之间暂存于服务端（MFA 挑战缓存），绝不下发给客户端。
+//
+// 签名计数器：断言中的 signCount 未大于已存值（且两者不全为 0）时视为凭证可能被克隆，
+// Finish 返回 ErrCloneDetected；同步类通行密钥的计数器恒为 0，不参与比较。
+package webauthn
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	gowebauthn "github.com/go-webauthn/webauthn/webauthn"
+)
+
+// MaxCredentialIDLength 凭证 ID 原始字节上限，base64url 编码后不超过 512 个字符（与落库列宽一致）。
+const MaxCredentialIDLength = 384
+
+// DefaultTimeout 仪式超时（下发给浏览器，并在服务端校验）。
+const DefaultTimeout = 5 * time.Minute
+
+var (
+	ErrInvalidConfig     = errors.New("webauthn: invalid relying party config")
+	ErrInvalidResponse   = errors.New("webauthn: invalid authenticator response")
+	ErrInvalidSession    = errors.New("webauthn: invalid ceremony session")
+	ErrUnknownCredential = errors.New("webauthn: unknown credential")
+	ErrCloneDetected     = errors.New("webauthn: signature counter did not increase, credential may be cloned")
+)
+
+// Config 依赖方配置。
+type Config struct {
+	// RPID 依赖方标识，为前端页面的有效域名（不含协议与端口），如 admin.example.com
+	RPID string
+	// RPDisplayName 认证器内展示的依赖方名称
+	RPDisplayName string
+	// RPOrigins 允许发起仪式的前端源，如 https://admin.example.com
+	RPOrigins []string
+	// Timeout 仪式超时，为 0 时使用 DefaultTimeout
+	Timeout time.Duration
+}
+
+// Credential 已注册的凭证（落库形态）。
+type Credential struct {
+	ID                []byte
+	PublicKey         []byte
+	AttestationType   string
+	AttestationFormat string
+	Transports        []string
+	// Flags 注册 / 最近一次断言时的认证器标志位（UP/UV/BE/BS），断言时校验备份资格（BE）不可变
+	Flags     uint8
+	AAGUID    []byte
+	SignCount uint32
+}
+
+// User 参与仪式的用户。
+type User struct {
+	// Handle 用户句柄（WebAuthn user.id），写入可发现凭证，无密码登录时据此反查用户
+	Handle      []byte
+	Name        string
+	DisplayName string
+	Credentials []Credential
+}
+
+// Challenge 一次仪式的下发参数与服务端暂存状态。
+type Challenge struct {
+	// Challenge base64url 编码的随机挑战
+	Challenge string
+	// OptionsJSON 形如 {"publicKey":{...}} 的仪式参数
+	OptionsJSON string
+	// Session 服务端暂存的仪式状态，Finish 时原样传回
+	Session []byte
+}
+
+// AttestationResponse 注册仪式中认证器的响应，字段均为 base64url。
+type AttestationResponse struct {
+	ID                string
+	ClientDataJSON    string
+	AttestationObject string
+	Transports        []string
+}
+
+// AssertionResponse 断言仪式中认证器的响应，字段均为 base64url。
+type AssertionResponse struct {
+	ID                string
+	ClientDataJSON    string
+	AuthenticatorData string
+	Signature         string
+	UserHandle        string
+}
+
+// RelyingParty WebAuthn 依赖方。
+type RelyingParty struct {
+	wa *gowebauthn.WebAuthn
+}
+
+// New 创建依赖方。
+func New(cfg Config) (*RelyingParty, error) {
+	if cfg.RPID == "" || len(cfg.RPOrigins) == 0 {
+		return nil, fmt.Errorf("%w: rp id and origins are required", ErrInvalidConfig)
+	}
+	if cfg.RPDisplayName == "" {
+		cfg.RPDisplayName = cfg.RPID
+	}
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	ceremonyTimeout := gowebauthn.TimeoutConfig{Enforce: true, Timeout: timeout, TimeoutUVD: timeout}
+
+	wa, err := gowebauthn.New(&gowebauthn.Config{
+		RPID:          cfg.RPID,
+		RPDisplayName: cfg.RPDisplayName,
+		RPOrigins:     cfg.RPOrigins,
+		Timeouts: gowebauthn.TimeoutsConfig{
+			Login:        ceremonyTimeout,
+			Registration: ceremonyTimeout,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidConfig, err)
+	}
+	return &RelyingParty{wa: wa}, nil
+}
+
+// RPID 返回依赖方标识。
+func (rp *RelyingParty) RPID() string {
+	return rp.wa.Config.RPID
+}
+
+// BeginRegistration 开始注册仪式：排除用户已有凭证，优先创建可发现凭证（通行密钥）以便无密码登录。
+func (rp *RelyingParty) BeginRegistration(user *User) (*Challenge, error) {
+	u := ceremonyUser{user}
+	creation, session, err := rp.wa.BeginRegistration(u,
+		gowebauthn.WithExclusions(gowebauthn.Credentials(u.WebAuthnCredentials()).CredentialDescriptors()),
+		gowebauthn.WithAuthenticatorSelection(protocol.AuthenticatorSelection{
+			ResidentKey:      protocol.ResidentKeyRequirementPreferred,
+			UserVerification: protocol.VerificationPreferred,
+		}),
+		gowebauthn.WithConveyancePreference(protocol.PreferNoAttestation),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("begin registration failed: %w", err)
+	}
+	return newChallenge(creation.Response.Challenge, creation, session)
+}
+
+// FinishRegistration 校验注册响应，返回新凭证。
+func (rp *RelyingParty) FinishRegistration(user *User, session []byte, resp *AttestationResponse) (*Credential, error) {
+	sd, err := decodeSession(session)
+	if err != nil {
+		return nil, err
+	}
+	if resp == nil {
+		return nil, fmt.Errorf("%w: empty response", ErrInvalidResponse)
+	}
+
+	id, err := canonicalB64(resp.ID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: credential id: %v", ErrInvalidResponse, err)
+	}
+	clientData, err := canonicalB64(resp.ClientDataJSON)
+	if err != nil {
+		return nil, fmt.Errorf("%w: client data: %v", ErrInvalidResponse, err)
+	}
+	attestation, err := canonicalB64(resp.AttestationObject)
+	if err != nil {
+		return nil, fmt.Errorf("%w: attestation object: %v", ErrInvalidResponse, err)
+	}
+
+	body, _ := json.Marshal(map[string]any{
+		"id":    id,
+		"rawId": id,
+		"type":  string(protocol.PublicKeyCredentialType),
+		"response": map[string]any{
+			"clientDataJSON":    clientData,
+			"attestationObject": attestation,
+			"transports":        resp.Transports,
+		},
+	})
+	parsed, err := protocol.ParseCredentialCreationResponseBytes(body)
+	if err != nil {
+		return nil, responseError(err)
+	}
+
+	cred, err := rp.wa.CreateCredential(ceremonyUser{user}, *sd, parsed)
+	if err != nil {
+		return nil, responseError(err)
+	}
+	if len(cred.ID) > MaxCredentialIDLength {
+		return nil, fmt.Errorf("%w: credential id too long", ErrInvalidResponse)
+	}
+	return fromLibraryCredential(cred), nil
+}
+
+// BeginLogin 开始断言仪式（二次验证），允许列表为用户已注册的凭证。
+func (rp *RelyingParty) BeginLogin(user *User) (*Challenge, error) {
+	if user == nil || len(user.Credentials) == 0 {
+		return nil, ErrUnknownCredential
+	}
+	assertion, session, err := rp.wa.BeginLogin(ceremonyUser{user},
+		gowebauthn.WithUserVerification(protocol.VerificationPreferred),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("begin login failed: %w", err)
+	}
+	return newChallenge(assertion.Response.Challenge, assertion, session)
+}
+
+// FinishLogin 校验断言，返回计数器与标志位已更新的凭证。
+// 检测到克隆时同时返回凭证（计数器未更新）与 ErrCloneDetected，调用方据此停用该凭证。
+func (rp *RelyingParty) FinishLogin(user *User, session []byte, resp *AssertionResponse) (*Credential, error) {
+	sd, err := decodeSession(session)
+	if err != nil {
+		return nil, err
+	}
+	parsed, err := parseAssertion(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	cred, err := rp.wa.ValidateLogin(ceremonyUser{user}, *sd, parsed)
+	if err != nil {
+		return nil, responseError(err)
+	}
+	return checkCounter(cred)
+}
+
+// BeginDiscoverableLogin 开始无密码登录仪式：不指定用户，由认证器选择可发现凭证，强制用户验证（UV）。
+func (rp *RelyingParty) BeginDiscoverableLogin() (*Challenge, error) {
+	assertion, session, err := rp.wa.BeginDiscoverableLogin(
+		gowebauthn.WithUserVerification(protocol.VerificationRequired),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("begin discoverable login failed: %w", err)
+	}
+	return newChallenge(assertion.Response.Challenge, assertion, session)
+}
+
+// FinishDiscoverableLogin 校验无密码登录断言。lookup 按用户句柄加载用户及其凭证，找不到时返回 ErrUnknownCredential。
+func (rp *RelyingParty) FinishDiscoverableLogin(session []byte, resp *AssertionResponse, lookup func(userHandle []byte) (*User, error)) (*User, *Credential, error) {
+	sd, err := decodeSession(session)
+	if err != nil {
+		return nil, nil, err
+	}
+	parsed, err := parseAssertion(resp)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		found     *User
+		lookupErr error
+	)
+	_, cred, err := rp.wa.ValidatePasskeyLogin(func(_, userHandle []byte) (gowebauthn.User, error) {
+		found, lookupErr = lookup(userHandle)
+		if lookupErr != nil {
+			return nil, lookupErr
+		}
+		if found == nil {
+			lookupErr = ErrUnknownCredential
+			return nil, lookupErr
+		}
+		return ceremonyUser{found}, nil
+	}, *sd, parsed)
+	if lookupErr != nil {
+		return nil, nil, lookupErr
+	}
+	if err != nil {
+		return nil, nil, responseError(err)
+	}
+
+	c, err := checkCounter(cred)
+	return found, c, err
+}
+
+// FindCredential 在用户凭证中按 ID 查找。
+func (u *User) FindCredential(id []byte) *Credential {
+	for i := range u.Credentials {
+		if bytes.Equal(u.Credentials[i].ID, id) {
+			return &u.Credentials[i]
+		}
+	}
+	return nil
+}
+
+// EncodeID 将凭证 ID 编码为 base64url（无填充），用于落库与展示。
+func EncodeID(id []byte) string {
+	return base64.RawURLEncoding.EncodeToString(id)
+}
+
+// DecodeID 解析 base64url 编码的凭证 ID（容忍填充）。
+func DecodeID(s string) ([]byte, error) {
+	return base64.RawURLEncoding.DecodeString(strings.TrimRight(s, "="))
+}
+
+func checkCounter(cred *gowebauthn.Credential) (*Credential, error) {
+	c := fromLibraryCredential(cred)
+	if cred.Authenticator.CloneWarning {
+		return c, ErrCloneDetected
+	}
+	return c, nil
+}
+
+func parseAssertion(resp *AssertionResponse) (*protocol.ParsedCredentialAssertionData, error) {
+	if resp == nil {
+		return nil, fmt.Errorf("%w: empty response", ErrInvalidResponse)
+	}
+
+	fields := map[string]string{}
+	for name, value := range map[string]string{
+		"id":                resp.ID,
+		"clientDataJSON":    resp.ClientDataJSON,
+		"authenticatorData": resp.AuthenticatorData,
+		"signature":         resp.Signature,
+	} {
+		v, err := canonicalB64(value)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidResponse, name, err)
+		}
+		fields[name] = v
+	}
+	response := map[string]any{
+		"clientDataJSON":    fields["clientDataJSON"],
+		"authenticatorData": fields["authenticatorData"],
+		"signature":         fields["signature"],
+	}
+	if resp.UserHandle != "" {
+		v, err := canonicalB64(resp.UserHandle)
+		if err != nil {
+			return nil, fmt.Errorf("%w: user handle: %v", ErrInvalidResponse, err)
+		}
+		response["userHandle"] = v
+	}
+
+	body, _ := json.Marshal(map[string]any{
+		"id":       fields["id"],
+		"rawId":    fields["id"],
+		"type":     string(protocol.PublicKeyCredentialType),
+		"response": response,
+	})
+	parsed, err := protocol.ParseCredentialRequestResponseBytes(body)
+	if err != nil {
+		return nil, responseError(err)
+	}
+	return parsed, nil
+}
+
+// canonicalB64 校验并规范化 base64url 字段（去掉填充）。
+func canonicalB64(s string) (string, error) {
+	if s == "" {
+		return "", errors.New("missing")
+	}
+	raw, err := DecodeID(s)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+func newChallenge(challenge protocol.URLEncodedBase64, options any, session *gowebauthn.SessionData) (*Challenge, error) {
+	optionsJSON, err := json.Marshal(options)
+	if err != nil {
+		return nil, fmt.Errorf("marshal ceremony options failed: %w", err)
+	}
+	sessionData, err := json.Marshal(session)
+	if err != nil {
+		return nil, fmt.Errorf("marshal ceremony session failed: %w", err)
+	}
+	return &Challenge{
+		Challenge:   challenge.String(),
+		OptionsJSON: string(optionsJSON),
+		Session:     sessionData,
+	}, nil
+}
+
+func decodeSession(session []byte) (*gowebauthn.SessionData, error) {
+	var sd gowebauthn.SessionData
+	if len(session) == 0 || json.Unmarshal(session, &sd) != nil || sd.Challenge == "" {
+		return nil, ErrInvalidSession
+	}
+	return &sd, nil
+}
+
+// responseError 将库的校验错误归为 ErrInvalidResponse，保留其细节便于排查。
+func responseError(err error) error {
+	var pe *protocol.Error
+	if errors.As(err, &pe) {
+		return fmt.Errorf("%w: %s %s", ErrInvalidResponse, pe.Details, pe.DevInfo)
+	}
+	return fmt.Errorf("%w: %v", ErrInvalidResponse, err)
+}
+
+func fromLibraryCredential(c *gowebauthn.Credential) *Credential {
+	transports := make([]string, 0, len(c.Transport))
+	for _, t := range c.Transport {
+		transports = append(transports, string(t))
+	}
+	return &Credential{
+		ID:                c.ID,
+		PublicKey:         c.PublicKey,
+		AttestationType:   c.AttestationType,
+		AttestationFormat: c.AttestationFormat,
+		Transports:        transports,
+		Flags:             uint8(c.Flags.ProtocolValue()),
+		AAGUID:            c.Authenticator.AAGUID,
+		SignCount:         c.Authenticator.SignCount,
+	}
+}
+
+func (c *Credential) toLibrary() gowebauthn.Credential {
+	transports := make([]protocol.AuthenticatorTransport, 0, len(c.Transports))
+	for _, t := range c.Transports {
+		transports = append(transports, protocol.AuthenticatorTransport(t))
+	}
+	return gowebauthn.Credential{
+		ID:                c.ID,
+		PublicKey:         c.PublicKey,
+		AttestationType:   c.AttestationType,
+		AttestationFormat: c.AttestationFormat,
+		Transport:         transports,
+		Flags:             gowebauthn.NewCredentialFlags(protocol.AuthenticatorFlags(c.Flags)),
+		Authenticator: gowebauthn.Authenticator{
+			AAGUID:    c.AAGUID,
+			SignCount: c.SignCount,
+		},
+	}
+}
+
+// ceremonyUser 将 User 适配为 go-webauthn 的 User 接口。
+type ceremonyUser struct {
+	*User
+}
+
+func (u ceremonyUser) WebAuthnID() []byte          { return u.Handle }
+func (u ceremonyUser) WebAuthnName() string        { return u.Name }
+func (u ceremonyUser) WebAuthnDisplayName() string { return u.DisplayName }
+
+func (u ceremonyUser) WebAuthnCredentials() []gowebauthn.Credential {
+	creds := make([]gowebauthn.Credential, 0, len(u.Credentials))
+	for i := range u.Credentials {
+		creds = append(creds, u.Credentials[i].toLibrary())
+	}
+	return creds
+}
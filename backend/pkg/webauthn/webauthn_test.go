@@ -0,0 +1,139 @@
+package webauthn_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go-wind-admin/pkg/webauthn"
+	"go-wind-admin/pkg/webauthn/webauthntest"
+)
+
+const testOrigin = "https://admin.example.com"
+
+func newRelyingParty(t *testing.T) *webauthn.RelyingParty {
+	t.Helper()
+	rp, err := webauthn.New(webauthn.Config{
+		RPID:      "admin.example.com",
+		RPOrigins: []string{testOrigin},
+	})
+	require.NoError(t, err)
+	return rp
+}
+
+func register(t *testing.T, rp *webauthn.RelyingParty, user *webauthn.User, authr *webauthntest.Authenticator) *webauthn.Credential {
+	t.Helper()
+	ch, err := rp.BeginRegistration(user)
+	require.NoError(t, err)
+	assert.NotEmpty(t, ch.Challenge)
+	assert.Contains(t, ch.OptionsJSON, `"publicKey"`)
+
+	resp, err := authr.Register(ch.OptionsJSON)
+	require.NoError(t, err)
+	cred, err := rp.FinishRegistration(user, ch.Session, resp)
+	require.NoError(t, err)
+	return cred
+}
+
+func TestNew_InvalidConfig(t *testing.T) {
+	_, err := webauthn.New(webauthn.Config{RPID: "admin.example.com"})
+	assert.ErrorIs(t, err, webauthn.ErrInvalidConfig)
+}
+
+func TestRelyingParty_RegisterAndLogin(t *testing.T) {
+	rp := newRelyingParty(t)
+	authr := webauthntest.New(testOrigin)
+	user := &webauthn.User{Handle: []byte{0, 0, 0, 1, 0, 0, 0, 2}, Name: "alice", DisplayName: "Alice"}
+
+	cred := register(t, rp, user, authr)
+	assert.Equal(t, authr.CredentialID, cred.ID)
+	assert.Equal(t, []string{"usb"}, cred.Transports)
+	assert.NotEmpty(t, cred.PublicKey)
+	assert.Equal(t, user.Handle, authr.UserHandle)
+
+	user.Credentials = []webauthn.Credential{*cred}
+
+	ch, err := rp.BeginLogin(user)
+	require.NoError(t, err)
+	resp, err := authr.Assert(ch.OptionsJSON)
+	require.NoError(t, err)
+	updated, err := rp.FinishLogin(user, ch.Session, resp)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(1), updated.SignCount)
+
+	// 会话与挑战绑定：用另一次仪式的响应校验失败
+	other, err := rp.BeginLogin(user)
+	require.NoError(t, err)
+	_, err = rp.FinishLogin(user, other.Session, resp)
+	assert.ErrorIs(t, err, webauthn.ErrInvalidResponse)
+
+	_, err = rp.FinishLogin(user, nil, resp)
+	assert.ErrorIs(t, err, webauthn.ErrInvalidSession)
+}
+
+func TestRelyingParty_CloneDetection(t *testing.T) {
+	rp := newRelyingParty(t)
+	authr := webauthntest.New(testOrigin)
+	user := &webauthn.User{Handle: []byte("u1"), Name: "alice"}
+	cred := register(t, rp, user, authr)
+
+	cred.SignCount = 10
+	user.Credentials = []webauthn.Credential{*cred}
+	authr.SignCount = 4 // 下次断言计数为 5，小于已存值
+
+	ch, err := rp.BeginLogin(user)
+	require.NoError(t, err)
+	resp, err := authr.Assert(ch.OptionsJSON)
+	require.NoError(t, err)
+	got, err := rp.FinishLogin(user, ch.Session, resp)
+	assert.ErrorIs(t, err, webauthn.ErrCloneDetected)
+	require.NotNil(t, got)
+	assert.Equal(t, uint32(10), got.SignCount)
+}
+
+func TestRelyingParty_WrongOrigin(t *testing.T) {
+	rp := newRelyingParty(t)
+	user := &webauthn.User{Handle: []byte("u1"), Name: "alice"}
+
+	ch, err := rp.BeginRegistration(user)
+	require.NoError(t, err)
+	resp, err := webauthntest.New("https://evil.example.com").Register(ch.OptionsJSON)
+	require.NoError(t, err)
+	_, err = rp.FinishRegistration(user, ch.Session, resp)
+	assert.ErrorIs(t, err, webauthn.ErrInvalidResponse)
+}
+
+func TestRelyingParty_DiscoverableLogin(t *testing.T) {
+	rp := newRelyingParty(t)
+	authr := webauthntest.New(testOrigin)
+	user := &webauthn.User{Handle: []byte("handle-1"), Name: "alice"}
+	cred := register(t, rp, user, authr)
+	user.Credentials = []webauthn.Credential{*cred}
+
+	lookup := func(handle []byte) (*webauthn.User, error) {
+		if bytes.Equal(handle, user.Handle) {
+			return user, nil
+		}
+		return nil, nil
+	}
+
+	ch, err := rp.BeginDiscoverableLogin()
+	require.NoError(t, err)
+	resp, err := authr.Assert(ch.OptionsJSON)
+	require.NoError(t, err)
+	found, got, err := rp.FinishDiscoverableLogin(ch.Session, resp, lookup)
+	require.NoError(t, err)
+	assert.Equal(t, user, found)
+	assert.Equal(t, uint32(1), got.SignCount)
+
+	// 未知用户句柄
+	authr.UserHandle = []byte("nobody")
+	ch, err = rp.BeginDiscoverableLogin()
+	require.NoError(t, err)
+	resp, err = authr.Assert(ch.OptionsJSON)
+	require.NoError(t, err)
+	_, _, err = rp.FinishDiscoverableLogin(ch.Session, resp, lookup)
+	assert.ErrorIs(t, err, webauthn.ErrUnknownCredential)
+}
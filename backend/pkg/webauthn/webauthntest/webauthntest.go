@@ -0,0 +1,169 @@
+// Package webauthntest 软件认证器：以 P-256 密钥模拟一把支持可发现凭证的安全密钥，
+// 按依赖方下发的仪式参数生成注册（none 证明）与断言响应，供单元测试替代浏览器与真实认证器。
+//
+// 签名计数器每次断言加一；可直接修改 SignCount 模拟被克隆的认证器。
+package webauthntest
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/go-webauthn/webauthn/protocol/webauthncbor"
+	"github.com/go-webauthn/webauthn/protocol/webauthncose"
+
+	"go-wind-admin/pkg/webauthn"
+)
+
+// 认证器数据标志位
+const (
+	flagUserPresent  = 0x01
+	flagUserVerified = 0x04
+	flagAttestedData = 0x40
+)
+
+// Authenticator 软件认证器，持有一个凭证。
+type Authenticator struct {
+	Origin string
+
+	// CredentialID 注册后生成的凭证 ID
+	CredentialID []byte
+	// UserHandle 注册时依赖方下发的用户句柄，无密码登录时回传
+	UserHandle []byte
+	// SignCount 签名计数器，每次断言前加一
+	SignCount uint32
+
+	key *ecdsa.PrivateKey
+}
+
+// New 创建认证器，origin 为模拟的前端页面源。
+func New(origin string) *Authenticator {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		panic(err)
+	}
+	id := make([]byte, 16)
+	_, _ = rand.Read(id)
+	return &Authenticator{Origin: origin, CredentialID: id, key: key}
+}
+
+type ceremonyOptions struct {
+	PublicKey struct {
+		Challenge string `json:"challenge"`
+		RPID      string `json:"rpId"`
+		RP        struct {
+			ID string `json:"id"`
+		} `json:"rp"`
+		User struct {
+			ID string `json:"id"`
+		} `json:"user"`
+	} `json:"publicKey"`
+}
+
+func parseOptions(optionsJSON string) (*ceremonyOptions, error) {
+	var opts ceremonyOptions
+	if err := json.Unmarshal([]byte(optionsJSON), &opts); err != nil {
+		return nil, fmt.Errorf("webauthntest: invalid options: %w", err)
+	}
+	if opts.PublicKey.Challenge == "" {
+		return nil, errors.New("webauthntest: options without challenge")
+	}
+	return &opts, nil
+}
+
+// Register 按注册仪式参数创建凭证，返回 none 证明的注册响应。
+func (a *Authenticator) Register(optionsJSON string) (*webauthn.AttestationResponse, error) {
+	opts, err := parseOptions(optionsJSON)
+	if err != nil {
+		return nil, err
+	}
+	if a.UserHandle, err = webauthn.DecodeID(opts.PublicKey.User.ID); err != nil {
+		return nil, fmt.Errorf("webauthntest: invalid user id: %w", err)
+	}
+
+	coseKey, err := webauthncbor.Marshal(webauthncose.EC2PublicKeyData{
+		PublicKeyData: webauthncose.PublicKeyData{
+			KeyType:   int64(webauthncose.EllipticKey),
+			Algorithm: int64(webauthncose.AlgES256),
+		},
+		Curve:  int64(webauthncose.P256),
+		XCoord: a.key.X.FillBytes(make([]byte, 32)),
+		YCoord: a.key.Y.FillBytes(make([]byte, 32)),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	authData := a.authenticatorData(opts.PublicKey.RP.ID, flagUserPresent|flagUserVerified|flagAttestedData)
+	authData = append(authData, make([]byte, 16)...) // AAGUID
+	authData = binary.BigEndian.AppendUint16(authData, uint16(len(a.CredentialID)))
+	authData = append(authData, a.CredentialID...)
+	authData = append(authData, coseKey...)
+
+	attestation, err := webauthncbor.Marshal(map[string]any{
+		"fmt":      "none",
+		"attStmt":  map[string]any{},
+		"authData": authData,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &webauthn.AttestationResponse{
+		ID:                webauthn.EncodeID(a.CredentialID),
+		ClientDataJSON:    a.clientData("webauthn.create", opts.PublicKey.Challenge),
+		AttestationObject: base64.RawURLEncoding.EncodeToString(attestation),
+		Transports:        []string{"usb"},
+	}, nil
+}
+
+// Assert 按断言仪式参数签名，返回断言响应（带用户句柄）。
+func (a *Authenticator) Assert(optionsJSON string) (*webauthn.AssertionResponse, error) {
+	opts, err := parseOptions(optionsJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	a.SignCount++
+	authData := a.authenticatorData(opts.PublicKey.RPID, flagUserPresent|flagUserVerified)
+	clientData := a.clientData("webauthn.get", opts.PublicKey.Challenge)
+
+	rawClientData, _ := base64.RawURLEncoding.DecodeString(clientData)
+	clientDataHash := sha256.Sum256(rawClientData)
+	digest := sha256.Sum256(append(append([]byte{}, authData...), clientDataHash[:]...))
+	signature, err := ecdsa.SignASN1(rand.Reader, a.key, digest[:])
+	if err != nil {
+		return nil, err
+	}
+
+	return &webauthn.AssertionResponse{
+		ID:                webauthn.EncodeID(a.CredentialID),
+		ClientDataJSON:    clientData,
+		AuthenticatorData: base64.RawURLEncoding.EncodeToString(authData),
+		Signature:         base64.RawURLEncoding.EncodeToString(signature),
+		UserHandle:        base64.RawURLEncoding.EncodeToString(a.UserHandle),
+	}, nil
+}
+
+func (a *Authenticator) authenticatorData(rpID string, flags byte) []byte {
+	rpIDHash := sha256.Sum256([]byte(rpID))
+	data := append([]byte{}, rpIDHash[:]...)
+	data = append(data, flags)
+	return binary.BigEndian.AppendUint32(data, a.SignCount)
+}
+
+func (a *Authenticator) clientData(ceremony, challenge string) string {
+	raw, _ := json.Marshal(map[string]any{
+		"type":        ceremony,
+		"challenge":   challenge,
+		"origin":      a.Origin,
+		"crossOrigin": false,
+	})
+	return base64.RawURLEncoding.EncodeToString(raw)
+}